@@ -0,0 +1,185 @@
+package accounting
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/louai60/e-commerce_project/backend/admin-service/reports"
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+var day = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func refundEvent(id int64, status string, amount float64, at time.Time) *inventorypb.RefundEvent {
+	return &inventorypb.RefundEvent{
+		Id:               id,
+		RefundId:         "refund-1",
+		Status:           status,
+		OrderReference:   "ORD-1",
+		PaymentReference: "PAY-1",
+		Amount:           amount,
+		Currency:         "USD",
+		CreatedAt:        timestamppb.New(at),
+	}
+}
+
+func TestRefundEntries(t *testing.T) {
+	events := []*inventorypb.RefundEvent{
+		refundEvent(1, "requested", 19.99, day.Add(time.Hour)),
+		refundEvent(2, "processed", 19.99, day.Add(2*time.Hour)),
+		refundEvent(3, "processed", 5, day.Add(-time.Hour)),
+		refundEvent(4, "processed", 7, day.Add(24*time.Hour)),
+	}
+
+	entries := RefundEntries(events, DefaultAccounts, day, day.AddDate(0, 0, 1))
+	if len(entries) != 1 {
+		t.Fatalf("RefundEntries() returned %d entries, want 1", len(entries))
+	}
+	entry := entries[0]
+	if entry.Number != "RF-2" || entry.Reference != "refund-1" {
+		t.Errorf("entry = %+v", entry)
+	}
+	debit, credit := entry.Lines[0], entry.Lines[1]
+	if debit.Account != DefaultAccounts.SalesReturns || debit.Debit != 1999 {
+		t.Errorf("debit line = %+v", debit)
+	}
+	if credit.Account != DefaultAccounts.PaymentClearing || credit.Credit != 1999 {
+		t.Errorf("credit line = %+v", credit)
+	}
+}
+
+func TestEncode(t *testing.T) {
+	entries := RefundEntries([]*inventorypb.RefundEvent{refundEvent(2, "processed", 19.99, day)}, DefaultAccounts, day, day.AddDate(0, 0, 1))
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{FormatCSV, "entry,date,account_code,account_name,debit,credit,currency,reference,description\n" +
+			"RF-2,2024-03-01,4100,Sales Returns and Allowances,19.99,0.00,USD,refund-1,\"Refund of order ORD-1, payment PAY-1\"\n" +
+			"RF-2,2024-03-01,1210,Payment Clearing,0.00,19.99,USD,refund-1,\"Refund of order ORD-1, payment PAY-1\"\n"},
+		{FormatXero, "*Narration,*Date,Description,*AccountCode,*TaxRate,*Amount\n" +
+			"\"RF-2 Refund of order ORD-1, payment PAY-1\",2024-03-01,refund-1,4100,Tax Exempt,19.99\n" +
+			"\"RF-2 Refund of order ORD-1, payment PAY-1\",2024-03-01,refund-1,1210,Tax Exempt,-19.99\n"},
+		{FormatQuickBooks, "Journal No,Journal Date,Currency Code,Memo,Account,Debits,Credits,Description\n" +
+			"RF-2,03/01/2024,USD,\"Refund of order ORD-1, payment PAY-1\",Sales Returns and Allowances,19.99,,refund-1\n" +
+			"RF-2,03/01/2024,USD,\"Refund of order ORD-1, payment PAY-1\",Payment Clearing,,19.99,refund-1\n"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		if err := Encode(&buf, tt.format, entries); err != nil {
+			t.Fatalf("Encode(%s) error = %v", tt.format, err)
+		}
+		if buf.String() != tt.want {
+			t.Errorf("Encode(%s) = %q, want %q", tt.format, buf.String(), tt.want)
+		}
+	}
+
+	if err := Encode(&bytes.Buffer{}, "xlsx", entries); err == nil {
+		t.Error("Encode(xlsx) expected an error")
+	}
+}
+
+func TestParseAccount(t *testing.T) {
+	account, err := ParseAccount(" 4000 : Sales ")
+	if err != nil || account != (Account{Code: "4000", Name: "Sales"}) {
+		t.Errorf("ParseAccount() = %+v, %v", account, err)
+	}
+	for _, value := range []string{"4000", ":Sales", "4000:"} {
+		if _, err := ParseAccount(value); err == nil {
+			t.Errorf("ParseAccount(%q) expected an error", value)
+		}
+	}
+}
+
+// fakeInventory serves refund events
+type fakeInventory struct {
+	inventorypb.InventoryServiceClient
+	events []*inventorypb.RefundEvent
+	calls  int
+}
+
+func (f *fakeInventory) ListRefundEvents(_ context.Context, req *inventorypb.ListRefundEventsRequest, _ ...grpc.CallOption) (*inventorypb.ListRefundEventsResponse, error) {
+	f.calls++
+	resp := &inventorypb.ListRefundEventsResponse{}
+	for _, event := range f.events {
+		if event.Id > req.AfterId && len(resp.Events) < int(req.Limit) {
+			resp.Events = append(resp.Events, event)
+		}
+	}
+	return resp, nil
+}
+
+// fakePublisher keeps stored files in memory
+type fakePublisher struct {
+	files map[string][]byte
+}
+
+func (f *fakePublisher) Store(_ context.Context, kind, format, fileName string, data []byte, rowCount int, generatedAt time.Time) (*reports.Generated, error) {
+	f.files[fileName] = data
+	return &reports.Generated{Kind: kind, Format: format, FileName: fileName, StorageKey: "reports/default/" + fileName, RowCount: rowCount}, nil
+}
+
+func (f *fakePublisher) Link(storageKey string, now time.Time) (string, time.Time) {
+	return "https://shop.example/reports/" + storageKey, now.Add(time.Hour)
+}
+
+func TestExportIsIdempotent(t *testing.T) {
+	runs, err := NewFileRunStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileRunStore() error = %v", err)
+	}
+	inventory := &fakeInventory{events: []*inventorypb.RefundEvent{refundEvent(1, "processed", 10, day.Add(time.Hour))}}
+	publisher := &fakePublisher{files: map[string][]byte{}}
+	service := NewService(inventory, publisher, runs, nil, DefaultAccounts, zap.NewNop())
+	ctx := context.Background()
+
+	run, created, err := service.Export(ctx, FormatXero, day, day.AddDate(0, 0, 1))
+	if err != nil || !created {
+		t.Fatalf("Export() = %v, %v", created, err)
+	}
+	if run.EntryCount != 1 || run.FileName != "journal_xero_20240301_20240301.csv" {
+		t.Errorf("run = %+v", run)
+	}
+
+	// A later refund of the same day is not exported again with the period
+	inventory.events = append(inventory.events, refundEvent(2, "processed", 5, day.Add(2*time.Hour)))
+	again, created, err := service.Export(ctx, FormatXero, day.Add(3*time.Hour), day.AddDate(0, 0, 1))
+	if err != nil || created {
+		t.Fatalf("second Export() = %v, %v", created, err)
+	}
+	if again.ID != run.ID || again.EntryCount != 1 || len(publisher.files) != 1 || inventory.calls != 1 {
+		t.Errorf("second run = %+v, files = %d, calls = %d", again, len(publisher.files), inventory.calls)
+	}
+
+	listed, err := service.ListRuns(ctx, FormatXero)
+	if err != nil || len(listed) != 1 || listed[0].ID != run.ID {
+		t.Errorf("ListRuns() = %+v, %v", listed, err)
+	}
+	if listed, _ := service.ListRuns(ctx, FormatCSV); len(listed) != 0 {
+		t.Errorf("ListRuns(csv) = %+v", listed)
+	}
+}
+
+func TestExportRejectsOpenPeriods(t *testing.T) {
+	runs, err := NewFileRunStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFileRunStore() error = %v", err)
+	}
+	service := NewService(&fakeInventory{}, &fakePublisher{files: map[string][]byte{}}, runs, nil, DefaultAccounts, zap.NewNop())
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	if _, _, err := service.Export(context.Background(), FormatCSV, today, today.AddDate(0, 0, 1)); !errors.Is(err, ErrPeriodNotClosed) {
+		t.Errorf("Export(today) error = %v, want ErrPeriodNotClosed", err)
+	}
+	if _, _, err := service.Export(context.Background(), FormatCSV, day, day); !errors.Is(err, ErrInvalidPeriod) {
+		t.Errorf("Export(empty period) error = %v, want ErrInvalidPeriod", err)
+	}
+}
@@ -0,0 +1,104 @@
+package accounting
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+// Export file formats
+const (
+	// FormatCSV is a generic journal, one row per debit or credit
+	FormatCSV = "csv"
+	// FormatXero is the manual journal import of Xero
+	FormatXero = "xero"
+	// FormatQuickBooks is the journal entry import of QuickBooks Online
+	FormatQuickBooks = "quickbooks"
+)
+
+// Formats lists every export format
+var Formats = []string{FormatCSV, FormatXero, FormatQuickBooks}
+
+// xeroTaxRate is the tax rate of Xero journal lines; amounts are exported
+// as refunded, tax included
+const xeroTaxRate = "Tax Exempt"
+
+// IsValidFormat reports whether format names a known export format
+func IsValidFormat(format string) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// Encode writes the entries as a CSV file of the given format
+func Encode(w io.Writer, format string, entries []Entry) error {
+	writer := csv.NewWriter(w)
+	switch format {
+	case FormatCSV:
+		writer.Write([]string{"entry", "date", "account_code", "account_name", "debit", "credit", "currency", "reference", "description"})
+		for _, entry := range entries {
+			for _, line := range entry.Lines {
+				writer.Write([]string{
+					entry.Number,
+					entry.Date.Format("2006-01-02"),
+					line.Account.Code,
+					line.Account.Name,
+					formatCents(line.Debit),
+					formatCents(line.Credit),
+					entry.Currency,
+					entry.Reference,
+					entry.Description,
+				})
+			}
+		}
+	case FormatXero:
+		// Debits are positive amounts and credits negative ones; lines of
+		// the same narration and date form one journal
+		writer.Write([]string{"*Narration", "*Date", "Description", "*AccountCode", "*TaxRate", "*Amount"})
+		for _, entry := range entries {
+			for _, line := range entry.Lines {
+				writer.Write([]string{
+					entry.Number + " " + entry.Description,
+					entry.Date.Format("2006-01-02"),
+					entry.Reference,
+					line.Account.Code,
+					xeroTaxRate,
+					formatCents(line.Debit - line.Credit),
+				})
+			}
+		}
+	case FormatQuickBooks:
+		writer.Write([]string{"Journal No", "Journal Date", "Currency Code", "Memo", "Account", "Debits", "Credits", "Description"})
+		for _, entry := range entries {
+			for _, line := range entry.Lines {
+				debit, credit := "", ""
+				if line.Debit != 0 {
+					debit = formatCents(line.Debit)
+				}
+				if line.Credit != 0 {
+					credit = formatCents(line.Credit)
+				}
+				writer.Write([]string{
+					entry.Number,
+					entry.Date.Format("01/02/2006"),
+					entry.Currency,
+					entry.Description,
+					line.Account.Name,
+					debit,
+					credit,
+					entry.Reference,
+				})
+			}
+		}
+	default:
+		return fmt.Errorf("unsupported export format %q", format)
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+	return nil
+}
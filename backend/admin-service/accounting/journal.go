@@ -0,0 +1,110 @@
+// Package accounting exports the money movements of the stores as
+// double-entry journals for finance, in CSV or in the journal import formats
+// of Xero and QuickBooks. Exports cover whole days and are recorded as runs,
+// so exporting a period again returns the file of the first run instead of
+// posting its entries twice.
+//
+// Processed refunds are the only movements the backend records today; order
+// payments, gift cards and tax are journaled once the services recording
+// them exist.
+package accounting
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// refundProcessed is the refund status at which money leaves the store
+const refundProcessed = "processed"
+
+// Account is a ledger account of the chart of accounts of a store
+type Account struct {
+	Code string
+	Name string
+}
+
+// Accounts are the ledger accounts entries are posted to
+type Accounts struct {
+	// SalesReturns is debited with the refunds paid back to customers
+	SalesReturns Account
+	// PaymentClearing is the account the payment provider settles through
+	PaymentClearing Account
+}
+
+// DefaultAccounts follow common chart of accounts numbering
+var DefaultAccounts = Accounts{
+	SalesReturns:    Account{Code: "4100", Name: "Sales Returns and Allowances"},
+	PaymentClearing: Account{Code: "1210", Name: "Payment Clearing"},
+}
+
+// ParseAccount parses a "code:name" account, e.g. "4100:Sales Returns"
+func ParseAccount(value string) (Account, error) {
+	code, name, ok := strings.Cut(value, ":")
+	code, name = strings.TrimSpace(code), strings.TrimSpace(name)
+	if !ok || code == "" || name == "" {
+		return Account{}, fmt.Errorf("account %q must be code:name", value)
+	}
+	return Account{Code: code, Name: name}, nil
+}
+
+// Entry is a balanced journal entry
+type Entry struct {
+	// Number identifies the entry in the ledger; it is derived from the
+	// movement journaled, so exporting it again yields the same number
+	Number      string
+	Date        time.Time
+	Reference   string
+	Description string
+	Currency    string
+	Lines       []Line
+}
+
+// Line is a debit or a credit of an account, in cents
+type Line struct {
+	Account Account
+	Debit   int64
+	Credit  int64
+}
+
+// RefundEntries journals the refunds processed within [start, end): the
+// amount refunded is debited to sales returns and credited to payment
+// clearing. Other refund events move no money and are skipped.
+func RefundEntries(events []*inventorypb.RefundEvent, accounts Accounts, start, end time.Time) []Entry {
+	var entries []Entry
+	for _, event := range events {
+		occurredAt := event.CreatedAt.AsTime()
+		if event.Status != refundProcessed || occurredAt.Before(start) || !occurredAt.Before(end) {
+			continue
+		}
+		amount := toCents(event.Amount)
+		entries = append(entries, Entry{
+			Number:      fmt.Sprintf("RF-%d", event.Id),
+			Date:        occurredAt.UTC(),
+			Reference:   event.RefundId,
+			Description: fmt.Sprintf("Refund of order %s, payment %s", event.OrderReference, event.PaymentReference),
+			Currency:    event.Currency,
+			Lines: []Line{
+				{Account: accounts.SalesReturns, Debit: amount},
+				{Account: accounts.PaymentClearing, Credit: amount},
+			},
+		})
+	}
+	return entries
+}
+
+func toCents(amount float64) int64 {
+	return int64(math.Round(amount * 100))
+}
+
+// formatCents formats cents as a decimal amount, e.g. 1999 as "19.99"
+func formatCents(cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign, cents = "-", -cents
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, cents/100, cents%100)
+}
@@ -0,0 +1,127 @@
+package accounting
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+)
+
+// ErrRunNotFound is returned for a period that was not exported yet
+var ErrRunNotFound = errors.New("export run not found")
+
+// Run is the export of the journal of a store for a period in a format
+type Run struct {
+	ID     string    `json:"id"`
+	Format string    `json:"format"`
+	Start  time.Time `json:"start"` // First day of the period
+	End    time.Time `json:"end"`   // Day after the last day of the period
+	// FileName and StorageKey locate the exported file in report storage
+	FileName   string    `json:"file_name"`
+	StorageKey string    `json:"storage_key"`
+	EntryCount int       `json:"entry_count"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// RunID returns the ID of the run exporting [start, end) in a format, e.g.
+// xero_20240101_20240102
+func RunID(format string, start, end time.Time) string {
+	return fmt.Sprintf("%s_%s_%s", format, start.UTC().Format("20060102"), end.UTC().Format("20060102"))
+}
+
+// RunStore records the export runs of the store in the context
+type RunStore interface {
+	GetRun(ctx context.Context, id string) (*Run, error)
+	SaveRun(ctx context.Context, run *Run) error
+	// ListRuns lists the runs newest first
+	ListRuns(ctx context.Context) ([]Run, error)
+}
+
+// FileRunStore keeps each run as a JSON file, one folder per store
+type FileRunStore struct {
+	dir string
+}
+
+// NewFileRunStore creates a run store in dir, creating it when missing
+func NewFileRunStore(dir string) (*FileRunStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create export run directory: %w", err)
+	}
+	return &FileRunStore{dir: dir}, nil
+}
+
+// GetRun reads a run of the store in ctx
+func (s *FileRunStore) GetRun(ctx context.Context, id string) (*Run, error) {
+	data, err := os.ReadFile(s.path(ctx, id))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, ErrRunNotFound
+		}
+		return nil, fmt.Errorf("failed to read export run: %w", err)
+	}
+	var run Run
+	if err := json.Unmarshal(data, &run); err != nil {
+		return nil, fmt.Errorf("failed to decode export run %s: %w", id, err)
+	}
+	return &run, nil
+}
+
+// SaveRun writes a run of the store in ctx. The file is written aside and
+// renamed into place, so a run is never read half written.
+func (s *FileRunStore) SaveRun(ctx context.Context, run *Run) error {
+	data, err := json.Marshal(run)
+	if err != nil {
+		return fmt.Errorf("failed to encode export run: %w", err)
+	}
+	path := s.path(ctx, run.ID)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create export run directory: %w", err)
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write export run: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write export run: %w", err)
+	}
+	return nil
+}
+
+// ListRuns lists the runs of the store in ctx, newest first
+func (s *FileRunStore) ListRuns(ctx context.Context) ([]Run, error) {
+	files, err := os.ReadDir(filepath.Join(s.dir, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list export runs: %w", err)
+	}
+
+	var runs []Run
+	for _, file := range files {
+		id, ok := strings.CutSuffix(file.Name(), ".json")
+		if !ok || file.IsDir() {
+			continue
+		}
+		run, err := s.GetRun(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, *run)
+	}
+	sort.SliceStable(runs, func(i, j int) bool {
+		return runs[i].CreatedAt.After(runs[j].CreatedAt)
+	})
+	return runs, nil
+}
+
+func (s *FileRunStore) path(ctx context.Context, id string) string {
+	return filepath.Join(s.dir, tenant.FromContext(ctx), filepath.Base(id)+".json")
+}
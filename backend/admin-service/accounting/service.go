@@ -0,0 +1,241 @@
+package accounting
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/admin-service/reports"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+const (
+	// eventPageSize is the page size used when reading refund events
+	eventPageSize = 500
+	// maxPeriodDays bounds the period of an export
+	maxPeriodDays = 366
+	// reportKind is the report kind exports are stored as
+	reportKind = "journal"
+)
+
+var (
+	// ErrInvalidPeriod is returned for periods that are empty or too long
+	ErrInvalidPeriod = fmt.Errorf("period must cover 1 to %d days", maxPeriodDays)
+	// ErrPeriodNotClosed is returned for periods that include today, whose
+	// movements are still coming in
+	ErrPeriodNotClosed = errors.New("period must end before today")
+)
+
+// Publisher stores export files and signs their download links;
+// reports.Service implements it
+type Publisher interface {
+	Store(ctx context.Context, kind, format, fileName string, data []byte, rowCount int, generatedAt time.Time) (*reports.Generated, error)
+	Link(storageKey string, now time.Time) (string, time.Time)
+}
+
+// Service exports journals and records the export runs
+type Service struct {
+	inventory inventorypb.InventoryServiceClient // nil when not configured
+	publisher Publisher
+	runs      RunStore
+	stores    productpb.ProductServiceClient
+	accounts  Accounts
+	logger    *zap.Logger
+	// mu serializes exports, so that a scheduled and an admin export of the
+	// same period do not both run
+	mu sync.Mutex
+}
+
+// NewService creates an accounting export service. inventory may be nil.
+// stores lists the stores scheduled exports are run for.
+func NewService(inventory inventorypb.InventoryServiceClient, publisher Publisher, runs RunStore, stores productpb.ProductServiceClient, accounts Accounts, logger *zap.Logger) *Service {
+	return &Service{
+		inventory: inventory,
+		publisher: publisher,
+		runs:      runs,
+		stores:    stores,
+		accounts:  accounts,
+		logger:    logger.Named("accounting"),
+	}
+}
+
+// Export exports the journal of the store in ctx for the days from start up
+// to end, exclusive. A period already exported in the format returns its
+// first run, with created false, so entries are never exported twice.
+func (s *Service) Export(ctx context.Context, format string, start, end time.Time) (run *Run, created bool, err error) {
+	if !IsValidFormat(format) {
+		return nil, false, fmt.Errorf("unsupported export format %q", format)
+	}
+	start, end = truncateDay(start), truncateDay(end)
+	if !end.After(start) || end.Sub(start) > maxPeriodDays*24*time.Hour {
+		return nil, false, ErrInvalidPeriod
+	}
+	if end.After(truncateDay(time.Now())) {
+		return nil, false, ErrPeriodNotClosed
+	}
+	if s.inventory == nil {
+		return nil, false, reports.ErrInventoryUnavailable
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := RunID(format, start, end)
+	run, err = s.runs.GetRun(ctx, id)
+	if err == nil {
+		return run, false, nil
+	}
+	if !errors.Is(err, ErrRunNotFound) {
+		return nil, false, err
+	}
+
+	events, err := s.refundEvents(ctx, end)
+	if err != nil {
+		return nil, false, err
+	}
+	entries := RefundEntries(events, s.accounts, start, end)
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, format, entries); err != nil {
+		return nil, false, err
+	}
+	now := time.Now()
+	fileName := fmt.Sprintf("journal_%s_%s_%s.csv", format, start.Format("20060102"), end.AddDate(0, 0, -1).Format("20060102"))
+	stored, err := s.publisher.Store(ctx, reportKind, format, fileName, buf.Bytes(), len(entries), now)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// A run is recorded only once its file is stored; an export failing in
+	// between is simply run again
+	run = &Run{
+		ID:         id,
+		Format:     format,
+		Start:      start,
+		End:        end,
+		FileName:   fileName,
+		StorageKey: stored.StorageKey,
+		EntryCount: len(entries),
+		CreatedAt:  now.UTC(),
+	}
+	if err := s.runs.SaveRun(ctx, run); err != nil {
+		return nil, false, err
+	}
+
+	s.logger.Info("Journal exported",
+		zap.String("tenant_id", tenant.FromContext(ctx)),
+		zap.String("run_id", run.ID),
+		zap.Int("entries", run.EntryCount))
+	return run, true, nil
+}
+
+// ListRuns lists the export runs of the store in ctx, of one format when
+// given, newest first
+func (s *Service) ListRuns(ctx context.Context, format string) ([]Run, error) {
+	runs, err := s.runs.ListRuns(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if format == "" {
+		return runs, nil
+	}
+	filtered := runs[:0]
+	for _, run := range runs {
+		if run.Format == format {
+			filtered = append(filtered, run)
+		}
+	}
+	return filtered, nil
+}
+
+// Link signs a download link of the file of a run, empty when links are not
+// configured
+func (s *Service) Link(run *Run) (string, time.Time) {
+	return s.publisher.Link(run.StorageKey, time.Now())
+}
+
+// refundEvents reads the refund events recorded before end, oldest first
+func (s *Service) refundEvents(ctx context.Context, end time.Time) ([]*inventorypb.RefundEvent, error) {
+	var events []*inventorypb.RefundEvent
+	var afterID int64
+	for {
+		resp, err := s.inventory.ListRefundEvents(ctx, &inventorypb.ListRefundEventsRequest{
+			AfterId: afterID,
+			Limit:   eventPageSize,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list refund events: %w", err)
+		}
+		events = append(events, resp.Events...)
+		if len(resp.Events) < eventPageSize {
+			return events, nil
+		}
+		last := resp.Events[len(resp.Events)-1]
+		if !last.CreatedAt.AsTime().Before(end) {
+			return events, nil
+		}
+		afterID = last.Id
+	}
+}
+
+// StartScheduler exports the journal of the previous day in each format for
+// every active store, right away and then at each interval, until the
+// context is cancelled. Days already exported are skipped.
+func (s *Service) StartScheduler(ctx context.Context, formats []string, interval time.Duration) {
+	if len(formats) == 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			s.runSchedule(ctx, formats)
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Accounting export scheduler stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// runSchedule exports the previous day for every active store. A failing
+// store does not stop the others.
+func (s *Service) runSchedule(ctx context.Context, formats []string) {
+	resp, err := s.stores.ListStores(ctx, &productpb.ListStoresRequest{})
+	if err != nil {
+		s.logger.Error("Failed to list stores for scheduled accounting export", zap.Error(err))
+		return
+	}
+
+	end := truncateDay(time.Now())
+	start := end.AddDate(0, 0, -1)
+	for _, store := range resp.Stores {
+		if !store.IsActive {
+			continue
+		}
+		storeCtx := tenant.WithTenant(ctx, store.Id)
+		for _, format := range formats {
+			if _, _, err := s.Export(storeCtx, format, start, end); err != nil {
+				s.logger.Error("Scheduled accounting export failed",
+					zap.String("tenant_id", store.Id),
+					zap.String("format", format),
+					zap.Error(err))
+			}
+		}
+	}
+}
+
+// truncateDay returns the start of the UTC day of t
+func truncateDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,203 @@
+// Package activity aggregates the recent changes of a store from the backend
+// services into a single feed for the admin dashboard: catalog changes from
+// the product service, stock movements from the inventory service and
+// account events from the user service. Each service pages through its own
+// changes newest first; the feed merges their pages by time and pages
+// through them with a cursor holding the position reached in each service.
+package activity
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/admin-service/fanout"
+)
+
+// Sources of the feed
+const (
+	SourceCatalog   = "catalog"
+	SourceInventory = "inventory"
+	SourceUsers     = "users"
+)
+
+const (
+	// DefaultLimit is the number of entries of a page when none is requested
+	DefaultLimit = 50
+	// MaxLimit bounds the entries of a page
+	MaxLimit = 200
+)
+
+var (
+	// ErrInvalidCursor is returned for cursors the feed did not issue
+	ErrInvalidCursor = errors.New("invalid activity cursor")
+	// ErrUnknownSource is returned when listing a source the feed lacks
+	ErrUnknownSource = errors.New("unknown activity source")
+)
+
+// Entry is a change in the feed
+type Entry struct {
+	Source     string
+	ID         string
+	Type       string
+	SubjectID  string
+	Subject    string
+	ActorID    string
+	Summary    string
+	OccurredAt time.Time
+
+	// position resumes the source right after this entry
+	position string
+}
+
+// Source lists the changes of one service. List returns up to limit entries
+// older than position, newest first, each with the position after it; the
+// latest entries when position is empty.
+type Source struct {
+	Name    string
+	Service string
+	List    func(ctx context.Context, position string, limit int) ([]Entry, error)
+}
+
+// Page is a page of the feed. NextCursor is empty on the last page.
+type Page struct {
+	Entries    []Entry
+	NextCursor string
+	Failures   []fanout.Failure
+}
+
+// Feed merges the changes of its sources
+type Feed struct {
+	sources []Source
+	runner  *fanout.Runner
+}
+
+// NewFeed creates a feed of the given sources, calling them with runner
+func NewFeed(runner *fanout.Runner, sources ...Source) *Feed {
+	return &Feed{sources: sources, runner: runner}
+}
+
+// Sources returns the names of the sources of the feed
+func (f *Feed) Sources() []string {
+	names := make([]string, len(f.sources))
+	for i, source := range f.sources {
+		names[i] = source.Name
+	}
+	return names
+}
+
+// List returns the page of the feed following cursor, the latest one when
+// cursor is empty, with the entries of the named sources or of all sources
+// when names is empty. A source that cannot answer is reported in the
+// failures and left where it was, so that the next pages catch up on it.
+func (f *Feed) List(ctx context.Context, cursor string, limit int, names ...string) (*Page, error) {
+	positions, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	sources := f.sources
+	if len(names) > 0 {
+		sources = nil
+		for _, name := range names {
+			source, ok := f.source(name)
+			if !ok {
+				return nil, fmt.Errorf("%w %q", ErrUnknownSource, name)
+			}
+			sources = append(sources, source)
+		}
+	}
+
+	// Each source lists one more entry than needed to tell whether it has
+	// more after the page
+	results := make([][]Entry, len(sources))
+	calls := make([]fanout.Call, len(sources))
+	for i, source := range sources {
+		calls[i] = fanout.Call{
+			Service:    source.Service,
+			Idempotent: true,
+			Do: func(ctx context.Context) error {
+				entries, err := source.List(ctx, positions[source.Name], limit+1)
+				if err != nil {
+					return err
+				}
+				for j := range entries {
+					entries[j].Source = source.Name
+				}
+				results[i] = entries
+				return nil
+			},
+		}
+	}
+	page := &Page{Failures: f.runner.Run(ctx, calls...)}
+
+	var merged []Entry
+	for _, entries := range results {
+		merged = append(merged, entries...)
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		if !merged[i].OccurredAt.Equal(merged[j].OccurredAt) {
+			return merged[i].OccurredAt.After(merged[j].OccurredAt)
+		}
+		return merged[i].Source < merged[j].Source
+	})
+
+	more := len(merged) > limit || len(page.Failures) > 0
+	if len(merged) > limit {
+		merged = merged[:limit]
+	}
+	page.Entries = merged
+
+	if more {
+		next := make(map[string]string, len(positions))
+		for name, position := range positions {
+			next[name] = position
+		}
+		for _, entry := range merged {
+			next[entry.Source] = entry.position
+		}
+		page.NextCursor = encodeCursor(next)
+	}
+	return page, nil
+}
+
+func (f *Feed) source(name string) (Source, bool) {
+	for _, source := range f.sources {
+		if source.Name == name {
+			return source, true
+		}
+	}
+	return Source{}, false
+}
+
+// The cursor is the base64 encoded JSON object of the positions reached in
+// each source
+func encodeCursor(positions map[string]string) string {
+	data, _ := json.Marshal(positions)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(cursor string) (map[string]string, error) {
+	positions := make(map[string]string)
+	if cursor == "" {
+		return positions, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(data, &positions); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return positions, nil
+}
@@ -0,0 +1,136 @@
+package activity
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/admin-service/fanout"
+)
+
+// fakeSource serves entries given newest first, with their index as position
+func fakeSource(name string, entries []Entry, err *error) Source {
+	return Source{
+		Name:    name,
+		Service: name + "-service",
+		List: func(ctx context.Context, position string, limit int) ([]Entry, error) {
+			if err != nil && *err != nil {
+				return nil, *err
+			}
+			start := 0
+			if position != "" {
+				index, _ := strconv.Atoi(position)
+				start = index + 1
+			}
+			var page []Entry
+			for i := start; i < len(entries) && len(page) < limit; i++ {
+				entry := entries[i]
+				entry.position = strconv.Itoa(i)
+				page = append(page, entry)
+			}
+			return page, nil
+		},
+	}
+}
+
+func at(minute int) time.Time {
+	return time.Date(2026, 1, 1, 12, minute, 0, 0, time.UTC)
+}
+
+func newTestRunner() *fanout.Runner {
+	runner := fanout.NewRunner(zap.NewNop())
+	runner.Attempts = 1
+	return runner
+}
+
+func TestListMergesSourcesAcrossPages(t *testing.T) {
+	catalog := []Entry{{ID: "c1", OccurredAt: at(50)}, {ID: "c2", OccurredAt: at(30)}, {ID: "c3", OccurredAt: at(10)}}
+	users := []Entry{{ID: "u1", OccurredAt: at(40)}, {ID: "u2", OccurredAt: at(20)}}
+	feed := NewFeed(newTestRunner(), fakeSource(SourceCatalog, catalog, nil), fakeSource(SourceUsers, users, nil))
+
+	var got []string
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > 5 {
+			t.Fatal("List() did not reach the last page")
+		}
+		page, err := feed.List(context.Background(), cursor, 2)
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		for _, entry := range page.Entries {
+			got = append(got, entry.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	want := []string{"c1", "u1", "c2", "u2", "c3"}
+	if len(got) != len(want) {
+		t.Fatalf("entries = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("entries = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestListKeepsFailedSourcePosition(t *testing.T) {
+	catalog := []Entry{{ID: "c1", OccurredAt: at(50)}}
+	users := []Entry{{ID: "u1", OccurredAt: at(40)}}
+	usersErr := status.Error(codes.Unavailable, "connection refused")
+	feed := NewFeed(newTestRunner(), fakeSource(SourceCatalog, catalog, nil), fakeSource(SourceUsers, users, &usersErr))
+
+	page, err := feed.List(context.Background(), "", 10)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(page.Failures) != 1 || page.Failures[0].Service != "users-service" {
+		t.Fatalf("failures = %+v, want users-service", page.Failures)
+	}
+	if len(page.Entries) != 1 || page.Entries[0].ID != "c1" {
+		t.Fatalf("entries = %+v, want c1", page.Entries)
+	}
+	if page.NextCursor == "" {
+		t.Fatal("NextCursor is empty, want a cursor to catch up on the failed source")
+	}
+
+	usersErr = nil
+	page, err = feed.List(context.Background(), page.NextCursor, 10)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(page.Entries) != 1 || page.Entries[0].ID != "u1" || page.NextCursor != "" {
+		t.Errorf("next page = %+v, want only u1 and no cursor", page)
+	}
+}
+
+func TestListSourcesAndCursorValidation(t *testing.T) {
+	catalog := []Entry{{ID: "c1", OccurredAt: at(50)}}
+	users := []Entry{{ID: "u1", OccurredAt: at(40)}}
+	feed := NewFeed(newTestRunner(), fakeSource(SourceCatalog, catalog, nil), fakeSource(SourceUsers, users, nil))
+
+	page, err := feed.List(context.Background(), "", 10, SourceUsers)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(page.Entries) != 1 || page.Entries[0].Source != SourceUsers {
+		t.Errorf("entries = %+v, want the users entry only", page.Entries)
+	}
+
+	if _, err := feed.List(context.Background(), "", 10, "orders"); !errors.Is(err, ErrUnknownSource) {
+		t.Errorf("List() with an unknown source error = %v, want ErrUnknownSource", err)
+	}
+	if _, err := feed.List(context.Background(), "not a cursor!", 10); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("List() with an invalid cursor error = %v, want ErrInvalidCursor", err)
+	}
+}
@@ -0,0 +1,147 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	userpb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// CatalogSource lists the product changes recorded by the product service.
+// Positions are catalog activity IDs.
+func CatalogSource(client productpb.ProductServiceClient) Source {
+	return Source{
+		Name:    SourceCatalog,
+		Service: "product-service",
+		List: func(ctx context.Context, position string, limit int) ([]Entry, error) {
+			beforeID, err := parseID(position)
+			if err != nil {
+				return nil, err
+			}
+			resp, err := client.ListCatalogActivity(ctx, &productpb.ListCatalogActivityRequest{
+				BeforeId: beforeID,
+				Limit:    int32(limit),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			entries := make([]Entry, len(resp.Entries))
+			for i, change := range resp.Entries {
+				id := strconv.FormatInt(change.Id, 10)
+				entries[i] = Entry{
+					ID:         id,
+					Type:       "product." + change.Action,
+					SubjectID:  change.ProductId,
+					Subject:    change.ProductTitle,
+					ActorID:    change.ActorId,
+					Summary:    fmt.Sprintf("Product %q %s", change.ProductTitle, change.Action),
+					OccurredAt: change.OccurredAt.AsTime(),
+					position:   id,
+				}
+			}
+			return entries, nil
+		},
+	}
+}
+
+// InventorySource lists the stock movements of the inventory service.
+// Positions are the time and ID of a movement.
+func InventorySource(client inventorypb.InventoryServiceClient) Source {
+	return Source{
+		Name:    SourceInventory,
+		Service: "inventory-service",
+		List: func(ctx context.Context, position string, limit int) ([]Entry, error) {
+			req := &inventorypb.ListInventoryActivityRequest{Limit: int32(limit)}
+			if position != "" {
+				at, id, ok := strings.Cut(position, "|")
+				before, err := time.Parse(time.RFC3339Nano, at)
+				if !ok || err != nil {
+					return nil, ErrInvalidCursor
+				}
+				req.BeforeTime = timestamppb.New(before)
+				req.BeforeId = id
+			}
+			resp, err := client.ListInventoryActivity(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			entries := make([]Entry, len(resp.Entries))
+			for i, movement := range resp.Entries {
+				occurredAt := movement.CreatedAt.AsTime()
+				entries[i] = Entry{
+					ID:         movement.Id,
+					Type:       "inventory." + movement.TransactionType,
+					SubjectID:  movement.ProductId,
+					Subject:    movement.Sku,
+					ActorID:    movement.CreatedBy,
+					Summary:    fmt.Sprintf("%s %s %+d", movement.Sku, strings.ReplaceAll(movement.TransactionType, "_", " "), movement.Quantity),
+					OccurredAt: occurredAt,
+					position:   occurredAt.Format(time.RFC3339Nano) + "|" + movement.Id,
+				}
+			}
+			return entries, nil
+		},
+	}
+}
+
+// UserSource lists the account events of the user service. Positions are
+// user event IDs.
+func UserSource(client userpb.UserServiceClient) Source {
+	return Source{
+		Name:    SourceUsers,
+		Service: "user-service",
+		List: func(ctx context.Context, position string, limit int) ([]Entry, error) {
+			beforeID, err := parseID(position)
+			if err != nil {
+				return nil, err
+			}
+			resp, err := client.ListUserEvents(ctx, &userpb.ListUserEventsRequest{
+				BeforeId: beforeID,
+				Limit:    int32(limit),
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			entries := make([]Entry, len(resp.Events))
+			for i, event := range resp.Events {
+				occurredAt, err := time.Parse(time.RFC3339, event.CreatedAt)
+				if err != nil {
+					return nil, fmt.Errorf("invalid time of user event %d: %w", event.Id, err)
+				}
+				id := strconv.FormatInt(event.Id, 10)
+				entries[i] = Entry{
+					ID:         id,
+					Type:       "user." + event.Type,
+					SubjectID:  event.UserId,
+					Subject:    event.Email,
+					ActorID:    event.ActorId,
+					Summary:    fmt.Sprintf("%s %s", event.Email, strings.ReplaceAll(event.Type, "_", " ")),
+					OccurredAt: occurredAt,
+					position:   id,
+				}
+			}
+			return entries, nil
+		},
+	}
+}
+
+func parseID(position string) (int64, error) {
+	if position == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(position, 10, 64)
+	if err != nil || id <= 0 {
+		return 0, ErrInvalidCursor
+	}
+	return id, nil
+}
@@ -0,0 +1,154 @@
+// Package fanout calls the backend services an admin request depends on
+// concurrently. Each dependency gets its own timeout, idempotent reads are
+// retried after transient failures, and a dependency that cannot answer is
+// reported as a failure instead of failing the whole request, so handlers
+// can return partial results marked as degraded.
+package fanout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultTimeout bounds each attempt of a call without its own timeout
+	DefaultTimeout = 3 * time.Second
+	// DefaultAttempts is the number of attempts of idempotent calls
+	DefaultAttempts = 3
+	// DefaultBackoff is the wait before the first retry
+	DefaultBackoff = 100 * time.Millisecond
+)
+
+// Call is a request to one dependency. Do must only keep its result when it
+// succeeds, since a retried call runs Do again.
+type Call struct {
+	// Service names the dependency in failures and timeouts
+	Service string
+	// Idempotent calls are retried after transient failures
+	Idempotent bool
+	Do         func(ctx context.Context) error
+}
+
+// Failure is a dependency that could not answer
+type Failure struct {
+	Service string
+	Err     error
+}
+
+// Runner runs the calls of a request concurrently
+type Runner struct {
+	// Timeouts overrides the timeout of the attempts of a service
+	Timeouts map[string]time.Duration
+	// Attempts is the number of attempts of idempotent calls
+	Attempts int
+	// Backoff is the wait before the first retry, doubled for each next one
+	Backoff time.Duration
+	logger  *zap.Logger
+}
+
+// NewRunner creates a runner with the default timeout and retries
+func NewRunner(logger *zap.Logger) *Runner {
+	return &Runner{
+		Attempts: DefaultAttempts,
+		Backoff:  DefaultBackoff,
+		logger:   logger,
+	}
+}
+
+// Run runs the calls concurrently and waits for all of them. It returns the
+// failed calls in the order of calls, none when every call succeeded.
+func (r *Runner) Run(ctx context.Context, calls ...Call) []Failure {
+	errs := make([]error, len(calls))
+	var wg sync.WaitGroup
+	for i, call := range calls {
+		wg.Add(1)
+		go func(i int, call Call) {
+			defer wg.Done()
+			errs[i] = r.call(ctx, call)
+		}(i, call)
+	}
+	wg.Wait()
+
+	var failures []Failure
+	for i, err := range errs {
+		if err != nil {
+			r.logger.Warn("Dependency failed", zap.String("service", calls[i].Service), zap.Error(err))
+			failures = append(failures, Failure{Service: calls[i].Service, Err: err})
+		}
+	}
+	return failures
+}
+
+func (r *Runner) call(ctx context.Context, call Call) error {
+	attempts := 1
+	if call.Idempotent && r.Attempts > 1 {
+		attempts = r.Attempts
+	}
+	timeout := r.Timeouts[call.Service]
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	backoff := r.Backoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = callOnce(ctx, call, timeout)
+		if err == nil || attempt == attempts || !Retryable(err) || ctx.Err() != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func callOnce(ctx context.Context, call Call, timeout time.Duration) error {
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return call.Do(callCtx)
+}
+
+// Retryable reports whether a failed call may succeed when made again
+func Retryable(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// ParseTimeouts parses comma separated service=duration entries, e.g.
+// "product-service=2s,inventory-service=500ms"
+func ParseTimeouts(value string) (map[string]time.Duration, error) {
+	timeouts := make(map[string]time.Duration)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		service, duration, ok := strings.Cut(entry, "=")
+		if !ok || strings.TrimSpace(service) == "" {
+			return nil, fmt.Errorf("timeout %q must be service=duration", entry)
+		}
+		timeout, err := time.ParseDuration(strings.TrimSpace(duration))
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("timeout %q: duration must be positive", entry)
+		}
+		timeouts[strings.TrimSpace(service)] = timeout
+	}
+	return timeouts, nil
+}
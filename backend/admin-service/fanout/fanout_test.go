@@ -0,0 +1,85 @@
+package fanout
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRunPartialResults(t *testing.T) {
+	runner := NewRunner(zap.NewNop())
+	runner.Backoff = time.Millisecond
+
+	var products, users int
+	var userAttempts, writeAttempts int32
+	failures := runner.Run(context.Background(),
+		Call{Service: "product-service", Idempotent: true, Do: func(ctx context.Context) error {
+			products = 12
+			return nil
+		}},
+		Call{Service: "user-service", Idempotent: true, Do: func(ctx context.Context) error {
+			if atomic.AddInt32(&userAttempts, 1) < 2 {
+				return status.Error(codes.Unavailable, "connection refused")
+			}
+			users = 3
+			return nil
+		}},
+		Call{Service: "inventory-service", Do: func(ctx context.Context) error {
+			atomic.AddInt32(&writeAttempts, 1)
+			return status.Error(codes.Unavailable, "connection refused")
+		}},
+	)
+
+	if products != 12 || users != 3 {
+		t.Errorf("results = %d products, %d users, want 12 and 3", products, users)
+	}
+	if userAttempts != 2 {
+		t.Errorf("user-service attempts = %d, want 2", userAttempts)
+	}
+	if writeAttempts != 1 {
+		t.Errorf("non-idempotent call attempts = %d, want 1", writeAttempts)
+	}
+	if len(failures) != 1 || failures[0].Service != "inventory-service" {
+		t.Fatalf("failures = %+v, want inventory-service", failures)
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	runner := NewRunner(zap.NewNop())
+	runner.Attempts = 1
+	runner.Timeouts = map[string]time.Duration{"slow-service": 10 * time.Millisecond}
+
+	start := time.Now()
+	failures := runner.Run(context.Background(), Call{Service: "slow-service", Do: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}})
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Run() took %s, want the service timeout", elapsed)
+	}
+	if len(failures) != 1 || !errors.Is(failures[0].Err, context.DeadlineExceeded) {
+		t.Errorf("failures = %+v, want a deadline failure", failures)
+	}
+}
+
+func TestParseTimeouts(t *testing.T) {
+	timeouts, err := ParseTimeouts("product-service=2s, inventory-service=500ms")
+	if err != nil {
+		t.Fatalf("ParseTimeouts() error = %v", err)
+	}
+	if timeouts["product-service"] != 2*time.Second || timeouts["inventory-service"] != 500*time.Millisecond {
+		t.Errorf("ParseTimeouts() = %v", timeouts)
+	}
+
+	for _, value := range []string{"product-service", "=2s", "user-service=soon", "user-service=-1s"} {
+		if _, err := ParseTimeouts(value); err == nil {
+			t.Errorf("ParseTimeouts(%q) expected an error", value)
+		}
+	}
+}
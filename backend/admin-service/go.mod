@@ -3,7 +3,10 @@ module github.com/louai60/e-commerce_project/backend/admin-service
 go 1.24.0
 
 require (
+	github.com/google/uuid v1.6.0
 	github.com/joho/godotenv v1.5.1
+	github.com/louai60/e-commerce_project/backend/common v0.0.0
+	github.com/louai60/e-commerce_project/backend/inventory-service v0.0.0-00010101000000-000000000000
 	github.com/louai60/e-commerce_project/backend/product-service v0.0.0-00010101000000-000000000000
 	github.com/louai60/e-commerce_project/backend/user-service v0.0.0-00010101000000-000000000000
 	go.uber.org/zap v1.27.0
@@ -19,6 +22,10 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
 )
 
+replace github.com/louai60/e-commerce_project/backend/common => ../common
+
+replace github.com/louai60/e-commerce_project/backend/inventory-service => ../inventory-service
+
 replace github.com/louai60/e-commerce_project/backend/product-service => ../product-service
 
 replace github.com/louai60/e-commerce_project/backend/user-service => ../user-service
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/admin-service/accounting"
+	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+	"github.com/louai60/e-commerce_project/backend/admin-service/reports"
+)
+
+// SetupAccounting creates the accounting export service on top of the report
+// service, which stores the exported files, and returns it so scheduled
+// exports can be started. SetupReports must be called first.
+func (h *AdminHandler) SetupAccounting(runs accounting.RunStore, accounts accounting.Accounts) *accounting.Service {
+	h.accounting = accounting.NewService(h.inventoryClient, h.reports, runs, h.productClient, accounts, h.logger)
+	return h.accounting
+}
+
+// ExportJournal exports the accounting journal of the current store for a
+// period of whole days
+func (h *AdminHandler) ExportJournal(ctx context.Context, req *adminpb.ExportJournalRequest) (*adminpb.JournalExport, error) {
+	if h.accounting == nil {
+		return nil, status.Error(codes.FailedPrecondition, "accounting exports are not configured")
+	}
+	if !accounting.IsValidFormat(req.Format) {
+		return nil, status.Errorf(codes.InvalidArgument, "format must be one of %s", strings.Join(accounting.Formats, ", "))
+	}
+	from, err := time.Parse(time.DateOnly, req.From)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "from must be a YYYY-MM-DD date")
+	}
+	to, err := time.Parse(time.DateOnly, req.To)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "to must be a YYYY-MM-DD date")
+	}
+
+	run, created, err := h.accounting.Export(ctx, req.Format, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		switch {
+		case errors.Is(err, accounting.ErrInvalidPeriod):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, accounting.ErrPeriodNotClosed), errors.Is(err, reports.ErrInventoryUnavailable):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		h.logger.Error("Failed to export journal", zap.String("format", req.Format), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to export journal: %v", err)
+	}
+
+	resp := h.mapJournalExport(run)
+	resp.Created = created
+	return resp, nil
+}
+
+// ListJournalExports lists the journal export runs of the current store with
+// fresh download links
+func (h *AdminHandler) ListJournalExports(ctx context.Context, req *adminpb.ListJournalExportsRequest) (*adminpb.ListJournalExportsResponse, error) {
+	if h.accounting == nil {
+		return nil, status.Error(codes.FailedPrecondition, "accounting exports are not configured")
+	}
+	if req.Format != "" && !accounting.IsValidFormat(req.Format) {
+		return nil, status.Errorf(codes.InvalidArgument, "format must be one of %s", strings.Join(accounting.Formats, ", "))
+	}
+
+	runs, err := h.accounting.ListRuns(ctx, req.Format)
+	if err != nil {
+		h.logger.Error("Failed to list journal exports", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to list journal exports: %v", err)
+	}
+
+	resp := &adminpb.ListJournalExportsResponse{Exports: make([]*adminpb.JournalExport, len(runs))}
+	for i := range runs {
+		resp.Exports[i] = h.mapJournalExport(&runs[i])
+	}
+	return resp, nil
+}
+
+func (h *AdminHandler) mapJournalExport(run *accounting.Run) *adminpb.JournalExport {
+	export := &adminpb.JournalExport{
+		Id:         run.ID,
+		Format:     run.Format,
+		From:       run.Start.Format(time.DateOnly),
+		To:         run.End.AddDate(0, 0, -1).Format(time.DateOnly),
+		FileName:   run.FileName,
+		EntryCount: int32(run.EntryCount),
+		CreatedAt:  run.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	url, expiresAt := h.accounting.Link(run)
+	if url != "" {
+		export.Url = url
+		export.UrlExpiresAt = expiresAt.UTC().Format(time.RFC3339)
+	}
+	return export
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/admin-service/activity"
+	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+)
+
+// ListActivity lists the recent changes of the store from the product,
+// inventory and user services. Services that cannot answer are reported as
+// failures and the page is marked as degraded.
+func (h *AdminHandler) ListActivity(ctx context.Context, req *adminpb.ListActivityRequest) (*adminpb.ListActivityResponse, error) {
+	page, err := h.activity.List(ctx, req.Cursor, int(req.Limit), req.Sources...)
+	if errors.Is(err, activity.ErrInvalidCursor) || errors.Is(err, activity.ErrUnknownSource) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to list activity: %v", err)
+	}
+
+	resp := &adminpb.ListActivityResponse{
+		Entries:    make([]*adminpb.ActivityEntry, len(page.Entries)),
+		NextCursor: page.NextCursor,
+	}
+	for i, entry := range page.Entries {
+		resp.Entries[i] = &adminpb.ActivityEntry{
+			Source:     entry.Source,
+			Id:         entry.ID,
+			Type:       entry.Type,
+			SubjectId:  entry.SubjectID,
+			Subject:    entry.Subject,
+			ActorId:    entry.ActorID,
+			Summary:    entry.Summary,
+			OccurredAt: entry.OccurredAt.Format(time.RFC3339),
+		}
+	}
+	for _, failure := range page.Failures {
+		resp.Degraded = true
+		resp.Failures = append(resp.Failures, &adminpb.DependencyFailure{
+			Service: failure.Service,
+			Error:   failure.Err.Error(),
+		})
+	}
+	return resp, nil
+}
@@ -1,12 +1,23 @@
 package handlers
 
 import (
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/louai60/e-commerce_project/backend/admin-service/accounting"
+	"github.com/louai60/e-commerce_project/backend/admin-service/activity"
+	"github.com/louai60/e-commerce_project/backend/admin-service/fanout"
+	"github.com/louai60/e-commerce_project/backend/admin-service/presence"
 	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+	"github.com/louai60/e-commerce_project/backend/admin-service/reports"
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
 	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
 	userpb "github.com/louai60/e-commerce_project/backend/user-service/proto"
 )
@@ -15,17 +26,24 @@ import (
 type AdminHandler struct {
 	adminpb.UnimplementedAdminServiceServer // Embed for forward compatibility
 
-	logger        *zap.Logger
-	productClient productpb.ProductServiceClient
-	userClient    userpb.UserServiceClient
-	productConn   *grpc.ClientConn // save connection to close later
-	userConn      *grpc.ClientConn
+	logger          *zap.Logger
+	productClient   productpb.ProductServiceClient
+	userClient      userpb.UserServiceClient
+	inventoryClient inventorypb.InventoryServiceClient // nil when no inventory address is configured
+	productConn     *grpc.ClientConn                   // save connection to close later
+	userConn        *grpc.ClientConn
+	inventoryConn   *grpc.ClientConn
+	reports         *reports.Service    // nil until SetupReports is called
+	accounting      *accounting.Service // nil until SetupAccounting is called
+	fanout          *fanout.Runner      // calls the services a request depends on
+	activity        *activity.Feed
+	presence        *presence.Tracker
 }
 
-// NewAdminHandler creates a new AdminHandler.
-func NewAdminHandler(logger *zap.Logger, productServiceAddr, userServiceAddr string) (*AdminHandler, error) {
+// NewAdminHandler creates a new AdminHandler. The inventory service address is optional.
+func NewAdminHandler(logger *zap.Logger, productServiceAddr, userServiceAddr, inventoryServiceAddr string) (*AdminHandler, error) {
 	// Connect to Product Service
-	productConn, err := grpc.Dial(productServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	productConn, err := grpc.Dial(productServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), cachectl.UnaryClientInterceptor()))
 	if err != nil {
 		logger.Error("Failed to connect to product service", zap.String("address", productServiceAddr), zap.Error(err))
 		return nil, err
@@ -33,7 +51,7 @@ func NewAdminHandler(logger *zap.Logger, productServiceAddr, userServiceAddr str
 	productClient := productpb.NewProductServiceClient(productConn)
 
 	// Connect to User Service
-	userConn, err := grpc.Dial(userServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	userConn, err := grpc.Dial(userServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), cachectl.UnaryClientInterceptor()))
 	if err != nil {
 		logger.Error("Failed to connect to user service", zap.String("address", userServiceAddr), zap.Error(err))
 		productConn.Close() // Close already-opened product connection
@@ -41,13 +59,47 @@ func NewAdminHandler(logger *zap.Logger, productServiceAddr, userServiceAddr str
 	}
 	userClient := userpb.NewUserServiceClient(userConn)
 
-	return &AdminHandler{
+	handler := &AdminHandler{
 		logger:        logger,
 		productClient: productClient,
 		userClient:    userClient,
 		productConn:   productConn,
 		userConn:      userConn,
-	}, nil
+		fanout:        fanout.NewRunner(logger),
+		presence:      presence.NewTracker(presence.DefaultTTL),
+	}
+
+	// Connect to Inventory Service if configured
+	if inventoryServiceAddr != "" {
+		inventoryConn, err := grpc.Dial(inventoryServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), cachectl.UnaryClientInterceptor()))
+		if err != nil {
+			logger.Error("Failed to connect to inventory service", zap.String("address", inventoryServiceAddr), zap.Error(err))
+			handler.Close() // Close already-opened connections
+			return nil, err
+		}
+		handler.inventoryClient = inventorypb.NewInventoryServiceClient(inventoryConn)
+		handler.inventoryConn = inventoryConn
+	}
+
+	sources := []activity.Source{activity.CatalogSource(productClient), activity.UserSource(userClient)}
+	if handler.inventoryClient != nil {
+		sources = append(sources, activity.InventorySource(handler.inventoryClient))
+	}
+	handler.activity = activity.NewFeed(handler.fanout, sources...)
+
+	return handler, nil
+}
+
+// SetDependencyTimeouts overrides the timeout of the calls to some services,
+// by service name
+func (h *AdminHandler) SetDependencyTimeouts(timeouts map[string]time.Duration) {
+	h.fanout.Timeouts = timeouts
+}
+
+// SetPresenceTTL sets how long admins are shown as editing a product after
+// their last heartbeat
+func (h *AdminHandler) SetPresenceTTL(ttl time.Duration) {
+	h.presence = presence.NewTracker(ttl)
 }
 
 // Close closes the gRPC connections when shutting down
@@ -58,4 +110,7 @@ func (h *AdminHandler) Close() {
 	if h.userConn != nil {
 		h.userConn.Close()
 	}
+	if h.inventoryConn != nil {
+		h.inventoryConn.Close()
+	}
 }
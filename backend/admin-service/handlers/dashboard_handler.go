@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/louai60/e-commerce_project/backend/admin-service/fanout"
+	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	userpb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// GetDashboardStats collects the store totals from the product, user and
+// inventory services concurrently. A service that cannot answer leaves its
+// figures at 0 and marks the response as degraded instead of failing it.
+// Revenue and orders stay 0 until an order service exists.
+func (h *AdminHandler) GetDashboardStats(ctx context.Context, req *adminpb.GetDashboardStatsRequest) (*adminpb.GetDashboardStatsResponse, error) {
+	resp := &adminpb.GetDashboardStatsResponse{}
+
+	calls := []fanout.Call{
+		{
+			Service:    "product-service",
+			Idempotent: true,
+			Do: func(ctx context.Context) error {
+				products, err := h.productClient.ListProducts(ctx, &productpb.ListProductsRequest{Page: 1, Limit: 1})
+				if err != nil {
+					return err
+				}
+				resp.TotalProducts = int64(products.Total)
+				return nil
+			},
+		},
+		{
+			Service:    "user-service",
+			Idempotent: true,
+			Do: func(ctx context.Context) error {
+				users, err := h.userClient.ListUsers(ctx, &userpb.ListUsersRequest{Page: 1, Limit: 1})
+				if err != nil {
+					return err
+				}
+				resp.TotalUsers = int64(users.Total)
+				return nil
+			},
+		},
+	}
+	if h.inventoryClient != nil {
+		calls = append(calls, fanout.Call{
+			Service:    "inventory-service",
+			Idempotent: true,
+			Do: func(ctx context.Context) error {
+				alerts, err := h.inventoryClient.ListStockAlerts(ctx, &inventorypb.ListStockAlertsRequest{})
+				if err != nil {
+					return err
+				}
+				resp.LowStockAlerts = int64(len(alerts.Alerts))
+				return nil
+			},
+		})
+	}
+
+	for _, failure := range h.fanout.Run(ctx, calls...) {
+		resp.Degraded = true
+		resp.Failures = append(resp.Failures, &adminpb.DependencyFailure{
+			Service: failure.Service,
+			Error:   failure.Err.Error(),
+		})
+	}
+	return resp, nil
+}
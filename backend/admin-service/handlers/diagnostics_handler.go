@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/admin-service/fanout"
+	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	userpb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// diagnosticsCollector collects the diagnostics of one service
+type diagnosticsCollector struct {
+	service string
+	collect func(context.Context) (*adminpb.ServiceDiagnostics, error)
+}
+
+// GetServiceDiagnostics queries every backend service for its runtime diagnostics in parallel.
+// Services that fail to respond are reported as unreachable instead of failing the whole call.
+func (h *AdminHandler) GetServiceDiagnostics(ctx context.Context, req *adminpb.GetServiceDiagnosticsRequest) (*adminpb.GetServiceDiagnosticsResponse, error) {
+	collectors := []diagnosticsCollector{
+		{"product-service", h.productDiagnostics},
+		{"user-service", h.userDiagnostics},
+	}
+	if h.inventoryClient != nil {
+		collectors = append(collectors, diagnosticsCollector{"inventory-service", h.inventoryDiagnostics})
+	}
+
+	services := make([]*adminpb.ServiceDiagnostics, len(collectors))
+	calls := make([]fanout.Call, len(collectors))
+	for i, collector := range collectors {
+		calls[i] = fanout.Call{
+			Service:    collector.service,
+			Idempotent: true,
+			Do: func(ctx context.Context) error {
+				diag, err := collector.collect(ctx)
+				if err != nil {
+					return err
+				}
+				services[i] = diag
+				return nil
+			},
+		}
+	}
+	failures := h.fanout.Run(ctx, calls...)
+	for _, failure := range failures {
+		for i, collector := range collectors {
+			if collector.service == failure.Service {
+				services[i] = unreachableService(failure.Service, failure.Err)
+			}
+		}
+	}
+
+	return &adminpb.GetServiceDiagnosticsResponse{
+		Services: services,
+		Degraded: len(failures) > 0,
+	}, nil
+}
+
+func (h *AdminHandler) productDiagnostics(ctx context.Context) (*adminpb.ServiceDiagnostics, error) {
+	resp, err := h.productClient.GetDiagnostics(ctx, &productpb.GetDiagnosticsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	diag := &adminpb.ServiceDiagnostics{
+		Service:       resp.Service,
+		Reachable:     true,
+		UptimeSeconds: resp.UptimeSeconds,
+		Goroutines:    resp.Goroutines,
+	}
+	if resp.CollectedAt != nil {
+		diag.CollectedAt = resp.CollectedAt.AsTime().Format(time.RFC3339)
+	}
+	for _, pool := range resp.DbPools {
+		diag.DbPools = append(diag.DbPools, &adminpb.DBPoolDiagnostics{
+			Name:                pool.Name,
+			Replica:             pool.Replica,
+			MaxOpenConnections:  pool.MaxOpenConnections,
+			OpenConnections:     pool.OpenConnections,
+			InUse:               pool.InUse,
+			Idle:                pool.Idle,
+			WaitCount:           pool.WaitCount,
+			WaitDurationSeconds: pool.WaitDurationSeconds,
+			MaxIdleClosed:       pool.MaxIdleClosed,
+			MaxIdleTimeClosed:   pool.MaxIdleTimeClosed,
+			MaxLifetimeClosed:   pool.MaxLifetimeClosed,
+			ReplicaLagKnown:     pool.ReplicaLagKnown,
+			ReplicaLagSeconds:   pool.ReplicaLagSeconds,
+			Excluded:            pool.Excluded,
+		})
+	}
+	for _, c := range resp.Caches {
+		diag.Caches = append(diag.Caches, &adminpb.CacheDiagnostics{
+			Name:                c.Name,
+			CircuitBreakerState: c.CircuitBreakerState,
+			Healthy:             c.Healthy,
+			MemoryEntries:       c.MemoryEntries,
+			Hits:                c.Hits,
+			Misses:              c.Misses,
+			Errors:              c.Errors,
+			HitRate:             c.HitRate,
+		})
+	}
+	return diag, nil
+}
+
+func (h *AdminHandler) userDiagnostics(ctx context.Context) (*adminpb.ServiceDiagnostics, error) {
+	resp, err := h.userClient.GetDiagnostics(ctx, &userpb.GetDiagnosticsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	diag := &adminpb.ServiceDiagnostics{
+		Service:       resp.Service,
+		Reachable:     true,
+		CollectedAt:   resp.CollectedAt,
+		UptimeSeconds: resp.UptimeSeconds,
+		Goroutines:    resp.Goroutines,
+	}
+	for _, pool := range resp.DbPools {
+		diag.DbPools = append(diag.DbPools, &adminpb.DBPoolDiagnostics{
+			Name:                pool.Name,
+			Replica:             pool.Replica,
+			MaxOpenConnections:  pool.MaxOpenConnections,
+			OpenConnections:     pool.OpenConnections,
+			InUse:               pool.InUse,
+			Idle:                pool.Idle,
+			WaitCount:           pool.WaitCount,
+			WaitDurationSeconds: pool.WaitDurationSeconds,
+			MaxIdleClosed:       pool.MaxIdleClosed,
+			MaxIdleTimeClosed:   pool.MaxIdleTimeClosed,
+			MaxLifetimeClosed:   pool.MaxLifetimeClosed,
+			ReplicaLagKnown:     pool.ReplicaLagKnown,
+			ReplicaLagSeconds:   pool.ReplicaLagSeconds,
+		})
+	}
+	for _, c := range resp.Caches {
+		diag.Caches = append(diag.Caches, &adminpb.CacheDiagnostics{
+			Name:                c.Name,
+			CircuitBreakerState: c.CircuitBreakerState,
+			Healthy:             c.Healthy,
+			MemoryEntries:       c.MemoryEntries,
+			Hits:                c.Hits,
+			Misses:              c.Misses,
+			Errors:              c.Errors,
+			HitRate:             c.HitRate,
+		})
+	}
+	return diag, nil
+}
+
+func (h *AdminHandler) inventoryDiagnostics(ctx context.Context) (*adminpb.ServiceDiagnostics, error) {
+	resp, err := h.inventoryClient.GetDiagnostics(ctx, &inventorypb.GetDiagnosticsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	diag := &adminpb.ServiceDiagnostics{
+		Service:       resp.Service,
+		Reachable:     true,
+		UptimeSeconds: resp.UptimeSeconds,
+		Goroutines:    resp.Goroutines,
+	}
+	if resp.CollectedAt != nil {
+		diag.CollectedAt = resp.CollectedAt.AsTime().Format(time.RFC3339)
+	}
+	for _, pool := range resp.DbPools {
+		diag.DbPools = append(diag.DbPools, &adminpb.DBPoolDiagnostics{
+			Name:                pool.Name,
+			Replica:             pool.Replica,
+			MaxOpenConnections:  pool.MaxOpenConnections,
+			OpenConnections:     pool.OpenConnections,
+			InUse:               pool.InUse,
+			Idle:                pool.Idle,
+			WaitCount:           pool.WaitCount,
+			WaitDurationSeconds: pool.WaitDurationSeconds,
+			MaxIdleClosed:       pool.MaxIdleClosed,
+			MaxIdleTimeClosed:   pool.MaxIdleTimeClosed,
+			MaxLifetimeClosed:   pool.MaxLifetimeClosed,
+			ReplicaLagKnown:     pool.ReplicaLagKnown,
+			ReplicaLagSeconds:   pool.ReplicaLagSeconds,
+		})
+	}
+	for _, c := range resp.Caches {
+		diag.Caches = append(diag.Caches, &adminpb.CacheDiagnostics{
+			Name:                c.Name,
+			CircuitBreakerState: c.CircuitBreakerState,
+			Healthy:             c.Healthy,
+			MemoryEntries:       c.MemoryEntries,
+			Hits:                c.Hits,
+			Misses:              c.Misses,
+			Errors:              c.Errors,
+			HitRate:             c.HitRate,
+		})
+	}
+	return diag, nil
+}
+
+func unreachableService(service string, err error) *adminpb.ServiceDiagnostics {
+	return &adminpb.ServiceDiagnostics{
+		Service: service,
+		Error:   err.Error(),
+	}
+}
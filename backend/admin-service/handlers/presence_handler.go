@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/admin-service/presence"
+	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+)
+
+// TouchPresence records a heartbeat of an admin editing a product. The admin
+// panel sends one when a product is opened for editing and then regularly
+// while it stays open, and shows the other editors it gets back.
+func (h *AdminHandler) TouchPresence(ctx context.Context, req *adminpb.TouchPresenceRequest) (*adminpb.PresenceResponse, error) {
+	if err := validatePresence(req.ProductId, req.AdminId, true); err != nil {
+		return nil, err
+	}
+	editors := h.presence.Touch(tenant.FromContext(ctx), req.ProductId, req.AdminId, req.AdminName)
+	return h.presenceResponse(req.ProductId, editors), nil
+}
+
+// GetPresence returns the admins editing a product
+func (h *AdminHandler) GetPresence(ctx context.Context, req *adminpb.GetPresenceRequest) (*adminpb.PresenceResponse, error) {
+	if err := validatePresence(req.ProductId, "", false); err != nil {
+		return nil, err
+	}
+	editors := h.presence.Editors(tenant.FromContext(ctx), req.ProductId)
+	return h.presenceResponse(req.ProductId, editors), nil
+}
+
+// LeavePresence records that an admin closed a product
+func (h *AdminHandler) LeavePresence(ctx context.Context, req *adminpb.LeavePresenceRequest) (*adminpb.PresenceResponse, error) {
+	if err := validatePresence(req.ProductId, req.AdminId, true); err != nil {
+		return nil, err
+	}
+	editors := h.presence.Leave(tenant.FromContext(ctx), req.ProductId, req.AdminId)
+	return h.presenceResponse(req.ProductId, editors), nil
+}
+
+func validatePresence(productID, adminID string, needAdmin bool) error {
+	if _, err := uuid.Parse(productID); err != nil {
+		return status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	if needAdmin && adminID == "" {
+		return status.Error(codes.InvalidArgument, "admin ID is required")
+	}
+	return nil
+}
+
+func (h *AdminHandler) presenceResponse(productID string, editors []presence.Editor) *adminpb.PresenceResponse {
+	resp := &adminpb.PresenceResponse{
+		ProductId:  productID,
+		Editors:    make([]*adminpb.ProductEditor, len(editors)),
+		TtlSeconds: int32(h.presence.TTL() / time.Second),
+	}
+	for i, editor := range editors {
+		resp.Editors[i] = &adminpb.ProductEditor{
+			AdminId:   editor.AdminID,
+			AdminName: editor.AdminName,
+			Since:     editor.Since.Format(time.RFC3339),
+			LastSeen:  editor.LastSeen.Format(time.RFC3339),
+		}
+	}
+	return resp
+}
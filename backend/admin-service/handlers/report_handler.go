@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/mail"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+	"github.com/louai60/e-commerce_project/backend/admin-service/reports"
+	"github.com/louai60/e-commerce_project/backend/common/downloadtoken"
+	appmail "github.com/louai60/e-commerce_project/backend/common/mail"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/storage"
+)
+
+// reportChunkSize is the size of the chunks reports are streamed in
+const reportChunkSize = 64 * 1024
+
+// SetupReports creates the report service on top of the handler's service
+// connections and returns it so scheduled reports can be started
+func (h *AdminHandler) SetupReports(store storage.Storage, mailer appmail.Mailer, newUsersWindow time.Duration, options reports.Options) *reports.Service {
+	source := reports.NewSource(h.productClient, h.inventoryClient, h.userClient, newUsersWindow)
+	h.reports = reports.NewService(source, store, mailer, h.productClient, options, h.logger)
+	return h.reports
+}
+
+// GenerateReport builds a report for the current store right away
+func (h *AdminHandler) GenerateReport(ctx context.Context, req *adminpb.GenerateReportRequest) (*adminpb.Report, error) {
+	if h.reports == nil {
+		return nil, status.Error(codes.FailedPrecondition, "reports are not configured")
+	}
+	if !reports.IsValidKind(req.Kind) {
+		return nil, status.Errorf(codes.InvalidArgument, "kind must be one of %s", strings.Join(reports.Kinds, ", "))
+	}
+	if !reports.IsValidFormat(req.Format) {
+		return nil, status.Error(codes.InvalidArgument, "format must be csv or pdf")
+	}
+	for _, recipient := range req.Recipients {
+		if _, err := mail.ParseAddress(recipient); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid recipient %q", recipient)
+		}
+	}
+
+	report, err := h.reports.Generate(ctx, req.Kind, req.Format)
+	if err != nil {
+		if errors.Is(err, reports.ErrInventoryUnavailable) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		h.logger.Error("Failed to generate report", zap.String("kind", req.Kind), zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to generate report: %v", err)
+	}
+
+	resp := &adminpb.Report{
+		Kind:        report.Kind,
+		Format:      report.Format,
+		FileName:    report.FileName,
+		RowCount:    int32(report.RowCount),
+		Url:         report.URL,
+		GeneratedAt: report.GeneratedAt.UTC().Format(time.RFC3339),
+	}
+	if report.URL != "" {
+		resp.UrlExpiresAt = report.URLExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	if len(req.Recipients) > 0 {
+		if err := h.reports.Deliver(ctx, report, req.Recipients); err != nil {
+			h.logger.Error("Failed to deliver report",
+				zap.String("tenant_id", tenant.FromContext(ctx)),
+				zap.String("kind", req.Kind),
+				zap.Error(err))
+			return nil, status.Errorf(codes.Unavailable, "Report was generated but could not be emailed: %v", err)
+		}
+		resp.EmailedTo = req.Recipients
+	}
+
+	return resp, nil
+}
+
+// DownloadReport verifies a report token and streams the report in chunks
+func (h *AdminHandler) DownloadReport(req *adminpb.DownloadReportRequest, stream grpc.ServerStreamingServer[adminpb.ReportChunk]) error {
+	if h.reports == nil {
+		return status.Error(codes.FailedPrecondition, "reports are not configured")
+	}
+
+	file, name, err := h.reports.Open(req.Token)
+	switch {
+	case errors.Is(err, downloadtoken.ErrExpired):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, downloadtoken.ErrInvalid):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case err != nil:
+		h.logger.Error("Failed to open report", zap.Error(err))
+		return status.Error(codes.NotFound, "report not found")
+	}
+	defer file.Close()
+
+	// Reports are small enough to size up front for the Content-Length
+	data, err := io.ReadAll(file)
+	if err != nil {
+		h.logger.Error("Failed to read report", zap.Error(err))
+		return status.Error(codes.Internal, "Failed to read report")
+	}
+
+	chunk := &adminpb.ReportChunk{
+		FileName:    name,
+		ContentType: reports.ContentType(strings.TrimPrefix(path.Ext(name), ".")),
+		SizeBytes:   int64(len(data)),
+	}
+	for {
+		n := len(data)
+		if n > reportChunkSize {
+			n = reportChunkSize
+		}
+		chunk.Data = data[:n]
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+		data = data[n:]
+		if len(data) == 0 {
+			return nil
+		}
+		chunk = &adminpb.ReportChunk{}
+	}
+}
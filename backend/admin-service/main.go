@@ -1,11 +1,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -14,7 +17,19 @@ import (
 	"google.golang.org/grpc"
 
 	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/mail"
+	"github.com/louai60/e-commerce_project/backend/common/profiling"
+	"github.com/louai60/e-commerce_project/backend/common/recovery"
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/admin-service/accounting"
+	"github.com/louai60/e-commerce_project/backend/admin-service/fanout"
 	"github.com/louai60/e-commerce_project/backend/admin-service/handlers"
+	"github.com/louai60/e-commerce_project/backend/admin-service/presence"
+	"github.com/louai60/e-commerce_project/backend/admin-service/reports"
+	"github.com/louai60/e-commerce_project/backend/product-service/storage"
 )
 
 func main() {
@@ -24,11 +39,19 @@ func main() {
 	}
 
 	// Initialize logger
-	logger, err := zap.NewProduction()
+	logger := applogger.InitFromEnv("admin-service")
+	defer logger.Sync() // flushes buffer, if any
+
+	// Sign internal calls so that services can enforce who calls their
+	// privileged RPCs
+	serviceSigner, err := servicetoken.SignerFromEnv("admin-service")
 	if err != nil {
-		log.Fatalf("Failed to initialize logger: %v", err)
+		logger.Fatal("Invalid service token key", zap.Error(err))
 	}
-	defer logger.Sync() // flushes buffer, if any
+	if serviceSigner == nil {
+		logger.Warn("SERVICE_TOKEN_KEY not set, internal calls are sent without service tokens")
+	}
+	servicetoken.SetSigner(serviceSigner)
 
 	// Get service addresses and port from environment variables
 	productServiceAddr := os.Getenv("PRODUCT_SERVICE_ADDR")
@@ -39,6 +62,10 @@ func main() {
 	if userServiceAddr == "" {
 		logger.Fatal("USER_SERVICE_ADDR environment variable is required")
 	}
+	inventoryServiceAddr := os.Getenv("INVENTORY_SERVICE_ADDR")
+	if inventoryServiceAddr == "" {
+		logger.Warn("INVENTORY_SERVICE_ADDR not set, inventory diagnostics will be unavailable")
+	}
 	port := os.Getenv("ADMIN_SERVICE_PORT")
 	if port == "" {
 		port = "8085" // Default port
@@ -51,16 +78,57 @@ func main() {
 		logger.Fatal("Failed to listen", zap.Error(err), zap.String("port", port))
 	}
 
+	// Recover panics of handlers, reporting them to Sentry when configured
+	panicReporter, err := recovery.ReporterFromEnv(context.Background(), logger)
+	if err != nil {
+		logger.Fatal("Invalid Sentry configuration", zap.Error(err))
+	}
+	recoverer := recovery.New("admin-service", logger, panicReporter)
+
 	// Create a new gRPC server
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(applogger.UnaryServerInterceptor(logger), recoverer.UnaryServerInterceptor(), tenant.UnaryServerInterceptor(), cachectl.UnaryServerInterceptor()))
 
 	// Create and register the admin handler
-	adminHandler, err := handlers.NewAdminHandler(logger, productServiceAddr, userServiceAddr)
+	adminHandler, err := handlers.NewAdminHandler(logger, productServiceAddr, userServiceAddr, inventoryServiceAddr)
 	if err != nil {
 		logger.Fatal("Failed to create admin handler", zap.Error(err))
 	}
 	adminpb.RegisterAdminServiceServer(s, adminHandler)
 
+	// Timeouts of the calls to individual services, e.g.
+	// "product-service=2s,inventory-service=500ms"
+	if value := os.Getenv("ADMIN_DEPENDENCY_TIMEOUTS"); value != "" {
+		timeouts, err := fanout.ParseTimeouts(value)
+		if err != nil {
+			logger.Fatal("Invalid ADMIN_DEPENDENCY_TIMEOUTS", zap.Error(err))
+		}
+		adminHandler.SetDependencyTimeouts(timeouts)
+	}
+
+	// How long admins are shown as editing a product after their last heartbeat
+	presenceTTL, err := durationFromEnv("PRESENCE_TTL", presence.DefaultTTL)
+	if err != nil {
+		logger.Fatal("Invalid PRESENCE_TTL", zap.Error(err))
+	}
+	adminHandler.SetPresenceTTL(presenceTTL)
+
+	// Set up report generation and the scheduled report emails
+	reportCtx, stopReports := context.WithCancel(context.Background())
+	defer stopReports()
+	if err := setupReports(reportCtx, adminHandler, logger); err != nil {
+		logger.Fatal("Failed to set up reports", zap.Error(err))
+	}
+	if err := setupAccounting(reportCtx, adminHandler, logger); err != nil {
+		logger.Fatal("Failed to set up accounting exports", zap.Error(err))
+	}
+
+	// Profiling endpoints are off unless PPROF_ADDR names an internal address
+	if addr := os.Getenv("PPROF_ADDR"); addr != "" {
+		if err := profiling.Start(reportCtx, addr, logger); err != nil {
+			logger.Error("Failed to start profiling endpoints", zap.Error(err))
+		}
+	}
+
 	// Set up channel for graceful shutdown
 	stopChan := make(chan os.Signal, 1)
 	signal.Notify(stopChan, syscall.SIGINT, syscall.SIGTERM)
@@ -81,7 +149,8 @@ func main() {
 	logger.Info("Shutting down the server...")
 	s.GracefulStop()
 
-	// Close the admin handler gRPC connections
+	// Stop scheduled reports and close the admin handler gRPC connections
+	stopReports()
 	adminHandler.Close()
 
 	// Give some time for graceful shutdown
@@ -89,3 +158,146 @@ func main() {
 
 	logger.Info("Server gracefully shut down")
 }
+
+// setupReports configures report storage, email delivery and schedules from
+// the environment:
+//   - REPORTS_STORAGE_PATH: folder reports are stored in (default ./reports)
+//   - REPORTS_BASE_URL: public download endpoint, e.g. https://shop.example/api/v1/reports
+//   - REPORT_SIGNING_SECRET: signs download links; links are not issued without it
+//   - REPORT_LINK_TTL: how long download links stay valid (default 72h)
+//   - REPORT_SCHEDULES: e.g. "low_stock:csv:24h,catalog_completeness:pdf:168h"
+//   - REPORT_RECIPIENTS: comma separated emails scheduled reports are sent to
+//   - REPORT_NEW_USERS_WINDOW: look-back of the new users report (default 168h)
+//   - SMTP_HOST, SMTP_PORT (default 587), SMTP_USERNAME, SMTP_PASSWORD, SMTP_FROM:
+//     email relay; emails are only logged when SMTP_HOST is not set
+func setupReports(ctx context.Context, adminHandler *handlers.AdminHandler, logger *zap.Logger) error {
+	storagePath := os.Getenv("REPORTS_STORAGE_PATH")
+	if storagePath == "" {
+		storagePath = "./reports"
+	}
+	store, err := storage.NewLocalStorage(storagePath)
+	if err != nil {
+		return err
+	}
+
+	linkTTL, err := durationFromEnv("REPORT_LINK_TTL", 72*time.Hour)
+	if err != nil {
+		return err
+	}
+	newUsersWindow, err := durationFromEnv("REPORT_NEW_USERS_WINDOW", 7*24*time.Hour)
+	if err != nil {
+		return err
+	}
+
+	var mailer mail.Mailer = mail.NewLogMailer(logger)
+	if host := os.Getenv("SMTP_HOST"); host != "" {
+		port := 587
+		if value := os.Getenv("SMTP_PORT"); value != "" {
+			if port, err = strconv.Atoi(value); err != nil {
+				return fmt.Errorf("invalid SMTP_PORT: %w", err)
+			}
+		}
+		from := os.Getenv("SMTP_FROM")
+		if from == "" {
+			return fmt.Errorf("SMTP_FROM is required when SMTP_HOST is set")
+		}
+		mailer = mail.NewSMTPMailer(host, port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), from)
+	}
+
+	options := reports.Options{
+		SigningKey: os.Getenv("REPORT_SIGNING_SECRET"),
+		BaseURL:    os.Getenv("REPORTS_BASE_URL"),
+		LinkTTL:    linkTTL,
+	}
+	if options.SigningKey == "" || options.BaseURL == "" {
+		logger.Warn("REPORT_SIGNING_SECRET or REPORTS_BASE_URL not set, reports will have no download links")
+	}
+	reportService := adminHandler.SetupReports(store, mailer, newUsersWindow, options)
+
+	var recipients []string
+	for _, recipient := range strings.Split(os.Getenv("REPORT_RECIPIENTS"), ",") {
+		if recipient = strings.TrimSpace(recipient); recipient != "" {
+			recipients = append(recipients, recipient)
+		}
+	}
+	schedules, err := reports.ParseSchedules(os.Getenv("REPORT_SCHEDULES"), recipients)
+	if err != nil {
+		return err
+	}
+	if len(schedules) > 0 && len(recipients) == 0 {
+		logger.Warn("REPORT_SCHEDULES set without REPORT_RECIPIENTS, scheduled reports will only be stored")
+	}
+	reportService.StartScheduler(ctx, schedules)
+	logger.Info("Reports configured", zap.String("storage_path", storagePath), zap.Int("schedules", len(schedules)))
+
+	return nil
+}
+
+// setupAccounting configures the accounting journal exports, stored with the
+// reports, from the environment:
+//   - ACCOUNTING_RUNS_PATH: folder export runs are recorded in (default ./accounting)
+//   - ACCOUNTING_SALES_RETURNS_ACCOUNT: code:name of the account refunds are
+//     debited to (default 4100:Sales Returns and Allowances)
+//   - ACCOUNTING_CLEARING_ACCOUNT: code:name of the payment clearing account
+//     (default 1210:Payment Clearing)
+//   - ACCOUNTING_EXPORT_FORMATS: e.g. "xero,quickbooks"; the previous day is
+//     exported in each format for every store
+//   - ACCOUNTING_EXPORT_INTERVAL: how often scheduled exports run (default 1h);
+//     days already exported are skipped
+func setupAccounting(ctx context.Context, adminHandler *handlers.AdminHandler, logger *zap.Logger) error {
+	runsPath := os.Getenv("ACCOUNTING_RUNS_PATH")
+	if runsPath == "" {
+		runsPath = "./accounting"
+	}
+	runs, err := accounting.NewFileRunStore(runsPath)
+	if err != nil {
+		return err
+	}
+
+	accounts := accounting.DefaultAccounts
+	for key, account := range map[string]*accounting.Account{
+		"ACCOUNTING_SALES_RETURNS_ACCOUNT": &accounts.SalesReturns,
+		"ACCOUNTING_CLEARING_ACCOUNT":      &accounts.PaymentClearing,
+	} {
+		if value := os.Getenv(key); value != "" {
+			if *account, err = accounting.ParseAccount(value); err != nil {
+				return fmt.Errorf("invalid %s: %w", key, err)
+			}
+		}
+	}
+
+	var formats []string
+	for _, format := range strings.Split(os.Getenv("ACCOUNTING_EXPORT_FORMATS"), ",") {
+		if format = strings.TrimSpace(format); format == "" {
+			continue
+		}
+		if !accounting.IsValidFormat(format) {
+			return fmt.Errorf("invalid ACCOUNTING_EXPORT_FORMATS: unknown format %q", format)
+		}
+		formats = append(formats, format)
+	}
+	interval, err := durationFromEnv("ACCOUNTING_EXPORT_INTERVAL", time.Hour)
+	if err != nil {
+		return err
+	}
+
+	accountingService := adminHandler.SetupAccounting(runs, accounts)
+	accountingService.StartScheduler(ctx, formats, interval)
+	logger.Info("Accounting exports configured", zap.String("runs_path", runsPath), zap.Strings("formats", formats))
+
+	return nil
+}
+
+// durationFromEnv parses a duration environment variable, returning def when
+// it is not set
+func durationFromEnv(key string, def time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return def, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid %s: must be a positive duration", key)
+	}
+	return d, nil
+}
@@ -0,0 +1,133 @@
+// Package presence tracks which admins are editing a product, so that the
+// admin panel can warn an admin opening a product someone else is already
+// changing. Editors announce themselves with heartbeats and are forgotten
+// when they leave or stop sending them.
+package presence
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// DefaultTTL is how long an editor is shown after their last heartbeat
+const DefaultTTL = 45 * time.Second
+
+// Editor is an admin editing a product
+type Editor struct {
+	AdminID   string
+	AdminName string
+	// Since is when the admin started editing the product
+	Since time.Time
+	// LastSeen is the last heartbeat of the admin
+	LastSeen time.Time
+}
+
+// Tracker keeps the editors of the products in memory. Its state belongs
+// to the instance, so the admin service must run as a single instance, or
+// with sticky routing per product, for all editors to see each other.
+type Tracker struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	editors map[string]map[string]*Editor
+}
+
+// NewTracker creates a tracker forgetting editors ttl after their last
+// heartbeat, DefaultTTL when ttl is not positive
+func NewTracker(ttl time.Duration) *Tracker {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Tracker{
+		ttl:     ttl,
+		now:     time.Now,
+		editors: make(map[string]map[string]*Editor),
+	}
+}
+
+// TTL returns how long an editor is shown after their last heartbeat
+func (t *Tracker) TTL() time.Duration {
+	return t.ttl
+}
+
+// Touch records a heartbeat of an admin editing a product of a store, and
+// returns the editors of the product
+func (t *Tracker) Touch(tenantID, productID, adminID, adminName string) []Editor {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := productKey(tenantID, productID)
+	now := t.now()
+	editors := t.prune(key, now)
+	if editors == nil {
+		editors = make(map[string]*Editor)
+		t.editors[key] = editors
+	}
+	editor, ok := editors[adminID]
+	if !ok {
+		editor = &Editor{AdminID: adminID, Since: now}
+		editors[adminID] = editor
+	}
+	editor.AdminName = adminName
+	editor.LastSeen = now
+	return list(editors)
+}
+
+// Leave forgets an admin editing a product of a store, and returns the
+// remaining editors of the product
+func (t *Tracker) Leave(tenantID, productID, adminID string) []Editor {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	key := productKey(tenantID, productID)
+	editors := t.prune(key, t.now())
+	delete(editors, adminID)
+	if len(editors) == 0 {
+		delete(t.editors, key)
+	}
+	return list(editors)
+}
+
+// Editors returns the editors of a product of a store, the earliest first
+func (t *Tracker) Editors(tenantID, productID string) []Editor {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return list(t.prune(productKey(tenantID, productID), t.now()))
+}
+
+// prune forgets the editors of a product without a recent heartbeat, and
+// the product when none is left
+func (t *Tracker) prune(key string, now time.Time) map[string]*Editor {
+	editors := t.editors[key]
+	for adminID, editor := range editors {
+		if now.Sub(editor.LastSeen) > t.ttl {
+			delete(editors, adminID)
+		}
+	}
+	if editors != nil && len(editors) == 0 {
+		delete(t.editors, key)
+		return nil
+	}
+	return editors
+}
+
+func list(editors map[string]*Editor) []Editor {
+	result := make([]Editor, 0, len(editors))
+	for _, editor := range editors {
+		result = append(result, *editor)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].Since.Equal(result[j].Since) {
+			return result[i].Since.Before(result[j].Since)
+		}
+		return result[i].AdminID < result[j].AdminID
+	})
+	return result
+}
+
+func productKey(tenantID, productID string) string {
+	return tenantID + "/" + productID
+}
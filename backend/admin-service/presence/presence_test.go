@@ -0,0 +1,76 @@
+package presence
+
+import (
+	"testing"
+	"time"
+)
+
+func newTestTracker(now *time.Time) *Tracker {
+	tracker := NewTracker(time.Minute)
+	tracker.now = func() time.Time { return *now }
+	return tracker
+}
+
+func TestTouchListsEditorsInOrder(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tracker := newTestTracker(&now)
+
+	tracker.Touch("store", "p1", "bob", "Bob")
+	now = now.Add(10 * time.Second)
+	editors := tracker.Touch("store", "p1", "alice", "Alice")
+	if len(editors) != 2 || editors[0].AdminID != "bob" || editors[1].AdminID != "alice" {
+		t.Fatalf("editors = %+v, want bob then alice", editors)
+	}
+
+	now = now.Add(10 * time.Second)
+	editors = tracker.Touch("store", "p1", "bob", "Bob")
+	if !editors[0].LastSeen.Equal(now) || editors[0].Since.Equal(now) {
+		t.Errorf("heartbeat editor = %+v, want LastSeen updated and Since kept", editors[0])
+	}
+
+	if editors := tracker.Editors("other-store", "p1"); len(editors) != 0 {
+		t.Errorf("editors of another store = %+v, want none", editors)
+	}
+}
+
+func TestEditorsExpireWithoutHeartbeat(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tracker := newTestTracker(&now)
+
+	tracker.Touch("store", "p1", "bob", "Bob")
+	now = now.Add(30 * time.Second)
+	tracker.Touch("store", "p1", "alice", "Alice")
+
+	now = now.Add(45 * time.Second)
+	editors := tracker.Editors("store", "p1")
+	if len(editors) != 1 || editors[0].AdminID != "alice" {
+		t.Fatalf("editors = %+v, want alice only", editors)
+	}
+
+	now = now.Add(time.Minute)
+	if editors := tracker.Editors("store", "p1"); len(editors) != 0 {
+		t.Errorf("editors = %+v, want none", editors)
+	}
+	if len(tracker.editors) != 0 {
+		t.Errorf("tracker kept %d products without editors", len(tracker.editors))
+	}
+}
+
+func TestLeave(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tracker := newTestTracker(&now)
+
+	tracker.Touch("store", "p1", "bob", "Bob")
+	tracker.Touch("store", "p1", "alice", "Alice")
+	editors := tracker.Leave("store", "p1", "bob")
+	if len(editors) != 1 || editors[0].AdminID != "alice" {
+		t.Fatalf("editors = %+v, want alice only", editors)
+	}
+
+	if editors := tracker.Leave("store", "p1", "alice"); len(editors) != 0 {
+		t.Errorf("editors = %+v, want none", editors)
+	}
+	if editors := tracker.Leave("store", "p2", "alice"); len(editors) != 0 {
+		t.Errorf("leaving an unknown product editors = %+v, want none", editors)
+	}
+}
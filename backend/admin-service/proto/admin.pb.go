@@ -58,20 +58,78 @@ func (*GetDashboardStatsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_admin_proto_rawDescGZIP(), []int{0}
 }
 
+// A service that could not answer; the figures it provides are left out
+type DependencyFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyFailure) Reset() {
+	*x = DependencyFailure{}
+	mi := &file_proto_admin_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyFailure) ProtoMessage() {}
+
+func (x *DependencyFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyFailure.ProtoReflect.Descriptor instead.
+func (*DependencyFailure) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DependencyFailure) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *DependencyFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
 // Response message for GetDashboardStats
 type GetDashboardStatsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TotalUsers    int64                  `protobuf:"varint,1,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
-	TotalProducts int64                  `protobuf:"varint,2,opt,name=total_products,json=totalProducts,proto3" json:"total_products,omitempty"`
-	TotalRevenue  float64                `protobuf:"fixed64,3,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
-	TotalOrders   int64                  `protobuf:"varint,4,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"` // Add more stats as needed
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalUsers     int64                  `protobuf:"varint,1,opt,name=total_users,json=totalUsers,proto3" json:"total_users,omitempty"`
+	TotalProducts  int64                  `protobuf:"varint,2,opt,name=total_products,json=totalProducts,proto3" json:"total_products,omitempty"`
+	TotalRevenue   float64                `protobuf:"fixed64,3,opt,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
+	TotalOrders    int64                  `protobuf:"varint,4,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"`
+	LowStockAlerts int64                  `protobuf:"varint,5,opt,name=low_stock_alerts,json=lowStockAlerts,proto3" json:"low_stock_alerts,omitempty"`
+	// degraded is set when some services could not answer; they are listed
+	// in failures and their figures are 0
+	Degraded      bool                 `protobuf:"varint,6,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	Failures      []*DependencyFailure `protobuf:"bytes,7,rep,name=failures,proto3" json:"failures,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetDashboardStatsResponse) Reset() {
 	*x = GetDashboardStatsResponse{}
-	mi := &file_proto_admin_proto_msgTypes[1]
+	mi := &file_proto_admin_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -83,7 +141,7 @@ func (x *GetDashboardStatsResponse) String() string {
 func (*GetDashboardStatsResponse) ProtoMessage() {}
 
 func (x *GetDashboardStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_admin_proto_msgTypes[1]
+	mi := &file_proto_admin_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -96,7 +154,7 @@ func (x *GetDashboardStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDashboardStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetDashboardStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_admin_proto_rawDescGZIP(), []int{1}
+	return file_proto_admin_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *GetDashboardStatsResponse) GetTotalUsers() int64 {
@@ -127,20 +185,1693 @@ func (x *GetDashboardStatsResponse) GetTotalOrders() int64 {
 	return 0
 }
 
-var File_proto_admin_proto protoreflect.FileDescriptor
+func (x *GetDashboardStatsResponse) GetLowStockAlerts() int64 {
+	if x != nil {
+		return x.LowStockAlerts
+	}
+	return 0
+}
 
-const file_proto_admin_proto_rawDesc = "" +
-	"\n" +
-	"\x11proto/admin.proto\x12\x05admin\"\x1a\n" +
-	"\x18GetDashboardStatsRequest\"\xab\x01\n" +
-	"\x19GetDashboardStatsResponse\x12\x1f\n" +
-	"\vtotal_users\x18\x01 \x01(\x03R\n" +
-	"totalUsers\x12%\n" +
-	"\x0etotal_products\x18\x02 \x01(\x03R\rtotalProducts\x12#\n" +
-	"\rtotal_revenue\x18\x03 \x01(\x01R\ftotalRevenue\x12!\n" +
-	"\ftotal_orders\x18\x04 \x01(\x03R\vtotalOrders2f\n" +
+func (x *GetDashboardStatsResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *GetDashboardStatsResponse) GetFailures() []*DependencyFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// Request message for GetServiceDiagnostics
+type GetServiceDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceDiagnosticsRequest) Reset() {
+	*x = GetServiceDiagnosticsRequest{}
+	mi := &file_proto_admin_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetServiceDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetServiceDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{3}
+}
+
+type DBPoolDiagnostics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Replica             bool                   `protobuf:"varint,2,opt,name=replica,proto3" json:"replica,omitempty"`
+	MaxOpenConnections  int32                  `protobuf:"varint,3,opt,name=max_open_connections,json=maxOpenConnections,proto3" json:"max_open_connections,omitempty"`
+	OpenConnections     int32                  `protobuf:"varint,4,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
+	InUse               int32                  `protobuf:"varint,5,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	Idle                int32                  `protobuf:"varint,6,opt,name=idle,proto3" json:"idle,omitempty"`
+	WaitCount           int64                  `protobuf:"varint,7,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	WaitDurationSeconds float64                `protobuf:"fixed64,8,opt,name=wait_duration_seconds,json=waitDurationSeconds,proto3" json:"wait_duration_seconds,omitempty"`
+	MaxIdleClosed       int64                  `protobuf:"varint,9,opt,name=max_idle_closed,json=maxIdleClosed,proto3" json:"max_idle_closed,omitempty"`
+	MaxIdleTimeClosed   int64                  `protobuf:"varint,10,opt,name=max_idle_time_closed,json=maxIdleTimeClosed,proto3" json:"max_idle_time_closed,omitempty"`
+	MaxLifetimeClosed   int64                  `protobuf:"varint,11,opt,name=max_lifetime_closed,json=maxLifetimeClosed,proto3" json:"max_lifetime_closed,omitempty"`
+	ReplicaLagKnown     bool                   `protobuf:"varint,12,opt,name=replica_lag_known,json=replicaLagKnown,proto3" json:"replica_lag_known,omitempty"`
+	ReplicaLagSeconds   float64                `protobuf:"fixed64,13,opt,name=replica_lag_seconds,json=replicaLagSeconds,proto3" json:"replica_lag_seconds,omitempty"`
+	Excluded            bool                   `protobuf:"varint,14,opt,name=excluded,proto3" json:"excluded,omitempty"` // Reads are routed away from the replica
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DBPoolDiagnostics) Reset() {
+	*x = DBPoolDiagnostics{}
+	mi := &file_proto_admin_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBPoolDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBPoolDiagnostics) ProtoMessage() {}
+
+func (x *DBPoolDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBPoolDiagnostics.ProtoReflect.Descriptor instead.
+func (*DBPoolDiagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DBPoolDiagnostics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DBPoolDiagnostics) GetReplica() bool {
+	if x != nil {
+		return x.Replica
+	}
+	return false
+}
+
+func (x *DBPoolDiagnostics) GetMaxOpenConnections() int32 {
+	if x != nil {
+		return x.MaxOpenConnections
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetOpenConnections() int32 {
+	if x != nil {
+		return x.OpenConnections
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetInUse() int32 {
+	if x != nil {
+		return x.InUse
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetIdle() int32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetWaitCount() int64 {
+	if x != nil {
+		return x.WaitCount
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetWaitDurationSeconds() float64 {
+	if x != nil {
+		return x.WaitDurationSeconds
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxIdleClosed() int64 {
+	if x != nil {
+		return x.MaxIdleClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxIdleTimeClosed() int64 {
+	if x != nil {
+		return x.MaxIdleTimeClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxLifetimeClosed() int64 {
+	if x != nil {
+		return x.MaxLifetimeClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetReplicaLagKnown() bool {
+	if x != nil {
+		return x.ReplicaLagKnown
+	}
+	return false
+}
+
+func (x *DBPoolDiagnostics) GetReplicaLagSeconds() float64 {
+	if x != nil {
+		return x.ReplicaLagSeconds
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+type CacheDiagnostics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CircuitBreakerState string                 `protobuf:"bytes,2,opt,name=circuit_breaker_state,json=circuitBreakerState,proto3" json:"circuit_breaker_state,omitempty"` // closed, open or half-open
+	Healthy             bool                   `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	MemoryEntries       int64                  `protobuf:"varint,4,opt,name=memory_entries,json=memoryEntries,proto3" json:"memory_entries,omitempty"`
+	Hits                int64                  `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses              int64                  `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`
+	Errors              int64                  `protobuf:"varint,7,opt,name=errors,proto3" json:"errors,omitempty"`
+	HitRate             float64                `protobuf:"fixed64,8,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CacheDiagnostics) Reset() {
+	*x = CacheDiagnostics{}
+	mi := &file_proto_admin_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheDiagnostics) ProtoMessage() {}
+
+func (x *CacheDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheDiagnostics.ProtoReflect.Descriptor instead.
+func (*CacheDiagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CacheDiagnostics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CacheDiagnostics) GetCircuitBreakerState() string {
+	if x != nil {
+		return x.CircuitBreakerState
+	}
+	return ""
+}
+
+func (x *CacheDiagnostics) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *CacheDiagnostics) GetMemoryEntries() int64 {
+	if x != nil {
+		return x.MemoryEntries
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetHitRate() float64 {
+	if x != nil {
+		return x.HitRate
+	}
+	return 0
+}
+
+// Diagnostics of a single service; when reachable is false only error is set
+type ServiceDiagnostics struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	Reachable     bool                   `protobuf:"varint,2,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	CollectedAt   string                 `protobuf:"bytes,4,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"` // RFC3339 formatted timestamp
+	UptimeSeconds float64                `protobuf:"fixed64,5,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	Goroutines    int32                  `protobuf:"varint,6,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	DbPools       []*DBPoolDiagnostics   `protobuf:"bytes,7,rep,name=db_pools,json=dbPools,proto3" json:"db_pools,omitempty"`
+	Caches        []*CacheDiagnostics    `protobuf:"bytes,8,rep,name=caches,proto3" json:"caches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServiceDiagnostics) Reset() {
+	*x = ServiceDiagnostics{}
+	mi := &file_proto_admin_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServiceDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceDiagnostics) ProtoMessage() {}
+
+func (x *ServiceDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceDiagnostics.ProtoReflect.Descriptor instead.
+func (*ServiceDiagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ServiceDiagnostics) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *ServiceDiagnostics) GetReachable() bool {
+	if x != nil {
+		return x.Reachable
+	}
+	return false
+}
+
+func (x *ServiceDiagnostics) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ServiceDiagnostics) GetCollectedAt() string {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return ""
+}
+
+func (x *ServiceDiagnostics) GetUptimeSeconds() float64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *ServiceDiagnostics) GetGoroutines() int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *ServiceDiagnostics) GetDbPools() []*DBPoolDiagnostics {
+	if x != nil {
+		return x.DbPools
+	}
+	return nil
+}
+
+func (x *ServiceDiagnostics) GetCaches() []*CacheDiagnostics {
+	if x != nil {
+		return x.Caches
+	}
+	return nil
+}
+
+// Response message for GetServiceDiagnostics
+type GetServiceDiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Services      []*ServiceDiagnostics  `protobuf:"bytes,1,rep,name=services,proto3" json:"services,omitempty"`
+	Degraded      bool                   `protobuf:"varint,2,opt,name=degraded,proto3" json:"degraded,omitempty"` // Some services are unreachable
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServiceDiagnosticsResponse) Reset() {
+	*x = GetServiceDiagnosticsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServiceDiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServiceDiagnosticsResponse) ProtoMessage() {}
+
+func (x *GetServiceDiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServiceDiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*GetServiceDiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetServiceDiagnosticsResponse) GetServices() []*ServiceDiagnostics {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+func (x *GetServiceDiagnosticsResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+// Request message for GenerateReport
+type GenerateReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`             // catalog_completeness, low_stock or new_users
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`         // csv or pdf
+	Recipients    []string               `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients,omitempty"` // Optional email addresses to send the link to
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateReportRequest) Reset() {
+	*x = GenerateReportRequest{}
+	mi := &file_proto_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateReportRequest) ProtoMessage() {}
+
+func (x *GenerateReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateReportRequest.ProtoReflect.Descriptor instead.
+func (*GenerateReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GenerateReportRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *GenerateReportRequest) GetRecipients() []string {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+type Report struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	RowCount      int32                  `protobuf:"varint,4,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`                                         // Empty when report links are not configured
+	UrlExpiresAt  string                 `protobuf:"bytes,6,opt,name=url_expires_at,json=urlExpiresAt,proto3" json:"url_expires_at,omitempty"` // RFC3339 formatted timestamp
+	GeneratedAt   string                 `protobuf:"bytes,7,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`      // RFC3339 formatted timestamp
+	EmailedTo     []string               `protobuf:"bytes,8,rep,name=emailed_to,json=emailedTo,proto3" json:"emailed_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Report) Reset() {
+	*x = Report{}
+	mi := &file_proto_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Report) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Report) ProtoMessage() {}
+
+func (x *Report) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Report.ProtoReflect.Descriptor instead.
+func (*Report) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Report) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Report) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *Report) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Report) GetRowCount() int32 {
+	if x != nil {
+		return x.RowCount
+	}
+	return 0
+}
+
+func (x *Report) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Report) GetUrlExpiresAt() string {
+	if x != nil {
+		return x.UrlExpiresAt
+	}
+	return ""
+}
+
+func (x *Report) GetGeneratedAt() string {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return ""
+}
+
+func (x *Report) GetEmailedTo() []string {
+	if x != nil {
+		return x.EmailedTo
+	}
+	return nil
+}
+
+// Request message for DownloadReport
+type DownloadReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadReportRequest) Reset() {
+	*x = DownloadReportRequest{}
+	mi := &file_proto_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadReportRequest) ProtoMessage() {}
+
+func (x *DownloadReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadReportRequest.ProtoReflect.Descriptor instead.
+func (*DownloadReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DownloadReportRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ReportChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// File details are only set on the first chunk
+	FileName      string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Data          []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportChunk) Reset() {
+	*x = ReportChunk{}
+	mi := &file_proto_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportChunk) ProtoMessage() {}
+
+func (x *ReportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReportChunk.ProtoReflect.Descriptor instead.
+func (*ReportChunk) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReportChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ReportChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ReportChunk) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ReportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Request message for ExportJournal
+type ExportJournalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // csv, xero or quickbooks
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`     // First day of the period, YYYY-MM-DD
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`         // Last day of the period, YYYY-MM-DD; before today
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportJournalRequest) Reset() {
+	*x = ExportJournalRequest{}
+	mi := &file_proto_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportJournalRequest) ProtoMessage() {}
+
+func (x *ExportJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportJournalRequest.ProtoReflect.Descriptor instead.
+func (*ExportJournalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ExportJournalRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportJournalRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *ExportJournalRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type JournalExport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Format        string                 `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"` // YYYY-MM-DD
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`     // YYYY-MM-DD
+	FileName      string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	EntryCount    int32                  `protobuf:"varint,6,opt,name=entry_count,json=entryCount,proto3" json:"entry_count,omitempty"`
+	Url           string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`                                         // Download link; empty when report links are not configured
+	UrlExpiresAt  string                 `protobuf:"bytes,8,opt,name=url_expires_at,json=urlExpiresAt,proto3" json:"url_expires_at,omitempty"` // RFC3339 formatted timestamp
+	CreatedAt     string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`            // RFC3339 formatted timestamp
+	Created       bool                   `protobuf:"varint,10,opt,name=created,proto3" json:"created,omitempty"`                               // False when the period had already been exported
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JournalExport) Reset() {
+	*x = JournalExport{}
+	mi := &file_proto_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JournalExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JournalExport) ProtoMessage() {}
+
+func (x *JournalExport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JournalExport.ProtoReflect.Descriptor instead.
+func (*JournalExport) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *JournalExport) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *JournalExport) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *JournalExport) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *JournalExport) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *JournalExport) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *JournalExport) GetEntryCount() int32 {
+	if x != nil {
+		return x.EntryCount
+	}
+	return 0
+}
+
+func (x *JournalExport) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *JournalExport) GetUrlExpiresAt() string {
+	if x != nil {
+		return x.UrlExpiresAt
+	}
+	return ""
+}
+
+func (x *JournalExport) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JournalExport) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+// Request message for ListJournalExports
+type ListJournalExportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // Optional format filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalExportsRequest) Reset() {
+	*x = ListJournalExportsRequest{}
+	mi := &file_proto_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalExportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalExportsRequest) ProtoMessage() {}
+
+func (x *ListJournalExportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalExportsRequest.ProtoReflect.Descriptor instead.
+func (*ListJournalExportsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListJournalExportsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// Response message for ListJournalExports
+type ListJournalExportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exports       []*JournalExport       `protobuf:"bytes,1,rep,name=exports,proto3" json:"exports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJournalExportsResponse) Reset() {
+	*x = ListJournalExportsResponse{}
+	mi := &file_proto_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJournalExportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJournalExportsResponse) ProtoMessage() {}
+
+func (x *ListJournalExportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJournalExportsResponse.ProtoReflect.Descriptor instead.
+func (*ListJournalExportsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListJournalExportsResponse) GetExports() []*JournalExport {
+	if x != nil {
+		return x.Exports
+	}
+	return nil
+}
+
+// Request message for ListActivity
+type ListActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cursor        string                 `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`   // next_cursor of the previous page; empty for the first page
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`    // Defaults to 50, at most 200
+	Sources       []string               `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"` // catalog, inventory or users; all when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivityRequest) Reset() {
+	*x = ListActivityRequest{}
+	mi := &file_proto_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityRequest) ProtoMessage() {}
+
+func (x *ListActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityRequest.ProtoReflect.Descriptor instead.
+func (*ListActivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListActivityRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListActivityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListActivityRequest) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type ActivityEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Source        string                 `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`                        // catalog, inventory or users
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`                                // ID of the entry within its source
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                            // e.g. product.updated, inventory.adjustment, user.logged_in
+	SubjectId     string                 `protobuf:"bytes,4,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"` // Product or user the entry is about
+	Subject       string                 `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`                      // Product title, SKU or email
+	ActorId       string                 `protobuf:"bytes,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`       // User who made the change, when known
+	Summary       string                 `protobuf:"bytes,7,opt,name=summary,proto3" json:"summary,omitempty"`
+	OccurredAt    string                 `protobuf:"bytes,8,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // RFC3339 formatted timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityEntry) Reset() {
+	*x = ActivityEntry{}
+	mi := &file_proto_admin_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityEntry) ProtoMessage() {}
+
+func (x *ActivityEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityEntry.ProtoReflect.Descriptor instead.
+func (*ActivityEntry) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ActivityEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ActivityEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ActivityEntry) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ActivityEntry) GetSubjectId() string {
+	if x != nil {
+		return x.SubjectId
+	}
+	return ""
+}
+
+func (x *ActivityEntry) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *ActivityEntry) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *ActivityEntry) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ActivityEntry) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+// Response message for ListActivity
+type ListActivityResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Entries []*ActivityEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Empty on the last page; set when sources failed, to retry them from
+	// where they stopped
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// degraded is set when some sources could not answer; they are listed in
+	// failures and their entries are left out
+	Degraded      bool                 `protobuf:"varint,3,opt,name=degraded,proto3" json:"degraded,omitempty"`
+	Failures      []*DependencyFailure `protobuf:"bytes,4,rep,name=failures,proto3" json:"failures,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActivityResponse) Reset() {
+	*x = ListActivityResponse{}
+	mi := &file_proto_admin_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListActivityResponse) ProtoMessage() {}
+
+func (x *ListActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListActivityResponse.ProtoReflect.Descriptor instead.
+func (*ListActivityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListActivityResponse) GetEntries() []*ActivityEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListActivityResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListActivityResponse) GetDegraded() bool {
+	if x != nil {
+		return x.Degraded
+	}
+	return false
+}
+
+func (x *ListActivityResponse) GetFailures() []*DependencyFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+// Request message for TouchPresence
+type TouchPresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AdminName     string                 `protobuf:"bytes,3,opt,name=admin_name,json=adminName,proto3" json:"admin_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TouchPresenceRequest) Reset() {
+	*x = TouchPresenceRequest{}
+	mi := &file_proto_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TouchPresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TouchPresenceRequest) ProtoMessage() {}
+
+func (x *TouchPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TouchPresenceRequest.ProtoReflect.Descriptor instead.
+func (*TouchPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TouchPresenceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *TouchPresenceRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *TouchPresenceRequest) GetAdminName() string {
+	if x != nil {
+		return x.AdminName
+	}
+	return ""
+}
+
+// Request message for GetPresence
+type GetPresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPresenceRequest) Reset() {
+	*x = GetPresenceRequest{}
+	mi := &file_proto_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPresenceRequest) ProtoMessage() {}
+
+func (x *GetPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPresenceRequest.ProtoReflect.Descriptor instead.
+func (*GetPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetPresenceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+// Request message for LeavePresence
+type LeavePresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AdminId       string                 `protobuf:"bytes,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeavePresenceRequest) Reset() {
+	*x = LeavePresenceRequest{}
+	mi := &file_proto_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeavePresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeavePresenceRequest) ProtoMessage() {}
+
+func (x *LeavePresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeavePresenceRequest.ProtoReflect.Descriptor instead.
+func (*LeavePresenceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LeavePresenceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *LeavePresenceRequest) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+type ProductEditor struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       string                 `protobuf:"bytes,1,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	AdminName     string                 `protobuf:"bytes,2,opt,name=admin_name,json=adminName,proto3" json:"admin_name,omitempty"`
+	Since         string                 `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`                       // RFC3339 formatted timestamp
+	LastSeen      string                 `protobuf:"bytes,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"` // RFC3339 formatted timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductEditor) Reset() {
+	*x = ProductEditor{}
+	mi := &file_proto_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductEditor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductEditor) ProtoMessage() {}
+
+func (x *ProductEditor) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductEditor.ProtoReflect.Descriptor instead.
+func (*ProductEditor) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProductEditor) GetAdminId() string {
+	if x != nil {
+		return x.AdminId
+	}
+	return ""
+}
+
+func (x *ProductEditor) GetAdminName() string {
+	if x != nil {
+		return x.AdminName
+	}
+	return ""
+}
+
+func (x *ProductEditor) GetSince() string {
+	if x != nil {
+		return x.Since
+	}
+	return ""
+}
+
+func (x *ProductEditor) GetLastSeen() string {
+	if x != nil {
+		return x.LastSeen
+	}
+	return ""
+}
+
+// Editors of a product, the earliest first; editors are forgotten when they
+// send no heartbeat for ttl_seconds
+type PresenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Editors       []*ProductEditor       `protobuf:"bytes,2,rep,name=editors,proto3" json:"editors,omitempty"`
+	TtlSeconds    int32                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PresenceResponse) Reset() {
+	*x = PresenceResponse{}
+	mi := &file_proto_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PresenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceResponse) ProtoMessage() {}
+
+func (x *PresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceResponse.ProtoReflect.Descriptor instead.
+func (*PresenceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PresenceResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PresenceResponse) GetEditors() []*ProductEditor {
+	if x != nil {
+		return x.Editors
+	}
+	return nil
+}
+
+func (x *PresenceResponse) GetTtlSeconds() int32 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+var File_proto_admin_proto protoreflect.FileDescriptor
+
+const file_proto_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x11proto/admin.proto\x12\x05admin\"\x1a\n" +
+	"\x18GetDashboardStatsRequest\"C\n" +
+	"\x11DependencyFailure\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xa7\x02\n" +
+	"\x19GetDashboardStatsResponse\x12\x1f\n" +
+	"\vtotal_users\x18\x01 \x01(\x03R\n" +
+	"totalUsers\x12%\n" +
+	"\x0etotal_products\x18\x02 \x01(\x03R\rtotalProducts\x12#\n" +
+	"\rtotal_revenue\x18\x03 \x01(\x01R\ftotalRevenue\x12!\n" +
+	"\ftotal_orders\x18\x04 \x01(\x03R\vtotalOrders\x12(\n" +
+	"\x10low_stock_alerts\x18\x05 \x01(\x03R\x0elowStockAlerts\x12\x1a\n" +
+	"\bdegraded\x18\x06 \x01(\bR\bdegraded\x124\n" +
+	"\bfailures\x18\a \x03(\v2\x18.admin.DependencyFailureR\bfailures\"\x1e\n" +
+	"\x1cGetServiceDiagnosticsRequest\"\x9d\x04\n" +
+	"\x11DBPoolDiagnostics\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\areplica\x18\x02 \x01(\bR\areplica\x120\n" +
+	"\x14max_open_connections\x18\x03 \x01(\x05R\x12maxOpenConnections\x12)\n" +
+	"\x10open_connections\x18\x04 \x01(\x05R\x0fopenConnections\x12\x15\n" +
+	"\x06in_use\x18\x05 \x01(\x05R\x05inUse\x12\x12\n" +
+	"\x04idle\x18\x06 \x01(\x05R\x04idle\x12\x1d\n" +
+	"\n" +
+	"wait_count\x18\a \x01(\x03R\twaitCount\x122\n" +
+	"\x15wait_duration_seconds\x18\b \x01(\x01R\x13waitDurationSeconds\x12&\n" +
+	"\x0fmax_idle_closed\x18\t \x01(\x03R\rmaxIdleClosed\x12/\n" +
+	"\x14max_idle_time_closed\x18\n" +
+	" \x01(\x03R\x11maxIdleTimeClosed\x12.\n" +
+	"\x13max_lifetime_closed\x18\v \x01(\x03R\x11maxLifetimeClosed\x12*\n" +
+	"\x11replica_lag_known\x18\f \x01(\bR\x0freplicaLagKnown\x12.\n" +
+	"\x13replica_lag_seconds\x18\r \x01(\x01R\x11replicaLagSeconds\x12\x1a\n" +
+	"\bexcluded\x18\x0e \x01(\bR\bexcluded\"\xfa\x01\n" +
+	"\x10CacheDiagnostics\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
+	"\x15circuit_breaker_state\x18\x02 \x01(\tR\x13circuitBreakerState\x12\x18\n" +
+	"\ahealthy\x18\x03 \x01(\bR\ahealthy\x12%\n" +
+	"\x0ememory_entries\x18\x04 \x01(\x03R\rmemoryEntries\x12\x12\n" +
+	"\x04hits\x18\x05 \x01(\x03R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x06 \x01(\x03R\x06misses\x12\x16\n" +
+	"\x06errors\x18\a \x01(\x03R\x06errors\x12\x19\n" +
+	"\bhit_rate\x18\b \x01(\x01R\ahitRate\"\xb2\x02\n" +
+	"\x12ServiceDiagnostics\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12\x1c\n" +
+	"\treachable\x18\x02 \x01(\bR\treachable\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12!\n" +
+	"\fcollected_at\x18\x04 \x01(\tR\vcollectedAt\x12%\n" +
+	"\x0euptime_seconds\x18\x05 \x01(\x01R\ruptimeSeconds\x12\x1e\n" +
+	"\n" +
+	"goroutines\x18\x06 \x01(\x05R\n" +
+	"goroutines\x123\n" +
+	"\bdb_pools\x18\a \x03(\v2\x18.admin.DBPoolDiagnosticsR\adbPools\x12/\n" +
+	"\x06caches\x18\b \x03(\v2\x17.admin.CacheDiagnosticsR\x06caches\"r\n" +
+	"\x1dGetServiceDiagnosticsResponse\x125\n" +
+	"\bservices\x18\x01 \x03(\v2\x19.admin.ServiceDiagnosticsR\bservices\x12\x1a\n" +
+	"\bdegraded\x18\x02 \x01(\bR\bdegraded\"c\n" +
+	"\x15GenerateReportRequest\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x1e\n" +
+	"\n" +
+	"recipients\x18\x03 \x03(\tR\n" +
+	"recipients\"\xe8\x01\n" +
+	"\x06Report\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12\x1b\n" +
+	"\trow_count\x18\x04 \x01(\x05R\browCount\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12$\n" +
+	"\x0eurl_expires_at\x18\x06 \x01(\tR\furlExpiresAt\x12!\n" +
+	"\fgenerated_at\x18\a \x01(\tR\vgeneratedAt\x12\x1d\n" +
+	"\n" +
+	"emailed_to\x18\b \x03(\tR\temailedTo\"-\n" +
+	"\x15DownloadReportRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x80\x01\n" +
+	"\vReportChunk\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"R\n" +
+	"\x14ExportJournalRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"\x8a\x02\n" +
+	"\rJournalExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06format\x18\x02 \x01(\tR\x06format\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\x12\x1f\n" +
+	"\ventry_count\x18\x06 \x01(\x05R\n" +
+	"entryCount\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12$\n" +
+	"\x0eurl_expires_at\x18\b \x01(\tR\furlExpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x18\n" +
+	"\acreated\x18\n" +
+	" \x01(\bR\acreated\"3\n" +
+	"\x19ListJournalExportsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\"L\n" +
+	"\x1aListJournalExportsResponse\x12.\n" +
+	"\aexports\x18\x01 \x03(\v2\x14.admin.JournalExportR\aexports\"]\n" +
+	"\x13ListActivityRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x18\n" +
+	"\asources\x18\x03 \x03(\tR\asources\"\xda\x01\n" +
+	"\rActivityEntry\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"subject_id\x18\x04 \x01(\tR\tsubjectId\x12\x18\n" +
+	"\asubject\x18\x05 \x01(\tR\asubject\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\tR\aactorId\x12\x18\n" +
+	"\asummary\x18\a \x01(\tR\asummary\x12\x1f\n" +
+	"\voccurred_at\x18\b \x01(\tR\n" +
+	"occurredAt\"\xb9\x01\n" +
+	"\x14ListActivityResponse\x12.\n" +
+	"\aentries\x18\x01 \x03(\v2\x14.admin.ActivityEntryR\aentries\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x1a\n" +
+	"\bdegraded\x18\x03 \x01(\bR\bdegraded\x124\n" +
+	"\bfailures\x18\x04 \x03(\v2\x18.admin.DependencyFailureR\bfailures\"o\n" +
+	"\x14TouchPresenceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\x12\x1d\n" +
+	"\n" +
+	"admin_name\x18\x03 \x01(\tR\tadminName\"3\n" +
+	"\x12GetPresenceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"P\n" +
+	"\x14LeavePresenceRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\tR\aadminId\"|\n" +
+	"\rProductEditor\x12\x19\n" +
+	"\badmin_id\x18\x01 \x01(\tR\aadminId\x12\x1d\n" +
+	"\n" +
+	"admin_name\x18\x02 \x01(\tR\tadminName\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\tR\x05since\x12\x1b\n" +
+	"\tlast_seen\x18\x04 \x01(\tR\blastSeen\"\x82\x01\n" +
+	"\x10PresenceResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12.\n" +
+	"\aeditors\x18\x02 \x03(\v2\x14.admin.ProductEditorR\aeditors\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x05R\n" +
+	"ttlSeconds2\x88\x06\n" +
 	"\fAdminService\x12V\n" +
-	"\x11GetDashboardStats\x12\x1f.admin.GetDashboardStatsRequest\x1a .admin.GetDashboardStatsResponseBCZAgithub.com/louai60/e-commerce_project/backend/admin-service/protob\x06proto3"
+	"\x11GetDashboardStats\x12\x1f.admin.GetDashboardStatsRequest\x1a .admin.GetDashboardStatsResponse\x12b\n" +
+	"\x15GetServiceDiagnostics\x12#.admin.GetServiceDiagnosticsRequest\x1a$.admin.GetServiceDiagnosticsResponse\x12=\n" +
+	"\x0eGenerateReport\x12\x1c.admin.GenerateReportRequest\x1a\r.admin.Report\x12D\n" +
+	"\x0eDownloadReport\x12\x1c.admin.DownloadReportRequest\x1a\x12.admin.ReportChunk0\x01\x12B\n" +
+	"\rExportJournal\x12\x1b.admin.ExportJournalRequest\x1a\x14.admin.JournalExport\x12Y\n" +
+	"\x12ListJournalExports\x12 .admin.ListJournalExportsRequest\x1a!.admin.ListJournalExportsResponse\x12G\n" +
+	"\fListActivity\x12\x1a.admin.ListActivityRequest\x1a\x1b.admin.ListActivityResponse\x12E\n" +
+	"\rTouchPresence\x12\x1b.admin.TouchPresenceRequest\x1a\x17.admin.PresenceResponse\x12A\n" +
+	"\vGetPresence\x12\x19.admin.GetPresenceRequest\x1a\x17.admin.PresenceResponse\x12E\n" +
+	"\rLeavePresence\x12\x1b.admin.LeavePresenceRequest\x1a\x17.admin.PresenceResponseBCZAgithub.com/louai60/e-commerce_project/backend/admin-service/protob\x06proto3"
 
 var (
 	file_proto_admin_proto_rawDescOnce sync.Once
@@ -154,19 +1885,67 @@ func file_proto_admin_proto_rawDescGZIP() []byte {
 	return file_proto_admin_proto_rawDescData
 }
 
-var file_proto_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_proto_admin_proto_goTypes = []any{
-	(*GetDashboardStatsRequest)(nil),  // 0: admin.GetDashboardStatsRequest
-	(*GetDashboardStatsResponse)(nil), // 1: admin.GetDashboardStatsResponse
+	(*GetDashboardStatsRequest)(nil),      // 0: admin.GetDashboardStatsRequest
+	(*DependencyFailure)(nil),             // 1: admin.DependencyFailure
+	(*GetDashboardStatsResponse)(nil),     // 2: admin.GetDashboardStatsResponse
+	(*GetServiceDiagnosticsRequest)(nil),  // 3: admin.GetServiceDiagnosticsRequest
+	(*DBPoolDiagnostics)(nil),             // 4: admin.DBPoolDiagnostics
+	(*CacheDiagnostics)(nil),              // 5: admin.CacheDiagnostics
+	(*ServiceDiagnostics)(nil),            // 6: admin.ServiceDiagnostics
+	(*GetServiceDiagnosticsResponse)(nil), // 7: admin.GetServiceDiagnosticsResponse
+	(*GenerateReportRequest)(nil),         // 8: admin.GenerateReportRequest
+	(*Report)(nil),                        // 9: admin.Report
+	(*DownloadReportRequest)(nil),         // 10: admin.DownloadReportRequest
+	(*ReportChunk)(nil),                   // 11: admin.ReportChunk
+	(*ExportJournalRequest)(nil),          // 12: admin.ExportJournalRequest
+	(*JournalExport)(nil),                 // 13: admin.JournalExport
+	(*ListJournalExportsRequest)(nil),     // 14: admin.ListJournalExportsRequest
+	(*ListJournalExportsResponse)(nil),    // 15: admin.ListJournalExportsResponse
+	(*ListActivityRequest)(nil),           // 16: admin.ListActivityRequest
+	(*ActivityEntry)(nil),                 // 17: admin.ActivityEntry
+	(*ListActivityResponse)(nil),          // 18: admin.ListActivityResponse
+	(*TouchPresenceRequest)(nil),          // 19: admin.TouchPresenceRequest
+	(*GetPresenceRequest)(nil),            // 20: admin.GetPresenceRequest
+	(*LeavePresenceRequest)(nil),          // 21: admin.LeavePresenceRequest
+	(*ProductEditor)(nil),                 // 22: admin.ProductEditor
+	(*PresenceResponse)(nil),              // 23: admin.PresenceResponse
 }
 var file_proto_admin_proto_depIdxs = []int32{
-	0, // 0: admin.AdminService.GetDashboardStats:input_type -> admin.GetDashboardStatsRequest
-	1, // 1: admin.AdminService.GetDashboardStats:output_type -> admin.GetDashboardStatsResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1,  // 0: admin.GetDashboardStatsResponse.failures:type_name -> admin.DependencyFailure
+	4,  // 1: admin.ServiceDiagnostics.db_pools:type_name -> admin.DBPoolDiagnostics
+	5,  // 2: admin.ServiceDiagnostics.caches:type_name -> admin.CacheDiagnostics
+	6,  // 3: admin.GetServiceDiagnosticsResponse.services:type_name -> admin.ServiceDiagnostics
+	13, // 4: admin.ListJournalExportsResponse.exports:type_name -> admin.JournalExport
+	17, // 5: admin.ListActivityResponse.entries:type_name -> admin.ActivityEntry
+	1,  // 6: admin.ListActivityResponse.failures:type_name -> admin.DependencyFailure
+	22, // 7: admin.PresenceResponse.editors:type_name -> admin.ProductEditor
+	0,  // 8: admin.AdminService.GetDashboardStats:input_type -> admin.GetDashboardStatsRequest
+	3,  // 9: admin.AdminService.GetServiceDiagnostics:input_type -> admin.GetServiceDiagnosticsRequest
+	8,  // 10: admin.AdminService.GenerateReport:input_type -> admin.GenerateReportRequest
+	10, // 11: admin.AdminService.DownloadReport:input_type -> admin.DownloadReportRequest
+	12, // 12: admin.AdminService.ExportJournal:input_type -> admin.ExportJournalRequest
+	14, // 13: admin.AdminService.ListJournalExports:input_type -> admin.ListJournalExportsRequest
+	16, // 14: admin.AdminService.ListActivity:input_type -> admin.ListActivityRequest
+	19, // 15: admin.AdminService.TouchPresence:input_type -> admin.TouchPresenceRequest
+	20, // 16: admin.AdminService.GetPresence:input_type -> admin.GetPresenceRequest
+	21, // 17: admin.AdminService.LeavePresence:input_type -> admin.LeavePresenceRequest
+	2,  // 18: admin.AdminService.GetDashboardStats:output_type -> admin.GetDashboardStatsResponse
+	7,  // 19: admin.AdminService.GetServiceDiagnostics:output_type -> admin.GetServiceDiagnosticsResponse
+	9,  // 20: admin.AdminService.GenerateReport:output_type -> admin.Report
+	11, // 21: admin.AdminService.DownloadReport:output_type -> admin.ReportChunk
+	13, // 22: admin.AdminService.ExportJournal:output_type -> admin.JournalExport
+	15, // 23: admin.AdminService.ListJournalExports:output_type -> admin.ListJournalExportsResponse
+	18, // 24: admin.AdminService.ListActivity:output_type -> admin.ListActivityResponse
+	23, // 25: admin.AdminService.TouchPresence:output_type -> admin.PresenceResponse
+	23, // 26: admin.AdminService.GetPresence:output_type -> admin.PresenceResponse
+	23, // 27: admin.AdminService.LeavePresence:output_type -> admin.PresenceResponse
+	18, // [18:28] is the sub-list for method output_type
+	8,  // [8:18] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_proto_admin_proto_init() }
@@ -180,7 +1959,7 @@ func file_proto_admin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_admin_proto_rawDesc), len(file_proto_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
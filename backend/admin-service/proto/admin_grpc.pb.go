@@ -19,18 +19,46 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_GetDashboardStats_FullMethodName = "/admin.AdminService/GetDashboardStats"
+	AdminService_GetDashboardStats_FullMethodName     = "/admin.AdminService/GetDashboardStats"
+	AdminService_GetServiceDiagnostics_FullMethodName = "/admin.AdminService/GetServiceDiagnostics"
+	AdminService_GenerateReport_FullMethodName        = "/admin.AdminService/GenerateReport"
+	AdminService_DownloadReport_FullMethodName        = "/admin.AdminService/DownloadReport"
+	AdminService_ExportJournal_FullMethodName         = "/admin.AdminService/ExportJournal"
+	AdminService_ListJournalExports_FullMethodName    = "/admin.AdminService/ListJournalExports"
+	AdminService_ListActivity_FullMethodName          = "/admin.AdminService/ListActivity"
+	AdminService_TouchPresence_FullMethodName         = "/admin.AdminService/TouchPresence"
+	AdminService_GetPresence_FullMethodName           = "/admin.AdminService/GetPresence"
+	AdminService_LeavePresence_FullMethodName         = "/admin.AdminService/LeavePresence"
 )
 
 // AdminServiceClient is the client API for AdminService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 //
-// Placeholder for Admin Service definition
-// We can add RPC methods here later as needed for the dashboard
+// AdminService backs the admin dashboard. Calls fanning out to several
+// services return partial results, marked as degraded, when some of them
+// cannot answer.
 type AdminServiceClient interface {
-	// Example RPC method (can be expanded later)
+	// Collects the store totals of the dashboard from the backend services
 	GetDashboardStats(ctx context.Context, in *GetDashboardStatsRequest, opts ...grpc.CallOption) (*GetDashboardStatsResponse, error)
+	// Collects runtime diagnostics from every backend service for the ops dashboard
+	GetServiceDiagnostics(ctx context.Context, in *GetServiceDiagnosticsRequest, opts ...grpc.CallOption) (*GetServiceDiagnosticsResponse, error)
+	// Generates a report for the current store and emails its download link to the recipients
+	GenerateReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*Report, error)
+	// Streams a stored report; the signed token from its download link is the credential
+	DownloadReport(ctx context.Context, in *DownloadReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReportChunk], error)
+	// Exports the accounting journal of the current store for a period; a period already exported returns its first run
+	ExportJournal(ctx context.Context, in *ExportJournalRequest, opts ...grpc.CallOption) (*JournalExport, error)
+	// Lists the accounting journal export runs of the current store, newest first
+	ListJournalExports(ctx context.Context, in *ListJournalExportsRequest, opts ...grpc.CallOption) (*ListJournalExportsResponse, error)
+	// Lists the recent catalog changes, inventory adjustments and user events, newest first
+	ListActivity(ctx context.Context, in *ListActivityRequest, opts ...grpc.CallOption) (*ListActivityResponse, error)
+	// Records a heartbeat of an admin editing a product and returns its editors
+	TouchPresence(ctx context.Context, in *TouchPresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error)
+	// Returns the admins currently editing a product
+	GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error)
+	// Records that an admin stopped editing a product
+	LeavePresence(ctx context.Context, in *LeavePresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error)
 }
 
 type adminServiceClient struct {
@@ -51,15 +79,133 @@ func (c *adminServiceClient) GetDashboardStats(ctx context.Context, in *GetDashb
 	return out, nil
 }
 
+func (c *adminServiceClient) GetServiceDiagnostics(ctx context.Context, in *GetServiceDiagnosticsRequest, opts ...grpc.CallOption) (*GetServiceDiagnosticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetServiceDiagnosticsResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetServiceDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GenerateReport(ctx context.Context, in *GenerateReportRequest, opts ...grpc.CallOption) (*Report, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Report)
+	err := c.cc.Invoke(ctx, AdminService_GenerateReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DownloadReport(ctx context.Context, in *DownloadReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], AdminService_DownloadReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadReportRequest, ReportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_DownloadReportClient = grpc.ServerStreamingClient[ReportChunk]
+
+func (c *adminServiceClient) ExportJournal(ctx context.Context, in *ExportJournalRequest, opts ...grpc.CallOption) (*JournalExport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JournalExport)
+	err := c.cc.Invoke(ctx, AdminService_ExportJournal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListJournalExports(ctx context.Context, in *ListJournalExportsRequest, opts ...grpc.CallOption) (*ListJournalExportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJournalExportsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListJournalExports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListActivity(ctx context.Context, in *ListActivityRequest, opts ...grpc.CallOption) (*ListActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListActivityResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) TouchPresence(ctx context.Context, in *TouchPresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresenceResponse)
+	err := c.cc.Invoke(ctx, AdminService_TouchPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetPresence(ctx context.Context, in *GetPresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresenceResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) LeavePresence(ctx context.Context, in *LeavePresenceRequest, opts ...grpc.CallOption) (*PresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PresenceResponse)
+	err := c.cc.Invoke(ctx, AdminService_LeavePresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
 //
-// Placeholder for Admin Service definition
-// We can add RPC methods here later as needed for the dashboard
+// AdminService backs the admin dashboard. Calls fanning out to several
+// services return partial results, marked as degraded, when some of them
+// cannot answer.
 type AdminServiceServer interface {
-	// Example RPC method (can be expanded later)
+	// Collects the store totals of the dashboard from the backend services
 	GetDashboardStats(context.Context, *GetDashboardStatsRequest) (*GetDashboardStatsResponse, error)
+	// Collects runtime diagnostics from every backend service for the ops dashboard
+	GetServiceDiagnostics(context.Context, *GetServiceDiagnosticsRequest) (*GetServiceDiagnosticsResponse, error)
+	// Generates a report for the current store and emails its download link to the recipients
+	GenerateReport(context.Context, *GenerateReportRequest) (*Report, error)
+	// Streams a stored report; the signed token from its download link is the credential
+	DownloadReport(*DownloadReportRequest, grpc.ServerStreamingServer[ReportChunk]) error
+	// Exports the accounting journal of the current store for a period; a period already exported returns its first run
+	ExportJournal(context.Context, *ExportJournalRequest) (*JournalExport, error)
+	// Lists the accounting journal export runs of the current store, newest first
+	ListJournalExports(context.Context, *ListJournalExportsRequest) (*ListJournalExportsResponse, error)
+	// Lists the recent catalog changes, inventory adjustments and user events, newest first
+	ListActivity(context.Context, *ListActivityRequest) (*ListActivityResponse, error)
+	// Records a heartbeat of an admin editing a product and returns its editors
+	TouchPresence(context.Context, *TouchPresenceRequest) (*PresenceResponse, error)
+	// Returns the admins currently editing a product
+	GetPresence(context.Context, *GetPresenceRequest) (*PresenceResponse, error)
+	// Records that an admin stopped editing a product
+	LeavePresence(context.Context, *LeavePresenceRequest) (*PresenceResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -73,6 +219,33 @@ type UnimplementedAdminServiceServer struct{}
 func (UnimplementedAdminServiceServer) GetDashboardStats(context.Context, *GetDashboardStatsRequest) (*GetDashboardStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDashboardStats not implemented")
 }
+func (UnimplementedAdminServiceServer) GetServiceDiagnostics(context.Context, *GetServiceDiagnosticsRequest) (*GetServiceDiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServiceDiagnostics not implemented")
+}
+func (UnimplementedAdminServiceServer) GenerateReport(context.Context, *GenerateReportRequest) (*Report, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateReport not implemented")
+}
+func (UnimplementedAdminServiceServer) DownloadReport(*DownloadReportRequest, grpc.ServerStreamingServer[ReportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadReport not implemented")
+}
+func (UnimplementedAdminServiceServer) ExportJournal(context.Context, *ExportJournalRequest) (*JournalExport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportJournal not implemented")
+}
+func (UnimplementedAdminServiceServer) ListJournalExports(context.Context, *ListJournalExportsRequest) (*ListJournalExportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJournalExports not implemented")
+}
+func (UnimplementedAdminServiceServer) ListActivity(context.Context, *ListActivityRequest) (*ListActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListActivity not implemented")
+}
+func (UnimplementedAdminServiceServer) TouchPresence(context.Context, *TouchPresenceRequest) (*PresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TouchPresence not implemented")
+}
+func (UnimplementedAdminServiceServer) GetPresence(context.Context, *GetPresenceRequest) (*PresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPresence not implemented")
+}
+func (UnimplementedAdminServiceServer) LeavePresence(context.Context, *LeavePresenceRequest) (*PresenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeavePresence not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -112,6 +285,161 @@ func _AdminService_GetDashboardStats_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_GetServiceDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServiceDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetServiceDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetServiceDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetServiceDiagnostics(ctx, req.(*GetServiceDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GenerateReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GenerateReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GenerateReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GenerateReport(ctx, req.(*GenerateReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DownloadReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).DownloadReport(m, &grpc.GenericServerStream[DownloadReportRequest, ReportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AdminService_DownloadReportServer = grpc.ServerStreamingServer[ReportChunk]
+
+func _AdminService_ExportJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportJournalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExportJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExportJournal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExportJournal(ctx, req.(*ExportJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListJournalExports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJournalExportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListJournalExports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListJournalExports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListJournalExports(ctx, req.(*ListJournalExportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListActivity(ctx, req.(*ListActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_TouchPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TouchPresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).TouchPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_TouchPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).TouchPresence(ctx, req.(*TouchPresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetPresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetPresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetPresence(ctx, req.(*GetPresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_LeavePresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeavePresenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).LeavePresence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_LeavePresence_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).LeavePresence(ctx, req.(*LeavePresenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -123,7 +451,45 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDashboardStats",
 			Handler:    _AdminService_GetDashboardStats_Handler,
 		},
+		{
+			MethodName: "GetServiceDiagnostics",
+			Handler:    _AdminService_GetServiceDiagnostics_Handler,
+		},
+		{
+			MethodName: "GenerateReport",
+			Handler:    _AdminService_GenerateReport_Handler,
+		},
+		{
+			MethodName: "ExportJournal",
+			Handler:    _AdminService_ExportJournal_Handler,
+		},
+		{
+			MethodName: "ListJournalExports",
+			Handler:    _AdminService_ListJournalExports_Handler,
+		},
+		{
+			MethodName: "ListActivity",
+			Handler:    _AdminService_ListActivity_Handler,
+		},
+		{
+			MethodName: "TouchPresence",
+			Handler:    _AdminService_TouchPresence_Handler,
+		},
+		{
+			MethodName: "GetPresence",
+			Handler:    _AdminService_GetPresence_Handler,
+		},
+		{
+			MethodName: "LeavePresence",
+			Handler:    _AdminService_LeavePresence_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadReport",
+			Handler:       _AdminService_DownloadReport_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/admin.proto",
 }
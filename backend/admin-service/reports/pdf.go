@@ -0,0 +1,160 @@
+package reports
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// PDF page layout: landscape A4 in 8pt Courier, so columns line up without
+// font metrics
+const (
+	pdfPageWidth    = 842
+	pdfPageHeight   = 595
+	pdfMargin       = 36
+	pdfFontSize     = 8
+	pdfLeading      = 10
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLeading
+	// Courier glyphs are 0.6 em wide
+	pdfCharsPerLine = (pdfPageWidth - 2*pdfMargin) * 10 / (pdfFontSize * 6)
+	// maxColumnWidth keeps one long value from pushing the other columns off
+	// the page
+	maxColumnWidth = 48
+)
+
+// tableLines lays the table out as fixed-width text lines
+func tableLines(table *Table, generatedAt time.Time) []string {
+	widths := make([]int, len(table.Columns))
+	for i, column := range table.Columns {
+		widths[i] = len(column)
+	}
+	for _, row := range table.Rows {
+		for i, cell := range row {
+			if i < len(widths) && len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+	for i := range widths {
+		if widths[i] > maxColumnWidth {
+			widths[i] = maxColumnWidth
+		}
+	}
+
+	formatRow := func(cells []string) string {
+		var b strings.Builder
+		for i, width := range widths {
+			cell := ""
+			if i < len(cells) {
+				cell = cells[i]
+			}
+			if len(cell) > width {
+				cell = cell[:width-3] + "..."
+			}
+			if i > 0 {
+				b.WriteString("  ")
+			}
+			b.WriteString(cell)
+			b.WriteString(strings.Repeat(" ", width-len(cell)))
+		}
+		return strings.TrimRight(b.String(), " ")
+	}
+
+	separators := make([]string, len(widths))
+	for i, width := range widths {
+		separators[i] = strings.Repeat("-", width)
+	}
+
+	lines := []string{
+		table.Title,
+		fmt.Sprintf("Generated %s, %d rows", generatedAt.UTC().Format("2006-01-02 15:04 UTC"), len(table.Rows)),
+		"",
+		formatRow(table.Columns),
+		formatRow(separators),
+	}
+	for _, row := range table.Rows {
+		lines = append(lines, formatRow(row))
+	}
+	return lines
+}
+
+// writePDF writes the lines as a minimal PDF document with one text stream
+// per page. Characters outside printable ASCII are replaced with '?' since
+// the standard Courier font has no Unicode mapping.
+func writePDF(w io.Writer, lines []string) error {
+	var pages [][]string
+	for len(lines) > pdfLinesPerPage {
+		pages = append(pages, lines[:pdfLinesPerPage])
+		lines = lines[pdfLinesPerPage:]
+	}
+	pages = append(pages, lines)
+
+	var buf bytes.Buffer
+	var offsets []int
+	writeObject := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+
+	// Objects 1-3 are the catalog, page tree and font; page i is object 4+2i
+	// and its content stream follows it
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+2*i)
+	}
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Courier >>")
+
+	for i, page := range pages {
+		writeObject(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 5+2*i))
+
+		var content strings.Builder
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLeading, pdfMargin, pdfPageHeight-pdfMargin-pdfFontSize)
+		for _, line := range page {
+			fmt.Fprintf(&content, "(%s) Tj T*\n", escapePDFText(line))
+		}
+		content.WriteString("ET")
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write PDF report: %w", err)
+	}
+	return nil
+}
+
+// escapePDFText prepares a line for a PDF string literal
+func escapePDFText(line string) string {
+	var b strings.Builder
+	count := 0
+	for _, r := range line {
+		if count == pdfCharsPerLine {
+			break
+		}
+		count++
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r < 0x20 || r > 0x7e:
+			b.WriteByte('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,84 @@
+// Package reports builds admin reports from the backend services, stores them
+// and emails download links to their recipients.
+package reports
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"time"
+)
+
+// Report kinds
+const (
+	KindCatalogCompleteness = "catalog_completeness"
+	KindLowStock            = "low_stock"
+	KindNewUsers            = "new_users"
+)
+
+// Report file formats
+const (
+	FormatCSV = "csv"
+	FormatPDF = "pdf"
+)
+
+// Kinds lists every report kind
+var Kinds = []string{KindCatalogCompleteness, KindLowStock, KindNewUsers}
+
+// Table is the content of a report
+type Table struct {
+	Title   string
+	Columns []string
+	Rows    [][]string
+}
+
+// IsValidKind reports whether kind names a known report
+func IsValidKind(kind string) bool {
+	for _, k := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidFormat reports whether format names a supported file format
+func IsValidFormat(format string) bool {
+	return format == FormatCSV || format == FormatPDF
+}
+
+// ContentType returns the MIME type of a report format
+func ContentType(format string) string {
+	if format == FormatPDF {
+		return "application/pdf"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// FileName returns the download file name of a report, e.g.
+// low_stock_20240102.csv
+func FileName(kind, format string, generatedAt time.Time) string {
+	return fmt.Sprintf("%s_%s.%s", kind, generatedAt.UTC().Format("20060102"), format)
+}
+
+// Encode writes the table in the given format
+func Encode(w io.Writer, format string, table *Table, generatedAt time.Time) error {
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, table)
+	case FormatPDF:
+		return writePDF(w, tableLines(table, generatedAt))
+	default:
+		return fmt.Errorf("unsupported report format %q", format)
+	}
+}
+
+func writeCSV(w io.Writer, table *Table) error {
+	writer := csv.NewWriter(w)
+	writer.Write(table.Columns)
+	writer.WriteAll(table.Rows)
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV report: %w", err)
+	}
+	return nil
+}
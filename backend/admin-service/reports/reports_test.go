@@ -0,0 +1,82 @@
+package reports
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseSchedules(t *testing.T) {
+	schedules, err := ParseSchedules("low_stock:csv:24h, catalog_completeness:pdf:168h", []string{"ops@example.com"})
+	if err != nil {
+		t.Fatalf("ParseSchedules() error = %v", err)
+	}
+	if len(schedules) != 2 {
+		t.Fatalf("ParseSchedules() returned %d schedules, want 2", len(schedules))
+	}
+	if schedules[1].Kind != KindCatalogCompleteness || schedules[1].Format != FormatPDF || schedules[1].Interval != 168*time.Hour {
+		t.Errorf("second schedule = %+v", schedules[1])
+	}
+	if schedules[0].Recipients[0] != "ops@example.com" {
+		t.Errorf("recipients = %v", schedules[0].Recipients)
+	}
+
+	for _, value := range []string{"low_stock:csv", "sales:csv:24h", "low_stock:xlsx:24h", "low_stock:csv:10s"} {
+		if _, err := ParseSchedules(value, nil); err == nil {
+			t.Errorf("ParseSchedules(%q) expected an error", value)
+		}
+	}
+}
+
+func TestEncodeCSV(t *testing.T) {
+	table := &Table{
+		Title:   "Low stock",
+		Columns: []string{"sku", "quantity"},
+		Rows:    [][]string{{"A,1", "2"}},
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, FormatCSV, table, time.Now()); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	if want := "sku,quantity\n\"A,1\",2\n"; buf.String() != want {
+		t.Errorf("Encode() = %q, want %q", buf.String(), want)
+	}
+}
+
+func TestEncodePDF(t *testing.T) {
+	table := &Table{Title: "New users (week)", Columns: []string{"email"}}
+	for i := 0; i < pdfLinesPerPage; i++ {
+		table.Rows = append(table.Rows, []string{"user@example.com"})
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, FormatPDF, table, time.Date(2024, 1, 2, 3, 4, 0, 0, time.UTC)); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	pdf := buf.String()
+
+	if !strings.HasPrefix(pdf, "%PDF-1.4\n") || !strings.HasSuffix(pdf, "%%EOF\n") {
+		t.Error("output is not framed as a PDF document")
+	}
+	// The header lines push the rows onto a second page
+	if !strings.Contains(pdf, "/Count 2") {
+		t.Error("expected two pages")
+	}
+	if !strings.Contains(pdf, `(New users \(week\)) Tj`) {
+		t.Error("title is not escaped")
+	}
+}
+
+func TestTableLinesTruncatesWideColumns(t *testing.T) {
+	table := &Table{
+		Columns: []string{"title", "sku"},
+		Rows:    [][]string{{strings.Repeat("x", 60), "A"}},
+	}
+	lines := tableLines(table, time.Now())
+	row := lines[len(lines)-1]
+	if want := strings.Repeat("x", maxColumnWidth-3) + "...  A"; row != want {
+		t.Errorf("row = %q, want %q", row, want)
+	}
+}
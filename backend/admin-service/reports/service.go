@@ -0,0 +1,279 @@
+package reports
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/downloadtoken"
+	"github.com/louai60/e-commerce_project/backend/common/mail"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/storage"
+)
+
+const (
+	defaultLinkTTL = 72 * time.Hour
+	// storageFolder is the storage folder reports are kept in, one subfolder
+	// per store
+	storageFolder = "reports"
+)
+
+// Schedule is a report generated and emailed at a fixed interval
+type Schedule struct {
+	Kind       string
+	Format     string
+	Interval   time.Duration
+	Recipients []string
+}
+
+// ParseSchedules parses comma separated kind:format:interval entries, e.g.
+// "low_stock:csv:24h,catalog_completeness:pdf:168h". Every schedule is
+// emailed to recipients.
+func ParseSchedules(value string, recipients []string) ([]Schedule, error) {
+	var schedules []Schedule
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("report schedule %q must be kind:format:interval", entry)
+		}
+		if !IsValidKind(parts[0]) {
+			return nil, fmt.Errorf("report schedule %q: unknown report kind", entry)
+		}
+		if !IsValidFormat(parts[1]) {
+			return nil, fmt.Errorf("report schedule %q: format must be csv or pdf", entry)
+		}
+		interval, err := time.ParseDuration(parts[2])
+		if err != nil || interval < time.Minute {
+			return nil, fmt.Errorf("report schedule %q: interval must be a duration of at least 1m", entry)
+		}
+		schedules = append(schedules, Schedule{
+			Kind:       parts[0],
+			Format:     parts[1],
+			Interval:   interval,
+			Recipients: recipients,
+		})
+	}
+	return schedules, nil
+}
+
+// Options configures report storage links
+type Options struct {
+	// SigningKey signs download links; links are not issued without it
+	SigningKey string
+	// BaseURL is the public download endpoint, links are BaseURL/<token>
+	BaseURL string
+	LinkTTL time.Duration
+}
+
+// Generated describes a stored report
+type Generated struct {
+	Kind         string
+	Format       string
+	FileName     string
+	StorageKey   string
+	RowCount     int
+	URL          string // Empty when links are not configured
+	URLExpiresAt time.Time
+	GeneratedAt  time.Time
+}
+
+// Service generates, stores and delivers reports
+type Service struct {
+	source  *Source
+	store   storage.Storage
+	mailer  mail.Mailer
+	stores  productpb.ProductServiceClient
+	options Options
+	logger  *zap.Logger
+}
+
+// NewService creates a report service. stores lists the stores scheduled
+// reports are generated for.
+func NewService(source *Source, store storage.Storage, mailer mail.Mailer, stores productpb.ProductServiceClient, options Options, logger *zap.Logger) *Service {
+	if options.LinkTTL <= 0 {
+		options.LinkTTL = defaultLinkTTL
+	}
+	return &Service{
+		source:  source,
+		store:   store,
+		mailer:  mailer,
+		stores:  stores,
+		options: options,
+		logger:  logger.Named("reports"),
+	}
+}
+
+// Generate builds a report for the store in ctx and stores it
+func (s *Service) Generate(ctx context.Context, kind, format string) (*Generated, error) {
+	if !IsValidKind(kind) {
+		return nil, fmt.Errorf("unknown report kind %q", kind)
+	}
+	if !IsValidFormat(format) {
+		return nil, fmt.Errorf("unsupported report format %q", format)
+	}
+
+	now := time.Now()
+	table, err := s.source.Build(ctx, kind, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := Encode(&buf, format, table, now); err != nil {
+		return nil, err
+	}
+	return s.Store(ctx, kind, format, FileName(kind, format, now), buf.Bytes(), len(table.Rows), now)
+}
+
+// Store stores an encoded report file of the store in ctx and signs its
+// download link. Files built outside this package, such as accounting
+// exports, are stored here so they share the report download endpoint.
+func (s *Service) Store(ctx context.Context, kind, format, fileName string, data []byte, rowCount int, generatedAt time.Time) (*Generated, error) {
+	result, err := s.store.Upload(data, path.Join(storageFolder, tenant.FromContext(ctx)), fileName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store report: %w", err)
+	}
+
+	report := &Generated{
+		Kind:        kind,
+		Format:      format,
+		FileName:    fileName,
+		StorageKey:  result.PublicID,
+		RowCount:    rowCount,
+		GeneratedAt: generatedAt,
+	}
+	report.URL, report.URLExpiresAt = s.Link(report.StorageKey, generatedAt)
+
+	s.logger.Info("Report generated",
+		zap.String("tenant_id", tenant.FromContext(ctx)),
+		zap.String("kind", kind),
+		zap.String("format", format),
+		zap.Int("rows", report.RowCount),
+		zap.String("storage_key", report.StorageKey))
+	return report, nil
+}
+
+// Link signs a download link of a stored report valid from now. It returns
+// an empty link when links are not configured.
+func (s *Service) Link(storageKey string, now time.Time) (string, time.Time) {
+	if s.options.SigningKey == "" || s.options.BaseURL == "" {
+		return "", time.Time{}
+	}
+	expiresAt := now.Add(s.options.LinkTTL)
+	token := downloadtoken.Sign([]byte(s.options.SigningKey), downloadtoken.Claims{
+		GrantID:   storageKey,
+		ExpiresAt: expiresAt,
+	})
+	return strings.TrimSuffix(s.options.BaseURL, "/") + "/" + token, expiresAt
+}
+
+// Deliver emails the download link of a report to the recipients
+func (s *Service) Deliver(ctx context.Context, report *Generated, recipients []string) error {
+	if len(recipients) == 0 {
+		return nil
+	}
+	if report.URL == "" {
+		return errors.New("report links are not configured")
+	}
+
+	title := strings.ReplaceAll(report.Kind, "_", " ")
+	body := fmt.Sprintf(
+		"Your %s report for store %s is ready.\n\nRows: %d\nGenerated: %s\n\nDownload: %s\n\nThe link expires on %s.\n",
+		title,
+		tenant.FromContext(ctx),
+		report.RowCount,
+		report.GeneratedAt.UTC().Format("2006-01-02 15:04 UTC"),
+		report.URL,
+		report.URLExpiresAt.UTC().Format("2006-01-02 15:04 UTC"),
+	)
+	return s.mailer.Send(ctx, mail.Message{
+		To:      recipients,
+		Subject: fmt.Sprintf("Report: %s (%s)", title, tenant.FromContext(ctx)),
+		Body:    body,
+	})
+}
+
+// Open verifies a download token and opens the report it grants access to
+func (s *Service) Open(token string) (io.ReadCloser, string, error) {
+	if s.options.SigningKey == "" {
+		return nil, "", errors.New("report links are not configured")
+	}
+	claims, err := downloadtoken.Verify([]byte(s.options.SigningKey), token, time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+	if !strings.HasPrefix(claims.GrantID, storageFolder+"/") {
+		return nil, "", downloadtoken.ErrInvalid
+	}
+
+	file, err := s.store.Open(claims.GrantID)
+	if err != nil {
+		return nil, "", err
+	}
+	return file, path.Base(claims.GrantID), nil
+}
+
+// StartScheduler runs each schedule at its interval for every active store
+// until the context is cancelled
+func (s *Service) StartScheduler(ctx context.Context, schedules []Schedule) {
+	for _, schedule := range schedules {
+		go func(schedule Schedule) {
+			ticker := time.NewTicker(schedule.Interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-ctx.Done():
+					s.logger.Info("Report scheduler stopped", zap.String("kind", schedule.Kind))
+					return
+				case <-ticker.C:
+					s.runSchedule(ctx, schedule)
+				}
+			}
+		}(schedule)
+	}
+}
+
+// runSchedule generates and delivers a scheduled report for every active
+// store. A failing store does not stop the others.
+func (s *Service) runSchedule(ctx context.Context, schedule Schedule) {
+	resp, err := s.stores.ListStores(ctx, &productpb.ListStoresRequest{})
+	if err != nil {
+		s.logger.Error("Failed to list stores for scheduled report", zap.String("kind", schedule.Kind), zap.Error(err))
+		return
+	}
+
+	for _, store := range resp.Stores {
+		if !store.IsActive {
+			continue
+		}
+		storeCtx := tenant.WithTenant(ctx, store.Id)
+
+		report, err := s.Generate(storeCtx, schedule.Kind, schedule.Format)
+		if err != nil {
+			s.logger.Error("Scheduled report failed",
+				zap.String("tenant_id", store.Id),
+				zap.String("kind", schedule.Kind),
+				zap.Error(err))
+			continue
+		}
+		if err := s.Deliver(storeCtx, report, schedule.Recipients); err != nil {
+			s.logger.Error("Failed to deliver scheduled report",
+				zap.String("tenant_id", store.Id),
+				zap.String("kind", schedule.Kind),
+				zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package reports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	userpb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// pageSize is the page size used when listing products and users
+const pageSize = 100
+
+// ErrInventoryUnavailable is returned for stock reports when no inventory
+// service is configured
+var ErrInventoryUnavailable = errors.New("inventory service is not configured")
+
+// Source builds report tables from the backend services. Calls are made for
+// the store in the context.
+type Source struct {
+	products  productpb.ProductServiceClient
+	inventory inventorypb.InventoryServiceClient // nil when not configured
+	users     userpb.UserServiceClient
+	// newUsersWindow is how far back the new users report looks
+	newUsersWindow time.Duration
+}
+
+// NewSource creates a report source. inventory may be nil.
+func NewSource(products productpb.ProductServiceClient, inventory inventorypb.InventoryServiceClient, users userpb.UserServiceClient, newUsersWindow time.Duration) *Source {
+	if newUsersWindow <= 0 {
+		newUsersWindow = 7 * 24 * time.Hour
+	}
+	return &Source{
+		products:       products,
+		inventory:      inventory,
+		users:          users,
+		newUsersWindow: newUsersWindow,
+	}
+}
+
+// Build builds the table of a report kind
+func (s *Source) Build(ctx context.Context, kind string, now time.Time) (*Table, error) {
+	switch kind {
+	case KindCatalogCompleteness:
+		return s.catalogCompleteness(ctx)
+	case KindLowStock:
+		return s.lowStock(ctx)
+	case KindNewUsers:
+		return s.newUsers(ctx, now.Add(-s.newUsersWindow))
+	default:
+		return nil, fmt.Errorf("unknown report kind %q", kind)
+	}
+}
+
+// catalogCompleteness lists every product with the share of catalog fields
+// it fills in, least complete first
+func (s *Source) catalogCompleteness(ctx context.Context) (*Table, error) {
+	type scored struct {
+		row   []string
+		score int
+	}
+	var products []scored
+
+	for page := int32(1); ; page++ {
+		resp, err := s.products.ListProducts(ctx, &productpb.ListProductsRequest{Page: page, Limit: pageSize})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list products: %w", err)
+		}
+		for _, product := range resp.Products {
+			missing := missingProductFields(product)
+			checks := len(productChecks)
+			if !product.RequiresShipping {
+				checks--
+			}
+			score := 100 * (checks - len(missing)) / checks
+			products = append(products, scored{
+				score: score,
+				row: []string{
+					product.Id,
+					product.Sku,
+					product.Title,
+					strconv.FormatBool(product.IsPublished),
+					strconv.Itoa(score),
+					strings.Join(missing, " "),
+				},
+			})
+		}
+		if len(resp.Products) < pageSize || len(products) >= int(resp.Total) {
+			break
+		}
+	}
+
+	sort.SliceStable(products, func(i, j int) bool {
+		return products[i].score < products[j].score
+	})
+
+	table := &Table{
+		Title:   "Catalog completeness",
+		Columns: []string{"product_id", "sku", "title", "published", "score", "missing"},
+		Rows:    make([][]string, len(products)),
+	}
+	for i, product := range products {
+		table.Rows[i] = product.row
+	}
+	return table, nil
+}
+
+// productChecks are the catalog fields a complete product fills in
+var productChecks = []struct {
+	name   string
+	filled func(*productpb.Product) bool
+}{
+	{"description", func(p *productpb.Product) bool { return strings.TrimSpace(p.Description) != "" }},
+	{"short_description", func(p *productpb.Product) bool { return strings.TrimSpace(p.ShortDescription) != "" }},
+	{"sku", func(p *productpb.Product) bool { return p.Sku != "" }},
+	{"price", func(p *productpb.Product) bool { return p.Price > 0 }},
+	{"images", func(p *productpb.Product) bool { return len(p.Images) > 0 }},
+	{"categories", func(p *productpb.Product) bool { return len(p.Categories) > 0 }},
+	{"brand", func(p *productpb.Product) bool { return p.BrandId != nil && p.BrandId.Value != "" }},
+	{"seo", func(p *productpb.Product) bool { return p.Seo != nil && p.Seo.MetaTitle != "" }},
+	// Only checked for products that ship
+	{"weight", func(p *productpb.Product) bool { return p.Weight != nil && p.Weight.Value > 0 }},
+}
+
+func missingProductFields(product *productpb.Product) []string {
+	var missing []string
+	for _, check := range productChecks {
+		if check.name == "weight" && !product.RequiresShipping {
+			continue
+		}
+		if !check.filled(product) {
+			missing = append(missing, check.name)
+		}
+	}
+	return missing
+}
+
+// lowStock lists the items below their reorder point or safety stock
+func (s *Source) lowStock(ctx context.Context) (*Table, error) {
+	if s.inventory == nil {
+		return nil, ErrInventoryUnavailable
+	}
+
+	resp, err := s.inventory.ListStockAlerts(ctx, &inventorypb.ListStockAlertsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stock alerts: %w", err)
+	}
+
+	table := &Table{
+		Title:   "Low stock",
+		Columns: []string{"sku", "product_id", "warehouse_id", "alert", "quantity", "threshold"},
+	}
+	for _, alert := range resp.Alerts {
+		if alert.Type != "LOW_STOCK" && alert.Type != "BELOW_SAFETY_STOCK" {
+			continue
+		}
+		table.Rows = append(table.Rows, []string{
+			alert.Sku,
+			alert.ProductId,
+			alert.WarehouseId.GetValue(),
+			alert.Type,
+			strconv.Itoa(int(alert.Quantity)),
+			strconv.Itoa(int(alert.Threshold)),
+		})
+	}
+
+	sort.SliceStable(table.Rows, func(i, j int) bool {
+		return table.Rows[i][0] < table.Rows[j][0]
+	})
+	return table, nil
+}
+
+// newUsers lists the users who registered since the given time, newest first
+func (s *Source) newUsers(ctx context.Context, since time.Time) (*Table, error) {
+	type registered struct {
+		row       []string
+		createdAt time.Time
+	}
+	var users []registered
+
+	for page := int32(1); ; page++ {
+		resp, err := s.users.ListUsers(ctx, &userpb.ListUsersRequest{Page: page, Limit: pageSize})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list users: %w", err)
+		}
+		for _, user := range resp.Users {
+			createdAt, err := time.Parse(time.RFC3339, user.CreatedAt)
+			if err != nil || createdAt.Before(since) {
+				continue
+			}
+			users = append(users, registered{
+				createdAt: createdAt,
+				row: []string{
+					user.UserId,
+					user.Email,
+					strings.TrimSpace(user.FirstName + " " + user.LastName),
+					user.Role,
+					user.AccountStatus,
+					createdAt.UTC().Format(time.RFC3339),
+				},
+			})
+		}
+		if len(resp.Users) < pageSize {
+			break
+		}
+	}
+
+	sort.SliceStable(users, func(i, j int) bool {
+		return users[i].createdAt.After(users[j].createdAt)
+	})
+
+	table := &Table{
+		Title:   fmt.Sprintf("New users since %s", since.UTC().Format("2006-01-02")),
+		Columns: []string{"user_id", "email", "name", "role", "account_status", "created_at"},
+		Rows:    make([][]string, len(users)),
+	}
+	for i, user := range users {
+		table.Rows[i] = user.row
+	}
+	return table, nil
+}
@@ -7,6 +7,12 @@ import (
     "google.golang.org/grpc/credentials/insecure"
 
     "github.com/louai60/e-commerce_project/backend/api-gateway/config"
+    "github.com/louai60/e-commerce_project/backend/common/cachectl"
+    "github.com/louai60/e-commerce_project/backend/common/locale"
+    applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+    "github.com/louai60/e-commerce_project/backend/common/scope"
+    "github.com/louai60/e-commerce_project/backend/common/servicetoken"
+    "github.com/louai60/e-commerce_project/backend/common/tenant"
     userpb "github.com/louai60/e-commerce_project/backend/user-service/proto"
 
     // Import service protos
@@ -65,6 +71,7 @@ func (sc *ServiceClients) createConnection(cfg config.ServiceConfig) (*grpc.Clie
     return grpc.Dial(
         fmt.Sprintf("%s:%s", cfg.Host, cfg.Port),
         grpc.WithTransportCredentials(insecure.NewCredentials()),
+        grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), locale.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), scope.UnaryClientInterceptor(), cachectl.UnaryClientInterceptor()),
     )
 }
 
@@ -8,9 +8,15 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/golang/protobuf/ptypes/wrappers"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/config"
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
 )
 
@@ -39,6 +45,8 @@ func NewInventoryClient(cfg *config.Config, logger *zap.Logger) (*InventoryClien
 			ctx,
 			inventoryAddr,
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), locale.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), scope.UnaryClientInterceptor()),
+			grpc.WithChainStreamInterceptor(applogger.StreamClientInterceptor(), tenant.StreamClientInterceptor(), servicetoken.StreamClientInterceptor(), scope.StreamClientInterceptor()),
 			grpc.WithBlock(),
 		)
 		cancel()
@@ -72,6 +80,16 @@ func NewInventoryClient(cfg *config.Config, logger *zap.Logger) (*InventoryClien
 	}, nil
 }
 
+// NewInventoryClientFromConn creates an inventory service client on an
+// existing connection, e.g. to an in-memory server in tests
+func NewInventoryClientFromConn(conn *grpc.ClientConn, logger *zap.Logger) *InventoryClient {
+	return &InventoryClient{
+		client: inventorypb.NewInventoryServiceClient(conn),
+		conn:   conn,
+		logger: logger,
+	}
+}
+
 // Close closes the gRPC connection
 func (c *InventoryClient) Close() error {
 	if c.conn != nil {
@@ -127,11 +145,13 @@ func (c *InventoryClient) GetInventoryItem(ctx context.Context, productID string
 	return resp.InventoryItem, nil
 }
 
-// CheckInventoryAvailability checks if a product is available in the requested quantity
-func (c *InventoryClient) CheckInventoryAvailability(ctx context.Context, productID string, quantity int) (bool, error) {
+// CheckInventoryAvailability checks if a product is available in the
+// requested quantity of the given unit of measure, the base unit when empty
+func (c *InventoryClient) CheckInventoryAvailability(ctx context.Context, productID string, quantity int, unit string) (bool, error) {
 	c.logger.Info("Checking inventory availability",
 		zap.String("product_id", productID),
-		zap.Int("quantity", quantity))
+		zap.Int("quantity", quantity),
+		zap.String("unit", unit))
 
 	// Create the request
 	req := &inventorypb.CheckInventoryAvailabilityRequest{
@@ -139,6 +159,7 @@ func (c *InventoryClient) CheckInventoryAvailability(ctx context.Context, produc
 			{
 				ProductId: productID,
 				Quantity:  int32(quantity),
+				Unit:      unit,
 			},
 		},
 	}
@@ -155,6 +176,20 @@ func (c *InventoryClient) CheckInventoryAvailability(ctx context.Context, produc
 	return resp.AllAvailable, nil
 }
 
+// CheckAvailabilityBulk checks the availability of several cart or checkout lines in a single call
+func (c *InventoryClient) CheckAvailabilityBulk(ctx context.Context, lines []*inventorypb.BulkAvailabilityLine) (*inventorypb.CheckAvailabilityBulkResponse, error) {
+	c.logger.Info("Checking bulk inventory availability", zap.Int("lines_count", len(lines)))
+
+	// Call the inventory service
+	resp, err := c.client.CheckAvailabilityBulk(ctx, &inventorypb.CheckAvailabilityBulkRequest{Lines: lines})
+	if err != nil {
+		c.logger.Error("Failed to check bulk inventory availability", zap.Error(err))
+		return nil, fmt.Errorf("failed to check bulk inventory availability: %w", err)
+	}
+
+	return resp, nil
+}
+
 // ListInventoryItems retrieves a paginated list of inventory items
 func (c *InventoryClient) ListInventoryItems(ctx context.Context, page, limit int, status, warehouseID string, lowStockOnly bool) ([]*inventorypb.InventoryItem, int, error) {
 	c.logger.Info("Listing inventory items",
@@ -258,6 +293,104 @@ func (c *InventoryClient) ListWarehouses(ctx context.Context, page, limit int, i
 	return resp.Warehouses, int(resp.Total), nil
 }
 
+// GetStockHistory retrieves the daily stock levels of a product's inventory between two dates.
+// An empty warehouseID returns the totals across all warehouses; zero dates use the service defaults.
+func (c *InventoryClient) GetStockHistory(ctx context.Context, productID, warehouseID string, from, to time.Time) (*inventorypb.StockHistoryResponse, error) {
+	c.logger.Info("Getting stock history",
+		zap.String("product_id", productID),
+		zap.String("warehouse_id", warehouseID))
+
+	// Create the request
+	req := &inventorypb.GetStockHistoryRequest{
+		Identifier: &inventorypb.GetStockHistoryRequest_ProductId{
+			ProductId: productID,
+		},
+	}
+
+	// Add optional filters
+	if warehouseID != "" {
+		req.WarehouseId = &wrappers.StringValue{Value: warehouseID}
+	}
+	if !from.IsZero() {
+		req.StartDate = timestamppb.New(from)
+	}
+	if !to.IsZero() {
+		req.EndDate = timestamppb.New(to)
+	}
+
+	// Call the inventory service
+	resp, err := c.client.GetStockHistory(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to get stock history",
+			zap.String("product_id", productID),
+			zap.Error(err))
+		return nil, fmt.Errorf("failed to get stock history: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetStockBuffers sets the safety stock and max stock of an inventory item at a warehouse
+func (c *InventoryClient) SetStockBuffers(ctx context.Context, inventoryItemID, warehouseID string, safetyStock, maxStock int) (*inventorypb.InventoryLocation, error) {
+	c.logger.Info("Setting stock buffers",
+		zap.String("inventory_item_id", inventoryItemID),
+		zap.String("warehouse_id", warehouseID))
+
+	resp, err := c.client.SetStockBuffers(ctx, &inventorypb.SetStockBuffersRequest{
+		InventoryItemId: inventoryItemID,
+		WarehouseId:     warehouseID,
+		SafetyStock:     int32(safetyStock),
+		MaxStock:        int32(maxStock),
+	})
+	if err != nil {
+		c.logger.Error("Failed to set stock buffers", zap.Error(err))
+		return nil, fmt.Errorf("failed to set stock buffers: %w", err)
+	}
+
+	return resp.InventoryLocation, nil
+}
+
+// ListStockAlerts retrieves the items and locations currently outside their stock
+// thresholds. An empty warehouseID returns alerts for all warehouses.
+func (c *InventoryClient) ListStockAlerts(ctx context.Context, warehouseID string) ([]*inventorypb.StockAlert, error) {
+	req := &inventorypb.ListStockAlertsRequest{}
+	if warehouseID != "" {
+		req.WarehouseId = &wrappers.StringValue{Value: warehouseID}
+	}
+
+	resp, err := c.client.ListStockAlerts(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list stock alerts", zap.Error(err))
+		return nil, fmt.Errorf("failed to list stock alerts: %w", err)
+	}
+
+	return resp.Alerts, nil
+}
+
+// WatchInventory opens a stream of stock changes for the given products or
+// warehouse. The stream ends when ctx is cancelled.
+func (c *InventoryClient) WatchInventory(ctx context.Context, productIDs []string, warehouseID string, includeCurrent bool) (inventorypb.InventoryService_WatchInventoryClient, error) {
+	c.logger.Info("Watching inventory",
+		zap.Int("product_count", len(productIDs)),
+		zap.String("warehouse_id", warehouseID))
+
+	req := &inventorypb.WatchInventoryRequest{
+		ProductIds:     productIDs,
+		IncludeCurrent: includeCurrent,
+	}
+	if warehouseID != "" {
+		req.WarehouseId = &wrappers.StringValue{Value: warehouseID}
+	}
+
+	stream, err := c.client.WatchInventory(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to watch inventory", zap.Error(err))
+		return nil, fmt.Errorf("failed to watch inventory: %w", err)
+	}
+
+	return stream, nil
+}
+
 // ListInventoryTransactions retrieves a paginated list of inventory transactions
 func (c *InventoryClient) ListInventoryTransactions(ctx context.Context, page, limit int, transactionType, warehouseID, dateFrom, dateTo string) ([]*inventorypb.InventoryTransaction, int, error) {
 	c.logger.Info("Listing inventory transactions",
@@ -282,3 +415,954 @@ func (c *InventoryClient) ListInventoryTransactions(ctx context.Context, page, l
 
 	return transactions, total, nil
 }
+
+// CreateIntegrationKey creates an API key for a fulfillment provider. The
+// returned secret is not stored and cannot be retrieved again.
+func (c *InventoryClient) CreateIntegrationKey(ctx context.Context, provider, name string, dailyQuota int) (*inventorypb.CreateIntegrationKeyResponse, error) {
+	c.logger.Info("Creating integration key", zap.String("provider", provider))
+
+	resp, err := c.client.CreateIntegrationKey(ctx, &inventorypb.CreateIntegrationKeyRequest{
+		Provider:   provider,
+		Name:       name,
+		DailyQuota: int32(dailyQuota),
+	})
+	if err != nil {
+		c.logger.Error("Failed to create integration key", zap.Error(err))
+		return nil, fmt.Errorf("failed to create integration key: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListIntegrationKeys retrieves the API keys of fulfillment providers
+func (c *InventoryClient) ListIntegrationKeys(ctx context.Context) ([]*inventorypb.IntegrationKey, error) {
+	resp, err := c.client.ListIntegrationKeys(ctx, &inventorypb.ListIntegrationKeysRequest{})
+	if err != nil {
+		c.logger.Error("Failed to list integration keys", zap.Error(err))
+		return nil, fmt.Errorf("failed to list integration keys: %w", err)
+	}
+
+	return resp.Keys, nil
+}
+
+// RevokeIntegrationKey revokes the API key of a fulfillment provider
+func (c *InventoryClient) RevokeIntegrationKey(ctx context.Context, id string) (*inventorypb.IntegrationKey, error) {
+	c.logger.Info("Revoking integration key", zap.String("id", id))
+
+	resp, err := c.client.RevokeIntegrationKey(ctx, &inventorypb.RevokeIntegrationKeyRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to revoke integration key", zap.Error(err))
+		return nil, fmt.Errorf("failed to revoke integration key: %w", err)
+	}
+
+	return resp.Key, nil
+}
+
+// SetIntegrationKeyQuota changes the daily quota of an API key; 0 removes it
+func (c *InventoryClient) SetIntegrationKeyQuota(ctx context.Context, id string, dailyQuota int) (*inventorypb.IntegrationKey, error) {
+	c.logger.Info("Setting integration key quota", zap.String("id", id), zap.Int("daily_quota", dailyQuota))
+
+	resp, err := c.client.SetIntegrationKeyQuota(ctx, &inventorypb.SetIntegrationKeyQuotaRequest{
+		Id:         id,
+		DailyQuota: int32(dailyQuota),
+	})
+	if err != nil {
+		c.logger.Error("Failed to set integration key quota", zap.Error(err))
+		return nil, fmt.Errorf("failed to set integration key quota: %w", err)
+	}
+
+	return resp.Key, nil
+}
+
+// GetIntegrationQuota retrieves the usage of the day of the API key apiKey
+func (c *InventoryClient) GetIntegrationQuota(ctx context.Context, apiKey string) (*inventorypb.IntegrationQuota, error) {
+	resp, err := c.client.GetIntegrationQuota(ctx, &inventorypb.GetIntegrationQuotaRequest{ApiKey: apiKey})
+	if err != nil {
+		c.logger.Error("Failed to get integration quota", zap.Error(err))
+		return nil, fmt.Errorf("failed to get integration quota: %w", err)
+	}
+
+	return resp, nil
+}
+
+// PushFulfillmentEvents forwards the events of a fulfillment provider
+// authenticated by apiKey
+func (c *InventoryClient) PushFulfillmentEvents(ctx context.Context, apiKey string, events []*inventorypb.FulfillmentEvent) (*inventorypb.PushFulfillmentEventsResponse, error) {
+	c.logger.Info("Pushing fulfillment events", zap.Int("event_count", len(events)))
+
+	resp, err := c.client.PushFulfillmentEvents(ctx, &inventorypb.PushFulfillmentEventsRequest{
+		ApiKey: apiKey,
+		Events: events,
+	})
+	if err != nil {
+		c.logger.Error("Failed to push fulfillment events", zap.Error(err))
+		return nil, fmt.Errorf("failed to push fulfillment events: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListOrderStatusEvents retrieves the order status changes reported by
+// fulfillment providers after the given event ID
+func (c *InventoryClient) ListOrderStatusEvents(ctx context.Context, afterID int64, limit int) ([]*inventorypb.OrderStatusEvent, error) {
+	resp, err := c.client.ListOrderStatusEvents(ctx, &inventorypb.ListOrderStatusEventsRequest{
+		AfterId: afterID,
+		Limit:   int32(limit),
+	})
+	if err != nil {
+		c.logger.Error("Failed to list order status events", zap.Error(err))
+		return nil, fmt.Errorf("failed to list order status events: %w", err)
+	}
+
+	return resp.Events, nil
+}
+
+// CreateShipment registers a shipment of an order for tracking
+func (c *InventoryClient) CreateShipment(ctx context.Context, req *inventorypb.CreateShipmentRequest) (*inventorypb.Shipment, error) {
+	c.logger.Info("Creating shipment",
+		zap.String("order_reference", req.OrderReference),
+		zap.String("carrier", req.Carrier))
+
+	resp, err := c.client.CreateShipment(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to create shipment", zap.Error(err))
+		return nil, fmt.Errorf("failed to create shipment: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListShipments retrieves a paginated list of shipments without their
+// tracking events
+func (c *InventoryClient) ListShipments(ctx context.Context, req *inventorypb.ListShipmentsRequest) (*inventorypb.ListShipmentsResponse, error) {
+	resp, err := c.client.ListShipments(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list shipments", zap.Error(err))
+		return nil, fmt.Errorf("failed to list shipments: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetShipmentStatus retrieves a shipment, or the shipments of an order, with
+// their tracking events. A non-empty userID restricts them to that customer.
+func (c *InventoryClient) GetShipmentStatus(ctx context.Context, id, orderReference, userID string) (*inventorypb.ShipmentStatusResponse, error) {
+	resp, err := c.client.GetShipmentStatus(ctx, &inventorypb.GetShipmentStatusRequest{
+		Id:             id,
+		OrderReference: orderReference,
+		UserId:         userID,
+	})
+	if err != nil {
+		c.logger.Error("Failed to get shipment status", zap.Error(err))
+		return nil, fmt.Errorf("failed to get shipment status: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SubscribeBackInStock subscribes an email to an out-of-stock product
+func (c *InventoryClient) SubscribeBackInStock(ctx context.Context, req *inventorypb.SubscribeBackInStockRequest) (*inventorypb.BackInStockSubscription, error) {
+	resp, err := c.client.SubscribeBackInStock(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to subscribe back in stock", zap.Error(err), zap.String("product_id", req.ProductId))
+		return nil, fmt.Errorf("failed to subscribe back in stock: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListBackInStockSubscriptions retrieves a paginated list of back-in-stock
+// subscriptions
+func (c *InventoryClient) ListBackInStockSubscriptions(ctx context.Context, req *inventorypb.ListBackInStockSubscriptionsRequest) (*inventorypb.ListBackInStockSubscriptionsResponse, error) {
+	resp, err := c.client.ListBackInStockSubscriptions(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list back-in-stock subscriptions", zap.Error(err))
+		return nil, fmt.Errorf("failed to list back-in-stock subscriptions: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DeleteBackInStockSubscription removes a back-in-stock subscription. A
+// non-empty userID restricts it to the subscriptions of that customer.
+func (c *InventoryClient) DeleteBackInStockSubscription(ctx context.Context, id, userID string) error {
+	_, err := c.client.DeleteBackInStockSubscription(ctx, &inventorypb.DeleteBackInStockSubscriptionRequest{
+		Id:     id,
+		UserId: userID,
+	})
+	if err != nil {
+		c.logger.Error("Failed to delete back-in-stock subscription", zap.Error(err), zap.String("id", id))
+		return fmt.Errorf("failed to delete back-in-stock subscription: %w", err)
+	}
+
+	return nil
+}
+
+// ReceiveCarrierEvents forwards the tracking events of a carrier
+// authenticated by apiKey
+func (c *InventoryClient) ReceiveCarrierEvents(ctx context.Context, apiKey, carrier string, events []*inventorypb.CarrierEvent) (*inventorypb.ReceiveCarrierEventsResponse, error) {
+	c.logger.Info("Receiving carrier events",
+		zap.String("carrier", carrier),
+		zap.Int("event_count", len(events)))
+
+	resp, err := c.client.ReceiveCarrierEvents(ctx, &inventorypb.ReceiveCarrierEventsRequest{
+		ApiKey:  apiKey,
+		Carrier: carrier,
+		Events:  events,
+	})
+	if err != nil {
+		c.logger.Error("Failed to receive carrier events", zap.Error(err))
+		return nil, fmt.Errorf("failed to receive carrier events: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CreateSupplier creates a supplier
+func (c *InventoryClient) CreateSupplier(ctx context.Context, req *inventorypb.CreateSupplierRequest) (*inventorypb.Supplier, error) {
+	c.logger.Info("Creating supplier", zap.String("name", req.Name))
+
+	resp, err := c.client.CreateSupplier(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to create supplier", zap.Error(err))
+		return nil, fmt.Errorf("failed to create supplier: %w", err)
+	}
+
+	return resp, nil
+}
+
+// UpdateSupplier updates a supplier
+func (c *InventoryClient) UpdateSupplier(ctx context.Context, req *inventorypb.UpdateSupplierRequest) (*inventorypb.Supplier, error) {
+	resp, err := c.client.UpdateSupplier(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to update supplier", zap.Error(err), zap.String("id", req.Id))
+		return nil, fmt.Errorf("failed to update supplier: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetSupplier retrieves a supplier with the inventory items it sells
+func (c *InventoryClient) GetSupplier(ctx context.Context, id string) (*inventorypb.Supplier, error) {
+	resp, err := c.client.GetSupplier(ctx, &inventorypb.GetSupplierRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to get supplier", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to get supplier: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListSuppliers retrieves a paginated list of suppliers
+func (c *InventoryClient) ListSuppliers(ctx context.Context, req *inventorypb.ListSuppliersRequest) (*inventorypb.ListSuppliersResponse, error) {
+	resp, err := c.client.ListSuppliers(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list suppliers", zap.Error(err))
+		return nil, fmt.Errorf("failed to list suppliers: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetSupplierProduct links an inventory item to a supplier with its cost
+// price and lead time
+func (c *InventoryClient) SetSupplierProduct(ctx context.Context, req *inventorypb.SetSupplierProductRequest) (*inventorypb.SupplierProduct, error) {
+	resp, err := c.client.SetSupplierProduct(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to set supplier product", zap.Error(err),
+			zap.String("supplier_id", req.SupplierId),
+			zap.String("inventory_item_id", req.InventoryItemId))
+		return nil, fmt.Errorf("failed to set supplier product: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RemoveSupplierProduct unlinks an inventory item from a supplier
+func (c *InventoryClient) RemoveSupplierProduct(ctx context.Context, supplierID, inventoryItemID string) error {
+	_, err := c.client.RemoveSupplierProduct(ctx, &inventorypb.RemoveSupplierProductRequest{
+		SupplierId:      supplierID,
+		InventoryItemId: inventoryItemID,
+	})
+	if err != nil {
+		c.logger.Error("Failed to remove supplier product", zap.Error(err),
+			zap.String("supplier_id", supplierID),
+			zap.String("inventory_item_id", inventoryItemID))
+		return fmt.Errorf("failed to remove supplier product: %w", err)
+	}
+
+	return nil
+}
+
+// CreatePurchaseOrder creates a purchase order of stock from a supplier
+func (c *InventoryClient) CreatePurchaseOrder(ctx context.Context, req *inventorypb.CreatePurchaseOrderRequest) (*inventorypb.PurchaseOrder, error) {
+	c.logger.Info("Creating purchase order",
+		zap.String("supplier_id", req.SupplierId),
+		zap.Int("lines", len(req.Lines)))
+
+	resp, err := c.client.CreatePurchaseOrder(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to create purchase order", zap.Error(err))
+		return nil, fmt.Errorf("failed to create purchase order: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetPurchaseOrder retrieves a purchase order with its lines
+func (c *InventoryClient) GetPurchaseOrder(ctx context.Context, id string) (*inventorypb.PurchaseOrder, error) {
+	resp, err := c.client.GetPurchaseOrder(ctx, &inventorypb.GetPurchaseOrderRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to get purchase order", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to get purchase order: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListPurchaseOrders retrieves a paginated list of purchase orders without
+// their lines
+func (c *InventoryClient) ListPurchaseOrders(ctx context.Context, req *inventorypb.ListPurchaseOrdersRequest) (*inventorypb.ListPurchaseOrdersResponse, error) {
+	resp, err := c.client.ListPurchaseOrders(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list purchase orders", zap.Error(err))
+		return nil, fmt.Errorf("failed to list purchase orders: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ReceivePurchaseOrder records the receipt of stock for a purchase order.
+// Without lines, everything outstanding is received.
+func (c *InventoryClient) ReceivePurchaseOrder(ctx context.Context, req *inventorypb.ReceivePurchaseOrderRequest) (*inventorypb.PurchaseOrder, error) {
+	c.logger.Info("Receiving purchase order",
+		zap.String("id", req.Id),
+		zap.Int("lines", len(req.Lines)))
+
+	resp, err := c.client.ReceivePurchaseOrder(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to receive purchase order", zap.Error(err))
+		return nil, fmt.Errorf("failed to receive purchase order: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CancelPurchaseOrder cancels an open purchase order
+func (c *InventoryClient) CancelPurchaseOrder(ctx context.Context, id string) (*inventorypb.PurchaseOrder, error) {
+	resp, err := c.client.CancelPurchaseOrder(ctx, &inventorypb.CancelPurchaseOrderRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to cancel purchase order", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to cancel purchase order: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetAvailabilityPolicy returns the availability thresholds of a product
+func (c *InventoryClient) GetAvailabilityPolicy(ctx context.Context, productID string) (*inventorypb.AvailabilityPolicy, error) {
+	resp, err := c.client.GetAvailabilityPolicy(ctx, &inventorypb.GetAvailabilityPolicyRequest{ProductId: productID})
+	if err != nil {
+		c.logger.Error("Failed to get availability policy", zap.Error(err), zap.String("product_id", productID))
+		return nil, fmt.Errorf("failed to get availability policy: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetAvailabilityPolicy creates or replaces the availability thresholds of a
+// product
+func (c *InventoryClient) SetAvailabilityPolicy(ctx context.Context, policy *inventorypb.AvailabilityPolicy) (*inventorypb.AvailabilityPolicy, error) {
+	resp, err := c.client.SetAvailabilityPolicy(ctx, &inventorypb.SetAvailabilityPolicyRequest{Policy: policy})
+	if err != nil {
+		c.logger.Error("Failed to set availability policy", zap.Error(err), zap.String("product_id", policy.ProductId))
+		return nil, fmt.Errorf("failed to set availability policy: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DeleteAvailabilityPolicy puts a product back on the default availability
+// thresholds
+func (c *InventoryClient) DeleteAvailabilityPolicy(ctx context.Context, productID string) error {
+	_, err := c.client.DeleteAvailabilityPolicy(ctx, &inventorypb.DeleteAvailabilityPolicyRequest{ProductId: productID})
+	if err != nil {
+		c.logger.Error("Failed to delete availability policy", zap.Error(err), zap.String("product_id", productID))
+		return fmt.Errorf("failed to delete availability policy: %w", err)
+	}
+
+	return nil
+}
+
+// ReceiveLot receives units of an inventory item into a lot at a warehouse
+func (c *InventoryClient) ReceiveLot(ctx context.Context, req *inventorypb.ReceiveLotRequest) (*inventorypb.InventoryLot, error) {
+	c.logger.Info("Receiving lot",
+		zap.String("inventory_item_id", req.InventoryItemId),
+		zap.String("lot_number", req.LotNumber),
+		zap.Int32("quantity", req.Quantity))
+
+	resp, err := c.client.ReceiveLot(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to receive lot", zap.Error(err))
+		return nil, fmt.Errorf("failed to receive lot: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListLots lists the lots of an inventory item first expired first out
+func (c *InventoryClient) ListLots(ctx context.Context, req *inventorypb.ListLotsRequest) ([]*inventorypb.InventoryLot, error) {
+	resp, err := c.client.ListLots(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list lots", zap.Error(err), zap.String("inventory_item_id", req.InventoryItemId))
+		return nil, fmt.Errorf("failed to list lots: %w", err)
+	}
+
+	return resp.Lots, nil
+}
+
+// ListExpiringLots lists the lots expiring soon and the expired lots not
+// written off yet
+func (c *InventoryClient) ListExpiringLots(ctx context.Context, req *inventorypb.ListExpiringLotsRequest) (*inventorypb.ListExpiringLotsResponse, error) {
+	resp, err := c.client.ListExpiringLots(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list expiring lots", zap.Error(err))
+		return nil, fmt.Errorf("failed to list expiring lots: %w", err)
+	}
+
+	return resp, nil
+}
+
+// SetInventoryUnit creates or replaces a unit of measure of an inventory item
+func (c *InventoryClient) SetInventoryUnit(ctx context.Context, req *inventorypb.SetInventoryUnitRequest) (*inventorypb.InventoryUnit, error) {
+	c.logger.Info("Setting unit of measure",
+		zap.String("inventory_item_id", req.InventoryItemId),
+		zap.String("code", req.Code),
+		zap.Int32("factor", req.Factor))
+
+	resp, err := c.client.SetInventoryUnit(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to set unit of measure", zap.Error(err))
+		return nil, fmt.Errorf("failed to set unit of measure: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListInventoryUnits lists the units of measure of an inventory item,
+// starting with the base unit
+func (c *InventoryClient) ListInventoryUnits(ctx context.Context, inventoryItemID string) ([]*inventorypb.InventoryUnit, error) {
+	resp, err := c.client.ListInventoryUnits(ctx, &inventorypb.ListInventoryUnitsRequest{InventoryItemId: inventoryItemID})
+	if err != nil {
+		c.logger.Error("Failed to list units of measure", zap.Error(err), zap.String("inventory_item_id", inventoryItemID))
+		return nil, fmt.Errorf("failed to list units of measure: %w", err)
+	}
+
+	return resp.Units, nil
+}
+
+// DeleteInventoryUnit removes a unit of measure of an inventory item
+func (c *InventoryClient) DeleteInventoryUnit(ctx context.Context, inventoryItemID, code string) error {
+	c.logger.Info("Deleting unit of measure",
+		zap.String("inventory_item_id", inventoryItemID),
+		zap.String("code", code))
+
+	_, err := c.client.DeleteInventoryUnit(ctx, &inventorypb.DeleteInventoryUnitRequest{InventoryItemId: inventoryItemID, Code: code})
+	if err != nil {
+		c.logger.Error("Failed to delete unit of measure", zap.Error(err))
+		return fmt.Errorf("failed to delete unit of measure: %w", err)
+	}
+
+	return nil
+}
+
+// SetWarehouseBin creates the bin of a warehouse at an address or sets
+// whether it is active
+func (c *InventoryClient) SetWarehouseBin(ctx context.Context, req *inventorypb.SetWarehouseBinRequest) (*inventorypb.WarehouseBin, error) {
+	c.logger.Info("Setting warehouse bin",
+		zap.String("warehouse_id", req.WarehouseId),
+		zap.String("zone", req.Zone),
+		zap.String("aisle", req.Aisle),
+		zap.String("shelf", req.Shelf),
+		zap.String("bin", req.Bin))
+
+	resp, err := c.client.SetWarehouseBin(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to set warehouse bin", zap.Error(err))
+		return nil, fmt.Errorf("failed to set warehouse bin: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListWarehouseBins lists the bins of a warehouse, of one zone when given
+func (c *InventoryClient) ListWarehouseBins(ctx context.Context, warehouseID, zone string) ([]*inventorypb.WarehouseBin, error) {
+	resp, err := c.client.ListWarehouseBins(ctx, &inventorypb.ListWarehouseBinsRequest{WarehouseId: warehouseID, Zone: zone})
+	if err != nil {
+		c.logger.Error("Failed to list warehouse bins", zap.Error(err), zap.String("warehouse_id", warehouseID))
+		return nil, fmt.Errorf("failed to list warehouse bins: %w", err)
+	}
+
+	return resp.Bins, nil
+}
+
+// DeleteWarehouseBin removes a bin that holds no stock
+func (c *InventoryClient) DeleteWarehouseBin(ctx context.Context, id string) error {
+	c.logger.Info("Deleting warehouse bin", zap.String("bin_id", id))
+
+	_, err := c.client.DeleteWarehouseBin(ctx, &inventorypb.DeleteWarehouseBinRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to delete warehouse bin", zap.Error(err))
+		return fmt.Errorf("failed to delete warehouse bin: %w", err)
+	}
+
+	return nil
+}
+
+// SetBinStock sets the units of an inventory item placed in a bin
+func (c *InventoryClient) SetBinStock(ctx context.Context, req *inventorypb.SetBinStockRequest) (*inventorypb.BinStock, error) {
+	c.logger.Info("Setting bin stock",
+		zap.String("bin_id", req.BinId),
+		zap.String("inventory_item_id", req.InventoryItemId),
+		zap.Int32("quantity", req.Quantity))
+
+	resp, err := c.client.SetBinStock(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to set bin stock", zap.Error(err))
+		return nil, fmt.Errorf("failed to set bin stock: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListBinStock lists the stock placed in the bins of a warehouse, of one
+// inventory item when given
+func (c *InventoryClient) ListBinStock(ctx context.Context, warehouseID, inventoryItemID string) ([]*inventorypb.BinStock, error) {
+	resp, err := c.client.ListBinStock(ctx, &inventorypb.ListBinStockRequest{WarehouseId: warehouseID, InventoryItemId: inventoryItemID})
+	if err != nil {
+		c.logger.Error("Failed to list bin stock", zap.Error(err), zap.String("warehouse_id", warehouseID))
+		return nil, fmt.Errorf("failed to list bin stock: %w", err)
+	}
+
+	return resp.Stock, nil
+}
+
+// GeneratePickList plans the picks of lines at a warehouse in path order
+func (c *InventoryClient) GeneratePickList(ctx context.Context, req *inventorypb.GeneratePickListRequest) (*inventorypb.PickList, error) {
+	resp, err := c.client.GeneratePickList(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to generate pick list", zap.Error(err), zap.String("warehouse_id", req.WarehouseId))
+		return nil, fmt.Errorf("failed to generate pick list: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CreateFulfillmentWave batches the pending orders of a warehouse into a wave
+func (c *InventoryClient) CreateFulfillmentWave(ctx context.Context, req *inventorypb.CreateFulfillmentWaveRequest) (*inventorypb.FulfillmentWave, error) {
+	c.logger.Info("Creating fulfillment wave",
+		zap.String("warehouse_id", req.WarehouseId),
+		zap.String("carrier", req.Carrier))
+
+	resp, err := c.client.CreateFulfillmentWave(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to create fulfillment wave", zap.Error(err))
+		return nil, fmt.Errorf("failed to create fulfillment wave: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetFulfillmentWave retrieves a wave with its lines
+func (c *InventoryClient) GetFulfillmentWave(ctx context.Context, id string) (*inventorypb.FulfillmentWave, error) {
+	resp, err := c.client.GetFulfillmentWave(ctx, &inventorypb.GetFulfillmentWaveRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to get fulfillment wave", zap.Error(err), zap.String("wave_id", id))
+		return nil, fmt.Errorf("failed to get fulfillment wave: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListFulfillmentWaves lists the waves newest first
+func (c *InventoryClient) ListFulfillmentWaves(ctx context.Context, req *inventorypb.ListFulfillmentWavesRequest) (*inventorypb.ListFulfillmentWavesResponse, error) {
+	resp, err := c.client.ListFulfillmentWaves(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list fulfillment waves", zap.Error(err))
+		return nil, fmt.Errorf("failed to list fulfillment waves: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GenerateWavePickList plans the consolidated picks of an open wave
+func (c *InventoryClient) GenerateWavePickList(ctx context.Context, id string) (*inventorypb.PickList, error) {
+	resp, err := c.client.GenerateWavePickList(ctx, &inventorypb.GenerateWavePickListRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to generate wave pick list", zap.Error(err), zap.String("wave_id", id))
+		return nil, fmt.Errorf("failed to generate wave pick list: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CompleteFulfillmentWave records the quantities picked of a wave and
+// completes it
+func (c *InventoryClient) CompleteFulfillmentWave(ctx context.Context, req *inventorypb.CompleteFulfillmentWaveRequest) (*inventorypb.FulfillmentWave, error) {
+	c.logger.Info("Completing fulfillment wave", zap.String("wave_id", req.Id))
+
+	resp, err := c.client.CompleteFulfillmentWave(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to complete fulfillment wave", zap.Error(err))
+		return nil, fmt.Errorf("failed to complete fulfillment wave: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CancelFulfillmentWave cancels an open wave
+func (c *InventoryClient) CancelFulfillmentWave(ctx context.Context, id string) (*inventorypb.FulfillmentWave, error) {
+	c.logger.Info("Cancelling fulfillment wave", zap.String("wave_id", id))
+
+	resp, err := c.client.CancelFulfillmentWave(ctx, &inventorypb.CancelFulfillmentWaveRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to cancel fulfillment wave", zap.Error(err))
+		return nil, fmt.Errorf("failed to cancel fulfillment wave: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RequestRefund records a requested refund of the payment of an order
+func (c *InventoryClient) RequestRefund(ctx context.Context, req *inventorypb.RequestRefundRequest) (*inventorypb.Refund, error) {
+	c.logger.Info("Requesting refund",
+		zap.String("order_reference", req.OrderReference),
+		zap.Float64("amount", req.Amount))
+
+	resp, err := c.client.RequestRefund(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to request refund", zap.Error(err))
+		return nil, fmt.Errorf("failed to request refund: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetRefund retrieves a refund with its lines
+func (c *InventoryClient) GetRefund(ctx context.Context, id string) (*inventorypb.Refund, error) {
+	resp, err := c.client.GetRefund(ctx, &inventorypb.GetRefundRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to get refund", zap.Error(err), zap.String("refund_id", id))
+		return nil, fmt.Errorf("failed to get refund: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListRefunds lists the refunds newest first
+func (c *InventoryClient) ListRefunds(ctx context.Context, req *inventorypb.ListRefundsRequest) (*inventorypb.ListRefundsResponse, error) {
+	resp, err := c.client.ListRefunds(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list refunds", zap.Error(err))
+		return nil, fmt.Errorf("failed to list refunds: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ApproveRefund approves a requested refund and restocks its returned lines
+func (c *InventoryClient) ApproveRefund(ctx context.Context, id, reviewedBy string) (*inventorypb.Refund, error) {
+	c.logger.Info("Approving refund", zap.String("refund_id", id), zap.String("reviewed_by", reviewedBy))
+
+	resp, err := c.client.ApproveRefund(ctx, &inventorypb.ApproveRefundRequest{Id: id, ReviewedBy: reviewedBy})
+	if err != nil {
+		c.logger.Error("Failed to approve refund", zap.Error(err))
+		return nil, fmt.Errorf("failed to approve refund: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RejectRefund rejects a requested refund
+func (c *InventoryClient) RejectRefund(ctx context.Context, req *inventorypb.RejectRefundRequest) (*inventorypb.Refund, error) {
+	c.logger.Info("Rejecting refund", zap.String("refund_id", req.Id), zap.String("reviewed_by", req.ReviewedBy))
+
+	resp, err := c.client.RejectRefund(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to reject refund", zap.Error(err))
+		return nil, fmt.Errorf("failed to reject refund: %w", err)
+	}
+
+	return resp, nil
+}
+
+// RecordRefundResult records the outcome of an approved refund reported by
+// the payment system
+func (c *InventoryClient) RecordRefundResult(ctx context.Context, req *inventorypb.RecordRefundResultRequest) (*inventorypb.Refund, error) {
+	c.logger.Info("Recording refund result", zap.String("refund_id", req.Id), zap.Bool("succeeded", req.Succeeded))
+
+	resp, err := c.client.RecordRefundResult(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to record refund result", zap.Error(err))
+		return nil, fmt.Errorf("failed to record refund result: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListRefundEvents retrieves the refund status changes after the given event
+// ID
+func (c *InventoryClient) ListRefundEvents(ctx context.Context, afterID int64, limit int) ([]*inventorypb.RefundEvent, error) {
+	resp, err := c.client.ListRefundEvents(ctx, &inventorypb.ListRefundEventsRequest{
+		AfterId: afterID,
+		Limit:   int32(limit),
+	})
+	if err != nil {
+		c.logger.Error("Failed to list refund events", zap.Error(err))
+		return nil, fmt.Errorf("failed to list refund events: %w", err)
+	}
+
+	return resp.Events, nil
+}
+
+// GetFraudCheck retrieves the fraud check of an order
+func (c *InventoryClient) GetFraudCheck(ctx context.Context, id string) (*inventorypb.FraudCheck, error) {
+	resp, err := c.client.GetFraudCheck(ctx, &inventorypb.GetFraudCheckRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to get fraud check", zap.Error(err), zap.String("fraud_check_id", id))
+		return nil, fmt.Errorf("failed to get fraud check: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListFraudChecks lists the fraud checks, or the review queue
+func (c *InventoryClient) ListFraudChecks(ctx context.Context, req *inventorypb.ListFraudChecksRequest) (*inventorypb.ListFraudChecksResponse, error) {
+	resp, err := c.client.ListFraudChecks(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list fraud checks", zap.Error(err))
+		return nil, fmt.Errorf("failed to list fraud checks: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ReviewFraudCheck approves or declines an order in the review queue
+func (c *InventoryClient) ReviewFraudCheck(ctx context.Context, req *inventorypb.ReviewFraudCheckRequest) (*inventorypb.FraudCheck, error) {
+	c.logger.Info("Reviewing fraud check",
+		zap.String("fraud_check_id", req.Id),
+		zap.Bool("approve", req.Approve),
+		zap.String("reviewed_by", req.ReviewedBy))
+
+	resp, err := c.client.ReviewFraudCheck(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to review fraud check", zap.Error(err))
+		return nil, fmt.Errorf("failed to review fraud check: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListFraudEvents retrieves the fraud decisions after the given event ID
+func (c *InventoryClient) ListFraudEvents(ctx context.Context, afterID int64, limit int) ([]*inventorypb.FraudEvent, error) {
+	resp, err := c.client.ListFraudEvents(ctx, &inventorypb.ListFraudEventsRequest{
+		AfterId: afterID,
+		Limit:   int32(limit),
+	})
+	if err != nil {
+		c.logger.Error("Failed to list fraud events", zap.Error(err))
+		return nil, fmt.Errorf("failed to list fraud events: %w", err)
+	}
+
+	return resp.Events, nil
+}
+
+// SetRetailStore makes a warehouse a retail store, or replaces its position,
+// opening hours and pickup settings
+func (c *InventoryClient) SetRetailStore(ctx context.Context, req *inventorypb.SetRetailStoreRequest) (*inventorypb.RetailStore, error) {
+	c.logger.Info("Setting retail store",
+		zap.String("warehouse_id", req.WarehouseId),
+		zap.Float64("latitude", req.Latitude),
+		zap.Float64("longitude", req.Longitude),
+		zap.Bool("pickup_enabled", req.PickupEnabled))
+
+	resp, err := c.client.SetRetailStore(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to set retail store", zap.Error(err))
+		return nil, fmt.Errorf("failed to set retail store: %w", err)
+	}
+
+	return resp, nil
+}
+
+// DeleteRetailStore stops a warehouse being a retail store
+func (c *InventoryClient) DeleteRetailStore(ctx context.Context, warehouseID string) error {
+	c.logger.Info("Deleting retail store", zap.String("warehouse_id", warehouseID))
+
+	_, err := c.client.DeleteRetailStore(ctx, &inventorypb.DeleteRetailStoreRequest{WarehouseId: warehouseID})
+	if err != nil {
+		c.logger.Error("Failed to delete retail store", zap.Error(err))
+		return fmt.Errorf("failed to delete retail store: %w", err)
+	}
+
+	return nil
+}
+
+// ListRetailStores lists the retail stores by name
+func (c *InventoryClient) ListRetailStores(ctx context.Context) ([]*inventorypb.RetailStore, error) {
+	resp, err := c.client.ListRetailStores(ctx, &inventorypb.ListRetailStoresRequest{})
+	if err != nil {
+		c.logger.Error("Failed to list retail stores", zap.Error(err))
+		return nil, fmt.Errorf("failed to list retail stores: %w", err)
+	}
+
+	return resp.Stores, nil
+}
+
+// FindNearbyStores finds the stores near a position, nearest first, with the
+// stock of a product at each when given
+func (c *InventoryClient) FindNearbyStores(ctx context.Context, req *inventorypb.FindNearbyStoresRequest) ([]*inventorypb.NearbyStore, error) {
+	resp, err := c.client.FindNearbyStores(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to find nearby stores", zap.Error(err))
+		return nil, fmt.Errorf("failed to find nearby stores: %w", err)
+	}
+
+	return resp.Stores, nil
+}
+
+// ReserveInStore reserves stock of a product at a store for in-store pickup
+func (c *InventoryClient) ReserveInStore(ctx context.Context, req *inventorypb.ReserveInStoreRequest) (*inventorypb.ReserveInStoreResponse, error) {
+	c.logger.Info("Reserving in store",
+		zap.String("warehouse_id", req.WarehouseId),
+		zap.String("product_id", req.ProductId),
+		zap.Int32("quantity", req.Quantity))
+
+	resp, err := c.client.ReserveInStore(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to reserve in store", zap.Error(err))
+		return nil, fmt.Errorf("failed to reserve in store: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CreatePickupOrder places a click-and-collect order, reserving its items at
+// the chosen store
+func (c *InventoryClient) CreatePickupOrder(ctx context.Context, req *inventorypb.CreatePickupOrderRequest) (*inventorypb.PickupOrder, error) {
+	c.logger.Info("Creating pickup order",
+		zap.String("order_reference", req.OrderReference),
+		zap.String("warehouse_id", req.WarehouseId))
+
+	resp, err := c.client.CreatePickupOrder(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to create pickup order", zap.Error(err))
+		return nil, fmt.Errorf("failed to create pickup order: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetPickupOrder retrieves a pickup order; given a user ID, orders of other
+// customers are not found
+func (c *InventoryClient) GetPickupOrder(ctx context.Context, id, userID string) (*inventorypb.PickupOrder, error) {
+	resp, err := c.client.GetPickupOrder(ctx, &inventorypb.GetPickupOrderRequest{Id: id, UserId: userID})
+	if err != nil {
+		c.logger.Error("Failed to get pickup order", zap.Error(err))
+		return nil, fmt.Errorf("failed to get pickup order: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListPickupOrders lists pickup orders oldest first
+func (c *InventoryClient) ListPickupOrders(ctx context.Context, req *inventorypb.ListPickupOrdersRequest) (*inventorypb.ListPickupOrdersResponse, error) {
+	resp, err := c.client.ListPickupOrders(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list pickup orders", zap.Error(err))
+		return nil, fmt.Errorf("failed to list pickup orders: %w", err)
+	}
+
+	return resp, nil
+}
+
+// StartPickupPicking records that the store is picking a pickup order
+func (c *InventoryClient) StartPickupPicking(ctx context.Context, id string) (*inventorypb.PickupOrder, error) {
+	c.logger.Info("Picking pickup order", zap.String("pickup_order_id", id))
+
+	resp, err := c.client.StartPickupPicking(ctx, &inventorypb.PickupOrderRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to start picking pickup order", zap.Error(err))
+		return nil, fmt.Errorf("failed to start picking pickup order: %w", err)
+	}
+
+	return resp, nil
+}
+
+// MarkPickupReady records that a pickup order waits at its store, emailing
+// the customer
+func (c *InventoryClient) MarkPickupReady(ctx context.Context, id string) (*inventorypb.PickupOrder, error) {
+	c.logger.Info("Marking pickup order ready", zap.String("pickup_order_id", id))
+
+	resp, err := c.client.MarkPickupReady(ctx, &inventorypb.PickupOrderRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to mark pickup order ready", zap.Error(err))
+		return nil, fmt.Errorf("failed to mark pickup order ready: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CollectPickupOrder records that the customer collected a pickup order
+func (c *InventoryClient) CollectPickupOrder(ctx context.Context, id string) (*inventorypb.PickupOrder, error) {
+	c.logger.Info("Collecting pickup order", zap.String("pickup_order_id", id))
+
+	resp, err := c.client.CollectPickupOrder(ctx, &inventorypb.PickupOrderRequest{Id: id})
+	if err != nil {
+		c.logger.Error("Failed to collect pickup order", zap.Error(err))
+		return nil, fmt.Errorf("failed to collect pickup order: %w", err)
+	}
+
+	return resp, nil
+}
+
+// CancelPickupOrder cancels a pickup order not yet collected, restocking it;
+// given a user ID, orders of other customers are not found
+func (c *InventoryClient) CancelPickupOrder(ctx context.Context, req *inventorypb.CancelPickupOrderRequest) (*inventorypb.PickupOrder, error) {
+	c.logger.Info("Cancelling pickup order", zap.String("pickup_order_id", req.Id), zap.String("reason", req.Reason))
+
+	resp, err := c.client.CancelPickupOrder(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to cancel pickup order", zap.Error(err))
+		return nil, fmt.Errorf("failed to cancel pickup order: %w", err)
+	}
+
+	return resp, nil
+}
+
+// GetForecast retrieves the forecast of a product at each of its warehouses,
+// or at a single warehouse
+func (c *InventoryClient) GetForecast(ctx context.Context, productID, warehouseID string) (*inventorypb.GetForecastResponse, error) {
+	resp, err := c.client.GetForecast(ctx, &inventorypb.GetForecastRequest{
+		Identifier:  &inventorypb.GetForecastRequest_ProductId{ProductId: productID},
+		WarehouseId: warehouseID,
+	})
+	if err != nil {
+		c.logger.Error("Failed to get forecast", zap.String("product_id", productID), zap.Error(err))
+		return nil, fmt.Errorf("failed to get forecast: %w", err)
+	}
+
+	return resp, nil
+}
+
+// ListReorderSuggestions lists the items to reorder, those running out first
+// first
+func (c *InventoryClient) ListReorderSuggestions(ctx context.Context, req *inventorypb.ListReorderSuggestionsRequest) (*inventorypb.ListReorderSuggestionsResponse, error) {
+	resp, err := c.client.ListReorderSuggestions(ctx, req)
+	if err != nil {
+		c.logger.Error("Failed to list reorder suggestions", zap.Error(err))
+		return nil, fmt.Errorf("failed to list reorder suggestions: %w", err)
+	}
+
+	return resp, nil
+}
@@ -10,6 +10,12 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/louai60/e-commerce_project/backend/api-gateway/config"
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
 )
 
@@ -51,6 +57,7 @@ func NewProductClient(cfg *config.Config, logger *zap.Logger) (*ProductClient, e
 			ctx,
 			productAddr,
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), locale.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), scope.UnaryClientInterceptor(), cachectl.UnaryClientInterceptor()),
 			grpc.WithBlock(),
 		)
 		cancel()
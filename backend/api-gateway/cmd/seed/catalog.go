@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strings"
+)
+
+// department is a top-level category with the subcategories, product nouns
+// and variant axes generated for it
+type department struct {
+	name          string
+	subcategories []string
+	nouns         []string
+	adjectives    []string
+	priceRange    [2]float64
+	axes          []axis
+	specs         []spec
+}
+
+// axis is a variant attribute such as Size or Color
+type axis struct {
+	name   string
+	values []string
+}
+
+type spec struct {
+	name   string
+	values []string
+	unit   string
+}
+
+var colors = axis{"Color", []string{"Black", "White", "Navy", "Red", "Olive", "Grey", "Sand"}}
+
+var departments = []department{
+	{
+		name:          "Apparel",
+		subcategories: []string{"T-Shirts", "Hoodies", "Jackets", "Trousers"},
+		nouns:         []string{"T-Shirt", "Hoodie", "Jacket", "Chinos", "Sweater", "Polo"},
+		adjectives:    []string{"Classic", "Relaxed", "Slim", "Organic", "Heavyweight", "Vintage"},
+		priceRange:    [2]float64{14, 180},
+		axes:          []axis{{"Size", []string{"XS", "S", "M", "L", "XL"}}, colors},
+		specs:         []spec{{"Material", []string{"Cotton", "Wool", "Linen", "Recycled polyester"}, ""}},
+	},
+	{
+		name:          "Electronics",
+		subcategories: []string{"Headphones", "Phones", "Laptops", "Accessories"},
+		nouns:         []string{"Headphones", "Smartphone", "Laptop", "Charger", "Speaker", "Smartwatch"},
+		adjectives:    []string{"Pro", "Wireless", "Compact", "Ultra", "Noise-Cancelling", "Portable"},
+		priceRange:    [2]float64{19, 2400},
+		axes:          []axis{{"Storage", []string{"64GB", "128GB", "256GB", "512GB"}}, colors},
+		specs:         []spec{{"Battery life", []string{"10", "20", "30", "40"}, "h"}, {"Warranty", []string{"12", "24"}, "months"}},
+	},
+	{
+		name:          "Home & Kitchen",
+		subcategories: []string{"Cookware", "Tableware", "Furniture", "Decor"},
+		nouns:         []string{"Skillet", "Dinner Set", "Side Table", "Lamp", "Mug", "Cushion"},
+		adjectives:    []string{"Cast Iron", "Stoneware", "Oak", "Minimal", "Handmade", "Nordic"},
+		priceRange:    [2]float64{9, 650},
+		axes:          []axis{colors, {"Material", []string{"Steel", "Ceramic", "Oak", "Walnut"}}},
+		specs:         []spec{{"Weight", []string{"0.4", "1.2", "2.5", "8"}, "kg"}},
+	},
+	{
+		name:          "Sports & Outdoors",
+		subcategories: []string{"Running", "Camping", "Cycling", "Fitness"},
+		nouns:         []string{"Running Shoes", "Tent", "Backpack", "Bike Helmet", "Yoga Mat", "Water Bottle"},
+		adjectives:    []string{"Trail", "Lightweight", "Waterproof", "Insulated", "All-Season", "Performance"},
+		priceRange:    [2]float64{12, 900},
+		axes:          []axis{{"Size", []string{"38", "40", "42", "44", "46"}}, colors},
+		specs:         []spec{{"Weight", []string{"0.3", "0.9", "1.8", "3.2"}, "kg"}},
+	},
+	{
+		name:          "Beauty",
+		subcategories: []string{"Skincare", "Fragrance", "Hair Care"},
+		nouns:         []string{"Face Serum", "Moisturiser", "Eau de Parfum", "Shampoo", "Lip Balm"},
+		adjectives:    []string{"Hydrating", "Fragrance-Free", "Botanical", "Daily", "Repair"},
+		priceRange:    [2]float64{6, 140},
+		axes:          []axis{{"Volume", []string{"30ml", "50ml", "100ml", "200ml"}}},
+		specs:         []spec{{"Skin type", []string{"All", "Dry", "Oily", "Sensitive"}, ""}},
+	},
+	{
+		name:          "Books",
+		subcategories: []string{"Fiction", "Cookbooks", "Travel"},
+		nouns:         []string{"Novel", "Cookbook", "Travel Guide", "Notebook", "Atlas"},
+		adjectives:    []string{"Illustrated", "Collected", "Pocket", "Essential", "Annotated"},
+		priceRange:    [2]float64{8, 60},
+		axes:          []axis{{"Format", []string{"Paperback", "Hardcover"}}},
+		specs:         []spec{{"Pages", []string{"160", "240", "320", "480"}, ""}},
+	},
+}
+
+var brandNames = []string{
+	"Northwind", "Acme", "Bluebird", "Copperline", "Driftwood", "Evergreen", "Foxglove", "Granite",
+	"Harbor", "Ironbark", "Juniper", "Kestrel", "Lumen", "Meridian", "Nimbus", "Oakridge",
+	"Pinecrest", "Quarry", "Riverstone", "Summit", "Tidewater", "Umber", "Vantage", "Willow",
+}
+
+var firstNames = []string{
+	"Amira", "Ben", "Chloe", "Daniel", "Elif", "Farid", "Grace", "Hugo", "Ines", "Jonas",
+	"Karim", "Lea", "Mateo", "Nora", "Omar", "Priya", "Quentin", "Rania", "Sami", "Tara",
+}
+
+var lastNames = []string{
+	"Ahmed", "Bernard", "Costa", "Dubois", "Evans", "Fischer", "Garcia", "Haddad", "Ito", "Jensen",
+	"Khan", "Laurent", "Meyer", "Novak", "Okafor", "Petit", "Rossi", "Smith", "Trabelsi", "Weber",
+}
+
+var warehouseSites = []struct{ city, state, country, postalCode string }{
+	{"Tunis", "Tunis", "TN", "1000"},
+	{"Paris", "Ile-de-France", "FR", "75001"},
+	{"Berlin", "Berlin", "DE", "10115"},
+	{"Madrid", "Madrid", "ES", "28001"},
+	{"Milan", "Lombardy", "IT", "20121"},
+	{"Rotterdam", "South Holland", "NL", "3011"},
+}
+
+// generator produces deterministic catalog data from a seed
+type generator struct {
+	rnd *rand.Rand
+}
+
+func newGenerator(seed int64) *generator {
+	return &generator{rnd: rand.New(rand.NewPCG(uint64(seed), 0x5eed))}
+}
+
+func (g *generator) pick(values []string) string {
+	return values[g.rnd.IntN(len(values))]
+}
+
+// price returns a price in the range rounded to a .99 or .49 ending
+func (g *generator) price(r [2]float64) float64 {
+	p := r[0] + g.rnd.Float64()*(r[1]-r[0])
+	ending := 0.99
+	if g.rnd.IntN(2) == 0 {
+		ending = 0.49
+	}
+	return math.Floor(p) + ending
+}
+
+// sample returns up to n distinct values in random order
+func (g *generator) sample(values []string, n int) []string {
+	shuffled := append([]string(nil), values...)
+	g.rnd.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	return shuffled[:min(n, len(shuffled))]
+}
+
+func slugify(s string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+			dash = false
+		case !dash && b.Len() > 0:
+			b.WriteByte('-')
+			dash = true
+		}
+	}
+	return strings.TrimSuffix(b.String(), "-")
+}
+
+// skuCode returns the upper-case letters of a name, e.g. "HOME" for
+// "Home & Kitchen"
+func skuCode(s string, n int) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(s) {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteRune(r)
+			if b.Len() == n {
+				break
+			}
+		}
+	}
+	return b.String()
+}
+
+func imageURL(slug string, position int) string {
+	return fmt.Sprintf("https://picsum.photos/seed/%s-%d/800/800", slug, position)
+}
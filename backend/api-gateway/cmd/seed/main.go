@@ -0,0 +1,122 @@
+// Command seed fills a development environment with a realistic catalog,
+// customers and stock. It talks to the product, inventory and user services
+// over gRPC rather than writing SQL, so the data goes through the same
+// validation, SKU generation and side effects as data created by the
+// gateway.
+//
+// The generated data only depends on -seed, so two runs with the same flags
+// produce the same catalog. Records that already exist (same slug, code or
+// email) are reused, which makes re-running the tool safe.
+//
+//	go run ./cmd/seed -products 500 -variants 3 -users 50 -warehouses 3
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	userpb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+func main() {
+	var opts Options
+	flag.StringVar(&opts.Tenant, "tenant", tenant.DefaultTenantID, "store to seed")
+	flag.Int64Var(&opts.Seed, "seed", 1, "random seed; the same seed generates the same data")
+	flag.IntVar(&opts.Brands, "brands", 12, "number of brands")
+	flag.IntVar(&opts.Categories, "categories", 6, "number of top-level categories, each with subcategories")
+	flag.IntVar(&opts.Products, "products", 100, "number of products")
+	flag.IntVar(&opts.Variants, "variants", 3, "maximum number of variants per product")
+	flag.IntVar(&opts.Images, "images", 3, "number of images per product")
+	flag.IntVar(&opts.Warehouses, "warehouses", 3, "number of warehouses stock is spread across")
+	flag.IntVar(&opts.Users, "users", 25, "number of customer accounts")
+	flag.StringVar(&opts.Password, "password", "Seed-Passw0rd!", "password of the seeded accounts")
+	productAddr := flag.String("product-addr", envOr("PRODUCT_SERVICE_ADDR", "localhost:50051"), "product service address")
+	inventoryAddr := flag.String("inventory-addr", envOr("INVENTORY_SERVICE_ADDR", "localhost:50055"), "inventory service address")
+	userAddr := flag.String("user-addr", envOr("USER_SERVICE_ADDR", "localhost:50052"), "user service address")
+	timeout := flag.Duration("timeout", 10*time.Minute, "maximum duration of the run")
+	flag.Parse()
+
+	logger, err := zap.NewDevelopment()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer logger.Sync()
+
+	if !tenant.IsValidID(opts.Tenant) {
+		logger.Fatal("Invalid tenant", zap.String("tenant", opts.Tenant))
+	}
+
+	// The seeder creates catalog data, which only the gateway may do, so it
+	// signs its calls with the key of the gateway
+	signer, err := servicetoken.SignerFromEnv("api-gateway")
+	if err != nil {
+		logger.Fatal("Invalid service token key", zap.Error(err))
+	}
+	servicetoken.SetSigner(signer)
+
+	productConn := dial(logger, *productAddr)
+	defer productConn.Close()
+	inventoryConn := dial(logger, *inventoryAddr)
+	defer inventoryConn.Close()
+	userConn := dial(logger, *userAddr)
+	defer userConn.Close()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+	ctx, cancel = context.WithTimeout(ctx, *timeout)
+	defer cancel()
+	ctx = tenant.WithTenant(ctx, opts.Tenant)
+
+	seeder := NewSeeder(
+		productpb.NewProductServiceClient(productConn),
+		inventorypb.NewInventoryServiceClient(inventoryConn),
+		userpb.NewUserServiceClient(userConn),
+		opts,
+		logger,
+	)
+	summary, err := seeder.Run(ctx)
+	if err != nil {
+		logger.Fatal("Seeding failed", zap.Error(err))
+	}
+	logger.Info("Seeding completed",
+		zap.String("tenant", opts.Tenant),
+		zap.Int("brands", summary.Brands),
+		zap.Int("categories", summary.Categories),
+		zap.Int("warehouses", summary.Warehouses),
+		zap.Int("products", summary.Products),
+		zap.Int("variants", summary.Variants),
+		zap.Int("inventory_items", summary.InventoryItems),
+		zap.Int("users", summary.Users),
+		zap.Int("skipped", summary.Skipped))
+}
+
+func dial(logger *zap.Logger, addr string) *grpc.ClientConn {
+	conn, err := grpc.NewClient(addr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(tenant.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor()),
+	)
+	if err != nil {
+		logger.Fatal("Failed to connect", zap.String("address", addr), zap.Error(err))
+	}
+	return conn
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,463 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	userpb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+const listPageSize = 100
+
+// Options controls the amount of generated data
+type Options struct {
+	Tenant     string
+	Seed       int64
+	Brands     int
+	Categories int
+	Products   int
+	Variants   int
+	Images     int
+	Warehouses int
+	Users      int
+	Password   string
+}
+
+// Summary counts the records created by a run
+type Summary struct {
+	Brands         int
+	Categories     int
+	Warehouses     int
+	Products       int
+	Variants       int
+	InventoryItems int
+	Users          int
+	Skipped        int // Records that already existed
+}
+
+// Seeder creates the seed data through the service APIs
+type Seeder struct {
+	products  productpb.ProductServiceClient
+	inventory inventorypb.InventoryServiceClient
+	users     userpb.UserServiceClient
+	opts      Options
+	logger    *zap.Logger
+
+	gen     *generator
+	summary Summary
+}
+
+// NewSeeder creates a seeder
+func NewSeeder(products productpb.ProductServiceClient, inventory inventorypb.InventoryServiceClient, users userpb.UserServiceClient, opts Options, logger *zap.Logger) *Seeder {
+	return &Seeder{
+		products:  products,
+		inventory: inventory,
+		users:     users,
+		opts:      opts,
+		logger:    logger,
+		gen:       newGenerator(opts.Seed),
+	}
+}
+
+// leafCategory is a subcategory products are assigned to
+type leafCategory struct {
+	department *department
+	category   *productpb.Category
+}
+
+// Run seeds brands, categories, warehouses, products with their stock and
+// users, in that order
+func (s *Seeder) Run(ctx context.Context) (Summary, error) {
+	brands, err := s.seedBrands(ctx)
+	if err != nil {
+		return s.summary, fmt.Errorf("brands: %w", err)
+	}
+	categories, err := s.seedCategories(ctx)
+	if err != nil {
+		return s.summary, fmt.Errorf("categories: %w", err)
+	}
+	warehouses, err := s.seedWarehouses(ctx)
+	if err != nil {
+		return s.summary, fmt.Errorf("warehouses: %w", err)
+	}
+	if err := s.seedProducts(ctx, brands, categories, warehouses); err != nil {
+		return s.summary, fmt.Errorf("products: %w", err)
+	}
+	if err := s.seedUsers(ctx); err != nil {
+		return s.summary, fmt.Errorf("users: %w", err)
+	}
+	return s.summary, nil
+}
+
+func (s *Seeder) seedBrands(ctx context.Context) ([]*productpb.Brand, error) {
+	existing := map[string]*productpb.Brand{}
+	for page := int32(1); ; page++ {
+		resp, err := s.products.ListBrands(ctx, &productpb.ListBrandsRequest{Page: page, Limit: listPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range resp.Brands {
+			existing[b.Slug] = b
+		}
+		if len(resp.Brands) < listPageSize {
+			break
+		}
+	}
+
+	brands := make([]*productpb.Brand, 0, s.opts.Brands)
+	for i := range s.opts.Brands {
+		name := brandNames[i%len(brandNames)]
+		if i >= len(brandNames) {
+			name = fmt.Sprintf("%s %d", name, i/len(brandNames)+1)
+		}
+		slug := slugify(name)
+		if b, ok := existing[slug]; ok {
+			brands = append(brands, b)
+			s.summary.Skipped++
+			continue
+		}
+
+		b, err := s.products.CreateBrand(ctx, &productpb.CreateBrandRequest{Brand: &productpb.Brand{
+			Name:        name,
+			Slug:        slug,
+			Description: fmt.Sprintf("%s makes everyday products built to last.", name),
+		}})
+		if err != nil {
+			return nil, fmt.Errorf("create %q: %w", name, err)
+		}
+		brands = append(brands, b)
+		s.summary.Brands++
+	}
+	return brands, nil
+}
+
+func (s *Seeder) seedCategories(ctx context.Context) ([]leafCategory, error) {
+	existing := map[string]*productpb.Category{}
+	for page := int32(1); ; page++ {
+		resp, err := s.products.ListCategories(ctx, &productpb.ListCategoriesRequest{Page: page, Limit: listPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range resp.Categories {
+			existing[c.Slug] = c
+		}
+		if len(resp.Categories) < listPageSize {
+			break
+		}
+	}
+
+	ensure := func(name, slug string, parent *productpb.Category) (*productpb.Category, error) {
+		if c, ok := existing[slug]; ok {
+			s.summary.Skipped++
+			return c, nil
+		}
+		category := &productpb.Category{Name: name, Slug: slug, Description: fmt.Sprintf("Shop %s.", strings.ToLower(name))}
+		if parent != nil {
+			category.ParentId = wrapperspb.String(parent.Id)
+		}
+		c, err := s.products.CreateCategory(ctx, &productpb.CreateCategoryRequest{Category: category})
+		if err != nil {
+			return nil, fmt.Errorf("create %q: %w", name, err)
+		}
+		s.summary.Categories++
+		return c, nil
+	}
+
+	var leaves []leafCategory
+	for i := range min(s.opts.Categories, len(departments)) {
+		d := &departments[i]
+		parent, err := ensure(d.name, slugify(d.name), nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, sub := range d.subcategories {
+			child, err := ensure(sub, slugify(d.name+" "+sub), parent)
+			if err != nil {
+				return nil, err
+			}
+			leaves = append(leaves, leafCategory{department: d, category: child})
+		}
+	}
+	if len(leaves) == 0 {
+		return nil, fmt.Errorf("at least one category is required")
+	}
+	return leaves, nil
+}
+
+func (s *Seeder) seedWarehouses(ctx context.Context) ([]*inventorypb.Warehouse, error) {
+	existing := map[string]*inventorypb.Warehouse{}
+	for page := int32(1); ; page++ {
+		resp, err := s.inventory.ListWarehouses(ctx, &inventorypb.ListWarehousesRequest{Page: page, Limit: listPageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range resp.Warehouses {
+			existing[w.Code] = w
+		}
+		if len(resp.Warehouses) < listPageSize {
+			break
+		}
+	}
+
+	warehouses := make([]*inventorypb.Warehouse, 0, s.opts.Warehouses)
+	for i := range s.opts.Warehouses {
+		site := warehouseSites[i%len(warehouseSites)]
+		code := fmt.Sprintf("WH-%s-%02d", site.country, i+1)
+		if w, ok := existing[code]; ok {
+			warehouses = append(warehouses, w)
+			s.summary.Skipped++
+			continue
+		}
+
+		resp, err := s.inventory.CreateWarehouse(ctx, &inventorypb.CreateWarehouseRequest{
+			Name:       fmt.Sprintf("%s Fulfilment Centre", site.city),
+			Code:       code,
+			Address:    fmt.Sprintf("%d Logistics Park", 10+i),
+			City:       site.city,
+			State:      site.state,
+			Country:    site.country,
+			PostalCode: site.postalCode,
+			Priority:   int32(i + 1),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create %s: %w", code, err)
+		}
+		warehouses = append(warehouses, resp.Warehouse)
+		s.summary.Warehouses++
+	}
+	return warehouses, nil
+}
+
+func (s *Seeder) seedProducts(ctx context.Context, brands []*productpb.Brand, categories []leafCategory, warehouses []*inventorypb.Warehouse) error {
+	for i := range s.opts.Products {
+		// Generate before talking to the services, so the random stream and
+		// with it the rest of the catalog do not depend on what exists
+		leaf := categories[s.gen.rnd.IntN(len(categories))]
+		var brand *productpb.Brand
+		if len(brands) > 0 {
+			brand = brands[s.gen.rnd.IntN(len(brands))]
+		}
+		product := s.generateProduct(i, leaf, brand)
+		stock := make([][]*inventorypb.WarehouseAllocation, len(product.Variants))
+		for v := range product.Variants {
+			stock[v] = s.generateStock(warehouses)
+		}
+
+		created, err := s.products.CreateProduct(ctx, &productpb.CreateProductRequest{Product: product})
+		switch status.Code(err) {
+		case codes.OK:
+			s.summary.Products++
+			s.summary.Variants += len(product.Variants)
+		case codes.AlreadyExists:
+			s.summary.Skipped++
+		default:
+			return fmt.Errorf("create %q: %w", product.Slug, err)
+		}
+
+		// Read the product back for the variant IDs; this also completes the
+		// stock of products seeded by an interrupted run
+		if created == nil || len(created.Variants) == 0 {
+			created, err = s.products.GetProduct(ctx, &productpb.GetProductRequest{
+				Identifier: &productpb.GetProductRequest_Slug{Slug: product.Slug},
+			})
+			if err != nil {
+				return fmt.Errorf("get %q: %w", product.Slug, err)
+			}
+		}
+		if err := s.seedStock(ctx, created, stock); err != nil {
+			return err
+		}
+
+		if (i+1)%50 == 0 {
+			s.logger.Info("Seeding products", zap.Int("done", i+1), zap.Int("total", s.opts.Products))
+		}
+	}
+	return nil
+}
+
+func (s *Seeder) generateProduct(i int, leaf leafCategory, brand *productpb.Brand) *productpb.Product {
+	d := leaf.department
+	title := fmt.Sprintf("%s %s", s.gen.pick(d.adjectives), s.gen.pick(d.nouns))
+	if brand != nil {
+		title = brand.Name + " " + title
+	}
+	slug := fmt.Sprintf("%s-%05d", slugify(title), i+1)
+	base := s.gen.price(d.priceRange)
+
+	product := &productpb.Product{
+		Title:            title,
+		Slug:             slug,
+		ShortDescription: fmt.Sprintf("%s from our %s range.", title, strings.ToLower(leaf.category.Name)),
+		Description: fmt.Sprintf("The %s is part of our %s collection. Designed for everyday use, "+
+			"it combines durable materials with a clean, timeless look.", title, strings.ToLower(d.name)),
+		Price:       base,
+		IsPublished: s.gen.rnd.IntN(10) != 0,
+		Categories:  []*productpb.Category{{Id: leaf.category.Id, Name: leaf.category.Name}},
+		Tags: []*productpb.ProductTag{
+			{Tag: slugify(d.name)},
+			{Tag: slugify(leaf.category.Name)},
+		},
+		Seo: &productpb.ProductSEO{
+			MetaTitle:       title,
+			MetaDescription: fmt.Sprintf("Buy the %s online.", title),
+			Keywords:        []string{strings.ToLower(d.name), strings.ToLower(leaf.category.Name)},
+		},
+		Shipping: &productpb.ProductShipping{
+			FreeShipping:     base >= 50,
+			EstimatedDays:    int32(2 + s.gen.rnd.IntN(5)),
+			ExpressAvailable: s.gen.rnd.IntN(2) == 0,
+		},
+	}
+	if brand != nil {
+		product.BrandId = wrapperspb.String(brand.Id)
+	}
+	for p := range s.opts.Images {
+		product.Images = append(product.Images, &productpb.ProductImage{
+			Url:      imageURL(slug, p+1),
+			AltText:  fmt.Sprintf("%s, view %d", title, p+1),
+			Position: int32(p + 1),
+		})
+	}
+	for _, sp := range d.specs {
+		product.Specifications = append(product.Specifications, &productpb.ProductSpecification{
+			Name:  sp.name,
+			Value: s.gen.pick(sp.values),
+			Unit:  sp.unit,
+		})
+	}
+
+	// Variants are combinations of the department's axes, e.g. M / Navy,
+	// and are distinct as long as one axis has a value per variant
+	count := 1 + s.gen.rnd.IntN(max(s.opts.Variants, 1))
+	widest := 0
+	for _, ax := range d.axes {
+		widest = max(widest, len(ax.values))
+	}
+	count = min(count, widest)
+	values := make([][]string, len(d.axes))
+	for a, ax := range d.axes {
+		values[a] = s.gen.sample(ax.values, count)
+	}
+	skuPrefix := fmt.Sprintf("%s-%05d", skuCode(d.name, 4), i+1)
+	for v := range count {
+		var attributes []*productpb.VariantAttributeValue
+		var names []string
+		for a, ax := range d.axes {
+			value := values[a][v%len(values[a])]
+			attributes = append(attributes, &productpb.VariantAttributeValue{Name: ax.name, Value: value})
+			names = append(names, value)
+		}
+		variant := &productpb.ProductVariant{
+			Sku:        fmt.Sprintf("%s-%02d", skuPrefix, v+1),
+			Title:      strings.Join(names, " / "),
+			Price:      base,
+			Attributes: attributes,
+		}
+		if s.gen.rnd.IntN(5) == 0 {
+			variant.DiscountPrice = wrapperspb.Double(float64(int(base*0.8)) + 0.99)
+		}
+		product.Variants = append(product.Variants, variant)
+	}
+	product.Sku = product.Variants[0].Sku
+	return product
+}
+
+// generateStock spreads the stock of a variant over some of the warehouses.
+// About one variant in ten is out of stock.
+func (s *Seeder) generateStock(warehouses []*inventorypb.Warehouse) []*inventorypb.WarehouseAllocation {
+	if len(warehouses) == 0 || s.gen.rnd.IntN(10) == 0 {
+		return nil
+	}
+	var allocations []*inventorypb.WarehouseAllocation
+	for _, w := range warehouses {
+		if s.gen.rnd.IntN(3) == 0 {
+			continue
+		}
+		allocations = append(allocations, &inventorypb.WarehouseAllocation{
+			WarehouseId: w.Id,
+			Quantity:    int32(1 + s.gen.rnd.IntN(120)),
+		})
+	}
+	return allocations
+}
+
+// seedStock creates the inventory item of every variant. The stock
+// generated for a variant is matched by SKU, as the product service may
+// return the variants in a different order.
+func (s *Seeder) seedStock(ctx context.Context, product *productpb.Product, stock [][]*inventorypb.WarehouseAllocation) error {
+	bySKU := map[string][]*inventorypb.WarehouseAllocation{}
+	for v, variant := range product.Variants {
+		if v < len(stock) {
+			bySKU[variant.Sku] = stock[v]
+		}
+	}
+
+	for _, variant := range product.Variants {
+		allocations := bySKU[variant.Sku]
+		var total int32
+		for _, a := range allocations {
+			total += a.Quantity
+		}
+		_, err := s.inventory.CreateInventoryItem(ctx, &inventorypb.CreateInventoryItemRequest{
+			ProductId:            product.Id,
+			VariantId:            wrapperspb.String(variant.Id),
+			Sku:                  variant.Sku,
+			InitialQuantity:      total,
+			ReorderPoint:         10,
+			ReorderQuantity:      50,
+			WarehouseAllocations: allocations,
+		})
+		switch status.Code(err) {
+		case codes.OK:
+			s.summary.InventoryItems++
+		case codes.AlreadyExists:
+			s.summary.Skipped++
+		default:
+			return fmt.Errorf("create inventory for %s: %w", variant.Sku, err)
+		}
+	}
+	return nil
+}
+
+func (s *Seeder) seedUsers(ctx context.Context) error {
+	accounts := []*userpb.CreateUserRequest{{
+		Email:     "admin@example.com",
+		Password:  s.opts.Password,
+		FirstName: "Seed",
+		LastName:  "Admin",
+		UserType:  "admin",
+		Role:      "admin",
+	}}
+	for i := range s.opts.Users {
+		first, last := s.gen.pick(firstNames), s.gen.pick(lastNames)
+		accounts = append(accounts, &userpb.CreateUserRequest{
+			Email:     fmt.Sprintf("%s.%s.%03d@example.com", strings.ToLower(first), strings.ToLower(last), i+1),
+			Password:  s.opts.Password,
+			FirstName: first,
+			LastName:  last,
+			UserType:  "customer",
+			Role:      "user",
+		})
+	}
+
+	for _, account := range accounts {
+		_, err := s.users.CreateUser(ctx, account)
+		switch status.Code(err) {
+		case codes.OK:
+			s.summary.Users++
+		case codes.AlreadyExists:
+			s.summary.Skipped++
+		default:
+			return fmt.Errorf("create %s: %w", account.Email, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+func TestGenerateProductIsDeterministic(t *testing.T) {
+	opts := Options{Seed: 42, Variants: 4, Images: 2}
+	leaf := leafCategory{department: &departments[0], category: &productpb.Category{Id: "c1", Name: "T-Shirts"}}
+	brand := &productpb.Brand{Id: "b1", Name: "Northwind"}
+
+	a := NewSeeder(nil, nil, nil, opts, zap.NewNop()).generateProduct(6, leaf, brand)
+	b := NewSeeder(nil, nil, nil, opts, zap.NewNop()).generateProduct(6, leaf, brand)
+	if !proto.Equal(a, b) {
+		t.Fatalf("the same seed generated different products:\n%v\n%v", a, b)
+	}
+
+	if len(a.Variants) < 1 || len(a.Variants) > opts.Variants {
+		t.Errorf("variants = %d, want 1..%d", len(a.Variants), opts.Variants)
+	}
+	if a.Sku != "APPA-00007-01" {
+		t.Errorf("sku = %q, want APPA-00007-01", a.Sku)
+	}
+	if len(a.Images) != opts.Images {
+		t.Errorf("images = %d, want %d", len(a.Images), opts.Images)
+	}
+	if a.BrandId.GetValue() != "b1" || a.Categories[0].Id != "c1" {
+		t.Errorf("brand or category not set: %v %v", a.BrandId, a.Categories)
+	}
+	seen := map[string]bool{}
+	for _, v := range a.Variants {
+		if seen[v.Title] {
+			t.Errorf("duplicate variant %q", v.Title)
+		}
+		seen[v.Title] = true
+	}
+}
+
+func TestSlugify(t *testing.T) {
+	tests := map[string]string{
+		"Home & Kitchen":           "home-kitchen",
+		"Northwind Pro Headphones": "northwind-pro-headphones",
+		"  T-Shirts ":              "t-shirts",
+	}
+	for in, want := range tests {
+		if got := slugify(in); got != want {
+			t.Errorf("slugify(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -12,6 +12,7 @@ type CategoryResponse struct {
 	Description string  `json:"description,omitempty"`
 	ParentID    *string `json:"parent_id,omitempty"`
 	ParentName  string  `json:"parent_name,omitempty"`
+	Position    int     `json:"position"`
 	CreatedAt   string  `json:"created_at,omitempty"`
 	UpdatedAt   string  `json:"updated_at,omitempty"`
 	DeletedAt   string  `json:"deleted_at,omitempty"`
@@ -36,6 +37,7 @@ func FormatCategory(category *pb.Category) CategoryResponse {
 		Slug:        category.Slug,
 		Description: category.Description,
 		ParentName:  category.ParentName,
+		Position:    int(category.Position),
 	}
 
 	// Format parent ID if available
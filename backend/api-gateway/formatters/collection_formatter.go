@@ -0,0 +1,124 @@
+package formatters
+
+import (
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// CollectionRulesInfo represents the rule-based membership filters of a collection
+type CollectionRulesInfo struct {
+	BrandIDs    []string `json:"brand_ids"`
+	CategoryIDs []string `json:"category_ids"`
+	Tags        []string `json:"tags"`
+}
+
+// CollectionResponse represents the formatted collection response
+type CollectionResponse struct {
+	ID          string              `json:"id"`
+	Name        string              `json:"name"`
+	Slug        string              `json:"slug"`
+	Description string              `json:"description,omitempty"`
+	ImageURL    string              `json:"image_url,omitempty"`
+	IsPublished bool                `json:"is_published"`
+	Rules       CollectionRulesInfo `json:"rules"`
+	ProductIDs  []string            `json:"product_ids"`
+	CreatedAt   string              `json:"created_at,omitempty"`
+	UpdatedAt   string              `json:"updated_at,omitempty"`
+}
+
+// CollectionListResponse represents the formatted collection list response
+type CollectionListResponse struct {
+	Collections []CollectionResponse `json:"collections"`
+	Total       int                  `json:"total"`
+	Pagination  PaginationInfo       `json:"pagination"`
+}
+
+// CollectionProductsResponse represents a collection landing page with its member products
+type CollectionProductsResponse struct {
+	Collection CollectionResponse `json:"collection"`
+	Products   []ProductResponse  `json:"products"`
+	Total      int                `json:"total"`
+	Pagination PaginationInfo     `json:"pagination"`
+}
+
+// FormatCollection formats a collection proto message into the desired response format
+func FormatCollection(collection *pb.Collection) CollectionResponse {
+	if collection == nil {
+		return CollectionResponse{}
+	}
+
+	formatted := CollectionResponse{
+		ID:          collection.Id,
+		Name:        collection.Name,
+		Slug:        collection.Slug,
+		Description: collection.Description,
+		ImageURL:    collection.ImageUrl,
+		IsPublished: collection.IsPublished,
+		Rules: CollectionRulesInfo{
+			BrandIDs:    []string{},
+			CategoryIDs: []string{},
+			Tags:        []string{},
+		},
+		ProductIDs: []string{},
+	}
+
+	if collection.Rules != nil {
+		if len(collection.Rules.BrandIds) > 0 {
+			formatted.Rules.BrandIDs = collection.Rules.BrandIds
+		}
+		if len(collection.Rules.CategoryIds) > 0 {
+			formatted.Rules.CategoryIDs = collection.Rules.CategoryIds
+		}
+		if len(collection.Rules.Tags) > 0 {
+			formatted.Rules.Tags = collection.Rules.Tags
+		}
+	}
+
+	if len(collection.ProductIds) > 0 {
+		formatted.ProductIDs = collection.ProductIds
+	}
+
+	if collection.CreatedAt != nil {
+		formatted.CreatedAt = formatTimestamp(collection.CreatedAt)
+	}
+
+	if collection.UpdatedAt != nil {
+		formatted.UpdatedAt = formatTimestamp(collection.UpdatedAt)
+	}
+
+	return formatted
+}
+
+// FormatCollectionList formats a list of collection proto messages into the desired response format
+func FormatCollectionList(collections []*pb.Collection, page, limit, total int) CollectionListResponse {
+	formattedCollections := make([]CollectionResponse, 0, len(collections))
+	for _, collection := range collections {
+		if collection != nil {
+			formattedCollections = append(formattedCollections, FormatCollection(collection))
+		}
+	}
+
+	totalPages := (total + limit - 1) / limit // Ceiling division
+
+	return CollectionListResponse{
+		Collections: formattedCollections,
+		Total:       total,
+		Pagination: PaginationInfo{
+			CurrentPage: page,
+			TotalPages:  totalPages,
+			PerPage:     limit,
+			TotalItems:  total,
+		},
+	}
+}
+
+// FormatCollectionProducts formats a collection and a page of its member products
+func FormatCollectionProducts(resp *pb.ListCollectionProductsResponse, page, limit int) CollectionProductsResponse {
+	productList := FormatProductList(resp.Products, page, limit, int(resp.Total))
+
+	return CollectionProductsResponse{
+		Collection: FormatCollection(resp.Collection),
+		Products:   productList.Products,
+		Total:      productList.Total,
+		Pagination: productList.Pagination,
+	}
+}
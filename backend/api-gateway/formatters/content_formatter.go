@@ -0,0 +1,115 @@
+package formatters
+
+import (
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// ContentPageResponse represents a static storefront page
+type ContentPageResponse struct {
+	ID          string `json:"id"`
+	Slug        string `json:"slug"`
+	Title       string `json:"title"`
+	Summary     string `json:"summary,omitempty"`
+	Body        string `json:"body"`
+	Status      string `json:"status"`
+	PublishAt   string `json:"publish_at,omitempty"`
+	UnpublishAt string `json:"unpublish_at,omitempty"`
+	Live        bool   `json:"live"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// ContentPageListResponse represents a page of storefront pages
+type ContentPageListResponse struct {
+	Pages      []ContentPageResponse `json:"pages"`
+	Total      int                   `json:"total"`
+	Pagination PaginationInfo        `json:"pagination"`
+}
+
+// ContentBannerResponse represents a banner of a storefront slot
+type ContentBannerResponse struct {
+	ID          string `json:"id"`
+	Slot        string `json:"slot"`
+	Title       string `json:"title"`
+	Subtitle    string `json:"subtitle,omitempty"`
+	ImageURL    string `json:"image_url"`
+	LinkURL     string `json:"link_url,omitempty"`
+	Position    int    `json:"position"`
+	Status      string `json:"status"`
+	PublishAt   string `json:"publish_at,omitempty"`
+	UnpublishAt string `json:"unpublish_at,omitempty"`
+	Live        bool   `json:"live"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// ContentSlotResponse represents the banners of a storefront slot, by position
+type ContentSlotResponse struct {
+	Slot    string                  `json:"slot"`
+	Banners []ContentBannerResponse `json:"banners"`
+}
+
+// FormatContentPage converts a content page proto message into its response
+func FormatContentPage(page *pb.ContentPage) ContentPageResponse {
+	return ContentPageResponse{
+		ID:          page.Id,
+		Slug:        page.Slug,
+		Title:       page.Title,
+		Summary:     page.Summary,
+		Body:        page.Body,
+		Status:      page.Status,
+		PublishAt:   formatTimestamp(page.PublishAt),
+		UnpublishAt: formatTimestamp(page.UnpublishAt),
+		Live:        page.Live,
+		CreatedAt:   formatTimestamp(page.CreatedAt),
+		UpdatedAt:   formatTimestamp(page.UpdatedAt),
+	}
+}
+
+// FormatContentPageList converts a page of content pages into its response
+func FormatContentPageList(pages []*pb.ContentPage, page, limit, total int) ContentPageListResponse {
+	formatted := make([]ContentPageResponse, len(pages))
+	for i, p := range pages {
+		formatted[i] = FormatContentPage(p)
+	}
+	return ContentPageListResponse{
+		Pages: formatted,
+		Total: total,
+		Pagination: PaginationInfo{
+			CurrentPage: page,
+			TotalPages:  (total + limit - 1) / limit,
+			PerPage:     limit,
+			TotalItems:  total,
+		},
+	}
+}
+
+// FormatContentBanner converts a content banner proto message into its
+// response
+func FormatContentBanner(banner *pb.ContentBanner) ContentBannerResponse {
+	return ContentBannerResponse{
+		ID:          banner.Id,
+		Slot:        banner.Slot,
+		Title:       banner.Title,
+		Subtitle:    banner.Subtitle,
+		ImageURL:    banner.ImageUrl,
+		LinkURL:     banner.LinkUrl,
+		Position:    int(banner.Position),
+		Status:      banner.Status,
+		PublishAt:   formatTimestamp(banner.PublishAt),
+		UnpublishAt: formatTimestamp(banner.UnpublishAt),
+		Live:        banner.Live,
+		CreatedAt:   formatTimestamp(banner.CreatedAt),
+		UpdatedAt:   formatTimestamp(banner.UpdatedAt),
+	}
+}
+
+// FormatContentBanners converts content banner proto messages into their
+// responses
+func FormatContentBanners(banners []*pb.ContentBanner) []ContentBannerResponse {
+	formatted := make([]ContentBannerResponse, len(banners))
+	for i, banner := range banners {
+		formatted[i] = FormatContentBanner(banner)
+	}
+	return formatted
+}
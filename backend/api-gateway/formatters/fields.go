@@ -0,0 +1,135 @@
+package formatters
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Fields is a sparse fieldset, JSON:API style: the attributes of a resource
+// a client asked for with fields=title,price. The id is always included.
+// A nil *Fields selects every attribute.
+type Fields struct {
+	fields []selectedField
+}
+
+type selectedField struct {
+	name      string
+	index     int
+	omitEmpty bool
+}
+
+// ParseFields parses a comma separated list of the JSON attribute names of
+// resource, a formatter response struct. It returns nil for an empty list and
+// an error naming the first unknown attribute.
+func ParseFields(value string, resource any) (*Fields, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+
+	known := jsonFields(reflect.TypeOf(resource))
+	selected := make(map[string]bool)
+	f := &Fields{}
+	add := func(name string) error {
+		field, ok := known[name]
+		if !ok {
+			return fmt.Errorf("unknown field %q", name)
+		}
+		if !selected[name] {
+			selected[name] = true
+			f.fields = append(f.fields, field)
+		}
+		return nil
+	}
+
+	if _, ok := known["id"]; ok {
+		add("id")
+	}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if err := add(name); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// Select returns the selected attributes of resource, which must be of the
+// type given to ParseFields, or resource itself when f is nil. Empty
+// attributes tagged omitempty are left out, as the encoder would.
+func (f *Fields) Select(resource any) any {
+	if f == nil {
+		return resource
+	}
+
+	v := reflect.ValueOf(resource)
+	selected := make(map[string]any, len(f.fields))
+	for _, field := range f.fields {
+		fv := v.Field(field.index)
+		if field.omitEmpty && isEmptyValue(fv) {
+			continue
+		}
+		selected[field.name] = fv.Interface()
+	}
+	return selected
+}
+
+// Has reports whether an attribute is selected, so that handlers can skip
+// fetching the data of attributes left out
+func (f *Fields) Has(name string) bool {
+	if f == nil {
+		return true
+	}
+	for _, field := range f.fields {
+		if field.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectEach applies Select to every resource of a list
+func SelectEach[T any](f *Fields, resources []T) any {
+	if f == nil {
+		return resources
+	}
+	selected := make([]any, len(resources))
+	for i, resource := range resources {
+		selected[i] = f.Select(resource)
+	}
+	return selected
+}
+
+// jsonFields maps the JSON names of the exported fields of a struct type to
+// their position
+func jsonFields(t reflect.Type) map[string]selectedField {
+	fields := make(map[string]selectedField, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, opts, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		fields[name] = selectedField{name: name, index: i, omitEmpty: strings.Contains(opts, "omitempty")}
+	}
+	return fields
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
 	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -31,6 +32,27 @@ type ProductResponse struct {
 	SEO              *EnhancedSEOInfo       `json:"seo,omitempty"`
 	Shipping         *EnhancedShippingInfo  `json:"shipping,omitempty"`
 	Discounts        []DiscountInfo         `json:"discounts,omitempty"`
+	Bundle           *BundleInfo            `json:"bundle,omitempty"`
+	ProductType      string                 `json:"product_type"`
+	RequiresShipping bool                   `json:"requires_shipping"`
+	DigitalAsset     *DigitalAssetInfo      `json:"digital_asset,omitempty"`
+	Subscription     *SubscriptionPlanInfo  `json:"subscription,omitempty"`
+	Badges           []BadgeInfo            `json:"badges,omitempty"`
+	// PersonalizationOptions are the inputs buyers give to personalize the
+	// product when adding it to their cart, in display order
+	PersonalizationOptions []PersonalizationOptionInfo `json:"personalization_options,omitempty"`
+	// Media are the videos and 3D models of the product for the storefront
+	// viewer, next to its images, in display order
+	Media []ProductMediaInfo `json:"media,omitempty"`
+	// Questions are the top answered customer questions, in the full view of
+	// a single product
+	Questions []QuestionInfo `json:"questions,omitempty"`
+	// Related are the published products linked to a product, such as its
+	// cross-sells and accessories, in the full view of a single product
+	Related []RelatedProductInfo `json:"related,omitempty"`
+	// CustomMetadata is the data integrators attached to the product, when
+	// the store exposes it
+	CustomMetadata map[string]any `json:"custom_metadata,omitempty"`
 }
 
 // CategoryInfo represents category information
@@ -58,7 +80,11 @@ type EnhancedPriceInfo struct {
 
 // EnhancedInventoryInfo represents enhanced inventory information
 type EnhancedInventoryInfo struct {
-	Status            string                 `json:"status"`
+	Status string `json:"status"`
+	// Availability is the stock badge computed by the inventory service:
+	// IN_STOCK, LOW_STOCK, BACKORDER or OUT_OF_STOCK. Available tells whether
+	// the badge lets customers order the product.
+	Availability      string                 `json:"availability"`
 	Available         bool                   `json:"available"`
 	Quantity          int                    `json:"quantity"`
 	TotalQuantity     int                    `json:"total_quantity"`
@@ -68,6 +94,17 @@ type EnhancedInventoryInfo struct {
 	ReorderQuantity   int                    `json:"reorder_quantity"`
 	LastUpdated       string                 `json:"last_updated,omitempty"`
 	Locations         []EnhancedLocationInfo `json:"locations,omitempty"`
+
+	// Set for bundles, whose stock is the number of complete component sets available
+	BundleComponents []BundleComponentStockInfo `json:"bundle_components,omitempty"`
+}
+
+// BundleComponentStockInfo represents the stock of one bundle component
+type BundleComponentStockInfo struct {
+	SKU               string `json:"sku"`
+	QuantityPerBundle int    `json:"quantity_per_bundle"`
+	AvailableQuantity int    `json:"available_quantity"`
+	AvailableSets     int    `json:"available_sets"`
 }
 
 // EnhancedLocationInfo represents enhanced warehouse location information
@@ -76,6 +113,65 @@ type EnhancedLocationInfo struct {
 	Quantity    int    `json:"quantity"`
 }
 
+// BundleInfo represents the components of a bundle product
+type BundleInfo struct {
+	Components      []BundleComponentInfo `json:"components"`
+	ComponentsPrice float64               `json:"components_price"`
+	PriceOverride   *float64              `json:"price_override,omitempty"`
+}
+
+// BundleComponentInfo represents one SKU of a bundle and its quantity per bundle
+type BundleComponentInfo struct {
+	SKU       string  `json:"sku"`
+	Quantity  int     `json:"quantity"`
+	ProductID string  `json:"product_id,omitempty"`
+	VariantID string  `json:"variant_id,omitempty"`
+	Title     string  `json:"title,omitempty"`
+	UnitPrice float64 `json:"unit_price"`
+}
+
+// DigitalAssetInfo represents the downloadable file of a digital product
+type DigitalAssetInfo struct {
+	FileName      string `json:"file_name"`
+	ContentType   string `json:"content_type"`
+	SizeBytes     int64  `json:"size_bytes"`
+	DownloadLimit int    `json:"download_limit"`
+}
+
+// SubscriptionPlanInfo represents the billing plan of a subscription product
+type SubscriptionPlanInfo struct {
+	Interval      string `json:"interval"`
+	IntervalCount int    `json:"interval_count"`
+	TrialDays     int    `json:"trial_days"`
+}
+
+// PersonalizationOptionInfo represents an input buyers give to personalize a
+// product, such as an engraving text or uploaded artwork
+type PersonalizationOptionInfo struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Required  bool     `json:"required"`
+	MaxLength int      `json:"max_length,omitempty"`
+	FileTypes []string `json:"file_types,omitempty"`
+}
+
+// BadgeInfo represents a display badge of a product, such as "New" or "Sale"
+type BadgeInfo struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+	Color string `json:"color,omitempty"`
+}
+
+// RelatedProductInfo represents a product linked to another one, in its
+// summary view
+type RelatedProductInfo struct {
+	RelationshipID string         `json:"relationship_id"`
+	Type           string         `json:"type"`
+	Position       int            `json:"position"`
+	Product        ProductSummary `json:"product"`
+}
+
 // WeightInfo represents weight information
 type WeightInfo struct {
 	Value float64 `json:"value"`
@@ -92,6 +188,23 @@ type EnhancedImageInfo struct {
 	IsThumbnail bool   `json:"is_thumbnail,omitempty"`
 }
 
+// ProductMediaInfo represents a video or 3D model of a product. Videos hosted
+// on YouTube or Vimeo are played in their embed URL; uploaded videos and 3D
+// models are loaded from their URL, in their format. ThumbnailURL is empty
+// while the media are processing.
+type ProductMediaInfo struct {
+	ID           string `json:"id"`
+	Type         string `json:"type"`
+	Provider     string `json:"provider"`
+	URL          string `json:"url"`
+	EmbedURL     string `json:"embed_url"`
+	Format       string `json:"format,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	AltText      string `json:"alt_text"`
+	Position     int    `json:"position"`
+	Status       string `json:"status"`
+}
+
 // EnhancedVariantInfo represents enhanced variant information
 type EnhancedVariantInfo struct {
 	ID            string              `json:"id"`
@@ -116,6 +229,10 @@ type EnhancedVariantInfo struct {
 	SEO              *EnhancedSEOInfo      `json:"seo,omitempty"`
 	Shipping         *EnhancedShippingInfo `json:"shipping,omitempty"`
 	Discounts        []DiscountInfo        `json:"discounts,omitempty"`
+
+	// CustomMetadata is the data integrators attached to the variant, when
+	// the store exposes it
+	CustomMetadata map[string]any `json:"custom_metadata,omitempty"`
 }
 
 // AttributeInfo represents attribute information
@@ -225,12 +342,15 @@ func FormatProduct(product *pb.Product) ProductResponse {
 		ShortDescription: product.ShortDescription,
 		Description:      product.Description,
 		SKU:              product.Sku,
+		ProductType:      product.ProductType,
+		RequiresShipping: product.RequiresShipping,
 		Tags:             []string{}, // Initialize with empty array
 		// Initialize inventory with default values
 		Inventory: &EnhancedInventoryInfo{
-			Status:    "in_stock",
-			Available: true,
-			Quantity:  0,
+			Status:       "in_stock",
+			Availability: availability.InStock,
+			Available:    true,
+			Quantity:     0,
 		},
 	}
 
@@ -239,6 +359,10 @@ func FormatProduct(product *pb.Product) ProductResponse {
 		formatted.DefaultVariantID = product.DefaultVariantId.Value
 	}
 
+	if product.Metadata != nil {
+		formatted.CustomMetadata = product.Metadata.AsMap()
+	}
+
 	// Format brand if available
 	if product.Brand != nil {
 		formatted.Brand = &BrandInfo{
@@ -499,6 +623,108 @@ func FormatProduct(product *pb.Product) ProductResponse {
 		formatted.Tags = []string{}
 	}
 
+	// Add bundle components if the product is a bundle
+	if product.Bundle != nil {
+		formatted.Bundle = FormatBundle(product.Bundle)
+	}
+
+	// Add the asset details if the product is digital
+	if product.DigitalAsset != nil {
+		formatted.DigitalAsset = &DigitalAssetInfo{
+			FileName:      product.DigitalAsset.FileName,
+			ContentType:   product.DigitalAsset.ContentType,
+			SizeBytes:     product.DigitalAsset.SizeBytes,
+			DownloadLimit: int(product.DigitalAsset.DownloadLimit),
+		}
+	}
+
+	// Add the billing plan if the product is sold as a subscription
+	if product.Subscription != nil {
+		formatted.Subscription = &SubscriptionPlanInfo{
+			Interval:      product.Subscription.Interval,
+			IntervalCount: int(product.Subscription.IntervalCount),
+			TrialDays:     int(product.Subscription.TrialDays),
+		}
+	}
+
+	// Add the personalization options, in display order
+	for _, option := range product.PersonalizationOptions {
+		formatted.PersonalizationOptions = append(formatted.PersonalizationOptions, PersonalizationOptionInfo{
+			ID:        option.Id,
+			Name:      option.Name,
+			Type:      option.Type,
+			Required:  option.Required,
+			MaxLength: int(option.MaxLength),
+			FileTypes: option.FileTypes,
+		})
+	}
+
+	// Add the videos and 3D models, in display order
+	for _, media := range product.Media {
+		formatted.Media = append(formatted.Media, FormatProductMedia(media))
+	}
+
+	// Add the display badges, in display order
+	for _, badge := range product.Badges {
+		formatted.Badges = append(formatted.Badges, BadgeInfo{
+			Code:  badge.Code,
+			Label: badge.Label,
+			Color: badge.Color,
+		})
+	}
+
+	// Add the linked products, grouped by type
+	for _, related := range product.RelatedProducts {
+		if related.Product == nil {
+			continue
+		}
+		formatted.Related = append(formatted.Related, RelatedProductInfo{
+			RelationshipID: related.RelationshipId,
+			Type:           related.Type,
+			Position:       int(related.Position),
+			Product:        SummarizeProduct(FormatProduct(related.Product)),
+		})
+	}
+
+	return formatted
+}
+
+// FormatProductMedia formats a video or 3D model of a product
+func FormatProductMedia(media *pb.ProductMedia) ProductMediaInfo {
+	return ProductMediaInfo{
+		ID:           media.Id,
+		Type:         media.Type,
+		Provider:     media.Provider,
+		URL:          media.Url,
+		EmbedURL:     media.EmbedUrl,
+		Format:       media.Format,
+		ThumbnailURL: media.ThumbnailUrl,
+		AltText:      media.AltText,
+		Position:     int(media.Position),
+		Status:       media.Status,
+	}
+}
+
+// FormatBundle formats the bundle details of a product
+func FormatBundle(bundle *pb.ProductBundle) *BundleInfo {
+	formatted := &BundleInfo{
+		Components:      make([]BundleComponentInfo, len(bundle.Components)),
+		ComponentsPrice: bundle.ComponentsPrice,
+	}
+	for i, component := range bundle.Components {
+		formatted.Components[i] = BundleComponentInfo{
+			SKU:       component.Sku,
+			Quantity:  int(component.Quantity),
+			ProductID: component.ProductId,
+			VariantID: component.VariantId,
+			Title:     component.Title,
+			UnitPrice: component.UnitPrice,
+		}
+	}
+	if bundle.PriceOverride != nil {
+		priceOverride := bundle.PriceOverride.Value
+		formatted.PriceOverride = &priceOverride
+	}
 	return formatted
 }
 
@@ -542,6 +768,9 @@ func FormatVariant(variant *pb.ProductVariant) EnhancedVariantInfo {
 		CreatedAt: formatTimestamp(variant.CreatedAt),
 		UpdatedAt: formatTimestamp(variant.UpdatedAt),
 	}
+	if variant.Metadata != nil {
+		formattedVariant.CustomMetadata = variant.Metadata.AsMap()
+	}
 
 	// Set inherited fields
 	formattedVariant.Description = variant.Description
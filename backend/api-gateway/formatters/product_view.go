@@ -0,0 +1,128 @@
+package formatters
+
+import "fmt"
+
+// View selects how much of a product is returned, through the view query
+// parameter of the product endpoints
+type View string
+
+const (
+	// ViewFull returns every field of ProductResponse
+	ViewFull View = "full"
+	// ViewSummary returns the fields a storefront list or card needs, without
+	// variants, specifications, reviews, SEO or shipping data
+	ViewSummary View = "summary"
+)
+
+// ParseView parses the view query parameter, defaulting to def when empty
+func ParseView(value string, def View) (View, error) {
+	switch View(value) {
+	case "":
+		return def, nil
+	case ViewFull, ViewSummary:
+		return View(value), nil
+	default:
+		return "", fmt.Errorf("invalid view %q: must be %q or %q", value, ViewSummary, ViewFull)
+	}
+}
+
+// ProductSummary represents the summary view of a product
+type ProductSummary struct {
+	ID               string                `json:"id"`
+	Title            string                `json:"title"`
+	Slug             string                `json:"slug"`
+	ShortDescription string                `json:"short_description,omitempty"`
+	SKU              string                `json:"sku"`
+	DefaultVariantID string                `json:"default_variant_id,omitempty"`
+	Price            *EnhancedPriceInfo    `json:"price"`
+	Image            *EnhancedImageInfo    `json:"image,omitempty"`
+	Brand            *BrandInfo            `json:"brand,omitempty"`
+	Rating           *RatingSummary        `json:"rating,omitempty"`
+	Inventory        *InventorySummary     `json:"inventory,omitempty"`
+	ProductType      string                `json:"product_type"`
+	VariantCount     int                   `json:"variant_count,omitempty"`
+	Subscription     *SubscriptionPlanInfo `json:"subscription,omitempty"`
+	Badges           []BadgeInfo           `json:"badges,omitempty"`
+}
+
+// RatingSummary represents the average rating of a product
+type RatingSummary struct {
+	AverageRating float64 `json:"average_rating"`
+	TotalReviews  int     `json:"total_reviews"`
+}
+
+// InventorySummary represents the stock status of a product
+type InventorySummary struct {
+	Status            string `json:"status"`
+	Availability      string `json:"availability"`
+	Available         bool   `json:"available"`
+	AvailableQuantity int    `json:"available_quantity"`
+}
+
+// ProductSummaryListResponse represents the summary view of a product list
+type ProductSummaryListResponse struct {
+	Products   []ProductSummary `json:"products"`
+	Total      int              `json:"total"`
+	Pagination PaginationInfo   `json:"pagination"`
+}
+
+// SummarizeProduct trims a formatted product down to its summary view. The
+// image is the thumbnail, or the first image when none is marked as such.
+func SummarizeProduct(product ProductResponse) ProductSummary {
+	summary := ProductSummary{
+		ID:               product.ID,
+		Title:            product.Title,
+		Slug:             product.Slug,
+		ShortDescription: product.ShortDescription,
+		SKU:              product.SKU,
+		DefaultVariantID: product.DefaultVariantID,
+		Price:            product.Price,
+		Brand:            product.Brand,
+		ProductType:      product.ProductType,
+		VariantCount:     len(product.Variants),
+		Subscription:     product.Subscription,
+		Badges:           product.Badges,
+	}
+
+	for i := range product.Images {
+		if product.Images[i].IsThumbnail {
+			summary.Image = &product.Images[i]
+			break
+		}
+	}
+	if summary.Image == nil && len(product.Images) > 0 {
+		summary.Image = &product.Images[0]
+	}
+
+	if product.Reviews != nil {
+		summary.Rating = &RatingSummary{
+			AverageRating: product.Reviews.Summary.AverageRating,
+			TotalReviews:  product.Reviews.Summary.TotalReviews,
+		}
+	}
+
+	if product.Inventory != nil {
+		summary.Inventory = &InventorySummary{
+			Status:            product.Inventory.Status,
+			Availability:      product.Inventory.Availability,
+			Available:         product.Inventory.Available,
+			AvailableQuantity: product.Inventory.AvailableQuantity,
+		}
+	}
+
+	return summary
+}
+
+// SummarizeProductList trims a formatted product list down to its summary view
+func SummarizeProductList(list ProductListResponse) ProductSummaryListResponse {
+	products := make([]ProductSummary, 0, len(list.Products))
+	for _, product := range list.Products {
+		products = append(products, SummarizeProduct(product))
+	}
+
+	return ProductSummaryListResponse{
+		Products:   products,
+		Total:      list.Total,
+		Pagination: list.Pagination,
+	}
+}
@@ -0,0 +1,171 @@
+package formatters
+
+// The public catalog API serves partners without credentials, so its
+// responses are built field by field from the formatted ones rather than
+// trimmed: fields added to ProductResponse stay private until they are added
+// here. Stock quantities, warehouses, reorder settings, publication metadata,
+// custom metadata and digital asset files are left out.
+
+// PublicProduct represents a product in the public catalog API
+type PublicProduct struct {
+	ID               string              `json:"id"`
+	Title            string              `json:"title"`
+	Slug             string              `json:"slug"`
+	ShortDescription string              `json:"short_description,omitempty"`
+	Description      string              `json:"description"`
+	SKU              string              `json:"sku"`
+	DefaultVariantID string              `json:"default_variant_id,omitempty"`
+	Price            *EnhancedPriceInfo  `json:"price"`
+	Attributes       []AttributeInfo     `json:"attributes"`
+	Variants         []PublicVariant     `json:"variants"`
+	Images           []EnhancedImageInfo `json:"images"`
+	Media            []PublicMedia       `json:"media,omitempty"`
+	Tags             []string            `json:"tags"`
+	Specifications   []SpecificationInfo `json:"specifications"`
+	Brand            *BrandInfo          `json:"brand,omitempty"`
+	Categories       []CategoryInfo      `json:"categories,omitempty"`
+	Rating           *RatingSummary      `json:"rating,omitempty"`
+	// Availability is the stock badge of the product, without quantities
+	Availability *PublicAvailability `json:"availability,omitempty"`
+	SEO          *EnhancedSEOInfo    `json:"seo,omitempty"`
+	ProductType  string              `json:"product_type"`
+	Badges       []BadgeInfo         `json:"badges,omitempty"`
+}
+
+// PublicVariant represents a variant of a product in the public catalog API
+type PublicVariant struct {
+	ID            string              `json:"id"`
+	SKU           string              `json:"sku"`
+	Title         string              `json:"title"`
+	Price         float64             `json:"price"`
+	DiscountPrice float64             `json:"discount_price,omitempty"`
+	Attributes    []AttributeInfo     `json:"attributes"`
+	Images        []EnhancedImageInfo `json:"images"`
+}
+
+// PublicMedia represents a processed video or 3D model of a product in the
+// public catalog API
+type PublicMedia struct {
+	Type         string `json:"type"`
+	Provider     string `json:"provider"`
+	URL          string `json:"url"`
+	EmbedURL     string `json:"embed_url"`
+	Format       string `json:"format,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	AltText      string `json:"alt_text"`
+	Position     int    `json:"position"`
+}
+
+// PublicAvailability represents the stock badge of a product: IN_STOCK,
+// LOW_STOCK, BACKORDER or OUT_OF_STOCK, and whether it can be ordered
+type PublicAvailability struct {
+	Availability string `json:"availability"`
+	Available    bool   `json:"available"`
+}
+
+// PublicProductListResponse represents a page of products in the public
+// catalog API
+type PublicProductListResponse struct {
+	Products   []PublicProduct `json:"products"`
+	Total      int             `json:"total"`
+	Pagination PaginationInfo  `json:"pagination"`
+}
+
+// PublicCategory represents a category in the public catalog API
+type PublicCategory struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Slug        string  `json:"slug,omitempty"`
+	Description string  `json:"description,omitempty"`
+	ParentID    *string `json:"parent_id,omitempty"`
+	Position    int     `json:"position"`
+}
+
+// PublicCategoryListResponse represents a page of categories in the public
+// catalog API
+type PublicCategoryListResponse struct {
+	Categories []PublicCategory `json:"categories"`
+	Total      int              `json:"total"`
+	Pagination PaginationInfo   `json:"pagination"`
+}
+
+// PublicizeProduct copies the public fields of a formatted product
+func PublicizeProduct(product ProductResponse) PublicProduct {
+	public := PublicProduct{
+		ID:               product.ID,
+		Title:            product.Title,
+		Slug:             product.Slug,
+		ShortDescription: product.ShortDescription,
+		Description:      product.Description,
+		SKU:              product.SKU,
+		DefaultVariantID: product.DefaultVariantID,
+		Price:            product.Price,
+		Attributes:       product.Attributes,
+		Variants:         make([]PublicVariant, len(product.Variants)),
+		Images:           product.Images,
+		Tags:             product.Tags,
+		Specifications:   product.Specifications,
+		Brand:            product.Brand,
+		Categories:       product.Categories,
+		SEO:              product.SEO,
+		ProductType:      product.ProductType,
+		Badges:           product.Badges,
+	}
+
+	for i, variant := range product.Variants {
+		public.Variants[i] = PublicVariant{
+			ID:            variant.ID,
+			SKU:           variant.SKU,
+			Title:         variant.Title,
+			Price:         variant.Price,
+			DiscountPrice: variant.DiscountPrice,
+			Attributes:    variant.Attributes,
+			Images:        variant.Images,
+		}
+	}
+
+	// Media still processing have no thumbnail and may fail
+	for _, media := range product.Media {
+		if media.Status != "ready" {
+			continue
+		}
+		public.Media = append(public.Media, PublicMedia{
+			Type:         media.Type,
+			Provider:     media.Provider,
+			URL:          media.URL,
+			EmbedURL:     media.EmbedURL,
+			Format:       media.Format,
+			ThumbnailURL: media.ThumbnailURL,
+			AltText:      media.AltText,
+			Position:     media.Position,
+		})
+	}
+
+	if product.Reviews != nil {
+		public.Rating = &RatingSummary{
+			AverageRating: product.Reviews.Summary.AverageRating,
+			TotalReviews:  product.Reviews.Summary.TotalReviews,
+		}
+	}
+
+	if product.Inventory != nil {
+		public.Availability = &PublicAvailability{
+			Availability: product.Inventory.Availability,
+			Available:    product.Inventory.Available,
+		}
+	}
+
+	return public
+}
+
+// PublicizeCategory copies the public fields of a formatted category
+func PublicizeCategory(category CategoryResponse) PublicCategory {
+	return PublicCategory{
+		ID:          category.ID,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Description: category.Description,
+		ParentID:    category.ParentID,
+		Position:    category.Position,
+	}
+}
@@ -0,0 +1,96 @@
+package formatters
+
+import (
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// QuestionInfo represents a customer question on a product with its answers
+type QuestionInfo struct {
+	ID         string       `json:"id"`
+	ProductID  string       `json:"product_id"`
+	AuthorName string       `json:"author_name"`
+	Body       string       `json:"body"`
+	Status     string       `json:"status"`
+	Answers    []AnswerInfo `json:"answers"`
+	CreatedAt  string       `json:"created_at"`
+	UpdatedAt  string       `json:"updated_at"`
+}
+
+// AnswerInfo represents the answer of an admin or seller to a question
+type AnswerInfo struct {
+	ID         string `json:"id"`
+	QuestionID string `json:"question_id"`
+	AuthorName string `json:"author_name"`
+	AuthorRole string `json:"author_role"`
+	Body       string `json:"body"`
+	Status     string `json:"status"`
+	Upvotes    int    `json:"upvotes"`
+	CreatedAt  string `json:"created_at"`
+	UpdatedAt  string `json:"updated_at"`
+}
+
+// QuestionListResponse represents a page of the questions on a product
+type QuestionListResponse struct {
+	Questions  []QuestionInfo `json:"questions"`
+	Total      int            `json:"total"`
+	Pagination PaginationInfo `json:"pagination"`
+}
+
+// FormatQuestion formats a question proto message into the desired response format
+func FormatQuestion(question *pb.ProductQuestion) QuestionInfo {
+	formatted := QuestionInfo{
+		ID:         question.Id,
+		ProductID:  question.ProductId,
+		AuthorName: question.AuthorName,
+		Body:       question.Body,
+		Status:     question.Status,
+		Answers:    make([]AnswerInfo, 0, len(question.Answers)),
+		CreatedAt:  formatTimestamp(question.CreatedAt),
+		UpdatedAt:  formatTimestamp(question.UpdatedAt),
+	}
+	for _, answer := range question.Answers {
+		formatted.Answers = append(formatted.Answers, FormatAnswer(answer))
+	}
+	return formatted
+}
+
+// FormatAnswer formats an answer proto message into the desired response format
+func FormatAnswer(answer *pb.ProductAnswer) AnswerInfo {
+	return AnswerInfo{
+		ID:         answer.Id,
+		QuestionID: answer.QuestionId,
+		AuthorName: answer.AuthorName,
+		AuthorRole: answer.AuthorRole,
+		Body:       answer.Body,
+		Status:     answer.Status,
+		Upvotes:    int(answer.Upvotes),
+		CreatedAt:  formatTimestamp(answer.CreatedAt),
+		UpdatedAt:  formatTimestamp(answer.UpdatedAt),
+	}
+}
+
+// FormatQuestions formats a list of question proto messages
+func FormatQuestions(questions []*pb.ProductQuestion) []QuestionInfo {
+	formatted := make([]QuestionInfo, 0, len(questions))
+	for _, question := range questions {
+		if question != nil {
+			formatted = append(formatted, FormatQuestion(question))
+		}
+	}
+	return formatted
+}
+
+// FormatQuestionList formats a page of questions
+func FormatQuestionList(resp *pb.ListProductQuestionsResponse, page, limit int) QuestionListResponse {
+	total := int(resp.Total)
+	return QuestionListResponse{
+		Questions: FormatQuestions(resp.Questions),
+		Total:     total,
+		Pagination: PaginationInfo{
+			CurrentPage: page,
+			TotalPages:  (total + limit - 1) / limit,
+			PerPage:     limit,
+			TotalItems:  total,
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package formatters
+
+import (
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// SavedSearchInfo represents a search a user saved with the alerts they
+// opted into
+type SavedSearchInfo struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Query            string   `json:"query"`
+	CategoryID       string   `json:"category_id,omitempty"`
+	BrandID          string   `json:"brand_id,omitempty"`
+	MinPrice         *float64 `json:"min_price,omitempty"`
+	MaxPrice         *float64 `json:"max_price,omitempty"`
+	AlertNewProducts bool     `json:"alert_new_products"`
+	PriceAlertBelow  *float64 `json:"price_alert_below,omitempty"`
+	LastEvaluatedAt  string   `json:"last_evaluated_at,omitempty"`
+	CreatedAt        string   `json:"created_at"`
+	UpdatedAt        string   `json:"updated_at"`
+}
+
+// SavedSearchListResponse represents the saved searches of a user
+type SavedSearchListResponse struct {
+	SavedSearches []SavedSearchInfo `json:"saved_searches"`
+}
+
+// FormatSavedSearch formats a saved search proto message into the desired
+// response format; zero prices are filters and alerts left unset
+func FormatSavedSearch(search *pb.SavedSearch) SavedSearchInfo {
+	formatted := SavedSearchInfo{
+		ID:               search.Id,
+		Name:             search.Name,
+		Query:            search.Query,
+		CategoryID:       search.CategoryId,
+		BrandID:          search.BrandId,
+		MinPrice:         optionalPrice(search.MinPrice),
+		MaxPrice:         optionalPrice(search.MaxPrice),
+		AlertNewProducts: search.AlertNewProducts,
+		PriceAlertBelow:  optionalPrice(search.PriceAlertBelow),
+		CreatedAt:        formatTimestamp(search.CreatedAt),
+		UpdatedAt:        formatTimestamp(search.UpdatedAt),
+	}
+	if search.LastEvaluatedAt != nil {
+		formatted.LastEvaluatedAt = formatTimestamp(search.LastEvaluatedAt)
+	}
+	return formatted
+}
+
+// FormatSavedSearchList formats the saved searches of a user
+func FormatSavedSearchList(resp *pb.ListSavedSearchesResponse) SavedSearchListResponse {
+	formatted := SavedSearchListResponse{SavedSearches: make([]SavedSearchInfo, 0, len(resp.SavedSearches))}
+	for _, search := range resp.SavedSearches {
+		formatted.SavedSearches = append(formatted.SavedSearches, FormatSavedSearch(search))
+	}
+	return formatted
+}
+
+func optionalPrice(price float64) *float64 {
+	if price == 0 {
+		return nil
+	}
+	return &price
+}
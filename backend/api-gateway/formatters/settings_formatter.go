@@ -0,0 +1,90 @@
+package formatters
+
+import (
+	"encoding/json"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// SettingResponse represents a store setting; Value is null when unset
+type SettingResponse struct {
+	Key         string          `json:"key"`
+	Value       json.RawMessage `json:"value"`
+	Public      bool            `json:"public"`
+	Description string          `json:"description"`
+	IsSet       bool            `json:"is_set"`
+	UpdatedBy   string          `json:"updated_by,omitempty"`
+	UpdatedAt   string          `json:"updated_at,omitempty"`
+}
+
+// StoreBrandingResponse represents the branding of the storefront
+type StoreBrandingResponse struct {
+	LogoURL        string `json:"logo_url,omitempty"`
+	FaviconURL     string `json:"favicon_url,omitempty"`
+	PrimaryColor   string `json:"primary_color,omitempty"`
+	SecondaryColor string `json:"secondary_color,omitempty"`
+}
+
+// StorefrontConfigResponse represents the public configuration the
+// storefront loads at boot
+type StorefrontConfigResponse struct {
+	StoreID          string                `json:"store_id"`
+	StoreName        string                `json:"store_name"`
+	DefaultCurrency  string                `json:"default_currency"`
+	DefaultLocale    string                `json:"default_locale"`
+	ActiveCurrencies []string              `json:"active_currencies"`
+	ActiveLocales    []string              `json:"active_locales"`
+	Branding         StoreBrandingResponse `json:"branding"`
+	SupportEmail     string                `json:"support_email,omitempty"`
+	SupportPhone     string                `json:"support_phone,omitempty"`
+}
+
+// FormatSetting converts a setting proto message into its response
+func FormatSetting(setting *pb.Setting) SettingResponse {
+	value := json.RawMessage("null")
+	if setting.IsSet {
+		value = json.RawMessage(setting.Value)
+	}
+	return SettingResponse{
+		Key:         setting.Key,
+		Value:       value,
+		Public:      setting.Public,
+		Description: setting.Description,
+		IsSet:       setting.IsSet,
+		UpdatedBy:   setting.UpdatedBy,
+		UpdatedAt:   formatTimestamp(setting.UpdatedAt),
+	}
+}
+
+// FormatSettings converts setting proto messages into their responses
+func FormatSettings(settings []*pb.Setting) []SettingResponse {
+	formatted := make([]SettingResponse, len(settings))
+	for i, setting := range settings {
+		formatted[i] = FormatSetting(setting)
+	}
+	return formatted
+}
+
+// FormatStorefrontConfig converts a storefront config proto message into its
+// response
+func FormatStorefrontConfig(config *pb.StorefrontConfig) StorefrontConfigResponse {
+	resp := StorefrontConfigResponse{
+		StoreID:          config.StoreId,
+		StoreName:        config.StoreName,
+		DefaultCurrency:  config.DefaultCurrency,
+		DefaultLocale:    config.DefaultLocale,
+		ActiveCurrencies: config.ActiveCurrencies,
+		ActiveLocales:    config.ActiveLocales,
+		SupportEmail:     config.SupportEmail,
+		SupportPhone:     config.SupportPhone,
+	}
+	if b := config.Branding; b != nil {
+		resp.Branding = StoreBrandingResponse{
+			LogoURL:        b.LogoUrl,
+			FaviconURL:     b.FaviconUrl,
+			PrimaryColor:   b.PrimaryColor,
+			SecondaryColor: b.SecondaryColor,
+		}
+	}
+	return resp
+}
@@ -4,9 +4,11 @@ go 1.24.0
 
 require (
 	github.com/gin-gonic/gin v1.10.0
+	github.com/go-redis/redis/v8 v8.11.5
 	github.com/golang-jwt/jwt v3.2.2+incompatible
 	github.com/joho/godotenv v1.5.1
 	github.com/louai60/e-commerce_project/backend/admin-service v0.0.0
+	github.com/louai60/e-commerce_project/backend/common v0.0.0
 	github.com/louai60/e-commerce_project/backend/inventory-service v0.0.0
 	github.com/louai60/e-commerce_project/backend/product-service v0.0.0
 	github.com/louai60/e-commerce_project/backend/shared v0.0.0
@@ -16,21 +18,24 @@ require (
 	google.golang.org/grpc v1.72.0
 )
 
+replace github.com/louai60/e-commerce_project/backend/common => ../common
+
 replace github.com/louai60/e-commerce_project/backend/shared => ../shared
 
 replace github.com/louai60/e-commerce_project/backend/inventory-service => ../inventory-service
 
 require (
+	github.com/andybalholm/brotli v1.2.0
 	github.com/golang/protobuf v1.5.4
 	github.com/google/uuid v1.6.0
+	github.com/graphql-go/graphql v0.8.1
+	github.com/graphql-go/handler v0.2.4
+	golang.org/x/sync v0.12.0
 )
 
 require (
 	github.com/cespare/xxhash/v2 v2.3.0 // indirect
 	github.com/dgryski/go-rendezvous v0.0.0-20200823014737-9f7001d12a5f // indirect
-	github.com/go-redis/redis/v8 v8.11.5 // indirect
-	github.com/graphql-go/graphql v0.8.1 // indirect
-	github.com/graphql-go/handler v0.2.4 // indirect
 )
 
 replace github.com/louai60/e-commerce_project/backend/product-service => ../product-service
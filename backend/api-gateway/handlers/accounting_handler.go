@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+)
+
+// ExportJournalRequest represents the JSON structure for exporting the
+// accounting journal of a period of whole days
+type ExportJournalRequest struct {
+	Format string `json:"format" binding:"required,oneof=csv xero quickbooks"`
+	// From and To are the first and last days of the period, YYYY-MM-DD;
+	// To must be before today
+	From string `json:"from" binding:"required,datetime=2006-01-02"`
+	To   string `json:"to" binding:"required,datetime=2006-01-02"`
+}
+
+// ExportJournal handles exporting the accounting journal of the current
+// store. A period already exported returns its first export, with a 200
+// instead of a 201.
+func (h *AdminHandler) ExportJournal(c *gin.Context) {
+	var req ExportJournalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	export, err := h.client.ExportJournal(c.Request.Context(), &adminpb.ExportJournalRequest{
+		Format: req.Format,
+		From:   req.From,
+		To:     req.To,
+	})
+	if err != nil {
+		handleGRPCError(c, err, "Failed to export journal", h.logger)
+		return
+	}
+
+	code := http.StatusOK
+	if export.Created {
+		code = http.StatusCreated
+	}
+	c.JSON(code, formatJournalExport(export))
+}
+
+// ListJournalExports handles listing the journal exports of the current
+// store, newest first, with fresh download links
+func (h *AdminHandler) ListJournalExports(c *gin.Context) {
+	resp, err := h.client.ListJournalExports(c.Request.Context(), &adminpb.ListJournalExportsRequest{
+		Format: c.Query("format"),
+	})
+	if err != nil {
+		handleGRPCError(c, err, "Failed to list journal exports", h.logger)
+		return
+	}
+
+	exports := make([]gin.H, len(resp.Exports))
+	for i, export := range resp.Exports {
+		exports[i] = formatJournalExport(export)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"exports": exports,
+		"total":   len(exports),
+	})
+}
+
+func formatJournalExport(export *adminpb.JournalExport) gin.H {
+	return gin.H{
+		"id":             export.Id,
+		"format":         export.Format,
+		"from":           export.From,
+		"to":             export.To,
+		"file_name":      export.FileName,
+		"entry_count":    export.EntryCount,
+		"url":            export.Url,
+		"url_expires_at": export.UrlExpiresAt,
+		"created_at":     export.CreatedAt,
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+)
+
+// ListActivity handles listing the recent catalog changes, inventory
+// adjustments and user events of the current store
+func (h *AdminHandler) ListActivity(c *gin.Context) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive number"})
+		return
+	}
+
+	res, err := h.client.ListActivity(c.Request.Context(), &adminpb.ListActivityRequest{
+		Cursor:  c.Query("cursor"),
+		Limit:   int32(limit),
+		Sources: c.QueryArray("source"),
+	})
+	if err != nil {
+		handleGRPCError(c, err, "Failed to list activity", h.logger)
+		return
+	}
+
+	entries := make([]gin.H, len(res.Entries))
+	for i, entry := range res.Entries {
+		entries[i] = gin.H{
+			"source":      entry.Source,
+			"id":          entry.Id,
+			"type":        entry.Type,
+			"subject_id":  entry.SubjectId,
+			"subject":     entry.Subject,
+			"actor_id":    entry.ActorId,
+			"summary":     entry.Summary,
+			"occurred_at": entry.OccurredAt,
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"entries":     entries,
+		"next_cursor": res.NextCursor,
+		"degraded":    res.Degraded,
+		"failures":    dependencyFailures(res.Failures),
+	})
+}
+
+// TouchProductPresence handles a heartbeat of the signed in admin editing a
+// product, returning the admins editing it
+func (h *AdminHandler) TouchProductPresence(c *gin.Context) {
+	res, err := h.client.TouchPresence(c.Request.Context(), &adminpb.TouchPresenceRequest{
+		ProductId: c.Param("id"),
+		AdminId:   c.GetString("user_id"),
+		AdminName: c.GetString("user_name"),
+	})
+	if err != nil {
+		handleGRPCError(c, err, "Failed to update presence", h.logger)
+		return
+	}
+	c.JSON(http.StatusOK, formatPresence(res))
+}
+
+// GetProductPresence handles listing the admins editing a product
+func (h *AdminHandler) GetProductPresence(c *gin.Context) {
+	res, err := h.client.GetPresence(c.Request.Context(), &adminpb.GetPresenceRequest{
+		ProductId: c.Param("id"),
+	})
+	if err != nil {
+		handleGRPCError(c, err, "Failed to get presence", h.logger)
+		return
+	}
+	c.JSON(http.StatusOK, formatPresence(res))
+}
+
+// LeaveProductPresence handles the signed in admin closing a product
+func (h *AdminHandler) LeaveProductPresence(c *gin.Context) {
+	res, err := h.client.LeavePresence(c.Request.Context(), &adminpb.LeavePresenceRequest{
+		ProductId: c.Param("id"),
+		AdminId:   c.GetString("user_id"),
+	})
+	if err != nil {
+		handleGRPCError(c, err, "Failed to update presence", h.logger)
+		return
+	}
+	c.JSON(http.StatusOK, formatPresence(res))
+}
+
+func formatPresence(res *adminpb.PresenceResponse) gin.H {
+	editors := make([]gin.H, len(res.Editors))
+	for i, editor := range res.Editors {
+		editors[i] = gin.H{
+			"admin_id":   editor.AdminId,
+			"admin_name": editor.AdminName,
+			"since":      editor.Since,
+			"last_seen":  editor.LastSeen,
+		}
+	}
+	return gin.H{
+		"product_id":  res.ProductId,
+		"editors":     editors,
+		"ttl_seconds": res.TtlSeconds,
+	}
+}
+
+func dependencyFailures(failures []*adminpb.DependencyFailure) []gin.H {
+	result := make([]gin.H, len(failures))
+	for i, failure := range failures {
+		result[i] = gin.H{"service": failure.Service, "error": failure.Error}
+	}
+	return result
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// AvailabilityPolicyRequest represents the JSON structure of the availability
+// thresholds of a product, which decide its stock badge: IN_STOCK,
+// LOW_STOCK, BACKORDER or OUT_OF_STOCK
+type AvailabilityPolicyRequest struct {
+	// LowStockThreshold is the sellable quantity at or below which stock is
+	// low; null uses the reorder point of the product's inventory items
+	LowStockThreshold *int32 `json:"low_stock_threshold" binding:"omitempty,min=0"`
+	// OutOfStockThreshold is the sellable quantity at or below which the
+	// product is out of stock
+	OutOfStockThreshold int32 `json:"out_of_stock_threshold" binding:"min=0"`
+	// AllowBackorder shows out of stock products as BACKORDER
+	AllowBackorder bool `json:"allow_backorder"`
+}
+
+// GetAvailabilityPolicy returns the availability thresholds of a product
+func (h *InventoryHandler) GetAvailabilityPolicy(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	policy, err := h.client.GetAvailabilityPolicy(c.Request.Context(), c.Param("product_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get availability policy")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatAvailabilityPolicy(policy))
+}
+
+// SetAvailabilityPolicy creates or replaces the availability thresholds of a
+// product
+func (h *InventoryHandler) SetAvailabilityPolicy(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req AvailabilityPolicyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	policy := &inventorypb.AvailabilityPolicy{
+		ProductId:           c.Param("product_id"),
+		OutOfStockThreshold: req.OutOfStockThreshold,
+		AllowBackorder:      req.AllowBackorder,
+	}
+	if req.LowStockThreshold != nil {
+		policy.LowStockThreshold = wrapperspb.Int32(*req.LowStockThreshold)
+	}
+
+	policy, err := h.client.SetAvailabilityPolicy(c.Request.Context(), policy)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set availability policy")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatAvailabilityPolicy(policy))
+}
+
+// DeleteAvailabilityPolicy puts a product back on the default availability
+// thresholds
+func (h *InventoryHandler) DeleteAvailabilityPolicy(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	if err := h.client.DeleteAvailabilityPolicy(c.Request.Context(), c.Param("product_id")); err != nil {
+		h.handleGRPCError(c, err, "Failed to delete availability policy")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func formatAvailabilityPolicy(policy *inventorypb.AvailabilityPolicy) gin.H {
+	result := gin.H{
+		"product_id":             policy.ProductId,
+		"low_stock_threshold":    nil,
+		"out_of_stock_threshold": policy.OutOfStockThreshold,
+		"allow_backorder":        policy.AllowBackorder,
+	}
+	if policy.LowStockThreshold != nil {
+		result["low_stock_threshold"] = policy.LowStockThreshold.Value
+	}
+	if policy.UpdatedAt != nil {
+		result["updated_at"] = formatTimestamp(policy.UpdatedAt)
+	}
+	return result
+}
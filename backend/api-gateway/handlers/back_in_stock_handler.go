@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// BackInStockRequest represents the JSON structure for subscribing to an
+// out-of-stock product. Signed-in users are emailed at the address of their
+// account unless they give another one.
+type BackInStockRequest struct {
+	Email     string `json:"email" binding:"omitempty,email"`
+	VariantID string `json:"variant_id"`
+}
+
+// SubscribeBackInStock subscribes an email to an out-of-stock product, or
+// one of its variants. Subscribers are emailed once when it is back in
+// stock; subscribing to a product in stock is refused.
+func (h *InventoryHandler) SubscribeBackInStock(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req BackInStockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	email := req.Email
+	if email == "" {
+		email = c.GetString("user_email")
+	}
+	if email == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "email is required"})
+		return
+	}
+
+	sub, err := h.client.SubscribeBackInStock(c.Request.Context(), &inventorypb.SubscribeBackInStockRequest{
+		ProductId: c.Param("id"),
+		VariantId: req.VariantID,
+		Email:     email,
+		UserId:    c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to subscribe back in stock")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatBackInStockSubscription(sub))
+}
+
+// ListMyBackInStockSubscriptions lists the back-in-stock subscriptions of
+// the current customer
+func (h *InventoryHandler) ListMyBackInStockSubscriptions(c *gin.Context) {
+	h.listBackInStockSubscriptions(c, c.GetString("user_id"))
+}
+
+// ListAllBackInStockSubscriptions lists back-in-stock subscriptions,
+// optionally filtered by user_id and product_id
+func (h *InventoryHandler) ListAllBackInStockSubscriptions(c *gin.Context) {
+	h.listBackInStockSubscriptions(c, c.Query("user_id"))
+}
+
+func (h *InventoryHandler) listBackInStockSubscriptions(c *gin.Context, userID string) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.ListBackInStockSubscriptions(c.Request.Context(), &inventorypb.ListBackInStockSubscriptionsRequest{
+		UserId:      userID,
+		ProductId:   c.Query("product_id"),
+		PendingOnly: c.Query("pending") == "true",
+		Page:        int32(page),
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list back-in-stock subscriptions")
+		return
+	}
+
+	subscriptions := make([]gin.H, len(resp.Subscriptions))
+	for i, sub := range resp.Subscriptions {
+		subscriptions[i] = formatBackInStockSubscription(sub)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"subscriptions": subscriptions,
+		"total":         resp.Total,
+		"page":          page,
+		"limit":         limit,
+	})
+}
+
+// DeleteMyBackInStockSubscription removes a back-in-stock subscription of
+// the current customer
+func (h *InventoryHandler) DeleteMyBackInStockSubscription(c *gin.Context) {
+	h.deleteBackInStockSubscription(c, c.GetString("user_id"))
+}
+
+// DeleteBackInStockSubscription removes any back-in-stock subscription
+func (h *InventoryHandler) DeleteBackInStockSubscription(c *gin.Context) {
+	h.deleteBackInStockSubscription(c, "")
+}
+
+func (h *InventoryHandler) deleteBackInStockSubscription(c *gin.Context, userID string) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	if err := h.client.DeleteBackInStockSubscription(c.Request.Context(), c.Param("id"), userID); err != nil {
+		h.handleGRPCError(c, err, "Failed to delete back-in-stock subscription")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func formatBackInStockSubscription(sub *inventorypb.BackInStockSubscription) gin.H {
+	result := gin.H{
+		"id":         sub.Id,
+		"product_id": sub.ProductId,
+		"email":      sub.Email,
+		"status":     "pending",
+		"created_at": formatTimestamp(sub.CreatedAt),
+	}
+	if sub.VariantId != "" {
+		result["variant_id"] = sub.VariantId
+	}
+	if sub.UserId != "" {
+		result["user_id"] = sub.UserId
+	}
+	if sub.NotifiedAt != nil {
+		result["status"] = "notified"
+		result["notified_at"] = formatTimestamp(sub.NotifiedAt)
+	}
+	return result
+}
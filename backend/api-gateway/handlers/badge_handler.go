@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// BadgeRuleRequest represents the JSON structure of a badge of the store.
+// Days apply to new badges, percent to bestseller badges and product IDs to
+// manual badges.
+type BadgeRuleRequest struct {
+	Label      string   `json:"label" binding:"required,max=50"`
+	Color      string   `json:"color" binding:"max=20"`
+	Kind       string   `json:"kind" binding:"required,oneof=new sale bestseller manual"`
+	Days       int32    `json:"days" binding:"gte=0"`
+	Percent    float64  `json:"percent" binding:"gte=0,lte=100"`
+	ProductIDs []string `json:"product_ids"`
+	Priority   int32    `json:"priority"`
+	Active     *bool    `json:"active"`
+}
+
+// ListBadgeRules lists the badges of the current store: the built-in New,
+// Sale and Bestseller badges as overridden by the store, and its custom ones
+func (h *ProductHandler) ListBadgeRules(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListBadgeRules(c.Request.Context(), &pb.ListBadgeRulesRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list badge rules")
+		return
+	}
+
+	rules := make([]gin.H, len(resp.Rules))
+	for i, rule := range resp.Rules {
+		rules[i] = formatBadgeRule(rule)
+	}
+	c.JSON(http.StatusOK, gin.H{"badges": rules})
+}
+
+// SaveBadgeRule creates or replaces a badge of the current store; the code of
+// a built-in badge overrides it. Products show the change once their badges
+// are recomputed, which starts right away.
+func (h *ProductHandler) SaveBadgeRule(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req BadgeRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	active := true
+	if req.Active != nil {
+		active = *req.Active
+	}
+
+	rule, err := h.client.SaveBadgeRule(c.Request.Context(), &pb.SaveBadgeRuleRequest{
+		Code:       c.Param("code"),
+		Label:      req.Label,
+		Color:      req.Color,
+		Kind:       req.Kind,
+		Days:       req.Days,
+		Percent:    req.Percent,
+		ProductIds: req.ProductIDs,
+		Priority:   req.Priority,
+		Active:     active,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to save badge rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatBadgeRule(rule))
+}
+
+// DeleteBadgeRule deletes a custom badge of the current store, or resets a
+// built-in badge to its defaults
+func (h *ProductHandler) DeleteBadgeRule(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteBadgeRule(c.Request.Context(), &pb.DeleteBadgeRuleRequest{
+		Code: c.Param("code"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete badge rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// RecomputeBadges recomputes the badges of every product of the current
+// store right away
+func (h *ProductHandler) RecomputeBadges(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.RecomputeBadges(c.Request.Context(), &pb.RecomputeBadgesRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to recompute badges")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"products": resp.Products, "badged": resp.Badged})
+}
+
+func formatBadgeRule(rule *pb.BadgeRule) gin.H {
+	return gin.H{
+		"code":        rule.Code,
+		"label":       rule.Label,
+		"color":       rule.Color,
+		"kind":        rule.Kind,
+		"days":        rule.Days,
+		"percent":     rule.Percent,
+		"product_ids": rule.ProductIds,
+		"priority":    rule.Priority,
+		"active":      rule.Active,
+		"built_in":    rule.BuiltIn,
+		"updated_at":  formatTimestamp(rule.UpdatedAt),
+	}
+}
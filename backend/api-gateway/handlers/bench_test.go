@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/clients"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/internal/contracttest"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// The benchmarks measure the gateway side of the hot paths: request parsing,
+// the gRPC round trip to an in-memory server and response formatting. The
+// service side is measured with the load profiles in backend/loadtest.
+//
+//	go test ./handlers -run '^$' -bench . -benchmem
+
+func benchmarkRouter(b *testing.B) *gin.Engine {
+	server := contracttest.NewServer(b, "testdata/contracts/benchmarks.json")
+	conn := server.Dial(b)
+
+	productHandler := NewProductHandler(productpb.NewProductServiceClient(conn), zap.NewNop())
+	inventoryHandler := NewInventoryHandler(clients.NewInventoryClientFromConn(conn, zap.NewNop()), zap.NewNop())
+
+	router := gin.New()
+	router.GET("/products", productHandler.ListProducts)
+	router.GET("/products/:id", productHandler.GetProduct)
+	router.POST("/inventory/check-bulk", inventoryHandler.CheckAvailabilityBulk)
+	return router
+}
+
+func runBenchmark(b *testing.B, router *gin.Engine, method, target, body string) {
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if w := serve(router, method, target, body); w.Code != http.StatusOK {
+			b.Fatalf("%s %s status = %d, body = %s", method, target, w.Code, w.Body)
+		}
+	}
+}
+
+func BenchmarkGetProduct(b *testing.B) {
+	runBenchmark(b, benchmarkRouter(b), http.MethodGet, "/products/bench-01", "")
+}
+
+func BenchmarkListProducts(b *testing.B) {
+	runBenchmark(b, benchmarkRouter(b), http.MethodGet, "/products?page=1&limit=20", "")
+}
+
+func BenchmarkCheckAvailabilityBulk(b *testing.B) {
+	body := `{"items":[` +
+		`{"sku":"BENCH-001","quantity":2},{"sku":"BENCH-002","quantity":2},{"sku":"BENCH-003","quantity":2},` +
+		`{"sku":"BENCH-004","quantity":2},{"sku":"BENCH-005","quantity":2},{"sku":"BENCH-006","quantity":2},` +
+		`{"sku":"BENCH-007","quantity":2},{"sku":"BENCH-008","quantity":2},{"sku":"BENCH-009","quantity":2},` +
+		`{"sku":"BENCH-010","quantity":2}]}`
+	runBenchmark(b, benchmarkRouter(b), http.MethodPost, "/inventory/check-bulk", body)
+}
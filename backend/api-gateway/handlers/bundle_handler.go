@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/clients"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// BundleRequest represents the JSON structure for bundle creation
+type BundleRequest struct {
+	Bundle struct {
+		Title            string                         `json:"title" binding:"required"`
+		Slug             string                         `json:"slug" binding:"required"`
+		Description      string                         `json:"description"`
+		ShortDescription string                         `json:"short_description"`
+		SKU              string                         `json:"sku"`
+		IsPublished      bool                           `json:"is_published"`
+		BrandID          *string                        `json:"brand_id,omitempty"`
+		Images           []formatters.EnhancedImageInfo `json:"images,omitempty"`
+		Categories       []formatters.CategoryInfo      `json:"categories,omitempty"`
+		Tags             []string                       `json:"tags,omitempty"`
+		PriceOverride    *float64                       `json:"price_override,omitempty"`
+		Components       []struct {
+			SKU      string `json:"sku" binding:"required"`
+			Quantity int    `json:"quantity" binding:"required,min=1"`
+		} `json:"components" binding:"required,min=1,dive"`
+	} `json:"bundle" binding:"required"`
+}
+
+// toProto converts the request body into a bundle creation request
+func (r *BundleRequest) toProto() *pb.CreateBundleRequest {
+	product := &pb.Product{
+		Title:            r.Bundle.Title,
+		Slug:             r.Bundle.Slug,
+		Description:      r.Bundle.Description,
+		ShortDescription: r.Bundle.ShortDescription,
+		Sku:              r.Bundle.SKU,
+		IsPublished:      r.Bundle.IsPublished,
+	}
+	if r.Bundle.BrandID != nil && *r.Bundle.BrandID != "" {
+		product.BrandId = wrapperspb.String(*r.Bundle.BrandID)
+	}
+	for _, img := range r.Bundle.Images {
+		product.Images = append(product.Images, &pb.ProductImage{
+			Url:      img.URL,
+			AltText:  img.AltText,
+			Position: int32(img.Position),
+		})
+	}
+	for _, category := range r.Bundle.Categories {
+		product.Categories = append(product.Categories, &pb.Category{
+			Id:   category.ID,
+			Name: category.Name,
+			Slug: category.Slug,
+		})
+	}
+	for _, tag := range r.Bundle.Tags {
+		product.Tags = append(product.Tags, &pb.ProductTag{Tag: tag})
+	}
+
+	req := &pb.CreateBundleRequest{Product: product}
+	for _, component := range r.Bundle.Components {
+		req.Components = append(req.Components, &pb.BundleComponent{
+			Sku:      component.SKU,
+			Quantity: int32(component.Quantity),
+		})
+	}
+	if r.Bundle.PriceOverride != nil {
+		req.PriceOverride = wrapperspb.Double(*r.Bundle.PriceOverride)
+	}
+	return req
+}
+
+// CreateBundle handles creating a bundle product composed of existing SKUs.
+// Bundles have no inventory of their own, so no inventory item is created.
+func (h *ProductHandler) CreateBundle(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req BundleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.CreateBundle(c.Request.Context(), req.toProto())
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create bundle")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatters.FormatProduct(resp))
+}
+
+// bundleInventory derives the stock of a bundle from its components: the number
+// of complete sets that can be assembled from the available component stock.
+func bundleInventory(ctx context.Context, client *clients.InventoryClient, bundle *formatters.BundleInfo) (*formatters.EnhancedInventoryInfo, error) {
+	lines := make([]*inventorypb.BulkAvailabilityLine, len(bundle.Components))
+	for i, component := range bundle.Components {
+		lines[i] = &inventorypb.BulkAvailabilityLine{
+			Sku:      component.SKU,
+			Quantity: int32(component.Quantity),
+		}
+	}
+
+	resp, err := client.CheckAvailabilityBulk(ctx, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	sets := -1
+	badges := make([]string, len(resp.Lines))
+	components := make([]formatters.BundleComponentStockInfo, len(resp.Lines))
+	for i, line := range resp.Lines {
+		badges[i] = line.Availability
+		componentSets := 0
+		if line.RequestedQuantity > 0 {
+			componentSets = int(line.AvailableQuantity / line.RequestedQuantity)
+		}
+		if sets < 0 || componentSets < sets {
+			sets = componentSets
+		}
+
+		components[i] = formatters.BundleComponentStockInfo{
+			SKU:               line.Sku,
+			QuantityPerBundle: int(line.RequestedQuantity),
+			AvailableQuantity: int(line.AvailableQuantity),
+			AvailableSets:     componentSets,
+		}
+	}
+	if sets < 0 {
+		sets = 0
+	}
+
+	// A bundle is only as available as its least available component
+	badge := availability.Least(badges...)
+	if sets == 0 && badge != availability.Backorder {
+		badge = availability.OutOfStock
+	}
+
+	status := "IN_STOCK"
+	if sets == 0 {
+		status = "OUT_OF_STOCK"
+	}
+
+	return &formatters.EnhancedInventoryInfo{
+		Status:            status,
+		Availability:      badge,
+		Available:         availability.Purchasable(badge),
+		Quantity:          sets, // For backward compatibility
+		TotalQuantity:     sets,
+		AvailableQuantity: sets,
+		BundleComponents:  components,
+	}, nil
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// FlushCacheNamespace handles removing the cached catalog entries of a
+// namespace (product, category or brand). The flush applies to every store,
+// so it is restricted to admins of the default store.
+func (h *ProductHandler) FlushCacheNamespace(c *gin.Context) {
+	if tenant.FromContext(c.Request.Context()) != tenant.DefaultTenantID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "caches can only be flushed from the default store"})
+		return
+	}
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.FlushCacheNamespace(c.Request.Context(), &pb.FlushCacheNamespaceRequest{
+		Namespace: c.Param("namespace"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to flush cache namespace")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"namespace": resp.Namespace,
+		"version":   resp.Version,
+	})
+}
+
+// ListCacheSchedules handles listing the configured cache schedules with
+// their next and latest runs
+func (h *ProductHandler) ListCacheSchedules(c *gin.Context) {
+	if tenant.FromContext(c.Request.Context()) != tenant.DefaultTenantID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "cache schedules can only be managed from the default store"})
+		return
+	}
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListCacheSchedules(c.Request.Context(), &pb.ListCacheSchedulesRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list cache schedules")
+		return
+	}
+
+	schedules := make([]gin.H, len(resp.Schedules))
+	for i, schedule := range resp.Schedules {
+		warm := make([]gin.H, len(schedule.Warm))
+		for j, query := range schedule.Warm {
+			warm[j] = gin.H{
+				"type":    query.Type,
+				"target":  query.Target,
+				"channel": query.Channel,
+				"page":    query.Page,
+				"limit":   query.Limit,
+			}
+		}
+		schedules[i] = gin.H{
+			"name":        schedule.Name,
+			"namespace":   schedule.Namespace,
+			"at":          schedule.At,
+			"days":        schedule.Days,
+			"timezone":    schedule.Timezone,
+			"invalidate":  schedule.Invalidate,
+			"warm":        warm,
+			"next_run_at": formatTimestamp(schedule.NextRunAt),
+			"last_run":    formatCacheScheduleRun(schedule.LastRun),
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"schedules": schedules})
+}
+
+// ListCacheScheduleRuns handles listing the latest cache schedule runs,
+// optionally of a single schedule
+func (h *ProductHandler) ListCacheScheduleRuns(c *gin.Context) {
+	if tenant.FromContext(c.Request.Context()) != tenant.DefaultTenantID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "cache schedules can only be managed from the default store"})
+		return
+	}
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive number"})
+		return
+	}
+
+	resp, err := h.client.ListCacheScheduleRuns(c.Request.Context(), &pb.ListCacheScheduleRunsRequest{
+		ScheduleName: c.Query("schedule"),
+		Limit:        int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list cache schedule runs")
+		return
+	}
+
+	runs := make([]gin.H, len(resp.Runs))
+	for i, run := range resp.Runs {
+		runs[i] = formatCacheScheduleRun(run)
+	}
+	c.JSON(http.StatusOK, gin.H{"runs": runs})
+}
+
+// RunCacheSchedule handles running a cache schedule now, such as after a
+// bulk import
+func (h *ProductHandler) RunCacheSchedule(c *gin.Context) {
+	if tenant.FromContext(c.Request.Context()) != tenant.DefaultTenantID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "cache schedules can only be managed from the default store"})
+		return
+	}
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	run, err := h.client.RunCacheSchedule(c.Request.Context(), &pb.RunCacheScheduleRequest{
+		Name: c.Param("name"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to run cache schedule")
+		return
+	}
+	c.JSON(http.StatusOK, formatCacheScheduleRun(run))
+}
+
+func formatCacheScheduleRun(run *pb.CacheScheduleRun) gin.H {
+	if run == nil {
+		return nil
+	}
+	return gin.H{
+		"id":            run.Id,
+		"schedule_name": run.ScheduleName,
+		"namespace":     run.Namespace,
+		"trigger":       run.Trigger,
+		"scheduled_for": formatTimestamp(run.ScheduledFor),
+		"status":        run.Status,
+		"invalidated":   run.Invalidated,
+		"warmed":        run.Warmed,
+		"failed":        run.Failed,
+		"error":         run.Error,
+		"started_at":    formatTimestamp(run.StartedAt),
+		"finished_at":   formatTimestamp(run.FinishedAt),
+	}
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// CartReminderStatsResponse counts the reminders of abandoned carts sent over
+// a period and the orders that followed them
+type CartReminderStatsResponse struct {
+	Reminded       int64   `json:"reminded"`
+	Converted      int64   `json:"converted"`
+	ConversionRate float64 `json:"conversion_rate"`
+}
+
+// GetCartReminderStats reports how many abandoned cart reminders were sent
+// between from and to (RFC3339, the last 30 days by default) and how many
+// led to an order
+func (h *UserHandler) GetCartReminderStats(c *gin.Context) {
+	resp, err := h.client.GetCartReminderStats(c.Request.Context(), &pb.GetCartReminderStatsRequest{
+		From: c.Query("from"),
+		To:   c.Query("to"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get cart reminder stats")
+		return
+	}
+
+	c.JSON(http.StatusOK, CartReminderStatsResponse{
+		Reminded:       resp.Reminded,
+		Converted:      resp.Converted,
+		ConversionRate: resp.ConversionRate,
+	})
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// GetCatalogQualityReport handles the catalog quality report of the current
+// store: score totals and the products to fix first, lowest scores first
+func (h *ProductHandler) GetCatalogQualityReport(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive number"})
+		return
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "offset must not be negative"})
+		return
+	}
+
+	req := &pb.GetCatalogQualityReportRequest{
+		Issue:  c.Query("issue"),
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	}
+	if value, ok := c.GetQuery("max_score"); ok {
+		maxScore, err := strconv.Atoi(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "max_score must be a number"})
+			return
+		}
+		req.MaxScore = wrapperspb.Int32(int32(maxScore))
+	}
+
+	resp, err := h.client.GetCatalogQualityReport(c.Request.Context(), req)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get catalog quality report")
+		return
+	}
+
+	products := make([]gin.H, len(resp.Products))
+	for i, score := range resp.Products {
+		products[i] = formatProductQualityScore(score)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"scored_count":   resp.ScoredCount,
+		"unscored_count": resp.UnscoredCount,
+		"average_score":  resp.AverageScore,
+		"issue_counts":   resp.IssueCounts,
+		"products":       products,
+		"total":          resp.Total,
+	})
+}
+
+// GetProductQualityScore handles fetching the quality score of a product
+func (h *ProductHandler) GetProductQualityScore(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.GetProductQualityScore(c.Request.Context(), &pb.GetProductQualityScoreRequest{ProductId: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get product quality score")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatProductQualityScore(resp))
+}
+
+// RecomputeCatalogQuality handles rescoring every product of the current store
+func (h *ProductHandler) RecomputeCatalogQuality(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.RecomputeCatalogQuality(c.Request.Context(), &pb.RecomputeCatalogQualityRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to recompute catalog quality")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"scored": resp.Scored, "failed": resp.Failed})
+}
+
+func formatProductQualityScore(score *pb.ProductQualityScore) gin.H {
+	return gin.H{
+		"product_id":  score.ProductId,
+		"title":       score.Title,
+		"sku":         score.Sku,
+		"score":       score.Score,
+		"issues":      score.Issues,
+		"computed_at": formatTimestamp(score.ComputedAt),
+	}
+}
@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// CategoryAttributeRequest represents the JSON structure of an attribute a
+// category defines for its products and those of its descendants
+type CategoryAttributeRequest struct {
+	Name string `json:"name" binding:"required,max=100"`
+	// Required attributes must be given when creating a product in the
+	// category, unless they have a default value
+	Required bool `json:"required"`
+	// Filterable attributes are offered as facets of the category
+	Filterable bool `json:"filterable"`
+	// AllowedValues restricts the values of the attribute; empty allows any
+	// value
+	AllowedValues []string `json:"allowed_values"`
+	// DefaultValue is given to products created without a value
+	DefaultValue string `json:"default_value" binding:"max=255"`
+}
+
+func (r CategoryAttributeRequest) toProto() *pb.CategoryAttribute {
+	return &pb.CategoryAttribute{
+		Name:          r.Name,
+		Required:      r.Required,
+		Filterable:    r.Filterable,
+		AllowedValues: r.AllowedValues,
+		DefaultValue:  r.DefaultValue,
+	}
+}
+
+// ListCategoryAttributes handles listing the attributes of a category;
+// inherited=true adds those of its ancestors
+func (h *ProductHandler) ListCategoryAttributes(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	inherited, err := strconv.ParseBool(c.DefaultQuery("inherited", "false"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid inherited parameter"})
+		return
+	}
+
+	resp, err := h.client.ListCategoryAttributes(c.Request.Context(), &pb.ListCategoryAttributesRequest{
+		CategoryId:       c.Param("id"),
+		IncludeInherited: inherited,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list category attributes")
+		return
+	}
+
+	list := make([]gin.H, len(resp.Attributes))
+	for i, attribute := range resp.Attributes {
+		list[i] = formatCategoryAttribute(attribute)
+	}
+	c.JSON(http.StatusOK, gin.H{"attributes": list})
+}
+
+// CreateCategoryAttribute handles adding an attribute to a category
+func (h *ProductHandler) CreateCategoryAttribute(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req CategoryAttributeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	attribute := req.toProto()
+	attribute.CategoryId = c.Param("id")
+	resp, err := h.client.CreateCategoryAttribute(c.Request.Context(), &pb.CreateCategoryAttributeRequest{Attribute: attribute})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create category attribute")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatCategoryAttribute(resp))
+}
+
+// UpdateCategoryAttribute handles replacing the definition of an attribute of
+// a category
+func (h *ProductHandler) UpdateCategoryAttribute(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req CategoryAttributeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !h.attributeOfCategory(c) {
+		return
+	}
+
+	attribute := req.toProto()
+	attribute.Id = c.Param("attribute_id")
+	resp, err := h.client.UpdateCategoryAttribute(c.Request.Context(), &pb.UpdateCategoryAttributeRequest{Attribute: attribute})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to update category attribute")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatCategoryAttribute(resp))
+}
+
+// DeleteCategoryAttribute handles removing an attribute from a category. The
+// values products have for it are kept.
+func (h *ProductHandler) DeleteCategoryAttribute(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	if !h.attributeOfCategory(c) {
+		return
+	}
+
+	if _, err := h.client.DeleteCategoryAttribute(c.Request.Context(), &pb.DeleteCategoryAttributeRequest{
+		Id: c.Param("attribute_id"),
+	}); err != nil {
+		h.handleGRPCError(c, err, "Failed to delete category attribute")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// GetCategoryFacets handles listing the filterable attributes of a category
+// with the number of products per value
+func (h *ProductHandler) GetCategoryFacets(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.GetCategoryFacets(c.Request.Context(), &pb.GetCategoryFacetsRequest{
+		CategoryId: c.Param("id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get category facets")
+		return
+	}
+
+	facets := make([]gin.H, len(resp.Facets))
+	for i, facet := range resp.Facets {
+		values := make([]gin.H, len(facet.Values))
+		for j, value := range facet.Values {
+			values[j] = gin.H{"value": value.Value, "count": value.Count}
+		}
+		facets[i] = gin.H{"name": facet.Name, "values": values}
+	}
+	c.JSON(http.StatusOK, gin.H{"facets": facets})
+}
+
+// attributeOfCategory checks that the attribute of the route is defined by
+// the category of the route, answering 404 otherwise
+func (h *ProductHandler) attributeOfCategory(c *gin.Context) bool {
+	resp, err := h.client.ListCategoryAttributes(c.Request.Context(), &pb.ListCategoryAttributesRequest{
+		CategoryId: c.Param("id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list category attributes")
+		return false
+	}
+	for _, attribute := range resp.Attributes {
+		if attribute.Id == c.Param("attribute_id") {
+			return true
+		}
+	}
+	c.JSON(http.StatusNotFound, gin.H{"error": "category attribute not found"})
+	return false
+}
+
+func formatCategoryAttribute(attribute *pb.CategoryAttribute) gin.H {
+	allowed := attribute.AllowedValues
+	if allowed == nil {
+		allowed = []string{}
+	}
+	return gin.H{
+		"id":             attribute.Id,
+		"category_id":    attribute.CategoryId,
+		"name":           attribute.Name,
+		"required":       attribute.Required,
+		"filterable":     attribute.Filterable,
+		"allowed_values": allowed,
+		"default_value":  attribute.DefaultValue,
+		"position":       attribute.Position,
+		"inherited":      attribute.Inherited,
+		"created_at":     formatTimestamp(attribute.CreatedAt),
+		"updated_at":     formatTimestamp(attribute.UpdatedAt),
+	}
+}
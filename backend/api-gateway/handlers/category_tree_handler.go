@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// MoveCategoryRequest represents the JSON structure for moving the category
+// of the route and its subtree
+type MoveCategoryRequest struct {
+	// ParentID is the new parent; null or empty moves the category to the
+	// root
+	ParentID *string `json:"parent_id"`
+}
+
+// MergeCategoriesRequest represents the JSON structure for merging a
+// category into the category of the route
+type MergeCategoriesRequest struct {
+	// SourceID is the category merged and deleted; its products and children
+	// move to the target
+	SourceID string `json:"source_id" binding:"required"`
+}
+
+// ReorderCategoriesRequest represents the JSON structure for ordering the
+// children of a category
+type ReorderCategoriesRequest struct {
+	// ParentID is the category whose children are ordered; null or empty
+	// orders the root categories
+	ParentID *string `json:"parent_id"`
+	// CategoryIDs lists every child of the parent in their new order
+	CategoryIDs []string `json:"category_ids" binding:"required,min=1"`
+}
+
+// MoveCategory handles moving the category of the route under another parent
+func (h *ProductHandler) MoveCategory(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req MoveCategoryRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.MoveCategory(c.Request.Context(), &pb.MoveCategoryRequest{
+		Id:       c.Param("id"),
+		ParentId: optionalCategoryID(req.ParentID),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to move category")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatCategory(resp))
+}
+
+// MergeCategories handles merging a source category into the category of the
+// route
+func (h *ProductHandler) MergeCategories(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req MergeCategoriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.MergeCategories(c.Request.Context(), &pb.MergeCategoriesRequest{
+		TargetId: c.Param("id"),
+		SourceId: req.SourceID,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to merge categories")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"category":       formatters.FormatCategory(resp.Category),
+		"products_moved": resp.ProductsMoved,
+		"children_moved": resp.ChildrenMoved,
+	})
+}
+
+// ReorderCategories handles ordering the children of a category
+func (h *ProductHandler) ReorderCategories(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req ReorderCategoriesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.ReorderSiblings(c.Request.Context(), &pb.ReorderSiblingsRequest{
+		ParentId:    optionalCategoryID(req.ParentID),
+		CategoryIds: req.CategoryIDs,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to reorder categories")
+		return
+	}
+
+	categories := make([]formatters.CategoryResponse, len(resp.Categories))
+	for i, category := range resp.Categories {
+		categories[i] = formatters.FormatCategory(category)
+	}
+	c.JSON(http.StatusOK, gin.H{"categories": categories})
+}
+
+// optionalCategoryID converts an optional category ID to a proto wrapper;
+// null and empty IDs stand for the root of the tree
+func optionalCategoryID(id *string) *wrapperspb.StringValue {
+	if id == nil || *id == "" {
+		return nil
+	}
+	return wrapperspb.String(*id)
+}
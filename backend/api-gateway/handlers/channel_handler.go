@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// SetProductChannelsRequest represents the JSON structure for toggling the
+// visibility of a product per publication channel, e.g. {"marketplace": false}
+type SetProductChannelsRequest struct {
+	Channels map[string]bool `json:"channels" binding:"required"`
+}
+
+// GetProductChannels handles fetching the visibility of a product in every channel
+func (h *ProductHandler) GetProductChannels(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.GetProductChannels(c.Request.Context(), &pb.GetProductChannelsRequest{ProductId: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get product channels")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatProductChannels(resp))
+}
+
+// SetProductChannels handles showing or hiding a product in publication channels
+func (h *ProductHandler) SetProductChannels(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req SetProductChannelsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	channels := make([]*pb.ProductChannel, 0, len(req.Channels))
+	for channel, visible := range req.Channels {
+		channels = append(channels, &pb.ProductChannel{Channel: channel, IsVisible: visible})
+	}
+
+	resp, err := h.client.SetProductChannels(c.Request.Context(), &pb.SetProductChannelsRequest{
+		ProductId: c.Param("id"),
+		Channels:  channels,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set product channels")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatProductChannels(resp))
+}
+
+func formatProductChannels(resp *pb.ProductChannelsResponse) gin.H {
+	channels := make(gin.H, len(resp.Channels))
+	for _, channel := range resp.Channels {
+		channels[channel.Channel] = gin.H{
+			"is_visible": channel.IsVisible,
+			"updated_at": formatTimestamp(channel.UpdatedAt),
+		}
+	}
+	return gin.H{
+		"product_id": resp.ProductId,
+		"channels":   channels,
+	}
+}
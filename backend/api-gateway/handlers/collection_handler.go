@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// CollectionRequest represents the JSON structure for collection creation/update
+type CollectionRequest struct {
+	Collection struct {
+		Name        string   `json:"name"`
+		Slug        string   `json:"slug"`
+		Description string   `json:"description"`
+		ImageURL    string   `json:"image_url"`
+		IsPublished bool     `json:"is_published"`
+		ProductIDs  []string `json:"product_ids"`
+		Rules       *struct {
+			BrandIDs    []string `json:"brand_ids"`
+			CategoryIDs []string `json:"category_ids"`
+			Tags        []string `json:"tags"`
+		} `json:"rules"`
+	} `json:"collection"`
+}
+
+// toProto converts the request body into a collection proto message
+func (r *CollectionRequest) toProto() *pb.Collection {
+	collection := &pb.Collection{
+		Name:        r.Collection.Name,
+		Slug:        r.Collection.Slug,
+		Description: r.Collection.Description,
+		ImageUrl:    r.Collection.ImageURL,
+		IsPublished: r.Collection.IsPublished,
+		ProductIds:  r.Collection.ProductIDs,
+	}
+	if r.Collection.Rules != nil {
+		collection.Rules = &pb.CollectionRules{
+			BrandIds:    r.Collection.Rules.BrandIDs,
+			CategoryIds: r.Collection.Rules.CategoryIDs,
+			Tags:        r.Collection.Rules.Tags,
+		}
+	}
+	return collection
+}
+
+// ListCollections handles retrieving a paginated list of published collections for the storefront
+func (h *ProductHandler) ListCollections(c *gin.Context) {
+	h.listCollections(c, true)
+}
+
+// ListAllCollections handles retrieving a paginated list of all collections, including unpublished ones
+func (h *ProductHandler) ListAllCollections(c *gin.Context) {
+	h.listCollections(c, false)
+}
+
+func (h *ProductHandler) listCollections(c *gin.Context, publishedOnly bool) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page number"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit number"})
+		return
+	}
+
+	resp, err := h.client.ListCollections(c.Request.Context(), &pb.ListCollectionsRequest{
+		Page:          int32(page),
+		Limit:         int32(limit),
+		PublishedOnly: publishedOnly,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list collections")
+		return
+	}
+
+	// The service defaults and caps the page size
+	c.JSON(http.StatusOK, formatters.FormatCollectionList(resp.Collections, int(resp.Page), int(resp.Limit), int(resp.Total)))
+}
+
+// GetCollection handles the storefront landing page for a collection, returning
+// the collection together with a page of its member products
+func (h *ProductHandler) GetCollection(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	slug := c.Param("slug")
+	if slug == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "collection slug is required"})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page number"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit number"})
+		return
+	}
+
+	resp, err := h.client.ListCollectionProducts(c.Request.Context(), &pb.ListCollectionProductsRequest{
+		Identifier: &pb.ListCollectionProductsRequest_Slug{Slug: slug},
+		Page:       int32(page),
+		Limit:      int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get collection")
+		return
+	}
+
+	// Unpublished collections are not part of the storefront
+	if resp.Collection == nil || !resp.Collection.IsPublished {
+		c.JSON(http.StatusNotFound, gin.H{"error": "collection not found"})
+		return
+	}
+
+	// The service defaults and caps the page size
+	c.JSON(http.StatusOK, formatters.FormatCollectionProducts(resp, int(resp.Page), int(resp.Limit)))
+}
+
+// CreateCollection handles creating a new collection
+func (h *ProductHandler) CreateCollection(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req CollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.CreateCollection(c.Request.Context(), &pb.CreateCollectionRequest{
+		Collection: req.toProto(),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create collection")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatters.FormatCollection(resp))
+}
+
+// UpdateCollection handles updating an existing collection
+func (h *ProductHandler) UpdateCollection(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "collection ID is required"})
+		return
+	}
+
+	var req CollectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	collection := req.toProto()
+	collection.Id = id
+
+	resp, err := h.client.UpdateCollection(c.Request.Context(), &pb.UpdateCollectionRequest{
+		Collection: collection,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to update collection")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatCollection(resp))
+}
+
+// DeleteCollection handles deleting a collection
+func (h *ProductHandler) DeleteCollection(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "collection ID is required"})
+		return
+	}
+
+	resp, err := h.client.DeleteCollection(c.Request.Context(), &pb.DeleteCollectionRequest{Id: id})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete collection")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// SetCollectionProducts handles replacing the manually ordered members of a collection
+func (h *ProductHandler) SetCollectionProducts(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	id := c.Param("id")
+	if id == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "collection ID is required"})
+		return
+	}
+
+	var req struct {
+		ProductIDs []string `json:"product_ids"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.SetCollectionProducts(c.Request.Context(), &pb.SetCollectionProductsRequest{
+		CollectionId: id,
+		ProductIds:   req.ProductIDs,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set collection products")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatCollection(resp))
+}
@@ -0,0 +1,322 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// CompanyRequest represents the JSON structure for creating or updating a
+// B2B company. Purchases above approval_threshold need an approver; without
+// a threshold no purchase does.
+type CompanyRequest struct {
+	Name              string   `json:"name" binding:"required,max=255"`
+	PaymentTerms      string   `json:"payment_terms" binding:"omitempty,oneof=prepaid net_15 net_30 net_60"`
+	ApprovalThreshold *float64 `json:"approval_threshold" binding:"omitempty,min=0"`
+}
+
+// CompanyMemberRequest represents the JSON structure for setting the role of
+// a company member
+type CompanyMemberRequest struct {
+	Role string `json:"role" binding:"required,oneof=purchaser approver"`
+}
+
+// CompanyAddressRequest represents the JSON structure for adding an address
+// shared by the members of a company
+type CompanyAddressRequest struct {
+	Label          string `json:"label" binding:"max=100"`
+	AddressType    string `json:"address_type" binding:"required,oneof=shipping billing"`
+	StreetAddress1 string `json:"street_address1" binding:"required"`
+	StreetAddress2 string `json:"street_address2"`
+	City           string `json:"city" binding:"required"`
+	State          string `json:"state" binding:"required"`
+	PostalCode     string `json:"postal_code" binding:"required"`
+	Country        string `json:"country" binding:"required"`
+	IsDefault      bool   `json:"is_default"`
+}
+
+// PurchaseApprovalRequest represents the JSON structure for asking the
+// approval of a purchase at checkout
+type PurchaseApprovalRequest struct {
+	OrderReference string  `json:"order_reference" binding:"required,max=100"`
+	Amount         float64 `json:"amount" binding:"required,gt=0"`
+}
+
+// PurchaseDecisionRequest represents the JSON structure for approving or
+// rejecting a pending purchase
+type PurchaseDecisionRequest struct {
+	Approve *bool  `json:"approve" binding:"required"`
+	Notes   string `json:"notes" binding:"max=1000"`
+}
+
+// ListCompanies lists the B2B companies of the store by name
+func (h *UserHandler) ListCompanies(c *gin.Context) {
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.ListCompanies(c.Request.Context(), &pb.ListCompaniesRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list companies")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"companies": resp.Companies,
+		"total":     resp.Total,
+		"page":      page,
+		"limit":     limit,
+	})
+}
+
+// CreateCompany creates a B2B company
+func (h *UserHandler) CreateCompany(c *gin.Context) {
+	var req CompanyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.CreateCompany(c.Request.Context(), &pb.CreateCompanyRequest{
+		Name:              req.Name,
+		PaymentTerms:      req.PaymentTerms,
+		RequiresApproval:  req.ApprovalThreshold != nil,
+		ApprovalThreshold: derefThreshold(req.ApprovalThreshold),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create company")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"company": resp.Company})
+}
+
+// GetCompany returns a company with its members and shared addresses
+func (h *UserHandler) GetCompany(c *gin.Context) {
+	resp, err := h.client.GetCompany(c.Request.Context(), &pb.GetCompanyRequest{CompanyId: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get company")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"company": resp.Company})
+}
+
+// UpdateCompany replaces the name, payment terms and approval threshold of
+// a company
+func (h *UserHandler) UpdateCompany(c *gin.Context) {
+	var req CompanyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.UpdateCompany(c.Request.Context(), &pb.UpdateCompanyRequest{
+		CompanyId:         c.Param("id"),
+		Name:              req.Name,
+		PaymentTerms:      req.PaymentTerms,
+		RequiresApproval:  req.ApprovalThreshold != nil,
+		ApprovalThreshold: derefThreshold(req.ApprovalThreshold),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to update company")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"company": resp.Company})
+}
+
+// SetCompanyMember adds a customer to a company as purchaser or approver,
+// or changes their role
+func (h *UserHandler) SetCompanyMember(c *gin.Context) {
+	userID, err := h.parseUserID(c.Param("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	var req CompanyMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.SetCompanyMember(c.Request.Context(), &pb.SetCompanyMemberRequest{
+		CompanyId: c.Param("id"),
+		UserId:    userID,
+		Role:      req.Role,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set company member")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"member": resp.Member})
+}
+
+// RemoveCompanyMember removes a user from a company
+func (h *UserHandler) RemoveCompanyMember(c *gin.Context) {
+	userID, err := h.parseUserID(c.Param("user_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	if _, err := h.client.RemoveCompanyMember(c.Request.Context(), &pb.RemoveCompanyMemberRequest{
+		CompanyId: c.Param("id"),
+		UserId:    userID,
+	}); err != nil {
+		h.handleGRPCError(c, err, "Failed to remove company member")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Company member removed successfully"})
+}
+
+// AddCompanyAddress adds an address shared by the members of a company
+func (h *UserHandler) AddCompanyAddress(c *gin.Context) {
+	var req CompanyAddressRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.AddCompanyAddress(c.Request.Context(), &pb.AddCompanyAddressRequest{
+		Address: &pb.CompanyAddress{
+			CompanyId:      c.Param("id"),
+			Label:          req.Label,
+			AddressType:    req.AddressType,
+			StreetAddress1: req.StreetAddress1,
+			StreetAddress2: req.StreetAddress2,
+			City:           req.City,
+			State:          req.State,
+			PostalCode:     req.PostalCode,
+			Country:        req.Country,
+			IsDefault:      req.IsDefault,
+		},
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to add company address")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"address": resp.Address})
+}
+
+// RemoveCompanyAddress removes a shared address of a company
+func (h *UserHandler) RemoveCompanyAddress(c *gin.Context) {
+	if _, err := h.client.RemoveCompanyAddress(c.Request.Context(), &pb.RemoveCompanyAddressRequest{
+		CompanyId: c.Param("id"),
+		AddressId: c.Param("address_id"),
+	}); err != nil {
+		h.handleGRPCError(c, err, "Failed to remove company address")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Company address removed successfully"})
+}
+
+// ListCompanyPurchaseApprovals lists the purchase approvals of a company,
+// most recent first, optionally of a status
+func (h *UserHandler) ListCompanyPurchaseApprovals(c *gin.Context) {
+	h.listPurchaseApprovals(c, &pb.ListPurchaseApprovalsRequest{CompanyId: c.Param("id")})
+}
+
+// GetMyCompany returns the company of the signed in user with their role in
+// it
+func (h *UserHandler) GetMyCompany(c *gin.Context) {
+	resp, err := h.client.GetUserCompany(c.Request.Context(), &pb.GetUserCompanyRequest{
+		UserId: c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get company")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"company": resp.Company,
+		"role":    resp.Membership.GetRole(),
+	})
+}
+
+// RequestPurchaseApproval asks the approval of a purchase of the signed in
+// company member. Purchases up to the approval threshold of the company are
+// approved at once.
+func (h *UserHandler) RequestPurchaseApproval(c *gin.Context) {
+	var req PurchaseApprovalRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.RequestPurchaseApproval(c.Request.Context(), &pb.RequestPurchaseApprovalRequest{
+		UserId:         c.GetString("user_id"),
+		OrderReference: req.OrderReference,
+		Amount:         req.Amount,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to request purchase approval")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"approval": resp.Approval})
+}
+
+// ListMyPurchaseApprovals lists the purchase approvals the signed in company
+// member sees: all those of the company for approvers, their own for
+// purchasers
+func (h *UserHandler) ListMyPurchaseApprovals(c *gin.Context) {
+	h.listPurchaseApprovals(c, &pb.ListPurchaseApprovalsRequest{UserId: c.GetString("user_id")})
+}
+
+// DecidePurchaseApproval approves or rejects a pending purchase as the
+// signed in approver
+func (h *UserHandler) DecidePurchaseApproval(c *gin.Context) {
+	var req PurchaseDecisionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.DecidePurchaseApproval(c.Request.Context(), &pb.DecidePurchaseApprovalRequest{
+		ApprovalId: c.Param("id"),
+		ApproverId: c.GetString("user_id"),
+		Approve:    *req.Approve,
+		Notes:      req.Notes,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to decide purchase approval")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"approval": resp.Approval})
+}
+
+func (h *UserHandler) listPurchaseApprovals(c *gin.Context, req *pb.ListPurchaseApprovalsRequest) {
+	page, limit := getPaginationParams(c)
+	req.Status = c.Query("status")
+	req.Page = int32(page)
+	req.Limit = int32(limit)
+
+	resp, err := h.client.ListPurchaseApprovals(c.Request.Context(), req)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list purchase approvals")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"approvals": resp.Approvals,
+		"total":     resp.Total,
+		"page":      page,
+		"limit":     limit,
+	})
+}
+
+func derefThreshold(threshold *float64) float64 {
+	if threshold == nil {
+		return 0
+	}
+	return *threshold
+}
@@ -0,0 +1,329 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// ContentScheduleRequest represents the publication state of a page or
+// banner. Content is a draft unless published; published content is live
+// from publish_at, when set, until unpublish_at, when set.
+type ContentScheduleRequest struct {
+	Status string `json:"status" binding:"omitempty,oneof=draft published"`
+	// PublishAt and UnpublishAt are RFC 3339 times
+	PublishAt   string `json:"publish_at"`
+	UnpublishAt string `json:"unpublish_at"`
+}
+
+// ContentPageRequest represents the JSON structure for creating or
+// replacing a storefront page
+type ContentPageRequest struct {
+	Slug    string `json:"slug" binding:"required"`
+	Title   string `json:"title" binding:"required"`
+	Summary string `json:"summary"`
+	Body    string `json:"body"`
+	ContentScheduleRequest
+}
+
+// ContentBannerRequest represents the JSON structure for creating or
+// replacing a banner of a storefront slot
+type ContentBannerRequest struct {
+	Slot     string `json:"slot" binding:"required"`
+	Title    string `json:"title" binding:"required"`
+	Subtitle string `json:"subtitle"`
+	ImageURL string `json:"image_url" binding:"required"`
+	LinkURL  string `json:"link_url"`
+	Position int    `json:"position" binding:"min=0"`
+	ContentScheduleRequest
+}
+
+// GetContentPage returns the live storefront page with a slug, in the
+// locale of the request when translated to it
+func (h *ProductHandler) GetContentPage(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	page, err := h.client.GetContentPage(c.Request.Context(), &pb.GetContentPageRequest{
+		Identifier: &pb.GetContentPageRequest_Slug{Slug: c.Param("slug")},
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get content page")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatContentPage(page))
+}
+
+// ListContentPages lists the live storefront pages, for navigation such as
+// the footer links
+func (h *ProductHandler) ListContentPages(c *gin.Context) {
+	h.listContentPages(c, true)
+}
+
+// ListAllContentPages lists every page, drafts and scheduled ones included
+func (h *ProductHandler) ListAllContentPages(c *gin.Context) {
+	h.listContentPages(c, false)
+}
+
+func (h *ProductHandler) listContentPages(c *gin.Context, liveOnly bool) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.ListContentPages(c.Request.Context(), &pb.ListContentPagesRequest{
+		LiveOnly: liveOnly,
+		Page:     int32(page),
+		Limit:    int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list content pages")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatContentPageList(resp.Pages, page, limit, int(resp.Total)))
+}
+
+// GetContentSlot returns the live banners of a storefront slot by position,
+// in the locale of the request when translated to it
+func (h *ProductHandler) GetContentSlot(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListContentBanners(c.Request.Context(), &pb.ListContentBannersRequest{
+		Slot:     c.Param("slot"),
+		LiveOnly: true,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list content banners")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.ContentSlotResponse{
+		Slot:    c.Param("slot"),
+		Banners: formatters.FormatContentBanners(resp.Banners),
+	})
+}
+
+// AdminGetContentPage returns a page by ID whatever its state, for editing
+func (h *ProductHandler) AdminGetContentPage(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	page, err := h.client.GetContentPage(c.Request.Context(), &pb.GetContentPageRequest{
+		Identifier: &pb.GetContentPageRequest_Id{Id: c.Param("id")},
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get content page")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatContentPage(page))
+}
+
+// CreateContentPage creates a storefront page
+func (h *ProductHandler) CreateContentPage(c *gin.Context) {
+	h.saveContentPage(c, "")
+}
+
+// UpdateContentPage replaces the content and schedule of a page
+func (h *ProductHandler) UpdateContentPage(c *gin.Context) {
+	h.saveContentPage(c, c.Param("id"))
+}
+
+// saveContentPage creates a page, or replaces the page with the ID when set
+func (h *ProductHandler) saveContentPage(c *gin.Context, id string) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req ContentPageRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	publishAt, unpublishAt, err := req.ContentScheduleRequest.times()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	page := &pb.ContentPage{
+		Id:          id,
+		Slug:        req.Slug,
+		Title:       req.Title,
+		Summary:     req.Summary,
+		Body:        req.Body,
+		Status:      req.Status,
+		PublishAt:   publishAt,
+		UnpublishAt: unpublishAt,
+	}
+	if id == "" {
+		page, err = h.client.CreateContentPage(c.Request.Context(), &pb.CreateContentPageRequest{Page: page})
+	} else {
+		page, err = h.client.UpdateContentPage(c.Request.Context(), &pb.UpdateContentPageRequest{Page: page})
+	}
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to save content page")
+		return
+	}
+
+	statusCode := http.StatusOK
+	if id == "" {
+		statusCode = http.StatusCreated
+	}
+	c.JSON(statusCode, formatters.FormatContentPage(page))
+}
+
+// DeleteContentPage removes a page, freeing its slug
+func (h *ProductHandler) DeleteContentPage(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteContentPage(c.Request.Context(), &pb.DeleteContentPageRequest{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete content page")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// ListContentBanners lists the banners of every slot, or of the slot query
+// parameter, drafts and scheduled ones included
+func (h *ProductHandler) ListContentBanners(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListContentBanners(c.Request.Context(), &pb.ListContentBannersRequest{Slot: c.Query("slot")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list content banners")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"banners": formatters.FormatContentBanners(resp.Banners)})
+}
+
+// CreateContentBanner adds a banner to a storefront slot
+func (h *ProductHandler) CreateContentBanner(c *gin.Context) {
+	h.saveContentBanner(c, "")
+}
+
+// UpdateContentBanner replaces the content, slot and schedule of a banner
+func (h *ProductHandler) UpdateContentBanner(c *gin.Context) {
+	h.saveContentBanner(c, c.Param("id"))
+}
+
+// saveContentBanner creates a banner, or replaces the banner with the ID
+// when set
+func (h *ProductHandler) saveContentBanner(c *gin.Context, id string) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req ContentBannerRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	publishAt, unpublishAt, err := req.ContentScheduleRequest.times()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	banner := &pb.ContentBanner{
+		Id:          id,
+		Slot:        req.Slot,
+		Title:       req.Title,
+		Subtitle:    req.Subtitle,
+		ImageUrl:    req.ImageURL,
+		LinkUrl:     req.LinkURL,
+		Position:    int32(req.Position),
+		Status:      req.Status,
+		PublishAt:   publishAt,
+		UnpublishAt: unpublishAt,
+	}
+	if id == "" {
+		banner, err = h.client.CreateContentBanner(c.Request.Context(), &pb.CreateContentBannerRequest{Banner: banner})
+	} else {
+		banner, err = h.client.UpdateContentBanner(c.Request.Context(), &pb.UpdateContentBannerRequest{Banner: banner})
+	}
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to save content banner")
+		return
+	}
+
+	statusCode := http.StatusOK
+	if id == "" {
+		statusCode = http.StatusCreated
+	}
+	c.JSON(statusCode, formatters.FormatContentBanner(banner))
+}
+
+// DeleteContentBanner removes a banner from its slot
+func (h *ProductHandler) DeleteContentBanner(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteContentBanner(c.Request.Context(), &pb.DeleteContentBannerRequest{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete content banner")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// times parses the schedule of content, nil when left out
+func (r ContentScheduleRequest) times() (publishAt, unpublishAt *timestamppb.Timestamp, err error) {
+	parse := func(field, value string) (*timestamppb.Timestamp, error) {
+		if value == "" {
+			return nil, nil
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 time", field)
+		}
+		return timestamppb.New(t), nil
+	}
+
+	if publishAt, err = parse("publish_at", r.PublishAt); err != nil {
+		return nil, nil, err
+	}
+	if unpublishAt, err = parse("unpublish_at", r.UnpublishAt); err != nil {
+		return nil, nil, err
+	}
+	return publishAt, unpublishAt, nil
+}
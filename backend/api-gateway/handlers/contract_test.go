@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/clients"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/internal/contracttest"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	userpb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// The tests in this file run gateway handlers against the golden service
+// fixtures in testdata/contracts and compare the HTTP responses with
+// testdata/golden. Run go test with -update after an intended change to a
+// response format.
+
+func init() {
+	gin.SetMode(gin.TestMode)
+}
+
+func serve(router *gin.Engine, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestProductContract(t *testing.T) {
+	server := contracttest.NewServer(t, "testdata/contracts/product.json")
+	handler := NewProductHandler(productpb.NewProductServiceClient(server.Dial(t)), zap.NewNop())
+
+	router := gin.New()
+	router.GET("/products/:id", handler.GetProduct)
+	router.GET("/categories", handler.ListCategories)
+
+	w := serve(router, http.MethodGet, "/products/3f1c2a9e-0000-4000-8000-000000000001", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET product status = %d, body = %s", w.Code, w.Body)
+	}
+	contracttest.AssertGoldenJSON(t, "testdata/golden/get_product.json", w.Body.Bytes())
+
+	w = serve(router, http.MethodGet, "/products/missing", "")
+	if w.Code != http.StatusNotFound {
+		t.Errorf("GET missing product status = %d, want 404", w.Code)
+	}
+
+	w = serve(router, http.MethodGet, "/products/3f1c2a9e-0000-4000-8000-000000000002?view=summary", "")
+	if location := w.Header().Get("Location"); w.Code != http.StatusMovedPermanently || location != "/products/3f1c2a9e-0000-4000-8000-000000000001?view=summary" {
+		t.Errorf("GET merged product = %d to %q, want a permanent redirect to the target", w.Code, location)
+	}
+
+	w = serve(router, http.MethodGet, "/categories", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET categories status = %d, body = %s", w.Code, w.Body)
+	}
+	contracttest.AssertGoldenJSON(t, "testdata/golden/list_categories.json", w.Body.Bytes())
+
+	w = serve(router, http.MethodGet, "/categories?fields[categories]=name,parent_id", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET categories with fields status = %d, body = %s", w.Code, w.Body)
+	}
+	contracttest.AssertGoldenJSON(t, "testdata/golden/list_categories_fields.json", w.Body.Bytes())
+
+	w = serve(router, http.MethodGet, "/categories?fields=nope", "")
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("GET categories with an unknown field status = %d, want 400", w.Code)
+	}
+
+	server.AssertAllCalled(t)
+}
+
+func TestUserContract(t *testing.T) {
+	server := contracttest.NewServer(t, "testdata/contracts/user.json")
+	handler := &UserHandler{client: userpb.NewUserServiceClient(server.Dial(t)), logger: zap.NewNop()}
+
+	router := gin.New()
+	router.POST("/users/login", handler.Login)
+
+	w := serve(router, http.MethodPost, "/users/login", `{"email":"jane@example.com","password":"correct-horse"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("login status = %d, body = %s", w.Code, w.Body)
+	}
+	contracttest.AssertGoldenJSON(t, "testdata/golden/login.json", w.Body.Bytes())
+	if cookie := w.Header().Get("Set-Cookie"); !strings.HasPrefix(cookie, "refresh_token=refresh-token") {
+		t.Errorf("Set-Cookie = %q", cookie)
+	}
+
+	w = serve(router, http.MethodPost, "/users/login", `{"email":"jane@example.com","password":"wrong"}`)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("login with a wrong password status = %d, want 401", w.Code)
+	}
+
+	// Guests see their own cart, which is merged into their account on login
+	guest := gin.New()
+	guest.Use(func(c *gin.Context) {
+		c.Set(middleware.GuestIDKey, "guest_5b6c1f3e-0000-4000-8000-000000000009")
+	})
+	guest.GET("/cart", handler.GetCart)
+	guest.POST("/users/login", handler.Login)
+
+	w = serve(guest, http.MethodGet, "/cart", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("guest cart status = %d, body = %s", w.Code, w.Body)
+	}
+	contracttest.AssertGoldenJSON(t, "testdata/golden/get_cart.json", w.Body.Bytes())
+
+	w = serve(guest, http.MethodPost, "/users/login", `{"email":"jane@example.com","password":"correct-horse"}`)
+	if w.Code != http.StatusOK {
+		t.Fatalf("guest login status = %d, body = %s", w.Code, w.Body)
+	}
+	contracttest.AssertGoldenJSON(t, "testdata/golden/login_guest.json", w.Body.Bytes())
+	if cookies := w.Header().Values("Set-Cookie"); len(cookies) != 2 || !strings.HasPrefix(cookies[1], middleware.GuestSessionCookie+"=;") {
+		t.Errorf("Set-Cookie = %q, want the guest session cleared", cookies)
+	}
+
+	server.AssertAllCalled(t)
+}
+
+func TestInventoryContract(t *testing.T) {
+	server := contracttest.NewServer(t, "testdata/contracts/inventory.json")
+	handler := NewInventoryHandler(clients.NewInventoryClientFromConn(server.Dial(t), zap.NewNop()), zap.NewNop())
+
+	router := gin.New()
+	router.GET("/inventory/items/:product_id", handler.GetInventoryItem)
+
+	w := serve(router, http.MethodGet, "/inventory/items/3f1c2a9e-0000-4000-8000-000000000001", "")
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET inventory item status = %d, body = %s", w.Code, w.Body)
+	}
+	contracttest.AssertGoldenJSON(t, "testdata/golden/get_inventory_item.json", w.Body.Bytes())
+
+	router.GET("/quota", handler.GetQuota)
+	req := httptest.NewRequest(http.MethodGet, "/quota", nil)
+	req.Header.Set(IntegrationKeyHeader, "3pl_0123456789abcdef")
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET quota status = %d, body = %s", w.Code, w.Body)
+	}
+	if got := w.Header().Get(QuotaRemainingHeader); got != "750" {
+		t.Errorf("%s = %q, want 750", QuotaRemainingHeader, got)
+	}
+	if got := w.Header().Get(QuotaResetHeader); got != "1704326400" {
+		t.Errorf("%s = %q, want 1704326400", QuotaResetHeader, got)
+	}
+	contracttest.AssertGoldenJSON(t, "testdata/golden/get_quota.json", w.Body.Bytes())
+
+	server.AssertAllCalled(t)
+}
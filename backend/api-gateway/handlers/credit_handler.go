@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// CreditLimitRequest represents the JSON structure for opening the credit
+// account of a company or changing its limit
+type CreditLimitRequest struct {
+	CreditLimit *float64 `json:"credit_limit" binding:"required,min=0"`
+}
+
+// CreditChargeRequest represents the JSON structure for placing an approved
+// purchase on the credit of the company at checkout
+type CreditChargeRequest struct {
+	ApprovalID string `json:"approval_id" binding:"required,uuid"`
+}
+
+// CreditPaymentRequest represents the JSON structure for recording a payment
+// received on a credit account
+type CreditPaymentRequest struct {
+	Amount    float64 `json:"amount" binding:"required,gt=0"`
+	Reference string  `json:"reference" binding:"required,max=100"`
+}
+
+// GetCompanyCredit returns the credit account of a company
+func (h *UserHandler) GetCompanyCredit(c *gin.Context) {
+	h.getCreditAccount(c, &pb.GetCreditAccountRequest{CompanyId: c.Param("id")})
+}
+
+// SetCompanyCreditLimit opens the credit account of a company or changes
+// its limit
+func (h *UserHandler) SetCompanyCreditLimit(c *gin.Context) {
+	var req CreditLimitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.SetCreditLimit(c.Request.Context(), &pb.SetCreditLimitRequest{
+		CompanyId:   c.Param("id"),
+		CreditLimit: *req.CreditLimit,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set credit limit")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"account": resp.Account})
+}
+
+// RecordCompanyCreditPayment records a payment received on the credit
+// account of a company
+func (h *UserHandler) RecordCompanyCreditPayment(c *gin.Context) {
+	var req CreditPaymentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.RecordCreditPayment(c.Request.Context(), &pb.RecordCreditPaymentRequest{
+		CompanyId: c.Param("id"),
+		Amount:    req.Amount,
+		Reference: req.Reference,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to record credit payment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"payment": resp.Payment})
+}
+
+// GetCompanyCreditStatement returns the statement of the credit account of a
+// company over a period, the current month by default
+func (h *UserHandler) GetCompanyCreditStatement(c *gin.Context) {
+	h.getCreditStatement(c, &pb.GetCreditStatementRequest{CompanyId: c.Param("id")})
+}
+
+// GetMyCredit returns the credit account of the company of the signed in
+// user
+func (h *UserHandler) GetMyCredit(c *gin.Context) {
+	h.getCreditAccount(c, &pb.GetCreditAccountRequest{UserId: c.GetString("user_id")})
+}
+
+// ChargeMyCredit places an approved purchase of the signed in company member
+// on the credit of their company, due at the end of its payment terms. The
+// order reference and amount are those of the purchase approval.
+func (h *UserHandler) ChargeMyCredit(c *gin.Context) {
+	var req CreditChargeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.ChargeCredit(c.Request.Context(), &pb.ChargeCreditRequest{
+		UserId:     c.GetString("user_id"),
+		ApprovalId: req.ApprovalID,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to charge credit")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"charge": resp.Charge})
+}
+
+// GetMyCreditStatement returns the statement of the credit account of the
+// company of the signed in user
+func (h *UserHandler) GetMyCreditStatement(c *gin.Context) {
+	h.getCreditStatement(c, &pb.GetCreditStatementRequest{UserId: c.GetString("user_id")})
+}
+
+func (h *UserHandler) getCreditAccount(c *gin.Context, req *pb.GetCreditAccountRequest) {
+	resp, err := h.client.GetCreditAccount(c.Request.Context(), req)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get credit account")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"account": resp.Account})
+}
+
+func (h *UserHandler) getCreditStatement(c *gin.Context, req *pb.GetCreditStatementRequest) {
+	req.From = c.Query("from")
+	req.To = c.Query("to")
+
+	resp, err := h.client.GetCreditStatement(c.Request.Context(), req)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get credit statement")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"statement": resp})
+}
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// DownloadLinkRequest represents the JSON structure for creating a download link
+type DownloadLinkRequest struct {
+	ProductID  string `json:"product_id" binding:"required"`
+	PurchaseID string `json:"purchase_id" binding:"required"`
+	UserID     string `json:"user_id" binding:"required"`
+}
+
+// UploadDigitalAsset handles uploading the downloadable file of a digital
+// product. Uploading a file turns the product into a digital product.
+func (h *ProductHandler) UploadDigitalAsset(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+
+	downloadLimit := 0 // Use the service default
+	if limit := c.PostForm("download_limit"); limit != "" {
+		downloadLimit, err = strconv.Atoi(limit)
+		if err != nil || downloadLimit < 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "download_limit must be a positive number"})
+			return
+		}
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		h.logger.Error("Failed to open file", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to open file"})
+		return
+	}
+	defer src.Close()
+
+	fileBytes, err := io.ReadAll(src)
+	if err != nil {
+		h.logger.Error("Failed to read file", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read file"})
+		return
+	}
+
+	resp, err := h.client.UploadDigitalAsset(c.Request.Context(), &pb.UploadDigitalAssetRequest{
+		ProductId:     c.Param("id"),
+		File:          fileBytes,
+		Filename:      file.Filename,
+		MimeType:      file.Header.Get("Content-Type"),
+		DownloadLimit: int32(downloadLimit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to upload digital asset")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"product_id":     resp.ProductId,
+		"file_name":      resp.FileName,
+		"content_type":   resp.ContentType,
+		"size_bytes":     resp.SizeBytes,
+		"download_limit": resp.DownloadLimit,
+	})
+}
+
+// CreateDownloadLink handles creating a time-limited download link for a
+// purchased digital product. Until an order service confirms purchases this
+// is restricted to admins.
+func (h *ProductHandler) CreateDownloadLink(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req DownloadLinkRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.CreateDownloadLink(c.Request.Context(), &pb.CreateDownloadLinkRequest{
+		ProductId:  req.ProductID,
+		PurchaseId: req.PurchaseID,
+		UserId:     req.UserID,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create download link")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"url":                 resp.Url,
+		"expires_at":          formatTimestamp(resp.ExpiresAt),
+		"downloads_remaining": resp.DownloadsRemaining,
+		"download_limit":      resp.DownloadLimit,
+	})
+}
+
+// DownloadDigitalAsset streams the file behind a signed download link. The
+// token in the URL is the only credential, so this route is public.
+func (h *ProductHandler) DownloadDigitalAsset(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	stream, err := h.client.DownloadDigitalAsset(c.Request.Context(), &pb.DownloadDigitalAssetRequest{
+		Token: c.Param("token"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to download file")
+		return
+	}
+
+	// Errors such as an expired link arrive with the first chunk, before any
+	// response has been written
+	chunk, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "file not found"})
+			return
+		}
+		h.handleGRPCError(c, err, "Failed to download file")
+		return
+	}
+
+	c.Header("Content-Type", chunk.ContentType)
+	c.Header("Content-Length", strconv.FormatInt(chunk.SizeBytes, 10))
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": chunk.FileName}))
+	c.Header("Cache-Control", "no-store")
+	c.Header("X-Downloads-Remaining", strconv.Itoa(int(chunk.DownloadsRemaining)))
+	c.Status(http.StatusOK)
+
+	for {
+		if _, err := c.Writer.Write(chunk.Data); err != nil {
+			h.logger.Warn("Client disconnected during download", zap.Error(err))
+			return
+		}
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			// Headers are already sent, so the truncated body is all the client sees
+			h.logger.Error("Download stream failed", zap.Error(err))
+			return
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// RunErpSync handles starting an ERP sync for the current store without
+// waiting for the next scheduled run
+func (h *ProductHandler) RunErpSync(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.RunErpSync(c.Request.Context(), &pb.RunErpSyncRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to run ERP sync")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"runs": formatErpSyncRuns(resp.Runs)})
+}
+
+// ListErpSyncRuns handles listing the ERP sync run log, most recent first
+func (h *ProductHandler) ListErpSyncRuns(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive number"})
+		return
+	}
+
+	resp, err := h.client.ListErpSyncRuns(c.Request.Context(), &pb.ListErpSyncRunsRequest{Limit: int32(limit)})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list ERP sync runs")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"runs": formatErpSyncRuns(resp.Runs)})
+}
+
+func formatErpSyncRuns(runs []*pb.ErpSyncRun) []gin.H {
+	formatted := make([]gin.H, len(runs))
+	for i, run := range runs {
+		formatted[i] = gin.H{
+			"id":              run.Id,
+			"connector":       run.Connector,
+			"entity":          run.Entity,
+			"direction":       run.Direction,
+			"status":          run.Status,
+			"records_read":    run.RecordsRead,
+			"records_applied": run.RecordsApplied,
+			"records_skipped": run.RecordsSkipped,
+			"conflicts":       run.Conflicts,
+			"error":           run.Error,
+			"started_at":      formatTimestamp(run.StartedAt),
+			"finished_at":     formatTimestamp(run.FinishedAt),
+		}
+	}
+	return formatted
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
+)
+
+// FeatureFlagHandler exposes admin endpoints to manage feature flags
+type FeatureFlagHandler struct {
+	flags  *featureflags.Client
+	logger *zap.Logger
+}
+
+// NewFeatureFlagHandler creates a new FeatureFlagHandler
+func NewFeatureFlagHandler(flags *featureflags.Client, logger *zap.Logger) *FeatureFlagHandler {
+	return &FeatureFlagHandler{
+		flags:  flags,
+		logger: logger,
+	}
+}
+
+// FeatureFlagRequest is the payload for creating or updating a flag
+type FeatureFlagRequest struct {
+	Description       string   `json:"description"`
+	Enabled           bool     `json:"enabled"`
+	RolloutPercentage *int     `json:"rollout_percentage"`
+	TargetUsers       []string `json:"target_users"`
+}
+
+// ListFeatureFlags returns all flags
+func (h *FeatureFlagHandler) ListFeatureFlags(c *gin.Context) {
+	flags, err := h.flags.List(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err, "Failed to list feature flags")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"flags": flags, "total": len(flags)})
+}
+
+// GetFeatureFlag returns a single flag
+func (h *FeatureFlagHandler) GetFeatureFlag(c *gin.Context) {
+	flag, err := h.flags.Get(c.Request.Context(), c.Param("key"))
+	if err != nil {
+		h.handleError(c, err, "Failed to get feature flag")
+		return
+	}
+
+	c.JSON(http.StatusOK, flag)
+}
+
+// UpsertFeatureFlag creates or replaces a flag. A missing rollout_percentage
+// means the flag applies to everyone while enabled.
+func (h *FeatureFlagHandler) UpsertFeatureFlag(c *gin.Context) {
+	var req FeatureFlagRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rollout := 100
+	if req.RolloutPercentage != nil {
+		rollout = *req.RolloutPercentage
+	}
+
+	flag := &featureflags.Flag{
+		Key:               c.Param("key"),
+		Description:       req.Description,
+		Enabled:           req.Enabled,
+		RolloutPercentage: rollout,
+		TargetUsers:       req.TargetUsers,
+		UpdatedBy:         c.GetString("user_id"),
+	}
+	if err := h.flags.Save(c.Request.Context(), flag); err != nil {
+		h.handleError(c, err, "Failed to save feature flag")
+		return
+	}
+
+	h.logger.Info("Feature flag updated",
+		zap.String("key", flag.Key),
+		zap.Bool("enabled", flag.Enabled),
+		zap.Int("rollout_percentage", flag.RolloutPercentage),
+		zap.String("updated_by", flag.UpdatedBy))
+
+	c.JSON(http.StatusOK, flag)
+}
+
+// DeleteFeatureFlag removes a flag
+func (h *FeatureFlagHandler) DeleteFeatureFlag(c *gin.Context) {
+	key := c.Param("key")
+	if err := h.flags.Delete(c.Request.Context(), key); err != nil {
+		h.handleError(c, err, "Failed to delete feature flag")
+		return
+	}
+
+	h.logger.Info("Feature flag deleted", zap.String("key", key))
+	c.JSON(http.StatusOK, gin.H{"message": "Feature flag deleted successfully"})
+}
+
+func (h *FeatureFlagHandler) handleError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, featureflags.ErrFlagNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error(), "request_id": middleware.GetRequestID(c)})
+	case errors.Is(err, featureflags.ErrInvalidFlag):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error(), "request_id": middleware.GetRequestID(c)})
+	default:
+		h.logger.Error(message, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message, "request_id": middleware.GetRequestID(c)})
+	}
+}
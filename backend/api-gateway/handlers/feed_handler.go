@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// ListProductFeeds handles listing the marketplace feeds of the current store
+// with fresh signed links to submit to Google Merchant Center and Facebook
+func (h *ProductHandler) ListProductFeeds(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListProductFeeds(c.Request.Context(), &pb.ListProductFeedsRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list product feeds")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"feeds": formatProductFeeds(resp.Feeds)})
+}
+
+// GenerateProductFeeds handles regenerating the marketplace feeds of the
+// current store without waiting for the next scheduled run
+func (h *ProductHandler) GenerateProductFeeds(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.GenerateProductFeeds(c.Request.Context(), &pb.GenerateProductFeedsRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to generate product feeds")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"feeds": formatProductFeeds(resp.Feeds)})
+}
+
+// DownloadProductFeed streams the feed behind a signed feed link. Marketplaces
+// fetch it without credentials, so the token is the only credential.
+func (h *ProductHandler) DownloadProductFeed(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	stream, err := h.client.DownloadProductFeed(c.Request.Context(), &pb.DownloadProductFeedRequest{
+		Token: c.Param("token"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to download feed")
+		return
+	}
+
+	// Errors such as an expired link arrive with the first chunk
+	chunk, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "feed not found"})
+			return
+		}
+		h.handleGRPCError(c, err, "Failed to download feed")
+		return
+	}
+
+	c.Header("Content-Type", chunk.ContentType)
+	c.Header("Content-Length", strconv.FormatInt(chunk.SizeBytes, 10))
+	c.Header("Content-Disposition", mime.FormatMediaType("inline", map[string]string{"filename": chunk.FileName}))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+
+	for {
+		if _, err := c.Writer.Write(chunk.Data); err != nil {
+			h.logger.Warn("Client disconnected during feed download", zap.Error(err))
+			return
+		}
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			h.logger.Error("Feed download stream failed", zap.Error(err))
+			return
+		}
+	}
+}
+
+func formatProductFeeds(feeds []*pb.ProductFeed) []gin.H {
+	formatted := make([]gin.H, len(feeds))
+	for i, feed := range feeds {
+		formatted[i] = gin.H{
+			"format":         feed.Format,
+			"product_count":  feed.ProductCount,
+			"size_bytes":     feed.SizeBytes,
+			"url":            feed.Url,
+			"url_expires_at": formatTimestamp(feed.UrlExpiresAt),
+			"generated_at":   formatTimestamp(feed.GeneratedAt),
+		}
+	}
+	return formatted
+}
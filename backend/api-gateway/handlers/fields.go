@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+)
+
+// parseFieldsQuery parses the sparse fieldset requested for a resource type,
+// from fields[<resourceType>] as in JSON:API or from a plain fields parameter
+func parseFieldsQuery(c *gin.Context, resourceType string, resource any) (*formatters.Fields, error) {
+	value, ok := c.GetQuery("fields[" + resourceType + "]")
+	if !ok {
+		value = c.Query("fields")
+	}
+	return formatters.ParseFields(value, resource)
+}
+
+// productResource returns the response struct of a product view, whose
+// attributes a sparse fieldset selects from
+func productResource(view formatters.View) any {
+	if view == formatters.ViewSummary {
+		return formatters.ProductSummary{}
+	}
+	return formatters.ProductResponse{}
+}
+
+// productListBody applies a view and a sparse fieldset to a product list
+func productListBody(list formatters.ProductListResponse, view formatters.View, fields *formatters.Fields) any {
+	var products any
+	if view == formatters.ViewSummary {
+		summary := formatters.SummarizeProductList(list)
+		if fields == nil {
+			return summary
+		}
+		products = formatters.SelectEach(fields, summary.Products)
+	} else {
+		if fields == nil {
+			return list
+		}
+		products = formatters.SelectEach(fields, list.Products)
+	}
+
+	return gin.H{
+		"products":   products,
+		"total":      list.Total,
+		"pagination": list.Pagination,
+	}
+}
@@ -0,0 +1,369 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// IntegrationKeyHeader carries the API key of a fulfillment provider
+const IntegrationKeyHeader = "X-API-Key"
+
+// Headers reporting the daily quota of the API key of a request. Quotas are
+// soft: requests over the quota are served with X-Quota-Remaining at 0.
+const (
+	QuotaLimitHeader     = "X-Quota-Limit"
+	QuotaRemainingHeader = "X-Quota-Remaining"
+	// QuotaResetHeader is the Unix time the usage of the day restarts at
+	QuotaResetHeader = "X-Quota-Reset"
+)
+
+// FulfillmentLineRequest is a quantity of a SKU in a shipment
+type FulfillmentLineRequest struct {
+	SKU      string `json:"sku"`
+	Quantity int32  `json:"quantity"`
+}
+
+// FulfillmentEventRequest is an event pushed by a fulfillment provider (3PL).
+//
+// A "stock.updated" event reports the stock of sku at the warehouse: with
+// mode "set" (the default) quantity is the counted on-hand quantity, with mode
+// "adjust" it is the change. A "shipment.confirmed" event reports that the
+// lines of order_reference left the warehouse. Events are identified by id,
+// so a provider can retry a push without applying events twice.
+type FulfillmentEventRequest struct {
+	ID            string     `json:"id" binding:"required"`
+	Type          string     `json:"type" binding:"required"`
+	WarehouseCode string     `json:"warehouse_code" binding:"required"`
+	OccurredAt    *time.Time `json:"occurred_at"`
+
+	SKU      string `json:"sku"`
+	Quantity int32  `json:"quantity"`
+	Mode     string `json:"mode"`
+
+	OrderReference string                   `json:"order_reference"`
+	Carrier        string                   `json:"carrier"`
+	TrackingNumber string                   `json:"tracking_number"`
+	Lines          []FulfillmentLineRequest `json:"lines"`
+}
+
+// FulfillmentEventsRequest represents the JSON structure of a push of
+// fulfillment events
+type FulfillmentEventsRequest struct {
+	Events []FulfillmentEventRequest `json:"events" binding:"required,min=1,max=500,dive"`
+}
+
+// CreateIntegrationKeyRequest represents the JSON structure for creating the
+// API key of a fulfillment provider
+type CreateIntegrationKeyRequest struct {
+	Provider string `json:"provider" binding:"required"`
+	Name     string `json:"name"`
+	// DailyQuota is the number of requests per day (UTC); 0 is unlimited
+	DailyQuota int `json:"daily_quota" binding:"min=0"`
+}
+
+// IntegrationKeyQuotaRequest represents the JSON structure for changing the
+// daily quota of an API key
+type IntegrationKeyQuotaRequest struct {
+	// DailyQuota is the number of requests per day (UTC); 0 removes the quota
+	DailyQuota *int `json:"daily_quota" binding:"required,min=0"`
+}
+
+// QuotaResponse is the usage of the day of an API key
+type QuotaResponse struct {
+	Provider string `json:"provider"`
+	// DailyQuota is 0 for unlimited keys
+	DailyQuota int       `json:"daily_quota"`
+	Used       int       `json:"used"`
+	Remaining  *int      `json:"remaining,omitempty"`
+	ResetsAt   time.Time `json:"resets_at"`
+}
+
+// PushFulfillmentEvents applies the stock updates and shipment confirmations
+// pushed by a fulfillment provider. The provider authenticates with the API
+// key in the X-API-Key header. Each event is reported as applied, duplicate
+// or failed.
+func (h *InventoryHandler) PushFulfillmentEvents(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	apiKey := c.GetHeader(IntegrationKeyHeader)
+	if apiKey == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "API key is required"})
+		return
+	}
+
+	var req FulfillmentEventsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	events := make([]*inventorypb.FulfillmentEvent, len(req.Events))
+	for i, e := range req.Events {
+		event := &inventorypb.FulfillmentEvent{
+			Id:             e.ID,
+			Type:           e.Type,
+			WarehouseCode:  e.WarehouseCode,
+			Sku:            e.SKU,
+			Quantity:       e.Quantity,
+			Mode:           e.Mode,
+			OrderReference: e.OrderReference,
+			Carrier:        e.Carrier,
+			TrackingNumber: e.TrackingNumber,
+		}
+		if e.OccurredAt != nil {
+			event.OccurredAt = timestamppb.New(*e.OccurredAt)
+		}
+		for _, line := range e.Lines {
+			event.Lines = append(event.Lines, &inventorypb.FulfillmentLine{Sku: line.SKU, Quantity: line.Quantity})
+		}
+		events[i] = event
+	}
+
+	resp, err := h.client.PushFulfillmentEvents(c.Request.Context(), apiKey, events)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to push fulfillment events")
+		return
+	}
+
+	setQuotaHeaders(c, resp.Quota)
+	results := make([]gin.H, len(resp.Results))
+	for i, result := range resp.Results {
+		results[i] = gin.H{
+			"id":     result.Id,
+			"status": result.Status,
+		}
+		if result.Error != "" {
+			results[i]["error"] = result.Error
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"provider": resp.Provider,
+		"results":  results,
+	})
+}
+
+// CreateIntegrationKey creates an API key for a fulfillment provider. The
+// key is only returned in this response.
+func (h *InventoryHandler) CreateIntegrationKey(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req CreateIntegrationKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.CreateIntegrationKey(c.Request.Context(), req.Provider, req.Name, req.DailyQuota)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create integration key")
+		return
+	}
+
+	result := formatIntegrationKey(resp.Key)
+	result["key"] = resp.Secret
+	c.JSON(http.StatusCreated, result)
+}
+
+// ListIntegrationKeys lists the API keys of fulfillment providers
+func (h *InventoryHandler) ListIntegrationKeys(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	keys, err := h.client.ListIntegrationKeys(c.Request.Context())
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list integration keys")
+		return
+	}
+
+	result := make([]gin.H, len(keys))
+	for i, key := range keys {
+		result[i] = formatIntegrationKey(key)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"keys":  result,
+		"total": len(result),
+	})
+}
+
+// RevokeIntegrationKey revokes the API key of a fulfillment provider
+func (h *InventoryHandler) RevokeIntegrationKey(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	key, err := h.client.RevokeIntegrationKey(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to revoke integration key")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatIntegrationKey(key))
+}
+
+// SetIntegrationKeyQuota changes the daily quota of an API key. The usage of
+// the day is kept.
+func (h *InventoryHandler) SetIntegrationKeyQuota(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req IntegrationKeyQuotaRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	key, err := h.client.SetIntegrationKeyQuota(c.Request.Context(), c.Param("id"), *req.DailyQuota)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set integration key quota")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatIntegrationKey(key))
+}
+
+// GetQuota returns the usage of the day of the API key in the X-API-Key
+// header, so integrators can monitor their consumption. Looking the quota up
+// does not count as a request.
+func (h *InventoryHandler) GetQuota(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	apiKey := c.GetHeader(IntegrationKeyHeader)
+	if apiKey == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "API key is required"})
+		return
+	}
+
+	quota, err := h.client.GetIntegrationQuota(c.Request.Context(), apiKey)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get quota")
+		return
+	}
+
+	setQuotaHeaders(c, quota)
+	resp := QuotaResponse{
+		Provider:   quota.Provider,
+		DailyQuota: int(quota.DailyQuota),
+		Used:       int(quota.Used),
+		ResetsAt:   quota.ResetsAt.AsTime(),
+	}
+	if quota.DailyQuota > 0 {
+		remaining := int(quota.Remaining)
+		resp.Remaining = &remaining
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// ListOrderStatusEvents lists the order status changes reported by
+// fulfillment providers, oldest first. Pass the ID of the last event seen as
+// after_id to read the next page.
+func (h *InventoryHandler) ListOrderStatusEvents(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	afterID, err := strconv.ParseInt(c.DefaultQuery("after_id", "0"), 10, 64)
+	if err != nil || afterID < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid after_id"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+		return
+	}
+
+	events, err := h.client.ListOrderStatusEvents(c.Request.Context(), afterID, limit)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list order status events")
+		return
+	}
+
+	result := make([]gin.H, len(events))
+	for i, event := range events {
+		result[i] = gin.H{
+			"id":              event.Id,
+			"order_reference": event.OrderReference,
+			"status":          event.Status,
+			"provider":        event.Provider,
+			"carrier":         event.Carrier,
+			"tracking_number": event.TrackingNumber,
+			"occurred_at":     formatTimestamp(event.OccurredAt),
+			"created_at":      formatTimestamp(event.CreatedAt),
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"events": result,
+		"total":  len(result),
+	})
+}
+
+// formatIntegrationKey formats an integration key for the API response
+func formatIntegrationKey(key *inventorypb.IntegrationKey) gin.H {
+	result := gin.H{
+		"id":          key.Id,
+		"provider":    key.Provider,
+		"name":        key.Name,
+		"key_prefix":  key.KeyPrefix,
+		"daily_quota": key.DailyQuota,
+		"used_today":  key.UsedToday,
+		"created_at":  formatTimestamp(key.CreatedAt),
+	}
+	if key.LastUsedAt != nil {
+		result["last_used_at"] = formatTimestamp(key.LastUsedAt)
+	}
+	if key.RevokedAt != nil {
+		result["revoked_at"] = formatTimestamp(key.RevokedAt)
+	}
+	return result
+}
+
+// setQuotaHeaders reports the usage of the day of the API key of a request.
+// Unlimited keys get no quota headers.
+func setQuotaHeaders(c *gin.Context, quota *inventorypb.IntegrationQuota) {
+	if quota == nil || quota.DailyQuota == 0 {
+		return
+	}
+	c.Header(QuotaLimitHeader, strconv.Itoa(int(quota.DailyQuota)))
+	c.Header(QuotaRemainingHeader, strconv.Itoa(int(quota.Remaining)))
+	if quota.ResetsAt != nil {
+		c.Header(QuotaResetHeader, strconv.FormatInt(quota.ResetsAt.AsTime().Unix(), 10))
+	}
+}
@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// maxCatalogFileSize keeps catalog files under the 4 MiB gRPC message limit
+const maxCatalogFileSize = 3 << 20
+
+// ImportTemplateRequest represents the JSON structure of the import template
+// of a supplier
+type ImportTemplateRequest struct {
+	// ColumnMappings maps supplier column names to product fields: title,
+	// slug, description, short_description, sku, price, discount_price or
+	// weight. title, sku and price must be mapped.
+	ColumnMappings    map[string]string `json:"column_mappings" binding:"required"`
+	DefaultBrandID    string            `json:"default_brand_id"`
+	DefaultCategoryID string            `json:"default_category_id"`
+	// PriceMultiplier applies to supplier prices, e.g. 1.4 for a 40% markup;
+	// defaults to 1
+	PriceMultiplier float64 `json:"price_multiplier" binding:"omitempty,gt=0"`
+	Publish         bool    `json:"publish"`
+}
+
+// ListImportTemplates lists the import templates of the store
+func (h *ProductHandler) ListImportTemplates(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListImportTemplates(c.Request.Context(), &pb.ListImportTemplatesRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list import templates")
+		return
+	}
+
+	templates := make([]gin.H, len(resp.Templates))
+	for i, template := range resp.Templates {
+		templates[i] = formatImportTemplate(template)
+	}
+	c.JSON(http.StatusOK, gin.H{"templates": templates})
+}
+
+// GetImportTemplate returns the import template of a supplier
+func (h *ProductHandler) GetImportTemplate(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	template, err := h.client.GetImportTemplate(c.Request.Context(), &pb.GetImportTemplateRequest{
+		Supplier: c.Param("supplier"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get import template")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatImportTemplate(template))
+}
+
+// SaveImportTemplate creates or replaces the import template of a supplier
+func (h *ProductHandler) SaveImportTemplate(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req ImportTemplateRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	template, err := h.client.SaveImportTemplate(c.Request.Context(), &pb.SaveImportTemplateRequest{
+		Template: &pb.ImportTemplate{
+			Supplier:          c.Param("supplier"),
+			ColumnMappings:    req.ColumnMappings,
+			DefaultBrandId:    req.DefaultBrandID,
+			DefaultCategoryId: req.DefaultCategoryID,
+			PriceMultiplier:   req.PriceMultiplier,
+			Publish:           req.Publish,
+		},
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to save import template")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatImportTemplate(template))
+}
+
+// DeleteImportTemplate removes the import template of a supplier
+func (h *ProductHandler) DeleteImportTemplate(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteImportTemplate(c.Request.Context(), &pb.DeleteImportTemplateRequest{
+		Supplier: c.Param("supplier"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete import template")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// ImportSupplierCatalog imports the CSV catalog file of a supplier, uploaded
+// as the file form field, with the supplier's import template
+func (h *ProductHandler) ImportSupplierCatalog(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+	if file.Size > maxCatalogFileSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "catalog files cannot exceed 3 MiB"})
+		return
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		h.logger.Error("Failed to open file", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to open file"})
+		return
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(src)
+	if err != nil {
+		h.logger.Error("Failed to read file", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read file"})
+		return
+	}
+
+	resp, err := h.client.ImportSupplierCatalog(c.Request.Context(), &pb.ImportSupplierCatalogRequest{
+		Supplier: c.Param("supplier"),
+		CsvData:  data,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to import supplier catalog")
+		return
+	}
+
+	rowErrors := make([]gin.H, len(resp.Errors))
+	for i, rowErr := range resp.Errors {
+		rowErrors[i] = gin.H{"line": rowErr.Line, "error": rowErr.Error}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"imported": resp.Imported,
+		"skipped":  resp.Skipped,
+		"errors":   rowErrors,
+	})
+}
+
+func formatImportTemplate(template *pb.ImportTemplate) gin.H {
+	return gin.H{
+		"id":                  template.Id,
+		"supplier":            template.Supplier,
+		"column_mappings":     template.ColumnMappings,
+		"default_brand_id":    template.DefaultBrandId,
+		"default_category_id": template.DefaultCategoryId,
+		"price_multiplier":    template.PriceMultiplier,
+		"publish":             template.Publish,
+		"created_at":          formatTimestamp(template.CreatedAt),
+		"updated_at":          formatTimestamp(template.UpdatedAt),
+	}
+}
@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// maxBulkAvailabilityLines limits how many lines a cart or checkout can check at once
+const maxBulkAvailabilityLines = 200
+
+// CheckAvailabilityBulk validates all lines of a cart or checkout in a single
+// round trip, returning per-line availability and suggested alternatives
+func (h *InventoryHandler) CheckAvailabilityBulk(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req struct {
+		Items []struct {
+			SKU         string `json:"sku" binding:"required"`
+			Quantity    int    `json:"quantity" binding:"required,min=1"`
+			WarehouseID string `json:"warehouse_id,omitempty"`
+		} `json:"items" binding:"required,min=1,dive"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if len(req.Items) > maxBulkAvailabilityLines {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "too many items"})
+		return
+	}
+
+	lines := make([]*inventorypb.BulkAvailabilityLine, 0, len(req.Items))
+	for _, item := range req.Items {
+		line := &inventorypb.BulkAvailabilityLine{
+			Sku:      item.SKU,
+			Quantity: int32(item.Quantity),
+		}
+		if item.WarehouseID != "" {
+			line.WarehouseId = &wrappers.StringValue{Value: item.WarehouseID}
+		}
+		lines = append(lines, line)
+	}
+
+	// Call the inventory service
+	resp, err := h.client.CheckAvailabilityBulk(c.Request.Context(), lines)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to check inventory availability")
+		return
+	}
+
+	// Format the response
+	items := make([]gin.H, len(resp.Lines))
+	for i, line := range resp.Lines {
+		items[i] = formatBulkAvailabilityResult(line)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"items":         items,
+		"all_available": resp.AllAvailable,
+	})
+}
+
+// formatBulkAvailabilityResult converts a line result to its JSON representation
+func formatBulkAvailabilityResult(line *inventorypb.BulkAvailabilityResult) gin.H {
+	alternatives := make([]gin.H, len(line.Alternatives))
+	for i, alternative := range line.Alternatives {
+		alternatives[i] = gin.H{
+			"type":               alternative.Type,
+			"quantity":           alternative.Quantity,
+			"available_quantity": alternative.AvailableQuantity,
+		}
+		if alternative.WarehouseId != nil {
+			alternatives[i]["warehouse_id"] = alternative.WarehouseId.Value
+		}
+		if alternative.WarehouseName != "" {
+			alternatives[i]["warehouse_name"] = alternative.WarehouseName
+		}
+	}
+
+	result := gin.H{
+		"line_index":         line.LineIndex,
+		"sku":                line.Sku,
+		"product_id":         line.ProductId,
+		"requested_quantity": line.RequestedQuantity,
+		"available_quantity": line.AvailableQuantity,
+		"available":          line.IsAvailable,
+		"status":             line.Status,
+		"availability":       line.Availability,
+		"alternatives":       alternatives,
+	}
+	if line.VariantId != nil {
+		result["variant_id"] = line.VariantId.Value
+	}
+	if line.WarehouseId != nil {
+		result["warehouse_id"] = line.WarehouseId.Value
+	}
+	return result
+}
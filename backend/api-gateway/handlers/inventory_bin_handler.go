@@ -0,0 +1,287 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// WarehouseBinRequest represents the JSON structure for creating a bin of a
+// warehouse at an address, or setting whether it is active
+type WarehouseBinRequest struct {
+	Zone  string `json:"zone" binding:"required,max=20"`
+	Aisle string `json:"aisle" binding:"required,max=20"`
+	Shelf string `json:"shelf" binding:"required,max=20"`
+	Bin   string `json:"bin" binding:"required,max=20"`
+	// IsActive defaults to true; inactive bins are skipped by pick lists
+	IsActive *bool `json:"is_active"`
+}
+
+// BinStockRequest represents the JSON structure for setting the units of a
+// product placed in a bin
+type BinStockRequest struct {
+	// Quantity of zero removes the product from the bin
+	Quantity int32 `json:"quantity" binding:"min=0"`
+	// Unit is the unit of measure of the quantity, each by default
+	Unit string `json:"unit" binding:"max=20"`
+}
+
+// PickListRequest represents the JSON structure for planning the picks of
+// an order at a warehouse
+type PickListRequest struct {
+	Lines []PickListLineRequest `json:"lines" binding:"required,min=1,max=200,dive"`
+}
+
+// PickListLineRequest is a quantity of a product to pick
+type PickListLineRequest struct {
+	ProductID string `json:"product_id" binding:"required"`
+	Quantity  int32  `json:"quantity" binding:"required,min=1"`
+}
+
+// SetWarehouseBin creates the bin of a warehouse at an address, or sets
+// whether the bin at that address is active
+func (h *InventoryHandler) SetWarehouseBin(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req WarehouseBinRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	isActive := true
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	bin, err := h.client.SetWarehouseBin(c.Request.Context(), &inventorypb.SetWarehouseBinRequest{
+		WarehouseId: c.Param("warehouse_id"),
+		Zone:        req.Zone,
+		Aisle:       req.Aisle,
+		Shelf:       req.Shelf,
+		Bin:         req.Bin,
+		IsActive:    isActive,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set warehouse bin")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatWarehouseBin(bin))
+}
+
+// ListWarehouseBins lists the bins of a warehouse by address
+func (h *InventoryHandler) ListWarehouseBins(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	bins, err := h.client.ListWarehouseBins(c.Request.Context(), c.Param("warehouse_id"), c.Query("zone"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list warehouse bins")
+		return
+	}
+
+	result := make([]gin.H, len(bins))
+	for i, bin := range bins {
+		result[i] = formatWarehouseBin(bin)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"bins":  result,
+		"total": len(result),
+	})
+}
+
+// DeleteWarehouseBin removes a bin that holds no stock
+func (h *InventoryHandler) DeleteWarehouseBin(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	if err := h.client.DeleteWarehouseBin(c.Request.Context(), c.Param("bin_id")); err != nil {
+		h.handleGRPCError(c, err, "Failed to delete warehouse bin")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// SetBinStock sets the units of a product placed in a bin
+func (h *InventoryHandler) SetBinStock(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req BinStockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	item, err := h.client.GetInventoryItem(c.Request.Context(), c.Param("product_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get inventory item")
+		return
+	}
+
+	stock, err := h.client.SetBinStock(c.Request.Context(), &inventorypb.SetBinStockRequest{
+		BinId:           c.Param("bin_id"),
+		InventoryItemId: item.Id,
+		Quantity:        req.Quantity,
+		Unit:            req.Unit,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set bin stock")
+		return
+	}
+
+	result := formatBinStock(stock)
+	result["product_id"] = item.ProductId
+	c.JSON(http.StatusOK, result)
+}
+
+// ListBinStock lists the stock placed in the bins of a warehouse, of one
+// product when given
+func (h *InventoryHandler) ListBinStock(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var inventoryItemID string
+	if productID := c.Query("product_id"); productID != "" {
+		item, err := h.client.GetInventoryItem(c.Request.Context(), productID)
+		if err != nil {
+			h.handleGRPCError(c, err, "Failed to get inventory item")
+			return
+		}
+		inventoryItemID = item.Id
+	}
+
+	stock, err := h.client.ListBinStock(c.Request.Context(), c.Param("warehouse_id"), inventoryItemID)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list bin stock")
+		return
+	}
+
+	result := make([]gin.H, len(stock))
+	for i, s := range stock {
+		result[i] = formatBinStock(s)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"stock": result,
+		"total": len(result),
+	})
+}
+
+// GeneratePickList plans the picks of the products of an order at a
+// warehouse, ordered along the pick path through its bins
+func (h *InventoryHandler) GeneratePickList(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req PickListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Resolve the products to their inventory items
+	pbReq := &inventorypb.GeneratePickListRequest{WarehouseId: c.Param("warehouse_id")}
+	productIDs := make(map[string]string, len(req.Lines))
+	for _, line := range req.Lines {
+		item, err := h.client.GetInventoryItem(c.Request.Context(), line.ProductID)
+		if err != nil {
+			h.handleGRPCError(c, err, "Failed to get inventory item")
+			return
+		}
+		productIDs[item.Id] = item.ProductId
+		pbReq.Lines = append(pbReq.Lines, &inventorypb.PickListLine{InventoryItemId: item.Id, Quantity: line.Quantity})
+	}
+
+	list, err := h.client.GeneratePickList(c.Request.Context(), pbReq)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to generate pick list")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPickList(list, productIDs))
+}
+
+// formatPickList formats a pick list with the products of its inventory
+// items, as far as they are known
+func formatPickList(list *inventorypb.PickList, productIDs map[string]string) gin.H {
+	picks := make([]gin.H, len(list.Picks))
+	for i, pick := range list.Picks {
+		picks[i] = gin.H{
+			"sequence":          pick.Sequence,
+			"bin":               formatWarehouseBin(pick.Bin),
+			"inventory_item_id": pick.InventoryItemId,
+			"sku":               pick.Sku,
+			"quantity":          pick.Quantity,
+		}
+		if productID, ok := productIDs[pick.InventoryItemId]; ok {
+			picks[i]["product_id"] = productID
+		}
+	}
+	shortages := make([]gin.H, len(list.Shortages))
+	for i, shortage := range list.Shortages {
+		shortages[i] = gin.H{
+			"inventory_item_id": shortage.InventoryItemId,
+			"quantity":          shortage.Quantity,
+		}
+		if productID, ok := productIDs[shortage.InventoryItemId]; ok {
+			shortages[i]["product_id"] = productID
+		}
+	}
+	return gin.H{
+		"warehouse_id": list.WarehouseId,
+		"picks":        picks,
+		"shortages":    shortages,
+	}
+}
+
+func formatWarehouseBin(bin *inventorypb.WarehouseBin) gin.H {
+	return gin.H{
+		"id":           bin.Id,
+		"warehouse_id": bin.WarehouseId,
+		"code":         bin.Code,
+		"zone":         bin.Zone,
+		"aisle":        bin.Aisle,
+		"shelf":        bin.Shelf,
+		"bin":          bin.Bin,
+		"is_active":    bin.IsActive,
+		"updated_at":   formatTimestamp(bin.UpdatedAt),
+	}
+}
+
+func formatBinStock(stock *inventorypb.BinStock) gin.H {
+	return gin.H{
+		"bin":               formatWarehouseBin(stock.Bin),
+		"inventory_item_id": stock.InventoryItemId,
+		"sku":               stock.Sku,
+		"quantity":          stock.Quantity,
+		"updated_at":        formatTimestamp(stock.UpdatedAt),
+	}
+}
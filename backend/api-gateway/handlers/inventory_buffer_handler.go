@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// StockBuffersRequest represents the JSON structure for setting the stock
+// buffers of a product at a warehouse
+type StockBuffersRequest struct {
+	SafetyStock int `json:"safety_stock" binding:"min=0"`
+	MaxStock    int `json:"max_stock" binding:"min=0"`
+}
+
+// SetStockBuffers sets the safety stock held back from sale and the max stock
+// of a product at a warehouse
+func (h *InventoryHandler) SetStockBuffers(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req StockBuffersRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.MaxStock > 0 && req.MaxStock < req.SafetyStock {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "max_stock cannot be lower than safety_stock"})
+		return
+	}
+
+	// Resolve the product to its inventory item
+	item, err := h.client.GetInventoryItem(c.Request.Context(), c.Param("product_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get inventory item")
+		return
+	}
+
+	location, err := h.client.SetStockBuffers(c.Request.Context(), item.Id, c.Param("warehouse_id"), req.SafetyStock, req.MaxStock)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set stock buffers")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"inventory_item_id":  location.InventoryItemId,
+		"warehouse_id":       location.WarehouseId,
+		"quantity":           location.Quantity,
+		"available_quantity": location.AvailableQuantity,
+		"safety_stock":       location.SafetyStock,
+		"max_stock":          location.MaxStock,
+	})
+}
+
+// ListStockAlerts lists products below their reorder point and warehouse
+// locations below their safety stock or above their max stock
+func (h *InventoryHandler) ListStockAlerts(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	alerts, err := h.client.ListStockAlerts(c.Request.Context(), c.Query("warehouse_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list stock alerts")
+		return
+	}
+
+	result := make([]gin.H, len(alerts))
+	for i, alert := range alerts {
+		result[i] = gin.H{
+			"type":              alert.Type,
+			"inventory_item_id": alert.InventoryItemId,
+			"product_id":        alert.ProductId,
+			"sku":               alert.Sku,
+			"quantity":          alert.Quantity,
+			"threshold":         alert.Threshold,
+			"detected_at":       formatTimestamp(alert.DetectedAt),
+		}
+		if alert.WarehouseId != nil {
+			result[i]["warehouse_id"] = alert.WarehouseId.Value
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"alerts": result,
+		"total":  len(result),
+	})
+}
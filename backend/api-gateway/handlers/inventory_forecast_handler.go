@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// GetForecast retrieves the sales velocity, stock-out date and suggested
+// reorder quantity of a product at each of its warehouses
+func (h *InventoryHandler) GetForecast(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	productID := c.Param("product_id")
+	resp, err := h.client.GetForecast(c.Request.Context(), productID, c.Query("warehouse_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get forecast")
+		return
+	}
+
+	forecasts := make([]gin.H, len(resp.Forecasts))
+	for i, forecast := range resp.Forecasts {
+		forecasts[i] = formatForecast(forecast)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"inventory_item_id": resp.InventoryItemId,
+		"product_id":        productID,
+		"forecasts":         forecasts,
+	})
+}
+
+// ListReorderSuggestions lists the items to reorder with the suggested
+// quantities, those running out first first
+func (h *InventoryHandler) ListReorderSuggestions(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.ListReorderSuggestions(c.Request.Context(), &inventorypb.ListReorderSuggestionsRequest{
+		WarehouseId: c.Query("warehouse_id"),
+		Page:        int32(page),
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list reorder suggestions")
+		return
+	}
+
+	suggestions := make([]gin.H, len(resp.Suggestions))
+	for i, forecast := range resp.Suggestions {
+		suggestions[i] = formatForecast(forecast)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"suggestions": suggestions,
+		"total":       resp.Total,
+		"page":        page,
+		"limit":       limit,
+	})
+}
+
+func formatForecast(forecast *inventorypb.InventoryForecast) gin.H {
+	return gin.H{
+		"inventory_item_id":  forecast.InventoryItemId,
+		"product_id":         forecast.ProductId,
+		"sku":                forecast.Sku,
+		"warehouse_id":       forecast.WarehouseId,
+		"short_velocity":     forecast.ShortVelocity,
+		"long_velocity":      forecast.LongVelocity,
+		"daily_velocity":     forecast.DailyVelocity,
+		"available_quantity": forecast.AvailableQuantity,
+		"on_order_quantity":  forecast.OnOrderQuantity,
+		"safety_stock":       forecast.SafetyStock,
+		"lead_time_days":     forecast.LeadTimeDays,
+		"days_of_cover":      forecast.DaysOfCover,
+		"stockout_date":      formatTimestamp(forecast.StockoutDate),
+		"reorder_quantity":   forecast.ReorderQuantity,
+		"computed_at":        formatTimestamp(forecast.ComputedAt),
+	}
+}
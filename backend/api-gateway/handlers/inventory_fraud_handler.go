@@ -0,0 +1,185 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// FraudReviewRequest represents the JSON structure for the decision of staff
+// on an order in the fraud review queue
+type FraudReviewRequest struct {
+	Approve *bool  `json:"approve" binding:"required"`
+	Notes   string `json:"notes" binding:"max=1000"`
+}
+
+// ListFraudChecks lists the fraud checks of orders newest first, of one
+// decision or order when given
+func (h *InventoryHandler) ListFraudChecks(c *gin.Context) {
+	h.listFraudChecks(c, c.Query("decision"))
+}
+
+// ListFraudReviewQueue lists the orders waiting for fraud review, oldest
+// first
+func (h *InventoryHandler) ListFraudReviewQueue(c *gin.Context) {
+	h.listFraudChecks(c, "review")
+}
+
+func (h *InventoryHandler) listFraudChecks(c *gin.Context, decision string) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.ListFraudChecks(c.Request.Context(), &inventorypb.ListFraudChecksRequest{
+		Decision:       decision,
+		OrderReference: c.Query("order_reference"),
+		Page:           int32(page),
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list fraud checks")
+		return
+	}
+
+	checks := make([]gin.H, len(resp.Checks))
+	for i, check := range resp.Checks {
+		checks[i] = formatFraudCheck(check)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"checks": checks,
+		"total":  resp.Total,
+		"page":   page,
+		"limit":  limit,
+	})
+}
+
+// GetFraudCheck retrieves the fraud check of an order with the signals that
+// scored it
+func (h *InventoryHandler) GetFraudCheck(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	check, err := h.client.GetFraudCheck(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get fraud check")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatFraudCheck(check))
+}
+
+// ReviewFraudCheck approves or declines an order in the fraud review queue
+func (h *InventoryHandler) ReviewFraudCheck(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req FraudReviewRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	check, err := h.client.ReviewFraudCheck(c.Request.Context(), &inventorypb.ReviewFraudCheckRequest{
+		Id:         c.Param("id"),
+		Approve:    *req.Approve,
+		ReviewedBy: c.GetString("user_id"),
+		Notes:      req.Notes,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to review fraud check")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatFraudCheck(check))
+}
+
+// ListFraudEvents lists the fraud decisions on orders, oldest first. Pass the
+// ID of the last event seen as after_id to read the next page.
+func (h *InventoryHandler) ListFraudEvents(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	afterID, err := strconv.ParseInt(c.DefaultQuery("after_id", "0"), 10, 64)
+	if err != nil || afterID < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid after_id"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+		return
+	}
+
+	events, err := h.client.ListFraudEvents(c.Request.Context(), afterID, limit)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list fraud events")
+		return
+	}
+
+	result := make([]gin.H, len(events))
+	for i, event := range events {
+		result[i] = gin.H{
+			"id":              event.Id,
+			"fraud_check_id":  event.FraudCheckId,
+			"order_reference": event.OrderReference,
+			"decision":        event.Decision,
+			"score":           event.Score,
+			"reviewed_by":     event.ReviewedBy,
+			"created_at":      formatTimestamp(event.CreatedAt),
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"events": result,
+		"total":  len(result),
+	})
+}
+
+func formatFraudCheck(check *inventorypb.FraudCheck) gin.H {
+	signals := make([]gin.H, len(check.Signals))
+	for i, signal := range check.Signals {
+		signals[i] = gin.H{
+			"rule":   signal.Rule,
+			"points": signal.Points,
+			"reason": signal.Reason,
+		}
+	}
+	return gin.H{
+		"id":               check.Id,
+		"order_reference":  check.OrderReference,
+		"user_id":          check.UserId,
+		"email":            check.Email,
+		"ip_address":       check.IpAddress,
+		"billing_country":  check.BillingCountry,
+		"shipping_country": check.ShippingCountry,
+		"amount":           check.Amount,
+		"currency":         check.Currency,
+		"score":            check.Score,
+		"decision":         check.Decision,
+		"signals":          signals,
+		"reviewed_by":      check.ReviewedBy,
+		"reviewed_at":      formatTimestamp(check.ReviewedAt),
+		"review_notes":     check.ReviewNotes,
+		"created_at":       formatTimestamp(check.CreatedAt),
+		"updated_at":       formatTimestamp(check.UpdatedAt),
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/louai60/e-commerce_project/backend/api-gateway/clients"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
 	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
 )
 
@@ -87,19 +88,25 @@ func (h *InventoryHandler) CheckInventoryAvailability(c *gin.Context) {
 		return
 	}
 
+	unit := c.Query("unit")
+
 	// Call the inventory service
-	available, err := h.client.CheckInventoryAvailability(c.Request.Context(), productID, quantity)
+	available, err := h.client.CheckInventoryAvailability(c.Request.Context(), productID, quantity, unit)
 	if err != nil {
 		h.handleGRPCError(c, err, "Failed to check inventory availability")
 		return
 	}
 
 	// Format the response
-	c.JSON(http.StatusOK, gin.H{
+	response := gin.H{
 		"product_id": productID,
 		"quantity":   quantity,
 		"available":  available,
-	})
+	}
+	if unit != "" {
+		response["unit"] = unit
+	}
+	c.JSON(http.StatusOK, response)
 }
 
 // ListInventoryItems retrieves a paginated list of inventory items
@@ -200,6 +207,69 @@ func (h *InventoryHandler) ListWarehouses(c *gin.Context) {
 	})
 }
 
+// GetStockHistory retrieves the daily stock levels of a product for charting and days-of-cover
+func (h *InventoryHandler) GetStockHistory(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	productID := c.Param("product_id")
+	if productID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "product ID is required"})
+		return
+	}
+
+	// Parse the optional date range (YYYY-MM-DD)
+	var dateFrom, dateTo time.Time
+	var err error
+	if value := c.Query("date_from"); value != "" {
+		if dateFrom, err = time.Parse("2006-01-02", value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date_from, expected YYYY-MM-DD"})
+			return
+		}
+	}
+	if value := c.Query("date_to"); value != "" {
+		if dateTo, err = time.Parse("2006-01-02", value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid date_to, expected YYYY-MM-DD"})
+			return
+		}
+	}
+
+	// Call the inventory service
+	history, err := h.client.GetStockHistory(c.Request.Context(), productID, c.Query("warehouse_id"), dateFrom, dateTo)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get stock history")
+		return
+	}
+
+	// Format the response
+	snapshots := make([]map[string]interface{}, len(history.Snapshots))
+	for i, snapshot := range history.Snapshots {
+		snapshots[i] = map[string]interface{}{
+			"date":               snapshot.SnapshotDate.AsTime().Format("2006-01-02"),
+			"quantity":           snapshot.Quantity,
+			"available_quantity": snapshot.AvailableQuantity,
+			"reserved_quantity":  snapshot.ReservedQuantity,
+		}
+	}
+
+	result := gin.H{
+		"inventory_item_id":   history.InventoryItemId,
+		"product_id":          productID,
+		"snapshots":           snapshots,
+		"average_daily_usage": history.AverageDailyUsage,
+		"days_of_cover":       history.DaysOfCover,
+	}
+	if history.WarehouseId != nil {
+		result["warehouse_id"] = history.WarehouseId.Value
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // Helper function to handle gRPC errors
 func (h *InventoryHandler) handleGRPCError(c *gin.Context, err error, message string) {
 	h.logger.Error(message, zap.Error(err))
@@ -208,19 +278,21 @@ func (h *InventoryHandler) handleGRPCError(c *gin.Context, err error, message st
 	if st, ok := status.FromError(err); ok {
 		switch st.Code() {
 		case codes.NotFound:
-			c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+			c.JSON(http.StatusNotFound, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 		case codes.InvalidArgument:
-			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 		case codes.PermissionDenied:
-			c.JSON(http.StatusForbidden, gin.H{"error": st.Message()})
+			c.JSON(http.StatusForbidden, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 		case codes.Unauthenticated:
-			c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
+			c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
+		case codes.AlreadyExists, codes.FailedPrecondition:
+			c.JSON(http.StatusConflict, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 		case codes.ResourceExhausted:
-			c.JSON(http.StatusTooManyRequests, gin.H{"error": st.Message()})
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 		case codes.Unavailable:
-			c.JSON(http.StatusServiceUnavailable, gin.H{"error": st.Message()})
+			c.JSON(http.StatusServiceUnavailable, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 		default:
-			c.JSON(http.StatusInternalServerError, gin.H{"error": message})
+			c.JSON(http.StatusInternalServerError, gin.H{"error": message, "request_id": middleware.GetRequestID(c)})
 		}
 		return
 	}
@@ -262,6 +334,8 @@ func formatInventoryItem(item *inventorypb.InventoryItem) map[string]interface{}
 			"quantity":           loc.Quantity,
 			"available_quantity": loc.AvailableQuantity,
 			"reserved_quantity":  loc.ReservedQuantity,
+			"safety_stock":       loc.SafetyStock,
+			"max_stock":          loc.MaxStock,
 			"warehouse":          formatWarehouse(loc.Warehouse),
 		}
 	}
@@ -277,6 +351,7 @@ func formatInventoryItem(item *inventorypb.InventoryItem) map[string]interface{}
 		"reorder_point":      item.ReorderPoint,
 		"reorder_quantity":   item.ReorderQuantity,
 		"status":             item.Status,
+		"availability":       item.Availability,
 		"locations":          locations,
 		"last_updated":       item.LastUpdated.AsTime().Format(time.RFC3339),
 		"created_at":         item.CreatedAt.AsTime().Format(time.RFC3339),
@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// ReceiveLotRequest represents the JSON structure for receiving stock of a
+// product into a lot
+type ReceiveLotRequest struct {
+	WarehouseID string `json:"warehouse_id" binding:"required,uuid"`
+	LotNumber   string `json:"lot_number" binding:"required,max=100"`
+	// ExpiresAt is an RFC 3339 time, left out for goods that do not expire
+	ExpiresAt string `json:"expires_at"`
+	Quantity  int32  `json:"quantity" binding:"required,min=1"`
+	// Unit is the unit of measure of the quantity, each by default
+	Unit  string `json:"unit" binding:"max=20"`
+	Notes string `json:"notes"`
+}
+
+// ReceiveLot receives stock of a product into a lot with an expiry date
+func (h *InventoryHandler) ReceiveLot(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req ReceiveLotRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	pbReq := &inventorypb.ReceiveLotRequest{
+		WarehouseId: req.WarehouseID,
+		LotNumber:   req.LotNumber,
+		Quantity:    req.Quantity,
+		Unit:        req.Unit,
+		Notes:       req.Notes,
+	}
+	if req.ExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "expires_at must be an RFC 3339 time"})
+			return
+		}
+		pbReq.ExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	// Resolve the product to its inventory item
+	item, err := h.client.GetInventoryItem(c.Request.Context(), c.Param("product_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get inventory item")
+		return
+	}
+	pbReq.InventoryItemId = item.Id
+
+	lot, err := h.client.ReceiveLot(c.Request.Context(), pbReq)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to receive lot")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatLot(lot))
+}
+
+// ListLots lists the lots of a product first expired first out
+func (h *InventoryHandler) ListLots(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	item, err := h.client.GetInventoryItem(c.Request.Context(), c.Param("product_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get inventory item")
+		return
+	}
+
+	lots, err := h.client.ListLots(c.Request.Context(), &inventorypb.ListLotsRequest{
+		InventoryItemId: item.Id,
+		WarehouseId:     c.Query("warehouse_id"),
+		IncludeEmpty:    c.Query("include_empty") == "true",
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list lots")
+		return
+	}
+
+	result := make([]gin.H, len(lots))
+	for i, lot := range lots {
+		result[i] = formatLot(lot)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"lots":  result,
+		"total": len(result),
+	})
+}
+
+// ListExpiringLots reports the lots expiring within the given number of days
+// and the expired lots not written off yet
+func (h *InventoryHandler) ListExpiringLots(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	withinDays, err := strconv.Atoi(c.DefaultQuery("within_days", "7"))
+	if err != nil || withinDays < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "within_days must be a positive number"})
+		return
+	}
+	page, limit := getPaginationParams(c)
+
+	resp, err := h.client.ListExpiringLots(c.Request.Context(), &inventorypb.ListExpiringLotsRequest{
+		WithinDays:  int32(withinDays),
+		WarehouseId: c.Query("warehouse_id"),
+		Page:        int32(page),
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list expiring lots")
+		return
+	}
+
+	lots := make([]gin.H, len(resp.Lots))
+	for i, lot := range resp.Lots {
+		lots[i] = formatLot(lot)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"lots":  lots,
+		"total": resp.Total,
+		"page":  page,
+		"limit": limit,
+	})
+}
+
+func formatLot(lot *inventorypb.InventoryLot) gin.H {
+	result := gin.H{
+		"id":                 lot.Id,
+		"inventory_item_id":  lot.InventoryItemId,
+		"warehouse_id":       lot.WarehouseId,
+		"lot_number":         lot.LotNumber,
+		"expires_at":         formatTimestamp(lot.ExpiresAt),
+		"quantity":           lot.Quantity,
+		"reserved_quantity":  lot.ReservedQuantity,
+		"available_quantity": lot.AvailableQuantity,
+		"expired":            lot.Expired,
+		"received_at":        formatTimestamp(lot.ReceivedAt),
+		"written_off_at":     formatTimestamp(lot.WrittenOffAt),
+	}
+	if lot.ProductId != "" {
+		result["product_id"] = lot.ProductId
+		result["sku"] = lot.Sku
+	}
+	return result
+}
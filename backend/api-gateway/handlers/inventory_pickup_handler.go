@@ -0,0 +1,298 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// PickupOrderRequest represents the JSON structure for choosing click-and-
+// collect at checkout: the order is collected at the store of a warehouse
+type PickupOrderRequest struct {
+	OrderReference string `json:"order_reference" binding:"required,max=255"`
+	WarehouseID    string `json:"warehouse_id" binding:"required"`
+	// Email is where the customer is told the order is ready, the email of
+	// their account by default
+	Email string              `json:"email" binding:"omitempty,email"`
+	Items []PickupItemRequest `json:"items" binding:"required,min=1,max=50,dive"`
+}
+
+// PickupItemRequest is a quantity of a product of a pickup order
+type PickupItemRequest struct {
+	ProductID string `json:"product_id" binding:"required"`
+	Quantity  int32  `json:"quantity" binding:"required,min=1"`
+	// Unit is the unit of measure of the quantity, each by default
+	Unit string `json:"unit" binding:"max=20"`
+	// Personalization is checked against the personalization options of the
+	// product and shown to the staff picking the order
+	Personalization []PersonalizationValueRequest `json:"personalization" binding:"max=10,dive"`
+}
+
+// CancelPickupOrderRequest represents the JSON structure for cancelling a
+// pickup order
+type CancelPickupOrderRequest struct {
+	Reason string `json:"reason" binding:"max=1000"`
+}
+
+// CreatePickupOrder places an order of the current customer for pickup at a
+// store, reserving its items there until it is collected or cancelled. The
+// personalization of the items is validated by the product service first.
+func (h *InventoryHandler) CreatePickupOrder(c *gin.Context, productClient productpb.ProductServiceClient) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req PickupOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Email == "" {
+		req.Email = c.GetString("user_email")
+	}
+	if productClient == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+	items := make([]*inventorypb.PickupItem, len(req.Items))
+	for i, item := range req.Items {
+		values, err := validatePersonalization(c.Request.Context(), productClient, item.ProductID, item.Personalization)
+		if err != nil {
+			h.handleGRPCError(c, err, "Failed to validate personalization")
+			return
+		}
+		items[i] = &inventorypb.PickupItem{ProductId: item.ProductID, Quantity: item.Quantity, Unit: item.Unit}
+		for _, value := range values {
+			items[i].Personalization = append(items[i].Personalization, &inventorypb.PersonalizationValue{
+				OptionId: value.OptionId,
+				Name:     value.Name,
+				Text:     value.Text,
+				FileUrl:  value.FileUrl,
+				FileName: value.FileName,
+			})
+		}
+	}
+
+	order, err := h.client.CreatePickupOrder(c.Request.Context(), &inventorypb.CreatePickupOrderRequest{
+		OrderReference: req.OrderReference,
+		WarehouseId:    req.WarehouseID,
+		UserId:         c.GetString("user_id"),
+		Email:          req.Email,
+		Items:          items,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create pickup order")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatPickupOrder(order))
+}
+
+// ListMyPickupOrders lists the pickup orders of the current customer
+func (h *InventoryHandler) ListMyPickupOrders(c *gin.Context) {
+	h.listPickupOrders(c, c.GetString("user_id"))
+}
+
+// ListPickupOrders lists pickup orders, optionally of a store, customer or
+// status, oldest first
+func (h *InventoryHandler) ListPickupOrders(c *gin.Context) {
+	h.listPickupOrders(c, c.Query("user_id"))
+}
+
+func (h *InventoryHandler) listPickupOrders(c *gin.Context, userID string) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.ListPickupOrders(c.Request.Context(), &inventorypb.ListPickupOrdersRequest{
+		WarehouseId:    c.Query("warehouse_id"),
+		UserId:         userID,
+		Status:         c.Query("status"),
+		OrderReference: c.Query("order_reference"),
+		Page:           int32(page),
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list pickup orders")
+		return
+	}
+
+	orders := make([]gin.H, len(resp.Orders))
+	for i, order := range resp.Orders {
+		orders[i] = formatPickupOrder(order)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"orders": orders,
+		"total":  resp.Total,
+		"page":   page,
+		"limit":  limit,
+	})
+}
+
+// GetMyPickupOrder retrieves a pickup order of the current customer
+func (h *InventoryHandler) GetMyPickupOrder(c *gin.Context) {
+	h.getPickupOrder(c, c.GetString("user_id"))
+}
+
+// GetPickupOrder retrieves a pickup order with its lines
+func (h *InventoryHandler) GetPickupOrder(c *gin.Context) {
+	h.getPickupOrder(c, "")
+}
+
+func (h *InventoryHandler) getPickupOrder(c *gin.Context, userID string) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	order, err := h.client.GetPickupOrder(c.Request.Context(), c.Param("id"), userID)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get pickup order")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPickupOrder(order))
+}
+
+// CancelMyPickupOrder cancels a pickup order of the current customer that
+// was not collected
+func (h *InventoryHandler) CancelMyPickupOrder(c *gin.Context) {
+	h.cancelPickupOrder(c, c.GetString("user_id"))
+}
+
+// CancelPickupOrder cancels a pickup order that was not collected,
+// restocking its items at the store
+func (h *InventoryHandler) CancelPickupOrder(c *gin.Context) {
+	h.cancelPickupOrder(c, "")
+}
+
+func (h *InventoryHandler) cancelPickupOrder(c *gin.Context, userID string) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req CancelPickupOrderRequest
+	// The reason is optional
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	order, err := h.client.CancelPickupOrder(c.Request.Context(), &inventorypb.CancelPickupOrderRequest{
+		Id:     c.Param("id"),
+		UserId: userID,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to cancel pickup order")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPickupOrder(order))
+}
+
+// StartPickupPicking records that the store is picking a pickup order
+func (h *InventoryHandler) StartPickupPicking(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	order, err := h.client.StartPickupPicking(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to start picking pickup order")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPickupOrder(order))
+}
+
+// MarkPickupReady records that a pickup order waits at its store, emailing
+// the customer when to collect it by
+func (h *InventoryHandler) MarkPickupReady(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	order, err := h.client.MarkPickupReady(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to mark pickup order ready")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPickupOrder(order))
+}
+
+// CollectPickupOrder records that the customer collected a ready pickup
+// order, removing its items from the stock of the store
+func (h *InventoryHandler) CollectPickupOrder(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	order, err := h.client.CollectPickupOrder(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to collect pickup order")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPickupOrder(order))
+}
+
+func formatPickupOrder(order *inventorypb.PickupOrder) gin.H {
+	lines := make([]gin.H, len(order.Lines))
+	for i, line := range order.Lines {
+		lines[i] = gin.H{
+			"id":                line.Id,
+			"inventory_item_id": line.InventoryItemId,
+			"product_id":        line.ProductId,
+			"sku":               line.Sku,
+			"quantity":          line.Quantity,
+		}
+		if len(line.Personalization) > 0 {
+			lines[i]["personalization"] = formatPersonalization(line.Personalization)
+		}
+	}
+	return gin.H{
+		"id":              order.Id,
+		"order_reference": order.OrderReference,
+		"warehouse_id":    order.WarehouseId,
+		"user_id":         order.UserId,
+		"email":           order.Email,
+		"status":          order.Status,
+		"collect_by":      formatTimestamp(order.CollectBy),
+		"ready_at":        formatTimestamp(order.ReadyAt),
+		"collected_at":    formatTimestamp(order.CollectedAt),
+		"cancelled_at":    formatTimestamp(order.CancelledAt),
+		"cancel_reason":   order.CancelReason,
+		"lines":           lines,
+		"created_at":      formatTimestamp(order.CreatedAt),
+		"updated_at":      formatTimestamp(order.UpdatedAt),
+	}
+}
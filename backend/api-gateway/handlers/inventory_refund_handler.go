@@ -0,0 +1,318 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// RefundRequest represents the JSON structure for requesting a refund of the
+// payment of an order
+type RefundRequest struct {
+	OrderReference   string  `json:"order_reference" binding:"required,max=255"`
+	PaymentReference string  `json:"payment_reference" binding:"required,max=255"`
+	UserID           string  `json:"user_id" binding:"max=255"`
+	Type             string  `json:"type" binding:"required,oneof=full partial"`
+	Amount           float64 `json:"amount" binding:"required,gt=0"`
+	// Currency is a 3-letter ISO code; USD by default
+	Currency string `json:"currency" binding:"omitempty,len=3"`
+	Reason   string `json:"reason" binding:"max=1000"`
+	// Lines are the items returned with the refund, if any
+	Lines []RefundLineRequest `json:"lines" binding:"max=100,dive"`
+}
+
+// RefundLineRequest is an item returned with a refund. Restocked lines are
+// added back to the stock of the warehouse when the refund is approved.
+type RefundLineRequest struct {
+	ProductID   string `json:"product_id" binding:"required"`
+	Quantity    int32  `json:"quantity" binding:"required,min=1"`
+	Restock     bool   `json:"restock"`
+	WarehouseID string `json:"warehouse_id" binding:"required_if=Restock true,omitempty,uuid"`
+}
+
+// RejectRefundRequest represents the JSON structure for rejecting a refund
+type RejectRefundRequest struct {
+	Reason string `json:"reason" binding:"max=1000"`
+}
+
+// RefundResultRequest represents the JSON structure for recording the
+// outcome of an approved refund at the payment provider
+type RefundResultRequest struct {
+	Succeeded         bool   `json:"succeeded"`
+	ProviderReference string `json:"provider_reference" binding:"max=255"`
+	FailureReason     string `json:"failure_reason" binding:"max=1000"`
+}
+
+// RequestRefund records a requested refund, in full or in part, of the
+// payment of an order
+func (h *InventoryHandler) RequestRefund(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req RefundRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	pbReq := &inventorypb.RequestRefundRequest{
+		OrderReference:   req.OrderReference,
+		PaymentReference: req.PaymentReference,
+		UserId:           req.UserID,
+		Type:             req.Type,
+		Amount:           req.Amount,
+		Currency:         req.Currency,
+		Reason:           req.Reason,
+		RequestedBy:      c.GetString("user_id"),
+	}
+	productIDs := make(map[string]string, len(req.Lines))
+	for _, line := range req.Lines {
+		item, err := h.client.GetInventoryItem(c.Request.Context(), line.ProductID)
+		if err != nil {
+			h.handleGRPCError(c, err, "Failed to get inventory item")
+			return
+		}
+		productIDs[item.Id] = item.ProductId
+		pbReq.Lines = append(pbReq.Lines, &inventorypb.RefundLine{
+			InventoryItemId: item.Id,
+			Quantity:        line.Quantity,
+			Restock:         line.Restock,
+			WarehouseId:     line.WarehouseID,
+		})
+	}
+
+	refund, err := h.client.RequestRefund(c.Request.Context(), pbReq)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to request refund")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatRefund(refund, productIDs))
+}
+
+// GetRefund retrieves a refund with its returned lines
+func (h *InventoryHandler) GetRefund(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	refund, err := h.client.GetRefund(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get refund")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatRefund(refund, nil))
+}
+
+// ListRefunds lists the refunds newest first, of one status or order when
+// given
+func (h *InventoryHandler) ListRefunds(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.ListRefunds(c.Request.Context(), &inventorypb.ListRefundsRequest{
+		Status:         c.Query("status"),
+		OrderReference: c.Query("order_reference"),
+		Page:           int32(page),
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list refunds")
+		return
+	}
+
+	refunds := make([]gin.H, len(resp.Refunds))
+	for i, refund := range resp.Refunds {
+		refunds[i] = formatRefund(refund, nil)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"refunds": refunds,
+		"total":   resp.Total,
+		"page":    page,
+		"limit":   limit,
+	})
+}
+
+// ApproveRefund approves a requested refund, restocking its lines marked for
+// restocking
+func (h *InventoryHandler) ApproveRefund(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	refund, err := h.client.ApproveRefund(c.Request.Context(), c.Param("id"), c.GetString("user_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to approve refund")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatRefund(refund, nil))
+}
+
+// RejectRefund rejects a requested refund
+func (h *InventoryHandler) RejectRefund(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req RejectRefundRequest
+	// The reason is optional
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	refund, err := h.client.RejectRefund(c.Request.Context(), &inventorypb.RejectRefundRequest{
+		Id:         c.Param("id"),
+		ReviewedBy: c.GetString("user_id"),
+		Reason:     req.Reason,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to reject refund")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatRefund(refund, nil))
+}
+
+// RecordRefundResult records whether the payment provider processed an
+// approved refund
+func (h *InventoryHandler) RecordRefundResult(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req RefundResultRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	refund, err := h.client.RecordRefundResult(c.Request.Context(), &inventorypb.RecordRefundResultRequest{
+		Id:                c.Param("id"),
+		Succeeded:         req.Succeeded,
+		ProviderReference: req.ProviderReference,
+		FailureReason:     req.FailureReason,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to record refund result")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatRefund(refund, nil))
+}
+
+// ListRefundEvents lists the refund status changes, oldest first, for the
+// notification and accounting exports. Pass the ID of the last event seen as
+// after_id to read the next page.
+func (h *InventoryHandler) ListRefundEvents(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	afterID, err := strconv.ParseInt(c.DefaultQuery("after_id", "0"), 10, 64)
+	if err != nil || afterID < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid after_id"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "100"))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+		return
+	}
+
+	events, err := h.client.ListRefundEvents(c.Request.Context(), afterID, limit)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list refund events")
+		return
+	}
+
+	result := make([]gin.H, len(events))
+	for i, event := range events {
+		result[i] = gin.H{
+			"id":                event.Id,
+			"refund_id":         event.RefundId,
+			"status":            event.Status,
+			"order_reference":   event.OrderReference,
+			"payment_reference": event.PaymentReference,
+			"user_id":           event.UserId,
+			"amount":            event.Amount,
+			"currency":          event.Currency,
+			"created_at":        formatTimestamp(event.CreatedAt),
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"events": result,
+		"total":  len(result),
+	})
+}
+
+// formatRefund formats a refund for the API response. productIDs maps the
+// inventory items of its lines to their products, when known.
+func formatRefund(refund *inventorypb.Refund, productIDs map[string]string) gin.H {
+	result := gin.H{
+		"id":                 refund.Id,
+		"order_reference":    refund.OrderReference,
+		"payment_reference":  refund.PaymentReference,
+		"user_id":            refund.UserId,
+		"type":               refund.Type,
+		"amount":             refund.Amount,
+		"currency":           refund.Currency,
+		"reason":             refund.Reason,
+		"status":             refund.Status,
+		"requested_by":       refund.RequestedBy,
+		"reviewed_by":        refund.ReviewedBy,
+		"reviewed_at":        formatTimestamp(refund.ReviewedAt),
+		"provider_reference": refund.ProviderReference,
+		"failure_reason":     refund.FailureReason,
+		"processed_at":       formatTimestamp(refund.ProcessedAt),
+		"created_at":         formatTimestamp(refund.CreatedAt),
+		"updated_at":         formatTimestamp(refund.UpdatedAt),
+	}
+	lines := make([]gin.H, len(refund.Lines))
+	for i, line := range refund.Lines {
+		lines[i] = gin.H{
+			"id":                line.Id,
+			"inventory_item_id": line.InventoryItemId,
+			"sku":               line.Sku,
+			"quantity":          line.Quantity,
+			"restock":           line.Restock,
+			"warehouse_id":      line.WarehouseId,
+		}
+		if productID, ok := productIDs[line.InventoryItemId]; ok {
+			lines[i]["product_id"] = productID
+		}
+	}
+	result["lines"] = lines
+	return result
+}
@@ -0,0 +1,254 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// RetailStoreRequest represents the JSON structure for making a warehouse a
+// retail store customers can visit, or replacing its store details
+type RetailStoreRequest struct {
+	Latitude  *float64 `json:"latitude" binding:"required,min=-90,max=90"`
+	Longitude *float64 `json:"longitude" binding:"required,min=-180,max=180"`
+	// Timezone is the IANA name of the local time of the opening hours, UTC
+	// by default
+	Timezone     string                `json:"timezone" binding:"max=64"`
+	Phone        string                `json:"phone" binding:"max=30"`
+	OpeningHours []OpeningHoursRequest `json:"opening_hours" binding:"max=21,dive"`
+	// PickupEnabled defaults to true
+	PickupEnabled *bool `json:"pickup_enabled"`
+	// HoldHours is how long in-store pickup reservations are held, 48 by
+	// default
+	HoldHours int32 `json:"hold_hours" binding:"min=0"`
+}
+
+// OpeningHoursRequest is the time a store opens and closes on a day of the
+// week, 0 being Sunday
+type OpeningHoursRequest struct {
+	Weekday int32  `json:"weekday" binding:"min=0,max=6"`
+	Opens   string `json:"opens" binding:"required,len=5"`
+	Closes  string `json:"closes" binding:"required,len=5"`
+}
+
+// ReserveInStoreRequest represents the JSON structure for reserving a
+// product at a store to pick it up
+type ReserveInStoreRequest struct {
+	ProductID string `json:"product_id" binding:"required"`
+	Quantity  int32  `json:"quantity" binding:"required,min=1"`
+	// Unit is the unit of measure of the quantity, each by default
+	Unit string `json:"unit" binding:"max=20"`
+}
+
+// FindNearbyStores finds the stores near a position, nearest first, with
+// whether they are open now and, given a product, its stock at each store
+func (h *InventoryHandler) FindNearbyStores(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	latitude, err := strconv.ParseFloat(c.Query("lat"), 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "lat is required and must be a number"})
+		return
+	}
+	longitude, err := strconv.ParseFloat(c.Query("lng"), 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "lng is required and must be a number"})
+		return
+	}
+	var radiusKm float64
+	if v := c.Query("radius_km"); v != "" {
+		if radiusKm, err = strconv.ParseFloat(v, 64); err != nil || radiusKm <= 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "radius_km must be a positive number"})
+			return
+		}
+	}
+	quantity, _ := strconv.Atoi(c.DefaultQuery("quantity", "1"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	stores, err := h.client.FindNearbyStores(c.Request.Context(), &inventorypb.FindNearbyStoresRequest{
+		Latitude:  latitude,
+		Longitude: longitude,
+		RadiusKm:  radiusKm,
+		ProductId: c.Query("product_id"),
+		Quantity:  int32(quantity),
+		Limit:     int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to find nearby stores")
+		return
+	}
+
+	withStock := c.Query("product_id") != ""
+	result := make([]gin.H, len(stores))
+	for i, found := range stores {
+		result[i] = formatRetailStore(found.Store)
+		result[i]["distance_km"] = found.DistanceKm
+		result[i]["open_now"] = found.OpenNow
+		if withStock {
+			result[i]["available_quantity"] = found.AvailableQuantity
+			result[i]["in_stock"] = found.InStock
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"stores": result,
+		"total":  len(result),
+	})
+}
+
+// ReserveInStore reserves a product at a store for the current customer to
+// pick up; the stock is held for the hold hours of the store
+func (h *InventoryHandler) ReserveInStore(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req ReserveInStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.ReserveInStore(c.Request.Context(), &inventorypb.ReserveInStoreRequest{
+		WarehouseId: c.Param("warehouse_id"),
+		ProductId:   req.ProductID,
+		Quantity:    req.Quantity,
+		Unit:        req.Unit,
+		ReferenceId: c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to reserve in store")
+		return
+	}
+
+	reservation := resp.Reservation
+	c.JSON(http.StatusCreated, gin.H{
+		"reservation_id": reservation.Id,
+		"product_id":     req.ProductID,
+		"quantity":       reservation.Quantity,
+		"status":         reservation.Status,
+		"expires_at":     formatTimestamp(reservation.ExpirationTime),
+		"store":          formatRetailStore(resp.Store),
+	})
+}
+
+// ListRetailStores lists the retail stores, including those of inactive
+// warehouses, by name
+func (h *InventoryHandler) ListRetailStores(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	stores, err := h.client.ListRetailStores(c.Request.Context())
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list retail stores")
+		return
+	}
+
+	result := make([]gin.H, len(stores))
+	for i, store := range stores {
+		result[i] = formatRetailStore(store)
+		result[i]["is_active"] = store.Warehouse.GetIsActive()
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"stores": result,
+		"total":  len(result),
+	})
+}
+
+// SetRetailStore makes a warehouse a retail store, or replaces its position,
+// opening hours and pickup settings
+func (h *InventoryHandler) SetRetailStore(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req RetailStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	pickupEnabled := true
+	if req.PickupEnabled != nil {
+		pickupEnabled = *req.PickupEnabled
+	}
+	hours := make([]*inventorypb.OpeningHours, len(req.OpeningHours))
+	for i, day := range req.OpeningHours {
+		hours[i] = &inventorypb.OpeningHours{Weekday: day.Weekday, Opens: day.Opens, Closes: day.Closes}
+	}
+
+	store, err := h.client.SetRetailStore(c.Request.Context(), &inventorypb.SetRetailStoreRequest{
+		WarehouseId:   c.Param("warehouse_id"),
+		Latitude:      *req.Latitude,
+		Longitude:     *req.Longitude,
+		Timezone:      req.Timezone,
+		Phone:         req.Phone,
+		OpeningHours:  hours,
+		PickupEnabled: pickupEnabled,
+		HoldHours:     req.HoldHours,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set retail store")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatRetailStore(store))
+}
+
+// DeleteRetailStore stops a warehouse being a retail store; the warehouse
+// and its stock are kept
+func (h *InventoryHandler) DeleteRetailStore(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	if err := h.client.DeleteRetailStore(c.Request.Context(), c.Param("warehouse_id")); err != nil {
+		h.handleGRPCError(c, err, "Failed to delete retail store")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func formatRetailStore(store *inventorypb.RetailStore) gin.H {
+	warehouse := store.GetWarehouse()
+	hours := make([]gin.H, len(store.OpeningHours))
+	for i, day := range store.OpeningHours {
+		hours[i] = gin.H{"weekday": day.Weekday, "opens": day.Opens, "closes": day.Closes}
+	}
+	return gin.H{
+		"warehouse_id":   warehouse.GetId(),
+		"name":           warehouse.GetName(),
+		"address":        warehouse.GetAddress(),
+		"city":           warehouse.GetCity(),
+		"state":          warehouse.GetState(),
+		"country":        warehouse.GetCountry(),
+		"postal_code":    warehouse.GetPostalCode(),
+		"latitude":       store.Latitude,
+		"longitude":      store.Longitude,
+		"timezone":       store.Timezone,
+		"phone":          store.Phone,
+		"opening_hours":  hours,
+		"pickup_enabled": store.PickupEnabled,
+		"hold_hours":     store.HoldHours,
+	}
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// InventoryUnitRequest represents the JSON structure for setting a unit of
+// measure of a product, such as a case of 12. factor is the number of eaches
+// in one unit.
+type InventoryUnitRequest struct {
+	Name   string `json:"name" binding:"required,max=100"`
+	Factor int32  `json:"factor" binding:"required,min=2"`
+}
+
+// ListInventoryUnits lists the units of measure of a product, starting with
+// the base unit
+func (h *InventoryHandler) ListInventoryUnits(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	item, err := h.client.GetInventoryItem(c.Request.Context(), c.Param("product_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get inventory item")
+		return
+	}
+
+	units, err := h.client.ListInventoryUnits(c.Request.Context(), item.Id)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list units of measure")
+		return
+	}
+
+	result := make([]gin.H, len(units))
+	for i, unit := range units {
+		result[i] = formatInventoryUnit(unit)
+	}
+	c.JSON(http.StatusOK, gin.H{"units": result})
+}
+
+// SetInventoryUnit creates or replaces a unit of measure of a product
+func (h *InventoryHandler) SetInventoryUnit(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req InventoryUnitRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	item, err := h.client.GetInventoryItem(c.Request.Context(), c.Param("product_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get inventory item")
+		return
+	}
+
+	unit, err := h.client.SetInventoryUnit(c.Request.Context(), &inventorypb.SetInventoryUnitRequest{
+		InventoryItemId: item.Id,
+		Code:            c.Param("code"),
+		Name:            req.Name,
+		Factor:          req.Factor,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set unit of measure")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatInventoryUnit(unit))
+}
+
+// DeleteInventoryUnit removes a unit of measure of a product
+func (h *InventoryHandler) DeleteInventoryUnit(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	item, err := h.client.GetInventoryItem(c.Request.Context(), c.Param("product_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get inventory item")
+		return
+	}
+
+	if err := h.client.DeleteInventoryUnit(c.Request.Context(), item.Id, c.Param("code")); err != nil {
+		h.handleGRPCError(c, err, "Failed to delete unit of measure")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+func formatInventoryUnit(unit *inventorypb.InventoryUnit) gin.H {
+	result := gin.H{
+		"code":   unit.Code,
+		"name":   unit.Name,
+		"factor": unit.Factor,
+	}
+	if unit.UpdatedAt != nil {
+		result["updated_at"] = formatTimestamp(unit.UpdatedAt)
+	}
+	return result
+}
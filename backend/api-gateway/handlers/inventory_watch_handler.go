@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+const (
+	// maxWatchProductIDs limits how many products a storefront page can watch at once
+	maxWatchProductIDs = 100
+	// watchHeartbeatInterval keeps idle event streams open through proxies
+	watchHeartbeatInterval = 30 * time.Second
+)
+
+// WatchInventory streams live stock changes as server-sent events so that
+// storefront pages can update stock badges without polling.
+//
+// Query parameters: product_ids (comma separated), warehouse_id, include_current.
+func (h *InventoryHandler) WatchInventory(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var productIDs []string
+	for _, id := range strings.Split(c.Query("product_ids"), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			productIDs = append(productIDs, id)
+		}
+	}
+	warehouseID := c.Query("warehouse_id")
+
+	if len(productIDs) == 0 && warehouseID == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "product_ids or warehouse_id is required"})
+		return
+	}
+	if len(productIDs) > maxWatchProductIDs {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "too many product_ids"})
+		return
+	}
+
+	ctx := c.Request.Context()
+	stream, err := h.client.WatchInventory(ctx, productIDs, warehouseID, c.Query("include_current") == "true")
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to watch inventory")
+		return
+	}
+
+	// Receive on a separate goroutine so heartbeats can be sent while idle
+	events := make(chan *inventorypb.StockChangeEvent)
+	errs := make(chan error, 1)
+	go func() {
+		defer close(events)
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	heartbeat := time.NewTicker(watchHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-heartbeat.C:
+			c.SSEvent("heartbeat", gin.H{"time": time.Now().UTC().Format(time.RFC3339)})
+			return true
+		case event, ok := <-events:
+			if !ok {
+				if err := <-errs; err != io.EOF && ctx.Err() == nil {
+					h.logger.Warn("Inventory watch stream ended", zap.Error(err))
+				}
+				return false
+			}
+			c.SSEvent("stock", formatStockChangeEvent(event))
+			return true
+		}
+	})
+}
+
+// formatStockChangeEvent converts a stock change to the JSON payload of an event
+func formatStockChangeEvent(event *inventorypb.StockChangeEvent) gin.H {
+	result := gin.H{
+		"inventory_item_id":  event.InventoryItemId,
+		"product_id":         event.ProductId,
+		"sku":                event.Sku,
+		"total_quantity":     event.TotalQuantity,
+		"available_quantity": event.AvailableQuantity,
+		"reserved_quantity":  event.ReservedQuantity,
+		"status":             event.Status,
+		"availability":       event.Availability,
+		"change_type":        event.ChangeType,
+		"in_stock":           availability.OnHand(event.Availability),
+	}
+	if event.VariantId != nil {
+		result["variant_id"] = event.VariantId.Value
+	}
+	if event.WarehouseId != nil {
+		result["warehouse_id"] = event.WarehouseId.Value
+		result["warehouse_quantity"] = event.WarehouseQuantity
+		result["warehouse_available_quantity"] = event.WarehouseAvailableQuantity
+	}
+	if event.OccurredAt != nil {
+		result["occurred_at"] = event.OccurredAt.AsTime().Format(time.RFC3339)
+	}
+	return result
+}
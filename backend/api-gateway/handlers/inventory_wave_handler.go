@@ -0,0 +1,227 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// CreateWaveRequest represents the JSON structure for batching the pending
+// orders of a warehouse into a fulfillment wave
+type CreateWaveRequest struct {
+	WarehouseID string `json:"warehouse_id" binding:"required,uuid"`
+	Carrier     string `json:"carrier" binding:"max=100"`
+	// CutoffAt is an RFC 3339 time, the latest time orders were reserved at;
+	// now by default
+	CutoffAt string `json:"cutoff_at"`
+	// OrderReferences restricts the wave to these orders, such as those
+	// shipping with the carrier
+	OrderReferences []string `json:"order_references" binding:"max=500"`
+	MaxOrders       int32    `json:"max_orders" binding:"min=0,max=500"`
+}
+
+// CompleteWaveRequest represents the JSON structure for completing a wave.
+// Lines not given were picked in full.
+type CompleteWaveRequest struct {
+	Lines []PickedWaveLineRequest `json:"lines" binding:"dive"`
+}
+
+// PickedWaveLineRequest is the quantity picked of a line of a wave
+type PickedWaveLineRequest struct {
+	LineID         string `json:"line_id" binding:"required,uuid"`
+	PickedQuantity int32  `json:"picked_quantity" binding:"min=0"`
+}
+
+// CreateFulfillmentWave batches the pending orders of a warehouse up to a
+// carrier cutoff into a wave
+func (h *InventoryHandler) CreateFulfillmentWave(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req CreateWaveRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	pbReq := &inventorypb.CreateFulfillmentWaveRequest{
+		WarehouseId:     req.WarehouseID,
+		Carrier:         req.Carrier,
+		OrderReferences: req.OrderReferences,
+		MaxOrders:       req.MaxOrders,
+	}
+	if req.CutoffAt != "" {
+		cutoffAt, err := time.Parse(time.RFC3339, req.CutoffAt)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "cutoff_at must be an RFC 3339 time"})
+			return
+		}
+		pbReq.CutoffAt = timestamppb.New(cutoffAt)
+	}
+
+	wave, err := h.client.CreateFulfillmentWave(c.Request.Context(), pbReq)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create fulfillment wave")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatFulfillmentWave(wave))
+}
+
+// GetFulfillmentWave retrieves a wave with its order lines
+func (h *InventoryHandler) GetFulfillmentWave(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	wave, err := h.client.GetFulfillmentWave(c.Request.Context(), c.Param("wave_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get fulfillment wave")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatFulfillmentWave(wave))
+}
+
+// ListFulfillmentWaves lists the waves newest first
+func (h *InventoryHandler) ListFulfillmentWaves(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.ListFulfillmentWaves(c.Request.Context(), &inventorypb.ListFulfillmentWavesRequest{
+		WarehouseId: c.Query("warehouse_id"),
+		Status:      c.Query("status"),
+		Page:        int32(page),
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list fulfillment waves")
+		return
+	}
+
+	waves := make([]gin.H, len(resp.Waves))
+	for i, wave := range resp.Waves {
+		waves[i] = formatFulfillmentWave(wave)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"waves": waves,
+		"total": resp.Total,
+		"page":  page,
+		"limit": limit,
+	})
+}
+
+// GenerateWavePickList plans the picks of the orders of an open wave,
+// consolidated by item, along the pick path of its warehouse
+func (h *InventoryHandler) GenerateWavePickList(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	list, err := h.client.GenerateWavePickList(c.Request.Context(), c.Param("wave_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to generate wave pick list")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPickList(list, nil))
+}
+
+// CompleteFulfillmentWave records the quantities picked of the lines of a
+// wave and reports its orders as picked
+func (h *InventoryHandler) CompleteFulfillmentWave(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req CompleteWaveRequest
+	// An empty body completes every line in full
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	pbReq := &inventorypb.CompleteFulfillmentWaveRequest{Id: c.Param("wave_id")}
+	for _, line := range req.Lines {
+		pbReq.Lines = append(pbReq.Lines, &inventorypb.PickedWaveLine{LineId: line.LineID, PickedQuantity: line.PickedQuantity})
+	}
+
+	wave, err := h.client.CompleteFulfillmentWave(c.Request.Context(), pbReq)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to complete fulfillment wave")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatFulfillmentWave(wave))
+}
+
+// CancelFulfillmentWave cancels an open wave, releasing its orders for later
+// waves
+func (h *InventoryHandler) CancelFulfillmentWave(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	wave, err := h.client.CancelFulfillmentWave(c.Request.Context(), c.Param("wave_id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to cancel fulfillment wave")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatFulfillmentWave(wave))
+}
+
+func formatFulfillmentWave(wave *inventorypb.FulfillmentWave) gin.H {
+	result := gin.H{
+		"id":           wave.Id,
+		"warehouse_id": wave.WarehouseId,
+		"carrier":      wave.Carrier,
+		"cutoff_at":    formatTimestamp(wave.CutoffAt),
+		"status":       wave.Status,
+		"orders":       wave.Orders,
+		"created_at":   formatTimestamp(wave.CreatedAt),
+		"completed_at": formatTimestamp(wave.CompletedAt),
+	}
+	if len(wave.Lines) > 0 {
+		lines := make([]gin.H, len(wave.Lines))
+		for i, line := range wave.Lines {
+			lines[i] = gin.H{
+				"id":                line.Id,
+				"reservation_id":    line.ReservationId,
+				"order_reference":   line.OrderReference,
+				"inventory_item_id": line.InventoryItemId,
+				"sku":               line.Sku,
+				"quantity":          line.Quantity,
+				"picked_quantity":   line.PickedQuantity,
+				"status":            line.Status,
+			}
+		}
+		result["lines"] = lines
+	}
+	return result
+}
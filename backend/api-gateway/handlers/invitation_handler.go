@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// maxUserImportFileSize keeps user import files under the 4 MiB gRPC message
+// limit
+const maxUserImportFileSize = 1 << 20
+
+// AcceptInvitationRequest represents the JSON structure for accepting an
+// invitation by setting the password of the invited account
+type AcceptInvitationRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=8"`
+}
+
+// ImportUsers imports the CSV file of users (email, name, role), uploaded as
+// the file form field. Each valid row creates an invited account and emails
+// its invitation; the response holds the result of every row.
+func (h *UserHandler) ImportUsers(c *gin.Context) {
+	file, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "file is required"})
+		return
+	}
+	if file.Size > maxUserImportFileSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": "user import files cannot exceed 1 MiB"})
+		return
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		h.logger.Error("Failed to open file", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to open file"})
+		return
+	}
+	defer src.Close()
+
+	data, err := io.ReadAll(src)
+	if err != nil {
+		h.logger.Error("Failed to read file", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to read file"})
+		return
+	}
+
+	resp, err := h.client.ImportUsers(c.Request.Context(), &pb.ImportUsersRequest{
+		Csv:     data,
+		ActorId: c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to import users")
+		return
+	}
+
+	rows := make([]gin.H, len(resp.Rows))
+	for i, row := range resp.Rows {
+		rows[i] = gin.H{
+			"row":        row.Row,
+			"email":      row.Email,
+			"status":     row.Status,
+			"error":      row.Error,
+			"user_id":    row.UserId,
+			"email_sent": row.EmailSent,
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"rows":    rows,
+		"invited": resp.Invited,
+		"failed":  resp.Failed,
+	})
+}
+
+// ResendInvitation emails a new invitation to an invited account, replacing
+// the link of the previous one
+func (h *UserHandler) ResendInvitation(c *gin.Context) {
+	userID, err := h.parseUserID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	resp, err := h.client.ResendInvitation(c.Request.Context(), &pb.ResendInvitationRequest{
+		UserId:  userID,
+		ActorId: c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to resend invitation")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"invitation": gin.H{
+		"user_id":    resp.UserId,
+		"email":      resp.Email,
+		"send_count": resp.SendCount,
+		"expires_at": resp.ExpiresAt,
+	}})
+}
+
+// AcceptInvitation sets the password of an invited account from the token of
+// its invitation link, activating the account
+func (h *UserHandler) AcceptInvitation(c *gin.Context) {
+	var req AcceptInvitationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if _, err := h.client.AcceptInvitation(c.Request.Context(), &pb.AcceptInvitationRequest{
+		Token:    req.Token,
+		Password: req.Password,
+	}); err != nil {
+		h.handleGRPCError(c, err, "Failed to accept invitation")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Invitation accepted"})
+}
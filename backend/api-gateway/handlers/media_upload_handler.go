@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// CreateMediaUploadRequest represents the JSON structure for choosing the
+// type of file to upload, an image when omitted
+type CreateMediaUploadRequest struct {
+	MediaType string `json:"media_type" binding:"omitempty,oneof=image video model_3d"`
+}
+
+// ConfirmMediaUploadRequest represents the JSON structure for attaching a
+// file uploaded straight to the media provider to its product
+type ConfirmMediaUploadRequest struct {
+	AltText  string `json:"alt_text" binding:"max=255"`
+	Position int32  `json:"position" binding:"min=0"`
+}
+
+// AddProductVideoRequest represents the JSON structure for adding a video
+// hosted on YouTube or Vimeo, or served from another site, to a product
+type AddProductVideoRequest struct {
+	URL      string `json:"url" binding:"required,max=2048"`
+	AltText  string `json:"alt_text" binding:"max=255"`
+	Position int32  `json:"position" binding:"min=0"`
+}
+
+// CreateMediaUpload handles handing out a signed ticket for uploading an
+// image, video or 3D model of a product straight to the media provider. The
+// client posts the file with the returned fields to the upload URL, then
+// confirms the upload.
+func (h *ProductHandler) CreateMediaUpload(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req CreateMediaUploadRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.client.CreateMediaUpload(c.Request.Context(), &pb.CreateMediaUploadRequest{
+		ProductId: c.Param("id"),
+		MediaType: req.MediaType,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create media upload")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"id":         resp.Id,
+		"product_id": resp.ProductId,
+		"upload_url": resp.UploadUrl,
+		"fields":     resp.Fields,
+		"expires_at": formatTimestamp(resp.ExpiresAt),
+		"max_bytes":  resp.MaxBytes,
+		"formats":    resp.Formats,
+		"media_type": resp.MediaType,
+	})
+}
+
+// ConfirmMediaUpload handles attaching an uploaded file to its product, once
+// the product service checked it. Images are returned as images, videos and
+// 3D models as media processing for their thumbnail.
+func (h *ProductHandler) ConfirmMediaUpload(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req ConfirmMediaUploadRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.client.ConfirmMediaUpload(c.Request.Context(), &pb.ConfirmMediaUploadRequest{
+		ProductId: c.Param("id"),
+		UploadId:  c.Param("upload_id"),
+		AltText:   req.AltText,
+		Position:  req.Position,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to confirm media upload")
+		return
+	}
+
+	if resp.Media != nil {
+		c.JSON(http.StatusOK, formatters.FormatProductMedia(resp.Media))
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"id":         resp.Image.Id,
+		"product_id": resp.Image.ProductId,
+		"url":        resp.Image.Url,
+		"alt_text":   resp.Image.AltText,
+		"position":   resp.Image.Position,
+		"created_at": formatTimestamp(resp.Image.CreatedAt),
+	})
+}
+
+// AddProductVideo handles adding a video hosted on YouTube or Vimeo, or a
+// video file served over HTTPS, to a product
+func (h *ProductHandler) AddProductVideo(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req AddProductVideoRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.AddProductVideo(c.Request.Context(), &pb.AddProductVideoRequest{
+		ProductId: c.Param("id"),
+		Url:       req.URL,
+		AltText:   req.AltText,
+		Position:  req.Position,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to add product video")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatters.FormatProductMedia(resp))
+}
+
+// DeleteProductMedia handles removing a video or 3D model from a product
+func (h *ProductHandler) DeleteProductMedia(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	_, err := h.client.DeleteProductMedia(c.Request.Context(), &pb.DeleteProductMediaRequest{
+		ProductId: c.Param("id"),
+		MediaId:   c.Param("media_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete product media")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Product media deleted successfully"})
+}
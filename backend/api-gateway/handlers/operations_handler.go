@@ -0,0 +1,138 @@
+package handlers
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
+)
+
+// routeGroupPattern matches route groups, the first path segment after
+// /api/v1, such as products or cart
+var routeGroupPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,49}$`)
+
+// MaintenanceRequest is the payload for putting the API, or one route group
+// of it, in maintenance or back in service
+type MaintenanceRequest struct {
+	Enabled bool `json:"enabled"`
+	// Group is a route group such as products or cart, empty for the whole API
+	Group  string `json:"group"`
+	Reason string `json:"reason"`
+}
+
+// KillSwitchRequest is the payload for switching a feature off or back on
+type KillSwitchRequest struct {
+	// Disabled switches the feature off
+	Disabled bool   `json:"disabled"`
+	Reason   string `json:"reason"`
+}
+
+// OperationsResponse is the state of the operational controls
+type OperationsResponse struct {
+	Maintenance struct {
+		Global bool `json:"global"`
+		// Groups lists the route groups in maintenance
+		Groups []string `json:"groups"`
+	} `json:"maintenance"`
+	// KillSwitches tells, for each feature, whether it is switched off
+	KillSwitches map[string]bool `json:"kill_switches"`
+}
+
+// GetOperations returns which route groups are in maintenance and which
+// features are switched off
+func (h *FeatureFlagHandler) GetOperations(c *gin.Context) {
+	flags, err := h.flags.List(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err, "Failed to list operational controls")
+		return
+	}
+
+	on := make(map[string]bool, len(flags))
+	var resp OperationsResponse
+	resp.Maintenance.Groups = []string{}
+	for _, flag := range flags {
+		on[flag.Key] = flag.IsEnabledFor("")
+		if group, ok := middleware.MaintenanceGroupOf(flag.Key); ok && on[flag.Key] {
+			resp.Maintenance.Groups = append(resp.Maintenance.Groups, group)
+		}
+	}
+	slices.Sort(resp.Maintenance.Groups)
+	resp.Maintenance.Global = on[middleware.MaintenanceFlag]
+	resp.KillSwitches = make(map[string]bool, len(middleware.KillSwitchFeatures))
+	for _, feature := range middleware.KillSwitchFeatures {
+		resp.KillSwitches[feature] = on[middleware.KillSwitchFlag(feature)]
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// SetMaintenance puts the API, or one route group of it, in maintenance or
+// back in service. The admin API keeps serving.
+func (h *FeatureFlagHandler) SetMaintenance(c *gin.Context) {
+	var req MaintenanceRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	key := middleware.MaintenanceFlag
+	if req.Group != "" {
+		if !routeGroupPattern.MatchString(req.Group) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "group must be a route group such as products or cart"})
+			return
+		}
+		if middleware.IsMaintenanceExempt(req.Group) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "the " + req.Group + " routes are never put in maintenance"})
+			return
+		}
+		key = middleware.MaintenanceGroupFlag(req.Group)
+	}
+
+	h.saveControl(c, key, req.Enabled, req.Reason)
+}
+
+// SetKillSwitch switches an expensive feature off or back on
+func (h *FeatureFlagHandler) SetKillSwitch(c *gin.Context) {
+	feature := c.Param("feature")
+	if !slices.Contains(middleware.KillSwitchFeatures, feature) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "unknown feature", "features": middleware.KillSwitchFeatures})
+		return
+	}
+
+	var req KillSwitchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.saveControl(c, middleware.KillSwitchFlag(feature), req.Disabled, req.Reason)
+}
+
+// saveControl stores the flag of an operational control, on for everyone
+// when enabled
+func (h *FeatureFlagHandler) saveControl(c *gin.Context, key string, enabled bool, reason string) {
+	flag := &featureflags.Flag{
+		Key:               key,
+		Description:       reason,
+		Enabled:           enabled,
+		RolloutPercentage: 100,
+		UpdatedBy:         c.GetString("user_id"),
+	}
+	if err := h.flags.Save(c.Request.Context(), flag); err != nil {
+		h.handleError(c, err, "Failed to save operational control")
+		return
+	}
+
+	h.logger.Warn("Operational control changed",
+		zap.String("key", flag.Key),
+		zap.Bool("enabled", flag.Enabled),
+		zap.String("reason", reason),
+		zap.String("updated_by", flag.UpdatedBy))
+
+	c.JSON(http.StatusOK, flag)
+}
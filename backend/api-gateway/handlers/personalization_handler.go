@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// PersonalizationOptionRequest represents an input buyers give to
+// personalize a product, such as an engraving text or uploaded artwork
+type PersonalizationOptionRequest struct {
+	// ID keeps an existing option, and the values buyers gave for it in
+	// their carts; options without one are added
+	ID        string   `json:"id"`
+	Name      string   `json:"name" binding:"required,max=50"`
+	Type      string   `json:"type" binding:"required,oneof=text file"`
+	Required  bool     `json:"required"`
+	MaxLength int32    `json:"max_length" binding:"min=0,max=1000"`
+	FileTypes []string `json:"file_types" binding:"max=20"`
+}
+
+// PersonalizationOptionsRequest represents the JSON structure for replacing
+// the personalization options of a product
+type PersonalizationOptionsRequest struct {
+	Options []PersonalizationOptionRequest `json:"options" binding:"max=10,dive"`
+}
+
+// PersonalizationValueRequest is the input of a buyer for a personalization
+// option of a product: a text, or the URL of a file they uploaded
+type PersonalizationValueRequest struct {
+	OptionID string `json:"option_id" binding:"required"`
+	Text     string `json:"text" binding:"max=1000"`
+	FileURL  string `json:"file_url" binding:"max=2048"`
+	FileName string `json:"file_name" binding:"max=255"`
+}
+
+// SetPersonalizationOptions handles replacing the personalization options of
+// a product
+func (h *ProductHandler) SetPersonalizationOptions(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req PersonalizationOptionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	options := make([]*pb.PersonalizationOption, len(req.Options))
+	for i, option := range req.Options {
+		options[i] = &pb.PersonalizationOption{
+			Id:        option.ID,
+			Name:      option.Name,
+			Type:      option.Type,
+			Required:  option.Required,
+			MaxLength: option.MaxLength,
+			FileTypes: option.FileTypes,
+		}
+	}
+
+	resp, err := h.client.SetPersonalizationOptions(c.Request.Context(), &pb.SetPersonalizationOptionsRequest{
+		ProductId: c.Param("id"),
+		Options:   options,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set personalization options")
+		return
+	}
+
+	formatted := make([]gin.H, len(resp.Options))
+	for i, option := range resp.Options {
+		formatted[i] = gin.H{
+			"id":         option.Id,
+			"name":       option.Name,
+			"type":       option.Type,
+			"required":   option.Required,
+			"max_length": option.MaxLength,
+			"file_types": option.FileTypes,
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"product_id": c.Param("id"), "options": formatted})
+}
+
+// validatePersonalization has the product service check the values a buyer
+// gave to personalize a product, and returns the values to store with their
+// cart or order line. Products with required options are refused without
+// them.
+func validatePersonalization(ctx context.Context, client pb.ProductServiceClient, productID string, values []PersonalizationValueRequest) ([]*pb.PersonalizationValue, error) {
+	req := &pb.ValidatePersonalizationRequest{
+		ProductId: productID,
+		Values:    make([]*pb.PersonalizationValue, len(values)),
+	}
+	for i, value := range values {
+		req.Values[i] = &pb.PersonalizationValue{
+			OptionId: value.OptionID,
+			Text:     value.Text,
+			FileUrl:  value.FileURL,
+			FileName: value.FileName,
+		}
+	}
+	resp, err := client.ValidatePersonalization(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Values, nil
+}
+
+// personalizationValue is a personalization value as stored by the user
+// service with cart items and by the inventory service with pickup lines
+type personalizationValue interface {
+	GetOptionId() string
+	GetName() string
+	GetText() string
+	GetFileUrl() string
+	GetFileName() string
+}
+
+func formatPersonalization[V personalizationValue](values []V) []gin.H {
+	formatted := make([]gin.H, len(values))
+	for i, value := range values {
+		formatted[i] = gin.H{
+			"option_id": value.GetOptionId(),
+			"name":      value.GetName(),
+		}
+		if value.GetText() != "" {
+			formatted[i]["text"] = value.GetText()
+		}
+		if value.GetFileUrl() != "" {
+			formatted[i]["file_url"] = value.GetFileUrl()
+			formatted[i]["file_name"] = value.GetFileName()
+		}
+	}
+	return formatted
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// BulkAdjustPricesRequest represents the JSON structure for adjusting the
+// prices of every product of a brand, category or tag. Without apply the
+// response is a preview and nothing is changed.
+type BulkAdjustPricesRequest struct {
+	Filter struct {
+		BrandID    string `json:"brand_id"`
+		CategoryID string `json:"category_id"`
+		Tag        string `json:"tag"`
+	} `json:"filter"`
+	Operation string  `json:"operation" binding:"required,oneof=percent fixed"`
+	Amount    float64 `json:"amount" binding:"required"`
+	Apply     bool    `json:"apply"`
+	Reason    string  `json:"reason"`
+}
+
+// BulkAdjustPrices handles previewing or applying a bulk price adjustment
+func (h *ProductHandler) BulkAdjustPrices(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req BulkAdjustPricesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if req.Filter.BrandID == "" && req.Filter.CategoryID == "" && req.Filter.Tag == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "filter must set a brand_id, category_id or tag"})
+		return
+	}
+
+	resp, err := h.client.BulkAdjustPrices(c.Request.Context(), &pb.BulkAdjustPricesRequest{
+		Filter: &pb.PriceAdjustmentFilter{
+			BrandId:    req.Filter.BrandID,
+			CategoryId: req.Filter.CategoryID,
+			Tag:        req.Filter.Tag,
+		},
+		Operation: req.Operation,
+		Amount:    req.Amount,
+		Apply:     req.Apply,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to adjust prices")
+		return
+	}
+
+	adjustments := make([]gin.H, len(resp.Adjustments))
+	for i, adjustment := range resp.Adjustments {
+		adjustments[i] = gin.H{
+			"product_id":         adjustment.ProductId,
+			"sku":                adjustment.Sku,
+			"title":              adjustment.Title,
+			"old_price":          adjustment.OldPrice,
+			"new_price":          adjustment.NewPrice,
+			"old_discount_price": optionalDouble(adjustment.OldDiscountPrice),
+			"new_discount_price": optionalDouble(adjustment.NewDiscountPrice),
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"adjustments": adjustments,
+		"affected":    resp.Affected,
+		"applied":     resp.Applied,
+		"batch_id":    resp.BatchId,
+	})
+}
+
+// optionalDouble returns nil for an unset value so it is encoded as null
+func optionalDouble(value *wrapperspb.DoubleValue) interface{} {
+	if value == nil {
+		return nil
+	}
+	return value.Value
+}
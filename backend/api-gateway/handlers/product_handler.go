@@ -1,21 +1,25 @@
 package handlers
 
 import (
-	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/clients"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
 	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
 )
 
@@ -168,13 +172,32 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 		return
 	}
 
+	view, err := formatters.ParseView(c.Query("view"), formatters.ViewFull)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	fields, err := parseFieldsQuery(c, "products", productResource(view))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	relatedLimit, err := parseRelatedLimit(c, view)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	req := &pb.GetProductRequest{
 		Identifier: &pb.GetProductRequest_Id{
 			Id: id,
 		},
+		RelatedLimit: relatedLimit,
 	}
 
-	resp, err := h.client.GetProduct(context.Background(), req)
+	resp, err := h.client.GetProduct(c.Request.Context(), req)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok {
@@ -192,14 +215,32 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 		return
 	}
 
+	// A product merged into another one redirects to it
+	if resp.Id != id {
+		location := *c.Request.URL
+		location.Path = strings.TrimSuffix(location.Path, id) + resp.Id
+		c.Redirect(http.StatusMovedPermanently, location.String())
+		return
+	}
+
 	// Format the product
 	formattedProduct := formatters.FormatProduct(resp)
 
-	// Try to fetch inventory data for the product
+	// Try to fetch inventory data for the product; digital products are never out of stock
 	inventoryClient, exists := c.Get("inventory_client")
-	if exists && inventoryClient != nil {
+	if exists && inventoryClient != nil && formattedProduct.DigitalAsset == nil {
 		invClient, ok := inventoryClient.(*clients.InventoryClient)
-		if ok {
+		if ok && formattedProduct.Bundle != nil {
+			// Bundles have no stock of their own; derive it from the components
+			inventory, err := bundleInventory(c.Request.Context(), invClient, formattedProduct.Bundle)
+			if err == nil {
+				formattedProduct.Inventory = inventory
+			} else {
+				h.logger.Warn("Failed to fetch inventory data for bundle",
+					zap.Error(err),
+					zap.String("product_id", resp.Id))
+			}
+		} else if ok {
 			// Add a delay to ensure inventory data is available
 			// This helps with eventual consistency between services
 			time.Sleep(500 * time.Millisecond)
@@ -217,7 +258,8 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 				// Update the inventory data in the response with comprehensive information
 				formattedProduct.Inventory = &formatters.EnhancedInventoryInfo{
 					Status:            inventoryItem.Status,
-					Available:         inventoryItem.AvailableQuantity > 0,
+					Availability:      inventoryItem.Availability,
+					Available:         availability.Purchasable(inventoryItem.Availability),
 					Quantity:          int(inventoryItem.AvailableQuantity), // For backward compatibility
 					TotalQuantity:     int(inventoryItem.TotalQuantity),
 					AvailableQuantity: int(inventoryItem.AvailableQuantity),
@@ -246,6 +288,10 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 		}
 	}
 
+	if view == formatters.ViewFull {
+		formattedProduct.Questions = h.topQuestions(c.Request.Context(), resp.Id)
+	}
+
 	// Wrap in a products array for consistent response format
 	response := formatters.ProductListResponse{
 		Products: []formatters.ProductResponse{formattedProduct},
@@ -257,7 +303,7 @@ func (h *ProductHandler) GetProduct(c *gin.Context) {
 			TotalItems:  1,
 		},
 	}
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, productListBody(response, view, fields))
 }
 
 // ListProducts handles retrieving a paginated list of products
@@ -286,18 +332,30 @@ func (h *ProductHandler) ListProducts(c *gin.Context) {
 		return
 	}
 
+	view, err := formatters.ParseView(c.Query("view"), formatters.ViewFull)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	fields, err := parseFieldsQuery(c, "products", productResource(view))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	req := &pb.ListProductsRequest{
-		Page:  int32(page),
-		Limit: int32(limit),
+		Page:    int32(page),
+		Limit:   int32(limit),
+		Channel: c.Query("channel"),
 	}
 
 	// Log that we're retrieving products
-	h.logger.Info("Retrieving product list", zap.Int("page", page), zap.Int("limit", limit))
+	h.logger.Info("Retrieving product list", zap.Int("page", page), zap.Int("limit", limit), zap.String("channel", req.Channel))
 
-	resp, err := h.client.ListProducts(context.Background(), req)
+	resp, err := h.client.ListProducts(c.Request.Context(), req)
 	if err != nil {
-		h.logger.Error("Failed to list products", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		h.handleGRPCError(c, err, "Failed to list products")
 		return
 	}
 
@@ -333,9 +391,9 @@ func (h *ProductHandler) ListProducts(c *gin.Context) {
 	// Format the response
 	formattedResponse := formatters.FormatProductList(resp.Products, page, limit, int(resp.Total))
 
-	// Try to fetch inventory data for each product
+	// Try to fetch inventory data for each product, unless the fieldset leaves it out
 	inventoryClient, exists := c.Get("inventory_client")
-	if exists && inventoryClient != nil {
+	if exists && inventoryClient != nil && fields.Has("inventory") {
 		invClient, ok := inventoryClient.(*clients.InventoryClient)
 		if ok {
 			// Add a delay to ensure inventory data is available
@@ -343,6 +401,24 @@ func (h *ProductHandler) ListProducts(c *gin.Context) {
 			time.Sleep(500 * time.Millisecond)
 
 			for i, product := range formattedResponse.Products {
+				if product.DigitalAsset != nil {
+					// Digital products are never out of stock
+					continue
+				}
+
+				if product.Bundle != nil {
+					// Bundles have no stock of their own; derive it from the components
+					inventory, err := bundleInventory(c.Request.Context(), invClient, product.Bundle)
+					if err == nil {
+						formattedResponse.Products[i].Inventory = inventory
+					} else {
+						h.logger.Warn("Failed to fetch inventory data for bundle in list",
+							zap.Error(err),
+							zap.String("product_id", product.ID))
+					}
+					continue
+				}
+
 				// Fetch inventory data
 				inventoryItem, err := invClient.GetInventoryItem(c.Request.Context(), product.ID)
 				if err == nil && inventoryItem != nil {
@@ -356,7 +432,8 @@ func (h *ProductHandler) ListProducts(c *gin.Context) {
 					// Update the inventory data in the response with comprehensive information
 					formattedResponse.Products[i].Inventory = &formatters.EnhancedInventoryInfo{
 						Status:            inventoryItem.Status,
-						Available:         inventoryItem.AvailableQuantity > 0,
+						Availability:      inventoryItem.Availability,
+						Available:         availability.Purchasable(inventoryItem.Availability),
 						Quantity:          int(inventoryItem.AvailableQuantity), // For backward compatibility
 						TotalQuantity:     int(inventoryItem.TotalQuantity),
 						AvailableQuantity: int(inventoryItem.AvailableQuantity),
@@ -389,7 +466,7 @@ func (h *ProductHandler) ListProducts(c *gin.Context) {
 	// Log the number of formatted products
 	h.logger.Info("Formatted products", zap.Int("count", len(formattedResponse.Products)))
 
-	c.JSON(http.StatusOK, formattedResponse)
+	c.JSON(http.StatusOK, productListBody(formattedResponse, view, fields))
 }
 
 // CreateProduct handles creating a new product
@@ -426,6 +503,7 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 			Inventory        *struct {
 				InitialQuantity int `json:"initial_quantity"`
 			} `json:"inventory,omitempty"`
+			CustomMetadata map[string]any `json:"custom_metadata,omitempty"`
 		} `json:"product" binding:"required"`
 	}
 
@@ -498,6 +576,11 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 		}
 	}
 
+	if err := setCustomMetadata(product, req.Product.CustomMetadata, req.Product.Variants); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	// Convert images
 	if len(req.Product.Images) > 0 {
 		product.Images = make([]*pb.ProductImage, len(req.Product.Images))
@@ -532,6 +615,17 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 		}
 	}
 
+	// Convert attributes
+	if len(req.Product.Attributes) > 0 {
+		product.Attributes = make([]*pb.ProductAttribute, len(req.Product.Attributes))
+		for i, attr := range req.Product.Attributes {
+			product.Attributes[i] = &pb.ProductAttribute{
+				Name:  attr.Name,
+				Value: attr.Value,
+			}
+		}
+	}
+
 	// Convert specifications
 	if len(req.Product.Specifications) > 0 {
 		product.Specifications = make([]*pb.ProductSpecification, len(req.Product.Specifications))
@@ -619,7 +713,8 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 				// Update the inventory data in the response with comprehensive information
 				formattedProduct.Inventory = &formatters.EnhancedInventoryInfo{
 					Status:            inventoryItem.Status,
-					Available:         inventoryItem.AvailableQuantity > 0,
+					Availability:      inventoryItem.Availability,
+					Available:         availability.Purchasable(inventoryItem.Availability),
 					Quantity:          int(inventoryItem.AvailableQuantity), // For backward compatibility
 					TotalQuantity:     int(inventoryItem.TotalQuantity),
 					AvailableQuantity: int(inventoryItem.AvailableQuantity),
@@ -649,9 +744,12 @@ func (h *ProductHandler) CreateProduct(c *gin.Context) {
 				// provide a default inventory object with the initial quantity
 				if req.Product.Inventory != nil {
 					initialQty := req.Product.Inventory.InitialQuantity
+					// A new product has the default availability policy
+					badge := availability.Policy{}.Badge(availability.Stock{Sellable: initialQty, ReorderPoint: 5})
 					formattedProduct.Inventory = &formatters.EnhancedInventoryInfo{
 						Status:            "IN_STOCK",
-						Available:         initialQty > 0,
+						Availability:      badge,
+						Available:         availability.Purchasable(badge),
 						Quantity:          initialQty, // For backward compatibility
 						TotalQuantity:     initialQty,
 						AvailableQuantity: initialQty,
@@ -704,6 +802,9 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 			SEO              *formatters.EnhancedSEOInfo      `json:"seo,omitempty"`
 			Shipping         *formatters.EnhancedShippingInfo `json:"shipping,omitempty"`
 			Discount         *formatters.DiscountInfo         `json:"discount,omitempty"`
+			// CustomMetadata is a JSON merge patch of the product metadata,
+			// where null values remove keys
+			CustomMetadata map[string]any `json:"custom_metadata,omitempty"`
 		} `json:"product" binding:"required"`
 	}
 
@@ -793,6 +894,11 @@ func (h *ProductHandler) UpdateProduct(c *gin.Context) {
 		}
 	}
 
+	if err := setCustomMetadata(product, req.Product.CustomMetadata, req.Product.Variants); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	// Convert images
 	if len(req.Product.Images) > 0 {
 		product.Images = make([]*pb.ProductImage, len(req.Product.Images))
@@ -915,7 +1021,7 @@ func (h *ProductHandler) DeleteProduct(c *gin.Context) {
 		Id: id,
 	}
 
-	resp, err := h.client.DeleteProduct(context.Background(), req)
+	resp, err := h.client.DeleteProduct(c.Request.Context(), req)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok {
@@ -955,7 +1061,7 @@ func (h *ProductHandler) GetBrand(c *gin.Context) {
 		},
 	}
 
-	resp, err := h.client.GetBrand(context.Background(), req)
+	resp, err := h.client.GetBrand(c.Request.Context(), req)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok {
@@ -1012,7 +1118,7 @@ func (h *ProductHandler) ListBrands(c *gin.Context) {
 		Limit: int32(limit),
 	}
 
-	resp, err := h.client.ListBrands(context.Background(), req)
+	resp, err := h.client.ListBrands(c.Request.Context(), req)
 	if err != nil {
 		h.logger.Error("Failed to list brands", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
@@ -1062,7 +1168,7 @@ func (h *ProductHandler) CreateBrand(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.client.CreateBrand(context.Background(), &req)
+	resp, err := h.client.CreateBrand(c.Request.Context(), &req)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok && st.Code() == codes.InvalidArgument {
@@ -1101,13 +1207,19 @@ func (h *ProductHandler) GetCategory(c *gin.Context) {
 		return
 	}
 
+	fields, err := parseFieldsQuery(c, "categories", formatters.CategoryResponse{})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	req := &pb.GetCategoryRequest{
 		Identifier: &pb.GetCategoryRequest_Id{
 			Id: id,
 		},
 	}
 
-	resp, err := h.client.GetCategory(context.Background(), req)
+	resp, err := h.client.GetCategory(c.Request.Context(), req)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok {
@@ -1132,7 +1244,7 @@ func (h *ProductHandler) GetCategory(c *gin.Context) {
 
 	// Format the response
 	formattedCategory := formatters.FormatCategory(resp)
-	c.JSON(http.StatusOK, formattedCategory)
+	c.JSON(http.StatusOK, fields.Select(formattedCategory))
 }
 
 // ListCategories handles retrieving a paginated list of categories
@@ -1159,12 +1271,18 @@ func (h *ProductHandler) ListCategories(c *gin.Context) {
 		return
 	}
 
+	fields, err := parseFieldsQuery(c, "categories", formatters.CategoryResponse{})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	req := &pb.ListCategoriesRequest{
 		Page:  int32(page),
 		Limit: int32(limit),
 	}
 
-	resp, err := h.client.ListCategories(context.Background(), req)
+	resp, err := h.client.ListCategories(c.Request.Context(), req)
 	if err != nil {
 		h.logger.Error("Failed to list categories", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
@@ -1190,6 +1308,14 @@ func (h *ProductHandler) ListCategories(c *gin.Context) {
 	}
 
 	formattedResponse := formatters.FormatCategoryList(categories, page, limit, total)
+	if fields != nil {
+		c.JSON(http.StatusOK, gin.H{
+			"categories": formatters.SelectEach(fields, formattedResponse.Categories),
+			"total":      formattedResponse.Total,
+			"pagination": formattedResponse.Pagination,
+		})
+		return
+	}
 	c.JSON(http.StatusOK, formattedResponse)
 }
 
@@ -1244,7 +1370,7 @@ func (h *ProductHandler) CreateCategory(c *gin.Context) {
 		Category: protoCategory,
 	}
 
-	resp, err := h.client.CreateCategory(context.Background(), req)
+	resp, err := h.client.CreateCategory(c.Request.Context(), req)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok && st.Code() == codes.InvalidArgument {
@@ -1273,14 +1399,14 @@ func (h *ProductHandler) handleGRPCError(c *gin.Context, err error, message stri
 	// Check if client is nil (this shouldn't happen if we check in each handler, but just in case)
 	if h.client == nil {
 		h.logger.Error("Product service client is nil")
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable", "request_id": middleware.GetRequestID(c)})
 		return
 	}
 
 	st, ok := status.FromError(err)
 	if !ok {
 		h.logger.Error(message, zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": message + ": " + err.Error()})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message + ": " + err.Error(), "request_id": middleware.GetRequestID(c)})
 		return
 	}
 
@@ -1293,16 +1419,39 @@ func (h *ProductHandler) handleGRPCError(c *gin.Context, err error, message stri
 
 	switch st.Code() {
 	case codes.NotFound:
-		c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		c.JSON(http.StatusNotFound, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 	case codes.InvalidArgument:
-		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 	case codes.PermissionDenied:
-		c.JSON(http.StatusForbidden, gin.H{"error": st.Message()})
+		c.JSON(http.StatusForbidden, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 	case codes.Unauthenticated:
-		c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
+		c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 	case codes.AlreadyExists:
-		c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+		c.JSON(http.StatusConflict, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 	default:
-		c.JSON(http.StatusInternalServerError, gin.H{"error": message + ": " + st.Message()})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": message + ": " + st.Message(), "request_id": middleware.GetRequestID(c)})
+	}
+}
+
+// setCustomMetadata sets the custom metadata of a product and its variants
+// from a request, whose variants are in the order of product.Variants
+func setCustomMetadata(product *pb.Product, productMetadata map[string]any, variants []formatters.EnhancedVariantInfo) error {
+	if productMetadata != nil {
+		doc, err := structpb.NewStruct(productMetadata)
+		if err != nil {
+			return fmt.Errorf("invalid custom_metadata: %w", err)
+		}
+		product.Metadata = doc
+	}
+	for i, variant := range variants {
+		if variant.CustomMetadata == nil {
+			continue
+		}
+		doc, err := structpb.NewStruct(variant.CustomMetadata)
+		if err != nil {
+			return fmt.Errorf("invalid custom_metadata of variant %d: %w", i, err)
+		}
+		product.Variants[i].Metadata = doc
 	}
+	return nil
 }
@@ -8,11 +8,13 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/clients"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
 	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
 )
 
@@ -367,7 +369,8 @@ func CreateProductWithInventory(
 			// Update the inventory data in the response with comprehensive information
 			formattedProduct.Inventory = &formatters.EnhancedInventoryInfo{
 				Status:            inventoryItem.Status,
-				Available:         inventoryItem.AvailableQuantity > 0,
+				Availability:      inventoryItem.Availability,
+				Available:         availability.Purchasable(inventoryItem.Availability),
 				Quantity:          int(inventoryItem.AvailableQuantity), // For backward compatibility
 				TotalQuantity:     int(inventoryItem.TotalQuantity),
 				AvailableQuantity: int(inventoryItem.AvailableQuantity),
@@ -397,9 +400,12 @@ func CreateProductWithInventory(
 			// provide a default inventory object with the initial quantity
 			if inventoryCreated && req.Product.Inventory != nil {
 				initialQty := req.Product.Inventory.InitialQuantity
+				// A new product has the default availability policy
+				badge := availability.Policy{}.Badge(availability.Stock{Sellable: initialQty, ReorderPoint: 5})
 				formattedProduct.Inventory = &formatters.EnhancedInventoryInfo{
 					Status:            "IN_STOCK",
-					Available:         initialQty > 0,
+					Availability:      badge,
+					Available:         availability.Purchasable(badge),
 					Quantity:          initialQty, // For backward compatibility
 					TotalQuantity:     initialQty,
 					AvailableQuantity: initialQty,
@@ -420,23 +426,23 @@ func handleGRPCError(c *gin.Context, err error, message string, logger *zap.Logg
 	st, ok := status.FromError(err)
 	if !ok {
 		logger.Error(message, zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %v", message, err)})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %v", message, err), "request_id": middleware.GetRequestID(c)})
 		return
 	}
 
 	switch st.Code() {
 	case codes.InvalidArgument:
-		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 	case codes.NotFound:
-		c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+		c.JSON(http.StatusNotFound, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 	case codes.AlreadyExists:
-		c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+		c.JSON(http.StatusConflict, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 	case codes.PermissionDenied:
-		c.JSON(http.StatusForbidden, gin.H{"error": st.Message()})
+		c.JSON(http.StatusForbidden, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 	case codes.Unauthenticated:
-		c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
+		c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
 	default:
 		logger.Error(message, zap.Error(err), zap.String("grpc_code", st.Code().String()))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", message, st.Message())})
+		c.JSON(http.StatusInternalServerError, gin.H{"error": fmt.Sprintf("%s: %s", message, st.Message()), "request_id": middleware.GetRequestID(c)})
 	}
 }
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// MergeProductsRequest represents the JSON structure for merging a product
+// into the product of the route
+type MergeProductsRequest struct {
+	// SourceID is the product merged and deleted; its ID and slug then
+	// resolve to the target
+	SourceID string `json:"source_id" binding:"required"`
+}
+
+// SplitVariantRequest represents the JSON structure for promoting a variant to
+// a standalone product
+type SplitVariantRequest struct {
+	// Title defaults to the variant title
+	Title string `json:"title"`
+	Slug  string `json:"slug" binding:"required"`
+}
+
+// MergeProducts handles merging the variants, images, tags, categories and
+// collections of a source product into the product of the route
+func (h *ProductHandler) MergeProducts(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req MergeProductsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.MergeProducts(c.Request.Context(), &pb.MergeProductsRequest{
+		TargetId: c.Param("id"),
+		SourceId: req.SourceID,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to merge products")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"product":        formatters.FormatProduct(resp.Product),
+		"variants_moved": resp.VariantsMoved,
+		"images_moved":   resp.ImagesMoved,
+	})
+}
+
+// SplitVariant handles promoting a variant of the product of the route to a
+// standalone product
+func (h *ProductHandler) SplitVariant(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req SplitVariantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.SplitVariant(c.Request.Context(), &pb.SplitVariantRequest{
+		ProductId: c.Param("id"),
+		VariantId: c.Param("variant_id"),
+		Title:     req.Title,
+		Slug:      req.Slug,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to split variant")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatters.FormatProduct(resp.Product))
+}
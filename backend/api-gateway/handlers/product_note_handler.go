@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// NoteRequest represents the JSON structure of an internal staff note on a
+// user or product
+type NoteRequest struct {
+	Body string `json:"body" binding:"required,max=5000"`
+}
+
+// AdminGetProduct returns a product with the internal notes staff attached
+// to it. Notes are never part of the storefront product endpoints.
+func (h *ProductHandler) AdminGetProduct(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	product, err := h.client.GetProduct(c.Request.Context(), &pb.GetProductRequest{
+		Identifier: &pb.GetProductRequest_Id{Id: c.Param("id")},
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get product")
+		return
+	}
+
+	result := gin.H{"product": formatters.FormatProduct(product)}
+	notes, err := h.client.ListProductNotes(c.Request.Context(), &pb.ListProductNotesRequest{ProductId: product.Id})
+	if err != nil {
+		h.logger.Warn("Failed to fetch product notes", zap.Error(err), zap.String("product_id", product.Id))
+	} else {
+		result["notes"] = formatProductNotes(notes.Notes)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ListProductNotes lists the notes on a product, newest first
+func (h *ProductHandler) ListProductNotes(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListProductNotes(c.Request.Context(), &pb.ListProductNotesRequest{ProductId: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list product notes")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"notes": formatProductNotes(resp.Notes)})
+}
+
+// CreateProductNote attaches a note to a product on behalf of the current
+// admin
+func (h *ProductHandler) CreateProductNote(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req NoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	note, err := h.client.CreateProductNote(c.Request.Context(), &pb.CreateProductNoteRequest{
+		ProductId:   c.Param("id"),
+		AuthorId:    c.GetString("user_id"),
+		AuthorEmail: c.GetString("user_email"),
+		Body:        req.Body,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create product note")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatProductNote(note))
+}
+
+// UpdateProductNote changes the body of a note; only its author may do so
+func (h *ProductHandler) UpdateProductNote(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req NoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	note, err := h.client.UpdateProductNote(c.Request.Context(), &pb.UpdateProductNoteRequest{
+		Id:        c.Param("note_id"),
+		ProductId: c.Param("id"),
+		AuthorId:  c.GetString("user_id"),
+		Body:      req.Body,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to update product note")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatProductNote(note))
+}
+
+// DeleteProductNote removes a note from a product
+func (h *ProductHandler) DeleteProductNote(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteProductNote(c.Request.Context(), &pb.DeleteProductNoteRequest{
+		Id:        c.Param("note_id"),
+		ProductId: c.Param("id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete product note")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+func formatProductNotes(notes []*pb.ProductNote) []gin.H {
+	formatted := make([]gin.H, len(notes))
+	for i, note := range notes {
+		formatted[i] = formatProductNote(note)
+	}
+	return formatted
+}
+
+func formatProductNote(note *pb.ProductNote) gin.H {
+	return gin.H{
+		"id":           note.Id,
+		"product_id":   note.ProductId,
+		"author_id":    note.AuthorId,
+		"author_email": note.AuthorEmail,
+		"body":         note.Body,
+		"created_at":   formatTimestamp(note.CreatedAt),
+		"updated_at":   formatTimestamp(note.UpdatedAt),
+	}
+}
@@ -0,0 +1,262 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// productDetailQuestions is the number of top questions returned with the
+// full view of a product
+const productDetailQuestions = 3
+
+// QuestionRequest represents the JSON structure of a customer question or of
+// an answer to it
+type QuestionRequest struct {
+	Body string `json:"body" binding:"required,max=5000"`
+}
+
+// ModerationRequest sets the moderation state of a question or answer
+type ModerationRequest struct {
+	Status string `json:"status" binding:"required,oneof=pending approved rejected"`
+}
+
+// ListProductQuestions lists the approved questions on a product with their
+// approved answers, newest first or with sort=top by answer upvotes
+func (h *ProductHandler) ListProductQuestions(c *gin.Context) {
+	h.listQuestions(c, c.Param("id"), "", false)
+}
+
+// AdminListQuestions lists the questions of the current store awaiting
+// moderation, or those in another status, with all their answers
+func (h *ProductHandler) AdminListQuestions(c *gin.Context) {
+	h.listQuestions(c, c.Query("product_id"), c.DefaultQuery("status", "pending"), true)
+}
+
+func (h *ProductHandler) listQuestions(c *gin.Context, productID, questionStatus string, includeUnmoderated bool) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page number"})
+		return
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil || limit < 1 || limit > 100 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+		return
+	}
+
+	resp, err := h.client.ListProductQuestions(c.Request.Context(), &pb.ListProductQuestionsRequest{
+		ProductId:          productID,
+		Status:             questionStatus,
+		Sort:               c.Query("sort"),
+		Page:               int32(page),
+		Limit:              int32(limit),
+		IncludeUnmoderated: includeUnmoderated,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list product questions")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatQuestionList(resp, page, limit))
+}
+
+// AskProductQuestion posts a question on a product on behalf of the current
+// user; it is shown once approved
+func (h *ProductHandler) AskProductQuestion(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req QuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	question, err := h.client.AskProductQuestion(c.Request.Context(), &pb.AskProductQuestionRequest{
+		ProductId:  c.Param("id"),
+		AuthorId:   c.GetString("user_id"),
+		AuthorName: c.GetString("user_name"),
+		Body:       req.Body,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to ask product question")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatters.FormatQuestion(question))
+}
+
+// AnswerProductQuestion answers a question on behalf of the current admin or
+// seller. Admin answers are shown right away, seller answers once approved.
+func (h *ProductHandler) AnswerProductQuestion(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req QuestionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	authorRole := "seller"
+	if c.GetString("user_role") == "admin" {
+		authorRole = "admin"
+	}
+
+	answer, err := h.client.AnswerProductQuestion(c.Request.Context(), &pb.AnswerProductQuestionRequest{
+		QuestionId: c.Param("id"),
+		AuthorId:   c.GetString("user_id"),
+		AuthorName: c.GetString("user_name"),
+		AuthorRole: authorRole,
+		Body:       req.Body,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to answer product question")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatters.FormatAnswer(answer))
+}
+
+// UpvoteProductAnswer upvotes an answer on behalf of the current user; each
+// user's upvote is counted once
+func (h *ProductHandler) UpvoteProductAnswer(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	answer, err := h.client.UpvoteProductAnswer(c.Request.Context(), &pb.UpvoteProductAnswerRequest{
+		Id:      c.Param("id"),
+		VoterId: c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to upvote product answer")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatAnswer(answer))
+}
+
+// ModerateProductQuestion approves or rejects a question
+func (h *ProductHandler) ModerateProductQuestion(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req ModerationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	question, err := h.client.ModerateProductQuestion(c.Request.Context(), &pb.ModerateProductQuestionRequest{
+		Id:     c.Param("id"),
+		Status: req.Status,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to moderate product question")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatQuestion(question))
+}
+
+// ModerateProductAnswer approves or rejects an answer
+func (h *ProductHandler) ModerateProductAnswer(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req ModerationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	answer, err := h.client.ModerateProductAnswer(c.Request.Context(), &pb.ModerateProductAnswerRequest{
+		Id:     c.Param("id"),
+		Status: req.Status,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to moderate product answer")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatAnswer(answer))
+}
+
+// DeleteProductQuestion removes a question with its answers
+func (h *ProductHandler) DeleteProductQuestion(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteProductQuestion(c.Request.Context(), &pb.DeleteProductQuestionRequest{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete product question")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// DeleteProductAnswer removes an answer
+func (h *ProductHandler) DeleteProductAnswer(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteProductAnswer(c.Request.Context(), &pb.DeleteProductAnswerRequest{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete product answer")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// topQuestions returns the most upvoted answered questions on a product for
+// its detail page. Questions are an optional part of the page, so a failure
+// is logged and the product returned without them.
+func (h *ProductHandler) topQuestions(ctx context.Context, productID string) []formatters.QuestionInfo {
+	resp, err := h.client.ListProductQuestions(ctx, &pb.ListProductQuestionsRequest{
+		ProductId: productID,
+		Sort:      "top",
+		Page:      1,
+		Limit:     productDetailQuestions,
+	})
+	if err != nil {
+		h.logger.Warn("Failed to fetch product questions", zap.Error(err), zap.String("product_id", productID))
+		return nil
+	}
+	return formatters.FormatQuestions(resp.Questions)
+}
@@ -0,0 +1,166 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// maxRelatedLimit bounds the related_limit query parameter of product details
+const maxRelatedLimit = 50
+
+// CreateRelationshipRequest represents the JSON structure for linking a
+// product to another one
+type CreateRelationshipRequest struct {
+	RelatedProductID string `json:"related_product_id" binding:"required"`
+	Type             string `json:"type" binding:"required,oneof=cross_sell up_sell accessory replacement"`
+	Position         int    `json:"position" binding:"min=0"`
+}
+
+// UpdateRelationshipRequest represents the JSON structure for moving a link
+// within its type
+type UpdateRelationshipRequest struct {
+	Position *int `json:"position" binding:"required,min=0"`
+}
+
+// ListProductRelationships lists the links of a product, optionally of one
+// type, including the links to unpublished products
+func (h *ProductHandler) ListProductRelationships(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListProductRelationships(c.Request.Context(), &pb.ListProductRelationshipsRequest{
+		ProductId: c.Param("id"),
+		Type:      c.Query("type"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list product relationships")
+		return
+	}
+
+	relationships := make([]gin.H, len(resp.Relationships))
+	for i, rel := range resp.Relationships {
+		relationships[i] = formatProductRelationship(rel)
+	}
+	c.JSON(http.StatusOK, gin.H{"relationships": relationships})
+}
+
+// CreateProductRelationship links a product to another one
+func (h *ProductHandler) CreateProductRelationship(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req CreateRelationshipRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rel, err := h.client.CreateProductRelationship(c.Request.Context(), &pb.CreateProductRelationshipRequest{
+		ProductId:        c.Param("id"),
+		RelatedProductId: req.RelatedProductID,
+		Type:             req.Type,
+		Position:         int32(req.Position),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create product relationship")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatProductRelationship(rel))
+}
+
+// UpdateProductRelationship moves a link within its type
+func (h *ProductHandler) UpdateProductRelationship(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req UpdateRelationshipRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rel, err := h.client.UpdateProductRelationship(c.Request.Context(), &pb.UpdateProductRelationshipRequest{
+		Id:        c.Param("relationship_id"),
+		ProductId: c.Param("id"),
+		Position:  int32(*req.Position),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to update product relationship")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatProductRelationship(rel))
+}
+
+// DeleteProductRelationship removes a link of a product
+func (h *ProductHandler) DeleteProductRelationship(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteProductRelationship(c.Request.Context(), &pb.DeleteProductRelationshipRequest{
+		Id:        c.Param("relationship_id"),
+		ProductId: c.Param("id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete product relationship")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// parseRelatedLimit parses the related_limit query parameter of product
+// details into the limit of the product service, where zero is its
+// configured limit and a negative limit leaves the related products out.
+// The summary view has no related products.
+func parseRelatedLimit(c *gin.Context, view formatters.View) (int32, error) {
+	if view == formatters.ViewSummary {
+		return -1, nil
+	}
+	value := c.Query("related_limit")
+	if value == "" {
+		return 0, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit < 0 || limit > maxRelatedLimit {
+		return 0, fmt.Errorf("related_limit must be between 0 and %d", maxRelatedLimit)
+	}
+	if limit == 0 {
+		return -1, nil
+	}
+	return int32(limit), nil
+}
+
+func formatProductRelationship(rel *pb.ProductRelationship) gin.H {
+	return gin.H{
+		"id":                   rel.Id,
+		"product_id":           rel.ProductId,
+		"related_product_id":   rel.RelatedProductId,
+		"type":                 rel.Type,
+		"position":             rel.Position,
+		"related_title":        rel.RelatedTitle,
+		"related_slug":         rel.RelatedSlug,
+		"related_is_published": rel.RelatedIsPublished,
+		"created_at":           formatTimestamp(rel.CreatedAt),
+		"updated_at":           formatTimestamp(rel.UpdatedAt),
+	}
+}
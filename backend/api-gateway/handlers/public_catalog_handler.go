@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/clients"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// maxPublicPageSize bounds the pages of the public catalog API, whose
+// clients are anonymous
+const maxPublicPageSize = 50
+
+// publicChannel is the sales channel of the public catalog API: products
+// hidden from the marketplace channel are not listed to partners
+const publicChannel = "marketplace"
+
+// PublicListProducts handles listing the published products of the
+// marketplace channel for partners, without credentials
+func (h *ProductHandler) PublicListProducts(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	page, limit, ok := parsePublicPage(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.ListProducts(c.Request.Context(), &pb.ListProductsRequest{
+		Page:    int32(page),
+		Limit:   int32(limit),
+		Channel: publicChannel,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list public products")
+		return
+	}
+
+	formatted := formatters.FormatProductList(resp.Products, page, limit, int(resp.Total))
+	products := make([]formatters.PublicProduct, 0, len(formatted.Products))
+	for i := range formatted.Products {
+		product := &formatted.Products[i]
+		if product.Metadata == nil || !product.Metadata.IsPublished {
+			continue
+		}
+		h.attachAvailability(c, product)
+		products = append(products, formatters.PublicizeProduct(*product))
+	}
+
+	c.JSON(http.StatusOK, formatters.PublicProductListResponse{
+		Products:   products,
+		Total:      formatted.Total,
+		Pagination: formatted.Pagination,
+	})
+}
+
+// PublicGetProduct handles getting a published product by ID or slug for
+// partners, without credentials
+func (h *ProductHandler) PublicGetProduct(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	identifier := c.Param("id")
+	req := &pb.GetProductRequest{Identifier: &pb.GetProductRequest_Slug{Slug: identifier}}
+	_, err := uuid.Parse(identifier)
+	byID := err == nil
+	if byID {
+		req.Identifier = &pb.GetProductRequest_Id{Id: identifier}
+	}
+
+	resp, err := h.client.GetProduct(c.Request.Context(), req)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get public product")
+		return
+	}
+
+	// A product merged into another one redirects to it
+	if byID && resp.Id != identifier {
+		location := *c.Request.URL
+		location.Path = strings.TrimSuffix(location.Path, identifier) + resp.Id
+		c.Redirect(http.StatusMovedPermanently, location.String())
+		return
+	}
+
+	product := formatters.FormatProduct(resp)
+	if product.Metadata == nil || !product.Metadata.IsPublished {
+		c.JSON(http.StatusNotFound, gin.H{"error": "product not found"})
+		return
+	}
+	h.attachAvailability(c, &product)
+	c.JSON(http.StatusOK, formatters.PublicizeProduct(product))
+}
+
+// PublicListCategories handles listing categories for partners, without
+// credentials
+func (h *ProductHandler) PublicListCategories(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	page, limit, ok := parsePublicPage(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.ListCategories(c.Request.Context(), &pb.ListCategoriesRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list public categories")
+		return
+	}
+
+	formatted := formatters.FormatCategoryList(resp.Categories, page, limit, int(resp.Total))
+	categories := make([]formatters.PublicCategory, len(formatted.Categories))
+	for i, category := range formatted.Categories {
+		categories[i] = formatters.PublicizeCategory(category)
+	}
+
+	c.JSON(http.StatusOK, formatters.PublicCategoryListResponse{
+		Categories: categories,
+		Total:      formatted.Total,
+		Pagination: formatted.Pagination,
+	})
+}
+
+// PublicGetCategory handles getting a category by ID or slug for partners,
+// without credentials
+func (h *ProductHandler) PublicGetCategory(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	identifier := c.Param("id")
+	req := &pb.GetCategoryRequest{Identifier: &pb.GetCategoryRequest_Slug{Slug: identifier}}
+	if _, err := uuid.Parse(identifier); err == nil {
+		req.Identifier = &pb.GetCategoryRequest_Id{Id: identifier}
+	}
+
+	resp, err := h.client.GetCategory(c.Request.Context(), req)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get public category")
+		return
+	}
+	c.JSON(http.StatusOK, formatters.PublicizeCategory(formatters.FormatCategory(resp)))
+}
+
+// attachAvailability sets the stock badge of a product from the inventory
+// service. Public responses only carry the badge, so it is looked up without
+// the delays of the storefront handlers; digital products have none.
+func (h *ProductHandler) attachAvailability(c *gin.Context, product *formatters.ProductResponse) {
+	if product.DigitalAsset != nil {
+		return
+	}
+	value, exists := c.Get("inventory_client")
+	invClient, ok := value.(*clients.InventoryClient)
+	if !exists || !ok || invClient == nil {
+		return
+	}
+
+	if product.Bundle != nil {
+		inventory, err := bundleInventory(c.Request.Context(), invClient, product.Bundle)
+		if err != nil {
+			h.logger.Warn("Failed to fetch public bundle availability", zap.String("product_id", product.ID), zap.Error(err))
+			return
+		}
+		product.Inventory = inventory
+		return
+	}
+
+	item, err := invClient.GetInventoryItem(c.Request.Context(), product.ID)
+	if err != nil || item == nil {
+		h.logger.Warn("Failed to fetch public product availability", zap.String("product_id", product.ID), zap.Error(err))
+		return
+	}
+	product.Inventory = &formatters.EnhancedInventoryInfo{
+		Status:       item.Status,
+		Availability: item.Availability,
+		Available:    availability.Purchasable(item.Availability),
+	}
+}
+
+// parsePublicPage parses the page and limit of a public listing, capping the
+// limit, and answers 400 when they are invalid
+func parsePublicPage(c *gin.Context) (page, limit int, ok bool) {
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil || page < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "page must be a positive number"})
+		return 0, 0, false
+	}
+	limit, err = strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive number"})
+		return 0, 0, false
+	}
+	if limit > maxPublicPageSize {
+		limit = maxPublicPageSize
+	}
+	return page, limit, true
+}
@@ -0,0 +1,466 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// SupplierRequest represents the JSON structure for creating or updating a
+// supplier. currency defaults to USD; is_active is only used on updates.
+type SupplierRequest struct {
+	Name     string `json:"name" binding:"required,max=255"`
+	Email    string `json:"email" binding:"omitempty,email"`
+	Phone    string `json:"phone" binding:"max=50"`
+	Currency string `json:"currency" binding:"omitempty,len=3"`
+	Notes    string `json:"notes"`
+	IsActive *bool  `json:"is_active"`
+}
+
+// SupplierProductRequest represents the JSON structure for linking an
+// inventory item to a supplier
+type SupplierProductRequest struct {
+	SupplierSKU  string  `json:"supplier_sku" binding:"max=100"`
+	CostPrice    float64 `json:"cost_price" binding:"min=0"`
+	LeadTimeDays int32   `json:"lead_time_days" binding:"min=0"`
+}
+
+// PurchaseOrderLineRequest is an inventory item ordered from the supplier.
+// The quantity and unit cost are in the unit of measure of the line, each by
+// default; unit_cost defaults to the supplier's cost price.
+type PurchaseOrderLineRequest struct {
+	InventoryItemID string  `json:"inventory_item_id" binding:"required"`
+	Quantity        int32   `json:"quantity" binding:"required,min=1"`
+	UnitCost        float64 `json:"unit_cost" binding:"min=0"`
+	Unit            string  `json:"unit" binding:"max=20"`
+}
+
+// CreatePurchaseOrderRequest represents the JSON structure for creating a
+// purchase order. expected_at defaults to now plus the longest lead time of
+// the ordered items.
+type CreatePurchaseOrderRequest struct {
+	SupplierID  string                     `json:"supplier_id" binding:"required"`
+	WarehouseID string                     `json:"warehouse_id" binding:"required"`
+	Currency    string                     `json:"currency" binding:"omitempty,len=3"`
+	Notes       string                     `json:"notes"`
+	ExpectedAt  *time.Time                 `json:"expected_at"`
+	Lines       []PurchaseOrderLineRequest `json:"lines" binding:"required,min=1,max=500,dive"`
+}
+
+// ReceiptLineRequest is a quantity of an inventory item received
+type ReceiptLineRequest struct {
+	InventoryItemID string `json:"inventory_item_id" binding:"required"`
+	Quantity        int32  `json:"quantity" binding:"required,min=1"`
+}
+
+// ReceivePurchaseOrderRequest represents the JSON structure for receiving a
+// purchase order. Everything outstanding is received when lines is empty.
+type ReceivePurchaseOrderRequest struct {
+	Lines []ReceiptLineRequest `json:"lines" binding:"max=500,dive"`
+}
+
+// ListSuppliers lists suppliers, optionally filtered by is_active
+func (h *InventoryHandler) ListSuppliers(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	req := &inventorypb.ListSuppliersRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	}
+	if isActive := c.Query("is_active"); isActive != "" {
+		req.IsActive = wrapperspb.Bool(isActive == "true")
+	}
+
+	resp, err := h.client.ListSuppliers(c.Request.Context(), req)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list suppliers")
+		return
+	}
+
+	suppliers := make([]gin.H, len(resp.Suppliers))
+	for i, supplier := range resp.Suppliers {
+		suppliers[i] = formatSupplier(supplier)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"suppliers": suppliers,
+		"total":     resp.Total,
+		"page":      page,
+		"limit":     limit,
+	})
+}
+
+// CreateSupplier creates a supplier
+func (h *InventoryHandler) CreateSupplier(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req SupplierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	supplier, err := h.client.CreateSupplier(c.Request.Context(), &inventorypb.CreateSupplierRequest{
+		Name:     req.Name,
+		Email:    req.Email,
+		Phone:    req.Phone,
+		Currency: req.Currency,
+		Notes:    req.Notes,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create supplier")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatSupplier(supplier))
+}
+
+// GetSupplier returns a supplier with the inventory items it sells
+func (h *InventoryHandler) GetSupplier(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	supplier, err := h.client.GetSupplier(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get supplier")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatSupplier(supplier))
+}
+
+// UpdateSupplier replaces the details of a supplier. A supplier stays active
+// unless is_active is false.
+func (h *InventoryHandler) UpdateSupplier(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req SupplierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	supplier, err := h.client.UpdateSupplier(c.Request.Context(), &inventorypb.UpdateSupplierRequest{
+		Id:       c.Param("id"),
+		Name:     req.Name,
+		Email:    req.Email,
+		Phone:    req.Phone,
+		Currency: req.Currency,
+		Notes:    req.Notes,
+		IsActive: req.IsActive == nil || *req.IsActive,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to update supplier")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatSupplier(supplier))
+}
+
+// SetSupplierProduct links an inventory item to a supplier with its cost
+// price and lead time, or updates the link
+func (h *InventoryHandler) SetSupplierProduct(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req SupplierProductRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	product, err := h.client.SetSupplierProduct(c.Request.Context(), &inventorypb.SetSupplierProductRequest{
+		SupplierId:      c.Param("id"),
+		InventoryItemId: c.Param("item_id"),
+		SupplierSku:     req.SupplierSKU,
+		CostPrice:       req.CostPrice,
+		LeadTimeDays:    req.LeadTimeDays,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set supplier product")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatSupplierProduct(product))
+}
+
+// RemoveSupplierProduct unlinks an inventory item from a supplier
+func (h *InventoryHandler) RemoveSupplierProduct(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	if err := h.client.RemoveSupplierProduct(c.Request.Context(), c.Param("id"), c.Param("item_id")); err != nil {
+		h.handleGRPCError(c, err, "Failed to remove supplier product")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// ListPurchaseOrders lists purchase orders, optionally filtered by
+// supplier_id, status, or expected_before to find overdue receipts
+func (h *InventoryHandler) ListPurchaseOrders(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	req := &inventorypb.ListPurchaseOrdersRequest{
+		SupplierId: c.Query("supplier_id"),
+		Status:     c.Query("status"),
+		Page:       int32(page),
+		Limit:      int32(limit),
+	}
+	if expectedBefore := c.Query("expected_before"); expectedBefore != "" {
+		t, err := time.Parse(time.RFC3339, expectedBefore)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "expected_before must be an RFC 3339 time"})
+			return
+		}
+		req.ExpectedBefore = timestamppb.New(t)
+	}
+
+	resp, err := h.client.ListPurchaseOrders(c.Request.Context(), req)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list purchase orders")
+		return
+	}
+
+	orders := make([]gin.H, len(resp.PurchaseOrders))
+	for i, order := range resp.PurchaseOrders {
+		orders[i] = formatPurchaseOrder(order)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"purchase_orders": orders,
+		"total":           resp.Total,
+		"page":            page,
+		"limit":           limit,
+	})
+}
+
+// CreatePurchaseOrder creates a purchase order of stock from a supplier
+func (h *InventoryHandler) CreatePurchaseOrder(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req CreatePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pbReq := &inventorypb.CreatePurchaseOrderRequest{
+		SupplierId:  req.SupplierID,
+		WarehouseId: req.WarehouseID,
+		Currency:    req.Currency,
+		Notes:       req.Notes,
+		Lines:       make([]*inventorypb.CreatePurchaseOrderLine, len(req.Lines)),
+	}
+	if req.ExpectedAt != nil {
+		pbReq.ExpectedAt = timestamppb.New(*req.ExpectedAt)
+	}
+	for i, line := range req.Lines {
+		pbReq.Lines[i] = &inventorypb.CreatePurchaseOrderLine{
+			InventoryItemId: line.InventoryItemID,
+			Quantity:        line.Quantity,
+			UnitCost:        line.UnitCost,
+			Unit:            line.Unit,
+		}
+	}
+
+	order, err := h.client.CreatePurchaseOrder(c.Request.Context(), pbReq)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create purchase order")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatPurchaseOrder(order))
+}
+
+// GetPurchaseOrder returns a purchase order with its lines
+func (h *InventoryHandler) GetPurchaseOrder(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	order, err := h.client.GetPurchaseOrder(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get purchase order")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPurchaseOrder(order))
+}
+
+// ReceivePurchaseOrder records the receipt of stock for a purchase order and
+// adds it to the order's warehouse
+func (h *InventoryHandler) ReceivePurchaseOrder(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	// An empty body receives everything outstanding
+	var req ReceivePurchaseOrderRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	pbReq := &inventorypb.ReceivePurchaseOrderRequest{
+		Id:    c.Param("id"),
+		Lines: make([]*inventorypb.ReceiptLine, len(req.Lines)),
+	}
+	for i, line := range req.Lines {
+		pbReq.Lines[i] = &inventorypb.ReceiptLine{
+			InventoryItemId: line.InventoryItemID,
+			Quantity:        line.Quantity,
+		}
+	}
+
+	order, err := h.client.ReceivePurchaseOrder(c.Request.Context(), pbReq)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to receive purchase order")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPurchaseOrder(order))
+}
+
+// CancelPurchaseOrder cancels an open purchase order
+func (h *InventoryHandler) CancelPurchaseOrder(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	order, err := h.client.CancelPurchaseOrder(c.Request.Context(), c.Param("id"))
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to cancel purchase order")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatPurchaseOrder(order))
+}
+
+// formatSupplier formats a supplier and the inventory items it sells for
+// the API response
+func formatSupplier(supplier *inventorypb.Supplier) gin.H {
+	result := gin.H{
+		"id":         supplier.Id,
+		"name":       supplier.Name,
+		"email":      supplier.Email,
+		"phone":      supplier.Phone,
+		"currency":   supplier.Currency,
+		"notes":      supplier.Notes,
+		"is_active":  supplier.IsActive,
+		"created_at": formatTimestamp(supplier.CreatedAt),
+		"updated_at": formatTimestamp(supplier.UpdatedAt),
+	}
+	if supplier.Products != nil {
+		products := make([]gin.H, len(supplier.Products))
+		for i, product := range supplier.Products {
+			products[i] = formatSupplierProduct(product)
+		}
+		result["products"] = products
+	}
+	return result
+}
+
+// formatSupplierProduct formats a supplier's inventory item for the API
+// response
+func formatSupplierProduct(product *inventorypb.SupplierProduct) gin.H {
+	return gin.H{
+		"inventory_item_id": product.InventoryItemId,
+		"sku":               product.Sku,
+		"supplier_sku":      product.SupplierSku,
+		"cost_price":        product.CostPrice,
+		"lead_time_days":    product.LeadTimeDays,
+		"updated_at":        formatTimestamp(product.UpdatedAt),
+	}
+}
+
+// formatPurchaseOrder formats a purchase order and its lines for the API
+// response
+func formatPurchaseOrder(order *inventorypb.PurchaseOrder) gin.H {
+	result := gin.H{
+		"id":           order.Id,
+		"supplier_id":  order.SupplierId,
+		"warehouse_id": order.WarehouseId,
+		"status":       order.Status,
+		"currency":     order.Currency,
+		"notes":        order.Notes,
+		"expected_at":  formatTimestamp(order.ExpectedAt),
+		"created_at":   formatTimestamp(order.CreatedAt),
+		"updated_at":   formatTimestamp(order.UpdatedAt),
+	}
+	if order.ReceivedAt != nil {
+		result["received_at"] = formatTimestamp(order.ReceivedAt)
+	}
+	if order.Lines != nil {
+		lines := make([]gin.H, len(order.Lines))
+		for i, line := range order.Lines {
+			lines[i] = gin.H{
+				"id":                line.Id,
+				"inventory_item_id": line.InventoryItemId,
+				"sku":               line.Sku,
+				"quantity_ordered":  line.QuantityOrdered,
+				"quantity_received": line.QuantityReceived,
+				"unit_cost":         line.UnitCost,
+				"unit":              line.Unit,
+			}
+		}
+		result["lines"] = lines
+	}
+	return result
+}
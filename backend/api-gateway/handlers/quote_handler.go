@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// QuoteRequest represents the JSON structure for requesting a quote for the
+// cart of the signed in user
+type QuoteRequest struct {
+	Notes string `json:"notes" binding:"max=2000"`
+}
+
+// QuotePrice represents the negotiated unit price of an item of a quote
+type QuotePrice struct {
+	ProductID string  `json:"product_id" binding:"required"`
+	VariantID string  `json:"variant_id"`
+	UnitPrice float64 `json:"unit_price" binding:"min=0"`
+}
+
+// QuoteResponseRequest represents the JSON structure for answering a quote
+// with the negotiated price of each of its items and the expiry of the offer
+type QuoteResponseRequest struct {
+	Prices    []QuotePrice `json:"prices" binding:"required,min=1,dive"`
+	ExpiresAt string       `json:"expires_at" binding:"required"`
+	Notes     string       `json:"notes" binding:"max=2000"`
+}
+
+// ConvertQuoteRequest represents the JSON structure for recording the order
+// placed from an accepted quote
+type ConvertQuoteRequest struct {
+	OrderReference string `json:"order_reference" binding:"required,max=100"`
+}
+
+// RequestQuote requests a quote for the cart of the signed in user
+func (h *UserHandler) RequestQuote(c *gin.Context) {
+	var req QuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.RequestQuote(c.Request.Context(), &pb.RequestQuoteRequest{
+		UserId: c.GetString("user_id"),
+		Notes:  req.Notes,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to request quote")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"quote": resp.Quote})
+}
+
+// ListMyQuotes lists the quotes of the signed in user, most recent first,
+// optionally of a status
+func (h *UserHandler) ListMyQuotes(c *gin.Context) {
+	h.listQuotes(c, c.GetString("user_id"))
+}
+
+// GetMyQuote returns a quote of the signed in user
+func (h *UserHandler) GetMyQuote(c *gin.Context) {
+	resp, err := h.client.GetQuote(c.Request.Context(), &pb.GetQuoteRequest{
+		QuoteId: c.Param("id"),
+		UserId:  c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get quote")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"quote": resp.Quote})
+}
+
+// AcceptQuote accepts the offer of a quote of the signed in user before it
+// expires
+func (h *UserHandler) AcceptQuote(c *gin.Context) {
+	resp, err := h.client.AcceptQuote(c.Request.Context(), &pb.QuoteActionRequest{
+		QuoteId: c.Param("id"),
+		UserId:  c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to accept quote")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"quote": resp.Quote})
+}
+
+// DeclineQuote declines a quote of the signed in user
+func (h *UserHandler) DeclineQuote(c *gin.Context) {
+	resp, err := h.client.DeclineQuote(c.Request.Context(), &pb.QuoteActionRequest{
+		QuoteId: c.Param("id"),
+		UserId:  c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to decline quote")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"quote": resp.Quote})
+}
+
+// ConvertQuote records the order placed from an accepted quote of the signed
+// in user at its quoted prices
+func (h *UserHandler) ConvertQuote(c *gin.Context) {
+	var req ConvertQuoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.ConvertQuote(c.Request.Context(), &pb.ConvertQuoteRequest{
+		QuoteId:        c.Param("id"),
+		UserId:         c.GetString("user_id"),
+		OrderReference: req.OrderReference,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to convert quote")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"quote": resp.Quote})
+}
+
+// ListQuotes lists the quotes of the store, most recent first, optionally of
+// a status
+func (h *UserHandler) ListQuotes(c *gin.Context) {
+	h.listQuotes(c, "")
+}
+
+// GetQuote returns a quote of any buyer
+func (h *UserHandler) GetQuote(c *gin.Context) {
+	resp, err := h.client.GetQuote(c.Request.Context(), &pb.GetQuoteRequest{QuoteId: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get quote")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"quote": resp.Quote})
+}
+
+// RespondToQuote answers a requested quote with negotiated prices and the
+// expiry of the offer
+func (h *UserHandler) RespondToQuote(c *gin.Context) {
+	var req QuoteResponseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	prices := make([]*pb.QuoteItem, len(req.Prices))
+	for i, price := range req.Prices {
+		prices[i] = &pb.QuoteItem{
+			ProductId: price.ProductID,
+			VariantId: price.VariantID,
+			UnitPrice: price.UnitPrice,
+		}
+	}
+
+	resp, err := h.client.RespondToQuote(c.Request.Context(), &pb.RespondToQuoteRequest{
+		QuoteId:   c.Param("id"),
+		Prices:    prices,
+		ExpiresAt: req.ExpiresAt,
+		Notes:     req.Notes,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to respond to quote")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"quote": resp.Quote})
+}
+
+func (h *UserHandler) listQuotes(c *gin.Context, userID string) {
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.ListQuotes(c.Request.Context(), &pb.ListQuotesRequest{
+		UserId: userID,
+		Status: c.Query("status"),
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list quotes")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"quotes": resp.Quotes,
+		"total":  resp.Total,
+		"page":   page,
+		"limit":  limit,
+	})
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// RunInventoryReconciliationRequest represents the JSON structure for starting
+// an inventory reconciliation
+type RunInventoryReconciliationRequest struct {
+	// AutoCreate creates the missing inventory items with zero quantity
+	AutoCreate bool `json:"auto_create"`
+}
+
+// RunInventoryReconciliation handles cross-checking product SKUs against
+// inventory items for the current store
+func (h *ProductHandler) RunInventoryReconciliation(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req RunInventoryReconciliationRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	resp, err := h.client.RunInventoryReconciliation(c.Request.Context(), &pb.RunInventoryReconciliationRequest{
+		AutoCreate: req.AutoCreate,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to reconcile inventory")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatInventoryReconciliation(resp))
+}
+
+// GetInventoryReconciliation handles fetching a reconciliation with the SKUs
+// it found on only one side. The "latest" ID returns the most recent one.
+func (h *ProductHandler) GetInventoryReconciliation(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	id := c.Param("id")
+	if id == "latest" {
+		id = ""
+	}
+
+	resp, err := h.client.GetInventoryReconciliation(c.Request.Context(), &pb.GetInventoryReconciliationRequest{Id: id})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get inventory reconciliation")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatInventoryReconciliation(resp))
+}
+
+// ListInventoryReconciliations handles listing reconciliation summaries, most
+// recent first
+func (h *ProductHandler) ListInventoryReconciliations(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	if err != nil || limit < 1 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive number"})
+		return
+	}
+
+	resp, err := h.client.ListInventoryReconciliations(c.Request.Context(), &pb.ListInventoryReconciliationsRequest{Limit: int32(limit)})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list inventory reconciliations")
+		return
+	}
+
+	reconciliations := make([]gin.H, len(resp.Reconciliations))
+	for i, reconciliation := range resp.Reconciliations {
+		reconciliations[i] = formatInventoryReconciliation(reconciliation)
+	}
+	c.JSON(http.StatusOK, gin.H{"reconciliations": reconciliations})
+}
+
+// formatInventoryReconciliation splits the entries into missing and orphaned
+// SKUs; listings carry no entries
+func formatInventoryReconciliation(reconciliation *pb.InventoryReconciliation) gin.H {
+	formatted := gin.H{
+		"id":                      reconciliation.Id,
+		"auto_create":             reconciliation.AutoCreate,
+		"skus_checked":            reconciliation.SkusChecked,
+		"inventory_items_checked": reconciliation.InventoryItemsChecked,
+		"missing_count":           reconciliation.MissingCount,
+		"orphaned_count":          reconciliation.OrphanedCount,
+		"created_count":           reconciliation.CreatedCount,
+		"started_at":              formatTimestamp(reconciliation.StartedAt),
+		"finished_at":             formatTimestamp(reconciliation.FinishedAt),
+	}
+	if len(reconciliation.Entries) == 0 {
+		return formatted
+	}
+
+	missing := []gin.H{}
+	orphaned := []gin.H{}
+	for _, entry := range reconciliation.Entries {
+		if entry.Kind == "orphaned" {
+			orphaned = append(orphaned, gin.H{
+				"sku":               entry.Sku,
+				"product_id":        entry.ProductId,
+				"inventory_item_id": entry.InventoryItemId,
+			})
+			continue
+		}
+		missing = append(missing, gin.H{
+			"sku":               entry.Sku,
+			"product_id":        entry.ProductId,
+			"variant_id":        entry.VariantId,
+			"created":           entry.Created,
+			"inventory_item_id": entry.InventoryItemId,
+			"error":             entry.Error,
+		})
+	}
+	formatted["missing"] = missing
+	formatted["orphaned"] = orphaned
+	return formatted
+}
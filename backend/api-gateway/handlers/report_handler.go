@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	adminpb "github.com/louai60/e-commerce_project/backend/admin-service/proto"
+)
+
+// GenerateReportRequest represents the JSON structure for generating a report
+// on demand, optionally emailing its download link
+type GenerateReportRequest struct {
+	Kind       string   `json:"kind" binding:"required,oneof=catalog_completeness low_stock new_users"`
+	Format     string   `json:"format" binding:"required,oneof=csv pdf"`
+	Recipients []string `json:"recipients" binding:"omitempty,dive,email"`
+}
+
+// GenerateReport handles generating a report for the current store
+func (h *AdminHandler) GenerateReport(c *gin.Context) {
+	var req GenerateReportRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	report, err := h.client.GenerateReport(c.Request.Context(), &adminpb.GenerateReportRequest{
+		Kind:       req.Kind,
+		Format:     req.Format,
+		Recipients: req.Recipients,
+	})
+	if err != nil {
+		handleGRPCError(c, err, "Failed to generate report", h.logger)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"kind":           report.Kind,
+		"format":         report.Format,
+		"file_name":      report.FileName,
+		"row_count":      report.RowCount,
+		"url":            report.Url,
+		"url_expires_at": report.UrlExpiresAt,
+		"generated_at":   report.GeneratedAt,
+		"emailed_to":     report.EmailedTo,
+	})
+}
+
+// DownloadReport handles streaming a report from an emailed download link
+func (h *AdminHandler) DownloadReport(c *gin.Context) {
+	stream, err := h.client.DownloadReport(c.Request.Context(), &adminpb.DownloadReportRequest{
+		Token: c.Param("token"),
+	})
+	if err != nil {
+		handleGRPCError(c, err, "Failed to download report", h.logger)
+		return
+	}
+
+	// Errors such as an expired link arrive with the first chunk
+	chunk, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "report not found"})
+			return
+		}
+		handleGRPCError(c, err, "Failed to download report", h.logger)
+		return
+	}
+
+	c.Header("Content-Type", chunk.ContentType)
+	c.Header("Content-Length", strconv.FormatInt(chunk.SizeBytes, 10))
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": chunk.FileName}))
+	c.Header("Cache-Control", "no-store")
+	c.Status(http.StatusOK)
+
+	for {
+		if _, err := c.Writer.Write(chunk.Data); err != nil {
+			h.logger.Warn("Client disconnected during report download", zap.Error(err))
+			return
+		}
+		chunk, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+		if err != nil {
+			h.logger.Error("Report download stream failed", zap.Error(err))
+			return
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// SavedSearchRequest represents the JSON structure of a saved search. Prices
+// left out or zero leave their filter or alert unset.
+type SavedSearchRequest struct {
+	Name             string  `json:"name" binding:"required,max=100"`
+	Query            string  `json:"query" binding:"max=200"`
+	CategoryID       string  `json:"category_id"`
+	BrandID          string  `json:"brand_id"`
+	MinPrice         float64 `json:"min_price" binding:"gte=0"`
+	MaxPrice         float64 `json:"max_price" binding:"gte=0"`
+	AlertNewProducts bool    `json:"alert_new_products"`
+	PriceAlertBelow  float64 `json:"price_alert_below" binding:"gte=0"`
+}
+
+// ListSavedSearches lists the saved searches of the current user
+func (h *ProductHandler) ListSavedSearches(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListSavedSearches(c.Request.Context(), &pb.ListSavedSearchesRequest{
+		UserId: c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list saved searches")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatSavedSearchList(resp))
+}
+
+// CreateSavedSearch saves a search of the current user
+func (h *ProductHandler) CreateSavedSearch(c *gin.Context) {
+	h.saveSearch(c, "", http.StatusCreated)
+}
+
+// UpdateSavedSearch replaces a saved search of the current user. Its alerts
+// start over from the results of the new filters.
+func (h *ProductHandler) UpdateSavedSearch(c *gin.Context) {
+	h.saveSearch(c, c.Param("id"), http.StatusOK)
+}
+
+func (h *ProductHandler) saveSearch(c *gin.Context, id string, successStatus int) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req SavedSearchRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	search, err := h.client.SaveSearch(c.Request.Context(), &pb.SaveSearchRequest{
+		Id:               id,
+		UserId:           c.GetString("user_id"),
+		Name:             req.Name,
+		Query:            req.Query,
+		CategoryId:       req.CategoryID,
+		BrandId:          req.BrandID,
+		MinPrice:         req.MinPrice,
+		MaxPrice:         req.MaxPrice,
+		AlertNewProducts: req.AlertNewProducts,
+		PriceAlertBelow:  req.PriceAlertBelow,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to save search")
+		return
+	}
+
+	c.JSON(successStatus, formatters.FormatSavedSearch(search))
+}
+
+// DeleteSavedSearch deletes a saved search of the current user
+func (h *ProductHandler) DeleteSavedSearch(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteSavedSearch(c.Request.Context(), &pb.DeleteSavedSearchRequest{
+		Id:     c.Param("id"),
+		UserId: c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete saved search")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// SearchRankingRuleRequest represents the JSON structure of a merchandising
+// rule of search. A boost multiplies the relevance of the products of a
+// brand, a category or both by its weight; a pin puts a product at a fixed
+// position of the results of a query.
+type SearchRankingRuleRequest struct {
+	Name       string  `json:"name" binding:"required,max=100"`
+	Kind       string  `json:"kind" binding:"required,oneof=boost pin"`
+	Query      string  `json:"query" binding:"max=200"`
+	BrandID    string  `json:"brand_id"`
+	CategoryID string  `json:"category_id"`
+	ProductID  string  `json:"product_id"`
+	Weight     float64 `json:"weight" binding:"gte=0"`
+	Position   int32   `json:"position" binding:"gte=0"`
+}
+
+// PublishSearchRankingRulesRequest represents the JSON structure for
+// publishing draft rules, all of them when no IDs are given
+type PublishSearchRankingRulesRequest struct {
+	IDs []string `json:"ids"`
+}
+
+// SearchProducts searches the published products of the current store,
+// ranked by relevance and the published merchandising rules
+func (h *ProductHandler) SearchProducts(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.SearchProducts(c.Request.Context(), &pb.SearchProductsRequest{
+		Query:      c.Query("q"),
+		CategoryId: c.Query("category_id"),
+		Page:       int32(page),
+		Limit:      int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to search products")
+		return
+	}
+
+	results := make([]gin.H, len(resp.Results))
+	for i, result := range resp.Results {
+		results[i] = formatSearchResult(result)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"results": results,
+		"pagination": gin.H{
+			"current_page": page,
+			"per_page":     limit,
+			"total_items":  resp.Total,
+		},
+	})
+}
+
+// PreviewSearchRanking ranks the results of a search with the published and
+// draft rules, showing where the rules move each product before publishing
+func (h *ProductHandler) PreviewSearchRanking(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	resp, err := h.client.PreviewSearchRanking(c.Request.Context(), &pb.PreviewSearchRankingRequest{
+		Query:      c.Query("q"),
+		CategoryId: c.Query("category_id"),
+		Limit:      int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to preview search ranking")
+		return
+	}
+
+	results := make([]gin.H, len(resp.Results))
+	for i, ranked := range resp.Results {
+		result := formatSearchResult(ranked.Result)
+		result["relevance"] = ranked.Relevance
+		result["position"] = ranked.Position
+		result["base_position"] = ranked.BasePosition
+		result["pinned"] = ranked.Pinned
+		result["rule_ids"] = ranked.RuleIds
+		results[i] = result
+	}
+	c.JSON(http.StatusOK, gin.H{"results": results})
+}
+
+// ListSearchRankingRules lists the merchandising rules of search of the
+// current store, optionally only the draft or published ones
+func (h *ProductHandler) ListSearchRankingRules(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListSearchRankingRules(c.Request.Context(), &pb.ListSearchRankingRulesRequest{
+		Status: c.Query("status"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list search ranking rules")
+		return
+	}
+
+	rules := make([]gin.H, len(resp.Rules))
+	for i, rule := range resp.Rules {
+		rules[i] = formatSearchRankingRule(rule)
+	}
+	c.JSON(http.StatusOK, gin.H{"rules": rules})
+}
+
+// CreateSearchRankingRule creates a draft merchandising rule of search
+func (h *ProductHandler) CreateSearchRankingRule(c *gin.Context) {
+	h.saveSearchRankingRule(c, "", http.StatusCreated)
+}
+
+// UpdateSearchRankingRule replaces a merchandising rule of search. A
+// published rule goes back to draft until published again.
+func (h *ProductHandler) UpdateSearchRankingRule(c *gin.Context) {
+	h.saveSearchRankingRule(c, c.Param("id"), http.StatusOK)
+}
+
+func (h *ProductHandler) saveSearchRankingRule(c *gin.Context, id string, successStatus int) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req SearchRankingRuleRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	rule, err := h.client.SaveSearchRankingRule(c.Request.Context(), &pb.SaveSearchRankingRuleRequest{
+		Id:         id,
+		Name:       req.Name,
+		Kind:       req.Kind,
+		Query:      req.Query,
+		BrandId:    req.BrandID,
+		CategoryId: req.CategoryID,
+		ProductId:  req.ProductID,
+		Weight:     req.Weight,
+		Position:   req.Position,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to save search ranking rule")
+		return
+	}
+
+	c.JSON(successStatus, formatSearchRankingRule(rule))
+}
+
+// DeleteSearchRankingRule deletes a merchandising rule of search, which
+// stops applying at once even when published
+func (h *ProductHandler) DeleteSearchRankingRule(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteSearchRankingRule(c.Request.Context(), &pb.DeleteSearchRankingRuleRequest{
+		Id: c.Param("id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete search ranking rule")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+// PublishSearchRankingRules publishes draft merchandising rules of search so
+// that they apply to the searches of shoppers
+func (h *ProductHandler) PublishSearchRankingRules(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req PublishSearchRankingRulesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.PublishSearchRankingRules(c.Request.Context(), &pb.PublishSearchRankingRulesRequest{
+		Ids: req.IDs,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to publish search ranking rules")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"published": resp.Published})
+}
+
+func formatSearchResult(result *pb.SearchResult) gin.H {
+	return gin.H{
+		"product_id": result.GetProductId(),
+		"title":      result.GetTitle(),
+		"slug":       result.GetSlug(),
+		"price":      result.GetPrice(),
+		"score":      result.GetScore(),
+	}
+}
+
+func formatSearchRankingRule(rule *pb.SearchRankingRule) gin.H {
+	return gin.H{
+		"id":           rule.Id,
+		"name":         rule.Name,
+		"kind":         rule.Kind,
+		"query":        rule.Query,
+		"brand_id":     rule.BrandId,
+		"category_id":  rule.CategoryId,
+		"product_id":   rule.ProductId,
+		"weight":       rule.Weight,
+		"position":     rule.Position,
+		"status":       rule.Status,
+		"published_at": formatTimestamp(rule.PublishedAt),
+		"created_at":   formatTimestamp(rule.CreatedAt),
+		"updated_at":   formatTimestamp(rule.UpdatedAt),
+	}
+}
@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// SettingRequest represents the JSON structure for setting a store setting.
+// The shape of the value depends on the key, see GET /admin/settings.
+type SettingRequest struct {
+	Value json.RawMessage `json:"value" binding:"required"`
+}
+
+// GetStorefrontConfig returns the public configuration of the store of the
+// request: its name, currencies, locales, branding and support contacts
+func (h *ProductHandler) GetStorefrontConfig(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	config, err := h.client.GetStorefrontConfig(c.Request.Context(), &pb.GetStorefrontConfigRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get storefront config")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatStorefrontConfig(config))
+}
+
+// ListSettings lists every known setting of the store, set or not
+func (h *ProductHandler) ListSettings(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListSettings(c.Request.Context(), &pb.ListSettingsRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list settings")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"settings": formatters.FormatSettings(resp.Settings)})
+}
+
+// SetSetting validates and stores the value of a setting
+func (h *ProductHandler) SetSetting(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req SettingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	setting, err := h.client.SetSetting(c.Request.Context(), &pb.SetSettingRequest{
+		Key:       c.Param("key"),
+		Value:     string(req.Value),
+		UpdatedBy: c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set setting")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatters.FormatSetting(setting))
+}
+
+// DeleteSetting unsets a setting, falling back to its default
+func (h *ProductHandler) DeleteSetting(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteSetting(c.Request.Context(), &pb.DeleteSettingRequest{Key: c.Param("key")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete setting")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
@@ -0,0 +1,253 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// CreateShipmentRequest represents the JSON structure for registering a
+// shipment of an order
+type CreateShipmentRequest struct {
+	OrderReference string     `json:"order_reference" binding:"required"`
+	UserID         string     `json:"user_id"`
+	Carrier        string     `json:"carrier" binding:"required"`
+	TrackingNumber string     `json:"tracking_number" binding:"required"`
+	ShippedAt      *time.Time `json:"shipped_at"`
+}
+
+// CarrierEventRequest is a tracking event pushed by a carrier. status is one
+// of label_created, in_transit, out_for_delivery, delivered, exception or
+// returned; occurred_at defaults to the time the event is received.
+type CarrierEventRequest struct {
+	TrackingNumber string     `json:"tracking_number" binding:"required"`
+	Status         string     `json:"status" binding:"required"`
+	Description    string     `json:"description"`
+	Location       string     `json:"location"`
+	OccurredAt     *time.Time `json:"occurred_at"`
+}
+
+// CarrierEventsRequest represents the JSON structure of a carrier webhook
+// call
+type CarrierEventsRequest struct {
+	Events []CarrierEventRequest `json:"events" binding:"required,min=1,max=500,dive"`
+}
+
+// ReceiveCarrierEvents records the tracking events a carrier pushes. The
+// carrier authenticates with an integration API key created for it, sent in
+// the X-API-Key header. Events of unknown tracking numbers are ignored.
+func (h *InventoryHandler) ReceiveCarrierEvents(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	apiKey := c.GetHeader(IntegrationKeyHeader)
+	if apiKey == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "API key is required"})
+		return
+	}
+
+	var req CarrierEventsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	events := make([]*inventorypb.CarrierEvent, len(req.Events))
+	for i, e := range req.Events {
+		events[i] = &inventorypb.CarrierEvent{
+			TrackingNumber: e.TrackingNumber,
+			Status:         e.Status,
+			Description:    e.Description,
+			Location:       e.Location,
+		}
+		if e.OccurredAt != nil {
+			events[i].OccurredAt = timestamppb.New(*e.OccurredAt)
+		}
+	}
+
+	resp, err := h.client.ReceiveCarrierEvents(c.Request.Context(), apiKey, c.Param("carrier"), events)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to receive carrier events")
+		return
+	}
+
+	setQuotaHeaders(c, resp.Quota)
+	formatted := make([]gin.H, len(resp.Results))
+	for i, result := range resp.Results {
+		formatted[i] = gin.H{
+			"tracking_number": result.TrackingNumber,
+			"status":          result.Status,
+		}
+		if result.Error != "" {
+			formatted[i]["error"] = result.Error
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"results": formatted})
+}
+
+// ListMyShipments lists the shipments of the current customer
+func (h *InventoryHandler) ListMyShipments(c *gin.Context) {
+	h.listShipments(c, c.GetString("user_id"))
+}
+
+// ListAllShipments lists shipments, optionally filtered by user_id
+func (h *InventoryHandler) ListAllShipments(c *gin.Context) {
+	h.listShipments(c, c.Query("user_id"))
+}
+
+func (h *InventoryHandler) listShipments(c *gin.Context, userID string) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	page, limit := getPaginationParams(c)
+	resp, err := h.client.ListShipments(c.Request.Context(), &inventorypb.ListShipmentsRequest{
+		OrderReference: c.Query("order_reference"),
+		UserId:         userID,
+		Status:         c.Query("status"),
+		Page:           int32(page),
+		Limit:          int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list shipments")
+		return
+	}
+
+	shipments := make([]gin.H, len(resp.Shipments))
+	for i, shipment := range resp.Shipments {
+		shipments[i] = formatShipment(shipment)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"shipments": shipments,
+		"total":     resp.Total,
+		"page":      page,
+		"limit":     limit,
+	})
+}
+
+// GetMyShipment returns a shipment of the current customer with its
+// tracking events
+func (h *InventoryHandler) GetMyShipment(c *gin.Context) {
+	h.getShipmentStatus(c, c.Param("id"), "", c.GetString("user_id"))
+}
+
+// GetMyOrderShipments returns the shipments of an order of the current
+// customer with their tracking events and the status across them
+func (h *InventoryHandler) GetMyOrderShipments(c *gin.Context) {
+	h.getShipmentStatus(c, "", c.Param("reference"), c.GetString("user_id"))
+}
+
+// GetShipment returns any shipment with its tracking events
+func (h *InventoryHandler) GetShipment(c *gin.Context) {
+	h.getShipmentStatus(c, c.Param("id"), "", "")
+}
+
+func (h *InventoryHandler) getShipmentStatus(c *gin.Context, id, orderReference, userID string) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	resp, err := h.client.GetShipmentStatus(c.Request.Context(), id, orderReference, userID)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get shipment status")
+		return
+	}
+
+	if id != "" && len(resp.Shipments) == 1 {
+		c.JSON(http.StatusOK, formatShipment(resp.Shipments[0]))
+		return
+	}
+
+	shipments := make([]gin.H, len(resp.Shipments))
+	for i, shipment := range resp.Shipments {
+		shipments[i] = formatShipment(shipment)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"order_reference": resp.OrderReference,
+		"status":          resp.Status,
+		"shipments":       shipments,
+	})
+}
+
+// CreateShipment registers a shipment of an order for tracking
+func (h *InventoryHandler) CreateShipment(c *gin.Context) {
+	// Check if client is nil
+	if h.client == nil {
+		h.logger.Error("Inventory service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "inventory service unavailable"})
+		return
+	}
+
+	var req CreateShipmentRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	pbReq := &inventorypb.CreateShipmentRequest{
+		OrderReference: req.OrderReference,
+		UserId:         req.UserID,
+		Carrier:        req.Carrier,
+		TrackingNumber: req.TrackingNumber,
+	}
+	if req.ShippedAt != nil {
+		pbReq.ShippedAt = timestamppb.New(*req.ShippedAt)
+	}
+
+	shipment, err := h.client.CreateShipment(c.Request.Context(), pbReq)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create shipment")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatShipment(shipment))
+}
+
+// formatShipment formats a shipment and its tracking events for the API
+// response
+func formatShipment(shipment *inventorypb.Shipment) gin.H {
+	result := gin.H{
+		"id":              shipment.Id,
+		"order_reference": shipment.OrderReference,
+		"carrier":         shipment.Carrier,
+		"tracking_number": shipment.TrackingNumber,
+		"status":          shipment.Status,
+		"shipped_at":      formatTimestamp(shipment.ShippedAt),
+		"updated_at":      formatTimestamp(shipment.UpdatedAt),
+	}
+	if shipment.UserId != "" {
+		result["user_id"] = shipment.UserId
+	}
+	if shipment.DeliveredAt != nil {
+		result["delivered_at"] = formatTimestamp(shipment.DeliveredAt)
+	}
+	if shipment.Events != nil {
+		events := make([]gin.H, len(shipment.Events))
+		for i, event := range shipment.Events {
+			events[i] = gin.H{
+				"status":      event.Status,
+				"description": event.Description,
+				"location":    event.Location,
+				"occurred_at": formatTimestamp(event.OccurredAt),
+			}
+		}
+		result["events"] = events
+	}
+	return result
+}
@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// Shopper lists, as named by the user service
+const (
+	listCart           = "cart"
+	listWishlist       = "wishlist"
+	listRecentlyViewed = "recently_viewed"
+)
+
+// CartItemRequest sets the quantity of a product in the cart
+type CartItemRequest struct {
+	ProductID string `json:"product_id" binding:"required"`
+	VariantID string `json:"variant_id"`
+	Quantity  int32  `json:"quantity" binding:"required,min=1,max=999"`
+	// Personalization replaces that of the cart item, and is checked against
+	// the personalization options of the product
+	Personalization []PersonalizationValueRequest `json:"personalization" binding:"max=10,dive"`
+}
+
+// ListItemRequest adds a product to the wishlist or the recently viewed
+// products
+type ListItemRequest struct {
+	ProductID string `json:"product_id" binding:"required"`
+	VariantID string `json:"variant_id"`
+}
+
+// GetCart returns the cart of the user, or of the guest before login
+func (h *UserHandler) GetCart(c *gin.Context) {
+	h.getShopperList(c, listCart)
+}
+
+// SetCartItem adds a product to the cart or changes its quantity and
+// personalization, which the product service validates first
+func (h *UserHandler) SetCartItem(c *gin.Context, productClient productpb.ProductServiceClient) {
+	var req CartItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if productClient == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	values, err := validatePersonalization(c.Request.Context(), productClient, req.ProductID, req.Personalization)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to validate personalization")
+		return
+	}
+	personalization := make([]*pb.PersonalizationValue, len(values))
+	for i, value := range values {
+		personalization[i] = &pb.PersonalizationValue{
+			OptionId: value.OptionId,
+			Name:     value.Name,
+			Text:     value.Text,
+			FileUrl:  value.FileUrl,
+			FileName: value.FileName,
+		}
+	}
+	h.setShopperListItem(c, listCart, req.ProductID, req.VariantID, req.Quantity, personalization)
+}
+
+// RemoveCartItem removes a product from the cart
+func (h *UserHandler) RemoveCartItem(c *gin.Context) {
+	h.removeShopperListItem(c, listCart)
+}
+
+// GetWishlist returns the wishlist of the user, or of the guest before login
+func (h *UserHandler) GetWishlist(c *gin.Context) {
+	h.getShopperList(c, listWishlist)
+}
+
+// AddWishlistItem adds a product to the wishlist
+func (h *UserHandler) AddWishlistItem(c *gin.Context) {
+	var req ListItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	h.setShopperListItem(c, listWishlist, req.ProductID, req.VariantID, 1, nil)
+}
+
+// RemoveWishlistItem removes a product from the wishlist
+func (h *UserHandler) RemoveWishlistItem(c *gin.Context) {
+	h.removeShopperListItem(c, listWishlist)
+}
+
+// GetRecentlyViewed returns the products the user or guest viewed last, most
+// recent first
+func (h *UserHandler) GetRecentlyViewed(c *gin.Context) {
+	h.getShopperList(c, listRecentlyViewed)
+}
+
+// AddRecentlyViewed records a view of a product
+func (h *UserHandler) AddRecentlyViewed(c *gin.Context) {
+	var req ListItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	h.setShopperListItem(c, listRecentlyViewed, req.ProductID, req.VariantID, 1, nil)
+}
+
+func (h *UserHandler) getShopperList(c *gin.Context, list string) {
+	ownerID, ok := shopperOwner(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.GetShopperList(c.Request.Context(), &pb.GetShopperListRequest{OwnerId: ownerID, List: list})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get list")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatShopperList(resp))
+}
+
+func (h *UserHandler) setShopperListItem(c *gin.Context, list, productID, variantID string, quantity int32, personalization []*pb.PersonalizationValue) {
+	ownerID, ok := shopperOwner(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.SetShopperListItem(c.Request.Context(), &pb.SetShopperListItemRequest{
+		OwnerId:   ownerID,
+		List:      list,
+		ProductId: productID,
+		VariantId: variantID,
+		Quantity:  quantity,
+
+		Personalization: personalization,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to update list")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatShopperList(resp))
+}
+
+func (h *UserHandler) removeShopperListItem(c *gin.Context, list string) {
+	ownerID, ok := shopperOwner(c)
+	if !ok {
+		return
+	}
+
+	resp, err := h.client.RemoveShopperListItem(c.Request.Context(), &pb.RemoveShopperListItemRequest{
+		OwnerId:   ownerID,
+		List:      list,
+		ProductId: c.Param("product_id"),
+		VariantId: c.Query("variant_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to remove list item")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatShopperList(resp))
+}
+
+// mergeGuestData moves the lists of the guest session of a login request
+// into the account that logged in, and ends the guest session. A failed merge
+// does not fail the login: the guest session is kept for a later attempt.
+func (h *UserHandler) mergeGuestData(ctx context.Context, c *gin.Context, userID string) gin.H {
+	guestID := middleware.GetGuestID(c)
+	if guestID == "" || userID == "" {
+		return nil
+	}
+
+	resp, err := h.client.MergeGuestData(ctx, &pb.MergeGuestDataRequest{GuestId: guestID, UserId: userID})
+	if err != nil {
+		h.logger.Warn("Failed to merge guest data", zap.String("user_id", userID), zap.Error(err))
+		return nil
+	}
+	middleware.ClearGuestSession(c)
+
+	return gin.H{
+		"cart_items":            resp.CartItems,
+		"wishlist_items":        resp.WishlistItems,
+		"recently_viewed_items": resp.RecentlyViewedItems,
+	}
+}
+
+// shopperOwner returns the user or guest the lists of a request belong to
+func shopperOwner(c *gin.Context) (string, bool) {
+	ownerID := middleware.ShopperID(c)
+	if ownerID == "" {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "a guest session or a token is required", "request_id": middleware.GetRequestID(c)})
+		return "", false
+	}
+	return ownerID, true
+}
+
+func formatShopperList(resp *pb.ShopperListResponse) gin.H {
+	items := make([]gin.H, len(resp.Items))
+	for i, item := range resp.Items {
+		items[i] = gin.H{
+			"product_id": item.ProductId,
+			"variant_id": item.VariantId,
+			"quantity":   item.Quantity,
+			"added_at":   item.AddedAt,
+			"updated_at": item.UpdatedAt,
+		}
+		if len(item.Personalization) > 0 {
+			items[i]["personalization"] = formatPersonalization(item.Personalization)
+		}
+	}
+	return gin.H{"list": resp.List, "items": items}
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+)
+
+// SLOResponse lists routes with their latencies against their SLO targets
+type SLOResponse struct {
+	Routes []middleware.RouteSLO `json:"routes"`
+}
+
+// SLOHandler reports the latencies the gateway recorded for each route
+type SLOHandler struct {
+	tracker *middleware.SLOTracker
+}
+
+// NewSLOHandler creates a handler reporting the routes of tracker
+func NewSLOHandler(tracker *middleware.SLOTracker) *SLOHandler {
+	return &SLOHandler{tracker: tracker}
+}
+
+// ListSLOs returns the latency histogram and burn rates of every route served
+// since the gateway started
+func (h *SLOHandler) ListSLOs(c *gin.Context) {
+	c.JSON(http.StatusOK, SLOResponse{Routes: h.tracker.Routes()})
+}
+
+// ListSLOViolations returns the routes currently burning their latency budget
+// faster than allowed, the fastest burning first
+func (h *SLOHandler) ListSLOViolations(c *gin.Context) {
+	c.JSON(http.StatusOK, SLOResponse{Routes: h.tracker.Violations()})
+}
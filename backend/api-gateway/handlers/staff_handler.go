@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// CreateStaffRequest represents the JSON structure for creating a staff
+// account
+type CreateStaffRequest struct {
+	Email          string   `json:"email" binding:"required,email"`
+	Username       string   `json:"username"`
+	Password       string   `json:"password" binding:"required,min=8"`
+	FirstName      string   `json:"first_name" binding:"required"`
+	LastName       string   `json:"last_name" binding:"required"`
+	PermissionSets []string `json:"permission_sets"`
+}
+
+// StaffPermissionsRequest represents the JSON structure for replacing the
+// permission sets of a staff account
+type StaffPermissionsRequest struct {
+	PermissionSets []string `json:"permission_sets"`
+}
+
+// ListStaff lists the staff accounts of the store with their permission sets
+func (h *UserHandler) ListStaff(c *gin.Context) {
+	resp, err := h.client.ListStaff(c.Request.Context(), &pb.ListStaffRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list staff")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"staff": resp.Staff})
+}
+
+// CreateStaff creates a staff account holding the given permission sets,
+// created by the signed in admin
+func (h *UserHandler) CreateStaff(c *gin.Context) {
+	var req CreateStaffRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.CreateStaff(c.Request.Context(), &pb.CreateStaffRequest{
+		Email:          req.Email,
+		Username:       req.Username,
+		Password:       req.Password,
+		FirstName:      req.FirstName,
+		LastName:       req.LastName,
+		PermissionSets: req.PermissionSets,
+		ActorId:        c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create staff")
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"user": resp.User})
+}
+
+// SetStaffPermissions replaces the permission sets of a staff account. The
+// user service refuses changes to the admin's own account.
+func (h *UserHandler) SetStaffPermissions(c *gin.Context) {
+	userID, err := h.parseUserID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	var req StaffPermissionsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.SetStaffPermissions(c.Request.Context(), &pb.SetStaffPermissionsRequest{
+		UserId:         userID,
+		PermissionSets: req.PermissionSets,
+		ActorId:        c.GetString("user_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set staff permissions")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": resp.User})
+}
+
+// ListPermissionSets lists the permission sets assignable to staff, with the
+// scopes each grants
+func (h *UserHandler) ListPermissionSets(c *gin.Context) {
+	resp, err := h.client.ListPermissionSets(c.Request.Context(), &pb.ListPermissionSetsRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list permission sets")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"permission_sets": resp.PermissionSets})
+}
@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// CreateStoreRequest represents the JSON structure for creating a store
+type CreateStoreRequest struct {
+	ID              string `json:"id" binding:"required"`
+	Name            string `json:"name" binding:"required"`
+	Domain          string `json:"domain"`
+	DefaultCurrency string `json:"default_currency"`
+	DefaultLocale   string `json:"default_locale"`
+}
+
+// UpdateStoreRequest represents the JSON structure for updating a store
+type UpdateStoreRequest struct {
+	Name            string  `json:"name"`
+	Domain          *string `json:"domain"`
+	DefaultCurrency string  `json:"default_currency"`
+	DefaultLocale   string  `json:"default_locale"`
+	IsActive        *bool   `json:"is_active"`
+}
+
+// platformAdmin reports whether the request comes from an admin of the
+// default store. Only they may manage the other stores.
+func (h *ProductHandler) platformAdmin(c *gin.Context) bool {
+	if tenant.FromContext(c.Request.Context()) != tenant.DefaultTenantID {
+		c.JSON(http.StatusForbidden, gin.H{"error": "stores can only be managed from the default store"})
+		return false
+	}
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return false
+	}
+	return true
+}
+
+// CreateStore handles adding a new storefront
+func (h *ProductHandler) CreateStore(c *gin.Context) {
+	if !h.platformAdmin(c) {
+		return
+	}
+
+	var req CreateStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.CreateStore(c.Request.Context(), &pb.CreateStoreRequest{
+		Id:              req.ID,
+		Name:            req.Name,
+		Domain:          req.Domain,
+		DefaultCurrency: req.DefaultCurrency,
+		DefaultLocale:   req.DefaultLocale,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create store")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatStore(resp))
+}
+
+// GetStore handles fetching a storefront by ID
+func (h *ProductHandler) GetStore(c *gin.Context) {
+	if !h.platformAdmin(c) {
+		return
+	}
+
+	resp, err := h.client.GetStore(c.Request.Context(), &pb.GetStoreRequest{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get store")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatStore(resp))
+}
+
+// ListStores handles listing all storefronts
+func (h *ProductHandler) ListStores(c *gin.Context) {
+	if !h.platformAdmin(c) {
+		return
+	}
+
+	resp, err := h.client.ListStores(c.Request.Context(), &pb.ListStoresRequest{})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list stores")
+		return
+	}
+
+	stores := make([]gin.H, len(resp.Stores))
+	for i, store := range resp.Stores {
+		stores[i] = formatStore(store)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"stores": stores})
+}
+
+// UpdateStore handles changing the settings of a storefront. Fields left out
+// keep their value; an empty domain removes the store's domain.
+func (h *ProductHandler) UpdateStore(c *gin.Context) {
+	if !h.platformAdmin(c) {
+		return
+	}
+
+	var req UpdateStoreRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	current, err := h.client.GetStore(c.Request.Context(), &pb.GetStoreRequest{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get store")
+		return
+	}
+
+	domain := current.Domain
+	if req.Domain != nil {
+		domain = *req.Domain
+	}
+	isActive := current.IsActive
+	if req.IsActive != nil {
+		isActive = *req.IsActive
+	}
+
+	resp, err := h.client.UpdateStore(c.Request.Context(), &pb.UpdateStoreRequest{
+		Id:              current.Id,
+		Name:            req.Name,
+		Domain:          domain,
+		DefaultCurrency: req.DefaultCurrency,
+		DefaultLocale:   req.DefaultLocale,
+		IsActive:        isActive,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to update store")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatStore(resp))
+}
+
+func formatStore(store *pb.Store) gin.H {
+	return gin.H{
+		"id":               store.Id,
+		"name":             store.Name,
+		"domain":           store.Domain,
+		"default_currency": store.DefaultCurrency,
+		"default_locale":   store.DefaultLocale,
+		"is_active":        store.IsActive,
+		"created_at":       formatTimestamp(store.CreatedAt),
+		"updated_at":       formatTimestamp(store.UpdatedAt),
+	}
+}
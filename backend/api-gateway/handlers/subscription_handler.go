@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// SubscriptionPlanRequest represents the JSON structure for setting the
+// billing plan of a subscription product
+type SubscriptionPlanRequest struct {
+	Interval      string `json:"interval" binding:"required"`
+	IntervalCount int32  `json:"interval_count"`
+	TrialDays     int32  `json:"trial_days"`
+}
+
+// CreateSubscriptionRequest represents the JSON structure for starting a subscription
+type CreateSubscriptionRequest struct {
+	ProductID  string `json:"product_id" binding:"required"`
+	PurchaseID string `json:"purchase_id" binding:"required"`
+	UserID     string `json:"user_id" binding:"required"`
+	Currency   string `json:"currency"`
+}
+
+// CancelSubscriptionRequest represents the JSON structure for cancelling a subscription
+type CancelSubscriptionRequest struct {
+	AtPeriodEnd bool `json:"at_period_end"`
+}
+
+// SetSubscriptionPlan handles making a product a subscription product or
+// changing its billing plan
+func (h *ProductHandler) SetSubscriptionPlan(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req SubscriptionPlanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.SetSubscriptionPlan(c.Request.Context(), &pb.SetSubscriptionPlanRequest{
+		ProductId:     c.Param("id"),
+		Interval:      req.Interval,
+		IntervalCount: req.IntervalCount,
+		TrialDays:     req.TrialDays,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to set subscription plan")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"product_id":     resp.ProductId,
+		"interval":       resp.Interval,
+		"interval_count": resp.IntervalCount,
+		"trial_days":     resp.TrialDays,
+	})
+}
+
+// CreateSubscription handles starting a subscription for a purchase. Until an
+// order service confirms purchases this is restricted to admins.
+func (h *ProductHandler) CreateSubscription(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req CreateSubscriptionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.CreateSubscription(c.Request.Context(), &pb.CreateSubscriptionRequest{
+		ProductId:  req.ProductID,
+		PurchaseId: req.PurchaseID,
+		UserId:     req.UserID,
+		Currency:   req.Currency,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create subscription")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatSubscription(resp))
+}
+
+// ListAllSubscriptions handles listing subscriptions of all users for admins,
+// optionally filtered by user and status
+func (h *ProductHandler) ListAllSubscriptions(c *gin.Context) {
+	h.listSubscriptions(c, c.Query("user_id"))
+}
+
+// ListMySubscriptions handles listing the subscriptions of the signed-in user
+func (h *ProductHandler) ListMySubscriptions(c *gin.Context) {
+	h.listSubscriptions(c, c.GetString("user_id"))
+}
+
+func (h *ProductHandler) listSubscriptions(c *gin.Context, userID string) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	page, err := strconv.Atoi(c.DefaultQuery("page", "1"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid page number"})
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit number"})
+		return
+	}
+
+	resp, err := h.client.ListSubscriptions(c.Request.Context(), &pb.ListSubscriptionsRequest{
+		UserId: userID,
+		Status: c.Query("status"),
+		Page:   int32(page),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list subscriptions")
+		return
+	}
+
+	subscriptions := make([]gin.H, len(resp.Subscriptions))
+	for i, sub := range resp.Subscriptions {
+		subscriptions[i] = formatSubscription(sub)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"subscriptions": subscriptions,
+		"pagination": gin.H{
+			"current_page": page,
+			"per_page":     limit,
+			"total_items":  resp.Total,
+		},
+	})
+}
+
+// CancelSubscription handles cancelling one of the signed-in user's
+// subscriptions, either right away or at the end of the paid period
+func (h *ProductHandler) CancelSubscription(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req CancelSubscriptionRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	sub, err := h.client.GetSubscription(c.Request.Context(), &pb.GetSubscriptionRequest{Id: c.Param("id")})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to get subscription")
+		return
+	}
+
+	// Admins may cancel any subscription, customers only their own
+	if sub.UserId != c.GetString("user_id") && c.GetString("user_role") != "admin" {
+		c.JSON(http.StatusNotFound, gin.H{"error": "subscription not found"})
+		return
+	}
+
+	resp, err := h.client.CancelSubscription(c.Request.Context(), &pb.CancelSubscriptionRequest{
+		Id:          sub.Id,
+		AtPeriodEnd: req.AtPeriodEnd,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to cancel subscription")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatSubscription(resp))
+}
+
+func formatSubscription(sub *pb.Subscription) gin.H {
+	return gin.H{
+		"id":                   sub.Id,
+		"product_id":           sub.ProductId,
+		"user_id":              sub.UserId,
+		"purchase_id":          sub.PurchaseId,
+		"status":               sub.Status,
+		"price":                sub.Price,
+		"currency":             sub.Currency,
+		"current_period_start": formatTimestamp(sub.CurrentPeriodStart),
+		"current_period_end":   formatTimestamp(sub.CurrentPeriodEnd),
+		"trial_ends_at":        formatTimestamp(sub.TrialEndsAt),
+		"cancel_at_period_end": sub.CancelAtPeriodEnd,
+		"cancelled_at":         formatTimestamp(sub.CancelledAt),
+		"created_at":           formatTimestamp(sub.CreatedAt),
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// TranslationRequest represents the JSON structure of the translation of a
+// product, category, content page or banner to a locale. Empty fields keep
+// the original content.
+type TranslationRequest struct {
+	// Name is the title of a product, page or banner or the name of a category
+	Name string `json:"name"`
+	// ShortDescription is the summary of a page or the subtitle of a banner;
+	// categories have none
+	ShortDescription string `json:"short_description"`
+	// Description is the body of a page; banners have none
+	Description string `json:"description"`
+}
+
+// ListTranslations lists the translations of a product, category, page or
+// banner
+func (h *ProductHandler) ListTranslations(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.ListTranslations(c.Request.Context(), &pb.ListTranslationsRequest{
+		EntityType: c.Param("entity_type"),
+		EntityId:   c.Param("entity_id"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list translations")
+		return
+	}
+
+	translations := make([]gin.H, len(resp.Translations))
+	for i, translation := range resp.Translations {
+		translations[i] = formatTranslation(translation)
+	}
+	c.JSON(http.StatusOK, gin.H{"translations": translations})
+}
+
+// SetTranslation creates or replaces the translation of a product,
+// category, page or banner to a locale
+func (h *ProductHandler) SetTranslation(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	var req TranslationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	translation, err := h.client.SetTranslation(c.Request.Context(), &pb.SetTranslationRequest{
+		Translation: &pb.Translation{
+			EntityType:       c.Param("entity_type"),
+			EntityId:         c.Param("entity_id"),
+			Locale:           c.Param("locale"),
+			Name:             req.Name,
+			ShortDescription: req.ShortDescription,
+			Description:      req.Description,
+		},
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to save translation")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatTranslation(translation))
+}
+
+// DeleteTranslation removes the translation of a product, category, page or
+// banner to a locale
+func (h *ProductHandler) DeleteTranslation(c *gin.Context) {
+	if h.client == nil {
+		h.logger.Error("Product service client is nil")
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "product service unavailable"})
+		return
+	}
+
+	resp, err := h.client.DeleteTranslation(c.Request.Context(), &pb.DeleteTranslationRequest{
+		EntityType: c.Param("entity_type"),
+		EntityId:   c.Param("entity_id"),
+		Locale:     c.Param("locale"),
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete translation")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": resp.Success})
+}
+
+func formatTranslation(translation *pb.Translation) gin.H {
+	return gin.H{
+		"entity_type":       translation.EntityType,
+		"entity_id":         translation.EntityId,
+		"locale":            translation.Locale,
+		"name":              translation.Name,
+		"short_description": translation.ShortDescription,
+		"description":       translation.Description,
+		"created_at":        formatTimestamp(translation.CreatedAt),
+		"updated_at":        formatTimestamp(translation.UpdatedAt),
+	}
+}
@@ -8,11 +8,20 @@ import (
     "strconv"
 
     "github.com/gin-gonic/gin"
+    "github.com/google/uuid"
     "go.uber.org/zap"
     "google.golang.org/grpc"
     "google.golang.org/grpc/credentials/insecure"
     "google.golang.org/grpc/status"
     "google.golang.org/grpc/codes"
+    "google.golang.org/protobuf/types/known/structpb"
+
+    "github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+    "github.com/louai60/e-commerce_project/backend/common/cachectl"
+    "github.com/louai60/e-commerce_project/backend/common/locale"
+    applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+    "github.com/louai60/e-commerce_project/backend/common/servicetoken"
+    "github.com/louai60/e-commerce_project/backend/common/tenant"
     pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
 )
 
@@ -35,6 +44,35 @@ type UpdateUserRequest struct {
     FirstName   string `json:"FirstName"`
     LastName    string `json:"LastName"`
     PhoneNumber string `json:"PhoneNumber"`
+    // Metadata is a JSON merge patch of the user metadata, where null values
+    // remove keys
+    Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+// userJSON renders the metadata of a user as a plain JSON object, which
+// encoding/json cannot do with the google.protobuf.Struct of pb.User
+type userJSON struct {
+    *pb.User
+    Metadata map[string]any `json:"metadata,omitempty"`
+}
+
+func formatUser(user *pb.User) *userJSON {
+    if user == nil {
+        return nil
+    }
+    formatted := &userJSON{User: user}
+    if user.Metadata != nil {
+        formatted.Metadata = user.Metadata.AsMap()
+    }
+    return formatted
+}
+
+func formatUsers(users []*pb.User) []*userJSON {
+    formatted := make([]*userJSON, len(users))
+    for i, user := range users {
+        formatted[i] = formatUser(user)
+    }
+    return formatted
 }
 
 type AddressRequest struct {
@@ -59,7 +97,7 @@ type PaymentMethodRequest struct {
 }
 
 func NewUserHandler(userServiceAddr string, logger *zap.Logger) (*UserHandler, error) {
-    conn, err := grpc.Dial(userServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+    conn, err := grpc.Dial(userServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), locale.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), cachectl.UnaryClientInterceptor()))
     if err != nil {
         return nil, err
     }
@@ -73,8 +111,8 @@ func NewUserHandler(userServiceAddr string, logger *zap.Logger) (*UserHandler, e
 
 // Helper function to parse user IDs
 func (h *UserHandler) parseUserID(idStr string) (string, error) {
-    // First parse as int64 to validate format
-    _, err := strconv.ParseInt(idStr, 10, 64)
+    // User IDs are UUIDs
+    _, err := uuid.Parse(idStr)
     if err != nil {
         h.logger.Error("Invalid user ID format", 
             zap.String("user_id", idStr),
@@ -145,7 +183,7 @@ func (h *UserHandler) GetProfile(c *gin.Context) {
         return
     }
 
-    c.JSON(http.StatusOK, resp)
+    c.JSON(http.StatusOK, gin.H{"user": formatUser(resp.User)})
 }
 
 func (h *UserHandler) UpdateProfile(c *gin.Context) {
@@ -169,6 +207,12 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
         LastName:    req.LastName,
         PhoneNumber: req.PhoneNumber,
     }
+    if req.Metadata != nil {
+        if grpcReq.Metadata, err = structpb.NewStruct(req.Metadata); err != nil {
+            c.JSON(http.StatusBadRequest, gin.H{"error": "invalid metadata: " + err.Error()})
+            return
+        }
+    }
 
     resp, err := h.client.UpdateUser(c.Request.Context(), grpcReq)
     if err != nil {
@@ -176,7 +220,7 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
         return
     }
 
-    c.JSON(http.StatusOK, resp)
+    c.JSON(http.StatusOK, gin.H{"user": formatUser(resp.User)})
 }
 
 func (h *UserHandler) ListUsers(c *gin.Context) {
@@ -199,7 +243,12 @@ func (h *UserHandler) ListUsers(c *gin.Context) {
         return
     }
 
-    c.JSON(http.StatusOK, resp)
+    c.JSON(http.StatusOK, gin.H{
+        "users": formatUsers(resp.Users),
+        "total": resp.Total,
+        "page":  resp.Page,
+        "limit": resp.Limit,
+    })
 }
 
 func (h *UserHandler) GetUser(c *gin.Context) {
@@ -218,7 +267,24 @@ func (h *UserHandler) GetUser(c *gin.Context) {
         return
     }
 
-    c.JSON(http.StatusOK, resp)
+    // Admins see the internal notes on the user alongside the account,
+    result := gin.H{"user": formatUser(resp.User)}
+    notes, err := h.client.ListUserNotes(c.Request.Context(), &pb.ListUserNotesRequest{UserId: userID})
+    if err != nil {
+        h.logger.Warn("Failed to fetch user notes", zap.Error(err), zap.String("user_id", userID))
+    } else {
+        result["notes"] = formatUserNotes(notes.Notes)
+    }
+
+    // and the lifetime metrics of their orders
+    stats, err := h.client.GetUserStats(c.Request.Context(), &pb.GetUserStatsRequest{UserId: userID})
+    if err != nil {
+        h.logger.Warn("Failed to fetch user stats", zap.Error(err), zap.String("user_id", userID))
+    } else {
+        result["stats"] = formatUserStats(stats.Stats)
+    }
+
+    c.JSON(http.StatusOK, result)
 }
 
 func (h *UserHandler) DeleteUser(c *gin.Context) {
@@ -229,7 +295,7 @@ func (h *UserHandler) DeleteUser(c *gin.Context) {
         return
     }
     
-    _, err = h.client.DeleteUser(c.Request.Context(), &pb.DeleteUserRequest{UserId: userID})
+    _, err = h.client.DeleteUser(c.Request.Context(), &pb.DeleteUserRequest{UserId: userID, ActorId: c.GetString("user_id")})
     if err != nil {
         h.handleGRPCError(c, err, "Failed to delete user")
         return
@@ -242,7 +308,7 @@ func (h *UserHandler) handleGRPCError(c *gin.Context, err error, defaultMsg stri
     st, ok := status.FromError(err)
     if !ok {
         h.logger.Error(defaultMsg, zap.Error(err))
-        c.JSON(http.StatusInternalServerError, gin.H{"error": defaultMsg})
+        c.JSON(http.StatusInternalServerError, gin.H{"error": defaultMsg, "request_id": middleware.GetRequestID(c)})
         return
     }
 
@@ -252,19 +318,19 @@ func (h *UserHandler) handleGRPCError(c *gin.Context, err error, defaultMsg stri
 
     switch st.Code() {
     case codes.NotFound:
-        c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+        c.JSON(http.StatusNotFound, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
     case codes.AlreadyExists:
-        c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+        c.JSON(http.StatusConflict, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
     case codes.InvalidArgument:
-        c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+        c.JSON(http.StatusBadRequest, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
     case codes.Unauthenticated:
-        c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
+        c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
     case codes.PermissionDenied:
-        c.JSON(http.StatusForbidden, gin.H{"error": st.Message()})
+        c.JSON(http.StatusForbidden, gin.H{"error": st.Message(), "request_id": middleware.GetRequestID(c)})
     case codes.Unavailable:
-        c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Service unavailable"})
+        c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Service unavailable", "request_id": middleware.GetRequestID(c)})
     default:
-        c.JSON(http.StatusInternalServerError, gin.H{"error": defaultMsg})
+        c.JSON(http.StatusInternalServerError, gin.H{"error": defaultMsg, "request_id": middleware.GetRequestID(c)})
     }
 }
 
@@ -314,10 +380,19 @@ func (h *UserHandler) Login(c *gin.Context) {
    
     // Return access token and user details in the response body
     // Refresh token is handled via HttpOnly cookie
-    c.JSON(http.StatusOK, gin.H{
+    body := gin.H{
     	"access_token": resp.Token, // Keep only one access_token key
         "user":         resp.User,
-    })
+    }
+
+    // Move what the shopper collected as a guest into their account
+    if resp.User != nil {
+        if merged := h.mergeGuestData(ctx, c, resp.User.UserId); merged != nil {
+            body["guest_data_merged"] = merged
+        }
+    }
+
+    c.JSON(http.StatusOK, body)
 }
 
 func (h *UserHandler) Logout(c *gin.Context) {
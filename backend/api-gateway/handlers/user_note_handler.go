@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// ListUserNotes lists the internal notes on a user, newest first
+func (h *UserHandler) ListUserNotes(c *gin.Context) {
+	userID, err := h.parseUserID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	resp, err := h.client.ListUserNotes(c.Request.Context(), &pb.ListUserNotesRequest{UserId: userID})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list notes")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"notes": formatUserNotes(resp.Notes)})
+}
+
+// CreateUserNote attaches a note to a user on behalf of the current admin
+func (h *UserHandler) CreateUserNote(c *gin.Context) {
+	userID, err := h.parseUserID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	var req NoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.CreateUserNote(c.Request.Context(), &pb.CreateUserNoteRequest{
+		UserId:      userID,
+		AuthorId:    c.GetString("user_id"),
+		AuthorEmail: c.GetString("user_email"),
+		Body:        req.Body,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to create note")
+		return
+	}
+
+	c.JSON(http.StatusCreated, formatUserNote(resp.Note))
+}
+
+// UpdateUserNote changes the body of a note; only its author may do so
+func (h *UserHandler) UpdateUserNote(c *gin.Context) {
+	userID, err := h.parseUserID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	var req NoteRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.client.UpdateUserNote(c.Request.Context(), &pb.UpdateUserNoteRequest{
+		NoteId:   c.Param("note_id"),
+		UserId:   userID,
+		AuthorId: c.GetString("user_id"),
+		Body:     req.Body,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to update note")
+		return
+	}
+
+	c.JSON(http.StatusOK, formatUserNote(resp.Note))
+}
+
+// DeleteUserNote removes a note from a user
+func (h *UserHandler) DeleteUserNote(c *gin.Context) {
+	userID, err := h.parseUserID(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user ID"})
+		return
+	}
+
+	_, err = h.client.DeleteUserNote(c.Request.Context(), &pb.DeleteUserNoteRequest{
+		NoteId: c.Param("note_id"),
+		UserId: userID,
+	})
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to delete note")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Note deleted successfully"})
+}
+
+func formatUserNotes(notes []*pb.UserNote) []gin.H {
+	formatted := make([]gin.H, len(notes))
+	for i, note := range notes {
+		formatted[i] = formatUserNote(note)
+	}
+	return formatted
+}
+
+func formatUserNote(note *pb.UserNote) gin.H {
+	return gin.H{
+		"id":           note.NoteId,
+		"user_id":      note.UserId,
+		"author_id":    note.AuthorId,
+		"author_email": note.AuthorEmail,
+		"body":         note.Body,
+		"created_at":   note.CreatedAt,
+		"updated_at":   note.UpdatedAt,
+	}
+}
@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// UserStatsResponse are the lifetime metrics of a customer, maintained from
+// the events of their orders
+type UserStatsResponse struct {
+	UserID            string  `json:"user_id"`
+	TotalOrders       int64   `json:"total_orders"`
+	TotalSpend        float64 `json:"total_spend"`
+	AverageOrderValue float64 `json:"average_order_value"`
+	LastOrderAt       string  `json:"last_order_at,omitempty"`
+}
+
+// ListCustomerMetrics lists the lifetime metrics of customers, highest spend
+// first, filtered by min_orders, min_spend and the last order date
+// (last_order_after and last_order_before, RFC3339), e.g. to build a segment
+func (h *UserHandler) ListCustomerMetrics(c *gin.Context) {
+	req := &pb.ListUserStatsRequest{
+		LastOrderAfter:  c.Query("last_order_after"),
+		LastOrderBefore: c.Query("last_order_before"),
+	}
+	var err error
+	if v := c.Query("min_orders"); v != "" {
+		if req.MinOrders, err = strconv.ParseInt(v, 10, 64); err != nil || req.MinOrders < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid min_orders"})
+			return
+		}
+	}
+	if v := c.Query("min_spend"); v != "" {
+		if req.MinSpend, err = strconv.ParseFloat(v, 64); err != nil || req.MinSpend < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid min_spend"})
+			return
+		}
+	}
+	page, limit := getPaginationParams(c)
+	req.Page = int32(page)
+	req.Limit = int32(limit)
+
+	resp, err := h.client.ListUserStats(c.Request.Context(), req)
+	if err != nil {
+		h.handleGRPCError(c, err, "Failed to list customer metrics")
+		return
+	}
+
+	customers := make([]UserStatsResponse, len(resp.Stats))
+	for i, stats := range resp.Stats {
+		customers[i] = formatUserStats(stats)
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"customers": customers,
+		"total":     resp.Total,
+		"page":      page,
+		"limit":     limit,
+	})
+}
+
+func formatUserStats(stats *pb.UserStats) UserStatsResponse {
+	return UserStatsResponse{
+		UserID:            stats.UserId,
+		TotalOrders:       stats.TotalOrders,
+		TotalSpend:        stats.TotalSpend,
+		AverageOrderValue: stats.AverageOrderValue,
+		LastOrderAt:       stats.LastOrderAt,
+	}
+}
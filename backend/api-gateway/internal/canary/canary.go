@@ -0,0 +1,145 @@
+// Package canary routes a share of the gateway's calls to a service, and the
+// calls of requests asking for it, to a canary build of that service, so that
+// new builds take real traffic before they replace the stable one.
+//
+// Each request is assigned a bucket from 0 to 99, stable for a shopper so
+// that they do not flip between builds. A service routes the requests whose
+// bucket is below its canary percentage to its canary address. The X-Canary
+// header overrides the bucket.
+package canary
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+)
+
+// Header lets a request pick the build: "always" routes it to the canary of
+// every service that has one, "never" to the stable builds
+const Header = "X-Canary"
+
+// Mode is how a request is routed
+type Mode int
+
+const (
+	// Auto routes by bucket
+	Auto Mode = iota
+	// Always routes to the canary
+	Always
+	// Never routes to the stable build
+	Never
+)
+
+// ParseMode parses the value of the X-Canary header, Auto when empty or
+// unknown
+func ParseMode(value string) Mode {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "always", "true", "1":
+		return Always
+	case "never", "false", "0":
+		return Never
+	}
+	return Auto
+}
+
+type routing struct {
+	mode   Mode
+	bucket int
+}
+
+type contextKey struct{}
+
+// WithRouting returns a context carrying how the calls of a request are
+// routed. The bucket of a shopper is stable; requests without one get a
+// random bucket.
+func WithRouting(ctx context.Context, mode Mode, shopperID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, routing{mode: mode, bucket: Bucket(shopperID)})
+}
+
+// Bucket maps a shopper to a value in [0, 100), random without a shopper
+func Bucket(shopperID string) int {
+	if shopperID == "" {
+		return rand.Intn(100)
+	}
+	h := fnv.New32a()
+	h.Write([]byte("canary:"))
+	h.Write([]byte(shopperID))
+	return int(h.Sum32() % 100)
+}
+
+// Config is the canary of a service
+type Config struct {
+	// Addr is the address of the canary build, empty for none
+	Addr string
+	// Percent is the share of the requests routed to the canary, 0 to 100
+	Percent int
+}
+
+// ConfigFromEnv reads the canary of a service from <PREFIX>_CANARY_ADDR and
+// <PREFIX>_CANARY_PERCENT, such as PRODUCT_SERVICE_CANARY_ADDR
+func ConfigFromEnv(prefix string) (Config, error) {
+	cfg := Config{Addr: os.Getenv(prefix + "_CANARY_ADDR")}
+	if percent := os.Getenv(prefix + "_CANARY_PERCENT"); percent != "" {
+		var err error
+		if cfg.Percent, err = strconv.Atoi(percent); err != nil || cfg.Percent < 0 || cfg.Percent > 100 {
+			return Config{}, fmt.Errorf("%s_CANARY_PERCENT must be between 0 and 100", prefix)
+		}
+	}
+	return cfg, nil
+}
+
+// Conn is a client connection sending each call either to the stable or to
+// the canary build of a service. Calls without routing, such as those of
+// background jobs, go to the stable build.
+type Conn struct {
+	stable  grpc.ClientConnInterface
+	canary  grpc.ClientConnInterface
+	percent int
+}
+
+// Ensure Conn can back generated clients
+var _ grpc.ClientConnInterface = (*Conn)(nil)
+
+// NewConn creates a connection routing percent of the requests, and those
+// asking for it, to the canary
+func NewConn(stable, canary grpc.ClientConnInterface, percent int) *Conn {
+	return &Conn{stable: stable, canary: canary, percent: percent}
+}
+
+// UsesCanary reports whether the calls made with ctx go to the canary
+func (c *Conn) UsesCanary(ctx context.Context) bool {
+	r, ok := ctx.Value(contextKey{}).(routing)
+	if !ok {
+		return false
+	}
+	switch r.mode {
+	case Always:
+		return true
+	case Never:
+		return false
+	}
+	return r.bucket < c.percent
+}
+
+func (c *Conn) pick(ctx context.Context) grpc.ClientConnInterface {
+	if c.UsesCanary(ctx) {
+		return c.canary
+	}
+	return c.stable
+}
+
+// Invoke sends a unary call to the build picked for ctx
+func (c *Conn) Invoke(ctx context.Context, method string, args, reply any, opts ...grpc.CallOption) error {
+	return c.pick(ctx).Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream opens a stream to the build picked for ctx
+func (c *Conn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.pick(ctx).NewStream(ctx, desc, method, opts...)
+}
@@ -0,0 +1,72 @@
+package canary
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+// fakeConn records the calls it receives
+type fakeConn struct {
+	calls int
+}
+
+func (f *fakeConn) Invoke(context.Context, string, any, any, ...grpc.CallOption) error {
+	f.calls++
+	return nil
+}
+
+func (f *fakeConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	f.calls++
+	return nil, nil
+}
+
+func TestConnRouting(t *testing.T) {
+	shopper := "user-1"
+	bucket := Bucket(shopper)
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		percent int
+		canary  bool
+	}{
+		{"no routing", context.Background(), 100, false},
+		{"always", WithRouting(context.Background(), Always, shopper), 0, true},
+		{"never", WithRouting(context.Background(), Never, shopper), 100, false},
+		{"bucket below percent", WithRouting(context.Background(), Auto, shopper), bucket + 1, true},
+		{"bucket at percent", WithRouting(context.Background(), Auto, shopper), bucket, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stable, canary := &fakeConn{}, &fakeConn{}
+			conn := NewConn(stable, canary, tt.percent)
+			if err := conn.Invoke(tt.ctx, "/svc/Method", nil, nil); err != nil {
+				t.Fatal(err)
+			}
+			if got := canary.calls == 1; got != tt.canary || stable.calls+canary.calls != 1 {
+				t.Errorf("canary calls = %d, stable calls = %d, want canary %v", canary.calls, stable.calls, tt.canary)
+			}
+		})
+	}
+}
+
+func TestBucketIsStable(t *testing.T) {
+	for i := 0; i < 10; i++ {
+		if Bucket("guest-42") != Bucket("guest-42") {
+			t.Fatal("bucket of a shopper changed")
+		}
+	}
+	if b := Bucket(""); b < 0 || b >= 100 {
+		t.Errorf("anonymous bucket = %d", b)
+	}
+}
+
+func TestParseMode(t *testing.T) {
+	for value, want := range map[string]Mode{"always": Always, "TRUE": Always, "never": Never, "0": Never, "": Auto, "maybe": Auto} {
+		if got := ParseMode(value); got != want {
+			t.Errorf("ParseMode(%q) = %v, want %v", value, got, want)
+		}
+	}
+}
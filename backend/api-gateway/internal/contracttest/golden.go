@@ -0,0 +1,53 @@
+package contracttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite golden files with the current output")
+
+// AssertGoldenJSON compares a JSON document with the golden file at path,
+// ignoring formatting. Run the tests with -update to write the golden file
+// from the current output after an intended change.
+func AssertGoldenJSON(t testing.TB, path string, got []byte) {
+	t.Helper()
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, got, "", "  "); err != nil {
+		t.Fatalf("contracttest: output is not JSON: %v\n%s", err, got)
+	}
+	indented.WriteByte('\n')
+
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("contracttest: %v", err)
+		}
+		if err := os.WriteFile(path, indented.Bytes(), 0o644); err != nil {
+			t.Fatalf("contracttest: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("contracttest: %v (run with -update to create it)", err)
+	}
+
+	var gotValue, wantValue any
+	if err := json.Unmarshal(got, &gotValue); err != nil {
+		t.Fatalf("contracttest: %v", err)
+	}
+	if err := json.Unmarshal(want, &wantValue); err != nil {
+		t.Fatalf("contracttest: golden file %s is not JSON: %v", path, err)
+	}
+	gotNormalized, _ := json.Marshal(gotValue)
+	wantNormalized, _ := json.Marshal(wantValue)
+	if !bytes.Equal(gotNormalized, wantNormalized) {
+		t.Errorf("contracttest: output does not match %s\ngot:\n%s\nwant:\n%s", path, indented.String(), want)
+	}
+}
@@ -0,0 +1,277 @@
+// Package contracttest verifies gateway handlers against the gRPC contracts
+// of the backend services. It serves golden request/response fixtures from
+// an in-memory gRPC server, so handler tests run against real proto
+// messages without starting the services.
+//
+// Fixture files hold a JSON array of fixtures. Requests and responses are
+// written in the protojson format of the method's message types:
+//
+//	[
+//	  {
+//	    "method": "/product.ProductService/GetProduct",
+//	    "request": {"id": "p1"},
+//	    "response": {"id": "p1", "title": "Mug"}
+//	  },
+//	  {
+//	    "method": "/product.ProductService/GetProduct",
+//	    "request": {"id": "missing"},
+//	    "error": {"code": "NotFound", "message": "product not found"}
+//	  }
+//	]
+//
+// Fixtures are parsed strictly, so a field that is renamed or removed in a
+// proto breaks the fixtures that use it.
+package contracttest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const bufSize = 1024 * 1024
+
+// Fixture is a golden request of a gRPC method and the response or error
+// the service answers it with
+type Fixture struct {
+	Method   string          `json:"method"`
+	Request  json.RawMessage `json:"request"`
+	Response json.RawMessage `json:"response,omitempty"`
+	Error    *FixtureError   `json:"error,omitempty"`
+}
+
+// FixtureError is the status a fixture answers with, e.g.
+// {"code": "NotFound", "message": "product not found"}
+type FixtureError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// fixture is a parsed fixture
+type fixture struct {
+	file     string
+	method   string
+	request  proto.Message
+	response proto.Message
+	err      error
+	called   bool
+}
+
+// Server is an in-memory gRPC server that answers calls from fixtures. Any
+// service whose proto package is linked into the test binary can be served.
+type Server struct {
+	listener *bufconn.Listener
+	server   *grpc.Server
+
+	mu       sync.Mutex
+	fixtures []*fixture
+}
+
+// NewServer starts a server with the fixtures of the given files. The test
+// fails if a fixture does not match the contract of its method. The server
+// is stopped when the test ends.
+func NewServer(t testing.TB, files ...string) *Server {
+	t.Helper()
+
+	s := &Server{listener: bufconn.Listen(bufSize)}
+	for _, file := range files {
+		fixtures, err := loadFixtures(file)
+		if err != nil {
+			t.Fatalf("contracttest: %v", err)
+		}
+		s.fixtures = append(s.fixtures, fixtures...)
+	}
+
+	s.server = grpc.NewServer(grpc.UnknownServiceHandler(s.handle))
+	go s.server.Serve(s.listener)
+	t.Cleanup(s.server.Stop)
+	return s
+}
+
+// Dial returns a client connection to the server. The connection is closed
+// when the test ends.
+func (s *Server) Dial(t testing.TB, opts ...grpc.DialOption) *grpc.ClientConn {
+	t.Helper()
+
+	opts = append([]grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return s.listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	}, opts...)
+	conn, err := grpc.NewClient("passthrough:///bufnet", opts...)
+	if err != nil {
+		t.Fatalf("contracttest: failed to dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// AssertAllCalled fails the test for every fixture that was never matched,
+// which usually means a handler stopped calling a method or changed its
+// request
+func (s *Server) AssertAllCalled(t testing.TB) {
+	t.Helper()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, f := range s.fixtures {
+		if !f.called {
+			t.Errorf("contracttest: fixture for %s in %s was not called with request %s", f.method, f.file, marshal(f.request))
+		}
+	}
+}
+
+// handle answers every call with the first fixture of the method whose
+// request equals the received one. Unmatched calls fail with Unimplemented
+// and the received request, which the handler under test turns into an
+// error response the test reports.
+func (s *Server) handle(_ any, stream grpc.ServerStream) error {
+	fullMethod, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "contracttest: unknown method")
+	}
+	input, _, err := methodTypes(fullMethod)
+	if err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+
+	req := input.New().Interface()
+	if err := stream.RecvMsg(req); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	var match *fixture
+	for _, f := range s.fixtures {
+		if f.method == fullMethod && proto.Equal(f.request, req) {
+			match = f
+			f.called = true
+			break
+		}
+	}
+	s.mu.Unlock()
+
+	if match == nil {
+		return status.Errorf(codes.Unimplemented, "contracttest: no fixture for %s with request %s", fullMethod, marshal(req))
+	}
+	if match.err != nil {
+		return match.err
+	}
+	return stream.SendMsg(match.response)
+}
+
+// loadFixtures parses a fixture file against the registered proto types
+func loadFixtures(file string) ([]*fixture, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read fixtures: %w", err)
+	}
+	var raw []Fixture
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+	}
+
+	fixtures := make([]*fixture, 0, len(raw))
+	for i, r := range raw {
+		input, output, err := methodTypes(r.Method)
+		if err != nil {
+			return nil, fmt.Errorf("%s fixture %d: %w", file, i, err)
+		}
+
+		f := &fixture{file: file, method: r.Method, request: input.New().Interface()}
+		if err := unmarshal(r.Request, f.request); err != nil {
+			return nil, fmt.Errorf("%s fixture %d: request does not match %s: %w", file, i, input.Descriptor().FullName(), err)
+		}
+
+		switch {
+		case r.Error != nil:
+			code, err := parseCode(r.Error.Code)
+			if err != nil {
+				return nil, fmt.Errorf("%s fixture %d: %w", file, i, err)
+			}
+			f.err = status.Error(code, r.Error.Message)
+		default:
+			f.response = output.New().Interface()
+			if err := unmarshal(r.Response, f.response); err != nil {
+				return nil, fmt.Errorf("%s fixture %d: response does not match %s: %w", file, i, output.Descriptor().FullName(), err)
+			}
+		}
+		fixtures = append(fixtures, f)
+	}
+	return fixtures, nil
+}
+
+// methodTypes resolves the request and response types of a full method
+// name such as /product.ProductService/GetProduct
+func methodTypes(fullMethod string) (protoreflect.MessageType, protoreflect.MessageType, error) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if !ok {
+		return nil, nil, fmt.Errorf("invalid method %q", fullMethod)
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown service %q, is its proto package imported?", service)
+	}
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("%q is not a service", service)
+	}
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(method))
+	if methodDesc == nil {
+		return nil, nil, fmt.Errorf("service %q has no method %q", service, method)
+	}
+	if methodDesc.IsStreamingClient() || methodDesc.IsStreamingServer() {
+		return nil, nil, fmt.Errorf("streaming method %q is not supported", fullMethod)
+	}
+
+	input, err := protoregistry.GlobalTypes.FindMessageByName(methodDesc.Input().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	output, err := protoregistry.GlobalTypes.FindMessageByName(methodDesc.Output().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	return input, output, nil
+}
+
+// parseCode parses a status code name such as NotFound
+func parseCode(name string) (codes.Code, error) {
+	for code := codes.OK; code <= codes.Unauthenticated; code++ {
+		if code.String() == name {
+			return code, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown status code %q", name)
+}
+
+func unmarshal(data json.RawMessage, m proto.Message) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return protojson.Unmarshal(data, m)
+}
+
+func marshal(m proto.Message) string {
+	data, err := protojson.Marshal(m)
+	if err != nil {
+		return fmt.Sprintf("<%v>", err)
+	}
+	return string(data)
+}
@@ -0,0 +1,150 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Schema is an OpenAPI 3 schema object
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemaFor returns the schema of a Go type. Named structs are added to the
+// components and referenced, so shared formatter types appear once.
+func (b *Builder) schemaFor(t reflect.Type) *Schema {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		nullable = true
+	}
+
+	var schema *Schema
+	switch {
+	case t == timeType:
+		schema = &Schema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		// Any JSON value, an empty schema
+		schema = &Schema{}
+	case t.Kind() == reflect.Struct && t.Name() != "":
+		b.addComponent(t)
+		// Siblings of $ref are ignored, so nullable references are not marked
+		return &Schema{Ref: "#/components/schemas/" + componentName(t)}
+	case t.Kind() == reflect.Struct:
+		schema = b.structSchema(t)
+	case t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8:
+		schema = &Schema{Type: "string", Format: "byte"}
+	case t.Kind() == reflect.Slice || t.Kind() == reflect.Array:
+		schema = &Schema{Type: "array", Items: b.schemaFor(t.Elem())}
+	case t.Kind() == reflect.Map:
+		schema = &Schema{Type: "object", AdditionalProperties: b.schemaFor(t.Elem())}
+	case t.Kind() == reflect.Bool:
+		schema = &Schema{Type: "boolean"}
+	case t.Kind() == reflect.String:
+		schema = &Schema{Type: "string"}
+	case t.Kind() == reflect.Float32:
+		schema = &Schema{Type: "number", Format: "float"}
+	case t.Kind() == reflect.Float64:
+		schema = &Schema{Type: "number", Format: "double"}
+	case t.Kind() == reflect.Int64 || t.Kind() == reflect.Uint64:
+		schema = &Schema{Type: "integer", Format: "int64"}
+	case t.Kind() >= reflect.Int && t.Kind() <= reflect.Uint32:
+		schema = &Schema{Type: "integer", Format: "int32"}
+	default:
+		// interface{} and anything else accepts any JSON value
+		schema = &Schema{}
+	}
+	schema.Nullable = nullable
+	return schema
+}
+
+// addComponent registers the schema of a named struct once
+func (b *Builder) addComponent(t reflect.Type) {
+	name := componentName(t)
+	if _, ok := b.components[name]; ok {
+		return
+	}
+	// Reserve the name first so recursive types terminate
+	b.components[name] = &Schema{}
+	*b.components[name] = *b.structSchema(t)
+}
+
+// structSchema builds the object schema of a struct from its json tags.
+// Fields whose gin "binding" tag contains "required" are marked required.
+func (b *Builder) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, skip := jsonName(field)
+		if skip {
+			continue
+		}
+		if field.Anonymous && name == "" {
+			// Embedded structs are flattened like encoding/json does
+			embedded := b.structSchema(indirect(field.Type))
+			for key, value := range embedded.Properties {
+				schema.Properties[key] = value
+			}
+			schema.Required = append(schema.Required, embedded.Required...)
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema.Properties[name] = b.schemaFor(field.Type)
+		if strings.Contains(field.Tag.Get("binding"), "required") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+// jsonName parses the json tag of a field
+func jsonName(field reflect.StructField) (name string, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	name, _, _ = strings.Cut(tag, ",")
+	return name, false
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+// componentName names the component of a struct after its package and
+// type, e.g. formatters.ProductResponse
+func componentName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if i := strings.LastIndex(pkg, "/"); i >= 0 {
+		pkg = pkg[i+1:]
+	}
+	if pkg == "" {
+		return t.Name()
+	}
+	return pkg + "." + t.Name()
+}
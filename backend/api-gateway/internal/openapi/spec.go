@@ -0,0 +1,319 @@
+// Package openapi generates the OpenAPI 3 document of the gateway. Paths
+// come from the registered routes and schemas are reflected from the Go
+// types handlers bind and return, so the document follows the code instead
+// of a hand-written YAML file.
+package openapi
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Route is a registered route, as listed by gin.Engine.Routes
+type Route struct {
+	Method string
+	Path   string
+}
+
+// Auth is the access level an operation requires
+type Auth int
+
+const (
+	// Public operations need no credentials
+	Public Auth = iota
+	// User operations need a bearer token
+	User
+	// Admin operations need a bearer token of an admin
+	Admin
+	// Integration operations need the API key of an integration in the
+	// X-API-Key header
+	Integration
+)
+
+// Operation documents a route
+type Operation struct {
+	Tag     string
+	Summary string
+	Auth    Auth
+	// Query lists the query parameters of the operation
+	Query []Param
+	// Request is a value of the type the request body is bound to, nil for
+	// operations without a body
+	Request any
+	// Response is a value of the type of the success response, nil when the
+	// response is an ad-hoc object
+	Response any
+	// Status is the success status code, 200 when zero
+	Status int
+}
+
+// Param is a query parameter
+type Param struct {
+	Name        string
+	Description string
+	Type        string // string, integer, number or boolean
+	Required    bool
+}
+
+// Document is an OpenAPI 3 document
+type Document struct {
+	OpenAPI    string                          `json:"openapi"`
+	Info       Info                            `json:"info"`
+	Servers    []Server                        `json:"servers,omitempty"`
+	Paths      map[string]map[string]*pathItem `json:"paths"`
+	Components components                      `json:"components"`
+}
+
+// Info is the info object of a document
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Server is a server the API is served from
+type Server struct {
+	URL string `json:"url"`
+}
+
+type pathItem struct {
+	Tags        []string             `json:"tags,omitempty"`
+	Summary     string               `json:"summary,omitempty"`
+	OperationID string               `json:"operationId"`
+	Parameters  []parameter          `json:"parameters,omitempty"`
+	RequestBody *requestBody         `json:"requestBody,omitempty"`
+	Responses   map[string]*response `json:"responses"`
+	Security    []map[string][]any   `json:"security,omitempty"`
+}
+
+type parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+type requestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]mediaType `json:"content"`
+}
+
+type response struct {
+	Description string               `json:"description"`
+	Content     map[string]mediaType `json:"content,omitempty"`
+}
+
+type mediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type components struct {
+	Schemas         map[string]*Schema        `json:"schemas"`
+	SecuritySchemes map[string]securityScheme `json:"securitySchemes"`
+}
+
+type securityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+	In           string `json:"in,omitempty"`
+	Name         string `json:"name,omitempty"`
+}
+
+// errorSchema is the body of every error response of the gateway
+var errorSchema = &Schema{
+	Type: "object",
+	Properties: map[string]*Schema{
+		"error":      {Type: "string"},
+		"request_id": {Type: "string"},
+	},
+	Required: []string{"error"},
+}
+
+// Builder collects operation docs and builds the document
+type Builder struct {
+	info       Info
+	operations map[string]Operation // "METHOD path" -> operation
+	components map[string]*Schema
+}
+
+// NewBuilder creates a builder for a document with the given info
+func NewBuilder(info Info) *Builder {
+	return &Builder{
+		info:       info,
+		operations: make(map[string]Operation),
+	}
+}
+
+// Document adds the docs of the route at method and path, using the gin
+// path syntax, e.g. "/api/v1/products/:id"
+func (b *Builder) Document(method, path string, op Operation) {
+	b.operations[method+" "+path] = op
+}
+
+// Build generates the document of the routes. Routes without docs are still
+// listed, with their path parameters and a generic response, so no route is
+// missing from the document.
+func (b *Builder) Build(routes []Route) *Document {
+	b.components = map[string]*Schema{
+		"Error": errorSchema,
+	}
+
+	doc := &Document{
+		OpenAPI: "3.0.3",
+		Info:    b.info,
+		Paths:   make(map[string]map[string]*pathItem),
+		Components: components{
+			Schemas: b.components,
+			SecuritySchemes: map[string]securityScheme{
+				"bearerAuth": {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+				"apiKeyAuth": {Type: "apiKey", In: "header", Name: "X-API-Key"},
+			},
+		},
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Method < routes[j].Method
+	})
+
+	for _, route := range routes {
+		path, params := convertPath(route.Path)
+		op, documented := b.operations[route.Method+" "+route.Path]
+		item := b.pathItem(route, op, documented, params)
+
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*pathItem)
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = item
+	}
+	return doc
+}
+
+func (b *Builder) pathItem(route Route, op Operation, documented bool, pathParams []string) *pathItem {
+	item := &pathItem{
+		Summary:     op.Summary,
+		OperationID: operationID(route),
+		Responses:   make(map[string]*response),
+	}
+	if op.Tag != "" {
+		item.Tags = []string{op.Tag}
+	} else {
+		item.Tags = []string{defaultTag(route.Path)}
+	}
+
+	// Every route is served for the store selected by the tenant header
+	item.Parameters = append(item.Parameters, parameter{
+		Name:        "X-Tenant-ID",
+		In:          "header",
+		Description: "Store the request is made for, defaults to the store of the request host",
+		Schema:      &Schema{Type: "string"},
+	})
+	for _, name := range pathParams {
+		item.Parameters = append(item.Parameters, parameter{Name: name, In: "path", Required: true, Schema: &Schema{Type: "string"}})
+	}
+	for _, param := range op.Query {
+		paramType := param.Type
+		if paramType == "" {
+			paramType = "string"
+		}
+		item.Parameters = append(item.Parameters, parameter{
+			Name:        param.Name,
+			In:          "query",
+			Description: param.Description,
+			Required:    param.Required,
+			Schema:      &Schema{Type: paramType},
+		})
+	}
+
+	if op.Request != nil {
+		item.RequestBody = &requestBody{
+			Required: true,
+			Content:  map[string]mediaType{"application/json": {Schema: b.schemaFor(reflect.TypeOf(op.Request))}},
+		}
+	}
+
+	status := op.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	successSchema := &Schema{Type: "object"}
+	if op.Response != nil {
+		successSchema = b.schemaFor(reflect.TypeOf(op.Response))
+	}
+	item.Responses[strconv.Itoa(status)] = &response{
+		Description: http.StatusText(status),
+		Content:     map[string]mediaType{"application/json": {Schema: successSchema}},
+	}
+
+	errorResponse := func(code int) {
+		item.Responses[strconv.Itoa(code)] = &response{
+			Description: http.StatusText(code),
+			Content:     map[string]mediaType{"application/json": {Schema: &Schema{Ref: "#/components/schemas/Error"}}},
+		}
+	}
+	errorResponse(http.StatusBadRequest)
+	errorResponse(http.StatusInternalServerError)
+
+	auth := op.Auth
+	if !documented && strings.Contains(route.Path, "/admin/") {
+		auth = Admin
+	}
+	switch auth {
+	case Integration:
+		item.Security = []map[string][]any{{"apiKeyAuth": {}}}
+		errorResponse(http.StatusUnauthorized)
+	case User, Admin:
+		item.Security = []map[string][]any{{"bearerAuth": {}}}
+		errorResponse(http.StatusUnauthorized)
+		if auth == Admin {
+			errorResponse(http.StatusForbidden)
+		}
+	}
+	return item
+}
+
+// convertPath turns a gin path into an OpenAPI path and lists its parameters
+func convertPath(path string) (string, []string) {
+	segments := strings.Split(path, "/")
+	var params []string
+	for i, segment := range segments {
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			name := segment[1:]
+			params = append(params, name)
+			segments[i] = "{" + name + "}"
+		}
+	}
+	return strings.Join(segments, "/"), params
+}
+
+// operationID derives a stable operation ID from the method and path, e.g.
+// GET /api/v1/products/:id becomes get_products_id
+func operationID(route Route) string {
+	path := strings.TrimPrefix(route.Path, "/api/v1")
+	replacer := strings.NewReplacer("/", "_", ":", "", "*", "", "-", "_")
+	id := strings.Trim(replacer.Replace(path), "_")
+	if id == "" {
+		id = "root"
+	}
+	return strings.ToLower(route.Method) + "_" + id
+}
+
+// defaultTag groups undocumented routes by their first path segment under
+// /api/v1, or under /api/v1/admin for admin routes
+func defaultTag(path string) string {
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(path, "/api/v1"), "/"), "/")
+	if segments[0] == "admin" && len(segments) > 1 {
+		return "admin"
+	}
+	if segments[0] == "" {
+		return "default"
+	}
+	return segments[0]
+}
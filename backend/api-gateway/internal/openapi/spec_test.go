@@ -0,0 +1,93 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+type testItem struct {
+	ID    string            `json:"id"`
+	Price *float64          `json:"price,omitempty"`
+	Tags  []string          `json:"tags"`
+	Attrs map[string]string `json:"attrs"`
+	Child *testItem         `json:"child,omitempty"`
+	Extra json.RawMessage   `json:"extra"`
+	Skip  string            `json:"-"`
+}
+
+type testRequest struct {
+	Item struct {
+		Name string `json:"name" binding:"required"`
+	} `json:"item" binding:"required"`
+}
+
+func TestBuild(t *testing.T) {
+	b := NewBuilder(Info{Title: "test", Version: "1"})
+	b.Document(http.MethodPost, "/api/v1/items", Operation{
+		Tag:      "items",
+		Auth:     Admin,
+		Request:  testRequest{},
+		Response: testItem{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodPost, "/api/v1/items/events", Operation{
+		Tag:  "items",
+		Auth: Integration,
+	})
+
+	doc := b.Build([]Route{
+		{Method: http.MethodPost, Path: "/api/v1/items"},
+		{Method: http.MethodPost, Path: "/api/v1/items/events"},
+		{Method: http.MethodGet, Path: "/api/v1/items/:id/parts/:part_id"},
+		{Method: http.MethodGet, Path: "/api/v1/admin/things"},
+	})
+
+	post := doc.Paths["/api/v1/items"]["post"]
+	if post == nil || post.Responses["201"] == nil || post.Responses["403"] == nil {
+		t.Fatalf("post operation = %+v", post)
+	}
+	if ref := post.RequestBody.Content["application/json"].Schema.Ref; ref != "#/components/schemas/openapi.testRequest" {
+		t.Errorf("request schema ref = %q", ref)
+	}
+	body := doc.Components.Schemas["openapi.testRequest"]
+	if len(body.Required) != 1 || body.Required[0] != "item" || body.Properties["item"].Required[0] != "name" {
+		t.Errorf("request schema = %+v", body)
+	}
+
+	item := doc.Components.Schemas["openapi.testItem"]
+	if item == nil {
+		t.Fatal("response type is not a component")
+	}
+	if item.Properties["price"].Format != "double" || !item.Properties["price"].Nullable {
+		t.Errorf("price schema = %+v", item.Properties["price"])
+	}
+	if item.Properties["child"].Ref != "#/components/schemas/openapi.testItem" {
+		t.Errorf("child schema = %+v", item.Properties["child"])
+	}
+	if extra := item.Properties["extra"]; extra == nil || extra.Type != "" {
+		t.Errorf("raw JSON schema = %+v", extra)
+	}
+	if _, ok := item.Properties["Skip"]; ok {
+		t.Error("fields tagged json:\"-\" must be skipped")
+	}
+
+	events := doc.Paths["/api/v1/items/events"]["post"]
+	if _, ok := events.Security[0]["apiKeyAuth"]; !ok || events.Responses["401"] == nil || events.Responses["403"] != nil {
+		t.Errorf("integration operation = %+v", events)
+	}
+
+	get := doc.Paths["/api/v1/items/{id}/parts/{part_id}"]["get"]
+	if get == nil || len(get.Parameters) != 3 || get.Parameters[2].Name != "part_id" {
+		t.Fatalf("path parameters = %+v", get)
+	}
+	if get.OperationID != "get_items_id_parts_part_id" {
+		t.Errorf("operation ID = %q", get.OperationID)
+	}
+
+	// Undocumented admin routes still require admin access
+	admin := doc.Paths["/api/v1/admin/things"]["get"]
+	if admin.Security == nil || admin.Responses["403"] == nil || admin.Tags[0] != "admin" {
+		t.Errorf("admin operation = %+v", admin)
+	}
+}
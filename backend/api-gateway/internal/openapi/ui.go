@@ -0,0 +1,37 @@
+package openapi
+
+import (
+	"html/template"
+	"io"
+)
+
+// swaggerUIVersion is the Swagger UI release loaded from the CDN
+const swaggerUIVersion = "5.17.14"
+
+var swaggerUITemplate = template.Must(template.New("swagger-ui").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>{{.Title}}</title>
+  <link rel="stylesheet" href="https://unpkg.com/swagger-ui-dist@{{.Version}}/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="https://unpkg.com/swagger-ui-dist@{{.Version}}/swagger-ui-bundle.js" crossorigin></script>
+  <script>
+    window.onload = function () {
+      window.ui = SwaggerUIBundle({ url: {{.SpecURL}}, dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`))
+
+// WriteSwaggerUI writes a Swagger UI page that loads the document at specURL
+func WriteSwaggerUI(w io.Writer, title, specURL string) error {
+	return swaggerUITemplate.Execute(w, struct {
+		Title   string
+		Version string
+		SpecURL string
+	}{title, swaggerUIVersion, specURL})
+}
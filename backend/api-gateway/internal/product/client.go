@@ -8,6 +8,11 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/louai60/e-commerce_project/backend/api-gateway/config"
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
 )
 
@@ -25,6 +30,7 @@ func NewClient(cfg *config.Config) (*Client, error) {
 		ctx,
 		cfg.Services.Product.Host+":"+cfg.Services.Product.Port,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), locale.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), cachectl.UnaryClientInterceptor()),
 		grpc.WithBlock(),
 	)
 	if err != nil {
@@ -3,12 +3,15 @@ package routes
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/handlers"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
 )
 
 // SetupGraphQLRoutes sets up GraphQL routes
-func SetupGraphQLRoutes(r *gin.Engine, graphqlHandler *handlers.GraphQLHandler) {
-	// GraphQL endpoint
-	graphql := r.Group("/api/v1/graphql")
+func SetupGraphQLRoutes(r *gin.Engine, graphqlHandler *handlers.GraphQLHandler, flags *featureflags.Client, killSwitch gin.HandlerFunc) {
+	// GraphQL endpoint, gated by the "graphql" feature flag (on until the flag
+	// is created) and behind the graphql kill switch
+	graphql := r.Group("/api/v1/graphql", middleware.FeatureFlag(flags, "graphql", true), killSwitch)
 	{
 		// Allow public access to GraphQL endpoint for queries
 		graphql.POST("", graphqlHandler.Handle)
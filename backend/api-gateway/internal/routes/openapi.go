@@ -0,0 +1,1622 @@
+package routes
+
+import (
+	"net/http"
+	"slices"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/formatters"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/handlers"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/internal/openapi"
+)
+
+// SetupOpenAPIRoutes serves the OpenAPI document of the gateway at
+// /api/v1/openapi.json and a Swagger UI at /api/v1/docs. The document is
+// generated from the routes registered on r the first time it is requested,
+// so it also covers routes registered after this call.
+func SetupOpenAPIRoutes(r *gin.Engine) {
+	builder := openapi.NewBuilder(openapi.Info{
+		Title:       "E-commerce API Gateway",
+		Description: "REST API of the e-commerce platform. Admin operations require a bearer token of an admin.",
+		Version:     "1.0.0",
+	})
+	documentOperations(builder)
+
+	var (
+		once sync.Once
+		doc  *openapi.Document
+	)
+	r.GET("/api/v1/openapi.json", func(c *gin.Context) {
+		once.Do(func() {
+			routes := r.Routes()
+			specRoutes := make([]openapi.Route, 0, len(routes))
+			for _, route := range routes {
+				specRoutes = append(specRoutes, openapi.Route{Method: route.Method, Path: route.Path})
+			}
+			doc = builder.Build(specRoutes)
+		})
+		c.JSON(http.StatusOK, doc)
+	})
+	r.GET("/api/v1/docs", func(c *gin.Context) {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		if err := openapi.WriteSwaggerUI(c.Writer, "E-commerce API Gateway", "/api/v1/openapi.json"); err != nil {
+			c.Status(http.StatusInternalServerError)
+		}
+	})
+}
+
+// pagination lists the query parameters of paginated listings
+var pagination = []openapi.Param{
+	{Name: "page", Type: "integer", Description: "Page number, starting at 1"},
+	{Name: "limit", Type: "integer", Description: "Items per page"},
+}
+
+// productView selects the full or summary representation of products
+var productView = openapi.Param{
+	Name:        "view",
+	Description: "full (default) or summary; the summary omits variants, specifications, reviews, SEO and shipping data",
+}
+
+// sparseFields selects the attributes of the returned resources
+var sparseFields = openapi.Param{
+	Name:        "fields",
+	Description: "Comma separated attributes to return, e.g. title,price; the id is always returned. fields[<type>] is accepted too.",
+}
+
+// documentOperations describes the routes whose request and response types
+// are known. Schemas are reflected from the types, so changing a formatter
+// or request struct changes the document.
+func documentOperations(b *openapi.Builder) {
+	// Products
+	b.Document(http.MethodGet, "/api/v1/products", openapi.Operation{
+		Tag:      "products",
+		Summary:  "List products",
+		Query:    slices.Concat(pagination, []openapi.Param{{Name: "channel", Description: "Only list products published to this sales channel"}, productView, sparseFields}),
+		Response: formatters.ProductListResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/products/:id", openapi.Operation{
+		Tag:     "products",
+		Summary: "Get a product with its related products",
+		Query: []openapi.Param{productView, sparseFields, {
+			Name:        "related_limit",
+			Description: "Related products of each type to include in the full view, 0 for none; defaults to the configured limit",
+			Type:        "integer",
+		}},
+		Response: formatters.ProductResponse{},
+	})
+	b.Document(http.MethodPost, "/api/v1/products", openapi.Operation{
+		Tag:      "products",
+		Summary:  "Create a product with its initial inventory",
+		Auth:     openapi.Admin,
+		Response: formatters.ProductResponse{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodPost, "/api/v1/products/bundles", openapi.Operation{
+		Tag:      "products",
+		Summary:  "Create a bundle product",
+		Auth:     openapi.Admin,
+		Request:  handlers.BundleRequest{},
+		Response: formatters.ProductResponse{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/products/:id", openapi.Operation{
+		Tag:      "products",
+		Summary:  "Update a product",
+		Auth:     openapi.Admin,
+		Response: formatters.ProductResponse{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/products/:id", openapi.Operation{
+		Tag:     "products",
+		Summary: "Delete a product",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/products/:id/channels", openapi.Operation{
+		Tag:     "products",
+		Summary: "Set the sales channels of a product",
+		Auth:    openapi.Admin,
+		Request: handlers.SetProductChannelsRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/products/:id/merge", openapi.Operation{
+		Tag:     "products",
+		Summary: "Merge a product into this one and redirect it here",
+		Auth:    openapi.Admin,
+		Request: handlers.MergeProductsRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/products/:id/variants/:variant_id/split", openapi.Operation{
+		Tag:      "products",
+		Summary:  "Promote a variant to a standalone product",
+		Auth:     openapi.Admin,
+		Request:  handlers.SplitVariantRequest{},
+		Response: formatters.ProductResponse{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/products/:id", openapi.Operation{
+		Tag:     "products",
+		Summary: "Get a product with the internal staff notes on it",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/products/:id/notes", openapi.Operation{
+		Tag:     "products",
+		Summary: "Add an internal note to a product, authored by the signed in admin",
+		Auth:    openapi.Admin,
+		Request: handlers.NoteRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/products/:id/notes/:note_id", openapi.Operation{
+		Tag:     "products",
+		Summary: "Edit an internal note on a product; only its author may",
+		Auth:    openapi.Admin,
+		Request: handlers.NoteRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/products/:id/relationships", openapi.Operation{
+		Tag:     "products",
+		Summary: "List the cross-sells, up-sells, accessories and replacements linked to a product",
+		Auth:    openapi.Admin,
+		Query:   []openapi.Param{{Name: "type", Description: "Only list links of this type: cross_sell, up_sell, accessory or replacement"}},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/products/:id/relationships", openapi.Operation{
+		Tag:     "products",
+		Summary: "Link a product to another one",
+		Auth:    openapi.Admin,
+		Request: handlers.CreateRelationshipRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/products/:id/relationships/:relationship_id", openapi.Operation{
+		Tag:     "products",
+		Summary: "Move a product link within its type",
+		Auth:    openapi.Admin,
+		Request: handlers.UpdateRelationshipRequest{},
+	})
+	// Search ranking
+	b.Document(http.MethodGet, "/api/v1/admin/search-ranking/preview", openapi.Operation{
+		Tag:     "products",
+		Summary: "Preview the ranking of a search with the published and draft rules",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "q", Description: "Search query"},
+			{Name: "category_id", Description: "Only search this category and its descendants"},
+			{Name: "limit", Description: "Number of results, 50 by default"},
+		},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/search-ranking/rules", openapi.Operation{
+		Tag:     "products",
+		Summary: "List the merchandising rules of search",
+		Auth:    openapi.Admin,
+		Query:   []openapi.Param{{Name: "status", Description: "Only list rules of this status: draft or published"}},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/search-ranking/rules", openapi.Operation{
+		Tag:     "products",
+		Summary: "Create a draft rule boosting a brand or category, or pinning a product for a query",
+		Auth:    openapi.Admin,
+		Request: handlers.SearchRankingRuleRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/search-ranking/rules/:id", openapi.Operation{
+		Tag:     "products",
+		Summary: "Replace a search ranking rule; a published rule goes back to draft",
+		Auth:    openapi.Admin,
+		Request: handlers.SearchRankingRuleRequest{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/search-ranking/rules/:id", openapi.Operation{
+		Tag:     "products",
+		Summary: "Delete a search ranking rule",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/search-ranking/rules/publish", openapi.Operation{
+		Tag:     "products",
+		Summary: "Publish draft search ranking rules, all of them when no IDs are given",
+		Auth:    openapi.Admin,
+		Request: handlers.PublishSearchRankingRulesRequest{},
+	})
+	// Product questions and answers
+	b.Document(http.MethodGet, "/api/v1/products/:id/questions", openapi.Operation{
+		Tag:      "questions",
+		Summary:  "List the approved questions on a product with their approved answers",
+		Query:    append([]openapi.Param{{Name: "sort", Description: "newest (default) or top, by answer upvotes"}}, pagination...),
+		Response: formatters.QuestionListResponse{},
+	})
+	b.Document(http.MethodPost, "/api/v1/products/:id/questions", openapi.Operation{
+		Tag:      "questions",
+		Summary:  "Ask a question on a product; it is shown once approved",
+		Auth:     openapi.User,
+		Request:  handlers.QuestionRequest{},
+		Response: formatters.QuestionInfo{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodPost, "/api/v1/questions/:id/answers", openapi.Operation{
+		Tag:      "questions",
+		Summary:  "Answer a question as an admin or seller; seller answers are shown once approved",
+		Auth:     openapi.User,
+		Request:  handlers.QuestionRequest{},
+		Response: formatters.AnswerInfo{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodPost, "/api/v1/answers/:id/upvote", openapi.Operation{
+		Tag:      "questions",
+		Summary:  "Upvote an answer; each user's upvote counts once",
+		Auth:     openapi.User,
+		Response: formatters.AnswerInfo{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/questions", openapi.Operation{
+		Tag:     "questions",
+		Summary: "List questions for moderation with all their answers",
+		Auth:    openapi.Admin,
+		Query: append([]openapi.Param{
+			{Name: "status", Description: "pending (default), approved or rejected"},
+			{Name: "product_id", Description: "Only list the questions on this product"},
+		}, pagination...),
+		Response: formatters.QuestionListResponse{},
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/questions/:id/status", openapi.Operation{
+		Tag:      "questions",
+		Summary:  "Approve or reject a question",
+		Auth:     openapi.Admin,
+		Request:  handlers.ModerationRequest{},
+		Response: formatters.QuestionInfo{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/questions/:id", openapi.Operation{
+		Tag:     "questions",
+		Summary: "Delete a question with its answers",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/answers/:id/status", openapi.Operation{
+		Tag:      "questions",
+		Summary:  "Approve or reject an answer",
+		Auth:     openapi.Admin,
+		Request:  handlers.ModerationRequest{},
+		Response: formatters.AnswerInfo{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/answers/:id", openapi.Operation{
+		Tag:     "questions",
+		Summary: "Delete an answer",
+		Auth:    openapi.Admin,
+	})
+	// Saved searches
+	b.Document(http.MethodGet, "/api/v1/saved-searches", openapi.Operation{
+		Tag:      "saved-searches",
+		Summary:  "List the saved searches of the current user",
+		Auth:     openapi.User,
+		Response: formatters.SavedSearchListResponse{},
+	})
+	b.Document(http.MethodPost, "/api/v1/saved-searches", openapi.Operation{
+		Tag:      "saved-searches",
+		Summary:  "Save a search, optionally alerting on new matching products and on prices dropping below a threshold",
+		Auth:     openapi.User,
+		Request:  handlers.SavedSearchRequest{},
+		Response: formatters.SavedSearchInfo{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/saved-searches/:id", openapi.Operation{
+		Tag:      "saved-searches",
+		Summary:  "Replace a saved search; its alerts start over from the new results",
+		Auth:     openapi.User,
+		Request:  handlers.SavedSearchRequest{},
+		Response: formatters.SavedSearchInfo{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/saved-searches/:id", openapi.Operation{
+		Tag:     "saved-searches",
+		Summary: "Delete a saved search",
+		Auth:    openapi.User,
+	})
+	b.Document(http.MethodGet, "/api/v1/products/search", openapi.Operation{
+		Tag:     "products",
+		Summary: "Search published products, ranked by relevance and the published merchandising rules",
+		Query: []openapi.Param{
+			{Name: "q", Description: "Search query"},
+			{Name: "category_id", Description: "Only search this category and its descendants"},
+			{Name: "page", Description: "Page number"},
+			{Name: "limit", Description: "Results per page"},
+		},
+	})
+	b.Document(http.MethodPut, "/api/v1/products/:id/subscription-plan", openapi.Operation{
+		Tag:     "products",
+		Summary: "Set the subscription plan of a product",
+		Auth:    openapi.Admin,
+		Request: handlers.SubscriptionPlanRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/products/:id/media-uploads", openapi.Operation{
+		Tag:     "products",
+		Summary: "Get a signed ticket for uploading an image, video or GLB/USDZ 3D model of a product straight to the media provider: post the file with the returned fields to the upload URL before the ticket expires, then confirm the upload",
+		Auth:    openapi.Admin,
+		Request: handlers.CreateMediaUploadRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPost, "/api/v1/products/:id/media-uploads/:upload_id/confirm", openapi.Operation{
+		Tag:     "products",
+		Summary: "Check the file of a media upload and attach it to the product, as an image or as a video or 3D model processing for its thumbnail; files over the size limit of their type or of other formats are refused and deleted",
+		Auth:    openapi.Admin,
+		Request: handlers.ConfirmMediaUploadRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/products/:id/videos", openapi.Operation{
+		Tag:     "products",
+		Summary: "Add a YouTube or Vimeo video, or a video file served over HTTPS, to a product; its thumbnail is extracted in the background",
+		Auth:    openapi.Admin,
+		Request: handlers.AddProductVideoRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodDelete, "/api/v1/products/:id/media/:media_id", openapi.Operation{
+		Tag:     "products",
+		Summary: "Remove a video or 3D model from a product",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/products/:id/personalization-options", openapi.Operation{
+		Tag:     "products",
+		Summary: "Replace the personalization options of a product, such as an engraving text or uploaded artwork; options keeping their ID keep the values buyers gave for them",
+		Auth:    openapi.Admin,
+		Request: handlers.PersonalizationOptionsRequest{},
+	})
+
+	// Public catalog
+	b.Document(http.MethodGet, "/api/v1/public/products", openapi.Operation{
+		Tag:      "public",
+		Summary:  "List the published products of the marketplace channel; rate limited per IP, at most 50 per page",
+		Query:    pagination,
+		Response: formatters.PublicProductListResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/public/products/:id", openapi.Operation{
+		Tag:      "public",
+		Summary:  "Get a published product by ID or slug; rate limited per IP",
+		Response: formatters.PublicProduct{},
+	})
+	b.Document(http.MethodGet, "/api/v1/public/categories", openapi.Operation{
+		Tag:      "public",
+		Summary:  "List categories; rate limited per IP, at most 50 per page",
+		Query:    pagination,
+		Response: formatters.PublicCategoryListResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/public/categories/:id", openapi.Operation{
+		Tag:      "public",
+		Summary:  "Get a category by ID or slug; rate limited per IP",
+		Response: formatters.PublicCategory{},
+	})
+
+	// Brands
+	b.Document(http.MethodGet, "/api/v1/brands", openapi.Operation{
+		Tag:      "brands",
+		Summary:  "List brands",
+		Query:    pagination,
+		Response: formatters.BrandListResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/brands/:id", openapi.Operation{
+		Tag:      "brands",
+		Summary:  "Get a brand",
+		Response: formatters.BrandResponse{},
+	})
+	b.Document(http.MethodPost, "/api/v1/brands", openapi.Operation{
+		Tag:      "brands",
+		Summary:  "Create a brand",
+		Auth:     openapi.Admin,
+		Response: formatters.BrandResponse{},
+		Status:   http.StatusCreated,
+	})
+
+	// Categories
+	b.Document(http.MethodGet, "/api/v1/categories", openapi.Operation{
+		Tag:      "categories",
+		Summary:  "List categories",
+		Query:    slices.Concat(pagination, []openapi.Param{sparseFields}),
+		Response: formatters.CategoryListResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/categories/:id", openapi.Operation{
+		Tag:      "categories",
+		Summary:  "Get a category",
+		Query:    []openapi.Param{sparseFields},
+		Response: formatters.CategoryResponse{},
+	})
+	b.Document(http.MethodPost, "/api/v1/categories", openapi.Operation{
+		Tag:      "categories",
+		Summary:  "Create a category",
+		Auth:     openapi.Admin,
+		Request:  handlers.CategoryRequest{},
+		Response: formatters.CategoryResponse{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/categories/order", openapi.Operation{
+		Tag:     "categories",
+		Summary: "Order the children of a category",
+		Auth:    openapi.Admin,
+		Request: handlers.ReorderCategoriesRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/categories/:id/move", openapi.Operation{
+		Tag:      "categories",
+		Summary:  "Move a category and its subtree under another parent",
+		Auth:     openapi.Admin,
+		Request:  handlers.MoveCategoryRequest{},
+		Response: formatters.CategoryResponse{},
+	})
+	b.Document(http.MethodPost, "/api/v1/categories/:id/merge", openapi.Operation{
+		Tag:     "categories",
+		Summary: "Merge a category into this one, moving its products and children",
+		Auth:    openapi.Admin,
+		Request: handlers.MergeCategoriesRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/categories/:id/facets", openapi.Operation{
+		Tag:     "categories",
+		Summary: "List the filterable attributes of a category with the number of products per value",
+	})
+	b.Document(http.MethodGet, "/api/v1/categories/:id/attributes", openapi.Operation{
+		Tag:     "categories",
+		Summary: "List the attributes of a category",
+		Query:   []openapi.Param{{Name: "inherited", Type: "boolean", Description: "Also list the attributes of the ancestors, root first"}},
+	})
+	b.Document(http.MethodPost, "/api/v1/categories/:id/attributes", openapi.Operation{
+		Tag:     "categories",
+		Summary: "Define an attribute for the products of a category and its descendants",
+		Auth:    openapi.Admin,
+		Request: handlers.CategoryAttributeRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/categories/:id/attributes/:attribute_id", openapi.Operation{
+		Tag:     "categories",
+		Summary: "Update an attribute of a category",
+		Auth:    openapi.Admin,
+		Request: handlers.CategoryAttributeRequest{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/categories/:id/attributes/:attribute_id", openapi.Operation{
+		Tag:     "categories",
+		Summary: "Delete an attribute of a category",
+		Auth:    openapi.Admin,
+	})
+
+	// Collections
+	b.Document(http.MethodGet, "/api/v1/collections", openapi.Operation{
+		Tag:      "collections",
+		Summary:  "List published collections",
+		Query:    pagination,
+		Response: formatters.CollectionListResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/collections/:slug", openapi.Operation{
+		Tag:      "collections",
+		Summary:  "Get a collection with its products",
+		Query:    pagination,
+		Response: formatters.CollectionProductsResponse{},
+	})
+	b.Document(http.MethodPost, "/api/v1/collections", openapi.Operation{
+		Tag:      "collections",
+		Summary:  "Create a collection",
+		Auth:     openapi.Admin,
+		Request:  handlers.CollectionRequest{},
+		Response: formatters.CollectionResponse{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/collections/:id", openapi.Operation{
+		Tag:      "collections",
+		Summary:  "Update a collection",
+		Auth:     openapi.Admin,
+		Request:  handlers.CollectionRequest{},
+		Response: formatters.CollectionResponse{},
+	})
+
+	// Storefront content
+	b.Document(http.MethodGet, "/api/v1/content", openapi.Operation{
+		Tag:      "content",
+		Summary:  "List the live storefront pages",
+		Query:    pagination,
+		Response: formatters.ContentPageListResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/content/:slug", openapi.Operation{
+		Tag:      "content",
+		Summary:  "Get a live storefront page, such as about, faq or a store policy, in the locale of the request",
+		Response: formatters.ContentPageResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/content/slots/:slot", openapi.Operation{
+		Tag:      "content",
+		Summary:  "Get the live banners of a storefront slot, such as homepage_hero, by position",
+		Response: formatters.ContentSlotResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/storefront/config", openapi.Operation{
+		Tag:      "content",
+		Summary:  "Get the configuration of the store, loaded by the storefront at boot: name, active currencies and locales, branding and support contacts",
+		Response: formatters.StorefrontConfigResponse{},
+	})
+
+	// Users
+	b.Document(http.MethodPost, "/api/v1/users/register", openapi.Operation{
+		Tag:     "users",
+		Summary: "Register a customer account",
+		Request: handlers.CreateUserRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPost, "/api/v1/users/invitations/accept", openapi.Operation{
+		Tag:     "users",
+		Summary: "Accept an invitation by setting the password of the invited account",
+		Request: handlers.AcceptInvitationRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/users/login", openapi.Operation{
+		Tag:     "users",
+		Summary: "Sign in; the refresh token is set as an HttpOnly cookie. After repeated failures the response is a 403 captcha challenge, to answer with the X-Captcha-Response header, then a 429. The cart, wishlist and recently viewed products of the guest session are merged into the account.",
+		Request: handlers.LoginRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/users/refresh", openapi.Operation{
+		Tag:     "users",
+		Summary: "Exchange the refresh token cookie for a new access token",
+	})
+	b.Document(http.MethodGet, "/api/v1/users/profile", openapi.Operation{
+		Tag:     "users",
+		Summary: "Get the signed in user",
+		Auth:    openapi.User,
+	})
+	b.Document(http.MethodPut, "/api/v1/users/profile", openapi.Operation{
+		Tag:     "users",
+		Summary: "Update the signed in user",
+		Auth:    openapi.User,
+		Request: handlers.UpdateUserRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/users/addresses", openapi.Operation{
+		Tag:     "users",
+		Summary: "Add an address to the signed in user",
+		Auth:    openapi.User,
+		Request: handlers.AddressRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/users/payment-methods", openapi.Operation{
+		Tag:     "users",
+		Summary: "Add a payment method to the signed in user",
+		Auth:    openapi.User,
+		Request: handlers.PaymentMethodRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/users/company", openapi.Operation{
+		Tag:     "users",
+		Summary: "Get the B2B company of the signed in user, with its members, shared addresses and the user's role",
+		Auth:    openapi.User,
+	})
+	b.Document(http.MethodGet, "/api/v1/users/company/purchase-approvals", openapi.Operation{
+		Tag:     "users",
+		Summary: "List the purchase approvals of the company: all of them for approvers, their own for purchasers",
+		Auth:    openapi.User,
+		Query:   slices.Concat(pagination, []openapi.Param{{Name: "status", Description: "pending, approved or rejected"}}),
+	})
+	b.Document(http.MethodPost, "/api/v1/users/company/purchase-approvals", openapi.Operation{
+		Tag:     "users",
+		Summary: "Ask the approval of a purchase at checkout; purchases up to the company threshold are approved at once",
+		Auth:    openapi.User,
+		Request: handlers.PurchaseApprovalRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPost, "/api/v1/users/company/purchase-approvals/:id/decision", openapi.Operation{
+		Tag:     "users",
+		Summary: "Approve or reject a pending purchase of another member, as an approver of the company",
+		Auth:    openapi.User,
+		Request: handlers.PurchaseDecisionRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/users/company/credit", openapi.Operation{
+		Tag:     "users",
+		Summary: "Get the credit account of the company: limit, balance, available and overdue credit",
+		Auth:    openapi.User,
+	})
+	b.Document(http.MethodPost, "/api/v1/users/company/credit/charges", openapi.Operation{
+		Tag:     "users",
+		Summary: "Place an approved purchase on the credit of the company, for its order reference and amount, due at the end of its payment terms",
+		Auth:    openapi.User,
+		Request: handlers.CreditChargeRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/users/company/credit/statement", openapi.Operation{
+		Tag:     "users",
+		Summary: "Get the statement of the credit account of the company over a period",
+		Auth:    openapi.User,
+		Query: []openapi.Param{
+			{Name: "from", Description: "RFC3339 start of the period; the current month when unset"},
+			{Name: "to", Description: "RFC3339 end of the period; now when unset"},
+		},
+	})
+	b.Document(http.MethodPost, "/api/v1/users/quotes", openapi.Operation{
+		Tag:     "users",
+		Summary: "Request a quote for the cart of the signed in user",
+		Auth:    openapi.User,
+		Request: handlers.QuoteRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/users/quotes", openapi.Operation{
+		Tag:     "users",
+		Summary: "List the quotes of the signed in user, most recent first",
+		Auth:    openapi.User,
+		Query:   slices.Concat(pagination, []openapi.Param{{Name: "status", Description: "requested, quoted, accepted, declined, expired or converted"}}),
+	})
+	b.Document(http.MethodGet, "/api/v1/users/quotes/:id", openapi.Operation{
+		Tag:     "users",
+		Summary: "Get a quote of the signed in user with its negotiated prices",
+		Auth:    openapi.User,
+	})
+	b.Document(http.MethodPost, "/api/v1/users/quotes/:id/accept", openapi.Operation{
+		Tag:     "users",
+		Summary: "Accept the offer of a quote before it expires",
+		Auth:    openapi.User,
+	})
+	b.Document(http.MethodPost, "/api/v1/users/quotes/:id/decline", openapi.Operation{
+		Tag:     "users",
+		Summary: "Decline a requested or quoted quote",
+		Auth:    openapi.User,
+	})
+	b.Document(http.MethodPost, "/api/v1/users/quotes/:id/convert", openapi.Operation{
+		Tag:     "users",
+		Summary: "Record the order placed from an accepted quote at its quoted prices",
+		Auth:    openapi.User,
+		Request: handlers.ConvertQuoteRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/users/:id", openapi.Operation{
+		Tag:     "users",
+		Summary: "Get a user with the internal staff notes on them and their lifetime order metrics",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/users/:id/notes", openapi.Operation{
+		Tag:     "users",
+		Summary: "Add an internal note to a user, authored by the signed in admin",
+		Auth:    openapi.Admin,
+		Request: handlers.NoteRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/users/:id/notes/:note_id", openapi.Operation{
+		Tag:     "users",
+		Summary: "Edit an internal note on a user; only its author may",
+		Auth:    openapi.Admin,
+		Request: handlers.NoteRequest{},
+	})
+
+	// Shopper lists of users, or of guests identified by their guest session
+	b.Document(http.MethodGet, "/api/v1/cart", openapi.Operation{
+		Tag:     "shopper",
+		Summary: "Get the cart of the signed in user or of the guest session",
+	})
+	b.Document(http.MethodPut, "/api/v1/cart/items", openapi.Operation{
+		Tag:     "shopper",
+		Summary: "Add a product to the cart or set its quantity and personalization, validated against the personalization options of the product",
+		Request: handlers.CartItemRequest{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/cart/items/:product_id", openapi.Operation{
+		Tag:     "shopper",
+		Summary: "Remove a product from the cart",
+		Query:   []openapi.Param{{Name: "variant_id", Description: "Variant of the product", Type: "string"}},
+	})
+	b.Document(http.MethodGet, "/api/v1/wishlist", openapi.Operation{
+		Tag:     "shopper",
+		Summary: "Get the wishlist of the signed in user or of the guest session",
+	})
+	b.Document(http.MethodPut, "/api/v1/wishlist/items", openapi.Operation{
+		Tag:     "shopper",
+		Summary: "Add a product to the wishlist",
+		Request: handlers.ListItemRequest{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/wishlist/items/:product_id", openapi.Operation{
+		Tag:     "shopper",
+		Summary: "Remove a product from the wishlist",
+		Query:   []openapi.Param{{Name: "variant_id", Description: "Variant of the product", Type: "string"}},
+	})
+	b.Document(http.MethodGet, "/api/v1/recently-viewed", openapi.Operation{
+		Tag:     "shopper",
+		Summary: "Get the products the signed in user or the guest viewed last, most recent first",
+	})
+	b.Document(http.MethodPost, "/api/v1/recently-viewed", openapi.Operation{
+		Tag:     "shopper",
+		Summary: "Record a view of a product",
+		Request: handlers.ListItemRequest{},
+	})
+
+	// Subscriptions
+	b.Document(http.MethodGet, "/api/v1/subscriptions", openapi.Operation{
+		Tag:     "subscriptions",
+		Summary: "List the subscriptions of the signed in user",
+		Auth:    openapi.User,
+	})
+	b.Document(http.MethodPost, "/api/v1/subscriptions/:id/cancel", openapi.Operation{
+		Tag:     "subscriptions",
+		Summary: "Cancel a subscription",
+		Auth:    openapi.User,
+		Request: handlers.CancelSubscriptionRequest{},
+	})
+
+	// Inventory
+	b.Document(http.MethodGet, "/api/v1/inventory/check", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Check whether a quantity of a product is available",
+		Query: []openapi.Param{
+			{Name: "product_id", Required: true},
+			{Name: "quantity", Type: "integer", Required: true},
+			{Name: "unit", Description: "Unit of measure of the quantity, such as a case; each by default"},
+		},
+	})
+	b.Document(http.MethodPost, "/api/v1/inventory/check-bulk", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Check the availability of the lines of a cart or checkout",
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/items", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "List inventory items",
+		Auth:    openapi.Admin,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "status"},
+			{Name: "warehouse_id"},
+			{Name: "low_stock_only", Type: "boolean"},
+		}),
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/items/:product_id/forecast", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Get the sales velocity, stock-out date and suggested reorder quantity of a product at each warehouse",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "warehouse_id"},
+		},
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/reorder-suggestions", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "List the items to reorder with the suggested quantities, those running out first first",
+		Auth:    openapi.Admin,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "warehouse_id"},
+		}),
+	})
+	b.Document(http.MethodPut, "/api/v1/inventory/items/:product_id/locations/:warehouse_id/buffers", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Set the safety and maximum stock of a location",
+		Auth:    openapi.Admin,
+		Request: handlers.StockBuffersRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/items/:product_id/lots", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "List the lots of a product, first expired first out",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "warehouse_id"},
+			{Name: "include_empty", Type: "boolean", Description: "Also list the lots with no units left"},
+		},
+	})
+	b.Document(http.MethodPost, "/api/v1/inventory/items/:product_id/lots", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Receive stock of a product into a lot with an expiry date",
+		Auth:    openapi.Admin,
+		Request: handlers.ReceiveLotRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/lots/expiring", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Report the lots expiring soon and the expired lots not written off yet",
+		Auth:    openapi.Admin,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "within_days", Type: "integer", Description: "Look-ahead in days, 7 by default"},
+			{Name: "warehouse_id"},
+		}),
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/items/:product_id/units", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "List the units of measure of a product, starting with the base unit (EA)",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/inventory/items/:product_id/units/:code", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Create or replace a unit of measure of a product, such as a case of 12",
+		Auth:    openapi.Admin,
+		Request: handlers.InventoryUnitRequest{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/inventory/items/:product_id/units/:code", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Delete a unit of measure no open purchase order is still to be received in",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/warehouses/:warehouse_id/bins", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "List the bins of a warehouse by zone, aisle, shelf and bin",
+		Auth:    openapi.Admin,
+		Query:   []openapi.Param{{Name: "zone"}},
+	})
+	b.Document(http.MethodPut, "/api/v1/inventory/warehouses/:warehouse_id/bins", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Create the bin of a warehouse at an address, or set whether it is active",
+		Auth:    openapi.Admin,
+		Request: handlers.WarehouseBinRequest{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/inventory/bins/:bin_id", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Delete a bin that holds no stock",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/warehouses/:warehouse_id/bin-stock", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "List the stock placed in the bins of a warehouse",
+		Auth:    openapi.Admin,
+		Query:   []openapi.Param{{Name: "product_id"}},
+	})
+	b.Document(http.MethodPut, "/api/v1/inventory/bins/:bin_id/stock/:product_id", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Set the units of a product placed in a bin, up to its stock at the warehouse",
+		Auth:    openapi.Admin,
+		Request: handlers.BinStockRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/inventory/warehouses/:warehouse_id/pick-lists", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Plan the picks of an order along the pick path through the bins of a warehouse",
+		Auth:    openapi.Admin,
+		Request: handlers.PickListRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/waves", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "List the fulfillment waves, newest first",
+		Auth:    openapi.Admin,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "warehouse_id"},
+			{Name: "status", Description: "open, completed or cancelled"},
+		}),
+	})
+	b.Document(http.MethodPost, "/api/v1/inventory/waves", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Batch the confirmed orders of a warehouse up to a carrier cutoff into a wave",
+		Auth:    openapi.Admin,
+		Request: handlers.CreateWaveRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/waves/:wave_id", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Get a fulfillment wave with its order lines",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/inventory/waves/:wave_id/pick-list", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Plan the consolidated picks of an open wave along the pick path",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/inventory/waves/:wave_id/complete", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Record the quantities picked of a wave and report its orders as picked",
+		Auth:    openapi.Admin,
+		Request: handlers.CompleteWaveRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/inventory/waves/:wave_id/cancel", openapi.Operation{
+		Tag:     "inventory",
+		Summary: "Cancel an open wave, releasing its orders for later waves",
+		Auth:    openapi.Admin,
+	})
+
+	// Integrations
+	b.Document(http.MethodPost, "/api/v1/integrations/fulfillment/events", openapi.Operation{
+		Tag:     "integrations",
+		Summary: "Push stock updates and shipment confirmations of a fulfillment provider",
+		Auth:    openapi.Integration,
+		Request: handlers.FulfillmentEventsRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/integrations/carriers/:carrier/events", openapi.Operation{
+		Tag:     "integrations",
+		Summary: "Push tracking events of a carrier",
+		Auth:    openapi.Integration,
+		Request: handlers.CarrierEventsRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/quota", openapi.Operation{
+		Tag:      "integrations",
+		Summary:  "Get the daily quota and usage of the API key",
+		Auth:     openapi.Integration,
+		Response: handlers.QuotaResponse{},
+	})
+
+	// Shipments
+	b.Document(http.MethodGet, "/api/v1/shipments", openapi.Operation{
+		Tag:     "shipments",
+		Summary: "List the shipments of the current user",
+		Auth:    openapi.User,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "order_reference"},
+			{Name: "status"},
+		}),
+	})
+	b.Document(http.MethodGet, "/api/v1/shipments/:id", openapi.Operation{
+		Tag:     "shipments",
+		Summary: "Get a shipment of the current user with its tracking events",
+		Auth:    openapi.User,
+	})
+	b.Document(http.MethodGet, "/api/v1/orders/:reference/shipments", openapi.Operation{
+		Tag:     "shipments",
+		Summary: "Get the shipments and delivery status of an order of the current user",
+		Auth:    openapi.User,
+	})
+
+	// Store locator
+	b.Document(http.MethodGet, "/api/v1/retail-stores/nearby", openapi.Operation{
+		Tag:     "stores",
+		Summary: "Find the stores near a position, nearest first, with the stock of a product at each",
+		Query: []openapi.Param{
+			{Name: "lat", Type: "number", Required: true},
+			{Name: "lng", Type: "number", Required: true},
+			{Name: "radius_km", Type: "number", Description: "Search radius, 50 km by default and at most 500"},
+			{Name: "product_id", Description: "Report the stock of this product at each store"},
+			{Name: "quantity", Type: "integer", Description: "Quantity the product is in stock for, 1 by default"},
+			{Name: "limit", Type: "integer", Description: "At most 50 stores, 10 by default"},
+		},
+	})
+	b.Document(http.MethodPost, "/api/v1/retail-stores/:warehouse_id/reservations", openapi.Operation{
+		Tag:     "stores",
+		Summary: "Reserve a product at a store to pick it up, held for the hold hours of the store",
+		Auth:    openapi.User,
+		Request: handlers.ReserveInStoreRequest{},
+		Status:  http.StatusCreated,
+	})
+
+	// Click-and-collect orders
+	b.Document(http.MethodPost, "/api/v1/pickup-orders", openapi.Operation{
+		Tag:     "stores",
+		Summary: "Choose pickup at a store at checkout, reserving the items of the order there",
+		Auth:    openapi.User,
+		Request: handlers.PickupOrderRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/pickup-orders", openapi.Operation{
+		Tag:     "stores",
+		Summary: "List the pickup orders of the current user, oldest first",
+		Auth:    openapi.User,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "status", Description: "pending, picking, ready, collected or cancelled"},
+			{Name: "order_reference"},
+		}),
+	})
+	b.Document(http.MethodGet, "/api/v1/pickup-orders/:id", openapi.Operation{
+		Tag:     "stores",
+		Summary: "Get a pickup order of the current user",
+		Auth:    openapi.User,
+	})
+	b.Document(http.MethodPost, "/api/v1/pickup-orders/:id/cancel", openapi.Operation{
+		Tag:     "stores",
+		Summary: "Cancel a pickup order of the current user that was not collected",
+		Auth:    openapi.User,
+		Request: handlers.CancelPickupOrderRequest{},
+	})
+
+	// Back-in-stock subscriptions
+	b.Document(http.MethodPost, "/api/v1/products/:id/back-in-stock", openapi.Operation{
+		Tag:     "back-in-stock",
+		Summary: "Get emailed once when an out-of-stock product is back in stock",
+		Request: handlers.BackInStockRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/back-in-stock", openapi.Operation{
+		Tag:     "back-in-stock",
+		Summary: "List the back-in-stock subscriptions of the current user",
+		Auth:    openapi.User,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "product_id"},
+			{Name: "pending", Type: "boolean", Description: "true to list only the subscriptions not notified yet"},
+		}),
+	})
+	b.Document(http.MethodDelete, "/api/v1/back-in-stock/:id", openapi.Operation{
+		Tag:     "back-in-stock",
+		Summary: "Remove a back-in-stock subscription of the current user",
+		Auth:    openapi.User,
+	})
+
+	// Admin
+	b.Document(http.MethodGet, "/api/v1/admin/collections", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "List all collections, including unpublished ones",
+		Auth:     openapi.Admin,
+		Query:    pagination,
+		Response: formatters.CollectionListResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/content/pages", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "List all storefront pages, including drafts and scheduled ones",
+		Auth:     openapi.Admin,
+		Query:    pagination,
+		Response: formatters.ContentPageListResponse{},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/content/pages", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "Create a storefront page, a draft unless published",
+		Auth:     openapi.Admin,
+		Request:  handlers.ContentPageRequest{},
+		Response: formatters.ContentPageResponse{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/content/pages/:id", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "Get a storefront page whatever its state",
+		Auth:     openapi.Admin,
+		Response: formatters.ContentPageResponse{},
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/content/pages/:id", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "Replace the content and schedule of a storefront page",
+		Auth:     openapi.Admin,
+		Request:  handlers.ContentPageRequest{},
+		Response: formatters.ContentPageResponse{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/content/pages/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Delete a storefront page",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/content/banners", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the banners of every storefront slot, including drafts and scheduled ones",
+		Auth:    openapi.Admin,
+		Query:   []openapi.Param{{Name: "slot", Description: "Only list the banners of this slot"}},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/content/banners", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "Add a banner to a storefront slot, a draft unless published",
+		Auth:     openapi.Admin,
+		Request:  handlers.ContentBannerRequest{},
+		Response: formatters.ContentBannerResponse{},
+		Status:   http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/content/banners/:id", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "Replace the content, slot and schedule of a banner",
+		Auth:     openapi.Admin,
+		Request:  handlers.ContentBannerRequest{},
+		Response: formatters.ContentBannerResponse{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/content/banners/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Delete a banner",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/settings", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List every store setting with its description, set or not",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/settings/:key", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "Set a store setting; the value is validated against the key and announced to every product service replica",
+		Auth:     openapi.Admin,
+		Request:  handlers.SettingRequest{},
+		Response: formatters.SettingResponse{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/settings/:key", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Reset a store setting to its default",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/downloads", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Create a download link for a digital purchase",
+		Auth:    openapi.Admin,
+		Request: handlers.DownloadLinkRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/subscriptions", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Start a subscription for a confirmed purchase",
+		Auth:    openapi.Admin,
+		Request: handlers.CreateSubscriptionRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/stores", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Create a store",
+		Auth:    openapi.Admin,
+		Request: handlers.CreateStoreRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/stores/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Update a store",
+		Auth:    openapi.Admin,
+		Request: handlers.UpdateStoreRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/prices/bulk-adjust", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Preview or apply a bulk price adjustment",
+		Auth:    openapi.Admin,
+		Request: handlers.BulkAdjustPricesRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/inventory-reconciliations", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Reconcile product SKUs with inventory items",
+		Auth:    openapi.Admin,
+		Request: handlers.RunInventoryReconciliationRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/integration-keys", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Create the API key of a fulfillment provider",
+		Auth:    openapi.Admin,
+		Request: handlers.CreateIntegrationKeyRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/integration-keys/:id/quota", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Set the daily quota of an API key",
+		Auth:    openapi.Admin,
+		Request: handlers.IntegrationKeyQuotaRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/abandoned-carts/stats", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Count the abandoned cart reminders sent and the orders that followed them",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "from", Description: "RFC3339 start of the period, 30 days before to by default"},
+			{Name: "to", Description: "RFC3339 end of the period, now by default"},
+		},
+		Response: handlers.CartReminderStatsResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/staff", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the staff accounts of the store with their permission sets",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/staff", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Create a staff account holding permission sets (catalog, inventory, orders, users)",
+		Auth:    openapi.Admin,
+		Request: handlers.CreateStaffRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/staff/permission-sets", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the permission sets assignable to staff with the scopes each grants",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/staff/:id/permissions", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Replace the permission sets of a staff account; admins cannot change their own",
+		Auth:    openapi.Admin,
+		Request: handlers.StaffPermissionsRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/user-imports", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Import a CSV file of users (email, name, role), uploaded as the file form field, inviting each by email",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/user-imports/invitations/:id/resend", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Resend the invitation of an invited user with a new link",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/companies", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the B2B companies of the store by name",
+		Auth:    openapi.Admin,
+		Query:   pagination,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/companies", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Create a B2B company with payment terms and an optional purchase approval threshold",
+		Auth:    openapi.Admin,
+		Request: handlers.CompanyRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/companies/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Get a company with its members and shared addresses",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/companies/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Replace the name, payment terms and approval threshold of a company",
+		Auth:    openapi.Admin,
+		Request: handlers.CompanyRequest{},
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/companies/:id/members/:user_id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Add a customer to a company as purchaser or approver, or change their role",
+		Auth:    openapi.Admin,
+		Request: handlers.CompanyMemberRequest{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/companies/:id/members/:user_id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Remove a member from a company",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/companies/:id/addresses", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Add an address shared by the members of a company",
+		Auth:    openapi.Admin,
+		Request: handlers.CompanyAddressRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/companies/:id/addresses/:address_id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Remove a shared address of a company",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/companies/:id/purchase-approvals", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the purchase approvals of a company, most recent first",
+		Auth:    openapi.Admin,
+		Query:   slices.Concat(pagination, []openapi.Param{{Name: "status", Description: "pending, approved or rejected"}}),
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/companies/:id/credit", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Get the credit account of a company: limit, balance, available and overdue credit",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/companies/:id/credit", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Open the credit account of a company on net terms, or change its limit",
+		Auth:    openapi.Admin,
+		Request: handlers.CreditLimitRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/companies/:id/credit/payments", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Record a payment received on a credit account, settling its oldest charges first",
+		Auth:    openapi.Admin,
+		Request: handlers.CreditPaymentRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/companies/:id/credit/statement", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Get the statement of the credit account of a company over a period",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "from", Description: "RFC3339 start of the period; the current month when unset"},
+			{Name: "to", Description: "RFC3339 end of the period; now when unset"},
+		},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/quotes", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the quotes requested by buyers, most recent first",
+		Auth:    openapi.Admin,
+		Query:   slices.Concat(pagination, []openapi.Param{{Name: "status", Description: "requested, quoted, accepted, declined, expired or converted"}}),
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/quotes/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Get a quote with the cart it was requested for",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/quotes/:id/response", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Answer a quote with the negotiated unit price of each item and the expiry of the offer (RFC3339)",
+		Auth:    openapi.Admin,
+		Request: handlers.QuoteResponseRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/customer-metrics", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the lifetime metrics of customers, highest spend first, to build segments",
+		Auth:    openapi.Admin,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "min_orders", Type: "integer"},
+			{Name: "min_spend", Type: "number"},
+			{Name: "last_order_after", Description: "RFC3339"},
+			{Name: "last_order_before", Description: "RFC3339"},
+		}),
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/shipments", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Register a shipment of an order for tracking",
+		Auth:    openapi.Admin,
+		Request: handlers.CreateShipmentRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/retail-stores", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the retail stores, the warehouses customers can visit",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/retail-stores/:warehouse_id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Make a warehouse a retail store, or set its position, opening hours and pickup settings",
+		Auth:    openapi.Admin,
+		Request: handlers.RetailStoreRequest{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/retail-stores/:warehouse_id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Stop a warehouse being a retail store",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/back-in-stock", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List back-in-stock subscriptions",
+		Auth:    openapi.Admin,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "user_id"},
+			{Name: "product_id"},
+			{Name: "pending", Type: "boolean", Description: "true to list only the subscriptions not notified yet"},
+		}),
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/back-in-stock/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Remove a back-in-stock subscription",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/suppliers", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Create a supplier",
+		Auth:    openapi.Admin,
+		Request: handlers.SupplierRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/suppliers/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Update a supplier",
+		Auth:    openapi.Admin,
+		Request: handlers.SupplierRequest{},
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/suppliers/:id/products/:item_id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Set the cost price and lead time of an inventory item from a supplier",
+		Auth:    openapi.Admin,
+		Request: handlers.SupplierProductRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/purchase-orders", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Create a purchase order of stock from a supplier",
+		Auth:    openapi.Admin,
+		Request: handlers.CreatePurchaseOrderRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/purchase-orders/:id/receive", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Receive the stock of a purchase order into its warehouse",
+		Auth:    openapi.Admin,
+		Request: handlers.ReceivePurchaseOrderRequest{},
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/availability-policies/:product_id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Set the stock thresholds deciding the availability badge of a product",
+		Auth:    openapi.Admin,
+		Request: handlers.AvailabilityPolicyRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/order-status-events", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the order status changes reported by fulfillment providers",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "after_id", Type: "integer", Description: "ID of the last event read"},
+			{Name: "limit", Type: "integer"},
+		},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/pickup-orders", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the click-and-collect orders, oldest first",
+		Auth:    openapi.Admin,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "warehouse_id", Description: "Warehouse of the store the orders are collected at"},
+			{Name: "user_id"},
+			{Name: "status", Description: "pending, picking, ready, collected or cancelled"},
+			{Name: "order_reference"},
+		}),
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/pickup-orders/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Get a pickup order with its lines",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/pickup-orders/:id/picking", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Record that the store is picking a pending pickup order",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/pickup-orders/:id/ready", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Mark a pickup order ready to collect and email the customer; it is cancelled if not collected in time",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/pickup-orders/:id/collect", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Record that the customer collected a ready pickup order",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/pickup-orders/:id/cancel", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Cancel a pickup order not yet collected, restocking its items at the store",
+		Auth:    openapi.Admin,
+		Request: handlers.CancelPickupOrderRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/refunds", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the refunds of order payments, newest first",
+		Auth:    openapi.Admin,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "status", Description: "requested, approved, rejected, processed or failed"},
+			{Name: "order_reference"},
+		}),
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/refunds", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Request a full or partial refund of the payment of an order",
+		Auth:    openapi.Admin,
+		Request: handlers.RefundRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/refunds/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Get a refund with its returned lines",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/refunds/:id/approve", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Approve a requested refund, restocking its lines marked for restocking",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/refunds/:id/reject", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Reject a requested refund",
+		Auth:    openapi.Admin,
+		Request: handlers.RejectRefundRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/refunds/:id/result", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Record whether the payment provider processed an approved refund",
+		Auth:    openapi.Admin,
+		Request: handlers.RefundResultRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/refund-events", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the refund status changes for the notification and accounting exports",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "after_id", Type: "integer", Description: "ID of the last event read"},
+			{Name: "limit", Type: "integer"},
+		},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/fraud/checks", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the fraud checks of orders, newest first",
+		Auth:    openapi.Admin,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "decision", Description: "approve, review or decline"},
+			{Name: "order_reference"},
+		}),
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/fraud/checks/:id", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Get the fraud check of an order with the signals that scored it",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/fraud/checks/:id/review", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Approve or decline an order in the fraud review queue",
+		Auth:    openapi.Admin,
+		Request: handlers.FraudReviewRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/fraud/review-queue", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the orders waiting for fraud review, oldest first",
+		Auth:    openapi.Admin,
+		Query: slices.Concat(pagination, []openapi.Param{
+			{Name: "order_reference"},
+		}),
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/fraud/events", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the fraud decisions on orders for the order system and fraud providers",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "after_id", Type: "integer", Description: "ID of the last event read"},
+			{Name: "limit", Type: "integer"},
+		},
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/import-templates/:supplier", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Save the column mapping, defaults and price multiplier of the catalog files of a supplier",
+		Auth:    openapi.Admin,
+		Request: handlers.ImportTemplateRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/imports/:supplier", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Import a CSV catalog file of a supplier, uploaded as the file form field, with the supplier's import template",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/translations/:entity_type/:entity_id/:locale", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Translate the content of a product, page or banner or the name and description of a category to a locale",
+		Auth:    openapi.Admin,
+		Request: handlers.TranslationRequest{},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/catalog-quality/recompute", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Recompute the catalog quality score of every product",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/badges", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the display badges of products: the built-in New, Sale and Bestseller badges and custom ones",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/badges/:code", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Create or replace a badge; the code of a built-in badge overrides it",
+		Auth:    openapi.Admin,
+		Request: handlers.BadgeRuleRequest{},
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/badges/:code", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Delete a custom badge or reset a built-in badge to its defaults",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/badges/recompute", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Recompute the badges of every product",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/cache/namespaces/:namespace/flush", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Flush the cached entries of a namespace for every store",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/cache/schedules", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the cache invalidation and warming schedules with their next and latest runs",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/cache/schedules/runs", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the latest cache schedule runs",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "schedule", Description: "Only list the runs of this schedule"},
+			{Name: "limit", Description: "Runs to return, at most 100", Type: "integer"},
+		},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/cache/schedules/:name/run", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Run a cache schedule now, such as after a bulk import",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/activity", openapi.Operation{
+		Tag:     "activity",
+		Summary: "List recent catalog changes, inventory adjustments and user events, newest first",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "cursor", Description: "next_cursor of the previous page"},
+			{Name: "limit", Type: "integer", Description: "Entries per page, 50 by default and at most 200"},
+			{Name: "source", Description: "catalog, inventory or users; repeat for several, all by default"},
+		},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/products/:id/presence", openapi.Operation{
+		Tag:     "activity",
+		Summary: "List the admins currently editing a product",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/products/:id/presence", openapi.Operation{
+		Tag:     "activity",
+		Summary: "Mark the signed in admin as editing a product; repeat before ttl_seconds to stay listed",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodDelete, "/api/v1/admin/products/:id/presence", openapi.Operation{
+		Tag:     "activity",
+		Summary: "Mark the signed in admin as no longer editing a product",
+		Auth:    openapi.Admin,
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/reports", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Generate a report and optionally email it",
+		Auth:    openapi.Admin,
+		Request: handlers.GenerateReportRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/accounting/exports", openapi.Operation{
+		Tag:     "admin",
+		Summary: "List the accounting journal exports, newest first, with fresh download links",
+		Auth:    openapi.Admin,
+		Query: []openapi.Param{
+			{Name: "format", Description: "csv, xero or quickbooks"},
+		},
+	})
+	b.Document(http.MethodPost, "/api/v1/admin/accounting/exports", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Export the accounting journal of a period as CSV or a Xero or QuickBooks import; a period already exported returns its first export",
+		Auth:    openapi.Admin,
+		Request: handlers.ExportJournalRequest{},
+		Status:  http.StatusCreated,
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/feature-flags/:key", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Create or update a feature flag",
+		Auth:    openapi.Admin,
+		Request: handlers.FeatureFlagRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/operations", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "Get the route groups in maintenance and the features switched off",
+		Auth:     openapi.Admin,
+		Response: handlers.OperationsResponse{},
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/operations/maintenance", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Put the API, or one route group of it such as products or cart, in maintenance or back in service. Refused requests get a 503 with Retry-After; the admin API and signing in keep serving.",
+		Auth:    openapi.Admin,
+		Request: handlers.MaintenanceRequest{},
+	})
+	b.Document(http.MethodPut, "/api/v1/admin/operations/kill-switches/:feature", openapi.Operation{
+		Tag:     "admin",
+		Summary: "Switch an expensive feature, search or graphql, off or back on. Its requests get a 503 with Retry-After while off.",
+		Auth:    openapi.Admin,
+		Request: handlers.KillSwitchRequest{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/slos", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "List the latency histogram and error budget burn rates of every route served by this gateway since it started",
+		Auth:     openapi.Admin,
+		Response: handlers.SLOResponse{},
+	})
+	b.Document(http.MethodGet, "/api/v1/admin/slos/violations", openapi.Operation{
+		Tag:      "admin",
+		Summary:  "List the routes burning their latency budget faster than allowed over both the last 5 minutes and the last hour, the fastest burning first",
+		Auth:     openapi.Admin,
+		Response: handlers.SLOResponse{},
+	})
+}
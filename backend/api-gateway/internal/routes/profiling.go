@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+	"github.com/louai60/e-commerce_project/backend/common/profiling"
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
+)
+
+// SetupProfilingRoutes serves the gateway's pprof endpoints to admins, gated
+// by the "pprof" feature flag (off until the flag is created)
+func SetupProfilingRoutes(r *gin.Engine, flags *featureflags.Client) {
+	pprof := r.Group("/debug/pprof", middleware.AuthRequired(), middleware.AdminRequired(), middleware.FeatureFlag(flags, "pprof", false))
+	{
+		pprof.GET("/*profile", gin.WrapH(profiling.Handler()))
+	}
+}
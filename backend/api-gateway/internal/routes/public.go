@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/handlers"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+)
+
+// SetupPublicRoutes serves the read-only public catalog API to partners
+// without credentials. Clients are rate limited per IP, and responses are
+// cached by the gateway in responseCache and by clients and CDNs for maxAge.
+func SetupPublicRoutes(r *gin.Engine, productHandler *handlers.ProductHandler, inventoryHandler *handlers.InventoryHandler, rateLimit gin.HandlerFunc, responseCache *middleware.ResponseCache, maxAge time.Duration) {
+	inventoryClientMiddleware := func(c *gin.Context) {
+		c.Set("inventory_client", inventoryHandler.GetClient())
+		c.Next()
+	}
+
+	public := r.Group("/api/v1/public", rateLimit, middleware.PublicCacheControl(maxAge), inventoryClientMiddleware)
+	{
+		public.GET("/products", responseCache.Middleware(middleware.CacheGroupProducts), productHandler.PublicListProducts)
+		public.GET("/products/:id", responseCache.Middleware(middleware.CacheGroupProducts), productHandler.PublicGetProduct)
+		public.GET("/categories", responseCache.Middleware(middleware.CacheGroupCategories), productHandler.PublicListCategories)
+		public.GET("/categories/:id", responseCache.Middleware(middleware.CacheGroupCategories), productHandler.PublicGetCategory)
+	}
+}
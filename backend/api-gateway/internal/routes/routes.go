@@ -4,9 +4,10 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/handlers"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+	"github.com/louai60/e-commerce_project/backend/common/scope"
 )
 
-func SetupRoutes(r *gin.Engine, productHandler *handlers.ProductHandler, userHandler *handlers.UserHandler, adminHandler *handlers.AdminHandler, inventoryHandler *handlers.InventoryHandler) {
+func SetupRoutes(r *gin.Engine, productHandler *handlers.ProductHandler, userHandler *handlers.UserHandler, adminHandler *handlers.AdminHandler, inventoryHandler *handlers.InventoryHandler, featureFlagHandler *handlers.FeatureFlagHandler, loginThrottle, guestSession, searchKillSwitch gin.HandlerFunc, responseCache *middleware.ResponseCache) {
 	// API routes
 	v1 := r.Group("/api/v1")
 	{
@@ -19,40 +20,105 @@ func SetupRoutes(r *gin.Engine, productHandler *handlers.ProductHandler, userHan
 		// Product routes
 		products := v1.Group("/products", inventoryClientMiddleware)
 		{
-			products.GET("", productHandler.ListProducts)
-			products.GET("/:id", productHandler.GetProduct)
+			products.GET("", responseCache.Middleware(middleware.CacheGroupProducts), productHandler.ListProducts)
+			products.GET("/search", searchKillSwitch, productHandler.SearchProducts)
+			products.GET("/:id", responseCache.Middleware(middleware.CacheGroupProducts), productHandler.GetProduct)
 			// Add inventory client to the context for product creation
-			products.POST("", middleware.AuthRequired(), middleware.AdminRequired(), func(c *gin.Context) {
+			products.POST("", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), func(c *gin.Context) {
 				// Use the product_inventory_handler to create product with inventory
 				handlers.CreateProductWithInventory(c, productHandler.GetClient(), inventoryHandler.GetClient(), productHandler.GetLogger())
 			})
-			products.PUT("/:id", middleware.AuthRequired(), middleware.AdminRequired(), productHandler.UpdateProduct)
-			products.DELETE("/:id", middleware.AuthRequired(), middleware.AdminRequired(), productHandler.DeleteProduct)
+			products.POST("/bundles", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.CreateBundle)
+			products.POST("/:id/digital-asset", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.UploadDigitalAsset)
+			products.PUT("/:id/subscription-plan", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.SetSubscriptionPlan)
+			// Images uploaded straight to the media provider, then confirmed
+			products.POST("/:id/media-uploads", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.CreateMediaUpload)
+			products.POST("/:id/media-uploads/:upload_id/confirm", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.ConfirmMediaUpload)
+			products.POST("/:id/videos", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.AddProductVideo)
+			products.DELETE("/:id/media/:media_id", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.DeleteProductMedia)
+			products.PUT("/:id/personalization-options", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.SetPersonalizationOptions)
+			products.GET("/:id/channels", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.GetProductChannels)
+			products.PUT("/:id/channels", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.SetProductChannels)
+			products.POST("/:id/merge", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.MergeProducts)
+			products.POST("/:id/variants/:variant_id/split", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.SplitVariant)
+			products.PUT("/:id", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.UpdateProduct)
+			products.DELETE("/:id", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.DeleteProduct)
+			products.GET("/:id/questions", productHandler.ListProductQuestions)
+			products.POST("/:id/questions", middleware.AuthRequired(), productHandler.AskProductQuestion)
 		}
 
-		// Brand routes
-		brands := v1.Group("/brands")
+		// Product questions are answered by admins and sellers; answers are
+		// upvoted by customers
+		v1.POST("/questions/:id/answers", middleware.AuthRequired(), middleware.RoleRequired("admin", "basic_seller", "verified_seller"), productHandler.AnswerProductQuestion)
+		v1.POST("/answers/:id/upvote", middleware.AuthRequired(), productHandler.UpvoteProductAnswer)
+
+		// Saved searches of the current user, with alerts on new matching
+		// products and price drops; behind the search kill switch
+		savedSearches := v1.Group("/saved-searches", middleware.AuthRequired(), searchKillSwitch)
+		{
+			savedSearches.GET("", productHandler.ListSavedSearches)
+			savedSearches.POST("", productHandler.CreateSavedSearch)
+			savedSearches.PUT("/:id", productHandler.UpdateSavedSearch)
+			savedSearches.DELETE("/:id", productHandler.DeleteSavedSearch)
+		}
+
+		// Brand routes; brand changes drop the cached brand and product
+		// responses
+		brands := v1.Group("/brands", responseCache.Invalidator(middleware.CacheGroupBrands, middleware.CacheGroupProducts))
+		{
+			brands.GET("", responseCache.Middleware(middleware.CacheGroupBrands), productHandler.ListBrands)
+			brands.GET("/:id", responseCache.Middleware(middleware.CacheGroupBrands), productHandler.GetBrand)
+			brands.POST("", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.CreateBrand)
+		}
+
+		// Category routes; category changes drop the cached category and
+		// product responses
+		categories := v1.Group("/categories", responseCache.Invalidator(middleware.CacheGroupCategories, middleware.CacheGroupProducts))
+		{
+			categories.GET("", responseCache.Middleware(middleware.CacheGroupCategories), productHandler.ListCategories)
+			categories.GET("/:id", responseCache.Middleware(middleware.CacheGroupCategories), productHandler.GetCategory)
+			categories.POST("", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.CreateCategory)
+			categories.PUT("/order", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.ReorderCategories)
+			categories.POST("/:id/move", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.MoveCategory)
+			categories.POST("/:id/merge", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.MergeCategories)
+			categories.GET("/:id/facets", searchKillSwitch, responseCache.Middleware(middleware.CacheGroupCategories), productHandler.GetCategoryFacets)
+			categories.GET("/:id/attributes", responseCache.Middleware(middleware.CacheGroupCategories), productHandler.ListCategoryAttributes)
+			categories.POST("/:id/attributes", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.CreateCategoryAttribute)
+			categories.PUT("/:id/attributes/:attribute_id", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.UpdateCategoryAttribute)
+			categories.DELETE("/:id/attributes/:attribute_id", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.DeleteCategoryAttribute)
+		}
+
+		// Collection routes
+		collections := v1.Group("/collections")
 		{
-			brands.GET("", productHandler.ListBrands)
-			brands.GET("/:id", productHandler.GetBrand)
-			brands.POST("", middleware.AuthRequired(), middleware.AdminRequired(), productHandler.CreateBrand)
+			collections.GET("", productHandler.ListCollections)
+			collections.GET("/:slug", productHandler.GetCollection)
+			collections.POST("", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.CreateCollection)
+			collections.PUT("/:id", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.UpdateCollection)
+			collections.DELETE("/:id", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.DeleteCollection)
+			collections.PUT("/:id/products", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.SetCollectionProducts)
 		}
 
-		// Category routes
-		categories := v1.Group("/categories")
+		// Storefront content: static pages and banner slots, served only
+		// when live and in the locale of the request when translated to it
+		content := v1.Group("/content")
 		{
-			categories.GET("", productHandler.ListCategories)
-			categories.GET("/:id", productHandler.GetCategory)
-			categories.POST("", middleware.AuthRequired(), middleware.AdminRequired(), productHandler.CreateCategory)
+			content.GET("", productHandler.ListContentPages)
+			content.GET("/:slug", productHandler.GetContentPage)
+			content.GET("/slots/:slot", productHandler.GetContentSlot)
 		}
 
+		// Storefront configuration, loaded by the frontend at boot
+		v1.GET("/storefront/config", productHandler.GetStorefrontConfig)
+
 		// User routes
 		users := v1.Group("/users")
 		{
 			users.POST("/register", userHandler.Register)
-			users.POST("/login", userHandler.Login)
+			users.POST("/login", loginThrottle, userHandler.Login)
 			users.POST("/logout", userHandler.Logout)
 			users.POST("/refresh", userHandler.RefreshToken)
+			users.POST("/invitations/accept", userHandler.AcceptInvitation)
 			users.POST("/admin", middleware.AdminKeyRequired(), userHandler.CreateAdmin)
 
 			// Protected routes
@@ -67,21 +133,113 @@ func SetupRoutes(r *gin.Engine, productHandler *handlers.ProductHandler, userHan
 				// Payment methods
 				authenticated.POST("/payment-methods", userHandler.AddPaymentMethod)
 
+				// B2B company of the user and the approval of its purchases
+				authenticated.GET("/company", userHandler.GetMyCompany)
+				authenticated.GET("/company/purchase-approvals", userHandler.ListMyPurchaseApprovals)
+				authenticated.POST("/company/purchase-approvals", userHandler.RequestPurchaseApproval)
+				authenticated.POST("/company/purchase-approvals/:id/decision", userHandler.DecidePurchaseApproval)
+				authenticated.GET("/company/credit", userHandler.GetMyCredit)
+				authenticated.POST("/company/credit/charges", userHandler.ChargeMyCredit)
+				authenticated.GET("/company/credit/statement", userHandler.GetMyCreditStatement)
+
+				// Quotes of the user's cart and their conversion into orders
+				authenticated.POST("/quotes", userHandler.RequestQuote)
+				authenticated.GET("/quotes", userHandler.ListMyQuotes)
+				authenticated.GET("/quotes/:id", userHandler.GetMyQuote)
+				authenticated.POST("/quotes/:id/accept", userHandler.AcceptQuote)
+				authenticated.POST("/quotes/:id/decline", userHandler.DeclineQuote)
+				authenticated.POST("/quotes/:id/convert", userHandler.ConvertQuote)
+
 				// Admin only routes
-				admin := authenticated.Group("/", middleware.AdminRequired())
+				admin := authenticated.Group("/", middleware.PermissionRequired(scope.UsersWrite))
 				{
 					admin.GET("", userHandler.ListUsers)
 					admin.GET("/:id", userHandler.GetUser)
+					// The user service lets administrators alone delete
+					// administrator and staff accounts
 					admin.DELETE("/:id", userHandler.DeleteUser)
+
+					// Internal staff notes
+					admin.GET("/:id/notes", userHandler.ListUserNotes)
+					admin.POST("/:id/notes", userHandler.CreateUserNote)
+					admin.PUT("/:id/notes/:note_id", userHandler.UpdateUserNote)
+					admin.DELETE("/:id/notes/:note_id", userHandler.DeleteUserNote)
 				}
 			}
 		}
 
+		// Cart, wishlist and recently viewed products of the user, or of the
+		// guest before login; guests get a session on their first call
+		shopper := v1.Group("", middleware.OptionalAuth(), guestSession)
+		{
+			shopper.GET("/cart", userHandler.GetCart)
+			shopper.PUT("/cart/items", func(c *gin.Context) {
+				// Personalization is validated against the options of the product
+				userHandler.SetCartItem(c, productHandler.GetClient())
+			})
+			shopper.DELETE("/cart/items/:product_id", userHandler.RemoveCartItem)
+			shopper.GET("/wishlist", userHandler.GetWishlist)
+			shopper.PUT("/wishlist/items", userHandler.AddWishlistItem)
+			shopper.DELETE("/wishlist/items/:product_id", userHandler.RemoveWishlistItem)
+			shopper.GET("/recently-viewed", userHandler.GetRecentlyViewed)
+			shopper.POST("/recently-viewed", userHandler.AddRecentlyViewed)
+		}
+
 		// Image routes
 		images := v1.Group("/images")
 		{
-			images.POST("/upload", middleware.AuthRequired(), middleware.AdminRequired(), productHandler.UploadImage)
-			images.DELETE("/:public_id", middleware.AuthRequired(), middleware.AdminRequired(), productHandler.DeleteImage)
+			images.POST("/upload", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.UploadImage)
+			images.DELETE("/:public_id", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite), productHandler.DeleteImage)
+		}
+
+		// Digital product downloads; the signed token is the credential
+		v1.GET("/downloads/:token", productHandler.DownloadDigitalAsset)
+
+		// Marketplace feeds; the signed token is the credential
+		v1.GET("/feeds/:token", productHandler.DownloadProductFeed)
+
+		// Emailed admin reports; the signed token is the credential
+		v1.GET("/reports/:token", adminHandler.DownloadReport)
+
+		// Customer subscriptions
+		subscriptions := v1.Group("/subscriptions", middleware.AuthRequired())
+		{
+			subscriptions.GET("", productHandler.ListMySubscriptions)
+			subscriptions.POST("/:id/cancel", productHandler.CancelSubscription)
+		}
+
+		// Customer shipment tracking
+		shipments := v1.Group("/shipments", middleware.AuthRequired())
+		{
+			shipments.GET("", inventoryHandler.ListMyShipments)
+			shipments.GET("/:id", inventoryHandler.GetMyShipment)
+		}
+		v1.GET("/orders/:reference/shipments", middleware.AuthRequired(), inventoryHandler.GetMyOrderShipments)
+
+		// Store locator: stores near a position with the stock of a product,
+		// and products reserved at a store for in-store pickup
+		v1.GET("/retail-stores/nearby", inventoryHandler.FindNearbyStores)
+		v1.POST("/retail-stores/:warehouse_id/reservations", middleware.AuthRequired(), inventoryHandler.ReserveInStore)
+
+		// Click-and-collect orders of the current customer, placed at checkout
+		// for pickup at a store
+		pickupOrders := v1.Group("/pickup-orders", middleware.AuthRequired())
+		{
+			pickupOrders.POST("", func(c *gin.Context) {
+				// Personalization is validated against the options of the products
+				inventoryHandler.CreatePickupOrder(c, productHandler.GetClient())
+			})
+			pickupOrders.GET("", inventoryHandler.ListMyPickupOrders)
+			pickupOrders.GET("/:id", inventoryHandler.GetMyPickupOrder)
+			pickupOrders.POST("/:id/cancel", inventoryHandler.CancelMyPickupOrder)
+		}
+
+		// Back-in-stock emails, for guests or signed-in customers
+		v1.POST("/products/:id/back-in-stock", middleware.OptionalAuth(), inventoryHandler.SubscribeBackInStock)
+		backInStock := v1.Group("/back-in-stock", middleware.AuthRequired())
+		{
+			backInStock.GET("", inventoryHandler.ListMyBackInStockSubscriptions)
+			backInStock.DELETE("/:id", inventoryHandler.DeleteMyBackInStockSubscription)
 		}
 
 		// Admin Dashboard routes (protected)
@@ -90,17 +248,399 @@ func SetupRoutes(r *gin.Engine, productHandler *handlers.ProductHandler, userHan
 			adminDashboard.GET("/stats", adminHandler.GetDashboardStats)
 		}
 
+		// Admin activity feed and the admins editing each product
+		v1.GET("/admin/activity", middleware.AuthRequired(), middleware.AdminRequired(), adminHandler.ListActivity)
+		adminPresence := v1.Group("/admin/products/:id/presence", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminPresence.GET("", adminHandler.GetProductPresence)
+			adminPresence.PUT("", adminHandler.TouchProductPresence)
+			adminPresence.DELETE("", adminHandler.LeaveProductPresence)
+		}
+
+		// Admin abandoned cart reminders
+		v1.GET("/admin/abandoned-carts/stats", middleware.AuthRequired(), middleware.PermissionRequired(scope.UsersWrite), userHandler.GetCartReminderStats)
+
+		// Admin staff accounts and their permission sets; staff get the routes
+		// of their sets through PermissionRequired, never these
+		adminStaff := v1.Group("/admin/staff", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminStaff.GET("", userHandler.ListStaff)
+			adminStaff.POST("", userHandler.CreateStaff)
+			adminStaff.GET("/permission-sets", userHandler.ListPermissionSets)
+			adminStaff.PUT("/:id/permissions", userHandler.SetStaffPermissions)
+		}
+
+		// Admin import of users, invited by email to set their password
+		adminUserImports := v1.Group("/admin/user-imports", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminUserImports.POST("", userHandler.ImportUsers)
+			adminUserImports.POST("/invitations/:id/resend", userHandler.ResendInvitation)
+		}
+
+		// Admin B2B companies, their members, shared addresses, purchase
+		// approvals and credit accounts
+		adminCompanies := v1.Group("/admin/companies", middleware.AuthRequired(), middleware.PermissionRequired(scope.UsersWrite))
+		{
+			adminCompanies.GET("", userHandler.ListCompanies)
+			adminCompanies.POST("", userHandler.CreateCompany)
+			adminCompanies.GET("/:id", userHandler.GetCompany)
+			adminCompanies.PUT("/:id", userHandler.UpdateCompany)
+			adminCompanies.PUT("/:id/members/:user_id", userHandler.SetCompanyMember)
+			adminCompanies.DELETE("/:id/members/:user_id", userHandler.RemoveCompanyMember)
+			adminCompanies.POST("/:id/addresses", userHandler.AddCompanyAddress)
+			adminCompanies.DELETE("/:id/addresses/:address_id", userHandler.RemoveCompanyAddress)
+			adminCompanies.GET("/:id/purchase-approvals", userHandler.ListCompanyPurchaseApprovals)
+			adminCompanies.GET("/:id/credit", userHandler.GetCompanyCredit)
+			adminCompanies.PUT("/:id/credit", userHandler.SetCompanyCreditLimit)
+			adminCompanies.POST("/:id/credit/payments", userHandler.RecordCompanyCreditPayment)
+			adminCompanies.GET("/:id/credit/statement", userHandler.GetCompanyCreditStatement)
+		}
+
+		// Admin quotes requested by buyers, answered with negotiated prices
+		adminQuotes := v1.Group("/admin/quotes", middleware.AuthRequired(), middleware.PermissionRequired(scope.UsersWrite))
+		{
+			adminQuotes.GET("", userHandler.ListQuotes)
+			adminQuotes.GET("/:id", userHandler.GetQuote)
+			adminQuotes.PUT("/:id/response", userHandler.RespondToQuote)
+		}
+
+		// Admin lifetime metrics of customers, for segments
+		v1.GET("/admin/customer-metrics", middleware.AuthRequired(), middleware.PermissionRequired(scope.UsersWrite), userHandler.ListCustomerMetrics)
+
+		// Admin reports for the current store
+		adminReports := v1.Group("/admin/reports", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminReports.POST("", adminHandler.GenerateReport)
+		}
+
+		// Admin accounting journal exports; files download from /reports/:token
+		adminAccounting := v1.Group("/admin/accounting/exports", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminAccounting.GET("", adminHandler.ListJournalExports)
+			adminAccounting.POST("", adminHandler.ExportJournal)
+		}
+
+		// Admin collection management (includes unpublished collections)
+		adminCollections := v1.Group("/admin/collections", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminCollections.GET("", productHandler.ListAllCollections)
+		}
+
+		// Admin content management, drafts and scheduled content included
+		adminContent := v1.Group("/admin/content", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminContent.GET("/pages", productHandler.ListAllContentPages)
+			adminContent.POST("/pages", productHandler.CreateContentPage)
+			adminContent.GET("/pages/:id", productHandler.AdminGetContentPage)
+			adminContent.PUT("/pages/:id", productHandler.UpdateContentPage)
+			adminContent.DELETE("/pages/:id", productHandler.DeleteContentPage)
+			adminContent.GET("/banners", productHandler.ListContentBanners)
+			adminContent.POST("/banners", productHandler.CreateContentBanner)
+			adminContent.PUT("/banners/:id", productHandler.UpdateContentBanner)
+			adminContent.DELETE("/banners/:id", productHandler.DeleteContentBanner)
+		}
+
+		// Admin store settings, validated against their key
+		adminSettings := v1.Group("/admin/settings", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminSettings.GET("", productHandler.ListSettings)
+			adminSettings.PUT("/:key", productHandler.SetSetting)
+			adminSettings.DELETE("/:key", productHandler.DeleteSetting)
+		}
+
+		// Admin download link management for digital purchases
+		adminDownloads := v1.Group("/admin/downloads", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminDownloads.POST("", productHandler.CreateDownloadLink)
+		}
+
+		// Admin subscription management; subscriptions are started for confirmed purchases
+		adminSubscriptions := v1.Group("/admin/subscriptions", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminSubscriptions.GET("", productHandler.ListAllSubscriptions)
+			adminSubscriptions.POST("", productHandler.CreateSubscription)
+		}
+
+		// Admin store (tenant) management, restricted to the default store
+		adminStores := v1.Group("/admin/stores", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminStores.GET("", productHandler.ListStores)
+			adminStores.POST("", productHandler.CreateStore)
+			adminStores.GET("/:id", productHandler.GetStore)
+			adminStores.PUT("/:id", productHandler.UpdateStore)
+		}
+
+		// Admin marketplace feed management for the current store
+		adminFeeds := v1.Group("/admin/feeds", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminFeeds.GET("", productHandler.ListProductFeeds)
+			adminFeeds.POST("", productHandler.GenerateProductFeeds)
+		}
+
+		// Admin ERP sync for the current store
+		adminErpSync := v1.Group("/admin/erp-sync", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminErpSync.GET("/runs", productHandler.ListErpSyncRuns)
+			adminErpSync.POST("/runs", productHandler.RunErpSync)
+		}
+
+		// Admin bulk price adjustments for the current store
+		adminPrices := v1.Group("/admin/prices", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminPrices.POST("/bulk-adjust", productHandler.BulkAdjustPrices)
+		}
+
+		// Admin product details with the internal staff notes on products and
+		// the links between products
+		adminProducts := v1.Group("/admin/products", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminProducts.GET("/:id", productHandler.AdminGetProduct)
+			adminProducts.GET("/:id/notes", productHandler.ListProductNotes)
+			adminProducts.POST("/:id/notes", productHandler.CreateProductNote)
+			adminProducts.PUT("/:id/notes/:note_id", productHandler.UpdateProductNote)
+			adminProducts.DELETE("/:id/notes/:note_id", productHandler.DeleteProductNote)
+			adminProducts.GET("/:id/relationships", productHandler.ListProductRelationships)
+			adminProducts.POST("/:id/relationships", productHandler.CreateProductRelationship)
+			adminProducts.PUT("/:id/relationships/:relationship_id", productHandler.UpdateProductRelationship)
+			adminProducts.DELETE("/:id/relationships/:relationship_id", productHandler.DeleteProductRelationship)
+		}
+
+		// Admin merchandising rules of search for the current store, previewed
+		// before publishing
+		adminSearchRanking := v1.Group("/admin/search-ranking", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminSearchRanking.GET("/preview", productHandler.PreviewSearchRanking)
+			adminSearchRanking.GET("/rules", productHandler.ListSearchRankingRules)
+			adminSearchRanking.POST("/rules", productHandler.CreateSearchRankingRule)
+			adminSearchRanking.PUT("/rules/:id", productHandler.UpdateSearchRankingRule)
+			adminSearchRanking.DELETE("/rules/:id", productHandler.DeleteSearchRankingRule)
+			adminSearchRanking.POST("/rules/publish", productHandler.PublishSearchRankingRules)
+		}
+
+		// Admin moderation of product questions and answers
+		adminQuestions := v1.Group("/admin", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminQuestions.GET("/questions", productHandler.AdminListQuestions)
+			adminQuestions.PUT("/questions/:id/status", productHandler.ModerateProductQuestion)
+			adminQuestions.DELETE("/questions/:id", productHandler.DeleteProductQuestion)
+			adminQuestions.PUT("/answers/:id/status", productHandler.ModerateProductAnswer)
+			adminQuestions.DELETE("/answers/:id", productHandler.DeleteProductAnswer)
+		}
+
+		// Admin retail stores, the warehouses customers can visit
+		adminRetailStores := v1.Group("/admin/retail-stores", middleware.AuthRequired(), middleware.PermissionRequired(scope.InventoryWrite))
+		{
+			adminRetailStores.GET("", inventoryHandler.ListRetailStores)
+			adminRetailStores.PUT("/:warehouse_id", inventoryHandler.SetRetailStore)
+			adminRetailStores.DELETE("/:warehouse_id", inventoryHandler.DeleteRetailStore)
+		}
+
+		// Admin product and inventory consistency checks for the current store
+		adminReconciliations := v1.Group("/admin/inventory-reconciliations", middleware.AuthRequired(), middleware.PermissionRequired(scope.InventoryWrite))
+		{
+			adminReconciliations.GET("", productHandler.ListInventoryReconciliations)
+			adminReconciliations.POST("", productHandler.RunInventoryReconciliation)
+			adminReconciliations.GET("/:id", productHandler.GetInventoryReconciliation)
+		}
+
+		// Admin supplier catalog imports with saved mapping templates
+		adminImportTemplates := v1.Group("/admin/import-templates", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminImportTemplates.GET("", productHandler.ListImportTemplates)
+			adminImportTemplates.GET("/:supplier", productHandler.GetImportTemplate)
+			adminImportTemplates.PUT("/:supplier", productHandler.SaveImportTemplate)
+			adminImportTemplates.DELETE("/:supplier", productHandler.DeleteImportTemplate)
+		}
+		adminImports := v1.Group("/admin/imports", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminImports.POST("/:supplier", productHandler.ImportSupplierCatalog)
+		}
+
+		// Admin translations of product content, category names and
+		// storefront content, served to requests whose Accept-Language asks
+		// for their locale
+		adminTranslations := v1.Group("/admin/translations", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminTranslations.GET("/:entity_type/:entity_id", productHandler.ListTranslations)
+			adminTranslations.PUT("/:entity_type/:entity_id/:locale", productHandler.SetTranslation)
+			adminTranslations.DELETE("/:entity_type/:entity_id/:locale", productHandler.DeleteTranslation)
+		}
+
+		// Admin catalog quality scores for the current store
+		adminCatalogQuality := v1.Group("/admin/catalog-quality", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminCatalogQuality.GET("", productHandler.GetCatalogQualityReport)
+			adminCatalogQuality.POST("/recompute", productHandler.RecomputeCatalogQuality)
+			adminCatalogQuality.GET("/products/:id", productHandler.GetProductQualityScore)
+		}
+
+		// Admin display badges of products for the current store
+		adminBadges := v1.Group("/admin/badges", middleware.AuthRequired(), middleware.PermissionRequired(scope.ProductsWrite))
+		{
+			adminBadges.GET("", productHandler.ListBadgeRules)
+			adminBadges.POST("/recompute", productHandler.RecomputeBadges)
+			adminBadges.PUT("/:code", productHandler.SaveBadgeRule)
+			adminBadges.DELETE("/:code", productHandler.DeleteBadgeRule)
+		}
+
+		// Admin cache administration, restricted to the default store
+		adminCache := v1.Group("/admin/cache", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminCache.POST("/namespaces/:namespace/flush", productHandler.FlushCacheNamespace)
+			adminCache.GET("/schedules", productHandler.ListCacheSchedules)
+			adminCache.GET("/schedules/runs", productHandler.ListCacheScheduleRuns)
+			adminCache.POST("/schedules/:name/run", productHandler.RunCacheSchedule)
+		}
+
+		// Admin feature flag management
+		adminFlags := v1.Group("/admin/feature-flags", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminFlags.GET("", featureFlagHandler.ListFeatureFlags)
+			adminFlags.GET("/:key", featureFlagHandler.GetFeatureFlag)
+			adminFlags.PUT("/:key", featureFlagHandler.UpsertFeatureFlag)
+			adminFlags.DELETE("/:key", featureFlagHandler.DeleteFeatureFlag)
+		}
+
+		// Admin operational controls: maintenance mode, globally or per route
+		// group, and kill switches of expensive features, backed by feature
+		// flags so that they apply without a redeploy
+		adminOperations := v1.Group("/admin/operations", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminOperations.GET("", featureFlagHandler.GetOperations)
+			adminOperations.PUT("/maintenance", featureFlagHandler.SetMaintenance)
+			adminOperations.PUT("/kill-switches/:feature", featureFlagHandler.SetKillSwitch)
+		}
+
+		// Admin API keys of fulfillment providers (3PLs) and the order status
+		// changes they report
+		adminIntegrationKeys := v1.Group("/admin/integration-keys", middleware.AuthRequired(), middleware.AdminRequired())
+		{
+			adminIntegrationKeys.GET("", inventoryHandler.ListIntegrationKeys)
+			adminIntegrationKeys.POST("", inventoryHandler.CreateIntegrationKey)
+			adminIntegrationKeys.DELETE("/:id", inventoryHandler.RevokeIntegrationKey)
+			adminIntegrationKeys.PUT("/:id/quota", inventoryHandler.SetIntegrationKeyQuota)
+		}
+		v1.GET("/admin/order-status-events", middleware.AuthRequired(), middleware.PermissionRequired(scope.OrdersWrite), inventoryHandler.ListOrderStatusEvents)
+
+		// Admin shipment registration and tracking
+		adminShipments := v1.Group("/admin/shipments", middleware.AuthRequired(), middleware.PermissionRequired(scope.OrdersWrite))
+		{
+			adminShipments.GET("", inventoryHandler.ListAllShipments)
+			adminShipments.POST("", inventoryHandler.CreateShipment)
+			adminShipments.GET("/:id", inventoryHandler.GetShipment)
+		}
+
+		// Admin refunds of order payments: requested, approved or rejected by
+		// staff, then reported processed or failed by the payment system
+		adminRefunds := v1.Group("/admin/refunds", middleware.AuthRequired(), middleware.PermissionRequired(scope.OrdersWrite))
+		{
+			adminRefunds.GET("", inventoryHandler.ListRefunds)
+			adminRefunds.POST("", inventoryHandler.RequestRefund)
+			adminRefunds.GET("/:id", inventoryHandler.GetRefund)
+			adminRefunds.POST("/:id/approve", inventoryHandler.ApproveRefund)
+			adminRefunds.POST("/:id/reject", inventoryHandler.RejectRefund)
+			adminRefunds.POST("/:id/result", inventoryHandler.RecordRefundResult)
+		}
+		// Admin click-and-collect orders: picked and made ready by the store,
+		// then collected by the customer or cancelled
+		adminPickupOrders := v1.Group("/admin/pickup-orders", middleware.AuthRequired(), middleware.PermissionRequired(scope.OrdersWrite))
+		{
+			adminPickupOrders.GET("", inventoryHandler.ListPickupOrders)
+			adminPickupOrders.GET("/:id", inventoryHandler.GetPickupOrder)
+			adminPickupOrders.POST("/:id/picking", inventoryHandler.StartPickupPicking)
+			adminPickupOrders.POST("/:id/ready", inventoryHandler.MarkPickupReady)
+			adminPickupOrders.POST("/:id/collect", inventoryHandler.CollectPickupOrder)
+			adminPickupOrders.POST("/:id/cancel", inventoryHandler.CancelPickupOrder)
+		}
+		v1.GET("/admin/refund-events", middleware.AuthRequired(), middleware.PermissionRequired(scope.OrdersWrite), inventoryHandler.ListRefundEvents)
+
+		// Admin fraud screening of orders and its review queue
+		adminFraud := v1.Group("/admin/fraud", middleware.AuthRequired(), middleware.PermissionRequired(scope.OrdersWrite))
+		{
+			adminFraud.GET("/checks", inventoryHandler.ListFraudChecks)
+			adminFraud.GET("/checks/:id", inventoryHandler.GetFraudCheck)
+			adminFraud.POST("/checks/:id/review", inventoryHandler.ReviewFraudCheck)
+			adminFraud.GET("/review-queue", inventoryHandler.ListFraudReviewQueue)
+			adminFraud.GET("/events", inventoryHandler.ListFraudEvents)
+		}
+
+		// Admin back-in-stock subscriptions
+		adminBackInStock := v1.Group("/admin/back-in-stock", middleware.AuthRequired(), middleware.PermissionRequired(scope.InventoryWrite))
+		{
+			adminBackInStock.GET("", inventoryHandler.ListAllBackInStockSubscriptions)
+			adminBackInStock.DELETE("/:id", inventoryHandler.DeleteBackInStockSubscription)
+		}
+
+		// Admin supplier management and purchase orders
+		adminSuppliers := v1.Group("/admin/suppliers", middleware.AuthRequired(), middleware.PermissionRequired(scope.InventoryWrite))
+		{
+			adminSuppliers.GET("", inventoryHandler.ListSuppliers)
+			adminSuppliers.POST("", inventoryHandler.CreateSupplier)
+			adminSuppliers.GET("/:id", inventoryHandler.GetSupplier)
+			adminSuppliers.PUT("/:id", inventoryHandler.UpdateSupplier)
+			adminSuppliers.PUT("/:id/products/:item_id", inventoryHandler.SetSupplierProduct)
+			adminSuppliers.DELETE("/:id/products/:item_id", inventoryHandler.RemoveSupplierProduct)
+		}
+		adminPurchaseOrders := v1.Group("/admin/purchase-orders", middleware.AuthRequired(), middleware.PermissionRequired(scope.InventoryWrite))
+		{
+			adminPurchaseOrders.GET("", inventoryHandler.ListPurchaseOrders)
+			adminPurchaseOrders.POST("", inventoryHandler.CreatePurchaseOrder)
+			adminPurchaseOrders.GET("/:id", inventoryHandler.GetPurchaseOrder)
+			adminPurchaseOrders.POST("/:id/receive", inventoryHandler.ReceivePurchaseOrder)
+			adminPurchaseOrders.POST("/:id/cancel", inventoryHandler.CancelPurchaseOrder)
+		}
+
+		// Admin availability thresholds deciding the stock badges of products
+		adminAvailabilityPolicies := v1.Group("/admin/availability-policies", middleware.AuthRequired(), middleware.PermissionRequired(scope.InventoryWrite))
+		{
+			adminAvailabilityPolicies.GET("/:product_id", inventoryHandler.GetAvailabilityPolicy)
+			adminAvailabilityPolicies.PUT("/:product_id", inventoryHandler.SetAvailabilityPolicy)
+			adminAvailabilityPolicies.DELETE("/:product_id", inventoryHandler.DeleteAvailabilityPolicy)
+		}
+
+		// Fulfillment providers push stock updates and shipment confirmations,
+		// and carriers push tracking events, with their API key
+		v1.POST("/integrations/fulfillment/events", inventoryHandler.PushFulfillmentEvents)
+		v1.POST("/integrations/carriers/:carrier/events", inventoryHandler.ReceiveCarrierEvents)
+		// Integrators monitor the daily quota of their API key
+		v1.GET("/quota", inventoryHandler.GetQuota)
+
 		// Inventory routes (most require admin access)
 		inventory := v1.Group("/inventory")
 		{
 			// Public routes
 			inventory.GET("/check", inventoryHandler.CheckInventoryAvailability)
+			inventory.POST("/check-bulk", inventoryHandler.CheckAvailabilityBulk)
+			inventory.GET("/watch", inventoryHandler.WatchInventory)
 
 			// Protected routes
-			protected := inventory.Group("/", middleware.AuthRequired(), middleware.AdminRequired())
+			protected := inventory.Group("/", middleware.AuthRequired(), middleware.PermissionRequired(scope.InventoryWrite))
 			{
 				protected.GET("/items", inventoryHandler.ListInventoryItems)
 				protected.GET("/items/:product_id", inventoryHandler.GetInventoryItem)
+				protected.GET("/items/:product_id/history", inventoryHandler.GetStockHistory)
+				protected.GET("/items/:product_id/forecast", inventoryHandler.GetForecast)
+				protected.GET("/reorder-suggestions", inventoryHandler.ListReorderSuggestions)
+				protected.PUT("/items/:product_id/locations/:warehouse_id/buffers", inventoryHandler.SetStockBuffers)
+				protected.GET("/items/:product_id/lots", inventoryHandler.ListLots)
+				protected.POST("/items/:product_id/lots", inventoryHandler.ReceiveLot)
+				protected.GET("/lots/expiring", inventoryHandler.ListExpiringLots)
+				protected.GET("/items/:product_id/units", inventoryHandler.ListInventoryUnits)
+				protected.PUT("/items/:product_id/units/:code", inventoryHandler.SetInventoryUnit)
+				protected.DELETE("/items/:product_id/units/:code", inventoryHandler.DeleteInventoryUnit)
+				protected.GET("/warehouses/:warehouse_id/bins", inventoryHandler.ListWarehouseBins)
+				protected.PUT("/warehouses/:warehouse_id/bins", inventoryHandler.SetWarehouseBin)
+				protected.DELETE("/bins/:bin_id", inventoryHandler.DeleteWarehouseBin)
+				protected.GET("/warehouses/:warehouse_id/bin-stock", inventoryHandler.ListBinStock)
+				protected.PUT("/bins/:bin_id/stock/:product_id", inventoryHandler.SetBinStock)
+				protected.POST("/warehouses/:warehouse_id/pick-lists", inventoryHandler.GeneratePickList)
+				protected.GET("/waves", inventoryHandler.ListFulfillmentWaves)
+				protected.POST("/waves", inventoryHandler.CreateFulfillmentWave)
+				protected.GET("/waves/:wave_id", inventoryHandler.GetFulfillmentWave)
+				protected.GET("/waves/:wave_id/pick-list", inventoryHandler.GenerateWavePickList)
+				protected.POST("/waves/:wave_id/complete", inventoryHandler.CompleteFulfillmentWave)
+				protected.POST("/waves/:wave_id/cancel", inventoryHandler.CancelFulfillmentWave)
+				protected.GET("/alerts", inventoryHandler.ListStockAlerts)
 				protected.GET("/warehouses", inventoryHandler.ListWarehouses)
 				protected.GET("/transactions", inventoryHandler.ListInventoryTransactions)
 			}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/handlers"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+)
+
+// SetupSLORoutes serves the per-route latency reports to admins
+func SetupSLORoutes(r *gin.Engine, sloHandler *handlers.SLOHandler) {
+	slos := r.Group("/api/v1/admin/slos", middleware.AuthRequired(), middleware.AdminRequired())
+	{
+		slos.GET("", sloHandler.ListSLOs)
+		slos.GET("/violations", sloHandler.ListSLOViolations)
+	}
+}
@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
+	"crypto/rand"
 	"log"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
 	"github.com/joho/godotenv"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -15,9 +20,22 @@ import (
 	"github.com/louai60/e-commerce_project/backend/api-gateway/clients"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/config"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/handlers"
+	"github.com/louai60/e-commerce_project/backend/api-gateway/internal/canary"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/internal/routes"
 	"github.com/louai60/e-commerce_project/backend/api-gateway/middleware"
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
+	"github.com/louai60/e-commerce_project/backend/common/i18n"
+	"github.com/louai60/e-commerce_project/backend/common/jwks"
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/recovery"
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/shared/catalogevents"
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
+	userpb "github.com/louai60/e-commerce_project/backend/user-service/proto"
 )
 
 func main() {
@@ -27,16 +45,19 @@ func main() {
 	}
 
 	// Initialize logger
-	logger, err := zap.NewProduction()
-	if err != nil {
-		log.Fatal("Failed to initialize logger:", err)
-	}
+	logger := applogger.InitFromEnv("api-gateway")
 	defer logger.Sync()
 
-	// Load JWT public key for token validation
-	if err := middleware.LoadPublicKey(); err != nil {
-		logger.Fatal("Failed to load JWT public key", zap.Error(err))
+	// Sign internal calls so that services can enforce who calls their
+	// privileged RPCs
+	serviceSigner, err := servicetoken.SignerFromEnv("api-gateway")
+	if err != nil {
+		logger.Fatal("Invalid service token key", zap.Error(err))
+	}
+	if serviceSigner == nil {
+		logger.Warn("SERVICE_TOKEN_KEY not set, internal calls are sent without service tokens")
 	}
+	servicetoken.SetSigner(serviceSigner)
 
 	// Initialize gRPC connections
 	productServiceAddr := os.Getenv("PRODUCT_SERVICE_ADDR")
@@ -44,44 +65,67 @@ func main() {
 		productServiceAddr = "localhost:50051" // fallback to default
 	}
 
-	var productConn *grpc.ClientConn
 	var productClient productpb.ProductServiceClient
+	productDialOptions := []grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), locale.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), scope.UnaryClientInterceptor(), cachectl.UnaryClientInterceptor()),
+		grpc.WithChainStreamInterceptor(tenant.StreamClientInterceptor(), servicetoken.StreamClientInterceptor(), scope.StreamClientInterceptor()),
+	}
 
 	// Try to connect to product service but don't block startup
-	productConn, err = grpc.Dial(
-		productServiceAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	productConn, err := grpc.Dial(productServiceAddr, productDialOptions...)
 	if err != nil {
 		logger.Error("Failed to connect to product service - some functionality will be unavailable",
 			zap.String("address", productServiceAddr),
 			zap.Error(err))
 	} else {
 		defer productConn.Close()
-		productClient = productpb.NewProductServiceClient(productConn)
+		productClient = productpb.NewProductServiceClient(withCanary("PRODUCT_SERVICE", productConn, productDialOptions, logger))
 	}
 
 	// Initialize product handler with potential nil client
 	productHandler := handlers.NewProductHandler(productClient, logger)
 
-	userConn, err := grpc.Dial("localhost:50052", grpc.WithTransportCredentials(insecure.NewCredentials()))
+	userConn, err := grpc.Dial("localhost:50052", grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), locale.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), scope.UnaryClientInterceptor(), cachectl.UnaryClientInterceptor()))
 	if err != nil {
 		logger.Fatal("Failed to connect to user service", zap.Error(err))
 	}
 	defer userConn.Close()
 
+	// Verify access tokens with the keys the user service publishes, following
+	// its key rotations
+	userClient := userpb.NewUserServiceClient(userConn)
+	jwtKeys := middleware.NewKeySet(func(ctx context.Context) (jwks.Set, error) {
+		resp, err := userClient.GetJWKS(ctx, &userpb.GetJWKSRequest{})
+		if err != nil {
+			return jwks.Set{}, err
+		}
+		set := jwks.Set{Keys: make([]jwks.Key, 0, len(resp.Keys))}
+		for _, key := range resp.Keys {
+			set.Keys = append(set.Keys, jwks.Key{Kid: key.Kid, Kty: key.Kty, Alg: key.Alg, Use: key.Use, N: key.N, E: key.E})
+		}
+		return set, nil
+	}, logger)
+	if err := jwtKeys.Refresh(context.Background()); err != nil {
+		// Requests with a token fetch the keys again once the user service is up
+		logger.Error("Failed to load the JWKS of the user service", zap.Error(err))
+	}
+	jwtKeys.Start(context.Background(), 5*time.Minute)
+	middleware.SetKeySet(jwtKeys)
+
 	// Connect to Admin Service
 	adminServiceAddr := os.Getenv("ADMIN_SERVICE_ADDR")
 	if adminServiceAddr == "" {
 		logger.Fatal("ADMIN_SERVICE_ADDR environment variable is required")
 	}
-	adminConn, err := grpc.Dial(adminServiceAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	adminDialOptions := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), locale.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor(), scope.UnaryClientInterceptor(), cachectl.UnaryClientInterceptor())}
+	adminConn, err := grpc.Dial(adminServiceAddr, adminDialOptions...)
 	if err != nil {
 		logger.Fatal("Failed to connect to admin service", zap.Error(err))
 	}
 	defer adminConn.Close()
 
-	adminClient := adminpb.NewAdminServiceClient(adminConn)
+	adminClient := adminpb.NewAdminServiceClient(withCanary("ADMIN_SERVICE", adminConn, adminDialOptions, logger))
 	adminHandler := handlers.NewAdminHandler(adminClient, logger)
 
 	userHandler, err := handlers.NewUserHandler("localhost:50052", logger)
@@ -128,6 +172,50 @@ func main() {
 	// Initialize inventory handler with potential nil client
 	inventoryHandler := handlers.NewInventoryHandler(inventoryClient, logger)
 
+	// Feature flags are stored in Redis and shared with the backend services
+	redisAddr := os.Getenv("REDIS_ADDR")
+	if redisAddr == "" {
+		redisAddr = "localhost:6379"
+	}
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     redisAddr,
+		Password: os.Getenv("REDIS_PASSWORD"),
+	})
+	defer redisClient.Close()
+
+	flagsCtx, stopFlags := context.WithCancel(context.Background())
+	defer stopFlags()
+	loadCtx, cancelLoad := context.WithTimeout(flagsCtx, 3*time.Second)
+	flagsClient := featureflags.NewClient(loadCtx, featureflags.NewRedisStore(redisClient), logger)
+	cancelLoad()
+	flagsClient.Start(flagsCtx)
+	featureFlagHandler := handlers.NewFeatureFlagHandler(flagsClient, logger)
+
+	// Maintenance mode and kill switches are toggled through the admin
+	// operations endpoint; refused requests are told to retry after
+	// MAINTENANCE_RETRY_AFTER
+	var maintenanceRetryAfter time.Duration
+	if retryAfter := os.Getenv("MAINTENANCE_RETRY_AFTER"); retryAfter != "" {
+		if maintenanceRetryAfter, err = time.ParseDuration(retryAfter); err != nil {
+			logger.Fatal("Invalid MAINTENANCE_RETRY_AFTER", zap.String("value", retryAfter), zap.Error(err))
+		}
+	}
+	operationalControls := middleware.NewOperationalControls(flagsClient, maintenanceRetryAfter)
+
+	// Anonymous catalog GETs are cached in Redis for RESPONSE_CACHE_TTL and
+	// dropped early on the product changes relayed by the product service
+	var responseCacheTTL time.Duration
+	if ttl := os.Getenv("RESPONSE_CACHE_TTL"); ttl != "" {
+		if responseCacheTTL, err = time.ParseDuration(ttl); err != nil {
+			logger.Fatal("Invalid RESPONSE_CACHE_TTL", zap.String("value", ttl), zap.Error(err))
+		}
+	}
+	responseCache := middleware.NewResponseCache(middleware.NewRedisResponseCacheStore(redisClient), responseCacheTTL, logger)
+	catalogevents.Subscribe(flagsCtx, redisClient, func(ctx context.Context, event catalogevents.Event) {
+		// Category facets count products, so they change with them
+		responseCache.Invalidate(ctx, event.TenantID, middleware.CacheGroupProducts, middleware.CacheGroupCategories)
+	}, logger)
+
 	// Initialize GraphQL handler
 	graphqlHandler, err := handlers.NewGraphQLHandler(logger, inventoryClient, productClient)
 	if err != nil {
@@ -136,14 +224,130 @@ func main() {
 
 	// Initialize Gin router
 	r := gin.New() // Use New() instead of Default() to avoid using the default logger and recovery
-	r.Use(middleware.Logger(logger), middleware.CORSMiddleware(), middleware.Recovery(logger))
+	tenantResolver := middleware.NewTenantResolver(productClient, logger, time.Minute)
+	bodyLogConfig := middleware.BodyLogConfig{MaxBodyBytes: middleware.DefaultBodyLogMaxBytes}
+	if rate := os.Getenv("BODY_LOG_SAMPLE_RATE"); rate != "" {
+		if bodyLogConfig.SampleRate, err = strconv.ParseFloat(rate, 64); err != nil {
+			logger.Fatal("Invalid BODY_LOG_SAMPLE_RATE", zap.String("value", rate), zap.Error(err))
+		}
+	}
+	// Recover panics of handlers, reporting them to Sentry when configured
+	panicReporter, err := recovery.ReporterFromEnv(context.Background(), logger)
+	if err != nil {
+		logger.Fatal("Invalid Sentry configuration", zap.Error(err))
+	}
+	recoverer := recovery.New("api-gateway", logger, panicReporter)
+	// Default the currency and locale of anonymous visitors to those of their
+	// country, looked up with MaxMind when an account is configured
+	var geoIPProvider middleware.GeoIPProvider
+	if accountID := os.Getenv("MAXMIND_ACCOUNT_ID"); accountID != "" {
+		geoIPProvider = middleware.NewCachedGeoIP(middleware.NewMaxMindGeoIP(accountID, os.Getenv("MAXMIND_LICENSE_KEY")), 24*time.Hour, 100000)
+	}
+	geoLocale := middleware.GeoLocale(geoIPProvider, middleware.GeoLocaleConfig{CountryHeader: os.Getenv("GEOIP_COUNTRY_HEADER")}, logger)
+	// Serve the language requested by Accept-Language, among SUPPORTED_LOCALES
+	// or the languages of the message catalog
+	var supportedLocales []string
+	for _, tag := range strings.Split(os.Getenv("SUPPORTED_LOCALES"), ",") {
+		if tag = strings.TrimSpace(tag); tag != "" {
+			supportedLocales = append(supportedLocales, tag)
+		}
+	}
+	localeNegotiation := middleware.LocaleNegotiation(i18n.Default(), supportedLocales)
+	// Sign the sessions of guests with GUEST_SESSION_SECRET; without one, a
+	// random secret ends all guest sessions when the gateway restarts
+	guestSecret := []byte(os.Getenv("GUEST_SESSION_SECRET"))
+	if len(guestSecret) == 0 {
+		logger.Warn("GUEST_SESSION_SECRET not set, guest sessions will not survive restarts")
+		guestSecret = make([]byte, 32)
+		if _, err := rand.Read(guestSecret); err != nil {
+			logger.Fatal("Failed to generate guest session secret", zap.Error(err))
+		}
+	}
+	var guestSessionTTL time.Duration
+	if ttl := os.Getenv("GUEST_SESSION_TTL"); ttl != "" {
+		if guestSessionTTL, err = time.ParseDuration(ttl); err != nil {
+			logger.Fatal("Invalid GUEST_SESSION_TTL", zap.String("value", ttl), zap.Error(err))
+		}
+	}
+	guestSessions := middleware.NewGuestSessions(guestSecret, guestSessionTTL)
+	// Track the latency of each route against SLO_LATENCY_TARGET and
+	// SLO_OBJECTIVE, or its own target in SLO_ROUTE_TARGETS
+	sloTarget := middleware.DefaultSLOTarget
+	if latency := os.Getenv("SLO_LATENCY_TARGET"); latency != "" {
+		if sloTarget.Latency, err = time.ParseDuration(latency); err != nil || sloTarget.Latency <= 0 {
+			logger.Fatal("Invalid SLO_LATENCY_TARGET", zap.String("value", latency), zap.Error(err))
+		}
+	}
+	if objective := os.Getenv("SLO_OBJECTIVE"); objective != "" {
+		if sloTarget.Objective, err = strconv.ParseFloat(objective, 64); err != nil || sloTarget.Objective <= 0 || sloTarget.Objective >= 1 {
+			logger.Fatal("Invalid SLO_OBJECTIVE", zap.String("value", objective), zap.Error(err))
+		}
+	}
+	sloRouteTargets, err := middleware.ParseSLOTargets(os.Getenv("SLO_ROUTE_TARGETS"), sloTarget)
+	if err != nil {
+		logger.Fatal("Invalid SLO_ROUTE_TARGETS", zap.Error(err))
+	}
+	sloTracker := middleware.NewSLOTracker(sloTarget, sloRouteTargets)
+	// CORS policies of the admin, storefront and public catalog routes, with
+	// their origins and credentials overridden by CORS_<POLICY>_* variables
+	corsPolicies, err := middleware.CORSPoliciesFromEnv(os.Getenv)
+	if err != nil {
+		logger.Fatal("Invalid CORS configuration", zap.Error(err))
+	}
+	corsHandler, err := middleware.CORS(corsPolicies)
+	if err != nil {
+		logger.Fatal("Invalid CORS configuration", zap.Error(err))
+	}
+	r.Use(middleware.RequestID(logger), middleware.Logger(logger), sloTracker.Middleware(), corsHandler, operationalControls.Maintenance(), middleware.Compression(middleware.DefaultCompressionMinSize),
+		middleware.BodyLogger(logger, bodyLogConfig), middleware.Recovery(recoverer), tenantResolver.Middleware(), middleware.AnonymousScopes(), guestSessions.Middleware(), middleware.CanaryRouting(), geoLocale, localeNegotiation, middleware.CacheControl())
+
+	// Throttle logins per IP and email, challenging with a CAPTCHA when a
+	// verification endpoint is configured
+	loginThrottleConfig := middleware.DefaultLoginThrottleConfig()
+	loginThrottleConfig.CaptchaSiteKey = os.Getenv("LOGIN_CAPTCHA_SITE_KEY")
+	var captchaVerifier middleware.CaptchaVerifier
+	if verifyURL := os.Getenv("LOGIN_CAPTCHA_VERIFY_URL"); verifyURL != "" {
+		captchaVerifier = middleware.NewSiteVerifyCaptcha(verifyURL, os.Getenv("LOGIN_CAPTCHA_SECRET"))
+	}
+	loginThrottle := middleware.NewLoginThrottle(middleware.NewRedisLoginAttemptStore(redisClient), captchaVerifier, loginThrottleConfig, logger)
 
 	// Setup all routes
-	routes.SetupRoutes(r, productHandler, userHandler, adminHandler, inventoryHandler)
+	routes.SetupRoutes(r, productHandler, userHandler, adminHandler, inventoryHandler, featureFlagHandler, loginThrottle.Middleware(), guestSessions.Ensure(), operationalControls.KillSwitch("search"), responseCache)
+
+	// Public catalog API for partners, rate limited per IP to
+	// PUBLIC_RATE_LIMIT requests per PUBLIC_RATE_LIMIT_WINDOW and cached for
+	// PUBLIC_CACHE_TTL. Its cache shares the generations of the response
+	// cache, so the same product events invalidate it.
+	publicRateLimitConfig := middleware.DefaultPublicRateLimitConfig()
+	if limit := os.Getenv("PUBLIC_RATE_LIMIT"); limit != "" {
+		if publicRateLimitConfig.Requests, err = strconv.Atoi(limit); err != nil || publicRateLimitConfig.Requests <= 0 {
+			logger.Fatal("Invalid PUBLIC_RATE_LIMIT", zap.String("value", limit), zap.Error(err))
+		}
+	}
+	if window := os.Getenv("PUBLIC_RATE_LIMIT_WINDOW"); window != "" {
+		if publicRateLimitConfig.Window, err = time.ParseDuration(window); err != nil || publicRateLimitConfig.Window <= 0 {
+			logger.Fatal("Invalid PUBLIC_RATE_LIMIT_WINDOW", zap.String("value", window), zap.Error(err))
+		}
+	}
+	publicCacheTTL := middleware.DefaultPublicCacheTTL
+	if ttl := os.Getenv("PUBLIC_CACHE_TTL"); ttl != "" {
+		if publicCacheTTL, err = time.ParseDuration(ttl); err != nil || publicCacheTTL <= 0 {
+			logger.Fatal("Invalid PUBLIC_CACHE_TTL", zap.String("value", ttl), zap.Error(err))
+		}
+	}
+	publicRateLimiter := middleware.NewRateLimiter("public", middleware.NewRedisRateLimitStore(redisClient), publicRateLimitConfig, logger)
+	publicCache := middleware.NewResponseCache(middleware.NewRedisResponseCacheStore(redisClient), publicCacheTTL, logger)
+	routes.SetupPublicRoutes(r, productHandler, inventoryHandler, publicRateLimiter.Middleware(), publicCache, publicCacheTTL)
+
+	// Per-route latency reports for admins
+	routes.SetupSLORoutes(r, handlers.NewSLOHandler(sloTracker))
+
+	// Profiling endpoints for admins, behind the "pprof" feature flag
+	routes.SetupProfilingRoutes(r, flagsClient)
 
 	// Setup GraphQL routes if handler was initialized successfully
 	if graphqlHandler != nil {
-		routes.SetupGraphQLRoutes(r, graphqlHandler)
+		routes.SetupGraphQLRoutes(r, graphqlHandler, flagsClient, operationalControls.KillSwitch("graphql"))
 		logger.Info("GraphQL endpoint configured at /api/v1/graphql")
 	}
 
@@ -159,6 +363,10 @@ func main() {
 	r.Static("/uploads", uploadsDir)
 	logger.Info("Static file server configured", zap.String("path", uploadsDir))
 
+	// Serve the OpenAPI document generated from the routes above
+	routes.SetupOpenAPIRoutes(r)
+	logger.Info("OpenAPI document configured at /api/v1/openapi.json, Swagger UI at /api/v1/docs")
+
 	// Start server
 	serverAddr := ":8080"
 	logger.Info("Starting API Gateway", zap.String("address", serverAddr))
@@ -166,3 +374,27 @@ func main() {
 		logger.Fatal("Failed to start server", zap.Error(err))
 	}
 }
+
+// withCanary routes a share of the calls to a service, and those of requests
+// asking for it with the X-Canary header, to the canary build configured with
+// <prefix>_CANARY_ADDR and <prefix>_CANARY_PERCENT. Without a canary, or when
+// it cannot be dialed, every call goes to the stable build.
+func withCanary(prefix string, stable *grpc.ClientConn, opts []grpc.DialOption, logger *zap.Logger) grpc.ClientConnInterface {
+	cfg, err := canary.ConfigFromEnv(prefix)
+	if err != nil {
+		logger.Fatal("Invalid canary configuration", zap.Error(err))
+	}
+	if cfg.Addr == "" {
+		return stable
+	}
+
+	canaryConn, err := grpc.Dial(cfg.Addr, opts...)
+	if err != nil {
+		logger.Error("Failed to connect to canary, routing every call to the stable build",
+			zap.String("service", prefix), zap.String("address", cfg.Addr), zap.Error(err))
+		return stable
+	}
+	logger.Info("Canary routing enabled",
+		zap.String("service", prefix), zap.String("address", cfg.Addr), zap.Int("percent", cfg.Percent))
+	return canary.NewConn(stable, canaryConn, cfg.Percent)
+}
@@ -17,7 +17,7 @@ func AdminRequired() gin.HandlerFunc {
         }
 
         // Check if user is admin
-        if role.(string) != "admin" {
+        if !isAdminRole(role.(string)) {
             c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
             c.Abort()
             return
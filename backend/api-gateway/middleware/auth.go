@@ -1,55 +1,35 @@
 package middleware
 
 import (
-	"crypto/rsa"
+	"context"
 	"fmt"
-	"io/ioutil"
 	"log"
 	"net/http"
-	"os"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
+
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 )
 
-// Global variable to hold the parsed public key
-var jwtPublicKey *rsa.PublicKey
+// jwtKeys holds the keys access tokens are verified with
+var jwtKeys *KeySet
 
-// LoadPublicKey loads the JWT public key from the specified path.
+// SetKeySet sets the keys AuthRequired verifies tokens with.
 // It should be called once during application startup.
-func LoadPublicKey() error {
-	publicKeyPath := os.Getenv("JWT_PUBLIC_KEY_PATH")
-	if publicKeyPath == "" {
-		publicKeyPath = "certificates/public_key.pem" // Default path
-	}
-
-	keyBytes, err := ioutil.ReadFile(publicKeyPath)
-	if err != nil {
-		return fmt.Errorf("failed to read public key file '%s': %w", publicKeyPath, err)
-	}
-
-	jwtPublicKey, err = jwt.ParseRSAPublicKeyFromPEM(keyBytes)
-	if err != nil {
-		return fmt.Errorf("failed to parse public key: %w", err)
-	}
-	log.Printf("Successfully loaded JWT public key from %s", publicKeyPath)
-	return nil
+func SetKeySet(keys *KeySet) {
+	jwtKeys = keys
 }
 
-
 func AuthRequired() gin.HandlerFunc {
-	// Ensure the public key is loaded before returning the handler
-	if jwtPublicKey == nil {
-		// This should ideally not happen if LoadPublicKey is called at startup
-		log.Fatal("JWT Public Key not loaded. Call LoadPublicKey() during initialization.")
-		// Or return a handler that always returns an error
-		// return func(c *gin.Context) {
-		// 	c.JSON(http.StatusInternalServerError, gin.H{"error": "JWT public key not configured"})
-		// 	c.Abort()
-		// }
+	// Ensure the key set is configured before returning the handler
+	if jwtKeys == nil {
+		log.Fatal("JWT key set not configured. Call SetKeySet() during initialization.")
 	}
 
 	return func(c *gin.Context) {
@@ -68,34 +48,99 @@ func AuthRequired() gin.HandlerFunc {
         }
 
         token := bearerToken[1]
-  claims, err := validateToken(token, jwtPublicKey) // Pass the loaded key
-  if err != nil {
+        claims, err := validateToken(c.Request.Context(), token, jwtKeys)
+        if err != nil {
             c.JSON(http.StatusUnauthorized, gin.H{"error": fmt.Sprintf("invalid token: %v", err)})
             c.Abort()
             return
         }
 
+        // Accounts belong to one store; tokens issued before stores existed
+        // belong to the default store
+        tokenTenant, _ := claims["tenant_id"].(string)
+        if tokenTenant == "" {
+            tokenTenant = tenant.DefaultTenantID
+        }
+        if tokenTenant != tenant.FromContext(c.Request.Context()) {
+            c.JSON(http.StatusForbidden, gin.H{"error": "token was issued for another store"})
+            c.Abort()
+            return
+        }
+
         // Set user information in context
         c.Set("user_id", claims["user_id"])
         c.Set("user_role", claims["role"])
         c.Set("user_email", claims["email"])
+        c.Set("user_name", claims["username"])
+        if userID, ok := claims["user_id"].(string); ok {
+            c.Request = c.Request.WithContext(applogger.WithUserID(c.Request.Context(), userID))
+        }
+
+        // Forward the scopes of the token for the services to check; tokens
+        // issued before scopes existed are left to the gateway's checks
+        if granted, ok := claims[scope.Claim].(string); ok {
+            c.Request = c.Request.WithContext(scope.NewContext(c.Request.Context(), scope.Parse(granted)))
+        } else {
+            c.Request = c.Request.WithContext(scope.WithoutScopes(c.Request.Context()))
+        }
 
         c.Next()
     }
 }
 
-func validateToken(tokenString string, publicKey *rsa.PublicKey) (jwt.MapClaims, error) {
-	if publicKey == nil {
-		return nil, fmt.Errorf("public key is nil, cannot validate token")
+// OptionalAuth authenticates requests carrying a token like AuthRequired,
+// rejecting invalid tokens, and lets requests without one through
+// anonymously, for routes serving both users and guests.
+func OptionalAuth() gin.HandlerFunc {
+	auth := AuthRequired()
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") == "" {
+			c.Next()
+			return
+		}
+		auth(c)
+	}
+}
+
+// AnonymousScopes makes the services check the calls of requests as made
+// by a user without scopes, until AuthRequired grants those of the token. A
+// route missing its authentication thus cannot change the catalog or stock.
+func AnonymousScopes() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(scope.NewContext(c.Request.Context(), nil))
+		c.Next()
+	}
+}
+
+func validateToken(ctx context.Context, tokenString string, keys *KeySet) (jwt.MapClaims, error) {
+	if keys == nil {
+		return nil, fmt.Errorf("key set is nil, cannot validate token")
+	}
+
+	// Read the kid before verifying, to pick the key the token was signed with
+	unverified, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	kid, _ := unverified.Header["kid"].(string)
+	publicKeys := keys.Keys(ctx, kid)
+	if len(publicKeys) == 0 {
+		return nil, fmt.Errorf("unknown signing key")
 	}
 
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		// Validate the alg is RS256
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	var token *jwt.Token
+	for _, publicKey := range publicKeys {
+		token, err = jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+			// Validate the alg is RS256
+			if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return publicKey, nil
+		})
+		if err == nil {
+			break
 		}
-		return publicKey, nil
-	})
+	}
 
 	if err != nil {
 		if ve, ok := err.(*jwt.ValidationError); ok {
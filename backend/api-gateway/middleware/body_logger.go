@@ -0,0 +1,120 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"math/rand"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// BodyLogConfig configures the logging of request and response bodies
+type BodyLogConfig struct {
+	// SampleRate is the fraction of requests whose bodies are logged, from 0
+	// (none) to 1 (all)
+	SampleRate float64
+	// MaxBodyBytes caps the logged size of each body; longer bodies are
+	// truncated
+	MaxBodyBytes int
+}
+
+// DefaultBodyLogMaxBytes is the body size cap used when MaxBodyBytes is unset
+const DefaultBodyLogMaxBytes = 4096
+
+// BodyLogger logs the request and response bodies of a sample of requests
+// with their request ID, so a failing call can be replayed when debugging.
+// Passwords, tokens and card data are redacted before logging, and binary or
+// multipart bodies are left out.
+func BodyLogger(logger *zap.Logger, config BodyLogConfig) gin.HandlerFunc {
+	logger = logger.Named("body_log")
+	if config.MaxBodyBytes <= 0 {
+		config.MaxBodyBytes = DefaultBodyLogMaxBytes
+	}
+
+	return func(c *gin.Context) {
+		if config.SampleRate <= 0 || rand.Float64() >= config.SampleRate {
+			c.Next()
+			return
+		}
+
+		requestContentType := c.ContentType()
+		var requestBody []byte
+		if c.Request.Body != nil && loggableContentType(requestContentType) {
+			// Read one byte past the cap to know whether the body was truncated,
+			// then hand the handlers the full body back
+			head, _ := io.ReadAll(io.LimitReader(c.Request.Body, int64(config.MaxBodyBytes)+1))
+			c.Request.Body = readCloser{io.MultiReader(bytes.NewReader(head), c.Request.Body), c.Request.Body}
+			requestBody = head
+		}
+
+		writer := &bodyLogWriter{ResponseWriter: c.Writer, max: config.MaxBodyBytes}
+		c.Writer = writer
+
+		c.Next()
+
+		fields := []zap.Field{
+			zap.String("request_id", GetRequestID(c)),
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.Int("status", c.Writer.Status()),
+		}
+		if c.Request.URL.RawQuery != "" {
+			fields = append(fields, zap.String("query", RedactQuery(c.Request.URL.Query())))
+		}
+		if len(requestBody) > 0 {
+			fields = append(fields, zap.String("request_body", logBody(requestContentType, requestBody, config.MaxBodyBytes)))
+		}
+		responseContentType := writer.Header().Get("Content-Type")
+		if writer.body.Len() > 0 && loggableContentType(responseContentType) {
+			fields = append(fields, zap.String("response_body", logBody(responseContentType, writer.body.Bytes(), config.MaxBodyBytes)))
+		}
+
+		logger.Info("Request bodies", fields...)
+	}
+}
+
+// logBody redacts a body captured with up to one byte past max. Truncated
+// bodies are not valid JSON anymore, so they only get card numbers masked.
+func logBody(contentType string, body []byte, max int) string {
+	if len(body) <= max {
+		return RedactBody(contentType, body)
+	}
+	return RedactBody("", body[:max]) + "...[truncated]"
+}
+
+func loggableContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	for _, textual := range []string{"json", "text/", "xml", "x-www-form-urlencoded"} {
+		if strings.Contains(contentType, textual) {
+			return true
+		}
+	}
+	return false
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// bodyLogWriter keeps the first max+1 bytes of the response body
+type bodyLogWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+	max  int
+}
+
+func (w *bodyLogWriter) Write(b []byte) (int, error) {
+	if room := w.max + 1 - w.body.Len(); room > 0 {
+		w.body.Write(b[:min(room, len(b))])
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyLogWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
@@ -1,134 +1,254 @@
 package middleware
 
 import (
-    "bytes"
-    "crypto/sha256"
-    "encoding/hex"
-    "fmt"
-    "net/http"
-    "sort"
-    "strings"
-    "time"
-
-    "github.com/gin-gonic/gin"
-    "github.com/louai60/e-commerce_project/backend/shared/cache"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/internal/canary"
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 )
 
-type CachedWriter struct {
-    gin.ResponseWriter
-    body *bytes.Buffer
-}
-
-func (w *CachedWriter) Write(b []byte) (int, error) {
-    w.body.Write(b)
-    return w.ResponseWriter.Write(b)
-}
-
-// CacheConfig holds configuration for cache middleware
-type CacheConfig struct {
-    TTL            time.Duration
-    ExcludeHeaders []string
-    IgnoreParams   []string
-}
-
-func CacheMiddleware(cacheManager *cache.CacheManager, config CacheConfig) gin.HandlerFunc {
-    return func(c *gin.Context) {
-        // Skip caching for non-GET requests
-        if c.Request.Method != "GET" {
-            c.Next()
-            return
-        }
-
-        // Generate cache key
-        key := generateCacheKey(c.Request, config)
-
-        // Try to get from cache
-        var cachedResponse []byte
-        err := cacheManager.Get(c.Request.Context(), key, &cachedResponse)
-        if err == nil {
-            c.Data(http.StatusOK, "application/json", cachedResponse)
-            c.Abort()
-            return
-        }
-
-        // Cache miss, wrap response writer
-        writer := &CachedWriter{
-            ResponseWriter: c.Writer,
-            body:          &bytes.Buffer{},
-        }
-        c.Writer = writer
-
-        c.Next()
-
-        // Only cache successful responses
-        if c.Writer.Status() == http.StatusOK {
-            // Set cache with configured TTL
-            cacheManager.Set(c.Request.Context(), key, writer.body.Bytes(), config.TTL)
-        }
-    }
-}
-
-func generateCacheKey(r *http.Request, config CacheConfig) string {
-    // Start with base components
-    components := []string{
-        r.Host,
-        r.Method,
-        r.URL.Path,
-    }
-
-    // Add sorted query parameters (excluding ignored ones)
-    if len(r.URL.Query()) > 0 {
-        params := []string{}
-        for key, values := range r.URL.Query() {
-            if !contains(config.IgnoreParams, key) {
-                sort.Strings(values)
-                params = append(params, fmt.Sprintf("%s=%s", key, strings.Join(values, ",")))
-            }
-        }
-        sort.Strings(params)
-        components = append(components, strings.Join(params, "&"))
-    }
-
-    // Add relevant headers
-    relevantHeaders := []string{}
-    for key, values := range r.Header {
-        if !contains(config.ExcludeHeaders, key) {
-            sort.Strings(values)
-            relevantHeaders = append(relevantHeaders, fmt.Sprintf("%s=%s", key, strings.Join(values, ",")))
-        }
-    }
-    if len(relevantHeaders) > 0 {
-        sort.Strings(relevantHeaders)
-        components = append(components, strings.Join(relevantHeaders, "&"))
-    }
-
-    // Generate SHA-256 hash of the combined string
-    hasher := sha256.New()
-    hasher.Write([]byte(strings.Join(components, "|")))
-    return "cache:" + hex.EncodeToString(hasher.Sum(nil))
-}
-
-func contains(slice []string, item string) bool {
-    for _, s := range slice {
-        if strings.EqualFold(s, item) {
-            return true
-        }
-    }
-    return false
-}
-
-// CacheInvalidator invalidates cache entries based on patterns
-func CacheInvalidator(cacheManager *cache.CacheManager) gin.HandlerFunc {
-    return func(c *gin.Context) {
-        c.Next()
-
-        // Only invalidate cache on successful write operations
-        if c.Writer.Status() >= 200 && c.Writer.Status() < 300 {
-            switch c.Request.Method {
-            case "POST", "PUT", "PATCH", "DELETE":
-                pattern := fmt.Sprintf("cache:%s:*", c.Request.URL.Path)
-                cacheManager.Clear(c.Request.Context(), pattern)
-            }
-        }
-    }
+// ResponseCacheHeader reports whether the gateway answered a request from its
+// response cache: HIT or MISS. Requests the cache does not apply to have none.
+const ResponseCacheHeader = "X-Gateway-Cache"
+
+// DefaultResponseCacheTTL is how long responses are cached unless configured
+// otherwise. It is short since only product events invalidate entries
+// early; other changes show after at most a TTL.
+const DefaultResponseCacheTTL = 30 * time.Second
+
+// DefaultPublicCacheTTL is how long the responses of the public catalog API
+// are cached unless configured otherwise. Partners read it in bulk and can
+// do with slightly stale data; product events still invalidate entries.
+const DefaultPublicCacheTTL = 10 * time.Minute
+
+// maxCachedResponseBytes bounds the responses that are cached
+const maxCachedResponseBytes = 1 << 20
+
+// Route groups of the response cache. Each group of a store has a
+// generation, part of the keys of its entries, so that bumping it drops
+// every entry of the group at once.
+const (
+	CacheGroupProducts   = "products"
+	CacheGroupCategories = "categories"
+	CacheGroupBrands     = "brands"
+)
+
+// ResponseCacheStore keeps cached responses and the generations of the
+// groups of each store
+type ResponseCacheStore interface {
+	// Generation returns the current generation of a group of a store
+	Generation(ctx context.Context, tenantID, group string) (int64, error)
+	// Bump moves groups of a store to a new generation
+	Bump(ctx context.Context, tenantID string, groups ...string) error
+	// Get returns a cached response, nil when there is none
+	Get(ctx context.Context, key string) ([]byte, error)
+	Set(ctx context.Context, key string, body []byte, ttl time.Duration) error
+}
+
+// ResponseCache caches the successful responses of anonymous GETs on catalog
+// routes. Entries are keyed by store, path, query, locale and currency, and
+// are dropped when the product service reports a product change or when an
+// admin changes the catalog through the gateway. A nil cache caches nothing.
+type ResponseCache struct {
+	store  ResponseCacheStore
+	ttl    time.Duration
+	logger *zap.Logger
+}
+
+// NewResponseCache creates a response cache, with DefaultResponseCacheTTL
+// when ttl is not positive
+func NewResponseCache(store ResponseCacheStore, ttl time.Duration, logger *zap.Logger) *ResponseCache {
+	if ttl <= 0 {
+		ttl = DefaultResponseCacheTTL
+	}
+	return &ResponseCache{
+		store:  store,
+		ttl:    ttl,
+		logger: logger.Named("response_cache"),
+	}
+}
+
+// Middleware serves the anonymous GETs of the routes behind it from the
+// cache of group, caching the responses of misses. The cache fails open: an
+// unavailable store only costs the calls to the services.
+func (rc *ResponseCache) Middleware(group string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if rc == nil || !isCacheableRequest(c) {
+			c.Next()
+			return
+		}
+
+		ctx := c.Request.Context()
+		tenantID := tenant.FromContext(ctx)
+		generation, err := rc.store.Generation(ctx, tenantID, group)
+		if err != nil {
+			rc.logger.Warn("Failed to read response cache generation", zap.String("group", group), zap.Error(err))
+			c.Next()
+			return
+		}
+		key := responseCacheKey(c.Request, tenantID, group, generation)
+
+		if body, err := rc.store.Get(ctx, key); err != nil {
+			rc.logger.Warn("Failed to read cached response", zap.Error(err))
+		} else if body != nil {
+			c.Header(ResponseCacheHeader, "HIT")
+			c.Data(http.StatusOK, "application/json; charset=utf-8", body)
+			c.Abort()
+			return
+		}
+
+		c.Header(ResponseCacheHeader, "MISS")
+		writer := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+
+		if writer.Status() != http.StatusOK || writer.overflow || !strings.HasPrefix(writer.Header().Get("Content-Type"), "application/json") {
+			return
+		}
+		if err := rc.store.Set(ctx, key, writer.body.Bytes(), rc.ttl); err != nil {
+			rc.logger.Warn("Failed to cache response", zap.Error(err))
+		}
+	}
+}
+
+// Invalidator drops the cached responses of groups after the successful
+// writes of the routes behind it, for catalog changes the product service
+// does not report, such as brand and category changes
+func (rc *ResponseCache) Invalidator(groups ...string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if rc == nil || c.Request.Method == http.MethodGet || c.Writer.Status() >= http.StatusBadRequest {
+			return
+		}
+		rc.Invalidate(c.Request.Context(), tenant.FromContext(c.Request.Context()), groups...)
+	}
+}
+
+// Invalidate drops the cached responses of groups of a store
+func (rc *ResponseCache) Invalidate(ctx context.Context, tenantID string, groups ...string) {
+	if rc == nil {
+		return
+	}
+	if err := rc.store.Bump(ctx, tenantID, groups...); err != nil {
+		rc.logger.Warn("Failed to invalidate cached responses",
+			zap.String("tenant_id", tenantID), zap.Strings("groups", groups), zap.Error(err))
+	}
+}
+
+// isCacheableRequest reports whether a request is an anonymous GET whose
+// response may be shared with other anonymous visitors
+func isCacheableRequest(c *gin.Context) bool {
+	if c.Request.Method != http.MethodGet || c.GetHeader("Authorization") != "" || c.GetHeader("X-Admin-Key") != "" {
+		return false
+	}
+	// Debug output and pinned builds are not shared
+	if c.Query("debug") == "true" || c.GetHeader(canary.Header) != "" {
+		return false
+	}
+	return !requestsNoCache(c.GetHeader("Cache-Control"))
+}
+
+// responseCacheKey identifies a response by store, group generation, path,
+// query and the locale preferences the services localize responses with
+func responseCacheKey(r *http.Request, tenantID, group string, generation int64) string {
+	query := r.URL.Query()
+	params := make([]string, 0, len(query))
+	for name, values := range query {
+		sorted := append([]string(nil), values...)
+		sort.Strings(sorted)
+		params = append(params, name+"="+strings.Join(sorted, ","))
+	}
+	sort.Strings(params)
+
+	prefs := locale.FromContext(r.Context())
+	hasher := sha256.New()
+	hasher.Write([]byte(strings.Join([]string{
+		r.URL.Path,
+		strings.Join(params, "&"),
+		prefs.Locale, prefs.Currency, prefs.Country,
+	}, "|")))
+	return "respcache:" + tenantID + ":" + group + ":" + strconv.FormatInt(generation, 10) + ":" + hex.EncodeToString(hasher.Sum(nil))
+}
+
+// responseRecorder keeps a copy of the body written, up to
+// maxCachedResponseBytes
+type responseRecorder struct {
+	gin.ResponseWriter
+	body     bytes.Buffer
+	overflow bool
+}
+
+func (w *responseRecorder) Write(b []byte) (int, error) {
+	if !w.overflow {
+		if w.body.Len()+len(b) > maxCachedResponseBytes {
+			w.overflow = true
+			w.body.Reset()
+		} else {
+			w.body.Write(b)
+		}
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// RedisResponseCacheStore keeps the cached responses in Redis so that every
+// gateway shares them
+type RedisResponseCacheStore struct {
+	client *redis.Client
+}
+
+// NewRedisResponseCacheStore creates a store on the given Redis client
+func NewRedisResponseCacheStore(client *redis.Client) *RedisResponseCacheStore {
+	return &RedisResponseCacheStore{client: client}
+}
+
+func generationKey(tenantID, group string) string {
+	return "respcache:gen:" + tenantID + ":" + group
+}
+
+func (s *RedisResponseCacheStore) Generation(ctx context.Context, tenantID, group string) (int64, error) {
+	generation, err := s.client.Get(ctx, generationKey(tenantID, group)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return generation, err
+}
+
+func (s *RedisResponseCacheStore) Bump(ctx context.Context, tenantID string, groups ...string) error {
+	pipe := s.client.Pipeline()
+	for _, group := range groups {
+		pipe.Incr(ctx, generationKey(tenantID, group))
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (s *RedisResponseCacheStore) Get(ctx context.Context, key string) ([]byte, error) {
+	body, err := s.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	return body, err
+}
+
+func (s *RedisResponseCacheStore) Set(ctx context.Context, key string, body []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, key, body, ttl).Err()
 }
@@ -0,0 +1,129 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+)
+
+// CacheStatusHeader reports whether the services answered a request from
+// their caches: HIT, MISS or BYPASS. Requests that read no cache have none.
+const CacheStatusHeader = "X-Cache"
+
+// CacheControl sets the X-Cache header of responses and lets admins bypass
+// the service caches with Cache-Control: no-cache. Other clients' no-cache
+// is ignored, so that it cannot be used to load the databases. It must run
+// after the tenant is resolved.
+func CacheControl() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, recorder := cachectl.WithRecorder(c.Request.Context())
+		if requestsNoCache(c.GetHeader("Cache-Control")) && isAdminRequest(c) {
+			ctx = cachectl.WithBypass(ctx)
+		}
+		c.Request = c.Request.WithContext(ctx)
+		c.Writer = &cacheStatusWriter{ResponseWriter: c.Writer, recorder: recorder}
+
+		c.Next()
+	}
+}
+
+// PublicCacheControl lets browsers, CDNs and the proxies of partners cache
+// the successful responses of the routes behind it for maxAge. Other
+// responses, such as errors and refused requests, are not cacheable.
+func PublicCacheControl(maxAge time.Duration) gin.HandlerFunc {
+	value := "public, max-age=" + strconv.Itoa(int(maxAge/time.Second))
+	return func(c *gin.Context) {
+		c.Writer = &publicCacheWriter{ResponseWriter: c.Writer, value: value}
+		c.Next()
+	}
+}
+
+// requestsNoCache reports whether a Cache-Control header has the no-cache
+// directive
+func requestsNoCache(header string) bool {
+	for _, directive := range strings.Split(header, ",") {
+		if strings.EqualFold(strings.TrimSpace(directive), "no-cache") {
+			return true
+		}
+	}
+	return false
+}
+
+// isAdminRequest reports whether a request carries a valid admin token of
+// its store. Unlike AuthRequired, it rejects nothing.
+func isAdminRequest(c *gin.Context) bool {
+	token, ok := strings.CutPrefix(c.GetHeader("Authorization"), "Bearer ")
+	if !ok || jwtKeys == nil {
+		return false
+	}
+	claims, err := validateToken(c.Request.Context(), token, jwtKeys)
+	if err != nil {
+		return false
+	}
+	tokenTenant, _ := claims["tenant_id"].(string)
+	if tokenTenant == "" {
+		tokenTenant = tenant.DefaultTenantID
+	}
+	role, _ := claims["role"].(string)
+	return tokenTenant == tenant.FromContext(c.Request.Context()) && role == "admin"
+}
+
+// cacheStatusWriter sets the X-Cache header just before the headers are
+// written, once the handler has made its calls
+type cacheStatusWriter struct {
+	gin.ResponseWriter
+	recorder *cachectl.Recorder
+}
+
+func (w *cacheStatusWriter) setHeader() {
+	if w.ResponseWriter.Written() {
+		return
+	}
+	if status := w.recorder.Status(); status != "" {
+		w.Header().Set(CacheStatusHeader, string(status))
+	}
+}
+
+func (w *cacheStatusWriter) WriteHeaderNow() {
+	w.setHeader()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *cacheStatusWriter) Write(b []byte) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *cacheStatusWriter) WriteString(s string) (int, error) {
+	w.setHeader()
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *cacheStatusWriter) Flush() {
+	w.setHeader()
+	w.ResponseWriter.Flush()
+}
+
+// publicCacheWriter sets the Cache-Control header of successful responses
+// just before the headers are written
+type publicCacheWriter struct {
+	gin.ResponseWriter
+	value string
+}
+
+func (w *publicCacheWriter) WriteHeader(code int) {
+	if !w.ResponseWriter.Written() {
+		if code == http.StatusOK {
+			w.Header().Set("Cache-Control", w.value)
+		} else {
+			w.Header().Set("Cache-Control", "no-store")
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,115 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
+	"github.com/louai60/e-commerce_project/backend/common/jwks"
+)
+
+func TestCacheControl(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	keys := NewKeySet(func(context.Context) (jwks.Set, error) {
+		return jwks.Set{Keys: []jwks.Key{jwks.FromPublicKey("k1", &key.PublicKey)}}, nil
+	}, zap.NewNop())
+	if err := keys.Refresh(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	previous := jwtKeys
+	SetKeySet(keys)
+	defer SetKeySet(previous)
+
+	sign := func(role string) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"user_id": uuid.NewString(), "email": "a@example.com", "username": "a",
+			"user_type": "customer", "role": role, "type": "access",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		token.Header["kid"] = "k1"
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	router := gin.New()
+	router.Use(CacheControl())
+	router.GET("/products", func(c *gin.Context) {
+		// Stands in for a gRPC call answered from a service cache
+		if cachectl.Bypassed(c.Request.Context()) {
+			cachectl.Record(c.Request.Context(), cachectl.StatusBypass)
+		} else {
+			cachectl.Record(c.Request.Context(), cachectl.StatusHit)
+		}
+		c.JSON(http.StatusOK, gin.H{"products": []string{}})
+	})
+	router.GET("/health", func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+
+	tests := []struct {
+		name         string
+		path         string
+		cacheControl string
+		token        string
+		expected     string
+	}{
+		{"cached", "/products", "", "", "HIT"},
+		{"anonymous no-cache", "/products", "no-cache", "", "HIT"},
+		{"customer no-cache", "/products", "no-cache", sign("user"), "HIT"},
+		{"admin", "/products", "max-age=0", sign("admin"), "HIT"},
+		{"admin no-cache", "/products", "max-age=0, no-cache", sign("admin"), "BYPASS"},
+		{"no lookup", "/health", "", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.cacheControl != "" {
+				req.Header.Set("Cache-Control", tt.cacheControl)
+			}
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			if got := w.Header().Get(CacheStatusHeader); got != tt.expected {
+				t.Errorf("%s = %q, want %q", CacheStatusHeader, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPublicCacheControl(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/items/:id", PublicCacheControl(10*time.Minute), func(c *gin.Context) {
+		if c.Param("id") == "missing" {
+			c.JSON(http.StatusNotFound, gin.H{"error": "not found"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"id": c.Param("id")})
+	})
+
+	for path, want := range map[string]string{
+		"/items/1":       "public, max-age=600",
+		"/items/missing": "no-store",
+	} {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if got := w.Header().Get("Cache-Control"); got != want {
+			t.Errorf("GET %s Cache-Control = %q, want %q", path, got, want)
+		}
+	}
+}
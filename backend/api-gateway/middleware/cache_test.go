@@ -0,0 +1,107 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+)
+
+// memoryResponseCacheStore is a ResponseCacheStore in memory, ignoring TTLs
+type memoryResponseCacheStore struct {
+	generations map[string]int64
+	entries     map[string][]byte
+}
+
+func newMemoryResponseCacheStore() *memoryResponseCacheStore {
+	return &memoryResponseCacheStore{generations: map[string]int64{}, entries: map[string][]byte{}}
+}
+
+func (s *memoryResponseCacheStore) Generation(_ context.Context, tenantID, group string) (int64, error) {
+	return s.generations[generationKey(tenantID, group)], nil
+}
+
+func (s *memoryResponseCacheStore) Bump(_ context.Context, tenantID string, groups ...string) error {
+	for _, group := range groups {
+		s.generations[generationKey(tenantID, group)]++
+	}
+	return nil
+}
+
+func (s *memoryResponseCacheStore) Get(_ context.Context, key string) ([]byte, error) {
+	return s.entries[key], nil
+}
+
+func (s *memoryResponseCacheStore) Set(_ context.Context, key string, body []byte, _ time.Duration) error {
+	s.entries[key] = body
+	return nil
+}
+
+func TestResponseCache(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	cache := NewResponseCache(newMemoryResponseCacheStore(), time.Minute, zap.NewNop())
+
+	calls := 0
+	r := gin.New()
+	brands := r.Group("/api/v1/brands", cache.Invalidator(CacheGroupBrands))
+	brands.GET("", cache.Middleware(CacheGroupBrands), func(c *gin.Context) {
+		calls++
+		c.JSON(http.StatusOK, gin.H{"calls": calls})
+	})
+	brands.POST("", func(c *gin.Context) { c.Status(http.StatusCreated) })
+
+	get := func(header, value string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/brands?page=1", nil)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+	expect := func(w *httptest.ResponseRecorder, cacheHeader, body string) {
+		t.Helper()
+		if got := w.Header().Get(ResponseCacheHeader); got != cacheHeader {
+			t.Errorf("%s = %q, want %q", ResponseCacheHeader, got, cacheHeader)
+		}
+		if w.Body.String() != body {
+			t.Errorf("body = %s, want %s", w.Body.String(), body)
+		}
+	}
+
+	expect(get("", ""), "MISS", `{"calls":1}`)
+	expect(get("", ""), "HIT", `{"calls":1}`)
+
+	// Signed-in, admin and uncached requests go to the services
+	expect(get("Authorization", "Bearer token"), "", `{"calls":2}`)
+	expect(get("X-Admin-Key", "key"), "", `{"calls":3}`)
+	expect(get("Cache-Control", "no-cache"), "", `{"calls":4}`)
+	expect(get("", ""), "HIT", `{"calls":1}`)
+
+	// A write drops the cached responses of the group
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/brands", nil))
+	expect(get("", ""), "MISS", `{"calls":5}`)
+	expect(get("", ""), "HIT", `{"calls":5}`)
+
+	cache.Invalidate(context.Background(), tenant.DefaultTenantID, CacheGroupBrands)
+	expect(get("", ""), "MISS", `{"calls":6}`)
+}
+
+func TestResponseCacheKey(t *testing.T) {
+	key := func(target string) string {
+		return responseCacheKey(httptest.NewRequest(http.MethodGet, target, nil), "store", CacheGroupProducts, 1)
+	}
+	if key("/api/v1/products?page=1&limit=10") != key("/api/v1/products?limit=10&page=1") {
+		t.Error("query parameter order changed the key")
+	}
+	if key("/api/v1/products?page=1") == key("/api/v1/products?page=2") {
+		t.Error("different queries share a key")
+	}
+}
@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/api-gateway/internal/canary"
+)
+
+// CanaryRouting assigns the request to the stable or canary builds of the
+// services, by its X-Canary header or else by the bucket of its shopper. Run
+// it after the guest session middleware so that guests keep their bucket.
+func CanaryRouting() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		mode := canary.ParseMode(c.GetHeader(canary.Header))
+		c.Request = c.Request.WithContext(canary.WithRouting(c.Request.Context(), mode, ShopperID(c)))
+		c.Next()
+	}
+}
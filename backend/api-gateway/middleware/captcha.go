@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// CaptchaVerifier checks the token a client got by solving a CAPTCHA
+type CaptchaVerifier interface {
+	// Verify reports whether response is a valid solution, sent from remoteIP
+	Verify(ctx context.Context, response, remoteIP string) (bool, error)
+}
+
+// CaptchaVerifierFunc adapts a function to CaptchaVerifier
+type CaptchaVerifierFunc func(ctx context.Context, response, remoteIP string) (bool, error)
+
+func (f CaptchaVerifierFunc) Verify(ctx context.Context, response, remoteIP string) (bool, error) {
+	return f(ctx, response, remoteIP)
+}
+
+// SiteVerifyCaptcha verifies tokens with a siteverify endpoint, the API shared
+// by reCAPTCHA, hCaptcha and Cloudflare Turnstile
+type SiteVerifyCaptcha struct {
+	URL    string
+	Secret string
+	Client *http.Client
+}
+
+// NewSiteVerifyCaptcha creates a verifier for the siteverify endpoint at url
+func NewSiteVerifyCaptcha(url, secret string) *SiteVerifyCaptcha {
+	return &SiteVerifyCaptcha{
+		URL:    url,
+		Secret: secret,
+		Client: &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (v *SiteVerifyCaptcha) Verify(ctx context.Context, response, remoteIP string) (bool, error) {
+	form := url.Values{"secret": {v.Secret}, "response": {response}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.URL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := v.Client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("failed to verify captcha: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("captcha verification returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Success bool `json:"success"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return false, fmt.Errorf("failed to decode captcha verification: %w", err)
+	}
+	return result.Success, nil
+}
@@ -0,0 +1,200 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+// DefaultCompressionMinSize is the body size under which responses are sent
+// uncompressed, as compression would save little or even grow them
+const DefaultCompressionMinSize = 1024
+
+// brotliLevel trades ratio for speed on dynamic responses; higher levels
+// cost much more CPU for little gain
+const brotliLevel = 5
+
+// Content codings the gateway compresses responses with
+const (
+	encodingBrotli = "br"
+	encodingGzip   = "gzip"
+)
+
+// encoder is a compressing writer that can be reused for other responses
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+	Reset(io.Writer)
+}
+
+// Compression compresses the responses of clients accepting the br or gzip
+// encoding, with Brotli unless the client prefers gzip. Bodies are buffered
+// up to minSize bytes to decide: smaller responses and responses already
+// encoded or in a compressed format are sent as is.
+func Compression(minSize int) gin.HandlerFunc {
+	pools := map[string]*sync.Pool{
+		encodingBrotli: {New: func() any { return brotli.NewWriterLevel(io.Discard, brotliLevel) }},
+		encodingGzip:   {New: func() any { return gzip.NewWriter(io.Discard) }},
+	}
+
+	return func(c *gin.Context) {
+		encoding := negotiateEncoding(c.GetHeader("Accept-Encoding"))
+		if encoding == "" || c.GetHeader("Upgrade") != "" {
+			c.Next()
+			return
+		}
+
+		c.Writer.Header().Add("Vary", "Accept-Encoding")
+		writer := &compressWriter{ResponseWriter: c.Writer, encoding: encoding, pool: pools[encoding], minSize: minSize}
+		c.Writer = writer
+
+		c.Next()
+
+		writer.close()
+	}
+}
+
+// negotiateEncoding picks the coding of an Accept-Encoding header with the
+// highest q-value among br and gzip, preferring br on ties, or "" when the
+// header allows neither. A "*" coding stands for those not listed.
+func negotiateEncoding(header string) string {
+	weights := make(map[string]float64)
+	for _, part := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		coding = strings.ToLower(strings.TrimSpace(coding))
+		if coding == "" {
+			continue
+		}
+		weight := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			weight = parsed
+		}
+		weights[coding] = weight
+	}
+
+	best, bestWeight := "", 0.0
+	for _, coding := range []string{encodingBrotli, encodingGzip} {
+		weight, ok := weights[coding]
+		if !ok {
+			weight = weights["*"]
+		}
+		if weight > bestWeight {
+			best, bestWeight = coding, weight
+		}
+	}
+	return best
+}
+
+// compressedContentTypes are formats that compression cannot shrink further
+var compressedContentTypes = []string{
+	"image/", "video/", "audio/",
+	"application/zip", "application/gzip", "application/pdf", "application/octet-stream",
+}
+
+type compressWriter struct {
+	gin.ResponseWriter
+	encoding string
+	pool     *sync.Pool
+	minSize  int
+
+	buf     []byte
+	decided bool
+	enc     encoder
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	if w.decided {
+		if w.enc != nil {
+			return w.enc.Write(b)
+		}
+		return w.ResponseWriter.Write(b)
+	}
+
+	w.buf = append(w.buf, b...)
+	if len(w.buf) < w.minSize {
+		return len(b), nil
+	}
+	if err := w.start(true); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Flush sends the buffered body, compressed, so that streamed responses
+// reach the client as they are written
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		w.start(true)
+	}
+	if w.enc != nil {
+		w.enc.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// start decides whether the response is compressed and writes the buffered
+// body
+func (w *compressWriter) start(compress bool) error {
+	w.decided = true
+	buf := w.buf
+	w.buf = nil
+
+	if compress && w.compressible() {
+		header := w.Header()
+		header.Set("Content-Encoding", w.encoding)
+		header.Del("Content-Length")
+
+		w.enc = w.pool.Get().(encoder)
+		w.enc.Reset(w.ResponseWriter)
+		if len(buf) == 0 {
+			return nil
+		}
+		_, err := w.enc.Write(buf)
+		return err
+	}
+
+	if len(buf) == 0 {
+		return nil
+	}
+	_, err := w.ResponseWriter.Write(buf)
+	return err
+}
+
+func (w *compressWriter) compressible() bool {
+	if w.Header().Get("Content-Encoding") != "" {
+		return false
+	}
+	contentType := w.Header().Get("Content-Type")
+	for _, prefix := range compressedContentTypes {
+		if strings.HasPrefix(contentType, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// close sends responses smaller than minSize uncompressed and terminates the
+// compressed stream of the others
+func (w *compressWriter) close() {
+	if !w.decided {
+		w.start(false)
+	}
+	if w.enc != nil {
+		w.enc.Close()
+		w.pool.Put(w.enc)
+		w.enc = nil
+	}
+}
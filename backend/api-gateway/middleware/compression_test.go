@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/andybalholm/brotli"
+	"github.com/gin-gonic/gin"
+)
+
+func TestCompression(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	large := strings.Repeat("product ", 512)
+
+	router := gin.New()
+	router.Use(Compression(DefaultCompressionMinSize))
+	router.GET("/large", func(c *gin.Context) { c.String(http.StatusOK, large) })
+	router.GET("/small", func(c *gin.Context) { c.String(http.StatusOK, "ok") })
+	router.GET("/image", func(c *gin.Context) { c.Data(http.StatusOK, "image/png", []byte(large)) })
+
+	tests := []struct {
+		path           string
+		acceptEncoding string
+		wantEncoding   string
+	}{
+		{"/large", "gzip, deflate, br", "br"},
+		{"/large", "gzip", "gzip"},
+		{"/large", "br;q=0.5, gzip", "gzip"},
+		{"/large", "br;q=0, gzip;q=0", ""},
+		{"/large", "", ""},
+		{"/small", "gzip, br", ""},
+		{"/image", "br", ""},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		req.Header.Set("Accept-Encoding", tt.acceptEncoding)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		gotEncoding := w.Header().Get("Content-Encoding")
+		if gotEncoding != tt.wantEncoding {
+			t.Errorf("GET %s with Accept-Encoding %q: encoding = %q, want %q", tt.path, tt.acceptEncoding, gotEncoding, tt.wantEncoding)
+			continue
+		}
+
+		var reader io.Reader = w.Body
+		switch gotEncoding {
+		case "gzip":
+			zr, err := gzip.NewReader(w.Body)
+			if err != nil {
+				t.Fatalf("GET %s: %v", tt.path, err)
+			}
+			reader = zr
+		case "br":
+			reader = brotli.NewReader(w.Body)
+		}
+		b, err := io.ReadAll(reader)
+		if err != nil {
+			t.Fatalf("GET %s: %v", tt.path, err)
+		}
+		body := string(b)
+		if want := map[string]string{"/large": large, "/small": "ok", "/image": large}[tt.path]; body != want {
+			t.Errorf("GET %s with Accept-Encoding %q: body of %d bytes, want %d", tt.path, tt.acceptEncoding, len(body), len(want))
+		}
+	}
+}
+
+func TestNegotiateEncoding(t *testing.T) {
+	for header, want := range map[string]string{
+		"br, gzip":             "br",
+		"gzip, br":             "br",
+		"gzip;q=1, br;q=0.8":   "gzip",
+		"br;q=0.8, gzip;q=0.8": "br",
+		"*":                    "br",
+		"*;q=0.5, gzip":        "gzip",
+		"br;q=0, *":            "gzip",
+		"deflate":              "",
+		"identity, gzip;q=0":   "",
+		"br;q=abc, gzip;q=0.1": "gzip",
+	} {
+		if got := negotiateEncoding(header); got != want {
+			t.Errorf("negotiateEncoding(%q) = %q, want %q", header, got, want)
+		}
+	}
+}
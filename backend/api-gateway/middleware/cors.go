@@ -1,39 +1,264 @@
 package middleware
 
 import (
-    "github.com/gin-contrib/cors"
-    "github.com/gin-gonic/gin"
-    "time"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+)
+
+// Names of the CORS policies of the gateway
+const (
+	CORSPolicyAdmin      = "admin"
+	CORSPolicyPublic     = "public"
+	CORSPolicyStorefront = "storefront"
 )
 
-func CORSMiddleware() gin.HandlerFunc {
-    return cors.New(cors.Config{
-        AllowOrigins: []string{
-            "http://localhost:3000",
-            "http://localhost:3001",
-            "http://127.0.0.1:3000",
-        },
-        AllowMethods: []string{
-            "GET",
-            "POST",
-            "PUT",
-            "PATCH",
-            "DELETE",
-            "OPTIONS",
-        },
-        AllowHeaders: []string{
-            "Origin",
-            "Content-Type",
-            "Content-Length",
-            "Accept",
-            "Authorization",
-            "X-Requested-With",
-            "X-Admin-Key",
-        },
-        ExposeHeaders: []string{
-            "Content-Length",
-        },
-        AllowCredentials: true,
-        MaxAge: 12 * time.Hour,
-    })
+// CORSPolicy is the CORS configuration of a group of routes
+type CORSPolicy struct {
+	Name string
+	// PathPrefixes are the paths the policy applies to; the policy with the
+	// longest matching prefix applies, and a policy without prefixes applies
+	// to the paths no other policy matches
+	PathPrefixes []string
+	// AllowOrigins are exact origins such as https://shop.example.com,
+	// wildcard subdomains such as https://*.example.com, or "*" for any
+	// origin, which cannot be combined with credentials
+	AllowOrigins     []string
+	AllowCredentials bool
+	AllowMethods     []string
+	AllowHeaders     []string
+	ExposeHeaders    []string
+	MaxAge           time.Duration
+}
+
+var defaultCORSMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+
+var defaultCORSHeaders = []string{
+	"Origin",
+	"Content-Type",
+	"Content-Length",
+	"Accept",
+	"Authorization",
+	"X-Requested-With",
+	"X-Tenant-ID",
+	"X-Canary",
+	"Cache-Control",
+}
+
+var defaultCORSExposeHeaders = []string{"Content-Length", CacheStatusHeader, ResponseCacheHeader}
+
+// DefaultCORSPolicies are the policies of local development: the admin
+// dashboard origin alone may call the admin routes, with the admin key
+// header; the storefront routes, which the dashboard also manages the
+// catalog through, admit both apps; and the public catalog API admits any
+// origin without credentials.
+func DefaultCORSPolicies() []CORSPolicy {
+	adminOrigins := []string{"http://localhost:3001", "http://127.0.0.1:3001"}
+	return []CORSPolicy{
+		{
+			Name:             CORSPolicyAdmin,
+			PathPrefixes:     []string{"/api/v1/admin", "/debug"},
+			AllowOrigins:     adminOrigins,
+			AllowCredentials: true,
+			AllowMethods:     defaultCORSMethods,
+			AllowHeaders:     append(append([]string(nil), defaultCORSHeaders...), "X-Admin-Key"),
+			ExposeHeaders:    defaultCORSExposeHeaders,
+			MaxAge:           12 * time.Hour,
+		},
+		{
+			Name:         CORSPolicyPublic,
+			PathPrefixes: []string{"/api/v1/public"},
+			AllowOrigins: []string{"*"},
+			AllowMethods: []string{"GET", "OPTIONS"},
+			AllowHeaders: []string{"Origin", "Accept", "Cache-Control"},
+			ExposeHeaders: append(append([]string(nil), defaultCORSExposeHeaders...),
+				RateLimitLimitHeader, RateLimitRemainingHeader, RateLimitResetHeader, "Retry-After"),
+			MaxAge: 24 * time.Hour,
+		},
+		{
+			Name:             CORSPolicyStorefront,
+			AllowOrigins:     append([]string{"http://localhost:3000", "http://127.0.0.1:3000"}, adminOrigins...),
+			AllowCredentials: true,
+			AllowMethods:     defaultCORSMethods,
+			AllowHeaders:     append(append([]string(nil), defaultCORSHeaders...), "X-Admin-Key"),
+			ExposeHeaders:    defaultCORSExposeHeaders,
+			MaxAge:           12 * time.Hour,
+		},
+	}
+}
+
+// CORSPoliciesFromEnv overrides the default policies with the environment:
+// CORS_<NAME>_ORIGINS replaces the origins of a policy with a comma separated
+// list, CORS_<NAME>_CREDENTIALS sets whether credentials are allowed, and
+// CORS_<NAME>_HEADERS adds comma separated request headers, where NAME is
+// ADMIN, STOREFRONT or PUBLIC
+func CORSPoliciesFromEnv(getenv func(string) string) ([]CORSPolicy, error) {
+	policies := DefaultCORSPolicies()
+	for i := range policies {
+		policy := &policies[i]
+		prefix := "CORS_" + strings.ToUpper(policy.Name) + "_"
+
+		if origins := splitList(getenv(prefix + "ORIGINS")); len(origins) > 0 {
+			policy.AllowOrigins = origins
+		}
+		if value := getenv(prefix + "CREDENTIALS"); value != "" {
+			credentials, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %sCREDENTIALS %q", prefix, value)
+			}
+			policy.AllowCredentials = credentials
+		}
+		policy.AllowHeaders = append(policy.AllowHeaders, splitList(getenv(prefix+"HEADERS"))...)
+
+		if err := policy.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return policies, nil
+}
+
+// CORS answers the preflight requests and sets the CORS headers of responses
+// with the policy of their path. It runs for every route, since preflight
+// requests do not match the routes of their group.
+func CORS(policies []CORSPolicy) (gin.HandlerFunc, error) {
+	type compiled struct {
+		prefixes []string
+		handler  gin.HandlerFunc
+	}
+
+	var fallback gin.HandlerFunc
+	handlers := make([]compiled, 0, len(policies))
+	for _, policy := range policies {
+		if err := policy.validate(); err != nil {
+			return nil, err
+		}
+		handler := cors.New(policy.config())
+		if len(policy.PathPrefixes) == 0 {
+			fallback = handler
+			continue
+		}
+		handlers = append(handlers, compiled{prefixes: policy.PathPrefixes, handler: handler})
+	}
+
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+		handler, longest := fallback, -1
+		for _, h := range handlers {
+			for _, prefix := range h.prefixes {
+				if len(prefix) > longest && hasPathPrefix(path, prefix) {
+					handler, longest = h.handler, len(prefix)
+				}
+			}
+		}
+		if handler == nil {
+			c.Next()
+			return
+		}
+		handler(c)
+	}, nil
+}
+
+func (p CORSPolicy) validate() error {
+	if len(p.AllowOrigins) == 0 {
+		return fmt.Errorf("CORS policy %s has no origins", p.Name)
+	}
+	for _, origin := range p.AllowOrigins {
+		if origin == "*" {
+			if p.AllowCredentials {
+				return fmt.Errorf("CORS policy %s cannot allow credentials from any origin", p.Name)
+			}
+			if len(p.AllowOrigins) > 1 {
+				return fmt.Errorf("CORS policy %s allows any origin and lists origins", p.Name)
+			}
+			continue
+		}
+		if _, err := parseOriginPattern(origin); err != nil {
+			return fmt.Errorf("CORS policy %s: %w", p.Name, err)
+		}
+	}
+	return nil
+}
+
+func (p CORSPolicy) config() cors.Config {
+	config := cors.Config{
+		AllowMethods:     p.AllowMethods,
+		AllowHeaders:     p.AllowHeaders,
+		ExposeHeaders:    p.ExposeHeaders,
+		AllowCredentials: p.AllowCredentials,
+		MaxAge:           p.MaxAge,
+	}
+	if len(p.AllowOrigins) == 1 && p.AllowOrigins[0] == "*" {
+		// Responses do not vary by origin, so shared caches can keep them
+		config.AllowAllOrigins = true
+		return config
+	}
+
+	patterns := make([]originPattern, len(p.AllowOrigins))
+	for i, origin := range p.AllowOrigins {
+		patterns[i], _ = parseOriginPattern(origin)
+	}
+	config.AllowOriginFunc = func(origin string) bool {
+		for _, pattern := range patterns {
+			if pattern.matches(origin) {
+				return true
+			}
+		}
+		return false
+	}
+	return config
+}
+
+// originPattern is an allowed origin. With a wildcard, it matches the
+// subdomains of host at any depth, but not host itself.
+type originPattern struct {
+	scheme   string
+	host     string
+	wildcard bool
+}
+
+func parseOriginPattern(origin string) (originPattern, error) {
+	scheme, host, ok := strings.Cut(strings.ToLower(origin), "://")
+	if !ok || (scheme != "http" && scheme != "https") || host == "" || strings.ContainsAny(host, "/?#") {
+		return originPattern{}, fmt.Errorf("invalid origin %q, want scheme://host[:port]", origin)
+	}
+	pattern := originPattern{scheme: scheme, host: host}
+	if rest, wildcard := strings.CutPrefix(host, "*."); wildcard {
+		pattern.host, pattern.wildcard = rest, true
+	}
+	if strings.Contains(pattern.host, "*") || pattern.host == "" {
+		return originPattern{}, fmt.Errorf("invalid origin %q, wildcards are only allowed as the first label", origin)
+	}
+	return pattern, nil
+}
+
+func (p originPattern) matches(origin string) bool {
+	u, err := url.Parse(strings.ToLower(origin))
+	if err != nil || u.Scheme != p.scheme || u.Host == "" {
+		return false
+	}
+	if !p.wildcard {
+		return u.Host == p.host
+	}
+	return strings.HasSuffix(u.Host, "."+p.host)
+}
+
+// hasPathPrefix reports whether path is prefix or below it
+func hasPathPrefix(path, prefix string) bool {
+	return path == prefix || strings.HasPrefix(path, strings.TrimSuffix(prefix, "/")+"/")
+}
+
+// splitList splits a comma separated list, dropping empty entries
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
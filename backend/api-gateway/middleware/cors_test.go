@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestCORSPolicies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	env := map[string]string{
+		"CORS_ADMIN_ORIGINS":      "https://admin.example.com",
+		"CORS_STOREFRONT_ORIGINS": "https://*.example.com, https://example.com",
+		"CORS_STOREFRONT_HEADERS": "X-Guest-Session",
+	}
+	policies, err := CORSPoliciesFromEnv(func(key string) string { return env[key] })
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler, err := CORS(policies)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	router := gin.New()
+	router.Use(handler)
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+	router.GET("/api/v1/admin/stores", ok)
+	router.GET("/api/v1/products", ok)
+	router.GET("/api/v1/public/products", ok)
+
+	tests := []struct {
+		name, path, origin string
+		wantOrigin         string
+		wantCredentials    bool
+	}{
+		{"admin origin on admin route", "/api/v1/admin/stores", "https://admin.example.com", "https://admin.example.com", true},
+		{"storefront origin on admin route", "/api/v1/admin/stores", "https://shop.example.com", "", false},
+		{"wildcard subdomain", "/api/v1/products", "https://shop.example.com", "https://shop.example.com", true},
+		{"nested subdomain", "/api/v1/products", "https://eu.shop.example.com", "https://eu.shop.example.com", true},
+		{"apex listed separately", "/api/v1/products", "https://example.com", "https://example.com", true},
+		{"other scheme", "/api/v1/products", "http://shop.example.com", "", false},
+		{"lookalike domain", "/api/v1/products", "https://shopexample.com", "", false},
+		{"public route", "/api/v1/public/products", "https://partner.test", "*", false},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+		req.Host = "api.example.net"
+		req.Header.Set("Origin", tt.origin)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		if got := w.Header().Get("Access-Control-Allow-Origin"); got != tt.wantOrigin {
+			t.Errorf("%s: Access-Control-Allow-Origin = %q, want %q", tt.name, got, tt.wantOrigin)
+		}
+		if got := w.Header().Get("Access-Control-Allow-Credentials") == "true"; got != tt.wantCredentials {
+			t.Errorf("%s: credentials = %v, want %v", tt.name, got, tt.wantCredentials)
+		}
+	}
+
+	// Preflight requests match no route but get the policy of their path
+	req := httptest.NewRequest(http.MethodOptions, "/api/v1/products", nil)
+	req.Host = "api.example.net"
+	req.Header.Set("Origin", "https://shop.example.com")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	req.Header.Set("Access-Control-Request-Headers", "X-Guest-Session")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Code != http.StatusNoContent || w.Header().Get("Access-Control-Allow-Origin") != "https://shop.example.com" {
+		t.Errorf("preflight = %d %q, want 204 with the origin", w.Code, w.Header().Get("Access-Control-Allow-Origin"))
+	}
+}
+
+func TestCORSPoliciesFromEnvRejectsInvalidPolicies(t *testing.T) {
+	for name, env := range map[string]map[string]string{
+		"any origin with credentials": {"CORS_STOREFRONT_ORIGINS": "*"},
+		"wildcard inside the host":    {"CORS_ADMIN_ORIGINS": "https://admin.*.example.com"},
+		"missing scheme":              {"CORS_ADMIN_ORIGINS": "admin.example.com"},
+		"invalid credentials":         {"CORS_PUBLIC_CREDENTIALS": "sometimes"},
+	} {
+		if _, err := CORSPoliciesFromEnv(func(key string) string { return env[key] }); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
+)
+
+// FeatureFlag hides the routes behind it unless the flag is on for the caller,
+// the logged in user or else the guest, so that guests keep their rollout
+// bucket across requests. fallback is used while the flag has not been
+// created yet.
+func FeatureFlag(flags *featureflags.Client, key string, fallback bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !flags.IsEnabledOr(key, ShopperID(c), fallback) {
+			c.AbortWithStatusJSON(http.StatusNotFound, gin.H{
+				"error":      "not found",
+				"request_id": GetRequestID(c),
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+)
+
+// Gin context keys holding the preferences resolved by GeoLocale
+const (
+	CountryKey  = "country"
+	CurrencyKey = "currency"
+	LocaleKey   = "locale"
+)
+
+// GeoIPProvider resolves the country of an IP address
+type GeoIPProvider interface {
+	// Country returns the ISO 3166-1 alpha-2 code of the country of ip, or
+	// "" when it is not known
+	Country(ctx context.Context, ip string) (string, error)
+}
+
+// GeoIPProviderFunc adapts a function to GeoIPProvider
+type GeoIPProviderFunc func(ctx context.Context, ip string) (string, error)
+
+func (f GeoIPProviderFunc) Country(ctx context.Context, ip string) (string, error) {
+	return f(ctx, ip)
+}
+
+// MaxMindGeoIP resolves countries with the MaxMind GeoIP2 Country web service
+type MaxMindGeoIP struct {
+	URL        string
+	AccountID  string
+	LicenseKey string
+	Client     *http.Client
+}
+
+// DefaultMaxMindURL is the endpoint of the GeoIP2 Country web service
+const DefaultMaxMindURL = "https://geoip.maxmind.com/geoip/v2.1/country/"
+
+// NewMaxMindGeoIP creates a provider for the MaxMind account
+func NewMaxMindGeoIP(accountID, licenseKey string) *MaxMindGeoIP {
+	return &MaxMindGeoIP{
+		URL:        DefaultMaxMindURL,
+		AccountID:  accountID,
+		LicenseKey: licenseKey,
+		Client:     &http.Client{Timeout: 2 * time.Second},
+	}
+}
+
+func (m *MaxMindGeoIP) Country(ctx context.Context, ip string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.URL+url.PathEscape(ip), nil)
+	if err != nil {
+		return "", err
+	}
+	req.SetBasicAuth(m.AccountID, m.LicenseKey)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := m.Client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up IP country: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Addresses missing from the database are not an error
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("IP country lookup returned status %d", resp.StatusCode)
+	}
+
+	var result struct {
+		Country struct {
+			ISOCode string `json:"iso_code"`
+		} `json:"country"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode IP country: %w", err)
+	}
+	return result.Country.ISOCode, nil
+}
+
+// CachedGeoIP remembers the countries a provider resolved, including
+// unknown ones, so that each address is looked up once per TTL. Failed
+// lookups are not cached.
+type CachedGeoIP struct {
+	provider   GeoIPProvider
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries map[string]geoIPEntry
+}
+
+type geoIPEntry struct {
+	country   string
+	expiresAt time.Time
+}
+
+// NewCachedGeoIP caches the lookups of provider for ttl, keeping at most
+// maxEntries addresses
+func NewCachedGeoIP(provider GeoIPProvider, ttl time.Duration, maxEntries int) *CachedGeoIP {
+	return &CachedGeoIP{
+		provider:   provider,
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[string]geoIPEntry),
+	}
+}
+
+func (c *CachedGeoIP) Country(ctx context.Context, ip string) (string, error) {
+	now := time.Now()
+	c.mu.Lock()
+	entry, ok := c.entries[ip]
+	c.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.country, nil
+	}
+
+	country, err := c.provider.Country(ctx, ip)
+	if err != nil {
+		return "", err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= c.maxEntries {
+		// Drop the expired entries, or everything when none has expired
+		for key, e := range c.entries {
+			if now.After(e.expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+		if len(c.entries) >= c.maxEntries {
+			c.entries = make(map[string]geoIPEntry)
+		}
+	}
+	c.entries[ip] = geoIPEntry{country: country, expiresAt: now.Add(c.ttl)}
+	return country, nil
+}
+
+// GeoLocaleConfig configures GeoLocale
+type GeoLocaleConfig struct {
+	// CountryHeader names a header with the visitor's country set by a
+	// trusted CDN, such as CF-IPCountry. It is used before the provider.
+	CountryHeader string
+	// Timeout bounds the provider lookup of a request
+	Timeout time.Duration
+}
+
+// GeoLocale resolves the country of unauthenticated visitors from their IP
+// address and sets the currency and locale of that country as the defaults
+// of the request. The preferences are stored on the gin context and on the
+// request context, so that gRPC calls forward them to the services.
+// Authenticated users, private addresses and failed lookups get no
+// preferences, and the services fall back to the defaults of the store.
+// A nil provider only uses the country header.
+func GeoLocale(provider GeoIPProvider, config GeoLocaleConfig, logger *zap.Logger) gin.HandlerFunc {
+	if config.Timeout <= 0 {
+		config.Timeout = 500 * time.Millisecond
+	}
+
+	return func(c *gin.Context) {
+		if c.GetHeader("Authorization") != "" {
+			c.Next()
+			return
+		}
+
+		country := ""
+		if config.CountryHeader != "" {
+			country = c.GetHeader(config.CountryHeader)
+		}
+		if country == "" && provider != nil {
+			if ip := net.ParseIP(c.ClientIP()); ip != nil && isPublicIP(ip) {
+				ctx, cancel := context.WithTimeout(c.Request.Context(), config.Timeout)
+				var err error
+				country, err = provider.Country(ctx, ip.String())
+				cancel()
+				if err != nil {
+					logger.Warn("Failed to resolve the country of the client IP", zap.Error(err))
+				}
+			}
+		}
+
+		prefs := locale.ForCountry(country)
+		if !prefs.IsZero() {
+			c.Set(CountryKey, prefs.Country)
+			if prefs.Currency != "" {
+				c.Set(CurrencyKey, prefs.Currency)
+			}
+			if prefs.Locale != "" {
+				c.Set(LocaleKey, prefs.Locale)
+			}
+			c.Request = c.Request.WithContext(locale.WithPreferences(c.Request.Context(), prefs))
+		}
+
+		c.Next()
+	}
+}
+
+// isPublicIP reports whether ip can be located
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsPrivate() && !ip.IsUnspecified() &&
+		!ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast()
+}
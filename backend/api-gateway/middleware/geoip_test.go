@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+)
+
+func TestGeoLocale(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	lookups := 0
+	provider := NewCachedGeoIP(GeoIPProviderFunc(func(_ context.Context, ip string) (string, error) {
+		lookups++
+		switch ip {
+		case "192.0.2.1":
+			return "FR", nil
+		case "192.0.2.2":
+			return "", errors.New("lookup failed")
+		}
+		return "", nil
+	}), time.Minute, 100)
+
+	router := gin.New()
+	router.Use(GeoLocale(provider, GeoLocaleConfig{CountryHeader: "CF-IPCountry"}, zap.NewNop()))
+	router.GET("/", func(c *gin.Context) {
+		prefs := locale.FromContext(c.Request.Context())
+		c.String(http.StatusOK, prefs.Country+"|"+prefs.Currency+"|"+prefs.Locale)
+	})
+
+	resolve := func(remoteAddr string, headers map[string]string) string {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.RemoteAddr = remoteAddr
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Body.String()
+	}
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		headers    map[string]string
+		expected   string
+	}{
+		{"resolved country", "192.0.2.1:1234", nil, "FR|EUR|fr-FR"},
+		{"trusted header", "192.0.2.1:1234", map[string]string{"CF-IPCountry": "tn"}, "TN|TND|fr-TN"},
+		{"unknown address", "192.0.2.3:1234", nil, "||"},
+		{"failed lookup", "192.0.2.2:1234", nil, "||"},
+		{"private address", "10.0.0.1:1234", nil, "||"},
+		{"authenticated user", "192.0.2.1:1234", map[string]string{"Authorization": "Bearer token"}, "||"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolve(tt.remoteAddr, tt.headers); got != tt.expected {
+				t.Errorf("preferences = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	// The first request looked 192.0.2.1 up; the others used the cache. The
+	// failed lookup is not cached.
+	before := lookups
+	resolve("192.0.2.1:1234", nil)
+	resolve("192.0.2.2:1234", nil)
+	if lookups != before+1 {
+		t.Errorf("lookups = %d, want %d", lookups-before, 1)
+	}
+}
@@ -0,0 +1,138 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// Guest sessions are carried by a cookie for browsers, or by a header for
+// clients that do not keep cookies; new sessions are returned in both
+const (
+	GuestSessionCookie = "guest_session"
+	GuestSessionHeader = "X-Guest-Session"
+)
+
+// GuestIDKey is the gin context key holding the guest session ID
+const GuestIDKey = "guest_id"
+
+// guestIDPrefix starts guest IDs so that they never collide with user IDs
+const guestIDPrefix = "guest_"
+
+// GuestSessions issues and verifies the signed sessions of shoppers who have
+// not logged in, so that their cart, wishlist, recently viewed products and
+// experiment assignments persist until they do. Sessions are tokens of the
+// form <guest ID>.<expiry>.<signature>, signed with HMAC-SHA256.
+type GuestSessions struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewGuestSessions creates guest sessions signed with secret and valid for ttl
+func NewGuestSessions(secret []byte, ttl time.Duration) *GuestSessions {
+	if ttl <= 0 {
+		ttl = 30 * 24 * time.Hour
+	}
+	return &GuestSessions{secret: secret, ttl: ttl, now: time.Now}
+}
+
+// Issue starts a new guest session and returns its ID and token
+func (g *GuestSessions) Issue() (string, string) {
+	id := guestIDPrefix + uuid.NewString()
+	payload := id + "." + strconv.FormatInt(g.now().Add(g.ttl).Unix(), 10)
+	return id, payload + "." + g.sign(payload)
+}
+
+// Verify returns the guest ID of a token, and false when the token is
+// malformed, forged or expired
+func (g *GuestSessions) Verify(token string) (string, bool) {
+	id, expiry, ok := g.parse(token)
+	if !ok || !g.now().Before(expiry) {
+		return "", false
+	}
+	return id, true
+}
+
+func (g *GuestSessions) parse(token string) (string, time.Time, bool) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], guestIDPrefix) {
+		return "", time.Time{}, false
+	}
+	payload := parts[0] + "." + parts[1]
+	if !hmac.Equal([]byte(parts[2]), []byte(g.sign(payload))) {
+		return "", time.Time{}, false
+	}
+	expiry, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	return parts[0], time.Unix(expiry, 0), true
+}
+
+func (g *GuestSessions) sign(payload string) string {
+	mac := hmac.New(sha256.New, g.secret)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Middleware stores the guest ID of requests carrying a valid guest session.
+// Invalid sessions are ignored, as if the request had none.
+func (g *GuestSessions) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := c.GetHeader(GuestSessionHeader)
+		if token == "" {
+			token, _ = c.Cookie(GuestSessionCookie)
+		}
+		if id, ok := g.Verify(token); ok {
+			c.Set(GuestIDKey, id)
+		}
+
+		c.Next()
+	}
+}
+
+// Ensure starts a guest session for anonymous requests without one, on the
+// routes that keep guest data. It must run after Middleware and after any
+// authentication of the route.
+func (g *GuestSessions) Ensure() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("user_id") == "" && GetGuestID(c) == "" {
+			id, token := g.Issue()
+			c.Set(GuestIDKey, id)
+			c.Header(GuestSessionHeader, token)
+			c.SetSameSite(http.SameSiteLaxMode)
+			c.SetCookie(GuestSessionCookie, token, int(g.ttl.Seconds()), "/", "", true, true)
+		}
+
+		c.Next()
+	}
+}
+
+// ClearGuestSession ends the guest session of a request, once its data has
+// been merged into the account the guest logged in to
+func ClearGuestSession(c *gin.Context) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(GuestSessionCookie, "", -1, "/", "", true, true)
+}
+
+// GetGuestID returns the guest session ID of a request, if any
+func GetGuestID(c *gin.Context) string {
+	return c.GetString(GuestIDKey)
+}
+
+// ShopperID identifies the shopper of a request: the logged in user, or else
+// the guest. It is empty for anonymous requests without a guest session.
+func ShopperID(c *gin.Context) string {
+	if userID := c.GetString("user_id"); userID != "" {
+		return userID
+	}
+	return GetGuestID(c)
+}
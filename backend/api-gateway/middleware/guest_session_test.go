@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestGuestSessionsVerify(t *testing.T) {
+	sessions := NewGuestSessions([]byte("secret"), time.Hour)
+	id, token := sessions.Issue()
+	if !strings.HasPrefix(id, guestIDPrefix) {
+		t.Fatalf("Issue() id = %q, want prefix %q", id, guestIDPrefix)
+	}
+
+	if got, ok := sessions.Verify(token); !ok || got != id {
+		t.Errorf("Verify() = %q, %v, want %q, true", got, ok, id)
+	}
+
+	other := NewGuestSessions([]byte("other secret"), time.Hour)
+	if _, ok := other.Verify(token); ok {
+		t.Error("Verify() accepted a token signed with another secret")
+	}
+
+	parts := strings.Split(token, ".")
+	forged := guestIDPrefix + "00000000-0000-4000-8000-000000000000." + parts[1] + "." + parts[2]
+	if _, ok := sessions.Verify(forged); ok {
+		t.Error("Verify() accepted a token with a forged ID")
+	}
+
+	sessions.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if _, ok := sessions.Verify(token); ok {
+		t.Error("Verify() accepted an expired token")
+	}
+}
+
+func TestGuestSessionsMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	sessions := NewGuestSessions([]byte("secret"), time.Hour)
+
+	router := gin.New()
+	router.Use(sessions.Middleware())
+	router.GET("/cart", sessions.Ensure(), func(c *gin.Context) {
+		c.String(http.StatusOK, ShopperID(c))
+	})
+	router.GET("/me", func(c *gin.Context) {
+		c.Set("user_id", "7d0e9a52-0000-4000-8000-000000000002")
+		c.Next()
+	}, sessions.Ensure(), func(c *gin.Context) {
+		c.String(http.StatusOK, ShopperID(c))
+	})
+
+	// A guest without a session gets one
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/cart", nil))
+	token := w.Header().Get(GuestSessionHeader)
+	guestID, ok := sessions.Verify(token)
+	if !ok || w.Body.String() != guestID {
+		t.Fatalf("new session token = %q, shopper = %q", token, w.Body.String())
+	}
+	if cookie := w.Header().Get("Set-Cookie"); !strings.HasPrefix(cookie, GuestSessionCookie+"="+token) {
+		t.Errorf("Set-Cookie = %q", cookie)
+	}
+
+	// The session is kept on later requests, from the header or the cookie
+	req := httptest.NewRequest(http.MethodGet, "/cart", nil)
+	req.Header.Set(GuestSessionHeader, token)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Body.String() != guestID || w.Header().Get(GuestSessionHeader) != "" {
+		t.Errorf("shopper with header = %q, new session = %q", w.Body.String(), w.Header().Get(GuestSessionHeader))
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/cart", nil)
+	req.AddCookie(&http.Cookie{Name: GuestSessionCookie, Value: token})
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	if w.Body.String() != guestID {
+		t.Errorf("shopper with cookie = %q, want %q", w.Body.String(), guestID)
+	}
+
+	// Users are identified by their account and get no session
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/me", nil))
+	if w.Body.String() != "7d0e9a52-0000-4000-8000-000000000002" || w.Header().Get(GuestSessionHeader) != "" {
+		t.Errorf("user shopper = %q, new session = %q", w.Body.String(), w.Header().Get(GuestSessionHeader))
+	}
+}
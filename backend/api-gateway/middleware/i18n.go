@@ -0,0 +1,102 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/common/i18n"
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+)
+
+// LocaleNegotiation picks the language of a request among the supported
+// ones from its Accept-Language header. The language overrides the locale
+// resolved by GeoLocale and is forwarded to the services, which return
+// category names and product content in it; the error messages of JSON
+// error responses are translated with the catalog. Responses vary on
+// Accept-Language and name their language in Content-Language.
+func LocaleNegotiation(catalog *i18n.Catalog, supported []string) gin.HandlerFunc {
+	if len(supported) == 0 {
+		supported = catalog.Languages()
+	}
+
+	return func(c *gin.Context) {
+		c.Writer.Header().Add("Vary", "Accept-Language")
+
+		tag := i18n.Negotiate(c.GetHeader("Accept-Language"), supported)
+		if tag == "" {
+			c.Next()
+			return
+		}
+
+		prefs := locale.FromContext(c.Request.Context())
+		prefs.Locale = tag
+		prefs = prefs.Normalize()
+		if prefs.Locale == "" {
+			c.Next()
+			return
+		}
+		c.Set(LocaleKey, prefs.Locale)
+		c.Request = c.Request.WithContext(locale.WithPreferences(c.Request.Context(), prefs))
+		c.Header("Content-Language", prefs.Locale)
+
+		writer := &translatingWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		c.Next()
+		writer.flush(catalog, prefs.Locale)
+	}
+}
+
+// translatingWriter holds back the body of JSON error responses so that
+// their error message can be translated
+type translatingWriter struct {
+	gin.ResponseWriter
+	body     bytes.Buffer
+	buffered bool
+}
+
+func (w *translatingWriter) holds() bool {
+	return w.Status() >= http.StatusBadRequest &&
+		strings.HasPrefix(w.Header().Get("Content-Type"), "application/json")
+}
+
+func (w *translatingWriter) Write(data []byte) (int, error) {
+	if w.holds() {
+		w.buffered = true
+		return w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *translatingWriter) WriteString(s string) (int, error) {
+	if w.holds() {
+		w.buffered = true
+		return w.body.WriteString(s)
+	}
+	return w.ResponseWriter.WriteString(s)
+}
+
+// flush writes the held back body with its error message translated. Bodies
+// that are not JSON objects with a string error are written unchanged.
+func (w *translatingWriter) flush(catalog *i18n.Catalog, tag string) {
+	if !w.buffered {
+		return
+	}
+	body := w.body.Bytes()
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		if message, ok := payload["error"].(string); ok {
+			if translated := catalog.Translate(tag, message); translated != message {
+				payload["error"] = translated
+				if encoded, err := json.Marshal(payload); err == nil {
+					body = encoded
+				}
+			}
+		}
+	}
+	w.ResponseWriter.Write(body)
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/common/i18n"
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+)
+
+func TestLocaleNegotiation(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	router.Use(LocaleNegotiation(i18n.Default(), nil))
+	router.GET("/missing", func(c *gin.Context) {
+		c.JSON(http.StatusNotFound, gin.H{"error": "product not found", "request_id": "abc"})
+	})
+	router.GET("/locale", func(c *gin.Context) {
+		c.String(http.StatusOK, locale.FromContext(c.Request.Context()).Locale)
+	})
+
+	get := func(path, acceptLanguage string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if acceptLanguage != "" {
+			req.Header.Set("Accept-Language", acceptLanguage)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := get("/missing", "fr-FR,fr;q=0.9,en;q=0.8")
+	if w.Code != http.StatusNotFound || w.Body.String() != `{"error":"produit introuvable","request_id":"abc"}` {
+		t.Errorf("French error = %d %s", w.Code, w.Body.String())
+	}
+	if got := w.Header().Get("Content-Language"); got != "fr-FR" {
+		t.Errorf("Content-Language = %q, want fr-FR", got)
+	}
+	if got := w.Header().Get("Vary"); got != "Accept-Language" {
+		t.Errorf("Vary = %q, want Accept-Language", got)
+	}
+
+	if w := get("/missing", "ja"); w.Body.String() != `{"error":"product not found","request_id":"abc"}` {
+		t.Errorf("unsupported language error = %s", w.Body.String())
+	}
+	if w := get("/locale", "de;q=0.9, ar"); w.Body.String() != "ar" {
+		t.Errorf("negotiated locale = %q, want ar", w.Body.String())
+	}
+}
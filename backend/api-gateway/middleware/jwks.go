@@ -0,0 +1,140 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/jwks"
+)
+
+// JWKSFetcher returns the current JWK set of the user service
+type JWKSFetcher func(ctx context.Context) (jwks.Set, error)
+
+// KeySet holds the public keys access tokens are verified with, by kid. It
+// refreshes them from the user service periodically and when a token names
+// an unknown key, so that rotated keys are picked up without a restart.
+type KeySet struct {
+	fetch  JWKSFetcher
+	logger *zap.Logger
+
+	// MinRefreshInterval limits the refreshes triggered by unknown kids, so
+	// that forged tokens cannot flood the user service
+	MinRefreshInterval time.Duration
+
+	mu          sync.RWMutex
+	keys        map[string]*rsa.PublicKey
+	lastAttempt time.Time
+	refreshMu   sync.Mutex
+}
+
+// NewKeySet creates an empty key set; call Refresh or Start to load it
+func NewKeySet(fetch JWKSFetcher, logger *zap.Logger) *KeySet {
+	return &KeySet{
+		fetch:              fetch,
+		logger:             logger.Named("jwks"),
+		MinRefreshInterval: 30 * time.Second,
+		keys:               make(map[string]*rsa.PublicKey),
+	}
+}
+
+// Start refreshes the keys every interval until ctx is done
+func (s *KeySet) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := s.Refresh(ctx); err != nil {
+				s.logger.Error("Failed to refresh JWKS", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// Refresh replaces the keys with the ones the user service serves. Keys that
+// cannot be decoded are skipped; on error the current keys are kept.
+func (s *KeySet) Refresh(ctx context.Context) error {
+	s.refreshMu.Lock()
+	defer s.refreshMu.Unlock()
+	return s.refresh(ctx)
+}
+
+func (s *KeySet) refresh(ctx context.Context) error {
+	s.mu.Lock()
+	s.lastAttempt = time.Now()
+	s.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	set, err := s.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		key, err := jwk.PublicKey()
+		if err != nil {
+			s.logger.Warn("Skipping JSON web key", zap.String("kid", jwk.Kid), zap.Error(err))
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("JWKS has no usable key")
+	}
+
+	s.mu.Lock()
+	s.keys = keys
+	s.mu.Unlock()
+	return nil
+}
+
+// Keys returns the keys to try for a token header's kid. Tokens issued
+// before key rotation carry no kid and are tried against every key.
+func (s *KeySet) Keys(ctx context.Context, kid string) []*rsa.PublicKey {
+	if keys := s.lookup(kid); len(keys) > 0 {
+		return keys
+	}
+
+	// An unknown kid is usually a key published since the last refresh
+	s.refreshMu.Lock()
+	s.mu.RLock()
+	stale := time.Since(s.lastAttempt) >= s.MinRefreshInterval
+	s.mu.RUnlock()
+	if stale {
+		if err := s.refresh(ctx); err != nil {
+			s.logger.Error("Failed to refresh JWKS for an unknown key", zap.String("kid", kid), zap.Error(err))
+		}
+	}
+	s.refreshMu.Unlock()
+
+	return s.lookup(kid)
+}
+
+func (s *KeySet) lookup(kid string) []*rsa.PublicKey {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if kid != "" {
+		if key, ok := s.keys[kid]; ok {
+			return []*rsa.PublicKey{key}
+		}
+		return nil
+	}
+	keys := make([]*rsa.PublicKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+	return keys
+}
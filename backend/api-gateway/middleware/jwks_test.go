@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/jwks"
+)
+
+func TestValidateTokenFollowsKeyRotation(t *testing.T) {
+	oldKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	newKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+
+	published := []jwks.Key{jwks.FromPublicKey("old", &oldKey.PublicKey)}
+	fetches := 0
+	keys := NewKeySet(func(context.Context) (jwks.Set, error) {
+		fetches++
+		return jwks.Set{Keys: published}, nil
+	}, zap.NewNop())
+	keys.MinRefreshInterval = 0
+	ctx := context.Background()
+	if err := keys.Refresh(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	sign := func(kid string, key *rsa.PrivateKey) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"user_id": uuid.NewString(), "email": "a@example.com", "username": "a",
+			"user_type": "customer", "role": "user", "type": "access",
+			"exp": time.Now().Add(time.Hour).Unix(),
+		})
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return signed
+	}
+
+	if _, err := validateToken(ctx, sign("old", oldKey), keys); err != nil {
+		t.Errorf("token of the current key: %v", err)
+	}
+	if _, err := validateToken(ctx, sign("", oldKey), keys); err != nil {
+		t.Errorf("token without kid: %v", err)
+	}
+
+	// A token of a key published after the last refresh triggers a refresh
+	published = append(published, jwks.FromPublicKey("new", &newKey.PublicKey))
+	if _, err := validateToken(ctx, sign("new", newKey), keys); err != nil {
+		t.Errorf("token of a newly published key: %v", err)
+	}
+	if fetches != 2 {
+		t.Errorf("fetches = %d, want 2", fetches)
+	}
+
+	if _, err := validateToken(ctx, sign("old", newKey), keys); err == nil {
+		t.Error("token signed with another key than its kid was accepted")
+	}
+}
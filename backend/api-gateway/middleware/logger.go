@@ -28,6 +28,7 @@ func Logger(logger *zap.Logger) gin.HandlerFunc {
             zap.Int("status", status),
             zap.Duration("latency", latency),
             zap.String("ip", c.ClientIP()),
+            zap.String("request_id", GetRequestID(c)),
         )
     }
 }
\ No newline at end of file
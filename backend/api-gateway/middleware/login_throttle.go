@@ -0,0 +1,297 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// CaptchaResponseHeader carries the token of a solved CAPTCHA on login
+// requests that were challenged
+const CaptchaResponseHeader = "X-Captcha-Response"
+
+// LoginAttemptStore counts the failed logins of a key over a window
+type LoginAttemptStore interface {
+	// Failures returns the failures of key and the time until they expire
+	Failures(ctx context.Context, key string) (int, time.Duration, error)
+	// RecordFailure adds a failure to key, starting a window when there is
+	// none, and returns the new count
+	RecordFailure(ctx context.Context, key string, window time.Duration) (int, error)
+	// Reset forgets the failures of key
+	Reset(ctx context.Context, key string) error
+}
+
+// LoginThrottleConfig configures the login throttle
+type LoginThrottleConfig struct {
+	// ChallengeAfter is the number of failures after which a CAPTCHA is
+	// required; zero disables challenges
+	ChallengeAfter int
+	// BlockAfter is the number of failures after which logins are refused
+	// until the window expires
+	BlockAfter int
+	// Window is how long failures are remembered after the first one
+	Window time.Duration
+	// CaptchaSiteKey is returned in challenges for the client widget
+	CaptchaSiteKey string
+}
+
+// DefaultLoginThrottleConfig challenges after 3 failures and blocks after 10
+// within 15 minutes
+func DefaultLoginThrottleConfig() LoginThrottleConfig {
+	return LoginThrottleConfig{
+		ChallengeAfter: 3,
+		BlockAfter:     10,
+		Window:         15 * time.Minute,
+	}
+}
+
+// LoginChallenge is the body of a login refused by the throttle
+type LoginChallenge struct {
+	Error string `json:"error"`
+	// Code is captcha_required, captcha_invalid or too_many_attempts
+	Code       string            `json:"code"`
+	RetryAfter int               `json:"retry_after,omitempty"`
+	Captcha    *CaptchaChallenge `json:"captcha,omitempty"`
+	RequestID  string            `json:"request_id,omitempty"`
+}
+
+// CaptchaChallenge tells the client how to present a solved CAPTCHA
+type CaptchaChallenge struct {
+	SiteKey string `json:"site_key,omitempty"`
+	// Header is the request header carrying the CAPTCHA token
+	Header string `json:"header"`
+}
+
+// LoginThrottle slows down password guessing against the login endpoint. It
+// counts failed logins per client IP and email: past a few failures the
+// client has to solve a CAPTCHA, and past more it is refused until the window
+// expires. It complements the per-IP limiter of the user service, which
+// cannot tell a single targeted account from general traffic.
+type LoginThrottle struct {
+	store    LoginAttemptStore
+	verifier CaptchaVerifier
+	config   LoginThrottleConfig
+	logger   *zap.Logger
+}
+
+// NewLoginThrottle creates a throttle. Without a verifier, clients are never
+// challenged and only the block applies.
+func NewLoginThrottle(store LoginAttemptStore, verifier CaptchaVerifier, config LoginThrottleConfig, logger *zap.Logger) *LoginThrottle {
+	return &LoginThrottle{
+		store:    store,
+		verifier: verifier,
+		config:   config,
+		logger:   logger.Named("login_throttle"),
+	}
+}
+
+// Middleware guards a login handler. Responses with status 401 count as
+// failures and a successful login clears them.
+func (t *LoginThrottle) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		key := t.key(c)
+
+		failures, ttl, err := t.store.Failures(ctx, key)
+		if err != nil {
+			// Fail open: an unavailable store must not lock everyone out
+			t.logger.Warn("Failed to read login failures", zap.Error(err))
+		}
+
+		if t.config.BlockAfter > 0 && failures >= t.config.BlockAfter {
+			retryAfter := int(ttl.Round(time.Second) / time.Second)
+			c.Header("Retry-After", strconv.Itoa(retryAfter))
+			t.refuse(c, http.StatusTooManyRequests, LoginChallenge{
+				Error:      "too many failed login attempts",
+				Code:       "too_many_attempts",
+				RetryAfter: retryAfter,
+			})
+			return
+		}
+
+		if t.verifier != nil && t.config.ChallengeAfter > 0 && failures >= t.config.ChallengeAfter {
+			response := c.GetHeader(CaptchaResponseHeader)
+			if response == "" {
+				t.refuse(c, http.StatusForbidden, t.challenge("captcha required", "captcha_required"))
+				return
+			}
+			ok, err := t.verifier.Verify(ctx, response, c.ClientIP())
+			if err != nil {
+				t.logger.Error("Failed to verify captcha", zap.Error(err))
+				c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "captcha verification unavailable", "request_id": GetRequestID(c)})
+				return
+			}
+			if !ok {
+				t.refuse(c, http.StatusForbidden, t.challenge("invalid captcha", "captcha_invalid"))
+				return
+			}
+		}
+
+		c.Next()
+
+		switch c.Writer.Status() {
+		case http.StatusUnauthorized:
+			count, err := t.store.RecordFailure(ctx, key, t.config.Window)
+			if err != nil {
+				t.logger.Warn("Failed to record login failure", zap.Error(err))
+				return
+			}
+			if count == t.config.ChallengeAfter || count == t.config.BlockAfter {
+				t.logger.Warn("Repeated login failures",
+					zap.Int("failures", count),
+					zap.String("ip", c.ClientIP()),
+					zap.String("request_id", GetRequestID(c)))
+			}
+		case http.StatusOK:
+			if failures > 0 {
+				if err := t.store.Reset(ctx, key); err != nil {
+					t.logger.Warn("Failed to reset login failures", zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+func (t *LoginThrottle) challenge(message, code string) LoginChallenge {
+	return LoginChallenge{
+		Error:   message,
+		Code:    code,
+		Captcha: &CaptchaChallenge{SiteKey: t.config.CaptchaSiteKey, Header: CaptchaResponseHeader},
+	}
+}
+
+func (t *LoginThrottle) refuse(c *gin.Context, status int, challenge LoginChallenge) {
+	challenge.RequestID = GetRequestID(c)
+	c.AbortWithStatusJSON(status, challenge)
+}
+
+// key identifies the client IP and the email of a login request. The email is
+// peeked from the JSON body, which is restored for the handler, and hashed so
+// that addresses do not end up in Redis.
+func (t *LoginThrottle) key(c *gin.Context) string {
+	var email string
+	if c.Request.Body != nil {
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, 64<<10))
+		if err == nil {
+			c.Request.Body = readCloser{io.MultiReader(bytes.NewReader(body), c.Request.Body), c.Request.Body}
+			var req struct {
+				Email string `json:"email"`
+			}
+			if json.Unmarshal(body, &req) == nil {
+				email = strings.ToLower(strings.TrimSpace(req.Email))
+			}
+		}
+	}
+
+	sum := sha256.Sum256([]byte(c.ClientIP() + "|" + email))
+	return "login_throttle:" + hex.EncodeToString(sum[:16])
+}
+
+// RedisLoginAttemptStore keeps the failures in Redis so that every gateway
+// instance sees them
+type RedisLoginAttemptStore struct {
+	client *redis.Client
+}
+
+// NewRedisLoginAttemptStore creates a store on the given Redis client
+func NewRedisLoginAttemptStore(client *redis.Client) *RedisLoginAttemptStore {
+	return &RedisLoginAttemptStore{client: client}
+}
+
+func (s *RedisLoginAttemptStore) Failures(ctx context.Context, key string) (int, time.Duration, error) {
+	pipe := s.client.Pipeline()
+	get := pipe.Get(ctx, key)
+	ttl := pipe.TTL(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return 0, 0, err
+	}
+	count, err := get.Int()
+	if err == redis.Nil {
+		return 0, 0, nil
+	}
+	return count, ttl.Val(), err
+}
+
+func (s *RedisLoginAttemptStore) RecordFailure(ctx context.Context, key string, window time.Duration) (int, error) {
+	count, err := s.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	// Only the first failure starts the window, so that failing again does
+	// not extend a block
+	if count == 1 {
+		if err := s.client.Expire(ctx, key, window).Err(); err != nil {
+			return 0, err
+		}
+	}
+	return int(count), nil
+}
+
+func (s *RedisLoginAttemptStore) Reset(ctx context.Context, key string) error {
+	return s.client.Del(ctx, key).Err()
+}
+
+// MemoryLoginAttemptStore keeps the failures in memory, for a single gateway
+// instance
+type MemoryLoginAttemptStore struct {
+	mu       sync.Mutex
+	failures map[string]memoryFailures
+	now      func() time.Time
+}
+
+type memoryFailures struct {
+	count   int
+	expires time.Time
+}
+
+// NewMemoryLoginAttemptStore creates an empty in-memory store
+func NewMemoryLoginAttemptStore() *MemoryLoginAttemptStore {
+	return &MemoryLoginAttemptStore{failures: make(map[string]memoryFailures), now: time.Now}
+}
+
+func (s *MemoryLoginAttemptStore) Failures(_ context.Context, key string) (int, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, ok := s.failures[key]
+	now := s.now()
+	if !ok || !now.Before(f.expires) {
+		delete(s.failures, key)
+		return 0, 0, nil
+	}
+	return f.count, f.expires.Sub(now), nil
+}
+
+func (s *MemoryLoginAttemptStore) RecordFailure(_ context.Context, key string, window time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	f, ok := s.failures[key]
+	if !ok || !now.Before(f.expires) {
+		f = memoryFailures{expires: now.Add(window)}
+	}
+	f.count++
+	s.failures[key] = f
+	return f.count, nil
+}
+
+func (s *MemoryLoginAttemptStore) Reset(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.failures, key)
+	return nil
+}
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+func TestLoginThrottle(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	store := NewMemoryLoginAttemptStore()
+	verifier := CaptchaVerifierFunc(func(_ context.Context, response, _ string) (bool, error) {
+		return response == "solved", nil
+	})
+	config := LoginThrottleConfig{ChallengeAfter: 2, BlockAfter: 4, Window: time.Minute}
+	throttle := NewLoginThrottle(store, verifier, config, zap.NewNop())
+
+	router := gin.New()
+	router.POST("/login", throttle.Middleware(), func(c *gin.Context) {
+		var req struct{ Email, Password string }
+		c.BindJSON(&req)
+		if req.Password != "right" {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"email": req.Email})
+	})
+
+	login := func(email, password, captcha string) (*httptest.ResponseRecorder, LoginChallenge) {
+		body := `{"email":"` + email + `","password":"` + password + `"}`
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if captcha != "" {
+			req.Header.Set(CaptchaResponseHeader, captcha)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		var challenge LoginChallenge
+		json.Unmarshal(w.Body.Bytes(), &challenge)
+		return w, challenge
+	}
+
+	for i := 0; i < 2; i++ {
+		if w, _ := login("a@example.com", "wrong", ""); w.Code != http.StatusUnauthorized {
+			t.Fatalf("failure %d status = %d, want 401", i+1, w.Code)
+		}
+	}
+
+	if w, challenge := login("a@example.com", "right", ""); w.Code != http.StatusForbidden || challenge.Code != "captcha_required" {
+		t.Fatalf("login without captcha = %d %q, want 403 captcha_required", w.Code, challenge.Code)
+	}
+	if w, challenge := login("a@example.com", "right", "guess"); w.Code != http.StatusForbidden || challenge.Code != "captcha_invalid" {
+		t.Fatalf("login with a wrong captcha = %d %q, want 403 captcha_invalid", w.Code, challenge.Code)
+	}
+	if w, _ := login("b@example.com", "right", ""); w.Code != http.StatusOK {
+		t.Fatalf("login of another email = %d, want 200", w.Code)
+	}
+
+	for i := 0; i < 2; i++ {
+		login("a@example.com", "wrong", "solved")
+	}
+	w, challenge := login("a@example.com", "right", "solved")
+	if w.Code != http.StatusTooManyRequests || challenge.Code != "too_many_attempts" || w.Header().Get("Retry-After") == "" {
+		t.Fatalf("login after %d failures = %d %q, want 429 too_many_attempts with Retry-After", config.BlockAfter, w.Code, challenge.Code)
+	}
+
+	store.now = func() time.Time { return time.Now().Add(config.Window) }
+	if w, _ := login("a@example.com", "right", ""); w.Code != http.StatusOK {
+		t.Fatalf("login after the window = %d, want 200", w.Code)
+	}
+}
@@ -0,0 +1,136 @@
+package middleware
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
+)
+
+// DefaultMaintenanceRetryAfter is the Retry-After sent while in maintenance
+// or while a feature is switched off, unless configured otherwise
+const DefaultMaintenanceRetryAfter = 5 * time.Minute
+
+// Feature flags backing the operational controls. They are toggled through
+// the admin operations endpoint and are on only at a 100% rollout.
+const (
+	// MaintenanceFlag puts every route group in maintenance
+	MaintenanceFlag = "maintenance"
+	// maintenanceGroupPrefix prefixes the flags putting one route group, the
+	// first path segment after /api/v1, in maintenance
+	maintenanceGroupPrefix = MaintenanceFlag + "."
+	// killSwitchPrefix prefixes the flags switching off an expensive feature
+	killSwitchPrefix = "killswitch."
+)
+
+// KillSwitchFeatures lists the expensive features that can be switched off
+// while the rest of the API keeps serving
+var KillSwitchFeatures = []string{"search", "graphql"}
+
+// maintenanceExempt lists the route groups served during maintenance, so
+// that staff can sign in and lift it
+var maintenanceExempt = map[string]bool{
+	"admin": true,
+}
+
+// maintenanceExemptPaths lists the routes of other groups served during
+// maintenance
+var maintenanceExemptPaths = map[string]bool{
+	"/api/v1/users/login":   true,
+	"/api/v1/users/refresh": true,
+}
+
+// MaintenanceGroupFlag returns the flag putting a route group in maintenance
+func MaintenanceGroupFlag(group string) string {
+	return maintenanceGroupPrefix + group
+}
+
+// MaintenanceGroupOf returns the route group of a maintenance flag, false for
+// other flags
+func MaintenanceGroupOf(key string) (string, bool) {
+	return strings.CutPrefix(key, maintenanceGroupPrefix)
+}
+
+// KillSwitchFlag returns the flag switching off a feature
+func KillSwitchFlag(feature string) string {
+	return killSwitchPrefix + feature
+}
+
+// IsMaintenanceExempt reports whether a route group keeps serving during
+// maintenance
+func IsMaintenanceExempt(group string) bool {
+	return maintenanceExempt[group]
+}
+
+// OperationalControls answers requests with a 503 and a Retry-After while
+// their route group is in maintenance or their feature is switched off. The
+// flags are read from the in-memory snapshot of the feature flags client, so
+// toggles apply to every gateway within its refresh interval.
+type OperationalControls struct {
+	flags      *featureflags.Client
+	retryAfter time.Duration
+}
+
+// NewOperationalControls creates the operational controls, with
+// DefaultMaintenanceRetryAfter when retryAfter is not positive
+func NewOperationalControls(flags *featureflags.Client, retryAfter time.Duration) *OperationalControls {
+	if retryAfter <= 0 {
+		retryAfter = DefaultMaintenanceRetryAfter
+	}
+	return &OperationalControls{flags: flags, retryAfter: retryAfter}
+}
+
+// Maintenance refuses the requests of the API route groups in maintenance,
+// except those of the admin API and of signing in
+func (o *OperationalControls) Maintenance() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+		rest, ok := strings.CutPrefix(path, "/api/v1/")
+		if !ok || maintenanceExemptPaths[path] {
+			c.Next()
+			return
+		}
+		group, _, _ := strings.Cut(rest, "/")
+		if maintenanceExempt[group] {
+			c.Next()
+			return
+		}
+
+		if o.isOn(MaintenanceFlag) || (group != "" && o.isOn(MaintenanceGroupFlag(group))) {
+			o.refuse(c, "maintenance", "the service is down for maintenance, please try again later")
+			return
+		}
+		c.Next()
+	}
+}
+
+// KillSwitch refuses the requests of the routes behind it while the feature
+// is switched off
+func (o *OperationalControls) KillSwitch(feature string) gin.HandlerFunc {
+	key := KillSwitchFlag(feature)
+	return func(c *gin.Context) {
+		if o.isOn(key) {
+			o.refuse(c, "feature_disabled", feature+" is temporarily unavailable, please try again later")
+			return
+		}
+		c.Next()
+	}
+}
+
+// isOn reports whether a control flag is on; missing flags are off
+func (o *OperationalControls) isOn(key string) bool {
+	return o.flags.IsEnabledOr(key, "", false)
+}
+
+func (o *OperationalControls) refuse(c *gin.Context, code, message string) {
+	c.Header("Retry-After", strconv.Itoa(int(o.retryAfter/time.Second)))
+	c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{
+		"error":      message,
+		"code":       code,
+		"request_id": GetRequestID(c),
+	})
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
+)
+
+// memoryFlagStore is a featureflags.Store of a fixed set of flags
+type memoryFlagStore []*featureflags.Flag
+
+func (s memoryFlagStore) List(context.Context) ([]*featureflags.Flag, error) { return s, nil }
+func (s memoryFlagStore) Get(context.Context, string) (*featureflags.Flag, error) {
+	return nil, featureflags.ErrFlagNotFound
+}
+func (s memoryFlagStore) Save(context.Context, *featureflags.Flag) error { return nil }
+func (s memoryFlagStore) Delete(context.Context, string) error           { return nil }
+
+func TestOperationalControls(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	on := func(key string) *featureflags.Flag {
+		return &featureflags.Flag{Key: key, Enabled: true, RolloutPercentage: 100}
+	}
+
+	newRouter := func(flags ...*featureflags.Flag) *gin.Engine {
+		client := featureflags.NewClient(context.Background(), memoryFlagStore(flags), zap.NewNop())
+		controls := NewOperationalControls(client, 2*time.Minute)
+		r := gin.New()
+		r.Use(controls.Maintenance())
+		ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+		r.GET("/api/v1/products", ok)
+		r.GET("/api/v1/cart", ok)
+		r.POST("/api/v1/users/login", ok)
+		r.GET("/api/v1/admin/operations", ok)
+		r.GET("/api/v1/categories/:id/facets", controls.KillSwitch("search"), ok)
+		r.GET("/uploads/logo.png", ok)
+		return r
+	}
+
+	tests := []struct {
+		name  string
+		flags []*featureflags.Flag
+		path  string
+		want  int
+	}{
+		{"no controls", nil, "/api/v1/products", http.StatusOK},
+		{"global maintenance", []*featureflags.Flag{on(MaintenanceFlag)}, "/api/v1/products", http.StatusServiceUnavailable},
+		{"admin API during maintenance", []*featureflags.Flag{on(MaintenanceFlag)}, "/api/v1/admin/operations", http.StatusOK},
+		{"login during maintenance", []*featureflags.Flag{on(MaintenanceFlag)}, "/api/v1/users/login", http.StatusOK},
+		{"outside the API during maintenance", []*featureflags.Flag{on(MaintenanceFlag)}, "/uploads/logo.png", http.StatusOK},
+		{"group in maintenance", []*featureflags.Flag{on(MaintenanceGroupFlag("cart"))}, "/api/v1/cart", http.StatusServiceUnavailable},
+		{"other group in maintenance", []*featureflags.Flag{on(MaintenanceGroupFlag("cart"))}, "/api/v1/products", http.StatusOK},
+		{"partial rollout is off", []*featureflags.Flag{{Key: MaintenanceFlag, Enabled: true, RolloutPercentage: 50}}, "/api/v1/products", http.StatusOK},
+		{"feature switched off", []*featureflags.Flag{on(KillSwitchFlag("search"))}, "/api/v1/categories/1/facets", http.StatusServiceUnavailable},
+		{"other feature switched off", []*featureflags.Flag{on(KillSwitchFlag("graphql"))}, "/api/v1/categories/1/facets", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method := http.MethodGet
+			if tt.path == "/api/v1/users/login" {
+				method = http.MethodPost
+			}
+			w := httptest.NewRecorder()
+			newRouter(tt.flags...).ServeHTTP(w, httptest.NewRequest(method, tt.path, nil))
+			if w.Code != tt.want {
+				t.Fatalf("%s %s = %d, want %d", method, tt.path, w.Code, tt.want)
+			}
+			if tt.want == http.StatusServiceUnavailable && w.Header().Get("Retry-After") != "120" {
+				t.Errorf("Retry-After = %q, want 120", w.Header().Get("Retry-After"))
+			}
+		})
+	}
+}
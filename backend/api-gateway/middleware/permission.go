@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+)
+
+// Roles of the users administering the store
+const (
+	roleAdmin      = "admin"
+	roleSuperAdmin = "super_admin"
+	roleStaff      = "staff"
+)
+
+// isAdminRole reports whether role administers the whole store, which
+// AdminRequired and PermissionRequired both let through
+func isAdminRole(role string) bool {
+	return role == roleAdmin || role == roleSuperAdmin
+}
+
+// PermissionRequired lets through administrators, and staff whose token
+// grants the scope of one of their permission sets, e.g.
+// scope.ProductsWrite for the catalog. Routes left to AdminRequired stay
+// closed to staff. It must run after AuthRequired.
+func PermissionRequired(required string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role := c.GetString("user_role")
+		if isAdminRole(role) {
+			c.Next()
+			return
+		}
+
+		switch role {
+		case "":
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "unauthorized"})
+			c.Abort()
+			return
+		case roleStaff:
+			if granted, ok := scope.FromContext(c.Request.Context()); ok && scope.Has(granted, required) {
+				c.Next()
+				return
+			}
+			c.JSON(http.StatusForbidden, gin.H{"error": "permission required", "scope": required})
+			c.Abort()
+			return
+		}
+
+		c.JSON(http.StatusForbidden, gin.H{"error": "admin access required"})
+		c.Abort()
+	}
+}
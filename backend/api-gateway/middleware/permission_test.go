@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+)
+
+func TestPermissionRequired(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name   string
+		role   string
+		scopes []string
+		want   int
+	}{
+		{"unauthenticated", "", nil, http.StatusUnauthorized},
+		{"customer", "user", []string{scope.ProductsRead}, http.StatusForbidden},
+		{"admin", "admin", nil, http.StatusOK},
+		{"super admin", "super_admin", nil, http.StatusOK},
+		{"staff with the permission", "staff", []string{scope.ProductsRead, scope.ProductsWrite}, http.StatusOK},
+		{"staff without the permission", "staff", []string{scope.InventoryRead, scope.InventoryWrite}, http.StatusForbidden},
+		{"staff with a token without scopes", "staff", nil, http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.POST("/products", func(c *gin.Context) {
+				if tt.role != "" {
+					c.Set("user_role", tt.role)
+				}
+				if tt.scopes != nil {
+					c.Request = c.Request.WithContext(scope.NewContext(c.Request.Context(), tt.scopes))
+				}
+				c.Next()
+			}, PermissionRequired(scope.ProductsWrite), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/products", nil))
+			if w.Code != tt.want {
+				t.Errorf("status = %d, want %d", w.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestAdminRequiredAdmitsTheSameAdmins(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for role, want := range map[string]int{
+		"admin":       http.StatusOK,
+		"super_admin": http.StatusOK,
+		"staff":       http.StatusForbidden,
+		"user":        http.StatusForbidden,
+	} {
+		router := gin.New()
+		router.POST("/admin", func(c *gin.Context) {
+			c.Set("user_role", role)
+			c.Next()
+		}, AdminRequired(), func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/admin", nil))
+		if w.Code != want {
+			t.Errorf("AdminRequired for %s: status = %d, want %d", role, w.Code, want)
+		}
+	}
+}
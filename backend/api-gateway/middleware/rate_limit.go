@@ -0,0 +1,171 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+)
+
+// Headers telling clients of rate limited routes where they stand
+const (
+	RateLimitLimitHeader     = "X-RateLimit-Limit"
+	RateLimitRemainingHeader = "X-RateLimit-Remaining"
+	RateLimitResetHeader     = "X-RateLimit-Reset"
+)
+
+// RateLimitStore counts the requests of a key over fixed windows
+type RateLimitStore interface {
+	// Hit adds a request to key, starting a window when there is none, and
+	// returns the requests of the window and the time until it ends
+	Hit(ctx context.Context, key string, window time.Duration) (int, time.Duration, error)
+}
+
+// RateLimitConfig configures a rate limiter
+type RateLimitConfig struct {
+	// Requests is the number of requests a client may make per window
+	Requests int
+	// Window is the length of the windows requests are counted over
+	Window time.Duration
+}
+
+// DefaultPublicRateLimitConfig allows anonymous clients of the public API 60
+// requests a minute
+func DefaultPublicRateLimitConfig() RateLimitConfig {
+	return RateLimitConfig{
+		Requests: 60,
+		Window:   time.Minute,
+	}
+}
+
+// RateLimiter limits the requests of each client IP to the routes behind it,
+// per store. Unlike LoginThrottle, every request counts, successful or not.
+type RateLimiter struct {
+	name   string
+	store  RateLimitStore
+	config RateLimitConfig
+	logger *zap.Logger
+}
+
+// NewRateLimiter creates a limiter. Its name separates its counts from those
+// of other limiters on the same store.
+func NewRateLimiter(name string, store RateLimitStore, config RateLimitConfig, logger *zap.Logger) *RateLimiter {
+	return &RateLimiter{
+		name:   name,
+		store:  store,
+		config: config,
+		logger: logger.Named("rate_limit"),
+	}
+}
+
+// Middleware refuses requests past the limit with 429 until the window ends.
+// The limiter fails open: an unavailable store must not take the routes down.
+func (l *RateLimiter) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := "rate_limit:" + l.name + ":" + tenant.FromContext(c.Request.Context()) + ":" + c.ClientIP()
+		count, ttl, err := l.store.Hit(c.Request.Context(), key, l.config.Window)
+		if err != nil {
+			l.logger.Warn("Failed to count request", zap.String("limiter", l.name), zap.Error(err))
+			c.Next()
+			return
+		}
+
+		reset := int((ttl + time.Second - 1) / time.Second)
+		remaining := l.config.Requests - count
+		if remaining < 0 {
+			remaining = 0
+		}
+		c.Header(RateLimitLimitHeader, strconv.Itoa(l.config.Requests))
+		c.Header(RateLimitRemainingHeader, strconv.Itoa(remaining))
+		c.Header(RateLimitResetHeader, strconv.Itoa(reset))
+
+		if count > l.config.Requests {
+			c.Header("Retry-After", strconv.Itoa(reset))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{
+				"error":       "rate limit exceeded",
+				"retry_after": reset,
+				"request_id":  GetRequestID(c),
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// RedisRateLimitStore keeps the counts in Redis so that every gateway
+// instance shares them
+type RedisRateLimitStore struct {
+	client *redis.Client
+}
+
+// NewRedisRateLimitStore creates a store on the given Redis client
+func NewRedisRateLimitStore(client *redis.Client) *RedisRateLimitStore {
+	return &RedisRateLimitStore{client: client}
+}
+
+func (s *RedisRateLimitStore) Hit(ctx context.Context, key string, window time.Duration) (int, time.Duration, error) {
+	count, err := s.client.Incr(ctx, key).Result()
+	if err != nil {
+		return 0, 0, err
+	}
+	// Only the first request starts the window, so that requests refused
+	// do not extend it
+	if count == 1 {
+		if err := s.client.Expire(ctx, key, window).Err(); err != nil {
+			return 0, 0, err
+		}
+		return 1, window, nil
+	}
+	ttl, err := s.client.TTL(ctx, key).Result()
+	if err != nil {
+		return 0, 0, err
+	}
+	if ttl < 0 {
+		// The key lost its expiry, such as when the first request failed to
+		// set it; start the window again
+		if err := s.client.Expire(ctx, key, window).Err(); err != nil {
+			return 0, 0, err
+		}
+		ttl = window
+	}
+	return int(count), ttl, nil
+}
+
+// MemoryRateLimitStore keeps the counts in memory, for a single gateway
+// instance
+type MemoryRateLimitStore struct {
+	mu      sync.Mutex
+	windows map[string]memoryWindow
+	now     func() time.Time
+}
+
+type memoryWindow struct {
+	count   int
+	expires time.Time
+}
+
+// NewMemoryRateLimitStore creates an empty in-memory store
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{windows: make(map[string]memoryWindow), now: time.Now}
+}
+
+func (s *MemoryRateLimitStore) Hit(_ context.Context, key string, window time.Duration) (int, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	w, ok := s.windows[key]
+	if !ok || !now.Before(w.expires) {
+		w = memoryWindow{expires: now.Add(window)}
+	}
+	w.count++
+	s.windows[key] = w
+	return w.count, w.expires.Sub(now), nil
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+func TestRateLimiter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	store := NewMemoryRateLimitStore()
+	store.now = func() time.Time { return now }
+	limiter := NewRateLimiter("public", store, RateLimitConfig{Requests: 2, Window: time.Minute}, zap.NewNop())
+
+	router := gin.New()
+	router.GET("/catalog", limiter.Middleware(), func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ok": true})
+	})
+
+	get := func(ip string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/catalog", nil)
+		req.RemoteAddr = ip + ":1234"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	for i, wantRemaining := range []string{"1", "0"} {
+		w := get("10.0.0.1")
+		if w.Code != http.StatusOK {
+			t.Fatalf("request %d status = %d, want 200", i+1, w.Code)
+		}
+		if got := w.Header().Get(RateLimitRemainingHeader); got != wantRemaining {
+			t.Errorf("request %d remaining = %q, want %q", i+1, got, wantRemaining)
+		}
+	}
+
+	now = now.Add(20 * time.Second)
+	w := get("10.0.0.1")
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("request past the limit status = %d, want 429", w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "40" {
+		t.Errorf("Retry-After = %q, want 40", got)
+	}
+	if w := get("10.0.0.2"); w.Code != http.StatusOK {
+		t.Fatalf("request of another client status = %d, want 200", w.Code)
+	}
+
+	now = now.Add(40 * time.Second)
+	if w := get("10.0.0.1"); w.Code != http.StatusOK {
+		t.Fatalf("request in a new window status = %d, want 200", w.Code)
+	}
+}
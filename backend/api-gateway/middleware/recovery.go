@@ -1,31 +1,32 @@
 package middleware
 
 import (
-	"fmt"
 	"net/http"
-	"runtime/debug"
 
 	"github.com/gin-gonic/gin"
-	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/recovery"
 )
 
-// Recovery returns a middleware that recovers from any panics and logs the error
-func Recovery(logger *zap.Logger) gin.HandlerFunc {
+// Recovery returns a middleware that recovers from any panics, logs and
+// reports them with their stack trace, and responds with a generic 500
+func Recovery(recoverer *recovery.Recoverer) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		defer func() {
 			if err := recover(); err != nil {
-				// Log the error and stack trace
-				stackTrace := string(debug.Stack())
-				logger.Error("Panic recovered",
-					zap.Any("error", err),
-					zap.String("stack", stackTrace),
-					zap.String("path", c.Request.URL.Path),
-					zap.String("method", c.Request.Method),
-				)
+				operation := c.FullPath()
+				if operation == "" {
+					operation = c.Request.URL.Path
+				}
+				recoverer.Recovered(c.Request.Context(), err, c.Request.Method+" "+operation, map[string]string{
+					"http.method": c.Request.Method,
+					"http.path":   c.Request.URL.Path,
+				})
 
-				// Respond with a 500 error
+				// The panic value may carry internal details, so it is not returned
 				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{
-					"error": fmt.Sprintf("Internal server error: %v", err),
+					"error":      recovery.InternalMessage,
+					"request_id": GetRequestID(c),
 				})
 			}
 		}()
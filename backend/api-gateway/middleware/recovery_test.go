@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/recovery"
+)
+
+func TestRecovery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var reported []recovery.Event
+	recoverer := recovery.New("api-gateway", zap.NewNop(), recovery.ReporterFunc(func(event recovery.Event) {
+		reported = append(reported, event)
+	}))
+
+	router := gin.New()
+	router.Use(Recovery(recoverer))
+	router.GET("/products/:id", func(c *gin.Context) {
+		panic("pq: secret connection string")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/products/42", nil))
+
+	if w.Code != http.StatusInternalServerError {
+		t.Errorf("status = %d, want 500", w.Code)
+	}
+	if strings.Contains(w.Body.String(), "secret") {
+		t.Errorf("response leaks the panic value: %s", w.Body.String())
+	}
+	if len(reported) != 1 || reported[0].Operation != "GET /products/:id" {
+		t.Errorf("reported = %+v, want one event for GET /products/:id", reported)
+	}
+}
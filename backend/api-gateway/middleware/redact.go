@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// redacted replaces sensitive values in logged bodies
+const redacted = "[REDACTED]"
+
+// sensitiveKeyFragments are the fragments of field names whose values are
+// never logged: a key is sensitive when, lowercased and without separators,
+// it contains one of them, so "new_password", "accessToken" and "Card-Number"
+// all match
+var sensitiveKeyFragments = []string{
+	"password", "passwd", "secret", "token", "apikey", "authorization", "cookie",
+	"cardnumber", "ccnumber", "iban",
+}
+
+// sensitiveKeys are short field names matched exactly after normalization,
+// as fragments they would match unrelated names
+var sensitiveKeys = map[string]bool{
+	"otp": true, "pan": true, "pin": true, "cvv": true, "cvc": true, "ssn": true,
+}
+
+// cardNumberPattern matches 13 to 19 digit sequences, optionally grouped by
+// spaces or dashes, that are checked against the Luhn checksum
+var cardNumberPattern = regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`)
+
+func isSensitiveKey(key string) bool {
+	normalized := strings.Map(func(r rune) rune {
+		switch r {
+		case '_', '-', ' ', '.':
+			return -1
+		}
+		return r
+	}, strings.ToLower(key))
+
+	if sensitiveKeys[normalized] {
+		return true
+	}
+	for _, fragment := range sensitiveKeyFragments {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactBody returns a body safe to log: the values of sensitive JSON or form
+// fields are replaced and card numbers are masked wherever they appear.
+// Bodies of other content types are only scanned for card numbers.
+func RedactBody(contentType string, body []byte) string {
+	switch {
+	case strings.Contains(contentType, "json"):
+		var value any
+		decoder := json.NewDecoder(bytes.NewReader(body))
+		decoder.UseNumber()
+		if err := decoder.Decode(&value); err == nil {
+			if redactedBody, err := json.Marshal(redactValue(value)); err == nil {
+				return string(redactedBody)
+			}
+		}
+	case strings.Contains(contentType, "application/x-www-form-urlencoded"):
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return RedactQuery(values)
+		}
+	}
+	return redactCardNumbers(string(body))
+}
+
+// RedactQuery encodes query or form values with sensitive values replaced
+func RedactQuery(values url.Values) string {
+	clean := make(url.Values, len(values))
+	for key, vals := range values {
+		for _, v := range vals {
+			if isSensitiveKey(key) {
+				v = redacted
+			} else {
+				v = redactCardNumbers(v)
+			}
+			clean.Add(key, v)
+		}
+	}
+	return clean.Encode()
+}
+
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isSensitiveKey(key) {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(field)
+			}
+		}
+		return v
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+		return v
+	case string:
+		return redactCardNumbers(v)
+	case json.Number:
+		if luhnValid(v.String()) {
+			return redacted
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func redactCardNumbers(s string) string {
+	return cardNumberPattern.ReplaceAllStringFunc(s, func(match string) string {
+		if luhnValid(match) {
+			return redacted
+		}
+		return match
+	})
+}
+
+// luhnValid reports whether s, ignoring spaces and dashes, is a 13 to 19
+// digit number with a valid Luhn check digit
+func luhnValid(s string) bool {
+	digits := make([]int, 0, len(s))
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9':
+			digits = append(digits, int(r-'0'))
+		case r == ' ' || r == '-':
+		default:
+			return false
+		}
+	}
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	for i := range digits {
+		d := digits[len(digits)-1-i]
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
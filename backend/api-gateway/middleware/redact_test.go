@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name:        "json fields",
+			contentType: "application/json",
+			body:        `{"email":"a@b.c","password":"hunter2","tokens":{"accessToken":"x"},"items":[{"new_password":"y","qty":2}]}`,
+			want:        `{"email":"a@b.c","items":[{"new_password":"[REDACTED]","qty":2}],"password":"[REDACTED]","tokens":"[REDACTED]"}`,
+		},
+		{
+			name:        "json card number",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"note":"card 4242 4242 4242 4242","number":4111111111111111,"sku":"1234567890123"}`,
+			want:        `{"note":"card [REDACTED]","number":"[REDACTED]","sku":"1234567890123"}`,
+		},
+		{
+			name:        "form",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "username=bob&cvv=123&company=acme",
+			want:        "company=acme&cvv=%5BREDACTED%5D&username=bob",
+		},
+		{
+			name:        "invalid json",
+			contentType: "application/json",
+			body:        `{"pan":"4242-4242-4242-4242"`,
+			want:        `{"pan":"[REDACTED]"`,
+		},
+	}
+	for _, tt := range tests {
+		if got := RedactBody(tt.contentType, []byte(tt.body)); got != tt.want {
+			t.Errorf("%s: RedactBody = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRedactQuery(t *testing.T) {
+	got := RedactQuery(url.Values{"token": {"abc"}, "page": {"2"}})
+	if strings.Contains(got, "abc") || !strings.Contains(got, "page=2") {
+		t.Errorf("RedactQuery = %s", got)
+	}
+}
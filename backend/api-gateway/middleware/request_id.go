@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+)
+
+// RequestIDKey is the gin context key holding the current request ID
+const RequestIDKey = "request_id"
+
+// RequestID assigns every request an ID, taken from the X-Request-ID header
+// when the client supplies one, and stores it on the request context so that
+// outgoing gRPC calls forward it to the backend services.
+func RequestID(logger *zap.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(applogger.RequestIDHeader)
+		if requestID == "" {
+			requestID = uuid.New().String()
+		}
+
+		c.Set(RequestIDKey, requestID)
+		c.Header(applogger.RequestIDHeader, requestID)
+
+		ctx := applogger.WithRequestID(c.Request.Context(), requestID)
+		ctx = applogger.WithContext(ctx, logger)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
+
+// GetRequestID returns the request ID assigned by the RequestID middleware
+func GetRequestID(c *gin.Context) string {
+	return c.GetString(RequestIDKey)
+}
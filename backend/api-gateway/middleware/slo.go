@@ -0,0 +1,267 @@
+package middleware
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// LatencyBuckets are the upper bounds of the latency histograms of routes
+var LatencyBuckets = []time.Duration{
+	5 * time.Millisecond,
+	10 * time.Millisecond,
+	25 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// Burn rate windows. A route violates its budget while it burns it faster
+// than allowed over both, so that a single slow minute does not flag it and
+// a recovered route stops being flagged within minutes.
+const (
+	sloShortWindow = 5 * time.Minute
+	sloLongWindow  = time.Hour
+	// sloSlots is the number of one-minute slots kept per route
+	sloSlots = int(sloLongWindow / time.Minute)
+)
+
+// SLOTarget is the latency objective of a route: Objective of its requests,
+// such as 0.99, complete within Latency
+type SLOTarget struct {
+	Latency   time.Duration
+	Objective float64
+}
+
+// DefaultSLOTarget applies to routes without a target of their own: 99% of
+// requests within 500ms
+var DefaultSLOTarget = SLOTarget{Latency: 500 * time.Millisecond, Objective: 0.99}
+
+// ParseSLOTargets parses the per-route targets of SLO_ROUTE_TARGETS: a comma
+// separated list of "METHOD /path=latency" or "METHOD /path=latency@objective"
+// with the route as registered, such as
+// "GET /api/v1/products/:id=150ms@0.995". Routes without an objective take
+// that of def.
+func ParseSLOTargets(value string, def SLOTarget) (map[string]SLOTarget, error) {
+	targets := make(map[string]SLOTarget)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		route, spec, ok := strings.Cut(entry, "=")
+		method, path, hasPath := strings.Cut(strings.TrimSpace(route), " ")
+		if !ok || !hasPath || !strings.HasPrefix(strings.TrimSpace(path), "/") {
+			return nil, fmt.Errorf("invalid SLO target %q, want \"METHOD /path=latency[@objective]\"", entry)
+		}
+
+		target := def
+		latency, objective, hasObjective := strings.Cut(spec, "@")
+		var err error
+		if target.Latency, err = time.ParseDuration(strings.TrimSpace(latency)); err != nil || target.Latency <= 0 {
+			return nil, fmt.Errorf("invalid latency in SLO target %q", entry)
+		}
+		if hasObjective {
+			if target.Objective, err = strconv.ParseFloat(strings.TrimSpace(objective), 64); err != nil || target.Objective <= 0 || target.Objective >= 1 {
+				return nil, fmt.Errorf("invalid objective in SLO target %q, want a ratio such as 0.99", entry)
+			}
+		}
+		targets[strings.ToUpper(method)+" "+strings.TrimSpace(path)] = target
+	}
+	return targets, nil
+}
+
+// sloSlot counts the requests of a route during one minute
+type sloSlot struct {
+	minute int64
+	total  uint64
+	slow   uint64
+}
+
+// routeStats are the latencies recorded for a route
+type routeStats struct {
+	target  SLOTarget
+	buckets []uint64
+	count   uint64
+	sum     time.Duration
+	slots   [sloSlots]sloSlot
+}
+
+// LatencyBucket is the number of requests that completed within LE
+// milliseconds, cumulative as in Prometheus histograms; the last bucket has
+// no bound
+type LatencyBucket struct {
+	LE    float64 `json:"le_ms,omitempty"`
+	Count uint64  `json:"count"`
+}
+
+// RouteSLO reports the latencies of a route against its target
+type RouteSLO struct {
+	Route      string          `json:"route"`
+	TargetMS   float64         `json:"target_ms"`
+	Objective  float64         `json:"objective"`
+	Count      uint64          `json:"count"`
+	MeanMS     float64         `json:"mean_ms"`
+	Histogram  []LatencyBucket `json:"histogram"`
+	BurnRate5m float64         `json:"burn_rate_5m"`
+	BurnRate1h float64         `json:"burn_rate_1h"`
+	// Violating is set while the route burns its budget faster than allowed
+	// over both the last 5 minutes and the last hour
+	Violating bool `json:"violating"`
+}
+
+// SLOTracker records the latency of each route against its SLO target. A
+// route burns its error budget, the 1 - objective share of requests allowed
+// over the target, at a rate of 1 when it is slow exactly as often as
+// allowed. Latencies are kept in memory, so each gateway reports its own
+// traffic since it started.
+type SLOTracker struct {
+	def    SLOTarget
+	routes map[string]SLOTarget
+	now    func() time.Time
+
+	mu    sync.Mutex
+	stats map[string]*routeStats
+}
+
+// NewSLOTracker creates a tracker holding routes to their targets and the
+// other routes to def
+func NewSLOTracker(def SLOTarget, routes map[string]SLOTarget) *SLOTracker {
+	return &SLOTracker{
+		def:    def,
+		routes: routes,
+		now:    time.Now,
+		stats:  make(map[string]*routeStats),
+	}
+}
+
+// Middleware records the latency of the requests of matched routes
+func (t *SLOTracker) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := t.now()
+		c.Next()
+
+		// Unmatched paths would grow the routes without bound
+		if c.FullPath() == "" {
+			return
+		}
+		t.Record(c.Request.Method+" "+c.FullPath(), t.now().Sub(start))
+	}
+}
+
+// Record adds the latency of a request of route, such as
+// "GET /api/v1/products/:id"
+func (t *SLOTracker) Record(route string, latency time.Duration) {
+	minute := t.now().Unix() / 60
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats, ok := t.stats[route]
+	if !ok {
+		target, ok := t.routes[route]
+		if !ok {
+			target = t.def
+		}
+		stats = &routeStats{target: target, buckets: make([]uint64, len(LatencyBuckets)+1)}
+		t.stats[route] = stats
+	}
+
+	bucket := sort.Search(len(LatencyBuckets), func(i int) bool { return latency <= LatencyBuckets[i] })
+	stats.buckets[bucket]++
+	stats.count++
+	stats.sum += latency
+
+	slot := &stats.slots[minute%int64(sloSlots)]
+	if slot.minute != minute {
+		*slot = sloSlot{minute: minute}
+	}
+	slot.total++
+	if latency > stats.target.Latency {
+		slot.slow++
+	}
+}
+
+// Routes reports every route recorded, sorted by route
+func (t *SLOTracker) Routes() []RouteSLO {
+	minute := t.now().Unix() / 60
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	routes := make([]RouteSLO, 0, len(t.stats))
+	for route, stats := range t.stats {
+		routes = append(routes, stats.report(route, minute))
+	}
+	sort.Slice(routes, func(i, j int) bool { return routes[i].Route < routes[j].Route })
+	return routes
+}
+
+// Violations reports the routes currently violating their latency budget,
+// the fastest burning first
+func (t *SLOTracker) Violations() []RouteSLO {
+	violations := []RouteSLO{}
+	for _, route := range t.Routes() {
+		if route.Violating {
+			violations = append(violations, route)
+		}
+	}
+	sort.SliceStable(violations, func(i, j int) bool { return violations[i].BurnRate5m > violations[j].BurnRate5m })
+	return violations
+}
+
+func (s *routeStats) report(route string, minute int64) RouteSLO {
+	report := RouteSLO{
+		Route:      route,
+		TargetMS:   durationMS(s.target.Latency),
+		Objective:  s.target.Objective,
+		Count:      s.count,
+		Histogram:  make([]LatencyBucket, len(s.buckets)),
+		BurnRate5m: s.burnRate(minute, sloShortWindow),
+		BurnRate1h: s.burnRate(minute, sloLongWindow),
+	}
+	if s.count > 0 {
+		report.MeanMS = durationMS(s.sum) / float64(s.count)
+	}
+	var cumulative uint64
+	for i, count := range s.buckets {
+		cumulative += count
+		report.Histogram[i].Count = cumulative
+		if i < len(LatencyBuckets) {
+			report.Histogram[i].LE = durationMS(LatencyBuckets[i])
+		}
+	}
+	report.Violating = report.BurnRate5m > 1 && report.BurnRate1h > 1
+	return report
+}
+
+// burnRate is the share of slow requests over the window ending with minute,
+// relative to the share the objective allows
+func (s *routeStats) burnRate(minute int64, window time.Duration) float64 {
+	since := minute - int64(window/time.Minute)
+	var total, slow uint64
+	for _, slot := range s.slots {
+		if slot.minute > since && slot.minute <= minute {
+			total += slot.total
+			slow += slot.slow
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(slow) / float64(total) / (1 - s.target.Objective)
+}
+
+func durationMS(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
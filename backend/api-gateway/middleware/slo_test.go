@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSLOTargets(t *testing.T) {
+	targets, err := ParseSLOTargets("GET /api/v1/products/:id=150ms@0.995, post /api/v1/cart=1s", DefaultSLOTarget)
+	if err != nil {
+		t.Fatalf("ParseSLOTargets: %v", err)
+	}
+	if got := targets["GET /api/v1/products/:id"]; got != (SLOTarget{Latency: 150 * time.Millisecond, Objective: 0.995}) {
+		t.Errorf("product target = %+v", got)
+	}
+	if got := targets["POST /api/v1/cart"]; got != (SLOTarget{Latency: time.Second, Objective: DefaultSLOTarget.Objective}) {
+		t.Errorf("cart target = %+v", got)
+	}
+
+	for _, invalid := range []string{"/api/v1/products=1s", "GET /api/v1/products", "GET /api/v1/products=fast", "GET /api/v1/products=1s@1"} {
+		if _, err := ParseSLOTargets(invalid, DefaultSLOTarget); err == nil {
+			t.Errorf("ParseSLOTargets(%q) succeeded", invalid)
+		}
+	}
+}
+
+func TestSLOTracker(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tracker := NewSLOTracker(SLOTarget{Latency: 100 * time.Millisecond, Objective: 0.9}, map[string]SLOTarget{
+		"GET /slow": {Latency: time.Second, Objective: 0.9},
+	})
+	tracker.now = func() time.Time { return now }
+
+	// 20% of the requests of /fast miss its target, twice the 10% allowed
+	for i := 0; i < 10; i++ {
+		latency := 20 * time.Millisecond
+		if i < 2 {
+			latency = 300 * time.Millisecond
+		}
+		tracker.Record("GET /fast", latency)
+		tracker.Record("GET /slow", latency)
+	}
+
+	routes := tracker.Routes()
+	if len(routes) != 2 || routes[0].Route != "GET /fast" || routes[1].Route != "GET /slow" {
+		t.Fatalf("Routes() = %+v", routes)
+	}
+	fast := routes[0]
+	if fast.Count != 10 || fast.BurnRate5m < 1.99 || fast.BurnRate5m > 2.01 || !fast.Violating {
+		t.Errorf("fast = %+v, want a burn rate of 2 and a violation", fast)
+	}
+	if last := fast.Histogram[len(fast.Histogram)-1]; last.Count != 10 {
+		t.Errorf("histogram total = %d, want 10", last.Count)
+	}
+	if routes[1].Violating || routes[1].BurnRate1h != 0 {
+		t.Errorf("slow = %+v, want no violation under its own target", routes[1])
+	}
+	if violations := tracker.Violations(); len(violations) != 1 || violations[0].Route != "GET /fast" {
+		t.Errorf("Violations() = %+v", violations)
+	}
+
+	// Fast requests since bring the short window back within budget
+	now = now.Add(10 * time.Minute)
+	tracker.Record("GET /fast", 20*time.Millisecond)
+	fast = tracker.Routes()[0]
+	if fast.BurnRate5m != 0 || fast.BurnRate1h <= 1 || fast.Violating {
+		t.Errorf("after recovery fast = %+v, want a 1h burn only", fast)
+	}
+
+	// Slots older than an hour are reused
+	now = now.Add(2 * time.Hour)
+	tracker.Record("GET /fast", 20*time.Millisecond)
+	if fast = tracker.Routes()[0]; fast.BurnRate1h != 0 || fast.Count != 12 {
+		t.Errorf("after an hour fast = %+v", fast)
+	}
+}
@@ -0,0 +1,170 @@
+package middleware
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// TenantIDKey is the gin context key holding the resolved tenant ID
+const TenantIDKey = "tenant_id"
+
+// TenantResolver resolves the store (tenant) of a request. Stores are loaded
+// from the product service and refreshed once the cached list is older than
+// the refresh interval.
+type TenantResolver struct {
+	client          productpb.ProductServiceClient
+	logger          *zap.Logger
+	refreshInterval time.Duration
+
+	snapshot atomic.Pointer[storeSnapshot]
+	loads    singleflight.Group
+}
+
+// storeSnapshot is a store list loaded from the product service. Snapshots
+// are never modified, a reload swaps in a new one.
+type storeSnapshot struct {
+	loadedAt time.Time
+	domains  map[string]string // domain -> store ID
+	active   map[string]bool   // store ID -> is active
+}
+
+// NewTenantResolver creates a tenant resolver. A nil client disables domain
+// resolution and store validation; the header and the default still apply.
+func NewTenantResolver(client productpb.ProductServiceClient, logger *zap.Logger, refreshInterval time.Duration) *TenantResolver {
+	if refreshInterval <= 0 {
+		refreshInterval = time.Minute
+	}
+	return &TenantResolver{
+		client:          client,
+		logger:          logger,
+		refreshInterval: refreshInterval,
+	}
+}
+
+// Middleware resolves the tenant from the X-Tenant-ID header, then from the
+// request host, falling back to the default store. The tenant is stored on the
+// gin context and on the request context so gRPC calls forward it.
+func (r *TenantResolver) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		domains, active := r.stores(c.Request.Context())
+
+		tenantID := strings.ToLower(strings.TrimSpace(c.GetHeader(tenant.Header)))
+		if tenantID == "" {
+			tenantID = domains[requestHost(c.Request)]
+		}
+		if tenantID == "" {
+			tenantID = tenant.DefaultTenantID
+		}
+
+		if !tenant.IsValidID(tenantID) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid store ID"})
+			c.Abort()
+			return
+		}
+
+		// Without a store list the services are the only check
+		if active != nil && !active[tenantID] {
+			c.JSON(http.StatusNotFound, gin.H{"error": "store not found"})
+			c.Abort()
+			return
+		}
+
+		c.Set(TenantIDKey, tenantID)
+		c.Request = c.Request.WithContext(tenant.WithTenant(c.Request.Context(), tenantID))
+
+		c.Next()
+	}
+}
+
+// stores returns the cached domain and active store maps. Once they are
+// stale, a single reload runs in the background while requests go on with
+// the stale maps; only the first requests, before any list is loaded, wait
+// for it.
+func (r *TenantResolver) stores(ctx context.Context) (map[string]string, map[string]bool) {
+	if r.client == nil {
+		return nil, nil
+	}
+
+	snapshot := r.snapshot.Load()
+	if snapshot != nil && time.Since(snapshot.loadedAt) < r.refreshInterval {
+		return snapshot.domains, snapshot.active
+	}
+
+	// The reload outlives the request that started it
+	loads := r.loads.DoChan("stores", func() (any, error) {
+		return r.load(context.WithoutCancel(ctx)), nil
+	})
+	if snapshot != nil {
+		return snapshot.domains, snapshot.active
+	}
+
+	select {
+	case result := <-loads:
+		snapshot = result.Val.(*storeSnapshot)
+		return snapshot.domains, snapshot.active
+	case <-ctx.Done():
+		return nil, nil
+	}
+}
+
+// load reloads the stores and swaps in the new snapshot. On a failure the
+// previous maps are kept.
+func (r *TenantResolver) load(ctx context.Context) *storeSnapshot {
+	loadCtx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	previous := r.snapshot.Load()
+	resp, err := r.client.ListStores(loadCtx, &productpb.ListStoresRequest{})
+	if err != nil {
+		r.logger.Warn("Failed to refresh stores, using cached list", zap.Error(err))
+		// Retry after the next interval rather than on every request
+		snapshot := &storeSnapshot{loadedAt: time.Now()}
+		if previous != nil {
+			snapshot.domains, snapshot.active = previous.domains, previous.active
+		}
+		r.snapshot.Store(snapshot)
+		return snapshot
+	}
+
+	snapshot := &storeSnapshot{
+		loadedAt: time.Now(),
+		domains:  make(map[string]string, len(resp.Stores)),
+		active:   make(map[string]bool, len(resp.Stores)),
+	}
+	for _, store := range resp.Stores {
+		snapshot.active[store.Id] = store.IsActive
+		if store.Domain != "" && store.IsActive {
+			snapshot.domains[strings.ToLower(store.Domain)] = store.Id
+		}
+	}
+	r.snapshot.Store(snapshot)
+	return snapshot
+}
+
+// requestHost returns the lowercased host of the request without its port
+func requestHost(req *http.Request) string {
+	host := req.Host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.ToLower(host)
+}
+
+// GetTenantID returns the tenant resolved by the tenant middleware
+func GetTenantID(c *gin.Context) string {
+	if tenantID := c.GetString(TenantIDKey); tenantID != "" {
+		return tenantID
+	}
+	return tenant.DefaultTenantID
+}
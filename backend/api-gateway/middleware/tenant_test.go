@@ -0,0 +1,90 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+
+	productpb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// storeLister serves ListStores, blocking each call until release is closed
+type storeLister struct {
+	productpb.ProductServiceClient
+	calls   atomic.Int32
+	release chan struct{}
+}
+
+func (s *storeLister) ListStores(ctx context.Context, _ *productpb.ListStoresRequest, _ ...grpc.CallOption) (*productpb.ListStoresResponse, error) {
+	s.calls.Add(1)
+	select {
+	case <-s.release:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return &productpb.ListStoresResponse{Stores: []*productpb.Store{
+		{Id: "acme", Domain: "Shop.Acme.test", IsActive: true},
+	}}, nil
+}
+
+func TestTenantResolverLoadsStoresOnce(t *testing.T) {
+	lister := &storeLister{release: make(chan struct{})}
+	resolver := NewTenantResolver(lister, zap.NewNop(), time.Minute)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			domains, active := resolver.stores(context.Background())
+			if domains["shop.acme.test"] != "acme" || !active["acme"] {
+				t.Errorf("stores = %v, %v, want the acme store", domains, active)
+			}
+		}()
+	}
+	// Let the requests pile up on the first load
+	time.Sleep(20 * time.Millisecond)
+	close(lister.release)
+	wg.Wait()
+
+	if calls := lister.calls.Load(); calls != 1 {
+		t.Errorf("ListStores called %d times, want 1", calls)
+	}
+}
+
+func TestTenantResolverServesStaleStoresDuringRefresh(t *testing.T) {
+	lister := &storeLister{release: make(chan struct{})}
+	resolver := NewTenantResolver(lister, zap.NewNop(), time.Minute)
+	resolver.snapshot.Store(&storeSnapshot{
+		loadedAt: time.Now().Add(-time.Hour),
+		domains:  map[string]string{"old.test": "old"},
+		active:   map[string]bool{"old": true},
+	})
+
+	// The refresh blocks until release, the stale list is returned meanwhile
+	for i := 0; i < 3; i++ {
+		if domains, _ := resolver.stores(context.Background()); domains["old.test"] != "old" {
+			t.Fatalf("stores = %v, want the stale list", domains)
+		}
+	}
+	close(lister.release)
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		if domains, _ := resolver.stores(context.Background()); domains["shop.acme.test"] == "acme" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("refreshed stores never served")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if calls := lister.calls.Load(); calls != 1 {
+		t.Errorf("ListStores called %d times, want 1", calls)
+	}
+}
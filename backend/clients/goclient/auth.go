@@ -0,0 +1,95 @@
+package goclient
+
+import (
+	"context"
+	"net/http"
+)
+
+// User is a user account as returned by the gateway
+type User struct {
+	UserID        string `json:"user_id"`
+	Email         string `json:"email"`
+	Username      string `json:"username,omitempty"`
+	FirstName     string `json:"first_name,omitempty"`
+	LastName      string `json:"last_name,omitempty"`
+	PhoneNumber   string `json:"phone_number,omitempty"`
+	UserType      string `json:"user_type,omitempty"`
+	Role          string `json:"role,omitempty"`
+	AccountStatus string `json:"account_status,omitempty"`
+	EmailVerified bool   `json:"email_verified,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+	UpdatedAt     string `json:"updated_at,omitempty"`
+	LastLogin     string `json:"last_login,omitempty"`
+}
+
+// Session is the result of a login or token refresh
+type Session struct {
+	AccessToken string `json:"access_token"`
+	User        *User  `json:"user"`
+}
+
+// RegisterInput is the body of a registration request
+type RegisterInput struct {
+	Email     string `json:"Email"`
+	Password  string `json:"Password"`
+	FirstName string `json:"FirstName"`
+	LastName  string `json:"LastName"`
+}
+
+// Register creates a customer account
+func (c *Client) Register(ctx context.Context, input RegisterInput, opts ...RequestOption) (*User, error) {
+	var resp struct {
+		User *User `json:"user"`
+	}
+	if err := c.do(ctx, http.MethodPost, "/users/register", nil, input, &resp, opts...); err != nil {
+		return nil, err
+	}
+	return resp.User, nil
+}
+
+// Login signs in and uses the access token for the following requests. The
+// refresh token is kept in the cookie jar of the HTTP client.
+func (c *Client) Login(ctx context.Context, email, password string) (*Session, error) {
+	body := struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}{Email: email, Password: password}
+
+	var session Session
+	if err := c.do(ctx, http.MethodPost, "/users/login", nil, body, &session); err != nil {
+		return nil, err
+	}
+	c.SetAccessToken(session.AccessToken)
+	return &session, nil
+}
+
+// RefreshToken exchanges the refresh token cookie set at login for a new
+// access token and uses it for the following requests
+func (c *Client) RefreshToken(ctx context.Context) (*Session, error) {
+	var session Session
+	if err := c.do(ctx, http.MethodPost, "/users/refresh", nil, nil, &session); err != nil {
+		return nil, err
+	}
+	c.SetAccessToken(session.AccessToken)
+	return &session, nil
+}
+
+// Logout clears the refresh token cookie and forgets the access token
+func (c *Client) Logout(ctx context.Context) error {
+	if err := c.do(ctx, http.MethodPost, "/users/logout", nil, nil, nil); err != nil {
+		return err
+	}
+	c.SetAccessToken("")
+	return nil
+}
+
+// Profile returns the signed in user
+func (c *Client) Profile(ctx context.Context) (*User, error) {
+	var resp struct {
+		User *User `json:"user"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/users/profile", nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.User, nil
+}
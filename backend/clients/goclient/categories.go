@@ -0,0 +1,86 @@
+package goclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Category is a category as returned by the gateway
+type Category struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Slug        string  `json:"slug,omitempty"`
+	Description string  `json:"description,omitempty"`
+	ParentID    *string `json:"parent_id,omitempty"`
+	ParentName  string  `json:"parent_name,omitempty"`
+	CreatedAt   string  `json:"created_at,omitempty"`
+	UpdatedAt   string  `json:"updated_at,omitempty"`
+}
+
+// CategoryList is a page of categories
+type CategoryList struct {
+	Categories []Category `json:"categories"`
+	Total      int        `json:"total"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// CategoryInput is the body of a category create request
+type CategoryInput struct {
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description,omitempty"`
+	ParentID    string `json:"parent_id,omitempty"`
+}
+
+// ListCategories returns a page of categories. Zero page or limit use the
+// gateway defaults.
+func (c *Client) ListCategories(ctx context.Context, page, limit int) (*CategoryList, error) {
+	query := url.Values{}
+	if page > 0 {
+		query.Set("page", strconv.Itoa(page))
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var list CategoryList
+	if err := c.do(ctx, http.MethodGet, "/categories", query, nil, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// CategoryPager walks every category, limit per page
+func (c *Client) CategoryPager(limit int) *Pager[Category] {
+	return NewPager(func(ctx context.Context, page int) ([]Category, int, error) {
+		list, err := c.ListCategories(ctx, page, limit)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Categories, list.Pagination.TotalPages, nil
+	})
+}
+
+// GetCategory returns a category by ID
+func (c *Client) GetCategory(ctx context.Context, id string) (*Category, error) {
+	var category Category
+	if err := c.do(ctx, http.MethodGet, "/categories/"+url.PathEscape(id), nil, nil, &category); err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// CreateCategory creates a category. Requires an admin token.
+func (c *Client) CreateCategory(ctx context.Context, input CategoryInput, opts ...RequestOption) (*Category, error) {
+	body := struct {
+		Category CategoryInput `json:"category"`
+	}{Category: input}
+
+	var category Category
+	if err := c.do(ctx, http.MethodPost, "/categories", nil, body, &category, opts...); err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
@@ -0,0 +1,338 @@
+// Package goclient is a typed Go client for the api-gateway REST API. It is
+// meant for internal tools and tests that would otherwise hand-roll HTTP
+// calls against /api/v1.
+//
+//	client := goclient.New("http://localhost:8080", goclient.WithTenant("default"))
+//	if _, err := client.Login(ctx, "admin@example.com", "secret"); err != nil {
+//		return err
+//	}
+//	products, err := client.ListProducts(ctx, goclient.ListProductsParams{Limit: 50})
+package goclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	apiPrefix = "/api/v1"
+
+	// TenantHeader selects the store a request is made for
+	TenantHeader = "X-Tenant-ID"
+	// IdempotencyKeyHeader carries the idempotency key of mutating requests
+	IdempotencyKeyHeader = "Idempotency-Key"
+
+	defaultTimeout    = 30 * time.Second
+	defaultMaxRetries = 3
+	defaultMinBackoff = 200 * time.Millisecond
+	defaultMaxBackoff = 5 * time.Second
+)
+
+// Client calls the api-gateway REST API. It is safe for concurrent use.
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	tenantID   string
+	userAgent  string
+	maxRetries int
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	mu          sync.RWMutex
+	accessToken string
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sets the HTTP client used for requests. A client without a
+// cookie jar cannot refresh tokens, since the refresh token is a cookie.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithTenant sends every request for the given store
+func WithTenant(tenantID string) Option {
+	return func(c *Client) {
+		c.tenantID = tenantID
+	}
+}
+
+// WithAccessToken sets the bearer token sent with every request
+func WithAccessToken(token string) Option {
+	return func(c *Client) {
+		c.accessToken = token
+	}
+}
+
+// WithUserAgent sets the User-Agent header
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithRetries sets how often retryable requests are retried and the bounds
+// of the exponential backoff between attempts. Zero retries disables them.
+func WithRetries(maxRetries int, minBackoff, maxBackoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.minBackoff = minBackoff
+		c.maxBackoff = maxBackoff
+	}
+}
+
+// New creates a client for the gateway at baseURL, e.g. http://localhost:8080
+func New(baseURL string, opts ...Option) *Client {
+	jar, _ := cookiejar.New(nil)
+	c := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: defaultTimeout, Jar: jar},
+		userAgent:  "e-commerce-goclient",
+		maxRetries: defaultMaxRetries,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// AccessToken returns the bearer token currently sent with requests
+func (c *Client) AccessToken() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.accessToken
+}
+
+// SetAccessToken replaces the bearer token sent with requests
+func (c *Client) SetAccessToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.accessToken = token
+}
+
+// APIError is returned for responses with a non 2xx status
+type APIError struct {
+	StatusCode int
+	Message    string // The "error" field of the response, or the raw body
+	RequestID  string
+}
+
+func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("api error %d: %s (request %s)", e.StatusCode, e.Message, e.RequestID)
+	}
+	return fmt.Sprintf("api error %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound reports whether err is an APIError with status 404
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// NewIdempotencyKey returns a random key for a mutating request. Reusing the
+// key when retrying the same operation lets the server recognise duplicates.
+func NewIdempotencyKey() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// crypto/rand does not fail on supported platforms
+		panic(fmt.Sprintf("goclient: failed to generate idempotency key: %v", err))
+	}
+	return hex.EncodeToString(b[:])
+}
+
+// RequestOption adjusts a single request
+type RequestOption func(*requestOptions)
+
+type requestOptions struct {
+	idempotencyKey string
+	headers        http.Header
+}
+
+// WithIdempotencyKey sends the key in the Idempotency-Key header. Mutating
+// requests are only retried when they carry a key.
+func WithIdempotencyKey(key string) RequestOption {
+	return func(o *requestOptions) {
+		o.idempotencyKey = key
+	}
+}
+
+// WithHeader adds a header to the request
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		if o.headers == nil {
+			o.headers = http.Header{}
+		}
+		o.headers.Add(key, value)
+	}
+}
+
+// do sends a request to path (relative to /api/v1) with body encoded as
+// JSON, and decodes the response into out when it is not nil. GET, PUT and
+// DELETE requests, and POST requests with an idempotency key, are retried on
+// network errors, 429 and 5xx responses.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, out any, opts ...RequestOption) error {
+	var options requestOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var payload []byte
+	if body != nil {
+		var err error
+		payload, err = json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+	}
+
+	target := c.baseURL + apiPrefix + path
+	if len(query) > 0 {
+		target += "?" + query.Encode()
+	}
+
+	retryable := method != http.MethodPost || options.idempotencyKey != ""
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, method, target, payload, options)
+		if err == nil && resp.StatusCode < 300 {
+			defer resp.Body.Close()
+			if out == nil {
+				return nil
+			}
+			if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+				return fmt.Errorf("failed to decode response: %w", err)
+			}
+			return nil
+		}
+
+		var wait time.Duration
+		if err == nil {
+			err = readAPIError(resp)
+			wait = retryAfter(resp)
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if !retryable || attempt >= c.maxRetries || !shouldRetry(err) {
+			return err
+		}
+
+		if backoff := c.backoff(attempt); wait < backoff {
+			wait = backoff
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// send makes a single attempt of a request
+func (c *Client) send(ctx context.Context, method, target string, payload []byte, options requestOptions) (*http.Response, error) {
+	var body io.Reader
+	if payload != nil {
+		body = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	if c.tenantID != "" {
+		req.Header.Set(TenantHeader, c.tenantID)
+	}
+	if token := c.AccessToken(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if options.idempotencyKey != "" {
+		req.Header.Set(IdempotencyKeyHeader, options.idempotencyKey)
+	}
+	for key, values := range options.headers {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	return c.httpClient.Do(req)
+}
+
+// readAPIError consumes and closes the body of a failed response
+func readAPIError(resp *http.Response) error {
+	defer resp.Body.Close()
+	data, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+
+	apiErr := &APIError{StatusCode: resp.StatusCode, RequestID: resp.Header.Get("X-Request-ID")}
+	var body struct {
+		Error     string `json:"error"`
+		RequestID string `json:"request_id"`
+	}
+	if json.Unmarshal(data, &body) == nil && body.Error != "" {
+		apiErr.Message = body.Error
+		if body.RequestID != "" {
+			apiErr.RequestID = body.RequestID
+		}
+	} else {
+		apiErr.Message = strings.TrimSpace(string(data))
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	return apiErr
+}
+
+// shouldRetry reports whether a failed attempt may succeed when repeated
+func shouldRetry(err error) bool {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+	// Transport errors such as refused connections
+	return true
+}
+
+// retryAfter returns the wait asked for by a Retry-After header in seconds
+func retryAfter(resp *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(resp.Header.Get("Retry-After"))
+	if err != nil || seconds <= 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// backoff returns the exponential backoff before retry attempt+1
+func (c *Client) backoff(attempt int) time.Duration {
+	wait := time.Duration(float64(c.minBackoff) * math.Pow(2, float64(attempt)))
+	if wait > c.maxBackoff || wait <= 0 {
+		wait = c.maxBackoff
+	}
+	return wait
+}
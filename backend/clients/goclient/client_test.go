@@ -0,0 +1,144 @@
+package goclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	return New(server.URL, WithTenant("store-a"), WithRetries(2, time.Millisecond, time.Millisecond))
+}
+
+func TestLoginStoresAccessToken(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/users/login":
+			http.SetCookie(w, &http.Cookie{Name: "refresh_token", Value: "refresh", Path: "/api/v1/users/refresh"})
+			json.NewEncoder(w).Encode(map[string]any{"access_token": "token-1", "user": map[string]string{"user_id": "u1"}})
+		case "/api/v1/users/refresh":
+			if cookie, err := r.Cookie("refresh_token"); err != nil || cookie.Value != "refresh" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{"access_token": "token-2"})
+		case "/api/v1/products/p1":
+			if r.Header.Get("Authorization") != "Bearer token-2" || r.Header.Get(TenantHeader) != "store-a" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]any{"id": "p1", "title": "Mug"})
+		}
+	})
+
+	ctx := context.Background()
+	session, err := client.Login(ctx, "a@example.com", "secret")
+	if err != nil {
+		t.Fatalf("Login() error = %v", err)
+	}
+	if session.User.UserID != "u1" || client.AccessToken() != "token-1" {
+		t.Fatalf("Login() session = %+v, token = %q", session, client.AccessToken())
+	}
+	if _, err := client.RefreshToken(ctx); err != nil {
+		t.Fatalf("RefreshToken() error = %v", err)
+	}
+
+	product, err := client.GetProduct(ctx, "p1")
+	if err != nil {
+		t.Fatalf("GetProduct() error = %v", err)
+	}
+	if product.Title != "Mug" {
+		t.Errorf("GetProduct() title = %q", product.Title)
+	}
+}
+
+func TestRetries(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method == http.MethodPost && r.Header.Get(IdempotencyKeyHeader) != "key-1" {
+			t.Errorf("idempotency key = %q", r.Header.Get(IdempotencyKeyHeader))
+		}
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]any{"id": "c1"})
+	})
+	ctx := context.Background()
+
+	if _, err := client.GetCategory(ctx, "c1"); err != nil {
+		t.Fatalf("GetCategory() error = %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("GET made %d calls, want 3", calls.Load())
+	}
+
+	// POST requests are only retried with an idempotency key
+	calls.Store(0)
+	_, err := client.CreateCategory(ctx, CategoryInput{Name: "Mugs", Slug: "mugs"})
+	if apiErr, ok := err.(*APIError); !ok || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("CreateCategory() error = %v, want a 503 APIError", err)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("POST without key made %d calls, want 1", calls.Load())
+	}
+
+	calls.Store(0)
+	if _, err := client.CreateCategory(ctx, CategoryInput{Name: "Mugs", Slug: "mugs"}, WithIdempotencyKey("key-1")); err != nil {
+		t.Fatalf("CreateCategory() error = %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("POST with key made %d calls, want 3", calls.Load())
+	}
+}
+
+func TestAPIErrorIsNotRetried(t *testing.T) {
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(map[string]string{"error": "product not found", "request_id": "req-1"})
+	})
+
+	_, err := client.GetProduct(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Fatalf("GetProduct() error = %v, want not found", err)
+	}
+	if apiErr := err.(*APIError); apiErr.Message != "product not found" || apiErr.RequestID != "req-1" {
+		t.Errorf("APIError = %+v", apiErr)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("made %d calls, want 1", calls.Load())
+	}
+}
+
+func TestProductPager(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		products := []map[string]string{{"id": "p" + strconv.Itoa(page*2-1)}, {"id": "p" + strconv.Itoa(page*2)}}
+		if page == 3 {
+			products = products[:1]
+		}
+		json.NewEncoder(w).Encode(map[string]any{
+			"products":   products,
+			"pagination": map[string]int{"current_page": page, "total_pages": 3, "per_page": 2, "total_items": 5},
+		})
+	})
+
+	products, err := client.ProductPager(ListProductsParams{Limit: 2}).All(context.Background())
+	if err != nil {
+		t.Fatalf("All() error = %v", err)
+	}
+	if len(products) != 5 || products[4].ID != "p5" {
+		t.Errorf("All() = %+v", products)
+	}
+}
@@ -0,0 +1,3 @@
+module github.com/louai60/e-commerce_project/backend/clients/goclient
+
+go 1.24.0
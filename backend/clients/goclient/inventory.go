@@ -0,0 +1,194 @@
+package goclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// InventoryItem is the stock of a product across warehouses
+type InventoryItem struct {
+	ID                string              `json:"id"`
+	ProductID         string              `json:"product_id"`
+	VariantID         string              `json:"variant_id"`
+	SKU               string              `json:"sku"`
+	TotalQuantity     int                 `json:"total_quantity"`
+	AvailableQuantity int                 `json:"available_quantity"`
+	ReservedQuantity  int                 `json:"reserved_quantity"`
+	ReorderPoint      int                 `json:"reorder_point"`
+	ReorderQuantity   int                 `json:"reorder_quantity"`
+	Status            string              `json:"status"`
+	Locations         []InventoryLocation `json:"locations"`
+	LastUpdated       string              `json:"last_updated"`
+	CreatedAt         string              `json:"created_at"`
+	UpdatedAt         string              `json:"updated_at"`
+}
+
+// InventoryLocation is the stock of an item in one warehouse
+type InventoryLocation struct {
+	ID                string     `json:"id"`
+	WarehouseID       string     `json:"warehouse_id"`
+	Quantity          int        `json:"quantity"`
+	AvailableQuantity int        `json:"available_quantity"`
+	ReservedQuantity  int        `json:"reserved_quantity"`
+	SafetyStock       int        `json:"safety_stock"`
+	MaxStock          int        `json:"max_stock"`
+	Warehouse         *Warehouse `json:"warehouse"`
+}
+
+// Warehouse is a stock location
+type Warehouse struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Code       string `json:"code"`
+	Address    string `json:"address"`
+	City       string `json:"city"`
+	State      string `json:"state"`
+	Country    string `json:"country"`
+	PostalCode string `json:"postal_code"`
+	IsActive   bool   `json:"is_active"`
+	Priority   int    `json:"priority"`
+}
+
+// InventoryPagination describes the page of an inventory listing
+type InventoryPagination struct {
+	Total      int `json:"total"`
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	TotalPages int `json:"total_pages"`
+}
+
+// InventoryItemList is a page of inventory items
+type InventoryItemList struct {
+	Items      []InventoryItem     `json:"items"`
+	Pagination InventoryPagination `json:"pagination"`
+}
+
+// ListInventoryItemsParams filters an inventory listing
+type ListInventoryItemsParams struct {
+	Page         int
+	Limit        int // At most 100
+	Status       string
+	WarehouseID  string
+	LowStockOnly bool
+}
+
+// Availability is the result of a single availability check
+type Availability struct {
+	ProductID string `json:"product_id"`
+	Quantity  int    `json:"quantity"`
+	Available bool   `json:"available"`
+}
+
+// AvailabilityLine is one line of a bulk availability check
+type AvailabilityLine struct {
+	SKU         string `json:"sku"`
+	Quantity    int    `json:"quantity"`
+	WarehouseID string `json:"warehouse_id,omitempty"`
+}
+
+// AvailabilityResult is the availability of one line of a bulk check
+type AvailabilityResult struct {
+	LineIndex         int                       `json:"line_index"`
+	SKU               string                    `json:"sku"`
+	ProductID         string                    `json:"product_id"`
+	VariantID         string                    `json:"variant_id,omitempty"`
+	WarehouseID       string                    `json:"warehouse_id,omitempty"`
+	RequestedQuantity int                       `json:"requested_quantity"`
+	AvailableQuantity int                       `json:"available_quantity"`
+	Available         bool                      `json:"available"`
+	Status            string                    `json:"status"`
+	Alternatives      []AvailabilityAlternative `json:"alternatives"`
+}
+
+// AvailabilityAlternative is a way to fulfil a line that is not available
+// as requested
+type AvailabilityAlternative struct {
+	Type              string `json:"type"`
+	Quantity          int    `json:"quantity"`
+	AvailableQuantity int    `json:"available_quantity"`
+	WarehouseID       string `json:"warehouse_id,omitempty"`
+	WarehouseName     string `json:"warehouse_name,omitempty"`
+}
+
+// BulkAvailability is the result of a bulk availability check
+type BulkAvailability struct {
+	Items        []AvailabilityResult `json:"items"`
+	AllAvailable bool                 `json:"all_available"`
+}
+
+// CheckAvailability reports whether quantity units of a product are available
+func (c *Client) CheckAvailability(ctx context.Context, productID string, quantity int) (*Availability, error) {
+	query := url.Values{}
+	query.Set("product_id", productID)
+	query.Set("quantity", strconv.Itoa(quantity))
+
+	var availability Availability
+	if err := c.do(ctx, http.MethodGet, "/inventory/check", query, nil, &availability); err != nil {
+		return nil, err
+	}
+	return &availability, nil
+}
+
+// CheckAvailabilityBulk checks the lines of a cart or checkout at once. The
+// check has no side effects, so it is always retried.
+func (c *Client) CheckAvailabilityBulk(ctx context.Context, lines []AvailabilityLine) (*BulkAvailability, error) {
+	body := struct {
+		Items []AvailabilityLine `json:"items"`
+	}{Items: lines}
+
+	var result BulkAvailability
+	if err := c.do(ctx, http.MethodPost, "/inventory/check-bulk", nil, body, &result, WithIdempotencyKey(NewIdempotencyKey())); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetInventoryItem returns the stock of a product. Requires an admin token.
+func (c *Client) GetInventoryItem(ctx context.Context, productID string) (*InventoryItem, error) {
+	var item InventoryItem
+	if err := c.do(ctx, http.MethodGet, "/inventory/items/"+url.PathEscape(productID), nil, nil, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+// ListInventoryItems returns a page of inventory items. Requires an admin
+// token.
+func (c *Client) ListInventoryItems(ctx context.Context, params ListInventoryItemsParams) (*InventoryItemList, error) {
+	query := url.Values{}
+	if params.Page > 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.Limit > 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Status != "" {
+		query.Set("status", params.Status)
+	}
+	if params.WarehouseID != "" {
+		query.Set("warehouse_id", params.WarehouseID)
+	}
+	if params.LowStockOnly {
+		query.Set("low_stock_only", "true")
+	}
+
+	var list InventoryItemList
+	if err := c.do(ctx, http.MethodGet, "/inventory/items", query, nil, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// InventoryItemPager walks every inventory item matching params
+func (c *Client) InventoryItemPager(params ListInventoryItemsParams) *Pager[InventoryItem] {
+	return NewPager(func(ctx context.Context, page int) ([]InventoryItem, int, error) {
+		params.Page = page
+		list, err := c.ListInventoryItems(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Items, list.Pagination.TotalPages, nil
+	})
+}
@@ -0,0 +1,83 @@
+package goclient
+
+import "context"
+
+// Pagination describes the page a list response holds
+type Pagination struct {
+	CurrentPage int `json:"current_page"`
+	TotalPages  int `json:"total_pages"`
+	PerPage     int `json:"per_page"`
+	TotalItems  int `json:"total_items"`
+}
+
+// PageFetcher fetches one page of a list. It returns the items of the page
+// and the total number of pages.
+type PageFetcher[T any] func(ctx context.Context, page int) (items []T, totalPages int, err error)
+
+// Pager walks every item of a paginated list, fetching pages as needed:
+//
+//	pager := client.ProductPager(goclient.ListProductsParams{Limit: 100})
+//	for pager.Next(ctx) {
+//		product := pager.Item()
+//	}
+//	if err := pager.Err(); err != nil {
+//		return err
+//	}
+type Pager[T any] struct {
+	fetch      PageFetcher[T]
+	page       int
+	totalPages int
+	items      []T
+	index      int
+	item       T
+	err        error
+	done       bool
+}
+
+// NewPager creates a pager starting at page 1
+func NewPager[T any](fetch PageFetcher[T]) *Pager[T] {
+	return &Pager[T]{fetch: fetch}
+}
+
+// Next advances to the next item. It returns false when the list is
+// exhausted or a page failed to load.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	for p.index >= len(p.items) {
+		if p.done || p.err != nil {
+			return false
+		}
+		p.page++
+		items, totalPages, err := p.fetch(ctx, p.page)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		p.items, p.index, p.totalPages = items, 0, totalPages
+		// An empty page ends the list even when the total is stale
+		if len(items) == 0 || p.page >= totalPages {
+			p.done = true
+		}
+	}
+	p.item = p.items[p.index]
+	p.index++
+	return true
+}
+
+// Item returns the current item
+func (p *Pager[T]) Item() T {
+	return p.item
+}
+
+// Err returns the error that stopped the pager, if any
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All loads every remaining item
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var all []T
+	for p.Next(ctx) {
+		all = append(all, p.Item())
+	}
+	return all, p.Err()
+}
@@ -0,0 +1,223 @@
+package goclient
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Product is a product as returned by the gateway
+type Product struct {
+	ID               string            `json:"id"`
+	Title            string            `json:"title"`
+	Slug             string            `json:"slug"`
+	ShortDescription string            `json:"short_description"`
+	Description      string            `json:"description"`
+	SKU              string            `json:"sku"`
+	DefaultVariantID string            `json:"default_variant_id,omitempty"`
+	Price            *Price            `json:"price"`
+	Attributes       []Attribute       `json:"attributes"`
+	Variants         []Variant         `json:"variants"`
+	Images           []Image           `json:"images"`
+	Tags             []string          `json:"tags"`
+	Specifications   []Specification   `json:"specifications"`
+	Brand            *BrandRef         `json:"brand,omitempty"`
+	Categories       []CategoryRef     `json:"categories,omitempty"`
+	Inventory        *ProductInventory `json:"inventory"`
+	Metadata         *ProductMetadata  `json:"metadata"`
+	SEO              *SEO              `json:"seo,omitempty"`
+	ProductType      string            `json:"product_type"`
+	RequiresShipping bool              `json:"requires_shipping"`
+}
+
+// Price is the price block of a product
+type Price struct {
+	Current           map[string]float64 `json:"current"`
+	Currency          string             `json:"currency"`
+	SavingsPercentage float64            `json:"savings_percentage,omitempty"`
+}
+
+// Attribute is a name/value pair on a product or variant
+type Attribute struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Specification is a product specification
+type Specification struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Unit  string `json:"unit,omitempty"`
+}
+
+// Image is a product or variant image
+type Image struct {
+	ID       string `json:"id,omitempty"`
+	URL      string `json:"url"`
+	AltText  string `json:"alt_text"`
+	Position int    `json:"position"`
+}
+
+// Variant is a product variant
+type Variant struct {
+	ID            string      `json:"id,omitempty"`
+	ProductID     string      `json:"product_id,omitempty"`
+	SKU           string      `json:"sku"`
+	Title         string      `json:"title"`
+	Price         float64     `json:"price"`
+	DiscountPrice float64     `json:"discount_price,omitempty"`
+	InventoryQty  int         `json:"inventory_qty"`
+	Attributes    []Attribute `json:"attributes"`
+	Images        []Image     `json:"images,omitempty"`
+}
+
+// BrandRef references the brand of a product
+type BrandRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	Slug string `json:"slug,omitempty"`
+}
+
+// CategoryRef references a category of a product
+type CategoryRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+	Slug string `json:"slug,omitempty"`
+}
+
+// ProductInventory is the stock summary embedded in a product
+type ProductInventory struct {
+	Status            string `json:"status"`
+	Available         bool   `json:"available"`
+	TotalQuantity     int    `json:"total_quantity"`
+	AvailableQuantity int    `json:"available_quantity"`
+	ReservedQuantity  int    `json:"reserved_quantity"`
+	LastUpdated       string `json:"last_updated,omitempty"`
+}
+
+// ProductMetadata holds the publication state of a product
+type ProductMetadata struct {
+	IsPublished bool  `json:"is_published"`
+	CreatedAt   int64 `json:"created_at"`
+	UpdatedAt   int64 `json:"updated_at"`
+}
+
+// SEO holds the SEO fields of a product
+type SEO struct {
+	MetaTitle       string   `json:"meta_title"`
+	MetaDescription string   `json:"meta_description"`
+	Keywords        []string `json:"keywords"`
+}
+
+// ProductList is a page of products
+type ProductList struct {
+	Products   []Product  `json:"products"`
+	Total      int        `json:"total"`
+	Pagination Pagination `json:"pagination"`
+}
+
+// ListProductsParams filters a product listing
+type ListProductsParams struct {
+	Page    int    // Defaults to 1
+	Limit   int    // Defaults to the gateway default
+	Channel string // Sales channel code, optional
+}
+
+// ProductInput is the body of product create and update requests. Update
+// requests only change the fields that are set.
+type ProductInput struct {
+	Title            string          `json:"title,omitempty"`
+	Slug             string          `json:"slug,omitempty"`
+	Description      string          `json:"description,omitempty"`
+	ShortDescription string          `json:"short_description,omitempty"`
+	Price            float64         `json:"price,omitempty"`
+	DiscountPrice    *float64        `json:"discount_price,omitempty"`
+	SKU              string          `json:"sku,omitempty"`
+	Weight           *float64        `json:"weight,omitempty"`
+	IsPublished      bool            `json:"is_published,omitempty"`
+	BrandID          *string         `json:"brand_id,omitempty"`
+	Images           []Image         `json:"images,omitempty"`
+	Categories       []CategoryRef   `json:"categories,omitempty"`
+	Variants         []Variant       `json:"variants,omitempty"`
+	Tags             []string        `json:"tags,omitempty"`
+	Attributes       []Attribute     `json:"attributes,omitempty"`
+	Specifications   []Specification `json:"specifications,omitempty"`
+	SEO              *SEO            `json:"seo,omitempty"`
+	// Inventory sets the initial stock of a new product
+	Inventory *InitialInventory `json:"inventory,omitempty"`
+}
+
+// InitialInventory is the stock a product is created with
+type InitialInventory struct {
+	InitialQuantity int `json:"initial_quantity"`
+}
+
+type productEnvelope struct {
+	Product ProductInput `json:"product"`
+}
+
+// ListProducts returns a page of products
+func (c *Client) ListProducts(ctx context.Context, params ListProductsParams) (*ProductList, error) {
+	query := url.Values{}
+	if params.Page > 0 {
+		query.Set("page", strconv.Itoa(params.Page))
+	}
+	if params.Limit > 0 {
+		query.Set("limit", strconv.Itoa(params.Limit))
+	}
+	if params.Channel != "" {
+		query.Set("channel", params.Channel)
+	}
+
+	var list ProductList
+	if err := c.do(ctx, http.MethodGet, "/products", query, nil, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// ProductPager walks every product matching params, starting at page 1
+func (c *Client) ProductPager(params ListProductsParams) *Pager[Product] {
+	return NewPager(func(ctx context.Context, page int) ([]Product, int, error) {
+		params.Page = page
+		list, err := c.ListProducts(ctx, params)
+		if err != nil {
+			return nil, 0, err
+		}
+		return list.Products, list.Pagination.TotalPages, nil
+	})
+}
+
+// GetProduct returns a product by ID
+func (c *Client) GetProduct(ctx context.Context, id string) (*Product, error) {
+	var product Product
+	if err := c.do(ctx, http.MethodGet, "/products/"+url.PathEscape(id), nil, nil, &product); err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// CreateProduct creates a product. Requires an admin token.
+func (c *Client) CreateProduct(ctx context.Context, input ProductInput, opts ...RequestOption) (*Product, error) {
+	var product Product
+	if err := c.do(ctx, http.MethodPost, "/products", nil, productEnvelope{Product: input}, &product, opts...); err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// UpdateProduct updates the fields of a product that are set in input.
+// Requires an admin token.
+func (c *Client) UpdateProduct(ctx context.Context, id string, input ProductInput, opts ...RequestOption) (*Product, error) {
+	var product Product
+	if err := c.do(ctx, http.MethodPut, "/products/"+url.PathEscape(id), nil, productEnvelope{Product: input}, &product, opts...); err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// DeleteProduct deletes a product. Requires an admin token.
+func (c *Client) DeleteProduct(ctx context.Context, id string, opts ...RequestOption) error {
+	return c.do(ctx, http.MethodDelete, "/products/"+url.PathEscape(id), nil, nil, nil, opts...)
+}
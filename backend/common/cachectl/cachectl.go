@@ -0,0 +1,101 @@
+// Package cachectl carries cache control from the gateway through the gRPC
+// services: whether a request bypasses the caches, and whether the services
+// answered it from them.
+package cachectl
+
+import (
+	"context"
+	"sync"
+)
+
+const (
+	// BypassMetadataKey is the gRPC metadata key asking services to skip
+	// their caches
+	BypassMetadataKey = "x-cache-bypass"
+	// StatusMetadataKey is the gRPC header metadata key carrying the Status
+	// of a call
+	StatusMetadataKey = "x-cache"
+)
+
+// Status tells whether a request was answered from the caches
+type Status string
+
+const (
+	StatusHit    Status = "HIT"
+	StatusMiss   Status = "MISS"
+	StatusBypass Status = "BYPASS"
+)
+
+// rank orders statuses by precedence: a request is a hit only when all its
+// lookups hit, and a bypass whenever one of them skipped the cache
+func (s Status) rank() int {
+	switch s {
+	case StatusHit:
+		return 1
+	case StatusMiss:
+		return 2
+	case StatusBypass:
+		return 3
+	}
+	return 0
+}
+
+// Recorder collects the cache lookups of one request
+type Recorder struct {
+	mu     sync.Mutex
+	status Status
+}
+
+// Record adds a lookup to the recorder
+func (r *Recorder) Record(status Status) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if status.rank() > r.status.rank() {
+		r.status = status
+	}
+}
+
+// Status returns the status of the request, empty when it did no lookup
+func (r *Recorder) Status() Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status
+}
+
+type bypassKey struct{}
+
+type recorderKey struct{}
+
+// WithBypass returns a copy of ctx whose requests skip the caches
+func WithBypass(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassKey{}, true)
+}
+
+// Bypassed reports whether requests made with ctx skip the caches
+func Bypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassKey{}).(bool)
+	return bypass
+}
+
+// WithRecorder returns a copy of ctx recording its cache lookups in a new
+// recorder
+func WithRecorder(ctx context.Context) (context.Context, *Recorder) {
+	recorder := &Recorder{}
+	return context.WithValue(ctx, recorderKey{}, recorder), recorder
+}
+
+// Record adds a lookup to the recorder of ctx, if it has one
+func Record(ctx context.Context, status Status) {
+	if recorder, ok := ctx.Value(recorderKey{}).(*Recorder); ok {
+		recorder.Record(status)
+	}
+}
+
+// RecordLookup records a cache lookup that hit or missed
+func RecordLookup(ctx context.Context, hit bool) {
+	if hit {
+		Record(ctx, StatusHit)
+	} else {
+		Record(ctx, StatusMiss)
+	}
+}
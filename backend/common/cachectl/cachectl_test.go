@@ -0,0 +1,69 @@
+package cachectl
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRecorder(t *testing.T) {
+	tests := []struct {
+		name     string
+		lookups  []Status
+		expected Status
+	}{
+		{"no lookup", nil, ""},
+		{"all hits", []Status{StatusHit, StatusHit}, StatusHit},
+		{"one miss", []Status{StatusHit, StatusMiss, StatusHit}, StatusMiss},
+		{"bypass", []Status{StatusMiss, StatusBypass, StatusHit}, StatusBypass},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, recorder := WithRecorder(context.Background())
+			for _, status := range tt.lookups {
+				Record(ctx, status)
+			}
+			if got := recorder.Status(); got != tt.expected {
+				t.Errorf("Status() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	// Lookups outside a request are not recorded
+	RecordLookup(context.Background(), true)
+}
+
+func TestInterceptors(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(BypassMetadataKey, "true"))
+	_, err := UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		if !Bypassed(ctx) {
+			t.Error("Bypassed() = false in handler, want true")
+		}
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("UnaryServerInterceptor() unexpected error: %v", err)
+	}
+
+	ctx, recorder := WithRecorder(WithBypass(context.Background()))
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		if values := md.Get(BypassMetadataKey); len(values) != 1 || values[0] != "true" {
+			t.Errorf("outgoing %s = %v, want [true]", BypassMetadataKey, values)
+		}
+		for _, opt := range opts {
+			if header, ok := opt.(grpc.HeaderCallOption); ok {
+				*header.HeaderAddr = metadata.Pairs(StatusMetadataKey, string(StatusMiss))
+			}
+		}
+		return nil
+	}
+	if err := UnaryClientInterceptor()(ctx, "/test.Service/Get", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("UnaryClientInterceptor() unexpected error: %v", err)
+	}
+	if got := recorder.Status(); got != StatusMiss {
+		t.Errorf("recorded status = %q, want %q", got, StatusMiss)
+	}
+}
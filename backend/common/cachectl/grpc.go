@@ -0,0 +1,44 @@
+package cachectl
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryServerInterceptor skips the caches for calls carrying the bypass
+// metadata and returns the cache status of each call in its header
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(BypassMetadataKey); len(values) > 0 && values[0] == "true" {
+				ctx = WithBypass(ctx)
+			}
+		}
+		ctx, recorder := WithRecorder(ctx)
+
+		resp, err := handler(ctx, req)
+		if status := recorder.Status(); status != "" {
+			grpc.SetHeader(ctx, metadata.Pairs(StatusMetadataKey, string(status)))
+		}
+		return resp, err
+	}
+}
+
+// UnaryClientInterceptor forwards the bypass of the context to the called
+// service and records the cache status it returns
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if Bypassed(ctx) {
+			ctx = metadata.AppendToOutgoingContext(ctx, BypassMetadataKey, "true")
+		}
+
+		var header metadata.MD
+		err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+		if values := header.Get(StatusMetadataKey); len(values) > 0 {
+			Record(ctx, Status(values[0]))
+		}
+		return err
+	}
+}
@@ -0,0 +1,79 @@
+// Package downloadtoken signs and verifies expiring download links. A token
+// carries the ID of the grant it gives access to and is only valid with the
+// secret it was signed with.
+package downloadtoken
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalid = errors.New("invalid download token")
+	ErrExpired = errors.New("download link has expired")
+)
+
+// Claims identifies the download grant a signed link is valid for
+type Claims struct {
+	GrantID   string
+	ExpiresAt time.Time
+}
+
+// Sign creates a URL-safe token for the claims
+// Format: base64url(GRANT_ID:EXPIRY_UNIX).base64url(HMAC-SHA256)
+func Sign(secret []byte, claims Claims) string {
+	payload := claims.GrantID + ":" + strconv.FormatInt(claims.ExpiresAt.Unix(), 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(payload)) + "." +
+		base64.RawURLEncoding.EncodeToString(sign(secret, payload))
+}
+
+// Verify checks the signature and expiry of a token created by
+// Sign and returns its claims
+func Verify(secret []byte, token string, now time.Time) (*Claims, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalid
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	if !hmac.Equal(signature, sign(secret, string(payload))) {
+		return nil, ErrInvalid
+	}
+
+	grantID, expiry, ok := strings.Cut(string(payload), ":")
+	if !ok || grantID == "" {
+		return nil, ErrInvalid
+	}
+	expiresAt, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+
+	claims := &Claims{
+		GrantID:   grantID,
+		ExpiresAt: time.Unix(expiresAt, 0),
+	}
+	if !now.Before(claims.ExpiresAt) {
+		return nil, ErrExpired
+	}
+
+	return claims, nil
+}
+
+func sign(secret []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
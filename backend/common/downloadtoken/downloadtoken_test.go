@@ -0,0 +1,91 @@
+package downloadtoken
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDownloadToken(t *testing.T) {
+	secret := []byte("test-secret")
+	now := time.Now()
+	token := Sign(secret, Claims{
+		GrantID:   "a1b2c3",
+		ExpiresAt: now.Add(time.Hour),
+	})
+
+	claims, err := Verify(secret, token, now)
+	if err != nil {
+		t.Fatalf("Verify() unexpected error: %v", err)
+	}
+	if claims.GrantID != "a1b2c3" {
+		t.Errorf("Verify() grant ID = %q, want %q", claims.GrantID, "a1b2c3")
+	}
+	if claims.ExpiresAt.Unix() != now.Add(time.Hour).Unix() {
+		t.Errorf("Verify() expiry = %v, want %v", claims.ExpiresAt, now.Add(time.Hour))
+	}
+}
+
+func TestDownloadTokenRejected(t *testing.T) {
+	secret := []byte("test-secret")
+	now := time.Now()
+	token := Sign(secret, Claims{
+		GrantID:   "a1b2c3",
+		ExpiresAt: now.Add(time.Hour),
+	})
+	payload, signature, _ := strings.Cut(token, ".")
+
+	tests := []struct {
+		name    string
+		secret  []byte
+		token   string
+		now     time.Time
+		wantErr error
+	}{
+		{
+			name:    "Expired",
+			secret:  secret,
+			token:   token,
+			now:     now.Add(2 * time.Hour),
+			wantErr: ErrExpired,
+		},
+		{
+			name:    "Wrong secret",
+			secret:  []byte("other-secret"),
+			token:   token,
+			now:     now,
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "Tampered payload",
+			secret:  secret,
+			token:   Sign(secret, Claims{GrantID: "d4e5f6", ExpiresAt: now.Add(time.Hour)})[:len(payload)] + "." + signature,
+			now:     now,
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "Missing signature",
+			secret:  secret,
+			token:   payload,
+			now:     now,
+			wantErr: ErrInvalid,
+		},
+		{
+			name:    "Malformed",
+			secret:  secret,
+			token:   "not.a-token!",
+			now:     now,
+			wantErr: ErrInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Verify(tt.secret, tt.token, tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+// Package errors defines the kinds of domain errors shared by the services
+// and maps them to and from gRPC status codes. Services declare their own
+// errors with New, so that callers can test for the specific error or for its
+// kind with the standard errors.Is:
+//
+//	var ErrBrandNotFound = apperrors.New(apperrors.ErrNotFound, "brand not found")
+//
+//	errors.Is(err, ErrBrandNotFound)       // the brand is missing
+//	errors.Is(err, apperrors.ErrNotFound) // something is missing
+package errors
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+)
+
+// Kind classifies domain errors by how callers should react to them. Each
+// kind maps to one gRPC status code.
+type Kind struct {
+	name string
+	code codes.Code
+}
+
+func (k *Kind) Error() string {
+	return k.name
+}
+
+// Code returns the gRPC status code of the kind
+func (k *Kind) Code() codes.Code {
+	return k.code
+}
+
+var (
+	ErrNotFound           = &Kind{"not found", codes.NotFound}
+	ErrAlreadyExists      = &Kind{"already exists", codes.AlreadyExists}
+	ErrInvalidArgument    = &Kind{"invalid argument", codes.InvalidArgument}
+	ErrFailedPrecondition = &Kind{"failed precondition", codes.FailedPrecondition}
+	ErrPermissionDenied   = &Kind{"permission denied", codes.PermissionDenied}
+	ErrUnauthenticated    = &Kind{"unauthenticated", codes.Unauthenticated}
+	// ErrConflict is a concurrent modification the caller may retry
+	ErrConflict          = &Kind{"conflict", codes.Aborted}
+	ErrResourceExhausted = &Kind{"resource exhausted", codes.ResourceExhausted}
+	ErrUnavailable       = &Kind{"unavailable", codes.Unavailable}
+	ErrInternal          = &Kind{"internal error", codes.Internal}
+)
+
+var kinds = []*Kind{
+	ErrNotFound, ErrAlreadyExists, ErrInvalidArgument, ErrFailedPrecondition,
+	ErrPermissionDenied, ErrUnauthenticated, ErrConflict, ErrResourceExhausted,
+	ErrUnavailable, ErrInternal,
+}
+
+// Error is a domain error of a kind. It matches both itself and its kind
+// with errors.Is, and its cause when it wraps one.
+type Error struct {
+	kind    *Kind
+	message string
+	cause   error
+}
+
+// New creates a domain error of kind, usually assigned to a package variable
+func New(kind *Kind, message string) error {
+	return &Error{kind: kind, message: message}
+}
+
+// Errorf creates a domain error of kind with a formatted message. A %w verb
+// wraps its operand as the cause.
+func Errorf(kind *Kind, format string, args ...any) error {
+	err := fmt.Errorf(format, args...)
+	return &Error{kind: kind, message: err.Error(), cause: errors.Unwrap(err)}
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+// Kind returns the kind of the error
+func (e *Error) Kind() *Kind {
+	return e.kind
+}
+
+func (e *Error) Unwrap() []error {
+	if e.cause != nil {
+		return []error{e.kind, e.cause}
+	}
+	return []error{e.kind}
+}
+
+// KindOf returns the kind of err, or nil when err is not a domain error
+func KindOf(err error) *Kind {
+	var kind *Kind
+	if errors.As(err, &kind) {
+		return kind
+	}
+	return nil
+}
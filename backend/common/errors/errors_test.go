@@ -0,0 +1,69 @@
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errBrandNotFound = New(ErrNotFound, "brand not found")
+
+func TestError(t *testing.T) {
+	err := fmt.Errorf("failed to get brand: %w", errBrandNotFound)
+
+	if !errors.Is(err, errBrandNotFound) {
+		t.Error("errors.Is(err, errBrandNotFound) = false, want true")
+	}
+	if !errors.Is(err, ErrNotFound) {
+		t.Error("errors.Is(err, ErrNotFound) = false, want true")
+	}
+	if errors.Is(err, ErrAlreadyExists) {
+		t.Error("errors.Is(err, ErrAlreadyExists) = true, want false")
+	}
+	if kind := KindOf(err); kind != ErrNotFound {
+		t.Errorf("KindOf() = %v, want %v", kind, ErrNotFound)
+	}
+
+	cause := errors.New("duplicate key")
+	wrapped := Errorf(ErrAlreadyExists, "brand %q already exists: %w", "acme", cause)
+	if wrapped.Error() != `brand "acme" already exists: duplicate key` {
+		t.Errorf("Errorf() message = %q", wrapped.Error())
+	}
+	if !errors.Is(wrapped, cause) || !errors.Is(wrapped, ErrAlreadyExists) {
+		t.Error("Errorf() does not match its cause and kind")
+	}
+}
+
+func TestGRPC(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    codes.Code
+		message string
+	}{
+		{"domain error", fmt.Errorf("lookup: %w", errBrandNotFound), codes.NotFound, "lookup: brand not found"},
+		{"status error", status.Error(codes.PermissionDenied, "no"), codes.PermissionDenied, "no"},
+		{"context error", context.DeadlineExceeded, codes.DeadlineExceeded, context.DeadlineExceeded.Error()},
+		{"other error", errors.New("pq: connection refused"), codes.Internal, internalMessage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := status.Convert(ToGRPC(tt.err))
+			if st.Code() != tt.code || st.Message() != tt.message {
+				t.Errorf("ToGRPC() = %v %q, want %v %q", st.Code(), st.Message(), tt.code, tt.message)
+			}
+		})
+	}
+
+	err := FromGRPC(status.Error(codes.NotFound, "inventory item not found"))
+	if !errors.Is(err, ErrNotFound) || err.Error() != "inventory item not found" {
+		t.Errorf("FromGRPC() = %v, want a not found error", err)
+	}
+	if Code(err) != codes.NotFound {
+		t.Errorf("Code(FromGRPC()) = %v, want %v", Code(err), codes.NotFound)
+	}
+}
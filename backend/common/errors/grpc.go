@@ -0,0 +1,96 @@
+package errors
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// internalMessage replaces the message of errors without a kind, which may
+// carry SQL or other internal details
+const internalMessage = "internal server error"
+
+// Code returns the gRPC status code of err: the code of its kind, the code it
+// carries when it is a status error, Canceled or DeadlineExceeded for context
+// errors and Unknown otherwise
+func Code(err error) codes.Code {
+	if err == nil {
+		return codes.OK
+	}
+	if kind := KindOf(err); kind != nil {
+		return kind.code
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Code()
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	}
+	return codes.Unknown
+}
+
+// ToGRPC converts err to a gRPC status error. Domain errors keep their
+// message, status errors pass through and other errors become Internal with
+// a generic message.
+func ToGRPC(err error) error {
+	if err == nil {
+		return nil
+	}
+	if kind := KindOf(err); kind != nil {
+		return status.Error(kind.code, err.Error())
+	}
+	if st, ok := status.FromError(err); ok {
+		return st.Err()
+	}
+	switch code := Code(err); code {
+	case codes.Canceled, codes.DeadlineExceeded:
+		return status.Error(code, err.Error())
+	}
+	return status.Error(codes.Internal, internalMessage)
+}
+
+// FromGRPC converts a status error returned by another service to a domain
+// error of the matching kind, keeping the message of the status. Errors
+// without a matching kind are returned unchanged.
+func FromGRPC(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || err == nil {
+		return err
+	}
+	for _, kind := range kinds {
+		if kind.code == st.Code() {
+			return &Error{kind: kind, message: st.Message(), cause: err}
+		}
+	}
+	return err
+}
+
+// UnaryServerInterceptor converts the domain errors returned by handlers to
+// status errors with the code of their kind. Other errors are left to the
+// handler, as gRPC reports them with code Unknown.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil && KindOf(err) != nil {
+			return resp, ToGRPC(err)
+		}
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil && KindOf(err) != nil {
+			return ToGRPC(err)
+		}
+		return err
+	}
+}
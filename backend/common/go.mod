@@ -2,6 +2,17 @@ module github.com/louai60/e-commerce_project/backend/common
 
 go 1.24.0
 
-require go.uber.org/zap v1.27.0
+require (
+	github.com/google/uuid v1.6.0
+	go.uber.org/zap v1.27.0
+	google.golang.org/grpc v1.72.0
+	google.golang.org/protobuf v1.36.6
+)
 
-require go.uber.org/multierr v1.10.0 // indirect
+require (
+	go.uber.org/multierr v1.10.0 // indirect
+	golang.org/x/net v0.35.0 // indirect
+	golang.org/x/sys v0.30.0 // indirect
+	golang.org/x/text v0.22.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250218202821-56aae31c358a // indirect
+)
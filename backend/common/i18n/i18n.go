@@ -0,0 +1,132 @@
+// Package i18n negotiates the language of a request from its Accept-Language
+// header and translates the messages returned to clients. Messages are
+// identified by their English text, so untranslated messages are returned
+// as they are.
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Catalog holds the translations of messages per language
+type Catalog struct {
+	defaultLanguage string
+	languages       []string
+	messages        map[string]map[string]string // language -> lowercased English message -> translation
+}
+
+// NewCatalog creates a catalog whose messages are written in defaultLanguage
+func NewCatalog(defaultLanguage string) *Catalog {
+	return &Catalog{
+		defaultLanguage: defaultLanguage,
+		languages:       []string{defaultLanguage},
+		messages:        make(map[string]map[string]string),
+	}
+}
+
+// Add registers translations of English messages into language
+func (c *Catalog) Add(language string, messages map[string]string) {
+	language = strings.ToLower(language)
+	translations, ok := c.messages[language]
+	if !ok {
+		translations = make(map[string]string, len(messages))
+		c.messages[language] = translations
+		if language != c.defaultLanguage {
+			c.languages = append(c.languages, language)
+		}
+	}
+	for message, translation := range messages {
+		translations[strings.ToLower(message)] = translation
+	}
+}
+
+// Languages returns the default language followed by the languages with
+// translations
+func (c *Catalog) Languages() []string {
+	return append([]string(nil), c.languages...)
+}
+
+// Translate returns message in the language of tag, falling back from a
+// regional tag such as fr-CA to its language. Messages without a
+// translation are returned unchanged.
+func (c *Catalog) Translate(tag, message string) string {
+	key := strings.ToLower(message)
+	for _, language := range Candidates(tag) {
+		if translation, ok := c.messages[language][key]; ok {
+			return translation
+		}
+	}
+	return message
+}
+
+// Candidates returns the lowercased tags to look translations up with, most
+// specific first: fr-ca-x gives fr-ca-x, fr-ca and fr
+func Candidates(tag string) []string {
+	tag = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+	if tag == "" {
+		return nil
+	}
+	candidates := []string{tag}
+	for i := strings.LastIndex(tag, "-"); i > 0; i = strings.LastIndex(tag, "-") {
+		tag = tag[:i]
+		candidates = append(candidates, tag)
+	}
+	return candidates
+}
+
+// ParseAcceptLanguage returns the tags of an Accept-Language header by
+// decreasing quality, dropping the wildcard and tags with a quality of 0
+func ParseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag     string
+		quality float64
+	}
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.TrimSpace(fields[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		quality := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				q, err := strconv.ParseFloat(param[2:], 64)
+				if err != nil {
+					q = 0
+				}
+				quality = q
+			}
+		}
+		if quality > 0 {
+			tags = append(tags, weighted{tag, quality})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].quality > tags[j].quality })
+
+	result := make([]string, len(tags))
+	for i, t := range tags {
+		result[i] = t.tag
+	}
+	return result
+}
+
+// Negotiate returns the preferred tag of an Accept-Language header that one
+// of the supported languages serves, or "" when none does. A tag is served
+// by its exact language or by its base language, so fr-CA matches a
+// supported fr and is returned as fr-CA.
+func Negotiate(header string, supported []string) string {
+	for _, tag := range ParseAcceptLanguage(header) {
+		for _, candidate := range Candidates(tag) {
+			for _, language := range supported {
+				if strings.EqualFold(candidate, language) {
+					return tag
+				}
+			}
+		}
+	}
+	return ""
+}
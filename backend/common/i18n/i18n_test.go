@@ -0,0 +1,48 @@
+package i18n
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	got := ParseAcceptLanguage("fr-CA;q=0.8, en;q=0.5, ar, *;q=0.1, de;q=0")
+	expected := []string{"ar", "fr-CA", "en"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("ParseAcceptLanguage() = %v, want %v", got, expected)
+	}
+}
+
+func TestNegotiate(t *testing.T) {
+	supported := []string{"en", "fr", "ar"}
+	tests := []struct {
+		header   string
+		expected string
+	}{
+		{"fr-CA,fr;q=0.9,en;q=0.8", "fr-CA"},
+		{"de-DE,de;q=0.9,en;q=0.5", "en"},
+		{"ja", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Negotiate(tt.header, supported); got != tt.expected {
+			t.Errorf("Negotiate(%q) = %q, want %q", tt.header, got, tt.expected)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	catalog := Default()
+	if got := catalog.Translate("fr-CA", "Product not found"); got != "produit introuvable" {
+		t.Errorf("Translate(fr-CA) = %q", got)
+	}
+	if got := catalog.Translate("en", "product not found"); got != "product not found" {
+		t.Errorf("Translate(en) = %q", got)
+	}
+	if got := catalog.Translate("fr", "an unknown message"); got != "an unknown message" {
+		t.Errorf("Translate(unknown) = %q", got)
+	}
+	if got := catalog.Languages(); !reflect.DeepEqual(got, []string{"en", "fr", "ar"}) {
+		t.Errorf("Languages() = %v", got)
+	}
+}
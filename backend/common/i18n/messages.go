@@ -0,0 +1,86 @@
+package i18n
+
+// Default returns a catalog with the French and Arabic translations of the
+// messages the gateway and the services commonly return
+func Default() *Catalog {
+	catalog := NewCatalog("en")
+	catalog.Add("fr", frenchMessages)
+	catalog.Add("ar", arabicMessages)
+	return catalog
+}
+
+var frenchMessages = map[string]string{
+	"internal server error":               "erreur interne du serveur",
+	"service unavailable":                 "service indisponible",
+	"product service unavailable":         "service produits indisponible",
+	"inventory service unavailable":       "service de stock indisponible",
+	"unauthorized":                        "non autorisé",
+	"authorization header is required":    "l'en-tête Authorization est requis",
+	"invalid authorization header format": "format de l'en-tête Authorization invalide",
+	"token was issued for another store":  "le jeton a été émis pour une autre boutique",
+	"admin access required":               "accès administrateur requis",
+	"invalid credentials":                 "identifiants invalides",
+	"invalid request":                     "requête invalide",
+	"invalid page number":                 "numéro de page invalide",
+	"invalid limit number":                "limite invalide",
+	"invalid limit":                       "limite invalide",
+	"invalid quantity":                    "quantité invalide",
+	"invalid user ID":                     "identifiant utilisateur invalide",
+	"invalid user ID format":              "format d'identifiant utilisateur invalide",
+	"invalid store ID":                    "identifiant de boutique invalide",
+	"store not found":                     "boutique introuvable",
+	"product not found":                   "produit introuvable",
+	"product ID is required":              "l'identifiant du produit est requis",
+	"category not found":                  "catégorie introuvable",
+	"category ID is required":             "l'identifiant de la catégorie est requis",
+	"brand not found":                     "marque introuvable",
+	"collection not found":                "collection introuvable",
+	"collection ID is required":           "l'identifiant de la collection est requis",
+	"user not found":                      "utilisateur introuvable",
+	"file is required":                    "un fichier est requis",
+	"file not found":                      "fichier introuvable",
+	"insufficient inventory":              "stock insuffisant",
+	"resource not found":                  "ressource introuvable",
+	"too many failed login attempts":      "trop de tentatives de connexion échouées",
+	"captcha required":                    "captcha requis",
+	"invalid captcha":                     "captcha invalide",
+	"captcha verification unavailable":    "vérification du captcha indisponible",
+}
+
+var arabicMessages = map[string]string{
+	"internal server error":               "خطأ داخلي في الخادم",
+	"service unavailable":                 "الخدمة غير متاحة",
+	"product service unavailable":         "خدمة المنتجات غير متاحة",
+	"inventory service unavailable":       "خدمة المخزون غير متاحة",
+	"unauthorized":                        "غير مصرح",
+	"authorization header is required":    "ترويسة Authorization مطلوبة",
+	"invalid authorization header format": "صيغة ترويسة Authorization غير صالحة",
+	"token was issued for another store":  "تم إصدار الرمز لمتجر آخر",
+	"admin access required":               "يتطلب صلاحيات المسؤول",
+	"invalid credentials":                 "بيانات الدخول غير صحيحة",
+	"invalid request":                     "طلب غير صالح",
+	"invalid page number":                 "رقم الصفحة غير صالح",
+	"invalid limit number":                "الحد غير صالح",
+	"invalid limit":                       "الحد غير صالح",
+	"invalid quantity":                    "الكمية غير صالحة",
+	"invalid user ID":                     "معرف المستخدم غير صالح",
+	"invalid user ID format":              "صيغة معرف المستخدم غير صالحة",
+	"invalid store ID":                    "معرف المتجر غير صالح",
+	"store not found":                     "المتجر غير موجود",
+	"product not found":                   "المنتج غير موجود",
+	"product ID is required":              "معرف المنتج مطلوب",
+	"category not found":                  "الفئة غير موجودة",
+	"category ID is required":             "معرف الفئة مطلوب",
+	"brand not found":                     "العلامة التجارية غير موجودة",
+	"collection not found":                "المجموعة غير موجودة",
+	"collection ID is required":           "معرف المجموعة مطلوب",
+	"user not found":                      "المستخدم غير موجود",
+	"file is required":                    "الملف مطلوب",
+	"file not found":                      "الملف غير موجود",
+	"insufficient inventory":              "المخزون غير كافٍ",
+	"resource not found":                  "المورد غير موجود",
+	"too many failed login attempts":      "محاولات تسجيل دخول فاشلة كثيرة",
+	"captcha required":                    "مطلوب اختبار captcha",
+	"invalid captcha":                     "اختبار captcha غير صالح",
+	"captcha verification unavailable":    "التحقق من captcha غير متاح",
+}
@@ -0,0 +1,74 @@
+// Package jwks converts the RSA public keys that sign access tokens to and
+// from JSON Web Keys (RFC 7517), so that the services verifying tokens can
+// fetch the keys of the user service instead of sharing key files.
+package jwks
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+var ErrUnsupportedKey = errors.New("unsupported JSON web key")
+
+// Key is an RSA public key in JWK form
+type Key struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// Set is a JWK set, as served at /.well-known/jwks.json
+type Set struct {
+	Keys []Key `json:"keys"`
+}
+
+// FromPublicKey returns the JWK of an RS256 signing key
+func FromPublicKey(kid string, key *rsa.PublicKey) Key {
+	return Key{
+		Kid: kid,
+		Kty: "RSA",
+		Alg: "RS256",
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}
+}
+
+// PublicKey decodes the RSA public key of a JWK
+func (k Key) PublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != "RSA" || (k.Use != "" && k.Use != "sig") {
+		return nil, fmt.Errorf("%w: kty %q, use %q", ErrUnsupportedKey, k.Kty, k.Use)
+	}
+
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus of key %s: %w", k.Kid, err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent of key %s: %w", k.Kid, err)
+	}
+	exponent := new(big.Int).SetBytes(e)
+	if len(n) == 0 || !exponent.IsInt64() || exponent.Int64() < 3 || exponent.Int64() > 1<<31-1 {
+		return nil, fmt.Errorf("%w: invalid parameters of key %s", ErrUnsupportedKey, k.Kid)
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+}
+
+// Thumbprint returns the RFC 7638 thumbprint of an RSA public key, base64url
+// encoded. It identifies a key that has no other ID.
+func Thumbprint(key *rsa.PublicKey) string {
+	jwk := FromPublicKey("", key)
+	// The members are the required ones, in lexicographic order
+	canonical := fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, jwk.E, jwk.N)
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
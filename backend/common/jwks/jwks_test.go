@@ -0,0 +1,35 @@
+package jwks
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+)
+
+func TestKeyRoundTrip(t *testing.T) {
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	key := FromPublicKey("k1", &private.PublicKey)
+	if key.E != "AQAB" {
+		t.Errorf("E = %q, want AQAB", key.E)
+	}
+	public, err := key.PublicKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !public.Equal(&private.PublicKey) {
+		t.Error("decoded key differs from the encoded one")
+	}
+	if Thumbprint(public) != Thumbprint(&private.PublicKey) || len(Thumbprint(public)) != 43 {
+		t.Errorf("Thumbprint = %q", Thumbprint(public))
+	}
+
+	key.Kty = "EC"
+	if _, err := key.PublicKey(); !errors.Is(err, ErrUnsupportedKey) {
+		t.Errorf("PublicKey of an EC key = %v, want ErrUnsupportedKey", err)
+	}
+}
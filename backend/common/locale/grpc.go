@@ -0,0 +1,66 @@
+package locale
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryServerInterceptor reads the preferences from incoming metadata and
+// stores them in the handler context. Invalid values are ignored.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(incomingContext(ctx), req)
+	}
+}
+
+// incomingContext stores the preferences from incoming metadata in ctx
+func incomingContext(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	first := func(key string) string {
+		if values := md.Get(key); len(values) > 0 {
+			return values[0]
+		}
+		return ""
+	}
+	prefs := Preferences{
+		Country:  first(CountryMetadataKey),
+		Currency: first(CurrencyMetadataKey),
+		Locale:   first(LocaleMetadataKey),
+	}.Normalize()
+	if prefs.IsZero() {
+		return ctx
+	}
+	return WithPreferences(ctx, prefs)
+}
+
+// UnaryClientInterceptor forwards the preferences stored in the context to
+// the called service as outgoing metadata
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// outgoingContext copies the known preferences of ctx into outgoing metadata
+func outgoingContext(ctx context.Context) context.Context {
+	prefs := FromContext(ctx)
+	var pairs []string
+	if prefs.Country != "" {
+		pairs = append(pairs, CountryMetadataKey, prefs.Country)
+	}
+	if prefs.Currency != "" {
+		pairs = append(pairs, CurrencyMetadataKey, prefs.Currency)
+	}
+	if prefs.Locale != "" {
+		pairs = append(pairs, LocaleMetadataKey, prefs.Locale)
+	}
+	if len(pairs) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
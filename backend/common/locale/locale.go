@@ -0,0 +1,141 @@
+// Package locale carries the country, currency and locale a request prefers
+// from the gateway through the gRPC services. Services fall back to the
+// defaults of the store for the preferences a request does not carry.
+package locale
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// gRPC metadata keys carrying the preferences
+const (
+	CountryMetadataKey  = "x-country"
+	CurrencyMetadataKey = "x-currency"
+	LocaleMetadataKey   = "x-locale"
+)
+
+var (
+	validCountry  = regexp.MustCompile(`^[A-Z]{2}$`)
+	validCurrency = regexp.MustCompile(`^[A-Z]{3}$`)
+	validLocale   = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+)
+
+// Preferences are the country, currency and locale of a request. Empty
+// fields are not known.
+type Preferences struct {
+	// Country is an ISO 3166-1 alpha-2 code, such as FR
+	Country string
+	// Currency is an ISO 4217 code, such as EUR
+	Currency string
+	// Locale is a BCP 47 language tag, such as fr-FR
+	Locale string
+}
+
+// IsZero reports whether no preference is known
+func (p Preferences) IsZero() bool {
+	return p.Country == "" && p.Currency == "" && p.Locale == ""
+}
+
+// Normalize canonicalizes the case of the preferences and drops the ones
+// that are not valid codes
+func (p Preferences) Normalize() Preferences {
+	p.Country = strings.ToUpper(strings.TrimSpace(p.Country))
+	if !validCountry.MatchString(p.Country) {
+		p.Country = ""
+	}
+	p.Currency = strings.ToUpper(strings.TrimSpace(p.Currency))
+	if !validCurrency.MatchString(p.Currency) {
+		p.Currency = ""
+	}
+	p.Locale = canonicalLocale(p.Locale)
+	if !validLocale.MatchString(p.Locale) {
+		p.Locale = ""
+	}
+	return p
+}
+
+// canonicalLocale lowercases the language of a tag and uppercases a region,
+// so that fr_fr becomes fr-FR
+func canonicalLocale(tag string) string {
+	parts := strings.Split(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"), "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) == 2 {
+			parts[i] = strings.ToUpper(parts[i])
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+type contextKey struct{}
+
+// WithPreferences returns a copy of ctx carrying the preferences
+func WithPreferences(ctx context.Context, prefs Preferences) context.Context {
+	return context.WithValue(ctx, contextKey{}, prefs)
+}
+
+// FromContext returns the preferences stored in ctx, which are zero when
+// there are none
+func FromContext(ctx context.Context) Preferences {
+	if ctx != nil {
+		if prefs, ok := ctx.Value(contextKey{}).(Preferences); ok {
+			return prefs
+		}
+	}
+	return Preferences{}
+}
+
+// countryDefaults are the currency and locale of the countries the stores
+// commonly sell to
+var countryDefaults = map[string]Preferences{
+	"US": {Currency: "USD", Locale: "en-US"},
+	"CA": {Currency: "CAD", Locale: "en-CA"},
+	"MX": {Currency: "MXN", Locale: "es-MX"},
+	"BR": {Currency: "BRL", Locale: "pt-BR"},
+	"GB": {Currency: "GBP", Locale: "en-GB"},
+	"IE": {Currency: "EUR", Locale: "en-IE"},
+	"FR": {Currency: "EUR", Locale: "fr-FR"},
+	"BE": {Currency: "EUR", Locale: "fr-BE"},
+	"LU": {Currency: "EUR", Locale: "fr-LU"},
+	"DE": {Currency: "EUR", Locale: "de-DE"},
+	"AT": {Currency: "EUR", Locale: "de-AT"},
+	"NL": {Currency: "EUR", Locale: "nl-NL"},
+	"ES": {Currency: "EUR", Locale: "es-ES"},
+	"PT": {Currency: "EUR", Locale: "pt-PT"},
+	"IT": {Currency: "EUR", Locale: "it-IT"},
+	"FI": {Currency: "EUR", Locale: "fi-FI"},
+	"GR": {Currency: "EUR", Locale: "el-GR"},
+	"CH": {Currency: "CHF", Locale: "de-CH"},
+	"SE": {Currency: "SEK", Locale: "sv-SE"},
+	"NO": {Currency: "NOK", Locale: "nb-NO"},
+	"DK": {Currency: "DKK", Locale: "da-DK"},
+	"PL": {Currency: "PLN", Locale: "pl-PL"},
+	"TR": {Currency: "TRY", Locale: "tr-TR"},
+	"TN": {Currency: "TND", Locale: "fr-TN"},
+	"MA": {Currency: "MAD", Locale: "fr-MA"},
+	"DZ": {Currency: "DZD", Locale: "fr-DZ"},
+	"EG": {Currency: "EGP", Locale: "ar-EG"},
+	"SA": {Currency: "SAR", Locale: "ar-SA"},
+	"AE": {Currency: "AED", Locale: "ar-AE"},
+	"IN": {Currency: "INR", Locale: "en-IN"},
+	"JP": {Currency: "JPY", Locale: "ja-JP"},
+	"CN": {Currency: "CNY", Locale: "zh-CN"},
+	"KR": {Currency: "KRW", Locale: "ko-KR"},
+	"AU": {Currency: "AUD", Locale: "en-AU"},
+	"NZ": {Currency: "NZD", Locale: "en-NZ"},
+	"ZA": {Currency: "ZAR", Locale: "en-ZA"},
+}
+
+// ForCountry returns the preferences of a visitor from country: its
+// currency and locale when known, or only the country
+func ForCountry(country string) Preferences {
+	country = strings.ToUpper(strings.TrimSpace(country))
+	if !validCountry.MatchString(country) {
+		return Preferences{}
+	}
+	prefs := countryDefaults[country]
+	prefs.Country = country
+	return prefs
+}
@@ -0,0 +1,66 @@
+package locale
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefs    Preferences
+		expected Preferences
+	}{
+		{"canonical case", Preferences{"fr", "eur", "fr_fr"}, Preferences{"FR", "EUR", "fr-FR"}},
+		{"script subtag", Preferences{Locale: "zh-Hant-TW"}, Preferences{Locale: "zh-Hant-TW"}},
+		{"invalid values", Preferences{"FRA", "EURO", "fr-"}, Preferences{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.prefs.Normalize(); got != tt.expected {
+				t.Errorf("Normalize() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestForCountry(t *testing.T) {
+	if got := ForCountry("de"); got != (Preferences{"DE", "EUR", "de-DE"}) {
+		t.Errorf("ForCountry(de) = %+v", got)
+	}
+	// Countries without defaults keep the country only
+	if got := ForCountry("XK"); got != (Preferences{Country: "XK"}) {
+		t.Errorf("ForCountry(XK) = %+v", got)
+	}
+	if got := ForCountry("unknown"); !got.IsZero() {
+		t.Errorf("ForCountry(unknown) = %+v, want zero", got)
+	}
+}
+
+func TestInterceptors(t *testing.T) {
+	prefs := Preferences{"TN", "TND", "fr-TN"}
+
+	var md metadata.MD
+	err := UnaryClientInterceptor()(WithPreferences(context.Background(), prefs), "/svc/Method", nil, nil, nil,
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			md, _ = metadata.FromOutgoingContext(ctx)
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = UnaryServerInterceptor()(metadata.NewIncomingContext(context.Background(), md), nil, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			if got := FromContext(ctx); got != prefs {
+				t.Errorf("FromContext() = %+v, want %+v", got, prefs)
+			}
+			return nil, nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
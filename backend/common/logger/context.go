@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// RequestIDHeader is the HTTP header carrying the request ID
+	RequestIDHeader = "X-Request-ID"
+	// RequestIDMetadataKey is the gRPC metadata key carrying the request ID
+	RequestIDMetadataKey = "x-request-id"
+	// UserIDMetadataKey is the gRPC metadata key carrying the authenticated user ID
+	UserIDMetadataKey = "x-user-id"
+)
+
+type contextKey int
+
+const (
+	loggerKey contextKey = iota
+	requestIDKey
+	userIDKey
+)
+
+// WithContext returns a copy of ctx carrying the given logger
+func WithContext(ctx context.Context, l *zap.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey, l)
+}
+
+// FromContext returns the logger stored in ctx, or the global logger when there
+// is none, annotated with the request ID and user ID carried by ctx
+func FromContext(ctx context.Context) *zap.Logger {
+	if ctx == nil {
+		return GetLogger()
+	}
+
+	l, ok := ctx.Value(loggerKey).(*zap.Logger)
+	if !ok || l == nil {
+		l = GetLogger()
+	}
+
+	var fields []zap.Field
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		fields = append(fields, zap.String("request_id", requestID))
+	}
+	if userID := UserIDFromContext(ctx); userID != "" {
+		fields = append(fields, zap.String("user_id", userID))
+	}
+	if len(fields) == 0 {
+		return l
+	}
+	return l.With(fields...)
+}
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// RequestIDFromContext returns the request ID stored in ctx, or an empty string
+func RequestIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+// WithUserID returns a copy of ctx carrying the authenticated user ID
+func WithUserID(ctx context.Context, userID string) context.Context {
+	return context.WithValue(ctx, userIDKey, userID)
+}
+
+// UserIDFromContext returns the user ID stored in ctx, or an empty string
+func UserIDFromContext(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	userID, _ := ctx.Value(userIDKey).(string)
+	return userID
+}
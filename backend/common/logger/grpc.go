@@ -0,0 +1,84 @@
+package logger
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnaryServerInterceptor reads the request ID and user ID from incoming metadata,
+// generating a request ID when the caller did not send one, and stores them
+// together with the base logger in the handler context
+func UnaryServerInterceptor(base *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return handler(incomingContext(ctx, base), req)
+	}
+}
+
+// incomingContext stores the request ID, user ID and base logger in ctx
+func incomingContext(ctx context.Context, base *zap.Logger) context.Context {
+	requestID, userID := "", ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(RequestIDMetadataKey); len(values) > 0 {
+			requestID = values[0]
+		}
+		if values := md.Get(UserIDMetadataKey); len(values) > 0 {
+			userID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	ctx = WithRequestID(ctx, requestID)
+	if userID != "" {
+		ctx = WithUserID(ctx, userID)
+	}
+	return WithContext(ctx, base)
+}
+
+// UnaryClientInterceptor forwards the request ID and user ID stored in the context
+// to the called service as outgoing metadata
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// outgoingContext copies the request ID and user ID from ctx into outgoing metadata
+func outgoingContext(ctx context.Context) context.Context {
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, RequestIDMetadataKey, requestID)
+	}
+	if userID := UserIDFromContext(ctx); userID != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, UserIDMetadataKey, userID)
+	}
+	return ctx
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor
+func StreamServerInterceptor(base *zap.Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: incomingContext(ss.Context(), base)})
+	}
+}
+
+// StreamClientInterceptor is the streaming counterpart of UnaryClientInterceptor
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+// contextServerStream overrides the context of a server stream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
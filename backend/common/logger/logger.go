@@ -1,29 +1,130 @@
 package logger
 
 import (
+    "os"
+    "strconv"
+    "strings"
+
     "go.uber.org/zap"
     "go.uber.org/zap/zapcore"
 )
 
-var log *zap.Logger
+var (
+    log   *zap.Logger
+    level zap.AtomicLevel
+)
 
-// Initialize sets up the logger
-func Initialize(env string) {
+// Config controls how the logger is built
+type Config struct {
+    Env     string
+    Service string
+    // Level is one of debug, info, warn, error; empty uses the environment default
+    Level string
+    // SamplingInitial and SamplingThereafter configure zap sampling per second:
+    // the first SamplingInitial entries with the same message are logged, then
+    // every SamplingThereafter-th. Zero keeps the environment default and a
+    // negative SamplingInitial disables sampling.
+    SamplingInitial    int
+    SamplingThereafter int
+}
+
+// ConfigFromEnv builds a logger configuration for the named service from the
+// APP_ENV, LOG_LEVEL, LOG_SAMPLING_INITIAL and LOG_SAMPLING_THEREAFTER variables
+func ConfigFromEnv(service string) Config {
+    env := os.Getenv("APP_ENV")
+    if env == "" {
+        env = "development"
+    }
+
+    cfg := Config{
+        Env:     env,
+        Service: service,
+        Level:   os.Getenv("LOG_LEVEL"),
+    }
+    if v, err := strconv.Atoi(os.Getenv("LOG_SAMPLING_INITIAL")); err == nil {
+        cfg.SamplingInitial = v
+    }
+    if v, err := strconv.Atoi(os.Getenv("LOG_SAMPLING_THEREAFTER")); err == nil {
+        cfg.SamplingThereafter = v
+    }
+    return cfg
+}
+
+// New builds a logger from cfg without touching the global instance
+func New(cfg Config) (*zap.Logger, error) {
+    l, _, err := build(cfg)
+    return l, err
+}
+
+func build(cfg Config) (*zap.Logger, zap.AtomicLevel, error) {
     var config zap.Config
-    if env == "production" {
+    if cfg.Env == "production" {
         config = zap.NewProductionConfig()
     } else {
         config = zap.NewDevelopmentConfig()
     }
-    
+
     config.EncoderConfig.TimeKey = "timestamp"
     config.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
-    
-    var err error
-    log, err = config.Build()
+
+    if cfg.Level != "" {
+        lvl, err := zapcore.ParseLevel(strings.ToLower(cfg.Level))
+        if err != nil {
+            return nil, config.Level, err
+        }
+        config.Level = zap.NewAtomicLevelAt(lvl)
+    }
+
+    if cfg.SamplingInitial < 0 {
+        config.Sampling = nil
+    } else if cfg.SamplingInitial > 0 && cfg.SamplingThereafter > 0 {
+        config.Sampling = &zap.SamplingConfig{
+            Initial:    cfg.SamplingInitial,
+            Thereafter: cfg.SamplingThereafter,
+        }
+    }
+
+    l, err := config.Build()
+    if err != nil {
+        return nil, config.Level, err
+    }
+    if cfg.Service != "" {
+        l = l.With(zap.String("service", cfg.Service))
+    }
+    return l, config.Level, nil
+}
+
+// Initialize sets up the logger
+func Initialize(env string) {
+    InitializeWithConfig(Config{Env: env})
+}
+
+// InitializeWithConfig sets up the global logger from cfg
+func InitializeWithConfig(cfg Config) {
+    l, lvl, err := build(cfg)
     if err != nil {
         panic("failed to initialize logger: " + err.Error())
     }
+    log = l
+    level = lvl
+}
+
+// SetLevel changes the level of the global logger at runtime
+func SetLevel(name string) error {
+    lvl, err := zapcore.ParseLevel(strings.ToLower(name))
+    if err != nil {
+        return err
+    }
+    GetLogger()
+    level.SetLevel(lvl)
+    return nil
+}
+
+// InitFromEnv sets up the global logger for the named service using
+// ConfigFromEnv and returns it
+func InitFromEnv(service string) *zap.Logger {
+    InitializeWithConfig(ConfigFromEnv(service))
+    return log
 }
 
 // GetLogger returns the configured logger instance
@@ -32,4 +133,4 @@ func GetLogger() *zap.Logger {
         Initialize("development")
     }
     return log
-}
\ No newline at end of file
+}
@@ -0,0 +1,95 @@
+// Package mail sends plain text emails through an SMTP relay, or logs them
+// when no relay is configured.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Message is a plain text email
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers emails
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMTPMailer sends email through an SMTP relay, using STARTTLS when the
+// server offers it
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// Ensure SMTPMailer implements Mailer
+var _ Mailer = (*SMTPMailer)(nil)
+
+// NewSMTPMailer creates a mailer for the relay at host:port. Authentication
+// is skipped when username is empty.
+func NewSMTPMailer(host string, port int, username, password, from string) *SMTPMailer {
+	mailer := &SMTPMailer{
+		addr: net.JoinHostPort(host, strconv.Itoa(port)),
+		from: from,
+	}
+	if username != "" {
+		mailer.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return mailer
+}
+
+// Send sends the message. The context is not used since net/smtp does not
+// support cancellation.
+func (m *SMTPMailer) Send(_ context.Context, msg Message) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", m.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+
+	// SendMail rejects addresses containing line breaks
+	if err := smtp.SendMail(m.addr, m.auth, m.from, msg.To, buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	return nil
+}
+
+// LogMailer logs emails instead of sending them, for setups without SMTP
+type LogMailer struct {
+	logger *zap.Logger
+}
+
+// Ensure LogMailer implements Mailer
+var _ Mailer = (*LogMailer)(nil)
+
+// NewLogMailer creates a mailer that logs every message
+func NewLogMailer(logger *zap.Logger) *LogMailer {
+	return &LogMailer{logger: logger}
+}
+
+// Send logs the message
+func (m *LogMailer) Send(_ context.Context, msg Message) error {
+	m.logger.Info("Email not sent, SMTP is not configured",
+		zap.Strings("to", msg.To),
+		zap.String("subject", msg.Subject),
+		zap.String("body", msg.Body))
+	return nil
+}
@@ -0,0 +1,160 @@
+// Package metadata handles the custom data integrators attach to products,
+// variants and users, such as ERP IDs or marketing tags. Metadata is a JSON
+// object stored in a JSONB column, carried as a google.protobuf.Struct in the
+// protos, and updated with JSON merge patches (RFC 7396): keys of the patch
+// replace those of the metadata, nested objects are merged, and null values
+// remove keys.
+package metadata
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Limits of the metadata of a record
+const (
+	// MaxBytes is the size of the metadata encoded as JSON, also enforced by
+	// the CHECK constraints of the metadata columns
+	MaxBytes = 8 << 10
+	// MaxKeys is the number of top-level keys
+	MaxKeys = 50
+	// MaxDepth is the nesting depth of objects and arrays below the top level
+	MaxDepth = 3
+)
+
+// FlagKey is the feature flag exposing metadata in API responses, evaluated
+// per store. Metadata is accepted on writes whether or not it is exposed, so
+// integrators can backfill it before the rollout.
+const FlagKey = "custom_metadata"
+
+var (
+	ErrNotObject   = apperrors.New(apperrors.ErrInvalidArgument, "metadata must be a JSON object")
+	ErrTooLarge    = apperrors.New(apperrors.ErrInvalidArgument, fmt.Sprintf("metadata must not exceed %d bytes", MaxBytes))
+	ErrTooManyKeys = apperrors.New(apperrors.ErrInvalidArgument, fmt.Sprintf("metadata must not have more than %d keys", MaxKeys))
+	ErrTooDeep     = apperrors.New(apperrors.ErrInvalidArgument, fmt.Sprintf("metadata must not be nested more than %d levels", MaxDepth))
+	ErrInvalidKey  = apperrors.New(apperrors.ErrInvalidArgument, "metadata keys must be 1 to 64 letters, digits, '_', '-' or '.'")
+)
+
+// Empty is the metadata of records without any
+var Empty = json.RawMessage(`{}`)
+
+var keyPattern = regexp.MustCompile(`^[A-Za-z0-9_.-]{1,64}$`)
+
+// Validate checks that doc is a JSON object within the limits
+func Validate(doc json.RawMessage) error {
+	if len(doc) > MaxBytes {
+		return ErrTooLarge
+	}
+	var object map[string]any
+	if err := json.Unmarshal(doc, &object); err != nil || object == nil {
+		return ErrNotObject
+	}
+	if len(object) > MaxKeys {
+		return ErrTooManyKeys
+	}
+	for key, value := range object {
+		if !keyPattern.MatchString(key) {
+			return ErrInvalidKey
+		}
+		if depth(value) > MaxDepth {
+			return ErrTooDeep
+		}
+	}
+	return nil
+}
+
+// Merge applies patch to doc as a JSON merge patch and validates the result.
+// An empty doc is treated as an empty object.
+func Merge(doc, patch json.RawMessage) (json.RawMessage, error) {
+	var target, changes map[string]any
+	if len(bytes.TrimSpace(doc)) > 0 {
+		if err := json.Unmarshal(doc, &target); err != nil {
+			return nil, fmt.Errorf("failed to decode metadata: %w", err)
+		}
+	}
+	if err := json.Unmarshal(patch, &changes); err != nil || changes == nil {
+		return nil, ErrNotObject
+	}
+
+	merged, err := json.Marshal(mergePatch(target, changes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode metadata: %w", err)
+	}
+	if err := Validate(merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// FromStruct encodes the metadata of a proto message. A nil s, meaning the
+// field was not set, encodes to nil.
+func FromStruct(s *structpb.Struct) (json.RawMessage, error) {
+	if s == nil {
+		return nil, nil
+	}
+	doc, err := json.Marshal(s.AsMap())
+	if err != nil {
+		return nil, ErrNotObject
+	}
+	return doc, nil
+}
+
+// ToStruct decodes metadata into a proto message field. Empty metadata
+// decodes to nil, which leaves the field unset.
+func ToStruct(doc json.RawMessage) (*structpb.Struct, error) {
+	if len(doc) == 0 || bytes.Equal(doc, Empty) {
+		return nil, nil
+	}
+	s := &structpb.Struct{}
+	if err := s.UnmarshalJSON(doc); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata: %w", err)
+	}
+	return s, nil
+}
+
+// mergePatch implements the MergePatch function of RFC 7396
+func mergePatch(target map[string]any, patch map[string]any) map[string]any {
+	if target == nil {
+		target = map[string]any{}
+	}
+	for key, value := range patch {
+		if value == nil {
+			delete(target, key)
+			continue
+		}
+		if object, ok := value.(map[string]any); ok {
+			existing, _ := target[key].(map[string]any)
+			target[key] = mergePatch(existing, object)
+			continue
+		}
+		target[key] = value
+	}
+	return target
+}
+
+// depth returns the nesting depth of objects and arrays in value, 0 for a
+// scalar
+func depth(value any) int {
+	var children []any
+	switch v := value.(type) {
+	case map[string]any:
+		for _, child := range v {
+			children = append(children, child)
+		}
+	case []any:
+		children = v
+	default:
+		return 0
+	}
+
+	deepest := 0
+	for _, child := range children {
+		deepest = max(deepest, depth(child))
+	}
+	return deepest + 1
+}
@@ -0,0 +1,105 @@
+package metadata
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		expected error
+	}{
+		{"empty object", `{}`, nil},
+		{"nested", `{"erp_id":"A-1","tags":["x","y"],"erp":{"plant":{"code":"P1"}}}`, nil},
+		{"array", `["erp_id"]`, ErrNotObject},
+		{"null", `null`, ErrNotObject},
+		{"invalid key", `{"erp id":"A-1"}`, ErrInvalidKey},
+		{"too deep", `{"a":{"b":{"c":{"d":{"e":1}}}}}`, ErrTooDeep},
+		{"too large", `{"notes":"` + strings.Repeat("x", MaxBytes) + `"}`, ErrTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(json.RawMessage(tt.doc)); !errors.Is(err, tt.expected) {
+				t.Errorf("Validate() = %v, want %v", err, tt.expected)
+			}
+		})
+	}
+
+	keys := make(map[string]int, MaxKeys+1)
+	for i := 0; i <= MaxKeys; i++ {
+		keys["k"+strings.Repeat("x", i)] = i
+	}
+	doc, _ := json.Marshal(keys)
+	if err := Validate(doc); !errors.Is(err, ErrTooManyKeys) {
+		t.Errorf("Validate() = %v, want %v", err, ErrTooManyKeys)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	tests := []struct {
+		name     string
+		doc      string
+		patch    string
+		expected string
+	}{
+		{"no metadata", ``, `{"erp_id":"A-1"}`, `{"erp_id":"A-1"}`},
+		{"replace", `{"erp_id":"A-1","tag":"x"}`, `{"erp_id":"A-2"}`, `{"erp_id":"A-2","tag":"x"}`},
+		{"remove", `{"erp_id":"A-1","tag":"x"}`, `{"tag":null}`, `{"erp_id":"A-1"}`},
+		{"merge nested", `{"erp":{"id":"A-1","plant":"P1"}}`, `{"erp":{"plant":null,"site":"S1"}}`, `{"erp":{"id":"A-1","site":"S1"}}`},
+		{"replace arrays", `{"tags":["x","y"]}`, `{"tags":["z"]}`, `{"tags":["z"]}`},
+		{"object over scalar", `{"erp":"A-1"}`, `{"erp":{"id":"A-1","old":null}}`, `{"erp":{"id":"A-1"}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, err := Merge(json.RawMessage(tt.doc), json.RawMessage(tt.patch))
+			if err != nil {
+				t.Fatalf("Merge() error = %v", err)
+			}
+			if string(merged) != tt.expected {
+				t.Errorf("Merge() = %s, want %s", merged, tt.expected)
+			}
+		})
+	}
+
+	if _, err := Merge(json.RawMessage(`{}`), json.RawMessage(`{"a":{"b":{"c":{"d":{"e":1}}}}}`)); !errors.Is(err, ErrTooDeep) {
+		t.Errorf("Merge() = %v, want %v", err, ErrTooDeep)
+	}
+	if _, err := Merge(json.RawMessage(`{}`), json.RawMessage(`"erp_id"`)); !errors.Is(err, ErrNotObject) {
+		t.Errorf("Merge() = %v, want %v", err, ErrNotObject)
+	}
+}
+
+func TestStructConversion(t *testing.T) {
+	if doc, err := FromStruct(nil); err != nil || doc != nil {
+		t.Errorf("FromStruct(nil) = %s, %v, want nil", doc, err)
+	}
+	if s, err := ToStruct(Empty); err != nil || s != nil {
+		t.Errorf("ToStruct(Empty) = %v, %v, want nil", s, err)
+	}
+
+	s, err := structpb.NewStruct(map[string]any{"erp_id": "A-1", "removed": nil})
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc, err := FromStruct(s)
+	if err != nil {
+		t.Fatalf("FromStruct() error = %v", err)
+	}
+	if string(doc) != `{"erp_id":"A-1","removed":null}` {
+		t.Errorf("FromStruct() = %s", doc)
+	}
+
+	back, err := ToStruct(doc)
+	if err != nil {
+		t.Fatalf("ToStruct() error = %v", err)
+	}
+	if got := back.Fields["erp_id"].GetStringValue(); got != "A-1" {
+		t.Errorf("ToStruct() erp_id = %q, want A-1", got)
+	}
+}
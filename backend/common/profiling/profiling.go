@@ -0,0 +1,54 @@
+// Package profiling exposes the net/http/pprof endpoints of a service. The
+// endpoints are served on a separate listener that is off unless an
+// operator enables it, since profiles reveal internals and cost CPU.
+package profiling
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Handler returns a handler serving the pprof endpoints under /debug/pprof/
+func Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	return mux
+}
+
+// Start serves the pprof endpoints on addr until ctx is cancelled. Bind addr
+// to a loopback or internal interface: the endpoints are unauthenticated.
+func Start(ctx context.Context, addr string, logger *zap.Logger) error {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler:           Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("Profiling server stopped", zap.Error(err))
+		}
+	}()
+
+	logger.Warn("Profiling endpoints enabled", zap.String("address", listener.Addr().String()))
+	return nil
+}
@@ -0,0 +1,142 @@
+// Package recovery recovers panics in request handlers, logs them with their
+// stack trace and reports them to an error tracker, so that one bad request
+// neither kills a service nor goes unnoticed.
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+)
+
+// InternalMessage is returned to clients instead of the panic value, which
+// may carry internal details
+const InternalMessage = "internal server error"
+
+// Event describes a recovered panic
+type Event struct {
+	Service string
+	// Message is the panic value, formatted
+	Message string
+	// Frames is the stack of the panicking goroutine, innermost call first
+	Frames []runtime.Frame
+	// Operation is the RPC method or HTTP route that panicked
+	Operation string
+	RequestID string
+	Tags      map[string]string
+	Time      time.Time
+}
+
+// Reporter sends recovered panics to an error tracker. Report must not
+// block the request for long.
+type Reporter interface {
+	Report(event Event)
+}
+
+// ReporterFunc adapts a function to the Reporter interface
+type ReporterFunc func(event Event)
+
+func (f ReporterFunc) Report(event Event) {
+	f(event)
+}
+
+// Recoverer logs and reports the panics of one service
+type Recoverer struct {
+	service  string
+	logger   *zap.Logger
+	reporter Reporter
+}
+
+// New creates a recoverer. Without a reporter, panics are only logged.
+func New(service string, logger *zap.Logger, reporter Reporter) *Recoverer {
+	return &Recoverer{service: service, logger: logger, reporter: reporter}
+}
+
+// Recovered logs and reports a value returned by recover. It must be called
+// from the deferred function that recovered it, whose stack still holds the
+// panicking calls.
+func (r *Recoverer) Recovered(ctx context.Context, value any, operation string, tags map[string]string) Event {
+	event := Event{
+		Service:   r.service,
+		Message:   fmt.Sprint(value),
+		Frames:    callers(4),
+		Operation: operation,
+		RequestID: applogger.RequestIDFromContext(ctx),
+		Tags:      tags,
+		Time:      time.Now().UTC(),
+	}
+
+	applogger.FromContext(applogger.WithContext(ctx, r.logger)).Error("Panic recovered",
+		zap.String("panic", event.Message),
+		zap.String("operation", operation),
+		zap.String("stack", formatFrames(event.Frames)),
+	)
+	if r.reporter != nil {
+		r.reporter.Report(event)
+	}
+	return event
+}
+
+// UnaryServerInterceptor turns panics of handlers into Internal errors
+func (r *Recoverer) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if value := recover(); value != nil {
+				r.Recovered(ctx, value, info.FullMethod, nil)
+				resp, err = nil, status.Error(codes.Internal, InternalMessage)
+			}
+		}()
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor
+func (r *Recoverer) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if value := recover(); value != nil {
+				r.Recovered(ss.Context(), value, info.FullMethod, nil)
+				err = status.Error(codes.Internal, InternalMessage)
+			}
+		}()
+		return handler(srv, ss)
+	}
+}
+
+// callers returns the stack above skip frames, leaving out the frames of the
+// runtime's panic machinery. Skipping 4 frames starts the stack above the
+// deferred function that called Recovered.
+func callers(skip int) []runtime.Frame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var stack []runtime.Frame
+	for {
+		frame, more := frames.Next()
+		if frame.Function != "runtime.gopanic" && frame.Function != "runtime.panicmem" && frame.Function != "runtime.sigpanic" {
+			stack = append(stack, frame)
+		}
+		if !more {
+			break
+		}
+	}
+	return stack
+}
+
+func formatFrames(frames []runtime.Frame) string {
+	var b strings.Builder
+	for _, frame := range frames {
+		fmt.Fprintf(&b, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+	}
+	return b.String()
+}
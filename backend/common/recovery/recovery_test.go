@@ -0,0 +1,90 @@
+package recovery
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	var reported []Event
+	recoverer := New("test-service", zap.NewNop(), ReporterFunc(func(event Event) {
+		reported = append(reported, event)
+	}))
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Explode"}
+	_, err := recoverer.UnaryServerInterceptor()(context.Background(), nil, info, func(context.Context, interface{}) (interface{}, error) {
+		panic("boom")
+	})
+
+	if st := status.Convert(err); st.Code() != codes.Internal || st.Message() != InternalMessage {
+		t.Fatalf("error = %v %q, want Internal %q", st.Code(), st.Message(), InternalMessage)
+	}
+	if len(reported) != 1 {
+		t.Fatalf("reported %d events, want 1", len(reported))
+	}
+	event := reported[0]
+	if event.Operation != info.FullMethod || event.Message != "boom" {
+		t.Errorf("event = %q %q, want the method and the panic value", event.Operation, event.Message)
+	}
+	if len(event.Frames) == 0 || !strings.Contains(event.Frames[0].Function, "TestUnaryServerInterceptor") {
+		t.Errorf("innermost frame = %+v, want the panicking handler", event.Frames)
+	}
+}
+
+func TestSentryReporter(t *testing.T) {
+	received := make(chan []string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/42/envelope/" || !strings.Contains(r.Header.Get("X-Sentry-Auth"), "sentry_key=public") {
+			t.Errorf("request to %s with auth %q", r.URL.Path, r.Header.Get("X-Sentry-Auth"))
+		}
+		body, _ := io.ReadAll(r.Body)
+		received <- strings.Split(strings.TrimSpace(string(body)), "\n")
+	}))
+	defer server.Close()
+
+	dsn := strings.Replace(server.URL, "http://", "http://public@", 1) + "/42"
+	reporter, err := NewSentryReporter(dsn, "test", "", zap.NewNop())
+	if err != nil {
+		t.Fatalf("NewSentryReporter() unexpected error: %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	reporter.Start(ctx)
+
+	reporter.Report(Event{Service: "test-service", Message: "boom", Operation: "/test.Service/Explode", Time: time.Now()})
+
+	select {
+	case lines := <-received:
+		if len(lines) != 3 {
+			t.Fatalf("envelope has %d lines, want 3", len(lines))
+		}
+		var event struct {
+			Level     string            `json:"level"`
+			Tags      map[string]string `json:"tags"`
+			Exception struct {
+				Values []struct {
+					Value string `json:"value"`
+				} `json:"values"`
+			} `json:"exception"`
+		}
+		if err := json.Unmarshal([]byte(lines[2]), &event); err != nil {
+			t.Fatalf("event is not JSON: %v", err)
+		}
+		if event.Tags["service"] != "test-service" || len(event.Exception.Values) != 1 || event.Exception.Values[0].Value != "boom" {
+			t.Errorf("event = %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no event received")
+	}
+}
@@ -0,0 +1,185 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// SentryReporter sends events to Sentry through its envelope endpoint. Events
+// are queued and sent in the background; when the queue is full they are
+// dropped, so that a burst of panics cannot pile up requests.
+type SentryReporter struct {
+	endpoint    string
+	authHeader  string
+	dsn         string
+	environment string
+	release     string
+	client      *http.Client
+	logger      *zap.Logger
+	queue       chan Event
+}
+
+// NewSentryReporter creates a reporter for a Sentry DSN, of the form
+// https://<public key>@<host>/<project ID>
+func NewSentryReporter(dsn, environment, release string, logger *zap.Logger) (*SentryReporter, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Sentry DSN: %w", err)
+	}
+	key := u.User.Username()
+	projectID := strings.TrimPrefix(u.Path, "/")
+	if key == "" || projectID == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid Sentry DSN: want https://<key>@<host>/<project ID>")
+	}
+
+	return &SentryReporter{
+		endpoint:    fmt.Sprintf("%s://%s/api/%s/envelope/", u.Scheme, u.Host, projectID),
+		authHeader:  fmt.Sprintf("Sentry sentry_version=7, sentry_client=ecommerce-recovery/1.0, sentry_key=%s", key),
+		dsn:         dsn,
+		environment: environment,
+		release:     release,
+		client:      &http.Client{Timeout: 5 * time.Second},
+		logger:      logger.Named("sentry"),
+		queue:       make(chan Event, 100),
+	}, nil
+}
+
+// ReporterFromEnv starts a Sentry reporter from SENTRY_DSN, tagged with
+// APP_ENV and SENTRY_RELEASE, that sends events until ctx is done. It
+// returns nil when SENTRY_DSN is unset, which leaves panics only logged.
+func ReporterFromEnv(ctx context.Context, logger *zap.Logger) (Reporter, error) {
+	dsn := os.Getenv("SENTRY_DSN")
+	if dsn == "" {
+		return nil, nil
+	}
+	reporter, err := NewSentryReporter(dsn, os.Getenv("APP_ENV"), os.Getenv("SENTRY_RELEASE"), logger)
+	if err != nil {
+		return nil, err
+	}
+	reporter.Start(ctx)
+	return reporter, nil
+}
+
+// Start sends queued events until ctx is done
+func (s *SentryReporter) Start(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-s.queue:
+				if err := s.send(ctx, event); err != nil {
+					s.logger.Warn("Failed to send event to Sentry", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Report queues an event, dropping it when the queue is full
+func (s *SentryReporter) Report(event Event) {
+	select {
+	case s.queue <- event:
+	default:
+		s.logger.Warn("Sentry queue full, dropping event", zap.String("operation", event.Operation))
+	}
+}
+
+// sentryFrame is a stack frame in the Sentry event format
+type sentryFrame struct {
+	Function string `json:"function"`
+	Filename string `json:"filename"`
+	Lineno   int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
+
+func (s *SentryReporter) send(ctx context.Context, event Event) error {
+	eventID := newEventID()
+
+	// Sentry lists frames outermost first
+	frames := make([]sentryFrame, 0, len(event.Frames))
+	for i := len(event.Frames) - 1; i >= 0; i-- {
+		frame := event.Frames[i]
+		frames = append(frames, sentryFrame{
+			Function: frame.Function,
+			Filename: frame.File,
+			Lineno:   frame.Line,
+			InApp:    strings.Contains(frame.Function, "e-commerce_project"),
+		})
+	}
+
+	tags := map[string]string{"service": event.Service}
+	if event.Operation != "" {
+		tags["operation"] = event.Operation
+	}
+	if event.RequestID != "" {
+		tags["request_id"] = event.RequestID
+	}
+	for k, v := range event.Tags {
+		tags[k] = v
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"event_id":    eventID,
+		"timestamp":   event.Time.Format(time.RFC3339Nano),
+		"platform":    "go",
+		"level":       "fatal",
+		"logger":      event.Service,
+		"server_name": event.Service,
+		"environment": s.environment,
+		"release":     s.release,
+		"transaction": event.Operation,
+		"tags":        tags,
+		"exception": map[string]any{
+			"values": []map[string]any{{
+				"type":       "panic",
+				"value":      event.Message,
+				"stacktrace": map[string]any{"frames": frames},
+				"mechanism":  map[string]any{"type": "recovery", "handled": true},
+			}},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	var body bytes.Buffer
+	json.NewEncoder(&body).Encode(map[string]string{"event_id": eventID, "dsn": s.dsn})
+	json.NewEncoder(&body).Encode(map[string]any{"type": "event", "length": len(payload)})
+	body.Write(payload)
+	body.WriteByte('\n')
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, &body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-sentry-envelope")
+	req.Header.Set("X-Sentry-Auth", s.authHeader)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("sentry responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
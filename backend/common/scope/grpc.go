@@ -0,0 +1,83 @@
+package scope
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataKey is the gRPC metadata key carrying the scopes of the user a
+// call is made for
+const MetadataKey = "x-user-scopes"
+
+// Policy lists the scope each method requires, by full method name. Methods
+// not listed require none.
+type Policy map[string]string
+
+// UnaryClientInterceptor forwards the scopes of the context to the called
+// service. Calls not made on behalf of a user forward none.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is the streaming counterpart of UnaryClientInterceptor
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+// UnaryServerInterceptor refuses the methods of policy to users without the
+// scope they require. Calls carrying no scopes are made by services on
+// their own behalf and are left to the service tokens.
+func UnaryServerInterceptor(policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := authorize(ctx, info.FullMethod, policy); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor
+func StreamServerInterceptor(policy Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := authorize(ss.Context(), info.FullMethod, policy); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+func authorize(ctx context.Context, method string, policy Policy) error {
+	required, ok := policy[method]
+	if !ok {
+		return nil
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return nil
+	}
+	if !Has(Parse(values[0]), required) {
+		return status.Errorf(codes.PermissionDenied, "scope %s required", required)
+	}
+	return nil
+}
+
+// outgoingContext adds the scopes of ctx to outgoing metadata
+func outgoingContext(ctx context.Context) context.Context {
+	scopes, ok := FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, Join(scopes))
+}
@@ -0,0 +1,73 @@
+package scope
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if _, ok := FromContext(ctx); ok {
+		t.Error("FromContext() of a context without scopes returned ok")
+	}
+
+	anonymous, ok := FromContext(NewContext(ctx, nil))
+	if !ok || len(anonymous) != 0 {
+		t.Errorf("FromContext() of an anonymous context = %v, %v, want no scopes and ok", anonymous, ok)
+	}
+
+	scopes, ok := FromContext(NewContext(ctx, Parse("products:read  products:write")))
+	if !ok || !Has(scopes, ProductsWrite) || Has(scopes, InventoryWrite) {
+		t.Errorf("FromContext() = %v, %v", scopes, ok)
+	}
+
+	if _, ok := FromContext(WithoutScopes(NewContext(ctx, nil))); ok {
+		t.Error("FromContext() after WithoutScopes() returned ok")
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	const method = "/product.ProductService/CreateProduct"
+	interceptor := UnaryServerInterceptor(Policy{method: ProductsWrite})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	tests := []struct {
+		name   string
+		md     metadata.MD
+		method string
+		want   codes.Code
+	}{
+		{"granted", metadata.Pairs(MetadataKey, "products:read products:write"), method, codes.OK},
+		{"missing scope", metadata.Pairs(MetadataKey, "products:read"), method, codes.PermissionDenied},
+		{"anonymous", metadata.Pairs(MetadataKey, ""), method, codes.PermissionDenied},
+		{"service call", metadata.MD{}, method, codes.OK},
+		{"open method", metadata.Pairs(MetadataKey, ""), "/product.ProductService/GetProduct", codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("interceptor() code = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutgoingContext(t *testing.T) {
+	ctx := outgoingContext(NewContext(context.Background(), []string{ProductsRead, InventoryRead}))
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if got := md.Get(MetadataKey); len(got) != 1 || got[0] != "products:read inventory:read" {
+		t.Errorf("outgoing scopes = %v", got)
+	}
+
+	ctx = outgoingContext(context.Background())
+	if md, ok := metadata.FromOutgoingContext(ctx); ok && len(md.Get(MetadataKey)) > 0 {
+		t.Errorf("outgoing scopes of a service call = %v, want none", md.Get(MetadataKey))
+	}
+}
@@ -0,0 +1,134 @@
+package servicetoken
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var signer atomic.Pointer[Signer]
+
+// SetSigner sets the signer the client interceptors attach tokens with. A nil
+// signer sends calls without tokens.
+func SetSigner(s *Signer) {
+	signer.Store(s)
+}
+
+// Policy lists the callers allowed to call each privileged method, by full
+// method name. Methods not listed are open to any caller.
+type Policy map[string][]string
+
+type callerKey struct{}
+
+// CallerFromContext returns the service that made an authenticated call
+func CallerFromContext(ctx context.Context) (string, bool) {
+	caller, ok := ctx.Value(callerKey{}).(string)
+	return caller, ok
+}
+
+// UnaryClientInterceptor attaches a token for the called service to outgoing
+// calls
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx, method), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is the streaming counterpart of UnaryClientInterceptor
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx, method), desc, cc, method, opts...)
+	}
+}
+
+// UnaryServerInterceptor verifies the token of incoming calls and enforces
+// policy. A nil verifier means tokens are not configured: every call is let
+// through anonymously.
+func UnaryServerInterceptor(verifier *Verifier, policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := authorize(ctx, info.FullMethod, verifier, policy)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor
+func StreamServerInterceptor(verifier *Verifier, policy Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authorize(ss.Context(), info.FullMethod, verifier, policy)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// authorize stores the caller of an authenticated call in ctx and refuses
+// privileged methods to other callers. Invalid tokens on open methods are
+// ignored, so that a misconfigured caller only loses privileged access.
+func authorize(ctx context.Context, method string, verifier *Verifier, policy Policy) (context.Context, error) {
+	if verifier == nil {
+		return ctx, nil
+	}
+
+	var claims *Claims
+	var verifyErr error
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(MetadataKey); len(values) > 0 && values[0] != "" {
+			claims, verifyErr = verifier.Verify(values[0], audience(method))
+		}
+	}
+	if claims != nil {
+		ctx = context.WithValue(ctx, callerKey{}, claims.Issuer)
+	}
+
+	allowed, privileged := policy[method]
+	if !privileged {
+		return ctx, nil
+	}
+	if claims == nil {
+		if verifyErr != nil {
+			return nil, status.Error(codes.Unauthenticated, verifyErr.Error())
+		}
+		return nil, status.Error(codes.Unauthenticated, "service token required")
+	}
+	for _, caller := range allowed {
+		if caller == claims.Issuer {
+			return ctx, nil
+		}
+	}
+	return nil, status.Errorf(codes.PermissionDenied, "service %s may not call %s", claims.Issuer, method)
+}
+
+// outgoingContext adds a token for the service of method to outgoing metadata
+func outgoingContext(ctx context.Context, method string) context.Context {
+	s := signer.Load()
+	if s == nil {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, s.Token(audience(method)))
+}
+
+// audience returns the service of a full method name, e.g.
+// inventory.InventoryService for /inventory.InventoryService/GetInventoryItem
+func audience(method string) string {
+	service, _, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	return service
+}
+
+// contextServerStream overrides the context of a server stream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,218 @@
+// Package servicetoken authenticates the gRPC calls services make to each
+// other. Each calling service holds an Ed25519 key and signs short-lived
+// tokens naming itself and the called service; the called service verifies
+// them with the public keys of the callers it trusts, so that privileged RPCs
+// can be limited to the services meant to call them.
+package servicetoken
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// MetadataKey is the gRPC metadata key carrying the token
+	MetadataKey = "x-service-token"
+
+	// PrivateKeyEnv holds the base64 encoded Ed25519 seed a service signs with
+	PrivateKeyEnv = "SERVICE_TOKEN_KEY"
+	// TrustedKeysEnv lists the callers a service accepts as
+	// name=base64(public key) pairs, separated by commas
+	TrustedKeysEnv = "SERVICE_TOKEN_TRUSTED_KEYS"
+
+	// DefaultTTL is the lifetime of a token
+	DefaultTTL = time.Minute
+
+	// leeway tolerates clock drift between services
+	leeway = 30 * time.Second
+)
+
+var (
+	ErrInvalid = errors.New("invalid service token")
+	ErrExpired = errors.New("service token has expired")
+)
+
+// Claims identifies the service that signed a token and the service it is
+// valid for
+type Claims struct {
+	Issuer    string `json:"iss"`
+	Audience  string `json:"aud"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Signer creates the tokens of one service. Tokens are cached per audience
+// and renewed once half their lifetime has passed.
+type Signer struct {
+	service string
+	key     ed25519.PrivateKey
+	ttl     time.Duration
+	now     func() time.Time
+
+	mu     sync.Mutex
+	tokens map[string]cachedToken
+}
+
+type cachedToken struct {
+	token   string
+	renewAt time.Time
+}
+
+// NewSigner creates a signer for the named service
+func NewSigner(service string, key ed25519.PrivateKey) *Signer {
+	return &Signer{
+		service: service,
+		key:     key,
+		ttl:     DefaultTTL,
+		now:     time.Now,
+		tokens:  make(map[string]cachedToken),
+	}
+}
+
+// Service returns the name the signer issues tokens as
+func (s *Signer) Service() string {
+	return s.service
+}
+
+// Token returns a token for calling the audience service
+// Format: base64url(JSON claims).base64url(Ed25519 signature)
+func (s *Signer) Token(audience string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if cached, ok := s.tokens[audience]; ok && now.Before(cached.renewAt) {
+		return cached.token
+	}
+
+	payload, _ := json.Marshal(Claims{
+		Issuer:    s.service,
+		Audience:  audience,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.ttl).Unix(),
+	})
+	token := base64.RawURLEncoding.EncodeToString(payload) + "." +
+		base64.RawURLEncoding.EncodeToString(ed25519.Sign(s.key, payload))
+
+	s.tokens[audience] = cachedToken{token: token, renewAt: now.Add(s.ttl / 2)}
+	return token
+}
+
+// Verifier checks tokens against the public keys of the trusted callers
+type Verifier struct {
+	keys map[string]ed25519.PublicKey
+	now  func() time.Time
+}
+
+// NewVerifier creates a verifier trusting the given callers, by service name
+func NewVerifier(keys map[string]ed25519.PublicKey) *Verifier {
+	return &Verifier{keys: keys, now: time.Now}
+}
+
+// Verify checks the signature, audience and expiry of a token and returns
+// its claims
+func (v *Verifier) Verify(token, audience string) (*Claims, error) {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, ErrInvalid
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, ErrInvalid
+	}
+
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrInvalid
+	}
+	key, ok := v.keys[claims.Issuer]
+	if !ok || !ed25519.Verify(key, payload, signature) {
+		return nil, ErrInvalid
+	}
+	if claims.Audience != audience {
+		return nil, ErrInvalid
+	}
+
+	now := v.now()
+	if now.Add(leeway).Before(time.Unix(claims.IssuedAt, 0)) {
+		return nil, ErrInvalid
+	}
+	if !now.Add(-leeway).Before(time.Unix(claims.ExpiresAt, 0)) {
+		return nil, ErrExpired
+	}
+	return &claims, nil
+}
+
+// ParsePrivateKey decodes a base64 encoded Ed25519 seed, as produced by
+// `openssl genpkey -algorithm ed25519 -outform DER | tail -c 32 | base64`
+func ParsePrivateKey(value string) (ed25519.PrivateKey, error) {
+	seed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		return nil, fmt.Errorf("invalid service token key: %w", err)
+	}
+	if len(seed) != ed25519.SeedSize {
+		return nil, fmt.Errorf("invalid service token key: want a %d byte seed, got %d bytes", ed25519.SeedSize, len(seed))
+	}
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// ParseTrustedKeys decodes a list of name=base64(public key) pairs separated
+// by commas
+func ParseTrustedKeys(value string) (map[string]ed25519.PublicKey, error) {
+	keys := make(map[string]ed25519.PublicKey)
+	for _, pair := range strings.Split(value, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		name, encoded, ok := strings.Cut(pair, "=")
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid trusted service key %q: want name=key", pair)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid public key of service %s", name)
+		}
+		keys[name] = ed25519.PublicKey(key)
+	}
+	return keys, nil
+}
+
+// SignerFromEnv creates a signer for the named service from PrivateKeyEnv. It
+// returns nil when the variable is unset, so that calls go out without tokens.
+func SignerFromEnv(service string) (*Signer, error) {
+	value := os.Getenv(PrivateKeyEnv)
+	if value == "" {
+		return nil, nil
+	}
+	key, err := ParsePrivateKey(value)
+	if err != nil {
+		return nil, err
+	}
+	return NewSigner(service, key), nil
+}
+
+// VerifierFromEnv creates a verifier from TrustedKeysEnv. It returns nil when
+// the variable is unset, which leaves privileged RPCs unenforced.
+func VerifierFromEnv() (*Verifier, error) {
+	value := os.Getenv(TrustedKeysEnv)
+	if value == "" {
+		return nil, nil
+	}
+	keys, err := ParseTrustedKeys(value)
+	if err != nil {
+		return nil, err
+	}
+	return NewVerifier(keys), nil
+}
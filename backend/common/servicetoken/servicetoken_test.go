@@ -0,0 +1,104 @@
+package servicetoken
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func newKey(t *testing.T) (ed25519.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() unexpected error: %v", err)
+	}
+	return public, private
+}
+
+func TestServiceToken(t *testing.T) {
+	gatewayPublic, gatewayPrivate := newKey(t)
+	_, otherPrivate := newKey(t)
+
+	now := time.Now()
+	signer := NewSigner("api-gateway", gatewayPrivate)
+	signer.now = func() time.Time { return now }
+	verifier := NewVerifier(map[string]ed25519.PublicKey{"api-gateway": gatewayPublic})
+	verifier.now = func() time.Time { return now }
+
+	token := signer.Token("product.ProductService")
+	if again := signer.Token("product.ProductService"); again != token {
+		t.Error("Token() was not cached")
+	}
+
+	claims, err := verifier.Verify(token, "product.ProductService")
+	if err != nil {
+		t.Fatalf("Verify() unexpected error: %v", err)
+	}
+	if claims.Issuer != "api-gateway" {
+		t.Errorf("Verify() issuer = %q, want %q", claims.Issuer, "api-gateway")
+	}
+
+	if _, err := verifier.Verify(token, "inventory.InventoryService"); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Verify() for another audience error = %v, want %v", err, ErrInvalid)
+	}
+	forged := NewSigner("api-gateway", otherPrivate).Token("product.ProductService")
+	if _, err := verifier.Verify(forged, "product.ProductService"); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Verify() of a forged token error = %v, want %v", err, ErrInvalid)
+	}
+
+	verifier.now = func() time.Time { return now.Add(DefaultTTL + leeway) }
+	if _, err := verifier.Verify(token, "product.ProductService"); !errors.Is(err, ErrExpired) {
+		t.Errorf("Verify() after expiry error = %v, want %v", err, ErrExpired)
+	}
+}
+
+func TestServerInterceptor(t *testing.T) {
+	gatewayPublic, gatewayPrivate := newKey(t)
+	productPublic, productPrivate := newKey(t)
+	verifier := NewVerifier(map[string]ed25519.PublicKey{
+		"api-gateway":     gatewayPublic,
+		"product-service": productPublic,
+	})
+
+	const privileged = "/inventory.InventoryService/CreateWarehouse"
+	const open = "/inventory.InventoryService/GetInventoryItem"
+	interceptor := UnaryServerInterceptor(verifier, Policy{privileged: {"api-gateway"}})
+
+	call := func(signer *Signer, method string) (string, error) {
+		ctx := context.Background()
+		if signer != nil {
+			md := metadata.Pairs(MetadataKey, signer.Token(audience(method)))
+			ctx = metadata.NewIncomingContext(ctx, md)
+		}
+		var caller string
+		_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, _ interface{}) (interface{}, error) {
+			caller, _ = CallerFromContext(ctx)
+			return nil, nil
+		})
+		return caller, err
+	}
+
+	gateway := NewSigner("api-gateway", gatewayPrivate)
+	product := NewSigner("product-service", productPrivate)
+
+	if caller, err := call(gateway, privileged); err != nil || caller != "api-gateway" {
+		t.Errorf("privileged call of the gateway = %q, %v, want api-gateway, nil", caller, err)
+	}
+	if _, err := call(nil, privileged); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("privileged call without token code = %v, want %v", status.Code(err), codes.Unauthenticated)
+	}
+	if _, err := call(product, privileged); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("privileged call of another service code = %v, want %v", status.Code(err), codes.PermissionDenied)
+	}
+	if _, err := call(nil, open); err != nil {
+		t.Errorf("open call without token unexpected error: %v", err)
+	}
+}
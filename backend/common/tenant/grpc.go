@@ -0,0 +1,77 @@
+package tenant
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor reads the tenant ID from incoming metadata and stores
+// it in the handler context. Calls without a tenant use DefaultTenantID.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := incomingContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is the streaming counterpart of UnaryServerInterceptor
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := incomingContext(ss.Context())
+		if err != nil {
+			return err
+		}
+		return handler(srv, &contextServerStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// incomingContext stores the tenant ID from incoming metadata in ctx
+func incomingContext(ctx context.Context) (context.Context, error) {
+	tenantID := DefaultTenantID
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(MetadataKey); len(values) > 0 && values[0] != "" {
+			tenantID = values[0]
+		}
+	}
+	if !IsValidID(tenantID) {
+		return nil, status.Error(codes.InvalidArgument, "invalid tenant ID")
+	}
+	return WithTenant(ctx, tenantID), nil
+}
+
+// UnaryClientInterceptor forwards the tenant ID stored in the context to the
+// called service as outgoing metadata
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(outgoingContext(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor is the streaming counterpart of UnaryClientInterceptor
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(outgoingContext(ctx), desc, cc, method, opts...)
+	}
+}
+
+// outgoingContext copies the tenant ID from ctx into outgoing metadata
+func outgoingContext(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, FromContext(ctx))
+}
+
+// contextServerStream overrides the context of a server stream
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
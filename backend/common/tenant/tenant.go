@@ -0,0 +1,44 @@
+// Package tenant carries the store (tenant) a request belongs to from the
+// gateway through the gRPC services.
+package tenant
+
+import (
+	"context"
+	"regexp"
+)
+
+const (
+	// DefaultTenantID is the store used when a request names no tenant. Data
+	// created before stores existed belongs to it.
+	DefaultTenantID = "default"
+	// Header is the HTTP header clients may use to select a store
+	Header = "X-Tenant-ID"
+	// MetadataKey is the gRPC metadata key carrying the tenant ID
+	MetadataKey = "x-tenant-id"
+)
+
+// validID matches store IDs: lowercase letters, digits and dashes
+var validID = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,49}$`)
+
+// IsValidID reports whether id can be used as a tenant ID
+func IsValidID(id string) bool {
+	return validID.MatchString(id)
+}
+
+type contextKey struct{}
+
+// WithTenant returns a copy of ctx carrying the tenant ID
+func WithTenant(ctx context.Context, tenantID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, tenantID)
+}
+
+// FromContext returns the tenant ID stored in ctx, or DefaultTenantID when
+// there is none
+func FromContext(ctx context.Context) string {
+	if ctx != nil {
+		if tenantID, ok := ctx.Value(contextKey{}).(string); ok && tenantID != "" {
+			return tenantID
+		}
+	}
+	return DefaultTenantID
+}
@@ -0,0 +1,94 @@
+// Package availability decides the stock badge customers see for a product.
+// It is the one place the availability thresholds are applied: the inventory
+// service returns the badge with its items, availability checks and stock
+// changes, and the gateway and the product service render that badge rather
+// than comparing quantities themselves.
+package availability
+
+// Stock badges, from the most to the least available
+const (
+	InStock    = "IN_STOCK"
+	LowStock   = "LOW_STOCK"
+	Backorder  = "BACKORDER"
+	OutOfStock = "OUT_OF_STOCK"
+)
+
+// Policy holds the availability thresholds of a product. The zero policy
+// flags stock as low at the reorder point of the item and never backorders.
+type Policy struct {
+	// LowStockThreshold is the sellable quantity at or below which stock is
+	// low. Nil uses the reorder point of the item.
+	LowStockThreshold *int
+	// OutOfStockThreshold is the sellable quantity at or below which the
+	// product is out of stock, keeping the last units off the storefront
+	OutOfStockThreshold int
+	// AllowBackorder lets customers order the product while it is out of
+	// stock
+	AllowBackorder bool
+}
+
+// Stock is the state of an inventory item a badge is computed from
+type Stock struct {
+	// Sellable is the available quantity without the safety stock
+	Sellable     int
+	ReorderPoint int
+	// Discontinued items are out of stock whatever their quantity
+	Discontinued bool
+	// Backordered items were marked by staff as taking backorders
+	Backordered bool
+}
+
+// Badge returns the badge of the stock under the policy
+func (p Policy) Badge(stock Stock) string {
+	if stock.Discontinued {
+		return OutOfStock
+	}
+	if stock.Sellable <= p.OutOfStockThreshold {
+		if p.AllowBackorder || stock.Backordered {
+			return Backorder
+		}
+		return OutOfStock
+	}
+
+	lowStock := stock.ReorderPoint
+	if p.LowStockThreshold != nil {
+		lowStock = *p.LowStockThreshold
+	}
+	if stock.Sellable <= lowStock {
+		return LowStock
+	}
+	return InStock
+}
+
+// Purchasable reports whether customers can order a product with the badge
+func Purchasable(badge string) bool {
+	return badge == InStock || badge == LowStock || badge == Backorder
+}
+
+// OnHand reports whether the badge means the product ships right away
+func OnHand(badge string) bool {
+	return badge == InStock || badge == LowStock
+}
+
+// rank orders the badges from the most to the least available; unknown
+// badges rank as out of stock
+var rank = map[string]int{InStock: 0, LowStock: 1, Backorder: 2, OutOfStock: 3}
+
+// Least returns the least available of the badges, such as the badge of a
+// bundle from those of its components. No badges give OutOfStock.
+func Least(badges ...string) string {
+	least := ""
+	for _, badge := range badges {
+		r, ok := rank[badge]
+		if !ok {
+			return OutOfStock
+		}
+		if least == "" || r > rank[least] {
+			least = badge
+		}
+	}
+	if least == "" {
+		return OutOfStock
+	}
+	return least
+}
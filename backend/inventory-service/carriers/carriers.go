@@ -0,0 +1,49 @@
+// Package carriers defines the adapters the inventory service polls carriers
+// for the tracking events of shipments with. Carriers that push events to the
+// webhook endpoint need no adapter.
+package carriers
+
+import (
+	"context"
+	"sort"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// Tracker fetches the tracking events of a shipment from a carrier. Events
+// must use the shipment statuses of the models package; trackers translate
+// the statuses of their carrier.
+type Tracker interface {
+	Track(ctx context.Context, trackingNumber string) ([]models.ShipmentEvent, error)
+}
+
+// Registry holds the trackers of the carriers that are polled, by carrier
+// name
+type Registry struct {
+	trackers map[string]Tracker
+}
+
+// NewRegistry creates an empty registry
+func NewRegistry() *Registry {
+	return &Registry{trackers: make(map[string]Tracker)}
+}
+
+// Register sets the tracker of a carrier
+func (r *Registry) Register(carrier string, tracker Tracker) {
+	r.trackers[carrier] = tracker
+}
+
+// Get returns the tracker of a carrier, nil when the carrier is not polled
+func (r *Registry) Get(carrier string) Tracker {
+	return r.trackers[carrier]
+}
+
+// Carriers lists the polled carriers in name order
+func (r *Registry) Carriers() []string {
+	names := make([]string, 0, len(r.trackers))
+	for name := range r.trackers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
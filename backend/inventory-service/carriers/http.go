@@ -0,0 +1,93 @@
+package carriers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// HTTPTracker polls a tracking API that answers GET requests for a tracking
+// number with the events in the format of the carrier webhook:
+//
+//	{"events": [{"status": "in_transit", "description": "...", "location": "...", "occurred_at": "2024-01-02T15:04:05Z"}]}
+//
+// Carrier APIs with another format sit behind a small translating proxy or
+// get a Tracker of their own.
+type HTTPTracker struct {
+	// URL is the tracking URL, with {tracking_number} standing for the
+	// escaped tracking number
+	URL string
+	// APIKey is sent as a bearer token when set
+	APIKey string
+	Client *http.Client
+}
+
+// NewHTTPTracker creates a tracker for the given URL template
+func NewHTTPTracker(urlTemplate, apiKey string) *HTTPTracker {
+	return &HTTPTracker{
+		URL:    urlTemplate,
+		APIKey: apiKey,
+		Client: &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+type httpTrackingResponse struct {
+	Events []struct {
+		Status      string    `json:"status"`
+		Description string    `json:"description"`
+		Location    string    `json:"location"`
+		OccurredAt  time.Time `json:"occurred_at"`
+	} `json:"events"`
+}
+
+// Track fetches the tracking events of a shipment. Events with unknown
+// statuses are skipped.
+func (t *HTTPTracker) Track(ctx context.Context, trackingNumber string) ([]models.ShipmentEvent, error) {
+	target := strings.ReplaceAll(t.URL, "{tracking_number}", url.PathEscape(trackingNumber))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create tracking request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if t.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+t.APIKey)
+	}
+
+	resp, err := t.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tracking events: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		// The carrier does not know the shipment yet
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("tracking API returned status %d", resp.StatusCode)
+	}
+
+	var body httpTrackingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to decode tracking events: %w", err)
+	}
+
+	events := make([]models.ShipmentEvent, 0, len(body.Events))
+	for _, e := range body.Events {
+		if !models.IsShipmentStatus(e.Status) || e.OccurredAt.IsZero() {
+			continue
+		}
+		events = append(events, models.ShipmentEvent{
+			Status:      e.Status,
+			Description: e.Description,
+			Location:    e.Location,
+			OccurredAt:  e.OccurredAt,
+		})
+	}
+	return events, nil
+}
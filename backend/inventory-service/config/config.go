@@ -1,19 +1,38 @@
 package config
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/spf13/viper"
+	"go.uber.org/zap"
+
+	sharedconfig "github.com/louai60/e-commerce_project/backend/shared/config"
 )
 
+// consulConfigKey is the default Consul KV key holding runtime overrides
+const consulConfigKey = "ecommerce/inventory-service/config"
+
 // Config holds all configuration for the service
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Redis    RedisConfig    `mapstructure:"redis"`
-	Logging  LoggingConfig  `mapstructure:"logging"`
+	Server      ServerConfig      `mapstructure:"server"`
+	Database    DatabaseConfig    `mapstructure:"database"`
+	Redis       RedisConfig       `mapstructure:"redis"`
+	Logging     LoggingConfig     `mapstructure:"logging"`
+	Snapshot    SnapshotConfig    `mapstructure:"snapshot"`
+	Forecast    ForecastConfig    `mapstructure:"forecast"`
+	StockAlerts StockAlertsConfig `mapstructure:"stock_alerts"`
+	Archival    ArchivalConfig    `mapstructure:"archival"`
+	Profiling   ProfilingConfig   `mapstructure:"profiling"`
+	Shipments   ShipmentsConfig   `mapstructure:"shipments"`
+	BackInStock BackInStockConfig `mapstructure:"back_in_stock"`
+	Lots        LotsConfig        `mapstructure:"lots"`
+	Pickup      PickupConfig      `mapstructure:"pickup"`
+	Mail        MailConfig        `mapstructure:"mail"`
+	Fraud       FraudConfig       `mapstructure:"fraud"`
 }
 
 // ServerConfig holds the configuration for the gRPC server
@@ -47,8 +66,161 @@ type LoggingConfig struct {
 	Level string `mapstructure:"level"`
 }
 
+// SnapshotConfig holds the configuration for the nightly inventory snapshot job
+type SnapshotConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	HourUTC int  `mapstructure:"hour_utc"`
+}
+
+// ForecastConfig holds the configuration for the nightly forecasting job.
+// Reorder suggestions cover the sales of the supplier lead time plus
+// review_days.
+type ForecastConfig struct {
+	Enabled    bool `mapstructure:"enabled"`
+	HourUTC    int  `mapstructure:"hour_utc"`
+	ReviewDays int  `mapstructure:"review_days"`
+}
+
+// StockAlertsConfig holds the configuration for the low stock alerting job,
+// which reports items below their reorder point and locations outside their
+// safety and max stock
+type StockAlertsConfig struct {
+	Enabled         bool `mapstructure:"enabled"`
+	IntervalMinutes int  `mapstructure:"interval_minutes"`
+}
+
+// ArchivalConfig holds the configuration for the job maintaining the monthly
+// partitions of inventory movements. Movements older than retention_months
+// are exported to archive_dir and dropped; zero keeps them all.
+type ArchivalConfig struct {
+	Enabled         bool   `mapstructure:"enabled"`
+	IntervalHours   int    `mapstructure:"interval_hours"`
+	RetentionMonths int    `mapstructure:"retention_months"`
+	ArchiveDir      string `mapstructure:"archive_dir"`
+}
+
+// ProfilingConfig holds the configuration for the pprof endpoints, served on
+// a separate listener that should stay internal
+type ProfilingConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Addr    string `mapstructure:"addr"`
+}
+
+// ShipmentsConfig holds the configuration for polling carriers for the
+// tracking events of shipments under way. Only the carriers listed are
+// polled; others are expected to push events to the webhook endpoint.
+type ShipmentsConfig struct {
+	PollEnabled         bool                     `mapstructure:"poll_enabled"`
+	PollIntervalMinutes int                      `mapstructure:"poll_interval_minutes"`
+	Carriers            map[string]CarrierConfig `mapstructure:"carriers"`
+}
+
+// CarrierConfig holds the tracking API of a polled carrier. tracking_url
+// contains {tracking_number} where the tracking number goes.
+type CarrierConfig struct {
+	TrackingURL string `mapstructure:"tracking_url"`
+	APIKey      string `mapstructure:"api_key"`
+}
+
+// BackInStockConfig holds the configuration for emailing back-in-stock
+// subscribers. Products restocked while the notifier missed the change are
+// caught by a sweep every sweep_interval_minutes. product_url is the
+// storefront page linked in the emails, with {product_id} where the product
+// ID goes.
+type BackInStockConfig struct {
+	Enabled              bool   `mapstructure:"enabled"`
+	SweepIntervalMinutes int    `mapstructure:"sweep_interval_minutes"`
+	ProductURL           string `mapstructure:"product_url"`
+}
+
+// LotsConfig holds the configuration for writing off the unreserved units
+// of expired lots every write_off_interval_minutes
+type LotsConfig struct {
+	WriteOffEnabled         bool `mapstructure:"write_off_enabled"`
+	WriteOffIntervalMinutes int  `mapstructure:"write_off_interval_minutes"`
+}
+
+// PickupConfig holds the configuration for click-and-collect orders. Ready
+// orders not collected within collect_days are cancelled and restocked by a
+// sweep every expiry_interval_minutes.
+type PickupConfig struct {
+	CollectDays           int  `mapstructure:"collect_days"`
+	ExpiryEnabled         bool `mapstructure:"expiry_enabled"`
+	ExpiryIntervalMinutes int  `mapstructure:"expiry_interval_minutes"`
+}
+
+// MailConfig holds the SMTP relay emails are sent through. Emails are only
+// logged when smtp_host is not set.
+type MailConfig struct {
+	SMTPHost     string `mapstructure:"smtp_host"`
+	SMTPPort     int    `mapstructure:"smtp_port"`
+	SMTPUsername string `mapstructure:"smtp_username"`
+	SMTPPassword string `mapstructure:"smtp_password"`
+	From         string `mapstructure:"from"`
+}
+
+// FraudConfig holds the configuration for screening orders for fraud. Orders
+// scoring at least review_score points wait for staff review, and those
+// scoring at least decline_score points are declined. Customers placing more
+// than velocity_max_orders orders within velocity_window_minutes, orders
+// billed and shipped to different countries and disposable email addresses
+// score the points of their rule. Providers are external fraud providers
+// orders are also sent to.
+type FraudConfig struct {
+	ReviewScore           int                            `mapstructure:"review_score"`
+	DeclineScore          int                            `mapstructure:"decline_score"`
+	VelocityWindowMinutes int                            `mapstructure:"velocity_window_minutes"`
+	VelocityMaxOrders     int                            `mapstructure:"velocity_max_orders"`
+	VelocityPoints        int                            `mapstructure:"velocity_points"`
+	CountryMismatchPoints int                            `mapstructure:"country_mismatch_points"`
+	DisposableEmailPoints int                            `mapstructure:"disposable_email_points"`
+	DisposableDomains     []string                       `mapstructure:"disposable_domains"`
+	Providers             map[string]FraudProviderConfig `mapstructure:"providers"`
+}
+
+// FraudProviderConfig holds the scoring API of an external fraud provider
+type FraudProviderConfig struct {
+	URL    string `mapstructure:"url"`
+	APIKey string `mapstructure:"api_key"`
+}
+
 // LoadConfig loads the configuration from config files and environment variables
 func LoadConfig() (*Config, error) {
+	config, _, err := readConfig(context.Background(), sharedconfig.NewConsulSourceFromEnv(consulConfigKey))
+	return config, err
+}
+
+// NewWatcher loads the configuration and returns a watcher that reloads it
+// whenever the config file, the INVENTORY_ environment variables or the
+// optional Consul key change
+func NewWatcher(ctx context.Context, logger *zap.Logger) (*sharedconfig.Watcher[Config], error) {
+	remote := sharedconfig.NewConsulSourceFromEnv(consulConfigKey)
+
+	_, file, err := readConfig(ctx, remote)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []sharedconfig.Source{sharedconfig.NewEnvSource("INVENTORY_")}
+	if file != "" {
+		sources = append(sources, sharedconfig.NewFileSource(file))
+	}
+	if remote != nil {
+		sources = append(sources, remote)
+	}
+
+	return sharedconfig.NewWatcher(ctx, func(ctx context.Context) (*Config, error) {
+		config, _, err := readConfig(ctx, remote)
+		return config, err
+	}, sharedconfig.WatcherOptions{
+		Sources: sources,
+		Logger:  logger,
+	})
+}
+
+// readConfig loads the configuration, merging the Consul overrides when a
+// remote source is given, and returns it with the config file path used
+func readConfig(ctx context.Context, remote *sharedconfig.ConsulSource) (*Config, string, error) {
 	var config Config
 
 	// Set default configuration file path
@@ -74,7 +246,20 @@ func LoadConfig() (*Config, error) {
 	if err := v.ReadInConfig(); err != nil {
 		// It's okay if config file doesn't exist
 		if _, ok := err.(viper.ConfigFileNotFoundError); !ok {
-			return nil, fmt.Errorf("error reading config file: %w", err)
+			return nil, "", fmt.Errorf("error reading config file: %w", err)
+		}
+	}
+
+	// Merge runtime overrides from Consul
+	if remote != nil {
+		data, err := remote.Fetch(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading remote config: %w", err)
+		}
+		if len(data) > 0 {
+			if err := v.MergeConfig(bytes.NewReader(data)); err != nil {
+				return nil, "", fmt.Errorf("error merging remote config: %w", err)
+			}
 		}
 	}
 
@@ -88,10 +273,10 @@ func LoadConfig() (*Config, error) {
 
 	// Unmarshal config
 	if err := v.Unmarshal(&config); err != nil {
-		return nil, fmt.Errorf("unable to decode config into struct: %w", err)
+		return nil, "", fmt.Errorf("unable to decode config into struct: %w", err)
 	}
 
-	return &config, nil
+	return &config, v.ConfigFileUsed(), nil
 }
 
 // setDefaults sets default values for configuration
@@ -118,4 +303,61 @@ func setDefaults(v *viper.Viper) {
 
 	// Logging defaults
 	v.SetDefault("logging.level", "info")
+
+	// Snapshot defaults
+	v.SetDefault("snapshot.enabled", true)
+	v.SetDefault("snapshot.hour_utc", 0)
+
+	// Forecasting defaults
+	v.SetDefault("forecast.enabled", true)
+	v.SetDefault("forecast.hour_utc", 1)
+	v.SetDefault("forecast.review_days", 14)
+
+	// Stock alert defaults
+	v.SetDefault("stock_alerts.enabled", true)
+	v.SetDefault("stock_alerts.interval_minutes", 15)
+
+	// Archival defaults
+	v.SetDefault("archival.enabled", true)
+	v.SetDefault("archival.interval_hours", 24)
+	v.SetDefault("archival.retention_months", 24)
+	v.SetDefault("archival.archive_dir", "./archive")
+
+	// Profiling defaults
+	v.SetDefault("profiling.enabled", false)
+	v.SetDefault("profiling.addr", "127.0.0.1:6062")
+
+	// Shipment tracking defaults
+	v.SetDefault("shipments.poll_enabled", true)
+	v.SetDefault("shipments.poll_interval_minutes", 30)
+
+	// Back-in-stock defaults
+	v.SetDefault("back_in_stock.enabled", true)
+	v.SetDefault("back_in_stock.sweep_interval_minutes", 10)
+	v.SetDefault("back_in_stock.product_url", "")
+
+	// Lot expiry defaults
+	v.SetDefault("lots.write_off_enabled", true)
+	v.SetDefault("lots.write_off_interval_minutes", 60)
+
+	// Pickup order defaults
+	v.SetDefault("pickup.collect_days", 7)
+	v.SetDefault("pickup.expiry_enabled", true)
+	v.SetDefault("pickup.expiry_interval_minutes", 60)
+
+	// Fraud screening defaults
+	v.SetDefault("fraud.review_score", 50)
+	v.SetDefault("fraud.decline_score", 80)
+	v.SetDefault("fraud.velocity_window_minutes", 60)
+	v.SetDefault("fraud.velocity_max_orders", 5)
+	v.SetDefault("fraud.velocity_points", 50)
+	v.SetDefault("fraud.country_mismatch_points", 30)
+	v.SetDefault("fraud.disposable_email_points", 40)
+
+	// Mail defaults
+	v.SetDefault("mail.smtp_host", "")
+	v.SetDefault("mail.smtp_port", 587)
+	v.SetDefault("mail.smtp_username", "")
+	v.SetDefault("mail.smtp_password", "")
+	v.SetDefault("mail.from", "")
 }
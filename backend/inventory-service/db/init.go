@@ -8,7 +8,7 @@ import (
 	"sort"
 	"strings"
 
-	_ "github.com/lib/pq"
+	_ "github.com/jackc/pgx/v5/stdlib"
 	"go.uber.org/zap"
 
 	"github.com/louai60/e-commerce_project/backend/inventory-service/config"
@@ -22,7 +22,7 @@ func InitDatabase(cfg *config.Config, logger *zap.Logger) (*sql.DB, error) {
 		cfg.Database.Host, cfg.Database.Port, cfg.Database.User, cfg.Database.Password,
 	)
 
-	pgDB, err := sql.Open("postgres", pgDSN)
+	pgDB, err := sql.Open("pgx", pgDSN)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
 	}
@@ -54,7 +54,7 @@ func InitDatabase(cfg *config.Config, logger *zap.Logger) (*sql.DB, error) {
 		cfg.Database.Host, cfg.Database.Port, cfg.Database.User, cfg.Database.Password, cfg.Database.Name,
 	)
 
-	db, err := sql.Open("postgres", dbDSN)
+	db, err := sql.Open("pgx", dbDSN)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -0,0 +1,94 @@
+// Package fraud scores orders at checkout for the risk of fraud. Scorers are
+// pluggable: the built-in rules check the velocity of orders, billing and
+// shipping countries that do not match and disposable email addresses, and
+// external fraud providers plug in over HTTP. The points of all scorers add
+// up to the score of an order, which thresholds turn into a decision.
+package fraud
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// Scorer scores an order. It returns a signal for each risk it finds, none
+// when the order looks fine.
+type Scorer interface {
+	Score(ctx context.Context, check *models.FraudCheck) ([]models.FraudSignal, error)
+}
+
+// Thresholds turn scores into decisions: orders scoring at least Review
+// points are reviewed by staff, and those scoring at least Decline points
+// are declined
+type Thresholds struct {
+	Review  int
+	Decline int
+}
+
+// DefaultThresholds send orders with one strong signal to review and decline
+// orders with two
+var DefaultThresholds = Thresholds{Review: 50, Decline: 80}
+
+// Decide returns the decision for a score
+func (t Thresholds) Decide(score int) string {
+	switch {
+	case score >= t.Decline:
+		return models.FraudDecline
+	case score >= t.Review:
+		return models.FraudReview
+	default:
+		return models.FraudApprove
+	}
+}
+
+// Screener runs the registered scorers over orders
+type Screener struct {
+	scorers    map[string]Scorer
+	thresholds Thresholds
+}
+
+// NewScreener creates a screener without scorers
+func NewScreener(thresholds Thresholds) *Screener {
+	return &Screener{scorers: make(map[string]Scorer), thresholds: thresholds}
+}
+
+// Register sets the scorer of a name
+func (s *Screener) Register(name string, scorer Scorer) {
+	s.scorers[name] = scorer
+}
+
+// Scorers lists the registered scorers in name order
+func (s *Screener) Scorers() []string {
+	names := make([]string, 0, len(s.scorers))
+	for name := range s.scorers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Screen scores an order with every scorer and sets its score, signals and
+// decision. A scorer that fails, such as an unreachable provider, adds a
+// signal without points, so that the failure shows in the review but does
+// not block checkout.
+func (s *Screener) Screen(ctx context.Context, check *models.FraudCheck) {
+	check.Score = 0
+	check.Signals = []models.FraudSignal{}
+	for _, name := range s.Scorers() {
+		signals, err := s.scorers[name].Score(ctx, check)
+		if err != nil {
+			check.Signals = append(check.Signals, models.FraudSignal{
+				Rule:   name,
+				Reason: fmt.Sprintf("scorer failed: %v", err),
+			})
+			continue
+		}
+		for _, signal := range signals {
+			check.Score += signal.Points
+			check.Signals = append(check.Signals, signal)
+		}
+	}
+	check.Decision = s.thresholds.Decide(check.Score)
+}
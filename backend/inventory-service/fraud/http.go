@@ -0,0 +1,109 @@
+package fraud
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// HTTPScorer asks an external fraud provider to score orders. The order is
+// POSTed as JSON:
+//
+//	{"order_reference": "...", "user_id": "...", "email": "...", "ip_address": "...",
+//	 "billing_country": "US", "shipping_country": "US", "amount": 19.99, "currency": "USD"}
+//
+// and the provider answers with the points it gives the order and why:
+//
+//	{"score": 30, "reasons": ["..."]}
+//
+// Provider APIs with another format sit behind a small translating proxy or
+// get a Scorer of their own.
+type HTTPScorer struct {
+	// Name is the rule of the signals of the provider
+	Name string
+	URL  string
+	// APIKey is sent as a bearer token when set
+	APIKey string
+	Client *http.Client
+}
+
+// NewHTTPScorer creates a scorer for the provider at the given URL. Checkout
+// waits for the provider, so requests time out quickly.
+func NewHTTPScorer(name, url, apiKey string) *HTTPScorer {
+	return &HTTPScorer{
+		Name:   name,
+		URL:    url,
+		APIKey: apiKey,
+		Client: &http.Client{Timeout: 3 * time.Second},
+	}
+}
+
+type httpScoreRequest struct {
+	OrderReference  string  `json:"order_reference"`
+	UserID          string  `json:"user_id"`
+	Email           string  `json:"email"`
+	IPAddress       string  `json:"ip_address"`
+	BillingCountry  string  `json:"billing_country"`
+	ShippingCountry string  `json:"shipping_country"`
+	Amount          float64 `json:"amount"`
+	Currency        string  `json:"currency"`
+}
+
+type httpScoreResponse struct {
+	Score   int      `json:"score"`
+	Reasons []string `json:"reasons"`
+}
+
+// Score sends the order to the provider
+func (s *HTTPScorer) Score(ctx context.Context, check *models.FraudCheck) ([]models.FraudSignal, error) {
+	body, err := json.Marshal(httpScoreRequest{
+		OrderReference:  check.OrderReference,
+		UserID:          check.UserID,
+		Email:           check.Email,
+		IPAddress:       check.IPAddress,
+		BillingCountry:  check.BillingCountry,
+		ShippingCountry: check.ShippingCountry,
+		Amount:          check.Amount,
+		Currency:        check.Currency,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode score request: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create score request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if s.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.APIKey)
+	}
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach fraud provider: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fraud provider returned status %d", resp.StatusCode)
+	}
+
+	var result httpScoreResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode fraud score: %w", err)
+	}
+	if result.Score <= 0 {
+		return nil, nil
+	}
+	reason := "scored by provider"
+	if len(result.Reasons) > 0 {
+		reason = strings.Join(result.Reasons, "; ")
+	}
+	return []models.FraudSignal{{Rule: s.Name, Points: result.Score, Reason: reason}}, nil
+}
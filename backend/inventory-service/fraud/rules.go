@@ -0,0 +1,126 @@
+package fraud
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// Rule names of the built-in scorers
+const (
+	RuleVelocity        = "velocity"
+	RuleCountryMismatch = "country_mismatch"
+	RuleDisposableEmail = "disposable_email"
+)
+
+// VelocityCounter counts the orders screened since a time by email address
+// and by IP address
+type VelocityCounter interface {
+	CountRecentFraudChecks(ctx context.Context, email, ipAddress string, since time.Time) (byEmail, byIP int, err error)
+}
+
+// Velocity flags customers placing many orders in a short time, by email
+// address or by IP address
+type Velocity struct {
+	Counter VelocityCounter
+	Window  time.Duration
+	// MaxOrders is the number of orders allowed within the window before
+	// the order screened
+	MaxOrders int
+	Points    int
+}
+
+// Score counts the orders of the customer within the window
+func (v *Velocity) Score(ctx context.Context, check *models.FraudCheck) ([]models.FraudSignal, error) {
+	if check.Email == "" && check.IPAddress == "" {
+		return nil, nil
+	}
+	byEmail, byIP, err := v.Counter.CountRecentFraudChecks(ctx, check.Email, check.IPAddress, time.Now().Add(-v.Window))
+	if err != nil {
+		return nil, err
+	}
+
+	var signals []models.FraudSignal
+	if byEmail >= v.MaxOrders {
+		signals = append(signals, models.FraudSignal{
+			Rule:   RuleVelocity,
+			Points: v.Points,
+			Reason: fmt.Sprintf("%d orders from this email address in the last %s", byEmail, v.Window),
+		})
+	} else if byIP >= v.MaxOrders {
+		signals = append(signals, models.FraudSignal{
+			Rule:   RuleVelocity,
+			Points: v.Points,
+			Reason: fmt.Sprintf("%d orders from this IP address in the last %s", byIP, v.Window),
+		})
+	}
+	return signals, nil
+}
+
+// CountryMismatch flags orders shipped to another country than the one of
+// their billing address
+type CountryMismatch struct {
+	Points int
+}
+
+// Score compares the billing and shipping countries
+func (m *CountryMismatch) Score(_ context.Context, check *models.FraudCheck) ([]models.FraudSignal, error) {
+	if check.BillingCountry == "" || check.ShippingCountry == "" || check.BillingCountry == check.ShippingCountry {
+		return nil, nil
+	}
+	return []models.FraudSignal{{
+		Rule:   RuleCountryMismatch,
+		Points: m.Points,
+		Reason: fmt.Sprintf("billed in %s but shipped to %s", check.BillingCountry, check.ShippingCountry),
+	}}, nil
+}
+
+// defaultDisposableDomains are well known disposable email providers
+var defaultDisposableDomains = []string{
+	"10minutemail.com", "discard.email", "dispostable.com", "getnada.com", "guerrillamail.com",
+	"maildrop.cc", "mailinator.com", "mailnesia.com", "mintemail.com", "mohmal.com",
+	"sharklasers.com", "temp-mail.org", "tempmail.com", "throwawaymail.com", "trashmail.com",
+	"yopmail.com",
+}
+
+// DisposableEmail flags email addresses of disposable email providers
+type DisposableEmail struct {
+	domains map[string]bool
+	points  int
+}
+
+// NewDisposableEmail creates a rule flagging the well known disposable
+// email providers and the extra domains given
+func NewDisposableEmail(extraDomains []string, points int) *DisposableEmail {
+	domains := make(map[string]bool, len(defaultDisposableDomains)+len(extraDomains))
+	for _, domain := range append(defaultDisposableDomains, extraDomains...) {
+		domains[strings.ToLower(strings.TrimSpace(domain))] = true
+	}
+	return &DisposableEmail{domains: domains, points: points}
+}
+
+// Score checks the domain of the email address, and its parent domains
+func (d *DisposableEmail) Score(_ context.Context, check *models.FraudCheck) ([]models.FraudSignal, error) {
+	_, domain, ok := strings.Cut(check.Email, "@")
+	if !ok {
+		return nil, nil
+	}
+	for domain = strings.ToLower(domain); domain != ""; {
+		if d.domains[domain] {
+			return []models.FraudSignal{{
+				Rule:   RuleDisposableEmail,
+				Points: d.points,
+				Reason: fmt.Sprintf("%s is a disposable email provider", domain),
+			}}, nil
+		}
+		_, parent, ok := strings.Cut(domain, ".")
+		if !ok {
+			break
+		}
+		domain = parent
+	}
+	return nil, nil
+}
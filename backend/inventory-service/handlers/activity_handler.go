@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// ListInventoryActivity lists the recent stock movements of the store for the
+// admin activity feed
+func (h *InventoryHandler) ListInventoryActivity(ctx context.Context, req *pb.ListInventoryActivityRequest) (*pb.ListInventoryActivityResponse, error) {
+	var before time.Time
+	if req.BeforeTime != nil {
+		before = req.BeforeTime.AsTime()
+	}
+
+	entries, err := h.inventoryService.ListInventoryActivity(ctx, before, req.BeforeId, int(req.Limit))
+	if err != nil {
+		h.logger.Error("Failed to list inventory activity", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	resp := &pb.ListInventoryActivityResponse{Entries: make([]*pb.InventoryActivity, len(entries))}
+	for i, entry := range entries {
+		activity := &pb.InventoryActivity{
+			Id:              entry.ID,
+			InventoryItemId: entry.InventoryItemID,
+			ProductId:       entry.ProductID,
+			Sku:             entry.SKU,
+			TransactionType: entry.TransactionType,
+			Quantity:        int32(entry.Quantity),
+			CreatedAt:       timestamppb.New(entry.CreatedAt),
+		}
+		if entry.WarehouseID != nil {
+			activity.WarehouseId = *entry.WarehouseID
+		}
+		if entry.ReferenceType != nil {
+			activity.ReferenceType = *entry.ReferenceType
+		}
+		if entry.Notes != nil {
+			activity.Notes = *entry.Notes
+		}
+		if entry.CreatedBy != nil {
+			activity.CreatedBy = *entry.CreatedBy
+		}
+		if entry.UnitCode != nil && entry.UnitQuantity != nil {
+			activity.UnitCode = *entry.UnitCode
+			activity.UnitQuantity = int32(*entry.UnitQuantity)
+		}
+		resp.Entries[i] = activity
+	}
+	return resp, nil
+}
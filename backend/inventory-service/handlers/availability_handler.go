@@ -0,0 +1,150 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// maxBulkAvailabilityLines limits how many lines a single bulk check may contain
+const maxBulkAvailabilityLines = 200
+
+// CheckAvailabilityBulk checks the availability of all cart or checkout lines in one round trip
+func (h *InventoryHandler) CheckAvailabilityBulk(ctx context.Context, req *pb.CheckAvailabilityBulkRequest) (*pb.CheckAvailabilityBulkResponse, error) {
+	h.logger.Info("CheckAvailabilityBulk request received", zap.Int("lines_count", len(req.Lines)))
+
+	if len(req.Lines) > maxBulkAvailabilityLines {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d lines can be checked at once", maxBulkAvailabilityLines)
+	}
+
+	lines := make([]models.BulkAvailabilityLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		var warehouseID *string
+		if line.WarehouseId != nil && line.WarehouseId.Value != "" {
+			warehouseID = &line.WarehouseId.Value
+		}
+
+		lines = append(lines, models.BulkAvailabilityLine{
+			SKU:         line.Sku,
+			Quantity:    int(line.Quantity),
+			WarehouseID: warehouseID,
+		})
+	}
+
+	results, allAvailable, err := h.inventoryService.CheckAvailabilityBulk(ctx, lines)
+	if err != nil {
+		h.logger.Error("Failed to check bulk availability", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbLines := make([]*pb.BulkAvailabilityResult, 0, len(results))
+	for i := range results {
+		pbLines = append(pbLines, mapBulkAvailabilityResultToProto(&results[i]))
+	}
+
+	return &pb.CheckAvailabilityBulkResponse{
+		Lines:        pbLines,
+		AllAvailable: allAvailable,
+	}, nil
+}
+
+// mapBulkAvailabilityResultToProto converts a domain availability result to a protobuf message
+func mapBulkAvailabilityResultToProto(result *models.BulkAvailabilityResult) *pb.BulkAvailabilityResult {
+	var alternatives []*pb.AvailabilityAlternative
+	for _, alternative := range result.Alternatives {
+		alternatives = append(alternatives, &pb.AvailabilityAlternative{
+			Type:              alternative.Type,
+			WarehouseId:       stringValue(alternative.WarehouseID),
+			WarehouseName:     alternative.WarehouseName,
+			Quantity:          int32(alternative.Quantity),
+			AvailableQuantity: int32(alternative.AvailableQuantity),
+		})
+	}
+
+	return &pb.BulkAvailabilityResult{
+		LineIndex:         int32(result.LineIndex),
+		Sku:               result.SKU,
+		ProductId:         result.ProductID,
+		VariantId:         stringValue(result.VariantID),
+		WarehouseId:       stringValue(result.WarehouseID),
+		RequestedQuantity: int32(result.RequestedQuantity),
+		AvailableQuantity: int32(result.AvailableQuantity),
+		IsAvailable:       result.IsAvailable,
+		Status:            result.Status,
+		Alternatives:      alternatives,
+		Availability:      result.Availability,
+	}
+}
+
+func stringValue(s *string) *wrappers.StringValue {
+	if s == nil {
+		return nil
+	}
+	return &wrappers.StringValue{Value: *s}
+}
+
+// GetAvailabilityPolicy returns the availability thresholds of a product
+func (h *InventoryHandler) GetAvailabilityPolicy(ctx context.Context, req *pb.GetAvailabilityPolicyRequest) (*pb.AvailabilityPolicy, error) {
+	policy, err := h.inventoryService.GetAvailabilityPolicy(ctx, req.ProductId)
+	if err != nil {
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapAvailabilityPolicyToProto(policy), nil
+}
+
+// SetAvailabilityPolicy creates or replaces the availability thresholds of a product
+func (h *InventoryHandler) SetAvailabilityPolicy(ctx context.Context, req *pb.SetAvailabilityPolicyRequest) (*pb.AvailabilityPolicy, error) {
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+	h.logger.Info("SetAvailabilityPolicy request received", zap.String("product_id", req.Policy.ProductId))
+
+	policy := &models.AvailabilityPolicy{
+		ProductID:           req.Policy.ProductId,
+		OutOfStockThreshold: int(req.Policy.OutOfStockThreshold),
+		AllowBackorder:      req.Policy.AllowBackorder,
+	}
+	if req.Policy.LowStockThreshold != nil {
+		threshold := int(req.Policy.LowStockThreshold.Value)
+		policy.LowStockThreshold = &threshold
+	}
+
+	policy, err := h.inventoryService.SetAvailabilityPolicy(ctx, policy)
+	if err != nil {
+		h.logger.Error("Failed to set availability policy", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapAvailabilityPolicyToProto(policy), nil
+}
+
+// DeleteAvailabilityPolicy puts a product back on the default availability thresholds
+func (h *InventoryHandler) DeleteAvailabilityPolicy(ctx context.Context, req *pb.DeleteAvailabilityPolicyRequest) (*pb.DeleteAvailabilityPolicyResponse, error) {
+	h.logger.Info("DeleteAvailabilityPolicy request received", zap.String("product_id", req.ProductId))
+
+	if err := h.inventoryService.DeleteAvailabilityPolicy(ctx, req.ProductId); err != nil {
+		return nil, apperrors.ToGRPC(err)
+	}
+	return &pb.DeleteAvailabilityPolicyResponse{Success: true}, nil
+}
+
+func mapAvailabilityPolicyToProto(policy *models.AvailabilityPolicy) *pb.AvailabilityPolicy {
+	result := &pb.AvailabilityPolicy{
+		ProductId:           policy.ProductID,
+		OutOfStockThreshold: int32(policy.OutOfStockThreshold),
+		AllowBackorder:      policy.AllowBackorder,
+	}
+	if policy.LowStockThreshold != nil {
+		result.LowStockThreshold = &wrappers.Int32Value{Value: int32(*policy.LowStockThreshold)}
+	}
+	if !policy.UpdatedAt.IsZero() {
+		result.UpdatedAt = timeToProto(policy.UpdatedAt)
+	}
+	return result
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// SubscribeBackInStock subscribes an email to an out-of-stock product
+func (h *InventoryHandler) SubscribeBackInStock(ctx context.Context, req *pb.SubscribeBackInStockRequest) (*pb.BackInStockSubscription, error) {
+	sub, err := h.backInStockService.Subscribe(ctx, &models.BackInStockSubscription{
+		ProductID: req.ProductId,
+		VariantID: req.VariantId,
+		Email:     req.Email,
+		UserID:    req.UserId,
+	})
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to subscribe back in stock", zap.Error(err), zap.String("product_id", req.ProductId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapBackInStockSubscriptionToProto(sub), nil
+}
+
+// ListBackInStockSubscriptions lists the subscriptions of a user or to a
+// product
+func (h *InventoryHandler) ListBackInStockSubscriptions(ctx context.Context, req *pb.ListBackInStockSubscriptionsRequest) (*pb.ListBackInStockSubscriptionsResponse, error) {
+	filter := models.BackInStockFilter{
+		UserID:      req.UserId,
+		ProductID:   req.ProductId,
+		PendingOnly: req.PendingOnly,
+	}
+	subs, total, err := h.backInStockService.ListSubscriptions(ctx, filter, int(req.Page), int(req.Limit))
+	if err != nil {
+		h.logger.Error("Failed to list back-in-stock subscriptions", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbSubs := make([]*pb.BackInStockSubscription, 0, len(subs))
+	for i := range subs {
+		pbSubs = append(pbSubs, mapBackInStockSubscriptionToProto(&subs[i]))
+	}
+	return &pb.ListBackInStockSubscriptionsResponse{
+		Subscriptions: pbSubs,
+		Total:         int32(total),
+	}, nil
+}
+
+// DeleteBackInStockSubscription removes a subscription, only one of the
+// given user's when user_id is set
+func (h *InventoryHandler) DeleteBackInStockSubscription(ctx context.Context, req *pb.DeleteBackInStockSubscriptionRequest) (*pb.DeleteBackInStockSubscriptionResponse, error) {
+	if err := h.backInStockService.DeleteSubscription(ctx, req.Id, req.UserId); err != nil {
+		if apperrors.KindOf(err) != apperrors.ErrNotFound {
+			h.logger.Error("Failed to delete back-in-stock subscription", zap.Error(err), zap.String("id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return &pb.DeleteBackInStockSubscriptionResponse{Success: true}, nil
+}
+
+func mapBackInStockSubscriptionToProto(sub *models.BackInStockSubscription) *pb.BackInStockSubscription {
+	pbSub := &pb.BackInStockSubscription{
+		Id:        sub.ID,
+		ProductId: sub.ProductID,
+		VariantId: sub.VariantID,
+		Email:     sub.Email,
+		UserId:    sub.UserID,
+		CreatedAt: timeToProto(sub.CreatedAt),
+	}
+	if sub.NotifiedAt != nil {
+		pbSub.NotifiedAt = timeToProto(*sub.NotifiedAt)
+	}
+	return pbSub
+}
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// SetWarehouseBin creates the bin of a warehouse at an address, or sets
+// whether it is active
+func (h *InventoryHandler) SetWarehouseBin(ctx context.Context, req *pb.SetWarehouseBinRequest) (*pb.WarehouseBin, error) {
+	bin, err := h.binService.SaveBin(ctx, &models.WarehouseBin{
+		WarehouseID: req.WarehouseId,
+		Zone:        req.Zone,
+		Aisle:       req.Aisle,
+		Shelf:       req.Shelf,
+		Bin:         req.Bin,
+		IsActive:    req.IsActive,
+	})
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to save bin", zap.Error(err), zap.String("warehouse_id", req.WarehouseId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapBinToProto(bin), nil
+}
+
+// ListWarehouseBins lists the bins of a warehouse by address
+func (h *InventoryHandler) ListWarehouseBins(ctx context.Context, req *pb.ListWarehouseBinsRequest) (*pb.ListWarehouseBinsResponse, error) {
+	bins, err := h.binService.ListBins(ctx, req.WarehouseId, req.Zone)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to list bins", zap.Error(err), zap.String("warehouse_id", req.WarehouseId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbBins := make([]*pb.WarehouseBin, 0, len(bins))
+	for i := range bins {
+		pbBins = append(pbBins, mapBinToProto(&bins[i]))
+	}
+	return &pb.ListWarehouseBinsResponse{Bins: pbBins}, nil
+}
+
+// DeleteWarehouseBin removes a bin that holds no stock
+func (h *InventoryHandler) DeleteWarehouseBin(ctx context.Context, req *pb.DeleteWarehouseBinRequest) (*pb.DeleteWarehouseBinResponse, error) {
+	if err := h.binService.DeleteBin(ctx, req.Id); err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to delete bin", zap.Error(err), zap.String("bin_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return &pb.DeleteWarehouseBinResponse{}, nil
+}
+
+// SetBinStock sets the units of an item placed in a bin
+func (h *InventoryHandler) SetBinStock(ctx context.Context, req *pb.SetBinStockRequest) (*pb.BinStock, error) {
+	stock, err := h.binService.SetBinStock(ctx, req.BinId, req.InventoryItemId, int(req.Quantity), req.Unit)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to set bin stock", zap.Error(err),
+				zap.String("bin_id", req.BinId), zap.String("inventory_item_id", req.InventoryItemId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapBinStockToProto(stock), nil
+}
+
+// ListBinStock lists the stock placed in the bins of a warehouse
+func (h *InventoryHandler) ListBinStock(ctx context.Context, req *pb.ListBinStockRequest) (*pb.ListBinStockResponse, error) {
+	stock, err := h.binService.ListBinStock(ctx, req.WarehouseId, req.InventoryItemId)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to list bin stock", zap.Error(err), zap.String("warehouse_id", req.WarehouseId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbStock := make([]*pb.BinStock, 0, len(stock))
+	for i := range stock {
+		pbStock = append(pbStock, mapBinStockToProto(&stock[i]))
+	}
+	return &pb.ListBinStockResponse{Stock: pbStock}, nil
+}
+
+// GeneratePickList plans the picks of lines at a warehouse in path order
+func (h *InventoryHandler) GeneratePickList(ctx context.Context, req *pb.GeneratePickListRequest) (*pb.PickList, error) {
+	lines := make([]models.PickListLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		lines = append(lines, models.PickListLine{InventoryItemID: line.InventoryItemId, Quantity: int(line.Quantity)})
+	}
+
+	list, err := h.binService.GeneratePickList(ctx, req.WarehouseId, lines)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to generate pick list", zap.Error(err), zap.String("warehouse_id", req.WarehouseId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	return mapPickListToProto(list), nil
+}
+
+func mapPickListToProto(list *models.PickList) *pb.PickList {
+	pbList := &pb.PickList{
+		WarehouseId: list.WarehouseID,
+		Picks:       make([]*pb.Pick, 0, len(list.Picks)),
+		Shortages:   make([]*pb.PickListLine, 0, len(list.Shortages)),
+	}
+	for i := range list.Picks {
+		pick := &list.Picks[i]
+		pbList.Picks = append(pbList.Picks, &pb.Pick{
+			Sequence:        int32(pick.Sequence),
+			Bin:             mapBinToProto(&pick.Bin),
+			InventoryItemId: pick.InventoryItemID,
+			Sku:             pick.SKU,
+			Quantity:        int32(pick.Quantity),
+		})
+	}
+	for _, shortage := range list.Shortages {
+		pbList.Shortages = append(pbList.Shortages, &pb.PickListLine{
+			InventoryItemId: shortage.InventoryItemID,
+			Quantity:        int32(shortage.Quantity),
+		})
+	}
+	return pbList
+}
+
+func mapBinToProto(bin *models.WarehouseBin) *pb.WarehouseBin {
+	return &pb.WarehouseBin{
+		Id:          bin.ID,
+		WarehouseId: bin.WarehouseID,
+		Code:        bin.Code,
+		Zone:        bin.Zone,
+		Aisle:       bin.Aisle,
+		Shelf:       bin.Shelf,
+		Bin:         bin.Bin,
+		IsActive:    bin.IsActive,
+		CreatedAt:   timeToProto(bin.CreatedAt),
+		UpdatedAt:   timeToProto(bin.UpdatedAt),
+	}
+}
+
+func mapBinStockToProto(stock *models.BinStock) *pb.BinStock {
+	return &pb.BinStock{
+		Bin:             mapBinToProto(&stock.Bin),
+		InventoryItemId: stock.InventoryItemID,
+		Sku:             stock.SKU,
+		Quantity:        int32(stock.Quantity),
+		UpdatedAt:       timeToProto(stock.UpdatedAt),
+	}
+}
@@ -0,0 +1,67 @@
+package handlers
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	"github.com/louai60/e-commerce_project/backend/shared/diagnostics"
+)
+
+// GetDiagnostics returns runtime information about the database pools and caches
+func (h *InventoryHandler) GetDiagnostics(ctx context.Context, req *pb.GetDiagnosticsRequest) (*pb.DiagnosticsResponse, error) {
+	if h.diagnostics == nil {
+		return nil, status.Error(codes.Unavailable, "diagnostics not configured")
+	}
+
+	report := h.diagnostics.Collect(ctx)
+
+	resp := &pb.DiagnosticsResponse{
+		Service:       report.Service,
+		CollectedAt:   timestamppb.New(report.CollectedAt),
+		UptimeSeconds: report.Uptime.Seconds(),
+		Goroutines:    int32(report.Goroutines),
+	}
+
+	for _, pool := range report.DBPools {
+		resp.DbPools = append(resp.DbPools, convertDBPoolToProto(pool))
+	}
+	for _, c := range report.Caches {
+		resp.Caches = append(resp.Caches, &pb.CacheDiagnostics{
+			Name:                c.Name,
+			CircuitBreakerState: c.CircuitState.String(),
+			Healthy:             c.Healthy,
+			MemoryEntries:       int64(c.MemoryEntries),
+			Hits:                c.Hits,
+			Misses:              c.Misses,
+			Errors:              c.Errors,
+			HitRate:             c.HitRate,
+		})
+	}
+
+	return resp, nil
+}
+
+func convertDBPoolToProto(pool diagnostics.DBPoolStats) *pb.DBPoolDiagnostics {
+	proto := &pb.DBPoolDiagnostics{
+		Name:                pool.Name,
+		Replica:             pool.Replica,
+		MaxOpenConnections:  int32(pool.MaxOpenConnections),
+		OpenConnections:     int32(pool.OpenConnections),
+		InUse:               int32(pool.InUse),
+		Idle:                int32(pool.Idle),
+		WaitCount:           pool.WaitCount,
+		WaitDurationSeconds: pool.WaitDuration.Seconds(),
+		MaxIdleClosed:       pool.MaxIdleClosed,
+		MaxIdleTimeClosed:   pool.MaxIdleTimeClosed,
+		MaxLifetimeClosed:   pool.MaxLifetimeClosed,
+	}
+	if pool.ReplicaLag != nil {
+		proto.ReplicaLagKnown = true
+		proto.ReplicaLagSeconds = pool.ReplicaLag.Seconds()
+	}
+	return proto
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// GetForecast retrieves the forecast of an inventory item at each of its
+// warehouses, or at a single warehouse
+func (h *InventoryHandler) GetForecast(ctx context.Context, req *pb.GetForecastRequest) (*pb.GetForecastResponse, error) {
+	var id, productID, sku string
+
+	// Extract the identifier based on which field is set
+	switch req.Identifier.(type) {
+	case *pb.GetForecastRequest_Id:
+		id = req.GetId()
+	case *pb.GetForecastRequest_ProductId:
+		productID = req.GetProductId()
+	case *pb.GetForecastRequest_Sku:
+		sku = req.GetSku()
+	default:
+		return nil, status.Error(codes.InvalidArgument, "No identifier provided")
+	}
+
+	item, forecasts, err := h.forecastService.GetForecast(ctx, id, productID, sku, req.WarehouseId)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to get forecast", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbForecasts := make([]*pb.InventoryForecast, 0, len(forecasts))
+	for i := range forecasts {
+		pbForecasts = append(pbForecasts, mapForecastToProto(&forecasts[i]))
+	}
+	return &pb.GetForecastResponse{
+		InventoryItemId: item.ID,
+		Forecasts:       pbForecasts,
+	}, nil
+}
+
+// ListReorderSuggestions lists the items to reorder, those running out first
+// first
+func (h *InventoryHandler) ListReorderSuggestions(ctx context.Context, req *pb.ListReorderSuggestionsRequest) (*pb.ListReorderSuggestionsResponse, error) {
+	forecasts, total, err := h.forecastService.ListReorderSuggestions(ctx, req.WarehouseId, int(req.Page), int(req.Limit))
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to list reorder suggestions", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	suggestions := make([]*pb.InventoryForecast, 0, len(forecasts))
+	for i := range forecasts {
+		suggestions = append(suggestions, mapForecastToProto(&forecasts[i]))
+	}
+	return &pb.ListReorderSuggestionsResponse{
+		Suggestions: suggestions,
+		Total:       int32(total),
+	}, nil
+}
+
+func mapForecastToProto(forecast *models.InventoryForecast) *pb.InventoryForecast {
+	pbForecast := &pb.InventoryForecast{
+		InventoryItemId:   forecast.InventoryItemID,
+		ProductId:         forecast.ProductID,
+		Sku:               forecast.SKU,
+		WarehouseId:       forecast.WarehouseID,
+		ShortVelocity:     forecast.ShortVelocity,
+		LongVelocity:      forecast.LongVelocity,
+		DailyVelocity:     forecast.DailyVelocity,
+		AvailableQuantity: int32(forecast.AvailableQuantity),
+		OnOrderQuantity:   int32(forecast.OnOrderQuantity),
+		SafetyStock:       int32(forecast.SafetyStock),
+		LeadTimeDays:      int32(forecast.LeadTimeDays),
+		DaysOfCover:       forecast.DaysOfCover,
+		ReorderQuantity:   int32(forecast.ReorderQuantity),
+		ComputedAt:        timeToProto(forecast.ComputedAt),
+	}
+	if forecast.StockoutDate != nil {
+		pbForecast.StockoutDate = timeToProto(*forecast.StockoutDate)
+	}
+	return pbForecast
+}
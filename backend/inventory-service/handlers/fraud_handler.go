@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// ScreenOrder screens an order for fraud at checkout
+func (h *InventoryHandler) ScreenOrder(ctx context.Context, req *pb.ScreenOrderRequest) (*pb.FraudCheck, error) {
+	check, err := h.fraudService.ScreenOrder(ctx, &models.FraudCheck{
+		OrderReference:  req.OrderReference,
+		UserID:          req.UserId,
+		Email:           req.Email,
+		IPAddress:       req.IpAddress,
+		BillingCountry:  req.BillingCountry,
+		ShippingCountry: req.ShippingCountry,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+	})
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to screen order", zap.Error(err), zap.String("order_reference", req.OrderReference))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapFraudCheckToProto(check), nil
+}
+
+// GetFraudCheck retrieves the fraud check of an order
+func (h *InventoryHandler) GetFraudCheck(ctx context.Context, req *pb.GetFraudCheckRequest) (*pb.FraudCheck, error) {
+	check, err := h.fraudService.GetFraudCheck(ctx, req.Id)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to get fraud check", zap.Error(err), zap.String("fraud_check_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapFraudCheckToProto(check), nil
+}
+
+// ListFraudChecks lists the fraud checks, or the review queue
+func (h *InventoryHandler) ListFraudChecks(ctx context.Context, req *pb.ListFraudChecksRequest) (*pb.ListFraudChecksResponse, error) {
+	checks, total, err := h.fraudService.ListFraudChecks(ctx, models.FraudCheckFilter{
+		Decision:       req.Decision,
+		OrderReference: req.OrderReference,
+	}, int(req.Page), int(req.Limit))
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to list fraud checks", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbChecks := make([]*pb.FraudCheck, 0, len(checks))
+	for i := range checks {
+		pbChecks = append(pbChecks, mapFraudCheckToProto(&checks[i]))
+	}
+	return &pb.ListFraudChecksResponse{
+		Checks: pbChecks,
+		Total:  int32(total),
+	}, nil
+}
+
+// ReviewFraudCheck approves or declines an order in the review queue
+func (h *InventoryHandler) ReviewFraudCheck(ctx context.Context, req *pb.ReviewFraudCheckRequest) (*pb.FraudCheck, error) {
+	check, err := h.fraudService.ReviewFraudCheck(ctx, req.Id, req.Approve, req.ReviewedBy, req.Notes)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to review fraud check", zap.Error(err), zap.String("fraud_check_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapFraudCheckToProto(check), nil
+}
+
+// ListFraudEvents lists the fraud events after an ID, oldest first
+func (h *InventoryHandler) ListFraudEvents(ctx context.Context, req *pb.ListFraudEventsRequest) (*pb.ListFraudEventsResponse, error) {
+	events, err := h.fraudService.ListFraudEvents(ctx, req.AfterId, int(req.Limit))
+	if err != nil {
+		h.logger.Error("Failed to list fraud events", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbEvents := make([]*pb.FraudEvent, 0, len(events))
+	for _, event := range events {
+		pbEvents = append(pbEvents, &pb.FraudEvent{
+			Id:             event.ID,
+			FraudCheckId:   event.FraudCheckID,
+			OrderReference: event.OrderReference,
+			Decision:       event.Decision,
+			Score:          int32(event.Score),
+			ReviewedBy:     event.ReviewedBy,
+			CreatedAt:      timeToProto(event.CreatedAt),
+		})
+	}
+	return &pb.ListFraudEventsResponse{Events: pbEvents}, nil
+}
+
+func mapFraudCheckToProto(check *models.FraudCheck) *pb.FraudCheck {
+	pbCheck := &pb.FraudCheck{
+		Id:              check.ID,
+		OrderReference:  check.OrderReference,
+		UserId:          check.UserID,
+		Email:           check.Email,
+		IpAddress:       check.IPAddress,
+		BillingCountry:  check.BillingCountry,
+		ShippingCountry: check.ShippingCountry,
+		Amount:          check.Amount,
+		Currency:        check.Currency,
+		Score:           int32(check.Score),
+		Decision:        check.Decision,
+		ReviewedBy:      check.ReviewedBy,
+		ReviewNotes:     check.ReviewNotes,
+		CreatedAt:       timeToProto(check.CreatedAt),
+		UpdatedAt:       timeToProto(check.UpdatedAt),
+	}
+	if check.ReviewedAt != nil {
+		pbCheck.ReviewedAt = timeToProto(*check.ReviewedAt)
+	}
+	for _, signal := range check.Signals {
+		pbCheck.Signals = append(pbCheck.Signals, &pb.FraudSignal{
+			Rule:   signal.Rule,
+			Points: int32(signal.Points),
+			Reason: signal.Reason,
+		})
+	}
+	return pbCheck
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// CreateIntegrationKey creates an API key for a fulfillment provider
+func (h *InventoryHandler) CreateIntegrationKey(ctx context.Context, req *pb.CreateIntegrationKeyRequest) (*pb.CreateIntegrationKeyResponse, error) {
+	key, secret, err := h.fulfillmentService.CreateIntegrationKey(ctx, req.Provider, req.Name, int(req.DailyQuota))
+	if err != nil {
+		h.logger.Error("Failed to create integration key", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	return &pb.CreateIntegrationKeyResponse{
+		Key:    mapIntegrationKeyToProto(key),
+		Secret: secret,
+	}, nil
+}
+
+// ListIntegrationKeys lists the API keys of fulfillment providers
+func (h *InventoryHandler) ListIntegrationKeys(ctx context.Context, req *pb.ListIntegrationKeysRequest) (*pb.ListIntegrationKeysResponse, error) {
+	keys, err := h.fulfillmentService.ListIntegrationKeys(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list integration keys", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbKeys := make([]*pb.IntegrationKey, 0, len(keys))
+	for i := range keys {
+		pbKeys = append(pbKeys, mapIntegrationKeyToProto(&keys[i]))
+	}
+	return &pb.ListIntegrationKeysResponse{Keys: pbKeys}, nil
+}
+
+// RevokeIntegrationKey revokes the API key of a fulfillment provider
+func (h *InventoryHandler) RevokeIntegrationKey(ctx context.Context, req *pb.RevokeIntegrationKeyRequest) (*pb.IntegrationKeyResponse, error) {
+	key, err := h.fulfillmentService.RevokeIntegrationKey(ctx, req.Id)
+	if err != nil {
+		h.logger.Error("Failed to revoke integration key", zap.Error(err), zap.String("id", req.Id))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return &pb.IntegrationKeyResponse{Key: mapIntegrationKeyToProto(key)}, nil
+}
+
+// SetIntegrationKeyQuota changes the daily quota of an API key
+func (h *InventoryHandler) SetIntegrationKeyQuota(ctx context.Context, req *pb.SetIntegrationKeyQuotaRequest) (*pb.IntegrationKeyResponse, error) {
+	key, err := h.fulfillmentService.SetIntegrationKeyQuota(ctx, req.Id, int(req.DailyQuota))
+	if err != nil {
+		h.logger.Error("Failed to set integration key quota", zap.Error(err), zap.String("id", req.Id))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return &pb.IntegrationKeyResponse{Key: mapIntegrationKeyToProto(key)}, nil
+}
+
+// GetIntegrationQuota returns the usage of the day of an integrator's API key
+func (h *InventoryHandler) GetIntegrationQuota(ctx context.Context, req *pb.GetIntegrationQuotaRequest) (*pb.IntegrationQuota, error) {
+	key, err := h.fulfillmentService.GetQuota(ctx, req.ApiKey)
+	if err != nil {
+		h.logger.Warn("Rejected quota lookup", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapIntegrationQuotaToProto(key), nil
+}
+
+// PushFulfillmentEvents applies the stock updates and shipment confirmations
+// of a fulfillment provider
+func (h *InventoryHandler) PushFulfillmentEvents(ctx context.Context, req *pb.PushFulfillmentEventsRequest) (*pb.PushFulfillmentEventsResponse, error) {
+	events := make([]models.FulfillmentEvent, 0, len(req.Events))
+	for _, e := range req.Events {
+		event := models.FulfillmentEvent{
+			ID:             e.Id,
+			Type:           e.Type,
+			WarehouseCode:  e.WarehouseCode,
+			SKU:            e.Sku,
+			Quantity:       int(e.Quantity),
+			Mode:           e.Mode,
+			OrderReference: e.OrderReference,
+			Carrier:        e.Carrier,
+			TrackingNumber: e.TrackingNumber,
+		}
+		if e.OccurredAt != nil {
+			event.OccurredAt = time.Unix(e.OccurredAt.Seconds, int64(e.OccurredAt.Nanos)).UTC()
+		}
+		for _, line := range e.Lines {
+			event.Lines = append(event.Lines, models.FulfillmentLine{SKU: line.Sku, Quantity: int(line.Quantity)})
+		}
+		events = append(events, event)
+	}
+
+	key, results, err := h.fulfillmentService.PushEvents(ctx, req.ApiKey, events)
+	if err != nil {
+		h.logger.Warn("Rejected fulfillment events", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbResults := make([]*pb.FulfillmentEventResult, 0, len(results))
+	for _, result := range results {
+		pbResults = append(pbResults, &pb.FulfillmentEventResult{
+			Id:     result.ID,
+			Status: result.Status,
+			Error:  result.Error,
+		})
+	}
+	return &pb.PushFulfillmentEventsResponse{
+		Provider: key.Provider,
+		Results:  pbResults,
+		Quota:    mapIntegrationQuotaToProto(key),
+	}, nil
+}
+
+// ListOrderStatusEvents lists the order status changes reported by
+// fulfillment providers
+func (h *InventoryHandler) ListOrderStatusEvents(ctx context.Context, req *pb.ListOrderStatusEventsRequest) (*pb.ListOrderStatusEventsResponse, error) {
+	events, err := h.fulfillmentService.ListOrderStatusEvents(ctx, req.AfterId, int(req.Limit))
+	if err != nil {
+		h.logger.Error("Failed to list order status events", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbEvents := make([]*pb.OrderStatusEvent, 0, len(events))
+	for _, event := range events {
+		pbEvents = append(pbEvents, &pb.OrderStatusEvent{
+			Id:             event.ID,
+			OrderReference: event.OrderReference,
+			Status:         event.Status,
+			Provider:       event.Provider,
+			Carrier:        event.Carrier,
+			TrackingNumber: event.TrackingNumber,
+			OccurredAt:     timeToProto(event.OccurredAt),
+			CreatedAt:      timeToProto(event.CreatedAt),
+		})
+	}
+	return &pb.ListOrderStatusEventsResponse{Events: pbEvents}, nil
+}
+
+// mapIntegrationKeyToProto converts a domain integration key to a protobuf message
+func mapIntegrationKeyToProto(key *models.IntegrationKey) *pb.IntegrationKey {
+	pbKey := &pb.IntegrationKey{
+		Id:         key.ID,
+		Provider:   key.Provider,
+		Name:       key.Name,
+		KeyPrefix:  key.KeyPrefix,
+		CreatedAt:  timeToProto(key.CreatedAt),
+		DailyQuota: int32(key.DailyQuota),
+		UsedToday:  int32(key.UsedToday),
+	}
+	if key.LastUsedAt != nil {
+		pbKey.LastUsedAt = timeToProto(*key.LastUsedAt)
+	}
+	if key.RevokedAt != nil {
+		pbKey.RevokedAt = timeToProto(*key.RevokedAt)
+	}
+	return pbKey
+}
+
+func timeToProto(t time.Time) *timestamp.Timestamp {
+	return &timestamp.Timestamp{
+		Seconds: t.Unix(),
+		Nanos:   int32(t.Nanosecond()),
+	}
+}
+
+// mapIntegrationQuotaToProto converts the usage of the day of an integration
+// key to a protobuf message
+func mapIntegrationQuotaToProto(key *models.IntegrationKey) *pb.IntegrationQuota {
+	remaining, _ := key.QuotaRemaining()
+	return &pb.IntegrationQuota{
+		KeyId:      key.ID,
+		Provider:   key.Provider,
+		DailyQuota: int32(key.DailyQuota),
+		Used:       int32(key.UsedToday),
+		Remaining:  int32(remaining),
+		ResetsAt:   timeToProto(models.QuotaResetAt(time.Now())),
+	}
+}
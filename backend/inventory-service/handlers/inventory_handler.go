@@ -10,16 +10,31 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
 	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/service"
+	"github.com/louai60/e-commerce_project/backend/shared/diagnostics"
 )
 
 // InventoryHandler handles gRPC requests for inventory operations
 type InventoryHandler struct {
-	inventoryService *service.InventoryService
-	warehouseService *service.WarehouseService
-	logger           *zap.Logger
+	inventoryService    *service.InventoryService
+	warehouseService    *service.WarehouseService
+	fulfillmentService  *service.FulfillmentService
+	shipmentService     *service.ShipmentService
+	purchasingService   *service.PurchasingService
+	backInStockService  *service.BackInStockService
+	lotService          *service.LotService
+	binService          *service.BinService
+	waveService         *service.WaveService
+	refundService       *service.RefundService
+	fraudService        *service.FraudService
+	storeLocatorService *service.StoreLocatorService
+	pickupService       *service.PickupService
+	forecastService     *service.ForecastService
+	diagnostics         *diagnostics.Collector
+	logger              *zap.Logger
 	pb.UnimplementedInventoryServiceServer
 }
 
@@ -27,12 +42,38 @@ type InventoryHandler struct {
 func NewInventoryHandler(
 	inventoryService *service.InventoryService,
 	warehouseService *service.WarehouseService,
+	fulfillmentService *service.FulfillmentService,
+	shipmentService *service.ShipmentService,
+	purchasingService *service.PurchasingService,
+	backInStockService *service.BackInStockService,
+	lotService *service.LotService,
+	binService *service.BinService,
+	waveService *service.WaveService,
+	refundService *service.RefundService,
+	fraudService *service.FraudService,
+	storeLocatorService *service.StoreLocatorService,
+	pickupService *service.PickupService,
+	forecastService *service.ForecastService,
+	diagnostics *diagnostics.Collector,
 	logger *zap.Logger,
 ) *InventoryHandler {
 	return &InventoryHandler{
-		inventoryService: inventoryService,
-		warehouseService: warehouseService,
-		logger:           logger,
+		inventoryService:    inventoryService,
+		warehouseService:    warehouseService,
+		fulfillmentService:  fulfillmentService,
+		shipmentService:     shipmentService,
+		purchasingService:   purchasingService,
+		backInStockService:  backInStockService,
+		lotService:          lotService,
+		binService:          binService,
+		waveService:         waveService,
+		refundService:       refundService,
+		fraudService:        fraudService,
+		storeLocatorService: storeLocatorService,
+		pickupService:       pickupService,
+		forecastService:     forecastService,
+		diagnostics:         diagnostics,
+		logger:              logger,
 	}
 }
 
@@ -69,7 +110,7 @@ func (h *InventoryHandler) CreateInventoryItem(ctx context.Context, req *pb.Crea
 
 	if err != nil {
 		h.logger.Error("Failed to create inventory item", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -108,7 +149,7 @@ func (h *InventoryHandler) GetInventoryItem(ctx context.Context, req *pb.GetInve
 	item, err := h.inventoryService.GetInventoryItem(ctx, id, productID, sku)
 	if err != nil {
 		h.logger.Error("Failed to get inventory item", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -150,7 +191,7 @@ func (h *InventoryHandler) UpdateInventoryItem(ctx context.Context, req *pb.Upda
 	item, err := h.inventoryService.UpdateInventoryItem(ctx, req.Id, reorderPoint, reorderQty, statusValue)
 	if err != nil {
 		h.logger.Error("Failed to update inventory item", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -191,7 +232,7 @@ func (h *InventoryHandler) ListInventoryItems(ctx context.Context, req *pb.ListI
 	)
 	if err != nil {
 		h.logger.Error("Failed to list inventory items", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -260,6 +301,7 @@ func mapInventoryItemToProto(item *models.InventoryItem) (*pb.InventoryItem, err
 		CreatedAt:         createdAt,
 		UpdatedAt:         updatedAt,
 		Locations:         pbLocations,
+		Availability:      item.Availability,
 	}, nil
 }
 
@@ -295,6 +337,8 @@ func mapInventoryLocationToProto(location *models.InventoryLocation) (*pb.Invent
 		CreatedAt:         createdAt,
 		UpdatedAt:         updatedAt,
 		Warehouse:         pbWarehouse,
+		SafetyStock:       int32(location.SafetyStock),
+		MaxStock:          int32(location.MaxStock),
 	}, nil
 }
 
@@ -402,6 +446,8 @@ func mapProtoToInventoryLocation(pbLocation *pb.InventoryLocation) (*models.Inve
 		Quantity:          int(pbLocation.Quantity),
 		AvailableQuantity: int(pbLocation.AvailableQuantity),
 		ReservedQuantity:  int(pbLocation.ReservedQuantity),
+		SafetyStock:       int(pbLocation.SafetyStock),
+		MaxStock:          int(pbLocation.MaxStock),
 		CreatedAt:         createdAt,
 		UpdatedAt:         updatedAt,
 		Warehouse:         warehouse,
@@ -435,34 +481,6 @@ func mapProtoToWarehouse(pbWarehouse *pb.Warehouse) (*models.Warehouse, error) {
 	}, nil
 }
 
-// mapErrorToGRPCStatus maps domain errors to gRPC status errors
-func mapErrorToGRPCStatus(err error) error {
-	switch err {
-	case models.ErrNotFound:
-		return status.Error(codes.NotFound, err.Error())
-	case models.ErrAlreadyExists:
-		return status.Error(codes.AlreadyExists, err.Error())
-	case models.ErrInvalidInput:
-		return status.Error(codes.InvalidArgument, err.Error())
-	case models.ErrInsufficientInventory:
-		return status.Error(codes.FailedPrecondition, err.Error())
-	case models.ErrReservationExpired:
-		return status.Error(codes.FailedPrecondition, err.Error())
-	case models.ErrReservationNotFound:
-		return status.Error(codes.NotFound, err.Error())
-	case models.ErrReservationInvalidState:
-		return status.Error(codes.FailedPrecondition, err.Error())
-	case models.ErrWarehouseNotFound:
-		return status.Error(codes.NotFound, err.Error())
-	case models.ErrWarehouseInactive:
-		return status.Error(codes.FailedPrecondition, err.Error())
-	case models.ErrInvalidQuantity:
-		return status.Error(codes.InvalidArgument, err.Error())
-	default:
-		return status.Error(codes.Internal, "Internal server error")
-	}
-}
-
 // CreateWarehouse creates a new warehouse
 func (h *InventoryHandler) CreateWarehouse(ctx context.Context, req *pb.CreateWarehouseRequest) (*pb.WarehouseResponse, error) {
 	h.logger.Info("CreateWarehouse request received", zap.String("name", req.Name), zap.String("code", req.Code))
@@ -482,7 +500,7 @@ func (h *InventoryHandler) CreateWarehouse(ctx context.Context, req *pb.CreateWa
 
 	if err != nil {
 		h.logger.Error("Failed to create warehouse", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -518,7 +536,7 @@ func (h *InventoryHandler) GetWarehouse(ctx context.Context, req *pb.GetWarehous
 	warehouse, err := h.warehouseService.GetWarehouse(ctx, id, code)
 	if err != nil {
 		h.logger.Error("Failed to get warehouse", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -598,7 +616,7 @@ func (h *InventoryHandler) UpdateWarehouse(ctx context.Context, req *pb.UpdateWa
 
 	if err != nil {
 		h.logger.Error("Failed to update warehouse", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -635,7 +653,7 @@ func (h *InventoryHandler) ListWarehouses(ctx context.Context, req *pb.ListWareh
 	)
 	if err != nil {
 		h.logger.Error("Failed to list warehouses", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -660,7 +678,8 @@ func (h *InventoryHandler) AddInventoryToLocation(ctx context.Context, req *pb.A
 	h.logger.Info("AddInventoryToLocation request received",
 		zap.String("inventory_item_id", req.InventoryItemId),
 		zap.String("warehouse_id", req.WarehouseId),
-		zap.Int32("quantity", req.Quantity))
+		zap.Int32("quantity", req.Quantity),
+		zap.String("unit", req.Unit))
 
 	// Add inventory to location
 	location, err := h.inventoryService.AddInventoryToLocation(
@@ -668,14 +687,16 @@ func (h *InventoryHandler) AddInventoryToLocation(ctx context.Context, req *pb.A
 		req.InventoryItemId,
 		req.WarehouseId,
 		int(req.Quantity),
+		req.Unit,
 		req.ReferenceId,
 		req.ReferenceType,
 		req.Notes,
+		req.IdempotencyKey,
 	)
 
 	if err != nil {
 		h.logger.Error("Failed to add inventory to location", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -695,7 +716,8 @@ func (h *InventoryHandler) RemoveInventoryFromLocation(ctx context.Context, req
 	h.logger.Info("RemoveInventoryFromLocation request received",
 		zap.String("inventory_item_id", req.InventoryItemId),
 		zap.String("warehouse_id", req.WarehouseId),
-		zap.Int32("quantity", req.Quantity))
+		zap.Int32("quantity", req.Quantity),
+		zap.String("unit", req.Unit))
 
 	// Remove inventory from location
 	location, err := h.inventoryService.RemoveInventoryFromLocation(
@@ -703,14 +725,16 @@ func (h *InventoryHandler) RemoveInventoryFromLocation(ctx context.Context, req
 		req.InventoryItemId,
 		req.WarehouseId,
 		int(req.Quantity),
+		req.Unit,
 		req.ReferenceId,
 		req.ReferenceType,
 		req.Notes,
+		req.IdempotencyKey,
 	)
 
 	if err != nil {
 		h.logger.Error("Failed to remove inventory from location", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -742,7 +766,7 @@ func (h *InventoryHandler) GetInventoryByLocation(ctx context.Context, req *pb.G
 
 	if err != nil {
 		h.logger.Error("Failed to get inventory by location", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -780,6 +804,7 @@ func (h *InventoryHandler) ReserveInventory(ctx context.Context, req *pb.Reserve
 			InventoryItemID: item.InventoryItemId,
 			Quantity:        int(item.Quantity),
 			WarehouseID:     warehouseID,
+			Unit:            item.Unit,
 		})
 	}
 
@@ -794,7 +819,7 @@ func (h *InventoryHandler) ReserveInventory(ctx context.Context, req *pb.Reserve
 
 	if err != nil {
 		h.logger.Error("Failed to reserve inventory", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -819,7 +844,7 @@ func (h *InventoryHandler) ConfirmReservation(ctx context.Context, req *pb.Confi
 	reservation, err := h.inventoryService.ConfirmReservation(ctx, req.ReservationId)
 	if err != nil {
 		h.logger.Error("Failed to confirm reservation", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -844,7 +869,7 @@ func (h *InventoryHandler) CancelReservation(ctx context.Context, req *pb.Cancel
 	reservation, err := h.inventoryService.CancelReservation(ctx, req.ReservationId)
 	if err != nil {
 		h.logger.Error("Failed to cancel reservation", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -878,6 +903,7 @@ func (h *InventoryHandler) CheckInventoryAvailability(ctx context.Context, req *
 			VariantID: variantID,
 			SKU:       item.Sku,
 			Quantity:  int(item.Quantity),
+			Unit:      item.Unit,
 		})
 	}
 
@@ -885,7 +911,7 @@ func (h *InventoryHandler) CheckInventoryAvailability(ctx context.Context, req *
 	availabilityResults, allAvailable, err := h.inventoryService.CheckInventoryAvailability(ctx, items)
 	if err != nil {
 		h.logger.Error("Failed to check inventory availability", zap.Error(err))
-		return nil, mapErrorToGRPCStatus(err)
+		return nil, apperrors.ToGRPC(err)
 	}
 
 	// Convert to protobuf response
@@ -904,6 +930,10 @@ func (h *InventoryHandler) CheckInventoryAvailability(ctx context.Context, req *
 			AvailableQuantity: int32(result.AvailableQuantity),
 			IsAvailable:       result.IsAvailable,
 			Status:            result.Status,
+			Availability:      result.Availability,
+			Unit:              result.Unit,
+			UnitFactor:        int32(result.UnitFactor),
+			AvailableUnits:    int32(result.AvailableUnits),
 		})
 	}
 
@@ -949,9 +979,11 @@ func (h *InventoryHandler) BulkUpdateInventory(ctx context.Context, req *pb.Bulk
 				inventoryItem.ID,
 				item.WarehouseId,
 				int(item.QuantityDelta),
+				models.BaseUnit,
 				item.ReferenceId,
 				item.ReferenceType,
 				item.Notes,
+				item.IdempotencyKey,
 			)
 		} else if item.QuantityDelta < 0 {
 			// Removing inventory
@@ -960,9 +992,11 @@ func (h *InventoryHandler) BulkUpdateInventory(ctx context.Context, req *pb.Bulk
 				inventoryItem.ID,
 				item.WarehouseId,
 				int(-item.QuantityDelta), // Convert negative to positive
+				models.BaseUnit,
 				item.ReferenceId,
 				item.ReferenceType,
 				item.Notes,
+				item.IdempotencyKey,
 			)
 		} else {
 			// No change
@@ -1058,5 +1092,6 @@ func mapReservationToProto(reservation *models.InventoryReservation) (*pb.Invent
 		ReferenceType:   reservation.ReferenceType,
 		CreatedAt:       createdAt,
 		UpdatedAt:       updatedAt,
+		Lots:            mapLotAllocationsToProto(reservation.Lots),
 	}, nil
 }
@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// ReceiveLot receives units of an item into a lot at a warehouse
+func (h *InventoryHandler) ReceiveLot(ctx context.Context, req *pb.ReceiveLotRequest) (*pb.InventoryLot, error) {
+	lot := &models.InventoryLot{
+		InventoryItemID: req.InventoryItemId,
+		WarehouseID:     req.WarehouseId,
+		LotNumber:       req.LotNumber,
+		Quantity:        int(req.Quantity),
+	}
+	if req.ExpiresAt != nil {
+		expiresAt := req.ExpiresAt.AsTime()
+		lot.ExpiresAt = &expiresAt
+	}
+
+	lot, err := h.lotService.ReceiveLot(ctx, lot, req.Unit, req.Notes)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to receive lot", zap.Error(err), zap.String("inventory_item_id", req.InventoryItemId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapLotToProto(lot, time.Now()), nil
+}
+
+// ListLots lists the lots of an item first expired first out
+func (h *InventoryHandler) ListLots(ctx context.Context, req *pb.ListLotsRequest) (*pb.ListLotsResponse, error) {
+	lots, err := h.lotService.ListLots(ctx, req.InventoryItemId, req.WarehouseId, req.IncludeEmpty)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to list lots", zap.Error(err), zap.String("inventory_item_id", req.InventoryItemId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	now := time.Now()
+	pbLots := make([]*pb.InventoryLot, 0, len(lots))
+	for i := range lots {
+		pbLots = append(pbLots, mapLotToProto(&lots[i], now))
+	}
+	return &pb.ListLotsResponse{Lots: pbLots}, nil
+}
+
+// ListExpiringLots reports the lots expiring soon and the expired lots not
+// written off yet
+func (h *InventoryHandler) ListExpiringLots(ctx context.Context, req *pb.ListExpiringLotsRequest) (*pb.ListExpiringLotsResponse, error) {
+	within := time.Duration(req.WithinDays) * 24 * time.Hour
+	lots, total, err := h.lotService.ListExpiringLots(ctx, within, req.WarehouseId, int(req.Page), int(req.Limit))
+	if err != nil {
+		h.logger.Error("Failed to list expiring lots", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	now := time.Now()
+	pbLots := make([]*pb.InventoryLot, 0, len(lots))
+	for i := range lots {
+		pbLots = append(pbLots, mapLotToProto(&lots[i], now))
+	}
+	return &pb.ListExpiringLotsResponse{
+		Lots:  pbLots,
+		Total: int32(total),
+	}, nil
+}
+
+func mapLotToProto(lot *models.InventoryLot, now time.Time) *pb.InventoryLot {
+	pbLot := &pb.InventoryLot{
+		Id:                lot.ID,
+		InventoryItemId:   lot.InventoryItemID,
+		WarehouseId:       lot.WarehouseID,
+		LotNumber:         lot.LotNumber,
+		Quantity:          int32(lot.Quantity),
+		ReservedQuantity:  int32(lot.ReservedQuantity),
+		AvailableQuantity: int32(lot.AvailableQuantity()),
+		Expired:           lot.Expired(now),
+		ReceivedAt:        timeToProto(lot.ReceivedAt),
+		UpdatedAt:         timeToProto(lot.UpdatedAt),
+		ProductId:         lot.ProductID,
+		Sku:               lot.SKU,
+	}
+	if lot.ExpiresAt != nil {
+		pbLot.ExpiresAt = timeToProto(*lot.ExpiresAt)
+	}
+	if lot.WrittenOffAt != nil {
+		pbLot.WrittenOffAt = timeToProto(*lot.WrittenOffAt)
+	}
+	return pbLot
+}
+
+func mapLotAllocationsToProto(allocations []models.LotAllocation) []*pb.LotAllocation {
+	pbAllocations := make([]*pb.LotAllocation, 0, len(allocations))
+	for _, allocation := range allocations {
+		pbAllocation := &pb.LotAllocation{
+			LotId:     allocation.LotID,
+			LotNumber: allocation.LotNumber,
+			Quantity:  int32(allocation.Quantity),
+		}
+		if allocation.ExpiresAt != nil {
+			pbAllocation.ExpiresAt = timeToProto(*allocation.ExpiresAt)
+		}
+		pbAllocations = append(pbAllocations, pbAllocation)
+	}
+	return pbAllocations
+}
@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// CreatePickupOrder places a click-and-collect order, reserving its items at
+// the chosen store
+func (h *InventoryHandler) CreatePickupOrder(ctx context.Context, req *pb.CreatePickupOrderRequest) (*pb.PickupOrder, error) {
+	h.logger.Info("CreatePickupOrder request received",
+		zap.String("order_reference", req.OrderReference),
+		zap.String("warehouse_id", req.WarehouseId),
+		zap.Int("items", len(req.Items)))
+
+	items := make([]models.PickupItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, models.PickupItem{
+			ProductID: item.ProductId,
+			Quantity:  int(item.Quantity),
+			Unit:      item.Unit,
+
+			Personalization: mapPersonalizationFromProto(item.Personalization),
+		})
+	}
+
+	order, err := h.pickupService.CreatePickupOrder(ctx, &models.PickupOrder{
+		OrderReference: req.OrderReference,
+		WarehouseID:    req.WarehouseId,
+		UserID:         req.UserId,
+		Email:          req.Email,
+	}, items)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to create pickup order", zap.Error(err), zap.String("order_reference", req.OrderReference))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPickupOrderToProto(order), nil
+}
+
+// GetPickupOrder retrieves a pickup order with its lines
+func (h *InventoryHandler) GetPickupOrder(ctx context.Context, req *pb.GetPickupOrderRequest) (*pb.PickupOrder, error) {
+	order, err := h.pickupService.GetPickupOrder(ctx, req.Id, req.UserId)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to get pickup order", zap.Error(err), zap.String("pickup_order_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPickupOrderToProto(order), nil
+}
+
+// ListPickupOrders lists pickup orders, oldest first
+func (h *InventoryHandler) ListPickupOrders(ctx context.Context, req *pb.ListPickupOrdersRequest) (*pb.ListPickupOrdersResponse, error) {
+	orders, total, err := h.pickupService.ListPickupOrders(ctx, models.PickupOrderFilter{
+		WarehouseID:    req.WarehouseId,
+		UserID:         req.UserId,
+		Status:         req.Status,
+		OrderReference: req.OrderReference,
+	}, int(req.Page), int(req.Limit))
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to list pickup orders", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbOrders := make([]*pb.PickupOrder, 0, len(orders))
+	for i := range orders {
+		pbOrders = append(pbOrders, mapPickupOrderToProto(&orders[i]))
+	}
+	return &pb.ListPickupOrdersResponse{
+		Orders: pbOrders,
+		Total:  int32(total),
+	}, nil
+}
+
+// StartPickupPicking records that the store is picking a pending pickup order
+func (h *InventoryHandler) StartPickupPicking(ctx context.Context, req *pb.PickupOrderRequest) (*pb.PickupOrder, error) {
+	order, err := h.pickupService.StartPicking(ctx, req.Id)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to start picking pickup order", zap.Error(err), zap.String("pickup_order_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPickupOrderToProto(order), nil
+}
+
+// MarkPickupReady records that a pickup order waits at its store and emails
+// the customer
+func (h *InventoryHandler) MarkPickupReady(ctx context.Context, req *pb.PickupOrderRequest) (*pb.PickupOrder, error) {
+	order, err := h.pickupService.MarkReady(ctx, req.Id)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to mark pickup order ready", zap.Error(err), zap.String("pickup_order_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPickupOrderToProto(order), nil
+}
+
+// CollectPickupOrder records that the customer collected a ready pickup order
+func (h *InventoryHandler) CollectPickupOrder(ctx context.Context, req *pb.PickupOrderRequest) (*pb.PickupOrder, error) {
+	order, err := h.pickupService.CollectPickupOrder(ctx, req.Id)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to collect pickup order", zap.Error(err), zap.String("pickup_order_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPickupOrderToProto(order), nil
+}
+
+// CancelPickupOrder cancels a pickup order not yet collected and restocks it
+func (h *InventoryHandler) CancelPickupOrder(ctx context.Context, req *pb.CancelPickupOrderRequest) (*pb.PickupOrder, error) {
+	order, err := h.pickupService.CancelPickupOrder(ctx, req.Id, req.UserId, req.Reason)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to cancel pickup order", zap.Error(err), zap.String("pickup_order_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPickupOrderToProto(order), nil
+}
+
+func mapPickupOrderToProto(order *models.PickupOrder) *pb.PickupOrder {
+	pbOrder := &pb.PickupOrder{
+		Id:             order.ID,
+		OrderReference: order.OrderReference,
+		WarehouseId:    order.WarehouseID,
+		UserId:         order.UserID,
+		Email:          order.Email,
+		Status:         order.Status,
+		CancelReason:   order.CancelReason,
+		CreatedAt:      timeToProto(order.CreatedAt),
+		UpdatedAt:      timeToProto(order.UpdatedAt),
+	}
+	if order.CollectBy != nil {
+		pbOrder.CollectBy = timeToProto(*order.CollectBy)
+	}
+	if order.ReadyAt != nil {
+		pbOrder.ReadyAt = timeToProto(*order.ReadyAt)
+	}
+	if order.CollectedAt != nil {
+		pbOrder.CollectedAt = timeToProto(*order.CollectedAt)
+	}
+	if order.CancelledAt != nil {
+		pbOrder.CancelledAt = timeToProto(*order.CancelledAt)
+	}
+	for _, line := range order.Lines {
+		pbOrder.Lines = append(pbOrder.Lines, &pb.PickupLine{
+			Id:              line.ID,
+			InventoryItemId: line.InventoryItemID,
+			ProductId:       line.ProductID,
+			Sku:             line.SKU,
+			Quantity:        int32(line.Quantity),
+			ReservationId:   line.ReservationID,
+			Personalization: mapPersonalizationToProto(line.Personalization),
+		})
+	}
+	return pbOrder
+}
+
+func mapPersonalizationFromProto(values []*pb.PersonalizationValue) []models.PersonalizationValue {
+	if len(values) == 0 {
+		return nil
+	}
+	mapped := make([]models.PersonalizationValue, len(values))
+	for i, value := range values {
+		mapped[i] = models.PersonalizationValue{
+			OptionID: value.OptionId,
+			Name:     value.Name,
+			Text:     value.Text,
+			FileURL:  value.FileUrl,
+			FileName: value.FileName,
+		}
+	}
+	return mapped
+}
+
+func mapPersonalizationToProto(values []models.PersonalizationValue) []*pb.PersonalizationValue {
+	if len(values) == 0 {
+		return nil
+	}
+	mapped := make([]*pb.PersonalizationValue, len(values))
+	for i, value := range values {
+		mapped[i] = &pb.PersonalizationValue{
+			OptionId: value.OptionID,
+			Name:     value.Name,
+			Text:     value.Text,
+			FileUrl:  value.FileURL,
+			FileName: value.FileName,
+		}
+	}
+	return mapped
+}
@@ -0,0 +1,278 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// CreateSupplier creates a supplier
+func (h *InventoryHandler) CreateSupplier(ctx context.Context, req *pb.CreateSupplierRequest) (*pb.Supplier, error) {
+	supplier, err := h.purchasingService.CreateSupplier(ctx, &models.Supplier{
+		Name:     req.Name,
+		Email:    req.Email,
+		Phone:    req.Phone,
+		Currency: req.Currency,
+		Notes:    req.Notes,
+		IsActive: true,
+	})
+	if err != nil {
+		h.logger.Error("Failed to create supplier", zap.Error(err), zap.String("name", req.Name))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapSupplierToProto(supplier), nil
+}
+
+// UpdateSupplier updates a supplier
+func (h *InventoryHandler) UpdateSupplier(ctx context.Context, req *pb.UpdateSupplierRequest) (*pb.Supplier, error) {
+	supplier, err := h.purchasingService.UpdateSupplier(ctx, &models.Supplier{
+		ID:       req.Id,
+		Name:     req.Name,
+		Email:    req.Email,
+		Phone:    req.Phone,
+		Currency: req.Currency,
+		Notes:    req.Notes,
+		IsActive: req.IsActive,
+	})
+	if err != nil {
+		h.logger.Error("Failed to update supplier", zap.Error(err), zap.String("id", req.Id))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapSupplierToProto(supplier), nil
+}
+
+// GetSupplier retrieves a supplier with the inventory items it sells
+func (h *InventoryHandler) GetSupplier(ctx context.Context, req *pb.GetSupplierRequest) (*pb.Supplier, error) {
+	supplier, err := h.purchasingService.GetSupplier(ctx, req.Id)
+	if err != nil {
+		if apperrors.KindOf(err) != apperrors.ErrNotFound {
+			h.logger.Error("Failed to get supplier", zap.Error(err), zap.String("id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapSupplierToProto(supplier), nil
+}
+
+// ListSuppliers lists suppliers by name
+func (h *InventoryHandler) ListSuppliers(ctx context.Context, req *pb.ListSuppliersRequest) (*pb.ListSuppliersResponse, error) {
+	var isActive *bool
+	if req.IsActive != nil {
+		a := req.IsActive.Value
+		isActive = &a
+	}
+
+	suppliers, total, err := h.purchasingService.ListSuppliers(ctx, int(req.Page), int(req.Limit), isActive)
+	if err != nil {
+		h.logger.Error("Failed to list suppliers", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbSuppliers := make([]*pb.Supplier, 0, len(suppliers))
+	for i := range suppliers {
+		pbSuppliers = append(pbSuppliers, mapSupplierToProto(&suppliers[i]))
+	}
+	return &pb.ListSuppliersResponse{
+		Suppliers: pbSuppliers,
+		Total:     int32(total),
+	}, nil
+}
+
+// SetSupplierProduct links an inventory item to a supplier with its cost
+// price and lead time
+func (h *InventoryHandler) SetSupplierProduct(ctx context.Context, req *pb.SetSupplierProductRequest) (*pb.SupplierProduct, error) {
+	product, err := h.purchasingService.SetSupplierProduct(ctx, &models.SupplierProduct{
+		SupplierID:      req.SupplierId,
+		InventoryItemID: req.InventoryItemId,
+		SupplierSKU:     req.SupplierSku,
+		CostPrice:       req.CostPrice,
+		LeadTimeDays:    int(req.LeadTimeDays),
+	})
+	if err != nil {
+		h.logger.Error("Failed to set supplier product", zap.Error(err),
+			zap.String("supplier_id", req.SupplierId),
+			zap.String("inventory_item_id", req.InventoryItemId))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapSupplierProductToProto(product), nil
+}
+
+// RemoveSupplierProduct unlinks an inventory item from a supplier
+func (h *InventoryHandler) RemoveSupplierProduct(ctx context.Context, req *pb.RemoveSupplierProductRequest) (*pb.RemoveSupplierProductResponse, error) {
+	if err := h.purchasingService.RemoveSupplierProduct(ctx, req.SupplierId, req.InventoryItemId); err != nil {
+		if apperrors.KindOf(err) != apperrors.ErrNotFound {
+			h.logger.Error("Failed to remove supplier product", zap.Error(err),
+				zap.String("supplier_id", req.SupplierId),
+				zap.String("inventory_item_id", req.InventoryItemId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return &pb.RemoveSupplierProductResponse{Success: true}, nil
+}
+
+// CreatePurchaseOrder creates a purchase order of stock from a supplier
+func (h *InventoryHandler) CreatePurchaseOrder(ctx context.Context, req *pb.CreatePurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	order := &models.PurchaseOrder{
+		SupplierID:  req.SupplierId,
+		WarehouseID: req.WarehouseId,
+		Currency:    req.Currency,
+		Notes:       req.Notes,
+	}
+	if req.ExpectedAt != nil {
+		expectedAt := time.Unix(req.ExpectedAt.Seconds, int64(req.ExpectedAt.Nanos)).UTC()
+		order.ExpectedAt = &expectedAt
+	}
+	for _, line := range req.Lines {
+		order.Lines = append(order.Lines, models.PurchaseOrderLine{
+			InventoryItemID: line.InventoryItemId,
+			QuantityOrdered: int(line.Quantity),
+			UnitCost:        line.UnitCost,
+			UnitCode:        line.Unit,
+		})
+	}
+
+	order, err := h.purchasingService.CreatePurchaseOrder(ctx, order)
+	if err != nil {
+		h.logger.Error("Failed to create purchase order", zap.Error(err), zap.String("supplier_id", req.SupplierId))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPurchaseOrderToProto(order), nil
+}
+
+// GetPurchaseOrder retrieves a purchase order with its lines
+func (h *InventoryHandler) GetPurchaseOrder(ctx context.Context, req *pb.GetPurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	order, err := h.purchasingService.GetPurchaseOrder(ctx, req.Id)
+	if err != nil {
+		if apperrors.KindOf(err) != apperrors.ErrNotFound {
+			h.logger.Error("Failed to get purchase order", zap.Error(err), zap.String("id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPurchaseOrderToProto(order), nil
+}
+
+// ListPurchaseOrders lists purchase orders without their lines
+func (h *InventoryHandler) ListPurchaseOrders(ctx context.Context, req *pb.ListPurchaseOrdersRequest) (*pb.ListPurchaseOrdersResponse, error) {
+	filter := models.PurchaseOrderFilter{
+		SupplierID: req.SupplierId,
+		Status:     req.Status,
+	}
+	if req.ExpectedBefore != nil {
+		expectedBefore := time.Unix(req.ExpectedBefore.Seconds, int64(req.ExpectedBefore.Nanos)).UTC()
+		filter.ExpectedBefore = &expectedBefore
+	}
+
+	orders, total, err := h.purchasingService.ListPurchaseOrders(ctx, filter, int(req.Page), int(req.Limit))
+	if err != nil {
+		h.logger.Error("Failed to list purchase orders", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbOrders := make([]*pb.PurchaseOrder, 0, len(orders))
+	for i := range orders {
+		pbOrders = append(pbOrders, mapPurchaseOrderToProto(&orders[i]))
+	}
+	return &pb.ListPurchaseOrdersResponse{
+		PurchaseOrders: pbOrders,
+		Total:          int32(total),
+	}, nil
+}
+
+// ReceivePurchaseOrder records the receipt of stock for a purchase order and
+// adds it to the order's warehouse
+func (h *InventoryHandler) ReceivePurchaseOrder(ctx context.Context, req *pb.ReceivePurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	lines := make([]models.ReceiptLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		lines = append(lines, models.ReceiptLine{
+			InventoryItemID: line.InventoryItemId,
+			Quantity:        int(line.Quantity),
+		})
+	}
+
+	order, err := h.purchasingService.ReceivePurchaseOrder(ctx, req.Id, lines)
+	if err != nil {
+		h.logger.Error("Failed to receive purchase order", zap.Error(err), zap.String("id", req.Id))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPurchaseOrderToProto(order), nil
+}
+
+// CancelPurchaseOrder cancels an open purchase order
+func (h *InventoryHandler) CancelPurchaseOrder(ctx context.Context, req *pb.CancelPurchaseOrderRequest) (*pb.PurchaseOrder, error) {
+	order, err := h.purchasingService.CancelPurchaseOrder(ctx, req.Id)
+	if err != nil {
+		h.logger.Error("Failed to cancel purchase order", zap.Error(err), zap.String("id", req.Id))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPurchaseOrderToProto(order), nil
+}
+
+// mapSupplierToProto converts a domain supplier to a protobuf message
+func mapSupplierToProto(supplier *models.Supplier) *pb.Supplier {
+	pbSupplier := &pb.Supplier{
+		Id:        supplier.ID,
+		Name:      supplier.Name,
+		Email:     supplier.Email,
+		Phone:     supplier.Phone,
+		Currency:  supplier.Currency,
+		Notes:     supplier.Notes,
+		IsActive:  supplier.IsActive,
+		CreatedAt: timeToProto(supplier.CreatedAt),
+		UpdatedAt: timeToProto(supplier.UpdatedAt),
+	}
+	for i := range supplier.Products {
+		pbSupplier.Products = append(pbSupplier.Products, mapSupplierProductToProto(&supplier.Products[i]))
+	}
+	return pbSupplier
+}
+
+// mapSupplierProductToProto converts a domain supplier product to a protobuf
+// message
+func mapSupplierProductToProto(product *models.SupplierProduct) *pb.SupplierProduct {
+	return &pb.SupplierProduct{
+		SupplierId:      product.SupplierID,
+		InventoryItemId: product.InventoryItemID,
+		Sku:             product.SKU,
+		SupplierSku:     product.SupplierSKU,
+		CostPrice:       product.CostPrice,
+		LeadTimeDays:    int32(product.LeadTimeDays),
+		UpdatedAt:       timeToProto(product.UpdatedAt),
+	}
+}
+
+// mapPurchaseOrderToProto converts a domain purchase order to a protobuf
+// message
+func mapPurchaseOrderToProto(order *models.PurchaseOrder) *pb.PurchaseOrder {
+	pbOrder := &pb.PurchaseOrder{
+		Id:          order.ID,
+		SupplierId:  order.SupplierID,
+		WarehouseId: order.WarehouseID,
+		Status:      order.Status,
+		Currency:    order.Currency,
+		Notes:       order.Notes,
+		CreatedAt:   timeToProto(order.CreatedAt),
+		UpdatedAt:   timeToProto(order.UpdatedAt),
+	}
+	if order.ExpectedAt != nil {
+		pbOrder.ExpectedAt = timeToProto(*order.ExpectedAt)
+	}
+	if order.ReceivedAt != nil {
+		pbOrder.ReceivedAt = timeToProto(*order.ReceivedAt)
+	}
+	for _, line := range order.Lines {
+		pbOrder.Lines = append(pbOrder.Lines, &pb.PurchaseOrderLine{
+			Id:               line.ID,
+			InventoryItemId:  line.InventoryItemID,
+			Sku:              line.SKU,
+			QuantityOrdered:  int32(line.QuantityOrdered),
+			QuantityReceived: int32(line.QuantityReceived),
+			UnitCost:         line.UnitCost,
+			Unit:             line.UnitCode,
+		})
+	}
+	return pbOrder
+}
@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// RequestRefund records a requested refund of the payment of an order
+func (h *InventoryHandler) RequestRefund(ctx context.Context, req *pb.RequestRefundRequest) (*pb.Refund, error) {
+	refund := &models.Refund{
+		OrderReference:   req.OrderReference,
+		PaymentReference: req.PaymentReference,
+		UserID:           req.UserId,
+		Type:             req.Type,
+		Amount:           req.Amount,
+		Currency:         req.Currency,
+		Reason:           req.Reason,
+		RequestedBy:      req.RequestedBy,
+	}
+	for _, line := range req.Lines {
+		refundLine := models.RefundLine{
+			InventoryItemID: line.InventoryItemId,
+			Quantity:        int(line.Quantity),
+			Restock:         line.Restock,
+		}
+		if line.WarehouseId != "" {
+			warehouseID := line.WarehouseId
+			refundLine.WarehouseID = &warehouseID
+		}
+		refund.Lines = append(refund.Lines, refundLine)
+	}
+
+	refund, err := h.refundService.RequestRefund(ctx, refund)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to request refund", zap.Error(err), zap.String("order_reference", req.OrderReference))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapRefundToProto(refund), nil
+}
+
+// GetRefund retrieves a refund with its lines
+func (h *InventoryHandler) GetRefund(ctx context.Context, req *pb.GetRefundRequest) (*pb.Refund, error) {
+	refund, err := h.refundService.GetRefund(ctx, req.Id)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to get refund", zap.Error(err), zap.String("refund_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapRefundToProto(refund), nil
+}
+
+// ListRefunds lists the refunds newest first
+func (h *InventoryHandler) ListRefunds(ctx context.Context, req *pb.ListRefundsRequest) (*pb.ListRefundsResponse, error) {
+	refunds, total, err := h.refundService.ListRefunds(ctx, models.RefundFilter{
+		Status:         req.Status,
+		OrderReference: req.OrderReference,
+	}, int(req.Page), int(req.Limit))
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to list refunds", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbRefunds := make([]*pb.Refund, 0, len(refunds))
+	for i := range refunds {
+		pbRefunds = append(pbRefunds, mapRefundToProto(&refunds[i]))
+	}
+	return &pb.ListRefundsResponse{
+		Refunds: pbRefunds,
+		Total:   int32(total),
+	}, nil
+}
+
+// ApproveRefund approves a requested refund and restocks its returned lines
+func (h *InventoryHandler) ApproveRefund(ctx context.Context, req *pb.ApproveRefundRequest) (*pb.Refund, error) {
+	refund, err := h.refundService.ApproveRefund(ctx, req.Id, req.ReviewedBy)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to approve refund", zap.Error(err), zap.String("refund_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapRefundToProto(refund), nil
+}
+
+// RejectRefund rejects a requested refund
+func (h *InventoryHandler) RejectRefund(ctx context.Context, req *pb.RejectRefundRequest) (*pb.Refund, error) {
+	refund, err := h.refundService.RejectRefund(ctx, req.Id, req.ReviewedBy, req.Reason)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to reject refund", zap.Error(err), zap.String("refund_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapRefundToProto(refund), nil
+}
+
+// RecordRefundResult records the outcome of an approved refund reported by
+// the payment system
+func (h *InventoryHandler) RecordRefundResult(ctx context.Context, req *pb.RecordRefundResultRequest) (*pb.Refund, error) {
+	refund, err := h.refundService.RecordRefundResult(ctx, req.Id, req.Succeeded, req.ProviderReference, req.FailureReason)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to record refund result", zap.Error(err), zap.String("refund_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapRefundToProto(refund), nil
+}
+
+// ListRefundEvents lists the refund events after an ID, oldest first
+func (h *InventoryHandler) ListRefundEvents(ctx context.Context, req *pb.ListRefundEventsRequest) (*pb.ListRefundEventsResponse, error) {
+	events, err := h.refundService.ListRefundEvents(ctx, req.AfterId, int(req.Limit))
+	if err != nil {
+		h.logger.Error("Failed to list refund events", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbEvents := make([]*pb.RefundEvent, 0, len(events))
+	for _, event := range events {
+		pbEvents = append(pbEvents, &pb.RefundEvent{
+			Id:               event.ID,
+			RefundId:         event.RefundID,
+			Status:           event.Status,
+			OrderReference:   event.OrderReference,
+			PaymentReference: event.PaymentReference,
+			UserId:           event.UserID,
+			Amount:           event.Amount,
+			Currency:         event.Currency,
+			CreatedAt:        timeToProto(event.CreatedAt),
+		})
+	}
+	return &pb.ListRefundEventsResponse{Events: pbEvents}, nil
+}
+
+func mapRefundToProto(refund *models.Refund) *pb.Refund {
+	pbRefund := &pb.Refund{
+		Id:                refund.ID,
+		OrderReference:    refund.OrderReference,
+		PaymentReference:  refund.PaymentReference,
+		UserId:            refund.UserID,
+		Type:              refund.Type,
+		Amount:            refund.Amount,
+		Currency:          refund.Currency,
+		Reason:            refund.Reason,
+		Status:            refund.Status,
+		RequestedBy:       refund.RequestedBy,
+		ReviewedBy:        refund.ReviewedBy,
+		ProviderReference: refund.ProviderReference,
+		FailureReason:     refund.FailureReason,
+		CreatedAt:         timeToProto(refund.CreatedAt),
+		UpdatedAt:         timeToProto(refund.UpdatedAt),
+	}
+	if refund.ReviewedAt != nil {
+		pbRefund.ReviewedAt = timeToProto(*refund.ReviewedAt)
+	}
+	if refund.ProcessedAt != nil {
+		pbRefund.ProcessedAt = timeToProto(*refund.ProcessedAt)
+	}
+	for _, line := range refund.Lines {
+		pbLine := &pb.RefundLine{
+			Id:              line.ID,
+			InventoryItemId: line.InventoryItemID,
+			Sku:             line.SKU,
+			Quantity:        int32(line.Quantity),
+			Restock:         line.Restock,
+		}
+		if line.WarehouseID != nil {
+			pbLine.WarehouseId = *line.WarehouseID
+		}
+		pbRefund.Lines = append(pbRefund.Lines, pbLine)
+	}
+	return pbRefund
+}
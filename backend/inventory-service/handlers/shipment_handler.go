@@ -0,0 +1,141 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// CreateShipment registers a shipment of an order for tracking
+func (h *InventoryHandler) CreateShipment(ctx context.Context, req *pb.CreateShipmentRequest) (*pb.Shipment, error) {
+	shipment := &models.Shipment{
+		OrderReference: req.OrderReference,
+		UserID:         req.UserId,
+		Carrier:        req.Carrier,
+		TrackingNumber: req.TrackingNumber,
+	}
+	if req.ShippedAt != nil {
+		shipment.ShippedAt = time.Unix(req.ShippedAt.Seconds, int64(req.ShippedAt.Nanos)).UTC()
+	}
+
+	shipment, err := h.shipmentService.CreateShipment(ctx, shipment, models.ShipmentSourceManual)
+	if err != nil {
+		h.logger.Error("Failed to create shipment", zap.Error(err), zap.String("order_reference", req.OrderReference))
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapShipmentToProto(shipment), nil
+}
+
+// ListShipments lists shipments without their tracking events
+func (h *InventoryHandler) ListShipments(ctx context.Context, req *pb.ListShipmentsRequest) (*pb.ListShipmentsResponse, error) {
+	filter := models.ShipmentFilter{
+		OrderReference: req.OrderReference,
+		UserID:         req.UserId,
+		Status:         req.Status,
+	}
+	shipments, total, err := h.shipmentService.ListShipments(ctx, filter, int(req.Page), int(req.Limit))
+	if err != nil {
+		h.logger.Error("Failed to list shipments", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbShipments := make([]*pb.Shipment, 0, len(shipments))
+	for i := range shipments {
+		pbShipments = append(pbShipments, mapShipmentToProto(&shipments[i]))
+	}
+	return &pb.ListShipmentsResponse{
+		Shipments: pbShipments,
+		Total:     int32(total),
+	}, nil
+}
+
+// GetShipmentStatus returns a shipment, or the shipments of an order, with
+// their tracking events
+func (h *InventoryHandler) GetShipmentStatus(ctx context.Context, req *pb.GetShipmentStatusRequest) (*pb.ShipmentStatusResponse, error) {
+	orderReference, status, shipments, err := h.shipmentService.GetShipmentStatus(ctx, req.Id, req.OrderReference, req.UserId)
+	if err != nil {
+		if apperrors.KindOf(err) != apperrors.ErrNotFound {
+			h.logger.Error("Failed to get shipment status", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbShipments := make([]*pb.Shipment, 0, len(shipments))
+	for i := range shipments {
+		pbShipments = append(pbShipments, mapShipmentToProto(&shipments[i]))
+	}
+	return &pb.ShipmentStatusResponse{
+		OrderReference: orderReference,
+		Status:         status,
+		Shipments:      pbShipments,
+	}, nil
+}
+
+// ReceiveCarrierEvents records the tracking events a carrier pushes
+func (h *InventoryHandler) ReceiveCarrierEvents(ctx context.Context, req *pb.ReceiveCarrierEventsRequest) (*pb.ReceiveCarrierEventsResponse, error) {
+	events := make([]models.CarrierEvent, 0, len(req.Events))
+	for _, e := range req.Events {
+		event := models.CarrierEvent{
+			TrackingNumber: e.TrackingNumber,
+			Status:         e.Status,
+			Description:    e.Description,
+			Location:       e.Location,
+		}
+		if e.OccurredAt != nil {
+			event.OccurredAt = time.Unix(e.OccurredAt.Seconds, int64(e.OccurredAt.Nanos)).UTC()
+		}
+		events = append(events, event)
+	}
+
+	key, results, err := h.shipmentService.ReceiveCarrierEvents(ctx, req.ApiKey, req.Carrier, events)
+	if err != nil {
+		h.logger.Warn("Rejected carrier events", zap.Error(err), zap.String("carrier", req.Carrier))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbResults := make([]*pb.CarrierEventResult, 0, len(results))
+	for _, result := range results {
+		pbResults = append(pbResults, &pb.CarrierEventResult{
+			TrackingNumber: result.TrackingNumber,
+			Status:         result.Status,
+			Error:          result.Error,
+		})
+	}
+	return &pb.ReceiveCarrierEventsResponse{
+		Results: pbResults,
+		Quota:   mapIntegrationQuotaToProto(key),
+	}, nil
+}
+
+// mapShipmentToProto converts a domain shipment to a protobuf message
+func mapShipmentToProto(shipment *models.Shipment) *pb.Shipment {
+	pbShipment := &pb.Shipment{
+		Id:             shipment.ID,
+		OrderReference: shipment.OrderReference,
+		UserId:         shipment.UserID,
+		Carrier:        shipment.Carrier,
+		TrackingNumber: shipment.TrackingNumber,
+		Status:         shipment.Status,
+		ShippedAt:      timeToProto(shipment.ShippedAt),
+		CreatedAt:      timeToProto(shipment.CreatedAt),
+		UpdatedAt:      timeToProto(shipment.UpdatedAt),
+	}
+	if shipment.DeliveredAt != nil {
+		pbShipment.DeliveredAt = timeToProto(*shipment.DeliveredAt)
+	}
+	for _, event := range shipment.Events {
+		pbShipment.Events = append(pbShipment.Events, &pb.ShipmentEvent{
+			Status:      event.Status,
+			Description: event.Description,
+			Location:    event.Location,
+			Source:      event.Source,
+			OccurredAt:  timeToProto(event.OccurredAt),
+		})
+	}
+	return pbShipment
+}
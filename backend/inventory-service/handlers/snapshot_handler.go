@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// GetStockHistory retrieves the daily stock levels of an inventory item over a date range
+func (h *InventoryHandler) GetStockHistory(ctx context.Context, req *pb.GetStockHistoryRequest) (*pb.StockHistoryResponse, error) {
+	var id, productID, sku string
+
+	// Extract the identifier based on which field is set
+	switch req.Identifier.(type) {
+	case *pb.GetStockHistoryRequest_Id:
+		id = req.GetId()
+	case *pb.GetStockHistoryRequest_ProductId:
+		productID = req.GetProductId()
+	case *pb.GetStockHistoryRequest_Sku:
+		sku = req.GetSku()
+	default:
+		h.logger.Warn("GetStockHistory request received with no identifier")
+		return nil, status.Error(codes.InvalidArgument, "No identifier provided")
+	}
+
+	h.logger.Info("GetStockHistory request received",
+		zap.String("id", id),
+		zap.String("product_id", productID),
+		zap.String("sku", sku))
+
+	var warehouseID *string
+	if req.WarehouseId != nil && req.WarehouseId.Value != "" {
+		warehouseID = &req.WarehouseId.Value
+	}
+
+	var from, to time.Time
+	if req.StartDate != nil {
+		from = time.Unix(req.StartDate.Seconds, int64(req.StartDate.Nanos)).UTC()
+	}
+	if req.EndDate != nil {
+		to = time.Unix(req.EndDate.Seconds, int64(req.EndDate.Nanos)).UTC()
+	}
+
+	history, err := h.inventoryService.GetStockHistory(ctx, id, productID, sku, warehouseID, from, to)
+	if err != nil {
+		h.logger.Error("Failed to get stock history", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbSnapshots := make([]*pb.InventorySnapshot, 0, len(history.Snapshots))
+	for i := range history.Snapshots {
+		pbSnapshots = append(pbSnapshots, mapInventorySnapshotToProto(&history.Snapshots[i]))
+	}
+
+	var pbWarehouseID *wrappers.StringValue
+	if history.WarehouseID != nil {
+		pbWarehouseID = &wrappers.StringValue{Value: *history.WarehouseID}
+	}
+
+	return &pb.StockHistoryResponse{
+		InventoryItemId:   history.InventoryItemID,
+		WarehouseId:       pbWarehouseID,
+		Snapshots:         pbSnapshots,
+		AverageDailyUsage: history.AverageDailyUsage,
+		DaysOfCover:       history.DaysOfCover,
+	}, nil
+}
+
+// mapInventorySnapshotToProto converts a domain inventory snapshot to a protobuf message
+func mapInventorySnapshotToProto(snapshot *models.InventorySnapshot) *pb.InventorySnapshot {
+	var warehouseID *wrappers.StringValue
+	if snapshot.WarehouseID != nil {
+		warehouseID = &wrappers.StringValue{Value: *snapshot.WarehouseID}
+	}
+
+	return &pb.InventorySnapshot{
+		Id: snapshot.ID,
+		SnapshotDate: &timestamp.Timestamp{
+			Seconds: snapshot.SnapshotDate.Unix(),
+		},
+		InventoryItemId:   snapshot.InventoryItemID,
+		WarehouseId:       warehouseID,
+		Quantity:          int32(snapshot.Quantity),
+		AvailableQuantity: int32(snapshot.AvailableQuantity),
+		ReservedQuantity:  int32(snapshot.ReservedQuantity),
+	}
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// SetStockBuffers sets the safety stock and max stock of an item at a warehouse
+func (h *InventoryHandler) SetStockBuffers(ctx context.Context, req *pb.SetStockBuffersRequest) (*pb.InventoryLocationResponse, error) {
+	h.logger.Info("SetStockBuffers request received",
+		zap.String("inventory_item_id", req.InventoryItemId),
+		zap.String("warehouse_id", req.WarehouseId),
+		zap.Int32("safety_stock", req.SafetyStock),
+		zap.Int32("max_stock", req.MaxStock))
+
+	location, err := h.inventoryService.SetStockBuffers(
+		ctx,
+		req.InventoryItemId,
+		req.WarehouseId,
+		int(req.SafetyStock),
+		int(req.MaxStock),
+	)
+	if err != nil {
+		h.logger.Error("Failed to set stock buffers", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbLocation, err := mapInventoryLocationToProto(location)
+	if err != nil {
+		h.logger.Error("Failed to map inventory location to proto", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to map inventory location to proto")
+	}
+
+	return &pb.InventoryLocationResponse{
+		InventoryLocation: pbLocation,
+	}, nil
+}
+
+// ListStockAlerts lists the items and locations currently outside their stock thresholds
+func (h *InventoryHandler) ListStockAlerts(ctx context.Context, req *pb.ListStockAlertsRequest) (*pb.ListStockAlertsResponse, error) {
+	var warehouseID string
+	if req.WarehouseId != nil {
+		warehouseID = req.WarehouseId.Value
+	}
+
+	alerts, err := h.inventoryService.ListStockAlerts(ctx, warehouseID)
+	if err != nil {
+		h.logger.Error("Failed to list stock alerts", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbAlerts := make([]*pb.StockAlert, 0, len(alerts))
+	for i := range alerts {
+		pbAlerts = append(pbAlerts, mapStockAlertToProto(&alerts[i]))
+	}
+
+	return &pb.ListStockAlertsResponse{
+		Alerts: pbAlerts,
+	}, nil
+}
+
+// mapStockAlertToProto converts a domain stock alert to a protobuf message
+func mapStockAlertToProto(alert *models.StockAlert) *pb.StockAlert {
+	var warehouseID *wrappers.StringValue
+	if alert.WarehouseID != nil {
+		warehouseID = &wrappers.StringValue{Value: *alert.WarehouseID}
+	}
+
+	return &pb.StockAlert{
+		Type:            alert.Type,
+		InventoryItemId: alert.InventoryItemID,
+		ProductId:       alert.ProductID,
+		Sku:             alert.SKU,
+		WarehouseId:     warehouseID,
+		Quantity:        int32(alert.Quantity),
+		Threshold:       int32(alert.Threshold),
+		DetectedAt: &timestamp.Timestamp{
+			Seconds: alert.DetectedAt.Unix(),
+			Nanos:   int32(alert.DetectedAt.Nanosecond()),
+		},
+	}
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// SetRetailStore makes a warehouse a retail store, or replaces its position,
+// opening hours and pickup settings
+func (h *InventoryHandler) SetRetailStore(ctx context.Context, req *pb.SetRetailStoreRequest) (*pb.RetailStore, error) {
+	hours := make([]models.OpeningHours, 0, len(req.OpeningHours))
+	for _, day := range req.OpeningHours {
+		hours = append(hours, models.OpeningHours{
+			Weekday: time.Weekday(day.Weekday),
+			Opens:   day.Opens,
+			Closes:  day.Closes,
+		})
+	}
+
+	store, err := h.storeLocatorService.SaveStore(ctx, &models.RetailStore{
+		WarehouseID:   req.WarehouseId,
+		Latitude:      req.Latitude,
+		Longitude:     req.Longitude,
+		Timezone:      req.Timezone,
+		Phone:         req.Phone,
+		OpeningHours:  hours,
+		PickupEnabled: req.PickupEnabled,
+		HoldHours:     int(req.HoldHours),
+	})
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to save retail store", zap.Error(err), zap.String("warehouse_id", req.WarehouseId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapRetailStoreToProto(store)
+}
+
+// DeleteRetailStore stops a warehouse being a retail store
+func (h *InventoryHandler) DeleteRetailStore(ctx context.Context, req *pb.DeleteRetailStoreRequest) (*pb.DeleteRetailStoreResponse, error) {
+	if err := h.storeLocatorService.DeleteStore(ctx, req.WarehouseId); err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to delete retail store", zap.Error(err), zap.String("warehouse_id", req.WarehouseId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return &pb.DeleteRetailStoreResponse{}, nil
+}
+
+// ListRetailStores lists the retail stores by name
+func (h *InventoryHandler) ListRetailStores(ctx context.Context, req *pb.ListRetailStoresRequest) (*pb.ListRetailStoresResponse, error) {
+	stores, err := h.storeLocatorService.ListStores(ctx)
+	if err != nil {
+		h.logger.Error("Failed to list retail stores", zap.Error(err))
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbStores := make([]*pb.RetailStore, 0, len(stores))
+	for i := range stores {
+		pbStore, err := mapRetailStoreToProto(&stores[i])
+		if err != nil {
+			return nil, err
+		}
+		pbStores = append(pbStores, pbStore)
+	}
+	return &pb.ListRetailStoresResponse{Stores: pbStores}, nil
+}
+
+// FindNearbyStores finds the stores near a position, nearest first, with the
+// stock of a product at each when given
+func (h *InventoryHandler) FindNearbyStores(ctx context.Context, req *pb.FindNearbyStoresRequest) (*pb.FindNearbyStoresResponse, error) {
+	nearby, err := h.storeLocatorService.FindNearbyStores(ctx, req.Latitude, req.Longitude, req.RadiusKm,
+		req.ProductId, int(req.Quantity), int(req.Limit))
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to find nearby stores", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbStores := make([]*pb.NearbyStore, 0, len(nearby))
+	for _, found := range nearby {
+		pbStore, err := mapRetailStoreToProto(found.Store)
+		if err != nil {
+			return nil, err
+		}
+		pbStores = append(pbStores, &pb.NearbyStore{
+			Store:             pbStore,
+			DistanceKm:        found.DistanceKm,
+			OpenNow:           found.OpenNow,
+			AvailableQuantity: int32(found.AvailableQuantity),
+			InStock:           found.InStock,
+		})
+	}
+	return &pb.FindNearbyStoresResponse{Stores: pbStores}, nil
+}
+
+// ReserveInStore reserves stock of a product at a store for in-store pickup
+func (h *InventoryHandler) ReserveInStore(ctx context.Context, req *pb.ReserveInStoreRequest) (*pb.ReserveInStoreResponse, error) {
+	h.logger.Info("ReserveInStore request received",
+		zap.String("warehouse_id", req.WarehouseId),
+		zap.String("product_id", req.ProductId),
+		zap.Int32("quantity", req.Quantity))
+
+	reservation, store, err := h.storeLocatorService.ReserveInStore(ctx, req.WarehouseId, req.ProductId,
+		int(req.Quantity), req.Unit, req.ReferenceId)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to reserve in store", zap.Error(err), zap.String("warehouse_id", req.WarehouseId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbReservation, err := mapReservationToProto(reservation)
+	if err != nil {
+		h.logger.Error("Failed to map reservation to proto", zap.Error(err))
+		return nil, status.Error(codes.Internal, "Failed to map reservation to proto")
+	}
+	pbStore, err := mapRetailStoreToProto(store)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReserveInStoreResponse{Reservation: pbReservation, Store: pbStore}, nil
+}
+
+func mapRetailStoreToProto(store *models.RetailStore) (*pb.RetailStore, error) {
+	var warehouse *pb.Warehouse
+	if store.Warehouse != nil {
+		var err error
+		if warehouse, err = mapWarehouseToProto(store.Warehouse); err != nil {
+			return nil, status.Error(codes.Internal, "Failed to map warehouse to proto")
+		}
+	}
+
+	hours := make([]*pb.OpeningHours, 0, len(store.OpeningHours))
+	for _, day := range store.OpeningHours {
+		hours = append(hours, &pb.OpeningHours{
+			Weekday: int32(day.Weekday),
+			Opens:   day.Opens,
+			Closes:  day.Closes,
+		})
+	}
+
+	return &pb.RetailStore{
+		Warehouse:     warehouse,
+		Latitude:      store.Latitude,
+		Longitude:     store.Longitude,
+		Timezone:      store.Timezone,
+		Phone:         store.Phone,
+		OpeningHours:  hours,
+		PickupEnabled: store.PickupEnabled,
+		HoldHours:     int32(store.HoldHours),
+		CreatedAt:     timeToProto(store.CreatedAt),
+		UpdatedAt:     timeToProto(store.UpdatedAt),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// SetInventoryUnit creates or replaces a unit of measure of an item
+func (h *InventoryHandler) SetInventoryUnit(ctx context.Context, req *pb.SetInventoryUnitRequest) (*pb.InventoryUnit, error) {
+	h.logger.Info("SetInventoryUnit request received",
+		zap.String("inventory_item_id", req.InventoryItemId),
+		zap.String("code", req.Code),
+		zap.Int32("factor", req.Factor))
+
+	unit, err := h.inventoryService.SetUnit(ctx, &models.InventoryUnit{
+		InventoryItemID: req.InventoryItemId,
+		Code:            req.Code,
+		Name:            req.Name,
+		Factor:          int(req.Factor),
+	})
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to set unit of measure", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapUnitToProto(unit), nil
+}
+
+// ListInventoryUnits lists the units of measure of an item, starting with
+// the base unit
+func (h *InventoryHandler) ListInventoryUnits(ctx context.Context, req *pb.ListInventoryUnitsRequest) (*pb.ListInventoryUnitsResponse, error) {
+	units, err := h.inventoryService.ListUnits(ctx, req.InventoryItemId)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to list units of measure", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	resp := &pb.ListInventoryUnitsResponse{Units: make([]*pb.InventoryUnit, len(units))}
+	for i := range units {
+		resp.Units[i] = mapUnitToProto(&units[i])
+	}
+	return resp, nil
+}
+
+// DeleteInventoryUnit removes a unit of measure of an item
+func (h *InventoryHandler) DeleteInventoryUnit(ctx context.Context, req *pb.DeleteInventoryUnitRequest) (*pb.DeleteInventoryUnitResponse, error) {
+	h.logger.Info("DeleteInventoryUnit request received",
+		zap.String("inventory_item_id", req.InventoryItemId),
+		zap.String("code", req.Code))
+
+	if err := h.inventoryService.DeleteUnit(ctx, req.InventoryItemId, req.Code); err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to delete unit of measure", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return &pb.DeleteInventoryUnitResponse{}, nil
+}
+
+func mapUnitToProto(unit *models.InventoryUnit) *pb.InventoryUnit {
+	result := &pb.InventoryUnit{
+		InventoryItemId: unit.InventoryItemID,
+		Code:            unit.Code,
+		Name:            unit.Name,
+		Factor:          int32(unit.Factor),
+	}
+	if !unit.UpdatedAt.IsZero() {
+		result.UpdatedAt = timeToProto(unit.UpdatedAt)
+	}
+	return result
+}
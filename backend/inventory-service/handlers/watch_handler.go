@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// maxWatchedProducts limits how many products a single stream may watch
+const maxWatchedProducts = 500
+
+// WatchInventory streams stock changes for a set of products or a warehouse until the client disconnects
+func (h *InventoryHandler) WatchInventory(req *pb.WatchInventoryRequest, stream grpc.ServerStreamingServer[pb.StockChangeEvent]) error {
+	filter := models.StockFilter{ProductIDs: req.ProductIds}
+	if req.WarehouseId != nil {
+		filter.WarehouseID = req.WarehouseId.Value
+	}
+
+	if len(filter.ProductIDs) == 0 && filter.WarehouseID == "" {
+		return status.Error(codes.InvalidArgument, "product_ids or warehouse_id is required")
+	}
+	if len(filter.ProductIDs) > maxWatchedProducts {
+		return status.Errorf(codes.InvalidArgument, "at most %d product_ids can be watched", maxWatchedProducts)
+	}
+
+	ctx := stream.Context()
+	h.logger.Info("WatchInventory stream opened",
+		zap.Int("product_count", len(filter.ProductIDs)),
+		zap.String("warehouse_id", filter.WarehouseID))
+
+	// Subscribe before reading current levels so no change falls in between
+	changes, unsubscribe := h.inventoryService.WatchInventory(filter)
+	defer unsubscribe()
+
+	if req.IncludeCurrent {
+		current, err := h.inventoryService.CurrentStockLevels(ctx, filter)
+		if err != nil {
+			h.logger.Error("Failed to load current stock levels", zap.Error(err))
+			return apperrors.ToGRPC(err)
+		}
+		for i := range current {
+			if err := stream.Send(mapStockChangeToProto(&current[i])); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			h.logger.Info("WatchInventory stream closed")
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(mapStockChangeToProto(&change)); err != nil {
+				h.logger.Warn("Failed to send stock change", zap.Error(err))
+				return err
+			}
+		}
+	}
+}
+
+// mapStockChangeToProto converts a domain stock change to a protobuf message
+func mapStockChangeToProto(change *models.StockChange) *pb.StockChangeEvent {
+	var variantID, warehouseID *wrappers.StringValue
+	if change.VariantID != nil {
+		variantID = &wrappers.StringValue{Value: *change.VariantID}
+	}
+	if change.WarehouseID != nil {
+		warehouseID = &wrappers.StringValue{Value: *change.WarehouseID}
+	}
+
+	return &pb.StockChangeEvent{
+		InventoryItemId:            change.InventoryItemID,
+		ProductId:                  change.ProductID,
+		VariantId:                  variantID,
+		Sku:                        change.SKU,
+		TotalQuantity:              int32(change.TotalQuantity),
+		AvailableQuantity:          int32(change.AvailableQuantity),
+		ReservedQuantity:           int32(change.ReservedQuantity),
+		Status:                     change.Status,
+		Availability:               change.Availability,
+		WarehouseId:                warehouseID,
+		WarehouseQuantity:          int32(change.WarehouseQuantity),
+		WarehouseAvailableQuantity: int32(change.WarehouseAvailableQuantity),
+		ChangeType:                 change.ChangeType,
+		OccurredAt: &timestamp.Timestamp{
+			Seconds: change.OccurredAt.Unix(),
+			Nanos:   int32(change.OccurredAt.Nanosecond()),
+		},
+	}
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// CreateFulfillmentWave batches the pending orders of a warehouse into a wave
+func (h *InventoryHandler) CreateFulfillmentWave(ctx context.Context, req *pb.CreateFulfillmentWaveRequest) (*pb.FulfillmentWave, error) {
+	filter := models.WaveFilter{
+		WarehouseID:     req.WarehouseId,
+		Carrier:         req.Carrier,
+		OrderReferences: req.OrderReferences,
+		MaxOrders:       int(req.MaxOrders),
+	}
+	if req.CutoffAt != nil {
+		filter.CutoffAt = req.CutoffAt.AsTime()
+	}
+
+	wave, err := h.waveService.CreateWave(ctx, filter)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to create wave", zap.Error(err), zap.String("warehouse_id", req.WarehouseId))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapWaveToProto(wave), nil
+}
+
+// GetFulfillmentWave retrieves a wave with its lines
+func (h *InventoryHandler) GetFulfillmentWave(ctx context.Context, req *pb.GetFulfillmentWaveRequest) (*pb.FulfillmentWave, error) {
+	wave, err := h.waveService.GetWave(ctx, req.Id)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to get wave", zap.Error(err), zap.String("wave_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapWaveToProto(wave), nil
+}
+
+// ListFulfillmentWaves lists the waves newest first
+func (h *InventoryHandler) ListFulfillmentWaves(ctx context.Context, req *pb.ListFulfillmentWavesRequest) (*pb.ListFulfillmentWavesResponse, error) {
+	waves, total, err := h.waveService.ListWaves(ctx, req.WarehouseId, req.Status, int(req.Page), int(req.Limit))
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to list waves", zap.Error(err))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+
+	pbWaves := make([]*pb.FulfillmentWave, 0, len(waves))
+	for i := range waves {
+		pbWaves = append(pbWaves, mapWaveToProto(&waves[i]))
+	}
+	return &pb.ListFulfillmentWavesResponse{
+		Waves: pbWaves,
+		Total: int32(total),
+	}, nil
+}
+
+// GenerateWavePickList plans the consolidated picks of an open wave
+func (h *InventoryHandler) GenerateWavePickList(ctx context.Context, req *pb.GenerateWavePickListRequest) (*pb.PickList, error) {
+	list, err := h.waveService.GenerateWavePickList(ctx, req.Id)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to generate wave pick list", zap.Error(err), zap.String("wave_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapPickListToProto(list), nil
+}
+
+// CompleteFulfillmentWave records the quantities picked of a wave and
+// completes it
+func (h *InventoryHandler) CompleteFulfillmentWave(ctx context.Context, req *pb.CompleteFulfillmentWaveRequest) (*pb.FulfillmentWave, error) {
+	picked := make([]models.PickedLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		picked = append(picked, models.PickedLine{LineID: line.LineId, PickedQuantity: int(line.PickedQuantity)})
+	}
+
+	wave, err := h.waveService.CompleteWave(ctx, req.Id, picked)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to complete wave", zap.Error(err), zap.String("wave_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapWaveToProto(wave), nil
+}
+
+// CancelFulfillmentWave cancels an open wave
+func (h *InventoryHandler) CancelFulfillmentWave(ctx context.Context, req *pb.CancelFulfillmentWaveRequest) (*pb.FulfillmentWave, error) {
+	wave, err := h.waveService.CancelWave(ctx, req.Id)
+	if err != nil {
+		if apperrors.KindOf(err) == apperrors.ErrInternal {
+			h.logger.Error("Failed to cancel wave", zap.Error(err), zap.String("wave_id", req.Id))
+		}
+		return nil, apperrors.ToGRPC(err)
+	}
+	return mapWaveToProto(wave), nil
+}
+
+func mapWaveToProto(wave *models.Wave) *pb.FulfillmentWave {
+	pbWave := &pb.FulfillmentWave{
+		Id:          wave.ID,
+		WarehouseId: wave.WarehouseID,
+		Carrier:     wave.Carrier,
+		CutoffAt:    timeToProto(wave.CutoffAt),
+		Status:      wave.Status,
+		Orders:      int32(wave.Orders),
+		CreatedAt:   timeToProto(wave.CreatedAt),
+	}
+	if wave.CompletedAt != nil {
+		pbWave.CompletedAt = timeToProto(*wave.CompletedAt)
+	}
+	for _, line := range wave.Lines {
+		pbWave.Lines = append(pbWave.Lines, &pb.FulfillmentWaveLine{
+			Id:              line.ID,
+			ReservationId:   line.ReservationID,
+			OrderReference:  line.OrderReference,
+			InventoryItemId: line.InventoryItemID,
+			Sku:             line.SKU,
+			Quantity:        int32(line.Quantity),
+			PickedQuantity:  int32(line.PickedQuantity),
+			Status:          line.Status,
+		})
+	}
+	return pbWave
+}
@@ -13,18 +13,30 @@ import (
 	"syscall"
 	"time"
 
-	_ "github.com/lib/pq"
+	_ "github.com/jackc/pgx/v5/stdlib"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
-	"github.com/louai60/e-commerce_project/backend/common/logger"
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/mail"
+	"github.com/louai60/e-commerce_project/backend/common/profiling"
+	"github.com/louai60/e-commerce_project/backend/common/recovery"
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/carriers"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/config"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/fraud"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/handlers"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/middleware"
 	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/repository/postgres"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/service"
+	sharedconfig "github.com/louai60/e-commerce_project/backend/shared/config"
+	"github.com/louai60/e-commerce_project/backend/shared/diagnostics"
+	"github.com/louai60/e-commerce_project/backend/shared/partition"
 )
 
 func main() {
@@ -32,11 +44,12 @@ func main() {
 	logger := initLogger()
 	defer logger.Sync()
 
-	// Load configuration
-	cfg, err := config.LoadConfig()
+	// Load configuration and watch it for runtime changes
+	configWatcher, err := config.NewWatcher(context.Background(), logger)
 	if err != nil {
 		logger.Fatal("Failed to load configuration", zap.Error(err))
 	}
+	cfg := configWatcher.Current()
 
 	// Connect to database
 	db, err := connectToDatabase(cfg, logger)
@@ -48,17 +61,163 @@ func main() {
 	// Initialize repositories
 	inventoryRepo := postgres.NewInventoryRepository(db, logger)
 	warehouseRepo := postgres.NewWarehouseRepository(db, logger)
+	fulfillmentRepo := postgres.NewFulfillmentRepository(db, logger)
+	shipmentRepo := postgres.NewShipmentRepository(db, logger)
+	purchasingRepo := postgres.NewPurchasingRepository(db, logger)
+	policyRepo := postgres.NewAvailabilityPolicyRepository(db, logger)
+	backInStockRepo := postgres.NewBackInStockRepository(db, logger)
+	lotRepo := postgres.NewLotRepository(db, logger)
+	unitRepo := postgres.NewUnitRepository(db, logger)
+	binRepo := postgres.NewBinRepository(db, logger)
+	waveRepo := postgres.NewWaveRepository(db, logger)
+	refundRepo := postgres.NewRefundRepository(db, logger)
+	fraudRepo := postgres.NewFraudRepository(db, logger)
+	storeRepo := postgres.NewRetailStoreRepository(db, logger)
+	pickupRepo := postgres.NewPickupRepository(db, logger)
+	forecastRepo := postgres.NewForecastRepository(db, logger)
+
+	// Register the trackers of the carriers polled for tracking events
+	trackers := carriers.NewRegistry()
+	for name, carrier := range cfg.Shipments.Carriers {
+		trackers.Register(strings.ToLower(name), carriers.NewHTTPTracker(carrier.TrackingURL, carrier.APIKey))
+	}
+
+	// Register the built-in fraud rules and the external fraud providers
+	screener := fraud.NewScreener(fraud.Thresholds{Review: cfg.Fraud.ReviewScore, Decline: cfg.Fraud.DeclineScore})
+	screener.Register(fraud.RuleVelocity, &fraud.Velocity{
+		Counter:   fraudRepo,
+		Window:    time.Duration(cfg.Fraud.VelocityWindowMinutes) * time.Minute,
+		MaxOrders: cfg.Fraud.VelocityMaxOrders,
+		Points:    cfg.Fraud.VelocityPoints,
+	})
+	screener.Register(fraud.RuleCountryMismatch, &fraud.CountryMismatch{Points: cfg.Fraud.CountryMismatchPoints})
+	screener.Register(fraud.RuleDisposableEmail, fraud.NewDisposableEmail(cfg.Fraud.DisposableDomains, cfg.Fraud.DisposableEmailPoints))
+	for name, provider := range cfg.Fraud.Providers {
+		name = strings.ToLower(name)
+		screener.Register(name, fraud.NewHTTPScorer(name, provider.URL, provider.APIKey))
+	}
 
 	// Initialize services
-	inventoryService := service.NewInventoryService(inventoryRepo, warehouseRepo, logger)
+	inventoryService := service.NewInventoryService(inventoryRepo, warehouseRepo, policyRepo, unitRepo, logger)
 	warehouseService := service.NewWarehouseService(warehouseRepo, logger)
+	shipmentService := service.NewShipmentService(shipmentRepo, fulfillmentRepo, trackers, logger)
+	fulfillmentService := service.NewFulfillmentService(fulfillmentRepo, inventoryRepo, warehouseRepo, inventoryService, shipmentService, logger)
+	purchasingService := service.NewPurchasingService(purchasingRepo, inventoryRepo, warehouseRepo, inventoryService, logger)
+	lotService := service.NewLotService(lotRepo, warehouseRepo, inventoryService, logger)
+	binService := service.NewBinService(binRepo, warehouseRepo, inventoryService, logger)
+	waveService := service.NewWaveService(waveRepo, fulfillmentRepo, binService, logger)
+	refundService := service.NewRefundService(refundRepo, inventoryService, logger)
+	fraudService := service.NewFraudService(fraudRepo, screener, logger)
+	storeLocatorService := service.NewStoreLocatorService(storeRepo, inventoryService, logger)
+	forecastService := service.NewForecastService(forecastRepo, inventoryService, cfg.Forecast.ReviewDays, logger)
+
+	// Emails are only logged until an SMTP relay is configured
+	var mailer mail.Mailer = mail.NewLogMailer(logger)
+	if cfg.Mail.SMTPHost != "" {
+		if cfg.Mail.From == "" {
+			logger.Fatal("mail.from is required when mail.smtp_host is set")
+		}
+		mailer = mail.NewSMTPMailer(cfg.Mail.SMTPHost, cfg.Mail.SMTPPort, cfg.Mail.SMTPUsername, cfg.Mail.SMTPPassword, cfg.Mail.From)
+	}
+	backInStockService := service.NewBackInStockService(backInStockRepo, mailer, cfg.BackInStock.ProductURL, logger)
+	pickupService := service.NewPickupService(pickupRepo, storeRepo, fulfillmentRepo, inventoryService, mailer, cfg.Pickup.CollectDays, logger)
+
+	// Schedule nightly inventory snapshots
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	defer stopJobs()
+
+	// Apply log level and connection pool changes without a restart
+	sharedconfig.OnChange(configWatcher, func(c *config.Config) string { return c.Logging.Level }, func(level string) {
+		if err := applogger.SetLevel(level); err != nil {
+			logger.Warn("Ignoring invalid log level from configuration", zap.String("level", level), zap.Error(err))
+		}
+	})
+	configWatcher.Subscribe(func(old, new *config.Config) {
+		db.SetMaxOpenConns(new.Database.MaxOpenConns)
+		db.SetMaxIdleConns(new.Database.MaxIdleConns)
+		db.SetConnMaxLifetime(time.Duration(new.Database.ConnMaxLifetimeMinutes) * time.Minute)
+	})
+	configWatcher.Start(jobsCtx)
+	if cfg.Snapshot.Enabled {
+		inventoryService.StartSnapshotScheduler(jobsCtx, cfg.Snapshot.HourUTC)
+	}
+	if cfg.Forecast.Enabled {
+		forecastService.StartForecastScheduler(jobsCtx, cfg.Forecast.HourUTC)
+	}
+	if cfg.StockAlerts.Enabled {
+		inventoryService.StartStockAlertScheduler(jobsCtx, time.Duration(cfg.StockAlerts.IntervalMinutes)*time.Minute)
+	}
+
+	if cfg.Shipments.PollEnabled {
+		shipmentService.StartTrackingPoller(jobsCtx, time.Duration(cfg.Shipments.PollIntervalMinutes)*time.Minute)
+	}
+
+	if cfg.BackInStock.Enabled {
+		backInStockService.StartNotifier(jobsCtx, inventoryService, time.Duration(cfg.BackInStock.SweepIntervalMinutes)*time.Minute)
+	}
+
+	if cfg.Lots.WriteOffEnabled {
+		lotService.StartExpiryWriteOff(jobsCtx, time.Duration(cfg.Lots.WriteOffIntervalMinutes)*time.Minute)
+	}
+
+	if cfg.Pickup.ExpiryEnabled {
+		pickupService.StartExpiryScheduler(jobsCtx, time.Duration(cfg.Pickup.ExpiryIntervalMinutes)*time.Minute)
+	}
+
+	if cfg.Archival.Enabled {
+		partition.NewManager(db, partition.DirArchiver(cfg.Archival.ArchiveDir), logger,
+			partition.Table{Name: "inventory_transactions", Retention: cfg.Archival.RetentionMonths},
+		).Start(jobsCtx, time.Duration(cfg.Archival.IntervalHours)*time.Hour)
+	}
+
+	if cfg.Profiling.Enabled {
+		if err := profiling.Start(jobsCtx, cfg.Profiling.Addr, logger); err != nil {
+			logger.Error("Failed to start profiling endpoints", zap.Error(err))
+		}
+	}
+
+	// Register the database pool for the diagnostics endpoint
+	diagnosticsCollector := diagnostics.NewCollector("inventory-service")
+	diagnosticsCollector.AddDB("master", db, false)
 
 	// Initialize gRPC handler
-	inventoryHandler := handlers.NewInventoryHandler(inventoryService, warehouseService, logger)
+	inventoryHandler := handlers.NewInventoryHandler(inventoryService, warehouseService, fulfillmentService, shipmentService, purchasingService, backInStockService, lotService, binService, waveService, refundService, fraudService, storeLocatorService, pickupService, forecastService, diagnosticsCollector, logger)
+
+	// Recover panics of handlers, reporting them to Sentry when configured
+	panicReporter, err := recovery.ReporterFromEnv(jobsCtx, logger)
+	if err != nil {
+		logger.Fatal("Invalid Sentry configuration", zap.Error(err))
+	}
+	recoverer := recovery.New("inventory-service", logger, panicReporter)
+
+	// Verify the service tokens of callers of privileged RPCs
+	serviceVerifier, err := servicetoken.VerifierFromEnv()
+	if err != nil {
+		logger.Fatal("Invalid trusted service keys", zap.Error(err))
+	}
+	if serviceVerifier == nil {
+		logger.Warn("SERVICE_TOKEN_TRUSTED_KEYS not set, privileged RPCs are open to any caller")
+	}
 
 	// Start gRPC server
 	server := grpc.NewServer(
-		grpc.UnaryInterceptor(middleware.LoggingInterceptor(logger)),
+		grpc.ChainUnaryInterceptor(
+			applogger.UnaryServerInterceptor(logger),
+			recoverer.UnaryServerInterceptor(),
+			tenant.UnaryServerInterceptor(),
+			servicetoken.UnaryServerInterceptor(serviceVerifier, middleware.PrivilegedMethods),
+			scope.UnaryServerInterceptor(middleware.ScopedMethods),
+			middleware.LoggingInterceptor(logger),
+			apperrors.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			applogger.StreamServerInterceptor(logger),
+			recoverer.StreamServerInterceptor(),
+			tenant.StreamServerInterceptor(),
+			servicetoken.StreamServerInterceptor(serviceVerifier, middleware.PrivilegedMethods),
+			scope.StreamServerInterceptor(middleware.ScopedMethods),
+			apperrors.StreamServerInterceptor(),
+		),
 	)
 	pb.RegisterInventoryServiceServer(server, inventoryHandler)
 	reflection.Register(server)
@@ -84,18 +243,13 @@ func main() {
 	<-quit
 
 	logger.Info("Shutting down inventory service...")
+	stopJobs()
 	server.GracefulStop()
 	logger.Info("Inventory service stopped")
 }
 
 func initLogger() *zap.Logger {
-	env := os.Getenv("APP_ENV")
-	if env == "" {
-		env = "development"
-	}
-
-	logger.Initialize(env)
-	return logger.GetLogger()
+	return applogger.InitFromEnv("inventory-service")
 }
 
 func connectToDatabase(cfg *config.Config, logger *zap.Logger) (*sql.DB, error) {
@@ -108,7 +262,7 @@ func connectToDatabase(cfg *config.Config, logger *zap.Logger) (*sql.DB, error)
 	)
 
 	logger.Info("Connecting to postgres to check if database exists")
-	pgDB, err := sql.Open("postgres", pgDSN)
+	pgDB, err := sql.Open("pgx", pgDSN)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
 	}
@@ -147,7 +301,7 @@ func connectToDatabase(cfg *config.Config, logger *zap.Logger) (*sql.DB, error)
 
 	for i := 0; i < maxRetries; i++ {
 		logger.Info("Attempting to connect to database", zap.Int("attempt", i+1))
-		db, err = sql.Open("postgres", dsn)
+		db, err = sql.Open("pgx", dsn)
 		if err != nil {
 			logger.Error("Failed to open database connection", zap.Error(err))
 			time.Sleep(retryInterval)
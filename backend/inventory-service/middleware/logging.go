@@ -7,6 +7,8 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
+
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
 )
 
 // LoggingInterceptor creates a gRPC interceptor for logging requests
@@ -17,18 +19,19 @@ func LoggingInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
 		// Execute the handler
 		resp, err := handler(ctx, req)
 		
-		// Log the request
+		// Log the request, tagged with the request and user IDs carried by ctx
 		duration := time.Since(start)
+		log := applogger.FromContext(applogger.WithContext(ctx, logger))
 		if err != nil {
 			st, _ := status.FromError(err)
-			logger.Error("gRPC request failed",
+			log.Error("gRPC request failed",
 				zap.String("method", info.FullMethod),
 				zap.Duration("duration", duration),
 				zap.String("code", st.Code().String()),
 				zap.Error(err),
 			)
 		} else {
-			logger.Info("gRPC request successful",
+			log.Info("gRPC request successful",
 				zap.String("method", info.FullMethod),
 				zap.Duration("duration", duration),
 			)
@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// Callers of the privileged RPCs, as named in their service tokens
+const (
+	gatewayService = "api-gateway"
+	adminService   = "admin-service"
+	productService = "product-service"
+)
+
+var (
+	staffCallers = []string{gatewayService, adminService}
+	// Fulfillment providers and carriers reach the inventory service through
+	// the gateway
+	gatewayCallers = []string{gatewayService}
+	// The product service creates and updates the stock of the products it
+	// manages
+	catalogCallers = []string{gatewayService, adminService, productService}
+)
+
+// PrivilegedMethods lists the RPCs that change stock levels or warehouses, or
+// expose operational or purchasing data, and the services allowed to call
+// them. Fulfillment and carrier pushes are also authenticated with the API
+// key of the sender. Availability checks, checkout reservations, nearby
+// store searches, in-store pickup reservations and back-in-stock subscribing
+// stay open.
+var PrivilegedMethods = servicetoken.Policy{
+	pb.InventoryService_CreateInventoryItem_FullMethodName:           catalogCallers,
+	pb.InventoryService_UpdateInventoryItem_FullMethodName:           catalogCallers,
+	pb.InventoryService_BulkUpdateInventory_FullMethodName:           catalogCallers,
+	pb.InventoryService_CreateWarehouse_FullMethodName:               staffCallers,
+	pb.InventoryService_UpdateWarehouse_FullMethodName:               staffCallers,
+	pb.InventoryService_AddInventoryToLocation_FullMethodName:        staffCallers,
+	pb.InventoryService_RemoveInventoryFromLocation_FullMethodName:   staffCallers,
+	pb.InventoryService_SetStockBuffers_FullMethodName:               staffCallers,
+	pb.InventoryService_GetAvailabilityPolicy_FullMethodName:         staffCallers,
+	pb.InventoryService_SetAvailabilityPolicy_FullMethodName:         staffCallers,
+	pb.InventoryService_DeleteAvailabilityPolicy_FullMethodName:      staffCallers,
+	pb.InventoryService_GetDiagnostics_FullMethodName:                staffCallers,
+	pb.InventoryService_ListInventoryActivity_FullMethodName:         staffCallers,
+	pb.InventoryService_GetStockHistory_FullMethodName:               staffCallers,
+	pb.InventoryService_ListStockAlerts_FullMethodName:               staffCallers,
+	pb.InventoryService_GetForecast_FullMethodName:                   staffCallers,
+	pb.InventoryService_ListReorderSuggestions_FullMethodName:        staffCallers,
+	pb.InventoryService_CreateIntegrationKey_FullMethodName:          staffCallers,
+	pb.InventoryService_ListIntegrationKeys_FullMethodName:           staffCallers,
+	pb.InventoryService_RevokeIntegrationKey_FullMethodName:          staffCallers,
+	pb.InventoryService_SetIntegrationKeyQuota_FullMethodName:        staffCallers,
+	pb.InventoryService_GetIntegrationQuota_FullMethodName:           gatewayCallers,
+	pb.InventoryService_PushFulfillmentEvents_FullMethodName:         gatewayCallers,
+	pb.InventoryService_ListOrderStatusEvents_FullMethodName:         staffCallers,
+	pb.InventoryService_CreateShipment_FullMethodName:                staffCallers,
+	pb.InventoryService_ListShipments_FullMethodName:                 staffCallers,
+	pb.InventoryService_GetShipmentStatus_FullMethodName:             staffCallers,
+	pb.InventoryService_ReceiveCarrierEvents_FullMethodName:          gatewayCallers,
+	pb.InventoryService_ListBackInStockSubscriptions_FullMethodName:  staffCallers,
+	pb.InventoryService_ReceiveLot_FullMethodName:                    staffCallers,
+	pb.InventoryService_ListLots_FullMethodName:                      staffCallers,
+	pb.InventoryService_ListExpiringLots_FullMethodName:              staffCallers,
+	pb.InventoryService_SetInventoryUnit_FullMethodName:              catalogCallers,
+	pb.InventoryService_DeleteInventoryUnit_FullMethodName:           catalogCallers,
+	pb.InventoryService_SetWarehouseBin_FullMethodName:               staffCallers,
+	pb.InventoryService_ListWarehouseBins_FullMethodName:             staffCallers,
+	pb.InventoryService_DeleteWarehouseBin_FullMethodName:            staffCallers,
+	pb.InventoryService_SetBinStock_FullMethodName:                   staffCallers,
+	pb.InventoryService_ListBinStock_FullMethodName:                  staffCallers,
+	pb.InventoryService_GeneratePickList_FullMethodName:              staffCallers,
+	pb.InventoryService_CreateFulfillmentWave_FullMethodName:         staffCallers,
+	pb.InventoryService_GetFulfillmentWave_FullMethodName:            staffCallers,
+	pb.InventoryService_ListFulfillmentWaves_FullMethodName:          staffCallers,
+	pb.InventoryService_GenerateWavePickList_FullMethodName:          staffCallers,
+	pb.InventoryService_CompleteFulfillmentWave_FullMethodName:       staffCallers,
+	pb.InventoryService_CancelFulfillmentWave_FullMethodName:         staffCallers,
+	pb.InventoryService_RequestRefund_FullMethodName:                 staffCallers,
+	pb.InventoryService_GetRefund_FullMethodName:                     staffCallers,
+	pb.InventoryService_ListRefunds_FullMethodName:                   staffCallers,
+	pb.InventoryService_ApproveRefund_FullMethodName:                 staffCallers,
+	pb.InventoryService_RejectRefund_FullMethodName:                  staffCallers,
+	pb.InventoryService_RecordRefundResult_FullMethodName:            staffCallers,
+	pb.InventoryService_ListRefundEvents_FullMethodName:              staffCallers,
+	pb.InventoryService_ScreenOrder_FullMethodName:                   staffCallers,
+	pb.InventoryService_GetFraudCheck_FullMethodName:                 staffCallers,
+	pb.InventoryService_ListFraudChecks_FullMethodName:               staffCallers,
+	pb.InventoryService_ReviewFraudCheck_FullMethodName:              staffCallers,
+	pb.InventoryService_ListFraudEvents_FullMethodName:               staffCallers,
+	pb.InventoryService_SetRetailStore_FullMethodName:                staffCallers,
+	pb.InventoryService_DeleteRetailStore_FullMethodName:             staffCallers,
+	pb.InventoryService_ListRetailStores_FullMethodName:              staffCallers,
+	pb.InventoryService_CreatePickupOrder_FullMethodName:             staffCallers,
+	pb.InventoryService_GetPickupOrder_FullMethodName:                staffCallers,
+	pb.InventoryService_ListPickupOrders_FullMethodName:              staffCallers,
+	pb.InventoryService_StartPickupPicking_FullMethodName:            staffCallers,
+	pb.InventoryService_MarkPickupReady_FullMethodName:               staffCallers,
+	pb.InventoryService_CollectPickupOrder_FullMethodName:            staffCallers,
+	pb.InventoryService_CancelPickupOrder_FullMethodName:             staffCallers,
+	pb.InventoryService_DeleteBackInStockSubscription_FullMethodName: staffCallers,
+	pb.InventoryService_CreateSupplier_FullMethodName:                staffCallers,
+	pb.InventoryService_UpdateSupplier_FullMethodName:                staffCallers,
+	pb.InventoryService_GetSupplier_FullMethodName:                   staffCallers,
+	pb.InventoryService_ListSuppliers_FullMethodName:                 staffCallers,
+	pb.InventoryService_SetSupplierProduct_FullMethodName:            staffCallers,
+	pb.InventoryService_RemoveSupplierProduct_FullMethodName:         staffCallers,
+	pb.InventoryService_CreatePurchaseOrder_FullMethodName:           staffCallers,
+	pb.InventoryService_GetPurchaseOrder_FullMethodName:              staffCallers,
+	pb.InventoryService_ListPurchaseOrders_FullMethodName:            staffCallers,
+	pb.InventoryService_ReceivePurchaseOrder_FullMethodName:          staffCallers,
+	pb.InventoryService_CancelPurchaseOrder_FullMethodName:           staffCallers,
+}
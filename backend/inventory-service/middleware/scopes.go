@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+	pb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+)
+
+// ScopedMethods lists the RPCs changing stock levels, warehouses or
+// purchasing, or the shipments, refunds, fraud reviews and store pickups of
+// orders, and the scope the users they are made for must hold. Calls made by
+// services on their own behalf carry no user scopes and are left to
+// PrivilegedMethods; checkout reservations, pickup orders and their
+// cancelling stay open to customers.
+var ScopedMethods = scope.Policy{
+	pb.InventoryService_CreateInventoryItem_FullMethodName:         scope.InventoryWrite,
+	pb.InventoryService_UpdateInventoryItem_FullMethodName:         scope.InventoryWrite,
+	pb.InventoryService_BulkUpdateInventory_FullMethodName:         scope.InventoryWrite,
+	pb.InventoryService_CreateWarehouse_FullMethodName:             scope.InventoryWrite,
+	pb.InventoryService_UpdateWarehouse_FullMethodName:             scope.InventoryWrite,
+	pb.InventoryService_AddInventoryToLocation_FullMethodName:      scope.InventoryWrite,
+	pb.InventoryService_RemoveInventoryFromLocation_FullMethodName: scope.InventoryWrite,
+	pb.InventoryService_SetStockBuffers_FullMethodName:             scope.InventoryWrite,
+	pb.InventoryService_SetAvailabilityPolicy_FullMethodName:       scope.InventoryWrite,
+	pb.InventoryService_DeleteAvailabilityPolicy_FullMethodName:    scope.InventoryWrite,
+	pb.InventoryService_CreateShipment_FullMethodName:              scope.OrdersWrite,
+	pb.InventoryService_CreateSupplier_FullMethodName:              scope.InventoryWrite,
+	pb.InventoryService_UpdateSupplier_FullMethodName:              scope.InventoryWrite,
+	pb.InventoryService_SetSupplierProduct_FullMethodName:          scope.InventoryWrite,
+	pb.InventoryService_RemoveSupplierProduct_FullMethodName:       scope.InventoryWrite,
+	pb.InventoryService_CreatePurchaseOrder_FullMethodName:         scope.InventoryWrite,
+	pb.InventoryService_ReceivePurchaseOrder_FullMethodName:        scope.InventoryWrite,
+	pb.InventoryService_CancelPurchaseOrder_FullMethodName:         scope.InventoryWrite,
+	pb.InventoryService_ReceiveLot_FullMethodName:                  scope.InventoryWrite,
+	pb.InventoryService_SetInventoryUnit_FullMethodName:            scope.InventoryWrite,
+	pb.InventoryService_DeleteInventoryUnit_FullMethodName:         scope.InventoryWrite,
+	pb.InventoryService_SetWarehouseBin_FullMethodName:             scope.InventoryWrite,
+	pb.InventoryService_DeleteWarehouseBin_FullMethodName:          scope.InventoryWrite,
+	pb.InventoryService_SetBinStock_FullMethodName:                 scope.InventoryWrite,
+	pb.InventoryService_CreateFulfillmentWave_FullMethodName:       scope.InventoryWrite,
+	pb.InventoryService_CompleteFulfillmentWave_FullMethodName:     scope.InventoryWrite,
+	pb.InventoryService_CancelFulfillmentWave_FullMethodName:       scope.InventoryWrite,
+	pb.InventoryService_SetRetailStore_FullMethodName:              scope.InventoryWrite,
+	pb.InventoryService_DeleteRetailStore_FullMethodName:           scope.InventoryWrite,
+	pb.InventoryService_RequestRefund_FullMethodName:               scope.OrdersWrite,
+	pb.InventoryService_ApproveRefund_FullMethodName:               scope.OrdersWrite,
+	pb.InventoryService_RejectRefund_FullMethodName:                scope.OrdersWrite,
+	pb.InventoryService_RecordRefundResult_FullMethodName:          scope.OrdersWrite,
+	pb.InventoryService_ReviewFraudCheck_FullMethodName:            scope.OrdersWrite,
+	pb.InventoryService_StartPickupPicking_FullMethodName:          scope.OrdersWrite,
+	pb.InventoryService_MarkPickupReady_FullMethodName:             scope.OrdersWrite,
+	pb.InventoryService_CollectPickupOrder_FullMethodName:          scope.OrdersWrite,
+}
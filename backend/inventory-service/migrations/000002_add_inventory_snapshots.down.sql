@@ -0,0 +1,7 @@
+-- Drop indexes
+DROP INDEX IF EXISTS idx_inventory_snapshots_snapshot_date;
+DROP INDEX IF EXISTS idx_inventory_snapshots_item_warehouse;
+DROP INDEX IF EXISTS idx_inventory_snapshots_item_total;
+
+-- Drop tables
+DROP TABLE IF EXISTS inventory_snapshots;
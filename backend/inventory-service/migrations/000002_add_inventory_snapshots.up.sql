@@ -0,0 +1,22 @@
+-- Create inventory_snapshots table
+-- One row per item per day with warehouse_id NULL holding the item totals, plus
+-- one row per item per warehouse per day
+CREATE TABLE inventory_snapshots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    snapshot_date DATE NOT NULL,
+    inventory_item_id UUID NOT NULL,
+    warehouse_id UUID,
+    quantity INT NOT NULL DEFAULT 0,
+    available_quantity INT NOT NULL DEFAULT 0,
+    reserved_quantity INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT fk_inventory_item_snapshot FOREIGN KEY (inventory_item_id) REFERENCES inventory_items(id) ON DELETE CASCADE,
+    CONSTRAINT fk_warehouse_snapshot FOREIGN KEY (warehouse_id) REFERENCES warehouses(id) ON DELETE CASCADE
+);
+
+-- Create indexes for performance
+CREATE UNIQUE INDEX idx_inventory_snapshots_item_total
+    ON inventory_snapshots(inventory_item_id, snapshot_date) WHERE warehouse_id IS NULL;
+CREATE UNIQUE INDEX idx_inventory_snapshots_item_warehouse
+    ON inventory_snapshots(inventory_item_id, warehouse_id, snapshot_date) WHERE warehouse_id IS NOT NULL;
+CREATE INDEX idx_inventory_snapshots_snapshot_date ON inventory_snapshots(snapshot_date);
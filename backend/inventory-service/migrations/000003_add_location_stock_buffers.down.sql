@@ -0,0 +1,5 @@
+-- Drop per-warehouse stock buffers
+ALTER TABLE inventory_locations
+    DROP CONSTRAINT IF EXISTS inventory_location_buffers_check,
+    DROP COLUMN IF EXISTS max_stock,
+    DROP COLUMN IF EXISTS safety_stock;
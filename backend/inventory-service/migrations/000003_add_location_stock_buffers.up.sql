@@ -0,0 +1,7 @@
+-- Add per-warehouse stock buffers to inventory_locations
+-- safety_stock is held back from sale at the location; max_stock is the
+-- capacity above which the location is overstocked, 0 meaning no maximum
+ALTER TABLE inventory_locations
+    ADD COLUMN safety_stock INT NOT NULL DEFAULT 0,
+    ADD COLUMN max_stock INT NOT NULL DEFAULT 0,
+    ADD CONSTRAINT inventory_location_buffers_check CHECK (safety_stock >= 0 AND max_stock >= 0);
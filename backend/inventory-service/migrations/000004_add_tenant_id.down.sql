@@ -0,0 +1,8 @@
+-- Drop tenant scoping of warehouses and inventory items
+DROP INDEX IF EXISTS idx_inventory_items_tenant_id;
+ALTER TABLE inventory_items DROP COLUMN IF EXISTS tenant_id;
+
+DROP INDEX IF EXISTS warehouses_tenant_code_key;
+ALTER TABLE warehouses
+    DROP COLUMN IF EXISTS tenant_id,
+    ADD CONSTRAINT warehouses_code_key UNIQUE (code);
@@ -0,0 +1,11 @@
+-- Scope warehouses and inventory items to the store (tenant) they belong to.
+-- Existing rows belong to the default store; warehouse codes become unique
+-- per store while SKUs stay globally unique like in the product service.
+ALTER TABLE warehouses
+    ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    DROP CONSTRAINT IF EXISTS warehouses_code_key;
+CREATE UNIQUE INDEX warehouses_tenant_code_key ON warehouses(tenant_id, code);
+
+ALTER TABLE inventory_items
+    ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+CREATE INDEX idx_inventory_items_tenant_id ON inventory_items(tenant_id);
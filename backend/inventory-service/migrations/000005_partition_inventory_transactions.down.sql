@@ -0,0 +1,27 @@
+-- Turn inventory_transactions back into a plain table
+-- Movements of archived partitions are not restored
+ALTER TABLE inventory_transactions RENAME TO inventory_transactions_partitioned;
+ALTER TABLE inventory_transactions_partitioned RENAME CONSTRAINT inventory_transactions_pkey TO inventory_transactions_partitioned_pkey;
+DROP INDEX IF EXISTS idx_inventory_transactions_inventory_item_id;
+DROP INDEX IF EXISTS idx_inventory_transactions_reference_id;
+
+CREATE TABLE inventory_transactions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    inventory_item_id UUID NOT NULL,
+    warehouse_id UUID,
+    transaction_type VARCHAR(50) NOT NULL,
+    quantity INT NOT NULL,
+    reference_id UUID,
+    reference_type VARCHAR(50),
+    notes TEXT,
+    created_by UUID,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT fk_inventory_item_transaction FOREIGN KEY (inventory_item_id) REFERENCES inventory_items(id) ON DELETE CASCADE,
+    CONSTRAINT fk_warehouse_transaction FOREIGN KEY (warehouse_id) REFERENCES warehouses(id) ON DELETE SET NULL
+);
+
+INSERT INTO inventory_transactions SELECT * FROM inventory_transactions_partitioned;
+DROP TABLE inventory_transactions_partitioned;
+
+CREATE INDEX idx_inventory_transactions_inventory_item_id ON inventory_transactions(inventory_item_id);
+CREATE INDEX idx_inventory_transactions_reference_id ON inventory_transactions(reference_id);
@@ -0,0 +1,62 @@
+-- Partition inventory_transactions by month of created_at
+-- Monthly partitions are named inventory_transactions_yYYYYmMM and created
+-- ahead of time by the partition maintenance job, which also archives the
+-- partitions past retention. The default partition only catches rows outside
+-- of them. The primary key must include the partition key, so created_at
+-- becomes required.
+ALTER TABLE inventory_transactions RENAME TO inventory_transactions_unpartitioned;
+ALTER TABLE inventory_transactions_unpartitioned RENAME CONSTRAINT inventory_transactions_pkey TO inventory_transactions_unpartitioned_pkey;
+DROP INDEX IF EXISTS idx_inventory_transactions_inventory_item_id;
+DROP INDEX IF EXISTS idx_inventory_transactions_reference_id;
+
+CREATE TABLE inventory_transactions (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    inventory_item_id UUID NOT NULL,
+    warehouse_id UUID,
+    transaction_type VARCHAR(50) NOT NULL,
+    quantity INT NOT NULL,
+    reference_id UUID,
+    reference_type VARCHAR(50),
+    notes TEXT,
+    created_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id, created_at),
+    CONSTRAINT fk_inventory_item_transaction FOREIGN KEY (inventory_item_id) REFERENCES inventory_items(id) ON DELETE CASCADE,
+    CONSTRAINT fk_warehouse_transaction FOREIGN KEY (warehouse_id) REFERENCES warehouses(id) ON DELETE SET NULL
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE inventory_transactions_default PARTITION OF inventory_transactions DEFAULT;
+
+-- UTC monthly partitions from the oldest movement to two months ahead
+DO $$
+DECLARE
+    month_start TIMESTAMP;
+BEGIN
+    FOR month_start IN
+        SELECT generate_series(
+            date_trunc('month', COALESCE((SELECT MIN(created_at) FROM inventory_transactions_unpartitioned), NOW()) AT TIME ZONE 'UTC'),
+            date_trunc('month', NOW() AT TIME ZONE 'UTC') + INTERVAL '2 months',
+            INTERVAL '1 month'
+        )
+    LOOP
+        EXECUTE format(
+            'CREATE TABLE %I PARTITION OF inventory_transactions FOR VALUES FROM (%L) TO (%L)',
+            'inventory_transactions_' || to_char(month_start, '"y"YYYY"m"MM'),
+            month_start AT TIME ZONE 'UTC',
+            (month_start + INTERVAL '1 month') AT TIME ZONE 'UTC'
+        );
+    END LOOP;
+END $$;
+
+INSERT INTO inventory_transactions (
+    id, inventory_item_id, warehouse_id, transaction_type, quantity,
+    reference_id, reference_type, notes, created_by, created_at
+)
+SELECT
+    id, inventory_item_id, warehouse_id, transaction_type, quantity,
+    reference_id, reference_type, notes, created_by, COALESCE(created_at, NOW())
+FROM inventory_transactions_unpartitioned;
+DROP TABLE inventory_transactions_unpartitioned;
+
+CREATE INDEX idx_inventory_transactions_inventory_item_id ON inventory_transactions(inventory_item_id);
+CREATE INDEX idx_inventory_transactions_reference_id ON inventory_transactions(reference_id);
@@ -0,0 +1,4 @@
+-- Drop the fulfillment provider integration tables
+DROP TABLE IF EXISTS order_status_events;
+DROP TABLE IF EXISTS fulfillment_events;
+DROP TABLE IF EXISTS integration_api_keys;
@@ -0,0 +1,45 @@
+-- API keys of fulfillment providers (3PLs) pushing events for a store. Only
+-- a SHA-256 hash of each key is stored; key_prefix identifies it in listings.
+CREATE TABLE integration_api_keys (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    provider VARCHAR(100) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    key_prefix VARCHAR(20) NOT NULL,
+    key_hash CHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+CREATE INDEX idx_integration_api_keys_tenant_id ON integration_api_keys(tenant_id);
+
+-- Events received from fulfillment providers. Each provider event ID is
+-- applied once; failed events may be pushed again.
+CREATE TABLE fulfillment_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    provider VARCHAR(100) NOT NULL,
+    event_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    error TEXT,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMPTZ
+);
+CREATE UNIQUE INDEX fulfillment_events_provider_event_key ON fulfillment_events(tenant_id, provider, event_id);
+
+-- Order status changes reported by fulfillment providers, read in ID order
+-- by the order system
+CREATE TABLE order_status_events (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    order_reference VARCHAR(255) NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    provider VARCHAR(100) NOT NULL,
+    carrier VARCHAR(100) NOT NULL DEFAULT '',
+    tracking_number VARCHAR(255) NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_order_status_events_tenant_id ON order_status_events(tenant_id, id);
+CREATE INDEX idx_order_status_events_order_reference ON order_status_events(tenant_id, order_reference);
@@ -0,0 +1,3 @@
+-- Drop the shipment tracking tables
+DROP TABLE IF EXISTS shipment_events;
+DROP TABLE IF EXISTS shipments;
@@ -0,0 +1,34 @@
+-- Shipments of orders, tracked by carrier and tracking number. status and
+-- delivered_at follow the latest tracking event.
+CREATE TABLE shipments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    order_reference VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255),
+    carrier VARCHAR(100) NOT NULL,
+    tracking_number VARCHAR(255) NOT NULL,
+    status VARCHAR(30) NOT NULL DEFAULT 'label_created',
+    shipped_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMPTZ,
+    last_polled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX shipments_tracking_number_key ON shipments(tenant_id, carrier, tracking_number);
+CREATE INDEX idx_shipments_order_reference ON shipments(tenant_id, order_reference);
+CREATE INDEX idx_shipments_user_id ON shipments(tenant_id, user_id);
+CREATE INDEX idx_shipments_poll ON shipments(carrier, last_polled_at) WHERE status NOT IN ('delivered', 'returned');
+
+-- Tracking events of shipments. Webhook redeliveries and overlapping polls
+-- report the same event again, so events are unique per status and time.
+CREATE TABLE shipment_events (
+    id BIGSERIAL PRIMARY KEY,
+    shipment_id UUID NOT NULL REFERENCES shipments(id) ON DELETE CASCADE,
+    status VARCHAR(30) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    location VARCHAR(255) NOT NULL DEFAULT '',
+    source VARCHAR(20) NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX shipment_events_status_key ON shipment_events(shipment_id, status, occurred_at);
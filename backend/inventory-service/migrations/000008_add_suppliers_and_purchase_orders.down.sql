@@ -0,0 +1,5 @@
+-- Drop the supplier and purchase order tables
+DROP TABLE IF EXISTS purchase_order_lines;
+DROP TABLE IF EXISTS purchase_orders;
+DROP TABLE IF EXISTS supplier_products;
+DROP TABLE IF EXISTS suppliers;
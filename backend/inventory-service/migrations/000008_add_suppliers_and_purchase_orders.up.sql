@@ -0,0 +1,56 @@
+-- Suppliers (vendors) the stores buy stock from
+CREATE TABLE suppliers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    phone VARCHAR(50) NOT NULL DEFAULT '',
+    currency CHAR(3) NOT NULL DEFAULT 'USD',
+    notes TEXT NOT NULL DEFAULT '',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX suppliers_tenant_name_key ON suppliers(tenant_id, name);
+
+-- Inventory items a supplier sells, with its cost price and lead time
+CREATE TABLE supplier_products (
+    supplier_id UUID NOT NULL REFERENCES suppliers(id) ON DELETE CASCADE,
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    supplier_sku VARCHAR(100) NOT NULL DEFAULT '',
+    cost_price NUMERIC(12, 2) NOT NULL,
+    lead_time_days INTEGER NOT NULL DEFAULT 0 CHECK (lead_time_days >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (supplier_id, inventory_item_id)
+);
+CREATE INDEX idx_supplier_products_inventory_item_id ON supplier_products(inventory_item_id);
+
+-- Purchase orders of stock from a supplier into a warehouse. status is open,
+-- partially_received, received or cancelled.
+CREATE TABLE purchase_orders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    supplier_id UUID NOT NULL REFERENCES suppliers(id),
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id),
+    status VARCHAR(30) NOT NULL DEFAULT 'open',
+    currency CHAR(3) NOT NULL DEFAULT 'USD',
+    notes TEXT NOT NULL DEFAULT '',
+    expected_at TIMESTAMPTZ,
+    received_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_purchase_orders_supplier_id ON purchase_orders(tenant_id, supplier_id);
+CREATE INDEX idx_purchase_orders_expected_at ON purchase_orders(tenant_id, expected_at)
+    WHERE status IN ('open', 'partially_received');
+
+CREATE TABLE purchase_order_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    purchase_order_id UUID NOT NULL REFERENCES purchase_orders(id) ON DELETE CASCADE,
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id),
+    quantity_ordered INTEGER NOT NULL CHECK (quantity_ordered > 0),
+    quantity_received INTEGER NOT NULL DEFAULT 0 CHECK (quantity_received >= 0),
+    unit_cost NUMERIC(12, 2) NOT NULL,
+    UNIQUE (purchase_order_id, inventory_item_id)
+);
@@ -0,0 +1,2 @@
+-- Drop the availability policies
+DROP TABLE IF EXISTS availability_policies;
@@ -0,0 +1,13 @@
+-- Availability thresholds of products, applied to all their inventory items
+-- when computing the stock badge customers see. Products without a row use
+-- the reorder point of their items and never backorder.
+CREATE TABLE availability_policies (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL,
+    low_stock_threshold INTEGER CHECK (low_stock_threshold >= 0),
+    out_of_stock_threshold INTEGER NOT NULL DEFAULT 0 CHECK (out_of_stock_threshold >= 0),
+    allow_backorder BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, product_id)
+);
@@ -0,0 +1,3 @@
+-- Drop the quotas of integration API keys
+DROP TABLE IF EXISTS integration_key_usage;
+ALTER TABLE integration_api_keys DROP COLUMN IF EXISTS daily_quota;
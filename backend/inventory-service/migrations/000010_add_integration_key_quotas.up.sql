@@ -0,0 +1,12 @@
+-- Daily request quotas of integration API keys; 0 leaves a key unlimited.
+-- Quotas are soft: requests over the quota are served and reported in the
+-- quota headers of the responses.
+ALTER TABLE integration_api_keys ADD COLUMN daily_quota INTEGER NOT NULL DEFAULT 0 CHECK (daily_quota >= 0);
+
+-- Requests made with each key per day (UTC)
+CREATE TABLE integration_key_usage (
+    key_id UUID NOT NULL REFERENCES integration_api_keys(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    requests INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (key_id, day)
+);
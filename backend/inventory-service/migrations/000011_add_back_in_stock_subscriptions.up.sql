@@ -0,0 +1,15 @@
+-- Shoppers waiting for a product, or one of its variants, to be back in
+-- stock. A subscription is emailed once and then kept with its notified_at;
+-- an empty variant_id waits for any variant of the product.
+CREATE TABLE back_in_stock_subscriptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id VARCHAR(255) NOT NULL,
+    variant_id VARCHAR(255) NOT NULL DEFAULT '',
+    email VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255),
+    notified_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE UNIQUE INDEX back_in_stock_subscriptions_pending_key ON back_in_stock_subscriptions(tenant_id, product_id, variant_id, email) WHERE notified_at IS NULL;
+CREATE INDEX idx_back_in_stock_subscriptions_user_id ON back_in_stock_subscriptions(tenant_id, user_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS inventory_reservation_lots;
+DROP TABLE IF EXISTS inventory_lots;
@@ -0,0 +1,32 @@
+-- Lots (batches) of an item received at a warehouse, for perishable goods.
+-- Lot tracking is optional: the units of a location not received into a lot
+-- are untracked. reserved_quantity is the part of the lot allocated to
+-- reservations; expired lots are written off once their unreserved units
+-- are removed from the location.
+CREATE TABLE inventory_lots (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id) ON DELETE CASCADE,
+    lot_number VARCHAR(100) NOT NULL,
+    expires_at TIMESTAMPTZ,
+    quantity INT NOT NULL DEFAULT 0,
+    reserved_quantity INT NOT NULL DEFAULT 0,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    written_off_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT inventory_lot_unique UNIQUE (inventory_item_id, warehouse_id, lot_number),
+    CONSTRAINT inventory_lot_quantities_check CHECK (quantity >= 0 AND reserved_quantity >= 0 AND reserved_quantity <= quantity)
+);
+CREATE INDEX idx_inventory_lots_fefo ON inventory_lots(inventory_item_id, warehouse_id, expires_at) WHERE quantity > 0;
+CREATE INDEX idx_inventory_lots_expires_at ON inventory_lots(tenant_id, expires_at) WHERE quantity > 0;
+
+-- Units of lots allocated to reservations, first expired first out, so that
+-- releasing a reservation returns them to the same lots
+CREATE TABLE inventory_reservation_lots (
+    reservation_id UUID NOT NULL REFERENCES inventory_reservations(id) ON DELETE CASCADE,
+    lot_id UUID NOT NULL REFERENCES inventory_lots(id) ON DELETE CASCADE,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (reservation_id, lot_id)
+);
+CREATE INDEX idx_inventory_reservation_lots_lot_id ON inventory_reservation_lots(lot_id);
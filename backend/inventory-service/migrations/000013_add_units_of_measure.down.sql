@@ -0,0 +1,7 @@
+ALTER TABLE inventory_transactions
+    DROP COLUMN IF EXISTS unit_quantity,
+    DROP COLUMN IF EXISTS unit_code;
+
+ALTER TABLE purchase_order_lines DROP COLUMN IF EXISTS unit_code;
+
+DROP TABLE IF EXISTS inventory_units;
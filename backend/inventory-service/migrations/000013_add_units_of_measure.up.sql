@@ -0,0 +1,22 @@
+-- Units of measure an item is counted in besides the base unit (each), such
+-- as a case of 12 or a pallet. factor is the number of base units in one
+-- unit; stock, reservations and availability are always kept in base units.
+CREATE TABLE inventory_units (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    code VARCHAR(20) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    factor INT NOT NULL CHECK (factor > 1),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT inventory_unit_unique UNIQUE (inventory_item_id, code)
+);
+
+-- Purchase order lines are ordered and received in a unit of the item
+ALTER TABLE purchase_order_lines ADD COLUMN unit_code VARCHAR(20) NOT NULL DEFAULT 'EA';
+
+-- The unit and quantity a movement was entered in, when not in base units
+ALTER TABLE inventory_transactions
+    ADD COLUMN unit_code VARCHAR(20),
+    ADD COLUMN unit_quantity INT;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_inventory_transactions_idempotency_key;
+ALTER TABLE inventory_transactions DROP COLUMN IF EXISTS idempotency_key;
+
+DROP TABLE IF EXISTS stock_mutation_keys;
@@ -0,0 +1,21 @@
+-- Idempotency keys of stock mutations, so that a retried adjustment is
+-- applied once. Unique constraints of the partitioned movements table must
+-- include created_at, so the keys are claimed here; the movement a key
+-- applied is tagged with it. result is the response of the mutation, NULL
+-- while it is being applied.
+CREATE TABLE stock_mutation_keys (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    idempotency_key VARCHAR(255) NOT NULL,
+    operation VARCHAR(50) NOT NULL,
+    request_hash CHAR(64) NOT NULL,
+    result JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+    PRIMARY KEY (tenant_id, idempotency_key)
+);
+
+CREATE INDEX idx_stock_mutation_keys_created_at ON stock_mutation_keys(created_at);
+
+ALTER TABLE inventory_transactions ADD COLUMN idempotency_key VARCHAR(255);
+CREATE INDEX idx_inventory_transactions_idempotency_key ON inventory_transactions(idempotency_key)
+    WHERE idempotency_key IS NOT NULL;
@@ -0,0 +1,2 @@
+ALTER TABLE inventory_locations DROP COLUMN IF EXISTS version;
+ALTER TABLE inventory_items DROP COLUMN IF EXISTS version;
@@ -0,0 +1,5 @@
+-- Row versions of items and locations for optimistic locking: every change
+-- of the quantities increments the version, and read-modify-write updates
+-- only apply while the row is still at the version they read
+ALTER TABLE inventory_items ADD COLUMN version INT NOT NULL DEFAULT 1;
+ALTER TABLE inventory_locations ADD COLUMN version INT NOT NULL DEFAULT 1;
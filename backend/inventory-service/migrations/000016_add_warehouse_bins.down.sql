@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS inventory_bin_stock;
+DROP TABLE IF EXISTS warehouse_bins;
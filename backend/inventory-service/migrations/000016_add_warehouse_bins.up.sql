@@ -0,0 +1,28 @@
+-- Bins of a warehouse, the storage positions addressed by zone, aisle, shelf
+-- and bin. code is the address joined with dashes, such as A-03-2-B.
+CREATE TABLE warehouse_bins (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id) ON DELETE CASCADE,
+    code VARCHAR(100) NOT NULL,
+    zone VARCHAR(20) NOT NULL,
+    aisle VARCHAR(20) NOT NULL,
+    shelf VARCHAR(20) NOT NULL,
+    bin VARCHAR(20) NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT warehouse_bin_unique UNIQUE (warehouse_id, zone, aisle, shelf, bin)
+);
+CREATE INDEX idx_warehouse_bins_tenant_id ON warehouse_bins(tenant_id, warehouse_id);
+
+-- Units of an item placed in a bin. The stock of a location is the total;
+-- bins hold part or all of it, the rest is not placed in a bin yet.
+CREATE TABLE inventory_bin_stock (
+    bin_id UUID NOT NULL REFERENCES warehouse_bins(id) ON DELETE RESTRICT,
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (bin_id, inventory_item_id)
+);
+CREATE INDEX idx_inventory_bin_stock_inventory_item_id ON inventory_bin_stock(inventory_item_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS fulfillment_wave_lines;
+DROP TABLE IF EXISTS fulfillment_waves;
@@ -0,0 +1,30 @@
+-- Waves batch the confirmed order reservations of a warehouse up to a
+-- carrier cutoff, to be picked together along one consolidated pick list
+CREATE TABLE fulfillment_waves (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id),
+    carrier VARCHAR(100) NOT NULL DEFAULT '',
+    cutoff_at TIMESTAMPTZ NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ
+);
+CREATE INDEX idx_fulfillment_waves_tenant_id ON fulfillment_waves(tenant_id, warehouse_id, created_at DESC);
+
+-- Order lines of a wave, one per reservation. A reservation is in at most
+-- one wave that was not cancelled.
+CREATE TABLE fulfillment_wave_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wave_id UUID NOT NULL REFERENCES fulfillment_waves(id) ON DELETE CASCADE,
+    reservation_id UUID NOT NULL REFERENCES inventory_reservations(id) ON DELETE CASCADE,
+    order_reference VARCHAR(255) NOT NULL,
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    picked_quantity INT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_fulfillment_wave_lines_wave_id ON fulfillment_wave_lines(wave_id);
+CREATE UNIQUE INDEX fulfillment_wave_lines_reservation_key
+    ON fulfillment_wave_lines(reservation_id) WHERE status <> 'cancelled';
@@ -0,0 +1,53 @@
+-- Refunds of the payments of orders. Orders and payments live outside this
+-- service and are referenced by their references. A refund is requested,
+-- approved or rejected by staff, and processed or failed by the payment
+-- system; returned goods are restocked when it is approved.
+CREATE TABLE refunds (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    order_reference VARCHAR(255) NOT NULL,
+    payment_reference VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL DEFAULT '',
+    refund_type VARCHAR(20) NOT NULL,
+    amount NUMERIC(12, 2) NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL DEFAULT 'USD',
+    reason TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'requested',
+    requested_by VARCHAR(255) NOT NULL DEFAULT '',
+    reviewed_by VARCHAR(255) NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMPTZ,
+    provider_reference VARCHAR(255) NOT NULL DEFAULT '',
+    failure_reason TEXT NOT NULL DEFAULT '',
+    processed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_refunds_tenant_id ON refunds(tenant_id, status, created_at DESC);
+CREATE INDEX idx_refunds_order_reference ON refunds(tenant_id, order_reference);
+
+-- Items of a refund; restocked lines are put back at their warehouse
+CREATE TABLE refund_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    refund_id UUID NOT NULL REFERENCES refunds(id) ON DELETE CASCADE,
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    restock BOOLEAN NOT NULL DEFAULT FALSE,
+    warehouse_id UUID REFERENCES warehouses(id)
+);
+CREATE INDEX idx_refund_lines_refund_id ON refund_lines(refund_id);
+
+-- Changes of the status of refunds, read in ID order by the notification
+-- and accounting exports
+CREATE TABLE refund_events (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    refund_id UUID NOT NULL REFERENCES refunds(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    order_reference VARCHAR(255) NOT NULL,
+    payment_reference VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL DEFAULT '',
+    amount NUMERIC(12, 2) NOT NULL,
+    currency CHAR(3) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_refund_events_tenant_id ON refund_events(tenant_id, id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS fraud_events;
+DROP TABLE IF EXISTS fraud_checks;
@@ -0,0 +1,43 @@
+-- Fraud screenings of orders at checkout. Orders live outside this service
+-- and are referenced by their references; an order is screened once. Orders
+-- sent to review wait in the queue until staff approve or decline them.
+CREATE TABLE fraud_checks (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    order_reference VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL DEFAULT '',
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    billing_country CHAR(2) NOT NULL DEFAULT '',
+    shipping_country CHAR(2) NOT NULL DEFAULT '',
+    amount NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    currency CHAR(3) NOT NULL DEFAULT 'USD',
+    score INT NOT NULL DEFAULT 0,
+    decision VARCHAR(20) NOT NULL,
+    -- Rules that scored the order: [{"rule": "...", "points": 0, "reason": "..."}]
+    signals JSONB NOT NULL DEFAULT '[]',
+    reviewed_by VARCHAR(255) NOT NULL DEFAULT '',
+    reviewed_at TIMESTAMPTZ,
+    review_notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, order_reference)
+);
+CREATE INDEX idx_fraud_checks_tenant_id ON fraud_checks(tenant_id, decision, created_at DESC);
+-- Velocity rules count the recent orders of a customer
+CREATE INDEX idx_fraud_checks_email ON fraud_checks(tenant_id, email, created_at) WHERE email <> '';
+CREATE INDEX idx_fraud_checks_ip_address ON fraud_checks(tenant_id, ip_address, created_at) WHERE ip_address <> '';
+
+-- Decisions on orders, read in ID order by the order system and external
+-- fraud providers
+CREATE TABLE fraud_events (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    fraud_check_id UUID NOT NULL REFERENCES fraud_checks(id) ON DELETE CASCADE,
+    order_reference VARCHAR(255) NOT NULL,
+    decision VARCHAR(20) NOT NULL,
+    score INT NOT NULL,
+    reviewed_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_fraud_events_tenant_id ON fraud_events(tenant_id, id);
@@ -0,0 +1,18 @@
+-- Physical stores, the warehouses customers can visit: their position for
+-- the store locator, opening hours in the local time of the store, as a JSON
+-- array of {weekday, opens, closes}, and whether stock can be reserved there
+-- for in-store pickup, held for hold_hours.
+CREATE TABLE retail_stores (
+    warehouse_id UUID PRIMARY KEY REFERENCES warehouses(id) ON DELETE CASCADE,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    latitude DOUBLE PRECISION NOT NULL CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION NOT NULL CHECK (longitude BETWEEN -180 AND 180),
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    phone VARCHAR(30) NOT NULL DEFAULT '',
+    opening_hours JSONB NOT NULL DEFAULT '[]',
+    pickup_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    hold_hours INT NOT NULL DEFAULT 48 CHECK (hold_hours > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+CREATE INDEX idx_retail_stores_tenant_id ON retail_stores(tenant_id);
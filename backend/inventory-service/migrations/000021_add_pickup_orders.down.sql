@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS pickup_order_lines;
+DROP TABLE IF EXISTS pickup_orders;
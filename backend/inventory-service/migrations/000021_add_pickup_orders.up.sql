@@ -0,0 +1,35 @@
+-- Click-and-collect orders, placed online and collected at a retail store.
+-- Orders live outside this service and are referenced by their references.
+-- The lines are reserved at the store at checkout; the reservations are
+-- consumed when the order is collected and released when it is cancelled,
+-- including when it is not collected by collect_by.
+CREATE TABLE pickup_orders (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    order_reference VARCHAR(255) NOT NULL,
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id),
+    user_id VARCHAR(255) NOT NULL DEFAULT '',
+    email VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    collect_by TIMESTAMPTZ,
+    ready_at TIMESTAMPTZ,
+    collected_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ,
+    cancel_reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT pickup_order_reference_unique UNIQUE (tenant_id, order_reference)
+);
+CREATE INDEX idx_pickup_orders_store ON pickup_orders(tenant_id, warehouse_id, status, created_at);
+CREATE INDEX idx_pickup_orders_user_id ON pickup_orders(tenant_id, user_id);
+CREATE INDEX idx_pickup_orders_collect_by ON pickup_orders(collect_by) WHERE status = 'ready';
+
+-- Items of a pickup order and the reservations holding them at the store
+CREATE TABLE pickup_order_lines (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    pickup_order_id UUID NOT NULL REFERENCES pickup_orders(id) ON DELETE CASCADE,
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    reservation_id UUID NOT NULL REFERENCES inventory_reservations(id)
+);
+CREATE INDEX idx_pickup_order_lines_order_id ON pickup_order_lines(pickup_order_id);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_inventory_reservations_sales;
+DROP TABLE IF EXISTS inventory_forecasts;
@@ -0,0 +1,27 @@
+-- Stock forecasts of each item at each warehouse, recomputed nightly from
+-- the sales velocity of the last 7 and 28 days. stockout_date is NULL for
+-- items without sales; reorder_quantity is the quantity suggested to order
+-- to cover the supplier lead time and review period.
+CREATE TABLE inventory_forecasts (
+    inventory_item_id UUID NOT NULL REFERENCES inventory_items(id) ON DELETE CASCADE,
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id) ON DELETE CASCADE,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    short_velocity DOUBLE PRECISION NOT NULL DEFAULT 0,
+    long_velocity DOUBLE PRECISION NOT NULL DEFAULT 0,
+    daily_velocity DOUBLE PRECISION NOT NULL DEFAULT 0,
+    available_quantity INT NOT NULL DEFAULT 0,
+    on_order_quantity INT NOT NULL DEFAULT 0,
+    safety_stock INT NOT NULL DEFAULT 0,
+    lead_time_days INT NOT NULL DEFAULT 0,
+    days_of_cover DOUBLE PRECISION NOT NULL DEFAULT -1,
+    stockout_date TIMESTAMPTZ,
+    reorder_quantity INT NOT NULL DEFAULT 0,
+    computed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (inventory_item_id, warehouse_id)
+);
+CREATE INDEX idx_inventory_forecasts_reorder ON inventory_forecasts(tenant_id, stockout_date)
+    WHERE reorder_quantity > 0;
+
+-- Sales velocity is read from the reservations confirmed in the window
+CREATE INDEX idx_inventory_reservations_sales ON inventory_reservations(inventory_item_id, created_at)
+    WHERE status IN ('CONFIRMED', 'FULFILLED');
@@ -0,0 +1 @@
+ALTER TABLE pickup_order_lines DROP COLUMN IF EXISTS personalization;
@@ -0,0 +1,8 @@
+-- Personalization of pickup order lines, such as an engraving text or
+-- uploaded artwork: a JSON array of the values the buyer gave for the
+-- personalization options of the product, shown to the staff picking the
+-- order
+ALTER TABLE pickup_order_lines
+    ADD COLUMN personalization JSONB NOT NULL DEFAULT '[]'::jsonb,
+    ADD CONSTRAINT pickup_order_lines_personalization_check
+        CHECK (jsonb_typeof(personalization) = 'array');
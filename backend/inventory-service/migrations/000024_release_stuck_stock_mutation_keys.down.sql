@@ -0,0 +1,2 @@
+-- Released idempotency keys are not restored
+SELECT 1;
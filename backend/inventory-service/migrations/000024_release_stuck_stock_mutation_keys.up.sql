@@ -0,0 +1,10 @@
+-- Idempotency keys are now recorded in the transaction that applies their
+-- stock mutation, together with its result. Keys claimed before whose
+-- mutation never recorded a movement were not applied, so they are released
+-- for their retries; the others stay as applied mutations without a result.
+DELETE FROM stock_mutation_keys k
+WHERE k.result IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM inventory_transactions t
+        WHERE t.idempotency_key = k.idempotency_key
+    );
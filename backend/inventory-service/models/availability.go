@@ -0,0 +1,79 @@
+package models
+
+import (
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
+)
+
+// Availability alternative types
+const (
+	AlternativeOtherWarehouse  = "OTHER_WAREHOUSE"
+	AlternativeReducedQuantity = "REDUCED_QUANTITY"
+)
+
+// AvailabilityStatusNotFound marks lines whose SKU has no inventory item
+const AvailabilityStatusNotFound = "NOT_FOUND"
+
+// BulkAvailabilityLine is a single cart or checkout line to check. When
+// WarehouseID is nil the line is checked against stock across all warehouses.
+type BulkAvailabilityLine struct {
+	SKU         string  `json:"sku"`
+	Quantity    int     `json:"quantity"`
+	WarehouseID *string `json:"warehouse_id,omitempty"`
+}
+
+// AvailabilityAlternative suggests how a line that cannot be fulfilled as
+// requested could still be fulfilled
+type AvailabilityAlternative struct {
+	Type              string  `json:"type"`
+	WarehouseID       *string `json:"warehouse_id,omitempty"`
+	WarehouseName     string  `json:"warehouse_name,omitempty"`
+	Quantity          int     `json:"quantity"`
+	AvailableQuantity int     `json:"available_quantity"`
+}
+
+// BulkAvailabilityResult is the availability of one requested line
+type BulkAvailabilityResult struct {
+	LineIndex         int                       `json:"line_index"`
+	SKU               string                    `json:"sku"`
+	ProductID         string                    `json:"product_id"`
+	VariantID         *string                   `json:"variant_id,omitempty"`
+	WarehouseID       *string                   `json:"warehouse_id,omitempty"`
+	RequestedQuantity int                       `json:"requested_quantity"`
+	AvailableQuantity int                       `json:"available_quantity"`
+	IsAvailable       bool                      `json:"is_available"`
+	Status            string                    `json:"status"`
+	Availability      string                    `json:"availability"`
+	Alternatives      []AvailabilityAlternative `json:"alternatives,omitempty"`
+}
+
+// AvailabilityPolicy holds the availability thresholds of a product, applied
+// to all its inventory items. Products without a policy use the zero policy.
+type AvailabilityPolicy struct {
+	ProductID           string    `json:"product_id" db:"product_id"`
+	LowStockThreshold   *int      `json:"low_stock_threshold,omitempty" db:"low_stock_threshold"`
+	OutOfStockThreshold int       `json:"out_of_stock_threshold" db:"out_of_stock_threshold"`
+	AllowBackorder      bool      `json:"allow_backorder" db:"allow_backorder"`
+	UpdatedAt           time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Rules returns the thresholds of the policy
+func (p *AvailabilityPolicy) Rules() availability.Policy {
+	return availability.Policy{
+		LowStockThreshold:   p.LowStockThreshold,
+		OutOfStockThreshold: p.OutOfStockThreshold,
+		AllowBackorder:      p.AllowBackorder,
+	}
+}
+
+// StockOf returns the stock of an item its badge is computed from.
+// Locations must be loaded for safety stock to be held back.
+func StockOf(item *InventoryItem) availability.Stock {
+	return availability.Stock{
+		Sellable:     item.SellableQuantity(),
+		ReorderPoint: item.ReorderPoint,
+		Discontinued: item.Status == StatusDiscontinued,
+		Backordered:  item.Status == StatusBackordered,
+	}
+}
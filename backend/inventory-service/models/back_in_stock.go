@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	// ErrBackInStockSubscriptionNotFound is returned for unknown subscriptions
+	// and for subscriptions of other users
+	ErrBackInStockSubscriptionNotFound = apperrors.New(apperrors.ErrNotFound, "back-in-stock subscription not found")
+	// ErrProductInStock is returned when subscribing to a product that can be
+	// bought already
+	ErrProductInStock = apperrors.New(apperrors.ErrFailedPrecondition, "product is in stock")
+)
+
+// BackInStockSubscription is a shopper waiting for a product, or one of its
+// variants, to be back in stock. It is notified once.
+type BackInStockSubscription struct {
+	ID         string     `json:"id" db:"id"`
+	ProductID  string     `json:"product_id" db:"product_id"`
+	VariantID  string     `json:"variant_id,omitempty" db:"variant_id"`
+	Email      string     `json:"email" db:"email"`
+	UserID     string     `json:"user_id,omitempty" db:"user_id"`
+	NotifiedAt *time.Time `json:"notified_at,omitempty" db:"notified_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+
+	// TenantID is only set on the subscriptions claimed for notification,
+	// which span all stores
+	TenantID string `json:"-" db:"tenant_id"`
+}
+
+// BackInStockFilter selects the subscriptions of a list
+type BackInStockFilter struct {
+	UserID      string
+	ProductID   string
+	PendingOnly bool
+}
@@ -0,0 +1,74 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	// ErrBinNotFound is returned for a bin that does not exist in the store
+	ErrBinNotFound = apperrors.New(apperrors.ErrNotFound, "bin not found")
+	// ErrBinNotEmpty is returned when deleting a bin that still holds stock
+	ErrBinNotEmpty = apperrors.New(apperrors.ErrFailedPrecondition, "bin still holds stock")
+	// ErrBinInactive is returned when placing stock in an inactive bin
+	ErrBinInactive = apperrors.New(apperrors.ErrFailedPrecondition, "bin is inactive")
+	// ErrBinStockExceedsLocation is returned when the bins of an item would
+	// hold more units than its location at the warehouse
+	ErrBinStockExceedsLocation = apperrors.New(apperrors.ErrFailedPrecondition, "bins would hold more units than the location stock")
+)
+
+// WarehouseBin is a storage position of a warehouse, addressed by zone,
+// aisle, shelf and bin
+type WarehouseBin struct {
+	ID          string    `json:"id" db:"id"`
+	WarehouseID string    `json:"warehouse_id" db:"warehouse_id"`
+	Code        string    `json:"code" db:"code"`
+	Zone        string    `json:"zone" db:"zone"`
+	Aisle       string    `json:"aisle" db:"aisle"`
+	Shelf       string    `json:"shelf" db:"shelf"`
+	Bin         string    `json:"bin" db:"bin"`
+	IsActive    bool      `json:"is_active" db:"is_active"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// BinCode joins the address of a bin, such as A-03-2-B
+func BinCode(zone, aisle, shelf, bin string) string {
+	return strings.Join([]string{zone, aisle, shelf, bin}, "-")
+}
+
+// BinStock is the units of an item placed in a bin
+type BinStock struct {
+	Bin             WarehouseBin `json:"bin"`
+	InventoryItemID string       `json:"inventory_item_id" db:"inventory_item_id"`
+	SKU             string       `json:"sku" db:"sku"`
+	Quantity        int          `json:"quantity" db:"quantity"`
+	UpdatedAt       time.Time    `json:"updated_at" db:"updated_at"`
+}
+
+// PickListLine is a quantity of an item to pick at a warehouse
+type PickListLine struct {
+	InventoryItemID string `json:"inventory_item_id"`
+	Quantity        int    `json:"quantity"`
+}
+
+// Pick is a stop of a pick list: the units of an item to take from a bin
+type Pick struct {
+	// Sequence is the position of the stop on the pick path, from 1
+	Sequence        int          `json:"sequence"`
+	Bin             WarehouseBin `json:"bin"`
+	InventoryItemID string       `json:"inventory_item_id"`
+	SKU             string       `json:"sku"`
+	Quantity        int          `json:"quantity"`
+}
+
+// PickList is the stops to pick the lines of an order at a warehouse in
+// walking order. Shortages are the units of lines that are not placed in any
+// active bin.
+type PickList struct {
+	WarehouseID string         `json:"warehouse_id"`
+	Picks       []Pick         `json:"picks"`
+	Shortages   []PickListLine `json:"shortages"`
+}
@@ -1,21 +1,24 @@
 package models
 
 import (
-	"errors"
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
 )
 
 // Common errors
 var (
-	ErrNotFound                = errors.New("resource not found")
-	ErrAlreadyExists           = errors.New("resource already exists")
-	ErrInvalidInput            = errors.New("invalid input")
-	ErrInsufficientInventory   = errors.New("insufficient inventory")
-	ErrReservationExpired      = errors.New("reservation expired")
-	ErrReservationNotFound     = errors.New("reservation not found")
-	ErrReservationInvalidState = errors.New("reservation in invalid state")
-	ErrWarehouseNotFound       = errors.New("warehouse not found")
-	ErrWarehouseInactive       = errors.New("warehouse is inactive")
-	ErrInternalError           = errors.New("internal server error")
-	ErrInvalidQuantity         = errors.New("invalid quantity")
-	ErrDatabaseError           = errors.New("database error")
+	ErrNotFound                = apperrors.New(apperrors.ErrNotFound, "resource not found")
+	ErrAlreadyExists           = apperrors.New(apperrors.ErrAlreadyExists, "resource already exists")
+	ErrInvalidInput            = apperrors.New(apperrors.ErrInvalidArgument, "invalid input")
+	ErrInsufficientInventory   = apperrors.New(apperrors.ErrFailedPrecondition, "insufficient inventory")
+	ErrReservationExpired      = apperrors.New(apperrors.ErrFailedPrecondition, "reservation expired")
+	ErrReservationNotFound     = apperrors.New(apperrors.ErrNotFound, "reservation not found")
+	ErrReservationInvalidState = apperrors.New(apperrors.ErrFailedPrecondition, "reservation in invalid state")
+	ErrWarehouseNotFound       = apperrors.New(apperrors.ErrNotFound, "warehouse not found")
+	ErrWarehouseInactive       = apperrors.New(apperrors.ErrFailedPrecondition, "warehouse is inactive")
+	ErrInternalError           = apperrors.New(apperrors.ErrInternal, "internal server error")
+	ErrInvalidQuantity         = apperrors.New(apperrors.ErrInvalidArgument, "invalid quantity")
+	ErrDatabaseError           = apperrors.New(apperrors.ErrInternal, "database error")
+	// ErrConcurrentUpdate is returned when a row changed between being read
+	// and written; the change can be retried from a fresh read
+	ErrConcurrentUpdate = apperrors.New(apperrors.ErrConflict, "inventory was changed concurrently, please retry")
 )
@@ -0,0 +1,111 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// ErrForecastNotFound is returned for an item whose forecast was not
+// computed yet
+var ErrForecastNotFound = apperrors.New(apperrors.ErrNotFound, "forecast not found")
+
+// Sales velocity windows, in days. The velocity weighs the last week as much
+// as the last four weeks, so it follows changes in demand without chasing
+// one-off spikes.
+const (
+	ShortVelocityDays = 7
+	LongVelocityDays  = 28
+)
+
+// ForecastInput holds the stock and recent sales of an item at a warehouse a
+// forecast is computed from. Sales are the units of confirmed and fulfilled
+// reservations; units reserved without a warehouse are shared between the
+// locations of the item by Share.
+type ForecastInput struct {
+	InventoryItemID   string
+	ProductID         string
+	SKU               string
+	WarehouseID       string
+	AvailableQuantity int
+	SafetyStock       int
+	// OnOrderQuantity is the units of open purchase orders not received
+	// yet at the warehouse
+	OnOrderQuantity int
+	// LeadTimeDays is the shortest lead time of the suppliers of the item
+	LeadTimeDays    int
+	ShortSold       int
+	LongSold        int
+	UnassignedShort int
+	UnassignedLong  int
+	Share           float64
+	TenantID        string
+}
+
+// InventoryForecast projects when an item runs out at a warehouse at its
+// sales velocity, and how much to reorder to cover the supplier lead time
+// and the review period
+type InventoryForecast struct {
+	InventoryItemID   string  `json:"inventory_item_id" db:"inventory_item_id"`
+	ProductID         string  `json:"product_id" db:"product_id"`
+	SKU               string  `json:"sku" db:"sku"`
+	WarehouseID       string  `json:"warehouse_id" db:"warehouse_id"`
+	ShortVelocity     float64 `json:"short_velocity" db:"short_velocity"`
+	LongVelocity      float64 `json:"long_velocity" db:"long_velocity"`
+	DailyVelocity     float64 `json:"daily_velocity" db:"daily_velocity"`
+	AvailableQuantity int     `json:"available_quantity" db:"available_quantity"`
+	OnOrderQuantity   int     `json:"on_order_quantity" db:"on_order_quantity"`
+	SafetyStock       int     `json:"safety_stock" db:"safety_stock"`
+	LeadTimeDays      int     `json:"lead_time_days" db:"lead_time_days"`
+	// DaysOfCover is how many days the available quantity lasts, -1 without
+	// sales
+	DaysOfCover float64 `json:"days_of_cover" db:"days_of_cover"`
+	// StockoutDate is when the available quantity runs out, nil without
+	// sales
+	StockoutDate *time.Time `json:"stockout_date,omitempty" db:"stockout_date"`
+	// ReorderQuantity is the suggested quantity to order now, 0 when the
+	// stock and open orders cover the lead time and review period
+	ReorderQuantity int       `json:"reorder_quantity" db:"reorder_quantity"`
+	ComputedAt      time.Time `json:"computed_at" db:"computed_at"`
+
+	// TenantID is only set on the forecasts computed by the forecasting job,
+	// which spans all stores
+	TenantID string `json:"-" db:"tenant_id"`
+}
+
+// Forecast computes the forecast of the input at now. The suggested reorder
+// quantity covers the sales expected over the lead time and reviewDays, plus
+// the safety stock, less the available and on-order units.
+func (in ForecastInput) Forecast(now time.Time, reviewDays int) InventoryForecast {
+	short := (float64(in.ShortSold) + float64(in.UnassignedShort)*in.Share) / ShortVelocityDays
+	long := (float64(in.LongSold) + float64(in.UnassignedLong)*in.Share) / LongVelocityDays
+	velocity := (short + long) / 2
+
+	forecast := InventoryForecast{
+		InventoryItemID:   in.InventoryItemID,
+		ProductID:         in.ProductID,
+		SKU:               in.SKU,
+		WarehouseID:       in.WarehouseID,
+		ShortVelocity:     short,
+		LongVelocity:      long,
+		DailyVelocity:     velocity,
+		AvailableQuantity: in.AvailableQuantity,
+		OnOrderQuantity:   in.OnOrderQuantity,
+		SafetyStock:       in.SafetyStock,
+		LeadTimeDays:      in.LeadTimeDays,
+		DaysOfCover:       CalculateDaysOfCover(in.AvailableQuantity, velocity),
+		ComputedAt:        now,
+		TenantID:          in.TenantID,
+	}
+	if velocity <= 0 {
+		return forecast
+	}
+
+	stockout := now.Add(time.Duration(max(forecast.DaysOfCover, 0) * 24 * float64(time.Hour)))
+	forecast.StockoutDate = &stockout
+
+	demand := int(math.Ceil(velocity * float64(in.LeadTimeDays+reviewDays)))
+	forecast.ReorderQuantity = max(demand+in.SafetyStock-in.AvailableQuantity-in.OnOrderQuantity, 0)
+	return forecast
+}
@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	// ErrFraudCheckNotFound is returned for a fraud check that does not
+	// exist in the store
+	ErrFraudCheckNotFound = apperrors.New(apperrors.ErrNotFound, "fraud check not found")
+	// ErrFraudCheckNotInReview is returned when reviewing an order that is
+	// not waiting for review
+	ErrFraudCheckNotInReview = apperrors.New(apperrors.ErrFailedPrecondition, "order is not waiting for fraud review")
+)
+
+// Fraud decisions. Orders in review wait for staff to approve or decline
+// them.
+const (
+	FraudApprove = "approve"
+	FraudReview  = "review"
+	FraudDecline = "decline"
+)
+
+// FraudCheck is the fraud screening of an order
+type FraudCheck struct {
+	ID              string        `json:"id" db:"id"`
+	OrderReference  string        `json:"order_reference" db:"order_reference"`
+	UserID          string        `json:"user_id" db:"user_id"`
+	Email           string        `json:"email" db:"email"`
+	IPAddress       string        `json:"ip_address" db:"ip_address"`
+	BillingCountry  string        `json:"billing_country" db:"billing_country"`
+	ShippingCountry string        `json:"shipping_country" db:"shipping_country"`
+	Amount          float64       `json:"amount" db:"amount"`
+	Currency        string        `json:"currency" db:"currency"`
+	Score           int           `json:"score" db:"score"`
+	Decision        string        `json:"decision" db:"decision"`
+	Signals         []FraudSignal `json:"signals" db:"signals"`
+	ReviewedBy      string        `json:"reviewed_by" db:"reviewed_by"`
+	ReviewedAt      *time.Time    `json:"reviewed_at" db:"reviewed_at"`
+	ReviewNotes     string        `json:"review_notes" db:"review_notes"`
+	CreatedAt       time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at" db:"updated_at"`
+}
+
+// FraudSignal is the score a fraud rule gave an order and why
+type FraudSignal struct {
+	Rule   string `json:"rule"`
+	Points int    `json:"points"`
+	Reason string `json:"reason"`
+}
+
+// FraudCheckFilter selects fraud checks
+type FraudCheckFilter struct {
+	Decision       string
+	OrderReference string
+}
+
+// FraudEvent is a decision on an order, in the order it was made
+type FraudEvent struct {
+	ID             int64     `json:"id" db:"id"`
+	FraudCheckID   string    `json:"fraud_check_id" db:"fraud_check_id"`
+	OrderReference string    `json:"order_reference" db:"order_reference"`
+	Decision       string    `json:"decision" db:"decision"`
+	Score          int       `json:"score" db:"score"`
+	ReviewedBy     string    `json:"reviewed_by" db:"reviewed_by"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,134 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// Errors of the fulfillment provider integration
+var (
+	ErrInvalidIntegrationKey  = apperrors.New(apperrors.ErrUnauthenticated, "invalid integration API key")
+	ErrIntegrationKeyNotFound = apperrors.New(apperrors.ErrNotFound, "integration API key not found")
+	ErrInvalidQuota           = apperrors.New(apperrors.ErrInvalidArgument, "daily quota cannot be negative")
+)
+
+// IntegrationKeyPrefix starts the API keys of fulfillment providers
+const IntegrationKeyPrefix = "3pl_"
+
+// IntegrationKey is an API key a fulfillment provider (3PL) pushes events
+// for a store with. The key itself is only returned when it is created.
+type IntegrationKey struct {
+	ID         string     `json:"id" db:"id"`
+	Provider   string     `json:"provider" db:"provider"`
+	Name       string     `json:"name" db:"name"`
+	KeyPrefix  string     `json:"key_prefix" db:"key_prefix"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty" db:"last_used_at"`
+	RevokedAt  *time.Time `json:"revoked_at,omitempty" db:"revoked_at"`
+
+	// DailyQuota is the number of requests the key may make per day (UTC);
+	// 0 is unlimited. Requests over the quota are still served.
+	DailyQuota int `json:"daily_quota" db:"daily_quota"`
+	// UsedToday is the number of requests made with the key today
+	UsedToday int `json:"used_today" db:"-"`
+}
+
+// QuotaRemaining returns the requests left today under the daily quota of
+// the key, 0 once it is used up, and false when the key is unlimited
+func (k *IntegrationKey) QuotaRemaining() (int, bool) {
+	if k.DailyQuota == 0 {
+		return 0, false
+	}
+	if k.UsedToday >= k.DailyQuota {
+		return 0, true
+	}
+	return k.DailyQuota - k.UsedToday, true
+}
+
+// QuotaResetAt returns when the usage of the day of now ends: the next
+// midnight UTC
+func QuotaResetAt(now time.Time) time.Time {
+	y, m, d := now.UTC().Date()
+	return time.Date(y, m, d+1, 0, 0, 0, 0, time.UTC)
+}
+
+// Types of fulfillment events
+const (
+	// FulfillmentStockUpdated reports the stock of a SKU at a warehouse
+	FulfillmentStockUpdated = "stock.updated"
+	// FulfillmentShipmentConfirmed reports that the lines of an order left a
+	// warehouse
+	FulfillmentShipmentConfirmed = "shipment.confirmed"
+)
+
+// Modes of stock updates
+const (
+	// StockUpdateSet reports the on-hand quantity counted by the provider
+	StockUpdateSet = "set"
+	// StockUpdateAdjust reports a change of the quantity
+	StockUpdateAdjust = "adjust"
+)
+
+// Statuses of fulfillment events
+const (
+	FulfillmentEventProcessing = "processing"
+	FulfillmentEventApplied    = "applied"
+	FulfillmentEventDuplicate  = "duplicate"
+	FulfillmentEventFailed     = "failed"
+)
+
+// Reference types of the inventory transactions of fulfillment events
+const (
+	ReferenceFulfillmentStockUpdate = "FULFILLMENT_STOCK_UPDATE"
+	ReferenceFulfillmentShipment    = "FULFILLMENT_SHIPMENT"
+)
+
+// OrderStatusShipped is the order status of confirmed shipments
+const OrderStatusShipped = "shipped"
+
+// FulfillmentLine is a quantity of a SKU in a shipment
+type FulfillmentLine struct {
+	SKU      string `json:"sku"`
+	Quantity int    `json:"quantity"`
+}
+
+// FulfillmentEvent is an event pushed by a fulfillment provider
+type FulfillmentEvent struct {
+	// ID is the provider's ID of the event
+	ID            string    `json:"id"`
+	Type          string    `json:"type"`
+	WarehouseCode string    `json:"warehouse_code"`
+	OccurredAt    time.Time `json:"occurred_at"`
+
+	// SKU, Quantity and Mode describe stock updates
+	SKU      string `json:"sku,omitempty"`
+	Quantity int    `json:"quantity,omitempty"`
+	Mode     string `json:"mode,omitempty"`
+
+	// OrderReference, Carrier, TrackingNumber and Lines describe shipments
+	OrderReference string            `json:"order_reference,omitempty"`
+	Carrier        string            `json:"carrier,omitempty"`
+	TrackingNumber string            `json:"tracking_number,omitempty"`
+	Lines          []FulfillmentLine `json:"lines,omitempty"`
+}
+
+// FulfillmentEventResult is the outcome of a pushed event
+type FulfillmentEventResult struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// OrderStatusEvent is an order status change reported by a fulfillment
+// provider, for the order system to apply
+type OrderStatusEvent struct {
+	ID             int64     `json:"id" db:"id"`
+	OrderReference string    `json:"order_reference" db:"order_reference"`
+	Status         string    `json:"status" db:"status"`
+	Provider       string    `json:"provider" db:"provider"`
+	Carrier        string    `json:"carrier" db:"carrier"`
+	TrackingNumber string    `json:"tracking_number" db:"tracking_number"`
+	OccurredAt     time.Time `json:"occurred_at" db:"occurred_at"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
@@ -6,20 +6,25 @@ import (
 
 // InventoryItem represents the main inventory record for a product or variant
 type InventoryItem struct {
-	ID                string              `json:"id" db:"id"`
-	ProductID         string              `json:"product_id" db:"product_id"`
-	VariantID         *string             `json:"variant_id,omitempty" db:"variant_id"`
-	SKU               string              `json:"sku" db:"sku"`
-	TotalQuantity     int                 `json:"total_quantity" db:"total_quantity"`
-	AvailableQuantity int                 `json:"available_quantity" db:"available_quantity"`
-	ReservedQuantity  int                 `json:"reserved_quantity" db:"reserved_quantity"`
-	ReorderPoint      int                 `json:"reorder_point" db:"reorder_point"`
-	ReorderQuantity   int                 `json:"reorder_quantity" db:"reorder_quantity"`
-	Status            string              `json:"status" db:"status"`
-	LastUpdated       time.Time           `json:"last_updated" db:"last_updated"`
-	CreatedAt         time.Time           `json:"created_at" db:"created_at"`
-	UpdatedAt         time.Time           `json:"updated_at" db:"updated_at"`
-	Locations         []InventoryLocation `json:"locations,omitempty" db:"-"`
+	ID                string  `json:"id" db:"id"`
+	ProductID         string  `json:"product_id" db:"product_id"`
+	VariantID         *string `json:"variant_id,omitempty" db:"variant_id"`
+	SKU               string  `json:"sku" db:"sku"`
+	TotalQuantity     int     `json:"total_quantity" db:"total_quantity"`
+	AvailableQuantity int     `json:"available_quantity" db:"available_quantity"`
+	ReservedQuantity  int     `json:"reserved_quantity" db:"reserved_quantity"`
+	ReorderPoint      int     `json:"reorder_point" db:"reorder_point"`
+	ReorderQuantity   int     `json:"reorder_quantity" db:"reorder_quantity"`
+	Status            string  `json:"status" db:"status"`
+	// Availability is the stock badge of the item, set by the service
+	Availability string              `json:"availability" db:"-"`
+	LastUpdated  time.Time           `json:"last_updated" db:"last_updated"`
+	CreatedAt    time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time           `json:"updated_at" db:"updated_at"`
+	Locations    []InventoryLocation `json:"locations,omitempty" db:"-"`
+	// Version is incremented by every change of the item, so that updates
+	// made from a stale read are refused
+	Version int `json:"version" db:"version"`
 }
 
 // InventoryLocation represents inventory at a specific warehouse
@@ -30,9 +35,36 @@ type InventoryLocation struct {
 	Quantity          int        `json:"quantity" db:"quantity"`
 	AvailableQuantity int        `json:"available_quantity" db:"available_quantity"`
 	ReservedQuantity  int        `json:"reserved_quantity" db:"reserved_quantity"`
+	SafetyStock       int        `json:"safety_stock" db:"safety_stock"`
+	MaxStock          int        `json:"max_stock" db:"max_stock"`
 	CreatedAt         time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt         time.Time  `json:"updated_at" db:"updated_at"`
 	Warehouse         *Warehouse `json:"warehouse,omitempty" db:"-"`
+	// Version is incremented by every change of the quantities at the
+	// location, zero for a location not stored yet
+	Version int `json:"version" db:"version"`
+}
+
+// SellableQuantity returns the available quantity at the location that may be
+// sold, i.e. without the safety stock held back at the warehouse
+func (l *InventoryLocation) SellableQuantity() int {
+	if l.AvailableQuantity <= l.SafetyStock {
+		return 0
+	}
+	return l.AvailableQuantity - l.SafetyStock
+}
+
+// SellableQuantity returns the available quantity of the item minus the safety
+// stock of all its locations. Locations must be loaded.
+func (i *InventoryItem) SellableQuantity() int {
+	sellable := i.AvailableQuantity
+	for _, location := range i.Locations {
+		sellable -= location.SafetyStock
+	}
+	if sellable < 0 {
+		return 0
+	}
+	return sellable
 }
 
 // InventoryTransaction represents a change in inventory
@@ -47,6 +79,20 @@ type InventoryTransaction struct {
 	Notes           *string   `json:"notes,omitempty" db:"notes"`
 	CreatedBy       *string   `json:"created_by,omitempty" db:"created_by"`
 	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	// UnitCode and UnitQuantity are the unit of measure and quantity the
+	// movement was entered in, when not in base units
+	UnitCode     *string `json:"unit_code,omitempty" db:"unit_code"`
+	UnitQuantity *int    `json:"unit_quantity,omitempty" db:"unit_quantity"`
+	// IdempotencyKey is the key the movement was applied with, if any
+	IdempotencyKey *string `json:"idempotency_key,omitempty" db:"idempotency_key"`
+}
+
+// InventoryActivity is an inventory transaction with the product and SKU of
+// its inventory item, as shown in the admin activity feed
+type InventoryActivity struct {
+	InventoryTransaction
+	ProductID string `json:"product_id" db:"product_id"`
+	SKU       string `json:"sku" db:"sku"`
 }
 
 // InventoryReservation represents a temporary hold on inventory
@@ -61,6 +107,9 @@ type InventoryReservation struct {
 	ReferenceType   string    `json:"reference_type" db:"reference_type"`
 	CreatedAt       time.Time `json:"created_at" db:"created_at"`
 	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+	// Lots are the lots the reservation was allocated from, set when it is
+	// created
+	Lots []LotAllocation `json:"lots,omitempty" db:"-"`
 }
 
 // WarehouseAllocation represents a quantity allocation to a specific warehouse
@@ -74,6 +123,8 @@ type ReservationItem struct {
 	InventoryItemID string  `json:"inventory_item_id"`
 	Quantity        int     `json:"quantity"`
 	WarehouseID     *string `json:"warehouse_id,omitempty"`
+	// Unit is the unit of measure of the quantity, the base unit when empty
+	Unit string `json:"unit,omitempty"`
 }
 
 // AvailabilityCheckItem represents an item to check for availability
@@ -82,6 +133,8 @@ type AvailabilityCheckItem struct {
 	VariantID *string `json:"variant_id,omitempty"`
 	SKU       string  `json:"sku"`
 	Quantity  int     `json:"quantity"`
+	// Unit is the unit of measure of the quantity, the base unit when empty
+	Unit string `json:"unit,omitempty"`
 }
 
 // ItemAvailability represents the availability status of an item
@@ -93,6 +146,13 @@ type ItemAvailability struct {
 	AvailableQuantity int     `json:"available_quantity"`
 	IsAvailable       bool    `json:"is_available"`
 	Status            string  `json:"status"`
+	Availability      string  `json:"availability"`
+	// The requested and available quantities are in base units; Unit is the
+	// unit of measure of the check and AvailableUnits the whole units of it
+	// available
+	Unit           string `json:"unit"`
+	UnitFactor     int    `json:"unit_factor"`
+	AvailableUnits int    `json:"available_units"`
 }
 
 // Constants for inventory status
@@ -111,6 +171,7 @@ const (
 	TransactionReservation        = "RESERVATION"
 	TransactionReservationRelease = "RESERVATION_RELEASE"
 	TransactionAdjustment         = "ADJUSTMENT"
+	TransactionWriteOff           = "WRITE_OFF"
 )
 
 // Constants for reservation status
@@ -122,7 +183,9 @@ const (
 	ReservationExpired   = "EXPIRED"
 )
 
-// DetermineInventoryStatus calculates the appropriate inventory status based on quantities
+// DetermineInventoryStatus calculates the appropriate inventory status based on
+// quantities. The status drives replenishment; customers see the badge of the
+// availability policy instead.
 func DetermineInventoryStatus(availableQty, reorderPoint int) string {
 	if availableQty <= 0 {
 		return StatusOutOfStock
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	// ErrLotExpiryMismatch is returned when receiving into an existing lot
+	// with another expiry date
+	ErrLotExpiryMismatch = apperrors.New(apperrors.ErrFailedPrecondition, "lot was received with another expiry date")
+	// ErrLotExpired is returned when receiving stock that has already expired
+	ErrLotExpired = apperrors.New(apperrors.ErrInvalidArgument, "lot has already expired")
+)
+
+// ReferenceLot is the reference type of the inventory transactions of lots
+const ReferenceLot = "LOT"
+
+// InventoryLot is a batch of an item received at a warehouse, with the date
+// its units expire. Reservations are allocated the units of the lots that
+// expire first.
+type InventoryLot struct {
+	ID               string     `json:"id" db:"id"`
+	InventoryItemID  string     `json:"inventory_item_id" db:"inventory_item_id"`
+	WarehouseID      string     `json:"warehouse_id" db:"warehouse_id"`
+	LotNumber        string     `json:"lot_number" db:"lot_number"`
+	ExpiresAt        *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	Quantity         int        `json:"quantity" db:"quantity"`
+	ReservedQuantity int        `json:"reserved_quantity" db:"reserved_quantity"`
+	ReceivedAt       time.Time  `json:"received_at" db:"received_at"`
+	WrittenOffAt     *time.Time `json:"written_off_at,omitempty" db:"written_off_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+
+	// ProductID and SKU are only set on the lots of the expiry report
+	ProductID string `json:"product_id,omitempty" db:"product_id"`
+	SKU       string `json:"sku,omitempty" db:"sku"`
+
+	// TenantID is only set on the lots written off, which span all stores
+	TenantID string `json:"-" db:"tenant_id"`
+}
+
+// AvailableQuantity returns the units of the lot not allocated to reservations
+func (l *InventoryLot) AvailableQuantity() int {
+	return l.Quantity - l.ReservedQuantity
+}
+
+// Expired reports whether the lot has expired at the given time
+func (l *InventoryLot) Expired(now time.Time) bool {
+	return l.ExpiresAt != nil && !l.ExpiresAt.After(now)
+}
+
+// LotAllocation is the part of a reservation allocated from a lot
+type LotAllocation struct {
+	LotID     string     `json:"lot_id" db:"lot_id"`
+	LotNumber string     `json:"lot_number" db:"lot_number"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	Quantity  int        `json:"quantity" db:"quantity"`
+}
+
+// LotWriteOff is the removal of the unreserved units of an expired lot
+type LotWriteOff struct {
+	Lot      InventoryLot `json:"lot"`
+	Quantity int          `json:"quantity"`
+}
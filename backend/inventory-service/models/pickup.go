@@ -0,0 +1,138 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	// ErrPickupOrderNotFound is returned for a pickup order that does not
+	// exist in the store, or of another customer
+	ErrPickupOrderNotFound = apperrors.New(apperrors.ErrNotFound, "pickup order not found")
+	// ErrPickupInvalidTransition is returned when moving a pickup order to a
+	// status its current status does not lead to
+	ErrPickupInvalidTransition = apperrors.New(apperrors.ErrFailedPrecondition, "pickup order cannot move to this status")
+)
+
+// Pickup order statuses, in the order a pickup order normally goes through
+// them
+const (
+	PickupPending   = "pending"
+	PickupPicking   = "picking"
+	PickupReady     = "ready"
+	PickupCollected = "collected"
+	PickupCancelled = "cancelled"
+)
+
+// pickupTransitions lists the statuses each pickup order status leads to.
+// Orders are cancelled with their stock released until they are collected.
+var pickupTransitions = map[string][]string{
+	PickupPending: {PickupPicking, PickupReady, PickupCancelled},
+	PickupPicking: {PickupReady, PickupCancelled},
+	PickupReady:   {PickupCollected, PickupCancelled},
+}
+
+// CanTransitionPickup reports whether a pickup order in status from may move
+// to status to
+func CanTransitionPickup(from, to string) bool {
+	for _, next := range pickupTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPickupStatus reports whether status is a known pickup order status
+func IsPickupStatus(status string) bool {
+	_, ok := pickupTransitions[status]
+	return ok || status == PickupCollected || status == PickupCancelled
+}
+
+// ReferencePickupOrder is the reference type of the reservations and
+// inventory transactions of pickup orders, referenced by the pickup order ID
+const ReferencePickupOrder = "PICKUP_ORDER"
+
+// PickupProvider is the provider of the order status events of pickup
+// orders
+const PickupProvider = "store"
+
+// Order statuses of pickup orders reported to the order system
+const (
+	OrderStatusReadyForPickup  = "ready_for_pickup"
+	OrderStatusCollected       = "collected"
+	OrderStatusPickupCancelled = "pickup_cancelled"
+)
+
+// PickupCancelNotCollected is the cancel reason of the ready orders not
+// collected in time
+const PickupCancelNotCollected = "not collected"
+
+// PickupOrder is an order placed online for the customer to collect at a
+// store (click-and-collect). Its lines are reserved at the store from
+// checkout until they are collected or the order is cancelled.
+type PickupOrder struct {
+	ID             string `json:"id" db:"id"`
+	OrderReference string `json:"order_reference" db:"order_reference"`
+	// WarehouseID is the warehouse of the store the order is collected at
+	WarehouseID string `json:"warehouse_id" db:"warehouse_id"`
+	UserID      string `json:"user_id,omitempty" db:"user_id"`
+	Email       string `json:"email" db:"email"`
+	Status      string `json:"status" db:"status"`
+	// CollectBy is when a ready order not collected is cancelled
+	CollectBy    *time.Time   `json:"collect_by,omitempty" db:"collect_by"`
+	ReadyAt      *time.Time   `json:"ready_at,omitempty" db:"ready_at"`
+	CollectedAt  *time.Time   `json:"collected_at,omitempty" db:"collected_at"`
+	CancelledAt  *time.Time   `json:"cancelled_at,omitempty" db:"cancelled_at"`
+	CancelReason string       `json:"cancel_reason,omitempty" db:"cancel_reason"`
+	CreatedAt    time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at" db:"updated_at"`
+	Lines        []PickupLine `json:"lines,omitempty" db:"-"`
+
+	// TenantID is only set on the orders listed for expiry, which span all
+	// stores
+	TenantID string `json:"-" db:"tenant_id"`
+}
+
+// PickupLine is a quantity of an item of a pickup order, held by its
+// reservation at the store
+type PickupLine struct {
+	ID              string `json:"id" db:"id"`
+	InventoryItemID string `json:"inventory_item_id" db:"inventory_item_id"`
+	ProductID       string `json:"product_id" db:"product_id"`
+	SKU             string `json:"sku" db:"sku"`
+	Quantity        int    `json:"quantity" db:"quantity"`
+	ReservationID   string `json:"reservation_id" db:"reservation_id"`
+	// Personalization is what the item is personalized with, for the staff
+	// picking it
+	Personalization []PersonalizationValue `json:"personalization,omitempty" db:"personalization"`
+}
+
+// PersonalizationValue is the input of a buyer for a personalization option
+// of a product, such as an engraving text or uploaded artwork, as validated
+// by the product service
+type PersonalizationValue struct {
+	OptionID string `json:"option_id"`
+	Name     string `json:"name"`
+	Text     string `json:"text,omitempty"`
+	FileURL  string `json:"file_url,omitempty"`
+	FileName string `json:"file_name,omitempty"`
+}
+
+// PickupItem is a quantity of a product ordered for pickup at checkout
+type PickupItem struct {
+	ProductID string
+	Quantity  int
+	// Unit is the unit of measure of the quantity, each when empty
+	Unit            string
+	Personalization []PersonalizationValue
+}
+
+// PickupOrderFilter selects pickup orders; empty fields match all orders
+type PickupOrderFilter struct {
+	WarehouseID    string
+	UserID         string
+	Status         string
+	OrderReference string
+}
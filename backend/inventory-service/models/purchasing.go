@@ -0,0 +1,117 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// Errors of supplier management and purchase orders
+var (
+	ErrSupplierNotFound        = apperrors.New(apperrors.ErrNotFound, "supplier not found")
+	ErrSupplierExists          = apperrors.New(apperrors.ErrAlreadyExists, "a supplier with this name already exists")
+	ErrSupplierInactive        = apperrors.New(apperrors.ErrFailedPrecondition, "supplier is inactive")
+	ErrSupplierProductNotFound = apperrors.New(apperrors.ErrNotFound, "supplier product not found")
+	ErrPurchaseOrderNotFound   = apperrors.New(apperrors.ErrNotFound, "purchase order not found")
+	ErrPurchaseOrderClosed     = apperrors.New(apperrors.ErrFailedPrecondition, "purchase order is already received or cancelled")
+)
+
+// Purchase order statuses
+const (
+	PurchaseOrderOpen              = "open"
+	PurchaseOrderPartiallyReceived = "partially_received"
+	PurchaseOrderReceived          = "received"
+	PurchaseOrderCancelled         = "cancelled"
+)
+
+// IsPurchaseOrderStatus reports whether status is a known purchase order
+// status
+func IsPurchaseOrderStatus(status string) bool {
+	switch status {
+	case PurchaseOrderOpen, PurchaseOrderPartiallyReceived, PurchaseOrderReceived, PurchaseOrderCancelled:
+		return true
+	}
+	return false
+}
+
+// ReferencePurchaseOrder is the reference type of the inventory transactions
+// of received purchase orders
+const ReferencePurchaseOrder = "PURCHASE_ORDER"
+
+// Supplier is a vendor a store buys stock from
+type Supplier struct {
+	ID        string    `json:"id" db:"id"`
+	Name      string    `json:"name" db:"name"`
+	Email     string    `json:"email" db:"email"`
+	Phone     string    `json:"phone" db:"phone"`
+	Currency  string    `json:"currency" db:"currency"`
+	Notes     string    `json:"notes" db:"notes"`
+	IsActive  bool      `json:"is_active" db:"is_active"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+
+	// Products is only set when a single supplier is retrieved
+	Products []SupplierProduct `json:"products,omitempty"`
+}
+
+// SupplierProduct links an inventory item to a supplier selling it
+type SupplierProduct struct {
+	SupplierID      string    `json:"supplier_id" db:"supplier_id"`
+	InventoryItemID string    `json:"inventory_item_id" db:"inventory_item_id"`
+	SKU             string    `json:"sku" db:"sku"`
+	SupplierSKU     string    `json:"supplier_sku" db:"supplier_sku"`
+	CostPrice       float64   `json:"cost_price" db:"cost_price"`
+	LeadTimeDays    int       `json:"lead_time_days" db:"lead_time_days"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// PurchaseOrder is an order of stock from a supplier, received into a
+// warehouse
+type PurchaseOrder struct {
+	ID          string              `json:"id" db:"id"`
+	SupplierID  string              `json:"supplier_id" db:"supplier_id"`
+	WarehouseID string              `json:"warehouse_id" db:"warehouse_id"`
+	Status      string              `json:"status" db:"status"`
+	Currency    string              `json:"currency" db:"currency"`
+	Notes       string              `json:"notes" db:"notes"`
+	ExpectedAt  *time.Time          `json:"expected_at,omitempty" db:"expected_at"`
+	ReceivedAt  *time.Time          `json:"received_at,omitempty" db:"received_at"`
+	CreatedAt   time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at" db:"updated_at"`
+	Lines       []PurchaseOrderLine `json:"lines,omitempty"`
+}
+
+// PurchaseOrderLine is a quantity of an inventory item ordered from the
+// supplier. Quantities and the unit cost are in the unit of measure of the
+// line.
+type PurchaseOrderLine struct {
+	ID               string  `json:"id" db:"id"`
+	InventoryItemID  string  `json:"inventory_item_id" db:"inventory_item_id"`
+	SKU              string  `json:"sku" db:"sku"`
+	UnitCode         string  `json:"unit_code" db:"unit_code"`
+	QuantityOrdered  int     `json:"quantity_ordered" db:"quantity_ordered"`
+	QuantityReceived int     `json:"quantity_received" db:"quantity_received"`
+	UnitCost         float64 `json:"unit_cost" db:"unit_cost"`
+}
+
+// Outstanding returns the quantity of the line still to be received
+func (l PurchaseOrderLine) Outstanding() int {
+	return l.QuantityOrdered - l.QuantityReceived
+}
+
+// PurchaseOrderFilter selects purchase orders; empty fields match all orders
+type PurchaseOrderFilter struct {
+	SupplierID string
+	Status     string
+	// ExpectedBefore selects the open orders expected before the time
+	ExpectedBefore *time.Time
+}
+
+// ReceiptLine is a quantity of an inventory item received for a purchase
+// order, in the unit of measure of its order line
+type ReceiptLine struct {
+	InventoryItemID string `json:"inventory_item_id"`
+	Quantity        int    `json:"quantity"`
+	// UnitCode is set from the order line on the receipts recorded
+	UnitCode string `json:"unit_code,omitempty"`
+}
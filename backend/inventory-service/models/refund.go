@@ -0,0 +1,109 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	// ErrRefundNotFound is returned for a refund that does not exist in the
+	// store
+	ErrRefundNotFound = apperrors.New(apperrors.ErrNotFound, "refund not found")
+	// ErrRefundInvalidTransition is returned when moving a refund to a status
+	// its current status does not lead to
+	ErrRefundInvalidTransition = apperrors.New(apperrors.ErrFailedPrecondition, "refund cannot move to this status")
+)
+
+// Refund statuses
+const (
+	RefundRequested = "requested"
+	RefundApproved  = "approved"
+	RefundRejected  = "rejected"
+	RefundProcessed = "processed"
+	RefundFailed    = "failed"
+)
+
+// refundTransitions lists the statuses each refund status leads to. A failed
+// refund may be processed when the payment system retries it.
+var refundTransitions = map[string][]string{
+	RefundRequested: {RefundApproved, RefundRejected},
+	RefundApproved:  {RefundProcessed, RefundFailed},
+	RefundFailed:    {RefundProcessed},
+}
+
+// CanTransitionRefund reports whether a refund in status from may move to
+// status to
+func CanTransitionRefund(from, to string) bool {
+	for _, next := range refundTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// Refund types
+const (
+	RefundFull    = "full"
+	RefundPartial = "partial"
+)
+
+// ReferenceRefund is the reference type of the inventory transactions of
+// restocked refund lines
+const ReferenceRefund = "REFUND"
+
+// Refund is a refund of the payment of an order, in full or in part
+type Refund struct {
+	ID               string     `json:"id" db:"id"`
+	OrderReference   string     `json:"order_reference" db:"order_reference"`
+	PaymentReference string     `json:"payment_reference" db:"payment_reference"`
+	UserID           string     `json:"user_id,omitempty" db:"user_id"`
+	Type             string     `json:"type" db:"refund_type"`
+	Amount           float64    `json:"amount" db:"amount"`
+	Currency         string     `json:"currency" db:"currency"`
+	Reason           string     `json:"reason" db:"reason"`
+	Status           string     `json:"status" db:"status"`
+	RequestedBy      string     `json:"requested_by,omitempty" db:"requested_by"`
+	ReviewedBy       string     `json:"reviewed_by,omitempty" db:"reviewed_by"`
+	ReviewedAt       *time.Time `json:"reviewed_at,omitempty" db:"reviewed_at"`
+	// ProviderReference is the payment provider's ID of the processed refund
+	ProviderReference string `json:"provider_reference,omitempty" db:"provider_reference"`
+	// FailureReason is why the refund was rejected or failed
+	FailureReason string       `json:"failure_reason,omitempty" db:"failure_reason"`
+	ProcessedAt   *time.Time   `json:"processed_at,omitempty" db:"processed_at"`
+	CreatedAt     time.Time    `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time    `json:"updated_at" db:"updated_at"`
+	Lines         []RefundLine `json:"lines,omitempty" db:"-"`
+}
+
+// RefundLine is a quantity of an item returned with a refund. Restocked
+// lines are put back at their warehouse when the refund is approved.
+type RefundLine struct {
+	ID              string  `json:"id" db:"id"`
+	InventoryItemID string  `json:"inventory_item_id" db:"inventory_item_id"`
+	SKU             string  `json:"sku" db:"sku"`
+	Quantity        int     `json:"quantity" db:"quantity"`
+	Restock         bool    `json:"restock" db:"restock"`
+	WarehouseID     *string `json:"warehouse_id,omitempty" db:"warehouse_id"`
+}
+
+// RefundFilter selects the refunds listed
+type RefundFilter struct {
+	Status         string
+	OrderReference string
+}
+
+// RefundEvent is a change of the status of a refund, for the notification
+// and accounting exports to pick up
+type RefundEvent struct {
+	ID               int64     `json:"id" db:"id"`
+	RefundID         string    `json:"refund_id" db:"refund_id"`
+	Status           string    `json:"status" db:"status"`
+	OrderReference   string    `json:"order_reference" db:"order_reference"`
+	PaymentReference string    `json:"payment_reference" db:"payment_reference"`
+	UserID           string    `json:"user_id,omitempty" db:"user_id"`
+	Amount           float64   `json:"amount" db:"amount"`
+	Currency         string    `json:"currency" db:"currency"`
+	CreatedAt        time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	// ErrRetailStoreNotFound is returned for a warehouse that is not a store
+	// customers can visit
+	ErrRetailStoreNotFound = apperrors.New(apperrors.ErrNotFound, "store not found")
+	// ErrPickupUnavailable is returned when reserving stock at a store that is
+	// inactive or does not take in-store pickup reservations
+	ErrPickupUnavailable = apperrors.New(apperrors.ErrFailedPrecondition, "store does not take in-store pickup reservations")
+)
+
+// ReferenceTypeStorePickup is the reference type of the reservations held
+// at a store for customers to pick up
+const ReferenceTypeStorePickup = "STORE_PICKUP"
+
+// earthRadiusKm is the mean radius of the Earth
+const earthRadiusKm = 6371.0
+
+// OpeningHours is the time a store opens and closes on a day of the week, as
+// HH:MM in the local time of the store
+type OpeningHours struct {
+	Weekday time.Weekday `json:"weekday"`
+	Opens   string       `json:"opens"`
+	Closes  string       `json:"closes"`
+}
+
+// RetailStore is a warehouse customers can visit: a physical store with its
+// position, opening hours and in-store pickup settings
+type RetailStore struct {
+	WarehouseID  string         `json:"warehouse_id" db:"warehouse_id"`
+	Latitude     float64        `json:"latitude" db:"latitude"`
+	Longitude    float64        `json:"longitude" db:"longitude"`
+	Timezone     string         `json:"timezone" db:"timezone"`
+	Phone        string         `json:"phone" db:"phone"`
+	OpeningHours []OpeningHours `json:"opening_hours" db:"opening_hours"`
+	// PickupEnabled lets customers reserve stock at the store to pick up;
+	// reservations are held for HoldHours
+	PickupEnabled bool       `json:"pickup_enabled" db:"pickup_enabled"`
+	HoldHours     int        `json:"hold_hours" db:"hold_hours"`
+	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at" db:"updated_at"`
+	Warehouse     *Warehouse `json:"warehouse,omitempty" db:"-"`
+}
+
+// NearbyStore is a store found around a position, with the stock of the
+// product searched for, if any
+type NearbyStore struct {
+	Store      *RetailStore `json:"store"`
+	DistanceKm float64      `json:"distance_km"`
+	OpenNow    bool         `json:"open_now"`
+	// AvailableQuantity is the sellable quantity of the product at the store
+	AvailableQuantity int  `json:"available_quantity"`
+	InStock           bool `json:"in_stock"`
+}
+
+// ParseClock parses a HH:MM time of day into minutes after midnight
+func ParseClock(value string) (int, error) {
+	var hours, minutes int
+	if _, err := fmt.Sscanf(value, "%2d:%2d", &hours, &minutes); err != nil || len(value) != 5 {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	if hours < 0 || hours > 24 || minutes < 0 || minutes > 59 || (hours == 24 && minutes > 0) {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", value)
+	}
+	return hours*60 + minutes, nil
+}
+
+// IsOpen reports whether the store is open at t, in its local time. Stores
+// without valid hours or timezone are reported closed.
+func (s *RetailStore) IsOpen(t time.Time) bool {
+	location, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return false
+	}
+	local := t.In(location)
+	minute := local.Hour()*60 + local.Minute()
+
+	for _, hours := range s.OpeningHours {
+		if hours.Weekday != local.Weekday() {
+			continue
+		}
+		opens, err := ParseClock(hours.Opens)
+		if err != nil {
+			continue
+		}
+		closes, err := ParseClock(hours.Closes)
+		if err != nil {
+			continue
+		}
+		if minute >= opens && minute < closes {
+			return true
+		}
+	}
+	return false
+}
+
+// DistanceKm returns the great-circle distance between two positions in
+// kilometers
+func DistanceKm(lat1, lon1, lat2, lon2 float64) float64 {
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(a)))
+}
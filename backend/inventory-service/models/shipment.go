@@ -0,0 +1,142 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// ErrShipmentNotFound is returned for unknown shipments and for shipments of
+// other customers
+var ErrShipmentNotFound = apperrors.New(apperrors.ErrNotFound, "shipment not found")
+
+// Shipment statuses, in the order a shipment normally goes through them
+const (
+	ShipmentLabelCreated   = "label_created"
+	ShipmentInTransit      = "in_transit"
+	ShipmentOutForDelivery = "out_for_delivery"
+	ShipmentDelivered      = "delivered"
+	ShipmentException      = "exception"
+	ShipmentReturned       = "returned"
+
+	// ShipmentPartiallyDelivered is the status of an order some of whose
+	// shipments were delivered
+	ShipmentPartiallyDelivered = "partially_delivered"
+)
+
+// shipmentProgress ranks the statuses of shipments on their way to the
+// customer
+var shipmentProgress = map[string]int{
+	ShipmentLabelCreated:   1,
+	ShipmentInTransit:      2,
+	ShipmentOutForDelivery: 3,
+	ShipmentDelivered:      4,
+}
+
+// IsShipmentStatus reports whether status is a known shipment status
+func IsShipmentStatus(status string) bool {
+	_, ok := shipmentProgress[status]
+	return ok || status == ShipmentException || status == ShipmentReturned
+}
+
+// IsFinalShipmentStatus reports whether a shipment in status needs no more
+// tracking
+func IsFinalShipmentStatus(status string) bool {
+	return status == ShipmentDelivered || status == ShipmentReturned
+}
+
+// Sources of shipment events
+const (
+	ShipmentSourceManual      = "manual"
+	ShipmentSourceFulfillment = "fulfillment"
+	ShipmentSourceWebhook     = "webhook"
+	ShipmentSourcePoll        = "poll"
+)
+
+// Outcomes of carrier events
+const (
+	CarrierEventApplied   = "applied"
+	CarrierEventDuplicate = "duplicate"
+	CarrierEventIgnored   = "ignored"
+	CarrierEventFailed    = "failed"
+)
+
+// Shipment is a parcel of an order handed to a carrier
+type Shipment struct {
+	ID             string          `json:"id" db:"id"`
+	OrderReference string          `json:"order_reference" db:"order_reference"`
+	UserID         string          `json:"user_id,omitempty" db:"user_id"`
+	Carrier        string          `json:"carrier" db:"carrier"`
+	TrackingNumber string          `json:"tracking_number" db:"tracking_number"`
+	Status         string          `json:"status" db:"status"`
+	ShippedAt      time.Time       `json:"shipped_at" db:"shipped_at"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty" db:"delivered_at"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at" db:"updated_at"`
+	Events         []ShipmentEvent `json:"events,omitempty"`
+
+	// TenantID is only set on the shipments listed for polling, which span
+	// all stores
+	TenantID string `json:"-" db:"tenant_id"`
+}
+
+// ShipmentEvent is a tracking event of a shipment
+type ShipmentEvent struct {
+	ID          int64     `json:"id" db:"id"`
+	Status      string    `json:"status" db:"status"`
+	Description string    `json:"description" db:"description"`
+	Location    string    `json:"location" db:"location"`
+	Source      string    `json:"source" db:"source"`
+	OccurredAt  time.Time `json:"occurred_at" db:"occurred_at"`
+}
+
+// CarrierEvent is a tracking event pushed by a carrier
+type CarrierEvent struct {
+	TrackingNumber string    `json:"tracking_number"`
+	Status         string    `json:"status"`
+	Description    string    `json:"description"`
+	Location       string    `json:"location"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+// CarrierEventResult is the outcome of a pushed carrier event
+type CarrierEventResult struct {
+	TrackingNumber string `json:"tracking_number"`
+	Status         string `json:"status"`
+	Error          string `json:"error,omitempty"`
+}
+
+// ShipmentFilter selects shipments; empty fields match all shipments
+type ShipmentFilter struct {
+	OrderReference string
+	UserID         string
+	Status         string
+}
+
+// AggregateShipmentStatus summarizes the statuses of the shipments of an
+// order: the status they share, exception when any shipment has a problem,
+// partially_delivered when only some were delivered, or else the least
+// advanced status
+func AggregateShipmentStatus(shipments []Shipment) string {
+	if len(shipments) == 0 {
+		return ""
+	}
+
+	status := shipments[0].Status
+	delivered := 0
+	for _, shipment := range shipments {
+		switch {
+		case shipment.Status == ShipmentException:
+			return ShipmentException
+		case shipment.Status == ShipmentDelivered:
+			delivered++
+		}
+		if shipmentProgress[shipment.Status] < shipmentProgress[status] {
+			status = shipment.Status
+		}
+	}
+	if delivered > 0 && delivered < len(shipments) {
+		return ShipmentPartiallyDelivered
+	}
+	return status
+}
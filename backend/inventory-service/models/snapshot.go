@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+)
+
+// InventorySnapshot represents the stock level of an inventory item on a given day.
+// WarehouseID is nil for the item-wide totals.
+type InventorySnapshot struct {
+	ID                string    `json:"id" db:"id"`
+	SnapshotDate      time.Time `json:"snapshot_date" db:"snapshot_date"`
+	InventoryItemID   string    `json:"inventory_item_id" db:"inventory_item_id"`
+	WarehouseID       *string   `json:"warehouse_id,omitempty" db:"warehouse_id"`
+	Quantity          int       `json:"quantity" db:"quantity"`
+	AvailableQuantity int       `json:"available_quantity" db:"available_quantity"`
+	ReservedQuantity  int       `json:"reserved_quantity" db:"reserved_quantity"`
+	CreatedAt         time.Time `json:"created_at" db:"created_at"`
+}
+
+// StockHistory holds the daily snapshots of an item over a date range together
+// with the derived consumption figures
+type StockHistory struct {
+	InventoryItemID   string              `json:"inventory_item_id"`
+	WarehouseID       *string             `json:"warehouse_id,omitempty"`
+	Snapshots         []InventorySnapshot `json:"snapshots"`
+	AverageDailyUsage float64             `json:"average_daily_usage"`
+	DaysOfCover       float64             `json:"days_of_cover"`
+}
+
+// CalculateAverageDailyUsage estimates consumption from consecutive snapshots.
+// Only day-over-day decreases in quantity count as usage so restocks do not
+// offset sales. Snapshots must be ordered by date ascending.
+func CalculateAverageDailyUsage(snapshots []InventorySnapshot) float64 {
+	if len(snapshots) < 2 {
+		return 0
+	}
+
+	usage := 0
+	for i := 1; i < len(snapshots); i++ {
+		if drop := snapshots[i-1].Quantity - snapshots[i].Quantity; drop > 0 {
+			usage += drop
+		}
+	}
+
+	days := snapshots[len(snapshots)-1].SnapshotDate.Sub(snapshots[0].SnapshotDate).Hours() / 24
+	if days <= 0 {
+		return 0
+	}
+	return float64(usage) / days
+}
+
+// CalculateDaysOfCover returns how many days the available quantity lasts at the
+// given daily usage, or -1 when there is no measurable usage
+func CalculateDaysOfCover(availableQty int, averageDailyUsage float64) float64 {
+	if averageDailyUsage <= 0 {
+		return -1
+	}
+	return float64(availableQty) / averageDailyUsage
+}
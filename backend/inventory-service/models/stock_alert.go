@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// Stock alert types
+const (
+	// AlertLowStock is raised when an item's available quantity across all
+	// warehouses is at or below its reorder point
+	AlertLowStock = "LOW_STOCK"
+	// AlertBelowSafetyStock is raised when a location's available quantity has
+	// dropped below its safety stock
+	AlertBelowSafetyStock = "BELOW_SAFETY_STOCK"
+	// AlertAboveMaxStock is raised when a location holds more than its max stock
+	AlertAboveMaxStock = "ABOVE_MAX_STOCK"
+)
+
+// StockAlert describes an inventory item or location whose stock is outside
+// its thresholds. Item-level alerts have no warehouse.
+type StockAlert struct {
+	Type            string    `json:"type"`
+	InventoryItemID string    `json:"inventory_item_id"`
+	ProductID       string    `json:"product_id"`
+	SKU             string    `json:"sku"`
+	WarehouseID     *string   `json:"warehouse_id,omitempty"`
+	Quantity        int       `json:"quantity"`
+	Threshold       int       `json:"threshold"`
+	DetectedAt      time.Time `json:"detected_at"`
+}
+
+// Key identifies the alert across runs of the alerting job
+func (a StockAlert) Key() string {
+	key := a.Type + ":" + a.InventoryItemID
+	if a.WarehouseID != nil {
+		key += ":" + *a.WarehouseID
+	}
+	return key
+}
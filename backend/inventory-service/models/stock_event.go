@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+// Stock change types
+const (
+	StockChangeCreated              = "CREATED"
+	StockChangeUpdated              = "UPDATED"
+	StockChangeAdded                = "STOCK_ADDED"
+	StockChangeRemoved              = "STOCK_REMOVED"
+	StockChangeReserved             = "RESERVED"
+	StockChangeReservationReleased  = "RESERVATION_RELEASED"
+	StockChangeReservationConfirmed = "RESERVATION_CONFIRMED"
+	StockChangeSnapshot             = "SNAPSHOT"
+)
+
+// StockChange describes the stock level of an inventory item after a change.
+// When the change concerned a single warehouse the warehouse fields hold the
+// levels at that location.
+type StockChange struct {
+	InventoryItemID            string    `json:"inventory_item_id"`
+	ProductID                  string    `json:"product_id"`
+	VariantID                  *string   `json:"variant_id,omitempty"`
+	SKU                        string    `json:"sku"`
+	TotalQuantity              int       `json:"total_quantity"`
+	AvailableQuantity          int       `json:"available_quantity"`
+	ReservedQuantity           int       `json:"reserved_quantity"`
+	Status                     string    `json:"status"`
+	Availability               string    `json:"availability"`
+	WarehouseID                *string   `json:"warehouse_id,omitempty"`
+	WarehouseQuantity          int       `json:"warehouse_quantity"`
+	WarehouseAvailableQuantity int       `json:"warehouse_available_quantity"`
+	ChangeType                 string    `json:"change_type"`
+	OccurredAt                 time.Time `json:"occurred_at"`
+
+	// TenantID is the store of the item, for consumers spanning all stores
+	TenantID string `json:"-"`
+}
+
+// StockFilter selects the stock changes a subscriber receives. An empty
+// filter field matches everything; when both are set both must match.
+type StockFilter struct {
+	ProductIDs  []string
+	WarehouseID string
+}
+
+// Matches reports whether the change passes the filter
+func (f StockFilter) Matches(change StockChange) bool {
+	if f.WarehouseID != "" && (change.WarehouseID == nil || *change.WarehouseID != f.WarehouseID) {
+		return false
+	}
+	if len(f.ProductIDs) == 0 {
+		return true
+	}
+	for _, id := range f.ProductIDs {
+		if id == change.ProductID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	// ErrIdempotencyKeyReused is returned when an idempotency key is sent
+	// again with a different stock mutation
+	ErrIdempotencyKeyReused = apperrors.New(apperrors.ErrInvalidArgument, "idempotency key was already used for a different stock mutation")
+	// ErrStockMutationRecorded is returned when a stock mutation is saved
+	// with an idempotency key another attempt was applied with
+	ErrStockMutationRecorded = apperrors.New(apperrors.ErrAlreadyExists, "stock mutation with this idempotency key was already applied")
+)
+
+// MaxIdempotencyKeyLength is the longest idempotency key accepted
+const MaxIdempotencyKeyLength = 255
+
+// StockMutation is the record of a stock mutation made with an idempotency
+// key. RequestHash identifies the mutation the key was first sent with, and
+// Result holds its response, recorded with the key when it is applied.
+type StockMutation struct {
+	IdempotencyKey string          `json:"idempotency_key" db:"idempotency_key"`
+	Operation      string          `json:"operation" db:"operation"`
+	RequestHash    string          `json:"request_hash" db:"request_hash"`
+	Result         json.RawMessage `json:"result,omitempty" db:"result"`
+	CreatedAt      time.Time       `json:"created_at" db:"created_at"`
+}
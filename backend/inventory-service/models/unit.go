@@ -0,0 +1,63 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	// ErrUnitNotFound is returned for a unit of measure the item is not
+	// counted in
+	ErrUnitNotFound = apperrors.New(apperrors.ErrInvalidArgument, "unknown unit of measure for the item")
+	// ErrUnitInUse is returned when deleting a unit open purchase orders are
+	// still to be received in
+	ErrUnitInUse = apperrors.New(apperrors.ErrFailedPrecondition, "unit of measure is used by open purchase orders")
+)
+
+// BaseUnit is the code of the unit stock is kept in: one each
+const BaseUnit = "EA"
+
+// InventoryUnit is a unit of measure an item is received, moved or sold in,
+// such as a case of 12. Factor is the number of base units in one unit.
+type InventoryUnit struct {
+	ID              string    `json:"id" db:"id"`
+	InventoryItemID string    `json:"inventory_item_id" db:"inventory_item_id"`
+	Code            string    `json:"code" db:"code"`
+	Name            string    `json:"name" db:"name"`
+	Factor          int       `json:"factor" db:"factor"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// ToBase converts a quantity in the unit to base units
+func (u *InventoryUnit) ToBase(quantity int) int {
+	return quantity * u.Factor
+}
+
+// FromBase converts a quantity in base units to whole units of the unit,
+// rounding down
+func (u *InventoryUnit) FromBase(quantity int) int {
+	return quantity / u.Factor
+}
+
+// IsBase reports whether the unit is the base unit
+func (u *InventoryUnit) IsBase() bool {
+	return u.Code == BaseUnit
+}
+
+// BaseInventoryUnit returns the base unit of an item
+func BaseInventoryUnit(inventoryItemID string) *InventoryUnit {
+	return &InventoryUnit{InventoryItemID: inventoryItemID, Code: BaseUnit, Name: "Each", Factor: 1}
+}
+
+// NormalizeUnitCode returns the canonical form of a unit code, the base unit
+// when it is empty
+func NormalizeUnitCode(code string) string {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return BaseUnit
+	}
+	return code
+}
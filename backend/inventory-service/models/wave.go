@@ -0,0 +1,97 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	// ErrWaveNotFound is returned for a wave that does not exist in the store
+	ErrWaveNotFound = apperrors.New(apperrors.ErrNotFound, "wave not found")
+	// ErrWaveEmpty is returned when no pending order is left to batch into a
+	// wave
+	ErrWaveEmpty = apperrors.New(apperrors.ErrFailedPrecondition, "no pending orders to batch into a wave")
+	// ErrWaveNotOpen is returned when completing or cancelling a wave that
+	// was already completed or cancelled
+	ErrWaveNotOpen = apperrors.New(apperrors.ErrFailedPrecondition, "wave is not open")
+	// ErrWaveLineNotFound is returned when completing a line of another wave
+	ErrWaveLineNotFound = apperrors.New(apperrors.ErrInvalidArgument, "line is not part of the wave")
+)
+
+// Wave statuses
+const (
+	WaveOpen      = "open"
+	WaveCompleted = "completed"
+	WaveCancelled = "cancelled"
+)
+
+// Wave line statuses
+const (
+	WaveLinePending   = "pending"
+	WaveLinePicked    = "picked"
+	WaveLineShort     = "short"
+	WaveLineCancelled = "cancelled"
+)
+
+// Order statuses reported when a wave is completed
+const (
+	// OrderStatusPicked is the order status of orders all of whose lines in
+	// a wave were picked in full
+	OrderStatusPicked = "picked"
+	// OrderStatusPartiallyPicked is the order status of orders with lines
+	// picked short
+	OrderStatusPartiallyPicked = "partially_picked"
+)
+
+// WaveProvider is the provider of the order status events of waves
+const WaveProvider = "warehouse"
+
+// Wave is a batch of the order lines of a warehouse picked together, for the
+// orders reserved up to the cutoff of a carrier
+type Wave struct {
+	ID          string     `json:"id" db:"id"`
+	WarehouseID string     `json:"warehouse_id" db:"warehouse_id"`
+	Carrier     string     `json:"carrier" db:"carrier"`
+	CutoffAt    time.Time  `json:"cutoff_at" db:"cutoff_at"`
+	Status      string     `json:"status" db:"status"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	CompletedAt *time.Time `json:"completed_at,omitempty" db:"completed_at"`
+	// Orders is the number of orders of the wave
+	Orders int        `json:"orders" db:"-"`
+	Lines  []WaveLine `json:"lines,omitempty" db:"-"`
+}
+
+// WaveLine is the reserved quantity of an item of an order in a wave
+type WaveLine struct {
+	ID              string    `json:"id" db:"id"`
+	WaveID          string    `json:"wave_id" db:"wave_id"`
+	ReservationID   string    `json:"reservation_id" db:"reservation_id"`
+	OrderReference  string    `json:"order_reference" db:"order_reference"`
+	InventoryItemID string    `json:"inventory_item_id" db:"inventory_item_id"`
+	SKU             string    `json:"sku" db:"sku"`
+	Quantity        int       `json:"quantity" db:"quantity"`
+	PickedQuantity  int       `json:"picked_quantity" db:"picked_quantity"`
+	Status          string    `json:"status" db:"status"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// WaveFilter selects the pending orders batched into a new wave
+type WaveFilter struct {
+	WarehouseID string
+	// Carrier labels the wave
+	Carrier string
+	// CutoffAt is the latest time the orders were reserved at
+	CutoffAt time.Time
+	// OrderReferences restricts the wave to these orders, such as those
+	// shipping with the carrier, when not empty
+	OrderReferences []string
+	// MaxOrders bounds the orders of the wave, the oldest first
+	MaxOrders int
+}
+
+// PickedLine is the quantity picked of a line when a wave is completed
+type PickedLine struct {
+	LineID         string `json:"line_id"`
+	PickedQuantity int    `json:"picked_quantity"`
+}
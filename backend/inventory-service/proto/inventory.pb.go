@@ -35,11 +35,12 @@ type InventoryItem struct {
 	ReservedQuantity  int32                   `protobuf:"varint,7,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
 	ReorderPoint      int32                   `protobuf:"varint,8,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
 	ReorderQuantity   int32                   `protobuf:"varint,9,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
-	Status            string                  `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	Status            string                  `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"` // Replenishment status, driven by the reorder point
 	LastUpdated       *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
 	CreatedAt         *timestamppb.Timestamp  `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt         *timestamppb.Timestamp  `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Locations         []*InventoryLocation    `protobuf:"bytes,14,rep,name=locations,proto3" json:"locations,omitempty"`
+	Availability      string                  `protobuf:"bytes,15,opt,name=availability,proto3" json:"availability,omitempty"` // Stock badge: IN_STOCK, LOW_STOCK, BACKORDER or OUT_OF_STOCK
 	unknownFields     protoimpl.UnknownFields
 	sizeCache         protoimpl.SizeCache
 }
@@ -172,6 +173,13 @@ func (x *InventoryItem) GetLocations() []*InventoryLocation {
 	return nil
 }
 
+func (x *InventoryItem) GetAvailability() string {
+	if x != nil {
+		return x.Availability
+	}
+	return ""
+}
+
 // Warehouse messages
 type Warehouse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -317,8 +325,12 @@ type InventoryLocation struct {
 	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	Warehouse         *Warehouse             `protobuf:"bytes,9,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Units held back from sale at this warehouse
+	SafetyStock int32 `protobuf:"varint,10,opt,name=safety_stock,json=safetyStock,proto3" json:"safety_stock,omitempty"`
+	// Capacity above which the location is overstocked, 0 for no maximum
+	MaxStock      int32 `protobuf:"varint,11,opt,name=max_stock,json=maxStock,proto3" json:"max_stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InventoryLocation) Reset() {
@@ -414,6 +426,20 @@ func (x *InventoryLocation) GetWarehouse() *Warehouse {
 	return nil
 }
 
+func (x *InventoryLocation) GetSafetyStock() int32 {
+	if x != nil {
+		return x.SafetyStock
+	}
+	return 0
+}
+
+func (x *InventoryLocation) GetMaxStock() int32 {
+	if x != nil {
+		return x.MaxStock
+	}
+	return 0
+}
+
 // Inventory Transaction messages
 type InventoryTransaction struct {
 	state           protoimpl.MessageState  `protogen:"open.v1"`
@@ -544,8 +570,11 @@ type InventoryReservation struct {
 	ReferenceType   string                  `protobuf:"bytes,8,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"`
 	CreatedAt       *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt       *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Lots the reservation was allocated from, first expired first out; only
+	// set when the reservation is created
+	Lots          []*LotAllocation `protobuf:"bytes,11,rep,name=lots,proto3" json:"lots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InventoryReservation) Reset() {
@@ -648,6 +677,81 @@ func (x *InventoryReservation) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *InventoryReservation) GetLots() []*LotAllocation {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+type LotAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LotId         string                 `protobuf:"bytes,1,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	LotNumber     string                 `protobuf:"bytes,2,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unset for lots without expiry
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LotAllocation) Reset() {
+	*x = LotAllocation{}
+	mi := &file_proto_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LotAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LotAllocation) ProtoMessage() {}
+
+func (x *LotAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LotAllocation.ProtoReflect.Descriptor instead.
+func (*LotAllocation) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LotAllocation) GetLotId() string {
+	if x != nil {
+		return x.LotId
+	}
+	return ""
+}
+
+func (x *LotAllocation) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *LotAllocation) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *LotAllocation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 // Request and Response messages for each RPC
 type CreateInventoryItemRequest struct {
 	state                protoimpl.MessageState  `protogen:"open.v1"`
@@ -664,7 +768,7 @@ type CreateInventoryItemRequest struct {
 
 func (x *CreateInventoryItemRequest) Reset() {
 	*x = CreateInventoryItemRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[5]
+	mi := &file_proto_inventory_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -676,7 +780,7 @@ func (x *CreateInventoryItemRequest) String() string {
 func (*CreateInventoryItemRequest) ProtoMessage() {}
 
 func (x *CreateInventoryItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[5]
+	mi := &file_proto_inventory_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +793,7 @@ func (x *CreateInventoryItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateInventoryItemRequest.ProtoReflect.Descriptor instead.
 func (*CreateInventoryItemRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{5}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateInventoryItemRequest) GetProductId() string {
@@ -751,7 +855,7 @@ type WarehouseAllocation struct {
 
 func (x *WarehouseAllocation) Reset() {
 	*x = WarehouseAllocation{}
-	mi := &file_proto_inventory_proto_msgTypes[6]
+	mi := &file_proto_inventory_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -763,7 +867,7 @@ func (x *WarehouseAllocation) String() string {
 func (*WarehouseAllocation) ProtoMessage() {}
 
 func (x *WarehouseAllocation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[6]
+	mi := &file_proto_inventory_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +880,7 @@ func (x *WarehouseAllocation) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WarehouseAllocation.ProtoReflect.Descriptor instead.
 func (*WarehouseAllocation) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{6}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *WarehouseAllocation) GetWarehouseId() string {
@@ -807,7 +911,7 @@ type GetInventoryItemRequest struct {
 
 func (x *GetInventoryItemRequest) Reset() {
 	*x = GetInventoryItemRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[7]
+	mi := &file_proto_inventory_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -819,7 +923,7 @@ func (x *GetInventoryItemRequest) String() string {
 func (*GetInventoryItemRequest) ProtoMessage() {}
 
 func (x *GetInventoryItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[7]
+	mi := &file_proto_inventory_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,7 +936,7 @@ func (x *GetInventoryItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInventoryItemRequest.ProtoReflect.Descriptor instead.
 func (*GetInventoryItemRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{7}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetInventoryItemRequest) GetIdentifier() isGetInventoryItemRequest_Identifier {
@@ -903,7 +1007,7 @@ type UpdateInventoryItemRequest struct {
 
 func (x *UpdateInventoryItemRequest) Reset() {
 	*x = UpdateInventoryItemRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[8]
+	mi := &file_proto_inventory_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -915,7 +1019,7 @@ func (x *UpdateInventoryItemRequest) String() string {
 func (*UpdateInventoryItemRequest) ProtoMessage() {}
 
 func (x *UpdateInventoryItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[8]
+	mi := &file_proto_inventory_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -928,7 +1032,7 @@ func (x *UpdateInventoryItemRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateInventoryItemRequest.ProtoReflect.Descriptor instead.
 func (*UpdateInventoryItemRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{8}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *UpdateInventoryItemRequest) GetId() string {
@@ -972,7 +1076,7 @@ type ListInventoryItemsRequest struct {
 
 func (x *ListInventoryItemsRequest) Reset() {
 	*x = ListInventoryItemsRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[9]
+	mi := &file_proto_inventory_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -984,7 +1088,7 @@ func (x *ListInventoryItemsRequest) String() string {
 func (*ListInventoryItemsRequest) ProtoMessage() {}
 
 func (x *ListInventoryItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[9]
+	mi := &file_proto_inventory_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -997,7 +1101,7 @@ func (x *ListInventoryItemsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInventoryItemsRequest.ProtoReflect.Descriptor instead.
 func (*ListInventoryItemsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{9}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListInventoryItemsRequest) GetPage() int32 {
@@ -1044,7 +1148,7 @@ type InventoryItemResponse struct {
 
 func (x *InventoryItemResponse) Reset() {
 	*x = InventoryItemResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[10]
+	mi := &file_proto_inventory_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1056,7 +1160,7 @@ func (x *InventoryItemResponse) String() string {
 func (*InventoryItemResponse) ProtoMessage() {}
 
 func (x *InventoryItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[10]
+	mi := &file_proto_inventory_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1173,7 @@ func (x *InventoryItemResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InventoryItemResponse.ProtoReflect.Descriptor instead.
 func (*InventoryItemResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{10}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *InventoryItemResponse) GetInventoryItem() *InventoryItem {
@@ -1089,7 +1193,7 @@ type ListInventoryItemsResponse struct {
 
 func (x *ListInventoryItemsResponse) Reset() {
 	*x = ListInventoryItemsResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[11]
+	mi := &file_proto_inventory_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1101,7 +1205,7 @@ func (x *ListInventoryItemsResponse) String() string {
 func (*ListInventoryItemsResponse) ProtoMessage() {}
 
 func (x *ListInventoryItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[11]
+	mi := &file_proto_inventory_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,7 +1218,7 @@ func (x *ListInventoryItemsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInventoryItemsResponse.ProtoReflect.Descriptor instead.
 func (*ListInventoryItemsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{11}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ListInventoryItemsResponse) GetInventoryItems() []*InventoryItem {
@@ -1147,7 +1251,7 @@ type CreateWarehouseRequest struct {
 
 func (x *CreateWarehouseRequest) Reset() {
 	*x = CreateWarehouseRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[12]
+	mi := &file_proto_inventory_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1159,7 +1263,7 @@ func (x *CreateWarehouseRequest) String() string {
 func (*CreateWarehouseRequest) ProtoMessage() {}
 
 func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[12]
+	mi := &file_proto_inventory_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,7 +1276,7 @@ func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
 func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{12}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateWarehouseRequest) GetName() string {
@@ -1244,7 +1348,7 @@ type GetWarehouseRequest struct {
 
 func (x *GetWarehouseRequest) Reset() {
 	*x = GetWarehouseRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[13]
+	mi := &file_proto_inventory_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1256,7 +1360,7 @@ func (x *GetWarehouseRequest) String() string {
 func (*GetWarehouseRequest) ProtoMessage() {}
 
 func (x *GetWarehouseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[13]
+	mi := &file_proto_inventory_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1269,7 +1373,7 @@ func (x *GetWarehouseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetWarehouseRequest.ProtoReflect.Descriptor instead.
 func (*GetWarehouseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{13}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetWarehouseRequest) GetIdentifier() isGetWarehouseRequest_Identifier {
@@ -1330,7 +1434,7 @@ type UpdateWarehouseRequest struct {
 
 func (x *UpdateWarehouseRequest) Reset() {
 	*x = UpdateWarehouseRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[14]
+	mi := &file_proto_inventory_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1342,7 +1446,7 @@ func (x *UpdateWarehouseRequest) String() string {
 func (*UpdateWarehouseRequest) ProtoMessage() {}
 
 func (x *UpdateWarehouseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[14]
+	mi := &file_proto_inventory_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1355,7 +1459,7 @@ func (x *UpdateWarehouseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateWarehouseRequest.ProtoReflect.Descriptor instead.
 func (*UpdateWarehouseRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{14}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *UpdateWarehouseRequest) GetId() string {
@@ -1432,7 +1536,7 @@ type ListWarehousesRequest struct {
 
 func (x *ListWarehousesRequest) Reset() {
 	*x = ListWarehousesRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[15]
+	mi := &file_proto_inventory_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1444,7 +1548,7 @@ func (x *ListWarehousesRequest) String() string {
 func (*ListWarehousesRequest) ProtoMessage() {}
 
 func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[15]
+	mi := &file_proto_inventory_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1457,7 +1561,7 @@ func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
 func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{15}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListWarehousesRequest) GetPage() int32 {
@@ -1490,7 +1594,7 @@ type WarehouseResponse struct {
 
 func (x *WarehouseResponse) Reset() {
 	*x = WarehouseResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[16]
+	mi := &file_proto_inventory_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1502,7 +1606,7 @@ func (x *WarehouseResponse) String() string {
 func (*WarehouseResponse) ProtoMessage() {}
 
 func (x *WarehouseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[16]
+	mi := &file_proto_inventory_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1515,7 +1619,7 @@ func (x *WarehouseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WarehouseResponse.ProtoReflect.Descriptor instead.
 func (*WarehouseResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{16}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *WarehouseResponse) GetWarehouse() *Warehouse {
@@ -1535,7 +1639,7 @@ type ListWarehousesResponse struct {
 
 func (x *ListWarehousesResponse) Reset() {
 	*x = ListWarehousesResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[17]
+	mi := &file_proto_inventory_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1547,7 +1651,7 @@ func (x *ListWarehousesResponse) String() string {
 func (*ListWarehousesResponse) ProtoMessage() {}
 
 func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[17]
+	mi := &file_proto_inventory_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1560,7 +1664,7 @@ func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
 func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{17}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
@@ -1585,13 +1689,17 @@ type AddInventoryToLocationRequest struct {
 	ReferenceId     string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
 	ReferenceType   string                 `protobuf:"bytes,5,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"`
 	Notes           string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	Unit            string                 `protobuf:"bytes,7,opt,name=unit,proto3" json:"unit,omitempty"` // Unit of measure of the quantity; defaults to the base unit
+	// Retries with the same idempotency key return the location of the first
+	// attempt instead of moving the stock again
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AddInventoryToLocationRequest) Reset() {
 	*x = AddInventoryToLocationRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[18]
+	mi := &file_proto_inventory_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1603,7 +1711,7 @@ func (x *AddInventoryToLocationRequest) String() string {
 func (*AddInventoryToLocationRequest) ProtoMessage() {}
 
 func (x *AddInventoryToLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[18]
+	mi := &file_proto_inventory_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1616,7 +1724,7 @@ func (x *AddInventoryToLocationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddInventoryToLocationRequest.ProtoReflect.Descriptor instead.
 func (*AddInventoryToLocationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{18}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *AddInventoryToLocationRequest) GetInventoryItemId() string {
@@ -1661,6 +1769,20 @@ func (x *AddInventoryToLocationRequest) GetNotes() string {
 	return ""
 }
 
+func (x *AddInventoryToLocationRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *AddInventoryToLocationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type RemoveInventoryFromLocationRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
@@ -1669,13 +1791,17 @@ type RemoveInventoryFromLocationRequest struct {
 	ReferenceId     string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
 	ReferenceType   string                 `protobuf:"bytes,5,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"`
 	Notes           string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	Unit            string                 `protobuf:"bytes,7,opt,name=unit,proto3" json:"unit,omitempty"` // Unit of measure of the quantity; defaults to the base unit
+	// Retries with the same idempotency key return the location of the first
+	// attempt instead of moving the stock again
+	IdempotencyKey string `protobuf:"bytes,8,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *RemoveInventoryFromLocationRequest) Reset() {
 	*x = RemoveInventoryFromLocationRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[19]
+	mi := &file_proto_inventory_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1687,7 +1813,7 @@ func (x *RemoveInventoryFromLocationRequest) String() string {
 func (*RemoveInventoryFromLocationRequest) ProtoMessage() {}
 
 func (x *RemoveInventoryFromLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[19]
+	mi := &file_proto_inventory_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1700,7 +1826,7 @@ func (x *RemoveInventoryFromLocationRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RemoveInventoryFromLocationRequest.ProtoReflect.Descriptor instead.
 func (*RemoveInventoryFromLocationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{19}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *RemoveInventoryFromLocationRequest) GetInventoryItemId() string {
@@ -1745,6 +1871,20 @@ func (x *RemoveInventoryFromLocationRequest) GetNotes() string {
 	return ""
 }
 
+func (x *RemoveInventoryFromLocationRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *RemoveInventoryFromLocationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
 type GetInventoryByLocationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
@@ -1756,7 +1896,7 @@ type GetInventoryByLocationRequest struct {
 
 func (x *GetInventoryByLocationRequest) Reset() {
 	*x = GetInventoryByLocationRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[20]
+	mi := &file_proto_inventory_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1768,7 +1908,7 @@ func (x *GetInventoryByLocationRequest) String() string {
 func (*GetInventoryByLocationRequest) ProtoMessage() {}
 
 func (x *GetInventoryByLocationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[20]
+	mi := &file_proto_inventory_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1781,7 +1921,7 @@ func (x *GetInventoryByLocationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetInventoryByLocationRequest.ProtoReflect.Descriptor instead.
 func (*GetInventoryByLocationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{20}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetInventoryByLocationRequest) GetWarehouseId() string {
@@ -1805,28 +1945,31 @@ func (x *GetInventoryByLocationRequest) GetLimit() int32 {
 	return 0
 }
 
-type InventoryLocationResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	InventoryLocation *InventoryLocation     `protobuf:"bytes,1,opt,name=inventory_location,json=inventoryLocation,proto3" json:"inventory_location,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type SetStockBuffersRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	WarehouseId     string                 `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	SafetyStock     int32                  `protobuf:"varint,3,opt,name=safety_stock,json=safetyStock,proto3" json:"safety_stock,omitempty"`
+	MaxStock        int32                  `protobuf:"varint,4,opt,name=max_stock,json=maxStock,proto3" json:"max_stock,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *InventoryLocationResponse) Reset() {
-	*x = InventoryLocationResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[21]
+func (x *SetStockBuffersRequest) Reset() {
+	*x = SetStockBuffersRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InventoryLocationResponse) String() string {
+func (x *SetStockBuffersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InventoryLocationResponse) ProtoMessage() {}
+func (*SetStockBuffersRequest) ProtoMessage() {}
 
-func (x *InventoryLocationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[21]
+func (x *SetStockBuffersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1837,41 +1980,106 @@ func (x *InventoryLocationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InventoryLocationResponse.ProtoReflect.Descriptor instead.
-func (*InventoryLocationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SetStockBuffersRequest.ProtoReflect.Descriptor instead.
+func (*SetStockBuffersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *InventoryLocationResponse) GetInventoryLocation() *InventoryLocation {
+func (x *SetStockBuffersRequest) GetInventoryItemId() string {
 	if x != nil {
-		return x.InventoryLocation
+		return x.InventoryItemId
 	}
-	return nil
+	return ""
 }
 
-type ListInventoryLocationsResponse struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	InventoryLocations []*InventoryLocation   `protobuf:"bytes,1,rep,name=inventory_locations,json=inventoryLocations,proto3" json:"inventory_locations,omitempty"`
-	Total              int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+func (x *SetStockBuffersRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
 }
 
-func (x *ListInventoryLocationsResponse) Reset() {
-	*x = ListInventoryLocationsResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SetStockBuffersRequest) GetSafetyStock() int32 {
+	if x != nil {
+		return x.SafetyStock
+	}
+	return 0
 }
 
-func (x *ListInventoryLocationsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SetStockBuffersRequest) GetMaxStock() int32 {
+	if x != nil {
+		return x.MaxStock
+	}
+	return 0
 }
 
-func (*ListInventoryLocationsResponse) ProtoMessage() {}
-
-func (x *ListInventoryLocationsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[22]
+type InventoryLocationResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	InventoryLocation *InventoryLocation     `protobuf:"bytes,1,opt,name=inventory_location,json=inventoryLocation,proto3" json:"inventory_location,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InventoryLocationResponse) Reset() {
+	*x = InventoryLocationResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryLocationResponse) ProtoMessage() {}
+
+func (x *InventoryLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryLocationResponse.ProtoReflect.Descriptor instead.
+func (*InventoryLocationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InventoryLocationResponse) GetInventoryLocation() *InventoryLocation {
+	if x != nil {
+		return x.InventoryLocation
+	}
+	return nil
+}
+
+type ListInventoryLocationsResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	InventoryLocations []*InventoryLocation   `protobuf:"bytes,1,rep,name=inventory_locations,json=inventoryLocations,proto3" json:"inventory_locations,omitempty"`
+	Total              int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListInventoryLocationsResponse) Reset() {
+	*x = ListInventoryLocationsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryLocationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryLocationsResponse) ProtoMessage() {}
+
+func (x *ListInventoryLocationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,7 +2092,7 @@ func (x *ListInventoryLocationsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListInventoryLocationsResponse.ProtoReflect.Descriptor instead.
 func (*ListInventoryLocationsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{22}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ListInventoryLocationsResponse) GetInventoryLocations() []*InventoryLocation {
@@ -1913,7 +2121,7 @@ type ReserveInventoryRequest struct {
 
 func (x *ReserveInventoryRequest) Reset() {
 	*x = ReserveInventoryRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[23]
+	mi := &file_proto_inventory_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1925,7 +2133,7 @@ func (x *ReserveInventoryRequest) String() string {
 func (*ReserveInventoryRequest) ProtoMessage() {}
 
 func (x *ReserveInventoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[23]
+	mi := &file_proto_inventory_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1938,7 +2146,7 @@ func (x *ReserveInventoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReserveInventoryRequest.ProtoReflect.Descriptor instead.
 func (*ReserveInventoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{23}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ReserveInventoryRequest) GetItems() []*ReservationItem {
@@ -1974,13 +2182,14 @@ type ReservationItem struct {
 	InventoryItemId string                  `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
 	Quantity        int32                   `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	WarehouseId     *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Unit            string                  `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"` // Unit of measure of the quantity; defaults to the base unit
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ReservationItem) Reset() {
 	*x = ReservationItem{}
-	mi := &file_proto_inventory_proto_msgTypes[24]
+	mi := &file_proto_inventory_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1992,7 +2201,7 @@ func (x *ReservationItem) String() string {
 func (*ReservationItem) ProtoMessage() {}
 
 func (x *ReservationItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[24]
+	mi := &file_proto_inventory_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2005,7 +2214,7 @@ func (x *ReservationItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
 func (*ReservationItem) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{24}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ReservationItem) GetInventoryItemId() string {
@@ -2029,6 +2238,13 @@ func (x *ReservationItem) GetWarehouseId() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *ReservationItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 type ConfirmReservationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ReservationId string                 `protobuf:"bytes,1,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
@@ -2038,7 +2254,7 @@ type ConfirmReservationRequest struct {
 
 func (x *ConfirmReservationRequest) Reset() {
 	*x = ConfirmReservationRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[25]
+	mi := &file_proto_inventory_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2050,7 +2266,7 @@ func (x *ConfirmReservationRequest) String() string {
 func (*ConfirmReservationRequest) ProtoMessage() {}
 
 func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[25]
+	mi := &file_proto_inventory_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2063,7 +2279,7 @@ func (x *ConfirmReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfirmReservationRequest.ProtoReflect.Descriptor instead.
 func (*ConfirmReservationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{25}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ConfirmReservationRequest) GetReservationId() string {
@@ -2082,7 +2298,7 @@ type CancelReservationRequest struct {
 
 func (x *CancelReservationRequest) Reset() {
 	*x = CancelReservationRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[26]
+	mi := &file_proto_inventory_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2094,7 +2310,7 @@ func (x *CancelReservationRequest) String() string {
 func (*CancelReservationRequest) ProtoMessage() {}
 
 func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[26]
+	mi := &file_proto_inventory_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2107,7 +2323,7 @@ func (x *CancelReservationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelReservationRequest.ProtoReflect.Descriptor instead.
 func (*CancelReservationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{26}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CancelReservationRequest) GetReservationId() string {
@@ -2128,7 +2344,7 @@ type ReservationResponse struct {
 
 func (x *ReservationResponse) Reset() {
 	*x = ReservationResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[27]
+	mi := &file_proto_inventory_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2140,7 +2356,7 @@ func (x *ReservationResponse) String() string {
 func (*ReservationResponse) ProtoMessage() {}
 
 func (x *ReservationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[27]
+	mi := &file_proto_inventory_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2153,7 +2369,7 @@ func (x *ReservationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReservationResponse.ProtoReflect.Descriptor instead.
 func (*ReservationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{27}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ReservationResponse) GetReservation() *InventoryReservation {
@@ -2186,7 +2402,7 @@ type CheckInventoryAvailabilityRequest struct {
 
 func (x *CheckInventoryAvailabilityRequest) Reset() {
 	*x = CheckInventoryAvailabilityRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[28]
+	mi := &file_proto_inventory_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2198,7 +2414,7 @@ func (x *CheckInventoryAvailabilityRequest) String() string {
 func (*CheckInventoryAvailabilityRequest) ProtoMessage() {}
 
 func (x *CheckInventoryAvailabilityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[28]
+	mi := &file_proto_inventory_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2211,7 +2427,7 @@ func (x *CheckInventoryAvailabilityRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use CheckInventoryAvailabilityRequest.ProtoReflect.Descriptor instead.
 func (*CheckInventoryAvailabilityRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{28}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CheckInventoryAvailabilityRequest) GetItems() []*AvailabilityCheckItem {
@@ -2227,13 +2443,14 @@ type AvailabilityCheckItem struct {
 	VariantId     *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	Sku           string                  `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
 	Quantity      int32                   `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit          string                  `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"` // Unit of measure of the quantity; defaults to the base unit
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AvailabilityCheckItem) Reset() {
 	*x = AvailabilityCheckItem{}
-	mi := &file_proto_inventory_proto_msgTypes[29]
+	mi := &file_proto_inventory_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2245,7 +2462,7 @@ func (x *AvailabilityCheckItem) String() string {
 func (*AvailabilityCheckItem) ProtoMessage() {}
 
 func (x *AvailabilityCheckItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[29]
+	mi := &file_proto_inventory_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2258,7 +2475,7 @@ func (x *AvailabilityCheckItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AvailabilityCheckItem.ProtoReflect.Descriptor instead.
 func (*AvailabilityCheckItem) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{29}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *AvailabilityCheckItem) GetProductId() string {
@@ -2289,6 +2506,13 @@ func (x *AvailabilityCheckItem) GetQuantity() int32 {
 	return 0
 }
 
+func (x *AvailabilityCheckItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
 type InventoryAvailabilityResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*ItemAvailability    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -2299,7 +2523,7 @@ type InventoryAvailabilityResponse struct {
 
 func (x *InventoryAvailabilityResponse) Reset() {
 	*x = InventoryAvailabilityResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[30]
+	mi := &file_proto_inventory_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2311,7 +2535,7 @@ func (x *InventoryAvailabilityResponse) String() string {
 func (*InventoryAvailabilityResponse) ProtoMessage() {}
 
 func (x *InventoryAvailabilityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[30]
+	mi := &file_proto_inventory_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2324,7 +2548,7 @@ func (x *InventoryAvailabilityResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InventoryAvailabilityResponse.ProtoReflect.Descriptor instead.
 func (*InventoryAvailabilityResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{30}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *InventoryAvailabilityResponse) GetItems() []*ItemAvailability {
@@ -2350,13 +2574,20 @@ type ItemAvailability struct {
 	AvailableQuantity int32                   `protobuf:"varint,5,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
 	IsAvailable       bool                    `protobuf:"varint,6,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
 	Status            string                  `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	Availability      string                  `protobuf:"bytes,8,opt,name=availability,proto3" json:"availability,omitempty"` // Stock badge of the item
+	// The requested and available quantities are in base units; unit is the
+	// unit of measure of the check and available_units the whole units of it
+	// available
+	Unit           string `protobuf:"bytes,9,opt,name=unit,proto3" json:"unit,omitempty"`
+	UnitFactor     int32  `protobuf:"varint,10,opt,name=unit_factor,json=unitFactor,proto3" json:"unit_factor,omitempty"`
+	AvailableUnits int32  `protobuf:"varint,11,opt,name=available_units,json=availableUnits,proto3" json:"available_units,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *ItemAvailability) Reset() {
 	*x = ItemAvailability{}
-	mi := &file_proto_inventory_proto_msgTypes[31]
+	mi := &file_proto_inventory_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2368,7 +2599,7 @@ func (x *ItemAvailability) String() string {
 func (*ItemAvailability) ProtoMessage() {}
 
 func (x *ItemAvailability) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[31]
+	mi := &file_proto_inventory_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2381,7 +2612,7 @@ func (x *ItemAvailability) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ItemAvailability.ProtoReflect.Descriptor instead.
 func (*ItemAvailability) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{31}
+	return file_proto_inventory_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *ItemAvailability) GetProductId() string {
@@ -2433,28 +2664,56 @@ func (x *ItemAvailability) GetStatus() string {
 	return ""
 }
 
-type BulkUpdateInventoryRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*BulkUpdateItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+func (x *ItemAvailability) GetAvailability() string {
+	if x != nil {
+		return x.Availability
+	}
+	return ""
+}
+
+func (x *ItemAvailability) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ItemAvailability) GetUnitFactor() int32 {
+	if x != nil {
+		return x.UnitFactor
+	}
+	return 0
+}
+
+func (x *ItemAvailability) GetAvailableUnits() int32 {
+	if x != nil {
+		return x.AvailableUnits
+	}
+	return 0
+}
+
+type CheckAvailabilityBulkRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Lines         []*BulkAvailabilityLine `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUpdateInventoryRequest) Reset() {
-	*x = BulkUpdateInventoryRequest{}
-	mi := &file_proto_inventory_proto_msgTypes[32]
+func (x *CheckAvailabilityBulkRequest) Reset() {
+	*x = CheckAvailabilityBulkRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpdateInventoryRequest) String() string {
+func (x *CheckAvailabilityBulkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpdateInventoryRequest) ProtoMessage() {}
+func (*CheckAvailabilityBulkRequest) ProtoMessage() {}
 
-func (x *BulkUpdateInventoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[32]
+func (x *CheckAvailabilityBulkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2465,45 +2724,42 @@ func (x *BulkUpdateInventoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpdateInventoryRequest.ProtoReflect.Descriptor instead.
-func (*BulkUpdateInventoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CheckAvailabilityBulkRequest.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityBulkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *BulkUpdateInventoryRequest) GetItems() []*BulkUpdateItem {
+func (x *CheckAvailabilityBulkRequest) GetLines() []*BulkAvailabilityLine {
 	if x != nil {
-		return x.Items
+		return x.Lines
 	}
 	return nil
 }
 
-type BulkUpdateItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
-	QuantityDelta int32                  `protobuf:"varint,2,opt,name=quantity_delta,json=quantityDelta,proto3" json:"quantity_delta,omitempty"`
-	WarehouseId   string                 `protobuf:"bytes,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
-	ReferenceId   string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
-	ReferenceType string                 `protobuf:"bytes,5,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"`
-	Notes         string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+type BulkAvailabilityLine struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Sku           string                  `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      int32                   `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	WarehouseId   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUpdateItem) Reset() {
-	*x = BulkUpdateItem{}
-	mi := &file_proto_inventory_proto_msgTypes[33]
+func (x *BulkAvailabilityLine) Reset() {
+	*x = BulkAvailabilityLine{}
+	mi := &file_proto_inventory_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpdateItem) String() string {
+func (x *BulkAvailabilityLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpdateItem) ProtoMessage() {}
+func (*BulkAvailabilityLine) ProtoMessage() {}
 
-func (x *BulkUpdateItem) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[33]
+func (x *BulkAvailabilityLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2514,77 +2770,116 @@ func (x *BulkUpdateItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpdateItem.ProtoReflect.Descriptor instead.
-func (*BulkUpdateItem) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use BulkAvailabilityLine.ProtoReflect.Descriptor instead.
+func (*BulkAvailabilityLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *BulkUpdateItem) GetSku() string {
+func (x *BulkAvailabilityLine) GetSku() string {
 	if x != nil {
 		return x.Sku
 	}
 	return ""
 }
 
-func (x *BulkUpdateItem) GetQuantityDelta() int32 {
+func (x *BulkAvailabilityLine) GetQuantity() int32 {
 	if x != nil {
-		return x.QuantityDelta
+		return x.Quantity
 	}
 	return 0
 }
 
-func (x *BulkUpdateItem) GetWarehouseId() string {
+func (x *BulkAvailabilityLine) GetWarehouseId() *wrapperspb.StringValue {
 	if x != nil {
 		return x.WarehouseId
 	}
-	return ""
+	return nil
 }
 
-func (x *BulkUpdateItem) GetReferenceId() string {
+type CheckAvailabilityBulkResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Lines         []*BulkAvailabilityResult `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	AllAvailable  bool                      `protobuf:"varint,2,opt,name=all_available,json=allAvailable,proto3" json:"all_available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAvailabilityBulkResponse) Reset() {
+	*x = CheckAvailabilityBulkResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAvailabilityBulkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAvailabilityBulkResponse) ProtoMessage() {}
+
+func (x *CheckAvailabilityBulkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[36]
 	if x != nil {
-		return x.ReferenceId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *BulkUpdateItem) GetReferenceType() string {
+// Deprecated: Use CheckAvailabilityBulkResponse.ProtoReflect.Descriptor instead.
+func (*CheckAvailabilityBulkResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CheckAvailabilityBulkResponse) GetLines() []*BulkAvailabilityResult {
 	if x != nil {
-		return x.ReferenceType
+		return x.Lines
 	}
-	return ""
+	return nil
 }
 
-func (x *BulkUpdateItem) GetNotes() string {
+func (x *CheckAvailabilityBulkResponse) GetAllAvailable() bool {
 	if x != nil {
-		return x.Notes
+		return x.AllAvailable
 	}
-	return ""
+	return false
 }
 
-type BulkUpdateInventoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Results       []*BulkUpdateResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
-	SuccessCount  int32                  `protobuf:"varint,2,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
-	FailureCount  int32                  `protobuf:"varint,3,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BulkAvailabilityResult struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	LineIndex         int32                      `protobuf:"varint,1,opt,name=line_index,json=lineIndex,proto3" json:"line_index,omitempty"`
+	Sku               string                     `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductId         string                     `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId         *wrapperspb.StringValue    `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	WarehouseId       *wrapperspb.StringValue    `protobuf:"bytes,5,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	RequestedQuantity int32                      `protobuf:"varint,6,opt,name=requested_quantity,json=requestedQuantity,proto3" json:"requested_quantity,omitempty"`
+	AvailableQuantity int32                      `protobuf:"varint,7,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	IsAvailable       bool                       `protobuf:"varint,8,opt,name=is_available,json=isAvailable,proto3" json:"is_available,omitempty"`
+	Status            string                     `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Alternatives      []*AvailabilityAlternative `protobuf:"bytes,10,rep,name=alternatives,proto3" json:"alternatives,omitempty"`
+	Availability      string                     `protobuf:"bytes,11,opt,name=availability,proto3" json:"availability,omitempty"` // Stock badge of the item
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *BulkUpdateInventoryResponse) Reset() {
-	*x = BulkUpdateInventoryResponse{}
-	mi := &file_proto_inventory_proto_msgTypes[34]
+func (x *BulkAvailabilityResult) Reset() {
+	*x = BulkAvailabilityResult{}
+	mi := &file_proto_inventory_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpdateInventoryResponse) String() string {
+func (x *BulkAvailabilityResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpdateInventoryResponse) ProtoMessage() {}
+func (*BulkAvailabilityResult) ProtoMessage() {}
 
-func (x *BulkUpdateInventoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[34]
+func (x *BulkAvailabilityResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2595,57 +2890,11459 @@ func (x *BulkUpdateInventoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpdateInventoryResponse.ProtoReflect.Descriptor instead.
-func (*BulkUpdateInventoryResponse) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use BulkAvailabilityResult.ProtoReflect.Descriptor instead.
+func (*BulkAvailabilityResult) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *BulkUpdateInventoryResponse) GetResults() []*BulkUpdateResult {
+func (x *BulkAvailabilityResult) GetLineIndex() int32 {
 	if x != nil {
-		return x.Results
+		return x.LineIndex
 	}
-	return nil
+	return 0
+}
+
+func (x *BulkAvailabilityResult) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BulkAvailabilityResult) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BulkAvailabilityResult) GetVariantId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.VariantId
+	}
+	return nil
+}
+
+func (x *BulkAvailabilityResult) GetWarehouseId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return nil
+}
+
+func (x *BulkAvailabilityResult) GetRequestedQuantity() int32 {
+	if x != nil {
+		return x.RequestedQuantity
+	}
+	return 0
+}
+
+func (x *BulkAvailabilityResult) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *BulkAvailabilityResult) GetIsAvailable() bool {
+	if x != nil {
+		return x.IsAvailable
+	}
+	return false
+}
+
+func (x *BulkAvailabilityResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BulkAvailabilityResult) GetAlternatives() []*AvailabilityAlternative {
+	if x != nil {
+		return x.Alternatives
+	}
+	return nil
+}
+
+func (x *BulkAvailabilityResult) GetAvailability() string {
+	if x != nil {
+		return x.Availability
+	}
+	return ""
+}
+
+type AvailabilityAlternative struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Type              string                  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // OTHER_WAREHOUSE or REDUCED_QUANTITY
+	WarehouseId       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	WarehouseName     string                  `protobuf:"bytes,3,opt,name=warehouse_name,json=warehouseName,proto3" json:"warehouse_name,omitempty"`
+	Quantity          int32                   `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AvailableQuantity int32                   `protobuf:"varint,5,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AvailabilityAlternative) Reset() {
+	*x = AvailabilityAlternative{}
+	mi := &file_proto_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityAlternative) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityAlternative) ProtoMessage() {}
+
+func (x *AvailabilityAlternative) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityAlternative.ProtoReflect.Descriptor instead.
+func (*AvailabilityAlternative) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AvailabilityAlternative) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AvailabilityAlternative) GetWarehouseId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return nil
+}
+
+func (x *AvailabilityAlternative) GetWarehouseName() string {
+	if x != nil {
+		return x.WarehouseName
+	}
+	return ""
+}
+
+func (x *AvailabilityAlternative) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *AvailabilityAlternative) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+// Availability policy messages
+type AvailabilityPolicy struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// Sellable quantity at or below which stock is low; unset uses the reorder
+	// point of the items
+	LowStockThreshold *wrapperspb.Int32Value `protobuf:"bytes,2,opt,name=low_stock_threshold,json=lowStockThreshold,proto3" json:"low_stock_threshold,omitempty"`
+	// Sellable quantity at or below which the product is out of stock
+	OutOfStockThreshold int32                  `protobuf:"varint,3,opt,name=out_of_stock_threshold,json=outOfStockThreshold,proto3" json:"out_of_stock_threshold,omitempty"`
+	AllowBackorder      bool                   `protobuf:"varint,4,opt,name=allow_backorder,json=allowBackorder,proto3" json:"allow_backorder,omitempty"` // Out of stock products show BACKORDER
+	UpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                 // Unset for the default policy
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *AvailabilityPolicy) Reset() {
+	*x = AvailabilityPolicy{}
+	mi := &file_proto_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AvailabilityPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AvailabilityPolicy) ProtoMessage() {}
+
+func (x *AvailabilityPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AvailabilityPolicy.ProtoReflect.Descriptor instead.
+func (*AvailabilityPolicy) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AvailabilityPolicy) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AvailabilityPolicy) GetLowStockThreshold() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.LowStockThreshold
+	}
+	return nil
+}
+
+func (x *AvailabilityPolicy) GetOutOfStockThreshold() int32 {
+	if x != nil {
+		return x.OutOfStockThreshold
+	}
+	return 0
+}
+
+func (x *AvailabilityPolicy) GetAllowBackorder() bool {
+	if x != nil {
+		return x.AllowBackorder
+	}
+	return false
+}
+
+func (x *AvailabilityPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetAvailabilityPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityPolicyRequest) Reset() {
+	*x = GetAvailabilityPolicyRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityPolicyRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetAvailabilityPolicyRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type SetAvailabilityPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *AvailabilityPolicy    `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"` // Replaces the policy of the product
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAvailabilityPolicyRequest) Reset() {
+	*x = SetAvailabilityPolicyRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAvailabilityPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAvailabilityPolicyRequest) ProtoMessage() {}
+
+func (x *SetAvailabilityPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAvailabilityPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetAvailabilityPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetAvailabilityPolicyRequest) GetPolicy() *AvailabilityPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type DeleteAvailabilityPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAvailabilityPolicyRequest) Reset() {
+	*x = DeleteAvailabilityPolicyRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAvailabilityPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAvailabilityPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteAvailabilityPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAvailabilityPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAvailabilityPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteAvailabilityPolicyRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type DeleteAvailabilityPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAvailabilityPolicyResponse) Reset() {
+	*x = DeleteAvailabilityPolicyResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAvailabilityPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAvailabilityPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteAvailabilityPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAvailabilityPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAvailabilityPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteAvailabilityPolicyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type BulkUpdateInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*BulkUpdateItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateInventoryRequest) Reset() {
+	*x = BulkUpdateInventoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateInventoryRequest) ProtoMessage() {}
+
+func (x *BulkUpdateInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateInventoryRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *BulkUpdateInventoryRequest) GetItems() []*BulkUpdateItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BulkUpdateItem struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Sku            string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	QuantityDelta  int32                  `protobuf:"varint,2,opt,name=quantity_delta,json=quantityDelta,proto3" json:"quantity_delta,omitempty"`
+	WarehouseId    string                 `protobuf:"bytes,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ReferenceId    string                 `protobuf:"bytes,4,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	ReferenceType  string                 `protobuf:"bytes,5,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"`
+	Notes          string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Applies the update once per key, as in AddInventoryToLocation
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BulkUpdateItem) Reset() {
+	*x = BulkUpdateItem{}
+	mi := &file_proto_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateItem) ProtoMessage() {}
+
+func (x *BulkUpdateItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateItem.ProtoReflect.Descriptor instead.
+func (*BulkUpdateItem) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *BulkUpdateItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BulkUpdateItem) GetQuantityDelta() int32 {
+	if x != nil {
+		return x.QuantityDelta
+	}
+	return 0
+}
+
+func (x *BulkUpdateItem) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *BulkUpdateItem) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+func (x *BulkUpdateItem) GetReferenceType() string {
+	if x != nil {
+		return x.ReferenceType
+	}
+	return ""
+}
+
+func (x *BulkUpdateItem) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *BulkUpdateItem) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type BulkUpdateInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*BulkUpdateResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	SuccessCount  int32                  `protobuf:"varint,2,opt,name=success_count,json=successCount,proto3" json:"success_count,omitempty"`
+	FailureCount  int32                  `protobuf:"varint,3,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateInventoryResponse) Reset() {
+	*x = BulkUpdateInventoryResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateInventoryResponse) ProtoMessage() {}
+
+func (x *BulkUpdateInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateInventoryResponse.ProtoReflect.Descriptor instead.
+func (*BulkUpdateInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *BulkUpdateInventoryResponse) GetResults() []*BulkUpdateResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *BulkUpdateInventoryResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *BulkUpdateInventoryResponse) GetFailureCount() int32 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+type BulkUpdateResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	UpdatedItem   *InventoryItem         `protobuf:"bytes,4,opt,name=updated_item,json=updatedItem,proto3" json:"updated_item,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkUpdateResult) Reset() {
+	*x = BulkUpdateResult{}
+	mi := &file_proto_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkUpdateResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkUpdateResult) ProtoMessage() {}
+
+func (x *BulkUpdateResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkUpdateResult.ProtoReflect.Descriptor instead.
+func (*BulkUpdateResult) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *BulkUpdateResult) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BulkUpdateResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BulkUpdateResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BulkUpdateResult) GetUpdatedItem() *InventoryItem {
+	if x != nil {
+		return x.UpdatedItem
+	}
+	return nil
+}
+
+// Inventory Snapshot messages
+type InventorySnapshot struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SnapshotDate      *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=snapshot_date,json=snapshotDate,proto3" json:"snapshot_date,omitempty"`
+	InventoryItemId   string                  `protobuf:"bytes,3,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	WarehouseId       *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Quantity          int32                   `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	AvailableQuantity int32                   `protobuf:"varint,6,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	ReservedQuantity  int32                   `protobuf:"varint,7,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InventorySnapshot) Reset() {
+	*x = InventorySnapshot{}
+	mi := &file_proto_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventorySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventorySnapshot) ProtoMessage() {}
+
+func (x *InventorySnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventorySnapshot.ProtoReflect.Descriptor instead.
+func (*InventorySnapshot) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *InventorySnapshot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InventorySnapshot) GetSnapshotDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SnapshotDate
+	}
+	return nil
+}
+
+func (x *InventorySnapshot) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *InventorySnapshot) GetWarehouseId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return nil
+}
+
+func (x *InventorySnapshot) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventorySnapshot) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *InventorySnapshot) GetReservedQuantity() int32 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+// Stock streaming messages
+type WatchInventoryRequest struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	ProductIds     []string                `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	WarehouseId    *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	IncludeCurrent bool                    `protobuf:"varint,3,opt,name=include_current,json=includeCurrent,proto3" json:"include_current,omitempty"` // Send the current levels before any change
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *WatchInventoryRequest) Reset() {
+	*x = WatchInventoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchInventoryRequest) ProtoMessage() {}
+
+func (x *WatchInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchInventoryRequest.ProtoReflect.Descriptor instead.
+func (*WatchInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WatchInventoryRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *WatchInventoryRequest) GetWarehouseId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return nil
+}
+
+func (x *WatchInventoryRequest) GetIncludeCurrent() bool {
+	if x != nil {
+		return x.IncludeCurrent
+	}
+	return false
+}
+
+type StockChangeEvent struct {
+	state                      protoimpl.MessageState  `protogen:"open.v1"`
+	InventoryItemId            string                  `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	ProductId                  string                  `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId                  *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Sku                        string                  `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	TotalQuantity              int32                   `protobuf:"varint,5,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	AvailableQuantity          int32                   `protobuf:"varint,6,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	ReservedQuantity           int32                   `protobuf:"varint,7,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	Status                     string                  `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	WarehouseId                *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	WarehouseQuantity          int32                   `protobuf:"varint,10,opt,name=warehouse_quantity,json=warehouseQuantity,proto3" json:"warehouse_quantity,omitempty"`
+	WarehouseAvailableQuantity int32                   `protobuf:"varint,11,opt,name=warehouse_available_quantity,json=warehouseAvailableQuantity,proto3" json:"warehouse_available_quantity,omitempty"`
+	ChangeType                 string                  `protobuf:"bytes,12,opt,name=change_type,json=changeType,proto3" json:"change_type,omitempty"`
+	OccurredAt                 *timestamppb.Timestamp  `protobuf:"bytes,13,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	Availability               string                  `protobuf:"bytes,14,opt,name=availability,proto3" json:"availability,omitempty"` // Stock badge of the item after the change
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *StockChangeEvent) Reset() {
+	*x = StockChangeEvent{}
+	mi := &file_proto_inventory_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockChangeEvent) ProtoMessage() {}
+
+func (x *StockChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockChangeEvent.ProtoReflect.Descriptor instead.
+func (*StockChangeEvent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *StockChangeEvent) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *StockChangeEvent) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockChangeEvent) GetVariantId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.VariantId
+	}
+	return nil
+}
+
+func (x *StockChangeEvent) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *StockChangeEvent) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *StockChangeEvent) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *StockChangeEvent) GetReservedQuantity() int32 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *StockChangeEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *StockChangeEvent) GetWarehouseId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return nil
+}
+
+func (x *StockChangeEvent) GetWarehouseQuantity() int32 {
+	if x != nil {
+		return x.WarehouseQuantity
+	}
+	return 0
+}
+
+func (x *StockChangeEvent) GetWarehouseAvailableQuantity() int32 {
+	if x != nil {
+		return x.WarehouseAvailableQuantity
+	}
+	return 0
+}
+
+func (x *StockChangeEvent) GetChangeType() string {
+	if x != nil {
+		return x.ChangeType
+	}
+	return ""
+}
+
+func (x *StockChangeEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *StockChangeEvent) GetAvailability() string {
+	if x != nil {
+		return x.Availability
+	}
+	return ""
+}
+
+type GetStockHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetStockHistoryRequest_Id
+	//	*GetStockHistoryRequest_ProductId
+	//	*GetStockHistoryRequest_Sku
+	Identifier    isGetStockHistoryRequest_Identifier `protobuf_oneof:"identifier"`
+	WarehouseId   *wrapperspb.StringValue             `protobuf:"bytes,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	StartDate     *timestamppb.Timestamp              `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamppb.Timestamp              `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockHistoryRequest) Reset() {
+	*x = GetStockHistoryRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockHistoryRequest) ProtoMessage() {}
+
+func (x *GetStockHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetStockHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetStockHistoryRequest) GetIdentifier() isGetStockHistoryRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *GetStockHistoryRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetStockHistoryRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *GetStockHistoryRequest) GetProductId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetStockHistoryRequest_ProductId); ok {
+			return x.ProductId
+		}
+	}
+	return ""
+}
+
+func (x *GetStockHistoryRequest) GetSku() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetStockHistoryRequest_Sku); ok {
+			return x.Sku
+		}
+	}
+	return ""
+}
+
+func (x *GetStockHistoryRequest) GetWarehouseId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return nil
+}
+
+func (x *GetStockHistoryRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetStockHistoryRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+type isGetStockHistoryRequest_Identifier interface {
+	isGetStockHistoryRequest_Identifier()
+}
+
+type GetStockHistoryRequest_Id struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type GetStockHistoryRequest_ProductId struct {
+	ProductId string `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3,oneof"`
+}
+
+type GetStockHistoryRequest_Sku struct {
+	Sku string `protobuf:"bytes,3,opt,name=sku,proto3,oneof"`
+}
+
+func (*GetStockHistoryRequest_Id) isGetStockHistoryRequest_Identifier() {}
+
+func (*GetStockHistoryRequest_ProductId) isGetStockHistoryRequest_Identifier() {}
+
+func (*GetStockHistoryRequest_Sku) isGetStockHistoryRequest_Identifier() {}
+
+type StockHistoryResponse struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	InventoryItemId   string                  `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	WarehouseId       *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Snapshots         []*InventorySnapshot    `protobuf:"bytes,3,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	AverageDailyUsage float64                 `protobuf:"fixed64,4,opt,name=average_daily_usage,json=averageDailyUsage,proto3" json:"average_daily_usage,omitempty"`
+	// Days the current available quantity lasts at the average daily usage, -1 when there is no usage
+	DaysOfCover   float64 `protobuf:"fixed64,5,opt,name=days_of_cover,json=daysOfCover,proto3" json:"days_of_cover,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockHistoryResponse) Reset() {
+	*x = StockHistoryResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockHistoryResponse) ProtoMessage() {}
+
+func (x *StockHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockHistoryResponse.ProtoReflect.Descriptor instead.
+func (*StockHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *StockHistoryResponse) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *StockHistoryResponse) GetWarehouseId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return nil
+}
+
+func (x *StockHistoryResponse) GetSnapshots() []*InventorySnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+func (x *StockHistoryResponse) GetAverageDailyUsage() float64 {
+	if x != nil {
+		return x.AverageDailyUsage
+	}
+	return 0
+}
+
+func (x *StockHistoryResponse) GetDaysOfCover() float64 {
+	if x != nil {
+		return x.DaysOfCover
+	}
+	return 0
+}
+
+type GetForecastRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetForecastRequest_Id
+	//	*GetForecastRequest_ProductId
+	//	*GetForecastRequest_Sku
+	Identifier    isGetForecastRequest_Identifier `protobuf_oneof:"identifier"`
+	WarehouseId   string                          `protobuf:"bytes,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetForecastRequest) Reset() {
+	*x = GetForecastRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastRequest) ProtoMessage() {}
+
+func (x *GetForecastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastRequest.ProtoReflect.Descriptor instead.
+func (*GetForecastRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetForecastRequest) GetIdentifier() isGetForecastRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *GetForecastRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetForecastRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *GetForecastRequest) GetProductId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetForecastRequest_ProductId); ok {
+			return x.ProductId
+		}
+	}
+	return ""
+}
+
+func (x *GetForecastRequest) GetSku() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetForecastRequest_Sku); ok {
+			return x.Sku
+		}
+	}
+	return ""
+}
+
+func (x *GetForecastRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+type isGetForecastRequest_Identifier interface {
+	isGetForecastRequest_Identifier()
+}
+
+type GetForecastRequest_Id struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type GetForecastRequest_ProductId struct {
+	ProductId string `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3,oneof"`
+}
+
+type GetForecastRequest_Sku struct {
+	Sku string `protobuf:"bytes,3,opt,name=sku,proto3,oneof"`
+}
+
+func (*GetForecastRequest_Id) isGetForecastRequest_Identifier() {}
+
+func (*GetForecastRequest_ProductId) isGetForecastRequest_Identifier() {}
+
+func (*GetForecastRequest_Sku) isGetForecastRequest_Identifier() {}
+
+// The forecast of an item at a warehouse. Velocities are units sold per day.
+type InventoryForecast struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId   string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	ProductId         string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku               string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	WarehouseId       string                 `protobuf:"bytes,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ShortVelocity     float64                `protobuf:"fixed64,5,opt,name=short_velocity,json=shortVelocity,proto3" json:"short_velocity,omitempty"` // Over the last 7 days
+	LongVelocity      float64                `protobuf:"fixed64,6,opt,name=long_velocity,json=longVelocity,proto3" json:"long_velocity,omitempty"`    // Over the last 28 days
+	DailyVelocity     float64                `protobuf:"fixed64,7,opt,name=daily_velocity,json=dailyVelocity,proto3" json:"daily_velocity,omitempty"` // Average of the short and long velocities
+	AvailableQuantity int32                  `protobuf:"varint,8,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	OnOrderQuantity   int32                  `protobuf:"varint,9,opt,name=on_order_quantity,json=onOrderQuantity,proto3" json:"on_order_quantity,omitempty"` // Open purchase order units not received yet
+	SafetyStock       int32                  `protobuf:"varint,10,opt,name=safety_stock,json=safetyStock,proto3" json:"safety_stock,omitempty"`
+	LeadTimeDays      int32                  `protobuf:"varint,11,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"` // Shortest lead time of the suppliers of the item
+	// Days the available quantity lasts, -1 without sales
+	DaysOfCover     float64                `protobuf:"fixed64,12,opt,name=days_of_cover,json=daysOfCover,proto3" json:"days_of_cover,omitempty"`
+	StockoutDate    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=stockout_date,json=stockoutDate,proto3" json:"stockout_date,omitempty"`           // Unset without sales
+	ReorderQuantity int32                  `protobuf:"varint,14,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"` // Suggested quantity to order now
+	ComputedAt      *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InventoryForecast) Reset() {
+	*x = InventoryForecast{}
+	mi := &file_proto_inventory_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryForecast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryForecast) ProtoMessage() {}
+
+func (x *InventoryForecast) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryForecast.ProtoReflect.Descriptor instead.
+func (*InventoryForecast) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *InventoryForecast) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *InventoryForecast) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *InventoryForecast) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *InventoryForecast) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *InventoryForecast) GetShortVelocity() float64 {
+	if x != nil {
+		return x.ShortVelocity
+	}
+	return 0
+}
+
+func (x *InventoryForecast) GetLongVelocity() float64 {
+	if x != nil {
+		return x.LongVelocity
+	}
+	return 0
+}
+
+func (x *InventoryForecast) GetDailyVelocity() float64 {
+	if x != nil {
+		return x.DailyVelocity
+	}
+	return 0
+}
+
+func (x *InventoryForecast) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *InventoryForecast) GetOnOrderQuantity() int32 {
+	if x != nil {
+		return x.OnOrderQuantity
+	}
+	return 0
+}
+
+func (x *InventoryForecast) GetSafetyStock() int32 {
+	if x != nil {
+		return x.SafetyStock
+	}
+	return 0
+}
+
+func (x *InventoryForecast) GetLeadTimeDays() int32 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *InventoryForecast) GetDaysOfCover() float64 {
+	if x != nil {
+		return x.DaysOfCover
+	}
+	return 0
+}
+
+func (x *InventoryForecast) GetStockoutDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StockoutDate
+	}
+	return nil
+}
+
+func (x *InventoryForecast) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *InventoryForecast) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type GetForecastResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Forecasts       []*InventoryForecast   `protobuf:"bytes,2,rep,name=forecasts,proto3" json:"forecasts,omitempty"` // One per warehouse
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetForecastResponse) Reset() {
+	*x = GetForecastResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetForecastResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetForecastResponse) ProtoMessage() {}
+
+func (x *GetForecastResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetForecastResponse.ProtoReflect.Descriptor instead.
+func (*GetForecastResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetForecastResponse) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *GetForecastResponse) GetForecasts() []*InventoryForecast {
+	if x != nil {
+		return x.Forecasts
+	}
+	return nil
+}
+
+type ListReorderSuggestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // Optional
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReorderSuggestionsRequest) Reset() {
+	*x = ListReorderSuggestionsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReorderSuggestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReorderSuggestionsRequest) ProtoMessage() {}
+
+func (x *ListReorderSuggestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReorderSuggestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReorderSuggestionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListReorderSuggestionsRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ListReorderSuggestionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReorderSuggestionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListReorderSuggestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suggestions   []*InventoryForecast   `protobuf:"bytes,1,rep,name=suggestions,proto3" json:"suggestions,omitempty"` // Running out first first
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReorderSuggestionsResponse) Reset() {
+	*x = ListReorderSuggestionsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReorderSuggestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReorderSuggestionsResponse) ProtoMessage() {}
+
+func (x *ListReorderSuggestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReorderSuggestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReorderSuggestionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListReorderSuggestionsResponse) GetSuggestions() []*InventoryForecast {
+	if x != nil {
+		return x.Suggestions
+	}
+	return nil
+}
+
+func (x *ListReorderSuggestionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ListStockAlertsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return alerts of this warehouse; item-level low stock alerts are omitted
+	WarehouseId   *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockAlertsRequest) Reset() {
+	*x = ListStockAlertsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockAlertsRequest) ProtoMessage() {}
+
+func (x *ListStockAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListStockAlertsRequest) GetWarehouseId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return nil
+}
+
+type StockAlert struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Type            string                  `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // LOW_STOCK, BELOW_SAFETY_STOCK or ABOVE_MAX_STOCK
+	InventoryItemId string                  `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	ProductId       string                  `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku             string                  `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	WarehouseId     *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Quantity        int32                   `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Threshold       int32                   `protobuf:"varint,7,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	DetectedAt      *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StockAlert) Reset() {
+	*x = StockAlert{}
+	mi := &file_proto_inventory_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAlert) ProtoMessage() {}
+
+func (x *StockAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAlert.ProtoReflect.Descriptor instead.
+func (*StockAlert) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *StockAlert) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StockAlert) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *StockAlert) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockAlert) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *StockAlert) GetWarehouseId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return nil
+}
+
+func (x *StockAlert) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockAlert) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *StockAlert) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+type ListStockAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*StockAlert          `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockAlertsResponse) Reset() {
+	*x = ListStockAlertsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockAlertsResponse) ProtoMessage() {}
+
+func (x *ListStockAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListStockAlertsResponse) GetAlerts() []*StockAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type ListInventoryActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return movements older than the one of this time and ID, for
+	// readers to page backwards; unset for the newest
+	BeforeTime    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=before_time,json=beforeTime,proto3" json:"before_time,omitempty"`
+	BeforeId      string                 `protobuf:"bytes,2,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInventoryActivityRequest) Reset() {
+	*x = ListInventoryActivityRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryActivityRequest) ProtoMessage() {}
+
+func (x *ListInventoryActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryActivityRequest.ProtoReflect.Descriptor instead.
+func (*ListInventoryActivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListInventoryActivityRequest) GetBeforeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BeforeTime
+	}
+	return nil
+}
+
+func (x *ListInventoryActivityRequest) GetBeforeId() string {
+	if x != nil {
+		return x.BeforeId
+	}
+	return ""
+}
+
+func (x *ListInventoryActivityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// A stock movement of an inventory item
+type InventoryActivity struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	ProductId       string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku             string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	WarehouseId     string                 `protobuf:"bytes,5,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	TransactionType string                 `protobuf:"bytes,6,opt,name=transaction_type,json=transactionType,proto3" json:"transaction_type,omitempty"`
+	Quantity        int32                  `protobuf:"varint,7,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReferenceType   string                 `protobuf:"bytes,8,opt,name=reference_type,json=referenceType,proto3" json:"reference_type,omitempty"`
+	Notes           string                 `protobuf:"bytes,9,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedBy       string                 `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"` // Empty when the movement was not made by a user
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Unit of measure and quantity the movement was entered in, when not in
+	// base units; quantity is always in base units
+	UnitCode      string `protobuf:"bytes,12,opt,name=unit_code,json=unitCode,proto3" json:"unit_code,omitempty"`
+	UnitQuantity  int32  `protobuf:"varint,13,opt,name=unit_quantity,json=unitQuantity,proto3" json:"unit_quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryActivity) Reset() {
+	*x = InventoryActivity{}
+	mi := &file_proto_inventory_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryActivity) ProtoMessage() {}
+
+func (x *InventoryActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryActivity.ProtoReflect.Descriptor instead.
+func (*InventoryActivity) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *InventoryActivity) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InventoryActivity) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *InventoryActivity) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *InventoryActivity) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *InventoryActivity) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *InventoryActivity) GetTransactionType() string {
+	if x != nil {
+		return x.TransactionType
+	}
+	return ""
+}
+
+func (x *InventoryActivity) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventoryActivity) GetReferenceType() string {
+	if x != nil {
+		return x.ReferenceType
+	}
+	return ""
+}
+
+func (x *InventoryActivity) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *InventoryActivity) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *InventoryActivity) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *InventoryActivity) GetUnitCode() string {
+	if x != nil {
+		return x.UnitCode
+	}
+	return ""
+}
+
+func (x *InventoryActivity) GetUnitQuantity() int32 {
+	if x != nil {
+		return x.UnitQuantity
+	}
+	return 0
+}
+
+type ListInventoryActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*InventoryActivity   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInventoryActivityResponse) Reset() {
+	*x = ListInventoryActivityResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryActivityResponse) ProtoMessage() {}
+
+func (x *ListInventoryActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryActivityResponse.ProtoReflect.Descriptor instead.
+func (*ListInventoryActivityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListInventoryActivityResponse) GetEntries() []*InventoryActivity {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Diagnostics messages
+type GetDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiagnosticsRequest) Reset() {
+	*x = GetDiagnosticsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{64}
+}
+
+type DBPoolDiagnostics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Replica             bool                   `protobuf:"varint,2,opt,name=replica,proto3" json:"replica,omitempty"`
+	MaxOpenConnections  int32                  `protobuf:"varint,3,opt,name=max_open_connections,json=maxOpenConnections,proto3" json:"max_open_connections,omitempty"`
+	OpenConnections     int32                  `protobuf:"varint,4,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
+	InUse               int32                  `protobuf:"varint,5,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	Idle                int32                  `protobuf:"varint,6,opt,name=idle,proto3" json:"idle,omitempty"`
+	WaitCount           int64                  `protobuf:"varint,7,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	WaitDurationSeconds float64                `protobuf:"fixed64,8,opt,name=wait_duration_seconds,json=waitDurationSeconds,proto3" json:"wait_duration_seconds,omitempty"`
+	MaxIdleClosed       int64                  `protobuf:"varint,9,opt,name=max_idle_closed,json=maxIdleClosed,proto3" json:"max_idle_closed,omitempty"`
+	MaxIdleTimeClosed   int64                  `protobuf:"varint,10,opt,name=max_idle_time_closed,json=maxIdleTimeClosed,proto3" json:"max_idle_time_closed,omitempty"`
+	MaxLifetimeClosed   int64                  `protobuf:"varint,11,opt,name=max_lifetime_closed,json=maxLifetimeClosed,proto3" json:"max_lifetime_closed,omitempty"`
+	ReplicaLagKnown     bool                   `protobuf:"varint,12,opt,name=replica_lag_known,json=replicaLagKnown,proto3" json:"replica_lag_known,omitempty"`
+	ReplicaLagSeconds   float64                `protobuf:"fixed64,13,opt,name=replica_lag_seconds,json=replicaLagSeconds,proto3" json:"replica_lag_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DBPoolDiagnostics) Reset() {
+	*x = DBPoolDiagnostics{}
+	mi := &file_proto_inventory_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBPoolDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBPoolDiagnostics) ProtoMessage() {}
+
+func (x *DBPoolDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBPoolDiagnostics.ProtoReflect.Descriptor instead.
+func (*DBPoolDiagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DBPoolDiagnostics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DBPoolDiagnostics) GetReplica() bool {
+	if x != nil {
+		return x.Replica
+	}
+	return false
+}
+
+func (x *DBPoolDiagnostics) GetMaxOpenConnections() int32 {
+	if x != nil {
+		return x.MaxOpenConnections
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetOpenConnections() int32 {
+	if x != nil {
+		return x.OpenConnections
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetInUse() int32 {
+	if x != nil {
+		return x.InUse
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetIdle() int32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetWaitCount() int64 {
+	if x != nil {
+		return x.WaitCount
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetWaitDurationSeconds() float64 {
+	if x != nil {
+		return x.WaitDurationSeconds
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxIdleClosed() int64 {
+	if x != nil {
+		return x.MaxIdleClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxIdleTimeClosed() int64 {
+	if x != nil {
+		return x.MaxIdleTimeClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxLifetimeClosed() int64 {
+	if x != nil {
+		return x.MaxLifetimeClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetReplicaLagKnown() bool {
+	if x != nil {
+		return x.ReplicaLagKnown
+	}
+	return false
+}
+
+func (x *DBPoolDiagnostics) GetReplicaLagSeconds() float64 {
+	if x != nil {
+		return x.ReplicaLagSeconds
+	}
+	return 0
+}
+
+type CacheDiagnostics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CircuitBreakerState string                 `protobuf:"bytes,2,opt,name=circuit_breaker_state,json=circuitBreakerState,proto3" json:"circuit_breaker_state,omitempty"` // closed, open or half-open
+	Healthy             bool                   `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	MemoryEntries       int64                  `protobuf:"varint,4,opt,name=memory_entries,json=memoryEntries,proto3" json:"memory_entries,omitempty"`
+	Hits                int64                  `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses              int64                  `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`
+	Errors              int64                  `protobuf:"varint,7,opt,name=errors,proto3" json:"errors,omitempty"`
+	HitRate             float64                `protobuf:"fixed64,8,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CacheDiagnostics) Reset() {
+	*x = CacheDiagnostics{}
+	mi := &file_proto_inventory_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheDiagnostics) ProtoMessage() {}
+
+func (x *CacheDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheDiagnostics.ProtoReflect.Descriptor instead.
+func (*CacheDiagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CacheDiagnostics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CacheDiagnostics) GetCircuitBreakerState() string {
+	if x != nil {
+		return x.CircuitBreakerState
+	}
+	return ""
+}
+
+func (x *CacheDiagnostics) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *CacheDiagnostics) GetMemoryEntries() int64 {
+	if x != nil {
+		return x.MemoryEntries
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetHitRate() float64 {
+	if x != nil {
+		return x.HitRate
+	}
+	return 0
+}
+
+type DiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	CollectedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	UptimeSeconds float64                `protobuf:"fixed64,3,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	Goroutines    int32                  `protobuf:"varint,4,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	DbPools       []*DBPoolDiagnostics   `protobuf:"bytes,5,rep,name=db_pools,json=dbPools,proto3" json:"db_pools,omitempty"`
+	Caches        []*CacheDiagnostics    `protobuf:"bytes,6,rep,name=caches,proto3" json:"caches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosticsResponse) Reset() {
+	*x = DiagnosticsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsResponse) ProtoMessage() {}
+
+func (x *DiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*DiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DiagnosticsResponse) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *DiagnosticsResponse) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetUptimeSeconds() float64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetGoroutines() int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetDbPools() []*DBPoolDiagnostics {
+	if x != nil {
+		return x.DbPools
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetCaches() []*CacheDiagnostics {
+	if x != nil {
+		return x.Caches
+	}
+	return nil
+}
+
+// Fulfillment provider integration messages
+type IntegrationKey struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Provider string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	Name     string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// key_prefix is the start of the key, to tell keys apart
+	KeyPrefix  string                 `protobuf:"bytes,4,opt,name=key_prefix,json=keyPrefix,proto3" json:"key_prefix,omitempty"`
+	CreatedAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUsedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	// daily_quota is the number of requests per day (UTC); 0 is unlimited
+	DailyQuota    int32 `protobuf:"varint,8,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`
+	UsedToday     int32 `protobuf:"varint,9,opt,name=used_today,json=usedToday,proto3" json:"used_today,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationKey) Reset() {
+	*x = IntegrationKey{}
+	mi := &file_proto_inventory_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationKey) ProtoMessage() {}
+
+func (x *IntegrationKey) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationKey.ProtoReflect.Descriptor instead.
+func (*IntegrationKey) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *IntegrationKey) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *IntegrationKey) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *IntegrationKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *IntegrationKey) GetKeyPrefix() string {
+	if x != nil {
+		return x.KeyPrefix
+	}
+	return ""
+}
+
+func (x *IntegrationKey) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *IntegrationKey) GetLastUsedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return nil
+}
+
+func (x *IntegrationKey) GetRevokedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return nil
+}
+
+func (x *IntegrationKey) GetDailyQuota() int32 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+func (x *IntegrationKey) GetUsedToday() int32 {
+	if x != nil {
+		return x.UsedToday
+	}
+	return 0
+}
+
+type CreateIntegrationKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DailyQuota    int32                  `protobuf:"varint,3,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIntegrationKeyRequest) Reset() {
+	*x = CreateIntegrationKeyRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIntegrationKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIntegrationKeyRequest) ProtoMessage() {}
+
+func (x *CreateIntegrationKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIntegrationKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateIntegrationKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateIntegrationKeyRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *CreateIntegrationKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateIntegrationKeyRequest) GetDailyQuota() int32 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+type CreateIntegrationKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   *IntegrationKey        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// secret is the API key; it is only returned here
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateIntegrationKeyResponse) Reset() {
+	*x = CreateIntegrationKeyResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateIntegrationKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateIntegrationKeyResponse) ProtoMessage() {}
+
+func (x *CreateIntegrationKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateIntegrationKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateIntegrationKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateIntegrationKeyResponse) GetKey() *IntegrationKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *CreateIntegrationKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type ListIntegrationKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationKeysRequest) Reset() {
+	*x = ListIntegrationKeysRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationKeysRequest) ProtoMessage() {}
+
+func (x *ListIntegrationKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationKeysRequest.ProtoReflect.Descriptor instead.
+func (*ListIntegrationKeysRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{71}
+}
+
+type ListIntegrationKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*IntegrationKey      `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIntegrationKeysResponse) Reset() {
+	*x = ListIntegrationKeysResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIntegrationKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIntegrationKeysResponse) ProtoMessage() {}
+
+func (x *ListIntegrationKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIntegrationKeysResponse.ProtoReflect.Descriptor instead.
+func (*ListIntegrationKeysResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListIntegrationKeysResponse) GetKeys() []*IntegrationKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+type RevokeIntegrationKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeIntegrationKeyRequest) Reset() {
+	*x = RevokeIntegrationKeyRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeIntegrationKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeIntegrationKeyRequest) ProtoMessage() {}
+
+func (x *RevokeIntegrationKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeIntegrationKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeIntegrationKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *RevokeIntegrationKeyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type IntegrationKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *IntegrationKey        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationKeyResponse) Reset() {
+	*x = IntegrationKeyResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationKeyResponse) ProtoMessage() {}
+
+func (x *IntegrationKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationKeyResponse.ProtoReflect.Descriptor instead.
+func (*IntegrationKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *IntegrationKeyResponse) GetKey() *IntegrationKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type SetIntegrationKeyQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DailyQuota    int32                  `protobuf:"varint,2,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"` // 0 removes the quota
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIntegrationKeyQuotaRequest) Reset() {
+	*x = SetIntegrationKeyQuotaRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIntegrationKeyQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIntegrationKeyQuotaRequest) ProtoMessage() {}
+
+func (x *SetIntegrationKeyQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIntegrationKeyQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetIntegrationKeyQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetIntegrationKeyQuotaRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SetIntegrationKeyQuotaRequest) GetDailyQuota() int32 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+type GetIntegrationQuotaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIntegrationQuotaRequest) Reset() {
+	*x = GetIntegrationQuotaRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIntegrationQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIntegrationQuotaRequest) ProtoMessage() {}
+
+func (x *GetIntegrationQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIntegrationQuotaRequest.ProtoReflect.Descriptor instead.
+func (*GetIntegrationQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetIntegrationQuotaRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+// IntegrationQuota is the usage of an API key today. Quotas are soft:
+// requests over the quota are served, with remaining at 0.
+type IntegrationQuota struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	Provider      string                 `protobuf:"bytes,2,opt,name=provider,proto3" json:"provider,omitempty"`
+	DailyQuota    int32                  `protobuf:"varint,3,opt,name=daily_quota,json=dailyQuota,proto3" json:"daily_quota,omitempty"` // 0 is unlimited
+	Used          int32                  `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+	Remaining     int32                  `protobuf:"varint,5,opt,name=remaining,proto3" json:"remaining,omitempty"` // 0 for unlimited keys
+	ResetsAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=resets_at,json=resetsAt,proto3" json:"resets_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntegrationQuota) Reset() {
+	*x = IntegrationQuota{}
+	mi := &file_proto_inventory_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntegrationQuota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntegrationQuota) ProtoMessage() {}
+
+func (x *IntegrationQuota) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntegrationQuota.ProtoReflect.Descriptor instead.
+func (*IntegrationQuota) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *IntegrationQuota) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *IntegrationQuota) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *IntegrationQuota) GetDailyQuota() int32 {
+	if x != nil {
+		return x.DailyQuota
+	}
+	return 0
+}
+
+func (x *IntegrationQuota) GetUsed() int32 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *IntegrationQuota) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *IntegrationQuota) GetResetsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResetsAt
+	}
+	return nil
+}
+
+type FulfillmentLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FulfillmentLine) Reset() {
+	*x = FulfillmentLine{}
+	mi := &file_proto_inventory_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillmentLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillmentLine) ProtoMessage() {}
+
+func (x *FulfillmentLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillmentLine.ProtoReflect.Descriptor instead.
+func (*FulfillmentLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *FulfillmentLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *FulfillmentLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type FulfillmentEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// id is the provider's ID of the event; each ID is applied once
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // stock.updated or shipment.confirmed
+	WarehouseCode string                 `protobuf:"bytes,3,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	// Stock updates
+	Sku      string `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity int32  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Mode     string `protobuf:"bytes,7,opt,name=mode,proto3" json:"mode,omitempty"` // set (on-hand quantity, default) or adjust (change)
+	// Shipment confirmations
+	OrderReference string             `protobuf:"bytes,8,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	Carrier        string             `protobuf:"bytes,9,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string             `protobuf:"bytes,10,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	Lines          []*FulfillmentLine `protobuf:"bytes,11,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FulfillmentEvent) Reset() {
+	*x = FulfillmentEvent{}
+	mi := &file_proto_inventory_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillmentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillmentEvent) ProtoMessage() {}
+
+func (x *FulfillmentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillmentEvent.ProtoReflect.Descriptor instead.
+func (*FulfillmentEvent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *FulfillmentEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FulfillmentEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *FulfillmentEvent) GetWarehouseCode() string {
+	if x != nil {
+		return x.WarehouseCode
+	}
+	return ""
+}
+
+func (x *FulfillmentEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *FulfillmentEvent) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *FulfillmentEvent) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *FulfillmentEvent) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *FulfillmentEvent) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *FulfillmentEvent) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *FulfillmentEvent) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *FulfillmentEvent) GetLines() []*FulfillmentLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type PushFulfillmentEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Events        []*FulfillmentEvent    `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushFulfillmentEventsRequest) Reset() {
+	*x = PushFulfillmentEventsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushFulfillmentEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFulfillmentEventsRequest) ProtoMessage() {}
+
+func (x *PushFulfillmentEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFulfillmentEventsRequest.ProtoReflect.Descriptor instead.
+func (*PushFulfillmentEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *PushFulfillmentEventsRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *PushFulfillmentEventsRequest) GetEvents() []*FulfillmentEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type FulfillmentEventResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // applied, duplicate or failed
+	Error         string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FulfillmentEventResult) Reset() {
+	*x = FulfillmentEventResult{}
+	mi := &file_proto_inventory_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillmentEventResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillmentEventResult) ProtoMessage() {}
+
+func (x *FulfillmentEventResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillmentEventResult.ProtoReflect.Descriptor instead.
+func (*FulfillmentEventResult) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *FulfillmentEventResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FulfillmentEventResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FulfillmentEventResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type PushFulfillmentEventsResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Provider      string                    `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Results       []*FulfillmentEventResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Quota         *IntegrationQuota         `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushFulfillmentEventsResponse) Reset() {
+	*x = PushFulfillmentEventsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushFulfillmentEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFulfillmentEventsResponse) ProtoMessage() {}
+
+func (x *PushFulfillmentEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFulfillmentEventsResponse.ProtoReflect.Descriptor instead.
+func (*PushFulfillmentEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *PushFulfillmentEventsResponse) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *PushFulfillmentEventsResponse) GetResults() []*FulfillmentEventResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *PushFulfillmentEventsResponse) GetQuota() *IntegrationQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type OrderStatusEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderReference string                 `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Provider       string                 `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	Carrier        string                 `protobuf:"bytes,5,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,6,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *OrderStatusEvent) Reset() {
+	*x = OrderStatusEvent{}
+	mi := &file_proto_inventory_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusEvent) ProtoMessage() {}
+
+func (x *OrderStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusEvent.ProtoReflect.Descriptor instead.
+func (*OrderStatusEvent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *OrderStatusEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderStatusEvent) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *OrderStatusEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *OrderStatusEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListOrderStatusEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return events after this ID, for readers to resume where they stopped
+	AfterId       int64 `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderStatusEventsRequest) Reset() {
+	*x = ListOrderStatusEventsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderStatusEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderStatusEventsRequest) ProtoMessage() {}
+
+func (x *ListOrderStatusEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderStatusEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderStatusEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListOrderStatusEventsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListOrderStatusEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListOrderStatusEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*OrderStatusEvent    `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderStatusEventsResponse) Reset() {
+	*x = ListOrderStatusEventsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderStatusEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderStatusEventsResponse) ProtoMessage() {}
+
+func (x *ListOrderStatusEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderStatusEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderStatusEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListOrderStatusEventsResponse) GetEvents() []*OrderStatusEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Shipment tracking messages
+type ShipmentEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// label_created, in_transit, out_for_delivery, delivered, exception or returned
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"` // manual, fulfillment, webhook or poll
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentEvent) Reset() {
+	*x = ShipmentEvent{}
+	mi := &file_proto_inventory_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentEvent) ProtoMessage() {}
+
+func (x *ShipmentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentEvent.ProtoReflect.Descriptor instead.
+func (*ShipmentEvent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ShipmentEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShipmentEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ShipmentEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ShipmentEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ShipmentEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type Shipment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderReference string                 `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Carrier        string                 `protobuf:"bytes,4,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,5,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // Status of the latest event
+	ShippedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"`
+	DeliveredAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=delivered_at,json=deliveredAt,proto3" json:"delivered_at,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Events         []*ShipmentEvent       `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"` // Newest first
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Shipment) Reset() {
+	*x = Shipment{}
+	mi := &file_proto_inventory_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Shipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shipment) ProtoMessage() {}
+
+func (x *Shipment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shipment.ProtoReflect.Descriptor instead.
+func (*Shipment) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *Shipment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Shipment) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *Shipment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Shipment) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *Shipment) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *Shipment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Shipment) GetShippedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return nil
+}
+
+func (x *Shipment) GetDeliveredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return nil
+}
+
+func (x *Shipment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Shipment) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Shipment) GetEvents() []*ShipmentEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CreateShipmentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderReference string                 `protobuf:"bytes,1,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Customer allowed to track the shipment
+	Carrier        string                 `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	TrackingNumber string                 `protobuf:"bytes,4,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	ShippedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=shipped_at,json=shippedAt,proto3" json:"shipped_at,omitempty"` // Defaults to now
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateShipmentRequest) Reset() {
+	*x = CreateShipmentRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShipmentRequest) ProtoMessage() {}
+
+func (x *CreateShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *CreateShipmentRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *CreateShipmentRequest) GetShippedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return nil
+}
+
+type ListShipmentsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderReference string                 `protobuf:"bytes,1,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Page           int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListShipmentsRequest) Reset() {
+	*x = ListShipmentsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShipmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShipmentsRequest) ProtoMessage() {}
+
+func (x *ListShipmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShipmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListShipmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListShipmentsRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *ListShipmentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListShipmentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListShipmentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListShipmentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListShipmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shipments     []*Shipment            `protobuf:"bytes,1,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListShipmentsResponse) Reset() {
+	*x = ListShipmentsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListShipmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListShipmentsResponse) ProtoMessage() {}
+
+func (x *ListShipmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListShipmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListShipmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ListShipmentsResponse) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+func (x *ListShipmentsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetShipmentStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Either a shipment ID or the reference of an order with all its shipments
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderReference string `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	// Only return shipments of this customer when set
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShipmentStatusRequest) Reset() {
+	*x = GetShipmentStatusRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShipmentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShipmentStatusRequest) ProtoMessage() {}
+
+func (x *GetShipmentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShipmentStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetShipmentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetShipmentStatusRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetShipmentStatusRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *GetShipmentStatusRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ShipmentStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderReference string                 `protobuf:"bytes,1,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	// Status across the shipments: the status they share, partially_delivered,
+	// exception when any shipment has a problem, or the least advanced status
+	Status        string      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Shipments     []*Shipment `protobuf:"bytes,3,rep,name=shipments,proto3" json:"shipments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShipmentStatusResponse) Reset() {
+	*x = ShipmentStatusResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShipmentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShipmentStatusResponse) ProtoMessage() {}
+
+func (x *ShipmentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShipmentStatusResponse.ProtoReflect.Descriptor instead.
+func (*ShipmentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *ShipmentStatusResponse) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *ShipmentStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ShipmentStatusResponse) GetShipments() []*Shipment {
+	if x != nil {
+		return x.Shipments
+	}
+	return nil
+}
+
+type CarrierEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TrackingNumber string                 `protobuf:"bytes,1,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Location       string                 `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	OccurredAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CarrierEvent) Reset() {
+	*x = CarrierEvent{}
+	mi := &file_proto_inventory_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarrierEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarrierEvent) ProtoMessage() {}
+
+func (x *CarrierEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarrierEvent.ProtoReflect.Descriptor instead.
+func (*CarrierEvent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *CarrierEvent) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *CarrierEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CarrierEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CarrierEvent) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CarrierEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type ReceiveCarrierEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Carrier       string                 `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	Events        []*CarrierEvent        `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveCarrierEventsRequest) Reset() {
+	*x = ReceiveCarrierEventsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveCarrierEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveCarrierEventsRequest) ProtoMessage() {}
+
+func (x *ReceiveCarrierEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveCarrierEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveCarrierEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ReceiveCarrierEventsRequest) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *ReceiveCarrierEventsRequest) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *ReceiveCarrierEventsRequest) GetEvents() []*CarrierEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type CarrierEventResult struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TrackingNumber string                 `protobuf:"bytes,1,opt,name=tracking_number,json=trackingNumber,proto3" json:"tracking_number,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"` // applied, duplicate, ignored (unknown shipment) or failed
+	Error          string                 `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CarrierEventResult) Reset() {
+	*x = CarrierEventResult{}
+	mi := &file_proto_inventory_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CarrierEventResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CarrierEventResult) ProtoMessage() {}
+
+func (x *CarrierEventResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CarrierEventResult.ProtoReflect.Descriptor instead.
+func (*CarrierEventResult) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CarrierEventResult) GetTrackingNumber() string {
+	if x != nil {
+		return x.TrackingNumber
+	}
+	return ""
+}
+
+func (x *CarrierEventResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CarrierEventResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ReceiveCarrierEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*CarrierEventResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Quota         *IntegrationQuota      `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveCarrierEventsResponse) Reset() {
+	*x = ReceiveCarrierEventsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveCarrierEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveCarrierEventsResponse) ProtoMessage() {}
+
+func (x *ReceiveCarrierEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveCarrierEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveCarrierEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReceiveCarrierEventsResponse) GetResults() []*CarrierEventResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ReceiveCarrierEventsResponse) GetQuota() *IntegrationQuota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// Supplier and purchase order messages
+type SupplierProduct struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId      string                 `protobuf:"bytes,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Sku             string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	SupplierSku     string                 `protobuf:"bytes,4,opt,name=supplier_sku,json=supplierSku,proto3" json:"supplier_sku,omitempty"`
+	CostPrice       float64                `protobuf:"fixed64,5,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	LeadTimeDays    int32                  `protobuf:"varint,6,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SupplierProduct) Reset() {
+	*x = SupplierProduct{}
+	mi := &file_proto_inventory_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupplierProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupplierProduct) ProtoMessage() {}
+
+func (x *SupplierProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupplierProduct.ProtoReflect.Descriptor instead.
+func (*SupplierProduct) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SupplierProduct) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *SupplierProduct) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *SupplierProduct) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *SupplierProduct) GetSupplierSku() string {
+	if x != nil {
+		return x.SupplierSku
+	}
+	return ""
+}
+
+func (x *SupplierProduct) GetCostPrice() float64 {
+	if x != nil {
+		return x.CostPrice
+	}
+	return 0
+}
+
+func (x *SupplierProduct) GetLeadTimeDays() int32 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+func (x *SupplierProduct) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type Supplier struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Notes         string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Products      []*SupplierProduct     `protobuf:"bytes,10,rep,name=products,proto3" json:"products,omitempty"` // Only set by GetSupplier
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Supplier) Reset() {
+	*x = Supplier{}
+	mi := &file_proto_inventory_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Supplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Supplier) ProtoMessage() {}
+
+func (x *Supplier) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Supplier.ProtoReflect.Descriptor instead.
+func (*Supplier) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *Supplier) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Supplier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Supplier) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Supplier) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Supplier) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Supplier) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Supplier) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Supplier) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Supplier) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Supplier) GetProducts() []*SupplierProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type CreateSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"` // Defaults to USD
+	Notes         string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSupplierRequest) Reset() {
+	*x = CreateSupplierRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSupplierRequest) ProtoMessage() {}
+
+func (x *CreateSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSupplierRequest.ProtoReflect.Descriptor instead.
+func (*CreateSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *CreateSupplierRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateSupplierRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type UpdateSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Notes         string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	IsActive      bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSupplierRequest) Reset() {
+	*x = UpdateSupplierRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSupplierRequest) ProtoMessage() {}
+
+func (x *UpdateSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSupplierRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *UpdateSupplierRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *UpdateSupplierRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type GetSupplierRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupplierRequest) Reset() {
+	*x = GetSupplierRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupplierRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupplierRequest) ProtoMessage() {}
+
+func (x *GetSupplierRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupplierRequest.ProtoReflect.Descriptor instead.
+func (*GetSupplierRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *GetSupplierRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListSuppliersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	IsActive      *wrapperspb.BoolValue  `protobuf:"bytes,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersRequest) Reset() {
+	*x = ListSuppliersRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersRequest) ProtoMessage() {}
+
+func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersRequest.ProtoReflect.Descriptor instead.
+func (*ListSuppliersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *ListSuppliersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSuppliersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSuppliersRequest) GetIsActive() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.IsActive
+	}
+	return nil
+}
+
+type ListSuppliersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Suppliers     []*Supplier            `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSuppliersResponse) Reset() {
+	*x = ListSuppliersResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSuppliersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSuppliersResponse) ProtoMessage() {}
+
+func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSuppliersResponse.ProtoReflect.Descriptor instead.
+func (*ListSuppliersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *ListSuppliersResponse) GetSuppliers() []*Supplier {
+	if x != nil {
+		return x.Suppliers
+	}
+	return nil
+}
+
+func (x *ListSuppliersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SetSupplierProductRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId      string                 `protobuf:"bytes,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	SupplierSku     string                 `protobuf:"bytes,3,opt,name=supplier_sku,json=supplierSku,proto3" json:"supplier_sku,omitempty"`
+	CostPrice       float64                `protobuf:"fixed64,4,opt,name=cost_price,json=costPrice,proto3" json:"cost_price,omitempty"`
+	LeadTimeDays    int32                  `protobuf:"varint,5,opt,name=lead_time_days,json=leadTimeDays,proto3" json:"lead_time_days,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetSupplierProductRequest) Reset() {
+	*x = SetSupplierProductRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSupplierProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSupplierProductRequest) ProtoMessage() {}
+
+func (x *SetSupplierProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSupplierProductRequest.ProtoReflect.Descriptor instead.
+func (*SetSupplierProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *SetSupplierProductRequest) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *SetSupplierProductRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *SetSupplierProductRequest) GetSupplierSku() string {
+	if x != nil {
+		return x.SupplierSku
+	}
+	return ""
+}
+
+func (x *SetSupplierProductRequest) GetCostPrice() float64 {
+	if x != nil {
+		return x.CostPrice
+	}
+	return 0
+}
+
+func (x *SetSupplierProductRequest) GetLeadTimeDays() int32 {
+	if x != nil {
+		return x.LeadTimeDays
+	}
+	return 0
+}
+
+type RemoveSupplierProductRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId      string                 `protobuf:"bytes,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RemoveSupplierProductRequest) Reset() {
+	*x = RemoveSupplierProductRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSupplierProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSupplierProductRequest) ProtoMessage() {}
+
+func (x *RemoveSupplierProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSupplierProductRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSupplierProductRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *RemoveSupplierProductRequest) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *RemoveSupplierProductRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+type RemoveSupplierProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveSupplierProductResponse) Reset() {
+	*x = RemoveSupplierProductResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveSupplierProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveSupplierProductResponse) ProtoMessage() {}
+
+func (x *RemoveSupplierProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveSupplierProductResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSupplierProductResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *RemoveSupplierProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type PurchaseOrderLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InventoryItemId  string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Sku              string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	QuantityOrdered  int32                  `protobuf:"varint,4,opt,name=quantity_ordered,json=quantityOrdered,proto3" json:"quantity_ordered,omitempty"`
+	QuantityReceived int32                  `protobuf:"varint,5,opt,name=quantity_received,json=quantityReceived,proto3" json:"quantity_received,omitempty"`
+	UnitCost         float64                `protobuf:"fixed64,6,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"`
+	Unit             string                 `protobuf:"bytes,7,opt,name=unit,proto3" json:"unit,omitempty"` // Unit of measure of the quantities and unit cost
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PurchaseOrderLine) Reset() {
+	*x = PurchaseOrderLine{}
+	mi := &file_proto_inventory_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrderLine) ProtoMessage() {}
+
+func (x *PurchaseOrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrderLine.ProtoReflect.Descriptor instead.
+func (*PurchaseOrderLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *PurchaseOrderLine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurchaseOrderLine) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *PurchaseOrderLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *PurchaseOrderLine) GetQuantityOrdered() int32 {
+	if x != nil {
+		return x.QuantityOrdered
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetQuantityReceived() int32 {
+	if x != nil {
+		return x.QuantityReceived
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *PurchaseOrderLine) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type PurchaseOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SupplierId    string                 `protobuf:"bytes,2,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"` // open, partially_received, received or cancelled
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Notes         string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExpectedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expected_at,json=expectedAt,proto3" json:"expected_at,omitempty"`
+	ReceivedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"` // Time of the latest receipt
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Lines         []*PurchaseOrderLine   `protobuf:"bytes,11,rep,name=lines,proto3" json:"lines,omitempty"` // Not set by ListPurchaseOrders
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseOrder) Reset() {
+	*x = PurchaseOrder{}
+	mi := &file_proto_inventory_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseOrder) ProtoMessage() {}
+
+func (x *PurchaseOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseOrder.ProtoReflect.Descriptor instead.
+func (*PurchaseOrder) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *PurchaseOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *PurchaseOrder) GetExpectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PurchaseOrder) GetLines() []*PurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type CreatePurchaseOrderLine struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitCost        float64                `protobuf:"fixed64,3,opt,name=unit_cost,json=unitCost,proto3" json:"unit_cost,omitempty"` // Defaults to the supplier's cost price
+	Unit            string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`                           // Unit of measure of the line; defaults to the base unit
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrderLine) Reset() {
+	*x = CreatePurchaseOrderLine{}
+	mi := &file_proto_inventory_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePurchaseOrderLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePurchaseOrderLine) ProtoMessage() {}
+
+func (x *CreatePurchaseOrderLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePurchaseOrderLine.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *CreatePurchaseOrderLine) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreatePurchaseOrderLine) GetUnitCost() float64 {
+	if x != nil {
+		return x.UnitCost
+	}
+	return 0
+}
+
+func (x *CreatePurchaseOrderLine) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type CreatePurchaseOrderRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId  string                 `protobuf:"bytes,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	WarehouseId string                 `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Currency    string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"` // Defaults to the supplier's currency
+	Notes       string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Defaults to now plus the longest lead time of the ordered items
+	ExpectedAt    *timestamppb.Timestamp     `protobuf:"bytes,5,opt,name=expected_at,json=expectedAt,proto3" json:"expected_at,omitempty"`
+	Lines         []*CreatePurchaseOrderLine `protobuf:"bytes,6,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePurchaseOrderRequest) Reset() {
+	*x = CreatePurchaseOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *CreatePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreatePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *CreatePurchaseOrderRequest) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *CreatePurchaseOrderRequest) GetExpectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedAt
+	}
+	return nil
+}
+
+func (x *CreatePurchaseOrderRequest) GetLines() []*CreatePurchaseOrderLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type GetPurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPurchaseOrderRequest) Reset() {
+	*x = GetPurchaseOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPurchaseOrderRequest) ProtoMessage() {}
+
+func (x *GetPurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetPurchaseOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPurchaseOrdersRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SupplierId string                 `protobuf:"bytes,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	Status     string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Only open orders expected before this time, to find overdue receipts
+	ExpectedBefore *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expected_before,json=expectedBefore,proto3" json:"expected_before,omitempty"`
+	Page           int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersRequest) Reset() {
+	*x = ListPurchaseOrdersRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersRequest) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListPurchaseOrdersRequest) GetSupplierId() string {
+	if x != nil {
+		return x.SupplierId
+	}
+	return ""
+}
+
+func (x *ListPurchaseOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPurchaseOrdersRequest) GetExpectedBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpectedBefore
+	}
+	return nil
+}
+
+func (x *ListPurchaseOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPurchaseOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPurchaseOrdersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PurchaseOrders []*PurchaseOrder       `protobuf:"bytes,1,rep,name=purchase_orders,json=purchaseOrders,proto3" json:"purchase_orders,omitempty"`
+	Total          int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPurchaseOrdersResponse) Reset() {
+	*x = ListPurchaseOrdersResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseOrdersResponse) ProtoMessage() {}
+
+func (x *ListPurchaseOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListPurchaseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ListPurchaseOrdersResponse) GetPurchaseOrders() []*PurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrders
+	}
+	return nil
+}
+
+func (x *ListPurchaseOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ReceiptLine struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReceiptLine) Reset() {
+	*x = ReceiptLine{}
+	mi := &file_proto_inventory_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptLine) ProtoMessage() {}
+
+func (x *ReceiptLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptLine.ProtoReflect.Descriptor instead.
+func (*ReceiptLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ReceiptLine) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *ReceiptLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReceivePurchaseOrderRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Received quantities; everything outstanding is received when empty
+	Lines         []*ReceiptLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceivePurchaseOrderRequest) Reset() {
+	*x = ReceivePurchaseOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivePurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivePurchaseOrderRequest) ProtoMessage() {}
+
+func (x *ReceivePurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivePurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*ReceivePurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ReceivePurchaseOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReceivePurchaseOrderRequest) GetLines() []*ReceiptLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type CancelPurchaseOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPurchaseOrderRequest) Reset() {
+	*x = CancelPurchaseOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPurchaseOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPurchaseOrderRequest) ProtoMessage() {}
+
+func (x *CancelPurchaseOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelPurchaseOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelPurchaseOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CancelPurchaseOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Back-in-stock subscription messages. Subscribers are emailed once, when
+// the product or variant goes from no available units to some.
+type BackInStockSubscription struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"` // Empty for any variant of the product
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // Empty for guests
+	NotifiedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=notified_at,json=notifiedAt,proto3" json:"notified_at,omitempty"` // Unset until the email is sent
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackInStockSubscription) Reset() {
+	*x = BackInStockSubscription{}
+	mi := &file_proto_inventory_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackInStockSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackInStockSubscription) ProtoMessage() {}
+
+func (x *BackInStockSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackInStockSubscription.ProtoReflect.Descriptor instead.
+func (*BackInStockSubscription) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *BackInStockSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *BackInStockSubscription) GetNotifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotifiedAt
+	}
+	return nil
+}
+
+func (x *BackInStockSubscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type SubscribeBackInStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	UserId        string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeBackInStockRequest) Reset() {
+	*x = SubscribeBackInStockRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeBackInStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBackInStockRequest) ProtoMessage() {}
+
+func (x *SubscribeBackInStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBackInStockRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBackInStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *SubscribeBackInStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SubscribeBackInStockRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *SubscribeBackInStockRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SubscribeBackInStockRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListBackInStockSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PendingOnly   bool                   `protobuf:"varint,3,opt,name=pending_only,json=pendingOnly,proto3" json:"pending_only,omitempty"` // Only subscriptions not notified yet
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBackInStockSubscriptionsRequest) Reset() {
+	*x = ListBackInStockSubscriptionsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBackInStockSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBackInStockSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListBackInStockSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBackInStockSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListBackInStockSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ListBackInStockSubscriptionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListBackInStockSubscriptionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListBackInStockSubscriptionsRequest) GetPendingOnly() bool {
+	if x != nil {
+		return x.PendingOnly
+	}
+	return false
+}
+
+func (x *ListBackInStockSubscriptionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListBackInStockSubscriptionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListBackInStockSubscriptionsResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Subscriptions []*BackInStockSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Total         int32                      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBackInStockSubscriptionsResponse) Reset() {
+	*x = ListBackInStockSubscriptionsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBackInStockSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBackInStockSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListBackInStockSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBackInStockSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListBackInStockSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ListBackInStockSubscriptionsResponse) GetSubscriptions() []*BackInStockSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *ListBackInStockSubscriptionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DeleteBackInStockSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // When set, only a subscription of this user is deleted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBackInStockSubscriptionRequest) Reset() {
+	*x = DeleteBackInStockSubscriptionRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBackInStockSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBackInStockSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteBackInStockSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBackInStockSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBackInStockSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *DeleteBackInStockSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteBackInStockSubscriptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteBackInStockSubscriptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBackInStockSubscriptionResponse) Reset() {
+	*x = DeleteBackInStockSubscriptionResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBackInStockSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBackInStockSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteBackInStockSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBackInStockSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBackInStockSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *DeleteBackInStockSubscriptionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Lot messages
+type InventoryLot struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InventoryItemId   string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	WarehouseId       string                 `protobuf:"bytes,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	LotNumber         string                 `protobuf:"bytes,4,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Unset for lots without expiry
+	Quantity          int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReservedQuantity  int32                  `protobuf:"varint,7,opt,name=reserved_quantity,json=reservedQuantity,proto3" json:"reserved_quantity,omitempty"`
+	AvailableQuantity int32                  `protobuf:"varint,8,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"`
+	Expired           bool                   `protobuf:"varint,9,opt,name=expired,proto3" json:"expired,omitempty"`
+	ReceivedAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	WrittenOffAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=written_off_at,json=writtenOffAt,proto3" json:"written_off_at,omitempty"` // Set once its expired units were written off
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Only set in the expiring lots report
+	ProductId     string `protobuf:"bytes,13,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku           string `protobuf:"bytes,14,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InventoryLot) Reset() {
+	*x = InventoryLot{}
+	mi := &file_proto_inventory_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryLot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryLot) ProtoMessage() {}
+
+func (x *InventoryLot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryLot.ProtoReflect.Descriptor instead.
+func (*InventoryLot) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *InventoryLot) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InventoryLot) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *InventoryLot) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *InventoryLot) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *InventoryLot) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *InventoryLot) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventoryLot) GetReservedQuantity() int32 {
+	if x != nil {
+		return x.ReservedQuantity
+	}
+	return 0
+}
+
+func (x *InventoryLot) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *InventoryLot) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+func (x *InventoryLot) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *InventoryLot) GetWrittenOffAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WrittenOffAt
+	}
+	return nil
+}
+
+func (x *InventoryLot) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *InventoryLot) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *InventoryLot) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+type ReceiveLotRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	WarehouseId     string                 `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	LotNumber       string                 `protobuf:"bytes,3,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Optional for goods that do not expire
+	Quantity        int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Notes           string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Unit            string                 `protobuf:"bytes,7,opt,name=unit,proto3" json:"unit,omitempty"` // Unit of measure of the quantity; defaults to the base unit
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReceiveLotRequest) Reset() {
+	*x = ReceiveLotRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveLotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveLotRequest) ProtoMessage() {}
+
+func (x *ReceiveLotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveLotRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveLotRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ReceiveLotRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *ReceiveLotRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ReceiveLotRequest) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *ReceiveLotRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ReceiveLotRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReceiveLotRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ReceiveLotRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type ListLotsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	WarehouseId     string                 `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`     // Optional
+	IncludeEmpty    bool                   `protobuf:"varint,3,opt,name=include_empty,json=includeEmpty,proto3" json:"include_empty,omitempty"` // Also list the lots with no units left
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListLotsRequest) Reset() {
+	*x = ListLotsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLotsRequest) ProtoMessage() {}
+
+func (x *ListLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLotsRequest.ProtoReflect.Descriptor instead.
+func (*ListLotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ListLotsRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *ListLotsRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ListLotsRequest) GetIncludeEmpty() bool {
+	if x != nil {
+		return x.IncludeEmpty
+	}
+	return false
+}
+
+type ListLotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lots          []*InventoryLot        `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLotsResponse) Reset() {
+	*x = ListLotsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLotsResponse) ProtoMessage() {}
+
+func (x *ListLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLotsResponse.ProtoReflect.Descriptor instead.
+func (*ListLotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListLotsResponse) GetLots() []*InventoryLot {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+type ListExpiringLotsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WithinDays    int32                  `protobuf:"varint,1,opt,name=within_days,json=withinDays,proto3" json:"within_days,omitempty"` // Defaults to 7; expired lots not written off yet are included
+	WarehouseId   string                 `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringLotsRequest) Reset() {
+	*x = ListExpiringLotsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringLotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringLotsRequest) ProtoMessage() {}
+
+func (x *ListExpiringLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringLotsRequest.ProtoReflect.Descriptor instead.
+func (*ListExpiringLotsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListExpiringLotsRequest) GetWithinDays() int32 {
+	if x != nil {
+		return x.WithinDays
+	}
+	return 0
+}
+
+func (x *ListExpiringLotsRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ListExpiringLotsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListExpiringLotsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListExpiringLotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lots          []*InventoryLot        `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListExpiringLotsResponse) Reset() {
+	*x = ListExpiringLotsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListExpiringLotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListExpiringLotsResponse) ProtoMessage() {}
+
+func (x *ListExpiringLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListExpiringLotsResponse.ProtoReflect.Descriptor instead.
+func (*ListExpiringLotsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ListExpiringLotsResponse) GetLots() []*InventoryLot {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+func (x *ListExpiringLotsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Unit of measure messages
+type InventoryUnit struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"` // EA for the base unit
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Factor          int32                  `protobuf:"varint,4,opt,name=factor,proto3" json:"factor,omitempty"`                       // Base units in one unit
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Not set on the base unit
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InventoryUnit) Reset() {
+	*x = InventoryUnit{}
+	mi := &file_proto_inventory_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryUnit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryUnit) ProtoMessage() {}
+
+func (x *InventoryUnit) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryUnit.ProtoReflect.Descriptor instead.
+func (*InventoryUnit) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *InventoryUnit) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *InventoryUnit) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InventoryUnit) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InventoryUnit) GetFactor() int32 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+func (x *InventoryUnit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetInventoryUnitRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Factor          int32                  `protobuf:"varint,4,opt,name=factor,proto3" json:"factor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetInventoryUnitRequest) Reset() {
+	*x = SetInventoryUnitRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetInventoryUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetInventoryUnitRequest) ProtoMessage() {}
+
+func (x *SetInventoryUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetInventoryUnitRequest.ProtoReflect.Descriptor instead.
+func (*SetInventoryUnitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SetInventoryUnitRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *SetInventoryUnitRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SetInventoryUnitRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetInventoryUnitRequest) GetFactor() int32 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type ListInventoryUnitsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListInventoryUnitsRequest) Reset() {
+	*x = ListInventoryUnitsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryUnitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryUnitsRequest) ProtoMessage() {}
+
+func (x *ListInventoryUnitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryUnitsRequest.ProtoReflect.Descriptor instead.
+func (*ListInventoryUnitsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ListInventoryUnitsRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+type ListInventoryUnitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Units         []*InventoryUnit       `protobuf:"bytes,1,rep,name=units,proto3" json:"units,omitempty"` // The base unit first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInventoryUnitsResponse) Reset() {
+	*x = ListInventoryUnitsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryUnitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryUnitsResponse) ProtoMessage() {}
+
+func (x *ListInventoryUnitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryUnitsResponse.ProtoReflect.Descriptor instead.
+func (*ListInventoryUnitsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ListInventoryUnitsResponse) GetUnits() []*InventoryUnit {
+	if x != nil {
+		return x.Units
+	}
+	return nil
+}
+
+type DeleteInventoryUnitRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Code            string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteInventoryUnitRequest) Reset() {
+	*x = DeleteInventoryUnitRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInventoryUnitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInventoryUnitRequest) ProtoMessage() {}
+
+func (x *DeleteInventoryUnitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInventoryUnitRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInventoryUnitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *DeleteInventoryUnitRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *DeleteInventoryUnitRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeleteInventoryUnitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInventoryUnitResponse) Reset() {
+	*x = DeleteInventoryUnitResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInventoryUnitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInventoryUnitResponse) ProtoMessage() {}
+
+func (x *DeleteInventoryUnitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInventoryUnitResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInventoryUnitResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{134}
+}
+
+// Warehouse bin messages
+type WarehouseBin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"` // Zone, aisle, shelf and bin joined, such as A-03-2-B
+	Zone          string                 `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone,omitempty"`
+	Aisle         string                 `protobuf:"bytes,5,opt,name=aisle,proto3" json:"aisle,omitempty"`
+	Shelf         string                 `protobuf:"bytes,6,opt,name=shelf,proto3" json:"shelf,omitempty"`
+	Bin           string                 `protobuf:"bytes,7,opt,name=bin,proto3" json:"bin,omitempty"`
+	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseBin) Reset() {
+	*x = WarehouseBin{}
+	mi := &file_proto_inventory_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseBin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseBin) ProtoMessage() {}
+
+func (x *WarehouseBin) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseBin.ProtoReflect.Descriptor instead.
+func (*WarehouseBin) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *WarehouseBin) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WarehouseBin) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *WarehouseBin) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *WarehouseBin) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *WarehouseBin) GetAisle() string {
+	if x != nil {
+		return x.Aisle
+	}
+	return ""
+}
+
+func (x *WarehouseBin) GetShelf() string {
+	if x != nil {
+		return x.Shelf
+	}
+	return ""
+}
+
+func (x *WarehouseBin) GetBin() string {
+	if x != nil {
+		return x.Bin
+	}
+	return ""
+}
+
+func (x *WarehouseBin) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *WarehouseBin) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *WarehouseBin) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Creates the bin at the address, or sets whether it is active
+type SetWarehouseBinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Zone          string                 `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	Aisle         string                 `protobuf:"bytes,3,opt,name=aisle,proto3" json:"aisle,omitempty"`
+	Shelf         string                 `protobuf:"bytes,4,opt,name=shelf,proto3" json:"shelf,omitempty"`
+	Bin           string                 `protobuf:"bytes,5,opt,name=bin,proto3" json:"bin,omitempty"`
+	IsActive      bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetWarehouseBinRequest) Reset() {
+	*x = SetWarehouseBinRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetWarehouseBinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetWarehouseBinRequest) ProtoMessage() {}
+
+func (x *SetWarehouseBinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetWarehouseBinRequest.ProtoReflect.Descriptor instead.
+func (*SetWarehouseBinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *SetWarehouseBinRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *SetWarehouseBinRequest) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+func (x *SetWarehouseBinRequest) GetAisle() string {
+	if x != nil {
+		return x.Aisle
+	}
+	return ""
+}
+
+func (x *SetWarehouseBinRequest) GetShelf() string {
+	if x != nil {
+		return x.Shelf
+	}
+	return ""
+}
+
+func (x *SetWarehouseBinRequest) GetBin() string {
+	if x != nil {
+		return x.Bin
+	}
+	return ""
+}
+
+func (x *SetWarehouseBinRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type ListWarehouseBinsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Zone          string                 `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehouseBinsRequest) Reset() {
+	*x = ListWarehouseBinsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehouseBinsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehouseBinsRequest) ProtoMessage() {}
+
+func (x *ListWarehouseBinsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehouseBinsRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehouseBinsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ListWarehouseBinsRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ListWarehouseBinsRequest) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+type ListWarehouseBinsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bins          []*WarehouseBin        `protobuf:"bytes,1,rep,name=bins,proto3" json:"bins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehouseBinsResponse) Reset() {
+	*x = ListWarehouseBinsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehouseBinsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehouseBinsResponse) ProtoMessage() {}
+
+func (x *ListWarehouseBinsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehouseBinsResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehouseBinsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ListWarehouseBinsResponse) GetBins() []*WarehouseBin {
+	if x != nil {
+		return x.Bins
+	}
+	return nil
+}
+
+type DeleteWarehouseBinRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWarehouseBinRequest) Reset() {
+	*x = DeleteWarehouseBinRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWarehouseBinRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWarehouseBinRequest) ProtoMessage() {}
+
+func (x *DeleteWarehouseBinRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWarehouseBinRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWarehouseBinRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *DeleteWarehouseBinRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteWarehouseBinResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWarehouseBinResponse) Reset() {
+	*x = DeleteWarehouseBinResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWarehouseBinResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWarehouseBinResponse) ProtoMessage() {}
+
+func (x *DeleteWarehouseBinResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWarehouseBinResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWarehouseBinResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{140}
+}
+
+type BinStock struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Bin             *WarehouseBin          `protobuf:"bytes,1,opt,name=bin,proto3" json:"bin,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Sku             string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity        int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"` // In base units
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BinStock) Reset() {
+	*x = BinStock{}
+	mi := &file_proto_inventory_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinStock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinStock) ProtoMessage() {}
+
+func (x *BinStock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BinStock.ProtoReflect.Descriptor instead.
+func (*BinStock) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *BinStock) GetBin() *WarehouseBin {
+	if x != nil {
+		return x.Bin
+	}
+	return nil
+}
+
+func (x *BinStock) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *BinStock) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BinStock) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BinStock) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Sets the units of an item in a bin; zero removes the item from the bin. The
+// bins of an item may not hold more than its stock at the warehouse.
+type SetBinStockRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BinId           string                 `protobuf:"bytes,1,opt,name=bin_id,json=binId,proto3" json:"bin_id,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit            string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"` // Unit of measure of the quantity; defaults to the base unit
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetBinStockRequest) Reset() {
+	*x = SetBinStockRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetBinStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetBinStockRequest) ProtoMessage() {}
+
+func (x *SetBinStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetBinStockRequest.ProtoReflect.Descriptor instead.
+func (*SetBinStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *SetBinStockRequest) GetBinId() string {
+	if x != nil {
+		return x.BinId
+	}
+	return ""
+}
+
+func (x *SetBinStockRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *SetBinStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SetBinStockRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+type ListBinStockRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId     string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"` // Optional
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListBinStockRequest) Reset() {
+	*x = ListBinStockRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBinStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBinStockRequest) ProtoMessage() {}
+
+func (x *ListBinStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBinStockRequest.ProtoReflect.Descriptor instead.
+func (*ListBinStockRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ListBinStockRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ListBinStockRequest) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+type ListBinStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stock         []*BinStock            `protobuf:"bytes,1,rep,name=stock,proto3" json:"stock,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBinStockResponse) Reset() {
+	*x = ListBinStockResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBinStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBinStockResponse) ProtoMessage() {}
+
+func (x *ListBinStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBinStockResponse.ProtoReflect.Descriptor instead.
+func (*ListBinStockResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ListBinStockResponse) GetStock() []*BinStock {
+	if x != nil {
+		return x.Stock
+	}
+	return nil
+}
+
+type PickListLine struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	InventoryItemId string                 `protobuf:"bytes,1,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"` // In base units
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PickListLine) Reset() {
+	*x = PickListLine{}
+	mi := &file_proto_inventory_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickListLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickListLine) ProtoMessage() {}
+
+func (x *PickListLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickListLine.ProtoReflect.Descriptor instead.
+func (*PickListLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *PickListLine) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *PickListLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GeneratePickListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Lines         []*PickListLine        `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeneratePickListRequest) Reset() {
+	*x = GeneratePickListRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratePickListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratePickListRequest) ProtoMessage() {}
+
+func (x *GeneratePickListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratePickListRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePickListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *GeneratePickListRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *GeneratePickListRequest) GetLines() []*PickListLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type Pick struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Sequence        int32                  `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"` // Position on the pick path, from 1
+	Bin             *WarehouseBin          `protobuf:"bytes,2,opt,name=bin,proto3" json:"bin,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,3,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Sku             string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity        int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Pick) Reset() {
+	*x = Pick{}
+	mi := &file_proto_inventory_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pick) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pick) ProtoMessage() {}
+
+func (x *Pick) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pick.ProtoReflect.Descriptor instead.
+func (*Pick) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *Pick) GetSequence() int32 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Pick) GetBin() *WarehouseBin {
+	if x != nil {
+		return x.Bin
+	}
+	return nil
+}
+
+func (x *Pick) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *Pick) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Pick) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type PickList struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Picks       []*Pick                `protobuf:"bytes,2,rep,name=picks,proto3" json:"picks,omitempty"`
+	// Units of lines not placed in any active bin
+	Shortages     []*PickListLine `protobuf:"bytes,3,rep,name=shortages,proto3" json:"shortages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickList) Reset() {
+	*x = PickList{}
+	mi := &file_proto_inventory_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickList) ProtoMessage() {}
+
+func (x *PickList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickList.ProtoReflect.Descriptor instead.
+func (*PickList) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *PickList) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *PickList) GetPicks() []*Pick {
+	if x != nil {
+		return x.Picks
+	}
+	return nil
+}
+
+func (x *PickList) GetShortages() []*PickListLine {
+	if x != nil {
+		return x.Shortages
+	}
+	return nil
+}
+
+// Fulfillment wave messages
+type FulfillmentWave struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Carrier       string                 `protobuf:"bytes,3,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	CutoffAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=cutoff_at,json=cutoffAt,proto3" json:"cutoff_at,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // open, completed or cancelled
+	Orders        int32                  `protobuf:"varint,6,opt,name=orders,proto3" json:"orders,omitempty"`
+	Lines         []*FulfillmentWaveLine `protobuf:"bytes,7,rep,name=lines,proto3" json:"lines,omitempty"` // Not set when listing waves
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FulfillmentWave) Reset() {
+	*x = FulfillmentWave{}
+	mi := &file_proto_inventory_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillmentWave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillmentWave) ProtoMessage() {}
+
+func (x *FulfillmentWave) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillmentWave.ProtoReflect.Descriptor instead.
+func (*FulfillmentWave) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *FulfillmentWave) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FulfillmentWave) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *FulfillmentWave) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *FulfillmentWave) GetCutoffAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CutoffAt
+	}
+	return nil
+}
+
+func (x *FulfillmentWave) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FulfillmentWave) GetOrders() int32 {
+	if x != nil {
+		return x.Orders
+	}
+	return 0
+}
+
+func (x *FulfillmentWave) GetLines() []*FulfillmentWaveLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *FulfillmentWave) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FulfillmentWave) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+type FulfillmentWaveLine struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReservationId   string                 `protobuf:"bytes,2,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	OrderReference  string                 `protobuf:"bytes,3,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,4,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Sku             string                 `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity        int32                  `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	PickedQuantity  int32                  `protobuf:"varint,7,opt,name=picked_quantity,json=pickedQuantity,proto3" json:"picked_quantity,omitempty"`
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"` // pending, picked, short or cancelled
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FulfillmentWaveLine) Reset() {
+	*x = FulfillmentWaveLine{}
+	mi := &file_proto_inventory_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FulfillmentWaveLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FulfillmentWaveLine) ProtoMessage() {}
+
+func (x *FulfillmentWaveLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FulfillmentWaveLine.ProtoReflect.Descriptor instead.
+func (*FulfillmentWaveLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *FulfillmentWaveLine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FulfillmentWaveLine) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *FulfillmentWaveLine) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *FulfillmentWaveLine) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *FulfillmentWaveLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *FulfillmentWaveLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *FulfillmentWaveLine) GetPickedQuantity() int32 {
+	if x != nil {
+		return x.PickedQuantity
+	}
+	return 0
+}
+
+func (x *FulfillmentWaveLine) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateFulfillmentWaveRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Carrier     string                 `protobuf:"bytes,2,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	// Latest time the orders were reserved at; defaults to now
+	CutoffAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=cutoff_at,json=cutoffAt,proto3" json:"cutoff_at,omitempty"`
+	// Restricts the wave to these orders, such as those shipping with the
+	// carrier, when not empty
+	OrderReferences []string `protobuf:"bytes,4,rep,name=order_references,json=orderReferences,proto3" json:"order_references,omitempty"`
+	MaxOrders       int32    `protobuf:"varint,5,opt,name=max_orders,json=maxOrders,proto3" json:"max_orders,omitempty"` // Defaults to 50
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateFulfillmentWaveRequest) Reset() {
+	*x = CreateFulfillmentWaveRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFulfillmentWaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFulfillmentWaveRequest) ProtoMessage() {}
+
+func (x *CreateFulfillmentWaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFulfillmentWaveRequest.ProtoReflect.Descriptor instead.
+func (*CreateFulfillmentWaveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *CreateFulfillmentWaveRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *CreateFulfillmentWaveRequest) GetCarrier() string {
+	if x != nil {
+		return x.Carrier
+	}
+	return ""
+}
+
+func (x *CreateFulfillmentWaveRequest) GetCutoffAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CutoffAt
+	}
+	return nil
+}
+
+func (x *CreateFulfillmentWaveRequest) GetOrderReferences() []string {
+	if x != nil {
+		return x.OrderReferences
+	}
+	return nil
+}
+
+func (x *CreateFulfillmentWaveRequest) GetMaxOrders() int32 {
+	if x != nil {
+		return x.MaxOrders
+	}
+	return 0
+}
+
+type GetFulfillmentWaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFulfillmentWaveRequest) Reset() {
+	*x = GetFulfillmentWaveRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFulfillmentWaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFulfillmentWaveRequest) ProtoMessage() {}
+
+func (x *GetFulfillmentWaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFulfillmentWaveRequest.ProtoReflect.Descriptor instead.
+func (*GetFulfillmentWaveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *GetFulfillmentWaveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListFulfillmentWavesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // Optional
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                              // Optional
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFulfillmentWavesRequest) Reset() {
+	*x = ListFulfillmentWavesRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFulfillmentWavesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFulfillmentWavesRequest) ProtoMessage() {}
+
+func (x *ListFulfillmentWavesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFulfillmentWavesRequest.ProtoReflect.Descriptor instead.
+func (*ListFulfillmentWavesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ListFulfillmentWavesRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ListFulfillmentWavesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListFulfillmentWavesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFulfillmentWavesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFulfillmentWavesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Waves         []*FulfillmentWave     `protobuf:"bytes,1,rep,name=waves,proto3" json:"waves,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFulfillmentWavesResponse) Reset() {
+	*x = ListFulfillmentWavesResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFulfillmentWavesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFulfillmentWavesResponse) ProtoMessage() {}
+
+func (x *ListFulfillmentWavesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFulfillmentWavesResponse.ProtoReflect.Descriptor instead.
+func (*ListFulfillmentWavesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *ListFulfillmentWavesResponse) GetWaves() []*FulfillmentWave {
+	if x != nil {
+		return x.Waves
+	}
+	return nil
+}
+
+func (x *ListFulfillmentWavesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GenerateWavePickListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateWavePickListRequest) Reset() {
+	*x = GenerateWavePickListRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateWavePickListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateWavePickListRequest) ProtoMessage() {}
+
+func (x *GenerateWavePickListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateWavePickListRequest.ProtoReflect.Descriptor instead.
+func (*GenerateWavePickListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *GenerateWavePickListRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PickedWaveLine struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LineId         string                 `protobuf:"bytes,1,opt,name=line_id,json=lineId,proto3" json:"line_id,omitempty"`
+	PickedQuantity int32                  `protobuf:"varint,2,opt,name=picked_quantity,json=pickedQuantity,proto3" json:"picked_quantity,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PickedWaveLine) Reset() {
+	*x = PickedWaveLine{}
+	mi := &file_proto_inventory_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickedWaveLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickedWaveLine) ProtoMessage() {}
+
+func (x *PickedWaveLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickedWaveLine.ProtoReflect.Descriptor instead.
+func (*PickedWaveLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *PickedWaveLine) GetLineId() string {
+	if x != nil {
+		return x.LineId
+	}
+	return ""
+}
+
+func (x *PickedWaveLine) GetPickedQuantity() int32 {
+	if x != nil {
+		return x.PickedQuantity
+	}
+	return 0
+}
+
+// Lines not given are picked in full
+type CompleteFulfillmentWaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lines         []*PickedWaveLine      `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteFulfillmentWaveRequest) Reset() {
+	*x = CompleteFulfillmentWaveRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteFulfillmentWaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteFulfillmentWaveRequest) ProtoMessage() {}
+
+func (x *CompleteFulfillmentWaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteFulfillmentWaveRequest.ProtoReflect.Descriptor instead.
+func (*CompleteFulfillmentWaveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *CompleteFulfillmentWaveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompleteFulfillmentWaveRequest) GetLines() []*PickedWaveLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type CancelFulfillmentWaveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFulfillmentWaveRequest) Reset() {
+	*x = CancelFulfillmentWaveRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFulfillmentWaveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFulfillmentWaveRequest) ProtoMessage() {}
+
+func (x *CancelFulfillmentWaveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFulfillmentWaveRequest.ProtoReflect.Descriptor instead.
+func (*CancelFulfillmentWaveRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *CancelFulfillmentWaveRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Refund messages
+type Refund struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderReference    string                 `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	PaymentReference  string                 `protobuf:"bytes,3,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
+	UserId            string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type              string                 `protobuf:"bytes,5,opt,name=type,proto3" json:"type,omitempty"` // full or partial
+	Amount            float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency          string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Reason            string                 `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status            string                 `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"` // requested, approved, rejected, processed or failed
+	RequestedBy       string                 `protobuf:"bytes,10,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	ReviewedBy        string                 `protobuf:"bytes,11,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ProviderReference string                 `protobuf:"bytes,13,opt,name=provider_reference,json=providerReference,proto3" json:"provider_reference,omitempty"`
+	FailureReason     string                 `protobuf:"bytes,14,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"` // Why the refund was rejected or failed
+	ProcessedAt       *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	Lines             []*RefundLine          `protobuf:"bytes,16,rep,name=lines,proto3" json:"lines,omitempty"` // Not set when listing refunds
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Refund) Reset() {
+	*x = Refund{}
+	mi := &file_proto_inventory_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Refund) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Refund) ProtoMessage() {}
+
+func (x *Refund) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Refund.ProtoReflect.Descriptor instead.
+func (*Refund) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *Refund) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Refund) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *Refund) GetPaymentReference() string {
+	if x != nil {
+		return x.PaymentReference
+	}
+	return ""
+}
+
+func (x *Refund) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Refund) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Refund) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Refund) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Refund) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Refund) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Refund) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+func (x *Refund) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *Refund) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *Refund) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *Refund) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Refund) GetProcessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
+	}
+	return nil
+}
+
+func (x *Refund) GetLines() []*RefundLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Refund) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Refund) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type RefundLine struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Sku             string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity        int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Restocked lines are put back at the warehouse when the refund is approved
+	Restock       bool   `protobuf:"varint,5,opt,name=restock,proto3" json:"restock,omitempty"`
+	WarehouseId   string `protobuf:"bytes,6,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // Required when restocked
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundLine) Reset() {
+	*x = RefundLine{}
+	mi := &file_proto_inventory_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundLine) ProtoMessage() {}
+
+func (x *RefundLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundLine.ProtoReflect.Descriptor instead.
+func (*RefundLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *RefundLine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RefundLine) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *RefundLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *RefundLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RefundLine) GetRestock() bool {
+	if x != nil {
+		return x.Restock
+	}
+	return false
+}
+
+func (x *RefundLine) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+type RequestRefundRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrderReference   string                 `protobuf:"bytes,1,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	PaymentReference string                 `protobuf:"bytes,2,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Customer of the order, optional
+	Type             string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Amount           float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency         string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"` // Defaults to USD
+	Reason           string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Lines            []*RefundLine          `protobuf:"bytes,8,rep,name=lines,proto3" json:"lines,omitempty"`
+	RequestedBy      string                 `protobuf:"bytes,9,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RequestRefundRequest) Reset() {
+	*x = RequestRefundRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRefundRequest) ProtoMessage() {}
+
+func (x *RequestRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRefundRequest.ProtoReflect.Descriptor instead.
+func (*RequestRefundRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *RequestRefundRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *RequestRefundRequest) GetPaymentReference() string {
+	if x != nil {
+		return x.PaymentReference
+	}
+	return ""
+}
+
+func (x *RequestRefundRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequestRefundRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RequestRefundRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RequestRefundRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RequestRefundRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RequestRefundRequest) GetLines() []*RefundLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *RequestRefundRequest) GetRequestedBy() string {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return ""
+}
+
+type GetRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRefundRequest) Reset() {
+	*x = GetRefundRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRefundRequest) ProtoMessage() {}
+
+func (x *GetRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRefundRequest.ProtoReflect.Descriptor instead.
+func (*GetRefundRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *GetRefundRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListRefundsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Status         string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                                       // Optional
+	OrderReference string                 `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"` // Optional
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListRefundsRequest) Reset() {
+	*x = ListRefundsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsRequest) ProtoMessage() {}
+
+func (x *ListRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ListRefundsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRefundsRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *ListRefundsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRefundsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRefundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refunds       []*Refund              `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundsResponse) Reset() {
+	*x = ListRefundsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundsResponse) ProtoMessage() {}
+
+func (x *ListRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundsResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *ListRefundsResponse) GetRefunds() []*Refund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+func (x *ListRefundsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ApproveRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,2,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApproveRefundRequest) Reset() {
+	*x = ApproveRefundRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApproveRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApproveRefundRequest) ProtoMessage() {}
+
+func (x *ApproveRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApproveRefundRequest.ProtoReflect.Descriptor instead.
+func (*ApproveRefundRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *ApproveRefundRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApproveRefundRequest) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+type RejectRefundRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,2,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRefundRequest) Reset() {
+	*x = RejectRefundRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRefundRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRefundRequest) ProtoMessage() {}
+
+func (x *RejectRefundRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRefundRequest.ProtoReflect.Descriptor instead.
+func (*RejectRefundRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *RejectRefundRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RejectRefundRequest) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *RejectRefundRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Outcome of an approved refund reported by the payment system
+type RecordRefundResultRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Succeeded         bool                   `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	ProviderReference string                 `protobuf:"bytes,3,opt,name=provider_reference,json=providerReference,proto3" json:"provider_reference,omitempty"`
+	FailureReason     string                 `protobuf:"bytes,4,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RecordRefundResultRequest) Reset() {
+	*x = RecordRefundResultRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordRefundResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordRefundResultRequest) ProtoMessage() {}
+
+func (x *RecordRefundResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordRefundResultRequest.ProtoReflect.Descriptor instead.
+func (*RecordRefundResultRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *RecordRefundResultRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecordRefundResultRequest) GetSucceeded() bool {
+	if x != nil {
+		return x.Succeeded
+	}
+	return false
+}
+
+func (x *RecordRefundResultRequest) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+func (x *RecordRefundResultRequest) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+type RefundEvent struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RefundId         string                 `protobuf:"bytes,2,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	Status           string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	OrderReference   string                 `protobuf:"bytes,4,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	PaymentReference string                 `protobuf:"bytes,5,opt,name=payment_reference,json=paymentReference,proto3" json:"payment_reference,omitempty"`
+	UserId           string                 `protobuf:"bytes,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Amount           float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency         string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RefundEvent) Reset() {
+	*x = RefundEvent{}
+	mi := &file_proto_inventory_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundEvent) ProtoMessage() {}
+
+func (x *RefundEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundEvent.ProtoReflect.Descriptor instead.
+func (*RefundEvent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *RefundEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RefundEvent) GetRefundId() string {
+	if x != nil {
+		return x.RefundId
+	}
+	return ""
+}
+
+func (x *RefundEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RefundEvent) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *RefundEvent) GetPaymentReference() string {
+	if x != nil {
+		return x.PaymentReference
+	}
+	return ""
+}
+
+func (x *RefundEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RefundEvent) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundEvent) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RefundEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListRefundEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return events after this ID, for readers to resume where they stopped
+	AfterId       int64 `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundEventsRequest) Reset() {
+	*x = ListRefundEventsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundEventsRequest) ProtoMessage() {}
+
+func (x *ListRefundEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListRefundEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *ListRefundEventsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListRefundEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRefundEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*RefundEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRefundEventsResponse) Reset() {
+	*x = ListRefundEventsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRefundEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRefundEventsResponse) ProtoMessage() {}
+
+func (x *ListRefundEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRefundEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListRefundEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *ListRefundEventsResponse) GetEvents() []*RefundEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Fraud messages
+type FraudCheck struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderReference  string                 `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	UserId          string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email           string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	IpAddress       string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	BillingCountry  string                 `protobuf:"bytes,6,opt,name=billing_country,json=billingCountry,proto3" json:"billing_country,omitempty"`
+	ShippingCountry string                 `protobuf:"bytes,7,opt,name=shipping_country,json=shippingCountry,proto3" json:"shipping_country,omitempty"`
+	Amount          float64                `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency        string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	Score           int32                  `protobuf:"varint,10,opt,name=score,proto3" json:"score,omitempty"`
+	Decision        string                 `protobuf:"bytes,11,opt,name=decision,proto3" json:"decision,omitempty"` // approve, review or decline
+	Signals         []*FraudSignal         `protobuf:"bytes,12,rep,name=signals,proto3" json:"signals,omitempty"`
+	ReviewedBy      string                 `protobuf:"bytes,13,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ReviewNotes     string                 `protobuf:"bytes,15,opt,name=review_notes,json=reviewNotes,proto3" json:"review_notes,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *FraudCheck) Reset() {
+	*x = FraudCheck{}
+	mi := &file_proto_inventory_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FraudCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudCheck) ProtoMessage() {}
+
+func (x *FraudCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudCheck.ProtoReflect.Descriptor instead.
+func (*FraudCheck) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *FraudCheck) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetBillingCountry() string {
+	if x != nil {
+		return x.BillingCountry
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetShippingCountry() string {
+	if x != nil {
+		return x.ShippingCountry
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FraudCheck) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *FraudCheck) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetSignals() []*FraudSignal {
+	if x != nil {
+		return x.Signals
+	}
+	return nil
+}
+
+func (x *FraudCheck) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *FraudCheck) GetReviewNotes() string {
+	if x != nil {
+		return x.ReviewNotes
+	}
+	return ""
+}
+
+func (x *FraudCheck) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *FraudCheck) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Score a rule gave an order and why
+type FraudSignal struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Points        int32                  `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FraudSignal) Reset() {
+	*x = FraudSignal{}
+	mi := &file_proto_inventory_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FraudSignal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudSignal) ProtoMessage() {}
+
+func (x *FraudSignal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudSignal.ProtoReflect.Descriptor instead.
+func (*FraudSignal) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *FraudSignal) GetRule() string {
+	if x != nil {
+		return x.Rule
+	}
+	return ""
+}
+
+func (x *FraudSignal) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *FraudSignal) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ScreenOrderRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	OrderReference  string                 `protobuf:"bytes,1,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email           string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	IpAddress       string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	BillingCountry  string                 `protobuf:"bytes,5,opt,name=billing_country,json=billingCountry,proto3" json:"billing_country,omitempty"`    // 2-letter ISO code
+	ShippingCountry string                 `protobuf:"bytes,6,opt,name=shipping_country,json=shippingCountry,proto3" json:"shipping_country,omitempty"` // 2-letter ISO code
+	Amount          float64                `protobuf:"fixed64,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency        string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"` // Defaults to USD
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScreenOrderRequest) Reset() {
+	*x = ScreenOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScreenOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScreenOrderRequest) ProtoMessage() {}
+
+func (x *ScreenOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScreenOrderRequest.ProtoReflect.Descriptor instead.
+func (*ScreenOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ScreenOrderRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *ScreenOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ScreenOrderRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ScreenOrderRequest) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ScreenOrderRequest) GetBillingCountry() string {
+	if x != nil {
+		return x.BillingCountry
+	}
+	return ""
+}
+
+func (x *ScreenOrderRequest) GetShippingCountry() string {
+	if x != nil {
+		return x.ShippingCountry
+	}
+	return ""
+}
+
+func (x *ScreenOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *ScreenOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetFraudCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFraudCheckRequest) Reset() {
+	*x = GetFraudCheckRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFraudCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFraudCheckRequest) ProtoMessage() {}
+
+func (x *GetFraudCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFraudCheckRequest.ProtoReflect.Descriptor instead.
+func (*GetFraudCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *GetFraudCheckRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListFraudChecksRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Decision       string                 `protobuf:"bytes,1,opt,name=decision,proto3" json:"decision,omitempty"`                                   // Optional; review lists the review queue, oldest first
+	OrderReference string                 `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"` // Optional
+	Page           int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListFraudChecksRequest) Reset() {
+	*x = ListFraudChecksRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudChecksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudChecksRequest) ProtoMessage() {}
+
+func (x *ListFraudChecksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudChecksRequest.ProtoReflect.Descriptor instead.
+func (*ListFraudChecksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *ListFraudChecksRequest) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *ListFraudChecksRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *ListFraudChecksRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListFraudChecksRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFraudChecksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checks        []*FraudCheck          `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudChecksResponse) Reset() {
+	*x = ListFraudChecksResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudChecksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudChecksResponse) ProtoMessage() {}
+
+func (x *ListFraudChecksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudChecksResponse.ProtoReflect.Descriptor instead.
+func (*ListFraudChecksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ListFraudChecksResponse) GetChecks() []*FraudCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *ListFraudChecksResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Decision of staff on an order in the review queue
+type ReviewFraudCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Approve       bool                   `protobuf:"varint,2,opt,name=approve,proto3" json:"approve,omitempty"`
+	ReviewedBy    string                 `protobuf:"bytes,3,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewFraudCheckRequest) Reset() {
+	*x = ReviewFraudCheckRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewFraudCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewFraudCheckRequest) ProtoMessage() {}
+
+func (x *ReviewFraudCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewFraudCheckRequest.ProtoReflect.Descriptor instead.
+func (*ReviewFraudCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *ReviewFraudCheckRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReviewFraudCheckRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewFraudCheckRequest) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *ReviewFraudCheckRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type FraudEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FraudCheckId   string                 `protobuf:"bytes,2,opt,name=fraud_check_id,json=fraudCheckId,proto3" json:"fraud_check_id,omitempty"`
+	OrderReference string                 `protobuf:"bytes,3,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	Decision       string                 `protobuf:"bytes,4,opt,name=decision,proto3" json:"decision,omitempty"`
+	Score          int32                  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	ReviewedBy     string                 `protobuf:"bytes,6,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FraudEvent) Reset() {
+	*x = FraudEvent{}
+	mi := &file_proto_inventory_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FraudEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudEvent) ProtoMessage() {}
+
+func (x *FraudEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudEvent.ProtoReflect.Descriptor instead.
+func (*FraudEvent) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *FraudEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FraudEvent) GetFraudCheckId() string {
+	if x != nil {
+		return x.FraudCheckId
+	}
+	return ""
+}
+
+func (x *FraudEvent) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *FraudEvent) GetDecision() string {
+	if x != nil {
+		return x.Decision
+	}
+	return ""
+}
+
+func (x *FraudEvent) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *FraudEvent) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *FraudEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListFraudEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only return events after this ID, for readers to resume where they stopped
+	AfterId       int64 `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudEventsRequest) Reset() {
+	*x = ListFraudEventsRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudEventsRequest) ProtoMessage() {}
+
+func (x *ListFraudEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListFraudEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *ListFraudEventsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListFraudEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFraudEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*FraudEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFraudEventsResponse) Reset() {
+	*x = ListFraudEventsResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFraudEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFraudEventsResponse) ProtoMessage() {}
+
+func (x *ListFraudEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFraudEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListFraudEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *ListFraudEventsResponse) GetEvents() []*FraudEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Retail store messages
+type OpeningHours struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekday       int32                  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"` // 0 is Sunday
+	Opens         string                 `protobuf:"bytes,2,opt,name=opens,proto3" json:"opens,omitempty"`      // HH:MM, local time of the store
+	Closes        string                 `protobuf:"bytes,3,opt,name=closes,proto3" json:"closes,omitempty"`    // HH:MM, local time of the store
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OpeningHours) Reset() {
+	*x = OpeningHours{}
+	mi := &file_proto_inventory_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpeningHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpeningHours) ProtoMessage() {}
+
+func (x *OpeningHours) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpeningHours.ProtoReflect.Descriptor instead.
+func (*OpeningHours) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *OpeningHours) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *OpeningHours) GetOpens() string {
+	if x != nil {
+		return x.Opens
+	}
+	return ""
+}
+
+func (x *OpeningHours) GetCloses() string {
+	if x != nil {
+		return x.Closes
+	}
+	return ""
+}
+
+type RetailStore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA name, such as Europe/Paris
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	OpeningHours  []*OpeningHours        `protobuf:"bytes,6,rep,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	PickupEnabled bool                   `protobuf:"varint,7,opt,name=pickup_enabled,json=pickupEnabled,proto3" json:"pickup_enabled,omitempty"`
+	HoldHours     int32                  `protobuf:"varint,8,opt,name=hold_hours,json=holdHours,proto3" json:"hold_hours,omitempty"` // How long in-store pickup reservations are held
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetailStore) Reset() {
+	*x = RetailStore{}
+	mi := &file_proto_inventory_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetailStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetailStore) ProtoMessage() {}
+
+func (x *RetailStore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetailStore.ProtoReflect.Descriptor instead.
+func (*RetailStore) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *RetailStore) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+func (x *RetailStore) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *RetailStore) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *RetailStore) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *RetailStore) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *RetailStore) GetOpeningHours() []*OpeningHours {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+func (x *RetailStore) GetPickupEnabled() bool {
+	if x != nil {
+		return x.PickupEnabled
+	}
+	return false
+}
+
+func (x *RetailStore) GetHoldHours() int32 {
+	if x != nil {
+		return x.HoldHours
+	}
+	return 0
+}
+
+func (x *RetailStore) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RetailStore) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Makes a warehouse a retail store, or replaces its store details
+type SetRetailStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Latitude      float64                `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Timezone      string                 `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"` // Defaults to UTC
+	Phone         string                 `protobuf:"bytes,5,opt,name=phone,proto3" json:"phone,omitempty"`
+	OpeningHours  []*OpeningHours        `protobuf:"bytes,6,rep,name=opening_hours,json=openingHours,proto3" json:"opening_hours,omitempty"`
+	PickupEnabled bool                   `protobuf:"varint,7,opt,name=pickup_enabled,json=pickupEnabled,proto3" json:"pickup_enabled,omitempty"`
+	HoldHours     int32                  `protobuf:"varint,8,opt,name=hold_hours,json=holdHours,proto3" json:"hold_hours,omitempty"` // Defaults to 48
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRetailStoreRequest) Reset() {
+	*x = SetRetailStoreRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRetailStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetailStoreRequest) ProtoMessage() {}
+
+func (x *SetRetailStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetailStoreRequest.ProtoReflect.Descriptor instead.
+func (*SetRetailStoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *SetRetailStoreRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *SetRetailStoreRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *SetRetailStoreRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *SetRetailStoreRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *SetRetailStoreRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *SetRetailStoreRequest) GetOpeningHours() []*OpeningHours {
+	if x != nil {
+		return x.OpeningHours
+	}
+	return nil
+}
+
+func (x *SetRetailStoreRequest) GetPickupEnabled() bool {
+	if x != nil {
+		return x.PickupEnabled
+	}
+	return false
+}
+
+func (x *SetRetailStoreRequest) GetHoldHours() int32 {
+	if x != nil {
+		return x.HoldHours
+	}
+	return 0
+}
+
+type DeleteRetailStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRetailStoreRequest) Reset() {
+	*x = DeleteRetailStoreRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRetailStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRetailStoreRequest) ProtoMessage() {}
+
+func (x *DeleteRetailStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRetailStoreRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRetailStoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *DeleteRetailStoreRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+type DeleteRetailStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRetailStoreResponse) Reset() {
+	*x = DeleteRetailStoreResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRetailStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRetailStoreResponse) ProtoMessage() {}
+
+func (x *DeleteRetailStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRetailStoreResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRetailStoreResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{185}
+}
+
+type ListRetailStoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRetailStoresRequest) Reset() {
+	*x = ListRetailStoresRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRetailStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetailStoresRequest) ProtoMessage() {}
+
+func (x *ListRetailStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetailStoresRequest.ProtoReflect.Descriptor instead.
+func (*ListRetailStoresRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{186}
+}
+
+type ListRetailStoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stores        []*RetailStore         `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRetailStoresResponse) Reset() {
+	*x = ListRetailStoresResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRetailStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetailStoresResponse) ProtoMessage() {}
+
+func (x *ListRetailStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetailStoresResponse.ProtoReflect.Descriptor instead.
+func (*ListRetailStoresResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *ListRetailStoresResponse) GetStores() []*RetailStore {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+type FindNearbyStoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	RadiusKm      float64                `protobuf:"fixed64,3,opt,name=radius_km,json=radiusKm,proto3" json:"radius_km,omitempty"`  // Defaults to 50, at most 500
+	ProductId     string                 `protobuf:"bytes,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // Optional; reports the stock of the product at each store
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`                   // Quantity the product is in stock for; defaults to 1
+	Limit         int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`                         // Defaults to 10, at most 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearbyStoresRequest) Reset() {
+	*x = FindNearbyStoresRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearbyStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearbyStoresRequest) ProtoMessage() {}
+
+func (x *FindNearbyStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearbyStoresRequest.ProtoReflect.Descriptor instead.
+func (*FindNearbyStoresRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *FindNearbyStoresRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *FindNearbyStoresRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *FindNearbyStoresRequest) GetRadiusKm() float64 {
+	if x != nil {
+		return x.RadiusKm
+	}
+	return 0
+}
+
+func (x *FindNearbyStoresRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *FindNearbyStoresRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *FindNearbyStoresRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type NearbyStore struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Store             *RetailStore           `protobuf:"bytes,1,opt,name=store,proto3" json:"store,omitempty"`
+	DistanceKm        float64                `protobuf:"fixed64,2,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	OpenNow           bool                   `protobuf:"varint,3,opt,name=open_now,json=openNow,proto3" json:"open_now,omitempty"`
+	AvailableQuantity int32                  `protobuf:"varint,4,opt,name=available_quantity,json=availableQuantity,proto3" json:"available_quantity,omitempty"` // Sellable stock of the product at the store
+	InStock           bool                   `protobuf:"varint,5,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NearbyStore) Reset() {
+	*x = NearbyStore{}
+	mi := &file_proto_inventory_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearbyStore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearbyStore) ProtoMessage() {}
+
+func (x *NearbyStore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearbyStore.ProtoReflect.Descriptor instead.
+func (*NearbyStore) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *NearbyStore) GetStore() *RetailStore {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+func (x *NearbyStore) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *NearbyStore) GetOpenNow() bool {
+	if x != nil {
+		return x.OpenNow
+	}
+	return false
+}
+
+func (x *NearbyStore) GetAvailableQuantity() int32 {
+	if x != nil {
+		return x.AvailableQuantity
+	}
+	return 0
+}
+
+func (x *NearbyStore) GetInStock() bool {
+	if x != nil {
+		return x.InStock
+	}
+	return false
+}
+
+type FindNearbyStoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stores        []*NearbyStore         `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"` // Nearest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindNearbyStoresResponse) Reset() {
+	*x = FindNearbyStoresResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindNearbyStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindNearbyStoresResponse) ProtoMessage() {}
+
+func (x *FindNearbyStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindNearbyStoresResponse.ProtoReflect.Descriptor instead.
+func (*FindNearbyStoresResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *FindNearbyStoresResponse) GetStores() []*NearbyStore {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+type ReserveInStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`                                  // Unit of measure of the quantity; defaults to the base unit
+	ReferenceId   string                 `protobuf:"bytes,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"` // Optional, such as the customer or cart
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveInStoreRequest) Reset() {
+	*x = ReserveInStoreRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveInStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveInStoreRequest) ProtoMessage() {}
+
+func (x *ReserveInStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveInStoreRequest.ProtoReflect.Descriptor instead.
+func (*ReserveInStoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *ReserveInStoreRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ReserveInStoreRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReserveInStoreRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReserveInStoreRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ReserveInStoreRequest) GetReferenceId() string {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return ""
+}
+
+type ReserveInStoreResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reservation   *InventoryReservation  `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	Store         *RetailStore           `protobuf:"bytes,2,opt,name=store,proto3" json:"store,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveInStoreResponse) Reset() {
+	*x = ReserveInStoreResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveInStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveInStoreResponse) ProtoMessage() {}
+
+func (x *ReserveInStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveInStoreResponse.ProtoReflect.Descriptor instead.
+func (*ReserveInStoreResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ReserveInStoreResponse) GetReservation() *InventoryReservation {
+	if x != nil {
+		return x.Reservation
+	}
+	return nil
+}
+
+func (x *ReserveInStoreResponse) GetStore() *RetailStore {
+	if x != nil {
+		return x.Store
+	}
+	return nil
+}
+
+// Pickup order messages
+type PickupLine struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Id              string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	InventoryItemId string                  `protobuf:"bytes,2,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	ProductId       string                  `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku             string                  `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity        int32                   `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"` // In base units
+	ReservationId   string                  `protobuf:"bytes,6,opt,name=reservation_id,json=reservationId,proto3" json:"reservation_id,omitempty"`
+	Personalization []*PersonalizationValue `protobuf:"bytes,7,rep,name=personalization,proto3" json:"personalization,omitempty"` // Shown to the staff picking the line
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PickupLine) Reset() {
+	*x = PickupLine{}
+	mi := &file_proto_inventory_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupLine) ProtoMessage() {}
+
+func (x *PickupLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupLine.ProtoReflect.Descriptor instead.
+func (*PickupLine) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *PickupLine) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PickupLine) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *PickupLine) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PickupLine) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *PickupLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PickupLine) GetReservationId() string {
+	if x != nil {
+		return x.ReservationId
+	}
+	return ""
+}
+
+func (x *PickupLine) GetPersonalization() []*PersonalizationValue {
+	if x != nil {
+		return x.Personalization
+	}
+	return nil
+}
+
+// Input of a buyer for a personalization option of a product, such as an
+// engraving text or uploaded artwork, as validated by the product service
+type PersonalizationValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionId      string                 `protobuf:"bytes,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	FileUrl       string                 `protobuf:"bytes,4,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	FileName      string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonalizationValue) Reset() {
+	*x = PersonalizationValue{}
+	mi := &file_proto_inventory_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalizationValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalizationValue) ProtoMessage() {}
+
+func (x *PersonalizationValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalizationValue.ProtoReflect.Descriptor instead.
+func (*PersonalizationValue) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *PersonalizationValue) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type PickupOrder struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderReference string                 `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	WarehouseId    string                 `protobuf:"bytes,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"` // Warehouse of the store the order is collected at
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email          string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                        // pending, picking, ready, collected or cancelled
+	CollectBy      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=collect_by,json=collectBy,proto3" json:"collect_by,omitempty"` // Set once ready; cancelled after
+	ReadyAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ready_at,json=readyAt,proto3" json:"ready_at,omitempty"`
+	CollectedAt    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	CancelledAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CancelReason   string                 `protobuf:"bytes,11,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
+	Lines          []*PickupLine          `protobuf:"bytes,12,rep,name=lines,proto3" json:"lines,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PickupOrder) Reset() {
+	*x = PickupOrder{}
+	mi := &file_proto_inventory_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupOrder) ProtoMessage() {}
+
+func (x *PickupOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupOrder.ProtoReflect.Descriptor instead.
+func (*PickupOrder) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *PickupOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PickupOrder) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *PickupOrder) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *PickupOrder) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PickupOrder) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *PickupOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PickupOrder) GetCollectBy() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectBy
+	}
+	return nil
+}
+
+func (x *PickupOrder) GetReadyAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadyAt
+	}
+	return nil
+}
+
+func (x *PickupOrder) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *PickupOrder) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *PickupOrder) GetCancelReason() string {
+	if x != nil {
+		return x.CancelReason
+	}
+	return ""
+}
+
+func (x *PickupOrder) GetLines() []*PickupLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *PickupOrder) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PickupOrder) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type PickupItem struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	ProductId       string                  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity        int32                   `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Unit            string                  `protobuf:"bytes,3,opt,name=unit,proto3" json:"unit,omitempty"` // Unit of measure of the quantity; defaults to the base unit
+	Personalization []*PersonalizationValue `protobuf:"bytes,4,rep,name=personalization,proto3" json:"personalization,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PickupItem) Reset() {
+	*x = PickupItem{}
+	mi := &file_proto_inventory_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupItem) ProtoMessage() {}
+
+func (x *PickupItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupItem.ProtoReflect.Descriptor instead.
+func (*PickupItem) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *PickupItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PickupItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PickupItem) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *PickupItem) GetPersonalization() []*PersonalizationValue {
+	if x != nil {
+		return x.Personalization
+	}
+	return nil
+}
+
+type CreatePickupOrderRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderReference string                 `protobuf:"bytes,1,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	WarehouseId    string                 `protobuf:"bytes,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Optional, for guest checkouts
+	Email          string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                 // Where the customer is told the order is ready
+	Items          []*PickupItem          `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePickupOrderRequest) Reset() {
+	*x = CreatePickupOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePickupOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePickupOrderRequest) ProtoMessage() {}
+
+func (x *CreatePickupOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePickupOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreatePickupOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *CreatePickupOrderRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *CreatePickupOrderRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *CreatePickupOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreatePickupOrderRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreatePickupOrderRequest) GetItems() []*PickupItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetPickupOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Optional; orders of other customers are not found
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPickupOrderRequest) Reset() {
+	*x = GetPickupOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPickupOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPickupOrderRequest) ProtoMessage() {}
+
+func (x *GetPickupOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPickupOrderRequest.ProtoReflect.Descriptor instead.
+func (*GetPickupOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *GetPickupOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetPickupOrderRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListPickupOrdersRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId    string                 `protobuf:"bytes,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`          // Optional
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                         // Optional
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`                                       // Optional
+	OrderReference string                 `protobuf:"bytes,4,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"` // Optional
+	Page           int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit          int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPickupOrdersRequest) Reset() {
+	*x = ListPickupOrdersRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPickupOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPickupOrdersRequest) ProtoMessage() {}
+
+func (x *ListPickupOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPickupOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListPickupOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{199}
 }
 
-func (x *BulkUpdateInventoryResponse) GetSuccessCount() int32 {
+func (x *ListPickupOrdersRequest) GetWarehouseId() string {
 	if x != nil {
-		return x.SuccessCount
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ListPickupOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListPickupOrdersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPickupOrdersRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *ListPickupOrdersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
 	}
 	return 0
 }
 
-func (x *BulkUpdateInventoryResponse) GetFailureCount() int32 {
+func (x *ListPickupOrdersRequest) GetLimit() int32 {
 	if x != nil {
-		return x.FailureCount
+		return x.Limit
 	}
 	return 0
 }
 
-type BulkUpdateResult struct {
+type ListPickupOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	UpdatedItem   *InventoryItem         `protobuf:"bytes,4,opt,name=updated_item,json=updatedItem,proto3" json:"updated_item,omitempty"`
+	Orders        []*PickupOrder         `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"` // Oldest first
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BulkUpdateResult) Reset() {
-	*x = BulkUpdateResult{}
-	mi := &file_proto_inventory_proto_msgTypes[35]
+func (x *ListPickupOrdersResponse) Reset() {
+	*x = ListPickupOrdersResponse{}
+	mi := &file_proto_inventory_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BulkUpdateResult) String() string {
+func (x *ListPickupOrdersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BulkUpdateResult) ProtoMessage() {}
+func (*ListPickupOrdersResponse) ProtoMessage() {}
 
-func (x *BulkUpdateResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_inventory_proto_msgTypes[35]
+func (x *ListPickupOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2656,44 +14353,134 @@ func (x *BulkUpdateResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BulkUpdateResult.ProtoReflect.Descriptor instead.
-func (*BulkUpdateResult) Descriptor() ([]byte, []int) {
-	return file_proto_inventory_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListPickupOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListPickupOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{200}
 }
 
-func (x *BulkUpdateResult) GetSku() string {
+func (x *ListPickupOrdersResponse) GetOrders() []*PickupOrder {
 	if x != nil {
-		return x.Sku
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListPickupOrdersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type PickupOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PickupOrderRequest) Reset() {
+	*x = PickupOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PickupOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PickupOrderRequest) ProtoMessage() {}
+
+func (x *PickupOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PickupOrderRequest.ProtoReflect.Descriptor instead.
+func (*PickupOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *PickupOrderRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BulkUpdateResult) GetSuccess() bool {
+type CancelPickupOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Optional; orders of other customers are not found
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelPickupOrderRequest) Reset() {
+	*x = CancelPickupOrderRequest{}
+	mi := &file_proto_inventory_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelPickupOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelPickupOrderRequest) ProtoMessage() {}
+
+func (x *CancelPickupOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_inventory_proto_msgTypes[202]
 	if x != nil {
-		return x.Success
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *BulkUpdateResult) GetMessage() string {
+// Deprecated: Use CancelPickupOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelPickupOrderRequest) Descriptor() ([]byte, []int) {
+	return file_proto_inventory_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *CancelPickupOrderRequest) GetId() string {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
 	return ""
 }
 
-func (x *BulkUpdateResult) GetUpdatedItem() *InventoryItem {
+func (x *CancelPickupOrderRequest) GetUserId() string {
 	if x != nil {
-		return x.UpdatedItem
+		return x.UserId
 	}
-	return nil
+	return ""
+}
+
+func (x *CancelPickupOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
 var File_proto_inventory_proto protoreflect.FileDescriptor
 
 const file_proto_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x15proto/inventory.proto\x12\tinventory\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xe9\x04\n" +
+	"\x15proto/inventory.proto\x12\tinventory\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x8d\x05\n" +
 	"\rInventoryItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -2713,7 +14500,8 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12:\n" +
-	"\tlocations\x18\x0e \x03(\v2\x1c.inventory.InventoryLocationR\tlocations\"\xf1\x02\n" +
+	"\tlocations\x18\x0e \x03(\v2\x1c.inventory.InventoryLocationR\tlocations\x12\"\n" +
+	"\favailability\x18\x0f \x01(\tR\favailability\"\xf1\x02\n" +
 	"\tWarehouse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -2730,7 +14518,7 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x94\x03\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd4\x03\n" +
 	"\x11InventoryLocation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
 	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12!\n" +
@@ -2742,7 +14530,10 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x122\n" +
-	"\twarehouse\x18\t \x01(\v2\x14.inventory.WarehouseR\twarehouse\"\x8c\x04\n" +
+	"\twarehouse\x18\t \x01(\v2\x14.inventory.WarehouseR\twarehouse\x12!\n" +
+	"\fsafety_stock\x18\n" +
+	" \x01(\x05R\vsafetyStock\x12\x1b\n" +
+	"\tmax_stock\x18\v \x01(\x05R\bmaxStock\"\x8c\x04\n" +
 	"\x14InventoryTransaction\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
 	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12?\n" +
@@ -2756,7 +14547,7 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"created_by\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\tcreatedBy\x129\n" +
 	"\n" +
 	"created_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xea\x03\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x98\x04\n" +
 	"\x14InventoryReservation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
 	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12?\n" +
@@ -2770,7 +14561,15 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xda\x02\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12,\n" +
+	"\x04lots\x18\v \x03(\v2\x18.inventory.LotAllocationR\x04lots\"\x9c\x01\n" +
+	"\rLotAllocation\x12\x15\n" +
+	"\x06lot_id\x18\x01 \x01(\tR\x05lotId\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x02 \x01(\tR\tlotNumber\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"\xda\x02\n" +
 	"\x1aCreateInventoryItemRequest\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12;\n" +
@@ -2843,25 +14642,34 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\n" +
 	"warehouses\x18\x01 \x03(\v2\x14.inventory.WarehouseR\n" +
 	"warehouses\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x05R\x05total\"\xea\x01\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xa7\x02\n" +
 	"\x1dAddInventoryToLocationRequest\x12*\n" +
 	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12!\n" +
 	"\fwarehouse_id\x18\x02 \x01(\tR\vwarehouseId\x12\x1a\n" +
 	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12!\n" +
 	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12%\n" +
 	"\x0ereference_type\x18\x05 \x01(\tR\rreferenceType\x12\x14\n" +
-	"\x05notes\x18\x06 \x01(\tR\x05notes\"\xef\x01\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12\x12\n" +
+	"\x04unit\x18\a \x01(\tR\x04unit\x12'\n" +
+	"\x0fidempotency_key\x18\b \x01(\tR\x0eidempotencyKey\"\xac\x02\n" +
 	"\"RemoveInventoryFromLocationRequest\x12*\n" +
 	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12!\n" +
 	"\fwarehouse_id\x18\x02 \x01(\tR\vwarehouseId\x12\x1a\n" +
 	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12!\n" +
 	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12%\n" +
 	"\x0ereference_type\x18\x05 \x01(\tR\rreferenceType\x12\x14\n" +
-	"\x05notes\x18\x06 \x01(\tR\x05notes\"l\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12\x12\n" +
+	"\x04unit\x18\a \x01(\tR\x04unit\x12'\n" +
+	"\x0fidempotency_key\x18\b \x01(\tR\x0eidempotencyKey\"l\n" +
 	"\x1dGetInventoryByLocationRequest\x12!\n" +
 	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x05R\x05limit\"h\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xa7\x01\n" +
+	"\x16SetStockBuffersRequest\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\tR\vwarehouseId\x12!\n" +
+	"\fsafety_stock\x18\x03 \x01(\x05R\vsafetyStock\x12\x1b\n" +
+	"\tmax_stock\x18\x04 \x01(\x05R\bmaxStock\"h\n" +
 	"\x19InventoryLocationResponse\x12K\n" +
 	"\x12inventory_location\x18\x01 \x01(\v2\x1c.inventory.InventoryLocationR\x11inventoryLocation\"\x85\x01\n" +
 	"\x1eListInventoryLocationsResponse\x12M\n" +
@@ -2871,11 +14679,12 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\x05items\x18\x01 \x03(\v2\x1a.inventory.ReservationItemR\x05items\x12!\n" +
 	"\freference_id\x18\x02 \x01(\tR\vreferenceId\x12%\n" +
 	"\x0ereference_type\x18\x03 \x01(\tR\rreferenceType\x12/\n" +
-	"\x13reservation_minutes\x18\x04 \x01(\x05R\x12reservationMinutes\"\x9a\x01\n" +
+	"\x13reservation_minutes\x18\x04 \x01(\x05R\x12reservationMinutes\"\xae\x01\n" +
 	"\x0fReservationItem\x12*\n" +
 	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x1a\n" +
 	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12?\n" +
-	"\fwarehouse_id\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\"B\n" +
+	"\fwarehouse_id\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\"B\n" +
 	"\x19ConfirmReservationRequest\x12%\n" +
 	"\x0ereservation_id\x18\x01 \x01(\tR\rreservationId\"A\n" +
 	"\x18CancelReservationRequest\x12%\n" +
@@ -2885,17 +14694,18 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\"[\n" +
 	"!CheckInventoryAvailabilityRequest\x126\n" +
-	"\x05items\x18\x01 \x03(\v2 .inventory.AvailabilityCheckItemR\x05items\"\xa1\x01\n" +
+	"\x05items\x18\x01 \x03(\v2 .inventory.AvailabilityCheckItemR\x05items\"\xb5\x01\n" +
 	"\x15AvailabilityCheckItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12;\n" +
 	"\n" +
 	"variant_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\tvariantId\x12\x10\n" +
 	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x05R\bquantity\"w\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\"w\n" +
 	"\x1dInventoryAvailabilityResponse\x121\n" +
 	"\x05items\x18\x01 \x03(\v2\x1b.inventory.ItemAvailabilityR\x05items\x12#\n" +
-	"\rall_available\x18\x02 \x01(\bR\fallAvailable\"\x99\x02\n" +
+	"\rall_available\x18\x02 \x01(\bR\fallAvailable\"\x9b\x03\n" +
 	"\x10ItemAvailability\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12;\n" +
@@ -2905,16 +14715,72 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\x12requested_quantity\x18\x04 \x01(\x05R\x11requestedQuantity\x12-\n" +
 	"\x12available_quantity\x18\x05 \x01(\x05R\x11availableQuantity\x12!\n" +
 	"\fis_available\x18\x06 \x01(\bR\visAvailable\x12\x16\n" +
-	"\x06status\x18\a \x01(\tR\x06status\"M\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\"\n" +
+	"\favailability\x18\b \x01(\tR\favailability\x12\x12\n" +
+	"\x04unit\x18\t \x01(\tR\x04unit\x12\x1f\n" +
+	"\vunit_factor\x18\n" +
+	" \x01(\x05R\n" +
+	"unitFactor\x12'\n" +
+	"\x0favailable_units\x18\v \x01(\x05R\x0eavailableUnits\"U\n" +
+	"\x1cCheckAvailabilityBulkRequest\x125\n" +
+	"\x05lines\x18\x01 \x03(\v2\x1f.inventory.BulkAvailabilityLineR\x05lines\"\x85\x01\n" +
+	"\x14BulkAvailabilityLine\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12?\n" +
+	"\fwarehouse_id\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\"}\n" +
+	"\x1dCheckAvailabilityBulkResponse\x127\n" +
+	"\x05lines\x18\x01 \x03(\v2!.inventory.BulkAvailabilityResultR\x05lines\x12#\n" +
+	"\rall_available\x18\x02 \x01(\bR\fallAvailable\"\xeb\x03\n" +
+	"\x16BulkAvailabilityResult\x12\x1d\n" +
+	"\n" +
+	"line_index\x18\x01 \x01(\x05R\tlineIndex\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12;\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\tvariantId\x12?\n" +
+	"\fwarehouse_id\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\x12-\n" +
+	"\x12requested_quantity\x18\x06 \x01(\x05R\x11requestedQuantity\x12-\n" +
+	"\x12available_quantity\x18\a \x01(\x05R\x11availableQuantity\x12!\n" +
+	"\fis_available\x18\b \x01(\bR\visAvailable\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12F\n" +
+	"\falternatives\x18\n" +
+	" \x03(\v2\".inventory.AvailabilityAlternativeR\falternatives\x12\"\n" +
+	"\favailability\x18\v \x01(\tR\favailability\"\xe0\x01\n" +
+	"\x17AvailabilityAlternative\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12?\n" +
+	"\fwarehouse_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\x12%\n" +
+	"\x0ewarehouse_name\x18\x03 \x01(\tR\rwarehouseName\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12-\n" +
+	"\x12available_quantity\x18\x05 \x01(\x05R\x11availableQuantity\"\x99\x02\n" +
+	"\x12AvailabilityPolicy\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12K\n" +
+	"\x13low_stock_threshold\x18\x02 \x01(\v2\x1b.google.protobuf.Int32ValueR\x11lowStockThreshold\x123\n" +
+	"\x16out_of_stock_threshold\x18\x03 \x01(\x05R\x13outOfStockThreshold\x12'\n" +
+	"\x0fallow_backorder\x18\x04 \x01(\bR\x0eallowBackorder\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"=\n" +
+	"\x1cGetAvailabilityPolicyRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"U\n" +
+	"\x1cSetAvailabilityPolicyRequest\x125\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1d.inventory.AvailabilityPolicyR\x06policy\"@\n" +
+	"\x1fDeleteAvailabilityPolicyRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"<\n" +
+	" DeleteAvailabilityPolicyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"M\n" +
 	"\x1aBulkUpdateInventoryRequest\x12/\n" +
-	"\x05items\x18\x01 \x03(\v2\x19.inventory.BulkUpdateItemR\x05items\"\xcc\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x19.inventory.BulkUpdateItemR\x05items\"\xf5\x01\n" +
 	"\x0eBulkUpdateItem\x12\x10\n" +
 	"\x03sku\x18\x01 \x01(\tR\x03sku\x12%\n" +
 	"\x0equantity_delta\x18\x02 \x01(\x05R\rquantityDelta\x12!\n" +
 	"\fwarehouse_id\x18\x03 \x01(\tR\vwarehouseId\x12!\n" +
 	"\freference_id\x18\x04 \x01(\tR\vreferenceId\x12%\n" +
 	"\x0ereference_type\x18\x05 \x01(\tR\rreferenceType\x12\x14\n" +
-	"\x05notes\x18\x06 \x01(\tR\x05notes\"\x9e\x01\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12'\n" +
+	"\x0fidempotency_key\x18\a \x01(\tR\x0eidempotencyKey\"\x9e\x01\n" +
 	"\x1bBulkUpdateInventoryResponse\x125\n" +
 	"\aresults\x18\x01 \x03(\v2\x1b.inventory.BulkUpdateResultR\aresults\x12#\n" +
 	"\rsuccess_count\x18\x02 \x01(\x05R\fsuccessCount\x12#\n" +
@@ -2923,7 +14789,937 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12;\n" +
-	"\fupdated_item\x18\x04 \x01(\v2\x18.inventory.InventoryItemR\vupdatedItem2\x93\f\n" +
+	"\fupdated_item\x18\x04 \x01(\v2\x18.inventory.InventoryItemR\vupdatedItem\"\xc9\x02\n" +
+	"\x11InventorySnapshot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12?\n" +
+	"\rsnapshot_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\fsnapshotDate\x12*\n" +
+	"\x11inventory_item_id\x18\x03 \x01(\tR\x0finventoryItemId\x12?\n" +
+	"\fwarehouse_id\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12-\n" +
+	"\x12available_quantity\x18\x06 \x01(\x05R\x11availableQuantity\x12+\n" +
+	"\x11reserved_quantity\x18\a \x01(\x05R\x10reservedQuantity\"\xa2\x01\n" +
+	"\x15WatchInventoryRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12?\n" +
+	"\fwarehouse_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\x12'\n" +
+	"\x0finclude_current\x18\x03 \x01(\bR\x0eincludeCurrent\"\xfb\x04\n" +
+	"\x10StockChangeEvent\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12;\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\tvariantId\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12%\n" +
+	"\x0etotal_quantity\x18\x05 \x01(\x05R\rtotalQuantity\x12-\n" +
+	"\x12available_quantity\x18\x06 \x01(\x05R\x11availableQuantity\x12+\n" +
+	"\x11reserved_quantity\x18\a \x01(\x05R\x10reservedQuantity\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12?\n" +
+	"\fwarehouse_id\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\x12-\n" +
+	"\x12warehouse_quantity\x18\n" +
+	" \x01(\x05R\x11warehouseQuantity\x12@\n" +
+	"\x1cwarehouse_available_quantity\x18\v \x01(\x05R\x1awarehouseAvailableQuantity\x12\x1f\n" +
+	"\vchange_type\x18\f \x01(\tR\n" +
+	"changeType\x12;\n" +
+	"\voccurred_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\"\n" +
+	"\favailability\x18\x0e \x01(\tR\favailability\"\xa0\x02\n" +
+	"\x16GetStockHistoryRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12\x1f\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tH\x00R\tproductId\x12\x12\n" +
+	"\x03sku\x18\x03 \x01(\tH\x00R\x03sku\x12?\n" +
+	"\fwarehouse_id\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\x129\n" +
+	"\n" +
+	"start_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendDateB\f\n" +
+	"\n" +
+	"identifier\"\x93\x02\n" +
+	"\x14StockHistoryResponse\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12?\n" +
+	"\fwarehouse_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\x12:\n" +
+	"\tsnapshots\x18\x03 \x03(\v2\x1c.inventory.InventorySnapshotR\tsnapshots\x12.\n" +
+	"\x13average_daily_usage\x18\x04 \x01(\x01R\x11averageDailyUsage\x12\"\n" +
+	"\rdays_of_cover\x18\x05 \x01(\x01R\vdaysOfCover\"\x8c\x01\n" +
+	"\x12GetForecastRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12\x1f\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tH\x00R\tproductId\x12\x12\n" +
+	"\x03sku\x18\x03 \x01(\tH\x00R\x03sku\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\tR\vwarehouseIdB\f\n" +
+	"\n" +
+	"identifier\"\xf7\x04\n" +
+	"\x11InventoryForecast\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\tR\vwarehouseId\x12%\n" +
+	"\x0eshort_velocity\x18\x05 \x01(\x01R\rshortVelocity\x12#\n" +
+	"\rlong_velocity\x18\x06 \x01(\x01R\flongVelocity\x12%\n" +
+	"\x0edaily_velocity\x18\a \x01(\x01R\rdailyVelocity\x12-\n" +
+	"\x12available_quantity\x18\b \x01(\x05R\x11availableQuantity\x12*\n" +
+	"\x11on_order_quantity\x18\t \x01(\x05R\x0fonOrderQuantity\x12!\n" +
+	"\fsafety_stock\x18\n" +
+	" \x01(\x05R\vsafetyStock\x12$\n" +
+	"\x0elead_time_days\x18\v \x01(\x05R\fleadTimeDays\x12\"\n" +
+	"\rdays_of_cover\x18\f \x01(\x01R\vdaysOfCover\x12?\n" +
+	"\rstockout_date\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\fstockoutDate\x12)\n" +
+	"\x10reorder_quantity\x18\x0e \x01(\x05R\x0freorderQuantity\x12;\n" +
+	"\vcomputed_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"}\n" +
+	"\x13GetForecastResponse\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12:\n" +
+	"\tforecasts\x18\x02 \x03(\v2\x1c.inventory.InventoryForecastR\tforecasts\"l\n" +
+	"\x1dListReorderSuggestionsRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"v\n" +
+	"\x1eListReorderSuggestionsResponse\x12>\n" +
+	"\vsuggestions\x18\x01 \x03(\v2\x1c.inventory.InventoryForecastR\vsuggestions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"Y\n" +
+	"\x16ListStockAlertsRequest\x12?\n" +
+	"\fwarehouse_id\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\"\xb5\x02\n" +
+	"\n" +
+	"StockAlert\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12?\n" +
+	"\fwarehouse_id\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\vwarehouseId\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1c\n" +
+	"\tthreshold\x18\a \x01(\x05R\tthreshold\x12;\n" +
+	"\vdetected_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAt\"H\n" +
+	"\x17ListStockAlertsResponse\x12-\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x15.inventory.StockAlertR\x06alerts\"\x8e\x01\n" +
+	"\x1cListInventoryActivityRequest\x12;\n" +
+	"\vbefore_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"beforeTime\x12\x1b\n" +
+	"\tbefore_id\x18\x02 \x01(\tR\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xc3\x03\n" +
+	"\x11InventoryActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12!\n" +
+	"\fwarehouse_id\x18\x05 \x01(\tR\vwarehouseId\x12)\n" +
+	"\x10transaction_type\x18\x06 \x01(\tR\x0ftransactionType\x12\x1a\n" +
+	"\bquantity\x18\a \x01(\x05R\bquantity\x12%\n" +
+	"\x0ereference_type\x18\b \x01(\tR\rreferenceType\x12\x14\n" +
+	"\x05notes\x18\t \x01(\tR\x05notes\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tunit_code\x18\f \x01(\tR\bunitCode\x12#\n" +
+	"\runit_quantity\x18\r \x01(\x05R\funitQuantity\"W\n" +
+	"\x1dListInventoryActivityResponse\x126\n" +
+	"\aentries\x18\x01 \x03(\v2\x1c.inventory.InventoryActivityR\aentries\"\x17\n" +
+	"\x15GetDiagnosticsRequest\"\x81\x04\n" +
+	"\x11DBPoolDiagnostics\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\areplica\x18\x02 \x01(\bR\areplica\x120\n" +
+	"\x14max_open_connections\x18\x03 \x01(\x05R\x12maxOpenConnections\x12)\n" +
+	"\x10open_connections\x18\x04 \x01(\x05R\x0fopenConnections\x12\x15\n" +
+	"\x06in_use\x18\x05 \x01(\x05R\x05inUse\x12\x12\n" +
+	"\x04idle\x18\x06 \x01(\x05R\x04idle\x12\x1d\n" +
+	"\n" +
+	"wait_count\x18\a \x01(\x03R\twaitCount\x122\n" +
+	"\x15wait_duration_seconds\x18\b \x01(\x01R\x13waitDurationSeconds\x12&\n" +
+	"\x0fmax_idle_closed\x18\t \x01(\x03R\rmaxIdleClosed\x12/\n" +
+	"\x14max_idle_time_closed\x18\n" +
+	" \x01(\x03R\x11maxIdleTimeClosed\x12.\n" +
+	"\x13max_lifetime_closed\x18\v \x01(\x03R\x11maxLifetimeClosed\x12*\n" +
+	"\x11replica_lag_known\x18\f \x01(\bR\x0freplicaLagKnown\x12.\n" +
+	"\x13replica_lag_seconds\x18\r \x01(\x01R\x11replicaLagSeconds\"\xfa\x01\n" +
+	"\x10CacheDiagnostics\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
+	"\x15circuit_breaker_state\x18\x02 \x01(\tR\x13circuitBreakerState\x12\x18\n" +
+	"\ahealthy\x18\x03 \x01(\bR\ahealthy\x12%\n" +
+	"\x0ememory_entries\x18\x04 \x01(\x03R\rmemoryEntries\x12\x12\n" +
+	"\x04hits\x18\x05 \x01(\x03R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x06 \x01(\x03R\x06misses\x12\x16\n" +
+	"\x06errors\x18\a \x01(\x03R\x06errors\x12\x19\n" +
+	"\bhit_rate\x18\b \x01(\x01R\ahitRate\"\xa3\x02\n" +
+	"\x13DiagnosticsResponse\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12=\n" +
+	"\fcollected_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcollectedAt\x12%\n" +
+	"\x0euptime_seconds\x18\x03 \x01(\x01R\ruptimeSeconds\x12\x1e\n" +
+	"\n" +
+	"goroutines\x18\x04 \x01(\x05R\n" +
+	"goroutines\x127\n" +
+	"\bdb_pools\x18\x05 \x03(\v2\x1c.inventory.DBPoolDiagnosticsR\adbPools\x123\n" +
+	"\x06caches\x18\x06 \x03(\v2\x1b.inventory.CacheDiagnosticsR\x06caches\"\xe3\x02\n" +
+	"\x0eIntegrationKey\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"key_prefix\x18\x04 \x01(\tR\tkeyPrefix\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12<\n" +
+	"\flast_used_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastUsedAt\x129\n" +
+	"\n" +
+	"revoked_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\trevokedAt\x12\x1f\n" +
+	"\vdaily_quota\x18\b \x01(\x05R\n" +
+	"dailyQuota\x12\x1d\n" +
+	"\n" +
+	"used_today\x18\t \x01(\x05R\tusedToday\"n\n" +
+	"\x1bCreateIntegrationKeyRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
+	"\vdaily_quota\x18\x03 \x01(\x05R\n" +
+	"dailyQuota\"c\n" +
+	"\x1cCreateIntegrationKeyResponse\x12+\n" +
+	"\x03key\x18\x01 \x01(\v2\x19.inventory.IntegrationKeyR\x03key\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\x1c\n" +
+	"\x1aListIntegrationKeysRequest\"L\n" +
+	"\x1bListIntegrationKeysResponse\x12-\n" +
+	"\x04keys\x18\x01 \x03(\v2\x19.inventory.IntegrationKeyR\x04keys\"-\n" +
+	"\x1bRevokeIntegrationKeyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"E\n" +
+	"\x16IntegrationKeyResponse\x12+\n" +
+	"\x03key\x18\x01 \x01(\v2\x19.inventory.IntegrationKeyR\x03key\"P\n" +
+	"\x1dSetIntegrationKeyQuotaRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vdaily_quota\x18\x02 \x01(\x05R\n" +
+	"dailyQuota\"5\n" +
+	"\x1aGetIntegrationQuotaRequest\x12\x17\n" +
+	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\"\xd1\x01\n" +
+	"\x10IntegrationQuota\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x1a\n" +
+	"\bprovider\x18\x02 \x01(\tR\bprovider\x12\x1f\n" +
+	"\vdaily_quota\x18\x03 \x01(\x05R\n" +
+	"dailyQuota\x12\x12\n" +
+	"\x04used\x18\x04 \x01(\x05R\x04used\x12\x1c\n" +
+	"\tremaining\x18\x05 \x01(\x05R\tremaining\x127\n" +
+	"\tresets_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bresetsAt\"?\n" +
+	"\x0fFulfillmentLine\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xfa\x02\n" +
+	"\x10FulfillmentEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12%\n" +
+	"\x0ewarehouse_code\x18\x03 \x01(\tR\rwarehouseCode\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04mode\x18\a \x01(\tR\x04mode\x12'\n" +
+	"\x0forder_reference\x18\b \x01(\tR\x0eorderReference\x12\x18\n" +
+	"\acarrier\x18\t \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\n" +
+	" \x01(\tR\x0etrackingNumber\x120\n" +
+	"\x05lines\x18\v \x03(\v2\x1a.inventory.FulfillmentLineR\x05lines\"l\n" +
+	"\x1cPushFulfillmentEventsRequest\x12\x17\n" +
+	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x123\n" +
+	"\x06events\x18\x02 \x03(\v2\x1b.inventory.FulfillmentEventR\x06events\"V\n" +
+	"\x16FulfillmentEventResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\xab\x01\n" +
+	"\x1dPushFulfillmentEventsResponse\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12;\n" +
+	"\aresults\x18\x02 \x03(\v2!.inventory.FulfillmentEventResultR\aresults\x121\n" +
+	"\x05quota\x18\x03 \x01(\v2\x1b.inventory.IntegrationQuotaR\x05quota\"\xba\x02\n" +
+	"\x10OrderStatusEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1a\n" +
+	"\bprovider\x18\x04 \x01(\tR\bprovider\x12\x18\n" +
+	"\acarrier\x18\x05 \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x06 \x01(\tR\x0etrackingNumber\x12;\n" +
+	"\voccurred_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"O\n" +
+	"\x1cListOrderStatusEventsRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"T\n" +
+	"\x1dListOrderStatusEventsResponse\x123\n" +
+	"\x06events\x18\x01 \x03(\v2\x1b.inventory.OrderStatusEventR\x06events\"\xba\x01\n" +
+	"\rShipmentEvent\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1a\n" +
+	"\blocation\x18\x03 \x01(\tR\blocation\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xd9\x03\n" +
+	"\bShipment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x18\n" +
+	"\acarrier\x18\x04 \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x05 \x01(\tR\x0etrackingNumber\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"shipped_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tshippedAt\x12=\n" +
+	"\fdelivered_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vdeliveredAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x120\n" +
+	"\x06events\x18\v \x03(\v2\x18.inventory.ShipmentEventR\x06events\"\xd7\x01\n" +
+	"\x15CreateShipmentRequest\x12'\n" +
+	"\x0forder_reference\x18\x01 \x01(\tR\x0eorderReference\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x18\n" +
+	"\acarrier\x18\x03 \x01(\tR\acarrier\x12'\n" +
+	"\x0ftracking_number\x18\x04 \x01(\tR\x0etrackingNumber\x129\n" +
+	"\n" +
+	"shipped_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tshippedAt\"\x9a\x01\n" +
+	"\x14ListShipmentsRequest\x12'\n" +
+	"\x0forder_reference\x18\x01 \x01(\tR\x0eorderReference\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"`\n" +
+	"\x15ListShipmentsResponse\x121\n" +
+	"\tshipments\x18\x01 \x03(\v2\x13.inventory.ShipmentR\tshipments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"l\n" +
+	"\x18GetShipmentStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\x8c\x01\n" +
+	"\x16ShipmentStatusResponse\x12'\n" +
+	"\x0forder_reference\x18\x01 \x01(\tR\x0eorderReference\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x121\n" +
+	"\tshipments\x18\x03 \x03(\v2\x13.inventory.ShipmentR\tshipments\"\xca\x01\n" +
+	"\fCarrierEvent\x12'\n" +
+	"\x0ftracking_number\x18\x01 \x01(\tR\x0etrackingNumber\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\blocation\x18\x04 \x01(\tR\blocation\x12;\n" +
+	"\voccurred_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x81\x01\n" +
+	"\x1bReceiveCarrierEventsRequest\x12\x17\n" +
+	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x12/\n" +
+	"\x06events\x18\x03 \x03(\v2\x17.inventory.CarrierEventR\x06events\"k\n" +
+	"\x12CarrierEventResult\x12'\n" +
+	"\x0ftracking_number\x18\x01 \x01(\tR\x0etrackingNumber\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x8a\x01\n" +
+	"\x1cReceiveCarrierEventsResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.inventory.CarrierEventResultR\aresults\x121\n" +
+	"\x05quota\x18\x02 \x01(\v2\x1b.inventory.IntegrationQuotaR\x05quota\"\x93\x02\n" +
+	"\x0fSupplierProduct\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\tR\n" +
+	"supplierId\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12!\n" +
+	"\fsupplier_sku\x18\x04 \x01(\tR\vsupplierSku\x12\x1d\n" +
+	"\n" +
+	"cost_price\x18\x05 \x01(\x01R\tcostPrice\x12$\n" +
+	"\x0elead_time_days\x18\x06 \x01(\x05R\fleadTimeDays\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd7\x02\n" +
+	"\bSupplier\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x126\n" +
+	"\bproducts\x18\n" +
+	" \x03(\v2\x1a.inventory.SupplierProductR\bproducts\"\x89\x01\n" +
+	"\x15CreateSupplierRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\"\xb6\x01\n" +
+	"\x15UpdateSupplierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\"$\n" +
+	"\x12GetSupplierRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"y\n" +
+	"\x14ListSuppliersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x127\n" +
+	"\tis_active\x18\x03 \x01(\v2\x1a.google.protobuf.BoolValueR\bisActive\"`\n" +
+	"\x15ListSuppliersResponse\x121\n" +
+	"\tsuppliers\x18\x01 \x03(\v2\x13.inventory.SupplierR\tsuppliers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xd0\x01\n" +
+	"\x19SetSupplierProductRequest\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\tR\n" +
+	"supplierId\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12!\n" +
+	"\fsupplier_sku\x18\x03 \x01(\tR\vsupplierSku\x12\x1d\n" +
+	"\n" +
+	"cost_price\x18\x04 \x01(\x01R\tcostPrice\x12$\n" +
+	"\x0elead_time_days\x18\x05 \x01(\x05R\fleadTimeDays\"k\n" +
+	"\x1cRemoveSupplierProductRequest\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\tR\n" +
+	"supplierId\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\"9\n" +
+	"\x1dRemoveSupplierProductResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xea\x01\n" +
+	"\x11PurchaseOrderLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12)\n" +
+	"\x10quantity_ordered\x18\x04 \x01(\x05R\x0fquantityOrdered\x12+\n" +
+	"\x11quantity_received\x18\x05 \x01(\x05R\x10quantityReceived\x12\x1b\n" +
+	"\tunit_cost\x18\x06 \x01(\x01R\bunitCost\x12\x12\n" +
+	"\x04unit\x18\a \x01(\tR\x04unit\"\xd1\x03\n" +
+	"\rPurchaseOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vsupplier_id\x18\x02 \x01(\tR\n" +
+	"supplierId\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\tR\vwarehouseId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12;\n" +
+	"\vexpected_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expectedAt\x12;\n" +
+	"\vreceived_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x122\n" +
+	"\x05lines\x18\v \x03(\v2\x1c.inventory.PurchaseOrderLineR\x05lines\"\x92\x01\n" +
+	"\x17CreatePurchaseOrderLine\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\tunit_cost\x18\x03 \x01(\x01R\bunitCost\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\"\x89\x02\n" +
+	"\x1aCreatePurchaseOrderRequest\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\tR\n" +
+	"supplierId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\tR\vwarehouseId\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\x12;\n" +
+	"\vexpected_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"expectedAt\x128\n" +
+	"\x05lines\x18\x06 \x03(\v2\".inventory.CreatePurchaseOrderLineR\x05lines\")\n" +
+	"\x17GetPurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc3\x01\n" +
+	"\x19ListPurchaseOrdersRequest\x12\x1f\n" +
+	"\vsupplier_id\x18\x01 \x01(\tR\n" +
+	"supplierId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12C\n" +
+	"\x0fexpected_before\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0eexpectedBefore\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"u\n" +
+	"\x1aListPurchaseOrdersResponse\x12A\n" +
+	"\x0fpurchase_orders\x18\x01 \x03(\v2\x18.inventory.PurchaseOrderR\x0epurchaseOrders\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"U\n" +
+	"\vReceiptLine\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"[\n" +
+	"\x1bReceivePurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12,\n" +
+	"\x05lines\x18\x02 \x03(\v2\x16.inventory.ReceiptLineR\x05lines\",\n" +
+	"\x1aCancelPurchaseOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x8e\x02\n" +
+	"\x17BackInStockSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\tR\tvariantId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12;\n" +
+	"\vnotified_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"notifiedAt\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8a\x01\n" +
+	"\x1bSubscribeBackInStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\"\xaa\x01\n" +
+	"#ListBackInStockSubscriptionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12!\n" +
+	"\fpending_only\x18\x03 \x01(\bR\vpendingOnly\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x86\x01\n" +
+	"$ListBackInStockSubscriptionsResponse\x12H\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\".inventory.BackInStockSubscriptionR\rsubscriptions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"O\n" +
+	"$DeleteBackInStockSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"A\n" +
+	"%DeleteBackInStockSubscriptionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc4\x04\n" +
+	"\fInventoryLot\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\tR\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x04 \x01(\tR\tlotNumber\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12+\n" +
+	"\x11reserved_quantity\x18\a \x01(\x05R\x10reservedQuantity\x12-\n" +
+	"\x12available_quantity\x18\b \x01(\x05R\x11availableQuantity\x12\x18\n" +
+	"\aexpired\x18\t \x01(\bR\aexpired\x12;\n" +
+	"\vreceived_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\x12@\n" +
+	"\x0ewritten_off_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\fwrittenOffAt\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\r \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x0e \x01(\tR\x03sku\"\x82\x02\n" +
+	"\x11ReceiveLotRequest\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\tR\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x03 \x01(\tR\tlotNumber\x129\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05notes\x18\x06 \x01(\tR\x05notes\x12\x12\n" +
+	"\x04unit\x18\a \x01(\tR\x04unit\"\x85\x01\n" +
+	"\x0fListLotsRequest\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\tR\vwarehouseId\x12#\n" +
+	"\rinclude_empty\x18\x03 \x01(\bR\fincludeEmpty\"?\n" +
+	"\x10ListLotsResponse\x12+\n" +
+	"\x04lots\x18\x01 \x03(\v2\x17.inventory.InventoryLotR\x04lots\"\x87\x01\n" +
+	"\x17ListExpiringLotsRequest\x12\x1f\n" +
+	"\vwithin_days\x18\x01 \x01(\x05R\n" +
+	"withinDays\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\tR\vwarehouseId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"]\n" +
+	"\x18ListExpiringLotsResponse\x12+\n" +
+	"\x04lots\x18\x01 \x03(\v2\x17.inventory.InventoryLotR\x04lots\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\xb6\x01\n" +
+	"\rInventoryUnit\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06factor\x18\x04 \x01(\x05R\x06factor\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x85\x01\n" +
+	"\x17SetInventoryUnitRequest\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06factor\x18\x04 \x01(\x05R\x06factor\"G\n" +
+	"\x19ListInventoryUnitsRequest\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\"L\n" +
+	"\x1aListInventoryUnitsResponse\x12.\n" +
+	"\x05units\x18\x01 \x03(\v2\x18.inventory.InventoryUnitR\x05units\"\\\n" +
+	"\x1aDeleteInventoryUnitRequest\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x1d\n" +
+	"\x1bDeleteInventoryUnitResponse\"\xba\x02\n" +
+	"\fWarehouseBin\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\tR\vwarehouseId\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04zone\x18\x04 \x01(\tR\x04zone\x12\x14\n" +
+	"\x05aisle\x18\x05 \x01(\tR\x05aisle\x12\x14\n" +
+	"\x05shelf\x18\x06 \x01(\tR\x05shelf\x12\x10\n" +
+	"\x03bin\x18\a \x01(\tR\x03bin\x12\x1b\n" +
+	"\tis_active\x18\b \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaa\x01\n" +
+	"\x16SetWarehouseBinRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x12\n" +
+	"\x04zone\x18\x02 \x01(\tR\x04zone\x12\x14\n" +
+	"\x05aisle\x18\x03 \x01(\tR\x05aisle\x12\x14\n" +
+	"\x05shelf\x18\x04 \x01(\tR\x05shelf\x12\x10\n" +
+	"\x03bin\x18\x05 \x01(\tR\x03bin\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\"Q\n" +
+	"\x18ListWarehouseBinsRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x12\n" +
+	"\x04zone\x18\x02 \x01(\tR\x04zone\"H\n" +
+	"\x19ListWarehouseBinsResponse\x12+\n" +
+	"\x04bins\x18\x01 \x03(\v2\x17.inventory.WarehouseBinR\x04bins\"+\n" +
+	"\x19DeleteWarehouseBinRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1c\n" +
+	"\x1aDeleteWarehouseBinResponse\"\xca\x01\n" +
+	"\bBinStock\x12)\n" +
+	"\x03bin\x18\x01 \x01(\v2\x17.inventory.WarehouseBinR\x03bin\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x87\x01\n" +
+	"\x12SetBinStockRequest\x12\x15\n" +
+	"\x06bin_id\x18\x01 \x01(\tR\x05binId\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\"d\n" +
+	"\x13ListBinStockRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\"A\n" +
+	"\x14ListBinStockResponse\x12)\n" +
+	"\x05stock\x18\x01 \x03(\v2\x13.inventory.BinStockR\x05stock\"V\n" +
+	"\fPickListLine\x12*\n" +
+	"\x11inventory_item_id\x18\x01 \x01(\tR\x0finventoryItemId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"k\n" +
+	"\x17GeneratePickListRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12-\n" +
+	"\x05lines\x18\x02 \x03(\v2\x17.inventory.PickListLineR\x05lines\"\xa7\x01\n" +
+	"\x04Pick\x12\x1a\n" +
+	"\bsequence\x18\x01 \x01(\x05R\bsequence\x12)\n" +
+	"\x03bin\x18\x02 \x01(\v2\x17.inventory.WarehouseBinR\x03bin\x12*\n" +
+	"\x11inventory_item_id\x18\x03 \x01(\tR\x0finventoryItemId\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\"\x8b\x01\n" +
+	"\bPickList\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12%\n" +
+	"\x05picks\x18\x02 \x03(\v2\x0f.inventory.PickR\x05picks\x125\n" +
+	"\tshortages\x18\x03 \x03(\v2\x17.inventory.PickListLineR\tshortages\"\xf7\x02\n" +
+	"\x0fFulfillmentWave\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\tR\vwarehouseId\x12\x18\n" +
+	"\acarrier\x18\x03 \x01(\tR\acarrier\x127\n" +
+	"\tcutoff_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bcutoffAt\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x16\n" +
+	"\x06orders\x18\x06 \x01(\x05R\x06orders\x124\n" +
+	"\x05lines\x18\a \x03(\v2\x1e.inventory.FulfillmentWaveLineR\x05lines\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\"\x90\x02\n" +
+	"\x13FulfillmentWaveLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0ereservation_id\x18\x02 \x01(\tR\rreservationId\x12'\n" +
+	"\x0forder_reference\x18\x03 \x01(\tR\x0eorderReference\x12*\n" +
+	"\x11inventory_item_id\x18\x04 \x01(\tR\x0finventoryItemId\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12'\n" +
+	"\x0fpicked_quantity\x18\a \x01(\x05R\x0epickedQuantity\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"\xde\x01\n" +
+	"\x1cCreateFulfillmentWaveRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x18\n" +
+	"\acarrier\x18\x02 \x01(\tR\acarrier\x127\n" +
+	"\tcutoff_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bcutoffAt\x12)\n" +
+	"\x10order_references\x18\x04 \x03(\tR\x0forderReferences\x12\x1d\n" +
+	"\n" +
+	"max_orders\x18\x05 \x01(\x05R\tmaxOrders\"+\n" +
+	"\x19GetFulfillmentWaveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x82\x01\n" +
+	"\x1bListFulfillmentWavesRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"f\n" +
+	"\x1cListFulfillmentWavesResponse\x120\n" +
+	"\x05waves\x18\x01 \x03(\v2\x1a.inventory.FulfillmentWaveR\x05waves\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"-\n" +
+	"\x1bGenerateWavePickListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x0ePickedWaveLine\x12\x17\n" +
+	"\aline_id\x18\x01 \x01(\tR\x06lineId\x12'\n" +
+	"\x0fpicked_quantity\x18\x02 \x01(\x05R\x0epickedQuantity\"a\n" +
+	"\x1eCompleteFulfillmentWaveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\x05lines\x18\x02 \x03(\v2\x19.inventory.PickedWaveLineR\x05lines\".\n" +
+	"\x1cCancelFulfillmentWaveRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xb8\x05\n" +
+	"\x06Refund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\x12+\n" +
+	"\x11payment_reference\x18\x03 \x01(\tR\x10paymentReference\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04type\x18\x05 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x16\n" +
+	"\x06status\x18\t \x01(\tR\x06status\x12!\n" +
+	"\frequested_by\x18\n" +
+	" \x01(\tR\vrequestedBy\x12\x1f\n" +
+	"\vreviewed_by\x18\v \x01(\tR\n" +
+	"reviewedBy\x12;\n" +
+	"\vreviewed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12-\n" +
+	"\x12provider_reference\x18\r \x01(\tR\x11providerReference\x12%\n" +
+	"\x0efailure_reason\x18\x0e \x01(\tR\rfailureReason\x12=\n" +
+	"\fprocessed_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\x12+\n" +
+	"\x05lines\x18\x10 \x03(\v2\x15.inventory.RefundLineR\x05lines\x129\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb3\x01\n" +
+	"\n" +
+	"RefundLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x18\n" +
+	"\arestock\x18\x05 \x01(\bR\arestock\x12!\n" +
+	"\fwarehouse_id\x18\x06 \x01(\tR\vwarehouseId\"\xb5\x02\n" +
+	"\x14RequestRefundRequest\x12'\n" +
+	"\x0forder_reference\x18\x01 \x01(\tR\x0eorderReference\x12+\n" +
+	"\x11payment_reference\x18\x02 \x01(\tR\x10paymentReference\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12+\n" +
+	"\x05lines\x18\b \x03(\v2\x15.inventory.RefundLineR\x05lines\x12!\n" +
+	"\frequested_by\x18\t \x01(\tR\vrequestedBy\"\"\n" +
+	"\x10GetRefundRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x7f\n" +
+	"\x12ListRefundsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"X\n" +
+	"\x13ListRefundsResponse\x12+\n" +
+	"\arefunds\x18\x01 \x03(\v2\x11.inventory.RefundR\arefunds\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"G\n" +
+	"\x14ApproveRefundRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vreviewed_by\x18\x02 \x01(\tR\n" +
+	"reviewedBy\"^\n" +
+	"\x13RejectRefundRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vreviewed_by\x18\x02 \x01(\tR\n" +
+	"reviewedBy\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x9f\x01\n" +
+	"\x19RecordRefundResultRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\bR\tsucceeded\x12-\n" +
+	"\x12provider_reference\x18\x03 \x01(\tR\x11providerReference\x12%\n" +
+	"\x0efailure_reason\x18\x04 \x01(\tR\rfailureReason\"\xb0\x02\n" +
+	"\vRefundEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\trefund_id\x18\x02 \x01(\tR\brefundId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x0forder_reference\x18\x04 \x01(\tR\x0eorderReference\x12+\n" +
+	"\x11payment_reference\x18\x05 \x01(\tR\x10paymentReference\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"J\n" +
+	"\x17ListRefundEventsRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"J\n" +
+	"\x18ListRefundEventsResponse\x12.\n" +
+	"\x06events\x18\x01 \x03(\v2\x16.inventory.RefundEventR\x06events\"\xf6\x04\n" +
+	"\n" +
+	"FraudCheck\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x05 \x01(\tR\tipAddress\x12'\n" +
+	"\x0fbilling_country\x18\x06 \x01(\tR\x0ebillingCountry\x12)\n" +
+	"\x10shipping_country\x18\a \x01(\tR\x0fshippingCountry\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05score\x18\n" +
+	" \x01(\x05R\x05score\x12\x1a\n" +
+	"\bdecision\x18\v \x01(\tR\bdecision\x120\n" +
+	"\asignals\x18\f \x03(\v2\x16.inventory.FraudSignalR\asignals\x12\x1f\n" +
+	"\vreviewed_by\x18\r \x01(\tR\n" +
+	"reviewedBy\x12;\n" +
+	"\vreviewed_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12!\n" +
+	"\freview_notes\x18\x0f \x01(\tR\vreviewNotes\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"Q\n" +
+	"\vFraudSignal\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06points\x18\x02 \x01(\x05R\x06points\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x93\x02\n" +
+	"\x12ScreenOrderRequest\x12'\n" +
+	"\x0forder_reference\x18\x01 \x01(\tR\x0eorderReference\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\x12'\n" +
+	"\x0fbilling_country\x18\x05 \x01(\tR\x0ebillingCountry\x12)\n" +
+	"\x10shipping_country\x18\x06 \x01(\tR\x0fshippingCountry\x12\x16\n" +
+	"\x06amount\x18\a \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\"&\n" +
+	"\x14GetFraudCheckRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x87\x01\n" +
+	"\x16ListFraudChecksRequest\x12\x1a\n" +
+	"\bdecision\x18\x01 \x01(\tR\bdecision\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"^\n" +
+	"\x17ListFraudChecksResponse\x12-\n" +
+	"\x06checks\x18\x01 \x03(\v2\x15.inventory.FraudCheckR\x06checks\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"z\n" +
+	"\x17ReviewFraudCheckRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aapprove\x18\x02 \x01(\bR\aapprove\x12\x1f\n" +
+	"\vreviewed_by\x18\x03 \x01(\tR\n" +
+	"reviewedBy\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\"\xf9\x01\n" +
+	"\n" +
+	"FraudEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12$\n" +
+	"\x0efraud_check_id\x18\x02 \x01(\tR\ffraudCheckId\x12'\n" +
+	"\x0forder_reference\x18\x03 \x01(\tR\x0eorderReference\x12\x1a\n" +
+	"\bdecision\x18\x04 \x01(\tR\bdecision\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x05R\x05score\x12\x1f\n" +
+	"\vreviewed_by\x18\x06 \x01(\tR\n" +
+	"reviewedBy\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"I\n" +
+	"\x16ListFraudEventsRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"H\n" +
+	"\x17ListFraudEventsResponse\x12-\n" +
+	"\x06events\x18\x01 \x03(\v2\x15.inventory.FraudEventR\x06events\"V\n" +
+	"\fOpeningHours\x12\x18\n" +
+	"\aweekday\x18\x01 \x01(\x05R\aweekday\x12\x14\n" +
+	"\x05opens\x18\x02 \x01(\tR\x05opens\x12\x16\n" +
+	"\x06closes\x18\x03 \x01(\tR\x06closes\"\xa7\x03\n" +
+	"\vRetailStore\x122\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x14.inventory.WarehouseR\twarehouse\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12<\n" +
+	"\ropening_hours\x18\x06 \x03(\v2\x17.inventory.OpeningHoursR\fopeningHours\x12%\n" +
+	"\x0epickup_enabled\x18\a \x01(\bR\rpickupEnabled\x12\x1d\n" +
+	"\n" +
+	"hold_hours\x18\b \x01(\x05R\tholdHours\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xaa\x02\n" +
+	"\x15SetRetailStoreRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x1a\n" +
+	"\blatitude\x18\x02 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x03 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\btimezone\x18\x04 \x01(\tR\btimezone\x12\x14\n" +
+	"\x05phone\x18\x05 \x01(\tR\x05phone\x12<\n" +
+	"\ropening_hours\x18\x06 \x03(\v2\x17.inventory.OpeningHoursR\fopeningHours\x12%\n" +
+	"\x0epickup_enabled\x18\a \x01(\bR\rpickupEnabled\x12\x1d\n" +
+	"\n" +
+	"hold_hours\x18\b \x01(\x05R\tholdHours\"=\n" +
+	"\x18DeleteRetailStoreRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\"\x1b\n" +
+	"\x19DeleteRetailStoreResponse\"\x19\n" +
+	"\x17ListRetailStoresRequest\"J\n" +
+	"\x18ListRetailStoresResponse\x12.\n" +
+	"\x06stores\x18\x01 \x03(\v2\x16.inventory.RetailStoreR\x06stores\"\xc1\x01\n" +
+	"\x17FindNearbyStoresRequest\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1b\n" +
+	"\tradius_km\x18\x03 \x01(\x01R\bradiusKm\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x04 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\xc1\x01\n" +
+	"\vNearbyStore\x12,\n" +
+	"\x05store\x18\x01 \x01(\v2\x16.inventory.RetailStoreR\x05store\x12\x1f\n" +
+	"\vdistance_km\x18\x02 \x01(\x01R\n" +
+	"distanceKm\x12\x19\n" +
+	"\bopen_now\x18\x03 \x01(\bR\aopenNow\x12-\n" +
+	"\x12available_quantity\x18\x04 \x01(\x05R\x11availableQuantity\x12\x19\n" +
+	"\bin_stock\x18\x05 \x01(\bR\ainStock\"J\n" +
+	"\x18FindNearbyStoresResponse\x12.\n" +
+	"\x06stores\x18\x01 \x03(\v2\x16.inventory.NearbyStoreR\x06stores\"\xac\x01\n" +
+	"\x15ReserveInStoreRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12!\n" +
+	"\freference_id\x18\x05 \x01(\tR\vreferenceId\"\x89\x01\n" +
+	"\x16ReserveInStoreResponse\x12A\n" +
+	"\vreservation\x18\x01 \x01(\v2\x1f.inventory.InventoryReservationR\vreservation\x12,\n" +
+	"\x05store\x18\x02 \x01(\v2\x16.inventory.RetailStoreR\x05store\"\x87\x02\n" +
+	"\n" +
+	"PickupLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11inventory_item_id\x18\x02 \x01(\tR\x0finventoryItemId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12%\n" +
+	"\x0ereservation_id\x18\x06 \x01(\tR\rreservationId\x12I\n" +
+	"\x0fpersonalization\x18\a \x03(\v2\x1f.inventory.PersonalizationValueR\x0fpersonalization\"\x93\x01\n" +
+	"\x14PersonalizationValue\x12\x1b\n" +
+	"\toption_id\x18\x01 \x01(\tR\boptionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x19\n" +
+	"\bfile_url\x18\x04 \x01(\tR\afileUrl\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\"\xe8\x04\n" +
+	"\vPickupOrder\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\tR\vwarehouseId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"collect_by\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcollectBy\x125\n" +
+	"\bready_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\areadyAt\x12=\n" +
+	"\fcollected_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcollectedAt\x12=\n" +
+	"\fcancelled_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x12#\n" +
+	"\rcancel_reason\x18\v \x01(\tR\fcancelReason\x12+\n" +
+	"\x05lines\x18\f \x03(\v2\x15.inventory.PickupLineR\x05lines\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa6\x01\n" +
+	"\n" +
+	"PickupItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04unit\x18\x03 \x01(\tR\x04unit\x12I\n" +
+	"\x0fpersonalization\x18\x04 \x03(\v2\x1f.inventory.PersonalizationValueR\x0fpersonalization\"\xc2\x01\n" +
+	"\x18CreatePickupOrderRequest\x12'\n" +
+	"\x0forder_reference\x18\x01 \x01(\tR\x0eorderReference\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\tR\vwarehouseId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12+\n" +
+	"\x05items\x18\x05 \x03(\v2\x15.inventory.PickupItemR\x05items\"@\n" +
+	"\x15GetPickupOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xc0\x01\n" +
+	"\x17ListPickupOrdersRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\tR\vwarehouseId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x0forder_reference\x18\x04 \x01(\tR\x0eorderReference\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"`\n" +
+	"\x18ListPickupOrdersResponse\x12.\n" +
+	"\x06orders\x18\x01 \x03(\v2\x16.inventory.PickupOrderR\x06orders\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"$\n" +
+	"\x12PickupOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"[\n" +
+	"\x18CancelPickupOrderRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason2\x84C\n" +
 	"\x10InventoryService\x12^\n" +
 	"\x13CreateInventoryItem\x12%.inventory.CreateInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12X\n" +
 	"\x10GetInventoryItem\x12\".inventory.GetInventoryItemRequest\x1a .inventory.InventoryItemResponse\x12^\n" +
@@ -2935,12 +15731,92 @@ const file_proto_inventory_proto_rawDesc = "" +
 	"\x0eListWarehouses\x12 .inventory.ListWarehousesRequest\x1a!.inventory.ListWarehousesResponse\x12h\n" +
 	"\x16AddInventoryToLocation\x12(.inventory.AddInventoryToLocationRequest\x1a$.inventory.InventoryLocationResponse\x12r\n" +
 	"\x1bRemoveInventoryFromLocation\x12-.inventory.RemoveInventoryFromLocationRequest\x1a$.inventory.InventoryLocationResponse\x12m\n" +
-	"\x16GetInventoryByLocation\x12(.inventory.GetInventoryByLocationRequest\x1a).inventory.ListInventoryLocationsResponse\x12V\n" +
+	"\x16GetInventoryByLocation\x12(.inventory.GetInventoryByLocationRequest\x1a).inventory.ListInventoryLocationsResponse\x12Z\n" +
+	"\x0fSetStockBuffers\x12!.inventory.SetStockBuffersRequest\x1a$.inventory.InventoryLocationResponse\x12V\n" +
 	"\x10ReserveInventory\x12\".inventory.ReserveInventoryRequest\x1a\x1e.inventory.ReservationResponse\x12Z\n" +
 	"\x12ConfirmReservation\x12$.inventory.ConfirmReservationRequest\x1a\x1e.inventory.ReservationResponse\x12X\n" +
 	"\x11CancelReservation\x12#.inventory.CancelReservationRequest\x1a\x1e.inventory.ReservationResponse\x12t\n" +
-	"\x1aCheckInventoryAvailability\x12,.inventory.CheckInventoryAvailabilityRequest\x1a(.inventory.InventoryAvailabilityResponse\x12d\n" +
-	"\x13BulkUpdateInventory\x12%.inventory.BulkUpdateInventoryRequest\x1a&.inventory.BulkUpdateInventoryResponseBGZEgithub.com/louai60/e-commerce_project/backend/inventory-service/protob\x06proto3"
+	"\x1aCheckInventoryAvailability\x12,.inventory.CheckInventoryAvailabilityRequest\x1a(.inventory.InventoryAvailabilityResponse\x12j\n" +
+	"\x15CheckAvailabilityBulk\x12'.inventory.CheckAvailabilityBulkRequest\x1a(.inventory.CheckAvailabilityBulkResponse\x12_\n" +
+	"\x15GetAvailabilityPolicy\x12'.inventory.GetAvailabilityPolicyRequest\x1a\x1d.inventory.AvailabilityPolicy\x12_\n" +
+	"\x15SetAvailabilityPolicy\x12'.inventory.SetAvailabilityPolicyRequest\x1a\x1d.inventory.AvailabilityPolicy\x12s\n" +
+	"\x18DeleteAvailabilityPolicy\x12*.inventory.DeleteAvailabilityPolicyRequest\x1a+.inventory.DeleteAvailabilityPolicyResponse\x12d\n" +
+	"\x13BulkUpdateInventory\x12%.inventory.BulkUpdateInventoryRequest\x1a&.inventory.BulkUpdateInventoryResponse\x12Q\n" +
+	"\x0eWatchInventory\x12 .inventory.WatchInventoryRequest\x1a\x1b.inventory.StockChangeEvent0\x01\x12U\n" +
+	"\x0fGetStockHistory\x12!.inventory.GetStockHistoryRequest\x1a\x1f.inventory.StockHistoryResponse\x12X\n" +
+	"\x0fListStockAlerts\x12!.inventory.ListStockAlertsRequest\x1a\".inventory.ListStockAlertsResponse\x12j\n" +
+	"\x15ListInventoryActivity\x12'.inventory.ListInventoryActivityRequest\x1a(.inventory.ListInventoryActivityResponse\x12L\n" +
+	"\vGetForecast\x12\x1d.inventory.GetForecastRequest\x1a\x1e.inventory.GetForecastResponse\x12m\n" +
+	"\x16ListReorderSuggestions\x12(.inventory.ListReorderSuggestionsRequest\x1a).inventory.ListReorderSuggestionsResponse\x12R\n" +
+	"\x0eGetDiagnostics\x12 .inventory.GetDiagnosticsRequest\x1a\x1e.inventory.DiagnosticsResponse\x12g\n" +
+	"\x14CreateIntegrationKey\x12&.inventory.CreateIntegrationKeyRequest\x1a'.inventory.CreateIntegrationKeyResponse\x12d\n" +
+	"\x13ListIntegrationKeys\x12%.inventory.ListIntegrationKeysRequest\x1a&.inventory.ListIntegrationKeysResponse\x12a\n" +
+	"\x14RevokeIntegrationKey\x12&.inventory.RevokeIntegrationKeyRequest\x1a!.inventory.IntegrationKeyResponse\x12e\n" +
+	"\x16SetIntegrationKeyQuota\x12(.inventory.SetIntegrationKeyQuotaRequest\x1a!.inventory.IntegrationKeyResponse\x12Y\n" +
+	"\x13GetIntegrationQuota\x12%.inventory.GetIntegrationQuotaRequest\x1a\x1b.inventory.IntegrationQuota\x12j\n" +
+	"\x15PushFulfillmentEvents\x12'.inventory.PushFulfillmentEventsRequest\x1a(.inventory.PushFulfillmentEventsResponse\x12j\n" +
+	"\x15ListOrderStatusEvents\x12'.inventory.ListOrderStatusEventsRequest\x1a(.inventory.ListOrderStatusEventsResponse\x12G\n" +
+	"\x0eCreateShipment\x12 .inventory.CreateShipmentRequest\x1a\x13.inventory.Shipment\x12R\n" +
+	"\rListShipments\x12\x1f.inventory.ListShipmentsRequest\x1a .inventory.ListShipmentsResponse\x12[\n" +
+	"\x11GetShipmentStatus\x12#.inventory.GetShipmentStatusRequest\x1a!.inventory.ShipmentStatusResponse\x12g\n" +
+	"\x14ReceiveCarrierEvents\x12&.inventory.ReceiveCarrierEventsRequest\x1a'.inventory.ReceiveCarrierEventsResponse\x12G\n" +
+	"\x0eCreateSupplier\x12 .inventory.CreateSupplierRequest\x1a\x13.inventory.Supplier\x12G\n" +
+	"\x0eUpdateSupplier\x12 .inventory.UpdateSupplierRequest\x1a\x13.inventory.Supplier\x12A\n" +
+	"\vGetSupplier\x12\x1d.inventory.GetSupplierRequest\x1a\x13.inventory.Supplier\x12R\n" +
+	"\rListSuppliers\x12\x1f.inventory.ListSuppliersRequest\x1a .inventory.ListSuppliersResponse\x12V\n" +
+	"\x12SetSupplierProduct\x12$.inventory.SetSupplierProductRequest\x1a\x1a.inventory.SupplierProduct\x12j\n" +
+	"\x15RemoveSupplierProduct\x12'.inventory.RemoveSupplierProductRequest\x1a(.inventory.RemoveSupplierProductResponse\x12V\n" +
+	"\x13CreatePurchaseOrder\x12%.inventory.CreatePurchaseOrderRequest\x1a\x18.inventory.PurchaseOrder\x12P\n" +
+	"\x10GetPurchaseOrder\x12\".inventory.GetPurchaseOrderRequest\x1a\x18.inventory.PurchaseOrder\x12a\n" +
+	"\x12ListPurchaseOrders\x12$.inventory.ListPurchaseOrdersRequest\x1a%.inventory.ListPurchaseOrdersResponse\x12X\n" +
+	"\x14ReceivePurchaseOrder\x12&.inventory.ReceivePurchaseOrderRequest\x1a\x18.inventory.PurchaseOrder\x12V\n" +
+	"\x13CancelPurchaseOrder\x12%.inventory.CancelPurchaseOrderRequest\x1a\x18.inventory.PurchaseOrder\x12b\n" +
+	"\x14SubscribeBackInStock\x12&.inventory.SubscribeBackInStockRequest\x1a\".inventory.BackInStockSubscription\x12\x7f\n" +
+	"\x1cListBackInStockSubscriptions\x12..inventory.ListBackInStockSubscriptionsRequest\x1a/.inventory.ListBackInStockSubscriptionsResponse\x12\x82\x01\n" +
+	"\x1dDeleteBackInStockSubscription\x12/.inventory.DeleteBackInStockSubscriptionRequest\x1a0.inventory.DeleteBackInStockSubscriptionResponse\x12C\n" +
+	"\n" +
+	"ReceiveLot\x12\x1c.inventory.ReceiveLotRequest\x1a\x17.inventory.InventoryLot\x12C\n" +
+	"\bListLots\x12\x1a.inventory.ListLotsRequest\x1a\x1b.inventory.ListLotsResponse\x12[\n" +
+	"\x10ListExpiringLots\x12\".inventory.ListExpiringLotsRequest\x1a#.inventory.ListExpiringLotsResponse\x12P\n" +
+	"\x10SetInventoryUnit\x12\".inventory.SetInventoryUnitRequest\x1a\x18.inventory.InventoryUnit\x12a\n" +
+	"\x12ListInventoryUnits\x12$.inventory.ListInventoryUnitsRequest\x1a%.inventory.ListInventoryUnitsResponse\x12d\n" +
+	"\x13DeleteInventoryUnit\x12%.inventory.DeleteInventoryUnitRequest\x1a&.inventory.DeleteInventoryUnitResponse\x12M\n" +
+	"\x0fSetWarehouseBin\x12!.inventory.SetWarehouseBinRequest\x1a\x17.inventory.WarehouseBin\x12^\n" +
+	"\x11ListWarehouseBins\x12#.inventory.ListWarehouseBinsRequest\x1a$.inventory.ListWarehouseBinsResponse\x12a\n" +
+	"\x12DeleteWarehouseBin\x12$.inventory.DeleteWarehouseBinRequest\x1a%.inventory.DeleteWarehouseBinResponse\x12A\n" +
+	"\vSetBinStock\x12\x1d.inventory.SetBinStockRequest\x1a\x13.inventory.BinStock\x12O\n" +
+	"\fListBinStock\x12\x1e.inventory.ListBinStockRequest\x1a\x1f.inventory.ListBinStockResponse\x12K\n" +
+	"\x10GeneratePickList\x12\".inventory.GeneratePickListRequest\x1a\x13.inventory.PickList\x12\\\n" +
+	"\x15CreateFulfillmentWave\x12'.inventory.CreateFulfillmentWaveRequest\x1a\x1a.inventory.FulfillmentWave\x12V\n" +
+	"\x12GetFulfillmentWave\x12$.inventory.GetFulfillmentWaveRequest\x1a\x1a.inventory.FulfillmentWave\x12g\n" +
+	"\x14ListFulfillmentWaves\x12&.inventory.ListFulfillmentWavesRequest\x1a'.inventory.ListFulfillmentWavesResponse\x12S\n" +
+	"\x14GenerateWavePickList\x12&.inventory.GenerateWavePickListRequest\x1a\x13.inventory.PickList\x12`\n" +
+	"\x17CompleteFulfillmentWave\x12).inventory.CompleteFulfillmentWaveRequest\x1a\x1a.inventory.FulfillmentWave\x12\\\n" +
+	"\x15CancelFulfillmentWave\x12'.inventory.CancelFulfillmentWaveRequest\x1a\x1a.inventory.FulfillmentWave\x12C\n" +
+	"\rRequestRefund\x12\x1f.inventory.RequestRefundRequest\x1a\x11.inventory.Refund\x12;\n" +
+	"\tGetRefund\x12\x1b.inventory.GetRefundRequest\x1a\x11.inventory.Refund\x12L\n" +
+	"\vListRefunds\x12\x1d.inventory.ListRefundsRequest\x1a\x1e.inventory.ListRefundsResponse\x12C\n" +
+	"\rApproveRefund\x12\x1f.inventory.ApproveRefundRequest\x1a\x11.inventory.Refund\x12A\n" +
+	"\fRejectRefund\x12\x1e.inventory.RejectRefundRequest\x1a\x11.inventory.Refund\x12M\n" +
+	"\x12RecordRefundResult\x12$.inventory.RecordRefundResultRequest\x1a\x11.inventory.Refund\x12[\n" +
+	"\x10ListRefundEvents\x12\".inventory.ListRefundEventsRequest\x1a#.inventory.ListRefundEventsResponse\x12C\n" +
+	"\vScreenOrder\x12\x1d.inventory.ScreenOrderRequest\x1a\x15.inventory.FraudCheck\x12G\n" +
+	"\rGetFraudCheck\x12\x1f.inventory.GetFraudCheckRequest\x1a\x15.inventory.FraudCheck\x12X\n" +
+	"\x0fListFraudChecks\x12!.inventory.ListFraudChecksRequest\x1a\".inventory.ListFraudChecksResponse\x12M\n" +
+	"\x10ReviewFraudCheck\x12\".inventory.ReviewFraudCheckRequest\x1a\x15.inventory.FraudCheck\x12X\n" +
+	"\x0fListFraudEvents\x12!.inventory.ListFraudEventsRequest\x1a\".inventory.ListFraudEventsResponse\x12J\n" +
+	"\x0eSetRetailStore\x12 .inventory.SetRetailStoreRequest\x1a\x16.inventory.RetailStore\x12^\n" +
+	"\x11DeleteRetailStore\x12#.inventory.DeleteRetailStoreRequest\x1a$.inventory.DeleteRetailStoreResponse\x12[\n" +
+	"\x10ListRetailStores\x12\".inventory.ListRetailStoresRequest\x1a#.inventory.ListRetailStoresResponse\x12[\n" +
+	"\x10FindNearbyStores\x12\".inventory.FindNearbyStoresRequest\x1a#.inventory.FindNearbyStoresResponse\x12U\n" +
+	"\x0eReserveInStore\x12 .inventory.ReserveInStoreRequest\x1a!.inventory.ReserveInStoreResponse\x12P\n" +
+	"\x11CreatePickupOrder\x12#.inventory.CreatePickupOrderRequest\x1a\x16.inventory.PickupOrder\x12J\n" +
+	"\x0eGetPickupOrder\x12 .inventory.GetPickupOrderRequest\x1a\x16.inventory.PickupOrder\x12[\n" +
+	"\x10ListPickupOrders\x12\".inventory.ListPickupOrdersRequest\x1a#.inventory.ListPickupOrdersResponse\x12K\n" +
+	"\x12StartPickupPicking\x12\x1d.inventory.PickupOrderRequest\x1a\x16.inventory.PickupOrder\x12H\n" +
+	"\x0fMarkPickupReady\x12\x1d.inventory.PickupOrderRequest\x1a\x16.inventory.PickupOrder\x12K\n" +
+	"\x12CollectPickupOrder\x12\x1d.inventory.PickupOrderRequest\x1a\x16.inventory.PickupOrder\x12P\n" +
+	"\x11CancelPickupOrder\x12#.inventory.CancelPickupOrderRequest\x1a\x16.inventory.PickupOrderBGZEgithub.com/louai60/e-commerce_project/backend/inventory-service/protob\x06proto3"
 
 var (
 	file_proto_inventory_proto_rawDescOnce sync.Once
@@ -2954,140 +15830,612 @@ func file_proto_inventory_proto_rawDescGZIP() []byte {
 	return file_proto_inventory_proto_rawDescData
 }
 
-var file_proto_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
+var file_proto_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 203)
 var file_proto_inventory_proto_goTypes = []any{
-	(*InventoryItem)(nil),                      // 0: inventory.InventoryItem
-	(*Warehouse)(nil),                          // 1: inventory.Warehouse
-	(*InventoryLocation)(nil),                  // 2: inventory.InventoryLocation
-	(*InventoryTransaction)(nil),               // 3: inventory.InventoryTransaction
-	(*InventoryReservation)(nil),               // 4: inventory.InventoryReservation
-	(*CreateInventoryItemRequest)(nil),         // 5: inventory.CreateInventoryItemRequest
-	(*WarehouseAllocation)(nil),                // 6: inventory.WarehouseAllocation
-	(*GetInventoryItemRequest)(nil),            // 7: inventory.GetInventoryItemRequest
-	(*UpdateInventoryItemRequest)(nil),         // 8: inventory.UpdateInventoryItemRequest
-	(*ListInventoryItemsRequest)(nil),          // 9: inventory.ListInventoryItemsRequest
-	(*InventoryItemResponse)(nil),              // 10: inventory.InventoryItemResponse
-	(*ListInventoryItemsResponse)(nil),         // 11: inventory.ListInventoryItemsResponse
-	(*CreateWarehouseRequest)(nil),             // 12: inventory.CreateWarehouseRequest
-	(*GetWarehouseRequest)(nil),                // 13: inventory.GetWarehouseRequest
-	(*UpdateWarehouseRequest)(nil),             // 14: inventory.UpdateWarehouseRequest
-	(*ListWarehousesRequest)(nil),              // 15: inventory.ListWarehousesRequest
-	(*WarehouseResponse)(nil),                  // 16: inventory.WarehouseResponse
-	(*ListWarehousesResponse)(nil),             // 17: inventory.ListWarehousesResponse
-	(*AddInventoryToLocationRequest)(nil),      // 18: inventory.AddInventoryToLocationRequest
-	(*RemoveInventoryFromLocationRequest)(nil), // 19: inventory.RemoveInventoryFromLocationRequest
-	(*GetInventoryByLocationRequest)(nil),      // 20: inventory.GetInventoryByLocationRequest
-	(*InventoryLocationResponse)(nil),          // 21: inventory.InventoryLocationResponse
-	(*ListInventoryLocationsResponse)(nil),     // 22: inventory.ListInventoryLocationsResponse
-	(*ReserveInventoryRequest)(nil),            // 23: inventory.ReserveInventoryRequest
-	(*ReservationItem)(nil),                    // 24: inventory.ReservationItem
-	(*ConfirmReservationRequest)(nil),          // 25: inventory.ConfirmReservationRequest
-	(*CancelReservationRequest)(nil),           // 26: inventory.CancelReservationRequest
-	(*ReservationResponse)(nil),                // 27: inventory.ReservationResponse
-	(*CheckInventoryAvailabilityRequest)(nil),  // 28: inventory.CheckInventoryAvailabilityRequest
-	(*AvailabilityCheckItem)(nil),              // 29: inventory.AvailabilityCheckItem
-	(*InventoryAvailabilityResponse)(nil),      // 30: inventory.InventoryAvailabilityResponse
-	(*ItemAvailability)(nil),                   // 31: inventory.ItemAvailability
-	(*BulkUpdateInventoryRequest)(nil),         // 32: inventory.BulkUpdateInventoryRequest
-	(*BulkUpdateItem)(nil),                     // 33: inventory.BulkUpdateItem
-	(*BulkUpdateInventoryResponse)(nil),        // 34: inventory.BulkUpdateInventoryResponse
-	(*BulkUpdateResult)(nil),                   // 35: inventory.BulkUpdateResult
-	(*wrapperspb.StringValue)(nil),             // 36: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),              // 37: google.protobuf.Timestamp
-	(*wrapperspb.Int32Value)(nil),              // 38: google.protobuf.Int32Value
-	(*wrapperspb.BoolValue)(nil),               // 39: google.protobuf.BoolValue
+	(*InventoryItem)(nil),                         // 0: inventory.InventoryItem
+	(*Warehouse)(nil),                             // 1: inventory.Warehouse
+	(*InventoryLocation)(nil),                     // 2: inventory.InventoryLocation
+	(*InventoryTransaction)(nil),                  // 3: inventory.InventoryTransaction
+	(*InventoryReservation)(nil),                  // 4: inventory.InventoryReservation
+	(*LotAllocation)(nil),                         // 5: inventory.LotAllocation
+	(*CreateInventoryItemRequest)(nil),            // 6: inventory.CreateInventoryItemRequest
+	(*WarehouseAllocation)(nil),                   // 7: inventory.WarehouseAllocation
+	(*GetInventoryItemRequest)(nil),               // 8: inventory.GetInventoryItemRequest
+	(*UpdateInventoryItemRequest)(nil),            // 9: inventory.UpdateInventoryItemRequest
+	(*ListInventoryItemsRequest)(nil),             // 10: inventory.ListInventoryItemsRequest
+	(*InventoryItemResponse)(nil),                 // 11: inventory.InventoryItemResponse
+	(*ListInventoryItemsResponse)(nil),            // 12: inventory.ListInventoryItemsResponse
+	(*CreateWarehouseRequest)(nil),                // 13: inventory.CreateWarehouseRequest
+	(*GetWarehouseRequest)(nil),                   // 14: inventory.GetWarehouseRequest
+	(*UpdateWarehouseRequest)(nil),                // 15: inventory.UpdateWarehouseRequest
+	(*ListWarehousesRequest)(nil),                 // 16: inventory.ListWarehousesRequest
+	(*WarehouseResponse)(nil),                     // 17: inventory.WarehouseResponse
+	(*ListWarehousesResponse)(nil),                // 18: inventory.ListWarehousesResponse
+	(*AddInventoryToLocationRequest)(nil),         // 19: inventory.AddInventoryToLocationRequest
+	(*RemoveInventoryFromLocationRequest)(nil),    // 20: inventory.RemoveInventoryFromLocationRequest
+	(*GetInventoryByLocationRequest)(nil),         // 21: inventory.GetInventoryByLocationRequest
+	(*SetStockBuffersRequest)(nil),                // 22: inventory.SetStockBuffersRequest
+	(*InventoryLocationResponse)(nil),             // 23: inventory.InventoryLocationResponse
+	(*ListInventoryLocationsResponse)(nil),        // 24: inventory.ListInventoryLocationsResponse
+	(*ReserveInventoryRequest)(nil),               // 25: inventory.ReserveInventoryRequest
+	(*ReservationItem)(nil),                       // 26: inventory.ReservationItem
+	(*ConfirmReservationRequest)(nil),             // 27: inventory.ConfirmReservationRequest
+	(*CancelReservationRequest)(nil),              // 28: inventory.CancelReservationRequest
+	(*ReservationResponse)(nil),                   // 29: inventory.ReservationResponse
+	(*CheckInventoryAvailabilityRequest)(nil),     // 30: inventory.CheckInventoryAvailabilityRequest
+	(*AvailabilityCheckItem)(nil),                 // 31: inventory.AvailabilityCheckItem
+	(*InventoryAvailabilityResponse)(nil),         // 32: inventory.InventoryAvailabilityResponse
+	(*ItemAvailability)(nil),                      // 33: inventory.ItemAvailability
+	(*CheckAvailabilityBulkRequest)(nil),          // 34: inventory.CheckAvailabilityBulkRequest
+	(*BulkAvailabilityLine)(nil),                  // 35: inventory.BulkAvailabilityLine
+	(*CheckAvailabilityBulkResponse)(nil),         // 36: inventory.CheckAvailabilityBulkResponse
+	(*BulkAvailabilityResult)(nil),                // 37: inventory.BulkAvailabilityResult
+	(*AvailabilityAlternative)(nil),               // 38: inventory.AvailabilityAlternative
+	(*AvailabilityPolicy)(nil),                    // 39: inventory.AvailabilityPolicy
+	(*GetAvailabilityPolicyRequest)(nil),          // 40: inventory.GetAvailabilityPolicyRequest
+	(*SetAvailabilityPolicyRequest)(nil),          // 41: inventory.SetAvailabilityPolicyRequest
+	(*DeleteAvailabilityPolicyRequest)(nil),       // 42: inventory.DeleteAvailabilityPolicyRequest
+	(*DeleteAvailabilityPolicyResponse)(nil),      // 43: inventory.DeleteAvailabilityPolicyResponse
+	(*BulkUpdateInventoryRequest)(nil),            // 44: inventory.BulkUpdateInventoryRequest
+	(*BulkUpdateItem)(nil),                        // 45: inventory.BulkUpdateItem
+	(*BulkUpdateInventoryResponse)(nil),           // 46: inventory.BulkUpdateInventoryResponse
+	(*BulkUpdateResult)(nil),                      // 47: inventory.BulkUpdateResult
+	(*InventorySnapshot)(nil),                     // 48: inventory.InventorySnapshot
+	(*WatchInventoryRequest)(nil),                 // 49: inventory.WatchInventoryRequest
+	(*StockChangeEvent)(nil),                      // 50: inventory.StockChangeEvent
+	(*GetStockHistoryRequest)(nil),                // 51: inventory.GetStockHistoryRequest
+	(*StockHistoryResponse)(nil),                  // 52: inventory.StockHistoryResponse
+	(*GetForecastRequest)(nil),                    // 53: inventory.GetForecastRequest
+	(*InventoryForecast)(nil),                     // 54: inventory.InventoryForecast
+	(*GetForecastResponse)(nil),                   // 55: inventory.GetForecastResponse
+	(*ListReorderSuggestionsRequest)(nil),         // 56: inventory.ListReorderSuggestionsRequest
+	(*ListReorderSuggestionsResponse)(nil),        // 57: inventory.ListReorderSuggestionsResponse
+	(*ListStockAlertsRequest)(nil),                // 58: inventory.ListStockAlertsRequest
+	(*StockAlert)(nil),                            // 59: inventory.StockAlert
+	(*ListStockAlertsResponse)(nil),               // 60: inventory.ListStockAlertsResponse
+	(*ListInventoryActivityRequest)(nil),          // 61: inventory.ListInventoryActivityRequest
+	(*InventoryActivity)(nil),                     // 62: inventory.InventoryActivity
+	(*ListInventoryActivityResponse)(nil),         // 63: inventory.ListInventoryActivityResponse
+	(*GetDiagnosticsRequest)(nil),                 // 64: inventory.GetDiagnosticsRequest
+	(*DBPoolDiagnostics)(nil),                     // 65: inventory.DBPoolDiagnostics
+	(*CacheDiagnostics)(nil),                      // 66: inventory.CacheDiagnostics
+	(*DiagnosticsResponse)(nil),                   // 67: inventory.DiagnosticsResponse
+	(*IntegrationKey)(nil),                        // 68: inventory.IntegrationKey
+	(*CreateIntegrationKeyRequest)(nil),           // 69: inventory.CreateIntegrationKeyRequest
+	(*CreateIntegrationKeyResponse)(nil),          // 70: inventory.CreateIntegrationKeyResponse
+	(*ListIntegrationKeysRequest)(nil),            // 71: inventory.ListIntegrationKeysRequest
+	(*ListIntegrationKeysResponse)(nil),           // 72: inventory.ListIntegrationKeysResponse
+	(*RevokeIntegrationKeyRequest)(nil),           // 73: inventory.RevokeIntegrationKeyRequest
+	(*IntegrationKeyResponse)(nil),                // 74: inventory.IntegrationKeyResponse
+	(*SetIntegrationKeyQuotaRequest)(nil),         // 75: inventory.SetIntegrationKeyQuotaRequest
+	(*GetIntegrationQuotaRequest)(nil),            // 76: inventory.GetIntegrationQuotaRequest
+	(*IntegrationQuota)(nil),                      // 77: inventory.IntegrationQuota
+	(*FulfillmentLine)(nil),                       // 78: inventory.FulfillmentLine
+	(*FulfillmentEvent)(nil),                      // 79: inventory.FulfillmentEvent
+	(*PushFulfillmentEventsRequest)(nil),          // 80: inventory.PushFulfillmentEventsRequest
+	(*FulfillmentEventResult)(nil),                // 81: inventory.FulfillmentEventResult
+	(*PushFulfillmentEventsResponse)(nil),         // 82: inventory.PushFulfillmentEventsResponse
+	(*OrderStatusEvent)(nil),                      // 83: inventory.OrderStatusEvent
+	(*ListOrderStatusEventsRequest)(nil),          // 84: inventory.ListOrderStatusEventsRequest
+	(*ListOrderStatusEventsResponse)(nil),         // 85: inventory.ListOrderStatusEventsResponse
+	(*ShipmentEvent)(nil),                         // 86: inventory.ShipmentEvent
+	(*Shipment)(nil),                              // 87: inventory.Shipment
+	(*CreateShipmentRequest)(nil),                 // 88: inventory.CreateShipmentRequest
+	(*ListShipmentsRequest)(nil),                  // 89: inventory.ListShipmentsRequest
+	(*ListShipmentsResponse)(nil),                 // 90: inventory.ListShipmentsResponse
+	(*GetShipmentStatusRequest)(nil),              // 91: inventory.GetShipmentStatusRequest
+	(*ShipmentStatusResponse)(nil),                // 92: inventory.ShipmentStatusResponse
+	(*CarrierEvent)(nil),                          // 93: inventory.CarrierEvent
+	(*ReceiveCarrierEventsRequest)(nil),           // 94: inventory.ReceiveCarrierEventsRequest
+	(*CarrierEventResult)(nil),                    // 95: inventory.CarrierEventResult
+	(*ReceiveCarrierEventsResponse)(nil),          // 96: inventory.ReceiveCarrierEventsResponse
+	(*SupplierProduct)(nil),                       // 97: inventory.SupplierProduct
+	(*Supplier)(nil),                              // 98: inventory.Supplier
+	(*CreateSupplierRequest)(nil),                 // 99: inventory.CreateSupplierRequest
+	(*UpdateSupplierRequest)(nil),                 // 100: inventory.UpdateSupplierRequest
+	(*GetSupplierRequest)(nil),                    // 101: inventory.GetSupplierRequest
+	(*ListSuppliersRequest)(nil),                  // 102: inventory.ListSuppliersRequest
+	(*ListSuppliersResponse)(nil),                 // 103: inventory.ListSuppliersResponse
+	(*SetSupplierProductRequest)(nil),             // 104: inventory.SetSupplierProductRequest
+	(*RemoveSupplierProductRequest)(nil),          // 105: inventory.RemoveSupplierProductRequest
+	(*RemoveSupplierProductResponse)(nil),         // 106: inventory.RemoveSupplierProductResponse
+	(*PurchaseOrderLine)(nil),                     // 107: inventory.PurchaseOrderLine
+	(*PurchaseOrder)(nil),                         // 108: inventory.PurchaseOrder
+	(*CreatePurchaseOrderLine)(nil),               // 109: inventory.CreatePurchaseOrderLine
+	(*CreatePurchaseOrderRequest)(nil),            // 110: inventory.CreatePurchaseOrderRequest
+	(*GetPurchaseOrderRequest)(nil),               // 111: inventory.GetPurchaseOrderRequest
+	(*ListPurchaseOrdersRequest)(nil),             // 112: inventory.ListPurchaseOrdersRequest
+	(*ListPurchaseOrdersResponse)(nil),            // 113: inventory.ListPurchaseOrdersResponse
+	(*ReceiptLine)(nil),                           // 114: inventory.ReceiptLine
+	(*ReceivePurchaseOrderRequest)(nil),           // 115: inventory.ReceivePurchaseOrderRequest
+	(*CancelPurchaseOrderRequest)(nil),            // 116: inventory.CancelPurchaseOrderRequest
+	(*BackInStockSubscription)(nil),               // 117: inventory.BackInStockSubscription
+	(*SubscribeBackInStockRequest)(nil),           // 118: inventory.SubscribeBackInStockRequest
+	(*ListBackInStockSubscriptionsRequest)(nil),   // 119: inventory.ListBackInStockSubscriptionsRequest
+	(*ListBackInStockSubscriptionsResponse)(nil),  // 120: inventory.ListBackInStockSubscriptionsResponse
+	(*DeleteBackInStockSubscriptionRequest)(nil),  // 121: inventory.DeleteBackInStockSubscriptionRequest
+	(*DeleteBackInStockSubscriptionResponse)(nil), // 122: inventory.DeleteBackInStockSubscriptionResponse
+	(*InventoryLot)(nil),                          // 123: inventory.InventoryLot
+	(*ReceiveLotRequest)(nil),                     // 124: inventory.ReceiveLotRequest
+	(*ListLotsRequest)(nil),                       // 125: inventory.ListLotsRequest
+	(*ListLotsResponse)(nil),                      // 126: inventory.ListLotsResponse
+	(*ListExpiringLotsRequest)(nil),               // 127: inventory.ListExpiringLotsRequest
+	(*ListExpiringLotsResponse)(nil),              // 128: inventory.ListExpiringLotsResponse
+	(*InventoryUnit)(nil),                         // 129: inventory.InventoryUnit
+	(*SetInventoryUnitRequest)(nil),               // 130: inventory.SetInventoryUnitRequest
+	(*ListInventoryUnitsRequest)(nil),             // 131: inventory.ListInventoryUnitsRequest
+	(*ListInventoryUnitsResponse)(nil),            // 132: inventory.ListInventoryUnitsResponse
+	(*DeleteInventoryUnitRequest)(nil),            // 133: inventory.DeleteInventoryUnitRequest
+	(*DeleteInventoryUnitResponse)(nil),           // 134: inventory.DeleteInventoryUnitResponse
+	(*WarehouseBin)(nil),                          // 135: inventory.WarehouseBin
+	(*SetWarehouseBinRequest)(nil),                // 136: inventory.SetWarehouseBinRequest
+	(*ListWarehouseBinsRequest)(nil),              // 137: inventory.ListWarehouseBinsRequest
+	(*ListWarehouseBinsResponse)(nil),             // 138: inventory.ListWarehouseBinsResponse
+	(*DeleteWarehouseBinRequest)(nil),             // 139: inventory.DeleteWarehouseBinRequest
+	(*DeleteWarehouseBinResponse)(nil),            // 140: inventory.DeleteWarehouseBinResponse
+	(*BinStock)(nil),                              // 141: inventory.BinStock
+	(*SetBinStockRequest)(nil),                    // 142: inventory.SetBinStockRequest
+	(*ListBinStockRequest)(nil),                   // 143: inventory.ListBinStockRequest
+	(*ListBinStockResponse)(nil),                  // 144: inventory.ListBinStockResponse
+	(*PickListLine)(nil),                          // 145: inventory.PickListLine
+	(*GeneratePickListRequest)(nil),               // 146: inventory.GeneratePickListRequest
+	(*Pick)(nil),                                  // 147: inventory.Pick
+	(*PickList)(nil),                              // 148: inventory.PickList
+	(*FulfillmentWave)(nil),                       // 149: inventory.FulfillmentWave
+	(*FulfillmentWaveLine)(nil),                   // 150: inventory.FulfillmentWaveLine
+	(*CreateFulfillmentWaveRequest)(nil),          // 151: inventory.CreateFulfillmentWaveRequest
+	(*GetFulfillmentWaveRequest)(nil),             // 152: inventory.GetFulfillmentWaveRequest
+	(*ListFulfillmentWavesRequest)(nil),           // 153: inventory.ListFulfillmentWavesRequest
+	(*ListFulfillmentWavesResponse)(nil),          // 154: inventory.ListFulfillmentWavesResponse
+	(*GenerateWavePickListRequest)(nil),           // 155: inventory.GenerateWavePickListRequest
+	(*PickedWaveLine)(nil),                        // 156: inventory.PickedWaveLine
+	(*CompleteFulfillmentWaveRequest)(nil),        // 157: inventory.CompleteFulfillmentWaveRequest
+	(*CancelFulfillmentWaveRequest)(nil),          // 158: inventory.CancelFulfillmentWaveRequest
+	(*Refund)(nil),                                // 159: inventory.Refund
+	(*RefundLine)(nil),                            // 160: inventory.RefundLine
+	(*RequestRefundRequest)(nil),                  // 161: inventory.RequestRefundRequest
+	(*GetRefundRequest)(nil),                      // 162: inventory.GetRefundRequest
+	(*ListRefundsRequest)(nil),                    // 163: inventory.ListRefundsRequest
+	(*ListRefundsResponse)(nil),                   // 164: inventory.ListRefundsResponse
+	(*ApproveRefundRequest)(nil),                  // 165: inventory.ApproveRefundRequest
+	(*RejectRefundRequest)(nil),                   // 166: inventory.RejectRefundRequest
+	(*RecordRefundResultRequest)(nil),             // 167: inventory.RecordRefundResultRequest
+	(*RefundEvent)(nil),                           // 168: inventory.RefundEvent
+	(*ListRefundEventsRequest)(nil),               // 169: inventory.ListRefundEventsRequest
+	(*ListRefundEventsResponse)(nil),              // 170: inventory.ListRefundEventsResponse
+	(*FraudCheck)(nil),                            // 171: inventory.FraudCheck
+	(*FraudSignal)(nil),                           // 172: inventory.FraudSignal
+	(*ScreenOrderRequest)(nil),                    // 173: inventory.ScreenOrderRequest
+	(*GetFraudCheckRequest)(nil),                  // 174: inventory.GetFraudCheckRequest
+	(*ListFraudChecksRequest)(nil),                // 175: inventory.ListFraudChecksRequest
+	(*ListFraudChecksResponse)(nil),               // 176: inventory.ListFraudChecksResponse
+	(*ReviewFraudCheckRequest)(nil),               // 177: inventory.ReviewFraudCheckRequest
+	(*FraudEvent)(nil),                            // 178: inventory.FraudEvent
+	(*ListFraudEventsRequest)(nil),                // 179: inventory.ListFraudEventsRequest
+	(*ListFraudEventsResponse)(nil),               // 180: inventory.ListFraudEventsResponse
+	(*OpeningHours)(nil),                          // 181: inventory.OpeningHours
+	(*RetailStore)(nil),                           // 182: inventory.RetailStore
+	(*SetRetailStoreRequest)(nil),                 // 183: inventory.SetRetailStoreRequest
+	(*DeleteRetailStoreRequest)(nil),              // 184: inventory.DeleteRetailStoreRequest
+	(*DeleteRetailStoreResponse)(nil),             // 185: inventory.DeleteRetailStoreResponse
+	(*ListRetailStoresRequest)(nil),               // 186: inventory.ListRetailStoresRequest
+	(*ListRetailStoresResponse)(nil),              // 187: inventory.ListRetailStoresResponse
+	(*FindNearbyStoresRequest)(nil),               // 188: inventory.FindNearbyStoresRequest
+	(*NearbyStore)(nil),                           // 189: inventory.NearbyStore
+	(*FindNearbyStoresResponse)(nil),              // 190: inventory.FindNearbyStoresResponse
+	(*ReserveInStoreRequest)(nil),                 // 191: inventory.ReserveInStoreRequest
+	(*ReserveInStoreResponse)(nil),                // 192: inventory.ReserveInStoreResponse
+	(*PickupLine)(nil),                            // 193: inventory.PickupLine
+	(*PersonalizationValue)(nil),                  // 194: inventory.PersonalizationValue
+	(*PickupOrder)(nil),                           // 195: inventory.PickupOrder
+	(*PickupItem)(nil),                            // 196: inventory.PickupItem
+	(*CreatePickupOrderRequest)(nil),              // 197: inventory.CreatePickupOrderRequest
+	(*GetPickupOrderRequest)(nil),                 // 198: inventory.GetPickupOrderRequest
+	(*ListPickupOrdersRequest)(nil),               // 199: inventory.ListPickupOrdersRequest
+	(*ListPickupOrdersResponse)(nil),              // 200: inventory.ListPickupOrdersResponse
+	(*PickupOrderRequest)(nil),                    // 201: inventory.PickupOrderRequest
+	(*CancelPickupOrderRequest)(nil),              // 202: inventory.CancelPickupOrderRequest
+	(*wrapperspb.StringValue)(nil),                // 203: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),                 // 204: google.protobuf.Timestamp
+	(*wrapperspb.Int32Value)(nil),                 // 205: google.protobuf.Int32Value
+	(*wrapperspb.BoolValue)(nil),                  // 206: google.protobuf.BoolValue
 }
 var file_proto_inventory_proto_depIdxs = []int32{
-	36, // 0: inventory.InventoryItem.variant_id:type_name -> google.protobuf.StringValue
-	37, // 1: inventory.InventoryItem.last_updated:type_name -> google.protobuf.Timestamp
-	37, // 2: inventory.InventoryItem.created_at:type_name -> google.protobuf.Timestamp
-	37, // 3: inventory.InventoryItem.updated_at:type_name -> google.protobuf.Timestamp
-	2,  // 4: inventory.InventoryItem.locations:type_name -> inventory.InventoryLocation
-	37, // 5: inventory.Warehouse.created_at:type_name -> google.protobuf.Timestamp
-	37, // 6: inventory.Warehouse.updated_at:type_name -> google.protobuf.Timestamp
-	37, // 7: inventory.InventoryLocation.created_at:type_name -> google.protobuf.Timestamp
-	37, // 8: inventory.InventoryLocation.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 9: inventory.InventoryLocation.warehouse:type_name -> inventory.Warehouse
-	36, // 10: inventory.InventoryTransaction.warehouse_id:type_name -> google.protobuf.StringValue
-	36, // 11: inventory.InventoryTransaction.reference_id:type_name -> google.protobuf.StringValue
-	36, // 12: inventory.InventoryTransaction.reference_type:type_name -> google.protobuf.StringValue
-	36, // 13: inventory.InventoryTransaction.notes:type_name -> google.protobuf.StringValue
-	36, // 14: inventory.InventoryTransaction.created_by:type_name -> google.protobuf.StringValue
-	37, // 15: inventory.InventoryTransaction.created_at:type_name -> google.protobuf.Timestamp
-	36, // 16: inventory.InventoryReservation.warehouse_id:type_name -> google.protobuf.StringValue
-	37, // 17: inventory.InventoryReservation.expiration_time:type_name -> google.protobuf.Timestamp
-	36, // 18: inventory.InventoryReservation.reference_id:type_name -> google.protobuf.StringValue
-	37, // 19: inventory.InventoryReservation.created_at:type_name -> google.protobuf.Timestamp
-	37, // 20: inventory.InventoryReservation.updated_at:type_name -> google.protobuf.Timestamp
-	36, // 21: inventory.CreateInventoryItemRequest.variant_id:type_name -> google.protobuf.StringValue
-	6,  // 22: inventory.CreateInventoryItemRequest.warehouse_allocations:type_name -> inventory.WarehouseAllocation
-	38, // 23: inventory.UpdateInventoryItemRequest.reorder_point:type_name -> google.protobuf.Int32Value
-	38, // 24: inventory.UpdateInventoryItemRequest.reorder_quantity:type_name -> google.protobuf.Int32Value
-	36, // 25: inventory.UpdateInventoryItemRequest.status:type_name -> google.protobuf.StringValue
-	36, // 26: inventory.ListInventoryItemsRequest.status:type_name -> google.protobuf.StringValue
-	36, // 27: inventory.ListInventoryItemsRequest.warehouse_id:type_name -> google.protobuf.StringValue
-	0,  // 28: inventory.InventoryItemResponse.inventory_item:type_name -> inventory.InventoryItem
-	0,  // 29: inventory.ListInventoryItemsResponse.inventory_items:type_name -> inventory.InventoryItem
-	36, // 30: inventory.UpdateWarehouseRequest.name:type_name -> google.protobuf.StringValue
-	36, // 31: inventory.UpdateWarehouseRequest.address:type_name -> google.protobuf.StringValue
-	36, // 32: inventory.UpdateWarehouseRequest.city:type_name -> google.protobuf.StringValue
-	36, // 33: inventory.UpdateWarehouseRequest.state:type_name -> google.protobuf.StringValue
-	36, // 34: inventory.UpdateWarehouseRequest.country:type_name -> google.protobuf.StringValue
-	36, // 35: inventory.UpdateWarehouseRequest.postal_code:type_name -> google.protobuf.StringValue
-	38, // 36: inventory.UpdateWarehouseRequest.priority:type_name -> google.protobuf.Int32Value
-	39, // 37: inventory.UpdateWarehouseRequest.is_active:type_name -> google.protobuf.BoolValue
-	39, // 38: inventory.ListWarehousesRequest.is_active:type_name -> google.protobuf.BoolValue
-	1,  // 39: inventory.WarehouseResponse.warehouse:type_name -> inventory.Warehouse
-	1,  // 40: inventory.ListWarehousesResponse.warehouses:type_name -> inventory.Warehouse
-	2,  // 41: inventory.InventoryLocationResponse.inventory_location:type_name -> inventory.InventoryLocation
-	2,  // 42: inventory.ListInventoryLocationsResponse.inventory_locations:type_name -> inventory.InventoryLocation
-	24, // 43: inventory.ReserveInventoryRequest.items:type_name -> inventory.ReservationItem
-	36, // 44: inventory.ReservationItem.warehouse_id:type_name -> google.protobuf.StringValue
-	4,  // 45: inventory.ReservationResponse.reservation:type_name -> inventory.InventoryReservation
-	29, // 46: inventory.CheckInventoryAvailabilityRequest.items:type_name -> inventory.AvailabilityCheckItem
-	36, // 47: inventory.AvailabilityCheckItem.variant_id:type_name -> google.protobuf.StringValue
-	31, // 48: inventory.InventoryAvailabilityResponse.items:type_name -> inventory.ItemAvailability
-	36, // 49: inventory.ItemAvailability.variant_id:type_name -> google.protobuf.StringValue
-	33, // 50: inventory.BulkUpdateInventoryRequest.items:type_name -> inventory.BulkUpdateItem
-	35, // 51: inventory.BulkUpdateInventoryResponse.results:type_name -> inventory.BulkUpdateResult
-	0,  // 52: inventory.BulkUpdateResult.updated_item:type_name -> inventory.InventoryItem
-	5,  // 53: inventory.InventoryService.CreateInventoryItem:input_type -> inventory.CreateInventoryItemRequest
-	7,  // 54: inventory.InventoryService.GetInventoryItem:input_type -> inventory.GetInventoryItemRequest
-	8,  // 55: inventory.InventoryService.UpdateInventoryItem:input_type -> inventory.UpdateInventoryItemRequest
-	9,  // 56: inventory.InventoryService.ListInventoryItems:input_type -> inventory.ListInventoryItemsRequest
-	12, // 57: inventory.InventoryService.CreateWarehouse:input_type -> inventory.CreateWarehouseRequest
-	13, // 58: inventory.InventoryService.GetWarehouse:input_type -> inventory.GetWarehouseRequest
-	14, // 59: inventory.InventoryService.UpdateWarehouse:input_type -> inventory.UpdateWarehouseRequest
-	15, // 60: inventory.InventoryService.ListWarehouses:input_type -> inventory.ListWarehousesRequest
-	18, // 61: inventory.InventoryService.AddInventoryToLocation:input_type -> inventory.AddInventoryToLocationRequest
-	19, // 62: inventory.InventoryService.RemoveInventoryFromLocation:input_type -> inventory.RemoveInventoryFromLocationRequest
-	20, // 63: inventory.InventoryService.GetInventoryByLocation:input_type -> inventory.GetInventoryByLocationRequest
-	23, // 64: inventory.InventoryService.ReserveInventory:input_type -> inventory.ReserveInventoryRequest
-	25, // 65: inventory.InventoryService.ConfirmReservation:input_type -> inventory.ConfirmReservationRequest
-	26, // 66: inventory.InventoryService.CancelReservation:input_type -> inventory.CancelReservationRequest
-	28, // 67: inventory.InventoryService.CheckInventoryAvailability:input_type -> inventory.CheckInventoryAvailabilityRequest
-	32, // 68: inventory.InventoryService.BulkUpdateInventory:input_type -> inventory.BulkUpdateInventoryRequest
-	10, // 69: inventory.InventoryService.CreateInventoryItem:output_type -> inventory.InventoryItemResponse
-	10, // 70: inventory.InventoryService.GetInventoryItem:output_type -> inventory.InventoryItemResponse
-	10, // 71: inventory.InventoryService.UpdateInventoryItem:output_type -> inventory.InventoryItemResponse
-	11, // 72: inventory.InventoryService.ListInventoryItems:output_type -> inventory.ListInventoryItemsResponse
-	16, // 73: inventory.InventoryService.CreateWarehouse:output_type -> inventory.WarehouseResponse
-	16, // 74: inventory.InventoryService.GetWarehouse:output_type -> inventory.WarehouseResponse
-	16, // 75: inventory.InventoryService.UpdateWarehouse:output_type -> inventory.WarehouseResponse
-	17, // 76: inventory.InventoryService.ListWarehouses:output_type -> inventory.ListWarehousesResponse
-	21, // 77: inventory.InventoryService.AddInventoryToLocation:output_type -> inventory.InventoryLocationResponse
-	21, // 78: inventory.InventoryService.RemoveInventoryFromLocation:output_type -> inventory.InventoryLocationResponse
-	22, // 79: inventory.InventoryService.GetInventoryByLocation:output_type -> inventory.ListInventoryLocationsResponse
-	27, // 80: inventory.InventoryService.ReserveInventory:output_type -> inventory.ReservationResponse
-	27, // 81: inventory.InventoryService.ConfirmReservation:output_type -> inventory.ReservationResponse
-	27, // 82: inventory.InventoryService.CancelReservation:output_type -> inventory.ReservationResponse
-	30, // 83: inventory.InventoryService.CheckInventoryAvailability:output_type -> inventory.InventoryAvailabilityResponse
-	34, // 84: inventory.InventoryService.BulkUpdateInventory:output_type -> inventory.BulkUpdateInventoryResponse
-	69, // [69:85] is the sub-list for method output_type
-	53, // [53:69] is the sub-list for method input_type
-	53, // [53:53] is the sub-list for extension type_name
-	53, // [53:53] is the sub-list for extension extendee
-	0,  // [0:53] is the sub-list for field type_name
+	203, // 0: inventory.InventoryItem.variant_id:type_name -> google.protobuf.StringValue
+	204, // 1: inventory.InventoryItem.last_updated:type_name -> google.protobuf.Timestamp
+	204, // 2: inventory.InventoryItem.created_at:type_name -> google.protobuf.Timestamp
+	204, // 3: inventory.InventoryItem.updated_at:type_name -> google.protobuf.Timestamp
+	2,   // 4: inventory.InventoryItem.locations:type_name -> inventory.InventoryLocation
+	204, // 5: inventory.Warehouse.created_at:type_name -> google.protobuf.Timestamp
+	204, // 6: inventory.Warehouse.updated_at:type_name -> google.protobuf.Timestamp
+	204, // 7: inventory.InventoryLocation.created_at:type_name -> google.protobuf.Timestamp
+	204, // 8: inventory.InventoryLocation.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 9: inventory.InventoryLocation.warehouse:type_name -> inventory.Warehouse
+	203, // 10: inventory.InventoryTransaction.warehouse_id:type_name -> google.protobuf.StringValue
+	203, // 11: inventory.InventoryTransaction.reference_id:type_name -> google.protobuf.StringValue
+	203, // 12: inventory.InventoryTransaction.reference_type:type_name -> google.protobuf.StringValue
+	203, // 13: inventory.InventoryTransaction.notes:type_name -> google.protobuf.StringValue
+	203, // 14: inventory.InventoryTransaction.created_by:type_name -> google.protobuf.StringValue
+	204, // 15: inventory.InventoryTransaction.created_at:type_name -> google.protobuf.Timestamp
+	203, // 16: inventory.InventoryReservation.warehouse_id:type_name -> google.protobuf.StringValue
+	204, // 17: inventory.InventoryReservation.expiration_time:type_name -> google.protobuf.Timestamp
+	203, // 18: inventory.InventoryReservation.reference_id:type_name -> google.protobuf.StringValue
+	204, // 19: inventory.InventoryReservation.created_at:type_name -> google.protobuf.Timestamp
+	204, // 20: inventory.InventoryReservation.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 21: inventory.InventoryReservation.lots:type_name -> inventory.LotAllocation
+	204, // 22: inventory.LotAllocation.expires_at:type_name -> google.protobuf.Timestamp
+	203, // 23: inventory.CreateInventoryItemRequest.variant_id:type_name -> google.protobuf.StringValue
+	7,   // 24: inventory.CreateInventoryItemRequest.warehouse_allocations:type_name -> inventory.WarehouseAllocation
+	205, // 25: inventory.UpdateInventoryItemRequest.reorder_point:type_name -> google.protobuf.Int32Value
+	205, // 26: inventory.UpdateInventoryItemRequest.reorder_quantity:type_name -> google.protobuf.Int32Value
+	203, // 27: inventory.UpdateInventoryItemRequest.status:type_name -> google.protobuf.StringValue
+	203, // 28: inventory.ListInventoryItemsRequest.status:type_name -> google.protobuf.StringValue
+	203, // 29: inventory.ListInventoryItemsRequest.warehouse_id:type_name -> google.protobuf.StringValue
+	0,   // 30: inventory.InventoryItemResponse.inventory_item:type_name -> inventory.InventoryItem
+	0,   // 31: inventory.ListInventoryItemsResponse.inventory_items:type_name -> inventory.InventoryItem
+	203, // 32: inventory.UpdateWarehouseRequest.name:type_name -> google.protobuf.StringValue
+	203, // 33: inventory.UpdateWarehouseRequest.address:type_name -> google.protobuf.StringValue
+	203, // 34: inventory.UpdateWarehouseRequest.city:type_name -> google.protobuf.StringValue
+	203, // 35: inventory.UpdateWarehouseRequest.state:type_name -> google.protobuf.StringValue
+	203, // 36: inventory.UpdateWarehouseRequest.country:type_name -> google.protobuf.StringValue
+	203, // 37: inventory.UpdateWarehouseRequest.postal_code:type_name -> google.protobuf.StringValue
+	205, // 38: inventory.UpdateWarehouseRequest.priority:type_name -> google.protobuf.Int32Value
+	206, // 39: inventory.UpdateWarehouseRequest.is_active:type_name -> google.protobuf.BoolValue
+	206, // 40: inventory.ListWarehousesRequest.is_active:type_name -> google.protobuf.BoolValue
+	1,   // 41: inventory.WarehouseResponse.warehouse:type_name -> inventory.Warehouse
+	1,   // 42: inventory.ListWarehousesResponse.warehouses:type_name -> inventory.Warehouse
+	2,   // 43: inventory.InventoryLocationResponse.inventory_location:type_name -> inventory.InventoryLocation
+	2,   // 44: inventory.ListInventoryLocationsResponse.inventory_locations:type_name -> inventory.InventoryLocation
+	26,  // 45: inventory.ReserveInventoryRequest.items:type_name -> inventory.ReservationItem
+	203, // 46: inventory.ReservationItem.warehouse_id:type_name -> google.protobuf.StringValue
+	4,   // 47: inventory.ReservationResponse.reservation:type_name -> inventory.InventoryReservation
+	31,  // 48: inventory.CheckInventoryAvailabilityRequest.items:type_name -> inventory.AvailabilityCheckItem
+	203, // 49: inventory.AvailabilityCheckItem.variant_id:type_name -> google.protobuf.StringValue
+	33,  // 50: inventory.InventoryAvailabilityResponse.items:type_name -> inventory.ItemAvailability
+	203, // 51: inventory.ItemAvailability.variant_id:type_name -> google.protobuf.StringValue
+	35,  // 52: inventory.CheckAvailabilityBulkRequest.lines:type_name -> inventory.BulkAvailabilityLine
+	203, // 53: inventory.BulkAvailabilityLine.warehouse_id:type_name -> google.protobuf.StringValue
+	37,  // 54: inventory.CheckAvailabilityBulkResponse.lines:type_name -> inventory.BulkAvailabilityResult
+	203, // 55: inventory.BulkAvailabilityResult.variant_id:type_name -> google.protobuf.StringValue
+	203, // 56: inventory.BulkAvailabilityResult.warehouse_id:type_name -> google.protobuf.StringValue
+	38,  // 57: inventory.BulkAvailabilityResult.alternatives:type_name -> inventory.AvailabilityAlternative
+	203, // 58: inventory.AvailabilityAlternative.warehouse_id:type_name -> google.protobuf.StringValue
+	205, // 59: inventory.AvailabilityPolicy.low_stock_threshold:type_name -> google.protobuf.Int32Value
+	204, // 60: inventory.AvailabilityPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	39,  // 61: inventory.SetAvailabilityPolicyRequest.policy:type_name -> inventory.AvailabilityPolicy
+	45,  // 62: inventory.BulkUpdateInventoryRequest.items:type_name -> inventory.BulkUpdateItem
+	47,  // 63: inventory.BulkUpdateInventoryResponse.results:type_name -> inventory.BulkUpdateResult
+	0,   // 64: inventory.BulkUpdateResult.updated_item:type_name -> inventory.InventoryItem
+	204, // 65: inventory.InventorySnapshot.snapshot_date:type_name -> google.protobuf.Timestamp
+	203, // 66: inventory.InventorySnapshot.warehouse_id:type_name -> google.protobuf.StringValue
+	203, // 67: inventory.WatchInventoryRequest.warehouse_id:type_name -> google.protobuf.StringValue
+	203, // 68: inventory.StockChangeEvent.variant_id:type_name -> google.protobuf.StringValue
+	203, // 69: inventory.StockChangeEvent.warehouse_id:type_name -> google.protobuf.StringValue
+	204, // 70: inventory.StockChangeEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	203, // 71: inventory.GetStockHistoryRequest.warehouse_id:type_name -> google.protobuf.StringValue
+	204, // 72: inventory.GetStockHistoryRequest.start_date:type_name -> google.protobuf.Timestamp
+	204, // 73: inventory.GetStockHistoryRequest.end_date:type_name -> google.protobuf.Timestamp
+	203, // 74: inventory.StockHistoryResponse.warehouse_id:type_name -> google.protobuf.StringValue
+	48,  // 75: inventory.StockHistoryResponse.snapshots:type_name -> inventory.InventorySnapshot
+	204, // 76: inventory.InventoryForecast.stockout_date:type_name -> google.protobuf.Timestamp
+	204, // 77: inventory.InventoryForecast.computed_at:type_name -> google.protobuf.Timestamp
+	54,  // 78: inventory.GetForecastResponse.forecasts:type_name -> inventory.InventoryForecast
+	54,  // 79: inventory.ListReorderSuggestionsResponse.suggestions:type_name -> inventory.InventoryForecast
+	203, // 80: inventory.ListStockAlertsRequest.warehouse_id:type_name -> google.protobuf.StringValue
+	203, // 81: inventory.StockAlert.warehouse_id:type_name -> google.protobuf.StringValue
+	204, // 82: inventory.StockAlert.detected_at:type_name -> google.protobuf.Timestamp
+	59,  // 83: inventory.ListStockAlertsResponse.alerts:type_name -> inventory.StockAlert
+	204, // 84: inventory.ListInventoryActivityRequest.before_time:type_name -> google.protobuf.Timestamp
+	204, // 85: inventory.InventoryActivity.created_at:type_name -> google.protobuf.Timestamp
+	62,  // 86: inventory.ListInventoryActivityResponse.entries:type_name -> inventory.InventoryActivity
+	204, // 87: inventory.DiagnosticsResponse.collected_at:type_name -> google.protobuf.Timestamp
+	65,  // 88: inventory.DiagnosticsResponse.db_pools:type_name -> inventory.DBPoolDiagnostics
+	66,  // 89: inventory.DiagnosticsResponse.caches:type_name -> inventory.CacheDiagnostics
+	204, // 90: inventory.IntegrationKey.created_at:type_name -> google.protobuf.Timestamp
+	204, // 91: inventory.IntegrationKey.last_used_at:type_name -> google.protobuf.Timestamp
+	204, // 92: inventory.IntegrationKey.revoked_at:type_name -> google.protobuf.Timestamp
+	68,  // 93: inventory.CreateIntegrationKeyResponse.key:type_name -> inventory.IntegrationKey
+	68,  // 94: inventory.ListIntegrationKeysResponse.keys:type_name -> inventory.IntegrationKey
+	68,  // 95: inventory.IntegrationKeyResponse.key:type_name -> inventory.IntegrationKey
+	204, // 96: inventory.IntegrationQuota.resets_at:type_name -> google.protobuf.Timestamp
+	204, // 97: inventory.FulfillmentEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	78,  // 98: inventory.FulfillmentEvent.lines:type_name -> inventory.FulfillmentLine
+	79,  // 99: inventory.PushFulfillmentEventsRequest.events:type_name -> inventory.FulfillmentEvent
+	81,  // 100: inventory.PushFulfillmentEventsResponse.results:type_name -> inventory.FulfillmentEventResult
+	77,  // 101: inventory.PushFulfillmentEventsResponse.quota:type_name -> inventory.IntegrationQuota
+	204, // 102: inventory.OrderStatusEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	204, // 103: inventory.OrderStatusEvent.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 104: inventory.ListOrderStatusEventsResponse.events:type_name -> inventory.OrderStatusEvent
+	204, // 105: inventory.ShipmentEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	204, // 106: inventory.Shipment.shipped_at:type_name -> google.protobuf.Timestamp
+	204, // 107: inventory.Shipment.delivered_at:type_name -> google.protobuf.Timestamp
+	204, // 108: inventory.Shipment.created_at:type_name -> google.protobuf.Timestamp
+	204, // 109: inventory.Shipment.updated_at:type_name -> google.protobuf.Timestamp
+	86,  // 110: inventory.Shipment.events:type_name -> inventory.ShipmentEvent
+	204, // 111: inventory.CreateShipmentRequest.shipped_at:type_name -> google.protobuf.Timestamp
+	87,  // 112: inventory.ListShipmentsResponse.shipments:type_name -> inventory.Shipment
+	87,  // 113: inventory.ShipmentStatusResponse.shipments:type_name -> inventory.Shipment
+	204, // 114: inventory.CarrierEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	93,  // 115: inventory.ReceiveCarrierEventsRequest.events:type_name -> inventory.CarrierEvent
+	95,  // 116: inventory.ReceiveCarrierEventsResponse.results:type_name -> inventory.CarrierEventResult
+	77,  // 117: inventory.ReceiveCarrierEventsResponse.quota:type_name -> inventory.IntegrationQuota
+	204, // 118: inventory.SupplierProduct.updated_at:type_name -> google.protobuf.Timestamp
+	204, // 119: inventory.Supplier.created_at:type_name -> google.protobuf.Timestamp
+	204, // 120: inventory.Supplier.updated_at:type_name -> google.protobuf.Timestamp
+	97,  // 121: inventory.Supplier.products:type_name -> inventory.SupplierProduct
+	206, // 122: inventory.ListSuppliersRequest.is_active:type_name -> google.protobuf.BoolValue
+	98,  // 123: inventory.ListSuppliersResponse.suppliers:type_name -> inventory.Supplier
+	204, // 124: inventory.PurchaseOrder.expected_at:type_name -> google.protobuf.Timestamp
+	204, // 125: inventory.PurchaseOrder.received_at:type_name -> google.protobuf.Timestamp
+	204, // 126: inventory.PurchaseOrder.created_at:type_name -> google.protobuf.Timestamp
+	204, // 127: inventory.PurchaseOrder.updated_at:type_name -> google.protobuf.Timestamp
+	107, // 128: inventory.PurchaseOrder.lines:type_name -> inventory.PurchaseOrderLine
+	204, // 129: inventory.CreatePurchaseOrderRequest.expected_at:type_name -> google.protobuf.Timestamp
+	109, // 130: inventory.CreatePurchaseOrderRequest.lines:type_name -> inventory.CreatePurchaseOrderLine
+	204, // 131: inventory.ListPurchaseOrdersRequest.expected_before:type_name -> google.protobuf.Timestamp
+	108, // 132: inventory.ListPurchaseOrdersResponse.purchase_orders:type_name -> inventory.PurchaseOrder
+	114, // 133: inventory.ReceivePurchaseOrderRequest.lines:type_name -> inventory.ReceiptLine
+	204, // 134: inventory.BackInStockSubscription.notified_at:type_name -> google.protobuf.Timestamp
+	204, // 135: inventory.BackInStockSubscription.created_at:type_name -> google.protobuf.Timestamp
+	117, // 136: inventory.ListBackInStockSubscriptionsResponse.subscriptions:type_name -> inventory.BackInStockSubscription
+	204, // 137: inventory.InventoryLot.expires_at:type_name -> google.protobuf.Timestamp
+	204, // 138: inventory.InventoryLot.received_at:type_name -> google.protobuf.Timestamp
+	204, // 139: inventory.InventoryLot.written_off_at:type_name -> google.protobuf.Timestamp
+	204, // 140: inventory.InventoryLot.updated_at:type_name -> google.protobuf.Timestamp
+	204, // 141: inventory.ReceiveLotRequest.expires_at:type_name -> google.protobuf.Timestamp
+	123, // 142: inventory.ListLotsResponse.lots:type_name -> inventory.InventoryLot
+	123, // 143: inventory.ListExpiringLotsResponse.lots:type_name -> inventory.InventoryLot
+	204, // 144: inventory.InventoryUnit.updated_at:type_name -> google.protobuf.Timestamp
+	129, // 145: inventory.ListInventoryUnitsResponse.units:type_name -> inventory.InventoryUnit
+	204, // 146: inventory.WarehouseBin.created_at:type_name -> google.protobuf.Timestamp
+	204, // 147: inventory.WarehouseBin.updated_at:type_name -> google.protobuf.Timestamp
+	135, // 148: inventory.ListWarehouseBinsResponse.bins:type_name -> inventory.WarehouseBin
+	135, // 149: inventory.BinStock.bin:type_name -> inventory.WarehouseBin
+	204, // 150: inventory.BinStock.updated_at:type_name -> google.protobuf.Timestamp
+	141, // 151: inventory.ListBinStockResponse.stock:type_name -> inventory.BinStock
+	145, // 152: inventory.GeneratePickListRequest.lines:type_name -> inventory.PickListLine
+	135, // 153: inventory.Pick.bin:type_name -> inventory.WarehouseBin
+	147, // 154: inventory.PickList.picks:type_name -> inventory.Pick
+	145, // 155: inventory.PickList.shortages:type_name -> inventory.PickListLine
+	204, // 156: inventory.FulfillmentWave.cutoff_at:type_name -> google.protobuf.Timestamp
+	150, // 157: inventory.FulfillmentWave.lines:type_name -> inventory.FulfillmentWaveLine
+	204, // 158: inventory.FulfillmentWave.created_at:type_name -> google.protobuf.Timestamp
+	204, // 159: inventory.FulfillmentWave.completed_at:type_name -> google.protobuf.Timestamp
+	204, // 160: inventory.CreateFulfillmentWaveRequest.cutoff_at:type_name -> google.protobuf.Timestamp
+	149, // 161: inventory.ListFulfillmentWavesResponse.waves:type_name -> inventory.FulfillmentWave
+	156, // 162: inventory.CompleteFulfillmentWaveRequest.lines:type_name -> inventory.PickedWaveLine
+	204, // 163: inventory.Refund.reviewed_at:type_name -> google.protobuf.Timestamp
+	204, // 164: inventory.Refund.processed_at:type_name -> google.protobuf.Timestamp
+	160, // 165: inventory.Refund.lines:type_name -> inventory.RefundLine
+	204, // 166: inventory.Refund.created_at:type_name -> google.protobuf.Timestamp
+	204, // 167: inventory.Refund.updated_at:type_name -> google.protobuf.Timestamp
+	160, // 168: inventory.RequestRefundRequest.lines:type_name -> inventory.RefundLine
+	159, // 169: inventory.ListRefundsResponse.refunds:type_name -> inventory.Refund
+	204, // 170: inventory.RefundEvent.created_at:type_name -> google.protobuf.Timestamp
+	168, // 171: inventory.ListRefundEventsResponse.events:type_name -> inventory.RefundEvent
+	172, // 172: inventory.FraudCheck.signals:type_name -> inventory.FraudSignal
+	204, // 173: inventory.FraudCheck.reviewed_at:type_name -> google.protobuf.Timestamp
+	204, // 174: inventory.FraudCheck.created_at:type_name -> google.protobuf.Timestamp
+	204, // 175: inventory.FraudCheck.updated_at:type_name -> google.protobuf.Timestamp
+	171, // 176: inventory.ListFraudChecksResponse.checks:type_name -> inventory.FraudCheck
+	204, // 177: inventory.FraudEvent.created_at:type_name -> google.protobuf.Timestamp
+	178, // 178: inventory.ListFraudEventsResponse.events:type_name -> inventory.FraudEvent
+	1,   // 179: inventory.RetailStore.warehouse:type_name -> inventory.Warehouse
+	181, // 180: inventory.RetailStore.opening_hours:type_name -> inventory.OpeningHours
+	204, // 181: inventory.RetailStore.created_at:type_name -> google.protobuf.Timestamp
+	204, // 182: inventory.RetailStore.updated_at:type_name -> google.protobuf.Timestamp
+	181, // 183: inventory.SetRetailStoreRequest.opening_hours:type_name -> inventory.OpeningHours
+	182, // 184: inventory.ListRetailStoresResponse.stores:type_name -> inventory.RetailStore
+	182, // 185: inventory.NearbyStore.store:type_name -> inventory.RetailStore
+	189, // 186: inventory.FindNearbyStoresResponse.stores:type_name -> inventory.NearbyStore
+	4,   // 187: inventory.ReserveInStoreResponse.reservation:type_name -> inventory.InventoryReservation
+	182, // 188: inventory.ReserveInStoreResponse.store:type_name -> inventory.RetailStore
+	194, // 189: inventory.PickupLine.personalization:type_name -> inventory.PersonalizationValue
+	204, // 190: inventory.PickupOrder.collect_by:type_name -> google.protobuf.Timestamp
+	204, // 191: inventory.PickupOrder.ready_at:type_name -> google.protobuf.Timestamp
+	204, // 192: inventory.PickupOrder.collected_at:type_name -> google.protobuf.Timestamp
+	204, // 193: inventory.PickupOrder.cancelled_at:type_name -> google.protobuf.Timestamp
+	193, // 194: inventory.PickupOrder.lines:type_name -> inventory.PickupLine
+	204, // 195: inventory.PickupOrder.created_at:type_name -> google.protobuf.Timestamp
+	204, // 196: inventory.PickupOrder.updated_at:type_name -> google.protobuf.Timestamp
+	194, // 197: inventory.PickupItem.personalization:type_name -> inventory.PersonalizationValue
+	196, // 198: inventory.CreatePickupOrderRequest.items:type_name -> inventory.PickupItem
+	195, // 199: inventory.ListPickupOrdersResponse.orders:type_name -> inventory.PickupOrder
+	6,   // 200: inventory.InventoryService.CreateInventoryItem:input_type -> inventory.CreateInventoryItemRequest
+	8,   // 201: inventory.InventoryService.GetInventoryItem:input_type -> inventory.GetInventoryItemRequest
+	9,   // 202: inventory.InventoryService.UpdateInventoryItem:input_type -> inventory.UpdateInventoryItemRequest
+	10,  // 203: inventory.InventoryService.ListInventoryItems:input_type -> inventory.ListInventoryItemsRequest
+	13,  // 204: inventory.InventoryService.CreateWarehouse:input_type -> inventory.CreateWarehouseRequest
+	14,  // 205: inventory.InventoryService.GetWarehouse:input_type -> inventory.GetWarehouseRequest
+	15,  // 206: inventory.InventoryService.UpdateWarehouse:input_type -> inventory.UpdateWarehouseRequest
+	16,  // 207: inventory.InventoryService.ListWarehouses:input_type -> inventory.ListWarehousesRequest
+	19,  // 208: inventory.InventoryService.AddInventoryToLocation:input_type -> inventory.AddInventoryToLocationRequest
+	20,  // 209: inventory.InventoryService.RemoveInventoryFromLocation:input_type -> inventory.RemoveInventoryFromLocationRequest
+	21,  // 210: inventory.InventoryService.GetInventoryByLocation:input_type -> inventory.GetInventoryByLocationRequest
+	22,  // 211: inventory.InventoryService.SetStockBuffers:input_type -> inventory.SetStockBuffersRequest
+	25,  // 212: inventory.InventoryService.ReserveInventory:input_type -> inventory.ReserveInventoryRequest
+	27,  // 213: inventory.InventoryService.ConfirmReservation:input_type -> inventory.ConfirmReservationRequest
+	28,  // 214: inventory.InventoryService.CancelReservation:input_type -> inventory.CancelReservationRequest
+	30,  // 215: inventory.InventoryService.CheckInventoryAvailability:input_type -> inventory.CheckInventoryAvailabilityRequest
+	34,  // 216: inventory.InventoryService.CheckAvailabilityBulk:input_type -> inventory.CheckAvailabilityBulkRequest
+	40,  // 217: inventory.InventoryService.GetAvailabilityPolicy:input_type -> inventory.GetAvailabilityPolicyRequest
+	41,  // 218: inventory.InventoryService.SetAvailabilityPolicy:input_type -> inventory.SetAvailabilityPolicyRequest
+	42,  // 219: inventory.InventoryService.DeleteAvailabilityPolicy:input_type -> inventory.DeleteAvailabilityPolicyRequest
+	44,  // 220: inventory.InventoryService.BulkUpdateInventory:input_type -> inventory.BulkUpdateInventoryRequest
+	49,  // 221: inventory.InventoryService.WatchInventory:input_type -> inventory.WatchInventoryRequest
+	51,  // 222: inventory.InventoryService.GetStockHistory:input_type -> inventory.GetStockHistoryRequest
+	58,  // 223: inventory.InventoryService.ListStockAlerts:input_type -> inventory.ListStockAlertsRequest
+	61,  // 224: inventory.InventoryService.ListInventoryActivity:input_type -> inventory.ListInventoryActivityRequest
+	53,  // 225: inventory.InventoryService.GetForecast:input_type -> inventory.GetForecastRequest
+	56,  // 226: inventory.InventoryService.ListReorderSuggestions:input_type -> inventory.ListReorderSuggestionsRequest
+	64,  // 227: inventory.InventoryService.GetDiagnostics:input_type -> inventory.GetDiagnosticsRequest
+	69,  // 228: inventory.InventoryService.CreateIntegrationKey:input_type -> inventory.CreateIntegrationKeyRequest
+	71,  // 229: inventory.InventoryService.ListIntegrationKeys:input_type -> inventory.ListIntegrationKeysRequest
+	73,  // 230: inventory.InventoryService.RevokeIntegrationKey:input_type -> inventory.RevokeIntegrationKeyRequest
+	75,  // 231: inventory.InventoryService.SetIntegrationKeyQuota:input_type -> inventory.SetIntegrationKeyQuotaRequest
+	76,  // 232: inventory.InventoryService.GetIntegrationQuota:input_type -> inventory.GetIntegrationQuotaRequest
+	80,  // 233: inventory.InventoryService.PushFulfillmentEvents:input_type -> inventory.PushFulfillmentEventsRequest
+	84,  // 234: inventory.InventoryService.ListOrderStatusEvents:input_type -> inventory.ListOrderStatusEventsRequest
+	88,  // 235: inventory.InventoryService.CreateShipment:input_type -> inventory.CreateShipmentRequest
+	89,  // 236: inventory.InventoryService.ListShipments:input_type -> inventory.ListShipmentsRequest
+	91,  // 237: inventory.InventoryService.GetShipmentStatus:input_type -> inventory.GetShipmentStatusRequest
+	94,  // 238: inventory.InventoryService.ReceiveCarrierEvents:input_type -> inventory.ReceiveCarrierEventsRequest
+	99,  // 239: inventory.InventoryService.CreateSupplier:input_type -> inventory.CreateSupplierRequest
+	100, // 240: inventory.InventoryService.UpdateSupplier:input_type -> inventory.UpdateSupplierRequest
+	101, // 241: inventory.InventoryService.GetSupplier:input_type -> inventory.GetSupplierRequest
+	102, // 242: inventory.InventoryService.ListSuppliers:input_type -> inventory.ListSuppliersRequest
+	104, // 243: inventory.InventoryService.SetSupplierProduct:input_type -> inventory.SetSupplierProductRequest
+	105, // 244: inventory.InventoryService.RemoveSupplierProduct:input_type -> inventory.RemoveSupplierProductRequest
+	110, // 245: inventory.InventoryService.CreatePurchaseOrder:input_type -> inventory.CreatePurchaseOrderRequest
+	111, // 246: inventory.InventoryService.GetPurchaseOrder:input_type -> inventory.GetPurchaseOrderRequest
+	112, // 247: inventory.InventoryService.ListPurchaseOrders:input_type -> inventory.ListPurchaseOrdersRequest
+	115, // 248: inventory.InventoryService.ReceivePurchaseOrder:input_type -> inventory.ReceivePurchaseOrderRequest
+	116, // 249: inventory.InventoryService.CancelPurchaseOrder:input_type -> inventory.CancelPurchaseOrderRequest
+	118, // 250: inventory.InventoryService.SubscribeBackInStock:input_type -> inventory.SubscribeBackInStockRequest
+	119, // 251: inventory.InventoryService.ListBackInStockSubscriptions:input_type -> inventory.ListBackInStockSubscriptionsRequest
+	121, // 252: inventory.InventoryService.DeleteBackInStockSubscription:input_type -> inventory.DeleteBackInStockSubscriptionRequest
+	124, // 253: inventory.InventoryService.ReceiveLot:input_type -> inventory.ReceiveLotRequest
+	125, // 254: inventory.InventoryService.ListLots:input_type -> inventory.ListLotsRequest
+	127, // 255: inventory.InventoryService.ListExpiringLots:input_type -> inventory.ListExpiringLotsRequest
+	130, // 256: inventory.InventoryService.SetInventoryUnit:input_type -> inventory.SetInventoryUnitRequest
+	131, // 257: inventory.InventoryService.ListInventoryUnits:input_type -> inventory.ListInventoryUnitsRequest
+	133, // 258: inventory.InventoryService.DeleteInventoryUnit:input_type -> inventory.DeleteInventoryUnitRequest
+	136, // 259: inventory.InventoryService.SetWarehouseBin:input_type -> inventory.SetWarehouseBinRequest
+	137, // 260: inventory.InventoryService.ListWarehouseBins:input_type -> inventory.ListWarehouseBinsRequest
+	139, // 261: inventory.InventoryService.DeleteWarehouseBin:input_type -> inventory.DeleteWarehouseBinRequest
+	142, // 262: inventory.InventoryService.SetBinStock:input_type -> inventory.SetBinStockRequest
+	143, // 263: inventory.InventoryService.ListBinStock:input_type -> inventory.ListBinStockRequest
+	146, // 264: inventory.InventoryService.GeneratePickList:input_type -> inventory.GeneratePickListRequest
+	151, // 265: inventory.InventoryService.CreateFulfillmentWave:input_type -> inventory.CreateFulfillmentWaveRequest
+	152, // 266: inventory.InventoryService.GetFulfillmentWave:input_type -> inventory.GetFulfillmentWaveRequest
+	153, // 267: inventory.InventoryService.ListFulfillmentWaves:input_type -> inventory.ListFulfillmentWavesRequest
+	155, // 268: inventory.InventoryService.GenerateWavePickList:input_type -> inventory.GenerateWavePickListRequest
+	157, // 269: inventory.InventoryService.CompleteFulfillmentWave:input_type -> inventory.CompleteFulfillmentWaveRequest
+	158, // 270: inventory.InventoryService.CancelFulfillmentWave:input_type -> inventory.CancelFulfillmentWaveRequest
+	161, // 271: inventory.InventoryService.RequestRefund:input_type -> inventory.RequestRefundRequest
+	162, // 272: inventory.InventoryService.GetRefund:input_type -> inventory.GetRefundRequest
+	163, // 273: inventory.InventoryService.ListRefunds:input_type -> inventory.ListRefundsRequest
+	165, // 274: inventory.InventoryService.ApproveRefund:input_type -> inventory.ApproveRefundRequest
+	166, // 275: inventory.InventoryService.RejectRefund:input_type -> inventory.RejectRefundRequest
+	167, // 276: inventory.InventoryService.RecordRefundResult:input_type -> inventory.RecordRefundResultRequest
+	169, // 277: inventory.InventoryService.ListRefundEvents:input_type -> inventory.ListRefundEventsRequest
+	173, // 278: inventory.InventoryService.ScreenOrder:input_type -> inventory.ScreenOrderRequest
+	174, // 279: inventory.InventoryService.GetFraudCheck:input_type -> inventory.GetFraudCheckRequest
+	175, // 280: inventory.InventoryService.ListFraudChecks:input_type -> inventory.ListFraudChecksRequest
+	177, // 281: inventory.InventoryService.ReviewFraudCheck:input_type -> inventory.ReviewFraudCheckRequest
+	179, // 282: inventory.InventoryService.ListFraudEvents:input_type -> inventory.ListFraudEventsRequest
+	183, // 283: inventory.InventoryService.SetRetailStore:input_type -> inventory.SetRetailStoreRequest
+	184, // 284: inventory.InventoryService.DeleteRetailStore:input_type -> inventory.DeleteRetailStoreRequest
+	186, // 285: inventory.InventoryService.ListRetailStores:input_type -> inventory.ListRetailStoresRequest
+	188, // 286: inventory.InventoryService.FindNearbyStores:input_type -> inventory.FindNearbyStoresRequest
+	191, // 287: inventory.InventoryService.ReserveInStore:input_type -> inventory.ReserveInStoreRequest
+	197, // 288: inventory.InventoryService.CreatePickupOrder:input_type -> inventory.CreatePickupOrderRequest
+	198, // 289: inventory.InventoryService.GetPickupOrder:input_type -> inventory.GetPickupOrderRequest
+	199, // 290: inventory.InventoryService.ListPickupOrders:input_type -> inventory.ListPickupOrdersRequest
+	201, // 291: inventory.InventoryService.StartPickupPicking:input_type -> inventory.PickupOrderRequest
+	201, // 292: inventory.InventoryService.MarkPickupReady:input_type -> inventory.PickupOrderRequest
+	201, // 293: inventory.InventoryService.CollectPickupOrder:input_type -> inventory.PickupOrderRequest
+	202, // 294: inventory.InventoryService.CancelPickupOrder:input_type -> inventory.CancelPickupOrderRequest
+	11,  // 295: inventory.InventoryService.CreateInventoryItem:output_type -> inventory.InventoryItemResponse
+	11,  // 296: inventory.InventoryService.GetInventoryItem:output_type -> inventory.InventoryItemResponse
+	11,  // 297: inventory.InventoryService.UpdateInventoryItem:output_type -> inventory.InventoryItemResponse
+	12,  // 298: inventory.InventoryService.ListInventoryItems:output_type -> inventory.ListInventoryItemsResponse
+	17,  // 299: inventory.InventoryService.CreateWarehouse:output_type -> inventory.WarehouseResponse
+	17,  // 300: inventory.InventoryService.GetWarehouse:output_type -> inventory.WarehouseResponse
+	17,  // 301: inventory.InventoryService.UpdateWarehouse:output_type -> inventory.WarehouseResponse
+	18,  // 302: inventory.InventoryService.ListWarehouses:output_type -> inventory.ListWarehousesResponse
+	23,  // 303: inventory.InventoryService.AddInventoryToLocation:output_type -> inventory.InventoryLocationResponse
+	23,  // 304: inventory.InventoryService.RemoveInventoryFromLocation:output_type -> inventory.InventoryLocationResponse
+	24,  // 305: inventory.InventoryService.GetInventoryByLocation:output_type -> inventory.ListInventoryLocationsResponse
+	23,  // 306: inventory.InventoryService.SetStockBuffers:output_type -> inventory.InventoryLocationResponse
+	29,  // 307: inventory.InventoryService.ReserveInventory:output_type -> inventory.ReservationResponse
+	29,  // 308: inventory.InventoryService.ConfirmReservation:output_type -> inventory.ReservationResponse
+	29,  // 309: inventory.InventoryService.CancelReservation:output_type -> inventory.ReservationResponse
+	32,  // 310: inventory.InventoryService.CheckInventoryAvailability:output_type -> inventory.InventoryAvailabilityResponse
+	36,  // 311: inventory.InventoryService.CheckAvailabilityBulk:output_type -> inventory.CheckAvailabilityBulkResponse
+	39,  // 312: inventory.InventoryService.GetAvailabilityPolicy:output_type -> inventory.AvailabilityPolicy
+	39,  // 313: inventory.InventoryService.SetAvailabilityPolicy:output_type -> inventory.AvailabilityPolicy
+	43,  // 314: inventory.InventoryService.DeleteAvailabilityPolicy:output_type -> inventory.DeleteAvailabilityPolicyResponse
+	46,  // 315: inventory.InventoryService.BulkUpdateInventory:output_type -> inventory.BulkUpdateInventoryResponse
+	50,  // 316: inventory.InventoryService.WatchInventory:output_type -> inventory.StockChangeEvent
+	52,  // 317: inventory.InventoryService.GetStockHistory:output_type -> inventory.StockHistoryResponse
+	60,  // 318: inventory.InventoryService.ListStockAlerts:output_type -> inventory.ListStockAlertsResponse
+	63,  // 319: inventory.InventoryService.ListInventoryActivity:output_type -> inventory.ListInventoryActivityResponse
+	55,  // 320: inventory.InventoryService.GetForecast:output_type -> inventory.GetForecastResponse
+	57,  // 321: inventory.InventoryService.ListReorderSuggestions:output_type -> inventory.ListReorderSuggestionsResponse
+	67,  // 322: inventory.InventoryService.GetDiagnostics:output_type -> inventory.DiagnosticsResponse
+	70,  // 323: inventory.InventoryService.CreateIntegrationKey:output_type -> inventory.CreateIntegrationKeyResponse
+	72,  // 324: inventory.InventoryService.ListIntegrationKeys:output_type -> inventory.ListIntegrationKeysResponse
+	74,  // 325: inventory.InventoryService.RevokeIntegrationKey:output_type -> inventory.IntegrationKeyResponse
+	74,  // 326: inventory.InventoryService.SetIntegrationKeyQuota:output_type -> inventory.IntegrationKeyResponse
+	77,  // 327: inventory.InventoryService.GetIntegrationQuota:output_type -> inventory.IntegrationQuota
+	82,  // 328: inventory.InventoryService.PushFulfillmentEvents:output_type -> inventory.PushFulfillmentEventsResponse
+	85,  // 329: inventory.InventoryService.ListOrderStatusEvents:output_type -> inventory.ListOrderStatusEventsResponse
+	87,  // 330: inventory.InventoryService.CreateShipment:output_type -> inventory.Shipment
+	90,  // 331: inventory.InventoryService.ListShipments:output_type -> inventory.ListShipmentsResponse
+	92,  // 332: inventory.InventoryService.GetShipmentStatus:output_type -> inventory.ShipmentStatusResponse
+	96,  // 333: inventory.InventoryService.ReceiveCarrierEvents:output_type -> inventory.ReceiveCarrierEventsResponse
+	98,  // 334: inventory.InventoryService.CreateSupplier:output_type -> inventory.Supplier
+	98,  // 335: inventory.InventoryService.UpdateSupplier:output_type -> inventory.Supplier
+	98,  // 336: inventory.InventoryService.GetSupplier:output_type -> inventory.Supplier
+	103, // 337: inventory.InventoryService.ListSuppliers:output_type -> inventory.ListSuppliersResponse
+	97,  // 338: inventory.InventoryService.SetSupplierProduct:output_type -> inventory.SupplierProduct
+	106, // 339: inventory.InventoryService.RemoveSupplierProduct:output_type -> inventory.RemoveSupplierProductResponse
+	108, // 340: inventory.InventoryService.CreatePurchaseOrder:output_type -> inventory.PurchaseOrder
+	108, // 341: inventory.InventoryService.GetPurchaseOrder:output_type -> inventory.PurchaseOrder
+	113, // 342: inventory.InventoryService.ListPurchaseOrders:output_type -> inventory.ListPurchaseOrdersResponse
+	108, // 343: inventory.InventoryService.ReceivePurchaseOrder:output_type -> inventory.PurchaseOrder
+	108, // 344: inventory.InventoryService.CancelPurchaseOrder:output_type -> inventory.PurchaseOrder
+	117, // 345: inventory.InventoryService.SubscribeBackInStock:output_type -> inventory.BackInStockSubscription
+	120, // 346: inventory.InventoryService.ListBackInStockSubscriptions:output_type -> inventory.ListBackInStockSubscriptionsResponse
+	122, // 347: inventory.InventoryService.DeleteBackInStockSubscription:output_type -> inventory.DeleteBackInStockSubscriptionResponse
+	123, // 348: inventory.InventoryService.ReceiveLot:output_type -> inventory.InventoryLot
+	126, // 349: inventory.InventoryService.ListLots:output_type -> inventory.ListLotsResponse
+	128, // 350: inventory.InventoryService.ListExpiringLots:output_type -> inventory.ListExpiringLotsResponse
+	129, // 351: inventory.InventoryService.SetInventoryUnit:output_type -> inventory.InventoryUnit
+	132, // 352: inventory.InventoryService.ListInventoryUnits:output_type -> inventory.ListInventoryUnitsResponse
+	134, // 353: inventory.InventoryService.DeleteInventoryUnit:output_type -> inventory.DeleteInventoryUnitResponse
+	135, // 354: inventory.InventoryService.SetWarehouseBin:output_type -> inventory.WarehouseBin
+	138, // 355: inventory.InventoryService.ListWarehouseBins:output_type -> inventory.ListWarehouseBinsResponse
+	140, // 356: inventory.InventoryService.DeleteWarehouseBin:output_type -> inventory.DeleteWarehouseBinResponse
+	141, // 357: inventory.InventoryService.SetBinStock:output_type -> inventory.BinStock
+	144, // 358: inventory.InventoryService.ListBinStock:output_type -> inventory.ListBinStockResponse
+	148, // 359: inventory.InventoryService.GeneratePickList:output_type -> inventory.PickList
+	149, // 360: inventory.InventoryService.CreateFulfillmentWave:output_type -> inventory.FulfillmentWave
+	149, // 361: inventory.InventoryService.GetFulfillmentWave:output_type -> inventory.FulfillmentWave
+	154, // 362: inventory.InventoryService.ListFulfillmentWaves:output_type -> inventory.ListFulfillmentWavesResponse
+	148, // 363: inventory.InventoryService.GenerateWavePickList:output_type -> inventory.PickList
+	149, // 364: inventory.InventoryService.CompleteFulfillmentWave:output_type -> inventory.FulfillmentWave
+	149, // 365: inventory.InventoryService.CancelFulfillmentWave:output_type -> inventory.FulfillmentWave
+	159, // 366: inventory.InventoryService.RequestRefund:output_type -> inventory.Refund
+	159, // 367: inventory.InventoryService.GetRefund:output_type -> inventory.Refund
+	164, // 368: inventory.InventoryService.ListRefunds:output_type -> inventory.ListRefundsResponse
+	159, // 369: inventory.InventoryService.ApproveRefund:output_type -> inventory.Refund
+	159, // 370: inventory.InventoryService.RejectRefund:output_type -> inventory.Refund
+	159, // 371: inventory.InventoryService.RecordRefundResult:output_type -> inventory.Refund
+	170, // 372: inventory.InventoryService.ListRefundEvents:output_type -> inventory.ListRefundEventsResponse
+	171, // 373: inventory.InventoryService.ScreenOrder:output_type -> inventory.FraudCheck
+	171, // 374: inventory.InventoryService.GetFraudCheck:output_type -> inventory.FraudCheck
+	176, // 375: inventory.InventoryService.ListFraudChecks:output_type -> inventory.ListFraudChecksResponse
+	171, // 376: inventory.InventoryService.ReviewFraudCheck:output_type -> inventory.FraudCheck
+	180, // 377: inventory.InventoryService.ListFraudEvents:output_type -> inventory.ListFraudEventsResponse
+	182, // 378: inventory.InventoryService.SetRetailStore:output_type -> inventory.RetailStore
+	185, // 379: inventory.InventoryService.DeleteRetailStore:output_type -> inventory.DeleteRetailStoreResponse
+	187, // 380: inventory.InventoryService.ListRetailStores:output_type -> inventory.ListRetailStoresResponse
+	190, // 381: inventory.InventoryService.FindNearbyStores:output_type -> inventory.FindNearbyStoresResponse
+	192, // 382: inventory.InventoryService.ReserveInStore:output_type -> inventory.ReserveInStoreResponse
+	195, // 383: inventory.InventoryService.CreatePickupOrder:output_type -> inventory.PickupOrder
+	195, // 384: inventory.InventoryService.GetPickupOrder:output_type -> inventory.PickupOrder
+	200, // 385: inventory.InventoryService.ListPickupOrders:output_type -> inventory.ListPickupOrdersResponse
+	195, // 386: inventory.InventoryService.StartPickupPicking:output_type -> inventory.PickupOrder
+	195, // 387: inventory.InventoryService.MarkPickupReady:output_type -> inventory.PickupOrder
+	195, // 388: inventory.InventoryService.CollectPickupOrder:output_type -> inventory.PickupOrder
+	195, // 389: inventory.InventoryService.CancelPickupOrder:output_type -> inventory.PickupOrder
+	295, // [295:390] is the sub-list for method output_type
+	200, // [200:295] is the sub-list for method input_type
+	200, // [200:200] is the sub-list for extension type_name
+	200, // [200:200] is the sub-list for extension extendee
+	0,   // [0:200] is the sub-list for field type_name
 }
 
 func init() { file_proto_inventory_proto_init() }
@@ -3095,22 +16443,32 @@ func file_proto_inventory_proto_init() {
 	if File_proto_inventory_proto != nil {
 		return
 	}
-	file_proto_inventory_proto_msgTypes[7].OneofWrappers = []any{
+	file_proto_inventory_proto_msgTypes[8].OneofWrappers = []any{
 		(*GetInventoryItemRequest_Id)(nil),
 		(*GetInventoryItemRequest_ProductId)(nil),
 		(*GetInventoryItemRequest_Sku)(nil),
 	}
-	file_proto_inventory_proto_msgTypes[13].OneofWrappers = []any{
+	file_proto_inventory_proto_msgTypes[14].OneofWrappers = []any{
 		(*GetWarehouseRequest_Id)(nil),
 		(*GetWarehouseRequest_Code)(nil),
 	}
+	file_proto_inventory_proto_msgTypes[51].OneofWrappers = []any{
+		(*GetStockHistoryRequest_Id)(nil),
+		(*GetStockHistoryRequest_ProductId)(nil),
+		(*GetStockHistoryRequest_Sku)(nil),
+	}
+	file_proto_inventory_proto_msgTypes[53].OneofWrappers = []any{
+		(*GetForecastRequest_Id)(nil),
+		(*GetForecastRequest_ProductId)(nil),
+		(*GetForecastRequest_Sku)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_inventory_proto_rawDesc), len(file_proto_inventory_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   36,
+			NumMessages:   203,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,22 +19,101 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_CreateInventoryItem_FullMethodName         = "/inventory.InventoryService/CreateInventoryItem"
-	InventoryService_GetInventoryItem_FullMethodName            = "/inventory.InventoryService/GetInventoryItem"
-	InventoryService_UpdateInventoryItem_FullMethodName         = "/inventory.InventoryService/UpdateInventoryItem"
-	InventoryService_ListInventoryItems_FullMethodName          = "/inventory.InventoryService/ListInventoryItems"
-	InventoryService_CreateWarehouse_FullMethodName             = "/inventory.InventoryService/CreateWarehouse"
-	InventoryService_GetWarehouse_FullMethodName                = "/inventory.InventoryService/GetWarehouse"
-	InventoryService_UpdateWarehouse_FullMethodName             = "/inventory.InventoryService/UpdateWarehouse"
-	InventoryService_ListWarehouses_FullMethodName              = "/inventory.InventoryService/ListWarehouses"
-	InventoryService_AddInventoryToLocation_FullMethodName      = "/inventory.InventoryService/AddInventoryToLocation"
-	InventoryService_RemoveInventoryFromLocation_FullMethodName = "/inventory.InventoryService/RemoveInventoryFromLocation"
-	InventoryService_GetInventoryByLocation_FullMethodName      = "/inventory.InventoryService/GetInventoryByLocation"
-	InventoryService_ReserveInventory_FullMethodName            = "/inventory.InventoryService/ReserveInventory"
-	InventoryService_ConfirmReservation_FullMethodName          = "/inventory.InventoryService/ConfirmReservation"
-	InventoryService_CancelReservation_FullMethodName           = "/inventory.InventoryService/CancelReservation"
-	InventoryService_CheckInventoryAvailability_FullMethodName  = "/inventory.InventoryService/CheckInventoryAvailability"
-	InventoryService_BulkUpdateInventory_FullMethodName         = "/inventory.InventoryService/BulkUpdateInventory"
+	InventoryService_CreateInventoryItem_FullMethodName           = "/inventory.InventoryService/CreateInventoryItem"
+	InventoryService_GetInventoryItem_FullMethodName              = "/inventory.InventoryService/GetInventoryItem"
+	InventoryService_UpdateInventoryItem_FullMethodName           = "/inventory.InventoryService/UpdateInventoryItem"
+	InventoryService_ListInventoryItems_FullMethodName            = "/inventory.InventoryService/ListInventoryItems"
+	InventoryService_CreateWarehouse_FullMethodName               = "/inventory.InventoryService/CreateWarehouse"
+	InventoryService_GetWarehouse_FullMethodName                  = "/inventory.InventoryService/GetWarehouse"
+	InventoryService_UpdateWarehouse_FullMethodName               = "/inventory.InventoryService/UpdateWarehouse"
+	InventoryService_ListWarehouses_FullMethodName                = "/inventory.InventoryService/ListWarehouses"
+	InventoryService_AddInventoryToLocation_FullMethodName        = "/inventory.InventoryService/AddInventoryToLocation"
+	InventoryService_RemoveInventoryFromLocation_FullMethodName   = "/inventory.InventoryService/RemoveInventoryFromLocation"
+	InventoryService_GetInventoryByLocation_FullMethodName        = "/inventory.InventoryService/GetInventoryByLocation"
+	InventoryService_SetStockBuffers_FullMethodName               = "/inventory.InventoryService/SetStockBuffers"
+	InventoryService_ReserveInventory_FullMethodName              = "/inventory.InventoryService/ReserveInventory"
+	InventoryService_ConfirmReservation_FullMethodName            = "/inventory.InventoryService/ConfirmReservation"
+	InventoryService_CancelReservation_FullMethodName             = "/inventory.InventoryService/CancelReservation"
+	InventoryService_CheckInventoryAvailability_FullMethodName    = "/inventory.InventoryService/CheckInventoryAvailability"
+	InventoryService_CheckAvailabilityBulk_FullMethodName         = "/inventory.InventoryService/CheckAvailabilityBulk"
+	InventoryService_GetAvailabilityPolicy_FullMethodName         = "/inventory.InventoryService/GetAvailabilityPolicy"
+	InventoryService_SetAvailabilityPolicy_FullMethodName         = "/inventory.InventoryService/SetAvailabilityPolicy"
+	InventoryService_DeleteAvailabilityPolicy_FullMethodName      = "/inventory.InventoryService/DeleteAvailabilityPolicy"
+	InventoryService_BulkUpdateInventory_FullMethodName           = "/inventory.InventoryService/BulkUpdateInventory"
+	InventoryService_WatchInventory_FullMethodName                = "/inventory.InventoryService/WatchInventory"
+	InventoryService_GetStockHistory_FullMethodName               = "/inventory.InventoryService/GetStockHistory"
+	InventoryService_ListStockAlerts_FullMethodName               = "/inventory.InventoryService/ListStockAlerts"
+	InventoryService_ListInventoryActivity_FullMethodName         = "/inventory.InventoryService/ListInventoryActivity"
+	InventoryService_GetForecast_FullMethodName                   = "/inventory.InventoryService/GetForecast"
+	InventoryService_ListReorderSuggestions_FullMethodName        = "/inventory.InventoryService/ListReorderSuggestions"
+	InventoryService_GetDiagnostics_FullMethodName                = "/inventory.InventoryService/GetDiagnostics"
+	InventoryService_CreateIntegrationKey_FullMethodName          = "/inventory.InventoryService/CreateIntegrationKey"
+	InventoryService_ListIntegrationKeys_FullMethodName           = "/inventory.InventoryService/ListIntegrationKeys"
+	InventoryService_RevokeIntegrationKey_FullMethodName          = "/inventory.InventoryService/RevokeIntegrationKey"
+	InventoryService_SetIntegrationKeyQuota_FullMethodName        = "/inventory.InventoryService/SetIntegrationKeyQuota"
+	InventoryService_GetIntegrationQuota_FullMethodName           = "/inventory.InventoryService/GetIntegrationQuota"
+	InventoryService_PushFulfillmentEvents_FullMethodName         = "/inventory.InventoryService/PushFulfillmentEvents"
+	InventoryService_ListOrderStatusEvents_FullMethodName         = "/inventory.InventoryService/ListOrderStatusEvents"
+	InventoryService_CreateShipment_FullMethodName                = "/inventory.InventoryService/CreateShipment"
+	InventoryService_ListShipments_FullMethodName                 = "/inventory.InventoryService/ListShipments"
+	InventoryService_GetShipmentStatus_FullMethodName             = "/inventory.InventoryService/GetShipmentStatus"
+	InventoryService_ReceiveCarrierEvents_FullMethodName          = "/inventory.InventoryService/ReceiveCarrierEvents"
+	InventoryService_CreateSupplier_FullMethodName                = "/inventory.InventoryService/CreateSupplier"
+	InventoryService_UpdateSupplier_FullMethodName                = "/inventory.InventoryService/UpdateSupplier"
+	InventoryService_GetSupplier_FullMethodName                   = "/inventory.InventoryService/GetSupplier"
+	InventoryService_ListSuppliers_FullMethodName                 = "/inventory.InventoryService/ListSuppliers"
+	InventoryService_SetSupplierProduct_FullMethodName            = "/inventory.InventoryService/SetSupplierProduct"
+	InventoryService_RemoveSupplierProduct_FullMethodName         = "/inventory.InventoryService/RemoveSupplierProduct"
+	InventoryService_CreatePurchaseOrder_FullMethodName           = "/inventory.InventoryService/CreatePurchaseOrder"
+	InventoryService_GetPurchaseOrder_FullMethodName              = "/inventory.InventoryService/GetPurchaseOrder"
+	InventoryService_ListPurchaseOrders_FullMethodName            = "/inventory.InventoryService/ListPurchaseOrders"
+	InventoryService_ReceivePurchaseOrder_FullMethodName          = "/inventory.InventoryService/ReceivePurchaseOrder"
+	InventoryService_CancelPurchaseOrder_FullMethodName           = "/inventory.InventoryService/CancelPurchaseOrder"
+	InventoryService_SubscribeBackInStock_FullMethodName          = "/inventory.InventoryService/SubscribeBackInStock"
+	InventoryService_ListBackInStockSubscriptions_FullMethodName  = "/inventory.InventoryService/ListBackInStockSubscriptions"
+	InventoryService_DeleteBackInStockSubscription_FullMethodName = "/inventory.InventoryService/DeleteBackInStockSubscription"
+	InventoryService_ReceiveLot_FullMethodName                    = "/inventory.InventoryService/ReceiveLot"
+	InventoryService_ListLots_FullMethodName                      = "/inventory.InventoryService/ListLots"
+	InventoryService_ListExpiringLots_FullMethodName              = "/inventory.InventoryService/ListExpiringLots"
+	InventoryService_SetInventoryUnit_FullMethodName              = "/inventory.InventoryService/SetInventoryUnit"
+	InventoryService_ListInventoryUnits_FullMethodName            = "/inventory.InventoryService/ListInventoryUnits"
+	InventoryService_DeleteInventoryUnit_FullMethodName           = "/inventory.InventoryService/DeleteInventoryUnit"
+	InventoryService_SetWarehouseBin_FullMethodName               = "/inventory.InventoryService/SetWarehouseBin"
+	InventoryService_ListWarehouseBins_FullMethodName             = "/inventory.InventoryService/ListWarehouseBins"
+	InventoryService_DeleteWarehouseBin_FullMethodName            = "/inventory.InventoryService/DeleteWarehouseBin"
+	InventoryService_SetBinStock_FullMethodName                   = "/inventory.InventoryService/SetBinStock"
+	InventoryService_ListBinStock_FullMethodName                  = "/inventory.InventoryService/ListBinStock"
+	InventoryService_GeneratePickList_FullMethodName              = "/inventory.InventoryService/GeneratePickList"
+	InventoryService_CreateFulfillmentWave_FullMethodName         = "/inventory.InventoryService/CreateFulfillmentWave"
+	InventoryService_GetFulfillmentWave_FullMethodName            = "/inventory.InventoryService/GetFulfillmentWave"
+	InventoryService_ListFulfillmentWaves_FullMethodName          = "/inventory.InventoryService/ListFulfillmentWaves"
+	InventoryService_GenerateWavePickList_FullMethodName          = "/inventory.InventoryService/GenerateWavePickList"
+	InventoryService_CompleteFulfillmentWave_FullMethodName       = "/inventory.InventoryService/CompleteFulfillmentWave"
+	InventoryService_CancelFulfillmentWave_FullMethodName         = "/inventory.InventoryService/CancelFulfillmentWave"
+	InventoryService_RequestRefund_FullMethodName                 = "/inventory.InventoryService/RequestRefund"
+	InventoryService_GetRefund_FullMethodName                     = "/inventory.InventoryService/GetRefund"
+	InventoryService_ListRefunds_FullMethodName                   = "/inventory.InventoryService/ListRefunds"
+	InventoryService_ApproveRefund_FullMethodName                 = "/inventory.InventoryService/ApproveRefund"
+	InventoryService_RejectRefund_FullMethodName                  = "/inventory.InventoryService/RejectRefund"
+	InventoryService_RecordRefundResult_FullMethodName            = "/inventory.InventoryService/RecordRefundResult"
+	InventoryService_ListRefundEvents_FullMethodName              = "/inventory.InventoryService/ListRefundEvents"
+	InventoryService_ScreenOrder_FullMethodName                   = "/inventory.InventoryService/ScreenOrder"
+	InventoryService_GetFraudCheck_FullMethodName                 = "/inventory.InventoryService/GetFraudCheck"
+	InventoryService_ListFraudChecks_FullMethodName               = "/inventory.InventoryService/ListFraudChecks"
+	InventoryService_ReviewFraudCheck_FullMethodName              = "/inventory.InventoryService/ReviewFraudCheck"
+	InventoryService_ListFraudEvents_FullMethodName               = "/inventory.InventoryService/ListFraudEvents"
+	InventoryService_SetRetailStore_FullMethodName                = "/inventory.InventoryService/SetRetailStore"
+	InventoryService_DeleteRetailStore_FullMethodName             = "/inventory.InventoryService/DeleteRetailStore"
+	InventoryService_ListRetailStores_FullMethodName              = "/inventory.InventoryService/ListRetailStores"
+	InventoryService_FindNearbyStores_FullMethodName              = "/inventory.InventoryService/FindNearbyStores"
+	InventoryService_ReserveInStore_FullMethodName                = "/inventory.InventoryService/ReserveInStore"
+	InventoryService_CreatePickupOrder_FullMethodName             = "/inventory.InventoryService/CreatePickupOrder"
+	InventoryService_GetPickupOrder_FullMethodName                = "/inventory.InventoryService/GetPickupOrder"
+	InventoryService_ListPickupOrders_FullMethodName              = "/inventory.InventoryService/ListPickupOrders"
+	InventoryService_StartPickupPicking_FullMethodName            = "/inventory.InventoryService/StartPickupPicking"
+	InventoryService_MarkPickupReady_FullMethodName               = "/inventory.InventoryService/MarkPickupReady"
+	InventoryService_CollectPickupOrder_FullMethodName            = "/inventory.InventoryService/CollectPickupOrder"
+	InventoryService_CancelPickupOrder_FullMethodName             = "/inventory.InventoryService/CancelPickupOrder"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -55,14 +134,122 @@ type InventoryServiceClient interface {
 	AddInventoryToLocation(ctx context.Context, in *AddInventoryToLocationRequest, opts ...grpc.CallOption) (*InventoryLocationResponse, error)
 	RemoveInventoryFromLocation(ctx context.Context, in *RemoveInventoryFromLocationRequest, opts ...grpc.CallOption) (*InventoryLocationResponse, error)
 	GetInventoryByLocation(ctx context.Context, in *GetInventoryByLocationRequest, opts ...grpc.CallOption) (*ListInventoryLocationsResponse, error)
+	SetStockBuffers(ctx context.Context, in *SetStockBuffersRequest, opts ...grpc.CallOption) (*InventoryLocationResponse, error)
 	// Reservation operations
 	ReserveInventory(ctx context.Context, in *ReserveInventoryRequest, opts ...grpc.CallOption) (*ReservationResponse, error)
 	ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*ReservationResponse, error)
 	CancelReservation(ctx context.Context, in *CancelReservationRequest, opts ...grpc.CallOption) (*ReservationResponse, error)
 	// Inventory check operations
 	CheckInventoryAvailability(ctx context.Context, in *CheckInventoryAvailabilityRequest, opts ...grpc.CallOption) (*InventoryAvailabilityResponse, error)
+	CheckAvailabilityBulk(ctx context.Context, in *CheckAvailabilityBulkRequest, opts ...grpc.CallOption) (*CheckAvailabilityBulkResponse, error)
+	// Availability thresholds of the stock badges of products
+	GetAvailabilityPolicy(ctx context.Context, in *GetAvailabilityPolicyRequest, opts ...grpc.CallOption) (*AvailabilityPolicy, error)
+	SetAvailabilityPolicy(ctx context.Context, in *SetAvailabilityPolicyRequest, opts ...grpc.CallOption) (*AvailabilityPolicy, error)
+	DeleteAvailabilityPolicy(ctx context.Context, in *DeleteAvailabilityPolicyRequest, opts ...grpc.CallOption) (*DeleteAvailabilityPolicyResponse, error)
 	// Bulk operations
 	BulkUpdateInventory(ctx context.Context, in *BulkUpdateInventoryRequest, opts ...grpc.CallOption) (*BulkUpdateInventoryResponse, error)
+	// Streaming operations
+	WatchInventory(ctx context.Context, in *WatchInventoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StockChangeEvent], error)
+	// Reporting operations
+	GetStockHistory(ctx context.Context, in *GetStockHistoryRequest, opts ...grpc.CallOption) (*StockHistoryResponse, error)
+	ListStockAlerts(ctx context.Context, in *ListStockAlertsRequest, opts ...grpc.CallOption) (*ListStockAlertsResponse, error)
+	// Recent stock movements of the store, newest first, for the admin activity feed
+	ListInventoryActivity(ctx context.Context, in *ListInventoryActivityRequest, opts ...grpc.CallOption) (*ListInventoryActivityResponse, error)
+	// Stock-out dates and reorder quantities forecast nightly from sales velocity
+	GetForecast(ctx context.Context, in *GetForecastRequest, opts ...grpc.CallOption) (*GetForecastResponse, error)
+	ListReorderSuggestions(ctx context.Context, in *ListReorderSuggestionsRequest, opts ...grpc.CallOption) (*ListReorderSuggestionsResponse, error)
+	// Diagnostics
+	GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*DiagnosticsResponse, error)
+	// Fulfillment provider (3PL) integration
+	CreateIntegrationKey(ctx context.Context, in *CreateIntegrationKeyRequest, opts ...grpc.CallOption) (*CreateIntegrationKeyResponse, error)
+	ListIntegrationKeys(ctx context.Context, in *ListIntegrationKeysRequest, opts ...grpc.CallOption) (*ListIntegrationKeysResponse, error)
+	RevokeIntegrationKey(ctx context.Context, in *RevokeIntegrationKeyRequest, opts ...grpc.CallOption) (*IntegrationKeyResponse, error)
+	SetIntegrationKeyQuota(ctx context.Context, in *SetIntegrationKeyQuotaRequest, opts ...grpc.CallOption) (*IntegrationKeyResponse, error)
+	GetIntegrationQuota(ctx context.Context, in *GetIntegrationQuotaRequest, opts ...grpc.CallOption) (*IntegrationQuota, error)
+	PushFulfillmentEvents(ctx context.Context, in *PushFulfillmentEventsRequest, opts ...grpc.CallOption) (*PushFulfillmentEventsResponse, error)
+	ListOrderStatusEvents(ctx context.Context, in *ListOrderStatusEventsRequest, opts ...grpc.CallOption) (*ListOrderStatusEventsResponse, error)
+	// Shipment tracking
+	CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*Shipment, error)
+	ListShipments(ctx context.Context, in *ListShipmentsRequest, opts ...grpc.CallOption) (*ListShipmentsResponse, error)
+	GetShipmentStatus(ctx context.Context, in *GetShipmentStatusRequest, opts ...grpc.CallOption) (*ShipmentStatusResponse, error)
+	ReceiveCarrierEvents(ctx context.Context, in *ReceiveCarrierEventsRequest, opts ...grpc.CallOption) (*ReceiveCarrierEventsResponse, error)
+	// Suppliers and purchase orders
+	CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*Supplier, error)
+	UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, opts ...grpc.CallOption) (*Supplier, error)
+	GetSupplier(ctx context.Context, in *GetSupplierRequest, opts ...grpc.CallOption) (*Supplier, error)
+	ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error)
+	SetSupplierProduct(ctx context.Context, in *SetSupplierProductRequest, opts ...grpc.CallOption) (*SupplierProduct, error)
+	RemoveSupplierProduct(ctx context.Context, in *RemoveSupplierProductRequest, opts ...grpc.CallOption) (*RemoveSupplierProductResponse, error)
+	CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error)
+	ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	CancelPurchaseOrder(ctx context.Context, in *CancelPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error)
+	// Back-in-stock email subscriptions
+	SubscribeBackInStock(ctx context.Context, in *SubscribeBackInStockRequest, opts ...grpc.CallOption) (*BackInStockSubscription, error)
+	ListBackInStockSubscriptions(ctx context.Context, in *ListBackInStockSubscriptionsRequest, opts ...grpc.CallOption) (*ListBackInStockSubscriptionsResponse, error)
+	DeleteBackInStockSubscription(ctx context.Context, in *DeleteBackInStockSubscriptionRequest, opts ...grpc.CallOption) (*DeleteBackInStockSubscriptionResponse, error)
+	// Lots of perishable goods; reservations are allocated from the lots that
+	// expire first, and expired lots are written off automatically
+	ReceiveLot(ctx context.Context, in *ReceiveLotRequest, opts ...grpc.CallOption) (*InventoryLot, error)
+	ListLots(ctx context.Context, in *ListLotsRequest, opts ...grpc.CallOption) (*ListLotsResponse, error)
+	ListExpiringLots(ctx context.Context, in *ListExpiringLotsRequest, opts ...grpc.CallOption) (*ListExpiringLotsResponse, error)
+	// Units of measure, such as a case of 12, items are received, moved and
+	// sold in; stock is always kept in base units (each)
+	SetInventoryUnit(ctx context.Context, in *SetInventoryUnitRequest, opts ...grpc.CallOption) (*InventoryUnit, error)
+	ListInventoryUnits(ctx context.Context, in *ListInventoryUnitsRequest, opts ...grpc.CallOption) (*ListInventoryUnitsResponse, error)
+	DeleteInventoryUnit(ctx context.Context, in *DeleteInventoryUnitRequest, opts ...grpc.CallOption) (*DeleteInventoryUnitResponse, error)
+	// Bins of warehouses, addressed by zone, aisle, shelf and bin, stock placed
+	// in them and pick lists walking them in path order
+	SetWarehouseBin(ctx context.Context, in *SetWarehouseBinRequest, opts ...grpc.CallOption) (*WarehouseBin, error)
+	ListWarehouseBins(ctx context.Context, in *ListWarehouseBinsRequest, opts ...grpc.CallOption) (*ListWarehouseBinsResponse, error)
+	DeleteWarehouseBin(ctx context.Context, in *DeleteWarehouseBinRequest, opts ...grpc.CallOption) (*DeleteWarehouseBinResponse, error)
+	SetBinStock(ctx context.Context, in *SetBinStockRequest, opts ...grpc.CallOption) (*BinStock, error)
+	ListBinStock(ctx context.Context, in *ListBinStockRequest, opts ...grpc.CallOption) (*ListBinStockResponse, error)
+	GeneratePickList(ctx context.Context, in *GeneratePickListRequest, opts ...grpc.CallOption) (*PickList, error)
+	// Waves batching the confirmed orders of a warehouse up to a carrier
+	// cutoff, picked together along one consolidated pick list
+	CreateFulfillmentWave(ctx context.Context, in *CreateFulfillmentWaveRequest, opts ...grpc.CallOption) (*FulfillmentWave, error)
+	GetFulfillmentWave(ctx context.Context, in *GetFulfillmentWaveRequest, opts ...grpc.CallOption) (*FulfillmentWave, error)
+	ListFulfillmentWaves(ctx context.Context, in *ListFulfillmentWavesRequest, opts ...grpc.CallOption) (*ListFulfillmentWavesResponse, error)
+	GenerateWavePickList(ctx context.Context, in *GenerateWavePickListRequest, opts ...grpc.CallOption) (*PickList, error)
+	CompleteFulfillmentWave(ctx context.Context, in *CompleteFulfillmentWaveRequest, opts ...grpc.CallOption) (*FulfillmentWave, error)
+	CancelFulfillmentWave(ctx context.Context, in *CancelFulfillmentWaveRequest, opts ...grpc.CallOption) (*FulfillmentWave, error)
+	// Refunds of the payments of orders: requested and approved or rejected by
+	// staff, then processed or failed by the payment system. Status changes
+	// are read as refund events by the notification and accounting exports.
+	RequestRefund(ctx context.Context, in *RequestRefundRequest, opts ...grpc.CallOption) (*Refund, error)
+	GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*Refund, error)
+	ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error)
+	ApproveRefund(ctx context.Context, in *ApproveRefundRequest, opts ...grpc.CallOption) (*Refund, error)
+	RejectRefund(ctx context.Context, in *RejectRefundRequest, opts ...grpc.CallOption) (*Refund, error)
+	RecordRefundResult(ctx context.Context, in *RecordRefundResultRequest, opts ...grpc.CallOption) (*Refund, error)
+	ListRefundEvents(ctx context.Context, in *ListRefundEventsRequest, opts ...grpc.CallOption) (*ListRefundEventsResponse, error)
+	// Fraud screening of orders at checkout: approved, declined or sent to the
+	// review queue of staff. Decisions are read as fraud events by the order
+	// system and external fraud providers.
+	ScreenOrder(ctx context.Context, in *ScreenOrderRequest, opts ...grpc.CallOption) (*FraudCheck, error)
+	GetFraudCheck(ctx context.Context, in *GetFraudCheckRequest, opts ...grpc.CallOption) (*FraudCheck, error)
+	ListFraudChecks(ctx context.Context, in *ListFraudChecksRequest, opts ...grpc.CallOption) (*ListFraudChecksResponse, error)
+	ReviewFraudCheck(ctx context.Context, in *ReviewFraudCheckRequest, opts ...grpc.CallOption) (*FraudCheck, error)
+	ListFraudEvents(ctx context.Context, in *ListFraudEventsRequest, opts ...grpc.CallOption) (*ListFraudEventsResponse, error)
+	// Retail stores, the warehouses customers can visit: the stores near a
+	// position with the stock of a product at each, and stock reserved at a
+	// store for in-store pickup
+	SetRetailStore(ctx context.Context, in *SetRetailStoreRequest, opts ...grpc.CallOption) (*RetailStore, error)
+	DeleteRetailStore(ctx context.Context, in *DeleteRetailStoreRequest, opts ...grpc.CallOption) (*DeleteRetailStoreResponse, error)
+	ListRetailStores(ctx context.Context, in *ListRetailStoresRequest, opts ...grpc.CallOption) (*ListRetailStoresResponse, error)
+	FindNearbyStores(ctx context.Context, in *FindNearbyStoresRequest, opts ...grpc.CallOption) (*FindNearbyStoresResponse, error)
+	ReserveInStore(ctx context.Context, in *ReserveInStoreRequest, opts ...grpc.CallOption) (*ReserveInStoreResponse, error)
+	// Click-and-collect orders: placed online with their items reserved at a
+	// store, picked and made ready by the store, then collected or cancelled
+	CreatePickupOrder(ctx context.Context, in *CreatePickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error)
+	GetPickupOrder(ctx context.Context, in *GetPickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error)
+	ListPickupOrders(ctx context.Context, in *ListPickupOrdersRequest, opts ...grpc.CallOption) (*ListPickupOrdersResponse, error)
+	StartPickupPicking(ctx context.Context, in *PickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error)
+	MarkPickupReady(ctx context.Context, in *PickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error)
+	CollectPickupOrder(ctx context.Context, in *PickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error)
+	CancelPickupOrder(ctx context.Context, in *CancelPickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error)
 }
 
 type inventoryServiceClient struct {
@@ -183,6 +370,16 @@ func (c *inventoryServiceClient) GetInventoryByLocation(ctx context.Context, in
 	return out, nil
 }
 
+func (c *inventoryServiceClient) SetStockBuffers(ctx context.Context, in *SetStockBuffersRequest, opts ...grpc.CallOption) (*InventoryLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InventoryLocationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetStockBuffers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) ReserveInventory(ctx context.Context, in *ReserveInventoryRequest, opts ...grpc.CallOption) (*ReservationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ReservationResponse)
@@ -223,6 +420,46 @@ func (c *inventoryServiceClient) CheckInventoryAvailability(ctx context.Context,
 	return out, nil
 }
 
+func (c *inventoryServiceClient) CheckAvailabilityBulk(ctx context.Context, in *CheckAvailabilityBulkRequest, opts ...grpc.CallOption) (*CheckAvailabilityBulkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckAvailabilityBulkResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CheckAvailabilityBulk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetAvailabilityPolicy(ctx context.Context, in *GetAvailabilityPolicyRequest, opts ...grpc.CallOption) (*AvailabilityPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityPolicy)
+	err := c.cc.Invoke(ctx, InventoryService_GetAvailabilityPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetAvailabilityPolicy(ctx context.Context, in *SetAvailabilityPolicyRequest, opts ...grpc.CallOption) (*AvailabilityPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AvailabilityPolicy)
+	err := c.cc.Invoke(ctx, InventoryService_SetAvailabilityPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteAvailabilityPolicy(ctx context.Context, in *DeleteAvailabilityPolicyRequest, opts ...grpc.CallOption) (*DeleteAvailabilityPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAvailabilityPolicyResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteAvailabilityPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *inventoryServiceClient) BulkUpdateInventory(ctx context.Context, in *BulkUpdateInventoryRequest, opts ...grpc.CallOption) (*BulkUpdateInventoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(BulkUpdateInventoryResponse)
@@ -233,395 +470,2904 @@ func (c *inventoryServiceClient) BulkUpdateInventory(ctx context.Context, in *Bu
 	return out, nil
 }
 
-// InventoryServiceServer is the server API for InventoryService service.
-// All implementations must embed UnimplementedInventoryServiceServer
-// for forward compatibility.
-type InventoryServiceServer interface {
-	// Product inventory management
-	CreateInventoryItem(context.Context, *CreateInventoryItemRequest) (*InventoryItemResponse, error)
-	GetInventoryItem(context.Context, *GetInventoryItemRequest) (*InventoryItemResponse, error)
-	UpdateInventoryItem(context.Context, *UpdateInventoryItemRequest) (*InventoryItemResponse, error)
-	ListInventoryItems(context.Context, *ListInventoryItemsRequest) (*ListInventoryItemsResponse, error)
-	// Warehouse operations
-	CreateWarehouse(context.Context, *CreateWarehouseRequest) (*WarehouseResponse, error)
-	GetWarehouse(context.Context, *GetWarehouseRequest) (*WarehouseResponse, error)
-	UpdateWarehouse(context.Context, *UpdateWarehouseRequest) (*WarehouseResponse, error)
-	ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error)
-	// Inventory location operations
-	AddInventoryToLocation(context.Context, *AddInventoryToLocationRequest) (*InventoryLocationResponse, error)
-	RemoveInventoryFromLocation(context.Context, *RemoveInventoryFromLocationRequest) (*InventoryLocationResponse, error)
-	GetInventoryByLocation(context.Context, *GetInventoryByLocationRequest) (*ListInventoryLocationsResponse, error)
-	// Reservation operations
-	ReserveInventory(context.Context, *ReserveInventoryRequest) (*ReservationResponse, error)
-	ConfirmReservation(context.Context, *ConfirmReservationRequest) (*ReservationResponse, error)
-	CancelReservation(context.Context, *CancelReservationRequest) (*ReservationResponse, error)
-	// Inventory check operations
-	CheckInventoryAvailability(context.Context, *CheckInventoryAvailabilityRequest) (*InventoryAvailabilityResponse, error)
-	// Bulk operations
-	BulkUpdateInventory(context.Context, *BulkUpdateInventoryRequest) (*BulkUpdateInventoryResponse, error)
-	mustEmbedUnimplementedInventoryServiceServer()
+func (c *inventoryServiceClient) WatchInventory(ctx context.Context, in *WatchInventoryRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[StockChangeEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_WatchInventory_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchInventoryRequest, StockChangeEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-// UnimplementedInventoryServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedInventoryServiceServer struct{}
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchInventoryClient = grpc.ServerStreamingClient[StockChangeEvent]
 
-func (UnimplementedInventoryServiceServer) CreateInventoryItem(context.Context, *CreateInventoryItemRequest) (*InventoryItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateInventoryItem not implemented")
+func (c *inventoryServiceClient) GetStockHistory(ctx context.Context, in *GetStockHistoryRequest, opts ...grpc.CallOption) (*StockHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockHistoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) GetInventoryItem(context.Context, *GetInventoryItemRequest) (*InventoryItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryItem not implemented")
+
+func (c *inventoryServiceClient) ListStockAlerts(ctx context.Context, in *ListStockAlertsRequest, opts ...grpc.CallOption) (*ListStockAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockAlertsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) UpdateInventoryItem(context.Context, *UpdateInventoryItemRequest) (*InventoryItemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateInventoryItem not implemented")
+
+func (c *inventoryServiceClient) ListInventoryActivity(ctx context.Context, in *ListInventoryActivityRequest, opts ...grpc.CallOption) (*ListInventoryActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInventoryActivityResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListInventoryActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) ListInventoryItems(context.Context, *ListInventoryItemsRequest) (*ListInventoryItemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListInventoryItems not implemented")
+
+func (c *inventoryServiceClient) GetForecast(ctx context.Context, in *GetForecastRequest, opts ...grpc.CallOption) (*GetForecastResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetForecastResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetForecast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) CreateWarehouse(context.Context, *CreateWarehouseRequest) (*WarehouseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+
+func (c *inventoryServiceClient) ListReorderSuggestions(ctx context.Context, in *ListReorderSuggestionsRequest, opts ...grpc.CallOption) (*ListReorderSuggestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReorderSuggestionsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListReorderSuggestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) GetWarehouse(context.Context, *GetWarehouseRequest) (*WarehouseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWarehouse not implemented")
+
+func (c *inventoryServiceClient) GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*DiagnosticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiagnosticsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) UpdateWarehouse(context.Context, *UpdateWarehouseRequest) (*WarehouseResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateWarehouse not implemented")
+
+func (c *inventoryServiceClient) CreateIntegrationKey(ctx context.Context, in *CreateIntegrationKeyRequest, opts ...grpc.CallOption) (*CreateIntegrationKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateIntegrationKeyResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateIntegrationKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListWarehouses not implemented")
+
+func (c *inventoryServiceClient) ListIntegrationKeys(ctx context.Context, in *ListIntegrationKeysRequest, opts ...grpc.CallOption) (*ListIntegrationKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIntegrationKeysResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListIntegrationKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) AddInventoryToLocation(context.Context, *AddInventoryToLocationRequest) (*InventoryLocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddInventoryToLocation not implemented")
+
+func (c *inventoryServiceClient) RevokeIntegrationKey(ctx context.Context, in *RevokeIntegrationKeyRequest, opts ...grpc.CallOption) (*IntegrationKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntegrationKeyResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RevokeIntegrationKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) RemoveInventoryFromLocation(context.Context, *RemoveInventoryFromLocationRequest) (*InventoryLocationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveInventoryFromLocation not implemented")
+
+func (c *inventoryServiceClient) SetIntegrationKeyQuota(ctx context.Context, in *SetIntegrationKeyQuotaRequest, opts ...grpc.CallOption) (*IntegrationKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntegrationKeyResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SetIntegrationKeyQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) GetInventoryByLocation(context.Context, *GetInventoryByLocationRequest) (*ListInventoryLocationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryByLocation not implemented")
+
+func (c *inventoryServiceClient) GetIntegrationQuota(ctx context.Context, in *GetIntegrationQuotaRequest, opts ...grpc.CallOption) (*IntegrationQuota, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntegrationQuota)
+	err := c.cc.Invoke(ctx, InventoryService_GetIntegrationQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) ReserveInventory(context.Context, *ReserveInventoryRequest) (*ReservationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReserveInventory not implemented")
+
+func (c *inventoryServiceClient) PushFulfillmentEvents(ctx context.Context, in *PushFulfillmentEventsRequest, opts ...grpc.CallOption) (*PushFulfillmentEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushFulfillmentEventsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_PushFulfillmentEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) ConfirmReservation(context.Context, *ConfirmReservationRequest) (*ReservationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReservation not implemented")
+
+func (c *inventoryServiceClient) ListOrderStatusEvents(ctx context.Context, in *ListOrderStatusEventsRequest, opts ...grpc.CallOption) (*ListOrderStatusEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrderStatusEventsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListOrderStatusEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) CancelReservation(context.Context, *CancelReservationRequest) (*ReservationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+
+func (c *inventoryServiceClient) CreateShipment(ctx context.Context, in *CreateShipmentRequest, opts ...grpc.CallOption) (*Shipment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Shipment)
+	err := c.cc.Invoke(ctx, InventoryService_CreateShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) CheckInventoryAvailability(context.Context, *CheckInventoryAvailabilityRequest) (*InventoryAvailabilityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CheckInventoryAvailability not implemented")
+
+func (c *inventoryServiceClient) ListShipments(ctx context.Context, in *ListShipmentsRequest, opts ...grpc.CallOption) (*ListShipmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShipmentsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListShipments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) BulkUpdateInventory(context.Context, *BulkUpdateInventoryRequest) (*BulkUpdateInventoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateInventory not implemented")
+
+func (c *inventoryServiceClient) GetShipmentStatus(ctx context.Context, in *GetShipmentStatusRequest, opts ...grpc.CallOption) (*ShipmentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShipmentStatusResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetShipmentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
-func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to InventoryServiceServer will
-// result in compilation errors.
-type UnsafeInventoryServiceServer interface {
-	mustEmbedUnimplementedInventoryServiceServer()
+func (c *inventoryServiceClient) ReceiveCarrierEvents(ctx context.Context, in *ReceiveCarrierEventsRequest, opts ...grpc.CallOption) (*ReceiveCarrierEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiveCarrierEventsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReceiveCarrierEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
-	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func (c *inventoryServiceClient) CreateSupplier(ctx context.Context, in *CreateSupplierRequest, opts ...grpc.CallOption) (*Supplier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Supplier)
+	err := c.cc.Invoke(ctx, InventoryService_CreateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateSupplier(ctx context.Context, in *UpdateSupplierRequest, opts ...grpc.CallOption) (*Supplier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Supplier)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetSupplier(ctx context.Context, in *GetSupplierRequest, opts ...grpc.CallOption) (*Supplier, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Supplier)
+	err := c.cc.Invoke(ctx, InventoryService_GetSupplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListSuppliers(ctx context.Context, in *ListSuppliersRequest, opts ...grpc.CallOption) (*ListSuppliersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSuppliersResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListSuppliers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetSupplierProduct(ctx context.Context, in *SetSupplierProductRequest, opts ...grpc.CallOption) (*SupplierProduct, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupplierProduct)
+	err := c.cc.Invoke(ctx, InventoryService_SetSupplierProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RemoveSupplierProduct(ctx context.Context, in *RemoveSupplierProductRequest, opts ...grpc.CallOption) (*RemoveSupplierProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveSupplierProductResponse)
+	err := c.cc.Invoke(ctx, InventoryService_RemoveSupplierProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreatePurchaseOrder(ctx context.Context, in *CreatePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, InventoryService_CreatePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPurchaseOrder(ctx context.Context, in *GetPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, InventoryService_GetPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListPurchaseOrders(ctx context.Context, in *ListPurchaseOrdersRequest, opts ...grpc.CallOption) (*ListPurchaseOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPurchaseOrdersResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListPurchaseOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReceivePurchaseOrder(ctx context.Context, in *ReceivePurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, InventoryService_ReceivePurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CancelPurchaseOrder(ctx context.Context, in *CancelPurchaseOrderRequest, opts ...grpc.CallOption) (*PurchaseOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseOrder)
+	err := c.cc.Invoke(ctx, InventoryService_CancelPurchaseOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SubscribeBackInStock(ctx context.Context, in *SubscribeBackInStockRequest, opts ...grpc.CallOption) (*BackInStockSubscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BackInStockSubscription)
+	err := c.cc.Invoke(ctx, InventoryService_SubscribeBackInStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListBackInStockSubscriptions(ctx context.Context, in *ListBackInStockSubscriptionsRequest, opts ...grpc.CallOption) (*ListBackInStockSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBackInStockSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListBackInStockSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteBackInStockSubscription(ctx context.Context, in *DeleteBackInStockSubscriptionRequest, opts ...grpc.CallOption) (*DeleteBackInStockSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBackInStockSubscriptionResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteBackInStockSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReceiveLot(ctx context.Context, in *ReceiveLotRequest, opts ...grpc.CallOption) (*InventoryLot, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InventoryLot)
+	err := c.cc.Invoke(ctx, InventoryService_ReceiveLot_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListLots(ctx context.Context, in *ListLotsRequest, opts ...grpc.CallOption) (*ListLotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLotsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListLots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListExpiringLots(ctx context.Context, in *ListExpiringLotsRequest, opts ...grpc.CallOption) (*ListExpiringLotsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpiringLotsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListExpiringLots_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetInventoryUnit(ctx context.Context, in *SetInventoryUnitRequest, opts ...grpc.CallOption) (*InventoryUnit, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InventoryUnit)
+	err := c.cc.Invoke(ctx, InventoryService_SetInventoryUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListInventoryUnits(ctx context.Context, in *ListInventoryUnitsRequest, opts ...grpc.CallOption) (*ListInventoryUnitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInventoryUnitsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListInventoryUnits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteInventoryUnit(ctx context.Context, in *DeleteInventoryUnitRequest, opts ...grpc.CallOption) (*DeleteInventoryUnitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInventoryUnitResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteInventoryUnit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetWarehouseBin(ctx context.Context, in *SetWarehouseBinRequest, opts ...grpc.CallOption) (*WarehouseBin, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarehouseBin)
+	err := c.cc.Invoke(ctx, InventoryService_SetWarehouseBin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListWarehouseBins(ctx context.Context, in *ListWarehouseBinsRequest, opts ...grpc.CallOption) (*ListWarehouseBinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWarehouseBinsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListWarehouseBins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteWarehouseBin(ctx context.Context, in *DeleteWarehouseBinRequest, opts ...grpc.CallOption) (*DeleteWarehouseBinResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWarehouseBinResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteWarehouseBin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetBinStock(ctx context.Context, in *SetBinStockRequest, opts ...grpc.CallOption) (*BinStock, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BinStock)
+	err := c.cc.Invoke(ctx, InventoryService_SetBinStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListBinStock(ctx context.Context, in *ListBinStockRequest, opts ...grpc.CallOption) (*ListBinStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBinStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListBinStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GeneratePickList(ctx context.Context, in *GeneratePickListRequest, opts ...grpc.CallOption) (*PickList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickList)
+	err := c.cc.Invoke(ctx, InventoryService_GeneratePickList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreateFulfillmentWave(ctx context.Context, in *CreateFulfillmentWaveRequest, opts ...grpc.CallOption) (*FulfillmentWave, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FulfillmentWave)
+	err := c.cc.Invoke(ctx, InventoryService_CreateFulfillmentWave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetFulfillmentWave(ctx context.Context, in *GetFulfillmentWaveRequest, opts ...grpc.CallOption) (*FulfillmentWave, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FulfillmentWave)
+	err := c.cc.Invoke(ctx, InventoryService_GetFulfillmentWave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListFulfillmentWaves(ctx context.Context, in *ListFulfillmentWavesRequest, opts ...grpc.CallOption) (*ListFulfillmentWavesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFulfillmentWavesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListFulfillmentWaves_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GenerateWavePickList(ctx context.Context, in *GenerateWavePickListRequest, opts ...grpc.CallOption) (*PickList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickList)
+	err := c.cc.Invoke(ctx, InventoryService_GenerateWavePickList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CompleteFulfillmentWave(ctx context.Context, in *CompleteFulfillmentWaveRequest, opts ...grpc.CallOption) (*FulfillmentWave, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FulfillmentWave)
+	err := c.cc.Invoke(ctx, InventoryService_CompleteFulfillmentWave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CancelFulfillmentWave(ctx context.Context, in *CancelFulfillmentWaveRequest, opts ...grpc.CallOption) (*FulfillmentWave, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FulfillmentWave)
+	err := c.cc.Invoke(ctx, InventoryService_CancelFulfillmentWave_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RequestRefund(ctx context.Context, in *RequestRefundRequest, opts ...grpc.CallOption) (*Refund, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Refund)
+	err := c.cc.Invoke(ctx, InventoryService_RequestRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetRefund(ctx context.Context, in *GetRefundRequest, opts ...grpc.CallOption) (*Refund, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Refund)
+	err := c.cc.Invoke(ctx, InventoryService_GetRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListRefunds(ctx context.Context, in *ListRefundsRequest, opts ...grpc.CallOption) (*ListRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ApproveRefund(ctx context.Context, in *ApproveRefundRequest, opts ...grpc.CallOption) (*Refund, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Refund)
+	err := c.cc.Invoke(ctx, InventoryService_ApproveRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RejectRefund(ctx context.Context, in *RejectRefundRequest, opts ...grpc.CallOption) (*Refund, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Refund)
+	err := c.cc.Invoke(ctx, InventoryService_RejectRefund_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) RecordRefundResult(ctx context.Context, in *RecordRefundResultRequest, opts ...grpc.CallOption) (*Refund, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Refund)
+	err := c.cc.Invoke(ctx, InventoryService_RecordRefundResult_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListRefundEvents(ctx context.Context, in *ListRefundEventsRequest, opts ...grpc.CallOption) (*ListRefundEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRefundEventsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListRefundEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ScreenOrder(ctx context.Context, in *ScreenOrderRequest, opts ...grpc.CallOption) (*FraudCheck, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FraudCheck)
+	err := c.cc.Invoke(ctx, InventoryService_ScreenOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetFraudCheck(ctx context.Context, in *GetFraudCheckRequest, opts ...grpc.CallOption) (*FraudCheck, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FraudCheck)
+	err := c.cc.Invoke(ctx, InventoryService_GetFraudCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListFraudChecks(ctx context.Context, in *ListFraudChecksRequest, opts ...grpc.CallOption) (*ListFraudChecksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFraudChecksResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListFraudChecks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReviewFraudCheck(ctx context.Context, in *ReviewFraudCheckRequest, opts ...grpc.CallOption) (*FraudCheck, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FraudCheck)
+	err := c.cc.Invoke(ctx, InventoryService_ReviewFraudCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListFraudEvents(ctx context.Context, in *ListFraudEventsRequest, opts ...grpc.CallOption) (*ListFraudEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFraudEventsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListFraudEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SetRetailStore(ctx context.Context, in *SetRetailStoreRequest, opts ...grpc.CallOption) (*RetailStore, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetailStore)
+	err := c.cc.Invoke(ctx, InventoryService_SetRetailStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteRetailStore(ctx context.Context, in *DeleteRetailStoreRequest, opts ...grpc.CallOption) (*DeleteRetailStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRetailStoreResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteRetailStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListRetailStores(ctx context.Context, in *ListRetailStoresRequest, opts ...grpc.CallOption) (*ListRetailStoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRetailStoresResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListRetailStores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) FindNearbyStores(ctx context.Context, in *FindNearbyStoresRequest, opts ...grpc.CallOption) (*FindNearbyStoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindNearbyStoresResponse)
+	err := c.cc.Invoke(ctx, InventoryService_FindNearbyStores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReserveInStore(ctx context.Context, in *ReserveInStoreRequest, opts ...grpc.CallOption) (*ReserveInStoreResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveInStoreResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReserveInStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreatePickupOrder(ctx context.Context, in *CreatePickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupOrder)
+	err := c.cc.Invoke(ctx, InventoryService_CreatePickupOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPickupOrder(ctx context.Context, in *GetPickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupOrder)
+	err := c.cc.Invoke(ctx, InventoryService_GetPickupOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListPickupOrders(ctx context.Context, in *ListPickupOrdersRequest, opts ...grpc.CallOption) (*ListPickupOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPickupOrdersResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListPickupOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) StartPickupPicking(ctx context.Context, in *PickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupOrder)
+	err := c.cc.Invoke(ctx, InventoryService_StartPickupPicking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) MarkPickupReady(ctx context.Context, in *PickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupOrder)
+	err := c.cc.Invoke(ctx, InventoryService_MarkPickupReady_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CollectPickupOrder(ctx context.Context, in *PickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupOrder)
+	err := c.cc.Invoke(ctx, InventoryService_CollectPickupOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CancelPickupOrder(ctx context.Context, in *CancelPickupOrderRequest, opts ...grpc.CallOption) (*PickupOrder, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PickupOrder)
+	err := c.cc.Invoke(ctx, InventoryService_CancelPickupOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InventoryServiceServer is the server API for InventoryService service.
+// All implementations must embed UnimplementedInventoryServiceServer
+// for forward compatibility.
+type InventoryServiceServer interface {
+	// Product inventory management
+	CreateInventoryItem(context.Context, *CreateInventoryItemRequest) (*InventoryItemResponse, error)
+	GetInventoryItem(context.Context, *GetInventoryItemRequest) (*InventoryItemResponse, error)
+	UpdateInventoryItem(context.Context, *UpdateInventoryItemRequest) (*InventoryItemResponse, error)
+	ListInventoryItems(context.Context, *ListInventoryItemsRequest) (*ListInventoryItemsResponse, error)
+	// Warehouse operations
+	CreateWarehouse(context.Context, *CreateWarehouseRequest) (*WarehouseResponse, error)
+	GetWarehouse(context.Context, *GetWarehouseRequest) (*WarehouseResponse, error)
+	UpdateWarehouse(context.Context, *UpdateWarehouseRequest) (*WarehouseResponse, error)
+	ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error)
+	// Inventory location operations
+	AddInventoryToLocation(context.Context, *AddInventoryToLocationRequest) (*InventoryLocationResponse, error)
+	RemoveInventoryFromLocation(context.Context, *RemoveInventoryFromLocationRequest) (*InventoryLocationResponse, error)
+	GetInventoryByLocation(context.Context, *GetInventoryByLocationRequest) (*ListInventoryLocationsResponse, error)
+	SetStockBuffers(context.Context, *SetStockBuffersRequest) (*InventoryLocationResponse, error)
+	// Reservation operations
+	ReserveInventory(context.Context, *ReserveInventoryRequest) (*ReservationResponse, error)
+	ConfirmReservation(context.Context, *ConfirmReservationRequest) (*ReservationResponse, error)
+	CancelReservation(context.Context, *CancelReservationRequest) (*ReservationResponse, error)
+	// Inventory check operations
+	CheckInventoryAvailability(context.Context, *CheckInventoryAvailabilityRequest) (*InventoryAvailabilityResponse, error)
+	CheckAvailabilityBulk(context.Context, *CheckAvailabilityBulkRequest) (*CheckAvailabilityBulkResponse, error)
+	// Availability thresholds of the stock badges of products
+	GetAvailabilityPolicy(context.Context, *GetAvailabilityPolicyRequest) (*AvailabilityPolicy, error)
+	SetAvailabilityPolicy(context.Context, *SetAvailabilityPolicyRequest) (*AvailabilityPolicy, error)
+	DeleteAvailabilityPolicy(context.Context, *DeleteAvailabilityPolicyRequest) (*DeleteAvailabilityPolicyResponse, error)
+	// Bulk operations
+	BulkUpdateInventory(context.Context, *BulkUpdateInventoryRequest) (*BulkUpdateInventoryResponse, error)
+	// Streaming operations
+	WatchInventory(*WatchInventoryRequest, grpc.ServerStreamingServer[StockChangeEvent]) error
+	// Reporting operations
+	GetStockHistory(context.Context, *GetStockHistoryRequest) (*StockHistoryResponse, error)
+	ListStockAlerts(context.Context, *ListStockAlertsRequest) (*ListStockAlertsResponse, error)
+	// Recent stock movements of the store, newest first, for the admin activity feed
+	ListInventoryActivity(context.Context, *ListInventoryActivityRequest) (*ListInventoryActivityResponse, error)
+	// Stock-out dates and reorder quantities forecast nightly from sales velocity
+	GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error)
+	ListReorderSuggestions(context.Context, *ListReorderSuggestionsRequest) (*ListReorderSuggestionsResponse, error)
+	// Diagnostics
+	GetDiagnostics(context.Context, *GetDiagnosticsRequest) (*DiagnosticsResponse, error)
+	// Fulfillment provider (3PL) integration
+	CreateIntegrationKey(context.Context, *CreateIntegrationKeyRequest) (*CreateIntegrationKeyResponse, error)
+	ListIntegrationKeys(context.Context, *ListIntegrationKeysRequest) (*ListIntegrationKeysResponse, error)
+	RevokeIntegrationKey(context.Context, *RevokeIntegrationKeyRequest) (*IntegrationKeyResponse, error)
+	SetIntegrationKeyQuota(context.Context, *SetIntegrationKeyQuotaRequest) (*IntegrationKeyResponse, error)
+	GetIntegrationQuota(context.Context, *GetIntegrationQuotaRequest) (*IntegrationQuota, error)
+	PushFulfillmentEvents(context.Context, *PushFulfillmentEventsRequest) (*PushFulfillmentEventsResponse, error)
+	ListOrderStatusEvents(context.Context, *ListOrderStatusEventsRequest) (*ListOrderStatusEventsResponse, error)
+	// Shipment tracking
+	CreateShipment(context.Context, *CreateShipmentRequest) (*Shipment, error)
+	ListShipments(context.Context, *ListShipmentsRequest) (*ListShipmentsResponse, error)
+	GetShipmentStatus(context.Context, *GetShipmentStatusRequest) (*ShipmentStatusResponse, error)
+	ReceiveCarrierEvents(context.Context, *ReceiveCarrierEventsRequest) (*ReceiveCarrierEventsResponse, error)
+	// Suppliers and purchase orders
+	CreateSupplier(context.Context, *CreateSupplierRequest) (*Supplier, error)
+	UpdateSupplier(context.Context, *UpdateSupplierRequest) (*Supplier, error)
+	GetSupplier(context.Context, *GetSupplierRequest) (*Supplier, error)
+	ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error)
+	SetSupplierProduct(context.Context, *SetSupplierProductRequest) (*SupplierProduct, error)
+	RemoveSupplierProduct(context.Context, *RemoveSupplierProductRequest) (*RemoveSupplierProductResponse, error)
+	CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*PurchaseOrder, error)
+	GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*PurchaseOrder, error)
+	ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error)
+	ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*PurchaseOrder, error)
+	CancelPurchaseOrder(context.Context, *CancelPurchaseOrderRequest) (*PurchaseOrder, error)
+	// Back-in-stock email subscriptions
+	SubscribeBackInStock(context.Context, *SubscribeBackInStockRequest) (*BackInStockSubscription, error)
+	ListBackInStockSubscriptions(context.Context, *ListBackInStockSubscriptionsRequest) (*ListBackInStockSubscriptionsResponse, error)
+	DeleteBackInStockSubscription(context.Context, *DeleteBackInStockSubscriptionRequest) (*DeleteBackInStockSubscriptionResponse, error)
+	// Lots of perishable goods; reservations are allocated from the lots that
+	// expire first, and expired lots are written off automatically
+	ReceiveLot(context.Context, *ReceiveLotRequest) (*InventoryLot, error)
+	ListLots(context.Context, *ListLotsRequest) (*ListLotsResponse, error)
+	ListExpiringLots(context.Context, *ListExpiringLotsRequest) (*ListExpiringLotsResponse, error)
+	// Units of measure, such as a case of 12, items are received, moved and
+	// sold in; stock is always kept in base units (each)
+	SetInventoryUnit(context.Context, *SetInventoryUnitRequest) (*InventoryUnit, error)
+	ListInventoryUnits(context.Context, *ListInventoryUnitsRequest) (*ListInventoryUnitsResponse, error)
+	DeleteInventoryUnit(context.Context, *DeleteInventoryUnitRequest) (*DeleteInventoryUnitResponse, error)
+	// Bins of warehouses, addressed by zone, aisle, shelf and bin, stock placed
+	// in them and pick lists walking them in path order
+	SetWarehouseBin(context.Context, *SetWarehouseBinRequest) (*WarehouseBin, error)
+	ListWarehouseBins(context.Context, *ListWarehouseBinsRequest) (*ListWarehouseBinsResponse, error)
+	DeleteWarehouseBin(context.Context, *DeleteWarehouseBinRequest) (*DeleteWarehouseBinResponse, error)
+	SetBinStock(context.Context, *SetBinStockRequest) (*BinStock, error)
+	ListBinStock(context.Context, *ListBinStockRequest) (*ListBinStockResponse, error)
+	GeneratePickList(context.Context, *GeneratePickListRequest) (*PickList, error)
+	// Waves batching the confirmed orders of a warehouse up to a carrier
+	// cutoff, picked together along one consolidated pick list
+	CreateFulfillmentWave(context.Context, *CreateFulfillmentWaveRequest) (*FulfillmentWave, error)
+	GetFulfillmentWave(context.Context, *GetFulfillmentWaveRequest) (*FulfillmentWave, error)
+	ListFulfillmentWaves(context.Context, *ListFulfillmentWavesRequest) (*ListFulfillmentWavesResponse, error)
+	GenerateWavePickList(context.Context, *GenerateWavePickListRequest) (*PickList, error)
+	CompleteFulfillmentWave(context.Context, *CompleteFulfillmentWaveRequest) (*FulfillmentWave, error)
+	CancelFulfillmentWave(context.Context, *CancelFulfillmentWaveRequest) (*FulfillmentWave, error)
+	// Refunds of the payments of orders: requested and approved or rejected by
+	// staff, then processed or failed by the payment system. Status changes
+	// are read as refund events by the notification and accounting exports.
+	RequestRefund(context.Context, *RequestRefundRequest) (*Refund, error)
+	GetRefund(context.Context, *GetRefundRequest) (*Refund, error)
+	ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error)
+	ApproveRefund(context.Context, *ApproveRefundRequest) (*Refund, error)
+	RejectRefund(context.Context, *RejectRefundRequest) (*Refund, error)
+	RecordRefundResult(context.Context, *RecordRefundResultRequest) (*Refund, error)
+	ListRefundEvents(context.Context, *ListRefundEventsRequest) (*ListRefundEventsResponse, error)
+	// Fraud screening of orders at checkout: approved, declined or sent to the
+	// review queue of staff. Decisions are read as fraud events by the order
+	// system and external fraud providers.
+	ScreenOrder(context.Context, *ScreenOrderRequest) (*FraudCheck, error)
+	GetFraudCheck(context.Context, *GetFraudCheckRequest) (*FraudCheck, error)
+	ListFraudChecks(context.Context, *ListFraudChecksRequest) (*ListFraudChecksResponse, error)
+	ReviewFraudCheck(context.Context, *ReviewFraudCheckRequest) (*FraudCheck, error)
+	ListFraudEvents(context.Context, *ListFraudEventsRequest) (*ListFraudEventsResponse, error)
+	// Retail stores, the warehouses customers can visit: the stores near a
+	// position with the stock of a product at each, and stock reserved at a
+	// store for in-store pickup
+	SetRetailStore(context.Context, *SetRetailStoreRequest) (*RetailStore, error)
+	DeleteRetailStore(context.Context, *DeleteRetailStoreRequest) (*DeleteRetailStoreResponse, error)
+	ListRetailStores(context.Context, *ListRetailStoresRequest) (*ListRetailStoresResponse, error)
+	FindNearbyStores(context.Context, *FindNearbyStoresRequest) (*FindNearbyStoresResponse, error)
+	ReserveInStore(context.Context, *ReserveInStoreRequest) (*ReserveInStoreResponse, error)
+	// Click-and-collect orders: placed online with their items reserved at a
+	// store, picked and made ready by the store, then collected or cancelled
+	CreatePickupOrder(context.Context, *CreatePickupOrderRequest) (*PickupOrder, error)
+	GetPickupOrder(context.Context, *GetPickupOrderRequest) (*PickupOrder, error)
+	ListPickupOrders(context.Context, *ListPickupOrdersRequest) (*ListPickupOrdersResponse, error)
+	StartPickupPicking(context.Context, *PickupOrderRequest) (*PickupOrder, error)
+	MarkPickupReady(context.Context, *PickupOrderRequest) (*PickupOrder, error)
+	CollectPickupOrder(context.Context, *PickupOrderRequest) (*PickupOrder, error)
+	CancelPickupOrder(context.Context, *CancelPickupOrderRequest) (*PickupOrder, error)
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+// UnimplementedInventoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInventoryServiceServer struct{}
+
+func (UnimplementedInventoryServiceServer) CreateInventoryItem(context.Context, *CreateInventoryItemRequest) (*InventoryItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInventoryItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetInventoryItem(context.Context, *GetInventoryItemRequest) (*InventoryItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateInventoryItem(context.Context, *UpdateInventoryItemRequest) (*InventoryItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInventoryItem not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListInventoryItems(context.Context, *ListInventoryItemsRequest) (*ListInventoryItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInventoryItems not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateWarehouse(context.Context, *CreateWarehouseRequest) (*WarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetWarehouse(context.Context, *GetWarehouseRequest) (*WarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWarehouse not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateWarehouse(context.Context, *UpdateWarehouseRequest) (*WarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWarehouse not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWarehouses not implemented")
+}
+func (UnimplementedInventoryServiceServer) AddInventoryToLocation(context.Context, *AddInventoryToLocationRequest) (*InventoryLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddInventoryToLocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) RemoveInventoryFromLocation(context.Context, *RemoveInventoryFromLocationRequest) (*InventoryLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveInventoryFromLocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetInventoryByLocation(context.Context, *GetInventoryByLocationRequest) (*ListInventoryLocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryByLocation not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetStockBuffers(context.Context, *SetStockBuffersRequest) (*InventoryLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStockBuffers not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReserveInventory(context.Context, *ReserveInventoryRequest) (*ReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveInventory not implemented")
+}
+func (UnimplementedInventoryServiceServer) ConfirmReservation(context.Context, *ConfirmReservationRequest) (*ReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmReservation not implemented")
+}
+func (UnimplementedInventoryServiceServer) CancelReservation(context.Context, *CancelReservationRequest) (*ReservationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelReservation not implemented")
+}
+func (UnimplementedInventoryServiceServer) CheckInventoryAvailability(context.Context, *CheckInventoryAvailabilityRequest) (*InventoryAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckInventoryAvailability not implemented")
+}
+func (UnimplementedInventoryServiceServer) CheckAvailabilityBulk(context.Context, *CheckAvailabilityBulkRequest) (*CheckAvailabilityBulkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckAvailabilityBulk not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetAvailabilityPolicy(context.Context, *GetAvailabilityPolicyRequest) (*AvailabilityPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailabilityPolicy not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetAvailabilityPolicy(context.Context, *SetAvailabilityPolicyRequest) (*AvailabilityPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAvailabilityPolicy not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteAvailabilityPolicy(context.Context, *DeleteAvailabilityPolicyRequest) (*DeleteAvailabilityPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAvailabilityPolicy not implemented")
+}
+func (UnimplementedInventoryServiceServer) BulkUpdateInventory(context.Context, *BulkUpdateInventoryRequest) (*BulkUpdateInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateInventory not implemented")
+}
+func (UnimplementedInventoryServiceServer) WatchInventory(*WatchInventoryRequest, grpc.ServerStreamingServer[StockChangeEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchInventory not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockHistory(context.Context, *GetStockHistoryRequest) (*StockHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockHistory not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockAlerts(context.Context, *ListStockAlertsRequest) (*ListStockAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockAlerts not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListInventoryActivity(context.Context, *ListInventoryActivityRequest) (*ListInventoryActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInventoryActivity not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetForecast(context.Context, *GetForecastRequest) (*GetForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForecast not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListReorderSuggestions(context.Context, *ListReorderSuggestionsRequest) (*ListReorderSuggestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReorderSuggestions not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetDiagnostics(context.Context, *GetDiagnosticsRequest) (*DiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiagnostics not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateIntegrationKey(context.Context, *CreateIntegrationKeyRequest) (*CreateIntegrationKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIntegrationKey not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListIntegrationKeys(context.Context, *ListIntegrationKeysRequest) (*ListIntegrationKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIntegrationKeys not implemented")
+}
+func (UnimplementedInventoryServiceServer) RevokeIntegrationKey(context.Context, *RevokeIntegrationKeyRequest) (*IntegrationKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeIntegrationKey not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetIntegrationKeyQuota(context.Context, *SetIntegrationKeyQuotaRequest) (*IntegrationKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIntegrationKeyQuota not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetIntegrationQuota(context.Context, *GetIntegrationQuotaRequest) (*IntegrationQuota, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIntegrationQuota not implemented")
+}
+func (UnimplementedInventoryServiceServer) PushFulfillmentEvents(context.Context, *PushFulfillmentEventsRequest) (*PushFulfillmentEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushFulfillmentEvents not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListOrderStatusEvents(context.Context, *ListOrderStatusEventsRequest) (*ListOrderStatusEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrderStatusEvents not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateShipment(context.Context, *CreateShipmentRequest) (*Shipment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShipment not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListShipments(context.Context, *ListShipmentsRequest) (*ListShipmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShipments not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetShipmentStatus(context.Context, *GetShipmentStatusRequest) (*ShipmentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShipmentStatus not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReceiveCarrierEvents(context.Context, *ReceiveCarrierEventsRequest) (*ReceiveCarrierEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveCarrierEvents not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateSupplier(context.Context, *CreateSupplierRequest) (*Supplier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSupplier not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateSupplier(context.Context, *UpdateSupplierRequest) (*Supplier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSupplier not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetSupplier(context.Context, *GetSupplierRequest) (*Supplier, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupplier not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListSuppliers(context.Context, *ListSuppliersRequest) (*ListSuppliersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSuppliers not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetSupplierProduct(context.Context, *SetSupplierProductRequest) (*SupplierProduct, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSupplierProduct not implemented")
+}
+func (UnimplementedInventoryServiceServer) RemoveSupplierProduct(context.Context, *RemoveSupplierProductRequest) (*RemoveSupplierProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSupplierProduct not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreatePurchaseOrder(context.Context, *CreatePurchaseOrderRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPurchaseOrder(context.Context, *GetPurchaseOrderRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListPurchaseOrders(context.Context, *ListPurchaseOrdersRequest) (*ListPurchaseOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPurchaseOrders not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReceivePurchaseOrder(context.Context, *ReceivePurchaseOrderRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceivePurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) CancelPurchaseOrder(context.Context, *CancelPurchaseOrderRequest) (*PurchaseOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPurchaseOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) SubscribeBackInStock(context.Context, *SubscribeBackInStockRequest) (*BackInStockSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubscribeBackInStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListBackInStockSubscriptions(context.Context, *ListBackInStockSubscriptionsRequest) (*ListBackInStockSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBackInStockSubscriptions not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteBackInStockSubscription(context.Context, *DeleteBackInStockSubscriptionRequest) (*DeleteBackInStockSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBackInStockSubscription not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReceiveLot(context.Context, *ReceiveLotRequest) (*InventoryLot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveLot not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListLots(context.Context, *ListLotsRequest) (*ListLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLots not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListExpiringLots(context.Context, *ListExpiringLotsRequest) (*ListExpiringLotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringLots not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetInventoryUnit(context.Context, *SetInventoryUnitRequest) (*InventoryUnit, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetInventoryUnit not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListInventoryUnits(context.Context, *ListInventoryUnitsRequest) (*ListInventoryUnitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInventoryUnits not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteInventoryUnit(context.Context, *DeleteInventoryUnitRequest) (*DeleteInventoryUnitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInventoryUnit not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetWarehouseBin(context.Context, *SetWarehouseBinRequest) (*WarehouseBin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWarehouseBin not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListWarehouseBins(context.Context, *ListWarehouseBinsRequest) (*ListWarehouseBinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWarehouseBins not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteWarehouseBin(context.Context, *DeleteWarehouseBinRequest) (*DeleteWarehouseBinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWarehouseBin not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetBinStock(context.Context, *SetBinStockRequest) (*BinStock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBinStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListBinStock(context.Context, *ListBinStockRequest) (*ListBinStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBinStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) GeneratePickList(context.Context, *GeneratePickListRequest) (*PickList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeneratePickList not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateFulfillmentWave(context.Context, *CreateFulfillmentWaveRequest) (*FulfillmentWave, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFulfillmentWave not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetFulfillmentWave(context.Context, *GetFulfillmentWaveRequest) (*FulfillmentWave, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFulfillmentWave not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListFulfillmentWaves(context.Context, *ListFulfillmentWavesRequest) (*ListFulfillmentWavesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFulfillmentWaves not implemented")
+}
+func (UnimplementedInventoryServiceServer) GenerateWavePickList(context.Context, *GenerateWavePickListRequest) (*PickList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateWavePickList not implemented")
+}
+func (UnimplementedInventoryServiceServer) CompleteFulfillmentWave(context.Context, *CompleteFulfillmentWaveRequest) (*FulfillmentWave, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteFulfillmentWave not implemented")
+}
+func (UnimplementedInventoryServiceServer) CancelFulfillmentWave(context.Context, *CancelFulfillmentWaveRequest) (*FulfillmentWave, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelFulfillmentWave not implemented")
+}
+func (UnimplementedInventoryServiceServer) RequestRefund(context.Context, *RequestRefundRequest) (*Refund, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestRefund not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetRefund(context.Context, *GetRefundRequest) (*Refund, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRefund not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListRefunds(context.Context, *ListRefundsRequest) (*ListRefundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRefunds not implemented")
+}
+func (UnimplementedInventoryServiceServer) ApproveRefund(context.Context, *ApproveRefundRequest) (*Refund, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRefund not implemented")
+}
+func (UnimplementedInventoryServiceServer) RejectRefund(context.Context, *RejectRefundRequest) (*Refund, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRefund not implemented")
+}
+func (UnimplementedInventoryServiceServer) RecordRefundResult(context.Context, *RecordRefundResultRequest) (*Refund, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordRefundResult not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListRefundEvents(context.Context, *ListRefundEventsRequest) (*ListRefundEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRefundEvents not implemented")
+}
+func (UnimplementedInventoryServiceServer) ScreenOrder(context.Context, *ScreenOrderRequest) (*FraudCheck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScreenOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetFraudCheck(context.Context, *GetFraudCheckRequest) (*FraudCheck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFraudCheck not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListFraudChecks(context.Context, *ListFraudChecksRequest) (*ListFraudChecksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFraudChecks not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReviewFraudCheck(context.Context, *ReviewFraudCheckRequest) (*FraudCheck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewFraudCheck not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListFraudEvents(context.Context, *ListFraudEventsRequest) (*ListFraudEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFraudEvents not implemented")
+}
+func (UnimplementedInventoryServiceServer) SetRetailStore(context.Context, *SetRetailStoreRequest) (*RetailStore, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetailStore not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteRetailStore(context.Context, *DeleteRetailStoreRequest) (*DeleteRetailStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRetailStore not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListRetailStores(context.Context, *ListRetailStoresRequest) (*ListRetailStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRetailStores not implemented")
+}
+func (UnimplementedInventoryServiceServer) FindNearbyStores(context.Context, *FindNearbyStoresRequest) (*FindNearbyStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindNearbyStores not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReserveInStore(context.Context, *ReserveInStoreRequest) (*ReserveInStoreResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveInStore not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreatePickupOrder(context.Context, *CreatePickupOrderRequest) (*PickupOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePickupOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPickupOrder(context.Context, *GetPickupOrderRequest) (*PickupOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPickupOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListPickupOrders(context.Context, *ListPickupOrdersRequest) (*ListPickupOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPickupOrders not implemented")
+}
+func (UnimplementedInventoryServiceServer) StartPickupPicking(context.Context, *PickupOrderRequest) (*PickupOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartPickupPicking not implemented")
+}
+func (UnimplementedInventoryServiceServer) MarkPickupReady(context.Context, *PickupOrderRequest) (*PickupOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkPickupReady not implemented")
+}
+func (UnimplementedInventoryServiceServer) CollectPickupOrder(context.Context, *PickupOrderRequest) (*PickupOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectPickupOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) CancelPickupOrder(context.Context, *CancelPickupOrderRequest) (*PickupOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPickupOrder not implemented")
+}
+func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
+func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServiceServer will
+// result in compilation errors.
+type UnsafeInventoryServiceServer interface {
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InventoryService_ServiceDesc, srv)
+}
+
+func _InventoryService_CreateInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInventoryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateInventoryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateInventoryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateInventoryItem(ctx, req.(*CreateInventoryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInventoryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetInventoryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetInventoryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetInventoryItem(ctx, req.(*GetInventoryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInventoryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateInventoryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateInventoryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateInventoryItem(ctx, req.(*UpdateInventoryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListInventoryItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInventoryItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListInventoryItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListInventoryItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListInventoryItems(ctx, req.(*ListInventoryItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateWarehouse(ctx, req.(*CreateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetWarehouse(ctx, req.(*GetWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateWarehouse(ctx, req.(*UpdateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListWarehouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWarehousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListWarehouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListWarehouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListWarehouses(ctx, req.(*ListWarehousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_AddInventoryToLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddInventoryToLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).AddInventoryToLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_AddInventoryToLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).AddInventoryToLocation(ctx, req.(*AddInventoryToLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RemoveInventoryFromLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveInventoryFromLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RemoveInventoryFromLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RemoveInventoryFromLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RemoveInventoryFromLocation(ctx, req.(*RemoveInventoryFromLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetInventoryByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInventoryByLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetInventoryByLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetInventoryByLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetInventoryByLocation(ctx, req.(*GetInventoryByLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetStockBuffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStockBuffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetStockBuffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetStockBuffers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetStockBuffers(ctx, req.(*SetStockBuffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReserveInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReserveInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReserveInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReserveInventory(ctx, req.(*ReserveInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ConfirmReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ConfirmReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ConfirmReservation(ctx, req.(*ConfirmReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CancelReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CancelReservation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CancelReservation(ctx, req.(*CancelReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CheckInventoryAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckInventoryAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CheckInventoryAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CheckInventoryAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CheckInventoryAvailability(ctx, req.(*CheckInventoryAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CheckAvailabilityBulk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckAvailabilityBulkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CheckAvailabilityBulk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CheckAvailabilityBulk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CheckAvailabilityBulk(ctx, req.(*CheckAvailabilityBulkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetAvailabilityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetAvailabilityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetAvailabilityPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetAvailabilityPolicy(ctx, req.(*GetAvailabilityPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetAvailabilityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAvailabilityPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetAvailabilityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetAvailabilityPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetAvailabilityPolicy(ctx, req.(*SetAvailabilityPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteAvailabilityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAvailabilityPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteAvailabilityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteAvailabilityPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteAvailabilityPolicy(ctx, req.(*DeleteAvailabilityPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_BulkUpdateInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).BulkUpdateInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_BulkUpdateInventory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).BulkUpdateInventory(ctx, req.(*BulkUpdateInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_WatchInventory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchInventoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).WatchInventory(m, &grpc.GenericServerStream[WatchInventoryRequest, StockChangeEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchInventoryServer = grpc.ServerStreamingServer[StockChangeEvent]
+
+func _InventoryService_GetStockHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockHistory(ctx, req.(*GetStockHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListStockAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListStockAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListStockAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListStockAlerts(ctx, req.(*ListStockAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListInventoryActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInventoryActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListInventoryActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListInventoryActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListInventoryActivity(ctx, req.(*ListInventoryActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetForecast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetForecast(ctx, req.(*GetForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListReorderSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReorderSuggestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListReorderSuggestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListReorderSuggestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListReorderSuggestions(ctx, req.(*ListReorderSuggestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetDiagnostics(ctx, req.(*GetDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateIntegrationKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateIntegrationKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateIntegrationKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateIntegrationKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateIntegrationKey(ctx, req.(*CreateIntegrationKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListIntegrationKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIntegrationKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListIntegrationKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListIntegrationKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListIntegrationKeys(ctx, req.(*ListIntegrationKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RevokeIntegrationKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeIntegrationKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RevokeIntegrationKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RevokeIntegrationKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RevokeIntegrationKey(ctx, req.(*RevokeIntegrationKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetIntegrationKeyQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIntegrationKeyQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetIntegrationKeyQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetIntegrationKeyQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetIntegrationKeyQuota(ctx, req.(*SetIntegrationKeyQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetIntegrationQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetIntegrationQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetIntegrationQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetIntegrationQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetIntegrationQuota(ctx, req.(*GetIntegrationQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_PushFulfillmentEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushFulfillmentEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).PushFulfillmentEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_PushFulfillmentEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).PushFulfillmentEvents(ctx, req.(*PushFulfillmentEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListOrderStatusEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrderStatusEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListOrderStatusEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListOrderStatusEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListOrderStatusEvents(ctx, req.(*ListOrderStatusEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateShipment(ctx, req.(*CreateShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListShipments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShipmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListShipments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListShipments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListShipments(ctx, req.(*ListShipmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetShipmentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShipmentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetShipmentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetShipmentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetShipmentStatus(ctx, req.(*GetShipmentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReceiveCarrierEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveCarrierEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReceiveCarrierEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReceiveCarrierEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReceiveCarrierEvents(ctx, req.(*ReceiveCarrierEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSupplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateSupplier(ctx, req.(*CreateSupplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSupplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateSupplier(ctx, req.(*UpdateSupplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetSupplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSupplierRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetSupplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetSupplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetSupplier(ctx, req.(*GetSupplierRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListSuppliers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSuppliersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListSuppliers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListSuppliers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListSuppliers(ctx, req.(*ListSuppliersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetSupplierProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSupplierProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetSupplierProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetSupplierProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetSupplierProduct(ctx, req.(*SetSupplierProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RemoveSupplierProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSupplierProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RemoveSupplierProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RemoveSupplierProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RemoveSupplierProduct(ctx, req.(*RemoveSupplierProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreatePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreatePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreatePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreatePurchaseOrder(ctx, req.(*CreatePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPurchaseOrder(ctx, req.(*GetPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListPurchaseOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPurchaseOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListPurchaseOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListPurchaseOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListPurchaseOrders(ctx, req.(*ListPurchaseOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReceivePurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceivePurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReceivePurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReceivePurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReceivePurchaseOrder(ctx, req.(*ReceivePurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CancelPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPurchaseOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CancelPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CancelPurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CancelPurchaseOrder(ctx, req.(*CancelPurchaseOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SubscribeBackInStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeBackInStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SubscribeBackInStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SubscribeBackInStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SubscribeBackInStock(ctx, req.(*SubscribeBackInStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListBackInStockSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBackInStockSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListBackInStockSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListBackInStockSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListBackInStockSubscriptions(ctx, req.(*ListBackInStockSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteBackInStockSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBackInStockSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteBackInStockSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteBackInStockSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteBackInStockSubscription(ctx, req.(*DeleteBackInStockSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReceiveLot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveLotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReceiveLot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReceiveLot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReceiveLot(ctx, req.(*ReceiveLotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListLots(ctx, req.(*ListLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListExpiringLots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringLotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListExpiringLots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListExpiringLots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListExpiringLots(ctx, req.(*ListExpiringLotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetInventoryUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetInventoryUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetInventoryUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetInventoryUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetInventoryUnit(ctx, req.(*SetInventoryUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListInventoryUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInventoryUnitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListInventoryUnits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListInventoryUnits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListInventoryUnits(ctx, req.(*ListInventoryUnitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteInventoryUnit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInventoryUnitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteInventoryUnit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteInventoryUnit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteInventoryUnit(ctx, req.(*DeleteInventoryUnitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetWarehouseBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWarehouseBinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetWarehouseBin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetWarehouseBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetWarehouseBin(ctx, req.(*SetWarehouseBinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListWarehouseBins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWarehouseBinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListWarehouseBins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListWarehouseBins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListWarehouseBins(ctx, req.(*ListWarehouseBinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteWarehouseBin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWarehouseBinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteWarehouseBin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteWarehouseBin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteWarehouseBin(ctx, req.(*DeleteWarehouseBinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SetBinStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBinStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SetBinStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SetBinStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SetBinStock(ctx, req.(*SetBinStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListBinStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBinStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListBinStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListBinStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListBinStock(ctx, req.(*ListBinStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GeneratePickList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeneratePickListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GeneratePickList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GeneratePickList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GeneratePickList(ctx, req.(*GeneratePickListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateFulfillmentWave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFulfillmentWaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateFulfillmentWave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateFulfillmentWave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateFulfillmentWave(ctx, req.(*CreateFulfillmentWaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetFulfillmentWave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFulfillmentWaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetFulfillmentWave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetFulfillmentWave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetFulfillmentWave(ctx, req.(*GetFulfillmentWaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListFulfillmentWaves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFulfillmentWavesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListFulfillmentWaves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListFulfillmentWaves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListFulfillmentWaves(ctx, req.(*ListFulfillmentWavesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GenerateWavePickList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateWavePickListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GenerateWavePickList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GenerateWavePickList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GenerateWavePickList(ctx, req.(*GenerateWavePickListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CompleteFulfillmentWave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteFulfillmentWaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CompleteFulfillmentWave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CompleteFulfillmentWave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CompleteFulfillmentWave(ctx, req.(*CompleteFulfillmentWaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CancelFulfillmentWave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelFulfillmentWaveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CancelFulfillmentWave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CancelFulfillmentWave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CancelFulfillmentWave(ctx, req.(*CancelFulfillmentWaveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RequestRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RequestRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RequestRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RequestRefund(ctx, req.(*RequestRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetRefund(ctx, req.(*GetRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListRefunds(ctx, req.(*ListRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ApproveRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ApproveRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ApproveRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ApproveRefund(ctx, req.(*ApproveRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RejectRefund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRefundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RejectRefund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RejectRefund_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RejectRefund(ctx, req.(*RejectRefundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_RecordRefundResult_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordRefundResultRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).RecordRefundResult(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_RecordRefundResult_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).RecordRefundResult(ctx, req.(*RecordRefundResultRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListRefundEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRefundEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListRefundEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListRefundEvents_FullMethodName,
 	}
-	s.RegisterService(&InventoryService_ServiceDesc, srv)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListRefundEvents(ctx, req.(*ListRefundEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_CreateInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateInventoryItemRequest)
+func _InventoryService_ScreenOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScreenOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).CreateInventoryItem(ctx, in)
+		return srv.(InventoryServiceServer).ScreenOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_CreateInventoryItem_FullMethodName,
+		FullMethod: InventoryService_ScreenOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).CreateInventoryItem(ctx, req.(*CreateInventoryItemRequest))
+		return srv.(InventoryServiceServer).ScreenOrder(ctx, req.(*ScreenOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_GetInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInventoryItemRequest)
+func _InventoryService_GetFraudCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFraudCheckRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).GetInventoryItem(ctx, in)
+		return srv.(InventoryServiceServer).GetFraudCheck(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_GetInventoryItem_FullMethodName,
+		FullMethod: InventoryService_GetFraudCheck_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).GetInventoryItem(ctx, req.(*GetInventoryItemRequest))
+		return srv.(InventoryServiceServer).GetFraudCheck(ctx, req.(*GetFraudCheckRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_UpdateInventoryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateInventoryItemRequest)
+func _InventoryService_ListFraudChecks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFraudChecksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).UpdateInventoryItem(ctx, in)
+		return srv.(InventoryServiceServer).ListFraudChecks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_UpdateInventoryItem_FullMethodName,
+		FullMethod: InventoryService_ListFraudChecks_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).UpdateInventoryItem(ctx, req.(*UpdateInventoryItemRequest))
+		return srv.(InventoryServiceServer).ListFraudChecks(ctx, req.(*ListFraudChecksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_ListInventoryItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListInventoryItemsRequest)
+func _InventoryService_ReviewFraudCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewFraudCheckRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).ListInventoryItems(ctx, in)
+		return srv.(InventoryServiceServer).ReviewFraudCheck(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_ListInventoryItems_FullMethodName,
+		FullMethod: InventoryService_ReviewFraudCheck_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).ListInventoryItems(ctx, req.(*ListInventoryItemsRequest))
+		return srv.(InventoryServiceServer).ReviewFraudCheck(ctx, req.(*ReviewFraudCheckRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateWarehouseRequest)
+func _InventoryService_ListFraudEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFraudEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).CreateWarehouse(ctx, in)
+		return srv.(InventoryServiceServer).ListFraudEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_CreateWarehouse_FullMethodName,
+		FullMethod: InventoryService_ListFraudEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).CreateWarehouse(ctx, req.(*CreateWarehouseRequest))
+		return srv.(InventoryServiceServer).ListFraudEvents(ctx, req.(*ListFraudEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_GetWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWarehouseRequest)
+func _InventoryService_SetRetailStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetailStoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).GetWarehouse(ctx, in)
+		return srv.(InventoryServiceServer).SetRetailStore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_GetWarehouse_FullMethodName,
+		FullMethod: InventoryService_SetRetailStore_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).GetWarehouse(ctx, req.(*GetWarehouseRequest))
+		return srv.(InventoryServiceServer).SetRetailStore(ctx, req.(*SetRetailStoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_UpdateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateWarehouseRequest)
+func _InventoryService_DeleteRetailStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRetailStoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).UpdateWarehouse(ctx, in)
+		return srv.(InventoryServiceServer).DeleteRetailStore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_UpdateWarehouse_FullMethodName,
+		FullMethod: InventoryService_DeleteRetailStore_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).UpdateWarehouse(ctx, req.(*UpdateWarehouseRequest))
+		return srv.(InventoryServiceServer).DeleteRetailStore(ctx, req.(*DeleteRetailStoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_ListWarehouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListWarehousesRequest)
+func _InventoryService_ListRetailStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRetailStoresRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).ListWarehouses(ctx, in)
+		return srv.(InventoryServiceServer).ListRetailStores(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_ListWarehouses_FullMethodName,
+		FullMethod: InventoryService_ListRetailStores_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).ListWarehouses(ctx, req.(*ListWarehousesRequest))
+		return srv.(InventoryServiceServer).ListRetailStores(ctx, req.(*ListRetailStoresRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_AddInventoryToLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddInventoryToLocationRequest)
+func _InventoryService_FindNearbyStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindNearbyStoresRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).AddInventoryToLocation(ctx, in)
+		return srv.(InventoryServiceServer).FindNearbyStores(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_AddInventoryToLocation_FullMethodName,
+		FullMethod: InventoryService_FindNearbyStores_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).AddInventoryToLocation(ctx, req.(*AddInventoryToLocationRequest))
+		return srv.(InventoryServiceServer).FindNearbyStores(ctx, req.(*FindNearbyStoresRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_RemoveInventoryFromLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveInventoryFromLocationRequest)
+func _InventoryService_ReserveInStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveInStoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).RemoveInventoryFromLocation(ctx, in)
+		return srv.(InventoryServiceServer).ReserveInStore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_RemoveInventoryFromLocation_FullMethodName,
+		FullMethod: InventoryService_ReserveInStore_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).RemoveInventoryFromLocation(ctx, req.(*RemoveInventoryFromLocationRequest))
+		return srv.(InventoryServiceServer).ReserveInStore(ctx, req.(*ReserveInStoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_GetInventoryByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInventoryByLocationRequest)
+func _InventoryService_CreatePickupOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePickupOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).GetInventoryByLocation(ctx, in)
+		return srv.(InventoryServiceServer).CreatePickupOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_GetInventoryByLocation_FullMethodName,
+		FullMethod: InventoryService_CreatePickupOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).GetInventoryByLocation(ctx, req.(*GetInventoryByLocationRequest))
+		return srv.(InventoryServiceServer).CreatePickupOrder(ctx, req.(*CreatePickupOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_ReserveInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReserveInventoryRequest)
+func _InventoryService_GetPickupOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPickupOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).ReserveInventory(ctx, in)
+		return srv.(InventoryServiceServer).GetPickupOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_ReserveInventory_FullMethodName,
+		FullMethod: InventoryService_GetPickupOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).ReserveInventory(ctx, req.(*ReserveInventoryRequest))
+		return srv.(InventoryServiceServer).GetPickupOrder(ctx, req.(*GetPickupOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConfirmReservationRequest)
+func _InventoryService_ListPickupOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPickupOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).ConfirmReservation(ctx, in)
+		return srv.(InventoryServiceServer).ListPickupOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_ConfirmReservation_FullMethodName,
+		FullMethod: InventoryService_ListPickupOrders_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).ConfirmReservation(ctx, req.(*ConfirmReservationRequest))
+		return srv.(InventoryServiceServer).ListPickupOrders(ctx, req.(*ListPickupOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelReservationRequest)
+func _InventoryService_StartPickupPicking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).CancelReservation(ctx, in)
+		return srv.(InventoryServiceServer).StartPickupPicking(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_CancelReservation_FullMethodName,
+		FullMethod: InventoryService_StartPickupPicking_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).CancelReservation(ctx, req.(*CancelReservationRequest))
+		return srv.(InventoryServiceServer).StartPickupPicking(ctx, req.(*PickupOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_CheckInventoryAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CheckInventoryAvailabilityRequest)
+func _InventoryService_MarkPickupReady_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).CheckInventoryAvailability(ctx, in)
+		return srv.(InventoryServiceServer).MarkPickupReady(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_CheckInventoryAvailability_FullMethodName,
+		FullMethod: InventoryService_MarkPickupReady_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).CheckInventoryAvailability(ctx, req.(*CheckInventoryAvailabilityRequest))
+		return srv.(InventoryServiceServer).MarkPickupReady(ctx, req.(*PickupOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_BulkUpdateInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BulkUpdateInventoryRequest)
+func _InventoryService_CollectPickupOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PickupOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).BulkUpdateInventory(ctx, in)
+		return srv.(InventoryServiceServer).CollectPickupOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_BulkUpdateInventory_FullMethodName,
+		FullMethod: InventoryService_CollectPickupOrder_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).BulkUpdateInventory(ctx, req.(*BulkUpdateInventoryRequest))
+		return srv.(InventoryServiceServer).CollectPickupOrder(ctx, req.(*PickupOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CancelPickupOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPickupOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CancelPickupOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CancelPickupOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CancelPickupOrder(ctx, req.(*CancelPickupOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -677,6 +3423,10 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetInventoryByLocation",
 			Handler:    _InventoryService_GetInventoryByLocation_Handler,
 		},
+		{
+			MethodName: "SetStockBuffers",
+			Handler:    _InventoryService_SetStockBuffers_Handler,
+		},
 		{
 			MethodName: "ReserveInventory",
 			Handler:    _InventoryService_ReserveInventory_Handler,
@@ -693,11 +3443,325 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckInventoryAvailability",
 			Handler:    _InventoryService_CheckInventoryAvailability_Handler,
 		},
+		{
+			MethodName: "CheckAvailabilityBulk",
+			Handler:    _InventoryService_CheckAvailabilityBulk_Handler,
+		},
+		{
+			MethodName: "GetAvailabilityPolicy",
+			Handler:    _InventoryService_GetAvailabilityPolicy_Handler,
+		},
+		{
+			MethodName: "SetAvailabilityPolicy",
+			Handler:    _InventoryService_SetAvailabilityPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteAvailabilityPolicy",
+			Handler:    _InventoryService_DeleteAvailabilityPolicy_Handler,
+		},
 		{
 			MethodName: "BulkUpdateInventory",
 			Handler:    _InventoryService_BulkUpdateInventory_Handler,
 		},
+		{
+			MethodName: "GetStockHistory",
+			Handler:    _InventoryService_GetStockHistory_Handler,
+		},
+		{
+			MethodName: "ListStockAlerts",
+			Handler:    _InventoryService_ListStockAlerts_Handler,
+		},
+		{
+			MethodName: "ListInventoryActivity",
+			Handler:    _InventoryService_ListInventoryActivity_Handler,
+		},
+		{
+			MethodName: "GetForecast",
+			Handler:    _InventoryService_GetForecast_Handler,
+		},
+		{
+			MethodName: "ListReorderSuggestions",
+			Handler:    _InventoryService_ListReorderSuggestions_Handler,
+		},
+		{
+			MethodName: "GetDiagnostics",
+			Handler:    _InventoryService_GetDiagnostics_Handler,
+		},
+		{
+			MethodName: "CreateIntegrationKey",
+			Handler:    _InventoryService_CreateIntegrationKey_Handler,
+		},
+		{
+			MethodName: "ListIntegrationKeys",
+			Handler:    _InventoryService_ListIntegrationKeys_Handler,
+		},
+		{
+			MethodName: "RevokeIntegrationKey",
+			Handler:    _InventoryService_RevokeIntegrationKey_Handler,
+		},
+		{
+			MethodName: "SetIntegrationKeyQuota",
+			Handler:    _InventoryService_SetIntegrationKeyQuota_Handler,
+		},
+		{
+			MethodName: "GetIntegrationQuota",
+			Handler:    _InventoryService_GetIntegrationQuota_Handler,
+		},
+		{
+			MethodName: "PushFulfillmentEvents",
+			Handler:    _InventoryService_PushFulfillmentEvents_Handler,
+		},
+		{
+			MethodName: "ListOrderStatusEvents",
+			Handler:    _InventoryService_ListOrderStatusEvents_Handler,
+		},
+		{
+			MethodName: "CreateShipment",
+			Handler:    _InventoryService_CreateShipment_Handler,
+		},
+		{
+			MethodName: "ListShipments",
+			Handler:    _InventoryService_ListShipments_Handler,
+		},
+		{
+			MethodName: "GetShipmentStatus",
+			Handler:    _InventoryService_GetShipmentStatus_Handler,
+		},
+		{
+			MethodName: "ReceiveCarrierEvents",
+			Handler:    _InventoryService_ReceiveCarrierEvents_Handler,
+		},
+		{
+			MethodName: "CreateSupplier",
+			Handler:    _InventoryService_CreateSupplier_Handler,
+		},
+		{
+			MethodName: "UpdateSupplier",
+			Handler:    _InventoryService_UpdateSupplier_Handler,
+		},
+		{
+			MethodName: "GetSupplier",
+			Handler:    _InventoryService_GetSupplier_Handler,
+		},
+		{
+			MethodName: "ListSuppliers",
+			Handler:    _InventoryService_ListSuppliers_Handler,
+		},
+		{
+			MethodName: "SetSupplierProduct",
+			Handler:    _InventoryService_SetSupplierProduct_Handler,
+		},
+		{
+			MethodName: "RemoveSupplierProduct",
+			Handler:    _InventoryService_RemoveSupplierProduct_Handler,
+		},
+		{
+			MethodName: "CreatePurchaseOrder",
+			Handler:    _InventoryService_CreatePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "GetPurchaseOrder",
+			Handler:    _InventoryService_GetPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ListPurchaseOrders",
+			Handler:    _InventoryService_ListPurchaseOrders_Handler,
+		},
+		{
+			MethodName: "ReceivePurchaseOrder",
+			Handler:    _InventoryService_ReceivePurchaseOrder_Handler,
+		},
+		{
+			MethodName: "CancelPurchaseOrder",
+			Handler:    _InventoryService_CancelPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "SubscribeBackInStock",
+			Handler:    _InventoryService_SubscribeBackInStock_Handler,
+		},
+		{
+			MethodName: "ListBackInStockSubscriptions",
+			Handler:    _InventoryService_ListBackInStockSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteBackInStockSubscription",
+			Handler:    _InventoryService_DeleteBackInStockSubscription_Handler,
+		},
+		{
+			MethodName: "ReceiveLot",
+			Handler:    _InventoryService_ReceiveLot_Handler,
+		},
+		{
+			MethodName: "ListLots",
+			Handler:    _InventoryService_ListLots_Handler,
+		},
+		{
+			MethodName: "ListExpiringLots",
+			Handler:    _InventoryService_ListExpiringLots_Handler,
+		},
+		{
+			MethodName: "SetInventoryUnit",
+			Handler:    _InventoryService_SetInventoryUnit_Handler,
+		},
+		{
+			MethodName: "ListInventoryUnits",
+			Handler:    _InventoryService_ListInventoryUnits_Handler,
+		},
+		{
+			MethodName: "DeleteInventoryUnit",
+			Handler:    _InventoryService_DeleteInventoryUnit_Handler,
+		},
+		{
+			MethodName: "SetWarehouseBin",
+			Handler:    _InventoryService_SetWarehouseBin_Handler,
+		},
+		{
+			MethodName: "ListWarehouseBins",
+			Handler:    _InventoryService_ListWarehouseBins_Handler,
+		},
+		{
+			MethodName: "DeleteWarehouseBin",
+			Handler:    _InventoryService_DeleteWarehouseBin_Handler,
+		},
+		{
+			MethodName: "SetBinStock",
+			Handler:    _InventoryService_SetBinStock_Handler,
+		},
+		{
+			MethodName: "ListBinStock",
+			Handler:    _InventoryService_ListBinStock_Handler,
+		},
+		{
+			MethodName: "GeneratePickList",
+			Handler:    _InventoryService_GeneratePickList_Handler,
+		},
+		{
+			MethodName: "CreateFulfillmentWave",
+			Handler:    _InventoryService_CreateFulfillmentWave_Handler,
+		},
+		{
+			MethodName: "GetFulfillmentWave",
+			Handler:    _InventoryService_GetFulfillmentWave_Handler,
+		},
+		{
+			MethodName: "ListFulfillmentWaves",
+			Handler:    _InventoryService_ListFulfillmentWaves_Handler,
+		},
+		{
+			MethodName: "GenerateWavePickList",
+			Handler:    _InventoryService_GenerateWavePickList_Handler,
+		},
+		{
+			MethodName: "CompleteFulfillmentWave",
+			Handler:    _InventoryService_CompleteFulfillmentWave_Handler,
+		},
+		{
+			MethodName: "CancelFulfillmentWave",
+			Handler:    _InventoryService_CancelFulfillmentWave_Handler,
+		},
+		{
+			MethodName: "RequestRefund",
+			Handler:    _InventoryService_RequestRefund_Handler,
+		},
+		{
+			MethodName: "GetRefund",
+			Handler:    _InventoryService_GetRefund_Handler,
+		},
+		{
+			MethodName: "ListRefunds",
+			Handler:    _InventoryService_ListRefunds_Handler,
+		},
+		{
+			MethodName: "ApproveRefund",
+			Handler:    _InventoryService_ApproveRefund_Handler,
+		},
+		{
+			MethodName: "RejectRefund",
+			Handler:    _InventoryService_RejectRefund_Handler,
+		},
+		{
+			MethodName: "RecordRefundResult",
+			Handler:    _InventoryService_RecordRefundResult_Handler,
+		},
+		{
+			MethodName: "ListRefundEvents",
+			Handler:    _InventoryService_ListRefundEvents_Handler,
+		},
+		{
+			MethodName: "ScreenOrder",
+			Handler:    _InventoryService_ScreenOrder_Handler,
+		},
+		{
+			MethodName: "GetFraudCheck",
+			Handler:    _InventoryService_GetFraudCheck_Handler,
+		},
+		{
+			MethodName: "ListFraudChecks",
+			Handler:    _InventoryService_ListFraudChecks_Handler,
+		},
+		{
+			MethodName: "ReviewFraudCheck",
+			Handler:    _InventoryService_ReviewFraudCheck_Handler,
+		},
+		{
+			MethodName: "ListFraudEvents",
+			Handler:    _InventoryService_ListFraudEvents_Handler,
+		},
+		{
+			MethodName: "SetRetailStore",
+			Handler:    _InventoryService_SetRetailStore_Handler,
+		},
+		{
+			MethodName: "DeleteRetailStore",
+			Handler:    _InventoryService_DeleteRetailStore_Handler,
+		},
+		{
+			MethodName: "ListRetailStores",
+			Handler:    _InventoryService_ListRetailStores_Handler,
+		},
+		{
+			MethodName: "FindNearbyStores",
+			Handler:    _InventoryService_FindNearbyStores_Handler,
+		},
+		{
+			MethodName: "ReserveInStore",
+			Handler:    _InventoryService_ReserveInStore_Handler,
+		},
+		{
+			MethodName: "CreatePickupOrder",
+			Handler:    _InventoryService_CreatePickupOrder_Handler,
+		},
+		{
+			MethodName: "GetPickupOrder",
+			Handler:    _InventoryService_GetPickupOrder_Handler,
+		},
+		{
+			MethodName: "ListPickupOrders",
+			Handler:    _InventoryService_ListPickupOrders_Handler,
+		},
+		{
+			MethodName: "StartPickupPicking",
+			Handler:    _InventoryService_StartPickupPicking_Handler,
+		},
+		{
+			MethodName: "MarkPickupReady",
+			Handler:    _InventoryService_MarkPickupReady_Handler,
+		},
+		{
+			MethodName: "CollectPickupOrder",
+			Handler:    _InventoryService_CollectPickupOrder_Handler,
+		},
+		{
+			MethodName: "CancelPickupOrder",
+			Handler:    _InventoryService_CancelPickupOrder_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchInventory",
+			Handler:       _InventoryService_WatchInventory_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/inventory.proto",
 }
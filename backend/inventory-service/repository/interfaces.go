@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
 )
@@ -20,10 +21,22 @@ type InventoryRepository interface {
 	GetInventoryLocations(ctx context.Context, inventoryItemID string) ([]models.InventoryLocation, error)
 	UpsertInventoryLocation(ctx context.Context, location *models.InventoryLocation) error
 	GetInventoryByWarehouse(ctx context.Context, warehouseID string, offset, limit int) ([]models.InventoryLocation, int, error)
+	SetLocationStockBuffers(ctx context.Context, inventoryItemID, warehouseID string, safetyStock, maxStock int) (*models.InventoryLocation, error)
+	ListStockAlerts(ctx context.Context, warehouseID string) ([]models.StockAlert, error)
 	
 	// Inventory Transaction operations
 	CreateInventoryTransaction(ctx context.Context, transaction *models.InventoryTransaction) error
 	GetInventoryTransactions(ctx context.Context, inventoryItemID string, limit int) ([]models.InventoryTransaction, error)
+	CopyInventoryTransactions(ctx context.Context, transactions []models.InventoryTransaction) (int, error)
+	ListInventoryActivity(ctx context.Context, beforeTime time.Time, beforeID string, limit int) ([]models.InventoryActivity, error)
+
+	// Idempotent stock mutation operations
+	// GetStockMutation loads the mutation recorded under an idempotency key
+	GetStockMutation(ctx context.Context, idempotencyKey string) (*models.StockMutation, error)
+	// SaveStockMovement writes a location and records its movement, and the
+	// mutation under its idempotency key if any, in one transaction. It
+	// returns models.ErrStockMutationRecorded when the key is already taken.
+	SaveStockMovement(ctx context.Context, location *models.InventoryLocation, movement *models.InventoryTransaction, mutation *models.StockMutation) error
 	
 	// Inventory Reservation operations
 	CreateReservation(ctx context.Context, reservation *models.InventoryReservation) error
@@ -31,6 +44,10 @@ type InventoryRepository interface {
 	UpdateReservation(ctx context.Context, reservation *models.InventoryReservation) error
 	GetActiveReservations(ctx context.Context, inventoryItemID string) ([]models.InventoryReservation, error)
 	CleanExpiredReservations(ctx context.Context) (int, error)
+	
+	// Inventory Snapshot operations
+	CreateInventorySnapshots(ctx context.Context, snapshotDate time.Time) (int, error)
+	GetInventorySnapshots(ctx context.Context, inventoryItemID string, warehouseID *string, from, to time.Time) ([]models.InventorySnapshot, error)
 }
 
 // WarehouseRepository defines the interface for warehouse data operations
@@ -41,3 +58,239 @@ type WarehouseRepository interface {
 	UpdateWarehouse(ctx context.Context, warehouse *models.Warehouse) error
 	ListWarehouses(ctx context.Context, offset, limit int, isActive *bool) ([]*models.Warehouse, int, error)
 }
+
+// FulfillmentRepository defines the data operations of the fulfillment
+// provider integration
+type FulfillmentRepository interface {
+	CreateIntegrationKey(ctx context.Context, key *models.IntegrationKey, keyHash string) error
+	ListIntegrationKeys(ctx context.Context) ([]models.IntegrationKey, error)
+	RevokeIntegrationKey(ctx context.Context, id string) (*models.IntegrationKey, error)
+	SetIntegrationKeyQuota(ctx context.Context, id string, dailyQuota int) (*models.IntegrationKey, error)
+	// AuthenticateIntegrationKey returns the active key of the current store
+	// with the given hash and records its use in the usage of the day
+	AuthenticateIntegrationKey(ctx context.Context, keyHash string) (*models.IntegrationKey, error)
+	// GetIntegrationKeyByHash returns the active key of the current store
+	// with the given hash without recording its use
+	GetIntegrationKeyByHash(ctx context.Context, keyHash string) (*models.IntegrationKey, error)
+
+	// ClaimFulfillmentEvent records an event as processing. It returns false
+	// when the event was already applied or is being applied.
+	ClaimFulfillmentEvent(ctx context.Context, provider string, event *models.FulfillmentEvent) (bool, error)
+	CompleteFulfillmentEvent(ctx context.Context, provider, eventID, status, errMessage string) error
+	// GetInventoryItemIDBySKU resolves a SKU of the current store
+	GetInventoryItemIDBySKU(ctx context.Context, sku string) (string, error)
+
+	CreateOrderStatusEvent(ctx context.Context, event *models.OrderStatusEvent) error
+	ListOrderStatusEvents(ctx context.Context, afterID int64, limit int) ([]models.OrderStatusEvent, error)
+}
+
+// ShipmentRepository defines the data operations of shipment tracking
+type ShipmentRepository interface {
+	// UpsertShipment creates the shipment with the carrier and tracking number
+	// of the given one, or attaches the existing shipment to its order and
+	// customer
+	UpsertShipment(ctx context.Context, shipment *models.Shipment) error
+	GetShipment(ctx context.Context, id string) (*models.Shipment, error)
+	GetShipmentByTrackingNumber(ctx context.Context, carrier, trackingNumber string) (*models.Shipment, error)
+	ListShipments(ctx context.Context, filter models.ShipmentFilter, offset, limit int) ([]models.Shipment, int, error)
+
+	// AddShipmentEvents records the events not recorded yet and moves the
+	// shipment to the status of its latest event. It returns the number of
+	// events added.
+	AddShipmentEvents(ctx context.Context, shipmentID string, events []models.ShipmentEvent) (int, error)
+	ListShipmentEvents(ctx context.Context, shipmentID string) ([]models.ShipmentEvent, error)
+
+	// ListShipmentsToPoll lists the shipments of all stores with the given
+	// carriers that are still under way and were not polled since
+	// polledBefore
+	ListShipmentsToPoll(ctx context.Context, carriers []string, polledBefore time.Time, limit int) ([]models.Shipment, error)
+	MarkShipmentPolled(ctx context.Context, id string) error
+}
+
+// BackInStockRepository defines the data operations of back-in-stock
+// subscriptions
+type BackInStockRepository interface {
+	// CreateSubscription subscribes an email, returning the pending
+	// subscription of the email to the same product and variant if any
+	CreateSubscription(ctx context.Context, sub *models.BackInStockSubscription) error
+	ListSubscriptions(ctx context.Context, filter models.BackInStockFilter, offset, limit int) ([]models.BackInStockSubscription, int, error)
+	DeleteSubscription(ctx context.Context, id, userID string) error
+	IsInStock(ctx context.Context, productID, variantID string) (bool, error)
+
+	// ClaimSubscriptions and ClaimRestockedSubscriptions mark the pending
+	// subscriptions to notify as notified and return them
+	ClaimSubscriptions(ctx context.Context, productID, variantID string) ([]models.BackInStockSubscription, error)
+	ClaimRestockedSubscriptions(ctx context.Context, limit int) ([]models.BackInStockSubscription, error)
+	ReleaseSubscription(ctx context.Context, id string) error
+}
+
+// PurchasingRepository defines the data operations of suppliers and
+// purchase orders
+type PurchasingRepository interface {
+	CreateSupplier(ctx context.Context, supplier *models.Supplier) error
+	GetSupplier(ctx context.Context, id string) (*models.Supplier, error)
+	UpdateSupplier(ctx context.Context, supplier *models.Supplier) error
+	ListSuppliers(ctx context.Context, offset, limit int, isActive *bool) ([]models.Supplier, int, error)
+
+	// UpsertSupplierProduct links an inventory item to a supplier or updates
+	// the link's cost price and lead time
+	UpsertSupplierProduct(ctx context.Context, product *models.SupplierProduct) error
+	DeleteSupplierProduct(ctx context.Context, supplierID, inventoryItemID string) error
+	ListSupplierProducts(ctx context.Context, supplierID string) ([]models.SupplierProduct, error)
+
+	// CreatePurchaseOrder creates a purchase order with its lines
+	CreatePurchaseOrder(ctx context.Context, order *models.PurchaseOrder) error
+	// GetPurchaseOrder retrieves a purchase order with its lines
+	GetPurchaseOrder(ctx context.Context, id string) (*models.PurchaseOrder, error)
+	// ListPurchaseOrders lists purchase orders without their lines
+	ListPurchaseOrders(ctx context.Context, filter models.PurchaseOrderFilter, offset, limit int) ([]models.PurchaseOrder, int, error)
+	// ReceivePurchaseOrder records the received quantities on the lines of
+	// an open purchase order and moves it to partially_received or received.
+	// Without lines, everything outstanding is received. It returns the order
+	// and the receipts recorded.
+	ReceivePurchaseOrder(ctx context.Context, id string, lines []models.ReceiptLine) (*models.PurchaseOrder, []models.ReceiptLine, error)
+	CancelPurchaseOrder(ctx context.Context, id string) (*models.PurchaseOrder, error)
+}
+
+// LotRepository defines the data operations of the lots of perishable
+// items. Reservations are allocated from lots by the InventoryRepository.
+type LotRepository interface {
+	// ReceiveLot adds units to a lot, creating it on its first receipt, and
+	// to the stock of its location
+	ReceiveLot(ctx context.Context, lot *models.InventoryLot, notes string) error
+	ListLots(ctx context.Context, inventoryItemID, warehouseID string, includeEmpty bool) ([]models.InventoryLot, error)
+	ListExpiringLots(ctx context.Context, before time.Time, warehouseID string, offset, limit int) ([]models.InventoryLot, int, error)
+	// WriteOffExpiredLots removes the unreserved units of the lots of all
+	// stores expired at the given time from the stock
+	WriteOffExpiredLots(ctx context.Context, now time.Time, limit int) ([]models.LotWriteOff, error)
+}
+
+// BinRepository defines the data operations of the bins of warehouses and
+// the stock placed in them
+type BinRepository interface {
+	// SaveBin creates a bin at its address or sets whether it is active
+	SaveBin(ctx context.Context, bin *models.WarehouseBin) error
+	GetBin(ctx context.Context, id string) (*models.WarehouseBin, error)
+	ListBins(ctx context.Context, warehouseID, zone string) ([]models.WarehouseBin, error)
+	// DeleteBin removes a bin, unless it holds stock
+	DeleteBin(ctx context.Context, id string) error
+	// SetBinStock sets the units of an item in a bin, removing the item from
+	// the bin at zero. The bins of the item at the warehouse may not hold
+	// more than its location.
+	SetBinStock(ctx context.Context, binID, inventoryItemID string, quantity int) (*models.BinStock, error)
+	// ListBinStock lists the stock placed in the bins of a warehouse, of the
+	// given items only when there are any
+	ListBinStock(ctx context.Context, warehouseID string, inventoryItemIDs []string, activeOnly bool) ([]models.BinStock, error)
+}
+
+// WaveRepository defines the data operations of fulfillment waves
+type WaveRepository interface {
+	// CreateWave creates a wave with a line for each confirmed order
+	// reservation selected by the filter that is not in another wave
+	CreateWave(ctx context.Context, wave *models.Wave, filter models.WaveFilter) error
+	// GetWave retrieves a wave with its lines
+	GetWave(ctx context.Context, id string) (*models.Wave, error)
+	ListWaves(ctx context.Context, warehouseID, status string, offset, limit int) ([]models.Wave, int, error)
+	// CompleteWave records the quantities picked of the lines of an open
+	// wave, lines not given being picked in full, and completes it
+	CompleteWave(ctx context.Context, id string, picked []models.PickedLine) (*models.Wave, error)
+	// CancelWave cancels an open wave, releasing its orders for later waves
+	CancelWave(ctx context.Context, id string) (*models.Wave, error)
+}
+
+// RefundRepository defines the data operations of refunds. Every change of
+// the status of a refund records a refund event with it.
+type RefundRepository interface {
+	CreateRefund(ctx context.Context, refund *models.Refund) error
+	// GetRefund retrieves a refund with its lines
+	GetRefund(ctx context.Context, id string) (*models.Refund, error)
+	ListRefunds(ctx context.Context, filter models.RefundFilter, offset, limit int) ([]models.Refund, int, error)
+	// UpdateRefundStatus moves a refund still in status from to the status of
+	// the given refund, with its review or processing details
+	UpdateRefundStatus(ctx context.Context, refund *models.Refund, from string) error
+	ListRefundEvents(ctx context.Context, afterID int64, limit int) ([]models.RefundEvent, error)
+}
+
+// FraudRepository defines the data operations of the fraud checks of orders.
+// Every decision on an order records a fraud event with it.
+type FraudRepository interface {
+	// CreateFraudCheck records the screening of an order, or returns
+	// models.ErrAlreadyExists when the order was screened before
+	CreateFraudCheck(ctx context.Context, check *models.FraudCheck) error
+	GetFraudCheck(ctx context.Context, id string) (*models.FraudCheck, error)
+	GetFraudCheckByOrder(ctx context.Context, orderReference string) (*models.FraudCheck, error)
+	ListFraudChecks(ctx context.Context, filter models.FraudCheckFilter, offset, limit int) ([]models.FraudCheck, int, error)
+	// ReviewFraudCheck records the decision of staff on an order still in
+	// review
+	ReviewFraudCheck(ctx context.Context, check *models.FraudCheck) error
+	CountRecentFraudChecks(ctx context.Context, email, ipAddress string, since time.Time) (byEmail, byIP int, err error)
+	ListFraudEvents(ctx context.Context, afterID int64, limit int) ([]models.FraudEvent, error)
+}
+
+// UnitRepository defines the data operations of the units of measure of
+// items
+type UnitRepository interface {
+	// SaveUnit creates a unit of an item or replaces its name and factor
+	SaveUnit(ctx context.Context, unit *models.InventoryUnit) error
+	GetUnit(ctx context.Context, inventoryItemID, code string) (*models.InventoryUnit, error)
+	ListUnits(ctx context.Context, inventoryItemID string) ([]models.InventoryUnit, error)
+	// DeleteUnit removes a unit, unless open purchase orders are still to be
+	// received in it
+	DeleteUnit(ctx context.Context, inventoryItemID, code string) error
+}
+
+// AvailabilityPolicyRepository defines the data operations of the
+// availability thresholds of products
+type AvailabilityPolicyRepository interface {
+	GetPolicy(ctx context.Context, productID string) (*models.AvailabilityPolicy, error)
+	// ListPolicies returns the policies of the products that have one, keyed
+	// by product ID
+	ListPolicies(ctx context.Context, productIDs []string) (map[string]*models.AvailabilityPolicy, error)
+	// SavePolicy creates the policy of a product or replaces it
+	SavePolicy(ctx context.Context, policy *models.AvailabilityPolicy) error
+	DeletePolicy(ctx context.Context, productID string) error
+}
+
+// RetailStoreRepository defines the data operations of retail stores, the
+// warehouses customers can visit
+type RetailStoreRepository interface {
+	// SaveStore makes a warehouse a retail store or replaces its store
+	// details, returning models.ErrWarehouseNotFound for unknown warehouses
+	SaveStore(ctx context.Context, store *models.RetailStore) error
+	GetStore(ctx context.Context, warehouseID string) (*models.RetailStore, error)
+	ListStores(ctx context.Context, activeOnly bool) ([]models.RetailStore, error)
+	DeleteStore(ctx context.Context, warehouseID string) error
+}
+
+// PickupRepository defines the data operations of click-and-collect pickup
+// orders
+type PickupRepository interface {
+	// CreatePickupOrder records an order with its lines and confirms their
+	// reservations, returning models.ErrAlreadyExists for a known order
+	// reference
+	CreatePickupOrder(ctx context.Context, order *models.PickupOrder) error
+	GetPickupOrder(ctx context.Context, id string) (*models.PickupOrder, error)
+	ListPickupOrders(ctx context.Context, filter models.PickupOrderFilter, offset, limit int) ([]models.PickupOrder, int, error)
+	// UpdatePickupStatus moves an order from status from to its status,
+	// returning models.ErrPickupInvalidTransition when it moved on
+	UpdatePickupStatus(ctx context.Context, order *models.PickupOrder, from string) error
+	// CollectPickupOrder removes the reserved units of a ready order from
+	// the stock of its store
+	CollectPickupOrder(ctx context.Context, id string, now time.Time) (*models.PickupOrder, error)
+	// CancelPickupOrder releases the reserved units of an order not collected
+	// back into the stock of its store
+	CancelPickupOrder(ctx context.Context, id, reason string, now time.Time) (*models.PickupOrder, error)
+	// ListOverduePickupOrders lists the ready orders of every tenant not
+	// collected by their collect-by time
+	ListOverduePickupOrders(ctx context.Context, now time.Time, limit int) ([]models.PickupOrder, error)
+}
+
+// ForecastRepository defines the data operations of stock forecasts
+type ForecastRepository interface {
+	// ListForecastInputs pages through the stock and recent sales of the
+	// items of every tenant at their active warehouses
+	ListForecastInputs(ctx context.Context, now time.Time, afterItemID, afterWarehouseID string, limit int) ([]models.ForecastInput, error)
+	SaveForecasts(ctx context.Context, forecasts []models.InventoryForecast) error
+	GetForecasts(ctx context.Context, inventoryItemID, warehouseID string) ([]models.InventoryForecast, error)
+	ListReorderSuggestions(ctx context.Context, warehouseID string, offset, limit int) ([]models.InventoryForecast, int, error)
+}
@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// AvailabilityPolicyRepository implements the
+// repository.AvailabilityPolicyRepository interface
+type AvailabilityPolicyRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewAvailabilityPolicyRepository creates a new PostgreSQL availability
+// policy repository
+func NewAvailabilityPolicyRepository(db *sql.DB, logger *zap.Logger) *AvailabilityPolicyRepository {
+	return &AvailabilityPolicyRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const availabilityPolicyColumns = `product_id, low_stock_threshold, out_of_stock_threshold, allow_backorder, updated_at`
+
+func scanAvailabilityPolicy(row interface{ Scan(...any) error }) (*models.AvailabilityPolicy, error) {
+	var policy models.AvailabilityPolicy
+	var lowStockThreshold sql.NullInt64
+	err := row.Scan(&policy.ProductID, &lowStockThreshold, &policy.OutOfStockThreshold,
+		&policy.AllowBackorder, &policy.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if lowStockThreshold.Valid {
+		threshold := int(lowStockThreshold.Int64)
+		policy.LowStockThreshold = &threshold
+	}
+	return &policy, nil
+}
+
+// GetPolicy retrieves the policy of a product of the current store
+func (r *AvailabilityPolicyRepository) GetPolicy(ctx context.Context, productID string) (*models.AvailabilityPolicy, error) {
+	query := `
+		SELECT ` + availabilityPolicyColumns + `
+		FROM availability_policies
+		WHERE tenant_id = $1 AND product_id = $2`
+
+	policy, err := scanAvailabilityPolicy(r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), productID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get availability policy: %w", err)
+	}
+	return policy, nil
+}
+
+// ListPolicies returns the policies of the products that have one, keyed by
+// product ID
+func (r *AvailabilityPolicyRepository) ListPolicies(ctx context.Context, productIDs []string) (map[string]*models.AvailabilityPolicy, error) {
+	policies := make(map[string]*models.AvailabilityPolicy)
+	if len(productIDs) == 0 {
+		return policies, nil
+	}
+
+	query := `
+		SELECT ` + availabilityPolicyColumns + `
+		FROM availability_policies
+		WHERE tenant_id = $1 AND product_id = ANY($2)`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), productIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list availability policies: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		policy, err := scanAvailabilityPolicy(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan availability policy: %w", err)
+		}
+		policies[policy.ProductID] = policy
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate availability policies: %w", err)
+	}
+	return policies, nil
+}
+
+// SavePolicy creates the policy of a product of the current store or
+// replaces it
+func (r *AvailabilityPolicyRepository) SavePolicy(ctx context.Context, policy *models.AvailabilityPolicy) error {
+	query := `
+		INSERT INTO availability_policies (tenant_id, product_id, low_stock_threshold, out_of_stock_threshold, allow_backorder)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (tenant_id, product_id) DO UPDATE SET
+			low_stock_threshold = EXCLUDED.low_stock_threshold,
+			out_of_stock_threshold = EXCLUDED.out_of_stock_threshold,
+			allow_backorder = EXCLUDED.allow_backorder,
+			updated_at = NOW()
+		RETURNING updated_at`
+
+	var lowStockThreshold sql.NullInt64
+	if policy.LowStockThreshold != nil {
+		lowStockThreshold = sql.NullInt64{Int64: int64(*policy.LowStockThreshold), Valid: true}
+	}
+
+	err := r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), policy.ProductID, lowStockThreshold,
+		policy.OutOfStockThreshold, policy.AllowBackorder).Scan(&policy.UpdatedAt)
+	if err != nil {
+		r.logger.Error("Failed to save availability policy", zap.Error(err), zap.String("product_id", policy.ProductID))
+		return fmt.Errorf("failed to save availability policy: %w", err)
+	}
+	return nil
+}
+
+// DeletePolicy removes the policy of a product, which falls back to the
+// default thresholds
+func (r *AvailabilityPolicyRepository) DeletePolicy(ctx context.Context, productID string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM availability_policies
+		WHERE tenant_id = $1 AND product_id = $2`,
+		tenant.FromContext(ctx), productID)
+	if err != nil {
+		return fmt.Errorf("failed to delete availability policy: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return models.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// BackInStockRepository implements the repository.BackInStockRepository interface
+type BackInStockRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewBackInStockRepository creates a new PostgreSQL back-in-stock repository
+func NewBackInStockRepository(db *sql.DB, logger *zap.Logger) *BackInStockRepository {
+	return &BackInStockRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const backInStockColumns = `id, product_id, variant_id, email, user_id, notified_at, created_at, tenant_id`
+
+func scanBackInStockSubscription(row interface{ Scan(...any) error }) (*models.BackInStockSubscription, error) {
+	var sub models.BackInStockSubscription
+	var userID sql.NullString
+	var notifiedAt sql.NullTime
+	err := row.Scan(&sub.ID, &sub.ProductID, &sub.VariantID, &sub.Email, &userID, &notifiedAt, &sub.CreatedAt, &sub.TenantID)
+	if err != nil {
+		return nil, err
+	}
+	sub.UserID = userID.String
+	if notifiedAt.Valid {
+		sub.NotifiedAt = &notifiedAt.Time
+	}
+	return &sub, nil
+}
+
+// inStockCondition matches the subscriptions s whose product, or variant,
+// has available units in an inventory item of their store
+const inStockCondition = `EXISTS (
+	SELECT 1 FROM inventory_items i
+	WHERE i.tenant_id = s.tenant_id
+		AND i.product_id::text = s.product_id
+		AND (s.variant_id = '' OR i.variant_id::text = s.variant_id)
+		AND i.available_quantity > 0
+)`
+
+// CreateSubscription subscribes an email to a product of the current store.
+// Subscribing again while the subscription is pending returns it, attached
+// to the given user when it had none.
+func (r *BackInStockRepository) CreateSubscription(ctx context.Context, sub *models.BackInStockSubscription) error {
+	query := `
+		INSERT INTO back_in_stock_subscriptions (tenant_id, product_id, variant_id, email, user_id)
+		VALUES ($1, $2, $3, $4, NULLIF($5, ''))
+		ON CONFLICT (tenant_id, product_id, variant_id, email) WHERE notified_at IS NULL DO UPDATE
+		SET user_id = COALESCE(back_in_stock_subscriptions.user_id, EXCLUDED.user_id)
+		RETURNING ` + backInStockColumns
+
+	saved, err := scanBackInStockSubscription(r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx),
+		sub.ProductID, sub.VariantID, sub.Email, sub.UserID))
+	if err != nil {
+		r.logger.Error("Failed to save back-in-stock subscription", zap.Error(err), zap.String("product_id", sub.ProductID))
+		return fmt.Errorf("failed to save back-in-stock subscription: %w", err)
+	}
+	*sub = *saved
+	return nil
+}
+
+// ListSubscriptions lists the subscriptions of the current store matching
+// the filter, newest first
+func (r *BackInStockRepository) ListSubscriptions(ctx context.Context, filter models.BackInStockFilter, offset, limit int) ([]models.BackInStockSubscription, int, error) {
+	conditions := []string{"tenant_id = $1"}
+	args := []interface{}{tenant.FromContext(ctx)}
+	argIndex := 2
+
+	if filter.UserID != "" {
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", argIndex))
+		args = append(args, filter.UserID)
+		argIndex++
+	}
+	if filter.ProductID != "" {
+		conditions = append(conditions, fmt.Sprintf("product_id = $%d", argIndex))
+		args = append(args, filter.ProductID)
+		argIndex++
+	}
+	if filter.PendingOnly {
+		conditions = append(conditions, "notified_at IS NULL")
+	}
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM back_in_stock_subscriptions "+whereClause, args...).Scan(&total); err != nil {
+		r.logger.Error("Failed to count back-in-stock subscriptions", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count back-in-stock subscriptions: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM back_in_stock_subscriptions
+		%s
+		ORDER BY created_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, backInStockColumns, whereClause, argIndex, argIndex+1)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Failed to list back-in-stock subscriptions", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to list back-in-stock subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subs, err := scanBackInStockSubscriptions(rows)
+	if err != nil {
+		return nil, 0, err
+	}
+	return subs, total, nil
+}
+
+// DeleteSubscription removes a subscription of the current store, only when
+// it belongs to userID if that is set
+func (r *BackInStockRepository) DeleteSubscription(ctx context.Context, id, userID string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM back_in_stock_subscriptions
+		WHERE id = $1 AND tenant_id = $2 AND ($3 = '' OR user_id = $3)`,
+		id, tenant.FromContext(ctx), userID)
+	if err != nil {
+		r.logger.Error("Failed to delete back-in-stock subscription", zap.Error(err), zap.String("id", id))
+		return fmt.Errorf("failed to delete back-in-stock subscription: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return models.ErrBackInStockSubscriptionNotFound
+	}
+	return nil
+}
+
+// IsInStock reports whether a product of the current store, or one of its
+// variants when variantID is set, has available units
+func (r *BackInStockRepository) IsInStock(ctx context.Context, productID, variantID string) (bool, error) {
+	var inStock bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM inventory_items
+			WHERE tenant_id = $1 AND product_id::text = $2
+				AND ($3 = '' OR variant_id::text = $3)
+				AND available_quantity > 0
+		)`,
+		tenant.FromContext(ctx), productID, variantID).Scan(&inStock)
+	if err != nil {
+		r.logger.Error("Failed to check stock", zap.Error(err), zap.String("product_id", productID))
+		return false, fmt.Errorf("failed to check stock: %w", err)
+	}
+	return inStock, nil
+}
+
+// ClaimSubscriptions marks the pending subscriptions of the current store to
+// a product back in stock as notified and returns them, so that concurrent
+// claims never notify a subscription twice. Subscriptions to any variant of
+// the product are claimed along with those to variantID.
+func (r *BackInStockRepository) ClaimSubscriptions(ctx context.Context, productID, variantID string) ([]models.BackInStockSubscription, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE back_in_stock_subscriptions
+		SET notified_at = NOW()
+		WHERE tenant_id = $1 AND product_id = $2
+			AND (variant_id = '' OR variant_id = $3)
+			AND notified_at IS NULL
+		RETURNING `+backInStockColumns,
+		tenant.FromContext(ctx), productID, variantID)
+	if err != nil {
+		r.logger.Error("Failed to claim back-in-stock subscriptions", zap.Error(err), zap.String("product_id", productID))
+		return nil, fmt.Errorf("failed to claim back-in-stock subscriptions: %w", err)
+	}
+	defer rows.Close()
+	return scanBackInStockSubscriptions(rows)
+}
+
+// ClaimRestockedSubscriptions claims up to limit pending subscriptions of all
+// stores whose product is in stock, for the stock changes the notifier
+// missed
+func (r *BackInStockRepository) ClaimRestockedSubscriptions(ctx context.Context, limit int) ([]models.BackInStockSubscription, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		UPDATE back_in_stock_subscriptions
+		SET notified_at = NOW()
+		WHERE id IN (
+			SELECT s.id FROM back_in_stock_subscriptions s
+			WHERE s.notified_at IS NULL AND `+inStockCondition+`
+			ORDER BY s.created_at
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+backInStockColumns,
+		limit)
+	if err != nil {
+		r.logger.Error("Failed to claim restocked subscriptions", zap.Error(err))
+		return nil, fmt.Errorf("failed to claim restocked subscriptions: %w", err)
+	}
+	defer rows.Close()
+	return scanBackInStockSubscriptions(rows)
+}
+
+// ReleaseSubscription returns a claimed subscription to pending after its
+// email could not be sent
+func (r *BackInStockRepository) ReleaseSubscription(ctx context.Context, id string) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE back_in_stock_subscriptions SET notified_at = NULL WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to release back-in-stock subscription: %w", err)
+	}
+	return nil
+}
+
+func scanBackInStockSubscriptions(rows *sql.Rows) ([]models.BackInStockSubscription, error) {
+	var subs []models.BackInStockSubscription
+	for rows.Next() {
+		sub, err := scanBackInStockSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan back-in-stock subscription: %w", err)
+		}
+		subs = append(subs, *sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating back-in-stock subscriptions: %w", err)
+	}
+	return subs, nil
+}
@@ -0,0 +1,271 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// BinRepository implements the repository.BinRepository interface
+type BinRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewBinRepository creates a new PostgreSQL warehouse bin repository
+func NewBinRepository(db *sql.DB, logger *zap.Logger) *BinRepository {
+	return &BinRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const binColumns = `b.id, b.warehouse_id, b.code, b.zone, b.aisle, b.shelf, b.bin, b.is_active,
+	b.created_at, b.updated_at`
+
+func scanBin(row interface{ Scan(...any) error }, extra ...any) (*models.WarehouseBin, error) {
+	var bin models.WarehouseBin
+	dest := append([]any{
+		&bin.ID, &bin.WarehouseID, &bin.Code, &bin.Zone, &bin.Aisle, &bin.Shelf, &bin.Bin, &bin.IsActive,
+		&bin.CreatedAt, &bin.UpdatedAt,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return &bin, nil
+}
+
+// SaveBin creates a bin of a warehouse of the current store at its address,
+// or sets whether the bin at that address is active
+func (r *BinRepository) SaveBin(ctx context.Context, bin *models.WarehouseBin) error {
+	query := `
+		INSERT INTO warehouse_bins AS b (tenant_id, warehouse_id, code, zone, aisle, shelf, bin, is_active)
+		SELECT $1, w.id, $3, $4, $5, $6, $7, $8
+		FROM warehouses w
+		WHERE w.id = $2 AND w.tenant_id = $1
+		ON CONFLICT (warehouse_id, zone, aisle, shelf, bin) DO UPDATE SET
+			is_active = EXCLUDED.is_active,
+			updated_at = NOW()
+		RETURNING ` + binColumns
+
+	saved, err := scanBin(r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), bin.WarehouseID,
+		bin.Code, bin.Zone, bin.Aisle, bin.Shelf, bin.Bin, bin.IsActive))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrWarehouseNotFound
+		}
+		r.logger.Error("Failed to save bin", zap.Error(err),
+			zap.String("warehouse_id", bin.WarehouseID), zap.String("code", bin.Code))
+		return fmt.Errorf("failed to save bin: %w", err)
+	}
+	*bin = *saved
+	return nil
+}
+
+// GetBin retrieves a bin of the current store
+func (r *BinRepository) GetBin(ctx context.Context, id string) (*models.WarehouseBin, error) {
+	query := `
+		SELECT ` + binColumns + `
+		FROM warehouse_bins b
+		WHERE b.id = $1 AND b.tenant_id = $2`
+
+	bin, err := scanBin(r.db.QueryRowContext(ctx, query, id, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrBinNotFound
+		}
+		return nil, fmt.Errorf("failed to get bin: %w", err)
+	}
+	return bin, nil
+}
+
+// ListBins lists the bins of a warehouse of the current store, of one zone
+// when given, by address
+func (r *BinRepository) ListBins(ctx context.Context, warehouseID, zone string) ([]models.WarehouseBin, error) {
+	query := `
+		SELECT ` + binColumns + `
+		FROM warehouse_bins b
+		WHERE b.tenant_id = $1 AND b.warehouse_id = $2 AND ($3 = '' OR b.zone = $3)
+		ORDER BY b.zone, b.aisle, b.shelf, b.bin`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), warehouseID, zone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bins: %w", err)
+	}
+	defer rows.Close()
+
+	var bins []models.WarehouseBin
+	for rows.Next() {
+		bin, err := scanBin(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bin: %w", err)
+		}
+		bins = append(bins, *bin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate bins: %w", err)
+	}
+	return bins, nil
+}
+
+// DeleteBin removes a bin of the current store, unless it holds stock
+func (r *BinRepository) DeleteBin(ctx context.Context, id string) error {
+	var exists, holdsStock bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT TRUE, EXISTS (SELECT 1 FROM inventory_bin_stock s WHERE s.bin_id = b.id)
+		FROM warehouse_bins b
+		WHERE b.id = $1 AND b.tenant_id = $2`,
+		id, tenant.FromContext(ctx)).Scan(&exists, &holdsStock)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrBinNotFound
+		}
+		return fmt.Errorf("failed to check stock of bin: %w", err)
+	}
+	if holdsStock {
+		return models.ErrBinNotEmpty
+	}
+
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM warehouse_bins WHERE id = $1 AND tenant_id = $2`,
+		id, tenant.FromContext(ctx)); err != nil {
+		var pgErr *pgconn.PgError
+		// Stock placed since the check
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return models.ErrBinNotEmpty
+		}
+		return fmt.Errorf("failed to delete bin: %w", err)
+	}
+	return nil
+}
+
+// SetBinStock sets the units of an item of the current store in a bin. The
+// location of the item at the bin's warehouse is locked while the bins of
+// the item are checked against it, so that concurrent placements cannot
+// exceed it together.
+func (r *BinRepository) SetBinStock(ctx context.Context, binID, inventoryItemID string, quantity int) (*models.BinStock, error) {
+	tenantID := tenant.FromContext(ctx)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	bin, err := scanBin(tx.QueryRowContext(ctx, `
+		SELECT `+binColumns+`
+		FROM warehouse_bins b
+		WHERE b.id = $1 AND b.tenant_id = $2`, binID, tenantID))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrBinNotFound
+		}
+		return nil, fmt.Errorf("failed to get bin: %w", err)
+	}
+	if !bin.IsActive && quantity > 0 {
+		return nil, models.ErrBinInactive
+	}
+
+	stock := &models.BinStock{Bin: *bin, InventoryItemID: inventoryItemID, Quantity: quantity}
+	var locationQuantity int
+	err = tx.QueryRowContext(ctx, `
+		SELECT l.quantity, i.sku
+		FROM inventory_locations l
+		JOIN inventory_items i ON i.id = l.inventory_item_id
+		WHERE l.inventory_item_id = $1 AND l.warehouse_id = $2 AND i.tenant_id = $3
+		FOR UPDATE OF l`,
+		inventoryItemID, bin.WarehouseID, tenantID).Scan(&locationQuantity, &stock.SKU)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to lock inventory location: %w", err)
+	}
+
+	var otherBins int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(s.quantity), 0)
+		FROM inventory_bin_stock s
+		JOIN warehouse_bins b ON b.id = s.bin_id
+		WHERE s.inventory_item_id = $1 AND b.warehouse_id = $2 AND s.bin_id <> $3`,
+		inventoryItemID, bin.WarehouseID, binID).Scan(&otherBins)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sum bin stock: %w", err)
+	}
+	if otherBins+quantity > locationQuantity {
+		return nil, models.ErrBinStockExceedsLocation
+	}
+
+	if quantity == 0 {
+		_, err = tx.ExecContext(ctx, `DELETE FROM inventory_bin_stock WHERE bin_id = $1 AND inventory_item_id = $2`,
+			binID, inventoryItemID)
+		stock.UpdatedAt = time.Now().UTC()
+	} else {
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO inventory_bin_stock (bin_id, inventory_item_id, quantity)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (bin_id, inventory_item_id) DO UPDATE SET
+				quantity = EXCLUDED.quantity,
+				updated_at = NOW()
+			RETURNING updated_at`,
+			binID, inventoryItemID, quantity).Scan(&stock.UpdatedAt)
+	}
+	if err != nil {
+		r.logger.Error("Failed to set bin stock", zap.Error(err),
+			zap.String("bin_id", binID), zap.String("inventory_item_id", inventoryItemID))
+		return nil, fmt.Errorf("failed to set bin stock: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return stock, nil
+}
+
+// ListBinStock lists the stock placed in the bins of a warehouse of the
+// current store, of the given items only when there are any and in active
+// bins only when asked
+func (r *BinRepository) ListBinStock(ctx context.Context, warehouseID string, inventoryItemIDs []string, activeOnly bool) ([]models.BinStock, error) {
+	var itemFilter any
+	if len(inventoryItemIDs) > 0 {
+		itemFilter = inventoryItemIDs
+	}
+
+	query := `
+		SELECT ` + binColumns + `, s.inventory_item_id, i.sku, s.quantity, s.updated_at
+		FROM inventory_bin_stock s
+		JOIN warehouse_bins b ON b.id = s.bin_id
+		JOIN inventory_items i ON i.id = s.inventory_item_id
+		WHERE b.tenant_id = $1 AND b.warehouse_id = $2
+			AND ($3::uuid[] IS NULL OR s.inventory_item_id = ANY($3::uuid[]))
+			AND (NOT $4 OR b.is_active)
+		ORDER BY b.zone, b.aisle, b.shelf, b.bin, i.sku`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), warehouseID, itemFilter, activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bin stock: %w", err)
+	}
+	defer rows.Close()
+
+	var stock []models.BinStock
+	for rows.Next() {
+		var s models.BinStock
+		bin, err := scanBin(rows, &s.InventoryItemID, &s.SKU, &s.Quantity, &s.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan bin stock: %w", err)
+		}
+		s.Bin = *bin
+		stock = append(stock, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate bin stock: %w", err)
+	}
+	return stock, nil
+}
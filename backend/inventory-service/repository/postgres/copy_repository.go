@@ -0,0 +1,103 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// CopyInventoryTransactions bulk inserts inventory movements with COPY FROM,
+// which streams all rows in one statement instead of one insert per movement.
+// It is meant for imports: either all movements are stored or none, and the
+// stock levels of the items are left untouched. The items must belong to the
+// store of ctx.
+func (r *InventoryRepository) CopyInventoryTransactions(ctx context.Context, transactions []models.InventoryTransaction) (int, error) {
+	if len(transactions) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now().UTC()
+	itemIDs := make([]string, len(transactions))
+	for i := range transactions {
+		t := &transactions[i]
+		if t.ID == "" {
+			t.ID = uuid.New().String()
+		}
+		if t.CreatedAt.IsZero() {
+			t.CreatedAt = now
+		}
+		itemIDs[i] = t.InventoryItemID
+	}
+
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		r.logger.Error("Failed to get connection", zap.Error(err))
+		return 0, fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	// COPY FROM is not exposed by database/sql, it runs on the pgx connection
+	err = conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver connection %T, want pgx", driverConn)
+		}
+		return pgx.BeginFunc(ctx, c.Conn(), func(tx pgx.Tx) error {
+			var foreign bool
+			if err := tx.QueryRow(ctx, `
+				SELECT EXISTS (
+					SELECT 1 FROM unnest($1::uuid[]) AS m(item_id)
+					WHERE NOT EXISTS (SELECT 1 FROM inventory_items i WHERE i.id = m.item_id AND i.tenant_id = $2)
+				)`,
+				itemIDs, tenant.FromContext(ctx),
+			).Scan(&foreign); err != nil {
+				return err
+			}
+			if foreign {
+				return models.ErrNotFound
+			}
+
+			columns := []string{
+				"id", "inventory_item_id", "warehouse_id", "transaction_type", "quantity",
+				"reference_id", "reference_type", "notes", "created_by", "created_at",
+			}
+			_, err := tx.CopyFrom(ctx, pgx.Identifier{"inventory_transactions"}, columns,
+				pgx.CopyFromSlice(len(transactions), func(i int) ([]any, error) {
+					t := &transactions[i]
+					return []any{
+						t.ID, t.InventoryItemID, copyValue(t.WarehouseID), t.TransactionType, t.Quantity,
+						copyValue(t.ReferenceID), copyValue(t.ReferenceType), copyValue(t.Notes), copyValue(t.CreatedBy), t.CreatedAt,
+					}, nil
+				}))
+			return err
+		})
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return 0, err
+		}
+		r.logger.Error("Failed to copy inventory transactions", zap.Error(err))
+		return 0, fmt.Errorf("failed to copy inventory transactions: %w", err)
+	}
+
+	return len(transactions), nil
+}
+
+// copyValue returns an optional string as a COPY value, nil when unset. COPY
+// sends the values in binary, which pgx converts plain strings to, but not
+// pointers to strings.
+func copyValue(s *string) any {
+	if s == nil {
+		return nil
+	}
+	return *s
+}
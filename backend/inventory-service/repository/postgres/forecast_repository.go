@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// ForecastRepository implements the repository.ForecastRepository interface
+type ForecastRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewForecastRepository creates a new PostgreSQL forecast repository
+func NewForecastRepository(db *sql.DB, logger *zap.Logger) *ForecastRepository {
+	return &ForecastRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const forecastColumns = `f.inventory_item_id, i.product_id, i.sku, f.warehouse_id, f.short_velocity,
+	f.long_velocity, f.daily_velocity, f.available_quantity, f.on_order_quantity, f.safety_stock,
+	f.lead_time_days, f.days_of_cover, f.stockout_date, f.reorder_quantity, f.computed_at`
+
+func scanForecast(row interface{ Scan(...any) error }, extra ...any) (*models.InventoryForecast, error) {
+	var forecast models.InventoryForecast
+	var stockoutDate sql.NullTime
+	dest := append([]any{
+		&forecast.InventoryItemID, &forecast.ProductID, &forecast.SKU, &forecast.WarehouseID, &forecast.ShortVelocity,
+		&forecast.LongVelocity, &forecast.DailyVelocity, &forecast.AvailableQuantity, &forecast.OnOrderQuantity, &forecast.SafetyStock,
+		&forecast.LeadTimeDays, &forecast.DaysOfCover, &stockoutDate, &forecast.ReorderQuantity, &forecast.ComputedAt,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if stockoutDate.Valid {
+		forecast.StockoutDate = &stockoutDate.Time
+	}
+	return &forecast, nil
+}
+
+// firstForecastKey sorts before every inventory item and warehouse ID
+const firstForecastKey = "00000000-0000-0000-0000-000000000000"
+
+// ListForecastInputs returns the stock and sales of the items of all stores
+// at their active warehouses, ordered by item and warehouse after the
+// location with afterItemID and afterWarehouseID, or from the first location
+// when they are empty. Sales are counted since now less the long velocity
+// window.
+func (r *ForecastRepository) ListForecastInputs(ctx context.Context, now time.Time, afterItemID, afterWarehouseID string, limit int) ([]models.ForecastInput, error) {
+	if afterItemID == "" {
+		afterItemID, afterWarehouseID = firstForecastKey, firstForecastKey
+	}
+
+	query := `
+		SELECT l.inventory_item_id, i.product_id, i.sku, l.warehouse_id, i.tenant_id,
+			l.available_quantity, l.safety_stock, o.on_order, sp.lead_time_days,
+			s.short_sold, s.long_sold, u.short_sold, u.long_sold,
+			COALESCE(l.quantity::float8 / NULLIF(i.total_quantity, 0),
+				1.0 / (SELECT COUNT(*) FROM inventory_locations c WHERE c.inventory_item_id = l.inventory_item_id))
+		FROM inventory_locations l
+		JOIN inventory_items i ON i.id = l.inventory_item_id
+		JOIN warehouses w ON w.id = l.warehouse_id AND w.is_active
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(r.quantity) FILTER (WHERE r.created_at >= $1), 0) AS short_sold,
+				COALESCE(SUM(r.quantity), 0) AS long_sold
+			FROM inventory_reservations r
+			WHERE r.inventory_item_id = l.inventory_item_id AND r.warehouse_id = l.warehouse_id
+				AND r.status IN ($3, $4) AND r.created_at >= $2
+		) s
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(r.quantity) FILTER (WHERE r.created_at >= $1), 0) AS short_sold,
+				COALESCE(SUM(r.quantity), 0) AS long_sold
+			FROM inventory_reservations r
+			WHERE r.inventory_item_id = l.inventory_item_id AND r.warehouse_id IS NULL
+				AND r.status IN ($3, $4) AND r.created_at >= $2
+		) u
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(pl.quantity_ordered - pl.quantity_received), 0) AS on_order
+			FROM purchase_order_lines pl
+			JOIN purchase_orders po ON po.id = pl.purchase_order_id
+			WHERE pl.inventory_item_id = l.inventory_item_id AND po.warehouse_id = l.warehouse_id
+				AND po.status IN ($5, $6)
+		) o
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(MIN(lead_time_days), 0) AS lead_time_days
+			FROM supplier_products
+			WHERE inventory_item_id = l.inventory_item_id
+		) sp
+		WHERE (l.inventory_item_id, l.warehouse_id) > ($7::uuid, $8::uuid)
+		ORDER BY l.inventory_item_id, l.warehouse_id
+		LIMIT $9`
+
+	rows, err := r.db.QueryContext(ctx, query,
+		now.AddDate(0, 0, -models.ShortVelocityDays), now.AddDate(0, 0, -models.LongVelocityDays),
+		models.ReservationConfirmed, models.ReservationFulfilled,
+		models.PurchaseOrderOpen, models.PurchaseOrderPartiallyReceived,
+		afterItemID, afterWarehouseID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list forecast inputs: %w", err)
+	}
+	defer rows.Close()
+
+	var inputs []models.ForecastInput
+	for rows.Next() {
+		var in models.ForecastInput
+		err := rows.Scan(
+			&in.InventoryItemID, &in.ProductID, &in.SKU, &in.WarehouseID, &in.TenantID,
+			&in.AvailableQuantity, &in.SafetyStock, &in.OnOrderQuantity, &in.LeadTimeDays,
+			&in.ShortSold, &in.LongSold, &in.UnassignedShort, &in.UnassignedLong, &in.Share,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan forecast input: %w", err)
+		}
+		inputs = append(inputs, in)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate forecast inputs: %w", err)
+	}
+	return inputs, nil
+}
+
+// SaveForecasts records computed forecasts, replacing the previous forecast
+// of each item at each warehouse
+func (r *ForecastRepository) SaveForecasts(ctx context.Context, forecasts []models.InventoryForecast) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO inventory_forecasts (
+			inventory_item_id, warehouse_id, tenant_id, short_velocity, long_velocity, daily_velocity,
+			available_quantity, on_order_quantity, safety_stock, lead_time_days, days_of_cover,
+			stockout_date, reorder_quantity, computed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		ON CONFLICT (inventory_item_id, warehouse_id) DO UPDATE SET
+			short_velocity = EXCLUDED.short_velocity,
+			long_velocity = EXCLUDED.long_velocity,
+			daily_velocity = EXCLUDED.daily_velocity,
+			available_quantity = EXCLUDED.available_quantity,
+			on_order_quantity = EXCLUDED.on_order_quantity,
+			safety_stock = EXCLUDED.safety_stock,
+			lead_time_days = EXCLUDED.lead_time_days,
+			days_of_cover = EXCLUDED.days_of_cover,
+			stockout_date = EXCLUDED.stockout_date,
+			reorder_quantity = EXCLUDED.reorder_quantity,
+			computed_at = EXCLUDED.computed_at`)
+	if err != nil {
+		return fmt.Errorf("failed to prepare forecast insert: %w", err)
+	}
+	defer stmt.Close()
+
+	for _, f := range forecasts {
+		_, err := stmt.ExecContext(ctx,
+			f.InventoryItemID, f.WarehouseID, f.TenantID, f.ShortVelocity, f.LongVelocity, f.DailyVelocity,
+			f.AvailableQuantity, f.OnOrderQuantity, f.SafetyStock, f.LeadTimeDays, f.DaysOfCover,
+			f.StockoutDate, f.ReorderQuantity, f.ComputedAt)
+		if err != nil {
+			r.logger.Error("Failed to save forecast", zap.Error(err),
+				zap.String("inventory_item_id", f.InventoryItemID), zap.String("warehouse_id", f.WarehouseID))
+			return fmt.Errorf("failed to save forecast: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetForecasts returns the forecasts of an item of the current store at each
+// of its warehouses, of a single warehouse when warehouseID is set
+func (r *ForecastRepository) GetForecasts(ctx context.Context, inventoryItemID, warehouseID string) ([]models.InventoryForecast, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+forecastColumns+`
+		FROM inventory_forecasts f
+		JOIN inventory_items i ON i.id = f.inventory_item_id
+		WHERE f.inventory_item_id = $1 AND f.tenant_id = $2 AND ($3 = '' OR f.warehouse_id::text = $3)
+		ORDER BY f.warehouse_id`,
+		inventoryItemID, tenant.FromContext(ctx), warehouseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get forecasts: %w", err)
+	}
+	defer rows.Close()
+
+	var forecasts []models.InventoryForecast
+	for rows.Next() {
+		forecast, err := scanForecast(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan forecast: %w", err)
+		}
+		forecasts = append(forecasts, *forecast)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate forecasts: %w", err)
+	}
+	return forecasts, nil
+}
+
+// ListReorderSuggestions lists the forecasts of the current store with a
+// quantity to reorder, of a single warehouse when warehouseID is set, the
+// items running out first first
+func (r *ForecastRepository) ListReorderSuggestions(ctx context.Context, warehouseID string, offset, limit int) ([]models.InventoryForecast, int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+forecastColumns+`, COUNT(*) OVER()
+		FROM inventory_forecasts f
+		JOIN inventory_items i ON i.id = f.inventory_item_id
+		WHERE f.tenant_id = $1 AND f.reorder_quantity > 0 AND ($2 = '' OR f.warehouse_id::text = $2)
+		ORDER BY f.stockout_date NULLS LAST, i.sku, f.warehouse_id
+		LIMIT $3 OFFSET $4`,
+		tenant.FromContext(ctx), warehouseID, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list reorder suggestions: %w", err)
+	}
+	defer rows.Close()
+
+	var forecasts []models.InventoryForecast
+	total := 0
+	for rows.Next() {
+		forecast, err := scanForecast(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan forecast: %w", err)
+		}
+		forecasts = append(forecasts, *forecast)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate forecasts: %w", err)
+	}
+	return forecasts, total, nil
+}
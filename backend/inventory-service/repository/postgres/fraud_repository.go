@@ -0,0 +1,257 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// FraudRepository implements the repository.FraudRepository interface
+type FraudRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewFraudRepository creates a new PostgreSQL fraud repository
+func NewFraudRepository(db *sql.DB, logger *zap.Logger) *FraudRepository {
+	return &FraudRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const fraudCheckColumns = `id, order_reference, user_id, email, ip_address, billing_country, shipping_country,
+	amount, currency, score, decision, signals, reviewed_by, reviewed_at, review_notes, created_at, updated_at`
+
+func scanFraudCheck(row interface{ Scan(...any) error }, extra ...any) (*models.FraudCheck, error) {
+	var check models.FraudCheck
+	var signals []byte
+	var reviewedAt sql.NullTime
+	dest := append([]any{
+		&check.ID, &check.OrderReference, &check.UserID, &check.Email, &check.IPAddress,
+		&check.BillingCountry, &check.ShippingCountry, &check.Amount, &check.Currency, &check.Score,
+		&check.Decision, &signals, &check.ReviewedBy, &reviewedAt, &check.ReviewNotes,
+		&check.CreatedAt, &check.UpdatedAt,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(signals, &check.Signals); err != nil {
+		return nil, fmt.Errorf("failed to decode fraud signals: %w", err)
+	}
+	if reviewedAt.Valid {
+		check.ReviewedAt = &reviewedAt.Time
+	}
+	return &check, nil
+}
+
+// CreateFraudCheck records the screening of an order of the current store
+// and its decision as a fraud event
+func (r *FraudRepository) CreateFraudCheck(ctx context.Context, check *models.FraudCheck) error {
+	signals, err := json.Marshal(check.Signals)
+	if err != nil {
+		return fmt.Errorf("failed to encode fraud signals: %w", err)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO fraud_checks (
+			tenant_id, order_reference, user_id, email, ip_address, billing_country, shipping_country,
+			amount, currency, score, decision, signals
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		RETURNING id, created_at, updated_at`,
+		tenant.FromContext(ctx), check.OrderReference, check.UserID, check.Email, check.IPAddress,
+		check.BillingCountry, check.ShippingCountry, check.Amount, check.Currency, check.Score,
+		check.Decision, signals,
+	).Scan(&check.ID, &check.CreatedAt, &check.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return models.ErrAlreadyExists
+		}
+		r.logger.Error("Failed to create fraud check", zap.Error(err), zap.String("order_reference", check.OrderReference))
+		return fmt.Errorf("failed to create fraud check: %w", err)
+	}
+
+	if err := insertFraudEvent(ctx, tx, check.ID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetFraudCheck retrieves a fraud check of the current store
+func (r *FraudRepository) GetFraudCheck(ctx context.Context, id string) (*models.FraudCheck, error) {
+	check, err := scanFraudCheck(r.db.QueryRowContext(ctx, `
+		SELECT `+fraudCheckColumns+`
+		FROM fraud_checks
+		WHERE id = $1 AND tenant_id = $2`, id, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrFraudCheckNotFound
+		}
+		return nil, fmt.Errorf("failed to get fraud check: %w", err)
+	}
+	return check, nil
+}
+
+// GetFraudCheckByOrder retrieves the fraud check of an order of the current
+// store
+func (r *FraudRepository) GetFraudCheckByOrder(ctx context.Context, orderReference string) (*models.FraudCheck, error) {
+	check, err := scanFraudCheck(r.db.QueryRowContext(ctx, `
+		SELECT `+fraudCheckColumns+`
+		FROM fraud_checks
+		WHERE order_reference = $1 AND tenant_id = $2`, orderReference, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrFraudCheckNotFound
+		}
+		return nil, fmt.Errorf("failed to get fraud check: %w", err)
+	}
+	return check, nil
+}
+
+// ListFraudChecks lists the fraud checks of the current store. Orders in
+// review are listed oldest first, as a queue; other listings newest first.
+func (r *FraudRepository) ListFraudChecks(ctx context.Context, filter models.FraudCheckFilter, offset, limit int) ([]models.FraudCheck, int, error) {
+	order := "created_at DESC"
+	if filter.Decision == models.FraudReview {
+		order = "created_at"
+	}
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+fraudCheckColumns+`, COUNT(*) OVER()
+		FROM fraud_checks
+		WHERE tenant_id = $1
+			AND ($2 = '' OR decision = $2)
+			AND ($3 = '' OR order_reference = $3)
+		ORDER BY `+order+`
+		LIMIT $4 OFFSET $5`,
+		tenant.FromContext(ctx), filter.Decision, filter.OrderReference, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list fraud checks: %w", err)
+	}
+	defer rows.Close()
+
+	var checks []models.FraudCheck
+	total := 0
+	for rows.Next() {
+		check, err := scanFraudCheck(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan fraud check: %w", err)
+		}
+		checks = append(checks, *check)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate fraud checks: %w", err)
+	}
+	return checks, total, nil
+}
+
+// ReviewFraudCheck records the decision of staff on an order of the current
+// store still in review, with a fraud event
+func (r *FraudRepository) ReviewFraudCheck(ctx context.Context, check *models.FraudCheck) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE fraud_checks
+		SET decision = $4, reviewed_by = $5, reviewed_at = $6, review_notes = $7, updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2 AND decision = $3
+		RETURNING updated_at`,
+		check.ID, tenant.FromContext(ctx), models.FraudReview, check.Decision, check.ReviewedBy,
+		check.ReviewedAt, check.ReviewNotes,
+	).Scan(&check.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrFraudCheckNotInReview
+		}
+		r.logger.Error("Failed to review fraud check", zap.Error(err), zap.String("fraud_check_id", check.ID))
+		return fmt.Errorf("failed to review fraud check: %w", err)
+	}
+
+	if err := insertFraudEvent(ctx, tx, check.ID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// insertFraudEvent records the current decision on an order as an event
+func insertFraudEvent(ctx context.Context, tx *sql.Tx, checkID string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO fraud_events (tenant_id, fraud_check_id, order_reference, decision, score, reviewed_by)
+		SELECT tenant_id, id, order_reference, decision, score, reviewed_by
+		FROM fraud_checks
+		WHERE id = $1`, checkID)
+	if err != nil {
+		return fmt.Errorf("failed to record fraud event: %w", err)
+	}
+	return nil
+}
+
+// CountRecentFraudChecks counts the orders of the current store screened
+// since the given time with the email address and with the IP address
+func (r *FraudRepository) CountRecentFraudChecks(ctx context.Context, email, ipAddress string, since time.Time) (int, int, error) {
+	var byEmail, byIP int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE $2 <> '' AND email = $2),
+			COUNT(*) FILTER (WHERE $3 <> '' AND ip_address = $3)
+		FROM fraud_checks
+		WHERE tenant_id = $1 AND created_at >= $4 AND (email = $2 OR ip_address = $3)`,
+		tenant.FromContext(ctx), email, ipAddress, since,
+	).Scan(&byEmail, &byIP)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to count recent fraud checks: %w", err)
+	}
+	return byEmail, byIP, nil
+}
+
+// ListFraudEvents lists the fraud events of the current store after the
+// given ID, oldest first
+func (r *FraudRepository) ListFraudEvents(ctx context.Context, afterID int64, limit int) ([]models.FraudEvent, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, fraud_check_id, order_reference, decision, score, reviewed_by, created_at
+		FROM fraud_events
+		WHERE tenant_id = $1 AND id > $2
+		ORDER BY id
+		LIMIT $3`, tenant.FromContext(ctx), afterID, limit)
+	if err != nil {
+		r.logger.Error("Failed to list fraud events", zap.Error(err))
+		return nil, fmt.Errorf("failed to list fraud events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.FraudEvent
+	for rows.Next() {
+		var event models.FraudEvent
+		if err := rows.Scan(&event.ID, &event.FraudCheckID, &event.OrderReference, &event.Decision,
+			&event.Score, &event.ReviewedBy, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan fraud event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating fraud events: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,300 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// FulfillmentRepository implements the repository.FulfillmentRepository interface
+type FulfillmentRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewFulfillmentRepository creates a new PostgreSQL fulfillment repository
+func NewFulfillmentRepository(db *sql.DB, logger *zap.Logger) *FulfillmentRepository {
+	return &FulfillmentRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// quotaDay is the day (UTC) the usage of integration keys is counted in
+const quotaDay = `(NOW() AT TIME ZONE 'UTC')::date`
+
+const integrationKeyColumns = `id, provider, name, key_prefix, created_at, last_used_at, revoked_at, daily_quota,
+	COALESCE((
+		SELECT requests FROM integration_key_usage u
+		WHERE u.key_id = integration_api_keys.id AND u.day = ` + quotaDay + `
+	), 0)`
+
+func scanIntegrationKey(row interface{ Scan(...any) error }) (*models.IntegrationKey, error) {
+	var key models.IntegrationKey
+	var lastUsedAt, revokedAt sql.NullTime
+	if err := row.Scan(&key.ID, &key.Provider, &key.Name, &key.KeyPrefix, &key.CreatedAt, &lastUsedAt, &revokedAt,
+		&key.DailyQuota, &key.UsedToday); err != nil {
+		return nil, err
+	}
+	if lastUsedAt.Valid {
+		key.LastUsedAt = &lastUsedAt.Time
+	}
+	if revokedAt.Valid {
+		key.RevokedAt = &revokedAt.Time
+	}
+	return &key, nil
+}
+
+// CreateIntegrationKey stores a new API key of the current store by its hash
+func (r *FulfillmentRepository) CreateIntegrationKey(ctx context.Context, key *models.IntegrationKey, keyHash string) error {
+	query := `
+		INSERT INTO integration_api_keys (tenant_id, provider, name, key_prefix, key_hash, daily_quota)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), key.Provider, key.Name, key.KeyPrefix, keyHash, key.DailyQuota).
+		Scan(&key.ID, &key.CreatedAt)
+	if err != nil {
+		r.logger.Error("Failed to create integration key", zap.Error(err), zap.String("provider", key.Provider))
+		return fmt.Errorf("failed to create integration key: %w", err)
+	}
+	return nil
+}
+
+// ListIntegrationKeys lists the API keys of the current store, newest first
+func (r *FulfillmentRepository) ListIntegrationKeys(ctx context.Context) ([]models.IntegrationKey, error) {
+	query := `
+		SELECT ` + integrationKeyColumns + `
+		FROM integration_api_keys
+		WHERE tenant_id = $1
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("Failed to list integration keys", zap.Error(err))
+		return nil, fmt.Errorf("failed to list integration keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []models.IntegrationKey
+	for rows.Next() {
+		key, err := scanIntegrationKey(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan integration key: %w", err)
+		}
+		keys = append(keys, *key)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating integration keys: %w", err)
+	}
+	return keys, nil
+}
+
+// RevokeIntegrationKey revokes an API key of the current store. Revoking a
+// revoked key keeps its first revocation time.
+func (r *FulfillmentRepository) RevokeIntegrationKey(ctx context.Context, id string) (*models.IntegrationKey, error) {
+	query := `
+		UPDATE integration_api_keys
+		SET revoked_at = COALESCE(revoked_at, NOW())
+		WHERE id = $1 AND tenant_id = $2
+		RETURNING ` + integrationKeyColumns
+
+	key, err := scanIntegrationKey(r.db.QueryRowContext(ctx, query, id, tenant.FromContext(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrIntegrationKeyNotFound
+		}
+		r.logger.Error("Failed to revoke integration key", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to revoke integration key: %w", err)
+	}
+	return key, nil
+}
+
+// SetIntegrationKeyQuota changes the daily quota of an API key of the
+// current store
+func (r *FulfillmentRepository) SetIntegrationKeyQuota(ctx context.Context, id string, dailyQuota int) (*models.IntegrationKey, error) {
+	query := `
+		UPDATE integration_api_keys
+		SET daily_quota = $3
+		WHERE id = $1 AND tenant_id = $2
+		RETURNING ` + integrationKeyColumns
+
+	key, err := scanIntegrationKey(r.db.QueryRowContext(ctx, query, id, tenant.FromContext(ctx), dailyQuota))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrIntegrationKeyNotFound
+		}
+		r.logger.Error("Failed to set integration key quota", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to set integration key quota: %w", err)
+	}
+	return key, nil
+}
+
+// AuthenticateIntegrationKey returns the active key of the current store with
+// the given hash and records its use, counting the request in the usage of
+// the day
+func (r *FulfillmentRepository) AuthenticateIntegrationKey(ctx context.Context, keyHash string) (*models.IntegrationKey, error) {
+	query := `
+		WITH authenticated AS (
+			UPDATE integration_api_keys
+			SET last_used_at = NOW()
+			WHERE key_hash = $1 AND tenant_id = $2 AND revoked_at IS NULL
+			RETURNING id, provider, name, key_prefix, created_at, last_used_at, revoked_at, daily_quota
+		), counted AS (
+			INSERT INTO integration_key_usage (key_id, day, requests)
+			SELECT id, ` + quotaDay + `, 1 FROM authenticated
+			ON CONFLICT (key_id, day) DO UPDATE SET requests = integration_key_usage.requests + 1
+			RETURNING requests
+		)
+		SELECT a.id, a.provider, a.name, a.key_prefix, a.created_at, a.last_used_at, a.revoked_at, a.daily_quota, u.requests
+		FROM authenticated a, counted u
+	`
+
+	key, err := scanIntegrationKey(r.db.QueryRowContext(ctx, query, keyHash, tenant.FromContext(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrInvalidIntegrationKey
+		}
+		r.logger.Error("Failed to authenticate integration key", zap.Error(err))
+		return nil, fmt.Errorf("failed to authenticate integration key: %w", err)
+	}
+	return key, nil
+}
+
+// GetIntegrationKeyByHash returns the active key of the current store with
+// the given hash, without recording its use
+func (r *FulfillmentRepository) GetIntegrationKeyByHash(ctx context.Context, keyHash string) (*models.IntegrationKey, error) {
+	query := `
+		SELECT ` + integrationKeyColumns + `
+		FROM integration_api_keys
+		WHERE key_hash = $1 AND tenant_id = $2 AND revoked_at IS NULL
+	`
+
+	key, err := scanIntegrationKey(r.db.QueryRowContext(ctx, query, keyHash, tenant.FromContext(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrInvalidIntegrationKey
+		}
+		r.logger.Error("Failed to get integration key", zap.Error(err))
+		return nil, fmt.Errorf("failed to get integration key: %w", err)
+	}
+	return key, nil
+}
+
+// ClaimFulfillmentEvent records an event as processing. Failed events, and
+// events left processing by a crash, may be claimed again.
+func (r *FulfillmentRepository) ClaimFulfillmentEvent(ctx context.Context, provider string, event *models.FulfillmentEvent) (bool, error) {
+	query := `
+		INSERT INTO fulfillment_events (tenant_id, provider, event_id, event_type, status)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (tenant_id, provider, event_id) DO UPDATE
+		SET event_type = EXCLUDED.event_type, status = EXCLUDED.status, error = NULL,
+			received_at = NOW(), processed_at = NULL
+		WHERE fulfillment_events.status = $6
+			OR (fulfillment_events.status = $5 AND fulfillment_events.received_at < NOW() - INTERVAL '10 minutes')
+		RETURNING id
+	`
+
+	var id string
+	err := r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), provider, event.ID, event.Type,
+		models.FulfillmentEventProcessing, models.FulfillmentEventFailed).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		r.logger.Error("Failed to claim fulfillment event", zap.Error(err), zap.String("event_id", event.ID))
+		return false, fmt.Errorf("failed to claim fulfillment event: %w", err)
+	}
+	return true, nil
+}
+
+// CompleteFulfillmentEvent records the outcome of a claimed event
+func (r *FulfillmentRepository) CompleteFulfillmentEvent(ctx context.Context, provider, eventID, status, errMessage string) error {
+	query := `
+		UPDATE fulfillment_events
+		SET status = $4, error = NULLIF($5, ''), processed_at = NOW()
+		WHERE tenant_id = $1 AND provider = $2 AND event_id = $3
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, tenant.FromContext(ctx), provider, eventID, status, errMessage); err != nil {
+		r.logger.Error("Failed to complete fulfillment event", zap.Error(err), zap.String("event_id", eventID))
+		return fmt.Errorf("failed to complete fulfillment event: %w", err)
+	}
+	return nil
+}
+
+// GetInventoryItemIDBySKU resolves a SKU to the inventory item of the current
+// store. SKUs are unique across stores, so a provider must not reach the
+// items of another store through them.
+func (r *FulfillmentRepository) GetInventoryItemIDBySKU(ctx context.Context, sku string) (string, error) {
+	query := `SELECT id FROM inventory_items WHERE sku = $1 AND tenant_id = $2`
+
+	var id string
+	if err := r.db.QueryRowContext(ctx, query, sku, tenant.FromContext(ctx)).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return "", models.ErrNotFound
+		}
+		r.logger.Error("Failed to get inventory item by SKU", zap.Error(err), zap.String("sku", sku))
+		return "", fmt.Errorf("failed to get inventory item by SKU: %w", err)
+	}
+	return id, nil
+}
+
+// CreateOrderStatusEvent records an order status change of the current store
+func (r *FulfillmentRepository) CreateOrderStatusEvent(ctx context.Context, event *models.OrderStatusEvent) error {
+	query := `
+		INSERT INTO order_status_events (
+			tenant_id, order_reference, status, provider, carrier, tracking_number, occurred_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), event.OrderReference, event.Status,
+		event.Provider, event.Carrier, event.TrackingNumber, event.OccurredAt).Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		r.logger.Error("Failed to create order status event", zap.Error(err), zap.String("order_reference", event.OrderReference))
+		return fmt.Errorf("failed to create order status event: %w", err)
+	}
+	return nil
+}
+
+// ListOrderStatusEvents lists the order status events of the current store
+// after the given ID, oldest first
+func (r *FulfillmentRepository) ListOrderStatusEvents(ctx context.Context, afterID int64, limit int) ([]models.OrderStatusEvent, error) {
+	query := `
+		SELECT id, order_reference, status, provider, carrier, tracking_number, occurred_at, created_at
+		FROM order_status_events
+		WHERE tenant_id = $1 AND id > $2
+		ORDER BY id
+		LIMIT $3
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), afterID, limit)
+	if err != nil {
+		r.logger.Error("Failed to list order status events", zap.Error(err))
+		return nil, fmt.Errorf("failed to list order status events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.OrderStatusEvent
+	for rows.Next() {
+		var event models.OrderStatusEvent
+		if err := rows.Scan(&event.ID, &event.OrderReference, &event.Status, &event.Provider,
+			&event.Carrier, &event.TrackingNumber, &event.OccurredAt, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan order status event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order status events: %w", err)
+	}
+	return events, nil
+}
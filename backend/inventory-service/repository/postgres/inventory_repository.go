@@ -10,6 +10,7 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
 )
 
@@ -27,6 +28,37 @@ func NewInventoryRepository(db *sql.DB, logger *zap.Logger) *InventoryRepository
 	}
 }
 
+// refreshItemQuantitiesQuery recomputes the quantities and status of the
+// inventory item $1 from its locations at time $2
+const refreshItemQuantitiesQuery = `
+	UPDATE inventory_items
+	SET
+		total_quantity = (
+			SELECT COALESCE(SUM(quantity), 0)
+			FROM inventory_locations
+			WHERE inventory_item_id = $1
+		),
+		available_quantity = (
+			SELECT COALESCE(SUM(available_quantity), 0)
+			FROM inventory_locations
+			WHERE inventory_item_id = $1
+		),
+		reserved_quantity = (
+			SELECT COALESCE(SUM(reserved_quantity), 0)
+			FROM inventory_locations
+			WHERE inventory_item_id = $1
+		),
+		status = CASE
+			WHEN (SELECT COALESCE(SUM(available_quantity), 0) FROM inventory_locations WHERE inventory_item_id = $1) <= 0 THEN 'OUT_OF_STOCK'
+			WHEN (SELECT COALESCE(SUM(available_quantity), 0) FROM inventory_locations WHERE inventory_item_id = $1) <= reorder_point THEN 'LOW_STOCK'
+			ELSE 'IN_STOCK'
+		END,
+		last_updated = $2,
+		updated_at = $2,
+		version = version + 1
+	WHERE id = $1
+`
+
 // CreateInventoryItem creates a new inventory item in the database
 func (r *InventoryRepository) CreateInventoryItem(ctx context.Context, item *models.InventoryItem) error {
 	// Start a transaction
@@ -52,9 +84,9 @@ func (r *InventoryRepository) CreateInventoryItem(ctx context.Context, item *mod
 		INSERT INTO inventory_items (
 			id, product_id, variant_id, sku, total_quantity, available_quantity,
 			reserved_quantity, reorder_point, reorder_quantity, status,
-			last_updated, created_at, updated_at
+			last_updated, created_at, updated_at, tenant_id
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14
 		)
 	`
 
@@ -63,6 +95,7 @@ func (r *InventoryRepository) CreateInventoryItem(ctx context.Context, item *mod
 		item.ID, item.ProductID, item.VariantID, item.SKU, item.TotalQuantity,
 		item.AvailableQuantity, item.ReservedQuantity, item.ReorderPoint,
 		item.ReorderQuantity, item.Status, item.LastUpdated, item.CreatedAt, item.UpdatedAt,
+		tenant.FromContext(ctx),
 	)
 
 	if err != nil {
@@ -85,7 +118,7 @@ func (r *InventoryRepository) GetInventoryItemByID(ctx context.Context, id strin
 		SELECT
 			id, product_id, variant_id, sku, total_quantity, available_quantity,
 			reserved_quantity, reorder_point, reorder_quantity, status,
-			last_updated, created_at, updated_at
+			last_updated, created_at, updated_at, version
 		FROM inventory_items
 		WHERE id = $1
 	`
@@ -97,6 +130,7 @@ func (r *InventoryRepository) GetInventoryItemByID(ctx context.Context, id strin
 		&item.ID, &item.ProductID, &variantID, &item.SKU, &item.TotalQuantity,
 		&item.AvailableQuantity, &item.ReservedQuantity, &item.ReorderPoint,
 		&item.ReorderQuantity, &item.Status, &item.LastUpdated, &item.CreatedAt, &item.UpdatedAt,
+		&item.Version,
 	)
 
 	if err != nil {
@@ -131,7 +165,7 @@ func (r *InventoryRepository) GetInventoryItemByProductID(ctx context.Context, p
 		SELECT
 			id, product_id, variant_id, sku, total_quantity, available_quantity,
 			reserved_quantity, reorder_point, reorder_quantity, status,
-			last_updated, created_at, updated_at
+			last_updated, created_at, updated_at, version
 		FROM inventory_items
 		WHERE product_id = $1
 	`
@@ -145,6 +179,7 @@ func (r *InventoryRepository) GetInventoryItemByProductID(ctx context.Context, p
 		&item.ID, &item.ProductID, &variantID, &item.SKU, &item.TotalQuantity,
 		&item.AvailableQuantity, &item.ReservedQuantity, &item.ReorderPoint,
 		&item.ReorderQuantity, &item.Status, &item.LastUpdated, &item.CreatedAt, &item.UpdatedAt,
+		&item.Version,
 	)
 
 	if err != nil {
@@ -187,7 +222,7 @@ func (r *InventoryRepository) GetInventoryItemBySKU(ctx context.Context, sku str
 		SELECT
 			id, product_id, variant_id, sku, total_quantity, available_quantity,
 			reserved_quantity, reorder_point, reorder_quantity, status,
-			last_updated, created_at, updated_at
+			last_updated, created_at, updated_at, version
 		FROM inventory_items
 		WHERE sku = $1
 	`
@@ -199,6 +234,7 @@ func (r *InventoryRepository) GetInventoryItemBySKU(ctx context.Context, sku str
 		&item.ID, &item.ProductID, &variantID, &item.SKU, &item.TotalQuantity,
 		&item.AvailableQuantity, &item.ReservedQuantity, &item.ReorderPoint,
 		&item.ReorderQuantity, &item.Status, &item.LastUpdated, &item.CreatedAt, &item.UpdatedAt,
+		&item.Version,
 	)
 
 	if err != nil {
@@ -225,7 +261,9 @@ func (r *InventoryRepository) GetInventoryItemBySKU(ctx context.Context, sku str
 	return &item, nil
 }
 
-// UpdateInventoryItem updates an existing inventory item
+// UpdateInventoryItem updates an existing inventory item, provided it is
+// still at the version it was read at. It returns ErrConcurrentUpdate when
+// the item changed since.
 func (r *InventoryRepository) UpdateInventoryItem(ctx context.Context, item *models.InventoryItem) error {
 	// Start a transaction
 	tx, err := r.db.BeginTx(ctx, nil)
@@ -250,32 +288,35 @@ func (r *InventoryRepository) UpdateInventoryItem(ctx context.Context, item *mod
 			reorder_quantity = $5,
 			status = $6,
 			last_updated = $7,
-			updated_at = $8
-		WHERE id = $9
+			updated_at = $8,
+			version = version + 1
+		WHERE id = $9 AND version = $10
+		RETURNING version
 	`
 
-	result, err := tx.ExecContext(
+	err = tx.QueryRowContext(
 		ctx, query,
 		item.TotalQuantity, item.AvailableQuantity, item.ReservedQuantity,
 		item.ReorderPoint, item.ReorderQuantity, item.Status,
-		item.LastUpdated, item.UpdatedAt, item.ID,
-	)
-
+		item.LastUpdated, item.UpdatedAt, item.ID, item.Version,
+	).Scan(&item.Version)
+
+	if err == sql.ErrNoRows {
+		// Either the item is gone or it changed since it was read
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM inventory_items WHERE id = $1)`, item.ID).Scan(&exists); err != nil {
+			return fmt.Errorf("failed to check inventory item: %w", err)
+		}
+		if !exists {
+			return models.ErrNotFound
+		}
+		return models.ErrConcurrentUpdate
+	}
 	if err != nil {
 		r.logger.Error("Failed to update inventory item", zap.Error(err), zap.String("id", item.ID))
 		return fmt.Errorf("failed to update inventory item: %w", err)
 	}
 
-	rowsAffected, err := result.RowsAffected()
-	if err != nil {
-		r.logger.Error("Failed to get rows affected", zap.Error(err))
-		return fmt.Errorf("failed to get rows affected: %w", err)
-	}
-
-	if rowsAffected == 0 {
-		return models.ErrNotFound
-	}
-
 	// Commit the transaction
 	if err := tx.Commit(); err != nil {
 		r.logger.Error("Failed to commit transaction", zap.Error(err))
@@ -290,7 +331,8 @@ func (r *InventoryRepository) GetInventoryLocations(ctx context.Context, invento
 	query := `
 		SELECT
 			id, inventory_item_id, warehouse_id, quantity, available_quantity,
-			reserved_quantity, created_at, updated_at
+			reserved_quantity, safety_stock, max_stock, created_at, updated_at,
+			version
 		FROM inventory_locations
 		WHERE inventory_item_id = $1
 	`
@@ -308,7 +350,8 @@ func (r *InventoryRepository) GetInventoryLocations(ctx context.Context, invento
 		if err := rows.Scan(
 			&location.ID, &location.InventoryItemID, &location.WarehouseID,
 			&location.Quantity, &location.AvailableQuantity, &location.ReservedQuantity,
-			&location.CreatedAt, &location.UpdatedAt,
+			&location.SafetyStock, &location.MaxStock, &location.CreatedAt, &location.UpdatedAt,
+			&location.Version,
 		); err != nil {
 			r.logger.Error("Failed to scan inventory location", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan inventory location: %w", err)
@@ -324,7 +367,10 @@ func (r *InventoryRepository) GetInventoryLocations(ctx context.Context, invento
 	return locations, nil
 }
 
-// UpsertInventoryLocation creates or updates an inventory location
+// UpsertInventoryLocation creates an inventory location, when its version
+// is zero, or updates it provided it is still at the version it was read at.
+// It returns ErrConcurrentUpdate when the location was created or changed
+// since.
 func (r *InventoryRepository) UpsertInventoryLocation(ctx context.Context, location *models.InventoryLocation) error {
 	// Start a transaction
 	tx, err := r.db.BeginTx(ctx, nil)
@@ -334,6 +380,22 @@ func (r *InventoryRepository) UpsertInventoryLocation(ctx context.Context, locat
 	}
 	defer tx.Rollback()
 
+	if err := r.upsertInventoryLocation(ctx, tx, location); err != nil {
+		return err
+	}
+
+	// Commit the transaction
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("Failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// upsertInventoryLocation writes a location within tx as described by
+// UpsertInventoryLocation, and the totals of its item
+func (r *InventoryRepository) upsertInventoryLocation(ctx context.Context, tx *sql.Tx, location *models.InventoryLocation) error {
 	now := time.Now().UTC()
 	location.UpdatedAt = now
 
@@ -343,76 +405,54 @@ func (r *InventoryRepository) UpsertInventoryLocation(ctx context.Context, locat
 		location.CreatedAt = now
 	}
 
-	query := `
-		INSERT INTO inventory_locations (
-			id, inventory_item_id, warehouse_id, quantity, available_quantity,
-			reserved_quantity, created_at, updated_at
-		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8
-		)
-		ON CONFLICT (inventory_item_id, warehouse_id)
-		DO UPDATE SET
-			quantity = $4,
-			available_quantity = $5,
-			reserved_quantity = $6,
-			updated_at = $8
-		RETURNING id
-	`
-
-	var id string
-	err = tx.QueryRowContext(
-		ctx, query,
-		location.ID, location.InventoryItemID, location.WarehouseID,
-		location.Quantity, location.AvailableQuantity, location.ReservedQuantity,
-		location.CreatedAt, location.UpdatedAt,
-	).Scan(&id)
+	var err error
+	if location.Version == 0 {
+		// A location another request created in the meantime is a conflict
+		// rather than a row to overwrite
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO inventory_locations (
+				id, inventory_item_id, warehouse_id, quantity, available_quantity,
+				reserved_quantity, created_at, updated_at
+			) VALUES (
+				$1, $2, $3, $4, $5, $6, $7, $8
+			)
+			ON CONFLICT (inventory_item_id, warehouse_id) DO NOTHING
+			RETURNING version`,
+			location.ID, location.InventoryItemID, location.WarehouseID,
+			location.Quantity, location.AvailableQuantity, location.ReservedQuantity,
+			location.CreatedAt, location.UpdatedAt,
+		).Scan(&location.Version)
+	} else {
+		err = tx.QueryRowContext(ctx, `
+			UPDATE inventory_locations
+			SET
+				quantity = $2,
+				available_quantity = $3,
+				reserved_quantity = $4,
+				updated_at = $5,
+				version = version + 1
+			WHERE id = $1 AND version = $6
+			RETURNING version`,
+			location.ID, location.Quantity, location.AvailableQuantity, location.ReservedQuantity,
+			location.UpdatedAt, location.Version,
+		).Scan(&location.Version)
+	}
 
+	if err == sql.ErrNoRows {
+		return models.ErrConcurrentUpdate
+	}
 	if err != nil {
 		r.logger.Error("Failed to upsert inventory location", zap.Error(err))
 		return fmt.Errorf("failed to upsert inventory location: %w", err)
 	}
 
 	// Update the inventory item's total quantities
-	updateItemQuery := `
-		UPDATE inventory_items
-		SET
-			total_quantity = (
-				SELECT COALESCE(SUM(quantity), 0)
-				FROM inventory_locations
-				WHERE inventory_item_id = $1
-			),
-			available_quantity = (
-				SELECT COALESCE(SUM(available_quantity), 0)
-				FROM inventory_locations
-				WHERE inventory_item_id = $1
-			),
-			reserved_quantity = (
-				SELECT COALESCE(SUM(reserved_quantity), 0)
-				FROM inventory_locations
-				WHERE inventory_item_id = $1
-			),
-			status = CASE
-				WHEN (SELECT COALESCE(SUM(available_quantity), 0) FROM inventory_locations WHERE inventory_item_id = $1) <= 0 THEN 'OUT_OF_STOCK'
-				WHEN (SELECT COALESCE(SUM(available_quantity), 0) FROM inventory_locations WHERE inventory_item_id = $1) <= reorder_point THEN 'LOW_STOCK'
-				ELSE 'IN_STOCK'
-			END,
-			last_updated = $2,
-			updated_at = $2
-		WHERE id = $1
-	`
-
-	_, err = tx.ExecContext(ctx, updateItemQuery, location.InventoryItemID, now)
+	_, err = tx.ExecContext(ctx, refreshItemQuantitiesQuery, location.InventoryItemID, now)
 	if err != nil {
 		r.logger.Error("Failed to update inventory item quantities", zap.Error(err))
 		return fmt.Errorf("failed to update inventory item quantities: %w", err)
 	}
 
-	// Commit the transaction
-	if err := tx.Commit(); err != nil {
-		r.logger.Error("Failed to commit transaction", zap.Error(err))
-		return fmt.Errorf("failed to commit transaction: %w", err)
-	}
-
 	return nil
 }
 
@@ -436,7 +476,7 @@ func (r *InventoryRepository) GetInventoryByWarehouse(ctx context.Context, wareh
 	query := `
 		SELECT
 			l.id, l.inventory_item_id, l.warehouse_id, l.quantity, l.available_quantity,
-			l.reserved_quantity, l.created_at, l.updated_at
+			l.reserved_quantity, l.safety_stock, l.max_stock, l.created_at, l.updated_at
 		FROM inventory_locations l
 		WHERE l.warehouse_id = $1
 		ORDER BY l.updated_at DESC
@@ -456,7 +496,7 @@ func (r *InventoryRepository) GetInventoryByWarehouse(ctx context.Context, wareh
 		if err := rows.Scan(
 			&location.ID, &location.InventoryItemID, &location.WarehouseID,
 			&location.Quantity, &location.AvailableQuantity, &location.ReservedQuantity,
-			&location.CreatedAt, &location.UpdatedAt,
+			&location.SafetyStock, &location.MaxStock, &location.CreatedAt, &location.UpdatedAt,
 		); err != nil {
 			r.logger.Error("Failed to scan inventory location", zap.Error(err))
 			return nil, 0, fmt.Errorf("failed to scan inventory location: %w", err)
@@ -474,10 +514,10 @@ func (r *InventoryRepository) GetInventoryByWarehouse(ctx context.Context, wareh
 
 // ListInventoryItems retrieves a paginated list of inventory items with optional filters
 func (r *InventoryRepository) ListInventoryItems(ctx context.Context, offset, limit int, filters map[string]interface{}) ([]*models.InventoryItem, int, error) {
-	// Build the WHERE clause based on filters
-	whereClause := ""
-	args := []interface{}{}
-	argIndex := 1
+	// Build the WHERE clause based on filters, always scoped to the tenant
+	whereClause := "WHERE tenant_id = $1"
+	args := []interface{}{tenant.FromContext(ctx)}
+	argIndex := 2
 
 	if filters != nil {
 		conditions := []string{}
@@ -499,7 +539,7 @@ func (r *InventoryRepository) ListInventoryItems(ctx context.Context, offset, li
 		}
 
 		if len(conditions) > 0 {
-			whereClause = "WHERE " + strings.Join(conditions, " AND ")
+			whereClause += " AND " + strings.Join(conditions, " AND ")
 		}
 	}
 
@@ -569,6 +609,21 @@ func (r *InventoryRepository) ListInventoryItems(ctx context.Context, offset, li
 
 // CreateInventoryTransaction creates a new inventory transaction record
 func (r *InventoryRepository) CreateInventoryTransaction(ctx context.Context, transaction *models.InventoryTransaction) error {
+	if err := insertInventoryTransaction(ctx, r.db, transaction); err != nil {
+		r.logger.Error("Failed to create inventory transaction", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// insertInventoryTransaction records a movement with db, the database or a
+// transaction
+func insertInventoryTransaction(ctx context.Context, db execer, transaction *models.InventoryTransaction) error {
 	// Generate ID if not provided
 	if transaction.ID == "" {
 		transaction.ID = uuid.New().String()
@@ -582,22 +637,22 @@ func (r *InventoryRepository) CreateInventoryTransaction(ctx context.Context, tr
 	query := `
 		INSERT INTO inventory_transactions (
 			id, inventory_item_id, warehouse_id, transaction_type, quantity,
-			reference_id, reference_type, notes, created_by, created_at
+			reference_id, reference_type, notes, created_by, created_at,
+			unit_code, unit_quantity, idempotency_key
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 		)
 	`
 
-	_, err := r.db.ExecContext(
+	_, err := db.ExecContext(
 		ctx, query,
 		transaction.ID, transaction.InventoryItemID, transaction.WarehouseID,
 		transaction.TransactionType, transaction.Quantity, transaction.ReferenceID,
 		transaction.ReferenceType, transaction.Notes, transaction.CreatedBy,
-		transaction.CreatedAt,
+		transaction.CreatedAt, transaction.UnitCode, transaction.UnitQuantity,
+		transaction.IdempotencyKey,
 	)
-
 	if err != nil {
-		r.logger.Error("Failed to create inventory transaction", zap.Error(err))
 		return fmt.Errorf("failed to create inventory transaction: %w", err)
 	}
 
@@ -609,7 +664,8 @@ func (r *InventoryRepository) GetInventoryTransactions(ctx context.Context, inve
 	query := `
 		SELECT
 			id, inventory_item_id, warehouse_id, transaction_type, quantity,
-			reference_id, reference_type, notes, created_by, created_at
+			reference_id, reference_type, notes, created_by, created_at,
+			unit_code, unit_quantity
 		FROM inventory_transactions
 		WHERE inventory_item_id = $1
 		ORDER BY created_at DESC
@@ -626,12 +682,14 @@ func (r *InventoryRepository) GetInventoryTransactions(ctx context.Context, inve
 	var transactions []models.InventoryTransaction
 	for rows.Next() {
 		var transaction models.InventoryTransaction
-		var warehouseID, referenceID, referenceType, notes, createdBy sql.NullString
+		var warehouseID, referenceID, referenceType, notes, createdBy, unitCode sql.NullString
+		var unitQuantity sql.NullInt64
 
 		if err := rows.Scan(
 			&transaction.ID, &transaction.InventoryItemID, &warehouseID,
 			&transaction.TransactionType, &transaction.Quantity, &referenceID,
 			&referenceType, &notes, &createdBy, &transaction.CreatedAt,
+			&unitCode, &unitQuantity,
 		); err != nil {
 			r.logger.Error("Failed to scan inventory transaction", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan inventory transaction: %w", err)
@@ -652,6 +710,7 @@ func (r *InventoryRepository) GetInventoryTransactions(ctx context.Context, inve
 		if createdBy.Valid {
 			transaction.CreatedBy = &createdBy.String
 		}
+		setTransactionUnit(&transaction, unitCode, unitQuantity)
 
 		transactions = append(transactions, transaction)
 	}
@@ -664,6 +723,88 @@ func (r *InventoryRepository) GetInventoryTransactions(ctx context.Context, inve
 	return transactions, nil
 }
 
+// ListInventoryActivity retrieves up to limit transactions of the current
+// tenant older than the transaction at beforeTime with beforeID, newest
+// first; the latest ones when beforeTime is zero
+func (r *InventoryRepository) ListInventoryActivity(ctx context.Context, beforeTime time.Time, beforeID string, limit int) ([]models.InventoryActivity, error) {
+	query := `
+		SELECT
+			t.id, t.inventory_item_id, t.warehouse_id, t.transaction_type, t.quantity,
+			t.reference_id, t.reference_type, t.notes, t.created_by, t.created_at,
+			t.unit_code, t.unit_quantity, i.product_id, i.sku
+		FROM inventory_transactions t
+		JOIN inventory_items i ON i.id = t.inventory_item_id
+		WHERE i.tenant_id = $1
+			AND ($2::timestamptz IS NULL OR (t.created_at, t.id::text) < ($2, $3))
+		ORDER BY t.created_at DESC, t.id::text DESC
+		LIMIT $4
+	`
+
+	var before *time.Time
+	if !beforeTime.IsZero() {
+		before = &beforeTime
+	}
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), before, beforeID, limit)
+	if err != nil {
+		r.logger.Error("Failed to list inventory activity", zap.Error(err))
+		return nil, fmt.Errorf("failed to list inventory activity: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []models.InventoryActivity
+	for rows.Next() {
+		var entry models.InventoryActivity
+		var warehouseID, referenceID, referenceType, notes, createdBy, unitCode sql.NullString
+		var unitQuantity sql.NullInt64
+
+		if err := rows.Scan(
+			&entry.ID, &entry.InventoryItemID, &warehouseID,
+			&entry.TransactionType, &entry.Quantity, &referenceID,
+			&referenceType, &notes, &createdBy, &entry.CreatedAt,
+			&unitCode, &unitQuantity, &entry.ProductID, &entry.SKU,
+		); err != nil {
+			r.logger.Error("Failed to scan inventory activity", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan inventory activity: %w", err)
+		}
+
+		if warehouseID.Valid {
+			entry.WarehouseID = &warehouseID.String
+		}
+		if referenceID.Valid {
+			entry.ReferenceID = &referenceID.String
+		}
+		if referenceType.Valid {
+			entry.ReferenceType = &referenceType.String
+		}
+		if notes.Valid {
+			entry.Notes = &notes.String
+		}
+		if createdBy.Valid {
+			entry.CreatedBy = &createdBy.String
+		}
+		setTransactionUnit(&entry.InventoryTransaction, unitCode, unitQuantity)
+
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("Error iterating inventory activity", zap.Error(err))
+		return nil, fmt.Errorf("error iterating inventory activity: %w", err)
+	}
+
+	return entries, nil
+}
+
+// setTransactionUnit sets the unit of measure a transaction was entered in,
+// when it was recorded
+func setTransactionUnit(transaction *models.InventoryTransaction, unitCode sql.NullString, unitQuantity sql.NullInt64) {
+	if unitCode.Valid && unitQuantity.Valid {
+		quantity := int(unitQuantity.Int64)
+		transaction.UnitCode = &unitCode.String
+		transaction.UnitQuantity = &quantity
+	}
+}
+
 // CreateReservation creates a new inventory reservation
 func (r *InventoryRepository) CreateReservation(ctx context.Context, reservation *models.InventoryReservation) error {
 	// Start a transaction
@@ -692,20 +833,29 @@ func (r *InventoryRepository) CreateReservation(ctx context.Context, reservation
 	var availableQty int
 	var checkQuery string
 
+	// Safety stock is held back from sale, so it cannot be reserved. The row is
+	// locked until the reservation is stored, so concurrent reservations wait
+	// for each other instead of both passing the check and overselling.
 	if reservation.WarehouseID != nil {
 		// Check specific warehouse
 		checkQuery = `
-			SELECT available_quantity
+			SELECT available_quantity - safety_stock
 			FROM inventory_locations
 			WHERE inventory_item_id = $1 AND warehouse_id = $2
+			FOR UPDATE
 		`
 		err = tx.QueryRowContext(ctx, checkQuery, reservation.InventoryItemID, *reservation.WarehouseID).Scan(&availableQty)
 	} else {
 		// Check total available quantity
 		checkQuery = `
-			SELECT available_quantity
-			FROM inventory_items
-			WHERE id = $1
+			SELECT i.available_quantity - COALESCE((
+				SELECT SUM(safety_stock)
+				FROM inventory_locations
+				WHERE inventory_item_id = i.id
+			), 0)
+			FROM inventory_items i
+			WHERE i.id = $1
+			FOR UPDATE
 		`
 		err = tx.QueryRowContext(ctx, checkQuery, reservation.InventoryItemID).Scan(&availableQty)
 	}
@@ -718,6 +868,14 @@ func (r *InventoryRepository) CreateReservation(ctx context.Context, reservation
 		return fmt.Errorf("failed to check available quantity: %w", err)
 	}
 
+	// Expired lots are available until written off but cannot be sold
+	expiredUnits, err := expiredLotUnits(ctx, tx, reservation.InventoryItemID, reservation.WarehouseID, now)
+	if err != nil {
+		r.logger.Error("Failed to check expired lots", zap.Error(err))
+		return err
+	}
+	availableQty -= expiredUnits
+
 	if availableQty < reservation.Quantity {
 		return models.ErrInsufficientInventory
 	}
@@ -754,6 +912,7 @@ func (r *InventoryRepository) CreateReservation(ctx context.Context, reservation
 			SET
 				available_quantity = available_quantity - $1,
 				reserved_quantity = reserved_quantity + $1,
+				version = version + 1,
 				updated_at = $2
 			WHERE inventory_item_id = $3 AND warehouse_id = $4
 		`
@@ -765,6 +924,7 @@ func (r *InventoryRepository) CreateReservation(ctx context.Context, reservation
 			SET
 				available_quantity = available_quantity - $1,
 				reserved_quantity = reserved_quantity + $1,
+				version = version + 1,
 				updated_at = $2,
 				last_updated = $2
 			WHERE id = $3
@@ -777,6 +937,14 @@ func (r *InventoryRepository) CreateReservation(ctx context.Context, reservation
 		return fmt.Errorf("failed to update available quantity: %w", err)
 	}
 
+	// Allocate the units of perishable items from the lots expiring first
+	allocations, err := allocateLots(ctx, tx, reservation, now)
+	if err != nil {
+		r.logger.Error("Failed to allocate lots", zap.Error(err))
+		return err
+	}
+	reservation.Lots = allocations
+
 	// Create a transaction record
 	transactionType := models.TransactionReservation
 	// Convert ReferenceType from string to *string
@@ -957,6 +1125,7 @@ func (r *InventoryRepository) UpdateReservation(ctx context.Context, reservation
 					SET
 						available_quantity = available_quantity + $1,
 						reserved_quantity = reserved_quantity - $1,
+						version = version + 1,
 						updated_at = $2
 					WHERE inventory_item_id = $3 AND warehouse_id = $4
 				`
@@ -971,6 +1140,7 @@ func (r *InventoryRepository) UpdateReservation(ctx context.Context, reservation
 					SET
 						available_quantity = available_quantity + $1,
 						reserved_quantity = reserved_quantity - $1,
+						version = version + 1,
 						updated_at = $2,
 						last_updated = $2
 					WHERE id = $3
@@ -985,6 +1155,10 @@ func (r *InventoryRepository) UpdateReservation(ctx context.Context, reservation
 				r.logger.Error("Failed to release reserved inventory", zap.Error(err))
 				return fmt.Errorf("failed to release reserved inventory: %w", err)
 			}
+			if err := releaseLots(ctx, tx, reservation.ID, now); err != nil {
+				r.logger.Error("Failed to release reserved lots", zap.Error(err))
+				return err
+			}
 
 		case models.ReservationConfirmed, models.ReservationFulfilled:
 			// For confirmed/fulfilled reservations, we keep the inventory reserved
@@ -1165,6 +1339,7 @@ func (r *InventoryRepository) CleanExpiredReservations(ctx context.Context) (int
 		SET
 			available_quantity = available_quantity + $1,
 			reserved_quantity = reserved_quantity - $1,
+			version = version + 1,
 			updated_at = $2
 		WHERE inventory_item_id = $3 AND warehouse_id = $4
 	`
@@ -1174,6 +1349,7 @@ func (r *InventoryRepository) CleanExpiredReservations(ctx context.Context) (int
 		SET
 			available_quantity = available_quantity + $1,
 			reserved_quantity = reserved_quantity - $1,
+			version = version + 1,
 			updated_at = $2,
 			last_updated = $2
 		WHERE id = $3
@@ -1208,6 +1384,10 @@ func (r *InventoryRepository) CleanExpiredReservations(ctx context.Context) (int
 			r.logger.Error("Failed to release inventory", zap.Error(err), zap.String("id", res.ID))
 			continue
 		}
+		if err := releaseLots(ctx, tx, res.ID, now); err != nil {
+			r.logger.Error("Failed to release reserved lots", zap.Error(err), zap.String("id", res.ID))
+			continue
+		}
 
 		// Create transaction record
 		transactionID := uuid.New().String()
@@ -0,0 +1,480 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// LotRepository implements the repository.LotRepository interface
+type LotRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewLotRepository creates a new PostgreSQL lot repository
+func NewLotRepository(db *sql.DB, logger *zap.Logger) *LotRepository {
+	return &LotRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const lotColumns = `l.id, l.inventory_item_id, l.warehouse_id, l.lot_number, l.expires_at, l.quantity,
+	l.reserved_quantity, l.received_at, l.written_off_at, l.updated_at, l.tenant_id`
+
+func scanLot(row interface{ Scan(...any) error }, extra ...any) (*models.InventoryLot, error) {
+	var lot models.InventoryLot
+	var expiresAt, writtenOffAt sql.NullTime
+	dest := append([]any{
+		&lot.ID, &lot.InventoryItemID, &lot.WarehouseID, &lot.LotNumber, &expiresAt, &lot.Quantity,
+		&lot.ReservedQuantity, &lot.ReceivedAt, &writtenOffAt, &lot.UpdatedAt, &lot.TenantID,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if expiresAt.Valid {
+		lot.ExpiresAt = &expiresAt.Time
+	}
+	if writtenOffAt.Valid {
+		lot.WrittenOffAt = &writtenOffAt.Time
+	}
+	return &lot, nil
+}
+
+// fefoOrder sorts lots first expired first out; lots without an expiry date
+// go last
+const fefoOrder = `l.expires_at ASC NULLS LAST, l.received_at, l.id`
+
+// ReceiveLot adds received units to a lot of an item of the current store at
+// a warehouse, creating the lot on its first receipt, and adds them to the
+// stock of the location with a stock addition referencing the lot
+func (r *LotRepository) ReceiveLot(ctx context.Context, lot *models.InventoryLot, notes string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	var exists bool
+	err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM inventory_items WHERE id = $1 AND tenant_id = $2)`,
+		lot.InventoryItemID, tenantID).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check inventory item: %w", err)
+	}
+	if !exists {
+		return models.ErrNotFound
+	}
+
+	now := time.Now().UTC()
+	received := *lot
+	row := tx.QueryRowContext(ctx, `
+		INSERT INTO inventory_lots AS l (
+			tenant_id, inventory_item_id, warehouse_id, lot_number, expires_at,
+			quantity, received_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		ON CONFLICT (inventory_item_id, warehouse_id, lot_number)
+		DO UPDATE SET
+			quantity = l.quantity + EXCLUDED.quantity,
+			written_off_at = NULL,
+			updated_at = EXCLUDED.updated_at
+		RETURNING `+lotColumns,
+		tenantID, lot.InventoryItemID, lot.WarehouseID, lot.LotNumber, lot.ExpiresAt, lot.Quantity, now)
+	saved, err := scanLot(row)
+	if err != nil {
+		r.logger.Error("Failed to upsert lot", zap.Error(err))
+		return fmt.Errorf("failed to upsert lot: %w", err)
+	}
+	if !sameExpiry(saved.ExpiresAt, received.ExpiresAt) {
+		return models.ErrLotExpiryMismatch
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO inventory_locations AS il (
+			id, inventory_item_id, warehouse_id, quantity, available_quantity,
+			reserved_quantity, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $4, 0, $5, $5)
+		ON CONFLICT (inventory_item_id, warehouse_id)
+		DO UPDATE SET
+			quantity = il.quantity + EXCLUDED.quantity,
+			available_quantity = il.available_quantity + EXCLUDED.available_quantity,
+			updated_at = EXCLUDED.updated_at,
+			version = il.version + 1
+	`, uuid.New().String(), lot.InventoryItemID, lot.WarehouseID, received.Quantity, now)
+	if err != nil {
+		r.logger.Error("Failed to add lot to inventory location", zap.Error(err))
+		return fmt.Errorf("failed to add lot to inventory location: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, refreshItemQuantitiesQuery, lot.InventoryItemID, now); err != nil {
+		r.logger.Error("Failed to update inventory item quantities", zap.Error(err))
+		return fmt.Errorf("failed to update inventory item quantities: %w", err)
+	}
+
+	if err := insertLotTransaction(ctx, tx, saved, models.TransactionStockAddition, received.Quantity, notes, now); err != nil {
+		r.logger.Error("Failed to create transaction record", zap.Error(err))
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("Failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	*lot = *saved
+	return nil
+}
+
+// ListLots lists the lots of an item of the current store first expired
+// first out, optionally at one warehouse; emptied lots only when
+// includeEmpty is set
+func (r *LotRepository) ListLots(ctx context.Context, inventoryItemID, warehouseID string, includeEmpty bool) ([]models.InventoryLot, error) {
+	conditions := []string{"i.tenant_id = $1", "l.inventory_item_id = $2"}
+	args := []interface{}{tenant.FromContext(ctx), inventoryItemID}
+	if warehouseID != "" {
+		conditions = append(conditions, "l.warehouse_id = $3")
+		args = append(args, warehouseID)
+	}
+	if !includeEmpty {
+		conditions = append(conditions, "l.quantity > 0")
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+lotColumns+`
+		FROM inventory_lots l
+		JOIN inventory_items i ON i.id = l.inventory_item_id
+		WHERE `+strings.Join(conditions, " AND ")+`
+		ORDER BY `+fefoOrder,
+		args...)
+	if err != nil {
+		r.logger.Error("Failed to list lots", zap.Error(err))
+		return nil, fmt.Errorf("failed to list lots: %w", err)
+	}
+	defer rows.Close()
+
+	var lots []models.InventoryLot
+	for rows.Next() {
+		lot, err := scanLot(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan lot: %w", err)
+		}
+		lots = append(lots, *lot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating lots: %w", err)
+	}
+	return lots, nil
+}
+
+// ListExpiringLots lists the lots of the current store with units left that
+// expire by the given time, soonest first, including the expired lots not
+// written off yet
+func (r *LotRepository) ListExpiringLots(ctx context.Context, before time.Time, warehouseID string, offset, limit int) ([]models.InventoryLot, int, error) {
+	conditions := []string{"l.tenant_id = $1", "l.quantity > 0", "l.expires_at <= $2"}
+	args := []interface{}{tenant.FromContext(ctx), before}
+	argIndex := 3
+	if warehouseID != "" {
+		conditions = append(conditions, fmt.Sprintf("l.warehouse_id = $%d", argIndex))
+		args = append(args, warehouseID)
+		argIndex++
+	}
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM inventory_lots l "+whereClause, args...).Scan(&total); err != nil {
+		r.logger.Error("Failed to count expiring lots", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count expiring lots: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, i.product_id, i.sku
+		FROM inventory_lots l
+		JOIN inventory_items i ON i.id = l.inventory_item_id
+		%s
+		ORDER BY %s
+		LIMIT $%d OFFSET $%d
+	`, lotColumns, whereClause, fefoOrder, argIndex, argIndex+1)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Failed to list expiring lots", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to list expiring lots: %w", err)
+	}
+	defer rows.Close()
+
+	var lots []models.InventoryLot
+	for rows.Next() {
+		var productID, sku string
+		lot, err := scanLot(rows, &productID, &sku)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan lot: %w", err)
+		}
+		lot.ProductID = productID
+		lot.SKU = sku
+		lots = append(lots, *lot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating lots: %w", err)
+	}
+	return lots, total, nil
+}
+
+// WriteOffExpiredLots writes off the unreserved units of up to limit lots of
+// all stores expired at the given time, each in its own transaction. Units
+// allocated to reservations stay with them; they are written off in a later
+// run if the reservation is released.
+func (r *LotRepository) WriteOffExpiredLots(ctx context.Context, now time.Time, limit int) ([]models.LotWriteOff, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id FROM inventory_lots
+		WHERE expires_at <= $1 AND quantity > reserved_quantity
+		ORDER BY expires_at
+		LIMIT $2
+	`, now, limit)
+	if err != nil {
+		r.logger.Error("Failed to find expired lots", zap.Error(err))
+		return nil, fmt.Errorf("failed to find expired lots: %w", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan expired lot: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating expired lots: %w", err)
+	}
+
+	var writeOffs []models.LotWriteOff
+	for _, id := range ids {
+		writeOff, err := r.writeOffLot(ctx, id, now)
+		if err != nil {
+			r.logger.Error("Failed to write off expired lot", zap.Error(err), zap.String("lot_id", id))
+			continue
+		}
+		if writeOff != nil {
+			writeOffs = append(writeOffs, *writeOff)
+		}
+	}
+	return writeOffs, nil
+}
+
+// writeOffLot removes the unreserved units of an expired lot from its lot and
+// location. A location holding fewer available units than the lot, after
+// untracked removals, only loses the units it has.
+func (r *LotRepository) writeOffLot(ctx context.Context, id string, now time.Time) (*models.LotWriteOff, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	lot, err := scanLot(tx.QueryRowContext(ctx, `
+		SELECT `+lotColumns+`
+		FROM inventory_lots l
+		WHERE l.id = $1 AND l.expires_at <= $2 AND l.quantity > l.reserved_quantity
+		FOR UPDATE
+	`, id, now))
+	if err == sql.ErrNoRows {
+		// Written off or reserved concurrently
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock lot: %w", err)
+	}
+
+	var locationAvailable int
+	err = tx.QueryRowContext(ctx, `
+		SELECT available_quantity FROM inventory_locations
+		WHERE inventory_item_id = $1 AND warehouse_id = $2
+		FOR UPDATE
+	`, lot.InventoryItemID, lot.WarehouseID).Scan(&locationAvailable)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, fmt.Errorf("failed to lock inventory location: %w", err)
+	}
+	units := min(lot.AvailableQuantity(), locationAvailable)
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE inventory_lots
+		SET quantity = reserved_quantity, written_off_at = $2, updated_at = $2
+		WHERE id = $1
+	`, lot.ID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write off lot: %w", err)
+	}
+
+	if units > 0 {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE inventory_locations
+			SET
+				quantity = quantity - $1,
+				available_quantity = available_quantity - $1,
+				updated_at = $2,
+				version = version + 1
+			WHERE inventory_item_id = $3 AND warehouse_id = $4
+		`, units, now, lot.InventoryItemID, lot.WarehouseID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove lot from inventory location: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, refreshItemQuantitiesQuery, lot.InventoryItemID, now); err != nil {
+			return nil, fmt.Errorf("failed to update inventory item quantities: %w", err)
+		}
+		notes := fmt.Sprintf("Lot %s expired", lot.LotNumber)
+		if err := insertLotTransaction(ctx, tx, lot, models.TransactionWriteOff, units, notes, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	lot.Quantity = lot.ReservedQuantity
+	lot.WrittenOffAt = &now
+	return &models.LotWriteOff{Lot: *lot, Quantity: units}, nil
+}
+
+func insertLotTransaction(ctx context.Context, tx *sql.Tx, lot *models.InventoryLot, transactionType string, quantity int, notes string, now time.Time) error {
+	var notePtr *string
+	if notes != "" {
+		notePtr = &notes
+	}
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO inventory_transactions (
+			id, inventory_item_id, warehouse_id, transaction_type, quantity,
+			reference_id, reference_type, notes, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)
+	`, uuid.New().String(), lot.InventoryItemID, lot.WarehouseID, transactionType, quantity,
+		lot.ID, models.ReferenceLot, notePtr, now)
+	if err != nil {
+		return fmt.Errorf("failed to create transaction record: %w", err)
+	}
+	return nil
+}
+
+func sameExpiry(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// expiredLotUnits returns the unreserved units of the expired lots of an
+// item, at a warehouse or at all of them, which cannot be reserved even
+// though they are available until written off
+func expiredLotUnits(ctx context.Context, tx *sql.Tx, inventoryItemID string, warehouseID *string, now time.Time) (int, error) {
+	var units int
+	err := tx.QueryRowContext(ctx, `
+		SELECT COALESCE(SUM(quantity - reserved_quantity), 0)
+		FROM inventory_lots
+		WHERE inventory_item_id = $1 AND ($2::uuid IS NULL OR warehouse_id = $2) AND expires_at <= $3
+	`, inventoryItemID, warehouseID, now).Scan(&units)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count expired lot units: %w", err)
+	}
+	return units, nil
+}
+
+// allocateLots allocates the units of a reservation from the unexpired lots
+// of its item first expired first out, and returns the allocations. Units
+// beyond those of the lots come from the untracked stock of the item.
+func allocateLots(ctx context.Context, tx *sql.Tx, reservation *models.InventoryReservation, now time.Time) ([]models.LotAllocation, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT l.id, l.lot_number, l.expires_at, l.quantity - l.reserved_quantity
+		FROM inventory_lots l
+		WHERE l.inventory_item_id = $1 AND ($2::uuid IS NULL OR l.warehouse_id = $2)
+			AND l.quantity > l.reserved_quantity
+			AND (l.expires_at IS NULL OR l.expires_at > $3)
+		ORDER BY `+fefoOrder+`
+		FOR UPDATE
+	`, reservation.InventoryItemID, reservation.WarehouseID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find lots to allocate: %w", err)
+	}
+
+	var allocations []models.LotAllocation
+	remaining := reservation.Quantity
+	for remaining > 0 && rows.Next() {
+		var allocation models.LotAllocation
+		var expiresAt sql.NullTime
+		var available int
+		if err := rows.Scan(&allocation.LotID, &allocation.LotNumber, &expiresAt, &available); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan lot: %w", err)
+		}
+		if expiresAt.Valid {
+			allocation.ExpiresAt = &expiresAt.Time
+		}
+		allocation.Quantity = min(available, remaining)
+		remaining -= allocation.Quantity
+		allocations = append(allocations, allocation)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating lots: %w", err)
+	}
+
+	for _, allocation := range allocations {
+		_, err := tx.ExecContext(ctx, `
+			UPDATE inventory_lots SET reserved_quantity = reserved_quantity + $1, updated_at = $2 WHERE id = $3
+		`, allocation.Quantity, now, allocation.LotID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reserve lot: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO inventory_reservation_lots (reservation_id, lot_id, quantity) VALUES ($1, $2, $3)
+		`, reservation.ID, allocation.LotID, allocation.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record lot allocation: %w", err)
+		}
+	}
+	return allocations, nil
+}
+
+// releaseLots returns the units a reservation was allocated to their lots
+func releaseLots(ctx context.Context, tx *sql.Tx, reservationID string, now time.Time) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE inventory_lots l
+		SET reserved_quantity = l.reserved_quantity - a.quantity, updated_at = $2
+		FROM inventory_reservation_lots a
+		WHERE a.reservation_id = $1 AND l.id = a.lot_id
+	`, reservationID, now)
+	if err != nil {
+		return fmt.Errorf("failed to release lots: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM inventory_reservation_lots WHERE reservation_id = $1`, reservationID); err != nil {
+		return fmt.Errorf("failed to delete lot allocations: %w", err)
+	}
+	return nil
+}
+
+// consumeLots removes the units a fulfilled reservation holds in its lots
+// from those lots
+func consumeLots(ctx context.Context, tx *sql.Tx, reservationID string, now time.Time) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE inventory_lots l
+		SET quantity = l.quantity - a.quantity, reserved_quantity = l.reserved_quantity - a.quantity, updated_at = $2
+		FROM inventory_reservation_lots a
+		WHERE a.reservation_id = $1 AND l.id = a.lot_id
+	`, reservationID, now)
+	if err != nil {
+		return fmt.Errorf("failed to consume lots: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM inventory_reservation_lots WHERE reservation_id = $1`, reservationID); err != nil {
+		return fmt.Errorf("failed to delete lot allocations: %w", err)
+	}
+	return nil
+}
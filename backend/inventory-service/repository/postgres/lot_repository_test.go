@@ -0,0 +1,98 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+func TestAllocateLotsFirstExpiredFirstOut(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	now := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+	warehouseID := "wh-1"
+	reservation := &models.InventoryReservation{ID: "res-1", InventoryItemID: "item-1", WarehouseID: &warehouseID, Quantity: 5}
+
+	mock.ExpectBegin()
+	// Expired lots are left out and the rest comes expiring first, lots
+	// without an expiry last
+	mock.ExpectQuery(regexp.QuoteMeta(`AND (l.expires_at IS NULL OR l.expires_at > $3)
+		ORDER BY `+fefoOrder)).
+		WithArgs("item-1", &warehouseID, now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "lot_number", "expires_at", "available"}).
+			AddRow("lot-a", "A", now.AddDate(0, 0, 2), 3).
+			AddRow("lot-b", "B", now.AddDate(0, 1, 0), 4).
+			AddRow("lot-c", "C", nil, 10))
+	for _, lot := range []struct {
+		id       string
+		quantity int
+	}{{"lot-a", 3}, {"lot-b", 2}} {
+		mock.ExpectExec(`UPDATE inventory_lots SET reserved_quantity`).
+			WithArgs(lot.quantity, now, lot.id).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO inventory_reservation_lots`).
+			WithArgs("res-1", lot.id, lot.quantity).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatal(err)
+	}
+	allocations, err := allocateLots(context.Background(), tx, reservation, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(allocations) != 2 ||
+		allocations[0].LotID != "lot-a" || allocations[0].Quantity != 3 ||
+		allocations[1].LotID != "lot-b" || allocations[1].Quantity != 2 {
+		t.Errorf("allocations = %+v, want 3 units of lot A, then 2 of lot B", allocations)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCreateReservationExcludesExpiredLots(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	repo := NewInventoryRepository(db, zap.NewNop())
+
+	warehouseID := "wh-1"
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT available_quantity - safety_stock\s+FROM inventory_locations`).
+		WithArgs("item-1", warehouseID).
+		WillReturnRows(sqlmock.NewRows([]string{"available"}).AddRow(8))
+	// 5 of the 8 available units are in lots past their expiry date
+	mock.ExpectQuery(regexp.QuoteMeta(`AND ($2::uuid IS NULL OR warehouse_id = $2) AND expires_at <= $3`)).
+		WithArgs("item-1", &warehouseID, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"units"}).AddRow(5))
+	mock.ExpectRollback()
+
+	err = repo.CreateReservation(context.Background(), &models.InventoryReservation{
+		InventoryItemID: "item-1",
+		WarehouseID:     &warehouseID,
+		Quantity:        4,
+	})
+	if !errors.Is(err, models.ErrInsufficientInventory) {
+		t.Errorf("err = %v, want ErrInsufficientInventory", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,410 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// PickupRepository implements the repository.PickupRepository interface
+type PickupRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewPickupRepository creates a new PostgreSQL pickup order repository
+func NewPickupRepository(db *sql.DB, logger *zap.Logger) *PickupRepository {
+	return &PickupRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const pickupOrderColumns = `id, order_reference, warehouse_id, user_id, email, status, collect_by,
+	ready_at, collected_at, cancelled_at, cancel_reason, created_at, updated_at`
+
+func scanPickupOrder(row interface{ Scan(...any) error }, extra ...any) (*models.PickupOrder, error) {
+	var order models.PickupOrder
+	var collectBy, readyAt, collectedAt, cancelledAt sql.NullTime
+	dest := append([]any{
+		&order.ID, &order.OrderReference, &order.WarehouseID, &order.UserID, &order.Email, &order.Status,
+		&collectBy, &readyAt, &collectedAt, &cancelledAt, &order.CancelReason, &order.CreatedAt, &order.UpdatedAt,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if collectBy.Valid {
+		order.CollectBy = &collectBy.Time
+	}
+	if readyAt.Valid {
+		order.ReadyAt = &readyAt.Time
+	}
+	if collectedAt.Valid {
+		order.CollectedAt = &collectedAt.Time
+	}
+	if cancelledAt.Valid {
+		order.CancelledAt = &cancelledAt.Time
+	}
+	return &order, nil
+}
+
+// CreatePickupOrder records a pickup order of the current store with its
+// lines and confirms their pending reservations, so that they no longer
+// expire. It returns models.ErrAlreadyExists when the order was recorded
+// before, and models.ErrReservationExpired when a reservation expired in the
+// meantime.
+func (r *PickupRepository) CreatePickupOrder(ctx context.Context, order *models.PickupOrder) error {
+	tenantID := tenant.FromContext(ctx)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO pickup_orders (id, tenant_id, order_reference, warehouse_id, user_id, email, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at, updated_at`,
+		order.ID, tenantID, order.OrderReference, order.WarehouseID, order.UserID, order.Email, order.Status,
+	).Scan(&order.CreatedAt, &order.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return models.ErrAlreadyExists
+		}
+		r.logger.Error("Failed to create pickup order", zap.Error(err), zap.String("order_reference", order.OrderReference))
+		return fmt.Errorf("failed to create pickup order: %w", err)
+	}
+
+	reservationIDs := make([]string, len(order.Lines))
+	for i := range order.Lines {
+		line := &order.Lines[i]
+		reservationIDs[i] = line.ReservationID
+		personalization, err := json.Marshal(line.Personalization)
+		if err != nil {
+			return fmt.Errorf("failed to encode pickup order line personalization: %w", err)
+		}
+		if line.Personalization == nil {
+			personalization = []byte(`[]`)
+		}
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO pickup_order_lines (pickup_order_id, inventory_item_id, quantity, reservation_id, personalization)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id`,
+			order.ID, line.InventoryItemID, line.Quantity, line.ReservationID, personalization,
+		).Scan(&line.ID)
+		if err != nil {
+			return fmt.Errorf("failed to create pickup order line: %w", err)
+		}
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE inventory_reservations
+		SET status = $2, updated_at = NOW()
+		WHERE id = ANY($1::uuid[]) AND status = $3`,
+		reservationIDs, models.ReservationConfirmed, models.ReservationPending)
+	if err != nil {
+		return fmt.Errorf("failed to confirm pickup reservations: %w", err)
+	}
+	if confirmed, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	} else if int(confirmed) != len(reservationIDs) {
+		return models.ErrReservationExpired
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetPickupOrder retrieves a pickup order of the current store with its
+// lines
+func (r *PickupRepository) GetPickupOrder(ctx context.Context, id string) (*models.PickupOrder, error) {
+	order, err := scanPickupOrder(r.db.QueryRowContext(ctx, `
+		SELECT `+pickupOrderColumns+`
+		FROM pickup_orders
+		WHERE id = $1 AND tenant_id = $2`, id, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrPickupOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get pickup order: %w", err)
+	}
+
+	if order.Lines, err = r.listPickupLines(ctx, r.db, order.ID); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// ListPickupOrders lists the pickup orders of the current store without
+// their lines, oldest first so that stores work through them in order
+func (r *PickupRepository) ListPickupOrders(ctx context.Context, filter models.PickupOrderFilter, offset, limit int) ([]models.PickupOrder, int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+pickupOrderColumns+`, COUNT(*) OVER()
+		FROM pickup_orders
+		WHERE tenant_id = $1
+			AND ($2 = '' OR warehouse_id::text = $2)
+			AND ($3 = '' OR user_id = $3)
+			AND ($4 = '' OR status = $4)
+			AND ($5 = '' OR order_reference = $5)
+		ORDER BY created_at, id
+		LIMIT $6 OFFSET $7`,
+		tenant.FromContext(ctx), filter.WarehouseID, filter.UserID, filter.Status, filter.OrderReference, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list pickup orders: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []models.PickupOrder
+	total := 0
+	for rows.Next() {
+		order, err := scanPickupOrder(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan pickup order: %w", err)
+		}
+		orders = append(orders, *order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate pickup orders: %w", err)
+	}
+	return orders, total, nil
+}
+
+// UpdatePickupStatus moves a pickup order of the current store from status
+// from to the status of order, with when it is ready and must be collected
+// by. An order no longer in status from is left as it is.
+func (r *PickupRepository) UpdatePickupStatus(ctx context.Context, order *models.PickupOrder, from string) error {
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE pickup_orders
+		SET status = $4, ready_at = $5, collect_by = $6, updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2 AND status = $3
+		RETURNING updated_at`,
+		order.ID, tenant.FromContext(ctx), from, order.Status, order.ReadyAt, order.CollectBy,
+	).Scan(&order.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrPickupInvalidTransition
+		}
+		r.logger.Error("Failed to update pickup order status", zap.Error(err), zap.String("pickup_order_id", order.ID))
+		return fmt.Errorf("failed to update pickup order status: %w", err)
+	}
+	return nil
+}
+
+// CollectPickupOrder records a ready pickup order of the current store as
+// collected: its reservations are fulfilled and their units leave the stock
+// of the store
+func (r *PickupRepository) CollectPickupOrder(ctx context.Context, id string, now time.Time) (*models.PickupOrder, error) {
+	return r.settlePickupOrder(ctx, id, []string{models.PickupReady}, models.PickupCollected, "", now)
+}
+
+// CancelPickupOrder cancels a pickup order of the current store that was not
+// collected: its reservations are released and their units are available at
+// the store again
+func (r *PickupRepository) CancelPickupOrder(ctx context.Context, id, reason string, now time.Time) (*models.PickupOrder, error) {
+	return r.settlePickupOrder(ctx, id, []string{models.PickupPending, models.PickupPicking, models.PickupReady},
+		models.PickupCancelled, reason, now)
+}
+
+// settlePickupOrder moves an order in one of the statuses from to collected
+// or cancelled, consuming or releasing the reservations of its lines
+func (r *PickupRepository) settlePickupOrder(ctx context.Context, id string, from []string, to, reason string, now time.Time) (*models.PickupOrder, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var status, warehouseID string
+	err = tx.QueryRowContext(ctx, `
+		SELECT status, warehouse_id FROM pickup_orders
+		WHERE id = $1 AND tenant_id = $2
+		FOR UPDATE`,
+		id, tenant.FromContext(ctx)).Scan(&status, &warehouseID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrPickupOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to lock pickup order: %w", err)
+	}
+	if !slices.Contains(from, status) {
+		return nil, models.ErrPickupInvalidTransition
+	}
+
+	lines, err := r.listPickupLines(ctx, tx, id)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range lines {
+		if err := settlePickupLine(ctx, tx, id, warehouseID, line, to, now); err != nil {
+			r.logger.Error("Failed to settle pickup order line", zap.Error(err),
+				zap.String("pickup_order_id", id), zap.String("reservation_id", line.ReservationID))
+			return nil, err
+		}
+	}
+
+	var collectedAt, cancelledAt *time.Time
+	if to == models.PickupCollected {
+		collectedAt = &now
+	} else {
+		cancelledAt = &now
+	}
+	order, err := scanPickupOrder(tx.QueryRowContext(ctx, `
+		UPDATE pickup_orders
+		SET status = $2, collected_at = $3, cancelled_at = $4, cancel_reason = $5, updated_at = $6
+		WHERE id = $1
+		RETURNING `+pickupOrderColumns,
+		id, to, collectedAt, cancelledAt, reason, now))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update pickup order status: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	order.Lines = lines
+	return order, nil
+}
+
+// settlePickupLine fulfills the reservation of a line of a collected order,
+// removing its units from the store, or releases it for a cancelled order,
+// making them available again, and records the inventory transaction
+func settlePickupLine(ctx context.Context, tx *sql.Tx, orderID, warehouseID string, line models.PickupLine, to string, now time.Time) error {
+	reservationStatus := models.ReservationCancelled
+	transactionType := models.TransactionReservationRelease
+	locationQuery := `
+		UPDATE inventory_locations
+		SET available_quantity = available_quantity + $1,
+			reserved_quantity = reserved_quantity - $1,
+			version = version + 1,
+			updated_at = $2
+		WHERE inventory_item_id = $3 AND warehouse_id = $4`
+	if to == models.PickupCollected {
+		reservationStatus = models.ReservationFulfilled
+		transactionType = models.TransactionStockRemoval
+		locationQuery = `
+			UPDATE inventory_locations
+			SET quantity = quantity - $1,
+				reserved_quantity = reserved_quantity - $1,
+				version = version + 1,
+				updated_at = $2
+			WHERE inventory_item_id = $3 AND warehouse_id = $4`
+	}
+
+	// Reservations no longer confirmed were settled some other way and are
+	// left alone
+	result, err := tx.ExecContext(ctx, `
+		UPDATE inventory_reservations SET status = $2, updated_at = $3
+		WHERE id = $1 AND status = $4`,
+		line.ReservationID, reservationStatus, now, models.ReservationConfirmed)
+	if err != nil {
+		return fmt.Errorf("failed to update pickup reservation: %w", err)
+	}
+	if updated, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	} else if updated == 0 {
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, locationQuery, line.Quantity, now, line.InventoryItemID, warehouseID); err != nil {
+		return fmt.Errorf("failed to update store stock: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, refreshItemQuantitiesQuery, line.InventoryItemID, now); err != nil {
+		return fmt.Errorf("failed to update inventory item quantities: %w", err)
+	}
+	if to == models.PickupCollected {
+		err = consumeLots(ctx, tx, line.ReservationID, now)
+	} else {
+		err = releaseLots(ctx, tx, line.ReservationID, now)
+	}
+	if err != nil {
+		return err
+	}
+
+	notes := fmt.Sprintf("Pickup order %s", to)
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO inventory_transactions (
+			id, inventory_item_id, warehouse_id, transaction_type, quantity,
+			reference_id, reference_type, notes, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
+		)`,
+		uuid.New().String(), line.InventoryItemID, warehouseID, transactionType, line.Quantity,
+		orderID, models.ReferencePickupOrder, notes, now)
+	if err != nil {
+		return fmt.Errorf("failed to create transaction record: %w", err)
+	}
+	return nil
+}
+
+// ListOverduePickupOrders lists the ready pickup orders of all stores that
+// were to be collected before now, oldest first, with their tenant
+func (r *PickupRepository) ListOverduePickupOrders(ctx context.Context, now time.Time, limit int) ([]models.PickupOrder, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+pickupOrderColumns+`, tenant_id
+		FROM pickup_orders
+		WHERE status = $1 AND collect_by < $2
+		ORDER BY collect_by
+		LIMIT $3`,
+		models.PickupReady, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list overdue pickup orders: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []models.PickupOrder
+	for rows.Next() {
+		var tenantID string
+		order, err := scanPickupOrder(rows, &tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan pickup order: %w", err)
+		}
+		order.TenantID = tenantID
+		orders = append(orders, *order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate pickup orders: %w", err)
+	}
+	return orders, nil
+}
+
+func (r *PickupRepository) listPickupLines(ctx context.Context, q queryer, orderID string) ([]models.PickupLine, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT l.id, l.inventory_item_id, i.product_id, i.sku, l.quantity, l.reservation_id, l.personalization
+		FROM pickup_order_lines l
+		JOIN inventory_items i ON i.id = l.inventory_item_id
+		WHERE l.pickup_order_id = $1
+		ORDER BY i.sku`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pickup order lines: %w", err)
+	}
+	defer rows.Close()
+
+	var lines []models.PickupLine
+	for rows.Next() {
+		var line models.PickupLine
+		var personalization []byte
+		if err := rows.Scan(&line.ID, &line.InventoryItemID, &line.ProductID, &line.SKU, &line.Quantity, &line.ReservationID, &personalization); err != nil {
+			return nil, fmt.Errorf("failed to scan pickup order line: %w", err)
+		}
+		if err := json.Unmarshal(personalization, &line.Personalization); err != nil {
+			return nil, fmt.Errorf("failed to decode pickup order line personalization: %w", err)
+		}
+		lines = append(lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate pickup order lines: %w", err)
+	}
+	return lines, nil
+}
@@ -0,0 +1,536 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// PurchasingRepository implements the repository.PurchasingRepository
+// interface
+type PurchasingRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewPurchasingRepository creates a new PostgreSQL supplier and purchase
+// order repository
+func NewPurchasingRepository(db *sql.DB, logger *zap.Logger) *PurchasingRepository {
+	return &PurchasingRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const supplierColumns = `id, name, email, phone, currency, notes, is_active, created_at, updated_at`
+
+func scanSupplier(row interface{ Scan(...any) error }) (*models.Supplier, error) {
+	var supplier models.Supplier
+	err := row.Scan(&supplier.ID, &supplier.Name, &supplier.Email, &supplier.Phone, &supplier.Currency,
+		&supplier.Notes, &supplier.IsActive, &supplier.CreatedAt, &supplier.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &supplier, nil
+}
+
+const purchaseOrderColumns = `id, supplier_id, warehouse_id, status, currency, notes,
+	expected_at, received_at, created_at, updated_at`
+
+func scanPurchaseOrder(row interface{ Scan(...any) error }) (*models.PurchaseOrder, error) {
+	var order models.PurchaseOrder
+	var expectedAt, receivedAt sql.NullTime
+	err := row.Scan(&order.ID, &order.SupplierID, &order.WarehouseID, &order.Status, &order.Currency,
+		&order.Notes, &expectedAt, &receivedAt, &order.CreatedAt, &order.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if expectedAt.Valid {
+		order.ExpectedAt = &expectedAt.Time
+	}
+	if receivedAt.Valid {
+		order.ReceivedAt = &receivedAt.Time
+	}
+	return &order, nil
+}
+
+// isUniqueViolation reports whether err is a unique constraint violation
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation
+}
+
+// CreateSupplier creates a supplier of the current store
+func (r *PurchasingRepository) CreateSupplier(ctx context.Context, supplier *models.Supplier) error {
+	query := `
+		INSERT INTO suppliers (tenant_id, name, email, phone, currency, notes, is_active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + supplierColumns
+
+	saved, err := scanSupplier(r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), supplier.Name,
+		supplier.Email, supplier.Phone, supplier.Currency, supplier.Notes, supplier.IsActive))
+	if err != nil {
+		if isUniqueViolation(err) {
+			return models.ErrSupplierExists
+		}
+		r.logger.Error("Failed to create supplier", zap.Error(err), zap.String("name", supplier.Name))
+		return fmt.Errorf("failed to create supplier: %w", err)
+	}
+	*supplier = *saved
+	return nil
+}
+
+// GetSupplier retrieves a supplier of the current store
+func (r *PurchasingRepository) GetSupplier(ctx context.Context, id string) (*models.Supplier, error) {
+	query := `SELECT ` + supplierColumns + ` FROM suppliers WHERE id = $1 AND tenant_id = $2`
+
+	supplier, err := scanSupplier(r.db.QueryRowContext(ctx, query, id, tenant.FromContext(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrSupplierNotFound
+		}
+		r.logger.Error("Failed to get supplier", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to get supplier: %w", err)
+	}
+	return supplier, nil
+}
+
+// UpdateSupplier updates a supplier of the current store
+func (r *PurchasingRepository) UpdateSupplier(ctx context.Context, supplier *models.Supplier) error {
+	query := `
+		UPDATE suppliers
+		SET name = $3, email = $4, phone = $5, currency = $6, notes = $7, is_active = $8, updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2
+		RETURNING ` + supplierColumns
+
+	saved, err := scanSupplier(r.db.QueryRowContext(ctx, query, supplier.ID, tenant.FromContext(ctx), supplier.Name,
+		supplier.Email, supplier.Phone, supplier.Currency, supplier.Notes, supplier.IsActive))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.ErrSupplierNotFound
+		}
+		if isUniqueViolation(err) {
+			return models.ErrSupplierExists
+		}
+		r.logger.Error("Failed to update supplier", zap.Error(err), zap.String("id", supplier.ID))
+		return fmt.Errorf("failed to update supplier: %w", err)
+	}
+	*supplier = *saved
+	return nil
+}
+
+// ListSuppliers lists the suppliers of the current store by name
+func (r *PurchasingRepository) ListSuppliers(ctx context.Context, offset, limit int, isActive *bool) ([]models.Supplier, int, error) {
+	whereClause := "WHERE tenant_id = $1"
+	args := []interface{}{tenant.FromContext(ctx)}
+	if isActive != nil {
+		whereClause += " AND is_active = $2"
+		args = append(args, *isActive)
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM suppliers "+whereClause, args...).Scan(&total); err != nil {
+		r.logger.Error("Failed to count suppliers", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count suppliers: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM suppliers
+		%s
+		ORDER BY name
+		LIMIT $%d OFFSET $%d
+	`, supplierColumns, whereClause, len(args)+1, len(args)+2)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Failed to list suppliers", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to list suppliers: %w", err)
+	}
+	defer rows.Close()
+
+	var suppliers []models.Supplier
+	for rows.Next() {
+		supplier, err := scanSupplier(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan supplier: %w", err)
+		}
+		suppliers = append(suppliers, *supplier)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating suppliers: %w", err)
+	}
+	return suppliers, total, nil
+}
+
+// UpsertSupplierProduct links an inventory item of the current store to a
+// supplier of the store, or updates the existing link
+func (r *PurchasingRepository) UpsertSupplierProduct(ctx context.Context, product *models.SupplierProduct) error {
+	query := `
+		INSERT INTO supplier_products (supplier_id, inventory_item_id, supplier_sku, cost_price, lead_time_days)
+		SELECT s.id, i.id, $3, $4, $5
+		FROM suppliers s, inventory_items i
+		WHERE s.id = $1 AND s.tenant_id = $6 AND i.id = $2 AND i.tenant_id = $6
+		ON CONFLICT (supplier_id, inventory_item_id) DO UPDATE
+		SET supplier_sku = EXCLUDED.supplier_sku,
+			cost_price = EXCLUDED.cost_price,
+			lead_time_days = EXCLUDED.lead_time_days,
+			updated_at = NOW()
+		RETURNING updated_at
+	`
+
+	err := r.db.QueryRowContext(ctx, query, product.SupplierID, product.InventoryItemID, product.SupplierSKU,
+		product.CostPrice, product.LeadTimeDays, tenant.FromContext(ctx)).Scan(&product.UpdatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return apperrors.New(apperrors.ErrNotFound, "supplier or inventory item not found")
+		}
+		r.logger.Error("Failed to save supplier product", zap.Error(err),
+			zap.String("supplier_id", product.SupplierID),
+			zap.String("inventory_item_id", product.InventoryItemID))
+		return fmt.Errorf("failed to save supplier product: %w", err)
+	}
+	return nil
+}
+
+// DeleteSupplierProduct unlinks an inventory item from a supplier of the
+// current store
+func (r *PurchasingRepository) DeleteSupplierProduct(ctx context.Context, supplierID, inventoryItemID string) error {
+	query := `
+		DELETE FROM supplier_products sp
+		USING suppliers s
+		WHERE sp.supplier_id = s.id AND s.id = $1 AND s.tenant_id = $2 AND sp.inventory_item_id = $3
+	`
+
+	result, err := r.db.ExecContext(ctx, query, supplierID, tenant.FromContext(ctx), inventoryItemID)
+	if err != nil {
+		r.logger.Error("Failed to delete supplier product", zap.Error(err),
+			zap.String("supplier_id", supplierID),
+			zap.String("inventory_item_id", inventoryItemID))
+		return fmt.Errorf("failed to delete supplier product: %w", err)
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return models.ErrSupplierProductNotFound
+	}
+	return nil
+}
+
+// ListSupplierProducts lists the inventory items a supplier of the current
+// store sells, by SKU
+func (r *PurchasingRepository) ListSupplierProducts(ctx context.Context, supplierID string) ([]models.SupplierProduct, error) {
+	query := `
+		SELECT sp.supplier_id, sp.inventory_item_id, i.sku, sp.supplier_sku, sp.cost_price, sp.lead_time_days, sp.updated_at
+		FROM supplier_products sp
+		JOIN suppliers s ON s.id = sp.supplier_id
+		JOIN inventory_items i ON i.id = sp.inventory_item_id
+		WHERE sp.supplier_id = $1 AND s.tenant_id = $2
+		ORDER BY i.sku
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, supplierID, tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("Failed to list supplier products", zap.Error(err), zap.String("supplier_id", supplierID))
+		return nil, fmt.Errorf("failed to list supplier products: %w", err)
+	}
+	defer rows.Close()
+
+	var products []models.SupplierProduct
+	for rows.Next() {
+		var p models.SupplierProduct
+		if err := rows.Scan(&p.SupplierID, &p.InventoryItemID, &p.SKU, &p.SupplierSKU, &p.CostPrice, &p.LeadTimeDays, &p.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan supplier product: %w", err)
+		}
+		products = append(products, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating supplier products: %w", err)
+	}
+	return products, nil
+}
+
+// CreatePurchaseOrder creates a purchase order of the current store with its
+// lines
+func (r *PurchasingRepository) CreatePurchaseOrder(ctx context.Context, order *models.PurchaseOrder) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO purchase_orders (tenant_id, supplier_id, warehouse_id, status, currency, notes, expected_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING ` + purchaseOrderColumns
+
+	saved, err := scanPurchaseOrder(tx.QueryRowContext(ctx, query, tenant.FromContext(ctx), order.SupplierID,
+		order.WarehouseID, models.PurchaseOrderOpen, order.Currency, order.Notes, order.ExpectedAt))
+	if err != nil {
+		r.logger.Error("Failed to create purchase order", zap.Error(err), zap.String("supplier_id", order.SupplierID))
+		return fmt.Errorf("failed to create purchase order: %w", err)
+	}
+
+	insertLine := `
+		INSERT INTO purchase_order_lines (purchase_order_id, inventory_item_id, unit_code, quantity_ordered, unit_cost)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`
+	for i := range order.Lines {
+		line := &order.Lines[i]
+		if err := tx.QueryRowContext(ctx, insertLine, saved.ID, line.InventoryItemID, line.UnitCode, line.QuantityOrdered, line.UnitCost).Scan(&line.ID); err != nil {
+			if isUniqueViolation(err) {
+				return apperrors.Errorf(apperrors.ErrInvalidArgument, "inventory item %s is ordered more than once", line.InventoryItemID)
+			}
+			r.logger.Error("Failed to create purchase order line", zap.Error(err), zap.String("purchase_order_id", saved.ID))
+			return fmt.Errorf("failed to create purchase order line: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	saved.Lines = order.Lines
+	*order = *saved
+	return nil
+}
+
+// GetPurchaseOrder retrieves a purchase order of the current store with its
+// lines
+func (r *PurchasingRepository) GetPurchaseOrder(ctx context.Context, id string) (*models.PurchaseOrder, error) {
+	return r.getPurchaseOrder(ctx, r.db, id, false)
+}
+
+// queryer is implemented by both *sql.DB and *sql.Tx
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+func (r *PurchasingRepository) getPurchaseOrder(ctx context.Context, q queryer, id string, forUpdate bool) (*models.PurchaseOrder, error) {
+	query := `SELECT ` + purchaseOrderColumns + ` FROM purchase_orders WHERE id = $1 AND tenant_id = $2`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+
+	order, err := scanPurchaseOrder(q.QueryRowContext(ctx, query, id, tenant.FromContext(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrPurchaseOrderNotFound
+		}
+		r.logger.Error("Failed to get purchase order", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to get purchase order: %w", err)
+	}
+
+	linesQuery := `
+		SELECT l.id, l.inventory_item_id, i.sku, l.unit_code, l.quantity_ordered, l.quantity_received, l.unit_cost
+		FROM purchase_order_lines l
+		JOIN inventory_items i ON i.id = l.inventory_item_id
+		WHERE l.purchase_order_id = $1
+		ORDER BY i.sku
+	`
+	rows, err := q.QueryContext(ctx, linesQuery, id)
+	if err != nil {
+		r.logger.Error("Failed to list purchase order lines", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to list purchase order lines: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line models.PurchaseOrderLine
+		if err := rows.Scan(&line.ID, &line.InventoryItemID, &line.SKU, &line.UnitCode, &line.QuantityOrdered, &line.QuantityReceived, &line.UnitCost); err != nil {
+			return nil, fmt.Errorf("failed to scan purchase order line: %w", err)
+		}
+		order.Lines = append(order.Lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating purchase order lines: %w", err)
+	}
+	return order, nil
+}
+
+// ListPurchaseOrders lists the purchase orders of the current store matching
+// the filter, newest first
+func (r *PurchasingRepository) ListPurchaseOrders(ctx context.Context, filter models.PurchaseOrderFilter, offset, limit int) ([]models.PurchaseOrder, int, error) {
+	conditions := []string{"tenant_id = $1"}
+	args := []interface{}{tenant.FromContext(ctx)}
+	argIndex := 2
+
+	if filter.SupplierID != "" {
+		conditions = append(conditions, fmt.Sprintf("supplier_id = $%d", argIndex))
+		args = append(args, filter.SupplierID)
+		argIndex++
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argIndex))
+		args = append(args, filter.Status)
+		argIndex++
+	}
+	if filter.ExpectedBefore != nil {
+		conditions = append(conditions, fmt.Sprintf("status IN ($%d, $%d) AND expected_at < $%d", argIndex, argIndex+1, argIndex+2))
+		args = append(args, models.PurchaseOrderOpen, models.PurchaseOrderPartiallyReceived, *filter.ExpectedBefore)
+		argIndex += 3
+	}
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM purchase_orders "+whereClause, args...).Scan(&total); err != nil {
+		r.logger.Error("Failed to count purchase orders", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count purchase orders: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM purchase_orders
+		%s
+		ORDER BY created_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, purchaseOrderColumns, whereClause, argIndex, argIndex+1)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Failed to list purchase orders", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to list purchase orders: %w", err)
+	}
+	defer rows.Close()
+
+	var orders []models.PurchaseOrder
+	for rows.Next() {
+		order, err := scanPurchaseOrder(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan purchase order: %w", err)
+		}
+		orders = append(orders, *order)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating purchase orders: %w", err)
+	}
+	return orders, total, nil
+}
+
+// ReceivePurchaseOrder records received quantities on the lines of an open
+// purchase order of the current store. The order is locked while the
+// quantities are checked, so concurrent receipts cannot exceed the ordered
+// quantities.
+func (r *PurchasingRepository) ReceivePurchaseOrder(ctx context.Context, id string, lines []models.ReceiptLine) (*models.PurchaseOrder, []models.ReceiptLine, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	order, err := r.getPurchaseOrder(ctx, tx, id, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	if order.Status != models.PurchaseOrderOpen && order.Status != models.PurchaseOrderPartiallyReceived {
+		return nil, nil, models.ErrPurchaseOrderClosed
+	}
+
+	outstanding := make(map[string]int, len(order.Lines))
+	unitCodes := make(map[string]string, len(order.Lines))
+	for _, line := range order.Lines {
+		outstanding[line.InventoryItemID] = line.Outstanding()
+		unitCodes[line.InventoryItemID] = line.UnitCode
+	}
+
+	var receipts []models.ReceiptLine
+	if len(lines) == 0 {
+		for _, line := range order.Lines {
+			if line.Outstanding() > 0 {
+				receipts = append(receipts, models.ReceiptLine{InventoryItemID: line.InventoryItemID, Quantity: line.Outstanding(), UnitCode: line.UnitCode})
+			}
+		}
+	} else {
+		for _, line := range lines {
+			remaining, ok := outstanding[line.InventoryItemID]
+			if !ok {
+				return nil, nil, apperrors.Errorf(apperrors.ErrInvalidArgument, "inventory item %s is not on the purchase order", line.InventoryItemID)
+			}
+			if line.Quantity > remaining {
+				return nil, nil, apperrors.Errorf(apperrors.ErrInvalidArgument,
+					"received quantity %d of inventory item %s exceeds the %d outstanding", line.Quantity, line.InventoryItemID, remaining)
+			}
+			outstanding[line.InventoryItemID] -= line.Quantity
+			line.UnitCode = unitCodes[line.InventoryItemID]
+			receipts = append(receipts, line)
+		}
+	}
+
+	update := `
+		UPDATE purchase_order_lines
+		SET quantity_received = quantity_received + $3
+		WHERE purchase_order_id = $1 AND inventory_item_id = $2
+	`
+	for _, receipt := range receipts {
+		if _, err := tx.ExecContext(ctx, update, id, receipt.InventoryItemID, receipt.Quantity); err != nil {
+			r.logger.Error("Failed to receive purchase order line", zap.Error(err), zap.String("id", id))
+			return nil, nil, fmt.Errorf("failed to receive purchase order line: %w", err)
+		}
+	}
+
+	statusUpdate := `
+		UPDATE purchase_orders po
+		SET status = CASE
+				WHEN NOT EXISTS (
+					SELECT 1 FROM purchase_order_lines
+					WHERE purchase_order_id = po.id AND quantity_received < quantity_ordered
+				) THEN $2
+				ELSE $3
+			END,
+			received_at = NOW(),
+			updated_at = NOW()
+		WHERE po.id = $1
+	`
+	if _, err := tx.ExecContext(ctx, statusUpdate, id, models.PurchaseOrderReceived, models.PurchaseOrderPartiallyReceived); err != nil {
+		r.logger.Error("Failed to update purchase order status", zap.Error(err), zap.String("id", id))
+		return nil, nil, fmt.Errorf("failed to update purchase order status: %w", err)
+	}
+
+	order, err = r.getPurchaseOrder(ctx, tx, id, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return order, receipts, nil
+}
+
+// CancelPurchaseOrder cancels an open purchase order of the current store.
+// Orders some of whose stock was received are cancelled too; what was
+// received stays in stock.
+func (r *PurchasingRepository) CancelPurchaseOrder(ctx context.Context, id string) (*models.PurchaseOrder, error) {
+	query := `
+		UPDATE purchase_orders
+		SET status = $3, updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2 AND status IN ($4, $5)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, id, tenant.FromContext(ctx), models.PurchaseOrderCancelled,
+		models.PurchaseOrderOpen, models.PurchaseOrderPartiallyReceived)
+	if err != nil {
+		r.logger.Error("Failed to cancel purchase order", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to cancel purchase order: %w", err)
+	}
+	order, err := r.GetPurchaseOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if n, _ := result.RowsAffected(); n == 0 {
+		return nil, models.ErrPurchaseOrderClosed
+	}
+	return order, nil
+}
@@ -0,0 +1,259 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// RefundRepository implements the repository.RefundRepository interface
+type RefundRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewRefundRepository creates a new PostgreSQL refund repository
+func NewRefundRepository(db *sql.DB, logger *zap.Logger) *RefundRepository {
+	return &RefundRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const refundColumns = `id, order_reference, payment_reference, user_id, refund_type, amount, currency, reason,
+	status, requested_by, reviewed_by, reviewed_at, provider_reference, failure_reason, processed_at,
+	created_at, updated_at`
+
+func scanRefund(row interface{ Scan(...any) error }, extra ...any) (*models.Refund, error) {
+	var refund models.Refund
+	var reviewedAt, processedAt sql.NullTime
+	dest := append([]any{
+		&refund.ID, &refund.OrderReference, &refund.PaymentReference, &refund.UserID, &refund.Type,
+		&refund.Amount, &refund.Currency, &refund.Reason, &refund.Status, &refund.RequestedBy,
+		&refund.ReviewedBy, &reviewedAt, &refund.ProviderReference, &refund.FailureReason, &processedAt,
+		&refund.CreatedAt, &refund.UpdatedAt,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if reviewedAt.Valid {
+		refund.ReviewedAt = &reviewedAt.Time
+	}
+	if processedAt.Valid {
+		refund.ProcessedAt = &processedAt.Time
+	}
+	return &refund, nil
+}
+
+// CreateRefund creates a requested refund of the current store with its
+// lines. Lines must be items of the store.
+func (r *RefundRepository) CreateRefund(ctx context.Context, refund *models.Refund) error {
+	tenantID := tenant.FromContext(ctx)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO refunds (
+			tenant_id, order_reference, payment_reference, user_id, refund_type, amount, currency,
+			reason, status, requested_by
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at, updated_at`,
+		tenantID, refund.OrderReference, refund.PaymentReference, refund.UserID, refund.Type,
+		refund.Amount, refund.Currency, refund.Reason, refund.Status, refund.RequestedBy,
+	).Scan(&refund.ID, &refund.CreatedAt, &refund.UpdatedAt)
+	if err != nil {
+		r.logger.Error("Failed to create refund", zap.Error(err), zap.String("order_reference", refund.OrderReference))
+		return fmt.Errorf("failed to create refund: %w", err)
+	}
+
+	for i := range refund.Lines {
+		line := &refund.Lines[i]
+		err := tx.QueryRowContext(ctx, `
+			WITH item AS (
+				SELECT id, sku FROM inventory_items WHERE id = $2 AND tenant_id = $6
+			), line AS (
+				INSERT INTO refund_lines (refund_id, inventory_item_id, quantity, restock, warehouse_id)
+				SELECT $1, item.id, $3, $4, $5 FROM item
+				RETURNING id
+			)
+			SELECT line.id, item.sku FROM line, item`,
+			refund.ID, line.InventoryItemID, line.Quantity, line.Restock, line.WarehouseID, tenantID,
+		).Scan(&line.ID, &line.SKU)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return models.ErrNotFound
+			}
+			return fmt.Errorf("failed to create refund line: %w", err)
+		}
+	}
+
+	if err := insertRefundEvent(ctx, tx, refund.ID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetRefund retrieves a refund of the current store with its lines
+func (r *RefundRepository) GetRefund(ctx context.Context, id string) (*models.Refund, error) {
+	refund, err := scanRefund(r.db.QueryRowContext(ctx, `
+		SELECT `+refundColumns+`
+		FROM refunds
+		WHERE id = $1 AND tenant_id = $2`, id, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrRefundNotFound
+		}
+		return nil, fmt.Errorf("failed to get refund: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT l.id, l.inventory_item_id, i.sku, l.quantity, l.restock, l.warehouse_id
+		FROM refund_lines l
+		JOIN inventory_items i ON i.id = l.inventory_item_id
+		WHERE l.refund_id = $1
+		ORDER BY i.sku`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get refund lines: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line models.RefundLine
+		var warehouseID sql.NullString
+		if err := rows.Scan(&line.ID, &line.InventoryItemID, &line.SKU, &line.Quantity, &line.Restock, &warehouseID); err != nil {
+			return nil, fmt.Errorf("failed to scan refund line: %w", err)
+		}
+		if warehouseID.Valid {
+			line.WarehouseID = &warehouseID.String
+		}
+		refund.Lines = append(refund.Lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate refund lines: %w", err)
+	}
+	return refund, nil
+}
+
+// ListRefunds lists the refunds of the current store, newest first
+func (r *RefundRepository) ListRefunds(ctx context.Context, filter models.RefundFilter, offset, limit int) ([]models.Refund, int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+refundColumns+`, COUNT(*) OVER()
+		FROM refunds
+		WHERE tenant_id = $1
+			AND ($2 = '' OR status = $2)
+			AND ($3 = '' OR order_reference = $3)
+		ORDER BY created_at DESC
+		LIMIT $4 OFFSET $5`,
+		tenant.FromContext(ctx), filter.Status, filter.OrderReference, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list refunds: %w", err)
+	}
+	defer rows.Close()
+
+	var refunds []models.Refund
+	total := 0
+	for rows.Next() {
+		refund, err := scanRefund(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan refund: %w", err)
+		}
+		refunds = append(refunds, *refund)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate refunds: %w", err)
+	}
+	return refunds, total, nil
+}
+
+// UpdateRefundStatus moves a refund of the current store from status from to
+// the status of refund, recording its review and processing details and a
+// refund event. A refund no longer in status from is left as it is.
+func (r *RefundRepository) UpdateRefundStatus(ctx context.Context, refund *models.Refund, from string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE refunds
+		SET status = $4, reviewed_by = $5, reviewed_at = $6, provider_reference = $7,
+			failure_reason = $8, processed_at = $9, updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2 AND status = $3
+		RETURNING updated_at`,
+		refund.ID, tenant.FromContext(ctx), from, refund.Status, refund.ReviewedBy, refund.ReviewedAt,
+		refund.ProviderReference, refund.FailureReason, refund.ProcessedAt,
+	).Scan(&refund.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrRefundInvalidTransition
+		}
+		r.logger.Error("Failed to update refund status", zap.Error(err), zap.String("refund_id", refund.ID))
+		return fmt.Errorf("failed to update refund status: %w", err)
+	}
+
+	if err := insertRefundEvent(ctx, tx, refund.ID); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// insertRefundEvent records the current status of a refund as an event
+func insertRefundEvent(ctx context.Context, tx *sql.Tx, refundID string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO refund_events (
+			tenant_id, refund_id, status, order_reference, payment_reference, user_id, amount, currency
+		)
+		SELECT tenant_id, id, status, order_reference, payment_reference, user_id, amount, currency
+		FROM refunds
+		WHERE id = $1`, refundID)
+	if err != nil {
+		return fmt.Errorf("failed to record refund event: %w", err)
+	}
+	return nil
+}
+
+// ListRefundEvents lists the refund events of the current store after the
+// given ID, oldest first
+func (r *RefundRepository) ListRefundEvents(ctx context.Context, afterID int64, limit int) ([]models.RefundEvent, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, refund_id, status, order_reference, payment_reference, user_id, amount, currency, created_at
+		FROM refund_events
+		WHERE tenant_id = $1 AND id > $2
+		ORDER BY id
+		LIMIT $3`, tenant.FromContext(ctx), afterID, limit)
+	if err != nil {
+		r.logger.Error("Failed to list refund events", zap.Error(err))
+		return nil, fmt.Errorf("failed to list refund events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.RefundEvent
+	for rows.Next() {
+		var event models.RefundEvent
+		if err := rows.Scan(&event.ID, &event.RefundID, &event.Status, &event.OrderReference, &event.PaymentReference,
+			&event.UserID, &event.Amount, &event.Currency, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan refund event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating refund events: %w", err)
+	}
+	return events, nil
+}
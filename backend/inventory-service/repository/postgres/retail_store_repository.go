@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// RetailStoreRepository implements the repository.RetailStoreRepository interface
+type RetailStoreRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewRetailStoreRepository creates a new PostgreSQL retail store repository
+func NewRetailStoreRepository(db *sql.DB, logger *zap.Logger) *RetailStoreRepository {
+	return &RetailStoreRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const retailStoreColumns = `s.warehouse_id, s.latitude, s.longitude, s.timezone, s.phone, s.opening_hours,
+	s.pickup_enabled, s.hold_hours, s.created_at, s.updated_at,
+	w.id, w.name, w.code, w.address, w.city, w.state, w.country, w.postal_code,
+	w.is_active, w.priority, w.created_at, w.updated_at`
+
+func scanRetailStore(row interface{ Scan(...any) error }) (*models.RetailStore, error) {
+	var store models.RetailStore
+	var warehouse models.Warehouse
+	var hours []byte
+	err := row.Scan(
+		&store.WarehouseID, &store.Latitude, &store.Longitude, &store.Timezone, &store.Phone, &hours,
+		&store.PickupEnabled, &store.HoldHours, &store.CreatedAt, &store.UpdatedAt,
+		&warehouse.ID, &warehouse.Name, &warehouse.Code, &warehouse.Address,
+		&warehouse.City, &warehouse.State, &warehouse.Country, &warehouse.PostalCode,
+		&warehouse.IsActive, &warehouse.Priority, &warehouse.CreatedAt, &warehouse.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(hours, &store.OpeningHours); err != nil {
+		return nil, fmt.Errorf("failed to decode opening hours: %w", err)
+	}
+	store.Warehouse = &warehouse
+	return &store, nil
+}
+
+// SaveStore makes a warehouse of the current store a retail store, or
+// replaces its store details
+func (r *RetailStoreRepository) SaveStore(ctx context.Context, store *models.RetailStore) error {
+	hours, err := json.Marshal(store.OpeningHours)
+	if err != nil {
+		return fmt.Errorf("failed to encode opening hours: %w", err)
+	}
+
+	query := `
+		WITH saved AS (
+			INSERT INTO retail_stores (warehouse_id, tenant_id, latitude, longitude, timezone, phone,
+				opening_hours, pickup_enabled, hold_hours)
+			SELECT w.id, $1, $3, $4, $5, $6, $7, $8, $9
+			FROM warehouses w
+			WHERE w.id = $2 AND w.tenant_id = $1
+			ON CONFLICT (warehouse_id) DO UPDATE SET
+				latitude = EXCLUDED.latitude,
+				longitude = EXCLUDED.longitude,
+				timezone = EXCLUDED.timezone,
+				phone = EXCLUDED.phone,
+				opening_hours = EXCLUDED.opening_hours,
+				pickup_enabled = EXCLUDED.pickup_enabled,
+				hold_hours = EXCLUDED.hold_hours,
+				updated_at = NOW()
+			RETURNING *
+		)
+		SELECT ` + retailStoreColumns + `
+		FROM saved s
+		JOIN warehouses w ON w.id = s.warehouse_id`
+
+	saved, err := scanRetailStore(r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), store.WarehouseID,
+		store.Latitude, store.Longitude, store.Timezone, store.Phone, hours, store.PickupEnabled, store.HoldHours))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrWarehouseNotFound
+		}
+		r.logger.Error("Failed to save retail store", zap.Error(err), zap.String("warehouse_id", store.WarehouseID))
+		return fmt.Errorf("failed to save retail store: %w", err)
+	}
+	*store = *saved
+	return nil
+}
+
+// GetStore retrieves the retail store of a warehouse of the current store,
+// with its warehouse
+func (r *RetailStoreRepository) GetStore(ctx context.Context, warehouseID string) (*models.RetailStore, error) {
+	query := `
+		SELECT ` + retailStoreColumns + `
+		FROM retail_stores s
+		JOIN warehouses w ON w.id = s.warehouse_id
+		WHERE s.warehouse_id = $1 AND s.tenant_id = $2`
+
+	store, err := scanRetailStore(r.db.QueryRowContext(ctx, query, warehouseID, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrRetailStoreNotFound
+		}
+		return nil, fmt.Errorf("failed to get retail store: %w", err)
+	}
+	return store, nil
+}
+
+// ListStores lists the retail stores of the current store with their
+// warehouses, of active warehouses only when asked, by warehouse name
+func (r *RetailStoreRepository) ListStores(ctx context.Context, activeOnly bool) ([]models.RetailStore, error) {
+	query := `
+		SELECT ` + retailStoreColumns + `
+		FROM retail_stores s
+		JOIN warehouses w ON w.id = s.warehouse_id
+		WHERE s.tenant_id = $1 AND (NOT $2 OR w.is_active)
+		ORDER BY w.name`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), activeOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list retail stores: %w", err)
+	}
+	defer rows.Close()
+
+	var stores []models.RetailStore
+	for rows.Next() {
+		store, err := scanRetailStore(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan retail store: %w", err)
+		}
+		stores = append(stores, *store)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate retail stores: %w", err)
+	}
+	return stores, nil
+}
+
+// DeleteStore removes the retail store of a warehouse of the current store;
+// the warehouse itself is kept
+func (r *RetailStoreRepository) DeleteStore(ctx context.Context, warehouseID string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM retail_stores WHERE warehouse_id = $1 AND tenant_id = $2`,
+		warehouseID, tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("Failed to delete retail store", zap.Error(err), zap.String("warehouse_id", warehouseID))
+		return fmt.Errorf("failed to delete retail store: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return models.ErrRetailStoreNotFound
+	}
+	return nil
+}
@@ -0,0 +1,316 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// ShipmentRepository implements the repository.ShipmentRepository interface
+type ShipmentRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewShipmentRepository creates a new PostgreSQL shipment repository
+func NewShipmentRepository(db *sql.DB, logger *zap.Logger) *ShipmentRepository {
+	return &ShipmentRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const shipmentColumns = `id, order_reference, user_id, carrier, tracking_number, status,
+	shipped_at, delivered_at, created_at, updated_at`
+
+func scanShipment(row interface{ Scan(...any) error }, extra ...any) (*models.Shipment, error) {
+	var shipment models.Shipment
+	var userID sql.NullString
+	var deliveredAt sql.NullTime
+	dest := append([]any{
+		&shipment.ID, &shipment.OrderReference, &userID, &shipment.Carrier, &shipment.TrackingNumber,
+		&shipment.Status, &shipment.ShippedAt, &deliveredAt, &shipment.CreatedAt, &shipment.UpdatedAt,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	shipment.UserID = userID.String
+	if deliveredAt.Valid {
+		shipment.DeliveredAt = &deliveredAt.Time
+	}
+	return &shipment, nil
+}
+
+// UpsertShipment creates a shipment of the current store, or attaches the
+// shipment with the same carrier and tracking number to the given order and
+// customer. A shipment keeps its customer when none is given.
+func (r *ShipmentRepository) UpsertShipment(ctx context.Context, shipment *models.Shipment) error {
+	query := `
+		INSERT INTO shipments (tenant_id, order_reference, user_id, carrier, tracking_number, shipped_at)
+		VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6)
+		ON CONFLICT (tenant_id, carrier, tracking_number) DO UPDATE
+		SET order_reference = EXCLUDED.order_reference,
+			user_id = COALESCE(EXCLUDED.user_id, shipments.user_id),
+			updated_at = NOW()
+		RETURNING ` + shipmentColumns
+
+	saved, err := scanShipment(r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), shipment.OrderReference,
+		shipment.UserID, shipment.Carrier, shipment.TrackingNumber, shipment.ShippedAt))
+	if err != nil {
+		r.logger.Error("Failed to save shipment", zap.Error(err),
+			zap.String("carrier", shipment.Carrier),
+			zap.String("tracking_number", shipment.TrackingNumber))
+		return fmt.Errorf("failed to save shipment: %w", err)
+	}
+	*shipment = *saved
+	return nil
+}
+
+// GetShipment retrieves a shipment of the current store
+func (r *ShipmentRepository) GetShipment(ctx context.Context, id string) (*models.Shipment, error) {
+	query := `SELECT ` + shipmentColumns + ` FROM shipments WHERE id = $1 AND tenant_id = $2`
+
+	shipment, err := scanShipment(r.db.QueryRowContext(ctx, query, id, tenant.FromContext(ctx)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrShipmentNotFound
+		}
+		r.logger.Error("Failed to get shipment", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to get shipment: %w", err)
+	}
+	return shipment, nil
+}
+
+// GetShipmentByTrackingNumber retrieves the shipment of the current store
+// with the given carrier and tracking number
+func (r *ShipmentRepository) GetShipmentByTrackingNumber(ctx context.Context, carrier, trackingNumber string) (*models.Shipment, error) {
+	query := `
+		SELECT ` + shipmentColumns + `
+		FROM shipments
+		WHERE tenant_id = $1 AND carrier = $2 AND tracking_number = $3
+	`
+
+	shipment, err := scanShipment(r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), carrier, trackingNumber))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrShipmentNotFound
+		}
+		r.logger.Error("Failed to get shipment by tracking number", zap.Error(err),
+			zap.String("carrier", carrier),
+			zap.String("tracking_number", trackingNumber))
+		return nil, fmt.Errorf("failed to get shipment by tracking number: %w", err)
+	}
+	return shipment, nil
+}
+
+// ListShipments lists the shipments of the current store matching the
+// filter, newest first
+func (r *ShipmentRepository) ListShipments(ctx context.Context, filter models.ShipmentFilter, offset, limit int) ([]models.Shipment, int, error) {
+	conditions := []string{"tenant_id = $1"}
+	args := []interface{}{tenant.FromContext(ctx)}
+	argIndex := 2
+
+	if filter.OrderReference != "" {
+		conditions = append(conditions, fmt.Sprintf("order_reference = $%d", argIndex))
+		args = append(args, filter.OrderReference)
+		argIndex++
+	}
+	if filter.UserID != "" {
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", argIndex))
+		args = append(args, filter.UserID)
+		argIndex++
+	}
+	if filter.Status != "" {
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argIndex))
+		args = append(args, filter.Status)
+		argIndex++
+	}
+	whereClause := "WHERE " + strings.Join(conditions, " AND ")
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM shipments "+whereClause, args...).Scan(&total); err != nil {
+		r.logger.Error("Failed to count shipments", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count shipments: %w", err)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM shipments
+		%s
+		ORDER BY shipped_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, shipmentColumns, whereClause, argIndex, argIndex+1)
+	args = append(args, limit, offset)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Failed to list shipments", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to list shipments: %w", err)
+	}
+	defer rows.Close()
+
+	var shipments []models.Shipment
+	for rows.Next() {
+		shipment, err := scanShipment(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan shipment: %w", err)
+		}
+		shipments = append(shipments, *shipment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating shipments: %w", err)
+	}
+	return shipments, total, nil
+}
+
+// AddShipmentEvents records the events of a shipment of the current store
+// that were not recorded yet, and moves the shipment to the status of its
+// latest event
+func (r *ShipmentRepository) AddShipmentEvents(ctx context.Context, shipmentID string, events []models.ShipmentEvent) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Lock the shipment, which also checks that it belongs to the store
+	var id string
+	err = tx.QueryRowContext(ctx, `SELECT id FROM shipments WHERE id = $1 AND tenant_id = $2 FOR UPDATE`,
+		shipmentID, tenant.FromContext(ctx)).Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, models.ErrShipmentNotFound
+		}
+		return 0, fmt.Errorf("failed to lock shipment: %w", err)
+	}
+
+	insert := `
+		INSERT INTO shipment_events (shipment_id, status, description, location, source, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (shipment_id, status, occurred_at) DO NOTHING
+	`
+	added := 0
+	for _, event := range events {
+		result, err := tx.ExecContext(ctx, insert, shipmentID, event.Status, event.Description, event.Location, event.Source, event.OccurredAt)
+		if err != nil {
+			r.logger.Error("Failed to add shipment event", zap.Error(err), zap.String("shipment_id", shipmentID))
+			return 0, fmt.Errorf("failed to add shipment event: %w", err)
+		}
+		n, _ := result.RowsAffected()
+		added += int(n)
+	}
+	if added == 0 {
+		return 0, nil
+	}
+
+	update := `
+		UPDATE shipments s
+		SET status = latest.status,
+			delivered_at = CASE WHEN latest.status = $2 THEN latest.occurred_at END,
+			updated_at = NOW()
+		FROM (
+			SELECT status, occurred_at
+			FROM shipment_events
+			WHERE shipment_id = $1
+			ORDER BY occurred_at DESC, id DESC
+			LIMIT 1
+		) latest
+		WHERE s.id = $1
+	`
+	if _, err := tx.ExecContext(ctx, update, shipmentID, models.ShipmentDelivered); err != nil {
+		r.logger.Error("Failed to update shipment status", zap.Error(err), zap.String("shipment_id", shipmentID))
+		return 0, fmt.Errorf("failed to update shipment status: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return added, nil
+}
+
+// ListShipmentEvents lists the events of a shipment, newest first
+func (r *ShipmentRepository) ListShipmentEvents(ctx context.Context, shipmentID string) ([]models.ShipmentEvent, error) {
+	query := `
+		SELECT e.id, e.status, e.description, e.location, e.source, e.occurred_at
+		FROM shipment_events e
+		JOIN shipments s ON s.id = e.shipment_id
+		WHERE e.shipment_id = $1 AND s.tenant_id = $2
+		ORDER BY e.occurred_at DESC, e.id DESC
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, shipmentID, tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("Failed to list shipment events", zap.Error(err), zap.String("shipment_id", shipmentID))
+		return nil, fmt.Errorf("failed to list shipment events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []models.ShipmentEvent
+	for rows.Next() {
+		var event models.ShipmentEvent
+		if err := rows.Scan(&event.ID, &event.Status, &event.Description, &event.Location, &event.Source, &event.OccurredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan shipment event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating shipment events: %w", err)
+	}
+	return events, nil
+}
+
+// ListShipmentsToPoll lists the shipments of all stores with the given
+// carriers that are still under way, least recently polled first
+func (r *ShipmentRepository) ListShipmentsToPoll(ctx context.Context, carriers []string, polledBefore time.Time, limit int) ([]models.Shipment, error) {
+	query := `
+		SELECT ` + shipmentColumns + `, tenant_id
+		FROM shipments
+		WHERE carrier = ANY($1)
+			AND status NOT IN ($2, $3)
+			AND (last_polled_at IS NULL OR last_polled_at < $4)
+		ORDER BY last_polled_at NULLS FIRST
+		LIMIT $5
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, carriers, models.ShipmentDelivered, models.ShipmentReturned, polledBefore, limit)
+	if err != nil {
+		r.logger.Error("Failed to list shipments to poll", zap.Error(err))
+		return nil, fmt.Errorf("failed to list shipments to poll: %w", err)
+	}
+	defer rows.Close()
+
+	var shipments []models.Shipment
+	for rows.Next() {
+		var tenantID string
+		shipment, err := scanShipment(rows, &tenantID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan shipment: %w", err)
+		}
+		shipment.TenantID = tenantID
+		shipments = append(shipments, *shipment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating shipments: %w", err)
+	}
+	return shipments, nil
+}
+
+// MarkShipmentPolled records that the carrier of a shipment of the current
+// store was just asked for its tracking events
+func (r *ShipmentRepository) MarkShipmentPolled(ctx context.Context, id string) error {
+	query := `UPDATE shipments SET last_polled_at = NOW() WHERE id = $1 AND tenant_id = $2`
+
+	if _, err := r.db.ExecContext(ctx, query, id, tenant.FromContext(ctx)); err != nil {
+		r.logger.Error("Failed to mark shipment polled", zap.Error(err), zap.String("id", id))
+		return fmt.Errorf("failed to mark shipment polled: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// CreateInventorySnapshots records the current stock level of every inventory item,
+// both as item totals and per warehouse, for the given day. Running it again for
+// the same day overwrites that day's snapshot.
+func (r *InventoryRepository) CreateInventorySnapshots(ctx context.Context, snapshotDate time.Time) (int, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	date := snapshotDate.UTC().Format("2006-01-02")
+
+	totalsQuery := `
+		INSERT INTO inventory_snapshots (
+			snapshot_date, inventory_item_id, warehouse_id,
+			quantity, available_quantity, reserved_quantity
+		)
+		SELECT $1::date, id, NULL, total_quantity, available_quantity, reserved_quantity
+		FROM inventory_items
+		ON CONFLICT (inventory_item_id, snapshot_date) WHERE warehouse_id IS NULL
+		DO UPDATE SET
+			quantity = EXCLUDED.quantity,
+			available_quantity = EXCLUDED.available_quantity,
+			reserved_quantity = EXCLUDED.reserved_quantity,
+			created_at = NOW()
+	`
+
+	result, err := tx.ExecContext(ctx, totalsQuery, date)
+	if err != nil {
+		r.logger.Error("Failed to snapshot inventory totals", zap.Error(err))
+		return 0, fmt.Errorf("failed to snapshot inventory totals: %w", err)
+	}
+	itemCount, _ := result.RowsAffected()
+
+	locationsQuery := `
+		INSERT INTO inventory_snapshots (
+			snapshot_date, inventory_item_id, warehouse_id,
+			quantity, available_quantity, reserved_quantity
+		)
+		SELECT $1::date, inventory_item_id, warehouse_id, quantity, available_quantity, reserved_quantity
+		FROM inventory_locations
+		ON CONFLICT (inventory_item_id, warehouse_id, snapshot_date) WHERE warehouse_id IS NOT NULL
+		DO UPDATE SET
+			quantity = EXCLUDED.quantity,
+			available_quantity = EXCLUDED.available_quantity,
+			reserved_quantity = EXCLUDED.reserved_quantity,
+			created_at = NOW()
+	`
+
+	if _, err := tx.ExecContext(ctx, locationsQuery, date); err != nil {
+		r.logger.Error("Failed to snapshot inventory locations", zap.Error(err))
+		return 0, fmt.Errorf("failed to snapshot inventory locations: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("Failed to commit transaction", zap.Error(err))
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return int(itemCount), nil
+}
+
+// GetInventorySnapshots retrieves the daily snapshots of an inventory item between
+// two dates (inclusive), ordered by date. A nil warehouseID returns the item totals.
+func (r *InventoryRepository) GetInventorySnapshots(ctx context.Context, inventoryItemID string, warehouseID *string, from, to time.Time) ([]models.InventorySnapshot, error) {
+	query := `
+		SELECT
+			id, snapshot_date, inventory_item_id, warehouse_id,
+			quantity, available_quantity, reserved_quantity, created_at
+		FROM inventory_snapshots
+		WHERE inventory_item_id = $1
+			AND snapshot_date BETWEEN $2::date AND $3::date
+	`
+	args := []interface{}{
+		inventoryItemID,
+		from.UTC().Format("2006-01-02"),
+		to.UTC().Format("2006-01-02"),
+	}
+
+	if warehouseID != nil {
+		query += " AND warehouse_id = $4"
+		args = append(args, *warehouseID)
+	} else {
+		query += " AND warehouse_id IS NULL"
+	}
+	query += " ORDER BY snapshot_date ASC"
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("Failed to get inventory snapshots", zap.Error(err))
+		return nil, fmt.Errorf("failed to get inventory snapshots: %w", err)
+	}
+	defer rows.Close()
+
+	var snapshots []models.InventorySnapshot
+	for rows.Next() {
+		var snapshot models.InventorySnapshot
+		var snapshotWarehouseID sql.NullString
+
+		if err := rows.Scan(
+			&snapshot.ID, &snapshot.SnapshotDate, &snapshot.InventoryItemID, &snapshotWarehouseID,
+			&snapshot.Quantity, &snapshot.AvailableQuantity, &snapshot.ReservedQuantity, &snapshot.CreatedAt,
+		); err != nil {
+			r.logger.Error("Failed to scan inventory snapshot", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan inventory snapshot: %w", err)
+		}
+
+		if snapshotWarehouseID.Valid {
+			snapshot.WarehouseID = &snapshotWarehouseID.String
+		}
+
+		snapshots = append(snapshots, snapshot)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("Error iterating inventory snapshots", zap.Error(err))
+		return nil, fmt.Errorf("error iterating inventory snapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// SetLocationStockBuffers sets the safety stock and max stock of an existing
+// inventory location
+func (r *InventoryRepository) SetLocationStockBuffers(ctx context.Context, inventoryItemID, warehouseID string, safetyStock, maxStock int) (*models.InventoryLocation, error) {
+	query := `
+		UPDATE inventory_locations
+		SET safety_stock = $3, max_stock = $4, updated_at = $5
+		WHERE inventory_item_id = $1 AND warehouse_id = $2
+		RETURNING
+			id, inventory_item_id, warehouse_id, quantity, available_quantity,
+			reserved_quantity, safety_stock, max_stock, created_at, updated_at
+	`
+
+	var location models.InventoryLocation
+	err := r.db.QueryRowContext(ctx, query, inventoryItemID, warehouseID, safetyStock, maxStock, time.Now().UTC()).Scan(
+		&location.ID, &location.InventoryItemID, &location.WarehouseID,
+		&location.Quantity, &location.AvailableQuantity, &location.ReservedQuantity,
+		&location.SafetyStock, &location.MaxStock, &location.CreatedAt, &location.UpdatedAt,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrNotFound
+		}
+		r.logger.Error("Failed to set location stock buffers",
+			zap.Error(err),
+			zap.String("inventory_item_id", inventoryItemID),
+			zap.String("warehouse_id", warehouseID))
+		return nil, fmt.Errorf("failed to set location stock buffers: %w", err)
+	}
+
+	return &location, nil
+}
+
+// ListStockAlerts returns every item at or below its reorder point and every
+// location below its safety stock or above its max stock. With a warehouse ID
+// only that warehouse's location alerts are returned.
+func (r *InventoryRepository) ListStockAlerts(ctx context.Context, warehouseID string) ([]models.StockAlert, error) {
+	query := `
+		SELECT type, inventory_item_id, product_id, sku, warehouse_id, quantity, threshold
+		FROM (
+			SELECT $2 AS type, i.id AS inventory_item_id, i.product_id, i.sku,
+				NULL::uuid AS warehouse_id, i.available_quantity AS quantity, i.reorder_point AS threshold
+			FROM inventory_items i
+			WHERE $1 = '' AND i.status <> $5 AND i.available_quantity <= i.reorder_point
+
+			UNION ALL
+
+			SELECT $3, i.id, i.product_id, i.sku, l.warehouse_id, l.available_quantity, l.safety_stock
+			FROM inventory_locations l
+			JOIN inventory_items i ON i.id = l.inventory_item_id
+			WHERE l.safety_stock > 0 AND l.available_quantity < l.safety_stock
+				AND ($1 = '' OR l.warehouse_id::text = $1)
+
+			UNION ALL
+
+			SELECT $4, i.id, i.product_id, i.sku, l.warehouse_id, l.quantity, l.max_stock
+			FROM inventory_locations l
+			JOIN inventory_items i ON i.id = l.inventory_item_id
+			WHERE l.max_stock > 0 AND l.quantity > l.max_stock
+				AND ($1 = '' OR l.warehouse_id::text = $1)
+		) alerts
+		ORDER BY sku, type
+	`
+
+	rows, err := r.db.QueryContext(ctx, query, warehouseID,
+		models.AlertLowStock, models.AlertBelowSafetyStock, models.AlertAboveMaxStock, models.StatusDiscontinued)
+	if err != nil {
+		r.logger.Error("Failed to list stock alerts", zap.Error(err))
+		return nil, fmt.Errorf("failed to list stock alerts: %w", err)
+	}
+	defer rows.Close()
+
+	now := time.Now().UTC()
+	var alerts []models.StockAlert
+	for rows.Next() {
+		var alert models.StockAlert
+		var alertWarehouseID sql.NullString
+		if err := rows.Scan(
+			&alert.Type, &alert.InventoryItemID, &alert.ProductID, &alert.SKU,
+			&alertWarehouseID, &alert.Quantity, &alert.Threshold,
+		); err != nil {
+			r.logger.Error("Failed to scan stock alert", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan stock alert: %w", err)
+		}
+		if alertWarehouseID.Valid {
+			alert.WarehouseID = &alertWarehouseID.String
+		}
+		alert.DetectedAt = now
+		alerts = append(alerts, alert)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.logger.Error("Error iterating stock alerts", zap.Error(err))
+		return nil, fmt.Errorf("error iterating stock alerts: %w", err)
+	}
+
+	return alerts, nil
+}
@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// GetStockMutation loads the stock mutation recorded under an idempotency key
+// of the current store
+func (r *InventoryRepository) GetStockMutation(ctx context.Context, idempotencyKey string) (*models.StockMutation, error) {
+	var mutation models.StockMutation
+	var result []byte
+	err := r.db.QueryRowContext(ctx, `
+		SELECT idempotency_key, operation, request_hash, result, created_at
+		FROM stock_mutation_keys
+		WHERE tenant_id = $1 AND idempotency_key = $2`,
+		tenant.FromContext(ctx), idempotencyKey).Scan(&mutation.IdempotencyKey, &mutation.Operation,
+		&mutation.RequestHash, &result, &mutation.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrNotFound
+		}
+		r.logger.Error("Failed to get stock mutation", zap.Error(err), zap.String("idempotency_key", idempotencyKey))
+		return nil, fmt.Errorf("failed to get stock mutation: %w", err)
+	}
+	mutation.Result = result
+	return &mutation, nil
+}
+
+// SaveStockMovement writes a location changed by a stock movement, as
+// UpsertInventoryLocation does, and records the movement in one transaction.
+// With a mutation, its idempotency key is recorded in the same transaction
+// with the location as its result, so the key is taken exactly when the
+// movement is applied. It returns ErrStockMutationRecorded, writing nothing,
+// when the key is already recorded; the primary key of stock_mutation_keys
+// makes a concurrent attempt with the key wait for the first one to commit.
+func (r *InventoryRepository) SaveStockMovement(ctx context.Context, location *models.InventoryLocation, movement *models.InventoryTransaction, mutation *models.StockMutation) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.logger.Error("Failed to begin transaction", zap.Error(err))
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	if mutation != nil {
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO stock_mutation_keys (tenant_id, idempotency_key, operation, request_hash)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (tenant_id, idempotency_key) DO NOTHING
+			RETURNING created_at`,
+			tenantID, mutation.IdempotencyKey, mutation.Operation, mutation.RequestHash).Scan(&mutation.CreatedAt)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrStockMutationRecorded
+		}
+		if err != nil {
+			r.logger.Error("Failed to record idempotency key", zap.Error(err), zap.String("idempotency_key", mutation.IdempotencyKey))
+			return fmt.Errorf("failed to record idempotency key: %w", err)
+		}
+	}
+
+	if err := r.upsertInventoryLocation(ctx, tx, location); err != nil {
+		return err
+	}
+
+	movement.CreatedAt = location.UpdatedAt
+	if err := insertInventoryTransaction(ctx, tx, movement); err != nil {
+		r.logger.Error("Failed to create inventory transaction", zap.Error(err))
+		return err
+	}
+
+	if mutation != nil {
+		result, err := json.Marshal(location)
+		if err != nil {
+			return fmt.Errorf("failed to encode stock mutation result: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE stock_mutation_keys
+			SET result = $3, completed_at = NOW()
+			WHERE tenant_id = $1 AND idempotency_key = $2`,
+			tenantID, mutation.IdempotencyKey, result); err != nil {
+			return fmt.Errorf("failed to complete stock mutation: %w", err)
+		}
+		mutation.Result = result
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.logger.Error("Failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
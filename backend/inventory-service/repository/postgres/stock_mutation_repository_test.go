@@ -0,0 +1,38 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+func TestSaveStockMovementWithARecordedKey(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	repo := NewInventoryRepository(db, zap.NewNop())
+
+	mutation := &models.StockMutation{IdempotencyKey: "key-1", Operation: models.TransactionStockAddition, RequestHash: "hash"}
+	mock.ExpectBegin()
+	// The key is taken, so the insert returns no row
+	mock.ExpectQuery(`INSERT INTO stock_mutation_keys`).
+		WithArgs(sqlmock.AnyArg(), "key-1", models.TransactionStockAddition, "hash").
+		WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+	mock.ExpectRollback()
+
+	err = repo.SaveStockMovement(context.Background(), &models.InventoryLocation{}, &models.InventoryTransaction{}, mutation)
+	if !errors.Is(err, models.ErrStockMutationRecorded) {
+		t.Errorf("err = %v, want ErrStockMutationRecorded", err)
+	}
+	// Neither the location nor the movement is written
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,150 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// UnitRepository implements the repository.UnitRepository interface
+type UnitRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewUnitRepository creates a new PostgreSQL unit of measure repository
+func NewUnitRepository(db *sql.DB, logger *zap.Logger) *UnitRepository {
+	return &UnitRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const unitColumns = `id, inventory_item_id, code, name, factor, created_at, updated_at`
+
+func scanUnit(row interface{ Scan(...any) error }) (*models.InventoryUnit, error) {
+	var unit models.InventoryUnit
+	err := row.Scan(&unit.ID, &unit.InventoryItemID, &unit.Code, &unit.Name, &unit.Factor,
+		&unit.CreatedAt, &unit.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &unit, nil
+}
+
+// SaveUnit creates a unit of an item of the current store or replaces its
+// name and factor
+func (r *UnitRepository) SaveUnit(ctx context.Context, unit *models.InventoryUnit) error {
+	query := `
+		INSERT INTO inventory_units (tenant_id, inventory_item_id, code, name, factor)
+		SELECT $1, i.id, $3, $4, $5
+		FROM inventory_items i
+		WHERE i.id = $2 AND i.tenant_id = $1
+		ON CONFLICT (inventory_item_id, code) DO UPDATE SET
+			name = EXCLUDED.name,
+			factor = EXCLUDED.factor,
+			updated_at = NOW()
+		RETURNING ` + unitColumns
+
+	saved, err := scanUnit(r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), unit.InventoryItemID,
+		unit.Code, unit.Name, unit.Factor))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrNotFound
+		}
+		r.logger.Error("Failed to save unit of measure", zap.Error(err),
+			zap.String("inventory_item_id", unit.InventoryItemID), zap.String("code", unit.Code))
+		return fmt.Errorf("failed to save unit of measure: %w", err)
+	}
+	*unit = *saved
+	return nil
+}
+
+// GetUnit retrieves a unit of an item of the current store by its code
+func (r *UnitRepository) GetUnit(ctx context.Context, inventoryItemID, code string) (*models.InventoryUnit, error) {
+	query := `
+		SELECT ` + unitColumns + `
+		FROM inventory_units
+		WHERE tenant_id = $1 AND inventory_item_id = $2 AND code = $3`
+
+	unit, err := scanUnit(r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), inventoryItemID, code))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrUnitNotFound
+		}
+		return nil, fmt.Errorf("failed to get unit of measure: %w", err)
+	}
+	return unit, nil
+}
+
+// ListUnits lists the units of an item of the current store, smallest first
+func (r *UnitRepository) ListUnits(ctx context.Context, inventoryItemID string) ([]models.InventoryUnit, error) {
+	query := `
+		SELECT ` + unitColumns + `
+		FROM inventory_units
+		WHERE tenant_id = $1 AND inventory_item_id = $2
+		ORDER BY factor, code`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), inventoryItemID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list units of measure: %w", err)
+	}
+	defer rows.Close()
+
+	var units []models.InventoryUnit
+	for rows.Next() {
+		unit, err := scanUnit(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan unit of measure: %w", err)
+		}
+		units = append(units, *unit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate units of measure: %w", err)
+	}
+	return units, nil
+}
+
+// DeleteUnit removes a unit of an item of the current store, unless open
+// purchase orders are still to be received in it
+func (r *UnitRepository) DeleteUnit(ctx context.Context, inventoryItemID, code string) error {
+	var inUse bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM purchase_order_lines l
+			JOIN purchase_orders po ON po.id = l.purchase_order_id
+			WHERE l.inventory_item_id = $1 AND l.unit_code = $2
+				AND l.quantity_received < l.quantity_ordered
+				AND po.status IN ($3, $4)
+		)`,
+		inventoryItemID, code, models.PurchaseOrderOpen, models.PurchaseOrderPartiallyReceived).Scan(&inUse)
+	if err != nil {
+		return fmt.Errorf("failed to check purchase orders of unit of measure: %w", err)
+	}
+	if inUse {
+		return models.ErrUnitInUse
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM inventory_units
+		WHERE tenant_id = $1 AND inventory_item_id = $2 AND code = $3`,
+		tenant.FromContext(ctx), inventoryItemID, code)
+	if err != nil {
+		return fmt.Errorf("failed to delete unit of measure: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return models.ErrUnitNotFound
+	}
+	return nil
+}
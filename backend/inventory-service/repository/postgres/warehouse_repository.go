@@ -9,6 +9,7 @@ import (
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
 )
 
@@ -45,9 +46,9 @@ func (r *WarehouseRepository) CreateWarehouse(ctx context.Context, warehouse *mo
 	query := `
 		INSERT INTO warehouses (
 			id, name, code, address, city, state, country, postal_code,
-			is_active, priority, created_at, updated_at
+			is_active, priority, created_at, updated_at, tenant_id
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
 		)
 	`
 
@@ -56,6 +57,7 @@ func (r *WarehouseRepository) CreateWarehouse(ctx context.Context, warehouse *mo
 		warehouse.ID, warehouse.Name, warehouse.Code, warehouse.Address,
 		warehouse.City, warehouse.State, warehouse.Country, warehouse.PostalCode,
 		warehouse.IsActive, warehouse.Priority, warehouse.CreatedAt, warehouse.UpdatedAt,
+		tenant.FromContext(ctx),
 	)
 
 	if err != nil {
@@ -94,18 +96,19 @@ func (r *WarehouseRepository) GetWarehouseByID(ctx context.Context, id string) (
 	return &warehouse, nil
 }
 
-// GetWarehouseByCode retrieves a warehouse by its code
+// GetWarehouseByCode retrieves a warehouse of the current store by its code,
+// as codes are only unique per store
 func (r *WarehouseRepository) GetWarehouseByCode(ctx context.Context, code string) (*models.Warehouse, error) {
 	query := `
 		SELECT 
 			id, name, code, address, city, state, country, postal_code,
 			is_active, priority, created_at, updated_at
 		FROM warehouses
-		WHERE code = $1
+		WHERE code = $1 AND tenant_id = $2
 	`
 
 	var warehouse models.Warehouse
-	err := r.db.QueryRowContext(ctx, query, code).Scan(
+	err := r.db.QueryRowContext(ctx, query, code, tenant.FromContext(ctx)).Scan(
 		&warehouse.ID, &warehouse.Name, &warehouse.Code, &warehouse.Address,
 		&warehouse.City, &warehouse.State, &warehouse.Country, &warehouse.PostalCode,
 		&warehouse.IsActive, &warehouse.Priority, &warehouse.CreatedAt, &warehouse.UpdatedAt,
@@ -170,13 +173,13 @@ func (r *WarehouseRepository) UpdateWarehouse(ctx context.Context, warehouse *mo
 
 // ListWarehouses retrieves a paginated list of warehouses with optional filters
 func (r *WarehouseRepository) ListWarehouses(ctx context.Context, offset, limit int, isActive *bool) ([]*models.Warehouse, int, error) {
-	// Build the WHERE clause based on filters
-	whereClause := ""
-	args := []interface{}{}
-	argIndex := 1
+	// Build the WHERE clause based on filters, always scoped to the tenant
+	whereClause := "WHERE tenant_id = $1"
+	args := []interface{}{tenant.FromContext(ctx)}
+	argIndex := 2
 
 	if isActive != nil {
-		whereClause = fmt.Sprintf("WHERE is_active = $%d", argIndex)
+		whereClause += fmt.Sprintf(" AND is_active = $%d", argIndex)
 		args = append(args, *isActive)
 		argIndex++
 	}
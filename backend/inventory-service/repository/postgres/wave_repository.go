@@ -0,0 +1,300 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// WaveRepository implements the repository.WaveRepository interface
+type WaveRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// NewWaveRepository creates a new PostgreSQL fulfillment wave repository
+func NewWaveRepository(db *sql.DB, logger *zap.Logger) *WaveRepository {
+	return &WaveRepository{
+		db:     db,
+		logger: logger,
+	}
+}
+
+const waveColumns = `w.id, w.warehouse_id, w.carrier, w.cutoff_at, w.status, w.created_at, w.completed_at,
+	(SELECT COUNT(DISTINCT l.order_reference) FROM fulfillment_wave_lines l WHERE l.wave_id = w.id)`
+
+func scanWave(row interface{ Scan(...any) error }, extra ...any) (*models.Wave, error) {
+	var wave models.Wave
+	var completedAt sql.NullTime
+	dest := append([]any{
+		&wave.ID, &wave.WarehouseID, &wave.Carrier, &wave.CutoffAt, &wave.Status, &wave.CreatedAt, &completedAt,
+		&wave.Orders,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if completedAt.Valid {
+		wave.CompletedAt = &completedAt.Time
+	}
+	return &wave, nil
+}
+
+// CreateWave creates a wave of a warehouse of the current store with a line
+// for each confirmed order reservation at the warehouse selected by the
+// filter, the oldest orders first. Reservations already in a wave that was
+// not cancelled are left out, also when another wave takes them meanwhile.
+func (r *WaveRepository) CreateWave(ctx context.Context, wave *models.Wave, filter models.WaveFilter) error {
+	tenantID := tenant.FromContext(ctx)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO fulfillment_waves (tenant_id, warehouse_id, carrier, cutoff_at, status)
+		SELECT $1, w.id, $3, $4, $5
+		FROM warehouses w
+		WHERE w.id = $2 AND w.tenant_id = $1
+		RETURNING id, status, created_at`,
+		tenantID, filter.WarehouseID, filter.Carrier, filter.CutoffAt, models.WaveOpen,
+	).Scan(&wave.ID, &wave.Status, &wave.CreatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrWarehouseNotFound
+		}
+		return fmt.Errorf("failed to create wave: %w", err)
+	}
+
+	var orderFilter any
+	if len(filter.OrderReferences) > 0 {
+		orderFilter = filter.OrderReferences
+	}
+	result, err := tx.ExecContext(ctx, `
+		WITH pending AS (
+			SELECT r.id, r.reference_id::text AS order_reference, r.inventory_item_id, r.quantity, r.created_at
+			FROM inventory_reservations r
+			JOIN inventory_items i ON i.id = r.inventory_item_id
+			WHERE i.tenant_id = $2 AND r.warehouse_id = $3 AND r.status = $4
+				AND r.reference_id IS NOT NULL AND r.created_at <= $5
+				-- Pickup orders are picked by their store, not shipped
+				AND r.reference_type IS DISTINCT FROM $10
+				AND ($6::text[] IS NULL OR r.reference_id::text = ANY($6::text[]))
+				AND NOT EXISTS (
+					SELECT 1 FROM fulfillment_wave_lines l
+					WHERE l.reservation_id = r.id AND l.status <> $7
+				)
+		), orders AS (
+			SELECT order_reference
+			FROM pending
+			GROUP BY order_reference
+			ORDER BY MIN(created_at), order_reference
+			LIMIT $8
+		)
+		INSERT INTO fulfillment_wave_lines (wave_id, reservation_id, order_reference, inventory_item_id, quantity, status)
+		SELECT $1, p.id, p.order_reference, p.inventory_item_id, p.quantity, $9
+		FROM pending p
+		JOIN orders o ON o.order_reference = p.order_reference
+		ON CONFLICT (reservation_id) WHERE status <> 'cancelled' DO NOTHING`,
+		wave.ID, tenantID, filter.WarehouseID, models.ReservationConfirmed, filter.CutoffAt,
+		orderFilter, models.WaveLineCancelled, filter.MaxOrders, models.WaveLinePending, models.ReferencePickupOrder)
+	if err != nil {
+		r.logger.Error("Failed to batch wave lines", zap.Error(err), zap.String("wave_id", wave.ID))
+		return fmt.Errorf("failed to batch wave lines: %w", err)
+	}
+	if lines, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	} else if lines == 0 {
+		return models.ErrWaveEmpty
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	wave.WarehouseID = filter.WarehouseID
+	wave.Carrier = filter.Carrier
+	wave.CutoffAt = filter.CutoffAt
+	return nil
+}
+
+// GetWave retrieves a wave of the current store with its lines by order
+func (r *WaveRepository) GetWave(ctx context.Context, id string) (*models.Wave, error) {
+	wave, err := scanWave(r.db.QueryRowContext(ctx, `
+		SELECT `+waveColumns+`
+		FROM fulfillment_waves w
+		WHERE w.id = $1 AND w.tenant_id = $2`, id, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrWaveNotFound
+		}
+		return nil, fmt.Errorf("failed to get wave: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT l.id, l.wave_id, l.reservation_id, l.order_reference, l.inventory_item_id, i.sku,
+			l.quantity, l.picked_quantity, l.status, l.updated_at
+		FROM fulfillment_wave_lines l
+		JOIN inventory_items i ON i.id = l.inventory_item_id
+		WHERE l.wave_id = $1
+		ORDER BY l.order_reference, i.sku`, id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wave lines: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var line models.WaveLine
+		if err := rows.Scan(
+			&line.ID, &line.WaveID, &line.ReservationID, &line.OrderReference, &line.InventoryItemID, &line.SKU,
+			&line.Quantity, &line.PickedQuantity, &line.Status, &line.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan wave line: %w", err)
+		}
+		wave.Lines = append(wave.Lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate wave lines: %w", err)
+	}
+	return wave, nil
+}
+
+// ListWaves lists the waves of the current store, of one warehouse and in
+// one status when given, newest first
+func (r *WaveRepository) ListWaves(ctx context.Context, warehouseID, status string, offset, limit int) ([]models.Wave, int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+waveColumns+`, COUNT(*) OVER()
+		FROM fulfillment_waves w
+		WHERE w.tenant_id = $1
+			AND ($2 = '' OR w.warehouse_id::text = $2)
+			AND ($3 = '' OR w.status = $3)
+		ORDER BY w.created_at DESC
+		LIMIT $4 OFFSET $5`,
+		tenant.FromContext(ctx), warehouseID, status, limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list waves: %w", err)
+	}
+	defer rows.Close()
+
+	var waves []models.Wave
+	total := 0
+	for rows.Next() {
+		wave, err := scanWave(rows, &total)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan wave: %w", err)
+		}
+		waves = append(waves, *wave)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate waves: %w", err)
+	}
+	return waves, total, nil
+}
+
+// CompleteWave records the quantities picked of the lines of an open wave of
+// the current store and completes it. Lines picked in full are picked, the
+// others short; lines not given are picked in full.
+func (r *WaveRepository) CompleteWave(ctx context.Context, id string, picked []models.PickedLine) (*models.Wave, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockOpenWave(ctx, tx, id); err != nil {
+		return nil, err
+	}
+
+	for _, line := range picked {
+		result, err := tx.ExecContext(ctx, `
+			UPDATE fulfillment_wave_lines
+			SET picked_quantity = $3,
+				status = CASE WHEN $3 = quantity THEN $4 ELSE $5 END,
+				updated_at = NOW()
+			WHERE id = $1 AND wave_id = $2 AND $3 <= quantity`,
+			line.LineID, id, line.PickedQuantity, models.WaveLinePicked, models.WaveLineShort)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record picked line: %w", err)
+		}
+		if updated, err := result.RowsAffected(); err != nil {
+			return nil, fmt.Errorf("failed to get rows affected: %w", err)
+		} else if updated == 0 {
+			var exists bool
+			if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM fulfillment_wave_lines WHERE id = $1 AND wave_id = $2)`,
+				line.LineID, id).Scan(&exists); err != nil {
+				return nil, fmt.Errorf("failed to check wave line: %w", err)
+			}
+			if !exists {
+				return nil, models.ErrWaveLineNotFound
+			}
+			return nil, apperrors.Errorf(apperrors.ErrInvalidArgument, "picked quantity of line %s exceeds its quantity", line.LineID)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE fulfillment_wave_lines
+		SET picked_quantity = quantity, status = $2, updated_at = NOW()
+		WHERE wave_id = $1 AND status = $3`,
+		id, models.WaveLinePicked, models.WaveLinePending); err != nil {
+		return nil, fmt.Errorf("failed to pick remaining lines: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE fulfillment_waves SET status = $2, completed_at = NOW() WHERE id = $1`,
+		id, models.WaveCompleted); err != nil {
+		return nil, fmt.Errorf("failed to complete wave: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetWave(ctx, id)
+}
+
+// CancelWave cancels an open wave of the current store and its lines, so
+// that its orders are batched into later waves
+func (r *WaveRepository) CancelWave(ctx context.Context, id string) (*models.Wave, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockOpenWave(ctx, tx, id); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE fulfillment_wave_lines SET status = $2, updated_at = NOW() WHERE wave_id = $1`,
+		id, models.WaveLineCancelled); err != nil {
+		return nil, fmt.Errorf("failed to cancel wave lines: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE fulfillment_waves SET status = $2 WHERE id = $1`,
+		id, models.WaveCancelled); err != nil {
+		return nil, fmt.Errorf("failed to cancel wave: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return r.GetWave(ctx, id)
+}
+
+// lockOpenWave locks a wave of the current store, which must be open
+func lockOpenWave(ctx context.Context, tx *sql.Tx, id string) error {
+	var status string
+	err := tx.QueryRowContext(ctx, `SELECT status FROM fulfillment_waves WHERE id = $1 AND tenant_id = $2 FOR UPDATE`,
+		id, tenant.FromContext(ctx)).Scan(&status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrWaveNotFound
+		}
+		return fmt.Errorf("failed to lock wave: %w", err)
+	}
+	if status != models.WaveOpen {
+		return models.ErrWaveNotOpen
+	}
+	return nil
+}
@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// CheckAvailabilityBulk checks all lines of a cart or checkout in a single call.
+// Lines are checked independently of each other. A line that cannot be
+// fulfilled as requested carries alternatives: other active warehouses that
+// hold the full quantity, highest priority first, and the largest quantity
+// that can be fulfilled from the requested stock. Safety stock held back at a
+// warehouse never counts as available.
+func (s *InventoryService) CheckAvailabilityBulk(ctx context.Context, lines []models.BulkAvailabilityLine) ([]models.BulkAvailabilityResult, bool, error) {
+	if len(lines) == 0 {
+		return nil, false, models.ErrInvalidInput
+	}
+	for _, line := range lines {
+		if line.SKU == "" || line.Quantity <= 0 {
+			return nil, false, models.ErrInvalidInput
+		}
+	}
+
+	// Carts may repeat a SKU across lines, so items and warehouses are loaded once per call
+	items := make(map[string]*models.InventoryItem)
+	warehouses := make(map[string]*models.Warehouse)
+
+	results := make([]models.BulkAvailabilityResult, 0, len(lines))
+	allAvailable := true
+
+	for i, line := range lines {
+		item, loaded := items[line.SKU]
+		if !loaded {
+			var err error
+			item, err = s.inventoryRepo.GetInventoryItemBySKU(ctx, line.SKU)
+			if err != nil {
+				if !errors.Is(err, models.ErrNotFound) {
+					s.logger.Error("Failed to get inventory item", zap.Error(err), zap.String("sku", line.SKU))
+					return nil, false, fmt.Errorf("failed to get inventory item: %w", err)
+				}
+				item = nil
+			} else {
+				s.applyAvailability(ctx, item)
+			}
+			items[line.SKU] = item
+		}
+
+		result := models.BulkAvailabilityResult{
+			LineIndex:         i,
+			SKU:               line.SKU,
+			WarehouseID:       line.WarehouseID,
+			RequestedQuantity: line.Quantity,
+		}
+
+		if item == nil {
+			result.Status = models.AvailabilityStatusNotFound
+			result.Availability = availability.OutOfStock
+			results = append(results, result)
+			allAvailable = false
+			continue
+		}
+
+		result.ProductID = item.ProductID
+		result.VariantID = item.VariantID
+		result.Status = item.Status
+		result.Availability = item.Availability
+		result.AvailableQuantity = item.SellableQuantity()
+		if line.WarehouseID != nil {
+			result.AvailableQuantity = locationSellableQuantity(item.Locations, *line.WarehouseID)
+		}
+		result.IsAvailable = result.AvailableQuantity >= line.Quantity
+
+		if !result.IsAvailable {
+			allAvailable = false
+			alternatives, err := s.availabilityAlternatives(ctx, item, line, result.AvailableQuantity, warehouses)
+			if err != nil {
+				return nil, false, err
+			}
+			result.Alternatives = alternatives
+		}
+
+		results = append(results, result)
+	}
+
+	return results, allAvailable, nil
+}
+
+// availabilityAlternatives suggests other warehouses and a reduced quantity for
+// a line that cannot be fulfilled as requested
+func (s *InventoryService) availabilityAlternatives(ctx context.Context, item *models.InventoryItem, line models.BulkAvailabilityLine, available int, warehouses map[string]*models.Warehouse) ([]models.AvailabilityAlternative, error) {
+	var alternatives []models.AvailabilityAlternative
+
+	if line.WarehouseID != nil {
+		for _, location := range item.Locations {
+			if location.WarehouseID == *line.WarehouseID || location.SellableQuantity() < line.Quantity {
+				continue
+			}
+
+			warehouse, err := s.cachedWarehouse(ctx, location.WarehouseID, warehouses)
+			if err != nil {
+				return nil, err
+			}
+			if warehouse == nil || !warehouse.IsActive {
+				continue
+			}
+
+			warehouseID := location.WarehouseID
+			alternatives = append(alternatives, models.AvailabilityAlternative{
+				Type:              models.AlternativeOtherWarehouse,
+				WarehouseID:       &warehouseID,
+				WarehouseName:     warehouse.Name,
+				Quantity:          line.Quantity,
+				AvailableQuantity: location.SellableQuantity(),
+			})
+		}
+
+		sort.SliceStable(alternatives, func(i, j int) bool {
+			return warehouses[*alternatives[i].WarehouseID].Priority > warehouses[*alternatives[j].WarehouseID].Priority
+		})
+	}
+
+	if available > 0 {
+		alternatives = append(alternatives, models.AvailabilityAlternative{
+			Type:              models.AlternativeReducedQuantity,
+			WarehouseID:       line.WarehouseID,
+			Quantity:          available,
+			AvailableQuantity: available,
+		})
+	}
+
+	return alternatives, nil
+}
+
+// cachedWarehouse returns the warehouse from the per-call cache, loading it on
+// first use. Unknown warehouses are cached as nil.
+func (s *InventoryService) cachedWarehouse(ctx context.Context, id string, warehouses map[string]*models.Warehouse) (*models.Warehouse, error) {
+	if warehouse, ok := warehouses[id]; ok {
+		return warehouse, nil
+	}
+
+	warehouse, err := s.warehouseRepo.GetWarehouseByID(ctx, id)
+	if err != nil {
+		if !errors.Is(err, models.ErrNotFound) {
+			s.logger.Error("Failed to get warehouse", zap.Error(err), zap.String("id", id))
+			return nil, fmt.Errorf("failed to get warehouse: %w", err)
+		}
+		warehouse = nil
+	}
+	warehouses[id] = warehouse
+	return warehouse, nil
+}
+
+func locationSellableQuantity(locations []models.InventoryLocation, warehouseID string) int {
+	for _, location := range locations {
+		if location.WarehouseID == warehouseID {
+			return location.SellableQuantity()
+		}
+	}
+	return 0
+}
+
+// GetAvailabilityPolicy returns the availability thresholds of a product, or
+// the default policy when the product has none
+func (s *InventoryService) GetAvailabilityPolicy(ctx context.Context, productID string) (*models.AvailabilityPolicy, error) {
+	if _, err := uuid.Parse(productID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid product ID")
+	}
+
+	policy, err := s.policyRepo.GetPolicy(ctx, productID)
+	if errors.Is(err, models.ErrNotFound) {
+		return &models.AvailabilityPolicy{ProductID: productID}, nil
+	}
+	if err != nil {
+		s.logger.Error("Failed to get availability policy", zap.Error(err), zap.String("product_id", productID))
+		return nil, err
+	}
+	return policy, nil
+}
+
+// SetAvailabilityPolicy creates or replaces the availability thresholds of a
+// product. The low stock threshold cannot be below the out of stock one, or
+// the product would never show as low on stock.
+func (s *InventoryService) SetAvailabilityPolicy(ctx context.Context, policy *models.AvailabilityPolicy) (*models.AvailabilityPolicy, error) {
+	if _, err := uuid.Parse(policy.ProductID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid product ID")
+	}
+	if policy.OutOfStockThreshold < 0 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "out_of_stock_threshold must not be negative")
+	}
+	if policy.LowStockThreshold != nil && *policy.LowStockThreshold < policy.OutOfStockThreshold {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "low_stock_threshold must not be below out_of_stock_threshold")
+	}
+
+	if err := s.policyRepo.SavePolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Availability policy saved",
+		zap.String("product_id", policy.ProductID),
+		zap.Int("out_of_stock_threshold", policy.OutOfStockThreshold),
+		zap.Bool("allow_backorder", policy.AllowBackorder))
+	return policy, nil
+}
+
+// DeleteAvailabilityPolicy removes the thresholds of a product, which goes
+// back to the default policy
+func (s *InventoryService) DeleteAvailabilityPolicy(ctx context.Context, productID string) error {
+	if _, err := uuid.Parse(productID); err != nil {
+		return apperrors.New(apperrors.ErrInvalidArgument, "invalid product ID")
+	}
+	return s.policyRepo.DeletePolicy(ctx, productID)
+}
+
+// applyAvailability sets the stock badge of the items from the policies of
+// their products. Locations must be loaded for safety stock to be held back.
+// Items whose policy cannot be loaded use the default policy.
+func (s *InventoryService) applyAvailability(ctx context.Context, items ...*models.InventoryItem) {
+	if len(items) == 0 {
+		return
+	}
+
+	productIDs := make([]string, 0, len(items))
+	seen := make(map[string]bool, len(items))
+	for _, item := range items {
+		if !seen[item.ProductID] {
+			seen[item.ProductID] = true
+			productIDs = append(productIDs, item.ProductID)
+		}
+	}
+
+	policies, err := s.policyRepo.ListPolicies(ctx, productIDs)
+	if err != nil {
+		s.logger.Warn("Failed to load availability policies, using the default policy", zap.Error(err))
+	}
+
+	for _, item := range items {
+		rules := availability.Policy{}
+		if policy, ok := policies[item.ProductID]; ok {
+			rules = policy.Rules()
+		}
+		item.Availability = rules.Badge(models.StockOf(item))
+	}
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	netmail "net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/mail"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+// backInStockSweepBatch bounds the subscriptions notified per sweep
+const backInStockSweepBatch = 200
+
+// BackInStockService keeps the back-in-stock subscriptions of shoppers and
+// emails them when the stock of their product comes back. Subscribing to a
+// product with available units is refused, so every pending subscription
+// waits for its product to go from no available units to some, and is
+// notified once when it does.
+type BackInStockService struct {
+	repo       repository.BackInStockRepository
+	mailer     mail.Mailer
+	productURL string
+	logger     *zap.Logger
+}
+
+// NewBackInStockService creates a new back-in-stock service. productURL is
+// the storefront page linked in the emails, with {product_id} where the
+// product ID goes; the emails carry no link when it is empty.
+func NewBackInStockService(repo repository.BackInStockRepository, mailer mail.Mailer, productURL string, logger *zap.Logger) *BackInStockService {
+	return &BackInStockService{
+		repo:       repo,
+		mailer:     mailer,
+		productURL: productURL,
+		logger:     logger,
+	}
+}
+
+// Subscribe subscribes an email to a product, or to one of its variants,
+// that is out of stock
+func (s *BackInStockService) Subscribe(ctx context.Context, sub *models.BackInStockSubscription) (*models.BackInStockSubscription, error) {
+	if _, err := uuid.Parse(sub.ProductID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid product ID")
+	}
+	if sub.VariantID != "" {
+		if _, err := uuid.Parse(sub.VariantID); err != nil {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid variant ID")
+		}
+	}
+	address, err := netmail.ParseAddress(strings.TrimSpace(sub.Email))
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid email address")
+	}
+	sub.Email = strings.ToLower(address.Address)
+
+	inStock, err := s.repo.IsInStock(ctx, sub.ProductID, sub.VariantID)
+	if err != nil {
+		return nil, err
+	}
+	if inStock {
+		return nil, models.ErrProductInStock
+	}
+
+	if err := s.repo.CreateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Back-in-stock subscription saved",
+		zap.String("id", sub.ID),
+		zap.String("product_id", sub.ProductID),
+		zap.String("variant_id", sub.VariantID))
+	return sub, nil
+}
+
+// ListSubscriptions retrieves a paginated list of subscriptions
+func (s *BackInStockService) ListSubscriptions(ctx context.Context, filter models.BackInStockFilter, page, limit int) ([]models.BackInStockSubscription, int, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+	return s.repo.ListSubscriptions(ctx, filter, offset, limit)
+}
+
+// DeleteSubscription removes a subscription; when userID is set only one of
+// that user's subscriptions
+func (s *BackInStockService) DeleteSubscription(ctx context.Context, id, userID string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return models.ErrBackInStockSubscriptionNotFound
+	}
+	return s.repo.DeleteSubscription(ctx, id, userID)
+}
+
+// StartNotifier notifies subscribers as the stock changes of this instance
+// bring their products back in stock, and sweeps at the given interval for
+// the products restocked while changes were dropped or made elsewhere,
+// until the context is cancelled
+func (s *BackInStockService) StartNotifier(ctx context.Context, inventory *InventoryService, sweepInterval time.Duration) {
+	changes, unsubscribe := inventory.WatchInventory(models.StockFilter{})
+
+	go func() {
+		defer unsubscribe()
+		ticker := time.NewTicker(sweepInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Back-in-stock notifier stopped")
+				return
+			case change := <-changes:
+				if change.AvailableQuantity > 0 {
+					s.notifyRestocked(tenant.WithTenant(ctx, change.TenantID), change)
+				}
+			case <-ticker.C:
+				s.sweep(ctx)
+			}
+		}
+	}()
+}
+
+func (s *BackInStockService) notifyRestocked(ctx context.Context, change models.StockChange) {
+	variantID := ""
+	if change.VariantID != nil {
+		variantID = *change.VariantID
+	}
+	subs, err := s.repo.ClaimSubscriptions(ctx, change.ProductID, variantID)
+	if err != nil {
+		s.logger.Error("Failed to claim back-in-stock subscriptions", zap.Error(err), zap.String("product_id", change.ProductID))
+		return
+	}
+	for _, sub := range subs {
+		s.notify(ctx, sub)
+	}
+}
+
+func (s *BackInStockService) sweep(ctx context.Context) {
+	subs, err := s.repo.ClaimRestockedSubscriptions(ctx, backInStockSweepBatch)
+	if err != nil {
+		s.logger.Error("Back-in-stock sweep failed", zap.Error(err))
+		return
+	}
+	for _, sub := range subs {
+		s.notify(tenant.WithTenant(ctx, sub.TenantID), sub)
+	}
+}
+
+// notify emails a claimed subscription, returning it to pending when the
+// email cannot be sent so that the next sweep retries it
+func (s *BackInStockService) notify(ctx context.Context, sub models.BackInStockSubscription) {
+	body := "Good news: a product you asked us to watch is back in stock.\n"
+	if s.productURL != "" {
+		body += "\n" + strings.ReplaceAll(s.productURL, "{product_id}", sub.ProductID) + "\n"
+	}
+	body += "\nThis is the only email you will get about it; subscribe again to be told next time it sells out and comes back.\n"
+
+	err := s.mailer.Send(ctx, mail.Message{
+		To:      []string{sub.Email},
+		Subject: "Back in stock",
+		Body:    body,
+	})
+	if err != nil {
+		s.logger.Warn("Failed to send back-in-stock email", zap.Error(err), zap.String("id", sub.ID))
+		if err := s.repo.ReleaseSubscription(ctx, sub.ID); err != nil {
+			s.logger.Error("Failed to release back-in-stock subscription", zap.Error(err), zap.String("id", sub.ID))
+		}
+		return
+	}
+	s.logger.Info("Back-in-stock subscriber notified",
+		zap.String("id", sub.ID),
+		zap.String("product_id", sub.ProductID),
+		zap.String("tenant_id", tenant.FromContext(ctx)))
+}
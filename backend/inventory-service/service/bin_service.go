@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+// maxBinAddressPart bounds each part of the address of a bin
+const maxBinAddressPart = 20
+
+// BinService manages the bins of warehouses, addressed by zone, aisle, shelf
+// and bin, and the stock placed in them. Bin stock refines the stock of the
+// locations: the bins of an item at a warehouse never hold more than its
+// location, and units not placed in a bin are simply unassigned. Pick lists
+// walk the bins holding the stock of an order in path order.
+type BinService struct {
+	binRepo          repository.BinRepository
+	warehouseRepo    repository.WarehouseRepository
+	inventoryService *InventoryService
+	logger           *zap.Logger
+}
+
+// NewBinService creates a new bin service
+func NewBinService(
+	binRepo repository.BinRepository,
+	warehouseRepo repository.WarehouseRepository,
+	inventoryService *InventoryService,
+	logger *zap.Logger,
+) *BinService {
+	return &BinService{
+		binRepo:          binRepo,
+		warehouseRepo:    warehouseRepo,
+		inventoryService: inventoryService,
+		logger:           logger,
+	}
+}
+
+// SaveBin creates the bin of a warehouse at the given address, or sets
+// whether the bin at that address is active. Address parts are upper cased.
+func (s *BinService) SaveBin(ctx context.Context, bin *models.WarehouseBin) (*models.WarehouseBin, error) {
+	if _, err := uuid.Parse(bin.WarehouseID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+	}
+	for _, part := range []struct {
+		name  string
+		value *string
+	}{{"zone", &bin.Zone}, {"aisle", &bin.Aisle}, {"shelf", &bin.Shelf}, {"bin", &bin.Bin}} {
+		*part.value = strings.ToUpper(strings.TrimSpace(*part.value))
+		if *part.value == "" || len(*part.value) > maxBinAddressPart || strings.Contains(*part.value, "-") {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument,
+				fmt.Sprintf("%s is required, must be at most %d characters and may not contain '-'", part.name, maxBinAddressPart))
+		}
+	}
+	bin.Code = models.BinCode(bin.Zone, bin.Aisle, bin.Shelf, bin.Bin)
+
+	if err := s.binRepo.SaveBin(ctx, bin); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Bin saved",
+		zap.String("bin_id", bin.ID),
+		zap.String("warehouse_id", bin.WarehouseID),
+		zap.String("code", bin.Code),
+		zap.Bool("is_active", bin.IsActive))
+	return bin, nil
+}
+
+// ListBins lists the bins of a warehouse, of one zone when given, by address
+func (s *BinService) ListBins(ctx context.Context, warehouseID, zone string) ([]models.WarehouseBin, error) {
+	if _, err := uuid.Parse(warehouseID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+	}
+	return s.binRepo.ListBins(ctx, warehouseID, strings.ToUpper(strings.TrimSpace(zone)))
+}
+
+// DeleteBin removes a bin that holds no stock
+func (s *BinService) DeleteBin(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return apperrors.New(apperrors.ErrInvalidArgument, "invalid bin ID")
+	}
+	if err := s.binRepo.DeleteBin(ctx, id); err != nil {
+		return err
+	}
+	s.logger.Info("Bin deleted", zap.String("bin_id", id))
+	return nil
+}
+
+// SetBinStock sets the units of an item placed in a bin, in the given unit of
+// measure converted to base units. Zero removes the item from the bin.
+func (s *BinService) SetBinStock(ctx context.Context, binID, inventoryItemID string, quantity int, unitCode string) (*models.BinStock, error) {
+	if _, err := uuid.Parse(binID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid bin ID")
+	}
+	if _, err := uuid.Parse(inventoryItemID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid inventory item ID")
+	}
+	if quantity < 0 {
+		return nil, models.ErrInvalidQuantity
+	}
+	unit, err := s.inventoryService.ResolveUnit(ctx, inventoryItemID, unitCode)
+	if err != nil {
+		return nil, err
+	}
+
+	stock, err := s.binRepo.SetBinStock(ctx, binID, inventoryItemID, unit.ToBase(quantity))
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Bin stock set",
+		zap.String("bin_id", binID),
+		zap.String("inventory_item_id", inventoryItemID),
+		zap.Int("quantity", stock.Quantity))
+	return stock, nil
+}
+
+// ListBinStock lists the stock placed in the bins of a warehouse, of one item
+// when given
+func (s *BinService) ListBinStock(ctx context.Context, warehouseID, inventoryItemID string) ([]models.BinStock, error) {
+	if _, err := uuid.Parse(warehouseID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+	}
+	var itemIDs []string
+	if inventoryItemID != "" {
+		if _, err := uuid.Parse(inventoryItemID); err != nil {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid inventory item ID")
+		}
+		itemIDs = []string{inventoryItemID}
+	}
+	return s.binRepo.ListBinStock(ctx, warehouseID, itemIDs, false)
+}
+
+// GeneratePickList plans the picks of the lines, in base units, at a
+// warehouse. Each line is taken from the active bins holding the most of its
+// item first, so that it needs as few stops as possible; units not placed in
+// any active bin are reported as shortages. Stops are ordered along the pick
+// path: zone by zone, aisle by aisle, walking up one aisle and down the next.
+// Lines of the same item are merged.
+func (s *BinService) GeneratePickList(ctx context.Context, warehouseID string, lines []models.PickListLine) (*models.PickList, error) {
+	if _, err := uuid.Parse(warehouseID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+	}
+	if len(lines) == 0 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "at least one line is required")
+	}
+	var itemIDs []string
+	wanted := make(map[string]int, len(lines))
+	for _, line := range lines {
+		if _, err := uuid.Parse(line.InventoryItemID); err != nil {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid inventory item ID")
+		}
+		if line.Quantity <= 0 {
+			return nil, models.ErrInvalidQuantity
+		}
+		if _, ok := wanted[line.InventoryItemID]; !ok {
+			itemIDs = append(itemIDs, line.InventoryItemID)
+		}
+		wanted[line.InventoryItemID] += line.Quantity
+	}
+
+	if _, err := s.warehouseRepo.GetWarehouseByID(ctx, warehouseID); err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, models.ErrWarehouseNotFound
+		}
+		return nil, fmt.Errorf("failed to get warehouse: %w", err)
+	}
+
+	stock, err := s.binRepo.ListBinStock(ctx, warehouseID, itemIDs, true)
+	if err != nil {
+		return nil, err
+	}
+	binsByItem := make(map[string][]models.BinStock)
+	for _, placed := range stock {
+		binsByItem[placed.InventoryItemID] = append(binsByItem[placed.InventoryItemID], placed)
+	}
+
+	list := &models.PickList{WarehouseID: warehouseID, Picks: []models.Pick{}, Shortages: []models.PickListLine{}}
+	for _, itemID := range itemIDs {
+		remaining := wanted[itemID]
+		bins := binsByItem[itemID]
+		sort.SliceStable(bins, func(i, j int) bool { return bins[i].Quantity > bins[j].Quantity })
+		for _, placed := range bins {
+			if remaining == 0 {
+				break
+			}
+			take := min(placed.Quantity, remaining)
+			list.Picks = append(list.Picks, models.Pick{
+				Bin:             placed.Bin,
+				InventoryItemID: itemID,
+				SKU:             placed.SKU,
+				Quantity:        take,
+			})
+			remaining -= take
+		}
+		if remaining > 0 {
+			list.Shortages = append(list.Shortages, models.PickListLine{InventoryItemID: itemID, Quantity: remaining})
+		}
+	}
+
+	sortPickPath(list.Picks)
+	for i := range list.Picks {
+		list.Picks[i].Sequence = i + 1
+	}
+	return list, nil
+}
+
+// sortPickPath orders picks along a serpentine path: zones and aisles in
+// order, shelves and bins ascending in every other aisle visited within a
+// zone and descending in the others, so that the picker never walks back
+// down an aisle. Address parts compare numbers by value, so aisle 10 follows
+// aisle 9.
+func sortPickPath(picks []models.Pick) {
+	sort.SliceStable(picks, func(i, j int) bool {
+		a, b := picks[i].Bin, picks[j].Bin
+		if c := compareNatural(a.Zone, b.Zone); c != 0 {
+			return c < 0
+		}
+		if c := compareNatural(a.Aisle, b.Aisle); c != 0 {
+			return c < 0
+		}
+		if c := compareNatural(a.Shelf, b.Shelf); c != 0 {
+			return c < 0
+		}
+		return compareNatural(a.Bin, b.Bin) < 0
+	})
+
+	// Reverse the stops of every second aisle visited in each zone
+	for start, visited := 0, 0; start < len(picks); {
+		end := start + 1
+		for end < len(picks) && picks[end].Bin.Zone == picks[start].Bin.Zone && picks[end].Bin.Aisle == picks[start].Bin.Aisle {
+			end++
+		}
+		if start > 0 && picks[start-1].Bin.Zone != picks[start].Bin.Zone {
+			visited = 0
+		}
+		if visited%2 == 1 {
+			for i, j := start, end-1; i < j; i, j = i+1, j-1 {
+				picks[i], picks[j] = picks[j], picks[i]
+			}
+		}
+		visited++
+		start = end
+	}
+}
+
+// compareNatural compares two strings with runs of digits compared by value
+func compareNatural(a, b string) int {
+	for a != "" && b != "" {
+		aDigits, bDigits := leadingDigits(a), leadingDigits(b)
+		if aDigits > 0 && bDigits > 0 {
+			an := strings.TrimLeft(a[:aDigits], "0")
+			bn := strings.TrimLeft(b[:bDigits], "0")
+			if len(an) != len(bn) {
+				return len(an) - len(bn)
+			}
+			if c := strings.Compare(an, bn); c != 0 {
+				return c
+			}
+			a, b = a[aDigits:], b[bDigits:]
+			continue
+		}
+		if a[0] != b[0] {
+			return int(a[0]) - int(b[0])
+		}
+		a, b = a[1:], b[1:]
+	}
+	return len(a) - len(b)
+}
+
+func leadingDigits(s string) int {
+	n := 0
+	for n < len(s) && s[n] >= '0' && s[n] <= '9' {
+		n++
+	}
+	return n
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+const (
+	// forecastBatch bounds the locations forecast per batch
+	forecastBatch = 500
+	// defaultReorderReviewDays is how many days of sales beyond the lead
+	// time reorder suggestions cover when not configured
+	defaultReorderReviewDays = 14
+)
+
+// ForecastService forecasts the stock of every item at every warehouse from
+// its sales velocity: when it runs out and how much to reorder. Forecasts
+// are computed nightly by the forecasting job and read by the admin
+// dashboard; the reorder suggestions are the forecasts with a quantity to
+// reorder.
+type ForecastService struct {
+	forecastRepo     repository.ForecastRepository
+	inventoryService *InventoryService
+	reviewDays       int
+	logger           *zap.Logger
+}
+
+// NewForecastService creates a new forecast service. reviewDays is how many
+// days of sales beyond the supplier lead time reorder suggestions cover, 14
+// when not positive.
+func NewForecastService(forecastRepo repository.ForecastRepository, inventoryService *InventoryService, reviewDays int, logger *zap.Logger) *ForecastService {
+	if reviewDays <= 0 {
+		reviewDays = defaultReorderReviewDays
+	}
+	return &ForecastService{
+		forecastRepo:     forecastRepo,
+		inventoryService: inventoryService,
+		reviewDays:       reviewDays,
+		logger:           logger,
+	}
+}
+
+// ComputeForecasts recomputes the forecasts of every item of every store at
+// its active warehouses, returning how many were computed
+func (s *ForecastService) ComputeForecasts(ctx context.Context, now time.Time) (int, error) {
+	computed := 0
+	var afterItemID, afterWarehouseID string
+	for {
+		inputs, err := s.forecastRepo.ListForecastInputs(ctx, now, afterItemID, afterWarehouseID, forecastBatch)
+		if err != nil {
+			return computed, err
+		}
+		if len(inputs) == 0 {
+			return computed, nil
+		}
+
+		forecasts := make([]models.InventoryForecast, len(inputs))
+		for i, in := range inputs {
+			forecasts[i] = in.Forecast(now, s.reviewDays)
+		}
+		if err := s.forecastRepo.SaveForecasts(ctx, forecasts); err != nil {
+			return computed, err
+		}
+		computed += len(forecasts)
+
+		last := inputs[len(inputs)-1]
+		afterItemID, afterWarehouseID = last.InventoryItemID, last.WarehouseID
+		if len(inputs) < forecastBatch {
+			return computed, nil
+		}
+	}
+}
+
+// StartForecastScheduler recomputes the forecasts every day at the given UTC
+// hour until the context is cancelled
+func (s *ForecastService) StartForecastScheduler(ctx context.Context, hourUTC int) {
+	go func() {
+		for {
+			now := time.Now().UTC()
+			next := time.Date(now.Year(), now.Month(), now.Day(), hourUTC, 0, 0, 0, time.UTC)
+			if !next.After(now) {
+				next = next.AddDate(0, 0, 1)
+			}
+
+			timer := time.NewTimer(next.Sub(now))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				s.logger.Info("Forecast scheduler stopped")
+				return
+			case <-timer.C:
+				computed, err := s.ComputeForecasts(ctx, time.Now().UTC())
+				if err != nil {
+					s.logger.Error("Scheduled forecasting failed", zap.Error(err), zap.Int("computed", computed))
+					continue
+				}
+				s.logger.Info("Forecasts computed", zap.Int("computed", computed))
+			}
+		}
+	}()
+}
+
+// GetForecast returns an item with its forecast at each of its warehouses,
+// or at a single warehouse
+func (s *ForecastService) GetForecast(ctx context.Context, id, productID, sku, warehouseID string) (*models.InventoryItem, []models.InventoryForecast, error) {
+	if warehouseID != "" {
+		if _, err := uuid.Parse(warehouseID); err != nil {
+			return nil, nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+		}
+	}
+
+	item, err := s.inventoryService.GetInventoryItem(ctx, id, productID, sku)
+	if err != nil {
+		return nil, nil, err
+	}
+	forecasts, err := s.forecastRepo.GetForecasts(ctx, item.ID, warehouseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(forecasts) == 0 {
+		return nil, nil, models.ErrForecastNotFound
+	}
+	return item, forecasts, nil
+}
+
+// ListReorderSuggestions lists the items to reorder at each warehouse, or at
+// a single warehouse, those running out first first
+func (s *ForecastService) ListReorderSuggestions(ctx context.Context, warehouseID string, page, limit int) ([]models.InventoryForecast, int, error) {
+	if warehouseID != "" {
+		if _, err := uuid.Parse(warehouseID); err != nil {
+			return nil, 0, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+		}
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	return s.forecastRepo.ListReorderSuggestions(ctx, warehouseID, (page-1)*limit, limit)
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/fraud"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+// FraudService screens orders at checkout for fraud. Each order is screened
+// once by the scorers of the screener: approved orders go ahead, declined
+// ones are refused and the others wait in the review queue until staff
+// approve or decline them. Every decision is recorded as a fraud event for
+// the order system and external fraud providers.
+type FraudService struct {
+	fraudRepo repository.FraudRepository
+	screener  *fraud.Screener
+	logger    *zap.Logger
+}
+
+// NewFraudService creates a new fraud service
+func NewFraudService(
+	fraudRepo repository.FraudRepository,
+	screener *fraud.Screener,
+	logger *zap.Logger,
+) *FraudService {
+	return &FraudService{
+		fraudRepo: fraudRepo,
+		screener:  screener,
+		logger:    logger,
+	}
+}
+
+// ScreenOrder screens an order and records the decision. An order screened
+// before returns its first check, so checkout can retry safely. The
+// currency defaults to USD.
+func (s *FraudService) ScreenOrder(ctx context.Context, check *models.FraudCheck) (*models.FraudCheck, error) {
+	check.OrderReference = strings.TrimSpace(check.OrderReference)
+	if check.OrderReference == "" || len(check.OrderReference) > 255 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "order_reference is required and must be at most 255 characters")
+	}
+	check.Email = strings.ToLower(strings.TrimSpace(check.Email))
+	if len(check.Email) > 255 || (check.Email != "" && !strings.Contains(check.Email, "@")) {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid email")
+	}
+	if check.IPAddress != "" && net.ParseIP(check.IPAddress) == nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid ip_address")
+	}
+	for _, country := range []*string{&check.BillingCountry, &check.ShippingCountry} {
+		*country = strings.ToUpper(strings.TrimSpace(*country))
+		if *country != "" && len(*country) != 2 {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, "countries must be 2-letter ISO codes")
+		}
+	}
+	if !(check.Amount >= 0 && check.Amount < 1e10) {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "amount must not be negative")
+	}
+	check.Amount = math.Round(check.Amount*100) / 100
+	check.Currency = strings.ToUpper(strings.TrimSpace(check.Currency))
+	if check.Currency == "" {
+		check.Currency = "USD"
+	}
+	if len(check.Currency) != 3 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "currency must be a 3-letter ISO code")
+	}
+
+	existing, err := s.fraudRepo.GetFraudCheckByOrder(ctx, check.OrderReference)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, models.ErrFraudCheckNotFound) {
+		return nil, err
+	}
+
+	s.screener.Screen(ctx, check)
+	if err := s.fraudRepo.CreateFraudCheck(ctx, check); err != nil {
+		if errors.Is(err, models.ErrAlreadyExists) {
+			// Screened concurrently; the first check stands
+			return s.fraudRepo.GetFraudCheckByOrder(ctx, check.OrderReference)
+		}
+		return nil, err
+	}
+	s.logger.Info("Order screened for fraud",
+		zap.String("fraud_check_id", check.ID),
+		zap.String("order_reference", check.OrderReference),
+		zap.Int("score", check.Score),
+		zap.String("decision", check.Decision))
+	return check, nil
+}
+
+// GetFraudCheck retrieves a fraud check
+func (s *FraudService) GetFraudCheck(ctx context.Context, id string) (*models.FraudCheck, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid fraud check ID")
+	}
+	return s.fraudRepo.GetFraudCheck(ctx, id)
+}
+
+// ListFraudChecks lists the fraud checks. Orders in review are listed oldest
+// first, as a queue; other listings newest first.
+func (s *FraudService) ListFraudChecks(ctx context.Context, filter models.FraudCheckFilter, page, limit int) ([]models.FraudCheck, int, error) {
+	switch filter.Decision {
+	case "", models.FraudApprove, models.FraudReview, models.FraudDecline:
+	default:
+		return nil, 0, apperrors.Errorf(apperrors.ErrInvalidArgument, "unknown fraud decision %q", filter.Decision)
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+	return s.fraudRepo.ListFraudChecks(ctx, filter, offset, limit)
+}
+
+// ReviewFraudCheck approves or declines an order waiting in the review
+// queue
+func (s *FraudService) ReviewFraudCheck(ctx context.Context, id string, approve bool, reviewedBy, notes string) (*models.FraudCheck, error) {
+	if len(notes) > 1000 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "notes must be at most 1000 characters")
+	}
+	check, err := s.GetFraudCheck(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if check.Decision != models.FraudReview {
+		return nil, models.ErrFraudCheckNotInReview
+	}
+
+	now := time.Now().UTC()
+	check.Decision = models.FraudDecline
+	if approve {
+		check.Decision = models.FraudApprove
+	}
+	check.ReviewedBy = reviewedBy
+	check.ReviewedAt = &now
+	check.ReviewNotes = strings.TrimSpace(notes)
+	if err := s.fraudRepo.ReviewFraudCheck(ctx, check); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Fraud review recorded",
+		zap.String("fraud_check_id", check.ID),
+		zap.String("decision", check.Decision),
+		zap.String("reviewed_by", reviewedBy))
+	return check, nil
+}
+
+// ListFraudEvents lists the fraud events after afterID, for the order system
+// and external fraud providers to read them in order
+func (s *FraudService) ListFraudEvents(ctx context.Context, afterID int64, limit int) ([]models.FraudEvent, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return s.fraudRepo.ListFraudEvents(ctx, afterID, limit)
+}
@@ -0,0 +1,401 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+// maxFulfillmentEvents bounds the events of one push
+const maxFulfillmentEvents = 500
+
+// FulfillmentService translates the events fulfillment providers (3PLs) push
+// into inventory movements and order status events
+type FulfillmentService struct {
+	fulfillmentRepo  repository.FulfillmentRepository
+	inventoryRepo    repository.InventoryRepository
+	warehouseRepo    repository.WarehouseRepository
+	inventoryService *InventoryService
+	shipmentService  *ShipmentService
+	logger           *zap.Logger
+}
+
+// NewFulfillmentService creates a new fulfillment service
+func NewFulfillmentService(
+	fulfillmentRepo repository.FulfillmentRepository,
+	inventoryRepo repository.InventoryRepository,
+	warehouseRepo repository.WarehouseRepository,
+	inventoryService *InventoryService,
+	shipmentService *ShipmentService,
+	logger *zap.Logger,
+) *FulfillmentService {
+	return &FulfillmentService{
+		fulfillmentRepo:  fulfillmentRepo,
+		inventoryRepo:    inventoryRepo,
+		warehouseRepo:    warehouseRepo,
+		inventoryService: inventoryService,
+		shipmentService:  shipmentService,
+		logger:           logger,
+	}
+}
+
+// CreateIntegrationKey creates an API key for a provider of the current
+// store, with a daily quota of requests (0 for none). The returned secret is
+// not stored and cannot be retrieved later.
+func (s *FulfillmentService) CreateIntegrationKey(ctx context.Context, provider, name string, dailyQuota int) (*models.IntegrationKey, string, error) {
+	provider = strings.TrimSpace(provider)
+	if provider == "" {
+		return nil, "", apperrors.New(apperrors.ErrInvalidArgument, "provider is required")
+	}
+	if dailyQuota < 0 {
+		return nil, "", models.ErrInvalidQuota
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", fmt.Errorf("failed to generate integration key: %w", err)
+	}
+	secret := models.IntegrationKeyPrefix + hex.EncodeToString(b)
+
+	key := &models.IntegrationKey{
+		Provider:   provider,
+		Name:       strings.TrimSpace(name),
+		KeyPrefix:  secret[:len(models.IntegrationKeyPrefix)+8],
+		DailyQuota: dailyQuota,
+	}
+	if err := s.fulfillmentRepo.CreateIntegrationKey(ctx, key, hashIntegrationKey(secret)); err != nil {
+		return nil, "", err
+	}
+
+	s.logger.Info("Integration key created", zap.String("id", key.ID), zap.String("provider", provider))
+	return key, secret, nil
+}
+
+// ListIntegrationKeys lists the API keys of the current store
+func (s *FulfillmentService) ListIntegrationKeys(ctx context.Context) ([]models.IntegrationKey, error) {
+	return s.fulfillmentRepo.ListIntegrationKeys(ctx)
+}
+
+// RevokeIntegrationKey revokes an API key; pushes with it are rejected
+func (s *FulfillmentService) RevokeIntegrationKey(ctx context.Context, id string) (*models.IntegrationKey, error) {
+	key, err := s.fulfillmentRepo.RevokeIntegrationKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Integration key revoked", zap.String("id", id), zap.String("provider", key.Provider))
+	return key, nil
+}
+
+// SetIntegrationKeyQuota changes the daily quota of an API key; 0 removes
+// it. The usage of the day is kept.
+func (s *FulfillmentService) SetIntegrationKeyQuota(ctx context.Context, id string, dailyQuota int) (*models.IntegrationKey, error) {
+	if dailyQuota < 0 {
+		return nil, models.ErrInvalidQuota
+	}
+	key, err := s.fulfillmentRepo.SetIntegrationKeyQuota(ctx, id, dailyQuota)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Integration key quota set", zap.String("id", id), zap.Int("daily_quota", dailyQuota))
+	return key, nil
+}
+
+// GetQuota returns the API key of an integrator with its usage of the day.
+// Looking the quota up does not count as a request.
+func (s *FulfillmentService) GetQuota(ctx context.Context, apiKey string) (*models.IntegrationKey, error) {
+	if !strings.HasPrefix(apiKey, models.IntegrationKeyPrefix) {
+		return nil, models.ErrInvalidIntegrationKey
+	}
+	return s.fulfillmentRepo.GetIntegrationKeyByHash(ctx, hashIntegrationKey(apiKey))
+}
+
+// PushEvents authenticates a provider by its API key and applies its
+// events in order. Each event succeeds or fails on its own; an event ID that
+// was already applied is reported as a duplicate and not applied again. The
+// returned key carries the usage of the day, this push included.
+func (s *FulfillmentService) PushEvents(ctx context.Context, apiKey string, events []models.FulfillmentEvent) (*models.IntegrationKey, []models.FulfillmentEventResult, error) {
+	key, err := authenticateIntegrationKey(ctx, s.fulfillmentRepo, s.logger, apiKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(events) > maxFulfillmentEvents {
+		return nil, nil, apperrors.Errorf(apperrors.ErrInvalidArgument, "at most %d events can be pushed at once", maxFulfillmentEvents)
+	}
+
+	results := make([]models.FulfillmentEventResult, 0, len(events))
+	for i := range events {
+		results = append(results, s.applyEvent(ctx, key.Provider, &events[i]))
+	}
+	return key, results, nil
+}
+
+// applyEvent validates, claims and applies one event
+func (s *FulfillmentService) applyEvent(ctx context.Context, provider string, event *models.FulfillmentEvent) models.FulfillmentEventResult {
+	result := models.FulfillmentEventResult{ID: event.ID}
+	if err := validateFulfillmentEvent(event); err != nil {
+		result.Status, result.Error = models.FulfillmentEventFailed, err.Error()
+		return result
+	}
+
+	claimed, err := s.fulfillmentRepo.ClaimFulfillmentEvent(ctx, provider, event)
+	if err != nil {
+		result.Status, result.Error = models.FulfillmentEventFailed, "failed to record event"
+		return result
+	}
+	if !claimed {
+		result.Status = models.FulfillmentEventDuplicate
+		return result
+	}
+
+	switch event.Type {
+	case models.FulfillmentStockUpdated:
+		err = s.applyStockUpdate(ctx, provider, event)
+	case models.FulfillmentShipmentConfirmed:
+		err = s.applyShipment(ctx, provider, event)
+	}
+
+	result.Status = models.FulfillmentEventApplied
+	if err != nil {
+		result.Status, result.Error = models.FulfillmentEventFailed, fulfillmentErrorMessage(err)
+		s.logger.Warn("Failed to apply fulfillment event",
+			zap.Error(err),
+			zap.String("provider", provider),
+			zap.String("event_id", event.ID),
+			zap.String("type", event.Type))
+	}
+	if err := s.fulfillmentRepo.CompleteFulfillmentEvent(ctx, provider, event.ID, result.Status, result.Error); err != nil {
+		s.logger.Error("Failed to record fulfillment event outcome", zap.Error(err), zap.String("event_id", event.ID))
+	}
+	return result
+}
+
+// applyStockUpdate moves the stock of a SKU at a warehouse to the reported
+// quantity, or by the reported change
+func (s *FulfillmentService) applyStockUpdate(ctx context.Context, provider string, event *models.FulfillmentEvent) error {
+	warehouse, err := s.resolveWarehouse(ctx, event.WarehouseCode)
+	if err != nil {
+		return err
+	}
+	itemID, err := s.resolveSKU(ctx, event.SKU)
+	if err != nil {
+		return err
+	}
+
+	delta := event.Quantity
+	if event.Mode == models.StockUpdateSet {
+		location, err := s.findLocation(ctx, itemID, warehouse.ID)
+		if err != nil {
+			return err
+		}
+		current := 0
+		if location != nil {
+			current = location.Quantity
+		}
+		delta = event.Quantity - current
+	}
+
+	notes := fmt.Sprintf("Stock update from %s", provider)
+	switch {
+	case delta > 0:
+		_, err = s.inventoryService.AddInventoryToLocation(ctx, itemID, warehouse.ID, delta, models.BaseUnit, event.ID, models.ReferenceFulfillmentStockUpdate, notes, "")
+	case delta < 0:
+		_, err = s.inventoryService.RemoveInventoryFromLocation(ctx, itemID, warehouse.ID, -delta, models.BaseUnit, event.ID, models.ReferenceFulfillmentStockUpdate, notes, "")
+	}
+	return err
+}
+
+// applyShipment removes the shipped lines from the warehouse, starts tracking
+// the parcel and records the order as shipped. All lines are checked before any stock moves, so
+// that a rejected shipment can be pushed again once corrected.
+func (s *FulfillmentService) applyShipment(ctx context.Context, provider string, event *models.FulfillmentEvent) error {
+	warehouse, err := s.resolveWarehouse(ctx, event.WarehouseCode)
+	if err != nil {
+		return err
+	}
+
+	itemIDs := make([]string, len(event.Lines))
+	for i, line := range event.Lines {
+		itemID, err := s.resolveSKU(ctx, line.SKU)
+		if err != nil {
+			return err
+		}
+		location, err := s.findLocation(ctx, itemID, warehouse.ID)
+		if err != nil {
+			return err
+		}
+		if location == nil || location.AvailableQuantity < line.Quantity {
+			return apperrors.Errorf(apperrors.ErrFailedPrecondition, "insufficient inventory of SKU %s at warehouse %s", line.SKU, warehouse.Code)
+		}
+		itemIDs[i] = itemID
+	}
+
+	notes := fmt.Sprintf("Shipment %s from %s", event.ID, provider)
+	for i, line := range event.Lines {
+		if _, err := s.inventoryService.RemoveInventoryFromLocation(ctx, itemIDs[i], warehouse.ID, line.Quantity, models.BaseUnit, event.OrderReference, models.ReferenceFulfillmentShipment, notes, ""); err != nil {
+			return fmt.Errorf("failed to remove SKU %s after %d of %d lines: %w", line.SKU, i, len(event.Lines), err)
+		}
+	}
+
+	// Start tracking the parcel; the stock has moved, so a failure here
+	// does not fail the event
+	if event.Carrier != "" && event.TrackingNumber != "" {
+		_, err := s.shipmentService.CreateShipment(ctx, &models.Shipment{
+			OrderReference: event.OrderReference,
+			Carrier:        event.Carrier,
+			TrackingNumber: event.TrackingNumber,
+			ShippedAt:      event.OccurredAt,
+		}, models.ShipmentSourceFulfillment)
+		if err != nil {
+			s.logger.Error("Failed to register shipment", zap.Error(err), zap.String("event_id", event.ID))
+		}
+	}
+
+	return s.fulfillmentRepo.CreateOrderStatusEvent(ctx, &models.OrderStatusEvent{
+		OrderReference: event.OrderReference,
+		Status:         models.OrderStatusShipped,
+		Provider:       provider,
+		Carrier:        event.Carrier,
+		TrackingNumber: event.TrackingNumber,
+		OccurredAt:     event.OccurredAt,
+	})
+}
+
+// ListOrderStatusEvents lists the order status events after afterID, for the
+// order system to apply them in order
+func (s *FulfillmentService) ListOrderStatusEvents(ctx context.Context, afterID int64, limit int) ([]models.OrderStatusEvent, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return s.fulfillmentRepo.ListOrderStatusEvents(ctx, afterID, limit)
+}
+
+func (s *FulfillmentService) resolveWarehouse(ctx context.Context, code string) (*models.Warehouse, error) {
+	warehouse, err := s.warehouseRepo.GetWarehouseByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, apperrors.Errorf(apperrors.ErrNotFound, "unknown warehouse %s", code)
+		}
+		return nil, err
+	}
+	return warehouse, nil
+}
+
+func (s *FulfillmentService) resolveSKU(ctx context.Context, sku string) (string, error) {
+	itemID, err := s.fulfillmentRepo.GetInventoryItemIDBySKU(ctx, sku)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return "", apperrors.Errorf(apperrors.ErrNotFound, "unknown SKU %s", sku)
+		}
+		return "", err
+	}
+	return itemID, nil
+}
+
+// findLocation returns the location of an item at a warehouse, nil when the
+// warehouse never held the item
+func (s *FulfillmentService) findLocation(ctx context.Context, itemID, warehouseID string) (*models.InventoryLocation, error) {
+	locations, err := s.inventoryRepo.GetInventoryLocations(ctx, itemID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range locations {
+		if locations[i].WarehouseID == warehouseID {
+			return &locations[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// validateFulfillmentEvent checks the fields an event of its type needs and
+// fills in defaults
+func validateFulfillmentEvent(event *models.FulfillmentEvent) error {
+	if event.ID == "" {
+		return errors.New("id is required")
+	}
+	if event.WarehouseCode == "" {
+		return errors.New("warehouse_code is required")
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+
+	switch event.Type {
+	case models.FulfillmentStockUpdated:
+		if event.SKU == "" {
+			return errors.New("sku is required")
+		}
+		if event.Mode == "" {
+			event.Mode = models.StockUpdateSet
+		}
+		switch event.Mode {
+		case models.StockUpdateSet:
+			if event.Quantity < 0 {
+				return errors.New("quantity cannot be negative")
+			}
+		case models.StockUpdateAdjust:
+		default:
+			return fmt.Errorf("unknown mode %q, want set or adjust", event.Mode)
+		}
+	case models.FulfillmentShipmentConfirmed:
+		if event.OrderReference == "" {
+			return errors.New("order_reference is required")
+		}
+		if len(event.Lines) == 0 {
+			return errors.New("lines are required")
+		}
+		for _, line := range event.Lines {
+			if line.SKU == "" || line.Quantity <= 0 {
+				return errors.New("lines need a sku and a positive quantity")
+			}
+		}
+	default:
+		return fmt.Errorf("unknown type %q, want %s or %s", event.Type, models.FulfillmentStockUpdated, models.FulfillmentShipmentConfirmed)
+	}
+	return nil
+}
+
+// fulfillmentErrorMessage returns the message of errors providers can act
+// on, and a generic message for internal errors
+func fulfillmentErrorMessage(err error) string {
+	if kind := apperrors.KindOf(err); kind != nil && kind != apperrors.ErrInternal {
+		return err.Error()
+	}
+	return "failed to apply event"
+}
+
+// authenticateIntegrationKey returns the active key of the current store
+// matching apiKey and counts the request in its usage of the day. Quotas are
+// soft: requests over the quota are served, and logged when the quota is
+// first exceeded.
+func authenticateIntegrationKey(ctx context.Context, repo repository.FulfillmentRepository, logger *zap.Logger, apiKey string) (*models.IntegrationKey, error) {
+	if !strings.HasPrefix(apiKey, models.IntegrationKeyPrefix) {
+		return nil, models.ErrInvalidIntegrationKey
+	}
+	key, err := repo.AuthenticateIntegrationKey(ctx, hashIntegrationKey(apiKey))
+	if err != nil {
+		return nil, err
+	}
+	if key.DailyQuota > 0 && key.UsedToday == key.DailyQuota+1 {
+		logger.Warn("Integration key exceeded its daily quota",
+			zap.String("id", key.ID),
+			zap.String("provider", key.Provider),
+			zap.Int("daily_quota", key.DailyQuota))
+	}
+	return key, nil
+}
+
+func hashIntegrationKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
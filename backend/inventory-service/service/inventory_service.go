@@ -2,12 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
 	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
 )
@@ -16,6 +18,9 @@ import (
 type InventoryService struct {
 	inventoryRepo repository.InventoryRepository
 	warehouseRepo repository.WarehouseRepository
+	policyRepo    repository.AvailabilityPolicyRepository
+	unitRepo      repository.UnitRepository
+	stockBroker   *StockBroker
 	logger        *zap.Logger
 }
 
@@ -23,11 +28,16 @@ type InventoryService struct {
 func NewInventoryService(
 	inventoryRepo repository.InventoryRepository,
 	warehouseRepo repository.WarehouseRepository,
+	policyRepo repository.AvailabilityPolicyRepository,
+	unitRepo repository.UnitRepository,
 	logger *zap.Logger,
 ) *InventoryService {
 	return &InventoryService{
 		inventoryRepo: inventoryRepo,
 		warehouseRepo: warehouseRepo,
+		policyRepo:    policyRepo,
+		unitRepo:      unitRepo,
+		stockBroker:   NewStockBroker(logger),
 		logger:        logger,
 	}
 }
@@ -39,7 +49,7 @@ func (s *InventoryService) CreateInventoryItem(ctx context.Context, productID, s
 	if err == nil {
 		// Item already exists
 		return nil, models.ErrAlreadyExists
-	} else if err != models.ErrNotFound {
+	} else if !errors.Is(err, models.ErrNotFound) {
 		// Unexpected error
 		s.logger.Error("Error checking for existing inventory item", zap.Error(err))
 		return nil, fmt.Errorf("error checking for existing inventory item: %w", err)
@@ -158,9 +168,12 @@ func (s *InventoryService) CreateInventoryItem(ctx context.Context, productID, s
 	createdItem, err := s.inventoryRepo.GetInventoryItemByID(ctx, item.ID)
 	if err != nil {
 		s.logger.Warn("Failed to retrieve created inventory item", zap.Error(err))
+		s.applyAvailability(ctx, item)
 		return item, nil // Return the original item without locations
 	}
 
+	s.publishStockChange(ctx, item.ID, nil, models.StockChangeCreated)
+	s.applyAvailability(ctx, createdItem)
 	return createdItem, nil
 }
 
@@ -189,7 +202,7 @@ func (s *InventoryService) GetInventoryItem(ctx context.Context, id, productID,
 	}
 
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			s.logger.Warn("Inventory item not found",
 				zap.String("id", id),
 				zap.String("product_id", productID),
@@ -211,41 +224,56 @@ func (s *InventoryService) GetInventoryItem(ctx context.Context, id, productID,
 		zap.Int("total_quantity", item.TotalQuantity),
 		zap.Int("available_quantity", item.AvailableQuantity))
 
+	s.applyAvailability(ctx, item)
 	return item, nil
 }
 
-// UpdateInventoryItem updates an inventory item's properties
+// UpdateInventoryItem updates an inventory item's properties, again from a
+// fresh read when the item changed concurrently
 func (s *InventoryService) UpdateInventoryItem(ctx context.Context, id string, reorderPoint, reorderQty *int, status *string) (*models.InventoryItem, error) {
-	// Get the current item
-	item, err := s.inventoryRepo.GetInventoryItemByID(ctx, id)
-	if err != nil {
-		if err == models.ErrNotFound {
-			return nil, models.ErrNotFound
+	var item *models.InventoryItem
+	err := s.retryOnConflict(ctx, func() error {
+		// Get the current item
+		var err error
+		item, err = s.inventoryRepo.GetInventoryItemByID(ctx, id)
+		if err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				return models.ErrNotFound
+			}
+			s.logger.Error("Failed to get inventory item for update", zap.Error(err), zap.String("id", id))
+			return fmt.Errorf("failed to get inventory item for update: %w", err)
 		}
-		s.logger.Error("Failed to get inventory item for update", zap.Error(err), zap.String("id", id))
-		return nil, fmt.Errorf("failed to get inventory item for update: %w", err)
-	}
 
-	// Update fields if provided
-	if reorderPoint != nil {
-		item.ReorderPoint = *reorderPoint
-	}
-	if reorderQty != nil {
-		item.ReorderQuantity = *reorderQty
-	}
-	if status != nil {
-		item.Status = *status
-	} else {
-		// Recalculate status based on current quantities and reorder point
-		item.Status = models.DetermineInventoryStatus(item.AvailableQuantity, item.ReorderPoint)
-	}
+		// Update fields if provided
+		if reorderPoint != nil {
+			item.ReorderPoint = *reorderPoint
+		}
+		if reorderQty != nil {
+			item.ReorderQuantity = *reorderQty
+		}
+		if status != nil {
+			item.Status = *status
+		} else {
+			// Recalculate status based on current quantities and reorder point
+			item.Status = models.DetermineInventoryStatus(item.AvailableQuantity, item.ReorderPoint)
+		}
 
-	// Update the item
-	if err := s.inventoryRepo.UpdateInventoryItem(ctx, item); err != nil {
-		s.logger.Error("Failed to update inventory item", zap.Error(err), zap.String("id", id))
-		return nil, fmt.Errorf("failed to update inventory item: %w", err)
+		// Update the item
+		if err := s.inventoryRepo.UpdateInventoryItem(ctx, item); err != nil {
+			if errors.Is(err, models.ErrConcurrentUpdate) || errors.Is(err, models.ErrNotFound) {
+				return err
+			}
+			s.logger.Error("Failed to update inventory item", zap.Error(err), zap.String("id", id))
+			return fmt.Errorf("failed to update inventory item: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	s.publishStockChange(ctx, item.ID, nil, models.StockChangeUpdated)
+	s.applyAvailability(ctx, item)
 	return item, nil
 }
 
@@ -289,30 +317,53 @@ func (s *InventoryService) ListInventoryItems(ctx context.Context, page, limit i
 		}
 	}
 
+	s.applyAvailability(ctx, items...)
 	return items, total, nil
 }
 
-// AddInventoryToLocation adds inventory to a specific warehouse location
-func (s *InventoryService) AddInventoryToLocation(ctx context.Context, inventoryItemID, warehouseID string, quantity int, referenceID, referenceType, notes string) (*models.InventoryLocation, error) {
+// AddInventoryToLocation adds inventory to a specific warehouse location.
+// The quantity is in the given unit of measure of the item, converted to
+// base units; an empty unit is the base unit. With an idempotency key, a
+// retry of the addition returns the location of the first attempt instead
+// of adding the stock again.
+func (s *InventoryService) AddInventoryToLocation(ctx context.Context, inventoryItemID, warehouseID string, unitQuantity int, unitCode, referenceID, referenceType, notes, idempotencyKey string) (*models.InventoryLocation, error) {
+	if idempotencyKey == "" {
+		return s.addInventoryToLocation(ctx, inventoryItemID, warehouseID, unitQuantity, unitCode, referenceID, referenceType, notes, nil)
+	}
+	request := []any{inventoryItemID, warehouseID, unitQuantity, unitCode, referenceID, referenceType, notes}
+	return s.applyStockMutationOnce(ctx, idempotencyKey, models.TransactionStockAddition, request, func(mutation *models.StockMutation) (*models.InventoryLocation, error) {
+		return s.addInventoryToLocation(ctx, inventoryItemID, warehouseID, unitQuantity, unitCode, referenceID, referenceType, notes, mutation)
+	})
+}
+
+// addInventoryToLocation adds the stock, recording the mutation with its
+// idempotency key in the same transaction when there is one
+func (s *InventoryService) addInventoryToLocation(ctx context.Context, inventoryItemID, warehouseID string, unitQuantity int, unitCode, referenceID, referenceType, notes string, mutation *models.StockMutation) (*models.InventoryLocation, error) {
 	// Validate inputs
-	if quantity <= 0 {
+	if unitQuantity <= 0 {
 		return nil, models.ErrInvalidQuantity
 	}
 
 	// Check if inventory item exists
 	_, err := s.inventoryRepo.GetInventoryItemByID(ctx, inventoryItemID)
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, models.ErrNotFound
 		}
 		s.logger.Error("Failed to get inventory item", zap.Error(err), zap.String("id", inventoryItemID))
 		return nil, fmt.Errorf("failed to get inventory item: %w", err)
 	}
 
+	unit, err := s.ResolveUnit(ctx, inventoryItemID, unitCode)
+	if err != nil {
+		return nil, err
+	}
+	quantity := unit.ToBase(unitQuantity)
+
 	// Check if warehouse exists and is active
 	warehouse, err := s.warehouseRepo.GetWarehouseByID(ctx, warehouseID)
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, models.ErrWarehouseNotFound
 		}
 		s.logger.Error("Failed to get warehouse", zap.Error(err), zap.String("id", warehouseID))
@@ -323,183 +374,191 @@ func (s *InventoryService) AddInventoryToLocation(ctx context.Context, inventory
 		return nil, models.ErrWarehouseInactive
 	}
 
-	// Get existing location or create new one
-	locations, err := s.inventoryRepo.GetInventoryLocations(ctx, inventoryItemID)
-	if err != nil {
-		s.logger.Error("Failed to get inventory locations", zap.Error(err), zap.String("inventory_item_id", inventoryItemID))
-		return nil, fmt.Errorf("failed to get inventory locations: %w", err)
-	}
+	transaction := newStockMovement(inventoryItemID, warehouseID, models.TransactionStockAddition, quantity, referenceID, referenceType, notes, mutation)
+	setMovementUnit(transaction, unit, unitQuantity)
 
+	// Get existing location or create new one, again from a fresh read when
+	// the location changed concurrently
 	var location *models.InventoryLocation
-	for i := range locations {
-		if locations[i].WarehouseID == warehouseID {
-			location = &locations[i]
-			break
+	err = s.retryOnConflict(ctx, func() error {
+		locations, err := s.inventoryRepo.GetInventoryLocations(ctx, inventoryItemID)
+		if err != nil {
+			s.logger.Error("Failed to get inventory locations", zap.Error(err), zap.String("inventory_item_id", inventoryItemID))
+			return fmt.Errorf("failed to get inventory locations: %w", err)
 		}
-	}
 
-	now := time.Now().UTC()
-	if location == nil {
-		// Create new location
-		location = &models.InventoryLocation{
-			ID:                uuid.New().String(),
-			InventoryItemID:   inventoryItemID,
-			WarehouseID:       warehouseID,
-			Quantity:          quantity,
-			AvailableQuantity: quantity,
-			ReservedQuantity:  0,
-			CreatedAt:         now,
-			UpdatedAt:         now,
+		location = nil
+		for i := range locations {
+			if locations[i].WarehouseID == warehouseID {
+				location = &locations[i]
+				break
+			}
 		}
-	} else {
-		// Update existing location
-		location.Quantity += quantity
-		location.AvailableQuantity += quantity
-		location.UpdatedAt = now
-	}
 
-	// Update the location
-	if err := s.inventoryRepo.UpsertInventoryLocation(ctx, location); err != nil {
-		s.logger.Error("Failed to update inventory location", zap.Error(err))
-		return nil, fmt.Errorf("failed to update inventory location: %w", err)
-	}
+		now := time.Now().UTC()
+		if location == nil {
+			// Create new location
+			location = &models.InventoryLocation{
+				ID:                uuid.New().String(),
+				InventoryItemID:   inventoryItemID,
+				WarehouseID:       warehouseID,
+				Quantity:          quantity,
+				AvailableQuantity: quantity,
+				ReservedQuantity:  0,
+				CreatedAt:         now,
+				UpdatedAt:         now,
+			}
+		} else {
+			// Update existing location
+			location.Quantity += quantity
+			location.AvailableQuantity += quantity
+			location.UpdatedAt = now
+		}
 
-	// Create transaction record
-	var refID *string
-	var refType *string
-	var notePtr *string
+		// Set the warehouse in the location for the response
+		location.Warehouse = warehouse
 
-	if referenceID != "" {
-		refID = &referenceID
-	}
-	if referenceType != "" {
-		refType = &referenceType
-	}
-	if notes != "" {
-		notePtr = &notes
-	}
-
-	transaction := &models.InventoryTransaction{
-		ID:              uuid.New().String(),
-		InventoryItemID: inventoryItemID,
-		WarehouseID:     &warehouseID,
-		TransactionType: models.TransactionStockAddition,
-		Quantity:        quantity,
-		ReferenceID:     refID,
-		ReferenceType:   refType,
-		Notes:           notePtr,
-		CreatedAt:       now,
-	}
-
-	if err := s.inventoryRepo.CreateInventoryTransaction(ctx, transaction); err != nil {
-		s.logger.Warn("Failed to create transaction record", zap.Error(err))
-		// Continue even if transaction record fails
+		return s.saveStockMovement(ctx, location, transaction, mutation)
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	// Set the warehouse in the location for the response
-	location.Warehouse = warehouse
-
+	s.publishStockChange(ctx, inventoryItemID, &warehouseID, models.StockChangeAdded)
 	return location, nil
 }
 
-// RemoveInventoryFromLocation removes inventory from a specific warehouse location
-func (s *InventoryService) RemoveInventoryFromLocation(ctx context.Context, inventoryItemID, warehouseID string, quantity int, referenceID, referenceType, notes string) (*models.InventoryLocation, error) {
+// RemoveInventoryFromLocation removes inventory from a specific warehouse
+// location. The quantity is in the given unit of measure of the item,
+// converted to base units; an empty unit is the base unit. With an
+// idempotency key, a retry of the removal returns the location of the first
+// attempt instead of removing the stock again.
+func (s *InventoryService) RemoveInventoryFromLocation(ctx context.Context, inventoryItemID, warehouseID string, unitQuantity int, unitCode, referenceID, referenceType, notes, idempotencyKey string) (*models.InventoryLocation, error) {
+	if idempotencyKey == "" {
+		return s.removeInventoryFromLocation(ctx, inventoryItemID, warehouseID, unitQuantity, unitCode, referenceID, referenceType, notes, nil)
+	}
+	request := []any{inventoryItemID, warehouseID, unitQuantity, unitCode, referenceID, referenceType, notes}
+	return s.applyStockMutationOnce(ctx, idempotencyKey, models.TransactionStockRemoval, request, func(mutation *models.StockMutation) (*models.InventoryLocation, error) {
+		return s.removeInventoryFromLocation(ctx, inventoryItemID, warehouseID, unitQuantity, unitCode, referenceID, referenceType, notes, mutation)
+	})
+}
+
+// removeInventoryFromLocation removes the stock, recording the mutation with
+// its idempotency key in the same transaction when there is one
+func (s *InventoryService) removeInventoryFromLocation(ctx context.Context, inventoryItemID, warehouseID string, unitQuantity int, unitCode, referenceID, referenceType, notes string, mutation *models.StockMutation) (*models.InventoryLocation, error) {
 	// Validate inputs
-	if quantity <= 0 {
+	if unitQuantity <= 0 {
 		return nil, models.ErrInvalidQuantity
 	}
 
 	// Check if inventory item exists
 	_, err := s.inventoryRepo.GetInventoryItemByID(ctx, inventoryItemID)
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, models.ErrNotFound
 		}
 		s.logger.Error("Failed to get inventory item", zap.Error(err), zap.String("id", inventoryItemID))
 		return nil, fmt.Errorf("failed to get inventory item: %w", err)
 	}
 
+	unit, err := s.ResolveUnit(ctx, inventoryItemID, unitCode)
+	if err != nil {
+		return nil, err
+	}
+	quantity := unit.ToBase(unitQuantity)
+
 	// Check if warehouse exists
 	warehouse, err := s.warehouseRepo.GetWarehouseByID(ctx, warehouseID)
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, models.ErrWarehouseNotFound
 		}
 		s.logger.Error("Failed to get warehouse", zap.Error(err), zap.String("id", warehouseID))
 		return nil, fmt.Errorf("failed to get warehouse: %w", err)
 	}
 
-	// Get existing location
-	locations, err := s.inventoryRepo.GetInventoryLocations(ctx, inventoryItemID)
-	if err != nil {
-		s.logger.Error("Failed to get inventory locations", zap.Error(err), zap.String("inventory_item_id", inventoryItemID))
-		return nil, fmt.Errorf("failed to get inventory locations: %w", err)
-	}
+	transaction := newStockMovement(inventoryItemID, warehouseID, models.TransactionStockRemoval, quantity, referenceID, referenceType, notes, mutation)
+	setMovementUnit(transaction, unit, unitQuantity)
 
+	// Get existing location, again from a fresh read when it changed
+	// concurrently
 	var location *models.InventoryLocation
-	for i := range locations {
-		if locations[i].WarehouseID == warehouseID {
-			location = &locations[i]
-			break
+	err = s.retryOnConflict(ctx, func() error {
+		locations, err := s.inventoryRepo.GetInventoryLocations(ctx, inventoryItemID)
+		if err != nil {
+			s.logger.Error("Failed to get inventory locations", zap.Error(err), zap.String("inventory_item_id", inventoryItemID))
+			return fmt.Errorf("failed to get inventory locations: %w", err)
 		}
-	}
 
-	if location == nil {
-		return nil, models.ErrNotFound
-	}
+		location = nil
+		for i := range locations {
+			if locations[i].WarehouseID == warehouseID {
+				location = &locations[i]
+				break
+			}
+		}
 
-	// Check if there's enough available inventory
-	if location.AvailableQuantity < quantity {
-		return nil, models.ErrInsufficientInventory
-	}
+		if location == nil {
+			return models.ErrNotFound
+		}
 
-	// Update the location
-	now := time.Now().UTC()
-	location.Quantity -= quantity
-	location.AvailableQuantity -= quantity
-	location.UpdatedAt = now
+		// Check if there's enough available inventory
+		if location.AvailableQuantity < quantity {
+			return models.ErrInsufficientInventory
+		}
 
-	if err := s.inventoryRepo.UpsertInventoryLocation(ctx, location); err != nil {
-		s.logger.Error("Failed to update inventory location", zap.Error(err))
-		return nil, fmt.Errorf("failed to update inventory location: %w", err)
-	}
+		// Update the location
+		location.Quantity -= quantity
+		location.AvailableQuantity -= quantity
+		location.UpdatedAt = time.Now().UTC()
 
-	// Create transaction record
-	var refID *string
-	var refType *string
-	var notePtr *string
+		// Set the warehouse in the location for the response
+		location.Warehouse = warehouse
 
-	if referenceID != "" {
-		refID = &referenceID
-	}
-	if referenceType != "" {
-		refType = &referenceType
-	}
-	if notes != "" {
-		notePtr = &notes
+		return s.saveStockMovement(ctx, location, transaction, mutation)
+	})
+	if err != nil {
+		return nil, err
 	}
 
+	s.publishStockChange(ctx, inventoryItemID, &warehouseID, models.StockChangeRemoved)
+	return location, nil
+}
+
+// newStockMovement returns the record of a stock movement at a warehouse,
+// tagged with the idempotency key of mutation if any
+func newStockMovement(inventoryItemID, warehouseID, transactionType string, quantity int, referenceID, referenceType, notes string, mutation *models.StockMutation) *models.InventoryTransaction {
 	transaction := &models.InventoryTransaction{
 		ID:              uuid.New().String(),
 		InventoryItemID: inventoryItemID,
 		WarehouseID:     &warehouseID,
-		TransactionType: models.TransactionStockRemoval,
+		TransactionType: transactionType,
 		Quantity:        quantity,
-		ReferenceID:     refID,
-		ReferenceType:   refType,
-		Notes:           notePtr,
-		CreatedAt:       now,
 	}
-
-	if err := s.inventoryRepo.CreateInventoryTransaction(ctx, transaction); err != nil {
-		s.logger.Warn("Failed to create transaction record", zap.Error(err))
-		// Continue even if transaction record fails
+	if referenceID != "" {
+		transaction.ReferenceID = &referenceID
 	}
+	if referenceType != "" {
+		transaction.ReferenceType = &referenceType
+	}
+	if notes != "" {
+		transaction.Notes = &notes
+	}
+	if mutation != nil {
+		transaction.IdempotencyKey = &mutation.IdempotencyKey
+	}
+	return transaction
+}
 
-	// Set the warehouse in the location for the response
-	location.Warehouse = warehouse
-
-	return location, nil
+// saveStockMovement writes a location changed by a stock movement together
+// with the movement record and the mutation, if any
+func (s *InventoryService) saveStockMovement(ctx context.Context, location *models.InventoryLocation, transaction *models.InventoryTransaction, mutation *models.StockMutation) error {
+	err := s.inventoryRepo.SaveStockMovement(ctx, location, transaction, mutation)
+	if err != nil && !errors.Is(err, models.ErrConcurrentUpdate) && !errors.Is(err, models.ErrStockMutationRecorded) {
+		s.logger.Error("Failed to update inventory location", zap.Error(err))
+		return fmt.Errorf("failed to update inventory location: %w", err)
+	}
+	return err
 }
 
 // GetInventoryByLocation retrieves inventory items at a specific warehouse
@@ -507,7 +566,7 @@ func (s *InventoryService) GetInventoryByLocation(ctx context.Context, warehouse
 	// Check if warehouse exists
 	warehouse, err := s.warehouseRepo.GetWarehouseByID(ctx, warehouseID)
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, 0, models.ErrWarehouseNotFound
 		}
 		s.logger.Error("Failed to get warehouse", zap.Error(err), zap.String("id", warehouseID))
@@ -550,13 +609,23 @@ func (s *InventoryService) ReserveInventory(ctx context.Context, items []models.
 		expirationMinutes = 30 // Default to 30 minutes
 	}
 
+	// Reservations are held in base units
+	for i := range items {
+		unit, err := s.ResolveUnit(ctx, items[i].InventoryItemID, items[i].Unit)
+		if err != nil {
+			return nil, err
+		}
+		items[i].Quantity = unit.ToBase(items[i].Quantity)
+		items[i].Unit = models.BaseUnit
+	}
+
 	// We'll use the first item for the main reservation
 	firstItem := items[0]
 
 	// Check if inventory item exists
 	_, err := s.inventoryRepo.GetInventoryItemByID(ctx, firstItem.InventoryItemID)
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, models.ErrNotFound
 		}
 		s.logger.Error("Failed to get inventory item", zap.Error(err), zap.String("id", firstItem.InventoryItemID))
@@ -587,7 +656,7 @@ func (s *InventoryService) ReserveInventory(ctx context.Context, items []models.
 
 	// Create the reservation in the database
 	if err := s.inventoryRepo.CreateReservation(ctx, reservation); err != nil {
-		if err == models.ErrInsufficientInventory {
+		if errors.Is(err, models.ErrInsufficientInventory) {
 			return nil, models.ErrInsufficientInventory
 		}
 		s.logger.Error("Failed to create reservation", zap.Error(err))
@@ -622,6 +691,9 @@ func (s *InventoryService) ReserveInventory(ctx context.Context, items []models.
 		}
 	}
 
+	for _, item := range items {
+		s.publishStockChange(ctx, item.InventoryItemID, item.WarehouseID, models.StockChangeReserved)
+	}
 	return reservation, nil
 }
 
@@ -630,7 +702,7 @@ func (s *InventoryService) ConfirmReservation(ctx context.Context, reservationID
 	// Get the reservation
 	reservation, err := s.inventoryRepo.GetReservationByID(ctx, reservationID)
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, models.ErrReservationNotFound
 		}
 		s.logger.Error("Failed to get reservation", zap.Error(err), zap.String("id", reservationID))
@@ -656,6 +728,7 @@ func (s *InventoryService) ConfirmReservation(ctx context.Context, reservationID
 		return nil, fmt.Errorf("failed to update reservation: %w", err)
 	}
 
+	s.publishStockChange(ctx, reservation.InventoryItemID, reservation.WarehouseID, models.StockChangeReservationConfirmed)
 	return reservation, nil
 }
 
@@ -664,7 +737,7 @@ func (s *InventoryService) CancelReservation(ctx context.Context, reservationID
 	// Get the reservation
 	reservation, err := s.inventoryRepo.GetReservationByID(ctx, reservationID)
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, models.ErrReservationNotFound
 		}
 		s.logger.Error("Failed to get reservation", zap.Error(err), zap.String("id", reservationID))
@@ -685,6 +758,7 @@ func (s *InventoryService) CancelReservation(ctx context.Context, reservationID
 		return nil, fmt.Errorf("failed to update reservation: %w", err)
 	}
 
+	s.publishStockChange(ctx, reservation.InventoryItemID, reservation.WarehouseID, models.StockChangeReservationReleased)
 	return reservation, nil
 }
 
@@ -712,7 +786,7 @@ func (s *InventoryService) CheckInventoryAvailability(ctx context.Context, items
 		}
 
 		if err != nil {
-			if err == models.ErrNotFound {
+			if errors.Is(err, models.ErrNotFound) {
 				// Item not found, mark as unavailable
 				result := models.ItemAvailability{
 					ProductID:         item.ProductID,
@@ -722,6 +796,8 @@ func (s *InventoryService) CheckInventoryAvailability(ctx context.Context, items
 					AvailableQuantity: 0,
 					IsAvailable:       false,
 					Status:            "NOT_FOUND",
+					Availability:      availability.OutOfStock,
+					Unit:              models.NormalizeUnitCode(item.Unit),
 				}
 				results = append(results, result)
 				allAvailable = false
@@ -732,8 +808,17 @@ func (s *InventoryService) CheckInventoryAvailability(ctx context.Context, items
 			continue
 		}
 
-		// Check if there's enough available inventory
-		isAvailable := inventoryItem.AvailableQuantity >= item.Quantity
+		// The requested quantity may be in another unit than the stock
+		unit, err := s.ResolveUnit(ctx, inventoryItem.ID, item.Unit)
+		if err != nil {
+			return nil, false, err
+		}
+		requestedQty := unit.ToBase(item.Quantity)
+
+		// Check if there's enough available inventory; safety stock is not for sale
+		s.applyAvailability(ctx, inventoryItem)
+		sellableQty := inventoryItem.SellableQuantity()
+		isAvailable := sellableQty >= requestedQty
 		if !isAvailable {
 			allAvailable = false
 		}
@@ -742,10 +827,14 @@ func (s *InventoryService) CheckInventoryAvailability(ctx context.Context, items
 			ProductID:         inventoryItem.ProductID,
 			VariantID:         inventoryItem.VariantID,
 			SKU:               inventoryItem.SKU,
-			RequestedQuantity: item.Quantity,
-			AvailableQuantity: inventoryItem.AvailableQuantity,
+			RequestedQuantity: requestedQty,
+			AvailableQuantity: sellableQty,
 			IsAvailable:       isAvailable,
 			Status:            inventoryItem.Status,
+			Availability:      inventoryItem.Availability,
+			Unit:              unit.Code,
+			UnitFactor:        unit.Factor,
+			AvailableUnits:    unit.FromBase(sellableQty),
 		}
 		results = append(results, result)
 	}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+const (
+	// lotWriteOffBatch bounds the lots written off per query of a run
+	lotWriteOffBatch = 200
+	// defaultExpiryWindow is the look-ahead of the expiring lots report
+	defaultExpiryWindow = 7 * 24 * time.Hour
+)
+
+// LotService tracks the lots of perishable goods. Stock received into a lot
+// carries its expiry date; reservations are allocated the units of the lots
+// that expire first, and expired lots are written off the stock. Items
+// without lots are not affected.
+type LotService struct {
+	lotRepo          repository.LotRepository
+	warehouseRepo    repository.WarehouseRepository
+	inventoryService *InventoryService
+	logger           *zap.Logger
+}
+
+// NewLotService creates a new lot service
+func NewLotService(
+	lotRepo repository.LotRepository,
+	warehouseRepo repository.WarehouseRepository,
+	inventoryService *InventoryService,
+	logger *zap.Logger,
+) *LotService {
+	return &LotService{
+		lotRepo:          lotRepo,
+		warehouseRepo:    warehouseRepo,
+		inventoryService: inventoryService,
+		logger:           logger,
+	}
+}
+
+// ReceiveLot receives units of an item into a lot at a warehouse. The
+// quantity of the lot is in the given unit of measure, converted to base
+// units. Receiving a lot number again adds to the lot, and must give the same
+// expiry date.
+func (s *LotService) ReceiveLot(ctx context.Context, lot *models.InventoryLot, unitCode, notes string) (*models.InventoryLot, error) {
+	if _, err := uuid.Parse(lot.InventoryItemID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid inventory item ID")
+	}
+	lot.LotNumber = strings.TrimSpace(lot.LotNumber)
+	if lot.LotNumber == "" || len(lot.LotNumber) > 100 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "lot_number is required and must be at most 100 characters")
+	}
+	if lot.Quantity <= 0 {
+		return nil, models.ErrInvalidQuantity
+	}
+	if lot.Expired(time.Now()) {
+		return nil, models.ErrLotExpired
+	}
+	unit, err := s.inventoryService.ResolveUnit(ctx, lot.InventoryItemID, unitCode)
+	if err != nil {
+		return nil, err
+	}
+	lot.Quantity = unit.ToBase(lot.Quantity)
+
+	warehouse, err := s.warehouseRepo.GetWarehouseByID(ctx, lot.WarehouseID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, models.ErrWarehouseNotFound
+		}
+		return nil, fmt.Errorf("failed to get warehouse: %w", err)
+	}
+	if !warehouse.IsActive {
+		return nil, models.ErrWarehouseInactive
+	}
+
+	received := lot.Quantity
+	if err := s.lotRepo.ReceiveLot(ctx, lot, notes); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Lot received",
+		zap.String("lot_id", lot.ID),
+		zap.String("lot_number", lot.LotNumber),
+		zap.String("inventory_item_id", lot.InventoryItemID),
+		zap.String("warehouse_id", lot.WarehouseID),
+		zap.Int("quantity", received))
+
+	s.inventoryService.publishStockChange(ctx, lot.InventoryItemID, &lot.WarehouseID, models.StockChangeAdded)
+	return lot, nil
+}
+
+// ListLots lists the lots of an item first expired first out
+func (s *LotService) ListLots(ctx context.Context, inventoryItemID, warehouseID string, includeEmpty bool) ([]models.InventoryLot, error) {
+	if _, err := uuid.Parse(inventoryItemID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid inventory item ID")
+	}
+	return s.lotRepo.ListLots(ctx, inventoryItemID, warehouseID, includeEmpty)
+}
+
+// ListExpiringLots reports the lots with units left that expire within the
+// given window, seven days when it is not positive, soonest first
+func (s *LotService) ListExpiringLots(ctx context.Context, within time.Duration, warehouseID string, page, limit int) ([]models.InventoryLot, int, error) {
+	if within <= 0 {
+		within = defaultExpiryWindow
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+	return s.lotRepo.ListExpiringLots(ctx, time.Now().UTC().Add(within), warehouseID, offset, limit)
+}
+
+// StartExpiryWriteOff writes off the expired lots of all stores at the given
+// interval until the context is cancelled
+func (s *LotService) StartExpiryWriteOff(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Lot expiry write-off stopped")
+				return
+			case <-ticker.C:
+				s.writeOffExpiredLots(ctx)
+			}
+		}
+	}()
+}
+
+// writeOffExpiredLots writes off the expired lots in batches, publishing
+// the stock changes of the items that lost units
+func (s *LotService) writeOffExpiredLots(ctx context.Context) {
+	now := time.Now().UTC()
+	for {
+		writeOffs, err := s.lotRepo.WriteOffExpiredLots(ctx, now, lotWriteOffBatch)
+		if err != nil {
+			s.logger.Error("Lot expiry write-off failed", zap.Error(err))
+			return
+		}
+		for _, writeOff := range writeOffs {
+			lot := writeOff.Lot
+			s.logger.Info("Expired lot written off",
+				zap.String("lot_id", lot.ID),
+				zap.String("lot_number", lot.LotNumber),
+				zap.String("inventory_item_id", lot.InventoryItemID),
+				zap.String("warehouse_id", lot.WarehouseID),
+				zap.String("tenant_id", lot.TenantID),
+				zap.Int("quantity", writeOff.Quantity))
+			if writeOff.Quantity > 0 {
+				s.inventoryService.publishStockChange(tenant.WithTenant(ctx, lot.TenantID), lot.InventoryItemID, &lot.WarehouseID, models.StockChangeRemoved)
+			}
+		}
+		if len(writeOffs) < lotWriteOffBatch {
+			return
+		}
+	}
+}
@@ -0,0 +1,405 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	netmail "net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/mail"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+const (
+	// defaultPickupCollectDays is how many days customers have to collect a
+	// ready order when not configured
+	defaultPickupCollectDays = 7
+	maxPickupLines           = 50
+	maxOrderReference        = 255
+	// pickupExpiryBatch bounds the overdue orders cancelled per batch
+	pickupExpiryBatch = 100
+	// pickupCheckoutHoldMinutes is how long the reservations of an order
+	// are held while it is recorded, before they are confirmed
+	pickupCheckoutHoldMinutes = 15
+)
+
+// PickupService handles click-and-collect orders: orders placed online for
+// the customer to collect at a retail store. Checkout reserves the lines at
+// the chosen store; staff then pick the order and mark it ready, which emails
+// the customer, and record it collected, which removes its units from the
+// stock of the store. Ready orders not collected within the collect days are
+// cancelled and their units restocked by the expiry scheduler.
+type PickupService struct {
+	pickupRepo       repository.PickupRepository
+	storeRepo        repository.RetailStoreRepository
+	fulfillmentRepo  repository.FulfillmentRepository
+	inventoryService *InventoryService
+	mailer           mail.Mailer
+	collectDays      int
+	logger           *zap.Logger
+}
+
+// NewPickupService creates a new pickup service. collectDays is how many
+// days customers have to collect a ready order, 7 when not positive.
+func NewPickupService(
+	pickupRepo repository.PickupRepository,
+	storeRepo repository.RetailStoreRepository,
+	fulfillmentRepo repository.FulfillmentRepository,
+	inventoryService *InventoryService,
+	mailer mail.Mailer,
+	collectDays int,
+	logger *zap.Logger,
+) *PickupService {
+	if collectDays <= 0 {
+		collectDays = defaultPickupCollectDays
+	}
+	return &PickupService{
+		pickupRepo:       pickupRepo,
+		storeRepo:        storeRepo,
+		fulfillmentRepo:  fulfillmentRepo,
+		inventoryService: inventoryService,
+		mailer:           mailer,
+		collectDays:      collectDays,
+		logger:           logger,
+	}
+}
+
+// CreatePickupOrder places an order for pickup at the store of a warehouse,
+// reserving its items there until the order is collected or cancelled. The
+// store must be active and take pickup orders, and hold every item.
+func (s *PickupService) CreatePickupOrder(ctx context.Context, order *models.PickupOrder, items []models.PickupItem) (*models.PickupOrder, error) {
+	order.OrderReference = strings.TrimSpace(order.OrderReference)
+	if order.OrderReference == "" || len(order.OrderReference) > maxOrderReference {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument,
+			fmt.Sprintf("order reference is required and must be at most %d characters", maxOrderReference))
+	}
+	if _, err := uuid.Parse(order.WarehouseID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+	}
+	address, err := netmail.ParseAddress(strings.TrimSpace(order.Email))
+	if err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid email address")
+	}
+	order.Email = address.Address
+	if len(items) == 0 || len(items) > maxPickupLines {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument,
+			fmt.Sprintf("a pickup order must have between 1 and %d items", maxPickupLines))
+	}
+	for _, item := range items {
+		if item.ProductID == "" {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, "product ID is required")
+		}
+		if item.Quantity <= 0 {
+			return nil, models.ErrInvalidQuantity
+		}
+	}
+
+	store, err := s.storeRepo.GetStore(ctx, order.WarehouseID)
+	if err != nil {
+		return nil, err
+	}
+	if !store.PickupEnabled || !store.Warehouse.IsActive {
+		return nil, models.ErrPickupUnavailable
+	}
+
+	// The reservations reference the order by its ID, so it is known before
+	// the order is recorded
+	order.ID = uuid.New().String()
+	order.Status = models.PickupPending
+	order.Lines = make([]models.PickupLine, 0, len(items))
+	for _, item := range items {
+		line, err := s.reserveLine(ctx, order, item)
+		if err != nil {
+			s.releaseLines(ctx, order.Lines)
+			return nil, err
+		}
+		order.Lines = append(order.Lines, *line)
+	}
+
+	if err := s.pickupRepo.CreatePickupOrder(ctx, order); err != nil {
+		s.releaseLines(ctx, order.Lines)
+		return nil, err
+	}
+
+	s.logger.Info("Pickup order created",
+		zap.String("pickup_order_id", order.ID),
+		zap.String("order_reference", order.OrderReference),
+		zap.String("warehouse_id", order.WarehouseID),
+		zap.Int("lines", len(order.Lines)))
+	return order, nil
+}
+
+// reserveLine reserves an item of a pickup order at its store
+func (s *PickupService) reserveLine(ctx context.Context, order *models.PickupOrder, item models.PickupItem) (*models.PickupLine, error) {
+	inventoryItem, err := s.inventoryService.GetInventoryItem(ctx, "", item.ProductID, "")
+	if err != nil {
+		return nil, err
+	}
+	reservation, err := s.inventoryService.ReserveInventory(ctx, []models.ReservationItem{{
+		InventoryItemID: inventoryItem.ID,
+		Quantity:        item.Quantity,
+		WarehouseID:     &order.WarehouseID,
+		Unit:            item.Unit,
+	}}, order.ID, models.ReferencePickupOrder, pickupCheckoutHoldMinutes)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PickupLine{
+		InventoryItemID: inventoryItem.ID,
+		ProductID:       inventoryItem.ProductID,
+		SKU:             inventoryItem.SKU,
+		Quantity:        reservation.Quantity,
+		ReservationID:   reservation.ID,
+		Personalization: item.Personalization,
+	}, nil
+}
+
+// releaseLines cancels the reservations of the lines of an order that could
+// not be placed
+func (s *PickupService) releaseLines(ctx context.Context, lines []models.PickupLine) {
+	for _, line := range lines {
+		if _, err := s.inventoryService.CancelReservation(ctx, line.ReservationID); err != nil {
+			s.logger.Error("Failed to release pickup order reservation", zap.Error(err),
+				zap.String("reservation_id", line.ReservationID))
+		}
+	}
+}
+
+// GetPickupOrder retrieves a pickup order with its lines. Given a user ID,
+// orders of other customers are not found.
+func (s *PickupService) GetPickupOrder(ctx context.Context, id, userID string) (*models.PickupOrder, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid pickup order ID")
+	}
+	order, err := s.pickupRepo.GetPickupOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if userID != "" && order.UserID != userID {
+		return nil, models.ErrPickupOrderNotFound
+	}
+	return order, nil
+}
+
+// ListPickupOrders lists pickup orders, oldest first
+func (s *PickupService) ListPickupOrders(ctx context.Context, filter models.PickupOrderFilter, page, limit int) ([]models.PickupOrder, int, error) {
+	if filter.WarehouseID != "" {
+		if _, err := uuid.Parse(filter.WarehouseID); err != nil {
+			return nil, 0, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+		}
+	}
+	if filter.Status != "" && !models.IsPickupStatus(filter.Status) {
+		return nil, 0, apperrors.New(apperrors.ErrInvalidArgument, fmt.Sprintf("unknown pickup order status %q", filter.Status))
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	return s.pickupRepo.ListPickupOrders(ctx, filter, (page-1)*limit, limit)
+}
+
+// StartPicking records that the store is picking a pending pickup order
+func (s *PickupService) StartPicking(ctx context.Context, id string) (*models.PickupOrder, error) {
+	order, err := s.GetPickupOrder(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !models.CanTransitionPickup(order.Status, models.PickupPicking) {
+		return nil, models.ErrPickupInvalidTransition
+	}
+
+	from := order.Status
+	order.Status = models.PickupPicking
+	if err := s.pickupRepo.UpdatePickupStatus(ctx, order, from); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Pickup order picking", zap.String("pickup_order_id", order.ID))
+	return order, nil
+}
+
+// MarkReady records that a pickup order waits at its store, to be collected
+// within the collect days, and emails the customer
+func (s *PickupService) MarkReady(ctx context.Context, id string) (*models.PickupOrder, error) {
+	order, err := s.GetPickupOrder(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+	if !models.CanTransitionPickup(order.Status, models.PickupReady) {
+		return nil, models.ErrPickupInvalidTransition
+	}
+
+	now := time.Now().UTC()
+	collectBy := now.AddDate(0, 0, s.collectDays)
+	from := order.Status
+	order.Status = models.PickupReady
+	order.ReadyAt = &now
+	order.CollectBy = &collectBy
+	if err := s.pickupRepo.UpdatePickupStatus(ctx, order, from); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Pickup order ready",
+		zap.String("pickup_order_id", order.ID),
+		zap.Time("collect_by", collectBy))
+	s.recordOrderStatus(ctx, order, models.OrderStatusReadyForPickup, now)
+	s.notifyReady(ctx, order)
+	return order, nil
+}
+
+// notifyReady emails the customer of a ready order where and until when to
+// collect it. A failed email is logged; the order stays ready.
+func (s *PickupService) notifyReady(ctx context.Context, order *models.PickupOrder) {
+	var where string
+	if store, err := s.storeRepo.GetStore(ctx, order.WarehouseID); err == nil {
+		warehouse := store.Warehouse
+		where = fmt.Sprintf("\n%s\n%s\n%s %s\n", warehouse.Name, warehouse.Address, warehouse.PostalCode, warehouse.City)
+		if store.Phone != "" {
+			where += store.Phone + "\n"
+		}
+	} else {
+		s.logger.Warn("Failed to get store of pickup order", zap.Error(err), zap.String("pickup_order_id", order.ID))
+	}
+
+	body := fmt.Sprintf("Your order %s is ready to collect.\n%s\nPlease collect it by %s, after which it will be cancelled.\n",
+		order.OrderReference, where, order.CollectBy.Format("Monday 2 January 2006"))
+	err := s.mailer.Send(ctx, mail.Message{
+		To:      []string{order.Email},
+		Subject: fmt.Sprintf("Order %s is ready for pickup", order.OrderReference),
+		Body:    body,
+	})
+	if err != nil {
+		s.logger.Warn("Failed to send pickup ready email", zap.Error(err), zap.String("pickup_order_id", order.ID))
+	}
+}
+
+// CollectPickupOrder records that the customer collected a ready pickup
+// order, removing its units from the stock of the store
+func (s *PickupService) CollectPickupOrder(ctx context.Context, id string) (*models.PickupOrder, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid pickup order ID")
+	}
+
+	now := time.Now().UTC()
+	order, err := s.pickupRepo.CollectPickupOrder(ctx, id, now)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Pickup order collected",
+		zap.String("pickup_order_id", order.ID),
+		zap.String("order_reference", order.OrderReference))
+	for _, line := range order.Lines {
+		s.inventoryService.publishStockChange(ctx, line.InventoryItemID, &order.WarehouseID, models.StockChangeRemoved)
+	}
+	s.recordOrderStatus(ctx, order, models.OrderStatusCollected, now)
+	return order, nil
+}
+
+// CancelPickupOrder cancels a pickup order not yet collected, restocking its
+// units at the store. Given a user ID, orders of other customers are not
+// found.
+func (s *PickupService) CancelPickupOrder(ctx context.Context, id, userID, reason string) (*models.PickupOrder, error) {
+	if userID != "" {
+		if _, err := s.GetPickupOrder(ctx, id, userID); err != nil {
+			return nil, err
+		}
+	} else if _, err := uuid.Parse(id); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid pickup order ID")
+	}
+	return s.cancel(ctx, id, strings.TrimSpace(reason), time.Now().UTC())
+}
+
+func (s *PickupService) cancel(ctx context.Context, id, reason string, now time.Time) (*models.PickupOrder, error) {
+	order, err := s.pickupRepo.CancelPickupOrder(ctx, id, reason, now)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Pickup order cancelled",
+		zap.String("pickup_order_id", order.ID),
+		zap.String("order_reference", order.OrderReference),
+		zap.String("reason", reason))
+	for _, line := range order.Lines {
+		s.inventoryService.publishStockChange(ctx, line.InventoryItemID, &order.WarehouseID, models.StockChangeReservationReleased)
+	}
+	s.recordOrderStatus(ctx, order, models.OrderStatusPickupCancelled, now)
+	return order, nil
+}
+
+// recordOrderStatus reports a status of a pickup order to the order system.
+// A failure is logged; the order keeps its status.
+func (s *PickupService) recordOrderStatus(ctx context.Context, order *models.PickupOrder, status string, at time.Time) {
+	err := s.fulfillmentRepo.CreateOrderStatusEvent(ctx, &models.OrderStatusEvent{
+		OrderReference: order.OrderReference,
+		Status:         status,
+		Provider:       models.PickupProvider,
+		OccurredAt:     at,
+	})
+	if err != nil {
+		s.logger.Error("Failed to record order status of pickup order", zap.Error(err),
+			zap.String("pickup_order_id", order.ID), zap.String("order_reference", order.OrderReference))
+	}
+}
+
+// StartExpiryScheduler cancels the ready pickup orders of every store not
+// collected by their collect-by time, restocking their units, every interval
+// until ctx is done
+func (s *PickupService) StartExpiryScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Pickup order expiry stopped")
+				return
+			case <-ticker.C:
+				s.cancelOverdueOrders(ctx)
+			}
+		}
+	}()
+}
+
+// cancelOverdueOrders cancels the overdue pickup orders in batches
+func (s *PickupService) cancelOverdueOrders(ctx context.Context) {
+	now := time.Now().UTC()
+	for {
+		orders, err := s.pickupRepo.ListOverduePickupOrders(ctx, now, pickupExpiryBatch)
+		if err != nil {
+			s.logger.Error("Pickup order expiry failed", zap.Error(err))
+			return
+		}
+		cancelled := 0
+		for _, order := range orders {
+			_, err := s.cancel(tenant.WithTenant(ctx, order.TenantID), order.ID, models.PickupCancelNotCollected, now)
+			if err != nil {
+				// Collected or cancelled concurrently
+				if !errors.Is(err, models.ErrPickupInvalidTransition) {
+					s.logger.Error("Failed to cancel overdue pickup order", zap.Error(err),
+						zap.String("pickup_order_id", order.ID), zap.String("tenant_id", order.TenantID))
+				}
+				continue
+			}
+			cancelled++
+		}
+		// Stop when a batch made no progress, so failing orders are retried
+		// on the next tick rather than looped over
+		if len(orders) < pickupExpiryBatch || cancelled == 0 {
+			return
+		}
+	}
+}
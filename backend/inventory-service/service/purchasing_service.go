@@ -0,0 +1,300 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+// maxPurchaseOrderLines bounds the lines of one purchase order
+const maxPurchaseOrderLines = 500
+
+// PurchasingService manages suppliers and the purchase orders of stock from
+// them. Received stock enters the warehouse through the inventory
+// transaction ledger.
+type PurchasingService struct {
+	purchasingRepo   repository.PurchasingRepository
+	inventoryRepo    repository.InventoryRepository
+	warehouseRepo    repository.WarehouseRepository
+	inventoryService *InventoryService
+	logger           *zap.Logger
+}
+
+// NewPurchasingService creates a new purchasing service
+func NewPurchasingService(
+	purchasingRepo repository.PurchasingRepository,
+	inventoryRepo repository.InventoryRepository,
+	warehouseRepo repository.WarehouseRepository,
+	inventoryService *InventoryService,
+	logger *zap.Logger,
+) *PurchasingService {
+	return &PurchasingService{
+		purchasingRepo:   purchasingRepo,
+		inventoryRepo:    inventoryRepo,
+		warehouseRepo:    warehouseRepo,
+		inventoryService: inventoryService,
+		logger:           logger,
+	}
+}
+
+// CreateSupplier creates a supplier
+func (s *PurchasingService) CreateSupplier(ctx context.Context, supplier *models.Supplier) (*models.Supplier, error) {
+	if err := normalizeSupplier(supplier); err != nil {
+		return nil, err
+	}
+	if err := s.purchasingRepo.CreateSupplier(ctx, supplier); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Supplier created", zap.String("id", supplier.ID), zap.String("name", supplier.Name))
+	return supplier, nil
+}
+
+// UpdateSupplier updates a supplier
+func (s *PurchasingService) UpdateSupplier(ctx context.Context, supplier *models.Supplier) (*models.Supplier, error) {
+	if err := normalizeSupplier(supplier); err != nil {
+		return nil, err
+	}
+	if err := s.purchasingRepo.UpdateSupplier(ctx, supplier); err != nil {
+		return nil, err
+	}
+	return supplier, nil
+}
+
+// GetSupplier retrieves a supplier with the inventory items it sells
+func (s *PurchasingService) GetSupplier(ctx context.Context, id string) (*models.Supplier, error) {
+	supplier, err := s.purchasingRepo.GetSupplier(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	supplier.Products, err = s.purchasingRepo.ListSupplierProducts(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return supplier, nil
+}
+
+// ListSuppliers retrieves a paginated list of suppliers
+func (s *PurchasingService) ListSuppliers(ctx context.Context, page, limit int, isActive *bool) ([]models.Supplier, int, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+	return s.purchasingRepo.ListSuppliers(ctx, offset, limit, isActive)
+}
+
+// SetSupplierProduct links an inventory item to a supplier with its cost
+// price and lead time, or updates the link
+func (s *PurchasingService) SetSupplierProduct(ctx context.Context, product *models.SupplierProduct) (*models.SupplierProduct, error) {
+	product.SupplierSKU = strings.TrimSpace(product.SupplierSKU)
+	if product.SupplierID == "" || product.InventoryItemID == "" {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "supplier_id and inventory_item_id are required")
+	}
+	if product.CostPrice < 0 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "cost_price must not be negative")
+	}
+	if product.LeadTimeDays < 0 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "lead_time_days must not be negative")
+	}
+	if err := s.purchasingRepo.UpsertSupplierProduct(ctx, product); err != nil {
+		return nil, err
+	}
+	return product, nil
+}
+
+// RemoveSupplierProduct unlinks an inventory item from a supplier
+func (s *PurchasingService) RemoveSupplierProduct(ctx context.Context, supplierID, inventoryItemID string) error {
+	return s.purchasingRepo.DeleteSupplierProduct(ctx, supplierID, inventoryItemID)
+}
+
+// CreatePurchaseOrder creates an open purchase order from an active
+// supplier into an active warehouse. Lines without a unit cost use the
+// supplier's cost price, and an order without an expected receipt date is
+// expected after the longest lead time of its items.
+func (s *PurchasingService) CreatePurchaseOrder(ctx context.Context, order *models.PurchaseOrder) (*models.PurchaseOrder, error) {
+	if len(order.Lines) == 0 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "a purchase order needs at least one line")
+	}
+	if len(order.Lines) > maxPurchaseOrderLines {
+		return nil, apperrors.Errorf(apperrors.ErrInvalidArgument, "a purchase order has at most %d lines", maxPurchaseOrderLines)
+	}
+
+	supplier, err := s.purchasingRepo.GetSupplier(ctx, order.SupplierID)
+	if err != nil {
+		return nil, err
+	}
+	if !supplier.IsActive {
+		return nil, models.ErrSupplierInactive
+	}
+	warehouse, err := s.warehouseRepo.GetWarehouseByID(ctx, order.WarehouseID)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, models.ErrWarehouseNotFound
+		}
+		return nil, fmt.Errorf("failed to get warehouse: %w", err)
+	}
+	if !warehouse.IsActive {
+		return nil, models.ErrWarehouseInactive
+	}
+
+	supplierProducts, err := s.purchasingRepo.ListSupplierProducts(ctx, supplier.ID)
+	if err != nil {
+		return nil, err
+	}
+	linked := make(map[string]models.SupplierProduct, len(supplierProducts))
+	for _, p := range supplierProducts {
+		linked[p.InventoryItemID] = p
+	}
+
+	leadTimeDays := 0
+	for i := range order.Lines {
+		line := &order.Lines[i]
+		if line.QuantityOrdered <= 0 {
+			return nil, models.ErrInvalidQuantity
+		}
+		if _, err := s.inventoryRepo.GetInventoryItemByID(ctx, line.InventoryItemID); err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				return nil, apperrors.Errorf(apperrors.ErrNotFound, "inventory item %s not found", line.InventoryItemID)
+			}
+			return nil, fmt.Errorf("failed to get inventory item: %w", err)
+		}
+		unit, err := s.inventoryService.ResolveUnit(ctx, line.InventoryItemID, line.UnitCode)
+		if err != nil {
+			return nil, err
+		}
+		line.UnitCode = unit.Code
+		if p, ok := linked[line.InventoryItemID]; ok {
+			// The supplier's cost price is per base unit
+			if line.UnitCost == 0 {
+				line.UnitCost = p.CostPrice * float64(unit.Factor)
+			}
+			if p.LeadTimeDays > leadTimeDays {
+				leadTimeDays = p.LeadTimeDays
+			}
+		}
+		if line.UnitCost < 0 {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, "unit_cost must not be negative")
+		}
+	}
+
+	if order.Currency == "" {
+		order.Currency = supplier.Currency
+	}
+	order.Currency = strings.ToUpper(strings.TrimSpace(order.Currency))
+	if len(order.Currency) != 3 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "currency must be a 3-letter ISO code")
+	}
+	if order.ExpectedAt == nil {
+		expectedAt := time.Now().UTC().AddDate(0, 0, leadTimeDays)
+		order.ExpectedAt = &expectedAt
+	}
+
+	if err := s.purchasingRepo.CreatePurchaseOrder(ctx, order); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Purchase order created",
+		zap.String("id", order.ID),
+		zap.String("supplier_id", order.SupplierID),
+		zap.Int("lines", len(order.Lines)))
+	return s.purchasingRepo.GetPurchaseOrder(ctx, order.ID)
+}
+
+// GetPurchaseOrder retrieves a purchase order with its lines
+func (s *PurchasingService) GetPurchaseOrder(ctx context.Context, id string) (*models.PurchaseOrder, error) {
+	return s.purchasingRepo.GetPurchaseOrder(ctx, id)
+}
+
+// ListPurchaseOrders retrieves a paginated list of purchase orders without
+// their lines
+func (s *PurchasingService) ListPurchaseOrders(ctx context.Context, filter models.PurchaseOrderFilter, page, limit int) ([]models.PurchaseOrder, int, error) {
+	if filter.Status != "" && !models.IsPurchaseOrderStatus(filter.Status) {
+		return nil, 0, apperrors.Errorf(apperrors.ErrInvalidArgument, "unknown status %s", filter.Status)
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+	return s.purchasingRepo.ListPurchaseOrders(ctx, filter, offset, limit)
+}
+
+// ReceivePurchaseOrder records the receipt of stock for a purchase order and
+// adds it to the order's warehouse through the inventory transaction ledger.
+// Without lines, everything outstanding is received. The receipt is recorded
+// before the stock moves, so a failing stock movement is logged for manual
+// correction rather than received twice on a retry.
+func (s *PurchasingService) ReceivePurchaseOrder(ctx context.Context, id string, lines []models.ReceiptLine) (*models.PurchaseOrder, error) {
+	for _, line := range lines {
+		if line.InventoryItemID == "" {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, "inventory_item_id is required")
+		}
+		if line.Quantity <= 0 {
+			return nil, models.ErrInvalidQuantity
+		}
+	}
+
+	order, receipts, err := s.purchasingRepo.ReceivePurchaseOrder(ctx, id, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	notes := fmt.Sprintf("Received for purchase order %s", order.ID)
+	for _, receipt := range receipts {
+		_, err := s.inventoryService.AddInventoryToLocation(ctx, receipt.InventoryItemID, order.WarehouseID,
+			receipt.Quantity, receipt.UnitCode, order.ID, models.ReferencePurchaseOrder, notes, "")
+		if err != nil {
+			s.logger.Error("Failed to add received stock of purchase order",
+				zap.Error(err),
+				zap.String("purchase_order_id", order.ID),
+				zap.String("inventory_item_id", receipt.InventoryItemID),
+				zap.Int("quantity", receipt.Quantity),
+				zap.String("unit", receipt.UnitCode))
+		}
+	}
+
+	s.logger.Info("Purchase order received",
+		zap.String("id", order.ID),
+		zap.String("status", order.Status),
+		zap.Int("lines", len(receipts)))
+	return order, nil
+}
+
+// CancelPurchaseOrder cancels an open or partially received purchase order
+func (s *PurchasingService) CancelPurchaseOrder(ctx context.Context, id string) (*models.PurchaseOrder, error) {
+	order, err := s.purchasingRepo.CancelPurchaseOrder(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Purchase order cancelled", zap.String("id", id))
+	return order, nil
+}
+
+// normalizeSupplier trims and validates the fields of a supplier
+func normalizeSupplier(supplier *models.Supplier) error {
+	supplier.Name = strings.TrimSpace(supplier.Name)
+	supplier.Email = strings.TrimSpace(supplier.Email)
+	supplier.Phone = strings.TrimSpace(supplier.Phone)
+	supplier.Currency = strings.ToUpper(strings.TrimSpace(supplier.Currency))
+	if supplier.Name == "" {
+		return apperrors.New(apperrors.ErrInvalidArgument, "name is required")
+	}
+	if supplier.Currency == "" {
+		supplier.Currency = "USD"
+	}
+	if len(supplier.Currency) != 3 {
+		return apperrors.New(apperrors.ErrInvalidArgument, "currency must be a 3-letter ISO code")
+	}
+	return nil
+}
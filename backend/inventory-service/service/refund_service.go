@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+// maxRefundLines bounds the lines of a refund
+const maxRefundLines = 100
+
+// RefundService runs the refunds of the payments of orders. Staff request a
+// refund, in full or in part, and approve or reject it; approving restocks
+// the returned lines marked for restocking. The payment system then reports
+// the refund processed or failed. Every change of status is recorded as a
+// refund event for the notification and accounting exports.
+type RefundService struct {
+	refundRepo       repository.RefundRepository
+	inventoryService *InventoryService
+	logger           *zap.Logger
+}
+
+// NewRefundService creates a new refund service
+func NewRefundService(
+	refundRepo repository.RefundRepository,
+	inventoryService *InventoryService,
+	logger *zap.Logger,
+) *RefundService {
+	return &RefundService{
+		refundRepo:       refundRepo,
+		inventoryService: inventoryService,
+		logger:           logger,
+	}
+}
+
+// RequestRefund records a requested refund of the payment of an order. The
+// currency defaults to USD.
+func (s *RefundService) RequestRefund(ctx context.Context, refund *models.Refund) (*models.Refund, error) {
+	refund.OrderReference = strings.TrimSpace(refund.OrderReference)
+	refund.PaymentReference = strings.TrimSpace(refund.PaymentReference)
+	if refund.OrderReference == "" || len(refund.OrderReference) > 255 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "order_reference is required and must be at most 255 characters")
+	}
+	if refund.PaymentReference == "" || len(refund.PaymentReference) > 255 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "payment_reference is required and must be at most 255 characters")
+	}
+	if refund.Type != models.RefundFull && refund.Type != models.RefundPartial {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "type must be full or partial")
+	}
+	if !(refund.Amount > 0 && refund.Amount < 1e10) {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "amount must be positive")
+	}
+	refund.Amount = math.Round(refund.Amount*100) / 100
+	refund.Currency = strings.ToUpper(strings.TrimSpace(refund.Currency))
+	if refund.Currency == "" {
+		refund.Currency = "USD"
+	}
+	if len(refund.Currency) != 3 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "currency must be a 3-letter ISO code")
+	}
+	if len(refund.Reason) > 1000 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "reason must be at most 1000 characters")
+	}
+	if len(refund.Lines) > maxRefundLines {
+		return nil, apperrors.Errorf(apperrors.ErrInvalidArgument, "a refund has at most %d lines", maxRefundLines)
+	}
+	for _, line := range refund.Lines {
+		if _, err := uuid.Parse(line.InventoryItemID); err != nil {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid inventory item ID")
+		}
+		if line.Quantity <= 0 {
+			return nil, models.ErrInvalidQuantity
+		}
+		if line.Restock {
+			if line.WarehouseID == nil {
+				return nil, apperrors.New(apperrors.ErrInvalidArgument, "restocked lines need a warehouse")
+			}
+			if _, err := uuid.Parse(*line.WarehouseID); err != nil {
+				return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+			}
+		}
+	}
+	refund.Status = models.RefundRequested
+
+	if err := s.refundRepo.CreateRefund(ctx, refund); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Refund requested",
+		zap.String("refund_id", refund.ID),
+		zap.String("order_reference", refund.OrderReference),
+		zap.Float64("amount", refund.Amount),
+		zap.String("currency", refund.Currency))
+	return refund, nil
+}
+
+// GetRefund retrieves a refund with its lines
+func (s *RefundService) GetRefund(ctx context.Context, id string) (*models.Refund, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid refund ID")
+	}
+	return s.refundRepo.GetRefund(ctx, id)
+}
+
+// ListRefunds lists the refunds, newest first
+func (s *RefundService) ListRefunds(ctx context.Context, filter models.RefundFilter, page, limit int) ([]models.Refund, int, error) {
+	switch filter.Status {
+	case "", models.RefundRequested, models.RefundApproved, models.RefundRejected, models.RefundProcessed, models.RefundFailed:
+	default:
+		return nil, 0, apperrors.Errorf(apperrors.ErrInvalidArgument, "unknown refund status %q", filter.Status)
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+	return s.refundRepo.ListRefunds(ctx, filter, offset, limit)
+}
+
+// ApproveRefund approves a requested refund and restocks its lines marked
+// for restocking. The refund is approved first, so a refund rejected
+// concurrently is never restocked. Each line is restocked under an
+// idempotency key: approving an approved refund again restocks the lines a
+// failure left out, and none twice.
+func (s *RefundService) ApproveRefund(ctx context.Context, id, reviewedBy string) (*models.Refund, error) {
+	refund, err := s.GetRefund(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if refund.Status != models.RefundApproved {
+		if !models.CanTransitionRefund(refund.Status, models.RefundApproved) {
+			return nil, models.ErrRefundInvalidTransition
+		}
+		now := time.Now().UTC()
+		refund.Status = models.RefundApproved
+		refund.ReviewedBy = reviewedBy
+		refund.ReviewedAt = &now
+		if err := s.refundRepo.UpdateRefundStatus(ctx, refund, models.RefundRequested); err != nil {
+			return nil, err
+		}
+		s.logger.Info("Refund approved", zap.String("refund_id", refund.ID), zap.String("reviewed_by", reviewedBy))
+	}
+
+	notes := fmt.Sprintf("Returned with refund %s of order %s", refund.ID, refund.OrderReference)
+	for _, line := range refund.Lines {
+		if !line.Restock {
+			continue
+		}
+		_, err := s.inventoryService.AddInventoryToLocation(ctx, line.InventoryItemID, *line.WarehouseID, line.Quantity,
+			models.BaseUnit, refund.ID, models.ReferenceRefund, notes, "refund:"+line.ID)
+		if err != nil {
+			return nil, fmt.Errorf("refund approved but failed to restock SKU %s, approve it again to retry: %w", line.SKU, err)
+		}
+	}
+	return refund, nil
+}
+
+// RejectRefund rejects a requested refund
+func (s *RefundService) RejectRefund(ctx context.Context, id, reviewedBy, reason string) (*models.Refund, error) {
+	if len(reason) > 1000 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "reason must be at most 1000 characters")
+	}
+	refund, err := s.GetRefund(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !models.CanTransitionRefund(refund.Status, models.RefundRejected) {
+		return nil, models.ErrRefundInvalidTransition
+	}
+
+	now := time.Now().UTC()
+	refund.Status = models.RefundRejected
+	refund.ReviewedBy = reviewedBy
+	refund.ReviewedAt = &now
+	refund.FailureReason = strings.TrimSpace(reason)
+	if err := s.refundRepo.UpdateRefundStatus(ctx, refund, models.RefundRequested); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Refund rejected", zap.String("refund_id", refund.ID), zap.String("reviewed_by", reviewedBy))
+	return refund, nil
+}
+
+// RecordRefundResult records the outcome of an approved refund reported by
+// the payment system: processed with the provider's reference, or failed. A
+// failed refund may still be reported processed when it is retried.
+func (s *RefundService) RecordRefundResult(ctx context.Context, id string, succeeded bool, providerReference, failureReason string) (*models.Refund, error) {
+	if len(providerReference) > 255 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "provider_reference must be at most 255 characters")
+	}
+	refund, err := s.GetRefund(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	from := refund.Status
+	if succeeded {
+		now := time.Now().UTC()
+		refund.Status = models.RefundProcessed
+		refund.ProviderReference = strings.TrimSpace(providerReference)
+		refund.FailureReason = ""
+		refund.ProcessedAt = &now
+	} else {
+		refund.Status = models.RefundFailed
+		refund.FailureReason = strings.TrimSpace(failureReason)
+	}
+	if !models.CanTransitionRefund(from, refund.Status) {
+		return nil, models.ErrRefundInvalidTransition
+	}
+	if err := s.refundRepo.UpdateRefundStatus(ctx, refund, from); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Refund result recorded", zap.String("refund_id", refund.ID), zap.String("status", refund.Status))
+	return refund, nil
+}
+
+// ListRefundEvents lists the refund events after afterID, for the
+// notification and accounting exports to read them in order
+func (s *RefundService) ListRefundEvents(ctx context.Context, afterID int64, limit int) ([]models.RefundEvent, error) {
+	if limit <= 0 || limit > 500 {
+		limit = 100
+	}
+	return s.refundRepo.ListRefundEvents(ctx, afterID, limit)
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/carriers"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+const (
+	// maxCarrierEvents bounds the events of one carrier webhook call
+	maxCarrierEvents = 500
+	// maxOrderShipments bounds the shipments returned for an order
+	maxOrderShipments = 100
+	// shipmentPollBatch bounds the shipments polled per run
+	shipmentPollBatch = 200
+)
+
+// ShipmentService tracks the shipments of orders from the events carriers
+// push to the webhook endpoint or the trackers poll
+type ShipmentService struct {
+	shipmentRepo    repository.ShipmentRepository
+	fulfillmentRepo repository.FulfillmentRepository
+	trackers        *carriers.Registry
+	logger          *zap.Logger
+}
+
+// NewShipmentService creates a new shipment service. Carrier webhooks
+// authenticate with the integration keys of the fulfillment integration.
+func NewShipmentService(
+	shipmentRepo repository.ShipmentRepository,
+	fulfillmentRepo repository.FulfillmentRepository,
+	trackers *carriers.Registry,
+	logger *zap.Logger,
+) *ShipmentService {
+	return &ShipmentService{
+		shipmentRepo:    shipmentRepo,
+		fulfillmentRepo: fulfillmentRepo,
+		trackers:        trackers,
+		logger:          logger,
+	}
+}
+
+// CreateShipment registers a shipment of an order. Registering a known
+// carrier and tracking number again attaches the shipment to the given order
+// and customer instead of creating another one.
+func (s *ShipmentService) CreateShipment(ctx context.Context, shipment *models.Shipment, source string) (*models.Shipment, error) {
+	shipment.OrderReference = strings.TrimSpace(shipment.OrderReference)
+	shipment.Carrier = normalizeCarrier(shipment.Carrier)
+	shipment.TrackingNumber = strings.TrimSpace(shipment.TrackingNumber)
+	if shipment.OrderReference == "" || shipment.Carrier == "" || shipment.TrackingNumber == "" {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "order_reference, carrier and tracking_number are required")
+	}
+	if shipment.ShippedAt.IsZero() {
+		shipment.ShippedAt = time.Now().UTC()
+	}
+
+	if err := s.shipmentRepo.UpsertShipment(ctx, shipment); err != nil {
+		return nil, err
+	}
+	_, err := s.shipmentRepo.AddShipmentEvents(ctx, shipment.ID, []models.ShipmentEvent{{
+		Status:      models.ShipmentLabelCreated,
+		Description: "Shipment registered",
+		Source:      source,
+		OccurredAt:  shipment.ShippedAt,
+	}})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Shipment registered",
+		zap.String("id", shipment.ID),
+		zap.String("order_reference", shipment.OrderReference),
+		zap.String("carrier", shipment.Carrier))
+	return s.withEvents(ctx, shipment.ID)
+}
+
+// ListShipments retrieves a paginated list of shipments without their events
+func (s *ShipmentService) ListShipments(ctx context.Context, filter models.ShipmentFilter, page, limit int) ([]models.Shipment, int, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+	return s.shipmentRepo.ListShipments(ctx, filter, offset, limit)
+}
+
+// GetShipmentStatus returns a shipment, or all shipments of an order, with
+// their tracking events and the status across them. When userID is set only
+// the shipments of that customer are visible.
+func (s *ShipmentService) GetShipmentStatus(ctx context.Context, id, orderReference, userID string) (string, string, []models.Shipment, error) {
+	var shipments []models.Shipment
+	switch {
+	case id != "":
+		shipment, err := s.shipmentRepo.GetShipment(ctx, id)
+		if err != nil {
+			return "", "", nil, err
+		}
+		if userID != "" && shipment.UserID != userID {
+			return "", "", nil, models.ErrShipmentNotFound
+		}
+		shipments = []models.Shipment{*shipment}
+		orderReference = shipment.OrderReference
+	case orderReference != "":
+		var err error
+		shipments, _, err = s.shipmentRepo.ListShipments(ctx, models.ShipmentFilter{
+			OrderReference: orderReference,
+			UserID:         userID,
+		}, 0, maxOrderShipments)
+		if err != nil {
+			return "", "", nil, err
+		}
+		if len(shipments) == 0 {
+			return "", "", nil, models.ErrShipmentNotFound
+		}
+	default:
+		return "", "", nil, apperrors.New(apperrors.ErrInvalidArgument, "id or order_reference is required")
+	}
+
+	for i := range shipments {
+		events, err := s.shipmentRepo.ListShipmentEvents(ctx, shipments[i].ID)
+		if err != nil {
+			return "", "", nil, err
+		}
+		shipments[i].Events = events
+	}
+	return orderReference, models.AggregateShipmentStatus(shipments), shipments, nil
+}
+
+// ReceiveCarrierEvents authenticates a carrier by its API key and records
+// its tracking events. Each event succeeds or fails on its own; events of
+// unknown shipments are ignored. The returned key carries the usage of the
+// day, this push included.
+func (s *ShipmentService) ReceiveCarrierEvents(ctx context.Context, apiKey, carrier string, events []models.CarrierEvent) (*models.IntegrationKey, []models.CarrierEventResult, error) {
+	key, err := authenticateIntegrationKey(ctx, s.fulfillmentRepo, s.logger, apiKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	carrier = normalizeCarrier(carrier)
+	if normalizeCarrier(key.Provider) != carrier {
+		return nil, nil, apperrors.Errorf(apperrors.ErrPermissionDenied, "API key is not a key of carrier %s", carrier)
+	}
+	if len(events) > maxCarrierEvents {
+		return nil, nil, apperrors.Errorf(apperrors.ErrInvalidArgument, "at most %d events can be pushed at once", maxCarrierEvents)
+	}
+
+	results := make([]models.CarrierEventResult, 0, len(events))
+	for _, event := range events {
+		results = append(results, s.applyCarrierEvent(ctx, carrier, event))
+	}
+	return key, results, nil
+}
+
+func (s *ShipmentService) applyCarrierEvent(ctx context.Context, carrier string, event models.CarrierEvent) models.CarrierEventResult {
+	result := models.CarrierEventResult{TrackingNumber: event.TrackingNumber}
+	if event.TrackingNumber == "" {
+		result.Status, result.Error = models.CarrierEventFailed, "tracking_number is required"
+		return result
+	}
+	if !models.IsShipmentStatus(event.Status) {
+		result.Status, result.Error = models.CarrierEventFailed, "unknown status "+event.Status
+		return result
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+
+	shipment, err := s.shipmentRepo.GetShipmentByTrackingNumber(ctx, carrier, event.TrackingNumber)
+	if err != nil {
+		if errors.Is(err, models.ErrShipmentNotFound) {
+			result.Status = models.CarrierEventIgnored
+			return result
+		}
+		result.Status, result.Error = models.CarrierEventFailed, "failed to record event"
+		return result
+	}
+
+	added, err := s.shipmentRepo.AddShipmentEvents(ctx, shipment.ID, []models.ShipmentEvent{{
+		Status:      event.Status,
+		Description: event.Description,
+		Location:    event.Location,
+		Source:      models.ShipmentSourceWebhook,
+		OccurredAt:  event.OccurredAt,
+	}})
+	switch {
+	case err != nil:
+		result.Status, result.Error = models.CarrierEventFailed, "failed to record event"
+	case added == 0:
+		result.Status = models.CarrierEventDuplicate
+	default:
+		result.Status = models.CarrierEventApplied
+	}
+	return result
+}
+
+// StartTrackingPoller polls the carriers with a tracker for the events of
+// shipments under way at the given interval until the context is cancelled
+func (s *ShipmentService) StartTrackingPoller(ctx context.Context, interval time.Duration) {
+	polled := s.trackers.Carriers()
+	if len(polled) == 0 {
+		s.logger.Info("No carriers to poll for tracking events")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Shipment tracking poller stopped")
+				return
+			case <-ticker.C:
+				s.pollShipments(ctx, polled, interval)
+			}
+		}
+	}()
+}
+
+// pollShipments fetches the events of the shipments not polled within the
+// interval. Shipments are marked polled even when their carrier fails, so a
+// failing carrier is retried at the next interval rather than every run.
+func (s *ShipmentService) pollShipments(ctx context.Context, polled []string, interval time.Duration) {
+	shipments, err := s.shipmentRepo.ListShipmentsToPoll(ctx, polled, time.Now().Add(-interval), shipmentPollBatch)
+	if err != nil {
+		s.logger.Error("Scheduled shipment tracking poll failed", zap.Error(err))
+		return
+	}
+
+	for _, shipment := range shipments {
+		tenantCtx := tenant.WithTenant(ctx, shipment.TenantID)
+		events, err := s.trackers.Get(shipment.Carrier).Track(tenantCtx, shipment.TrackingNumber)
+		if err != nil {
+			s.logger.Warn("Failed to poll shipment tracking events",
+				zap.Error(err),
+				zap.String("carrier", shipment.Carrier),
+				zap.String("tracking_number", shipment.TrackingNumber))
+		} else {
+			for i := range events {
+				events[i].Source = models.ShipmentSourcePoll
+			}
+			if _, err := s.shipmentRepo.AddShipmentEvents(tenantCtx, shipment.ID, events); err != nil {
+				s.logger.Error("Failed to record polled tracking events", zap.Error(err), zap.String("shipment_id", shipment.ID))
+			}
+		}
+		if err := s.shipmentRepo.MarkShipmentPolled(tenantCtx, shipment.ID); err != nil {
+			s.logger.Error("Failed to mark shipment polled", zap.Error(err), zap.String("shipment_id", shipment.ID))
+		}
+	}
+}
+
+func (s *ShipmentService) withEvents(ctx context.Context, id string) (*models.Shipment, error) {
+	shipment, err := s.shipmentRepo.GetShipment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	shipment.Events, err = s.shipmentRepo.ListShipmentEvents(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return shipment, nil
+}
+
+// normalizeCarrier makes carrier names case insensitive
+func normalizeCarrier(carrier string) string {
+	return strings.ToLower(strings.TrimSpace(carrier))
+}
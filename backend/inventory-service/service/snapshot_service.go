@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// defaultStockHistoryDays is the range returned when no start date is requested
+const defaultStockHistoryDays = 30
+
+const (
+	defaultInventoryActivityLimit = 50
+	maxInventoryActivityLimit     = 200
+)
+
+// CreateDailySnapshot records the stock levels of all inventory items for the given day
+func (s *InventoryService) CreateDailySnapshot(ctx context.Context, snapshotDate time.Time) (int, error) {
+	count, err := s.inventoryRepo.CreateInventorySnapshots(ctx, snapshotDate)
+	if err != nil {
+		s.logger.Error("Failed to create inventory snapshot", zap.Error(err))
+		return 0, fmt.Errorf("failed to create inventory snapshot: %w", err)
+	}
+
+	s.logger.Info("Created inventory snapshot",
+		zap.String("snapshot_date", snapshotDate.UTC().Format("2006-01-02")),
+		zap.Int("items", count))
+
+	return count, nil
+}
+
+// StartSnapshotScheduler takes a daily inventory snapshot at the given UTC hour
+// until the context is cancelled
+func (s *InventoryService) StartSnapshotScheduler(ctx context.Context, hourUTC int) {
+	go func() {
+		for {
+			now := time.Now().UTC()
+			next := time.Date(now.Year(), now.Month(), now.Day(), hourUTC, 0, 0, 0, time.UTC)
+			if !next.After(now) {
+				next = next.AddDate(0, 0, 1)
+			}
+
+			timer := time.NewTimer(next.Sub(now))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				s.logger.Info("Inventory snapshot scheduler stopped")
+				return
+			case <-timer.C:
+				if _, err := s.CreateDailySnapshot(ctx, next); err != nil {
+					s.logger.Error("Scheduled inventory snapshot failed", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// GetStockHistory returns the daily stock levels of an inventory item between two dates,
+// optionally for a single warehouse, along with its average daily usage and days of cover
+func (s *InventoryService) GetStockHistory(ctx context.Context, id, productID, sku string, warehouseID *string, from, to time.Time) (*models.StockHistory, error) {
+	if to.IsZero() {
+		to = time.Now().UTC()
+	}
+	if from.IsZero() {
+		from = to.AddDate(0, 0, -defaultStockHistoryDays)
+	}
+	if from.After(to) {
+		return nil, models.ErrInvalidInput
+	}
+
+	item, err := s.GetInventoryItem(ctx, id, productID, sku)
+	if err != nil {
+		return nil, err
+	}
+
+	availableQty := item.AvailableQuantity
+	if warehouseID != nil {
+		locations, err := s.inventoryRepo.GetInventoryLocations(ctx, item.ID)
+		if err != nil {
+			s.logger.Error("Failed to get inventory locations", zap.Error(err), zap.String("inventory_item_id", item.ID))
+			return nil, fmt.Errorf("failed to get inventory locations: %w", err)
+		}
+
+		availableQty = 0
+		for _, location := range locations {
+			if location.WarehouseID == *warehouseID {
+				availableQty = location.AvailableQuantity
+				break
+			}
+		}
+	}
+
+	snapshots, err := s.inventoryRepo.GetInventorySnapshots(ctx, item.ID, warehouseID, from, to)
+	if err != nil {
+		s.logger.Error("Failed to get inventory snapshots", zap.Error(err), zap.String("inventory_item_id", item.ID))
+		return nil, fmt.Errorf("failed to get inventory snapshots: %w", err)
+	}
+
+	usage := models.CalculateAverageDailyUsage(snapshots)
+
+	return &models.StockHistory{
+		InventoryItemID:   item.ID,
+		WarehouseID:       warehouseID,
+		Snapshots:         snapshots,
+		AverageDailyUsage: usage,
+		DaysOfCover:       models.CalculateDaysOfCover(availableQty, usage),
+	}, nil
+}
+
+// ListInventoryActivity returns the latest stock movements of the store, or
+// those older than the movement at beforeTime with beforeID, newest first
+func (s *InventoryService) ListInventoryActivity(ctx context.Context, beforeTime time.Time, beforeID string, limit int) ([]models.InventoryActivity, error) {
+	if limit <= 0 {
+		limit = defaultInventoryActivityLimit
+	}
+	if limit > maxInventoryActivityLimit {
+		limit = maxInventoryActivityLimit
+	}
+	return s.inventoryRepo.ListInventoryActivity(ctx, beforeTime, beforeID, limit)
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// stockSubscriberBuffer is the number of changes queued per subscriber before
+// further changes are dropped for that subscriber
+const stockSubscriberBuffer = 64
+
+type stockSubscriber struct {
+	filter models.StockFilter
+	ch     chan models.StockChange
+}
+
+// StockBroker fans stock changes out to WatchInventory subscribers within
+// this instance. Slow subscribers miss changes instead of blocking writers.
+type StockBroker struct {
+	mu          sync.RWMutex
+	nextID      int
+	subscribers map[int]*stockSubscriber
+	logger      *zap.Logger
+}
+
+// NewStockBroker creates an empty broker
+func NewStockBroker(logger *zap.Logger) *StockBroker {
+	return &StockBroker{
+		subscribers: make(map[int]*stockSubscriber),
+		logger:      logger,
+	}
+}
+
+// Subscribe registers a subscriber and returns its channel together with a
+// function that unregisters it and closes the channel
+func (b *StockBroker) Subscribe(filter models.StockFilter) (<-chan models.StockChange, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := b.nextID
+	b.nextID++
+	sub := &stockSubscriber{
+		filter: filter,
+		ch:     make(chan models.StockChange, stockSubscriberBuffer),
+	}
+	b.subscribers[id] = sub
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscribers, id)
+			b.mu.Unlock()
+			close(sub.ch)
+		})
+	}
+}
+
+// HasSubscribers reports whether anyone is watching, so publishers can skip
+// building changes nobody will receive
+func (b *StockBroker) HasSubscribers() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers) > 0
+}
+
+// Publish delivers the change to every matching subscriber without blocking
+func (b *StockBroker) Publish(change models.StockChange) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for _, sub := range b.subscribers {
+		if !sub.filter.Matches(change) {
+			continue
+		}
+		select {
+		case sub.ch <- change:
+		default:
+			b.logger.Warn("Dropping stock change for slow subscriber",
+				zap.String("inventory_item_id", change.InventoryItemID))
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// SetStockBuffers sets the safety stock and max stock of an item at a
+// warehouse. A max stock of 0 means the location has no maximum.
+func (s *InventoryService) SetStockBuffers(ctx context.Context, inventoryItemID, warehouseID string, safetyStock, maxStock int) (*models.InventoryLocation, error) {
+	if inventoryItemID == "" || warehouseID == "" || safetyStock < 0 || maxStock < 0 {
+		return nil, models.ErrInvalidInput
+	}
+	if maxStock > 0 && maxStock < safetyStock {
+		return nil, models.ErrInvalidInput
+	}
+
+	location, err := s.inventoryRepo.SetLocationStockBuffers(ctx, inventoryItemID, warehouseID, safetyStock, maxStock)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, models.ErrNotFound
+		}
+		s.logger.Error("Failed to set stock buffers", zap.Error(err),
+			zap.String("inventory_item_id", inventoryItemID),
+			zap.String("warehouse_id", warehouseID))
+		return nil, fmt.Errorf("failed to set stock buffers: %w", err)
+	}
+
+	s.logger.Info("Stock buffers updated",
+		zap.String("inventory_item_id", inventoryItemID),
+		zap.String("warehouse_id", warehouseID),
+		zap.Int("safety_stock", safetyStock),
+		zap.Int("max_stock", maxStock))
+
+	// Sellable stock changed even though no units moved
+	s.publishStockChange(ctx, inventoryItemID, &warehouseID, models.StockChangeUpdated)
+	return location, nil
+}
+
+// ListStockAlerts returns the items and locations currently outside their
+// stock thresholds, optionally for a single warehouse
+func (s *InventoryService) ListStockAlerts(ctx context.Context, warehouseID string) ([]models.StockAlert, error) {
+	alerts, err := s.inventoryRepo.ListStockAlerts(ctx, warehouseID)
+	if err != nil {
+		s.logger.Error("Failed to list stock alerts", zap.Error(err))
+		return nil, fmt.Errorf("failed to list stock alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+// StartStockAlertScheduler checks stock levels at the given interval until the
+// context is cancelled. Each breach is logged once when it is first seen and
+// again after it has been resolved and reoccurs.
+func (s *InventoryService) StartStockAlertScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		active := make(map[string]bool)
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Stock alert scheduler stopped")
+				return
+			case <-ticker.C:
+				alerts, err := s.ListStockAlerts(ctx, "")
+				if err != nil {
+					s.logger.Error("Scheduled stock alert check failed", zap.Error(err))
+					continue
+				}
+				active = s.reportStockAlerts(alerts, active)
+			}
+		}
+	}()
+}
+
+// reportStockAlerts logs the alerts that were not active in the previous run
+// and returns the set of currently active alerts
+func (s *InventoryService) reportStockAlerts(alerts []models.StockAlert, previous map[string]bool) map[string]bool {
+	current := make(map[string]bool, len(alerts))
+	for _, alert := range alerts {
+		key := alert.Key()
+		current[key] = true
+		if previous[key] {
+			continue
+		}
+
+		fields := []zap.Field{
+			zap.String("type", alert.Type),
+			zap.String("inventory_item_id", alert.InventoryItemID),
+			zap.String("product_id", alert.ProductID),
+			zap.String("sku", alert.SKU),
+			zap.Int("quantity", alert.Quantity),
+			zap.Int("threshold", alert.Threshold),
+		}
+		if alert.WarehouseID != nil {
+			fields = append(fields, zap.String("warehouse_id", *alert.WarehouseID))
+		}
+		s.logger.Warn("Stock alert", fields...)
+	}
+
+	if resolved := len(previous) - countStillActive(previous, current); resolved > 0 {
+		s.logger.Info("Stock alerts resolved", zap.Int("count", resolved))
+	}
+	return current
+}
+
+func countStillActive(previous, current map[string]bool) int {
+	count := 0
+	for key := range previous {
+		if current[key] {
+			count++
+		}
+	}
+	return count
+}
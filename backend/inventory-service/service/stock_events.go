@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// WatchInventory subscribes to stock changes matching the filter. The returned
+// function must be called to release the subscription.
+func (s *InventoryService) WatchInventory(filter models.StockFilter) (<-chan models.StockChange, func()) {
+	return s.stockBroker.Subscribe(filter)
+}
+
+// CurrentStockLevels returns the current stock of the filtered items so that
+// watchers can render initial state before the first change arrives
+func (s *InventoryService) CurrentStockLevels(ctx context.Context, filter models.StockFilter) ([]models.StockChange, error) {
+	var changes []models.StockChange
+	for _, productID := range filter.ProductIDs {
+		item, err := s.inventoryRepo.GetInventoryItemByProductID(ctx, productID)
+		if err != nil {
+			if errors.Is(err, models.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+
+		change, err := s.buildStockChange(ctx, item, warehousePtr(filter.WarehouseID), models.StockChangeSnapshot)
+		if err != nil {
+			return nil, err
+		}
+		if filter.Matches(change) {
+			changes = append(changes, change)
+		}
+	}
+	return changes, nil
+}
+
+// publishStockChange reloads the item and publishes its stock levels. It is a
+// no-op when nobody is watching and never fails the calling operation.
+func (s *InventoryService) publishStockChange(ctx context.Context, inventoryItemID string, warehouseID *string, changeType string) {
+	if !s.stockBroker.HasSubscribers() {
+		return
+	}
+
+	item, err := s.inventoryRepo.GetInventoryItemByID(ctx, inventoryItemID)
+	if err != nil {
+		s.logger.Warn("Failed to load inventory item for stock change",
+			zap.Error(err),
+			zap.String("inventory_item_id", inventoryItemID))
+		return
+	}
+
+	change, err := s.buildStockChange(ctx, item, warehouseID, changeType)
+	if err != nil {
+		s.logger.Warn("Failed to load inventory locations for stock change",
+			zap.Error(err),
+			zap.String("inventory_item_id", inventoryItemID))
+		return
+	}
+	s.stockBroker.Publish(change)
+}
+
+func (s *InventoryService) buildStockChange(ctx context.Context, item *models.InventoryItem, warehouseID *string, changeType string) (models.StockChange, error) {
+	s.applyAvailability(ctx, item)
+	change := models.StockChange{
+		InventoryItemID:   item.ID,
+		ProductID:         item.ProductID,
+		VariantID:         item.VariantID,
+		SKU:               item.SKU,
+		TotalQuantity:     item.TotalQuantity,
+		AvailableQuantity: item.AvailableQuantity,
+		ReservedQuantity:  item.ReservedQuantity,
+		Status:            item.Status,
+		Availability:      item.Availability,
+		ChangeType:        changeType,
+		OccurredAt:        time.Now().UTC(),
+		TenantID:          tenant.FromContext(ctx),
+	}
+	if warehouseID == nil {
+		return change, nil
+	}
+
+	change.WarehouseID = warehouseID
+	locations, err := s.inventoryRepo.GetInventoryLocations(ctx, item.ID)
+	if err != nil {
+		return change, err
+	}
+	for _, location := range locations {
+		if location.WarehouseID == *warehouseID {
+			change.WarehouseQuantity = location.Quantity
+			change.WarehouseAvailableQuantity = location.AvailableQuantity
+			break
+		}
+	}
+	return change, nil
+}
+
+func warehousePtr(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
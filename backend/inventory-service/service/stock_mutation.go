@@ -0,0 +1,95 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// applyStockMutationOnce applies a stock mutation once per idempotency key.
+// mutate moves the stock and records the key with its result in the same
+// transaction, so a key is never taken by a mutation that was not applied. A
+// retry with the same key and request gets the location the first attempt
+// returned, and a key sent with another request gets ErrIdempotencyKeyReused.
+func (s *InventoryService) applyStockMutationOnce(ctx context.Context, idempotencyKey, operation string, request []any, mutate func(mutation *models.StockMutation) (*models.InventoryLocation, error)) (*models.InventoryLocation, error) {
+	if len(idempotencyKey) > models.MaxIdempotencyKeyLength {
+		return nil, models.ErrInvalidInput
+	}
+
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%s%q", operation, request)))
+	requestHash := hex.EncodeToString(hash[:])
+	recorded, err := s.inventoryRepo.GetStockMutation(ctx, idempotencyKey)
+	if err == nil {
+		return replayStockMutation(recorded, operation, requestHash)
+	}
+	if !errors.Is(err, models.ErrNotFound) {
+		return nil, err
+	}
+
+	location, err := mutate(&models.StockMutation{
+		IdempotencyKey: idempotencyKey,
+		Operation:      operation,
+		RequestHash:    requestHash,
+	})
+	if err != nil {
+		// A concurrent attempt with the key may have been applied since the
+		// lookup, making this one fail on the key or on the stock it moved
+		if recorded, lookupErr := s.inventoryRepo.GetStockMutation(ctx, idempotencyKey); lookupErr == nil {
+			return replayStockMutation(recorded, operation, requestHash)
+		}
+		return nil, err
+	}
+	return location, nil
+}
+
+// replayStockMutation returns the result recorded for a retried stock
+// mutation
+func replayStockMutation(mutation *models.StockMutation, operation, requestHash string) (*models.InventoryLocation, error) {
+	if mutation.Operation != operation || mutation.RequestHash != requestHash {
+		return nil, models.ErrIdempotencyKeyReused
+	}
+	// Keys recorded before results were stored with them have none
+	if mutation.Result == nil {
+		return nil, models.ErrStockMutationRecorded
+	}
+
+	var location models.InventoryLocation
+	if err := json.Unmarshal(mutation.Result, &location); err != nil {
+		return nil, fmt.Errorf("failed to decode stock mutation result: %w", err)
+	}
+	return &location, nil
+}
+
+// conflictRetries is the number of times a change refused for a concurrent
+// update is retried
+const conflictRetries = 4
+
+// retryOnConflict runs update, a read-modify-write of inventory rows, again
+// while it fails with ErrConcurrentUpdate, after a short random backoff so
+// that competing updates spread out. The last conflict is returned once the
+// retries are exhausted.
+func (s *InventoryService) retryOnConflict(ctx context.Context, update func() error) error {
+	for attempt := 0; ; attempt++ {
+		err := update()
+		if !errors.Is(err, models.ErrConcurrentUpdate) || attempt == conflictRetries {
+			return err
+		}
+		s.logger.Debug("Retrying inventory update after a concurrent change", zap.Int("attempt", attempt+1))
+
+		backoff := time.Duration(rand.Int63n(int64(10*time.Millisecond) << attempt))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
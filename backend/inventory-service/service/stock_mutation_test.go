@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+// mutationRepository records stock mutations in memory, as the repository
+// does when it applies them
+type mutationRepository struct {
+	repository.InventoryRepository
+	mutations map[string]*models.StockMutation
+}
+
+func (r *mutationRepository) GetStockMutation(_ context.Context, idempotencyKey string) (*models.StockMutation, error) {
+	mutation, ok := r.mutations[idempotencyKey]
+	if !ok {
+		return nil, models.ErrNotFound
+	}
+	return mutation, nil
+}
+
+func newMutationService() (*InventoryService, *mutationRepository) {
+	repo := &mutationRepository{mutations: map[string]*models.StockMutation{}}
+	return &InventoryService{inventoryRepo: repo, logger: zap.NewNop()}, repo
+}
+
+// addStock is a mutation adding quantity units to a location, recording its
+// key with the location
+func addStock(repo *mutationRepository, location *models.InventoryLocation, quantity int, applied *int) func(*models.StockMutation) (*models.InventoryLocation, error) {
+	return func(mutation *models.StockMutation) (*models.InventoryLocation, error) {
+		*applied++
+		location.Quantity += quantity
+		result := *location
+		encoded, err := json.Marshal(&result)
+		if err != nil {
+			return nil, err
+		}
+		mutation.Result = encoded
+		repo.mutations[mutation.IdempotencyKey] = mutation
+		return &result, nil
+	}
+}
+
+func TestApplyStockMutationOnceReplaysARetry(t *testing.T) {
+	s, repo := newMutationService()
+	ctx := context.Background()
+	location := &models.InventoryLocation{ID: "loc-1", Quantity: 10}
+	applied := 0
+	request := []any{"item-1", "wh-1", 5}
+
+	first, err := s.applyStockMutationOnce(ctx, "key-1", models.TransactionStockAddition, request, addStock(repo, location, 5, &applied))
+	if err != nil {
+		t.Fatal(err)
+	}
+	retry, err := s.applyStockMutationOnce(ctx, "key-1", models.TransactionStockAddition, request, addStock(repo, location, 5, &applied))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if applied != 1 {
+		t.Errorf("mutation applied %d times, want once", applied)
+	}
+	if first.Quantity != 15 || retry.Quantity != 15 || retry.ID != "loc-1" {
+		t.Errorf("retry = %+v, want the location of the first attempt, %+v", retry, first)
+	}
+}
+
+func TestApplyStockMutationOnceRejectsAReusedKey(t *testing.T) {
+	s, repo := newMutationService()
+	ctx := context.Background()
+	location := &models.InventoryLocation{ID: "loc-1", Quantity: 10}
+	applied := 0
+
+	if _, err := s.applyStockMutationOnce(ctx, "key-1", models.TransactionStockAddition, []any{"item-1", "wh-1", 5}, addStock(repo, location, 5, &applied)); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		operation string
+		request   []any
+	}{
+		{"other quantity", models.TransactionStockAddition, []any{"item-1", "wh-1", 6}},
+		{"other operation", models.TransactionStockRemoval, []any{"item-1", "wh-1", 5}},
+	}
+	for _, tt := range tests {
+		_, err := s.applyStockMutationOnce(ctx, "key-1", tt.operation, tt.request, addStock(repo, location, 5, &applied))
+		if !errors.Is(err, models.ErrIdempotencyKeyReused) {
+			t.Errorf("%s: err = %v, want ErrIdempotencyKeyReused", tt.name, err)
+		}
+	}
+	if applied != 1 || location.Quantity != 15 {
+		t.Errorf("mutation applied %d times, quantity %d, want once and 15", applied, location.Quantity)
+	}
+}
+
+func TestApplyStockMutationOnceReplaysAConcurrentAttempt(t *testing.T) {
+	s, repo := newMutationService()
+	ctx := context.Background()
+	location := &models.InventoryLocation{ID: "loc-1", Quantity: 10}
+	applied := 0
+	request := []any{"item-1", "wh-1", 5}
+
+	// Another attempt with the key commits between the lookup and this one
+	_, err := s.applyStockMutationOnce(ctx, "key-1", models.TransactionStockAddition, request, func(mutation *models.StockMutation) (*models.InventoryLocation, error) {
+		if _, err := addStock(repo, location, 5, &applied)(mutation); err != nil {
+			return nil, err
+		}
+		return nil, models.ErrStockMutationRecorded
+	})
+	if err != nil {
+		t.Fatalf("err = %v, want the result of the concurrent attempt", err)
+	}
+	if applied != 1 {
+		t.Errorf("mutation applied %d times, want once", applied)
+	}
+}
+
+func TestRetryOnConflict(t *testing.T) {
+	s, _ := newMutationService()
+	ctx := context.Background()
+
+	calls := 0
+	err := s.retryOnConflict(ctx, func() error {
+		calls++
+		if calls < 3 {
+			return models.ErrConcurrentUpdate
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("retryOnConflict = %v after %d calls, want success after 3", err, calls)
+	}
+
+	calls = 0
+	err = s.retryOnConflict(ctx, func() error {
+		calls++
+		return models.ErrConcurrentUpdate
+	})
+	if !errors.Is(err, models.ErrConcurrentUpdate) || calls != conflictRetries+1 {
+		t.Errorf("retryOnConflict = %v after %d calls, want ErrConcurrentUpdate after %d", err, calls, conflictRetries+1)
+	}
+
+	// Other errors are not retried
+	calls = 0
+	failed := errors.New("failed")
+	if err := s.retryOnConflict(ctx, func() error { calls++; return failed }); err != failed || calls != 1 {
+		t.Errorf("retryOnConflict = %v after %d calls, want the error after 1", err, calls)
+	}
+}
+
+func TestRetryOnConflictStopsWithTheContext(t *testing.T) {
+	s, _ := newMutationService()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := s.retryOnConflict(ctx, func() error {
+		calls++
+		return models.ErrConcurrentUpdate
+	})
+	if !errors.Is(err, context.Canceled) || calls > conflictRetries {
+		t.Errorf("retryOnConflict = %v after %d calls, want context.Canceled before the retries run out", err, calls)
+	}
+}
@@ -0,0 +1,261 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+const (
+	// defaultStoreRadiusKm and maxStoreRadiusKm bound the distance stores
+	// are searched within
+	defaultStoreRadiusKm = 50
+	maxStoreRadiusKm     = 500
+	defaultNearbyStores  = 10
+	maxNearbyStores      = 50
+	// defaultStoreHoldHours and maxStoreHoldHours bound how long stock
+	// reserved for in-store pickup is held
+	defaultStoreHoldHours = 48
+	maxStoreHoldHours     = 14 * 24
+	maxStorePhone         = 30
+)
+
+// StoreLocatorService manages retail stores, the warehouses customers can
+// visit, finds the stores near a position with the stock of a product at
+// each, and reserves stock at a store for customers to pick up. Pickup
+// reservations are ordinary reservations at the warehouse of the store,
+// held for its hold hours.
+type StoreLocatorService struct {
+	storeRepo        repository.RetailStoreRepository
+	inventoryService *InventoryService
+	logger           *zap.Logger
+}
+
+// NewStoreLocatorService creates a new store locator service
+func NewStoreLocatorService(
+	storeRepo repository.RetailStoreRepository,
+	inventoryService *InventoryService,
+	logger *zap.Logger,
+) *StoreLocatorService {
+	return &StoreLocatorService{
+		storeRepo:        storeRepo,
+		inventoryService: inventoryService,
+		logger:           logger,
+	}
+}
+
+// SaveStore makes a warehouse a retail store, or replaces its position,
+// opening hours and pickup settings. The timezone defaults to UTC and the
+// hold hours to 48.
+func (s *StoreLocatorService) SaveStore(ctx context.Context, store *models.RetailStore) (*models.RetailStore, error) {
+	if _, err := uuid.Parse(store.WarehouseID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+	}
+	if err := validatePosition(store.Latitude, store.Longitude); err != nil {
+		return nil, err
+	}
+
+	store.Timezone = strings.TrimSpace(store.Timezone)
+	if store.Timezone == "" {
+		store.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(store.Timezone); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, fmt.Sprintf("unknown timezone %q", store.Timezone))
+	}
+
+	store.Phone = strings.TrimSpace(store.Phone)
+	if len(store.Phone) > maxStorePhone {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument,
+			fmt.Sprintf("phone must be at most %d characters", maxStorePhone))
+	}
+
+	if store.HoldHours == 0 {
+		store.HoldHours = defaultStoreHoldHours
+	}
+	if store.HoldHours < 0 || store.HoldHours > maxStoreHoldHours {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument,
+			fmt.Sprintf("hold hours must be between 1 and %d", maxStoreHoldHours))
+	}
+
+	if store.OpeningHours == nil {
+		store.OpeningHours = []models.OpeningHours{}
+	}
+	for _, hours := range store.OpeningHours {
+		if hours.Weekday < time.Sunday || hours.Weekday > time.Saturday {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, "weekday must be between 0 (Sunday) and 6 (Saturday)")
+		}
+		opens, err := models.ParseClock(hours.Opens)
+		if err != nil {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, err.Error())
+		}
+		closes, err := models.ParseClock(hours.Closes)
+		if err != nil {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, err.Error())
+		}
+		if closes <= opens {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument,
+				fmt.Sprintf("%s opening hours must close after they open", hours.Weekday))
+		}
+	}
+
+	if err := s.storeRepo.SaveStore(ctx, store); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Retail store saved",
+		zap.String("warehouse_id", store.WarehouseID),
+		zap.Float64("latitude", store.Latitude),
+		zap.Float64("longitude", store.Longitude),
+		zap.Bool("pickup_enabled", store.PickupEnabled))
+	return store, nil
+}
+
+// DeleteStore stops a warehouse being a retail store; the warehouse and its
+// stock are kept
+func (s *StoreLocatorService) DeleteStore(ctx context.Context, warehouseID string) error {
+	if _, err := uuid.Parse(warehouseID); err != nil {
+		return apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+	}
+	if err := s.storeRepo.DeleteStore(ctx, warehouseID); err != nil {
+		return err
+	}
+	s.logger.Info("Retail store deleted", zap.String("warehouse_id", warehouseID))
+	return nil
+}
+
+// ListStores lists the retail stores, including those of inactive
+// warehouses, by name
+func (s *StoreLocatorService) ListStores(ctx context.Context) ([]models.RetailStore, error) {
+	return s.storeRepo.ListStores(ctx, false)
+}
+
+// FindNearbyStores returns the stores of active warehouses within radiusKm
+// of a position, nearest first. With a product ID each store carries the
+// sellable stock of the product there, in stock when it covers the quantity.
+func (s *StoreLocatorService) FindNearbyStores(ctx context.Context, latitude, longitude, radiusKm float64, productID string, quantity, limit int) ([]models.NearbyStore, error) {
+	if err := validatePosition(latitude, longitude); err != nil {
+		return nil, err
+	}
+	if radiusKm <= 0 {
+		radiusKm = defaultStoreRadiusKm
+	}
+	if radiusKm > maxStoreRadiusKm {
+		radiusKm = maxStoreRadiusKm
+	}
+	if limit <= 0 {
+		limit = defaultNearbyStores
+	}
+	if limit > maxNearbyStores {
+		limit = maxNearbyStores
+	}
+	if quantity <= 0 {
+		quantity = 1
+	}
+
+	// Untracked products are out of stock at every store
+	var stock map[string]int
+	if productID != "" {
+		stock = make(map[string]int)
+		item, err := s.inventoryService.GetInventoryItem(ctx, "", productID, "")
+		if err != nil && !errors.Is(err, models.ErrNotFound) {
+			return nil, err
+		}
+		if item != nil {
+			for i := range item.Locations {
+				stock[item.Locations[i].WarehouseID] = item.Locations[i].SellableQuantity()
+			}
+		}
+	}
+
+	stores, err := s.storeRepo.ListStores(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	nearby := make([]models.NearbyStore, 0, len(stores))
+	for i := range stores {
+		store := &stores[i]
+		distance := models.DistanceKm(latitude, longitude, store.Latitude, store.Longitude)
+		if distance > radiusKm {
+			continue
+		}
+		found := models.NearbyStore{
+			Store:      store,
+			DistanceKm: distance,
+			OpenNow:    store.IsOpen(now),
+		}
+		if stock != nil {
+			found.AvailableQuantity = stock[store.WarehouseID]
+			found.InStock = found.AvailableQuantity >= quantity
+		}
+		nearby = append(nearby, found)
+	}
+
+	sort.SliceStable(nearby, func(i, j int) bool { return nearby[i].DistanceKm < nearby[j].DistanceKm })
+	if len(nearby) > limit {
+		nearby = nearby[:limit]
+	}
+	return nearby, nil
+}
+
+// ReserveInStore reserves stock of a product at a store for a customer to
+// pick up, held for the hold hours of the store. The store must be active
+// and take pickup reservations.
+func (s *StoreLocatorService) ReserveInStore(ctx context.Context, warehouseID, productID string, quantity int, unit, referenceID string) (*models.InventoryReservation, *models.RetailStore, error) {
+	if _, err := uuid.Parse(warehouseID); err != nil {
+		return nil, nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+	}
+	if productID == "" {
+		return nil, nil, apperrors.New(apperrors.ErrInvalidArgument, "product ID is required")
+	}
+	if quantity <= 0 {
+		return nil, nil, models.ErrInvalidQuantity
+	}
+
+	store, err := s.storeRepo.GetStore(ctx, warehouseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !store.PickupEnabled || !store.Warehouse.IsActive {
+		return nil, nil, models.ErrPickupUnavailable
+	}
+
+	item, err := s.inventoryService.GetInventoryItem(ctx, "", productID, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, err := s.inventoryService.ReserveInventory(ctx, []models.ReservationItem{{
+		InventoryItemID: item.ID,
+		Quantity:        quantity,
+		WarehouseID:     &store.WarehouseID,
+		Unit:            unit,
+	}}, referenceID, models.ReferenceTypeStorePickup, store.HoldHours*60)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.logger.Info("Stock reserved for in-store pickup",
+		zap.String("reservation_id", reservation.ID),
+		zap.String("warehouse_id", warehouseID),
+		zap.String("product_id", productID),
+		zap.Int("quantity", reservation.Quantity))
+	return reservation, store, nil
+}
+
+func validatePosition(latitude, longitude float64) error {
+	if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+		return apperrors.New(apperrors.ErrInvalidArgument, "latitude must be between -90 and 90 and longitude between -180 and 180")
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+)
+
+// unitCodePattern matches the normalized codes of units of measure
+var unitCodePattern = regexp.MustCompile(`^[A-Z0-9_-]{1,20}$`)
+
+// SetUnit creates a unit of measure of an item, such as a case of 12, or
+// replaces its name and factor. Changing the factor applies to the purchase
+// orders still to be received in the unit.
+func (s *InventoryService) SetUnit(ctx context.Context, unit *models.InventoryUnit) (*models.InventoryUnit, error) {
+	if _, err := uuid.Parse(unit.InventoryItemID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid inventory item ID")
+	}
+	unit.Code = models.NormalizeUnitCode(unit.Code)
+	if unit.Code == models.BaseUnit {
+		return nil, apperrors.Errorf(apperrors.ErrInvalidArgument, "%s is the base unit of every item", models.BaseUnit)
+	}
+	if !unitCodePattern.MatchString(unit.Code) {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "code must be at most 20 letters, digits, dashes or underscores")
+	}
+	unit.Name = strings.TrimSpace(unit.Name)
+	if unit.Name == "" || len(unit.Name) > 100 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "name is required and must be at most 100 characters")
+	}
+	if unit.Factor < 2 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "factor must be at least 2 base units")
+	}
+
+	if err := s.unitRepo.SaveUnit(ctx, unit); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Unit of measure saved",
+		zap.String("inventory_item_id", unit.InventoryItemID),
+		zap.String("code", unit.Code),
+		zap.Int("factor", unit.Factor))
+	return unit, nil
+}
+
+// ListUnits lists the units of measure of an item, starting with the base
+// unit
+func (s *InventoryService) ListUnits(ctx context.Context, inventoryItemID string) ([]models.InventoryUnit, error) {
+	if _, err := uuid.Parse(inventoryItemID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid inventory item ID")
+	}
+	if _, err := s.inventoryRepo.GetInventoryItemByID(ctx, inventoryItemID); err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, models.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get inventory item: %w", err)
+	}
+
+	units, err := s.unitRepo.ListUnits(ctx, inventoryItemID)
+	if err != nil {
+		return nil, err
+	}
+	return append([]models.InventoryUnit{*models.BaseInventoryUnit(inventoryItemID)}, units...), nil
+}
+
+// DeleteUnit removes a unit of measure of an item, unless open purchase
+// orders are still to be received in it
+func (s *InventoryService) DeleteUnit(ctx context.Context, inventoryItemID, code string) error {
+	if _, err := uuid.Parse(inventoryItemID); err != nil {
+		return apperrors.New(apperrors.ErrInvalidArgument, "invalid inventory item ID")
+	}
+	code = models.NormalizeUnitCode(code)
+	if code == models.BaseUnit {
+		return apperrors.New(apperrors.ErrInvalidArgument, "the base unit cannot be deleted")
+	}
+	if err := s.unitRepo.DeleteUnit(ctx, inventoryItemID, code); err != nil {
+		return err
+	}
+	s.logger.Info("Unit of measure deleted",
+		zap.String("inventory_item_id", inventoryItemID),
+		zap.String("code", code))
+	return nil
+}
+
+// ResolveUnit returns the unit of measure of an item with the given code,
+// the base unit when the code is empty
+func (s *InventoryService) ResolveUnit(ctx context.Context, inventoryItemID, code string) (*models.InventoryUnit, error) {
+	code = models.NormalizeUnitCode(code)
+	if code == models.BaseUnit {
+		return models.BaseInventoryUnit(inventoryItemID), nil
+	}
+	return s.unitRepo.GetUnit(ctx, inventoryItemID, code)
+}
+
+// setMovementUnit records the unit of measure a movement was entered in,
+// unless it was the base unit
+func setMovementUnit(transaction *models.InventoryTransaction, unit *models.InventoryUnit, unitQuantity int) {
+	if unit.IsBase() {
+		return
+	}
+	transaction.UnitCode = &unit.Code
+	transaction.UnitQuantity = &unitQuantity
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -41,7 +42,7 @@ func (s *WarehouseService) CreateWarehouse(ctx context.Context, name, code, addr
 	if err == nil {
 		// Warehouse already exists
 		return nil, models.ErrAlreadyExists
-	} else if err != models.ErrNotFound {
+	} else if !errors.Is(err, models.ErrNotFound) {
 		// Unexpected error
 		s.logger.Error("Error checking for existing warehouse", zap.Error(err))
 		return nil, fmt.Errorf("error checking for existing warehouse: %w", err)
@@ -87,7 +88,7 @@ func (s *WarehouseService) GetWarehouse(ctx context.Context, id, code string) (*
 	}
 
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, models.ErrNotFound
 		}
 		s.logger.Error("Failed to get warehouse", zap.Error(err))
@@ -102,7 +103,7 @@ func (s *WarehouseService) UpdateWarehouse(ctx context.Context, id, name, addres
 	// Get the current warehouse
 	warehouse, err := s.warehouseRepo.GetWarehouseByID(ctx, id)
 	if err != nil {
-		if err == models.ErrNotFound {
+		if errors.Is(err, models.ErrNotFound) {
 			return nil, models.ErrNotFound
 		}
 		s.logger.Error("Failed to get warehouse for update", zap.Error(err), zap.String("id", id))
@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/models"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/repository"
+)
+
+const (
+	// defaultWaveOrders is the number of orders of a wave when not given
+	defaultWaveOrders = 50
+	// maxWaveOrders bounds the orders of a wave
+	maxWaveOrders = 500
+)
+
+// WaveService batches the pending orders of a warehouse into waves picked
+// together. A wave takes the confirmed order reservations at the warehouse
+// up to the cutoff of a carrier; its lines are picked along one consolidated
+// pick list, and completing it records the quantities picked and reports the
+// orders as picked to the order system. Reservations are left as they are:
+// stock leaves the warehouse when the order ships.
+type WaveService struct {
+	waveRepo        repository.WaveRepository
+	fulfillmentRepo repository.FulfillmentRepository
+	binService      *BinService
+	logger          *zap.Logger
+}
+
+// NewWaveService creates a new wave service
+func NewWaveService(
+	waveRepo repository.WaveRepository,
+	fulfillmentRepo repository.FulfillmentRepository,
+	binService *BinService,
+	logger *zap.Logger,
+) *WaveService {
+	return &WaveService{
+		waveRepo:        waveRepo,
+		fulfillmentRepo: fulfillmentRepo,
+		binService:      binService,
+		logger:          logger,
+	}
+}
+
+// CreateWave batches the pending orders selected by the filter into a new
+// wave, the oldest first. The cutoff defaults to now.
+func (s *WaveService) CreateWave(ctx context.Context, filter models.WaveFilter) (*models.Wave, error) {
+	if _, err := uuid.Parse(filter.WarehouseID); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid warehouse ID")
+	}
+	filter.Carrier = strings.TrimSpace(filter.Carrier)
+	if len(filter.Carrier) > 100 {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "carrier must be at most 100 characters")
+	}
+	if len(filter.OrderReferences) > maxWaveOrders {
+		return nil, apperrors.Errorf(apperrors.ErrInvalidArgument, "at most %d order references are allowed", maxWaveOrders)
+	}
+	if filter.CutoffAt.IsZero() {
+		filter.CutoffAt = time.Now().UTC()
+	}
+	if filter.MaxOrders <= 0 {
+		filter.MaxOrders = defaultWaveOrders
+	}
+	filter.MaxOrders = min(filter.MaxOrders, maxWaveOrders)
+
+	wave := &models.Wave{}
+	if err := s.waveRepo.CreateWave(ctx, wave, filter); err != nil {
+		return nil, err
+	}
+	s.logger.Info("Wave created",
+		zap.String("wave_id", wave.ID),
+		zap.String("warehouse_id", wave.WarehouseID),
+		zap.String("carrier", wave.Carrier),
+		zap.Time("cutoff_at", wave.CutoffAt))
+	return s.waveRepo.GetWave(ctx, wave.ID)
+}
+
+// GetWave retrieves a wave with its lines
+func (s *WaveService) GetWave(ctx context.Context, id string) (*models.Wave, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid wave ID")
+	}
+	return s.waveRepo.GetWave(ctx, id)
+}
+
+// ListWaves lists the waves, of one warehouse and in one status when given,
+// newest first
+func (s *WaveService) ListWaves(ctx context.Context, warehouseID, status string, page, limit int) ([]models.Wave, int, error) {
+	switch status {
+	case "", models.WaveOpen, models.WaveCompleted, models.WaveCancelled:
+	default:
+		return nil, 0, apperrors.Errorf(apperrors.ErrInvalidArgument, "unknown wave status %q", status)
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := (page - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+	return s.waveRepo.ListWaves(ctx, warehouseID, status, offset, limit)
+}
+
+// GenerateWavePickList plans the picks of the lines of an open wave, the
+// lines of all its orders consolidated by item
+func (s *WaveService) GenerateWavePickList(ctx context.Context, id string) (*models.PickList, error) {
+	wave, err := s.GetWave(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if wave.Status != models.WaveOpen {
+		return nil, models.ErrWaveNotOpen
+	}
+
+	lines := make([]models.PickListLine, 0, len(wave.Lines))
+	for _, line := range wave.Lines {
+		lines = append(lines, models.PickListLine{InventoryItemID: line.InventoryItemID, Quantity: line.Quantity})
+	}
+	return s.binService.GeneratePickList(ctx, wave.WarehouseID, lines)
+}
+
+// CompleteWave records the quantities picked of the lines of an open wave,
+// lines not given being picked in full, and reports its orders as picked, or
+// partially picked when a line was picked short
+func (s *WaveService) CompleteWave(ctx context.Context, id string, picked []models.PickedLine) (*models.Wave, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid wave ID")
+	}
+	seen := make(map[string]bool, len(picked))
+	for _, line := range picked {
+		if _, err := uuid.Parse(line.LineID); err != nil {
+			return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid line ID")
+		}
+		if line.PickedQuantity < 0 {
+			return nil, models.ErrInvalidQuantity
+		}
+		if seen[line.LineID] {
+			return nil, apperrors.Errorf(apperrors.ErrInvalidArgument, "line %s is given twice", line.LineID)
+		}
+		seen[line.LineID] = true
+	}
+
+	wave, err := s.waveRepo.CompleteWave(ctx, id, picked)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Wave completed", zap.String("wave_id", wave.ID), zap.Int("orders", wave.Orders))
+
+	// Report each order once; the wave is complete, so a failure here only
+	// delays the status in the order system
+	statuses := make(map[string]string)
+	var orders []string
+	for _, line := range wave.Lines {
+		if _, ok := statuses[line.OrderReference]; !ok {
+			orders = append(orders, line.OrderReference)
+			statuses[line.OrderReference] = models.OrderStatusPicked
+		}
+		if line.Status == models.WaveLineShort {
+			statuses[line.OrderReference] = models.OrderStatusPartiallyPicked
+		}
+	}
+	for _, order := range orders {
+		err := s.fulfillmentRepo.CreateOrderStatusEvent(ctx, &models.OrderStatusEvent{
+			OrderReference: order,
+			Status:         statuses[order],
+			Provider:       models.WaveProvider,
+			Carrier:        wave.Carrier,
+			OccurredAt:     *wave.CompletedAt,
+		})
+		if err != nil {
+			s.logger.Error("Failed to record order status of wave", zap.Error(err),
+				zap.String("wave_id", wave.ID), zap.String("order_reference", order))
+		}
+	}
+	return wave, nil
+}
+
+// CancelWave cancels an open wave, so that its orders are batched into later
+// waves
+func (s *WaveService) CancelWave(ctx context.Context, id string) (*models.Wave, error) {
+	if _, err := uuid.Parse(id); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, "invalid wave ID")
+	}
+	wave, err := s.waveRepo.CancelWave(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info("Wave cancelled", zap.String("wave_id", wave.ID))
+	return wave, nil
+}
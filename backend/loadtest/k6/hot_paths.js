@@ -0,0 +1,83 @@
+// Load profile for the catalog and inventory hot paths.
+//
+//   k6 run -e BASE_URL=http://localhost:8080 -e PRODUCT_ID=<id> loadtest/k6/hot_paths.js
+//
+// PRODUCT_ID and SKUS (comma separated) should point at seeded data;
+// without PRODUCT_ID the first product of the list is used.
+
+import http from 'k6/http';
+import { check } from 'k6';
+
+const BASE_URL = __ENV.BASE_URL || 'http://localhost:8080';
+const TENANT = __ENV.TENANT_ID || 'default';
+const SKUS = (__ENV.SKUS || '').split(',').filter((s) => s !== '');
+
+const headers = { 'X-Tenant-ID': TENANT, 'Content-Type': 'application/json' };
+
+export const options = {
+  scenarios: {
+    list_products: {
+      executor: 'constant-arrival-rate',
+      exec: 'listProducts',
+      rate: 50,
+      timeUnit: '1s',
+      duration: __ENV.DURATION || '1m',
+      preAllocatedVUs: 20,
+    },
+    get_product: {
+      executor: 'constant-arrival-rate',
+      exec: 'getProduct',
+      rate: 100,
+      timeUnit: '1s',
+      duration: __ENV.DURATION || '1m',
+      preAllocatedVUs: 20,
+    },
+    check_availability: {
+      executor: 'constant-arrival-rate',
+      exec: 'checkAvailability',
+      rate: 50,
+      timeUnit: '1s',
+      duration: __ENV.DURATION || '1m',
+      preAllocatedVUs: 20,
+    },
+  },
+  thresholds: {
+    'http_req_failed': ['rate<0.01'],
+    'http_req_duration{scenario:list_products}': ['p(95)<300'],
+    'http_req_duration{scenario:get_product}': ['p(95)<150'],
+    'http_req_duration{scenario:check_availability}': ['p(95)<200'],
+  },
+};
+
+export function setup() {
+  let productId = __ENV.PRODUCT_ID;
+  let skus = SKUS;
+  if (!productId || skus.length === 0) {
+    const res = http.get(`${BASE_URL}/api/v1/products?page=1&limit=20`, { headers });
+    const products = res.json('products') || [];
+    if (!productId && products.length > 0) {
+      productId = products[0].id;
+    }
+    if (skus.length === 0) {
+      skus = products.map((p) => p.sku).filter((s) => s);
+    }
+  }
+  return { productId, skus: skus.slice(0, 10) };
+}
+
+export function listProducts() {
+  const page = 1 + Math.floor(Math.random() * 5);
+  const res = http.get(`${BASE_URL}/api/v1/products?page=${page}&limit=20`, { headers });
+  check(res, { 'list products 200': (r) => r.status === 200 });
+}
+
+export function getProduct(data) {
+  const res = http.get(`${BASE_URL}/api/v1/products/${data.productId}`, { headers });
+  check(res, { 'get product 200': (r) => r.status === 200 });
+}
+
+export function checkAvailability(data) {
+  const body = JSON.stringify({ items: data.skus.map((sku) => ({ sku, quantity: 1 })) });
+  const res = http.post(`${BASE_URL}/api/v1/inventory/check-bulk`, body, { headers });
+  check(res, { 'check availability 200': (r) => r.status === 200 });
+}
@@ -0,0 +1,175 @@
+// Package attributes applies the attributes categories define for their
+// products, such as "Screen Size" for TVs. A category inherits the
+// attributes of its ancestors, a definition of the same name nearer to the
+// category overriding the inherited one. Products created in a category must
+// give a value to its required attributes, among the allowed values when the
+// definition restricts them, and the filterable attributes are offered as
+// facets of the category.
+package attributes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Definition is an attribute defined by a category
+type Definition struct {
+	Name string
+	// Required attributes must have a value on the products of the category
+	Required bool
+	// Filterable attributes are offered as facets
+	Filterable bool
+	// AllowedValues restricts the values of the attribute; empty allows any
+	// value
+	AllowedValues []string
+	// DefaultValue is given to products created without a value
+	DefaultValue string
+	// Depth is the distance from the category the definition is applied to
+	// to the category defining it: 0 for its own definitions, 1 for those of
+	// its parent and so on
+	Depth int
+}
+
+// Value is the value of an attribute of a product
+type Value struct {
+	Name  string
+	Value string
+}
+
+// Key returns the name attributes are compared by, ignoring case and
+// surrounding spaces
+func Key(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// Resolve returns the definitions that apply, the nearest definition of each
+// name overriding the others. The definitions keep the order of the first
+// definition of their name, so passing them from the root category down
+// lists inherited attributes first.
+func Resolve(definitions []Definition) []Definition {
+	resolved := make([]Definition, 0, len(definitions))
+	index := make(map[string]int, len(definitions))
+	for _, definition := range definitions {
+		key := Key(definition.Name)
+		if i, ok := index[key]; ok {
+			if definition.Depth < resolved[i].Depth {
+				resolved[i] = definition
+			}
+			continue
+		}
+		index[key] = len(resolved)
+		resolved = append(resolved, definition)
+	}
+	return resolved
+}
+
+// ValidationError lists the attribute values of a product that break the
+// definitions of its categories
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid product attributes: " + strings.Join(e.Problems, "; ")
+}
+
+// Apply checks the values of a product against resolved definitions. It
+// returns the values with the allowed spelling of restricted values and the
+// default values of the attributes missing from the product, or a
+// *ValidationError. Values of attributes without a definition are kept.
+func Apply(definitions []Definition, values []Value) ([]Value, error) {
+	applied := make([]Value, 0, len(values)+len(definitions))
+	given := make(map[string]bool, len(values))
+	for _, value := range values {
+		given[Key(value.Name)] = strings.TrimSpace(value.Value) != ""
+	}
+
+	var problems []string
+	byKey := make(map[string]Definition, len(definitions))
+	for _, definition := range definitions {
+		byKey[Key(definition.Name)] = definition
+	}
+	for _, value := range values {
+		definition, ok := byKey[Key(value.Name)]
+		if ok && len(definition.AllowedValues) > 0 && strings.TrimSpace(value.Value) != "" {
+			allowed, found := match(definition.AllowedValues, value.Value)
+			if !found {
+				problems = append(problems, fmt.Sprintf("%q must be one of %s", definition.Name, strings.Join(definition.AllowedValues, ", ")))
+				continue
+			}
+			value.Value = allowed
+		}
+		applied = append(applied, value)
+	}
+
+	for _, definition := range definitions {
+		if given[Key(definition.Name)] {
+			continue
+		}
+		if definition.DefaultValue != "" {
+			applied = append(applied, Value{Name: definition.Name, Value: definition.DefaultValue})
+			continue
+		}
+		if definition.Required {
+			problems = append(problems, fmt.Sprintf("%q is required", definition.Name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+	return applied, nil
+}
+
+// match returns the allowed value equal to value, ignoring case
+func match(allowed []string, value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	for _, candidate := range allowed {
+		if strings.EqualFold(candidate, value) {
+			return candidate, true
+		}
+	}
+	return "", false
+}
+
+// ValueCount is the number of products with a value of an attribute
+type ValueCount struct {
+	Name  string
+	Value string
+	Count int
+}
+
+// Facet is a filterable attribute with the number of products per value
+type Facet struct {
+	Name   string
+	Values []ValueCount
+}
+
+// Facets builds the facets of the filterable definitions from the value
+// counts of the products, in the order of the definitions. Values are
+// ordered by decreasing count, then by value; attributes without values
+// have no facet.
+func Facets(definitions []Definition, counts []ValueCount) []Facet {
+	byKey := make(map[string][]ValueCount)
+	for _, count := range counts {
+		key := Key(count.Name)
+		byKey[key] = append(byKey[key], count)
+	}
+
+	facets := make([]Facet, 0, len(definitions))
+	for _, definition := range definitions {
+		values := byKey[Key(definition.Name)]
+		if !definition.Filterable || len(values) == 0 {
+			continue
+		}
+		sort.Slice(values, func(i, j int) bool {
+			if values[i].Count != values[j].Count {
+				return values[i].Count > values[j].Count
+			}
+			return values[i].Value < values[j].Value
+		})
+		facets = append(facets, Facet{Name: definition.Name, Values: values})
+	}
+	return facets
+}
@@ -0,0 +1,99 @@
+package attributes
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	definitions := []Definition{
+		{Name: "Screen Size", Required: true, Depth: 1},
+		{Name: "Brand Line", Depth: 1},
+		{Name: "screen size ", AllowedValues: []string{"55in", "65in"}, Depth: 0},
+	}
+
+	resolved := Resolve(definitions)
+	want := []Definition{
+		{Name: "screen size ", AllowedValues: []string{"55in", "65in"}, Depth: 0},
+		{Name: "Brand Line", Depth: 1},
+	}
+	if !reflect.DeepEqual(resolved, want) {
+		t.Errorf("Resolve() = %+v, want %+v", resolved, want)
+	}
+}
+
+func TestApply(t *testing.T) {
+	definitions := []Definition{
+		{Name: "Screen Size", Required: true, AllowedValues: []string{"55in", "65in"}},
+		{Name: "Panel", DefaultValue: "LED"},
+		{Name: "Warranty", Required: true},
+	}
+
+	tests := []struct {
+		name     string
+		values   []Value
+		want     []Value
+		problems []string
+	}{
+		{
+			name:   "valid",
+			values: []Value{{"screen size", "65IN"}, {"Warranty", "2 years"}, {"Color", "Black"}},
+			want:   []Value{{"screen size", "65in"}, {"Warranty", "2 years"}, {"Color", "Black"}, {"Panel", "LED"}},
+		},
+		{
+			name:     "missing required",
+			values:   []Value{{"Screen Size", "55in"}, {"Warranty", " "}},
+			problems: []string{`"Warranty" is required`},
+		},
+		{
+			name:     "value not allowed",
+			values:   []Value{{"Screen Size", "40in"}, {"Warranty", "1 year"}},
+			problems: []string{`"Screen Size" must be one of 55in, 65in`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Apply(definitions, tt.values)
+			if tt.problems != nil {
+				var validationErr *ValidationError
+				if !errors.As(err, &validationErr) || !reflect.DeepEqual(validationErr.Problems, tt.problems) {
+					t.Fatalf("Apply() error = %v, want problems %v", err, tt.problems)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Apply() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFacets(t *testing.T) {
+	definitions := []Definition{
+		{Name: "Screen Size", Filterable: true},
+		{Name: "Warranty"},
+		{Name: "Panel", Filterable: true},
+		{Name: "Refresh Rate", Filterable: true},
+	}
+	counts := []ValueCount{
+		{"panel", "LED", 2},
+		{"Screen Size", "55in", 3},
+		{"Panel", "OLED", 4},
+		{"Screen Size", "65in", 3},
+		{"Warranty", "2 years", 7},
+	}
+
+	facets := Facets(definitions, counts)
+	want := []Facet{
+		{Name: "Screen Size", Values: []ValueCount{{"Screen Size", "55in", 3}, {"Screen Size", "65in", 3}}},
+		{Name: "Panel", Values: []ValueCount{{"Panel", "OLED", 4}, {"panel", "LED", 2}}},
+	}
+	if !reflect.DeepEqual(facets, want) {
+		t.Errorf("Facets() = %+v, want %+v", facets, want)
+	}
+}
@@ -0,0 +1,231 @@
+// Package badges decides which display badges, such as "New", "Sale" or
+// "Bestseller", a product shows, from the badge rules of the store and facts
+// about the product and its sales.
+package badges
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"time"
+)
+
+// Kinds of badge rules
+const (
+	// KindNew matches products created within the last Days days
+	KindNew = "new"
+	// KindSale matches products with an active discount
+	KindSale = "sale"
+	// KindBestseller matches the products in the top Percent percent of the
+	// store by units sold
+	KindBestseller = "bestseller"
+	// KindManual matches the products listed by the rule
+	KindManual = "manual"
+)
+
+// MaxDays bounds the age of the products matched by KindNew rules
+const MaxDays = 365
+
+var codePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,49}$`)
+
+// Rule is a badge of the store and the products it is shown on. Badges are
+// shown by ascending Priority.
+type Rule struct {
+	Code       string
+	Label      string
+	Color      string
+	Kind       string
+	Days       int
+	Percent    float64
+	ProductIDs []string
+	Priority   int
+	Active     bool
+}
+
+// Defaults are the built-in badges of every store, which a store can
+// override by saving a rule of the same code
+var Defaults = []Rule{
+	{Code: "sale", Label: "Sale", Color: "#dc2626", Kind: KindSale, Priority: 10, Active: true},
+	{Code: "bestseller", Label: "Bestseller", Color: "#d97706", Kind: KindBestseller, Percent: 5, Priority: 20, Active: true},
+	{Code: "new", Label: "New", Color: "#2563eb", Kind: KindNew, Days: 30, Priority: 30, Active: true},
+}
+
+// IsBuiltIn reports whether code is the code of a default badge
+func IsBuiltIn(code string) bool {
+	for _, rule := range Defaults {
+		if rule.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+// IsKind reports whether kind is a known kind of badge rule
+func IsKind(kind string) bool {
+	switch kind {
+	case KindNew, KindSale, KindBestseller, KindManual:
+		return true
+	}
+	return false
+}
+
+// Validate checks that a rule is complete for its kind
+func Validate(rule Rule) error {
+	if !codePattern.MatchString(rule.Code) {
+		return errors.New("code must be 1 to 50 lowercase letters, digits, dashes or underscores")
+	}
+	if rule.Label == "" || len(rule.Label) > 50 {
+		return errors.New("label must be 1 to 50 characters")
+	}
+	switch rule.Kind {
+	case KindNew:
+		if rule.Days < 1 || rule.Days > MaxDays {
+			return fmt.Errorf("days must be between 1 and %d", MaxDays)
+		}
+	case KindBestseller:
+		if rule.Percent <= 0 || rule.Percent > 100 {
+			return errors.New("percent must be greater than 0 and at most 100")
+		}
+	case KindManual:
+		if len(rule.ProductIDs) == 0 {
+			return errors.New("manual badges need at least one product")
+		}
+	case KindSale:
+	default:
+		return fmt.Errorf("unknown badge kind %q", rule.Kind)
+	}
+	return nil
+}
+
+// Effective returns the default badges replaced by the overrides of the
+// same code, followed by the other overrides, in display order
+func Effective(overrides []Rule) []Rule {
+	byCode := make(map[string]Rule, len(overrides))
+	for _, rule := range overrides {
+		byCode[rule.Code] = rule
+	}
+
+	rules := make([]Rule, 0, len(Defaults)+len(overrides))
+	for _, rule := range Defaults {
+		if override, ok := byCode[rule.Code]; ok {
+			rule = override
+			delete(byCode, rule.Code)
+		}
+		rules = append(rules, rule)
+	}
+	for _, rule := range overrides {
+		if _, ok := byCode[rule.Code]; ok {
+			rules = append(rules, rule)
+		}
+	}
+
+	sort.SliceStable(rules, func(i, j int) bool {
+		if rules[i].Priority != rules[j].Priority {
+			return rules[i].Priority < rules[j].Priority
+		}
+		return rules[i].Code < rules[j].Code
+	})
+	return rules
+}
+
+// Facts holds what the rules know about a product
+type Facts struct {
+	ProductID string
+	CreatedAt time.Time
+	OnSale    bool
+	// UnitsSold counts the units sold over the sales window of the store
+	UnitsSold int
+}
+
+// Evaluator matches products against the rules of a store
+type Evaluator struct {
+	rules      []Rule
+	now        time.Time
+	thresholds map[string]int
+	manual     map[string]map[string]bool
+}
+
+// NewEvaluator prepares the active rules for the products of a store at
+// now. Facts must cover every product of the store, as bestsellers are
+// ranked against all of them.
+func NewEvaluator(rules []Rule, facts []Facts, now time.Time) *Evaluator {
+	e := &Evaluator{
+		now:        now,
+		thresholds: make(map[string]int),
+		manual:     make(map[string]map[string]bool),
+	}
+
+	var units []int
+	for _, rule := range rules {
+		if !rule.Active {
+			continue
+		}
+		e.rules = append(e.rules, rule)
+
+		switch rule.Kind {
+		case KindBestseller:
+			if units == nil {
+				units = make([]int, len(facts))
+				for i, f := range facts {
+					units[i] = f.UnitsSold
+				}
+				sort.Sort(sort.Reverse(sort.IntSlice(units)))
+			}
+			e.thresholds[rule.Code] = Threshold(units, rule.Percent)
+		case KindManual:
+			products := make(map[string]bool, len(rule.ProductIDs))
+			for _, id := range rule.ProductIDs {
+				products[id] = true
+			}
+			e.manual[rule.Code] = products
+		}
+	}
+	return e
+}
+
+// Threshold returns the units a product must have sold to be in the top
+// percent of units, sorted in descending order. Products tied at the
+// threshold all make it, and products without sales never do.
+func Threshold(units []int, percent float64) int {
+	if len(units) == 0 {
+		return 1
+	}
+	n := int(math.Ceil(float64(len(units)) * percent / 100))
+	if n < 1 {
+		n = 1
+	}
+	if n > len(units) {
+		n = len(units)
+	}
+	if units[n-1] < 1 {
+		return 1
+	}
+	return units[n-1]
+}
+
+// Badges returns the codes of the badges of a product, in display order
+func (e *Evaluator) Badges(f Facts) []string {
+	codes := []string{}
+	for _, rule := range e.rules {
+		if e.matches(rule, f) {
+			codes = append(codes, rule.Code)
+		}
+	}
+	return codes
+}
+
+func (e *Evaluator) matches(rule Rule, f Facts) bool {
+	switch rule.Kind {
+	case KindNew:
+		return !f.CreatedAt.Before(e.now.AddDate(0, 0, -rule.Days))
+	case KindSale:
+		return f.OnSale
+	case KindBestseller:
+		return f.UnitsSold >= e.thresholds[rule.Code]
+	case KindManual:
+		return e.manual[rule.Code][f.ProductID]
+	}
+	return false
+}
@@ -0,0 +1,100 @@
+package badges
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBadges(t *testing.T) {
+	now := time.Date(2026, time.June, 1, 12, 0, 0, 0, time.UTC)
+	facts := []Facts{
+		{ProductID: "a", CreatedAt: now.AddDate(0, 0, -3), UnitsSold: 40},
+		{ProductID: "b", CreatedAt: now.AddDate(0, -6, 0), OnSale: true, UnitsSold: 2},
+		{ProductID: "c", CreatedAt: now.AddDate(0, 0, -30), OnSale: true},
+	}
+	for i := 0; i < 17; i++ {
+		facts = append(facts, Facts{ProductID: "filler", CreatedAt: now.AddDate(-1, 0, 0), UnitsSold: 1})
+	}
+	rules := Effective([]Rule{
+		{Code: "eco", Label: "Eco", Kind: KindManual, ProductIDs: []string{"b"}, Priority: 5, Active: true},
+	})
+
+	e := NewEvaluator(rules, facts, now)
+	tests := []struct {
+		facts Facts
+		want  []string
+	}{
+		{facts[0], []string{"bestseller", "new"}},
+		{facts[1], []string{"eco", "sale"}},
+		{facts[2], []string{"sale", "new"}},
+		{facts[3], []string{}},
+	}
+	for _, tt := range tests {
+		if got := e.Badges(tt.facts); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Badges(%s) = %v, want %v", tt.facts.ProductID, got, tt.want)
+		}
+	}
+}
+
+func TestEffectiveOverrides(t *testing.T) {
+	rules := Effective([]Rule{
+		{Code: "sale", Label: "Deal", Kind: KindSale, Priority: 40, Active: false},
+	})
+	if len(rules) != len(Defaults) {
+		t.Fatalf("Effective returned %d rules, want %d", len(rules), len(Defaults))
+	}
+	if last := rules[len(rules)-1]; last.Label != "Deal" || last.Active {
+		t.Errorf("overridden sale badge = %+v, want the inactive Deal badge last", last)
+	}
+
+	e := NewEvaluator(rules, []Facts{{ProductID: "a", OnSale: true}}, time.Now())
+	if got := e.Badges(Facts{ProductID: "a", OnSale: true}); len(got) != 0 {
+		t.Errorf("Badges with the sale badge off = %v, want none", got)
+	}
+}
+
+func TestThreshold(t *testing.T) {
+	tests := []struct {
+		units   []int
+		percent float64
+		want    int
+	}{
+		{nil, 5, 1},
+		{[]int{9, 5, 5, 1}, 50, 5},
+		{[]int{9, 5, 5, 1}, 1, 9},
+		{[]int{0, 0}, 100, 1},
+	}
+	for _, tt := range tests {
+		if got := Threshold(tt.units, tt.percent); got != tt.want {
+			t.Errorf("Threshold(%v, %v) = %d, want %d", tt.units, tt.percent, got, tt.want)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := []Rule{
+		{Code: "new", Label: "New", Kind: KindNew, Days: 14},
+		{Code: "top-10", Label: "Top", Kind: KindBestseller, Percent: 10},
+		{Code: "eco", Label: "Eco", Kind: KindManual, ProductIDs: []string{"a"}},
+	}
+	for _, rule := range valid {
+		if err := Validate(rule); err != nil {
+			t.Errorf("Validate(%+v) = %v, want nil", rule, err)
+		}
+	}
+
+	invalid := []Rule{
+		{Code: "New Arrivals", Label: "New", Kind: KindNew, Days: 14},
+		{Code: "new", Kind: KindNew, Days: 14},
+		{Code: "new", Label: "New", Kind: KindNew, Days: MaxDays + 1},
+		{Code: "top", Label: "Top", Kind: KindBestseller},
+		{Code: "eco", Label: "Eco", Kind: KindManual},
+		{Code: "hot", Label: "Hot", Kind: "trending"},
+	}
+	for _, rule := range invalid {
+		if err := Validate(rule); err == nil {
+			t.Errorf("Validate(%+v) = nil, want an error", rule)
+		}
+	}
+}
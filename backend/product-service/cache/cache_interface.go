@@ -37,6 +37,7 @@ type CacheInterface interface {
 	InvalidateProductAndRelated(ctx context.Context, productID string) error
 	InvalidateByPattern(ctx context.Context, pattern string) error
 	InvalidateProductsByCategory(ctx context.Context, categoryID string) error
+	FlushNamespace(ctx context.Context, namespace string) error
 	Close() error
 	HealthCheck(ctx context.Context) error
 	GetCacheStats(ctx context.Context) (map[string]interface{}, error)
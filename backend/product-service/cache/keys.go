@@ -0,0 +1,78 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+)
+
+// Cache keys are laid out as tenant:<tenant>:<namespace>:v<version>:<rest>,
+// e.g. tenant:default:product:v1:list:<filter>. The namespace is the first
+// segment of the keys built by the cache managers.
+const (
+	NamespaceProduct  = "product"
+	NamespaceCategory = "category"
+	NamespaceBrand    = "brand"
+)
+
+// namespaceVersions holds the schema version of each namespace. Bump the
+// version of a namespace when a change to its cached structs would make
+// entries written by the previous release fail to decode or decode wrongly:
+// after the deploy, reads and writes move to fresh keys and the old entries
+// expire with their TTL.
+var namespaceVersions = map[string]int{
+	NamespaceProduct:  1,
+	NamespaceCategory: 1,
+	NamespaceBrand:    1,
+}
+
+// ErrUnknownNamespace is returned for namespaces without a version
+var ErrUnknownNamespace = fmt.Errorf("unknown cache namespace")
+
+// Namespaces returns the cache namespaces in alphabetical order
+func Namespaces() []string {
+	names := make([]string, 0, len(namespaceVersions))
+	for name := range namespaceVersions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NamespaceVersion returns the current schema version of a namespace
+func NamespaceVersion(namespace string) (int, error) {
+	version, ok := namespaceVersions[namespace]
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrUnknownNamespace, namespace)
+	}
+	return version, nil
+}
+
+// versionedKey inserts the version of the namespace of a key or pattern after
+// its first segment. Keys outside of the known namespaces are unchanged.
+func versionedKey(key string) string {
+	namespace, rest, ok := strings.Cut(key, ":")
+	if !ok {
+		return key
+	}
+	version, known := namespaceVersions[namespace]
+	if !known {
+		return key
+	}
+	return fmt.Sprintf("%s:v%d:%s", namespace, version, rest)
+}
+
+// tenantKey scopes a cache key or pattern to the tenant of the request so
+// stores never see each other's cached catalog data, and versions it
+func tenantKey(ctx context.Context, key string) string {
+	return fmt.Sprintf("tenant:%s:%s", tenant.FromContext(ctx), versionedKey(key))
+}
+
+// namespacePattern matches the keys of a namespace of every tenant, for all
+// versions and unversioned keys written before versioning
+func namespacePattern(namespace string) string {
+	return fmt.Sprintf("tenant:*:%s:*", namespace)
+}
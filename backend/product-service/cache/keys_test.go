@@ -0,0 +1,38 @@
+package cache
+
+import (
+	"context"
+	"testing"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+)
+
+func TestTenantKeyVersionsNamespaces(t *testing.T) {
+	ctx := tenant.WithTenant(context.Background(), "acme")
+
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{ProductKeyPrefix + "42", "tenant:acme:product:v1:42"},
+		{ProductListKeyPrefix + "*", "tenant:acme:product:v1:list:*"},
+		{BrandKeyPrefix + "slug:nike", "tenant:acme:brand:v1:slug:nike"},
+		{"session:42", "tenant:acme:session:42"},
+	}
+	for _, tt := range tests {
+		if got := tenantKey(ctx, tt.key); got != tt.want {
+			t.Errorf("tenantKey(%q) = %q, want %q", tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestNamespaceVersionRejectsUnknownNamespaces(t *testing.T) {
+	if _, err := NamespaceVersion("session"); err == nil {
+		t.Error("NamespaceVersion(session) succeeded, want an error")
+	}
+	for _, namespace := range Namespaces() {
+		if _, err := NamespaceVersion(namespace); err != nil {
+			t.Errorf("NamespaceVersion(%s) = %v", namespace, err)
+		}
+	}
+}
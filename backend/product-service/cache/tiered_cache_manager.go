@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
 	"github.com/louai60/e-commerce_project/backend/product-service/models"
 	"github.com/louai60/e-commerce_project/backend/shared/cache"
 	"go.uber.org/zap"
@@ -74,6 +75,18 @@ func (cm *TieredCacheManager) withTimeout(ctx context.Context, timeout time.Dura
 	return context.WithTimeout(ctx, timeout)
 }
 
+// getObject reads a cached object, or misses without reading when the
+// request bypasses the caches, and records the lookup for the X-Cache header
+func (cm *TieredCacheManager) getObject(ctx context.Context, key string, keyType string, dest interface{}) error {
+	if cachectl.Bypassed(ctx) {
+		cachectl.Record(ctx, cachectl.StatusBypass)
+		return ErrCacheKeyNotFound
+	}
+	err := cm.tieredCache.GetObject(ctx, key, keyType, dest)
+	cachectl.RecordLookup(ctx, err == nil)
+	return err
+}
+
 // GetProduct retrieves a product from the cache
 func (cm *TieredCacheManager) GetProduct(ctx context.Context, id string) (*models.Product, error) {
 	ctx, cancel := cm.withTimeout(ctx, DefaultTimeout)
@@ -87,7 +100,7 @@ func (cm *TieredCacheManager) GetProduct(ctx context.Context, id string) (*model
 
 	// Try to get from cache first without locking
 	var product models.Product
-	err := cm.tieredCache.GetObject(ctx, key, "product", &product)
+	err := cm.getObject(ctx, tenantKey(ctx, key), "product", &product)
 	if err == nil {
 		// Get variants from cache
 		variants, err := cm.GetProductVariants(ctx, id)
@@ -111,7 +124,7 @@ func (cm *TieredCacheManager) GetProduct(ctx context.Context, id string) (*model
 	}()
 
 	// Try again after acquiring lock (another goroutine might have populated the cache)
-	err = cm.tieredCache.GetObject(ctx, key, "product", &product)
+	err = cm.getObject(ctx, tenantKey(ctx, key), "product", &product)
 	if err == nil {
 		// Get variants from cache
 		variants, err := cm.GetProductVariants(ctx, id)
@@ -138,7 +151,7 @@ func (cm *TieredCacheManager) SetProduct(ctx context.Context, product *models.Pr
 	productCopy.Variants = nil
 
 	// Store in tiered cache
-	if err := cm.tieredCache.SetObject(ctx, key, productCopy, "product"); err != nil {
+	if err := cm.tieredCache.SetObject(ctx, tenantKey(ctx, key), productCopy, "product"); err != nil {
 		return err
 	}
 
@@ -151,7 +164,7 @@ func (cm *TieredCacheManager) SetProduct(ctx context.Context, product *models.Pr
 			variantPtrs[i] = &product.Variants[i]
 		}
 
-		if err := cm.tieredCache.SetObject(ctx, variantsKey, variantPtrs, "product"); err != nil {
+		if err := cm.tieredCache.SetObject(ctx, tenantKey(ctx, variantsKey), variantPtrs, "product"); err != nil {
 			return err
 		}
 	}
@@ -164,7 +177,7 @@ func (cm *TieredCacheManager) GetProductVariants(ctx context.Context, productID
 	key := fmt.Sprintf("product:variants:%s", productID)
 
 	var variants []*models.ProductVariant
-	err := cm.tieredCache.GetObject(ctx, key, "product", &variants)
+	err := cm.tieredCache.GetObject(ctx, tenantKey(ctx, key), "product", &variants)
 	if err != nil {
 		return nil, nil // Return nil instead of error for variants
 	}
@@ -177,7 +190,7 @@ func (cm *TieredCacheManager) GetProductList(ctx context.Context, filterKey stri
 	key := fmt.Sprintf("%s%s", ProductListKeyPrefix, filterKey)
 
 	var products []*models.Product
-	err := cm.tieredCache.GetObject(ctx, key, "product_list", &products)
+	err := cm.getObject(ctx, tenantKey(ctx, key), "product_list", &products)
 	if err != nil {
 		return nil, err
 	}
@@ -188,7 +201,7 @@ func (cm *TieredCacheManager) GetProductList(ctx context.Context, filterKey stri
 // SetProductList stores a list of products in the cache
 func (cm *TieredCacheManager) SetProductList(ctx context.Context, filterKey string, products []*models.Product) error {
 	key := fmt.Sprintf("%s%s", ProductListKeyPrefix, filterKey)
-	return cm.tieredCache.SetObject(ctx, key, products, "product_list")
+	return cm.tieredCache.SetObject(ctx, tenantKey(ctx, key), products, "product_list")
 }
 
 // InvalidateProduct removes a product from the cache
@@ -197,12 +210,12 @@ func (cm *TieredCacheManager) InvalidateProduct(ctx context.Context, id string)
 	variantsKey := fmt.Sprintf("product:variants:%s", id)
 
 	// Delete both product and variants
-	if err := cm.tieredCache.Delete(ctx, key); err != nil {
+	if err := cm.tieredCache.Delete(ctx, tenantKey(ctx, key)); err != nil {
 		return err
 	}
 
 	// Ignore errors for variants deletion
-	_ = cm.tieredCache.Delete(ctx, variantsKey)
+	_ = cm.tieredCache.Delete(ctx, tenantKey(ctx, variantsKey))
 
 	return nil
 }
@@ -210,7 +223,7 @@ func (cm *TieredCacheManager) InvalidateProduct(ctx context.Context, id string)
 // InvalidateProductLists removes all product lists from the cache
 func (cm *TieredCacheManager) InvalidateProductLists(ctx context.Context) error {
 	pattern := fmt.Sprintf("%s*", ProductListKeyPrefix)
-	return cm.tieredCache.DeleteByPattern(ctx, pattern)
+	return cm.tieredCache.DeleteByPattern(ctx, tenantKey(ctx, pattern))
 }
 
 // Category-related methods
@@ -218,7 +231,7 @@ func (cm *TieredCacheManager) GetCategory(ctx context.Context, id string) (*mode
 	key := fmt.Sprintf("%s%s", CategoryKeyPrefix, id)
 
 	var category models.Category
-	err := cm.tieredCache.GetObject(ctx, key, "category", &category)
+	err := cm.getObject(ctx, tenantKey(ctx, key), "category", &category)
 	if err != nil {
 		return nil, err
 	}
@@ -228,14 +241,14 @@ func (cm *TieredCacheManager) GetCategory(ctx context.Context, id string) (*mode
 
 func (cm *TieredCacheManager) SetCategory(ctx context.Context, category *models.Category) error {
 	key := fmt.Sprintf("%s%s", CategoryKeyPrefix, category.ID)
-	return cm.tieredCache.SetObject(ctx, key, category, "category")
+	return cm.tieredCache.SetObject(ctx, tenantKey(ctx, key), category, "category")
 }
 
 func (cm *TieredCacheManager) GetCategoryList(ctx context.Context, filterKey string) ([]*models.Category, error) {
 	key := fmt.Sprintf("%s%s", CategoryListKeyPrefix, filterKey)
 
 	var categories []*models.Category
-	err := cm.tieredCache.GetObject(ctx, key, "category_list", &categories)
+	err := cm.getObject(ctx, tenantKey(ctx, key), "category_list", &categories)
 	if err != nil {
 		return nil, err
 	}
@@ -245,23 +258,23 @@ func (cm *TieredCacheManager) GetCategoryList(ctx context.Context, filterKey str
 
 func (cm *TieredCacheManager) SetCategoryList(ctx context.Context, filterKey string, categories []*models.Category) error {
 	key := fmt.Sprintf("%s%s", CategoryListKeyPrefix, filterKey)
-	return cm.tieredCache.SetObject(ctx, key, categories, "category_list")
+	return cm.tieredCache.SetObject(ctx, tenantKey(ctx, key), categories, "category_list")
 }
 
 func (cm *TieredCacheManager) InvalidateCategory(ctx context.Context, id string) error {
 	key := fmt.Sprintf("%s%s", CategoryKeyPrefix, id)
-	return cm.tieredCache.Delete(ctx, key)
+	return cm.tieredCache.Delete(ctx, tenantKey(ctx, key))
 }
 
 func (cm *TieredCacheManager) InvalidateCategoryLists(ctx context.Context) error {
 	pattern := fmt.Sprintf("%s*", CategoryListKeyPrefix)
-	return cm.tieredCache.DeleteByPattern(ctx, pattern)
+	return cm.tieredCache.DeleteByPattern(ctx, tenantKey(ctx, pattern))
 }
 
 // Brand-related methods
 func (cm *TieredCacheManager) GetBrand(ctx context.Context, key string) (*models.Brand, error) {
 	var brand models.Brand
-	err := cm.tieredCache.GetObject(ctx, key, "brand", &brand)
+	err := cm.getObject(ctx, tenantKey(ctx, key), "brand", &brand)
 	if err != nil {
 		return nil, err
 	}
@@ -270,14 +283,14 @@ func (cm *TieredCacheManager) GetBrand(ctx context.Context, key string) (*models
 }
 
 func (cm *TieredCacheManager) SetBrand(ctx context.Context, key string, brand *models.Brand) error {
-	return cm.tieredCache.SetObject(ctx, key, brand, "brand")
+	return cm.tieredCache.SetObject(ctx, tenantKey(ctx, key), brand, "brand")
 }
 
 func (cm *TieredCacheManager) GetBrandList(ctx context.Context, filterKey string) ([]*models.Brand, error) {
 	key := fmt.Sprintf("%s%s", BrandListKeyPrefix, filterKey)
 
 	var brands []*models.Brand
-	err := cm.tieredCache.GetObject(ctx, key, "brand_list", &brands)
+	err := cm.getObject(ctx, tenantKey(ctx, key), "brand_list", &brands)
 	if err != nil {
 		return nil, err
 	}
@@ -287,7 +300,7 @@ func (cm *TieredCacheManager) GetBrandList(ctx context.Context, filterKey string
 
 func (cm *TieredCacheManager) SetBrandList(ctx context.Context, filterKey string, brands []*models.Brand) error {
 	key := fmt.Sprintf("%s%s", BrandListKeyPrefix, filterKey)
-	return cm.tieredCache.SetObject(ctx, key, brands, "brand_list")
+	return cm.tieredCache.SetObject(ctx, tenantKey(ctx, key), brands, "brand_list")
 }
 
 func (cm *TieredCacheManager) InvalidateBrand(ctx context.Context, id string, slug string) error {
@@ -301,7 +314,7 @@ func (cm *TieredCacheManager) InvalidateBrand(ctx context.Context, id string, sl
 	}
 
 	for _, key := range keysToDelete {
-		if err := cm.tieredCache.Delete(ctx, key); err != nil {
+		if err := cm.tieredCache.Delete(ctx, tenantKey(ctx, key)); err != nil {
 			return err
 		}
 	}
@@ -311,7 +324,7 @@ func (cm *TieredCacheManager) InvalidateBrand(ctx context.Context, id string, sl
 
 func (cm *TieredCacheManager) InvalidateBrandLists(ctx context.Context) error {
 	pattern := fmt.Sprintf("%s*", BrandListKeyPrefix)
-	return cm.tieredCache.DeleteByPattern(ctx, pattern)
+	return cm.tieredCache.DeleteByPattern(ctx, tenantKey(ctx, pattern))
 }
 
 // InvalidateProductAndRelated invalidates a product and all related caches
@@ -336,7 +349,17 @@ func (cm *TieredCacheManager) InvalidateProductAndRelated(ctx context.Context, p
 
 // InvalidateByPattern invalidates all keys matching a pattern
 func (cm *TieredCacheManager) InvalidateByPattern(ctx context.Context, pattern string) error {
-	return cm.tieredCache.DeleteByPattern(ctx, pattern)
+	return cm.tieredCache.DeleteByPattern(ctx, tenantKey(ctx, pattern))
+}
+
+// FlushNamespace removes the keys of a namespace for every store, including
+// keys of previous versions. Memory cache entries of other instances expire
+// with their TTL.
+func (cm *TieredCacheManager) FlushNamespace(ctx context.Context, namespace string) error {
+	if _, err := NamespaceVersion(namespace); err != nil {
+		return err
+	}
+	return cm.tieredCache.DeleteByPattern(ctx, namespacePattern(namespace))
 }
 
 // InvalidateProductsByCategory invalidates all product caches related to a category
@@ -361,6 +384,23 @@ func (cm *TieredCacheManager) HealthCheck(ctx context.Context) error {
 	return cm.tieredCache.HealthCheck(ctx)
 }
 
+// Stats returns a snapshot of the cache counters and circuit breaker state
+func (cm *TieredCacheManager) Stats() cache.Stats {
+	return cm.tieredCache.Stats()
+}
+
+// ApplyTTLs updates the default TTL and the per key type TTLs at runtime
+func (cm *TieredCacheManager) ApplyTTLs(defaultTTL time.Duration, ttls map[string]time.Duration) {
+	if defaultTTL > 0 {
+		cm.tieredCache.SetDefaultTTL(defaultTTL)
+	}
+	for keyType, ttl := range ttls {
+		if ttl > 0 {
+			cm.tieredCache.SetTTL(keyType, ttl)
+		}
+	}
+}
+
 // GetCacheStats returns statistics about the cache
 func (cm *TieredCacheManager) GetCacheStats(ctx context.Context) (map[string]interface{}, error) {
 	return cm.tieredCache.GetMemoryCacheStats(), nil
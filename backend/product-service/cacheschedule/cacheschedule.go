@@ -0,0 +1,177 @@
+// Package cacheschedule describes the scheduled maintenance of the catalog
+// caches: at set times of day, a cache namespace is invalidated and the
+// entries busy pages depend on, such as the homepage collections, are warmed
+// again before shoppers ask for them.
+package cacheschedule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Types of warm queries
+const (
+	// WarmProducts warms a page of the product list, of Channel when set
+	WarmProducts = "products"
+	// WarmCategories warms a page of the category list
+	WarmCategories = "categories"
+	// WarmBrands warms a page of the brand list
+	WarmBrands = "brands"
+	// WarmCollection warms the products of a page of the collection with
+	// slug Target
+	WarmCollection = "collection"
+	// WarmProduct warms the product with slug or ID Target
+	WarmProduct = "product"
+)
+
+// Default page of warm queries without one
+const (
+	DefaultPage  = 1
+	DefaultLimit = 20
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// WarmQuery is a read whose result is cached again after an invalidation
+type WarmQuery struct {
+	Type    string `json:"type"`
+	Target  string `json:"target,omitempty"`
+	Channel string `json:"channel,omitempty"`
+	Page    int    `json:"page,omitempty"`
+	Limit   int    `json:"limit,omitempty"`
+}
+
+// Schedule invalidates a cache namespace and warms queries at a time of day
+type Schedule struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// At is the time of day of runs, as HH:MM
+	At string `json:"at"`
+	// Days restricts runs to days of the week, as "mon" to "sun"; runs are
+	// daily when empty
+	Days []string `json:"days,omitempty"`
+	// Timezone is the IANA zone of At, UTC when empty
+	Timezone string `json:"timezone,omitempty"`
+	// Invalidate flushes the namespace before the queries are warmed
+	Invalidate bool        `json:"invalidate"`
+	Warm       []WarmQuery `json:"warm,omitempty"`
+
+	hour, minute int
+	days         map[time.Weekday]bool
+	location     *time.Location
+}
+
+// Compile checks a schedule against the known cache namespaces and prepares
+// it for Next
+func (s *Schedule) Compile(namespaces []string) error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("cache schedule name is required")
+	}
+	if !contains(namespaces, s.Namespace) {
+		return fmt.Errorf("cache schedule %s: namespace must be one of %s", s.Name, strings.Join(namespaces, ", "))
+	}
+
+	at, err := time.Parse("15:04", s.At)
+	if err != nil {
+		return fmt.Errorf("cache schedule %s: at must be a time of day as HH:MM", s.Name)
+	}
+	s.hour, s.minute = at.Hour(), at.Minute()
+
+	s.days = nil
+	for _, day := range s.Days {
+		weekday, ok := weekdays[strings.ToLower(strings.TrimSpace(day))]
+		if !ok {
+			return fmt.Errorf("cache schedule %s: unknown day %q", s.Name, day)
+		}
+		if s.days == nil {
+			s.days = make(map[time.Weekday]bool)
+		}
+		s.days[weekday] = true
+	}
+
+	s.location = time.UTC
+	if s.Timezone != "" {
+		if s.location, err = time.LoadLocation(s.Timezone); err != nil {
+			return fmt.Errorf("cache schedule %s: unknown timezone %q", s.Name, s.Timezone)
+		}
+	}
+
+	if !s.Invalidate && len(s.Warm) == 0 {
+		return fmt.Errorf("cache schedule %s must invalidate its namespace or warm queries", s.Name)
+	}
+	for i := range s.Warm {
+		if err := s.Warm[i].normalize(); err != nil {
+			return fmt.Errorf("cache schedule %s: %w", s.Name, err)
+		}
+	}
+	return nil
+}
+
+func (q *WarmQuery) normalize() error {
+	switch q.Type {
+	case WarmProducts, WarmCategories, WarmBrands:
+	case WarmCollection, WarmProduct:
+		if q.Target == "" {
+			return fmt.Errorf("%s warm queries need a target", q.Type)
+		}
+	default:
+		return fmt.Errorf("unknown warm query type %q", q.Type)
+	}
+	if q.Page < 0 || q.Limit < 0 {
+		return fmt.Errorf("warm query pages and limits cannot be negative")
+	}
+	if q.Page == 0 {
+		q.Page = DefaultPage
+	}
+	if q.Limit == 0 {
+		q.Limit = DefaultLimit
+	}
+	return nil
+}
+
+// CompileAll compiles schedules, which must have distinct names
+func CompileAll(schedules []Schedule, namespaces []string) error {
+	names := make(map[string]bool, len(schedules))
+	for i := range schedules {
+		if err := schedules[i].Compile(namespaces); err != nil {
+			return err
+		}
+		if names[schedules[i].Name] {
+			return fmt.Errorf("duplicate cache schedule %s", schedules[i].Name)
+		}
+		names[schedules[i].Name] = true
+	}
+	return nil
+}
+
+// Next returns the first run of a compiled schedule after after
+func (s *Schedule) Next(after time.Time) time.Time {
+	local := after.In(s.location)
+	day := time.Date(local.Year(), local.Month(), local.Day(), s.hour, s.minute, 0, 0, s.location)
+	// A week and a day covers every restriction of the days of the week
+	for i := 0; i <= 7; i++ {
+		run := day.AddDate(0, 0, i)
+		if run.After(after) && (s.days == nil || s.days[run.Weekday()]) {
+			return run
+		}
+	}
+	return time.Time{}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package cacheschedule
+
+import (
+	"testing"
+	"time"
+)
+
+var namespaces = []string{"brand", "category", "product"}
+
+func TestCompile(t *testing.T) {
+	s := Schedule{
+		Name:       "homepage",
+		Namespace:  "product",
+		At:         "06:00",
+		Invalidate: true,
+		Warm:       []WarmQuery{{Type: WarmCollection, Target: "homepage"}},
+	}
+	if err := s.Compile(namespaces); err != nil {
+		t.Fatalf("Compile() error = %v", err)
+	}
+	if s.Warm[0].Page != DefaultPage || s.Warm[0].Limit != DefaultLimit {
+		t.Errorf("warm query = %+v, want the default page", s.Warm[0])
+	}
+
+	invalid := map[string]Schedule{
+		"no name":           {Namespace: "product", At: "06:00", Invalidate: true},
+		"unknown namespace": {Name: "a", Namespace: "orders", At: "06:00", Invalidate: true},
+		"bad time":          {Name: "a", Namespace: "product", At: "6am", Invalidate: true},
+		"unknown day":       {Name: "a", Namespace: "product", At: "06:00", Days: []string{"someday"}, Invalidate: true},
+		"unknown timezone":  {Name: "a", Namespace: "product", At: "06:00", Timezone: "Mars/Base", Invalidate: true},
+		"nothing to do":     {Name: "a", Namespace: "product", At: "06:00"},
+		"unknown warm":      {Name: "a", Namespace: "product", At: "06:00", Warm: []WarmQuery{{Type: "orders"}}},
+		"missing target":    {Name: "a", Namespace: "product", At: "06:00", Warm: []WarmQuery{{Type: WarmCollection}}},
+	}
+	for name, schedule := range invalid {
+		if err := schedule.Compile(namespaces); err == nil {
+			t.Errorf("Compile(%s) succeeded, want an error", name)
+		}
+	}
+}
+
+func TestCompileAllRejectsDuplicates(t *testing.T) {
+	schedules := []Schedule{
+		{Name: "a", Namespace: "product", At: "06:00", Invalidate: true},
+		{Name: "a", Namespace: "brand", At: "07:00", Invalidate: true},
+	}
+	if err := CompileAll(schedules, namespaces); err == nil {
+		t.Error("CompileAll() succeeded, want an error")
+	}
+}
+
+func TestNext(t *testing.T) {
+	daily := Schedule{Name: "a", Namespace: "product", At: "06:00", Invalidate: true}
+	if err := daily.Compile(namespaces); err != nil {
+		t.Fatal(err)
+	}
+
+	// Wednesday
+	before := time.Date(2026, 1, 7, 5, 59, 0, 0, time.UTC)
+	if got, want := daily.Next(before), time.Date(2026, 1, 7, 6, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Next(before) = %v, want %v", got, want)
+	}
+	at := time.Date(2026, 1, 7, 6, 0, 0, 0, time.UTC)
+	if got, want := daily.Next(at), time.Date(2026, 1, 8, 6, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Next(at) = %v, want %v", got, want)
+	}
+
+	weekly := Schedule{Name: "b", Namespace: "product", At: "06:00", Days: []string{"Mon"}, Invalidate: true}
+	if err := weekly.Compile(namespaces); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := weekly.Next(before), time.Date(2026, 1, 12, 6, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Next(weekly) = %v, want %v", got, want)
+	}
+
+	zoned := Schedule{Name: "c", Namespace: "product", At: "06:00", Timezone: "America/New_York", Invalidate: true}
+	if err := zoned.Compile(namespaces); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := zoned.Next(before), time.Date(2026, 1, 7, 11, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("Next(zoned) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,177 @@
+// Package catalogimport maps the rows of supplier catalog files to products
+// using the supplier's saved import template.
+package catalogimport
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+)
+
+// ErrTooManyRows is returned for files with more rows than the limit
+var ErrTooManyRows = errors.New("catalog file has too many rows")
+
+// Result is the outcome of mapping a catalog file: the products of its valid
+// rows and the errors of the others
+type Result struct {
+	Products []*models.Product
+	Errors   []models.ImportRowError
+}
+
+// Map reads a CSV catalog file with a header row and maps each row with the
+// template. Columns the template does not map are ignored. Rows that cannot
+// be mapped, and rows repeating the SKU of an earlier row, are reported in
+// the result rather than failing the file.
+func Map(template *models.ImportTemplate, r io.Reader, maxRows int) (*Result, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, errors.New("catalog file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read catalog header: %w", err)
+	}
+
+	// Resolve the column of each mapped field; column names match case
+	// insensitively
+	mappings := make(map[string]string, len(template.ColumnMappings))
+	for column, field := range template.ColumnMappings {
+		mappings[normalizeColumn(column)] = field
+	}
+	columns := make(map[string]int)
+	for i, name := range header {
+		if field, ok := mappings[normalizeColumn(name)]; ok {
+			columns[field] = i
+		}
+	}
+	for _, field := range models.RequiredImportFields {
+		if _, ok := columns[field]; !ok {
+			return nil, fmt.Errorf("catalog file has no column mapped to %s", field)
+		}
+	}
+
+	multiplier := template.PriceMultiplier
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+
+	result := &Result{}
+	skus := make(map[string]int)
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read catalog line %d: %w", line, err)
+		}
+		if line-1 > maxRows {
+			return nil, ErrTooManyRows
+		}
+
+		row := func(field string) string {
+			i, ok := columns[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		product, err := mapRow(row, template, multiplier)
+		if err == nil {
+			if first, ok := skus[product.SKU]; ok {
+				err = fmt.Errorf("sku %s repeats line %d", product.SKU, first)
+			}
+		}
+		if err != nil {
+			result.Errors = append(result.Errors, models.ImportRowError{Line: line, Error: err.Error()})
+			continue
+		}
+		skus[product.SKU] = line
+		result.Products = append(result.Products, product)
+	}
+
+	return result, nil
+}
+
+func mapRow(row func(string) string, template *models.ImportTemplate, multiplier float64) (*models.Product, error) {
+	product := &models.Product{
+		Title:            row(models.ImportFieldTitle),
+		Slug:             row(models.ImportFieldSlug),
+		Description:      row(models.ImportFieldDescription),
+		ShortDescription: row(models.ImportFieldShortDescription),
+		SKU:              row(models.ImportFieldSKU),
+		IsPublished:      template.Publish,
+		BrandID:          template.DefaultBrandID,
+	}
+	if product.Title == "" {
+		return nil, errors.New("title is required")
+	}
+	if product.SKU == "" {
+		return nil, errors.New("sku is required")
+	}
+	if product.Slug == "" {
+		product.Slug = Slugify(product.Title + " " + product.SKU)
+	}
+
+	price, err := parseAmount(row(models.ImportFieldPrice))
+	if err != nil || price <= 0 {
+		return nil, fmt.Errorf("invalid price %q", row(models.ImportFieldPrice))
+	}
+	product.Price = models.Price{Amount: roundPrice(price * multiplier)}
+
+	if value := row(models.ImportFieldDiscountPrice); value != "" {
+		discount, err := parseAmount(value)
+		if err != nil || discount <= 0 || discount >= price {
+			return nil, fmt.Errorf("invalid discount_price %q", value)
+		}
+		product.DiscountPrice = &models.Price{Amount: roundPrice(discount * multiplier)}
+	}
+
+	if value := row(models.ImportFieldWeight); value != "" {
+		weight, err := strconv.ParseFloat(value, 64)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid weight %q", value)
+		}
+		product.Weight = &weight
+	}
+
+	return product, nil
+}
+
+// parseAmount parses supplier prices, which may carry a currency symbol or
+// thousands separators, such as "$1,299.00"
+func parseAmount(value string) (float64, error) {
+	value = strings.Map(func(r rune) rune {
+		if (r >= '0' && r <= '9') || r == '.' || r == '-' {
+			return r
+		}
+		return -1
+	}, value)
+	return strconv.ParseFloat(value, 64)
+}
+
+func roundPrice(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func normalizeColumn(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+var nonSlugChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Slugify turns s into a URL slug of lowercase letters, digits and dashes
+func Slugify(s string) string {
+	return strings.Trim(nonSlugChars.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
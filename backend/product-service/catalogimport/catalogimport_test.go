@@ -0,0 +1,84 @@
+package catalogimport
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+)
+
+func testTemplate() *models.ImportTemplate {
+	brandID := "brand-1"
+	return &models.ImportTemplate{
+		Supplier: "acme",
+		ColumnMappings: map[string]string{
+			"Item Name":  models.ImportFieldTitle,
+			"Article No": models.ImportFieldSKU,
+			"Cost":       models.ImportFieldPrice,
+			"Sale Cost":  models.ImportFieldDiscountPrice,
+		},
+		DefaultBrandID:  &brandID,
+		PriceMultiplier: 1.5,
+	}
+}
+
+func TestMapAppliesTemplate(t *testing.T) {
+	file := "item name,ARTICLE NO,cost,sale cost,unused\n" +
+		"Blue Mug,MUG-1,$1,000.00,,x\n" +
+		"Red Mug,MUG-2,10,8,x\n"
+
+	result, err := Map(testTemplate(), strings.NewReader(file), 100)
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	if len(result.Errors) != 1 || result.Errors[0].Line != 2 {
+		t.Fatalf("Map() errors = %+v, want the unquoted price of line 2 to fail", result.Errors)
+	}
+	if len(result.Products) != 1 {
+		t.Fatalf("Map() products = %d, want 1", len(result.Products))
+	}
+
+	product := result.Products[0]
+	if product.Title != "Red Mug" || product.SKU != "MUG-2" || product.Slug != "red-mug-mug-2" {
+		t.Errorf("product = %q %q %q", product.Title, product.SKU, product.Slug)
+	}
+	if product.Price.Amount != 15 || product.DiscountPrice == nil || product.DiscountPrice.Amount != 12 {
+		t.Errorf("prices = %v %v, want the multiplier applied", product.Price, product.DiscountPrice)
+	}
+	if product.BrandID == nil || *product.BrandID != "brand-1" {
+		t.Errorf("brand = %v, want the template default", product.BrandID)
+	}
+}
+
+func TestMapReportsInvalidRows(t *testing.T) {
+	file := "Item Name,Article No,Cost,Sale Cost\n" +
+		"Mug,MUG-1,\"1,299.00\",\n" +
+		"Mug again,MUG-1,5,\n" +
+		",MUG-3,5,\n" +
+		"Cup,MUG-4,5,6\n"
+
+	result, err := Map(testTemplate(), strings.NewReader(file), 100)
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	if len(result.Products) != 1 || result.Products[0].Price.Amount != 1948.5 {
+		t.Fatalf("Map() products = %+v, want only MUG-1", result.Products)
+	}
+	lines := []int{}
+	for _, rowErr := range result.Errors {
+		lines = append(lines, rowErr.Line)
+	}
+	if len(lines) != 3 || lines[0] != 3 || lines[1] != 4 || lines[2] != 5 {
+		t.Errorf("Map() error lines = %v, want [3 4 5]", lines)
+	}
+}
+
+func TestMapRejectsFiles(t *testing.T) {
+	if _, err := Map(testTemplate(), strings.NewReader("Item Name,Cost\nMug,5\n"), 100); err == nil {
+		t.Error("expected an error for a file without a SKU column")
+	}
+	file := "Item Name,Article No,Cost\nA,1,5\nB,2,5\n"
+	if _, err := Map(testTemplate(), strings.NewReader(file), 1); err != ErrTooManyRows {
+		t.Errorf("Map() error = %v, want ErrTooManyRows", err)
+	}
+}
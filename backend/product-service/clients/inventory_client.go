@@ -10,6 +10,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	"github.com/louai60/e-commerce_project/backend/product-service/config"
 	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
 )
@@ -39,6 +42,7 @@ func NewInventoryClient(cfg *config.Config, logger *zap.Logger) (*InventoryClien
 			ctx,
 			inventoryAddr,
 			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithChainUnaryInterceptor(applogger.UnaryClientInterceptor(), tenant.UnaryClientInterceptor(), servicetoken.UnaryClientInterceptor()),
 			grpc.WithBlock(),
 		)
 		cancel()
@@ -216,3 +220,40 @@ func (c *InventoryClient) CheckInventoryAvailability(ctx context.Context, produc
 
 	return resp.AllAvailable, nil
 }
+
+// GetInventoryItemBySKU retrieves an inventory item by SKU
+func (c *InventoryClient) GetInventoryItemBySKU(ctx context.Context, sku string) (*inventorypb.InventoryItem, error) {
+	resp, err := c.client.GetInventoryItem(ctx, &inventorypb.GetInventoryItemRequest{
+		Identifier: &inventorypb.GetInventoryItemRequest_Sku{Sku: sku},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get inventory item: %w", err)
+	}
+
+	return resp.InventoryItem, nil
+}
+
+// ListInventoryItems returns one page of inventory items and the total count
+func (c *InventoryClient) ListInventoryItems(ctx context.Context, page, limit int) ([]*inventorypb.InventoryItem, int, error) {
+	resp, err := c.client.ListInventoryItems(ctx, &inventorypb.ListInventoryItemsRequest{
+		Page:  int32(page),
+		Limit: int32(limit),
+	})
+	if err != nil {
+		c.logger.Error("Failed to list inventory items", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to list inventory items: %w", err)
+	}
+
+	return resp.InventoryItems, int(resp.Total), nil
+}
+
+// BulkUpdateInventory applies quantity changes to several SKUs at once
+func (c *InventoryClient) BulkUpdateInventory(ctx context.Context, items []*inventorypb.BulkUpdateItem) (*inventorypb.BulkUpdateInventoryResponse, error) {
+	resp, err := c.client.BulkUpdateInventory(ctx, &inventorypb.BulkUpdateInventoryRequest{Items: items})
+	if err != nil {
+		c.logger.Error("Failed to bulk update inventory", zap.Error(err), zap.Int("items", len(items)))
+		return nil, fmt.Errorf("failed to bulk update inventory: %w", err)
+	}
+
+	return resp, nil
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -8,16 +10,37 @@ import (
 
 	"github.com/spf13/viper"
 	"go.uber.org/zap"
+
+	sharedconfig "github.com/louai60/e-commerce_project/backend/shared/config"
 )
 
+// consulConfigKey is the default Consul KV key holding runtime overrides
+const consulConfigKey = "ecommerce/product-service/config"
+
 // Config holds all configuration for our program
 type Config struct {
-	Server     ServerConfig   `yaml:"server"`
-	Database   DatabaseConfig `yaml:"database"`
-	Redis      RedisConfig    `yaml:"redis"`
-	Services   ServicesConfig `yaml:"services"`
-	Secrets    SecretsConfig  `yaml:"secrets"`
-	Cloudinary struct {
+	Server          ServerConfig          `yaml:"server"`
+	Database        DatabaseConfig        `yaml:"database"`
+	Redis           RedisConfig           `yaml:"redis"`
+	Services        ServicesConfig        `yaml:"services"`
+	Secrets         SecretsConfig         `yaml:"secrets"`
+	Cache           CacheConfig           `mapstructure:"cache"`
+	Digital         DigitalConfig         `mapstructure:"digital"`
+	Media           MediaConfig           `mapstructure:"media"`
+	Subscriptions   SubscriptionsConfig   `mapstructure:"subscriptions"`
+	Feeds           FeedsConfig           `mapstructure:"feeds"`
+	ErpSync         ErpSyncConfig         `mapstructure:"erpSync"`
+	Reconciliation  ReconciliationConfig  `mapstructure:"reconciliation"`
+	CatalogQuality  CatalogQualityConfig  `mapstructure:"catalogQuality"`
+	SavedSearches   SavedSearchesConfig   `mapstructure:"savedSearches"`
+	Badges          BadgesConfig          `mapstructure:"badges"`
+	CacheSchedules  CacheSchedulesConfig  `mapstructure:"cacheSchedules"`
+	Relationships   RelationshipsConfig   `mapstructure:"relationships"`
+	Settings        SettingsConfig        `mapstructure:"settings"`
+	Archival        ArchivalConfig        `mapstructure:"archival"`
+	PricingBackfill PricingBackfillConfig `mapstructure:"pricingBackfill"`
+	Profiling       ProfilingConfig       `mapstructure:"profiling"`
+	Cloudinary      struct {
 		CloudName string
 		APIKey    string
 		APISecret string
@@ -46,9 +69,14 @@ type DatabaseConfig struct {
 	MaxOpenConns    int           `mapstructure:"maxOpenConns"`
 	MaxIdleConns    int           `mapstructure:"maxIdleConns"`
 	ConnMaxLifetime time.Duration `mapstructure:"connMaxLifetime"`
+	// How pgx runs queries, a default_query_exec_mode of pgx. The default,
+	// cache_statement, prepares every query once per connection; use exec
+	// behind PgBouncer in transaction pooling mode
+	QueryExecMode string `mapstructure:"queryExecMode"`
 
 	// Read replica configuration
-	Replicas []ReplicaConfig `mapstructure:"replicas"`
+	Replicas       []ReplicaConfig      `mapstructure:"replicas"`
+	ReplicaRouting ReplicaRoutingConfig `mapstructure:"replicaRouting"`
 
 	// Sharding configuration
 	Sharding ShardingConfig `mapstructure:"sharding"`
@@ -63,6 +91,13 @@ type ReplicaConfig struct {
 	SSLMode string `mapstructure:"sslMode"`
 }
 
+// ReplicaRoutingConfig controls the sampling of the connection pools and
+// replica lag, and routes reads away from replicas that lag too far behind
+type ReplicaRoutingConfig struct {
+	SampleInterval time.Duration `mapstructure:"sampleInterval"`
+	MaxLag         time.Duration `mapstructure:"maxLag"` // Zero keeps lagging replicas in rotation
+}
+
 // ShardingConfig holds configuration for database sharding
 type ShardingConfig struct {
 	Enabled      bool          `mapstructure:"enabled"`
@@ -90,6 +125,199 @@ type SecretsConfig struct {
 	DatabasePassword string
 	JWTSecret        string
 	APIKeys          map[string]string
+	// DownloadSecret signs digital download links; links are disabled without it
+	DownloadSecret string
+	// FeedSecret signs marketplace feed links; links are disabled without it
+	FeedSecret string
+	// ErpSFTPPassword authenticates the ERP sync SFTP user when no key is set
+	ErpSFTPPassword string
+}
+
+// CacheConfig holds cache TTLs that can be changed at runtime
+type CacheConfig struct {
+	DefaultTTL time.Duration `mapstructure:"defaultTTL"`
+	// TTLs overrides the TTL per key type, e.g. product, product_list, category
+	TTLs map[string]time.Duration `mapstructure:"ttls"`
+}
+
+// DigitalConfig holds configuration for digital product downloads
+type DigitalConfig struct {
+	// StoragePath is a private directory that is not served publicly
+	StoragePath          string        `mapstructure:"storagePath"`
+	DownloadBaseURL      string        `mapstructure:"downloadBaseURL"`
+	LinkTTL              time.Duration `mapstructure:"linkTTL"`
+	DefaultDownloadLimit int           `mapstructure:"defaultDownloadLimit"`
+}
+
+// MediaConfig holds configuration for the direct uploads of product media to
+// Cloudinary, signed with the Cloudinary credentials, and for the processing
+// of product videos and 3D models. MaxBytes and Formats apply to images.
+type MediaConfig struct {
+	Folder string `mapstructure:"folder"`
+	// UploadTTL is how long upload tickets can be used, at most an hour
+	UploadTTL time.Duration    `mapstructure:"uploadTTL"`
+	MaxBytes  int64            `mapstructure:"maxBytes"`
+	Formats   []string         `mapstructure:"formats"`
+	Video     MediaLimitConfig `mapstructure:"video"`
+	Model3D   MediaLimitConfig `mapstructure:"model3d"`
+	// ProcessingInterval is how often the thumbnails of new videos and 3D
+	// models are extracted
+	ProcessingInterval time.Duration `mapstructure:"processingInterval"`
+}
+
+// MediaLimitConfig holds the checks uploaded files of a media type must pass
+type MediaLimitConfig struct {
+	MaxBytes int64    `mapstructure:"maxBytes"`
+	Formats  []string `mapstructure:"formats"`
+}
+
+// SubscriptionsConfig holds configuration for the subscription renewal job
+type SubscriptionsConfig struct {
+	RenewalEnabled   bool          `mapstructure:"renewalEnabled"`
+	RenewalInterval  time.Duration `mapstructure:"renewalInterval"`
+	RenewalBatchSize int           `mapstructure:"renewalBatchSize"`
+}
+
+// FeedsConfig holds configuration for marketplace feed generation
+type FeedsConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+	// StoragePath is a private directory that is not served publicly
+	StoragePath   string        `mapstructure:"storagePath"`
+	BaseURL       string        `mapstructure:"baseURL"`
+	LinkTTL       time.Duration `mapstructure:"linkTTL"`
+	StorefrontURL string        `mapstructure:"storefrontURL"`
+}
+
+// ErpSyncConfig holds configuration for the ERP sync job and its CSV connector
+type ErpSyncConfig struct {
+	Enabled        bool          `mapstructure:"enabled"`
+	Interval       time.Duration `mapstructure:"interval"`
+	Connector      string        `mapstructure:"connector"`
+	ConflictPolicy string        `mapstructure:"conflictPolicy"` // erp_wins, local_wins or newest_wins
+	BatchSize      int           `mapstructure:"batchSize"`
+	// Transport is "local" for a directory or "sftp" for an SFTP drop folder
+	Transport string            `mapstructure:"transport"`
+	LocalDir  string            `mapstructure:"localDir"`
+	SFTP      ErpSyncSFTPConfig `mapstructure:"sftp"`
+}
+
+// ErpSyncSFTPConfig holds the SFTP drop folder of the ERP sync connector
+type ErpSyncSFTPConfig struct {
+	Host           string `mapstructure:"host"`
+	Port           int    `mapstructure:"port"`
+	User           string `mapstructure:"user"`
+	PrivateKeyPath string `mapstructure:"privateKeyPath"`
+	KnownHostsPath string `mapstructure:"knownHostsPath"`
+	Dir            string `mapstructure:"dir"`
+}
+
+// ReconciliationConfig holds configuration for the job cross-checking product
+// SKUs against inventory items
+type ReconciliationConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+	// AutoCreate creates missing inventory items with zero quantity
+	AutoCreate bool `mapstructure:"autoCreate"`
+}
+
+// CatalogQualityConfig holds configuration for the job recomputing the
+// catalog quality scores of every product
+type CatalogQualityConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// SavedSearchesConfig holds configuration for the job evaluating the saved
+// searches with alerts
+type SavedSearchesConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+// BadgesConfig holds configuration for the job recomputing the display
+// badges of every product
+type BadgesConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+	// SalesWindow is the period over which bestsellers are ranked
+	SalesWindow time.Duration `mapstructure:"salesWindow"`
+}
+
+// CacheSchedulesConfig holds the scheduled invalidations and re-warms of the
+// cache namespaces. Schedules due are looked for every CheckInterval.
+type CacheSchedulesConfig struct {
+	Enabled       bool                  `mapstructure:"enabled"`
+	CheckInterval time.Duration         `mapstructure:"checkInterval"`
+	Schedules     []CacheScheduleConfig `mapstructure:"schedules"`
+}
+
+// CacheScheduleConfig invalidates a cache namespace (product, category or
+// brand) and warms queries at a time of day
+type CacheScheduleConfig struct {
+	Name      string `mapstructure:"name"`
+	Namespace string `mapstructure:"namespace"`
+	// At is the time of day of runs, as HH:MM in Timezone (UTC by default)
+	At       string   `mapstructure:"at"`
+	Days     []string `mapstructure:"days"`
+	Timezone string   `mapstructure:"timezone"`
+	// Invalidate flushes the namespace before the queries are warmed
+	Invalidate bool                   `mapstructure:"invalidate"`
+	Warm       []CacheWarmQueryConfig `mapstructure:"warm"`
+}
+
+// CacheWarmQueryConfig is a read cached again by a cache schedule: a page of
+// products, categories or brands, the products of the collection with slug
+// Target, or the product with slug or ID Target
+type CacheWarmQueryConfig struct {
+	Type    string `mapstructure:"type"`
+	Target  string `mapstructure:"target"`
+	Channel string `mapstructure:"channel"`
+	Page    int    `mapstructure:"page"`
+	Limit   int    `mapstructure:"limit"`
+}
+
+// RelationshipsConfig holds configuration for the linked products included
+// in product detail responses
+type RelationshipsConfig struct {
+	// DetailLimit is the number of linked products of each relationship type
+	// included by default, zero leaving them out
+	DetailLimit int `mapstructure:"detailLimit"`
+}
+
+// SettingsConfig holds configuration for the cache of the store settings
+type SettingsConfig struct {
+	// CacheTTL bounds how long cached settings are served when an
+	// invalidation is missed
+	CacheTTL time.Duration `mapstructure:"cacheTTL"`
+}
+
+// ArchivalConfig holds configuration for the job maintaining the monthly
+// partitions of the price history
+type ArchivalConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+	// PriceHistoryMonths is the number of past months of price history kept in
+	// the database, zero keeping all of it
+	PriceHistoryMonths int `mapstructure:"priceHistoryMonths"`
+	// StoragePath is a private directory receiving the archived partitions
+	StoragePath string `mapstructure:"storagePath"`
+}
+
+// PricingBackfillConfig holds configuration for the job giving every product
+// a default variant its price and SKU are derived from
+type PricingBackfillConfig struct {
+	Enabled  bool          `mapstructure:"enabled"`
+	Interval time.Duration `mapstructure:"interval"`
+	// DryRun only reports the drifted products
+	DryRun bool `mapstructure:"dryRun"`
+}
+
+// ProfilingConfig holds configuration for the pprof endpoints
+type ProfilingConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// Addr is the address of the profiling listener, keep it internal
+	Addr string `mapstructure:"addr"`
 }
 
 type RedisConfig struct {
@@ -112,13 +340,60 @@ type ServicesConfig struct {
 
 // LoadConfig reads configuration from files and environment variables
 func LoadConfig(logger *zap.Logger) (*Config, error) {
-	config := &Config{}
+	env := environment()
+
+	config, _, err := readConfig(context.Background(), env, sharedconfig.NewConsulSourceFromEnv(consulConfigKey))
+	if err != nil {
+		return nil, err
+	}
+
+	// Log non-sensitive configuration
+	logger.Info("Configuration loaded successfully",
+		zap.String("environment", env),
+		zap.String("service", config.Server.ServiceName),
+		zap.String("port", config.Server.Port),
+	)
+
+	return config, nil
+}
+
+// NewWatcher loads the configuration and returns a watcher that reloads it
+// whenever the config file or the optional Consul key changes
+func NewWatcher(ctx context.Context, logger *zap.Logger) (*sharedconfig.Watcher[Config], error) {
+	env := environment()
+	remote := sharedconfig.NewConsulSourceFromEnv(consulConfigKey)
+
+	_, file, err := readConfig(ctx, env, remote)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []sharedconfig.Source{sharedconfig.NewFileSource(file)}
+	if remote != nil {
+		sources = append(sources, remote)
+	}
+
+	return sharedconfig.NewWatcher(ctx, func(ctx context.Context) (*Config, error) {
+		config, _, err := readConfig(ctx, env, remote)
+		return config, err
+	}, sharedconfig.WatcherOptions{
+		Sources: sources,
+		Logger:  logger,
+	})
+}
 
-	// Set default environment if not set
+func environment() string {
 	env := os.Getenv("APP_ENV")
 	if env == "" {
 		env = "development"
 	}
+	return env
+}
+
+// readConfig reads the config file for env, merges the Consul overrides when
+// a remote source is given, and returns the config with the file path used
+func readConfig(ctx context.Context, env string, remote *sharedconfig.ConsulSource) (*Config, string, error) {
+	config := &Config{}
 
 	// Initialize viper for config file
 	v := viper.New()
@@ -126,35 +401,98 @@ func LoadConfig(logger *zap.Logger) (*Config, error) {
 	v.SetConfigType("yaml")
 	v.AddConfigPath("./config")
 	v.AddConfigPath("../config")
+	v.SetDefault("database.queryExecMode", "cache_statement")
+	v.SetDefault("database.replicaRouting.sampleInterval", "15s")
+	v.SetDefault("database.replicaRouting.maxLag", "10s")
+	v.SetDefault("cache.defaultTTL", "15m")
+	v.SetDefault("digital.storagePath", "./private_uploads")
+	v.SetDefault("digital.downloadBaseURL", "http://localhost:8080/api/v1/downloads")
+	v.SetDefault("digital.linkTTL", "15m")
+	v.SetDefault("digital.defaultDownloadLimit", 5)
+	v.SetDefault("media.folder", "products")
+	v.SetDefault("media.uploadTTL", "15m")
+	v.SetDefault("media.maxBytes", 10485760)
+	v.SetDefault("media.formats", []string{"jpg", "jpeg", "png", "webp", "gif"})
+	v.SetDefault("media.video.maxBytes", 104857600)
+	v.SetDefault("media.video.formats", []string{"mp4", "webm", "mov"})
+	v.SetDefault("media.model3d.maxBytes", 52428800)
+	v.SetDefault("media.model3d.formats", []string{"glb", "usdz"})
+	v.SetDefault("media.processingInterval", "1m")
+	v.SetDefault("subscriptions.renewalEnabled", true)
+	v.SetDefault("subscriptions.renewalInterval", "5m")
+	v.SetDefault("subscriptions.renewalBatchSize", 100)
+	v.SetDefault("feeds.enabled", true)
+	v.SetDefault("feeds.interval", "6h")
+	v.SetDefault("feeds.storagePath", "./private_feeds")
+	v.SetDefault("feeds.baseURL", "http://localhost:8080/api/v1/feeds")
+	v.SetDefault("feeds.linkTTL", "720h")
+	v.SetDefault("feeds.storefrontURL", "http://localhost:3000")
+	v.SetDefault("erpSync.enabled", false)
+	v.SetDefault("erpSync.interval", "15m")
+	v.SetDefault("erpSync.connector", "erp-csv")
+	v.SetDefault("erpSync.conflictPolicy", "erp_wins")
+	v.SetDefault("erpSync.batchSize", 500)
+	v.SetDefault("erpSync.transport", "local")
+	v.SetDefault("erpSync.localDir", "./erp_sync")
+	v.SetDefault("erpSync.sftp.port", 22)
+	v.SetDefault("reconciliation.enabled", true)
+	v.SetDefault("reconciliation.interval", "24h")
+	v.SetDefault("reconciliation.autoCreate", false)
+	v.SetDefault("catalogQuality.enabled", true)
+	v.SetDefault("catalogQuality.interval", "24h")
+	v.SetDefault("savedSearches.enabled", true)
+	v.SetDefault("savedSearches.interval", "1h")
+	v.SetDefault("badges.enabled", true)
+	v.SetDefault("badges.interval", "1h")
+	v.SetDefault("badges.salesWindow", "720h")
+	v.SetDefault("cacheSchedules.enabled", true)
+	v.SetDefault("cacheSchedules.checkInterval", "1m")
+	v.SetDefault("relationships.detailLimit", 8)
+	v.SetDefault("settings.cacheTTL", "5m")
+	v.SetDefault("archival.enabled", true)
+	v.SetDefault("archival.interval", "24h")
+	v.SetDefault("archival.priceHistoryMonths", 24)
+	v.SetDefault("archival.storagePath", "./private_archive")
+	v.SetDefault("pricingBackfill.enabled", true)
+	v.SetDefault("pricingBackfill.interval", "24h")
+	v.SetDefault("pricingBackfill.dryRun", false)
+	v.SetDefault("profiling.enabled", false)
+	v.SetDefault("profiling.addr", "127.0.0.1:6061")
 
 	// Read config file
 	if err := v.ReadInConfig(); err != nil {
-		return nil, fmt.Errorf("failed to read config file: %w", err)
+		return nil, "", fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	// Merge runtime overrides from Consul
+	if remote != nil {
+		data, err := remote.Fetch(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read remote config: %w", err)
+		}
+		if len(data) > 0 {
+			if err := v.MergeConfig(bytes.NewReader(data)); err != nil {
+				return nil, "", fmt.Errorf("failed to merge remote config: %w", err)
+			}
+		}
 	}
 
 	// Unmarshal config file
 	if err := v.Unmarshal(config); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+		return nil, "", fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
 	// Load secrets from environment variables
 	if err := loadSecrets(config); err != nil {
-		return nil, fmt.Errorf("failed to load secrets: %w", err)
+		return nil, "", fmt.Errorf("failed to load secrets: %w", err)
 	}
 
 	// Validate config
 	if err := validateConfig(config); err != nil {
-		return nil, fmt.Errorf("config validation failed: %w", err)
+		return nil, "", fmt.Errorf("config validation failed: %w", err)
 	}
 
-	// Log non-sensitive configuration
-	logger.Info("Configuration loaded successfully",
-		zap.String("environment", env),
-		zap.String("service", config.Server.ServiceName),
-		zap.String("port", config.Server.Port),
-	)
-
-	return config, nil
+	return config, v.ConfigFileUsed(), nil
 }
 
 // loadSecrets loads sensitive configuration from environment variables
@@ -178,6 +516,15 @@ func loadSecrets(config *Config) error {
 	}
 	config.Secrets.JWTSecret = jwtSecret
 
+	// Load digital download signing secret (optional)
+	config.Secrets.DownloadSecret = os.Getenv("DIGITAL_DOWNLOAD_SECRET")
+
+	// Load marketplace feed signing secret (optional)
+	config.Secrets.FeedSecret = os.Getenv("FEED_SIGNING_SECRET")
+
+	// Load ERP sync SFTP password (optional)
+	config.Secrets.ErpSFTPPassword = os.Getenv("ERP_SFTP_PASSWORD")
+
 	// Load API keys
 	for _, env := range os.Environ() {
 		if strings.HasPrefix(env, "API_KEY_") {
@@ -203,18 +550,24 @@ func validateConfig(config *Config) error {
 	if config.Database.Host == "" {
 		return fmt.Errorf("database host is required")
 	}
+	switch config.Database.QueryExecMode {
+	case "cache_statement", "cache_describe", "describe_exec", "exec", "simple_protocol":
+	default:
+		return fmt.Errorf("invalid database query exec mode %q", config.Database.QueryExecMode)
+	}
 	// Add more validation as needed
 	return nil
 }
 
 // GetDSN returns the database connection string
 func (c *Config) GetDSN() string {
-	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s default_query_exec_mode=%s",
 		c.Database.Host,
 		c.Database.Port,
 		c.Database.User,
 		c.Secrets.DatabasePassword,
 		c.Database.Name,
 		c.Database.SSLMode,
+		c.Database.QueryExecMode,
 	)
 }
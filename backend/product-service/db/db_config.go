@@ -17,6 +17,11 @@ type DBConfig struct {
 	Master   *sql.DB
 	Replicas []*sql.DB
 	Logger   *zap.Logger
+
+	// Set by the pool monitor, see StartPoolMonitor
+	mu       sync.RWMutex
+	monitor  *poolMonitor
+	routable []*sql.DB
 }
 
 // ReplicaSelector is a function type that selects a replica from a list
@@ -66,7 +71,7 @@ func RandomSelector() ReplicaSelector {
 // NewDBConfig creates a new database configuration with master and replicas
 func NewDBConfig(cfg *config.Config, logger *zap.Logger) (*DBConfig, error) {
 	// Connect to master database
-	masterDB, err := sql.Open("postgres", cfg.GetDSN())
+	masterDB, err := sql.Open(DriverName, cfg.GetDSN())
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to master database: %w", err)
 	}
@@ -89,16 +94,17 @@ func NewDBConfig(cfg *config.Config, logger *zap.Logger) (*DBConfig, error) {
 
 	// Connect to replica databases if configured
 	for i, replicaConfig := range cfg.Database.Replicas {
-		replicaDSN := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+		replicaDSN := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s default_query_exec_mode=%s",
 			replicaConfig.Host,
 			replicaConfig.Port,
 			replicaConfig.User,
 			cfg.Secrets.DatabasePassword, // Assuming same password for replicas
 			replicaConfig.Name,
 			replicaConfig.SSLMode,
+			cfg.Database.QueryExecMode,
 		)
 
-		replicaDB, err := sql.Open("postgres", replicaDSN)
+		replicaDB, err := sql.Open(DriverName, replicaDSN)
 		if err != nil {
 			logger.Warn("Failed to connect to replica database",
 				zap.Int("replica_index", i),
@@ -205,12 +211,24 @@ func (c *DBConfig) Close() {
 	}
 }
 
-// GetReplicaOrMaster returns a replica if available, otherwise returns the master
+// GetReplicaOrMaster returns a replica if available, otherwise returns the
+// master. When the pool monitor runs, replicas lagging behind are skipped.
 func (c *DBConfig) GetReplicaOrMaster(selector ReplicaSelector) *sql.DB {
-	if len(c.Replicas) > 0 {
-		if replica := selector(c.Replicas); replica != nil {
+	replicas := c.routableReplicas()
+	if len(replicas) > 0 {
+		if replica := selector(replicas); replica != nil {
 			return replica
 		}
 	}
 	return c.Master
 }
+
+// routableReplicas returns the replicas reads may be sent to
+func (c *DBConfig) routableReplicas() []*sql.DB {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.monitor == nil {
+		return c.Replicas
+	}
+	return c.routable
+}
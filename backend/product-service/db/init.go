@@ -8,7 +8,6 @@ import (
 	"sort"
 	"strings"
 
-	_ "github.com/lib/pq"
 	"go.uber.org/zap"
 
 	"github.com/louai60/e-commerce_project/backend/product-service/config"
@@ -18,12 +17,12 @@ import (
 func InitDatabase(cfg *config.Config, logger *zap.Logger) (*DBConfig, error) {
 	// First, connect to postgres to check if our database exists
 	pgDSN := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=postgres sslmode=%s",
-		cfg.Database.Host, cfg.Database.Port, cfg.Database.User, cfg.Secrets.DatabasePassword, cfg.Database.SSLMode,
+		"host=%s port=%s user=%s password=%s dbname=postgres sslmode=%s default_query_exec_mode=%s",
+		cfg.Database.Host, cfg.Database.Port, cfg.Database.User, cfg.Secrets.DatabasePassword, cfg.Database.SSLMode, cfg.Database.QueryExecMode,
 	)
 
 	logger.Info("Connecting to postgres to check if database exists")
-	pgDB, err := sql.Open("postgres", pgDSN)
+	pgDB, err := sql.Open(DriverName, pgDSN)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to postgres: %w", err)
 	}
@@ -171,12 +170,9 @@ func runMigrations(db *sql.DB, logger *zap.Logger) error {
 
 // GetTempDBConnection creates a temporary connection to the database for fixing migrations
 func GetTempDBConnection(cfg *config.Config, logger *zap.Logger) (*sql.DB, error) {
-	dsn := fmt.Sprintf(
-		"host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
-		cfg.Database.Host, cfg.Database.Port, cfg.Database.User, cfg.Secrets.DatabasePassword, cfg.Database.Name, cfg.Database.SSLMode,
-	)
+	dsn := cfg.GetDSN()
 
-	db, err := sql.Open("postgres", dsn)
+	db, err := sql.Open(DriverName, dsn)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to database: %w", err)
 	}
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+// DriverName is the database/sql driver of the service, the pgx driver
+const DriverName = "pgx"
+
+// WithPgxConn runs fn with the pgx connection underlying conn, for the pgx
+// features database/sql does not expose, such as batches and COPY FROM. The
+// statements fn runs take part in the transaction open on conn, if any.
+func WithPgxConn(conn *sql.Conn, fn func(*pgx.Conn) error) error {
+	return conn.Raw(func(driverConn any) error {
+		c, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unexpected driver connection %T, want pgx", driverConn)
+		}
+		return fn(c.Conn())
+	})
+}
+
+// arrayTypes decodes the array columns scanned by ScanArray. A pgtype.Map
+// memoizes its scan plans without synchronization, hence the lock.
+var (
+	arrayTypesMu sync.Mutex
+	arrayTypes   = pgtype.NewMap()
+)
+
+// ScanArray scans a PostgreSQL array column into dst, a pointer to a slice.
+// Array parameters need no wrapping, pgx encodes slices itself.
+func ScanArray(dst any) sql.Scanner {
+	return arrayScanner{dst: dst}
+}
+
+type arrayScanner struct {
+	dst any
+}
+
+func (s arrayScanner) Scan(src any) error {
+	arrayTypesMu.Lock()
+	defer arrayTypesMu.Unlock()
+	return arrayTypes.SQLScanner(s.dst).Scan(src)
+}
@@ -0,0 +1,37 @@
+package db
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestScanArray(t *testing.T) {
+	var keywords []string
+	if err := ScanArray(&keywords).Scan(`{shirt,"red shirt"}`); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"shirt", "red shirt"}; !reflect.DeepEqual(keywords, want) {
+		t.Errorf("keywords = %q, want %q", keywords, want)
+	}
+}
+
+// The scanners of concurrent queries share one type map; run with -race
+func TestScanArrayConcurrently(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var ids []int64
+			if err := ScanArray(&ids).Scan(`{1,2,3}`); err != nil {
+				t.Error(err)
+				return
+			}
+			if len(ids) != 3 {
+				t.Errorf("ids = %v, want 3 elements", ids)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,135 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/shared/diagnostics"
+)
+
+// poolMonitor holds the last samples of the pool monitor
+type poolMonitor struct {
+	maxLag   time.Duration
+	pools    map[string]sql.DBStats
+	replicas map[*sql.DB]diagnostics.ReplicaState
+}
+
+// StartPoolMonitor samples the connection pools and the replay lag of the
+// replicas every interval until ctx is done. Replicas lagging more than
+// maxLag, or whose lag cannot be read, get no reads until a later sample
+// finds them caught up. With a zero maxLag only unreachable replicas are
+// skipped.
+func (c *DBConfig) StartPoolMonitor(ctx context.Context, interval, maxLag time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	c.monitor = &poolMonitor{
+		maxLag:   maxLag,
+		pools:    map[string]sql.DBStats{},
+		replicas: map[*sql.DB]diagnostics.ReplicaState{},
+	}
+	c.routable = c.Replicas
+	c.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			c.samplePools(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// ReplicaState returns the last sampled state of a replica. It implements
+// diagnostics.ReplicaStateSource.
+func (c *DBConfig) ReplicaState(db *sql.DB) (diagnostics.ReplicaState, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.monitor == nil {
+		return diagnostics.ReplicaState{}, false
+	}
+	state, ok := c.monitor.replicas[db]
+	return state, ok
+}
+
+func (c *DBConfig) samplePools(ctx context.Context) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	c.mu.RLock()
+	maxLag := c.monitor.maxLag
+	previousPools := c.monitor.pools
+	previousStates := c.monitor.replicas
+	c.mu.RUnlock()
+
+	now := time.Now().UTC()
+	pools := map[string]sql.DBStats{"master": c.Master.Stats()}
+	states := make(map[*sql.DB]diagnostics.ReplicaState, len(c.Replicas))
+	routable := make([]*sql.DB, 0, len(c.Replicas))
+
+	for i, replica := range c.Replicas {
+		name := fmt.Sprintf("replica-%d", i)
+		pools[name] = replica.Stats()
+
+		lag, err := diagnostics.ReplicaLag(ctx, replica)
+		if ctx.Err() != nil {
+			return
+		}
+		state := diagnostics.ReplicaState{
+			Lag:       lag,
+			Excluded:  err != nil || (maxLag > 0 && lag != nil && *lag > maxLag),
+			SampledAt: now,
+		}
+		states[replica] = state
+		if !state.Excluded {
+			routable = append(routable, replica)
+		}
+
+		previous, sampled := previousStates[replica]
+		switch {
+		case state.Excluded && (!sampled || !previous.Excluded):
+			fields := []zap.Field{zap.String("replica", name), zap.Duration("max_lag", maxLag)}
+			if lag != nil {
+				fields = append(fields, zap.Duration("lag", *lag))
+			}
+			if err != nil {
+				fields = append(fields, zap.Error(err))
+			}
+			c.Logger.Warn("Routing reads away from replica", fields...)
+		case !state.Excluded && sampled && previous.Excluded:
+			c.Logger.Info("Replica back in read rotation", zap.String("replica", name))
+		}
+	}
+
+	for name, stats := range pools {
+		previous, ok := previousPools[name]
+		if !ok || stats.WaitCount <= previous.WaitCount {
+			continue
+		}
+		c.Logger.Warn("Requests waited for a database connection since the last sample",
+			zap.String("pool", name),
+			zap.Int64("waits", stats.WaitCount-previous.WaitCount),
+			zap.Duration("wait_duration", stats.WaitDuration-previous.WaitDuration),
+			zap.Int("in_use", stats.InUse),
+			zap.Int("max_open_connections", stats.MaxOpenConnections))
+	}
+
+	c.mu.Lock()
+	c.monitor.pools = pools
+	c.monitor.replicas = states
+	c.routable = routable
+	c.mu.Unlock()
+}
@@ -0,0 +1,47 @@
+package erpsync
+
+import (
+	"fmt"
+	"time"
+)
+
+// ConflictPolicy decides which side wins when a record changed both in the
+// ERP and in the catalog since the last sync
+type ConflictPolicy string
+
+const (
+	// PolicyERPWins always applies the ERP record
+	PolicyERPWins ConflictPolicy = "erp_wins"
+	// PolicyLocalWins keeps the catalog record and skips the ERP change
+	PolicyLocalWins ConflictPolicy = "local_wins"
+	// PolicyNewestWins applies whichever change has the later updated_at
+	PolicyNewestWins ConflictPolicy = "newest_wins"
+)
+
+// ParseConflictPolicy validates a policy name, defaulting to PolicyERPWins
+func ParseConflictPolicy(name string) (ConflictPolicy, error) {
+	switch policy := ConflictPolicy(name); policy {
+	case "":
+		return PolicyERPWins, nil
+	case PolicyERPWins, PolicyLocalWins, PolicyNewestWins:
+		return policy, nil
+	}
+	return "", fmt.Errorf("unknown conflict policy %q", name)
+}
+
+// Resolve decides whether a pulled record should replace the local one. The
+// records conflict when the local record changed after lastSync, the time the
+// previous pull finished.
+func (p ConflictPolicy) Resolve(remoteUpdatedAt, localUpdatedAt, lastSync time.Time) (apply, conflict bool) {
+	if !localUpdatedAt.After(lastSync) {
+		return true, false
+	}
+
+	switch p {
+	case PolicyLocalWins:
+		return false, true
+	case PolicyNewestWins:
+		return remoteUpdatedAt.After(localUpdatedAt), true
+	}
+	return true, true
+}
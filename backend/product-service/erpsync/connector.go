@@ -0,0 +1,84 @@
+// Package erpsync synchronizes products, stock and prices with external ERP
+// systems. Each ERP is reached through a Connector; the sync service pulls
+// and pushes incremental changes using cursors over the records' updated_at.
+package erpsync
+
+import (
+	"context"
+	"time"
+)
+
+// Entities that can be synchronized
+const (
+	EntityProducts = "products"
+	EntityStock    = "stock"
+	EntityPrices   = "prices"
+)
+
+// Entities lists all synchronized entities in the order they are synced
+var Entities = []string{EntityProducts, EntityPrices, EntityStock}
+
+// Sync directions
+const (
+	DirectionPull = "pull" // ERP to catalog
+	DirectionPush = "push" // Catalog to ERP
+)
+
+// Cursor marks how far an entity has been synchronized in one direction.
+// Records are ordered by updated_at and then by SKU, so records sharing a
+// timestamp are neither skipped nor read twice.
+type Cursor struct {
+	UpdatedAt time.Time
+	SKU       string
+}
+
+// After reports whether a record with the given updated_at and SKU comes
+// after the cursor
+func (c Cursor) After(updatedAt time.Time, sku string) bool {
+	if updatedAt.Equal(c.UpdatedAt) {
+		return sku > c.SKU
+	}
+	return updatedAt.After(c.UpdatedAt)
+}
+
+// ProductRecord is the ERP view of a product's descriptive fields
+type ProductRecord struct {
+	SKU         string
+	Title       string
+	Description string
+	IsPublished bool
+	UpdatedAt   time.Time
+}
+
+// StockRecord is the on-hand quantity of a SKU in one warehouse
+type StockRecord struct {
+	SKU         string
+	WarehouseID string
+	Quantity    int
+	UpdatedAt   time.Time
+}
+
+// PriceRecord is the selling price of a SKU
+type PriceRecord struct {
+	SKU            string
+	Amount         float64
+	DiscountAmount *float64
+	Currency       string
+	UpdatedAt      time.Time
+}
+
+// Connector exchanges records with one ERP system. Pull methods return the
+// records changed after the cursor ordered by updated_at and SKU; push methods
+// deliver local changes.
+type Connector interface {
+	Name() string
+
+	PullProducts(ctx context.Context, since Cursor) ([]ProductRecord, error)
+	PushProducts(ctx context.Context, records []ProductRecord) error
+
+	PullStock(ctx context.Context, since Cursor) ([]StockRecord, error)
+	PushStock(ctx context.Context, records []StockRecord) error
+
+	PullPrices(ctx context.Context, since Cursor) ([]PriceRecord, error)
+	PushPrices(ctx context.Context, records []PriceRecord) error
+}
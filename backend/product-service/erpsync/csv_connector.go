@@ -0,0 +1,251 @@
+package erpsync
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CSV column layouts, one file per entity
+var (
+	productColumns = []string{"sku", "title", "description", "is_published", "updated_at"}
+	stockColumns   = []string{"sku", "warehouse_id", "quantity", "updated_at"}
+	priceColumns   = []string{"sku", "amount", "discount_amount", "currency", "updated_at"}
+)
+
+// CSVConnector is the reference connector for ERPs that exchange CSV files
+// through a drop folder. The ERP exports inbound/<entity>.csv with all rows
+// and their updated_at; pushed changes are written to
+// outbound/<entity>_<timestamp>.csv. Timestamps use RFC 3339.
+type CSVConnector struct {
+	name      string
+	transport Transport
+	now       func() time.Time
+}
+
+// Ensure CSVConnector implements Connector
+var _ Connector = (*CSVConnector)(nil)
+
+// NewCSVConnector creates a CSV connector exchanging files over transport
+func NewCSVConnector(name string, transport Transport) *CSVConnector {
+	return &CSVConnector{name: name, transport: transport, now: time.Now}
+}
+
+// Name returns the connector name used for cursors and run logs
+func (c *CSVConnector) Name() string {
+	return c.name
+}
+
+// PullProducts reads the product rows changed after the cursor
+func (c *CSVConnector) PullProducts(ctx context.Context, since Cursor) ([]ProductRecord, error) {
+	rows, err := c.readRows(ctx, EntityProducts, productColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []ProductRecord
+	for i, row := range rows {
+		updatedAt, err := time.Parse(time.RFC3339, row[4])
+		if err != nil {
+			return nil, rowError(EntityProducts, i, "updated_at", err)
+		}
+		if !since.After(updatedAt, row[0]) {
+			continue
+		}
+		published, err := strconv.ParseBool(row[3])
+		if err != nil {
+			return nil, rowError(EntityProducts, i, "is_published", err)
+		}
+		records = append(records, ProductRecord{
+			SKU:         row[0],
+			Title:       row[1],
+			Description: row[2],
+			IsPublished: published,
+			UpdatedAt:   updatedAt,
+		})
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return recordLess(records[i].UpdatedAt, records[i].SKU, records[j].UpdatedAt, records[j].SKU)
+	})
+	return records, nil
+}
+
+// PushProducts writes product changes to the outbound folder
+func (c *CSVConnector) PushProducts(ctx context.Context, records []ProductRecord) error {
+	rows := make([][]string, len(records))
+	for i, r := range records {
+		rows[i] = []string{r.SKU, r.Title, r.Description, strconv.FormatBool(r.IsPublished), formatTime(r.UpdatedAt)}
+	}
+	return c.writeRows(ctx, EntityProducts, productColumns, rows)
+}
+
+// PullStock reads the stock rows changed after the cursor
+func (c *CSVConnector) PullStock(ctx context.Context, since Cursor) ([]StockRecord, error) {
+	rows, err := c.readRows(ctx, EntityStock, stockColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []StockRecord
+	for i, row := range rows {
+		updatedAt, err := time.Parse(time.RFC3339, row[3])
+		if err != nil {
+			return nil, rowError(EntityStock, i, "updated_at", err)
+		}
+		if !since.After(updatedAt, row[0]) {
+			continue
+		}
+		quantity, err := strconv.Atoi(row[2])
+		if err != nil || quantity < 0 {
+			return nil, rowError(EntityStock, i, "quantity", err)
+		}
+		records = append(records, StockRecord{
+			SKU:         row[0],
+			WarehouseID: row[1],
+			Quantity:    quantity,
+			UpdatedAt:   updatedAt,
+		})
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return recordLess(records[i].UpdatedAt, records[i].SKU, records[j].UpdatedAt, records[j].SKU)
+	})
+	return records, nil
+}
+
+// PushStock writes stock levels to the outbound folder
+func (c *CSVConnector) PushStock(ctx context.Context, records []StockRecord) error {
+	rows := make([][]string, len(records))
+	for i, r := range records {
+		rows[i] = []string{r.SKU, r.WarehouseID, strconv.Itoa(r.Quantity), formatTime(r.UpdatedAt)}
+	}
+	return c.writeRows(ctx, EntityStock, stockColumns, rows)
+}
+
+// PullPrices reads the price rows changed after the cursor
+func (c *CSVConnector) PullPrices(ctx context.Context, since Cursor) ([]PriceRecord, error) {
+	rows, err := c.readRows(ctx, EntityPrices, priceColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []PriceRecord
+	for i, row := range rows {
+		updatedAt, err := time.Parse(time.RFC3339, row[4])
+		if err != nil {
+			return nil, rowError(EntityPrices, i, "updated_at", err)
+		}
+		if !since.After(updatedAt, row[0]) {
+			continue
+		}
+		amount, err := strconv.ParseFloat(row[1], 64)
+		if err != nil || amount <= 0 {
+			return nil, rowError(EntityPrices, i, "amount", err)
+		}
+		record := PriceRecord{SKU: row[0], Amount: amount, Currency: row[3], UpdatedAt: updatedAt}
+		if row[2] != "" {
+			discount, err := strconv.ParseFloat(row[2], 64)
+			if err != nil {
+				return nil, rowError(EntityPrices, i, "discount_amount", err)
+			}
+			record.DiscountAmount = &discount
+		}
+		records = append(records, record)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return recordLess(records[i].UpdatedAt, records[i].SKU, records[j].UpdatedAt, records[j].SKU)
+	})
+	return records, nil
+}
+
+// PushPrices writes price changes to the outbound folder
+func (c *CSVConnector) PushPrices(ctx context.Context, records []PriceRecord) error {
+	rows := make([][]string, len(records))
+	for i, r := range records {
+		discount := ""
+		if r.DiscountAmount != nil {
+			discount = strconv.FormatFloat(*r.DiscountAmount, 'f', 2, 64)
+		}
+		rows[i] = []string{r.SKU, strconv.FormatFloat(r.Amount, 'f', 2, 64), discount, r.Currency, formatTime(r.UpdatedAt)}
+	}
+	return c.writeRows(ctx, EntityPrices, priceColumns, rows)
+}
+
+// readRows reads inbound/<entity>.csv and checks its header. A missing file
+// means the ERP has nothing to send.
+func (c *CSVConnector) readRows(ctx context.Context, entity string, columns []string) ([][]string, error) {
+	data, err := c.transport.ReadFile(ctx, "inbound/"+entity+".csv")
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s file: %w", entity, err)
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = len(columns)
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s file: %w", entity, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	for i, column := range columns {
+		if strings.TrimSpace(rows[0][i]) != column {
+			return nil, fmt.Errorf("%s file header must be %s", entity, strings.Join(columns, ","))
+		}
+	}
+
+	return rows[1:], nil
+}
+
+// writeRows writes outbound/<entity>_<timestamp>.csv. Nothing is written when
+// there are no rows.
+func (c *CSVConnector) writeRows(ctx context.Context, entity string, columns []string, rows [][]string) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Write(columns)
+	writer.WriteAll(rows)
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to encode %s file: %w", entity, err)
+	}
+
+	name := fmt.Sprintf("outbound/%s_%s.csv", entity, c.now().UTC().Format("20060102T150405.000Z"))
+	if err := c.transport.WriteFile(ctx, name, buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write %s file: %w", entity, err)
+	}
+	return nil
+}
+
+func recordLess(aTime time.Time, aSKU string, bTime time.Time, bSKU string) bool {
+	if aTime.Equal(bTime) {
+		return aSKU < bSKU
+	}
+	return aTime.Before(bTime)
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// rowError reports an invalid value; row numbers count the header as row 1
+func rowError(entity string, index int, column string, err error) error {
+	if err == nil {
+		err = errors.New("out of range")
+	}
+	return fmt.Errorf("%s file row %d: invalid %s: %w", entity, index+2, column, err)
+}
@@ -0,0 +1,126 @@
+package erpsync
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestConflictPolicyResolve(t *testing.T) {
+	lastSync := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	before := lastSync.Add(-time.Hour)
+	after := lastSync.Add(time.Hour)
+	later := lastSync.Add(2 * time.Hour)
+
+	tests := []struct {
+		name         string
+		policy       ConflictPolicy
+		remote       time.Time
+		local        time.Time
+		wantApply    bool
+		wantConflict bool
+	}{
+		{"unchanged locally", PolicyLocalWins, after, before, true, false},
+		{"erp wins", PolicyERPWins, after, later, true, true},
+		{"local wins", PolicyLocalWins, later, after, false, true},
+		{"newest is remote", PolicyNewestWins, later, after, true, true},
+		{"newest is local", PolicyNewestWins, after, later, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apply, conflict := tt.policy.Resolve(tt.remote, tt.local, lastSync)
+			if apply != tt.wantApply || conflict != tt.wantConflict {
+				t.Errorf("Resolve() = (%v, %v), want (%v, %v)", apply, conflict, tt.wantApply, tt.wantConflict)
+			}
+		})
+	}
+}
+
+func TestParseConflictPolicy(t *testing.T) {
+	if policy, err := ParseConflictPolicy(""); err != nil || policy != PolicyERPWins {
+		t.Errorf("ParseConflictPolicy(\"\") = %v, %v", policy, err)
+	}
+	if _, err := ParseConflictPolicy("remote_wins"); err == nil {
+		t.Error("expected an error for an unknown policy")
+	}
+}
+
+func TestCSVConnectorPullsChangesAfterCursor(t *testing.T) {
+	dir := t.TempDir()
+	transport, err := NewLocalTransport(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.MkdirAll(filepath.Join(dir, "inbound"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	content := "sku,amount,discount_amount,currency,updated_at\n" +
+		"B,20.00,,USD,2024-01-02T00:00:00Z\n" +
+		"A,10.00,8.50,USD,2024-01-02T00:00:00Z\n" +
+		"C,5.00,,USD,2024-01-01T00:00:00Z\n"
+	if err := os.WriteFile(filepath.Join(dir, "inbound", "prices.csv"), []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	connector := NewCSVConnector("erp", transport)
+	since := Cursor{UpdatedAt: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), SKU: "A"}
+	records, err := connector.PullPrices(context.Background(), since)
+	if err != nil {
+		t.Fatalf("PullPrices() error = %v", err)
+	}
+	if len(records) != 1 || records[0].SKU != "B" {
+		t.Fatalf("PullPrices() = %+v, want only B", records)
+	}
+
+	records, err = connector.PullPrices(context.Background(), Cursor{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := []string{records[0].SKU, records[1].SKU, records[2].SKU}; strings.Join(got, "") != "CAB" {
+		t.Errorf("records are ordered %v, want C A B", got)
+	}
+	if records[1].DiscountAmount == nil || *records[1].DiscountAmount != 8.5 {
+		t.Errorf("discount of A = %v, want 8.5", records[1].DiscountAmount)
+	}
+}
+
+func TestCSVConnectorPushWritesOutboundFile(t *testing.T) {
+	dir := t.TempDir()
+	transport, err := NewLocalTransport(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	connector := NewCSVConnector("erp", transport)
+	connector.now = func() time.Time { return time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC) }
+
+	err = connector.PushStock(context.Background(), []StockRecord{
+		{SKU: "A", WarehouseID: "w1", Quantity: 3, UpdatedAt: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	})
+	if err != nil {
+		t.Fatalf("PushStock() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "outbound", "stock_20240304T050607.000Z.csv"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "sku,warehouse_id,quantity,updated_at\nA,w1,3,2024-03-01T00:00:00Z\n"
+	if string(data) != want {
+		t.Errorf("outbound file = %q, want %q", data, want)
+	}
+}
+
+func TestCSVConnectorMissingFileHasNoRecords(t *testing.T) {
+	transport, err := NewLocalTransport(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	records, err := NewCSVConnector("erp", transport).PullStock(context.Background(), Cursor{})
+	if err != nil || len(records) != 0 {
+		t.Errorf("PullStock() = %v, %v, want no records", records, err)
+	}
+}
@@ -0,0 +1,420 @@
+package erpsync
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"os"
+	"path"
+	"strconv"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+)
+
+// SFTPConfig holds the connection settings of an SFTP drop folder
+type SFTPConfig struct {
+	Host string
+	Port int
+	User string
+	// Password or PrivateKeyPath authenticate the user
+	Password       string
+	PrivateKeyPath string
+	// KnownHostsPath verifies the server; connecting without it is refused
+	KnownHostsPath string
+	Dir            string
+}
+
+// SFTPTransport exchanges files with an SFTP server. Each call opens its own
+// connection, which suits the few files a sync run moves.
+type SFTPTransport struct {
+	config SFTPConfig
+}
+
+// Ensure SFTPTransport implements Transport
+var _ Transport = (*SFTPTransport)(nil)
+
+// NewSFTPTransport validates the settings and creates an SFTP transport
+func NewSFTPTransport(config SFTPConfig) (*SFTPTransport, error) {
+	if config.Host == "" || config.User == "" {
+		return nil, errors.New("sftp host and user are required")
+	}
+	if config.KnownHostsPath == "" {
+		return nil, errors.New("sftp known hosts file is required")
+	}
+	if config.Password == "" && config.PrivateKeyPath == "" {
+		return nil, errors.New("sftp password or private key is required")
+	}
+	if config.Port == 0 {
+		config.Port = 22
+	}
+	return &SFTPTransport{config: config}, nil
+}
+
+// ReadFile downloads a file relative to the configured directory
+func (t *SFTPTransport) ReadFile(ctx context.Context, name string) ([]byte, error) {
+	client, err := t.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return client.readFile(path.Join(t.config.Dir, name))
+}
+
+// WriteFile uploads to a temporary file and renames it into place
+func (t *SFTPTransport) WriteFile(ctx context.Context, name string, data []byte) error {
+	client, err := t.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	target := path.Join(t.config.Dir, name)
+	tmp := target + ".tmp"
+	if err := client.writeFile(tmp, data); err != nil {
+		return err
+	}
+	// SFTP v3 cannot rename over an existing file
+	if err := client.remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return client.rename(tmp, target)
+}
+
+func (t *SFTPTransport) connect(ctx context.Context) (*sftpClient, error) {
+	hostKeyCallback, err := knownhosts.New(t.config.KnownHostsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load known hosts: %w", err)
+	}
+
+	var auth []ssh.AuthMethod
+	if t.config.PrivateKeyPath != "" {
+		key, err := os.ReadFile(t.config.PrivateKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sftp private key: %w", err)
+		}
+		signer, err := ssh.ParsePrivateKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse sftp private key: %w", err)
+		}
+		auth = append(auth, ssh.PublicKeys(signer))
+	}
+	if t.config.Password != "" {
+		auth = append(auth, ssh.Password(t.config.Password))
+	}
+
+	addr := net.JoinHostPort(t.config.Host, strconv.Itoa(t.config.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to sftp server: %w", err)
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, addr, &ssh.ClientConfig{
+		User:            t.config.User,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to open ssh connection: %w", err)
+	}
+
+	client, err := newSFTPClient(ssh.NewClient(sshConn, chans, reqs))
+	if err != nil {
+		sshConn.Close()
+		return nil, err
+	}
+
+	// Abort blocked reads and writes when the sync is cancelled
+	go func() {
+		select {
+		case <-ctx.Done():
+			client.Close()
+		case <-client.done:
+		}
+	}()
+
+	return client, nil
+}
+
+// SFTP protocol version 3 packet types and flags used by the client
+const (
+	sftpInit    = 1
+	sftpVersion = 2
+	sftpOpen    = 3
+	sftpClose   = 4
+	sftpRead    = 5
+	sftpWrite   = 6
+	sftpRemove  = 13
+	sftpRename  = 18
+	sftpStatus  = 101
+	sftpHandle  = 102
+	sftpData    = 103
+
+	sftpFlagRead   = 0x01
+	sftpFlagWrite  = 0x02
+	sftpFlagCreate = 0x08
+	sftpFlagTrunc  = 0x10
+
+	sftpStatusOK         = 0
+	sftpStatusEOF        = 1
+	sftpStatusNoSuchFile = 2
+
+	// sftpChunkSize stays below the 32KB servers are required to accept
+	sftpChunkSize = 32 * 1024
+	// sftpMaxPacket bounds the packets accepted from the server
+	sftpMaxPacket = 256 * 1024
+)
+
+// sftpClient is a minimal sequential SFTP v3 client covering the operations
+// file-based connectors need
+type sftpClient struct {
+	conn   *ssh.Client
+	stdin  io.WriteCloser
+	stdout io.Reader
+	nextID uint32
+	done   chan struct{}
+}
+
+func newSFTPClient(conn *ssh.Client) (*sftpClient, error) {
+	session, err := conn.NewSession()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open ssh session: %w", err)
+	}
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := session.RequestSubsystem("sftp"); err != nil {
+		return nil, fmt.Errorf("failed to start sftp subsystem: %w", err)
+	}
+
+	c := &sftpClient{conn: conn, stdin: stdin, stdout: stdout, done: make(chan struct{})}
+
+	// INIT carries the version instead of a request ID
+	if err := c.send(sftpInit, uint32(3)); err != nil {
+		return nil, err
+	}
+	typ, _, err := c.receive()
+	if err != nil {
+		return nil, err
+	}
+	if typ != sftpVersion {
+		return nil, fmt.Errorf("unexpected sftp handshake packet %d", typ)
+	}
+
+	return c, nil
+}
+
+// Close ends the SFTP session and the SSH connection
+func (c *sftpClient) Close() error {
+	select {
+	case <-c.done:
+		return nil
+	default:
+		close(c.done)
+	}
+	return c.conn.Close()
+}
+
+func (c *sftpClient) readFile(name string) ([]byte, error) {
+	handle, err := c.open(name, sftpFlagRead)
+	if err != nil {
+		return nil, err
+	}
+	defer c.closeHandle(handle)
+
+	var data []byte
+	for {
+		typ, payload, err := c.request(sftpRead, handle, uint64(len(data)), uint32(sftpChunkSize))
+		if err != nil {
+			return nil, err
+		}
+		if typ == sftpStatus {
+			err := statusError(payload, name)
+			if errors.Is(err, io.EOF) {
+				return data, nil
+			}
+			if err == nil {
+				err = errors.New("sftp server returned no data")
+			}
+			return nil, err
+		}
+		if typ != sftpData {
+			return nil, fmt.Errorf("unexpected sftp packet %d", typ)
+		}
+		chunk, _, ok := readString(payload)
+		if !ok {
+			return nil, errors.New("malformed sftp data packet")
+		}
+		data = append(data, chunk...)
+	}
+}
+
+func (c *sftpClient) writeFile(name string, data []byte) error {
+	handle, err := c.open(name, sftpFlagWrite|sftpFlagCreate|sftpFlagTrunc)
+	if err != nil {
+		return err
+	}
+
+	for offset := 0; offset < len(data); offset += sftpChunkSize {
+		end := offset + sftpChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		if err := c.expectStatus(name, sftpWrite, handle, uint64(offset), data[offset:end]); err != nil {
+			c.closeHandle(handle)
+			return err
+		}
+	}
+
+	return c.closeHandle(handle)
+}
+
+func (c *sftpClient) remove(name string) error {
+	return c.expectStatus(name, sftpRemove, name)
+}
+
+func (c *sftpClient) rename(from, to string) error {
+	return c.expectStatus(from, sftpRename, from, to)
+}
+
+func (c *sftpClient) open(name string, flags uint32) (string, error) {
+	// The trailing zero is an empty attribute set
+	typ, payload, err := c.request(sftpOpen, name, flags, uint32(0))
+	if err != nil {
+		return "", err
+	}
+	if typ == sftpStatus {
+		if err := statusError(payload, name); err != nil {
+			return "", err
+		}
+		return "", errors.New("sftp server returned no file handle")
+	}
+	if typ != sftpHandle {
+		return "", fmt.Errorf("unexpected sftp packet %d", typ)
+	}
+	handle, _, ok := readString(payload)
+	if !ok {
+		return "", errors.New("malformed sftp handle packet")
+	}
+	return string(handle), nil
+}
+
+func (c *sftpClient) closeHandle(handle string) error {
+	return c.expectStatus("", sftpClose, handle)
+}
+
+func (c *sftpClient) expectStatus(name string, typ byte, fields ...interface{}) error {
+	respType, payload, err := c.request(typ, fields...)
+	if err != nil {
+		return err
+	}
+	if respType != sftpStatus {
+		return fmt.Errorf("unexpected sftp packet %d", respType)
+	}
+	return statusError(payload, name)
+}
+
+// request sends a packet with the next request ID and returns the response
+// payload after its ID
+func (c *sftpClient) request(typ byte, fields ...interface{}) (byte, []byte, error) {
+	c.nextID++
+	id := c.nextID
+	if err := c.send(typ, append([]interface{}{id}, fields...)...); err != nil {
+		return 0, nil, err
+	}
+
+	respType, payload, err := c.receive()
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(payload) < 4 || binary.BigEndian.Uint32(payload) != id {
+		return 0, nil, errors.New("sftp response does not match request")
+	}
+	return respType, payload[4:], nil
+}
+
+func (c *sftpClient) send(typ byte, fields ...interface{}) error {
+	packet := []byte{0, 0, 0, 0, typ}
+	for _, field := range fields {
+		switch v := field.(type) {
+		case uint32:
+			packet = binary.BigEndian.AppendUint32(packet, v)
+		case uint64:
+			packet = binary.BigEndian.AppendUint64(packet, v)
+		case string:
+			packet = binary.BigEndian.AppendUint32(packet, uint32(len(v)))
+			packet = append(packet, v...)
+		case []byte:
+			packet = binary.BigEndian.AppendUint32(packet, uint32(len(v)))
+			packet = append(packet, v...)
+		default:
+			return fmt.Errorf("unsupported sftp field type %T", field)
+		}
+	}
+	binary.BigEndian.PutUint32(packet, uint32(len(packet)-4))
+
+	if _, err := c.stdin.Write(packet); err != nil {
+		return fmt.Errorf("failed to send sftp packet: %w", err)
+	}
+	return nil
+}
+
+func (c *sftpClient) receive() (byte, []byte, error) {
+	var header [5]byte
+	if _, err := io.ReadFull(c.stdout, header[:]); err != nil {
+		return 0, nil, fmt.Errorf("failed to read sftp packet: %w", err)
+	}
+	length := binary.BigEndian.Uint32(header[:4])
+	if length < 1 || length > sftpMaxPacket {
+		return 0, nil, fmt.Errorf("invalid sftp packet length %d", length)
+	}
+
+	payload := make([]byte, length-1)
+	if _, err := io.ReadFull(c.stdout, payload); err != nil {
+		return 0, nil, fmt.Errorf("failed to read sftp packet: %w", err)
+	}
+	return header[4], payload, nil
+}
+
+// statusError converts a STATUS payload to an error. EOF maps to io.EOF and a
+// missing file to an error wrapping fs.ErrNotExist.
+func statusError(payload []byte, name string) error {
+	if len(payload) < 4 {
+		return errors.New("malformed sftp status packet")
+	}
+	code := binary.BigEndian.Uint32(payload)
+	message, _, _ := readString(payload[4:])
+
+	switch code {
+	case sftpStatusOK:
+		return nil
+	case sftpStatusEOF:
+		return io.EOF
+	case sftpStatusNoSuchFile:
+		return &fs.PathError{Op: "sftp", Path: name, Err: fs.ErrNotExist}
+	}
+	return fmt.Errorf("sftp error %d on %s: %s", code, name, message)
+}
+
+func readString(b []byte) ([]byte, []byte, bool) {
+	if len(b) < 4 {
+		return nil, nil, false
+	}
+	n := binary.BigEndian.Uint32(b)
+	if uint32(len(b)-4) < n {
+		return nil, nil, false
+	}
+	return b[4 : 4+n], b[4+n:], true
+}
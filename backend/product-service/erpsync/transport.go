@@ -0,0 +1,61 @@
+package erpsync
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// Transport reads and writes the files a file-based connector exchanges with
+// the ERP. ReadFile returns an error wrapping fs.ErrNotExist for missing files.
+type Transport interface {
+	ReadFile(ctx context.Context, name string) ([]byte, error)
+	// WriteFile replaces the named file. Readers never see a partial file.
+	WriteFile(ctx context.Context, name string, data []byte) error
+}
+
+// LocalTransport exchanges files through a local directory, e.g. a share the
+// ERP exports to
+type LocalTransport struct {
+	Dir string
+}
+
+// Ensure LocalTransport implements Transport
+var _ Transport = (*LocalTransport)(nil)
+
+// NewLocalTransport creates a transport for dir, creating it if needed
+func NewLocalTransport(dir string) (*LocalTransport, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create sync directory: %w", err)
+	}
+	return &LocalTransport{Dir: dir}, nil
+}
+
+// ReadFile reads a file relative to the transport directory
+func (t *LocalTransport) ReadFile(_ context.Context, name string) ([]byte, error) {
+	return os.ReadFile(t.path(name))
+}
+
+// WriteFile writes to a temporary file and renames it into place
+func (t *LocalTransport) WriteFile(_ context.Context, name string, data []byte) error {
+	target := t.path(name)
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("failed to create folder: %w", err)
+	}
+
+	tmp := target + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write file: %w", err)
+	}
+	if err := os.Rename(tmp, target); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to move file into place: %w", err)
+	}
+	return nil
+}
+
+// path resolves name inside the transport directory
+func (t *LocalTransport) path(name string) string {
+	return filepath.Join(t.Dir, filepath.Clean("/"+name))
+}
@@ -0,0 +1,69 @@
+// Package events is an in-process bus for catalog changes, letting modules
+// react to product writes without the product service knowing about them.
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Product event types
+const (
+	ProductCreated = "product.created"
+	ProductUpdated = "product.updated"
+	ProductDeleted = "product.deleted"
+)
+
+// Event describes a change to a product of a store
+type Event struct {
+	Type      string
+	ProductID string
+	TenantID  string
+	// ActorID is the user who made the change, empty when unknown
+	ActorID    string
+	OccurredAt time.Time
+}
+
+// Handler reacts to an event. Handlers run on the publishing goroutine, so
+// slow work must be queued rather than done inline.
+type Handler func(ctx context.Context, event Event)
+
+// Bus delivers published events to the handlers subscribed to their type. A
+// nil bus drops events.
+type Bus struct {
+	mu       sync.RWMutex
+	handlers map[string][]Handler
+}
+
+// NewBus creates an event bus without subscribers
+func NewBus() *Bus {
+	return &Bus{handlers: make(map[string][]Handler)}
+}
+
+// Subscribe registers handler for the given event types
+func (b *Bus) Subscribe(handler Handler, types ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, eventType := range types {
+		b.handlers[eventType] = append(b.handlers[eventType], handler)
+	}
+}
+
+// Publish delivers event to the handlers of its type
+func (b *Bus) Publish(ctx context.Context, event Event) {
+	if b == nil {
+		return
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now().UTC()
+	}
+
+	b.mu.RLock()
+	handlers := b.handlers[event.Type]
+	b.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(ctx, event)
+	}
+}
@@ -0,0 +1,66 @@
+package events
+
+import (
+	"context"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/shared/catalogevents"
+)
+
+// relayQueueSize bounds the events waiting to be published to Redis
+const relayQueueSize = 1000
+
+// Relay publishes the product events of the bus to the catalog events
+// channel of Redis, for the services outside this process. Events are queued
+// so that publishing never slows down product writes.
+type Relay struct {
+	client *redis.Client
+	queue  chan Event
+	logger *zap.Logger
+}
+
+// NewRelay subscribes a relay to the product events of bus; call Start to
+// publish them
+func NewRelay(bus *Bus, client *redis.Client, logger *zap.Logger) *Relay {
+	r := &Relay{
+		client: client,
+		queue:  make(chan Event, relayQueueSize),
+		logger: logger.Named("event_relay"),
+	}
+	bus.Subscribe(r.enqueue, ProductCreated, ProductUpdated, ProductDeleted)
+	return r
+}
+
+func (r *Relay) enqueue(_ context.Context, event Event) {
+	select {
+	case r.queue <- event:
+	default:
+		r.logger.Warn("Event relay queue full, dropping product event",
+			zap.String("type", event.Type),
+			zap.String("product_id", event.ProductID))
+	}
+}
+
+// Start publishes the queued events until the context is cancelled
+func (r *Relay) Start(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-r.queue:
+				err := catalogevents.Publish(ctx, r.client, catalogevents.Event{
+					Type:       event.Type,
+					TenantID:   event.TenantID,
+					ProductID:  event.ProductID,
+					OccurredAt: event.OccurredAt,
+				})
+				if err != nil {
+					r.logger.Warn("Failed to publish product event", zap.String("product_id", event.ProductID), zap.Error(err))
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,209 @@
+// Package feeds renders product catalog feeds in the formats marketplaces
+// import: Google Merchant Center XML and Facebook Catalog CSV.
+package feeds
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Supported feed formats
+const (
+	FormatGoogleMerchant  = "google_merchant"
+	FormatFacebookCatalog = "facebook_catalog"
+)
+
+// Formats lists all supported feed formats
+var Formats = []string{FormatGoogleMerchant, FormatFacebookCatalog}
+
+// Availability values shared by both marketplaces
+const (
+	AvailabilityInStock    = "in stock"
+	AvailabilityOutOfStock = "out of stock"
+	AvailabilityBackorder  = "backorder"
+)
+
+// maxAdditionalImages is the number of extra images Google Merchant accepts
+const maxAdditionalImages = 10
+
+// Item is one product in a feed
+type Item struct {
+	ID                   string
+	Title                string
+	Description          string
+	Link                 string
+	ImageLink            string
+	AdditionalImageLinks []string
+	Brand                string
+	SKU                  string
+	Availability         string
+	Price                float64
+	SalePrice            float64 // 0 when the product is not discounted
+	Currency             string
+}
+
+// IsValidFormat reports whether format is a supported feed format
+func IsValidFormat(format string) bool {
+	for _, f := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// FileName returns the file name a feed of the given format is served under
+func FileName(format string) string {
+	if format == FormatGoogleMerchant {
+		return "google_merchant.xml"
+	}
+	return "facebook_catalog.csv"
+}
+
+// ContentType returns the MIME type of a feed of the given format
+func ContentType(format string) string {
+	if format == FormatGoogleMerchant {
+		return "application/xml"
+	}
+	return "text/csv"
+}
+
+// Write renders items in the given format
+func Write(w io.Writer, format, title, link string, items []Item) error {
+	switch format {
+	case FormatGoogleMerchant:
+		return WriteGoogleMerchantXML(w, title, link, items)
+	case FormatFacebookCatalog:
+		return WriteFacebookCSV(w, items)
+	}
+	return fmt.Errorf("unsupported feed format %q", format)
+}
+
+type googleRSS struct {
+	XMLName xml.Name      `xml:"rss"`
+	Version string        `xml:"version,attr"`
+	XmlnsG  string        `xml:"xmlns:g,attr"`
+	Channel googleChannel `xml:"channel"`
+}
+
+type googleChannel struct {
+	Title string       `xml:"title"`
+	Link  string       `xml:"link"`
+	Items []googleItem `xml:"item"`
+}
+
+type googleItem struct {
+	ID                   string   `xml:"g:id"`
+	Title                string   `xml:"g:title"`
+	Description          string   `xml:"g:description"`
+	Link                 string   `xml:"g:link"`
+	ImageLink            string   `xml:"g:image_link,omitempty"`
+	AdditionalImageLinks []string `xml:"g:additional_image_link,omitempty"`
+	Brand                string   `xml:"g:brand,omitempty"`
+	MPN                  string   `xml:"g:mpn,omitempty"`
+	Condition            string   `xml:"g:condition"`
+	Availability         string   `xml:"g:availability"`
+	Price                string   `xml:"g:price"`
+	SalePrice            string   `xml:"g:sale_price,omitempty"`
+	IdentifierExists     string   `xml:"g:identifier_exists,omitempty"`
+}
+
+// WriteGoogleMerchantXML renders items as a Google Merchant Center RSS 2.0 feed
+func WriteGoogleMerchantXML(w io.Writer, title, link string, items []Item) error {
+	feed := googleRSS{
+		Version: "2.0",
+		XmlnsG:  "http://base.google.com/ns/1.0",
+		Channel: googleChannel{
+			Title: title,
+			Link:  link,
+			Items: make([]googleItem, len(items)),
+		},
+	}
+
+	for i, item := range items {
+		additional := item.AdditionalImageLinks
+		if len(additional) > maxAdditionalImages {
+			additional = additional[:maxAdditionalImages]
+		}
+
+		entry := googleItem{
+			ID:                   item.ID,
+			Title:                item.Title,
+			Description:          item.Description,
+			Link:                 item.Link,
+			ImageLink:            item.ImageLink,
+			AdditionalImageLinks: additional,
+			Brand:                item.Brand,
+			MPN:                  item.SKU,
+			Condition:            "new",
+			Availability:         item.Availability,
+			Price:                formatPrice(item.Price, item.Currency),
+		}
+		if item.SalePrice > 0 {
+			entry.SalePrice = formatPrice(item.SalePrice, item.Currency)
+		}
+		// Products without a brand or GTIN must say so or Google rejects them
+		if item.Brand == "" {
+			entry.IdentifierExists = "no"
+		}
+		feed.Channel.Items[i] = entry
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(feed); err != nil {
+		return fmt.Errorf("failed to encode google merchant feed: %w", err)
+	}
+	return enc.Flush()
+}
+
+var facebookHeader = []string{
+	"id", "title", "description", "availability", "condition", "price", "sale_price",
+	"link", "image_link", "additional_image_link", "brand",
+}
+
+// WriteFacebookCSV renders items as a Facebook Catalog CSV feed
+func WriteFacebookCSV(w io.Writer, items []Item) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(facebookHeader); err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		salePrice := ""
+		if item.SalePrice > 0 {
+			salePrice = formatPrice(item.SalePrice, item.Currency)
+		}
+		record := []string{
+			item.ID,
+			item.Title,
+			item.Description,
+			item.Availability,
+			"new",
+			formatPrice(item.Price, item.Currency),
+			salePrice,
+			item.Link,
+			item.ImageLink,
+			strings.Join(item.AdditionalImageLinks, ","),
+			item.Brand,
+		}
+		if err := cw.Write(record); err != nil {
+			return fmt.Errorf("failed to write facebook catalog row: %w", err)
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatPrice formats an amount the way both marketplaces expect, e.g. "9.99 USD"
+func formatPrice(amount float64, currency string) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64) + " " + currency
+}
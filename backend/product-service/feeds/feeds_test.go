@@ -0,0 +1,92 @@
+package feeds
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+)
+
+var testItems = []Item{
+	{
+		ID:                   "p1",
+		Title:                "Trail Shoe",
+		Description:          "Light & fast",
+		Link:                 "https://shop.example.com/products/trail-shoe",
+		ImageLink:            "https://cdn.example.com/1.jpg",
+		AdditionalImageLinks: []string{"https://cdn.example.com/2.jpg"},
+		Brand:                "Acme",
+		SKU:                  "TS-1",
+		Availability:         AvailabilityInStock,
+		Price:                120,
+		SalePrice:            99.5,
+		Currency:             "USD",
+	},
+	{
+		ID:           "p2",
+		Title:        "Sock, wool",
+		Link:         "https://shop.example.com/products/sock",
+		Availability: AvailabilityOutOfStock,
+		Price:        9.99,
+		Currency:     "EUR",
+	},
+}
+
+func TestWriteGoogleMerchantXML(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteGoogleMerchantXML(&buf, "Shop", "https://shop.example.com", testItems); err != nil {
+		t.Fatalf("WriteGoogleMerchantXML() error = %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		`<rss version="2.0" xmlns:g="http://base.google.com/ns/1.0">`,
+		`<g:id>p1</g:id>`,
+		`<g:description>Light &amp; fast</g:description>`,
+		`<g:price>120.00 USD</g:price>`,
+		`<g:sale_price>99.50 USD</g:sale_price>`,
+		`<g:additional_image_link>https://cdn.example.com/2.jpg</g:additional_image_link>`,
+		`<g:availability>out of stock</g:availability>`,
+		`<g:identifier_exists>no</g:identifier_exists>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("feed is missing %s\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "<g:sale_price>") != 1 {
+		t.Errorf("expected only the discounted item to have a sale price")
+	}
+}
+
+func TestWriteFacebookCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteFacebookCSV(&buf, testItems); err != nil {
+		t.Fatalf("WriteFacebookCSV() error = %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to read csv: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d records", len(records))
+	}
+	if records[0][0] != "id" || len(records[0]) != len(facebookHeader) {
+		t.Errorf("unexpected header %v", records[0])
+	}
+	if got := records[1][5]; got != "120.00 USD" {
+		t.Errorf("price = %q, want 120.00 USD", got)
+	}
+	if got := records[2][1]; got != "Sock, wool" {
+		t.Errorf("title = %q, want it unchanged", got)
+	}
+	if got := records[2][6]; got != "" {
+		t.Errorf("sale_price = %q, want empty", got)
+	}
+}
+
+func TestWriteRejectsUnknownFormat(t *testing.T) {
+	if err := Write(&bytes.Buffer{}, "rss", "", "", nil); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
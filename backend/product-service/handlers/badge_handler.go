@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Badge methods
+func (h *ProductHandler) ListBadgeRules(ctx context.Context, req *pb.ListBadgeRulesRequest) (*pb.ListBadgeRulesResponse, error) {
+	return h.badgeService.ListBadgeRules(ctx, req)
+}
+
+func (h *ProductHandler) SaveBadgeRule(ctx context.Context, req *pb.SaveBadgeRuleRequest) (*pb.BadgeRule, error) {
+	return h.badgeService.SaveBadgeRule(ctx, req)
+}
+
+func (h *ProductHandler) DeleteBadgeRule(ctx context.Context, req *pb.DeleteBadgeRuleRequest) (*pb.DeleteBadgeRuleResponse, error) {
+	return h.badgeService.DeleteBadgeRule(ctx, req)
+}
+
+func (h *ProductHandler) RecomputeBadges(ctx context.Context, req *pb.RecomputeBadgesRequest) (*pb.RecomputeBadgesResponse, error) {
+	return h.badgeService.RecomputeBadges(ctx, req)
+}
+
+func (h *ProductHandler) RecordProductSales(ctx context.Context, req *pb.RecordProductSalesRequest) (*pb.RecordProductSalesResponse, error) {
+	return h.badgeService.RecordProductSales(ctx, req)
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Bundle methods
+func (h *ProductHandler) CreateBundle(ctx context.Context, req *pb.CreateBundleRequest) (*pb.Product, error) {
+	if req == nil || req.Product == nil {
+		h.logger.Error("invalid request: request or product is nil")
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Product.Title == "" {
+		h.logger.Error("invalid request: bundle title is required")
+		return nil, status.Error(codes.InvalidArgument, "bundle title is required")
+	}
+
+	if len(req.Components) == 0 {
+		h.logger.Error("invalid request: bundle components are required")
+		return nil, status.Error(codes.InvalidArgument, "a bundle needs at least one component")
+	}
+
+	seen := make(map[string]bool, len(req.Components))
+	for _, component := range req.Components {
+		if component.Sku == "" || component.Quantity < 1 {
+			return nil, status.Error(codes.InvalidArgument, "bundle components need a SKU and a positive quantity")
+		}
+		if seen[component.Sku] {
+			return nil, status.Errorf(codes.InvalidArgument, "bundle component %s is listed more than once", component.Sku)
+		}
+		seen[component.Sku] = true
+	}
+
+	if req.PriceOverride != nil && req.PriceOverride.Value <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "bundle price override must be positive")
+	}
+
+	h.logger.Info("Creating bundle",
+		zap.String("title", req.Product.Title),
+		zap.Int("components", len(req.Components)))
+	return h.service.CreateBundle(ctx, req)
+}
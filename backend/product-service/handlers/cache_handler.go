@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Cache administration methods
+func (h *ProductHandler) FlushCacheNamespace(ctx context.Context, req *pb.FlushCacheNamespaceRequest) (*pb.FlushCacheNamespaceResponse, error) {
+	return h.service.FlushCacheNamespace(ctx, req)
+}
+
+// Cache schedule methods
+func (h *ProductHandler) ListCacheSchedules(ctx context.Context, req *pb.ListCacheSchedulesRequest) (*pb.ListCacheSchedulesResponse, error) {
+	return h.cacheScheduleService.ListCacheSchedules(ctx, req)
+}
+
+func (h *ProductHandler) ListCacheScheduleRuns(ctx context.Context, req *pb.ListCacheScheduleRunsRequest) (*pb.ListCacheScheduleRunsResponse, error) {
+	return h.cacheScheduleService.ListCacheScheduleRuns(ctx, req)
+}
+
+func (h *ProductHandler) RunCacheSchedule(ctx context.Context, req *pb.RunCacheScheduleRequest) (*pb.CacheScheduleRun, error) {
+	if req == nil || req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "schedule name is required")
+	}
+
+	h.logger.Info("Running cache schedule", zap.String("schedule", req.Name))
+	return h.cacheScheduleService.RunCacheSchedule(ctx, req)
+}
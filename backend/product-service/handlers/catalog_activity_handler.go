@@ -0,0 +1,12 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// ListCatalogActivity returns the recent product changes of the store
+func (h *ProductHandler) ListCatalogActivity(ctx context.Context, req *pb.ListCatalogActivityRequest) (*pb.ListCatalogActivityResponse, error) {
+	return h.catalogActivityService.ListActivity(ctx, req)
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Catalog quality methods
+func (h *ProductHandler) GetCatalogQualityReport(ctx context.Context, req *pb.GetCatalogQualityReportRequest) (*pb.CatalogQualityReport, error) {
+	return h.catalogQualityService.GetCatalogQualityReport(ctx, req)
+}
+
+func (h *ProductHandler) GetProductQualityScore(ctx context.Context, req *pb.GetProductQualityScoreRequest) (*pb.ProductQualityScore, error) {
+	return h.catalogQualityService.GetProductQualityScore(ctx, req)
+}
+
+func (h *ProductHandler) RecomputeCatalogQuality(ctx context.Context, req *pb.RecomputeCatalogQualityRequest) (*pb.RecomputeCatalogQualityResponse, error) {
+	h.logger.Info("Recomputing catalog quality", zap.String("tenant_id", tenant.FromContext(ctx)))
+	return h.catalogQualityService.RecomputeCatalogQuality(ctx, req)
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Category attribute methods
+func (h *ProductHandler) CreateCategoryAttribute(ctx context.Context, req *pb.CreateCategoryAttributeRequest) (*pb.CategoryAttribute, error) {
+	if req.Attribute != nil {
+		h.logger.Info("Creating category attribute",
+			zap.String("category_id", req.Attribute.CategoryId),
+			zap.String("name", req.Attribute.Name))
+	}
+	return h.attributeService.CreateAttribute(ctx, req)
+}
+
+func (h *ProductHandler) UpdateCategoryAttribute(ctx context.Context, req *pb.UpdateCategoryAttributeRequest) (*pb.CategoryAttribute, error) {
+	if req.Attribute != nil {
+		h.logger.Info("Updating category attribute", zap.String("id", req.Attribute.Id))
+	}
+	return h.attributeService.UpdateAttribute(ctx, req)
+}
+
+func (h *ProductHandler) ListCategoryAttributes(ctx context.Context, req *pb.ListCategoryAttributesRequest) (*pb.ListCategoryAttributesResponse, error) {
+	return h.attributeService.ListAttributes(ctx, req)
+}
+
+func (h *ProductHandler) DeleteCategoryAttribute(ctx context.Context, req *pb.DeleteCategoryAttributeRequest) (*pb.DeleteCategoryAttributeResponse, error) {
+	h.logger.Info("Deleting category attribute", zap.String("id", req.Id))
+	return h.attributeService.DeleteAttribute(ctx, req)
+}
+
+func (h *ProductHandler) GetCategoryFacets(ctx context.Context, req *pb.GetCategoryFacetsRequest) (*pb.GetCategoryFacetsResponse, error) {
+	return h.attributeService.GetFacets(ctx, req)
+}
@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Channel visibility methods
+func (h *ProductHandler) SetProductChannels(ctx context.Context, req *pb.SetProductChannelsRequest) (*pb.ProductChannelsResponse, error) {
+	if req == nil || req.ProductId == "" {
+		h.logger.Error("invalid request: product ID is required")
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	if len(req.Channels) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one channel is required")
+	}
+
+	for _, channel := range req.Channels {
+		if channel == nil || !models.IsValidChannel(channel.Channel) {
+			return nil, status.Error(codes.InvalidArgument, "channel must be one of web, mobile or marketplace")
+		}
+	}
+
+	h.logger.Info("Setting product channels", zap.String("product_id", req.ProductId))
+	return h.channelService.SetProductChannels(ctx, req)
+}
+
+func (h *ProductHandler) GetProductChannels(ctx context.Context, req *pb.GetProductChannelsRequest) (*pb.ProductChannelsResponse, error) {
+	if req == nil || req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	return h.channelService.GetProductChannels(ctx, req)
+}
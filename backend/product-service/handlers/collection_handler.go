@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Collection methods
+func (h *ProductHandler) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.Collection, error) {
+	if req == nil || req.Collection == nil {
+		h.logger.Error("invalid request: request or collection is nil")
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Collection.Name == "" || req.Collection.Slug == "" {
+		h.logger.Error("invalid request: collection name and slug are required")
+		return nil, status.Error(codes.InvalidArgument, "collection name and slug are required")
+	}
+
+	h.logger.Info("Creating collection", zap.String("name", req.Collection.Name))
+	return h.collectionService.CreateCollection(ctx, req)
+}
+
+func (h *ProductHandler) GetCollection(ctx context.Context, req *pb.GetCollectionRequest) (*pb.Collection, error) {
+	if req == nil || req.Identifier == nil {
+		h.logger.Error("invalid request: identifier is required")
+		return nil, status.Error(codes.InvalidArgument, "identifier is required")
+	}
+
+	h.logger.Info("Getting collection", zap.Any("identifier", req.Identifier))
+	return h.collectionService.GetCollection(ctx, req)
+}
+
+func (h *ProductHandler) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	if req == nil {
+		h.logger.Error("invalid request: request is nil")
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 {
+		req.Limit = 10
+	}
+
+	h.logger.Info("Listing collections",
+		zap.Int32("page", req.Page),
+		zap.Int32("limit", req.Limit),
+		zap.Bool("published_only", req.PublishedOnly))
+	return h.collectionService.ListCollections(ctx, req)
+}
+
+func (h *ProductHandler) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionRequest) (*pb.Collection, error) {
+	if req == nil || req.Collection == nil {
+		h.logger.Error("invalid request: request or collection is nil")
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Collection.Id == "" {
+		h.logger.Error("invalid request: collection ID is required")
+		return nil, status.Error(codes.InvalidArgument, "collection ID is required")
+	}
+
+	h.logger.Info("Updating collection", zap.String("id", req.Collection.Id))
+	return h.collectionService.UpdateCollection(ctx, req)
+}
+
+func (h *ProductHandler) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionResponse, error) {
+	if req == nil || req.Id == "" {
+		h.logger.Error("invalid request: collection ID is required")
+		return nil, status.Error(codes.InvalidArgument, "collection ID is required")
+	}
+
+	h.logger.Info("Deleting collection", zap.String("id", req.Id))
+	return h.collectionService.DeleteCollection(ctx, req)
+}
+
+func (h *ProductHandler) SetCollectionProducts(ctx context.Context, req *pb.SetCollectionProductsRequest) (*pb.Collection, error) {
+	if req == nil || req.CollectionId == "" {
+		h.logger.Error("invalid request: collection ID is required")
+		return nil, status.Error(codes.InvalidArgument, "collection ID is required")
+	}
+
+	h.logger.Info("Setting collection products",
+		zap.String("collection_id", req.CollectionId),
+		zap.Int("count", len(req.ProductIds)))
+	return h.collectionService.SetCollectionProducts(ctx, req)
+}
+
+func (h *ProductHandler) ListCollectionProducts(ctx context.Context, req *pb.ListCollectionProductsRequest) (*pb.ListCollectionProductsResponse, error) {
+	if req == nil || req.Identifier == nil {
+		h.logger.Error("invalid request: identifier is required")
+		return nil, status.Error(codes.InvalidArgument, "identifier is required")
+	}
+
+	if req.Page < 1 {
+		req.Page = 1
+	}
+	if req.Limit < 1 {
+		req.Limit = 10
+	}
+
+	h.logger.Info("Listing collection products",
+		zap.Any("identifier", req.Identifier),
+		zap.Int32("page", req.Page),
+		zap.Int32("limit", req.Limit))
+	return h.collectionService.ListCollectionProducts(ctx, req)
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Storefront content methods. Only storefront reads, of live content, are
+// localized, so staff always edit the original content.
+func (h *ProductHandler) CreateContentPage(ctx context.Context, req *pb.CreateContentPageRequest) (*pb.ContentPage, error) {
+	h.logger.Info("Creating content page", zap.String("slug", req.GetPage().GetSlug()))
+	return h.contentService.CreateContentPage(ctx, req)
+}
+
+func (h *ProductHandler) UpdateContentPage(ctx context.Context, req *pb.UpdateContentPageRequest) (*pb.ContentPage, error) {
+	h.logger.Info("Updating content page", zap.String("id", req.GetPage().GetId()))
+	return h.contentService.UpdateContentPage(ctx, req)
+}
+
+func (h *ProductHandler) GetContentPage(ctx context.Context, req *pb.GetContentPageRequest) (*pb.ContentPage, error) {
+	page, err := h.contentService.GetContentPage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if _, bySlug := req.Identifier.(*pb.GetContentPageRequest_Slug); bySlug {
+		h.translationService.LocalizeContentPages(ctx, page)
+	}
+	return page, nil
+}
+
+func (h *ProductHandler) ListContentPages(ctx context.Context, req *pb.ListContentPagesRequest) (*pb.ListContentPagesResponse, error) {
+	resp, err := h.contentService.ListContentPages(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if req.LiveOnly {
+		h.translationService.LocalizeContentPages(ctx, resp.Pages...)
+	}
+	return resp, nil
+}
+
+func (h *ProductHandler) DeleteContentPage(ctx context.Context, req *pb.DeleteContentPageRequest) (*pb.DeleteContentPageResponse, error) {
+	h.logger.Info("Deleting content page", zap.String("id", req.Id))
+	return h.contentService.DeleteContentPage(ctx, req)
+}
+
+func (h *ProductHandler) CreateContentBanner(ctx context.Context, req *pb.CreateContentBannerRequest) (*pb.ContentBanner, error) {
+	h.logger.Info("Creating content banner", zap.String("slot", req.GetBanner().GetSlot()))
+	return h.contentService.CreateContentBanner(ctx, req)
+}
+
+func (h *ProductHandler) UpdateContentBanner(ctx context.Context, req *pb.UpdateContentBannerRequest) (*pb.ContentBanner, error) {
+	h.logger.Info("Updating content banner", zap.String("id", req.GetBanner().GetId()))
+	return h.contentService.UpdateContentBanner(ctx, req)
+}
+
+func (h *ProductHandler) ListContentBanners(ctx context.Context, req *pb.ListContentBannersRequest) (*pb.ListContentBannersResponse, error) {
+	resp, err := h.contentService.ListContentBanners(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if req.LiveOnly {
+		h.translationService.LocalizeContentBanners(ctx, resp.Banners...)
+	}
+	return resp, nil
+}
+
+func (h *ProductHandler) DeleteContentBanner(ctx context.Context, req *pb.DeleteContentBannerRequest) (*pb.DeleteContentBannerResponse, error) {
+	h.logger.Info("Deleting content banner", zap.String("id", req.Id))
+	return h.contentService.DeleteContentBanner(ctx, req)
+}
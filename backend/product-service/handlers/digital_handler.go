@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Digital product methods
+func (h *ProductHandler) UploadDigitalAsset(ctx context.Context, req *pb.UploadDigitalAssetRequest) (*pb.DigitalAsset, error) {
+	if req == nil || req.ProductId == "" {
+		h.logger.Error("invalid request: product ID is required")
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	if len(req.File) == 0 || req.Filename == "" {
+		return nil, status.Error(codes.InvalidArgument, "file and filename are required")
+	}
+
+	if req.DownloadLimit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "download limit cannot be negative")
+	}
+
+	h.logger.Info("Uploading digital asset",
+		zap.String("product_id", req.ProductId),
+		zap.String("filename", req.Filename),
+		zap.Int("size", len(req.File)))
+	return h.digitalService.UploadDigitalAsset(ctx, req)
+}
+
+func (h *ProductHandler) CreateDownloadLink(ctx context.Context, req *pb.CreateDownloadLinkRequest) (*pb.DownloadLink, error) {
+	if req == nil || req.ProductId == "" || req.PurchaseId == "" || req.UserId == "" {
+		h.logger.Error("invalid request: product, purchase and user IDs are required")
+		return nil, status.Error(codes.InvalidArgument, "product ID, purchase ID and user ID are required")
+	}
+
+	h.logger.Info("Creating download link",
+		zap.String("product_id", req.ProductId),
+		zap.String("purchase_id", req.PurchaseId))
+	return h.digitalService.CreateDownloadLink(ctx, req)
+}
+
+func (h *ProductHandler) DownloadDigitalAsset(req *pb.DownloadDigitalAssetRequest, stream grpc.ServerStreamingServer[pb.DigitalAssetChunk]) error {
+	if req == nil || req.Token == "" {
+		return status.Error(codes.InvalidArgument, "download token is required")
+	}
+
+	return h.digitalService.DownloadDigitalAsset(req, stream)
+}
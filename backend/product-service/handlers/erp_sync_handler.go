@@ -0,0 +1,20 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// ERP sync methods
+func (h *ProductHandler) RunErpSync(ctx context.Context, req *pb.RunErpSyncRequest) (*pb.ListErpSyncRunsResponse, error) {
+	h.logger.Info("Running ERP sync", zap.String("tenant_id", tenant.FromContext(ctx)))
+	return h.erpSyncService.RunErpSync(ctx, req)
+}
+
+func (h *ProductHandler) ListErpSyncRuns(ctx context.Context, req *pb.ListErpSyncRunsRequest) (*pb.ListErpSyncRunsResponse, error) {
+	return h.erpSyncService.ListErpSyncRuns(ctx, req)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Marketplace feed methods
+func (h *ProductHandler) ListProductFeeds(ctx context.Context, req *pb.ListProductFeedsRequest) (*pb.ListProductFeedsResponse, error) {
+	return h.feedService.ListProductFeeds(ctx, req)
+}
+
+func (h *ProductHandler) GenerateProductFeeds(ctx context.Context, req *pb.GenerateProductFeedsRequest) (*pb.ListProductFeedsResponse, error) {
+	h.logger.Info("Generating product feeds", zap.String("tenant_id", tenant.FromContext(ctx)))
+	return h.feedService.GenerateProductFeeds(ctx, req)
+}
+
+func (h *ProductHandler) DownloadProductFeed(req *pb.DownloadProductFeedRequest, stream grpc.ServerStreamingServer[pb.ProductFeedChunk]) error {
+	if req == nil || req.Token == "" {
+		return status.Error(codes.InvalidArgument, "feed token is required")
+	}
+
+	return h.feedService.DownloadProductFeed(req, stream)
+}
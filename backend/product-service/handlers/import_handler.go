@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Supplier catalog import methods
+func (h *ProductHandler) SaveImportTemplate(ctx context.Context, req *pb.SaveImportTemplateRequest) (*pb.ImportTemplate, error) {
+	h.logger.Info("Saving import template", zap.String("supplier", req.GetTemplate().GetSupplier()))
+	return h.importService.SaveImportTemplate(ctx, req)
+}
+
+func (h *ProductHandler) GetImportTemplate(ctx context.Context, req *pb.GetImportTemplateRequest) (*pb.ImportTemplate, error) {
+	return h.importService.GetImportTemplate(ctx, req)
+}
+
+func (h *ProductHandler) ListImportTemplates(ctx context.Context, req *pb.ListImportTemplatesRequest) (*pb.ListImportTemplatesResponse, error) {
+	return h.importService.ListImportTemplates(ctx, req)
+}
+
+func (h *ProductHandler) DeleteImportTemplate(ctx context.Context, req *pb.DeleteImportTemplateRequest) (*pb.DeleteImportTemplateResponse, error) {
+	h.logger.Info("Deleting import template", zap.String("supplier", req.Supplier))
+	return h.importService.DeleteImportTemplate(ctx, req)
+}
+
+func (h *ProductHandler) ImportSupplierCatalog(ctx context.Context, req *pb.ImportSupplierCatalogRequest) (*pb.ImportSupplierCatalogResponse, error) {
+	h.logger.Info("Importing supplier catalog",
+		zap.String("supplier", req.Supplier),
+		zap.Int("size", len(req.CsvData)))
+	return h.importService.ImportSupplierCatalog(ctx, req)
+}
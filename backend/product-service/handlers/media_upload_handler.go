@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Direct media upload methods
+func (h *ProductHandler) CreateMediaUpload(ctx context.Context, req *pb.CreateMediaUploadRequest) (*pb.MediaUpload, error) {
+	if req == nil || req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	h.logger.Info("Creating media upload",
+		zap.String("product_id", req.ProductId),
+		zap.String("media_type", req.MediaType))
+	return h.mediaUploadService.CreateMediaUpload(ctx, req)
+}
+
+func (h *ProductHandler) ConfirmMediaUpload(ctx context.Context, req *pb.ConfirmMediaUploadRequest) (*pb.ConfirmMediaUploadResponse, error) {
+	if req == nil || req.ProductId == "" || req.UploadId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID and upload ID are required")
+	}
+
+	h.logger.Info("Confirming media upload",
+		zap.String("product_id", req.ProductId),
+		zap.String("upload_id", req.UploadId))
+	return h.mediaUploadService.ConfirmMediaUpload(ctx, req)
+}
+
+// Product video and 3D model methods
+func (h *ProductHandler) AddProductVideo(ctx context.Context, req *pb.AddProductVideoRequest) (*pb.ProductMedia, error) {
+	if req == nil || req.ProductId == "" || req.Url == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID and video URL are required")
+	}
+
+	h.logger.Info("Adding product video", zap.String("product_id", req.ProductId))
+	return h.mediaService.AddProductVideo(ctx, req)
+}
+
+func (h *ProductHandler) DeleteProductMedia(ctx context.Context, req *pb.DeleteProductMediaRequest) (*pb.DeleteProductMediaResponse, error) {
+	if req == nil || req.ProductId == "" || req.MediaId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID and media ID are required")
+	}
+
+	h.logger.Info("Deleting product media",
+		zap.String("product_id", req.ProductId),
+		zap.String("media_id", req.MediaId))
+	return h.mediaService.DeleteProductMedia(ctx, req)
+}
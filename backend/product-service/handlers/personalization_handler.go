@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Personalization methods
+func (h *ProductHandler) SetPersonalizationOptions(ctx context.Context, req *pb.SetPersonalizationOptionsRequest) (*pb.PersonalizationOptionsResponse, error) {
+	if req == nil || req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	h.logger.Info("Setting personalization options",
+		zap.String("product_id", req.ProductId),
+		zap.Int("options", len(req.Options)))
+	return h.personalizationService.SetPersonalizationOptions(ctx, req)
+}
+
+func (h *ProductHandler) ValidatePersonalization(ctx context.Context, req *pb.ValidatePersonalizationRequest) (*pb.ValidatePersonalizationResponse, error) {
+	if req == nil || req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+	return h.personalizationService.ValidatePersonalization(ctx, req)
+}
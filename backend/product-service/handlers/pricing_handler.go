@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Pricing methods
+func (h *ProductHandler) BulkAdjustPrices(ctx context.Context, req *pb.BulkAdjustPricesRequest) (*pb.BulkAdjustPricesResponse, error) {
+	h.logger.Info("Bulk adjusting prices",
+		zap.String("operation", req.Operation),
+		zap.Float64("amount", req.Amount),
+		zap.Bool("apply", req.Apply))
+	return h.pricingService.BulkAdjustPrices(ctx, req)
+}
@@ -6,18 +6,48 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
 	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
 	"github.com/louai60/e-commerce_project/backend/product-service/service"
+	"github.com/louai60/e-commerce_project/backend/shared/diagnostics"
 	"go.uber.org/zap"
 )
 
 type ProductHandler struct {
 	pb.UnimplementedProductServiceServer
-	service *service.ProductService
-	logger  *zap.Logger
+	service                *service.ProductService
+	collectionService      *service.CollectionService
+	digitalService         *service.DigitalService
+	subscriptionService    *service.SubscriptionService
+	storeService           *service.StoreService
+	channelService         *service.ChannelService
+	feedService            *service.FeedService
+	erpSyncService         *service.ErpSyncService
+	pricingService         *service.PricingService
+	reconciliationService  *service.ReconciliationService
+	catalogQualityService  *service.CatalogQualityService
+	mergeService           *service.ProductMergeService
+	noteService            *service.ProductNoteService
+	importService          *service.ImportService
+	translationService     *service.TranslationService
+	attributeService       *service.CategoryAttributeService
+	questionService        *service.ProductQuestionService
+	savedSearchService     *service.SavedSearchService
+	searchRankingService   *service.SearchRankingService
+	badgeService           *service.BadgeService
+	catalogActivityService *service.CatalogActivityService
+	relationshipService    *service.RelationshipService
+	contentService         *service.ContentService
+	settingsService        *service.SettingsService
+	personalizationService *service.PersonalizationService
+	mediaUploadService     *service.MediaUploadService
+	mediaService           *service.ProductMediaService
+	cacheScheduleService   *service.CacheScheduleService
+	diagnostics            *diagnostics.Collector
+	logger                 *zap.Logger
 }
 
-func NewProductHandler(service *service.ProductService, logger *zap.Logger) *ProductHandler {
+func NewProductHandler(service *service.ProductService, collectionService *service.CollectionService, digitalService *service.DigitalService, subscriptionService *service.SubscriptionService, storeService *service.StoreService, channelService *service.ChannelService, feedService *service.FeedService, erpSyncService *service.ErpSyncService, pricingService *service.PricingService, reconciliationService *service.ReconciliationService, catalogQualityService *service.CatalogQualityService, mergeService *service.ProductMergeService, noteService *service.ProductNoteService, importService *service.ImportService, translationService *service.TranslationService, attributeService *service.CategoryAttributeService, questionService *service.ProductQuestionService, savedSearchService *service.SavedSearchService, searchRankingService *service.SearchRankingService, badgeService *service.BadgeService, catalogActivityService *service.CatalogActivityService, relationshipService *service.RelationshipService, contentService *service.ContentService, settingsService *service.SettingsService, personalizationService *service.PersonalizationService, mediaUploadService *service.MediaUploadService, mediaService *service.ProductMediaService, cacheScheduleService *service.CacheScheduleService, diagnostics *diagnostics.Collector, logger *zap.Logger) *ProductHandler {
 	if service == nil {
 		logger.Error("service is nil in NewProductHandler")
 		return nil
@@ -29,8 +59,36 @@ func NewProductHandler(service *service.ProductService, logger *zap.Logger) *Pro
 
 	logger.Info("Initializing product handler")
 	return &ProductHandler{
-		service: service,
-		logger:  logger,
+		service:                service,
+		collectionService:      collectionService,
+		digitalService:         digitalService,
+		subscriptionService:    subscriptionService,
+		storeService:           storeService,
+		channelService:         channelService,
+		feedService:            feedService,
+		erpSyncService:         erpSyncService,
+		pricingService:         pricingService,
+		reconciliationService:  reconciliationService,
+		catalogQualityService:  catalogQualityService,
+		mergeService:           mergeService,
+		noteService:            noteService,
+		importService:          importService,
+		translationService:     translationService,
+		attributeService:       attributeService,
+		questionService:        questionService,
+		savedSearchService:     savedSearchService,
+		searchRankingService:   searchRankingService,
+		badgeService:           badgeService,
+		catalogActivityService: catalogActivityService,
+		relationshipService:    relationshipService,
+		contentService:         contentService,
+		settingsService:        settingsService,
+		personalizationService: personalizationService,
+		mediaUploadService:     mediaUploadService,
+		mediaService:           mediaService,
+		cacheScheduleService:   cacheScheduleService,
+		diagnostics:            diagnostics,
+		logger:                 logger,
 	}
 }
 
@@ -45,19 +103,54 @@ func (h *ProductHandler) CreateProduct(ctx context.Context, req *pb.CreateProduc
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	return h.service.CreateProduct(ctx, req)
+	product, err := h.service.CreateProduct(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	h.service.HideMetadata(ctx, product)
+	return product, nil
 }
 
 func (h *ProductHandler) GetProduct(ctx context.Context, req *pb.GetProductRequest) (*pb.Product, error) {
 	h.logger.Info("Getting product", zap.Any("identifier", req.Identifier))
-	return h.service.GetProduct(ctx, req)
+	product, err := h.service.GetProduct(ctx, req)
+	if status.Code(err) == codes.NotFound {
+		// Products merged into another one resolve to it
+		if redirected, ok := h.mergeService.RedirectedRequest(ctx, req); ok {
+			product, err = h.service.GetProduct(ctx, redirected)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	h.relationshipService.AttachRelated(ctx, product, req.RelatedLimit)
+	h.badgeService.AttachBadges(ctx, product)
+	h.translationService.LocalizeProducts(ctx, product)
+	h.service.HideMetadata(ctx, product)
+	for _, related := range product.RelatedProducts {
+		h.translationService.LocalizeProducts(ctx, related.Product)
+		h.service.HideMetadata(ctx, related.Product)
+	}
+	return product, nil
 }
 
 func (h *ProductHandler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
+	if req.Channel != "" && !models.IsValidChannel(req.Channel) {
+		return nil, status.Error(codes.InvalidArgument, "channel must be one of web, mobile or marketplace")
+	}
+
 	h.logger.Info("Listing products",
 		zap.Int32("page", req.Page),
-		zap.Int32("limit", req.Limit))
-	return h.service.ListProducts(ctx, req)
+		zap.Int32("limit", req.Limit),
+		zap.String("channel", req.Channel))
+	resp, err := h.service.ListProducts(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	h.translationService.LocalizeProducts(ctx, resp.Products...)
+	h.badgeService.AttachBadges(ctx, resp.Products...)
+	h.service.HideMetadata(ctx, resp.Products...)
+	return resp, nil
 }
 
 func (h *ProductHandler) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.Product, error) {
@@ -72,7 +165,12 @@ func (h *ProductHandler) UpdateProduct(ctx context.Context, req *pb.UpdateProduc
 	}
 
 	h.logger.Info("Updating product", zap.String("id", req.Product.Id))
-	return h.service.UpdateProduct(ctx, req)
+	product, err := h.service.UpdateProduct(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	h.service.HideMetadata(ctx, product)
+	return product, nil
 }
 
 func (h *ProductHandler) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
@@ -202,7 +300,12 @@ func (h *ProductHandler) GetCategory(ctx context.Context, req *pb.GetCategoryReq
 	}
 
 	h.logger.Info("Getting category", zap.Any("identifier", req.Identifier))
-	return h.service.GetCategory(ctx, req)
+	category, err := h.service.GetCategory(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	h.translationService.LocalizeCategories(ctx, category)
+	return category, nil
 }
 
 func (h *ProductHandler) ListCategories(ctx context.Context, req *pb.ListCategoriesRequest) (*pb.ListCategoriesResponse, error) {
@@ -221,5 +324,32 @@ func (h *ProductHandler) ListCategories(ctx context.Context, req *pb.ListCategor
 	h.logger.Info("Listing categories",
 		zap.Int32("page", req.Page),
 		zap.Int32("limit", req.Limit))
-	return h.service.ListCategories(ctx, req)
+	resp, err := h.service.ListCategories(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	h.translationService.LocalizeCategories(ctx, resp.Categories...)
+	return resp, nil
+}
+
+// Category tree methods
+func (h *ProductHandler) MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.Category, error) {
+	h.logger.Info("Moving category",
+		zap.String("id", req.Id),
+		zap.String("parent_id", req.GetParentId().GetValue()))
+	return h.service.MoveCategory(ctx, req)
+}
+
+func (h *ProductHandler) MergeCategories(ctx context.Context, req *pb.MergeCategoriesRequest) (*pb.MergeCategoriesResponse, error) {
+	h.logger.Info("Merging categories",
+		zap.String("target_id", req.TargetId),
+		zap.String("source_id", req.SourceId))
+	return h.service.MergeCategories(ctx, req)
+}
+
+func (h *ProductHandler) ReorderSiblings(ctx context.Context, req *pb.ReorderSiblingsRequest) (*pb.ReorderSiblingsResponse, error) {
+	h.logger.Info("Reordering categories",
+		zap.String("parent_id", req.GetParentId().GetValue()),
+		zap.Int("count", len(req.CategoryIds)))
+	return h.service.ReorderSiblings(ctx, req)
 }
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Product merge and split methods
+func (h *ProductHandler) MergeProducts(ctx context.Context, req *pb.MergeProductsRequest) (*pb.MergeProductsResponse, error) {
+	h.logger.Info("Merging products",
+		zap.String("target_id", req.TargetId),
+		zap.String("source_id", req.SourceId))
+	return h.mergeService.MergeProducts(ctx, req)
+}
+
+func (h *ProductHandler) SplitVariant(ctx context.Context, req *pb.SplitVariantRequest) (*pb.SplitVariantResponse, error) {
+	h.logger.Info("Splitting variant",
+		zap.String("product_id", req.ProductId),
+		zap.String("variant_id", req.VariantId))
+	return h.mergeService.SplitVariant(ctx, req)
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Product note methods
+func (h *ProductHandler) CreateProductNote(ctx context.Context, req *pb.CreateProductNoteRequest) (*pb.ProductNote, error) {
+	h.logger.Info("Adding product note",
+		zap.String("product_id", req.ProductId),
+		zap.String("author_id", req.AuthorId))
+	return h.noteService.CreateNote(ctx, req)
+}
+
+func (h *ProductHandler) ListProductNotes(ctx context.Context, req *pb.ListProductNotesRequest) (*pb.ListProductNotesResponse, error) {
+	return h.noteService.ListNotes(ctx, req)
+}
+
+func (h *ProductHandler) UpdateProductNote(ctx context.Context, req *pb.UpdateProductNoteRequest) (*pb.ProductNote, error) {
+	h.logger.Info("Updating product note",
+		zap.String("product_id", req.ProductId),
+		zap.String("note_id", req.Id))
+	return h.noteService.UpdateNote(ctx, req)
+}
+
+func (h *ProductHandler) DeleteProductNote(ctx context.Context, req *pb.DeleteProductNoteRequest) (*pb.DeleteProductNoteResponse, error) {
+	h.logger.Info("Deleting product note",
+		zap.String("product_id", req.ProductId),
+		zap.String("note_id", req.Id))
+	return h.noteService.DeleteNote(ctx, req)
+}
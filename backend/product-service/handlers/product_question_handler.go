@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Product question and answer methods
+func (h *ProductHandler) AskProductQuestion(ctx context.Context, req *pb.AskProductQuestionRequest) (*pb.ProductQuestion, error) {
+	h.logger.Info("Asking product question",
+		zap.String("product_id", req.ProductId),
+		zap.String("author_id", req.AuthorId))
+	return h.questionService.AskQuestion(ctx, req)
+}
+
+func (h *ProductHandler) GetProductQuestion(ctx context.Context, req *pb.GetProductQuestionRequest) (*pb.ProductQuestion, error) {
+	return h.questionService.GetQuestion(ctx, req)
+}
+
+func (h *ProductHandler) ListProductQuestions(ctx context.Context, req *pb.ListProductQuestionsRequest) (*pb.ListProductQuestionsResponse, error) {
+	return h.questionService.ListQuestions(ctx, req)
+}
+
+func (h *ProductHandler) ModerateProductQuestion(ctx context.Context, req *pb.ModerateProductQuestionRequest) (*pb.ProductQuestion, error) {
+	h.logger.Info("Moderating product question",
+		zap.String("question_id", req.Id),
+		zap.String("status", req.Status))
+	return h.questionService.ModerateQuestion(ctx, req)
+}
+
+func (h *ProductHandler) DeleteProductQuestion(ctx context.Context, req *pb.DeleteProductQuestionRequest) (*pb.DeleteProductQuestionResponse, error) {
+	h.logger.Info("Deleting product question", zap.String("question_id", req.Id))
+	return h.questionService.DeleteQuestion(ctx, req)
+}
+
+func (h *ProductHandler) AnswerProductQuestion(ctx context.Context, req *pb.AnswerProductQuestionRequest) (*pb.ProductAnswer, error) {
+	h.logger.Info("Answering product question",
+		zap.String("question_id", req.QuestionId),
+		zap.String("author_id", req.AuthorId),
+		zap.String("author_role", req.AuthorRole))
+	return h.questionService.AnswerQuestion(ctx, req)
+}
+
+func (h *ProductHandler) ModerateProductAnswer(ctx context.Context, req *pb.ModerateProductAnswerRequest) (*pb.ProductAnswer, error) {
+	h.logger.Info("Moderating product answer",
+		zap.String("answer_id", req.Id),
+		zap.String("status", req.Status))
+	return h.questionService.ModerateAnswer(ctx, req)
+}
+
+func (h *ProductHandler) DeleteProductAnswer(ctx context.Context, req *pb.DeleteProductAnswerRequest) (*pb.DeleteProductAnswerResponse, error) {
+	h.logger.Info("Deleting product answer", zap.String("answer_id", req.Id))
+	return h.questionService.DeleteAnswer(ctx, req)
+}
+
+func (h *ProductHandler) UpvoteProductAnswer(ctx context.Context, req *pb.UpvoteProductAnswerRequest) (*pb.ProductAnswer, error) {
+	return h.questionService.UpvoteAnswer(ctx, req)
+}
@@ -0,0 +1,26 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Inventory reconciliation methods
+func (h *ProductHandler) RunInventoryReconciliation(ctx context.Context, req *pb.RunInventoryReconciliationRequest) (*pb.InventoryReconciliation, error) {
+	h.logger.Info("Running inventory reconciliation",
+		zap.String("tenant_id", tenant.FromContext(ctx)),
+		zap.Bool("auto_create", req.AutoCreate))
+	return h.reconciliationService.RunInventoryReconciliation(ctx, req)
+}
+
+func (h *ProductHandler) GetInventoryReconciliation(ctx context.Context, req *pb.GetInventoryReconciliationRequest) (*pb.InventoryReconciliation, error) {
+	return h.reconciliationService.GetInventoryReconciliation(ctx, req)
+}
+
+func (h *ProductHandler) ListInventoryReconciliations(ctx context.Context, req *pb.ListInventoryReconciliationsRequest) (*pb.ListInventoryReconciliationsResponse, error) {
+	return h.reconciliationService.ListInventoryReconciliations(ctx, req)
+}
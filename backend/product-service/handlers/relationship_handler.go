@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Product relationship methods
+func (h *ProductHandler) CreateProductRelationship(ctx context.Context, req *pb.CreateProductRelationshipRequest) (*pb.ProductRelationship, error) {
+	h.logger.Info("Linking products",
+		zap.String("product_id", req.ProductId),
+		zap.String("related_product_id", req.RelatedProductId),
+		zap.String("type", req.Type))
+	return h.relationshipService.CreateRelationship(ctx, req)
+}
+
+func (h *ProductHandler) ListProductRelationships(ctx context.Context, req *pb.ListProductRelationshipsRequest) (*pb.ListProductRelationshipsResponse, error) {
+	return h.relationshipService.ListRelationships(ctx, req)
+}
+
+func (h *ProductHandler) UpdateProductRelationship(ctx context.Context, req *pb.UpdateProductRelationshipRequest) (*pb.ProductRelationship, error) {
+	h.logger.Info("Updating product relationship",
+		zap.String("product_id", req.ProductId),
+		zap.String("relationship_id", req.Id))
+	return h.relationshipService.UpdateRelationship(ctx, req)
+}
+
+func (h *ProductHandler) DeleteProductRelationship(ctx context.Context, req *pb.DeleteProductRelationshipRequest) (*pb.DeleteProductRelationshipResponse, error) {
+	h.logger.Info("Deleting product relationship",
+		zap.String("product_id", req.ProductId),
+		zap.String("relationship_id", req.Id))
+	return h.relationshipService.DeleteRelationship(ctx, req)
+}
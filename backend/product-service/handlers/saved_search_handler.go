@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Saved search methods
+func (h *ProductHandler) SaveSearch(ctx context.Context, req *pb.SaveSearchRequest) (*pb.SavedSearch, error) {
+	h.logger.Info("Saving search",
+		zap.String("id", req.Id),
+		zap.String("user_id", req.UserId))
+	return h.savedSearchService.SaveSearch(ctx, req)
+}
+
+func (h *ProductHandler) ListSavedSearches(ctx context.Context, req *pb.ListSavedSearchesRequest) (*pb.ListSavedSearchesResponse, error) {
+	return h.savedSearchService.ListSavedSearches(ctx, req)
+}
+
+func (h *ProductHandler) DeleteSavedSearch(ctx context.Context, req *pb.DeleteSavedSearchRequest) (*pb.DeleteSavedSearchResponse, error) {
+	h.logger.Info("Deleting saved search",
+		zap.String("id", req.Id),
+		zap.String("user_id", req.UserId))
+	return h.savedSearchService.DeleteSavedSearch(ctx, req)
+}
+
+func (h *ProductHandler) ListSavedSearchAlerts(ctx context.Context, req *pb.ListSavedSearchAlertsRequest) (*pb.ListSavedSearchAlertsResponse, error) {
+	return h.savedSearchService.ListSavedSearchAlerts(ctx, req)
+}
+
+func (h *ProductHandler) AckSavedSearchAlerts(ctx context.Context, req *pb.AckSavedSearchAlertsRequest) (*pb.AckSavedSearchAlertsResponse, error) {
+	h.logger.Info("Acknowledging saved search alerts", zap.Int("count", len(req.AlertIds)))
+	return h.savedSearchService.AckSavedSearchAlerts(ctx, req)
+}
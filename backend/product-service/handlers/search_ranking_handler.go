@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Search ranking methods
+func (h *ProductHandler) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	return h.searchRankingService.SearchProducts(ctx, req)
+}
+
+func (h *ProductHandler) PreviewSearchRanking(ctx context.Context, req *pb.PreviewSearchRankingRequest) (*pb.PreviewSearchRankingResponse, error) {
+	return h.searchRankingService.PreviewSearchRanking(ctx, req)
+}
+
+func (h *ProductHandler) SaveSearchRankingRule(ctx context.Context, req *pb.SaveSearchRankingRuleRequest) (*pb.SearchRankingRule, error) {
+	h.logger.Info("Saving search ranking rule",
+		zap.String("id", req.Id),
+		zap.String("kind", req.Kind))
+	return h.searchRankingService.SaveSearchRankingRule(ctx, req)
+}
+
+func (h *ProductHandler) ListSearchRankingRules(ctx context.Context, req *pb.ListSearchRankingRulesRequest) (*pb.ListSearchRankingRulesResponse, error) {
+	return h.searchRankingService.ListSearchRankingRules(ctx, req)
+}
+
+func (h *ProductHandler) DeleteSearchRankingRule(ctx context.Context, req *pb.DeleteSearchRankingRuleRequest) (*pb.DeleteSearchRankingRuleResponse, error) {
+	h.logger.Info("Deleting search ranking rule", zap.String("id", req.Id))
+	return h.searchRankingService.DeleteSearchRankingRule(ctx, req)
+}
+
+func (h *ProductHandler) PublishSearchRankingRules(ctx context.Context, req *pb.PublishSearchRankingRulesRequest) (*pb.PublishSearchRankingRulesResponse, error) {
+	h.logger.Info("Publishing search ranking rules", zap.Int("count", len(req.Ids)))
+	return h.searchRankingService.PublishSearchRankingRules(ctx, req)
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Store settings methods
+func (h *ProductHandler) ListSettings(ctx context.Context, req *pb.ListSettingsRequest) (*pb.ListSettingsResponse, error) {
+	return h.settingsService.ListSettings(ctx, req)
+}
+
+func (h *ProductHandler) SetSetting(ctx context.Context, req *pb.SetSettingRequest) (*pb.Setting, error) {
+	h.logger.Info("Setting store setting", zap.String("key", req.Key), zap.String("updated_by", req.UpdatedBy))
+	return h.settingsService.SetSetting(ctx, req)
+}
+
+func (h *ProductHandler) DeleteSetting(ctx context.Context, req *pb.DeleteSettingRequest) (*pb.DeleteSettingResponse, error) {
+	h.logger.Info("Resetting store setting", zap.String("key", req.Key))
+	return h.settingsService.DeleteSetting(ctx, req)
+}
+
+func (h *ProductHandler) GetStorefrontConfig(ctx context.Context, req *pb.GetStorefrontConfigRequest) (*pb.StorefrontConfig, error) {
+	return h.settingsService.GetStorefrontConfig(ctx, req)
+}
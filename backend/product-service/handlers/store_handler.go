@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Store methods
+func (h *ProductHandler) CreateStore(ctx context.Context, req *pb.CreateStoreRequest) (*pb.Store, error) {
+	if req == nil || req.Name == "" {
+		h.logger.Error("invalid request: store name is required")
+		return nil, status.Error(codes.InvalidArgument, "store name is required")
+	}
+
+	if !tenant.IsValidID(req.Id) {
+		return nil, status.Error(codes.InvalidArgument, "store ID must be a lowercase slug of at most 50 characters")
+	}
+
+	if req.DefaultCurrency != "" && len(req.DefaultCurrency) != 3 {
+		return nil, status.Error(codes.InvalidArgument, "default currency must be a 3-letter code")
+	}
+
+	h.logger.Info("Creating store", zap.String("id", req.Id), zap.String("domain", req.Domain))
+	return h.storeService.CreateStore(ctx, req)
+}
+
+func (h *ProductHandler) GetStore(ctx context.Context, req *pb.GetStoreRequest) (*pb.Store, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "store ID is required")
+	}
+
+	return h.storeService.GetStore(ctx, req)
+}
+
+func (h *ProductHandler) ListStores(ctx context.Context, req *pb.ListStoresRequest) (*pb.ListStoresResponse, error) {
+	if req == nil {
+		req = &pb.ListStoresRequest{}
+	}
+
+	return h.storeService.ListStores(ctx, req)
+}
+
+func (h *ProductHandler) UpdateStore(ctx context.Context, req *pb.UpdateStoreRequest) (*pb.Store, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "store ID is required")
+	}
+
+	if req.DefaultCurrency != "" && len(req.DefaultCurrency) != 3 {
+		return nil, status.Error(codes.InvalidArgument, "default currency must be a 3-letter code")
+	}
+
+	h.logger.Info("Updating store", zap.String("id", req.Id), zap.Bool("is_active", req.IsActive))
+	store, err := h.storeService.UpdateStore(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// The storefront config carries the name and defaults of the store
+	h.settingsService.Invalidate(ctx, store.Id)
+	return store, nil
+}
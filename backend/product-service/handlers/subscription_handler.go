@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Subscription methods
+func (h *ProductHandler) SetSubscriptionPlan(ctx context.Context, req *pb.SetSubscriptionPlanRequest) (*pb.SubscriptionPlan, error) {
+	if req == nil || req.ProductId == "" {
+		h.logger.Error("invalid request: product ID is required")
+		return nil, status.Error(codes.InvalidArgument, "product ID is required")
+	}
+
+	if !models.IsValidBillingInterval(req.Interval) {
+		return nil, status.Error(codes.InvalidArgument, "interval must be one of day, week, month or year")
+	}
+
+	if req.IntervalCount < 0 || req.TrialDays < 0 {
+		return nil, status.Error(codes.InvalidArgument, "interval count and trial days cannot be negative")
+	}
+
+	h.logger.Info("Setting subscription plan",
+		zap.String("product_id", req.ProductId),
+		zap.String("interval", req.Interval))
+	return h.subscriptionService.SetSubscriptionPlan(ctx, req)
+}
+
+func (h *ProductHandler) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.Subscription, error) {
+	if req == nil || req.ProductId == "" || req.PurchaseId == "" || req.UserId == "" {
+		h.logger.Error("invalid request: product, purchase and user IDs are required")
+		return nil, status.Error(codes.InvalidArgument, "product ID, purchase ID and user ID are required")
+	}
+
+	h.logger.Info("Creating subscription",
+		zap.String("product_id", req.ProductId),
+		zap.String("purchase_id", req.PurchaseId))
+	return h.subscriptionService.CreateSubscription(ctx, req)
+}
+
+func (h *ProductHandler) GetSubscription(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.Subscription, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "subscription ID is required")
+	}
+
+	return h.subscriptionService.GetSubscription(ctx, req)
+}
+
+func (h *ProductHandler) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.Subscription, error) {
+	if req == nil || req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "subscription ID is required")
+	}
+
+	h.logger.Info("Cancelling subscription",
+		zap.String("id", req.Id),
+		zap.Bool("at_period_end", req.AtPeriodEnd))
+	return h.subscriptionService.CancelSubscription(ctx, req)
+}
+
+func (h *ProductHandler) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsResponse, error) {
+	if req == nil {
+		req = &pb.ListSubscriptionsRequest{}
+	}
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.Limit <= 0 {
+		req.Limit = 10
+	}
+
+	return h.subscriptionService.ListSubscriptions(ctx, req)
+}
+
+// Billing events polled by the payment service
+func (h *ProductHandler) ListSubscriptionEvents(ctx context.Context, req *pb.ListSubscriptionEventsRequest) (*pb.ListSubscriptionEventsResponse, error) {
+	if req == nil {
+		req = &pb.ListSubscriptionEventsRequest{}
+	}
+
+	return h.subscriptionService.ListSubscriptionEvents(ctx, req)
+}
+
+func (h *ProductHandler) AckSubscriptionEvents(ctx context.Context, req *pb.AckSubscriptionEventsRequest) (*pb.AckSubscriptionEventsResponse, error) {
+	if req == nil || len(req.EventIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one event ID is required")
+	}
+
+	return h.subscriptionService.AckSubscriptionEvents(ctx, req)
+}
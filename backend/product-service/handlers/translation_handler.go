@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Catalog translation methods
+func (h *ProductHandler) SetTranslation(ctx context.Context, req *pb.SetTranslationRequest) (*pb.Translation, error) {
+	if req.Translation != nil {
+		h.logger.Info("Setting translation",
+			zap.String("entity_type", req.Translation.EntityType),
+			zap.String("entity_id", req.Translation.EntityId),
+			zap.String("locale", req.Translation.Locale))
+	}
+	return h.translationService.SetTranslation(ctx, req)
+}
+
+func (h *ProductHandler) ListTranslations(ctx context.Context, req *pb.ListTranslationsRequest) (*pb.ListTranslationsResponse, error) {
+	return h.translationService.ListTranslations(ctx, req)
+}
+
+func (h *ProductHandler) DeleteTranslation(ctx context.Context, req *pb.DeleteTranslationRequest) (*pb.DeleteTranslationResponse, error) {
+	h.logger.Info("Deleting translation",
+		zap.String("entity_type", req.EntityType),
+		zap.String("entity_id", req.EntityId),
+		zap.String("locale", req.Locale))
+	return h.translationService.DeleteTranslation(ctx, req)
+}
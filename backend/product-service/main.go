@@ -5,24 +5,43 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	"github.com/joho/godotenv"
-	_ "github.com/lib/pq" // PostgreSQL driver (import driver for side effects)
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/locale"
 	"github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/profiling"
+	"github.com/louai60/e-commerce_project/backend/common/recovery"
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	"github.com/louai60/e-commerce_project/backend/product-service/cache"
+	"github.com/louai60/e-commerce_project/backend/product-service/cacheschedule"
 	"github.com/louai60/e-commerce_project/backend/product-service/clients"
 	"github.com/louai60/e-commerce_project/backend/product-service/config"
 	"github.com/louai60/e-commerce_project/backend/product-service/db"
+	"github.com/louai60/e-commerce_project/backend/product-service/erpsync"
+	"github.com/louai60/e-commerce_project/backend/product-service/events"
 	"github.com/louai60/e-commerce_project/backend/product-service/handlers"
+	"github.com/louai60/e-commerce_project/backend/product-service/mediaupload"
 	"github.com/louai60/e-commerce_project/backend/product-service/middleware"
 	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
 	"github.com/louai60/e-commerce_project/backend/product-service/repository"
 	"github.com/louai60/e-commerce_project/backend/product-service/repository/postgres"
 	"github.com/louai60/e-commerce_project/backend/product-service/service"
+	"github.com/louai60/e-commerce_project/backend/product-service/storage"
+	"github.com/louai60/e-commerce_project/backend/product-service/validationhook"
+	sharedconfig "github.com/louai60/e-commerce_project/backend/shared/config"
+	"github.com/louai60/e-commerce_project/backend/shared/diagnostics"
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
+	"github.com/louai60/e-commerce_project/backend/shared/partition"
 )
 
 func main() {
@@ -35,14 +54,27 @@ func main() {
 	}
 
 	// Initialize logger first
-	log := logger.GetLogger()
+	log := logger.InitFromEnv("product-service")
 	defer log.Sync()
 
-	// Load configuration
-	cfg, err := config.LoadConfig(log)
+	// Load configuration and watch it for runtime changes
+	configWatcher, err := config.NewWatcher(context.Background(), log)
 	if err != nil {
 		log.Fatal("Failed to load configuration", zap.Error(err))
 	}
+	cfg := configWatcher.Current()
+
+	// Sign the calls to the inventory service, which only accepts stock
+	// changes from known services
+	serviceSigner, err := servicetoken.SignerFromEnv("product-service")
+	if err != nil {
+		log.Fatal("Invalid service token key", zap.Error(err))
+	}
+	servicetoken.SetSigner(serviceSigner)
+	log.Info("Configuration loaded successfully",
+		zap.String("service", cfg.Server.ServiceName),
+		zap.String("port", cfg.Server.Port),
+	)
 
 	// Initialize database, create if not exists, and run migrations
 	log.Info("Initializing database and running migrations...")
@@ -91,6 +123,34 @@ func main() {
 	// For now, use the master connection for other repositories
 	brandRepo := repository.NewBrandRepository(dbConfig.Master, log)
 	categoryRepo := repository.NewCategoryRepository(dbConfig.Master, log)
+	collectionRepo := repository.NewCollectionRepository(dbConfig.Master, log)
+	bundleRepo := repository.NewBundleRepository(dbConfig.Master, log)
+	digitalRepo := repository.NewDigitalAssetRepository(dbConfig.Master, log)
+	subscriptionRepo := repository.NewSubscriptionRepository(dbConfig.Master, log)
+	personalizationRepo := repository.NewPersonalizationRepository(dbConfig.Master, log)
+	mediaUploadRepo := repository.NewMediaUploadRepository(dbConfig.Master, log)
+	productMediaRepo := repository.NewProductMediaRepository(dbConfig.Master, log)
+	storeRepo := repository.NewStoreRepository(dbConfig.Master, log)
+	channelRepo := repository.NewChannelRepository(dbConfig.Master, log)
+	feedRepo := repository.NewFeedRepository(dbConfig.Master, log)
+	syncRepo := repository.NewSyncRepository(dbConfig.Master, log)
+	pricingRepo := repository.NewPricingRepository(dbConfig.Master, log)
+	reconciliationRepo := repository.NewReconciliationRepository(dbConfig.Master, log)
+	catalogQualityRepo := repository.NewCatalogQualityRepository(dbConfig.Master, log)
+	badgeRepo := repository.NewBadgeRepository(dbConfig.Master, log)
+	mergeRepo := repository.NewProductMergeRepository(dbConfig.Master, log)
+	noteRepo := repository.NewProductNoteRepository(dbConfig.Master, log)
+	relationshipRepo := repository.NewRelationshipRepository(dbConfig.Master, log)
+	contentRepo := repository.NewContentRepository(dbConfig.Master, log)
+	settingsRepo := repository.NewSettingsRepository(dbConfig.Master, log)
+	importRepo := repository.NewImportRepository(dbConfig.Master, log)
+	importTemplateRepo := repository.NewImportTemplateRepository(dbConfig.Master, log)
+	translationRepo := repository.NewTranslationRepository(dbConfig.Master, log)
+	attributeRepo := repository.NewCategoryAttributeRepository(dbConfig.Master, log)
+	questionRepo := repository.NewProductQuestionRepository(dbConfig.Master, log)
+	savedSearchRepo := repository.NewSavedSearchRepository(dbConfig.Master, log)
+	searchRankingRepo := repository.NewSearchRankingRepository(dbConfig.Master, log)
+	catalogActivityRepo := repository.NewCatalogActivityRepository(dbConfig.Master, log)
 
 	// Initialize tiered cache manager with circuit breaker
 	cacheManager, err := cache.NewTieredCacheManager(cache.TieredCacheOptions{
@@ -98,7 +158,7 @@ func main() {
 		RedisPassword: cfg.Redis.Password,
 		RedisDB:       cfg.Redis.DB,
 		RedisPoolSize: 10,
-		DefaultTTL:    15 * time.Minute,
+		DefaultTTL:    cfg.Cache.DefaultTTL,
 		Logger:        log,
 		// Circuit breaker settings
 		FailureThreshold:         5,
@@ -109,6 +169,20 @@ func main() {
 		log.Fatal("Failed to initialize tiered cache manager", zap.Error(err))
 	}
 	defer cacheManager.Close()
+	cacheManager.ApplyTTLs(0, cfg.Cache.TTLs)
+
+	// Apply cache TTL and log level changes without a restart
+	configWatcher.Subscribe(func(old, new *config.Config) {
+		cacheManager.ApplyTTLs(new.Cache.DefaultTTL, new.Cache.TTLs)
+	})
+	sharedconfig.OnChange(configWatcher, func(c *config.Config) string { return c.Server.LogLevel }, func(level string) {
+		if err := logger.SetLevel(level); err != nil {
+			log.Warn("Ignoring invalid log level from configuration", zap.String("level", level), zap.Error(err))
+		}
+	})
+	watchCtx, stopWatching := context.WithCancel(context.Background())
+	defer stopWatching()
+	configWatcher.Start(watchCtx)
 
 	// Warm up cache with critical data
 	log.Info("Starting cache warm-up")
@@ -134,20 +208,223 @@ func main() {
 	}()
 
 	// Initialize service with all required repositories
+	// Feature flags are managed through the gateway admin API and shared via Redis
+	flagsRedis := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%s", cfg.Redis.Host, cfg.Redis.Port),
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	defer flagsRedis.Close()
+	flagsLoadCtx, cancelFlagsLoad := context.WithTimeout(watchCtx, 3*time.Second)
+	flagsClient := featureflags.NewClient(flagsLoadCtx, featureflags.NewRedisStore(flagsRedis), log)
+	cancelFlagsLoad()
+	flagsClient.Start(watchCtx)
+
+	// Product changes are published in-process to modules reacting to them,
+	// and relayed over Redis to the other services, such as the gateway
+	// response cache
+	eventBus := events.NewBus()
+	events.NewRelay(eventBus, flagsRedis, log).Start(watchCtx)
+
+	// Products written by older releases, or directly in the database, are
+	// given a default variant deriving their price and SKU
+	if cfg.PricingBackfill.Enabled {
+		backfillRepo := postgres.NewPricingBackfillRepository(dbConfig.Master, log)
+		service.NewPricingBackfillService(backfillRepo, log).StartBackfillScheduler(watchCtx, cfg.PricingBackfill.Interval, cfg.PricingBackfill.DryRun)
+	}
+
+	// Stores may have products checked by an external validator, such as
+	// their PIM, before they are saved
+	productValidator := service.NewProductValidator(settingsRepo, validationhook.NewClient(), log)
+
 	productService := service.NewProductService(
 		productRepo,
 		brandRepo,
 		categoryRepo,
+		bundleRepo,
+		digitalRepo,
+		subscriptionRepo,
+		attributeRepo,
+		personalizationRepo,
+		productMediaRepo,
 		cacheManager,
 		log,
 		inventoryClient,
+		flagsClient,
+		eventBus,
+		productValidator,
 	)
 	if productService == nil {
 		log.Fatal("Failed to create product service")
 	}
 
-	// Initialize handler with the service
-	productHandler := handlers.NewProductHandler(productService, log)
+	collectionService := service.NewCollectionService(collectionRepo, log)
+
+	// Digital assets are kept in private storage and only served through signed links
+	digitalStorage, err := storage.NewLocalStorage(cfg.Digital.StoragePath)
+	if err != nil {
+		log.Fatal("Failed to initialize digital asset storage", zap.Error(err))
+	}
+	if cfg.Secrets.DownloadSecret == "" {
+		log.Warn("DIGITAL_DOWNLOAD_SECRET is not set; digital download links are disabled")
+	}
+	digitalService := service.NewDigitalService(digitalRepo, productService, digitalStorage, service.DigitalServiceOptions{
+		SigningKey:           cfg.Secrets.DownloadSecret,
+		DownloadBaseURL:      cfg.Digital.DownloadBaseURL,
+		LinkTTL:              cfg.Digital.LinkTTL,
+		DefaultDownloadLimit: cfg.Digital.DefaultDownloadLimit,
+	}, log)
+
+	subscriptionService := service.NewSubscriptionService(subscriptionRepo, productService, log)
+	personalizationService := service.NewPersonalizationService(personalizationRepo, productService, log)
+
+	// Product media can be uploaded straight to Cloudinary with signed tickets
+	mediaUploadService := service.NewMediaUploadService(mediaUploadRepo, productService, service.MediaUploadOptions{
+		Folder:    cfg.Media.Folder,
+		UploadTTL: cfg.Media.UploadTTL,
+		MaxBytes:  cfg.Media.MaxBytes,
+		Formats:   cfg.Media.Formats,
+		Video:     mediaupload.Rules{MaxBytes: cfg.Media.Video.MaxBytes, Formats: cfg.Media.Video.Formats},
+		Model3D:   mediaupload.Rules{MaxBytes: cfg.Media.Model3D.MaxBytes, Formats: cfg.Media.Model3D.Formats},
+	}, log)
+
+	// Videos and 3D models are processing until their thumbnail is extracted
+	productMediaService := service.NewProductMediaService(productMediaRepo, storeRepo, productService, service.ProductMediaOptions{
+		VideoFormats: cfg.Media.Video.Formats,
+	}, log)
+	productMediaService.StartMediaProcessingScheduler(watchCtx, cfg.Media.ProcessingInterval)
+	if cfg.Subscriptions.RenewalEnabled {
+		subscriptionService.StartRenewalScheduler(watchCtx, cfg.Subscriptions.RenewalInterval, cfg.Subscriptions.RenewalBatchSize)
+	}
+
+	storeService := service.NewStoreService(storeRepo, log)
+	channelService := service.NewChannelService(channelRepo, productService, log)
+
+	// Marketplace feeds are kept in private storage and only served through signed links
+	feedStorage, err := storage.NewLocalStorage(cfg.Feeds.StoragePath)
+	if err != nil {
+		log.Fatal("Failed to initialize feed storage", zap.Error(err))
+	}
+	if cfg.Secrets.FeedSecret == "" {
+		log.Warn("FEED_SIGNING_SECRET is not set; marketplace feed links are disabled")
+	}
+	feedService := service.NewFeedService(feedRepo, storeRepo, productService, feedStorage, service.FeedServiceOptions{
+		SigningKey:    cfg.Secrets.FeedSecret,
+		BaseURL:       cfg.Feeds.BaseURL,
+		LinkTTL:       cfg.Feeds.LinkTTL,
+		StorefrontURL: cfg.Feeds.StorefrontURL,
+	}, log)
+	if cfg.Feeds.Enabled {
+		feedService.StartFeedScheduler(watchCtx, cfg.Feeds.Interval)
+	}
+
+	// ERP sync runs are refused while no connector is configured
+	var erpConnector erpsync.Connector
+	conflictPolicy, err := erpsync.ParseConflictPolicy(cfg.ErpSync.ConflictPolicy)
+	if err != nil {
+		log.Fatal("Invalid ERP sync configuration", zap.Error(err))
+	}
+	if cfg.ErpSync.Enabled {
+		erpConnector, err = newErpConnector(cfg)
+		if err != nil {
+			log.Fatal("Failed to initialize ERP sync connector", zap.Error(err))
+		}
+	}
+	erpSyncService := service.NewErpSyncService(syncRepo, storeRepo, productService, erpConnector, service.ErpSyncOptions{
+		Policy:    conflictPolicy,
+		BatchSize: cfg.ErpSync.BatchSize,
+	}, log)
+	if erpConnector != nil {
+		erpSyncService.StartSyncScheduler(watchCtx, cfg.ErpSync.Interval)
+	}
+
+	pricingService := service.NewPricingService(pricingRepo, productService, log)
+	reconciliationService := service.NewReconciliationService(reconciliationRepo, storeRepo, productService, log)
+	if cfg.Reconciliation.Enabled && inventoryClient != nil {
+		reconciliationService.StartReconciliationScheduler(watchCtx, cfg.Reconciliation.Interval, cfg.Reconciliation.AutoCreate)
+	}
+
+	mergeService := service.NewProductMergeService(mergeRepo, productService, log)
+	noteService := service.NewProductNoteService(noteRepo, log)
+	relationshipService := service.NewRelationshipService(relationshipRepo, productService, cfg.Relationships.DetailLimit, log)
+	importService := service.NewImportService(importTemplateRepo, importRepo, productService, log)
+	translationService := service.NewTranslationService(translationRepo, log)
+	contentService := service.NewContentService(contentRepo, log)
+
+	// Store settings are cached per store; changes are announced to every
+	// replica over the feature flags Redis
+	settingsService := service.NewSettingsService(settingsRepo, storeRepo, flagsRedis, cfg.Settings.CacheTTL, log)
+	settingsService.Start(watchCtx)
+	attributeService := service.NewCategoryAttributeService(attributeRepo, log)
+	questionService := service.NewProductQuestionService(questionRepo, log)
+
+	// Saved searches with alerts are diffed against their previous results;
+	// the notification service polls the alerts
+	savedSearchService := service.NewSavedSearchService(savedSearchRepo, log)
+	searchRankingService := service.NewSearchRankingService(searchRankingRepo, log)
+	if cfg.SavedSearches.Enabled {
+		savedSearchService.StartSavedSearchScheduler(watchCtx, cfg.SavedSearches.Interval)
+	}
+
+	// Product events feed the admin activity feed
+	catalogActivityService := service.NewCatalogActivityService(catalogActivityRepo, eventBus, log)
+	catalogActivityService.Start(watchCtx)
+
+	// Quality scores follow product events; the scheduler catches up on stock
+	// changes, which happen in the inventory service
+	catalogQualityService := service.NewCatalogQualityService(catalogQualityRepo, storeRepo, productService, eventBus, log)
+	catalogQualityService.Start(watchCtx)
+	if cfg.CatalogQuality.Enabled {
+		catalogQualityService.StartCatalogQualityScheduler(watchCtx, cfg.CatalogQuality.Interval)
+	}
+
+	// Badges are precomputed for every product when products or badge rules
+	// change; the scheduler catches up on products aging and selling
+	badgeService := service.NewBadgeService(badgeRepo, storeRepo, cfg.Badges.SalesWindow, eventBus, log)
+	badgeService.Start(watchCtx)
+	if cfg.Badges.Enabled {
+		badgeService.StartBadgeScheduler(watchCtx, cfg.Badges.Interval)
+	}
+
+	// Cache namespaces are invalidated and warmed again on the configured
+	// schedules
+	cacheSchedules, err := newCacheSchedules(cfg)
+	if err != nil {
+		log.Fatal("Invalid cache schedules", zap.Error(err))
+	}
+	cacheScheduleRepo := repository.NewCacheScheduleRepository(dbConfig.Master, log)
+	cacheScheduleService := service.NewCacheScheduleService(cacheScheduleRepo, storeRepo, productService, collectionService, cacheSchedules, log)
+	if cfg.CacheSchedules.Enabled {
+		cacheScheduleService.StartCacheScheduler(watchCtx, cfg.CacheSchedules.CheckInterval)
+	}
+
+	// Price history is partitioned by month; months past retention go to private storage
+	if cfg.Archival.Enabled {
+		archiver := partition.DirArchiver(filepath.Join(cfg.Archival.StoragePath, "partitions"))
+		partition.NewManager(dbConfig.Master, archiver, log,
+			partition.Table{Name: "price_history", Retention: cfg.Archival.PriceHistoryMonths},
+		).Start(watchCtx, cfg.Archival.Interval)
+	}
+
+	dbConfig.StartPoolMonitor(watchCtx, cfg.Database.ReplicaRouting.SampleInterval, cfg.Database.ReplicaRouting.MaxLag)
+
+	if cfg.Profiling.Enabled {
+		if err := profiling.Start(watchCtx, cfg.Profiling.Addr, log); err != nil {
+			log.Error("Failed to start profiling endpoints", zap.Error(err))
+		}
+	}
+
+	// Register database pools and caches for the diagnostics endpoint
+	diagnosticsCollector := diagnostics.NewCollector("product-service")
+	diagnosticsCollector.AddDB("master", dbConfig.Master, false)
+	for i, replica := range dbConfig.Replicas {
+		diagnosticsCollector.AddDB(fmt.Sprintf("replica-%d", i), replica, true)
+	}
+	diagnosticsCollector.SetReplicaStateSource(dbConfig)
+	diagnosticsCollector.AddCache("tiered", cacheManager)
+
+	// Initialize handler with the services
+	productHandler := handlers.NewProductHandler(productService, collectionService, digitalService, subscriptionService, storeService, channelService, feedService, erpSyncService, pricingService, reconciliationService, catalogQualityService, mergeService, noteService, importService, translationService, attributeService, questionService, savedSearchService, searchRankingService, badgeService, catalogActivityService, relationshipService, contentService, settingsService, personalizationService, mediaUploadService, productMediaService, cacheScheduleService, diagnosticsCollector, log)
 	if productHandler == nil {
 		log.Fatal("Failed to create product handler")
 	}
@@ -158,8 +435,41 @@ func main() {
 		log.Fatal("Failed to listen", zap.Error(err))
 	}
 
+	// Recover panics of handlers, reporting them to Sentry when configured
+	panicReporter, err := recovery.ReporterFromEnv(watchCtx, log)
+	if err != nil {
+		log.Fatal("Invalid Sentry configuration", zap.Error(err))
+	}
+	recoverer := recovery.New("product-service", log, panicReporter)
+
+	// Verify the service tokens of callers of privileged RPCs
+	serviceVerifier, err := servicetoken.VerifierFromEnv()
+	if err != nil {
+		log.Fatal("Invalid trusted service keys", zap.Error(err))
+	}
+	if serviceVerifier == nil {
+		log.Warn("SERVICE_TOKEN_TRUSTED_KEYS not set, privileged RPCs are open to any caller")
+	}
+
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(middleware.LoggingInterceptor(log)),
+		grpc.ChainUnaryInterceptor(
+			logger.UnaryServerInterceptor(log),
+			recoverer.UnaryServerInterceptor(),
+			tenant.UnaryServerInterceptor(),
+			locale.UnaryServerInterceptor(),
+			servicetoken.UnaryServerInterceptor(serviceVerifier, middleware.PrivilegedMethods),
+			scope.UnaryServerInterceptor(middleware.ScopedMethods),
+			cachectl.UnaryServerInterceptor(),
+			middleware.LoggingInterceptor(log),
+			apperrors.UnaryServerInterceptor(),
+		),
+		grpc.ChainStreamInterceptor(
+			recoverer.StreamServerInterceptor(),
+			tenant.StreamServerInterceptor(),
+			servicetoken.StreamServerInterceptor(serviceVerifier, middleware.PrivilegedMethods),
+			scope.StreamServerInterceptor(middleware.ScopedMethods),
+			apperrors.StreamServerInterceptor(),
+		),
 	)
 	pb.RegisterProductServiceServer(grpcServer, productHandler)
 
@@ -173,3 +483,62 @@ func main() {
 		log.Fatal("Failed to serve", zap.Error(err))
 	}
 }
+
+// newErpConnector creates the CSV connector over the configured transport
+func newErpConnector(cfg *config.Config) (erpsync.Connector, error) {
+	var transport erpsync.Transport
+	switch cfg.ErpSync.Transport {
+	case "sftp":
+		sftpTransport, err := erpsync.NewSFTPTransport(erpsync.SFTPConfig{
+			Host:           cfg.ErpSync.SFTP.Host,
+			Port:           cfg.ErpSync.SFTP.Port,
+			User:           cfg.ErpSync.SFTP.User,
+			Password:       cfg.Secrets.ErpSFTPPassword,
+			PrivateKeyPath: cfg.ErpSync.SFTP.PrivateKeyPath,
+			KnownHostsPath: cfg.ErpSync.SFTP.KnownHostsPath,
+			Dir:            cfg.ErpSync.SFTP.Dir,
+		})
+		if err != nil {
+			return nil, err
+		}
+		transport = sftpTransport
+	case "local", "":
+		localTransport, err := erpsync.NewLocalTransport(cfg.ErpSync.LocalDir)
+		if err != nil {
+			return nil, err
+		}
+		transport = localTransport
+	default:
+		return nil, fmt.Errorf("unknown ERP sync transport %q", cfg.ErpSync.Transport)
+	}
+
+	return erpsync.NewCSVConnector(cfg.ErpSync.Connector, transport), nil
+}
+
+// newCacheSchedules compiles the configured cache schedules
+func newCacheSchedules(cfg *config.Config) ([]cacheschedule.Schedule, error) {
+	schedules := make([]cacheschedule.Schedule, len(cfg.CacheSchedules.Schedules))
+	for i, scheduleCfg := range cfg.CacheSchedules.Schedules {
+		schedules[i] = cacheschedule.Schedule{
+			Name:       scheduleCfg.Name,
+			Namespace:  scheduleCfg.Namespace,
+			At:         scheduleCfg.At,
+			Days:       scheduleCfg.Days,
+			Timezone:   scheduleCfg.Timezone,
+			Invalidate: scheduleCfg.Invalidate,
+		}
+		for _, warm := range scheduleCfg.Warm {
+			schedules[i].Warm = append(schedules[i].Warm, cacheschedule.WarmQuery{
+				Type:    warm.Type,
+				Target:  warm.Target,
+				Channel: warm.Channel,
+				Page:    warm.Page,
+				Limit:   warm.Limit,
+			})
+		}
+	}
+	if err := cacheschedule.CompileAll(schedules, cache.Namespaces()); err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
@@ -0,0 +1,97 @@
+package mediaupload
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloudinary/cloudinary-go/v2"
+	"github.com/cloudinary/cloudinary-go/v2/api"
+	"github.com/cloudinary/cloudinary-go/v2/api/admin"
+	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+)
+
+// cloudinarySignatureTTL is how long Cloudinary accepts upload signatures for
+const cloudinarySignatureTTL = time.Hour
+
+// Cloudinary signs uploads to the upload API of a Cloudinary account
+type Cloudinary struct {
+	cld *cloudinary.Cloudinary
+}
+
+// Ensure Cloudinary implements Provider
+var _ Provider = (*Cloudinary)(nil)
+
+// NewCloudinary returns a provider uploading to the account of cld
+func NewCloudinary(cld *cloudinary.Cloudinary) *Cloudinary {
+	return &Cloudinary{cld: cld}
+}
+
+// Sign returns a signed upload form for the upload API of resourceType. The
+// signature covers the public ID and formats, so they cannot be changed by the
+// client.
+func (c *Cloudinary) Sign(publicID, resourceType string, formats []string, now time.Time) (*Ticket, error) {
+	params := url.Values{}
+	params.Set("public_id", publicID)
+	params.Set("timestamp", strconv.FormatInt(now.Unix(), 10))
+	if len(formats) > 0 {
+		params.Set("allowed_formats", strings.Join(formats, ","))
+	}
+
+	signature, err := api.SignParameters(params, c.cld.Config.Cloud.APISecret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign upload: %w", err)
+	}
+
+	fields := make(map[string]string, len(params)+2)
+	for key := range params {
+		fields[key] = params.Get(key)
+	}
+	fields["api_key"] = c.cld.Config.Cloud.APIKey
+	fields["signature"] = signature
+
+	return &Ticket{
+		URL:       fmt.Sprintf("https://api.cloudinary.com/v1_1/%s/%s/upload", c.cld.Config.Cloud.CloudName, resourceType),
+		Fields:    fields,
+		ExpiresAt: now.Add(cloudinarySignatureTTL),
+	}, nil
+}
+
+// Inspect returns the asset of resourceType uploaded under publicID
+func (c *Cloudinary) Inspect(ctx context.Context, resourceType, publicID string) (*Asset, error) {
+	result, err := c.cld.Admin.Asset(ctx, admin.AssetParams{AssetType: api.AssetType(resourceType), PublicID: publicID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get uploaded asset: %w", err)
+	}
+	if result.Error.Message != "" {
+		if strings.Contains(strings.ToLower(result.Error.Message), "not found") {
+			return nil, ErrAssetNotFound
+		}
+		return nil, fmt.Errorf("failed to get uploaded asset: %s", result.Error.Message)
+	}
+	return &Asset{
+		PublicID: result.PublicID,
+		URL:      result.SecureURL,
+		Format:   result.Format,
+		Bytes:    int64(result.Bytes),
+		Width:    result.Width,
+		Height:   result.Height,
+
+		UploadedAt: result.CreatedAt,
+	}, nil
+}
+
+// Delete removes the asset of resourceType uploaded under publicID
+func (c *Cloudinary) Delete(ctx context.Context, resourceType, publicID string) error {
+	result, err := c.cld.Upload.Destroy(ctx, uploader.DestroyParams{PublicID: publicID, ResourceType: resourceType})
+	if err != nil {
+		return fmt.Errorf("failed to delete uploaded asset: %w", err)
+	}
+	if result.Error.Message != "" {
+		return fmt.Errorf("failed to delete uploaded asset: %s", result.Error.Message)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Package mediaupload lets clients upload product media straight to the media
+// provider with a signed upload form, instead of sending the bytes through the
+// gateway and the product service, and checks the uploaded assets before they
+// are attached to a product.
+package mediaupload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+)
+
+// ErrAssetNotFound is returned when inspecting an asset that was not uploaded
+var ErrAssetNotFound = errors.New("uploaded asset not found")
+
+// DefaultFormats are the image formats accepted when none are configured
+var DefaultFormats = []string{"jpg", "jpeg", "png", "webp", "gif"}
+
+// DefaultMaxBytes is the size limit of uploaded assets when none is configured
+const DefaultMaxBytes = 10 << 20
+
+// Ticket is what a client needs to upload a file straight to the provider: a
+// multipart form POST of the file with Fields to URL, before ExpiresAt
+type Ticket struct {
+	URL       string
+	Fields    map[string]string
+	ExpiresAt time.Time
+}
+
+// Asset is a file uploaded to the provider, as reported by the provider
+type Asset struct {
+	PublicID string
+	URL      string
+	Format   string
+	Bytes    int64
+	Width    int
+	Height   int
+	// UploadedAt is when the provider received the file
+	UploadedAt time.Time
+}
+
+// Rules are the checks uploaded assets must pass to be attached to a product
+type Rules struct {
+	MaxBytes int64
+	Formats  []string
+}
+
+// Provider signs direct uploads and reports on the uploaded assets. Assets
+// are stored by resource type, such as image or video.
+type Provider interface {
+	// Sign returns a ticket uploading a file of resourceType under publicID,
+	// restricted to formats when any are given
+	Sign(publicID, resourceType string, formats []string, now time.Time) (*Ticket, error)
+	// Inspect returns the asset uploaded under publicID, or ErrAssetNotFound
+	Inspect(ctx context.Context, resourceType, publicID string) (*Asset, error)
+	// Delete removes the asset uploaded under publicID
+	Delete(ctx context.Context, resourceType, publicID string) error
+}
+
+// PublicID returns the public ID an upload of a product is stored under. The
+// ID of the upload makes it unguessable and unique, so that clients cannot
+// overwrite other assets with their ticket.
+func PublicID(folder, productID, uploadID string) string {
+	return path.Join(strings.Trim(folder, "/"), productID, uploadID)
+}
+
+// NormalizeFormats lowercases formats and drops their leading dots, returning
+// DefaultFormats when none are given
+func NormalizeFormats(formats []string) []string {
+	return NormalizeFormatsOr(formats, DefaultFormats)
+}
+
+// NormalizeFormatsOr lowercases formats and drops their leading dots,
+// returning defaults when none are given
+func NormalizeFormatsOr(formats, defaults []string) []string {
+	normalized := make([]string, 0, len(formats))
+	for _, format := range formats {
+		format = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(format)), ".")
+		if format != "" {
+			normalized = append(normalized, format)
+		}
+	}
+	if len(normalized) == 0 {
+		return append([]string(nil), defaults...)
+	}
+	return normalized
+}
+
+// Validate checks an asset uploaded with a ticket expiring at expiresAt
+// against rules
+func Validate(asset *Asset, expiresAt time.Time, rules Rules) error {
+	if !asset.UploadedAt.IsZero() && asset.UploadedAt.After(expiresAt) {
+		return fmt.Errorf("the file was uploaded after the upload expired")
+	}
+	if asset.URL == "" {
+		return fmt.Errorf("the uploaded asset has no URL")
+	}
+	if rules.MaxBytes > 0 && asset.Bytes > rules.MaxBytes {
+		return fmt.Errorf("the uploaded file is %d bytes, over the limit of %d bytes", asset.Bytes, rules.MaxBytes)
+	}
+	if len(rules.Formats) > 0 {
+		format := strings.ToLower(asset.Format)
+		for _, allowed := range rules.Formats {
+			if format == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("the uploaded file must be of type %s", strings.Join(rules.Formats, ", "))
+	}
+	return nil
+}
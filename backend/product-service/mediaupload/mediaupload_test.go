@@ -0,0 +1,91 @@
+package mediaupload
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cloudinary/cloudinary-go/v2"
+	"github.com/cloudinary/cloudinary-go/v2/api"
+)
+
+func TestPublicID(t *testing.T) {
+	if got := PublicID("/products/", "p1", "u1"); got != "products/p1/u1" {
+		t.Errorf("PublicID() = %q, want %q", got, "products/p1/u1")
+	}
+}
+
+func TestNormalizeFormats(t *testing.T) {
+	if got := NormalizeFormats([]string{" .PNG", "", "webp"}); !reflect.DeepEqual(got, []string{"png", "webp"}) {
+		t.Errorf("NormalizeFormats() = %v", got)
+	}
+	if got := NormalizeFormats(nil); !reflect.DeepEqual(got, DefaultFormats) {
+		t.Errorf("NormalizeFormats(nil) = %v, want %v", got, DefaultFormats)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	rules := Rules{MaxBytes: 1000, Formats: []string{"png", "jpg"}}
+	expiresAt := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	if err := Validate(&Asset{URL: "https://cdn/a.png", Format: "PNG", Bytes: 1000, UploadedAt: expiresAt}, expiresAt, rules); err != nil {
+		t.Errorf("Validate() error = %v", err)
+	}
+
+	invalid := map[string]*Asset{
+		"no URL":   {Format: "png", Bytes: 10},
+		"too big":  {URL: "https://cdn/a.png", Format: "png", Bytes: 1001},
+		"bad type": {URL: "https://cdn/a.gif", Format: "gif", Bytes: 10},
+		"expired":  {URL: "https://cdn/a.png", Format: "png", Bytes: 10, UploadedAt: expiresAt.Add(time.Second)},
+	}
+	for name, asset := range invalid {
+		if err := Validate(asset, expiresAt, rules); err == nil {
+			t.Errorf("Validate(%s) succeeded, want an error", name)
+		}
+	}
+}
+
+func TestCloudinarySign(t *testing.T) {
+	cld, err := cloudinary.NewFromParams("demo", "key", "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+
+	ticket, err := NewCloudinary(cld).Sign("products/p1/u1", "image", []string{"png", "jpg"}, now)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	if ticket.URL != "https://api.cloudinary.com/v1_1/demo/image/upload" {
+		t.Errorf("URL = %q", ticket.URL)
+	}
+	if !ticket.ExpiresAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("ExpiresAt = %v, want %v", ticket.ExpiresAt, now.Add(time.Hour))
+	}
+	if ticket.Fields["api_key"] != "key" || ticket.Fields["public_id"] != "products/p1/u1" || ticket.Fields["allowed_formats"] != "png,jpg" {
+		t.Errorf("Fields = %v", ticket.Fields)
+	}
+
+	// The signature covers the signed fields, so changing any is refused
+	signed := url.Values{
+		"public_id":       {"products/p1/u1"},
+		"timestamp":       {"1700000000"},
+		"allowed_formats": {"png,jpg"},
+	}
+	want, err := api.SignParameters(signed, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ticket.Fields["signature"] != want {
+		t.Errorf("signature = %q, want %q", ticket.Fields["signature"], want)
+	}
+
+	video, err := NewCloudinary(cld).Sign("products/p1/u2", "video", []string{"mp4"}, now)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	if video.URL != "https://api.cloudinary.com/v1_1/demo/video/upload" {
+		t.Errorf("URL = %q", video.URL)
+	}
+}
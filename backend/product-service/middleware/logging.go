@@ -1,38 +1,41 @@
 package middleware
 
 import (
-    "context"
-    "time"
+	"context"
+	"time"
 
-    "go.uber.org/zap"
-    "google.golang.org/grpc"
-    "google.golang.org/grpc/status"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
 )
 
 func LoggingInterceptor(logger *zap.Logger) grpc.UnaryServerInterceptor {
-    return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-        start := time.Now()
-        
-        // Execute the handler
-        resp, err := handler(ctx, req)
-        
-        // Log the request
-        duration := time.Since(start)
-        if err != nil {
-            st, _ := status.FromError(err)
-            logger.Error("gRPC request failed",
-                zap.String("method", info.FullMethod),
-                zap.Duration("duration", duration),
-                zap.String("code", st.Code().String()),
-                zap.Error(err),
-            )
-        } else {
-            logger.Info("gRPC request successful",
-                zap.String("method", info.FullMethod),
-                zap.Duration("duration", duration),
-            )
-        }
-        
-        return resp, err
-    }
-}
\ No newline at end of file
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		// Execute the handler
+		resp, err := handler(ctx, req)
+
+		// Log the request, tagged with the request and user IDs carried by ctx
+		duration := time.Since(start)
+		log := applogger.FromContext(applogger.WithContext(ctx, logger))
+		if err != nil {
+			st, _ := status.FromError(err)
+			log.Error("gRPC request failed",
+				zap.String("method", info.FullMethod),
+				zap.Duration("duration", duration),
+				zap.String("code", st.Code().String()),
+				zap.Error(err),
+			)
+		} else {
+			log.Info("gRPC request successful",
+				zap.String("method", info.FullMethod),
+				zap.Duration("duration", duration),
+			)
+		}
+
+		return resp, err
+	}
+}
@@ -0,0 +1,123 @@
+package middleware
+
+import (
+	"github.com/louai60/e-commerce_project/backend/common/servicetoken"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// Callers of the privileged RPCs, as named in their service tokens
+const (
+	gatewayService = "api-gateway"
+	adminService   = "admin-service"
+	orderService   = "order-service"
+	paymentService = "payment-service"
+)
+
+var (
+	staffCallers = []string{gatewayService, adminService}
+	// Sales feed the best-seller badges, so only the order flow reports them
+	orderCallers = []string{orderService}
+	// The payment service polls and acknowledges the subscription billing
+	// events
+	billingCallers = []string{paymentService}
+)
+
+// PrivilegedMethods lists the RPCs that change the catalog or expose
+// operational or subscription data, and the services allowed to call them.
+// Storefront reads stay open; customers reach their subscriptions through the
+// gateway, and subscription billing events are for the payment service only.
+var PrivilegedMethods = servicetoken.Policy{
+	pb.ProductService_CreateProduct_FullMethodName:                staffCallers,
+	pb.ProductService_UpdateProduct_FullMethodName:                staffCallers,
+	pb.ProductService_DeleteProduct_FullMethodName:                staffCallers,
+	pb.ProductService_MergeProducts_FullMethodName:                staffCallers,
+	pb.ProductService_SplitVariant_FullMethodName:                 staffCallers,
+	pb.ProductService_SaveImportTemplate_FullMethodName:           staffCallers,
+	pb.ProductService_GetImportTemplate_FullMethodName:            staffCallers,
+	pb.ProductService_ListImportTemplates_FullMethodName:          staffCallers,
+	pb.ProductService_DeleteImportTemplate_FullMethodName:         staffCallers,
+	pb.ProductService_ImportSupplierCatalog_FullMethodName:        staffCallers,
+	pb.ProductService_CreateProductNote_FullMethodName:            staffCallers,
+	pb.ProductService_ListProductNotes_FullMethodName:             staffCallers,
+	pb.ProductService_UpdateProductNote_FullMethodName:            staffCallers,
+	pb.ProductService_DeleteProductNote_FullMethodName:            staffCallers,
+	pb.ProductService_CreateProductRelationship_FullMethodName:    staffCallers,
+	pb.ProductService_ListProductRelationships_FullMethodName:     staffCallers,
+	pb.ProductService_UpdateProductRelationship_FullMethodName:    staffCallers,
+	pb.ProductService_DeleteProductRelationship_FullMethodName:    staffCallers,
+	pb.ProductService_ModerateProductQuestion_FullMethodName:      staffCallers,
+	pb.ProductService_DeleteProductQuestion_FullMethodName:        staffCallers,
+	pb.ProductService_AnswerProductQuestion_FullMethodName:        staffCallers,
+	pb.ProductService_ModerateProductAnswer_FullMethodName:        staffCallers,
+	pb.ProductService_DeleteProductAnswer_FullMethodName:          staffCallers,
+	pb.ProductService_SetTranslation_FullMethodName:               staffCallers,
+	pb.ProductService_ListTranslations_FullMethodName:             staffCallers,
+	pb.ProductService_DeleteTranslation_FullMethodName:            staffCallers,
+	pb.ProductService_CreateContentPage_FullMethodName:            staffCallers,
+	pb.ProductService_UpdateContentPage_FullMethodName:            staffCallers,
+	pb.ProductService_DeleteContentPage_FullMethodName:            staffCallers,
+	pb.ProductService_CreateContentBanner_FullMethodName:          staffCallers,
+	pb.ProductService_UpdateContentBanner_FullMethodName:          staffCallers,
+	pb.ProductService_DeleteContentBanner_FullMethodName:          staffCallers,
+	pb.ProductService_ListSettings_FullMethodName:                 staffCallers,
+	pb.ProductService_SetSetting_FullMethodName:                   staffCallers,
+	pb.ProductService_DeleteSetting_FullMethodName:                staffCallers,
+	pb.ProductService_CreateBrand_FullMethodName:                  staffCallers,
+	pb.ProductService_CreateCategory_FullMethodName:               staffCallers,
+	pb.ProductService_MoveCategory_FullMethodName:                 staffCallers,
+	pb.ProductService_MergeCategories_FullMethodName:              staffCallers,
+	pb.ProductService_ReorderSiblings_FullMethodName:              staffCallers,
+	pb.ProductService_CreateCategoryAttribute_FullMethodName:      staffCallers,
+	pb.ProductService_UpdateCategoryAttribute_FullMethodName:      staffCallers,
+	pb.ProductService_DeleteCategoryAttribute_FullMethodName:      staffCallers,
+	pb.ProductService_UploadImage_FullMethodName:                  staffCallers,
+	pb.ProductService_DeleteImage_FullMethodName:                  staffCallers,
+	pb.ProductService_CreateMediaUpload_FullMethodName:            staffCallers,
+	pb.ProductService_ConfirmMediaUpload_FullMethodName:           staffCallers,
+	pb.ProductService_AddProductVideo_FullMethodName:              staffCallers,
+	pb.ProductService_DeleteProductMedia_FullMethodName:           staffCallers,
+	pb.ProductService_CreateCollection_FullMethodName:             staffCallers,
+	pb.ProductService_UpdateCollection_FullMethodName:             staffCallers,
+	pb.ProductService_DeleteCollection_FullMethodName:             staffCallers,
+	pb.ProductService_SetCollectionProducts_FullMethodName:        staffCallers,
+	pb.ProductService_CreateBundle_FullMethodName:                 staffCallers,
+	pb.ProductService_UploadDigitalAsset_FullMethodName:           staffCallers,
+	pb.ProductService_CreateDownloadLink_FullMethodName:           staffCallers,
+	pb.ProductService_SetSubscriptionPlan_FullMethodName:          staffCallers,
+	pb.ProductService_CreateSubscription_FullMethodName:           staffCallers,
+	pb.ProductService_GetSubscription_FullMethodName:              staffCallers,
+	pb.ProductService_ListSubscriptions_FullMethodName:            staffCallers,
+	pb.ProductService_CancelSubscription_FullMethodName:           staffCallers,
+	pb.ProductService_SetPersonalizationOptions_FullMethodName:    staffCallers,
+	pb.ProductService_SetProductChannels_FullMethodName:           staffCallers,
+	pb.ProductService_CreateStore_FullMethodName:                  staffCallers,
+	pb.ProductService_UpdateStore_FullMethodName:                  staffCallers,
+	pb.ProductService_GenerateProductFeeds_FullMethodName:         staffCallers,
+	pb.ProductService_RunErpSync_FullMethodName:                   staffCallers,
+	pb.ProductService_BulkAdjustPrices_FullMethodName:             staffCallers,
+	pb.ProductService_RunInventoryReconciliation_FullMethodName:   staffCallers,
+	pb.ProductService_GetCatalogQualityReport_FullMethodName:      staffCallers,
+	pb.ProductService_GetProductQualityScore_FullMethodName:       staffCallers,
+	pb.ProductService_RecomputeCatalogQuality_FullMethodName:      staffCallers,
+	pb.ProductService_FlushCacheNamespace_FullMethodName:          staffCallers,
+	pb.ProductService_ListCacheSchedules_FullMethodName:           staffCallers,
+	pb.ProductService_ListCacheScheduleRuns_FullMethodName:        staffCallers,
+	pb.ProductService_RunCacheSchedule_FullMethodName:             staffCallers,
+	pb.ProductService_GetDiagnostics_FullMethodName:               staffCallers,
+	pb.ProductService_ListCatalogActivity_FullMethodName:          staffCallers,
+	pb.ProductService_PreviewSearchRanking_FullMethodName:         staffCallers,
+	pb.ProductService_SaveSearchRankingRule_FullMethodName:        staffCallers,
+	pb.ProductService_ListSearchRankingRules_FullMethodName:       staffCallers,
+	pb.ProductService_DeleteSearchRankingRule_FullMethodName:      staffCallers,
+	pb.ProductService_PublishSearchRankingRules_FullMethodName:    staffCallers,
+	pb.ProductService_ListBadgeRules_FullMethodName:               staffCallers,
+	pb.ProductService_SaveBadgeRule_FullMethodName:                staffCallers,
+	pb.ProductService_DeleteBadgeRule_FullMethodName:              staffCallers,
+	pb.ProductService_RecomputeBadges_FullMethodName:              staffCallers,
+	pb.ProductService_RecordProductSales_FullMethodName:           orderCallers,
+	pb.ProductService_ListSubscriptionEvents_FullMethodName:       billingCallers,
+	pb.ProductService_AckSubscriptionEvents_FullMethodName:        billingCallers,
+	pb.ProductService_ListErpSyncRuns_FullMethodName:              staffCallers,
+	pb.ProductService_GetInventoryReconciliation_FullMethodName:   staffCallers,
+	pb.ProductService_ListInventoryReconciliations_FullMethodName: staffCallers,
+}
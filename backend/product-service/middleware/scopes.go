@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// ScopedMethods lists the RPCs changing the catalog and the scope the users
+// they are made for must hold. Calls made by services on their own behalf
+// carry no user scopes and are left to PrivilegedMethods.
+var ScopedMethods = scope.Policy{
+	pb.ProductService_CreateProduct_FullMethodName:              scope.ProductsWrite,
+	pb.ProductService_UpdateProduct_FullMethodName:              scope.ProductsWrite,
+	pb.ProductService_DeleteProduct_FullMethodName:              scope.ProductsWrite,
+	pb.ProductService_MergeProducts_FullMethodName:              scope.ProductsWrite,
+	pb.ProductService_SplitVariant_FullMethodName:               scope.ProductsWrite,
+	pb.ProductService_SaveImportTemplate_FullMethodName:         scope.ProductsWrite,
+	pb.ProductService_DeleteImportTemplate_FullMethodName:       scope.ProductsWrite,
+	pb.ProductService_ImportSupplierCatalog_FullMethodName:      scope.ProductsWrite,
+	pb.ProductService_CreateProductNote_FullMethodName:          scope.ProductsWrite,
+	pb.ProductService_UpdateProductNote_FullMethodName:          scope.ProductsWrite,
+	pb.ProductService_DeleteProductNote_FullMethodName:          scope.ProductsWrite,
+	pb.ProductService_ModerateProductQuestion_FullMethodName:    scope.ProductsWrite,
+	pb.ProductService_DeleteProductQuestion_FullMethodName:      scope.ProductsWrite,
+	pb.ProductService_AnswerProductQuestion_FullMethodName:      scope.ProductsWrite,
+	pb.ProductService_ModerateProductAnswer_FullMethodName:      scope.ProductsWrite,
+	pb.ProductService_DeleteProductAnswer_FullMethodName:        scope.ProductsWrite,
+	pb.ProductService_SetTranslation_FullMethodName:             scope.ProductsWrite,
+	pb.ProductService_DeleteTranslation_FullMethodName:          scope.ProductsWrite,
+	pb.ProductService_CreateBrand_FullMethodName:                scope.ProductsWrite,
+	pb.ProductService_CreateCategory_FullMethodName:             scope.ProductsWrite,
+	pb.ProductService_MoveCategory_FullMethodName:               scope.ProductsWrite,
+	pb.ProductService_MergeCategories_FullMethodName:            scope.ProductsWrite,
+	pb.ProductService_ReorderSiblings_FullMethodName:            scope.ProductsWrite,
+	pb.ProductService_CreateCategoryAttribute_FullMethodName:    scope.ProductsWrite,
+	pb.ProductService_UpdateCategoryAttribute_FullMethodName:    scope.ProductsWrite,
+	pb.ProductService_DeleteCategoryAttribute_FullMethodName:    scope.ProductsWrite,
+	pb.ProductService_UploadImage_FullMethodName:                scope.ProductsWrite,
+	pb.ProductService_DeleteImage_FullMethodName:                scope.ProductsWrite,
+	pb.ProductService_CreateMediaUpload_FullMethodName:          scope.ProductsWrite,
+	pb.ProductService_ConfirmMediaUpload_FullMethodName:         scope.ProductsWrite,
+	pb.ProductService_AddProductVideo_FullMethodName:            scope.ProductsWrite,
+	pb.ProductService_DeleteProductMedia_FullMethodName:         scope.ProductsWrite,
+	pb.ProductService_CreateCollection_FullMethodName:           scope.ProductsWrite,
+	pb.ProductService_UpdateCollection_FullMethodName:           scope.ProductsWrite,
+	pb.ProductService_DeleteCollection_FullMethodName:           scope.ProductsWrite,
+	pb.ProductService_SetCollectionProducts_FullMethodName:      scope.ProductsWrite,
+	pb.ProductService_CreateBundle_FullMethodName:               scope.ProductsWrite,
+	pb.ProductService_UploadDigitalAsset_FullMethodName:         scope.ProductsWrite,
+	pb.ProductService_CreateDownloadLink_FullMethodName:         scope.ProductsWrite,
+	pb.ProductService_SetSubscriptionPlan_FullMethodName:        scope.ProductsWrite,
+	pb.ProductService_CreateSubscription_FullMethodName:         scope.ProductsWrite,
+	pb.ProductService_SetPersonalizationOptions_FullMethodName:  scope.ProductsWrite,
+	pb.ProductService_SetProductChannels_FullMethodName:         scope.ProductsWrite,
+	pb.ProductService_BulkAdjustPrices_FullMethodName:           scope.ProductsWrite,
+	pb.ProductService_RecomputeCatalogQuality_FullMethodName:    scope.ProductsWrite,
+	pb.ProductService_PreviewSearchRanking_FullMethodName:       scope.ProductsWrite,
+	pb.ProductService_SaveSearchRankingRule_FullMethodName:      scope.ProductsWrite,
+	pb.ProductService_DeleteSearchRankingRule_FullMethodName:    scope.ProductsWrite,
+	pb.ProductService_PublishSearchRankingRules_FullMethodName:  scope.ProductsWrite,
+	pb.ProductService_SaveBadgeRule_FullMethodName:              scope.ProductsWrite,
+	pb.ProductService_DeleteBadgeRule_FullMethodName:            scope.ProductsWrite,
+	pb.ProductService_RecomputeBadges_FullMethodName:            scope.ProductsWrite,
+	pb.ProductService_CreateStore_FullMethodName:                scope.ProductsWrite,
+	pb.ProductService_UpdateStore_FullMethodName:                scope.ProductsWrite,
+	pb.ProductService_GenerateProductFeeds_FullMethodName:       scope.ProductsWrite,
+	pb.ProductService_RunErpSync_FullMethodName:                 scope.ProductsWrite,
+	pb.ProductService_RunInventoryReconciliation_FullMethodName: scope.ProductsWrite,
+	pb.ProductService_FlushCacheNamespace_FullMethodName:        scope.ProductsWrite,
+	pb.ProductService_CreateProductRelationship_FullMethodName:  scope.ProductsWrite,
+	pb.ProductService_UpdateProductRelationship_FullMethodName:  scope.ProductsWrite,
+	pb.ProductService_DeleteProductRelationship_FullMethodName:  scope.ProductsWrite,
+	pb.ProductService_CreateContentPage_FullMethodName:          scope.ProductsWrite,
+	pb.ProductService_UpdateContentPage_FullMethodName:          scope.ProductsWrite,
+	pb.ProductService_DeleteContentPage_FullMethodName:          scope.ProductsWrite,
+	pb.ProductService_CreateContentBanner_FullMethodName:        scope.ProductsWrite,
+	pb.ProductService_UpdateContentBanner_FullMethodName:        scope.ProductsWrite,
+	pb.ProductService_DeleteContentBanner_FullMethodName:        scope.ProductsWrite,
+	pb.ProductService_SetSetting_FullMethodName:                 scope.ProductsWrite,
+	pb.ProductService_DeleteSetting_FullMethodName:              scope.ProductsWrite,
+	pb.ProductService_RunCacheSchedule_FullMethodName:           scope.ProductsWrite,
+}
@@ -0,0 +1,7 @@
+-- Migration: 000015_add_collections (Down)
+
+-- Step 1: Drop collection_products table
+DROP TABLE IF EXISTS collection_products CASCADE;
+
+-- Step 2: Drop collections table
+DROP TABLE IF EXISTS collections CASCADE;
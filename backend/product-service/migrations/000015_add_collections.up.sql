@@ -0,0 +1,32 @@
+-- Migration: 000015_add_collections (Up)
+
+-- Step 1: Create collections table
+CREATE TABLE collections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    name VARCHAR(255) NOT NULL,
+    slug VARCHAR(255) UNIQUE NOT NULL,
+    description TEXT,
+    image_url TEXT,
+    is_published BOOLEAN DEFAULT FALSE,
+    rule_brand_ids UUID[] NOT NULL DEFAULT '{}',
+    rule_category_ids UUID[] NOT NULL DEFAULT '{}',
+    rule_tags TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+CREATE INDEX idx_collections_slug ON collections(slug);
+CREATE INDEX idx_collections_deleted_at ON collections(deleted_at);
+
+-- Step 2: Create collection_products table for manually curated members
+CREATE TABLE collection_products (
+    collection_id UUID NOT NULL,
+    product_id UUID NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (collection_id, product_id),
+    CONSTRAINT fk_collection_product_collection FOREIGN KEY (collection_id) REFERENCES collections(id) ON DELETE CASCADE,
+    CONSTRAINT fk_collection_product_product FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+CREATE INDEX idx_collection_products_position ON collection_products(collection_id, position);
+CREATE INDEX idx_collection_products_product_id ON collection_products(product_id);
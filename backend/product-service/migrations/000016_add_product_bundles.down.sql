@@ -0,0 +1,7 @@
+-- Migration: 000016_add_product_bundles (Down)
+
+-- Step 1: Drop bundle_components table
+DROP TABLE IF EXISTS bundle_components CASCADE;
+
+-- Step 2: Drop product_bundles table
+DROP TABLE IF EXISTS product_bundles CASCADE;
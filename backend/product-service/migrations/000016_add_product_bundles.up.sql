@@ -0,0 +1,24 @@
+-- Migration: 000016_add_product_bundles (Up)
+
+-- Step 1: Create product_bundles table marking products sold as a kit of other SKUs
+CREATE TABLE product_bundles (
+    product_id UUID PRIMARY KEY,
+    price_override DECIMAL(10, 2),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT fk_product_bundle_product FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    CONSTRAINT product_bundles_price_override_check CHECK (price_override IS NULL OR price_override > 0)
+);
+
+-- Step 2: Create bundle_components table holding the component SKUs of each bundle
+CREATE TABLE bundle_components (
+    bundle_product_id UUID NOT NULL,
+    component_sku VARCHAR(100) NOT NULL,
+    quantity INT NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (bundle_product_id, component_sku),
+    CONSTRAINT fk_bundle_component_bundle FOREIGN KEY (bundle_product_id) REFERENCES product_bundles(product_id) ON DELETE CASCADE,
+    CONSTRAINT bundle_components_quantity_check CHECK (quantity > 0)
+);
+CREATE INDEX idx_bundle_components_sku ON bundle_components(component_sku);
@@ -0,0 +1,7 @@
+-- Migration: 000017_add_digital_products (Down)
+
+-- Step 1: Drop digital_download_grants table
+DROP TABLE IF EXISTS digital_download_grants CASCADE;
+
+-- Step 2: Drop digital_assets table
+DROP TABLE IF EXISTS digital_assets CASCADE;
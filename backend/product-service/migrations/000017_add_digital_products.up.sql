@@ -0,0 +1,32 @@
+-- Migration: 000017_add_digital_products (Up)
+
+-- Step 1: Create digital_assets table holding the downloadable file of a digital product
+CREATE TABLE digital_assets (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL UNIQUE,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    storage_key TEXT NOT NULL,
+    download_limit INT NOT NULL DEFAULT 5,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT fk_digital_asset_product FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    CONSTRAINT digital_assets_download_limit_check CHECK (download_limit > 0)
+);
+
+-- Step 2: Create digital_download_grants table counting downloads per purchase
+CREATE TABLE digital_download_grants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL,
+    purchase_id VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    download_count INT NOT NULL DEFAULT 0,
+    download_limit INT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    last_downloaded_at TIMESTAMPTZ,
+    CONSTRAINT fk_download_grant_product FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    CONSTRAINT digital_download_grants_purchase_product_key UNIQUE (purchase_id, product_id),
+    CONSTRAINT digital_download_grants_count_check CHECK (download_count >= 0 AND download_count <= download_limit)
+);
+CREATE INDEX idx_digital_download_grants_user_id ON digital_download_grants(user_id);
@@ -0,0 +1,10 @@
+-- Migration: 000018_add_subscriptions (Down)
+
+-- Step 1: Drop subscription_events table
+DROP TABLE IF EXISTS subscription_events CASCADE;
+
+-- Step 2: Drop subscriptions table
+DROP TABLE IF EXISTS subscriptions CASCADE;
+
+-- Step 3: Drop product_subscription_plans table
+DROP TABLE IF EXISTS product_subscription_plans CASCADE;
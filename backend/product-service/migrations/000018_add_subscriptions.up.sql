@@ -0,0 +1,53 @@
+-- Migration: 000018_add_subscriptions (Up)
+
+-- Step 1: Create product_subscription_plans table marking products sold as recurring subscriptions
+CREATE TABLE product_subscription_plans (
+    product_id UUID PRIMARY KEY,
+    billing_interval VARCHAR(10) NOT NULL,
+    interval_count INT NOT NULL DEFAULT 1,
+    trial_days INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT fk_subscription_plan_product FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    CONSTRAINT product_subscription_plans_interval_check CHECK (billing_interval IN ('day', 'week', 'month', 'year')),
+    CONSTRAINT product_subscription_plans_interval_count_check CHECK (interval_count > 0),
+    CONSTRAINT product_subscription_plans_trial_days_check CHECK (trial_days >= 0)
+);
+
+-- Step 2: Create subscriptions table tracking the subscriptions of customers
+CREATE TABLE subscriptions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    purchase_id VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    current_period_start TIMESTAMPTZ NOT NULL,
+    current_period_end TIMESTAMPTZ NOT NULL,
+    trial_ends_at TIMESTAMPTZ,
+    cancel_at_period_end BOOLEAN NOT NULL DEFAULT FALSE,
+    cancelled_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT fk_subscription_product FOREIGN KEY (product_id) REFERENCES products(id),
+    CONSTRAINT subscriptions_purchase_product_key UNIQUE (purchase_id, product_id),
+    CONSTRAINT subscriptions_status_check CHECK (status IN ('trialing', 'active', 'past_due', 'cancelled'))
+);
+CREATE INDEX idx_subscriptions_user_id ON subscriptions(user_id);
+CREATE INDEX idx_subscriptions_renewal ON subscriptions(current_period_end) WHERE status IN ('trialing', 'active');
+
+-- Step 3: Create subscription_events table as an outbox of billing events for the payment service
+CREATE TABLE subscription_events (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    subscription_id UUID NOT NULL,
+    event_type VARCHAR(50) NOT NULL,
+    amount DECIMAL(10, 2) NOT NULL DEFAULT 0,
+    currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    period_start TIMESTAMPTZ,
+    period_end TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    delivered_at TIMESTAMPTZ,
+    CONSTRAINT fk_subscription_event_subscription FOREIGN KEY (subscription_id) REFERENCES subscriptions(id) ON DELETE CASCADE
+);
+CREATE INDEX idx_subscription_events_pending ON subscription_events(created_at) WHERE delivered_at IS NULL;
@@ -0,0 +1,18 @@
+-- Migration: 000019_add_stores (Down)
+
+-- Step 1: Restore globally unique slugs
+DROP INDEX IF EXISTS products_tenant_slug_key;
+DROP INDEX IF EXISTS brands_tenant_slug_key;
+DROP INDEX IF EXISTS categories_tenant_slug_key;
+
+ALTER TABLE products ADD CONSTRAINT products_slug_key UNIQUE (slug);
+ALTER TABLE brands ADD CONSTRAINT brands_slug_key UNIQUE (slug);
+ALTER TABLE categories ADD CONSTRAINT categories_slug_key UNIQUE (slug);
+
+-- Step 2: Make tenant_id optional again
+ALTER TABLE products ALTER COLUMN tenant_id DROP NOT NULL, ALTER COLUMN tenant_id SET DEFAULT NULL;
+ALTER TABLE brands ALTER COLUMN tenant_id DROP NOT NULL, ALTER COLUMN tenant_id SET DEFAULT NULL;
+ALTER TABLE categories ALTER COLUMN tenant_id DROP NOT NULL, ALTER COLUMN tenant_id SET DEFAULT NULL;
+
+-- Step 3: Drop stores table
+DROP TABLE IF EXISTS stores;
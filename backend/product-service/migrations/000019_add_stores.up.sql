@@ -0,0 +1,36 @@
+-- Migration: 000019_add_stores (Up)
+
+-- Step 1: Create stores table holding the storefronts (tenants) served by the platform
+CREATE TABLE stores (
+    id VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    domain VARCHAR(255),
+    default_currency VARCHAR(3) NOT NULL DEFAULT 'USD',
+    default_locale VARCHAR(10) NOT NULL DEFAULT 'en',
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CONSTRAINT stores_domain_key UNIQUE (domain),
+    CONSTRAINT stores_id_check CHECK (id ~ '^[a-z0-9][a-z0-9-]*$')
+);
+
+-- Step 2: Seed the default store existing catalog data belongs to
+INSERT INTO stores (id, name) VALUES ('default', 'Default Store');
+
+-- Step 3: Assign existing catalog rows to the default store and make tenant_id required
+UPDATE products SET tenant_id = 'default' WHERE tenant_id IS NULL;
+UPDATE brands SET tenant_id = 'default' WHERE tenant_id IS NULL;
+UPDATE categories SET tenant_id = 'default' WHERE tenant_id IS NULL;
+
+ALTER TABLE products ALTER COLUMN tenant_id SET DEFAULT 'default', ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE brands ALTER COLUMN tenant_id SET DEFAULT 'default', ALTER COLUMN tenant_id SET NOT NULL;
+ALTER TABLE categories ALTER COLUMN tenant_id SET DEFAULT 'default', ALTER COLUMN tenant_id SET NOT NULL;
+
+-- Step 4: Make slugs unique per store instead of globally
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_slug_key;
+ALTER TABLE brands DROP CONSTRAINT IF EXISTS brands_slug_key;
+ALTER TABLE categories DROP CONSTRAINT IF EXISTS categories_slug_key;
+
+CREATE UNIQUE INDEX products_tenant_slug_key ON products(tenant_id, slug);
+CREATE UNIQUE INDEX brands_tenant_slug_key ON brands(tenant_id, slug);
+CREATE UNIQUE INDEX categories_tenant_slug_key ON categories(tenant_id, slug);
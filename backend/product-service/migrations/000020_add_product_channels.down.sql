@@ -0,0 +1,4 @@
+-- Migration: 000020_add_product_channels (Down)
+
+-- Step 1: Drop product_channels table
+DROP TABLE IF EXISTS product_channels;
@@ -0,0 +1,17 @@
+-- Migration: 000020_add_product_channels (Up)
+
+-- Step 1: Create product_channels table controlling where a product is published.
+-- Products without a row for a channel are visible in it, so existing
+-- products stay visible everywhere until an admin hides them.
+CREATE TABLE product_channels (
+    product_id UUID NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    is_visible BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (product_id, channel),
+    CONSTRAINT fk_product_channel_product FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE,
+    CONSTRAINT product_channels_channel_check CHECK (channel IN ('web', 'mobile', 'marketplace'))
+);
+
+-- Step 2: Index hidden products per channel for list filtering
+CREATE INDEX idx_product_channels_hidden ON product_channels(channel, product_id) WHERE NOT is_visible;
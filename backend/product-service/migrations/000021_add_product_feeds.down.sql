@@ -0,0 +1,4 @@
+-- Migration: 000021_add_product_feeds (Down)
+
+-- Step 1: Drop product_feeds table
+DROP TABLE IF EXISTS product_feeds;
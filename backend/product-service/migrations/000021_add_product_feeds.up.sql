@@ -0,0 +1,16 @@
+-- Migration: 000021_add_product_feeds (Up)
+
+-- Step 1: Create product_feeds table recording the latest generated
+-- marketplace feed of each store and format. The feed file itself lives in
+-- feed storage under storage_key.
+CREATE TABLE product_feeds (
+    tenant_id VARCHAR(50) NOT NULL,
+    format VARCHAR(30) NOT NULL,
+    storage_key TEXT NOT NULL,
+    product_count INT NOT NULL DEFAULT 0,
+    size_bytes BIGINT NOT NULL DEFAULT 0,
+    generated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, format),
+    CONSTRAINT fk_product_feed_store FOREIGN KEY (tenant_id) REFERENCES stores(id) ON DELETE CASCADE,
+    CONSTRAINT product_feeds_format_check CHECK (format IN ('google_merchant', 'facebook_catalog'))
+);
@@ -0,0 +1,8 @@
+-- Migration: 000022_add_erp_sync (Down)
+
+-- Step 1: Drop the product update index
+DROP INDEX IF EXISTS idx_products_tenant_updated;
+
+-- Step 2: Drop sync tables
+DROP TABLE IF EXISTS erp_sync_runs;
+DROP TABLE IF EXISTS erp_sync_cursors;
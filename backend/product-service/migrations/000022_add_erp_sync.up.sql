@@ -0,0 +1,40 @@
+-- Migration: 000022_add_erp_sync (Up)
+
+-- Step 1: Create erp_sync_cursors table recording how far each connector has
+-- synchronized an entity in each direction. synced_at is when the last pull
+-- finished and is used to detect records changed on both sides.
+CREATE TABLE erp_sync_cursors (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    connector VARCHAR(50) NOT NULL,
+    entity VARCHAR(20) NOT NULL,
+    direction VARCHAR(10) NOT NULL,
+    cursor_updated_at TIMESTAMPTZ NOT NULL DEFAULT 'epoch',
+    cursor_sku VARCHAR(100) NOT NULL DEFAULT '',
+    synced_at TIMESTAMPTZ NOT NULL DEFAULT 'epoch',
+    PRIMARY KEY (tenant_id, connector, entity, direction),
+    CONSTRAINT erp_sync_cursors_direction_check CHECK (direction IN ('pull', 'push'))
+);
+
+-- Step 2: Create erp_sync_runs table logging every entity sync
+CREATE TABLE erp_sync_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    connector VARCHAR(50) NOT NULL,
+    entity VARCHAR(20) NOT NULL,
+    direction VARCHAR(10) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'running',
+    records_read INT NOT NULL DEFAULT 0,
+    records_applied INT NOT NULL DEFAULT 0,
+    records_skipped INT NOT NULL DEFAULT 0,
+    conflicts INT NOT NULL DEFAULT 0,
+    error TEXT,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ,
+    CONSTRAINT erp_sync_runs_status_check CHECK (status IN ('running', 'succeeded', 'failed'))
+);
+
+-- Step 3: Index runs for the most recent first listing
+CREATE INDEX idx_erp_sync_runs_tenant_started ON erp_sync_runs(tenant_id, started_at DESC);
+
+-- Step 4: Index products by update time for incremental pushes
+CREATE INDEX IF NOT EXISTS idx_products_tenant_updated ON products(tenant_id, updated_at, sku);
@@ -0,0 +1,4 @@
+-- Migration: 000023_add_price_history (Down)
+
+-- Step 1: Drop price_history table
+DROP TABLE IF EXISTS price_history;
@@ -0,0 +1,23 @@
+-- Migration: 000023_add_price_history (Up)
+
+-- Step 1: Create price_history table recording every price change made by
+-- bulk adjustments. Entries of one adjustment share a batch_id.
+CREATE TABLE price_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL,
+    old_price DECIMAL(10, 2) NOT NULL,
+    new_price DECIMAL(10, 2) NOT NULL,
+    old_discount_price DECIMAL(10, 2),
+    new_discount_price DECIMAL(10, 2),
+    source VARCHAR(30) NOT NULL,
+    batch_id UUID NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    changed_by VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_price_history_product FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+-- Step 2: Index history per product and per batch
+CREATE INDEX idx_price_history_product ON price_history(product_id, created_at DESC);
+CREATE INDEX idx_price_history_batch ON price_history(batch_id);
@@ -0,0 +1,5 @@
+-- Migration: 000024_add_inventory_reconciliation (Down)
+
+-- Step 1: Drop the entries before the reconciliations they belong to
+DROP TABLE IF EXISTS inventory_reconciliation_entries;
+DROP TABLE IF EXISTS inventory_reconciliations;
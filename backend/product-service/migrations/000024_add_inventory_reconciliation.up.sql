@@ -0,0 +1,36 @@
+-- Migration: 000024_add_inventory_reconciliation (Up)
+
+-- Step 1: Create inventory_reconciliations table logging every cross-check of
+-- product SKUs against inventory items
+CREATE TABLE inventory_reconciliations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    auto_create BOOLEAN NOT NULL DEFAULT FALSE,
+    skus_checked INT NOT NULL DEFAULT 0,
+    inventory_items_checked INT NOT NULL DEFAULT 0,
+    missing_count INT NOT NULL DEFAULT 0,
+    orphaned_count INT NOT NULL DEFAULT 0,
+    created_count INT NOT NULL DEFAULT 0,
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ NOT NULL
+);
+
+-- Step 2: Create inventory_reconciliation_entries table holding the SKUs found
+-- on only one side. Missing entries are products or variants without an
+-- inventory item; orphaned entries are inventory items without a product.
+CREATE TABLE inventory_reconciliation_entries (
+    reconciliation_id UUID NOT NULL REFERENCES inventory_reconciliations(id) ON DELETE CASCADE,
+    kind VARCHAR(10) NOT NULL,
+    sku VARCHAR(100) NOT NULL,
+    product_id VARCHAR(100),
+    variant_id VARCHAR(100),
+    inventory_item_id VARCHAR(100),
+    created BOOLEAN NOT NULL DEFAULT FALSE,
+    error TEXT,
+    CONSTRAINT inventory_reconciliation_entries_kind_check CHECK (kind IN ('missing', 'orphaned'))
+);
+
+-- Step 3: Index reconciliations for the most recent first listing and entries
+-- by reconciliation
+CREATE INDEX idx_inventory_reconciliations_tenant_started ON inventory_reconciliations(tenant_id, started_at DESC);
+CREATE INDEX idx_inventory_reconciliation_entries_reconciliation ON inventory_reconciliation_entries(reconciliation_id);
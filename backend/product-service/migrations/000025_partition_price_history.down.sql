@@ -0,0 +1,33 @@
+-- Migration: 000025_partition_price_history (Down)
+
+-- Step 1: Set the partitioned table aside
+ALTER TABLE price_history RENAME TO price_history_partitioned;
+ALTER TABLE price_history_partitioned RENAME CONSTRAINT price_history_pkey TO price_history_partitioned_pkey;
+DROP INDEX IF EXISTS idx_price_history_product;
+DROP INDEX IF EXISTS idx_price_history_batch;
+
+-- Step 2: Recreate the plain price_history table
+CREATE TABLE price_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL,
+    old_price DECIMAL(10, 2) NOT NULL,
+    new_price DECIMAL(10, 2) NOT NULL,
+    old_discount_price DECIMAL(10, 2),
+    new_discount_price DECIMAL(10, 2),
+    source VARCHAR(30) NOT NULL,
+    batch_id UUID NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    changed_by VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT fk_price_history_product FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+);
+
+-- Step 3: Move the entries of the attached partitions back, archived
+-- partitions are not restored
+INSERT INTO price_history SELECT * FROM price_history_partitioned;
+DROP TABLE price_history_partitioned;
+
+-- Step 4: Index history per product and per batch
+CREATE INDEX idx_price_history_product ON price_history(product_id, created_at DESC);
+CREATE INDEX idx_price_history_batch ON price_history(batch_id);
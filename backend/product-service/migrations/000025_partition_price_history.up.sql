@@ -0,0 +1,60 @@
+-- Migration: 000025_partition_price_history (Up)
+
+-- Step 1: Set the current table aside
+ALTER TABLE price_history RENAME TO price_history_unpartitioned;
+ALTER TABLE price_history_unpartitioned RENAME CONSTRAINT price_history_pkey TO price_history_unpartitioned_pkey;
+DROP INDEX IF EXISTS idx_price_history_product;
+DROP INDEX IF EXISTS idx_price_history_batch;
+
+-- Step 2: Recreate price_history partitioned by month of created_at. The
+-- primary key must include the partition key. Monthly partitions are created
+-- ahead of time by the partition maintenance job; the default partition only
+-- catches rows outside of them.
+CREATE TABLE price_history (
+    id UUID NOT NULL DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL,
+    old_price DECIMAL(10, 2) NOT NULL,
+    new_price DECIMAL(10, 2) NOT NULL,
+    old_discount_price DECIMAL(10, 2),
+    new_discount_price DECIMAL(10, 2),
+    source VARCHAR(30) NOT NULL,
+    batch_id UUID NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    changed_by VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (id, created_at),
+    CONSTRAINT fk_price_history_product FOREIGN KEY (product_id) REFERENCES products(id) ON DELETE CASCADE
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE price_history_default PARTITION OF price_history DEFAULT;
+
+-- Step 3: Create the UTC monthly partitions from the oldest entry to two
+-- months ahead, named price_history_yYYYYmMM
+DO $$
+DECLARE
+    month_start TIMESTAMP;
+BEGIN
+    FOR month_start IN
+        SELECT generate_series(
+            date_trunc('month', COALESCE((SELECT MIN(created_at) FROM price_history_unpartitioned), NOW()) AT TIME ZONE 'UTC'),
+            date_trunc('month', NOW() AT TIME ZONE 'UTC') + INTERVAL '2 months',
+            INTERVAL '1 month'
+        )
+    LOOP
+        EXECUTE format(
+            'CREATE TABLE %I PARTITION OF price_history FOR VALUES FROM (%L) TO (%L)',
+            'price_history_' || to_char(month_start, '"y"YYYY"m"MM'),
+            month_start AT TIME ZONE 'UTC',
+            (month_start + INTERVAL '1 month') AT TIME ZONE 'UTC'
+        );
+    END LOOP;
+END $$;
+
+-- Step 4: Move the existing entries
+INSERT INTO price_history SELECT * FROM price_history_unpartitioned;
+DROP TABLE price_history_unpartitioned;
+
+-- Step 5: Index history per product and per batch
+CREATE INDEX idx_price_history_product ON price_history(product_id, created_at DESC);
+CREATE INDEX idx_price_history_batch ON price_history(batch_id);
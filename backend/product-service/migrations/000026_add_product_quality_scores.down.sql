@@ -0,0 +1,3 @@
+-- Migration: 000026_add_product_quality_scores (Down)
+
+DROP TABLE IF EXISTS product_quality_scores;
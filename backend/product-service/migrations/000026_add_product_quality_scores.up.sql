@@ -0,0 +1,15 @@
+-- Migration: 000026_add_product_quality_scores (Up)
+
+-- Step 1: Create product_quality_scores table holding the latest catalog
+-- quality score of each product, recomputed whenever the product changes
+CREATE TABLE product_quality_scores (
+    product_id UUID PRIMARY KEY REFERENCES products(id) ON DELETE CASCADE,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    score INT NOT NULL,
+    issues TEXT[] NOT NULL DEFAULT '{}',
+    computed_at TIMESTAMPTZ NOT NULL,
+    CONSTRAINT product_quality_scores_score_check CHECK (score BETWEEN 0 AND 100)
+);
+
+-- Step 2: Index scores for the lowest first report of a store
+CREATE INDEX idx_product_quality_scores_tenant_score ON product_quality_scores(tenant_id, score, product_id);
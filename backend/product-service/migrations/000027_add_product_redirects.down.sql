@@ -0,0 +1,3 @@
+-- Migration: 000027_add_product_redirects (Down)
+
+DROP TABLE IF EXISTS product_redirects;
@@ -0,0 +1,16 @@
+-- Migration: 000027_add_product_redirects (Up)
+
+-- Step 1: Create product_redirects table pointing the ID and slug of products
+-- merged into another product to the product they were merged into
+CREATE TABLE product_redirects (
+    from_product_id UUID PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    from_slug VARCHAR(255) NOT NULL,
+    to_product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Step 2: Index redirects for slug lookups and for repointing chains when the
+-- target is merged in turn
+CREATE UNIQUE INDEX idx_product_redirects_tenant_slug ON product_redirects(tenant_id, from_slug);
+CREATE INDEX idx_product_redirects_to_product ON product_redirects(to_product_id);
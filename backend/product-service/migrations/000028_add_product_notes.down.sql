@@ -0,0 +1,3 @@
+-- Migration: 000028_add_product_notes (Down)
+
+DROP TABLE IF EXISTS product_notes;
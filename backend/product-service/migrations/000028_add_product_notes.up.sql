@@ -0,0 +1,17 @@
+-- Migration: 000028_add_product_notes (Up)
+
+-- Step 1: Create product_notes table holding the internal notes staff attach
+-- to products; they are never part of storefront responses
+CREATE TABLE product_notes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    author_id VARCHAR(64) NOT NULL,
+    author_email VARCHAR(255) NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Step 2: Index notes for listing them per product, newest first
+CREATE INDEX idx_product_notes_product ON product_notes(tenant_id, product_id, created_at DESC);
@@ -0,0 +1,3 @@
+-- Migration: 000029_add_import_templates (Down)
+
+DROP TABLE IF EXISTS import_templates;
@@ -0,0 +1,19 @@
+-- Migration: 000029_add_import_templates (Up)
+
+-- Step 1: Create import_templates table holding the saved column mapping and
+-- defaults of the catalog files of each supplier
+CREATE TABLE import_templates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    supplier VARCHAR(100) NOT NULL,
+    column_mappings JSONB NOT NULL DEFAULT '{}',
+    default_brand_id UUID REFERENCES brands(id) ON DELETE SET NULL,
+    default_category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    price_multiplier NUMERIC(10,4) NOT NULL DEFAULT 1 CHECK (price_multiplier > 0),
+    publish BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Step 2: One template per supplier and store
+CREATE UNIQUE INDEX idx_import_templates_tenant_supplier ON import_templates(tenant_id, supplier);
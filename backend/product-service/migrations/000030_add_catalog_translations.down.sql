@@ -0,0 +1,3 @@
+-- Migration: 000030_add_catalog_translations (Down)
+
+DROP TABLE IF EXISTS catalog_translations;
@@ -0,0 +1,21 @@
+-- Migration: 000030_add_catalog_translations (Up)
+
+-- Step 1: Create catalog_translations table holding the content of products
+-- and categories in other languages than the one they were written in.
+-- Requests in a locale get the content of its translation, falling back to
+-- that of its base language and then to the original content.
+CREATE TABLE catalog_translations (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    entity_type VARCHAR(20) NOT NULL CHECK (entity_type IN ('product', 'category')),
+    entity_id UUID NOT NULL,
+    locale VARCHAR(35) NOT NULL,
+    name VARCHAR(255) NOT NULL DEFAULT '',
+    short_description TEXT NOT NULL DEFAULT '',
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, entity_type, entity_id, locale)
+);
+
+-- Step 2: Index translations for looking up a page of entities in a locale
+CREATE INDEX idx_catalog_translations_locale ON catalog_translations(tenant_id, entity_type, locale);
@@ -0,0 +1,4 @@
+-- Migration: 000031_add_category_positions (Down)
+
+DROP INDEX IF EXISTS idx_categories_tenant_parent_position;
+ALTER TABLE categories DROP COLUMN IF EXISTS position;
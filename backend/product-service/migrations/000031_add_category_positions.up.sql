@@ -0,0 +1,16 @@
+-- Migration: 000031_add_category_positions (Up)
+
+-- Step 1: Add position column ordering categories among their siblings
+ALTER TABLE categories ADD COLUMN position INT NOT NULL DEFAULT 0;
+
+-- Step 2: Number the existing siblings in creation order
+UPDATE categories c
+SET position = ordered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY tenant_id, parent_id ORDER BY created_at, id) - 1 AS position
+    FROM categories
+) ordered
+WHERE c.id = ordered.id;
+
+-- Step 3: Index the children of a category in their order
+CREATE INDEX idx_categories_tenant_parent_position ON categories(tenant_id, parent_id, position);
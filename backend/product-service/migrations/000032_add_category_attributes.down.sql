@@ -0,0 +1,4 @@
+-- Migration: 000032_add_category_attributes (Down)
+
+DROP INDEX IF EXISTS idx_product_attributes_lower_name_value;
+DROP TABLE IF EXISTS category_attributes;
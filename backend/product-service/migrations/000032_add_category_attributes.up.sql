@@ -0,0 +1,23 @@
+-- Migration: 000032_add_category_attributes (Up)
+
+-- Step 1: Create category_attributes table holding the attributes the
+-- products of a category and of its descendants are described by
+CREATE TABLE category_attributes (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    category_id UUID NOT NULL REFERENCES categories(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    filterable BOOLEAN NOT NULL DEFAULT FALSE,
+    allowed_values TEXT[] NOT NULL DEFAULT '{}',
+    default_value VARCHAR(255) NOT NULL DEFAULT '',
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Step 2: Names are unique within a category, ignoring case
+CREATE UNIQUE INDEX idx_category_attributes_name ON category_attributes(category_id, LOWER(name));
+
+-- Step 3: Index product attribute values for counting the facets of a category
+CREATE INDEX idx_product_attributes_lower_name_value ON product_attributes(LOWER(name), value);
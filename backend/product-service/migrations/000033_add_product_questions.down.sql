@@ -0,0 +1,5 @@
+-- Migration: 000033_add_product_questions (Down)
+
+DROP TABLE IF EXISTS product_answer_votes;
+DROP TABLE IF EXISTS product_answers;
+DROP TABLE IF EXISTS product_questions;
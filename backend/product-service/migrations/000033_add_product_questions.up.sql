@@ -0,0 +1,45 @@
+-- Migration: 000033_add_product_questions (Up)
+
+-- Step 1: Create product_questions table holding the questions customers
+-- ask on product pages, shown once approved
+CREATE TABLE product_questions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    author_id VARCHAR(64) NOT NULL,
+    author_name VARCHAR(100) NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_product_questions_product ON product_questions(tenant_id, product_id, status, created_at DESC);
+CREATE INDEX idx_product_questions_status ON product_questions(tenant_id, status, created_at);
+
+-- Step 2: Create product_answers table holding the answers of admins and
+-- sellers, with the number of upvotes they received
+CREATE TABLE product_answers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    question_id UUID NOT NULL REFERENCES product_questions(id) ON DELETE CASCADE,
+    author_id VARCHAR(64) NOT NULL,
+    author_name VARCHAR(100) NOT NULL DEFAULT '',
+    author_role VARCHAR(20) NOT NULL CHECK (author_role IN ('admin', 'seller')),
+    body TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'approved', 'rejected')),
+    upvotes INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_product_answers_question ON product_answers(question_id, status, upvotes DESC);
+
+-- Step 3: Create product_answer_votes table so that each user upvotes an
+-- answer at most once
+CREATE TABLE product_answer_votes (
+    answer_id UUID NOT NULL REFERENCES product_answers(id) ON DELETE CASCADE,
+    voter_id VARCHAR(64) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (answer_id, voter_id)
+);
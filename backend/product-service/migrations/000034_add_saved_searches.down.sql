@@ -0,0 +1,5 @@
+-- Migration: 000034_add_saved_searches (Down)
+
+DROP TABLE IF EXISTS saved_search_alerts;
+DROP TABLE IF EXISTS saved_search_matches;
+DROP TABLE IF EXISTS saved_searches;
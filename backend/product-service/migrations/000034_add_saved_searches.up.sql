@@ -0,0 +1,51 @@
+-- Migration: 000034_add_saved_searches (Up)
+
+-- Step 1: Create saved_searches table holding the search queries and filters
+-- users save, with the alerts they opted into
+CREATE TABLE saved_searches (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    user_id VARCHAR(64) NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    query VARCHAR(200) NOT NULL DEFAULT '',
+    category_id UUID REFERENCES categories(id) ON DELETE CASCADE,
+    brand_id UUID REFERENCES brands(id) ON DELETE CASCADE,
+    min_price DECIMAL(10, 2),
+    max_price DECIMAL(10, 2),
+    alert_new_products BOOLEAN NOT NULL DEFAULT FALSE,
+    price_alert_below DECIMAL(10, 2),
+    last_evaluated_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_saved_searches_user ON saved_searches(tenant_id, user_id, created_at);
+CREATE INDEX idx_saved_searches_alerts ON saved_searches(last_evaluated_at NULLS FIRST)
+    WHERE alert_new_products OR price_alert_below IS NOT NULL;
+
+-- Step 2: Create saved_search_matches table holding the products a saved
+-- search matched when last evaluated, with their price then, which the next
+-- evaluation diffs against
+CREATE TABLE saved_search_matches (
+    saved_search_id UUID NOT NULL REFERENCES saved_searches(id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    price DECIMAL(10, 2) NOT NULL,
+    PRIMARY KEY (saved_search_id, product_id)
+);
+
+-- Step 3: Create saved_search_alerts table as an outbox of alerts for the
+-- notification service
+CREATE TABLE saved_search_alerts (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    saved_search_id UUID NOT NULL REFERENCES saved_searches(id) ON DELETE CASCADE,
+    alert_type VARCHAR(20) NOT NULL CHECK (alert_type IN ('new_product', 'price_drop')),
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    product_title VARCHAR(255) NOT NULL,
+    price DECIMAL(10, 2) NOT NULL,
+    previous_price DECIMAL(10, 2),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_saved_search_alerts_pending ON saved_search_alerts(created_at) WHERE delivered_at IS NULL;
@@ -0,0 +1,3 @@
+-- Migration: 000035_add_catalog_activity (Down)
+
+DROP TABLE IF EXISTS catalog_activity;
@@ -0,0 +1,16 @@
+-- Migration: 000035_add_catalog_activity (Up)
+
+-- Create catalog_activity table recording the product changes of each store
+-- for the admin activity feed. Entries keep the title of the product at the
+-- time of the change, so they still read after the product is purged.
+CREATE TABLE catalog_activity (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    action VARCHAR(20) NOT NULL CHECK (action IN ('created', 'updated', 'deleted')),
+    product_id UUID NOT NULL,
+    product_title VARCHAR(255) NOT NULL DEFAULT '',
+    actor_id VARCHAR(64) NOT NULL DEFAULT '',
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_catalog_activity_tenant ON catalog_activity(tenant_id, id DESC);
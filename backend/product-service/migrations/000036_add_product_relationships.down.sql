@@ -0,0 +1,3 @@
+-- Migration: 000036_add_product_relationships (Down)
+
+DROP TABLE IF EXISTS product_relationships;
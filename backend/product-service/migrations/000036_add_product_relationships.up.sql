@@ -0,0 +1,20 @@
+-- Migration: 000036_add_product_relationships (Up)
+
+-- Create product_relationships table holding the typed links from a product
+-- to the products shown with it: cross-sells, up-sells, accessories and
+-- replacements, ordered by position within a type
+CREATE TABLE product_relationships (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    related_product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL CHECK (type IN ('cross_sell', 'up_sell', 'accessory', 'replacement')),
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT product_relationship_unique UNIQUE (product_id, related_product_id, type),
+    CONSTRAINT product_relationship_not_self CHECK (product_id <> related_product_id)
+);
+
+CREATE INDEX idx_product_relationships_product ON product_relationships(tenant_id, product_id, type, position);
+CREATE INDEX idx_product_relationships_related ON product_relationships(related_product_id);
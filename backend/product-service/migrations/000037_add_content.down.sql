@@ -0,0 +1,9 @@
+-- Migration: 000037_add_content (Down)
+
+DELETE FROM catalog_translations WHERE entity_type IN ('page', 'banner');
+ALTER TABLE catalog_translations DROP CONSTRAINT catalog_translations_entity_type_check;
+ALTER TABLE catalog_translations ADD CONSTRAINT catalog_translations_entity_type_check
+    CHECK (entity_type IN ('product', 'category'));
+
+DROP TABLE IF EXISTS content_banners;
+DROP TABLE IF EXISTS content_pages;
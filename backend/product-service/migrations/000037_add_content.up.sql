@@ -0,0 +1,50 @@
+-- Migration: 000037_add_content (Up)
+
+-- Step 1: Create content_pages table holding the static storefront pages,
+-- such as About, FAQ and the store policies. Published pages are live from
+-- publish_at, when set, until unpublish_at, when set.
+CREATE TABLE content_pages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    slug VARCHAR(255) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    summary TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published')),
+    publish_at TIMESTAMPTZ,
+    unpublish_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT content_page_window CHECK (unpublish_at IS NULL OR publish_at IS NULL OR unpublish_at > publish_at)
+);
+
+CREATE UNIQUE INDEX idx_content_pages_slug ON content_pages(tenant_id, slug) WHERE deleted_at IS NULL;
+
+-- Step 2: Create content_banners table holding the banners of the named
+-- storefront slots, such as the homepage hero, ordered by position within a
+-- slot and scheduled as pages are
+CREATE TABLE content_banners (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    slot VARCHAR(50) NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    subtitle TEXT NOT NULL DEFAULT '',
+    image_url TEXT NOT NULL,
+    link_url TEXT NOT NULL DEFAULT '',
+    position INT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published')),
+    publish_at TIMESTAMPTZ,
+    unpublish_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT content_banner_window CHECK (unpublish_at IS NULL OR publish_at IS NULL OR unpublish_at > publish_at)
+);
+
+CREATE INDEX idx_content_banners_slot ON content_banners(tenant_id, slot, position) WHERE deleted_at IS NULL;
+
+-- Step 3: Let pages and banners be translated like products and categories
+ALTER TABLE catalog_translations DROP CONSTRAINT catalog_translations_entity_type_check;
+ALTER TABLE catalog_translations ADD CONSTRAINT catalog_translations_entity_type_check
+    CHECK (entity_type IN ('product', 'category', 'page', 'banner'));
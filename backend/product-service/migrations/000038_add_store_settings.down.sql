@@ -0,0 +1,3 @@
+-- Migration: 000038_add_store_settings (Down)
+
+DROP TABLE IF EXISTS store_settings;
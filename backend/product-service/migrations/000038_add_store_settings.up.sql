@@ -0,0 +1,14 @@
+-- Migration: 000038_add_store_settings (Up)
+
+-- Step 1: Create store_settings table holding the typed site-wide settings
+-- of each store, such as its branding and the currencies and locales it
+-- offers. Values are validated by the service against the key registry; a
+-- key without a row is unset.
+CREATE TABLE store_settings (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    key VARCHAR(100) NOT NULL,
+    value JSONB NOT NULL,
+    updated_by VARCHAR(255) NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, key)
+);
@@ -0,0 +1,4 @@
+-- Migration: 000039_add_search_ranking_rules (Down)
+
+DROP INDEX IF EXISTS idx_products_search;
+DROP TABLE IF EXISTS search_ranking_rules;
@@ -0,0 +1,31 @@
+-- Migration: 000039_add_search_ranking_rules (Up)
+
+-- Step 1: Create search_ranking_rules table holding the merchandising rules
+-- applied on top of the relevance of search results. Boosts multiply the
+-- score of the products of a brand, category or product by their weight;
+-- pins place a product at a position. Rules with a query only apply to the
+-- searches for it. Drafts are only applied by previews until published.
+CREATE TABLE search_ranking_rules (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    name VARCHAR(100) NOT NULL,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('boost', 'pin')),
+    query VARCHAR(200) NOT NULL DEFAULT '',
+    brand_id UUID REFERENCES brands(id) ON DELETE CASCADE,
+    category_id UUID REFERENCES categories(id) ON DELETE CASCADE,
+    product_id UUID REFERENCES products(id) ON DELETE CASCADE,
+    weight DECIMAL(6,3) NOT NULL DEFAULT 1 CHECK (weight > 0),
+    position INTEGER NOT NULL DEFAULT 0 CHECK (position >= 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'published')),
+    published_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (kind <> 'pin' OR (product_id IS NOT NULL AND query <> '' AND position > 0))
+);
+
+CREATE INDEX idx_search_ranking_rules_tenant ON search_ranking_rules(tenant_id, status);
+
+-- Step 2: Index the published products for the substring matching of
+-- searches
+CREATE INDEX IF NOT EXISTS idx_products_search ON products(tenant_id, created_at DESC)
+    WHERE deleted_at IS NULL AND is_published;
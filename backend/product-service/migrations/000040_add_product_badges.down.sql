@@ -0,0 +1,5 @@
+-- Migration: 000040_add_product_badges (Down)
+
+DROP TABLE IF EXISTS product_badges;
+DROP TABLE IF EXISTS product_sales;
+DROP TABLE IF EXISTS badge_rules;
@@ -0,0 +1,48 @@
+-- Migration: 000040_add_product_badges (Up)
+
+-- Step 1: Create badge_rules table holding the badges of each store. Rows
+-- with the code of a built-in badge (new, sale, bestseller) override it;
+-- other codes are custom badges. Manual badges list their products.
+CREATE TABLE badge_rules (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    code VARCHAR(50) NOT NULL,
+    label VARCHAR(50) NOT NULL,
+    color VARCHAR(20) NOT NULL DEFAULT '',
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('new', 'sale', 'bestseller', 'manual')),
+    days INTEGER NOT NULL DEFAULT 0 CHECK (days >= 0),
+    percent DECIMAL(5,2) NOT NULL DEFAULT 0 CHECK (percent BETWEEN 0 AND 100),
+    product_ids UUID[] NOT NULL DEFAULT '{}',
+    priority INTEGER NOT NULL DEFAULT 0,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, code)
+);
+
+-- Step 2: Create product_sales table holding the units sold per order line,
+-- reported by the order side, for bestseller badges. An order reports each
+-- product once.
+CREATE TABLE product_sales (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    order_reference VARCHAR(100) NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    sold_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, order_reference, product_id)
+);
+
+CREATE INDEX idx_product_sales_tenant_sold_at ON product_sales(tenant_id, sold_at, product_id);
+
+-- Step 3: Create product_badges table holding the precomputed badges of
+-- each product, in display order, served with product lists and details
+CREATE TABLE product_badges (
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    code VARCHAR(50) NOT NULL,
+    position INTEGER NOT NULL,
+    computed_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (product_id, code)
+);
+
+CREATE INDEX idx_product_badges_tenant ON product_badges(tenant_id);
@@ -0,0 +1,3 @@
+-- Migration: 000041_add_product_default_variants (Down)
+
+DROP TABLE IF EXISTS product_default_variants;
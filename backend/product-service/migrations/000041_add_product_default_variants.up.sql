@@ -0,0 +1,23 @@
+-- Migration: 000041_add_product_default_variants (Up)
+
+-- Step 1: Create product_default_variants table, the new layout of the price
+-- and SKU of products once they are normalized onto variants. While the
+-- migration is rolled out, the product repository writes it alongside the
+-- products table, which reads still use, and a verification job diffs the
+-- two layouts.
+CREATE TABLE product_default_variants (
+    product_id UUID PRIMARY KEY REFERENCES products(id) ON DELETE CASCADE,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    sku VARCHAR(100) NOT NULL,
+    price DECIMAL(10,2) NOT NULL,
+    discount_price DECIMAL(10,2),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_product_default_variants_tenant ON product_default_variants(tenant_id);
+
+-- Step 2: Backfill the new layout from the products table
+INSERT INTO product_default_variants (product_id, tenant_id, sku, price, discount_price, updated_at)
+SELECT id, COALESCE(tenant_id, 'default'), sku, price, discount_price, NOW()
+FROM products
+WHERE deleted_at IS NULL;
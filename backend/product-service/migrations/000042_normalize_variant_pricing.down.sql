@@ -0,0 +1,30 @@
+-- Migration: 000042_normalize_variant_pricing (Down)
+
+CREATE TABLE IF NOT EXISTS product_default_variants (
+    product_id UUID PRIMARY KEY REFERENCES products(id) ON DELETE CASCADE,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    sku VARCHAR(100) NOT NULL,
+    price DECIMAL(10,2) NOT NULL,
+    discount_price DECIMAL(10,2),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_default_variants_tenant ON product_default_variants(tenant_id);
+
+INSERT INTO product_default_variants (product_id, tenant_id, sku, price, discount_price, updated_at)
+SELECT id, COALESCE(tenant_id, 'default'), sku, price, discount_price, NOW()
+FROM products
+WHERE deleted_at IS NULL
+ON CONFLICT (product_id) DO NOTHING;
+
+DROP VIEW IF EXISTS product_pricing;
+
+DROP TRIGGER IF EXISTS trg_products_derive_pricing ON products;
+DROP TRIGGER IF EXISTS trg_product_variants_sync_pricing ON product_variants;
+DROP FUNCTION IF EXISTS derive_product_pricing();
+DROP FUNCTION IF EXISTS sync_product_pricing_from_variant();
+DROP FUNCTION IF EXISTS ensure_default_variant(UUID);
+
+COMMENT ON COLUMN products.sku IS NULL;
+COMMENT ON COLUMN products.price IS NULL;
+COMMENT ON COLUMN products.discount_price IS NULL;
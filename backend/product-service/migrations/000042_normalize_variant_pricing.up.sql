@@ -0,0 +1,117 @@
+-- Migration: 000042_normalize_variant_pricing (Up)
+
+-- Step 1: Products without variations are priced at zero until set up, so
+-- their default variant must accept it
+ALTER TABLE product_variants DROP CONSTRAINT IF EXISTS product_variants_price_check;
+ALTER TABLE product_variants ADD CONSTRAINT product_variants_price_check CHECK (price >= 0);
+
+-- Step 2: Create ensure_default_variant, which gives a product a live
+-- default variant, preferring the variant carrying the product SKU and
+-- creating it from the product columns when there is none, then copies the
+-- price and SKU of the default variant to the product. It is used by the
+-- backfill below and by the pricing backfill job of the product service.
+CREATE OR REPLACE FUNCTION ensure_default_variant(p_product_id UUID) RETURNS UUID AS $$
+DECLARE
+    v_product products%ROWTYPE;
+    v_variant_id UUID;
+BEGIN
+    SELECT * INTO v_product FROM products WHERE id = p_product_id FOR UPDATE;
+    IF NOT FOUND THEN
+        RETURN NULL;
+    END IF;
+
+    SELECT id INTO v_variant_id
+    FROM product_variants
+    WHERE id = v_product.default_variant_id AND product_id = p_product_id AND deleted_at IS NULL;
+
+    IF v_variant_id IS NULL THEN
+        SELECT id INTO v_variant_id
+        FROM product_variants
+        WHERE product_id = p_product_id AND sku = v_product.sku AND deleted_at IS NULL;
+    END IF;
+
+    IF v_variant_id IS NULL AND NOT EXISTS (SELECT 1 FROM product_variants WHERE sku = v_product.sku) THEN
+        INSERT INTO product_variants (product_id, sku, title, price, discount_price, created_at, updated_at)
+        VALUES (p_product_id, v_product.sku, v_product.title, v_product.price,
+            CASE WHEN v_product.discount_price < v_product.price THEN v_product.discount_price END,
+            NOW(), NOW())
+        RETURNING id INTO v_variant_id;
+    END IF;
+
+    IF v_variant_id IS NULL THEN
+        SELECT id INTO v_variant_id
+        FROM product_variants
+        WHERE product_id = p_product_id AND deleted_at IS NULL
+        ORDER BY created_at, id
+        LIMIT 1;
+    END IF;
+
+    IF v_variant_id IS NOT NULL THEN
+        UPDATE products p
+        SET default_variant_id = v_variant_id, sku = v.sku, price = v.price, discount_price = v.discount_price
+        FROM product_variants v
+        WHERE p.id = p_product_id AND v.id = v_variant_id;
+    END IF;
+    RETURN v_variant_id;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Step 3: Backfill the default variant of every live product, pricing them
+-- from the products table, which the product writes used so far
+UPDATE product_variants v
+SET price = p.price, discount_price = p.discount_price, updated_at = NOW()
+FROM products p
+WHERE p.default_variant_id = v.id AND p.deleted_at IS NULL AND v.deleted_at IS NULL
+  AND (p.discount_price IS NULL OR p.discount_price < p.price)
+  AND (v.price, v.discount_price) IS DISTINCT FROM (p.price, p.discount_price);
+
+SELECT ensure_default_variant(id) FROM products WHERE deleted_at IS NULL;
+
+-- Step 4: The price and SKU of products become derived from their default
+-- variant, kept for the readers of the products table. Writes to the
+-- product columns of a product with a default variant are overridden.
+CREATE OR REPLACE FUNCTION sync_product_pricing_from_variant() RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE products
+    SET sku = NEW.sku, price = NEW.price, discount_price = NEW.discount_price, updated_at = NOW()
+    WHERE default_variant_id = NEW.id
+      AND (sku, price, discount_price) IS DISTINCT FROM (NEW.sku, NEW.price, NEW.discount_price);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_product_variants_sync_pricing
+    AFTER INSERT OR UPDATE OF sku, price, discount_price ON product_variants
+    FOR EACH ROW EXECUTE FUNCTION sync_product_pricing_from_variant();
+
+CREATE OR REPLACE FUNCTION derive_product_pricing() RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.default_variant_id IS NOT NULL THEN
+        SELECT v.sku, v.price, v.discount_price INTO NEW.sku, NEW.price, NEW.discount_price
+        FROM product_variants v
+        WHERE v.id = NEW.default_variant_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_products_derive_pricing
+    BEFORE INSERT OR UPDATE OF default_variant_id, sku, price, discount_price ON products
+    FOR EACH ROW EXECUTE FUNCTION derive_product_pricing();
+
+COMMENT ON COLUMN products.sku IS 'Derived from the default variant; write product_variants instead.';
+COMMENT ON COLUMN products.price IS 'Derived from the default variant; write product_variants instead.';
+COMMENT ON COLUMN products.discount_price IS 'Derived from the default variant; write product_variants instead.';
+
+-- Step 5: Create product_pricing view, the price and SKU of each product
+-- with its default variant, for reports and older clients
+CREATE VIEW product_pricing AS
+SELECT p.id AS product_id, p.tenant_id, v.id AS variant_id, v.sku, v.price, v.discount_price,
+    COALESCE(v.discount_price, v.price) AS effective_price
+FROM products p
+JOIN product_variants v ON v.id = p.default_variant_id
+WHERE p.deleted_at IS NULL AND v.deleted_at IS NULL;
+
+-- Step 6: Drop the product_default_variants shadow layout, superseded by the
+-- default variants
+DROP TABLE IF EXISTS product_default_variants;
@@ -0,0 +1,7 @@
+-- Migration: 000043_add_product_metadata (Down)
+
+DROP INDEX IF EXISTS idx_product_variants_metadata_erp_id;
+DROP INDEX IF EXISTS idx_products_metadata_erp_id;
+
+ALTER TABLE product_variants DROP COLUMN IF EXISTS metadata;
+ALTER TABLE products DROP COLUMN IF EXISTS metadata;
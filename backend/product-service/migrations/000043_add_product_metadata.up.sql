@@ -0,0 +1,19 @@
+-- Migration: 000043_add_product_metadata (Up)
+
+-- Step 1: Add the custom metadata of integrators to products and variants, a
+-- JSON object of at most 8 KiB
+ALTER TABLE products
+    ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ADD CONSTRAINT products_metadata_check
+        CHECK (jsonb_typeof(metadata) = 'object' AND octet_length(metadata::text) <= 8192);
+
+ALTER TABLE product_variants
+    ADD COLUMN metadata JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ADD CONSTRAINT product_variants_metadata_check
+        CHECK (jsonb_typeof(metadata) = 'object' AND octet_length(metadata::text) <= 8192);
+
+-- Step 2: Index the ERP IDs integrators look records up by
+CREATE INDEX idx_products_metadata_erp_id ON products (tenant_id, (metadata->>'erp_id'))
+    WHERE metadata ? 'erp_id';
+CREATE INDEX idx_product_variants_metadata_erp_id ON product_variants ((metadata->>'erp_id'))
+    WHERE metadata ? 'erp_id';
@@ -0,0 +1,3 @@
+-- Migration: 000044_add_personalization_options (Down)
+
+DROP TABLE IF EXISTS product_personalization_options;
@@ -0,0 +1,25 @@
+-- Migration: 000044_add_personalization_options (Up)
+
+-- Step 1: Create product_personalization_options table holding the inputs
+-- buyers give to personalize a product, such as an engraving text or
+-- uploaded artwork. Buyer values reference their option by ID, so options
+-- keep their ID when a product's options are replaced.
+CREATE TABLE product_personalization_options (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    name VARCHAR(50) NOT NULL,
+    option_type VARCHAR(10) NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    max_length INT NOT NULL DEFAULT 0,
+    file_types TEXT[] NOT NULL DEFAULT '{}',
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT product_personalization_options_type_check CHECK (option_type IN ('text', 'file')),
+    CONSTRAINT product_personalization_options_max_length_check CHECK (max_length >= 0)
+);
+
+-- Step 2: Index options for loading them per product, in display order
+CREATE INDEX idx_product_personalization_options_product
+    ON product_personalization_options(tenant_id, product_id, position);
@@ -0,0 +1,3 @@
+-- Migration: 000045_add_media_uploads (Down)
+
+DROP TABLE IF EXISTS media_uploads;
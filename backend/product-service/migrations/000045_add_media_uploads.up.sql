@@ -0,0 +1,21 @@
+-- Migration: 000045_add_media_uploads (Up)
+
+-- Step 1: Create media_uploads table tracking the tickets handed to clients
+-- for uploading product media straight to the media provider. An upload is
+-- confirmed once the uploaded asset passed validation and was attached to
+-- the product as image_id.
+CREATE TABLE media_uploads (
+    id UUID PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    public_id VARCHAR(255) NOT NULL UNIQUE,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    image_id UUID REFERENCES product_images(id) ON DELETE SET NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    confirmed_at TIMESTAMPTZ,
+    CONSTRAINT media_uploads_status_check CHECK (status IN ('pending', 'confirmed'))
+);
+
+-- Step 2: Index uploads per product
+CREATE INDEX idx_media_uploads_product ON media_uploads(tenant_id, product_id);
@@ -0,0 +1,8 @@
+-- Migration: 000046_add_product_media (Down)
+
+ALTER TABLE media_uploads
+    DROP CONSTRAINT IF EXISTS media_uploads_media_type_check,
+    DROP COLUMN IF EXISTS media_id,
+    DROP COLUMN IF EXISTS media_type;
+
+DROP TABLE IF EXISTS product_media;
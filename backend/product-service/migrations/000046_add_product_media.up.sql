@@ -0,0 +1,36 @@
+-- Migration: 000046_add_product_media (Up)
+
+-- Step 1: Create product_media table holding the videos and 3D models of
+-- products. Images stay in product_images. Media are processing until their
+-- thumbnail is extracted; failed processing is retried up to a few attempts.
+CREATE TABLE product_media (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    product_id UUID NOT NULL REFERENCES products(id) ON DELETE CASCADE,
+    media_type VARCHAR(20) NOT NULL,
+    provider VARCHAR(20) NOT NULL,
+    url TEXT NOT NULL,
+    external_id VARCHAR(255),
+    format VARCHAR(10),
+    thumbnail_url TEXT,
+    alt_text VARCHAR(255),
+    position INT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'processing',
+    attempts INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT product_media_type_check CHECK (media_type IN ('video', 'model_3d')),
+    CONSTRAINT product_media_provider_check CHECK (provider IN ('cloudinary', 'youtube', 'vimeo', 'direct')),
+    CONSTRAINT product_media_status_check CHECK (status IN ('processing', 'ready', 'failed'))
+);
+
+-- Step 2: Index media per product, and the media waiting for processing
+CREATE INDEX idx_product_media_product ON product_media(tenant_id, product_id, position);
+CREATE INDEX idx_product_media_processing ON product_media(tenant_id, created_at) WHERE status = 'processing';
+
+-- Step 3: Let direct uploads carry videos and 3D models, attached to the
+-- product as media_id instead of image_id
+ALTER TABLE media_uploads
+    ADD COLUMN media_type VARCHAR(20) NOT NULL DEFAULT 'image',
+    ADD COLUMN media_id UUID REFERENCES product_media(id) ON DELETE SET NULL,
+    ADD CONSTRAINT media_uploads_media_type_check CHECK (media_type IN ('image', 'video', 'model_3d'));
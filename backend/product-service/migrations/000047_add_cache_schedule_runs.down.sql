@@ -0,0 +1,3 @@
+-- Migration: 000047_add_cache_schedule_runs (Down)
+
+DROP TABLE IF EXISTS cache_schedule_runs;
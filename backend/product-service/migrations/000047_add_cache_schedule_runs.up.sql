@@ -0,0 +1,27 @@
+-- Migration: 000047_add_cache_schedule_runs (Up)
+
+-- Step 1: Create cache_schedule_runs table recording the runs of the
+-- scheduled cache invalidations and re-warms. Caches are shared by every
+-- store, so runs are not scoped to a tenant. Scheduled runs are claimed by
+-- their schedule and time, so that a single instance of the service runs
+-- them; manual runs have no scheduled time.
+CREATE TABLE cache_schedule_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    schedule_name VARCHAR(100) NOT NULL,
+    namespace VARCHAR(50) NOT NULL,
+    triggered_by VARCHAR(20) NOT NULL,
+    scheduled_for TIMESTAMPTZ,
+    status VARCHAR(20) NOT NULL DEFAULT 'running',
+    invalidated BOOLEAN NOT NULL DEFAULT FALSE,
+    warmed INT NOT NULL DEFAULT 0,
+    failed INT NOT NULL DEFAULT 0,
+    error TEXT,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    finished_at TIMESTAMPTZ,
+    CONSTRAINT cache_schedule_runs_triggered_by_check CHECK (triggered_by IN ('scheduled', 'manual')),
+    CONSTRAINT cache_schedule_runs_status_check CHECK (status IN ('running', 'succeeded', 'partial', 'failed')),
+    CONSTRAINT cache_schedule_runs_slot_unique UNIQUE (schedule_name, scheduled_for)
+);
+
+-- Step 2: Index the history of each schedule, latest first
+CREATE INDEX idx_cache_schedule_runs_schedule ON cache_schedule_runs(schedule_name, started_at DESC);
@@ -0,0 +1,4 @@
+-- Migration: 000048_derive_imported_product_pricing (Down)
+
+ALTER TABLE products ALTER COLUMN price SET NOT NULL;
+ALTER TABLE products ALTER COLUMN sku SET NOT NULL;
@@ -0,0 +1,8 @@
+-- Migration: 000048_derive_imported_product_pricing (Up)
+
+-- Step 1: The price and SKU of products are derived from their default
+-- variant by trg_products_derive_pricing. Bulk imports insert products before
+-- their default variants, within the same transaction, so the derived columns
+-- are unset until the default variant is assigned.
+ALTER TABLE products ALTER COLUMN sku DROP NOT NULL;
+ALTER TABLE products ALTER COLUMN price DROP NOT NULL;
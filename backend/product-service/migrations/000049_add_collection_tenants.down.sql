@@ -0,0 +1,10 @@
+-- Migration: 000049_add_collection_tenants (Down)
+
+-- Step 1: Restore globally unique slugs
+DROP INDEX IF EXISTS collections_tenant_slug_key;
+
+ALTER TABLE collections ADD CONSTRAINT collections_slug_key UNIQUE (slug);
+CREATE INDEX idx_collections_slug ON collections(slug);
+
+-- Step 2: Drop the store of collections
+ALTER TABLE collections DROP COLUMN IF EXISTS tenant_id;
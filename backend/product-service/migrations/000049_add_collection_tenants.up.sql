@@ -0,0 +1,10 @@
+-- Migration: 000049_add_collection_tenants (Up)
+
+-- Step 1: Assign collections to a store, existing ones to the default store
+ALTER TABLE collections ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+
+-- Step 2: Make slugs unique per store instead of globally
+ALTER TABLE collections DROP CONSTRAINT IF EXISTS collections_slug_key;
+DROP INDEX IF EXISTS idx_collections_slug;
+
+CREATE UNIQUE INDEX collections_tenant_slug_key ON collections(tenant_id, slug);
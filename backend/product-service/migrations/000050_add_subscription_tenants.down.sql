@@ -0,0 +1,10 @@
+-- Migration: 000050_add_subscription_tenants (Down)
+
+-- Step 1: Drop the store index of subscriptions
+DROP INDEX IF EXISTS idx_subscriptions_tenant_created_at;
+
+-- Step 2: Drop the store of subscription plans, subscriptions, digital assets and download grants
+ALTER TABLE digital_download_grants DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE digital_assets DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE subscriptions DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE product_subscription_plans DROP COLUMN IF EXISTS tenant_id;
@@ -0,0 +1,16 @@
+-- Migration: 000050_add_subscription_tenants (Up)
+
+-- Step 1: Assign subscription plans, subscriptions, digital assets and download
+-- grants to a store, existing rows to the store of their product
+ALTER TABLE product_subscription_plans ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE subscriptions ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE digital_assets ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+ALTER TABLE digital_download_grants ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+
+UPDATE product_subscription_plans t SET tenant_id = p.tenant_id FROM products p WHERE p.id = t.product_id;
+UPDATE subscriptions t SET tenant_id = p.tenant_id FROM products p WHERE p.id = t.product_id;
+UPDATE digital_assets t SET tenant_id = p.tenant_id FROM products p WHERE p.id = t.product_id;
+UPDATE digital_download_grants t SET tenant_id = p.tenant_id FROM products p WHERE p.id = t.product_id;
+
+-- Step 2: Index subscriptions by store for listing
+CREATE INDEX idx_subscriptions_tenant_created_at ON subscriptions(tenant_id, created_at DESC);
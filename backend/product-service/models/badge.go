@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var ErrBadgeRuleNotFound = apperrors.New(apperrors.ErrNotFound, "badge rule not found")
+
+// BadgeRule is a badge of a store: an override of a built-in badge when its
+// code is one, else a custom badge
+type BadgeRule struct {
+	Code       string    `json:"code" db:"code"`
+	Label      string    `json:"label" db:"label"`
+	Color      string    `json:"color" db:"color"`
+	Kind       string    `json:"kind" db:"kind"`
+	Days       int       `json:"days" db:"days"`       // Of new badges
+	Percent    float64   `json:"percent" db:"percent"` // Of bestseller badges
+	ProductIDs []string  `json:"product_ids" db:"product_ids"`
+	Priority   int       `json:"priority" db:"priority"`
+	Active     bool      `json:"active" db:"active"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// ProductSale is the units of a product sold by an order
+type ProductSale struct {
+	ProductID string `json:"product_id" db:"product_id"`
+	Quantity  int    `json:"quantity" db:"quantity"`
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	ErrBundleNotFound          = apperrors.New(apperrors.ErrNotFound, "bundle not found")
+	ErrBundleComponentNotFound = apperrors.New(apperrors.ErrNotFound, "bundle component SKU not found")
+	ErrBundleComponentIsBundle = apperrors.New(apperrors.ErrInvalidArgument, "bundle component cannot be another bundle")
+)
+
+// BundleComponent is one SKU of a bundle together with how many units a single
+// bundle contains. The product, variant, title and price are resolved from the
+// component variant when the bundle is loaded.
+type BundleComponent struct {
+	SKU       string  `json:"sku" db:"component_sku"`
+	Quantity  int     `json:"quantity" db:"quantity"`
+	Position  int     `json:"position" db:"position"`
+	ProductID string  `json:"product_id" db:"-"`
+	VariantID string  `json:"variant_id" db:"-"`
+	Title     string  `json:"title" db:"-"`
+	UnitPrice float64 `json:"unit_price" db:"-"`
+}
+
+// ProductBundle describes a product sold as a kit of other SKUs. Without a
+// price override the bundle costs the sum of its components.
+type ProductBundle struct {
+	ProductID     string            `json:"product_id" db:"product_id"`
+	PriceOverride *float64          `json:"price_override,omitempty" db:"price_override"`
+	Components    []BundleComponent `json:"components" db:"-"`
+	CreatedAt     time.Time         `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at" db:"updated_at"`
+}
+
+// ComponentsPrice returns the price of all components at their current unit prices
+func (b *ProductBundle) ComponentsPrice() float64 {
+	var total float64
+	for _, component := range b.Components {
+		total += component.UnitPrice * float64(component.Quantity)
+	}
+	return math.Round(total*100) / 100
+}
+
+// Price returns the price the bundle is sold at
+func (b *ProductBundle) Price() float64 {
+	if b.PriceOverride != nil {
+		return *b.PriceOverride
+	}
+	return b.ComponentsPrice()
+}
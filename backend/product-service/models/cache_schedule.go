@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Triggers of cache schedule runs
+const (
+	CacheRunScheduled = "scheduled"
+	CacheRunManual    = "manual"
+)
+
+// Statuses of cache schedule runs. Partial runs invalidated their namespace
+// but failed to warm some queries.
+const (
+	CacheRunRunning   = "running"
+	CacheRunSucceeded = "succeeded"
+	CacheRunPartial   = "partial"
+	CacheRunFailed    = "failed"
+)
+
+// CacheScheduleRun is a run of a scheduled cache invalidation and re-warm.
+// Warmed and Failed count the warm queries, once per store.
+type CacheScheduleRun struct {
+	ID           string     `json:"id" db:"id"`
+	ScheduleName string     `json:"schedule_name" db:"schedule_name"`
+	Namespace    string     `json:"namespace" db:"namespace"`
+	Trigger      string     `json:"trigger" db:"triggered_by"`
+	ScheduledFor *time.Time `json:"scheduled_for,omitempty" db:"scheduled_for"`
+	Status       string     `json:"status" db:"status"`
+	Invalidated  bool       `json:"invalidated" db:"invalidated"`
+	Warmed       int        `json:"warmed" db:"warmed"`
+	Failed       int        `json:"failed" db:"failed"`
+	Error        string     `json:"error,omitempty" db:"error"`
+	StartedAt    time.Time  `json:"started_at" db:"started_at"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty" db:"finished_at"`
+}
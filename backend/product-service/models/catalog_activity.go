@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// Catalog activity actions
+const (
+	CatalogActivityCreated = "created"
+	CatalogActivityUpdated = "updated"
+	CatalogActivityDeleted = "deleted"
+)
+
+// CatalogActivity is a change to a product of a store, as shown in the
+// admin activity feed
+type CatalogActivity struct {
+	ID           int64     `json:"id" db:"id"`
+	TenantID     string    `json:"-" db:"tenant_id"`
+	Action       string    `json:"action" db:"action"`
+	ProductID    string    `json:"product_id" db:"product_id"`
+	ProductTitle string    `json:"product_title" db:"product_title"`
+	ActorID      string    `json:"actor_id,omitempty" db:"actor_id"`
+	OccurredAt   time.Time `json:"occurred_at" db:"occurred_at"`
+}
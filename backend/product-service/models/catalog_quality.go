@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var ErrQualityScoreNotFound = apperrors.New(apperrors.ErrNotFound, "product quality score not found")
+
+// ProductQualityScore is the latest catalog quality score of a product, from
+// 0 to 100, with the issues that lowered it
+type ProductQualityScore struct {
+	ProductID  string    `json:"product_id" db:"product_id"`
+	Title      string    `json:"title" db:"title"` // Read from the product
+	SKU        string    `json:"sku" db:"sku"`     // Read from the product
+	Score      int       `json:"score" db:"score"`
+	Issues     []string  `json:"issues" db:"issues"`
+	ComputedAt time.Time `json:"computed_at" db:"computed_at"`
+}
+
+// QualityScoreFilter selects the scores of a catalog quality report
+type QualityScoreFilter struct {
+	// MaxScore keeps the products scoring at most this, when set
+	MaxScore *int
+	// Issue keeps the products with this issue, when set
+	Issue  string
+	Limit  int
+	Offset int
+}
+
+// CatalogQualitySummary aggregates the quality scores of a store
+type CatalogQualitySummary struct {
+	ScoredCount   int            `json:"scored_count"`
+	UnscoredCount int            `json:"unscored_count"` // Products never scored
+	AverageScore  float64        `json:"average_score"`
+	IssueCounts   map[string]int `json:"issue_counts"`
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/product-service/attributes"
+)
+
+var (
+	ErrCategoryAttributeNotFound = apperrors.New(apperrors.ErrNotFound, "category attribute not found")
+	ErrCategoryAttributeExists   = apperrors.New(apperrors.ErrAlreadyExists, "category already has an attribute with this name")
+)
+
+// CategoryAttribute is an attribute a category defines for its products and
+// those of its descendants
+type CategoryAttribute struct {
+	ID            string    `json:"id" db:"id"`
+	CategoryID    string    `json:"category_id" db:"category_id"`
+	Name          string    `json:"name" db:"name"`
+	Required      bool      `json:"required" db:"required"`
+	Filterable    bool      `json:"filterable" db:"filterable"`
+	AllowedValues []string  `json:"allowed_values" db:"allowed_values"`
+	DefaultValue  string    `json:"default_value" db:"default_value"`
+	Position      int       `json:"position" db:"position"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+	// Depth is the distance from the category the attributes were listed
+	// for to the category defining this one; 0 for its own attributes
+	Depth int `json:"depth" db:"-"`
+}
+
+// Definition returns the attribute as applied to products
+func (a *CategoryAttribute) Definition() attributes.Definition {
+	return attributes.Definition{
+		Name:          a.Name,
+		Required:      a.Required,
+		Filterable:    a.Filterable,
+		AllowedValues: a.AllowedValues,
+		DefaultValue:  a.DefaultValue,
+		Depth:         a.Depth,
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+import (
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	ErrCategoryCycle    = apperrors.New(apperrors.ErrFailedPrecondition, "cannot move a category under itself or one of its descendants")
+	ErrCategorySiblings = apperrors.New(apperrors.ErrInvalidArgument, "category IDs must list every child of the parent exactly once")
+)
+
+// CategoryMerge is the result of merging a source category into a target
+type CategoryMerge struct {
+	TargetID      string `json:"target_id"`
+	SourceID      string `json:"source_id"`
+	ProductsMoved int    `json:"products_moved"`
+	ChildrenMoved int    `json:"children_moved"`
+	// ProductIDs are the products of the source category
+	ProductIDs []string `json:"-"`
+}
@@ -0,0 +1,31 @@
+package models
+
+import "time"
+
+// Publication channels a product can be shown in
+const (
+	ChannelWeb         = "web"
+	ChannelMobile      = "mobile"
+	ChannelMarketplace = "marketplace"
+)
+
+// Channels lists all publication channels
+var Channels = []string{ChannelWeb, ChannelMobile, ChannelMarketplace}
+
+// ProductChannel is the visibility of a product in one publication channel
+type ProductChannel struct {
+	ProductID string    `json:"product_id" db:"product_id"`
+	Channel   string    `json:"channel" db:"channel"`
+	IsVisible bool      `json:"is_visible" db:"is_visible"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// IsValidChannel reports whether channel is a supported publication channel
+func IsValidChannel(channel string) bool {
+	for _, c := range Channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
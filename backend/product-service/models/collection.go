@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	ErrCollectionNotFound   = apperrors.New(apperrors.ErrNotFound, "collection not found")
+	ErrCollectionSlugExists = apperrors.New(apperrors.ErrAlreadyExists, "collection with this slug already exists")
+)
+
+// CollectionRules holds the optional rule-based membership filters for a collection.
+// A product matches when it satisfies every non-empty filter group; values within a
+// group are OR-ed together.
+type CollectionRules struct {
+	BrandIDs    []string `json:"brand_ids,omitempty" db:"rule_brand_ids"`
+	CategoryIDs []string `json:"category_ids,omitempty" db:"rule_category_ids"`
+	Tags        []string `json:"tags,omitempty" db:"rule_tags"`
+}
+
+// IsEmpty reports whether no rule filters are configured
+func (r CollectionRules) IsEmpty() bool {
+	return len(r.BrandIDs) == 0 && len(r.CategoryIDs) == 0 && len(r.Tags) == 0
+}
+
+// Collection represents an admin-curated grouping of products used for landing pages
+type Collection struct {
+	ID          string          `json:"id" db:"id"`
+	Name        string          `json:"name" db:"name"`
+	Slug        string          `json:"slug" db:"slug"`
+	Description string          `json:"description" db:"description"`
+	ImageURL    string          `json:"image_url,omitempty" db:"image_url"`
+	IsPublished bool            `json:"is_published" db:"is_published"`
+	Rules       CollectionRules `json:"rules" db:"-"`
+	CreatedAt   time.Time       `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at" db:"updated_at"`
+	DeletedAt   *time.Time      `json:"deleted_at,omitempty" db:"deleted_at"`
+
+	// Manually curated members in display order (populated from collection_products)
+	ProductIDs []string `json:"product_ids,omitempty" db:"-"`
+}
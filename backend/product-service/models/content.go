@@ -0,0 +1,71 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	ErrContentPageNotFound   = apperrors.New(apperrors.ErrNotFound, "content page not found")
+	ErrContentPageSlugExists = apperrors.New(apperrors.ErrAlreadyExists, "content page with this slug already exists")
+	ErrContentBannerNotFound = apperrors.New(apperrors.ErrNotFound, "content banner not found")
+)
+
+// Publication states of pages and banners
+const (
+	ContentStatusDraft     = "draft"
+	ContentStatusPublished = "published"
+)
+
+// IsValidContentStatus reports whether s is a known publication state
+func IsValidContentStatus(s string) bool {
+	return s == ContentStatusDraft || s == ContentStatusPublished
+}
+
+// ContentSchedule is the publication state of a page or banner. Published
+// content is live from PublishAt, when set, until UnpublishAt, when set.
+type ContentSchedule struct {
+	Status      string     `json:"status" db:"status"`
+	PublishAt   *time.Time `json:"publish_at,omitempty" db:"publish_at"`
+	UnpublishAt *time.Time `json:"unpublish_at,omitempty" db:"unpublish_at"`
+}
+
+// IsLive reports whether the content is shown on the storefront at t
+func (s ContentSchedule) IsLive(t time.Time) bool {
+	if s.Status != ContentStatusPublished {
+		return false
+	}
+	if s.PublishAt != nil && t.Before(*s.PublishAt) {
+		return false
+	}
+	return s.UnpublishAt == nil || t.Before(*s.UnpublishAt)
+}
+
+// ContentPage is a static storefront page, such as About, FAQ or a store
+// policy, addressed by its slug. The body is rendered by the frontend as is.
+type ContentPage struct {
+	ID        string    `json:"id" db:"id"`
+	Slug      string    `json:"slug" db:"slug"`
+	Title     string    `json:"title" db:"title"`
+	Summary   string    `json:"summary" db:"summary"`
+	Body      string    `json:"body" db:"body"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ContentSchedule
+}
+
+// ContentBanner is a banner of a named storefront slot, such as the homepage
+// hero. The banners of a slot are shown by position.
+type ContentBanner struct {
+	ID        string    `json:"id" db:"id"`
+	Slot      string    `json:"slot" db:"slot"`
+	Title     string    `json:"title" db:"title"`
+	Subtitle  string    `json:"subtitle" db:"subtitle"`
+	ImageURL  string    `json:"image_url" db:"image_url"`
+	LinkURL   string    `json:"link_url" db:"link_url"`
+	Position  int       `json:"position" db:"position"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	ContentSchedule
+}
@@ -0,0 +1,56 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// Product types derived from how a product is fulfilled
+const (
+	ProductTypePhysical = "physical"
+	ProductTypeDigital  = "digital"
+	ProductTypeBundle   = "bundle"
+)
+
+var (
+	ErrDigitalAssetNotFound  = apperrors.New(apperrors.ErrNotFound, "digital asset not found")
+	ErrDownloadGrantNotFound = apperrors.New(apperrors.ErrNotFound, "download grant not found")
+	ErrDownloadLimitReached  = apperrors.New(apperrors.ErrPermissionDenied, "download limit reached")
+)
+
+// DigitalAsset is the file delivered to customers who buy a digital product.
+// The file itself lives in private storage under StorageKey.
+type DigitalAsset struct {
+	ID            string    `json:"id" db:"id"`
+	ProductID     string    `json:"product_id" db:"product_id"`
+	FileName      string    `json:"file_name" db:"file_name"`
+	ContentType   string    `json:"content_type" db:"content_type"`
+	SizeBytes     int64     `json:"size_bytes" db:"size_bytes"`
+	StorageKey    string    `json:"-" db:"storage_key"`
+	DownloadLimit int       `json:"download_limit" db:"download_limit"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// DownloadGrant tracks how often the digital asset of a product has been
+// downloaded for one purchase. The limit is copied from the asset when the
+// grant is created, so later limit changes do not affect past purchases.
+type DownloadGrant struct {
+	ID               string     `json:"id" db:"id"`
+	ProductID        string     `json:"product_id" db:"product_id"`
+	PurchaseID       string     `json:"purchase_id" db:"purchase_id"`
+	UserID           string     `json:"user_id" db:"user_id"`
+	DownloadCount    int        `json:"download_count" db:"download_count"`
+	DownloadLimit    int        `json:"download_limit" db:"download_limit"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	LastDownloadedAt *time.Time `json:"last_downloaded_at,omitempty" db:"last_downloaded_at"`
+}
+
+// Remaining returns how many downloads are left for the purchase
+func (g *DownloadGrant) Remaining() int {
+	if g.DownloadCount >= g.DownloadLimit {
+		return 0
+	}
+	return g.DownloadLimit - g.DownloadCount
+}
@@ -0,0 +1,44 @@
+package models
+
+import "time"
+
+// ERP sync run statuses
+const (
+	SyncRunRunning   = "running"
+	SyncRunSucceeded = "succeeded"
+	SyncRunFailed    = "failed"
+)
+
+// SyncCursor is the position an ERP connector has synchronized an entity to
+// in one direction
+type SyncCursor struct {
+	Connector string    `json:"connector" db:"connector"`
+	Entity    string    `json:"entity" db:"entity"`
+	Direction string    `json:"direction" db:"direction"`
+	UpdatedAt time.Time `json:"cursor_updated_at" db:"cursor_updated_at"`
+	SKU       string    `json:"cursor_sku" db:"cursor_sku"`
+	SyncedAt  time.Time `json:"synced_at" db:"synced_at"`
+}
+
+// SyncRun is the log entry of one entity sync with an ERP connector
+type SyncRun struct {
+	ID             string     `json:"id" db:"id"`
+	Connector      string     `json:"connector" db:"connector"`
+	Entity         string     `json:"entity" db:"entity"`
+	Direction      string     `json:"direction" db:"direction"`
+	Status         string     `json:"status" db:"status"`
+	RecordsRead    int        `json:"records_read" db:"records_read"`
+	RecordsApplied int        `json:"records_applied" db:"records_applied"`
+	RecordsSkipped int        `json:"records_skipped" db:"records_skipped"`
+	Conflicts      int        `json:"conflicts" db:"conflicts"`
+	Error          *string    `json:"error,omitempty" db:"error"`
+	StartedAt      time.Time  `json:"started_at" db:"started_at"`
+	FinishedAt     *time.Time `json:"finished_at,omitempty" db:"finished_at"`
+}
+
+// SyncProductState is the local state of a product a pulled ERP record is
+// matched against
+type SyncProductState struct {
+	ID        string
+	UpdatedAt time.Time
+}
@@ -0,0 +1,19 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var ErrProductFeedNotFound = apperrors.New(apperrors.ErrNotFound, "product feed not found")
+
+// ProductFeed is the latest generated marketplace feed of a store in one format
+type ProductFeed struct {
+	TenantID     string    `json:"tenant_id" db:"tenant_id"`
+	Format       string    `json:"format" db:"format"`
+	StorageKey   string    `json:"-" db:"storage_key"`
+	ProductCount int       `json:"product_count" db:"product_count"`
+	SizeBytes    int64     `json:"size_bytes" db:"size_bytes"`
+	GeneratedAt  time.Time `json:"generated_at" db:"generated_at"`
+}
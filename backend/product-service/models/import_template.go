@@ -0,0 +1,58 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var ErrImportTemplateNotFound = apperrors.New(apperrors.ErrNotFound, "import template not found")
+
+// Product fields the columns of supplier catalog files can be mapped to
+const (
+	ImportFieldTitle            = "title"
+	ImportFieldSlug             = "slug"
+	ImportFieldDescription      = "description"
+	ImportFieldShortDescription = "short_description"
+	ImportFieldSKU              = "sku"
+	ImportFieldPrice            = "price"
+	ImportFieldDiscountPrice    = "discount_price"
+	ImportFieldWeight           = "weight"
+)
+
+// IsImportField reports whether field is a product field columns can be
+// mapped to
+func IsImportField(field string) bool {
+	switch field {
+	case ImportFieldTitle, ImportFieldSlug, ImportFieldDescription, ImportFieldShortDescription,
+		ImportFieldSKU, ImportFieldPrice, ImportFieldDiscountPrice, ImportFieldWeight:
+		return true
+	}
+	return false
+}
+
+// RequiredImportFields must be mapped by every template
+var RequiredImportFields = []string{ImportFieldTitle, ImportFieldSKU, ImportFieldPrice}
+
+// ImportTemplate is the saved mapping of the catalog files of a supplier, so
+// that recurring files import without configuring the mapping again
+type ImportTemplate struct {
+	ID       string `json:"id" db:"id"`
+	Supplier string `json:"supplier" db:"supplier"`
+	// ColumnMappings maps supplier column names to product fields
+	ColumnMappings    map[string]string `json:"column_mappings" db:"column_mappings"`
+	DefaultBrandID    *string           `json:"default_brand_id,omitempty" db:"default_brand_id"`
+	DefaultCategoryID *string           `json:"default_category_id,omitempty" db:"default_category_id"`
+	// PriceMultiplier converts supplier prices to selling prices, e.g. 1.4
+	// for a 40% markup; it applies to price and discount_price
+	PriceMultiplier float64   `json:"price_multiplier" db:"price_multiplier"`
+	Publish         bool      `json:"publish" db:"publish"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// ImportRowError reports a row of a catalog file that was not imported
+type ImportRowError struct {
+	Line  int    `json:"line"`
+	Error string `json:"error"`
+}
@@ -0,0 +1,36 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// Statuses of direct media uploads
+const (
+	MediaUploadPending   = "pending"
+	MediaUploadConfirmed = "confirmed"
+)
+
+var (
+	ErrMediaUploadNotFound  = apperrors.New(apperrors.ErrNotFound, "media upload not found")
+	ErrMediaUploadConfirmed = apperrors.New(apperrors.ErrFailedPrecondition, "media upload already confirmed")
+	ErrProductMediaNotFound = apperrors.New(apperrors.ErrNotFound, "product media not found")
+)
+
+// MediaUpload is a ticket for uploading a file of a product straight to the
+// media provider. The file is only attached to the product, as ImageID for
+// images and MediaID for videos and 3D models, once the upload is confirmed
+// and the uploaded asset passed validation.
+type MediaUpload struct {
+	ID          string     `json:"id" db:"id"`
+	ProductID   string     `json:"product_id" db:"product_id"`
+	PublicID    string     `json:"public_id" db:"public_id"`
+	MediaType   string     `json:"media_type" db:"media_type"`
+	Status      string     `json:"status" db:"status"`
+	ImageID     string     `json:"image_id,omitempty" db:"image_id"`
+	MediaID     string     `json:"media_id,omitempty" db:"media_id"`
+	ExpiresAt   time.Time  `json:"expires_at" db:"expires_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	ConfirmedAt *time.Time `json:"confirmed_at,omitempty" db:"confirmed_at"`
+}
@@ -0,0 +1,24 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	ErrProductNoteNotFound = apperrors.New(apperrors.ErrNotFound, "product note not found")
+	ErrNotNoteAuthor       = apperrors.New(apperrors.ErrPermissionDenied, "only the author can edit a note")
+)
+
+// ProductNote is an internal note staff attached to a product. Notes are
+// never part of storefront responses.
+type ProductNote struct {
+	ID          string    `json:"id" db:"id"`
+	ProductID   string    `json:"product_id" db:"product_id"`
+	AuthorID    string    `json:"author_id" db:"author_id"`
+	AuthorEmail string    `json:"author_email" db:"author_email"`
+	Body        string    `json:"body" db:"body"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,10 @@
+package models
+
+import (
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// ErrPersonalizationOptionNotFound is returned when replacing the
+// personalization options of a product with an option ID of no option of
+// the product
+var ErrPersonalizationOptionNotFound = apperrors.New(apperrors.ErrNotFound, "personalization option not found")
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// Bulk price adjustment operations
+const (
+	PriceAdjustPercent = "percent" // Amount is a percentage, e.g. -10 for 10% off
+	PriceAdjustFixed   = "fixed"   // Amount is added to the price
+)
+
+// PriceChangeSourceBulk marks price history entries written by bulk adjustments
+const PriceChangeSourceBulk = "bulk_adjustment"
+
+var ErrInvalidPriceAdjustment = apperrors.New(apperrors.ErrInvalidArgument, "price adjustment would make a price zero or negative")
+
+// PriceFilter selects the products a bulk price adjustment applies to. Set
+// fields are combined with AND.
+type PriceFilter struct {
+	BrandID    string
+	CategoryID string
+	Tag        string
+}
+
+// IsEmpty reports whether the filter selects every product
+func (f PriceFilter) IsEmpty() bool {
+	return f.BrandID == "" && f.CategoryID == "" && f.Tag == ""
+}
+
+// PriceAdjustment is a percent or fixed change applied to product prices
+type PriceAdjustment struct {
+	Operation string
+	Amount    float64
+}
+
+// PriceChange is the before and after price of one product
+type PriceChange struct {
+	ProductID        string   `json:"product_id"`
+	SKU              string   `json:"sku"`
+	Title            string   `json:"title"`
+	OldPrice         float64  `json:"old_price"`
+	NewPrice         float64  `json:"new_price"`
+	OldDiscountPrice *float64 `json:"old_discount_price,omitempty"`
+	NewDiscountPrice *float64 `json:"new_discount_price,omitempty"`
+}
+
+// PriceHistoryEntry records a change of a product's price
+type PriceHistoryEntry struct {
+	ID               string    `json:"id" db:"id"`
+	ProductID        string    `json:"product_id" db:"product_id"`
+	OldPrice         float64   `json:"old_price" db:"old_price"`
+	NewPrice         float64   `json:"new_price" db:"new_price"`
+	OldDiscountPrice *float64  `json:"old_discount_price,omitempty" db:"old_discount_price"`
+	NewDiscountPrice *float64  `json:"new_discount_price,omitempty" db:"new_discount_price"`
+	Source           string    `json:"source" db:"source"`
+	BatchID          string    `json:"batch_id" db:"batch_id"`
+	Reason           string    `json:"reason" db:"reason"`
+	ChangedBy        string    `json:"changed_by" db:"changed_by"`
+	CreatedAt        time.Time `json:"created_at" db:"created_at"`
+}
+
+// Apply fills in the new prices of a change from its old prices. The
+// discount price moves with the price and is dropped when it would no longer
+// be a positive discount.
+func (a PriceAdjustment) Apply(change *PriceChange) error {
+	change.NewPrice = a.adjust(change.OldPrice)
+	if change.NewPrice <= 0 {
+		return ErrInvalidPriceAdjustment
+	}
+
+	change.NewDiscountPrice = nil
+	if change.OldDiscountPrice != nil {
+		discount := a.adjust(*change.OldDiscountPrice)
+		if discount > 0 && discount < change.NewPrice {
+			change.NewDiscountPrice = &discount
+		}
+	}
+	return nil
+}
+
+// adjust applies the operation to one amount, rounded to cents
+func (a PriceAdjustment) adjust(amount float64) float64 {
+	if a.Operation == PriceAdjustPercent {
+		amount = amount * (1 + a.Amount/100)
+	} else {
+		amount = amount + a.Amount
+	}
+	return math.Round(amount*100) / 100
+}
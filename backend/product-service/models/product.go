@@ -1,23 +1,33 @@
 package models
 
 import (
-	"errors"
+	"encoding/json"
 	"fmt"
 	"sort"
 	"strings"
 	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/product-service/personalization"
+	"github.com/louai60/e-commerce_project/backend/product-service/productmedia"
 )
 
 var (
-	ErrProductNotFound      = errors.New("product not found")
-	ErrProductSlugExists    = errors.New("product with this slug already exists")
-	ErrProductAlreadyExists = errors.New("product already exists")
-	ErrVariantNotFound      = errors.New("variant not found")
-	ErrVariantAlreadyExists = errors.New("variant already exists")
-	ErrVariantSKUExists     = errors.New("variant with this SKU already exists")
-	ErrBrandNotFound        = errors.New("brand not found")
-	ErrCategoryNotFound     = errors.New("category not found")
-	ErrImageNotFound        = errors.New("image not found")
+	ErrProductNotFound           = apperrors.New(apperrors.ErrNotFound, "product not found")
+	ErrProductSlugExists         = apperrors.New(apperrors.ErrAlreadyExists, "product with this slug already exists")
+	ErrProductAlreadyExists      = apperrors.New(apperrors.ErrAlreadyExists, "product already exists")
+	ErrVariantNotFound           = apperrors.New(apperrors.ErrNotFound, "variant not found")
+	ErrVariantAlreadyExists      = apperrors.New(apperrors.ErrAlreadyExists, "variant already exists")
+	ErrVariantSKUExists          = apperrors.New(apperrors.ErrAlreadyExists, "variant with this SKU already exists")
+	ErrBrandNotFound             = apperrors.New(apperrors.ErrNotFound, "brand not found")
+	ErrCategoryNotFound          = apperrors.New(apperrors.ErrNotFound, "category not found")
+	ErrImageNotFound             = apperrors.New(apperrors.ErrNotFound, "image not found")
+	ErrVariantImageNotFound      = apperrors.New(apperrors.ErrNotFound, "variant image not found")
+	ErrAttributeNotFound         = apperrors.New(apperrors.ErrNotFound, "product attribute not found")
+	ErrSpecificationNotFound     = apperrors.New(apperrors.ErrNotFound, "product specification not found")
+	ErrDiscountNotFound          = apperrors.New(apperrors.ErrNotFound, "product discount not found")
+	ErrTagNotFound               = apperrors.New(apperrors.ErrNotFound, "product tag not found")
+	ErrInventoryLocationNotFound = apperrors.New(apperrors.ErrNotFound, "inventory location not found")
 )
 
 type Brand struct {
@@ -38,6 +48,7 @@ type Category struct {
 	Description string     `json:"description" db:"description"`
 	ParentID    *string    `json:"parent_id" db:"parent_id"`
 	ParentName  string     `json:"parent_name,omitempty" db:"-"`
+	Position    int        `json:"position" db:"position"`
 	TenantID    *string    `json:"tenant_id,omitempty" db:"tenant_id"` // Added for sharding
 	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
@@ -91,6 +102,8 @@ type ProductVariant struct {
 	CreatedAt     time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt     time.Time  `json:"updated_at" db:"updated_at"`
 	DeletedAt     *time.Time `json:"deleted_at,omitempty" db:"deleted_at"`
+	// Metadata is the custom JSON object integrators attach to the variant
+	Metadata json.RawMessage `json:"metadata,omitempty" db:"metadata"`
 
 	// Related entities (not stored directly in product_variants table)
 	Attributes []VariantAttributeValue `json:"attributes,omitempty" db:"-"` // Populated via join
@@ -152,6 +165,14 @@ type Product struct {
 
 	// Transient fields populated from default variant
 	SKU string `json:"sku" db:"-"` // Kept as reference key to inventory service
+	// DefaultVariantID is the variant the price and SKU of the product are
+	// derived from
+	DefaultVariantID *string `json:"default_variant_id,omitempty" db:"default_variant_id"`
+	// Metadata is the custom JSON object integrators attach to the product
+	Metadata json.RawMessage `json:"metadata,omitempty" db:"metadata"`
+	// MetadataPatch, when set, is merged into the stored metadata by an
+	// update instead of replacing it with Metadata
+	MetadataPatch json.RawMessage `json:"-" db:"-"`
 
 	// Related entities (populated separately)
 	Brand          *Brand                 `json:"brand,omitempty" db:"-"`
@@ -164,9 +185,35 @@ type Product struct {
 	SEO            *ProductSEO            `json:"seo,omitempty" db:"-"`
 	Shipping       *ProductShipping       `json:"shipping,omitempty" db:"-"`
 	Discount       *ProductDiscount       `json:"discount,omitempty" db:"-"`
+	Bundle         *ProductBundle         `json:"bundle,omitempty" db:"-"`        // Set for bundle products
+	DigitalAsset   *DigitalAsset          `json:"digital_asset,omitempty" db:"-"` // Set for digital products
+	Subscription   *SubscriptionPlan      `json:"subscription,omitempty" db:"-"`  // Set for subscription products
+	// PersonalizationOptions are the inputs buyers give to personalize the
+	// product, in display order
+	PersonalizationOptions []personalization.Option `json:"personalization_options,omitempty" db:"-"`
+	// Media are the videos and 3D models of the product, in display order
+	Media []productmedia.Media `json:"media,omitempty" db:"-"`
 	// InventoryLocations removed - now managed by inventory service
 }
 
+// Type returns how the product is fulfilled
+func (p *Product) Type() string {
+	switch {
+	case p.Bundle != nil:
+		return ProductTypeBundle
+	case p.DigitalAsset != nil:
+		return ProductTypeDigital
+	default:
+		return ProductTypePhysical
+	}
+}
+
+// RequiresShipping reports whether the product has to be shipped. Digital
+// products are delivered through download links instead.
+func (p *Product) RequiresShipping() bool {
+	return p.Type() != ProductTypeDigital
+}
+
 // ProductTag represents a tag associated with a product
 type ProductTag struct {
 	ID        string    `json:"id" db:"id"`
@@ -244,6 +291,7 @@ type InventoryLocation struct {
 
 type ProductFilters struct {
 	Category  string   `json:"category"` // TODO: Update filters based on variants/attributes in Phase 5
+	Channel   string   `json:"channel"`  // Only products visible in this publication channel
 	PriceMin  float64  `json:"price_min"`
 	PriceMax  float64  `json:"price_max"`
 	Tags      []string `json:"tags"`
@@ -257,6 +305,7 @@ func (f *ProductFilters) ToCacheKey() string {
 	components := []string{
 		fmt.Sprintf("cat:%s", f.Category),
 		fmt.Sprintf("price:%.2f-%.2f", f.PriceMin, f.PriceMax),
+		fmt.Sprintf("channel:%s", f.Channel),
 	}
 
 	if len(f.Tags) > 0 {
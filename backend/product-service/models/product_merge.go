@@ -0,0 +1,31 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	ErrProductRedirectNotFound = apperrors.New(apperrors.ErrNotFound, "product redirect not found")
+	ErrProductSKUExists        = apperrors.New(apperrors.ErrAlreadyExists, "product with this SKU already exists")
+	ErrSplitOnlyVariant        = apperrors.New(apperrors.ErrFailedPrecondition, "cannot split the only variant of a product")
+	ErrSplitProductSKU         = apperrors.New(apperrors.ErrFailedPrecondition, "cannot split the variant carrying the product SKU")
+)
+
+// ProductRedirect points the ID and slug of a product merged into another
+// product to that product
+type ProductRedirect struct {
+	FromProductID string    `json:"from_product_id" db:"from_product_id"`
+	FromSlug      string    `json:"from_slug" db:"from_slug"`
+	ToProductID   string    `json:"to_product_id" db:"to_product_id"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+}
+
+// ProductMerge is the result of merging a source product into a target
+type ProductMerge struct {
+	TargetID      string `json:"target_id"`
+	SourceID      string `json:"source_id"`
+	VariantsMoved int    `json:"variants_moved"`
+	ImagesMoved   int    `json:"images_moved"`
+}
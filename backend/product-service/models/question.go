@@ -0,0 +1,84 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	ErrProductQuestionNotFound = apperrors.New(apperrors.ErrNotFound, "product question not found")
+	ErrProductAnswerNotFound   = apperrors.New(apperrors.ErrNotFound, "product answer not found")
+)
+
+// Moderation states of questions and answers; only approved ones are shown
+// on the storefront
+const (
+	ModerationPending  = "pending"
+	ModerationApproved = "approved"
+	ModerationRejected = "rejected"
+)
+
+// Roles of the authors of answers
+const (
+	AnswerRoleAdmin  = "admin"
+	AnswerRoleSeller = "seller"
+)
+
+// Orders of question lists
+const (
+	QuestionSortNewest = "newest"
+	// QuestionSortTop orders questions by the upvotes of their approved
+	// answers
+	QuestionSortTop = "top"
+)
+
+// IsValidModerationStatus reports whether status is a moderation state
+func IsValidModerationStatus(status string) bool {
+	switch status {
+	case ModerationPending, ModerationApproved, ModerationRejected:
+		return true
+	}
+	return false
+}
+
+// ProductQuestion is a question a customer asked on a product page
+type ProductQuestion struct {
+	ID         string    `json:"id" db:"id"`
+	ProductID  string    `json:"product_id" db:"product_id"`
+	AuthorID   string    `json:"author_id" db:"author_id"`
+	AuthorName string    `json:"author_name" db:"author_name"`
+	Body       string    `json:"body" db:"body"`
+	Status     string    `json:"status" db:"status"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+	// Answers are the answers listed with the question, most upvoted first
+	Answers []*ProductAnswer `json:"answers" db:"-"`
+}
+
+// ProductAnswer is the answer of an admin or seller to a question
+type ProductAnswer struct {
+	ID         string    `json:"id" db:"id"`
+	QuestionID string    `json:"question_id" db:"question_id"`
+	AuthorID   string    `json:"author_id" db:"author_id"`
+	AuthorName string    `json:"author_name" db:"author_name"`
+	AuthorRole string    `json:"author_role" db:"author_role"`
+	Body       string    `json:"body" db:"body"`
+	Status     string    `json:"status" db:"status"`
+	Upvotes    int       `json:"upvotes" db:"upvotes"`
+	CreatedAt  time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// QuestionFilter selects the questions of a list
+type QuestionFilter struct {
+	// ProductID restricts the list to a product; empty lists all products
+	ProductID string
+	Status    string
+	Sort      string
+	Offset    int
+	Limit     int
+	// IncludeUnmoderated lists pending and rejected answers along with the
+	// approved ones
+	IncludeUnmoderated bool
+}
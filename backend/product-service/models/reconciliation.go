@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// Inventory reconciliation entry kinds
+const (
+	ReconciliationMissing  = "missing"  // Product or variant without an inventory item
+	ReconciliationOrphaned = "orphaned" // Inventory item without a product or variant
+)
+
+var ErrReconciliationNotFound = apperrors.New(apperrors.ErrNotFound, "inventory reconciliation not found")
+
+// StockedSKU is a product or variant SKU that is expected to have an
+// inventory item. Digital products and bundles are not stocked.
+type StockedSKU struct {
+	ProductID string
+	VariantID *string
+	SKU       string
+}
+
+// ReconciliationEntry is a SKU found on only one side of a reconciliation
+type ReconciliationEntry struct {
+	Kind            string  `json:"kind" db:"kind"`
+	SKU             string  `json:"sku" db:"sku"`
+	ProductID       *string `json:"product_id,omitempty" db:"product_id"`
+	VariantID       *string `json:"variant_id,omitempty" db:"variant_id"`
+	InventoryItemID *string `json:"inventory_item_id,omitempty" db:"inventory_item_id"`
+	// Created is set when the missing inventory item was created with zero
+	// quantity; Error explains why creating it failed
+	Created bool    `json:"created" db:"created"`
+	Error   *string `json:"error,omitempty" db:"error"`
+}
+
+// InventoryReconciliation is the result of cross-checking the product SKUs of
+// a store against its inventory items
+type InventoryReconciliation struct {
+	ID                    string                `json:"id" db:"id"`
+	AutoCreate            bool                  `json:"auto_create" db:"auto_create"`
+	SKUsChecked           int                   `json:"skus_checked" db:"skus_checked"`
+	InventoryItemsChecked int                   `json:"inventory_items_checked" db:"inventory_items_checked"`
+	MissingCount          int                   `json:"missing_count" db:"missing_count"`
+	OrphanedCount         int                   `json:"orphaned_count" db:"orphaned_count"`
+	CreatedCount          int                   `json:"created_count" db:"created_count"`
+	Entries               []ReconciliationEntry `json:"entries"`
+	StartedAt             time.Time             `json:"started_at" db:"started_at"`
+	FinishedAt            time.Time             `json:"finished_at" db:"finished_at"`
+}
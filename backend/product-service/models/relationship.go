@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	ErrRelationshipNotFound = apperrors.New(apperrors.ErrNotFound, "product relationship not found")
+	ErrRelationshipExists   = apperrors.New(apperrors.ErrAlreadyExists, "products are already linked with this relationship type")
+)
+
+// Types of the links between products
+const (
+	RelationshipCrossSell   = "cross_sell"
+	RelationshipUpSell      = "up_sell"
+	RelationshipAccessory   = "accessory"
+	RelationshipReplacement = "replacement"
+)
+
+// IsValidRelationshipType reports whether t is a known relationship type
+func IsValidRelationshipType(t string) bool {
+	switch t {
+	case RelationshipCrossSell, RelationshipUpSell, RelationshipAccessory, RelationshipReplacement:
+		return true
+	}
+	return false
+}
+
+// ProductRelationship is a typed link from a product to a product shown with
+// it, such as an accessory. Links are one-way and ordered by position within
+// their type.
+type ProductRelationship struct {
+	ID               string    `json:"id" db:"id"`
+	ProductID        string    `json:"product_id" db:"product_id"`
+	RelatedProductID string    `json:"related_product_id" db:"related_product_id"`
+	Type             string    `json:"type" db:"type"`
+	Position         int       `json:"position" db:"position"`
+	CreatedAt        time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at" db:"updated_at"`
+
+	// The title, slug and state of the linked product
+	RelatedTitle       string `json:"related_title" db:"related_title"`
+	RelatedSlug        string `json:"related_slug" db:"related_slug"`
+	RelatedIsPublished bool   `json:"related_is_published" db:"related_is_published"`
+}
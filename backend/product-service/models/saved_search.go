@@ -0,0 +1,68 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var ErrSavedSearchNotFound = apperrors.New(apperrors.ErrNotFound, "saved search not found")
+
+// Types of saved search alerts
+const (
+	// SavedSearchAlertNewProduct reports a product that started matching a
+	// saved search
+	SavedSearchAlertNewProduct = "new_product"
+	// SavedSearchAlertPriceDrop reports a matching product whose price went
+	// below the threshold of a saved search
+	SavedSearchAlertPriceDrop = "price_drop"
+)
+
+// SavedSearch is a search query with filters a user saved, optionally with
+// alerts on its results
+type SavedSearch struct {
+	ID               string     `json:"id" db:"id"`
+	TenantID         string     `json:"-" db:"tenant_id"`
+	UserID           string     `json:"user_id" db:"user_id"`
+	Name             string     `json:"name" db:"name"`
+	Query            string     `json:"query" db:"query"`
+	CategoryID       string     `json:"category_id,omitempty" db:"category_id"`
+	BrandID          string     `json:"brand_id,omitempty" db:"brand_id"`
+	MinPrice         *float64   `json:"min_price,omitempty" db:"min_price"`
+	MaxPrice         *float64   `json:"max_price,omitempty" db:"max_price"`
+	AlertNewProducts bool       `json:"alert_new_products" db:"alert_new_products"`
+	PriceAlertBelow  *float64   `json:"price_alert_below,omitempty" db:"price_alert_below"`
+	LastEvaluatedAt  *time.Time `json:"last_evaluated_at,omitempty" db:"last_evaluated_at"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// HasAlerts reports whether the results of the search are watched
+func (s *SavedSearch) HasAlerts() bool {
+	return s.AlertNewProducts || s.PriceAlertBelow != nil
+}
+
+// SavedSearchMatch is a product matching a saved search, with its effective
+// price: the discount price when set, else the price
+type SavedSearchMatch struct {
+	ProductID string  `json:"product_id" db:"product_id"`
+	Title     string  `json:"title" db:"title"`
+	Price     float64 `json:"price" db:"price"`
+}
+
+// SavedSearchAlert is an alert on the results of a saved search, waiting for
+// the notification service until it is delivered
+type SavedSearchAlert struct {
+	ID              string     `json:"id" db:"id"`
+	TenantID        string     `json:"tenant_id" db:"tenant_id"`
+	SavedSearchID   string     `json:"saved_search_id" db:"saved_search_id"`
+	SavedSearchName string     `json:"saved_search_name" db:"saved_search_name"`
+	UserID          string     `json:"user_id" db:"user_id"`
+	Type            string     `json:"type" db:"alert_type"`
+	ProductID       string     `json:"product_id" db:"product_id"`
+	ProductTitle    string     `json:"product_title" db:"product_title"`
+	Price           float64    `json:"price" db:"price"`
+	PreviousPrice   *float64   `json:"previous_price,omitempty" db:"previous_price"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at"`
+	DeliveredAt     *time.Time `json:"delivered_at,omitempty" db:"delivered_at"`
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var ErrSearchRankingRuleNotFound = apperrors.New(apperrors.ErrNotFound, "search ranking rule not found")
+
+// Statuses of search ranking rules. Drafts are only applied by previews;
+// saving a published rule turns it back into a draft until published again.
+const (
+	SearchRankingDraft     = "draft"
+	SearchRankingPublished = "published"
+)
+
+// SearchRankingRule is a merchandising rule applied on top of the relevance
+// of search results: a boost of the products of a brand, category or product,
+// or a pin of a product at a position
+type SearchRankingRule struct {
+	ID          string     `json:"id" db:"id"`
+	Name        string     `json:"name" db:"name"`
+	Kind        string     `json:"kind" db:"kind"`
+	Query       string     `json:"query" db:"query"` // Normalized; empty for all searches
+	BrandID     string     `json:"brand_id,omitempty" db:"brand_id"`
+	CategoryID  string     `json:"category_id,omitempty" db:"category_id"`
+	ProductID   string     `json:"product_id,omitempty" db:"product_id"`
+	Weight      float64    `json:"weight" db:"weight"`
+	Position    int        `json:"position" db:"position"`
+	Status      string     `json:"status" db:"status"`
+	PublishedAt *time.Time `json:"published_at,omitempty" db:"published_at"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// SearchHit is a published product found by a search, with its relevance to
+// the query
+type SearchHit struct {
+	ProductID   string   `json:"product_id" db:"product_id"`
+	Title       string   `json:"title" db:"title"`
+	Slug        string   `json:"slug" db:"slug"`
+	Price       float64  `json:"price" db:"price"` // The discount price when set
+	BrandID     string   `json:"brand_id,omitempty" db:"brand_id"`
+	CategoryIDs []string `json:"category_ids" db:"category_ids"`
+	Relevance   float64  `json:"relevance" db:"relevance"`
+}
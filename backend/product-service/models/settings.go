@@ -0,0 +1,247 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+)
+
+var (
+	ErrUnknownSetting  = apperrors.New(apperrors.ErrInvalidArgument, "unknown setting")
+	ErrSettingNotFound = apperrors.New(apperrors.ErrNotFound, "setting is not set")
+)
+
+// Keys of the store settings. The name, default currency and default locale
+// of a store are part of the store itself.
+const (
+	SettingBranding          = "branding"
+	SettingSupportEmail      = "support_email"
+	SettingSupportPhone      = "support_phone"
+	SettingActiveCurrencies  = "active_currencies"
+	SettingActiveLocales     = "active_locales"
+	SettingNotificationEmail = "notification_email"
+	SettingProductValidation = "product_validation"
+)
+
+// Failure policies of the product validator, applied when it cannot be
+// reached or times out
+const (
+	ValidationFailOpen   = "open"
+	ValidationFailClosed = "closed"
+)
+
+// Bounds of the timeout of the product validator, in milliseconds
+const (
+	DefaultValidationTimeoutMS = 2000
+	maxValidationTimeoutMS     = 10000
+)
+
+// maxActiveCodes bounds the currencies and locales a store offers
+const maxActiveCodes = 20
+
+var (
+	hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	phonePattern    = regexp.MustCompile(`^\+?[0-9 ()\-.]{4,30}$`)
+)
+
+// Branding is the value of the branding setting
+type Branding struct {
+	LogoURL        string `json:"logo_url,omitempty"`
+	FaviconURL     string `json:"favicon_url,omitempty"`
+	PrimaryColor   string `json:"primary_color,omitempty"`
+	SecondaryColor string `json:"secondary_color,omitempty"`
+}
+
+// ProductValidation is the value of the product_validation setting: an
+// external validator, such as a PIM or governance system, products are
+// checked against before they are created or updated
+type ProductValidation struct {
+	URL string `json:"url"`
+	// Secret signs the requests sent to the validator, when set
+	Secret    string `json:"secret,omitempty"`
+	TimeoutMS int    `json:"timeout_ms"`
+	// FailurePolicy tells whether products are saved anyway (open) or
+	// refused (closed) while the validator is unavailable
+	FailurePolicy string `json:"failure_policy"`
+}
+
+// SettingDefinition describes a typed setting key
+type SettingDefinition struct {
+	Key         string
+	Description string
+	// Public settings are part of the storefront config; the others are
+	// only seen by staff
+	Public bool
+	// normalize validates a JSON value of the key and returns it in its
+	// canonical form
+	normalize func(raw json.RawMessage) (any, error)
+}
+
+// Normalize validates a JSON value of the setting and returns it
+// re-encoded in its canonical form
+func (d SettingDefinition) Normalize(raw json.RawMessage) (json.RawMessage, error) {
+	value, err := d.normalize(raw)
+	if err != nil {
+		return nil, apperrors.Errorf(apperrors.ErrInvalidArgument, "invalid %s: %v", d.Key, err)
+	}
+	return json.Marshal(value)
+}
+
+// SettingDefinitions lists the setting keys in display order
+var SettingDefinitions = []SettingDefinition{
+	{Key: SettingBranding, Description: "Logo, favicon and colors of the storefront", Public: true, normalize: normalizeBranding},
+	{Key: SettingSupportEmail, Description: "Email address customers contact support at", Public: true, normalize: normalizeEmail},
+	{Key: SettingSupportPhone, Description: "Phone number customers contact support at", Public: true, normalize: normalizePhone},
+	{Key: SettingActiveCurrencies, Description: "ISO 4217 codes of the currencies shoppers can choose besides the store default", Public: true, normalize: normalizeCurrencies},
+	{Key: SettingActiveLocales, Description: "BCP 47 tags of the languages shoppers can choose besides the store default", Public: true, normalize: normalizeLocales},
+	{Key: SettingNotificationEmail, Description: "Email address staff notifications are sent to", normalize: normalizeEmail},
+	{Key: SettingProductValidation, Description: "External validator products are checked against before they are saved", normalize: normalizeProductValidation},
+}
+
+// LookupSetting returns the definition of a setting key
+func LookupSetting(key string) (SettingDefinition, bool) {
+	for _, def := range SettingDefinitions {
+		if def.Key == key {
+			return def, true
+		}
+	}
+	return SettingDefinition{}, false
+}
+
+// Setting is the value of a setting key of a store, as JSON
+type Setting struct {
+	Key       string          `json:"key" db:"key"`
+	Value     json.RawMessage `json:"value" db:"value"`
+	UpdatedBy string          `json:"updated_by" db:"updated_by"`
+	UpdatedAt time.Time       `json:"updated_at" db:"updated_at"`
+}
+
+// decodeStrict decodes a JSON value, rejecting unknown object fields
+func decodeStrict(raw json.RawMessage, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+func normalizeBranding(raw json.RawMessage) (any, error) {
+	var branding Branding
+	if err := decodeStrict(raw, &branding); err != nil {
+		return nil, fmt.Errorf("must be an object of logo_url, favicon_url, primary_color and secondary_color")
+	}
+	for _, u := range []string{branding.LogoURL, branding.FaviconURL} {
+		if u != "" && !isAssetURL(u) {
+			return nil, fmt.Errorf("%q is not an http(s) URL or a path", u)
+		}
+	}
+	for _, color := range []string{branding.PrimaryColor, branding.SecondaryColor} {
+		if color != "" && !hexColorPattern.MatchString(color) {
+			return nil, fmt.Errorf("%q is not a #RRGGBB color", color)
+		}
+	}
+	branding.PrimaryColor = strings.ToLower(branding.PrimaryColor)
+	branding.SecondaryColor = strings.ToLower(branding.SecondaryColor)
+	return branding, nil
+}
+
+func normalizeEmail(raw json.RawMessage) (any, error) {
+	var email string
+	if err := json.Unmarshal(raw, &email); err != nil {
+		return nil, fmt.Errorf("must be a string")
+	}
+	address, err := mail.ParseAddress(strings.TrimSpace(email))
+	if err != nil || address.Name != "" {
+		return nil, fmt.Errorf("%q is not an email address", email)
+	}
+	return address.Address, nil
+}
+
+func normalizePhone(raw json.RawMessage) (any, error) {
+	var phone string
+	if err := json.Unmarshal(raw, &phone); err != nil {
+		return nil, fmt.Errorf("must be a string")
+	}
+	phone = strings.TrimSpace(phone)
+	if !phonePattern.MatchString(phone) {
+		return nil, fmt.Errorf("%q is not a phone number", phone)
+	}
+	return phone, nil
+}
+
+func normalizeCurrencies(raw json.RawMessage) (any, error) {
+	return normalizeCodes(raw, func(code string) string {
+		return locale.Preferences{Currency: code}.Normalize().Currency
+	})
+}
+
+func normalizeLocales(raw json.RawMessage) (any, error) {
+	return normalizeCodes(raw, func(code string) string {
+		return locale.Preferences{Locale: code}.Normalize().Locale
+	})
+}
+
+func normalizeProductValidation(raw json.RawMessage) (any, error) {
+	var validation ProductValidation
+	if err := decodeStrict(raw, &validation); err != nil {
+		return nil, fmt.Errorf("must be an object of url, secret, timeout_ms and failure_policy")
+	}
+	parsed, err := url.Parse(validation.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return nil, fmt.Errorf("%q is not an http(s) URL", validation.URL)
+	}
+	if validation.TimeoutMS == 0 {
+		validation.TimeoutMS = DefaultValidationTimeoutMS
+	}
+	if validation.TimeoutMS < 0 || validation.TimeoutMS > maxValidationTimeoutMS {
+		return nil, fmt.Errorf("timeout_ms must be between 1 and %d", maxValidationTimeoutMS)
+	}
+	switch validation.FailurePolicy {
+	case "":
+		validation.FailurePolicy = ValidationFailOpen
+	case ValidationFailOpen, ValidationFailClosed:
+	default:
+		return nil, fmt.Errorf("failure_policy must be %q or %q", ValidationFailOpen, ValidationFailClosed)
+	}
+	return validation, nil
+}
+
+// normalizeCodes validates a list of currency or locale codes, dropping
+// duplicates
+func normalizeCodes(raw json.RawMessage, canonical func(string) string) (any, error) {
+	var codes []string
+	if err := json.Unmarshal(raw, &codes); err != nil {
+		return nil, fmt.Errorf("must be a list of codes")
+	}
+	if len(codes) == 0 || len(codes) > maxActiveCodes {
+		return nil, fmt.Errorf("must list between 1 and %d codes", maxActiveCodes)
+	}
+
+	normalized := make([]string, 0, len(codes))
+	seen := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		c := canonical(code)
+		if c == "" {
+			return nil, fmt.Errorf("%q is not a valid code", code)
+		}
+		if !seen[c] {
+			seen[c] = true
+			normalized = append(normalized, c)
+		}
+	}
+	return normalized, nil
+}
+
+func isAssetURL(u string) bool {
+	if strings.HasPrefix(u, "/") && !strings.HasPrefix(u, "//") {
+		return true
+	}
+	parsed, err := url.Parse(u)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
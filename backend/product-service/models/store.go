@@ -0,0 +1,25 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	ErrStoreNotFound = apperrors.New(apperrors.ErrNotFound, "store not found")
+	ErrStoreExists   = apperrors.New(apperrors.ErrAlreadyExists, "store already exists")
+)
+
+// Store is a storefront (tenant). Catalog data is scoped to the store its
+// tenant_id refers to.
+type Store struct {
+	ID              string    `json:"id" db:"id"`
+	Name            string    `json:"name" db:"name"`
+	Domain          *string   `json:"domain,omitempty" db:"domain"`
+	DefaultCurrency string    `json:"default_currency" db:"default_currency"`
+	DefaultLocale   string    `json:"default_locale" db:"default_locale"`
+	IsActive        bool      `json:"is_active" db:"is_active"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// Billing intervals of subscription plans
+const (
+	BillingIntervalDay   = "day"
+	BillingIntervalWeek  = "week"
+	BillingIntervalMonth = "month"
+	BillingIntervalYear  = "year"
+)
+
+// Subscription statuses
+const (
+	SubscriptionStatusTrialing  = "trialing"
+	SubscriptionStatusActive    = "active"
+	SubscriptionStatusPastDue   = "past_due"
+	SubscriptionStatusCancelled = "cancelled"
+)
+
+// Subscription event types published to the payment service
+const (
+	SubscriptionEventCreated    = "subscription.created"
+	SubscriptionEventRenewalDue = "subscription.renewal_due"
+	SubscriptionEventCancelled  = "subscription.cancelled"
+)
+
+var (
+	ErrSubscriptionPlanNotFound = apperrors.New(apperrors.ErrNotFound, "subscription plan not found")
+	ErrSubscriptionNotFound     = apperrors.New(apperrors.ErrNotFound, "subscription not found")
+	ErrSubscriptionExists       = apperrors.New(apperrors.ErrAlreadyExists, "subscription already exists for this purchase")
+	ErrSubscriptionCancelled    = apperrors.New(apperrors.ErrFailedPrecondition, "subscription is cancelled")
+)
+
+// SubscriptionPlan marks a product as sold on a recurring basis
+type SubscriptionPlan struct {
+	ProductID     string    `json:"product_id" db:"product_id"`
+	Interval      string    `json:"interval" db:"billing_interval"`
+	IntervalCount int       `json:"interval_count" db:"interval_count"`
+	TrialDays     int       `json:"trial_days" db:"trial_days"`
+	CreatedAt     time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// IsValidBillingInterval reports whether interval is a supported billing interval
+func IsValidBillingInterval(interval string) bool {
+	switch interval {
+	case BillingIntervalDay, BillingIntervalWeek, BillingIntervalMonth, BillingIntervalYear:
+		return true
+	}
+	return false
+}
+
+// PeriodEnd returns the end of a billing period starting at start
+func (p *SubscriptionPlan) PeriodEnd(start time.Time) time.Time {
+	switch p.Interval {
+	case BillingIntervalDay:
+		return start.AddDate(0, 0, p.IntervalCount)
+	case BillingIntervalWeek:
+		return start.AddDate(0, 0, 7*p.IntervalCount)
+	case BillingIntervalYear:
+		return start.AddDate(p.IntervalCount, 0, 0)
+	default:
+		return start.AddDate(0, p.IntervalCount, 0)
+	}
+}
+
+// Subscription is a customer's recurring purchase of a subscription product.
+// The price is fixed when the subscription starts.
+type Subscription struct {
+	ID                 string     `json:"id" db:"id"`
+	TenantID           string     `json:"-" db:"tenant_id"`
+	ProductID          string     `json:"product_id" db:"product_id"`
+	UserID             string     `json:"user_id" db:"user_id"`
+	PurchaseID         string     `json:"purchase_id" db:"purchase_id"`
+	Status             string     `json:"status" db:"status"`
+	Price              float64    `json:"price" db:"price"`
+	Currency           string     `json:"currency" db:"currency"`
+	CurrentPeriodStart time.Time  `json:"current_period_start" db:"current_period_start"`
+	CurrentPeriodEnd   time.Time  `json:"current_period_end" db:"current_period_end"`
+	TrialEndsAt        *time.Time `json:"trial_ends_at,omitempty" db:"trial_ends_at"`
+	CancelAtPeriodEnd  bool       `json:"cancel_at_period_end" db:"cancel_at_period_end"`
+	CancelledAt        *time.Time `json:"cancelled_at,omitempty" db:"cancelled_at"`
+	CreatedAt          time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// SubscriptionEvent is a billing event waiting to be picked up by the payment
+// service. Events stay pending until they are acknowledged.
+type SubscriptionEvent struct {
+	ID             string     `json:"id" db:"id"`
+	SubscriptionID string     `json:"subscription_id" db:"subscription_id"`
+	Type           string     `json:"type" db:"event_type"`
+	Amount         float64    `json:"amount" db:"amount"`
+	Currency       string     `json:"currency" db:"currency"`
+	PeriodStart    *time.Time `json:"period_start,omitempty" db:"period_start"`
+	PeriodEnd      *time.Time `json:"period_end,omitempty" db:"period_end"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty" db:"delivered_at"`
+
+	// UserID and ProductID are copied from the subscription when events are listed
+	UserID    string `json:"user_id" db:"-"`
+	ProductID string `json:"product_id" db:"-"`
+}
+
+// SubscriptionFilter narrows down listed subscriptions
+type SubscriptionFilter struct {
+	UserID string
+	Status string
+}
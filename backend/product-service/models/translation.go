@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var ErrTranslationNotFound = apperrors.New(apperrors.ErrNotFound, "translation not found")
+
+// Catalog entities that can be translated
+const (
+	TranslationEntityProduct  = "product"
+	TranslationEntityCategory = "category"
+	TranslationEntityPage     = "page"
+	TranslationEntityBanner   = "banner"
+)
+
+// IsTranslationEntity reports whether entityType can be translated
+func IsTranslationEntity(entityType string) bool {
+	switch entityType {
+	case TranslationEntityProduct, TranslationEntityCategory, TranslationEntityPage, TranslationEntityBanner:
+		return true
+	}
+	return false
+}
+
+// Translation is the content of a product, category, content page or banner
+// in a locale. Name is the title of a product, page or banner and the name
+// of a category. Short description is the summary of a page and the subtitle
+// of a banner; categories have none. Description is the body of a page;
+// banners have none. Empty fields keep the original content.
+type Translation struct {
+	EntityType       string    `json:"entity_type" db:"entity_type"`
+	EntityID         string    `json:"entity_id" db:"entity_id"`
+	Locale           string    `json:"locale" db:"locale"`
+	Name             string    `json:"name" db:"name"`
+	ShortDescription string    `json:"short_description" db:"short_description"`
+	Description      string    `json:"description" db:"description"`
+	CreatedAt        time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at" db:"updated_at"`
+}
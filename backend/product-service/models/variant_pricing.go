@@ -0,0 +1,38 @@
+package models
+
+import (
+	"time"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+// ErrInvalidVariantPrice is returned for a negative price, or a discount
+// price not below the price
+var ErrInvalidVariantPrice = apperrors.New(apperrors.ErrInvalidArgument, "price must not be negative and the discount price must be below it")
+
+// Reasons the price and SKU of a product are not derived from its default
+// variant
+const (
+	DriftNoDefaultVariant = "no_default_variant"
+	DriftOutOfSync        = "out_of_sync"
+)
+
+// PricingDrift is a product whose price and SKU are not derived from a live
+// default variant
+type PricingDrift struct {
+	ProductID string `json:"product_id"`
+	TenantID  string `json:"tenant_id"`
+	Reason    string `json:"reason"`
+}
+
+// PricingBackfill is the result of a run of the pricing backfill
+type PricingBackfill struct {
+	Drifted  int
+	Repaired int
+	// Unrepaired counts the products left without a default variant, whose
+	// SKU is taken by a variant of another product
+	Unrepaired int
+	Samples    []PricingDrift
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
@@ -0,0 +1,207 @@
+// Package personalization checks the inputs buyers give to personalize a
+// product, such as an engraving text or uploaded artwork, against the
+// personalization options the store configured on the product.
+package personalization
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Types of personalization options
+const (
+	// TypeText options take a text typed by the buyer, up to MaxLength
+	// characters
+	TypeText = "text"
+	// TypeFile options take a file uploaded by the buyer, of one of
+	// FileTypes
+	TypeFile = "file"
+)
+
+// Bounds of personalization options
+const (
+	MaxOptions       = 10
+	MaxNameLength    = 50
+	DefaultMaxLength = 100
+	MaxTextLength    = 1000
+	maxURLLength     = 2048
+	maxFileName      = 255
+)
+
+// DefaultFileTypes are the file types accepted by file options configured
+// without any
+var DefaultFileTypes = []string{"png", "jpg", "jpeg", "pdf", "svg"}
+
+var fileTypePattern = regexp.MustCompile(`^[a-z0-9]{1,10}$`)
+
+// Option is an input a buyer gives to personalize a product. Options are
+// presented to buyers in the order of the product.
+type Option struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`
+	Required  bool     `json:"required"`
+	MaxLength int      `json:"max_length,omitempty"`
+	FileTypes []string `json:"file_types,omitempty"`
+}
+
+// Value is the input of a buyer for an option, a text or the URL of an
+// uploaded file depending on the type of the option
+type Value struct {
+	OptionID string `json:"option_id"`
+	Name     string `json:"name"`
+	Text     string `json:"text,omitempty"`
+	FileURL  string `json:"file_url,omitempty"`
+	FileName string `json:"file_name,omitempty"`
+}
+
+// NormalizeOptions checks the options of a product and returns them with
+// their defaults applied
+func NormalizeOptions(options []Option) ([]Option, error) {
+	if len(options) > MaxOptions {
+		return nil, fmt.Errorf("a product can have at most %d personalization options", MaxOptions)
+	}
+
+	normalized := make([]Option, len(options))
+	names := make(map[string]bool, len(options))
+	for i, option := range options {
+		option.Name = strings.TrimSpace(option.Name)
+		if option.Name == "" || utf8.RuneCountInString(option.Name) > MaxNameLength {
+			return nil, fmt.Errorf("option names must be 1 to %d characters", MaxNameLength)
+		}
+		key := strings.ToLower(option.Name)
+		if names[key] {
+			return nil, fmt.Errorf("duplicate option %q", option.Name)
+		}
+		names[key] = true
+
+		switch option.Type {
+		case TypeText:
+			if option.MaxLength == 0 {
+				option.MaxLength = DefaultMaxLength
+			}
+			if option.MaxLength < 0 || option.MaxLength > MaxTextLength {
+				return nil, fmt.Errorf("the max length of %s must be between 1 and %d", option.Name, MaxTextLength)
+			}
+			option.FileTypes = nil
+		case TypeFile:
+			fileTypes, err := normalizeFileTypes(option.FileTypes)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", option.Name, err)
+			}
+			option.FileTypes = fileTypes
+			option.MaxLength = 0
+		default:
+			return nil, fmt.Errorf("unknown option type %q", option.Type)
+		}
+		normalized[i] = option
+	}
+	return normalized, nil
+}
+
+func normalizeFileTypes(fileTypes []string) ([]string, error) {
+	if len(fileTypes) == 0 {
+		return append([]string(nil), DefaultFileTypes...), nil
+	}
+	normalized := make([]string, 0, len(fileTypes))
+	seen := make(map[string]bool, len(fileTypes))
+	for _, fileType := range fileTypes {
+		fileType = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(fileType)), ".")
+		if !fileTypePattern.MatchString(fileType) {
+			return nil, fmt.Errorf("invalid file type %q", fileType)
+		}
+		if !seen[fileType] {
+			seen[fileType] = true
+			normalized = append(normalized, fileType)
+		}
+	}
+	return normalized, nil
+}
+
+// Validate checks the values a buyer gave for the options of a product and
+// returns them cleaned, named after their option and in option order.
+// Optional options left empty are dropped.
+func Validate(options []Option, values []Value) ([]Value, error) {
+	known := make(map[string]bool, len(options))
+	for _, option := range options {
+		known[option.ID] = true
+	}
+	byOption := make(map[string]Value, len(values))
+	for _, value := range values {
+		if !known[value.OptionID] {
+			return nil, fmt.Errorf("unknown personalization option %q", value.OptionID)
+		}
+		if _, ok := byOption[value.OptionID]; ok {
+			return nil, fmt.Errorf("duplicate value of option %q", value.OptionID)
+		}
+		byOption[value.OptionID] = value
+	}
+
+	validated := make([]Value, 0, len(values))
+	for _, option := range options {
+		value, ok := byOption[option.ID]
+
+		var err error
+		switch option.Type {
+		case TypeText:
+			value, err = validateText(option, value)
+		case TypeFile:
+			value, err = validateFile(option, value)
+		default:
+			err = fmt.Errorf("unknown option type %q", option.Type)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !ok || value == (Value{OptionID: option.ID, Name: option.Name}) {
+			if option.Required {
+				return nil, fmt.Errorf("%s is required", option.Name)
+			}
+			continue
+		}
+		validated = append(validated, value)
+	}
+	return validated, nil
+}
+
+func validateText(option Option, value Value) (Value, error) {
+	text := strings.TrimSpace(value.Text)
+	if utf8.RuneCountInString(text) > option.MaxLength {
+		return Value{}, fmt.Errorf("%s must be at most %d characters", option.Name, option.MaxLength)
+	}
+	if strings.IndexFunc(text, unicode.IsControl) >= 0 {
+		return Value{}, fmt.Errorf("%s must not contain control characters", option.Name)
+	}
+	return Value{OptionID: option.ID, Name: option.Name, Text: text}, nil
+}
+
+func validateFile(option Option, value Value) (Value, error) {
+	fileURL := strings.TrimSpace(value.FileURL)
+	if fileURL == "" {
+		return Value{OptionID: option.ID, Name: option.Name}, nil
+	}
+	u, err := url.Parse(fileURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || len(fileURL) > maxURLLength {
+		return Value{}, fmt.Errorf("%s must be the URL of an uploaded file", option.Name)
+	}
+
+	fileName := strings.TrimSpace(value.FileName)
+	if fileName == "" {
+		fileName = path.Base(u.Path)
+	}
+	if len(fileName) > maxFileName {
+		return Value{}, fmt.Errorf("the file name of %s must be at most %d characters", option.Name, maxFileName)
+	}
+	fileType := strings.ToLower(strings.TrimPrefix(path.Ext(fileName), "."))
+	for _, allowed := range option.FileTypes {
+		if fileType == allowed {
+			return Value{OptionID: option.ID, Name: option.Name, FileURL: fileURL, FileName: fileName}, nil
+		}
+	}
+	return Value{}, fmt.Errorf("%s must be a file of type %s", option.Name, strings.Join(option.FileTypes, ", "))
+}
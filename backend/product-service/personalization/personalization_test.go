@@ -0,0 +1,104 @@
+package personalization
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeOptions(t *testing.T) {
+	options, err := NormalizeOptions([]Option{
+		{Name: " Engraving ", Type: TypeText, Required: true, FileTypes: []string{"png"}},
+		{Name: "Artwork", Type: TypeFile, FileTypes: []string{".PNG", "pdf", "png"}, MaxLength: 20},
+		{Name: "Logo", Type: TypeFile},
+	})
+	if err != nil {
+		t.Fatalf("NormalizeOptions() error = %v", err)
+	}
+	want := []Option{
+		{Name: "Engraving", Type: TypeText, Required: true, MaxLength: DefaultMaxLength},
+		{Name: "Artwork", Type: TypeFile, FileTypes: []string{"png", "pdf"}},
+		{Name: "Logo", Type: TypeFile, FileTypes: DefaultFileTypes},
+	}
+	if !reflect.DeepEqual(options, want) {
+		t.Errorf("NormalizeOptions() = %+v, want %+v", options, want)
+	}
+
+	invalid := map[string][]Option{
+		"empty name":     {{Name: " ", Type: TypeText}},
+		"duplicate name": {{Name: "Text", Type: TypeText}, {Name: "text", Type: TypeFile}},
+		"unknown type":   {{Name: "Color", Type: "color"}},
+		"max length":     {{Name: "Text", Type: TypeText, MaxLength: MaxTextLength + 1}},
+		"file type":      {{Name: "Artwork", Type: TypeFile, FileTypes: []string{"p n g"}}},
+		"too many":       make([]Option, MaxOptions+1),
+	}
+	for name, options := range invalid {
+		if _, err := NormalizeOptions(options); err == nil {
+			t.Errorf("NormalizeOptions(%s) succeeded, want an error", name)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	options := []Option{
+		{ID: "engraving", Name: "Engraving", Type: TypeText, Required: true, MaxLength: 10},
+		{ID: "artwork", Name: "Artwork", Type: TypeFile, FileTypes: []string{"png", "pdf"}},
+		{ID: "note", Name: "Note", Type: TypeText, MaxLength: 50},
+	}
+
+	tests := []struct {
+		name    string
+		values  []Value
+		want    []Value
+		wantErr string
+	}{
+		{
+			name: "valid",
+			values: []Value{
+				{OptionID: "artwork", FileURL: "https://cdn.example.com/uploads/logo.PNG"},
+				{OptionID: "engraving", Text: "  For Ana "},
+				{OptionID: "note", Text: " "},
+			},
+			want: []Value{
+				{OptionID: "engraving", Name: "Engraving", Text: "For Ana"},
+				{OptionID: "artwork", Name: "Artwork", FileURL: "https://cdn.example.com/uploads/logo.PNG", FileName: "logo.PNG"},
+			},
+		},
+		{name: "missing required", values: []Value{{OptionID: "note", Text: "hi"}}, wantErr: "Engraving is required"},
+		{name: "too long", values: []Value{{OptionID: "engraving", Text: "eleven chars"}}, wantErr: "at most 10 characters"},
+		{name: "control characters", values: []Value{{OptionID: "engraving", Text: "a\nb"}}, wantErr: "control characters"},
+		{
+			name:    "file type",
+			values:  []Value{{OptionID: "engraving", Text: "x"}, {OptionID: "artwork", FileURL: "https://cdn.example.com/a.png", FileName: "a.exe"}},
+			wantErr: "type png, pdf",
+		},
+		{
+			name:    "file URL",
+			values:  []Value{{OptionID: "engraving", Text: "x"}, {OptionID: "artwork", FileURL: "file:///etc/a.png"}},
+			wantErr: "URL of an uploaded file",
+		},
+		{name: "unknown option", values: []Value{{OptionID: "color", Text: "red"}}, wantErr: "unknown personalization option"},
+		{name: "duplicate", values: []Value{{OptionID: "engraving", Text: "a"}, {OptionID: "engraving", Text: "b"}}, wantErr: "duplicate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Validate(options, tt.values)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Validate() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if got, err := Validate(nil, nil); err != nil || len(got) != 0 {
+		t.Errorf("Validate() of a product without options = %v, %v", got, err)
+	}
+}
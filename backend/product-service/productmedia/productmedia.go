@@ -0,0 +1,153 @@
+// Package productmedia describes the media of products beyond images: videos,
+// uploaded or hosted on a video platform, and 3D models for the storefront
+// viewer. It validates their sources and derives their thumbnails.
+package productmedia
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Media types. Images are stored as product images; the other types are
+// product media.
+const (
+	TypeImage   = "image"
+	TypeVideo   = "video"
+	TypeModel3D = "model_3d"
+)
+
+// Providers serving product media
+const (
+	// ProviderCloudinary serves files uploaded to Cloudinary
+	ProviderCloudinary = "cloudinary"
+	ProviderYouTube    = "youtube"
+	ProviderVimeo      = "vimeo"
+	// ProviderDirect serves a video file from any HTTPS URL
+	ProviderDirect = "direct"
+)
+
+// Processing statuses of product media. Media are processing until their
+// thumbnail is extracted.
+const (
+	StatusProcessing = "processing"
+	StatusReady      = "ready"
+	StatusFailed     = "failed"
+)
+
+// Default formats accepted for each media type
+var (
+	DefaultVideoFormats = []string{"mp4", "webm", "mov"}
+	DefaultModelFormats = []string{"glb", "usdz"}
+)
+
+// Default size limits of uploaded videos and 3D models
+const (
+	DefaultVideoMaxBytes = 100 << 20
+	DefaultModelMaxBytes = 50 << 20
+)
+
+const maxURLLength = 2048
+
+var (
+	youTubeIDPattern = regexp.MustCompile(`^[A-Za-z0-9_-]{11}$`)
+	vimeoIDPattern   = regexp.MustCompile(`^[0-9]{6,12}$`)
+)
+
+// Media is a video or 3D model of a product
+type Media struct {
+	ID           string `json:"id"`
+	ProductID    string `json:"product_id"`
+	Type         string `json:"type"`
+	Provider     string `json:"provider"`
+	URL          string `json:"url"`
+	Format       string `json:"format,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	AltText      string `json:"alt_text,omitempty"`
+	Position     int    `json:"position"`
+	Status       string `json:"status"`
+	// ExternalID identifies videos on their platform, and uploaded files at
+	// Cloudinary
+	ExternalID string    `json:"external_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// IsValidType reports whether mediaType is a known media type
+func IsValidType(mediaType string) bool {
+	return mediaType == TypeImage || mediaType == TypeVideo || mediaType == TypeModel3D
+}
+
+// ResourceType returns the Cloudinary resource type files of mediaType are
+// uploaded as. Cloudinary stores 3D models as images, which lets it render
+// their thumbnails.
+func ResourceType(mediaType string) string {
+	if mediaType == TypeVideo {
+		return "video"
+	}
+	return "image"
+}
+
+// EmbedURL returns the URL of the player of a video hosted on a platform, or
+// the URL of the media itself
+func EmbedURL(media *Media) string {
+	switch media.Provider {
+	case ProviderYouTube:
+		return "https://www.youtube-nocookie.com/embed/" + media.ExternalID
+	case ProviderVimeo:
+		return "https://player.vimeo.com/video/" + media.ExternalID
+	}
+	return media.URL
+}
+
+// ParseVideoURL returns the video at rawURL: a YouTube or Vimeo video, or a
+// video file served over HTTPS in one of formats
+func ParseVideoURL(rawURL string, formats []string) (*Media, error) {
+	rawURL = strings.TrimSpace(rawURL)
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" || len(rawURL) > maxURLLength {
+		return nil, fmt.Errorf("invalid video URL")
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("video URLs must use HTTPS")
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch host {
+	case "youtube.com", "m.youtube.com":
+		id := u.Query().Get("v")
+		if strings.HasPrefix(u.Path, "/embed/") || strings.HasPrefix(u.Path, "/shorts/") {
+			id = path.Base(u.Path)
+		}
+		return hostedVideo(ProviderYouTube, id, youTubeIDPattern)
+	case "youtu.be":
+		return hostedVideo(ProviderYouTube, strings.TrimPrefix(u.Path, "/"), youTubeIDPattern)
+	case "vimeo.com", "player.vimeo.com":
+		return hostedVideo(ProviderVimeo, path.Base(u.Path), vimeoIDPattern)
+	}
+
+	format := strings.ToLower(strings.TrimPrefix(path.Ext(u.Path), "."))
+	for _, allowed := range formats {
+		if format == allowed {
+			return &Media{Type: TypeVideo, Provider: ProviderDirect, URL: rawURL, Format: format}, nil
+		}
+	}
+	return nil, fmt.Errorf("video URLs must be YouTube or Vimeo videos, or files of type %s", strings.Join(formats, ", "))
+}
+
+func hostedVideo(provider, id string, pattern *regexp.Regexp) (*Media, error) {
+	if !pattern.MatchString(id) {
+		return nil, fmt.Errorf("invalid %s video URL", provider)
+	}
+	media := &Media{Type: TypeVideo, Provider: provider, ExternalID: id}
+	switch provider {
+	case ProviderYouTube:
+		media.URL = "https://www.youtube.com/watch?v=" + id
+	case ProviderVimeo:
+		media.URL = "https://vimeo.com/" + id
+	}
+	return media, nil
+}
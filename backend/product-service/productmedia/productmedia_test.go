@@ -0,0 +1,95 @@
+package productmedia
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseVideoURL(t *testing.T) {
+	formats := DefaultVideoFormats
+
+	tests := []struct {
+		url        string
+		provider   string
+		externalID string
+		format     string
+	}{
+		{"https://www.youtube.com/watch?v=dQw4w9WgXcQ&t=10", ProviderYouTube, "dQw4w9WgXcQ", ""},
+		{"https://youtu.be/dQw4w9WgXcQ", ProviderYouTube, "dQw4w9WgXcQ", ""},
+		{"https://www.youtube.com/embed/dQw4w9WgXcQ", ProviderYouTube, "dQw4w9WgXcQ", ""},
+		{"https://vimeo.com/76979871", ProviderVimeo, "76979871", ""},
+		{"https://cdn.example.com/videos/demo.MP4?v=2", ProviderDirect, "", "mp4"},
+	}
+	for _, tt := range tests {
+		media, err := ParseVideoURL(tt.url, formats)
+		if err != nil {
+			t.Errorf("ParseVideoURL(%q) error = %v", tt.url, err)
+			continue
+		}
+		if media.Type != TypeVideo || media.Provider != tt.provider || media.ExternalID != tt.externalID || media.Format != tt.format {
+			t.Errorf("ParseVideoURL(%q) = %+v", tt.url, media)
+		}
+	}
+
+	for _, invalid := range []string{
+		"http://www.youtube.com/watch?v=dQw4w9WgXcQ",
+		"https://www.youtube.com/watch?v=short",
+		"https://vimeo.com/channels/staff",
+		"https://cdn.example.com/videos/demo.avi",
+		"not a url",
+	} {
+		if _, err := ParseVideoURL(invalid, formats); err == nil {
+			t.Errorf("ParseVideoURL(%q) succeeded, want an error", invalid)
+		}
+	}
+}
+
+func TestEmbedURL(t *testing.T) {
+	if got := EmbedURL(&Media{Provider: ProviderYouTube, ExternalID: "dQw4w9WgXcQ"}); got != "https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ" {
+		t.Errorf("EmbedURL(youtube) = %q", got)
+	}
+	if got := EmbedURL(&Media{Provider: ProviderCloudinary, URL: "https://cdn/a.mp4"}); got != "https://cdn/a.mp4" {
+		t.Errorf("EmbedURL(cloudinary) = %q", got)
+	}
+}
+
+func TestThumbnail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("url") != "https://vimeo.com/76979871" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"thumbnail_url": "https://i.vimeocdn.com/video/1.jpg"}`))
+	}))
+	defer server.Close()
+
+	thumbnailer := NewThumbnailer("demo", server.Client())
+	thumbnailer.oEmbedURL = server.URL
+	ctx := context.Background()
+
+	tests := []struct {
+		media *Media
+		want  string
+	}{
+		{&Media{Type: TypeVideo, Provider: ProviderCloudinary, ExternalID: "products/p1/u1"}, "https://res.cloudinary.com/demo/video/upload/so_0/products/p1/u1.jpg"},
+		{&Media{Type: TypeModel3D, Provider: ProviderCloudinary, ExternalID: "products/p1/u2"}, "https://res.cloudinary.com/demo/image/upload/f_jpg/products/p1/u2.jpg"},
+		{&Media{Type: TypeVideo, Provider: ProviderYouTube, ExternalID: "dQw4w9WgXcQ"}, "https://i.ytimg.com/vi/dQw4w9WgXcQ/hqdefault.jpg"},
+		{&Media{Type: TypeVideo, Provider: ProviderVimeo, URL: "https://vimeo.com/76979871"}, "https://i.vimeocdn.com/video/1.jpg"},
+	}
+	for _, tt := range tests {
+		got, err := thumbnailer.Thumbnail(ctx, tt.media)
+		if err != nil || got != tt.want {
+			t.Errorf("Thumbnail(%+v) = %q, %v, want %q", tt.media, got, err, tt.want)
+		}
+	}
+
+	if _, err := thumbnailer.Thumbnail(ctx, &Media{Type: TypeVideo, Provider: ProviderDirect}); !errors.Is(err, ErrNoThumbnail) {
+		t.Errorf("Thumbnail(direct) error = %v, want ErrNoThumbnail", err)
+	}
+	if _, err := thumbnailer.Thumbnail(ctx, &Media{Type: TypeVideo, Provider: ProviderVimeo, URL: "https://vimeo.com/1"}); err == nil {
+		t.Error("Thumbnail(unknown vimeo video) succeeded, want an error")
+	}
+}
@@ -0,0 +1,88 @@
+package productmedia
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ErrNoThumbnail is returned for media whose thumbnail cannot be extracted,
+// such as video files served from other sites
+var ErrNoThumbnail = errors.New("the media has no thumbnail")
+
+const vimeoOEmbedURL = "https://vimeo.com/api/oembed.json"
+
+// Thumbnailer extracts the thumbnails of product media
+type Thumbnailer struct {
+	// CloudName is the Cloudinary account uploads are stored in
+	CloudName string
+	client    *http.Client
+	oEmbedURL string
+}
+
+// NewThumbnailer returns a thumbnailer for media uploaded to the Cloudinary
+// account cloudName
+func NewThumbnailer(cloudName string, client *http.Client) *Thumbnailer {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Thumbnailer{CloudName: cloudName, client: client, oEmbedURL: vimeoOEmbedURL}
+}
+
+// Thumbnail returns the URL of the thumbnail of media. Cloudinary renders
+// frames of videos and views of 3D models on the fly, YouTube serves the
+// thumbnails of its videos at fixed URLs, and Vimeo reports them over oEmbed.
+func (t *Thumbnailer) Thumbnail(ctx context.Context, media *Media) (string, error) {
+	switch media.Provider {
+	case ProviderCloudinary:
+		if t.CloudName == "" {
+			return "", fmt.Errorf("cloudinary is not configured")
+		}
+		return CloudinaryThumbnailURL(t.CloudName, media.Type, media.ExternalID), nil
+	case ProviderYouTube:
+		return "https://i.ytimg.com/vi/" + media.ExternalID + "/hqdefault.jpg", nil
+	case ProviderVimeo:
+		return t.vimeoThumbnail(ctx, media.URL)
+	}
+	return "", ErrNoThumbnail
+}
+
+// CloudinaryThumbnailURL returns the URL of the thumbnail Cloudinary renders
+// for the media of mediaType uploaded under publicID: the first frame of
+// videos, and the default view of 3D models
+func CloudinaryThumbnailURL(cloudName, mediaType, publicID string) string {
+	if mediaType == TypeVideo {
+		return fmt.Sprintf("https://res.cloudinary.com/%s/video/upload/so_0/%s.jpg", cloudName, publicID)
+	}
+	return fmt.Sprintf("https://res.cloudinary.com/%s/image/upload/f_jpg/%s.jpg", cloudName, publicID)
+}
+
+func (t *Thumbnailer) vimeoThumbnail(ctx context.Context, videoURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, t.oEmbedURL+"?url="+url.QueryEscape(videoURL), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to get vimeo video: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get vimeo video: status %d", resp.StatusCode)
+	}
+
+	var oEmbed struct {
+		ThumbnailURL string `json:"thumbnail_url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&oEmbed); err != nil {
+		return "", fmt.Errorf("failed to decode vimeo video: %w", err)
+	}
+	if oEmbed.ThumbnailURL == "" {
+		return "", ErrNoThumbnail
+	}
+	return oEmbed.ThumbnailURL, nil
+}
@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
@@ -190,8 +191,11 @@ type ProductVariant struct {
 	Seo              *ProductSEO             `protobuf:"bytes,18,opt,name=seo,proto3" json:"seo,omitempty"`
 	Shipping         *ProductShipping        `protobuf:"bytes,19,opt,name=shipping,proto3" json:"shipping,omitempty"`
 	Discount         *ProductDiscount        `protobuf:"bytes,20,opt,name=discount,proto3" json:"discount,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Custom data of integrators, set in responses when exposed to the store;
+	// a JSON merge patch of the existing metadata in updates
+	Metadata      *structpb.Struct `protobuf:"bytes,21,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProductVariant) Reset() {
@@ -357,6 +361,13 @@ func (x *ProductVariant) GetDiscount() *ProductDiscount {
 	return nil
 }
 
+func (x *ProductVariant) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type ProductTag struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -914,14 +925,26 @@ type Product struct {
 	Variants         []*ProductVariant       `protobuf:"bytes,19,rep,name=variants,proto3" json:"variants,omitempty"`
 	DefaultVariantId *wrapperspb.StringValue `protobuf:"bytes,20,opt,name=default_variant_id,json=defaultVariantId,proto3" json:"default_variant_id,omitempty"`
 	// New fields
-	Tags           []*ProductTag           `protobuf:"bytes,21,rep,name=tags,proto3" json:"tags,omitempty"`
-	Attributes     []*ProductAttribute     `protobuf:"bytes,22,rep,name=attributes,proto3" json:"attributes,omitempty"`
-	Specifications []*ProductSpecification `protobuf:"bytes,23,rep,name=specifications,proto3" json:"specifications,omitempty"`
-	Seo            *ProductSEO             `protobuf:"bytes,24,opt,name=seo,proto3" json:"seo,omitempty"`
-	Shipping       *ProductShipping        `protobuf:"bytes,25,opt,name=shipping,proto3" json:"shipping,omitempty"`
-	Discount       *ProductDiscount        `protobuf:"bytes,26,opt,name=discount,proto3" json:"discount,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	Tags             []*ProductTag           `protobuf:"bytes,21,rep,name=tags,proto3" json:"tags,omitempty"`
+	Attributes       []*ProductAttribute     `protobuf:"bytes,22,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Specifications   []*ProductSpecification `protobuf:"bytes,23,rep,name=specifications,proto3" json:"specifications,omitempty"`
+	Seo              *ProductSEO             `protobuf:"bytes,24,opt,name=seo,proto3" json:"seo,omitempty"`
+	Shipping         *ProductShipping        `protobuf:"bytes,25,opt,name=shipping,proto3" json:"shipping,omitempty"`
+	Discount         *ProductDiscount        `protobuf:"bytes,26,opt,name=discount,proto3" json:"discount,omitempty"`
+	Bundle           *ProductBundle          `protobuf:"bytes,27,opt,name=bundle,proto3" json:"bundle,omitempty"`                                              // Set when the product is a bundle of other SKUs
+	ProductType      string                  `protobuf:"bytes,28,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`                 // physical, digital or bundle
+	RequiresShipping bool                    `protobuf:"varint,29,opt,name=requires_shipping,json=requiresShipping,proto3" json:"requires_shipping,omitempty"` // False for digital products, which are delivered by download
+	DigitalAsset     *DigitalAsset           `protobuf:"bytes,30,opt,name=digital_asset,json=digitalAsset,proto3" json:"digital_asset,omitempty"`              // Set when the product is digital
+	Subscription     *SubscriptionPlan       `protobuf:"bytes,31,opt,name=subscription,proto3" json:"subscription,omitempty"`                                  // Set when the product is sold as a recurring subscription
+	RelatedProducts  []*RelatedProduct       `protobuf:"bytes,32,rep,name=related_products,json=relatedProducts,proto3" json:"related_products,omitempty"`     // Set on product detail responses, published products only
+	Badges           []*ProductBadge         `protobuf:"bytes,33,rep,name=badges,proto3" json:"badges,omitempty"`                                              // Display badges, in display order
+	// Custom data of integrators, set in responses when exposed to the store;
+	// a JSON merge patch of the existing metadata in updates
+	Metadata               *structpb.Struct         `protobuf:"bytes,34,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	PersonalizationOptions []*PersonalizationOption `protobuf:"bytes,35,rep,name=personalization_options,json=personalizationOptions,proto3" json:"personalization_options,omitempty"` // Inputs buyers give to personalize the product, in display order
+	Media                  []*ProductMedia          `protobuf:"bytes,36,rep,name=media,proto3" json:"media,omitempty"`                                                                 // Videos and 3D models, in display order
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *Product) Reset() {
@@ -1122,6 +1145,76 @@ func (x *Product) GetDiscount() *ProductDiscount {
 	return nil
 }
 
+func (x *Product) GetBundle() *ProductBundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *Product) GetProductType() string {
+	if x != nil {
+		return x.ProductType
+	}
+	return ""
+}
+
+func (x *Product) GetRequiresShipping() bool {
+	if x != nil {
+		return x.RequiresShipping
+	}
+	return false
+}
+
+func (x *Product) GetDigitalAsset() *DigitalAsset {
+	if x != nil {
+		return x.DigitalAsset
+	}
+	return nil
+}
+
+func (x *Product) GetSubscription() *SubscriptionPlan {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *Product) GetRelatedProducts() []*RelatedProduct {
+	if x != nil {
+		return x.RelatedProducts
+	}
+	return nil
+}
+
+func (x *Product) GetBadges() []*ProductBadge {
+	if x != nil {
+		return x.Badges
+	}
+	return nil
+}
+
+func (x *Product) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *Product) GetPersonalizationOptions() []*PersonalizationOption {
+	if x != nil {
+		return x.PersonalizationOptions
+	}
+	return nil
+}
+
+func (x *Product) GetMedia() []*ProductMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
 type ProductImage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1214,6 +1307,147 @@ func (x *ProductImage) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// ProductMedia is a video or 3D model of a product, for the storefront viewer
+type ProductMedia struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`         // video or model_3d
+	Provider      string                 `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"` // cloudinary, youtube, vimeo or direct
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	EmbedUrl      string                 `protobuf:"bytes,6,opt,name=embed_url,json=embedUrl,proto3" json:"embed_url,omitempty"`             // Player of videos hosted on YouTube or Vimeo, the URL otherwise
+	Format        string                 `protobuf:"bytes,7,opt,name=format,proto3" json:"format,omitempty"`                                 // File format, such as "mp4" or "glb", empty for hosted videos
+	ThumbnailUrl  string                 `protobuf:"bytes,8,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"` // Set once processed
+	AltText       string                 `protobuf:"bytes,9,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	Position      int32                  `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`
+	Status        string                 `protobuf:"bytes,11,opt,name=status,proto3" json:"status,omitempty"` // processing, ready or failed
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductMedia) Reset() {
+	*x = ProductMedia{}
+	mi := &file_proto_product_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductMedia) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductMedia) ProtoMessage() {}
+
+func (x *ProductMedia) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductMedia.ProtoReflect.Descriptor instead.
+func (*ProductMedia) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ProductMedia) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetEmbedUrl() string {
+	if x != nil {
+		return x.EmbedUrl
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ProductMedia) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProductMedia) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProductMedia) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
 type Brand struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -1229,7 +1463,7 @@ type Brand struct {
 
 func (x *Brand) Reset() {
 	*x = Brand{}
-	mi := &file_proto_product_proto_msgTypes[11]
+	mi := &file_proto_product_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1241,7 +1475,7 @@ func (x *Brand) String() string {
 func (*Brand) ProtoMessage() {}
 
 func (x *Brand) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[11]
+	mi := &file_proto_product_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1254,7 +1488,7 @@ func (x *Brand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Brand.ProtoReflect.Descriptor instead.
 func (*Brand) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{11}
+	return file_proto_product_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Brand) GetId() string {
@@ -1317,13 +1551,14 @@ type Category struct {
 	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	DeletedAt     *timestamppb.Timestamp  `protobuf:"bytes,9,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"` // Added for soft delete
+	Position      int32                   `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`                  // Order among the children of the parent
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Category) Reset() {
 	*x = Category{}
-	mi := &file_proto_product_proto_msgTypes[12]
+	mi := &file_proto_product_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1335,7 +1570,7 @@ func (x *Category) String() string {
 func (*Category) ProtoMessage() {}
 
 func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[12]
+	mi := &file_proto_product_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1348,7 +1583,7 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Category.ProtoReflect.Descriptor instead.
 func (*Category) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{12}
+	return file_proto_product_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Category) GetId() string {
@@ -1414,6 +1649,13 @@ func (x *Category) GetDeletedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Category) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
 // Product related messages
 type CreateProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1424,7 +1666,7 @@ type CreateProductRequest struct {
 
 func (x *CreateProductRequest) Reset() {
 	*x = CreateProductRequest{}
-	mi := &file_proto_product_proto_msgTypes[13]
+	mi := &file_proto_product_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1436,7 +1678,7 @@ func (x *CreateProductRequest) String() string {
 func (*CreateProductRequest) ProtoMessage() {}
 
 func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[13]
+	mi := &file_proto_product_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1449,7 +1691,7 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
 func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{13}
+	return file_proto_product_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CreateProductRequest) GetProduct() *Product {
@@ -1465,14 +1707,17 @@ type GetProductRequest struct {
 	//
 	//	*GetProductRequest_Id
 	//	*GetProductRequest_Slug
-	Identifier    isGetProductRequest_Identifier `protobuf_oneof:"identifier"`
+	Identifier isGetProductRequest_Identifier `protobuf_oneof:"identifier"`
+	// Linked products of each relationship type included, the configured
+	// limit when zero; negative leaves them out
+	RelatedLimit  int32 `protobuf:"varint,3,opt,name=related_limit,json=relatedLimit,proto3" json:"related_limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetProductRequest) Reset() {
 	*x = GetProductRequest{}
-	mi := &file_proto_product_proto_msgTypes[14]
+	mi := &file_proto_product_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1484,7 +1729,7 @@ func (x *GetProductRequest) String() string {
 func (*GetProductRequest) ProtoMessage() {}
 
 func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[14]
+	mi := &file_proto_product_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,7 +1742,7 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
 func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{14}
+	return file_proto_product_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetProductRequest) GetIdentifier() isGetProductRequest_Identifier {
@@ -1525,6 +1770,13 @@ func (x *GetProductRequest) GetSlug() string {
 	return ""
 }
 
+func (x *GetProductRequest) GetRelatedLimit() int32 {
+	if x != nil {
+		return x.RelatedLimit
+	}
+	return 0
+}
+
 type isGetProductRequest_Identifier interface {
 	isGetProductRequest_Identifier()
 }
@@ -1550,7 +1802,7 @@ type UpdateProductRequest struct {
 
 func (x *UpdateProductRequest) Reset() {
 	*x = UpdateProductRequest{}
-	mi := &file_proto_product_proto_msgTypes[15]
+	mi := &file_proto_product_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1562,7 +1814,7 @@ func (x *UpdateProductRequest) String() string {
 func (*UpdateProductRequest) ProtoMessage() {}
 
 func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[15]
+	mi := &file_proto_product_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1575,7 +1827,7 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
 func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{15}
+	return file_proto_product_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *UpdateProductRequest) GetProduct() *Product {
@@ -1594,7 +1846,7 @@ type DeleteProductRequest struct {
 
 func (x *DeleteProductRequest) Reset() {
 	*x = DeleteProductRequest{}
-	mi := &file_proto_product_proto_msgTypes[16]
+	mi := &file_proto_product_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1606,7 +1858,7 @@ func (x *DeleteProductRequest) String() string {
 func (*DeleteProductRequest) ProtoMessage() {}
 
 func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[16]
+	mi := &file_proto_product_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1619,7 +1871,7 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{16}
+	return file_proto_product_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *DeleteProductRequest) GetId() string {
@@ -1638,7 +1890,7 @@ type DeleteProductResponse struct {
 
 func (x *DeleteProductResponse) Reset() {
 	*x = DeleteProductResponse{}
-	mi := &file_proto_product_proto_msgTypes[17]
+	mi := &file_proto_product_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1650,7 +1902,7 @@ func (x *DeleteProductResponse) String() string {
 func (*DeleteProductResponse) ProtoMessage() {}
 
 func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[17]
+	mi := &file_proto_product_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,7 +1915,7 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{17}
+	return file_proto_product_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *DeleteProductResponse) GetSuccess() bool {
@@ -1677,13 +1929,14 @@ type ListProductsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
 	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"` // web, mobile or marketplace; only products visible there
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListProductsRequest) Reset() {
 	*x = ListProductsRequest{}
-	mi := &file_proto_product_proto_msgTypes[18]
+	mi := &file_proto_product_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1695,7 +1948,7 @@ func (x *ListProductsRequest) String() string {
 func (*ListProductsRequest) ProtoMessage() {}
 
 func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[18]
+	mi := &file_proto_product_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1708,7 +1961,7 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
 func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{18}
+	return file_proto_product_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ListProductsRequest) GetPage() int32 {
@@ -1725,6 +1978,13 @@ func (x *ListProductsRequest) GetLimit() int32 {
 	return 0
 }
 
+func (x *ListProductsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
 type ListProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
@@ -1735,7 +1995,7 @@ type ListProductsResponse struct {
 
 func (x *ListProductsResponse) Reset() {
 	*x = ListProductsResponse{}
-	mi := &file_proto_product_proto_msgTypes[19]
+	mi := &file_proto_product_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1747,7 +2007,7 @@ func (x *ListProductsResponse) String() string {
 func (*ListProductsResponse) ProtoMessage() {}
 
 func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[19]
+	mi := &file_proto_product_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1760,7 +2020,7 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
 func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{19}
+	return file_proto_product_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ListProductsResponse) GetProducts() []*Product {
@@ -1791,7 +2051,7 @@ type GetBrandRequest struct {
 
 func (x *GetBrandRequest) Reset() {
 	*x = GetBrandRequest{}
-	mi := &file_proto_product_proto_msgTypes[20]
+	mi := &file_proto_product_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1803,7 +2063,7 @@ func (x *GetBrandRequest) String() string {
 func (*GetBrandRequest) ProtoMessage() {}
 
 func (x *GetBrandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[20]
+	mi := &file_proto_product_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1816,7 +2076,7 @@ func (x *GetBrandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBrandRequest.ProtoReflect.Descriptor instead.
 func (*GetBrandRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{20}
+	return file_proto_product_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *GetBrandRequest) GetIdentifier() isGetBrandRequest_Identifier {
@@ -1870,7 +2130,7 @@ type ListBrandsRequest struct {
 
 func (x *ListBrandsRequest) Reset() {
 	*x = ListBrandsRequest{}
-	mi := &file_proto_product_proto_msgTypes[21]
+	mi := &file_proto_product_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1882,7 +2142,7 @@ func (x *ListBrandsRequest) String() string {
 func (*ListBrandsRequest) ProtoMessage() {}
 
 func (x *ListBrandsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[21]
+	mi := &file_proto_product_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,7 +2155,7 @@ func (x *ListBrandsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBrandsRequest.ProtoReflect.Descriptor instead.
 func (*ListBrandsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{21}
+	return file_proto_product_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ListBrandsRequest) GetPage() int32 {
@@ -1922,7 +2182,7 @@ type ListBrandsResponse struct {
 
 func (x *ListBrandsResponse) Reset() {
 	*x = ListBrandsResponse{}
-	mi := &file_proto_product_proto_msgTypes[22]
+	mi := &file_proto_product_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1934,7 +2194,7 @@ func (x *ListBrandsResponse) String() string {
 func (*ListBrandsResponse) ProtoMessage() {}
 
 func (x *ListBrandsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[22]
+	mi := &file_proto_product_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1947,7 +2207,7 @@ func (x *ListBrandsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBrandsResponse.ProtoReflect.Descriptor instead.
 func (*ListBrandsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{22}
+	return file_proto_product_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ListBrandsResponse) GetBrands() []*Brand {
@@ -1973,7 +2233,7 @@ type CreateBrandRequest struct {
 
 func (x *CreateBrandRequest) Reset() {
 	*x = CreateBrandRequest{}
-	mi := &file_proto_product_proto_msgTypes[23]
+	mi := &file_proto_product_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1985,7 +2245,7 @@ func (x *CreateBrandRequest) String() string {
 func (*CreateBrandRequest) ProtoMessage() {}
 
 func (x *CreateBrandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[23]
+	mi := &file_proto_product_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,7 +2258,7 @@ func (x *CreateBrandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBrandRequest.ProtoReflect.Descriptor instead.
 func (*CreateBrandRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{23}
+	return file_proto_product_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CreateBrandRequest) GetBrand() *Brand {
@@ -2022,7 +2282,7 @@ type GetCategoryRequest struct {
 
 func (x *GetCategoryRequest) Reset() {
 	*x = GetCategoryRequest{}
-	mi := &file_proto_product_proto_msgTypes[24]
+	mi := &file_proto_product_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2034,7 +2294,7 @@ func (x *GetCategoryRequest) String() string {
 func (*GetCategoryRequest) ProtoMessage() {}
 
 func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[24]
+	mi := &file_proto_product_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2047,7 +2307,7 @@ func (x *GetCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCategoryRequest.ProtoReflect.Descriptor instead.
 func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{24}
+	return file_proto_product_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *GetCategoryRequest) GetIdentifier() isGetCategoryRequest_Identifier {
@@ -2101,7 +2361,7 @@ type ListCategoriesRequest struct {
 
 func (x *ListCategoriesRequest) Reset() {
 	*x = ListCategoriesRequest{}
-	mi := &file_proto_product_proto_msgTypes[25]
+	mi := &file_proto_product_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2113,7 +2373,7 @@ func (x *ListCategoriesRequest) String() string {
 func (*ListCategoriesRequest) ProtoMessage() {}
 
 func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[25]
+	mi := &file_proto_product_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2126,7 +2386,7 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
 func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{25}
+	return file_proto_product_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ListCategoriesRequest) GetPage() int32 {
@@ -2153,7 +2413,7 @@ type ListCategoriesResponse struct {
 
 func (x *ListCategoriesResponse) Reset() {
 	*x = ListCategoriesResponse{}
-	mi := &file_proto_product_proto_msgTypes[26]
+	mi := &file_proto_product_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2165,7 +2425,7 @@ func (x *ListCategoriesResponse) String() string {
 func (*ListCategoriesResponse) ProtoMessage() {}
 
 func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[26]
+	mi := &file_proto_product_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,7 +2438,7 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
 func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{26}
+	return file_proto_product_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ListCategoriesResponse) GetCategories() []*Category {
@@ -2204,7 +2464,7 @@ type CreateCategoryRequest struct {
 
 func (x *CreateCategoryRequest) Reset() {
 	*x = CreateCategoryRequest{}
-	mi := &file_proto_product_proto_msgTypes[27]
+	mi := &file_proto_product_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2216,7 +2476,7 @@ func (x *CreateCategoryRequest) String() string {
 func (*CreateCategoryRequest) ProtoMessage() {}
 
 func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[27]
+	mi := &file_proto_product_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2229,7 +2489,7 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
 func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{27}
+	return file_proto_product_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *CreateCategoryRequest) GetCategory() *Category {
@@ -2239,34 +2499,30 @@ func (x *CreateCategoryRequest) GetCategory() *Category {
 	return nil
 }
 
-// Image upload related messages
-type UploadImageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	File          []byte                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
-	Folder        string                 `protobuf:"bytes,2,opt,name=folder,proto3" json:"folder,omitempty"`
-	AltText       string                 `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
-	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
-	Filename      string                 `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
-	MimeType      string                 `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+// Category tree messages
+type MoveCategoryRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"` // Unset moves the category to the root
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadImageRequest) Reset() {
-	*x = UploadImageRequest{}
-	mi := &file_proto_product_proto_msgTypes[28]
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_proto_product_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadImageRequest) String() string {
+func (x *MoveCategoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadImageRequest) ProtoMessage() {}
+func (*MoveCategoryRequest) ProtoMessage() {}
 
-func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[28]
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2277,78 +2533,101 @@ func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
-func (*UploadImageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *UploadImageRequest) GetFile() []byte {
+func (x *MoveCategoryRequest) GetId() string {
 	if x != nil {
-		return x.File
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *UploadImageRequest) GetFolder() string {
+func (x *MoveCategoryRequest) GetParentId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.Folder
+		return x.ParentId
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadImageRequest) GetAltText() string {
-	if x != nil {
-		return x.AltText
-	}
-	return ""
+type MergeCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	SourceId      string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"` // Deleted once its products and children moved to the target
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadImageRequest) GetPosition() int32 {
+func (x *MergeCategoriesRequest) Reset() {
+	*x = MergeCategoriesRequest{}
+	mi := &file_proto_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCategoriesRequest) ProtoMessage() {}
+
+func (x *MergeCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[30]
 	if x != nil {
-		return x.Position
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *UploadImageRequest) GetFilename() string {
+// Deprecated: Use MergeCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*MergeCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MergeCategoriesRequest) GetTargetId() string {
 	if x != nil {
-		return x.Filename
+		return x.TargetId
 	}
 	return ""
 }
 
-func (x *UploadImageRequest) GetMimeType() string {
+func (x *MergeCategoriesRequest) GetSourceId() string {
 	if x != nil {
-		return x.MimeType
+		return x.SourceId
 	}
 	return ""
 }
 
-type UploadImageResponse struct {
+type MergeCategoriesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	PublicId      string                 `protobuf:"bytes,2,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
-	AltText       string                 `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
-	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"` // The target
+	ProductsMoved int32                  `protobuf:"varint,2,opt,name=products_moved,json=productsMoved,proto3" json:"products_moved,omitempty"`
+	ChildrenMoved int32                  `protobuf:"varint,3,opt,name=children_moved,json=childrenMoved,proto3" json:"children_moved,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UploadImageResponse) Reset() {
-	*x = UploadImageResponse{}
-	mi := &file_proto_product_proto_msgTypes[29]
+func (x *MergeCategoriesResponse) Reset() {
+	*x = MergeCategoriesResponse{}
+	mi := &file_proto_product_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadImageResponse) String() string {
+func (x *MergeCategoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadImageResponse) ProtoMessage() {}
+func (*MergeCategoriesResponse) ProtoMessage() {}
 
-func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[29]
+func (x *MergeCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2359,61 +2638,55 @@ func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
-func (*UploadImageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{29}
-}
-
-func (x *UploadImageResponse) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
+// Deprecated: Use MergeCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*MergeCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UploadImageResponse) GetPublicId() string {
+func (x *MergeCategoriesResponse) GetCategory() *Category {
 	if x != nil {
-		return x.PublicId
+		return x.Category
 	}
-	return ""
+	return nil
 }
 
-func (x *UploadImageResponse) GetAltText() string {
+func (x *MergeCategoriesResponse) GetProductsMoved() int32 {
 	if x != nil {
-		return x.AltText
+		return x.ProductsMoved
 	}
-	return ""
+	return 0
 }
 
-func (x *UploadImageResponse) GetPosition() int32 {
+func (x *MergeCategoriesResponse) GetChildrenMoved() int32 {
 	if x != nil {
-		return x.Position
+		return x.ChildrenMoved
 	}
 	return 0
 }
 
-type DeleteImageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PublicId      string                 `protobuf:"bytes,1,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
+type ReorderSiblingsRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ParentId      *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`          // Unset reorders the root categories
+	CategoryIds   []string                `protobuf:"bytes,2,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"` // Every child of the parent, in their new order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteImageRequest) Reset() {
-	*x = DeleteImageRequest{}
-	mi := &file_proto_product_proto_msgTypes[30]
+func (x *ReorderSiblingsRequest) Reset() {
+	*x = ReorderSiblingsRequest{}
+	mi := &file_proto_product_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteImageRequest) String() string {
+func (x *ReorderSiblingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteImageRequest) ProtoMessage() {}
+func (*ReorderSiblingsRequest) ProtoMessage() {}
 
-func (x *DeleteImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[30]
+func (x *ReorderSiblingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2424,40 +2697,47 @@ func (x *DeleteImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteImageRequest.ProtoReflect.Descriptor instead.
-func (*DeleteImageRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ReorderSiblingsRequest.ProtoReflect.Descriptor instead.
+func (*ReorderSiblingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeleteImageRequest) GetPublicId() string {
+func (x *ReorderSiblingsRequest) GetParentId() *wrapperspb.StringValue {
 	if x != nil {
-		return x.PublicId
+		return x.ParentId
 	}
-	return ""
+	return nil
 }
 
-type DeleteImageResponse struct {
+func (x *ReorderSiblingsRequest) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type ReorderSiblingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"` // The children of the parent, in order
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteImageResponse) Reset() {
-	*x = DeleteImageResponse{}
-	mi := &file_proto_product_proto_msgTypes[31]
+func (x *ReorderSiblingsResponse) Reset() {
+	*x = ReorderSiblingsResponse{}
+	mi := &file_proto_product_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteImageResponse) String() string {
+func (x *ReorderSiblingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteImageResponse) ProtoMessage() {}
+func (*ReorderSiblingsResponse) ProtoMessage() {}
 
-func (x *DeleteImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[31]
+func (x *ReorderSiblingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2468,44 +2748,51 @@ func (x *DeleteImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteImageResponse.ProtoReflect.Descriptor instead.
-func (*DeleteImageResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ReorderSiblingsResponse.ProtoReflect.Descriptor instead.
+func (*ReorderSiblingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DeleteImageResponse) GetSuccess() bool {
+func (x *ReorderSiblingsResponse) GetCategories() []*Category {
 	if x != nil {
-		return x.Success
+		return x.Categories
 	}
-	return false
+	return nil
 }
 
-// SKU generation related messages
-type GenerateSKUPreviewRequest struct {
+// Category attribute messages
+type CategoryAttribute struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BrandName     string                 `protobuf:"bytes,1,opt,name=brand_name,json=brandName,proto3" json:"brand_name,omitempty"`
-	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
-	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Category defining the attribute
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`                               // Products of the category must have a value
+	Filterable    bool                   `protobuf:"varint,5,opt,name=filterable,proto3" json:"filterable,omitempty"`                           // Offered as a facet of the category
+	AllowedValues []string               `protobuf:"bytes,6,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"` // Empty allows any value
+	DefaultValue  string                 `protobuf:"bytes,7,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`    // Given to products created without a value
+	Position      int32                  `protobuf:"varint,8,opt,name=position,proto3" json:"position,omitempty"`
+	Inherited     bool                   `protobuf:"varint,9,opt,name=inherited,proto3" json:"inherited,omitempty"` // Defined by an ancestor of the listed category
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateSKUPreviewRequest) Reset() {
-	*x = GenerateSKUPreviewRequest{}
-	mi := &file_proto_product_proto_msgTypes[32]
+func (x *CategoryAttribute) Reset() {
+	*x = CategoryAttribute{}
+	mi := &file_proto_product_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateSKUPreviewRequest) String() string {
+func (x *CategoryAttribute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateSKUPreviewRequest) ProtoMessage() {}
+func (*CategoryAttribute) ProtoMessage() {}
 
-func (x *GenerateSKUPreviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[32]
+func (x *CategoryAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2516,61 +2803,110 @@ func (x *GenerateSKUPreviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateSKUPreviewRequest.ProtoReflect.Descriptor instead.
-func (*GenerateSKUPreviewRequest) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CategoryAttribute.ProtoReflect.Descriptor instead.
+func (*CategoryAttribute) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GenerateSKUPreviewRequest) GetBrandName() string {
+func (x *CategoryAttribute) GetId() string {
 	if x != nil {
-		return x.BrandName
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GenerateSKUPreviewRequest) GetCategoryName() string {
+func (x *CategoryAttribute) GetCategoryId() string {
 	if x != nil {
-		return x.CategoryName
+		return x.CategoryId
 	}
 	return ""
 }
 
-func (x *GenerateSKUPreviewRequest) GetColor() string {
+func (x *CategoryAttribute) GetName() string {
 	if x != nil {
-		return x.Color
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GenerateSKUPreviewRequest) GetSize() string {
+func (x *CategoryAttribute) GetRequired() bool {
 	if x != nil {
-		return x.Size
+		return x.Required
+	}
+	return false
+}
+
+func (x *CategoryAttribute) GetFilterable() bool {
+	if x != nil {
+		return x.Filterable
+	}
+	return false
+}
+
+func (x *CategoryAttribute) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *CategoryAttribute) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
 	}
 	return ""
 }
 
-type GenerateSKUPreviewResponse struct {
+func (x *CategoryAttribute) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *CategoryAttribute) GetInherited() bool {
+	if x != nil {
+		return x.Inherited
+	}
+	return false
+}
+
+func (x *CategoryAttribute) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CategoryAttribute) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateCategoryAttributeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Attribute     *CategoryAttribute     `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateSKUPreviewResponse) Reset() {
-	*x = GenerateSKUPreviewResponse{}
-	mi := &file_proto_product_proto_msgTypes[33]
+func (x *CreateCategoryAttributeRequest) Reset() {
+	*x = CreateCategoryAttributeRequest{}
+	mi := &file_proto_product_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateSKUPreviewResponse) String() string {
+func (x *CreateCategoryAttributeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateSKUPreviewResponse) ProtoMessage() {}
+func (*CreateCategoryAttributeRequest) ProtoMessage() {}
 
-func (x *GenerateSKUPreviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_product_proto_msgTypes[33]
+func (x *CreateCategoryAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2581,23 +2917,14006 @@ func (x *GenerateSKUPreviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateSKUPreviewResponse.ProtoReflect.Descriptor instead.
-func (*GenerateSKUPreviewResponse) Descriptor() ([]byte, []int) {
-	return file_proto_product_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use CreateCategoryAttributeRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GenerateSKUPreviewResponse) GetSku() string {
+func (x *CreateCategoryAttributeRequest) GetAttribute() *CategoryAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type UpdateCategoryAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     *CategoryAttribute     `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"` // The category and position are kept
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryAttributeRequest) Reset() {
+	*x = UpdateCategoryAttributeRequest{}
+	mi := &file_proto_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryAttributeRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryAttributeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateCategoryAttributeRequest) GetAttribute() *CategoryAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type ListCategoryAttributesRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId       string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeInherited bool                   `protobuf:"varint,2,opt,name=include_inherited,json=includeInherited,proto3" json:"include_inherited,omitempty"` // Also list the attributes of the ancestors, root first
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListCategoryAttributesRequest) Reset() {
+	*x = ListCategoryAttributesRequest{}
+	mi := &file_proto_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryAttributesRequest) ProtoMessage() {}
+
+func (x *ListCategoryAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryAttributesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoryAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListCategoryAttributesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListCategoryAttributesRequest) GetIncludeInherited() bool {
+	if x != nil {
+		return x.IncludeInherited
+	}
+	return false
+}
+
+type ListCategoryAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []*CategoryAttribute   `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoryAttributesResponse) Reset() {
+	*x = ListCategoryAttributesResponse{}
+	mi := &file_proto_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoryAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoryAttributesResponse) ProtoMessage() {}
+
+func (x *ListCategoryAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoryAttributesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoryAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListCategoryAttributesResponse) GetAttributes() []*CategoryAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type DeleteCategoryAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryAttributeRequest) Reset() {
+	*x = DeleteCategoryAttributeRequest{}
+	mi := &file_proto_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryAttributeRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteCategoryAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCategoryAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryAttributeResponse) Reset() {
+	*x = DeleteCategoryAttributeResponse{}
+	mi := &file_proto_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryAttributeResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryAttributeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteCategoryAttributeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetCategoryFacetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryFacetsRequest) Reset() {
+	*x = GetCategoryFacetsRequest{}
+	mi := &file_proto_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryFacetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryFacetsRequest) ProtoMessage() {}
+
+func (x *GetCategoryFacetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryFacetsRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryFacetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GetCategoryFacetsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type FacetValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Value         string                 `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"` // Published products of the category and its descendants
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FacetValue) Reset() {
+	*x = FacetValue{}
+	mi := &file_proto_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FacetValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FacetValue) ProtoMessage() {}
+
+func (x *FacetValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FacetValue.ProtoReflect.Descriptor instead.
+func (*FacetValue) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FacetValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *FacetValue) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Facet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []*FacetValue          `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"` // Most frequent first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Facet) Reset() {
+	*x = Facet{}
+	mi := &file_proto_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Facet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Facet) ProtoMessage() {}
+
+func (x *Facet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Facet.ProtoReflect.Descriptor instead.
+func (*Facet) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *Facet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Facet) GetValues() []*FacetValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type GetCategoryFacetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Facets        []*Facet               `protobuf:"bytes,1,rep,name=facets,proto3" json:"facets,omitempty"` // The filterable attributes of the category
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryFacetsResponse) Reset() {
+	*x = GetCategoryFacetsResponse{}
+	mi := &file_proto_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryFacetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryFacetsResponse) ProtoMessage() {}
+
+func (x *GetCategoryFacetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryFacetsResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryFacetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetCategoryFacetsResponse) GetFacets() []*Facet {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+// Image upload related messages
+type UploadImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	File          []byte                 `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	Folder        string                 `protobuf:"bytes,2,opt,name=folder,proto3" json:"folder,omitempty"`
+	AltText       string                 `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	Filename      string                 `protobuf:"bytes,5,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType      string                 `protobuf:"bytes,6,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageRequest) Reset() {
+	*x = UploadImageRequest{}
+	mi := &file_proto_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageRequest) ProtoMessage() {}
+
+func (x *UploadImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadImageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UploadImageRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *UploadImageRequest) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *UploadImageRequest) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *UploadImageRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *UploadImageRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadImageRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+type UploadImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	PublicId      string                 `protobuf:"bytes,2,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
+	AltText       string                 `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadImageResponse) Reset() {
+	*x = UploadImageResponse{}
+	mi := &file_proto_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadImageResponse) ProtoMessage() {}
+
+func (x *UploadImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadImageResponse.ProtoReflect.Descriptor instead.
+func (*UploadImageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UploadImageResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UploadImageResponse) GetPublicId() string {
+	if x != nil {
+		return x.PublicId
+	}
+	return ""
+}
+
+func (x *UploadImageResponse) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *UploadImageResponse) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type DeleteImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicId      string                 `protobuf:"bytes,1,opt,name=public_id,json=publicId,proto3" json:"public_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImageRequest) Reset() {
+	*x = DeleteImageRequest{}
+	mi := &file_proto_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImageRequest) ProtoMessage() {}
+
+func (x *DeleteImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteImageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteImageRequest) GetPublicId() string {
+	if x != nil {
+		return x.PublicId
+	}
+	return ""
+}
+
+// Direct media upload messages. Clients upload the file straight to the
+// media provider with the form fields of the upload, then confirm it to have
+// the uploaded asset validated and attached to the product.
+type CreateMediaUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MediaType     string                 `protobuf:"bytes,2,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"` // image (default), video or model_3d
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMediaUploadRequest) Reset() {
+	*x = CreateMediaUploadRequest{}
+	mi := &file_proto_product_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMediaUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMediaUploadRequest) ProtoMessage() {}
+
+func (x *CreateMediaUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMediaUploadRequest.ProtoReflect.Descriptor instead.
+func (*CreateMediaUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateMediaUploadRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateMediaUploadRequest) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+type MediaUpload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UploadUrl     string                 `protobuf:"bytes,3,opt,name=upload_url,json=uploadUrl,proto3" json:"upload_url,omitempty"`                                                    // Multipart form POST target of the file
+	Fields        map[string]string      `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Form fields to send along with the file
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	MaxBytes      int64                  `protobuf:"varint,6,opt,name=max_bytes,json=maxBytes,proto3" json:"max_bytes,omitempty"` // Larger files are refused on confirmation
+	Formats       []string               `protobuf:"bytes,7,rep,name=formats,proto3" json:"formats,omitempty"`                    // Accepted file formats, such as "png"
+	MediaType     string                 `protobuf:"bytes,8,opt,name=media_type,json=mediaType,proto3" json:"media_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MediaUpload) Reset() {
+	*x = MediaUpload{}
+	mi := &file_proto_product_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MediaUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MediaUpload) ProtoMessage() {}
+
+func (x *MediaUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MediaUpload.ProtoReflect.Descriptor instead.
+func (*MediaUpload) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MediaUpload) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MediaUpload) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *MediaUpload) GetUploadUrl() string {
+	if x != nil {
+		return x.UploadUrl
+	}
+	return ""
+}
+
+func (x *MediaUpload) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *MediaUpload) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *MediaUpload) GetMaxBytes() int64 {
+	if x != nil {
+		return x.MaxBytes
+	}
+	return 0
+}
+
+func (x *MediaUpload) GetFormats() []string {
+	if x != nil {
+		return x.Formats
+	}
+	return nil
+}
+
+func (x *MediaUpload) GetMediaType() string {
+	if x != nil {
+		return x.MediaType
+	}
+	return ""
+}
+
+type ConfirmMediaUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UploadId      string                 `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	AltText       string                 `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmMediaUploadRequest) Reset() {
+	*x = ConfirmMediaUploadRequest{}
+	mi := &file_proto_product_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmMediaUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmMediaUploadRequest) ProtoMessage() {}
+
+func (x *ConfirmMediaUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmMediaUploadRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmMediaUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ConfirmMediaUploadRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ConfirmMediaUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *ConfirmMediaUploadRequest) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *ConfirmMediaUploadRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ConfirmMediaUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"` // Set for images
+	Media         *ProductMedia          `protobuf:"bytes,2,opt,name=media,proto3" json:"media,omitempty"` // Set for videos and 3D models
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmMediaUploadResponse) Reset() {
+	*x = ConfirmMediaUploadResponse{}
+	mi := &file_proto_product_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmMediaUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmMediaUploadResponse) ProtoMessage() {}
+
+func (x *ConfirmMediaUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmMediaUploadResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmMediaUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ConfirmMediaUploadResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+func (x *ConfirmMediaUploadResponse) GetMedia() *ProductMedia {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
+type AddProductVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"` // YouTube or Vimeo video, or HTTPS video file
+	AltText       string                 `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductVideoRequest) Reset() {
+	*x = AddProductVideoRequest{}
+	mi := &file_proto_product_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductVideoRequest) ProtoMessage() {}
+
+func (x *AddProductVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductVideoRequest.ProtoReflect.Descriptor instead.
+func (*AddProductVideoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AddProductVideoRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AddProductVideoRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AddProductVideoRequest) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *AddProductVideoRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type DeleteProductMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	MediaId       string                 `protobuf:"bytes,2,opt,name=media_id,json=mediaId,proto3" json:"media_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductMediaRequest) Reset() {
+	*x = DeleteProductMediaRequest{}
+	mi := &file_proto_product_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductMediaRequest) ProtoMessage() {}
+
+func (x *DeleteProductMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductMediaRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductMediaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DeleteProductMediaRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeleteProductMediaRequest) GetMediaId() string {
+	if x != nil {
+		return x.MediaId
+	}
+	return ""
+}
+
+type DeleteProductMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductMediaResponse) Reset() {
+	*x = DeleteProductMediaResponse{}
+	mi := &file_proto_product_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductMediaResponse) ProtoMessage() {}
+
+func (x *DeleteProductMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductMediaResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductMediaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *DeleteProductMediaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DeleteImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImageResponse) Reset() {
+	*x = DeleteImageResponse{}
+	mi := &file_proto_product_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImageResponse) ProtoMessage() {}
+
+func (x *DeleteImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteImageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteImageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// SKU generation related messages
+type GenerateSKUPreviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BrandName     string                 `protobuf:"bytes,1,opt,name=brand_name,json=brandName,proto3" json:"brand_name,omitempty"`
+	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Size          string                 `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSKUPreviewRequest) Reset() {
+	*x = GenerateSKUPreviewRequest{}
+	mi := &file_proto_product_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSKUPreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSKUPreviewRequest) ProtoMessage() {}
+
+func (x *GenerateSKUPreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSKUPreviewRequest.ProtoReflect.Descriptor instead.
+func (*GenerateSKUPreviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GenerateSKUPreviewRequest) GetBrandName() string {
+	if x != nil {
+		return x.BrandName
+	}
+	return ""
+}
+
+func (x *GenerateSKUPreviewRequest) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *GenerateSKUPreviewRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *GenerateSKUPreviewRequest) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+type GenerateSKUPreviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateSKUPreviewResponse) Reset() {
+	*x = GenerateSKUPreviewResponse{}
+	mi := &file_proto_product_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateSKUPreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateSKUPreviewResponse) ProtoMessage() {}
+
+func (x *GenerateSKUPreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateSKUPreviewResponse.ProtoReflect.Descriptor instead.
+func (*GenerateSKUPreviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GenerateSKUPreviewResponse) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+// Collection related messages
+type CollectionRules struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BrandIds      []string               `protobuf:"bytes,1,rep,name=brand_ids,json=brandIds,proto3" json:"brand_ids,omitempty"`
+	CategoryIds   []string               `protobuf:"bytes,2,rep,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=tags,proto3" json:"tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CollectionRules) Reset() {
+	*x = CollectionRules{}
+	mi := &file_proto_product_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionRules) ProtoMessage() {}
+
+func (x *CollectionRules) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionRules.ProtoReflect.Descriptor instead.
+func (*CollectionRules) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *CollectionRules) GetBrandIds() []string {
+	if x != nil {
+		return x.BrandIds
+	}
+	return nil
+}
+
+func (x *CollectionRules) GetCategoryIds() []string {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+func (x *CollectionRules) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	IsPublished   bool                   `protobuf:"varint,6,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
+	Rules         *CollectionRules       `protobuf:"bytes,7,opt,name=rules,proto3" json:"rules,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,8,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // Manually curated members, in display order
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_proto_product_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *Collection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collection) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Collection) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Collection) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Collection) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Collection) GetIsPublished() bool {
+	if x != nil {
+		return x.IsPublished
+	}
+	return false
+}
+
+func (x *Collection) GetRules() *CollectionRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *Collection) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *Collection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Collection) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+type CreateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCollectionRequest) Reset() {
+	*x = CreateCollectionRequest{}
+	mi := &file_proto_product_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCollectionRequest) ProtoMessage() {}
+
+func (x *CreateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateCollectionRequest) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type GetCollectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetCollectionRequest_Id
+	//	*GetCollectionRequest_Slug
+	Identifier    isGetCollectionRequest_Identifier `protobuf_oneof:"identifier"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_proto_product_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionRequest) ProtoMessage() {}
+
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetCollectionRequest) GetIdentifier() isGetCollectionRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *GetCollectionRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetCollectionRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *GetCollectionRequest) GetSlug() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetCollectionRequest_Slug); ok {
+			return x.Slug
+		}
+	}
+	return ""
+}
+
+type isGetCollectionRequest_Identifier interface {
+	isGetCollectionRequest_Identifier()
+}
+
+type GetCollectionRequest_Id struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type GetCollectionRequest_Slug struct {
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3,oneof"`
+}
+
+func (*GetCollectionRequest_Id) isGetCollectionRequest_Identifier() {}
+
+func (*GetCollectionRequest_Slug) isGetCollectionRequest_Identifier() {}
+
+type UpdateCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCollectionRequest) Reset() {
+	*x = UpdateCollectionRequest{}
+	mi := &file_proto_product_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCollectionRequest) ProtoMessage() {}
+
+func (x *UpdateCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCollectionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UpdateCollectionRequest) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type DeleteCollectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionRequest) Reset() {
+	*x = DeleteCollectionRequest{}
+	mi := &file_proto_product_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionRequest) ProtoMessage() {}
+
+func (x *DeleteCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *DeleteCollectionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCollectionResponse) Reset() {
+	*x = DeleteCollectionResponse{}
+	mi := &file_proto_product_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCollectionResponse) ProtoMessage() {}
+
+func (x *DeleteCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCollectionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DeleteCollectionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListCollectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PublishedOnly bool                   `protobuf:"varint,3,opt,name=published_only,json=publishedOnly,proto3" json:"published_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_proto_product_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListCollectionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetPublishedOnly() bool {
+	if x != nil {
+		return x.PublishedOnly
+	}
+	return false
+}
+
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"` // Page and limit applied, after defaults and caps
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_proto_product_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListCollectionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCollectionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCollectionsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SetCollectionProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CollectionId  string                 `protobuf:"bytes,1,opt,name=collection_id,json=collectionId,proto3" json:"collection_id,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,2,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // Replaces the manual members; order is preserved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCollectionProductsRequest) Reset() {
+	*x = SetCollectionProductsRequest{}
+	mi := &file_proto_product_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCollectionProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCollectionProductsRequest) ProtoMessage() {}
+
+func (x *SetCollectionProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCollectionProductsRequest.ProtoReflect.Descriptor instead.
+func (*SetCollectionProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetCollectionProductsRequest) GetCollectionId() string {
+	if x != nil {
+		return x.CollectionId
+	}
+	return ""
+}
+
+func (x *SetCollectionProductsRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type ListCollectionProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*ListCollectionProductsRequest_Id
+	//	*ListCollectionProductsRequest_Slug
+	Identifier    isListCollectionProductsRequest_Identifier `protobuf_oneof:"identifier"`
+	Page          int32                                      `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                                      `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionProductsRequest) Reset() {
+	*x = ListCollectionProductsRequest{}
+	mi := &file_proto_product_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionProductsRequest) ProtoMessage() {}
+
+func (x *ListCollectionProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListCollectionProductsRequest) GetIdentifier() isListCollectionProductsRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *ListCollectionProductsRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*ListCollectionProductsRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *ListCollectionProductsRequest) GetSlug() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*ListCollectionProductsRequest_Slug); ok {
+			return x.Slug
+		}
+	}
+	return ""
+}
+
+func (x *ListCollectionProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCollectionProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type isListCollectionProductsRequest_Identifier interface {
+	isListCollectionProductsRequest_Identifier()
+}
+
+type ListCollectionProductsRequest_Id struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"`
+}
+
+type ListCollectionProductsRequest_Slug struct {
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3,oneof"`
+}
+
+func (*ListCollectionProductsRequest_Id) isListCollectionProductsRequest_Identifier() {}
+
+func (*ListCollectionProductsRequest_Slug) isListCollectionProductsRequest_Identifier() {}
+
+type ListCollectionProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	Total         int32                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"` // Page and limit applied, after defaults and caps
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionProductsResponse) Reset() {
+	*x = ListCollectionProductsResponse{}
+	mi := &file_proto_product_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionProductsResponse) ProtoMessage() {}
+
+func (x *ListCollectionProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListCollectionProductsResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+func (x *ListCollectionProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListCollectionProductsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCollectionProductsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCollectionProductsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Bundle related messages
+type BundleComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`                   // Units of this SKU in one bundle
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // Resolved from the component variant
+	VariantId     string                 `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Title         string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleComponent) Reset() {
+	*x = BundleComponent{}
+	mi := &file_proto_product_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleComponent) ProtoMessage() {}
+
+func (x *BundleComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleComponent.ProtoReflect.Descriptor instead.
+func (*BundleComponent) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *BundleComponent) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BundleComponent) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *BundleComponent) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+type ProductBundle struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Components      []*BundleComponent      `protobuf:"bytes,1,rep,name=components,proto3" json:"components,omitempty"`
+	PriceOverride   *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=price_override,json=priceOverride,proto3" json:"price_override,omitempty"`
+	ComponentsPrice float64                 `protobuf:"fixed64,3,opt,name=components_price,json=componentsPrice,proto3" json:"components_price,omitempty"` // Sum of the component prices; used as the price without an override
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ProductBundle) Reset() {
+	*x = ProductBundle{}
+	mi := &file_proto_product_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductBundle) ProtoMessage() {}
+
+func (x *ProductBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductBundle.ProtoReflect.Descriptor instead.
+func (*ProductBundle) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ProductBundle) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *ProductBundle) GetPriceOverride() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.PriceOverride
+	}
+	return nil
+}
+
+func (x *ProductBundle) GetComponentsPrice() float64 {
+	if x != nil {
+		return x.ComponentsPrice
+	}
+	return 0
+}
+
+type CreateBundleRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Product       *Product                `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`       // Bundle details; price and variants are derived from the bundle
+	Components    []*BundleComponent      `protobuf:"bytes,2,rep,name=components,proto3" json:"components,omitempty"` // Only sku and quantity are read
+	PriceOverride *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=price_override,json=priceOverride,proto3" json:"price_override,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBundleRequest) Reset() {
+	*x = CreateBundleRequest{}
+	mi := &file_proto_product_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBundleRequest) ProtoMessage() {}
+
+func (x *CreateBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBundleRequest.ProtoReflect.Descriptor instead.
+func (*CreateBundleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateBundleRequest) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *CreateBundleRequest) GetComponents() []*BundleComponent {
+	if x != nil {
+		return x.Components
+	}
+	return nil
+}
+
+func (x *CreateBundleRequest) GetPriceOverride() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.PriceOverride
+	}
+	return nil
+}
+
+// Digital product messages
+type DigitalAsset struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	FileName      string                 `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string                 `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,5,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	DownloadLimit int32                  `protobuf:"varint,6,opt,name=download_limit,json=downloadLimit,proto3" json:"download_limit,omitempty"` // Downloads allowed per purchase
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DigitalAsset) Reset() {
+	*x = DigitalAsset{}
+	mi := &file_proto_product_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigitalAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigitalAsset) ProtoMessage() {}
+
+func (x *DigitalAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigitalAsset.ProtoReflect.Descriptor instead.
+func (*DigitalAsset) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DigitalAsset) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DigitalAsset) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DigitalAsset) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DigitalAsset) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DigitalAsset) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *DigitalAsset) GetDownloadLimit() int32 {
+	if x != nil {
+		return x.DownloadLimit
+	}
+	return 0
+}
+
+func (x *DigitalAsset) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DigitalAsset) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type UploadDigitalAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	File          []byte                 `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	MimeType      string                 `protobuf:"bytes,4,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"`
+	DownloadLimit int32                  `protobuf:"varint,5,opt,name=download_limit,json=downloadLimit,proto3" json:"download_limit,omitempty"` // Uses the configured default when 0
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadDigitalAssetRequest) Reset() {
+	*x = UploadDigitalAssetRequest{}
+	mi := &file_proto_product_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadDigitalAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDigitalAssetRequest) ProtoMessage() {}
+
+func (x *UploadDigitalAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDigitalAssetRequest.ProtoReflect.Descriptor instead.
+func (*UploadDigitalAssetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UploadDigitalAssetRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UploadDigitalAssetRequest) GetFile() []byte {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *UploadDigitalAssetRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *UploadDigitalAssetRequest) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *UploadDigitalAssetRequest) GetDownloadLimit() int32 {
+	if x != nil {
+		return x.DownloadLimit
+	}
+	return 0
+}
+
+type CreateDownloadLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	PurchaseId    string                 `protobuf:"bytes,2,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"` // Order or payment reference the download is granted for
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDownloadLinkRequest) Reset() {
+	*x = CreateDownloadLinkRequest{}
+	mi := &file_proto_product_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDownloadLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDownloadLinkRequest) ProtoMessage() {}
+
+func (x *CreateDownloadLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDownloadLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateDownloadLinkRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateDownloadLinkRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateDownloadLinkRequest) GetPurchaseId() string {
+	if x != nil {
+		return x.PurchaseId
+	}
+	return ""
+}
+
+func (x *CreateDownloadLinkRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DownloadLink struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Url                string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Token              string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	DownloadsRemaining int32                  `protobuf:"varint,4,opt,name=downloads_remaining,json=downloadsRemaining,proto3" json:"downloads_remaining,omitempty"`
+	DownloadLimit      int32                  `protobuf:"varint,5,opt,name=download_limit,json=downloadLimit,proto3" json:"download_limit,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DownloadLink) Reset() {
+	*x = DownloadLink{}
+	mi := &file_proto_product_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadLink) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadLink) ProtoMessage() {}
+
+func (x *DownloadLink) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadLink.ProtoReflect.Descriptor instead.
+func (*DownloadLink) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DownloadLink) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DownloadLink) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *DownloadLink) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *DownloadLink) GetDownloadsRemaining() int32 {
+	if x != nil {
+		return x.DownloadsRemaining
+	}
+	return 0
+}
+
+func (x *DownloadLink) GetDownloadLimit() int32 {
+	if x != nil {
+		return x.DownloadLimit
+	}
+	return 0
+}
+
+type DownloadDigitalAssetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadDigitalAssetRequest) Reset() {
+	*x = DownloadDigitalAssetRequest{}
+	mi := &file_proto_product_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadDigitalAssetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadDigitalAssetRequest) ProtoMessage() {}
+
+func (x *DownloadDigitalAssetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadDigitalAssetRequest.ProtoReflect.Descriptor instead.
+func (*DownloadDigitalAssetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DownloadDigitalAssetRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DigitalAssetChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// File details are only set on the first chunk
+	FileName           string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType        string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes          int64  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	DownloadsRemaining int32  `protobuf:"varint,4,opt,name=downloads_remaining,json=downloadsRemaining,proto3" json:"downloads_remaining,omitempty"`
+	Data               []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DigitalAssetChunk) Reset() {
+	*x = DigitalAssetChunk{}
+	mi := &file_proto_product_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DigitalAssetChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DigitalAssetChunk) ProtoMessage() {}
+
+func (x *DigitalAssetChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DigitalAssetChunk.ProtoReflect.Descriptor instead.
+func (*DigitalAssetChunk) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DigitalAssetChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *DigitalAssetChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *DigitalAssetChunk) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *DigitalAssetChunk) GetDownloadsRemaining() int32 {
+	if x != nil {
+		return x.DownloadsRemaining
+	}
+	return 0
+}
+
+func (x *DigitalAssetChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Subscription messages
+type SubscriptionPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`                                 // day, week, month or year
+	IntervalCount int32                  `protobuf:"varint,3,opt,name=interval_count,json=intervalCount,proto3" json:"interval_count,omitempty"` // Number of intervals per billing period
+	TrialDays     int32                  `protobuf:"varint,4,opt,name=trial_days,json=trialDays,proto3" json:"trial_days,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscriptionPlan) Reset() {
+	*x = SubscriptionPlan{}
+	mi := &file_proto_product_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionPlan) ProtoMessage() {}
+
+func (x *SubscriptionPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionPlan.ProtoReflect.Descriptor instead.
+func (*SubscriptionPlan) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SubscriptionPlan) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *SubscriptionPlan) GetIntervalCount() int32 {
+	if x != nil {
+		return x.IntervalCount
+	}
+	return 0
+}
+
+func (x *SubscriptionPlan) GetTrialDays() int32 {
+	if x != nil {
+		return x.TrialDays
+	}
+	return 0
+}
+
+func (x *SubscriptionPlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SubscriptionPlan) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetSubscriptionPlanRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Interval      string                 `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	IntervalCount int32                  `protobuf:"varint,3,opt,name=interval_count,json=intervalCount,proto3" json:"interval_count,omitempty"` // Defaults to 1
+	TrialDays     int32                  `protobuf:"varint,4,opt,name=trial_days,json=trialDays,proto3" json:"trial_days,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSubscriptionPlanRequest) Reset() {
+	*x = SetSubscriptionPlanRequest{}
+	mi := &file_proto_product_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSubscriptionPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSubscriptionPlanRequest) ProtoMessage() {}
+
+func (x *SetSubscriptionPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSubscriptionPlanRequest.ProtoReflect.Descriptor instead.
+func (*SetSubscriptionPlanRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SetSubscriptionPlanRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetSubscriptionPlanRequest) GetInterval() string {
+	if x != nil {
+		return x.Interval
+	}
+	return ""
+}
+
+func (x *SetSubscriptionPlanRequest) GetIntervalCount() int32 {
+	if x != nil {
+		return x.IntervalCount
+	}
+	return 0
+}
+
+func (x *SetSubscriptionPlanRequest) GetTrialDays() int32 {
+	if x != nil {
+		return x.TrialDays
+	}
+	return 0
+}
+
+type Subscription struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId          string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId             string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PurchaseId         string                 `protobuf:"bytes,4,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"`
+	Status             string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"` // trialing, active, past_due or cancelled
+	Price              float64                `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"` // Charged every billing period
+	Currency           string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	CurrentPeriodStart *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=current_period_start,json=currentPeriodStart,proto3" json:"current_period_start,omitempty"`
+	CurrentPeriodEnd   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=current_period_end,json=currentPeriodEnd,proto3" json:"current_period_end,omitempty"`
+	TrialEndsAt        *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=trial_ends_at,json=trialEndsAt,proto3" json:"trial_ends_at,omitempty"`
+	CancelAtPeriodEnd  bool                   `protobuf:"varint,11,opt,name=cancel_at_period_end,json=cancelAtPeriodEnd,proto3" json:"cancel_at_period_end,omitempty"`
+	CancelledAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=cancelled_at,json=cancelledAt,proto3" json:"cancelled_at,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	mi := &file_proto_product_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *Subscription) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Subscription) GetPurchaseId() string {
+	if x != nil {
+		return x.PurchaseId
+	}
+	return ""
+}
+
+func (x *Subscription) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Subscription) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Subscription) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Subscription) GetCurrentPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentPeriodStart
+	}
+	return nil
+}
+
+func (x *Subscription) GetCurrentPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CurrentPeriodEnd
+	}
+	return nil
+}
+
+func (x *Subscription) GetTrialEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TrialEndsAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetCancelAtPeriodEnd() bool {
+	if x != nil {
+		return x.CancelAtPeriodEnd
+	}
+	return false
+}
+
+func (x *Subscription) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Subscription) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PurchaseId    string                 `protobuf:"bytes,3,opt,name=purchase_id,json=purchaseId,proto3" json:"purchase_id,omitempty"` // Order or payment reference of the initial purchase
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                       // Defaults to USD
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	mi := &file_proto_product_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateSubscriptionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetPurchaseId() string {
+	if x != nil {
+		return x.PurchaseId
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSubscriptionRequest) Reset() {
+	*x = GetSubscriptionRequest{}
+	mi := &file_proto_product_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubscriptionRequest) ProtoMessage() {}
+
+func (x *GetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*GetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelSubscriptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AtPeriodEnd   bool                   `protobuf:"varint,2,opt,name=at_period_end,json=atPeriodEnd,proto3" json:"at_period_end,omitempty"` // Keep the subscription until the paid period ends
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelSubscriptionRequest) Reset() {
+	*x = CancelSubscriptionRequest{}
+	mi := &file_proto_product_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *CancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CancelSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CancelSubscriptionRequest) GetAtPeriodEnd() bool {
+	if x != nil {
+		return x.AtPeriodEnd
+	}
+	return false
+}
+
+type ListSubscriptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	mi := &file_proto_product_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ListSubscriptionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListSubscriptionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListSubscriptionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSubscriptionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscriptions []*Subscription        `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	mi := &file_proto_product_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+func (x *ListSubscriptionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SubscriptionEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SubscriptionId string                 `protobuf:"bytes,2,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // subscription.created, subscription.renewal_due or subscription.cancelled
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId      string                 `protobuf:"bytes,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Amount         float64                `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"` // Amount to charge; 0 when nothing is due
+	Currency       string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	PeriodStart    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=period_start,json=periodStart,proto3" json:"period_start,omitempty"`
+	PeriodEnd      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=period_end,json=periodEnd,proto3" json:"period_end,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubscriptionEvent) Reset() {
+	*x = SubscriptionEvent{}
+	mi := &file_proto_product_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscriptionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionEvent) ProtoMessage() {}
+
+func (x *SubscriptionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionEvent.ProtoReflect.Descriptor instead.
+func (*SubscriptionEvent) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SubscriptionEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *SubscriptionEvent) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SubscriptionEvent) GetPeriodStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStart
+	}
+	return nil
+}
+
+func (x *SubscriptionEvent) GetPeriodEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodEnd
+	}
+	return nil
+}
+
+func (x *SubscriptionEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSubscriptionEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionEventsRequest) Reset() {
+	*x = ListSubscriptionEventsRequest{}
+	mi := &file_proto_product_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionEventsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListSubscriptionEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSubscriptionEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*SubscriptionEvent   `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubscriptionEventsResponse) Reset() {
+	*x = ListSubscriptionEventsResponse{}
+	mi := &file_proto_product_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubscriptionEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionEventsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListSubscriptionEventsResponse) GetEvents() []*SubscriptionEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type AckSubscriptionEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventIds      []string               `protobuf:"bytes,1,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckSubscriptionEventsRequest) Reset() {
+	*x = AckSubscriptionEventsRequest{}
+	mi := &file_proto_product_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckSubscriptionEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckSubscriptionEventsRequest) ProtoMessage() {}
+
+func (x *AckSubscriptionEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckSubscriptionEventsRequest.ProtoReflect.Descriptor instead.
+func (*AckSubscriptionEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *AckSubscriptionEventsRequest) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+type AckSubscriptionEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acknowledged  int32                  `protobuf:"varint,1,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckSubscriptionEventsResponse) Reset() {
+	*x = AckSubscriptionEventsResponse{}
+	mi := &file_proto_product_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckSubscriptionEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckSubscriptionEventsResponse) ProtoMessage() {}
+
+func (x *AckSubscriptionEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckSubscriptionEventsResponse.ProtoReflect.Descriptor instead.
+func (*AckSubscriptionEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *AckSubscriptionEventsResponse) GetAcknowledged() int32 {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return 0
+}
+
+// Personalization messages. Options are the inputs buyers give to
+// personalize a product; the validated values are stored with the cart and
+// order lines of the product.
+type PersonalizationOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`     // Empty for new options when setting them
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // Shown to buyers, such as "Engraving"
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // text or file
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	MaxLength     int32                  `protobuf:"varint,5,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"` // Characters of text options, 100 by default
+	FileTypes     []string               `protobuf:"bytes,6,rep,name=file_types,json=fileTypes,proto3" json:"file_types,omitempty"`  // Extensions accepted by file options, such as "png"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonalizationOption) Reset() {
+	*x = PersonalizationOption{}
+	mi := &file_proto_product_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalizationOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalizationOption) ProtoMessage() {}
+
+func (x *PersonalizationOption) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalizationOption.ProtoReflect.Descriptor instead.
+func (*PersonalizationOption) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PersonalizationOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PersonalizationOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonalizationOption) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PersonalizationOption) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *PersonalizationOption) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *PersonalizationOption) GetFileTypes() []string {
+	if x != nil {
+		return x.FileTypes
+	}
+	return nil
+}
+
+type PersonalizationValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionId      string                 `protobuf:"bytes,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                         // Set from the option on validated values
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`                         // Value of text options
+	FileUrl       string                 `protobuf:"bytes,4,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`    // Uploaded file of file options
+	FileName      string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"` // Defaults to the last segment of the file URL
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonalizationValue) Reset() {
+	*x = PersonalizationValue{}
+	mi := &file_proto_product_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalizationValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalizationValue) ProtoMessage() {}
+
+func (x *PersonalizationValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalizationValue.ProtoReflect.Descriptor instead.
+func (*PersonalizationValue) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PersonalizationValue) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+// Replaces the options of a product; options without an ID are added
+type SetPersonalizationOptionsRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	ProductId     string                   `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Options       []*PersonalizationOption `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetPersonalizationOptionsRequest) Reset() {
+	*x = SetPersonalizationOptionsRequest{}
+	mi := &file_proto_product_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetPersonalizationOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPersonalizationOptionsRequest) ProtoMessage() {}
+
+func (x *SetPersonalizationOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPersonalizationOptionsRequest.ProtoReflect.Descriptor instead.
+func (*SetPersonalizationOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *SetPersonalizationOptionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetPersonalizationOptionsRequest) GetOptions() []*PersonalizationOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type PersonalizationOptionsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Options       []*PersonalizationOption `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonalizationOptionsResponse) Reset() {
+	*x = PersonalizationOptionsResponse{}
+	mi := &file_proto_product_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalizationOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalizationOptionsResponse) ProtoMessage() {}
+
+func (x *PersonalizationOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalizationOptionsResponse.ProtoReflect.Descriptor instead.
+func (*PersonalizationOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *PersonalizationOptionsResponse) GetOptions() []*PersonalizationOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ValidatePersonalizationRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ProductId     string                  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Values        []*PersonalizationValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatePersonalizationRequest) Reset() {
+	*x = ValidatePersonalizationRequest{}
+	mi := &file_proto_product_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePersonalizationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePersonalizationRequest) ProtoMessage() {}
+
+func (x *ValidatePersonalizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePersonalizationRequest.ProtoReflect.Descriptor instead.
+func (*ValidatePersonalizationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ValidatePersonalizationRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ValidatePersonalizationRequest) GetValues() []*PersonalizationValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// The values to store, in option order; optional options left empty are
+// dropped
+type ValidatePersonalizationResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Values        []*PersonalizationValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidatePersonalizationResponse) Reset() {
+	*x = ValidatePersonalizationResponse{}
+	mi := &file_proto_product_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidatePersonalizationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidatePersonalizationResponse) ProtoMessage() {}
+
+func (x *ValidatePersonalizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidatePersonalizationResponse.ProtoReflect.Descriptor instead.
+func (*ValidatePersonalizationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ValidatePersonalizationResponse) GetValues() []*PersonalizationValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Channel visibility messages
+type ProductChannel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Channel       string                 `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"` // web, mobile or marketplace
+	IsVisible     bool                   `protobuf:"varint,2,opt,name=is_visible,json=isVisible,proto3" json:"is_visible,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductChannel) Reset() {
+	*x = ProductChannel{}
+	mi := &file_proto_product_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductChannel) ProtoMessage() {}
+
+func (x *ProductChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductChannel.ProtoReflect.Descriptor instead.
+func (*ProductChannel) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ProductChannel) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ProductChannel) GetIsVisible() bool {
+	if x != nil {
+		return x.IsVisible
+	}
+	return false
+}
+
+func (x *ProductChannel) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetProductChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Channels      []*ProductChannel      `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"` // Channels left out keep their visibility
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetProductChannelsRequest) Reset() {
+	*x = SetProductChannelsRequest{}
+	mi := &file_proto_product_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetProductChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProductChannelsRequest) ProtoMessage() {}
+
+func (x *SetProductChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProductChannelsRequest.ProtoReflect.Descriptor instead.
+func (*SetProductChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *SetProductChannelsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetProductChannelsRequest) GetChannels() []*ProductChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type GetProductChannelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductChannelsRequest) Reset() {
+	*x = GetProductChannelsRequest{}
+	mi := &file_proto_product_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductChannelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductChannelsRequest) ProtoMessage() {}
+
+func (x *GetProductChannelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductChannelsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductChannelsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetProductChannelsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ProductChannelsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Channels      []*ProductChannel      `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductChannelsResponse) Reset() {
+	*x = ProductChannelsResponse{}
+	mi := &file_proto_product_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductChannelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductChannelsResponse) ProtoMessage() {}
+
+func (x *ProductChannelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ProductChannelsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ProductChannelsResponse) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductChannelsResponse) GetChannels() []*ProductChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// Store messages
+type Store struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Tenant ID, a lowercase slug
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Domain          string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"` // Host name the gateway resolves to this store
+	DefaultCurrency string                 `protobuf:"bytes,4,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"`
+	DefaultLocale   string                 `protobuf:"bytes,5,opt,name=default_locale,json=defaultLocale,proto3" json:"default_locale,omitempty"`
+	IsActive        bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Store) Reset() {
+	*x = Store{}
+	mi := &file_proto_product_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Store) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Store) ProtoMessage() {}
+
+func (x *Store) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Store.ProtoReflect.Descriptor instead.
+func (*Store) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *Store) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Store) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Store) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *Store) GetDefaultCurrency() string {
+	if x != nil {
+		return x.DefaultCurrency
+	}
+	return ""
+}
+
+func (x *Store) GetDefaultLocale() string {
+	if x != nil {
+		return x.DefaultLocale
+	}
+	return ""
+}
+
+func (x *Store) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Store) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Store) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateStoreRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Domain          string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	DefaultCurrency string                 `protobuf:"bytes,4,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"` // Defaults to USD
+	DefaultLocale   string                 `protobuf:"bytes,5,opt,name=default_locale,json=defaultLocale,proto3" json:"default_locale,omitempty"`       // Defaults to en
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateStoreRequest) Reset() {
+	*x = CreateStoreRequest{}
+	mi := &file_proto_product_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStoreRequest) ProtoMessage() {}
+
+func (x *CreateStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStoreRequest.ProtoReflect.Descriptor instead.
+func (*CreateStoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CreateStoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetDefaultCurrency() string {
+	if x != nil {
+		return x.DefaultCurrency
+	}
+	return ""
+}
+
+func (x *CreateStoreRequest) GetDefaultLocale() string {
+	if x != nil {
+		return x.DefaultLocale
+	}
+	return ""
+}
+
+type GetStoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStoreRequest) Reset() {
+	*x = GetStoreRequest{}
+	mi := &file_proto_product_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStoreRequest) ProtoMessage() {}
+
+func (x *GetStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStoreRequest.ProtoReflect.Descriptor instead.
+func (*GetStoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetStoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListStoresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStoresRequest) Reset() {
+	*x = ListStoresRequest{}
+	mi := &file_proto_product_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStoresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoresRequest) ProtoMessage() {}
+
+func (x *ListStoresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoresRequest.ProtoReflect.Descriptor instead.
+func (*ListStoresRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{105}
+}
+
+type ListStoresResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stores        []*Store               `protobuf:"bytes,1,rep,name=stores,proto3" json:"stores,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStoresResponse) Reset() {
+	*x = ListStoresResponse{}
+	mi := &file_proto_product_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStoresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStoresResponse) ProtoMessage() {}
+
+func (x *ListStoresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStoresResponse.ProtoReflect.Descriptor instead.
+func (*ListStoresResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ListStoresResponse) GetStores() []*Store {
+	if x != nil {
+		return x.Stores
+	}
+	return nil
+}
+
+type UpdateStoreRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Domain          string                 `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	DefaultCurrency string                 `protobuf:"bytes,4,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"`
+	DefaultLocale   string                 `protobuf:"bytes,5,opt,name=default_locale,json=defaultLocale,proto3" json:"default_locale,omitempty"`
+	IsActive        bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateStoreRequest) Reset() {
+	*x = UpdateStoreRequest{}
+	mi := &file_proto_product_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateStoreRequest) ProtoMessage() {}
+
+func (x *UpdateStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateStoreRequest.ProtoReflect.Descriptor instead.
+func (*UpdateStoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *UpdateStoreRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateStoreRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateStoreRequest) GetDomain() string {
+	if x != nil {
+		return x.Domain
+	}
+	return ""
+}
+
+func (x *UpdateStoreRequest) GetDefaultCurrency() string {
+	if x != nil {
+		return x.DefaultCurrency
+	}
+	return ""
+}
+
+func (x *UpdateStoreRequest) GetDefaultLocale() string {
+	if x != nil {
+		return x.DefaultLocale
+	}
+	return ""
+}
+
+func (x *UpdateStoreRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+// Marketplace feed messages
+type ProductFeed struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"` // google_merchant or facebook_catalog
+	ProductCount  int32                  `protobuf:"varint,2,opt,name=product_count,json=productCount,proto3" json:"product_count,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"` // Signed link marketplaces fetch the feed from
+	UrlExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=url_expires_at,json=urlExpiresAt,proto3" json:"url_expires_at,omitempty"`
+	GeneratedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=generated_at,json=generatedAt,proto3" json:"generated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductFeed) Reset() {
+	*x = ProductFeed{}
+	mi := &file_proto_product_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductFeed) ProtoMessage() {}
+
+func (x *ProductFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductFeed.ProtoReflect.Descriptor instead.
+func (*ProductFeed) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ProductFeed) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ProductFeed) GetProductCount() int32 {
+	if x != nil {
+		return x.ProductCount
+	}
+	return 0
+}
+
+func (x *ProductFeed) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ProductFeed) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProductFeed) GetUrlExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UrlExpiresAt
+	}
+	return nil
+}
+
+func (x *ProductFeed) GetGeneratedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GeneratedAt
+	}
+	return nil
+}
+
+type ListProductFeedsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductFeedsRequest) Reset() {
+	*x = ListProductFeedsRequest{}
+	mi := &file_proto_product_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductFeedsRequest) ProtoMessage() {}
+
+func (x *ListProductFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductFeedsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{109}
+}
+
+type ListProductFeedsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Feeds         []*ProductFeed         `protobuf:"bytes,1,rep,name=feeds,proto3" json:"feeds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductFeedsResponse) Reset() {
+	*x = ListProductFeedsResponse{}
+	mi := &file_proto_product_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductFeedsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductFeedsResponse) ProtoMessage() {}
+
+func (x *ListProductFeedsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductFeedsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductFeedsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ListProductFeedsResponse) GetFeeds() []*ProductFeed {
+	if x != nil {
+		return x.Feeds
+	}
+	return nil
+}
+
+type GenerateProductFeedsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateProductFeedsRequest) Reset() {
+	*x = GenerateProductFeedsRequest{}
+	mi := &file_proto_product_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateProductFeedsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateProductFeedsRequest) ProtoMessage() {}
+
+func (x *GenerateProductFeedsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateProductFeedsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateProductFeedsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{111}
+}
+
+type DownloadProductFeedRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadProductFeedRequest) Reset() {
+	*x = DownloadProductFeedRequest{}
+	mi := &file_proto_product_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadProductFeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadProductFeedRequest) ProtoMessage() {}
+
+func (x *DownloadProductFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadProductFeedRequest.ProtoReflect.Descriptor instead.
+func (*DownloadProductFeedRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *DownloadProductFeedRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ProductFeedChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// File details are only set on the first chunk
+	FileName      string `protobuf:"bytes,1,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	ContentType   string `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Data          []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductFeedChunk) Reset() {
+	*x = ProductFeedChunk{}
+	mi := &file_proto_product_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductFeedChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductFeedChunk) ProtoMessage() {}
+
+func (x *ProductFeedChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductFeedChunk.ProtoReflect.Descriptor instead.
+func (*ProductFeedChunk) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *ProductFeedChunk) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *ProductFeedChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ProductFeedChunk) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ProductFeedChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ERP sync messages
+type ErpSyncRun struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Connector      string                 `protobuf:"bytes,2,opt,name=connector,proto3" json:"connector,omitempty"`
+	Entity         string                 `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`       // products, prices or stock
+	Direction      string                 `protobuf:"bytes,4,opt,name=direction,proto3" json:"direction,omitempty"` // pull or push
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`       // running, succeeded or failed
+	RecordsRead    int32                  `protobuf:"varint,6,opt,name=records_read,json=recordsRead,proto3" json:"records_read,omitempty"`
+	RecordsApplied int32                  `protobuf:"varint,7,opt,name=records_applied,json=recordsApplied,proto3" json:"records_applied,omitempty"`
+	RecordsSkipped int32                  `protobuf:"varint,8,opt,name=records_skipped,json=recordsSkipped,proto3" json:"records_skipped,omitempty"`
+	Conflicts      int32                  `protobuf:"varint,9,opt,name=conflicts,proto3" json:"conflicts,omitempty"`
+	Error          string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt      *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ErpSyncRun) Reset() {
+	*x = ErpSyncRun{}
+	mi := &file_proto_product_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErpSyncRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErpSyncRun) ProtoMessage() {}
+
+func (x *ErpSyncRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErpSyncRun.ProtoReflect.Descriptor instead.
+func (*ErpSyncRun) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *ErpSyncRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ErpSyncRun) GetConnector() string {
+	if x != nil {
+		return x.Connector
+	}
+	return ""
+}
+
+func (x *ErpSyncRun) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ErpSyncRun) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ErpSyncRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ErpSyncRun) GetRecordsRead() int32 {
+	if x != nil {
+		return x.RecordsRead
+	}
+	return 0
+}
+
+func (x *ErpSyncRun) GetRecordsApplied() int32 {
+	if x != nil {
+		return x.RecordsApplied
+	}
+	return 0
+}
+
+func (x *ErpSyncRun) GetRecordsSkipped() int32 {
+	if x != nil {
+		return x.RecordsSkipped
+	}
+	return 0
+}
+
+func (x *ErpSyncRun) GetConflicts() int32 {
+	if x != nil {
+		return x.Conflicts
+	}
+	return 0
+}
+
+func (x *ErpSyncRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ErpSyncRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ErpSyncRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type RunErpSyncRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunErpSyncRequest) Reset() {
+	*x = RunErpSyncRequest{}
+	mi := &file_proto_product_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunErpSyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunErpSyncRequest) ProtoMessage() {}
+
+func (x *RunErpSyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunErpSyncRequest.ProtoReflect.Descriptor instead.
+func (*RunErpSyncRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{115}
+}
+
+type ListErpSyncRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 50
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListErpSyncRunsRequest) Reset() {
+	*x = ListErpSyncRunsRequest{}
+	mi := &file_proto_product_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListErpSyncRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListErpSyncRunsRequest) ProtoMessage() {}
+
+func (x *ListErpSyncRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListErpSyncRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListErpSyncRunsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListErpSyncRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListErpSyncRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*ErpSyncRun          `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListErpSyncRunsResponse) Reset() {
+	*x = ListErpSyncRunsResponse{}
+	mi := &file_proto_product_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListErpSyncRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListErpSyncRunsResponse) ProtoMessage() {}
+
+func (x *ListErpSyncRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListErpSyncRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListErpSyncRunsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListErpSyncRunsResponse) GetRuns() []*ErpSyncRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+// Bulk price adjustment messages
+type PriceAdjustmentFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BrandId       string                 `protobuf:"bytes,1,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Tag           string                 `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceAdjustmentFilter) Reset() {
+	*x = PriceAdjustmentFilter{}
+	mi := &file_proto_product_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAdjustmentFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAdjustmentFilter) ProtoMessage() {}
+
+func (x *PriceAdjustmentFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAdjustmentFilter.ProtoReflect.Descriptor instead.
+func (*PriceAdjustmentFilter) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *PriceAdjustmentFilter) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *PriceAdjustmentFilter) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *PriceAdjustmentFilter) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+type BulkAdjustPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *PriceAdjustmentFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`       // At least one field is required
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"` // percent or fixed
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`     // Signed, e.g. -10 with percent for 10% off
+	Apply         bool                   `protobuf:"varint,4,opt,name=apply,proto3" json:"apply,omitempty"`        // Preview only when false
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAdjustPricesRequest) Reset() {
+	*x = BulkAdjustPricesRequest{}
+	mi := &file_proto_product_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAdjustPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAdjustPricesRequest) ProtoMessage() {}
+
+func (x *BulkAdjustPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAdjustPricesRequest.ProtoReflect.Descriptor instead.
+func (*BulkAdjustPricesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *BulkAdjustPricesRequest) GetFilter() *PriceAdjustmentFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BulkAdjustPricesRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *BulkAdjustPricesRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *BulkAdjustPricesRequest) GetApply() bool {
+	if x != nil {
+		return x.Apply
+	}
+	return false
+}
+
+func (x *BulkAdjustPricesRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type PriceAdjustment struct {
+	state            protoimpl.MessageState  `protogen:"open.v1"`
+	ProductId        string                  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku              string                  `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Title            string                  `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	OldPrice         float64                 `protobuf:"fixed64,4,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`
+	NewPrice         float64                 `protobuf:"fixed64,5,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"`
+	OldDiscountPrice *wrapperspb.DoubleValue `protobuf:"bytes,6,opt,name=old_discount_price,json=oldDiscountPrice,proto3" json:"old_discount_price,omitempty"`
+	NewDiscountPrice *wrapperspb.DoubleValue `protobuf:"bytes,7,opt,name=new_discount_price,json=newDiscountPrice,proto3" json:"new_discount_price,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PriceAdjustment) Reset() {
+	*x = PriceAdjustment{}
+	mi := &file_proto_product_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceAdjustment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceAdjustment) ProtoMessage() {}
+
+func (x *PriceAdjustment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceAdjustment.ProtoReflect.Descriptor instead.
+func (*PriceAdjustment) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *PriceAdjustment) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *PriceAdjustment) GetOldPrice() float64 {
+	if x != nil {
+		return x.OldPrice
+	}
+	return 0
+}
+
+func (x *PriceAdjustment) GetNewPrice() float64 {
+	if x != nil {
+		return x.NewPrice
+	}
+	return 0
+}
+
+func (x *PriceAdjustment) GetOldDiscountPrice() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.OldDiscountPrice
+	}
+	return nil
+}
+
+func (x *PriceAdjustment) GetNewDiscountPrice() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.NewDiscountPrice
+	}
+	return nil
+}
+
+type BulkAdjustPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Adjustments   []*PriceAdjustment     `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments,omitempty"` // Capped at 500 products
+	Affected      int32                  `protobuf:"varint,2,opt,name=affected,proto3" json:"affected,omitempty"`
+	Applied       bool                   `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	BatchId       string                 `protobuf:"bytes,4,opt,name=batch_id,json=batchId,proto3" json:"batch_id,omitempty"` // Set when applied
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkAdjustPricesResponse) Reset() {
+	*x = BulkAdjustPricesResponse{}
+	mi := &file_proto_product_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BulkAdjustPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkAdjustPricesResponse) ProtoMessage() {}
+
+func (x *BulkAdjustPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkAdjustPricesResponse.ProtoReflect.Descriptor instead.
+func (*BulkAdjustPricesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *BulkAdjustPricesResponse) GetAdjustments() []*PriceAdjustment {
+	if x != nil {
+		return x.Adjustments
+	}
+	return nil
+}
+
+func (x *BulkAdjustPricesResponse) GetAffected() int32 {
+	if x != nil {
+		return x.Affected
+	}
+	return 0
+}
+
+func (x *BulkAdjustPricesResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *BulkAdjustPricesResponse) GetBatchId() string {
+	if x != nil {
+		return x.BatchId
+	}
+	return ""
+}
+
+// Inventory reconciliation messages
+type ReconciliationEntry struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Kind            string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"` // missing (no inventory item) or orphaned (no product)
+	Sku             string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductId       string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId       string                 `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	InventoryItemId string                 `protobuf:"bytes,5,opt,name=inventory_item_id,json=inventoryItemId,proto3" json:"inventory_item_id,omitempty"`
+	Created         bool                   `protobuf:"varint,6,opt,name=created,proto3" json:"created,omitempty"` // The missing inventory item was created with zero quantity
+	Error           string                 `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`      // Why creating the inventory item failed
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReconciliationEntry) Reset() {
+	*x = ReconciliationEntry{}
+	mi := &file_proto_product_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconciliationEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconciliationEntry) ProtoMessage() {}
+
+func (x *ReconciliationEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconciliationEntry.ProtoReflect.Descriptor instead.
+func (*ReconciliationEntry) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ReconciliationEntry) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ReconciliationEntry) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReconciliationEntry) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ReconciliationEntry) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ReconciliationEntry) GetInventoryItemId() string {
+	if x != nil {
+		return x.InventoryItemId
+	}
+	return ""
+}
+
+func (x *ReconciliationEntry) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+func (x *ReconciliationEntry) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type InventoryReconciliation struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Id                    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AutoCreate            bool                   `protobuf:"varint,2,opt,name=auto_create,json=autoCreate,proto3" json:"auto_create,omitempty"`
+	SkusChecked           int32                  `protobuf:"varint,3,opt,name=skus_checked,json=skusChecked,proto3" json:"skus_checked,omitempty"`
+	InventoryItemsChecked int32                  `protobuf:"varint,4,opt,name=inventory_items_checked,json=inventoryItemsChecked,proto3" json:"inventory_items_checked,omitempty"`
+	MissingCount          int32                  `protobuf:"varint,5,opt,name=missing_count,json=missingCount,proto3" json:"missing_count,omitempty"`
+	OrphanedCount         int32                  `protobuf:"varint,6,opt,name=orphaned_count,json=orphanedCount,proto3" json:"orphaned_count,omitempty"`
+	CreatedCount          int32                  `protobuf:"varint,7,opt,name=created_count,json=createdCount,proto3" json:"created_count,omitempty"`
+	Entries               []*ReconciliationEntry `protobuf:"bytes,8,rep,name=entries,proto3" json:"entries,omitempty"` // Not set in listings
+	StartedAt             *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *InventoryReconciliation) Reset() {
+	*x = InventoryReconciliation{}
+	mi := &file_proto_product_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InventoryReconciliation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryReconciliation) ProtoMessage() {}
+
+func (x *InventoryReconciliation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryReconciliation.ProtoReflect.Descriptor instead.
+func (*InventoryReconciliation) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *InventoryReconciliation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InventoryReconciliation) GetAutoCreate() bool {
+	if x != nil {
+		return x.AutoCreate
+	}
+	return false
+}
+
+func (x *InventoryReconciliation) GetSkusChecked() int32 {
+	if x != nil {
+		return x.SkusChecked
+	}
+	return 0
+}
+
+func (x *InventoryReconciliation) GetInventoryItemsChecked() int32 {
+	if x != nil {
+		return x.InventoryItemsChecked
+	}
+	return 0
+}
+
+func (x *InventoryReconciliation) GetMissingCount() int32 {
+	if x != nil {
+		return x.MissingCount
+	}
+	return 0
+}
+
+func (x *InventoryReconciliation) GetOrphanedCount() int32 {
+	if x != nil {
+		return x.OrphanedCount
+	}
+	return 0
+}
+
+func (x *InventoryReconciliation) GetCreatedCount() int32 {
+	if x != nil {
+		return x.CreatedCount
+	}
+	return 0
+}
+
+func (x *InventoryReconciliation) GetEntries() []*ReconciliationEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *InventoryReconciliation) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *InventoryReconciliation) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type RunInventoryReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AutoCreate    bool                   `protobuf:"varint,1,opt,name=auto_create,json=autoCreate,proto3" json:"auto_create,omitempty"` // Create missing inventory items with zero quantity
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunInventoryReconciliationRequest) Reset() {
+	*x = RunInventoryReconciliationRequest{}
+	mi := &file_proto_product_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunInventoryReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunInventoryReconciliationRequest) ProtoMessage() {}
+
+func (x *RunInventoryReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunInventoryReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*RunInventoryReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *RunInventoryReconciliationRequest) GetAutoCreate() bool {
+	if x != nil {
+		return x.AutoCreate
+	}
+	return false
+}
+
+type GetInventoryReconciliationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // The most recent reconciliation when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInventoryReconciliationRequest) Reset() {
+	*x = GetInventoryReconciliationRequest{}
+	mi := &file_proto_product_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInventoryReconciliationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryReconciliationRequest) ProtoMessage() {}
+
+func (x *GetInventoryReconciliationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryReconciliationRequest.ProtoReflect.Descriptor instead.
+func (*GetInventoryReconciliationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetInventoryReconciliationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListInventoryReconciliationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"` // Defaults to 20
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInventoryReconciliationsRequest) Reset() {
+	*x = ListInventoryReconciliationsRequest{}
+	mi := &file_proto_product_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryReconciliationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryReconciliationsRequest) ProtoMessage() {}
+
+func (x *ListInventoryReconciliationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryReconciliationsRequest.ProtoReflect.Descriptor instead.
+func (*ListInventoryReconciliationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ListInventoryReconciliationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListInventoryReconciliationsResponse struct {
+	state           protoimpl.MessageState     `protogen:"open.v1"`
+	Reconciliations []*InventoryReconciliation `protobuf:"bytes,1,rep,name=reconciliations,proto3" json:"reconciliations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListInventoryReconciliationsResponse) Reset() {
+	*x = ListInventoryReconciliationsResponse{}
+	mi := &file_proto_product_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInventoryReconciliationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInventoryReconciliationsResponse) ProtoMessage() {}
+
+func (x *ListInventoryReconciliationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInventoryReconciliationsResponse.ProtoReflect.Descriptor instead.
+func (*ListInventoryReconciliationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ListInventoryReconciliationsResponse) GetReconciliations() []*InventoryReconciliation {
+	if x != nil {
+		return x.Reconciliations
+	}
+	return nil
+}
+
+// Product merge and split messages
+type MergeProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TargetId      string                 `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"` // Kept, with its slug
+	SourceId      string                 `protobuf:"bytes,2,opt,name=source_id,json=sourceId,proto3" json:"source_id,omitempty"` // Deleted; its ID and slug then resolve to the target
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeProductsRequest) Reset() {
+	*x = MergeProductsRequest{}
+	mi := &file_proto_product_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeProductsRequest) ProtoMessage() {}
+
+func (x *MergeProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeProductsRequest.ProtoReflect.Descriptor instead.
+func (*MergeProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *MergeProductsRequest) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *MergeProductsRequest) GetSourceId() string {
+	if x != nil {
+		return x.SourceId
+	}
+	return ""
+}
+
+type MergeProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // The target after the merge
+	VariantsMoved int32                  `protobuf:"varint,2,opt,name=variants_moved,json=variantsMoved,proto3" json:"variants_moved,omitempty"`
+	ImagesMoved   int32                  `protobuf:"varint,3,opt,name=images_moved,json=imagesMoved,proto3" json:"images_moved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeProductsResponse) Reset() {
+	*x = MergeProductsResponse{}
+	mi := &file_proto_product_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeProductsResponse) ProtoMessage() {}
+
+func (x *MergeProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeProductsResponse.ProtoReflect.Descriptor instead.
+func (*MergeProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *MergeProductsResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *MergeProductsResponse) GetVariantsMoved() int32 {
+	if x != nil {
+		return x.VariantsMoved
+	}
+	return 0
+}
+
+func (x *MergeProductsResponse) GetImagesMoved() int32 {
+	if x != nil {
+		return x.ImagesMoved
+	}
+	return 0
+}
+
+type SplitVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"` // Defaults to the variant title
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitVariantRequest) Reset() {
+	*x = SplitVariantRequest{}
+	mi := &file_proto_product_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitVariantRequest) ProtoMessage() {}
+
+func (x *SplitVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitVariantRequest.ProtoReflect.Descriptor instead.
+func (*SplitVariantRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *SplitVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SplitVariantRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *SplitVariantRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SplitVariantRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type SplitVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"` // The new product
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SplitVariantResponse) Reset() {
+	*x = SplitVariantResponse{}
+	mi := &file_proto_product_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SplitVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitVariantResponse) ProtoMessage() {}
+
+func (x *SplitVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitVariantResponse.ProtoReflect.Descriptor instead.
+func (*SplitVariantResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SplitVariantResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// Supplier catalog import messages
+type ImportTemplate struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Supplier string                 `protobuf:"bytes,2,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	// Supplier column name -> product field: title, slug, description,
+	// short_description, sku, price, discount_price or weight. title, sku and
+	// price must be mapped.
+	ColumnMappings    map[string]string      `protobuf:"bytes,3,rep,name=column_mappings,json=columnMappings,proto3" json:"column_mappings,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	DefaultBrandId    string                 `protobuf:"bytes,4,opt,name=default_brand_id,json=defaultBrandId,proto3" json:"default_brand_id,omitempty"`
+	DefaultCategoryId string                 `protobuf:"bytes,5,opt,name=default_category_id,json=defaultCategoryId,proto3" json:"default_category_id,omitempty"` // Imported products are added to it
+	PriceMultiplier   float64                `protobuf:"fixed64,6,opt,name=price_multiplier,json=priceMultiplier,proto3" json:"price_multiplier,omitempty"`       // Applied to price and discount_price; defaults to 1
+	Publish           bool                   `protobuf:"varint,7,opt,name=publish,proto3" json:"publish,omitempty"`                                               // Publish imported products right away
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ImportTemplate) Reset() {
+	*x = ImportTemplate{}
+	mi := &file_proto_product_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportTemplate) ProtoMessage() {}
+
+func (x *ImportTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportTemplate.ProtoReflect.Descriptor instead.
+func (*ImportTemplate) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *ImportTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ImportTemplate) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *ImportTemplate) GetColumnMappings() map[string]string {
+	if x != nil {
+		return x.ColumnMappings
+	}
+	return nil
+}
+
+func (x *ImportTemplate) GetDefaultBrandId() string {
+	if x != nil {
+		return x.DefaultBrandId
+	}
+	return ""
+}
+
+func (x *ImportTemplate) GetDefaultCategoryId() string {
+	if x != nil {
+		return x.DefaultCategoryId
+	}
+	return ""
+}
+
+func (x *ImportTemplate) GetPriceMultiplier() float64 {
+	if x != nil {
+		return x.PriceMultiplier
+	}
+	return 0
+}
+
+func (x *ImportTemplate) GetPublish() bool {
+	if x != nil {
+		return x.Publish
+	}
+	return false
+}
+
+func (x *ImportTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SaveImportTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *ImportTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"` // Replaces the template of the supplier
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveImportTemplateRequest) Reset() {
+	*x = SaveImportTemplateRequest{}
+	mi := &file_proto_product_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveImportTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveImportTemplateRequest) ProtoMessage() {}
+
+func (x *SaveImportTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveImportTemplateRequest.ProtoReflect.Descriptor instead.
+func (*SaveImportTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *SaveImportTemplateRequest) GetTemplate() *ImportTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetImportTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      string                 `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportTemplateRequest) Reset() {
+	*x = GetImportTemplateRequest{}
+	mi := &file_proto_product_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportTemplateRequest) ProtoMessage() {}
+
+func (x *GetImportTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportTemplateRequest.ProtoReflect.Descriptor instead.
+func (*GetImportTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *GetImportTemplateRequest) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+type ListImportTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImportTemplatesRequest) Reset() {
+	*x = ListImportTemplatesRequest{}
+	mi := &file_proto_product_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImportTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportTemplatesRequest) ProtoMessage() {}
+
+func (x *ListImportTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*ListImportTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{135}
+}
+
+type ListImportTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*ImportTemplate      `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListImportTemplatesResponse) Reset() {
+	*x = ListImportTemplatesResponse{}
+	mi := &file_proto_product_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListImportTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListImportTemplatesResponse) ProtoMessage() {}
+
+func (x *ListImportTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListImportTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*ListImportTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListImportTemplatesResponse) GetTemplates() []*ImportTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteImportTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      string                 `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImportTemplateRequest) Reset() {
+	*x = DeleteImportTemplateRequest{}
+	mi := &file_proto_product_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImportTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteImportTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteImportTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *DeleteImportTemplateRequest) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+type DeleteImportTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteImportTemplateResponse) Reset() {
+	*x = DeleteImportTemplateResponse{}
+	mi := &file_proto_product_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteImportTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteImportTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteImportTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteImportTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteImportTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *DeleteImportTemplateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ImportSupplierCatalogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      string                 `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	CsvData       []byte                 `protobuf:"bytes,2,opt,name=csv_data,json=csvData,proto3" json:"csv_data,omitempty"` // CSV file with a header row
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSupplierCatalogRequest) Reset() {
+	*x = ImportSupplierCatalogRequest{}
+	mi := &file_proto_product_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSupplierCatalogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSupplierCatalogRequest) ProtoMessage() {}
+
+func (x *ImportSupplierCatalogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSupplierCatalogRequest.ProtoReflect.Descriptor instead.
+func (*ImportSupplierCatalogRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ImportSupplierCatalogRequest) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *ImportSupplierCatalogRequest) GetCsvData() []byte {
+	if x != nil {
+		return x.CsvData
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_proto_product_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ImportRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportSupplierCatalogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Imported      int32                  `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	Skipped       int32                  `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSupplierCatalogResponse) Reset() {
+	*x = ImportSupplierCatalogResponse{}
+	mi := &file_proto_product_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSupplierCatalogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSupplierCatalogResponse) ProtoMessage() {}
+
+func (x *ImportSupplierCatalogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSupplierCatalogResponse.ProtoReflect.Descriptor instead.
+func (*ImportSupplierCatalogResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ImportSupplierCatalogResponse) GetImported() int32 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportSupplierCatalogResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+func (x *ImportSupplierCatalogResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Product note messages
+type ProductNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // User ID of the staff member who wrote the note
+	AuthorEmail   string                 `protobuf:"bytes,4,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductNote) Reset() {
+	*x = ProductNote{}
+	mi := &file_proto_product_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductNote) ProtoMessage() {}
+
+func (x *ProductNote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductNote.ProtoReflect.Descriptor instead.
+func (*ProductNote) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ProductNote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductNote) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductNote) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ProductNote) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *ProductNote) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ProductNote) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProductNote) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateProductNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorEmail   string                 `protobuf:"bytes,3,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductNoteRequest) Reset() {
+	*x = CreateProductNoteRequest{}
+	mi := &file_proto_product_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductNoteRequest) ProtoMessage() {}
+
+func (x *CreateProductNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *CreateProductNoteRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductNoteRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreateProductNoteRequest) GetAuthorEmail() string {
+	if x != nil {
+		return x.AuthorEmail
+	}
+	return ""
+}
+
+func (x *CreateProductNoteRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ListProductNotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductNotesRequest) Reset() {
+	*x = ListProductNotesRequest{}
+	mi := &file_proto_product_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductNotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductNotesRequest) ProtoMessage() {}
+
+func (x *ListProductNotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductNotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ListProductNotesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ListProductNotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Notes         []*ProductNote         `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"` // Newest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductNotesResponse) Reset() {
+	*x = ListProductNotesResponse{}
+	mi := &file_proto_product_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductNotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductNotesResponse) ProtoMessage() {}
+
+func (x *ListProductNotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductNotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ListProductNotesResponse) GetNotes() []*ProductNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type UpdateProductNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // Only the author of a note may edit it
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductNoteRequest) Reset() {
+	*x = UpdateProductNoteRequest{}
+	mi := &file_proto_product_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductNoteRequest) ProtoMessage() {}
+
+func (x *UpdateProductNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *UpdateProductNoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductNoteRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateProductNoteRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *UpdateProductNoteRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type DeleteProductNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductNoteRequest) Reset() {
+	*x = DeleteProductNoteRequest{}
+	mi := &file_proto_product_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductNoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductNoteRequest) ProtoMessage() {}
+
+func (x *DeleteProductNoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductNoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *DeleteProductNoteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteProductNoteRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type DeleteProductNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductNoteResponse) Reset() {
+	*x = DeleteProductNoteResponse{}
+	mi := &file_proto_product_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductNoteResponse) ProtoMessage() {}
+
+func (x *DeleteProductNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductNoteResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *DeleteProductNoteResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Product relationship messages. Links are one-way, from the product to the
+// products shown with it.
+type ProductRelationship struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId          string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RelatedProductId   string                 `protobuf:"bytes,3,opt,name=related_product_id,json=relatedProductId,proto3" json:"related_product_id,omitempty"`
+	Type               string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`          // cross_sell, up_sell, accessory or replacement
+	Position           int32                  `protobuf:"varint,5,opt,name=position,proto3" json:"position,omitempty"` // Order within the type
+	RelatedTitle       string                 `protobuf:"bytes,6,opt,name=related_title,json=relatedTitle,proto3" json:"related_title,omitempty"`
+	RelatedSlug        string                 `protobuf:"bytes,7,opt,name=related_slug,json=relatedSlug,proto3" json:"related_slug,omitempty"`
+	RelatedIsPublished bool                   `protobuf:"varint,8,opt,name=related_is_published,json=relatedIsPublished,proto3" json:"related_is_published,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ProductRelationship) Reset() {
+	*x = ProductRelationship{}
+	mi := &file_proto_product_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRelationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRelationship) ProtoMessage() {}
+
+func (x *ProductRelationship) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRelationship.ProtoReflect.Descriptor instead.
+func (*ProductRelationship) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ProductRelationship) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductRelationship) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductRelationship) GetRelatedProductId() string {
+	if x != nil {
+		return x.RelatedProductId
+	}
+	return ""
+}
+
+func (x *ProductRelationship) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductRelationship) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ProductRelationship) GetRelatedTitle() string {
+	if x != nil {
+		return x.RelatedTitle
+	}
+	return ""
+}
+
+func (x *ProductRelationship) GetRelatedSlug() string {
+	if x != nil {
+		return x.RelatedSlug
+	}
+	return ""
+}
+
+func (x *ProductRelationship) GetRelatedIsPublished() bool {
+	if x != nil {
+		return x.RelatedIsPublished
+	}
+	return false
+}
+
+func (x *ProductRelationship) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProductRelationship) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// RelatedProduct is a linked product included in a product detail response
+type RelatedProduct struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	RelationshipId string                 `protobuf:"bytes,1,opt,name=relationship_id,json=relationshipId,proto3" json:"relationship_id,omitempty"`
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Position       int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Product        *Product               `protobuf:"bytes,4,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RelatedProduct) Reset() {
+	*x = RelatedProduct{}
+	mi := &file_proto_product_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedProduct) ProtoMessage() {}
+
+func (x *RelatedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedProduct.ProtoReflect.Descriptor instead.
+func (*RelatedProduct) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *RelatedProduct) GetRelationshipId() string {
+	if x != nil {
+		return x.RelationshipId
+	}
+	return ""
+}
+
+func (x *RelatedProduct) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RelatedProduct) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RelatedProduct) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type CreateProductRelationshipRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RelatedProductId string                 `protobuf:"bytes,2,opt,name=related_product_id,json=relatedProductId,proto3" json:"related_product_id,omitempty"`
+	Type             string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Position         int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateProductRelationshipRequest) Reset() {
+	*x = CreateProductRelationshipRequest{}
+	mi := &file_proto_product_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRelationshipRequest) ProtoMessage() {}
+
+func (x *CreateProductRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *CreateProductRelationshipRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductRelationshipRequest) GetRelatedProductId() string {
+	if x != nil {
+		return x.RelatedProductId
+	}
+	return ""
+}
+
+func (x *CreateProductRelationshipRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateProductRelationshipRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ListProductRelationshipsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // Optional
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductRelationshipsRequest) Reset() {
+	*x = ListProductRelationshipsRequest{}
+	mi := &file_proto_product_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductRelationshipsRequest) ProtoMessage() {}
+
+func (x *ListProductRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *ListProductRelationshipsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListProductRelationshipsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type ListProductRelationshipsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Relationships []*ProductRelationship `protobuf:"bytes,1,rep,name=relationships,proto3" json:"relationships,omitempty"` // By type and position
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductRelationshipsResponse) Reset() {
+	*x = ListProductRelationshipsResponse{}
+	mi := &file_proto_product_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductRelationshipsResponse) ProtoMessage() {}
+
+func (x *ListProductRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *ListProductRelationshipsResponse) GetRelationships() []*ProductRelationship {
+	if x != nil {
+		return x.Relationships
+	}
+	return nil
+}
+
+type UpdateProductRelationshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRelationshipRequest) Reset() {
+	*x = UpdateProductRelationshipRequest{}
+	mi := &file_proto_product_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRelationshipRequest) ProtoMessage() {}
+
+func (x *UpdateProductRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *UpdateProductRelationshipRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateProductRelationshipRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductRelationshipRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type DeleteProductRelationshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRelationshipRequest) Reset() {
+	*x = DeleteProductRelationshipRequest{}
+	mi := &file_proto_product_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRelationshipRequest) ProtoMessage() {}
+
+func (x *DeleteProductRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *DeleteProductRelationshipRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeleteProductRelationshipRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductRelationshipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRelationshipResponse) Reset() {
+	*x = DeleteProductRelationshipResponse{}
+	mi := &file_proto_product_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRelationshipResponse) ProtoMessage() {}
+
+func (x *DeleteProductRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *DeleteProductRelationshipResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Product question and answer messages. Questions and answers are pending,
+// approved or rejected; the storefront shows only approved ones.
+type ProductAnswer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	AuthorRole    string                 `protobuf:"bytes,5,opt,name=author_role,json=authorRole,proto3" json:"author_role,omitempty"` // admin or seller
+	Body          string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	Status        string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Upvotes       int32                  `protobuf:"varint,8,opt,name=upvotes,proto3" json:"upvotes,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductAnswer) Reset() {
+	*x = ProductAnswer{}
+	mi := &file_proto_product_proto_msgTypes[157]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductAnswer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAnswer) ProtoMessage() {}
+
+func (x *ProductAnswer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[157]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAnswer.ProtoReflect.Descriptor instead.
+func (*ProductAnswer) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *ProductAnswer) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductAnswer) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *ProductAnswer) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ProductAnswer) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *ProductAnswer) GetAuthorRole() string {
+	if x != nil {
+		return x.AuthorRole
+	}
+	return ""
+}
+
+func (x *ProductAnswer) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ProductAnswer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProductAnswer) GetUpvotes() int32 {
+	if x != nil {
+		return x.Upvotes
+	}
+	return 0
+}
+
+func (x *ProductAnswer) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProductAnswer) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ProductQuestion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // User ID of the customer who asked
+	AuthorName    string                 `protobuf:"bytes,4,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	Answers       []*ProductAnswer       `protobuf:"bytes,7,rep,name=answers,proto3" json:"answers,omitempty"` // Most upvoted first
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductQuestion) Reset() {
+	*x = ProductQuestion{}
+	mi := &file_proto_product_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductQuestion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductQuestion) ProtoMessage() {}
+
+func (x *ProductQuestion) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[158]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductQuestion.ProtoReflect.Descriptor instead.
+func (*ProductQuestion) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *ProductQuestion) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductQuestion) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductQuestion) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ProductQuestion) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *ProductQuestion) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ProductQuestion) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ProductQuestion) GetAnswers() []*ProductAnswer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+func (x *ProductQuestion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProductQuestion) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type AskProductQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,3,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskProductQuestionRequest) Reset() {
+	*x = AskProductQuestionRequest{}
+	mi := &file_proto_product_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskProductQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskProductQuestionRequest) ProtoMessage() {}
+
+func (x *AskProductQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskProductQuestionRequest.ProtoReflect.Descriptor instead.
+func (*AskProductQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *AskProductQuestionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AskProductQuestionRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *AskProductQuestionRequest) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *AskProductQuestionRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type GetProductQuestionRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeUnmoderated bool                   `protobuf:"varint,2,opt,name=include_unmoderated,json=includeUnmoderated,proto3" json:"include_unmoderated,omitempty"` // Also return pending and rejected answers
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *GetProductQuestionRequest) Reset() {
+	*x = GetProductQuestionRequest{}
+	mi := &file_proto_product_proto_msgTypes[160]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductQuestionRequest) ProtoMessage() {}
+
+func (x *GetProductQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[160]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductQuestionRequest.ProtoReflect.Descriptor instead.
+func (*GetProductQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *GetProductQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetProductQuestionRequest) GetIncludeUnmoderated() bool {
+	if x != nil {
+		return x.IncludeUnmoderated
+	}
+	return false
+}
+
+type ListProductQuestionsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	ProductId          string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"` // Empty lists the questions of all products
+	Status             string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                        // approved when empty
+	Sort               string                 `protobuf:"bytes,3,opt,name=sort,proto3" json:"sort,omitempty"`                            // newest (default) or top, by answer upvotes
+	Page               int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit              int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	IncludeUnmoderated bool                   `protobuf:"varint,6,opt,name=include_unmoderated,json=includeUnmoderated,proto3" json:"include_unmoderated,omitempty"` // Also return pending and rejected answers
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListProductQuestionsRequest) Reset() {
+	*x = ListProductQuestionsRequest{}
+	mi := &file_proto_product_proto_msgTypes[161]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductQuestionsRequest) ProtoMessage() {}
+
+func (x *ListProductQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[161]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ListProductQuestionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListProductQuestionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListProductQuestionsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+func (x *ListProductQuestionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductQuestionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListProductQuestionsRequest) GetIncludeUnmoderated() bool {
+	if x != nil {
+		return x.IncludeUnmoderated
+	}
+	return false
+}
+
+type ListProductQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Questions     []*ProductQuestion     `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductQuestionsResponse) Reset() {
+	*x = ListProductQuestionsResponse{}
+	mi := &file_proto_product_proto_msgTypes[162]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductQuestionsResponse) ProtoMessage() {}
+
+func (x *ListProductQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[162]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *ListProductQuestionsResponse) GetQuestions() []*ProductQuestion {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ListProductQuestionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type ModerateProductQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateProductQuestionRequest) Reset() {
+	*x = ModerateProductQuestionRequest{}
+	mi := &file_proto_product_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateProductQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateProductQuestionRequest) ProtoMessage() {}
+
+func (x *ModerateProductQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[163]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateProductQuestionRequest.ProtoReflect.Descriptor instead.
+func (*ModerateProductQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ModerateProductQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateProductQuestionRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DeleteProductQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductQuestionRequest) Reset() {
+	*x = DeleteProductQuestionRequest{}
+	mi := &file_proto_product_proto_msgTypes[164]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductQuestionRequest) ProtoMessage() {}
+
+func (x *DeleteProductQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[164]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *DeleteProductQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductQuestionResponse) Reset() {
+	*x = DeleteProductQuestionResponse{}
+	mi := &file_proto_product_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductQuestionResponse) ProtoMessage() {}
+
+func (x *DeleteProductQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[165]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductQuestionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *DeleteProductQuestionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AnswerProductQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuestionId    string                 `protobuf:"bytes,1,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	AuthorName    string                 `protobuf:"bytes,3,opt,name=author_name,json=authorName,proto3" json:"author_name,omitempty"`
+	AuthorRole    string                 `protobuf:"bytes,4,opt,name=author_role,json=authorRole,proto3" json:"author_role,omitempty"` // Answers of admins are approved, those of sellers pending
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerProductQuestionRequest) Reset() {
+	*x = AnswerProductQuestionRequest{}
+	mi := &file_proto_product_proto_msgTypes[166]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerProductQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerProductQuestionRequest) ProtoMessage() {}
+
+func (x *AnswerProductQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[166]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerProductQuestionRequest.ProtoReflect.Descriptor instead.
+func (*AnswerProductQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *AnswerProductQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AnswerProductQuestionRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *AnswerProductQuestionRequest) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *AnswerProductQuestionRequest) GetAuthorRole() string {
+	if x != nil {
+		return x.AuthorRole
+	}
+	return ""
+}
+
+func (x *AnswerProductQuestionRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ModerateProductAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateProductAnswerRequest) Reset() {
+	*x = ModerateProductAnswerRequest{}
+	mi := &file_proto_product_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateProductAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateProductAnswerRequest) ProtoMessage() {}
+
+func (x *ModerateProductAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[167]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateProductAnswerRequest.ProtoReflect.Descriptor instead.
+func (*ModerateProductAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *ModerateProductAnswerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateProductAnswerRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type DeleteProductAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductAnswerRequest) Reset() {
+	*x = DeleteProductAnswerRequest{}
+	mi := &file_proto_product_proto_msgTypes[168]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductAnswerRequest) ProtoMessage() {}
+
+func (x *DeleteProductAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[168]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductAnswerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *DeleteProductAnswerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductAnswerResponse) Reset() {
+	*x = DeleteProductAnswerResponse{}
+	mi := &file_proto_product_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductAnswerResponse) ProtoMessage() {}
+
+func (x *DeleteProductAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[169]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductAnswerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *DeleteProductAnswerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type UpvoteProductAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	VoterId       string                 `protobuf:"bytes,2,opt,name=voter_id,json=voterId,proto3" json:"voter_id,omitempty"` // Each user upvotes an answer at most once
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpvoteProductAnswerRequest) Reset() {
+	*x = UpvoteProductAnswerRequest{}
+	mi := &file_proto_product_proto_msgTypes[170]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpvoteProductAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpvoteProductAnswerRequest) ProtoMessage() {}
+
+func (x *UpvoteProductAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[170]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpvoteProductAnswerRequest.ProtoReflect.Descriptor instead.
+func (*UpvoteProductAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *UpvoteProductAnswerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpvoteProductAnswerRequest) GetVoterId() string {
+	if x != nil {
+		return x.VoterId
+	}
+	return ""
+}
+
+// Saved search messages. A saved search matches the published products
+// whose title or description contains its query and that pass its filters;
+// a periodic job diffs its results and records alerts for the notification
+// service when new products match or prices drop below the threshold.
+type SavedSearch struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Query            string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId       string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	BrandId          string                 `protobuf:"bytes,6,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	MinPrice         float64                `protobuf:"fixed64,7,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"` // 0 when not filtered
+	MaxPrice         float64                `protobuf:"fixed64,8,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"` // 0 when not filtered
+	AlertNewProducts bool                   `protobuf:"varint,9,opt,name=alert_new_products,json=alertNewProducts,proto3" json:"alert_new_products,omitempty"`
+	PriceAlertBelow  float64                `protobuf:"fixed64,10,opt,name=price_alert_below,json=priceAlertBelow,proto3" json:"price_alert_below,omitempty"` // 0 disables price drop alerts
+	LastEvaluatedAt  *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_evaluated_at,json=lastEvaluatedAt,proto3" json:"last_evaluated_at,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SavedSearch) Reset() {
+	*x = SavedSearch{}
+	mi := &file_proto_product_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearch) ProtoMessage() {}
+
+func (x *SavedSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[171]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearch.ProtoReflect.Descriptor instead.
+func (*SavedSearch) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *SavedSearch) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *SavedSearch) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetAlertNewProducts() bool {
+	if x != nil {
+		return x.AlertNewProducts
+	}
+	return false
+}
+
+func (x *SavedSearch) GetPriceAlertBelow() float64 {
+	if x != nil {
+		return x.PriceAlertBelow
+	}
+	return 0
+}
+
+func (x *SavedSearch) GetLastEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastEvaluatedAt
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SavedSearch) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SaveSearchRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Empty to create a saved search, else the one to replace
+	UserId           string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Query            string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId       string                 `protobuf:"bytes,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	BrandId          string                 `protobuf:"bytes,6,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	MinPrice         float64                `protobuf:"fixed64,7,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice         float64                `protobuf:"fixed64,8,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	AlertNewProducts bool                   `protobuf:"varint,9,opt,name=alert_new_products,json=alertNewProducts,proto3" json:"alert_new_products,omitempty"`
+	PriceAlertBelow  float64                `protobuf:"fixed64,10,opt,name=price_alert_below,json=priceAlertBelow,proto3" json:"price_alert_below,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SaveSearchRequest) Reset() {
+	*x = SaveSearchRequest{}
+	mi := &file_proto_product_proto_msgTypes[172]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSearchRequest) ProtoMessage() {}
+
+func (x *SaveSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[172]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSearchRequest.ProtoReflect.Descriptor instead.
+func (*SaveSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *SaveSearchRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *SaveSearchRequest) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *SaveSearchRequest) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *SaveSearchRequest) GetAlertNewProducts() bool {
+	if x != nil {
+		return x.AlertNewProducts
+	}
+	return false
+}
+
+func (x *SaveSearchRequest) GetPriceAlertBelow() float64 {
+	if x != nil {
+		return x.PriceAlertBelow
+	}
+	return 0
+}
+
+type ListSavedSearchesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesRequest) Reset() {
+	*x = ListSavedSearchesRequest{}
+	mi := &file_proto_product_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[173]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *ListSavedSearchesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListSavedSearchesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SavedSearches []*SavedSearch         `protobuf:"bytes,1,rep,name=saved_searches,json=savedSearches,proto3" json:"saved_searches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchesResponse) Reset() {
+	*x = ListSavedSearchesResponse{}
+	mi := &file_proto_product_proto_msgTypes[174]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchesResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[174]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchesResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ListSavedSearchesResponse) GetSavedSearches() []*SavedSearch {
+	if x != nil {
+		return x.SavedSearches
+	}
+	return nil
+}
+
+type DeleteSavedSearchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchRequest) Reset() {
+	*x = DeleteSavedSearchRequest{}
+	mi := &file_proto_product_proto_msgTypes[175]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchRequest) ProtoMessage() {}
+
+func (x *DeleteSavedSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[175]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *DeleteSavedSearchRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSavedSearchRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteSavedSearchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSavedSearchResponse) Reset() {
+	*x = DeleteSavedSearchResponse{}
+	mi := &file_proto_product_proto_msgTypes[176]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSavedSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSavedSearchResponse) ProtoMessage() {}
+
+func (x *DeleteSavedSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[176]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSavedSearchResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSavedSearchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *DeleteSavedSearchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SavedSearchAlert struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TenantId        string                 `protobuf:"bytes,2,opt,name=tenant_id,json=tenantId,proto3" json:"tenant_id,omitempty"`
+	SavedSearchId   string                 `protobuf:"bytes,3,opt,name=saved_search_id,json=savedSearchId,proto3" json:"saved_search_id,omitempty"`
+	SavedSearchName string                 `protobuf:"bytes,4,opt,name=saved_search_name,json=savedSearchName,proto3" json:"saved_search_name,omitempty"`
+	UserId          string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type            string                 `protobuf:"bytes,6,opt,name=type,proto3" json:"type,omitempty"` // new_product or price_drop
+	ProductId       string                 `protobuf:"bytes,7,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductTitle    string                 `protobuf:"bytes,8,opt,name=product_title,json=productTitle,proto3" json:"product_title,omitempty"`
+	Price           float64                `protobuf:"fixed64,9,opt,name=price,proto3" json:"price,omitempty"`
+	PreviousPrice   float64                `protobuf:"fixed64,10,opt,name=previous_price,json=previousPrice,proto3" json:"previous_price,omitempty"` // 0 for new products
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SavedSearchAlert) Reset() {
+	*x = SavedSearchAlert{}
+	mi := &file_proto_product_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SavedSearchAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedSearchAlert) ProtoMessage() {}
+
+func (x *SavedSearchAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[177]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedSearchAlert.ProtoReflect.Descriptor instead.
+func (*SavedSearchAlert) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *SavedSearchAlert) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SavedSearchAlert) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *SavedSearchAlert) GetSavedSearchId() string {
+	if x != nil {
+		return x.SavedSearchId
+	}
+	return ""
+}
+
+func (x *SavedSearchAlert) GetSavedSearchName() string {
+	if x != nil {
+		return x.SavedSearchName
+	}
+	return ""
+}
+
+func (x *SavedSearchAlert) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SavedSearchAlert) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *SavedSearchAlert) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SavedSearchAlert) GetProductTitle() string {
+	if x != nil {
+		return x.ProductTitle
+	}
+	return ""
+}
+
+func (x *SavedSearchAlert) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SavedSearchAlert) GetPreviousPrice() float64 {
+	if x != nil {
+		return x.PreviousPrice
+	}
+	return 0
+}
+
+func (x *SavedSearchAlert) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSavedSearchAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchAlertsRequest) Reset() {
+	*x = ListSavedSearchAlertsRequest{}
+	mi := &file_proto_product_proto_msgTypes[178]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchAlertsRequest) ProtoMessage() {}
+
+func (x *ListSavedSearchAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[178]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchAlertsRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ListSavedSearchAlertsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSavedSearchAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alerts        []*SavedSearchAlert    `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSavedSearchAlertsResponse) Reset() {
+	*x = ListSavedSearchAlertsResponse{}
+	mi := &file_proto_product_proto_msgTypes[179]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSavedSearchAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedSearchAlertsResponse) ProtoMessage() {}
+
+func (x *ListSavedSearchAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[179]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedSearchAlertsResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedSearchAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *ListSavedSearchAlertsResponse) GetAlerts() []*SavedSearchAlert {
+	if x != nil {
+		return x.Alerts
+	}
+	return nil
+}
+
+type AckSavedSearchAlertsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AlertIds      []string               `protobuf:"bytes,1,rep,name=alert_ids,json=alertIds,proto3" json:"alert_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckSavedSearchAlertsRequest) Reset() {
+	*x = AckSavedSearchAlertsRequest{}
+	mi := &file_proto_product_proto_msgTypes[180]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckSavedSearchAlertsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckSavedSearchAlertsRequest) ProtoMessage() {}
+
+func (x *AckSavedSearchAlertsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[180]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckSavedSearchAlertsRequest.ProtoReflect.Descriptor instead.
+func (*AckSavedSearchAlertsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *AckSavedSearchAlertsRequest) GetAlertIds() []string {
+	if x != nil {
+		return x.AlertIds
+	}
+	return nil
+}
+
+type AckSavedSearchAlertsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Acknowledged  int32                  `protobuf:"varint,1,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AckSavedSearchAlertsResponse) Reset() {
+	*x = AckSavedSearchAlertsResponse{}
+	mi := &file_proto_product_proto_msgTypes[181]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AckSavedSearchAlertsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AckSavedSearchAlertsResponse) ProtoMessage() {}
+
+func (x *AckSavedSearchAlertsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[181]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AckSavedSearchAlertsResponse.ProtoReflect.Descriptor instead.
+func (*AckSavedSearchAlertsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *AckSavedSearchAlertsResponse) GetAcknowledged() int32 {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return 0
+}
+
+// Search ranking messages. Searches match the published products whose
+// title or description contains every word of the query; a word in the
+// title scores 2 and in the description 1. Boost rules multiply the score of
+// the products of a brand, category or product by their weight, and pin
+// rules place a product at a position for a query.
+type SearchRankingRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`   // boost or pin
+	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"` // Normalized; empty for all searches (boosts only)
+	BrandId       string                 `protobuf:"bytes,5,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,7,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Weight        float64                `protobuf:"fixed64,8,opt,name=weight,proto3" json:"weight,omitempty"`    // Of boosts
+	Position      int32                  `protobuf:"varint,9,opt,name=position,proto3" json:"position,omitempty"` // 1-based, of pins
+	Status        string                 `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`     // draft or published
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchRankingRule) Reset() {
+	*x = SearchRankingRule{}
+	mi := &file_proto_product_proto_msgTypes[182]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRankingRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRankingRule) ProtoMessage() {}
+
+func (x *SearchRankingRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[182]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRankingRule.ProtoReflect.Descriptor instead.
+func (*SearchRankingRule) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *SearchRankingRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SearchRankingRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SearchRankingRule) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SearchRankingRule) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchRankingRule) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *SearchRankingRule) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SearchRankingRule) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SearchRankingRule) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SearchRankingRule) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SearchRankingRule) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *SearchRankingRule) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *SearchRankingRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SearchRankingRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SaveSearchRankingRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Empty to create a draft rule, else the one to replace as a draft
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Kind          string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Query         string                 `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	BrandId       string                 `protobuf:"bytes,5,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,6,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,7,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Weight        float64                `protobuf:"fixed64,8,opt,name=weight,proto3" json:"weight,omitempty"`
+	Position      int32                  `protobuf:"varint,9,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveSearchRankingRuleRequest) Reset() {
+	*x = SaveSearchRankingRuleRequest{}
+	mi := &file_proto_product_proto_msgTypes[183]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveSearchRankingRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveSearchRankingRuleRequest) ProtoMessage() {}
+
+func (x *SaveSearchRankingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[183]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveSearchRankingRuleRequest.ProtoReflect.Descriptor instead.
+func (*SaveSearchRankingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *SaveSearchRankingRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SaveSearchRankingRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SaveSearchRankingRuleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SaveSearchRankingRuleRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SaveSearchRankingRuleRequest) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *SaveSearchRankingRuleRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SaveSearchRankingRuleRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SaveSearchRankingRuleRequest) GetWeight() float64 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *SaveSearchRankingRuleRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ListSearchRankingRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"` // Empty for all
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchRankingRulesRequest) Reset() {
+	*x = ListSearchRankingRulesRequest{}
+	mi := &file_proto_product_proto_msgTypes[184]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchRankingRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchRankingRulesRequest) ProtoMessage() {}
+
+func (x *ListSearchRankingRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[184]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchRankingRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListSearchRankingRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *ListSearchRankingRulesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListSearchRankingRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*SearchRankingRule   `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSearchRankingRulesResponse) Reset() {
+	*x = ListSearchRankingRulesResponse{}
+	mi := &file_proto_product_proto_msgTypes[185]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSearchRankingRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSearchRankingRulesResponse) ProtoMessage() {}
+
+func (x *ListSearchRankingRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[185]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSearchRankingRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListSearchRankingRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *ListSearchRankingRulesResponse) GetRules() []*SearchRankingRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type DeleteSearchRankingRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSearchRankingRuleRequest) Reset() {
+	*x = DeleteSearchRankingRuleRequest{}
+	mi := &file_proto_product_proto_msgTypes[186]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSearchRankingRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSearchRankingRuleRequest) ProtoMessage() {}
+
+func (x *DeleteSearchRankingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[186]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSearchRankingRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSearchRankingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *DeleteSearchRankingRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteSearchRankingRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSearchRankingRuleResponse) Reset() {
+	*x = DeleteSearchRankingRuleResponse{}
+	mi := &file_proto_product_proto_msgTypes[187]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSearchRankingRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSearchRankingRuleResponse) ProtoMessage() {}
+
+func (x *DeleteSearchRankingRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[187]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSearchRankingRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSearchRankingRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *DeleteSearchRankingRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type PublishSearchRankingRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"` // Empty to publish all drafts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishSearchRankingRulesRequest) Reset() {
+	*x = PublishSearchRankingRulesRequest{}
+	mi := &file_proto_product_proto_msgTypes[188]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishSearchRankingRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishSearchRankingRulesRequest) ProtoMessage() {}
+
+func (x *PublishSearchRankingRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[188]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishSearchRankingRulesRequest.ProtoReflect.Descriptor instead.
+func (*PublishSearchRankingRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *PublishSearchRankingRulesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type PublishSearchRankingRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Published     int32                  `protobuf:"varint,1,opt,name=published,proto3" json:"published,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublishSearchRankingRulesResponse) Reset() {
+	*x = PublishSearchRankingRulesResponse{}
+	mi := &file_proto_product_proto_msgTypes[189]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublishSearchRankingRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishSearchRankingRulesResponse) ProtoMessage() {}
+
+func (x *PublishSearchRankingRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[189]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublishSearchRankingRulesResponse.ProtoReflect.Descriptor instead.
+func (*PublishSearchRankingRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *PublishSearchRankingRulesResponse) GetPublished() int32 {
+	if x != nil {
+		return x.Published
+	}
+	return 0
+}
+
+type SearchProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"` // Restricts the results to the category and its descendants
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_proto_product_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[190]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type SearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Slug          string                 `protobuf:"bytes,3,opt,name=slug,proto3" json:"slug,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"` // The discount price when set
+	Score         float64                `protobuf:"fixed64,5,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
+	mi := &file_proto_product_proto_msgTypes[191]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchResult) ProtoMessage() {}
+
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[191]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *SearchResult) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SearchResult) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SearchResult) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *SearchResult) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SearchResult) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SearchResult        `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_proto_product_proto_msgTypes[192]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[192]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *SearchProductsResponse) GetResults() []*SearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *SearchProductsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type PreviewSearchRankingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewSearchRankingRequest) Reset() {
+	*x = PreviewSearchRankingRequest{}
+	mi := &file_proto_product_proto_msgTypes[193]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewSearchRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSearchRankingRequest) ProtoMessage() {}
+
+func (x *PreviewSearchRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[193]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSearchRankingRequest.ProtoReflect.Descriptor instead.
+func (*PreviewSearchRankingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *PreviewSearchRankingRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *PreviewSearchRankingRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *PreviewSearchRankingRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type RankedSearchResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Result        *SearchResult          `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Relevance     float64                `protobuf:"fixed64,2,opt,name=relevance,proto3" json:"relevance,omitempty"`                          // Before boosts
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`                             // 1-based, after the rules
+	BasePosition  int32                  `protobuf:"varint,4,opt,name=base_position,json=basePosition,proto3" json:"base_position,omitempty"` // 1-based, by relevance alone
+	Pinned        bool                   `protobuf:"varint,5,opt,name=pinned,proto3" json:"pinned,omitempty"`
+	RuleIds       []string               `protobuf:"bytes,6,rep,name=rule_ids,json=ruleIds,proto3" json:"rule_ids,omitempty"` // The rules that moved the product
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankedSearchResult) Reset() {
+	*x = RankedSearchResult{}
+	mi := &file_proto_product_proto_msgTypes[194]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankedSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankedSearchResult) ProtoMessage() {}
+
+func (x *RankedSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[194]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankedSearchResult.ProtoReflect.Descriptor instead.
+func (*RankedSearchResult) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *RankedSearchResult) GetResult() *SearchResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+func (x *RankedSearchResult) GetRelevance() float64 {
+	if x != nil {
+		return x.Relevance
+	}
+	return 0
+}
+
+func (x *RankedSearchResult) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *RankedSearchResult) GetBasePosition() int32 {
+	if x != nil {
+		return x.BasePosition
+	}
+	return 0
+}
+
+func (x *RankedSearchResult) GetPinned() bool {
+	if x != nil {
+		return x.Pinned
+	}
+	return false
+}
+
+func (x *RankedSearchResult) GetRuleIds() []string {
+	if x != nil {
+		return x.RuleIds
+	}
+	return nil
+}
+
+type PreviewSearchRankingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*RankedSearchResult  `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewSearchRankingResponse) Reset() {
+	*x = PreviewSearchRankingResponse{}
+	mi := &file_proto_product_proto_msgTypes[195]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewSearchRankingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewSearchRankingResponse) ProtoMessage() {}
+
+func (x *PreviewSearchRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[195]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewSearchRankingResponse.ProtoReflect.Descriptor instead.
+func (*PreviewSearchRankingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *PreviewSearchRankingResponse) GetResults() []*RankedSearchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// Badge messages
+type ProductBadge struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductBadge) Reset() {
+	*x = ProductBadge{}
+	mi := &file_proto_product_proto_msgTypes[196]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductBadge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductBadge) ProtoMessage() {}
+
+func (x *ProductBadge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[196]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductBadge.ProtoReflect.Descriptor instead.
+func (*ProductBadge) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *ProductBadge) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ProductBadge) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *ProductBadge) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+type BadgeRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`                               // new, sale, bestseller or manual
+	Days          int32                  `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`                              // Of new badges: the age of the products matched
+	Percent       float64                `protobuf:"fixed64,6,opt,name=percent,proto3" json:"percent,omitempty"`                       // Of bestseller badges: the top share of products by units sold
+	ProductIds    []string               `protobuf:"bytes,7,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"` // Of manual badges
+	Priority      int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`                      // Badges show by ascending priority
+	Active        bool                   `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	BuiltIn       bool                   `protobuf:"varint,10,opt,name=built_in,json=builtIn,proto3" json:"built_in,omitempty"`      // Built-in badges are reset rather than deleted
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // Unset for built-in badges never saved
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BadgeRule) Reset() {
+	*x = BadgeRule{}
+	mi := &file_proto_product_proto_msgTypes[197]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BadgeRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BadgeRule) ProtoMessage() {}
+
+func (x *BadgeRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[197]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BadgeRule.ProtoReflect.Descriptor instead.
+func (*BadgeRule) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *BadgeRule) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BadgeRule) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BadgeRule) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *BadgeRule) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *BadgeRule) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *BadgeRule) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *BadgeRule) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *BadgeRule) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *BadgeRule) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *BadgeRule) GetBuiltIn() bool {
+	if x != nil {
+		return x.BuiltIn
+	}
+	return false
+}
+
+func (x *BadgeRule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListBadgeRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBadgeRulesRequest) Reset() {
+	*x = ListBadgeRulesRequest{}
+	mi := &file_proto_product_proto_msgTypes[198]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBadgeRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBadgeRulesRequest) ProtoMessage() {}
+
+func (x *ListBadgeRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[198]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBadgeRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListBadgeRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{198}
+}
+
+type ListBadgeRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*BadgeRule           `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBadgeRulesResponse) Reset() {
+	*x = ListBadgeRulesResponse{}
+	mi := &file_proto_product_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBadgeRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBadgeRulesResponse) ProtoMessage() {}
+
+func (x *ListBadgeRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBadgeRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListBadgeRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *ListBadgeRulesResponse) GetRules() []*BadgeRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type SaveBadgeRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Label         string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Color         string                 `protobuf:"bytes,3,opt,name=color,proto3" json:"color,omitempty"`
+	Kind          string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Days          int32                  `protobuf:"varint,5,opt,name=days,proto3" json:"days,omitempty"`
+	Percent       float64                `protobuf:"fixed64,6,opt,name=percent,proto3" json:"percent,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,7,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	Priority      int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	Active        bool                   `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SaveBadgeRuleRequest) Reset() {
+	*x = SaveBadgeRuleRequest{}
+	mi := &file_proto_product_proto_msgTypes[200]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SaveBadgeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveBadgeRuleRequest) ProtoMessage() {}
+
+func (x *SaveBadgeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[200]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveBadgeRuleRequest.ProtoReflect.Descriptor instead.
+func (*SaveBadgeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *SaveBadgeRuleRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SaveBadgeRuleRequest) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SaveBadgeRuleRequest) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *SaveBadgeRuleRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *SaveBadgeRuleRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *SaveBadgeRuleRequest) GetPercent() float64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+func (x *SaveBadgeRuleRequest) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+func (x *SaveBadgeRuleRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *SaveBadgeRuleRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type DeleteBadgeRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBadgeRuleRequest) Reset() {
+	*x = DeleteBadgeRuleRequest{}
+	mi := &file_proto_product_proto_msgTypes[201]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBadgeRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBadgeRuleRequest) ProtoMessage() {}
+
+func (x *DeleteBadgeRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[201]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBadgeRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBadgeRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *DeleteBadgeRuleRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeleteBadgeRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBadgeRuleResponse) Reset() {
+	*x = DeleteBadgeRuleResponse{}
+	mi := &file_proto_product_proto_msgTypes[202]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBadgeRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBadgeRuleResponse) ProtoMessage() {}
+
+func (x *DeleteBadgeRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[202]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteBadgeRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBadgeRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *DeleteBadgeRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RecomputeBadgesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeBadgesRequest) Reset() {
+	*x = RecomputeBadgesRequest{}
+	mi := &file_proto_product_proto_msgTypes[203]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeBadgesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeBadgesRequest) ProtoMessage() {}
+
+func (x *RecomputeBadgesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[203]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeBadgesRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeBadgesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{203}
+}
+
+type RecomputeBadgesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      int32                  `protobuf:"varint,1,opt,name=products,proto3" json:"products,omitempty"` // Published products evaluated
+	Badged        int32                  `protobuf:"varint,2,opt,name=badged,proto3" json:"badged,omitempty"`     // Products with at least one badge
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeBadgesResponse) Reset() {
+	*x = RecomputeBadgesResponse{}
+	mi := &file_proto_product_proto_msgTypes[204]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeBadgesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeBadgesResponse) ProtoMessage() {}
+
+func (x *RecomputeBadgesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[204]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeBadgesResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeBadgesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *RecomputeBadgesResponse) GetProducts() int32 {
+	if x != nil {
+		return x.Products
+	}
+	return 0
+}
+
+func (x *RecomputeBadgesResponse) GetBadged() int32 {
+	if x != nil {
+		return x.Badged
+	}
+	return 0
+}
+
+type ProductSaleLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductSaleLine) Reset() {
+	*x = ProductSaleLine{}
+	mi := &file_proto_product_proto_msgTypes[205]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductSaleLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductSaleLine) ProtoMessage() {}
+
+func (x *ProductSaleLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[205]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductSaleLine.ProtoReflect.Descriptor instead.
+func (*ProductSaleLine) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *ProductSaleLine) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductSaleLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RecordProductSalesRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	OrderReference string                 `protobuf:"bytes,1,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	Lines          []*ProductSaleLine     `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecordProductSalesRequest) Reset() {
+	*x = RecordProductSalesRequest{}
+	mi := &file_proto_product_proto_msgTypes[206]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordProductSalesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordProductSalesRequest) ProtoMessage() {}
+
+func (x *RecordProductSalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[206]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordProductSalesRequest.ProtoReflect.Descriptor instead.
+func (*RecordProductSalesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *RecordProductSalesRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *RecordProductSalesRequest) GetLines() []*ProductSaleLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type RecordProductSalesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Recorded      int32                  `protobuf:"varint,1,opt,name=recorded,proto3" json:"recorded,omitempty"` // Lines not already reported by the order
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordProductSalesResponse) Reset() {
+	*x = RecordProductSalesResponse{}
+	mi := &file_proto_product_proto_msgTypes[207]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordProductSalesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordProductSalesResponse) ProtoMessage() {}
+
+func (x *RecordProductSalesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[207]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordProductSalesResponse.ProtoReflect.Descriptor instead.
+func (*RecordProductSalesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *RecordProductSalesResponse) GetRecorded() int32 {
+	if x != nil {
+		return x.Recorded
+	}
+	return 0
+}
+
+// Catalog activity messages
+type CatalogActivity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action        string                 `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"` // created, updated or deleted
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductTitle  string                 `protobuf:"bytes,4,opt,name=product_title,json=productTitle,proto3" json:"product_title,omitempty"`
+	ActorId       string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // Empty when the change was not made by a user
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogActivity) Reset() {
+	*x = CatalogActivity{}
+	mi := &file_proto_product_proto_msgTypes[208]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogActivity) ProtoMessage() {}
+
+func (x *CatalogActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[208]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogActivity.ProtoReflect.Descriptor instead.
+func (*CatalogActivity) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *CatalogActivity) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CatalogActivity) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *CatalogActivity) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CatalogActivity) GetProductTitle() string {
+	if x != nil {
+		return x.ProductTitle
+	}
+	return ""
+}
+
+func (x *CatalogActivity) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *CatalogActivity) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type ListCatalogActivityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BeforeId      int64                  `protobuf:"varint,1,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // Only list entries older than this one; 0 for the newest
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCatalogActivityRequest) Reset() {
+	*x = ListCatalogActivityRequest{}
+	mi := &file_proto_product_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogActivityRequest) ProtoMessage() {}
+
+func (x *ListCatalogActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[209]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogActivityRequest.ProtoReflect.Descriptor instead.
+func (*ListCatalogActivityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *ListCatalogActivityRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *ListCatalogActivityRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCatalogActivityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*CatalogActivity     `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCatalogActivityResponse) Reset() {
+	*x = ListCatalogActivityResponse{}
+	mi := &file_proto_product_proto_msgTypes[210]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCatalogActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCatalogActivityResponse) ProtoMessage() {}
+
+func (x *ListCatalogActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[210]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCatalogActivityResponse.ProtoReflect.Descriptor instead.
+func (*ListCatalogActivityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *ListCatalogActivityResponse) GetEntries() []*CatalogActivity {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Catalog translation messages
+type Translation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	EntityType       string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"` // product, category, page or banner
+	EntityId         string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Locale           string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`                                             // BCP 47 tag, such as fr or fr-CA
+	Name             string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                                 // Title of a product, page or banner, name of a category
+	ShortDescription string                 `protobuf:"bytes,5,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"` // Products, summary of a page, subtitle of a banner
+	Description      string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`                                   // Body of a page; banners have none
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Translation) Reset() {
+	*x = Translation{}
+	mi := &file_proto_product_proto_msgTypes[211]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Translation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Translation) ProtoMessage() {}
+
+func (x *Translation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[211]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Translation.ProtoReflect.Descriptor instead.
+func (*Translation) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *Translation) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *Translation) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *Translation) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Translation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Translation) GetShortDescription() string {
+	if x != nil {
+		return x.ShortDescription
+	}
+	return ""
+}
+
+func (x *Translation) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Translation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Translation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Translation   *Translation           `protobuf:"bytes,1,opt,name=translation,proto3" json:"translation,omitempty"` // Replaces the translation to its locale
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetTranslationRequest) Reset() {
+	*x = SetTranslationRequest{}
+	mi := &file_proto_product_proto_msgTypes[212]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTranslationRequest) ProtoMessage() {}
+
+func (x *SetTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[212]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTranslationRequest.ProtoReflect.Descriptor instead.
+func (*SetTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *SetTranslationRequest) GetTranslation() *Translation {
+	if x != nil {
+		return x.Translation
+	}
+	return nil
+}
+
+type ListTranslationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTranslationsRequest) Reset() {
+	*x = ListTranslationsRequest{}
+	mi := &file_proto_product_proto_msgTypes[213]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTranslationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTranslationsRequest) ProtoMessage() {}
+
+func (x *ListTranslationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[213]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTranslationsRequest.ProtoReflect.Descriptor instead.
+func (*ListTranslationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *ListTranslationsRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *ListTranslationsRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+type ListTranslationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Translations  []*Translation         `protobuf:"bytes,1,rep,name=translations,proto3" json:"translations,omitempty"` // Ordered by locale
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTranslationsResponse) Reset() {
+	*x = ListTranslationsResponse{}
+	mi := &file_proto_product_proto_msgTypes[214]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTranslationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTranslationsResponse) ProtoMessage() {}
+
+func (x *ListTranslationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[214]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTranslationsResponse.ProtoReflect.Descriptor instead.
+func (*ListTranslationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *ListTranslationsResponse) GetTranslations() []*Translation {
+	if x != nil {
+		return x.Translations
+	}
+	return nil
+}
+
+type DeleteTranslationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    string                 `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId      string                 `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Locale        string                 `protobuf:"bytes,3,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTranslationRequest) Reset() {
+	*x = DeleteTranslationRequest{}
+	mi := &file_proto_product_proto_msgTypes[215]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTranslationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTranslationRequest) ProtoMessage() {}
+
+func (x *DeleteTranslationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[215]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTranslationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTranslationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *DeleteTranslationRequest) GetEntityType() string {
+	if x != nil {
+		return x.EntityType
+	}
+	return ""
+}
+
+func (x *DeleteTranslationRequest) GetEntityId() string {
+	if x != nil {
+		return x.EntityId
+	}
+	return ""
+}
+
+func (x *DeleteTranslationRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type DeleteTranslationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTranslationResponse) Reset() {
+	*x = DeleteTranslationResponse{}
+	mi := &file_proto_product_proto_msgTypes[216]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTranslationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTranslationResponse) ProtoMessage() {}
+
+func (x *DeleteTranslationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[216]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTranslationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTranslationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *DeleteTranslationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Storefront content messages. Pages and banners are drafts until
+// published; published content is live from publish_at, when set, until
+// unpublish_at, when set.
+type ContentPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slug          string                 `protobuf:"bytes,2,opt,name=slug,proto3" json:"slug,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Summary       string                 `protobuf:"bytes,4,opt,name=summary,proto3" json:"summary,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`     // Rendered by the frontend as is
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"` // draft or published
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	UnpublishAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	Live          bool                   `protobuf:"varint,9,opt,name=live,proto3" json:"live,omitempty"` // Shown on the storefront now
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentPage) Reset() {
+	*x = ContentPage{}
+	mi := &file_proto_product_proto_msgTypes[217]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentPage) ProtoMessage() {}
+
+func (x *ContentPage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[217]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentPage.ProtoReflect.Descriptor instead.
+func (*ContentPage) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *ContentPage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContentPage) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *ContentPage) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ContentPage) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *ContentPage) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *ContentPage) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ContentPage) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *ContentPage) GetUnpublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return nil
+}
+
+func (x *ContentPage) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+func (x *ContentPage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ContentPage) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateContentPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ContentPage           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContentPageRequest) Reset() {
+	*x = CreateContentPageRequest{}
+	mi := &file_proto_product_proto_msgTypes[218]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContentPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContentPageRequest) ProtoMessage() {}
+
+func (x *CreateContentPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[218]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContentPageRequest.ProtoReflect.Descriptor instead.
+func (*CreateContentPageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *CreateContentPageRequest) GetPage() *ContentPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type UpdateContentPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *ContentPage           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"` // Replaces the page with its ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContentPageRequest) Reset() {
+	*x = UpdateContentPageRequest{}
+	mi := &file_proto_product_proto_msgTypes[219]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContentPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContentPageRequest) ProtoMessage() {}
+
+func (x *UpdateContentPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[219]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContentPageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContentPageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *UpdateContentPageRequest) GetPage() *ContentPage {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+type GetContentPageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetContentPageRequest_Id
+	//	*GetContentPageRequest_Slug
+	Identifier    isGetContentPageRequest_Identifier `protobuf_oneof:"identifier"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetContentPageRequest) Reset() {
+	*x = GetContentPageRequest{}
+	mi := &file_proto_product_proto_msgTypes[220]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetContentPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContentPageRequest) ProtoMessage() {}
+
+func (x *GetContentPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[220]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContentPageRequest.ProtoReflect.Descriptor instead.
+func (*GetContentPageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *GetContentPageRequest) GetIdentifier() isGetContentPageRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *GetContentPageRequest) GetId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetContentPageRequest_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *GetContentPageRequest) GetSlug() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetContentPageRequest_Slug); ok {
+			return x.Slug
+		}
+	}
+	return ""
+}
+
+type isGetContentPageRequest_Identifier interface {
+	isGetContentPageRequest_Identifier()
+}
+
+type GetContentPageRequest_Id struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3,oneof"` // Returns the page whatever its state
+}
+
+type GetContentPageRequest_Slug struct {
+	Slug string `protobuf:"bytes,2,opt,name=slug,proto3,oneof"` // Returns the page only when live
+}
+
+func (*GetContentPageRequest_Id) isGetContentPageRequest_Identifier() {}
+
+func (*GetContentPageRequest_Slug) isGetContentPageRequest_Identifier() {}
+
+type ListContentPagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LiveOnly      bool                   `protobuf:"varint,1,opt,name=live_only,json=liveOnly,proto3" json:"live_only,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContentPagesRequest) Reset() {
+	*x = ListContentPagesRequest{}
+	mi := &file_proto_product_proto_msgTypes[221]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentPagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentPagesRequest) ProtoMessage() {}
+
+func (x *ListContentPagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[221]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentPagesRequest.ProtoReflect.Descriptor instead.
+func (*ListContentPagesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *ListContentPagesRequest) GetLiveOnly() bool {
+	if x != nil {
+		return x.LiveOnly
+	}
+	return false
+}
+
+func (x *ListContentPagesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListContentPagesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListContentPagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pages         []*ContentPage         `protobuf:"bytes,1,rep,name=pages,proto3" json:"pages,omitempty"` // Ordered by slug
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContentPagesResponse) Reset() {
+	*x = ListContentPagesResponse{}
+	mi := &file_proto_product_proto_msgTypes[222]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentPagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentPagesResponse) ProtoMessage() {}
+
+func (x *ListContentPagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[222]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentPagesResponse.ProtoReflect.Descriptor instead.
+func (*ListContentPagesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *ListContentPagesResponse) GetPages() []*ContentPage {
+	if x != nil {
+		return x.Pages
+	}
+	return nil
+}
+
+func (x *ListContentPagesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type DeleteContentPageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContentPageRequest) Reset() {
+	*x = DeleteContentPageRequest{}
+	mi := &file_proto_product_proto_msgTypes[223]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContentPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContentPageRequest) ProtoMessage() {}
+
+func (x *DeleteContentPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[223]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContentPageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContentPageRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *DeleteContentPageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteContentPageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContentPageResponse) Reset() {
+	*x = DeleteContentPageResponse{}
+	mi := &file_proto_product_proto_msgTypes[224]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContentPageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContentPageResponse) ProtoMessage() {}
+
+func (x *DeleteContentPageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[224]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContentPageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContentPageResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *DeleteContentPageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ContentBanner struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Slot          string                 `protobuf:"bytes,2,opt,name=slot,proto3" json:"slot,omitempty"` // Named storefront slot, such as homepage_hero
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Subtitle      string                 `protobuf:"bytes,4,opt,name=subtitle,proto3" json:"subtitle,omitempty"`
+	ImageUrl      string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	LinkUrl       string                 `protobuf:"bytes,6,opt,name=link_url,json=linkUrl,proto3" json:"link_url,omitempty"`
+	Position      int32                  `protobuf:"varint,7,opt,name=position,proto3" json:"position,omitempty"` // Order within the slot
+	Status        string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`      // draft or published
+	PublishAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	UnpublishAt   *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=unpublish_at,json=unpublishAt,proto3" json:"unpublish_at,omitempty"`
+	Live          bool                   `protobuf:"varint,11,opt,name=live,proto3" json:"live,omitempty"` // Shown on the storefront now
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ContentBanner) Reset() {
+	*x = ContentBanner{}
+	mi := &file_proto_product_proto_msgTypes[225]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ContentBanner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContentBanner) ProtoMessage() {}
+
+func (x *ContentBanner) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[225]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContentBanner.ProtoReflect.Descriptor instead.
+func (*ContentBanner) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *ContentBanner) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContentBanner) GetSlot() string {
+	if x != nil {
+		return x.Slot
+	}
+	return ""
+}
+
+func (x *ContentBanner) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ContentBanner) GetSubtitle() string {
+	if x != nil {
+		return x.Subtitle
+	}
+	return ""
+}
+
+func (x *ContentBanner) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ContentBanner) GetLinkUrl() string {
+	if x != nil {
+		return x.LinkUrl
+	}
+	return ""
+}
+
+func (x *ContentBanner) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ContentBanner) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ContentBanner) GetPublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishAt
+	}
+	return nil
+}
+
+func (x *ContentBanner) GetUnpublishAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UnpublishAt
+	}
+	return nil
+}
+
+func (x *ContentBanner) GetLive() bool {
+	if x != nil {
+		return x.Live
+	}
+	return false
+}
+
+func (x *ContentBanner) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ContentBanner) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateContentBannerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Banner        *ContentBanner         `protobuf:"bytes,1,opt,name=banner,proto3" json:"banner,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateContentBannerRequest) Reset() {
+	*x = CreateContentBannerRequest{}
+	mi := &file_proto_product_proto_msgTypes[226]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateContentBannerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateContentBannerRequest) ProtoMessage() {}
+
+func (x *CreateContentBannerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[226]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateContentBannerRequest.ProtoReflect.Descriptor instead.
+func (*CreateContentBannerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *CreateContentBannerRequest) GetBanner() *ContentBanner {
+	if x != nil {
+		return x.Banner
+	}
+	return nil
+}
+
+type UpdateContentBannerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Banner        *ContentBanner         `protobuf:"bytes,1,opt,name=banner,proto3" json:"banner,omitempty"` // Replaces the banner with its ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateContentBannerRequest) Reset() {
+	*x = UpdateContentBannerRequest{}
+	mi := &file_proto_product_proto_msgTypes[227]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateContentBannerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateContentBannerRequest) ProtoMessage() {}
+
+func (x *UpdateContentBannerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[227]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateContentBannerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateContentBannerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *UpdateContentBannerRequest) GetBanner() *ContentBanner {
+	if x != nil {
+		return x.Banner
+	}
+	return nil
+}
+
+type ListContentBannersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Slot          string                 `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"` // Empty lists the banners of every slot
+	LiveOnly      bool                   `protobuf:"varint,2,opt,name=live_only,json=liveOnly,proto3" json:"live_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContentBannersRequest) Reset() {
+	*x = ListContentBannersRequest{}
+	mi := &file_proto_product_proto_msgTypes[228]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentBannersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentBannersRequest) ProtoMessage() {}
+
+func (x *ListContentBannersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[228]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentBannersRequest.ProtoReflect.Descriptor instead.
+func (*ListContentBannersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *ListContentBannersRequest) GetSlot() string {
+	if x != nil {
+		return x.Slot
+	}
+	return ""
+}
+
+func (x *ListContentBannersRequest) GetLiveOnly() bool {
+	if x != nil {
+		return x.LiveOnly
+	}
+	return false
+}
+
+type ListContentBannersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Banners       []*ContentBanner       `protobuf:"bytes,1,rep,name=banners,proto3" json:"banners,omitempty"` // Ordered by slot and position
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListContentBannersResponse) Reset() {
+	*x = ListContentBannersResponse{}
+	mi := &file_proto_product_proto_msgTypes[229]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListContentBannersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContentBannersResponse) ProtoMessage() {}
+
+func (x *ListContentBannersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[229]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContentBannersResponse.ProtoReflect.Descriptor instead.
+func (*ListContentBannersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *ListContentBannersResponse) GetBanners() []*ContentBanner {
+	if x != nil {
+		return x.Banners
+	}
+	return nil
+}
+
+type DeleteContentBannerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContentBannerRequest) Reset() {
+	*x = DeleteContentBannerRequest{}
+	mi := &file_proto_product_proto_msgTypes[230]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContentBannerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContentBannerRequest) ProtoMessage() {}
+
+func (x *DeleteContentBannerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[230]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContentBannerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteContentBannerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *DeleteContentBannerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteContentBannerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteContentBannerResponse) Reset() {
+	*x = DeleteContentBannerResponse{}
+	mi := &file_proto_product_proto_msgTypes[231]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteContentBannerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteContentBannerResponse) ProtoMessage() {}
+
+func (x *DeleteContentBannerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[231]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteContentBannerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteContentBannerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *DeleteContentBannerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// Store settings messages
+type Setting struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`    // JSON value, empty when unset
+	Public        bool                   `protobuf:"varint,3,opt,name=public,proto3" json:"public,omitempty"` // Part of the storefront config
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	IsSet         bool                   `protobuf:"varint,5,opt,name=is_set,json=isSet,proto3" json:"is_set,omitempty"`
+	UpdatedBy     string                 `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Setting) Reset() {
+	*x = Setting{}
+	mi := &file_proto_product_proto_msgTypes[232]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Setting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Setting) ProtoMessage() {}
+
+func (x *Setting) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[232]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Setting.ProtoReflect.Descriptor instead.
+func (*Setting) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *Setting) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Setting) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Setting) GetPublic() bool {
+	if x != nil {
+		return x.Public
+	}
+	return false
+}
+
+func (x *Setting) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Setting) GetIsSet() bool {
+	if x != nil {
+		return x.IsSet
+	}
+	return false
+}
+
+func (x *Setting) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *Setting) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettingsRequest) Reset() {
+	*x = ListSettingsRequest{}
+	mi := &file_proto_product_proto_msgTypes[233]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettingsRequest) ProtoMessage() {}
+
+func (x *ListSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[233]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettingsRequest.ProtoReflect.Descriptor instead.
+func (*ListSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{233}
+}
+
+type ListSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      []*Setting             `protobuf:"bytes,1,rep,name=settings,proto3" json:"settings,omitempty"` // Every known key, set or not
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSettingsResponse) Reset() {
+	*x = ListSettingsResponse{}
+	mi := &file_proto_product_proto_msgTypes[234]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSettingsResponse) ProtoMessage() {}
+
+func (x *ListSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[234]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSettingsResponse.ProtoReflect.Descriptor instead.
+func (*ListSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *ListSettingsResponse) GetSettings() []*Setting {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type SetSettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // JSON value, validated against the key
+	UpdatedBy     string                 `protobuf:"bytes,3,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSettingRequest) Reset() {
+	*x = SetSettingRequest{}
+	mi := &file_proto_product_proto_msgTypes[235]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSettingRequest) ProtoMessage() {}
+
+func (x *SetSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[235]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSettingRequest.ProtoReflect.Descriptor instead.
+func (*SetSettingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *SetSettingRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetSettingRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *SetSettingRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+type DeleteSettingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSettingRequest) Reset() {
+	*x = DeleteSettingRequest{}
+	mi := &file_proto_product_proto_msgTypes[236]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSettingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSettingRequest) ProtoMessage() {}
+
+func (x *DeleteSettingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[236]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSettingRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSettingRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *DeleteSettingRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteSettingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSettingResponse) Reset() {
+	*x = DeleteSettingResponse{}
+	mi := &file_proto_product_proto_msgTypes[237]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSettingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSettingResponse) ProtoMessage() {}
+
+func (x *DeleteSettingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[237]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSettingResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSettingResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *DeleteSettingResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetStorefrontConfigRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStorefrontConfigRequest) Reset() {
+	*x = GetStorefrontConfigRequest{}
+	mi := &file_proto_product_proto_msgTypes[238]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStorefrontConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStorefrontConfigRequest) ProtoMessage() {}
+
+func (x *GetStorefrontConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[238]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStorefrontConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetStorefrontConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{238}
+}
+
+type StoreBranding struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	LogoUrl        string                 `protobuf:"bytes,1,opt,name=logo_url,json=logoUrl,proto3" json:"logo_url,omitempty"`
+	FaviconUrl     string                 `protobuf:"bytes,2,opt,name=favicon_url,json=faviconUrl,proto3" json:"favicon_url,omitempty"`
+	PrimaryColor   string                 `protobuf:"bytes,3,opt,name=primary_color,json=primaryColor,proto3" json:"primary_color,omitempty"`
+	SecondaryColor string                 `protobuf:"bytes,4,opt,name=secondary_color,json=secondaryColor,proto3" json:"secondary_color,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StoreBranding) Reset() {
+	*x = StoreBranding{}
+	mi := &file_proto_product_proto_msgTypes[239]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoreBranding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoreBranding) ProtoMessage() {}
+
+func (x *StoreBranding) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[239]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoreBranding.ProtoReflect.Descriptor instead.
+func (*StoreBranding) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *StoreBranding) GetLogoUrl() string {
+	if x != nil {
+		return x.LogoUrl
+	}
+	return ""
+}
+
+func (x *StoreBranding) GetFaviconUrl() string {
+	if x != nil {
+		return x.FaviconUrl
+	}
+	return ""
+}
+
+func (x *StoreBranding) GetPrimaryColor() string {
+	if x != nil {
+		return x.PrimaryColor
+	}
+	return ""
+}
+
+func (x *StoreBranding) GetSecondaryColor() string {
+	if x != nil {
+		return x.SecondaryColor
+	}
+	return ""
+}
+
+// StorefrontConfig is the public configuration of the store of the request,
+// loaded by the storefront at boot
+type StorefrontConfig struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StoreId          string                 `protobuf:"bytes,1,opt,name=store_id,json=storeId,proto3" json:"store_id,omitempty"`
+	StoreName        string                 `protobuf:"bytes,2,opt,name=store_name,json=storeName,proto3" json:"store_name,omitempty"`
+	DefaultCurrency  string                 `protobuf:"bytes,3,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"`
+	DefaultLocale    string                 `protobuf:"bytes,4,opt,name=default_locale,json=defaultLocale,proto3" json:"default_locale,omitempty"`
+	ActiveCurrencies []string               `protobuf:"bytes,5,rep,name=active_currencies,json=activeCurrencies,proto3" json:"active_currencies,omitempty"` // Starting with the default currency
+	ActiveLocales    []string               `protobuf:"bytes,6,rep,name=active_locales,json=activeLocales,proto3" json:"active_locales,omitempty"`          // Starting with the default locale
+	Branding         *StoreBranding         `protobuf:"bytes,7,opt,name=branding,proto3" json:"branding,omitempty"`
+	SupportEmail     string                 `protobuf:"bytes,8,opt,name=support_email,json=supportEmail,proto3" json:"support_email,omitempty"`
+	SupportPhone     string                 `protobuf:"bytes,9,opt,name=support_phone,json=supportPhone,proto3" json:"support_phone,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StorefrontConfig) Reset() {
+	*x = StorefrontConfig{}
+	mi := &file_proto_product_proto_msgTypes[240]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StorefrontConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StorefrontConfig) ProtoMessage() {}
+
+func (x *StorefrontConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[240]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StorefrontConfig.ProtoReflect.Descriptor instead.
+func (*StorefrontConfig) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{240}
+}
+
+func (x *StorefrontConfig) GetStoreId() string {
+	if x != nil {
+		return x.StoreId
+	}
+	return ""
+}
+
+func (x *StorefrontConfig) GetStoreName() string {
+	if x != nil {
+		return x.StoreName
+	}
+	return ""
+}
+
+func (x *StorefrontConfig) GetDefaultCurrency() string {
+	if x != nil {
+		return x.DefaultCurrency
+	}
+	return ""
+}
+
+func (x *StorefrontConfig) GetDefaultLocale() string {
+	if x != nil {
+		return x.DefaultLocale
+	}
+	return ""
+}
+
+func (x *StorefrontConfig) GetActiveCurrencies() []string {
+	if x != nil {
+		return x.ActiveCurrencies
+	}
+	return nil
+}
+
+func (x *StorefrontConfig) GetActiveLocales() []string {
+	if x != nil {
+		return x.ActiveLocales
+	}
+	return nil
+}
+
+func (x *StorefrontConfig) GetBranding() *StoreBranding {
+	if x != nil {
+		return x.Branding
+	}
+	return nil
+}
+
+func (x *StorefrontConfig) GetSupportEmail() string {
+	if x != nil {
+		return x.SupportEmail
+	}
+	return ""
+}
+
+func (x *StorefrontConfig) GetSupportPhone() string {
+	if x != nil {
+		return x.SupportPhone
+	}
+	return ""
+}
+
+// Catalog quality messages
+type ProductQualityScore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Sku           string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	Score         int32                  `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`  // From 0 to 100
+	Issues        []string               `protobuf:"bytes,5,rep,name=issues,proto3" json:"issues,omitempty"` // no_images, short_description, missing_seo, no_specifications or out_of_stock
+	ComputedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=computed_at,json=computedAt,proto3" json:"computed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductQualityScore) Reset() {
+	*x = ProductQualityScore{}
+	mi := &file_proto_product_proto_msgTypes[241]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductQualityScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductQualityScore) ProtoMessage() {}
+
+func (x *ProductQualityScore) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[241]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductQualityScore.ProtoReflect.Descriptor instead.
+func (*ProductQualityScore) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{241}
+}
+
+func (x *ProductQualityScore) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductQualityScore) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ProductQualityScore) GetSku() string {
 	if x != nil {
 		return x.Sku
 	}
 	return ""
 }
 
+func (x *ProductQualityScore) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ProductQualityScore) GetIssues() []string {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *ProductQualityScore) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
+}
+
+type GetCatalogQualityReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MaxScore      *wrapperspb.Int32Value `protobuf:"bytes,1,opt,name=max_score,json=maxScore,proto3" json:"max_score,omitempty"` // Only products scoring at most this
+	Issue         string                 `protobuf:"bytes,2,opt,name=issue,proto3" json:"issue,omitempty"`                       // Only products with this issue
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                      // Defaults to 50
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCatalogQualityReportRequest) Reset() {
+	*x = GetCatalogQualityReportRequest{}
+	mi := &file_proto_product_proto_msgTypes[242]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCatalogQualityReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCatalogQualityReportRequest) ProtoMessage() {}
+
+func (x *GetCatalogQualityReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[242]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCatalogQualityReportRequest.ProtoReflect.Descriptor instead.
+func (*GetCatalogQualityReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *GetCatalogQualityReportRequest) GetMaxScore() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.MaxScore
+	}
+	return nil
+}
+
+func (x *GetCatalogQualityReportRequest) GetIssue() string {
+	if x != nil {
+		return x.Issue
+	}
+	return ""
+}
+
+func (x *GetCatalogQualityReportRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCatalogQualityReportRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type CatalogQualityReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScoredCount   int32                  `protobuf:"varint,1,opt,name=scored_count,json=scoredCount,proto3" json:"scored_count,omitempty"`
+	UnscoredCount int32                  `protobuf:"varint,2,opt,name=unscored_count,json=unscoredCount,proto3" json:"unscored_count,omitempty"` // Products never scored, see RecomputeCatalogQuality
+	AverageScore  float64                `protobuf:"fixed64,3,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+	IssueCounts   map[string]int32       `protobuf:"bytes,4,rep,name=issue_counts,json=issueCounts,proto3" json:"issue_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"` // Products per issue
+	Products      []*ProductQualityScore `protobuf:"bytes,5,rep,name=products,proto3" json:"products,omitempty"`                                                                                                     // Lowest scores first
+	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`                                                                                                          // Products matching the filter
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CatalogQualityReport) Reset() {
+	*x = CatalogQualityReport{}
+	mi := &file_proto_product_proto_msgTypes[243]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CatalogQualityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogQualityReport) ProtoMessage() {}
+
+func (x *CatalogQualityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[243]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogQualityReport.ProtoReflect.Descriptor instead.
+func (*CatalogQualityReport) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *CatalogQualityReport) GetScoredCount() int32 {
+	if x != nil {
+		return x.ScoredCount
+	}
+	return 0
+}
+
+func (x *CatalogQualityReport) GetUnscoredCount() int32 {
+	if x != nil {
+		return x.UnscoredCount
+	}
+	return 0
+}
+
+func (x *CatalogQualityReport) GetAverageScore() float64 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *CatalogQualityReport) GetIssueCounts() map[string]int32 {
+	if x != nil {
+		return x.IssueCounts
+	}
+	return nil
+}
+
+func (x *CatalogQualityReport) GetProducts() []*ProductQualityScore {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *CatalogQualityReport) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type GetProductQualityScoreRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductQualityScoreRequest) Reset() {
+	*x = GetProductQualityScoreRequest{}
+	mi := &file_proto_product_proto_msgTypes[244]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductQualityScoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductQualityScoreRequest) ProtoMessage() {}
+
+func (x *GetProductQualityScoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[244]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductQualityScoreRequest.ProtoReflect.Descriptor instead.
+func (*GetProductQualityScoreRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{244}
+}
+
+func (x *GetProductQualityScoreRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type RecomputeCatalogQualityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeCatalogQualityRequest) Reset() {
+	*x = RecomputeCatalogQualityRequest{}
+	mi := &file_proto_product_proto_msgTypes[245]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeCatalogQualityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeCatalogQualityRequest) ProtoMessage() {}
+
+func (x *RecomputeCatalogQualityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[245]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeCatalogQualityRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeCatalogQualityRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{245}
+}
+
+type RecomputeCatalogQualityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scored        int32                  `protobuf:"varint,1,opt,name=scored,proto3" json:"scored,omitempty"`
+	Failed        int32                  `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecomputeCatalogQualityResponse) Reset() {
+	*x = RecomputeCatalogQualityResponse{}
+	mi := &file_proto_product_proto_msgTypes[246]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecomputeCatalogQualityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeCatalogQualityResponse) ProtoMessage() {}
+
+func (x *RecomputeCatalogQualityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[246]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeCatalogQualityResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeCatalogQualityResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *RecomputeCatalogQualityResponse) GetScored() int32 {
+	if x != nil {
+		return x.Scored
+	}
+	return 0
+}
+
+func (x *RecomputeCatalogQualityResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+// Diagnostics messages
+type GetDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiagnosticsRequest) Reset() {
+	*x = GetDiagnosticsRequest{}
+	mi := &file_proto_product_proto_msgTypes[247]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[247]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{247}
+}
+
+type DBPoolDiagnostics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Replica             bool                   `protobuf:"varint,2,opt,name=replica,proto3" json:"replica,omitempty"`
+	MaxOpenConnections  int32                  `protobuf:"varint,3,opt,name=max_open_connections,json=maxOpenConnections,proto3" json:"max_open_connections,omitempty"`
+	OpenConnections     int32                  `protobuf:"varint,4,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
+	InUse               int32                  `protobuf:"varint,5,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	Idle                int32                  `protobuf:"varint,6,opt,name=idle,proto3" json:"idle,omitempty"`
+	WaitCount           int64                  `protobuf:"varint,7,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	WaitDurationSeconds float64                `protobuf:"fixed64,8,opt,name=wait_duration_seconds,json=waitDurationSeconds,proto3" json:"wait_duration_seconds,omitempty"`
+	MaxIdleClosed       int64                  `protobuf:"varint,9,opt,name=max_idle_closed,json=maxIdleClosed,proto3" json:"max_idle_closed,omitempty"`
+	MaxIdleTimeClosed   int64                  `protobuf:"varint,10,opt,name=max_idle_time_closed,json=maxIdleTimeClosed,proto3" json:"max_idle_time_closed,omitempty"`
+	MaxLifetimeClosed   int64                  `protobuf:"varint,11,opt,name=max_lifetime_closed,json=maxLifetimeClosed,proto3" json:"max_lifetime_closed,omitempty"`
+	ReplicaLagKnown     bool                   `protobuf:"varint,12,opt,name=replica_lag_known,json=replicaLagKnown,proto3" json:"replica_lag_known,omitempty"`
+	ReplicaLagSeconds   float64                `protobuf:"fixed64,13,opt,name=replica_lag_seconds,json=replicaLagSeconds,proto3" json:"replica_lag_seconds,omitempty"`
+	Excluded            bool                   `protobuf:"varint,14,opt,name=excluded,proto3" json:"excluded,omitempty"` // Reads are routed away from the replica
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DBPoolDiagnostics) Reset() {
+	*x = DBPoolDiagnostics{}
+	mi := &file_proto_product_proto_msgTypes[248]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBPoolDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBPoolDiagnostics) ProtoMessage() {}
+
+func (x *DBPoolDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[248]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBPoolDiagnostics.ProtoReflect.Descriptor instead.
+func (*DBPoolDiagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{248}
+}
+
+func (x *DBPoolDiagnostics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DBPoolDiagnostics) GetReplica() bool {
+	if x != nil {
+		return x.Replica
+	}
+	return false
+}
+
+func (x *DBPoolDiagnostics) GetMaxOpenConnections() int32 {
+	if x != nil {
+		return x.MaxOpenConnections
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetOpenConnections() int32 {
+	if x != nil {
+		return x.OpenConnections
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetInUse() int32 {
+	if x != nil {
+		return x.InUse
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetIdle() int32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetWaitCount() int64 {
+	if x != nil {
+		return x.WaitCount
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetWaitDurationSeconds() float64 {
+	if x != nil {
+		return x.WaitDurationSeconds
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxIdleClosed() int64 {
+	if x != nil {
+		return x.MaxIdleClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxIdleTimeClosed() int64 {
+	if x != nil {
+		return x.MaxIdleTimeClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxLifetimeClosed() int64 {
+	if x != nil {
+		return x.MaxLifetimeClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetReplicaLagKnown() bool {
+	if x != nil {
+		return x.ReplicaLagKnown
+	}
+	return false
+}
+
+func (x *DBPoolDiagnostics) GetReplicaLagSeconds() float64 {
+	if x != nil {
+		return x.ReplicaLagSeconds
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetExcluded() bool {
+	if x != nil {
+		return x.Excluded
+	}
+	return false
+}
+
+type CacheDiagnostics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CircuitBreakerState string                 `protobuf:"bytes,2,opt,name=circuit_breaker_state,json=circuitBreakerState,proto3" json:"circuit_breaker_state,omitempty"` // closed, open or half-open
+	Healthy             bool                   `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	MemoryEntries       int64                  `protobuf:"varint,4,opt,name=memory_entries,json=memoryEntries,proto3" json:"memory_entries,omitempty"`
+	Hits                int64                  `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses              int64                  `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`
+	Errors              int64                  `protobuf:"varint,7,opt,name=errors,proto3" json:"errors,omitempty"`
+	HitRate             float64                `protobuf:"fixed64,8,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CacheDiagnostics) Reset() {
+	*x = CacheDiagnostics{}
+	mi := &file_proto_product_proto_msgTypes[249]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheDiagnostics) ProtoMessage() {}
+
+func (x *CacheDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[249]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheDiagnostics.ProtoReflect.Descriptor instead.
+func (*CacheDiagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{249}
+}
+
+func (x *CacheDiagnostics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CacheDiagnostics) GetCircuitBreakerState() string {
+	if x != nil {
+		return x.CircuitBreakerState
+	}
+	return ""
+}
+
+func (x *CacheDiagnostics) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *CacheDiagnostics) GetMemoryEntries() int64 {
+	if x != nil {
+		return x.MemoryEntries
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetHitRate() float64 {
+	if x != nil {
+		return x.HitRate
+	}
+	return 0
+}
+
+type DiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	CollectedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"`
+	UptimeSeconds float64                `protobuf:"fixed64,3,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	Goroutines    int32                  `protobuf:"varint,4,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	DbPools       []*DBPoolDiagnostics   `protobuf:"bytes,5,rep,name=db_pools,json=dbPools,proto3" json:"db_pools,omitempty"`
+	Caches        []*CacheDiagnostics    `protobuf:"bytes,6,rep,name=caches,proto3" json:"caches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosticsResponse) Reset() {
+	*x = DiagnosticsResponse{}
+	mi := &file_proto_product_proto_msgTypes[250]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsResponse) ProtoMessage() {}
+
+func (x *DiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[250]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*DiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{250}
+}
+
+func (x *DiagnosticsResponse) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *DiagnosticsResponse) GetCollectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CollectedAt
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetUptimeSeconds() float64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetGoroutines() int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetDbPools() []*DBPoolDiagnostics {
+	if x != nil {
+		return x.DbPools
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetCaches() []*CacheDiagnostics {
+	if x != nil {
+		return x.Caches
+	}
+	return nil
+}
+
+// Cache administration messages
+type FlushCacheNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // product, category or brand
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushCacheNamespaceRequest) Reset() {
+	*x = FlushCacheNamespaceRequest{}
+	mi := &file_proto_product_proto_msgTypes[251]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushCacheNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCacheNamespaceRequest) ProtoMessage() {}
+
+func (x *FlushCacheNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[251]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCacheNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*FlushCacheNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{251}
+}
+
+func (x *FlushCacheNamespaceRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+type FlushCacheNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Version       int32                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // Current key version of the namespace
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FlushCacheNamespaceResponse) Reset() {
+	*x = FlushCacheNamespaceResponse{}
+	mi := &file_proto_product_proto_msgTypes[252]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FlushCacheNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCacheNamespaceResponse) ProtoMessage() {}
+
+func (x *FlushCacheNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[252]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCacheNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*FlushCacheNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{252}
+}
+
+func (x *FlushCacheNamespaceResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *FlushCacheNamespaceResponse) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Cache schedules invalidate a cache namespace and warm queries again at set
+// times of day; they are configured in the product service config
+type CacheWarmQuery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`       // products, categories, brands, collection or product
+	Target        string                 `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`   // Collection slug, or product slug or ID
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"` // Channel of product lists
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheWarmQuery) Reset() {
+	*x = CacheWarmQuery{}
+	mi := &file_proto_product_proto_msgTypes[253]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheWarmQuery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheWarmQuery) ProtoMessage() {}
+
+func (x *CacheWarmQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[253]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheWarmQuery.ProtoReflect.Descriptor instead.
+func (*CacheWarmQuery) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{253}
+}
+
+func (x *CacheWarmQuery) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CacheWarmQuery) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *CacheWarmQuery) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CacheWarmQuery) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CacheWarmQuery) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type CacheScheduleRun struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduleName  string                 `protobuf:"bytes,2,opt,name=schedule_name,json=scheduleName,proto3" json:"schedule_name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Trigger       string                 `protobuf:"bytes,4,opt,name=trigger,proto3" json:"trigger,omitempty"`                               // scheduled or manual
+	ScheduledFor  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scheduled_for,json=scheduledFor,proto3" json:"scheduled_for,omitempty"` // Set for scheduled runs
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                                 // running, succeeded, partial or failed
+	Invalidated   bool                   `protobuf:"varint,7,opt,name=invalidated,proto3" json:"invalidated,omitempty"`
+	Warmed        int32                  `protobuf:"varint,8,opt,name=warmed,proto3" json:"warmed,omitempty"` // Warm queries run, once per store
+	Failed        int32                  `protobuf:"varint,9,opt,name=failed,proto3" json:"failed,omitempty"` // Warm queries that failed
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`   // First error of the run
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheScheduleRun) Reset() {
+	*x = CacheScheduleRun{}
+	mi := &file_proto_product_proto_msgTypes[254]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheScheduleRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheScheduleRun) ProtoMessage() {}
+
+func (x *CacheScheduleRun) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[254]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheScheduleRun.ProtoReflect.Descriptor instead.
+func (*CacheScheduleRun) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{254}
+}
+
+func (x *CacheScheduleRun) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CacheScheduleRun) GetScheduleName() string {
+	if x != nil {
+		return x.ScheduleName
+	}
+	return ""
+}
+
+func (x *CacheScheduleRun) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CacheScheduleRun) GetTrigger() string {
+	if x != nil {
+		return x.Trigger
+	}
+	return ""
+}
+
+func (x *CacheScheduleRun) GetScheduledFor() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduledFor
+	}
+	return nil
+}
+
+func (x *CacheScheduleRun) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CacheScheduleRun) GetInvalidated() bool {
+	if x != nil {
+		return x.Invalidated
+	}
+	return false
+}
+
+func (x *CacheScheduleRun) GetWarmed() int32 {
+	if x != nil {
+		return x.Warmed
+	}
+	return 0
+}
+
+func (x *CacheScheduleRun) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *CacheScheduleRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CacheScheduleRun) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *CacheScheduleRun) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+type CacheSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	At            string                 `protobuf:"bytes,3,opt,name=at,proto3" json:"at,omitempty"`     // Time of day, as HH:MM
+	Days          []string               `protobuf:"bytes,4,rep,name=days,proto3" json:"days,omitempty"` // Days of the week, every day when empty
+	Timezone      string                 `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	Invalidate    bool                   `protobuf:"varint,6,opt,name=invalidate,proto3" json:"invalidate,omitempty"`
+	Warm          []*CacheWarmQuery      `protobuf:"bytes,7,rep,name=warm,proto3" json:"warm,omitempty"`
+	NextRunAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at,omitempty"`
+	LastRun       *CacheScheduleRun      `protobuf:"bytes,9,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheSchedule) Reset() {
+	*x = CacheSchedule{}
+	mi := &file_proto_product_proto_msgTypes[255]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheSchedule) ProtoMessage() {}
+
+func (x *CacheSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[255]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheSchedule.ProtoReflect.Descriptor instead.
+func (*CacheSchedule) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{255}
+}
+
+func (x *CacheSchedule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CacheSchedule) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CacheSchedule) GetAt() string {
+	if x != nil {
+		return x.At
+	}
+	return ""
+}
+
+func (x *CacheSchedule) GetDays() []string {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *CacheSchedule) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CacheSchedule) GetInvalidate() bool {
+	if x != nil {
+		return x.Invalidate
+	}
+	return false
+}
+
+func (x *CacheSchedule) GetWarm() []*CacheWarmQuery {
+	if x != nil {
+		return x.Warm
+	}
+	return nil
+}
+
+func (x *CacheSchedule) GetNextRunAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return nil
+}
+
+func (x *CacheSchedule) GetLastRun() *CacheScheduleRun {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+type ListCacheSchedulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCacheSchedulesRequest) Reset() {
+	*x = ListCacheSchedulesRequest{}
+	mi := &file_proto_product_proto_msgTypes[256]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCacheSchedulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCacheSchedulesRequest) ProtoMessage() {}
+
+func (x *ListCacheSchedulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[256]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCacheSchedulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCacheSchedulesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{256}
+}
+
+type ListCacheSchedulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedules     []*CacheSchedule       `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCacheSchedulesResponse) Reset() {
+	*x = ListCacheSchedulesResponse{}
+	mi := &file_proto_product_proto_msgTypes[257]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCacheSchedulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCacheSchedulesResponse) ProtoMessage() {}
+
+func (x *ListCacheSchedulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[257]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCacheSchedulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCacheSchedulesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{257}
+}
+
+func (x *ListCacheSchedulesResponse) GetSchedules() []*CacheSchedule {
+	if x != nil {
+		return x.Schedules
+	}
+	return nil
+}
+
+type ListCacheScheduleRunsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleName  string                 `protobuf:"bytes,1,opt,name=schedule_name,json=scheduleName,proto3" json:"schedule_name,omitempty"` // All schedules when empty
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCacheScheduleRunsRequest) Reset() {
+	*x = ListCacheScheduleRunsRequest{}
+	mi := &file_proto_product_proto_msgTypes[258]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCacheScheduleRunsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCacheScheduleRunsRequest) ProtoMessage() {}
+
+func (x *ListCacheScheduleRunsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[258]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCacheScheduleRunsRequest.ProtoReflect.Descriptor instead.
+func (*ListCacheScheduleRunsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{258}
+}
+
+func (x *ListCacheScheduleRunsRequest) GetScheduleName() string {
+	if x != nil {
+		return x.ScheduleName
+	}
+	return ""
+}
+
+func (x *ListCacheScheduleRunsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCacheScheduleRunsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Runs          []*CacheScheduleRun    `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCacheScheduleRunsResponse) Reset() {
+	*x = ListCacheScheduleRunsResponse{}
+	mi := &file_proto_product_proto_msgTypes[259]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCacheScheduleRunsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCacheScheduleRunsResponse) ProtoMessage() {}
+
+func (x *ListCacheScheduleRunsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[259]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCacheScheduleRunsResponse.ProtoReflect.Descriptor instead.
+func (*ListCacheScheduleRunsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{259}
+}
+
+func (x *ListCacheScheduleRunsResponse) GetRuns() []*CacheScheduleRun {
+	if x != nil {
+		return x.Runs
+	}
+	return nil
+}
+
+type RunCacheScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RunCacheScheduleRequest) Reset() {
+	*x = RunCacheScheduleRequest{}
+	mi := &file_proto_product_proto_msgTypes[260]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RunCacheScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RunCacheScheduleRequest) ProtoMessage() {}
+
+func (x *RunCacheScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_product_proto_msgTypes[260]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RunCacheScheduleRequest.ProtoReflect.Descriptor instead.
+func (*RunCacheScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_product_proto_rawDescGZIP(), []int{260}
+}
+
+func (x *RunCacheScheduleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
 var File_proto_product_proto protoreflect.FileDescriptor
 
 const file_proto_product_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/product.proto\x12\aproduct\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"A\n" +
+	"\x13proto/product.proto\x12\aproduct\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x1cgoogle/protobuf/struct.proto\"A\n" +
 	"\x15VariantAttributeValue\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value\"\xfc\x01\n" +
@@ -2611,7 +16930,7 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd2\x06\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x87\a\n" +
 	"\x0eProductVariant\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -2639,7 +16958,8 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x05brand\x18\x11 \x01(\v2\x0e.product.BrandR\x05brand\x12%\n" +
 	"\x03seo\x18\x12 \x01(\v2\x13.product.ProductSEOR\x03seo\x124\n" +
 	"\bshipping\x18\x13 \x01(\v2\x18.product.ProductShippingR\bshipping\x124\n" +
-	"\bdiscount\x18\x14 \x01(\v2\x18.product.ProductDiscountR\bdiscount\"\xc3\x01\n" +
+	"\bdiscount\x18\x14 \x01(\v2\x18.product.ProductDiscountR\bdiscount\x123\n" +
+	"\bmetadata\x18\x15 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\xc3\x01\n" +
 	"\n" +
 	"ProductTag\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
@@ -2707,7 +17027,7 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xce\b\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf7\f\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -2738,7 +17058,17 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x0especifications\x18\x17 \x03(\v2\x1d.product.ProductSpecificationR\x0especifications\x12%\n" +
 	"\x03seo\x18\x18 \x01(\v2\x13.product.ProductSEOR\x03seo\x124\n" +
 	"\bshipping\x18\x19 \x01(\v2\x18.product.ProductShippingR\bshipping\x124\n" +
-	"\bdiscount\x18\x1a \x01(\v2\x18.product.ProductDiscountR\bdiscount\"\xfc\x01\n" +
+	"\bdiscount\x18\x1a \x01(\v2\x18.product.ProductDiscountR\bdiscount\x12.\n" +
+	"\x06bundle\x18\x1b \x01(\v2\x16.product.ProductBundleR\x06bundle\x12!\n" +
+	"\fproduct_type\x18\x1c \x01(\tR\vproductType\x12+\n" +
+	"\x11requires_shipping\x18\x1d \x01(\bR\x10requiresShipping\x12:\n" +
+	"\rdigital_asset\x18\x1e \x01(\v2\x15.product.DigitalAssetR\fdigitalAsset\x12=\n" +
+	"\fsubscription\x18\x1f \x01(\v2\x19.product.SubscriptionPlanR\fsubscription\x12B\n" +
+	"\x10related_products\x18  \x03(\v2\x17.product.RelatedProductR\x0frelatedProducts\x12-\n" +
+	"\x06badges\x18! \x03(\v2\x15.product.ProductBadgeR\x06badges\x123\n" +
+	"\bmetadata\x18\" \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12W\n" +
+	"\x17personalization_options\x18# \x03(\v2\x1e.product.PersonalizationOptionR\x16personalizationOptions\x12+\n" +
+	"\x05media\x18$ \x03(\v2\x15.product.ProductMediaR\x05media\"\xfc\x01\n" +
 	"\fProductImage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -2749,7 +17079,25 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x92\x02\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9e\x03\n" +
+	"\fProductMedia\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bprovider\x18\x04 \x01(\tR\bprovider\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12\x1b\n" +
+	"\tembed_url\x18\x06 \x01(\tR\bembedUrl\x12\x16\n" +
+	"\x06format\x18\a \x01(\tR\x06format\x12#\n" +
+	"\rthumbnail_url\x18\b \x01(\tR\fthumbnailUrl\x12\x19\n" +
+	"\balt_text\x18\t \x01(\tR\aaltText\x12\x1a\n" +
+	"\bposition\x18\n" +
+	" \x01(\x05R\bposition\x12\x16\n" +
+	"\x06status\x18\v \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x92\x02\n" +
 	"\x05Brand\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -2760,7 +17108,7 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
 	"\n" +
-	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\xf1\x02\n" +
+	"deleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"\x8d\x03\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -2774,12 +17122,15 @@ const file_proto_product_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
 	"\n" +
-	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"B\n" +
+	"deleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x12\x1a\n" +
+	"\bposition\x18\n" +
+	" \x01(\x05R\bposition\"B\n" +
 	"\x14CreateProductRequest\x12*\n" +
-	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"I\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"n\n" +
 	"\x11GetProductRequest\x12\x10\n" +
 	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12\x14\n" +
-	"\x04slug\x18\x02 \x01(\tH\x00R\x04slugB\f\n" +
+	"\x04slug\x18\x02 \x01(\tH\x00R\x04slug\x12#\n" +
+	"\rrelated_limit\x18\x03 \x01(\x05R\frelatedLimitB\f\n" +
 	"\n" +
 	"identifier\"B\n" +
 	"\x14UpdateProductRequest\x12*\n" +
@@ -2787,10 +17138,11 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
 	"\x15DeleteProductResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"?\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Y\n" +
 	"\x13ListProductsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\"Z\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\"Z\n" +
 	"\x14ListProductsResponse\x12,\n" +
 	"\bproducts\x18\x01 \x03(\v2\x10.product.ProductR\bproducts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"G\n" +
@@ -2821,7 +17173,70 @@ const file_proto_product_proto_rawDesc = "" +
 	"categories\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\"F\n" +
 	"\x15CreateCategoryRequest\x12-\n" +
-	"\bcategory\x18\x01 \x01(\v2\x11.product.CategoryR\bcategory\"\xb0\x01\n" +
+	"\bcategory\x18\x01 \x01(\v2\x11.product.CategoryR\bcategory\"`\n" +
+	"\x13MoveCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x129\n" +
+	"\tparent_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\bparentId\"R\n" +
+	"\x16MergeCategoriesRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\"\x96\x01\n" +
+	"\x17MergeCategoriesResponse\x12-\n" +
+	"\bcategory\x18\x01 \x01(\v2\x11.product.CategoryR\bcategory\x12%\n" +
+	"\x0eproducts_moved\x18\x02 \x01(\x05R\rproductsMoved\x12%\n" +
+	"\x0echildren_moved\x18\x03 \x01(\x05R\rchildrenMoved\"v\n" +
+	"\x16ReorderSiblingsRequest\x129\n" +
+	"\tparent_id\x18\x01 \x01(\v2\x1c.google.protobuf.StringValueR\bparentId\x12!\n" +
+	"\fcategory_ids\x18\x02 \x03(\tR\vcategoryIds\"L\n" +
+	"\x17ReorderSiblingsResponse\x121\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x11.product.CategoryR\n" +
+	"categories\"\x90\x03\n" +
+	"\x11CategoryAttribute\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x1e\n" +
+	"\n" +
+	"filterable\x18\x05 \x01(\bR\n" +
+	"filterable\x12%\n" +
+	"\x0eallowed_values\x18\x06 \x03(\tR\rallowedValues\x12#\n" +
+	"\rdefault_value\x18\a \x01(\tR\fdefaultValue\x12\x1a\n" +
+	"\bposition\x18\b \x01(\x05R\bposition\x12\x1c\n" +
+	"\tinherited\x18\t \x01(\bR\tinherited\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"Z\n" +
+	"\x1eCreateCategoryAttributeRequest\x128\n" +
+	"\tattribute\x18\x01 \x01(\v2\x1a.product.CategoryAttributeR\tattribute\"Z\n" +
+	"\x1eUpdateCategoryAttributeRequest\x128\n" +
+	"\tattribute\x18\x01 \x01(\v2\x1a.product.CategoryAttributeR\tattribute\"m\n" +
+	"\x1dListCategoryAttributesRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12+\n" +
+	"\x11include_inherited\x18\x02 \x01(\bR\x10includeInherited\"\\\n" +
+	"\x1eListCategoryAttributesResponse\x12:\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\v2\x1a.product.CategoryAttributeR\n" +
+	"attributes\"0\n" +
+	"\x1eDeleteCategoryAttributeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x1fDeleteCategoryAttributeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\";\n" +
+	"\x18GetCategoryFacetsRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\"8\n" +
+	"\n" +
+	"FacetValue\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"H\n" +
+	"\x05Facet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
+	"\x06values\x18\x02 \x03(\v2\x13.product.FacetValueR\x06values\"C\n" +
+	"\x19GetCategoryFacetsResponse\x12&\n" +
+	"\x06facets\x18\x01 \x03(\v2\x0e.product.FacetR\x06facets\"\xb0\x01\n" +
 	"\x12UploadImageRequest\x12\x12\n" +
 	"\x04file\x18\x01 \x01(\fR\x04file\x12\x16\n" +
 	"\x06folder\x18\x02 \x01(\tR\x06folder\x12\x19\n" +
@@ -2835,7 +17250,49 @@ const file_proto_product_proto_rawDesc = "" +
 	"\balt_text\x18\x03 \x01(\tR\aaltText\x12\x1a\n" +
 	"\bposition\x18\x04 \x01(\x05R\bposition\"1\n" +
 	"\x12DeleteImageRequest\x12\x1b\n" +
-	"\tpublic_id\x18\x01 \x01(\tR\bpublicId\"/\n" +
+	"\tpublic_id\x18\x01 \x01(\tR\bpublicId\"X\n" +
+	"\x18CreateMediaUploadRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\x02 \x01(\tR\tmediaType\"\xe1\x02\n" +
+	"\vMediaUpload\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"upload_url\x18\x03 \x01(\tR\tuploadUrl\x128\n" +
+	"\x06fields\x18\x04 \x03(\v2 .product.MediaUpload.FieldsEntryR\x06fields\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1b\n" +
+	"\tmax_bytes\x18\x06 \x01(\x03R\bmaxBytes\x12\x18\n" +
+	"\aformats\x18\a \x03(\tR\aformats\x12\x1d\n" +
+	"\n" +
+	"media_type\x18\b \x01(\tR\tmediaType\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8e\x01\n" +
+	"\x19ConfirmMediaUploadRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\x12\x19\n" +
+	"\balt_text\x18\x03 \x01(\tR\aaltText\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"v\n" +
+	"\x1aConfirmMediaUploadResponse\x12+\n" +
+	"\x05image\x18\x01 \x01(\v2\x15.product.ProductImageR\x05image\x12+\n" +
+	"\x05media\x18\x02 \x01(\v2\x15.product.ProductMediaR\x05media\"\x80\x01\n" +
+	"\x16AddProductVideoRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x19\n" +
+	"\balt_text\x18\x03 \x01(\tR\aaltText\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"U\n" +
+	"\x19DeleteProductMediaRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x19\n" +
+	"\bmedia_id\x18\x02 \x01(\tR\amediaId\"6\n" +
+	"\x1aDeleteProductMediaResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
 	"\x13DeleteImageResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x89\x01\n" +
 	"\x19GenerateSKUPreviewRequest\x12\x1d\n" +
@@ -2845,7 +17302,1050 @@ const file_proto_product_proto_rawDesc = "" +
 	"\x05color\x18\x03 \x01(\tR\x05color\x12\x12\n" +
 	"\x04size\x18\x04 \x01(\tR\x04size\".\n" +
 	"\x1aGenerateSKUPreviewResponse\x12\x10\n" +
-	"\x03sku\x18\x01 \x01(\tR\x03sku2\xf0\a\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\"e\n" +
+	"\x0fCollectionRules\x12\x1b\n" +
+	"\tbrand_ids\x18\x01 \x03(\tR\bbrandIds\x12!\n" +
+	"\fcategory_ids\x18\x02 \x03(\tR\vcategoryIds\x12\x12\n" +
+	"\x04tags\x18\x03 \x03(\tR\x04tags\"\xa8\x03\n" +
+	"\n" +
+	"Collection\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12!\n" +
+	"\fis_published\x18\x06 \x01(\bR\visPublished\x12.\n" +
+	"\x05rules\x18\a \x01(\v2\x18.product.CollectionRulesR\x05rules\x12\x1f\n" +
+	"\vproduct_ids\x18\b \x03(\tR\n" +
+	"productIds\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\"N\n" +
+	"\x17CreateCollectionRequest\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.product.CollectionR\n" +
+	"collection\"L\n" +
+	"\x14GetCollectionRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12\x14\n" +
+	"\x04slug\x18\x02 \x01(\tH\x00R\x04slugB\f\n" +
+	"\n" +
+	"identifier\"N\n" +
+	"\x17UpdateCollectionRequest\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.product.CollectionR\n" +
+	"collection\")\n" +
+	"\x17DeleteCollectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x18DeleteCollectionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"i\n" +
+	"\x16ListCollectionsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12%\n" +
+	"\x0epublished_only\x18\x03 \x01(\bR\rpublishedOnly\"\x90\x01\n" +
+	"\x17ListCollectionsResponse\x125\n" +
+	"\vcollections\x18\x01 \x03(\v2\x13.product.CollectionR\vcollections\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"d\n" +
+	"\x1cSetCollectionProductsRequest\x12#\n" +
+	"\rcollection_id\x18\x01 \x01(\tR\fcollectionId\x12\x1f\n" +
+	"\vproduct_ids\x18\x02 \x03(\tR\n" +
+	"productIds\"\x7f\n" +
+	"\x1dListCollectionProductsRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12\x14\n" +
+	"\x04slug\x18\x02 \x01(\tH\x00R\x04slug\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limitB\f\n" +
+	"\n" +
+	"identifier\"\xc3\x01\n" +
+	"\x1eListCollectionProductsResponse\x123\n" +
+	"\n" +
+	"collection\x18\x01 \x01(\v2\x13.product.CollectionR\n" +
+	"collection\x12,\n" +
+	"\bproducts\x18\x02 \x03(\v2\x10.product.ProductR\bproducts\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\xb2\x01\n" +
+	"\x0fBundleComponent\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\tR\tvariantId\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x06 \x01(\x01R\tunitPrice\"\xb9\x01\n" +
+	"\rProductBundle\x128\n" +
+	"\n" +
+	"components\x18\x01 \x03(\v2\x18.product.BundleComponentR\n" +
+	"components\x12C\n" +
+	"\x0eprice_override\x18\x02 \x01(\v2\x1c.google.protobuf.DoubleValueR\rpriceOverride\x12)\n" +
+	"\x10components_price\x18\x03 \x01(\x01R\x0fcomponentsPrice\"\xc0\x01\n" +
+	"\x13CreateBundleRequest\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\x128\n" +
+	"\n" +
+	"components\x18\x02 \x03(\v2\x18.product.BundleComponentR\n" +
+	"components\x12C\n" +
+	"\x0eprice_override\x18\x03 \x01(\v2\x1c.google.protobuf.DoubleValueR\rpriceOverride\"\xb9\x02\n" +
+	"\fDigitalAsset\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x05 \x01(\x03R\tsizeBytes\x12%\n" +
+	"\x0edownload_limit\x18\x06 \x01(\x05R\rdownloadLimit\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xae\x01\n" +
+	"\x19UploadDigitalAssetRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04file\x18\x02 \x01(\fR\x04file\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x1b\n" +
+	"\tmime_type\x18\x04 \x01(\tR\bmimeType\x12%\n" +
+	"\x0edownload_limit\x18\x05 \x01(\x05R\rdownloadLimit\"t\n" +
+	"\x19CreateDownloadLinkRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vpurchase_id\x18\x02 \x01(\tR\n" +
+	"purchaseId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"\xc9\x01\n" +
+	"\fDownloadLink\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12/\n" +
+	"\x13downloads_remaining\x18\x04 \x01(\x05R\x12downloadsRemaining\x12%\n" +
+	"\x0edownload_limit\x18\x05 \x01(\x05R\rdownloadLimit\"3\n" +
+	"\x1bDownloadDigitalAssetRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xb7\x01\n" +
+	"\x11DigitalAssetChunk\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\x12/\n" +
+	"\x13downloads_remaining\x18\x04 \x01(\x05R\x12downloadsRemaining\x12\x12\n" +
+	"\x04data\x18\x05 \x01(\fR\x04data\"\x89\x02\n" +
+	"\x10SubscriptionPlan\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\x12%\n" +
+	"\x0einterval_count\x18\x03 \x01(\x05R\rintervalCount\x12\x1d\n" +
+	"\n" +
+	"trial_days\x18\x04 \x01(\x05R\ttrialDays\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9d\x01\n" +
+	"\x1aSetSubscriptionPlanRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\tR\binterval\x12%\n" +
+	"\x0einterval_count\x18\x03 \x01(\x05R\rintervalCount\x12\x1d\n" +
+	"\n" +
+	"trial_days\x18\x04 \x01(\x05R\ttrialDays\"\xff\x04\n" +
+	"\fSubscription\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpurchase_id\x18\x04 \x01(\tR\n" +
+	"purchaseId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x14\n" +
+	"\x05price\x18\x06 \x01(\x01R\x05price\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12L\n" +
+	"\x14current_period_start\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x12currentPeriodStart\x12H\n" +
+	"\x12current_period_end\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x10currentPeriodEnd\x12>\n" +
+	"\rtrial_ends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vtrialEndsAt\x12/\n" +
+	"\x14cancel_at_period_end\x18\v \x01(\bR\x11cancelAtPeriodEnd\x12=\n" +
+	"\fcancelled_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\vcancelledAt\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x90\x01\n" +
+	"\x19CreateSubscriptionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vpurchase_id\x18\x03 \x01(\tR\n" +
+	"purchaseId\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"(\n" +
+	"\x16GetSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x19CancelSubscriptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\"\n" +
+	"\rat_period_end\x18\x02 \x01(\bR\vatPeriodEnd\"u\n" +
+	"\x18ListSubscriptionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"n\n" +
+	"\x19ListSubscriptionsResponse\x12;\n" +
+	"\rsubscriptions\x18\x01 \x03(\v2\x15.product.SubscriptionR\rsubscriptions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"\x81\x03\n" +
+	"\x11SubscriptionEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12'\n" +
+	"\x0fsubscription_id\x18\x02 \x01(\tR\x0esubscriptionId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x05 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12=\n" +
+	"\fperiod_start\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vperiodStart\x129\n" +
+	"\n" +
+	"period_end\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tperiodEnd\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"5\n" +
+	"\x1dListSubscriptionEventsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"T\n" +
+	"\x1eListSubscriptionEventsResponse\x122\n" +
+	"\x06events\x18\x01 \x03(\v2\x1a.product.SubscriptionEventR\x06events\";\n" +
+	"\x1cAckSubscriptionEventsRequest\x12\x1b\n" +
+	"\tevent_ids\x18\x01 \x03(\tR\beventIds\"C\n" +
+	"\x1dAckSubscriptionEventsResponse\x12\"\n" +
+	"\facknowledged\x18\x01 \x01(\x05R\facknowledged\"\xa9\x01\n" +
+	"\x15PersonalizationOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x05 \x01(\x05R\tmaxLength\x12\x1d\n" +
+	"\n" +
+	"file_types\x18\x06 \x03(\tR\tfileTypes\"\x93\x01\n" +
+	"\x14PersonalizationValue\x12\x1b\n" +
+	"\toption_id\x18\x01 \x01(\tR\boptionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x19\n" +
+	"\bfile_url\x18\x04 \x01(\tR\afileUrl\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\"{\n" +
+	" SetPersonalizationOptionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x128\n" +
+	"\aoptions\x18\x02 \x03(\v2\x1e.product.PersonalizationOptionR\aoptions\"Z\n" +
+	"\x1ePersonalizationOptionsResponse\x128\n" +
+	"\aoptions\x18\x01 \x03(\v2\x1e.product.PersonalizationOptionR\aoptions\"v\n" +
+	"\x1eValidatePersonalizationRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x125\n" +
+	"\x06values\x18\x02 \x03(\v2\x1d.product.PersonalizationValueR\x06values\"X\n" +
+	"\x1fValidatePersonalizationResponse\x125\n" +
+	"\x06values\x18\x01 \x03(\v2\x1d.product.PersonalizationValueR\x06values\"\x84\x01\n" +
+	"\x0eProductChannel\x12\x18\n" +
+	"\achannel\x18\x01 \x01(\tR\achannel\x12\x1d\n" +
+	"\n" +
+	"is_visible\x18\x02 \x01(\bR\tisVisible\x129\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"o\n" +
+	"\x19SetProductChannelsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x123\n" +
+	"\bchannels\x18\x02 \x03(\v2\x17.product.ProductChannelR\bchannels\":\n" +
+	"\x19GetProductChannelsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"m\n" +
+	"\x17ProductChannelsResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x123\n" +
+	"\bchannels\x18\x02 \x03(\v2\x17.product.ProductChannelR\bchannels\"\xa8\x02\n" +
+	"\x05Store\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12)\n" +
+	"\x10default_currency\x18\x04 \x01(\tR\x0fdefaultCurrency\x12%\n" +
+	"\x0edefault_locale\x18\x05 \x01(\tR\rdefaultLocale\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa2\x01\n" +
+	"\x12CreateStoreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12)\n" +
+	"\x10default_currency\x18\x04 \x01(\tR\x0fdefaultCurrency\x12%\n" +
+	"\x0edefault_locale\x18\x05 \x01(\tR\rdefaultLocale\"!\n" +
+	"\x0fGetStoreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x13\n" +
+	"\x11ListStoresRequest\"<\n" +
+	"\x12ListStoresResponse\x12&\n" +
+	"\x06stores\x18\x01 \x03(\v2\x0e.product.StoreR\x06stores\"\xbf\x01\n" +
+	"\x12UpdateStoreRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06domain\x18\x03 \x01(\tR\x06domain\x12)\n" +
+	"\x10default_currency\x18\x04 \x01(\tR\x0fdefaultCurrency\x12%\n" +
+	"\x0edefault_locale\x18\x05 \x01(\tR\rdefaultLocale\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\"\xfc\x01\n" +
+	"\vProductFeed\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12#\n" +
+	"\rproduct_count\x18\x02 \x01(\x05R\fproductCount\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\x12\x10\n" +
+	"\x03url\x18\x04 \x01(\tR\x03url\x12@\n" +
+	"\x0eurl_expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\furlExpiresAt\x12=\n" +
+	"\fgenerated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vgeneratedAt\"\x19\n" +
+	"\x17ListProductFeedsRequest\"F\n" +
+	"\x18ListProductFeedsResponse\x12*\n" +
+	"\x05feeds\x18\x01 \x03(\v2\x14.product.ProductFeedR\x05feeds\"\x1d\n" +
+	"\x1bGenerateProductFeedsRequest\"2\n" +
+	"\x1aDownloadProductFeedRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x85\x01\n" +
+	"\x10ProductFeedChunk\x12\x1b\n" +
+	"\tfile_name\x18\x01 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x03 \x01(\x03R\tsizeBytes\x12\x12\n" +
+	"\x04data\x18\x04 \x01(\fR\x04data\"\xa9\x03\n" +
+	"\n" +
+	"ErpSyncRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1c\n" +
+	"\tconnector\x18\x02 \x01(\tR\tconnector\x12\x16\n" +
+	"\x06entity\x18\x03 \x01(\tR\x06entity\x12\x1c\n" +
+	"\tdirection\x18\x04 \x01(\tR\tdirection\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12!\n" +
+	"\frecords_read\x18\x06 \x01(\x05R\vrecordsRead\x12'\n" +
+	"\x0frecords_applied\x18\a \x01(\x05R\x0erecordsApplied\x12'\n" +
+	"\x0frecords_skipped\x18\b \x01(\x05R\x0erecordsSkipped\x12\x1c\n" +
+	"\tconflicts\x18\t \x01(\x05R\tconflicts\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"started_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"\x13\n" +
+	"\x11RunErpSyncRequest\".\n" +
+	"\x16ListErpSyncRunsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"B\n" +
+	"\x17ListErpSyncRunsResponse\x12'\n" +
+	"\x04runs\x18\x01 \x03(\v2\x13.product.ErpSyncRunR\x04runs\"e\n" +
+	"\x15PriceAdjustmentFilter\x12\x19\n" +
+	"\bbrand_id\x18\x01 \x01(\tR\abrandId\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x10\n" +
+	"\x03tag\x18\x03 \x01(\tR\x03tag\"\xb5\x01\n" +
+	"\x17BulkAdjustPricesRequest\x126\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1e.product.PriceAdjustmentFilterR\x06filter\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x14\n" +
+	"\x05apply\x18\x04 \x01(\bR\x05apply\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xaa\x02\n" +
+	"\x0fPriceAdjustment\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1b\n" +
+	"\told_price\x18\x04 \x01(\x01R\boldPrice\x12\x1b\n" +
+	"\tnew_price\x18\x05 \x01(\x01R\bnewPrice\x12J\n" +
+	"\x12old_discount_price\x18\x06 \x01(\v2\x1c.google.protobuf.DoubleValueR\x10oldDiscountPrice\x12J\n" +
+	"\x12new_discount_price\x18\a \x01(\v2\x1c.google.protobuf.DoubleValueR\x10newDiscountPrice\"\xa7\x01\n" +
+	"\x18BulkAdjustPricesResponse\x12:\n" +
+	"\vadjustments\x18\x01 \x03(\v2\x18.product.PriceAdjustmentR\vadjustments\x12\x1a\n" +
+	"\baffected\x18\x02 \x01(\x05R\baffected\x12\x18\n" +
+	"\aapplied\x18\x03 \x01(\bR\aapplied\x12\x19\n" +
+	"\bbatch_id\x18\x04 \x01(\tR\abatchId\"\xd5\x01\n" +
+	"\x13ReconciliationEntry\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\tR\tvariantId\x12*\n" +
+	"\x11inventory_item_id\x18\x05 \x01(\tR\x0finventoryItemId\x12\x18\n" +
+	"\acreated\x18\x06 \x01(\bR\acreated\x12\x14\n" +
+	"\x05error\x18\a \x01(\tR\x05error\"\xc6\x03\n" +
+	"\x17InventoryReconciliation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vauto_create\x18\x02 \x01(\bR\n" +
+	"autoCreate\x12!\n" +
+	"\fskus_checked\x18\x03 \x01(\x05R\vskusChecked\x126\n" +
+	"\x17inventory_items_checked\x18\x04 \x01(\x05R\x15inventoryItemsChecked\x12#\n" +
+	"\rmissing_count\x18\x05 \x01(\x05R\fmissingCount\x12%\n" +
+	"\x0eorphaned_count\x18\x06 \x01(\x05R\rorphanedCount\x12#\n" +
+	"\rcreated_count\x18\a \x01(\x05R\fcreatedCount\x126\n" +
+	"\aentries\x18\b \x03(\v2\x1c.product.ReconciliationEntryR\aentries\x129\n" +
+	"\n" +
+	"started_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"D\n" +
+	"!RunInventoryReconciliationRequest\x12\x1f\n" +
+	"\vauto_create\x18\x01 \x01(\bR\n" +
+	"autoCreate\"3\n" +
+	"!GetInventoryReconciliationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"#ListInventoryReconciliationsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"r\n" +
+	"$ListInventoryReconciliationsResponse\x12J\n" +
+	"\x0freconciliations\x18\x01 \x03(\v2 .product.InventoryReconciliationR\x0freconciliations\"P\n" +
+	"\x14MergeProductsRequest\x12\x1b\n" +
+	"\ttarget_id\x18\x01 \x01(\tR\btargetId\x12\x1b\n" +
+	"\tsource_id\x18\x02 \x01(\tR\bsourceId\"\x8d\x01\n" +
+	"\x15MergeProductsResponse\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\x12%\n" +
+	"\x0evariants_moved\x18\x02 \x01(\x05R\rvariantsMoved\x12!\n" +
+	"\fimages_moved\x18\x03 \x01(\x05R\vimagesMoved\"}\n" +
+	"\x13SplitVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\"B\n" +
+	"\x14SplitVariantResponse\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"\xea\x03\n" +
+	"\x0eImportTemplate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1a\n" +
+	"\bsupplier\x18\x02 \x01(\tR\bsupplier\x12T\n" +
+	"\x0fcolumn_mappings\x18\x03 \x03(\v2+.product.ImportTemplate.ColumnMappingsEntryR\x0ecolumnMappings\x12(\n" +
+	"\x10default_brand_id\x18\x04 \x01(\tR\x0edefaultBrandId\x12.\n" +
+	"\x13default_category_id\x18\x05 \x01(\tR\x11defaultCategoryId\x12)\n" +
+	"\x10price_multiplier\x18\x06 \x01(\x01R\x0fpriceMultiplier\x12\x18\n" +
+	"\apublish\x18\a \x01(\bR\apublish\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aA\n" +
+	"\x13ColumnMappingsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"P\n" +
+	"\x19SaveImportTemplateRequest\x123\n" +
+	"\btemplate\x18\x01 \x01(\v2\x17.product.ImportTemplateR\btemplate\"6\n" +
+	"\x18GetImportTemplateRequest\x12\x1a\n" +
+	"\bsupplier\x18\x01 \x01(\tR\bsupplier\"\x1c\n" +
+	"\x1aListImportTemplatesRequest\"T\n" +
+	"\x1bListImportTemplatesResponse\x125\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x17.product.ImportTemplateR\ttemplates\"9\n" +
+	"\x1bDeleteImportTemplateRequest\x12\x1a\n" +
+	"\bsupplier\x18\x01 \x01(\tR\bsupplier\"8\n" +
+	"\x1cDeleteImportTemplateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"U\n" +
+	"\x1cImportSupplierCatalogRequest\x12\x1a\n" +
+	"\bsupplier\x18\x01 \x01(\tR\bsupplier\x12\x19\n" +
+	"\bcsv_data\x18\x02 \x01(\fR\acsvData\":\n" +
+	"\x0eImportRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\x86\x01\n" +
+	"\x1dImportSupplierCatalogResponse\x12\x1a\n" +
+	"\bimported\x18\x01 \x01(\x05R\bimported\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x05R\askipped\x12/\n" +
+	"\x06errors\x18\x03 \x03(\v2\x17.product.ImportRowErrorR\x06errors\"\x86\x02\n" +
+	"\vProductNote\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12!\n" +
+	"\fauthor_email\x18\x04 \x01(\tR\vauthorEmail\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8d\x01\n" +
+	"\x18CreateProductNoteRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12!\n" +
+	"\fauthor_email\x18\x03 \x01(\tR\vauthorEmail\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"8\n" +
+	"\x17ListProductNotesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"F\n" +
+	"\x18ListProductNotesResponse\x12*\n" +
+	"\x05notes\x18\x01 \x03(\v2\x14.product.ProductNoteR\x05notes\"z\n" +
+	"\x18UpdateProductNoteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"I\n" +
+	"\x18DeleteProductNoteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"5\n" +
+	"\x19DeleteProductNoteResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x92\x03\n" +
+	"\x13ProductRelationship\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12,\n" +
+	"\x12related_product_id\x18\x03 \x01(\tR\x10relatedProductId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1a\n" +
+	"\bposition\x18\x05 \x01(\x05R\bposition\x12#\n" +
+	"\rrelated_title\x18\x06 \x01(\tR\frelatedTitle\x12!\n" +
+	"\frelated_slug\x18\a \x01(\tR\vrelatedSlug\x120\n" +
+	"\x14related_is_published\x18\b \x01(\bR\x12relatedIsPublished\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x95\x01\n" +
+	"\x0eRelatedProduct\x12'\n" +
+	"\x0frelationship_id\x18\x01 \x01(\tR\x0erelationshipId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12*\n" +
+	"\aproduct\x18\x04 \x01(\v2\x10.product.ProductR\aproduct\"\x9f\x01\n" +
+	" CreateProductRelationshipRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12,\n" +
+	"\x12related_product_id\x18\x02 \x01(\tR\x10relatedProductId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"T\n" +
+	"\x1fListProductRelationshipsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\"f\n" +
+	" ListProductRelationshipsResponse\x12B\n" +
+	"\rrelationships\x18\x01 \x03(\v2\x1c.product.ProductRelationshipR\rrelationships\"m\n" +
+	" UpdateProductRelationshipRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\"Q\n" +
+	" DeleteProductRelationshipRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"=\n" +
+	"!DeleteProductRelationshipResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xdb\x02\n" +
+	"\rProductAnswer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vauthor_name\x18\x04 \x01(\tR\n" +
+	"authorName\x12\x1f\n" +
+	"\vauthor_role\x18\x05 \x01(\tR\n" +
+	"authorRole\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x18\n" +
+	"\aupvotes\x18\b \x01(\x05R\aupvotes\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd2\x02\n" +
+	"\x0fProductQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vauthor_name\x18\x04 \x01(\tR\n" +
+	"authorName\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x120\n" +
+	"\aanswers\x18\a \x03(\v2\x16.product.ProductAnswerR\aanswers\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x8c\x01\n" +
+	"\x19AskProductQuestionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vauthor_name\x18\x03 \x01(\tR\n" +
+	"authorName\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"\\\n" +
+	"\x19GetProductQuestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\x13include_unmoderated\x18\x02 \x01(\bR\x12includeUnmoderated\"\xc3\x01\n" +
+	"\x1bListProductQuestionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\tR\x04sort\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12/\n" +
+	"\x13include_unmoderated\x18\x06 \x01(\bR\x12includeUnmoderated\"l\n" +
+	"\x1cListProductQuestionsResponse\x126\n" +
+	"\tquestions\x18\x01 \x03(\v2\x18.product.ProductQuestionR\tquestions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"H\n" +
+	"\x1eModerateProductQuestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\".\n" +
+	"\x1cDeleteProductQuestionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"9\n" +
+	"\x1dDeleteProductQuestionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xb2\x01\n" +
+	"\x1cAnswerProductQuestionRequest\x12\x1f\n" +
+	"\vquestion_id\x18\x01 \x01(\tR\n" +
+	"questionId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12\x1f\n" +
+	"\vauthor_name\x18\x03 \x01(\tR\n" +
+	"authorName\x12\x1f\n" +
+	"\vauthor_role\x18\x04 \x01(\tR\n" +
+	"authorRole\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\"F\n" +
+	"\x1cModerateProductAnswerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\",\n" +
+	"\x1aDeleteProductAnswerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x1bDeleteProductAnswerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
+	"\x1aUpvoteProductAnswerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bvoter_id\x18\x02 \x01(\tR\avoterId\"\xee\x03\n" +
+	"\vSavedSearch\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\tR\n" +
+	"categoryId\x12\x19\n" +
+	"\bbrand_id\x18\x06 \x01(\tR\abrandId\x12\x1b\n" +
+	"\tmin_price\x18\a \x01(\x01R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\b \x01(\x01R\bmaxPrice\x12,\n" +
+	"\x12alert_new_products\x18\t \x01(\bR\x10alertNewProducts\x12*\n" +
+	"\x11price_alert_below\x18\n" +
+	" \x01(\x01R\x0fpriceAlertBelow\x12F\n" +
+	"\x11last_evaluated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0flastEvaluatedAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb6\x02\n" +
+	"\x11SaveSearchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x1f\n" +
+	"\vcategory_id\x18\x05 \x01(\tR\n" +
+	"categoryId\x12\x19\n" +
+	"\bbrand_id\x18\x06 \x01(\tR\abrandId\x12\x1b\n" +
+	"\tmin_price\x18\a \x01(\x01R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\b \x01(\x01R\bmaxPrice\x12,\n" +
+	"\x12alert_new_products\x18\t \x01(\bR\x10alertNewProducts\x12*\n" +
+	"\x11price_alert_below\x18\n" +
+	" \x01(\x01R\x0fpriceAlertBelow\"3\n" +
+	"\x18ListSavedSearchesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"X\n" +
+	"\x19ListSavedSearchesResponse\x12;\n" +
+	"\x0esaved_searches\x18\x01 \x03(\v2\x14.product.SavedSearchR\rsavedSearches\"C\n" +
+	"\x18DeleteSavedSearchRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"5\n" +
+	"\x19DeleteSavedSearchResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xfc\x02\n" +
+	"\x10SavedSearchAlert\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\ttenant_id\x18\x02 \x01(\tR\btenantId\x12&\n" +
+	"\x0fsaved_search_id\x18\x03 \x01(\tR\rsavedSearchId\x12*\n" +
+	"\x11saved_search_name\x18\x04 \x01(\tR\x0fsavedSearchName\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04type\x18\x06 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\a \x01(\tR\tproductId\x12#\n" +
+	"\rproduct_title\x18\b \x01(\tR\fproductTitle\x12\x14\n" +
+	"\x05price\x18\t \x01(\x01R\x05price\x12%\n" +
+	"\x0eprevious_price\x18\n" +
+	" \x01(\x01R\rpreviousPrice\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"4\n" +
+	"\x1cListSavedSearchAlertsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"R\n" +
+	"\x1dListSavedSearchAlertsResponse\x121\n" +
+	"\x06alerts\x18\x01 \x03(\v2\x19.product.SavedSearchAlertR\x06alerts\":\n" +
+	"\x1bAckSavedSearchAlertsRequest\x12\x1b\n" +
+	"\talert_ids\x18\x01 \x03(\tR\balertIds\"B\n" +
+	"\x1cAckSavedSearchAlertsResponse\x12\"\n" +
+	"\facknowledged\x18\x01 \x01(\x05R\facknowledged\"\xbd\x03\n" +
+	"\x11SearchRankingRule\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x19\n" +
+	"\bbrand_id\x18\x05 \x01(\tR\abrandId\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\a \x01(\tR\tproductId\x12\x16\n" +
+	"\x06weight\x18\b \x01(\x01R\x06weight\x12\x1a\n" +
+	"\bposition\x18\t \x01(\x05R\bposition\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\tR\x06status\x12=\n" +
+	"\fpublished_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xfb\x01\n" +
+	"\x1cSaveSearchRankingRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05query\x18\x04 \x01(\tR\x05query\x12\x19\n" +
+	"\bbrand_id\x18\x05 \x01(\tR\abrandId\x12\x1f\n" +
+	"\vcategory_id\x18\x06 \x01(\tR\n" +
+	"categoryId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\a \x01(\tR\tproductId\x12\x16\n" +
+	"\x06weight\x18\b \x01(\x01R\x06weight\x12\x1a\n" +
+	"\bposition\x18\t \x01(\x05R\bposition\"7\n" +
+	"\x1dListSearchRankingRulesRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"R\n" +
+	"\x1eListSearchRankingRulesResponse\x120\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1a.product.SearchRankingRuleR\x05rules\"0\n" +
+	"\x1eDeleteSearchRankingRuleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\";\n" +
+	"\x1fDeleteSearchRankingRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"4\n" +
+	" PublishSearchRankingRulesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"A\n" +
+	"!PublishSearchRankingRulesResponse\x12\x1c\n" +
+	"\tpublished\x18\x01 \x01(\x05R\tpublished\"x\n" +
+	"\x15SearchProductsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x83\x01\n" +
+	"\fSearchResult\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x14\n" +
+	"\x05score\x18\x05 \x01(\x01R\x05score\"_\n" +
+	"\x16SearchProductsResponse\x12/\n" +
+	"\aresults\x18\x01 \x03(\v2\x15.product.SearchResultR\aresults\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"j\n" +
+	"\x1bPreviewSearchRankingRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\xd5\x01\n" +
+	"\x12RankedSearchResult\x12-\n" +
+	"\x06result\x18\x01 \x01(\v2\x15.product.SearchResultR\x06result\x12\x1c\n" +
+	"\trelevance\x18\x02 \x01(\x01R\trelevance\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12#\n" +
+	"\rbase_position\x18\x04 \x01(\x05R\fbasePosition\x12\x16\n" +
+	"\x06pinned\x18\x05 \x01(\bR\x06pinned\x12\x19\n" +
+	"\brule_ids\x18\x06 \x03(\tR\aruleIds\"U\n" +
+	"\x1cPreviewSearchRankingResponse\x125\n" +
+	"\aresults\x18\x01 \x03(\v2\x1b.product.RankedSearchResultR\aresults\"N\n" +
+	"\fProductBadge\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\"\xb8\x02\n" +
+	"\tBadgeRule\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04days\x18\x05 \x01(\x05R\x04days\x12\x18\n" +
+	"\apercent\x18\x06 \x01(\x01R\apercent\x12\x1f\n" +
+	"\vproduct_ids\x18\a \x03(\tR\n" +
+	"productIds\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x12\x16\n" +
+	"\x06active\x18\t \x01(\bR\x06active\x12\x19\n" +
+	"\bbuilt_in\x18\n" +
+	" \x01(\bR\abuiltIn\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x17\n" +
+	"\x15ListBadgeRulesRequest\"B\n" +
+	"\x16ListBadgeRulesResponse\x12(\n" +
+	"\x05rules\x18\x01 \x03(\v2\x12.product.BadgeRuleR\x05rules\"\xed\x01\n" +
+	"\x14SaveBadgeRuleRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12\x14\n" +
+	"\x05color\x18\x03 \x01(\tR\x05color\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04days\x18\x05 \x01(\x05R\x04days\x12\x18\n" +
+	"\apercent\x18\x06 \x01(\x01R\apercent\x12\x1f\n" +
+	"\vproduct_ids\x18\a \x03(\tR\n" +
+	"productIds\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x12\x16\n" +
+	"\x06active\x18\t \x01(\bR\x06active\",\n" +
+	"\x16DeleteBadgeRuleRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"3\n" +
+	"\x17DeleteBadgeRuleResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x18\n" +
+	"\x16RecomputeBadgesRequest\"M\n" +
+	"\x17RecomputeBadgesResponse\x12\x1a\n" +
+	"\bproducts\x18\x01 \x01(\x05R\bproducts\x12\x16\n" +
+	"\x06badged\x18\x02 \x01(\x05R\x06badged\"L\n" +
+	"\x0fProductSaleLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"t\n" +
+	"\x19RecordProductSalesRequest\x12'\n" +
+	"\x0forder_reference\x18\x01 \x01(\tR\x0eorderReference\x12.\n" +
+	"\x05lines\x18\x02 \x03(\v2\x18.product.ProductSaleLineR\x05lines\"8\n" +
+	"\x1aRecordProductSalesResponse\x12\x1a\n" +
+	"\brecorded\x18\x01 \x01(\x05R\brecorded\"\xd5\x01\n" +
+	"\x0fCatalogActivity\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12#\n" +
+	"\rproduct_title\x18\x04 \x01(\tR\fproductTitle\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"O\n" +
+	"\x1aListCatalogActivityRequest\x12\x1b\n" +
+	"\tbefore_id\x18\x01 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"Q\n" +
+	"\x1bListCatalogActivityResponse\x122\n" +
+	"\aentries\x18\x01 \x03(\v2\x18.product.CatalogActivityR\aentries\"\xbc\x02\n" +
+	"\vTranslation\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12+\n" +
+	"\x11short_description\x18\x05 \x01(\tR\x10shortDescription\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"O\n" +
+	"\x15SetTranslationRequest\x126\n" +
+	"\vtranslation\x18\x01 \x01(\v2\x14.product.TranslationR\vtranslation\"W\n" +
+	"\x17ListTranslationsRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\"T\n" +
+	"\x18ListTranslationsResponse\x128\n" +
+	"\ftranslations\x18\x01 \x03(\v2\x14.product.TranslationR\ftranslations\"p\n" +
+	"\x18DeleteTranslationRequest\x12\x1f\n" +
+	"\ventity_type\x18\x01 \x01(\tR\n" +
+	"entityType\x12\x1b\n" +
+	"\tentity_id\x18\x02 \x01(\tR\bentityId\x12\x16\n" +
+	"\x06locale\x18\x03 \x01(\tR\x06locale\"5\n" +
+	"\x19DeleteTranslationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x91\x03\n" +
+	"\vContentPage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x18\n" +
+	"\asummary\x18\x04 \x01(\tR\asummary\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12=\n" +
+	"\funpublish_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vunpublishAt\x12\x12\n" +
+	"\x04live\x18\t \x01(\bR\x04live\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"D\n" +
+	"\x18CreateContentPageRequest\x12(\n" +
+	"\x04page\x18\x01 \x01(\v2\x14.product.ContentPageR\x04page\"D\n" +
+	"\x18UpdateContentPageRequest\x12(\n" +
+	"\x04page\x18\x01 \x01(\v2\x14.product.ContentPageR\x04page\"M\n" +
+	"\x15GetContentPageRequest\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x12\x14\n" +
+	"\x04slug\x18\x02 \x01(\tH\x00R\x04slugB\f\n" +
+	"\n" +
+	"identifier\"`\n" +
+	"\x17ListContentPagesRequest\x12\x1b\n" +
+	"\tlive_only\x18\x01 \x01(\bR\bliveOnly\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\\\n" +
+	"\x18ListContentPagesResponse\x12*\n" +
+	"\x05pages\x18\x01 \x03(\v2\x14.product.ContentPageR\x05pages\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\"*\n" +
+	"\x18DeleteContentPageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"5\n" +
+	"\x19DeleteContentPageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd5\x03\n" +
+	"\rContentBanner\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04slot\x18\x02 \x01(\tR\x04slot\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bsubtitle\x18\x04 \x01(\tR\bsubtitle\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x19\n" +
+	"\blink_url\x18\x06 \x01(\tR\alinkUrl\x12\x1a\n" +
+	"\bposition\x18\a \x01(\x05R\bposition\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"publish_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tpublishAt\x12=\n" +
+	"\funpublish_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vunpublishAt\x12\x12\n" +
+	"\x04live\x18\v \x01(\bR\x04live\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"L\n" +
+	"\x1aCreateContentBannerRequest\x12.\n" +
+	"\x06banner\x18\x01 \x01(\v2\x16.product.ContentBannerR\x06banner\"L\n" +
+	"\x1aUpdateContentBannerRequest\x12.\n" +
+	"\x06banner\x18\x01 \x01(\v2\x16.product.ContentBannerR\x06banner\"L\n" +
+	"\x19ListContentBannersRequest\x12\x12\n" +
+	"\x04slot\x18\x01 \x01(\tR\x04slot\x12\x1b\n" +
+	"\tlive_only\x18\x02 \x01(\bR\bliveOnly\"N\n" +
+	"\x1aListContentBannersResponse\x120\n" +
+	"\abanners\x18\x01 \x03(\v2\x16.product.ContentBannerR\abanners\",\n" +
+	"\x1aDeleteContentBannerRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"7\n" +
+	"\x1bDeleteContentBannerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xdc\x01\n" +
+	"\aSetting\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06public\x18\x03 \x01(\bR\x06public\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x15\n" +
+	"\x06is_set\x18\x05 \x01(\bR\x05isSet\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x06 \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x15\n" +
+	"\x13ListSettingsRequest\"D\n" +
+	"\x14ListSettingsResponse\x12,\n" +
+	"\bsettings\x18\x01 \x03(\v2\x10.product.SettingR\bsettings\"Z\n" +
+	"\x11SetSettingRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\x03 \x01(\tR\tupdatedBy\"(\n" +
+	"\x14DeleteSettingRequest\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\"1\n" +
+	"\x15DeleteSettingResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1c\n" +
+	"\x1aGetStorefrontConfigRequest\"\x99\x01\n" +
+	"\rStoreBranding\x12\x19\n" +
+	"\blogo_url\x18\x01 \x01(\tR\alogoUrl\x12\x1f\n" +
+	"\vfavicon_url\x18\x02 \x01(\tR\n" +
+	"faviconUrl\x12#\n" +
+	"\rprimary_color\x18\x03 \x01(\tR\fprimaryColor\x12'\n" +
+	"\x0fsecondary_color\x18\x04 \x01(\tR\x0esecondaryColor\"\xf0\x02\n" +
+	"\x10StorefrontConfig\x12\x19\n" +
+	"\bstore_id\x18\x01 \x01(\tR\astoreId\x12\x1d\n" +
+	"\n" +
+	"store_name\x18\x02 \x01(\tR\tstoreName\x12)\n" +
+	"\x10default_currency\x18\x03 \x01(\tR\x0fdefaultCurrency\x12%\n" +
+	"\x0edefault_locale\x18\x04 \x01(\tR\rdefaultLocale\x12+\n" +
+	"\x11active_currencies\x18\x05 \x03(\tR\x10activeCurrencies\x12%\n" +
+	"\x0eactive_locales\x18\x06 \x03(\tR\ractiveLocales\x122\n" +
+	"\bbranding\x18\a \x01(\v2\x16.product.StoreBrandingR\bbranding\x12#\n" +
+	"\rsupport_email\x18\b \x01(\tR\fsupportEmail\x12#\n" +
+	"\rsupport_phone\x18\t \x01(\tR\fsupportPhone\"\xc7\x01\n" +
+	"\x13ProductQualityScore\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x05R\x05score\x12\x16\n" +
+	"\x06issues\x18\x05 \x03(\tR\x06issues\x12;\n" +
+	"\vcomputed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"computedAt\"\x9e\x01\n" +
+	"\x1eGetCatalogQualityReportRequest\x128\n" +
+	"\tmax_score\x18\x01 \x01(\v2\x1b.google.protobuf.Int32ValueR\bmaxScore\x12\x14\n" +
+	"\x05issue\x18\x02 \x01(\tR\x05issue\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\"\xe8\x02\n" +
+	"\x14CatalogQualityReport\x12!\n" +
+	"\fscored_count\x18\x01 \x01(\x05R\vscoredCount\x12%\n" +
+	"\x0eunscored_count\x18\x02 \x01(\x05R\runscoredCount\x12#\n" +
+	"\raverage_score\x18\x03 \x01(\x01R\faverageScore\x12Q\n" +
+	"\fissue_counts\x18\x04 \x03(\v2..product.CatalogQualityReport.IssueCountsEntryR\vissueCounts\x128\n" +
+	"\bproducts\x18\x05 \x03(\v2\x1c.product.ProductQualityScoreR\bproducts\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x05R\x05total\x1a>\n" +
+	"\x10IssueCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x05R\x05value:\x028\x01\">\n" +
+	"\x1dGetProductQualityScoreRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\" \n" +
+	"\x1eRecomputeCatalogQualityRequest\"Q\n" +
+	"\x1fRecomputeCatalogQualityResponse\x12\x16\n" +
+	"\x06scored\x18\x01 \x01(\x05R\x06scored\x12\x16\n" +
+	"\x06failed\x18\x02 \x01(\x05R\x06failed\"\x17\n" +
+	"\x15GetDiagnosticsRequest\"\x9d\x04\n" +
+	"\x11DBPoolDiagnostics\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\areplica\x18\x02 \x01(\bR\areplica\x120\n" +
+	"\x14max_open_connections\x18\x03 \x01(\x05R\x12maxOpenConnections\x12)\n" +
+	"\x10open_connections\x18\x04 \x01(\x05R\x0fopenConnections\x12\x15\n" +
+	"\x06in_use\x18\x05 \x01(\x05R\x05inUse\x12\x12\n" +
+	"\x04idle\x18\x06 \x01(\x05R\x04idle\x12\x1d\n" +
+	"\n" +
+	"wait_count\x18\a \x01(\x03R\twaitCount\x122\n" +
+	"\x15wait_duration_seconds\x18\b \x01(\x01R\x13waitDurationSeconds\x12&\n" +
+	"\x0fmax_idle_closed\x18\t \x01(\x03R\rmaxIdleClosed\x12/\n" +
+	"\x14max_idle_time_closed\x18\n" +
+	" \x01(\x03R\x11maxIdleTimeClosed\x12.\n" +
+	"\x13max_lifetime_closed\x18\v \x01(\x03R\x11maxLifetimeClosed\x12*\n" +
+	"\x11replica_lag_known\x18\f \x01(\bR\x0freplicaLagKnown\x12.\n" +
+	"\x13replica_lag_seconds\x18\r \x01(\x01R\x11replicaLagSeconds\x12\x1a\n" +
+	"\bexcluded\x18\x0e \x01(\bR\bexcluded\"\xfa\x01\n" +
+	"\x10CacheDiagnostics\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
+	"\x15circuit_breaker_state\x18\x02 \x01(\tR\x13circuitBreakerState\x12\x18\n" +
+	"\ahealthy\x18\x03 \x01(\bR\ahealthy\x12%\n" +
+	"\x0ememory_entries\x18\x04 \x01(\x03R\rmemoryEntries\x12\x12\n" +
+	"\x04hits\x18\x05 \x01(\x03R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x06 \x01(\x03R\x06misses\x12\x16\n" +
+	"\x06errors\x18\a \x01(\x03R\x06errors\x12\x19\n" +
+	"\bhit_rate\x18\b \x01(\x01R\ahitRate\"\x9f\x02\n" +
+	"\x13DiagnosticsResponse\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12=\n" +
+	"\fcollected_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vcollectedAt\x12%\n" +
+	"\x0euptime_seconds\x18\x03 \x01(\x01R\ruptimeSeconds\x12\x1e\n" +
+	"\n" +
+	"goroutines\x18\x04 \x01(\x05R\n" +
+	"goroutines\x125\n" +
+	"\bdb_pools\x18\x05 \x03(\v2\x1a.product.DBPoolDiagnosticsR\adbPools\x121\n" +
+	"\x06caches\x18\x06 \x03(\v2\x19.product.CacheDiagnosticsR\x06caches\":\n" +
+	"\x1aFlushCacheNamespaceRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"U\n" +
+	"\x1bFlushCacheNamespaceResponse\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x05R\aversion\"\x80\x01\n" +
+	"\x0eCacheWarmQuery\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\xb8\x03\n" +
+	"\x10CacheScheduleRun\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12#\n" +
+	"\rschedule_name\x18\x02 \x01(\tR\fscheduleName\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x18\n" +
+	"\atrigger\x18\x04 \x01(\tR\atrigger\x12?\n" +
+	"\rscheduled_for\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\fscheduledFor\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12 \n" +
+	"\vinvalidated\x18\a \x01(\bR\vinvalidated\x12\x16\n" +
+	"\x06warmed\x18\b \x01(\x05R\x06warmed\x12\x16\n" +
+	"\x06failed\x18\t \x01(\x05R\x06failed\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"started_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\"\xc0\x02\n" +
+	"\rCacheSchedule\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x0e\n" +
+	"\x02at\x18\x03 \x01(\tR\x02at\x12\x12\n" +
+	"\x04days\x18\x04 \x03(\tR\x04days\x12\x1a\n" +
+	"\btimezone\x18\x05 \x01(\tR\btimezone\x12\x1e\n" +
+	"\n" +
+	"invalidate\x18\x06 \x01(\bR\n" +
+	"invalidate\x12+\n" +
+	"\x04warm\x18\a \x03(\v2\x17.product.CacheWarmQueryR\x04warm\x12:\n" +
+	"\vnext_run_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tnextRunAt\x124\n" +
+	"\blast_run\x18\t \x01(\v2\x19.product.CacheScheduleRunR\alastRun\"\x1b\n" +
+	"\x19ListCacheSchedulesRequest\"R\n" +
+	"\x1aListCacheSchedulesResponse\x124\n" +
+	"\tschedules\x18\x01 \x03(\v2\x16.product.CacheScheduleR\tschedules\"Y\n" +
+	"\x1cListCacheScheduleRunsRequest\x12#\n" +
+	"\rschedule_name\x18\x01 \x01(\tR\fscheduleName\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"N\n" +
+	"\x1dListCacheScheduleRunsResponse\x12-\n" +
+	"\x04runs\x18\x01 \x03(\v2\x19.product.CacheScheduleRunR\x04runs\"-\n" +
+	"\x17RunCacheScheduleRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name2\x92V\n" +
 	"\x0eProductService\x12@\n" +
 	"\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x10.product.Product\x12:\n" +
 	"\n" +
@@ -2859,10 +18359,126 @@ const file_proto_product_proto_rawDesc = "" +
 	"ListBrands\x12\x1a.product.ListBrandsRequest\x1a\x1b.product.ListBrandsResponse\x12C\n" +
 	"\x0eCreateCategory\x12\x1e.product.CreateCategoryRequest\x1a\x11.product.Category\x12=\n" +
 	"\vGetCategory\x12\x1b.product.GetCategoryRequest\x1a\x11.product.Category\x12Q\n" +
-	"\x0eListCategories\x12\x1e.product.ListCategoriesRequest\x1a\x1f.product.ListCategoriesResponse\x12H\n" +
+	"\x0eListCategories\x12\x1e.product.ListCategoriesRequest\x1a\x1f.product.ListCategoriesResponse\x12?\n" +
+	"\fMoveCategory\x12\x1c.product.MoveCategoryRequest\x1a\x11.product.Category\x12T\n" +
+	"\x0fMergeCategories\x12\x1f.product.MergeCategoriesRequest\x1a .product.MergeCategoriesResponse\x12T\n" +
+	"\x0fReorderSiblings\x12\x1f.product.ReorderSiblingsRequest\x1a .product.ReorderSiblingsResponse\x12^\n" +
+	"\x17CreateCategoryAttribute\x12'.product.CreateCategoryAttributeRequest\x1a\x1a.product.CategoryAttribute\x12^\n" +
+	"\x17UpdateCategoryAttribute\x12'.product.UpdateCategoryAttributeRequest\x1a\x1a.product.CategoryAttribute\x12i\n" +
+	"\x16ListCategoryAttributes\x12&.product.ListCategoryAttributesRequest\x1a'.product.ListCategoryAttributesResponse\x12l\n" +
+	"\x17DeleteCategoryAttribute\x12'.product.DeleteCategoryAttributeRequest\x1a(.product.DeleteCategoryAttributeResponse\x12Z\n" +
+	"\x11GetCategoryFacets\x12!.product.GetCategoryFacetsRequest\x1a\".product.GetCategoryFacetsResponse\x12H\n" +
 	"\vUploadImage\x12\x1b.product.UploadImageRequest\x1a\x1c.product.UploadImageResponse\x12H\n" +
-	"\vDeleteImage\x12\x1b.product.DeleteImageRequest\x1a\x1c.product.DeleteImageResponse\x12]\n" +
-	"\x12GenerateSKUPreview\x12\".product.GenerateSKUPreviewRequest\x1a#.product.GenerateSKUPreviewResponseBEZCgithub.com/louai60/e-commerce_project/backend/product-service/protob\x06proto3"
+	"\vDeleteImage\x12\x1b.product.DeleteImageRequest\x1a\x1c.product.DeleteImageResponse\x12L\n" +
+	"\x11CreateMediaUpload\x12!.product.CreateMediaUploadRequest\x1a\x14.product.MediaUpload\x12]\n" +
+	"\x12ConfirmMediaUpload\x12\".product.ConfirmMediaUploadRequest\x1a#.product.ConfirmMediaUploadResponse\x12I\n" +
+	"\x0fAddProductVideo\x12\x1f.product.AddProductVideoRequest\x1a\x15.product.ProductMedia\x12]\n" +
+	"\x12DeleteProductMedia\x12\".product.DeleteProductMediaRequest\x1a#.product.DeleteProductMediaResponse\x12]\n" +
+	"\x12GenerateSKUPreview\x12\".product.GenerateSKUPreviewRequest\x1a#.product.GenerateSKUPreviewResponse\x12I\n" +
+	"\x10CreateCollection\x12 .product.CreateCollectionRequest\x1a\x13.product.Collection\x12C\n" +
+	"\rGetCollection\x12\x1d.product.GetCollectionRequest\x1a\x13.product.Collection\x12T\n" +
+	"\x0fListCollections\x12\x1f.product.ListCollectionsRequest\x1a .product.ListCollectionsResponse\x12I\n" +
+	"\x10UpdateCollection\x12 .product.UpdateCollectionRequest\x1a\x13.product.Collection\x12W\n" +
+	"\x10DeleteCollection\x12 .product.DeleteCollectionRequest\x1a!.product.DeleteCollectionResponse\x12S\n" +
+	"\x15SetCollectionProducts\x12%.product.SetCollectionProductsRequest\x1a\x13.product.Collection\x12i\n" +
+	"\x16ListCollectionProducts\x12&.product.ListCollectionProductsRequest\x1a'.product.ListCollectionProductsResponse\x12>\n" +
+	"\fCreateBundle\x12\x1c.product.CreateBundleRequest\x1a\x10.product.Product\x12O\n" +
+	"\x12UploadDigitalAsset\x12\".product.UploadDigitalAssetRequest\x1a\x15.product.DigitalAsset\x12O\n" +
+	"\x12CreateDownloadLink\x12\".product.CreateDownloadLinkRequest\x1a\x15.product.DownloadLink\x12Z\n" +
+	"\x14DownloadDigitalAsset\x12$.product.DownloadDigitalAssetRequest\x1a\x1a.product.DigitalAssetChunk0\x01\x12U\n" +
+	"\x13SetSubscriptionPlan\x12#.product.SetSubscriptionPlanRequest\x1a\x19.product.SubscriptionPlan\x12O\n" +
+	"\x12CreateSubscription\x12\".product.CreateSubscriptionRequest\x1a\x15.product.Subscription\x12I\n" +
+	"\x0fGetSubscription\x12\x1f.product.GetSubscriptionRequest\x1a\x15.product.Subscription\x12O\n" +
+	"\x12CancelSubscription\x12\".product.CancelSubscriptionRequest\x1a\x15.product.Subscription\x12Z\n" +
+	"\x11ListSubscriptions\x12!.product.ListSubscriptionsRequest\x1a\".product.ListSubscriptionsResponse\x12i\n" +
+	"\x16ListSubscriptionEvents\x12&.product.ListSubscriptionEventsRequest\x1a'.product.ListSubscriptionEventsResponse\x12f\n" +
+	"\x15AckSubscriptionEvents\x12%.product.AckSubscriptionEventsRequest\x1a&.product.AckSubscriptionEventsResponse\x12o\n" +
+	"\x19SetPersonalizationOptions\x12).product.SetPersonalizationOptionsRequest\x1a'.product.PersonalizationOptionsResponse\x12l\n" +
+	"\x17ValidatePersonalization\x12'.product.ValidatePersonalizationRequest\x1a(.product.ValidatePersonalizationResponse\x12Z\n" +
+	"\x12SetProductChannels\x12\".product.SetProductChannelsRequest\x1a .product.ProductChannelsResponse\x12Z\n" +
+	"\x12GetProductChannels\x12\".product.GetProductChannelsRequest\x1a .product.ProductChannelsResponse\x12:\n" +
+	"\vCreateStore\x12\x1b.product.CreateStoreRequest\x1a\x0e.product.Store\x124\n" +
+	"\bGetStore\x12\x18.product.GetStoreRequest\x1a\x0e.product.Store\x12E\n" +
+	"\n" +
+	"ListStores\x12\x1a.product.ListStoresRequest\x1a\x1b.product.ListStoresResponse\x12:\n" +
+	"\vUpdateStore\x12\x1b.product.UpdateStoreRequest\x1a\x0e.product.Store\x12W\n" +
+	"\x10ListProductFeeds\x12 .product.ListProductFeedsRequest\x1a!.product.ListProductFeedsResponse\x12_\n" +
+	"\x14GenerateProductFeeds\x12$.product.GenerateProductFeedsRequest\x1a!.product.ListProductFeedsResponse\x12W\n" +
+	"\x13DownloadProductFeed\x12#.product.DownloadProductFeedRequest\x1a\x19.product.ProductFeedChunk0\x01\x12J\n" +
+	"\n" +
+	"RunErpSync\x12\x1a.product.RunErpSyncRequest\x1a .product.ListErpSyncRunsResponse\x12T\n" +
+	"\x0fListErpSyncRuns\x12\x1f.product.ListErpSyncRunsRequest\x1a .product.ListErpSyncRunsResponse\x12W\n" +
+	"\x10BulkAdjustPrices\x12 .product.BulkAdjustPricesRequest\x1a!.product.BulkAdjustPricesResponse\x12j\n" +
+	"\x1aRunInventoryReconciliation\x12*.product.RunInventoryReconciliationRequest\x1a .product.InventoryReconciliation\x12j\n" +
+	"\x1aGetInventoryReconciliation\x12*.product.GetInventoryReconciliationRequest\x1a .product.InventoryReconciliation\x12{\n" +
+	"\x1cListInventoryReconciliations\x12,.product.ListInventoryReconciliationsRequest\x1a-.product.ListInventoryReconciliationsResponse\x12N\n" +
+	"\rMergeProducts\x12\x1d.product.MergeProductsRequest\x1a\x1e.product.MergeProductsResponse\x12K\n" +
+	"\fSplitVariant\x12\x1c.product.SplitVariantRequest\x1a\x1d.product.SplitVariantResponse\x12Q\n" +
+	"\x12SaveImportTemplate\x12\".product.SaveImportTemplateRequest\x1a\x17.product.ImportTemplate\x12O\n" +
+	"\x11GetImportTemplate\x12!.product.GetImportTemplateRequest\x1a\x17.product.ImportTemplate\x12`\n" +
+	"\x13ListImportTemplates\x12#.product.ListImportTemplatesRequest\x1a$.product.ListImportTemplatesResponse\x12c\n" +
+	"\x14DeleteImportTemplate\x12$.product.DeleteImportTemplateRequest\x1a%.product.DeleteImportTemplateResponse\x12f\n" +
+	"\x15ImportSupplierCatalog\x12%.product.ImportSupplierCatalogRequest\x1a&.product.ImportSupplierCatalogResponse\x12L\n" +
+	"\x11CreateProductNote\x12!.product.CreateProductNoteRequest\x1a\x14.product.ProductNote\x12W\n" +
+	"\x10ListProductNotes\x12 .product.ListProductNotesRequest\x1a!.product.ListProductNotesResponse\x12L\n" +
+	"\x11UpdateProductNote\x12!.product.UpdateProductNoteRequest\x1a\x14.product.ProductNote\x12Z\n" +
+	"\x11DeleteProductNote\x12!.product.DeleteProductNoteRequest\x1a\".product.DeleteProductNoteResponse\x12d\n" +
+	"\x19CreateProductRelationship\x12).product.CreateProductRelationshipRequest\x1a\x1c.product.ProductRelationship\x12o\n" +
+	"\x18ListProductRelationships\x12(.product.ListProductRelationshipsRequest\x1a).product.ListProductRelationshipsResponse\x12d\n" +
+	"\x19UpdateProductRelationship\x12).product.UpdateProductRelationshipRequest\x1a\x1c.product.ProductRelationship\x12r\n" +
+	"\x19DeleteProductRelationship\x12).product.DeleteProductRelationshipRequest\x1a*.product.DeleteProductRelationshipResponse\x12R\n" +
+	"\x12AskProductQuestion\x12\".product.AskProductQuestionRequest\x1a\x18.product.ProductQuestion\x12R\n" +
+	"\x12GetProductQuestion\x12\".product.GetProductQuestionRequest\x1a\x18.product.ProductQuestion\x12c\n" +
+	"\x14ListProductQuestions\x12$.product.ListProductQuestionsRequest\x1a%.product.ListProductQuestionsResponse\x12\\\n" +
+	"\x17ModerateProductQuestion\x12'.product.ModerateProductQuestionRequest\x1a\x18.product.ProductQuestion\x12f\n" +
+	"\x15DeleteProductQuestion\x12%.product.DeleteProductQuestionRequest\x1a&.product.DeleteProductQuestionResponse\x12V\n" +
+	"\x15AnswerProductQuestion\x12%.product.AnswerProductQuestionRequest\x1a\x16.product.ProductAnswer\x12V\n" +
+	"\x15ModerateProductAnswer\x12%.product.ModerateProductAnswerRequest\x1a\x16.product.ProductAnswer\x12`\n" +
+	"\x13DeleteProductAnswer\x12#.product.DeleteProductAnswerRequest\x1a$.product.DeleteProductAnswerResponse\x12R\n" +
+	"\x13UpvoteProductAnswer\x12#.product.UpvoteProductAnswerRequest\x1a\x16.product.ProductAnswer\x12>\n" +
+	"\n" +
+	"SaveSearch\x12\x1a.product.SaveSearchRequest\x1a\x14.product.SavedSearch\x12Z\n" +
+	"\x11ListSavedSearches\x12!.product.ListSavedSearchesRequest\x1a\".product.ListSavedSearchesResponse\x12Z\n" +
+	"\x11DeleteSavedSearch\x12!.product.DeleteSavedSearchRequest\x1a\".product.DeleteSavedSearchResponse\x12f\n" +
+	"\x15ListSavedSearchAlerts\x12%.product.ListSavedSearchAlertsRequest\x1a&.product.ListSavedSearchAlertsResponse\x12c\n" +
+	"\x14AckSavedSearchAlerts\x12$.product.AckSavedSearchAlertsRequest\x1a%.product.AckSavedSearchAlertsResponse\x12Q\n" +
+	"\x0eSearchProducts\x12\x1e.product.SearchProductsRequest\x1a\x1f.product.SearchProductsResponse\x12c\n" +
+	"\x14PreviewSearchRanking\x12$.product.PreviewSearchRankingRequest\x1a%.product.PreviewSearchRankingResponse\x12Z\n" +
+	"\x15SaveSearchRankingRule\x12%.product.SaveSearchRankingRuleRequest\x1a\x1a.product.SearchRankingRule\x12i\n" +
+	"\x16ListSearchRankingRules\x12&.product.ListSearchRankingRulesRequest\x1a'.product.ListSearchRankingRulesResponse\x12l\n" +
+	"\x17DeleteSearchRankingRule\x12'.product.DeleteSearchRankingRuleRequest\x1a(.product.DeleteSearchRankingRuleResponse\x12r\n" +
+	"\x19PublishSearchRankingRules\x12).product.PublishSearchRankingRulesRequest\x1a*.product.PublishSearchRankingRulesResponse\x12Q\n" +
+	"\x0eListBadgeRules\x12\x1e.product.ListBadgeRulesRequest\x1a\x1f.product.ListBadgeRulesResponse\x12B\n" +
+	"\rSaveBadgeRule\x12\x1d.product.SaveBadgeRuleRequest\x1a\x12.product.BadgeRule\x12T\n" +
+	"\x0fDeleteBadgeRule\x12\x1f.product.DeleteBadgeRuleRequest\x1a .product.DeleteBadgeRuleResponse\x12T\n" +
+	"\x0fRecomputeBadges\x12\x1f.product.RecomputeBadgesRequest\x1a .product.RecomputeBadgesResponse\x12]\n" +
+	"\x12RecordProductSales\x12\".product.RecordProductSalesRequest\x1a#.product.RecordProductSalesResponse\x12`\n" +
+	"\x13ListCatalogActivity\x12#.product.ListCatalogActivityRequest\x1a$.product.ListCatalogActivityResponse\x12F\n" +
+	"\x0eSetTranslation\x12\x1e.product.SetTranslationRequest\x1a\x14.product.Translation\x12W\n" +
+	"\x10ListTranslations\x12 .product.ListTranslationsRequest\x1a!.product.ListTranslationsResponse\x12Z\n" +
+	"\x11DeleteTranslation\x12!.product.DeleteTranslationRequest\x1a\".product.DeleteTranslationResponse\x12L\n" +
+	"\x11CreateContentPage\x12!.product.CreateContentPageRequest\x1a\x14.product.ContentPage\x12L\n" +
+	"\x11UpdateContentPage\x12!.product.UpdateContentPageRequest\x1a\x14.product.ContentPage\x12F\n" +
+	"\x0eGetContentPage\x12\x1e.product.GetContentPageRequest\x1a\x14.product.ContentPage\x12W\n" +
+	"\x10ListContentPages\x12 .product.ListContentPagesRequest\x1a!.product.ListContentPagesResponse\x12Z\n" +
+	"\x11DeleteContentPage\x12!.product.DeleteContentPageRequest\x1a\".product.DeleteContentPageResponse\x12R\n" +
+	"\x13CreateContentBanner\x12#.product.CreateContentBannerRequest\x1a\x16.product.ContentBanner\x12R\n" +
+	"\x13UpdateContentBanner\x12#.product.UpdateContentBannerRequest\x1a\x16.product.ContentBanner\x12]\n" +
+	"\x12ListContentBanners\x12\".product.ListContentBannersRequest\x1a#.product.ListContentBannersResponse\x12`\n" +
+	"\x13DeleteContentBanner\x12#.product.DeleteContentBannerRequest\x1a$.product.DeleteContentBannerResponse\x12K\n" +
+	"\fListSettings\x12\x1c.product.ListSettingsRequest\x1a\x1d.product.ListSettingsResponse\x12:\n" +
+	"\n" +
+	"SetSetting\x12\x1a.product.SetSettingRequest\x1a\x10.product.Setting\x12N\n" +
+	"\rDeleteSetting\x12\x1d.product.DeleteSettingRequest\x1a\x1e.product.DeleteSettingResponse\x12U\n" +
+	"\x13GetStorefrontConfig\x12#.product.GetStorefrontConfigRequest\x1a\x19.product.StorefrontConfig\x12a\n" +
+	"\x17GetCatalogQualityReport\x12'.product.GetCatalogQualityReportRequest\x1a\x1d.product.CatalogQualityReport\x12^\n" +
+	"\x16GetProductQualityScore\x12&.product.GetProductQualityScoreRequest\x1a\x1c.product.ProductQualityScore\x12l\n" +
+	"\x17RecomputeCatalogQuality\x12'.product.RecomputeCatalogQualityRequest\x1a(.product.RecomputeCatalogQualityResponse\x12N\n" +
+	"\x0eGetDiagnostics\x12\x1e.product.GetDiagnosticsRequest\x1a\x1c.product.DiagnosticsResponse\x12`\n" +
+	"\x13FlushCacheNamespace\x12#.product.FlushCacheNamespaceRequest\x1a$.product.FlushCacheNamespaceResponse\x12]\n" +
+	"\x12ListCacheSchedules\x12\".product.ListCacheSchedulesRequest\x1a#.product.ListCacheSchedulesResponse\x12f\n" +
+	"\x15ListCacheScheduleRuns\x12%.product.ListCacheScheduleRunsRequest\x1a&.product.ListCacheScheduleRunsResponse\x12O\n" +
+	"\x10RunCacheSchedule\x12 .product.RunCacheScheduleRequest\x1a\x19.product.CacheScheduleRunBEZCgithub.com/louai60/e-commerce_project/backend/product-service/protob\x06proto3"
 
 var (
 	file_proto_product_proto_rawDescOnce sync.Once
@@ -2876,139 +18492,750 @@ func file_proto_product_proto_rawDescGZIP() []byte {
 	return file_proto_product_proto_rawDescData
 }
 
-var file_proto_product_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_proto_product_proto_msgTypes = make([]protoimpl.MessageInfo, 264)
 var file_proto_product_proto_goTypes = []any{
-	(*VariantAttributeValue)(nil),      // 0: product.VariantAttributeValue
-	(*VariantImage)(nil),               // 1: product.VariantImage
-	(*ProductVariant)(nil),             // 2: product.ProductVariant
-	(*ProductTag)(nil),                 // 3: product.ProductTag
-	(*ProductAttribute)(nil),           // 4: product.ProductAttribute
-	(*ProductSpecification)(nil),       // 5: product.ProductSpecification
-	(*ProductSEO)(nil),                 // 6: product.ProductSEO
-	(*ProductShipping)(nil),            // 7: product.ProductShipping
-	(*ProductDiscount)(nil),            // 8: product.ProductDiscount
-	(*Product)(nil),                    // 9: product.Product
-	(*ProductImage)(nil),               // 10: product.ProductImage
-	(*Brand)(nil),                      // 11: product.Brand
-	(*Category)(nil),                   // 12: product.Category
-	(*CreateProductRequest)(nil),       // 13: product.CreateProductRequest
-	(*GetProductRequest)(nil),          // 14: product.GetProductRequest
-	(*UpdateProductRequest)(nil),       // 15: product.UpdateProductRequest
-	(*DeleteProductRequest)(nil),       // 16: product.DeleteProductRequest
-	(*DeleteProductResponse)(nil),      // 17: product.DeleteProductResponse
-	(*ListProductsRequest)(nil),        // 18: product.ListProductsRequest
-	(*ListProductsResponse)(nil),       // 19: product.ListProductsResponse
-	(*GetBrandRequest)(nil),            // 20: product.GetBrandRequest
-	(*ListBrandsRequest)(nil),          // 21: product.ListBrandsRequest
-	(*ListBrandsResponse)(nil),         // 22: product.ListBrandsResponse
-	(*CreateBrandRequest)(nil),         // 23: product.CreateBrandRequest
-	(*GetCategoryRequest)(nil),         // 24: product.GetCategoryRequest
-	(*ListCategoriesRequest)(nil),      // 25: product.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil),     // 26: product.ListCategoriesResponse
-	(*CreateCategoryRequest)(nil),      // 27: product.CreateCategoryRequest
-	(*UploadImageRequest)(nil),         // 28: product.UploadImageRequest
-	(*UploadImageResponse)(nil),        // 29: product.UploadImageResponse
-	(*DeleteImageRequest)(nil),         // 30: product.DeleteImageRequest
-	(*DeleteImageResponse)(nil),        // 31: product.DeleteImageResponse
-	(*GenerateSKUPreviewRequest)(nil),  // 32: product.GenerateSKUPreviewRequest
-	(*GenerateSKUPreviewResponse)(nil), // 33: product.GenerateSKUPreviewResponse
-	(*timestamppb.Timestamp)(nil),      // 34: google.protobuf.Timestamp
-	(*wrapperspb.DoubleValue)(nil),     // 35: google.protobuf.DoubleValue
-	(*wrapperspb.StringValue)(nil),     // 36: google.protobuf.StringValue
+	(*VariantAttributeValue)(nil),                // 0: product.VariantAttributeValue
+	(*VariantImage)(nil),                         // 1: product.VariantImage
+	(*ProductVariant)(nil),                       // 2: product.ProductVariant
+	(*ProductTag)(nil),                           // 3: product.ProductTag
+	(*ProductAttribute)(nil),                     // 4: product.ProductAttribute
+	(*ProductSpecification)(nil),                 // 5: product.ProductSpecification
+	(*ProductSEO)(nil),                           // 6: product.ProductSEO
+	(*ProductShipping)(nil),                      // 7: product.ProductShipping
+	(*ProductDiscount)(nil),                      // 8: product.ProductDiscount
+	(*Product)(nil),                              // 9: product.Product
+	(*ProductImage)(nil),                         // 10: product.ProductImage
+	(*ProductMedia)(nil),                         // 11: product.ProductMedia
+	(*Brand)(nil),                                // 12: product.Brand
+	(*Category)(nil),                             // 13: product.Category
+	(*CreateProductRequest)(nil),                 // 14: product.CreateProductRequest
+	(*GetProductRequest)(nil),                    // 15: product.GetProductRequest
+	(*UpdateProductRequest)(nil),                 // 16: product.UpdateProductRequest
+	(*DeleteProductRequest)(nil),                 // 17: product.DeleteProductRequest
+	(*DeleteProductResponse)(nil),                // 18: product.DeleteProductResponse
+	(*ListProductsRequest)(nil),                  // 19: product.ListProductsRequest
+	(*ListProductsResponse)(nil),                 // 20: product.ListProductsResponse
+	(*GetBrandRequest)(nil),                      // 21: product.GetBrandRequest
+	(*ListBrandsRequest)(nil),                    // 22: product.ListBrandsRequest
+	(*ListBrandsResponse)(nil),                   // 23: product.ListBrandsResponse
+	(*CreateBrandRequest)(nil),                   // 24: product.CreateBrandRequest
+	(*GetCategoryRequest)(nil),                   // 25: product.GetCategoryRequest
+	(*ListCategoriesRequest)(nil),                // 26: product.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),               // 27: product.ListCategoriesResponse
+	(*CreateCategoryRequest)(nil),                // 28: product.CreateCategoryRequest
+	(*MoveCategoryRequest)(nil),                  // 29: product.MoveCategoryRequest
+	(*MergeCategoriesRequest)(nil),               // 30: product.MergeCategoriesRequest
+	(*MergeCategoriesResponse)(nil),              // 31: product.MergeCategoriesResponse
+	(*ReorderSiblingsRequest)(nil),               // 32: product.ReorderSiblingsRequest
+	(*ReorderSiblingsResponse)(nil),              // 33: product.ReorderSiblingsResponse
+	(*CategoryAttribute)(nil),                    // 34: product.CategoryAttribute
+	(*CreateCategoryAttributeRequest)(nil),       // 35: product.CreateCategoryAttributeRequest
+	(*UpdateCategoryAttributeRequest)(nil),       // 36: product.UpdateCategoryAttributeRequest
+	(*ListCategoryAttributesRequest)(nil),        // 37: product.ListCategoryAttributesRequest
+	(*ListCategoryAttributesResponse)(nil),       // 38: product.ListCategoryAttributesResponse
+	(*DeleteCategoryAttributeRequest)(nil),       // 39: product.DeleteCategoryAttributeRequest
+	(*DeleteCategoryAttributeResponse)(nil),      // 40: product.DeleteCategoryAttributeResponse
+	(*GetCategoryFacetsRequest)(nil),             // 41: product.GetCategoryFacetsRequest
+	(*FacetValue)(nil),                           // 42: product.FacetValue
+	(*Facet)(nil),                                // 43: product.Facet
+	(*GetCategoryFacetsResponse)(nil),            // 44: product.GetCategoryFacetsResponse
+	(*UploadImageRequest)(nil),                   // 45: product.UploadImageRequest
+	(*UploadImageResponse)(nil),                  // 46: product.UploadImageResponse
+	(*DeleteImageRequest)(nil),                   // 47: product.DeleteImageRequest
+	(*CreateMediaUploadRequest)(nil),             // 48: product.CreateMediaUploadRequest
+	(*MediaUpload)(nil),                          // 49: product.MediaUpload
+	(*ConfirmMediaUploadRequest)(nil),            // 50: product.ConfirmMediaUploadRequest
+	(*ConfirmMediaUploadResponse)(nil),           // 51: product.ConfirmMediaUploadResponse
+	(*AddProductVideoRequest)(nil),               // 52: product.AddProductVideoRequest
+	(*DeleteProductMediaRequest)(nil),            // 53: product.DeleteProductMediaRequest
+	(*DeleteProductMediaResponse)(nil),           // 54: product.DeleteProductMediaResponse
+	(*DeleteImageResponse)(nil),                  // 55: product.DeleteImageResponse
+	(*GenerateSKUPreviewRequest)(nil),            // 56: product.GenerateSKUPreviewRequest
+	(*GenerateSKUPreviewResponse)(nil),           // 57: product.GenerateSKUPreviewResponse
+	(*CollectionRules)(nil),                      // 58: product.CollectionRules
+	(*Collection)(nil),                           // 59: product.Collection
+	(*CreateCollectionRequest)(nil),              // 60: product.CreateCollectionRequest
+	(*GetCollectionRequest)(nil),                 // 61: product.GetCollectionRequest
+	(*UpdateCollectionRequest)(nil),              // 62: product.UpdateCollectionRequest
+	(*DeleteCollectionRequest)(nil),              // 63: product.DeleteCollectionRequest
+	(*DeleteCollectionResponse)(nil),             // 64: product.DeleteCollectionResponse
+	(*ListCollectionsRequest)(nil),               // 65: product.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),              // 66: product.ListCollectionsResponse
+	(*SetCollectionProductsRequest)(nil),         // 67: product.SetCollectionProductsRequest
+	(*ListCollectionProductsRequest)(nil),        // 68: product.ListCollectionProductsRequest
+	(*ListCollectionProductsResponse)(nil),       // 69: product.ListCollectionProductsResponse
+	(*BundleComponent)(nil),                      // 70: product.BundleComponent
+	(*ProductBundle)(nil),                        // 71: product.ProductBundle
+	(*CreateBundleRequest)(nil),                  // 72: product.CreateBundleRequest
+	(*DigitalAsset)(nil),                         // 73: product.DigitalAsset
+	(*UploadDigitalAssetRequest)(nil),            // 74: product.UploadDigitalAssetRequest
+	(*CreateDownloadLinkRequest)(nil),            // 75: product.CreateDownloadLinkRequest
+	(*DownloadLink)(nil),                         // 76: product.DownloadLink
+	(*DownloadDigitalAssetRequest)(nil),          // 77: product.DownloadDigitalAssetRequest
+	(*DigitalAssetChunk)(nil),                    // 78: product.DigitalAssetChunk
+	(*SubscriptionPlan)(nil),                     // 79: product.SubscriptionPlan
+	(*SetSubscriptionPlanRequest)(nil),           // 80: product.SetSubscriptionPlanRequest
+	(*Subscription)(nil),                         // 81: product.Subscription
+	(*CreateSubscriptionRequest)(nil),            // 82: product.CreateSubscriptionRequest
+	(*GetSubscriptionRequest)(nil),               // 83: product.GetSubscriptionRequest
+	(*CancelSubscriptionRequest)(nil),            // 84: product.CancelSubscriptionRequest
+	(*ListSubscriptionsRequest)(nil),             // 85: product.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil),            // 86: product.ListSubscriptionsResponse
+	(*SubscriptionEvent)(nil),                    // 87: product.SubscriptionEvent
+	(*ListSubscriptionEventsRequest)(nil),        // 88: product.ListSubscriptionEventsRequest
+	(*ListSubscriptionEventsResponse)(nil),       // 89: product.ListSubscriptionEventsResponse
+	(*AckSubscriptionEventsRequest)(nil),         // 90: product.AckSubscriptionEventsRequest
+	(*AckSubscriptionEventsResponse)(nil),        // 91: product.AckSubscriptionEventsResponse
+	(*PersonalizationOption)(nil),                // 92: product.PersonalizationOption
+	(*PersonalizationValue)(nil),                 // 93: product.PersonalizationValue
+	(*SetPersonalizationOptionsRequest)(nil),     // 94: product.SetPersonalizationOptionsRequest
+	(*PersonalizationOptionsResponse)(nil),       // 95: product.PersonalizationOptionsResponse
+	(*ValidatePersonalizationRequest)(nil),       // 96: product.ValidatePersonalizationRequest
+	(*ValidatePersonalizationResponse)(nil),      // 97: product.ValidatePersonalizationResponse
+	(*ProductChannel)(nil),                       // 98: product.ProductChannel
+	(*SetProductChannelsRequest)(nil),            // 99: product.SetProductChannelsRequest
+	(*GetProductChannelsRequest)(nil),            // 100: product.GetProductChannelsRequest
+	(*ProductChannelsResponse)(nil),              // 101: product.ProductChannelsResponse
+	(*Store)(nil),                                // 102: product.Store
+	(*CreateStoreRequest)(nil),                   // 103: product.CreateStoreRequest
+	(*GetStoreRequest)(nil),                      // 104: product.GetStoreRequest
+	(*ListStoresRequest)(nil),                    // 105: product.ListStoresRequest
+	(*ListStoresResponse)(nil),                   // 106: product.ListStoresResponse
+	(*UpdateStoreRequest)(nil),                   // 107: product.UpdateStoreRequest
+	(*ProductFeed)(nil),                          // 108: product.ProductFeed
+	(*ListProductFeedsRequest)(nil),              // 109: product.ListProductFeedsRequest
+	(*ListProductFeedsResponse)(nil),             // 110: product.ListProductFeedsResponse
+	(*GenerateProductFeedsRequest)(nil),          // 111: product.GenerateProductFeedsRequest
+	(*DownloadProductFeedRequest)(nil),           // 112: product.DownloadProductFeedRequest
+	(*ProductFeedChunk)(nil),                     // 113: product.ProductFeedChunk
+	(*ErpSyncRun)(nil),                           // 114: product.ErpSyncRun
+	(*RunErpSyncRequest)(nil),                    // 115: product.RunErpSyncRequest
+	(*ListErpSyncRunsRequest)(nil),               // 116: product.ListErpSyncRunsRequest
+	(*ListErpSyncRunsResponse)(nil),              // 117: product.ListErpSyncRunsResponse
+	(*PriceAdjustmentFilter)(nil),                // 118: product.PriceAdjustmentFilter
+	(*BulkAdjustPricesRequest)(nil),              // 119: product.BulkAdjustPricesRequest
+	(*PriceAdjustment)(nil),                      // 120: product.PriceAdjustment
+	(*BulkAdjustPricesResponse)(nil),             // 121: product.BulkAdjustPricesResponse
+	(*ReconciliationEntry)(nil),                  // 122: product.ReconciliationEntry
+	(*InventoryReconciliation)(nil),              // 123: product.InventoryReconciliation
+	(*RunInventoryReconciliationRequest)(nil),    // 124: product.RunInventoryReconciliationRequest
+	(*GetInventoryReconciliationRequest)(nil),    // 125: product.GetInventoryReconciliationRequest
+	(*ListInventoryReconciliationsRequest)(nil),  // 126: product.ListInventoryReconciliationsRequest
+	(*ListInventoryReconciliationsResponse)(nil), // 127: product.ListInventoryReconciliationsResponse
+	(*MergeProductsRequest)(nil),                 // 128: product.MergeProductsRequest
+	(*MergeProductsResponse)(nil),                // 129: product.MergeProductsResponse
+	(*SplitVariantRequest)(nil),                  // 130: product.SplitVariantRequest
+	(*SplitVariantResponse)(nil),                 // 131: product.SplitVariantResponse
+	(*ImportTemplate)(nil),                       // 132: product.ImportTemplate
+	(*SaveImportTemplateRequest)(nil),            // 133: product.SaveImportTemplateRequest
+	(*GetImportTemplateRequest)(nil),             // 134: product.GetImportTemplateRequest
+	(*ListImportTemplatesRequest)(nil),           // 135: product.ListImportTemplatesRequest
+	(*ListImportTemplatesResponse)(nil),          // 136: product.ListImportTemplatesResponse
+	(*DeleteImportTemplateRequest)(nil),          // 137: product.DeleteImportTemplateRequest
+	(*DeleteImportTemplateResponse)(nil),         // 138: product.DeleteImportTemplateResponse
+	(*ImportSupplierCatalogRequest)(nil),         // 139: product.ImportSupplierCatalogRequest
+	(*ImportRowError)(nil),                       // 140: product.ImportRowError
+	(*ImportSupplierCatalogResponse)(nil),        // 141: product.ImportSupplierCatalogResponse
+	(*ProductNote)(nil),                          // 142: product.ProductNote
+	(*CreateProductNoteRequest)(nil),             // 143: product.CreateProductNoteRequest
+	(*ListProductNotesRequest)(nil),              // 144: product.ListProductNotesRequest
+	(*ListProductNotesResponse)(nil),             // 145: product.ListProductNotesResponse
+	(*UpdateProductNoteRequest)(nil),             // 146: product.UpdateProductNoteRequest
+	(*DeleteProductNoteRequest)(nil),             // 147: product.DeleteProductNoteRequest
+	(*DeleteProductNoteResponse)(nil),            // 148: product.DeleteProductNoteResponse
+	(*ProductRelationship)(nil),                  // 149: product.ProductRelationship
+	(*RelatedProduct)(nil),                       // 150: product.RelatedProduct
+	(*CreateProductRelationshipRequest)(nil),     // 151: product.CreateProductRelationshipRequest
+	(*ListProductRelationshipsRequest)(nil),      // 152: product.ListProductRelationshipsRequest
+	(*ListProductRelationshipsResponse)(nil),     // 153: product.ListProductRelationshipsResponse
+	(*UpdateProductRelationshipRequest)(nil),     // 154: product.UpdateProductRelationshipRequest
+	(*DeleteProductRelationshipRequest)(nil),     // 155: product.DeleteProductRelationshipRequest
+	(*DeleteProductRelationshipResponse)(nil),    // 156: product.DeleteProductRelationshipResponse
+	(*ProductAnswer)(nil),                        // 157: product.ProductAnswer
+	(*ProductQuestion)(nil),                      // 158: product.ProductQuestion
+	(*AskProductQuestionRequest)(nil),            // 159: product.AskProductQuestionRequest
+	(*GetProductQuestionRequest)(nil),            // 160: product.GetProductQuestionRequest
+	(*ListProductQuestionsRequest)(nil),          // 161: product.ListProductQuestionsRequest
+	(*ListProductQuestionsResponse)(nil),         // 162: product.ListProductQuestionsResponse
+	(*ModerateProductQuestionRequest)(nil),       // 163: product.ModerateProductQuestionRequest
+	(*DeleteProductQuestionRequest)(nil),         // 164: product.DeleteProductQuestionRequest
+	(*DeleteProductQuestionResponse)(nil),        // 165: product.DeleteProductQuestionResponse
+	(*AnswerProductQuestionRequest)(nil),         // 166: product.AnswerProductQuestionRequest
+	(*ModerateProductAnswerRequest)(nil),         // 167: product.ModerateProductAnswerRequest
+	(*DeleteProductAnswerRequest)(nil),           // 168: product.DeleteProductAnswerRequest
+	(*DeleteProductAnswerResponse)(nil),          // 169: product.DeleteProductAnswerResponse
+	(*UpvoteProductAnswerRequest)(nil),           // 170: product.UpvoteProductAnswerRequest
+	(*SavedSearch)(nil),                          // 171: product.SavedSearch
+	(*SaveSearchRequest)(nil),                    // 172: product.SaveSearchRequest
+	(*ListSavedSearchesRequest)(nil),             // 173: product.ListSavedSearchesRequest
+	(*ListSavedSearchesResponse)(nil),            // 174: product.ListSavedSearchesResponse
+	(*DeleteSavedSearchRequest)(nil),             // 175: product.DeleteSavedSearchRequest
+	(*DeleteSavedSearchResponse)(nil),            // 176: product.DeleteSavedSearchResponse
+	(*SavedSearchAlert)(nil),                     // 177: product.SavedSearchAlert
+	(*ListSavedSearchAlertsRequest)(nil),         // 178: product.ListSavedSearchAlertsRequest
+	(*ListSavedSearchAlertsResponse)(nil),        // 179: product.ListSavedSearchAlertsResponse
+	(*AckSavedSearchAlertsRequest)(nil),          // 180: product.AckSavedSearchAlertsRequest
+	(*AckSavedSearchAlertsResponse)(nil),         // 181: product.AckSavedSearchAlertsResponse
+	(*SearchRankingRule)(nil),                    // 182: product.SearchRankingRule
+	(*SaveSearchRankingRuleRequest)(nil),         // 183: product.SaveSearchRankingRuleRequest
+	(*ListSearchRankingRulesRequest)(nil),        // 184: product.ListSearchRankingRulesRequest
+	(*ListSearchRankingRulesResponse)(nil),       // 185: product.ListSearchRankingRulesResponse
+	(*DeleteSearchRankingRuleRequest)(nil),       // 186: product.DeleteSearchRankingRuleRequest
+	(*DeleteSearchRankingRuleResponse)(nil),      // 187: product.DeleteSearchRankingRuleResponse
+	(*PublishSearchRankingRulesRequest)(nil),     // 188: product.PublishSearchRankingRulesRequest
+	(*PublishSearchRankingRulesResponse)(nil),    // 189: product.PublishSearchRankingRulesResponse
+	(*SearchProductsRequest)(nil),                // 190: product.SearchProductsRequest
+	(*SearchResult)(nil),                         // 191: product.SearchResult
+	(*SearchProductsResponse)(nil),               // 192: product.SearchProductsResponse
+	(*PreviewSearchRankingRequest)(nil),          // 193: product.PreviewSearchRankingRequest
+	(*RankedSearchResult)(nil),                   // 194: product.RankedSearchResult
+	(*PreviewSearchRankingResponse)(nil),         // 195: product.PreviewSearchRankingResponse
+	(*ProductBadge)(nil),                         // 196: product.ProductBadge
+	(*BadgeRule)(nil),                            // 197: product.BadgeRule
+	(*ListBadgeRulesRequest)(nil),                // 198: product.ListBadgeRulesRequest
+	(*ListBadgeRulesResponse)(nil),               // 199: product.ListBadgeRulesResponse
+	(*SaveBadgeRuleRequest)(nil),                 // 200: product.SaveBadgeRuleRequest
+	(*DeleteBadgeRuleRequest)(nil),               // 201: product.DeleteBadgeRuleRequest
+	(*DeleteBadgeRuleResponse)(nil),              // 202: product.DeleteBadgeRuleResponse
+	(*RecomputeBadgesRequest)(nil),               // 203: product.RecomputeBadgesRequest
+	(*RecomputeBadgesResponse)(nil),              // 204: product.RecomputeBadgesResponse
+	(*ProductSaleLine)(nil),                      // 205: product.ProductSaleLine
+	(*RecordProductSalesRequest)(nil),            // 206: product.RecordProductSalesRequest
+	(*RecordProductSalesResponse)(nil),           // 207: product.RecordProductSalesResponse
+	(*CatalogActivity)(nil),                      // 208: product.CatalogActivity
+	(*ListCatalogActivityRequest)(nil),           // 209: product.ListCatalogActivityRequest
+	(*ListCatalogActivityResponse)(nil),          // 210: product.ListCatalogActivityResponse
+	(*Translation)(nil),                          // 211: product.Translation
+	(*SetTranslationRequest)(nil),                // 212: product.SetTranslationRequest
+	(*ListTranslationsRequest)(nil),              // 213: product.ListTranslationsRequest
+	(*ListTranslationsResponse)(nil),             // 214: product.ListTranslationsResponse
+	(*DeleteTranslationRequest)(nil),             // 215: product.DeleteTranslationRequest
+	(*DeleteTranslationResponse)(nil),            // 216: product.DeleteTranslationResponse
+	(*ContentPage)(nil),                          // 217: product.ContentPage
+	(*CreateContentPageRequest)(nil),             // 218: product.CreateContentPageRequest
+	(*UpdateContentPageRequest)(nil),             // 219: product.UpdateContentPageRequest
+	(*GetContentPageRequest)(nil),                // 220: product.GetContentPageRequest
+	(*ListContentPagesRequest)(nil),              // 221: product.ListContentPagesRequest
+	(*ListContentPagesResponse)(nil),             // 222: product.ListContentPagesResponse
+	(*DeleteContentPageRequest)(nil),             // 223: product.DeleteContentPageRequest
+	(*DeleteContentPageResponse)(nil),            // 224: product.DeleteContentPageResponse
+	(*ContentBanner)(nil),                        // 225: product.ContentBanner
+	(*CreateContentBannerRequest)(nil),           // 226: product.CreateContentBannerRequest
+	(*UpdateContentBannerRequest)(nil),           // 227: product.UpdateContentBannerRequest
+	(*ListContentBannersRequest)(nil),            // 228: product.ListContentBannersRequest
+	(*ListContentBannersResponse)(nil),           // 229: product.ListContentBannersResponse
+	(*DeleteContentBannerRequest)(nil),           // 230: product.DeleteContentBannerRequest
+	(*DeleteContentBannerResponse)(nil),          // 231: product.DeleteContentBannerResponse
+	(*Setting)(nil),                              // 232: product.Setting
+	(*ListSettingsRequest)(nil),                  // 233: product.ListSettingsRequest
+	(*ListSettingsResponse)(nil),                 // 234: product.ListSettingsResponse
+	(*SetSettingRequest)(nil),                    // 235: product.SetSettingRequest
+	(*DeleteSettingRequest)(nil),                 // 236: product.DeleteSettingRequest
+	(*DeleteSettingResponse)(nil),                // 237: product.DeleteSettingResponse
+	(*GetStorefrontConfigRequest)(nil),           // 238: product.GetStorefrontConfigRequest
+	(*StoreBranding)(nil),                        // 239: product.StoreBranding
+	(*StorefrontConfig)(nil),                     // 240: product.StorefrontConfig
+	(*ProductQualityScore)(nil),                  // 241: product.ProductQualityScore
+	(*GetCatalogQualityReportRequest)(nil),       // 242: product.GetCatalogQualityReportRequest
+	(*CatalogQualityReport)(nil),                 // 243: product.CatalogQualityReport
+	(*GetProductQualityScoreRequest)(nil),        // 244: product.GetProductQualityScoreRequest
+	(*RecomputeCatalogQualityRequest)(nil),       // 245: product.RecomputeCatalogQualityRequest
+	(*RecomputeCatalogQualityResponse)(nil),      // 246: product.RecomputeCatalogQualityResponse
+	(*GetDiagnosticsRequest)(nil),                // 247: product.GetDiagnosticsRequest
+	(*DBPoolDiagnostics)(nil),                    // 248: product.DBPoolDiagnostics
+	(*CacheDiagnostics)(nil),                     // 249: product.CacheDiagnostics
+	(*DiagnosticsResponse)(nil),                  // 250: product.DiagnosticsResponse
+	(*FlushCacheNamespaceRequest)(nil),           // 251: product.FlushCacheNamespaceRequest
+	(*FlushCacheNamespaceResponse)(nil),          // 252: product.FlushCacheNamespaceResponse
+	(*CacheWarmQuery)(nil),                       // 253: product.CacheWarmQuery
+	(*CacheScheduleRun)(nil),                     // 254: product.CacheScheduleRun
+	(*CacheSchedule)(nil),                        // 255: product.CacheSchedule
+	(*ListCacheSchedulesRequest)(nil),            // 256: product.ListCacheSchedulesRequest
+	(*ListCacheSchedulesResponse)(nil),           // 257: product.ListCacheSchedulesResponse
+	(*ListCacheScheduleRunsRequest)(nil),         // 258: product.ListCacheScheduleRunsRequest
+	(*ListCacheScheduleRunsResponse)(nil),        // 259: product.ListCacheScheduleRunsResponse
+	(*RunCacheScheduleRequest)(nil),              // 260: product.RunCacheScheduleRequest
+	nil,                                          // 261: product.MediaUpload.FieldsEntry
+	nil,                                          // 262: product.ImportTemplate.ColumnMappingsEntry
+	nil,                                          // 263: product.CatalogQualityReport.IssueCountsEntry
+	(*timestamppb.Timestamp)(nil),                // 264: google.protobuf.Timestamp
+	(*wrapperspb.DoubleValue)(nil),               // 265: google.protobuf.DoubleValue
+	(*structpb.Struct)(nil),                      // 266: google.protobuf.Struct
+	(*wrapperspb.StringValue)(nil),               // 267: google.protobuf.StringValue
+	(*wrapperspb.Int32Value)(nil),                // 268: google.protobuf.Int32Value
 }
 var file_proto_product_proto_depIdxs = []int32{
-	34, // 0: product.VariantImage.created_at:type_name -> google.protobuf.Timestamp
-	34, // 1: product.VariantImage.updated_at:type_name -> google.protobuf.Timestamp
-	35, // 2: product.ProductVariant.discount_price:type_name -> google.protobuf.DoubleValue
-	0,  // 3: product.ProductVariant.attributes:type_name -> product.VariantAttributeValue
-	1,  // 4: product.ProductVariant.images:type_name -> product.VariantImage
-	34, // 5: product.ProductVariant.created_at:type_name -> google.protobuf.Timestamp
-	34, // 6: product.ProductVariant.updated_at:type_name -> google.protobuf.Timestamp
-	5,  // 7: product.ProductVariant.specifications:type_name -> product.ProductSpecification
-	3,  // 8: product.ProductVariant.tags:type_name -> product.ProductTag
-	12, // 9: product.ProductVariant.categories:type_name -> product.Category
-	11, // 10: product.ProductVariant.brand:type_name -> product.Brand
-	6,  // 11: product.ProductVariant.seo:type_name -> product.ProductSEO
-	7,  // 12: product.ProductVariant.shipping:type_name -> product.ProductShipping
-	8,  // 13: product.ProductVariant.discount:type_name -> product.ProductDiscount
-	34, // 14: product.ProductTag.created_at:type_name -> google.protobuf.Timestamp
-	34, // 15: product.ProductTag.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 16: product.ProductAttribute.created_at:type_name -> google.protobuf.Timestamp
-	34, // 17: product.ProductAttribute.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 18: product.ProductSpecification.created_at:type_name -> google.protobuf.Timestamp
-	34, // 19: product.ProductSpecification.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 20: product.ProductSEO.created_at:type_name -> google.protobuf.Timestamp
-	34, // 21: product.ProductSEO.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 22: product.ProductShipping.created_at:type_name -> google.protobuf.Timestamp
-	34, // 23: product.ProductShipping.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 24: product.ProductDiscount.expires_at:type_name -> google.protobuf.Timestamp
-	34, // 25: product.ProductDiscount.created_at:type_name -> google.protobuf.Timestamp
-	34, // 26: product.ProductDiscount.updated_at:type_name -> google.protobuf.Timestamp
-	35, // 27: product.Product.discount_price:type_name -> google.protobuf.DoubleValue
-	35, // 28: product.Product.weight:type_name -> google.protobuf.DoubleValue
-	34, // 29: product.Product.created_at:type_name -> google.protobuf.Timestamp
-	34, // 30: product.Product.updated_at:type_name -> google.protobuf.Timestamp
-	36, // 31: product.Product.brand_id:type_name -> google.protobuf.StringValue
-	11, // 32: product.Product.brand:type_name -> product.Brand
-	10, // 33: product.Product.images:type_name -> product.ProductImage
-	12, // 34: product.Product.categories:type_name -> product.Category
-	2,  // 35: product.Product.variants:type_name -> product.ProductVariant
-	36, // 36: product.Product.default_variant_id:type_name -> google.protobuf.StringValue
-	3,  // 37: product.Product.tags:type_name -> product.ProductTag
-	4,  // 38: product.Product.attributes:type_name -> product.ProductAttribute
-	5,  // 39: product.Product.specifications:type_name -> product.ProductSpecification
-	6,  // 40: product.Product.seo:type_name -> product.ProductSEO
-	7,  // 41: product.Product.shipping:type_name -> product.ProductShipping
-	8,  // 42: product.Product.discount:type_name -> product.ProductDiscount
-	34, // 43: product.ProductImage.created_at:type_name -> google.protobuf.Timestamp
-	34, // 44: product.ProductImage.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 45: product.Brand.created_at:type_name -> google.protobuf.Timestamp
-	34, // 46: product.Brand.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 47: product.Brand.deleted_at:type_name -> google.protobuf.Timestamp
-	36, // 48: product.Category.parent_id:type_name -> google.protobuf.StringValue
-	34, // 49: product.Category.created_at:type_name -> google.protobuf.Timestamp
-	34, // 50: product.Category.updated_at:type_name -> google.protobuf.Timestamp
-	34, // 51: product.Category.deleted_at:type_name -> google.protobuf.Timestamp
-	9,  // 52: product.CreateProductRequest.product:type_name -> product.Product
-	9,  // 53: product.UpdateProductRequest.product:type_name -> product.Product
-	9,  // 54: product.ListProductsResponse.products:type_name -> product.Product
-	11, // 55: product.ListBrandsResponse.brands:type_name -> product.Brand
-	11, // 56: product.CreateBrandRequest.brand:type_name -> product.Brand
-	12, // 57: product.ListCategoriesResponse.categories:type_name -> product.Category
-	12, // 58: product.CreateCategoryRequest.category:type_name -> product.Category
-	13, // 59: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
-	14, // 60: product.ProductService.GetProduct:input_type -> product.GetProductRequest
-	18, // 61: product.ProductService.ListProducts:input_type -> product.ListProductsRequest
-	15, // 62: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
-	16, // 63: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
-	23, // 64: product.ProductService.CreateBrand:input_type -> product.CreateBrandRequest
-	20, // 65: product.ProductService.GetBrand:input_type -> product.GetBrandRequest
-	21, // 66: product.ProductService.ListBrands:input_type -> product.ListBrandsRequest
-	27, // 67: product.ProductService.CreateCategory:input_type -> product.CreateCategoryRequest
-	24, // 68: product.ProductService.GetCategory:input_type -> product.GetCategoryRequest
-	25, // 69: product.ProductService.ListCategories:input_type -> product.ListCategoriesRequest
-	28, // 70: product.ProductService.UploadImage:input_type -> product.UploadImageRequest
-	30, // 71: product.ProductService.DeleteImage:input_type -> product.DeleteImageRequest
-	32, // 72: product.ProductService.GenerateSKUPreview:input_type -> product.GenerateSKUPreviewRequest
-	9,  // 73: product.ProductService.CreateProduct:output_type -> product.Product
-	9,  // 74: product.ProductService.GetProduct:output_type -> product.Product
-	19, // 75: product.ProductService.ListProducts:output_type -> product.ListProductsResponse
-	9,  // 76: product.ProductService.UpdateProduct:output_type -> product.Product
-	17, // 77: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
-	11, // 78: product.ProductService.CreateBrand:output_type -> product.Brand
-	11, // 79: product.ProductService.GetBrand:output_type -> product.Brand
-	22, // 80: product.ProductService.ListBrands:output_type -> product.ListBrandsResponse
-	12, // 81: product.ProductService.CreateCategory:output_type -> product.Category
-	12, // 82: product.ProductService.GetCategory:output_type -> product.Category
-	26, // 83: product.ProductService.ListCategories:output_type -> product.ListCategoriesResponse
-	29, // 84: product.ProductService.UploadImage:output_type -> product.UploadImageResponse
-	31, // 85: product.ProductService.DeleteImage:output_type -> product.DeleteImageResponse
-	33, // 86: product.ProductService.GenerateSKUPreview:output_type -> product.GenerateSKUPreviewResponse
-	73, // [73:87] is the sub-list for method output_type
-	59, // [59:73] is the sub-list for method input_type
-	59, // [59:59] is the sub-list for extension type_name
-	59, // [59:59] is the sub-list for extension extendee
-	0,  // [0:59] is the sub-list for field type_name
+	264, // 0: product.VariantImage.created_at:type_name -> google.protobuf.Timestamp
+	264, // 1: product.VariantImage.updated_at:type_name -> google.protobuf.Timestamp
+	265, // 2: product.ProductVariant.discount_price:type_name -> google.protobuf.DoubleValue
+	0,   // 3: product.ProductVariant.attributes:type_name -> product.VariantAttributeValue
+	1,   // 4: product.ProductVariant.images:type_name -> product.VariantImage
+	264, // 5: product.ProductVariant.created_at:type_name -> google.protobuf.Timestamp
+	264, // 6: product.ProductVariant.updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 7: product.ProductVariant.specifications:type_name -> product.ProductSpecification
+	3,   // 8: product.ProductVariant.tags:type_name -> product.ProductTag
+	13,  // 9: product.ProductVariant.categories:type_name -> product.Category
+	12,  // 10: product.ProductVariant.brand:type_name -> product.Brand
+	6,   // 11: product.ProductVariant.seo:type_name -> product.ProductSEO
+	7,   // 12: product.ProductVariant.shipping:type_name -> product.ProductShipping
+	8,   // 13: product.ProductVariant.discount:type_name -> product.ProductDiscount
+	266, // 14: product.ProductVariant.metadata:type_name -> google.protobuf.Struct
+	264, // 15: product.ProductTag.created_at:type_name -> google.protobuf.Timestamp
+	264, // 16: product.ProductTag.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 17: product.ProductAttribute.created_at:type_name -> google.protobuf.Timestamp
+	264, // 18: product.ProductAttribute.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 19: product.ProductSpecification.created_at:type_name -> google.protobuf.Timestamp
+	264, // 20: product.ProductSpecification.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 21: product.ProductSEO.created_at:type_name -> google.protobuf.Timestamp
+	264, // 22: product.ProductSEO.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 23: product.ProductShipping.created_at:type_name -> google.protobuf.Timestamp
+	264, // 24: product.ProductShipping.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 25: product.ProductDiscount.expires_at:type_name -> google.protobuf.Timestamp
+	264, // 26: product.ProductDiscount.created_at:type_name -> google.protobuf.Timestamp
+	264, // 27: product.ProductDiscount.updated_at:type_name -> google.protobuf.Timestamp
+	265, // 28: product.Product.discount_price:type_name -> google.protobuf.DoubleValue
+	265, // 29: product.Product.weight:type_name -> google.protobuf.DoubleValue
+	264, // 30: product.Product.created_at:type_name -> google.protobuf.Timestamp
+	264, // 31: product.Product.updated_at:type_name -> google.protobuf.Timestamp
+	267, // 32: product.Product.brand_id:type_name -> google.protobuf.StringValue
+	12,  // 33: product.Product.brand:type_name -> product.Brand
+	10,  // 34: product.Product.images:type_name -> product.ProductImage
+	13,  // 35: product.Product.categories:type_name -> product.Category
+	2,   // 36: product.Product.variants:type_name -> product.ProductVariant
+	267, // 37: product.Product.default_variant_id:type_name -> google.protobuf.StringValue
+	3,   // 38: product.Product.tags:type_name -> product.ProductTag
+	4,   // 39: product.Product.attributes:type_name -> product.ProductAttribute
+	5,   // 40: product.Product.specifications:type_name -> product.ProductSpecification
+	6,   // 41: product.Product.seo:type_name -> product.ProductSEO
+	7,   // 42: product.Product.shipping:type_name -> product.ProductShipping
+	8,   // 43: product.Product.discount:type_name -> product.ProductDiscount
+	71,  // 44: product.Product.bundle:type_name -> product.ProductBundle
+	73,  // 45: product.Product.digital_asset:type_name -> product.DigitalAsset
+	79,  // 46: product.Product.subscription:type_name -> product.SubscriptionPlan
+	150, // 47: product.Product.related_products:type_name -> product.RelatedProduct
+	196, // 48: product.Product.badges:type_name -> product.ProductBadge
+	266, // 49: product.Product.metadata:type_name -> google.protobuf.Struct
+	92,  // 50: product.Product.personalization_options:type_name -> product.PersonalizationOption
+	11,  // 51: product.Product.media:type_name -> product.ProductMedia
+	264, // 52: product.ProductImage.created_at:type_name -> google.protobuf.Timestamp
+	264, // 53: product.ProductImage.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 54: product.ProductMedia.created_at:type_name -> google.protobuf.Timestamp
+	264, // 55: product.ProductMedia.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 56: product.Brand.created_at:type_name -> google.protobuf.Timestamp
+	264, // 57: product.Brand.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 58: product.Brand.deleted_at:type_name -> google.protobuf.Timestamp
+	267, // 59: product.Category.parent_id:type_name -> google.protobuf.StringValue
+	264, // 60: product.Category.created_at:type_name -> google.protobuf.Timestamp
+	264, // 61: product.Category.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 62: product.Category.deleted_at:type_name -> google.protobuf.Timestamp
+	9,   // 63: product.CreateProductRequest.product:type_name -> product.Product
+	9,   // 64: product.UpdateProductRequest.product:type_name -> product.Product
+	9,   // 65: product.ListProductsResponse.products:type_name -> product.Product
+	12,  // 66: product.ListBrandsResponse.brands:type_name -> product.Brand
+	12,  // 67: product.CreateBrandRequest.brand:type_name -> product.Brand
+	13,  // 68: product.ListCategoriesResponse.categories:type_name -> product.Category
+	13,  // 69: product.CreateCategoryRequest.category:type_name -> product.Category
+	267, // 70: product.MoveCategoryRequest.parent_id:type_name -> google.protobuf.StringValue
+	13,  // 71: product.MergeCategoriesResponse.category:type_name -> product.Category
+	267, // 72: product.ReorderSiblingsRequest.parent_id:type_name -> google.protobuf.StringValue
+	13,  // 73: product.ReorderSiblingsResponse.categories:type_name -> product.Category
+	264, // 74: product.CategoryAttribute.created_at:type_name -> google.protobuf.Timestamp
+	264, // 75: product.CategoryAttribute.updated_at:type_name -> google.protobuf.Timestamp
+	34,  // 76: product.CreateCategoryAttributeRequest.attribute:type_name -> product.CategoryAttribute
+	34,  // 77: product.UpdateCategoryAttributeRequest.attribute:type_name -> product.CategoryAttribute
+	34,  // 78: product.ListCategoryAttributesResponse.attributes:type_name -> product.CategoryAttribute
+	42,  // 79: product.Facet.values:type_name -> product.FacetValue
+	43,  // 80: product.GetCategoryFacetsResponse.facets:type_name -> product.Facet
+	261, // 81: product.MediaUpload.fields:type_name -> product.MediaUpload.FieldsEntry
+	264, // 82: product.MediaUpload.expires_at:type_name -> google.protobuf.Timestamp
+	10,  // 83: product.ConfirmMediaUploadResponse.image:type_name -> product.ProductImage
+	11,  // 84: product.ConfirmMediaUploadResponse.media:type_name -> product.ProductMedia
+	58,  // 85: product.Collection.rules:type_name -> product.CollectionRules
+	264, // 86: product.Collection.created_at:type_name -> google.protobuf.Timestamp
+	264, // 87: product.Collection.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 88: product.Collection.deleted_at:type_name -> google.protobuf.Timestamp
+	59,  // 89: product.CreateCollectionRequest.collection:type_name -> product.Collection
+	59,  // 90: product.UpdateCollectionRequest.collection:type_name -> product.Collection
+	59,  // 91: product.ListCollectionsResponse.collections:type_name -> product.Collection
+	59,  // 92: product.ListCollectionProductsResponse.collection:type_name -> product.Collection
+	9,   // 93: product.ListCollectionProductsResponse.products:type_name -> product.Product
+	70,  // 94: product.ProductBundle.components:type_name -> product.BundleComponent
+	265, // 95: product.ProductBundle.price_override:type_name -> google.protobuf.DoubleValue
+	9,   // 96: product.CreateBundleRequest.product:type_name -> product.Product
+	70,  // 97: product.CreateBundleRequest.components:type_name -> product.BundleComponent
+	265, // 98: product.CreateBundleRequest.price_override:type_name -> google.protobuf.DoubleValue
+	264, // 99: product.DigitalAsset.created_at:type_name -> google.protobuf.Timestamp
+	264, // 100: product.DigitalAsset.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 101: product.DownloadLink.expires_at:type_name -> google.protobuf.Timestamp
+	264, // 102: product.SubscriptionPlan.created_at:type_name -> google.protobuf.Timestamp
+	264, // 103: product.SubscriptionPlan.updated_at:type_name -> google.protobuf.Timestamp
+	264, // 104: product.Subscription.current_period_start:type_name -> google.protobuf.Timestamp
+	264, // 105: product.Subscription.current_period_end:type_name -> google.protobuf.Timestamp
+	264, // 106: product.Subscription.trial_ends_at:type_name -> google.protobuf.Timestamp
+	264, // 107: product.Subscription.cancelled_at:type_name -> google.protobuf.Timestamp
+	264, // 108: product.Subscription.created_at:type_name -> google.protobuf.Timestamp
+	264, // 109: product.Subscription.updated_at:type_name -> google.protobuf.Timestamp
+	81,  // 110: product.ListSubscriptionsResponse.subscriptions:type_name -> product.Subscription
+	264, // 111: product.SubscriptionEvent.period_start:type_name -> google.protobuf.Timestamp
+	264, // 112: product.SubscriptionEvent.period_end:type_name -> google.protobuf.Timestamp
+	264, // 113: product.SubscriptionEvent.created_at:type_name -> google.protobuf.Timestamp
+	87,  // 114: product.ListSubscriptionEventsResponse.events:type_name -> product.SubscriptionEvent
+	92,  // 115: product.SetPersonalizationOptionsRequest.options:type_name -> product.PersonalizationOption
+	92,  // 116: product.PersonalizationOptionsResponse.options:type_name -> product.PersonalizationOption
+	93,  // 117: product.ValidatePersonalizationRequest.values:type_name -> product.PersonalizationValue
+	93,  // 118: product.ValidatePersonalizationResponse.values:type_name -> product.PersonalizationValue
+	264, // 119: product.ProductChannel.updated_at:type_name -> google.protobuf.Timestamp
+	98,  // 120: product.SetProductChannelsRequest.channels:type_name -> product.ProductChannel
+	98,  // 121: product.ProductChannelsResponse.channels:type_name -> product.ProductChannel
+	264, // 122: product.Store.created_at:type_name -> google.protobuf.Timestamp
+	264, // 123: product.Store.updated_at:type_name -> google.protobuf.Timestamp
+	102, // 124: product.ListStoresResponse.stores:type_name -> product.Store
+	264, // 125: product.ProductFeed.url_expires_at:type_name -> google.protobuf.Timestamp
+	264, // 126: product.ProductFeed.generated_at:type_name -> google.protobuf.Timestamp
+	108, // 127: product.ListProductFeedsResponse.feeds:type_name -> product.ProductFeed
+	264, // 128: product.ErpSyncRun.started_at:type_name -> google.protobuf.Timestamp
+	264, // 129: product.ErpSyncRun.finished_at:type_name -> google.protobuf.Timestamp
+	114, // 130: product.ListErpSyncRunsResponse.runs:type_name -> product.ErpSyncRun
+	118, // 131: product.BulkAdjustPricesRequest.filter:type_name -> product.PriceAdjustmentFilter
+	265, // 132: product.PriceAdjustment.old_discount_price:type_name -> google.protobuf.DoubleValue
+	265, // 133: product.PriceAdjustment.new_discount_price:type_name -> google.protobuf.DoubleValue
+	120, // 134: product.BulkAdjustPricesResponse.adjustments:type_name -> product.PriceAdjustment
+	122, // 135: product.InventoryReconciliation.entries:type_name -> product.ReconciliationEntry
+	264, // 136: product.InventoryReconciliation.started_at:type_name -> google.protobuf.Timestamp
+	264, // 137: product.InventoryReconciliation.finished_at:type_name -> google.protobuf.Timestamp
+	123, // 138: product.ListInventoryReconciliationsResponse.reconciliations:type_name -> product.InventoryReconciliation
+	9,   // 139: product.MergeProductsResponse.product:type_name -> product.Product
+	9,   // 140: product.SplitVariantResponse.product:type_name -> product.Product
+	262, // 141: product.ImportTemplate.column_mappings:type_name -> product.ImportTemplate.ColumnMappingsEntry
+	264, // 142: product.ImportTemplate.created_at:type_name -> google.protobuf.Timestamp
+	264, // 143: product.ImportTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	132, // 144: product.SaveImportTemplateRequest.template:type_name -> product.ImportTemplate
+	132, // 145: product.ListImportTemplatesResponse.templates:type_name -> product.ImportTemplate
+	140, // 146: product.ImportSupplierCatalogResponse.errors:type_name -> product.ImportRowError
+	264, // 147: product.ProductNote.created_at:type_name -> google.protobuf.Timestamp
+	264, // 148: product.ProductNote.updated_at:type_name -> google.protobuf.Timestamp
+	142, // 149: product.ListProductNotesResponse.notes:type_name -> product.ProductNote
+	264, // 150: product.ProductRelationship.created_at:type_name -> google.protobuf.Timestamp
+	264, // 151: product.ProductRelationship.updated_at:type_name -> google.protobuf.Timestamp
+	9,   // 152: product.RelatedProduct.product:type_name -> product.Product
+	149, // 153: product.ListProductRelationshipsResponse.relationships:type_name -> product.ProductRelationship
+	264, // 154: product.ProductAnswer.created_at:type_name -> google.protobuf.Timestamp
+	264, // 155: product.ProductAnswer.updated_at:type_name -> google.protobuf.Timestamp
+	157, // 156: product.ProductQuestion.answers:type_name -> product.ProductAnswer
+	264, // 157: product.ProductQuestion.created_at:type_name -> google.protobuf.Timestamp
+	264, // 158: product.ProductQuestion.updated_at:type_name -> google.protobuf.Timestamp
+	158, // 159: product.ListProductQuestionsResponse.questions:type_name -> product.ProductQuestion
+	264, // 160: product.SavedSearch.last_evaluated_at:type_name -> google.protobuf.Timestamp
+	264, // 161: product.SavedSearch.created_at:type_name -> google.protobuf.Timestamp
+	264, // 162: product.SavedSearch.updated_at:type_name -> google.protobuf.Timestamp
+	171, // 163: product.ListSavedSearchesResponse.saved_searches:type_name -> product.SavedSearch
+	264, // 164: product.SavedSearchAlert.created_at:type_name -> google.protobuf.Timestamp
+	177, // 165: product.ListSavedSearchAlertsResponse.alerts:type_name -> product.SavedSearchAlert
+	264, // 166: product.SearchRankingRule.published_at:type_name -> google.protobuf.Timestamp
+	264, // 167: product.SearchRankingRule.created_at:type_name -> google.protobuf.Timestamp
+	264, // 168: product.SearchRankingRule.updated_at:type_name -> google.protobuf.Timestamp
+	182, // 169: product.ListSearchRankingRulesResponse.rules:type_name -> product.SearchRankingRule
+	191, // 170: product.SearchProductsResponse.results:type_name -> product.SearchResult
+	191, // 171: product.RankedSearchResult.result:type_name -> product.SearchResult
+	194, // 172: product.PreviewSearchRankingResponse.results:type_name -> product.RankedSearchResult
+	264, // 173: product.BadgeRule.updated_at:type_name -> google.protobuf.Timestamp
+	197, // 174: product.ListBadgeRulesResponse.rules:type_name -> product.BadgeRule
+	205, // 175: product.RecordProductSalesRequest.lines:type_name -> product.ProductSaleLine
+	264, // 176: product.CatalogActivity.occurred_at:type_name -> google.protobuf.Timestamp
+	208, // 177: product.ListCatalogActivityResponse.entries:type_name -> product.CatalogActivity
+	264, // 178: product.Translation.created_at:type_name -> google.protobuf.Timestamp
+	264, // 179: product.Translation.updated_at:type_name -> google.protobuf.Timestamp
+	211, // 180: product.SetTranslationRequest.translation:type_name -> product.Translation
+	211, // 181: product.ListTranslationsResponse.translations:type_name -> product.Translation
+	264, // 182: product.ContentPage.publish_at:type_name -> google.protobuf.Timestamp
+	264, // 183: product.ContentPage.unpublish_at:type_name -> google.protobuf.Timestamp
+	264, // 184: product.ContentPage.created_at:type_name -> google.protobuf.Timestamp
+	264, // 185: product.ContentPage.updated_at:type_name -> google.protobuf.Timestamp
+	217, // 186: product.CreateContentPageRequest.page:type_name -> product.ContentPage
+	217, // 187: product.UpdateContentPageRequest.page:type_name -> product.ContentPage
+	217, // 188: product.ListContentPagesResponse.pages:type_name -> product.ContentPage
+	264, // 189: product.ContentBanner.publish_at:type_name -> google.protobuf.Timestamp
+	264, // 190: product.ContentBanner.unpublish_at:type_name -> google.protobuf.Timestamp
+	264, // 191: product.ContentBanner.created_at:type_name -> google.protobuf.Timestamp
+	264, // 192: product.ContentBanner.updated_at:type_name -> google.protobuf.Timestamp
+	225, // 193: product.CreateContentBannerRequest.banner:type_name -> product.ContentBanner
+	225, // 194: product.UpdateContentBannerRequest.banner:type_name -> product.ContentBanner
+	225, // 195: product.ListContentBannersResponse.banners:type_name -> product.ContentBanner
+	264, // 196: product.Setting.updated_at:type_name -> google.protobuf.Timestamp
+	232, // 197: product.ListSettingsResponse.settings:type_name -> product.Setting
+	239, // 198: product.StorefrontConfig.branding:type_name -> product.StoreBranding
+	264, // 199: product.ProductQualityScore.computed_at:type_name -> google.protobuf.Timestamp
+	268, // 200: product.GetCatalogQualityReportRequest.max_score:type_name -> google.protobuf.Int32Value
+	263, // 201: product.CatalogQualityReport.issue_counts:type_name -> product.CatalogQualityReport.IssueCountsEntry
+	241, // 202: product.CatalogQualityReport.products:type_name -> product.ProductQualityScore
+	264, // 203: product.DiagnosticsResponse.collected_at:type_name -> google.protobuf.Timestamp
+	248, // 204: product.DiagnosticsResponse.db_pools:type_name -> product.DBPoolDiagnostics
+	249, // 205: product.DiagnosticsResponse.caches:type_name -> product.CacheDiagnostics
+	264, // 206: product.CacheScheduleRun.scheduled_for:type_name -> google.protobuf.Timestamp
+	264, // 207: product.CacheScheduleRun.started_at:type_name -> google.protobuf.Timestamp
+	264, // 208: product.CacheScheduleRun.finished_at:type_name -> google.protobuf.Timestamp
+	253, // 209: product.CacheSchedule.warm:type_name -> product.CacheWarmQuery
+	264, // 210: product.CacheSchedule.next_run_at:type_name -> google.protobuf.Timestamp
+	254, // 211: product.CacheSchedule.last_run:type_name -> product.CacheScheduleRun
+	255, // 212: product.ListCacheSchedulesResponse.schedules:type_name -> product.CacheSchedule
+	254, // 213: product.ListCacheScheduleRunsResponse.runs:type_name -> product.CacheScheduleRun
+	14,  // 214: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	15,  // 215: product.ProductService.GetProduct:input_type -> product.GetProductRequest
+	19,  // 216: product.ProductService.ListProducts:input_type -> product.ListProductsRequest
+	16,  // 217: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
+	17,  // 218: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
+	24,  // 219: product.ProductService.CreateBrand:input_type -> product.CreateBrandRequest
+	21,  // 220: product.ProductService.GetBrand:input_type -> product.GetBrandRequest
+	22,  // 221: product.ProductService.ListBrands:input_type -> product.ListBrandsRequest
+	28,  // 222: product.ProductService.CreateCategory:input_type -> product.CreateCategoryRequest
+	25,  // 223: product.ProductService.GetCategory:input_type -> product.GetCategoryRequest
+	26,  // 224: product.ProductService.ListCategories:input_type -> product.ListCategoriesRequest
+	29,  // 225: product.ProductService.MoveCategory:input_type -> product.MoveCategoryRequest
+	30,  // 226: product.ProductService.MergeCategories:input_type -> product.MergeCategoriesRequest
+	32,  // 227: product.ProductService.ReorderSiblings:input_type -> product.ReorderSiblingsRequest
+	35,  // 228: product.ProductService.CreateCategoryAttribute:input_type -> product.CreateCategoryAttributeRequest
+	36,  // 229: product.ProductService.UpdateCategoryAttribute:input_type -> product.UpdateCategoryAttributeRequest
+	37,  // 230: product.ProductService.ListCategoryAttributes:input_type -> product.ListCategoryAttributesRequest
+	39,  // 231: product.ProductService.DeleteCategoryAttribute:input_type -> product.DeleteCategoryAttributeRequest
+	41,  // 232: product.ProductService.GetCategoryFacets:input_type -> product.GetCategoryFacetsRequest
+	45,  // 233: product.ProductService.UploadImage:input_type -> product.UploadImageRequest
+	47,  // 234: product.ProductService.DeleteImage:input_type -> product.DeleteImageRequest
+	48,  // 235: product.ProductService.CreateMediaUpload:input_type -> product.CreateMediaUploadRequest
+	50,  // 236: product.ProductService.ConfirmMediaUpload:input_type -> product.ConfirmMediaUploadRequest
+	52,  // 237: product.ProductService.AddProductVideo:input_type -> product.AddProductVideoRequest
+	53,  // 238: product.ProductService.DeleteProductMedia:input_type -> product.DeleteProductMediaRequest
+	56,  // 239: product.ProductService.GenerateSKUPreview:input_type -> product.GenerateSKUPreviewRequest
+	60,  // 240: product.ProductService.CreateCollection:input_type -> product.CreateCollectionRequest
+	61,  // 241: product.ProductService.GetCollection:input_type -> product.GetCollectionRequest
+	65,  // 242: product.ProductService.ListCollections:input_type -> product.ListCollectionsRequest
+	62,  // 243: product.ProductService.UpdateCollection:input_type -> product.UpdateCollectionRequest
+	63,  // 244: product.ProductService.DeleteCollection:input_type -> product.DeleteCollectionRequest
+	67,  // 245: product.ProductService.SetCollectionProducts:input_type -> product.SetCollectionProductsRequest
+	68,  // 246: product.ProductService.ListCollectionProducts:input_type -> product.ListCollectionProductsRequest
+	72,  // 247: product.ProductService.CreateBundle:input_type -> product.CreateBundleRequest
+	74,  // 248: product.ProductService.UploadDigitalAsset:input_type -> product.UploadDigitalAssetRequest
+	75,  // 249: product.ProductService.CreateDownloadLink:input_type -> product.CreateDownloadLinkRequest
+	77,  // 250: product.ProductService.DownloadDigitalAsset:input_type -> product.DownloadDigitalAssetRequest
+	80,  // 251: product.ProductService.SetSubscriptionPlan:input_type -> product.SetSubscriptionPlanRequest
+	82,  // 252: product.ProductService.CreateSubscription:input_type -> product.CreateSubscriptionRequest
+	83,  // 253: product.ProductService.GetSubscription:input_type -> product.GetSubscriptionRequest
+	84,  // 254: product.ProductService.CancelSubscription:input_type -> product.CancelSubscriptionRequest
+	85,  // 255: product.ProductService.ListSubscriptions:input_type -> product.ListSubscriptionsRequest
+	88,  // 256: product.ProductService.ListSubscriptionEvents:input_type -> product.ListSubscriptionEventsRequest
+	90,  // 257: product.ProductService.AckSubscriptionEvents:input_type -> product.AckSubscriptionEventsRequest
+	94,  // 258: product.ProductService.SetPersonalizationOptions:input_type -> product.SetPersonalizationOptionsRequest
+	96,  // 259: product.ProductService.ValidatePersonalization:input_type -> product.ValidatePersonalizationRequest
+	99,  // 260: product.ProductService.SetProductChannels:input_type -> product.SetProductChannelsRequest
+	100, // 261: product.ProductService.GetProductChannels:input_type -> product.GetProductChannelsRequest
+	103, // 262: product.ProductService.CreateStore:input_type -> product.CreateStoreRequest
+	104, // 263: product.ProductService.GetStore:input_type -> product.GetStoreRequest
+	105, // 264: product.ProductService.ListStores:input_type -> product.ListStoresRequest
+	107, // 265: product.ProductService.UpdateStore:input_type -> product.UpdateStoreRequest
+	109, // 266: product.ProductService.ListProductFeeds:input_type -> product.ListProductFeedsRequest
+	111, // 267: product.ProductService.GenerateProductFeeds:input_type -> product.GenerateProductFeedsRequest
+	112, // 268: product.ProductService.DownloadProductFeed:input_type -> product.DownloadProductFeedRequest
+	115, // 269: product.ProductService.RunErpSync:input_type -> product.RunErpSyncRequest
+	116, // 270: product.ProductService.ListErpSyncRuns:input_type -> product.ListErpSyncRunsRequest
+	119, // 271: product.ProductService.BulkAdjustPrices:input_type -> product.BulkAdjustPricesRequest
+	124, // 272: product.ProductService.RunInventoryReconciliation:input_type -> product.RunInventoryReconciliationRequest
+	125, // 273: product.ProductService.GetInventoryReconciliation:input_type -> product.GetInventoryReconciliationRequest
+	126, // 274: product.ProductService.ListInventoryReconciliations:input_type -> product.ListInventoryReconciliationsRequest
+	128, // 275: product.ProductService.MergeProducts:input_type -> product.MergeProductsRequest
+	130, // 276: product.ProductService.SplitVariant:input_type -> product.SplitVariantRequest
+	133, // 277: product.ProductService.SaveImportTemplate:input_type -> product.SaveImportTemplateRequest
+	134, // 278: product.ProductService.GetImportTemplate:input_type -> product.GetImportTemplateRequest
+	135, // 279: product.ProductService.ListImportTemplates:input_type -> product.ListImportTemplatesRequest
+	137, // 280: product.ProductService.DeleteImportTemplate:input_type -> product.DeleteImportTemplateRequest
+	139, // 281: product.ProductService.ImportSupplierCatalog:input_type -> product.ImportSupplierCatalogRequest
+	143, // 282: product.ProductService.CreateProductNote:input_type -> product.CreateProductNoteRequest
+	144, // 283: product.ProductService.ListProductNotes:input_type -> product.ListProductNotesRequest
+	146, // 284: product.ProductService.UpdateProductNote:input_type -> product.UpdateProductNoteRequest
+	147, // 285: product.ProductService.DeleteProductNote:input_type -> product.DeleteProductNoteRequest
+	151, // 286: product.ProductService.CreateProductRelationship:input_type -> product.CreateProductRelationshipRequest
+	152, // 287: product.ProductService.ListProductRelationships:input_type -> product.ListProductRelationshipsRequest
+	154, // 288: product.ProductService.UpdateProductRelationship:input_type -> product.UpdateProductRelationshipRequest
+	155, // 289: product.ProductService.DeleteProductRelationship:input_type -> product.DeleteProductRelationshipRequest
+	159, // 290: product.ProductService.AskProductQuestion:input_type -> product.AskProductQuestionRequest
+	160, // 291: product.ProductService.GetProductQuestion:input_type -> product.GetProductQuestionRequest
+	161, // 292: product.ProductService.ListProductQuestions:input_type -> product.ListProductQuestionsRequest
+	163, // 293: product.ProductService.ModerateProductQuestion:input_type -> product.ModerateProductQuestionRequest
+	164, // 294: product.ProductService.DeleteProductQuestion:input_type -> product.DeleteProductQuestionRequest
+	166, // 295: product.ProductService.AnswerProductQuestion:input_type -> product.AnswerProductQuestionRequest
+	167, // 296: product.ProductService.ModerateProductAnswer:input_type -> product.ModerateProductAnswerRequest
+	168, // 297: product.ProductService.DeleteProductAnswer:input_type -> product.DeleteProductAnswerRequest
+	170, // 298: product.ProductService.UpvoteProductAnswer:input_type -> product.UpvoteProductAnswerRequest
+	172, // 299: product.ProductService.SaveSearch:input_type -> product.SaveSearchRequest
+	173, // 300: product.ProductService.ListSavedSearches:input_type -> product.ListSavedSearchesRequest
+	175, // 301: product.ProductService.DeleteSavedSearch:input_type -> product.DeleteSavedSearchRequest
+	178, // 302: product.ProductService.ListSavedSearchAlerts:input_type -> product.ListSavedSearchAlertsRequest
+	180, // 303: product.ProductService.AckSavedSearchAlerts:input_type -> product.AckSavedSearchAlertsRequest
+	190, // 304: product.ProductService.SearchProducts:input_type -> product.SearchProductsRequest
+	193, // 305: product.ProductService.PreviewSearchRanking:input_type -> product.PreviewSearchRankingRequest
+	183, // 306: product.ProductService.SaveSearchRankingRule:input_type -> product.SaveSearchRankingRuleRequest
+	184, // 307: product.ProductService.ListSearchRankingRules:input_type -> product.ListSearchRankingRulesRequest
+	186, // 308: product.ProductService.DeleteSearchRankingRule:input_type -> product.DeleteSearchRankingRuleRequest
+	188, // 309: product.ProductService.PublishSearchRankingRules:input_type -> product.PublishSearchRankingRulesRequest
+	198, // 310: product.ProductService.ListBadgeRules:input_type -> product.ListBadgeRulesRequest
+	200, // 311: product.ProductService.SaveBadgeRule:input_type -> product.SaveBadgeRuleRequest
+	201, // 312: product.ProductService.DeleteBadgeRule:input_type -> product.DeleteBadgeRuleRequest
+	203, // 313: product.ProductService.RecomputeBadges:input_type -> product.RecomputeBadgesRequest
+	206, // 314: product.ProductService.RecordProductSales:input_type -> product.RecordProductSalesRequest
+	209, // 315: product.ProductService.ListCatalogActivity:input_type -> product.ListCatalogActivityRequest
+	212, // 316: product.ProductService.SetTranslation:input_type -> product.SetTranslationRequest
+	213, // 317: product.ProductService.ListTranslations:input_type -> product.ListTranslationsRequest
+	215, // 318: product.ProductService.DeleteTranslation:input_type -> product.DeleteTranslationRequest
+	218, // 319: product.ProductService.CreateContentPage:input_type -> product.CreateContentPageRequest
+	219, // 320: product.ProductService.UpdateContentPage:input_type -> product.UpdateContentPageRequest
+	220, // 321: product.ProductService.GetContentPage:input_type -> product.GetContentPageRequest
+	221, // 322: product.ProductService.ListContentPages:input_type -> product.ListContentPagesRequest
+	223, // 323: product.ProductService.DeleteContentPage:input_type -> product.DeleteContentPageRequest
+	226, // 324: product.ProductService.CreateContentBanner:input_type -> product.CreateContentBannerRequest
+	227, // 325: product.ProductService.UpdateContentBanner:input_type -> product.UpdateContentBannerRequest
+	228, // 326: product.ProductService.ListContentBanners:input_type -> product.ListContentBannersRequest
+	230, // 327: product.ProductService.DeleteContentBanner:input_type -> product.DeleteContentBannerRequest
+	233, // 328: product.ProductService.ListSettings:input_type -> product.ListSettingsRequest
+	235, // 329: product.ProductService.SetSetting:input_type -> product.SetSettingRequest
+	236, // 330: product.ProductService.DeleteSetting:input_type -> product.DeleteSettingRequest
+	238, // 331: product.ProductService.GetStorefrontConfig:input_type -> product.GetStorefrontConfigRequest
+	242, // 332: product.ProductService.GetCatalogQualityReport:input_type -> product.GetCatalogQualityReportRequest
+	244, // 333: product.ProductService.GetProductQualityScore:input_type -> product.GetProductQualityScoreRequest
+	245, // 334: product.ProductService.RecomputeCatalogQuality:input_type -> product.RecomputeCatalogQualityRequest
+	247, // 335: product.ProductService.GetDiagnostics:input_type -> product.GetDiagnosticsRequest
+	251, // 336: product.ProductService.FlushCacheNamespace:input_type -> product.FlushCacheNamespaceRequest
+	256, // 337: product.ProductService.ListCacheSchedules:input_type -> product.ListCacheSchedulesRequest
+	258, // 338: product.ProductService.ListCacheScheduleRuns:input_type -> product.ListCacheScheduleRunsRequest
+	260, // 339: product.ProductService.RunCacheSchedule:input_type -> product.RunCacheScheduleRequest
+	9,   // 340: product.ProductService.CreateProduct:output_type -> product.Product
+	9,   // 341: product.ProductService.GetProduct:output_type -> product.Product
+	20,  // 342: product.ProductService.ListProducts:output_type -> product.ListProductsResponse
+	9,   // 343: product.ProductService.UpdateProduct:output_type -> product.Product
+	18,  // 344: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
+	12,  // 345: product.ProductService.CreateBrand:output_type -> product.Brand
+	12,  // 346: product.ProductService.GetBrand:output_type -> product.Brand
+	23,  // 347: product.ProductService.ListBrands:output_type -> product.ListBrandsResponse
+	13,  // 348: product.ProductService.CreateCategory:output_type -> product.Category
+	13,  // 349: product.ProductService.GetCategory:output_type -> product.Category
+	27,  // 350: product.ProductService.ListCategories:output_type -> product.ListCategoriesResponse
+	13,  // 351: product.ProductService.MoveCategory:output_type -> product.Category
+	31,  // 352: product.ProductService.MergeCategories:output_type -> product.MergeCategoriesResponse
+	33,  // 353: product.ProductService.ReorderSiblings:output_type -> product.ReorderSiblingsResponse
+	34,  // 354: product.ProductService.CreateCategoryAttribute:output_type -> product.CategoryAttribute
+	34,  // 355: product.ProductService.UpdateCategoryAttribute:output_type -> product.CategoryAttribute
+	38,  // 356: product.ProductService.ListCategoryAttributes:output_type -> product.ListCategoryAttributesResponse
+	40,  // 357: product.ProductService.DeleteCategoryAttribute:output_type -> product.DeleteCategoryAttributeResponse
+	44,  // 358: product.ProductService.GetCategoryFacets:output_type -> product.GetCategoryFacetsResponse
+	46,  // 359: product.ProductService.UploadImage:output_type -> product.UploadImageResponse
+	55,  // 360: product.ProductService.DeleteImage:output_type -> product.DeleteImageResponse
+	49,  // 361: product.ProductService.CreateMediaUpload:output_type -> product.MediaUpload
+	51,  // 362: product.ProductService.ConfirmMediaUpload:output_type -> product.ConfirmMediaUploadResponse
+	11,  // 363: product.ProductService.AddProductVideo:output_type -> product.ProductMedia
+	54,  // 364: product.ProductService.DeleteProductMedia:output_type -> product.DeleteProductMediaResponse
+	57,  // 365: product.ProductService.GenerateSKUPreview:output_type -> product.GenerateSKUPreviewResponse
+	59,  // 366: product.ProductService.CreateCollection:output_type -> product.Collection
+	59,  // 367: product.ProductService.GetCollection:output_type -> product.Collection
+	66,  // 368: product.ProductService.ListCollections:output_type -> product.ListCollectionsResponse
+	59,  // 369: product.ProductService.UpdateCollection:output_type -> product.Collection
+	64,  // 370: product.ProductService.DeleteCollection:output_type -> product.DeleteCollectionResponse
+	59,  // 371: product.ProductService.SetCollectionProducts:output_type -> product.Collection
+	69,  // 372: product.ProductService.ListCollectionProducts:output_type -> product.ListCollectionProductsResponse
+	9,   // 373: product.ProductService.CreateBundle:output_type -> product.Product
+	73,  // 374: product.ProductService.UploadDigitalAsset:output_type -> product.DigitalAsset
+	76,  // 375: product.ProductService.CreateDownloadLink:output_type -> product.DownloadLink
+	78,  // 376: product.ProductService.DownloadDigitalAsset:output_type -> product.DigitalAssetChunk
+	79,  // 377: product.ProductService.SetSubscriptionPlan:output_type -> product.SubscriptionPlan
+	81,  // 378: product.ProductService.CreateSubscription:output_type -> product.Subscription
+	81,  // 379: product.ProductService.GetSubscription:output_type -> product.Subscription
+	81,  // 380: product.ProductService.CancelSubscription:output_type -> product.Subscription
+	86,  // 381: product.ProductService.ListSubscriptions:output_type -> product.ListSubscriptionsResponse
+	89,  // 382: product.ProductService.ListSubscriptionEvents:output_type -> product.ListSubscriptionEventsResponse
+	91,  // 383: product.ProductService.AckSubscriptionEvents:output_type -> product.AckSubscriptionEventsResponse
+	95,  // 384: product.ProductService.SetPersonalizationOptions:output_type -> product.PersonalizationOptionsResponse
+	97,  // 385: product.ProductService.ValidatePersonalization:output_type -> product.ValidatePersonalizationResponse
+	101, // 386: product.ProductService.SetProductChannels:output_type -> product.ProductChannelsResponse
+	101, // 387: product.ProductService.GetProductChannels:output_type -> product.ProductChannelsResponse
+	102, // 388: product.ProductService.CreateStore:output_type -> product.Store
+	102, // 389: product.ProductService.GetStore:output_type -> product.Store
+	106, // 390: product.ProductService.ListStores:output_type -> product.ListStoresResponse
+	102, // 391: product.ProductService.UpdateStore:output_type -> product.Store
+	110, // 392: product.ProductService.ListProductFeeds:output_type -> product.ListProductFeedsResponse
+	110, // 393: product.ProductService.GenerateProductFeeds:output_type -> product.ListProductFeedsResponse
+	113, // 394: product.ProductService.DownloadProductFeed:output_type -> product.ProductFeedChunk
+	117, // 395: product.ProductService.RunErpSync:output_type -> product.ListErpSyncRunsResponse
+	117, // 396: product.ProductService.ListErpSyncRuns:output_type -> product.ListErpSyncRunsResponse
+	121, // 397: product.ProductService.BulkAdjustPrices:output_type -> product.BulkAdjustPricesResponse
+	123, // 398: product.ProductService.RunInventoryReconciliation:output_type -> product.InventoryReconciliation
+	123, // 399: product.ProductService.GetInventoryReconciliation:output_type -> product.InventoryReconciliation
+	127, // 400: product.ProductService.ListInventoryReconciliations:output_type -> product.ListInventoryReconciliationsResponse
+	129, // 401: product.ProductService.MergeProducts:output_type -> product.MergeProductsResponse
+	131, // 402: product.ProductService.SplitVariant:output_type -> product.SplitVariantResponse
+	132, // 403: product.ProductService.SaveImportTemplate:output_type -> product.ImportTemplate
+	132, // 404: product.ProductService.GetImportTemplate:output_type -> product.ImportTemplate
+	136, // 405: product.ProductService.ListImportTemplates:output_type -> product.ListImportTemplatesResponse
+	138, // 406: product.ProductService.DeleteImportTemplate:output_type -> product.DeleteImportTemplateResponse
+	141, // 407: product.ProductService.ImportSupplierCatalog:output_type -> product.ImportSupplierCatalogResponse
+	142, // 408: product.ProductService.CreateProductNote:output_type -> product.ProductNote
+	145, // 409: product.ProductService.ListProductNotes:output_type -> product.ListProductNotesResponse
+	142, // 410: product.ProductService.UpdateProductNote:output_type -> product.ProductNote
+	148, // 411: product.ProductService.DeleteProductNote:output_type -> product.DeleteProductNoteResponse
+	149, // 412: product.ProductService.CreateProductRelationship:output_type -> product.ProductRelationship
+	153, // 413: product.ProductService.ListProductRelationships:output_type -> product.ListProductRelationshipsResponse
+	149, // 414: product.ProductService.UpdateProductRelationship:output_type -> product.ProductRelationship
+	156, // 415: product.ProductService.DeleteProductRelationship:output_type -> product.DeleteProductRelationshipResponse
+	158, // 416: product.ProductService.AskProductQuestion:output_type -> product.ProductQuestion
+	158, // 417: product.ProductService.GetProductQuestion:output_type -> product.ProductQuestion
+	162, // 418: product.ProductService.ListProductQuestions:output_type -> product.ListProductQuestionsResponse
+	158, // 419: product.ProductService.ModerateProductQuestion:output_type -> product.ProductQuestion
+	165, // 420: product.ProductService.DeleteProductQuestion:output_type -> product.DeleteProductQuestionResponse
+	157, // 421: product.ProductService.AnswerProductQuestion:output_type -> product.ProductAnswer
+	157, // 422: product.ProductService.ModerateProductAnswer:output_type -> product.ProductAnswer
+	169, // 423: product.ProductService.DeleteProductAnswer:output_type -> product.DeleteProductAnswerResponse
+	157, // 424: product.ProductService.UpvoteProductAnswer:output_type -> product.ProductAnswer
+	171, // 425: product.ProductService.SaveSearch:output_type -> product.SavedSearch
+	174, // 426: product.ProductService.ListSavedSearches:output_type -> product.ListSavedSearchesResponse
+	176, // 427: product.ProductService.DeleteSavedSearch:output_type -> product.DeleteSavedSearchResponse
+	179, // 428: product.ProductService.ListSavedSearchAlerts:output_type -> product.ListSavedSearchAlertsResponse
+	181, // 429: product.ProductService.AckSavedSearchAlerts:output_type -> product.AckSavedSearchAlertsResponse
+	192, // 430: product.ProductService.SearchProducts:output_type -> product.SearchProductsResponse
+	195, // 431: product.ProductService.PreviewSearchRanking:output_type -> product.PreviewSearchRankingResponse
+	182, // 432: product.ProductService.SaveSearchRankingRule:output_type -> product.SearchRankingRule
+	185, // 433: product.ProductService.ListSearchRankingRules:output_type -> product.ListSearchRankingRulesResponse
+	187, // 434: product.ProductService.DeleteSearchRankingRule:output_type -> product.DeleteSearchRankingRuleResponse
+	189, // 435: product.ProductService.PublishSearchRankingRules:output_type -> product.PublishSearchRankingRulesResponse
+	199, // 436: product.ProductService.ListBadgeRules:output_type -> product.ListBadgeRulesResponse
+	197, // 437: product.ProductService.SaveBadgeRule:output_type -> product.BadgeRule
+	202, // 438: product.ProductService.DeleteBadgeRule:output_type -> product.DeleteBadgeRuleResponse
+	204, // 439: product.ProductService.RecomputeBadges:output_type -> product.RecomputeBadgesResponse
+	207, // 440: product.ProductService.RecordProductSales:output_type -> product.RecordProductSalesResponse
+	210, // 441: product.ProductService.ListCatalogActivity:output_type -> product.ListCatalogActivityResponse
+	211, // 442: product.ProductService.SetTranslation:output_type -> product.Translation
+	214, // 443: product.ProductService.ListTranslations:output_type -> product.ListTranslationsResponse
+	216, // 444: product.ProductService.DeleteTranslation:output_type -> product.DeleteTranslationResponse
+	217, // 445: product.ProductService.CreateContentPage:output_type -> product.ContentPage
+	217, // 446: product.ProductService.UpdateContentPage:output_type -> product.ContentPage
+	217, // 447: product.ProductService.GetContentPage:output_type -> product.ContentPage
+	222, // 448: product.ProductService.ListContentPages:output_type -> product.ListContentPagesResponse
+	224, // 449: product.ProductService.DeleteContentPage:output_type -> product.DeleteContentPageResponse
+	225, // 450: product.ProductService.CreateContentBanner:output_type -> product.ContentBanner
+	225, // 451: product.ProductService.UpdateContentBanner:output_type -> product.ContentBanner
+	229, // 452: product.ProductService.ListContentBanners:output_type -> product.ListContentBannersResponse
+	231, // 453: product.ProductService.DeleteContentBanner:output_type -> product.DeleteContentBannerResponse
+	234, // 454: product.ProductService.ListSettings:output_type -> product.ListSettingsResponse
+	232, // 455: product.ProductService.SetSetting:output_type -> product.Setting
+	237, // 456: product.ProductService.DeleteSetting:output_type -> product.DeleteSettingResponse
+	240, // 457: product.ProductService.GetStorefrontConfig:output_type -> product.StorefrontConfig
+	243, // 458: product.ProductService.GetCatalogQualityReport:output_type -> product.CatalogQualityReport
+	241, // 459: product.ProductService.GetProductQualityScore:output_type -> product.ProductQualityScore
+	246, // 460: product.ProductService.RecomputeCatalogQuality:output_type -> product.RecomputeCatalogQualityResponse
+	250, // 461: product.ProductService.GetDiagnostics:output_type -> product.DiagnosticsResponse
+	252, // 462: product.ProductService.FlushCacheNamespace:output_type -> product.FlushCacheNamespaceResponse
+	257, // 463: product.ProductService.ListCacheSchedules:output_type -> product.ListCacheSchedulesResponse
+	259, // 464: product.ProductService.ListCacheScheduleRuns:output_type -> product.ListCacheScheduleRunsResponse
+	254, // 465: product.ProductService.RunCacheSchedule:output_type -> product.CacheScheduleRun
+	340, // [340:466] is the sub-list for method output_type
+	214, // [214:340] is the sub-list for method input_type
+	214, // [214:214] is the sub-list for extension type_name
+	214, // [214:214] is the sub-list for extension extendee
+	0,   // [0:214] is the sub-list for field type_name
 }
 
 func init() { file_proto_product_proto_init() }
@@ -3016,25 +19243,37 @@ func file_proto_product_proto_init() {
 	if File_proto_product_proto != nil {
 		return
 	}
-	file_proto_product_proto_msgTypes[14].OneofWrappers = []any{
+	file_proto_product_proto_msgTypes[15].OneofWrappers = []any{
 		(*GetProductRequest_Id)(nil),
 		(*GetProductRequest_Slug)(nil),
 	}
-	file_proto_product_proto_msgTypes[20].OneofWrappers = []any{
+	file_proto_product_proto_msgTypes[21].OneofWrappers = []any{
 		(*GetBrandRequest_Id)(nil),
 		(*GetBrandRequest_Slug)(nil),
 	}
-	file_proto_product_proto_msgTypes[24].OneofWrappers = []any{
+	file_proto_product_proto_msgTypes[25].OneofWrappers = []any{
 		(*GetCategoryRequest_Id)(nil),
 		(*GetCategoryRequest_Slug)(nil),
 	}
+	file_proto_product_proto_msgTypes[61].OneofWrappers = []any{
+		(*GetCollectionRequest_Id)(nil),
+		(*GetCollectionRequest_Slug)(nil),
+	}
+	file_proto_product_proto_msgTypes[68].OneofWrappers = []any{
+		(*ListCollectionProductsRequest_Id)(nil),
+		(*ListCollectionProductsRequest_Slug)(nil),
+	}
+	file_proto_product_proto_msgTypes[220].OneofWrappers = []any{
+		(*GetContentPageRequest_Id)(nil),
+		(*GetContentPageRequest_Slug)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_product_proto_rawDesc), len(file_proto_product_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   264,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,20 +19,132 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_CreateProduct_FullMethodName      = "/product.ProductService/CreateProduct"
-	ProductService_GetProduct_FullMethodName         = "/product.ProductService/GetProduct"
-	ProductService_ListProducts_FullMethodName       = "/product.ProductService/ListProducts"
-	ProductService_UpdateProduct_FullMethodName      = "/product.ProductService/UpdateProduct"
-	ProductService_DeleteProduct_FullMethodName      = "/product.ProductService/DeleteProduct"
-	ProductService_CreateBrand_FullMethodName        = "/product.ProductService/CreateBrand"
-	ProductService_GetBrand_FullMethodName           = "/product.ProductService/GetBrand"
-	ProductService_ListBrands_FullMethodName         = "/product.ProductService/ListBrands"
-	ProductService_CreateCategory_FullMethodName     = "/product.ProductService/CreateCategory"
-	ProductService_GetCategory_FullMethodName        = "/product.ProductService/GetCategory"
-	ProductService_ListCategories_FullMethodName     = "/product.ProductService/ListCategories"
-	ProductService_UploadImage_FullMethodName        = "/product.ProductService/UploadImage"
-	ProductService_DeleteImage_FullMethodName        = "/product.ProductService/DeleteImage"
-	ProductService_GenerateSKUPreview_FullMethodName = "/product.ProductService/GenerateSKUPreview"
+	ProductService_CreateProduct_FullMethodName                = "/product.ProductService/CreateProduct"
+	ProductService_GetProduct_FullMethodName                   = "/product.ProductService/GetProduct"
+	ProductService_ListProducts_FullMethodName                 = "/product.ProductService/ListProducts"
+	ProductService_UpdateProduct_FullMethodName                = "/product.ProductService/UpdateProduct"
+	ProductService_DeleteProduct_FullMethodName                = "/product.ProductService/DeleteProduct"
+	ProductService_CreateBrand_FullMethodName                  = "/product.ProductService/CreateBrand"
+	ProductService_GetBrand_FullMethodName                     = "/product.ProductService/GetBrand"
+	ProductService_ListBrands_FullMethodName                   = "/product.ProductService/ListBrands"
+	ProductService_CreateCategory_FullMethodName               = "/product.ProductService/CreateCategory"
+	ProductService_GetCategory_FullMethodName                  = "/product.ProductService/GetCategory"
+	ProductService_ListCategories_FullMethodName               = "/product.ProductService/ListCategories"
+	ProductService_MoveCategory_FullMethodName                 = "/product.ProductService/MoveCategory"
+	ProductService_MergeCategories_FullMethodName              = "/product.ProductService/MergeCategories"
+	ProductService_ReorderSiblings_FullMethodName              = "/product.ProductService/ReorderSiblings"
+	ProductService_CreateCategoryAttribute_FullMethodName      = "/product.ProductService/CreateCategoryAttribute"
+	ProductService_UpdateCategoryAttribute_FullMethodName      = "/product.ProductService/UpdateCategoryAttribute"
+	ProductService_ListCategoryAttributes_FullMethodName       = "/product.ProductService/ListCategoryAttributes"
+	ProductService_DeleteCategoryAttribute_FullMethodName      = "/product.ProductService/DeleteCategoryAttribute"
+	ProductService_GetCategoryFacets_FullMethodName            = "/product.ProductService/GetCategoryFacets"
+	ProductService_UploadImage_FullMethodName                  = "/product.ProductService/UploadImage"
+	ProductService_DeleteImage_FullMethodName                  = "/product.ProductService/DeleteImage"
+	ProductService_CreateMediaUpload_FullMethodName            = "/product.ProductService/CreateMediaUpload"
+	ProductService_ConfirmMediaUpload_FullMethodName           = "/product.ProductService/ConfirmMediaUpload"
+	ProductService_AddProductVideo_FullMethodName              = "/product.ProductService/AddProductVideo"
+	ProductService_DeleteProductMedia_FullMethodName           = "/product.ProductService/DeleteProductMedia"
+	ProductService_GenerateSKUPreview_FullMethodName           = "/product.ProductService/GenerateSKUPreview"
+	ProductService_CreateCollection_FullMethodName             = "/product.ProductService/CreateCollection"
+	ProductService_GetCollection_FullMethodName                = "/product.ProductService/GetCollection"
+	ProductService_ListCollections_FullMethodName              = "/product.ProductService/ListCollections"
+	ProductService_UpdateCollection_FullMethodName             = "/product.ProductService/UpdateCollection"
+	ProductService_DeleteCollection_FullMethodName             = "/product.ProductService/DeleteCollection"
+	ProductService_SetCollectionProducts_FullMethodName        = "/product.ProductService/SetCollectionProducts"
+	ProductService_ListCollectionProducts_FullMethodName       = "/product.ProductService/ListCollectionProducts"
+	ProductService_CreateBundle_FullMethodName                 = "/product.ProductService/CreateBundle"
+	ProductService_UploadDigitalAsset_FullMethodName           = "/product.ProductService/UploadDigitalAsset"
+	ProductService_CreateDownloadLink_FullMethodName           = "/product.ProductService/CreateDownloadLink"
+	ProductService_DownloadDigitalAsset_FullMethodName         = "/product.ProductService/DownloadDigitalAsset"
+	ProductService_SetSubscriptionPlan_FullMethodName          = "/product.ProductService/SetSubscriptionPlan"
+	ProductService_CreateSubscription_FullMethodName           = "/product.ProductService/CreateSubscription"
+	ProductService_GetSubscription_FullMethodName              = "/product.ProductService/GetSubscription"
+	ProductService_CancelSubscription_FullMethodName           = "/product.ProductService/CancelSubscription"
+	ProductService_ListSubscriptions_FullMethodName            = "/product.ProductService/ListSubscriptions"
+	ProductService_ListSubscriptionEvents_FullMethodName       = "/product.ProductService/ListSubscriptionEvents"
+	ProductService_AckSubscriptionEvents_FullMethodName        = "/product.ProductService/AckSubscriptionEvents"
+	ProductService_SetPersonalizationOptions_FullMethodName    = "/product.ProductService/SetPersonalizationOptions"
+	ProductService_ValidatePersonalization_FullMethodName      = "/product.ProductService/ValidatePersonalization"
+	ProductService_SetProductChannels_FullMethodName           = "/product.ProductService/SetProductChannels"
+	ProductService_GetProductChannels_FullMethodName           = "/product.ProductService/GetProductChannels"
+	ProductService_CreateStore_FullMethodName                  = "/product.ProductService/CreateStore"
+	ProductService_GetStore_FullMethodName                     = "/product.ProductService/GetStore"
+	ProductService_ListStores_FullMethodName                   = "/product.ProductService/ListStores"
+	ProductService_UpdateStore_FullMethodName                  = "/product.ProductService/UpdateStore"
+	ProductService_ListProductFeeds_FullMethodName             = "/product.ProductService/ListProductFeeds"
+	ProductService_GenerateProductFeeds_FullMethodName         = "/product.ProductService/GenerateProductFeeds"
+	ProductService_DownloadProductFeed_FullMethodName          = "/product.ProductService/DownloadProductFeed"
+	ProductService_RunErpSync_FullMethodName                   = "/product.ProductService/RunErpSync"
+	ProductService_ListErpSyncRuns_FullMethodName              = "/product.ProductService/ListErpSyncRuns"
+	ProductService_BulkAdjustPrices_FullMethodName             = "/product.ProductService/BulkAdjustPrices"
+	ProductService_RunInventoryReconciliation_FullMethodName   = "/product.ProductService/RunInventoryReconciliation"
+	ProductService_GetInventoryReconciliation_FullMethodName   = "/product.ProductService/GetInventoryReconciliation"
+	ProductService_ListInventoryReconciliations_FullMethodName = "/product.ProductService/ListInventoryReconciliations"
+	ProductService_MergeProducts_FullMethodName                = "/product.ProductService/MergeProducts"
+	ProductService_SplitVariant_FullMethodName                 = "/product.ProductService/SplitVariant"
+	ProductService_SaveImportTemplate_FullMethodName           = "/product.ProductService/SaveImportTemplate"
+	ProductService_GetImportTemplate_FullMethodName            = "/product.ProductService/GetImportTemplate"
+	ProductService_ListImportTemplates_FullMethodName          = "/product.ProductService/ListImportTemplates"
+	ProductService_DeleteImportTemplate_FullMethodName         = "/product.ProductService/DeleteImportTemplate"
+	ProductService_ImportSupplierCatalog_FullMethodName        = "/product.ProductService/ImportSupplierCatalog"
+	ProductService_CreateProductNote_FullMethodName            = "/product.ProductService/CreateProductNote"
+	ProductService_ListProductNotes_FullMethodName             = "/product.ProductService/ListProductNotes"
+	ProductService_UpdateProductNote_FullMethodName            = "/product.ProductService/UpdateProductNote"
+	ProductService_DeleteProductNote_FullMethodName            = "/product.ProductService/DeleteProductNote"
+	ProductService_CreateProductRelationship_FullMethodName    = "/product.ProductService/CreateProductRelationship"
+	ProductService_ListProductRelationships_FullMethodName     = "/product.ProductService/ListProductRelationships"
+	ProductService_UpdateProductRelationship_FullMethodName    = "/product.ProductService/UpdateProductRelationship"
+	ProductService_DeleteProductRelationship_FullMethodName    = "/product.ProductService/DeleteProductRelationship"
+	ProductService_AskProductQuestion_FullMethodName           = "/product.ProductService/AskProductQuestion"
+	ProductService_GetProductQuestion_FullMethodName           = "/product.ProductService/GetProductQuestion"
+	ProductService_ListProductQuestions_FullMethodName         = "/product.ProductService/ListProductQuestions"
+	ProductService_ModerateProductQuestion_FullMethodName      = "/product.ProductService/ModerateProductQuestion"
+	ProductService_DeleteProductQuestion_FullMethodName        = "/product.ProductService/DeleteProductQuestion"
+	ProductService_AnswerProductQuestion_FullMethodName        = "/product.ProductService/AnswerProductQuestion"
+	ProductService_ModerateProductAnswer_FullMethodName        = "/product.ProductService/ModerateProductAnswer"
+	ProductService_DeleteProductAnswer_FullMethodName          = "/product.ProductService/DeleteProductAnswer"
+	ProductService_UpvoteProductAnswer_FullMethodName          = "/product.ProductService/UpvoteProductAnswer"
+	ProductService_SaveSearch_FullMethodName                   = "/product.ProductService/SaveSearch"
+	ProductService_ListSavedSearches_FullMethodName            = "/product.ProductService/ListSavedSearches"
+	ProductService_DeleteSavedSearch_FullMethodName            = "/product.ProductService/DeleteSavedSearch"
+	ProductService_ListSavedSearchAlerts_FullMethodName        = "/product.ProductService/ListSavedSearchAlerts"
+	ProductService_AckSavedSearchAlerts_FullMethodName         = "/product.ProductService/AckSavedSearchAlerts"
+	ProductService_SearchProducts_FullMethodName               = "/product.ProductService/SearchProducts"
+	ProductService_PreviewSearchRanking_FullMethodName         = "/product.ProductService/PreviewSearchRanking"
+	ProductService_SaveSearchRankingRule_FullMethodName        = "/product.ProductService/SaveSearchRankingRule"
+	ProductService_ListSearchRankingRules_FullMethodName       = "/product.ProductService/ListSearchRankingRules"
+	ProductService_DeleteSearchRankingRule_FullMethodName      = "/product.ProductService/DeleteSearchRankingRule"
+	ProductService_PublishSearchRankingRules_FullMethodName    = "/product.ProductService/PublishSearchRankingRules"
+	ProductService_ListBadgeRules_FullMethodName               = "/product.ProductService/ListBadgeRules"
+	ProductService_SaveBadgeRule_FullMethodName                = "/product.ProductService/SaveBadgeRule"
+	ProductService_DeleteBadgeRule_FullMethodName              = "/product.ProductService/DeleteBadgeRule"
+	ProductService_RecomputeBadges_FullMethodName              = "/product.ProductService/RecomputeBadges"
+	ProductService_RecordProductSales_FullMethodName           = "/product.ProductService/RecordProductSales"
+	ProductService_ListCatalogActivity_FullMethodName          = "/product.ProductService/ListCatalogActivity"
+	ProductService_SetTranslation_FullMethodName               = "/product.ProductService/SetTranslation"
+	ProductService_ListTranslations_FullMethodName             = "/product.ProductService/ListTranslations"
+	ProductService_DeleteTranslation_FullMethodName            = "/product.ProductService/DeleteTranslation"
+	ProductService_CreateContentPage_FullMethodName            = "/product.ProductService/CreateContentPage"
+	ProductService_UpdateContentPage_FullMethodName            = "/product.ProductService/UpdateContentPage"
+	ProductService_GetContentPage_FullMethodName               = "/product.ProductService/GetContentPage"
+	ProductService_ListContentPages_FullMethodName             = "/product.ProductService/ListContentPages"
+	ProductService_DeleteContentPage_FullMethodName            = "/product.ProductService/DeleteContentPage"
+	ProductService_CreateContentBanner_FullMethodName          = "/product.ProductService/CreateContentBanner"
+	ProductService_UpdateContentBanner_FullMethodName          = "/product.ProductService/UpdateContentBanner"
+	ProductService_ListContentBanners_FullMethodName           = "/product.ProductService/ListContentBanners"
+	ProductService_DeleteContentBanner_FullMethodName          = "/product.ProductService/DeleteContentBanner"
+	ProductService_ListSettings_FullMethodName                 = "/product.ProductService/ListSettings"
+	ProductService_SetSetting_FullMethodName                   = "/product.ProductService/SetSetting"
+	ProductService_DeleteSetting_FullMethodName                = "/product.ProductService/DeleteSetting"
+	ProductService_GetStorefrontConfig_FullMethodName          = "/product.ProductService/GetStorefrontConfig"
+	ProductService_GetCatalogQualityReport_FullMethodName      = "/product.ProductService/GetCatalogQualityReport"
+	ProductService_GetProductQualityScore_FullMethodName       = "/product.ProductService/GetProductQualityScore"
+	ProductService_RecomputeCatalogQuality_FullMethodName      = "/product.ProductService/RecomputeCatalogQuality"
+	ProductService_GetDiagnostics_FullMethodName               = "/product.ProductService/GetDiagnostics"
+	ProductService_FlushCacheNamespace_FullMethodName          = "/product.ProductService/FlushCacheNamespace"
+	ProductService_ListCacheSchedules_FullMethodName           = "/product.ProductService/ListCacheSchedules"
+	ProductService_ListCacheScheduleRuns_FullMethodName        = "/product.ProductService/ListCacheScheduleRuns"
+	ProductService_RunCacheSchedule_FullMethodName             = "/product.ProductService/RunCacheSchedule"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -52,11 +164,160 @@ type ProductServiceClient interface {
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*Category, error)
 	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*Category, error)
 	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// Category tree methods
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*Category, error)
+	MergeCategories(ctx context.Context, in *MergeCategoriesRequest, opts ...grpc.CallOption) (*MergeCategoriesResponse, error)
+	ReorderSiblings(ctx context.Context, in *ReorderSiblingsRequest, opts ...grpc.CallOption) (*ReorderSiblingsResponse, error)
+	// Category attribute methods; products created in a category must give
+	// the required attributes of the category and its ancestors
+	CreateCategoryAttribute(ctx context.Context, in *CreateCategoryAttributeRequest, opts ...grpc.CallOption) (*CategoryAttribute, error)
+	UpdateCategoryAttribute(ctx context.Context, in *UpdateCategoryAttributeRequest, opts ...grpc.CallOption) (*CategoryAttribute, error)
+	ListCategoryAttributes(ctx context.Context, in *ListCategoryAttributesRequest, opts ...grpc.CallOption) (*ListCategoryAttributesResponse, error)
+	DeleteCategoryAttribute(ctx context.Context, in *DeleteCategoryAttributeRequest, opts ...grpc.CallOption) (*DeleteCategoryAttributeResponse, error)
+	GetCategoryFacets(ctx context.Context, in *GetCategoryFacetsRequest, opts ...grpc.CallOption) (*GetCategoryFacetsResponse, error)
 	// Image upload methods
 	UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error)
 	DeleteImage(ctx context.Context, in *DeleteImageRequest, opts ...grpc.CallOption) (*DeleteImageResponse, error)
+	CreateMediaUpload(ctx context.Context, in *CreateMediaUploadRequest, opts ...grpc.CallOption) (*MediaUpload, error)
+	ConfirmMediaUpload(ctx context.Context, in *ConfirmMediaUploadRequest, opts ...grpc.CallOption) (*ConfirmMediaUploadResponse, error)
+	AddProductVideo(ctx context.Context, in *AddProductVideoRequest, opts ...grpc.CallOption) (*ProductMedia, error)
+	DeleteProductMedia(ctx context.Context, in *DeleteProductMediaRequest, opts ...grpc.CallOption) (*DeleteProductMediaResponse, error)
 	// SKU generation methods
 	GenerateSKUPreview(ctx context.Context, in *GenerateSKUPreviewRequest, opts ...grpc.CallOption) (*GenerateSKUPreviewResponse, error)
+	// Collection methods
+	CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error)
+	DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error)
+	SetCollectionProducts(ctx context.Context, in *SetCollectionProductsRequest, opts ...grpc.CallOption) (*Collection, error)
+	ListCollectionProducts(ctx context.Context, in *ListCollectionProductsRequest, opts ...grpc.CallOption) (*ListCollectionProductsResponse, error)
+	// Bundle methods
+	CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*Product, error)
+	// Digital product methods
+	UploadDigitalAsset(ctx context.Context, in *UploadDigitalAssetRequest, opts ...grpc.CallOption) (*DigitalAsset, error)
+	CreateDownloadLink(ctx context.Context, in *CreateDownloadLinkRequest, opts ...grpc.CallOption) (*DownloadLink, error)
+	DownloadDigitalAsset(ctx context.Context, in *DownloadDigitalAssetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DigitalAssetChunk], error)
+	// Subscription methods
+	SetSubscriptionPlan(ctx context.Context, in *SetSubscriptionPlanRequest, opts ...grpc.CallOption) (*SubscriptionPlan, error)
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	// Billing events polled by the payment service
+	ListSubscriptionEvents(ctx context.Context, in *ListSubscriptionEventsRequest, opts ...grpc.CallOption) (*ListSubscriptionEventsResponse, error)
+	AckSubscriptionEvents(ctx context.Context, in *AckSubscriptionEventsRequest, opts ...grpc.CallOption) (*AckSubscriptionEventsResponse, error)
+	// Personalization methods; values are validated before they are added
+	// to a cart or an order
+	SetPersonalizationOptions(ctx context.Context, in *SetPersonalizationOptionsRequest, opts ...grpc.CallOption) (*PersonalizationOptionsResponse, error)
+	ValidatePersonalization(ctx context.Context, in *ValidatePersonalizationRequest, opts ...grpc.CallOption) (*ValidatePersonalizationResponse, error)
+	// Channel visibility methods
+	SetProductChannels(ctx context.Context, in *SetProductChannelsRequest, opts ...grpc.CallOption) (*ProductChannelsResponse, error)
+	GetProductChannels(ctx context.Context, in *GetProductChannelsRequest, opts ...grpc.CallOption) (*ProductChannelsResponse, error)
+	// Store methods
+	CreateStore(ctx context.Context, in *CreateStoreRequest, opts ...grpc.CallOption) (*Store, error)
+	GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*Store, error)
+	ListStores(ctx context.Context, in *ListStoresRequest, opts ...grpc.CallOption) (*ListStoresResponse, error)
+	UpdateStore(ctx context.Context, in *UpdateStoreRequest, opts ...grpc.CallOption) (*Store, error)
+	// Marketplace feed methods
+	ListProductFeeds(ctx context.Context, in *ListProductFeedsRequest, opts ...grpc.CallOption) (*ListProductFeedsResponse, error)
+	GenerateProductFeeds(ctx context.Context, in *GenerateProductFeedsRequest, opts ...grpc.CallOption) (*ListProductFeedsResponse, error)
+	DownloadProductFeed(ctx context.Context, in *DownloadProductFeedRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProductFeedChunk], error)
+	// ERP sync methods
+	RunErpSync(ctx context.Context, in *RunErpSyncRequest, opts ...grpc.CallOption) (*ListErpSyncRunsResponse, error)
+	ListErpSyncRuns(ctx context.Context, in *ListErpSyncRunsRequest, opts ...grpc.CallOption) (*ListErpSyncRunsResponse, error)
+	// Pricing methods
+	BulkAdjustPrices(ctx context.Context, in *BulkAdjustPricesRequest, opts ...grpc.CallOption) (*BulkAdjustPricesResponse, error)
+	// Inventory reconciliation methods
+	RunInventoryReconciliation(ctx context.Context, in *RunInventoryReconciliationRequest, opts ...grpc.CallOption) (*InventoryReconciliation, error)
+	GetInventoryReconciliation(ctx context.Context, in *GetInventoryReconciliationRequest, opts ...grpc.CallOption) (*InventoryReconciliation, error)
+	ListInventoryReconciliations(ctx context.Context, in *ListInventoryReconciliationsRequest, opts ...grpc.CallOption) (*ListInventoryReconciliationsResponse, error)
+	// Product merge and split methods
+	MergeProducts(ctx context.Context, in *MergeProductsRequest, opts ...grpc.CallOption) (*MergeProductsResponse, error)
+	SplitVariant(ctx context.Context, in *SplitVariantRequest, opts ...grpc.CallOption) (*SplitVariantResponse, error)
+	// Supplier catalog import methods
+	SaveImportTemplate(ctx context.Context, in *SaveImportTemplateRequest, opts ...grpc.CallOption) (*ImportTemplate, error)
+	GetImportTemplate(ctx context.Context, in *GetImportTemplateRequest, opts ...grpc.CallOption) (*ImportTemplate, error)
+	ListImportTemplates(ctx context.Context, in *ListImportTemplatesRequest, opts ...grpc.CallOption) (*ListImportTemplatesResponse, error)
+	DeleteImportTemplate(ctx context.Context, in *DeleteImportTemplateRequest, opts ...grpc.CallOption) (*DeleteImportTemplateResponse, error)
+	ImportSupplierCatalog(ctx context.Context, in *ImportSupplierCatalogRequest, opts ...grpc.CallOption) (*ImportSupplierCatalogResponse, error)
+	// Internal staff notes on products
+	CreateProductNote(ctx context.Context, in *CreateProductNoteRequest, opts ...grpc.CallOption) (*ProductNote, error)
+	ListProductNotes(ctx context.Context, in *ListProductNotesRequest, opts ...grpc.CallOption) (*ListProductNotesResponse, error)
+	UpdateProductNote(ctx context.Context, in *UpdateProductNoteRequest, opts ...grpc.CallOption) (*ProductNote, error)
+	DeleteProductNote(ctx context.Context, in *DeleteProductNoteRequest, opts ...grpc.CallOption) (*DeleteProductNoteResponse, error)
+	// Product relationships: cross-sells, up-sells, accessories and replacements
+	CreateProductRelationship(ctx context.Context, in *CreateProductRelationshipRequest, opts ...grpc.CallOption) (*ProductRelationship, error)
+	ListProductRelationships(ctx context.Context, in *ListProductRelationshipsRequest, opts ...grpc.CallOption) (*ListProductRelationshipsResponse, error)
+	UpdateProductRelationship(ctx context.Context, in *UpdateProductRelationshipRequest, opts ...grpc.CallOption) (*ProductRelationship, error)
+	DeleteProductRelationship(ctx context.Context, in *DeleteProductRelationshipRequest, opts ...grpc.CallOption) (*DeleteProductRelationshipResponse, error)
+	// Customer questions on products, answered by admins and sellers
+	AskProductQuestion(ctx context.Context, in *AskProductQuestionRequest, opts ...grpc.CallOption) (*ProductQuestion, error)
+	GetProductQuestion(ctx context.Context, in *GetProductQuestionRequest, opts ...grpc.CallOption) (*ProductQuestion, error)
+	ListProductQuestions(ctx context.Context, in *ListProductQuestionsRequest, opts ...grpc.CallOption) (*ListProductQuestionsResponse, error)
+	ModerateProductQuestion(ctx context.Context, in *ModerateProductQuestionRequest, opts ...grpc.CallOption) (*ProductQuestion, error)
+	DeleteProductQuestion(ctx context.Context, in *DeleteProductQuestionRequest, opts ...grpc.CallOption) (*DeleteProductQuestionResponse, error)
+	AnswerProductQuestion(ctx context.Context, in *AnswerProductQuestionRequest, opts ...grpc.CallOption) (*ProductAnswer, error)
+	ModerateProductAnswer(ctx context.Context, in *ModerateProductAnswerRequest, opts ...grpc.CallOption) (*ProductAnswer, error)
+	DeleteProductAnswer(ctx context.Context, in *DeleteProductAnswerRequest, opts ...grpc.CallOption) (*DeleteProductAnswerResponse, error)
+	UpvoteProductAnswer(ctx context.Context, in *UpvoteProductAnswerRequest, opts ...grpc.CallOption) (*ProductAnswer, error)
+	// Saved searches of users, with alerts polled by the notification service
+	SaveSearch(ctx context.Context, in *SaveSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error)
+	ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error)
+	DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error)
+	ListSavedSearchAlerts(ctx context.Context, in *ListSavedSearchAlertsRequest, opts ...grpc.CallOption) (*ListSavedSearchAlertsResponse, error)
+	AckSavedSearchAlerts(ctx context.Context, in *AckSavedSearchAlertsRequest, opts ...grpc.CallOption) (*AckSavedSearchAlertsResponse, error)
+	// Product search ranked by relevance and the published merchandising
+	// rules of the store; previews also apply the draft rules
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
+	PreviewSearchRanking(ctx context.Context, in *PreviewSearchRankingRequest, opts ...grpc.CallOption) (*PreviewSearchRankingResponse, error)
+	SaveSearchRankingRule(ctx context.Context, in *SaveSearchRankingRuleRequest, opts ...grpc.CallOption) (*SearchRankingRule, error)
+	ListSearchRankingRules(ctx context.Context, in *ListSearchRankingRulesRequest, opts ...grpc.CallOption) (*ListSearchRankingRulesResponse, error)
+	DeleteSearchRankingRule(ctx context.Context, in *DeleteSearchRankingRuleRequest, opts ...grpc.CallOption) (*DeleteSearchRankingRuleResponse, error)
+	PublishSearchRankingRules(ctx context.Context, in *PublishSearchRankingRulesRequest, opts ...grpc.CallOption) (*PublishSearchRankingRulesResponse, error)
+	// Display badges of products, precomputed from the badge rules of the
+	// store; the order side reports sales for bestseller badges
+	ListBadgeRules(ctx context.Context, in *ListBadgeRulesRequest, opts ...grpc.CallOption) (*ListBadgeRulesResponse, error)
+	SaveBadgeRule(ctx context.Context, in *SaveBadgeRuleRequest, opts ...grpc.CallOption) (*BadgeRule, error)
+	DeleteBadgeRule(ctx context.Context, in *DeleteBadgeRuleRequest, opts ...grpc.CallOption) (*DeleteBadgeRuleResponse, error)
+	RecomputeBadges(ctx context.Context, in *RecomputeBadgesRequest, opts ...grpc.CallOption) (*RecomputeBadgesResponse, error)
+	RecordProductSales(ctx context.Context, in *RecordProductSalesRequest, opts ...grpc.CallOption) (*RecordProductSalesResponse, error)
+	// Recent catalog changes of the store, newest first, for the admin activity feed
+	ListCatalogActivity(ctx context.Context, in *ListCatalogActivityRequest, opts ...grpc.CallOption) (*ListCatalogActivityResponse, error)
+	// Catalog translation methods; products, categories, pages and banners
+	// are returned in the locale of the request when translated to it
+	SetTranslation(ctx context.Context, in *SetTranslationRequest, opts ...grpc.CallOption) (*Translation, error)
+	ListTranslations(ctx context.Context, in *ListTranslationsRequest, opts ...grpc.CallOption) (*ListTranslationsResponse, error)
+	DeleteTranslation(ctx context.Context, in *DeleteTranslationRequest, opts ...grpc.CallOption) (*DeleteTranslationResponse, error)
+	// Storefront content pages and banner slots, returned in the locale of
+	// the request when translated to it
+	CreateContentPage(ctx context.Context, in *CreateContentPageRequest, opts ...grpc.CallOption) (*ContentPage, error)
+	UpdateContentPage(ctx context.Context, in *UpdateContentPageRequest, opts ...grpc.CallOption) (*ContentPage, error)
+	GetContentPage(ctx context.Context, in *GetContentPageRequest, opts ...grpc.CallOption) (*ContentPage, error)
+	ListContentPages(ctx context.Context, in *ListContentPagesRequest, opts ...grpc.CallOption) (*ListContentPagesResponse, error)
+	DeleteContentPage(ctx context.Context, in *DeleteContentPageRequest, opts ...grpc.CallOption) (*DeleteContentPageResponse, error)
+	CreateContentBanner(ctx context.Context, in *CreateContentBannerRequest, opts ...grpc.CallOption) (*ContentBanner, error)
+	UpdateContentBanner(ctx context.Context, in *UpdateContentBannerRequest, opts ...grpc.CallOption) (*ContentBanner, error)
+	ListContentBanners(ctx context.Context, in *ListContentBannersRequest, opts ...grpc.CallOption) (*ListContentBannersResponse, error)
+	DeleteContentBanner(ctx context.Context, in *DeleteContentBannerRequest, opts ...grpc.CallOption) (*DeleteContentBannerResponse, error)
+	// Store settings methods; the storefront config merges the public
+	// settings with the store defaults
+	ListSettings(ctx context.Context, in *ListSettingsRequest, opts ...grpc.CallOption) (*ListSettingsResponse, error)
+	SetSetting(ctx context.Context, in *SetSettingRequest, opts ...grpc.CallOption) (*Setting, error)
+	DeleteSetting(ctx context.Context, in *DeleteSettingRequest, opts ...grpc.CallOption) (*DeleteSettingResponse, error)
+	GetStorefrontConfig(ctx context.Context, in *GetStorefrontConfigRequest, opts ...grpc.CallOption) (*StorefrontConfig, error)
+	// Catalog quality methods
+	GetCatalogQualityReport(ctx context.Context, in *GetCatalogQualityReportRequest, opts ...grpc.CallOption) (*CatalogQualityReport, error)
+	GetProductQualityScore(ctx context.Context, in *GetProductQualityScoreRequest, opts ...grpc.CallOption) (*ProductQualityScore, error)
+	RecomputeCatalogQuality(ctx context.Context, in *RecomputeCatalogQualityRequest, opts ...grpc.CallOption) (*RecomputeCatalogQualityResponse, error)
+	// Diagnostics
+	GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*DiagnosticsResponse, error)
+	// Cache administration, removes the cached entries of a namespace for every store
+	FlushCacheNamespace(ctx context.Context, in *FlushCacheNamespaceRequest, opts ...grpc.CallOption) (*FlushCacheNamespaceResponse, error)
+	// Scheduled cache invalidations and re-warms, and their run history
+	ListCacheSchedules(ctx context.Context, in *ListCacheSchedulesRequest, opts ...grpc.CallOption) (*ListCacheSchedulesResponse, error)
+	ListCacheScheduleRuns(ctx context.Context, in *ListCacheScheduleRunsRequest, opts ...grpc.CallOption) (*ListCacheScheduleRunsResponse, error)
+	RunCacheSchedule(ctx context.Context, in *RunCacheScheduleRequest, opts ...grpc.CallOption) (*CacheScheduleRun, error)
 }
 
 type productServiceClient struct {
@@ -177,6 +438,86 @@ func (c *productServiceClient) ListCategories(ctx context.Context, in *ListCateg
 	return out, nil
 }
 
+func (c *productServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*Category, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Category)
+	err := c.cc.Invoke(ctx, ProductService_MoveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) MergeCategories(ctx context.Context, in *MergeCategoriesRequest, opts ...grpc.CallOption) (*MergeCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeCategoriesResponse)
+	err := c.cc.Invoke(ctx, ProductService_MergeCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReorderSiblings(ctx context.Context, in *ReorderSiblingsRequest, opts ...grpc.CallOption) (*ReorderSiblingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReorderSiblingsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReorderSiblings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateCategoryAttribute(ctx context.Context, in *CreateCategoryAttributeRequest, opts ...grpc.CallOption) (*CategoryAttribute, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryAttribute)
+	err := c.cc.Invoke(ctx, ProductService_CreateCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateCategoryAttribute(ctx context.Context, in *UpdateCategoryAttributeRequest, opts ...grpc.CallOption) (*CategoryAttribute, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CategoryAttribute)
+	err := c.cc.Invoke(ctx, ProductService_UpdateCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCategoryAttributes(ctx context.Context, in *ListCategoryAttributesRequest, opts ...grpc.CallOption) (*ListCategoryAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoryAttributesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListCategoryAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteCategoryAttribute(ctx context.Context, in *DeleteCategoryAttributeRequest, opts ...grpc.CallOption) (*DeleteCategoryAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryAttributeResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCategoryFacets(ctx context.Context, in *GetCategoryFacetsRequest, opts ...grpc.CallOption) (*GetCategoryFacetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryFacetsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetCategoryFacets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) UploadImage(ctx context.Context, in *UploadImageRequest, opts ...grpc.CallOption) (*UploadImageResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UploadImageResponse)
@@ -184,372 +525,3917 @@ func (c *productServiceClient) UploadImage(ctx context.Context, in *UploadImageR
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteImage(ctx context.Context, in *DeleteImageRequest, opts ...grpc.CallOption) (*DeleteImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateMediaUpload(ctx context.Context, in *CreateMediaUploadRequest, opts ...grpc.CallOption) (*MediaUpload, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MediaUpload)
+	err := c.cc.Invoke(ctx, ProductService_CreateMediaUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ConfirmMediaUpload(ctx context.Context, in *ConfirmMediaUploadRequest, opts ...grpc.CallOption) (*ConfirmMediaUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmMediaUploadResponse)
+	err := c.cc.Invoke(ctx, ProductService_ConfirmMediaUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AddProductVideo(ctx context.Context, in *AddProductVideoRequest, opts ...grpc.CallOption) (*ProductMedia, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductMedia)
+	err := c.cc.Invoke(ctx, ProductService_AddProductVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductMedia(ctx context.Context, in *DeleteProductMediaRequest, opts ...grpc.CallOption) (*DeleteProductMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductMediaResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GenerateSKUPreview(ctx context.Context, in *GenerateSKUPreviewRequest, opts ...grpc.CallOption) (*GenerateSKUPreviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateSKUPreviewResponse)
+	err := c.cc.Invoke(ctx, ProductService_GenerateSKUPreview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateCollection(ctx context.Context, in *CreateCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, ProductService_CreateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, ProductService_GetCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListCollections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, ProductService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteCollection(ctx context.Context, in *DeleteCollectionRequest, opts ...grpc.CallOption) (*DeleteCollectionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCollectionResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetCollectionProducts(ctx context.Context, in *SetCollectionProductsRequest, opts ...grpc.CallOption) (*Collection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Collection)
+	err := c.cc.Invoke(ctx, ProductService_SetCollectionProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCollectionProducts(ctx context.Context, in *ListCollectionProductsRequest, opts ...grpc.CallOption) (*ListCollectionProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCollectionProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListCollectionProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*Product, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Product)
+	err := c.cc.Invoke(ctx, ProductService_CreateBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UploadDigitalAsset(ctx context.Context, in *UploadDigitalAssetRequest, opts ...grpc.CallOption) (*DigitalAsset, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DigitalAsset)
+	err := c.cc.Invoke(ctx, ProductService_UploadDigitalAsset_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateDownloadLink(ctx context.Context, in *CreateDownloadLinkRequest, opts ...grpc.CallOption) (*DownloadLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DownloadLink)
+	err := c.cc.Invoke(ctx, ProductService_CreateDownloadLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DownloadDigitalAsset(ctx context.Context, in *DownloadDigitalAssetRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DigitalAssetChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_DownloadDigitalAsset_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadDigitalAssetRequest, DigitalAssetChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_DownloadDigitalAssetClient = grpc.ServerStreamingClient[DigitalAssetChunk]
+
+func (c *productServiceClient) SetSubscriptionPlan(ctx context.Context, in *SetSubscriptionPlanRequest, opts ...grpc.CallOption) (*SubscriptionPlan, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionPlan)
+	err := c.cc.Invoke(ctx, ProductService_SetSubscriptionPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, ProductService_CreateSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetSubscription(ctx context.Context, in *GetSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, ProductService_GetSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CancelSubscription(ctx context.Context, in *CancelSubscriptionRequest, opts ...grpc.CallOption) (*Subscription, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Subscription)
+	err := c.cc.Invoke(ctx, ProductService_CancelSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListSubscriptionEvents(ctx context.Context, in *ListSubscriptionEventsRequest, opts ...grpc.CallOption) (*ListSubscriptionEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionEventsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListSubscriptionEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AckSubscriptionEvents(ctx context.Context, in *AckSubscriptionEventsRequest, opts ...grpc.CallOption) (*AckSubscriptionEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckSubscriptionEventsResponse)
+	err := c.cc.Invoke(ctx, ProductService_AckSubscriptionEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetPersonalizationOptions(ctx context.Context, in *SetPersonalizationOptionsRequest, opts ...grpc.CallOption) (*PersonalizationOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PersonalizationOptionsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetPersonalizationOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ValidatePersonalization(ctx context.Context, in *ValidatePersonalizationRequest, opts ...grpc.CallOption) (*ValidatePersonalizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidatePersonalizationResponse)
+	err := c.cc.Invoke(ctx, ProductService_ValidatePersonalization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductChannels(ctx context.Context, in *SetProductChannelsRequest, opts ...grpc.CallOption) (*ProductChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductChannelsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductChannels(ctx context.Context, in *GetProductChannelsRequest, opts ...grpc.CallOption) (*ProductChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductChannelsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateStore(ctx context.Context, in *CreateStoreRequest, opts ...grpc.CallOption) (*Store, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Store)
+	err := c.cc.Invoke(ctx, ProductService_CreateStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetStore(ctx context.Context, in *GetStoreRequest, opts ...grpc.CallOption) (*Store, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Store)
+	err := c.cc.Invoke(ctx, ProductService_GetStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListStores(ctx context.Context, in *ListStoresRequest, opts ...grpc.CallOption) (*ListStoresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStoresResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListStores_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateStore(ctx context.Context, in *UpdateStoreRequest, opts ...grpc.CallOption) (*Store, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Store)
+	err := c.cc.Invoke(ctx, ProductService_UpdateStore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductFeeds(ctx context.Context, in *ListProductFeedsRequest, opts ...grpc.CallOption) (*ListProductFeedsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductFeedsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductFeeds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GenerateProductFeeds(ctx context.Context, in *GenerateProductFeedsRequest, opts ...grpc.CallOption) (*ListProductFeedsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductFeedsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GenerateProductFeeds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DownloadProductFeed(ctx context.Context, in *DownloadProductFeedRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ProductFeedChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[1], ProductService_DownloadProductFeed_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadProductFeedRequest, ProductFeedChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_DownloadProductFeedClient = grpc.ServerStreamingClient[ProductFeedChunk]
+
+func (c *productServiceClient) RunErpSync(ctx context.Context, in *RunErpSyncRequest, opts ...grpc.CallOption) (*ListErpSyncRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListErpSyncRunsResponse)
+	err := c.cc.Invoke(ctx, ProductService_RunErpSync_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListErpSyncRuns(ctx context.Context, in *ListErpSyncRunsRequest, opts ...grpc.CallOption) (*ListErpSyncRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListErpSyncRunsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListErpSyncRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) BulkAdjustPrices(ctx context.Context, in *BulkAdjustPricesRequest, opts ...grpc.CallOption) (*BulkAdjustPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkAdjustPricesResponse)
+	err := c.cc.Invoke(ctx, ProductService_BulkAdjustPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RunInventoryReconciliation(ctx context.Context, in *RunInventoryReconciliationRequest, opts ...grpc.CallOption) (*InventoryReconciliation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InventoryReconciliation)
+	err := c.cc.Invoke(ctx, ProductService_RunInventoryReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetInventoryReconciliation(ctx context.Context, in *GetInventoryReconciliationRequest, opts ...grpc.CallOption) (*InventoryReconciliation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InventoryReconciliation)
+	err := c.cc.Invoke(ctx, ProductService_GetInventoryReconciliation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListInventoryReconciliations(ctx context.Context, in *ListInventoryReconciliationsRequest, opts ...grpc.CallOption) (*ListInventoryReconciliationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInventoryReconciliationsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListInventoryReconciliations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) MergeProducts(ctx context.Context, in *MergeProductsRequest, opts ...grpc.CallOption) (*MergeProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_MergeProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SplitVariant(ctx context.Context, in *SplitVariantRequest, opts ...grpc.CallOption) (*SplitVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SplitVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_SplitVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SaveImportTemplate(ctx context.Context, in *SaveImportTemplateRequest, opts ...grpc.CallOption) (*ImportTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportTemplate)
+	err := c.cc.Invoke(ctx, ProductService_SaveImportTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetImportTemplate(ctx context.Context, in *GetImportTemplateRequest, opts ...grpc.CallOption) (*ImportTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportTemplate)
+	err := c.cc.Invoke(ctx, ProductService_GetImportTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListImportTemplates(ctx context.Context, in *ListImportTemplatesRequest, opts ...grpc.CallOption) (*ListImportTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListImportTemplatesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListImportTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteImportTemplate(ctx context.Context, in *DeleteImportTemplateRequest, opts ...grpc.CallOption) (*DeleteImportTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteImportTemplateResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteImportTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportSupplierCatalog(ctx context.Context, in *ImportSupplierCatalogRequest, opts ...grpc.CallOption) (*ImportSupplierCatalogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportSupplierCatalogResponse)
+	err := c.cc.Invoke(ctx, ProductService_ImportSupplierCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateProductNote(ctx context.Context, in *CreateProductNoteRequest, opts ...grpc.CallOption) (*ProductNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductNote)
+	err := c.cc.Invoke(ctx, ProductService_CreateProductNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductNotes(ctx context.Context, in *ListProductNotesRequest, opts ...grpc.CallOption) (*ListProductNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductNotesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateProductNote(ctx context.Context, in *UpdateProductNoteRequest, opts ...grpc.CallOption) (*ProductNote, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductNote)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProductNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductNote(ctx context.Context, in *DeleteProductNoteRequest, opts ...grpc.CallOption) (*DeleteProductNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductNoteResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateProductRelationship(ctx context.Context, in *CreateProductRelationshipRequest, opts ...grpc.CallOption) (*ProductRelationship, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductRelationship)
+	err := c.cc.Invoke(ctx, ProductService_CreateProductRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductRelationships(ctx context.Context, in *ListProductRelationshipsRequest, opts ...grpc.CallOption) (*ListProductRelationshipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductRelationshipsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductRelationships_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateProductRelationship(ctx context.Context, in *UpdateProductRelationshipRequest, opts ...grpc.CallOption) (*ProductRelationship, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductRelationship)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProductRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductRelationship(ctx context.Context, in *DeleteProductRelationshipRequest, opts ...grpc.CallOption) (*DeleteProductRelationshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductRelationshipResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductRelationship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AskProductQuestion(ctx context.Context, in *AskProductQuestionRequest, opts ...grpc.CallOption) (*ProductQuestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductQuestion)
+	err := c.cc.Invoke(ctx, ProductService_AskProductQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductQuestion(ctx context.Context, in *GetProductQuestionRequest, opts ...grpc.CallOption) (*ProductQuestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductQuestion)
+	err := c.cc.Invoke(ctx, ProductService_GetProductQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductQuestions(ctx context.Context, in *ListProductQuestionsRequest, opts ...grpc.CallOption) (*ListProductQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductQuestionsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ModerateProductQuestion(ctx context.Context, in *ModerateProductQuestionRequest, opts ...grpc.CallOption) (*ProductQuestion, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductQuestion)
+	err := c.cc.Invoke(ctx, ProductService_ModerateProductQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductQuestion(ctx context.Context, in *DeleteProductQuestionRequest, opts ...grpc.CallOption) (*DeleteProductQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductQuestionResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AnswerProductQuestion(ctx context.Context, in *AnswerProductQuestionRequest, opts ...grpc.CallOption) (*ProductAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductAnswer)
+	err := c.cc.Invoke(ctx, ProductService_AnswerProductQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ModerateProductAnswer(ctx context.Context, in *ModerateProductAnswerRequest, opts ...grpc.CallOption) (*ProductAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductAnswer)
+	err := c.cc.Invoke(ctx, ProductService_ModerateProductAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductAnswer(ctx context.Context, in *DeleteProductAnswerRequest, opts ...grpc.CallOption) (*DeleteProductAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductAnswerResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpvoteProductAnswer(ctx context.Context, in *UpvoteProductAnswerRequest, opts ...grpc.CallOption) (*ProductAnswer, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductAnswer)
+	err := c.cc.Invoke(ctx, ProductService_UpvoteProductAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SaveSearch(ctx context.Context, in *SaveSearchRequest, opts ...grpc.CallOption) (*SavedSearch, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SavedSearch)
+	err := c.cc.Invoke(ctx, ProductService_SaveSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListSavedSearches(ctx context.Context, in *ListSavedSearchesRequest, opts ...grpc.CallOption) (*ListSavedSearchesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedSearchesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListSavedSearches_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteSavedSearch(ctx context.Context, in *DeleteSavedSearchRequest, opts ...grpc.CallOption) (*DeleteSavedSearchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSavedSearchResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteSavedSearch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListSavedSearchAlerts(ctx context.Context, in *ListSavedSearchAlertsRequest, opts ...grpc.CallOption) (*ListSavedSearchAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSavedSearchAlertsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListSavedSearchAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AckSavedSearchAlerts(ctx context.Context, in *AckSavedSearchAlertsRequest, opts ...grpc.CallOption) (*AckSavedSearchAlertsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AckSavedSearchAlertsResponse)
+	err := c.cc.Invoke(ctx, ProductService_AckSavedSearchAlerts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PreviewSearchRanking(ctx context.Context, in *PreviewSearchRankingRequest, opts ...grpc.CallOption) (*PreviewSearchRankingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewSearchRankingResponse)
+	err := c.cc.Invoke(ctx, ProductService_PreviewSearchRanking_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SaveSearchRankingRule(ctx context.Context, in *SaveSearchRankingRuleRequest, opts ...grpc.CallOption) (*SearchRankingRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchRankingRule)
+	err := c.cc.Invoke(ctx, ProductService_SaveSearchRankingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListSearchRankingRules(ctx context.Context, in *ListSearchRankingRulesRequest, opts ...grpc.CallOption) (*ListSearchRankingRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSearchRankingRulesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListSearchRankingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteSearchRankingRule(ctx context.Context, in *DeleteSearchRankingRuleRequest, opts ...grpc.CallOption) (*DeleteSearchRankingRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSearchRankingRuleResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteSearchRankingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PublishSearchRankingRules(ctx context.Context, in *PublishSearchRankingRulesRequest, opts ...grpc.CallOption) (*PublishSearchRankingRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishSearchRankingRulesResponse)
+	err := c.cc.Invoke(ctx, ProductService_PublishSearchRankingRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListBadgeRules(ctx context.Context, in *ListBadgeRulesRequest, opts ...grpc.CallOption) (*ListBadgeRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBadgeRulesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListBadgeRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SaveBadgeRule(ctx context.Context, in *SaveBadgeRuleRequest, opts ...grpc.CallOption) (*BadgeRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BadgeRule)
+	err := c.cc.Invoke(ctx, ProductService_SaveBadgeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteBadgeRule(ctx context.Context, in *DeleteBadgeRuleRequest, opts ...grpc.CallOption) (*DeleteBadgeRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteBadgeRuleResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteBadgeRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RecomputeBadges(ctx context.Context, in *RecomputeBadgesRequest, opts ...grpc.CallOption) (*RecomputeBadgesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeBadgesResponse)
+	err := c.cc.Invoke(ctx, ProductService_RecomputeBadges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RecordProductSales(ctx context.Context, in *RecordProductSalesRequest, opts ...grpc.CallOption) (*RecordProductSalesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordProductSalesResponse)
+	err := c.cc.Invoke(ctx, ProductService_RecordProductSales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCatalogActivity(ctx context.Context, in *ListCatalogActivityRequest, opts ...grpc.CallOption) (*ListCatalogActivityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCatalogActivityResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListCatalogActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetTranslation(ctx context.Context, in *SetTranslationRequest, opts ...grpc.CallOption) (*Translation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Translation)
+	err := c.cc.Invoke(ctx, ProductService_SetTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListTranslations(ctx context.Context, in *ListTranslationsRequest, opts ...grpc.CallOption) (*ListTranslationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTranslationsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListTranslations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteTranslation(ctx context.Context, in *DeleteTranslationRequest, opts ...grpc.CallOption) (*DeleteTranslationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTranslationResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteTranslation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateContentPage(ctx context.Context, in *CreateContentPageRequest, opts ...grpc.CallOption) (*ContentPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentPage)
+	err := c.cc.Invoke(ctx, ProductService_CreateContentPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateContentPage(ctx context.Context, in *UpdateContentPageRequest, opts ...grpc.CallOption) (*ContentPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentPage)
+	err := c.cc.Invoke(ctx, ProductService_UpdateContentPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetContentPage(ctx context.Context, in *GetContentPageRequest, opts ...grpc.CallOption) (*ContentPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentPage)
+	err := c.cc.Invoke(ctx, ProductService_GetContentPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListContentPages(ctx context.Context, in *ListContentPagesRequest, opts ...grpc.CallOption) (*ListContentPagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContentPagesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListContentPages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteContentPage(ctx context.Context, in *DeleteContentPageRequest, opts ...grpc.CallOption) (*DeleteContentPageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteContentPageResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteContentPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateContentBanner(ctx context.Context, in *CreateContentBannerRequest, opts ...grpc.CallOption) (*ContentBanner, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentBanner)
+	err := c.cc.Invoke(ctx, ProductService_CreateContentBanner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateContentBanner(ctx context.Context, in *UpdateContentBannerRequest, opts ...grpc.CallOption) (*ContentBanner, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ContentBanner)
+	err := c.cc.Invoke(ctx, ProductService_UpdateContentBanner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListContentBanners(ctx context.Context, in *ListContentBannersRequest, opts ...grpc.CallOption) (*ListContentBannersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListContentBannersResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListContentBanners_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteContentBanner(ctx context.Context, in *DeleteContentBannerRequest, opts ...grpc.CallOption) (*DeleteContentBannerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteContentBannerResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteContentBanner_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListSettings(ctx context.Context, in *ListSettingsRequest, opts ...grpc.CallOption) (*ListSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSettingsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetSetting(ctx context.Context, in *SetSettingRequest, opts ...grpc.CallOption) (*Setting, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Setting)
+	err := c.cc.Invoke(ctx, ProductService_SetSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteSetting(ctx context.Context, in *DeleteSettingRequest, opts ...grpc.CallOption) (*DeleteSettingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSettingResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteSetting_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetStorefrontConfig(ctx context.Context, in *GetStorefrontConfigRequest, opts ...grpc.CallOption) (*StorefrontConfig, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StorefrontConfig)
+	err := c.cc.Invoke(ctx, ProductService_GetStorefrontConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetCatalogQualityReport(ctx context.Context, in *GetCatalogQualityReportRequest, opts ...grpc.CallOption) (*CatalogQualityReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CatalogQualityReport)
+	err := c.cc.Invoke(ctx, ProductService_GetCatalogQualityReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetProductQualityScore(ctx context.Context, in *GetProductQualityScoreRequest, opts ...grpc.CallOption) (*ProductQualityScore, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductQualityScore)
+	err := c.cc.Invoke(ctx, ProductService_GetProductQualityScore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RecomputeCatalogQuality(ctx context.Context, in *RecomputeCatalogQualityRequest, opts ...grpc.CallOption) (*RecomputeCatalogQualityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecomputeCatalogQualityResponse)
+	err := c.cc.Invoke(ctx, ProductService_RecomputeCatalogQuality_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*DiagnosticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiagnosticsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) FlushCacheNamespace(ctx context.Context, in *FlushCacheNamespaceRequest, opts ...grpc.CallOption) (*FlushCacheNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FlushCacheNamespaceResponse)
+	err := c.cc.Invoke(ctx, ProductService_FlushCacheNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCacheSchedules(ctx context.Context, in *ListCacheSchedulesRequest, opts ...grpc.CallOption) (*ListCacheSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCacheSchedulesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListCacheSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListCacheScheduleRuns(ctx context.Context, in *ListCacheScheduleRunsRequest, opts ...grpc.CallOption) (*ListCacheScheduleRunsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCacheScheduleRunsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListCacheScheduleRuns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RunCacheSchedule(ctx context.Context, in *RunCacheScheduleRequest, opts ...grpc.CallOption) (*CacheScheduleRun, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CacheScheduleRun)
+	err := c.cc.Invoke(ctx, ProductService_RunCacheSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductServiceServer is the server API for ProductService service.
+// All implementations must embed UnimplementedProductServiceServer
+// for forward compatibility.
+//
+// Service definition
+type ProductServiceServer interface {
+	CreateProduct(context.Context, *CreateProductRequest) (*Product, error)
+	GetProduct(context.Context, *GetProductRequest) (*Product, error)
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error)
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	CreateBrand(context.Context, *CreateBrandRequest) (*Brand, error)
+	GetBrand(context.Context, *GetBrandRequest) (*Brand, error)
+	ListBrands(context.Context, *ListBrandsRequest) (*ListBrandsResponse, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
+	GetCategory(context.Context, *GetCategoryRequest) (*Category, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// Category tree methods
+	MoveCategory(context.Context, *MoveCategoryRequest) (*Category, error)
+	MergeCategories(context.Context, *MergeCategoriesRequest) (*MergeCategoriesResponse, error)
+	ReorderSiblings(context.Context, *ReorderSiblingsRequest) (*ReorderSiblingsResponse, error)
+	// Category attribute methods; products created in a category must give
+	// the required attributes of the category and its ancestors
+	CreateCategoryAttribute(context.Context, *CreateCategoryAttributeRequest) (*CategoryAttribute, error)
+	UpdateCategoryAttribute(context.Context, *UpdateCategoryAttributeRequest) (*CategoryAttribute, error)
+	ListCategoryAttributes(context.Context, *ListCategoryAttributesRequest) (*ListCategoryAttributesResponse, error)
+	DeleteCategoryAttribute(context.Context, *DeleteCategoryAttributeRequest) (*DeleteCategoryAttributeResponse, error)
+	GetCategoryFacets(context.Context, *GetCategoryFacetsRequest) (*GetCategoryFacetsResponse, error)
+	// Image upload methods
+	UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error)
+	DeleteImage(context.Context, *DeleteImageRequest) (*DeleteImageResponse, error)
+	CreateMediaUpload(context.Context, *CreateMediaUploadRequest) (*MediaUpload, error)
+	ConfirmMediaUpload(context.Context, *ConfirmMediaUploadRequest) (*ConfirmMediaUploadResponse, error)
+	AddProductVideo(context.Context, *AddProductVideoRequest) (*ProductMedia, error)
+	DeleteProductMedia(context.Context, *DeleteProductMediaRequest) (*DeleteProductMediaResponse, error)
+	// SKU generation methods
+	GenerateSKUPreview(context.Context, *GenerateSKUPreviewRequest) (*GenerateSKUPreviewResponse, error)
+	// Collection methods
+	CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error)
+	GetCollection(context.Context, *GetCollectionRequest) (*Collection, error)
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error)
+	DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error)
+	SetCollectionProducts(context.Context, *SetCollectionProductsRequest) (*Collection, error)
+	ListCollectionProducts(context.Context, *ListCollectionProductsRequest) (*ListCollectionProductsResponse, error)
+	// Bundle methods
+	CreateBundle(context.Context, *CreateBundleRequest) (*Product, error)
+	// Digital product methods
+	UploadDigitalAsset(context.Context, *UploadDigitalAssetRequest) (*DigitalAsset, error)
+	CreateDownloadLink(context.Context, *CreateDownloadLinkRequest) (*DownloadLink, error)
+	DownloadDigitalAsset(*DownloadDigitalAssetRequest, grpc.ServerStreamingServer[DigitalAssetChunk]) error
+	// Subscription methods
+	SetSubscriptionPlan(context.Context, *SetSubscriptionPlanRequest) (*SubscriptionPlan, error)
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*Subscription, error)
+	GetSubscription(context.Context, *GetSubscriptionRequest) (*Subscription, error)
+	CancelSubscription(context.Context, *CancelSubscriptionRequest) (*Subscription, error)
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	// Billing events polled by the payment service
+	ListSubscriptionEvents(context.Context, *ListSubscriptionEventsRequest) (*ListSubscriptionEventsResponse, error)
+	AckSubscriptionEvents(context.Context, *AckSubscriptionEventsRequest) (*AckSubscriptionEventsResponse, error)
+	// Personalization methods; values are validated before they are added
+	// to a cart or an order
+	SetPersonalizationOptions(context.Context, *SetPersonalizationOptionsRequest) (*PersonalizationOptionsResponse, error)
+	ValidatePersonalization(context.Context, *ValidatePersonalizationRequest) (*ValidatePersonalizationResponse, error)
+	// Channel visibility methods
+	SetProductChannels(context.Context, *SetProductChannelsRequest) (*ProductChannelsResponse, error)
+	GetProductChannels(context.Context, *GetProductChannelsRequest) (*ProductChannelsResponse, error)
+	// Store methods
+	CreateStore(context.Context, *CreateStoreRequest) (*Store, error)
+	GetStore(context.Context, *GetStoreRequest) (*Store, error)
+	ListStores(context.Context, *ListStoresRequest) (*ListStoresResponse, error)
+	UpdateStore(context.Context, *UpdateStoreRequest) (*Store, error)
+	// Marketplace feed methods
+	ListProductFeeds(context.Context, *ListProductFeedsRequest) (*ListProductFeedsResponse, error)
+	GenerateProductFeeds(context.Context, *GenerateProductFeedsRequest) (*ListProductFeedsResponse, error)
+	DownloadProductFeed(*DownloadProductFeedRequest, grpc.ServerStreamingServer[ProductFeedChunk]) error
+	// ERP sync methods
+	RunErpSync(context.Context, *RunErpSyncRequest) (*ListErpSyncRunsResponse, error)
+	ListErpSyncRuns(context.Context, *ListErpSyncRunsRequest) (*ListErpSyncRunsResponse, error)
+	// Pricing methods
+	BulkAdjustPrices(context.Context, *BulkAdjustPricesRequest) (*BulkAdjustPricesResponse, error)
+	// Inventory reconciliation methods
+	RunInventoryReconciliation(context.Context, *RunInventoryReconciliationRequest) (*InventoryReconciliation, error)
+	GetInventoryReconciliation(context.Context, *GetInventoryReconciliationRequest) (*InventoryReconciliation, error)
+	ListInventoryReconciliations(context.Context, *ListInventoryReconciliationsRequest) (*ListInventoryReconciliationsResponse, error)
+	// Product merge and split methods
+	MergeProducts(context.Context, *MergeProductsRequest) (*MergeProductsResponse, error)
+	SplitVariant(context.Context, *SplitVariantRequest) (*SplitVariantResponse, error)
+	// Supplier catalog import methods
+	SaveImportTemplate(context.Context, *SaveImportTemplateRequest) (*ImportTemplate, error)
+	GetImportTemplate(context.Context, *GetImportTemplateRequest) (*ImportTemplate, error)
+	ListImportTemplates(context.Context, *ListImportTemplatesRequest) (*ListImportTemplatesResponse, error)
+	DeleteImportTemplate(context.Context, *DeleteImportTemplateRequest) (*DeleteImportTemplateResponse, error)
+	ImportSupplierCatalog(context.Context, *ImportSupplierCatalogRequest) (*ImportSupplierCatalogResponse, error)
+	// Internal staff notes on products
+	CreateProductNote(context.Context, *CreateProductNoteRequest) (*ProductNote, error)
+	ListProductNotes(context.Context, *ListProductNotesRequest) (*ListProductNotesResponse, error)
+	UpdateProductNote(context.Context, *UpdateProductNoteRequest) (*ProductNote, error)
+	DeleteProductNote(context.Context, *DeleteProductNoteRequest) (*DeleteProductNoteResponse, error)
+	// Product relationships: cross-sells, up-sells, accessories and replacements
+	CreateProductRelationship(context.Context, *CreateProductRelationshipRequest) (*ProductRelationship, error)
+	ListProductRelationships(context.Context, *ListProductRelationshipsRequest) (*ListProductRelationshipsResponse, error)
+	UpdateProductRelationship(context.Context, *UpdateProductRelationshipRequest) (*ProductRelationship, error)
+	DeleteProductRelationship(context.Context, *DeleteProductRelationshipRequest) (*DeleteProductRelationshipResponse, error)
+	// Customer questions on products, answered by admins and sellers
+	AskProductQuestion(context.Context, *AskProductQuestionRequest) (*ProductQuestion, error)
+	GetProductQuestion(context.Context, *GetProductQuestionRequest) (*ProductQuestion, error)
+	ListProductQuestions(context.Context, *ListProductQuestionsRequest) (*ListProductQuestionsResponse, error)
+	ModerateProductQuestion(context.Context, *ModerateProductQuestionRequest) (*ProductQuestion, error)
+	DeleteProductQuestion(context.Context, *DeleteProductQuestionRequest) (*DeleteProductQuestionResponse, error)
+	AnswerProductQuestion(context.Context, *AnswerProductQuestionRequest) (*ProductAnswer, error)
+	ModerateProductAnswer(context.Context, *ModerateProductAnswerRequest) (*ProductAnswer, error)
+	DeleteProductAnswer(context.Context, *DeleteProductAnswerRequest) (*DeleteProductAnswerResponse, error)
+	UpvoteProductAnswer(context.Context, *UpvoteProductAnswerRequest) (*ProductAnswer, error)
+	// Saved searches of users, with alerts polled by the notification service
+	SaveSearch(context.Context, *SaveSearchRequest) (*SavedSearch, error)
+	ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error)
+	DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error)
+	ListSavedSearchAlerts(context.Context, *ListSavedSearchAlertsRequest) (*ListSavedSearchAlertsResponse, error)
+	AckSavedSearchAlerts(context.Context, *AckSavedSearchAlertsRequest) (*AckSavedSearchAlertsResponse, error)
+	// Product search ranked by relevance and the published merchandising
+	// rules of the store; previews also apply the draft rules
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	PreviewSearchRanking(context.Context, *PreviewSearchRankingRequest) (*PreviewSearchRankingResponse, error)
+	SaveSearchRankingRule(context.Context, *SaveSearchRankingRuleRequest) (*SearchRankingRule, error)
+	ListSearchRankingRules(context.Context, *ListSearchRankingRulesRequest) (*ListSearchRankingRulesResponse, error)
+	DeleteSearchRankingRule(context.Context, *DeleteSearchRankingRuleRequest) (*DeleteSearchRankingRuleResponse, error)
+	PublishSearchRankingRules(context.Context, *PublishSearchRankingRulesRequest) (*PublishSearchRankingRulesResponse, error)
+	// Display badges of products, precomputed from the badge rules of the
+	// store; the order side reports sales for bestseller badges
+	ListBadgeRules(context.Context, *ListBadgeRulesRequest) (*ListBadgeRulesResponse, error)
+	SaveBadgeRule(context.Context, *SaveBadgeRuleRequest) (*BadgeRule, error)
+	DeleteBadgeRule(context.Context, *DeleteBadgeRuleRequest) (*DeleteBadgeRuleResponse, error)
+	RecomputeBadges(context.Context, *RecomputeBadgesRequest) (*RecomputeBadgesResponse, error)
+	RecordProductSales(context.Context, *RecordProductSalesRequest) (*RecordProductSalesResponse, error)
+	// Recent catalog changes of the store, newest first, for the admin activity feed
+	ListCatalogActivity(context.Context, *ListCatalogActivityRequest) (*ListCatalogActivityResponse, error)
+	// Catalog translation methods; products, categories, pages and banners
+	// are returned in the locale of the request when translated to it
+	SetTranslation(context.Context, *SetTranslationRequest) (*Translation, error)
+	ListTranslations(context.Context, *ListTranslationsRequest) (*ListTranslationsResponse, error)
+	DeleteTranslation(context.Context, *DeleteTranslationRequest) (*DeleteTranslationResponse, error)
+	// Storefront content pages and banner slots, returned in the locale of
+	// the request when translated to it
+	CreateContentPage(context.Context, *CreateContentPageRequest) (*ContentPage, error)
+	UpdateContentPage(context.Context, *UpdateContentPageRequest) (*ContentPage, error)
+	GetContentPage(context.Context, *GetContentPageRequest) (*ContentPage, error)
+	ListContentPages(context.Context, *ListContentPagesRequest) (*ListContentPagesResponse, error)
+	DeleteContentPage(context.Context, *DeleteContentPageRequest) (*DeleteContentPageResponse, error)
+	CreateContentBanner(context.Context, *CreateContentBannerRequest) (*ContentBanner, error)
+	UpdateContentBanner(context.Context, *UpdateContentBannerRequest) (*ContentBanner, error)
+	ListContentBanners(context.Context, *ListContentBannersRequest) (*ListContentBannersResponse, error)
+	DeleteContentBanner(context.Context, *DeleteContentBannerRequest) (*DeleteContentBannerResponse, error)
+	// Store settings methods; the storefront config merges the public
+	// settings with the store defaults
+	ListSettings(context.Context, *ListSettingsRequest) (*ListSettingsResponse, error)
+	SetSetting(context.Context, *SetSettingRequest) (*Setting, error)
+	DeleteSetting(context.Context, *DeleteSettingRequest) (*DeleteSettingResponse, error)
+	GetStorefrontConfig(context.Context, *GetStorefrontConfigRequest) (*StorefrontConfig, error)
+	// Catalog quality methods
+	GetCatalogQualityReport(context.Context, *GetCatalogQualityReportRequest) (*CatalogQualityReport, error)
+	GetProductQualityScore(context.Context, *GetProductQualityScoreRequest) (*ProductQualityScore, error)
+	RecomputeCatalogQuality(context.Context, *RecomputeCatalogQualityRequest) (*RecomputeCatalogQualityResponse, error)
+	// Diagnostics
+	GetDiagnostics(context.Context, *GetDiagnosticsRequest) (*DiagnosticsResponse, error)
+	// Cache administration, removes the cached entries of a namespace for every store
+	FlushCacheNamespace(context.Context, *FlushCacheNamespaceRequest) (*FlushCacheNamespaceResponse, error)
+	// Scheduled cache invalidations and re-warms, and their run history
+	ListCacheSchedules(context.Context, *ListCacheSchedulesRequest) (*ListCacheSchedulesResponse, error)
+	ListCacheScheduleRuns(context.Context, *ListCacheScheduleRunsRequest) (*ListCacheScheduleRunsResponse, error)
+	RunCacheSchedule(context.Context, *RunCacheScheduleRequest) (*CacheScheduleRun, error)
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+// UnimplementedProductServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductServiceServer struct{}
+
+func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
+}
+func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductServiceServer) CreateBrand(context.Context, *CreateBrandRequest) (*Brand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBrand not implemented")
+}
+func (UnimplementedProductServiceServer) GetBrand(context.Context, *GetBrandRequest) (*Brand, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBrand not implemented")
+}
+func (UnimplementedProductServiceServer) ListBrands(context.Context, *ListBrandsRequest) (*ListBrandsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBrands not implemented")
+}
+func (UnimplementedProductServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedProductServiceServer) GetCategory(context.Context, *GetCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+}
+func (UnimplementedProductServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedProductServiceServer) MoveCategory(context.Context, *MoveCategoryRequest) (*Category, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedProductServiceServer) MergeCategories(context.Context, *MergeCategoriesRequest) (*MergeCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCategories not implemented")
+}
+func (UnimplementedProductServiceServer) ReorderSiblings(context.Context, *ReorderSiblingsRequest) (*ReorderSiblingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderSiblings not implemented")
+}
+func (UnimplementedProductServiceServer) CreateCategoryAttribute(context.Context, *CreateCategoryAttributeRequest) (*CategoryAttribute, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategoryAttribute not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateCategoryAttribute(context.Context, *UpdateCategoryAttributeRequest) (*CategoryAttribute, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategoryAttribute not implemented")
+}
+func (UnimplementedProductServiceServer) ListCategoryAttributes(context.Context, *ListCategoryAttributesRequest) (*ListCategoryAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategoryAttributes not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteCategoryAttribute(context.Context, *DeleteCategoryAttributeRequest) (*DeleteCategoryAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategoryAttribute not implemented")
+}
+func (UnimplementedProductServiceServer) GetCategoryFacets(context.Context, *GetCategoryFacetsRequest) (*GetCategoryFacetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryFacets not implemented")
+}
+func (UnimplementedProductServiceServer) UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteImage(context.Context, *DeleteImageRequest) (*DeleteImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImage not implemented")
+}
+func (UnimplementedProductServiceServer) CreateMediaUpload(context.Context, *CreateMediaUploadRequest) (*MediaUpload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMediaUpload not implemented")
+}
+func (UnimplementedProductServiceServer) ConfirmMediaUpload(context.Context, *ConfirmMediaUploadRequest) (*ConfirmMediaUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmMediaUpload not implemented")
+}
+func (UnimplementedProductServiceServer) AddProductVideo(context.Context, *AddProductVideoRequest) (*ProductMedia, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProductVideo not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductMedia(context.Context, *DeleteProductMediaRequest) (*DeleteProductMediaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductMedia not implemented")
+}
+func (UnimplementedProductServiceServer) GenerateSKUPreview(context.Context, *GenerateSKUPreviewRequest) (*GenerateSKUPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateSKUPreview not implemented")
+}
+func (UnimplementedProductServiceServer) CreateCollection(context.Context, *CreateCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCollection not implemented")
+}
+func (UnimplementedProductServiceServer) GetCollection(context.Context, *GetCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollection not implemented")
+}
+func (UnimplementedProductServiceServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteCollection(context.Context, *DeleteCollectionRequest) (*DeleteCollectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCollection not implemented")
+}
+func (UnimplementedProductServiceServer) SetCollectionProducts(context.Context, *SetCollectionProductsRequest) (*Collection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCollectionProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ListCollectionProducts(context.Context, *ListCollectionProductsRequest) (*ListCollectionProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollectionProducts not implemented")
+}
+func (UnimplementedProductServiceServer) CreateBundle(context.Context, *CreateBundleRequest) (*Product, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBundle not implemented")
+}
+func (UnimplementedProductServiceServer) UploadDigitalAsset(context.Context, *UploadDigitalAssetRequest) (*DigitalAsset, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadDigitalAsset not implemented")
+}
+func (UnimplementedProductServiceServer) CreateDownloadLink(context.Context, *CreateDownloadLinkRequest) (*DownloadLink, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDownloadLink not implemented")
+}
+func (UnimplementedProductServiceServer) DownloadDigitalAsset(*DownloadDigitalAssetRequest, grpc.ServerStreamingServer[DigitalAssetChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadDigitalAsset not implemented")
+}
+func (UnimplementedProductServiceServer) SetSubscriptionPlan(context.Context, *SetSubscriptionPlanRequest) (*SubscriptionPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSubscriptionPlan not implemented")
+}
+func (UnimplementedProductServiceServer) CreateSubscription(context.Context, *CreateSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedProductServiceServer) GetSubscription(context.Context, *GetSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubscription not implemented")
+}
+func (UnimplementedProductServiceServer) CancelSubscription(context.Context, *CancelSubscriptionRequest) (*Subscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSubscription not implemented")
+}
+func (UnimplementedProductServiceServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
+func (UnimplementedProductServiceServer) ListSubscriptionEvents(context.Context, *ListSubscriptionEventsRequest) (*ListSubscriptionEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptionEvents not implemented")
+}
+func (UnimplementedProductServiceServer) AckSubscriptionEvents(context.Context, *AckSubscriptionEventsRequest) (*AckSubscriptionEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckSubscriptionEvents not implemented")
+}
+func (UnimplementedProductServiceServer) SetPersonalizationOptions(context.Context, *SetPersonalizationOptionsRequest) (*PersonalizationOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPersonalizationOptions not implemented")
+}
+func (UnimplementedProductServiceServer) ValidatePersonalization(context.Context, *ValidatePersonalizationRequest) (*ValidatePersonalizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatePersonalization not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductChannels(context.Context, *SetProductChannelsRequest) (*ProductChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductChannels not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductChannels(context.Context, *GetProductChannelsRequest) (*ProductChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductChannels not implemented")
+}
+func (UnimplementedProductServiceServer) CreateStore(context.Context, *CreateStoreRequest) (*Store, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStore not implemented")
+}
+func (UnimplementedProductServiceServer) GetStore(context.Context, *GetStoreRequest) (*Store, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStore not implemented")
+}
+func (UnimplementedProductServiceServer) ListStores(context.Context, *ListStoresRequest) (*ListStoresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStores not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateStore(context.Context, *UpdateStoreRequest) (*Store, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStore not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductFeeds(context.Context, *ListProductFeedsRequest) (*ListProductFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductFeeds not implemented")
+}
+func (UnimplementedProductServiceServer) GenerateProductFeeds(context.Context, *GenerateProductFeedsRequest) (*ListProductFeedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateProductFeeds not implemented")
+}
+func (UnimplementedProductServiceServer) DownloadProductFeed(*DownloadProductFeedRequest, grpc.ServerStreamingServer[ProductFeedChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadProductFeed not implemented")
+}
+func (UnimplementedProductServiceServer) RunErpSync(context.Context, *RunErpSyncRequest) (*ListErpSyncRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunErpSync not implemented")
+}
+func (UnimplementedProductServiceServer) ListErpSyncRuns(context.Context, *ListErpSyncRunsRequest) (*ListErpSyncRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListErpSyncRuns not implemented")
+}
+func (UnimplementedProductServiceServer) BulkAdjustPrices(context.Context, *BulkAdjustPricesRequest) (*BulkAdjustPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkAdjustPrices not implemented")
+}
+func (UnimplementedProductServiceServer) RunInventoryReconciliation(context.Context, *RunInventoryReconciliationRequest) (*InventoryReconciliation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunInventoryReconciliation not implemented")
+}
+func (UnimplementedProductServiceServer) GetInventoryReconciliation(context.Context, *GetInventoryReconciliationRequest) (*InventoryReconciliation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryReconciliation not implemented")
+}
+func (UnimplementedProductServiceServer) ListInventoryReconciliations(context.Context, *ListInventoryReconciliationsRequest) (*ListInventoryReconciliationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInventoryReconciliations not implemented")
+}
+func (UnimplementedProductServiceServer) MergeProducts(context.Context, *MergeProductsRequest) (*MergeProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeProducts not implemented")
+}
+func (UnimplementedProductServiceServer) SplitVariant(context.Context, *SplitVariantRequest) (*SplitVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitVariant not implemented")
+}
+func (UnimplementedProductServiceServer) SaveImportTemplate(context.Context, *SaveImportTemplateRequest) (*ImportTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveImportTemplate not implemented")
+}
+func (UnimplementedProductServiceServer) GetImportTemplate(context.Context, *GetImportTemplateRequest) (*ImportTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportTemplate not implemented")
+}
+func (UnimplementedProductServiceServer) ListImportTemplates(context.Context, *ListImportTemplatesRequest) (*ListImportTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImportTemplates not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteImportTemplate(context.Context, *DeleteImportTemplateRequest) (*DeleteImportTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteImportTemplate not implemented")
+}
+func (UnimplementedProductServiceServer) ImportSupplierCatalog(context.Context, *ImportSupplierCatalogRequest) (*ImportSupplierCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportSupplierCatalog not implemented")
+}
+func (UnimplementedProductServiceServer) CreateProductNote(context.Context, *CreateProductNoteRequest) (*ProductNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductNote not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductNotes(context.Context, *ListProductNotesRequest) (*ListProductNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductNotes not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProductNote(context.Context, *UpdateProductNoteRequest) (*ProductNote, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductNote not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductNote(context.Context, *DeleteProductNoteRequest) (*DeleteProductNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductNote not implemented")
+}
+func (UnimplementedProductServiceServer) CreateProductRelationship(context.Context, *CreateProductRelationshipRequest) (*ProductRelationship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductRelationship not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductRelationships(context.Context, *ListProductRelationshipsRequest) (*ListProductRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductRelationships not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProductRelationship(context.Context, *UpdateProductRelationshipRequest) (*ProductRelationship, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductRelationship not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductRelationship(context.Context, *DeleteProductRelationshipRequest) (*DeleteProductRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductRelationship not implemented")
+}
+func (UnimplementedProductServiceServer) AskProductQuestion(context.Context, *AskProductQuestionRequest) (*ProductQuestion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AskProductQuestion not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductQuestion(context.Context, *GetProductQuestionRequest) (*ProductQuestion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductQuestion not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductQuestions(context.Context, *ListProductQuestionsRequest) (*ListProductQuestionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductQuestions not implemented")
+}
+func (UnimplementedProductServiceServer) ModerateProductQuestion(context.Context, *ModerateProductQuestionRequest) (*ProductQuestion, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateProductQuestion not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductQuestion(context.Context, *DeleteProductQuestionRequest) (*DeleteProductQuestionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductQuestion not implemented")
+}
+func (UnimplementedProductServiceServer) AnswerProductQuestion(context.Context, *AnswerProductQuestionRequest) (*ProductAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnswerProductQuestion not implemented")
+}
+func (UnimplementedProductServiceServer) ModerateProductAnswer(context.Context, *ModerateProductAnswerRequest) (*ProductAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateProductAnswer not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductAnswer(context.Context, *DeleteProductAnswerRequest) (*DeleteProductAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductAnswer not implemented")
+}
+func (UnimplementedProductServiceServer) UpvoteProductAnswer(context.Context, *UpvoteProductAnswerRequest) (*ProductAnswer, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpvoteProductAnswer not implemented")
+}
+func (UnimplementedProductServiceServer) SaveSearch(context.Context, *SaveSearchRequest) (*SavedSearch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSearch not implemented")
+}
+func (UnimplementedProductServiceServer) ListSavedSearches(context.Context, *ListSavedSearchesRequest) (*ListSavedSearchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedSearches not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteSavedSearch(context.Context, *DeleteSavedSearchRequest) (*DeleteSavedSearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSavedSearch not implemented")
+}
+func (UnimplementedProductServiceServer) ListSavedSearchAlerts(context.Context, *ListSavedSearchAlertsRequest) (*ListSavedSearchAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSavedSearchAlerts not implemented")
+}
+func (UnimplementedProductServiceServer) AckSavedSearchAlerts(context.Context, *AckSavedSearchAlertsRequest) (*AckSavedSearchAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AckSavedSearchAlerts not implemented")
+}
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductServiceServer) PreviewSearchRanking(context.Context, *PreviewSearchRankingRequest) (*PreviewSearchRankingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewSearchRanking not implemented")
+}
+func (UnimplementedProductServiceServer) SaveSearchRankingRule(context.Context, *SaveSearchRankingRuleRequest) (*SearchRankingRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveSearchRankingRule not implemented")
+}
+func (UnimplementedProductServiceServer) ListSearchRankingRules(context.Context, *ListSearchRankingRulesRequest) (*ListSearchRankingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSearchRankingRules not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteSearchRankingRule(context.Context, *DeleteSearchRankingRuleRequest) (*DeleteSearchRankingRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSearchRankingRule not implemented")
+}
+func (UnimplementedProductServiceServer) PublishSearchRankingRules(context.Context, *PublishSearchRankingRulesRequest) (*PublishSearchRankingRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishSearchRankingRules not implemented")
+}
+func (UnimplementedProductServiceServer) ListBadgeRules(context.Context, *ListBadgeRulesRequest) (*ListBadgeRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBadgeRules not implemented")
+}
+func (UnimplementedProductServiceServer) SaveBadgeRule(context.Context, *SaveBadgeRuleRequest) (*BadgeRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveBadgeRule not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteBadgeRule(context.Context, *DeleteBadgeRuleRequest) (*DeleteBadgeRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBadgeRule not implemented")
+}
+func (UnimplementedProductServiceServer) RecomputeBadges(context.Context, *RecomputeBadgesRequest) (*RecomputeBadgesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeBadges not implemented")
+}
+func (UnimplementedProductServiceServer) RecordProductSales(context.Context, *RecordProductSalesRequest) (*RecordProductSalesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordProductSales not implemented")
+}
+func (UnimplementedProductServiceServer) ListCatalogActivity(context.Context, *ListCatalogActivityRequest) (*ListCatalogActivityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCatalogActivity not implemented")
+}
+func (UnimplementedProductServiceServer) SetTranslation(context.Context, *SetTranslationRequest) (*Translation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTranslation not implemented")
+}
+func (UnimplementedProductServiceServer) ListTranslations(context.Context, *ListTranslationsRequest) (*ListTranslationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTranslations not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteTranslation(context.Context, *DeleteTranslationRequest) (*DeleteTranslationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTranslation not implemented")
+}
+func (UnimplementedProductServiceServer) CreateContentPage(context.Context, *CreateContentPageRequest) (*ContentPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContentPage not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateContentPage(context.Context, *UpdateContentPageRequest) (*ContentPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContentPage not implemented")
+}
+func (UnimplementedProductServiceServer) GetContentPage(context.Context, *GetContentPageRequest) (*ContentPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContentPage not implemented")
+}
+func (UnimplementedProductServiceServer) ListContentPages(context.Context, *ListContentPagesRequest) (*ListContentPagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContentPages not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteContentPage(context.Context, *DeleteContentPageRequest) (*DeleteContentPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContentPage not implemented")
+}
+func (UnimplementedProductServiceServer) CreateContentBanner(context.Context, *CreateContentBannerRequest) (*ContentBanner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateContentBanner not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateContentBanner(context.Context, *UpdateContentBannerRequest) (*ContentBanner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateContentBanner not implemented")
+}
+func (UnimplementedProductServiceServer) ListContentBanners(context.Context, *ListContentBannersRequest) (*ListContentBannersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContentBanners not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteContentBanner(context.Context, *DeleteContentBannerRequest) (*DeleteContentBannerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteContentBanner not implemented")
+}
+func (UnimplementedProductServiceServer) ListSettings(context.Context, *ListSettingsRequest) (*ListSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSettings not implemented")
+}
+func (UnimplementedProductServiceServer) SetSetting(context.Context, *SetSettingRequest) (*Setting, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSetting not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteSetting(context.Context, *DeleteSettingRequest) (*DeleteSettingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSetting not implemented")
+}
+func (UnimplementedProductServiceServer) GetStorefrontConfig(context.Context, *GetStorefrontConfigRequest) (*StorefrontConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStorefrontConfig not implemented")
+}
+func (UnimplementedProductServiceServer) GetCatalogQualityReport(context.Context, *GetCatalogQualityReportRequest) (*CatalogQualityReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogQualityReport not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductQualityScore(context.Context, *GetProductQualityScoreRequest) (*ProductQualityScore, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductQualityScore not implemented")
+}
+func (UnimplementedProductServiceServer) RecomputeCatalogQuality(context.Context, *RecomputeCatalogQualityRequest) (*RecomputeCatalogQualityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeCatalogQuality not implemented")
+}
+func (UnimplementedProductServiceServer) GetDiagnostics(context.Context, *GetDiagnosticsRequest) (*DiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiagnostics not implemented")
+}
+func (UnimplementedProductServiceServer) FlushCacheNamespace(context.Context, *FlushCacheNamespaceRequest) (*FlushCacheNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushCacheNamespace not implemented")
+}
+func (UnimplementedProductServiceServer) ListCacheSchedules(context.Context, *ListCacheSchedulesRequest) (*ListCacheSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCacheSchedules not implemented")
+}
+func (UnimplementedProductServiceServer) ListCacheScheduleRuns(context.Context, *ListCacheScheduleRunsRequest) (*ListCacheScheduleRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCacheScheduleRuns not implemented")
+}
+func (UnimplementedProductServiceServer) RunCacheSchedule(context.Context, *RunCacheScheduleRequest) (*CacheScheduleRun, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunCacheSchedule not implemented")
+}
+func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
+func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductServiceServer will
+// result in compilation errors.
+type UnsafeProductServiceServer interface {
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProductServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductService_ServiceDesc, srv)
+}
+
+func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateBrand(ctx, req.(*CreateBrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetBrand(ctx, req.(*GetBrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListBrands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBrandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListBrands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListBrands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListBrands(ctx, req.(*ListBrandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_MoveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_MergeCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).MergeCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_MergeCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).MergeCategories(ctx, req.(*MergeCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReorderSiblings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderSiblingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReorderSiblings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReorderSiblings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReorderSiblings(ctx, req.(*ReorderSiblingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateCategoryAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateCategoryAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateCategoryAttribute(ctx, req.(*CreateCategoryAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateCategoryAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateCategoryAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateCategoryAttribute(ctx, req.(*UpdateCategoryAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListCategoryAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoryAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCategoryAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListCategoryAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCategoryAttributes(ctx, req.(*ListCategoryAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteCategoryAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteCategoryAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteCategoryAttribute(ctx, req.(*DeleteCategoryAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategoryFacets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryFacetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategoryFacets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategoryFacets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategoryFacets(ctx, req.(*GetCategoryFacetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UploadImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UploadImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UploadImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UploadImage(ctx, req.(*UploadImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteImage(ctx, req.(*DeleteImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateMediaUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMediaUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateMediaUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateMediaUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateMediaUpload(ctx, req.(*CreateMediaUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ConfirmMediaUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmMediaUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ConfirmMediaUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ConfirmMediaUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ConfirmMediaUpload(ctx, req.(*ConfirmMediaUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AddProductVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AddProductVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AddProductVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AddProductVideo(ctx, req.(*AddProductVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductMedia(ctx, req.(*DeleteProductMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GenerateSKUPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateSKUPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GenerateSKUPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GenerateSKUPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GenerateSKUPreview(ctx, req.(*GenerateSKUPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateCollection(ctx, req.(*CreateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCollection(ctx, req.(*GetCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListCollections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteCollection(ctx, req.(*DeleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetCollectionProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCollectionProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetCollectionProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetCollectionProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetCollectionProducts(ctx, req.(*SetCollectionProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListCollectionProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCollectionProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListCollectionProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCollectionProducts(ctx, req.(*ListCollectionProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateBundle(ctx, req.(*CreateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UploadDigitalAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadDigitalAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UploadDigitalAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UploadDigitalAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UploadDigitalAsset(ctx, req.(*UploadDigitalAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateDownloadLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDownloadLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateDownloadLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateDownloadLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateDownloadLink(ctx, req.(*CreateDownloadLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DownloadDigitalAsset_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadDigitalAssetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).DownloadDigitalAsset(m, &grpc.GenericServerStream[DownloadDigitalAssetRequest, DigitalAssetChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_DownloadDigitalAssetServer = grpc.ServerStreamingServer[DigitalAssetChunk]
+
+func _ProductService_SetSubscriptionPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSubscriptionPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetSubscriptionPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetSubscriptionPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetSubscriptionPlan(ctx, req.(*SetSubscriptionPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateSubscription(ctx, req.(*CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetSubscription(ctx, req.(*GetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CancelSubscription(ctx, req.(*CancelSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListSubscriptionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListSubscriptionEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListSubscriptionEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListSubscriptionEvents(ctx, req.(*ListSubscriptionEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AckSubscriptionEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckSubscriptionEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AckSubscriptionEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AckSubscriptionEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AckSubscriptionEvents(ctx, req.(*AckSubscriptionEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetPersonalizationOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPersonalizationOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetPersonalizationOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetPersonalizationOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetPersonalizationOptions(ctx, req.(*SetPersonalizationOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ValidatePersonalization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidatePersonalizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ValidatePersonalization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ValidatePersonalization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ValidatePersonalization(ctx, req.(*ValidatePersonalizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetProductChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetProductChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetProductChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetProductChannels(ctx, req.(*SetProductChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductChannels(ctx, req.(*GetProductChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateStore(ctx, req.(*CreateStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetStore(ctx, req.(*GetStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListStores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListStores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListStores_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListStores(ctx, req.(*ListStoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateStore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateStore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateStore(ctx, req.(*UpdateStoreRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductFeeds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductFeeds(ctx, req.(*ListProductFeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GenerateProductFeeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateProductFeedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GenerateProductFeeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GenerateProductFeeds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GenerateProductFeeds(ctx, req.(*GenerateProductFeedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DownloadProductFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadProductFeedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).DownloadProductFeed(m, &grpc.GenericServerStream[DownloadProductFeedRequest, ProductFeedChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_DownloadProductFeedServer = grpc.ServerStreamingServer[ProductFeedChunk]
+
+func _ProductService_RunErpSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunErpSyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RunErpSync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RunErpSync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RunErpSync(ctx, req.(*RunErpSyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListErpSyncRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListErpSyncRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListErpSyncRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListErpSyncRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListErpSyncRuns(ctx, req.(*ListErpSyncRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_BulkAdjustPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkAdjustPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).BulkAdjustPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_BulkAdjustPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).BulkAdjustPrices(ctx, req.(*BulkAdjustPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RunInventoryReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunInventoryReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RunInventoryReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RunInventoryReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RunInventoryReconciliation(ctx, req.(*RunInventoryReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetInventoryReconciliation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInventoryReconciliationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetInventoryReconciliation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetInventoryReconciliation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetInventoryReconciliation(ctx, req.(*GetInventoryReconciliationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListInventoryReconciliations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInventoryReconciliationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListInventoryReconciliations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListInventoryReconciliations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListInventoryReconciliations(ctx, req.(*ListInventoryReconciliationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_MergeProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).MergeProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_MergeProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).MergeProducts(ctx, req.(*MergeProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SplitVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SplitVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SplitVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SplitVariant(ctx, req.(*SplitVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SaveImportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveImportTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SaveImportTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SaveImportTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SaveImportTemplate(ctx, req.(*SaveImportTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetImportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetImportTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetImportTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetImportTemplate(ctx, req.(*GetImportTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListImportTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImportTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListImportTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListImportTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListImportTemplates(ctx, req.(*ListImportTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteImportTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteImportTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteImportTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteImportTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteImportTemplate(ctx, req.(*DeleteImportTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportSupplierCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSupplierCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ImportSupplierCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ImportSupplierCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ImportSupplierCatalog(ctx, req.(*ImportSupplierCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateProductNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProductNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProductNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProductNote(ctx, req.(*CreateProductNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductNotes(ctx, req.(*ListProductNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProductNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProductNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProductNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProductNote(ctx, req.(*UpdateProductNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductNote(ctx, req.(*DeleteProductNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateProductRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProductRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProductRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProductRelationship(ctx, req.(*CreateProductRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductRelationships_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductRelationships(ctx, req.(*ListProductRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProductRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProductRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProductRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProductRelationship(ctx, req.(*UpdateProductRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductRelationship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductRelationship(ctx, req.(*DeleteProductRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AskProductQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskProductQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AskProductQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AskProductQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AskProductQuestion(ctx, req.(*AskProductQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductQuestion(ctx, req.(*GetProductQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductQuestions(ctx, req.(*ListProductQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ModerateProductQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateProductQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ModerateProductQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ModerateProductQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ModerateProductQuestion(ctx, req.(*ModerateProductQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductQuestion(ctx, req.(*DeleteProductQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AnswerProductQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerProductQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AnswerProductQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AnswerProductQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AnswerProductQuestion(ctx, req.(*AnswerProductQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ModerateProductAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateProductAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ModerateProductAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ModerateProductAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ModerateProductAnswer(ctx, req.(*ModerateProductAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductAnswer(ctx, req.(*DeleteProductAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpvoteProductAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpvoteProductAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpvoteProductAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpvoteProductAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpvoteProductAnswer(ctx, req.(*UpvoteProductAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SaveSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SaveSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SaveSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SaveSearch(ctx, req.(*SaveSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListSavedSearches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListSavedSearches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListSavedSearches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListSavedSearches(ctx, req.(*ListSavedSearchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteSavedSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSavedSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteSavedSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteSavedSearch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteSavedSearch(ctx, req.(*DeleteSavedSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListSavedSearchAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedSearchAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListSavedSearchAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListSavedSearchAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListSavedSearchAlerts(ctx, req.(*ListSavedSearchAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AckSavedSearchAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AckSavedSearchAlertsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AckSavedSearchAlerts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AckSavedSearchAlerts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AckSavedSearchAlerts(ctx, req.(*AckSavedSearchAlertsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PreviewSearchRanking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewSearchRankingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PreviewSearchRanking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PreviewSearchRanking_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PreviewSearchRanking(ctx, req.(*PreviewSearchRankingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SaveSearchRankingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveSearchRankingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SaveSearchRankingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SaveSearchRankingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SaveSearchRankingRule(ctx, req.(*SaveSearchRankingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListSearchRankingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSearchRankingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListSearchRankingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListSearchRankingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListSearchRankingRules(ctx, req.(*ListSearchRankingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteSearchRankingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSearchRankingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteSearchRankingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteSearchRankingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteSearchRankingRule(ctx, req.(*DeleteSearchRankingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_PublishSearchRankingRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishSearchRankingRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).PublishSearchRankingRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_PublishSearchRankingRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).PublishSearchRankingRules(ctx, req.(*PublishSearchRankingRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListBadgeRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBadgeRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListBadgeRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListBadgeRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListBadgeRules(ctx, req.(*ListBadgeRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SaveBadgeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveBadgeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SaveBadgeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SaveBadgeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SaveBadgeRule(ctx, req.(*SaveBadgeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteBadgeRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBadgeRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteBadgeRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteBadgeRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteBadgeRule(ctx, req.(*DeleteBadgeRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RecomputeBadges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeBadgesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RecomputeBadges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RecomputeBadges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RecomputeBadges(ctx, req.(*RecomputeBadgesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RecordProductSales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordProductSalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RecordProductSales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RecordProductSales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RecordProductSales(ctx, req.(*RecordProductSalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *productServiceClient) DeleteImage(ctx context.Context, in *DeleteImageRequest, opts ...grpc.CallOption) (*DeleteImageResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(DeleteImageResponse)
-	err := c.cc.Invoke(ctx, ProductService_DeleteImage_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ProductService_ListCatalogActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCatalogActivityRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCatalogActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListCatalogActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCatalogActivity(ctx, req.(*ListCatalogActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *productServiceClient) GenerateSKUPreview(ctx context.Context, in *GenerateSKUPreviewRequest, opts ...grpc.CallOption) (*GenerateSKUPreviewResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(GenerateSKUPreviewResponse)
-	err := c.cc.Invoke(ctx, ProductService_GenerateSKUPreview_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _ProductService_SetTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTranslationRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetTranslation(ctx, req.(*SetTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// ProductServiceServer is the server API for ProductService service.
-// All implementations must embed UnimplementedProductServiceServer
-// for forward compatibility.
-//
-// Service definition
-type ProductServiceServer interface {
-	CreateProduct(context.Context, *CreateProductRequest) (*Product, error)
-	GetProduct(context.Context, *GetProductRequest) (*Product, error)
-	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
-	UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error)
-	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
-	CreateBrand(context.Context, *CreateBrandRequest) (*Brand, error)
-	GetBrand(context.Context, *GetBrandRequest) (*Brand, error)
-	ListBrands(context.Context, *ListBrandsRequest) (*ListBrandsResponse, error)
-	CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error)
-	GetCategory(context.Context, *GetCategoryRequest) (*Category, error)
-	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
-	// Image upload methods
-	UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error)
-	DeleteImage(context.Context, *DeleteImageRequest) (*DeleteImageResponse, error)
-	// SKU generation methods
-	GenerateSKUPreview(context.Context, *GenerateSKUPreviewRequest) (*GenerateSKUPreviewResponse, error)
-	mustEmbedUnimplementedProductServiceServer()
+func _ProductService_ListTranslations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTranslationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListTranslations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListTranslations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListTranslations(ctx, req.(*ListTranslationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedProductServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedProductServiceServer struct{}
-
-func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*Product, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
-}
-func (UnimplementedProductServiceServer) GetProduct(context.Context, *GetProductRequest) (*Product, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProduct not implemented")
-}
-func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
-}
-func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*Product, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
-}
-func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
-}
-func (UnimplementedProductServiceServer) CreateBrand(context.Context, *CreateBrandRequest) (*Brand, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateBrand not implemented")
-}
-func (UnimplementedProductServiceServer) GetBrand(context.Context, *GetBrandRequest) (*Brand, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBrand not implemented")
-}
-func (UnimplementedProductServiceServer) ListBrands(context.Context, *ListBrandsRequest) (*ListBrandsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListBrands not implemented")
-}
-func (UnimplementedProductServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*Category, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+func _ProductService_DeleteTranslation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTranslationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteTranslation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteTranslation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteTranslation(ctx, req.(*DeleteTranslationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) GetCategory(context.Context, *GetCategoryRequest) (*Category, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategory not implemented")
+
+func _ProductService_CreateContentPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContentPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateContentPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateContentPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateContentPage(ctx, req.(*CreateContentPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+
+func _ProductService_UpdateContentPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContentPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateContentPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateContentPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateContentPage(ctx, req.(*UpdateContentPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) UploadImage(context.Context, *UploadImageRequest) (*UploadImageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UploadImage not implemented")
+
+func _ProductService_GetContentPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetContentPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetContentPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetContentPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetContentPage(ctx, req.(*GetContentPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) DeleteImage(context.Context, *DeleteImageRequest) (*DeleteImageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteImage not implemented")
+
+func _ProductService_ListContentPages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContentPagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListContentPages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListContentPages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListContentPages(ctx, req.(*ListContentPagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) GenerateSKUPreview(context.Context, *GenerateSKUPreviewRequest) (*GenerateSKUPreviewResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateSKUPreview not implemented")
+
+func _ProductService_DeleteContentPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContentPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteContentPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteContentPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteContentPage(ctx, req.(*DeleteContentPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
-func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProductServiceServer will
-// result in compilation errors.
-type UnsafeProductServiceServer interface {
-	mustEmbedUnimplementedProductServiceServer()
+func _ProductService_CreateContentBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateContentBannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateContentBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateContentBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateContentBanner(ctx, req.(*CreateContentBannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
-	// If the following call pancis, it indicates UnimplementedProductServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ProductService_UpdateContentBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateContentBannerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ProductService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateContentBanner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateContentBanner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateContentBanner(ctx, req.(*UpdateContentBannerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProductRequest)
+func _ProductService_ListContentBanners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListContentBannersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+		return srv.(ProductServiceServer).ListContentBanners(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateProduct_FullMethodName,
+		FullMethod: ProductService_ListContentBanners_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+		return srv.(ProductServiceServer).ListContentBanners(ctx, req.(*ListContentBannersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductRequest)
+func _ProductService_DeleteContentBanner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteContentBannerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetProduct(ctx, in)
+		return srv.(ProductServiceServer).DeleteContentBanner(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetProduct_FullMethodName,
+		FullMethod: ProductService_DeleteContentBanner_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetProduct(ctx, req.(*GetProductRequest))
+		return srv.(ProductServiceServer).DeleteContentBanner(ctx, req.(*DeleteContentBannerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProductsRequest)
+func _ProductService_ListSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSettingsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListProducts(ctx, in)
+		return srv.(ProductServiceServer).ListSettings(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListProducts_FullMethodName,
+		FullMethod: ProductService_ListSettings_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+		return srv.(ProductServiceServer).ListSettings(ctx, req.(*ListSettingsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _ProductService_SetSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSettingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+		return srv.(ProductServiceServer).SetSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateProduct_FullMethodName,
+		FullMethod: ProductService_SetSetting_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(ProductServiceServer).SetSetting(ctx, req.(*SetSettingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProductRequest)
+func _ProductService_DeleteSetting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSettingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
+		return srv.(ProductServiceServer).DeleteSetting(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteProduct_FullMethodName,
+		FullMethod: ProductService_DeleteSetting_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+		return srv.(ProductServiceServer).DeleteSetting(ctx, req.(*DeleteSettingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBrandRequest)
+func _ProductService_GetStorefrontConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorefrontConfigRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateBrand(ctx, in)
+		return srv.(ProductServiceServer).GetStorefrontConfig(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateBrand_FullMethodName,
+		FullMethod: ProductService_GetStorefrontConfig_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateBrand(ctx, req.(*CreateBrandRequest))
+		return srv.(ProductServiceServer).GetStorefrontConfig(ctx, req.(*GetStorefrontConfigRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBrandRequest)
+func _ProductService_GetCatalogQualityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogQualityReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetBrand(ctx, in)
+		return srv.(ProductServiceServer).GetCatalogQualityReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetBrand_FullMethodName,
+		FullMethod: ProductService_GetCatalogQualityReport_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetBrand(ctx, req.(*GetBrandRequest))
+		return srv.(ProductServiceServer).GetCatalogQualityReport(ctx, req.(*GetCatalogQualityReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListBrands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBrandsRequest)
+func _ProductService_GetProductQualityScore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductQualityScoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListBrands(ctx, in)
+		return srv.(ProductServiceServer).GetProductQualityScore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListBrands_FullMethodName,
+		FullMethod: ProductService_GetProductQualityScore_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListBrands(ctx, req.(*ListBrandsRequest))
+		return srv.(ProductServiceServer).GetProductQualityScore(ctx, req.(*GetProductQualityScoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCategoryRequest)
+func _ProductService_RecomputeCatalogQuality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeCatalogQualityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateCategory(ctx, in)
+		return srv.(ProductServiceServer).RecomputeCatalogQuality(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateCategory_FullMethodName,
+		FullMethod: ProductService_RecomputeCatalogQuality_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+		return srv.(ProductServiceServer).RecomputeCatalogQuality(ctx, req.(*RecomputeCatalogQualityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoryRequest)
+func _ProductService_GetDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiagnosticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetCategory(ctx, in)
+		return srv.(ProductServiceServer).GetDiagnostics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetCategory_FullMethodName,
+		FullMethod: ProductService_GetDiagnostics_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetCategory(ctx, req.(*GetCategoryRequest))
+		return srv.(ProductServiceServer).GetDiagnostics(ctx, req.(*GetDiagnosticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCategoriesRequest)
+func _ProductService_FlushCacheNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushCacheNamespaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListCategories(ctx, in)
+		return srv.(ProductServiceServer).FlushCacheNamespace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListCategories_FullMethodName,
+		FullMethod: ProductService_FlushCacheNamespace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+		return srv.(ProductServiceServer).FlushCacheNamespace(ctx, req.(*FlushCacheNamespaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UploadImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UploadImageRequest)
+func _ProductService_ListCacheSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCacheSchedulesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UploadImage(ctx, in)
+		return srv.(ProductServiceServer).ListCacheSchedules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UploadImage_FullMethodName,
+		FullMethod: ProductService_ListCacheSchedules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UploadImage(ctx, req.(*UploadImageRequest))
+		return srv.(ProductServiceServer).ListCacheSchedules(ctx, req.(*ListCacheSchedulesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteImageRequest)
+func _ProductService_ListCacheScheduleRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCacheScheduleRunsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteImage(ctx, in)
+		return srv.(ProductServiceServer).ListCacheScheduleRuns(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteImage_FullMethodName,
+		FullMethod: ProductService_ListCacheScheduleRuns_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteImage(ctx, req.(*DeleteImageRequest))
+		return srv.(ProductServiceServer).ListCacheScheduleRuns(ctx, req.(*ListCacheScheduleRunsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GenerateSKUPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateSKUPreviewRequest)
+func _ProductService_RunCacheSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunCacheScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GenerateSKUPreview(ctx, in)
+		return srv.(ProductServiceServer).RunCacheSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GenerateSKUPreview_FullMethodName,
+		FullMethod: ProductService_RunCacheSchedule_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GenerateSKUPreview(ctx, req.(*GenerateSKUPreviewRequest))
+		return srv.(ProductServiceServer).RunCacheSchedule(ctx, req.(*RunCacheScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -605,6 +4491,38 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCategories",
 			Handler:    _ProductService_ListCategories_Handler,
 		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _ProductService_MoveCategory_Handler,
+		},
+		{
+			MethodName: "MergeCategories",
+			Handler:    _ProductService_MergeCategories_Handler,
+		},
+		{
+			MethodName: "ReorderSiblings",
+			Handler:    _ProductService_ReorderSiblings_Handler,
+		},
+		{
+			MethodName: "CreateCategoryAttribute",
+			Handler:    _ProductService_CreateCategoryAttribute_Handler,
+		},
+		{
+			MethodName: "UpdateCategoryAttribute",
+			Handler:    _ProductService_UpdateCategoryAttribute_Handler,
+		},
+		{
+			MethodName: "ListCategoryAttributes",
+			Handler:    _ProductService_ListCategoryAttributes_Handler,
+		},
+		{
+			MethodName: "DeleteCategoryAttribute",
+			Handler:    _ProductService_DeleteCategoryAttribute_Handler,
+		},
+		{
+			MethodName: "GetCategoryFacets",
+			Handler:    _ProductService_GetCategoryFacets_Handler,
+		},
 		{
 			MethodName: "UploadImage",
 			Handler:    _ProductService_UploadImage_Handler,
@@ -613,11 +4531,430 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteImage",
 			Handler:    _ProductService_DeleteImage_Handler,
 		},
+		{
+			MethodName: "CreateMediaUpload",
+			Handler:    _ProductService_CreateMediaUpload_Handler,
+		},
+		{
+			MethodName: "ConfirmMediaUpload",
+			Handler:    _ProductService_ConfirmMediaUpload_Handler,
+		},
+		{
+			MethodName: "AddProductVideo",
+			Handler:    _ProductService_AddProductVideo_Handler,
+		},
+		{
+			MethodName: "DeleteProductMedia",
+			Handler:    _ProductService_DeleteProductMedia_Handler,
+		},
 		{
 			MethodName: "GenerateSKUPreview",
 			Handler:    _ProductService_GenerateSKUPreview_Handler,
 		},
+		{
+			MethodName: "CreateCollection",
+			Handler:    _ProductService_CreateCollection_Handler,
+		},
+		{
+			MethodName: "GetCollection",
+			Handler:    _ProductService_GetCollection_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _ProductService_ListCollections_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _ProductService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "DeleteCollection",
+			Handler:    _ProductService_DeleteCollection_Handler,
+		},
+		{
+			MethodName: "SetCollectionProducts",
+			Handler:    _ProductService_SetCollectionProducts_Handler,
+		},
+		{
+			MethodName: "ListCollectionProducts",
+			Handler:    _ProductService_ListCollectionProducts_Handler,
+		},
+		{
+			MethodName: "CreateBundle",
+			Handler:    _ProductService_CreateBundle_Handler,
+		},
+		{
+			MethodName: "UploadDigitalAsset",
+			Handler:    _ProductService_UploadDigitalAsset_Handler,
+		},
+		{
+			MethodName: "CreateDownloadLink",
+			Handler:    _ProductService_CreateDownloadLink_Handler,
+		},
+		{
+			MethodName: "SetSubscriptionPlan",
+			Handler:    _ProductService_SetSubscriptionPlan_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _ProductService_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "GetSubscription",
+			Handler:    _ProductService_GetSubscription_Handler,
+		},
+		{
+			MethodName: "CancelSubscription",
+			Handler:    _ProductService_CancelSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _ProductService_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "ListSubscriptionEvents",
+			Handler:    _ProductService_ListSubscriptionEvents_Handler,
+		},
+		{
+			MethodName: "AckSubscriptionEvents",
+			Handler:    _ProductService_AckSubscriptionEvents_Handler,
+		},
+		{
+			MethodName: "SetPersonalizationOptions",
+			Handler:    _ProductService_SetPersonalizationOptions_Handler,
+		},
+		{
+			MethodName: "ValidatePersonalization",
+			Handler:    _ProductService_ValidatePersonalization_Handler,
+		},
+		{
+			MethodName: "SetProductChannels",
+			Handler:    _ProductService_SetProductChannels_Handler,
+		},
+		{
+			MethodName: "GetProductChannels",
+			Handler:    _ProductService_GetProductChannels_Handler,
+		},
+		{
+			MethodName: "CreateStore",
+			Handler:    _ProductService_CreateStore_Handler,
+		},
+		{
+			MethodName: "GetStore",
+			Handler:    _ProductService_GetStore_Handler,
+		},
+		{
+			MethodName: "ListStores",
+			Handler:    _ProductService_ListStores_Handler,
+		},
+		{
+			MethodName: "UpdateStore",
+			Handler:    _ProductService_UpdateStore_Handler,
+		},
+		{
+			MethodName: "ListProductFeeds",
+			Handler:    _ProductService_ListProductFeeds_Handler,
+		},
+		{
+			MethodName: "GenerateProductFeeds",
+			Handler:    _ProductService_GenerateProductFeeds_Handler,
+		},
+		{
+			MethodName: "RunErpSync",
+			Handler:    _ProductService_RunErpSync_Handler,
+		},
+		{
+			MethodName: "ListErpSyncRuns",
+			Handler:    _ProductService_ListErpSyncRuns_Handler,
+		},
+		{
+			MethodName: "BulkAdjustPrices",
+			Handler:    _ProductService_BulkAdjustPrices_Handler,
+		},
+		{
+			MethodName: "RunInventoryReconciliation",
+			Handler:    _ProductService_RunInventoryReconciliation_Handler,
+		},
+		{
+			MethodName: "GetInventoryReconciliation",
+			Handler:    _ProductService_GetInventoryReconciliation_Handler,
+		},
+		{
+			MethodName: "ListInventoryReconciliations",
+			Handler:    _ProductService_ListInventoryReconciliations_Handler,
+		},
+		{
+			MethodName: "MergeProducts",
+			Handler:    _ProductService_MergeProducts_Handler,
+		},
+		{
+			MethodName: "SplitVariant",
+			Handler:    _ProductService_SplitVariant_Handler,
+		},
+		{
+			MethodName: "SaveImportTemplate",
+			Handler:    _ProductService_SaveImportTemplate_Handler,
+		},
+		{
+			MethodName: "GetImportTemplate",
+			Handler:    _ProductService_GetImportTemplate_Handler,
+		},
+		{
+			MethodName: "ListImportTemplates",
+			Handler:    _ProductService_ListImportTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteImportTemplate",
+			Handler:    _ProductService_DeleteImportTemplate_Handler,
+		},
+		{
+			MethodName: "ImportSupplierCatalog",
+			Handler:    _ProductService_ImportSupplierCatalog_Handler,
+		},
+		{
+			MethodName: "CreateProductNote",
+			Handler:    _ProductService_CreateProductNote_Handler,
+		},
+		{
+			MethodName: "ListProductNotes",
+			Handler:    _ProductService_ListProductNotes_Handler,
+		},
+		{
+			MethodName: "UpdateProductNote",
+			Handler:    _ProductService_UpdateProductNote_Handler,
+		},
+		{
+			MethodName: "DeleteProductNote",
+			Handler:    _ProductService_DeleteProductNote_Handler,
+		},
+		{
+			MethodName: "CreateProductRelationship",
+			Handler:    _ProductService_CreateProductRelationship_Handler,
+		},
+		{
+			MethodName: "ListProductRelationships",
+			Handler:    _ProductService_ListProductRelationships_Handler,
+		},
+		{
+			MethodName: "UpdateProductRelationship",
+			Handler:    _ProductService_UpdateProductRelationship_Handler,
+		},
+		{
+			MethodName: "DeleteProductRelationship",
+			Handler:    _ProductService_DeleteProductRelationship_Handler,
+		},
+		{
+			MethodName: "AskProductQuestion",
+			Handler:    _ProductService_AskProductQuestion_Handler,
+		},
+		{
+			MethodName: "GetProductQuestion",
+			Handler:    _ProductService_GetProductQuestion_Handler,
+		},
+		{
+			MethodName: "ListProductQuestions",
+			Handler:    _ProductService_ListProductQuestions_Handler,
+		},
+		{
+			MethodName: "ModerateProductQuestion",
+			Handler:    _ProductService_ModerateProductQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteProductQuestion",
+			Handler:    _ProductService_DeleteProductQuestion_Handler,
+		},
+		{
+			MethodName: "AnswerProductQuestion",
+			Handler:    _ProductService_AnswerProductQuestion_Handler,
+		},
+		{
+			MethodName: "ModerateProductAnswer",
+			Handler:    _ProductService_ModerateProductAnswer_Handler,
+		},
+		{
+			MethodName: "DeleteProductAnswer",
+			Handler:    _ProductService_DeleteProductAnswer_Handler,
+		},
+		{
+			MethodName: "UpvoteProductAnswer",
+			Handler:    _ProductService_UpvoteProductAnswer_Handler,
+		},
+		{
+			MethodName: "SaveSearch",
+			Handler:    _ProductService_SaveSearch_Handler,
+		},
+		{
+			MethodName: "ListSavedSearches",
+			Handler:    _ProductService_ListSavedSearches_Handler,
+		},
+		{
+			MethodName: "DeleteSavedSearch",
+			Handler:    _ProductService_DeleteSavedSearch_Handler,
+		},
+		{
+			MethodName: "ListSavedSearchAlerts",
+			Handler:    _ProductService_ListSavedSearchAlerts_Handler,
+		},
+		{
+			MethodName: "AckSavedSearchAlerts",
+			Handler:    _ProductService_AckSavedSearchAlerts_Handler,
+		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
+		{
+			MethodName: "PreviewSearchRanking",
+			Handler:    _ProductService_PreviewSearchRanking_Handler,
+		},
+		{
+			MethodName: "SaveSearchRankingRule",
+			Handler:    _ProductService_SaveSearchRankingRule_Handler,
+		},
+		{
+			MethodName: "ListSearchRankingRules",
+			Handler:    _ProductService_ListSearchRankingRules_Handler,
+		},
+		{
+			MethodName: "DeleteSearchRankingRule",
+			Handler:    _ProductService_DeleteSearchRankingRule_Handler,
+		},
+		{
+			MethodName: "PublishSearchRankingRules",
+			Handler:    _ProductService_PublishSearchRankingRules_Handler,
+		},
+		{
+			MethodName: "ListBadgeRules",
+			Handler:    _ProductService_ListBadgeRules_Handler,
+		},
+		{
+			MethodName: "SaveBadgeRule",
+			Handler:    _ProductService_SaveBadgeRule_Handler,
+		},
+		{
+			MethodName: "DeleteBadgeRule",
+			Handler:    _ProductService_DeleteBadgeRule_Handler,
+		},
+		{
+			MethodName: "RecomputeBadges",
+			Handler:    _ProductService_RecomputeBadges_Handler,
+		},
+		{
+			MethodName: "RecordProductSales",
+			Handler:    _ProductService_RecordProductSales_Handler,
+		},
+		{
+			MethodName: "ListCatalogActivity",
+			Handler:    _ProductService_ListCatalogActivity_Handler,
+		},
+		{
+			MethodName: "SetTranslation",
+			Handler:    _ProductService_SetTranslation_Handler,
+		},
+		{
+			MethodName: "ListTranslations",
+			Handler:    _ProductService_ListTranslations_Handler,
+		},
+		{
+			MethodName: "DeleteTranslation",
+			Handler:    _ProductService_DeleteTranslation_Handler,
+		},
+		{
+			MethodName: "CreateContentPage",
+			Handler:    _ProductService_CreateContentPage_Handler,
+		},
+		{
+			MethodName: "UpdateContentPage",
+			Handler:    _ProductService_UpdateContentPage_Handler,
+		},
+		{
+			MethodName: "GetContentPage",
+			Handler:    _ProductService_GetContentPage_Handler,
+		},
+		{
+			MethodName: "ListContentPages",
+			Handler:    _ProductService_ListContentPages_Handler,
+		},
+		{
+			MethodName: "DeleteContentPage",
+			Handler:    _ProductService_DeleteContentPage_Handler,
+		},
+		{
+			MethodName: "CreateContentBanner",
+			Handler:    _ProductService_CreateContentBanner_Handler,
+		},
+		{
+			MethodName: "UpdateContentBanner",
+			Handler:    _ProductService_UpdateContentBanner_Handler,
+		},
+		{
+			MethodName: "ListContentBanners",
+			Handler:    _ProductService_ListContentBanners_Handler,
+		},
+		{
+			MethodName: "DeleteContentBanner",
+			Handler:    _ProductService_DeleteContentBanner_Handler,
+		},
+		{
+			MethodName: "ListSettings",
+			Handler:    _ProductService_ListSettings_Handler,
+		},
+		{
+			MethodName: "SetSetting",
+			Handler:    _ProductService_SetSetting_Handler,
+		},
+		{
+			MethodName: "DeleteSetting",
+			Handler:    _ProductService_DeleteSetting_Handler,
+		},
+		{
+			MethodName: "GetStorefrontConfig",
+			Handler:    _ProductService_GetStorefrontConfig_Handler,
+		},
+		{
+			MethodName: "GetCatalogQualityReport",
+			Handler:    _ProductService_GetCatalogQualityReport_Handler,
+		},
+		{
+			MethodName: "GetProductQualityScore",
+			Handler:    _ProductService_GetProductQualityScore_Handler,
+		},
+		{
+			MethodName: "RecomputeCatalogQuality",
+			Handler:    _ProductService_RecomputeCatalogQuality_Handler,
+		},
+		{
+			MethodName: "GetDiagnostics",
+			Handler:    _ProductService_GetDiagnostics_Handler,
+		},
+		{
+			MethodName: "FlushCacheNamespace",
+			Handler:    _ProductService_FlushCacheNamespace_Handler,
+		},
+		{
+			MethodName: "ListCacheSchedules",
+			Handler:    _ProductService_ListCacheSchedules_Handler,
+		},
+		{
+			MethodName: "ListCacheScheduleRuns",
+			Handler:    _ProductService_ListCacheScheduleRuns_Handler,
+		},
+		{
+			MethodName: "RunCacheSchedule",
+			Handler:    _ProductService_RunCacheSchedule_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadDigitalAsset",
+			Handler:       _ProductService_DownloadDigitalAsset_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadProductFeed",
+			Handler:       _ProductService_DownloadProductFeed_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/product.proto",
 }
@@ -0,0 +1,82 @@
+// Package quality scores how complete the catalog entry of a product is, so
+// that merchandisers can fix the weakest products first.
+package quality
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Issues found when scoring a product, each costing the weight of the
+// criterion it fails
+const (
+	IssueNoImages         = "no_images"
+	IssueShortDescription = "short_description"
+	IssueMissingSEO       = "missing_seo"
+	IssueNoSpecifications = "no_specifications"
+	IssueOutOfStock       = "out_of_stock"
+)
+
+// Issues lists all issues, in the order they are reported
+var Issues = []string{IssueNoImages, IssueShortDescription, IssueMissingSEO, IssueNoSpecifications, IssueOutOfStock}
+
+// MaxScore is the score of a product without issues
+const MaxScore = 100
+
+// MinDescriptionLength is the number of characters below which a description
+// is too short to sell a product
+const MinDescriptionLength = 150
+
+// weights gives the points of each criterion, adding up to MaxScore
+var weights = map[string]int{
+	IssueNoImages:         25,
+	IssueShortDescription: 20,
+	IssueMissingSEO:       20,
+	IssueNoSpecifications: 15,
+	IssueOutOfStock:       20,
+}
+
+// IsIssue reports whether issue is a known issue
+func IsIssue(issue string) bool {
+	_, ok := weights[issue]
+	return ok
+}
+
+// Input holds the parts of a product that are scored
+type Input struct {
+	Images          int
+	Description     string
+	MetaTitle       string
+	MetaDescription string
+	Specifications  int
+	// InStock is set when the product can be sold right away; digital
+	// products are always in stock
+	InStock bool
+}
+
+// Result is the score of a product, from 0 to MaxScore, and the issues that
+// lowered it
+type Result struct {
+	Score  int
+	Issues []string
+}
+
+// Score scores a product
+func Score(in Input) Result {
+	failed := map[string]bool{
+		IssueNoImages:         in.Images == 0,
+		IssueShortDescription: utf8.RuneCountInString(strings.TrimSpace(in.Description)) < MinDescriptionLength,
+		IssueMissingSEO:       strings.TrimSpace(in.MetaTitle) == "" || strings.TrimSpace(in.MetaDescription) == "",
+		IssueNoSpecifications: in.Specifications == 0,
+		IssueOutOfStock:       !in.InStock,
+	}
+
+	result := Result{Score: MaxScore, Issues: []string{}}
+	for _, issue := range Issues {
+		if failed[issue] {
+			result.Score -= weights[issue]
+			result.Issues = append(result.Issues, issue)
+		}
+	}
+	return result
+}
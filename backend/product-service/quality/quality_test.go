@@ -0,0 +1,50 @@
+package quality
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestScore(t *testing.T) {
+	complete := Input{
+		Images:          3,
+		Description:     strings.Repeat("a", MinDescriptionLength),
+		MetaTitle:       "Trail Shoe",
+		MetaDescription: "A light trail running shoe",
+		Specifications:  4,
+		InStock:         true,
+	}
+
+	tests := []struct {
+		name       string
+		input      func(in Input) Input
+		wantScore  int
+		wantIssues []string
+	}{
+		{"complete", func(in Input) Input { return in }, 100, []string{}},
+		{"no images", func(in Input) Input { in.Images = 0; return in }, 75, []string{IssueNoImages}},
+		{"short description", func(in Input) Input { in.Description = "  short  "; return in }, 80, []string{IssueShortDescription}},
+		{"partial SEO", func(in Input) Input { in.MetaDescription = ""; return in }, 80, []string{IssueMissingSEO}},
+		{"empty", func(Input) Input { return Input{} }, 0, Issues},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Score(tt.input(complete))
+			if result.Score != tt.wantScore || !reflect.DeepEqual(result.Issues, tt.wantIssues) {
+				t.Errorf("Score() = %d %v, want %d %v", result.Score, result.Issues, tt.wantScore, tt.wantIssues)
+			}
+		})
+	}
+}
+
+func TestWeightsAddUpToMaxScore(t *testing.T) {
+	total := 0
+	for _, issue := range Issues {
+		total += weights[issue]
+	}
+	if total != MaxScore || len(weights) != len(Issues) {
+		t.Errorf("weights add up to %d over %d issues, want %d over %d", total, len(weights), MaxScore, len(Issues))
+	}
+}
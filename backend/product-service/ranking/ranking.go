@@ -0,0 +1,159 @@
+// Package ranking orders search results by the relevance score of the
+// search backend adjusted by the merchandising rules of the store: boosts
+// multiply the score of the products they match and pins place a product at
+// a fixed position for a query.
+package ranking
+
+import (
+	"sort"
+	"strings"
+)
+
+// Kinds of ranking rules
+const (
+	// KindBoost multiplies the score of the products of a brand, category or
+	// product by the weight of the rule
+	KindBoost = "boost"
+	// KindPin places a product at the position of the rule
+	KindPin = "pin"
+)
+
+// MaxWeight bounds the weight of boosts; weights below 1 bury products
+const MaxWeight = 100
+
+// Rule is a merchandising rule. A rule with a query only applies to the
+// searches for that query; one without applies to all searches.
+type Rule struct {
+	ID    string
+	Kind  string
+	Query string
+	// A boost matches the products of all of BrandID, CategoryID and
+	// ProductID that are set; a pin places ProductID
+	BrandID    string
+	CategoryID string
+	ProductID  string
+	Weight     float64
+	// Position is the 1-based position of pinned products
+	Position int
+}
+
+// Candidate is a product found by the search backend with its relevance to
+// the query
+type Candidate struct {
+	ProductID   string
+	BrandID     string
+	CategoryIDs []string
+	Relevance   float64
+}
+
+// Result is a ranked candidate with its score after boosts and the rules
+// that moved it
+type Result struct {
+	Candidate
+	Score float64
+	// BasePosition is the 1-based position of the candidate before ranking
+	BasePosition int
+	Pinned       bool
+	RuleIDs      []string
+}
+
+// IsKind reports whether kind is a kind of ranking rule
+func IsKind(kind string) bool {
+	return kind == KindBoost || kind == KindPin
+}
+
+// NormalizeQuery lowercases a query and collapses its spaces, so that rules
+// match the searches for the same words
+func NormalizeQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
+
+// AppliesTo reports whether the rule applies to the searches for query,
+// normalized
+func (r Rule) AppliesTo(query string) bool {
+	return r.Query == "" || NormalizeQuery(r.Query) == query
+}
+
+// Matches reports whether a boost matches a candidate
+func (r Rule) Matches(c Candidate) bool {
+	if r.BrandID != "" && r.BrandID != c.BrandID {
+		return false
+	}
+	if r.ProductID != "" && r.ProductID != c.ProductID {
+		return false
+	}
+	if r.CategoryID == "" {
+		return true
+	}
+	for _, id := range c.CategoryIDs {
+		if id == r.CategoryID {
+			return true
+		}
+	}
+	return false
+}
+
+// Rank orders the candidates of a search for query, given in the order of
+// the search backend. Boosts applying to the query multiply the relevance of
+// the candidates they match and the candidates are sorted by score, keeping
+// the backend order between equal scores; pins then move their product to
+// their position, lowest position first. Pins of products not among the
+// candidates are ignored.
+func Rank(query string, candidates []Candidate, rules []Rule) []Result {
+	query = NormalizeQuery(query)
+	results := make([]Result, len(candidates))
+	for i, c := range candidates {
+		results[i] = Result{Candidate: c, Score: c.Relevance, BasePosition: i + 1}
+	}
+
+	var pins []Rule
+	for _, rule := range rules {
+		if !rule.AppliesTo(query) {
+			continue
+		}
+		switch rule.Kind {
+		case KindBoost:
+			for i := range results {
+				if rule.Matches(results[i].Candidate) {
+					results[i].Score *= rule.Weight
+					results[i].RuleIDs = append(results[i].RuleIDs, rule.ID)
+				}
+			}
+		case KindPin:
+			pins = append(pins, rule)
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	sort.SliceStable(pins, func(i, j int) bool {
+		return pins[i].Position < pins[j].Position
+	})
+	for _, pin := range pins {
+		from := -1
+		for i := range results {
+			if results[i].ProductID == pin.ProductID {
+				from = i
+				break
+			}
+		}
+		if from < 0 || results[from].Pinned {
+			continue
+		}
+		pinned := results[from]
+		pinned.Pinned = true
+		pinned.RuleIDs = append(pinned.RuleIDs, pin.ID)
+		results = append(results[:from], results[from+1:]...)
+
+		to := pin.Position - 1
+		if to < 0 {
+			to = 0
+		}
+		if to > len(results) {
+			to = len(results)
+		}
+		results = append(results[:to], append([]Result{pinned}, results[to:]...)...)
+	}
+	return results
+}
@@ -0,0 +1,70 @@
+package ranking
+
+import (
+	"reflect"
+	"testing"
+)
+
+func productIDs(results []Result) []string {
+	ids := make([]string, len(results))
+	for i, r := range results {
+		ids[i] = r.ProductID
+	}
+	return ids
+}
+
+func TestRankBoosts(t *testing.T) {
+	candidates := []Candidate{
+		{ProductID: "a", BrandID: "acme", Relevance: 3},
+		{ProductID: "b", BrandID: "globex", CategoryIDs: []string{"laptops"}, Relevance: 2},
+		{ProductID: "c", BrandID: "globex", CategoryIDs: []string{"phones"}, Relevance: 2},
+	}
+	rules := []Rule{
+		{ID: "r1", Kind: KindBoost, BrandID: "globex", CategoryID: "laptops", Weight: 2},
+		{ID: "r2", Kind: KindBoost, Query: "tablet", BrandID: "acme", Weight: 0.1},
+	}
+
+	results := Rank("Laptop", candidates, rules)
+	if got, want := productIDs(results), []string{"b", "a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Rank = %v, want %v", got, want)
+	}
+	if results[0].Score != 4 || results[0].BasePosition != 2 || !reflect.DeepEqual(results[0].RuleIDs, []string{"r1"}) {
+		t.Errorf("boosted result = %+v", results[0])
+	}
+
+	// Boosts of other queries do not apply; equal scores keep the backend order
+	results = Rank("phone", candidates, rules[1:])
+	if got, want := productIDs(results), []string{"a", "b", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Rank without applicable rules = %v, want %v", got, want)
+	}
+	results = Rank("  TABLET ", candidates, rules[1:])
+	if got, want := productIDs(results), []string{"b", "c", "a"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Rank with a buried brand = %v, want %v", got, want)
+	}
+}
+
+func TestRankPins(t *testing.T) {
+	candidates := []Candidate{
+		{ProductID: "a", Relevance: 3},
+		{ProductID: "b", Relevance: 2},
+		{ProductID: "c", Relevance: 1},
+		{ProductID: "z"},
+	}
+	rules := []Rule{
+		{ID: "p1", Kind: KindPin, Query: "laptop", ProductID: "z", Position: 1},
+		{ID: "p2", Kind: KindPin, Query: "laptop", ProductID: "a", Position: 3},
+		{ID: "p3", Kind: KindPin, Query: "laptop", ProductID: "missing", Position: 2},
+		{ID: "p4", Kind: KindPin, Query: "laptop", ProductID: "z", Position: 10},
+	}
+
+	results := Rank("laptop", candidates, rules)
+	if got, want := productIDs(results), []string{"z", "b", "a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("Rank = %v, want %v", got, want)
+	}
+	if !results[0].Pinned || !reflect.DeepEqual(results[0].RuleIDs, []string{"p1"}) {
+		t.Errorf("pinned result = %+v", results[0])
+	}
+	if results[1].Pinned {
+		t.Errorf("unpinned result = %+v", results[1])
+	}
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/badges"
+	"github.com/louai60/e-commerce_project/backend/product-service/db"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+const badgeRuleColumns = `
+	code, label, color, kind, days, percent, product_ids::text[], priority, active, created_at, updated_at`
+
+type PostgresBadgeRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresBadgeRepository implements BadgeRepository
+var _ BadgeRepository = (*PostgresBadgeRepository)(nil)
+
+func NewBadgeRepository(db *sql.DB, logger *zap.Logger) BadgeRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresBadgeRepository{
+		db:     db,
+		logger: logger.Named("BadgeRepository"),
+	}
+}
+
+func scanBadgeRule(row interface{ Scan(...any) error }) (*models.BadgeRule, error) {
+	rule := &models.BadgeRule{}
+	err := row.Scan(&rule.Code, &rule.Label, &rule.Color, &rule.Kind, &rule.Days, &rule.Percent,
+		db.ScanArray(&rule.ProductIDs), &rule.Priority, &rule.Active, &rule.CreatedAt, &rule.UpdatedAt)
+	return rule, err
+}
+
+// ListBadgeRules returns the badge rules saved by the current store, by code
+func (r *PostgresBadgeRepository) ListBadgeRules(ctx context.Context) ([]*models.BadgeRule, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+badgeRuleColumns+`
+		FROM badge_rules
+		WHERE tenant_id = $1
+		ORDER BY code`,
+		tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("failed to list badge rules", zap.Error(err))
+		return nil, fmt.Errorf("failed to list badge rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*models.BadgeRule
+	for rows.Next() {
+		rule, err := scanBadgeRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan badge rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating badge rules: %w", err)
+	}
+	return rules, nil
+}
+
+// SaveBadgeRule creates or replaces the badge rule of its code for the
+// current store
+func (r *PostgresBadgeRepository) SaveBadgeRule(ctx context.Context, rule *models.BadgeRule) error {
+	row := r.db.QueryRowContext(ctx, `
+		INSERT INTO badge_rules (tenant_id, code, label, color, kind, days, percent, product_ids, priority, active)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8::uuid[], $9, $10)
+		ON CONFLICT (tenant_id, code) DO UPDATE SET
+			label = EXCLUDED.label,
+			color = EXCLUDED.color,
+			kind = EXCLUDED.kind,
+			days = EXCLUDED.days,
+			percent = EXCLUDED.percent,
+			product_ids = EXCLUDED.product_ids,
+			priority = EXCLUDED.priority,
+			active = EXCLUDED.active,
+			updated_at = NOW()
+		RETURNING `+badgeRuleColumns,
+		tenant.FromContext(ctx), rule.Code, rule.Label, rule.Color, rule.Kind, rule.Days, rule.Percent,
+		rule.ProductIDs, rule.Priority, rule.Active)
+
+	saved, err := scanBadgeRule(row)
+	if err != nil {
+		r.logger.Error("failed to save badge rule", zap.Error(err), zap.String("code", rule.Code))
+		return fmt.Errorf("failed to save badge rule: %w", err)
+	}
+	*rule = *saved
+	return nil
+}
+
+// DeleteBadgeRule removes the badge rule of a code of the current store
+func (r *PostgresBadgeRepository) DeleteBadgeRule(ctx context.Context, code string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM badge_rules WHERE tenant_id = $1 AND code = $2`,
+		tenant.FromContext(ctx), code)
+	if err != nil {
+		r.logger.Error("failed to delete badge rule", zap.Error(err), zap.String("code", code))
+		return fmt.Errorf("failed to delete badge rule: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return models.ErrBadgeRuleNotFound
+	}
+	return nil
+}
+
+// ListBadgeFacts returns the facts of every published product of the
+// current store at now, counting the units sold since salesSince
+func (r *PostgresBadgeRepository) ListBadgeFacts(ctx context.Context, now, salesSince time.Time) ([]badges.Facts, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT p.id, p.created_at,
+			p.discount_price IS NOT NULL OR EXISTS (
+				SELECT 1 FROM product_discounts d
+				WHERE d.product_id = p.id AND (d.expires_at IS NULL OR d.expires_at > $2)
+			),
+			COALESCE((
+				SELECT SUM(s.quantity) FROM product_sales s
+				WHERE s.product_id = p.id AND s.tenant_id = $1 AND s.sold_at >= $3
+			), 0)
+		FROM products p
+		WHERE p.tenant_id = $1 AND p.deleted_at IS NULL AND p.is_published`,
+		tenant.FromContext(ctx), now, salesSince)
+	if err != nil {
+		r.logger.Error("failed to list badge facts", zap.Error(err))
+		return nil, fmt.Errorf("failed to list badge facts: %w", err)
+	}
+	defer rows.Close()
+
+	var facts []badges.Facts
+	for rows.Next() {
+		var f badges.Facts
+		if err := rows.Scan(&f.ProductID, &f.CreatedAt, &f.OnSale, &f.UnitsSold); err != nil {
+			return nil, fmt.Errorf("failed to scan badge facts: %w", err)
+		}
+		facts = append(facts, f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating badge facts: %w", err)
+	}
+	return facts, nil
+}
+
+// ReplaceProductBadges replaces the precomputed badges of the products of
+// the current store with the badge codes of each product, in display order
+func (r *PostgresBadgeRepository) ReplaceProductBadges(ctx context.Context, assigned map[string][]string, computedAt time.Time) error {
+	var productIDs, codes []string
+	var positions []int64
+	for productID, productCodes := range assigned {
+		for i, code := range productCodes {
+			productIDs = append(productIDs, productID)
+			codes = append(codes, code)
+			positions = append(positions, int64(i))
+		}
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	if _, err := tx.ExecContext(ctx, `DELETE FROM product_badges WHERE tenant_id = $1`, tenantID); err != nil {
+		r.logger.Error("failed to clear product badges", zap.Error(err))
+		return fmt.Errorf("failed to clear product badges: %w", err)
+	}
+	// Products deleted since their facts were read are skipped
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO product_badges (product_id, tenant_id, code, position, computed_at)
+		SELECT b.product_id, $1, b.code, b.position, $5
+		FROM unnest($2::uuid[], $3::text[], $4::int[]) AS b(product_id, code, position)
+		JOIN products p ON p.id = b.product_id AND p.tenant_id = $1`,
+		tenantID, productIDs, codes, positions, computedAt)
+	if err != nil {
+		r.logger.Error("failed to save product badges", zap.Error(err))
+		return fmt.Errorf("failed to save product badges: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ListProductBadges returns the precomputed badge codes of the products of
+// the current store, in display order, by product ID
+func (r *PostgresBadgeRepository) ListProductBadges(ctx context.Context, productIDs []string) (map[string][]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT product_id, code
+		FROM product_badges
+		WHERE tenant_id = $1 AND product_id = ANY($2::uuid[])
+		ORDER BY product_id, position`,
+		tenant.FromContext(ctx), productIDs)
+	if err != nil {
+		r.logger.Error("failed to list product badges", zap.Error(err))
+		return nil, fmt.Errorf("failed to list product badges: %w", err)
+	}
+	defer rows.Close()
+
+	assigned := make(map[string][]string)
+	for rows.Next() {
+		var productID, code string
+		if err := rows.Scan(&productID, &code); err != nil {
+			return nil, fmt.Errorf("failed to scan product badge: %w", err)
+		}
+		assigned[productID] = append(assigned[productID], code)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product badges: %w", err)
+	}
+	return assigned, nil
+}
+
+// RecordProductSales records the units of the products of the current store
+// sold by an order and returns the number of lines recorded. Lines an order
+// already reported are ignored, so orders can be reported again safely.
+func (r *PostgresBadgeRepository) RecordProductSales(ctx context.Context, orderReference string, sales []models.ProductSale) (int, error) {
+	productIDs := make([]string, len(sales))
+	quantities := make([]int64, len(sales))
+	for i, sale := range sales {
+		productIDs[i] = sale.ProductID
+		quantities[i] = int64(sale.Quantity)
+	}
+
+	tenantID := tenant.FromContext(ctx)
+	var known int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM products
+		WHERE id = ANY($1::uuid[]) AND tenant_id = $2 AND deleted_at IS NULL`,
+		productIDs, tenantID).Scan(&known)
+	if err != nil {
+		r.logger.Error("failed to check sold products", zap.Error(err))
+		return 0, fmt.Errorf("failed to check sold products: %w", err)
+	}
+	if known != len(productIDs) {
+		return 0, models.ErrProductNotFound
+	}
+
+	result, err := r.db.ExecContext(ctx, `
+		INSERT INTO product_sales (tenant_id, product_id, order_reference, quantity)
+		SELECT $1, s.product_id, $2, s.quantity
+		FROM unnest($3::uuid[], $4::int[]) AS s(product_id, quantity)
+		ON CONFLICT (tenant_id, order_reference, product_id) DO NOTHING`,
+		tenantID, orderReference, productIDs, quantities)
+	if err != nil {
+		r.logger.Error("failed to record product sales", zap.Error(err), zap.String("order_reference", orderReference))
+		return 0, fmt.Errorf("failed to record product sales: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return int(n), nil
+}
@@ -0,0 +1,225 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/db"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+// PostgresImportRepository writes large catalogs with COPY FROM, which streams
+// all rows in one statement and is much faster than row by row inserts for
+// imports of hundreds of thousands of products. It skips the checks and
+// associations of CreateProduct: callers validate the rows and a failing row,
+// such as a duplicate slug or SKU, aborts the whole batch.
+type PostgresImportRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresImportRepository implements ImportRepository
+var _ ImportRepository = (*PostgresImportRepository)(nil)
+
+func NewImportRepository(db *sql.DB, logger *zap.Logger) ImportRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresImportRepository{
+		db:     db,
+		logger: logger.Named("ImportRepository"),
+	}
+}
+
+// CopyProducts inserts the products into the store of ctx with their default
+// variants, assigning their IDs and timestamps. The variants carry the price
+// and SKU of the products, which the database derives the product columns
+// from once the default variants are assigned.
+func (r *PostgresImportRepository) CopyProducts(ctx context.Context, products []*models.Product) (int, error) {
+	if len(products) == 0 {
+		return 0, nil
+	}
+	now := time.Now().UTC()
+	tenantID := tenant.FromContext(ctx)
+
+	productIDs := make([]string, len(products))
+	variantIDs := make([]string, len(products))
+	variants := make([]*models.ProductVariant, len(products))
+	for i, p := range products {
+		p.ID = uuid.NewString()
+		p.TenantID = &tenantID
+		p.CreatedAt = now
+		p.UpdatedAt = now
+		productIDs[i] = p.ID
+
+		var discountPrice *float64
+		if p.DiscountPrice != nil {
+			discountPrice = &p.DiscountPrice.Amount
+		}
+		title := p.Title
+		variants[i] = &models.ProductVariant{
+			ProductID:     p.ID,
+			SKU:           p.SKU,
+			Title:         &title,
+			Price:         p.Price.Amount,
+			DiscountPrice: discountPrice,
+		}
+	}
+
+	columns := []string{
+		"id", "title", "slug", "description", "short_description",
+		"weight", "is_published", "brand_id", "created_at", "updated_at", "tenant_id",
+	}
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		err := copyRows(ctx, tx, "products", columns, len(products), func(i int) []any {
+			p := products[i]
+			return []any{
+				p.ID, p.Title, p.Slug, p.Description, p.ShortDescription,
+				p.Weight, p.IsPublished, brandID(p), now, now, tenantID,
+			}
+		})
+		if err != nil {
+			return err
+		}
+		if err := copyVariants(ctx, tx, variants, now); err != nil {
+			return err
+		}
+		for i, v := range variants {
+			variantIDs[i] = v.ID
+		}
+
+		// Assigning the default variants derives the price and SKU columns
+		_, err = tx.Exec(ctx, `
+			UPDATE products p
+			SET default_variant_id = d.variant_id
+			FROM unnest($1::uuid[], $2::uuid[]) AS d(product_id, variant_id)
+			WHERE p.id = d.product_id`,
+			productIDs, variantIDs)
+		return err
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return 0, models.ErrProductAlreadyExists
+		}
+		r.logger.Error("failed to copy products", zap.Error(err), zap.Int("count", len(products)))
+		return 0, fmt.Errorf("failed to copy products: %w", err)
+	}
+
+	for i, p := range products {
+		p.DefaultVariantID = &variantIDs[i]
+	}
+	return len(products), nil
+}
+
+// CopyVariants inserts the variants of existing products of the store of ctx,
+// assigning their IDs and timestamps
+func (r *PostgresImportRepository) CopyVariants(ctx context.Context, variants []*models.ProductVariant) (int, error) {
+	if len(variants) == 0 {
+		return 0, nil
+	}
+	productIDs := make([]string, len(variants))
+	for i, v := range variants {
+		productIDs[i] = v.ProductID
+	}
+
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		// COPY cannot filter rows, so products of other stores are refused first
+		var foreign bool
+		if err := tx.QueryRow(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM unnest($1::uuid[]) AS v(product_id)
+				WHERE NOT EXISTS (SELECT 1 FROM products p WHERE p.id = v.product_id AND p.tenant_id = $2)
+			)`,
+			productIDs, tenant.FromContext(ctx),
+		).Scan(&foreign); err != nil {
+			return err
+		}
+		if foreign {
+			return models.ErrProductNotFound
+		}
+		return copyVariants(ctx, tx, variants, time.Now().UTC())
+	})
+	if err != nil {
+		if errors.Is(err, models.ErrProductNotFound) {
+			return 0, err
+		}
+		if isUniqueViolation(err) {
+			return 0, models.ErrVariantAlreadyExists
+		}
+		r.logger.Error("failed to copy variants", zap.Error(err), zap.Int("count", len(variants)))
+		return 0, fmt.Errorf("failed to copy variants: %w", err)
+	}
+
+	return len(variants), nil
+}
+
+// CopyProductCategories adds the products to a category
+func (r *PostgresImportRepository) CopyProductCategories(ctx context.Context, categoryID string, productIDs []string) error {
+	err := r.inTx(ctx, func(tx pgx.Tx) error {
+		return copyRows(ctx, tx, "product_categories", []string{"product_id", "category_id"}, len(productIDs), func(i int) []any {
+			return []any{productIDs[i], categoryID}
+		})
+	})
+	if err != nil {
+		r.logger.Error("failed to copy product categories", zap.Error(err), zap.Int("count", len(productIDs)))
+		return fmt.Errorf("failed to copy product categories: %w", err)
+	}
+	return nil
+}
+
+// inTx runs fn within a transaction on a pgx connection, so either all of
+// the rows it copies are stored or none
+func (r *PostgresImportRepository) inTx(ctx context.Context, fn func(tx pgx.Tx) error) error {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	return db.WithPgxConn(conn, func(c *pgx.Conn) error {
+		return pgx.BeginFunc(ctx, c, fn)
+	})
+}
+
+// copyVariants streams variants into product_variants, assigning their IDs
+// and timestamps
+func copyVariants(ctx context.Context, tx pgx.Tx, variants []*models.ProductVariant, now time.Time) error {
+	columns := []string{"id", "product_id", "sku", "title", "price", "discount_price", "created_at", "updated_at"}
+	return copyRows(ctx, tx, "product_variants", columns, len(variants), func(i int) []any {
+		v := variants[i]
+		v.ID = uuid.NewString()
+		v.CreatedAt = now
+		v.UpdatedAt = now
+		return []any{v.ID, v.ProductID, v.SKU, v.Title, v.Price, v.DiscountPrice, now, now}
+	})
+}
+
+// copyRows streams n rows into table with COPY FROM within tx. COPY sends
+// the values in binary, which pgx converts plain strings to, but not
+// pointers to strings.
+func copyRows(ctx context.Context, tx pgx.Tx, table string, columns []string, n int, row func(i int) []any) error {
+	if n == 0 {
+		return nil
+	}
+
+	_, err := tx.CopyFrom(ctx, pgx.Identifier{table}, columns, pgx.CopyFromSlice(n, func(i int) ([]any, error) {
+		return row(i), nil
+	}))
+	return err
+}
+
+// brandID returns the brand of p as a COPY value, nil without a brand
+func brandID(p *models.Product) any {
+	if p.BrandID == nil {
+		return nil
+	}
+	return *p.BrandID
+}
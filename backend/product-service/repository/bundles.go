@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresBundleRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresBundleRepository implements BundleRepository
+var _ BundleRepository = (*PostgresBundleRepository)(nil)
+
+func NewBundleRepository(db *sql.DB, logger *zap.Logger) BundleRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresBundleRepository{
+		db:     db,
+		logger: logger.Named("BundleRepository"),
+	}
+}
+
+// componentVariantsQuery resolves live variants by SKU together with whether the
+// owning product is itself a bundle. Parameters: $1 SKUs.
+const componentVariantsQuery = `
+	SELECT pv.sku, pv.id, pv.product_id, COALESCE(pv.title, p.title), pv.price,
+		EXISTS (SELECT 1 FROM product_bundles pb WHERE pb.product_id = pv.product_id)
+	FROM product_variants pv
+	JOIN products p ON p.id = pv.product_id
+	WHERE pv.sku = ANY($1::text[]) AND pv.deleted_at IS NULL AND p.deleted_at IS NULL`
+
+// CreateBundle stores the bundle details and its components, using the slice
+// order as the component position.
+func (r *PostgresBundleRepository) CreateBundle(ctx context.Context, bundle *models.ProductBundle) error {
+	now := time.Now().UTC()
+	bundle.CreatedAt = now
+	bundle.UpdatedAt = now
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO product_bundles (product_id, price_override, created_at, updated_at)
+		VALUES ($1, $2, $3, $4)`
+
+	if _, err := tx.ExecContext(ctx, query, bundle.ProductID, bundle.PriceOverride, now, now); err != nil {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return models.ErrProductNotFound
+		}
+		r.logger.Error("failed to create bundle", zap.Error(err), zap.String("product_id", bundle.ProductID))
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+
+	insertQuery := `
+		INSERT INTO bundle_components (bundle_product_id, component_sku, quantity, position, created_at)
+		VALUES ($1, $2, $3, $4, $5)`
+
+	for i := range bundle.Components {
+		component := &bundle.Components[i]
+		component.Position = i
+		if _, err := tx.ExecContext(ctx, insertQuery, bundle.ProductID, component.SKU, component.Quantity, i, now); err != nil {
+			r.logger.Error("failed to add bundle component", zap.Error(err), zap.String("sku", component.SKU))
+			return fmt.Errorf("failed to add bundle component: %w", err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// GetBundleByProductID loads a bundle with its components. Components whose SKU
+// no longer resolves to a live variant are returned without product details.
+func (r *PostgresBundleRepository) GetBundleByProductID(ctx context.Context, productID string) (*models.ProductBundle, error) {
+	bundle := &models.ProductBundle{}
+	var priceOverride sql.NullFloat64
+
+	query := `
+		SELECT product_id, price_override, created_at, updated_at
+		FROM product_bundles
+		WHERE product_id = $1`
+
+	err := r.db.QueryRowContext(ctx, query, productID).Scan(
+		&bundle.ProductID, &priceOverride, &bundle.CreatedAt, &bundle.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrBundleNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get bundle", zap.Error(err), zap.String("product_id", productID))
+		return nil, fmt.Errorf("failed to get bundle: %w", err)
+	}
+	if priceOverride.Valid {
+		bundle.PriceOverride = &priceOverride.Float64
+	}
+
+	componentsQuery := `
+		SELECT bc.component_sku, bc.quantity, bc.position,
+			COALESCE(pv.id::text, ''), COALESCE(pv.product_id::text, ''),
+			COALESCE(pv.title, p.title, ''), COALESCE(pv.price, 0)
+		FROM bundle_components bc
+		LEFT JOIN product_variants pv ON pv.sku = bc.component_sku AND pv.deleted_at IS NULL
+		LEFT JOIN products p ON p.id = pv.product_id
+		WHERE bc.bundle_product_id = $1
+		ORDER BY bc.position ASC`
+
+	rows, err := r.db.QueryContext(ctx, componentsQuery, productID)
+	if err != nil {
+		r.logger.Error("failed to get bundle components", zap.Error(err), zap.String("product_id", productID))
+		return nil, fmt.Errorf("failed to get bundle components: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var component models.BundleComponent
+		if err := rows.Scan(
+			&component.SKU, &component.Quantity, &component.Position,
+			&component.VariantID, &component.ProductID, &component.Title, &component.UnitPrice,
+		); err != nil {
+			r.logger.Error("failed to scan bundle component", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan bundle component: %w", err)
+		}
+		bundle.Components = append(bundle.Components, component)
+	}
+
+	return bundle, rows.Err()
+}
+
+// ResolveBundleComponents fills in the product details of each component from
+// its variant. It fails when a SKU does not exist or belongs to another bundle.
+func (r *PostgresBundleRepository) ResolveBundleComponents(ctx context.Context, components []models.BundleComponent) error {
+	skus := make([]string, len(components))
+	for i, component := range components {
+		skus[i] = component.SKU
+	}
+
+	rows, err := r.db.QueryContext(ctx, componentVariantsQuery, skus)
+	if err != nil {
+		r.logger.Error("failed to resolve bundle components", zap.Error(err))
+		return fmt.Errorf("failed to resolve bundle components: %w", err)
+	}
+	defer rows.Close()
+
+	type resolvedVariant struct {
+		component models.BundleComponent
+		isBundle  bool
+	}
+	resolved := make(map[string]resolvedVariant, len(components))
+	for rows.Next() {
+		var variant resolvedVariant
+		if err := rows.Scan(
+			&variant.component.SKU, &variant.component.VariantID, &variant.component.ProductID,
+			&variant.component.Title, &variant.component.UnitPrice, &variant.isBundle,
+		); err != nil {
+			r.logger.Error("failed to scan bundle component variant", zap.Error(err))
+			return fmt.Errorf("failed to scan bundle component variant: %w", err)
+		}
+		resolved[variant.component.SKU] = variant
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("failed to resolve bundle components: %w", err)
+	}
+
+	for i := range components {
+		variant, ok := resolved[components[i].SKU]
+		if !ok {
+			return fmt.Errorf("%w: %s", models.ErrBundleComponentNotFound, components[i].SKU)
+		}
+		if variant.isBundle {
+			return fmt.Errorf("%w: %s", models.ErrBundleComponentIsBundle, components[i].SKU)
+		}
+		components[i].VariantID = variant.component.VariantID
+		components[i].ProductID = variant.component.ProductID
+		components[i].Title = variant.component.Title
+		components[i].UnitPrice = variant.component.UnitPrice
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+const cacheScheduleRunColumns = `id, schedule_name, namespace, triggered_by, scheduled_for, status, invalidated,
+	warmed, failed, COALESCE(error, ''), started_at, finished_at`
+
+type PostgresCacheScheduleRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresCacheScheduleRepository implements CacheScheduleRepository
+var _ CacheScheduleRepository = (*PostgresCacheScheduleRepository)(nil)
+
+func NewCacheScheduleRepository(db *sql.DB, logger *zap.Logger) CacheScheduleRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresCacheScheduleRepository{
+		db:     db,
+		logger: logger.Named("CacheScheduleRepository"),
+	}
+}
+
+// StartCacheScheduleRun records a running run. Scheduled runs are only
+// recorded once per schedule and time; it reports whether the run was
+// recorded, false when another instance claimed it first.
+func (r *PostgresCacheScheduleRepository) StartCacheScheduleRun(ctx context.Context, run *models.CacheScheduleRun) (bool, error) {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO cache_schedule_runs (schedule_name, namespace, triggered_by, scheduled_for, status)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (schedule_name, scheduled_for) DO NOTHING
+		RETURNING id, started_at`,
+		run.ScheduleName, run.Namespace, run.Trigger, run.ScheduledFor, models.CacheRunRunning,
+	).Scan(&run.ID, &run.StartedAt)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		r.logger.Error("failed to start cache schedule run", zap.Error(err), zap.String("schedule", run.ScheduleName))
+		return false, fmt.Errorf("failed to start cache schedule run: %w", err)
+	}
+	run.Status = models.CacheRunRunning
+	return true, nil
+}
+
+// FinishCacheScheduleRun records the outcome of a run
+func (r *PostgresCacheScheduleRepository) FinishCacheScheduleRun(ctx context.Context, run *models.CacheScheduleRun) error {
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE cache_schedule_runs
+		SET status = $2, invalidated = $3, warmed = $4, failed = $5, error = NULLIF($6, ''), finished_at = NOW()
+		WHERE id = $1
+		RETURNING finished_at`,
+		run.ID, run.Status, run.Invalidated, run.Warmed, run.Failed, run.Error,
+	).Scan(&run.FinishedAt)
+	if err != nil {
+		r.logger.Error("failed to finish cache schedule run", zap.Error(err), zap.String("run_id", run.ID))
+		return fmt.Errorf("failed to finish cache schedule run: %w", err)
+	}
+	return nil
+}
+
+// ListCacheScheduleRuns returns the latest runs, of a schedule when
+// scheduleName is set
+func (r *PostgresCacheScheduleRepository) ListCacheScheduleRuns(ctx context.Context, scheduleName string, limit int) ([]*models.CacheScheduleRun, error) {
+	return r.queryRuns(ctx, `
+		SELECT `+cacheScheduleRunColumns+`
+		FROM cache_schedule_runs
+		WHERE $1 = '' OR schedule_name = $1
+		ORDER BY started_at DESC
+		LIMIT $2`,
+		scheduleName, limit)
+}
+
+// LatestCacheScheduleRuns returns the latest run of each schedule, by
+// schedule name
+func (r *PostgresCacheScheduleRepository) LatestCacheScheduleRuns(ctx context.Context) (map[string]*models.CacheScheduleRun, error) {
+	runs, err := r.queryRuns(ctx, `
+		SELECT DISTINCT ON (schedule_name) `+cacheScheduleRunColumns+`
+		FROM cache_schedule_runs
+		ORDER BY schedule_name, started_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	latest := make(map[string]*models.CacheScheduleRun, len(runs))
+	for _, run := range runs {
+		latest[run.ScheduleName] = run
+	}
+	return latest, nil
+}
+
+func (r *PostgresCacheScheduleRepository) queryRuns(ctx context.Context, query string, args ...interface{}) ([]*models.CacheScheduleRun, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("failed to list cache schedule runs", zap.Error(err))
+		return nil, fmt.Errorf("failed to list cache schedule runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*models.CacheScheduleRun
+	for rows.Next() {
+		run := &models.CacheScheduleRun{}
+		if err := rows.Scan(&run.ID, &run.ScheduleName, &run.Namespace, &run.Trigger, &run.ScheduledFor, &run.Status,
+			&run.Invalidated, &run.Warmed, &run.Failed, &run.Error, &run.StartedAt, &run.FinishedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan cache schedule run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	return runs, rows.Err()
+}
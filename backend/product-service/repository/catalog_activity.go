@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresCatalogActivityRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresCatalogActivityRepository implements CatalogActivityRepository
+var _ CatalogActivityRepository = (*PostgresCatalogActivityRepository)(nil)
+
+func NewCatalogActivityRepository(db *sql.DB, logger *zap.Logger) CatalogActivityRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresCatalogActivityRepository{
+		db:     db,
+		logger: logger.Named("CatalogActivityRepository"),
+	}
+}
+
+// RecordActivity records a change to a product of the current store, with
+// the current title of the product
+func (r *PostgresCatalogActivityRepository) RecordActivity(ctx context.Context, activity *models.CatalogActivity) error {
+	activity.TenantID = tenant.FromContext(ctx)
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO catalog_activity (tenant_id, action, product_id, product_title, actor_id, occurred_at)
+		VALUES ($1, $2, $3, COALESCE((SELECT title FROM products WHERE id = $3 AND tenant_id = $1), ''), $4, $5)
+		RETURNING id, product_title`,
+		activity.TenantID, activity.Action, activity.ProductID, activity.ActorID, activity.OccurredAt,
+	).Scan(&activity.ID, &activity.ProductTitle)
+	if err != nil {
+		r.logger.Error("failed to record catalog activity", zap.Error(err), zap.String("product_id", activity.ProductID))
+		return fmt.Errorf("failed to record catalog activity: %w", err)
+	}
+	return nil
+}
+
+// ListActivity returns up to limit changes of the current store older than
+// the entry beforeID, newest first; all the latest ones when beforeID is 0
+func (r *PostgresCatalogActivityRepository) ListActivity(ctx context.Context, beforeID int64, limit int) ([]*models.CatalogActivity, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, tenant_id, action, product_id, product_title, actor_id, occurred_at
+		FROM catalog_activity
+		WHERE tenant_id = $1 AND ($2::bigint = 0 OR id < $2)
+		ORDER BY id DESC
+		LIMIT $3`,
+		tenant.FromContext(ctx), beforeID, limit)
+	if err != nil {
+		r.logger.Error("failed to list catalog activity", zap.Error(err))
+		return nil, fmt.Errorf("failed to list catalog activity: %w", err)
+	}
+	defer rows.Close()
+
+	var entries []*models.CatalogActivity
+	for rows.Next() {
+		entry := &models.CatalogActivity{}
+		if err := rows.Scan(&entry.ID, &entry.TenantID, &entry.Action, &entry.ProductID,
+			&entry.ProductTitle, &entry.ActorID, &entry.OccurredAt); err != nil {
+			return nil, fmt.Errorf("failed to scan catalog activity: %w", err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating catalog activity: %w", err)
+	}
+	return entries, nil
+}
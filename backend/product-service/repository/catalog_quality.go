@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/db"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresCatalogQualityRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresCatalogQualityRepository implements CatalogQualityRepository
+var _ CatalogQualityRepository = (*PostgresCatalogQualityRepository)(nil)
+
+func NewCatalogQualityRepository(db *sql.DB, logger *zap.Logger) CatalogQualityRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresCatalogQualityRepository{
+		db:     db,
+		logger: logger.Named("CatalogQualityRepository"),
+	}
+}
+
+// SaveQualityScore stores the score of a product, replacing its previous one
+func (r *PostgresCatalogQualityRepository) SaveQualityScore(ctx context.Context, score *models.ProductQualityScore) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO product_quality_scores (product_id, tenant_id, score, issues, computed_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (product_id) DO UPDATE SET
+			score = EXCLUDED.score,
+			issues = EXCLUDED.issues,
+			computed_at = EXCLUDED.computed_at`,
+		score.ProductID, tenant.FromContext(ctx), score.Score, score.Issues, score.ComputedAt)
+	if err != nil {
+		r.logger.Error("failed to save quality score", zap.Error(err), zap.String("product_id", score.ProductID))
+		return fmt.Errorf("failed to save quality score: %w", err)
+	}
+	return nil
+}
+
+// DeleteQualityScore removes the score of a product, if any
+func (r *PostgresCatalogQualityRepository) DeleteQualityScore(ctx context.Context, productID string) error {
+	_, err := r.db.ExecContext(ctx, `
+		DELETE FROM product_quality_scores
+		WHERE product_id = $1 AND tenant_id = $2`,
+		productID, tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("failed to delete quality score", zap.Error(err), zap.String("product_id", productID))
+		return fmt.Errorf("failed to delete quality score: %w", err)
+	}
+	return nil
+}
+
+const qualityScoreColumns = `
+	q.product_id, p.title, p.sku, q.score, q.issues, q.computed_at`
+
+func scanQualityScore(row interface{ Scan(...interface{}) error }) (*models.ProductQualityScore, error) {
+	score := &models.ProductQualityScore{}
+	err := row.Scan(
+		&score.ProductID, &score.Title, &score.SKU, &score.Score,
+		db.ScanArray(&score.Issues), &score.ComputedAt,
+	)
+	return score, err
+}
+
+// GetQualityScore returns the score of a product of the store
+func (r *PostgresCatalogQualityRepository) GetQualityScore(ctx context.Context, productID string) (*models.ProductQualityScore, error) {
+	query := `SELECT` + qualityScoreColumns + `
+		FROM product_quality_scores q
+		JOIN products p ON p.id = q.product_id
+		WHERE q.product_id = $1 AND q.tenant_id = $2 AND p.deleted_at IS NULL`
+
+	score, err := scanQualityScore(r.db.QueryRowContext(ctx, query, productID, tenant.FromContext(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrQualityScoreNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get quality score", zap.Error(err), zap.String("product_id", productID))
+		return nil, fmt.Errorf("failed to get quality score: %w", err)
+	}
+	return score, nil
+}
+
+// ListQualityScores returns the scores matching the filter, lowest first, and
+// the number of scores matching it
+func (r *PostgresCatalogQualityRepository) ListQualityScores(ctx context.Context, filter models.QualityScoreFilter) ([]*models.ProductQualityScore, int, error) {
+	where := `
+		FROM product_quality_scores q
+		JOIN products p ON p.id = q.product_id
+		WHERE q.tenant_id = $1 AND p.deleted_at IS NULL
+			AND ($2::int IS NULL OR q.score <= $2)
+			AND ($3 = '' OR $3 = ANY(q.issues))`
+	args := []interface{}{tenant.FromContext(ctx), filter.MaxScore, filter.Issue}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*)`+where, args...).Scan(&total); err != nil {
+		r.logger.Error("failed to count quality scores", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count quality scores: %w", err)
+	}
+
+	query := `SELECT` + qualityScoreColumns + where + `
+		ORDER BY q.score, q.product_id
+		LIMIT $4 OFFSET $5`
+	rows, err := r.db.QueryContext(ctx, query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		r.logger.Error("failed to list quality scores", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to list quality scores: %w", err)
+	}
+	defer rows.Close()
+
+	var scores []*models.ProductQualityScore
+	for rows.Next() {
+		score, err := scanQualityScore(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan quality score: %w", err)
+		}
+		scores = append(scores, score)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating quality scores: %w", err)
+	}
+
+	return scores, total, nil
+}
+
+// GetQualitySummary aggregates the scores of the store's products
+func (r *PostgresCatalogQualityRepository) GetQualitySummary(ctx context.Context) (*models.CatalogQualitySummary, error) {
+	tenantID := tenant.FromContext(ctx)
+	summary := &models.CatalogQualitySummary{IssueCounts: make(map[string]int)}
+
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(q.product_id), COUNT(*) - COUNT(q.product_id), COALESCE(AVG(q.score), 0)
+		FROM products p
+		LEFT JOIN product_quality_scores q ON q.product_id = p.id
+		WHERE p.tenant_id = $1 AND p.deleted_at IS NULL`,
+		tenantID,
+	).Scan(&summary.ScoredCount, &summary.UnscoredCount, &summary.AverageScore)
+	if err != nil {
+		r.logger.Error("failed to summarize quality scores", zap.Error(err))
+		return nil, fmt.Errorf("failed to summarize quality scores: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT issue, COUNT(*)
+		FROM product_quality_scores q
+		JOIN products p ON p.id = q.product_id
+		CROSS JOIN LATERAL unnest(q.issues) AS issue
+		WHERE q.tenant_id = $1 AND p.deleted_at IS NULL
+		GROUP BY issue`,
+		tenantID)
+	if err != nil {
+		r.logger.Error("failed to count quality issues", zap.Error(err))
+		return nil, fmt.Errorf("failed to count quality issues: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var issue string
+		var count int
+		if err := rows.Scan(&issue, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan quality issue count: %w", err)
+		}
+		summary.IssueCounts[issue] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating quality issue counts: %w", err)
+	}
+
+	return summary, nil
+}
+
+// ListProductIDs returns the IDs of the store's products after afterID, in
+// ID order, to walk the catalog in pages
+func (r *PostgresCatalogQualityRepository) ListProductIDs(ctx context.Context, afterID string, limit int) ([]string, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id
+		FROM products
+		WHERE tenant_id = $1 AND deleted_at IS NULL AND ($2 = '' OR id > $2::uuid)
+		ORDER BY id
+		LIMIT $3`,
+		tenant.FromContext(ctx), afterID, limit)
+	if err != nil {
+		r.logger.Error("failed to list product IDs", zap.Error(err))
+		return nil, fmt.Errorf("failed to list product IDs: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan product ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product IDs: %w", err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/attributes"
+	"github.com/louai60/e-commerce_project/backend/product-service/db"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+// maxCategoryDepth bounds the walks up the category tree
+const maxCategoryDepth = 32
+
+type PostgresCategoryAttributeRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresCategoryAttributeRepository implements CategoryAttributeRepository
+var _ CategoryAttributeRepository = (*PostgresCategoryAttributeRepository)(nil)
+
+func NewCategoryAttributeRepository(db *sql.DB, logger *zap.Logger) CategoryAttributeRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresCategoryAttributeRepository{
+		db:     db,
+		logger: logger.Named("CategoryAttributeRepository"),
+	}
+}
+
+const categoryAttributeColumns = `ca.id, ca.category_id, ca.name, ca.required, ca.filterable, ca.allowed_values,
+		ca.default_value, ca.position, ca.created_at, ca.updated_at`
+
+func scanCategoryAttribute(row interface{ Scan(...interface{}) error }, extra ...interface{}) (*models.CategoryAttribute, error) {
+	attribute := &models.CategoryAttribute{}
+	dest := []interface{}{
+		&attribute.ID, &attribute.CategoryID, &attribute.Name, &attribute.Required, &attribute.Filterable,
+		db.ScanArray(&attribute.AllowedValues), &attribute.DefaultValue, &attribute.Position,
+		&attribute.CreatedAt, &attribute.UpdatedAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	return attribute, nil
+}
+
+// CreateAttribute adds an attribute to a category of the current store,
+// after its other attributes
+func (r *PostgresCategoryAttributeRepository) CreateAttribute(ctx context.Context, attribute *models.CategoryAttribute) error {
+	if attribute.AllowedValues == nil {
+		attribute.AllowedValues = []string{}
+	}
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO category_attributes (
+			tenant_id, category_id, name, required, filterable, allowed_values, default_value, position
+		)
+		SELECT $1, id, $3, $4, $5, $6, $7, (
+			SELECT COALESCE(MAX(position) + 1, 0) FROM category_attributes WHERE category_id = $2
+		)
+		FROM categories
+		WHERE id = $2 AND tenant_id = $1 AND deleted_at IS NULL
+		RETURNING id, position, created_at, updated_at`,
+		tenant.FromContext(ctx), attribute.CategoryID, attribute.Name, attribute.Required, attribute.Filterable,
+		attribute.AllowedValues, attribute.DefaultValue,
+	).Scan(&attribute.ID, &attribute.Position, &attribute.CreatedAt, &attribute.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrCategoryNotFound
+		}
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
+			return models.ErrCategoryAttributeExists
+		}
+		r.logger.Error("failed to create category attribute", zap.Error(err), zap.String("category_id", attribute.CategoryID))
+		return fmt.Errorf("failed to create category attribute: %w", err)
+	}
+	return nil
+}
+
+// GetAttribute retrieves an attribute of a category of the current store
+func (r *PostgresCategoryAttributeRepository) GetAttribute(ctx context.Context, id string) (*models.CategoryAttribute, error) {
+	attribute, err := scanCategoryAttribute(r.db.QueryRowContext(ctx, `
+		SELECT `+categoryAttributeColumns+`
+		FROM category_attributes ca
+		WHERE ca.id = $1 AND ca.tenant_id = $2`,
+		id, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrCategoryAttributeNotFound
+		}
+		return nil, fmt.Errorf("failed to get category attribute: %w", err)
+	}
+	return attribute, nil
+}
+
+// ListAttributes returns the attributes of a category, preceded by those of
+// its ancestors, root first, when inherited is set. Attributes are in their
+// order within each category.
+func (r *PostgresCategoryAttributeRepository) ListAttributes(ctx context.Context, categoryID string, inherited bool) ([]*models.CategoryAttribute, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id, 0 AS depth
+			FROM categories
+			WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, c.parent_id, a.depth + 1
+			FROM categories c
+			JOIN ancestors a ON c.id = a.parent_id
+			WHERE c.tenant_id = $2 AND c.deleted_at IS NULL AND $3 AND a.depth < $4
+		)
+		SELECT `+categoryAttributeColumns+`, a.depth
+		FROM category_attributes ca
+		JOIN ancestors a ON ca.category_id = a.id
+		ORDER BY a.depth DESC, ca.position, ca.created_at`,
+		categoryID, tenant.FromContext(ctx), inherited, maxCategoryDepth)
+	if err != nil {
+		r.logger.Error("failed to list category attributes", zap.Error(err), zap.String("category_id", categoryID))
+		return nil, fmt.Errorf("failed to list category attributes: %w", err)
+	}
+	defer rows.Close()
+
+	var list []*models.CategoryAttribute
+	for rows.Next() {
+		var depth int
+		attribute, err := scanCategoryAttribute(rows, &depth)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan category attribute: %w", err)
+		}
+		attribute.Depth = depth
+		list = append(list, attribute)
+	}
+	return list, rows.Err()
+}
+
+// UpdateAttribute replaces the definition of an attribute; its category and
+// position are kept
+func (r *PostgresCategoryAttributeRepository) UpdateAttribute(ctx context.Context, attribute *models.CategoryAttribute) error {
+	if attribute.AllowedValues == nil {
+		attribute.AllowedValues = []string{}
+	}
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE category_attributes
+		SET name = $3, required = $4, filterable = $5, allowed_values = $6, default_value = $7, updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2
+		RETURNING category_id, position, created_at, updated_at`,
+		attribute.ID, tenant.FromContext(ctx), attribute.Name, attribute.Required, attribute.Filterable,
+		attribute.AllowedValues, attribute.DefaultValue,
+	).Scan(&attribute.CategoryID, &attribute.Position, &attribute.CreatedAt, &attribute.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrCategoryAttributeNotFound
+		}
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
+			return models.ErrCategoryAttributeExists
+		}
+		r.logger.Error("failed to update category attribute", zap.Error(err), zap.String("id", attribute.ID))
+		return fmt.Errorf("failed to update category attribute: %w", err)
+	}
+	return nil
+}
+
+// DeleteAttribute removes an attribute from its category. The values
+// products have for it are kept.
+func (r *PostgresCategoryAttributeRepository) DeleteAttribute(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM category_attributes WHERE id = $1 AND tenant_id = $2`,
+		id, tenant.FromContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete category attribute: %w", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return models.ErrCategoryAttributeNotFound
+	}
+	return nil
+}
+
+// CountAttributeValues counts the published products of a category and its
+// descendants per value of the named attributes. Names are compared
+// ignoring case.
+func (r *PostgresCategoryAttributeRepository) CountAttributeValues(ctx context.Context, categoryID string, names []string) ([]attributes.ValueCount, error) {
+	keys := make([]string, len(names))
+	for i, name := range names {
+		keys[i] = attributes.Key(name)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		WITH RECURSIVE subtree AS (
+			SELECT id, 0 AS depth
+			FROM categories
+			WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, s.depth + 1
+			FROM categories c
+			JOIN subtree s ON c.parent_id = s.id
+			WHERE c.tenant_id = $2 AND c.deleted_at IS NULL AND s.depth < $4
+		)
+		SELECT MIN(pa.name), pa.value, COUNT(DISTINCT pa.product_id)
+		FROM product_attributes pa
+		JOIN products p ON p.id = pa.product_id
+		WHERE p.tenant_id = $2 AND p.deleted_at IS NULL AND p.is_published
+			AND LOWER(pa.name) = ANY($3)
+			AND EXISTS (
+				SELECT 1 FROM product_categories pc
+				WHERE pc.product_id = p.id AND pc.category_id IN (SELECT id FROM subtree)
+			)
+		GROUP BY LOWER(pa.name), pa.value`,
+		categoryID, tenant.FromContext(ctx), keys, maxCategoryDepth)
+	if err != nil {
+		r.logger.Error("failed to count attribute values", zap.Error(err), zap.String("category_id", categoryID))
+		return nil, fmt.Errorf("failed to count attribute values: %w", err)
+	}
+	defer rows.Close()
+
+	var counts []attributes.ValueCount
+	for rows.Next() {
+		var count attributes.ValueCount
+		if err := rows.Scan(&count.Name, &count.Value, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan attribute value count: %w", err)
+		}
+		counts = append(counts, count)
+	}
+	return counts, rows.Err()
+}
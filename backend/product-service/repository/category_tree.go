@@ -0,0 +1,395 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+// lockCategoryTree locks the categories of the tenant until the end of tx,
+// so that concurrent tree changes cannot combine into a cycle
+func lockCategoryTree(ctx context.Context, tx *sql.Tx, tenantID string) error {
+	if _, err := tx.ExecContext(ctx,
+		`SELECT id FROM categories WHERE tenant_id = $1 AND deleted_at IS NULL FOR UPDATE`,
+		tenantID,
+	); err != nil {
+		return fmt.Errorf("failed to lock category tree: %w", err)
+	}
+	return nil
+}
+
+// categoryPlacement returns the parent and position of a category
+func categoryPlacement(ctx context.Context, tx *sql.Tx, tenantID, id string) (*string, int, error) {
+	var parentID *string
+	var position int
+	err := tx.QueryRowContext(ctx,
+		`SELECT parent_id, position FROM categories WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`,
+		id, tenantID,
+	).Scan(&parentID, &position)
+	if err == sql.ErrNoRows {
+		return nil, 0, models.ErrCategoryNotFound
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get category: %w", err)
+	}
+	return parentID, position, nil
+}
+
+// isCategoryAncestor reports whether ancestorID is id or one of its ancestors
+func isCategoryAncestor(ctx context.Context, tx *sql.Tx, tenantID, ancestorID, id string) (bool, error) {
+	var found bool
+	err := tx.QueryRowContext(ctx, `
+		WITH RECURSIVE ancestors AS (
+			SELECT id, parent_id FROM categories WHERE id = $1 AND tenant_id = $2
+			UNION
+			SELECT c.id, c.parent_id
+			FROM categories c
+			JOIN ancestors a ON c.id = a.parent_id
+			WHERE c.tenant_id = $2
+		)
+		SELECT EXISTS (SELECT 1 FROM ancestors WHERE id = $3)`,
+		id, tenantID, ancestorID,
+	).Scan(&found)
+	if err != nil {
+		return false, fmt.Errorf("failed to walk category ancestors: %w", err)
+	}
+	return found, nil
+}
+
+// closeCategoryGap shifts the siblings after a category leaving its parent
+// back by one
+func closeCategoryGap(ctx context.Context, tx *sql.Tx, tenantID string, parentID *string, position int, now time.Time) error {
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE categories SET position = position - 1, updated_at = $4
+		WHERE tenant_id = $1 AND parent_id IS NOT DISTINCT FROM $2 AND position > $3 AND deleted_at IS NULL`,
+		tenantID, parentID, position, now,
+	); err != nil {
+		return fmt.Errorf("failed to reorder former siblings: %w", err)
+	}
+	return nil
+}
+
+// nextCategoryPosition returns the position after the last child of parentID
+func nextCategoryPosition(ctx context.Context, tx *sql.Tx, tenantID string, parentID *string) (int, error) {
+	var position int
+	if err := tx.QueryRowContext(ctx, `
+		SELECT COALESCE(MAX(position) + 1, 0) FROM categories
+		WHERE tenant_id = $1 AND parent_id IS NOT DISTINCT FROM $2 AND deleted_at IS NULL`,
+		tenantID, parentID,
+	).Scan(&position); err != nil {
+		return 0, fmt.Errorf("failed to get category position: %w", err)
+	}
+	return position, nil
+}
+
+// MoveCategory moves a category and its subtree under parentID, or to the
+// root when parentID is nil, after the last child of its new parent. Moving
+// a category under itself or one of its descendants fails with
+// ErrCategoryCycle. Products keep their categories.
+func (r *PostgresCategoryRepository) MoveCategory(ctx context.Context, id string, parentID *string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	now := time.Now().UTC()
+
+	if err := lockCategoryTree(ctx, tx, tenantID); err != nil {
+		return err
+	}
+	oldParentID, position, err := categoryPlacement(ctx, tx, tenantID, id)
+	if err != nil {
+		return err
+	}
+	if parentID != nil {
+		if _, _, err := categoryPlacement(ctx, tx, tenantID, *parentID); err != nil {
+			return err
+		}
+		cycle, err := isCategoryAncestor(ctx, tx, tenantID, id, *parentID)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return models.ErrCategoryCycle
+		}
+	}
+
+	// Staying under the same parent keeps the position
+	if (oldParentID == nil && parentID == nil) || (oldParentID != nil && parentID != nil && *oldParentID == *parentID) {
+		return nil
+	}
+
+	if err := closeCategoryGap(ctx, tx, tenantID, oldParentID, position, now); err != nil {
+		return err
+	}
+	next, err := nextCategoryPosition(ctx, tx, tenantID, parentID)
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE categories SET parent_id = $2, position = $3, updated_at = $4 WHERE id = $1`,
+		id, parentID, next, now,
+	); err != nil {
+		r.logger.Error("failed to move category", zap.Error(err))
+		return fmt.Errorf("failed to move category: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit category move: %w", err)
+	}
+	return nil
+}
+
+// MergeCategories moves the products and children of the source category
+// to the target in one transaction, then deletes the source. The target
+// takes the attributes of the source it does not define. Collections
+// selecting products by the source select them by the target afterwards.
+func (r *PostgresCategoryRepository) MergeCategories(ctx context.Context, targetID, sourceID string) (*models.CategoryMerge, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	now := time.Now().UTC()
+
+	if err := lockCategoryTree(ctx, tx, tenantID); err != nil {
+		return nil, err
+	}
+	if _, _, err := categoryPlacement(ctx, tx, tenantID, targetID); err != nil {
+		return nil, err
+	}
+	sourceParentID, sourcePosition, err := categoryPlacement(ctx, tx, tenantID, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	// The children of the source would otherwise end up under one of
+	// their own descendants
+	cycle, err := isCategoryAncestor(ctx, tx, tenantID, sourceID, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if cycle {
+		return nil, models.ErrCategoryCycle
+	}
+
+	merge := &models.CategoryMerge{TargetID: targetID, SourceID: sourceID}
+
+	rows, err := tx.QueryContext(ctx, `
+		DELETE FROM product_categories WHERE category_id = $1
+		RETURNING product_id`,
+		sourceID)
+	if err != nil {
+		r.logger.Error("failed to detach merged category products", zap.Error(err))
+		return nil, fmt.Errorf("failed to detach merged category products: %w", err)
+	}
+	for rows.Next() {
+		var productID string
+		if err := rows.Scan(&productID); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan merged category product: %w", err)
+		}
+		merge.ProductIDs = append(merge.ProductIDs, productID)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating merged category products: %w", err)
+	}
+	merge.ProductsMoved = len(merge.ProductIDs)
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO product_categories (product_id, category_id)
+		SELECT product_id, $1 FROM unnest($2::uuid[]) AS product_id
+		ON CONFLICT DO NOTHING`,
+		targetID, merge.ProductIDs,
+	); err != nil {
+		r.logger.Error("failed to attach products to target category", zap.Error(err))
+		return nil, fmt.Errorf("failed to attach products to target category: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE products SET category_id = $1, updated_at = $3 WHERE category_id = $2`,
+		targetID, sourceID, now,
+	); err != nil {
+		return nil, fmt.Errorf("failed to move primary category of products: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE collections SET
+			rule_category_ids = CASE
+				WHEN $1::uuid = ANY(rule_category_ids) THEN array_remove(rule_category_ids, $2::uuid)
+				ELSE array_replace(rule_category_ids, $2::uuid, $1::uuid)
+			END,
+			updated_at = $3
+		WHERE $2::uuid = ANY(rule_category_ids)`,
+		targetID, sourceID, now,
+	); err != nil {
+		return nil, fmt.Errorf("failed to repoint collection rules: %w", err)
+	}
+
+	// Children of the source go after those of the target
+	next, err := nextCategoryPosition(ctx, tx, tenantID, &targetID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := tx.ExecContext(ctx, `
+		UPDATE categories SET parent_id = $1, position = position + $3, updated_at = $4
+		WHERE parent_id = $2 AND tenant_id = $5 AND deleted_at IS NULL`,
+		targetID, sourceID, next, now, tenantID)
+	if err != nil {
+		r.logger.Error("failed to move child categories", zap.Error(err))
+		return nil, fmt.Errorf("failed to move child categories: %w", err)
+	}
+	moved, _ := result.RowsAffected()
+	merge.ChildrenMoved = int(moved)
+
+	// Attributes of the source the target does not define go after those of
+	// the target; the others are dropped
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE category_attributes SET
+			category_id = $1,
+			position = position + (SELECT COALESCE(MAX(position) + 1, 0) FROM category_attributes WHERE category_id = $1),
+			updated_at = $3
+		WHERE category_id = $2
+			AND LOWER(name) NOT IN (SELECT LOWER(name) FROM category_attributes WHERE category_id = $1)`,
+		targetID, sourceID, now,
+	); err != nil {
+		return nil, fmt.Errorf("failed to move category attributes: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM category_attributes WHERE category_id = $1`, sourceID); err != nil {
+		return nil, fmt.Errorf("failed to delete merged category attributes: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE categories SET deleted_at = $2, updated_at = $2 WHERE id = $1`,
+		sourceID, now,
+	); err != nil {
+		return nil, fmt.Errorf("failed to delete merged category: %w", err)
+	}
+	if err := closeCategoryGap(ctx, tx, tenantID, sourceParentID, sourcePosition, now); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM catalog_translations WHERE tenant_id = $1 AND entity_type = 'category' AND entity_id = $2`,
+		tenantID, sourceID,
+	); err != nil {
+		return nil, fmt.Errorf("failed to delete merged category translations: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit category merge: %w", err)
+	}
+	return merge, nil
+}
+
+// ReorderSiblings sets the order of the children of parentID, or of the root
+// categories when parentID is nil. ids must list every child exactly once.
+func (r *PostgresCategoryRepository) ReorderSiblings(ctx context.Context, parentID *string, ids []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+
+	if err := lockCategoryTree(ctx, tx, tenantID); err != nil {
+		return err
+	}
+	if parentID != nil {
+		if _, _, err := categoryPlacement(ctx, tx, tenantID, *parentID); err != nil {
+			return err
+		}
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id FROM categories
+		WHERE tenant_id = $1 AND parent_id IS NOT DISTINCT FROM $2 AND deleted_at IS NULL`,
+		tenantID, parentID)
+	if err != nil {
+		r.logger.Error("failed to list sibling categories", zap.Error(err))
+		return fmt.Errorf("failed to list sibling categories: %w", err)
+	}
+	siblings := make(map[string]bool)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan sibling category: %w", err)
+		}
+		siblings[id] = false
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating sibling categories: %w", err)
+	}
+
+	if len(ids) != len(siblings) {
+		return models.ErrCategorySiblings
+	}
+	for _, id := range ids {
+		listed, ok := siblings[id]
+		if !ok || listed {
+			return models.ErrCategorySiblings
+		}
+		siblings[id] = true
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE categories c SET position = o.position - 1, updated_at = $3
+		FROM unnest($2::uuid[]) WITH ORDINALITY AS o(id, position)
+		WHERE c.id = o.id AND c.tenant_id = $1`,
+		tenantID, ids, time.Now().UTC(),
+	); err != nil {
+		r.logger.Error("failed to reorder categories", zap.Error(err))
+		return fmt.Errorf("failed to reorder categories: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit category order: %w", err)
+	}
+	return nil
+}
+
+// ListChildCategories returns the children of parentID, or the root
+// categories when parentID is nil, in their order
+func (r *PostgresCategoryRepository) ListChildCategories(ctx context.Context, parentID *string) ([]*models.Category, error) {
+	rows, err := r.db.QueryContext(ctx, `
+        SELECT c.id, c.name, c.slug, c.description, c.parent_id, c.position, c.created_at, c.updated_at, c.deleted_at,
+               p.name as parent_name
+        FROM categories c
+        LEFT JOIN categories p ON c.parent_id = p.id
+        WHERE c.tenant_id = $1 AND c.parent_id IS NOT DISTINCT FROM $2 AND c.deleted_at IS NULL
+        ORDER BY c.position, c.created_at`,
+		tenant.FromContext(ctx), parentID)
+	if err != nil {
+		r.logger.Error("failed to list child categories", zap.Error(err))
+		return nil, fmt.Errorf("failed to list child categories: %w", err)
+	}
+	defer rows.Close()
+
+	var categories []*models.Category
+	for rows.Next() {
+		category := &models.Category{}
+		var parentName sql.NullString
+		if err := rows.Scan(
+			&category.ID, &category.Name, &category.Slug, &category.Description,
+			&category.ParentID, &category.Position, &category.CreatedAt, &category.UpdatedAt, &category.DeletedAt,
+			&parentName,
+		); err != nil {
+			r.logger.Error("failed to scan category", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan category: %w", err)
+		}
+		if parentName.Valid {
+			category.ParentName = parentName.String
+		}
+		categories = append(categories, category)
+	}
+	return categories, rows.Err()
+}
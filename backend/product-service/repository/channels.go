@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresChannelRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresChannelRepository implements ChannelRepository
+var _ ChannelRepository = (*PostgresChannelRepository)(nil)
+
+func NewChannelRepository(db *sql.DB, logger *zap.Logger) ChannelRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresChannelRepository{
+		db:     db,
+		logger: logger.Named("ChannelRepository"),
+	}
+}
+
+// SetProductChannels saves the visibility of a product in the given channels.
+// Channels not passed keep their current visibility.
+func (r *PostgresChannelRepository) SetProductChannels(ctx context.Context, productID string, channels []models.ProductChannel) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO product_channels (product_id, channel, is_visible, updated_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (product_id, channel) DO UPDATE SET
+			is_visible = EXCLUDED.is_visible,
+			updated_at = EXCLUDED.updated_at`
+
+	now := time.Now().UTC()
+	for i := range channels {
+		if _, err := tx.ExecContext(ctx, query, productID, channels[i].Channel, channels[i].IsVisible, now); err != nil {
+			if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.ForeignKeyViolation {
+				return models.ErrProductNotFound
+			}
+			r.logger.Error("failed to save product channel", zap.Error(err),
+				zap.String("product_id", productID),
+				zap.String("channel", channels[i].Channel))
+			return fmt.Errorf("failed to save product channel: %w", err)
+		}
+		channels[i].ProductID = productID
+		channels[i].UpdatedAt = now
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetProductChannels returns the visibility of a product in every channel,
+// including the channels it has no explicit setting for
+func (r *PostgresChannelRepository) GetProductChannels(ctx context.Context, productID string) ([]models.ProductChannel, error) {
+	query := `
+		SELECT channel, is_visible, updated_at
+		FROM product_channels
+		WHERE product_id = $1`
+
+	rows, err := r.db.QueryContext(ctx, query, productID)
+	if err != nil {
+		r.logger.Error("failed to get product channels", zap.Error(err), zap.String("product_id", productID))
+		return nil, fmt.Errorf("failed to get product channels: %w", err)
+	}
+	defer rows.Close()
+
+	saved := make(map[string]models.ProductChannel)
+	for rows.Next() {
+		var channel models.ProductChannel
+		if err := rows.Scan(&channel.Channel, &channel.IsVisible, &channel.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan product channel: %w", err)
+		}
+		channel.ProductID = productID
+		saved[channel.Channel] = channel
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product channels: %w", err)
+	}
+
+	channels := make([]models.ProductChannel, 0, len(models.Channels))
+	for _, name := range models.Channels {
+		channel, ok := saved[name]
+		if !ok {
+			channel = models.ProductChannel{ProductID: productID, Channel: name, IsVisible: true}
+		}
+		channels = append(channels, channel)
+	}
+
+	return channels, nil
+}
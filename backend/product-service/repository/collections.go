@@ -0,0 +1,482 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/db"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresCollectionRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresCollectionRepository implements CollectionRepository
+var _ CollectionRepository = (*PostgresCollectionRepository)(nil)
+
+func NewCollectionRepository(db *sql.DB, logger *zap.Logger) CollectionRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresCollectionRepository{
+		db:     db,
+		logger: logger.Named("CollectionRepository"),
+	}
+}
+
+const collectionColumns = `
+	id, name, slug, description, image_url, is_published,
+	rule_brand_ids, rule_category_ids, rule_tags,
+	created_at, updated_at, deleted_at`
+
+// collectionMembersQuery selects the published members of a collection: manually
+// curated products first (by position), followed by products matching the rules.
+// Parameters: $1 collection ID, $2 rules enabled, $3 brand IDs, $4 category IDs, $5 tags,
+// $6 store of the collection.
+const collectionMembersQuery = `
+	SELECT cp.product_id AS id, 0 AS grp, cp.position AS pos, p.created_at
+	FROM collection_products cp
+	JOIN products p ON p.id = cp.product_id
+	WHERE cp.collection_id = $1 AND p.tenant_id = $6 AND p.deleted_at IS NULL AND p.is_published = TRUE
+	UNION ALL
+	SELECT p.id, 1 AS grp, 0 AS pos, p.created_at
+	FROM products p
+	WHERE $2 AND p.tenant_id = $6 AND p.deleted_at IS NULL AND p.is_published = TRUE
+		AND NOT EXISTS (
+			SELECT 1 FROM collection_products cp
+			WHERE cp.collection_id = $1 AND cp.product_id = p.id
+		)
+		AND (cardinality($3::uuid[]) = 0 OR p.brand_id = ANY($3::uuid[]))
+		AND (cardinality($4::uuid[]) = 0 OR EXISTS (
+			SELECT 1 FROM product_categories pc
+			WHERE pc.product_id = p.id AND pc.category_id = ANY($4::uuid[])
+		))
+		AND (cardinality($5::text[]) = 0 OR EXISTS (
+			SELECT 1 FROM product_tags pt
+			WHERE pt.product_id = p.id AND pt.tag = ANY($5::text[])
+		))`
+
+func scanCollection(scanner interface{ Scan(...interface{}) error }, collection *models.Collection) error {
+	var description, imageURL sql.NullString
+	var brandIDs, categoryIDs, tags []string
+	err := scanner.Scan(
+		&collection.ID, &collection.Name, &collection.Slug, &description, &imageURL,
+		&collection.IsPublished, db.ScanArray(&brandIDs), db.ScanArray(&categoryIDs), db.ScanArray(&tags),
+		&collection.CreatedAt, &collection.UpdatedAt, &collection.DeletedAt,
+	)
+	if err != nil {
+		return err
+	}
+	collection.Description = description.String
+	collection.ImageURL = imageURL.String
+	collection.Rules = models.CollectionRules{
+		BrandIDs:    brandIDs,
+		CategoryIDs: categoryIDs,
+		Tags:        tags,
+	}
+	return nil
+}
+
+// CreateCollection creates a collection with its manually curated members,
+// in one transaction
+func (r *PostgresCollectionRepository) CreateCollection(ctx context.Context, collection *models.Collection) error {
+	now := time.Now().UTC()
+	collection.CreatedAt = now
+	collection.UpdatedAt = now
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO collections (
+			name, slug, description, image_url, is_published,
+			rule_brand_ids, rule_category_ids, rule_tags, created_at, updated_at, tenant_id
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING id`
+
+	err = tx.QueryRowContext(
+		ctx, query,
+		collection.Name, collection.Slug, collection.Description, collection.ImageURL, collection.IsPublished,
+		nonNilStrings(collection.Rules.BrandIDs),
+		nonNilStrings(collection.Rules.CategoryIDs),
+		nonNilStrings(collection.Rules.Tags),
+		now, now, tenant.FromContext(ctx),
+	).Scan(&collection.ID)
+
+	if err != nil {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
+			return models.ErrCollectionSlugExists
+		}
+		r.logger.Error("failed to create collection", zap.Error(err))
+		return fmt.Errorf("failed to create collection: %w", err)
+	}
+
+	if err := r.addCollectionProducts(ctx, tx, collection.ID, collection.ProductIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresCollectionRepository) GetCollectionByID(ctx context.Context, id string) (*models.Collection, error) {
+	query := `SELECT` + collectionColumns + `
+		FROM collections
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	return r.getCollection(ctx, query, id)
+}
+
+func (r *PostgresCollectionRepository) GetCollectionBySlug(ctx context.Context, slug string) (*models.Collection, error) {
+	query := `SELECT` + collectionColumns + `
+		FROM collections
+		WHERE slug = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	return r.getCollection(ctx, query, slug)
+}
+
+func (r *PostgresCollectionRepository) getCollection(ctx context.Context, query string, arg string) (*models.Collection, error) {
+	collection := &models.Collection{}
+	err := scanCollection(r.db.QueryRowContext(ctx, query, arg, tenant.FromContext(ctx)), collection)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrCollectionNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get collection", zap.Error(err))
+		return nil, fmt.Errorf("failed to get collection: %w", err)
+	}
+
+	productIDs, err := r.getManualProductIDs(ctx, collection.ID)
+	if err != nil {
+		return nil, err
+	}
+	collection.ProductIDs = productIDs
+
+	return collection, nil
+}
+
+func (r *PostgresCollectionRepository) getManualProductIDs(ctx context.Context, collectionID string) ([]string, error) {
+	query := `
+		SELECT product_id
+		FROM collection_products
+		WHERE collection_id = $1
+		ORDER BY position ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, collectionID)
+	if err != nil {
+		r.logger.Error("failed to get collection products", zap.Error(err), zap.String("collection_id", collectionID))
+		return nil, fmt.Errorf("failed to get collection products: %w", err)
+	}
+	defer rows.Close()
+
+	var productIDs []string
+	for rows.Next() {
+		var productID string
+		if err := rows.Scan(&productID); err != nil {
+			r.logger.Error("failed to scan collection product", zap.Error(err))
+			return nil, fmt.Errorf("failed to scan collection product: %w", err)
+		}
+		productIDs = append(productIDs, productID)
+	}
+
+	return productIDs, rows.Err()
+}
+
+func (r *PostgresCollectionRepository) ListCollections(ctx context.Context, offset, limit int, publishedOnly bool) ([]*models.Collection, int, error) {
+	var total int
+	countQuery := `
+		SELECT COUNT(*) FROM collections
+		WHERE tenant_id = $1 AND deleted_at IS NULL AND ($2 = FALSE OR is_published = TRUE)`
+	if err := r.db.QueryRowContext(ctx, countQuery, tenant.FromContext(ctx), publishedOnly).Scan(&total); err != nil {
+		r.logger.Error("failed to count collections", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count collections: %w", err)
+	}
+
+	query := `SELECT` + collectionColumns + `
+		FROM collections
+		WHERE tenant_id = $1 AND deleted_at IS NULL AND ($2 = FALSE OR is_published = TRUE)
+		ORDER BY created_at DESC
+		LIMIT $3 OFFSET $4`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), publishedOnly, limit, offset)
+	if err != nil {
+		r.logger.Error("failed to list collections", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to list collections: %w", err)
+	}
+	defer rows.Close()
+
+	var collections []*models.Collection
+	for rows.Next() {
+		collection := &models.Collection{}
+		if err := scanCollection(rows, collection); err != nil {
+			r.logger.Error("failed to scan collection", zap.Error(err))
+			return nil, 0, fmt.Errorf("failed to scan collection: %w", err)
+		}
+		collections = append(collections, collection)
+	}
+
+	return collections, total, rows.Err()
+}
+
+func (r *PostgresCollectionRepository) UpdateCollection(ctx context.Context, collection *models.Collection) error {
+	collection.UpdatedAt = time.Now().UTC()
+
+	query := `
+		UPDATE collections SET
+			name = $2, slug = $3, description = $4, image_url = $5, is_published = $6,
+			rule_brand_ids = $7, rule_category_ids = $8, rule_tags = $9, updated_at = $10
+		WHERE id = $1 AND tenant_id = $11 AND deleted_at IS NULL`
+
+	result, err := r.db.ExecContext(
+		ctx, query,
+		collection.ID, collection.Name, collection.Slug, collection.Description, collection.ImageURL,
+		collection.IsPublished,
+		nonNilStrings(collection.Rules.BrandIDs),
+		nonNilStrings(collection.Rules.CategoryIDs),
+		nonNilStrings(collection.Rules.Tags),
+		collection.UpdatedAt, tenant.FromContext(ctx),
+	)
+	if err != nil {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
+			return models.ErrCollectionSlugExists
+		}
+		r.logger.Error("failed to update collection", zap.Error(err))
+		return fmt.Errorf("failed to update collection: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return models.ErrCollectionNotFound
+	}
+
+	return nil
+}
+
+func (r *PostgresCollectionRepository) DeleteCollection(ctx context.Context, id string) error {
+	query := `
+		UPDATE collections
+		SET deleted_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query, id, tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("failed to delete collection", zap.Error(err))
+		return fmt.Errorf("failed to delete collection: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return models.ErrCollectionNotFound
+	}
+
+	return nil
+}
+
+// SetCollectionProducts replaces the manually curated members of a collection,
+// using the slice order as the display position. Members are products of the
+// store of the collection.
+func (r *PostgresCollectionRepository) SetCollectionProducts(ctx context.Context, collectionID string, productIDs []string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	result, err := tx.ExecContext(ctx, `
+		UPDATE collections SET updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`,
+		collectionID, tenantID)
+	if err != nil {
+		return fmt.Errorf("failed to touch collection: %w", err)
+	}
+	if rowsAffected, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	} else if rowsAffected == 0 {
+		return models.ErrCollectionNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection_products WHERE collection_id = $1`, collectionID); err != nil {
+		r.logger.Error("failed to clear collection products", zap.Error(err))
+		return fmt.Errorf("failed to clear collection products: %w", err)
+	}
+
+	if err := r.addCollectionProducts(ctx, tx, collectionID, productIDs); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// addCollectionProducts adds products of the current store to a collection
+// within tx, using the slice order as the display position
+func (r *PostgresCollectionRepository) addCollectionProducts(ctx context.Context, tx *sql.Tx, collectionID string, productIDs []string) error {
+	insertQuery := `
+		INSERT INTO collection_products (collection_id, product_id, position)
+		SELECT $1::uuid, id, $3::int FROM products
+		WHERE id = $2 AND tenant_id = $4
+		ON CONFLICT (collection_id, product_id) DO NOTHING`
+
+	added := make(map[string]bool, len(productIDs))
+	for i, productID := range productIDs {
+		result, err := tx.ExecContext(ctx, insertQuery, collectionID, productID, i, tenant.FromContext(ctx))
+		if err != nil {
+			r.logger.Error("failed to add collection product", zap.Error(err), zap.String("product_id", productID))
+			return fmt.Errorf("failed to add collection product: %w", err)
+		}
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		// Nothing is added for products listed twice, or of another store
+		if rowsAffected == 0 && !added[productID] {
+			return models.ErrProductNotFound
+		}
+		added[productID] = true
+	}
+	return nil
+}
+
+// ListCollectionProducts returns a page of published member products for a
+// collection along with the total member count. The page is loaded in one
+// query with what listings show: the brand and images of each product, and
+// its price and SKU, those of its default variant or, for bundles, the price
+// of the bundle. Variants and other details are left to GetProduct.
+func (r *PostgresCollectionRepository) ListCollectionProducts(ctx context.Context, collection *models.Collection, offset, limit int) ([]*models.Product, int, error) {
+	args := []interface{}{
+		collection.ID,
+		!collection.Rules.IsEmpty(),
+		nonNilStrings(collection.Rules.BrandIDs),
+		nonNilStrings(collection.Rules.CategoryIDs),
+		nonNilStrings(collection.Rules.Tags),
+		tenant.FromContext(ctx),
+	}
+
+	var total int
+	countQuery := `SELECT COUNT(*) FROM (` + collectionMembersQuery + `) members`
+	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		r.logger.Error("failed to count collection members", zap.Error(err), zap.String("collection_id", collection.ID))
+		return nil, 0, fmt.Errorf("failed to count collection members: %w", err)
+	}
+
+	// The default variant is the one set on the product, else the first one
+	query := `
+		SELECT
+			p.id, p.title, p.slug, p.description, p.short_description,
+			p.weight, p.is_published, p.created_at, p.updated_at,
+			p.brand_id, p.default_variant_id, p.metadata, b.id, b.name, b.slug,
+			COALESCE(dv.sku, p.sku), COALESCE(dv.price, p.price),
+			CASE WHEN dv.id IS NULL THEN p.discount_price ELSE dv.discount_price END,
+			pb.product_id IS NOT NULL, COALESCE(pb.price_override, bc.price),
+			COALESCE(img.images, '[]')
+		FROM (
+			SELECT id, grp, pos, created_at FROM (` + collectionMembersQuery + `) members
+			ORDER BY grp ASC, pos ASC, created_at DESC
+			LIMIT $7 OFFSET $8
+		) m
+		JOIN products p ON p.id = m.id
+		LEFT JOIN brands b ON b.id = p.brand_id AND b.deleted_at IS NULL
+		LEFT JOIN LATERAL (
+			SELECT pv.id, pv.sku, pv.price, pv.discount_price
+			FROM product_variants pv
+			WHERE pv.product_id = p.id AND pv.deleted_at IS NULL
+			ORDER BY (pv.id = p.default_variant_id) IS TRUE DESC, pv.created_at
+			LIMIT 1
+		) dv ON TRUE
+		LEFT JOIN product_bundles pb ON pb.product_id = p.id
+		LEFT JOIN LATERAL (
+			SELECT ROUND(SUM(COALESCE(pv.price, 0) * c.quantity), 2) AS price
+			FROM bundle_components c
+			LEFT JOIN product_variants pv ON pv.sku = c.component_sku AND pv.deleted_at IS NULL
+			WHERE c.bundle_product_id = pb.product_id
+		) bc ON TRUE
+		LEFT JOIN LATERAL (
+			SELECT json_agg(i ORDER BY i.position) AS images
+			FROM product_images i
+			WHERE i.product_id = p.id
+		) img ON TRUE
+		ORDER BY m.grp ASC, m.pos ASC, m.created_at DESC`
+
+	rows, err := r.db.QueryContext(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		r.logger.Error("failed to list collection members", zap.Error(err), zap.String("collection_id", collection.ID))
+		return nil, 0, fmt.Errorf("failed to list collection members: %w", err)
+	}
+	defer rows.Close()
+
+	var products []*models.Product
+	for rows.Next() {
+		product, err := scanCollectionProduct(rows)
+		if err != nil {
+			r.logger.Error("failed to scan collection member", zap.Error(err))
+			return nil, 0, fmt.Errorf("failed to scan collection member: %w", err)
+		}
+		products = append(products, product)
+	}
+
+	return products, total, rows.Err()
+}
+
+// scanCollectionProduct scans a product row of ListCollectionProducts
+func scanCollectionProduct(rows *sql.Rows) (*models.Product, error) {
+	product := &models.Product{}
+	var brandID, brandName, brandSlug sql.NullString
+	var price float64
+	var discountPrice, bundlePrice sql.NullFloat64
+	var isBundle bool
+	var images []byte
+	err := rows.Scan(
+		&product.ID, &product.Title, &product.Slug, &product.Description, &product.ShortDescription,
+		&product.Weight, &product.IsPublished, &product.CreatedAt, &product.UpdatedAt,
+		&product.BrandID, &product.DefaultVariantID, &product.Metadata, &brandID, &brandName, &brandSlug,
+		&product.SKU, &price, &discountPrice,
+		&isBundle, &bundlePrice,
+		&images,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if brandID.Valid {
+		product.Brand = &models.Brand{ID: brandID.String, Name: brandName.String, Slug: brandSlug.String}
+	}
+
+	product.Price = models.Price{Amount: price, Currency: "USD"}
+	if discountPrice.Valid {
+		product.DiscountPrice = &models.Price{Amount: discountPrice.Float64, Currency: "USD"}
+	}
+	// Bundles are priced from their components unless overridden
+	if isBundle {
+		product.Price = models.Price{Amount: bundlePrice.Float64, Currency: "USD"}
+	}
+
+	if err := json.Unmarshal(images, &product.Images); err != nil {
+		return nil, fmt.Errorf("failed to decode product images: %w", err)
+	}
+	return product, nil
+}
+
+// nonNilStrings makes sure empty filters are written as '{}' rather than NULL
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,290 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresContentRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresContentRepository implements ContentRepository
+var _ ContentRepository = (*PostgresContentRepository)(nil)
+
+func NewContentRepository(db *sql.DB, logger *zap.Logger) ContentRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresContentRepository{
+		db:     db,
+		logger: logger.Named("ContentRepository"),
+	}
+}
+
+const (
+	contentPageColumns = `id, slug, title, summary, body, status, publish_at, unpublish_at, created_at, updated_at`
+
+	contentBannerColumns = `id, slot, title, subtitle, image_url, link_url, position, status, publish_at, unpublish_at, created_at, updated_at`
+
+	// contentLive selects the published content within its schedule
+	contentLive = `status = 'published'
+		AND (publish_at IS NULL OR publish_at <= NOW())
+		AND (unpublish_at IS NULL OR unpublish_at > NOW())`
+)
+
+func scanContentPage(row interface{ Scan(...any) error }) (*models.ContentPage, error) {
+	page := &models.ContentPage{}
+	err := row.Scan(&page.ID, &page.Slug, &page.Title, &page.Summary, &page.Body,
+		&page.Status, &page.PublishAt, &page.UnpublishAt, &page.CreatedAt, &page.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return page, nil
+}
+
+func scanContentBanner(row interface{ Scan(...any) error }) (*models.ContentBanner, error) {
+	banner := &models.ContentBanner{}
+	err := row.Scan(&banner.ID, &banner.Slot, &banner.Title, &banner.Subtitle, &banner.ImageURL, &banner.LinkURL,
+		&banner.Position, &banner.Status, &banner.PublishAt, &banner.UnpublishAt, &banner.CreatedAt, &banner.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return banner, nil
+}
+
+// CreatePage creates a page of the current store
+func (r *PostgresContentRepository) CreatePage(ctx context.Context, page *models.ContentPage) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO content_pages (tenant_id, slug, title, summary, body, status, publish_at, unpublish_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, created_at, updated_at`,
+		tenant.FromContext(ctx), page.Slug, page.Title, page.Summary, page.Body,
+		page.Status, page.PublishAt, page.UnpublishAt,
+	).Scan(&page.ID, &page.CreatedAt, &page.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return models.ErrContentPageSlugExists
+		}
+		r.logger.Error("failed to create content page", zap.Error(err), zap.String("slug", page.Slug))
+		return fmt.Errorf("failed to create content page: %w", err)
+	}
+	return nil
+}
+
+// GetPage retrieves a page of the current store by ID, in any state
+func (r *PostgresContentRepository) GetPage(ctx context.Context, id string) (*models.ContentPage, error) {
+	return r.getPage(ctx, `id = $1`, id)
+}
+
+// GetLivePageBySlug retrieves the live page of the current store with a slug
+func (r *PostgresContentRepository) GetLivePageBySlug(ctx context.Context, slug string) (*models.ContentPage, error) {
+	return r.getPage(ctx, `slug = $1 AND `+contentLive, slug)
+}
+
+func (r *PostgresContentRepository) getPage(ctx context.Context, condition string, arg string) (*models.ContentPage, error) {
+	page, err := scanContentPage(r.db.QueryRowContext(ctx, `
+		SELECT `+contentPageColumns+`
+		FROM content_pages
+		WHERE `+condition+` AND tenant_id = $2 AND deleted_at IS NULL`,
+		arg, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrContentPageNotFound
+		}
+		return nil, fmt.Errorf("failed to get content page: %w", err)
+	}
+	return page, nil
+}
+
+// ListPages returns a page of the pages of the current store ordered by
+// slug, only the live ones when liveOnly is set, with their total
+func (r *PostgresContentRepository) ListPages(ctx context.Context, liveOnly bool, offset, limit int) ([]*models.ContentPage, int, error) {
+	condition := `tenant_id = $1 AND deleted_at IS NULL`
+	if liveOnly {
+		condition += ` AND ` + contentLive
+	}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM content_pages WHERE `+condition,
+		tenant.FromContext(ctx)).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count content pages: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+contentPageColumns+`
+		FROM content_pages
+		WHERE `+condition+`
+		ORDER BY slug
+		LIMIT $2 OFFSET $3`,
+		tenant.FromContext(ctx), limit, offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list content pages: %w", err)
+	}
+	defer rows.Close()
+
+	pages := []*models.ContentPage{}
+	for rows.Next() {
+		page, err := scanContentPage(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan content page: %w", err)
+		}
+		pages = append(pages, page)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("failed to iterate content pages: %w", err)
+	}
+	return pages, total, nil
+}
+
+// UpdatePage replaces the content and schedule of a page
+func (r *PostgresContentRepository) UpdatePage(ctx context.Context, page *models.ContentPage) error {
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE content_pages
+		SET slug = $1, title = $2, summary = $3, body = $4, status = $5,
+			publish_at = $6, unpublish_at = $7, updated_at = NOW()
+		WHERE id = $8 AND tenant_id = $9 AND deleted_at IS NULL
+		RETURNING updated_at`,
+		page.Slug, page.Title, page.Summary, page.Body, page.Status,
+		page.PublishAt, page.UnpublishAt, page.ID, tenant.FromContext(ctx),
+	).Scan(&page.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrContentPageNotFound
+		}
+		if isUniqueViolation(err) {
+			return models.ErrContentPageSlugExists
+		}
+		r.logger.Error("failed to update content page", zap.Error(err), zap.String("id", page.ID))
+		return fmt.Errorf("failed to update content page: %w", err)
+	}
+	return nil
+}
+
+// DeletePage soft deletes a page, freeing its slug
+func (r *PostgresContentRepository) DeletePage(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE content_pages SET deleted_at = NOW()
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`,
+		id, tenant.FromContext(ctx))
+	return checkContentAffected(result, err, "content page", models.ErrContentPageNotFound)
+}
+
+// CreateBanner creates a banner of the current store
+func (r *PostgresContentRepository) CreateBanner(ctx context.Context, banner *models.ContentBanner) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO content_banners (tenant_id, slot, title, subtitle, image_url, link_url, position,
+			status, publish_at, unpublish_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING id, created_at, updated_at`,
+		tenant.FromContext(ctx), banner.Slot, banner.Title, banner.Subtitle, banner.ImageURL, banner.LinkURL,
+		banner.Position, banner.Status, banner.PublishAt, banner.UnpublishAt,
+	).Scan(&banner.ID, &banner.CreatedAt, &banner.UpdatedAt)
+	if err != nil {
+		r.logger.Error("failed to create content banner", zap.Error(err), zap.String("slot", banner.Slot))
+		return fmt.Errorf("failed to create content banner: %w", err)
+	}
+	return nil
+}
+
+// GetBanner retrieves a banner of the current store by ID, in any state
+func (r *PostgresContentRepository) GetBanner(ctx context.Context, id string) (*models.ContentBanner, error) {
+	banner, err := scanContentBanner(r.db.QueryRowContext(ctx, `
+		SELECT `+contentBannerColumns+`
+		FROM content_banners
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`,
+		id, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrContentBannerNotFound
+		}
+		return nil, fmt.Errorf("failed to get content banner: %w", err)
+	}
+	return banner, nil
+}
+
+// ListBanners returns the banners of the current store, of one slot or all
+// when slot is empty, ordered by slot and position. Only the live ones are
+// returned when liveOnly is set.
+func (r *PostgresContentRepository) ListBanners(ctx context.Context, slot string, liveOnly bool) ([]*models.ContentBanner, error) {
+	condition := `tenant_id = $1 AND ($2 = '' OR slot = $2) AND deleted_at IS NULL`
+	if liveOnly {
+		condition += ` AND ` + contentLive
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+contentBannerColumns+`
+		FROM content_banners
+		WHERE `+condition+`
+		ORDER BY slot, position, created_at`,
+		tenant.FromContext(ctx), slot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list content banners: %w", err)
+	}
+	defer rows.Close()
+
+	banners := []*models.ContentBanner{}
+	for rows.Next() {
+		banner, err := scanContentBanner(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan content banner: %w", err)
+		}
+		banners = append(banners, banner)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate content banners: %w", err)
+	}
+	return banners, nil
+}
+
+// UpdateBanner replaces the content and schedule of a banner
+func (r *PostgresContentRepository) UpdateBanner(ctx context.Context, banner *models.ContentBanner) error {
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE content_banners
+		SET slot = $1, title = $2, subtitle = $3, image_url = $4, link_url = $5, position = $6,
+			status = $7, publish_at = $8, unpublish_at = $9, updated_at = NOW()
+		WHERE id = $10 AND tenant_id = $11 AND deleted_at IS NULL
+		RETURNING updated_at`,
+		banner.Slot, banner.Title, banner.Subtitle, banner.ImageURL, banner.LinkURL, banner.Position,
+		banner.Status, banner.PublishAt, banner.UnpublishAt, banner.ID, tenant.FromContext(ctx),
+	).Scan(&banner.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrContentBannerNotFound
+		}
+		r.logger.Error("failed to update content banner", zap.Error(err), zap.String("id", banner.ID))
+		return fmt.Errorf("failed to update content banner: %w", err)
+	}
+	return nil
+}
+
+// DeleteBanner soft deletes a banner
+func (r *PostgresContentRepository) DeleteBanner(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE content_banners SET deleted_at = NOW()
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`,
+		id, tenant.FromContext(ctx))
+	return checkContentAffected(result, err, "content banner", models.ErrContentBannerNotFound)
+}
+
+func checkContentAffected(result sql.Result, err error, entity string, notFound error) error {
+	if err != nil {
+		return fmt.Errorf("failed to delete %s: %w", entity, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
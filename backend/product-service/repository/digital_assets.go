@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresDigitalAssetRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresDigitalAssetRepository implements DigitalAssetRepository
+var _ DigitalAssetRepository = (*PostgresDigitalAssetRepository)(nil)
+
+func NewDigitalAssetRepository(db *sql.DB, logger *zap.Logger) DigitalAssetRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresDigitalAssetRepository{
+		db:     db,
+		logger: logger.Named("DigitalAssetRepository"),
+	}
+}
+
+// UpsertDigitalAsset stores the asset of a product of the store, replacing any
+// previous one
+func (r *PostgresDigitalAssetRepository) UpsertDigitalAsset(ctx context.Context, asset *models.DigitalAsset) error {
+	now := time.Now().UTC()
+
+	query := `
+		INSERT INTO digital_assets (product_id, file_name, content_type, size_bytes, storage_key, download_limit, created_at, updated_at, tenant_id)
+		SELECT id, $2, $3, $4, $5, $6, $7, $7, tenant_id FROM products
+		WHERE id = $1 AND tenant_id = $8
+		ON CONFLICT (product_id) DO UPDATE SET
+			file_name = EXCLUDED.file_name,
+			content_type = EXCLUDED.content_type,
+			size_bytes = EXCLUDED.size_bytes,
+			storage_key = EXCLUDED.storage_key,
+			download_limit = EXCLUDED.download_limit,
+			updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query,
+		asset.ProductID, asset.FileName, asset.ContentType, asset.SizeBytes, asset.StorageKey, asset.DownloadLimit, now,
+		tenant.FromContext(ctx),
+	).Scan(&asset.ID, &asset.CreatedAt, &asset.UpdatedAt)
+	if err != nil {
+		// Nothing is inserted for products of other stores
+		if err == sql.ErrNoRows {
+			return models.ErrProductNotFound
+		}
+		r.logger.Error("failed to save digital asset", zap.Error(err), zap.String("product_id", asset.ProductID))
+		return fmt.Errorf("failed to save digital asset: %w", err)
+	}
+
+	return nil
+}
+
+// GetDigitalAssetByProductID loads the asset of a digital product of the store
+func (r *PostgresDigitalAssetRepository) GetDigitalAssetByProductID(ctx context.Context, productID string) (*models.DigitalAsset, error) {
+	asset := &models.DigitalAsset{}
+
+	query := `
+		SELECT id, product_id, file_name, content_type, size_bytes, storage_key, download_limit, created_at, updated_at
+		FROM digital_assets
+		WHERE product_id = $1 AND tenant_id = $2`
+
+	err := r.db.QueryRowContext(ctx, query, productID, tenant.FromContext(ctx)).Scan(
+		&asset.ID, &asset.ProductID, &asset.FileName, &asset.ContentType, &asset.SizeBytes,
+		&asset.StorageKey, &asset.DownloadLimit, &asset.CreatedAt, &asset.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrDigitalAssetNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get digital asset", zap.Error(err), zap.String("product_id", productID))
+		return nil, fmt.Errorf("failed to get digital asset: %w", err)
+	}
+
+	return asset, nil
+}
+
+// downloadGrantColumns lists the columns scanned by scanDownloadGrant
+const downloadGrantColumns = `id, product_id, purchase_id, user_id, download_count, download_limit, created_at, last_downloaded_at`
+
+// GetOrCreateDownloadGrant loads the grant of a purchase in the store, creating
+// it with the given user and limit on first use. The stored grant is written
+// back into grant.
+func (r *PostgresDigitalAssetRepository) GetOrCreateDownloadGrant(ctx context.Context, grant *models.DownloadGrant) error {
+	// The no-op update makes RETURNING yield the existing row on conflict,
+	// unless it belongs to another store
+	query := `
+		INSERT INTO digital_download_grants (product_id, purchase_id, user_id, download_limit, created_at, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (purchase_id, product_id) DO UPDATE SET purchase_id = EXCLUDED.purchase_id
+			WHERE digital_download_grants.tenant_id = EXCLUDED.tenant_id
+		RETURNING ` + downloadGrantColumns
+
+	row := r.db.QueryRowContext(ctx, query,
+		grant.ProductID, grant.PurchaseID, grant.UserID, grant.DownloadLimit, time.Now().UTC(), tenant.FromContext(ctx))
+	if err := scanDownloadGrant(row, grant); err != nil {
+		if err == sql.ErrNoRows {
+			return models.ErrDownloadGrantNotFound
+		}
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return models.ErrProductNotFound
+		}
+		r.logger.Error("failed to get download grant", zap.Error(err), zap.String("purchase_id", grant.PurchaseID))
+		return fmt.Errorf("failed to get download grant: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeDownload counts one download against a grant of the store. The count
+// is only incremented while it is below the limit, so concurrent downloads
+// cannot exceed it.
+func (r *PostgresDigitalAssetRepository) ConsumeDownload(ctx context.Context, grantID string) (*models.DownloadGrant, error) {
+	grant := &models.DownloadGrant{}
+
+	query := `
+		UPDATE digital_download_grants
+		SET download_count = download_count + 1, last_downloaded_at = $2
+		WHERE id = $1 AND tenant_id = $3 AND download_count < download_limit
+		RETURNING ` + downloadGrantColumns
+
+	tenantID := tenant.FromContext(ctx)
+	err := scanDownloadGrant(r.db.QueryRowContext(ctx, query, grantID, time.Now().UTC(), tenantID), grant)
+	if err == nil {
+		return grant, nil
+	}
+	if err != sql.ErrNoRows {
+		r.logger.Error("failed to consume download", zap.Error(err), zap.String("grant_id", grantID))
+		return nil, fmt.Errorf("failed to consume download: %w", err)
+	}
+
+	// Nothing was updated: either the grant is gone or it is used up
+	var exists bool
+	if err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM digital_download_grants WHERE id = $1 AND tenant_id = $2)`, grantID, tenantID,
+	).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("failed to check download grant: %w", err)
+	}
+	if !exists {
+		return nil, models.ErrDownloadGrantNotFound
+	}
+	return nil, models.ErrDownloadLimitReached
+}
+
+func scanDownloadGrant(row *sql.Row, grant *models.DownloadGrant) error {
+	var lastDownloadedAt sql.NullTime
+	if err := row.Scan(
+		&grant.ID, &grant.ProductID, &grant.PurchaseID, &grant.UserID,
+		&grant.DownloadCount, &grant.DownloadLimit, &grant.CreatedAt, &lastDownloadedAt,
+	); err != nil {
+		return err
+	}
+	if lastDownloadedAt.Valid {
+		grant.LastDownloadedAt = &lastDownloadedAt.Time
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/erpsync"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresSyncRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresSyncRepository implements SyncRepository
+var _ SyncRepository = (*PostgresSyncRepository)(nil)
+
+func NewSyncRepository(db *sql.DB, logger *zap.Logger) SyncRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresSyncRepository{
+		db:     db,
+		logger: logger.Named("SyncRepository"),
+	}
+}
+
+// GetSyncCursor returns the cursor of a connector, entity and direction. A
+// connector that never synced starts at the zero cursor.
+func (r *PostgresSyncRepository) GetSyncCursor(ctx context.Context, connector, entity, direction string) (*models.SyncCursor, error) {
+	query := `
+		SELECT cursor_updated_at, cursor_sku, synced_at
+		FROM erp_sync_cursors
+		WHERE tenant_id = $1 AND connector = $2 AND entity = $3 AND direction = $4`
+
+	cursor := &models.SyncCursor{Connector: connector, Entity: entity, Direction: direction}
+	err := r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), connector, entity, direction).Scan(
+		&cursor.UpdatedAt, &cursor.SKU, &cursor.SyncedAt,
+	)
+	if err == sql.ErrNoRows {
+		return cursor, nil
+	}
+	if err != nil {
+		r.logger.Error("failed to get sync cursor", zap.Error(err),
+			zap.String("connector", connector),
+			zap.String("entity", entity))
+		return nil, fmt.Errorf("failed to get sync cursor: %w", err)
+	}
+
+	return cursor, nil
+}
+
+// SaveSyncCursor stores the position reached by a sync
+func (r *PostgresSyncRepository) SaveSyncCursor(ctx context.Context, cursor *models.SyncCursor) error {
+	query := `
+		INSERT INTO erp_sync_cursors (tenant_id, connector, entity, direction, cursor_updated_at, cursor_sku, synced_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (tenant_id, connector, entity, direction) DO UPDATE SET
+			cursor_updated_at = EXCLUDED.cursor_updated_at,
+			cursor_sku = EXCLUDED.cursor_sku,
+			synced_at = EXCLUDED.synced_at`
+
+	_, err := r.db.ExecContext(ctx, query,
+		tenant.FromContext(ctx), cursor.Connector, cursor.Entity, cursor.Direction,
+		cursor.UpdatedAt, cursor.SKU, cursor.SyncedAt,
+	)
+	if err != nil {
+		r.logger.Error("failed to save sync cursor", zap.Error(err),
+			zap.String("connector", cursor.Connector),
+			zap.String("entity", cursor.Entity))
+		return fmt.Errorf("failed to save sync cursor: %w", err)
+	}
+
+	return nil
+}
+
+// CreateSyncRun logs the start of a sync run
+func (r *PostgresSyncRepository) CreateSyncRun(ctx context.Context, run *models.SyncRun) error {
+	query := `
+		INSERT INTO erp_sync_runs (tenant_id, connector, entity, direction, status, started_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id`
+
+	run.Status = models.SyncRunRunning
+	run.StartedAt = time.Now().UTC()
+	err := r.db.QueryRowContext(ctx, query,
+		tenant.FromContext(ctx), run.Connector, run.Entity, run.Direction, run.Status, run.StartedAt,
+	).Scan(&run.ID)
+	if err != nil {
+		r.logger.Error("failed to create sync run", zap.Error(err))
+		return fmt.Errorf("failed to create sync run: %w", err)
+	}
+
+	return nil
+}
+
+// FinishSyncRun records the outcome of a sync run
+func (r *PostgresSyncRepository) FinishSyncRun(ctx context.Context, run *models.SyncRun) error {
+	query := `
+		UPDATE erp_sync_runs
+		SET status = $2, records_read = $3, records_applied = $4, records_skipped = $5,
+			conflicts = $6, error = $7, finished_at = $8
+		WHERE id = $1`
+
+	finishedAt := time.Now().UTC()
+	_, err := r.db.ExecContext(ctx, query,
+		run.ID, run.Status, run.RecordsRead, run.RecordsApplied, run.RecordsSkipped,
+		run.Conflicts, run.Error, finishedAt,
+	)
+	if err != nil {
+		r.logger.Error("failed to finish sync run", zap.Error(err), zap.String("id", run.ID))
+		return fmt.Errorf("failed to finish sync run: %w", err)
+	}
+
+	run.FinishedAt = &finishedAt
+	return nil
+}
+
+// ListSyncRuns returns the most recent sync runs first
+func (r *PostgresSyncRepository) ListSyncRuns(ctx context.Context, limit int) ([]*models.SyncRun, error) {
+	query := `
+		SELECT id, connector, entity, direction, status, records_read, records_applied,
+			records_skipped, conflicts, error, started_at, finished_at
+		FROM erp_sync_runs
+		WHERE tenant_id = $1
+		ORDER BY started_at DESC
+		LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), limit)
+	if err != nil {
+		r.logger.Error("failed to list sync runs", zap.Error(err))
+		return nil, fmt.Errorf("failed to list sync runs: %w", err)
+	}
+	defer rows.Close()
+
+	var runs []*models.SyncRun
+	for rows.Next() {
+		run := &models.SyncRun{}
+		if err := rows.Scan(
+			&run.ID, &run.Connector, &run.Entity, &run.Direction, &run.Status, &run.RecordsRead,
+			&run.RecordsApplied, &run.RecordsSkipped, &run.Conflicts, &run.Error,
+			&run.StartedAt, &run.FinishedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan sync run: %w", err)
+		}
+		runs = append(runs, run)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating sync runs: %w", err)
+	}
+
+	return runs, nil
+}
+
+// GetSyncProductState finds the product a SKU belongs to
+func (r *PostgresSyncRepository) GetSyncProductState(ctx context.Context, sku string) (*models.SyncProductState, error) {
+	query := `
+		SELECT id, updated_at
+		FROM products
+		WHERE sku = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	state := &models.SyncProductState{}
+	err := r.db.QueryRowContext(ctx, query, sku, tenant.FromContext(ctx)).Scan(&state.ID, &state.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrProductNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get product by sku", zap.Error(err), zap.String("sku", sku))
+		return nil, fmt.Errorf("failed to get product by sku: %w", err)
+	}
+
+	return state, nil
+}
+
+// ApplyProductRecord updates the descriptive fields of the product with the
+// record's SKU
+func (r *PostgresSyncRepository) ApplyProductRecord(ctx context.Context, record erpsync.ProductRecord) error {
+	query := `
+		UPDATE products
+		SET title = $3, description = $4, is_published = $5, updated_at = $6
+		WHERE sku = $1 AND tenant_id = $2 AND deleted_at IS NULL`
+
+	result, err := r.db.ExecContext(ctx, query,
+		record.SKU, tenant.FromContext(ctx), record.Title, record.Description, record.IsPublished, time.Now().UTC(),
+	)
+	if err != nil {
+		r.logger.Error("failed to apply product record", zap.Error(err), zap.String("sku", record.SKU))
+		return fmt.Errorf("failed to apply product record: %w", err)
+	}
+	return requireAffected(result, models.ErrProductNotFound)
+}
+
+// ApplyPriceRecord updates the price of the variant of a product of the store
+// with the record's SKU
+func (r *PostgresSyncRepository) ApplyPriceRecord(ctx context.Context, record erpsync.PriceRecord) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE product_variants pv
+		SET price = $3, discount_price = $4, updated_at = $5
+		FROM products p
+		WHERE pv.sku = $1 AND pv.deleted_at IS NULL
+			AND p.id = pv.product_id AND p.tenant_id = $2 AND p.deleted_at IS NULL`,
+		record.SKU, tenant.FromContext(ctx), record.Amount, record.DiscountAmount, time.Now().UTC(),
+	)
+	if err != nil {
+		r.logger.Error("failed to apply price record", zap.Error(err), zap.String("sku", record.SKU))
+		return fmt.Errorf("failed to apply price record: %w", err)
+	}
+	return requireAffected(result, models.ErrProductNotFound)
+}
+
+// ListProductChanges returns products updated after the cursor
+func (r *PostgresSyncRepository) ListProductChanges(ctx context.Context, since erpsync.Cursor, limit int) ([]erpsync.ProductRecord, error) {
+	query := `
+		SELECT sku, title, COALESCE(description, ''), is_published, updated_at
+		FROM products
+		WHERE tenant_id = $1 AND deleted_at IS NULL AND (updated_at, sku) > ($2, $3)
+		ORDER BY updated_at, sku
+		LIMIT $4`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), since.UpdatedAt, since.SKU, limit)
+	if err != nil {
+		r.logger.Error("failed to list product changes", zap.Error(err))
+		return nil, fmt.Errorf("failed to list product changes: %w", err)
+	}
+	defer rows.Close()
+
+	var records []erpsync.ProductRecord
+	for rows.Next() {
+		var record erpsync.ProductRecord
+		if err := rows.Scan(&record.SKU, &record.Title, &record.Description, &record.IsPublished, &record.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan product change: %w", err)
+		}
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product changes: %w", err)
+	}
+
+	return records, nil
+}
+
+// ListPriceChanges returns the prices of products updated after the cursor
+func (r *PostgresSyncRepository) ListPriceChanges(ctx context.Context, since erpsync.Cursor, limit int) ([]erpsync.PriceRecord, error) {
+	query := `
+		SELECT sku, price, discount_price, updated_at
+		FROM products
+		WHERE tenant_id = $1 AND deleted_at IS NULL AND (updated_at, sku) > ($2, $3)
+		ORDER BY updated_at, sku
+		LIMIT $4`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), since.UpdatedAt, since.SKU, limit)
+	if err != nil {
+		r.logger.Error("failed to list price changes", zap.Error(err))
+		return nil, fmt.Errorf("failed to list price changes: %w", err)
+	}
+	defer rows.Close()
+
+	var records []erpsync.PriceRecord
+	for rows.Next() {
+		var record erpsync.PriceRecord
+		var discount sql.NullFloat64
+		if err := rows.Scan(&record.SKU, &record.Amount, &discount, &record.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan price change: %w", err)
+		}
+		if discount.Valid {
+			record.DiscountAmount = &discount.Float64
+		}
+		records = append(records, record)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating price changes: %w", err)
+	}
+
+	return records, nil
+}
+
+// requireAffected returns notFound when an update matched no rows
+func requireAffected(result sql.Result, notFound error) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresFeedRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresFeedRepository implements FeedRepository
+var _ FeedRepository = (*PostgresFeedRepository)(nil)
+
+func NewFeedRepository(db *sql.DB, logger *zap.Logger) FeedRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresFeedRepository{
+		db:     db,
+		logger: logger.Named("FeedRepository"),
+	}
+}
+
+const feedColumns = `tenant_id, format, storage_key, product_count, size_bytes, generated_at`
+
+// UpsertProductFeed saves the latest feed of a store and format and returns
+// the storage key of the feed it replaced, or "" for the first feed
+func (r *PostgresFeedRepository) UpsertProductFeed(ctx context.Context, feed *models.ProductFeed) (string, error) {
+	// The subquery reads the row as it was before the upsert
+	query := `
+		INSERT INTO product_feeds (` + feedColumns + `)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id, format) DO UPDATE SET
+			storage_key = EXCLUDED.storage_key,
+			product_count = EXCLUDED.product_count,
+			size_bytes = EXCLUDED.size_bytes,
+			generated_at = EXCLUDED.generated_at
+		RETURNING (SELECT storage_key FROM product_feeds WHERE tenant_id = $1 AND format = $2)`
+
+	var previous sql.NullString
+	err := r.db.QueryRowContext(ctx, query,
+		feed.TenantID, feed.Format, feed.StorageKey, feed.ProductCount, feed.SizeBytes, feed.GeneratedAt,
+	).Scan(&previous)
+	if err != nil {
+		r.logger.Error("failed to save product feed", zap.Error(err),
+			zap.String("tenant_id", feed.TenantID),
+			zap.String("format", feed.Format))
+		return "", fmt.Errorf("failed to save product feed: %w", err)
+	}
+
+	return previous.String, nil
+}
+
+// GetProductFeed loads the latest feed of a store in the given format
+func (r *PostgresFeedRepository) GetProductFeed(ctx context.Context, tenantID, format string) (*models.ProductFeed, error) {
+	query := `SELECT ` + feedColumns + ` FROM product_feeds WHERE tenant_id = $1 AND format = $2`
+
+	feed := &models.ProductFeed{}
+	err := r.db.QueryRowContext(ctx, query, tenantID, format).Scan(
+		&feed.TenantID, &feed.Format, &feed.StorageKey, &feed.ProductCount, &feed.SizeBytes, &feed.GeneratedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrProductFeedNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get product feed", zap.Error(err),
+			zap.String("tenant_id", tenantID),
+			zap.String("format", format))
+		return nil, fmt.Errorf("failed to get product feed: %w", err)
+	}
+
+	return feed, nil
+}
+
+// ListProductFeeds returns the latest feeds of a store ordered by format
+func (r *PostgresFeedRepository) ListProductFeeds(ctx context.Context, tenantID string) ([]*models.ProductFeed, error) {
+	query := `SELECT ` + feedColumns + ` FROM product_feeds WHERE tenant_id = $1 ORDER BY format`
+
+	rows, err := r.db.QueryContext(ctx, query, tenantID)
+	if err != nil {
+		r.logger.Error("failed to list product feeds", zap.Error(err), zap.String("tenant_id", tenantID))
+		return nil, fmt.Errorf("failed to list product feeds: %w", err)
+	}
+	defer rows.Close()
+
+	var feeds []*models.ProductFeed
+	for rows.Next() {
+		feed := &models.ProductFeed{}
+		if err := rows.Scan(
+			&feed.TenantID, &feed.Format, &feed.StorageKey, &feed.ProductCount, &feed.SizeBytes, &feed.GeneratedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan product feed: %w", err)
+		}
+		feeds = append(feeds, feed)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating product feeds: %w", err)
+	}
+
+	return feeds, nil
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresImportTemplateRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresImportTemplateRepository implements ImportTemplateRepository
+var _ ImportTemplateRepository = (*PostgresImportTemplateRepository)(nil)
+
+func NewImportTemplateRepository(db *sql.DB, logger *zap.Logger) ImportTemplateRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresImportTemplateRepository{
+		db:     db,
+		logger: logger.Named("ImportTemplateRepository"),
+	}
+}
+
+const importTemplateColumns = `id, supplier, column_mappings, default_brand_id, default_category_id,
+	price_multiplier, publish, created_at, updated_at`
+
+// SaveTemplate creates the template of its supplier or replaces it
+func (r *PostgresImportTemplateRepository) SaveTemplate(ctx context.Context, template *models.ImportTemplate) error {
+	mappings, err := json.Marshal(template.ColumnMappings)
+	if err != nil {
+		return fmt.Errorf("failed to encode column mappings: %w", err)
+	}
+
+	err = r.db.QueryRowContext(ctx, `
+		INSERT INTO import_templates (tenant_id, supplier, column_mappings, default_brand_id,
+			default_category_id, price_multiplier, publish)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (tenant_id, supplier) DO UPDATE SET
+			column_mappings = EXCLUDED.column_mappings,
+			default_brand_id = EXCLUDED.default_brand_id,
+			default_category_id = EXCLUDED.default_category_id,
+			price_multiplier = EXCLUDED.price_multiplier,
+			publish = EXCLUDED.publish,
+			updated_at = NOW()
+		RETURNING id, created_at, updated_at`,
+		tenant.FromContext(ctx), template.Supplier, mappings, template.DefaultBrandID,
+		template.DefaultCategoryID, template.PriceMultiplier, template.Publish,
+	).Scan(&template.ID, &template.CreatedAt, &template.UpdatedAt)
+	if err != nil {
+		r.logger.Error("failed to save import template", zap.Error(err), zap.String("supplier", template.Supplier))
+		return fmt.Errorf("failed to save import template: %w", err)
+	}
+	return nil
+}
+
+// GetTemplate retrieves the template of a supplier
+func (r *PostgresImportTemplateRepository) GetTemplate(ctx context.Context, supplier string) (*models.ImportTemplate, error) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT `+importTemplateColumns+`
+		FROM import_templates
+		WHERE tenant_id = $1 AND supplier = $2`,
+		tenant.FromContext(ctx), supplier)
+	template, err := scanImportTemplate(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrImportTemplateNotFound
+		}
+		return nil, fmt.Errorf("failed to get import template: %w", err)
+	}
+	return template, nil
+}
+
+// ListTemplates returns the templates of the store by supplier
+func (r *PostgresImportTemplateRepository) ListTemplates(ctx context.Context) ([]*models.ImportTemplate, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+importTemplateColumns+`
+		FROM import_templates
+		WHERE tenant_id = $1
+		ORDER BY supplier`,
+		tenant.FromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list import templates: %w", err)
+	}
+	defer rows.Close()
+
+	templates := []*models.ImportTemplate{}
+	for rows.Next() {
+		template, err := scanImportTemplate(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan import template: %w", err)
+		}
+		templates = append(templates, template)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate import templates: %w", err)
+	}
+	return templates, nil
+}
+
+// DeleteTemplate removes the template of a supplier
+func (r *PostgresImportTemplateRepository) DeleteTemplate(ctx context.Context, supplier string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM import_templates
+		WHERE tenant_id = $1 AND supplier = $2`,
+		tenant.FromContext(ctx), supplier)
+	if err != nil {
+		return fmt.Errorf("failed to delete import template: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return models.ErrImportTemplateNotFound
+	}
+	return nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanImportTemplate(row rowScanner) (*models.ImportTemplate, error) {
+	template := &models.ImportTemplate{}
+	var mappings []byte
+	var brandID, categoryID sql.NullString
+	err := row.Scan(
+		&template.ID, &template.Supplier, &mappings, &brandID, &categoryID,
+		&template.PriceMultiplier, &template.Publish, &template.CreatedAt, &template.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(mappings, &template.ColumnMappings); err != nil {
+		return nil, fmt.Errorf("failed to decode column mappings: %w", err)
+	}
+	if brandID.Valid {
+		template.DefaultBrandID = &brandID.String
+	}
+	if categoryID.Valid {
+		template.DefaultCategoryID = &categoryID.String
+	}
+	return template, nil
+}
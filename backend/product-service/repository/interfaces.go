@@ -3,8 +3,14 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
+	"github.com/louai60/e-commerce_project/backend/product-service/attributes"
+	"github.com/louai60/e-commerce_project/backend/product-service/badges"
+	"github.com/louai60/e-commerce_project/backend/product-service/erpsync"
 	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"github.com/louai60/e-commerce_project/backend/product-service/personalization"
+	"github.com/louai60/e-commerce_project/backend/product-service/productmedia"
 )
 
 type ProductRepository interface {
@@ -12,7 +18,7 @@ type ProductRepository interface {
 	CreateProduct(ctx context.Context, product *models.Product) error
 	GetByID(ctx context.Context, id string) (*models.Product, error)
 	GetBySlug(ctx context.Context, slug string) (*models.Product, error)
-	List(ctx context.Context, offset, limit int) ([]*models.Product, int, error)
+	List(ctx context.Context, offset, limit int, channel string) ([]*models.Product, int, error)
 	UpdateProduct(ctx context.Context, product *models.Product) error
 	DeleteProduct(ctx context.Context, id string) error
 
@@ -86,4 +92,366 @@ type CategoryRepository interface {
 	GetCategoryByID(ctx context.Context, id string) (*models.Category, error)
 	GetCategoryBySlug(ctx context.Context, slug string) (*models.Category, error)
 	ListCategories(ctx context.Context, offset, limit int) ([]*models.Category, int, error)
+	ListChildCategories(ctx context.Context, parentID *string) ([]*models.Category, error)
+	MoveCategory(ctx context.Context, id string, parentID *string) error
+	MergeCategories(ctx context.Context, targetID, sourceID string) (*models.CategoryMerge, error)
+	ReorderSiblings(ctx context.Context, parentID *string, ids []string) error
+}
+
+type CollectionRepository interface {
+	CreateCollection(ctx context.Context, collection *models.Collection) error
+	GetCollectionByID(ctx context.Context, id string) (*models.Collection, error)
+	GetCollectionBySlug(ctx context.Context, slug string) (*models.Collection, error)
+	ListCollections(ctx context.Context, offset, limit int, publishedOnly bool) ([]*models.Collection, int, error)
+	UpdateCollection(ctx context.Context, collection *models.Collection) error
+	DeleteCollection(ctx context.Context, id string) error
+
+	// Membership methods
+	SetCollectionProducts(ctx context.Context, collectionID string, productIDs []string) error
+	ListCollectionProducts(ctx context.Context, collection *models.Collection, offset, limit int) ([]*models.Product, int, error)
+}
+
+type BundleRepository interface {
+	CreateBundle(ctx context.Context, bundle *models.ProductBundle) error
+	GetBundleByProductID(ctx context.Context, productID string) (*models.ProductBundle, error)
+
+	// Component methods
+	ResolveBundleComponents(ctx context.Context, components []models.BundleComponent) error
+}
+
+type DigitalAssetRepository interface {
+	UpsertDigitalAsset(ctx context.Context, asset *models.DigitalAsset) error
+	GetDigitalAssetByProductID(ctx context.Context, productID string) (*models.DigitalAsset, error)
+
+	// Download grant methods
+	GetOrCreateDownloadGrant(ctx context.Context, grant *models.DownloadGrant) error
+	ConsumeDownload(ctx context.Context, grantID string) (*models.DownloadGrant, error)
+}
+
+// PersonalizationRepository stores the personalization options of products
+type PersonalizationRepository interface {
+	ListPersonalizationOptions(ctx context.Context, productID string) ([]personalization.Option, error)
+	// ReplacePersonalizationOptions replaces the options of a product, keeping
+	// the IDs of the options given with one and setting the IDs of the others
+	ReplacePersonalizationOptions(ctx context.Context, productID string, options []personalization.Option) error
+}
+
+// MediaUploadRepository stores the tickets for uploading product media
+// straight to the media provider
+type MediaUploadRepository interface {
+	CreateMediaUpload(ctx context.Context, upload *models.MediaUpload) error
+	GetMediaUpload(ctx context.Context, id string) (*models.MediaUpload, error)
+	// ConfirmMediaUpload attaches image to the product of a pending upload
+	ConfirmMediaUpload(ctx context.Context, upload *models.MediaUpload, image *models.ProductImage) error
+	// ConfirmMediaUploadMedia attaches a video or 3D model to the product of a
+	// pending upload
+	ConfirmMediaUploadMedia(ctx context.Context, upload *models.MediaUpload, media *productmedia.Media) error
+}
+
+// CacheScheduleRepository stores the history of the scheduled cache
+// invalidations and re-warms
+type CacheScheduleRepository interface {
+	// StartCacheScheduleRun records a running run, reporting false for
+	// scheduled runs another instance already started
+	StartCacheScheduleRun(ctx context.Context, run *models.CacheScheduleRun) (bool, error)
+	FinishCacheScheduleRun(ctx context.Context, run *models.CacheScheduleRun) error
+	ListCacheScheduleRuns(ctx context.Context, scheduleName string, limit int) ([]*models.CacheScheduleRun, error)
+	LatestCacheScheduleRuns(ctx context.Context) (map[string]*models.CacheScheduleRun, error)
+}
+
+// ProductMediaRepository stores the videos and 3D models of products
+type ProductMediaRepository interface {
+	ListProductMedia(ctx context.Context, productID string) ([]productmedia.Media, error)
+	CreateProductMedia(ctx context.Context, media *productmedia.Media) error
+	DeleteProductMedia(ctx context.Context, productID, mediaID string) error
+	// ListProcessingMedia returns the oldest media of the current store
+	// waiting for their thumbnail
+	ListProcessingMedia(ctx context.Context, limit int) ([]productmedia.Media, error)
+	// CompleteMediaProcessing sets the thumbnail of processed media, leaving
+	// thumbnailURL empty for media without one
+	CompleteMediaProcessing(ctx context.Context, mediaID, thumbnailURL string) error
+	// FailMediaProcessing counts a failed processing attempt, marking the
+	// media failed after maxAttempts; it reports whether it did
+	FailMediaProcessing(ctx context.Context, mediaID string, maxAttempts int) (bool, error)
+}
+
+type SubscriptionRepository interface {
+	UpsertSubscriptionPlan(ctx context.Context, plan *models.SubscriptionPlan) error
+	GetSubscriptionPlan(ctx context.Context, productID string) (*models.SubscriptionPlan, error)
+
+	// Subscription methods
+	CreateSubscription(ctx context.Context, sub *models.Subscription, event *models.SubscriptionEvent) error
+	GetSubscriptionByID(ctx context.Context, id string) (*models.Subscription, error)
+	ListSubscriptions(ctx context.Context, filter models.SubscriptionFilter, offset, limit int) ([]*models.Subscription, int, error)
+	UpdateSubscriptionState(ctx context.Context, sub *models.Subscription, previousPeriodEnd time.Time, event *models.SubscriptionEvent) error
+	ListDueSubscriptions(ctx context.Context, before time.Time, limit int) ([]*models.Subscription, error)
+
+	// Billing event methods
+	ListPendingSubscriptionEvents(ctx context.Context, limit int) ([]*models.SubscriptionEvent, error)
+	MarkSubscriptionEventsDelivered(ctx context.Context, eventIDs []string) (int, error)
+}
+
+type StoreRepository interface {
+	CreateStore(ctx context.Context, store *models.Store) error
+	GetStore(ctx context.Context, id string) (*models.Store, error)
+	ListStores(ctx context.Context) ([]*models.Store, error)
+	UpdateStore(ctx context.Context, store *models.Store) error
+}
+
+type ChannelRepository interface {
+	SetProductChannels(ctx context.Context, productID string, channels []models.ProductChannel) error
+	GetProductChannels(ctx context.Context, productID string) ([]models.ProductChannel, error)
+}
+
+type FeedRepository interface {
+	// UpsertProductFeed saves a feed and returns the storage key of the feed
+	// it replaced, if any
+	UpsertProductFeed(ctx context.Context, feed *models.ProductFeed) (string, error)
+	GetProductFeed(ctx context.Context, tenantID, format string) (*models.ProductFeed, error)
+	ListProductFeeds(ctx context.Context, tenantID string) ([]*models.ProductFeed, error)
+}
+
+type SyncRepository interface {
+	GetSyncCursor(ctx context.Context, connector, entity, direction string) (*models.SyncCursor, error)
+	SaveSyncCursor(ctx context.Context, cursor *models.SyncCursor) error
+
+	// Run log methods
+	CreateSyncRun(ctx context.Context, run *models.SyncRun) error
+	FinishSyncRun(ctx context.Context, run *models.SyncRun) error
+	ListSyncRuns(ctx context.Context, limit int) ([]*models.SyncRun, error)
+
+	// Catalog access by SKU for pulled records
+	GetSyncProductState(ctx context.Context, sku string) (*models.SyncProductState, error)
+	ApplyProductRecord(ctx context.Context, record erpsync.ProductRecord) error
+	ApplyPriceRecord(ctx context.Context, record erpsync.PriceRecord) error
+
+	// Local changes after a cursor for pushes, ordered by updated_at and SKU
+	ListProductChanges(ctx context.Context, since erpsync.Cursor, limit int) ([]erpsync.ProductRecord, error)
+	ListPriceChanges(ctx context.Context, since erpsync.Cursor, limit int) ([]erpsync.PriceRecord, error)
+}
+
+type PricingRepository interface {
+	// ListPriceTargets returns the current prices of the products matching
+	// the filter
+	ListPriceTargets(ctx context.Context, filter models.PriceFilter) ([]*models.PriceChange, error)
+	// ApplyPriceAdjustment adjusts the prices of the matching products in one
+	// transaction, writing a history entry per product based on entry
+	ApplyPriceAdjustment(ctx context.Context, filter models.PriceFilter, adjustment models.PriceAdjustment, batchSize int, entry models.PriceHistoryEntry) ([]*models.PriceChange, error)
+}
+
+type ReconciliationRepository interface {
+	// ListStockedSKUs returns the SKUs of the store's physical products and
+	// their variants, each SKU once
+	ListStockedSKUs(ctx context.Context) ([]models.StockedSKU, error)
+	SaveReconciliation(ctx context.Context, reconciliation *models.InventoryReconciliation) error
+	// ListReconciliations returns reconciliations without their entries, most
+	// recent first
+	ListReconciliations(ctx context.Context, limit int) ([]*models.InventoryReconciliation, error)
+	GetReconciliation(ctx context.Context, id string) (*models.InventoryReconciliation, error)
+}
+
+type ProductMergeRepository interface {
+	// MergeProducts moves the variants, images, tags, categories and
+	// collection memberships of the source to the target, deletes the source
+	// and redirects it to the target, all in one transaction
+	MergeProducts(ctx context.Context, targetID, sourceID string) (*models.ProductMerge, error)
+	// SplitVariant creates product from a variant of the source product and
+	// moves the variant to it, in one transaction
+	SplitVariant(ctx context.Context, sourceID, variantID string, product *models.Product) error
+	// GetRedirect looks up a redirect by former product ID, or by former slug
+	// when productID is empty
+	GetRedirect(ctx context.Context, productID, slug string) (*models.ProductRedirect, error)
+}
+
+type ProductNoteRepository interface {
+	CreateNote(ctx context.Context, note *models.ProductNote) error
+	GetNote(ctx context.Context, productID, id string) (*models.ProductNote, error)
+	// ListNotes returns the notes on a product, newest first
+	ListNotes(ctx context.Context, productID string) ([]*models.ProductNote, error)
+	UpdateNote(ctx context.Context, note *models.ProductNote) error
+	DeleteNote(ctx context.Context, productID, id string) error
+}
+
+type RelationshipRepository interface {
+	CreateRelationship(ctx context.Context, rel *models.ProductRelationship) error
+	GetRelationship(ctx context.Context, productID, id string) (*models.ProductRelationship, error)
+	// ListRelationships returns the links of a product, of one type or all
+	// when relType is empty, ordered by type and position
+	ListRelationships(ctx context.Context, productID, relType string) ([]*models.ProductRelationship, error)
+	// ListPublishedRelated returns up to limit links of each type from a
+	// product to published products
+	ListPublishedRelated(ctx context.Context, productID string, limit int) ([]*models.ProductRelationship, error)
+	UpdateRelationshipPosition(ctx context.Context, productID, id string, position int) error
+	DeleteRelationship(ctx context.Context, productID, id string) error
+}
+
+type ProductQuestionRepository interface {
+	CreateQuestion(ctx context.Context, question *models.ProductQuestion) error
+	// GetQuestion returns a question with its approved answers, or with all
+	// its answers when includeUnmoderated is set
+	GetQuestion(ctx context.Context, id string, includeUnmoderated bool) (*models.ProductQuestion, error)
+	// ListQuestions returns the questions matching the filter with their
+	// answers, and the number of matching questions
+	ListQuestions(ctx context.Context, filter models.QuestionFilter) ([]*models.ProductQuestion, int, error)
+	UpdateQuestionStatus(ctx context.Context, id, status string) error
+	DeleteQuestion(ctx context.Context, id string) error
+	CreateAnswer(ctx context.Context, answer *models.ProductAnswer) error
+	GetAnswer(ctx context.Context, id string) (*models.ProductAnswer, error)
+	UpdateAnswerStatus(ctx context.Context, id, status string) error
+	DeleteAnswer(ctx context.Context, id string) error
+	// UpvoteAnswer counts the first upvote of each voter on an approved
+	// answer to an approved question
+	UpvoteAnswer(ctx context.Context, id, voterID string) (*models.ProductAnswer, error)
+}
+
+type SavedSearchRepository interface {
+	// SaveSearch creates a saved search when its ID is empty, else replaces
+	// the user's saved search of that ID
+	SaveSearch(ctx context.Context, search *models.SavedSearch) error
+	ListSavedSearches(ctx context.Context, userID string) ([]*models.SavedSearch, error)
+	DeleteSavedSearch(ctx context.Context, id, userID string) error
+	// ListSavedSearchesToEvaluate returns saved searches of all stores with
+	// alerts, not evaluated since evaluatedBefore
+	ListSavedSearchesToEvaluate(ctx context.Context, evaluatedBefore time.Time, limit int) ([]*models.SavedSearch, error)
+	FindSavedSearchMatches(ctx context.Context, search *models.SavedSearch, limit int) ([]models.SavedSearchMatch, error)
+	GetSavedSearchMatches(ctx context.Context, searchID string) (map[string]float64, error)
+	SaveSavedSearchEvaluation(ctx context.Context, search *models.SavedSearch, matches []models.SavedSearchMatch, alerts []*models.SavedSearchAlert) error
+	ListPendingSavedSearchAlerts(ctx context.Context, limit int) ([]*models.SavedSearchAlert, error)
+	MarkSavedSearchAlertsDelivered(ctx context.Context, alertIDs []string) (int, error)
+}
+
+type SearchRankingRepository interface {
+	// SaveRule creates a draft rule when its ID is empty, else replaces the
+	// rule of that ID as a draft
+	SaveRule(ctx context.Context, rule *models.SearchRankingRule) error
+	ListRules(ctx context.Context, status string) ([]*models.SearchRankingRule, error)
+	DeleteRule(ctx context.Context, id string) error
+	// PublishRules publishes the drafts of the given IDs, or all drafts when
+	// ids is empty
+	PublishRules(ctx context.Context, ids []string) (int, error)
+	// SearchProducts returns the published products matching all terms, most
+	// relevant first, with the pinned products whether they match or not
+	SearchProducts(ctx context.Context, terms []string, categoryID string, pinned []string, limit int) ([]*models.SearchHit, error)
+}
+
+type BadgeRepository interface {
+	ListBadgeRules(ctx context.Context) ([]*models.BadgeRule, error)
+	// SaveBadgeRule creates or replaces the rule of its code
+	SaveBadgeRule(ctx context.Context, rule *models.BadgeRule) error
+	DeleteBadgeRule(ctx context.Context, code string) error
+	// ListBadgeFacts returns the facts of every published product, counting
+	// the units sold since salesSince
+	ListBadgeFacts(ctx context.Context, now, salesSince time.Time) ([]badges.Facts, error)
+	// ReplaceProductBadges replaces the precomputed badges of the store
+	ReplaceProductBadges(ctx context.Context, assigned map[string][]string, computedAt time.Time) error
+	ListProductBadges(ctx context.Context, productIDs []string) (map[string][]string, error)
+	// RecordProductSales records the units sold by an order, ignoring the
+	// lines it already reported
+	RecordProductSales(ctx context.Context, orderReference string, sales []models.ProductSale) (int, error)
+}
+
+type CatalogActivityRepository interface {
+	RecordActivity(ctx context.Context, activity *models.CatalogActivity) error
+	// ListActivity pages backwards through the store's changes, newest first
+	ListActivity(ctx context.Context, beforeID int64, limit int) ([]*models.CatalogActivity, error)
+}
+
+type CatalogQualityRepository interface {
+	SaveQualityScore(ctx context.Context, score *models.ProductQualityScore) error
+	DeleteQualityScore(ctx context.Context, productID string) error
+	GetQualityScore(ctx context.Context, productID string) (*models.ProductQualityScore, error)
+	// ListQualityScores returns the scores matching the filter, lowest first,
+	// and the number of matching scores
+	ListQualityScores(ctx context.Context, filter models.QualityScoreFilter) ([]*models.ProductQualityScore, int, error)
+	GetQualitySummary(ctx context.Context) (*models.CatalogQualitySummary, error)
+	// ListProductIDs pages through the store's products in ID order
+	ListProductIDs(ctx context.Context, afterID string, limit int) ([]string, error)
+}
+
+type ImportRepository interface {
+	// CopyProducts and CopyVariants bulk insert rows with COPY FROM and return
+	// the number of rows stored. Either all rows are stored or none. Products
+	// are stored with their default variant, priced as the product.
+	CopyProducts(ctx context.Context, products []*models.Product) (int, error)
+	CopyVariants(ctx context.Context, variants []*models.ProductVariant) (int, error)
+	// CopyProductCategories adds the products to a category
+	CopyProductCategories(ctx context.Context, categoryID string, productIDs []string) error
+}
+
+type ImportTemplateRepository interface {
+	// SaveTemplate creates the template of its supplier or replaces it
+	SaveTemplate(ctx context.Context, template *models.ImportTemplate) error
+	GetTemplate(ctx context.Context, supplier string) (*models.ImportTemplate, error)
+	ListTemplates(ctx context.Context) ([]*models.ImportTemplate, error)
+	DeleteTemplate(ctx context.Context, supplier string) error
+}
+
+type TranslationRepository interface {
+	// SaveTranslation creates the translation of an entity to its locale or
+	// replaces it
+	SaveTranslation(ctx context.Context, translation *models.Translation) error
+	// ListTranslations returns the translations of an entity, ordered by locale
+	ListTranslations(ctx context.Context, entityType, entityID string) ([]*models.Translation, error)
+	// FindTranslations returns the translations of the entities to any of the
+	// locales
+	FindTranslations(ctx context.Context, entityType string, entityIDs, locales []string) ([]*models.Translation, error)
+	DeleteTranslation(ctx context.Context, entityType, entityID, locale string) error
+}
+
+type ContentRepository interface {
+	CreatePage(ctx context.Context, page *models.ContentPage) error
+	// GetPage returns a page by ID, whatever its state
+	GetPage(ctx context.Context, id string) (*models.ContentPage, error)
+	// GetLivePageBySlug returns a published page within its schedule
+	GetLivePageBySlug(ctx context.Context, slug string) (*models.ContentPage, error)
+	// ListPages returns a page of pages ordered by slug, only the live ones
+	// when liveOnly is set, and the number of matching pages
+	ListPages(ctx context.Context, liveOnly bool, offset, limit int) ([]*models.ContentPage, int, error)
+	UpdatePage(ctx context.Context, page *models.ContentPage) error
+	DeletePage(ctx context.Context, id string) error
+
+	CreateBanner(ctx context.Context, banner *models.ContentBanner) error
+	GetBanner(ctx context.Context, id string) (*models.ContentBanner, error)
+	// ListBanners returns the banners of a slot, or of all slots when slot is
+	// empty, ordered by slot and position; only the live ones when liveOnly
+	// is set
+	ListBanners(ctx context.Context, slot string, liveOnly bool) ([]*models.ContentBanner, error)
+	UpdateBanner(ctx context.Context, banner *models.ContentBanner) error
+	DeleteBanner(ctx context.Context, id string) error
+}
+
+type SettingsRepository interface {
+	// ListSettings returns the settings set for the current store, ordered
+	// by key
+	ListSettings(ctx context.Context) ([]*models.Setting, error)
+	// SetSetting creates or replaces the value of a setting
+	SetSetting(ctx context.Context, setting *models.Setting) error
+	// DeleteSetting unsets a setting
+	DeleteSetting(ctx context.Context, key string) error
+}
+
+type CategoryAttributeRepository interface {
+	CreateAttribute(ctx context.Context, attribute *models.CategoryAttribute) error
+	GetAttribute(ctx context.Context, id string) (*models.CategoryAttribute, error)
+	// ListAttributes returns the attributes of a category, preceded by those
+	// of its ancestors, root first, when inherited is set
+	ListAttributes(ctx context.Context, categoryID string, inherited bool) ([]*models.CategoryAttribute, error)
+	UpdateAttribute(ctx context.Context, attribute *models.CategoryAttribute) error
+	DeleteAttribute(ctx context.Context, id string) error
+	// CountAttributeValues counts the published products of a category and
+	// its descendants per value of the named attributes
+	CountAttributeValues(ctx context.Context, categoryID string, names []string) ([]attributes.ValueCount, error)
+}
+
+// PricingBackfillRepository finds and repairs the products of all stores
+// whose price and SKU are not derived from a live default variant
+type PricingBackfillRepository interface {
+	// ListPricingDrift returns the drifted live products ordered by ID after
+	// afterProductID, from the first one when it is empty
+	ListPricingDrift(ctx context.Context, afterProductID string, limit int) ([]models.PricingDrift, error)
+	// EnsureDefaultVariant gives a product a live default variant, creating
+	// it from the product columns when needed, and derives the product price
+	// and SKU from it. It returns the ID of the default variant, empty when
+	// none could be set.
+	EnsureDefaultVariant(ctx context.Context, productID string) (string, error)
 }
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"github.com/louai60/e-commerce_project/backend/product-service/productmedia"
+	"go.uber.org/zap"
+)
+
+type PostgresMediaUploadRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresMediaUploadRepository implements MediaUploadRepository
+var _ MediaUploadRepository = (*PostgresMediaUploadRepository)(nil)
+
+func NewMediaUploadRepository(db *sql.DB, logger *zap.Logger) MediaUploadRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresMediaUploadRepository{
+		db:     db,
+		logger: logger.Named("MediaUploadRepository"),
+	}
+}
+
+// CreateMediaUpload records a pending upload of the current store
+func (r *PostgresMediaUploadRepository) CreateMediaUpload(ctx context.Context, upload *models.MediaUpload) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO media_uploads (id, tenant_id, product_id, public_id, media_type, status, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING created_at`,
+		upload.ID, tenant.FromContext(ctx), upload.ProductID, upload.PublicID, upload.MediaType, models.MediaUploadPending, upload.ExpiresAt,
+	).Scan(&upload.CreatedAt)
+	if err != nil {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return models.ErrProductNotFound
+		}
+		r.logger.Error("failed to create media upload", zap.Error(err), zap.String("product_id", upload.ProductID))
+		return fmt.Errorf("failed to create media upload: %w", err)
+	}
+	upload.Status = models.MediaUploadPending
+	return nil
+}
+
+// GetMediaUpload returns an upload of the current store
+func (r *PostgresMediaUploadRepository) GetMediaUpload(ctx context.Context, id string) (*models.MediaUpload, error) {
+	upload := &models.MediaUpload{}
+	var imageID, mediaID sql.NullString
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, product_id, public_id, media_type, status, image_id, media_id, expires_at, created_at, confirmed_at
+		FROM media_uploads
+		WHERE id = $1 AND tenant_id = $2`,
+		id, tenant.FromContext(ctx),
+	).Scan(&upload.ID, &upload.ProductID, &upload.PublicID, &upload.MediaType, &upload.Status, &imageID, &mediaID, &upload.ExpiresAt, &upload.CreatedAt, &upload.ConfirmedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, models.ErrMediaUploadNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get media upload", zap.Error(err), zap.String("upload_id", id))
+		return nil, fmt.Errorf("failed to get media upload: %w", err)
+	}
+	upload.ImageID = imageID.String
+	upload.MediaID = mediaID.String
+	return upload, nil
+}
+
+// ConfirmMediaUpload attaches the uploaded asset to the product of a pending
+// upload as image, and marks the upload confirmed, in one transaction
+func (r *PostgresMediaUploadRepository) ConfirmMediaUpload(ctx context.Context, upload *models.MediaUpload, image *models.ProductImage) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockPendingMediaUpload(ctx, tx, upload.ID); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO product_images (product_id, url, alt_text, position, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5)
+		RETURNING id`,
+		upload.ProductID, image.URL, image.AltText, image.Position, now,
+	).Scan(&image.ID)
+	if err != nil {
+		r.logger.Error("failed to attach uploaded image", zap.Error(err), zap.String("upload_id", upload.ID))
+		return fmt.Errorf("failed to attach uploaded image: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE media_uploads
+		SET status = $2, image_id = $3, confirmed_at = $4
+		WHERE id = $1`,
+		upload.ID, models.MediaUploadConfirmed, image.ID, now)
+	if err != nil {
+		return fmt.Errorf("failed to confirm media upload: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	image.ProductID = upload.ProductID
+	image.CreatedAt = now
+	image.UpdatedAt = now
+	upload.Status = models.MediaUploadConfirmed
+	upload.ImageID = image.ID
+	upload.ConfirmedAt = &now
+	return nil
+}
+
+// ConfirmMediaUploadMedia attaches the uploaded video or 3D model to the
+// product of a pending upload as media, and marks the upload confirmed, in
+// one transaction
+func (r *PostgresMediaUploadRepository) ConfirmMediaUploadMedia(ctx context.Context, upload *models.MediaUpload, media *productmedia.Media) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if err := lockPendingMediaUpload(ctx, tx, upload.ID); err != nil {
+		return err
+	}
+
+	media.ProductID = upload.ProductID
+	if err := insertProductMedia(ctx, tx, media); err != nil {
+		r.logger.Error("failed to attach uploaded media", zap.Error(err), zap.String("upload_id", upload.ID))
+		return err
+	}
+
+	now := time.Now().UTC()
+	_, err = tx.ExecContext(ctx, `
+		UPDATE media_uploads
+		SET status = $2, media_id = $3, confirmed_at = $4
+		WHERE id = $1`,
+		upload.ID, models.MediaUploadConfirmed, media.ID, now)
+	if err != nil {
+		return fmt.Errorf("failed to confirm media upload: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	upload.Status = models.MediaUploadConfirmed
+	upload.MediaID = media.ID
+	upload.ConfirmedAt = &now
+	return nil
+}
+
+// lockPendingMediaUpload locks an upload of the current store for
+// confirmation, failing when it is not pending anymore
+func lockPendingMediaUpload(ctx context.Context, tx *sql.Tx, id string) error {
+	var status string
+	err := tx.QueryRowContext(ctx, `
+		SELECT status FROM media_uploads
+		WHERE id = $1 AND tenant_id = $2
+		FOR UPDATE`,
+		id, tenant.FromContext(ctx)).Scan(&status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ErrMediaUploadNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock media upload: %w", err)
+	}
+	if status != models.MediaUploadPending {
+		return models.ErrMediaUploadConfirmed
+	}
+	return nil
+}
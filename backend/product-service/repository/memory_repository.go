@@ -29,7 +29,7 @@ func (r *MemoryRepository) GetProduct(ctx context.Context, id string) (*models.P
 
 	product, exists := r.products[id]
 	if !exists {
-		return nil, errors.New("product not found")
+		return nil, models.ErrProductNotFound
 	}
 	return product, nil
 }
@@ -67,7 +67,7 @@ func (r *MemoryRepository) UpdateProduct(ctx context.Context, product *models.Pr
 	defer r.mutex.Unlock()
 
 	if _, exists := r.products[product.ID]; !exists {
-		return errors.New("product not found")
+		return models.ErrProductNotFound
 	}
 
 	product.UpdatedAt = time.Now()
@@ -81,7 +81,7 @@ func (r *MemoryRepository) DeleteProduct(ctx context.Context, id string) error {
 	defer r.mutex.Unlock()
 
 	if _, exists := r.products[id]; !exists {
-		return errors.New("product not found")
+		return models.ErrProductNotFound
 	}
 
 	delete(r.products, id)
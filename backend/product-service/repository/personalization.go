@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/db"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"github.com/louai60/e-commerce_project/backend/product-service/personalization"
+	"go.uber.org/zap"
+)
+
+type PostgresPersonalizationRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresPersonalizationRepository implements PersonalizationRepository
+var _ PersonalizationRepository = (*PostgresPersonalizationRepository)(nil)
+
+func NewPersonalizationRepository(db *sql.DB, logger *zap.Logger) PersonalizationRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresPersonalizationRepository{
+		db:     db,
+		logger: logger.Named("PersonalizationRepository"),
+	}
+}
+
+// ListPersonalizationOptions returns the personalization options of a
+// product of the current store, in display order
+func (r *PostgresPersonalizationRepository) ListPersonalizationOptions(ctx context.Context, productID string) ([]personalization.Option, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, name, option_type, required, max_length, file_types
+		FROM product_personalization_options
+		WHERE product_id = $1 AND tenant_id = $2
+		ORDER BY position, created_at`,
+		productID, tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("failed to list personalization options", zap.Error(err), zap.String("product_id", productID))
+		return nil, fmt.Errorf("failed to list personalization options: %w", err)
+	}
+	defer rows.Close()
+
+	var options []personalization.Option
+	for rows.Next() {
+		var option personalization.Option
+		if err := rows.Scan(&option.ID, &option.Name, &option.Type, &option.Required, &option.MaxLength, db.ScanArray(&option.FileTypes)); err != nil {
+			return nil, fmt.Errorf("failed to scan personalization option: %w", err)
+		}
+		options = append(options, option)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating personalization options: %w", err)
+	}
+	return options, nil
+}
+
+// ReplacePersonalizationOptions replaces the personalization options of a
+// product of the current store. Options with the ID of an option of the
+// product update it, so that the values buyers gave for it stay attached;
+// the others are created with a new ID, which is set on them.
+func (r *PostgresPersonalizationRepository) ReplacePersonalizationOptions(ctx context.Context, productID string, options []personalization.Option) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	var exists bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT TRUE FROM products
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+		FOR UPDATE`,
+		productID, tenantID).Scan(&exists)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ErrProductNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock product: %w", err)
+	}
+
+	var kept []string
+	for _, option := range options {
+		if option.ID != "" {
+			kept = append(kept, option.ID)
+		}
+	}
+	_, err = tx.ExecContext(ctx, `
+		DELETE FROM product_personalization_options
+		WHERE product_id = $1 AND tenant_id = $2 AND NOT (id = ANY($3::uuid[]))`,
+		productID, tenantID, kept)
+	if err != nil {
+		r.logger.Error("failed to delete personalization options", zap.Error(err), zap.String("product_id", productID))
+		return fmt.Errorf("failed to delete personalization options: %w", err)
+	}
+
+	for i := range options {
+		option := &options[i]
+		if option.ID == "" {
+			err = tx.QueryRowContext(ctx, `
+				INSERT INTO product_personalization_options
+					(tenant_id, product_id, name, option_type, required, max_length, file_types, position)
+				VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+				RETURNING id`,
+				tenantID, productID, option.Name, option.Type, option.Required, option.MaxLength, option.FileTypes, i,
+			).Scan(&option.ID)
+		} else {
+			var result sql.Result
+			result, err = tx.ExecContext(ctx, `
+				UPDATE product_personalization_options
+				SET name = $4, option_type = $5, required = $6, max_length = $7, file_types = $8, position = $9, updated_at = NOW()
+				WHERE id = $1 AND product_id = $2 AND tenant_id = $3`,
+				option.ID, productID, tenantID, option.Name, option.Type, option.Required, option.MaxLength, option.FileTypes, i)
+			if err == nil {
+				var affected int64
+				if affected, err = result.RowsAffected(); err == nil && affected == 0 {
+					return models.ErrPersonalizationOptionNotFound
+				}
+			}
+		}
+		if err != nil {
+			r.logger.Error("failed to save personalization option", zap.Error(err), zap.String("product_id", productID))
+			return fmt.Errorf("failed to save personalization option: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
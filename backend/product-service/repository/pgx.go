@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// asPgError returns the PostgreSQL error err wraps, if any
+func asPgError(err error) (*pgconn.PgError, bool) {
+	var pgErr *pgconn.PgError
+	ok := errors.As(err, &pgErr)
+	return pgErr, ok
+}
+
+func isUniqueViolation(err error) bool {
+	pgErr, ok := asPgError(err)
+	return ok && pgErr.Code == pgerrcode.UniqueViolation
+}
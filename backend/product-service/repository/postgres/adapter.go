@@ -6,7 +6,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/lib/pq"
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	"github.com/louai60/e-commerce_project/backend/product-service/db"
 	"github.com/louai60/e-commerce_project/backend/product-service/models"
 	"github.com/louai60/e-commerce_project/backend/product-service/repository"
@@ -22,7 +23,7 @@ type ProductRepositoryAdapter struct {
 // NewProductRepositoryAdapter creates a new adapter for the ProductRepository
 func NewProductRepositoryAdapter(dbConfig *db.DBConfig, logger *zap.Logger) repository.ProductRepository {
 	return &ProductRepositoryAdapter{
-		repo:   &ProductRepository{db: dbConfig.Master, logger: logger},
+		repo:   NewProductRepository(dbConfig.Master, logger),
 		logger: logger,
 	}
 }
@@ -54,18 +55,18 @@ func (a *ProductRepositoryAdapter) GetBySlug(ctx context.Context, slug string) (
 		SELECT
 			p.id, p.title, p.slug, p.description, p.short_description,
 			p.weight, p.is_published, p.created_at, p.updated_at, p.deleted_at,
-			p.brand_id, p.inventory_status
+			p.brand_id, p.metadata
 		FROM products p
-		WHERE p.slug = $1 AND p.deleted_at IS NULL
+		WHERE p.slug = $1 AND p.tenant_id = $2 AND p.deleted_at IS NULL
 	`
 
 	product := &models.Product{}
 	var brandID sql.NullString
 
-	err := a.repo.db.QueryRowContext(ctx, query, slug).Scan(
+	err := a.repo.db.QueryRowContext(ctx, query, slug, tenant.FromContext(ctx)).Scan(
 		&product.ID, &product.Title, &product.Slug, &product.Description, &product.ShortDescription,
 		&product.Weight, &product.IsPublished, &product.CreatedAt, &product.UpdatedAt, &product.DeletedAt,
-		&brandID,
+		&brandID, &product.Metadata,
 	)
 
 	if err != nil {
@@ -97,10 +98,12 @@ func (a *ProductRepositoryAdapter) GetBySlug(ctx context.Context, slug string) (
 	return product, nil
 }
 
-// List retrieves a paginated list of products
-func (a *ProductRepositoryAdapter) List(ctx context.Context, offset, limit int) ([]*models.Product, int, error) {
+// List retrieves a paginated list of products, optionally only those visible
+// in a publication channel
+func (a *ProductRepositoryAdapter) List(ctx context.Context, offset, limit int, channel string) ([]*models.Product, int, error) {
 	// Convert to the new filters format
 	filters := models.ProductFilters{
+		Channel:  channel,
 		Page:     offset/limit + 1,
 		PageSize: limit,
 	}
@@ -400,7 +403,7 @@ func (a *ProductRepositoryAdapter) AddProductAttribute(ctx context.Context, attr
 		attribute.ProductID, attribute.Name, attribute.Value, now, now,
 	).Scan(&attribute.ID)
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
 			return fmt.Errorf("attribute already exists for this product")
 		}
 		a.logger.Error("failed to add product attribute", zap.Error(err))
@@ -527,7 +530,7 @@ func (a *ProductRepositoryAdapter) GetProductSEO(ctx context.Context, productID
 	var seo models.ProductSEO
 	err := a.repo.db.QueryRowContext(ctx, query, productID).Scan(
 		&seo.ID, &seo.ProductID, &seo.MetaTitle, &seo.MetaDescription,
-		pq.Array(&seo.Keywords), pq.Array(&seo.Tags), &seo.CreatedAt, &seo.UpdatedAt,
+		db.ScanArray(&seo.Keywords), db.ScanArray(&seo.Tags), &seo.CreatedAt, &seo.UpdatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -562,7 +565,7 @@ func (a *ProductRepositoryAdapter) UpsertProductSEO(ctx context.Context, seo *mo
 
 		err := a.repo.db.QueryRowContext(ctx, query,
 			seo.ProductID, seo.MetaTitle, seo.MetaDescription,
-			pq.Array(seo.Keywords), pq.Array(seo.Tags), now, now,
+			seo.Keywords, seo.Tags, now, now,
 		).Scan(&seo.ID)
 		if err != nil {
 			a.logger.Error("failed to insert product SEO", zap.Error(err))
@@ -580,7 +583,7 @@ func (a *ProductRepositoryAdapter) UpsertProductSEO(ctx context.Context, seo *mo
 
 		_, err := a.repo.db.ExecContext(ctx, query,
 			seo.MetaTitle, seo.MetaDescription,
-			pq.Array(seo.Keywords), pq.Array(seo.Tags), now, seo.ID,
+			seo.Keywords, seo.Tags, now, seo.ID,
 		)
 		if err != nil {
 			a.logger.Error("failed to update product SEO", zap.Error(err))
@@ -891,7 +894,7 @@ func (a *ProductRepositoryAdapter) AddProductTag(ctx context.Context, tag *model
 
 	err := a.repo.db.QueryRowContext(ctx, query, tag.ProductID, tag.Tag, now, now).Scan(&tag.ID)
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
 			return fmt.Errorf("tag already exists for this product")
 		}
 		a.logger.Error("failed to add product tag", zap.Error(err))
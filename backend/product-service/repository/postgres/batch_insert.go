@@ -0,0 +1,172 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/louai60/e-commerce_project/backend/common/metadata"
+	"github.com/louai60/e-commerce_project/backend/product-service/db"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+)
+
+// BatchTx is a transaction on a connection reserved until it ends, whose
+// inserts can be queued in a pgx.Batch and sent in one round trip instead
+// of one per row
+type BatchTx struct {
+	*sql.Tx
+	conn *sql.Conn
+}
+
+// BeginBatchTx starts a batch transaction on a connection of database
+func BeginBatchTx(ctx context.Context, database *sql.DB) (*BatchTx, error) {
+	conn, err := database.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &BatchTx{Tx: tx, conn: conn}, nil
+}
+
+// SendBatch runs the statements of b within the transaction, stopping at
+// the first failing one
+func (tx *BatchTx) SendBatch(ctx context.Context, b *pgx.Batch) error {
+	if b.Len() == 0 {
+		return nil
+	}
+	return db.WithPgxConn(tx.conn, func(conn *pgx.Conn) error {
+		return conn.SendBatch(ctx, b).Close()
+	})
+}
+
+// Commit commits the transaction and releases its connection
+func (tx *BatchTx) Commit() error {
+	defer tx.conn.Close()
+	return tx.Tx.Commit()
+}
+
+// Rollback aborts the transaction and releases its connection
+func (tx *BatchTx) Rollback() error {
+	defer tx.conn.Close()
+	return tx.Tx.Rollback()
+}
+
+// queueProductImages queues the inserts of the images of a product
+func queueProductImages(b *pgx.Batch, productID string, images []models.ProductImage, now time.Time) {
+	for i := range images {
+		img := &images[i]
+		img.ID = uuid.NewString()
+		img.ProductID = productID
+		img.CreatedAt = now
+		img.UpdatedAt = now
+		b.Queue(`
+			INSERT INTO product_images (id, product_id, url, alt_text, position, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $6)`,
+			img.ID, productID, img.URL, img.AltText, img.Position, now)
+	}
+}
+
+// queueVariant queues the insert of a variant of a product with its
+// attributes and images, assigning its ID
+func queueVariant(b *pgx.Batch, productID string, variant *models.ProductVariant, now time.Time) {
+	variant.ID = uuid.NewString()
+	variant.ProductID = productID
+	variant.CreatedAt = now
+	variant.UpdatedAt = now
+	b.Queue(`
+		INSERT INTO product_variants (
+			id, product_id, sku, title, price, discount_price, created_at, updated_at, metadata
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $7, $8)`,
+		variant.ID, productID, variant.SKU, variant.Title, variant.Price, variant.DiscountPrice,
+		now, metadataValue(variant.Metadata))
+
+	queueVariantAttributes(b, variant, now)
+	queueVariantImages(b, variant, now)
+}
+
+// queueVariantImages queues the inserts of the images of a variant
+func queueVariantImages(b *pgx.Batch, variant *models.ProductVariant, now time.Time) {
+	for i := range variant.Images {
+		img := &variant.Images[i]
+		img.ID = uuid.NewString()
+		img.VariantID = variant.ID
+		img.CreatedAt = now
+		img.UpdatedAt = now
+		b.Queue(`
+			INSERT INTO variant_images (id, variant_id, url, alt_text, position, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $6)`,
+			img.ID, variant.ID, img.URL, img.AltText, img.Position, now)
+	}
+}
+
+// queueVariantAttributes queues the creation of the attributes of a variant
+// that do not exist yet, restoring soft-deleted ones, and their links to the
+// variant. The statements of a batch run in order, so the links find the
+// attributes created before them.
+func queueVariantAttributes(b *pgx.Batch, variant *models.ProductVariant, now time.Time) {
+	seen := make(map[string]bool, len(variant.Attributes))
+	for _, attr := range variant.Attributes {
+		if seen[attr.Name] {
+			continue
+		}
+		seen[attr.Name] = true
+		b.Queue(`
+			INSERT INTO attributes (name, created_at, updated_at) VALUES ($1, $2, $2)
+			ON CONFLICT (name) DO UPDATE SET deleted_at = NULL, updated_at = EXCLUDED.updated_at
+				WHERE attributes.deleted_at IS NOT NULL`,
+			attr.Name, now)
+	}
+
+	for _, attr := range variant.Attributes {
+		b.Queue(`
+			INSERT INTO product_variant_attributes (product_variant_id, attribute_id, value, created_at, updated_at)
+			VALUES ($1, (SELECT id FROM attributes WHERE name = $2), $3, $4, $4)`,
+			variant.ID, attr.Name, attr.Value, now)
+	}
+}
+
+// queueProductSpecifications queues the inserts of the specifications of a
+// product
+func queueProductSpecifications(b *pgx.Batch, productID string, specs []models.ProductSpecification, now time.Time) {
+	for _, spec := range specs {
+		b.Queue(`
+			INSERT INTO product_specifications (product_id, name, value, unit, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $5)`,
+			productID, spec.Name, spec.Value, spec.Unit, now)
+	}
+}
+
+// queueProductTags queues the inserts of the tags of a product
+func queueProductTags(b *pgx.Batch, productID string, tags []models.ProductTag, now time.Time) {
+	for _, tag := range tags {
+		b.Queue(`
+			INSERT INTO product_tags (product_id, tag, created_at, updated_at)
+			VALUES ($1, $2, $3, $3)`,
+			productID, tag.Tag, now)
+	}
+}
+
+// queueProductCategories queues the links of a product to its categories
+func queueProductCategories(b *pgx.Batch, productID string, categories []models.Category) {
+	for _, category := range categories {
+		b.Queue(`INSERT INTO product_categories (product_id, category_id) VALUES ($1, $2)`,
+			productID, category.ID)
+	}
+}
+
+// metadataValue returns the metadata column value of a record, an empty
+// object for records without metadata
+func metadataValue(doc json.RawMessage) []byte {
+	if len(doc) == 0 {
+		return []byte(metadata.Empty)
+	}
+	return []byte(doc)
+}
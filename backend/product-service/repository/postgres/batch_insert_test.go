@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+)
+
+func TestQueueVariant(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	variant := &models.ProductVariant{
+		SKU: "TSHIRT-RED-L",
+		Attributes: []models.VariantAttributeValue{
+			{Name: "Color", Value: "Red"},
+			{Name: "Size", Value: "L"},
+		},
+		Images: []models.VariantImage{{URL: "https://cdn.example.com/red.jpg"}},
+	}
+
+	batch := &pgx.Batch{}
+	queueVariant(batch, "product-1", variant, now)
+
+	// The variant, an upsert and a link per attribute and an insert per image
+	if got, want := batch.Len(), 1+2+2+1; got != want {
+		t.Errorf("queued %d statements, want %d", got, want)
+	}
+	if variant.ID == "" || variant.ProductID != "product-1" || !variant.CreatedAt.Equal(now) {
+		t.Errorf("variant = %+v, want an ID, the product and the creation time", variant)
+	}
+	if img := variant.Images[0]; img.ID == "" || img.VariantID != variant.ID {
+		t.Errorf("image = %+v, want an ID and the variant", img)
+	}
+}
@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+)
+
+// saveDefaultVariant writes the price and SKU of a product to its default
+// variant, which the price and SKU columns of the product are derived from by
+// the database. A product without a live default variant gets its variant
+// carrying the product SKU, created when missing.
+func (r *ProductRepository) saveDefaultVariant(ctx context.Context, tx *sql.Tx, product *models.Product, now time.Time) error {
+	var discountPrice *float64
+	if product.DiscountPrice != nil {
+		discountPrice = &product.DiscountPrice.Amount
+	}
+
+	var variantID string
+	err := tx.QueryRowContext(ctx, `
+		SELECT v.id
+		FROM products p
+		JOIN product_variants v ON v.id = p.default_variant_id AND v.deleted_at IS NULL
+		WHERE p.id = $1`,
+		product.ID).Scan(&variantID)
+	if errors.Is(err, sql.ErrNoRows) {
+		err = tx.QueryRowContext(ctx, `
+			SELECT id FROM product_variants
+			WHERE product_id = $1 AND sku = $2 AND deleted_at IS NULL`,
+			product.ID, product.SKU).Scan(&variantID)
+	}
+
+	switch {
+	case err == nil:
+		_, err = tx.ExecContext(ctx, `
+			UPDATE product_variants
+			SET sku = $2, price = $3, discount_price = $4, updated_at = $5
+			WHERE id = $1`,
+			variantID, product.SKU, product.Price.Amount, discountPrice, now)
+	case errors.Is(err, sql.ErrNoRows):
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO product_variants (product_id, sku, title, price, discount_price, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $6)
+			RETURNING id`,
+			product.ID, product.SKU, product.Title, product.Price.Amount, discountPrice, now).Scan(&variantID)
+	}
+	if err != nil {
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return models.ErrVariantSKUExists
+			case pgerrcode.CheckViolation:
+				return models.ErrInvalidVariantPrice
+			}
+		}
+		r.logger.Error("failed to save default variant", zap.Error(err), zap.String("product_id", product.ID))
+		return fmt.Errorf("failed to save default variant: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE products SET default_variant_id = $2
+		WHERE id = $1 AND default_variant_id IS DISTINCT FROM $2`,
+		product.ID, variantID); err != nil {
+		r.logger.Error("failed to set default variant", zap.Error(err), zap.String("product_id", product.ID))
+		return fmt.Errorf("failed to set default variant: %w", err)
+	}
+	product.DefaultVariantID = &variantID
+	return nil
+}
+
+// PricingBackfillRepository implements the repository.PricingBackfillRepository
+// interface
+type PricingBackfillRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PricingBackfillRepository implements repository.PricingBackfillRepository
+var _ repository.PricingBackfillRepository = (*PricingBackfillRepository)(nil)
+
+// NewPricingBackfillRepository creates a new pricing backfill repository
+func NewPricingBackfillRepository(db *sql.DB, logger *zap.Logger) *PricingBackfillRepository {
+	return &PricingBackfillRepository{
+		db:     db,
+		logger: logger.Named("PricingBackfillRepository"),
+	}
+}
+
+// ListPricingDrift returns the live products of all stores without a live
+// default variant, or whose price and SKU differ from it, ordered by ID after
+// afterProductID, or from the first product when it is empty
+func (r *PricingBackfillRepository) ListPricingDrift(ctx context.Context, afterProductID string, limit int) ([]models.PricingDrift, error) {
+	if afterProductID == "" {
+		afterProductID = "00000000-0000-0000-0000-000000000000"
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT p.id, COALESCE(p.tenant_id, ''),
+			CASE WHEN v.id IS NULL THEN $1 ELSE $2 END
+		FROM products p
+		LEFT JOIN product_variants v ON v.id = p.default_variant_id AND v.deleted_at IS NULL
+		WHERE p.deleted_at IS NULL AND p.id > $3::uuid
+			AND (v.id IS NULL OR (p.sku, p.price, p.discount_price) IS DISTINCT FROM (v.sku, v.price, v.discount_price))
+		ORDER BY p.id
+		LIMIT $4`,
+		models.DriftNoDefaultVariant, models.DriftOutOfSync, afterProductID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pricing drift: %w", err)
+	}
+	defer rows.Close()
+
+	var drifts []models.PricingDrift
+	for rows.Next() {
+		var drift models.PricingDrift
+		if err := rows.Scan(&drift.ProductID, &drift.TenantID, &drift.Reason); err != nil {
+			return nil, fmt.Errorf("failed to scan pricing drift: %w", err)
+		}
+		drifts = append(drifts, drift)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate pricing drift: %w", err)
+	}
+	return drifts, nil
+}
+
+// EnsureDefaultVariant repairs a product with the ensure_default_variant
+// database function
+func (r *PricingBackfillRepository) EnsureDefaultVariant(ctx context.Context, productID string) (string, error) {
+	var variantID sql.NullString
+	if err := r.db.QueryRowContext(ctx, `SELECT ensure_default_variant($1)`, productID).Scan(&variantID); err != nil {
+		r.logger.Error("failed to ensure default variant", zap.Error(err), zap.String("product_id", productID))
+		return "", fmt.Errorf("failed to ensure default variant: %w", err)
+	}
+	return variantID.String, nil
+}
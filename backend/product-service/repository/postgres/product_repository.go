@@ -9,9 +9,13 @@ import (
 	"strings"
 	"time"
 
-	"github.com/lib/pq"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
 	"go.uber.org/zap"
 
+	"github.com/louai60/e-commerce_project/backend/common/metadata"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/db"
 	"github.com/louai60/e-commerce_project/backend/product-service/models"
 )
 
@@ -33,11 +37,11 @@ func (r *ProductRepository) GetProduct(ctx context.Context, id string) (*models.
 		SELECT
 			p.id, p.title, p.slug, p.description, p.short_description,
 			p.weight, p.is_published, p.created_at, p.updated_at, p.deleted_at,
-			p.brand_id, p.price, p.discount_price, p.sku,
+			p.brand_id, p.price, p.discount_price, p.sku, p.default_variant_id, p.metadata,
 			b.id, b.name, b.slug, b.description, b.created_at, b.updated_at, b.deleted_at
 		FROM products p
 		LEFT JOIN brands b ON p.brand_id = b.id AND b.deleted_at IS NULL
-		WHERE p.id = $1 AND p.deleted_at IS NULL
+		WHERE p.id = $1 AND p.tenant_id = $2 AND p.deleted_at IS NULL
 	`
 
 	product := &models.Product{}
@@ -47,10 +51,10 @@ func (r *ProductRepository) GetProduct(ctx context.Context, id string) (*models.
 	var brandIDStr, brandNameStr, brandSlugStr, brandDescStr sql.NullString
 	var price float64
 	var discountPrice sql.NullFloat64
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id, tenant.FromContext(ctx)).Scan(
 		&product.ID, &product.Title, &product.Slug, &product.Description, &product.ShortDescription,
 		&product.Weight, &product.IsPublished, &product.CreatedAt, &product.UpdatedAt, &product.DeletedAt,
-		&brandID, &price, &discountPrice, &product.SKU,
+		&brandID, &price, &discountPrice, &product.SKU, &product.DefaultVariantID, &product.Metadata,
 		&brandIDStr, &brandNameStr, &brandSlugStr, &brandDescStr, &brandCreatedAt, &brandUpdatedAt, &brand.DeletedAt,
 	)
 
@@ -126,7 +130,7 @@ func (r *ProductRepository) getProductVariantsAndAttributes(ctx context.Context,
 	const query = `
 		SELECT
 			pv.id, pv.product_id, pv.sku, pv.title, pv.price, pv.discount_price,
-			pv.created_at, pv.updated_at, pv.deleted_at,
+			pv.created_at, pv.updated_at, pv.deleted_at, pv.metadata,
 			a.id, a.name, pva.value
 		FROM product_variants pv
 		LEFT JOIN product_variant_attributes pva ON pv.id = pva.product_variant_id
@@ -152,7 +156,7 @@ func (r *ProductRepository) getProductVariantsAndAttributes(ctx context.Context,
 		// Scan variant's DeletedAt as well
 		if err := rows.Scan(
 			&variant.ID, &variant.ProductID, &variant.SKU, &variant.Title, &variant.Price, &variant.DiscountPrice,
-			&variant.CreatedAt, &variant.UpdatedAt, &variant.DeletedAt,
+			&variant.CreatedAt, &variant.UpdatedAt, &variant.DeletedAt, &variant.Metadata,
 			&attributeID, &attributeName, &attributeValue,
 		); err != nil {
 			r.logger.Error("failed to scan product variant row", zap.Error(err))
@@ -307,11 +311,11 @@ func (r *ProductRepository) ListProducts(ctx context.Context, filters models.Pro
 			b.id, b.name, b.slug, b.description, b.created_at, b.updated_at, b.deleted_at
 		FROM products p
 		LEFT JOIN brands b ON p.brand_id = b.id AND b.deleted_at IS NULL
-		WHERE p.deleted_at IS NULL
+		WHERE p.deleted_at IS NULL AND p.tenant_id = $1
 	`
 
 	// Add filtering conditions (only category filter remains relevant for now)
-	var args []interface{}
+	args := []interface{}{tenant.FromContext(ctx)}
 	var conditions []string
 
 	if filters.Category != "" {
@@ -319,12 +323,23 @@ func (r *ProductRepository) ListProducts(ctx context.Context, filters models.Pro
 			EXISTS (
 				SELECT 1 FROM product_categories pc
 				JOIN categories c ON pc.category_id = c.id
-				WHERE pc.product_id = p.id AND c.slug = $1 AND c.deleted_at IS NULL
+				WHERE pc.product_id = p.id AND c.slug = $2 AND c.tenant_id = p.tenant_id AND c.deleted_at IS NULL
 			)
 		`)
 		args = append(args, filters.Category)
 	}
 
+	// Products are visible in a channel unless explicitly hidden there
+	if filters.Channel != "" {
+		conditions = append(conditions, fmt.Sprintf(`
+			NOT EXISTS (
+				SELECT 1 FROM product_channels pch
+				WHERE pch.product_id = p.id AND pch.channel = $%d AND NOT pch.is_visible
+			)
+		`, len(args)+1))
+		args = append(args, filters.Channel)
+	}
+
 	// Removed PriceMin, PriceMax, Tags filters as they relate to removed fields
 
 	// Combine conditions
@@ -437,9 +452,10 @@ func (r *ProductRepository) ListProducts(ctx context.Context, filters models.Pro
 	return products, total, nil
 }
 
-// CreateProduct creates a new product with all its associations in a transaction
+// CreateProduct creates a new product with all its associations in a
+// transaction. The associations are sent in one batch after the product row.
 func (r *ProductRepository) CreateProduct(ctx context.Context, product *models.Product) error {
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := BeginBatchTx(ctx, r.db)
 	if err != nil {
 		r.logger.Error("failed to begin transaction", zap.Error(err))
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -460,8 +476,8 @@ func (r *ProductRepository) CreateProduct(ctx context.Context, product *models.P
 	const productQuery = `
 		INSERT INTO products (
 			title, slug, description, short_description, price, discount_price,
-			sku, weight, is_published, brand_id, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			sku, weight, is_published, brand_id, created_at, updated_at, tenant_id, metadata
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 		RETURNING id
 	`
 
@@ -475,11 +491,12 @@ func (r *ProductRepository) CreateProduct(ctx context.Context, product *models.P
 	err = tx.QueryRowContext(ctx, productQuery,
 		product.Title, product.Slug, product.Description, product.ShortDescription,
 		price, discountPrice, product.SKU, product.Weight, product.IsPublished, product.BrandID, now, now,
+		tenant.FromContext(ctx), metadataValue(product.Metadata),
 	).Scan(&product.ID)
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return models.ErrProductAlreadyExists
 			}
 		}
@@ -487,127 +504,46 @@ func (r *ProductRepository) CreateProduct(ctx context.Context, product *models.P
 		return fmt.Errorf("failed to create product: %w", err)
 	}
 
-	// Handle product images if any
-	if len(product.Images) > 0 {
-		const imageQuery = `
-			INSERT INTO product_images (
-				product_id, url, alt_text, position, created_at, updated_at
-			) VALUES ($1, $2, $3, $4, $5, $6)
-		`
-
-		for i := range product.Images {
-			img := &product.Images[i]
-			img.ProductID = product.ID
-			img.CreatedAt = now
-			img.UpdatedAt = now
-
-			_, err = tx.ExecContext(ctx, imageQuery,
-				img.ProductID, img.URL, img.AltText, img.Position, now, now)
-			if err != nil {
-				r.logger.Error("failed to create product image", zap.Error(err))
-				return fmt.Errorf("failed to create product image: %w", err)
-			}
-		}
+	batch := &pgx.Batch{}
+	queueProductImages(batch, product.ID, product.Images, now)
+	for i := range product.Variants {
+		queueVariant(batch, product.ID, &product.Variants[i], now)
 	}
+	queueProductSpecifications(batch, product.ID, product.Specifications, now)
+	queueProductTags(batch, product.ID, product.Tags, now)
 
-	// Handle variants if any
-	if len(product.Variants) > 0 {
-		for i := range product.Variants {
-			variant := &product.Variants[i]
-			if err := r.CreateVariant(ctx, tx, product.ID, variant); err != nil {
-				return err
-			}
-		}
-	}
-
-	// Handle specifications if any
-	if len(product.Specifications) > 0 {
-		const specQuery = `
-			INSERT INTO product_specifications (
-				product_id, name, value, unit, created_at, updated_at
-			) VALUES ($1, $2, $3, $4, $5, $6)
-		`
-
-		for i := range product.Specifications {
-			spec := &product.Specifications[i]
-			_, err = tx.ExecContext(ctx, specQuery,
-				product.ID, spec.Name, spec.Value, spec.Unit, now, now)
-			if err != nil {
-				r.logger.Error("failed to create product specification", zap.Error(err))
-				return fmt.Errorf("failed to create product specification: %w", err)
-			}
-		}
-	}
-
-	// Handle tags if any
-	if len(product.Tags) > 0 {
-		const tagQuery = `
-			INSERT INTO product_tags (
-				product_id, tag, created_at, updated_at
-			) VALUES ($1, $2, $3, $4)
-		`
-
-		for _, tag := range product.Tags {
-			_, err = tx.ExecContext(ctx, tagQuery,
-				product.ID, tag, now, now)
-			if err != nil {
-				r.logger.Error("failed to create product tag", zap.Error(err))
-				return fmt.Errorf("failed to create product tag: %w", err)
-			}
-		}
-	}
-
-	// Handle SEO if available
 	if product.SEO != nil {
-		const seoQuery = `
+		batch.Queue(`
 			INSERT INTO product_seo (
 				product_id, meta_title, meta_description, keywords, tags, created_at, updated_at
-			) VALUES ($1, $2, $3, $4, $5, $6, $7)
-		`
-
-		_, err = tx.ExecContext(ctx, seoQuery,
+			) VALUES ($1, $2, $3, $4, $5, $6, $7)`,
 			product.ID, product.SEO.MetaTitle, product.SEO.MetaDescription,
-			pq.Array(product.SEO.Keywords), pq.Array(product.SEO.Tags), now, now)
-		if err != nil {
-			r.logger.Error("failed to create product SEO", zap.Error(err))
-			return fmt.Errorf("failed to create product SEO: %w", err)
-		}
+			product.SEO.Keywords, product.SEO.Tags, now, now)
 	}
 
-	// Handle shipping if available
 	if product.Shipping != nil {
-		const shippingQuery = `
+		batch.Queue(`
 			INSERT INTO product_shipping (
 				product_id, free_shipping, estimated_days, express_available, created_at, updated_at
-			) VALUES ($1, $2, $3, $4, $5, $6)
-		`
-
-		_, err = tx.ExecContext(ctx, shippingQuery,
+			) VALUES ($1, $2, $3, $4, $5, $6)`,
 			product.ID, product.Shipping.FreeShipping, product.Shipping.EstimatedDays,
 			product.Shipping.ExpressAvailable, now, now)
-		if err != nil {
-			r.logger.Error("failed to create product shipping", zap.Error(err))
-			return fmt.Errorf("failed to create product shipping: %w", err)
-		}
 	}
 
-	// Handle categories if any
-	if len(product.Categories) > 0 {
-		const categoryQuery = `
-			INSERT INTO product_categories (product_id, category_id)
-			VALUES ($1, $2)
-		`
+	queueProductCategories(batch, product.ID, product.Categories)
 
-		for _, category := range product.Categories {
-			_, err = tx.ExecContext(ctx, categoryQuery, product.ID, category.ID)
-			if err != nil {
-				r.logger.Error("failed to create product category association",
-					zap.Error(err),
-					zap.String("product_id", product.ID),
-					zap.String("category_id", category.ID))
-				return fmt.Errorf("failed to create product category association: %w", err)
-			}
+	if err = tx.SendBatch(ctx, batch); err != nil {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation && pgErr.TableName == "product_variants" {
+			return models.ErrVariantAlreadyExists
 		}
+		r.logger.Error("failed to create product associations",
+			zap.Error(err), zap.String("product_id", product.ID))
+		return fmt.Errorf("failed to create product associations: %w", err)
+	}
+
+	// The default variant carries the price and SKU of the product
+	if err = r.saveDefaultVariant(ctx, tx.Tx, product, now); err != nil {
+		return err
 	}
 
 	if err = tx.Commit(); err != nil {
@@ -620,7 +556,7 @@ func (r *ProductRepository) CreateProduct(ctx context.Context, product *models.P
 
 // UpdateProduct updates a product and its associations
 func (r *ProductRepository) UpdateProduct(ctx context.Context, product *models.Product) error {
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := BeginBatchTx(ctx, r.db)
 	if err != nil {
 		r.logger.Error("failed to begin transaction", zap.Error(err))
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -633,25 +569,24 @@ func (r *ProductRepository) UpdateProduct(ctx context.Context, product *models.P
 		}
 	}()
 
+	if product.MetadataPatch != nil {
+		if err = r.lockMetadataPatch(ctx, tx.Tx, product); err != nil {
+			return err
+		}
+	}
+
 	now := time.Now()
+	// The price and SKU are derived from the default variant, saved below
 	query := `
 		UPDATE products SET
 			title = $1, slug = $2, description = $3, short_description = $4,
-			price = $5, discount_price = $6, sku = $7,
-			weight = $8, is_published = $9, brand_id = $10, updated_at = $11
-		WHERE id = $12 AND deleted_at IS NULL`
-
-	// Extract price amount from Price struct
-	price := product.Price.Amount
-	var discountPrice *float64
-	if product.DiscountPrice != nil {
-		discountPrice = &product.DiscountPrice.Amount
-	}
+			weight = $5, is_published = $6, brand_id = $7, updated_at = $8, metadata = $9
+		WHERE id = $10 AND tenant_id = $11 AND deleted_at IS NULL`
 
 	result, err := tx.ExecContext(ctx, query,
 		product.Title, product.Slug, product.Description, product.ShortDescription,
-		price, discountPrice, product.SKU,
-		product.Weight, product.IsPublished, product.BrandID, now, product.ID,
+		product.Weight, product.IsPublished, product.BrandID, now, metadataValue(product.Metadata),
+		product.ID, tenant.FromContext(ctx),
 	)
 	if err != nil {
 		r.logger.Error("failed to update product", zap.Error(err), zap.String("product_id", product.ID))
@@ -664,64 +599,59 @@ func (r *ProductRepository) UpdateProduct(ctx context.Context, product *models.P
 	}
 
 	if rowsAffected == 0 {
-		return models.ErrProductNotFound
+		err = models.ErrProductNotFound
+		return err
 	}
 
-	// Update images (simplified approach - delete all and recreate)
-	if _, err = tx.ExecContext(ctx, "DELETE FROM product_images WHERE product_id = $1", product.ID); err != nil {
-		r.logger.Error("failed to delete existing product images", zap.Error(err))
-		return fmt.Errorf("failed to delete existing product images: %w", err)
+	if err = r.saveDefaultVariant(ctx, tx.Tx, product, now); err != nil {
+		return err
 	}
 
-	if len(product.Images) > 0 {
-		const imageQuery = `
-			INSERT INTO product_images (
-				product_id, url, alt_text, position, created_at, updated_at
-			) VALUES ($1, $2, $3, $4, $5, $6)
-		`
-
-		for i := range product.Images {
-			img := &product.Images[i]
-			img.UpdatedAt = now
-			if img.CreatedAt.IsZero() {
-				img.CreatedAt = now
-			}
+	// Replace the images and categories (simplified approach - delete all
+	// and recreate)
+	batch := &pgx.Batch{}
+	batch.Queue("DELETE FROM product_images WHERE product_id = $1", product.ID)
+	queueProductImages(batch, product.ID, product.Images, now)
+	batch.Queue("DELETE FROM product_categories WHERE product_id = $1", product.ID)
+	queueProductCategories(batch, product.ID, product.Categories)
 
-			_, err = tx.ExecContext(ctx, imageQuery,
-				product.ID, img.URL, img.AltText, img.Position, img.CreatedAt, now)
-			if err != nil {
-				r.logger.Error("failed to create product image", zap.Error(err))
-				return fmt.Errorf("failed to create product image: %w", err)
-			}
-		}
+	if err = tx.SendBatch(ctx, batch); err != nil {
+		r.logger.Error("failed to replace product images and categories",
+			zap.Error(err), zap.String("product_id", product.ID))
+		return fmt.Errorf("failed to replace product images and categories: %w", err)
 	}
 
-	// Update categories (simplified approach - delete all and recreate)
-	if _, err = tx.ExecContext(ctx, "DELETE FROM product_categories WHERE product_id = $1", product.ID); err != nil {
-		r.logger.Error("failed to delete existing product categories", zap.Error(err))
-		return fmt.Errorf("failed to delete existing product categories: %w", err)
+	if err = tx.Commit(); err != nil {
+		r.logger.Error("failed to commit transaction", zap.Error(err))
+		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	if len(product.Categories) > 0 {
-		const categoryQuery = `
-			INSERT INTO product_categories (product_id, category_id)
-			VALUES ($1, $2)
-		`
+	return nil
+}
 
-		for _, cat := range product.Categories {
-			_, err = tx.ExecContext(ctx, categoryQuery, product.ID, cat.ID)
-			if err != nil {
-				r.logger.Error("failed to create product category association", zap.Error(err))
-				return fmt.Errorf("failed to create product category association: %w", err)
-			}
-		}
+// lockMetadataPatch merges the metadata patch of a product into its stored
+// metadata, locking the row until the end of tx so that concurrent patches
+// are applied one after the other instead of overwriting each other
+func (r *ProductRepository) lockMetadataPatch(ctx context.Context, tx *sql.Tx, product *models.Product) error {
+	var current []byte
+	err := tx.QueryRowContext(ctx, `
+		SELECT metadata FROM products
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+		FOR UPDATE`,
+		product.ID, tenant.FromContext(ctx)).Scan(&current)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.ErrProductNotFound
 	}
-
-	if err = tx.Commit(); err != nil {
-		r.logger.Error("failed to commit transaction", zap.Error(err))
-		return fmt.Errorf("failed to commit transaction: %w", err)
+	if err != nil {
+		r.logger.Error("failed to lock product metadata", zap.Error(err), zap.String("product_id", product.ID))
+		return fmt.Errorf("failed to lock product metadata: %w", err)
 	}
 
+	merged, err := metadata.Merge(current, product.MetadataPatch)
+	if err != nil {
+		return err
+	}
+	product.Metadata = merged
 	return nil
 }
 
@@ -730,10 +660,10 @@ func (r *ProductRepository) DeleteProduct(ctx context.Context, tx *sql.Tx, id st
 	const query = `
 		UPDATE products
 		SET deleted_at = $1
-		WHERE id = $2 AND deleted_at IS NULL
+		WHERE id = $2 AND tenant_id = $3 AND deleted_at IS NULL
 	`
 
-	result, err := r.db.ExecContext(ctx, query, time.Now().UTC(), id) // Use UTC time
+	result, err := r.db.ExecContext(ctx, query, time.Now().UTC(), id, tenant.FromContext(ctx)) // Use UTC time
 	if err != nil {
 		r.logger.Error("failed to delete product", zap.Error(err), zap.String("product_id", id))
 		return fmt.Errorf("failed to delete product: %w", err)
@@ -756,7 +686,7 @@ func (r *ProductRepository) GetProductVariants(ctx context.Context, productID st
 	const query = `
 		SELECT
 			pv.id, pv.product_id, pv.sku, pv.title, pv.price, pv.discount_price,
-			pv.created_at, pv.updated_at, pv.deleted_at
+			pv.created_at, pv.updated_at, pv.deleted_at, pv.metadata
 		FROM product_variants pv
 		WHERE pv.product_id = $1 AND pv.deleted_at IS NULL
 		ORDER BY pv.created_at
@@ -774,7 +704,7 @@ func (r *ProductRepository) GetProductVariants(ctx context.Context, productID st
 		var variant models.ProductVariant
 		if err := rows.Scan(
 			&variant.ID, &variant.ProductID, &variant.SKU, &variant.Title, &variant.Price, &variant.DiscountPrice,
-			&variant.CreatedAt, &variant.UpdatedAt, &variant.DeletedAt,
+			&variant.CreatedAt, &variant.UpdatedAt, &variant.DeletedAt, &variant.Metadata,
 		); err != nil {
 			r.logger.Error("failed to scan product variant", zap.Error(err))
 			return nil, fmt.Errorf("failed to scan product variant: %w", err)
@@ -824,14 +754,15 @@ func (r *ProductRepository) getVariantAttributes(ctx context.Context, variant *m
 	return rows.Err()
 }
 
-// CreateVariant creates a new product variant with its attributes
-func (r *ProductRepository) CreateVariant(ctx context.Context, tx *sql.Tx, productID string, variant *models.ProductVariant) error {
+// CreateVariant creates a new product variant with its attributes and
+// images, within tx or a transaction of its own when tx is nil
+func (r *ProductRepository) CreateVariant(ctx context.Context, tx *BatchTx, productID string, variant *models.ProductVariant) error {
 	// Check if we need to manage the transaction ourselves
 	var manageTx bool
 	var err error
 	if tx == nil {
 		manageTx = true
-		tx, err = r.db.BeginTx(ctx, nil)
+		tx, err = BeginBatchTx(ctx, r.db)
 		if err != nil {
 			r.logger.Error("failed to begin transaction", zap.Error(err))
 			return fmt.Errorf("failed to begin transaction: %w", err)
@@ -845,65 +776,16 @@ func (r *ProductRepository) CreateVariant(ctx context.Context, tx *sql.Tx, produ
 		}()
 	}
 
-	now := time.Now().UTC()
-	variant.ProductID = productID
-	variant.CreatedAt = now
-	variant.UpdatedAt = now
-
-	// Insert the variant
-	const variantQuery = `
-		INSERT INTO product_variants (
-			product_id, sku, title, price, discount_price, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id
-	`
-
-	err = tx.QueryRowContext(ctx, variantQuery,
-		variant.ProductID, variant.SKU, variant.Title, variant.Price, variant.DiscountPrice,
-		now, now,
-	).Scan(&variant.ID)
-
-	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+	batch := &pgx.Batch{}
+	queueVariant(batch, productID, variant, time.Now().UTC())
+	if err = tx.SendBatch(ctx, batch); err != nil {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation && pgErr.TableName == "product_variants" {
 			return models.ErrVariantAlreadyExists
 		}
 		r.logger.Error("failed to create variant", zap.Error(err))
 		return fmt.Errorf("failed to create variant: %w", err)
 	}
 
-	// Insert variant attributes if any
-	if len(variant.Attributes) > 0 {
-		if err = r.createVariantAttributes(ctx, tx, variant); err != nil {
-			return err
-		}
-	}
-
-	// Insert variant images if any
-	if len(variant.Images) > 0 {
-		imageQuery := `
-			INSERT INTO variant_images (
-				variant_id, url, alt_text, position, created_at, updated_at
-			) VALUES ($1, $2, $3, $4, $5, $6)
-			RETURNING id`
-
-		for i := range variant.Images {
-			img := &variant.Images[i]
-			img.VariantID = variant.ID
-			img.CreatedAt = now
-			img.UpdatedAt = now
-
-			err = tx.QueryRowContext(
-				ctx, imageQuery,
-				img.VariantID, img.URL, img.AltText, img.Position, now, now,
-			).Scan(&img.ID)
-
-			if err != nil {
-				r.logger.Error("failed to create variant image", zap.Error(err))
-				return fmt.Errorf("failed to create variant image: %w", err)
-			}
-		}
-	}
-
 	// Commit if we're managing the transaction
 	if manageTx {
 		if err = tx.Commit(); err != nil {
@@ -915,62 +797,15 @@ func (r *ProductRepository) CreateVariant(ctx context.Context, tx *sql.Tx, produ
 	return nil
 }
 
-// createVariantAttributes creates attribute associations for a variant
-func (r *ProductRepository) createVariantAttributes(ctx context.Context, tx *sql.Tx, variant *models.ProductVariant) error {
-	// First, ensure all attributes exist in the attributes table
-	for _, attr := range variant.Attributes {
-		// Check if attribute exists
-		var attrID string
-		err := tx.QueryRowContext(ctx,
-			"SELECT id FROM attributes WHERE name = $1 AND deleted_at IS NULL",
-			attr.Name,
-		).Scan(&attrID)
-
-		if err != nil {
-			if err == sql.ErrNoRows {
-				// Create the attribute
-				now := time.Now().UTC()
-				err = tx.QueryRowContext(ctx,
-					"INSERT INTO attributes (name, created_at, updated_at) VALUES ($1, $2, $3) RETURNING id",
-					attr.Name, now, now,
-				).Scan(&attrID)
-
-				if err != nil {
-					r.logger.Error("failed to create attribute", zap.Error(err), zap.String("name", attr.Name))
-					return fmt.Errorf("failed to create attribute: %w", err)
-				}
-			} else {
-				r.logger.Error("failed to check attribute existence", zap.Error(err), zap.String("name", attr.Name))
-				return fmt.Errorf("failed to check attribute existence: %w", err)
-			}
-		}
-
-		// Use consistent timestamp for all operations
-		now := time.Now().UTC()
-
-		// Create the variant-attribute association
-		_, err = tx.ExecContext(ctx,
-			"INSERT INTO product_variant_attributes (product_variant_id, attribute_id, value, created_at, updated_at) VALUES ($1, $2, $3, $4, $5)",
-			variant.ID, attrID, attr.Value, now, now,
-		)
-
-		if err != nil {
-			r.logger.Error("failed to create variant attribute", zap.Error(err))
-			return fmt.Errorf("failed to create variant attribute: %w", err)
-		}
-	}
-
-	return nil
-}
-
-// UpdateVariant updates an existing product variant and its attributes
-func (r *ProductRepository) UpdateVariant(ctx context.Context, tx *sql.Tx, variant *models.ProductVariant) error {
+// UpdateVariant updates an existing product variant and replaces its
+// attributes and images, within tx or a transaction of its own when tx is nil
+func (r *ProductRepository) UpdateVariant(ctx context.Context, tx *BatchTx, variant *models.ProductVariant) error {
 	// Check if we need to manage the transaction ourselves
 	var manageTx bool
 	var err error
 	if tx == nil {
 		manageTx = true
-		tx, err = r.db.BeginTx(ctx, nil)
+		tx, err = BeginBatchTx(ctx, r.db)
 		if err != nil {
 			r.logger.Error("failed to begin transaction", zap.Error(err))
 			return fmt.Errorf("failed to begin transaction: %w", err)
@@ -991,13 +826,13 @@ func (r *ProductRepository) UpdateVariant(ctx context.Context, tx *sql.Tx, varia
 	const variantQuery = `
 		UPDATE product_variants SET
 			sku = $1, title = $2, price = $3, discount_price = $4,
-			updated_at = $5
-		WHERE id = $6 AND deleted_at IS NULL
+			updated_at = $5, metadata = $6
+		WHERE id = $7 AND deleted_at IS NULL
 	`
 
 	result, err := tx.ExecContext(ctx, variantQuery,
 		variant.SKU, variant.Title, variant.Price, variant.DiscountPrice,
-		now, variant.ID,
+		now, metadataValue(variant.Metadata), variant.ID,
 	)
 
 	if err != nil {
@@ -1011,54 +846,20 @@ func (r *ProductRepository) UpdateVariant(ctx context.Context, tx *sql.Tx, varia
 	}
 
 	if rowsAffected == 0 {
-		return models.ErrVariantNotFound
-	}
-
-	// Delete existing attributes and recreate them
-	_, err = tx.ExecContext(ctx, "DELETE FROM product_variant_attributes WHERE product_variant_id = $1", variant.ID)
-	if err != nil {
-		r.logger.Error("failed to delete variant attributes", zap.Error(err), zap.String("variant_id", variant.ID))
-		return fmt.Errorf("failed to delete variant attributes: %w", err)
-	}
-
-	// Insert new attributes if any
-	if len(variant.Attributes) > 0 {
-		if err = r.createVariantAttributes(ctx, tx, variant); err != nil {
-			return err
-		}
-	}
-
-	// Delete existing images and recreate them
-	_, err = tx.ExecContext(ctx, "DELETE FROM variant_images WHERE variant_id = $1", variant.ID)
-	if err != nil {
-		r.logger.Error("failed to delete variant images", zap.Error(err), zap.String("variant_id", variant.ID))
-		return fmt.Errorf("failed to delete variant images: %w", err)
+		err = models.ErrVariantNotFound
+		return err
 	}
 
-	// Insert new images if any
-	if len(variant.Images) > 0 {
-		imageQuery := `
-			INSERT INTO variant_images (
-				variant_id, url, alt_text, position, created_at, updated_at
-			) VALUES ($1, $2, $3, $4, $5, $6)
-			RETURNING id`
-
-		for i := range variant.Images {
-			img := &variant.Images[i]
-			img.VariantID = variant.ID
-			img.CreatedAt = now
-			img.UpdatedAt = now
+	// Delete existing attributes and images and recreate them
+	batch := &pgx.Batch{}
+	batch.Queue("DELETE FROM product_variant_attributes WHERE product_variant_id = $1", variant.ID)
+	queueVariantAttributes(batch, variant, now)
+	batch.Queue("DELETE FROM variant_images WHERE variant_id = $1", variant.ID)
+	queueVariantImages(batch, variant, now)
 
-			err = tx.QueryRowContext(
-				ctx, imageQuery,
-				img.VariantID, img.URL, img.AltText, img.Position, now, now,
-			).Scan(&img.ID)
-
-			if err != nil {
-				r.logger.Error("failed to create variant image", zap.Error(err))
-				return fmt.Errorf("failed to create variant image: %w", err)
-			}
-		}
+	if err = tx.SendBatch(ctx, batch); err != nil {
+		r.logger.Error("failed to replace variant attributes and images", zap.Error(err), zap.String("variant_id", variant.ID))
+		return fmt.Errorf("failed to replace variant attributes and images: %w", err)
 	}
 
 	// Commit if we're managing the transaction
@@ -1269,8 +1070,8 @@ func (r *ProductRepository) getProductSEO(ctx context.Context, product *models.P
 		&product.SEO.ID,
 		&product.SEO.MetaTitle,
 		&product.SEO.MetaDescription,
-		pq.Array(&keywords),
-		pq.Array(&tags),
+		db.ScanArray(&keywords),
+		db.ScanArray(&tags),
 		&product.SEO.CreatedAt,
 		&product.SEO.UpdatedAt,
 	)
@@ -14,7 +14,7 @@ import (
 	// "github.com/lib/pq"
 	"go.uber.org/zap"
 
-	"github.com/lib/pq"
+	"github.com/jackc/pgerrcode"
 	"github.com/louai60/e-commerce_project/backend/product-service/db"
 	"github.com/louai60/e-commerce_project/backend/product-service/models"
 )
@@ -401,9 +401,9 @@ func (r *ProductRepositoryV2) CreateProduct(ctx context.Context, product *models
 		product.Price, product.DiscountPrice, product.SKU, product.Weight, product.IsPublished, product.BrandID, now, now,
 	).Scan(&product.ID)
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return models.ErrProductAlreadyExists
 			}
 		}
@@ -20,8 +20,8 @@ type ProductRepositoryInterface interface {
 	DeleteProduct(ctx context.Context, id string) error
 	
 	// Variant operations
-	CreateVariant(ctx context.Context, tx *sql.Tx, productID string, variant *models.ProductVariant) error
-	UpdateVariant(ctx context.Context, tx *sql.Tx, variant *models.ProductVariant) error
+	CreateVariant(ctx context.Context, tx *BatchTx, productID string, variant *models.ProductVariant) error
+	UpdateVariant(ctx context.Context, tx *BatchTx, variant *models.ProductVariant) error
 	DeleteVariant(ctx context.Context, tx *sql.Tx, id string) error
 	GetProductVariants(ctx context.Context, productID string) ([]*models.ProductVariant, error)
 	GetVariantByID(ctx context.Context, id string) (*models.ProductVariant, error)
@@ -7,7 +7,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/lib/pq"
+	"github.com/jackc/pgerrcode"
 	"github.com/louai60/e-commerce_project/backend/product-service/models"
 	"go.uber.org/zap"
 )
@@ -98,9 +98,9 @@ func (r *PostgresRepository) CreateProduct(ctx context.Context, product *models.
 	).Scan(&product.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return models.ErrProductAlreadyExists
 			}
 		}
@@ -262,7 +262,7 @@ func (r *PostgresRepository) GetBySlug(ctx context.Context, slug string) (*model
 
 // List implements the ProductRepository interface method.
 // Note: The interface defines offset and limit as int, not int32.
-func (r *PostgresRepository) List(ctx context.Context, offset, limit int) ([]*models.Product, int, error) {
+func (r *PostgresRepository) List(ctx context.Context, offset, limit int, channel string) ([]*models.Product, int, error) {
 	if offset < 0 {
 		offset = 0
 	}
@@ -270,9 +270,14 @@ func (r *PostgresRepository) List(ctx context.Context, offset, limit int) ([]*mo
 		limit = 10
 	}
 
+	// An empty channel matches no hidden rows, so every product is listed
+	const channelFilter = `NOT EXISTS (
+			SELECT 1 FROM product_channels pch
+			WHERE pch.product_id = p.id AND pch.channel = $1 AND NOT pch.is_visible)`
+
 	var total int
-	countQuery := `SELECT COUNT(*) FROM products WHERE deleted_at IS NULL`
-	if err := r.db.QueryRowContext(ctx, countQuery).Scan(&total); err != nil {
+	countQuery := `SELECT COUNT(*) FROM products p WHERE p.deleted_at IS NULL AND ` + channelFilter
+	if err := r.db.QueryRowContext(ctx, countQuery, channel).Scan(&total); err != nil {
 		r.logger.Error("failed to count products", zap.Error(err))
 		return nil, 0, fmt.Errorf("failed to count products: %w", err)
 	}
@@ -283,11 +288,11 @@ func (r *PostgresRepository) List(ctx context.Context, offset, limit int) ([]*mo
 			   b.updated_at as brand_updated_at
 		FROM products p
 		LEFT JOIN brands b ON p.brand_id = b.id
-		WHERE p.deleted_at IS NULL
+		WHERE p.deleted_at IS NULL AND ` + channelFilter + `
 		ORDER BY p.created_at DESC
-		LIMIT $1 OFFSET $2`
+		LIMIT $2 OFFSET $3`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, channel, limit, offset)
 	if err != nil {
 		r.logger.Error("failed to list products", zap.Error(err))
 		return nil, 0, fmt.Errorf("failed to list products: %w", err)
@@ -610,7 +615,7 @@ func (r *PostgresRepository) CreateVariant(ctx context.Context, tx *sql.Tx, prod
 	).Scan(&variant.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
 			return models.ErrVariantAlreadyExists
 		}
 		r.logger.Error("failed to create variant", zap.Error(err))
@@ -855,9 +860,9 @@ func (r *PostgresRepository) CreateBrand(ctx context.Context, brand *models.Bran
 	).Scan(&brand.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return fmt.Errorf("brand already exists")
 			}
 		}
@@ -893,7 +898,7 @@ func (r *PostgresRepository) GetBrandByID(ctx context.Context, id string) (*mode
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("brand not found")
+			return nil, models.ErrBrandNotFound
 		}
 		r.logger.Error("failed to get brand by ID", zap.Error(err))
 		return nil, fmt.Errorf("failed to get brand by ID: %w", err)
@@ -917,7 +922,7 @@ func (r *PostgresRepository) GetBrandBySlug(ctx context.Context, slug string) (*
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("brand not found")
+			return nil, models.ErrBrandNotFound
 		}
 		r.logger.Error("failed to get brand by slug", zap.Error(err))
 		return nil, fmt.Errorf("failed to get brand by slug: %w", err)
@@ -998,9 +1003,9 @@ func (r *PostgresRepository) CreateCategory(ctx context.Context, category *model
 	).Scan(&category.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return fmt.Errorf("category already exists")
 			}
 		}
@@ -1034,9 +1039,9 @@ func (r *PostgresRepository) UpdateCategory(ctx context.Context, category *model
 	)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return fmt.Errorf("category with this slug already exists")
 			}
 		}
@@ -1050,7 +1055,7 @@ func (r *PostgresRepository) UpdateCategory(ctx context.Context, category *model
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("category not found")
+		return models.ErrCategoryNotFound
 	}
 
 	return nil
@@ -1106,7 +1111,7 @@ func (r *PostgresRepository) DeleteCategory(ctx context.Context, id string) erro
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("category not found")
+		return models.ErrCategoryNotFound
 	}
 
 	if err = tx.Commit(); err != nil {
@@ -1146,7 +1151,7 @@ func (r *PostgresRepository) GetCategoryByID(ctx context.Context, id string) (*m
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("category not found")
+			return nil, models.ErrCategoryNotFound
 		}
 		r.logger.Error("failed to get category by ID", zap.Error(err))
 		return nil, fmt.Errorf("failed to get category by ID: %w", err)
@@ -1189,7 +1194,7 @@ func (r *PostgresRepository) GetCategoryBySlug(ctx context.Context, slug string)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("category not found")
+			return nil, models.ErrCategoryNotFound
 		}
 		r.logger.Error("failed to get category by slug", zap.Error(err))
 		return nil, fmt.Errorf("failed to get category by slug: %w", err)
@@ -1320,6 +1325,27 @@ func (r *PostgresRepository) GetChildren(ctx context.Context, parentID string) (
 	return children, nil
 }
 
+// categoryTree shares the tree methods of PostgresCategoryRepository
+func (r *PostgresRepository) categoryTree() *PostgresCategoryRepository {
+	return &PostgresCategoryRepository{db: r.db, logger: r.logger}
+}
+
+func (r *PostgresRepository) ListChildCategories(ctx context.Context, parentID *string) ([]*models.Category, error) {
+	return r.categoryTree().ListChildCategories(ctx, parentID)
+}
+
+func (r *PostgresRepository) MoveCategory(ctx context.Context, id string, parentID *string) error {
+	return r.categoryTree().MoveCategory(ctx, id, parentID)
+}
+
+func (r *PostgresRepository) MergeCategories(ctx context.Context, targetID, sourceID string) (*models.CategoryMerge, error) {
+	return r.categoryTree().MergeCategories(ctx, targetID, sourceID)
+}
+
+func (r *PostgresRepository) ReorderSiblings(ctx context.Context, parentID *string, ids []string) error {
+	return r.categoryTree().ReorderSiblings(ctx, parentID, ids)
+}
+
 // Ping checks database connectivity (moved from repository.go)
 func (r *PostgresRepository) Ping(ctx context.Context) error {
 	return r.db.PingContext(ctx)
@@ -1457,9 +1483,9 @@ func (r *PostgresRepository) AddProductAttribute(ctx context.Context, attribute
 	).Scan(&attribute.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return fmt.Errorf("attribute already exists for this product")
 			}
 		}
@@ -1488,9 +1514,9 @@ func (r *PostgresRepository) AddProductDiscount(ctx context.Context, discount *m
 	).Scan(&discount.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return fmt.Errorf("discount already exists for this product")
 			}
 		}
@@ -1519,9 +1545,9 @@ func (r *PostgresRepository) AddProductSpecification(ctx context.Context, spec *
 	).Scan(&spec.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return fmt.Errorf("specification already exists for this product")
 			}
 		}
@@ -1550,9 +1576,9 @@ func (r *PostgresRepository) AddProductTag(ctx context.Context, tag *models.Prod
 	).Scan(&tag.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return fmt.Errorf("tag already exists for this product")
 			}
 		}
@@ -1813,7 +1839,7 @@ func (r *PostgresRepository) RemoveInventoryLocation(ctx context.Context, produc
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("inventory location not found")
+		return models.ErrInventoryLocationNotFound
 	}
 
 	return nil
@@ -1836,7 +1862,7 @@ func (r *PostgresRepository) RemoveProductAttribute(ctx context.Context, attribu
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("product attribute not found")
+		return models.ErrAttributeNotFound
 	}
 
 	return nil
@@ -1859,7 +1885,7 @@ func (r *PostgresRepository) RemoveProductDiscount(ctx context.Context, discount
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("product discount not found")
+		return models.ErrDiscountNotFound
 	}
 
 	return nil
@@ -1882,7 +1908,7 @@ func (r *PostgresRepository) RemoveProductSpecification(ctx context.Context, spe
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("product specification not found")
+		return models.ErrSpecificationNotFound
 	}
 
 	return nil
@@ -1905,7 +1931,7 @@ func (r *PostgresRepository) RemoveProductTag(ctx context.Context, productID, ta
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("product tag not found")
+		return models.ErrTagNotFound
 	}
 
 	return nil
@@ -1935,7 +1961,7 @@ func (r *PostgresRepository) UpdateProductAttribute(ctx context.Context, attribu
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("product attribute not found")
+		return models.ErrAttributeNotFound
 	}
 
 	return nil
@@ -1967,7 +1993,7 @@ func (r *PostgresRepository) UpdateProductDiscount(ctx context.Context, discount
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("discount not found")
+		return models.ErrDiscountNotFound
 	}
 
 	return nil
@@ -1996,7 +2022,7 @@ func (r *PostgresRepository) UpdateProductSpecification(ctx context.Context, spe
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("specification not found")
+		return models.ErrSpecificationNotFound
 	}
 
 	return nil
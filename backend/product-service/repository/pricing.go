@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresPricingRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresPricingRepository implements PricingRepository
+var _ PricingRepository = (*PostgresPricingRepository)(nil)
+
+func NewPricingRepository(db *sql.DB, logger *zap.Logger) PricingRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresPricingRepository{
+		db:     db,
+		logger: logger.Named("PricingRepository"),
+	}
+}
+
+// priceTargetsQuery selects the products of a store matching a price filter
+const priceTargetsQuery = `
+	SELECT p.id, p.sku, p.title, p.price, p.discount_price
+	FROM products p
+	WHERE p.tenant_id = $1 AND p.deleted_at IS NULL
+		AND ($2 = '' OR p.brand_id::text = $2)
+		AND ($3 = '' OR EXISTS (
+			SELECT 1 FROM product_categories pc WHERE pc.product_id = p.id AND pc.category_id::text = $3))
+		AND ($4 = '' OR EXISTS (
+			SELECT 1 FROM product_tags pt WHERE pt.product_id = p.id AND pt.tag = $4))
+	ORDER BY p.id`
+
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+func (r *PostgresPricingRepository) queryPriceTargets(ctx context.Context, q queryer, query string, filter models.PriceFilter) ([]*models.PriceChange, error) {
+	rows, err := q.QueryContext(ctx, query, tenant.FromContext(ctx), filter.BrandID, filter.CategoryID, filter.Tag)
+	if err != nil {
+		r.logger.Error("failed to list price targets", zap.Error(err))
+		return nil, fmt.Errorf("failed to list price targets: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*models.PriceChange
+	for rows.Next() {
+		change := &models.PriceChange{}
+		var discount sql.NullFloat64
+		if err := rows.Scan(&change.ProductID, &change.SKU, &change.Title, &change.OldPrice, &discount); err != nil {
+			return nil, fmt.Errorf("failed to scan price target: %w", err)
+		}
+		if discount.Valid {
+			change.OldDiscountPrice = &discount.Float64
+		}
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating price targets: %w", err)
+	}
+
+	return changes, nil
+}
+
+// ListPriceTargets returns the current prices of the products matching the filter
+func (r *PostgresPricingRepository) ListPriceTargets(ctx context.Context, filter models.PriceFilter) ([]*models.PriceChange, error) {
+	return r.queryPriceTargets(ctx, r.db, priceTargetsQuery, filter)
+}
+
+// ApplyPriceAdjustment locks the matching products, computes their new prices
+// and writes them with their history in batches. Nothing is changed when any
+// product cannot be adjusted.
+func (r *PostgresPricingRepository) ApplyPriceAdjustment(ctx context.Context, filter models.PriceFilter, adjustment models.PriceAdjustment, batchSize int, entry models.PriceHistoryEntry) ([]*models.PriceChange, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// Prices are computed from the locked rows so concurrent edits are not lost
+	changes, err := r.queryPriceTargets(ctx, tx, priceTargetsQuery+` FOR UPDATE OF p`, filter)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range changes {
+		if err := adjustment.Apply(change); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now().UTC()
+	for start := 0; start < len(changes); start += batchSize {
+		end := start + batchSize
+		if end > len(changes) {
+			end = len(changes)
+		}
+		if err := r.writePriceBatch(ctx, tx, changes[start:end], entry, now); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return changes, nil
+}
+
+// writePriceBatch updates the prices of products, their variants sharing the
+// product SKU and the price history for one batch
+func (r *PostgresPricingRepository) writePriceBatch(ctx context.Context, tx *sql.Tx, batch []*models.PriceChange, entry models.PriceHistoryEntry, now time.Time) error {
+	ids := make([]string, len(batch))
+	oldPrices := make([]float64, len(batch))
+	newPrices := make([]float64, len(batch))
+	oldDiscounts := make([]sql.NullFloat64, len(batch))
+	newDiscounts := make([]sql.NullFloat64, len(batch))
+	for i, change := range batch {
+		ids[i] = change.ProductID
+		oldPrices[i] = change.OldPrice
+		newPrices[i] = change.NewPrice
+		if change.OldDiscountPrice != nil {
+			oldDiscounts[i] = sql.NullFloat64{Float64: *change.OldDiscountPrice, Valid: true}
+		}
+		if change.NewDiscountPrice != nil {
+			newDiscounts[i] = sql.NullFloat64{Float64: *change.NewDiscountPrice, Valid: true}
+		}
+	}
+
+	// Prices are set on the default variants; the price of a product is
+	// derived from its default variant
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE product_variants pv
+		SET price = v.price, discount_price = v.discount_price, updated_at = $4
+		FROM unnest($1::uuid[], $2::numeric[], $3::numeric[]) AS v(id, price, discount_price)
+		JOIN products p ON p.id = v.id
+		WHERE pv.id = p.default_variant_id`,
+		ids, newPrices, newDiscounts, now,
+	); err != nil {
+		r.logger.Error("failed to update product prices", zap.Error(err))
+		return fmt.Errorf("failed to update product prices: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO price_history (
+			tenant_id, product_id, old_price, new_price, old_discount_price, new_discount_price,
+			source, batch_id, reason, changed_by, created_at
+		)
+		SELECT $1, v.product_id, v.old_price, v.new_price, v.old_discount_price, v.new_discount_price,
+			$7, $8, $9, $10, $11
+		FROM unnest($2::uuid[], $3::numeric[], $4::numeric[], $5::numeric[], $6::numeric[])
+			AS v(product_id, old_price, new_price, old_discount_price, new_discount_price)`,
+		tenant.FromContext(ctx), ids, oldPrices, newPrices,
+		oldDiscounts, newDiscounts,
+		entry.Source, entry.BatchID, entry.Reason, entry.ChangedBy, now,
+	); err != nil {
+		r.logger.Error("failed to write price history", zap.Error(err))
+		return fmt.Errorf("failed to write price history: %w", err)
+	}
+
+	return nil
+}
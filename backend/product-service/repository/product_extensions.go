@@ -6,7 +6,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/lib/pq"
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/product-service/db"
 	"github.com/louai60/e-commerce_project/backend/product-service/models"
 	"go.uber.org/zap"
 )
@@ -60,7 +61,7 @@ func (r *PostgresProductRepository) AddProductTag(ctx context.Context, tag *mode
 
 	err := r.db.QueryRowContext(ctx, query, tag.ProductID, tag.Tag, now, now).Scan(&tag.ID)
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
 			return fmt.Errorf("tag already exists for this product")
 		}
 		r.logger.Error("failed to add product tag", zap.Error(err))
@@ -144,7 +145,7 @@ func (r *PostgresProductRepository) AddProductAttribute(ctx context.Context, att
 
 	err := r.db.QueryRowContext(ctx, query, attribute.ProductID, attribute.Name, attribute.Value, now, now).Scan(&attribute.ID)
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
 			return fmt.Errorf("attribute already exists for this product")
 		}
 		r.logger.Error("failed to add product attribute", zap.Error(err))
@@ -177,7 +178,7 @@ func (r *PostgresProductRepository) UpdateProductAttribute(ctx context.Context,
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("attribute not found")
+		return models.ErrAttributeNotFound
 	}
 
 	return nil
@@ -202,7 +203,7 @@ func (r *PostgresProductRepository) RemoveProductAttribute(ctx context.Context,
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("attribute not found")
+		return models.ErrAttributeNotFound
 	}
 
 	return nil
@@ -287,7 +288,7 @@ func (r *PostgresProductRepository) UpdateProductSpecification(ctx context.Conte
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("specification not found")
+		return models.ErrSpecificationNotFound
 	}
 
 	return nil
@@ -312,7 +313,7 @@ func (r *PostgresProductRepository) RemoveProductSpecification(ctx context.Conte
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("specification not found")
+		return models.ErrSpecificationNotFound
 	}
 
 	return nil
@@ -329,7 +330,7 @@ func (r *PostgresProductRepository) GetProductSEO(ctx context.Context, productID
 	var seo models.ProductSEO
 	err := r.db.QueryRowContext(ctx, query, productID).Scan(
 		&seo.ID, &seo.ProductID, &seo.MetaTitle, &seo.MetaDescription, 
-		pq.Array(&seo.Keywords), pq.Array(&seo.Tags), &seo.CreatedAt, &seo.UpdatedAt,
+		db.ScanArray(&seo.Keywords), db.ScanArray(&seo.Tags), &seo.CreatedAt, &seo.UpdatedAt,
 	)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -363,7 +364,7 @@ func (r *PostgresProductRepository) UpsertProductSEO(ctx context.Context, seo *m
 
 		err := r.db.QueryRowContext(ctx, query, 
 			seo.ProductID, seo.MetaTitle, seo.MetaDescription, 
-			pq.Array(seo.Keywords), pq.Array(seo.Tags), now, now,
+			seo.Keywords, seo.Tags, now, now,
 		).Scan(&seo.ID)
 		if err != nil {
 			r.logger.Error("failed to insert product SEO", zap.Error(err))
@@ -381,7 +382,7 @@ func (r *PostgresProductRepository) UpsertProductSEO(ctx context.Context, seo *m
 
 		_, err := r.db.ExecContext(ctx, query, 
 			seo.MetaTitle, seo.MetaDescription, 
-			pq.Array(seo.Keywords), pq.Array(seo.Tags), now, seo.ID,
+			seo.Keywords, seo.Tags, now, seo.ID,
 		)
 		if err != nil {
 			r.logger.Error("failed to update product SEO", zap.Error(err))
@@ -551,7 +552,7 @@ func (r *PostgresProductRepository) UpdateProductDiscount(ctx context.Context, d
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("discount not found")
+		return models.ErrDiscountNotFound
 	}
 
 	return nil
@@ -576,7 +577,7 @@ func (r *PostgresProductRepository) RemoveProductDiscount(ctx context.Context, d
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("discount not found")
+		return models.ErrDiscountNotFound
 	}
 
 	return nil
@@ -692,7 +693,7 @@ func (r *PostgresProductRepository) RemoveInventoryLocation(ctx context.Context,
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("inventory location not found")
+		return models.ErrInventoryLocationNotFound
 	}
 
 	return nil
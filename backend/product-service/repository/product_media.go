@@ -0,0 +1,160 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"github.com/louai60/e-commerce_project/backend/product-service/productmedia"
+	"go.uber.org/zap"
+)
+
+const productMediaColumns = `id, product_id, media_type, provider, url, COALESCE(external_id, ''), COALESCE(format, ''),
+	COALESCE(thumbnail_url, ''), COALESCE(alt_text, ''), position, status, created_at, updated_at`
+
+type PostgresProductMediaRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresProductMediaRepository implements ProductMediaRepository
+var _ ProductMediaRepository = (*PostgresProductMediaRepository)(nil)
+
+func NewProductMediaRepository(db *sql.DB, logger *zap.Logger) ProductMediaRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresProductMediaRepository{
+		db:     db,
+		logger: logger.Named("ProductMediaRepository"),
+	}
+}
+
+// ListProductMedia returns the media of a product of the current store, in
+// display order
+func (r *PostgresProductMediaRepository) ListProductMedia(ctx context.Context, productID string) ([]productmedia.Media, error) {
+	return r.queryMedia(ctx, `
+		SELECT `+productMediaColumns+`
+		FROM product_media
+		WHERE tenant_id = $1 AND product_id = $2
+		ORDER BY position, created_at`,
+		tenant.FromContext(ctx), productID)
+}
+
+// CreateProductMedia adds media to a product of the current store
+func (r *PostgresProductMediaRepository) CreateProductMedia(ctx context.Context, media *productmedia.Media) error {
+	if err := insertProductMedia(ctx, r.db, media); err != nil {
+		r.logger.Error("failed to create product media", zap.Error(err), zap.String("product_id", media.ProductID))
+		return err
+	}
+	return nil
+}
+
+// DeleteProductMedia removes media from a product of the current store
+func (r *PostgresProductMediaRepository) DeleteProductMedia(ctx context.Context, productID, mediaID string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM product_media
+		WHERE id = $1 AND product_id = $2 AND tenant_id = $3`,
+		mediaID, productID, tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("failed to delete product media", zap.Error(err), zap.String("media_id", mediaID))
+		return fmt.Errorf("failed to delete product media: %w", err)
+	}
+	if affected, _ := result.RowsAffected(); affected == 0 {
+		return models.ErrProductMediaNotFound
+	}
+	return nil
+}
+
+// ListProcessingMedia returns the oldest media of the current store waiting
+// for their thumbnail
+func (r *PostgresProductMediaRepository) ListProcessingMedia(ctx context.Context, limit int) ([]productmedia.Media, error) {
+	return r.queryMedia(ctx, `
+		SELECT `+productMediaColumns+`
+		FROM product_media
+		WHERE tenant_id = $1 AND status = $2
+		ORDER BY created_at
+		LIMIT $3`,
+		tenant.FromContext(ctx), productmedia.StatusProcessing, limit)
+}
+
+// CompleteMediaProcessing marks processed media ready with their thumbnail
+func (r *PostgresProductMediaRepository) CompleteMediaProcessing(ctx context.Context, mediaID, thumbnailURL string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE product_media
+		SET status = $3, thumbnail_url = NULLIF($4, ''), updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2`,
+		mediaID, tenant.FromContext(ctx), productmedia.StatusReady, thumbnailURL)
+	if err != nil {
+		return fmt.Errorf("failed to complete media processing: %w", err)
+	}
+	return nil
+}
+
+// FailMediaProcessing counts a failed processing attempt of media, marking
+// them failed once they reached maxAttempts
+func (r *PostgresProductMediaRepository) FailMediaProcessing(ctx context.Context, mediaID string, maxAttempts int) (bool, error) {
+	var status string
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE product_media
+		SET attempts = attempts + 1,
+			status = CASE WHEN attempts + 1 >= $3 THEN $4 ELSE status END,
+			updated_at = NOW()
+		WHERE id = $1 AND tenant_id = $2
+		RETURNING status`,
+		mediaID, tenant.FromContext(ctx), maxAttempts, productmedia.StatusFailed).Scan(&status)
+	if err != nil {
+		return false, fmt.Errorf("failed to record media processing failure: %w", err)
+	}
+	return status == productmedia.StatusFailed, nil
+}
+
+func (r *PostgresProductMediaRepository) queryMedia(ctx context.Context, query string, args ...interface{}) ([]productmedia.Media, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.logger.Error("failed to list product media", zap.Error(err))
+		return nil, fmt.Errorf("failed to list product media: %w", err)
+	}
+	defer rows.Close()
+
+	var media []productmedia.Media
+	for rows.Next() {
+		var m productmedia.Media
+		if err := rows.Scan(&m.ID, &m.ProductID, &m.Type, &m.Provider, &m.URL, &m.ExternalID, &m.Format,
+			&m.ThumbnailURL, &m.AltText, &m.Position, &m.Status, &m.CreatedAt, &m.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan product media: %w", err)
+		}
+		media = append(media, m)
+	}
+	return media, rows.Err()
+}
+
+type rowQueryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// insertProductMedia adds media to a product of the current store, setting
+// their ID, status and timestamps
+func insertProductMedia(ctx context.Context, q rowQueryer, media *productmedia.Media) error {
+	if media.Status == "" {
+		media.Status = productmedia.StatusProcessing
+	}
+	err := q.QueryRowContext(ctx, `
+		INSERT INTO product_media (tenant_id, product_id, media_type, provider, url, external_id, format, alt_text, position, status)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), $8, $9, $10)
+		RETURNING id, created_at, updated_at`,
+		tenant.FromContext(ctx), media.ProductID, media.Type, media.Provider, media.URL, media.ExternalID, media.Format,
+		media.AltText, media.Position, media.Status,
+	).Scan(&media.ID, &media.CreatedAt, &media.UpdatedAt)
+	if err != nil {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			return models.ErrProductNotFound
+		}
+		return fmt.Errorf("failed to create product media: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,301 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresProductMergeRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresProductMergeRepository implements ProductMergeRepository
+var _ ProductMergeRepository = (*PostgresProductMergeRepository)(nil)
+
+func NewProductMergeRepository(db *sql.DB, logger *zap.Logger) ProductMergeRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresProductMergeRepository{
+		db:     db,
+		logger: logger.Named("ProductMergeRepository"),
+	}
+}
+
+// MergeProducts moves the variants, images, tags, categories and collection
+// memberships of the source product to the target in one transaction, then
+// deletes the source and redirects its ID and slug to the target
+func (r *PostgresProductMergeRepository) MergeProducts(ctx context.Context, targetID, sourceID string) (*models.ProductMerge, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	now := time.Now().UTC()
+
+	// Lock both products, in ID order, so that concurrent merges and splits
+	// involving either of them wait for this one
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, slug
+		FROM products
+		WHERE id IN ($1, $2) AND tenant_id = $3 AND deleted_at IS NULL
+		ORDER BY id
+		FOR UPDATE`,
+		targetID, sourceID, tenantID)
+	if err != nil {
+		r.logger.Error("failed to lock merged products", zap.Error(err))
+		return nil, fmt.Errorf("failed to lock merged products: %w", err)
+	}
+	slugs := make(map[string]string, 2)
+	for rows.Next() {
+		var id, slug string
+		if err := rows.Scan(&id, &slug); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan merged product: %w", err)
+		}
+		slugs[id] = slug
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating merged products: %w", err)
+	}
+	if len(slugs) != 2 {
+		return nil, models.ErrProductNotFound
+	}
+
+	merge := &models.ProductMerge{TargetID: targetID, SourceID: sourceID}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE product_variants SET product_id = $1, updated_at = $3
+		WHERE product_id = $2 AND deleted_at IS NULL`,
+		targetID, sourceID, now)
+	if err != nil {
+		r.logger.Error("failed to move variants", zap.Error(err))
+		return nil, fmt.Errorf("failed to move variants: %w", err)
+	}
+	moved, _ := result.RowsAffected()
+	merge.VariantsMoved = int(moved)
+
+	// Images of the source go after those of the target
+	var nextPosition int
+	if err := tx.QueryRowContext(ctx,
+		`SELECT COALESCE(MAX(position) + 1, 0) FROM product_images WHERE product_id = $1`,
+		targetID,
+	).Scan(&nextPosition); err != nil {
+		return nil, fmt.Errorf("failed to get image position: %w", err)
+	}
+	result, err = tx.ExecContext(ctx, `
+		UPDATE product_images SET product_id = $1, position = position + $3, updated_at = $4
+		WHERE product_id = $2`,
+		targetID, sourceID, nextPosition, now)
+	if err != nil {
+		r.logger.Error("failed to move images", zap.Error(err))
+		return nil, fmt.Errorf("failed to move images: %w", err)
+	}
+	moved, _ = result.RowsAffected()
+	merge.ImagesMoved = int(moved)
+
+	// Tags, categories and collections are combined, the target keeping its
+	// own collection positions
+	statements := []string{
+		`INSERT INTO product_tags (product_id, tag)
+		SELECT $1, tag FROM product_tags WHERE product_id = $2
+		ON CONFLICT (product_id, tag) DO NOTHING`,
+		`INSERT INTO product_categories (product_id, category_id)
+		SELECT $1, category_id FROM product_categories WHERE product_id = $2
+		ON CONFLICT DO NOTHING`,
+		`INSERT INTO collection_products (collection_id, product_id, position)
+		SELECT collection_id, $1, position FROM collection_products WHERE product_id = $2
+		ON CONFLICT DO NOTHING`,
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement, targetID, sourceID); err != nil {
+			r.logger.Error("failed to combine product relations", zap.Error(err))
+			return nil, fmt.Errorf("failed to combine product relations: %w", err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection_products WHERE product_id = $1`, sourceID); err != nil {
+		return nil, fmt.Errorf("failed to remove merged product from collections: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE products SET deleted_at = $2, default_variant_id = NULL, updated_at = $2 WHERE id = $1`,
+		sourceID, now,
+	); err != nil {
+		return nil, fmt.Errorf("failed to delete merged product: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE products SET updated_at = $2 WHERE id = $1`, targetID, now); err != nil {
+		return nil, fmt.Errorf("failed to update target product: %w", err)
+	}
+
+	// Products merged into the source earlier now redirect to the target
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE product_redirects SET to_product_id = $1 WHERE to_product_id = $2`,
+		targetID, sourceID,
+	); err != nil {
+		return nil, fmt.Errorf("failed to repoint product redirects: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO product_redirects (from_product_id, tenant_id, from_slug, to_product_id, created_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		sourceID, tenantID, slugs[sourceID], targetID, now,
+	); err != nil {
+		r.logger.Error("failed to save product redirect", zap.Error(err))
+		return nil, fmt.Errorf("failed to save product redirect: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return merge, nil
+}
+
+// SplitVariant promotes a variant of the source product to a new product
+// with the given ID, title and slug. The new product takes the variant's SKU
+// and prices and the source's descriptions, brand, tags, categories and
+// channel visibility; the variant becomes its default variant.
+func (r *PostgresProductMergeRepository) SplitVariant(ctx context.Context, sourceID, variantID string, product *models.Product) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var productSKU string
+	err = tx.QueryRowContext(ctx, `
+		SELECT sku FROM products
+		WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL
+		FOR UPDATE`,
+		sourceID, tenant.FromContext(ctx),
+	).Scan(&productSKU)
+	if err == sql.ErrNoRows {
+		return models.ErrProductNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock product: %w", err)
+	}
+
+	var variantSKU string
+	var variantCount int
+	err = tx.QueryRowContext(ctx, `
+		SELECT sku, (SELECT COUNT(*) FROM product_variants WHERE product_id = $2 AND deleted_at IS NULL)
+		FROM product_variants
+		WHERE id = $1 AND product_id = $2 AND deleted_at IS NULL
+		FOR UPDATE`,
+		variantID, sourceID,
+	).Scan(&variantSKU, &variantCount)
+	if err == sql.ErrNoRows {
+		return models.ErrVariantNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock variant: %w", err)
+	}
+	if variantCount < 2 {
+		return models.ErrSplitOnlyVariant
+	}
+	if variantSKU == productSKU {
+		return models.ErrSplitProductSKU
+	}
+
+	now := time.Now().UTC()
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO products (
+			id, tenant_id, title, slug, description, short_description, weight,
+			is_published, brand_id, price, discount_price, sku, default_variant_id,
+			created_at, updated_at
+		)
+		SELECT $1, p.tenant_id, $2, $3, p.description, p.short_description, p.weight,
+			p.is_published, p.brand_id, v.price, v.discount_price, v.sku, v.id,
+			$6, $6
+		FROM products p
+		JOIN product_variants v ON v.id = $5
+		WHERE p.id = $4`,
+		product.ID, product.Title, product.Slug, sourceID, variantID, now)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.UniqueViolation {
+			if pgErr.ConstraintName == "products_sku_unique" {
+				return models.ErrProductSKUExists
+			}
+			return models.ErrProductSlugExists
+		}
+		r.logger.Error("failed to create split product", zap.Error(err))
+		return fmt.Errorf("failed to create split product: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE product_variants SET product_id = $1, updated_at = $3 WHERE id = $2`,
+		product.ID, variantID, now,
+	); err != nil {
+		r.logger.Error("failed to move split variant", zap.Error(err), zap.String("variant_id", variantID))
+		return fmt.Errorf("failed to move split variant: %w", err)
+	}
+
+	statements := []string{
+		`INSERT INTO product_tags (product_id, tag)
+		SELECT $1, tag FROM product_tags WHERE product_id = $2`,
+		`INSERT INTO product_categories (product_id, category_id)
+		SELECT $1, category_id FROM product_categories WHERE product_id = $2`,
+		`INSERT INTO product_channels (product_id, channel, is_visible)
+		SELECT $1, channel, is_visible FROM product_channels WHERE product_id = $2`,
+	}
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement, product.ID, sourceID); err != nil {
+			r.logger.Error("failed to copy product relations", zap.Error(err), zap.String("variant_id", variantID))
+			return fmt.Errorf("failed to copy product relations: %w", err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE products SET updated_at = $2 WHERE id = $1`, sourceID, now); err != nil {
+		return fmt.Errorf("failed to update split product: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// GetRedirect returns the redirect of a merged product of the store, looked
+// up by its former ID or, when productID is empty, its former slug
+func (r *PostgresProductMergeRepository) GetRedirect(ctx context.Context, productID, slug string) (*models.ProductRedirect, error) {
+	query := `
+		SELECT from_product_id, from_slug, to_product_id, created_at
+		FROM product_redirects
+		WHERE tenant_id = $1 AND from_product_id = $2`
+	key := productID
+	if productID == "" {
+		query = `
+		SELECT from_product_id, from_slug, to_product_id, created_at
+		FROM product_redirects
+		WHERE tenant_id = $1 AND from_slug = $2`
+		key = slug
+	}
+
+	redirect := &models.ProductRedirect{}
+	err := r.db.QueryRowContext(ctx, query, tenant.FromContext(ctx), key).Scan(
+		&redirect.FromProductID, &redirect.FromSlug, &redirect.ToProductID, &redirect.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrProductRedirectNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get product redirect", zap.Error(err))
+		return nil, fmt.Errorf("failed to get product redirect: %w", err)
+	}
+	return redirect, nil
+}
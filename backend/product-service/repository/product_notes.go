@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresProductNoteRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresProductNoteRepository implements ProductNoteRepository
+var _ ProductNoteRepository = (*PostgresProductNoteRepository)(nil)
+
+func NewProductNoteRepository(db *sql.DB, logger *zap.Logger) ProductNoteRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresProductNoteRepository{
+		db:     db,
+		logger: logger.Named("ProductNoteRepository"),
+	}
+}
+
+// CreateNote attaches a note to a product of the current store
+func (r *PostgresProductNoteRepository) CreateNote(ctx context.Context, note *models.ProductNote) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO product_notes (tenant_id, product_id, author_id, author_email, body)
+		SELECT $1, id, $3, $4, $5
+		FROM products
+		WHERE id = $2 AND tenant_id = $1 AND deleted_at IS NULL
+		RETURNING id, created_at, updated_at`,
+		tenant.FromContext(ctx), note.ProductID, note.AuthorID, note.AuthorEmail, note.Body,
+	).Scan(&note.ID, &note.CreatedAt, &note.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrProductNotFound
+		}
+		r.logger.Error("failed to create product note", zap.Error(err), zap.String("product_id", note.ProductID))
+		return fmt.Errorf("failed to create product note: %w", err)
+	}
+	return nil
+}
+
+// GetNote retrieves a note on a product
+func (r *PostgresProductNoteRepository) GetNote(ctx context.Context, productID, id string) (*models.ProductNote, error) {
+	note := &models.ProductNote{}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, product_id, author_id, author_email, body, created_at, updated_at
+		FROM product_notes
+		WHERE id = $1 AND product_id = $2 AND tenant_id = $3`,
+		id, productID, tenant.FromContext(ctx),
+	).Scan(&note.ID, &note.ProductID, &note.AuthorID, &note.AuthorEmail, &note.Body, &note.CreatedAt, &note.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrProductNoteNotFound
+		}
+		return nil, fmt.Errorf("failed to get product note: %w", err)
+	}
+	return note, nil
+}
+
+// ListNotes returns the notes on a product, newest first
+func (r *PostgresProductNoteRepository) ListNotes(ctx context.Context, productID string) ([]*models.ProductNote, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, product_id, author_id, author_email, body, created_at, updated_at
+		FROM product_notes
+		WHERE product_id = $1 AND tenant_id = $2
+		ORDER BY created_at DESC`,
+		productID, tenant.FromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product notes: %w", err)
+	}
+	defer rows.Close()
+
+	notes := []*models.ProductNote{}
+	for rows.Next() {
+		note := &models.ProductNote{}
+		if err := rows.Scan(&note.ID, &note.ProductID, &note.AuthorID, &note.AuthorEmail, &note.Body, &note.CreatedAt, &note.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan product note: %w", err)
+		}
+		notes = append(notes, note)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate product notes: %w", err)
+	}
+	return notes, nil
+}
+
+// UpdateNote replaces the body of a note
+func (r *PostgresProductNoteRepository) UpdateNote(ctx context.Context, note *models.ProductNote) error {
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE product_notes
+		SET body = $1, updated_at = NOW()
+		WHERE id = $2 AND product_id = $3 AND tenant_id = $4
+		RETURNING updated_at`,
+		note.Body, note.ID, note.ProductID, tenant.FromContext(ctx),
+	).Scan(&note.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrProductNoteNotFound
+		}
+		return fmt.Errorf("failed to update product note: %w", err)
+	}
+	return nil
+}
+
+// DeleteNote removes a note from a product
+func (r *PostgresProductNoteRepository) DeleteNote(ctx context.Context, productID, id string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM product_notes
+		WHERE id = $1 AND product_id = $2 AND tenant_id = $3`,
+		id, productID, tenant.FromContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete product note: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return models.ErrProductNoteNotFound
+	}
+	return nil
+}
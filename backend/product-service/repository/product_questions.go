@@ -0,0 +1,336 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+const (
+	questionColumns = `
+		q.id, q.product_id, q.author_id, q.author_name, q.body, q.status, q.created_at, q.updated_at`
+	answerColumns = `
+		a.id, a.question_id, a.author_id, a.author_name, a.author_role, a.body, a.status, a.upvotes, a.created_at, a.updated_at`
+)
+
+type PostgresProductQuestionRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresProductQuestionRepository implements ProductQuestionRepository
+var _ ProductQuestionRepository = (*PostgresProductQuestionRepository)(nil)
+
+func NewProductQuestionRepository(db *sql.DB, logger *zap.Logger) ProductQuestionRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresProductQuestionRepository{
+		db:     db,
+		logger: logger.Named("ProductQuestionRepository"),
+	}
+}
+
+// CreateQuestion stores a question on a product of the current store
+func (r *PostgresProductQuestionRepository) CreateQuestion(ctx context.Context, question *models.ProductQuestion) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO product_questions (tenant_id, product_id, author_id, author_name, body, status)
+		SELECT $1, id, $3, $4, $5, $6
+		FROM products
+		WHERE id = $2 AND tenant_id = $1 AND deleted_at IS NULL
+		RETURNING id, created_at, updated_at`,
+		tenant.FromContext(ctx), question.ProductID, question.AuthorID, question.AuthorName, question.Body, question.Status,
+	).Scan(&question.ID, &question.CreatedAt, &question.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrProductNotFound
+		}
+		r.logger.Error("failed to create product question", zap.Error(err), zap.String("product_id", question.ProductID))
+		return fmt.Errorf("failed to create product question: %w", err)
+	}
+	return nil
+}
+
+// GetQuestion retrieves a question with its answers, only the approved ones
+// unless includeUnmoderated is set
+func (r *PostgresProductQuestionRepository) GetQuestion(ctx context.Context, id string, includeUnmoderated bool) (*models.ProductQuestion, error) {
+	question, err := scanQuestion(r.db.QueryRowContext(ctx, `
+		SELECT`+questionColumns+`
+		FROM product_questions q
+		WHERE q.id = $1 AND q.tenant_id = $2`,
+		id, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrProductQuestionNotFound
+		}
+		return nil, fmt.Errorf("failed to get product question: %w", err)
+	}
+
+	if err := r.attachAnswers(ctx, []*models.ProductQuestion{question}, includeUnmoderated); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+// ListQuestions returns the questions matching the filter with their answers,
+// and the number of matching questions
+func (r *PostgresProductQuestionRepository) ListQuestions(ctx context.Context, filter models.QuestionFilter) ([]*models.ProductQuestion, int, error) {
+	where := `
+		FROM product_questions q
+		WHERE q.tenant_id = $1
+			AND ($2 = '' OR q.product_id::text = $2)
+			AND ($3 = '' OR q.status = $3)`
+	args := []interface{}{tenant.FromContext(ctx), filter.ProductID, filter.Status}
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*)`+where, args...).Scan(&total); err != nil {
+		r.logger.Error("failed to count product questions", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count product questions: %w", err)
+	}
+
+	order := `q.created_at DESC`
+	if filter.Sort == models.QuestionSortTop {
+		order = `(
+			SELECT COALESCE(SUM(a.upvotes), 0)
+			FROM product_answers a
+			WHERE a.question_id = q.id AND a.status = 'approved'
+		) DESC, q.created_at DESC`
+	}
+	query := `SELECT` + questionColumns + where + `
+		ORDER BY ` + order + `
+		LIMIT $4 OFFSET $5`
+	rows, err := r.db.QueryContext(ctx, query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		r.logger.Error("failed to list product questions", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to list product questions: %w", err)
+	}
+	defer rows.Close()
+
+	questions := []*models.ProductQuestion{}
+	for rows.Next() {
+		question, err := scanQuestion(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan product question: %w", err)
+		}
+		questions = append(questions, question)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating product questions: %w", err)
+	}
+
+	if err := r.attachAnswers(ctx, questions, filter.IncludeUnmoderated); err != nil {
+		return nil, 0, err
+	}
+	return questions, total, nil
+}
+
+// UpdateQuestionStatus moves a question to a moderation state
+func (r *PostgresProductQuestionRepository) UpdateQuestionStatus(ctx context.Context, id, status string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE product_questions
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2 AND tenant_id = $3`,
+		status, id, tenant.FromContext(ctx))
+	return r.checkAffected(result, err, "update product question status", models.ErrProductQuestionNotFound)
+}
+
+// DeleteQuestion removes a question with its answers
+func (r *PostgresProductQuestionRepository) DeleteQuestion(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM product_questions
+		WHERE id = $1 AND tenant_id = $2`,
+		id, tenant.FromContext(ctx))
+	return r.checkAffected(result, err, "delete product question", models.ErrProductQuestionNotFound)
+}
+
+// CreateAnswer stores an answer to a question of the current store
+func (r *PostgresProductQuestionRepository) CreateAnswer(ctx context.Context, answer *models.ProductAnswer) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO product_answers (tenant_id, question_id, author_id, author_name, author_role, body, status)
+		SELECT $1, id, $3, $4, $5, $6, $7
+		FROM product_questions
+		WHERE id = $2 AND tenant_id = $1
+		RETURNING id, upvotes, created_at, updated_at`,
+		tenant.FromContext(ctx), answer.QuestionID, answer.AuthorID, answer.AuthorName, answer.AuthorRole, answer.Body, answer.Status,
+	).Scan(&answer.ID, &answer.Upvotes, &answer.CreatedAt, &answer.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrProductQuestionNotFound
+		}
+		r.logger.Error("failed to create product answer", zap.Error(err), zap.String("question_id", answer.QuestionID))
+		return fmt.Errorf("failed to create product answer: %w", err)
+	}
+	return nil
+}
+
+// GetAnswer retrieves an answer
+func (r *PostgresProductQuestionRepository) GetAnswer(ctx context.Context, id string) (*models.ProductAnswer, error) {
+	answer, err := scanAnswer(r.db.QueryRowContext(ctx, `
+		SELECT`+answerColumns+`
+		FROM product_answers a
+		WHERE a.id = $1 AND a.tenant_id = $2`,
+		id, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrProductAnswerNotFound
+		}
+		return nil, fmt.Errorf("failed to get product answer: %w", err)
+	}
+	return answer, nil
+}
+
+// UpdateAnswerStatus moves an answer to a moderation state
+func (r *PostgresProductQuestionRepository) UpdateAnswerStatus(ctx context.Context, id, status string) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE product_answers
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2 AND tenant_id = $3`,
+		status, id, tenant.FromContext(ctx))
+	return r.checkAffected(result, err, "update product answer status", models.ErrProductAnswerNotFound)
+}
+
+// DeleteAnswer removes an answer with its votes
+func (r *PostgresProductQuestionRepository) DeleteAnswer(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM product_answers
+		WHERE id = $1 AND tenant_id = $2`,
+		id, tenant.FromContext(ctx))
+	return r.checkAffected(result, err, "delete product answer", models.ErrProductAnswerNotFound)
+}
+
+// UpvoteAnswer records the upvote of a voter on an approved answer to an
+// approved question, and returns the answer. A voter's later upvotes of the
+// same answer are not counted.
+func (r *PostgresProductQuestionRepository) UpvoteAnswer(ctx context.Context, id, voterID string) (*models.ProductAnswer, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	answer, err := scanAnswer(tx.QueryRowContext(ctx, `
+		SELECT`+answerColumns+`
+		FROM product_answers a
+		JOIN product_questions q ON q.id = a.question_id
+		WHERE a.id = $1 AND a.tenant_id = $2 AND a.status = 'approved' AND q.status = 'approved'
+		FOR UPDATE OF a`,
+		id, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrProductAnswerNotFound
+		}
+		return nil, fmt.Errorf("failed to get product answer: %w", err)
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO product_answer_votes (answer_id, voter_id)
+		VALUES ($1, $2)
+		ON CONFLICT (answer_id, voter_id) DO NOTHING`,
+		answer.ID, voterID)
+	if err != nil {
+		r.logger.Error("failed to record answer vote", zap.Error(err), zap.String("answer_id", id))
+		return nil, fmt.Errorf("failed to record answer vote: %w", err)
+	}
+	if inserted, err := result.RowsAffected(); err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	} else if inserted == 0 {
+		return answer, nil
+	}
+
+	if err := tx.QueryRowContext(ctx, `
+		UPDATE product_answers
+		SET upvotes = upvotes + 1
+		WHERE id = $1
+		RETURNING upvotes`,
+		answer.ID,
+	).Scan(&answer.Upvotes); err != nil {
+		return nil, fmt.Errorf("failed to count answer vote: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit answer vote: %w", err)
+	}
+	return answer, nil
+}
+
+// attachAnswers loads the answers of questions in one query, most upvoted
+// first
+func (r *PostgresProductQuestionRepository) attachAnswers(ctx context.Context, questions []*models.ProductQuestion, includeUnmoderated bool) error {
+	if len(questions) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(questions))
+	byID := make(map[string]*models.ProductQuestion, len(questions))
+	for i, question := range questions {
+		ids[i] = question.ID
+		byID[question.ID] = question
+		question.Answers = []*models.ProductAnswer{}
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT`+answerColumns+`
+		FROM product_answers a
+		WHERE a.question_id::text = ANY($1) AND ($2 OR a.status = 'approved')
+		ORDER BY a.upvotes DESC, a.created_at`,
+		ids, includeUnmoderated)
+	if err != nil {
+		r.logger.Error("failed to list product answers", zap.Error(err))
+		return fmt.Errorf("failed to list product answers: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		answer, err := scanAnswer(rows)
+		if err != nil {
+			return fmt.Errorf("failed to scan product answer: %w", err)
+		}
+		if question, ok := byID[answer.QuestionID]; ok {
+			question.Answers = append(question.Answers, answer)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating product answers: %w", err)
+	}
+	return nil
+}
+
+func (r *PostgresProductQuestionRepository) checkAffected(result sql.Result, err error, action string, notFound error) error {
+	if err != nil {
+		return fmt.Errorf("failed to %s: %w", action, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
+
+func scanQuestion(row interface{ Scan(...interface{}) error }) (*models.ProductQuestion, error) {
+	question := &models.ProductQuestion{}
+	err := row.Scan(&question.ID, &question.ProductID, &question.AuthorID, &question.AuthorName,
+		&question.Body, &question.Status, &question.CreatedAt, &question.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+func scanAnswer(row interface{ Scan(...interface{}) error }) (*models.ProductAnswer, error) {
+	answer := &models.ProductAnswer{}
+	err := row.Scan(&answer.ID, &answer.QuestionID, &answer.AuthorID, &answer.AuthorName, &answer.AuthorRole,
+		&answer.Body, &answer.Status, &answer.Upvotes, &answer.CreatedAt, &answer.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return answer, nil
+}
@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresReconciliationRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresReconciliationRepository implements ReconciliationRepository
+var _ ReconciliationRepository = (*PostgresReconciliationRepository)(nil)
+
+func NewReconciliationRepository(db *sql.DB, logger *zap.Logger) ReconciliationRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresReconciliationRepository{
+		db:     db,
+		logger: logger.Named("ReconciliationRepository"),
+	}
+}
+
+// ListStockedSKUs returns the product and variant SKUs expected to have an
+// inventory item. Default variants often share the product SKU, in which case
+// the product-level SKU is kept.
+func (r *PostgresReconciliationRepository) ListStockedSKUs(ctx context.Context) ([]models.StockedSKU, error) {
+	query := `
+		WITH stocked AS (
+			SELECT p.id
+			FROM products p
+			WHERE p.tenant_id = $1 AND p.deleted_at IS NULL
+				AND NOT EXISTS (SELECT 1 FROM product_bundles pb WHERE pb.product_id = p.id)
+				AND NOT EXISTS (SELECT 1 FROM digital_assets da WHERE da.product_id = p.id)
+		)
+		SELECT DISTINCT ON (s.sku) s.product_id, s.variant_id, s.sku
+		FROM (
+			SELECT p.id AS product_id, NULL::uuid AS variant_id, p.sku
+			FROM products p
+			JOIN stocked ON stocked.id = p.id
+			UNION ALL
+			SELECT pv.product_id, pv.id, pv.sku
+			FROM product_variants pv
+			JOIN stocked ON stocked.id = pv.product_id
+			WHERE pv.deleted_at IS NULL
+		) s
+		WHERE s.sku <> ''
+		ORDER BY s.sku, s.variant_id NULLS FIRST`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("failed to list stocked SKUs", zap.Error(err))
+		return nil, fmt.Errorf("failed to list stocked SKUs: %w", err)
+	}
+	defer rows.Close()
+
+	var skus []models.StockedSKU
+	for rows.Next() {
+		var sku models.StockedSKU
+		var variantID sql.NullString
+		if err := rows.Scan(&sku.ProductID, &variantID, &sku.SKU); err != nil {
+			return nil, fmt.Errorf("failed to scan stocked SKU: %w", err)
+		}
+		if variantID.Valid {
+			sku.VariantID = &variantID.String
+		}
+		skus = append(skus, sku)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stocked SKUs: %w", err)
+	}
+
+	return skus, nil
+}
+
+// SaveReconciliation stores a reconciliation with its entries and sets its ID
+func (r *PostgresReconciliationRepository) SaveReconciliation(ctx context.Context, reconciliation *models.InventoryReconciliation) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO inventory_reconciliations (
+			tenant_id, auto_create, skus_checked, inventory_items_checked,
+			missing_count, orphaned_count, created_count, started_at, finished_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING id`,
+		tenant.FromContext(ctx), reconciliation.AutoCreate, reconciliation.SKUsChecked, reconciliation.InventoryItemsChecked,
+		reconciliation.MissingCount, reconciliation.OrphanedCount, reconciliation.CreatedCount,
+		reconciliation.StartedAt, reconciliation.FinishedAt,
+	).Scan(&reconciliation.ID)
+	if err != nil {
+		r.logger.Error("failed to save reconciliation", zap.Error(err))
+		return fmt.Errorf("failed to save reconciliation: %w", err)
+	}
+
+	if len(reconciliation.Entries) > 0 {
+		stmt, err := tx.PrepareContext(ctx, `
+			INSERT INTO inventory_reconciliation_entries (
+				reconciliation_id, kind, sku, product_id, variant_id, inventory_item_id, created, error
+			) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`)
+		if err != nil {
+			return fmt.Errorf("failed to prepare reconciliation entry insert: %w", err)
+		}
+		defer stmt.Close()
+
+		for _, entry := range reconciliation.Entries {
+			if _, err := stmt.ExecContext(ctx,
+				reconciliation.ID, entry.Kind, entry.SKU, entry.ProductID, entry.VariantID,
+				entry.InventoryItemID, entry.Created, entry.Error,
+			); err != nil {
+				r.logger.Error("failed to save reconciliation entry", zap.Error(err), zap.String("sku", entry.SKU))
+				return fmt.Errorf("failed to save reconciliation entry: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+const reconciliationColumns = `
+	id, auto_create, skus_checked, inventory_items_checked,
+	missing_count, orphaned_count, created_count, started_at, finished_at`
+
+func scanReconciliation(row interface{ Scan(...interface{}) error }) (*models.InventoryReconciliation, error) {
+	reconciliation := &models.InventoryReconciliation{}
+	err := row.Scan(
+		&reconciliation.ID, &reconciliation.AutoCreate, &reconciliation.SKUsChecked, &reconciliation.InventoryItemsChecked,
+		&reconciliation.MissingCount, &reconciliation.OrphanedCount, &reconciliation.CreatedCount,
+		&reconciliation.StartedAt, &reconciliation.FinishedAt,
+	)
+	return reconciliation, err
+}
+
+// ListReconciliations returns the most recent reconciliations of the store
+func (r *PostgresReconciliationRepository) ListReconciliations(ctx context.Context, limit int) ([]*models.InventoryReconciliation, error) {
+	query := `SELECT` + reconciliationColumns + `
+		FROM inventory_reconciliations
+		WHERE tenant_id = $1
+		ORDER BY started_at DESC
+		LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, tenant.FromContext(ctx), limit)
+	if err != nil {
+		r.logger.Error("failed to list reconciliations", zap.Error(err))
+		return nil, fmt.Errorf("failed to list reconciliations: %w", err)
+	}
+	defer rows.Close()
+
+	var reconciliations []*models.InventoryReconciliation
+	for rows.Next() {
+		reconciliation, err := scanReconciliation(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan reconciliation: %w", err)
+		}
+		reconciliations = append(reconciliations, reconciliation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reconciliations: %w", err)
+	}
+
+	return reconciliations, nil
+}
+
+// GetReconciliation returns a reconciliation of the store with its entries
+func (r *PostgresReconciliationRepository) GetReconciliation(ctx context.Context, id string) (*models.InventoryReconciliation, error) {
+	query := `SELECT` + reconciliationColumns + `
+		FROM inventory_reconciliations
+		WHERE id = $1 AND tenant_id = $2`
+
+	reconciliation, err := scanReconciliation(r.db.QueryRowContext(ctx, query, id, tenant.FromContext(ctx)))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrReconciliationNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get reconciliation", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to get reconciliation: %w", err)
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT kind, sku, product_id, variant_id, inventory_item_id, created, error
+		FROM inventory_reconciliation_entries
+		WHERE reconciliation_id = $1
+		ORDER BY kind, sku`, id)
+	if err != nil {
+		r.logger.Error("failed to get reconciliation entries", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to get reconciliation entries: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry models.ReconciliationEntry
+		if err := rows.Scan(
+			&entry.Kind, &entry.SKU, &entry.ProductID, &entry.VariantID,
+			&entry.InventoryItemID, &entry.Created, &entry.Error,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan reconciliation entry: %w", err)
+		}
+		reconciliation.Entries = append(reconciliation.Entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reconciliation entries: %w", err)
+	}
+
+	return reconciliation, nil
+}
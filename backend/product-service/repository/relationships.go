@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresRelationshipRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresRelationshipRepository implements RelationshipRepository
+var _ RelationshipRepository = (*PostgresRelationshipRepository)(nil)
+
+func NewRelationshipRepository(db *sql.DB, logger *zap.Logger) RelationshipRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresRelationshipRepository{
+		db:     db,
+		logger: logger.Named("RelationshipRepository"),
+	}
+}
+
+const relationshipColumns = `r.id, r.product_id, r.related_product_id, r.type, r.position, r.created_at, r.updated_at,
+	p.title, p.slug, p.is_published`
+
+func scanRelationship(row interface{ Scan(...any) error }) (*models.ProductRelationship, error) {
+	rel := &models.ProductRelationship{}
+	err := row.Scan(&rel.ID, &rel.ProductID, &rel.RelatedProductID, &rel.Type, &rel.Position,
+		&rel.CreatedAt, &rel.UpdatedAt, &rel.RelatedTitle, &rel.RelatedSlug, &rel.RelatedIsPublished)
+	if err != nil {
+		return nil, err
+	}
+	return rel, nil
+}
+
+// CreateRelationship links a product of the current store to another one
+func (r *PostgresRelationshipRepository) CreateRelationship(ctx context.Context, rel *models.ProductRelationship) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO product_relationships (tenant_id, product_id, related_product_id, type, position)
+		SELECT $1, p.id, related.id, $4, $5
+		FROM products p, products related
+		WHERE p.id = $2 AND p.tenant_id = $1 AND p.deleted_at IS NULL
+			AND related.id = $3 AND related.tenant_id = $1 AND related.deleted_at IS NULL
+		RETURNING id, created_at, updated_at`,
+		tenant.FromContext(ctx), rel.ProductID, rel.RelatedProductID, rel.Type, rel.Position,
+	).Scan(&rel.ID, &rel.CreatedAt, &rel.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrProductNotFound
+		}
+		if isUniqueViolation(err) {
+			return models.ErrRelationshipExists
+		}
+		r.logger.Error("failed to create product relationship", zap.Error(err), zap.String("product_id", rel.ProductID))
+		return fmt.Errorf("failed to create product relationship: %w", err)
+	}
+	return nil
+}
+
+// GetRelationship retrieves a link of a product with its linked product
+func (r *PostgresRelationshipRepository) GetRelationship(ctx context.Context, productID, id string) (*models.ProductRelationship, error) {
+	rel, err := scanRelationship(r.db.QueryRowContext(ctx, `
+		SELECT `+relationshipColumns+`
+		FROM product_relationships r
+		JOIN products p ON p.id = r.related_product_id
+		WHERE r.id = $1 AND r.product_id = $2 AND r.tenant_id = $3`,
+		id, productID, tenant.FromContext(ctx)))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, models.ErrRelationshipNotFound
+		}
+		return nil, fmt.Errorf("failed to get product relationship: %w", err)
+	}
+	return rel, nil
+}
+
+// ListRelationships returns the links of a product, of one type or all,
+// ordered by type and position. Links to deleted products are left out.
+func (r *PostgresRelationshipRepository) ListRelationships(ctx context.Context, productID, relType string) ([]*models.ProductRelationship, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+relationshipColumns+`
+		FROM product_relationships r
+		JOIN products p ON p.id = r.related_product_id AND p.deleted_at IS NULL
+		WHERE r.product_id = $1 AND r.tenant_id = $2 AND ($3 = '' OR r.type = $3)
+		ORDER BY r.type, r.position, r.created_at`,
+		productID, tenant.FromContext(ctx), relType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product relationships: %w", err)
+	}
+	return r.scanRelationships(rows)
+}
+
+// ListPublishedRelated returns up to limit links of each type from a product
+// to published products, ordered by type and position
+func (r *PostgresRelationshipRepository) ListPublishedRelated(ctx context.Context, productID string, limit int) ([]*models.ProductRelationship, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, product_id, related_product_id, type, position, created_at, updated_at,
+			title, slug, is_published
+		FROM (
+			SELECT `+relationshipColumns+`,
+				ROW_NUMBER() OVER (PARTITION BY r.type ORDER BY r.position, r.created_at) AS rank
+			FROM product_relationships r
+			JOIN products p ON p.id = r.related_product_id
+				AND p.deleted_at IS NULL AND p.is_published
+			WHERE r.product_id = $1 AND r.tenant_id = $2
+		) ranked
+		WHERE rank <= $3
+		ORDER BY type, rank`,
+		productID, tenant.FromContext(ctx), limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list related products: %w", err)
+	}
+	return r.scanRelationships(rows)
+}
+
+func (r *PostgresRelationshipRepository) scanRelationships(rows *sql.Rows) ([]*models.ProductRelationship, error) {
+	defer rows.Close()
+
+	relationships := []*models.ProductRelationship{}
+	for rows.Next() {
+		rel, err := scanRelationship(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan product relationship: %w", err)
+		}
+		relationships = append(relationships, rel)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate product relationships: %w", err)
+	}
+	return relationships, nil
+}
+
+// UpdateRelationshipPosition moves a link within its type
+func (r *PostgresRelationshipRepository) UpdateRelationshipPosition(ctx context.Context, productID, id string, position int) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE product_relationships
+		SET position = $1, updated_at = NOW()
+		WHERE id = $2 AND product_id = $3 AND tenant_id = $4`,
+		position, id, productID, tenant.FromContext(ctx))
+	return checkRelationshipAffected(result, err, "update")
+}
+
+// DeleteRelationship removes a link of a product
+func (r *PostgresRelationshipRepository) DeleteRelationship(ctx context.Context, productID, id string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM product_relationships
+		WHERE id = $1 AND product_id = $2 AND tenant_id = $3`,
+		id, productID, tenant.FromContext(ctx))
+	return checkRelationshipAffected(result, err, "delete")
+}
+
+func checkRelationshipAffected(result sql.Result, err error, action string) error {
+	if err != nil {
+		return fmt.Errorf("failed to %s product relationship: %w", action, err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return models.ErrRelationshipNotFound
+	}
+	return nil
+}
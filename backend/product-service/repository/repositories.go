@@ -6,7 +6,8 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/lib/pq"
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	"github.com/louai60/e-commerce_project/backend/product-service/models"
 	"go.uber.org/zap"
 )
@@ -79,10 +80,10 @@ func (r *PostgresProductRepository) CreateProduct(ctx context.Context, product *
 	).Scan(&product.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
-				if pqErr.Constraint == "products_slug_key" {
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				if pgErr.ConstraintName == "products_slug_key" {
 					return models.ErrProductSlugExists
 				}
 			}
@@ -330,7 +331,7 @@ func (r *PostgresProductRepository) GetBySlug(ctx context.Context, slug string)
 		&product.CreatedAt, &product.UpdatedAt,
 	)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("product not found")
+		return nil, models.ErrProductNotFound
 	}
 	if err != nil {
 		r.logger.Error("failed to get product", zap.Error(err))
@@ -401,10 +402,15 @@ func (r *PostgresProductRepository) GetBySlug(ctx context.Context, slug string)
 	return product, nil
 }
 
-func (r *PostgresProductRepository) List(ctx context.Context, offset, limit int) ([]*models.Product, int, error) {
+func (r *PostgresProductRepository) List(ctx context.Context, offset, limit int, channel string) ([]*models.Product, int, error) {
+	// An empty channel matches no hidden rows, so every product is listed
+	const channelFilter = `NOT EXISTS (
+            SELECT 1 FROM product_channels pch
+            WHERE pch.product_id = products.id AND pch.channel = $1 AND NOT pch.is_visible)`
+
 	var total int
 	// Remove deleted_at check initially to get total count
-	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM products WHERE deleted_at IS NULL").Scan(&total)
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM products WHERE deleted_at IS NULL AND "+channelFilter, channel).Scan(&total)
 	if err != nil {
 		r.logger.Error("failed to count products", zap.Error(err))
 		return nil, 0, fmt.Errorf("failed to count products: %w", err)
@@ -414,11 +420,11 @@ func (r *PostgresProductRepository) List(ctx context.Context, offset, limit int)
         SELECT id, title, slug, description, short_description, inventory_status,
                weight, is_published, brand_id, created_at, updated_at
         FROM products
-        WHERE deleted_at IS NULL
+        WHERE deleted_at IS NULL AND ` + channelFilter + `
         ORDER BY created_at DESC
-        LIMIT $1 OFFSET $2`
+        LIMIT $2 OFFSET $3`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, channel, limit, offset)
 	if err != nil {
 		r.logger.Error("failed to list products", zap.Error(err))
 		return nil, 0, fmt.Errorf("failed to list products: %w", err)
@@ -1110,8 +1116,8 @@ func (r *PostgresProductRepository) FixProductData(ctx context.Context, id strin
 			`, product.ID,
 				"Smart Fitness Tracker Watch | Health Monitoring | Your Brand",
 				"Track workouts, monitor health metrics, and stay connected with our advanced waterproof smartwatch featuring GPS and 7-day battery life.",
-				pq.Array([]string{"fitness watch", "health tracker", "smart wearable", "GPS watch"}),
-				pq.Array([]string{"fitness", "wearable tech", "smartwatch", "health"}),
+				[]string{"fitness watch", "health tracker", "smart wearable", "GPS watch"},
+				[]string{"fitness", "wearable tech", "smartwatch", "health"},
 				time.Now())
 
 			if err != nil {
@@ -1199,19 +1205,19 @@ func (r *PostgresBrandRepository) CreateBrand(ctx context.Context, brand *models
 
 	query := `
         INSERT INTO brands (
-            name, slug, description, created_at, updated_at, deleted_at
-        ) VALUES ($1, $2, $3, $4, $5, NULL)
+            name, slug, description, created_at, updated_at, deleted_at, tenant_id
+        ) VALUES ($1, $2, $3, $4, $5, NULL, $6)
         RETURNING id`
 
 	err := r.db.QueryRowContext(
 		ctx, query,
-		brand.Name, brand.Slug, brand.Description, now, now,
+		brand.Name, brand.Slug, brand.Description, now, now, tenant.FromContext(ctx),
 	).Scan(&brand.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return fmt.Errorf("brand already exists")
 			}
 		}
@@ -1227,14 +1233,14 @@ func (r *PostgresBrandRepository) GetBrandByID(ctx context.Context, id string) (
 	query := `
         SELECT id, name, slug, description, created_at, updated_at, deleted_at
         FROM brands
-        WHERE id = $1 AND deleted_at IS NULL`
+        WHERE id = $1 AND tenant_id = $2 AND deleted_at IS NULL`
 
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id, tenant.FromContext(ctx)).Scan(
 		&brand.ID, &brand.Name, &brand.Slug, &brand.Description,
 		&brand.CreatedAt, &brand.UpdatedAt, &brand.DeletedAt,
 	)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("brand not found")
+		return nil, models.ErrBrandNotFound
 	}
 	if err != nil {
 		r.logger.Error("failed to get brand", zap.Error(err))
@@ -1248,14 +1254,14 @@ func (r *PostgresBrandRepository) GetBrandBySlug(ctx context.Context, slug strin
 	query := `
         SELECT id, name, slug, description, created_at, updated_at, deleted_at
         FROM brands
-        WHERE slug = $1 AND deleted_at IS NULL`
+        WHERE slug = $1 AND tenant_id = $2 AND deleted_at IS NULL`
 
-	err := r.db.QueryRowContext(ctx, query, slug).Scan(
+	err := r.db.QueryRowContext(ctx, query, slug, tenant.FromContext(ctx)).Scan(
 		&brand.ID, &brand.Name, &brand.Slug, &brand.Description,
 		&brand.CreatedAt, &brand.UpdatedAt, &brand.DeletedAt,
 	)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("brand not found")
+		return nil, models.ErrBrandNotFound
 	}
 	if err != nil {
 		r.logger.Error("failed to get brand", zap.Error(err))
@@ -1265,8 +1271,10 @@ func (r *PostgresBrandRepository) GetBrandBySlug(ctx context.Context, slug strin
 }
 
 func (r *PostgresBrandRepository) ListBrands(ctx context.Context, offset, limit int) ([]*models.Brand, int, error) {
+	tenantID := tenant.FromContext(ctx)
+
 	var total int
-	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM brands WHERE deleted_at IS NULL").Scan(&total)
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM brands WHERE tenant_id = $1 AND deleted_at IS NULL", tenantID).Scan(&total)
 	if err != nil {
 		r.logger.Error("failed to count brands", zap.Error(err))
 		return nil, 0, fmt.Errorf("failed to count brands: %w", err)
@@ -1275,11 +1283,11 @@ func (r *PostgresBrandRepository) ListBrands(ctx context.Context, offset, limit
 	query := `
         SELECT id, name, slug, description, created_at, updated_at, deleted_at
         FROM brands
-        WHERE deleted_at IS NULL
+        WHERE tenant_id = $3 AND deleted_at IS NULL
         ORDER BY created_at DESC
         LIMIT $1 OFFSET $2`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, limit, offset, tenantID)
 	if err != nil {
 		r.logger.Error("failed to list brands", zap.Error(err))
 		return nil, 0, fmt.Errorf("failed to list brands: %w", err)
@@ -1311,20 +1319,23 @@ func (r *PostgresCategoryRepository) CreateCategory(ctx context.Context, categor
 
 	query := `
         INSERT INTO categories (
-            name, slug, description, parent_id, created_at, updated_at
-        ) VALUES ($1, $2, $3, $4, $5, $6)
-        RETURNING id`
+            name, slug, description, parent_id, created_at, updated_at, tenant_id, position
+        ) VALUES ($1, $2, $3, $4, $5, $6, $7, (
+            SELECT COALESCE(MAX(position) + 1, 0) FROM categories
+            WHERE tenant_id = $7 AND parent_id IS NOT DISTINCT FROM $4 AND deleted_at IS NULL
+        ))
+        RETURNING id, position`
 
 	err := r.db.QueryRowContext(
 		ctx, query,
 		category.Name, category.Slug, category.Description,
-		category.ParentID, now, now,
-	).Scan(&category.ID)
+		category.ParentID, now, now, tenant.FromContext(ctx),
+	).Scan(&category.ID, &category.Position)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
 				return fmt.Errorf("category already exists")
 			}
 		}
@@ -1338,20 +1349,20 @@ func (r *PostgresCategoryRepository) CreateCategory(ctx context.Context, categor
 func (r *PostgresCategoryRepository) GetCategoryByID(ctx context.Context, id string) (*models.Category, error) {
 	category := &models.Category{}
 	query := `
-        SELECT c.id, c.name, c.slug, c.description, c.parent_id, c.created_at, c.updated_at, c.deleted_at,
+        SELECT c.id, c.name, c.slug, c.description, c.parent_id, c.position, c.created_at, c.updated_at, c.deleted_at,
                p.name as parent_name
         FROM categories c
         LEFT JOIN categories p ON c.parent_id = p.id
-        WHERE c.id = $1 AND c.deleted_at IS NULL`
+        WHERE c.id = $1 AND c.tenant_id = $2 AND c.deleted_at IS NULL`
 
 	var parentName sql.NullString
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := r.db.QueryRowContext(ctx, query, id, tenant.FromContext(ctx)).Scan(
 		&category.ID, &category.Name, &category.Slug, &category.Description,
-		&category.ParentID, &category.CreatedAt, &category.UpdatedAt, &category.DeletedAt,
+		&category.ParentID, &category.Position, &category.CreatedAt, &category.UpdatedAt, &category.DeletedAt,
 		&parentName,
 	)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("category not found")
+		return nil, models.ErrCategoryNotFound
 	}
 	if err != nil {
 		r.logger.Error("failed to get category", zap.Error(err))
@@ -1369,20 +1380,20 @@ func (r *PostgresCategoryRepository) GetCategoryByID(ctx context.Context, id str
 func (r *PostgresCategoryRepository) GetCategoryBySlug(ctx context.Context, slug string) (*models.Category, error) {
 	category := &models.Category{}
 	query := `
-        SELECT c.id, c.name, c.slug, c.description, c.parent_id, c.created_at, c.updated_at, c.deleted_at,
+        SELECT c.id, c.name, c.slug, c.description, c.parent_id, c.position, c.created_at, c.updated_at, c.deleted_at,
                p.name as parent_name
         FROM categories c
         LEFT JOIN categories p ON c.parent_id = p.id
-        WHERE c.slug = $1 AND c.deleted_at IS NULL`
+        WHERE c.slug = $1 AND c.tenant_id = $2 AND c.deleted_at IS NULL`
 
 	var parentName sql.NullString
-	err := r.db.QueryRowContext(ctx, query, slug).Scan(
+	err := r.db.QueryRowContext(ctx, query, slug, tenant.FromContext(ctx)).Scan(
 		&category.ID, &category.Name, &category.Slug, &category.Description,
-		&category.ParentID, &category.CreatedAt, &category.UpdatedAt, &category.DeletedAt,
+		&category.ParentID, &category.Position, &category.CreatedAt, &category.UpdatedAt, &category.DeletedAt,
 		&parentName,
 	)
 	if err == sql.ErrNoRows {
-		return nil, fmt.Errorf("category not found")
+		return nil, models.ErrCategoryNotFound
 	}
 	if err != nil {
 		r.logger.Error("failed to get category", zap.Error(err))
@@ -1398,8 +1409,10 @@ func (r *PostgresCategoryRepository) GetCategoryBySlug(ctx context.Context, slug
 }
 
 func (r *PostgresCategoryRepository) ListCategories(ctx context.Context, offset, limit int) ([]*models.Category, int, error) {
+	tenantID := tenant.FromContext(ctx)
+
 	var total int
-	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM categories WHERE deleted_at IS NULL").Scan(&total)
+	err := r.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM categories WHERE tenant_id = $1 AND deleted_at IS NULL", tenantID).Scan(&total)
 	if err != nil {
 		r.logger.Error("failed to count categories", zap.Error(err))
 		return nil, 0, fmt.Errorf("failed to count categories: %w", err)
@@ -1407,15 +1420,15 @@ func (r *PostgresCategoryRepository) ListCategories(ctx context.Context, offset,
 
 	// Modified query to join with parent category to get parent_name
 	query := `
-        SELECT c.id, c.name, c.slug, c.description, c.parent_id, c.created_at, c.updated_at, c.deleted_at,
+        SELECT c.id, c.name, c.slug, c.description, c.parent_id, c.position, c.created_at, c.updated_at, c.deleted_at,
                p.name as parent_name
         FROM categories c
         LEFT JOIN categories p ON c.parent_id = p.id
-        WHERE c.deleted_at IS NULL
-        ORDER BY c.created_at DESC
+        WHERE c.tenant_id = $3 AND c.deleted_at IS NULL
+        ORDER BY c.position, c.created_at DESC
         LIMIT $1 OFFSET $2`
 
-	rows, err := r.db.QueryContext(ctx, query, limit, offset)
+	rows, err := r.db.QueryContext(ctx, query, limit, offset, tenantID)
 	if err != nil {
 		r.logger.Error("failed to list categories", zap.Error(err))
 		return nil, 0, fmt.Errorf("failed to list categories: %w", err)
@@ -1428,7 +1441,7 @@ func (r *PostgresCategoryRepository) ListCategories(ctx context.Context, offset,
 		var parentName sql.NullString
 		err := rows.Scan(
 			&category.ID, &category.Name, &category.Slug, &category.Description,
-			&category.ParentID, &category.CreatedAt, &category.UpdatedAt, &category.DeletedAt,
+			&category.ParentID, &category.Position, &category.CreatedAt, &category.UpdatedAt, &category.DeletedAt,
 			&parentName,
 		)
 		if err != nil {
@@ -1625,10 +1638,10 @@ func (r *PostgresProductRepository) CreateVariant(ctx context.Context, tx *sql.T
 	).Scan(&variant.ID)
 
 	if err != nil {
-		if pqErr, ok := err.(*pq.Error); ok {
-			switch pqErr.Code.Name() {
-			case "unique_violation":
-				if pqErr.Constraint == "product_variants_sku_key" {
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				if pgErr.ConstraintName == "product_variants_sku_key" {
 					return models.ErrVariantSKUExists
 				}
 			}
@@ -0,0 +1,390 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+const savedSearchColumns = `
+	id, tenant_id, user_id, name, query, COALESCE(category_id::text, ''), COALESCE(brand_id::text, ''),
+	min_price, max_price, alert_new_products, price_alert_below, last_evaluated_at, created_at, updated_at`
+
+type PostgresSavedSearchRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresSavedSearchRepository implements SavedSearchRepository
+var _ SavedSearchRepository = (*PostgresSavedSearchRepository)(nil)
+
+func NewSavedSearchRepository(db *sql.DB, logger *zap.Logger) SavedSearchRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresSavedSearchRepository{
+		db:     db,
+		logger: logger.Named("SavedSearchRepository"),
+	}
+}
+
+// SaveSearch creates a saved search of the current store, or replaces the
+// saved search of the same ID and user. Replacing it discards the results
+// of its last evaluation, so that the next one starts over without alerts.
+func (r *PostgresSavedSearchRepository) SaveSearch(ctx context.Context, search *models.SavedSearch) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	var row *sql.Row
+	if search.ID == "" {
+		row = tx.QueryRowContext(ctx, `
+			INSERT INTO saved_searches (tenant_id, user_id, name, query, category_id, brand_id,
+				min_price, max_price, alert_new_products, price_alert_below)
+			VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, NULLIF($6, '')::uuid, $7, $8, $9, $10)
+			RETURNING `+savedSearchColumns,
+			tenantID, search.UserID, search.Name, search.Query, search.CategoryID, search.BrandID,
+			search.MinPrice, search.MaxPrice, search.AlertNewProducts, search.PriceAlertBelow)
+	} else {
+		row = tx.QueryRowContext(ctx, `
+			UPDATE saved_searches
+			SET name = $4, query = $5, category_id = NULLIF($6, '')::uuid, brand_id = NULLIF($7, '')::uuid,
+				min_price = $8, max_price = $9, alert_new_products = $10, price_alert_below = $11,
+				last_evaluated_at = NULL, updated_at = NOW()
+			WHERE id = $1 AND tenant_id = $2 AND user_id = $3
+			RETURNING `+savedSearchColumns,
+			search.ID, tenantID, search.UserID, search.Name, search.Query, search.CategoryID, search.BrandID,
+			search.MinPrice, search.MaxPrice, search.AlertNewProducts, search.PriceAlertBelow)
+	}
+
+	saved, err := scanSavedSearch(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrSavedSearchNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			if pgErr.ConstraintName == "saved_searches_brand_id_fkey" {
+				return models.ErrBrandNotFound
+			}
+			return models.ErrCategoryNotFound
+		}
+		r.logger.Error("failed to save search", zap.Error(err), zap.String("user_id", search.UserID))
+		return fmt.Errorf("failed to save search: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `DELETE FROM saved_search_matches WHERE saved_search_id = $1`, saved.ID); err != nil {
+		return fmt.Errorf("failed to reset saved search matches: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit saved search: %w", err)
+	}
+	*search = *saved
+	return nil
+}
+
+// ListSavedSearches returns the saved searches of a user of the current
+// store, oldest first
+func (r *PostgresSavedSearchRepository) ListSavedSearches(ctx context.Context, userID string) ([]*models.SavedSearch, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+savedSearchColumns+`
+		FROM saved_searches
+		WHERE tenant_id = $1 AND user_id = $2
+		ORDER BY created_at, id`,
+		tenant.FromContext(ctx), userID)
+	if err != nil {
+		r.logger.Error("failed to list saved searches", zap.Error(err), zap.String("user_id", userID))
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	defer rows.Close()
+	return scanSavedSearches(rows)
+}
+
+// DeleteSavedSearch removes a saved search of a user with its pending alerts
+func (r *PostgresSavedSearchRepository) DeleteSavedSearch(ctx context.Context, id, userID string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM saved_searches
+		WHERE id = $1 AND tenant_id = $2 AND user_id = $3`,
+		id, tenant.FromContext(ctx), userID)
+	if err != nil {
+		r.logger.Error("failed to delete saved search", zap.Error(err), zap.String("id", id))
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	if affected == 0 {
+		return models.ErrSavedSearchNotFound
+	}
+	return nil
+}
+
+// ListSavedSearchesToEvaluate returns up to limit saved searches of all
+// stores with alerts that were not evaluated since evaluatedBefore, never
+// evaluated ones first
+func (r *PostgresSavedSearchRepository) ListSavedSearchesToEvaluate(ctx context.Context, evaluatedBefore time.Time, limit int) ([]*models.SavedSearch, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+savedSearchColumns+`
+		FROM saved_searches
+		WHERE (alert_new_products OR price_alert_below IS NOT NULL)
+			AND (last_evaluated_at IS NULL OR last_evaluated_at < $1)
+		ORDER BY last_evaluated_at NULLS FIRST, id
+		LIMIT $2`,
+		evaluatedBefore, limit)
+	if err != nil {
+		r.logger.Error("failed to list saved searches to evaluate", zap.Error(err))
+		return nil, fmt.Errorf("failed to list saved searches to evaluate: %w", err)
+	}
+	defer rows.Close()
+	return scanSavedSearches(rows)
+}
+
+// FindSavedSearchMatches returns up to limit published products of the
+// current store matching a saved search, cheapest first. Products match
+// when their title or description contains the query, ignoring case, and
+// they belong to the category of the search or one of its descendants.
+func (r *PostgresSavedSearchRepository) FindSavedSearchMatches(ctx context.Context, search *models.SavedSearch, limit int) ([]models.SavedSearchMatch, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH RECURSIVE subtree AS (
+			SELECT id, 0 AS depth
+			FROM categories
+			WHERE id = NULLIF($3, '')::uuid AND tenant_id = $1 AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, s.depth + 1
+			FROM categories c
+			JOIN subtree s ON c.parent_id = s.id
+			WHERE c.tenant_id = $1 AND c.deleted_at IS NULL AND s.depth < $8
+		)
+		SELECT p.id, p.title, COALESCE(p.discount_price, p.price) AS effective_price
+		FROM products p
+		WHERE p.tenant_id = $1 AND p.deleted_at IS NULL AND p.is_published
+			AND ($2 = '' OR POSITION(LOWER($2) IN LOWER(p.title || ' ' || COALESCE(p.description, ''))) > 0)
+			AND ($3 = '' OR EXISTS (
+				SELECT 1 FROM product_categories pc
+				WHERE pc.product_id = p.id AND pc.category_id IN (SELECT id FROM subtree)
+			))
+			AND ($4 = '' OR p.brand_id = NULLIF($4, '')::uuid)
+			AND ($5::decimal IS NULL OR COALESCE(p.discount_price, p.price) >= $5)
+			AND ($6::decimal IS NULL OR COALESCE(p.discount_price, p.price) <= $6)
+		ORDER BY effective_price, p.id
+		LIMIT $7`,
+		tenant.FromContext(ctx), search.Query, search.CategoryID, search.BrandID,
+		search.MinPrice, search.MaxPrice, limit, maxCategoryDepth)
+	if err != nil {
+		r.logger.Error("failed to find saved search matches", zap.Error(err), zap.String("saved_search_id", search.ID))
+		return nil, fmt.Errorf("failed to find saved search matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []models.SavedSearchMatch
+	for rows.Next() {
+		var match models.SavedSearchMatch
+		if err := rows.Scan(&match.ProductID, &match.Title, &match.Price); err != nil {
+			return nil, fmt.Errorf("failed to scan saved search match: %w", err)
+		}
+		matches = append(matches, match)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating saved search matches: %w", err)
+	}
+	return matches, nil
+}
+
+// GetSavedSearchMatches returns the prices of the products a saved search
+// matched when last evaluated, keyed by product ID
+func (r *PostgresSavedSearchRepository) GetSavedSearchMatches(ctx context.Context, searchID string) (map[string]float64, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT product_id, price FROM saved_search_matches WHERE saved_search_id = $1`,
+		searchID)
+	if err != nil {
+		r.logger.Error("failed to get saved search matches", zap.Error(err), zap.String("saved_search_id", searchID))
+		return nil, fmt.Errorf("failed to get saved search matches: %w", err)
+	}
+	defer rows.Close()
+
+	prices := make(map[string]float64)
+	for rows.Next() {
+		var productID string
+		var price float64
+		if err := rows.Scan(&productID, &price); err != nil {
+			return nil, fmt.Errorf("failed to scan saved search match: %w", err)
+		}
+		prices[productID] = price
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating saved search matches: %w", err)
+	}
+	return prices, nil
+}
+
+// SaveSavedSearchEvaluation replaces the matches of a saved search with
+// those of its evaluation and records its alerts, in one transaction
+func (r *PostgresSavedSearchRepository) SaveSavedSearchEvaluation(ctx context.Context, search *models.SavedSearch, matches []models.SavedSearchMatch, alerts []*models.SavedSearchAlert) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	// The search may have been replaced or deleted since it was listed; its
+	// new filters then start over at the next evaluation
+	result, err := tx.ExecContext(ctx, `
+		UPDATE saved_searches SET last_evaluated_at = NOW()
+		WHERE id = $1 AND updated_at = $2`,
+		search.ID, search.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update saved search: %w", err)
+	}
+	if affected, err := result.RowsAffected(); err != nil {
+		return fmt.Errorf("failed to update saved search: %w", err)
+	} else if affected == 0 {
+		return models.ErrSavedSearchNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM saved_search_matches WHERE saved_search_id = $1`, search.ID); err != nil {
+		return fmt.Errorf("failed to replace saved search matches: %w", err)
+	}
+	if len(matches) > 0 {
+		productIDs := make([]string, len(matches))
+		prices := make([]float64, len(matches))
+		for i, match := range matches {
+			productIDs[i] = match.ProductID
+			prices[i] = match.Price
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO saved_search_matches (saved_search_id, product_id, price)
+			SELECT $1, UNNEST($2::uuid[]), UNNEST($3::decimal[])`,
+			search.ID, productIDs, prices); err != nil {
+			return fmt.Errorf("failed to save saved search matches: %w", err)
+		}
+	}
+
+	for _, alert := range alerts {
+		if err := tx.QueryRowContext(ctx, `
+			INSERT INTO saved_search_alerts (tenant_id, saved_search_id, alert_type, product_id, product_title, price, previous_price)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id, created_at`,
+			search.TenantID, search.ID, alert.Type, alert.ProductID, alert.ProductTitle, alert.Price, alert.PreviousPrice,
+		).Scan(&alert.ID, &alert.CreatedAt); err != nil {
+			return fmt.Errorf("failed to record saved search alert: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit saved search evaluation: %w", err)
+	}
+	return nil
+}
+
+// ListPendingSavedSearchAlerts returns the alerts of all stores the
+// notification service has not acknowledged yet, oldest first
+func (r *PostgresSavedSearchRepository) ListPendingSavedSearchAlerts(ctx context.Context, limit int) ([]*models.SavedSearchAlert, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT a.id, a.tenant_id, a.saved_search_id, s.name, s.user_id, a.alert_type, a.product_id,
+			a.product_title, a.price, a.previous_price, a.created_at
+		FROM saved_search_alerts a
+		JOIN saved_searches s ON s.id = a.saved_search_id
+		WHERE a.delivered_at IS NULL
+		ORDER BY a.created_at
+		LIMIT $1`,
+		limit)
+	if err != nil {
+		r.logger.Error("failed to list saved search alerts", zap.Error(err))
+		return nil, fmt.Errorf("failed to list saved search alerts: %w", err)
+	}
+	defer rows.Close()
+
+	var alerts []*models.SavedSearchAlert
+	for rows.Next() {
+		alert := &models.SavedSearchAlert{}
+		var previousPrice sql.NullFloat64
+		if err := rows.Scan(
+			&alert.ID, &alert.TenantID, &alert.SavedSearchID, &alert.SavedSearchName, &alert.UserID, &alert.Type,
+			&alert.ProductID, &alert.ProductTitle, &alert.Price, &previousPrice, &alert.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan saved search alert: %w", err)
+		}
+		if previousPrice.Valid {
+			alert.PreviousPrice = &previousPrice.Float64
+		}
+		alerts = append(alerts, alert)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating saved search alerts: %w", err)
+	}
+	return alerts, nil
+}
+
+// MarkSavedSearchAlertsDelivered acknowledges alerts handled by the
+// notification service
+func (r *PostgresSavedSearchRepository) MarkSavedSearchAlertsDelivered(ctx context.Context, alertIDs []string) (int, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE saved_search_alerts
+		SET delivered_at = $2
+		WHERE id = ANY($1::uuid[]) AND delivered_at IS NULL`,
+		alertIDs, time.Now().UTC())
+	if err != nil {
+		r.logger.Error("failed to acknowledge saved search alerts", zap.Error(err))
+		return 0, fmt.Errorf("failed to acknowledge saved search alerts: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to acknowledge saved search alerts: %w", err)
+	}
+	return int(affected), nil
+}
+
+func scanSavedSearch(row interface{ Scan(...any) error }) (*models.SavedSearch, error) {
+	search := &models.SavedSearch{}
+	var minPrice, maxPrice, priceAlertBelow sql.NullFloat64
+	var lastEvaluatedAt sql.NullTime
+	if err := row.Scan(
+		&search.ID, &search.TenantID, &search.UserID, &search.Name, &search.Query, &search.CategoryID, &search.BrandID,
+		&minPrice, &maxPrice, &search.AlertNewProducts, &priceAlertBelow, &lastEvaluatedAt,
+		&search.CreatedAt, &search.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	if minPrice.Valid {
+		search.MinPrice = &minPrice.Float64
+	}
+	if maxPrice.Valid {
+		search.MaxPrice = &maxPrice.Float64
+	}
+	if priceAlertBelow.Valid {
+		search.PriceAlertBelow = &priceAlertBelow.Float64
+	}
+	if lastEvaluatedAt.Valid {
+		search.LastEvaluatedAt = &lastEvaluatedAt.Time
+	}
+	return search, nil
+}
+
+func scanSavedSearches(rows *sql.Rows) ([]*models.SavedSearch, error) {
+	var searches []*models.SavedSearch
+	for rows.Next() {
+		search, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved search: %w", err)
+		}
+		searches = append(searches, search)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating saved searches: %w", err)
+	}
+	return searches, nil
+}
@@ -0,0 +1,222 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/db"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+const searchRankingRuleColumns = `
+	id, name, kind, query, COALESCE(brand_id::text, ''), COALESCE(category_id::text, ''),
+	COALESCE(product_id::text, ''), weight, position, status, published_at, created_at, updated_at`
+
+type PostgresSearchRankingRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresSearchRankingRepository implements SearchRankingRepository
+var _ SearchRankingRepository = (*PostgresSearchRankingRepository)(nil)
+
+func NewSearchRankingRepository(db *sql.DB, logger *zap.Logger) SearchRankingRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresSearchRankingRepository{
+		db:     db,
+		logger: logger.Named("SearchRankingRepository"),
+	}
+}
+
+func scanSearchRankingRule(row interface{ Scan(...any) error }) (*models.SearchRankingRule, error) {
+	rule := &models.SearchRankingRule{}
+	var publishedAt sql.NullTime
+	err := row.Scan(&rule.ID, &rule.Name, &rule.Kind, &rule.Query, &rule.BrandID, &rule.CategoryID,
+		&rule.ProductID, &rule.Weight, &rule.Position, &rule.Status, &publishedAt, &rule.CreatedAt, &rule.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	if publishedAt.Valid {
+		rule.PublishedAt = &publishedAt.Time
+	}
+	return rule, nil
+}
+
+// SaveRule creates a draft rule of the current store when its ID is empty,
+// else replaces the rule of that ID and turns it back into a draft
+func (r *PostgresSearchRankingRepository) SaveRule(ctx context.Context, rule *models.SearchRankingRule) error {
+	tenantID := tenant.FromContext(ctx)
+	var row *sql.Row
+	if rule.ID == "" {
+		row = r.db.QueryRowContext(ctx, `
+			INSERT INTO search_ranking_rules (tenant_id, name, kind, query, brand_id, category_id, product_id, weight, position)
+			VALUES ($1, $2, $3, $4, NULLIF($5, '')::uuid, NULLIF($6, '')::uuid, NULLIF($7, '')::uuid, $8, $9)
+			RETURNING `+searchRankingRuleColumns,
+			tenantID, rule.Name, rule.Kind, rule.Query, rule.BrandID, rule.CategoryID, rule.ProductID,
+			rule.Weight, rule.Position)
+	} else {
+		row = r.db.QueryRowContext(ctx, `
+			UPDATE search_ranking_rules
+			SET name = $3, kind = $4, query = $5, brand_id = NULLIF($6, '')::uuid, category_id = NULLIF($7, '')::uuid,
+				product_id = NULLIF($8, '')::uuid, weight = $9, position = $10, status = 'draft', updated_at = NOW()
+			WHERE id = $1 AND tenant_id = $2
+			RETURNING `+searchRankingRuleColumns,
+			rule.ID, tenantID, rule.Name, rule.Kind, rule.Query, rule.BrandID, rule.CategoryID, rule.ProductID,
+			rule.Weight, rule.Position)
+	}
+
+	saved, err := scanSearchRankingRule(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ErrSearchRankingRuleNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgerrcode.ForeignKeyViolation {
+			switch pgErr.ConstraintName {
+			case "search_ranking_rules_brand_id_fkey":
+				return models.ErrBrandNotFound
+			case "search_ranking_rules_category_id_fkey":
+				return models.ErrCategoryNotFound
+			}
+			return models.ErrProductNotFound
+		}
+		r.logger.Error("failed to save search ranking rule", zap.Error(err), zap.String("id", rule.ID))
+		return fmt.Errorf("failed to save search ranking rule: %w", err)
+	}
+	*rule = *saved
+	return nil
+}
+
+// ListRules returns the rules of the current store, of one status or all,
+// oldest first
+func (r *PostgresSearchRankingRepository) ListRules(ctx context.Context, status string) ([]*models.SearchRankingRule, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+searchRankingRuleColumns+`
+		FROM search_ranking_rules
+		WHERE tenant_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY created_at, id`,
+		tenant.FromContext(ctx), status)
+	if err != nil {
+		r.logger.Error("failed to list search ranking rules", zap.Error(err))
+		return nil, fmt.Errorf("failed to list search ranking rules: %w", err)
+	}
+	defer rows.Close()
+
+	var rules []*models.SearchRankingRule
+	for rows.Next() {
+		rule, err := scanSearchRankingRule(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan search ranking rule: %w", err)
+		}
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating search ranking rules: %w", err)
+	}
+	return rules, nil
+}
+
+// DeleteRule removes a rule of the current store, published or not
+func (r *PostgresSearchRankingRepository) DeleteRule(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM search_ranking_rules WHERE id = $1 AND tenant_id = $2`,
+		id, tenant.FromContext(ctx))
+	if err != nil {
+		r.logger.Error("failed to delete search ranking rule", zap.Error(err), zap.String("id", id))
+		return fmt.Errorf("failed to delete search ranking rule: %w", err)
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return models.ErrSearchRankingRuleNotFound
+	}
+	return nil
+}
+
+// PublishRules publishes the draft rules of the current store of the given
+// IDs, or all of them when ids is empty, and returns the number published
+func (r *PostgresSearchRankingRepository) PublishRules(ctx context.Context, ids []string) (int, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE search_ranking_rules
+		SET status = 'published', published_at = NOW(), updated_at = NOW()
+		WHERE tenant_id = $1 AND status = 'draft'
+			AND (cardinality($2::uuid[]) = 0 OR id = ANY($2::uuid[]))`,
+		tenant.FromContext(ctx), ids)
+	if err != nil {
+		r.logger.Error("failed to publish search ranking rules", zap.Error(err))
+		return 0, fmt.Errorf("failed to publish search ranking rules: %w", err)
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return int(n), nil
+}
+
+// SearchProducts returns up to limit published products of the current
+// store matching all terms, ignoring case, most relevant first, and the
+// products of pinned whether they match or not. A term found in the title
+// scores 2 and in the description 1; without terms all products match with
+// no relevance, newest first. A category restricts the products to it and
+// its descendants.
+func (r *PostgresSearchRankingRepository) SearchProducts(ctx context.Context, terms []string, categoryID string, pinned []string, limit int) ([]*models.SearchHit, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH RECURSIVE subtree AS (
+			SELECT id, 0 AS depth
+			FROM categories
+			WHERE id = NULLIF($3, '')::uuid AND tenant_id = $1 AND deleted_at IS NULL
+			UNION ALL
+			SELECT c.id, s.depth + 1
+			FROM categories c
+			JOIN subtree s ON c.parent_id = s.id
+			WHERE c.tenant_id = $1 AND c.deleted_at IS NULL AND s.depth < $6
+		)
+		SELECT p.id, p.title, p.slug, COALESCE(p.discount_price, p.price), COALESCE(p.brand_id::text, ''),
+			ARRAY(SELECT pc.category_id::text FROM product_categories pc WHERE pc.product_id = p.id),
+			COALESCE((
+				SELECT SUM(CASE WHEN POSITION(t IN LOWER(p.title)) > 0 THEN 2 ELSE 0 END
+					+ CASE WHEN POSITION(t IN LOWER(COALESCE(p.description, ''))) > 0 THEN 1 ELSE 0 END)
+				FROM unnest($2::text[]) t
+			), 0) AS relevance
+		FROM products p
+		WHERE p.tenant_id = $1 AND p.deleted_at IS NULL AND p.is_published
+			AND (p.id = ANY($4::uuid[]) OR (
+				NOT EXISTS (
+					SELECT 1 FROM unnest($2::text[]) t
+					WHERE POSITION(t IN LOWER(p.title || ' ' || COALESCE(p.description, ''))) = 0
+				)
+				AND ($3 = '' OR EXISTS (
+					SELECT 1 FROM product_categories pc
+					WHERE pc.product_id = p.id AND pc.category_id IN (SELECT id FROM subtree)
+				))
+			))
+		ORDER BY relevance DESC, p.created_at DESC, p.id
+		LIMIT $5`,
+		tenant.FromContext(ctx), terms, categoryID, pinned, limit, maxCategoryDepth)
+	if err != nil {
+		r.logger.Error("failed to search products", zap.Error(err))
+		return nil, fmt.Errorf("failed to search products: %w", err)
+	}
+	defer rows.Close()
+
+	var hits []*models.SearchHit
+	for rows.Next() {
+		hit := &models.SearchHit{}
+		if err := rows.Scan(&hit.ProductID, &hit.Title, &hit.Slug, &hit.Price, &hit.BrandID,
+			db.ScanArray(&hit.CategoryIDs), &hit.Relevance); err != nil {
+			return nil, fmt.Errorf("failed to scan search hit: %w", err)
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating search hits: %w", err)
+	}
+	return hits, nil
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresSettingsRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresSettingsRepository implements SettingsRepository
+var _ SettingsRepository = (*PostgresSettingsRepository)(nil)
+
+func NewSettingsRepository(db *sql.DB, logger *zap.Logger) SettingsRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresSettingsRepository{
+		db:     db,
+		logger: logger.Named("SettingsRepository"),
+	}
+}
+
+// ListSettings returns the settings set for the current store, ordered by key
+func (r *PostgresSettingsRepository) ListSettings(ctx context.Context) ([]*models.Setting, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT key, value, updated_by, updated_at
+		FROM store_settings
+		WHERE tenant_id = $1
+		ORDER BY key`,
+		tenant.FromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list settings: %w", err)
+	}
+	defer rows.Close()
+
+	settings := []*models.Setting{}
+	for rows.Next() {
+		setting := &models.Setting{}
+		if err := rows.Scan(&setting.Key, &setting.Value, &setting.UpdatedBy, &setting.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan setting: %w", err)
+		}
+		settings = append(settings, setting)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate settings: %w", err)
+	}
+	return settings, nil
+}
+
+// SetSetting creates or replaces the value of a setting of the current store
+func (r *PostgresSettingsRepository) SetSetting(ctx context.Context, setting *models.Setting) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO store_settings (tenant_id, key, value, updated_by)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (tenant_id, key)
+		DO UPDATE SET value = EXCLUDED.value, updated_by = EXCLUDED.updated_by, updated_at = NOW()
+		RETURNING updated_at`,
+		tenant.FromContext(ctx), setting.Key, []byte(setting.Value), setting.UpdatedBy,
+	).Scan(&setting.UpdatedAt)
+	if err != nil {
+		r.logger.Error("failed to set setting", zap.Error(err), zap.String("key", setting.Key))
+		return fmt.Errorf("failed to set setting: %w", err)
+	}
+	return nil
+}
+
+// DeleteSetting unsets a setting of the current store
+func (r *PostgresSettingsRepository) DeleteSetting(ctx context.Context, key string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM store_settings WHERE tenant_id = $1 AND key = $2`,
+		tenant.FromContext(ctx), key)
+	if err != nil {
+		return fmt.Errorf("failed to delete setting: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return models.ErrSettingNotFound
+	}
+	return nil
+}
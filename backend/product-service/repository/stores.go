@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresStoreRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresStoreRepository implements StoreRepository
+var _ StoreRepository = (*PostgresStoreRepository)(nil)
+
+func NewStoreRepository(db *sql.DB, logger *zap.Logger) StoreRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresStoreRepository{
+		db:     db,
+		logger: logger.Named("StoreRepository"),
+	}
+}
+
+const storeColumns = `id, name, domain, default_currency, default_locale, is_active, created_at, updated_at`
+
+func scanStore(scanner interface{ Scan(...interface{}) error }, store *models.Store) error {
+	var domain sql.NullString
+	if err := scanner.Scan(
+		&store.ID, &store.Name, &domain, &store.DefaultCurrency, &store.DefaultLocale,
+		&store.IsActive, &store.CreatedAt, &store.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	if domain.Valid {
+		store.Domain = &domain.String
+	}
+	return nil
+}
+
+// CreateStore adds a new storefront
+func (r *PostgresStoreRepository) CreateStore(ctx context.Context, store *models.Store) error {
+	query := `
+		INSERT INTO stores (id, name, domain, default_currency, default_locale, is_active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+		RETURNING created_at, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query,
+		store.ID, store.Name, store.Domain, store.DefaultCurrency, store.DefaultLocale, store.IsActive, time.Now().UTC(),
+	).Scan(&store.CreatedAt, &store.UpdatedAt)
+	if err != nil {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
+			return models.ErrStoreExists
+		}
+		r.logger.Error("failed to create store", zap.Error(err), zap.String("id", store.ID))
+		return fmt.Errorf("failed to create store: %w", err)
+	}
+
+	return nil
+}
+
+// GetStore loads a storefront by its ID
+func (r *PostgresStoreRepository) GetStore(ctx context.Context, id string) (*models.Store, error) {
+	store := &models.Store{}
+
+	query := `SELECT ` + storeColumns + ` FROM stores WHERE id = $1`
+	err := scanStore(r.db.QueryRowContext(ctx, query, id), store)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrStoreNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get store", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to get store: %w", err)
+	}
+
+	return store, nil
+}
+
+// ListStores returns all storefronts ordered by ID
+func (r *PostgresStoreRepository) ListStores(ctx context.Context) ([]*models.Store, error) {
+	query := `SELECT ` + storeColumns + ` FROM stores ORDER BY id`
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		r.logger.Error("failed to list stores", zap.Error(err))
+		return nil, fmt.Errorf("failed to list stores: %w", err)
+	}
+	defer rows.Close()
+
+	var stores []*models.Store
+	for rows.Next() {
+		store := &models.Store{}
+		if err := scanStore(rows, store); err != nil {
+			return nil, fmt.Errorf("failed to scan store: %w", err)
+		}
+		stores = append(stores, store)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stores: %w", err)
+	}
+
+	return stores, nil
+}
+
+// UpdateStore changes the settings of an existing storefront
+func (r *PostgresStoreRepository) UpdateStore(ctx context.Context, store *models.Store) error {
+	query := `
+		UPDATE stores
+		SET name = $2, domain = $3, default_currency = $4, default_locale = $5, is_active = $6, updated_at = $7
+		WHERE id = $1
+		RETURNING created_at, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query,
+		store.ID, store.Name, store.Domain, store.DefaultCurrency, store.DefaultLocale, store.IsActive, time.Now().UTC(),
+	).Scan(&store.CreatedAt, &store.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return models.ErrStoreNotFound
+	}
+	if err != nil {
+		if pgErr, ok := asPgError(err); ok && pgErr.Code == pgerrcode.UniqueViolation {
+			return models.ErrStoreExists
+		}
+		r.logger.Error("failed to update store", zap.Error(err), zap.String("id", store.ID))
+		return fmt.Errorf("failed to update store: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,345 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresSubscriptionRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresSubscriptionRepository implements SubscriptionRepository
+var _ SubscriptionRepository = (*PostgresSubscriptionRepository)(nil)
+
+func NewSubscriptionRepository(db *sql.DB, logger *zap.Logger) SubscriptionRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresSubscriptionRepository{
+		db:     db,
+		logger: logger.Named("SubscriptionRepository"),
+	}
+}
+
+// UpsertSubscriptionPlan makes a product of the store a subscription product or
+// changes its plan. Existing subscriptions keep their current period.
+func (r *PostgresSubscriptionRepository) UpsertSubscriptionPlan(ctx context.Context, plan *models.SubscriptionPlan) error {
+	query := `
+		INSERT INTO product_subscription_plans (product_id, billing_interval, interval_count, trial_days, created_at, updated_at, tenant_id)
+		SELECT id, $2, $3, $4, $5, $5, tenant_id FROM products
+		WHERE id = $1 AND tenant_id = $6
+		ON CONFLICT (product_id) DO UPDATE SET
+			billing_interval = EXCLUDED.billing_interval,
+			interval_count = EXCLUDED.interval_count,
+			trial_days = EXCLUDED.trial_days,
+			updated_at = EXCLUDED.updated_at
+		RETURNING created_at, updated_at`
+
+	err := r.db.QueryRowContext(ctx, query,
+		plan.ProductID, plan.Interval, plan.IntervalCount, plan.TrialDays, time.Now().UTC(), tenant.FromContext(ctx),
+	).Scan(&plan.CreatedAt, &plan.UpdatedAt)
+	if err != nil {
+		// Nothing is inserted for products of other stores
+		if err == sql.ErrNoRows {
+			return models.ErrProductNotFound
+		}
+		r.logger.Error("failed to save subscription plan", zap.Error(err), zap.String("product_id", plan.ProductID))
+		return fmt.Errorf("failed to save subscription plan: %w", err)
+	}
+
+	return nil
+}
+
+// GetSubscriptionPlan loads the plan of a subscription product
+func (r *PostgresSubscriptionRepository) GetSubscriptionPlan(ctx context.Context, productID string) (*models.SubscriptionPlan, error) {
+	plan := &models.SubscriptionPlan{}
+
+	query := `
+		SELECT product_id, billing_interval, interval_count, trial_days, created_at, updated_at
+		FROM product_subscription_plans
+		WHERE product_id = $1 AND tenant_id = $2`
+
+	err := r.db.QueryRowContext(ctx, query, productID, tenant.FromContext(ctx)).Scan(
+		&plan.ProductID, &plan.Interval, &plan.IntervalCount, &plan.TrialDays, &plan.CreatedAt, &plan.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrSubscriptionPlanNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get subscription plan", zap.Error(err), zap.String("product_id", productID))
+		return nil, fmt.Errorf("failed to get subscription plan: %w", err)
+	}
+
+	return plan, nil
+}
+
+// subscriptionColumns lists the columns scanned by scanSubscription
+const subscriptionColumns = `id, tenant_id, product_id, user_id, purchase_id, status, price, currency,
+	current_period_start, current_period_end, trial_ends_at, cancel_at_period_end, cancelled_at, created_at, updated_at`
+
+func scanSubscription(scanner interface{ Scan(...interface{}) error }, sub *models.Subscription) error {
+	var trialEndsAt, cancelledAt sql.NullTime
+	if err := scanner.Scan(
+		&sub.ID, &sub.TenantID, &sub.ProductID, &sub.UserID, &sub.PurchaseID, &sub.Status, &sub.Price, &sub.Currency,
+		&sub.CurrentPeriodStart, &sub.CurrentPeriodEnd, &trialEndsAt, &sub.CancelAtPeriodEnd, &cancelledAt,
+		&sub.CreatedAt, &sub.UpdatedAt,
+	); err != nil {
+		return err
+	}
+	if trialEndsAt.Valid {
+		sub.TrialEndsAt = &trialEndsAt.Time
+	}
+	if cancelledAt.Valid {
+		sub.CancelledAt = &cancelledAt.Time
+	}
+	return nil
+}
+
+// CreateSubscription stores a new subscription of the store together with its
+// created event
+func (r *PostgresSubscriptionRepository) CreateSubscription(ctx context.Context, sub *models.Subscription, event *models.SubscriptionEvent) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	sub.TenantID = tenant.FromContext(ctx)
+	query := `
+		INSERT INTO subscriptions (product_id, user_id, purchase_id, status, price, currency,
+			current_period_start, current_period_end, trial_ends_at, created_at, updated_at, tenant_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10, $11)
+		RETURNING id, created_at, updated_at`
+
+	err = tx.QueryRowContext(ctx, query,
+		sub.ProductID, sub.UserID, sub.PurchaseID, sub.Status, sub.Price, sub.Currency,
+		sub.CurrentPeriodStart, sub.CurrentPeriodEnd, sub.TrialEndsAt, now, sub.TenantID,
+	).Scan(&sub.ID, &sub.CreatedAt, &sub.UpdatedAt)
+	if err != nil {
+		if pgErr, ok := asPgError(err); ok {
+			switch pgErr.Code {
+			case pgerrcode.UniqueViolation:
+				return models.ErrSubscriptionExists
+			case pgerrcode.ForeignKeyViolation:
+				return models.ErrProductNotFound
+			}
+		}
+		r.logger.Error("failed to create subscription", zap.Error(err), zap.String("purchase_id", sub.PurchaseID))
+		return fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	event.SubscriptionID = sub.ID
+	if err := insertSubscriptionEvent(ctx, tx, event); err != nil {
+		r.logger.Error("failed to record subscription event", zap.Error(err), zap.String("subscription_id", sub.ID))
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetSubscriptionByID loads a subscription of the store
+func (r *PostgresSubscriptionRepository) GetSubscriptionByID(ctx context.Context, id string) (*models.Subscription, error) {
+	sub := &models.Subscription{}
+
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions WHERE id = $1 AND tenant_id = $2`
+	err := scanSubscription(r.db.QueryRowContext(ctx, query, id, tenant.FromContext(ctx)), sub)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrSubscriptionNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to get subscription", zap.Error(err), zap.String("id", id))
+		return nil, fmt.Errorf("failed to get subscription: %w", err)
+	}
+
+	return sub, nil
+}
+
+// ListSubscriptions lists subscriptions of the store, newest first
+func (r *PostgresSubscriptionRepository) ListSubscriptions(ctx context.Context, filter models.SubscriptionFilter, offset, limit int) ([]*models.Subscription, int, error) {
+	where := `WHERE tenant_id = $3 AND ($1 = '' OR user_id = $1) AND ($2 = '' OR status = $2)`
+	tenantID := tenant.FromContext(ctx)
+
+	var total int
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM subscriptions `+where, filter.UserID, filter.Status, tenantID).Scan(&total); err != nil {
+		r.logger.Error("failed to count subscriptions", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to count subscriptions: %w", err)
+	}
+
+	query := `SELECT ` + subscriptionColumns + ` FROM subscriptions ` + where + `
+		ORDER BY created_at DESC
+		LIMIT $4 OFFSET $5`
+
+	rows, err := r.db.QueryContext(ctx, query, filter.UserID, filter.Status, tenantID, limit, offset)
+	if err != nil {
+		r.logger.Error("failed to list subscriptions", zap.Error(err))
+		return nil, 0, fmt.Errorf("failed to list subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*models.Subscription
+	for rows.Next() {
+		sub := &models.Subscription{}
+		if err := scanSubscription(rows, sub); err != nil {
+			r.logger.Error("failed to scan subscription", zap.Error(err))
+			return nil, 0, fmt.Errorf("failed to scan subscription: %w", err)
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+
+	return subscriptions, total, rows.Err()
+}
+
+// UpdateSubscriptionState writes the status, period and cancellation fields of
+// a subscription of the store and records an event in the same transaction.
+// The update only applies while the period still ends at previousPeriodEnd, so
+// a subscription that was changed concurrently is not renewed or cancelled twice.
+func (r *PostgresSubscriptionRepository) UpdateSubscriptionState(ctx context.Context, sub *models.Subscription, previousPeriodEnd time.Time, event *models.SubscriptionEvent) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE subscriptions
+		SET status = $3, current_period_start = $4, current_period_end = $5,
+			cancel_at_period_end = $6, cancelled_at = $7, updated_at = $8
+		WHERE id = $1 AND tenant_id = $9 AND current_period_end = $2 AND status <> 'cancelled'
+		RETURNING updated_at`
+
+	err = tx.QueryRowContext(ctx, query,
+		sub.ID, previousPeriodEnd, sub.Status, sub.CurrentPeriodStart, sub.CurrentPeriodEnd,
+		sub.CancelAtPeriodEnd, sub.CancelledAt, time.Now().UTC(), tenant.FromContext(ctx),
+	).Scan(&sub.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return models.ErrSubscriptionNotFound
+	}
+	if err != nil {
+		r.logger.Error("failed to update subscription", zap.Error(err), zap.String("id", sub.ID))
+		return fmt.Errorf("failed to update subscription: %w", err)
+	}
+
+	if event != nil {
+		event.SubscriptionID = sub.ID
+		if err := insertSubscriptionEvent(ctx, tx, event); err != nil {
+			r.logger.Error("failed to record subscription event", zap.Error(err), zap.String("subscription_id", sub.ID))
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// ListDueSubscriptions returns running subscriptions of all stores whose
+// current period has ended before the given time, oldest first
+func (r *PostgresSubscriptionRepository) ListDueSubscriptions(ctx context.Context, before time.Time, limit int) ([]*models.Subscription, error) {
+	query := `SELECT ` + subscriptionColumns + `
+		FROM subscriptions
+		WHERE status IN ('trialing', 'active') AND current_period_end <= $1
+		ORDER BY current_period_end
+		LIMIT $2`
+
+	rows, err := r.db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		r.logger.Error("failed to list due subscriptions", zap.Error(err))
+		return nil, fmt.Errorf("failed to list due subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*models.Subscription
+	for rows.Next() {
+		sub := &models.Subscription{}
+		if err := scanSubscription(rows, sub); err != nil {
+			return nil, fmt.Errorf("failed to scan subscription: %w", err)
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+
+	return subscriptions, rows.Err()
+}
+
+func insertSubscriptionEvent(ctx context.Context, tx *sql.Tx, event *models.SubscriptionEvent) error {
+	query := `
+		INSERT INTO subscription_events (subscription_id, event_type, amount, currency, period_start, period_end, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at`
+
+	err := tx.QueryRowContext(ctx, query,
+		event.SubscriptionID, event.Type, event.Amount, event.Currency, event.PeriodStart, event.PeriodEnd, time.Now().UTC(),
+	).Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record subscription event: %w", err)
+	}
+	return nil
+}
+
+// ListPendingSubscriptionEvents returns events not yet acknowledged by the
+// payment service, oldest first
+func (r *PostgresSubscriptionRepository) ListPendingSubscriptionEvents(ctx context.Context, limit int) ([]*models.SubscriptionEvent, error) {
+	query := `
+		SELECT e.id, e.subscription_id, e.event_type, e.amount, e.currency, e.period_start, e.period_end, e.created_at,
+			s.user_id, s.product_id
+		FROM subscription_events e
+		JOIN subscriptions s ON s.id = e.subscription_id
+		WHERE e.delivered_at IS NULL
+		ORDER BY e.created_at
+		LIMIT $1`
+
+	rows, err := r.db.QueryContext(ctx, query, limit)
+	if err != nil {
+		r.logger.Error("failed to list subscription events", zap.Error(err))
+		return nil, fmt.Errorf("failed to list subscription events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []*models.SubscriptionEvent
+	for rows.Next() {
+		event := &models.SubscriptionEvent{}
+		var periodStart, periodEnd sql.NullTime
+		if err := rows.Scan(
+			&event.ID, &event.SubscriptionID, &event.Type, &event.Amount, &event.Currency,
+			&periodStart, &periodEnd, &event.CreatedAt, &event.UserID, &event.ProductID,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan subscription event: %w", err)
+		}
+		if periodStart.Valid {
+			event.PeriodStart = &periodStart.Time
+		}
+		if periodEnd.Valid {
+			event.PeriodEnd = &periodEnd.Time
+		}
+		events = append(events, event)
+	}
+
+	return events, rows.Err()
+}
+
+// MarkSubscriptionEventsDelivered acknowledges events so they are not listed again
+func (r *PostgresSubscriptionRepository) MarkSubscriptionEventsDelivered(ctx context.Context, eventIDs []string) (int, error) {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE subscription_events
+		SET delivered_at = $2
+		WHERE id = ANY($1::uuid[]) AND delivered_at IS NULL`,
+		eventIDs, time.Now().UTC())
+	if err != nil {
+		r.logger.Error("failed to acknowledge subscription events", zap.Error(err))
+		return 0, fmt.Errorf("failed to acknowledge subscription events: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to acknowledge subscription events: %w", err)
+	}
+	return int(affected), nil
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"go.uber.org/zap"
+)
+
+type PostgresTranslationRepository struct {
+	db     *sql.DB
+	logger *zap.Logger
+}
+
+// Ensure PostgresTranslationRepository implements TranslationRepository
+var _ TranslationRepository = (*PostgresTranslationRepository)(nil)
+
+func NewTranslationRepository(db *sql.DB, logger *zap.Logger) TranslationRepository {
+	if db == nil {
+		logger.Fatal("database connection cannot be nil")
+		return nil
+	}
+	return &PostgresTranslationRepository{
+		db:     db,
+		logger: logger.Named("TranslationRepository"),
+	}
+}
+
+const translationColumns = `entity_type, entity_id, locale, name, short_description, description, created_at, updated_at`
+
+// SaveTranslation creates the translation of an entity of the current store
+// to its locale or replaces it
+func (r *PostgresTranslationRepository) SaveTranslation(ctx context.Context, translation *models.Translation) error {
+	// The entity type selects the table the entity must exist in
+	table, notFound := "products", models.ErrProductNotFound
+	switch translation.EntityType {
+	case models.TranslationEntityCategory:
+		table, notFound = "categories", models.ErrCategoryNotFound
+	case models.TranslationEntityPage:
+		table, notFound = "content_pages", models.ErrContentPageNotFound
+	case models.TranslationEntityBanner:
+		table, notFound = "content_banners", models.ErrContentBannerNotFound
+	}
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO catalog_translations (tenant_id, entity_type, entity_id, locale, name, short_description, description)
+		SELECT $1, $2, id, $4, $5, $6, $7
+		FROM `+table+`
+		WHERE id = $3 AND tenant_id = $1 AND deleted_at IS NULL
+		ON CONFLICT (tenant_id, entity_type, entity_id, locale) DO UPDATE SET
+			name = EXCLUDED.name,
+			short_description = EXCLUDED.short_description,
+			description = EXCLUDED.description,
+			updated_at = NOW()
+		RETURNING created_at, updated_at`,
+		tenant.FromContext(ctx), translation.EntityType, translation.EntityID, translation.Locale,
+		translation.Name, translation.ShortDescription, translation.Description,
+	).Scan(&translation.CreatedAt, &translation.UpdatedAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return notFound
+		}
+		r.logger.Error("failed to save translation", zap.Error(err),
+			zap.String("entity_type", translation.EntityType),
+			zap.String("entity_id", translation.EntityID),
+			zap.String("locale", translation.Locale))
+		return fmt.Errorf("failed to save translation: %w", err)
+	}
+	return nil
+}
+
+// ListTranslations returns the translations of an entity, ordered by locale
+func (r *PostgresTranslationRepository) ListTranslations(ctx context.Context, entityType, entityID string) ([]*models.Translation, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+translationColumns+`
+		FROM catalog_translations
+		WHERE tenant_id = $1 AND entity_type = $2 AND entity_id = $3
+		ORDER BY locale`,
+		tenant.FromContext(ctx), entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list translations: %w", err)
+	}
+	return scanTranslations(rows)
+}
+
+// FindTranslations returns the translations of the entities to any of the
+// locales
+func (r *PostgresTranslationRepository) FindTranslations(ctx context.Context, entityType string, entityIDs, locales []string) ([]*models.Translation, error) {
+	if len(entityIDs) == 0 || len(locales) == 0 {
+		return nil, nil
+	}
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+translationColumns+`
+		FROM catalog_translations
+		WHERE tenant_id = $1 AND entity_type = $2 AND entity_id = ANY($3) AND locale = ANY($4)`,
+		tenant.FromContext(ctx), entityType, entityIDs, locales)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find translations: %w", err)
+	}
+	return scanTranslations(rows)
+}
+
+// DeleteTranslation removes the translation of an entity to a locale
+func (r *PostgresTranslationRepository) DeleteTranslation(ctx context.Context, entityType, entityID, locale string) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM catalog_translations
+		WHERE tenant_id = $1 AND entity_type = $2 AND entity_id = $3 AND locale = $4`,
+		tenant.FromContext(ctx), entityType, entityID, locale)
+	if err != nil {
+		return fmt.Errorf("failed to delete translation: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if affected == 0 {
+		return models.ErrTranslationNotFound
+	}
+	return nil
+}
+
+func scanTranslations(rows *sql.Rows) ([]*models.Translation, error) {
+	defer rows.Close()
+
+	translations := []*models.Translation{}
+	for rows.Next() {
+		t := &models.Translation{}
+		if err := rows.Scan(&t.EntityType, &t.EntityID, &t.Locale, &t.Name, &t.ShortDescription,
+			&t.Description, &t.CreatedAt, &t.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan translation: %w", err)
+		}
+		translations = append(translations, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate translations: %w", err)
+	}
+	return translations, nil
+}
@@ -96,7 +96,7 @@ func (r *PostgresProductRepository) UpdateVariantImage(ctx context.Context, imag
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("variant image not found")
+		return models.ErrVariantImageNotFound
 	}
 
 	return nil
@@ -119,7 +119,7 @@ func (r *PostgresProductRepository) DeleteVariantImage(ctx context.Context, id s
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("variant image not found")
+		return models.ErrVariantImageNotFound
 	}
 
 	return nil
@@ -0,0 +1,421 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/badges"
+	"github.com/louai60/e-commerce_project/backend/product-service/events"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// badgeQueueSize bounds the stores waiting for their badges to be
+	// recomputed. Stores beyond it are caught up by the next scheduled
+	// recompute.
+	badgeQueueSize          = 100
+	maxSaleLines            = 500
+	maxOrderReferenceLength = 100
+)
+
+// BadgeService decides the display badges of products from the badge rules
+// of the store. Badges are precomputed for every product of a store when
+// its products or rules change and periodically, as products age and sell,
+// so that serving them with product lists and details is a single lookup.
+type BadgeService struct {
+	badgeRepo   repository.BadgeRepository
+	storeRepo   repository.StoreRepository
+	salesWindow time.Duration
+	logger      *zap.Logger
+	queue       chan string
+
+	// running prevents overlapping recomputes
+	running sync.Mutex
+}
+
+// NewBadgeService creates a new badge service listening to the product
+// events of bus. Bestsellers are ranked by the units sold over salesWindow.
+func NewBadgeService(
+	badgeRepo repository.BadgeRepository,
+	storeRepo repository.StoreRepository,
+	salesWindow time.Duration,
+	bus *events.Bus,
+	logger *zap.Logger,
+) *BadgeService {
+	s := &BadgeService{
+		badgeRepo:   badgeRepo,
+		storeRepo:   storeRepo,
+		salesWindow: salesWindow,
+		logger:      logger,
+		queue:       make(chan string, badgeQueueSize),
+	}
+	bus.Subscribe(func(_ context.Context, event events.Event) {
+		s.enqueue(event.TenantID)
+	}, events.ProductCreated, events.ProductUpdated, events.ProductDeleted)
+	return s
+}
+
+func (s *BadgeService) enqueue(tenantID string) {
+	select {
+	case s.queue <- tenantID:
+	default:
+		s.logger.Warn("Badge queue full, dropping store recompute", zap.String("tenant_id", tenantID))
+	}
+}
+
+// Start recomputes the badges of queued stores until the context is
+// cancelled. Stores queued several times while waiting are recomputed once.
+func (s *BadgeService) Start(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case tenantID := <-s.queue:
+				pending := map[string]bool{tenantID: true}
+				for drained := false; !drained; {
+					select {
+					case tenantID := <-s.queue:
+						pending[tenantID] = true
+					default:
+						drained = true
+					}
+				}
+				for tenantID := range pending {
+					s.running.Lock()
+					_, _, err := s.recompute(tenant.WithTenant(ctx, tenantID))
+					s.running.Unlock()
+					if err != nil {
+						s.logger.Warn("Failed to recompute badges", zap.String("tenant_id", tenantID), zap.Error(err))
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Recompute evaluates the badges of every product of the store in ctx and
+// returns the number of products evaluated and of products with badges
+func (s *BadgeService) Recompute(ctx context.Context) (products, badged int, err error) {
+	if !s.running.TryLock() {
+		return 0, 0, status.Error(codes.Aborted, "a badge recompute is already running")
+	}
+	defer s.running.Unlock()
+	return s.recompute(ctx)
+}
+
+func (s *BadgeService) recompute(ctx context.Context) (products, badged int, err error) {
+	rules, _, err := s.loadRules(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	now := time.Now().UTC()
+	facts, err := s.badgeRepo.ListBadgeFacts(ctx, now, now.Add(-s.salesWindow))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	evaluator := badges.NewEvaluator(rules, facts, now)
+	assigned := make(map[string][]string)
+	for _, f := range facts {
+		if badgeCodes := evaluator.Badges(f); len(badgeCodes) > 0 {
+			assigned[f.ProductID] = badgeCodes
+		}
+	}
+	if err := s.badgeRepo.ReplaceProductBadges(ctx, assigned, now); err != nil {
+		return 0, 0, err
+	}
+
+	s.logger.Info("Badges recomputed",
+		zap.String("tenant_id", tenant.FromContext(ctx)),
+		zap.Int("products", len(facts)),
+		zap.Int("badged", len(assigned)))
+
+	return len(facts), len(assigned), nil
+}
+
+// StartBadgeScheduler recomputes the badges of every active store at the
+// given interval until the context is cancelled
+func (s *BadgeService) StartBadgeScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Badge scheduler stopped")
+				return
+			case <-ticker.C:
+				s.recomputeAllStores(ctx)
+			}
+		}
+	}()
+}
+
+func (s *BadgeService) recomputeAllStores(ctx context.Context) {
+	stores, err := s.storeRepo.ListStores(ctx)
+	if err != nil {
+		s.logger.Error("Scheduled badge recompute failed", zap.Error(err))
+		return
+	}
+
+	for _, shop := range stores {
+		if !shop.IsActive {
+			continue
+		}
+		if _, _, err := s.Recompute(tenant.WithTenant(ctx, shop.ID)); err != nil {
+			s.logger.Error("Scheduled badge recompute failed", zap.String("tenant_id", shop.ID), zap.Error(err))
+		}
+	}
+}
+
+// loadRules returns the effective badge rules of the store in ctx, in
+// display order, and the rules it saved by code
+func (s *BadgeService) loadRules(ctx context.Context) ([]badges.Rule, map[string]*models.BadgeRule, error) {
+	saved, err := s.badgeRepo.ListBadgeRules(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	overrides := make([]badges.Rule, len(saved))
+	byCode := make(map[string]*models.BadgeRule, len(saved))
+	for i, rule := range saved {
+		overrides[i] = badges.Rule{
+			Code:       rule.Code,
+			Label:      rule.Label,
+			Color:      rule.Color,
+			Kind:       rule.Kind,
+			Days:       rule.Days,
+			Percent:    rule.Percent,
+			ProductIDs: rule.ProductIDs,
+			Priority:   rule.Priority,
+			Active:     rule.Active,
+		}
+		byCode[rule.Code] = rule
+	}
+	return badges.Effective(overrides), byCode, nil
+}
+
+// AttachBadges sets the precomputed badges of the products. Lookup failures
+// are logged and leave the products without badges.
+func (s *BadgeService) AttachBadges(ctx context.Context, products ...*pb.Product) {
+	ids := make([]string, 0, len(products))
+	for _, product := range products {
+		if product != nil {
+			ids = append(ids, product.Id)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	assigned, err := s.badgeRepo.ListProductBadges(ctx, ids)
+	if err != nil {
+		s.logger.Warn("Failed to list product badges", zap.Error(err))
+		return
+	}
+	if len(assigned) == 0 {
+		return
+	}
+	rules, _, err := s.loadRules(ctx)
+	if err != nil {
+		s.logger.Warn("Failed to load badge rules", zap.Error(err))
+		return
+	}
+
+	byCode := make(map[string]badges.Rule, len(rules))
+	for _, rule := range rules {
+		byCode[rule.Code] = rule
+	}
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		for _, code := range assigned[product.Id] {
+			// Badges deactivated since the last recompute are left out
+			if rule, ok := byCode[code]; ok && rule.Active {
+				product.Badges = append(product.Badges, &pb.ProductBadge{
+					Code:  rule.Code,
+					Label: rule.Label,
+					Color: rule.Color,
+				})
+			}
+		}
+	}
+}
+
+// ListBadgeRules lists the badges of the current store: the built-in ones,
+// as overridden by the store, and its custom ones, in display order
+func (s *BadgeService) ListBadgeRules(ctx context.Context, req *pb.ListBadgeRulesRequest) (*pb.ListBadgeRulesResponse, error) {
+	rules, saved, err := s.loadRules(ctx)
+	if err != nil {
+		return nil, s.badgeError("Failed to list badge rules", err)
+	}
+
+	resp := &pb.ListBadgeRulesResponse{Rules: make([]*pb.BadgeRule, len(rules))}
+	for i, rule := range rules {
+		resp.Rules[i] = badgeRuleToProto(rule, saved[rule.Code])
+	}
+	return resp, nil
+}
+
+// SaveBadgeRule creates or replaces a badge of the current store. Saving the
+// code of a built-in badge overrides it, keeping its kind. Products pick up
+// the change on the next recompute, which is queued right away.
+func (s *BadgeService) SaveBadgeRule(ctx context.Context, req *pb.SaveBadgeRuleRequest) (*pb.BadgeRule, error) {
+	rule := badges.Rule{
+		Code:       strings.ToLower(strings.TrimSpace(req.Code)),
+		Label:      strings.TrimSpace(req.Label),
+		Color:      strings.TrimSpace(req.Color),
+		Kind:       req.Kind,
+		Days:       int(req.Days),
+		Percent:    req.Percent,
+		ProductIDs: req.ProductIds,
+		Priority:   int(req.Priority),
+		Active:     req.Active,
+	}
+	if err := badges.Validate(rule); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	for _, builtIn := range badges.Defaults {
+		if builtIn.Code == rule.Code && builtIn.Kind != rule.Kind {
+			return nil, status.Errorf(codes.InvalidArgument, "built-in badge %q must keep the %s kind", rule.Code, builtIn.Kind)
+		}
+	}
+	// Only keep the settings of the kind of the rule
+	if rule.Kind != badges.KindNew {
+		rule.Days = 0
+	}
+	if rule.Kind != badges.KindBestseller {
+		rule.Percent = 0
+	}
+	if rule.Kind != badges.KindManual {
+		rule.ProductIDs = nil
+	}
+	for _, id := range rule.ProductIDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid product ID %q", id)
+		}
+	}
+
+	saved := &models.BadgeRule{
+		Code:       rule.Code,
+		Label:      rule.Label,
+		Color:      rule.Color,
+		Kind:       rule.Kind,
+		Days:       rule.Days,
+		Percent:    rule.Percent,
+		ProductIDs: rule.ProductIDs,
+		Priority:   rule.Priority,
+		Active:     rule.Active,
+	}
+	if err := s.badgeRepo.SaveBadgeRule(ctx, saved); err != nil {
+		return nil, s.badgeError("Failed to save badge rule", err)
+	}
+	s.enqueue(tenant.FromContext(ctx))
+
+	return badgeRuleToProto(rule, saved), nil
+}
+
+// DeleteBadgeRule deletes a custom badge of the current store, or resets a
+// built-in badge to its defaults
+func (s *BadgeService) DeleteBadgeRule(ctx context.Context, req *pb.DeleteBadgeRuleRequest) (*pb.DeleteBadgeRuleResponse, error) {
+	if err := s.badgeRepo.DeleteBadgeRule(ctx, req.Code); err != nil {
+		return nil, s.badgeError("Failed to delete badge rule", err)
+	}
+	s.enqueue(tenant.FromContext(ctx))
+
+	return &pb.DeleteBadgeRuleResponse{Success: true}, nil
+}
+
+// RecomputeBadges evaluates the badges of every product of the current store
+// right away
+func (s *BadgeService) RecomputeBadges(ctx context.Context, req *pb.RecomputeBadgesRequest) (*pb.RecomputeBadgesResponse, error) {
+	products, badged, err := s.Recompute(ctx)
+	if err != nil {
+		return nil, s.badgeError("Failed to recompute badges", err)
+	}
+	return &pb.RecomputeBadgesResponse{Products: int32(products), Badged: int32(badged)}, nil
+}
+
+// RecordProductSales records the units of products sold by an order of the
+// current store. Lines of the same product are added up; reporting an order
+// again is a no-op for the products it already reported.
+func (s *BadgeService) RecordProductSales(ctx context.Context, req *pb.RecordProductSalesRequest) (*pb.RecordProductSalesResponse, error) {
+	reference := strings.TrimSpace(req.OrderReference)
+	if reference == "" || len(reference) > maxOrderReferenceLength {
+		return nil, status.Errorf(codes.InvalidArgument, "order reference must be 1 to %d characters", maxOrderReferenceLength)
+	}
+	if len(req.Lines) == 0 || len(req.Lines) > maxSaleLines {
+		return nil, status.Errorf(codes.InvalidArgument, "an order must report 1 to %d lines", maxSaleLines)
+	}
+
+	var sales []models.ProductSale
+	index := make(map[string]int, len(req.Lines))
+	for _, line := range req.Lines {
+		if _, err := uuid.Parse(line.ProductId); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid product ID %q", line.ProductId)
+		}
+		if line.Quantity <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "quantities must be positive")
+		}
+		if i, ok := index[line.ProductId]; ok {
+			sales[i].Quantity += int(line.Quantity)
+			continue
+		}
+		index[line.ProductId] = len(sales)
+		sales = append(sales, models.ProductSale{ProductID: line.ProductId, Quantity: int(line.Quantity)})
+	}
+
+	recorded, err := s.badgeRepo.RecordProductSales(ctx, reference, sales)
+	if err != nil {
+		return nil, s.badgeError("Failed to record product sales", err)
+	}
+	return &pb.RecordProductSalesResponse{Recorded: int32(recorded)}, nil
+}
+
+func (s *BadgeService) badgeError(message string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, models.ErrBadgeRuleNotFound):
+		return status.Error(codes.NotFound, "badge rule not found")
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func badgeRuleToProto(rule badges.Rule, saved *models.BadgeRule) *pb.BadgeRule {
+	proto := &pb.BadgeRule{
+		Code:       rule.Code,
+		Label:      rule.Label,
+		Color:      rule.Color,
+		Kind:       rule.Kind,
+		Days:       int32(rule.Days),
+		Percent:    rule.Percent,
+		ProductIds: rule.ProductIDs,
+		Priority:   int32(rule.Priority),
+		Active:     rule.Active,
+		BuiltIn:    badges.IsBuiltIn(rule.Code),
+	}
+	if saved != nil {
+		proto.UpdatedAt = timestamppb.New(saved.UpdatedAt)
+	}
+	return proto
+}
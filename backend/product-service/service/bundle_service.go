@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// CreateBundle creates a bundle product composed of existing SKUs. The bundle is
+// stored as a regular product with a single default variant priced at the price
+// override, or at the sum of its components when no override is given.
+func (s *ProductService) CreateBundle(ctx context.Context, req *pb.CreateBundleRequest) (*pb.Product, error) {
+	bundle := &models.ProductBundle{
+		Components: make([]models.BundleComponent, len(req.Components)),
+	}
+	for i, component := range req.Components {
+		bundle.Components[i] = models.BundleComponent{
+			SKU:      component.Sku,
+			Quantity: int(component.Quantity),
+		}
+	}
+	if req.PriceOverride != nil {
+		priceOverride := req.PriceOverride.Value
+		bundle.PriceOverride = &priceOverride
+	}
+
+	if err := s.bundleRepo.ResolveBundleComponents(ctx, bundle.Components); err != nil {
+		return nil, s.bundleError("Failed to resolve bundle components", err)
+	}
+
+	s.logger.Info("Creating bundle",
+		zap.String("title", req.Product.Title),
+		zap.Int("components", len(bundle.Components)),
+		zap.Float64("price", bundle.Price()))
+
+	// The bundle itself is sold as a single unit
+	product := req.Product
+	product.Price = bundle.Price()
+	product.Variants = nil
+
+	created, err := s.CreateProduct(ctx, &pb.CreateProductRequest{Product: product})
+	if err != nil {
+		return nil, err
+	}
+
+	bundle.ProductID = created.Id
+	if err := s.bundleRepo.CreateBundle(ctx, bundle); err != nil {
+		// Remove the product again so that a failed bundle does not remain as a plain product
+		if deleteErr := s.productRepo.DeleteProduct(ctx, created.Id); deleteErr != nil {
+			s.logger.Error("Failed to remove product of failed bundle",
+				zap.String("product_id", created.Id),
+				zap.Error(deleteErr))
+		}
+		return nil, s.bundleError("Failed to create bundle", err)
+	}
+
+	// CreateProduct cached the product before its bundle existed
+	if err := s.cacheManager.InvalidateProduct(ctx, created.Id); err != nil {
+		s.logger.Warn("Failed to invalidate bundle product cache",
+			zap.String("product_id", created.Id),
+			zap.Error(err))
+	}
+
+	return s.GetProduct(ctx, &pb.GetProductRequest{
+		Identifier: &pb.GetProductRequest_Id{Id: created.Id},
+	})
+}
+
+// bundleError logs a repository error and maps it to a gRPC status
+func (s *ProductService) bundleError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrBundleComponentNotFound),
+		errors.Is(err, models.ErrBundleComponentIsBundle):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrBundleNotFound):
+		return status.Error(codes.NotFound, "bundle not found")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertBundleModelToProto(model *models.ProductBundle) *pb.ProductBundle {
+	if model == nil {
+		return nil
+	}
+
+	protoBundle := &pb.ProductBundle{
+		Components:      make([]*pb.BundleComponent, len(model.Components)),
+		ComponentsPrice: model.ComponentsPrice(),
+	}
+	for i, component := range model.Components {
+		protoBundle.Components[i] = &pb.BundleComponent{
+			Sku:       component.SKU,
+			Quantity:  int32(component.Quantity),
+			ProductId: component.ProductID,
+			VariantId: component.VariantID,
+			Title:     component.Title,
+			UnitPrice: component.UnitPrice,
+		}
+	}
+	if model.PriceOverride != nil {
+		protoBundle.PriceOverride = wrapperspb.Double(*model.PriceOverride)
+	}
+
+	return protoBundle
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/cache"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+)
+
+// FlushCacheNamespace removes the cached entries of a namespace for every
+// store, e.g. after fixing data written to the database directly
+func (s *ProductService) FlushCacheNamespace(ctx context.Context, req *pb.FlushCacheNamespaceRequest) (*pb.FlushCacheNamespaceResponse, error) {
+	version, err := cache.NamespaceVersion(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "namespace must be one of %s", strings.Join(cache.Namespaces(), ", "))
+	}
+
+	if err := s.cacheManager.FlushNamespace(ctx, req.Namespace); err != nil {
+		s.logger.Error("Failed to flush cache namespace", zap.String("namespace", req.Namespace), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to flush cache namespace")
+	}
+
+	s.logger.Info("Flushed cache namespace", zap.String("namespace", req.Namespace), zap.Int("version", version))
+	return &pb.FlushCacheNamespaceResponse{
+		Namespace: req.Namespace,
+		Version:   int32(version),
+	}, nil
+}
@@ -0,0 +1,333 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/cacheschedule"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultCacheScheduleRunLimit = 20
+	maxCacheScheduleRunLimit     = 100
+)
+
+// CacheScheduleService runs the cache schedules of the config: at their
+// times of day, it invalidates their cache namespace for every store and
+// warms their queries again for every active store, so that the first
+// shoppers after the invalidation are served from the cache. Runs are
+// recorded, and scheduled runs are claimed in the database so that a single
+// instance of the service runs them.
+type CacheScheduleService struct {
+	runRepo           repository.CacheScheduleRepository
+	storeRepo         repository.StoreRepository
+	productService    *ProductService
+	collectionService *CollectionService
+	schedules         []cacheschedule.Schedule
+	logger            *zap.Logger
+
+	// running prevents overlapping runs
+	running sync.Mutex
+}
+
+// NewCacheScheduleService creates a new cache schedule service running
+// compiled schedules
+func NewCacheScheduleService(
+	runRepo repository.CacheScheduleRepository,
+	storeRepo repository.StoreRepository,
+	productService *ProductService,
+	collectionService *CollectionService,
+	schedules []cacheschedule.Schedule,
+	logger *zap.Logger,
+) *CacheScheduleService {
+	return &CacheScheduleService{
+		runRepo:           runRepo,
+		storeRepo:         storeRepo,
+		productService:    productService,
+		collectionService: collectionService,
+		schedules:         schedules,
+		logger:            logger,
+	}
+}
+
+// StartCacheScheduler checks at the given interval for schedules due to run,
+// until the context is cancelled. Runs missed while the service was down are
+// skipped.
+func (s *CacheScheduleService) StartCacheScheduler(ctx context.Context, checkInterval time.Duration) {
+	if len(s.schedules) == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(checkInterval)
+		defer ticker.Stop()
+
+		now := time.Now()
+		next := make([]time.Time, len(s.schedules))
+		for i := range s.schedules {
+			next[i] = s.schedules[i].Next(now)
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Cache scheduler stopped")
+				return
+			case now := <-ticker.C:
+				for i := range s.schedules {
+					if now.Before(next[i]) {
+						continue
+					}
+					scheduledFor := next[i].UTC()
+					next[i] = s.schedules[i].Next(now)
+					if _, err := s.run(ctx, &s.schedules[i], models.CacheRunScheduled, &scheduledFor); err != nil {
+						s.logger.Error("Scheduled cache run failed", zap.String("schedule", s.schedules[i].Name), zap.Error(err))
+					}
+				}
+			}
+		}
+	}()
+}
+
+// ListCacheSchedules returns the configured schedules with their next and
+// latest runs
+func (s *CacheScheduleService) ListCacheSchedules(ctx context.Context, req *pb.ListCacheSchedulesRequest) (*pb.ListCacheSchedulesResponse, error) {
+	latest, err := s.runRepo.LatestCacheScheduleRuns(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list cache schedule runs")
+	}
+
+	now := time.Now()
+	resp := &pb.ListCacheSchedulesResponse{Schedules: make([]*pb.CacheSchedule, len(s.schedules))}
+	for i := range s.schedules {
+		schedule := &s.schedules[i]
+		resp.Schedules[i] = &pb.CacheSchedule{
+			Name:       schedule.Name,
+			Namespace:  schedule.Namespace,
+			At:         schedule.At,
+			Days:       schedule.Days,
+			Timezone:   schedule.Timezone,
+			Invalidate: schedule.Invalidate,
+			Warm:       convertWarmQueriesToProtos(schedule.Warm),
+			NextRunAt:  timestamppb.New(schedule.Next(now)),
+			LastRun:    convertCacheScheduleRunToProto(latest[schedule.Name]),
+		}
+	}
+	return resp, nil
+}
+
+// ListCacheScheduleRuns returns the latest runs, of a schedule when one is
+// given
+func (s *CacheScheduleService) ListCacheScheduleRuns(ctx context.Context, req *pb.ListCacheScheduleRunsRequest) (*pb.ListCacheScheduleRunsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultCacheScheduleRunLimit
+	}
+	if limit > maxCacheScheduleRunLimit {
+		limit = maxCacheScheduleRunLimit
+	}
+
+	runs, err := s.runRepo.ListCacheScheduleRuns(ctx, req.ScheduleName, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list cache schedule runs")
+	}
+
+	resp := &pb.ListCacheScheduleRunsResponse{Runs: make([]*pb.CacheScheduleRun, len(runs))}
+	for i, run := range runs {
+		resp.Runs[i] = convertCacheScheduleRunToProto(run)
+	}
+	return resp, nil
+}
+
+// RunCacheSchedule runs a schedule now, such as after a bulk import
+func (s *CacheScheduleService) RunCacheSchedule(ctx context.Context, req *pb.RunCacheScheduleRequest) (*pb.CacheScheduleRun, error) {
+	for i := range s.schedules {
+		if s.schedules[i].Name != req.Name {
+			continue
+		}
+		run, err := s.run(ctx, &s.schedules[i], models.CacheRunManual, nil)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to run cache schedule")
+		}
+		return convertCacheScheduleRunToProto(run), nil
+	}
+	return nil, status.Errorf(codes.NotFound, "cache schedule %q not found", req.Name)
+}
+
+// run invalidates the namespace of a schedule and warms its queries for
+// every active store, recording the run. Scheduled runs claimed by another
+// instance are skipped, returning a nil run.
+func (s *CacheScheduleService) run(ctx context.Context, schedule *cacheschedule.Schedule, trigger string, scheduledFor *time.Time) (*models.CacheScheduleRun, error) {
+	s.running.Lock()
+	defer s.running.Unlock()
+
+	run := &models.CacheScheduleRun{
+		ScheduleName: schedule.Name,
+		Namespace:    schedule.Namespace,
+		Trigger:      trigger,
+		ScheduledFor: scheduledFor,
+	}
+	claimed, err := s.runRepo.StartCacheScheduleRun(ctx, run)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		s.logger.Debug("Cache schedule run claimed by another instance", zap.String("schedule", schedule.Name))
+		return nil, nil
+	}
+
+	s.execute(ctx, schedule, run)
+
+	if err := s.runRepo.FinishCacheScheduleRun(ctx, run); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Cache schedule run finished",
+		zap.String("schedule", run.ScheduleName),
+		zap.String("trigger", run.Trigger),
+		zap.String("status", run.Status),
+		zap.Int("warmed", run.Warmed),
+		zap.Int("failed", run.Failed))
+	return run, nil
+}
+
+func (s *CacheScheduleService) execute(ctx context.Context, schedule *cacheschedule.Schedule, run *models.CacheScheduleRun) {
+	fail := func(err error) {
+		if run.Error == "" {
+			run.Error = err.Error()
+		}
+	}
+
+	if schedule.Invalidate {
+		if err := s.productService.cacheManager.FlushNamespace(ctx, schedule.Namespace); err != nil {
+			fail(fmt.Errorf("failed to invalidate namespace: %w", err))
+			run.Status = models.CacheRunFailed
+			return
+		}
+		run.Invalidated = true
+	}
+
+	if len(schedule.Warm) > 0 {
+		stores, err := s.storeRepo.ListStores(ctx)
+		if err != nil {
+			fail(fmt.Errorf("failed to list stores: %w", err))
+			run.Status = models.CacheRunPartial
+			return
+		}
+		for _, shop := range stores {
+			if !shop.IsActive {
+				continue
+			}
+			storeCtx := tenant.WithTenant(ctx, shop.ID)
+			for _, query := range schedule.Warm {
+				if err := s.warm(storeCtx, query); err != nil {
+					run.Failed++
+					fail(fmt.Errorf("store %s: %s warm query: %w", shop.ID, query.Type, err))
+					continue
+				}
+				run.Warmed++
+			}
+		}
+	}
+
+	run.Status = models.CacheRunSucceeded
+	if run.Failed > 0 {
+		run.Status = models.CacheRunPartial
+	}
+}
+
+// warm runs a warm query through the cached reads of the services, which
+// cache their results
+func (s *CacheScheduleService) warm(ctx context.Context, query cacheschedule.WarmQuery) error {
+	var err error
+	switch query.Type {
+	case cacheschedule.WarmProducts:
+		_, err = s.productService.ListProducts(ctx, &pb.ListProductsRequest{
+			Page:    int32(query.Page),
+			Limit:   int32(query.Limit),
+			Channel: query.Channel,
+		})
+	case cacheschedule.WarmCategories:
+		_, err = s.productService.ListCategories(ctx, &pb.ListCategoriesRequest{Page: int32(query.Page), Limit: int32(query.Limit)})
+	case cacheschedule.WarmBrands:
+		_, err = s.productService.ListBrands(ctx, &pb.ListBrandsRequest{Page: int32(query.Page), Limit: int32(query.Limit)})
+	case cacheschedule.WarmCollection:
+		// Collection pages are read in one query and not cached; the members
+		// are warmed for the product pages they link to
+		var resp *pb.ListCollectionProductsResponse
+		resp, err = s.collectionService.ListCollectionProducts(ctx, &pb.ListCollectionProductsRequest{
+			Identifier: &pb.ListCollectionProductsRequest_Slug{Slug: query.Target},
+			Page:       int32(query.Page),
+			Limit:      int32(query.Limit),
+		})
+		if err != nil {
+			break
+		}
+		for _, product := range resp.Products {
+			if _, err = s.productService.GetProduct(ctx, &pb.GetProductRequest{
+				Identifier: &pb.GetProductRequest_Id{Id: product.Id},
+			}); err != nil {
+				break
+			}
+		}
+	case cacheschedule.WarmProduct:
+		req := &pb.GetProductRequest{Identifier: &pb.GetProductRequest_Slug{Slug: query.Target}}
+		if _, parseErr := uuid.Parse(query.Target); parseErr == nil {
+			req.Identifier = &pb.GetProductRequest_Id{Id: query.Target}
+		}
+		_, err = s.productService.GetProduct(ctx, req)
+	default:
+		err = fmt.Errorf("unknown warm query type %q", query.Type)
+	}
+	return err
+}
+
+func convertWarmQueriesToProtos(queries []cacheschedule.WarmQuery) []*pb.CacheWarmQuery {
+	protos := make([]*pb.CacheWarmQuery, len(queries))
+	for i, query := range queries {
+		protos[i] = &pb.CacheWarmQuery{
+			Type:    query.Type,
+			Target:  query.Target,
+			Channel: query.Channel,
+			Page:    int32(query.Page),
+			Limit:   int32(query.Limit),
+		}
+	}
+	return protos
+}
+
+func convertCacheScheduleRunToProto(run *models.CacheScheduleRun) *pb.CacheScheduleRun {
+	if run == nil {
+		return nil
+	}
+	proto := &pb.CacheScheduleRun{
+		Id:           run.ID,
+		ScheduleName: run.ScheduleName,
+		Namespace:    run.Namespace,
+		Trigger:      run.Trigger,
+		Status:       run.Status,
+		Invalidated:  run.Invalidated,
+		Warmed:       int32(run.Warmed),
+		Failed:       int32(run.Failed),
+		Error:        run.Error,
+		StartedAt:    timestamppb.New(run.StartedAt),
+	}
+	if run.ScheduledFor != nil {
+		proto.ScheduledFor = timestamppb.New(*run.ScheduledFor)
+	}
+	if run.FinishedAt != nil {
+		proto.FinishedAt = timestamppb.New(*run.FinishedAt)
+	}
+	return proto
+}
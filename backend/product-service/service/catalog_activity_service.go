@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/events"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultCatalogActivityLimit = 50
+	maxCatalogActivityLimit     = 200
+	// activityQueueSize bounds the product events waiting to be recorded.
+	// Events beyond it are dropped from the activity feed.
+	activityQueueSize = 1000
+)
+
+// catalogActions maps product event types to catalog activity actions
+var catalogActions = map[string]string{
+	events.ProductCreated: models.CatalogActivityCreated,
+	events.ProductUpdated: models.CatalogActivityUpdated,
+	events.ProductDeleted: models.CatalogActivityDeleted,
+}
+
+// CatalogActivityService records the product changes of each store for the
+// admin activity feed, from the product events of the bus
+type CatalogActivityService struct {
+	activityRepo repository.CatalogActivityRepository
+	logger       *zap.Logger
+	queue        chan events.Event
+}
+
+// NewCatalogActivityService creates a new catalog activity service listening
+// to the product events of bus
+func NewCatalogActivityService(activityRepo repository.CatalogActivityRepository, bus *events.Bus, logger *zap.Logger) *CatalogActivityService {
+	s := &CatalogActivityService{
+		activityRepo: activityRepo,
+		logger:       logger,
+		queue:        make(chan events.Event, activityQueueSize),
+	}
+	bus.Subscribe(s.enqueue, events.ProductCreated, events.ProductUpdated, events.ProductDeleted)
+	return s
+}
+
+func (s *CatalogActivityService) enqueue(_ context.Context, event events.Event) {
+	select {
+	case s.queue <- event:
+	default:
+		s.logger.Warn("Catalog activity queue full, dropping product event",
+			zap.String("type", event.Type),
+			zap.String("product_id", event.ProductID))
+	}
+}
+
+// Start records the queued product events until the context is cancelled
+func (s *CatalogActivityService) Start(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-s.queue:
+				activity := &models.CatalogActivity{
+					Action:     catalogActions[event.Type],
+					ProductID:  event.ProductID,
+					ActorID:    event.ActorID,
+					OccurredAt: event.OccurredAt,
+				}
+				if err := s.activityRepo.RecordActivity(tenant.WithTenant(ctx, event.TenantID), activity); err != nil {
+					s.logger.Warn("Failed to record catalog activity", zap.String("product_id", event.ProductID), zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// ListActivity returns the latest product changes of the store, or those
+// older than an entry, newest first
+func (s *CatalogActivityService) ListActivity(ctx context.Context, req *pb.ListCatalogActivityRequest) (*pb.ListCatalogActivityResponse, error) {
+	if req.BeforeId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "before_id cannot be negative")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultCatalogActivityLimit
+	}
+	if limit > maxCatalogActivityLimit {
+		limit = maxCatalogActivityLimit
+	}
+
+	entries, err := s.activityRepo.ListActivity(ctx, req.BeforeId, limit)
+	if err != nil {
+		s.logger.Error("Failed to list catalog activity", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to list catalog activity: %v", err)
+	}
+
+	resp := &pb.ListCatalogActivityResponse{Entries: make([]*pb.CatalogActivity, len(entries))}
+	for i, entry := range entries {
+		resp.Entries[i] = &pb.CatalogActivity{
+			Id:           entry.ID,
+			Action:       entry.Action,
+			ProductId:    entry.ProductID,
+			ProductTitle: entry.ProductTitle,
+			ActorId:      entry.ActorID,
+			OccurredAt:   timestamppb.New(entry.OccurredAt),
+		}
+	}
+	return resp, nil
+}
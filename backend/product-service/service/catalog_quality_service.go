@@ -0,0 +1,334 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
+	"github.com/louai60/e-commerce_project/backend/product-service/events"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/quality"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	qualityPageSize           = 200
+	defaultQualityReportLimit = 50
+	maxQualityReportLimit     = 500
+	// qualityQueueSize bounds the product events waiting to be scored. Events
+	// beyond it are dropped and caught up by the next scheduled recompute.
+	qualityQueueSize = 1000
+)
+
+// CatalogQualityService scores the completeness of products so that
+// merchandisers can prioritize fixes. Scores are recomputed when products
+// change and periodically, as stock changes in the inventory service.
+type CatalogQualityService struct {
+	qualityRepo    repository.CatalogQualityRepository
+	storeRepo      repository.StoreRepository
+	productService *ProductService
+	logger         *zap.Logger
+	queue          chan events.Event
+
+	// running prevents overlapping recomputes of the scheduler and manual
+	// triggers
+	running sync.Mutex
+}
+
+// NewCatalogQualityService creates a new catalog quality service listening to
+// the product events of bus
+func NewCatalogQualityService(
+	qualityRepo repository.CatalogQualityRepository,
+	storeRepo repository.StoreRepository,
+	productService *ProductService,
+	bus *events.Bus,
+	logger *zap.Logger,
+) *CatalogQualityService {
+	s := &CatalogQualityService{
+		qualityRepo:    qualityRepo,
+		storeRepo:      storeRepo,
+		productService: productService,
+		logger:         logger,
+		queue:          make(chan events.Event, qualityQueueSize),
+	}
+	bus.Subscribe(s.enqueue, events.ProductCreated, events.ProductUpdated, events.ProductDeleted)
+	return s
+}
+
+func (s *CatalogQualityService) enqueue(_ context.Context, event events.Event) {
+	select {
+	case s.queue <- event:
+	default:
+		s.logger.Warn("Catalog quality queue full, dropping product event",
+			zap.String("type", event.Type),
+			zap.String("product_id", event.ProductID))
+	}
+}
+
+// Start scores the products of queued events until the context is cancelled
+func (s *CatalogQualityService) Start(ctx context.Context) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event := <-s.queue:
+				s.handleEvent(tenant.WithTenant(ctx, event.TenantID), event)
+			}
+		}
+	}()
+}
+
+func (s *CatalogQualityService) handleEvent(ctx context.Context, event events.Event) {
+	if event.Type == events.ProductDeleted {
+		if err := s.qualityRepo.DeleteQualityScore(ctx, event.ProductID); err != nil {
+			s.logger.Warn("Failed to delete product quality score", zap.String("product_id", event.ProductID), zap.Error(err))
+		}
+		return
+	}
+	if _, err := s.ScoreProduct(ctx, event.ProductID); err != nil {
+		s.logger.Warn("Failed to score product quality", zap.String("product_id", event.ProductID), zap.Error(err))
+	}
+}
+
+// ScoreProduct scores a product of the store in ctx and stores its score
+func (s *CatalogQualityService) ScoreProduct(ctx context.Context, productID string) (*models.ProductQualityScore, error) {
+	product, err := s.productService.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.productService.populateProductRelations(ctx, product); err != nil {
+		s.logger.Warn("Failed to populate product relations for quality score", zap.String("product_id", productID), zap.Error(err))
+	}
+
+	inStock, err := s.inStock(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+
+	input := quality.Input{
+		Images:         len(product.Images),
+		Description:    product.Description,
+		Specifications: len(product.Specifications),
+		InStock:        inStock,
+	}
+	if product.SEO != nil {
+		input.MetaTitle = product.SEO.MetaTitle
+		input.MetaDescription = product.SEO.MetaDescription
+	}
+	result := quality.Score(input)
+
+	score := &models.ProductQualityScore{
+		ProductID:  product.ID,
+		Title:      product.Title,
+		SKU:        product.SKU,
+		Score:      result.Score,
+		Issues:     result.Issues,
+		ComputedAt: time.Now().UTC(),
+	}
+	if err := s.qualityRepo.SaveQualityScore(ctx, score); err != nil {
+		return nil, err
+	}
+	return score, nil
+}
+
+// inStock reports whether a product has stock available. Without an
+// inventory service stock cannot be checked, so it does not count against
+// the product.
+func (s *CatalogQualityService) inStock(ctx context.Context, product *models.Product) (bool, error) {
+	// Digital products are never out of stock
+	if !product.RequiresShipping() || s.productService.inventoryClient == nil {
+		return true, nil
+	}
+
+	item, err := s.productService.inventoryClient.GetInventoryItem(ctx, product.ID)
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return availability.OnHand(item.Availability), nil
+}
+
+// Recompute scores every product of the store in ctx and returns the number
+// of products scored and failed
+func (s *CatalogQualityService) Recompute(ctx context.Context) (scored, failed int, err error) {
+	if !s.running.TryLock() {
+		return 0, 0, status.Error(codes.Aborted, "a catalog quality recompute is already running")
+	}
+	defer s.running.Unlock()
+
+	for afterID := ""; ; {
+		ids, err := s.qualityRepo.ListProductIDs(ctx, afterID, qualityPageSize)
+		if err != nil {
+			return scored, failed, err
+		}
+		for _, id := range ids {
+			if _, err := s.ScoreProduct(ctx, id); err != nil {
+				s.logger.Warn("Failed to score product quality", zap.String("product_id", id), zap.Error(err))
+				failed++
+				continue
+			}
+			scored++
+		}
+		if len(ids) < qualityPageSize {
+			break
+		}
+		afterID = ids[len(ids)-1]
+	}
+
+	s.logger.Info("Catalog quality recomputed",
+		zap.String("tenant_id", tenant.FromContext(ctx)),
+		zap.Int("scored", scored),
+		zap.Int("failed", failed))
+
+	return scored, failed, nil
+}
+
+// StartCatalogQualityScheduler recomputes the scores of every active store at
+// the given interval until the context is cancelled
+func (s *CatalogQualityService) StartCatalogQualityScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Catalog quality scheduler stopped")
+				return
+			case <-ticker.C:
+				s.recomputeAllStores(ctx)
+			}
+		}
+	}()
+}
+
+func (s *CatalogQualityService) recomputeAllStores(ctx context.Context) {
+	stores, err := s.storeRepo.ListStores(ctx)
+	if err != nil {
+		s.logger.Error("Scheduled catalog quality recompute failed", zap.Error(err))
+		return
+	}
+
+	for _, shop := range stores {
+		if !shop.IsActive {
+			continue
+		}
+		if _, _, err := s.Recompute(tenant.WithTenant(ctx, shop.ID)); err != nil {
+			s.logger.Error("Scheduled catalog quality recompute failed", zap.String("tenant_id", shop.ID), zap.Error(err))
+		}
+	}
+}
+
+// GetCatalogQualityReport summarizes the scores of the current store and
+// lists the matching products, lowest scores first
+func (s *CatalogQualityService) GetCatalogQualityReport(ctx context.Context, req *pb.GetCatalogQualityReportRequest) (*pb.CatalogQualityReport, error) {
+	filter := models.QualityScoreFilter{
+		Issue:  req.Issue,
+		Limit:  int(req.Limit),
+		Offset: int(req.Offset),
+	}
+	if filter.Issue != "" && !quality.IsIssue(filter.Issue) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown quality issue %q", filter.Issue)
+	}
+	if req.MaxScore != nil {
+		maxScore := int(req.MaxScore.Value)
+		if maxScore < 0 || maxScore > quality.MaxScore {
+			return nil, status.Errorf(codes.InvalidArgument, "max score must be between 0 and %d", quality.MaxScore)
+		}
+		filter.MaxScore = &maxScore
+	}
+	if filter.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset must not be negative")
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultQualityReportLimit
+	}
+	if filter.Limit > maxQualityReportLimit {
+		filter.Limit = maxQualityReportLimit
+	}
+
+	summary, err := s.qualityRepo.GetQualitySummary(ctx)
+	if err != nil {
+		return nil, s.qualityError("Failed to get catalog quality report", err)
+	}
+	scores, total, err := s.qualityRepo.ListQualityScores(ctx, filter)
+	if err != nil {
+		return nil, s.qualityError("Failed to get catalog quality report", err)
+	}
+
+	report := &pb.CatalogQualityReport{
+		ScoredCount:   int32(summary.ScoredCount),
+		UnscoredCount: int32(summary.UnscoredCount),
+		AverageScore:  summary.AverageScore,
+		IssueCounts:   make(map[string]int32, len(summary.IssueCounts)),
+		Products:      make([]*pb.ProductQualityScore, len(scores)),
+		Total:         int32(total),
+	}
+	for issue, count := range summary.IssueCounts {
+		report.IssueCounts[issue] = int32(count)
+	}
+	for i, score := range scores {
+		report.Products[i] = convertQualityScoreToProto(score)
+	}
+	return report, nil
+}
+
+// GetProductQualityScore returns the score of a product, scoring it first if
+// it has never been scored
+func (s *CatalogQualityService) GetProductQualityScore(ctx context.Context, req *pb.GetProductQualityScoreRequest) (*pb.ProductQualityScore, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+
+	score, err := s.qualityRepo.GetQualityScore(ctx, req.ProductId)
+	if errors.Is(err, models.ErrQualityScoreNotFound) {
+		score, err = s.ScoreProduct(ctx, req.ProductId)
+	}
+	if err != nil {
+		return nil, s.qualityError("Failed to get product quality score", err)
+	}
+	return convertQualityScoreToProto(score), nil
+}
+
+// RecomputeCatalogQuality scores every product of the current store right away
+func (s *CatalogQualityService) RecomputeCatalogQuality(ctx context.Context, req *pb.RecomputeCatalogQualityRequest) (*pb.RecomputeCatalogQualityResponse, error) {
+	scored, failed, err := s.Recompute(ctx)
+	if err != nil {
+		return nil, s.qualityError("Failed to recompute catalog quality", err)
+	}
+	return &pb.RecomputeCatalogQualityResponse{Scored: int32(scored), Failed: int32(failed)}, nil
+}
+
+func (s *CatalogQualityService) qualityError(message string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, models.ErrProductNotFound) {
+		return status.Error(codes.NotFound, "product not found")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertQualityScoreToProto(score *models.ProductQualityScore) *pb.ProductQualityScore {
+	return &pb.ProductQualityScore{
+		ProductId:  score.ProductID,
+		Title:      score.Title,
+		Sku:        score.SKU,
+		Score:      int32(score.Score),
+		Issues:     score.Issues,
+		ComputedAt: timestamppb.New(score.ComputedAt),
+	}
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/attributes"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Limits matching the columns of category attributes
+const (
+	maxAttributeNameLength  = 100
+	maxAttributeValueLength = 255
+)
+
+// CategoryAttributeService manages the attributes categories define for
+// their products and builds the facets of categories from them
+type CategoryAttributeService struct {
+	attributeRepo repository.CategoryAttributeRepository
+	logger        *zap.Logger
+}
+
+// NewCategoryAttributeService creates a new category attribute service
+func NewCategoryAttributeService(attributeRepo repository.CategoryAttributeRepository, logger *zap.Logger) *CategoryAttributeService {
+	return &CategoryAttributeService{
+		attributeRepo: attributeRepo,
+		logger:        logger,
+	}
+}
+
+// CreateAttribute adds an attribute to a category, after its other
+// attributes
+func (s *CategoryAttributeService) CreateAttribute(ctx context.Context, req *pb.CreateCategoryAttributeRequest) (*pb.CategoryAttribute, error) {
+	if req.Attribute == nil {
+		return nil, status.Error(codes.InvalidArgument, "attribute is required")
+	}
+	if _, err := uuid.Parse(req.Attribute.CategoryId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid category ID")
+	}
+	attribute, err := categoryAttributeFromProto(req.Attribute)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.attributeRepo.CreateAttribute(ctx, attribute); err != nil {
+		return nil, s.attributeError("Failed to create category attribute", err)
+	}
+
+	s.logger.Info("Category attribute created",
+		zap.String("category_id", attribute.CategoryID),
+		zap.String("name", attribute.Name))
+	return categoryAttributeToProto(attribute), nil
+}
+
+// UpdateAttribute replaces the definition of an attribute
+func (s *CategoryAttributeService) UpdateAttribute(ctx context.Context, req *pb.UpdateCategoryAttributeRequest) (*pb.CategoryAttribute, error) {
+	if req.Attribute == nil {
+		return nil, status.Error(codes.InvalidArgument, "attribute is required")
+	}
+	if _, err := uuid.Parse(req.Attribute.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid attribute ID")
+	}
+	attribute, err := categoryAttributeFromProto(req.Attribute)
+	if err != nil {
+		return nil, err
+	}
+	attribute.ID = req.Attribute.Id
+
+	if err := s.attributeRepo.UpdateAttribute(ctx, attribute); err != nil {
+		return nil, s.attributeError("Failed to update category attribute", err)
+	}
+	return categoryAttributeToProto(attribute), nil
+}
+
+// ListAttributes returns the attributes of a category, optionally preceded by
+// those it inherits from its ancestors
+func (s *CategoryAttributeService) ListAttributes(ctx context.Context, req *pb.ListCategoryAttributesRequest) (*pb.ListCategoryAttributesResponse, error) {
+	if _, err := uuid.Parse(req.CategoryId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid category ID")
+	}
+
+	list, err := s.attributeRepo.ListAttributes(ctx, req.CategoryId, req.IncludeInherited)
+	if err != nil {
+		return nil, s.attributeError("Failed to list category attributes", err)
+	}
+
+	resp := &pb.ListCategoryAttributesResponse{Attributes: make([]*pb.CategoryAttribute, len(list))}
+	for i, attribute := range list {
+		resp.Attributes[i] = categoryAttributeToProto(attribute)
+	}
+	return resp, nil
+}
+
+// DeleteAttribute removes an attribute from its category
+func (s *CategoryAttributeService) DeleteAttribute(ctx context.Context, req *pb.DeleteCategoryAttributeRequest) (*pb.DeleteCategoryAttributeResponse, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid attribute ID")
+	}
+
+	if err := s.attributeRepo.DeleteAttribute(ctx, req.Id); err != nil {
+		return nil, s.attributeError("Failed to delete category attribute", err)
+	}
+	return &pb.DeleteCategoryAttributeResponse{Success: true}, nil
+}
+
+// GetFacets returns the filterable attributes of a category, inherited ones
+// included, with the number of published products per value
+func (s *CategoryAttributeService) GetFacets(ctx context.Context, req *pb.GetCategoryFacetsRequest) (*pb.GetCategoryFacetsResponse, error) {
+	if _, err := uuid.Parse(req.CategoryId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid category ID")
+	}
+
+	list, err := s.attributeRepo.ListAttributes(ctx, req.CategoryId, true)
+	if err != nil {
+		return nil, s.attributeError("Failed to list category attributes", err)
+	}
+	definitions := resolveCategoryAttributes(list)
+
+	var names []string
+	for _, definition := range definitions {
+		if definition.Filterable {
+			names = append(names, definition.Name)
+		}
+	}
+	resp := &pb.GetCategoryFacetsResponse{Facets: []*pb.Facet{}}
+	if len(names) == 0 {
+		return resp, nil
+	}
+
+	counts, err := s.attributeRepo.CountAttributeValues(ctx, req.CategoryId, names)
+	if err != nil {
+		return nil, s.attributeError("Failed to count attribute values", err)
+	}
+	for _, facet := range attributes.Facets(definitions, counts) {
+		values := make([]*pb.FacetValue, len(facet.Values))
+		for i, value := range facet.Values {
+			values[i] = &pb.FacetValue{Value: value.Value, Count: int32(value.Count)}
+		}
+		resp.Facets = append(resp.Facets, &pb.Facet{Name: facet.Name, Values: values})
+	}
+	return resp, nil
+}
+
+// resolveCategoryAttributes returns the definitions applying to a category
+// from its own attributes and those of its ancestors
+func resolveCategoryAttributes(list []*models.CategoryAttribute) []attributes.Definition {
+	definitions := make([]attributes.Definition, len(list))
+	for i, attribute := range list {
+		definitions[i] = attribute.Definition()
+	}
+	return attributes.Resolve(definitions)
+}
+
+func (s *CategoryAttributeService) attributeError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, "category not found")
+	case errors.Is(err, models.ErrCategoryAttributeNotFound):
+		return status.Error(codes.NotFound, "category attribute not found")
+	case errors.Is(err, models.ErrCategoryAttributeExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// categoryAttributeFromProto validates the definition of an attribute
+func categoryAttributeFromProto(attribute *pb.CategoryAttribute) (*models.CategoryAttribute, error) {
+	name := strings.TrimSpace(attribute.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "attribute name is required")
+	}
+	if utf8.RuneCountInString(name) > maxAttributeNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "attribute name cannot exceed %d characters", maxAttributeNameLength)
+	}
+
+	allowed := make([]string, 0, len(attribute.AllowedValues))
+	seen := make(map[string]bool, len(attribute.AllowedValues))
+	for _, value := range attribute.AllowedValues {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, status.Error(codes.InvalidArgument, "allowed values cannot be empty")
+		}
+		if utf8.RuneCountInString(value) > maxAttributeValueLength {
+			return nil, status.Errorf(codes.InvalidArgument, "allowed values cannot exceed %d characters", maxAttributeValueLength)
+		}
+		if seen[strings.ToLower(value)] {
+			return nil, status.Errorf(codes.InvalidArgument, "allowed value %q is listed twice", value)
+		}
+		seen[strings.ToLower(value)] = true
+		allowed = append(allowed, value)
+	}
+
+	defaultValue := strings.TrimSpace(attribute.DefaultValue)
+	if utf8.RuneCountInString(defaultValue) > maxAttributeValueLength {
+		return nil, status.Errorf(codes.InvalidArgument, "default value cannot exceed %d characters", maxAttributeValueLength)
+	}
+	if defaultValue != "" && len(allowed) > 0 && !seen[strings.ToLower(defaultValue)] {
+		return nil, status.Error(codes.InvalidArgument, "default value must be one of the allowed values")
+	}
+
+	return &models.CategoryAttribute{
+		CategoryID:    attribute.CategoryId,
+		Name:          name,
+		Required:      attribute.Required,
+		Filterable:    attribute.Filterable,
+		AllowedValues: allowed,
+		DefaultValue:  defaultValue,
+	}, nil
+}
+
+func categoryAttributeToProto(attribute *models.CategoryAttribute) *pb.CategoryAttribute {
+	return &pb.CategoryAttribute{
+		Id:            attribute.ID,
+		CategoryId:    attribute.CategoryID,
+		Name:          attribute.Name,
+		Required:      attribute.Required,
+		Filterable:    attribute.Filterable,
+		AllowedValues: attribute.AllowedValues,
+		DefaultValue:  attribute.DefaultValue,
+		Position:      int32(attribute.Position),
+		Inherited:     attribute.Depth > 0,
+		CreatedAt:     timestamppb.New(attribute.CreatedAt),
+		UpdatedAt:     timestamppb.New(attribute.UpdatedAt),
+	}
+}
+
+// applyCategoryAttributes checks the attributes of a product created in the
+// categories against the attributes the categories and their ancestors
+// define, and returns them with the default values of the missing ones
+func (s *ProductService) applyCategoryAttributes(ctx context.Context, categories []models.Category, given []*pb.ProductAttribute) ([]attributes.Value, error) {
+	values := make([]attributes.Value, len(given))
+	for i, attribute := range given {
+		values[i] = attributes.Value{Name: attribute.Name, Value: attribute.Value}
+	}
+	if s.attributeRepo == nil {
+		return values, nil
+	}
+
+	var list []*models.CategoryAttribute
+	for _, category := range categories {
+		inherited, err := s.attributeRepo.ListAttributes(ctx, category.ID, true)
+		if err != nil {
+			s.logger.Error("Failed to get category attributes", zap.String("category_id", category.ID), zap.Error(err))
+			return nil, status.Errorf(codes.Internal, "failed to get category attributes: %v", err)
+		}
+		list = append(list, inherited...)
+	}
+	if len(list) == 0 {
+		return values, nil
+	}
+
+	applied, err := attributes.Apply(resolveCategoryAttributes(list), values)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return applied, nil
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/events"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MoveCategory moves a category and its subtree under another parent, or to
+// the root. The category goes after the children of its new parent.
+func (s *ProductService) MoveCategory(ctx context.Context, req *pb.MoveCategoryRequest) (*pb.Category, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid category ID")
+	}
+	var parentID *string
+	if req.ParentId != nil {
+		if _, err := uuid.Parse(req.ParentId.Value); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid parent category ID")
+		}
+		parentID = &req.ParentId.Value
+	}
+
+	if err := s.categoryRepo.MoveCategory(ctx, req.Id, parentID); err != nil {
+		return nil, s.categoryTreeError("Failed to move category", err)
+	}
+	s.invalidateCategories(ctx, req.Id)
+
+	category, err := s.categoryRepo.GetCategoryByID(ctx, req.Id)
+	if err != nil {
+		return nil, s.categoryTreeError("Failed to get moved category", err)
+	}
+	return convertCategoryModelToProto(category), nil
+}
+
+// MergeCategories moves the products and children of the source category to
+// the target and deletes the source
+func (s *ProductService) MergeCategories(ctx context.Context, req *pb.MergeCategoriesRequest) (*pb.MergeCategoriesResponse, error) {
+	if _, err := uuid.Parse(req.TargetId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid target category ID")
+	}
+	if _, err := uuid.Parse(req.SourceId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid source category ID")
+	}
+	if req.TargetId == req.SourceId {
+		return nil, status.Error(codes.InvalidArgument, "cannot merge a category into itself")
+	}
+
+	merge, err := s.categoryRepo.MergeCategories(ctx, req.TargetId, req.SourceId)
+	if err != nil {
+		return nil, s.categoryTreeError("Failed to merge categories", err)
+	}
+
+	s.invalidateCategories(ctx, req.TargetId, req.SourceId)
+	for _, productID := range merge.ProductIDs {
+		if err := s.cacheManager.InvalidateProduct(ctx, productID); err != nil {
+			s.logger.Warn("Failed to invalidate product cache", zap.String("product_id", productID), zap.Error(err))
+		}
+		s.publishProductEvent(ctx, events.ProductUpdated, productID)
+	}
+
+	s.logger.Info("Categories merged",
+		zap.String("target_id", merge.TargetID),
+		zap.String("source_id", merge.SourceID),
+		zap.Int("products_moved", merge.ProductsMoved),
+		zap.Int("children_moved", merge.ChildrenMoved))
+
+	category, err := s.categoryRepo.GetCategoryByID(ctx, req.TargetId)
+	if err != nil {
+		return nil, s.categoryTreeError("Failed to get merged category", err)
+	}
+	return &pb.MergeCategoriesResponse{
+		Category:      convertCategoryModelToProto(category),
+		ProductsMoved: int32(merge.ProductsMoved),
+		ChildrenMoved: int32(merge.ChildrenMoved),
+	}, nil
+}
+
+// ReorderSiblings sets the order of the children of a category, or of the
+// root categories
+func (s *ProductService) ReorderSiblings(ctx context.Context, req *pb.ReorderSiblingsRequest) (*pb.ReorderSiblingsResponse, error) {
+	var parentID *string
+	if req.ParentId != nil {
+		if _, err := uuid.Parse(req.ParentId.Value); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid parent category ID")
+		}
+		parentID = &req.ParentId.Value
+	}
+	for _, id := range req.CategoryIds {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid category ID %q", id)
+		}
+	}
+
+	if err := s.categoryRepo.ReorderSiblings(ctx, parentID, req.CategoryIds); err != nil {
+		return nil, s.categoryTreeError("Failed to reorder categories", err)
+	}
+	s.invalidateCategories(ctx, req.CategoryIds...)
+
+	categories, err := s.categoryRepo.ListChildCategories(ctx, parentID)
+	if err != nil {
+		return nil, s.categoryTreeError("Failed to list reordered categories", err)
+	}
+	return &pb.ReorderSiblingsResponse{Categories: convertCategoryModelsToProtos(categories)}, nil
+}
+
+// invalidateCategories drops the cached categories and category lists
+func (s *ProductService) invalidateCategories(ctx context.Context, ids ...string) {
+	for _, id := range ids {
+		if err := s.cacheManager.InvalidateCategory(ctx, id); err != nil {
+			s.logger.Warn("Failed to invalidate category cache", zap.String("category_id", id), zap.Error(err))
+		}
+		if err := s.cacheManager.InvalidateProductsByCategory(ctx, id); err != nil {
+			s.logger.Warn("Failed to invalidate category products cache", zap.String("category_id", id), zap.Error(err))
+		}
+	}
+	if err := s.cacheManager.InvalidateCategoryLists(ctx); err != nil {
+		s.logger.Warn("Failed to invalidate category cache", zap.Error(err))
+	}
+}
+
+func (s *ProductService) categoryTreeError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, "category not found")
+	case errors.Is(err, models.ErrCategoryCycle):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, models.ErrCategorySiblings):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
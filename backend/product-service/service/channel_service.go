@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ChannelService handles the visibility of products in the publication
+// channels (web, mobile and marketplace)
+type ChannelService struct {
+	channelRepo    repository.ChannelRepository
+	productService *ProductService
+	logger         *zap.Logger
+}
+
+// NewChannelService creates a new channel service
+func NewChannelService(channelRepo repository.ChannelRepository, productService *ProductService, logger *zap.Logger) *ChannelService {
+	return &ChannelService{
+		channelRepo:    channelRepo,
+		productService: productService,
+		logger:         logger,
+	}
+}
+
+// SetProductChannels shows or hides a product in the given channels
+func (s *ChannelService) SetProductChannels(ctx context.Context, req *pb.SetProductChannelsRequest) (*pb.ProductChannelsResponse, error) {
+	// The product must exist in the caller's store
+	if _, err := s.productService.productRepo.GetByID(ctx, req.ProductId); err != nil {
+		return nil, s.channelError("Failed to get product", err)
+	}
+
+	channels := make([]models.ProductChannel, len(req.Channels))
+	for i, channel := range req.Channels {
+		channels[i] = models.ProductChannel{Channel: channel.Channel, IsVisible: channel.IsVisible}
+	}
+
+	if err := s.channelRepo.SetProductChannels(ctx, req.ProductId, channels); err != nil {
+		return nil, s.channelError("Failed to save product channels", err)
+	}
+
+	// Cached lists of every channel may include or miss the product now
+	if err := s.productService.cacheManager.InvalidateProductLists(ctx); err != nil {
+		s.logger.Warn("Failed to invalidate product lists after channel change",
+			zap.String("product_id", req.ProductId),
+			zap.Error(err))
+	}
+
+	s.logger.Info("Product channels updated",
+		zap.String("product_id", req.ProductId),
+		zap.Int("channels", len(channels)))
+
+	return s.GetProductChannels(ctx, &pb.GetProductChannelsRequest{ProductId: req.ProductId})
+}
+
+// GetProductChannels returns the visibility of a product in every channel
+func (s *ChannelService) GetProductChannels(ctx context.Context, req *pb.GetProductChannelsRequest) (*pb.ProductChannelsResponse, error) {
+	channels, err := s.channelRepo.GetProductChannels(ctx, req.ProductId)
+	if err != nil {
+		return nil, s.channelError("Failed to get product channels", err)
+	}
+
+	resp := &pb.ProductChannelsResponse{
+		ProductId: req.ProductId,
+		Channels:  make([]*pb.ProductChannel, len(channels)),
+	}
+	for i, channel := range channels {
+		resp.Channels[i] = &pb.ProductChannel{
+			Channel:   channel.Channel,
+			IsVisible: channel.IsVisible,
+		}
+		if !channel.UpdatedAt.IsZero() {
+			resp.Channels[i].UpdatedAt = timestamppb.New(channel.UpdatedAt)
+		}
+	}
+	return resp, nil
+}
+
+func (s *ChannelService) channelError(message string, err error) error {
+	if errors.Is(err, models.ErrProductNotFound) {
+		return status.Error(codes.NotFound, "product not found")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Page sizes of collections and of their members
+const (
+	defaultCollectionsLimit        = 20
+	maxCollectionsLimit            = 100
+	defaultCollectionProductsLimit = 20
+	maxCollectionProductsLimit     = 50
+)
+
+// CollectionService handles business logic for admin-curated product collections
+type CollectionService struct {
+	collectionRepo repository.CollectionRepository
+	logger         *zap.Logger
+}
+
+// NewCollectionService creates a new collection service
+func NewCollectionService(
+	collectionRepo repository.CollectionRepository,
+	logger *zap.Logger,
+) *CollectionService {
+	return &CollectionService{
+		collectionRepo: collectionRepo,
+		logger:         logger,
+	}
+}
+
+// CreateCollection creates a collection and its manually curated members
+func (s *CollectionService) CreateCollection(ctx context.Context, req *pb.CreateCollectionRequest) (*pb.Collection, error) {
+	collection := convertProtoToCollectionModel(req.Collection)
+	collection.ProductIDs = req.Collection.ProductIds
+
+	s.logger.Info("Creating new collection", zap.String("name", collection.Name), zap.String("slug", collection.Slug))
+
+	if err := s.collectionRepo.CreateCollection(ctx, collection); err != nil {
+		return nil, s.collectionError("Failed to create collection", err)
+	}
+
+	return convertCollectionModelToProto(collection), nil
+}
+
+// GetCollection retrieves a collection by ID or slug
+func (s *CollectionService) GetCollection(ctx context.Context, req *pb.GetCollectionRequest) (*pb.Collection, error) {
+	var collection *models.Collection
+	var err error
+
+	switch identifier := req.Identifier.(type) {
+	case *pb.GetCollectionRequest_Id:
+		collection, err = s.collectionRepo.GetCollectionByID(ctx, identifier.Id)
+	case *pb.GetCollectionRequest_Slug:
+		collection, err = s.collectionRepo.GetCollectionBySlug(ctx, identifier.Slug)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid identifier")
+	}
+	if err != nil {
+		return nil, s.collectionError("Failed to get collection", err)
+	}
+
+	return convertCollectionModelToProto(collection), nil
+}
+
+// ListCollections returns a paginated list of collections
+func (s *CollectionService) ListCollections(ctx context.Context, req *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	page, limit := collectionPage(req.Page, req.Limit, defaultCollectionsLimit, maxCollectionsLimit)
+
+	collections, total, err := s.collectionRepo.ListCollections(ctx, (page-1)*limit, limit, req.PublishedOnly)
+	if err != nil {
+		return nil, s.collectionError("Failed to list collections", err)
+	}
+
+	protos := make([]*pb.Collection, len(collections))
+	for i, collection := range collections {
+		protos[i] = convertCollectionModelToProto(collection)
+	}
+
+	return &pb.ListCollectionsResponse{
+		Collections: protos,
+		Total:       int32(total),
+		Page:        int32(page),
+		Limit:       int32(limit),
+	}, nil
+}
+
+// UpdateCollection replaces the collection details, and the rules when provided.
+// Manual members are left untouched; use SetCollectionProducts to change them.
+func (s *CollectionService) UpdateCollection(ctx context.Context, req *pb.UpdateCollectionRequest) (*pb.Collection, error) {
+	existing, err := s.collectionRepo.GetCollectionByID(ctx, req.Collection.Id)
+	if err != nil {
+		return nil, s.collectionError("Failed to get collection for update", err)
+	}
+
+	updated := convertProtoToCollectionModel(req.Collection)
+	updated.ID = existing.ID
+	updated.CreatedAt = existing.CreatedAt
+	updated.ProductIDs = existing.ProductIDs
+	if updated.Name == "" {
+		updated.Name = existing.Name
+	}
+	if updated.Slug == "" {
+		updated.Slug = existing.Slug
+	}
+	if req.Collection.Rules == nil {
+		updated.Rules = existing.Rules
+	}
+
+	s.logger.Info("Updating collection", zap.String("id", updated.ID))
+
+	if err := s.collectionRepo.UpdateCollection(ctx, updated); err != nil {
+		return nil, s.collectionError("Failed to update collection", err)
+	}
+
+	return convertCollectionModelToProto(updated), nil
+}
+
+// DeleteCollection soft deletes a collection
+func (s *CollectionService) DeleteCollection(ctx context.Context, req *pb.DeleteCollectionRequest) (*pb.DeleteCollectionResponse, error) {
+	s.logger.Info("Deleting collection", zap.String("id", req.Id))
+
+	if err := s.collectionRepo.DeleteCollection(ctx, req.Id); err != nil {
+		return nil, s.collectionError("Failed to delete collection", err)
+	}
+
+	return &pb.DeleteCollectionResponse{Success: true}, nil
+}
+
+// SetCollectionProducts replaces the manually curated members of a collection
+func (s *CollectionService) SetCollectionProducts(ctx context.Context, req *pb.SetCollectionProductsRequest) (*pb.Collection, error) {
+	if err := s.collectionRepo.SetCollectionProducts(ctx, req.CollectionId, req.ProductIds); err != nil {
+		return nil, s.collectionError("Failed to set collection products", err)
+	}
+
+	collection, err := s.collectionRepo.GetCollectionByID(ctx, req.CollectionId)
+	if err != nil {
+		return nil, s.collectionError("Failed to get collection", err)
+	}
+
+	return convertCollectionModelToProto(collection), nil
+}
+
+// ListCollectionProducts returns a page of published member products for a collection
+func (s *CollectionService) ListCollectionProducts(ctx context.Context, req *pb.ListCollectionProductsRequest) (*pb.ListCollectionProductsResponse, error) {
+	var collection *models.Collection
+	var err error
+
+	switch identifier := req.Identifier.(type) {
+	case *pb.ListCollectionProductsRequest_Id:
+		collection, err = s.collectionRepo.GetCollectionByID(ctx, identifier.Id)
+	case *pb.ListCollectionProductsRequest_Slug:
+		collection, err = s.collectionRepo.GetCollectionBySlug(ctx, identifier.Slug)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid identifier")
+	}
+	if err != nil {
+		return nil, s.collectionError("Failed to get collection", err)
+	}
+
+	page, limit := collectionPage(req.Page, req.Limit, defaultCollectionProductsLimit, maxCollectionProductsLimit)
+
+	products, total, err := s.collectionRepo.ListCollectionProducts(ctx, collection, (page-1)*limit, limit)
+	if err != nil {
+		return nil, s.collectionError("Failed to list collection products", err)
+	}
+
+	return &pb.ListCollectionProductsResponse{
+		Collection: convertCollectionModelToProto(collection),
+		Products:   convertProductModelsToProtos(products),
+		Total:      int32(total),
+		Page:       int32(page),
+		Limit:      int32(limit),
+	}, nil
+}
+
+// collectionPage returns the page and limit of a list request, defaulting
+// to the first page and to defaultLimit, and capping the limit at maxLimit
+func collectionPage(page, limit int32, defaultLimit, maxLimit int) (int, int) {
+	p, l := int(page), int(limit)
+	if p < 1 {
+		p = 1
+	}
+	if l <= 0 {
+		l = defaultLimit
+	}
+	if l > maxLimit {
+		l = maxLimit
+	}
+	return p, l
+}
+
+// collectionError logs a repository error and maps it to a gRPC status
+func (s *CollectionService) collectionError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrCollectionNotFound):
+		return status.Error(codes.NotFound, "collection not found")
+	case errors.Is(err, models.ErrCollectionSlugExists):
+		return status.Error(codes.AlreadyExists, "collection with this slug already exists")
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.InvalidArgument, "collection references a product that does not exist")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertProtoToCollectionModel(proto *pb.Collection) *models.Collection {
+	collection := &models.Collection{
+		ID:          proto.Id,
+		Name:        proto.Name,
+		Slug:        proto.Slug,
+		Description: proto.Description,
+		ImageURL:    proto.ImageUrl,
+		IsPublished: proto.IsPublished,
+	}
+	if proto.Rules != nil {
+		collection.Rules = models.CollectionRules{
+			BrandIDs:    proto.Rules.BrandIds,
+			CategoryIDs: proto.Rules.CategoryIds,
+			Tags:        proto.Rules.Tags,
+		}
+	}
+	return collection
+}
+
+func convertCollectionModelToProto(model *models.Collection) *pb.Collection {
+	if model == nil {
+		return nil
+	}
+
+	protoCollection := &pb.Collection{
+		Id:          model.ID,
+		Name:        model.Name,
+		Slug:        model.Slug,
+		Description: model.Description,
+		ImageUrl:    model.ImageURL,
+		IsPublished: model.IsPublished,
+		Rules: &pb.CollectionRules{
+			BrandIds:    model.Rules.BrandIDs,
+			CategoryIds: model.Rules.CategoryIDs,
+			Tags:        model.Rules.Tags,
+		},
+		ProductIds: model.ProductIDs,
+		CreatedAt:  timestamppb.New(model.CreatedAt),
+		UpdatedAt:  timestamppb.New(model.UpdatedAt),
+	}
+
+	if model.DeletedAt != nil {
+		protoCollection.DeletedAt = timestamppb.New(*model.DeletedAt)
+	}
+
+	return protoCollection
+}
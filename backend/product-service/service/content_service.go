@@ -0,0 +1,399 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxContentTitleLength bounds the titles of pages and banners, in characters
+	maxContentTitleLength = 255
+	// maxContentBodyLength bounds the body of a page, in characters
+	maxContentBodyLength = 100000
+)
+
+var (
+	// contentSlugPattern matches page slugs such as shipping-policy
+	contentSlugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	// contentSlotPattern matches banner slot names such as homepage_hero
+	contentSlotPattern = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`)
+)
+
+// ContentService manages the static storefront pages, such as About, FAQ and
+// the store policies, and the banners of the storefront slots. Content is
+// drafted, then published, optionally within a schedule; the storefront only
+// sees live content.
+type ContentService struct {
+	contentRepo repository.ContentRepository
+	logger      *zap.Logger
+}
+
+// NewContentService creates a new content service
+func NewContentService(contentRepo repository.ContentRepository, logger *zap.Logger) *ContentService {
+	return &ContentService{
+		contentRepo: contentRepo,
+		logger:      logger,
+	}
+}
+
+// CreateContentPage creates a page, a draft unless its status says otherwise
+func (s *ContentService) CreateContentPage(ctx context.Context, req *pb.CreateContentPageRequest) (*pb.ContentPage, error) {
+	page, err := contentPageFromProto(req.Page)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.contentRepo.CreatePage(ctx, page); err != nil {
+		return nil, s.contentError("Failed to create content page", err)
+	}
+
+	s.logger.Info("Content page created",
+		zap.String("id", page.ID),
+		zap.String("slug", page.Slug),
+		zap.String("status", page.Status))
+	return contentPageToProto(page, time.Now()), nil
+}
+
+// UpdateContentPage replaces the content and schedule of a page
+func (s *ContentService) UpdateContentPage(ctx context.Context, req *pb.UpdateContentPageRequest) (*pb.ContentPage, error) {
+	if req.Page == nil {
+		return nil, status.Error(codes.InvalidArgument, "page is required")
+	}
+	if _, err := uuid.Parse(req.Page.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page ID")
+	}
+	page, err := contentPageFromProto(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	page.ID = req.Page.Id
+
+	if err := s.contentRepo.UpdatePage(ctx, page); err != nil {
+		return nil, s.contentError("Failed to update content page", err)
+	}
+	updated, err := s.contentRepo.GetPage(ctx, page.ID)
+	if err != nil {
+		return nil, s.contentError("Failed to get content page", err)
+	}
+
+	s.logger.Info("Content page updated",
+		zap.String("id", updated.ID),
+		zap.String("slug", updated.Slug),
+		zap.String("status", updated.Status))
+	return contentPageToProto(updated, time.Now()), nil
+}
+
+// GetContentPage returns a page by ID whatever its state, for editing, or
+// the live page with a slug, for the storefront
+func (s *ContentService) GetContentPage(ctx context.Context, req *pb.GetContentPageRequest) (*pb.ContentPage, error) {
+	var page *models.ContentPage
+	var err error
+
+	switch identifier := req.Identifier.(type) {
+	case *pb.GetContentPageRequest_Id:
+		if _, parseErr := uuid.Parse(identifier.Id); parseErr != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page ID")
+		}
+		page, err = s.contentRepo.GetPage(ctx, identifier.Id)
+	case *pb.GetContentPageRequest_Slug:
+		page, err = s.contentRepo.GetLivePageBySlug(ctx, strings.ToLower(identifier.Slug))
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid identifier")
+	}
+	if err != nil {
+		return nil, s.contentError("Failed to get content page", err)
+	}
+	return contentPageToProto(page, time.Now()), nil
+}
+
+// ListContentPages returns a page of the pages ordered by slug, only the
+// live ones when asked
+func (s *ContentService) ListContentPages(ctx context.Context, req *pb.ListContentPagesRequest) (*pb.ListContentPagesResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	offset := (int(req.Page) - 1) * limit
+	if offset < 0 {
+		offset = 0
+	}
+
+	pages, total, err := s.contentRepo.ListPages(ctx, req.LiveOnly, offset, limit)
+	if err != nil {
+		return nil, s.contentError("Failed to list content pages", err)
+	}
+
+	now := time.Now()
+	resp := &pb.ListContentPagesResponse{Pages: make([]*pb.ContentPage, len(pages)), Total: int32(total)}
+	for i, page := range pages {
+		resp.Pages[i] = contentPageToProto(page, now)
+	}
+	return resp, nil
+}
+
+// DeleteContentPage removes a page, freeing its slug
+func (s *ContentService) DeleteContentPage(ctx context.Context, req *pb.DeleteContentPageRequest) (*pb.DeleteContentPageResponse, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page ID")
+	}
+
+	if err := s.contentRepo.DeletePage(ctx, req.Id); err != nil {
+		return nil, s.contentError("Failed to delete content page", err)
+	}
+	s.logger.Info("Content page deleted", zap.String("id", req.Id))
+	return &pb.DeleteContentPageResponse{Success: true}, nil
+}
+
+// CreateContentBanner adds a banner to a slot, a draft unless its status
+// says otherwise
+func (s *ContentService) CreateContentBanner(ctx context.Context, req *pb.CreateContentBannerRequest) (*pb.ContentBanner, error) {
+	banner, err := contentBannerFromProto(req.Banner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.contentRepo.CreateBanner(ctx, banner); err != nil {
+		return nil, s.contentError("Failed to create content banner", err)
+	}
+
+	s.logger.Info("Content banner created",
+		zap.String("id", banner.ID),
+		zap.String("slot", banner.Slot),
+		zap.String("status", banner.Status))
+	return contentBannerToProto(banner, time.Now()), nil
+}
+
+// UpdateContentBanner replaces the content, slot and schedule of a banner
+func (s *ContentService) UpdateContentBanner(ctx context.Context, req *pb.UpdateContentBannerRequest) (*pb.ContentBanner, error) {
+	if req.Banner == nil {
+		return nil, status.Error(codes.InvalidArgument, "banner is required")
+	}
+	if _, err := uuid.Parse(req.Banner.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid banner ID")
+	}
+	banner, err := contentBannerFromProto(req.Banner)
+	if err != nil {
+		return nil, err
+	}
+	banner.ID = req.Banner.Id
+
+	if err := s.contentRepo.UpdateBanner(ctx, banner); err != nil {
+		return nil, s.contentError("Failed to update content banner", err)
+	}
+	updated, err := s.contentRepo.GetBanner(ctx, banner.ID)
+	if err != nil {
+		return nil, s.contentError("Failed to get content banner", err)
+	}
+	return contentBannerToProto(updated, time.Now()), nil
+}
+
+// ListContentBanners returns the banners of a slot, or of every slot, by
+// position, only the live ones when asked
+func (s *ContentService) ListContentBanners(ctx context.Context, req *pb.ListContentBannersRequest) (*pb.ListContentBannersResponse, error) {
+	slot := strings.ToLower(strings.TrimSpace(req.Slot))
+	if slot != "" && !contentSlotPattern.MatchString(slot) {
+		return nil, status.Error(codes.InvalidArgument, "invalid slot")
+	}
+
+	banners, err := s.contentRepo.ListBanners(ctx, slot, req.LiveOnly)
+	if err != nil {
+		return nil, s.contentError("Failed to list content banners", err)
+	}
+
+	now := time.Now()
+	resp := &pb.ListContentBannersResponse{Banners: make([]*pb.ContentBanner, len(banners))}
+	for i, banner := range banners {
+		resp.Banners[i] = contentBannerToProto(banner, now)
+	}
+	return resp, nil
+}
+
+// DeleteContentBanner removes a banner from its slot
+func (s *ContentService) DeleteContentBanner(ctx context.Context, req *pb.DeleteContentBannerRequest) (*pb.DeleteContentBannerResponse, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid banner ID")
+	}
+
+	if err := s.contentRepo.DeleteBanner(ctx, req.Id); err != nil {
+		return nil, s.contentError("Failed to delete content banner", err)
+	}
+	s.logger.Info("Content banner deleted", zap.String("id", req.Id))
+	return &pb.DeleteContentBannerResponse{Success: true}, nil
+}
+
+func (s *ContentService) contentError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrContentPageNotFound), errors.Is(err, models.ErrContentBannerNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrContentPageSlugExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func contentPageFromProto(proto *pb.ContentPage) (*models.ContentPage, error) {
+	if proto == nil {
+		return nil, status.Error(codes.InvalidArgument, "page is required")
+	}
+	page := &models.ContentPage{
+		Slug:    strings.ToLower(strings.TrimSpace(proto.Slug)),
+		Title:   strings.TrimSpace(proto.Title),
+		Summary: strings.TrimSpace(proto.Summary),
+		Body:    proto.Body,
+	}
+	if !contentSlugPattern.MatchString(page.Slug) || len(page.Slug) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "slug must be lowercase letters, digits and hyphens, at most 255 characters")
+	}
+	if err := contentTitle(page.Title); err != nil {
+		return nil, err
+	}
+	if utf8.RuneCountInString(page.Body) > maxContentBodyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "body cannot exceed %d characters", maxContentBodyLength)
+	}
+	schedule, err := contentScheduleFromProto(proto.Status, proto.PublishAt, proto.UnpublishAt)
+	if err != nil {
+		return nil, err
+	}
+	page.ContentSchedule = schedule
+	return page, nil
+}
+
+func contentBannerFromProto(proto *pb.ContentBanner) (*models.ContentBanner, error) {
+	if proto == nil {
+		return nil, status.Error(codes.InvalidArgument, "banner is required")
+	}
+	banner := &models.ContentBanner{
+		Slot:     strings.ToLower(strings.TrimSpace(proto.Slot)),
+		Title:    strings.TrimSpace(proto.Title),
+		Subtitle: strings.TrimSpace(proto.Subtitle),
+		ImageURL: strings.TrimSpace(proto.ImageUrl),
+		LinkURL:  strings.TrimSpace(proto.LinkUrl),
+		Position: int(proto.Position),
+	}
+	if !contentSlotPattern.MatchString(banner.Slot) || len(banner.Slot) > 50 {
+		return nil, status.Error(codes.InvalidArgument, "slot must be lowercase letters, digits and underscores, at most 50 characters")
+	}
+	if err := contentTitle(banner.Title); err != nil {
+		return nil, err
+	}
+	if banner.ImageURL == "" || !isContentURL(banner.ImageURL) {
+		return nil, status.Error(codes.InvalidArgument, "image_url must be an http(s) URL or a path")
+	}
+	if banner.LinkURL != "" && !isContentURL(banner.LinkURL) {
+		return nil, status.Error(codes.InvalidArgument, "link_url must be an http(s) URL or a path")
+	}
+	if banner.Position < 0 {
+		return nil, status.Error(codes.InvalidArgument, "position cannot be negative")
+	}
+	schedule, err := contentScheduleFromProto(proto.Status, proto.PublishAt, proto.UnpublishAt)
+	if err != nil {
+		return nil, err
+	}
+	banner.ContentSchedule = schedule
+	return banner, nil
+}
+
+func contentTitle(title string) error {
+	if title == "" {
+		return status.Error(codes.InvalidArgument, "title is required")
+	}
+	if utf8.RuneCountInString(title) > maxContentTitleLength {
+		return status.Errorf(codes.InvalidArgument, "title cannot exceed %d characters", maxContentTitleLength)
+	}
+	return nil
+}
+
+// contentScheduleFromProto validates the publication state of content,
+// a draft when no status is given
+func contentScheduleFromProto(state string, publishAt, unpublishAt *timestamppb.Timestamp) (models.ContentSchedule, error) {
+	schedule := models.ContentSchedule{Status: state}
+	if schedule.Status == "" {
+		schedule.Status = models.ContentStatusDraft
+	}
+	if !models.IsValidContentStatus(schedule.Status) {
+		return schedule, status.Error(codes.InvalidArgument, "status must be draft or published")
+	}
+	if publishAt != nil {
+		t := publishAt.AsTime()
+		schedule.PublishAt = &t
+	}
+	if unpublishAt != nil {
+		t := unpublishAt.AsTime()
+		schedule.UnpublishAt = &t
+	}
+	if schedule.PublishAt != nil && schedule.UnpublishAt != nil && !schedule.UnpublishAt.After(*schedule.PublishAt) {
+		return schedule, status.Error(codes.InvalidArgument, "unpublish_at must be after publish_at")
+	}
+	return schedule, nil
+}
+
+// isContentURL reports whether u is an absolute http(s) URL or a path on
+// the storefront
+func isContentURL(u string) bool {
+	if strings.HasPrefix(u, "/") && !strings.HasPrefix(u, "//") {
+		return true
+	}
+	parsed, err := url.Parse(u)
+	return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != ""
+}
+
+func contentScheduleToProto(schedule models.ContentSchedule) (publishAt, unpublishAt *timestamppb.Timestamp) {
+	if schedule.PublishAt != nil {
+		publishAt = timestamppb.New(*schedule.PublishAt)
+	}
+	if schedule.UnpublishAt != nil {
+		unpublishAt = timestamppb.New(*schedule.UnpublishAt)
+	}
+	return publishAt, unpublishAt
+}
+
+func contentPageToProto(page *models.ContentPage, now time.Time) *pb.ContentPage {
+	publishAt, unpublishAt := contentScheduleToProto(page.ContentSchedule)
+	return &pb.ContentPage{
+		Id:          page.ID,
+		Slug:        page.Slug,
+		Title:       page.Title,
+		Summary:     page.Summary,
+		Body:        page.Body,
+		Status:      page.Status,
+		PublishAt:   publishAt,
+		UnpublishAt: unpublishAt,
+		Live:        page.IsLive(now),
+		CreatedAt:   timestamppb.New(page.CreatedAt),
+		UpdatedAt:   timestamppb.New(page.UpdatedAt),
+	}
+}
+
+func contentBannerToProto(banner *models.ContentBanner, now time.Time) *pb.ContentBanner {
+	publishAt, unpublishAt := contentScheduleToProto(banner.ContentSchedule)
+	return &pb.ContentBanner{
+		Id:          banner.ID,
+		Slot:        banner.Slot,
+		Title:       banner.Title,
+		Subtitle:    banner.Subtitle,
+		ImageUrl:    banner.ImageURL,
+		LinkUrl:     banner.LinkURL,
+		Position:    int32(banner.Position),
+		Status:      banner.Status,
+		PublishAt:   publishAt,
+		UnpublishAt: unpublishAt,
+		Live:        banner.IsLive(now),
+		CreatedAt:   timestamppb.New(banner.CreatedAt),
+		UpdatedAt:   timestamppb.New(banner.UpdatedAt),
+	}
+}
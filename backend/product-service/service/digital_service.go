@@ -0,0 +1,282 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/common/downloadtoken"
+	"github.com/louai60/e-commerce_project/backend/product-service/events"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"github.com/louai60/e-commerce_project/backend/product-service/storage"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// downloadChunkSize is the size of the file chunks streamed to the gateway
+const downloadChunkSize = 64 * 1024
+
+// DigitalServiceOptions configures asset storage and download links
+type DigitalServiceOptions struct {
+	// SigningKey signs download links; links cannot be created without it
+	SigningKey           string
+	DownloadBaseURL      string
+	LinkTTL              time.Duration
+	DefaultDownloadLimit int
+}
+
+// DigitalService handles business logic for digital products: storing their
+// assets and handing them out through signed, time-limited download links
+type DigitalService struct {
+	digitalRepo    repository.DigitalAssetRepository
+	productService *ProductService
+	store          storage.Storage
+	options        DigitalServiceOptions
+	logger         *zap.Logger
+}
+
+// NewDigitalService creates a new digital product service
+func NewDigitalService(
+	digitalRepo repository.DigitalAssetRepository,
+	productService *ProductService,
+	store storage.Storage,
+	options DigitalServiceOptions,
+	logger *zap.Logger,
+) *DigitalService {
+	if options.LinkTTL <= 0 {
+		options.LinkTTL = 15 * time.Minute
+	}
+	if options.DefaultDownloadLimit <= 0 {
+		options.DefaultDownloadLimit = 5
+	}
+
+	return &DigitalService{
+		digitalRepo:    digitalRepo,
+		productService: productService,
+		store:          store,
+		options:        options,
+		logger:         logger,
+	}
+}
+
+// UploadDigitalAsset stores the file of a digital product, replacing the
+// previous file if the product already had one
+func (s *DigitalService) UploadDigitalAsset(ctx context.Context, req *pb.UploadDigitalAssetRequest) (*pb.DigitalAsset, error) {
+	if _, err := s.productService.productRepo.GetByID(ctx, req.ProductId); err != nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+	if _, err := s.productService.bundleRepo.GetBundleByProductID(ctx, req.ProductId); err == nil {
+		return nil, status.Error(codes.InvalidArgument, "bundles cannot be digital products")
+	}
+
+	previous, err := s.digitalRepo.GetDigitalAssetByProductID(ctx, req.ProductId)
+	if err != nil && !errors.Is(err, models.ErrDigitalAssetNotFound) {
+		return nil, s.digitalError("Failed to get digital asset", err)
+	}
+
+	result, err := s.store.Upload(req.File, req.ProductId, req.Filename)
+	if err != nil {
+		s.logger.Error("Failed to store digital asset", zap.Error(err), zap.String("product_id", req.ProductId))
+		return nil, status.Error(codes.Internal, "Failed to store digital asset")
+	}
+
+	asset := &models.DigitalAsset{
+		ProductID:     req.ProductId,
+		FileName:      req.Filename,
+		ContentType:   req.MimeType,
+		SizeBytes:     int64(len(req.File)),
+		StorageKey:    result.PublicID,
+		DownloadLimit: int(req.DownloadLimit),
+	}
+	if asset.ContentType == "" {
+		asset.ContentType = "application/octet-stream"
+	}
+	if asset.DownloadLimit == 0 {
+		asset.DownloadLimit = s.options.DefaultDownloadLimit
+	}
+
+	if err := s.digitalRepo.UpsertDigitalAsset(ctx, asset); err != nil {
+		if deleteErr := s.store.Delete(result.PublicID); deleteErr != nil {
+			s.logger.Warn("Failed to remove stored file of failed digital asset", zap.Error(deleteErr))
+		}
+		return nil, s.digitalError("Failed to save digital asset", err)
+	}
+
+	if previous != nil && previous.StorageKey != asset.StorageKey {
+		if err := s.store.Delete(previous.StorageKey); err != nil {
+			s.logger.Warn("Failed to remove replaced digital asset file",
+				zap.String("storage_key", previous.StorageKey),
+				zap.Error(err))
+		}
+	}
+
+	// The cached product does not know about its new type yet
+	if err := s.productService.cacheManager.InvalidateProduct(ctx, req.ProductId); err != nil {
+		s.logger.Warn("Failed to invalidate digital product cache",
+			zap.String("product_id", req.ProductId),
+			zap.Error(err))
+	}
+	s.productService.publishProductEvent(ctx, events.ProductUpdated, req.ProductId)
+
+	s.logger.Info("Digital asset uploaded",
+		zap.String("product_id", asset.ProductID),
+		zap.String("file_name", asset.FileName),
+		zap.Int64("size_bytes", asset.SizeBytes))
+
+	return convertDigitalAssetModelToProto(asset), nil
+}
+
+// CreateDownloadLink grants a purchase access to the asset of a digital product
+// and returns a signed link to it. Repeated calls for the same purchase share
+// one download limit.
+func (s *DigitalService) CreateDownloadLink(ctx context.Context, req *pb.CreateDownloadLinkRequest) (*pb.DownloadLink, error) {
+	if s.options.SigningKey == "" {
+		return nil, status.Error(codes.FailedPrecondition, "digital downloads are not configured")
+	}
+
+	asset, err := s.digitalRepo.GetDigitalAssetByProductID(ctx, req.ProductId)
+	if err != nil {
+		return nil, s.digitalError("Failed to get digital asset", err)
+	}
+
+	grant := &models.DownloadGrant{
+		ProductID:     req.ProductId,
+		PurchaseID:    req.PurchaseId,
+		UserID:        req.UserId,
+		DownloadLimit: asset.DownloadLimit,
+	}
+	if err := s.digitalRepo.GetOrCreateDownloadGrant(ctx, grant); err != nil {
+		return nil, s.digitalError("Failed to create download grant", err)
+	}
+	if grant.UserID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "purchase belongs to another user")
+	}
+	if grant.Remaining() == 0 {
+		return nil, status.Error(codes.PermissionDenied, models.ErrDownloadLimitReached.Error())
+	}
+
+	expiresAt := time.Now().Add(s.options.LinkTTL).Truncate(time.Second)
+	token := downloadtoken.Sign([]byte(s.options.SigningKey), downloadtoken.Claims{
+		GrantID:   grant.ID,
+		ExpiresAt: expiresAt,
+	})
+
+	return &pb.DownloadLink{
+		Url:                strings.TrimSuffix(s.options.DownloadBaseURL, "/") + "/" + token,
+		Token:              token,
+		ExpiresAt:          timestamppb.New(expiresAt),
+		DownloadsRemaining: int32(grant.Remaining()),
+		DownloadLimit:      int32(grant.DownloadLimit),
+	}, nil
+}
+
+// DownloadDigitalAsset verifies a download token, counts the download against
+// its purchase and streams the asset in chunks
+func (s *DigitalService) DownloadDigitalAsset(req *pb.DownloadDigitalAssetRequest, stream grpc.ServerStreamingServer[pb.DigitalAssetChunk]) error {
+	ctx := stream.Context()
+
+	if s.options.SigningKey == "" {
+		return status.Error(codes.FailedPrecondition, "digital downloads are not configured")
+	}
+
+	claims, err := downloadtoken.Verify([]byte(s.options.SigningKey), req.Token, time.Now())
+	if err != nil {
+		if errors.Is(err, downloadtoken.ErrExpired) {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	// Count the download first so that concurrent requests cannot exceed the limit
+	grant, err := s.digitalRepo.ConsumeDownload(ctx, claims.GrantID)
+	if err != nil {
+		return s.digitalError("Failed to record download", err)
+	}
+
+	asset, err := s.digitalRepo.GetDigitalAssetByProductID(ctx, grant.ProductID)
+	if err != nil {
+		return s.digitalError("Failed to get digital asset", err)
+	}
+
+	file, err := s.store.Open(asset.StorageKey)
+	if err != nil {
+		s.logger.Error("Failed to open digital asset", zap.Error(err), zap.String("storage_key", asset.StorageKey))
+		return status.Error(codes.Internal, "Failed to open digital asset")
+	}
+	defer file.Close()
+
+	s.logger.Info("Streaming digital asset",
+		zap.String("product_id", asset.ProductID),
+		zap.String("purchase_id", grant.PurchaseID),
+		zap.Int("downloads_remaining", grant.Remaining()))
+
+	chunk := &pb.DigitalAssetChunk{
+		FileName:           asset.FileName,
+		ContentType:        asset.ContentType,
+		SizeBytes:          asset.SizeBytes,
+		DownloadsRemaining: int32(grant.Remaining()),
+	}
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			chunk.Data = buf[:n]
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			chunk = &pb.DigitalAssetChunk{}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			s.logger.Error("Failed to read digital asset", zap.Error(err), zap.String("storage_key", asset.StorageKey))
+			return status.Error(codes.Internal, "Failed to read digital asset")
+		}
+	}
+
+	// Empty files still send their details
+	if chunk.FileName != "" {
+		return stream.Send(chunk)
+	}
+	return nil
+}
+
+// digitalError logs a repository error and maps it to a gRPC status
+func (s *DigitalService) digitalError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrDigitalAssetNotFound):
+		return status.Error(codes.NotFound, "product has no digital asset")
+	case errors.Is(err, models.ErrDownloadGrantNotFound):
+		return status.Error(codes.NotFound, "download not found")
+	case errors.Is(err, models.ErrDownloadLimitReached):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertDigitalAssetModelToProto(model *models.DigitalAsset) *pb.DigitalAsset {
+	if model == nil {
+		return nil
+	}
+	return &pb.DigitalAsset{
+		Id:            model.ID,
+		ProductId:     model.ProductID,
+		FileName:      model.FileName,
+		ContentType:   model.ContentType,
+		SizeBytes:     model.SizeBytes,
+		DownloadLimit: int32(model.DownloadLimit),
+		CreatedAt:     timestamppb.New(model.CreatedAt),
+		UpdatedAt:     timestamppb.New(model.UpdatedAt),
+	}
+}
@@ -0,0 +1,582 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	inventorypb "github.com/louai60/e-commerce_project/backend/inventory-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/erpsync"
+	"github.com/louai60/e-commerce_project/backend/product-service/events"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultSyncBatchSize = 500
+	defaultSyncRunLimit  = 50
+	maxSyncRunLimit      = 500
+	// syncReferenceType marks inventory movements made by ERP stock pulls
+	syncReferenceType = "ERP_SYNC"
+)
+
+// ErpSyncOptions configures how ERP records are synchronized
+type ErpSyncOptions struct {
+	Policy    erpsync.ConflictPolicy
+	BatchSize int
+}
+
+// ErpSyncService synchronizes products, prices and stock with an ERP through
+// a connector. Each entity is pulled and then pushed; every step is recorded
+// in the sync run log.
+type ErpSyncService struct {
+	syncRepo       repository.SyncRepository
+	storeRepo      repository.StoreRepository
+	productService *ProductService
+	connector      erpsync.Connector
+	options        ErpSyncOptions
+	logger         *zap.Logger
+
+	// running prevents overlapping runs of the scheduler and manual triggers
+	running sync.Mutex
+}
+
+// NewErpSyncService creates a new ERP sync service. connector may be nil when
+// no ERP is configured, in which case runs are refused.
+func NewErpSyncService(
+	syncRepo repository.SyncRepository,
+	storeRepo repository.StoreRepository,
+	productService *ProductService,
+	connector erpsync.Connector,
+	options ErpSyncOptions,
+	logger *zap.Logger,
+) *ErpSyncService {
+	if options.Policy == "" {
+		options.Policy = erpsync.PolicyERPWins
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = defaultSyncBatchSize
+	}
+
+	return &ErpSyncService{
+		syncRepo:       syncRepo,
+		storeRepo:      storeRepo,
+		productService: productService,
+		connector:      connector,
+		options:        options,
+		logger:         logger,
+	}
+}
+
+// syncResult collects the counters of one entity sync
+type syncResult struct {
+	read, applied, skipped, conflicts int
+}
+
+// Sync pulls and pushes every entity for the store in ctx and returns the
+// logged runs. A failing entity does not stop the others.
+func (s *ErpSyncService) Sync(ctx context.Context) ([]*models.SyncRun, error) {
+	if s.connector == nil {
+		return nil, status.Error(codes.FailedPrecondition, "ERP sync is not configured")
+	}
+	if !s.running.TryLock() {
+		return nil, status.Error(codes.Aborted, "an ERP sync is already running")
+	}
+	defer s.running.Unlock()
+
+	var runs []*models.SyncRun
+	for _, entity := range erpsync.Entities {
+		// SKUs updated by the pull are not echoed back by the push
+		pulled := make(map[string]bool)
+
+		run, err := s.runStep(ctx, entity, erpsync.DirectionPull, func(cursor *models.SyncCursor) (*syncResult, error) {
+			return s.pull(ctx, entity, cursor, pulled)
+		})
+		if err != nil {
+			return runs, err
+		}
+		runs = append(runs, run)
+
+		run, err = s.runStep(ctx, entity, erpsync.DirectionPush, func(cursor *models.SyncCursor) (*syncResult, error) {
+			return s.push(ctx, entity, cursor, pulled)
+		})
+		if err != nil {
+			return runs, err
+		}
+		runs = append(runs, run)
+	}
+
+	return runs, nil
+}
+
+// runStep logs a run around one entity sync and saves the cursor it reached.
+// Only failures of the run log itself are returned.
+func (s *ErpSyncService) runStep(ctx context.Context, entity, direction string, step func(*models.SyncCursor) (*syncResult, error)) (*models.SyncRun, error) {
+	run := &models.SyncRun{Connector: s.connector.Name(), Entity: entity, Direction: direction}
+	if err := s.syncRepo.CreateSyncRun(ctx, run); err != nil {
+		return nil, err
+	}
+
+	cursor, stepErr := s.syncRepo.GetSyncCursor(ctx, run.Connector, entity, direction)
+	if stepErr == nil {
+		var result *syncResult
+		result, stepErr = step(cursor)
+		if result != nil {
+			run.RecordsRead = result.read
+			run.RecordsApplied = result.applied
+			run.RecordsSkipped = result.skipped
+			run.Conflicts = result.conflicts
+		}
+
+		// Progress made before a failure is kept so it is not synced twice
+		if direction == erpsync.DirectionPull && stepErr == nil {
+			cursor.SyncedAt = time.Now().UTC()
+		}
+		if err := s.syncRepo.SaveSyncCursor(ctx, cursor); err != nil && stepErr == nil {
+			stepErr = err
+		}
+	}
+
+	run.Status = models.SyncRunSucceeded
+	if stepErr != nil {
+		run.Status = models.SyncRunFailed
+		message := stepErr.Error()
+		run.Error = &message
+		s.logger.Error("ERP sync failed",
+			zap.String("connector", run.Connector),
+			zap.String("entity", entity),
+			zap.String("direction", direction),
+			zap.Error(stepErr))
+	}
+
+	if err := s.syncRepo.FinishSyncRun(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+func (s *ErpSyncService) pull(ctx context.Context, entity string, cursor *models.SyncCursor, pulled map[string]bool) (*syncResult, error) {
+	since := erpsync.Cursor{UpdatedAt: cursor.UpdatedAt, SKU: cursor.SKU}
+	switch entity {
+	case erpsync.EntityProducts:
+		records, err := s.connector.PullProducts(ctx, since)
+		if err != nil {
+			return nil, err
+		}
+		return s.applyCatalogRecords(ctx, cursor, len(records), func(i int) (string, time.Time) {
+			return records[i].SKU, records[i].UpdatedAt
+		}, func(i int) error {
+			return s.syncRepo.ApplyProductRecord(ctx, records[i])
+		}, pulled)
+
+	case erpsync.EntityPrices:
+		records, err := s.connector.PullPrices(ctx, since)
+		if err != nil {
+			return nil, err
+		}
+		return s.applyCatalogRecords(ctx, cursor, len(records), func(i int) (string, time.Time) {
+			return records[i].SKU, records[i].UpdatedAt
+		}, func(i int) error {
+			if records[i].DiscountAmount != nil && *records[i].DiscountAmount >= records[i].Amount {
+				return errSyncRecordInvalid
+			}
+			return s.syncRepo.ApplyPriceRecord(ctx, records[i])
+		}, pulled)
+
+	case erpsync.EntityStock:
+		records, err := s.connector.PullStock(ctx, since)
+		if err != nil {
+			return nil, err
+		}
+		return s.applyStockRecords(ctx, cursor, records, pulled)
+	}
+	return nil, fmt.Errorf("unknown sync entity %q", entity)
+}
+
+// errSyncRecordInvalid marks a pulled record that cannot be applied; it is
+// skipped rather than failing the run
+var errSyncRecordInvalid = errors.New("invalid sync record")
+
+// applyCatalogRecords applies pulled product or price records in order,
+// resolving conflicts with products changed since the last pull. Unknown SKUs
+// and invalid records are skipped.
+func (s *ErpSyncService) applyCatalogRecords(
+	ctx context.Context,
+	cursor *models.SyncCursor,
+	count int,
+	key func(int) (string, time.Time),
+	apply func(int) error,
+	pulled map[string]bool,
+) (*syncResult, error) {
+	result := &syncResult{read: count}
+	for i := 0; i < count; i++ {
+		sku, updatedAt := key(i)
+
+		state, err := s.syncRepo.GetSyncProductState(ctx, sku)
+		switch {
+		case errors.Is(err, models.ErrProductNotFound):
+			result.skipped++
+		case err != nil:
+			return result, err
+		default:
+			ok, conflict := s.options.Policy.Resolve(updatedAt, state.UpdatedAt, cursor.SyncedAt)
+			if conflict {
+				result.conflicts++
+				s.logger.Warn("ERP sync conflict",
+					zap.String("sku", sku),
+					zap.String("policy", string(s.options.Policy)),
+					zap.Bool("applied", ok))
+			}
+			if !ok {
+				result.skipped++
+				break
+			}
+
+			if err := apply(i); err != nil {
+				if errors.Is(err, errSyncRecordInvalid) || errors.Is(err, models.ErrProductNotFound) {
+					result.skipped++
+					break
+				}
+				return result, err
+			}
+			result.applied++
+			pulled[sku] = true
+
+			if err := s.productService.cacheManager.InvalidateProductAndRelated(ctx, state.ID); err != nil {
+				s.logger.Warn("Failed to invalidate synced product cache", zap.String("product_id", state.ID), zap.Error(err))
+			}
+			s.productService.publishProductEvent(ctx, events.ProductUpdated, state.ID)
+		}
+
+		cursor.UpdatedAt, cursor.SKU = updatedAt, sku
+	}
+
+	return result, nil
+}
+
+// applyStockRecords sets the on-hand quantity of each pulled SKU and warehouse
+// by moving the difference through the inventory service
+func (s *ErpSyncService) applyStockRecords(ctx context.Context, cursor *models.SyncCursor, records []erpsync.StockRecord, pulled map[string]bool) (*syncResult, error) {
+	result := &syncResult{read: len(records)}
+	if len(records) == 0 {
+		return result, nil
+	}
+	if s.productService.inventoryClient == nil {
+		return result, errors.New("inventory service is not available")
+	}
+
+	var updates []*inventorypb.BulkUpdateItem
+	for _, record := range records {
+		item, err := s.productService.inventoryClient.GetInventoryItemBySKU(ctx, record.SKU)
+		if err != nil {
+			if status.Code(err) != codes.NotFound {
+				return result, err
+			}
+			result.skipped++
+			continue
+		}
+
+		current, localUpdatedAt := 0, item.UpdatedAt.AsTime()
+		for _, location := range item.Locations {
+			if location.WarehouseId == record.WarehouseID {
+				current, localUpdatedAt = int(location.Quantity), location.UpdatedAt.AsTime()
+				break
+			}
+		}
+
+		ok, conflict := s.options.Policy.Resolve(record.UpdatedAt, localUpdatedAt, cursor.SyncedAt)
+		if conflict {
+			result.conflicts++
+		}
+		if !ok || record.Quantity == current {
+			result.skipped++
+			continue
+		}
+
+		updates = append(updates, &inventorypb.BulkUpdateItem{
+			Sku:           record.SKU,
+			QuantityDelta: int32(record.Quantity - current),
+			WarehouseId:   record.WarehouseID,
+			ReferenceId:   s.connector.Name(),
+			ReferenceType: syncReferenceType,
+			Notes:         "Stock level from ERP sync",
+		})
+	}
+
+	if len(updates) > 0 {
+		resp, err := s.productService.inventoryClient.BulkUpdateInventory(ctx, updates)
+		if err != nil {
+			return result, err
+		}
+		for _, r := range resp.Results {
+			if !r.Success {
+				result.skipped++
+				s.logger.Warn("ERP stock update rejected", zap.String("sku", r.Sku), zap.String("message", r.Message))
+				continue
+			}
+			result.applied++
+			pulled[r.Sku] = true
+		}
+	}
+
+	last := records[len(records)-1]
+	cursor.UpdatedAt, cursor.SKU = last.UpdatedAt, last.SKU
+	return result, nil
+}
+
+func (s *ErpSyncService) push(ctx context.Context, entity string, cursor *models.SyncCursor, pulled map[string]bool) (*syncResult, error) {
+	switch entity {
+	case erpsync.EntityProducts:
+		return s.pushProductChanges(ctx, cursor, pulled)
+	case erpsync.EntityPrices:
+		return s.pushPriceChanges(ctx, cursor, pulled)
+	case erpsync.EntityStock:
+		return s.pushStockChanges(ctx, cursor, pulled)
+	}
+	return nil, fmt.Errorf("unknown sync entity %q", entity)
+}
+
+// pushProductChanges pushes the products changed after the cursor in batches
+func (s *ErpSyncService) pushProductChanges(ctx context.Context, cursor *models.SyncCursor, pulled map[string]bool) (*syncResult, error) {
+	result := &syncResult{}
+	for {
+		records, err := s.syncRepo.ListProductChanges(ctx, erpsync.Cursor{UpdatedAt: cursor.UpdatedAt, SKU: cursor.SKU}, s.options.BatchSize)
+		if err != nil {
+			return result, err
+		}
+		result.read += len(records)
+
+		batch := make([]erpsync.ProductRecord, 0, len(records))
+		for _, record := range records {
+			if pulled[record.SKU] {
+				result.skipped++
+				continue
+			}
+			batch = append(batch, record)
+		}
+		if len(batch) > 0 {
+			if err := s.connector.PushProducts(ctx, batch); err != nil {
+				return result, err
+			}
+			result.applied += len(batch)
+		}
+
+		if len(records) > 0 {
+			last := records[len(records)-1]
+			cursor.UpdatedAt, cursor.SKU = last.UpdatedAt, last.SKU
+		}
+		if len(records) < s.options.BatchSize {
+			return result, nil
+		}
+	}
+}
+
+// pushPriceChanges pushes the prices of products changed after the cursor in
+// batches, in the store's default currency
+func (s *ErpSyncService) pushPriceChanges(ctx context.Context, cursor *models.SyncCursor, pulled map[string]bool) (*syncResult, error) {
+	currency := s.storeCurrency(ctx)
+
+	result := &syncResult{}
+	for {
+		records, err := s.syncRepo.ListPriceChanges(ctx, erpsync.Cursor{UpdatedAt: cursor.UpdatedAt, SKU: cursor.SKU}, s.options.BatchSize)
+		if err != nil {
+			return result, err
+		}
+		result.read += len(records)
+
+		batch := make([]erpsync.PriceRecord, 0, len(records))
+		for _, record := range records {
+			if pulled[record.SKU] {
+				result.skipped++
+				continue
+			}
+			record.Currency = currency
+			batch = append(batch, record)
+		}
+		if len(batch) > 0 {
+			if err := s.connector.PushPrices(ctx, batch); err != nil {
+				return result, err
+			}
+			result.applied += len(batch)
+		}
+
+		if len(records) > 0 {
+			last := records[len(records)-1]
+			cursor.UpdatedAt, cursor.SKU = last.UpdatedAt, last.SKU
+		}
+		if len(records) < s.options.BatchSize {
+			return result, nil
+		}
+	}
+}
+
+// pushStockChanges pushes the warehouse quantities changed after the cursor.
+// The inventory service cannot filter by update time, so all items are read.
+func (s *ErpSyncService) pushStockChanges(ctx context.Context, cursor *models.SyncCursor, pulled map[string]bool) (*syncResult, error) {
+	result := &syncResult{}
+	if s.productService.inventoryClient == nil {
+		return result, errors.New("inventory service is not available")
+	}
+
+	since := erpsync.Cursor{UpdatedAt: cursor.UpdatedAt, SKU: cursor.SKU}
+	var records []erpsync.StockRecord
+	for page := 1; ; page++ {
+		items, total, err := s.productService.inventoryClient.ListInventoryItems(ctx, page, s.options.BatchSize)
+		if err != nil {
+			return result, err
+		}
+		for _, item := range items {
+			for _, location := range item.Locations {
+				updatedAt := location.UpdatedAt.AsTime()
+				if since.After(updatedAt, item.Sku) {
+					records = append(records, erpsync.StockRecord{
+						SKU:         item.Sku,
+						WarehouseID: location.WarehouseId,
+						Quantity:    int(location.Quantity),
+						UpdatedAt:   updatedAt,
+					})
+				}
+			}
+		}
+		if len(items) == 0 || page*s.options.BatchSize >= total {
+			break
+		}
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		if records[i].UpdatedAt.Equal(records[j].UpdatedAt) {
+			return records[i].SKU < records[j].SKU
+		}
+		return records[i].UpdatedAt.Before(records[j].UpdatedAt)
+	})
+	result.read = len(records)
+
+	keep := make([]erpsync.StockRecord, 0, len(records))
+	for _, record := range records {
+		if pulled[record.SKU] {
+			result.skipped++
+			continue
+		}
+		keep = append(keep, record)
+	}
+	if len(keep) > 0 {
+		if err := s.connector.PushStock(ctx, keep); err != nil {
+			return result, err
+		}
+		result.applied = len(keep)
+	}
+
+	if len(records) > 0 {
+		last := records[len(records)-1]
+		cursor.UpdatedAt, cursor.SKU = last.UpdatedAt, last.SKU
+	}
+	return result, nil
+}
+
+// storeCurrency returns the default currency of the store in ctx
+func (s *ErpSyncService) storeCurrency(ctx context.Context) string {
+	shop, err := s.storeRepo.GetStore(ctx, tenant.FromContext(ctx))
+	if err != nil {
+		s.logger.Warn("Failed to load store currency for ERP sync", zap.Error(err))
+		return "USD"
+	}
+	return shop.DefaultCurrency
+}
+
+// StartSyncScheduler runs a sync at the given interval until the context is
+// cancelled
+func (s *ErpSyncService) StartSyncScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("ERP sync scheduler stopped")
+				return
+			case <-ticker.C:
+				runs, err := s.Sync(ctx)
+				if err != nil {
+					s.logger.Error("Scheduled ERP sync failed", zap.Error(err))
+					continue
+				}
+				for _, run := range runs {
+					if run.RecordsApplied > 0 || run.Conflicts > 0 {
+						s.logger.Info("ERP sync run finished",
+							zap.String("entity", run.Entity),
+							zap.String("direction", run.Direction),
+							zap.Int("applied", run.RecordsApplied),
+							zap.Int("conflicts", run.Conflicts))
+					}
+				}
+			}
+		}
+	}()
+}
+
+// RunErpSync starts a sync right away and returns its runs
+func (s *ErpSyncService) RunErpSync(ctx context.Context, _ *pb.RunErpSyncRequest) (*pb.ListErpSyncRunsResponse, error) {
+	runs, err := s.Sync(ctx)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		s.logger.Error("Failed to run ERP sync", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to run ERP sync: %v", err)
+	}
+	return convertSyncRunsToProto(runs), nil
+}
+
+// ListErpSyncRuns returns the sync run log, most recent first
+func (s *ErpSyncService) ListErpSyncRuns(ctx context.Context, req *pb.ListErpSyncRunsRequest) (*pb.ListErpSyncRunsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSyncRunLimit
+	}
+	if limit > maxSyncRunLimit {
+		limit = maxSyncRunLimit
+	}
+
+	runs, err := s.syncRepo.ListSyncRuns(ctx, limit)
+	if err != nil {
+		s.logger.Error("Failed to list ERP sync runs", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "Failed to list ERP sync runs: %v", err)
+	}
+	return convertSyncRunsToProto(runs), nil
+}
+
+func convertSyncRunsToProto(runs []*models.SyncRun) *pb.ListErpSyncRunsResponse {
+	resp := &pb.ListErpSyncRunsResponse{Runs: make([]*pb.ErpSyncRun, len(runs))}
+	for i, run := range runs {
+		proto := &pb.ErpSyncRun{
+			Id:             run.ID,
+			Connector:      run.Connector,
+			Entity:         run.Entity,
+			Direction:      run.Direction,
+			Status:         run.Status,
+			RecordsRead:    int32(run.RecordsRead),
+			RecordsApplied: int32(run.RecordsApplied),
+			RecordsSkipped: int32(run.RecordsSkipped),
+			Conflicts:      int32(run.Conflicts),
+			StartedAt:      timestamppb.New(run.StartedAt),
+		}
+		if run.Error != nil {
+			proto.Error = *run.Error
+		}
+		if run.FinishedAt != nil {
+			proto.FinishedAt = timestamppb.New(*run.FinishedAt)
+		}
+		resp.Runs[i] = proto
+	}
+	return resp
+}
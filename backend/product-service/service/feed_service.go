@@ -0,0 +1,410 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/common/downloadtoken"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/inventory-service/availability"
+	"github.com/louai60/e-commerce_project/backend/product-service/feeds"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"github.com/louai60/e-commerce_project/backend/product-service/storage"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// feedPageSize is the number of products read per page while building a feed
+const feedPageSize = 100
+
+// inventoryStatusDiscontinued mirrors the inventory service item status
+const inventoryStatusDiscontinued = "DISCONTINUED"
+
+// FeedServiceOptions configures feed storage and the signed feed links
+type FeedServiceOptions struct {
+	// SigningKey signs feed links; links cannot be created without it
+	SigningKey string
+	BaseURL    string
+	LinkTTL    time.Duration
+	// StorefrontURL is used for product links of stores without a domain
+	StorefrontURL string
+}
+
+// FeedService builds marketplace feeds from the products published to the
+// marketplace channel and serves them through signed links
+type FeedService struct {
+	feedRepo       repository.FeedRepository
+	storeRepo      repository.StoreRepository
+	productService *ProductService
+	store          storage.Storage
+	options        FeedServiceOptions
+	logger         *zap.Logger
+}
+
+// NewFeedService creates a new marketplace feed service
+func NewFeedService(
+	feedRepo repository.FeedRepository,
+	storeRepo repository.StoreRepository,
+	productService *ProductService,
+	store storage.Storage,
+	options FeedServiceOptions,
+	logger *zap.Logger,
+) *FeedService {
+	if options.LinkTTL <= 0 {
+		options.LinkTTL = 30 * 24 * time.Hour
+	}
+
+	return &FeedService{
+		feedRepo:       feedRepo,
+		storeRepo:      storeRepo,
+		productService: productService,
+		store:          store,
+		options:        options,
+		logger:         logger,
+	}
+}
+
+// GenerateFeeds rebuilds every feed format of the store in ctx and returns
+// the new feeds
+func (s *FeedService) GenerateFeeds(ctx context.Context) ([]*models.ProductFeed, error) {
+	tenantID := tenant.FromContext(ctx)
+
+	shop, err := s.storeRepo.GetStore(ctx, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	siteURL := strings.TrimSuffix(s.options.StorefrontURL, "/")
+	if shop.Domain != nil && *shop.Domain != "" {
+		siteURL = "https://" + *shop.Domain
+	}
+
+	items, err := s.collectFeedItems(ctx, siteURL, shop.DefaultCurrency)
+	if err != nil {
+		return nil, err
+	}
+
+	generated := make([]*models.ProductFeed, 0, len(feeds.Formats))
+	for _, format := range feeds.Formats {
+		var buf bytes.Buffer
+		if err := feeds.Write(&buf, format, shop.Name, siteURL, items); err != nil {
+			return nil, err
+		}
+
+		result, err := s.store.Upload(buf.Bytes(), tenantID, feeds.FileName(format))
+		if err != nil {
+			return nil, err
+		}
+
+		feed := &models.ProductFeed{
+			TenantID:     tenantID,
+			Format:       format,
+			StorageKey:   result.PublicID,
+			ProductCount: len(items),
+			SizeBytes:    int64(buf.Len()),
+			GeneratedAt:  time.Now().UTC(),
+		}
+		previous, err := s.feedRepo.UpsertProductFeed(ctx, feed)
+		if err != nil {
+			if deleteErr := s.store.Delete(result.PublicID); deleteErr != nil {
+				s.logger.Warn("Failed to remove stored file of failed feed", zap.Error(deleteErr))
+			}
+			return nil, err
+		}
+		if previous != "" && previous != feed.StorageKey {
+			if err := s.store.Delete(previous); err != nil {
+				s.logger.Warn("Failed to remove replaced feed file",
+					zap.String("storage_key", previous),
+					zap.Error(err))
+			}
+		}
+
+		generated = append(generated, feed)
+	}
+
+	s.logger.Info("Product feeds generated",
+		zap.String("tenant_id", tenantID),
+		zap.Int("product_count", len(items)))
+
+	return generated, nil
+}
+
+// collectFeedItems reads every published product visible in the marketplace
+// channel of the store in ctx
+func (s *FeedService) collectFeedItems(ctx context.Context, siteURL, defaultCurrency string) ([]feeds.Item, error) {
+	var items []feeds.Item
+	for offset := 0; ; offset += feedPageSize {
+		products, total, err := s.productService.productRepo.List(ctx, offset, feedPageSize, models.ChannelMarketplace)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, listed := range products {
+			product, err := s.productService.productRepo.GetByID(ctx, listed.ID)
+			if err != nil {
+				s.logger.Warn("Skipping product missing from feed", zap.String("product_id", listed.ID), zap.Error(err))
+				continue
+			}
+			if !product.IsPublished {
+				continue
+			}
+			if err := s.productService.populateProductRelations(ctx, product); err != nil {
+				s.logger.Warn("Failed to populate feed product relations", zap.String("product_id", product.ID), zap.Error(err))
+			}
+
+			availability, ok := s.feedAvailability(ctx, product)
+			if !ok {
+				continue
+			}
+			items = append(items, newFeedItem(product, siteURL, defaultCurrency, availability))
+		}
+
+		if len(products) < feedPageSize || offset+feedPageSize >= total {
+			break
+		}
+	}
+
+	return items, nil
+}
+
+// feedAvailability returns the marketplace availability of a product from the
+// inventory service. Discontinued products are left out of feeds.
+func (s *FeedService) feedAvailability(ctx context.Context, product *models.Product) (string, bool) {
+	// Digital products are never out of stock
+	if !product.RequiresShipping() {
+		return feeds.AvailabilityInStock, true
+	}
+	if s.productService.inventoryClient == nil {
+		return feeds.AvailabilityOutOfStock, true
+	}
+
+	item, err := s.productService.inventoryClient.GetInventoryItem(ctx, product.ID)
+	if err != nil {
+		// Advertising stock we cannot confirm would oversell
+		return feeds.AvailabilityOutOfStock, true
+	}
+
+	if item.Status == inventoryStatusDiscontinued {
+		return "", false
+	}
+	switch item.Availability {
+	case availability.InStock, availability.LowStock:
+		return feeds.AvailabilityInStock, true
+	case availability.Backorder:
+		return feeds.AvailabilityBackorder, true
+	}
+	return feeds.AvailabilityOutOfStock, true
+}
+
+func newFeedItem(product *models.Product, siteURL, defaultCurrency, availability string) feeds.Item {
+	item := feeds.Item{
+		ID:           product.ID,
+		Title:        product.Title,
+		Description:  product.Description,
+		Link:         siteURL + "/products/" + product.Slug,
+		SKU:          product.SKU,
+		Availability: availability,
+		Price:        product.Price.Amount,
+		Currency:     product.Price.Currency,
+	}
+	if item.Description == "" {
+		item.Description = product.ShortDescription
+	}
+	if item.Currency == "" {
+		item.Currency = defaultCurrency
+	}
+	if product.Brand != nil {
+		item.Brand = product.Brand.Name
+	}
+	if product.DiscountPrice != nil && product.DiscountPrice.Amount > 0 && product.DiscountPrice.Amount < item.Price {
+		item.SalePrice = product.DiscountPrice.Amount
+	}
+
+	images := append([]models.ProductImage(nil), product.Images...)
+	sort.SliceStable(images, func(i, j int) bool { return images[i].Position < images[j].Position })
+	for i, image := range images {
+		link := image.URL
+		// Locally stored images have relative URLs
+		if strings.HasPrefix(link, "/") {
+			link = siteURL + link
+		}
+		if i == 0 {
+			item.ImageLink = link
+		} else {
+			item.AdditionalImageLinks = append(item.AdditionalImageLinks, link)
+		}
+	}
+
+	return item
+}
+
+// StartFeedScheduler regenerates the feeds of every active store at the given
+// interval until the context is cancelled
+func (s *FeedService) StartFeedScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Product feed scheduler stopped")
+				return
+			case <-ticker.C:
+				s.generateAllFeeds(ctx)
+			}
+		}
+	}()
+}
+
+func (s *FeedService) generateAllFeeds(ctx context.Context) {
+	stores, err := s.storeRepo.ListStores(ctx)
+	if err != nil {
+		s.logger.Error("Scheduled feed generation failed", zap.Error(err))
+		return
+	}
+
+	for _, shop := range stores {
+		if !shop.IsActive {
+			continue
+		}
+		if _, err := s.GenerateFeeds(tenant.WithTenant(ctx, shop.ID)); err != nil {
+			s.logger.Error("Scheduled feed generation failed", zap.String("tenant_id", shop.ID), zap.Error(err))
+		}
+	}
+}
+
+// ListProductFeeds returns the feeds of the store in ctx with signed links
+func (s *FeedService) ListProductFeeds(ctx context.Context, _ *pb.ListProductFeedsRequest) (*pb.ListProductFeedsResponse, error) {
+	list, err := s.feedRepo.ListProductFeeds(ctx, tenant.FromContext(ctx))
+	if err != nil {
+		return nil, s.feedError("Failed to list product feeds", err)
+	}
+	return s.feedsResponse(list), nil
+}
+
+// GenerateProductFeeds rebuilds the feeds of the store in ctx right away
+func (s *FeedService) GenerateProductFeeds(ctx context.Context, _ *pb.GenerateProductFeedsRequest) (*pb.ListProductFeedsResponse, error) {
+	generated, err := s.GenerateFeeds(ctx)
+	if err != nil {
+		return nil, s.feedError("Failed to generate product feeds", err)
+	}
+	return s.feedsResponse(generated), nil
+}
+
+// DownloadProductFeed verifies a feed token and streams the feed in chunks
+func (s *FeedService) DownloadProductFeed(req *pb.DownloadProductFeedRequest, stream grpc.ServerStreamingServer[pb.ProductFeedChunk]) error {
+	ctx := stream.Context()
+
+	if s.options.SigningKey == "" {
+		return status.Error(codes.FailedPrecondition, "product feeds are not configured")
+	}
+
+	claims, err := downloadtoken.Verify([]byte(s.options.SigningKey), req.Token, time.Now())
+	if err != nil {
+		if errors.Is(err, downloadtoken.ErrExpired) {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	// The token names the store, which may differ from the host it is fetched on
+	tenantID, format, ok := strings.Cut(claims.GrantID, ".")
+	if !ok || !feeds.IsValidFormat(format) {
+		return status.Error(codes.Unauthenticated, downloadtoken.ErrInvalid.Error())
+	}
+
+	feed, err := s.feedRepo.GetProductFeed(ctx, tenantID, format)
+	if err != nil {
+		return s.feedError("Failed to get product feed", err)
+	}
+
+	file, err := s.store.Open(feed.StorageKey)
+	if err != nil {
+		s.logger.Error("Failed to open product feed", zap.Error(err), zap.String("storage_key", feed.StorageKey))
+		return status.Error(codes.Internal, "Failed to open product feed")
+	}
+	defer file.Close()
+
+	chunk := &pb.ProductFeedChunk{
+		FileName:    feeds.FileName(format),
+		ContentType: feeds.ContentType(format),
+		SizeBytes:   feed.SizeBytes,
+	}
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			chunk.Data = buf[:n]
+			if err := stream.Send(chunk); err != nil {
+				return err
+			}
+			chunk = &pb.ProductFeedChunk{}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			s.logger.Error("Failed to read product feed", zap.Error(err), zap.String("storage_key", feed.StorageKey))
+			return status.Error(codes.Internal, "Failed to read product feed")
+		}
+	}
+
+	if chunk.FileName != "" {
+		return stream.Send(chunk)
+	}
+	return nil
+}
+
+func (s *FeedService) feedsResponse(list []*models.ProductFeed) *pb.ListProductFeedsResponse {
+	resp := &pb.ListProductFeedsResponse{Feeds: make([]*pb.ProductFeed, len(list))}
+	for i, feed := range list {
+		resp.Feeds[i] = s.convertFeedModelToProto(feed)
+	}
+	return resp
+}
+
+// convertFeedModelToProto converts a feed and signs a fresh link to it. The
+// link is left empty when feed links are not configured.
+func (s *FeedService) convertFeedModelToProto(feed *models.ProductFeed) *pb.ProductFeed {
+	proto := &pb.ProductFeed{
+		Format:       feed.Format,
+		ProductCount: int32(feed.ProductCount),
+		SizeBytes:    feed.SizeBytes,
+		GeneratedAt:  timestamppb.New(feed.GeneratedAt),
+	}
+
+	if s.options.SigningKey != "" {
+		expiresAt := time.Now().Add(s.options.LinkTTL).Truncate(time.Second)
+		token := downloadtoken.Sign([]byte(s.options.SigningKey), downloadtoken.Claims{
+			GrantID:   feed.TenantID + "." + feed.Format,
+			ExpiresAt: expiresAt,
+		})
+		proto.Url = strings.TrimSuffix(s.options.BaseURL, "/") + "/" + token
+		proto.UrlExpiresAt = timestamppb.New(expiresAt)
+	}
+
+	return proto
+}
+
+// feedError logs a repository error and maps it to a gRPC status
+func (s *FeedService) feedError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrProductFeedNotFound):
+		return status.Error(codes.NotFound, "product feed has not been generated yet")
+	case errors.Is(err, models.ErrStoreNotFound):
+		return status.Error(codes.NotFound, "store not found")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
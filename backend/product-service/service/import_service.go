@@ -0,0 +1,238 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/catalogimport"
+	"github.com/louai60/e-commerce_project/backend/product-service/events"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxImportRows bounds the rows of one supplier catalog file
+const maxImportRows = 50000
+
+// ImportService imports supplier catalog files with the mapping templates
+// saved for each supplier
+type ImportService struct {
+	templateRepo   repository.ImportTemplateRepository
+	importRepo     repository.ImportRepository
+	productService *ProductService
+	logger         *zap.Logger
+}
+
+// NewImportService creates a new import service
+func NewImportService(templateRepo repository.ImportTemplateRepository, importRepo repository.ImportRepository, productService *ProductService, logger *zap.Logger) *ImportService {
+	return &ImportService{
+		templateRepo:   templateRepo,
+		importRepo:     importRepo,
+		productService: productService,
+		logger:         logger,
+	}
+}
+
+// SaveImportTemplate creates or replaces the import template of a supplier
+func (s *ImportService) SaveImportTemplate(ctx context.Context, req *pb.SaveImportTemplateRequest) (*pb.ImportTemplate, error) {
+	if req.Template == nil {
+		return nil, status.Error(codes.InvalidArgument, "template is required")
+	}
+	template := &models.ImportTemplate{
+		Supplier:        normalizeSupplier(req.Template.Supplier),
+		ColumnMappings:  make(map[string]string, len(req.Template.ColumnMappings)),
+		PriceMultiplier: req.Template.PriceMultiplier,
+		Publish:         req.Template.Publish,
+	}
+	if template.Supplier == "" {
+		return nil, status.Error(codes.InvalidArgument, "supplier is required")
+	}
+	if template.PriceMultiplier == 0 {
+		template.PriceMultiplier = 1
+	}
+	if template.PriceMultiplier < 0 {
+		return nil, status.Error(codes.InvalidArgument, "price_multiplier must be positive")
+	}
+
+	mapped := make(map[string]string)
+	for column, field := range req.Template.ColumnMappings {
+		column, field = strings.TrimSpace(column), strings.TrimSpace(field)
+		if column == "" {
+			return nil, status.Error(codes.InvalidArgument, "column names cannot be empty")
+		}
+		if !models.IsImportField(field) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown product field %q for column %q", field, column)
+		}
+		if other, ok := mapped[field]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "columns %q and %q both map to %s", other, column, field)
+		}
+		mapped[field] = column
+		template.ColumnMappings[column] = field
+	}
+	for _, field := range models.RequiredImportFields {
+		if _, ok := mapped[field]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "a column must map to %s", field)
+		}
+	}
+
+	if id := req.Template.DefaultBrandId; id != "" {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid default brand ID")
+		}
+		if _, err := s.productService.brandRepo.GetBrandByID(ctx, id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "default brand not found")
+		}
+		template.DefaultBrandID = &id
+	}
+	if id := req.Template.DefaultCategoryId; id != "" {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid default category ID")
+		}
+		if _, err := s.productService.categoryRepo.GetCategoryByID(ctx, id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "default category not found")
+		}
+		template.DefaultCategoryID = &id
+	}
+
+	if err := s.templateRepo.SaveTemplate(ctx, template); err != nil {
+		return nil, s.importError("Failed to save import template", err)
+	}
+
+	s.logger.Info("Import template saved", zap.String("supplier", template.Supplier))
+	return importTemplateToProto(template), nil
+}
+
+// GetImportTemplate returns the import template of a supplier
+func (s *ImportService) GetImportTemplate(ctx context.Context, req *pb.GetImportTemplateRequest) (*pb.ImportTemplate, error) {
+	template, err := s.templateRepo.GetTemplate(ctx, normalizeSupplier(req.Supplier))
+	if err != nil {
+		return nil, s.importError("Failed to get import template", err)
+	}
+	return importTemplateToProto(template), nil
+}
+
+// ListImportTemplates returns the import templates of the store by supplier
+func (s *ImportService) ListImportTemplates(ctx context.Context, req *pb.ListImportTemplatesRequest) (*pb.ListImportTemplatesResponse, error) {
+	templates, err := s.templateRepo.ListTemplates(ctx)
+	if err != nil {
+		return nil, s.importError("Failed to list import templates", err)
+	}
+
+	resp := &pb.ListImportTemplatesResponse{Templates: make([]*pb.ImportTemplate, len(templates))}
+	for i, template := range templates {
+		resp.Templates[i] = importTemplateToProto(template)
+	}
+	return resp, nil
+}
+
+// DeleteImportTemplate removes the import template of a supplier
+func (s *ImportService) DeleteImportTemplate(ctx context.Context, req *pb.DeleteImportTemplateRequest) (*pb.DeleteImportTemplateResponse, error) {
+	if err := s.templateRepo.DeleteTemplate(ctx, normalizeSupplier(req.Supplier)); err != nil {
+		return nil, s.importError("Failed to delete import template", err)
+	}
+	return &pb.DeleteImportTemplateResponse{Success: true}, nil
+}
+
+// ImportSupplierCatalog imports a catalog file of a supplier with its saved
+// template. Rows that cannot be mapped are skipped and reported; the valid
+// rows are stored together, so a duplicate slug or SKU imports none of them.
+func (s *ImportService) ImportSupplierCatalog(ctx context.Context, req *pb.ImportSupplierCatalogRequest) (*pb.ImportSupplierCatalogResponse, error) {
+	supplier := normalizeSupplier(req.Supplier)
+	template, err := s.templateRepo.GetTemplate(ctx, supplier)
+	if err != nil {
+		if errors.Is(err, models.ErrImportTemplateNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no import template for supplier %s", supplier)
+		}
+		return nil, s.importError("Failed to get import template", err)
+	}
+
+	result, err := catalogimport.Map(template, bytes.NewReader(req.CsvData), maxImportRows)
+	if err != nil {
+		if errors.Is(err, catalogimport.ErrTooManyRows) {
+			return nil, status.Errorf(codes.InvalidArgument, "catalog files can have at most %d rows", maxImportRows)
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	imported, err := s.importRepo.CopyProducts(ctx, result.Products)
+	if err != nil {
+		if errors.Is(err, models.ErrProductAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, "a product of the file has the slug or SKU of an existing product; nothing was imported")
+		}
+		return nil, s.importError("Failed to import products", err)
+	}
+
+	productIDs := make([]string, len(result.Products))
+	for i, product := range result.Products {
+		productIDs[i] = product.ID
+	}
+	if template.DefaultCategoryID != nil {
+		if err := s.importRepo.CopyProductCategories(ctx, *template.DefaultCategoryID, productIDs); err != nil {
+			s.logger.Error("Imported products were not added to the default category",
+				zap.String("supplier", supplier), zap.Error(err))
+		}
+	}
+
+	if imported > 0 {
+		if err := s.productService.cacheManager.InvalidateProductLists(ctx); err != nil {
+			s.logger.Warn("Failed to invalidate product list cache", zap.Error(err))
+		}
+		for _, id := range productIDs {
+			s.productService.publishProductEvent(ctx, events.ProductCreated, id)
+		}
+	}
+
+	s.logger.Info("Supplier catalog imported",
+		zap.String("supplier", supplier),
+		zap.Int("imported", imported),
+		zap.Int("skipped", len(result.Errors)))
+
+	resp := &pb.ImportSupplierCatalogResponse{
+		Imported: int32(imported),
+		Skipped:  int32(len(result.Errors)),
+		Errors:   make([]*pb.ImportRowError, len(result.Errors)),
+	}
+	for i, rowErr := range result.Errors {
+		resp.Errors[i] = &pb.ImportRowError{Line: int32(rowErr.Line), Error: rowErr.Error}
+	}
+	return resp, nil
+}
+
+func (s *ImportService) importError(message string, err error) error {
+	if errors.Is(err, models.ErrImportTemplateNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// normalizeSupplier makes supplier names case insensitive
+func normalizeSupplier(supplier string) string {
+	return strings.ToLower(strings.TrimSpace(supplier))
+}
+
+func importTemplateToProto(template *models.ImportTemplate) *pb.ImportTemplate {
+	resp := &pb.ImportTemplate{
+		Id:              template.ID,
+		Supplier:        template.Supplier,
+		ColumnMappings:  template.ColumnMappings,
+		PriceMultiplier: template.PriceMultiplier,
+		Publish:         template.Publish,
+		CreatedAt:       timestamppb.New(template.CreatedAt),
+		UpdatedAt:       timestamppb.New(template.UpdatedAt),
+	}
+	if template.DefaultBrandID != nil {
+		resp.DefaultBrandId = *template.DefaultBrandID
+	}
+	if template.DefaultCategoryID != nil {
+		resp.DefaultCategoryId = *template.DefaultCategoryID
+	}
+	return resp
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/mediaupload"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"github.com/louai60/e-commerce_project/backend/product-service/productmedia"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// MediaUploadOptions configures direct media uploads. MaxBytes and Formats
+// apply to images; videos and 3D models have rules of their own.
+type MediaUploadOptions struct {
+	Folder    string
+	UploadTTL time.Duration
+	MaxBytes  int64
+	Formats   []string
+	Video     mediaupload.Rules
+	Model3D   mediaupload.Rules
+}
+
+// MediaUploadService hands out tickets for uploading product media straight
+// to the media provider, so that the bytes do not go through the gateway and
+// the product service, and attaches the uploaded assets to their product once
+// they pass validation
+type MediaUploadService struct {
+	uploadRepo     repository.MediaUploadRepository
+	productService *ProductService
+	options        MediaUploadOptions
+	logger         *zap.Logger
+}
+
+// NewMediaUploadService creates a new media upload service
+func NewMediaUploadService(uploadRepo repository.MediaUploadRepository, productService *ProductService, options MediaUploadOptions, logger *zap.Logger) *MediaUploadService {
+	if options.Folder == "" {
+		options.Folder = "products"
+	}
+	if options.UploadTTL <= 0 {
+		options.UploadTTL = 15 * time.Minute
+	}
+	if options.MaxBytes <= 0 {
+		options.MaxBytes = mediaupload.DefaultMaxBytes
+	}
+	options.Formats = mediaupload.NormalizeFormats(options.Formats)
+	if options.Video.MaxBytes <= 0 {
+		options.Video.MaxBytes = productmedia.DefaultVideoMaxBytes
+	}
+	options.Video.Formats = mediaupload.NormalizeFormatsOr(options.Video.Formats, productmedia.DefaultVideoFormats)
+	if options.Model3D.MaxBytes <= 0 {
+		options.Model3D.MaxBytes = productmedia.DefaultModelMaxBytes
+	}
+	options.Model3D.Formats = mediaupload.NormalizeFormatsOr(options.Model3D.Formats, productmedia.DefaultModelFormats)
+
+	return &MediaUploadService{
+		uploadRepo:     uploadRepo,
+		productService: productService,
+		options:        options,
+		logger:         logger,
+	}
+}
+
+// provider returns the media provider uploads go to, nil when Cloudinary is
+// not configured
+func (s *MediaUploadService) provider() mediaupload.Provider {
+	if s.productService.cld == nil {
+		return nil
+	}
+	return mediaupload.NewCloudinary(s.productService.cld)
+}
+
+// rules returns the checks uploaded files of mediaType must pass
+func (s *MediaUploadService) rules(mediaType string) mediaupload.Rules {
+	switch mediaType {
+	case productmedia.TypeVideo:
+		return s.options.Video
+	case productmedia.TypeModel3D:
+		return s.options.Model3D
+	}
+	return mediaupload.Rules{MaxBytes: s.options.MaxBytes, Formats: s.options.Formats}
+}
+
+// CreateMediaUpload returns a ticket for uploading an image, video or 3D
+// model of a product straight to the media provider
+func (s *MediaUploadService) CreateMediaUpload(ctx context.Context, req *pb.CreateMediaUploadRequest) (*pb.MediaUpload, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	mediaType := req.MediaType
+	if mediaType == "" {
+		mediaType = productmedia.TypeImage
+	}
+	if !productmedia.IsValidType(mediaType) {
+		return nil, status.Error(codes.InvalidArgument, "media type must be image, video or model_3d")
+	}
+	provider := s.provider()
+	if provider == nil {
+		return nil, status.Error(codes.FailedPrecondition, "direct media uploads need Cloudinary to be configured")
+	}
+	if _, err := s.productService.productRepo.GetByID(ctx, req.ProductId); err != nil {
+		return nil, status.Error(codes.NotFound, "product not found")
+	}
+
+	now := time.Now().UTC()
+	upload := &models.MediaUpload{
+		ID:        uuid.NewString(),
+		ProductID: req.ProductId,
+		MediaType: mediaType,
+	}
+	upload.PublicID = mediaupload.PublicID(s.options.Folder, upload.ProductID, upload.ID)
+
+	rules := s.rules(mediaType)
+	ticket, err := provider.Sign(upload.PublicID, productmedia.ResourceType(mediaType), rules.Formats, now)
+	if err != nil {
+		s.logger.Error("Failed to sign media upload", zap.Error(err), zap.String("product_id", req.ProductId))
+		return nil, status.Error(codes.Internal, "failed to sign media upload")
+	}
+	// The ticket cannot outlive the signature of the provider
+	upload.ExpiresAt = now.Add(s.options.UploadTTL)
+	if ticket.ExpiresAt.Before(upload.ExpiresAt) {
+		upload.ExpiresAt = ticket.ExpiresAt
+	}
+
+	if err := s.uploadRepo.CreateMediaUpload(ctx, upload); err != nil {
+		return nil, s.mediaUploadError("Failed to create media upload", err)
+	}
+
+	s.logger.Info("Media upload created",
+		zap.String("upload_id", upload.ID),
+		zap.String("product_id", upload.ProductID),
+		zap.String("media_type", upload.MediaType))
+
+	return &pb.MediaUpload{
+		Id:        upload.ID,
+		ProductId: upload.ProductID,
+		UploadUrl: ticket.URL,
+		Fields:    ticket.Fields,
+		ExpiresAt: timestamppb.New(upload.ExpiresAt),
+		MaxBytes:  rules.MaxBytes,
+		Formats:   rules.Formats,
+		MediaType: upload.MediaType,
+	}, nil
+}
+
+// ConfirmMediaUpload checks the asset uploaded with a ticket and attaches it
+// to the product, as an image or as media processed for their thumbnail.
+// Assets failing validation are deleted from the provider.
+func (s *MediaUploadService) ConfirmMediaUpload(ctx context.Context, req *pb.ConfirmMediaUploadRequest) (*pb.ConfirmMediaUploadResponse, error) {
+	if _, err := uuid.Parse(req.UploadId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid upload ID")
+	}
+	provider := s.provider()
+	if provider == nil {
+		return nil, status.Error(codes.FailedPrecondition, "direct media uploads need Cloudinary to be configured")
+	}
+
+	upload, err := s.uploadRepo.GetMediaUpload(ctx, req.UploadId)
+	if err != nil {
+		return nil, s.mediaUploadError("Failed to get media upload", err)
+	}
+	if upload.ProductID != req.ProductId {
+		return nil, status.Error(codes.NotFound, models.ErrMediaUploadNotFound.Error())
+	}
+	if upload.Status != models.MediaUploadPending {
+		return nil, status.Error(codes.FailedPrecondition, models.ErrMediaUploadConfirmed.Error())
+	}
+
+	resourceType := productmedia.ResourceType(upload.MediaType)
+	asset, err := provider.Inspect(ctx, resourceType, upload.PublicID)
+	if errors.Is(err, mediaupload.ErrAssetNotFound) {
+		if time.Now().After(upload.ExpiresAt) {
+			return nil, status.Error(codes.FailedPrecondition, "the media upload expired")
+		}
+		return nil, status.Error(codes.FailedPrecondition, "no file was uploaded yet")
+	}
+	if err != nil {
+		s.logger.Error("Failed to inspect uploaded asset", zap.Error(err), zap.String("upload_id", upload.ID))
+		return nil, status.Error(codes.Unavailable, "failed to inspect uploaded file")
+	}
+
+	if err := mediaupload.Validate(asset, upload.ExpiresAt, s.rules(upload.MediaType)); err != nil {
+		if err := provider.Delete(ctx, resourceType, upload.PublicID); err != nil {
+			s.logger.Warn("Failed to delete invalid uploaded asset", zap.Error(err), zap.String("public_id", upload.PublicID))
+		}
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if upload.MediaType != productmedia.TypeImage {
+		return s.confirmMedia(ctx, upload, asset, req)
+	}
+
+	image := &models.ProductImage{
+		URL:      asset.URL,
+		AltText:  req.AltText,
+		Position: int(req.Position),
+	}
+	if err := s.uploadRepo.ConfirmMediaUpload(ctx, upload, image); err != nil {
+		return nil, s.mediaUploadError("Failed to confirm media upload", err)
+	}
+
+	if err := s.productService.cacheManager.InvalidateProduct(ctx, upload.ProductID); err != nil {
+		s.logger.Warn("Failed to invalidate product cache after media upload",
+			zap.String("product_id", upload.ProductID),
+			zap.Error(err))
+	}
+
+	s.logger.Info("Media upload confirmed",
+		zap.String("upload_id", upload.ID),
+		zap.String("product_id", upload.ProductID),
+		zap.String("image_id", image.ID),
+		zap.Int64("bytes", asset.Bytes))
+
+	return &pb.ConfirmMediaUploadResponse{Image: convertImageModelToProto(image)}, nil
+}
+
+// confirmMedia attaches an uploaded video or 3D model to the product of
+// upload, leaving it processing until its thumbnail is extracted
+func (s *MediaUploadService) confirmMedia(ctx context.Context, upload *models.MediaUpload, asset *mediaupload.Asset, req *pb.ConfirmMediaUploadRequest) (*pb.ConfirmMediaUploadResponse, error) {
+	media := &productmedia.Media{
+		Type:       upload.MediaType,
+		Provider:   productmedia.ProviderCloudinary,
+		URL:        asset.URL,
+		Format:     strings.ToLower(asset.Format),
+		AltText:    req.AltText,
+		Position:   int(req.Position),
+		ExternalID: upload.PublicID,
+	}
+	if err := s.uploadRepo.ConfirmMediaUploadMedia(ctx, upload, media); err != nil {
+		return nil, s.mediaUploadError("Failed to confirm media upload", err)
+	}
+
+	if err := s.productService.cacheManager.InvalidateProduct(ctx, upload.ProductID); err != nil {
+		s.logger.Warn("Failed to invalidate product cache after media upload",
+			zap.String("product_id", upload.ProductID),
+			zap.Error(err))
+	}
+
+	s.logger.Info("Media upload confirmed",
+		zap.String("upload_id", upload.ID),
+		zap.String("product_id", upload.ProductID),
+		zap.String("media_id", media.ID),
+		zap.String("media_type", media.Type),
+		zap.Int64("bytes", asset.Bytes))
+
+	return &pb.ConfirmMediaUploadResponse{Media: convertProductMediaToProto(media)}, nil
+}
+
+func (s *MediaUploadService) mediaUploadError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, models.ErrMediaUploadNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrMediaUploadConfirmed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"github.com/louai60/e-commerce_project/backend/product-service/personalization"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PersonalizationService handles the personalization options of products,
+// such as an engraving text or uploaded artwork, and checks the values buyers
+// give for them before they are added to a cart or an order
+type PersonalizationService struct {
+	personalizationRepo repository.PersonalizationRepository
+	productService      *ProductService
+	logger              *zap.Logger
+}
+
+// NewPersonalizationService creates a new personalization service
+func NewPersonalizationService(personalizationRepo repository.PersonalizationRepository, productService *ProductService, logger *zap.Logger) *PersonalizationService {
+	return &PersonalizationService{
+		personalizationRepo: personalizationRepo,
+		productService:      productService,
+		logger:              logger,
+	}
+}
+
+// SetPersonalizationOptions replaces the personalization options of a
+// product. Options keeping their ID keep the values buyers already gave for
+// them in their carts.
+func (s *PersonalizationService) SetPersonalizationOptions(ctx context.Context, req *pb.SetPersonalizationOptionsRequest) (*pb.PersonalizationOptionsResponse, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	options := make([]personalization.Option, len(req.Options))
+	for i, option := range req.Options {
+		if option.Id != "" {
+			if _, err := uuid.Parse(option.Id); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid option ID %q", option.Id)
+			}
+		}
+		options[i] = convertPersonalizationOptionProtoToModel(option)
+	}
+	options, err := personalization.NormalizeOptions(options)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.personalizationRepo.ReplacePersonalizationOptions(ctx, req.ProductId, options); err != nil {
+		return nil, s.personalizationError("Failed to save personalization options", err)
+	}
+
+	// The cached product does not know about its options yet
+	if err := s.productService.cacheManager.InvalidateProduct(ctx, req.ProductId); err != nil {
+		s.logger.Warn("Failed to invalidate personalized product cache",
+			zap.String("product_id", req.ProductId),
+			zap.Error(err))
+	}
+
+	s.logger.Info("Personalization options saved",
+		zap.String("product_id", req.ProductId),
+		zap.Int("options", len(options)))
+
+	return &pb.PersonalizationOptionsResponse{Options: convertPersonalizationOptionsToProtos(options)}, nil
+}
+
+// ValidatePersonalization checks the values a buyer gave to personalize a
+// product and returns the values to store with their cart or order line
+func (s *PersonalizationService) ValidatePersonalization(ctx context.Context, req *pb.ValidatePersonalizationRequest) (*pb.ValidatePersonalizationResponse, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	options, err := s.personalizationRepo.ListPersonalizationOptions(ctx, req.ProductId)
+	if err != nil {
+		return nil, s.personalizationError("Failed to load personalization options", err)
+	}
+
+	values := make([]personalization.Value, len(req.Values))
+	for i, value := range req.Values {
+		values[i] = personalization.Value{
+			OptionID: value.OptionId,
+			Text:     value.Text,
+			FileURL:  value.FileUrl,
+			FileName: value.FileName,
+		}
+	}
+	values, err = personalization.Validate(options, values)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &pb.ValidatePersonalizationResponse{Values: make([]*pb.PersonalizationValue, len(values))}
+	for i, value := range values {
+		resp.Values[i] = &pb.PersonalizationValue{
+			OptionId: value.OptionID,
+			Name:     value.Name,
+			Text:     value.Text,
+			FileUrl:  value.FileURL,
+			FileName: value.FileName,
+		}
+	}
+	return resp, nil
+}
+
+func (s *PersonalizationService) personalizationError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, models.ErrPersonalizationOptionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertPersonalizationOptionProtoToModel(option *pb.PersonalizationOption) personalization.Option {
+	return personalization.Option{
+		ID:        option.Id,
+		Name:      option.Name,
+		Type:      option.Type,
+		Required:  option.Required,
+		MaxLength: int(option.MaxLength),
+		FileTypes: option.FileTypes,
+	}
+}
+
+func convertPersonalizationOptionsToProtos(options []personalization.Option) []*pb.PersonalizationOption {
+	if len(options) == 0 {
+		return nil
+	}
+	protos := make([]*pb.PersonalizationOption, len(options))
+	for i, option := range options {
+		protos[i] = &pb.PersonalizationOption{
+			Id:        option.ID,
+			Name:      option.Name,
+			Type:      option.Type,
+			Required:  option.Required,
+			MaxLength: int32(option.MaxLength),
+			FileTypes: option.FileTypes,
+		}
+	}
+	return protos
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	pricingBackfillPageSize    = 500
+	pricingBackfillSampleLimit = 20
+)
+
+// PricingBackfillService repairs the products whose price and SKU are not
+// derived from a live default variant, such as products written by older
+// releases or directly in the database
+type PricingBackfillService struct {
+	backfillRepo repository.PricingBackfillRepository
+	logger       *zap.Logger
+
+	// running prevents overlapping runs of the scheduler
+	running sync.Mutex
+}
+
+// NewPricingBackfillService creates a new pricing backfill service
+func NewPricingBackfillService(backfillRepo repository.PricingBackfillRepository, logger *zap.Logger) *PricingBackfillService {
+	return &PricingBackfillService{
+		backfillRepo: backfillRepo,
+		logger:       logger,
+	}
+}
+
+// Backfill finds the drifted products of all stores and, unless dryRun,
+// gives each one a default variant and derives its price and SKU from it
+func (s *PricingBackfillService) Backfill(ctx context.Context, dryRun bool) (*models.PricingBackfill, error) {
+	if !s.running.TryLock() {
+		return nil, status.Error(codes.Aborted, "a pricing backfill is already running")
+	}
+	defer s.running.Unlock()
+
+	result := &models.PricingBackfill{StartedAt: time.Now().UTC()}
+
+	var after string
+	for {
+		drifts, err := s.backfillRepo.ListPricingDrift(ctx, after, pricingBackfillPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, drift := range drifts {
+			result.Drifted++
+			if len(result.Samples) < pricingBackfillSampleLimit {
+				result.Samples = append(result.Samples, drift)
+			}
+			if dryRun {
+				continue
+			}
+
+			variantID, err := s.backfillRepo.EnsureDefaultVariant(ctx, drift.ProductID)
+			if err != nil {
+				return nil, err
+			}
+			if variantID == "" {
+				result.Unrepaired++
+				s.logger.Warn("Product left without a default variant",
+					zap.String("product_id", drift.ProductID),
+					zap.String("tenant_id", drift.TenantID))
+				continue
+			}
+			result.Repaired++
+		}
+
+		if len(drifts) < pricingBackfillPageSize {
+			break
+		}
+		after = drifts[len(drifts)-1].ProductID
+	}
+
+	result.FinishedAt = time.Now().UTC()
+	s.logger.Info("Pricing backfill completed",
+		zap.Bool("dry_run", dryRun),
+		zap.Int("drifted", result.Drifted),
+		zap.Int("repaired", result.Repaired),
+		zap.Int("unrepaired", result.Unrepaired),
+		zap.Duration("duration", result.FinishedAt.Sub(result.StartedAt)))
+	return result, nil
+}
+
+// StartBackfillScheduler runs the pricing backfill once, then at the given
+// interval until the context is cancelled
+func (s *PricingBackfillService) StartBackfillScheduler(ctx context.Context, interval time.Duration, dryRun bool) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if _, err := s.Backfill(ctx, dryRun); err != nil {
+				s.logger.Error("Scheduled pricing backfill failed", zap.Error(err))
+			}
+
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Pricing backfill scheduler stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	priceBatchSize = 200
+	// maxPriceAdjustmentsReturned caps the per-product details in a response;
+	// the affected count always covers every product
+	maxPriceAdjustmentsReturned = 500
+)
+
+// PricingService adjusts product prices in bulk
+type PricingService struct {
+	pricingRepo    repository.PricingRepository
+	productService *ProductService
+	logger         *zap.Logger
+}
+
+// NewPricingService creates a new pricing service
+func NewPricingService(pricingRepo repository.PricingRepository, productService *ProductService, logger *zap.Logger) *PricingService {
+	return &PricingService{
+		pricingRepo:    pricingRepo,
+		productService: productService,
+		logger:         logger,
+	}
+}
+
+// BulkAdjustPrices previews or applies a percent or fixed price change to the
+// products matching the filter. Applying is all or nothing.
+func (s *PricingService) BulkAdjustPrices(ctx context.Context, req *pb.BulkAdjustPricesRequest) (*pb.BulkAdjustPricesResponse, error) {
+	var filter models.PriceFilter
+	if req.Filter != nil {
+		filter = models.PriceFilter{
+			BrandID:    req.Filter.BrandId,
+			CategoryID: req.Filter.CategoryId,
+			Tag:        req.Filter.Tag,
+		}
+	}
+	if filter.IsEmpty() {
+		return nil, status.Error(codes.InvalidArgument, "filter must set a brand, category or tag")
+	}
+	if req.Operation != models.PriceAdjustPercent && req.Operation != models.PriceAdjustFixed {
+		return nil, status.Error(codes.InvalidArgument, "operation must be percent or fixed")
+	}
+	if req.Amount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must not be zero")
+	}
+	adjustment := models.PriceAdjustment{Operation: req.Operation, Amount: req.Amount}
+
+	if !req.Apply {
+		changes, err := s.pricingRepo.ListPriceTargets(ctx, filter)
+		if err != nil {
+			return nil, s.pricingError("Failed to preview price adjustment", err)
+		}
+		for _, change := range changes {
+			if err := adjustment.Apply(change); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "%v for product %s", err, change.SKU)
+			}
+		}
+		return convertPriceChangesToProto(changes), nil
+	}
+
+	entry := models.PriceHistoryEntry{
+		Source:    models.PriceChangeSourceBulk,
+		BatchID:   uuid.New().String(),
+		Reason:    req.Reason,
+		ChangedBy: applogger.UserIDFromContext(ctx),
+	}
+	changes, err := s.pricingRepo.ApplyPriceAdjustment(ctx, filter, adjustment, priceBatchSize, entry)
+	if err != nil {
+		return nil, s.pricingError("Failed to apply price adjustment", err)
+	}
+
+	for _, change := range changes {
+		if err := s.productService.cacheManager.InvalidateProductAndRelated(ctx, change.ProductID); err != nil {
+			s.logger.Warn("Failed to invalidate repriced product cache",
+				zap.String("product_id", change.ProductID),
+				zap.Error(err))
+		}
+	}
+	if err := s.productService.cacheManager.InvalidateProductLists(ctx); err != nil {
+		s.logger.Warn("Failed to invalidate product lists after price adjustment", zap.Error(err))
+	}
+
+	s.logger.Info("Bulk price adjustment applied",
+		zap.String("batch_id", entry.BatchID),
+		zap.String("operation", adjustment.Operation),
+		zap.Float64("amount", adjustment.Amount),
+		zap.Int("products", len(changes)))
+
+	resp := convertPriceChangesToProto(changes)
+	resp.Applied = true
+	resp.BatchId = entry.BatchID
+	return resp, nil
+}
+
+func (s *PricingService) pricingError(message string, err error) error {
+	if errors.Is(err, models.ErrInvalidPriceAdjustment) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertPriceChangesToProto(changes []*models.PriceChange) *pb.BulkAdjustPricesResponse {
+	resp := &pb.BulkAdjustPricesResponse{Affected: int32(len(changes))}
+	for i, change := range changes {
+		if i == maxPriceAdjustmentsReturned {
+			break
+		}
+		adjustment := &pb.PriceAdjustment{
+			ProductId: change.ProductID,
+			Sku:       change.SKU,
+			Title:     change.Title,
+			OldPrice:  change.OldPrice,
+			NewPrice:  change.NewPrice,
+		}
+		if change.OldDiscountPrice != nil {
+			adjustment.OldDiscountPrice = wrapperspb.Double(*change.OldDiscountPrice)
+		}
+		if change.NewDiscountPrice != nil {
+			adjustment.NewDiscountPrice = wrapperspb.Double(*change.NewDiscountPrice)
+		}
+		resp.Adjustments = append(resp.Adjustments, adjustment)
+	}
+	return resp
+}
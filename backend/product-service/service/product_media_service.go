@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/mediaupload"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"github.com/louai60/e-commerce_project/backend/product-service/productmedia"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultMediaProcessingBatch = 50
+	// maxMediaProcessingAttempts bounds the retries of media whose thumbnail
+	// cannot be extracted, such as deleted Vimeo videos
+	maxMediaProcessingAttempts = 3
+)
+
+// ProductMediaOptions configures product videos and 3D models
+type ProductMediaOptions struct {
+	// VideoFormats are the formats of video files accepted from other sites
+	VideoFormats []string
+	// ProcessingBatch bounds the media processed per store and run
+	ProcessingBatch int
+}
+
+// ProductMediaService manages the videos and 3D models of products. New
+// media are processing until the processing pipeline extracted their
+// thumbnail, which runs on a schedule.
+type ProductMediaService struct {
+	mediaRepo      repository.ProductMediaRepository
+	storeRepo      repository.StoreRepository
+	productService *ProductService
+	thumbnailer    *productmedia.Thumbnailer
+	options        ProductMediaOptions
+	logger         *zap.Logger
+}
+
+// NewProductMediaService creates a new product media service
+func NewProductMediaService(
+	mediaRepo repository.ProductMediaRepository,
+	storeRepo repository.StoreRepository,
+	productService *ProductService,
+	options ProductMediaOptions,
+	logger *zap.Logger,
+) *ProductMediaService {
+	options.VideoFormats = mediaupload.NormalizeFormatsOr(options.VideoFormats, productmedia.DefaultVideoFormats)
+	if options.ProcessingBatch <= 0 {
+		options.ProcessingBatch = defaultMediaProcessingBatch
+	}
+
+	var cloudName string
+	if productService.cld != nil {
+		cloudName = productService.cld.Config.Cloud.CloudName
+	}
+
+	return &ProductMediaService{
+		mediaRepo:      mediaRepo,
+		storeRepo:      storeRepo,
+		productService: productService,
+		thumbnailer:    productmedia.NewThumbnailer(cloudName, nil),
+		options:        options,
+		logger:         logger,
+	}
+}
+
+// AddProductVideo adds a video hosted on YouTube or Vimeo, or served from
+// another site, to a product
+func (s *ProductMediaService) AddProductVideo(ctx context.Context, req *pb.AddProductVideoRequest) (*pb.ProductMedia, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	media, err := productmedia.ParseVideoURL(req.Url, s.options.VideoFormats)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	media.ProductID = req.ProductId
+	media.AltText = req.AltText
+	media.Position = int(req.Position)
+
+	if err := s.mediaRepo.CreateProductMedia(ctx, media); err != nil {
+		return nil, s.productMediaError("Failed to add product video", err)
+	}
+	s.invalidateProduct(ctx, media.ProductID)
+
+	s.logger.Info("Product video added",
+		zap.String("media_id", media.ID),
+		zap.String("product_id", media.ProductID),
+		zap.String("provider", media.Provider))
+
+	return convertProductMediaToProto(media), nil
+}
+
+// DeleteProductMedia removes a video or 3D model from a product
+func (s *ProductMediaService) DeleteProductMedia(ctx context.Context, req *pb.DeleteProductMediaRequest) (*pb.DeleteProductMediaResponse, error) {
+	if _, err := uuid.Parse(req.MediaId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid media ID")
+	}
+	if err := s.mediaRepo.DeleteProductMedia(ctx, req.ProductId, req.MediaId); err != nil {
+		return nil, s.productMediaError("Failed to delete product media", err)
+	}
+	s.invalidateProduct(ctx, req.ProductId)
+	return &pb.DeleteProductMediaResponse{Success: true}, nil
+}
+
+// ProcessMedia extracts the thumbnails of the processing media of the store
+// in ctx, returning how many media were processed
+func (s *ProductMediaService) ProcessMedia(ctx context.Context) (int, error) {
+	pending, err := s.mediaRepo.ListProcessingMedia(ctx, s.options.ProcessingBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for i := range pending {
+		media := &pending[i]
+		thumbnailURL, err := s.thumbnailer.Thumbnail(ctx, media)
+		if err != nil && !errors.Is(err, productmedia.ErrNoThumbnail) {
+			failed, failErr := s.mediaRepo.FailMediaProcessing(ctx, media.ID, maxMediaProcessingAttempts)
+			if failErr != nil {
+				s.logger.Error("Failed to record media processing failure", zap.Error(failErr), zap.String("media_id", media.ID))
+			}
+			s.logger.Warn("Failed to extract media thumbnail",
+				zap.String("media_id", media.ID),
+				zap.Bool("gave_up", failed),
+				zap.Error(err))
+			if failed {
+				s.invalidateProduct(ctx, media.ProductID)
+			}
+			continue
+		}
+
+		if err := s.mediaRepo.CompleteMediaProcessing(ctx, media.ID, thumbnailURL); err != nil {
+			s.logger.Error("Failed to complete media processing", zap.Error(err), zap.String("media_id", media.ID))
+			continue
+		}
+		s.invalidateProduct(ctx, media.ProductID)
+		processed++
+	}
+	return processed, nil
+}
+
+// StartMediaProcessingScheduler processes the media of every active store at
+// the given interval until the context is cancelled
+func (s *ProductMediaService) StartMediaProcessingScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Media processing scheduler stopped")
+				return
+			case <-ticker.C:
+				s.processAllStores(ctx)
+			}
+		}
+	}()
+}
+
+func (s *ProductMediaService) processAllStores(ctx context.Context) {
+	stores, err := s.storeRepo.ListStores(ctx)
+	if err != nil {
+		s.logger.Error("Scheduled media processing failed", zap.Error(err))
+		return
+	}
+
+	for _, shop := range stores {
+		if !shop.IsActive {
+			continue
+		}
+		count, err := s.ProcessMedia(tenant.WithTenant(ctx, shop.ID))
+		if err != nil {
+			s.logger.Error("Scheduled media processing failed", zap.String("tenant_id", shop.ID), zap.Error(err))
+		} else if count > 0 {
+			s.logger.Info("Processed product media", zap.String("tenant_id", shop.ID), zap.Int("count", count))
+		}
+	}
+}
+
+func (s *ProductMediaService) invalidateProduct(ctx context.Context, productID string) {
+	if err := s.productService.cacheManager.InvalidateProduct(ctx, productID); err != nil {
+		s.logger.Warn("Failed to invalidate product cache after media change",
+			zap.String("product_id", productID),
+			zap.Error(err))
+	}
+}
+
+func (s *ProductMediaService) productMediaError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, models.ErrProductMediaNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertProductMediaToProto(media *productmedia.Media) *pb.ProductMedia {
+	return &pb.ProductMedia{
+		Id:           media.ID,
+		ProductId:    media.ProductID,
+		Type:         media.Type,
+		Provider:     media.Provider,
+		Url:          media.URL,
+		EmbedUrl:     productmedia.EmbedURL(media),
+		Format:       media.Format,
+		ThumbnailUrl: media.ThumbnailURL,
+		AltText:      media.AltText,
+		Position:     int32(media.Position),
+		Status:       media.Status,
+		CreatedAt:    timestamppb.New(media.CreatedAt),
+		UpdatedAt:    timestamppb.New(media.UpdatedAt),
+	}
+}
+
+func convertProductMediaToProtos(media []productmedia.Media) []*pb.ProductMedia {
+	protos := make([]*pb.ProductMedia, len(media))
+	for i := range media {
+		protos[i] = convertProductMediaToProto(&media[i])
+	}
+	return protos
+}
@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/events"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProductMergeService merges duplicate products and splits variants into
+// products of their own
+type ProductMergeService struct {
+	mergeRepo      repository.ProductMergeRepository
+	productService *ProductService
+	logger         *zap.Logger
+}
+
+// NewProductMergeService creates a new product merge service
+func NewProductMergeService(mergeRepo repository.ProductMergeRepository, productService *ProductService, logger *zap.Logger) *ProductMergeService {
+	return &ProductMergeService{
+		mergeRepo:      mergeRepo,
+		productService: productService,
+		logger:         logger,
+	}
+}
+
+// MergeProducts merges the source product into the target. The source is
+// deleted and its ID and slug resolve to the target afterwards.
+func (s *ProductMergeService) MergeProducts(ctx context.Context, req *pb.MergeProductsRequest) (*pb.MergeProductsResponse, error) {
+	if _, err := uuid.Parse(req.TargetId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid target product ID")
+	}
+	if _, err := uuid.Parse(req.SourceId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid source product ID")
+	}
+	if req.TargetId == req.SourceId {
+		return nil, status.Error(codes.InvalidArgument, "cannot merge a product into itself")
+	}
+	for _, id := range []string{req.TargetId, req.SourceId} {
+		if err := s.checkMergeable(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	merge, err := s.mergeRepo.MergeProducts(ctx, req.TargetId, req.SourceId)
+	if err != nil {
+		return nil, s.mergeError("Failed to merge products", err)
+	}
+
+	for _, id := range []string{req.TargetId, req.SourceId} {
+		if err := s.productService.cacheManager.InvalidateProductAndRelated(ctx, id); err != nil {
+			s.logger.Warn("Failed to invalidate merged product cache", zap.String("product_id", id), zap.Error(err))
+		}
+	}
+	s.productService.publishProductEvent(ctx, events.ProductDeleted, req.SourceId)
+	s.productService.publishProductEvent(ctx, events.ProductUpdated, req.TargetId)
+
+	s.logger.Info("Products merged",
+		zap.String("target_id", merge.TargetID),
+		zap.String("source_id", merge.SourceID),
+		zap.Int("variants_moved", merge.VariantsMoved),
+		zap.Int("images_moved", merge.ImagesMoved))
+
+	product, err := s.productService.GetProduct(ctx, &pb.GetProductRequest{
+		Identifier: &pb.GetProductRequest_Id{Id: req.TargetId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MergeProductsResponse{
+		Product:       product,
+		VariantsMoved: int32(merge.VariantsMoved),
+		ImagesMoved:   int32(merge.ImagesMoved),
+	}, nil
+}
+
+// checkMergeable rejects bundles and digital products, whose components and
+// assets cannot be combined with another product
+func (s *ProductMergeService) checkMergeable(ctx context.Context, productID string) error {
+	if _, err := s.productService.bundleRepo.GetBundleByProductID(ctx, productID); err == nil {
+		return status.Error(codes.FailedPrecondition, "bundles cannot be merged")
+	}
+	if _, err := s.productService.digitalRepo.GetDigitalAssetByProductID(ctx, productID); err == nil {
+		return status.Error(codes.FailedPrecondition, "digital products cannot be merged")
+	}
+	return nil
+}
+
+// SplitVariant promotes a variant to a standalone product
+func (s *ProductMergeService) SplitVariant(ctx context.Context, req *pb.SplitVariantRequest) (*pb.SplitVariantResponse, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	if _, err := uuid.Parse(req.VariantId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid variant ID")
+	}
+	if strings.TrimSpace(req.Slug) == "" {
+		return nil, status.Error(codes.InvalidArgument, "slug is required")
+	}
+	if _, err := s.productService.bundleRepo.GetBundleByProductID(ctx, req.ProductId); err == nil {
+		return nil, status.Error(codes.FailedPrecondition, "bundle variants cannot be split")
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		variants, err := s.productService.productRepo.GetProductVariants(ctx, req.ProductId)
+		if err != nil {
+			return nil, s.mergeError("Failed to split variant", err)
+		}
+		for _, variant := range variants {
+			if variant.ID == req.VariantId && variant.Title != nil {
+				title = *variant.Title
+			}
+		}
+		if title == "" {
+			return nil, status.Error(codes.InvalidArgument, "title is required for a variant without a title")
+		}
+	}
+
+	product := &models.Product{ID: uuid.New().String(), Title: title, Slug: strings.TrimSpace(req.Slug)}
+	if err := s.mergeRepo.SplitVariant(ctx, req.ProductId, req.VariantId, product); err != nil {
+		return nil, s.mergeError("Failed to split variant", err)
+	}
+
+	if err := s.productService.cacheManager.InvalidateProductAndRelated(ctx, req.ProductId); err != nil {
+		s.logger.Warn("Failed to invalidate split product cache", zap.String("product_id", req.ProductId), zap.Error(err))
+	}
+	s.productService.publishProductEvent(ctx, events.ProductCreated, product.ID)
+	s.productService.publishProductEvent(ctx, events.ProductUpdated, req.ProductId)
+
+	s.logger.Info("Variant split into a product",
+		zap.String("source_id", req.ProductId),
+		zap.String("variant_id", req.VariantId),
+		zap.String("product_id", product.ID))
+
+	created, err := s.productService.GetProduct(ctx, &pb.GetProductRequest{
+		Identifier: &pb.GetProductRequest_Id{Id: product.ID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SplitVariantResponse{Product: created}, nil
+}
+
+// RedirectedRequest returns the request for the product a merged product
+// was merged into, when req asks for a merged product
+func (s *ProductMergeService) RedirectedRequest(ctx context.Context, req *pb.GetProductRequest) (*pb.GetProductRequest, bool) {
+	var redirect *models.ProductRedirect
+	var err error
+	switch identifier := req.Identifier.(type) {
+	case *pb.GetProductRequest_Id:
+		if _, parseErr := uuid.Parse(identifier.Id); parseErr != nil {
+			return nil, false
+		}
+		redirect, err = s.mergeRepo.GetRedirect(ctx, identifier.Id, "")
+	case *pb.GetProductRequest_Slug:
+		redirect, err = s.mergeRepo.GetRedirect(ctx, "", identifier.Slug)
+	default:
+		return nil, false
+	}
+	if err != nil {
+		if !errors.Is(err, models.ErrProductRedirectNotFound) {
+			s.logger.Warn("Failed to look up product redirect", zap.Error(err))
+		}
+		return nil, false
+	}
+
+	return &pb.GetProductRequest{
+		Identifier: &pb.GetProductRequest_Id{Id: redirect.ToProductID},
+	}, true
+}
+
+func (s *ProductMergeService) mergeError(message string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, models.ErrVariantNotFound):
+		return status.Error(codes.NotFound, "variant not found")
+	case errors.Is(err, models.ErrProductSlugExists), errors.Is(err, models.ErrProductSKUExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, models.ErrSplitOnlyVariant), errors.Is(err, models.ErrSplitProductSKU):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/louai60/e-commerce_project/backend/common/metadata"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// HideMetadata clears the metadata of products and their variants unless
+// the metadata flag exposes it to the store in ctx
+func (s *ProductService) HideMetadata(ctx context.Context, products ...*pb.Product) {
+	if s.flags.IsEnabledOr(metadata.FlagKey, tenant.FromContext(ctx), false) {
+		return
+	}
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		product.Metadata = nil
+		for _, variant := range product.Variants {
+			variant.Metadata = nil
+		}
+	}
+}
+
+// newMetadata returns the metadata of a new product or variant
+func newMetadata(s *structpb.Struct) (json.RawMessage, error) {
+	doc, err := metadata.FromStruct(s)
+	if err != nil || doc == nil {
+		return doc, invalidMetadata(err)
+	}
+	return doc, invalidMetadata(metadata.Validate(doc))
+}
+
+// patchMetadata applies the metadata of an update, a merge patch, to the
+// existing metadata of a product or variant. Without a patch, the metadata
+// is left unchanged.
+func patchMetadata(doc json.RawMessage, patch *structpb.Struct) (json.RawMessage, error) {
+	changes, err := metadata.FromStruct(patch)
+	if err != nil || changes == nil {
+		return doc, invalidMetadata(err)
+	}
+	merged, err := metadata.Merge(doc, changes)
+	if err != nil {
+		return nil, invalidMetadata(err)
+	}
+	return merged, nil
+}
+
+func invalidMetadata(err error) error {
+	if err == nil {
+		return nil
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxProductNoteLength bounds the body of a note, in characters
+const maxProductNoteLength = 5000
+
+// ProductNoteService manages the internal notes staff attach to products
+type ProductNoteService struct {
+	noteRepo repository.ProductNoteRepository
+	logger   *zap.Logger
+}
+
+// NewProductNoteService creates a new product note service
+func NewProductNoteService(noteRepo repository.ProductNoteRepository, logger *zap.Logger) *ProductNoteService {
+	return &ProductNoteService{
+		noteRepo: noteRepo,
+		logger:   logger,
+	}
+}
+
+// CreateNote attaches a note to a product on behalf of its author
+func (s *ProductNoteService) CreateNote(ctx context.Context, req *pb.CreateProductNoteRequest) (*pb.ProductNote, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	if req.AuthorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "author_id is required")
+	}
+	body, err := noteBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	note := &models.ProductNote{
+		ProductID:   req.ProductId,
+		AuthorID:    req.AuthorId,
+		AuthorEmail: req.AuthorEmail,
+		Body:        body,
+	}
+	if err := s.noteRepo.CreateNote(ctx, note); err != nil {
+		return nil, s.noteError("Failed to create product note", err)
+	}
+
+	s.logger.Info("Note added to product",
+		zap.String("product_id", note.ProductID),
+		zap.String("note_id", note.ID),
+		zap.String("author_id", note.AuthorID))
+	return productNoteToProto(note), nil
+}
+
+// ListNotes returns the notes on a product, newest first
+func (s *ProductNoteService) ListNotes(ctx context.Context, req *pb.ListProductNotesRequest) (*pb.ListProductNotesResponse, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+
+	notes, err := s.noteRepo.ListNotes(ctx, req.ProductId)
+	if err != nil {
+		return nil, s.noteError("Failed to list product notes", err)
+	}
+
+	resp := &pb.ListProductNotesResponse{Notes: make([]*pb.ProductNote, len(notes))}
+	for i, note := range notes {
+		resp.Notes[i] = productNoteToProto(note)
+	}
+	return resp, nil
+}
+
+// UpdateNote replaces the body of a note. Only the author of a note may
+// edit it, so its attribution stays truthful.
+func (s *ProductNoteService) UpdateNote(ctx context.Context, req *pb.UpdateProductNoteRequest) (*pb.ProductNote, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid note ID")
+	}
+	body, err := noteBody(req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := s.noteRepo.GetNote(ctx, req.ProductId, req.Id)
+	if err != nil {
+		return nil, s.noteError("Failed to get product note", err)
+	}
+	if note.AuthorID != req.AuthorId {
+		return nil, s.noteError("Failed to update product note", models.ErrNotNoteAuthor)
+	}
+
+	note.Body = body
+	if err := s.noteRepo.UpdateNote(ctx, note); err != nil {
+		return nil, s.noteError("Failed to update product note", err)
+	}
+	return productNoteToProto(note), nil
+}
+
+// DeleteNote removes a note. Any staff member may remove a note.
+func (s *ProductNoteService) DeleteNote(ctx context.Context, req *pb.DeleteProductNoteRequest) (*pb.DeleteProductNoteResponse, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid note ID")
+	}
+
+	if err := s.noteRepo.DeleteNote(ctx, req.ProductId, req.Id); err != nil {
+		return nil, s.noteError("Failed to delete product note", err)
+	}
+	return &pb.DeleteProductNoteResponse{Success: true}, nil
+}
+
+func (s *ProductNoteService) noteError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, models.ErrProductNoteNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrNotNoteAuthor):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func noteBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", status.Error(codes.InvalidArgument, "note body is required")
+	}
+	if utf8.RuneCountInString(body) > maxProductNoteLength {
+		return "", status.Errorf(codes.InvalidArgument, "note body cannot exceed %d characters", maxProductNoteLength)
+	}
+	return body, nil
+}
+
+func productNoteToProto(note *models.ProductNote) *pb.ProductNote {
+	return &pb.ProductNote{
+		Id:          note.ID,
+		ProductId:   note.ProductID,
+		AuthorId:    note.AuthorID,
+		AuthorEmail: note.AuthorEmail,
+		Body:        note.Body,
+		CreatedAt:   timestamppb.New(note.CreatedAt),
+		UpdatedAt:   timestamppb.New(note.UpdatedAt),
+	}
+}
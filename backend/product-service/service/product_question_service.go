@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// maxQuestionLength and maxAnswerLength bound the bodies of questions and
+	// answers, in characters
+	maxQuestionLength = 1000
+	maxAnswerLength   = 5000
+
+	defaultQuestionLimit = 10
+	maxQuestionLimit     = 100
+)
+
+// ProductQuestionService manages the questions customers ask on product pages
+// and the answers of admins and sellers. Questions and seller answers wait
+// for moderation before the storefront shows them; admin answers are
+// approved as they are posted.
+type ProductQuestionService struct {
+	questionRepo repository.ProductQuestionRepository
+	logger       *zap.Logger
+}
+
+// NewProductQuestionService creates a new product question service
+func NewProductQuestionService(questionRepo repository.ProductQuestionRepository, logger *zap.Logger) *ProductQuestionService {
+	return &ProductQuestionService{
+		questionRepo: questionRepo,
+		logger:       logger,
+	}
+}
+
+// AskQuestion stores the question of a customer on a product, pending
+// moderation
+func (s *ProductQuestionService) AskQuestion(ctx context.Context, req *pb.AskProductQuestionRequest) (*pb.ProductQuestion, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	if req.AuthorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "author_id is required")
+	}
+	body, err := qaBody("question", req.Body, maxQuestionLength)
+	if err != nil {
+		return nil, err
+	}
+
+	question := &models.ProductQuestion{
+		ProductID:  req.ProductId,
+		AuthorID:   req.AuthorId,
+		AuthorName: strings.TrimSpace(req.AuthorName),
+		Body:       body,
+		Status:     models.ModerationPending,
+		Answers:    []*models.ProductAnswer{},
+	}
+	if err := s.questionRepo.CreateQuestion(ctx, question); err != nil {
+		return nil, s.questionError("Failed to create product question", err)
+	}
+
+	s.logger.Info("Question asked on product",
+		zap.String("product_id", question.ProductID),
+		zap.String("question_id", question.ID),
+		zap.String("author_id", question.AuthorID))
+	return productQuestionToProto(question), nil
+}
+
+// GetQuestion returns a question with its answers
+func (s *ProductQuestionService) GetQuestion(ctx context.Context, req *pb.GetProductQuestionRequest) (*pb.ProductQuestion, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid question ID")
+	}
+
+	question, err := s.questionRepo.GetQuestion(ctx, req.Id, req.IncludeUnmoderated)
+	if err != nil {
+		return nil, s.questionError("Failed to get product question", err)
+	}
+	return productQuestionToProto(question), nil
+}
+
+// ListQuestions returns a page of the questions of a product, or of all
+// products for moderation. Approved questions are listed unless another
+// status is requested.
+func (s *ProductQuestionService) ListQuestions(ctx context.Context, req *pb.ListProductQuestionsRequest) (*pb.ListProductQuestionsResponse, error) {
+	if req.ProductId != "" {
+		if _, err := uuid.Parse(req.ProductId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+		}
+	}
+
+	filter := models.QuestionFilter{
+		ProductID:          req.ProductId,
+		Status:             req.Status,
+		Sort:               req.Sort,
+		Limit:              int(req.Limit),
+		IncludeUnmoderated: req.IncludeUnmoderated,
+	}
+	if filter.Status == "" {
+		filter.Status = models.ModerationApproved
+	}
+	if !models.IsValidModerationStatus(filter.Status) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown question status %q", filter.Status)
+	}
+	switch filter.Sort {
+	case "":
+		filter.Sort = models.QuestionSortNewest
+	case models.QuestionSortNewest, models.QuestionSortTop:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown question sort %q", filter.Sort)
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultQuestionLimit
+	}
+	if filter.Limit > maxQuestionLimit {
+		filter.Limit = maxQuestionLimit
+	}
+	if req.Page > 1 {
+		filter.Offset = int(req.Page-1) * filter.Limit
+	}
+
+	questions, total, err := s.questionRepo.ListQuestions(ctx, filter)
+	if err != nil {
+		return nil, s.questionError("Failed to list product questions", err)
+	}
+
+	resp := &pb.ListProductQuestionsResponse{
+		Questions: make([]*pb.ProductQuestion, len(questions)),
+		Total:     int32(total),
+	}
+	for i, question := range questions {
+		resp.Questions[i] = productQuestionToProto(question)
+	}
+	return resp, nil
+}
+
+// ModerateQuestion approves or rejects a question, or returns it to pending
+func (s *ProductQuestionService) ModerateQuestion(ctx context.Context, req *pb.ModerateProductQuestionRequest) (*pb.ProductQuestion, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid question ID")
+	}
+	if !models.IsValidModerationStatus(req.Status) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown question status %q", req.Status)
+	}
+
+	if err := s.questionRepo.UpdateQuestionStatus(ctx, req.Id, req.Status); err != nil {
+		return nil, s.questionError("Failed to moderate product question", err)
+	}
+	question, err := s.questionRepo.GetQuestion(ctx, req.Id, true)
+	if err != nil {
+		return nil, s.questionError("Failed to get product question", err)
+	}
+
+	s.logger.Info("Product question moderated",
+		zap.String("question_id", question.ID),
+		zap.String("status", question.Status))
+	return productQuestionToProto(question), nil
+}
+
+// DeleteQuestion removes a question with its answers
+func (s *ProductQuestionService) DeleteQuestion(ctx context.Context, req *pb.DeleteProductQuestionRequest) (*pb.DeleteProductQuestionResponse, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid question ID")
+	}
+
+	if err := s.questionRepo.DeleteQuestion(ctx, req.Id); err != nil {
+		return nil, s.questionError("Failed to delete product question", err)
+	}
+	return &pb.DeleteProductQuestionResponse{Success: true}, nil
+}
+
+// AnswerQuestion stores the answer of an admin or seller. Admin answers are
+// approved right away, seller answers wait for moderation.
+func (s *ProductQuestionService) AnswerQuestion(ctx context.Context, req *pb.AnswerProductQuestionRequest) (*pb.ProductAnswer, error) {
+	if _, err := uuid.Parse(req.QuestionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid question ID")
+	}
+	if req.AuthorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "author_id is required")
+	}
+	answerStatus := models.ModerationPending
+	switch req.AuthorRole {
+	case models.AnswerRoleAdmin:
+		answerStatus = models.ModerationApproved
+	case models.AnswerRoleSeller:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "author role must be %s or %s", models.AnswerRoleAdmin, models.AnswerRoleSeller)
+	}
+	body, err := qaBody("answer", req.Body, maxAnswerLength)
+	if err != nil {
+		return nil, err
+	}
+
+	answer := &models.ProductAnswer{
+		QuestionID: req.QuestionId,
+		AuthorID:   req.AuthorId,
+		AuthorName: strings.TrimSpace(req.AuthorName),
+		AuthorRole: req.AuthorRole,
+		Body:       body,
+		Status:     answerStatus,
+	}
+	if err := s.questionRepo.CreateAnswer(ctx, answer); err != nil {
+		return nil, s.questionError("Failed to create product answer", err)
+	}
+
+	s.logger.Info("Product question answered",
+		zap.String("question_id", answer.QuestionID),
+		zap.String("answer_id", answer.ID),
+		zap.String("author_role", answer.AuthorRole))
+	return productAnswerToProto(answer), nil
+}
+
+// ModerateAnswer approves or rejects an answer, or returns it to pending
+func (s *ProductQuestionService) ModerateAnswer(ctx context.Context, req *pb.ModerateProductAnswerRequest) (*pb.ProductAnswer, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid answer ID")
+	}
+	if !models.IsValidModerationStatus(req.Status) {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown answer status %q", req.Status)
+	}
+
+	if err := s.questionRepo.UpdateAnswerStatus(ctx, req.Id, req.Status); err != nil {
+		return nil, s.questionError("Failed to moderate product answer", err)
+	}
+	answer, err := s.questionRepo.GetAnswer(ctx, req.Id)
+	if err != nil {
+		return nil, s.questionError("Failed to get product answer", err)
+	}
+	return productAnswerToProto(answer), nil
+}
+
+// DeleteAnswer removes an answer
+func (s *ProductQuestionService) DeleteAnswer(ctx context.Context, req *pb.DeleteProductAnswerRequest) (*pb.DeleteProductAnswerResponse, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid answer ID")
+	}
+
+	if err := s.questionRepo.DeleteAnswer(ctx, req.Id); err != nil {
+		return nil, s.questionError("Failed to delete product answer", err)
+	}
+	return &pb.DeleteProductAnswerResponse{Success: true}, nil
+}
+
+// UpvoteAnswer upvotes a published answer on behalf of a user. Repeated
+// upvotes of the same user are ignored.
+func (s *ProductQuestionService) UpvoteAnswer(ctx context.Context, req *pb.UpvoteProductAnswerRequest) (*pb.ProductAnswer, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid answer ID")
+	}
+	if req.VoterId == "" {
+		return nil, status.Error(codes.InvalidArgument, "voter_id is required")
+	}
+
+	answer, err := s.questionRepo.UpvoteAnswer(ctx, req.Id, req.VoterId)
+	if err != nil {
+		return nil, s.questionError("Failed to upvote product answer", err)
+	}
+	return productAnswerToProto(answer), nil
+}
+
+func (s *ProductQuestionService) questionError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, models.ErrProductQuestionNotFound),
+		errors.Is(err, models.ErrProductAnswerNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func qaBody(kind, body string, maxLength int) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", status.Errorf(codes.InvalidArgument, "%s body is required", kind)
+	}
+	if utf8.RuneCountInString(body) > maxLength {
+		return "", status.Errorf(codes.InvalidArgument, "%s body cannot exceed %d characters", kind, maxLength)
+	}
+	return body, nil
+}
+
+func productQuestionToProto(question *models.ProductQuestion) *pb.ProductQuestion {
+	answers := make([]*pb.ProductAnswer, len(question.Answers))
+	for i, answer := range question.Answers {
+		answers[i] = productAnswerToProto(answer)
+	}
+	return &pb.ProductQuestion{
+		Id:         question.ID,
+		ProductId:  question.ProductID,
+		AuthorId:   question.AuthorID,
+		AuthorName: question.AuthorName,
+		Body:       question.Body,
+		Status:     question.Status,
+		Answers:    answers,
+		CreatedAt:  timestamppb.New(question.CreatedAt),
+		UpdatedAt:  timestamppb.New(question.UpdatedAt),
+	}
+}
+
+func productAnswerToProto(answer *models.ProductAnswer) *pb.ProductAnswer {
+	return &pb.ProductAnswer{
+		Id:         answer.ID,
+		QuestionId: answer.QuestionID,
+		AuthorId:   answer.AuthorID,
+		AuthorName: answer.AuthorName,
+		AuthorRole: answer.AuthorRole,
+		Body:       answer.Body,
+		Status:     answer.Status,
+		Upvotes:    int32(answer.Upvotes),
+		CreatedAt:  timestamppb.New(answer.CreatedAt),
+		UpdatedAt:  timestamppb.New(answer.UpdatedAt),
+	}
+}
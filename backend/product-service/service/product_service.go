@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"time"
@@ -11,13 +13,20 @@ import (
 	"github.com/cloudinary/cloudinary-go/v2"
 	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 	"github.com/google/uuid"
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/metadata"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	"github.com/louai60/e-commerce_project/backend/product-service/cache"
 	"github.com/louai60/e-commerce_project/backend/product-service/clients"
+	"github.com/louai60/e-commerce_project/backend/product-service/events"
 	"github.com/louai60/e-commerce_project/backend/product-service/models"
 	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
 	"github.com/louai60/e-commerce_project/backend/product-service/repository"
 	"github.com/louai60/e-commerce_project/backend/product-service/storage"
 	"github.com/louai60/e-commerce_project/backend/product-service/utils"
+	"github.com/louai60/e-commerce_project/backend/product-service/validationhook"
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -27,13 +36,22 @@ import (
 
 // ProductService handles business logic for products, brands, and categories
 type ProductService struct {
-	productRepo     repository.ProductRepository
-	brandRepo       repository.BrandRepository
-	categoryRepo    repository.CategoryRepository
-	cacheManager    cache.CacheInterface
-	logger          *zap.Logger
-	cld             *cloudinary.Cloudinary
-	inventoryClient *clients.InventoryClient
+	productRepo         repository.ProductRepository
+	brandRepo           repository.BrandRepository
+	categoryRepo        repository.CategoryRepository
+	bundleRepo          repository.BundleRepository
+	digitalRepo         repository.DigitalAssetRepository
+	subscriptionRepo    repository.SubscriptionRepository
+	attributeRepo       repository.CategoryAttributeRepository
+	personalizationRepo repository.PersonalizationRepository
+	mediaRepo           repository.ProductMediaRepository
+	cacheManager        cache.CacheInterface
+	logger              *zap.Logger
+	cld                 *cloudinary.Cloudinary
+	inventoryClient     *clients.InventoryClient
+	flags               *featureflags.Client
+	events              *events.Bus
+	validator           *ProductValidator
 }
 
 // NewProductService creates a new product service
@@ -41,9 +59,18 @@ func NewProductService(
 	productRepo repository.ProductRepository,
 	brandRepo repository.BrandRepository,
 	categoryRepo repository.CategoryRepository,
+	bundleRepo repository.BundleRepository,
+	digitalRepo repository.DigitalAssetRepository,
+	subscriptionRepo repository.SubscriptionRepository,
+	attributeRepo repository.CategoryAttributeRepository,
+	personalizationRepo repository.PersonalizationRepository,
+	mediaRepo repository.ProductMediaRepository,
 	cacheManager cache.CacheInterface,
 	logger *zap.Logger,
 	inventoryClient *clients.InventoryClient,
+	flags *featureflags.Client,
+	eventBus *events.Bus,
+	validator *ProductValidator,
 ) *ProductService {
 	// Initialize Cloudinary
 	var cld *cloudinary.Cloudinary
@@ -60,16 +87,36 @@ func NewProductService(
 	}
 
 	return &ProductService{
-		productRepo:     productRepo,
-		brandRepo:       brandRepo,
-		categoryRepo:    categoryRepo,
-		cacheManager:    cacheManager,
-		logger:          logger,
-		cld:             cld,
-		inventoryClient: inventoryClient,
+		productRepo:         productRepo,
+		brandRepo:           brandRepo,
+		categoryRepo:        categoryRepo,
+		bundleRepo:          bundleRepo,
+		digitalRepo:         digitalRepo,
+		subscriptionRepo:    subscriptionRepo,
+		attributeRepo:       attributeRepo,
+		personalizationRepo: personalizationRepo,
+		mediaRepo:           mediaRepo,
+		cacheManager:        cacheManager,
+		logger:              logger,
+		cld:                 cld,
+		inventoryClient:     inventoryClient,
+		flags:               flags,
+		events:              eventBus,
+		validator:           validator,
 	}
 }
 
+// publishProductEvent tells subscribers that a product of the store in ctx
+// changed
+func (s *ProductService) publishProductEvent(ctx context.Context, eventType, productID string) {
+	s.events.Publish(ctx, events.Event{
+		Type:      eventType,
+		ProductID: productID,
+		TenantID:  tenant.FromContext(ctx),
+		ActorID:   applogger.UserIDFromContext(ctx),
+	})
+}
+
 func (s *ProductService) CreateProduct(ctx context.Context, req *pb.CreateProductRequest) (*pb.Product, error) {
 	if req == nil || req.Product == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request: product is required")
@@ -152,11 +199,64 @@ func (s *ProductService) CreateProduct(ctx context.Context, req *pb.CreateProduc
 		}
 	}
 
+	// Products inherit the attributes of their categories
+	attributeValues, err := s.applyCategoryAttributes(ctx, product.Categories, req.Product.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.Metadata, err = newMetadata(req.Product.Metadata); err != nil {
+		return nil, err
+	}
+
+	// The variants of the request are created with the product. The one
+	// carrying the product SKU becomes its default variant, which the price
+	// and SKU of the product are derived from; without one, the default
+	// variant is created from the product.
+	for _, variantProto := range req.Product.Variants {
+		variant := convertProtoToVariantModel(variantProto)
+		variant.ID = ""
+		variant.Images = convertProtoToVariantImages(variantProto.Images)
+		variant.InheritFromProduct(product)
+		if variant.Metadata, err = newMetadata(variantProto.Metadata); err != nil {
+			return nil, err
+		}
+		product.Variants = append(product.Variants, *variant)
+	}
+	if product.SKU == "" {
+		if len(product.Variants) > 0 {
+			product.SKU = product.Variants[0].SKU
+		} else {
+			product.SKU = s.generateProductSKU(ctx, product, req.Product.Categories)
+		}
+	}
+	for _, variant := range product.Variants {
+		if variant.SKU != product.SKU {
+			continue
+		}
+		product.Price.Amount = variant.Price
+		product.DiscountPrice = nil
+		if variant.DiscountPrice != nil {
+			product.DiscountPrice = &models.Price{Amount: *variant.DiscountPrice, Currency: product.Price.Currency}
+		}
+		break
+	}
+
+	// Stores may have products checked by their PIM before they are saved
+	if err := s.validator.Validate(ctx, validationhook.OperationCreate, product); err != nil {
+		return nil, err
+	}
+
 	// Create the product
 	if err := s.productRepo.CreateProduct(ctx, product); err != nil {
 		s.logger.Error("Failed to create product", zap.Error(err))
-		if err == models.ErrProductSlugExists {
+		switch {
+		case errors.Is(err, models.ErrProductSlugExists):
 			return nil, status.Errorf(codes.AlreadyExists, "product with this slug already exists")
+		case errors.Is(err, models.ErrProductAlreadyExists), errors.Is(err, models.ErrVariantAlreadyExists), errors.Is(err, models.ErrVariantSKUExists):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, models.ErrInvalidVariantPrice):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create product: %v", err)
 	}
@@ -167,108 +267,6 @@ func (s *ProductService) CreateProduct(ctx context.Context, req *pb.CreateProduc
 	// If this product is being created directly through the product service (not via API gateway),
 	// you'll need to create the inventory item separately through the inventory service
 
-	// Create default variant if no variants are provided
-	if len(req.Product.Variants) == 0 {
-		defaultVariant := createDefaultVariant(product)
-		defaultVariant.ProductID = product.ID
-
-		// Ensure SKU is set for the default variant
-		if defaultVariant.SKU == "" {
-			// Generate a proper SKU if none provided
-			// First try to get brand and category information
-			var brandName string
-			if product.BrandID != nil {
-				brand, err := s.brandRepo.GetBrandByID(ctx, *product.BrandID)
-				if err == nil && brand != nil {
-					brandName = brand.Name
-				}
-			}
-
-			// For categories, we can't get them yet since the product was just created
-			// and categories are associated after creation
-			var categoryName string
-			// Try to extract category from request if available
-			if len(req.Product.Categories) > 0 {
-				categoryName = req.Product.Categories[0].Name
-			}
-
-			// Generate a unique SKU using our utility
-			uniqueSKU, err := utils.GenerateUniqueSKU(ctx, s.productRepo, brandName, categoryName, "", "", 5)
-			if err != nil {
-				s.logger.Warn("Failed to generate unique SKU, falling back to basic SKU generation",
-					zap.Error(err),
-					zap.String("product_id", product.ID))
-				// Fallback to basic SKU generation
-				uniqueSKU = utils.GenerateSKU(brandName, categoryName, "", "")
-			}
-
-			defaultVariant.SKU = uniqueSKU
-
-			// Also update the product's SKU
-			product.SKU = defaultVariant.SKU
-
-			s.logger.Info("Generated unique SKU for product",
-				zap.String("product_id", product.ID),
-				zap.String("sku", product.SKU))
-		}
-
-		if err := s.productRepo.CreateVariant(ctx, nil, product.ID, &defaultVariant); err != nil {
-			s.logger.Error("Failed to create default variant", zap.Error(err))
-			// Continue even if default variant creation fails
-		}
-	}
-
-	// Handle variants if provided
-	if len(req.Product.Variants) > 0 {
-		for _, variantProto := range req.Product.Variants {
-			variant := &models.ProductVariant{
-				ProductID: product.ID,
-				SKU:       variantProto.Sku,
-				Price:     variantProto.Price,
-			}
-
-			if variantProto.Title != "" {
-				variant.Title = &variantProto.Title
-			}
-			if variantProto.DiscountPrice != nil {
-				discountPrice := variantProto.DiscountPrice.Value
-				variant.DiscountPrice = &discountPrice
-			}
-
-			// Process variant attributes
-			if len(variantProto.Attributes) > 0 {
-				variant.Attributes = make([]models.VariantAttributeValue, len(variantProto.Attributes))
-				for i, attr := range variantProto.Attributes {
-					variant.Attributes[i] = models.VariantAttributeValue{
-						Name:  attr.Name,
-						Value: attr.Value,
-					}
-				}
-			}
-
-			// Process variant images
-			if len(variantProto.Images) > 0 {
-				variant.Images = make([]models.VariantImage, len(variantProto.Images))
-				for i, img := range variantProto.Images {
-					variant.Images[i] = models.VariantImage{
-						VariantID: variant.ID, // This will be populated after variant creation
-						URL:       img.Url,
-						AltText:   img.AltText,
-						Position:  int(img.Position),
-					}
-				}
-			}
-
-			// Inherit fields from parent product
-			variant.InheritFromProduct(product)
-
-			if err := s.productRepo.CreateVariant(ctx, nil, product.ID, variant); err != nil {
-				s.logger.Error("Failed to create variant", zap.Error(err))
-				// Continue with other variants even if one fails
-			}
-		}
-	}
-
 	// Process shipping data if provided
 	if req.Product.Shipping != nil {
 		shipping := &models.ProductShipping{
@@ -341,12 +339,12 @@ func (s *ProductService) CreateProduct(ctx context.Context, req *pb.CreateProduc
 	}
 
 	// Process attributes if provided
-	if len(req.Product.Attributes) > 0 {
-		for _, attrProto := range req.Product.Attributes {
+	if len(attributeValues) > 0 {
+		for _, value := range attributeValues {
 			attr := &models.ProductAttribute{
 				ProductID: product.ID,
-				Name:      attrProto.Name,
-				Value:     attrProto.Value,
+				Name:      value.Name,
+				Value:     value.Value,
 				CreatedAt: time.Now().UTC(),
 				UpdatedAt: time.Now().UTC(),
 			}
@@ -391,6 +389,7 @@ func (s *ProductService) CreateProduct(ctx context.Context, req *pb.CreateProduc
 	} else {
 		s.logger.Info("Successfully invalidated product list caches", zap.String("product_id", product.ID))
 	}
+	s.publishProductEvent(ctx, events.ProductCreated, product.ID)
 
 	// Return the created product
 	return s.GetProduct(ctx, &pb.GetProductRequest{
@@ -422,8 +421,11 @@ func (s *ProductService) GetProduct(ctx context.Context, req *pb.GetProductReque
 	}
 
 	if err != nil {
+		if errors.Is(err, models.ErrProductNotFound) {
+			return nil, status.Error(codes.NotFound, "product not found")
+		}
 		s.logger.Error("Failed to get product", zap.Error(err))
-		return nil, status.Errorf(codes.NotFound, "product not found")
+		return nil, status.Errorf(codes.Internal, "failed to get product: %v", err)
 	}
 
 	// Populate related entities
@@ -441,29 +443,37 @@ func (s *ProductService) GetProduct(ctx context.Context, req *pb.GetProductReque
 }
 
 func (s *ProductService) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
-	// Generate cache key from pagination parameters
+	// Generate cache key from pagination parameters and the channel, so each
+	// channel caches its own lists
 	cacheKey := fmt.Sprintf("page:%d:limit:%d", req.Page, req.Limit)
+	if req.Channel != "" {
+		cacheKey = fmt.Sprintf("channel:%s:%s", req.Channel, cacheKey)
+	}
+
+	// The product_list_cache flag allows bypassing the list cache at runtime
+	useCache := s.flags.IsEnabledOr("product_list_cache", applogger.UserIDFromContext(ctx), true)
 
 	// Try cache first
-	products, err := s.cacheManager.GetProductList(ctx, cacheKey)
-	if err == nil {
-		s.logger.Debug("Cache hit for product list", zap.String("key", cacheKey))
+	if useCache {
+		if products, err := s.cacheManager.GetProductList(ctx, cacheKey); err == nil {
+			s.logger.Debug("Cache hit for product list", zap.String("key", cacheKey))
+
+			// Get the total count from the database to ensure accurate pagination
+			_, total, err := s.productRepo.List(ctx, 0, 1, req.Channel)
+			if err != nil {
+				s.logger.Error("Failed to get total product count", zap.Error(err))
+				// Fall back to using the cached products length
+				return &pb.ListProductsResponse{
+					Products: convertProductModelsToProtos(products),
+					Total:    int32(len(products)),
+				}, nil
+			}
 
-		// Get the total count from the database to ensure accurate pagination
-		_, total, err := s.productRepo.List(ctx, 0, 1)
-		if err != nil {
-			s.logger.Error("Failed to get total product count", zap.Error(err))
-			// Fall back to using the cached products length
 			return &pb.ListProductsResponse{
 				Products: convertProductModelsToProtos(products),
-				Total:    int32(len(products)),
+				Total:    int32(total),
 			}, nil
 		}
-
-		return &pb.ListProductsResponse{
-			Products: convertProductModelsToProtos(products),
-			Total:    int32(total),
-		}, nil
 	}
 
 	// Cache miss, get from database
@@ -473,7 +483,7 @@ func (s *ProductService) ListProducts(ctx context.Context, req *pb.ListProductsR
 	}
 
 	// Get basic product list
-	products, total, err := s.productRepo.List(ctx, int(offset), int(req.Limit))
+	products, total, err := s.productRepo.List(ctx, int(offset), int(req.Limit), req.Channel)
 	if err != nil {
 		s.logger.Error("Failed to list products", zap.Error(err))
 		return nil, status.Errorf(codes.Internal, "failed to list products")
@@ -511,8 +521,10 @@ func (s *ProductService) ListProducts(ctx context.Context, req *pb.ListProductsR
 	}
 
 	// Cache the enhanced result
-	if err := s.cacheManager.SetProductList(ctx, cacheKey, enhancedProducts); err != nil {
-		s.logger.Warn("Failed to cache product list", zap.Error(err))
+	if useCache {
+		if err := s.cacheManager.SetProductList(ctx, cacheKey, enhancedProducts); err != nil {
+			s.logger.Warn("Failed to cache product list", zap.Error(err))
+		}
 	}
 
 	// Log the number of products and their data completeness
@@ -541,26 +553,33 @@ func convertModelToProto(model *models.Product) *pb.Product {
 		return nil
 	}
 	protoProduct := &pb.Product{
-		Id:               model.ID,
-		Title:            model.Title,
-		Slug:             model.Slug,
-		Description:      model.Description,
-		ShortDescription: model.ShortDescription,
-		Price:            model.Price.Amount,
-		Sku:              model.SKU,
-		IsPublished:      model.IsPublished,
-		CreatedAt:        timestamppb.New(model.CreatedAt),
-		UpdatedAt:        timestamppb.New(model.UpdatedAt),
-		Brand:            convertBrandModelToProto(model.Brand),                    // Convert Brand
-		Images:           convertImageModelsToProtos(model.Images),                 // Convert Images
-		Categories:       convertCategorySliceToProtos(model.Categories),           // Convert Categories
-		Variants:         convertVariantModelsToProtos(model.Variants),             // Convert Variants
-		Tags:             convertTagModelsToProtos(model.Tags),                     // Convert Tags
-		Attributes:       convertProductAttributeModelsToProtos(model.Attributes),  // Convert Attributes
-		Specifications:   convertSpecificationModelsToProtos(model.Specifications), // Convert Specifications
-		Seo:              convertSEOModelToProto(model.SEO),                        // Convert SEO
-		Shipping:         convertShippingModelToProto(model.Shipping),              // Convert Shipping
-		Discount:         convertDiscountModelToProto(model.Discount),              // Convert Discount
+		Id:                     model.ID,
+		Title:                  model.Title,
+		Slug:                   model.Slug,
+		Description:            model.Description,
+		ShortDescription:       model.ShortDescription,
+		Price:                  model.Price.Amount,
+		Sku:                    model.SKU,
+		IsPublished:            model.IsPublished,
+		CreatedAt:              timestamppb.New(model.CreatedAt),
+		UpdatedAt:              timestamppb.New(model.UpdatedAt),
+		Brand:                  convertBrandModelToProto(model.Brand),                    // Convert Brand
+		Images:                 convertImageModelsToProtos(model.Images),                 // Convert Images
+		Categories:             convertCategorySliceToProtos(model.Categories),           // Convert Categories
+		Variants:               convertVariantModelsToProtos(model.Variants),             // Convert Variants
+		Tags:                   convertTagModelsToProtos(model.Tags),                     // Convert Tags
+		Attributes:             convertProductAttributeModelsToProtos(model.Attributes),  // Convert Attributes
+		Specifications:         convertSpecificationModelsToProtos(model.Specifications), // Convert Specifications
+		Seo:                    convertSEOModelToProto(model.SEO),                        // Convert SEO
+		Shipping:               convertShippingModelToProto(model.Shipping),              // Convert Shipping
+		Discount:               convertDiscountModelToProto(model.Discount),              // Convert Discount
+		Bundle:                 convertBundleModelToProto(model.Bundle),                  // Convert Bundle
+		ProductType:            model.Type(),
+		RequiresShipping:       model.RequiresShipping(),
+		DigitalAsset:           convertDigitalAssetModelToProto(model.DigitalAsset),     // Convert DigitalAsset
+		Subscription:           convertSubscriptionPlanModelToProto(model.Subscription), // Convert Subscription
+		PersonalizationOptions: convertPersonalizationOptionsToProtos(model.PersonalizationOptions),
+		Media:                  convertProductMediaToProtos(model.Media),
 	}
 
 	// Handle nullable fields
@@ -570,9 +589,13 @@ func convertModelToProto(model *models.Product) *pb.Product {
 	if model.Weight != nil {
 		protoProduct.Weight = wrapperspb.Double(*model.Weight)
 	}
+	if model.DefaultVariantID != nil {
+		protoProduct.DefaultVariantId = wrapperspb.String(*model.DefaultVariantID)
+	}
 	if model.BrandID != nil {
 		protoProduct.BrandId = wrapperspb.String(*model.BrandID)
 	}
+	protoProduct.Metadata, _ = metadata.ToStruct(model.Metadata)
 
 	return protoProduct
 }
@@ -606,6 +629,7 @@ func convertSingleCategoryModelToProto(model *models.Category) *pb.Category {
 		Name:        model.Name,
 		Slug:        model.Slug,
 		Description: model.Description,
+		Position:    int32(model.Position),
 		CreatedAt:   timestamppb.New(model.CreatedAt),
 		UpdatedAt:   timestamppb.New(model.UpdatedAt),
 	}
@@ -671,6 +695,7 @@ func convertVariantModelToProto(model models.ProductVariant) *pb.ProductVariant
 	if model.DiscountPrice != nil {
 		protoVariant.DiscountPrice = wrapperspb.Double(*model.DiscountPrice)
 	}
+	protoVariant.Metadata, _ = metadata.ToStruct(model.Metadata)
 
 	// Convert attributes
 	if len(model.Attributes) > 0 {
@@ -1019,14 +1044,40 @@ func (s *ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProduc
 	// 1. Get existing product
 	existingProduct, err := s.productRepo.GetByID(ctx, productID)
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "product with ID %s not found", productID)
+		if errors.Is(err, models.ErrProductNotFound) {
+			return nil, status.Errorf(codes.NotFound, "product with ID %s not found", productID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get product: %v", err)
 	}
 
 	// 2. Update base product
 	updatedProduct := convertProtoToModelForUpdate(req.Product, existingProduct)
 	updatedProduct.UpdatedAt = time.Now().UTC()
+	if updatedProduct.Metadata, err = patchMetadata(existingProduct.Metadata, req.Product.Metadata); err != nil {
+		return nil, err
+	}
+	// The metadata read above may be stale by the time the product is
+	// saved, so the repository merges the patch again under the row lock
+	if updatedProduct.MetadataPatch, err = metadata.FromStruct(req.Product.Metadata); err != nil {
+		return nil, invalidMetadata(err)
+	}
+
+	if err := s.validator.Validate(ctx, validationhook.OperationUpdate, updatedProduct); err != nil {
+		return nil, err
+	}
 
 	if err := s.productRepo.UpdateProduct(ctx, updatedProduct); err != nil {
+		switch {
+		case errors.Is(err, models.ErrVariantSKUExists):
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		case errors.Is(err, models.ErrInvalidVariantPrice):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, models.ErrProductNotFound):
+			return nil, status.Errorf(codes.NotFound, "product with ID %s not found", productID)
+		case apperrors.KindOf(err) == apperrors.ErrInvalidArgument:
+			// A concurrent patch took the metadata past its limits
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to update product: %v", err)
 	}
 
@@ -1039,7 +1090,10 @@ func (s *ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProduc
 		}
 		defer tx.Rollback()
 
-		if err := s.updateProductVariants(ctx, tx, productID, req.Product.Variants); err != nil {
+		if err := s.updateProductVariants(ctx, tx, productID, updatedProduct.DefaultVariantID, req.Product.Variants); err != nil {
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
 			return nil, status.Errorf(codes.Internal, "failed to update variants: %v", err)
 		}
 
@@ -1053,6 +1107,7 @@ func (s *ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProduc
 	if err := s.cacheManager.InvalidateProductAndRelated(ctx, productID); err != nil {
 		s.logger.Warn("Failed to invalidate caches", zap.String("id", productID), zap.Error(err))
 	}
+	s.publishProductEvent(ctx, events.ProductUpdated, productID)
 
 	// 5. Return updated product
 	return s.GetProduct(ctx, &pb.GetProductRequest{
@@ -1060,7 +1115,7 @@ func (s *ProductService) UpdateProduct(ctx context.Context, req *pb.UpdateProduc
 	})
 }
 
-func (s *ProductService) updateProductVariants(ctx context.Context, tx *sql.Tx, productID string, variants []*pb.ProductVariant) error {
+func (s *ProductService) updateProductVariants(ctx context.Context, tx *sql.Tx, productID string, defaultVariantID *string, variants []*pb.ProductVariant) error {
 	// 1. Get existing variants
 	existingVariants, err := s.productRepo.GetProductVariants(ctx, productID)
 	if err != nil {
@@ -1075,22 +1130,37 @@ func (s *ProductService) updateProductVariants(ctx context.Context, tx *sql.Tx,
 
 	// 2. Process each variant
 	for _, variant := range variants {
+		model := convertProtoToVariantModel(variant)
 		if variant.Id == "" {
 			// New variant
-			if err := s.productRepo.CreateVariant(ctx, tx, productID, convertProtoToVariantModel(variant)); err != nil {
+			if model.Metadata, err = newMetadata(variant.Metadata); err != nil {
+				return err
+			}
+			if err := s.productRepo.CreateVariant(ctx, tx, productID, model); err != nil {
 				return err
 			}
 		} else {
 			// Update existing variant
-			if err := s.productRepo.UpdateVariant(ctx, tx, convertProtoToVariantModel(variant)); err != nil {
+			var existingMetadata json.RawMessage
+			if existing, ok := existingVariantMap[variant.Id]; ok {
+				existingMetadata = existing.Metadata
+			}
+			if model.Metadata, err = patchMetadata(existingMetadata, variant.Metadata); err != nil {
+				return err
+			}
+			if err := s.productRepo.UpdateVariant(ctx, tx, model); err != nil {
 				return err
 			}
 			delete(existingVariantMap, variant.Id)
 		}
 	}
 
-	// 3. Delete variants that weren't included in the update
+	// 3. Delete variants that weren't included in the update, except the
+	// default variant carrying the price and SKU of the product
 	for variantID := range existingVariantMap {
+		if defaultVariantID != nil && variantID == *defaultVariantID {
+			continue
+		}
 		if err := s.productRepo.DeleteVariant(ctx, tx, variantID); err != nil {
 			return err
 		}
@@ -1142,7 +1212,7 @@ func (s *ProductService) DeleteProduct(ctx context.Context, req *pb.DeleteProduc
 
 	// Delete product (cascade will handle variants and attributes)
 	if err := s.productRepo.DeleteProduct(ctx, req.Id); err != nil {
-		if err == models.ErrProductNotFound {
+		if errors.Is(err, models.ErrProductNotFound) {
 			return nil, status.Error(codes.NotFound, "product not found")
 		}
 		return nil, status.Errorf(codes.Internal, "failed to delete product: %v", err)
@@ -1152,6 +1222,7 @@ func (s *ProductService) DeleteProduct(ctx context.Context, req *pb.DeleteProduc
 	if err := s.cacheManager.InvalidateProductAndRelated(ctx, req.Id); err != nil {
 		s.logger.Warn("Failed to invalidate caches", zap.String("id", req.Id), zap.Error(err))
 	}
+	s.publishProductEvent(ctx, events.ProductDeleted, req.Id)
 
 	return &pb.DeleteProductResponse{Success: true}, nil
 }
@@ -1232,7 +1303,7 @@ func (s *ProductService) GetBrand(ctx context.Context, req *pb.GetBrandRequest)
 
 	if err != nil {
 		// Differentiate between not found and other errors
-		if err.Error() == "brand not found" { // Assuming repo returns this specific error string
+		if errors.Is(err, models.ErrBrandNotFound) {
 			s.logger.Warn("Brand not found in DB", zap.Any("identifier", req.Identifier))
 			return nil, status.Errorf(codes.NotFound, "brand not found")
 		}
@@ -1330,10 +1401,13 @@ func (s *ProductService) CreateCategory(ctx context.Context, req *pb.CreateCateg
 		// Verify parent exists
 		parent, err := s.categoryRepo.GetCategoryByID(ctx, *category.ParentID)
 		if err != nil {
-			s.logger.Error("Parent category not found",
+			if errors.Is(err, models.ErrCategoryNotFound) {
+				return nil, status.Errorf(codes.NotFound, "parent category not found")
+			}
+			s.logger.Error("Failed to get parent category",
 				zap.String("parent_id", *category.ParentID),
 				zap.Error(err))
-			return nil, status.Errorf(codes.NotFound, "parent category not found")
+			return nil, status.Errorf(codes.Internal, "failed to get parent category: %v", err)
 		}
 		category.ParentName = parent.Name
 	}
@@ -1388,8 +1462,11 @@ func (s *ProductService) GetCategory(ctx context.Context, req *pb.GetCategoryReq
 	}
 
 	if err != nil {
+		if errors.Is(err, models.ErrCategoryNotFound) {
+			return nil, status.Errorf(codes.NotFound, "category not found")
+		}
 		s.logger.Error("Failed to get category", zap.Error(err))
-		return nil, status.Errorf(codes.NotFound, "category not found")
+		return nil, status.Errorf(codes.Internal, "failed to get category: %v", err)
 	}
 
 	// Cache the result
@@ -1463,6 +1540,7 @@ func convertCategoryModelToProto(model *models.Category) *pb.Category {
 		Slug:        model.Slug,
 		Description: model.Description,
 		ParentName:  model.ParentName,
+		Position:    int32(model.Position),
 		CreatedAt:   timestamppb.New(model.CreatedAt),
 		UpdatedAt:   timestamppb.New(model.UpdatedAt),
 	}
@@ -1529,9 +1607,16 @@ func (s *ProductService) populateProductRelations(ctx context.Context, product *
 		product.Variants[i].InheritFromProduct(product)
 	}
 
-	// Use the first variant's data for backward compatibility
+	// The price and SKU of the product are those of its default variant,
+	// or of its first variant until the pricing backfill sets one
 	if len(variants) > 0 {
-		defaultVariant := variants[0] // Use first variant
+		defaultVariant := variants[0]
+		for _, v := range variants {
+			if product.DefaultVariantID != nil && v.ID == *product.DefaultVariantID {
+				defaultVariant = v
+				break
+			}
+		}
 
 		// Copy default variant's values to product's transient fields
 		product.Price = models.Price{
@@ -1549,6 +1634,61 @@ func (s *ProductService) populateProductRelations(ctx context.Context, product *
 		product.SKU = defaultVariant.SKU
 	}
 
+	// Get bundle components; bundles are priced from their components unless overridden
+	bundle, err := s.bundleRepo.GetBundleByProductID(ctx, product.ID)
+	if err != nil {
+		if !errors.Is(err, models.ErrBundleNotFound) {
+			s.logger.Error("Failed to get product bundle", zap.Error(err), zap.String("product_id", product.ID))
+		}
+		// Continue even if bundle fails to load
+	} else {
+		product.Bundle = bundle
+		product.Price = models.Price{
+			Amount:   bundle.Price(),
+			Currency: "USD", // Default currency
+		}
+	}
+
+	// Get the digital asset; products with an asset are delivered by download
+	asset, err := s.digitalRepo.GetDigitalAssetByProductID(ctx, product.ID)
+	if err != nil {
+		if !errors.Is(err, models.ErrDigitalAssetNotFound) {
+			s.logger.Error("Failed to get digital asset", zap.Error(err), zap.String("product_id", product.ID))
+		}
+		// Continue even if the digital asset fails to load
+	} else {
+		product.DigitalAsset = asset
+	}
+
+	// Get the subscription plan; subscription products are billed on a recurring basis
+	plan, err := s.subscriptionRepo.GetSubscriptionPlan(ctx, product.ID)
+	if err != nil {
+		if !errors.Is(err, models.ErrSubscriptionPlanNotFound) {
+			s.logger.Error("Failed to get subscription plan", zap.Error(err), zap.String("product_id", product.ID))
+		}
+		// Continue even if the subscription plan fails to load
+	} else {
+		product.Subscription = plan
+	}
+
+	// Get the personalization options buyers fill in when ordering
+	options, err := s.personalizationRepo.ListPersonalizationOptions(ctx, product.ID)
+	if err != nil {
+		s.logger.Error("Failed to get personalization options", zap.Error(err), zap.String("product_id", product.ID))
+		// Continue even if the personalization options fail to load
+	} else {
+		product.PersonalizationOptions = options
+	}
+
+	// Get the videos and 3D models shown next to the images
+	media, err := s.mediaRepo.ListProductMedia(ctx, product.ID)
+	if err != nil {
+		s.logger.Error("Failed to get product media", zap.Error(err), zap.String("product_id", product.ID))
+		// Continue even if the media fail to load
+	} else {
+		product.Media = media
+	}
+
 	// Get tags
 	tags, err := s.productRepo.GetProductTags(ctx, product.ID)
 	if err != nil {
@@ -1698,40 +1838,42 @@ func convertProtoToModelForUpdate(proto *pb.Product, model *models.Product) *mod
 	return model
 }
 
-func createDefaultVariant(product *models.Product) models.ProductVariant {
-	now := time.Now().UTC()
-
-	var discountPrice *float64
-	if product.DiscountPrice != nil {
-		discountPrice = &product.DiscountPrice.Amount
+// generateProductSKU generates a unique SKU for a product created without
+// one, from its brand and first category
+func (s *ProductService) generateProductSKU(ctx context.Context, product *models.Product, categories []*pb.Category) string {
+	var brandName string
+	if product.BrandID != nil {
+		brand, err := s.brandRepo.GetBrandByID(ctx, *product.BrandID)
+		if err == nil && brand != nil {
+			brandName = brand.Name
+		}
 	}
-
-	// Ensure we have a valid price
-	price := product.Price.Amount
-	if price <= 0 {
-		price = 9.99 // Default price if none provided
+	var categoryName string
+	if len(categories) > 0 {
+		categoryName = categories[0].Name
 	}
 
-	// Ensure we have a valid SKU
-	sku := product.SKU
-	if sku == "" {
-		// Just use a simple SKU format here
-		// The main unique SKU generation happens in the CreateProduct method
-		// where we have more context and can check for uniqueness
-		sku = utils.GenerateSKU(
-			"", // No brand info in this context
-			"", // No category info in this context
-			"", // No color info
-			"", // No size info
-		)
+	sku, err := utils.GenerateUniqueSKU(ctx, s.productRepo, brandName, categoryName, "", "", 5)
+	if err != nil {
+		s.logger.Warn("Failed to generate unique SKU, falling back to basic SKU generation", zap.Error(err))
+		sku = utils.GenerateSKU(brandName, categoryName, "", "")
 	}
+	s.logger.Info("Generated unique SKU for product", zap.String("product_id", product.ID), zap.String("sku", sku))
+	return sku
+}
 
-	return models.ProductVariant{
-		Title:         &product.Title,
-		SKU:           sku,
-		Price:         price,
-		DiscountPrice: discountPrice,
-		CreatedAt:     now,
-		UpdatedAt:     now,
+// convertProtoToVariantImages converts the images of a new proto variant
+func convertProtoToVariantImages(images []*pb.VariantImage) []models.VariantImage {
+	if len(images) == 0 {
+		return nil
+	}
+	converted := make([]models.VariantImage, len(images))
+	for i, img := range images {
+		converted[i] = models.VariantImage{
+			URL:      img.Url,
+			AltText:  img.AltText,
+			Position: int(img.Position),
+		}
 	}
+	return converted
 }
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"github.com/louai60/e-commerce_project/backend/product-service/validationhook"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ProductValidator checks products against the external validator a store
+// configured in its product_validation setting before they are saved. Stores
+// without one are not validated. While the validator is unavailable, the
+// failure policy of the store either lets the save through or refuses it.
+type ProductValidator struct {
+	settingsRepo repository.SettingsRepository
+	client       *validationhook.Client
+	logger       *zap.Logger
+}
+
+// NewProductValidator creates a new product validator
+func NewProductValidator(settingsRepo repository.SettingsRepository, client *validationhook.Client, logger *zap.Logger) *ProductValidator {
+	return &ProductValidator{
+		settingsRepo: settingsRepo,
+		client:       client,
+		logger:       logger,
+	}
+}
+
+// Validate checks a product about to be saved for operation, returning an
+// InvalidArgument status listing the violations of a rejected product and an
+// Unavailable status when the validator of a fail-closed store cannot answer
+func (v *ProductValidator) Validate(ctx context.Context, operation string, product *models.Product) error {
+	if v == nil {
+		return nil
+	}
+
+	config, err := v.loadConfig(ctx)
+	if err != nil {
+		v.logger.Error("Failed to load product validation setting", zap.Error(err))
+		return status.Errorf(codes.Internal, "failed to load product validation setting: %v", err)
+	}
+	if config == nil {
+		return nil
+	}
+
+	tenantID := tenant.FromContext(ctx)
+	endpoint := validationhook.Endpoint{
+		URL:     config.URL,
+		Secret:  config.Secret,
+		Timeout: time.Duration(config.TimeoutMS) * time.Millisecond,
+	}
+	resp, err := v.client.Validate(ctx, endpoint, validationhook.Request{
+		Operation: operation,
+		TenantID:  tenantID,
+		Product:   product,
+		SentAt:    time.Now().UTC(),
+	})
+	if err != nil {
+		if config.FailurePolicy == models.ValidationFailClosed {
+			v.logger.Warn("Product validator unavailable, refusing save",
+				zap.String("tenant_id", tenantID), zap.String("product_id", product.ID), zap.Error(err))
+			return status.Error(codes.Unavailable, "product validator is unavailable, try again later")
+		}
+		v.logger.Warn("Product validator unavailable, saving without validation",
+			zap.String("tenant_id", tenantID), zap.String("product_id", product.ID), zap.Error(err))
+		return nil
+	}
+	if resp.Rejected() {
+		v.logger.Info("Product rejected by validator",
+			zap.String("tenant_id", tenantID), zap.String("product_id", product.ID), zap.Int("violations", len(resp.Violations)))
+		return status.Errorf(codes.InvalidArgument, "product failed validation: %s", resp.Summary())
+	}
+	return nil
+}
+
+// loadConfig returns the validator of the store of the request, nil when it
+// has none
+func (v *ProductValidator) loadConfig(ctx context.Context) (*models.ProductValidation, error) {
+	settings, err := v.settingsRepo.ListSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, setting := range settings {
+		if setting.Key != models.SettingProductValidation {
+			continue
+		}
+		var config models.ProductValidation
+		if err := json.Unmarshal(setting.Value, &config); err != nil {
+			v.logger.Warn("Ignoring malformed setting", zap.String("key", setting.Key), zap.Error(err))
+			return nil, nil
+		}
+		return &config, nil
+	}
+	return nil, nil
+}
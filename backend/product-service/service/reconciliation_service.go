@@ -0,0 +1,286 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	reconciliationPageSize     = 500
+	defaultReconciliationLimit = 20
+	maxReconciliationLimit     = 200
+	// Reorder settings of inventory items created by reconciliation, matching
+	// the defaults used when products are created through the gateway
+	reconciliationReorderPoint    = 5
+	reconciliationReorderQuantity = 20
+)
+
+// ReconciliationService cross-checks product SKUs against the inventory items
+// of the inventory service and optionally creates the missing items
+type ReconciliationService struct {
+	reconciliationRepo repository.ReconciliationRepository
+	storeRepo          repository.StoreRepository
+	productService     *ProductService
+	logger             *zap.Logger
+
+	// running prevents overlapping runs of the scheduler and manual triggers
+	running sync.Mutex
+}
+
+// NewReconciliationService creates a new inventory reconciliation service
+func NewReconciliationService(
+	reconciliationRepo repository.ReconciliationRepository,
+	storeRepo repository.StoreRepository,
+	productService *ProductService,
+	logger *zap.Logger,
+) *ReconciliationService {
+	return &ReconciliationService{
+		reconciliationRepo: reconciliationRepo,
+		storeRepo:          storeRepo,
+		productService:     productService,
+		logger:             logger,
+	}
+}
+
+// Reconcile compares the stocked SKUs of the store in ctx with its inventory
+// items and records the result. With autoCreate, missing inventory items are
+// created with zero quantity.
+func (s *ReconciliationService) Reconcile(ctx context.Context, autoCreate bool) (*models.InventoryReconciliation, error) {
+	if s.productService.inventoryClient == nil {
+		return nil, status.Error(codes.FailedPrecondition, "inventory service is not configured")
+	}
+	if !s.running.TryLock() {
+		return nil, status.Error(codes.Aborted, "an inventory reconciliation is already running")
+	}
+	defer s.running.Unlock()
+
+	result := &models.InventoryReconciliation{AutoCreate: autoCreate, StartedAt: time.Now().UTC()}
+
+	stocked, err := s.reconciliationRepo.ListStockedSKUs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.SKUsChecked = len(stocked)
+	stockedSKUs := make(map[string]bool, len(stocked))
+	for _, sku := range stocked {
+		stockedSKUs[sku.SKU] = true
+	}
+
+	// Inventory items are matched to products by SKU
+	inventorySKUs := make(map[string]bool)
+	for page := 1; ; page++ {
+		items, total, err := s.productService.inventoryClient.ListInventoryItems(ctx, page, reconciliationPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			result.InventoryItemsChecked++
+			inventorySKUs[item.Sku] = true
+			if !stockedSKUs[item.Sku] {
+				itemID, productID := item.Id, item.ProductId
+				result.Entries = append(result.Entries, models.ReconciliationEntry{
+					Kind:            models.ReconciliationOrphaned,
+					SKU:             item.Sku,
+					ProductID:       &productID,
+					InventoryItemID: &itemID,
+				})
+				result.OrphanedCount++
+			}
+		}
+		if len(items) == 0 || page*reconciliationPageSize >= total {
+			break
+		}
+	}
+
+	for _, sku := range stocked {
+		if inventorySKUs[sku.SKU] {
+			continue
+		}
+		productID := sku.ProductID
+		entry := models.ReconciliationEntry{
+			Kind:      models.ReconciliationMissing,
+			SKU:       sku.SKU,
+			ProductID: &productID,
+			VariantID: sku.VariantID,
+		}
+		result.MissingCount++
+
+		if autoCreate {
+			item, err := s.productService.inventoryClient.CreateInventoryItem(
+				ctx, sku.ProductID, sku.SKU, sku.VariantID, 0, reconciliationReorderPoint, reconciliationReorderQuantity)
+			if err != nil {
+				message := err.Error()
+				entry.Error = &message
+			} else {
+				entry.Created = true
+				entry.InventoryItemID = &item.Id
+				result.CreatedCount++
+			}
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+
+	result.FinishedAt = time.Now().UTC()
+	if err := s.reconciliationRepo.SaveReconciliation(ctx, result); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Inventory reconciliation finished",
+		zap.String("tenant_id", tenant.FromContext(ctx)),
+		zap.Int("skus_checked", result.SKUsChecked),
+		zap.Int("inventory_items_checked", result.InventoryItemsChecked),
+		zap.Int("missing", result.MissingCount),
+		zap.Int("orphaned", result.OrphanedCount),
+		zap.Int("created", result.CreatedCount))
+
+	return result, nil
+}
+
+// StartReconciliationScheduler reconciles every active store at the given
+// interval until the context is cancelled
+func (s *ReconciliationService) StartReconciliationScheduler(ctx context.Context, interval time.Duration, autoCreate bool) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Inventory reconciliation scheduler stopped")
+				return
+			case <-ticker.C:
+				s.reconcileAllStores(ctx, autoCreate)
+			}
+		}
+	}()
+}
+
+func (s *ReconciliationService) reconcileAllStores(ctx context.Context, autoCreate bool) {
+	stores, err := s.storeRepo.ListStores(ctx)
+	if err != nil {
+		s.logger.Error("Scheduled inventory reconciliation failed", zap.Error(err))
+		return
+	}
+
+	for _, shop := range stores {
+		if !shop.IsActive {
+			continue
+		}
+		if _, err := s.Reconcile(tenant.WithTenant(ctx, shop.ID), autoCreate); err != nil {
+			s.logger.Error("Scheduled inventory reconciliation failed", zap.String("tenant_id", shop.ID), zap.Error(err))
+		}
+	}
+}
+
+// RunInventoryReconciliation reconciles the current store right away
+func (s *ReconciliationService) RunInventoryReconciliation(ctx context.Context, req *pb.RunInventoryReconciliationRequest) (*pb.InventoryReconciliation, error) {
+	result, err := s.Reconcile(ctx, req.AutoCreate)
+	if err != nil {
+		return nil, s.reconciliationError("Failed to reconcile inventory", err)
+	}
+	return convertReconciliationToProto(result), nil
+}
+
+// GetInventoryReconciliation returns a reconciliation with its entries, or
+// the most recent one when no ID is given
+func (s *ReconciliationService) GetInventoryReconciliation(ctx context.Context, req *pb.GetInventoryReconciliationRequest) (*pb.InventoryReconciliation, error) {
+	id := req.Id
+	if id == "" {
+		latest, err := s.reconciliationRepo.ListReconciliations(ctx, 1)
+		if err != nil {
+			return nil, s.reconciliationError("Failed to get inventory reconciliation", err)
+		}
+		if len(latest) == 0 {
+			return nil, status.Error(codes.NotFound, "no inventory reconciliation has run yet")
+		}
+		id = latest[0].ID
+	} else if _, err := uuid.Parse(id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reconciliation ID")
+	}
+
+	result, err := s.reconciliationRepo.GetReconciliation(ctx, id)
+	if err != nil {
+		return nil, s.reconciliationError("Failed to get inventory reconciliation", err)
+	}
+	return convertReconciliationToProto(result), nil
+}
+
+// ListInventoryReconciliations returns reconciliation summaries, most recent first
+func (s *ReconciliationService) ListInventoryReconciliations(ctx context.Context, req *pb.ListInventoryReconciliationsRequest) (*pb.ListInventoryReconciliationsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultReconciliationLimit
+	}
+	if limit > maxReconciliationLimit {
+		limit = maxReconciliationLimit
+	}
+
+	list, err := s.reconciliationRepo.ListReconciliations(ctx, limit)
+	if err != nil {
+		return nil, s.reconciliationError("Failed to list inventory reconciliations", err)
+	}
+
+	resp := &pb.ListInventoryReconciliationsResponse{Reconciliations: make([]*pb.InventoryReconciliation, len(list))}
+	for i, result := range list {
+		resp.Reconciliations[i] = convertReconciliationToProto(result)
+	}
+	return resp, nil
+}
+
+func (s *ReconciliationService) reconciliationError(message string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, models.ErrReconciliationNotFound) {
+		return status.Error(codes.NotFound, "inventory reconciliation not found")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertReconciliationToProto(result *models.InventoryReconciliation) *pb.InventoryReconciliation {
+	proto := &pb.InventoryReconciliation{
+		Id:                    result.ID,
+		AutoCreate:            result.AutoCreate,
+		SkusChecked:           int32(result.SKUsChecked),
+		InventoryItemsChecked: int32(result.InventoryItemsChecked),
+		MissingCount:          int32(result.MissingCount),
+		OrphanedCount:         int32(result.OrphanedCount),
+		CreatedCount:          int32(result.CreatedCount),
+		StartedAt:             timestamppb.New(result.StartedAt),
+		FinishedAt:            timestamppb.New(result.FinishedAt),
+	}
+	for _, entry := range result.Entries {
+		protoEntry := &pb.ReconciliationEntry{
+			Kind:    entry.Kind,
+			Sku:     entry.SKU,
+			Created: entry.Created,
+		}
+		if entry.ProductID != nil {
+			protoEntry.ProductId = *entry.ProductID
+		}
+		if entry.VariantID != nil {
+			protoEntry.VariantId = *entry.VariantID
+		}
+		if entry.InventoryItemID != nil {
+			protoEntry.InventoryItemId = *entry.InventoryItemID
+		}
+		if entry.Error != nil {
+			protoEntry.Error = *entry.Error
+		}
+		proto.Entries = append(proto.Entries, protoEntry)
+	}
+	return proto
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxRelatedLimit bounds the linked products of each type a product detail
+// response may ask for
+const maxRelatedLimit = 50
+
+// RelationshipService manages the typed links between products, such as
+// cross-sells and accessories, and attaches the linked products to product
+// details
+type RelationshipService struct {
+	relRepo        repository.RelationshipRepository
+	productService *ProductService
+	detailLimit    int
+	logger         *zap.Logger
+}
+
+// NewRelationshipService creates a new relationship service. Product details
+// carry up to detailLimit linked products of each type unless a request asks
+// for another limit.
+func NewRelationshipService(relRepo repository.RelationshipRepository, productService *ProductService, detailLimit int, logger *zap.Logger) *RelationshipService {
+	return &RelationshipService{
+		relRepo:        relRepo,
+		productService: productService,
+		detailLimit:    detailLimit,
+		logger:         logger,
+	}
+}
+
+// CreateRelationship links a product to another one
+func (s *RelationshipService) CreateRelationship(ctx context.Context, req *pb.CreateProductRelationshipRequest) (*pb.ProductRelationship, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	if _, err := uuid.Parse(req.RelatedProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid related product ID")
+	}
+	if req.ProductId == req.RelatedProductId {
+		return nil, status.Error(codes.InvalidArgument, "a product cannot be linked to itself")
+	}
+	if !models.IsValidRelationshipType(req.Type) {
+		return nil, status.Error(codes.InvalidArgument, "type must be one of cross_sell, up_sell, accessory or replacement")
+	}
+	if req.Position < 0 {
+		return nil, status.Error(codes.InvalidArgument, "position cannot be negative")
+	}
+
+	rel := &models.ProductRelationship{
+		ProductID:        req.ProductId,
+		RelatedProductID: req.RelatedProductId,
+		Type:             req.Type,
+		Position:         int(req.Position),
+	}
+	if err := s.relRepo.CreateRelationship(ctx, rel); err != nil {
+		return nil, s.relationshipError("Failed to create product relationship", err)
+	}
+
+	s.logger.Info("Products linked",
+		zap.String("product_id", rel.ProductID),
+		zap.String("related_product_id", rel.RelatedProductID),
+		zap.String("type", rel.Type))
+
+	// Read the link back for the title and state of the linked product
+	created, err := s.relRepo.GetRelationship(ctx, rel.ProductID, rel.ID)
+	if err != nil {
+		return nil, s.relationshipError("Failed to get product relationship", err)
+	}
+	return relationshipToProto(created), nil
+}
+
+// ListRelationships returns the links of a product, of one type or all
+func (s *RelationshipService) ListRelationships(ctx context.Context, req *pb.ListProductRelationshipsRequest) (*pb.ListProductRelationshipsResponse, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	if req.Type != "" && !models.IsValidRelationshipType(req.Type) {
+		return nil, status.Error(codes.InvalidArgument, "type must be one of cross_sell, up_sell, accessory or replacement")
+	}
+
+	relationships, err := s.relRepo.ListRelationships(ctx, req.ProductId, req.Type)
+	if err != nil {
+		return nil, s.relationshipError("Failed to list product relationships", err)
+	}
+
+	resp := &pb.ListProductRelationshipsResponse{Relationships: make([]*pb.ProductRelationship, len(relationships))}
+	for i, rel := range relationships {
+		resp.Relationships[i] = relationshipToProto(rel)
+	}
+	return resp, nil
+}
+
+// UpdateRelationship moves a link within its type
+func (s *RelationshipService) UpdateRelationship(ctx context.Context, req *pb.UpdateProductRelationshipRequest) (*pb.ProductRelationship, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid relationship ID")
+	}
+	if req.Position < 0 {
+		return nil, status.Error(codes.InvalidArgument, "position cannot be negative")
+	}
+
+	if err := s.relRepo.UpdateRelationshipPosition(ctx, req.ProductId, req.Id, int(req.Position)); err != nil {
+		return nil, s.relationshipError("Failed to update product relationship", err)
+	}
+	rel, err := s.relRepo.GetRelationship(ctx, req.ProductId, req.Id)
+	if err != nil {
+		return nil, s.relationshipError("Failed to get product relationship", err)
+	}
+	return relationshipToProto(rel), nil
+}
+
+// DeleteRelationship removes a link of a product
+func (s *RelationshipService) DeleteRelationship(ctx context.Context, req *pb.DeleteProductRelationshipRequest) (*pb.DeleteProductRelationshipResponse, error) {
+	if _, err := uuid.Parse(req.ProductId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid product ID")
+	}
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid relationship ID")
+	}
+
+	if err := s.relRepo.DeleteRelationship(ctx, req.ProductId, req.Id); err != nil {
+		return nil, s.relationshipError("Failed to delete product relationship", err)
+	}
+	s.logger.Info("Product relationship removed",
+		zap.String("product_id", req.ProductId),
+		zap.String("relationship_id", req.Id))
+	return &pb.DeleteProductRelationshipResponse{Success: true}, nil
+}
+
+// AttachRelated adds the published products linked to a product to its
+// details, up to limit of each type. A zero limit uses the configured one and
+// a negative limit leaves them out. Related products are best effort: a
+// failure to load them is logged and the product is returned without them.
+func (s *RelationshipService) AttachRelated(ctx context.Context, product *pb.Product, limit int32) {
+	n := int(limit)
+	if n == 0 {
+		n = s.detailLimit
+	}
+	if n <= 0 || product == nil {
+		return
+	}
+	if n > maxRelatedLimit {
+		n = maxRelatedLimit
+	}
+
+	relationships, err := s.relRepo.ListPublishedRelated(ctx, product.Id, n)
+	if err != nil {
+		s.logger.Warn("Failed to list related products",
+			zap.String("product_id", product.Id),
+			zap.Error(err))
+		return
+	}
+
+	related := make([]*pb.RelatedProduct, 0, len(relationships))
+	for _, rel := range relationships {
+		linked, err := s.productService.GetProduct(ctx, &pb.GetProductRequest{
+			Identifier: &pb.GetProductRequest_Id{Id: rel.RelatedProductID},
+		})
+		if err != nil {
+			s.logger.Warn("Failed to load related product",
+				zap.String("product_id", product.Id),
+				zap.String("related_product_id", rel.RelatedProductID),
+				zap.Error(err))
+			continue
+		}
+		related = append(related, &pb.RelatedProduct{
+			RelationshipId: rel.ID,
+			Type:           rel.Type,
+			Position:       int32(rel.Position),
+			Product:        linked,
+		})
+	}
+	product.RelatedProducts = related
+}
+
+func (s *RelationshipService) relationshipError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, models.ErrRelationshipNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrRelationshipExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func relationshipToProto(rel *models.ProductRelationship) *pb.ProductRelationship {
+	return &pb.ProductRelationship{
+		Id:                 rel.ID,
+		ProductId:          rel.ProductID,
+		RelatedProductId:   rel.RelatedProductID,
+		Type:               rel.Type,
+		Position:           int32(rel.Position),
+		RelatedTitle:       rel.RelatedTitle,
+		RelatedSlug:        rel.RelatedSlug,
+		RelatedIsPublished: rel.RelatedIsPublished,
+		CreatedAt:          timestamppb.New(rel.CreatedAt),
+		UpdatedAt:          timestamppb.New(rel.UpdatedAt),
+	}
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxSavedSearchNameLength  = 100
+	maxSavedSearchQueryLength = 200
+	// maxSavedSearchesPerUser bounds the searches each user saves
+	maxSavedSearchesPerUser = 20
+	// maxSavedSearchMatches bounds the products an evaluation diffs; alerts
+	// are only raised on the cheapest matches of broad searches
+	maxSavedSearchMatches = 500
+	// savedSearchEvaluationBatch bounds the searches evaluated per run
+	savedSearchEvaluationBatch = 200
+
+	defaultSavedSearchAlertLimit = 100
+)
+
+// SavedSearchService manages the search queries users save and the alerts
+// they opt into. A periodic job evaluates the searches with alerts, diffs
+// their results against those of the previous evaluation and records an
+// alert for each product that started matching and each product whose price
+// went below the threshold of the search. The notification service polls
+// the alerts and acknowledges them once delivered.
+type SavedSearchService struct {
+	savedSearchRepo repository.SavedSearchRepository
+	logger          *zap.Logger
+}
+
+// NewSavedSearchService creates a new saved search service
+func NewSavedSearchService(savedSearchRepo repository.SavedSearchRepository, logger *zap.Logger) *SavedSearchService {
+	return &SavedSearchService{
+		savedSearchRepo: savedSearchRepo,
+		logger:          logger,
+	}
+}
+
+// SaveSearch creates a saved search of a user, or replaces one
+func (s *SavedSearchService) SaveSearch(ctx context.Context, req *pb.SaveSearchRequest) (*pb.SavedSearch, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if req.Id != "" {
+		if _, err := uuid.Parse(req.Id); err != nil {
+			return nil, status.Error(codes.NotFound, models.ErrSavedSearchNotFound.Error())
+		}
+	}
+	search, err := savedSearchFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if search.ID == "" {
+		existing, err := s.savedSearchRepo.ListSavedSearches(ctx, search.UserID)
+		if err != nil {
+			return nil, s.savedSearchError("Failed to list saved searches", err)
+		}
+		if len(existing) >= maxSavedSearchesPerUser {
+			return nil, status.Errorf(codes.ResourceExhausted, "cannot save more than %d searches", maxSavedSearchesPerUser)
+		}
+	}
+
+	if err := s.savedSearchRepo.SaveSearch(ctx, search); err != nil {
+		return nil, s.savedSearchError("Failed to save search", err)
+	}
+
+	s.logger.Info("Search saved",
+		zap.String("id", search.ID),
+		zap.String("user_id", search.UserID),
+		zap.Bool("alerts", search.HasAlerts()))
+	return savedSearchToProto(search), nil
+}
+
+// ListSavedSearches returns the saved searches of a user
+func (s *SavedSearchService) ListSavedSearches(ctx context.Context, req *pb.ListSavedSearchesRequest) (*pb.ListSavedSearchesResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	searches, err := s.savedSearchRepo.ListSavedSearches(ctx, req.UserId)
+	if err != nil {
+		return nil, s.savedSearchError("Failed to list saved searches", err)
+	}
+
+	resp := &pb.ListSavedSearchesResponse{SavedSearches: make([]*pb.SavedSearch, len(searches))}
+	for i, search := range searches {
+		resp.SavedSearches[i] = savedSearchToProto(search)
+	}
+	return resp, nil
+}
+
+// DeleteSavedSearch deletes a saved search of a user
+func (s *SavedSearchService) DeleteSavedSearch(ctx context.Context, req *pb.DeleteSavedSearchRequest) (*pb.DeleteSavedSearchResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.NotFound, models.ErrSavedSearchNotFound.Error())
+	}
+
+	if err := s.savedSearchRepo.DeleteSavedSearch(ctx, req.Id, req.UserId); err != nil {
+		return nil, s.savedSearchError("Failed to delete saved search", err)
+	}
+	return &pb.DeleteSavedSearchResponse{Success: true}, nil
+}
+
+// EvaluateSavedSearches evaluates up to a batch of the saved searches with
+// alerts of all stores that were not evaluated within the interval, and
+// returns the number of alerts recorded
+func (s *SavedSearchService) EvaluateSavedSearches(ctx context.Context, interval time.Duration) (int, error) {
+	searches, err := s.savedSearchRepo.ListSavedSearchesToEvaluate(ctx, time.Now().Add(-interval), savedSearchEvaluationBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	alerted := 0
+	for _, search := range searches {
+		count, err := s.evaluate(tenant.WithTenant(ctx, search.TenantID), search)
+		if err != nil {
+			if errors.Is(err, models.ErrSavedSearchNotFound) {
+				continue
+			}
+			s.logger.Error("Failed to evaluate saved search", zap.Error(err), zap.String("id", search.ID))
+			continue
+		}
+		alerted += count
+	}
+	return alerted, nil
+}
+
+// evaluate diffs the results of a saved search against those of its
+// previous evaluation. The first evaluation records the results the alerts
+// are raised against, without alerts.
+func (s *SavedSearchService) evaluate(ctx context.Context, search *models.SavedSearch) (int, error) {
+	matches, err := s.savedSearchRepo.FindSavedSearchMatches(ctx, search, maxSavedSearchMatches)
+	if err != nil {
+		return 0, err
+	}
+
+	var alerts []*models.SavedSearchAlert
+	if search.LastEvaluatedAt != nil {
+		previous, err := s.savedSearchRepo.GetSavedSearchMatches(ctx, search.ID)
+		if err != nil {
+			return 0, err
+		}
+		alerts = diffSavedSearchMatches(search, previous, matches)
+	}
+
+	if err := s.savedSearchRepo.SaveSavedSearchEvaluation(ctx, search, matches, alerts); err != nil {
+		return 0, err
+	}
+	if len(alerts) > 0 {
+		s.logger.Info("Saved search alerts recorded",
+			zap.String("id", search.ID),
+			zap.String("tenant_id", search.TenantID),
+			zap.Int("count", len(alerts)))
+	}
+	return len(alerts), nil
+}
+
+// diffSavedSearchMatches returns the alerts raised by the current matches
+// of a search given the prices of its previous matches. A price drop is
+// only reported as the price crosses the threshold, so a product staying
+// below it is reported once.
+func diffSavedSearchMatches(search *models.SavedSearch, previous map[string]float64, matches []models.SavedSearchMatch) []*models.SavedSearchAlert {
+	var alerts []*models.SavedSearchAlert
+	for _, match := range matches {
+		previousPrice, matched := previous[match.ProductID]
+		alert := &models.SavedSearchAlert{
+			ProductID:    match.ProductID,
+			ProductTitle: match.Title,
+			Price:        match.Price,
+		}
+
+		switch {
+		case search.PriceAlertBelow != nil && match.Price < *search.PriceAlertBelow &&
+			(!matched || previousPrice >= *search.PriceAlertBelow):
+			alert.Type = models.SavedSearchAlertPriceDrop
+			if matched {
+				alert.PreviousPrice = &previousPrice
+			}
+		case !matched && search.AlertNewProducts:
+			alert.Type = models.SavedSearchAlertNewProduct
+		default:
+			continue
+		}
+		alerts = append(alerts, alert)
+	}
+	return alerts
+}
+
+// StartSavedSearchScheduler evaluates the saved searches with alerts at the
+// given interval until the context is cancelled
+func (s *SavedSearchService) StartSavedSearchScheduler(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Saved search scheduler stopped")
+				return
+			case <-ticker.C:
+				// Searches evaluated a little less than an interval ago are
+				// due, as the ticks drift from the evaluation times
+				count, err := s.EvaluateSavedSearches(ctx, interval-interval/10)
+				if err != nil {
+					s.logger.Error("Scheduled saved search evaluation failed", zap.Error(err))
+				} else if count > 0 {
+					s.logger.Info("Recorded saved search alerts", zap.Int("count", count))
+				}
+			}
+		}
+	}()
+}
+
+// ListSavedSearchAlerts returns alerts the notification service has not
+// acknowledged yet
+func (s *SavedSearchService) ListSavedSearchAlerts(ctx context.Context, req *pb.ListSavedSearchAlertsRequest) (*pb.ListSavedSearchAlertsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSavedSearchAlertLimit
+	}
+
+	alerts, err := s.savedSearchRepo.ListPendingSavedSearchAlerts(ctx, limit)
+	if err != nil {
+		return nil, s.savedSearchError("Failed to list saved search alerts", err)
+	}
+
+	resp := &pb.ListSavedSearchAlertsResponse{Alerts: make([]*pb.SavedSearchAlert, len(alerts))}
+	for i, alert := range alerts {
+		resp.Alerts[i] = savedSearchAlertToProto(alert)
+	}
+	return resp, nil
+}
+
+// AckSavedSearchAlerts marks alerts as delivered by the notification service
+func (s *SavedSearchService) AckSavedSearchAlerts(ctx context.Context, req *pb.AckSavedSearchAlertsRequest) (*pb.AckSavedSearchAlertsResponse, error) {
+	for _, id := range req.AlertIds {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid alert ID %q", id)
+		}
+	}
+
+	count, err := s.savedSearchRepo.MarkSavedSearchAlertsDelivered(ctx, req.AlertIds)
+	if err != nil {
+		return nil, s.savedSearchError("Failed to acknowledge saved search alerts", err)
+	}
+	return &pb.AckSavedSearchAlertsResponse{Acknowledged: int32(count)}, nil
+}
+
+func (s *SavedSearchService) savedSearchError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrSavedSearchNotFound),
+		errors.Is(err, models.ErrCategoryNotFound),
+		errors.Is(err, models.ErrBrandNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func savedSearchFromRequest(req *pb.SaveSearchRequest) (*models.SavedSearch, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if utf8.RuneCountInString(name) > maxSavedSearchNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "name cannot exceed %d characters", maxSavedSearchNameLength)
+	}
+	query := strings.TrimSpace(req.Query)
+	if utf8.RuneCountInString(query) > maxSavedSearchQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query cannot exceed %d characters", maxSavedSearchQueryLength)
+	}
+	if req.CategoryId != "" {
+		if _, err := uuid.Parse(req.CategoryId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid category ID")
+		}
+	}
+	if req.BrandId != "" {
+		if _, err := uuid.Parse(req.BrandId); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid brand ID")
+		}
+	}
+	if req.MinPrice < 0 || req.MaxPrice < 0 || req.PriceAlertBelow < 0 {
+		return nil, status.Error(codes.InvalidArgument, "prices cannot be negative")
+	}
+	if req.MaxPrice > 0 && req.MinPrice > req.MaxPrice {
+		return nil, status.Error(codes.InvalidArgument, "min_price cannot exceed max_price")
+	}
+
+	return &models.SavedSearch{
+		ID:               req.Id,
+		UserID:           req.UserId,
+		Name:             name,
+		Query:            query,
+		CategoryID:       req.CategoryId,
+		BrandID:          req.BrandId,
+		MinPrice:         optionalPrice(req.MinPrice),
+		MaxPrice:         optionalPrice(req.MaxPrice),
+		AlertNewProducts: req.AlertNewProducts,
+		PriceAlertBelow:  optionalPrice(req.PriceAlertBelow),
+	}, nil
+}
+
+// optionalPrice maps the zero price of the proto messages to no price
+func optionalPrice(price float64) *float64 {
+	if price == 0 {
+		return nil
+	}
+	return &price
+}
+
+func savedSearchToProto(search *models.SavedSearch) *pb.SavedSearch {
+	proto := &pb.SavedSearch{
+		Id:               search.ID,
+		UserId:           search.UserID,
+		Name:             search.Name,
+		Query:            search.Query,
+		CategoryId:       search.CategoryID,
+		BrandId:          search.BrandID,
+		AlertNewProducts: search.AlertNewProducts,
+		CreatedAt:        timestamppb.New(search.CreatedAt),
+		UpdatedAt:        timestamppb.New(search.UpdatedAt),
+	}
+	if search.MinPrice != nil {
+		proto.MinPrice = *search.MinPrice
+	}
+	if search.MaxPrice != nil {
+		proto.MaxPrice = *search.MaxPrice
+	}
+	if search.PriceAlertBelow != nil {
+		proto.PriceAlertBelow = *search.PriceAlertBelow
+	}
+	if search.LastEvaluatedAt != nil {
+		proto.LastEvaluatedAt = timestamppb.New(*search.LastEvaluatedAt)
+	}
+	return proto
+}
+
+func savedSearchAlertToProto(alert *models.SavedSearchAlert) *pb.SavedSearchAlert {
+	proto := &pb.SavedSearchAlert{
+		Id:              alert.ID,
+		TenantId:        alert.TenantID,
+		SavedSearchId:   alert.SavedSearchID,
+		SavedSearchName: alert.SavedSearchName,
+		UserId:          alert.UserID,
+		Type:            alert.Type,
+		ProductId:       alert.ProductID,
+		ProductTitle:    alert.ProductTitle,
+		Price:           alert.Price,
+		CreatedAt:       timestamppb.New(alert.CreatedAt),
+	}
+	if alert.PreviousPrice != nil {
+		proto.PreviousPrice = *alert.PreviousPrice
+	}
+	return proto
+}
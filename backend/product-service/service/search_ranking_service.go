@@ -0,0 +1,342 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/ranking"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	maxSearchQueryLength = 200
+	maxSearchTerms       = 10
+	maxRankingRuleName   = 100
+	defaultSearchLimit   = 20
+	maxSearchLimit       = 100
+	defaultPreviewLimit  = 50
+	// maxSearchCandidates bounds the products ranked per search; deeper
+	// pages of broad searches are not served
+	maxSearchCandidates = 500
+)
+
+// SearchRankingService searches the published products of the store and
+// ranks the results by their relevance adjusted by the merchandising rules
+// of the store. Rules are saved as drafts, which only previews apply, until
+// they are published.
+type SearchRankingService struct {
+	rankingRepo repository.SearchRankingRepository
+	logger      *zap.Logger
+}
+
+// NewSearchRankingService creates a new search ranking service
+func NewSearchRankingService(rankingRepo repository.SearchRankingRepository, logger *zap.Logger) *SearchRankingService {
+	return &SearchRankingService{
+		rankingRepo: rankingRepo,
+		logger:      logger,
+	}
+}
+
+// SearchProducts returns a page of the products matching a search, ranked
+// with the published rules
+func (s *SearchRankingService) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	page, limit := int(req.Page), int(req.Limit)
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 || limit > maxSearchLimit {
+		limit = defaultSearchLimit
+	}
+
+	results, hits, err := s.rank(ctx, req.Query, req.CategoryId, models.SearchRankingPublished)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SearchProductsResponse{Results: []*pb.SearchResult{}, Total: int32(len(results))}
+	for i := (page - 1) * limit; i < len(results) && i < page*limit; i++ {
+		resp.Results = append(resp.Results, searchResultToProto(hits[results[i].ProductID], results[i].Score))
+	}
+	return resp, nil
+}
+
+// PreviewSearchRanking ranks the first results of a search with the
+// published and draft rules, showing the position each product had by
+// relevance alone and the rules that moved it
+func (s *SearchRankingService) PreviewSearchRanking(ctx context.Context, req *pb.PreviewSearchRankingRequest) (*pb.PreviewSearchRankingResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 || limit > maxSearchLimit {
+		limit = defaultPreviewLimit
+	}
+
+	results, hits, err := s.rank(ctx, req.Query, req.CategoryId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.PreviewSearchRankingResponse{Results: []*pb.RankedSearchResult{}}
+	for i := 0; i < len(results) && i < limit; i++ {
+		result := results[i]
+		resp.Results = append(resp.Results, &pb.RankedSearchResult{
+			Result:       searchResultToProto(hits[result.ProductID], result.Score),
+			Relevance:    result.Relevance,
+			Position:     int32(i + 1),
+			BasePosition: int32(result.BasePosition),
+			Pinned:       result.Pinned,
+			RuleIds:      result.RuleIDs,
+		})
+	}
+	return resp, nil
+}
+
+// SaveSearchRankingRule creates a draft rule, or replaces a rule as a draft
+func (s *SearchRankingService) SaveSearchRankingRule(ctx context.Context, req *pb.SaveSearchRankingRuleRequest) (*pb.SearchRankingRule, error) {
+	if req.Id != "" {
+		if _, err := uuid.Parse(req.Id); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid rule ID")
+		}
+	}
+	rule := &models.SearchRankingRule{
+		ID:         req.Id,
+		Name:       strings.TrimSpace(req.Name),
+		Kind:       req.Kind,
+		Query:      ranking.NormalizeQuery(req.Query),
+		BrandID:    req.BrandId,
+		CategoryID: req.CategoryId,
+		ProductID:  req.ProductId,
+		Weight:     req.Weight,
+		Position:   int(req.Position),
+	}
+	if err := validateSearchRankingRule(rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.rankingRepo.SaveRule(ctx, rule); err != nil {
+		return nil, s.searchRankingError("Failed to save search ranking rule", err)
+	}
+
+	s.logger.Info("Search ranking rule saved",
+		zap.String("id", rule.ID),
+		zap.String("kind", rule.Kind),
+		zap.String("query", rule.Query))
+	return searchRankingRuleToProto(rule), nil
+}
+
+// ListSearchRankingRules returns the rules of the store, of one status or all
+func (s *SearchRankingService) ListSearchRankingRules(ctx context.Context, req *pb.ListSearchRankingRulesRequest) (*pb.ListSearchRankingRulesResponse, error) {
+	if req.Status != "" && req.Status != models.SearchRankingDraft && req.Status != models.SearchRankingPublished {
+		return nil, status.Error(codes.InvalidArgument, "status must be draft or published")
+	}
+
+	rules, err := s.rankingRepo.ListRules(ctx, req.Status)
+	if err != nil {
+		return nil, s.searchRankingError("Failed to list search ranking rules", err)
+	}
+
+	resp := &pb.ListSearchRankingRulesResponse{Rules: make([]*pb.SearchRankingRule, len(rules))}
+	for i, rule := range rules {
+		resp.Rules[i] = searchRankingRuleToProto(rule)
+	}
+	return resp, nil
+}
+
+// DeleteSearchRankingRule removes a rule; published rules stop applying at
+// once
+func (s *SearchRankingService) DeleteSearchRankingRule(ctx context.Context, req *pb.DeleteSearchRankingRuleRequest) (*pb.DeleteSearchRankingRuleResponse, error) {
+	if _, err := uuid.Parse(req.Id); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid rule ID")
+	}
+
+	if err := s.rankingRepo.DeleteRule(ctx, req.Id); err != nil {
+		return nil, s.searchRankingError("Failed to delete search ranking rule", err)
+	}
+	s.logger.Info("Search ranking rule deleted", zap.String("id", req.Id))
+	return &pb.DeleteSearchRankingRuleResponse{Success: true}, nil
+}
+
+// PublishSearchRankingRules publishes draft rules, all of them unless IDs
+// are given, so that searches apply them
+func (s *SearchRankingService) PublishSearchRankingRules(ctx context.Context, req *pb.PublishSearchRankingRulesRequest) (*pb.PublishSearchRankingRulesResponse, error) {
+	for _, id := range req.Ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid rule ID %q", id)
+		}
+	}
+
+	published, err := s.rankingRepo.PublishRules(ctx, req.Ids)
+	if err != nil {
+		return nil, s.searchRankingError("Failed to publish search ranking rules", err)
+	}
+	s.logger.Info("Search ranking rules published", zap.Int("published", published))
+	return &pb.PublishSearchRankingRulesResponse{Published: int32(published)}, nil
+}
+
+// rank searches the products matching query and ranks them with the rules
+// of ruleStatus, or all rules when it is empty. It returns the ranked
+// results and the hits they were ranked from, keyed by product ID.
+func (s *SearchRankingService) rank(ctx context.Context, query, categoryID, ruleStatus string) ([]ranking.Result, map[string]*models.SearchHit, error) {
+	terms, err := searchTerms(query)
+	if err != nil {
+		return nil, nil, err
+	}
+	if categoryID != "" {
+		if _, err := uuid.Parse(categoryID); err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid category ID")
+		}
+	}
+
+	stored, err := s.rankingRepo.ListRules(ctx, ruleStatus)
+	if err != nil {
+		return nil, nil, s.searchRankingError("Failed to list search ranking rules", err)
+	}
+	normalized := ranking.NormalizeQuery(query)
+	rules := make([]ranking.Rule, len(stored))
+	var pinned []string
+	for i, rule := range stored {
+		rules[i] = ranking.Rule{
+			ID:         rule.ID,
+			Kind:       rule.Kind,
+			Query:      rule.Query,
+			BrandID:    rule.BrandID,
+			CategoryID: rule.CategoryID,
+			ProductID:  rule.ProductID,
+			Weight:     rule.Weight,
+			Position:   rule.Position,
+		}
+		if rule.Kind == ranking.KindPin && rules[i].AppliesTo(normalized) {
+			pinned = append(pinned, rule.ProductID)
+		}
+	}
+
+	found, err := s.rankingRepo.SearchProducts(ctx, terms, categoryID, pinned, maxSearchCandidates)
+	if err != nil {
+		return nil, nil, s.searchRankingError("Failed to search products", err)
+	}
+	hits := make(map[string]*models.SearchHit, len(found))
+	candidates := make([]ranking.Candidate, len(found))
+	for i, hit := range found {
+		hits[hit.ProductID] = hit
+		candidates[i] = ranking.Candidate{
+			ProductID:   hit.ProductID,
+			BrandID:     hit.BrandID,
+			CategoryIDs: hit.CategoryIDs,
+			Relevance:   hit.Relevance,
+		}
+	}
+	return ranking.Rank(normalized, candidates, rules), hits, nil
+}
+
+// searchTerms splits a query into its distinct lowercase words
+func searchTerms(query string) ([]string, error) {
+	if utf8.RuneCountInString(query) > maxSearchQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "query must be at most %d characters", maxSearchQueryLength)
+	}
+	var terms []string
+	seen := make(map[string]bool)
+	for _, term := range strings.Fields(ranking.NormalizeQuery(query)) {
+		if seen[term] {
+			continue
+		}
+		if len(terms) == maxSearchTerms {
+			return nil, status.Errorf(codes.InvalidArgument, "query must have at most %d words", maxSearchTerms)
+		}
+		seen[term] = true
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+func validateSearchRankingRule(rule *models.SearchRankingRule) error {
+	if rule.Name == "" || utf8.RuneCountInString(rule.Name) > maxRankingRuleName {
+		return status.Errorf(codes.InvalidArgument, "name is required and must be at most %d characters", maxRankingRuleName)
+	}
+	if utf8.RuneCountInString(rule.Query) > maxSearchQueryLength {
+		return status.Errorf(codes.InvalidArgument, "query must be at most %d characters", maxSearchQueryLength)
+	}
+	for _, id := range []string{rule.BrandID, rule.CategoryID, rule.ProductID} {
+		if id == "" {
+			continue
+		}
+		if _, err := uuid.Parse(id); err != nil {
+			return status.Error(codes.InvalidArgument, "brand_id, category_id and product_id must be UUIDs")
+		}
+	}
+
+	switch rule.Kind {
+	case ranking.KindBoost:
+		if rule.BrandID == "" && rule.CategoryID == "" && rule.ProductID == "" {
+			return status.Error(codes.InvalidArgument, "boosts need a brand_id, category_id or product_id")
+		}
+		if !(rule.Weight > 0) || rule.Weight > ranking.MaxWeight || math.IsInf(rule.Weight, 0) {
+			return status.Errorf(codes.InvalidArgument, "boosts need a weight above 0 and at most %d", ranking.MaxWeight)
+		}
+		rule.Position = 0
+	case ranking.KindPin:
+		if rule.ProductID == "" || rule.Query == "" || rule.Position < 1 {
+			return status.Error(codes.InvalidArgument, "pins need a product_id, a query and a position of 1 or more")
+		}
+		if rule.Position > maxSearchCandidates {
+			return status.Errorf(codes.InvalidArgument, "position must be at most %d", maxSearchCandidates)
+		}
+		rule.BrandID, rule.CategoryID, rule.Weight = "", "", 1
+	default:
+		return status.Error(codes.InvalidArgument, "kind must be boost or pin")
+	}
+	return nil
+}
+
+func (s *SearchRankingService) searchRankingError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrSearchRankingRuleNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, models.ErrBrandNotFound):
+		return status.Error(codes.NotFound, "brand not found")
+	case errors.Is(err, models.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, "category not found")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func searchResultToProto(hit *models.SearchHit, score float64) *pb.SearchResult {
+	return &pb.SearchResult{
+		ProductId: hit.ProductID,
+		Title:     hit.Title,
+		Slug:      hit.Slug,
+		Price:     hit.Price,
+		Score:     score,
+	}
+}
+
+func searchRankingRuleToProto(rule *models.SearchRankingRule) *pb.SearchRankingRule {
+	resp := &pb.SearchRankingRule{
+		Id:         rule.ID,
+		Name:       rule.Name,
+		Kind:       rule.Kind,
+		Query:      rule.Query,
+		BrandId:    rule.BrandID,
+		CategoryId: rule.CategoryID,
+		ProductId:  rule.ProductID,
+		Weight:     rule.Weight,
+		Position:   int32(rule.Position),
+		Status:     rule.Status,
+		CreatedAt:  timestamppb.New(rule.CreatedAt),
+		UpdatedAt:  timestamppb.New(rule.UpdatedAt),
+	}
+	if rule.PublishedAt != nil {
+		resp.PublishedAt = timestamppb.New(*rule.PublishedAt)
+	}
+	return resp
+}
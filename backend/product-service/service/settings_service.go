@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SettingsInvalidationChannel is the Redis channel carrying the IDs of the
+// stores whose settings changed, so that every replica drops its cached
+// storefront config
+const SettingsInvalidationChannel = "store_settings:invalidate"
+
+// SettingsService manages the typed site-wide settings of each store, such
+// as its branding, support contacts and the currencies and locales it
+// offers. The storefront config merges the public settings with the store
+// defaults; it is cached per store until a change is announced on
+// SettingsInvalidationChannel, or for the cache TTL when one is missed.
+type SettingsService struct {
+	settingsRepo repository.SettingsRepository
+	storeRepo    repository.StoreRepository
+	redis        *redis.Client
+	ttl          time.Duration
+	logger       *zap.Logger
+
+	mu    sync.RWMutex
+	cache map[string]cachedStorefrontConfig
+}
+
+type cachedStorefrontConfig struct {
+	config    *pb.StorefrontConfig
+	expiresAt time.Time
+}
+
+// NewSettingsService creates a new settings service. A nil Redis client
+// keeps invalidations local to this replica.
+func NewSettingsService(settingsRepo repository.SettingsRepository, storeRepo repository.StoreRepository, redisClient *redis.Client, ttl time.Duration, logger *zap.Logger) *SettingsService {
+	return &SettingsService{
+		settingsRepo: settingsRepo,
+		storeRepo:    storeRepo,
+		redis:        redisClient,
+		ttl:          ttl,
+		logger:       logger,
+		cache:        make(map[string]cachedStorefrontConfig),
+	}
+}
+
+// Start drops the cached config of the stores announced by any replica until
+// the context is cancelled
+func (s *SettingsService) Start(ctx context.Context) {
+	if s.redis == nil {
+		return
+	}
+
+	pubsub := s.redis.Subscribe(ctx, SettingsInvalidationChannel)
+	go func() {
+		defer pubsub.Close()
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				s.evict(msg.Payload)
+			}
+		}
+	}()
+}
+
+// Invalidate drops the cached config of a store on every replica, after its
+// settings or the store itself changed
+func (s *SettingsService) Invalidate(ctx context.Context, tenantID string) {
+	s.evict(tenantID)
+	if s.redis == nil {
+		return
+	}
+	if err := s.redis.Publish(ctx, SettingsInvalidationChannel, tenantID).Err(); err != nil {
+		s.logger.Warn("Failed to announce settings change, other replicas serve it after the cache TTL",
+			zap.String("tenant_id", tenantID), zap.Error(err))
+	}
+}
+
+func (s *SettingsService) evict(tenantID string) {
+	s.mu.Lock()
+	delete(s.cache, tenantID)
+	s.mu.Unlock()
+}
+
+// ListSettings returns every known setting of the store, set or not
+func (s *SettingsService) ListSettings(ctx context.Context, req *pb.ListSettingsRequest) (*pb.ListSettingsResponse, error) {
+	settings, err := s.loadSettings(ctx)
+	if err != nil {
+		return nil, s.settingsError("Failed to list settings", err)
+	}
+
+	resp := &pb.ListSettingsResponse{Settings: make([]*pb.Setting, len(models.SettingDefinitions))}
+	for i, def := range models.SettingDefinitions {
+		resp.Settings[i] = settingToProto(def, settings[def.Key])
+	}
+	return resp, nil
+}
+
+// SetSetting validates and stores the value of a setting
+func (s *SettingsService) SetSetting(ctx context.Context, req *pb.SetSettingRequest) (*pb.Setting, error) {
+	def, ok := models.LookupSetting(req.Key)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, models.ErrUnknownSetting.Error())
+	}
+	if !json.Valid([]byte(req.Value)) {
+		return nil, status.Error(codes.InvalidArgument, "value must be JSON")
+	}
+	value, err := def.Normalize(json.RawMessage(req.Value))
+	if err != nil {
+		return nil, s.settingsError("Failed to validate setting", err)
+	}
+
+	setting := &models.Setting{Key: def.Key, Value: value, UpdatedBy: req.UpdatedBy}
+	if err := s.settingsRepo.SetSetting(ctx, setting); err != nil {
+		return nil, s.settingsError("Failed to set setting", err)
+	}
+	s.Invalidate(ctx, tenant.FromContext(ctx))
+
+	s.logger.Info("Setting updated", zap.String("key", setting.Key), zap.String("updated_by", setting.UpdatedBy))
+	return settingToProto(def, setting), nil
+}
+
+// DeleteSetting unsets a setting, falling back to its default
+func (s *SettingsService) DeleteSetting(ctx context.Context, req *pb.DeleteSettingRequest) (*pb.DeleteSettingResponse, error) {
+	if _, ok := models.LookupSetting(req.Key); !ok {
+		return nil, status.Error(codes.InvalidArgument, models.ErrUnknownSetting.Error())
+	}
+	if err := s.settingsRepo.DeleteSetting(ctx, req.Key); err != nil {
+		return nil, s.settingsError("Failed to delete setting", err)
+	}
+	s.Invalidate(ctx, tenant.FromContext(ctx))
+
+	s.logger.Info("Setting reset", zap.String("key", req.Key))
+	return &pb.DeleteSettingResponse{Success: true}, nil
+}
+
+// GetStorefrontConfig returns the public configuration of the store of the
+// request, from the cache when fresh
+func (s *SettingsService) GetStorefrontConfig(ctx context.Context, req *pb.GetStorefrontConfigRequest) (*pb.StorefrontConfig, error) {
+	tenantID := tenant.FromContext(ctx)
+	s.mu.RLock()
+	cached, ok := s.cache[tenantID]
+	s.mu.RUnlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return proto.Clone(cached.config).(*pb.StorefrontConfig), nil
+	}
+
+	store, err := s.storeRepo.GetStore(ctx, tenantID)
+	if err != nil {
+		return nil, s.settingsError("Failed to get store", err)
+	}
+	settings, err := s.loadSettings(ctx)
+	if err != nil {
+		return nil, s.settingsError("Failed to load settings", err)
+	}
+	config := s.buildStorefrontConfig(store, settings)
+
+	s.mu.Lock()
+	s.cache[tenantID] = cachedStorefrontConfig{config: config, expiresAt: time.Now().Add(s.ttl)}
+	s.mu.Unlock()
+	return proto.Clone(config).(*pb.StorefrontConfig), nil
+}
+
+// loadSettings returns the settings set for the store of the request by key
+func (s *SettingsService) loadSettings(ctx context.Context) (map[string]*models.Setting, error) {
+	settings, err := s.settingsRepo.ListSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*models.Setting, len(settings))
+	for _, setting := range settings {
+		byKey[setting.Key] = setting
+	}
+	return byKey, nil
+}
+
+// buildStorefrontConfig merges the public settings of a store with its
+// defaults. The default currency and locale always come first among the
+// active ones, so that changing them never leaves them inactive.
+func (s *SettingsService) buildStorefrontConfig(store *models.Store, settings map[string]*models.Setting) *pb.StorefrontConfig {
+	config := &pb.StorefrontConfig{
+		StoreId:          store.ID,
+		StoreName:        store.Name,
+		DefaultCurrency:  store.DefaultCurrency,
+		DefaultLocale:    store.DefaultLocale,
+		ActiveCurrencies: []string{store.DefaultCurrency},
+		ActiveLocales:    []string{store.DefaultLocale},
+		Branding:         &pb.StoreBranding{},
+	}
+
+	decode := func(key string, v any) bool {
+		setting, ok := settings[key]
+		if !ok {
+			return false
+		}
+		if err := json.Unmarshal(setting.Value, v); err != nil {
+			s.logger.Warn("Ignoring malformed setting", zap.String("store_id", store.ID), zap.String("key", key), zap.Error(err))
+			return false
+		}
+		return true
+	}
+
+	var branding models.Branding
+	if decode(models.SettingBranding, &branding) {
+		config.Branding = &pb.StoreBranding{
+			LogoUrl:        branding.LogoURL,
+			FaviconUrl:     branding.FaviconURL,
+			PrimaryColor:   branding.PrimaryColor,
+			SecondaryColor: branding.SecondaryColor,
+		}
+	}
+	decode(models.SettingSupportEmail, &config.SupportEmail)
+	decode(models.SettingSupportPhone, &config.SupportPhone)
+
+	var active []string
+	if decode(models.SettingActiveCurrencies, &active) {
+		config.ActiveCurrencies = appendMissing(config.ActiveCurrencies, active)
+	}
+	active = nil
+	if decode(models.SettingActiveLocales, &active) {
+		config.ActiveLocales = appendMissing(config.ActiveLocales, active)
+	}
+	return config
+}
+
+func (s *SettingsService) settingsError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrSettingNotFound), errors.Is(err, models.ErrStoreNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, apperrors.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// appendMissing appends the values not in list yet
+func appendMissing(list, values []string) []string {
+	for _, value := range values {
+		found := false
+		for _, existing := range list {
+			if existing == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			list = append(list, value)
+		}
+	}
+	return list
+}
+
+func settingToProto(def models.SettingDefinition, setting *models.Setting) *pb.Setting {
+	proto := &pb.Setting{
+		Key:         def.Key,
+		Public:      def.Public,
+		Description: def.Description,
+	}
+	if setting != nil {
+		proto.Value = string(setting.Value)
+		proto.IsSet = true
+		proto.UpdatedBy = setting.UpdatedBy
+		proto.UpdatedAt = timestamppb.New(setting.UpdatedAt)
+	}
+	return proto
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StoreService handles business logic for stores, the tenants catalog data
+// is scoped to
+type StoreService struct {
+	storeRepo repository.StoreRepository
+	logger    *zap.Logger
+}
+
+// NewStoreService creates a new store service
+func NewStoreService(storeRepo repository.StoreRepository, logger *zap.Logger) *StoreService {
+	return &StoreService{
+		storeRepo: storeRepo,
+		logger:    logger,
+	}
+}
+
+// CreateStore adds a new storefront. The ID becomes the tenant ID used by the
+// gateway and the services, so it cannot be changed later.
+func (s *StoreService) CreateStore(ctx context.Context, req *pb.CreateStoreRequest) (*pb.Store, error) {
+	store := &models.Store{
+		ID:              req.Id,
+		Name:            req.Name,
+		Domain:          optionalDomain(req.Domain),
+		DefaultCurrency: strings.ToUpper(req.DefaultCurrency),
+		DefaultLocale:   req.DefaultLocale,
+		IsActive:        true,
+	}
+	if store.DefaultCurrency == "" {
+		store.DefaultCurrency = "USD"
+	}
+	if store.DefaultLocale == "" {
+		store.DefaultLocale = "en"
+	}
+
+	if err := s.storeRepo.CreateStore(ctx, store); err != nil {
+		return nil, s.storeError("Failed to create store", err)
+	}
+
+	s.logger.Info("Store created", zap.String("id", store.ID), zap.String("name", store.Name))
+	return convertStoreModelToProto(store), nil
+}
+
+// GetStore returns a storefront by its ID
+func (s *StoreService) GetStore(ctx context.Context, req *pb.GetStoreRequest) (*pb.Store, error) {
+	store, err := s.storeRepo.GetStore(ctx, req.Id)
+	if err != nil {
+		return nil, s.storeError("Failed to get store", err)
+	}
+	return convertStoreModelToProto(store), nil
+}
+
+// ListStores returns all storefronts
+func (s *StoreService) ListStores(ctx context.Context, req *pb.ListStoresRequest) (*pb.ListStoresResponse, error) {
+	stores, err := s.storeRepo.ListStores(ctx)
+	if err != nil {
+		return nil, s.storeError("Failed to list stores", err)
+	}
+
+	resp := &pb.ListStoresResponse{Stores: make([]*pb.Store, len(stores))}
+	for i, store := range stores {
+		resp.Stores[i] = convertStoreModelToProto(store)
+	}
+	return resp, nil
+}
+
+// UpdateStore changes the name, domain, defaults or active flag of a storefront
+func (s *StoreService) UpdateStore(ctx context.Context, req *pb.UpdateStoreRequest) (*pb.Store, error) {
+	store, err := s.storeRepo.GetStore(ctx, req.Id)
+	if err != nil {
+		return nil, s.storeError("Failed to get store", err)
+	}
+
+	if req.Id == tenant.DefaultTenantID && !req.IsActive {
+		return nil, status.Error(codes.FailedPrecondition, "the default store cannot be deactivated")
+	}
+
+	if req.Name != "" {
+		store.Name = req.Name
+	}
+	if req.DefaultCurrency != "" {
+		store.DefaultCurrency = strings.ToUpper(req.DefaultCurrency)
+	}
+	if req.DefaultLocale != "" {
+		store.DefaultLocale = req.DefaultLocale
+	}
+	store.Domain = optionalDomain(req.Domain)
+	store.IsActive = req.IsActive
+
+	if err := s.storeRepo.UpdateStore(ctx, store); err != nil {
+		return nil, s.storeError("Failed to update store", err)
+	}
+
+	s.logger.Info("Store updated", zap.String("id", store.ID), zap.Bool("is_active", store.IsActive))
+	return convertStoreModelToProto(store), nil
+}
+
+func (s *StoreService) storeError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrStoreNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrStoreExists):
+		return status.Error(codes.AlreadyExists, "a store with this ID or domain already exists")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// optionalDomain normalizes a store domain, returning nil when none is set
+func optionalDomain(domain string) *string {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	if domain == "" {
+		return nil
+	}
+	return &domain
+}
+
+func convertStoreModelToProto(model *models.Store) *pb.Store {
+	if model == nil {
+		return nil
+	}
+	store := &pb.Store{
+		Id:              model.ID,
+		Name:            model.Name,
+		DefaultCurrency: model.DefaultCurrency,
+		DefaultLocale:   model.DefaultLocale,
+		IsActive:        model.IsActive,
+		CreatedAt:       timestamppb.New(model.CreatedAt),
+		UpdatedAt:       timestamppb.New(model.UpdatedAt),
+	}
+	if model.Domain != nil {
+		store.Domain = *model.Domain
+	}
+	return store
+}
@@ -0,0 +1,429 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultSubscriptionEventLimit is the number of billing events returned when no limit is requested
+	defaultSubscriptionEventLimit = 100
+	// defaultSubscriptionLimit and maxSubscriptionLimit bound the page size of subscription lists
+	defaultSubscriptionLimit = 20
+	maxSubscriptionLimit     = 100
+)
+
+// SubscriptionService handles business logic for subscription products: their
+// billing plans, customer subscriptions and the billing events consumed by the
+// payment service
+type SubscriptionService struct {
+	subscriptionRepo repository.SubscriptionRepository
+	productService   *ProductService
+	logger           *zap.Logger
+}
+
+// NewSubscriptionService creates a new subscription service
+func NewSubscriptionService(subscriptionRepo repository.SubscriptionRepository, productService *ProductService, logger *zap.Logger) *SubscriptionService {
+	return &SubscriptionService{
+		subscriptionRepo: subscriptionRepo,
+		productService:   productService,
+		logger:           logger,
+	}
+}
+
+// SetSubscriptionPlan makes a product a subscription product or changes its
+// plan. Running subscriptions pick up the new interval at their next renewal.
+func (s *SubscriptionService) SetSubscriptionPlan(ctx context.Context, req *pb.SetSubscriptionPlanRequest) (*pb.SubscriptionPlan, error) {
+	if _, err := s.productService.bundleRepo.GetBundleByProductID(ctx, req.ProductId); err == nil {
+		return nil, status.Error(codes.InvalidArgument, "bundles cannot be subscription products")
+	}
+
+	plan := &models.SubscriptionPlan{
+		ProductID:     req.ProductId,
+		Interval:      req.Interval,
+		IntervalCount: int(req.IntervalCount),
+		TrialDays:     int(req.TrialDays),
+	}
+	if plan.IntervalCount == 0 {
+		plan.IntervalCount = 1
+	}
+
+	if err := s.subscriptionRepo.UpsertSubscriptionPlan(ctx, plan); err != nil {
+		return nil, s.subscriptionError("Failed to save subscription plan", err)
+	}
+
+	// The cached product does not know about its plan yet
+	if err := s.productService.cacheManager.InvalidateProduct(ctx, req.ProductId); err != nil {
+		s.logger.Warn("Failed to invalidate subscription product cache",
+			zap.String("product_id", req.ProductId),
+			zap.Error(err))
+	}
+
+	s.logger.Info("Subscription plan saved",
+		zap.String("product_id", plan.ProductID),
+		zap.String("interval", plan.Interval),
+		zap.Int("interval_count", plan.IntervalCount),
+		zap.Int("trial_days", plan.TrialDays))
+
+	return convertSubscriptionPlanModelToProto(plan), nil
+}
+
+// CreateSubscription starts a subscription for a purchase of a subscription
+// product. The price is fixed at the current product price and the first
+// period starts with the trial, if the plan has one.
+func (s *SubscriptionService) CreateSubscription(ctx context.Context, req *pb.CreateSubscriptionRequest) (*pb.Subscription, error) {
+	plan, err := s.subscriptionRepo.GetSubscriptionPlan(ctx, req.ProductId)
+	if err != nil {
+		return nil, s.subscriptionError("Failed to get subscription plan", err)
+	}
+
+	product, err := s.productService.GetProduct(ctx, &pb.GetProductRequest{
+		Identifier: &pb.GetProductRequest_Id{Id: req.ProductId},
+	})
+	if err != nil {
+		return nil, err
+	}
+	price := product.Price
+	if product.DiscountPrice != nil {
+		price = product.DiscountPrice.Value
+	}
+
+	currency := strings.ToUpper(req.Currency)
+	if currency == "" {
+		currency = "USD"
+	}
+
+	now := time.Now().UTC()
+	sub := &models.Subscription{
+		ProductID:          req.ProductId,
+		UserID:             req.UserId,
+		PurchaseID:         req.PurchaseId,
+		Status:             models.SubscriptionStatusActive,
+		Price:              price,
+		Currency:           currency,
+		CurrentPeriodStart: now,
+		CurrentPeriodEnd:   plan.PeriodEnd(now),
+	}
+	if plan.TrialDays > 0 {
+		trialEndsAt := now.AddDate(0, 0, plan.TrialDays)
+		sub.Status = models.SubscriptionStatusTrialing
+		sub.CurrentPeriodEnd = trialEndsAt
+		sub.TrialEndsAt = &trialEndsAt
+	}
+
+	// Nothing is due during a trial; otherwise the purchase pays the first period
+	event := &models.SubscriptionEvent{
+		Type:        models.SubscriptionEventCreated,
+		Currency:    currency,
+		PeriodStart: &sub.CurrentPeriodStart,
+		PeriodEnd:   &sub.CurrentPeriodEnd,
+	}
+
+	if err := s.subscriptionRepo.CreateSubscription(ctx, sub, event); err != nil {
+		return nil, s.subscriptionError("Failed to create subscription", err)
+	}
+
+	s.logger.Info("Subscription created",
+		zap.String("id", sub.ID),
+		zap.String("product_id", sub.ProductID),
+		zap.String("purchase_id", sub.PurchaseID),
+		zap.String("status", sub.Status))
+
+	return convertSubscriptionModelToProto(sub), nil
+}
+
+// GetSubscription retrieves a subscription by ID
+func (s *SubscriptionService) GetSubscription(ctx context.Context, req *pb.GetSubscriptionRequest) (*pb.Subscription, error) {
+	sub, err := s.subscriptionRepo.GetSubscriptionByID(ctx, req.Id)
+	if err != nil {
+		return nil, s.subscriptionError("Failed to get subscription", err)
+	}
+	return convertSubscriptionModelToProto(sub), nil
+}
+
+// CancelSubscription cancels a subscription right away, or at the end of the
+// current period so the customer keeps what was already paid for
+func (s *SubscriptionService) CancelSubscription(ctx context.Context, req *pb.CancelSubscriptionRequest) (*pb.Subscription, error) {
+	sub, err := s.subscriptionRepo.GetSubscriptionByID(ctx, req.Id)
+	if err != nil {
+		return nil, s.subscriptionError("Failed to get subscription", err)
+	}
+	if sub.Status == models.SubscriptionStatusCancelled {
+		return nil, status.Error(codes.FailedPrecondition, models.ErrSubscriptionCancelled.Error())
+	}
+
+	var event *models.SubscriptionEvent
+	if req.AtPeriodEnd {
+		// The renewal job cancels the subscription once the period is over
+		sub.CancelAtPeriodEnd = true
+	} else {
+		now := time.Now().UTC()
+		sub.Status = models.SubscriptionStatusCancelled
+		sub.CancelledAt = &now
+		event = &models.SubscriptionEvent{
+			Type:     models.SubscriptionEventCancelled,
+			Currency: sub.Currency,
+		}
+	}
+
+	if err := s.subscriptionRepo.UpdateSubscriptionState(ctx, sub, sub.CurrentPeriodEnd, event); err != nil {
+		if errors.Is(err, models.ErrSubscriptionNotFound) {
+			return nil, status.Error(codes.Aborted, "subscription changed concurrently, please retry")
+		}
+		return nil, s.subscriptionError("Failed to cancel subscription", err)
+	}
+
+	s.logger.Info("Subscription cancelled",
+		zap.String("id", sub.ID),
+		zap.Bool("at_period_end", req.AtPeriodEnd))
+
+	return convertSubscriptionModelToProto(sub), nil
+}
+
+// ListSubscriptions lists subscriptions, optionally of one user or in one status
+func (s *SubscriptionService) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSubscriptionLimit
+	}
+	if limit > maxSubscriptionLimit {
+		limit = maxSubscriptionLimit
+	}
+	offset := 0
+	if req.Page > 1 {
+		offset = int(req.Page-1) * limit
+	}
+	filter := models.SubscriptionFilter{UserID: req.UserId, Status: req.Status}
+
+	subscriptions, total, err := s.subscriptionRepo.ListSubscriptions(ctx, filter, offset, limit)
+	if err != nil {
+		return nil, s.subscriptionError("Failed to list subscriptions", err)
+	}
+
+	resp := &pb.ListSubscriptionsResponse{
+		Subscriptions: make([]*pb.Subscription, len(subscriptions)),
+		Total:         int32(total),
+	}
+	for i, sub := range subscriptions {
+		resp.Subscriptions[i] = convertSubscriptionModelToProto(sub)
+	}
+	return resp, nil
+}
+
+// RenewDueSubscriptions moves every subscription whose period has ended into
+// its next period and records a renewal_due event for the payment service.
+// Subscriptions flagged to cancel at the end of their period are cancelled
+// instead. It returns the number of subscriptions processed.
+func (s *SubscriptionService) RenewDueSubscriptions(ctx context.Context, now time.Time, batchSize int) (int, error) {
+	processed := 0
+	for {
+		due, err := s.subscriptionRepo.ListDueSubscriptions(ctx, now, batchSize)
+		if err != nil {
+			return processed, err
+		}
+
+		renewedInBatch := 0
+		for _, sub := range due {
+			if err := s.renewSubscription(tenant.WithTenant(ctx, sub.TenantID), sub, now); err != nil {
+				// Another instance got there first; it will be picked up as done
+				if errors.Is(err, models.ErrSubscriptionNotFound) {
+					continue
+				}
+				s.logger.Error("Failed to renew subscription", zap.Error(err), zap.String("id", sub.ID))
+				continue
+			}
+			renewedInBatch++
+		}
+		processed += renewedInBatch
+
+		// Stop when the batch was the last one, or when nothing in it could be
+		// processed so the same failing rows are not retried in a loop
+		if len(due) < batchSize || renewedInBatch == 0 {
+			return processed, nil
+		}
+	}
+}
+
+func (s *SubscriptionService) renewSubscription(ctx context.Context, sub *models.Subscription, now time.Time) error {
+	previousPeriodEnd := sub.CurrentPeriodEnd
+
+	if sub.CancelAtPeriodEnd {
+		sub.Status = models.SubscriptionStatusCancelled
+		sub.CancelledAt = &now
+		return s.subscriptionRepo.UpdateSubscriptionState(ctx, sub, previousPeriodEnd, &models.SubscriptionEvent{
+			Type:     models.SubscriptionEventCancelled,
+			Currency: sub.Currency,
+		})
+	}
+
+	plan, err := s.subscriptionRepo.GetSubscriptionPlan(ctx, sub.ProductID)
+	if err != nil {
+		return err
+	}
+
+	// Catch up on missed periods, e.g. after downtime, charging only the current one
+	start := previousPeriodEnd
+	end := plan.PeriodEnd(start)
+	for !end.After(now) {
+		start = end
+		end = plan.PeriodEnd(start)
+	}
+
+	sub.Status = models.SubscriptionStatusActive
+	sub.CurrentPeriodStart = start
+	sub.CurrentPeriodEnd = end
+	event := &models.SubscriptionEvent{
+		Type:        models.SubscriptionEventRenewalDue,
+		Amount:      sub.Price,
+		Currency:    sub.Currency,
+		PeriodStart: &start,
+		PeriodEnd:   &end,
+	}
+	if err := s.subscriptionRepo.UpdateSubscriptionState(ctx, sub, previousPeriodEnd, event); err != nil {
+		return err
+	}
+
+	s.logger.Info("Subscription renewed",
+		zap.String("id", sub.ID),
+		zap.Time("period_end", end),
+		zap.Float64("amount", sub.Price))
+	return nil
+}
+
+// StartRenewalScheduler renews due subscriptions at the given interval until
+// the context is cancelled
+func (s *SubscriptionService) StartRenewalScheduler(ctx context.Context, interval time.Duration, batchSize int) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				s.logger.Info("Subscription renewal scheduler stopped")
+				return
+			case <-ticker.C:
+				count, err := s.RenewDueSubscriptions(ctx, time.Now().UTC(), batchSize)
+				if err != nil {
+					s.logger.Error("Scheduled subscription renewal failed", zap.Error(err))
+				} else if count > 0 {
+					s.logger.Info("Renewed subscriptions", zap.Int("count", count))
+				}
+			}
+		}
+	}()
+}
+
+// ListSubscriptionEvents returns billing events the payment service has not
+// acknowledged yet
+func (s *SubscriptionService) ListSubscriptionEvents(ctx context.Context, req *pb.ListSubscriptionEventsRequest) (*pb.ListSubscriptionEventsResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = defaultSubscriptionEventLimit
+	}
+
+	events, err := s.subscriptionRepo.ListPendingSubscriptionEvents(ctx, limit)
+	if err != nil {
+		return nil, s.subscriptionError("Failed to list subscription events", err)
+	}
+
+	resp := &pb.ListSubscriptionEventsResponse{Events: make([]*pb.SubscriptionEvent, len(events))}
+	for i, event := range events {
+		resp.Events[i] = convertSubscriptionEventModelToProto(event)
+	}
+	return resp, nil
+}
+
+// AckSubscriptionEvents marks billing events as handled by the payment service
+func (s *SubscriptionService) AckSubscriptionEvents(ctx context.Context, req *pb.AckSubscriptionEventsRequest) (*pb.AckSubscriptionEventsResponse, error) {
+	count, err := s.subscriptionRepo.MarkSubscriptionEventsDelivered(ctx, req.EventIds)
+	if err != nil {
+		return nil, s.subscriptionError("Failed to acknowledge subscription events", err)
+	}
+	return &pb.AckSubscriptionEventsResponse{Acknowledged: int32(count)}, nil
+}
+
+// subscriptionError logs a repository error and maps it to a gRPC status
+func (s *SubscriptionService) subscriptionError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrSubscriptionPlanNotFound):
+		return status.Error(codes.FailedPrecondition, "product is not a subscription product")
+	case errors.Is(err, models.ErrSubscriptionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrSubscriptionExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertSubscriptionPlanModelToProto(model *models.SubscriptionPlan) *pb.SubscriptionPlan {
+	if model == nil {
+		return nil
+	}
+	return &pb.SubscriptionPlan{
+		ProductId:     model.ProductID,
+		Interval:      model.Interval,
+		IntervalCount: int32(model.IntervalCount),
+		TrialDays:     int32(model.TrialDays),
+		CreatedAt:     timestamppb.New(model.CreatedAt),
+		UpdatedAt:     timestamppb.New(model.UpdatedAt),
+	}
+}
+
+func convertSubscriptionModelToProto(model *models.Subscription) *pb.Subscription {
+	sub := &pb.Subscription{
+		Id:                 model.ID,
+		ProductId:          model.ProductID,
+		UserId:             model.UserID,
+		PurchaseId:         model.PurchaseID,
+		Status:             model.Status,
+		Price:              model.Price,
+		Currency:           model.Currency,
+		CurrentPeriodStart: timestamppb.New(model.CurrentPeriodStart),
+		CurrentPeriodEnd:   timestamppb.New(model.CurrentPeriodEnd),
+		CancelAtPeriodEnd:  model.CancelAtPeriodEnd,
+		CreatedAt:          timestamppb.New(model.CreatedAt),
+		UpdatedAt:          timestamppb.New(model.UpdatedAt),
+	}
+	if model.TrialEndsAt != nil {
+		sub.TrialEndsAt = timestamppb.New(*model.TrialEndsAt)
+	}
+	if model.CancelledAt != nil {
+		sub.CancelledAt = timestamppb.New(*model.CancelledAt)
+	}
+	return sub
+}
+
+func convertSubscriptionEventModelToProto(model *models.SubscriptionEvent) *pb.SubscriptionEvent {
+	event := &pb.SubscriptionEvent{
+		Id:             model.ID,
+		SubscriptionId: model.SubscriptionID,
+		Type:           model.Type,
+		UserId:         model.UserID,
+		ProductId:      model.ProductID,
+		Amount:         model.Amount,
+		Currency:       model.Currency,
+		CreatedAt:      timestamppb.New(model.CreatedAt),
+	}
+	if model.PeriodStart != nil {
+		event.PeriodStart = timestamppb.New(*model.PeriodStart)
+	}
+	if model.PeriodEnd != nil {
+		event.PeriodEnd = timestamppb.New(*model.PeriodEnd)
+	}
+	return event
+}
@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/common/i18n"
+	"github.com/louai60/e-commerce_project/backend/common/locale"
+	"github.com/louai60/e-commerce_project/backend/product-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/product-service/proto"
+	"github.com/louai60/e-commerce_project/backend/product-service/repository"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxTranslationNameLength bounds translated titles and names, in characters
+const maxTranslationNameLength = 255
+
+// TranslationService manages the translations of products, categories and
+// storefront content and applies them to the responses of requests made in
+// another locale
+type TranslationService struct {
+	translationRepo repository.TranslationRepository
+	logger          *zap.Logger
+}
+
+// NewTranslationService creates a new translation service
+func NewTranslationService(translationRepo repository.TranslationRepository, logger *zap.Logger) *TranslationService {
+	return &TranslationService{
+		translationRepo: translationRepo,
+		logger:          logger,
+	}
+}
+
+// SetTranslation creates the translation of a product, category, page or
+// banner to a locale or replaces it. Locales are stored lowercased.
+func (s *TranslationService) SetTranslation(ctx context.Context, req *pb.SetTranslationRequest) (*pb.Translation, error) {
+	if req.Translation == nil {
+		return nil, status.Error(codes.InvalidArgument, "translation is required")
+	}
+	entityType, entityID, tag, err := translationKey(req.Translation.EntityType, req.Translation.EntityId, req.Translation.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	translation := &models.Translation{
+		EntityType:       entityType,
+		EntityID:         entityID,
+		Locale:           tag,
+		Name:             strings.TrimSpace(req.Translation.Name),
+		ShortDescription: strings.TrimSpace(req.Translation.ShortDescription),
+		Description:      strings.TrimSpace(req.Translation.Description),
+	}
+	if translation.Name == "" && translation.ShortDescription == "" && translation.Description == "" {
+		return nil, status.Error(codes.InvalidArgument, "translation has no content")
+	}
+	if utf8.RuneCountInString(translation.Name) > maxTranslationNameLength {
+		return nil, status.Errorf(codes.InvalidArgument, "name cannot exceed %d characters", maxTranslationNameLength)
+	}
+	if entityType == models.TranslationEntityCategory && translation.ShortDescription != "" {
+		return nil, status.Error(codes.InvalidArgument, "categories have no short description")
+	}
+	if entityType == models.TranslationEntityBanner && translation.Description != "" {
+		return nil, status.Error(codes.InvalidArgument, "banners have no description")
+	}
+
+	if err := s.translationRepo.SaveTranslation(ctx, translation); err != nil {
+		return nil, s.translationError("Failed to save translation", err)
+	}
+
+	s.logger.Info("Translation saved",
+		zap.String("entity_type", entityType),
+		zap.String("entity_id", entityID),
+		zap.String("locale", tag))
+	return translationToProto(translation), nil
+}
+
+// ListTranslations returns the translations of a product, category, page or
+// banner
+func (s *TranslationService) ListTranslations(ctx context.Context, req *pb.ListTranslationsRequest) (*pb.ListTranslationsResponse, error) {
+	if !models.IsTranslationEntity(req.EntityType) {
+		return nil, status.Error(codes.InvalidArgument, "entity_type must be product, category, page or banner")
+	}
+	if _, err := uuid.Parse(req.EntityId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid entity ID")
+	}
+
+	translations, err := s.translationRepo.ListTranslations(ctx, req.EntityType, req.EntityId)
+	if err != nil {
+		return nil, s.translationError("Failed to list translations", err)
+	}
+
+	resp := &pb.ListTranslationsResponse{Translations: make([]*pb.Translation, len(translations))}
+	for i, translation := range translations {
+		resp.Translations[i] = translationToProto(translation)
+	}
+	return resp, nil
+}
+
+// DeleteTranslation removes the translation of a product, category, page or
+// banner to a
+// locale
+func (s *TranslationService) DeleteTranslation(ctx context.Context, req *pb.DeleteTranslationRequest) (*pb.DeleteTranslationResponse, error) {
+	entityType, entityID, tag, err := translationKey(req.EntityType, req.EntityId, req.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.translationRepo.DeleteTranslation(ctx, entityType, entityID, tag); err != nil {
+		return nil, s.translationError("Failed to delete translation", err)
+	}
+	return &pb.DeleteTranslationResponse{Success: true}, nil
+}
+
+// LocalizeProducts replaces the content of the products, and of their
+// categories, with their translations to the locale of the request. The
+// most specific translation wins, so fr-CA is used before fr; fields it
+// leaves empty keep the original content. Lookup failures are logged and
+// leave the products untouched.
+func (s *TranslationService) LocalizeProducts(ctx context.Context, products ...*pb.Product) {
+	candidates := requestLocales(ctx)
+	if len(candidates) == 0 {
+		return
+	}
+
+	ids := make([]string, 0, len(products))
+	var categories []*pb.Category
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		ids = append(ids, product.Id)
+		categories = append(categories, product.Categories...)
+	}
+
+	translations := s.findTranslations(ctx, models.TranslationEntityProduct, ids, candidates)
+	for _, product := range products {
+		if product == nil {
+			continue
+		}
+		if t, ok := translations[product.Id]; ok {
+			product.Title = translatedField(t.Name, product.Title)
+			product.ShortDescription = translatedField(t.ShortDescription, product.ShortDescription)
+			product.Description = translatedField(t.Description, product.Description)
+		}
+	}
+
+	s.localizeCategories(ctx, categories, candidates)
+}
+
+// LocalizeCategories replaces the names and descriptions of the categories,
+// and the names of their parents, with their translations to the locale of
+// the request, as LocalizeProducts does
+func (s *TranslationService) LocalizeCategories(ctx context.Context, categories ...*pb.Category) {
+	if candidates := requestLocales(ctx); len(candidates) > 0 {
+		s.localizeCategories(ctx, categories, candidates)
+	}
+}
+
+func (s *TranslationService) localizeCategories(ctx context.Context, categories []*pb.Category, candidates []string) {
+	ids := make([]string, 0, len(categories))
+	for _, category := range categories {
+		if category == nil {
+			continue
+		}
+		ids = append(ids, category.Id)
+		if category.ParentId != nil && category.ParentId.Value != "" {
+			ids = append(ids, category.ParentId.Value)
+		}
+	}
+
+	translations := s.findTranslations(ctx, models.TranslationEntityCategory, ids, candidates)
+	for _, category := range categories {
+		if category == nil {
+			continue
+		}
+		if t, ok := translations[category.Id]; ok {
+			category.Name = translatedField(t.Name, category.Name)
+			category.Description = translatedField(t.Description, category.Description)
+		}
+		if category.ParentId != nil {
+			if t, ok := translations[category.ParentId.Value]; ok {
+				category.ParentName = translatedField(t.Name, category.ParentName)
+			}
+		}
+	}
+}
+
+// LocalizeContentPages replaces the title, summary and body of the pages
+// with their translations to the locale of the request, as LocalizeProducts
+// does
+func (s *TranslationService) LocalizeContentPages(ctx context.Context, pages ...*pb.ContentPage) {
+	candidates := requestLocales(ctx)
+	if len(candidates) == 0 {
+		return
+	}
+
+	ids := make([]string, len(pages))
+	for i, page := range pages {
+		ids[i] = page.Id
+	}
+	translations := s.findTranslations(ctx, models.TranslationEntityPage, ids, candidates)
+	for _, page := range pages {
+		if t, ok := translations[page.Id]; ok {
+			page.Title = translatedField(t.Name, page.Title)
+			page.Summary = translatedField(t.ShortDescription, page.Summary)
+			page.Body = translatedField(t.Description, page.Body)
+		}
+	}
+}
+
+// LocalizeContentBanners replaces the title and subtitle of the banners with
+// their translations to the locale of the request, as LocalizeProducts does
+func (s *TranslationService) LocalizeContentBanners(ctx context.Context, banners ...*pb.ContentBanner) {
+	candidates := requestLocales(ctx)
+	if len(candidates) == 0 {
+		return
+	}
+
+	ids := make([]string, len(banners))
+	for i, banner := range banners {
+		ids[i] = banner.Id
+	}
+	translations := s.findTranslations(ctx, models.TranslationEntityBanner, ids, candidates)
+	for _, banner := range banners {
+		if t, ok := translations[banner.Id]; ok {
+			banner.Title = translatedField(t.Name, banner.Title)
+			banner.Subtitle = translatedField(t.ShortDescription, banner.Subtitle)
+		}
+	}
+}
+
+// findTranslations returns the most specific translation of each entity to
+// the candidate locales, keyed by entity ID
+func (s *TranslationService) findTranslations(ctx context.Context, entityType string, ids, candidates []string) map[string]*models.Translation {
+	if len(ids) == 0 {
+		return nil
+	}
+	translations, err := s.translationRepo.FindTranslations(ctx, entityType, ids, candidates)
+	if err != nil {
+		s.logger.Warn("Failed to look up translations",
+			zap.String("entity_type", entityType),
+			zap.Strings("locales", candidates),
+			zap.Error(err))
+		return nil
+	}
+
+	rank := make(map[string]int, len(candidates))
+	for i, candidate := range candidates {
+		rank[candidate] = i
+	}
+	best := make(map[string]*models.Translation, len(translations))
+	for _, t := range translations {
+		if current, ok := best[t.EntityID]; !ok || rank[t.Locale] < rank[current.Locale] {
+			best[t.EntityID] = t
+		}
+	}
+	return best
+}
+
+func (s *TranslationService) translationError(message string, err error) error {
+	switch {
+	case errors.Is(err, models.ErrProductNotFound):
+		return status.Error(codes.NotFound, "product not found")
+	case errors.Is(err, models.ErrCategoryNotFound):
+		return status.Error(codes.NotFound, "category not found")
+	case errors.Is(err, models.ErrContentPageNotFound), errors.Is(err, models.ErrContentBannerNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrTranslationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	s.logger.Error(message, zap.Error(err))
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// translationKey validates the entity and locale of a translation and
+// returns the locale lowercased
+func translationKey(entityType, entityID, tag string) (string, string, string, error) {
+	if !models.IsTranslationEntity(entityType) {
+		return "", "", "", status.Error(codes.InvalidArgument, "entity_type must be product, category, page or banner")
+	}
+	if _, err := uuid.Parse(entityID); err != nil {
+		return "", "", "", status.Error(codes.InvalidArgument, "invalid entity ID")
+	}
+	normalized := locale.Preferences{Locale: tag}.Normalize().Locale
+	if normalized == "" {
+		return "", "", "", status.Error(codes.InvalidArgument, "invalid locale")
+	}
+	return entityType, entityID, strings.ToLower(normalized), nil
+}
+
+// requestLocales returns the locales to look translations up with for the
+// locale of the request, most specific first
+func requestLocales(ctx context.Context) []string {
+	tag := locale.FromContext(ctx).Locale
+	if tag == "" {
+		return nil
+	}
+	return i18n.Candidates(tag)
+}
+
+func translatedField(translated, original string) string {
+	if translated == "" {
+		return original
+	}
+	return translated
+}
+
+func translationToProto(translation *models.Translation) *pb.Translation {
+	return &pb.Translation{
+		EntityType:       translation.EntityType,
+		EntityId:         translation.EntityID,
+		Locale:           translation.Locale,
+		Name:             translation.Name,
+		ShortDescription: translation.ShortDescription,
+		Description:      translation.Description,
+		CreatedAt:        timestamppb.New(translation.CreatedAt),
+		UpdatedAt:        timestamppb.New(translation.UpdatedAt),
+	}
+}
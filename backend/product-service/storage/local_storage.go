@@ -88,6 +88,18 @@ func (s *LocalStorage) Delete(publicID string) error {
 	return os.Remove(filePath)
 }
 
+// Open opens a stored file for reading. Public IDs that resolve outside the
+// base path are rejected.
+func (s *LocalStorage) Open(publicID string) (io.ReadCloser, error) {
+	filePath := filepath.Join(s.BasePath, filepath.Clean("/"+publicID))
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	return file, nil
+}
+
 // SaveBase64Image saves a base64 encoded image to local storage
 func (s *LocalStorage) SaveBase64Image(base64Data, folder, filename string) (*UploadResult, error) {
 	// Remove data URL prefix if present
@@ -0,0 +1,13 @@
+package storage
+
+import "io"
+
+// Storage stores product files under a public ID, e.g. "folder/filename"
+type Storage interface {
+	Upload(data []byte, folder, filename string) (*UploadResult, error)
+	Open(publicID string) (io.ReadCloser, error)
+	Delete(publicID string) error
+}
+
+// Ensure LocalStorage implements Storage
+var _ Storage = (*LocalStorage)(nil)
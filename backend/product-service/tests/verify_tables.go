@@ -8,7 +8,7 @@ import (
 	"os"
 	"time"
 
-	_ "github.com/lib/pq"
+	_ "github.com/jackc/pgx/v5/stdlib"
 )
 
 func main() {
@@ -19,7 +19,7 @@ func main() {
 	fmt.Println("Using connection string:", connStr)
 
 	// Connect to database
-	db, err := sql.Open("postgres", connStr)
+	db, err := sql.Open("pgx", connStr)
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
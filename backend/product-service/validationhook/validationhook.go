@@ -0,0 +1,156 @@
+// Package validationhook asks an external validator, such as a PIM or data
+// governance system, whether a product may be saved before it is committed.
+//
+// The validator receives a POST of a JSON Request and answers with a JSON
+// Response listing the violations found, if any. When a secret is set, the
+// body is signed with HMAC-SHA256 in the SignatureHeader so the validator can
+// authenticate the store.
+package validationhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Operations a product is validated for
+const (
+	OperationCreate = "product.create"
+	OperationUpdate = "product.update"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the request body, prefixed
+// with "sha256="
+const SignatureHeader = "X-Validation-Signature"
+
+// maxResponseBytes bounds the answer read from a validator
+const maxResponseBytes = 1 << 20
+
+// ErrUnavailable is wrapped by the errors of validators that could not be
+// reached, timed out or gave an unexpected answer
+var ErrUnavailable = errors.New("product validator unavailable")
+
+// Endpoint is the validator of a store
+type Endpoint struct {
+	URL     string
+	Secret  string
+	Timeout time.Duration
+}
+
+// Request is the body posted to the validator
+type Request struct {
+	Operation string    `json:"operation"`
+	TenantID  string    `json:"tenant_id"`
+	Product   any       `json:"product"`
+	SentAt    time.Time `json:"sent_at"`
+}
+
+// Violation is a rule of the validator a product breaks
+type Violation struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// Response is the answer of the validator. A product is valid when the
+// validator says so and reports no violation.
+type Response struct {
+	Valid      bool        `json:"valid"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// Rejected reports whether the validator refused the product
+func (r *Response) Rejected() bool {
+	return !r.Valid || len(r.Violations) > 0
+}
+
+// Summary describes the violations in one line
+func (r *Response) Summary() string {
+	if len(r.Violations) == 0 {
+		return "rejected by the product validator"
+	}
+	parts := make([]string, len(r.Violations))
+	for i, v := range r.Violations {
+		if v.Field != "" {
+			parts[i] = v.Field + ": " + v.Message
+		} else {
+			parts[i] = v.Message
+		}
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Client calls product validators
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient creates a validator client. Each call is bounded by the timeout
+// of its endpoint.
+func NewClient() *Client {
+	return &Client{httpClient: &http.Client{}}
+}
+
+// Sign returns the signature of body with secret, as sent in SignatureHeader
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Validate posts req to the endpoint and returns the answer of the
+// validator. Errors wrap ErrUnavailable.
+func (c *Client) Validate(ctx context.Context, endpoint Endpoint, req Request) (*Response, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode validation request: %w", err)
+	}
+
+	if endpoint.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, endpoint.Timeout)
+		defer cancel()
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	if endpoint.Secret != "" {
+		httpReq.Header.Set(SignatureHeader, Sign(endpoint.Secret, body))
+	}
+
+	httpResp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnavailable, err)
+	}
+	defer httpResp.Body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(httpResp.Body, maxResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("%w: failed to read answer: %v", ErrUnavailable, err)
+	}
+	// Validators may answer 422 with the violations of a rejected product
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusUnprocessableEntity {
+		return nil, fmt.Errorf("%w: unexpected status %d", ErrUnavailable, httpResp.StatusCode)
+	}
+
+	var resp Response
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, fmt.Errorf("%w: malformed answer: %v", ErrUnavailable, err)
+	}
+	if httpResp.StatusCode == http.StatusUnprocessableEntity {
+		resp.Valid = false
+	}
+	return &resp, nil
+}
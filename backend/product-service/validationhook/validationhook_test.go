@@ -0,0 +1,92 @@
+package validationhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		wantErr      bool
+		wantRejected bool
+		wantSummary  string
+	}{
+		{"valid", http.StatusOK, `{"valid":true}`, false, false, ""},
+		{"violations", http.StatusOK, `{"valid":true,"violations":[{"field":"title","message":"too long"},{"message":"no brand"}]}`, false, true, "title: too long; no brand"},
+		{"unprocessable", http.StatusUnprocessableEntity, `{"valid":true}`, false, true, "rejected by the product validator"},
+		{"server error", http.StatusInternalServerError, `{"valid":true}`, true, false, ""},
+		{"malformed", http.StatusOK, `valid`, true, false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				io.WriteString(w, tt.body)
+			}))
+			defer server.Close()
+
+			resp, err := NewClient().Validate(context.Background(), Endpoint{URL: server.URL, Timeout: time.Second}, Request{Operation: OperationCreate})
+			if tt.wantErr {
+				if !errors.Is(err, ErrUnavailable) {
+					t.Fatalf("Validate() error = %v, want ErrUnavailable", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Validate() error = %v", err)
+			}
+			if resp.Rejected() != tt.wantRejected {
+				t.Errorf("Rejected() = %v, want %v", resp.Rejected(), tt.wantRejected)
+			}
+			if tt.wantRejected && resp.Summary() != tt.wantSummary {
+				t.Errorf("Summary() = %q, want %q", resp.Summary(), tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestValidateSignsRequest(t *testing.T) {
+	var got Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(SignatureHeader) != Sign("s3cret", body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		json.Unmarshal(body, &got)
+		io.WriteString(w, `{"valid":true}`)
+	}))
+	defer server.Close()
+
+	req := Request{Operation: OperationUpdate, TenantID: "store-1", Product: map[string]string{"title": "Trail Shoe"}}
+	if _, err := NewClient().Validate(context.Background(), Endpoint{URL: server.URL, Secret: "s3cret"}, req); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+	if got.Operation != OperationUpdate || got.TenantID != "store-1" {
+		t.Errorf("validator received %+v", got)
+	}
+}
+
+func TestValidateTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	_, err := NewClient().Validate(context.Background(), Endpoint{URL: server.URL, Timeout: 50 * time.Millisecond}, Request{})
+	if !errors.Is(err, ErrUnavailable) {
+		t.Fatalf("Validate() error = %v, want ErrUnavailable", err)
+	}
+}
@@ -0,0 +1,39 @@
+package cache
+
+import (
+	"sync/atomic"
+)
+
+// Stats is a point-in-time snapshot of a tiered cache's health and counters
+type Stats struct {
+	CircuitState  CircuitState
+	MemoryEntries int
+	Hits          int64
+	Misses        int64
+	Errors        int64
+	HitRate       float64
+}
+
+// String returns the lowercase name of the circuit state
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// Stats returns a typed snapshot of the cache metrics and circuit breaker state
+func (c *TieredCache) Stats() Stats {
+	return Stats{
+		CircuitState:  c.circuitBreaker.GetState(),
+		MemoryEntries: c.memoryCache.Count(),
+		Hits:          atomic.LoadInt64(&c.metrics.hits),
+		Misses:        atomic.LoadInt64(&c.metrics.misses),
+		Errors:        atomic.LoadInt64(&c.metrics.errors),
+		HitRate:       c.metrics.GetHitRate(),
+	}
+}
@@ -17,6 +17,7 @@ type TTLProvider interface {
 
 // DefaultTTLProvider provides default TTL values for different key types
 type DefaultTTLProvider struct {
+	mu         sync.RWMutex
 	defaultTTL time.Duration
 	ttlMap     map[string]time.Duration
 }
@@ -44,6 +45,8 @@ func NewDefaultTTLProvider(defaultTTL time.Duration) *DefaultTTLProvider {
 
 // GetTTL returns the TTL for a given key type
 func (p *DefaultTTLProvider) GetTTL(keyType string) time.Duration {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
 	if ttl, ok := p.ttlMap[keyType]; ok {
 		return ttl
 	}
@@ -52,9 +55,18 @@ func (p *DefaultTTLProvider) GetTTL(keyType string) time.Duration {
 
 // SetTTL sets a custom TTL for a key type
 func (p *DefaultTTLProvider) SetTTL(keyType string, ttl time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
 	p.ttlMap[keyType] = ttl
 }
 
+// SetDefaultTTL changes the TTL used for key types without a custom TTL
+func (p *DefaultTTLProvider) SetDefaultTTL(ttl time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.defaultTTL = ttl
+}
+
 // TieredCache implements a two-level cache with memory and Redis
 type TieredCache struct {
 	memoryCache    *MemoryCache
@@ -404,3 +416,17 @@ func (c *TieredCache) GetCircuitBreakerState() CircuitState {
 func (c *TieredCache) ResetCircuitBreaker() {
 	c.circuitBreaker.Reset()
 }
+
+// SetDefaultTTL changes the TTL used for key types without a custom TTL
+func (c *TieredCache) SetDefaultTTL(ttl time.Duration) {
+	if p, ok := c.ttlProvider.(*DefaultTTLProvider); ok {
+		p.SetDefaultTTL(ttl)
+	}
+}
+
+// SetTTL changes the TTL for a key type
+func (c *TieredCache) SetTTL(keyType string, ttl time.Duration) {
+	if p, ok := c.ttlProvider.(*DefaultTTLProvider); ok {
+		p.SetTTL(keyType, ttl)
+	}
+}
@@ -0,0 +1,67 @@
+// Package catalogevents carries the catalog changes of the product service to
+// the other services over Redis pub/sub, so that they can drop what they
+// derived from the catalog, such as cached responses. Delivery is at most
+// once: subscribers must expire what they derive on their own as well.
+package catalogevents
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"go.uber.org/zap"
+)
+
+// Channel is the Redis channel the catalog changes are published on
+const Channel = "catalog:events"
+
+// Event types, those of the product service's in-process events
+const (
+	ProductCreated = "product.created"
+	ProductUpdated = "product.updated"
+	ProductDeleted = "product.deleted"
+)
+
+// Event is a change to a product of a store
+type Event struct {
+	Type       string    `json:"type"`
+	TenantID   string    `json:"tenant_id"`
+	ProductID  string    `json:"product_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// Publish sends an event to the subscribers
+func Publish(ctx context.Context, client *redis.Client, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return client.Publish(ctx, Channel, payload).Err()
+}
+
+// Subscribe calls handler with every event published until ctx is
+// cancelled. Malformed messages are logged and skipped.
+func Subscribe(ctx context.Context, client *redis.Client, handler func(context.Context, Event), logger *zap.Logger) {
+	pubsub := client.Subscribe(ctx, Channel)
+	go func() {
+		defer pubsub.Close()
+		messages := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-messages:
+				if !ok {
+					return
+				}
+				var event Event
+				if err := json.Unmarshal([]byte(msg.Payload), &event); err != nil {
+					logger.Warn("Ignoring malformed catalog event", zap.String("payload", msg.Payload), zap.Error(err))
+					continue
+				}
+				handler(ctx, event)
+			}
+		}
+	}()
+}
@@ -0,0 +1,156 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Source is something a configuration value is built from. The watcher
+// reloads the configuration whenever a source's fingerprint changes.
+type Source interface {
+	Name() string
+	Fingerprint(ctx context.Context) (string, error)
+}
+
+// FileSource watches a configuration file on disk
+type FileSource struct {
+	Path string
+}
+
+// NewFileSource creates a source for the file at path
+func NewFileSource(path string) *FileSource {
+	return &FileSource{Path: path}
+}
+
+// Name returns the source name
+func (s *FileSource) Name() string {
+	return "file:" + s.Path
+}
+
+// Fingerprint hashes the file contents; a missing file has an empty fingerprint
+func (s *FileSource) Fingerprint(ctx context.Context) (string, error) {
+	data, err := os.ReadFile(s.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	return hash(data), nil
+}
+
+// EnvSource watches the process environment variables sharing a prefix
+type EnvSource struct {
+	Prefix string
+}
+
+// NewEnvSource creates a source for the environment variables starting with prefix
+func NewEnvSource(prefix string) *EnvSource {
+	return &EnvSource{Prefix: prefix}
+}
+
+// Name returns the source name
+func (s *EnvSource) Name() string {
+	return "env:" + s.Prefix
+}
+
+// Fingerprint hashes the matching variables in a stable order
+func (s *EnvSource) Fingerprint(ctx context.Context) (string, error) {
+	var vars []string
+	for _, kv := range os.Environ() {
+		if strings.HasPrefix(kv, s.Prefix) {
+			vars = append(vars, kv)
+		}
+	}
+	sort.Strings(vars)
+	return hash([]byte(strings.Join(vars, "\n"))), nil
+}
+
+// ConsulSource reads a single key from the Consul KV HTTP API. The key is
+// expected to hold a YAML document that is merged over the file configuration.
+type ConsulSource struct {
+	Address string
+	Key     string
+	Token   string
+	client  *http.Client
+}
+
+// NewConsulSource creates a source for key on the Consul agent at address
+func NewConsulSource(address, key, token string) *ConsulSource {
+	if !strings.HasPrefix(address, "http://") && !strings.HasPrefix(address, "https://") {
+		address = "http://" + address
+	}
+	return &ConsulSource{
+		Address: strings.TrimSuffix(address, "/"),
+		Key:     strings.TrimPrefix(key, "/"),
+		Token:   token,
+		client:  &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// NewConsulSourceFromEnv creates a source from CONFIG_CONSUL_ADDR, CONFIG_CONSUL_KEY
+// and CONSUL_HTTP_TOKEN, returning nil when no Consul address is configured
+func NewConsulSourceFromEnv(defaultKey string) *ConsulSource {
+	addr := os.Getenv("CONFIG_CONSUL_ADDR")
+	if addr == "" {
+		return nil
+	}
+	key := os.Getenv("CONFIG_CONSUL_KEY")
+	if key == "" {
+		key = defaultKey
+	}
+	return NewConsulSource(addr, key, os.Getenv("CONSUL_HTTP_TOKEN"))
+}
+
+// Name returns the source name
+func (s *ConsulSource) Name() string {
+	return "consul:" + s.Key
+}
+
+// Fetch returns the raw value of the key, or nil when the key does not exist
+func (s *ConsulSource) Fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/v1/kv/%s?raw", s.Address, s.Key), nil)
+	if err != nil {
+		return nil, err
+	}
+	if s.Token != "" {
+		req.Header.Set("X-Consul-Token", s.Token)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query consul: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return io.ReadAll(resp.Body)
+	case http.StatusNotFound:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("consul returned status %d for key %s", resp.StatusCode, s.Key)
+	}
+}
+
+// Fingerprint hashes the current value of the key
+func (s *ConsulSource) Fingerprint(ctx context.Context) (string, error) {
+	data, err := s.Fetch(ctx)
+	if err != nil {
+		return "", err
+	}
+	return hash(data), nil
+}
+
+func hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
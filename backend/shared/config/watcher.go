@@ -0,0 +1,178 @@
+package config
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultPollInterval is how often a watcher checks its sources when no interval is given
+const DefaultPollInterval = 30 * time.Second
+
+// LoadFunc builds a fresh configuration value from all sources
+type LoadFunc[T any] func(ctx context.Context) (*T, error)
+
+// Watcher keeps a configuration value current by polling its sources and
+// reloading whenever one of them changes. Subscribers are notified with the
+// old and new value after every successful reload that changed the value.
+type Watcher[T any] struct {
+	load     LoadFunc[T]
+	sources  []Source
+	interval time.Duration
+	logger   *zap.Logger
+
+	mu           sync.RWMutex
+	current      *T
+	fingerprints map[string]string
+	subscribers  []func(old, new *T)
+}
+
+// WatcherOptions configures a Watcher
+type WatcherOptions struct {
+	Sources      []Source
+	PollInterval time.Duration
+	Logger       *zap.Logger
+}
+
+// NewWatcher loads the initial configuration and returns a watcher for it.
+// Start must be called to begin polling.
+func NewWatcher[T any](ctx context.Context, load LoadFunc[T], opts WatcherOptions) (*Watcher[T], error) {
+	if load == nil {
+		return nil, errors.New("config watcher requires a load function")
+	}
+	if opts.PollInterval <= 0 {
+		opts.PollInterval = DefaultPollInterval
+	}
+	if opts.Logger == nil {
+		opts.Logger = zap.NewNop()
+	}
+
+	w := &Watcher[T]{
+		load:         load,
+		sources:      opts.Sources,
+		interval:     opts.PollInterval,
+		logger:       opts.Logger,
+		fingerprints: make(map[string]string),
+	}
+
+	initial, err := load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	w.current = initial
+	w.fingerprints = w.snapshot(ctx)
+
+	return w, nil
+}
+
+// Current returns the most recently loaded configuration. Callers must treat
+// it as read-only.
+func (w *Watcher[T]) Current() *T {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.current
+}
+
+// Subscribe registers fn to be called after every reload that changed the configuration
+func (w *Watcher[T]) Subscribe(fn func(old, new *T)) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.subscribers = append(w.subscribers, fn)
+}
+
+// Start polls the sources until ctx is cancelled
+func (w *Watcher[T]) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if !w.changed(ctx) {
+					continue
+				}
+				if err := w.Reload(ctx); err != nil {
+					w.logger.Error("Failed to reload configuration, keeping previous values", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Reload loads the configuration immediately and notifies subscribers when it changed
+func (w *Watcher[T]) Reload(ctx context.Context) error {
+	fingerprints := w.snapshot(ctx)
+	next, err := w.load(ctx)
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	prev := w.current
+	w.current = next
+	w.fingerprints = fingerprints
+	subscribers := append([]func(old, new *T){}, w.subscribers...)
+	w.mu.Unlock()
+
+	if reflect.DeepEqual(prev, next) {
+		return nil
+	}
+
+	w.logger.Info("Configuration reloaded")
+	for _, fn := range subscribers {
+		fn(prev, next)
+	}
+	return nil
+}
+
+// changed reports whether any source fingerprint differs from the last load
+func (w *Watcher[T]) changed(ctx context.Context) bool {
+	current := w.snapshot(ctx)
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	for name, fp := range current {
+		if w.fingerprints[name] != fp {
+			return true
+		}
+	}
+	return false
+}
+
+// snapshot collects the fingerprint of every source. Sources that fail keep
+// their previous fingerprint so a transient error does not trigger a reload.
+func (w *Watcher[T]) snapshot(ctx context.Context) map[string]string {
+	fingerprints := make(map[string]string, len(w.sources))
+	for _, src := range w.sources {
+		fp, err := src.Fingerprint(ctx)
+		if err != nil {
+			w.logger.Warn("Failed to check configuration source",
+				zap.String("source", src.Name()),
+				zap.Error(err))
+			w.mu.RLock()
+			fp = w.fingerprints[src.Name()]
+			w.mu.RUnlock()
+		}
+		fingerprints[src.Name()] = fp
+	}
+	return fingerprints
+}
+
+// OnChange subscribes fn to a single value selected from the configuration.
+// fn is called with the new value only when the selected value changed.
+func OnChange[T any, V comparable](w *Watcher[T], selector func(*T) V, fn func(V)) {
+	w.Subscribe(func(old, new *T) {
+		next := selector(new)
+		if old != nil && selector(old) == next {
+			return
+		}
+		fn(next)
+	})
+}
@@ -0,0 +1,196 @@
+// Package diagnostics collects runtime information about a service's database
+// pools and caches for the internal ops dashboard.
+package diagnostics
+
+import (
+	"context"
+	"database/sql"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/shared/cache"
+)
+
+// replicaLagQuery returns the replay lag in seconds on a standby and NULL on a
+// primary. A standby that has replayed everything it received reports no lag,
+// as the last replay timestamp only moves when the primary writes.
+const replicaLagQuery = `
+	SELECT CASE
+		WHEN NOT pg_is_in_recovery() THEN NULL
+		WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE COALESCE(EXTRACT(EPOCH FROM NOW() - pg_last_xact_replay_timestamp()), 0)
+	END
+`
+
+// CacheSource is implemented by cache managers that can report their state
+type CacheSource interface {
+	Stats() cache.Stats
+	HealthCheck(ctx context.Context) error
+}
+
+// DBPoolStats describes the connection pool of a single database handle
+type DBPoolStats struct {
+	Name    string
+	Replica bool
+	sql.DBStats
+	// ReplicaLag is nil when the lag is unknown or the database is a primary
+	ReplicaLag *time.Duration
+	// Excluded is set when reads are routed away from the replica
+	Excluded bool
+}
+
+// ReplicaState is the last sampled state of a replica
+type ReplicaState struct {
+	Lag       *time.Duration // Nil when the lag could not be determined
+	Excluded  bool           // Reads are routed away from the replica
+	SampledAt time.Time
+}
+
+// ReplicaStateSource is implemented by connection managers that sample their
+// replicas. Reports use the sampled state instead of querying the replica.
+type ReplicaStateSource interface {
+	ReplicaState(db *sql.DB) (ReplicaState, bool)
+}
+
+// CacheStats describes a single cache
+type CacheStats struct {
+	Name string
+	cache.Stats
+	Healthy bool
+}
+
+// Report is the full diagnostics snapshot of a service
+type Report struct {
+	Service     string
+	CollectedAt time.Time
+	Uptime      time.Duration
+	Goroutines  int
+	DBPools     []DBPoolStats
+	Caches      []CacheStats
+}
+
+type namedDB struct {
+	name    string
+	db      *sql.DB
+	replica bool
+}
+
+type namedCache struct {
+	name   string
+	source CacheSource
+}
+
+// Collector gathers diagnostics from the components registered with it
+type Collector struct {
+	service   string
+	startedAt time.Time
+	mu        sync.RWMutex
+	dbs       []namedDB
+	caches    []namedCache
+	replicas  ReplicaStateSource
+}
+
+// NewCollector creates a collector for the named service
+func NewCollector(service string) *Collector {
+	return &Collector{
+		service:   service,
+		startedAt: time.Now(),
+	}
+}
+
+// AddDB registers a database handle. Replica lag is only queried for replicas.
+func (c *Collector) AddDB(name string, db *sql.DB, replica bool) {
+	if db == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dbs = append(c.dbs, namedDB{name: name, db: db, replica: replica})
+}
+
+// SetReplicaStateSource makes reports use the replica state sampled by src
+func (c *Collector) SetReplicaStateSource(src ReplicaStateSource) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.replicas = src
+}
+
+// AddCache registers a cache
+func (c *Collector) AddCache(name string, source CacheSource) {
+	if source == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.caches = append(c.caches, namedCache{name: name, source: source})
+}
+
+// Collect builds a report from the registered components. Failing lag queries
+// and health checks are reported as unknown/unhealthy rather than as errors.
+func (c *Collector) Collect(ctx context.Context) *Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	now := time.Now()
+	report := &Report{
+		Service:     c.service,
+		CollectedAt: now.UTC(),
+		Uptime:      now.Sub(c.startedAt),
+		Goroutines:  runtime.NumGoroutine(),
+		DBPools:     make([]DBPoolStats, 0, len(c.dbs)),
+		Caches:      make([]CacheStats, 0, len(c.caches)),
+	}
+
+	for _, entry := range c.dbs {
+		stats := DBPoolStats{
+			Name:    entry.name,
+			Replica: entry.replica,
+			DBStats: entry.db.Stats(),
+		}
+		if entry.replica {
+			if state, ok := c.replicaState(entry.db); ok {
+				stats.ReplicaLag = state.Lag
+				stats.Excluded = state.Excluded
+			} else {
+				stats.ReplicaLag, _ = ReplicaLag(ctx, entry.db)
+			}
+		}
+		report.DBPools = append(report.DBPools, stats)
+	}
+
+	for _, entry := range c.caches {
+		report.Caches = append(report.Caches, CacheStats{
+			Name:    entry.name,
+			Stats:   entry.source.Stats(),
+			Healthy: entry.source.HealthCheck(ctx) == nil,
+		})
+	}
+
+	return report
+}
+
+func (c *Collector) replicaState(db *sql.DB) (ReplicaState, bool) {
+	if c.replicas == nil {
+		return ReplicaState{}, false
+	}
+	return c.replicas.ReplicaState(db)
+}
+
+// ReplicaLag returns the replication lag of a standby. It returns nil and no
+// error when the database is not a standby.
+func ReplicaLag(ctx context.Context, db *sql.DB) (*time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	var seconds sql.NullFloat64
+	if err := db.QueryRowContext(ctx, replicaLagQuery).Scan(&seconds); err != nil {
+		return nil, err
+	}
+	if !seconds.Valid {
+		return nil, nil
+	}
+
+	lag := time.Duration(seconds.Float64 * float64(time.Second))
+	return &lag, nil
+}
@@ -0,0 +1,127 @@
+package featureflags
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// DefaultRefreshInterval is how often a client reloads flags from the store
+const DefaultRefreshInterval = 15 * time.Second
+
+// Client evaluates flags from an in-memory snapshot of the store so that flag
+// checks never block on Redis. The snapshot is refreshed in the background and
+// immediately after changes made through the client.
+type Client struct {
+	store    Store
+	logger   *zap.Logger
+	interval time.Duration
+
+	mu    sync.RWMutex
+	flags map[string]*Flag
+}
+
+// NewClient creates a client and loads the initial snapshot. A store error is
+// logged and leaves the snapshot empty, so every flag falls back to its default.
+func NewClient(ctx context.Context, store Store, logger *zap.Logger) *Client {
+	c := &Client{
+		store:    store,
+		logger:   logger,
+		interval: DefaultRefreshInterval,
+		flags:    make(map[string]*Flag),
+	}
+	if err := c.Refresh(ctx); err != nil {
+		logger.Warn("Failed to load feature flags, using defaults", zap.Error(err))
+	}
+	return c
+}
+
+// Start refreshes the snapshot periodically until ctx is cancelled
+func (c *Client) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(c.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.Refresh(ctx); err != nil {
+					c.logger.Warn("Failed to refresh feature flags", zap.Error(err))
+				}
+			}
+		}
+	}()
+}
+
+// Refresh reloads the snapshot from the store
+func (c *Client) Refresh(ctx context.Context) error {
+	flags, err := c.store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	snapshot := make(map[string]*Flag, len(flags))
+	for _, flag := range flags {
+		snapshot[flag.Key] = flag
+	}
+
+	c.mu.Lock()
+	c.flags = snapshot
+	c.mu.Unlock()
+	return nil
+}
+
+// IsEnabled reports whether the flag is on for userID; unknown flags are off
+func (c *Client) IsEnabled(key, userID string) bool {
+	return c.IsEnabledOr(key, userID, false)
+}
+
+// IsEnabledOr reports whether the flag is on for userID, returning fallback
+// when the flag does not exist
+func (c *Client) IsEnabledOr(key, userID string, fallback bool) bool {
+	if c == nil {
+		return fallback
+	}
+
+	c.mu.RLock()
+	flag, ok := c.flags[key]
+	c.mu.RUnlock()
+	if !ok {
+		return fallback
+	}
+	return flag.IsEnabledFor(userID)
+}
+
+// List returns all flags from the store
+func (c *Client) List(ctx context.Context) ([]*Flag, error) {
+	return c.store.List(ctx)
+}
+
+// Get returns a flag from the store
+func (c *Client) Get(ctx context.Context, key string) (*Flag, error) {
+	return c.store.Get(ctx, key)
+}
+
+// Save validates and stores a flag, then refreshes the snapshot
+func (c *Client) Save(ctx context.Context, flag *Flag) error {
+	if err := flag.Validate(); err != nil {
+		return err
+	}
+	flag.UpdatedAt = time.Now().UTC()
+	if err := c.store.Save(ctx, flag); err != nil {
+		return err
+	}
+	return c.Refresh(ctx)
+}
+
+// Delete removes a flag from the store, then refreshes the snapshot
+func (c *Client) Delete(ctx context.Context, key string) error {
+	if err := c.store.Delete(ctx, key); err != nil {
+		return err
+	}
+	return c.Refresh(ctx)
+}
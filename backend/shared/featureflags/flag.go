@@ -0,0 +1,70 @@
+package featureflags
+
+import (
+	"errors"
+	"hash/fnv"
+	"regexp"
+	"time"
+)
+
+var (
+	// ErrFlagNotFound is returned when a flag does not exist
+	ErrFlagNotFound = errors.New("feature flag not found")
+	// ErrInvalidFlag is returned when a flag fails validation
+	ErrInvalidFlag = errors.New("invalid feature flag")
+
+	keyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,63}$`)
+)
+
+// Flag is a feature toggle with an optional percentage rollout and a list of
+// users that always get the feature while the flag is enabled
+type Flag struct {
+	Key               string    `json:"key"`
+	Description       string    `json:"description"`
+	Enabled           bool      `json:"enabled"`
+	RolloutPercentage int       `json:"rollout_percentage"`
+	TargetUsers       []string  `json:"target_users"`
+	UpdatedBy         string    `json:"updated_by,omitempty"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
+
+// Validate checks the flag key and rollout percentage
+func (f *Flag) Validate() error {
+	if !keyPattern.MatchString(f.Key) {
+		return errors.Join(ErrInvalidFlag, errors.New("key must be lowercase letters, digits, '_', '-' or '.'"))
+	}
+	if f.RolloutPercentage < 0 || f.RolloutPercentage > 100 {
+		return errors.Join(ErrInvalidFlag, errors.New("rollout_percentage must be between 0 and 100"))
+	}
+	return nil
+}
+
+// IsEnabledFor reports whether the flag is on for userID. Targeted users always
+// get the feature; everyone else is bucketed by a stable hash of the flag key
+// and user ID. Anonymous callers only get the feature at a 100% rollout.
+func (f *Flag) IsEnabledFor(userID string) bool {
+	if !f.Enabled {
+		return false
+	}
+	if f.RolloutPercentage >= 100 {
+		return true
+	}
+	if userID == "" {
+		return false
+	}
+	for _, target := range f.TargetUsers {
+		if target == userID {
+			return true
+		}
+	}
+	return bucket(f.Key, userID) < f.RolloutPercentage
+}
+
+// bucket maps a user to a value in [0, 100) that is stable for a given flag
+func bucket(key, userID string) int {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	h.Write([]byte{':'})
+	h.Write([]byte(userID))
+	return int(h.Sum32() % 100)
+}
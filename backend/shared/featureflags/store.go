@@ -0,0 +1,93 @@
+package featureflags
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// DefaultRedisKey is the Redis hash holding all flags
+const DefaultRedisKey = "feature_flags"
+
+// Store persists feature flags
+type Store interface {
+	List(ctx context.Context) ([]*Flag, error)
+	Get(ctx context.Context, key string) (*Flag, error)
+	Save(ctx context.Context, flag *Flag) error
+	Delete(ctx context.Context, key string) error
+}
+
+// RedisStore keeps every flag as a JSON field of a single Redis hash so that
+// all services sharing the Redis instance see the same flags
+type RedisStore struct {
+	client *redis.Client
+	key    string
+}
+
+// NewRedisStore creates a store on the given Redis client
+func NewRedisStore(client *redis.Client) *RedisStore {
+	return &RedisStore{client: client, key: DefaultRedisKey}
+}
+
+// List returns all flags ordered by key
+func (s *RedisStore) List(ctx context.Context) ([]*Flag, error) {
+	values, err := s.client.HGetAll(ctx, s.key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list feature flags: %w", err)
+	}
+
+	flags := make([]*Flag, 0, len(values))
+	for field, value := range values {
+		var flag Flag
+		if err := json.Unmarshal([]byte(value), &flag); err != nil {
+			return nil, fmt.Errorf("failed to decode feature flag %s: %w", field, err)
+		}
+		flags = append(flags, &flag)
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i].Key < flags[j].Key })
+	return flags, nil
+}
+
+// Get returns a single flag
+func (s *RedisStore) Get(ctx context.Context, key string) (*Flag, error) {
+	value, err := s.client.HGet(ctx, s.key, key).Result()
+	if err == redis.Nil {
+		return nil, ErrFlagNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get feature flag: %w", err)
+	}
+
+	var flag Flag
+	if err := json.Unmarshal([]byte(value), &flag); err != nil {
+		return nil, fmt.Errorf("failed to decode feature flag %s: %w", key, err)
+	}
+	return &flag, nil
+}
+
+// Save creates or replaces a flag
+func (s *RedisStore) Save(ctx context.Context, flag *Flag) error {
+	data, err := json.Marshal(flag)
+	if err != nil {
+		return err
+	}
+	if err := s.client.HSet(ctx, s.key, flag.Key, data).Err(); err != nil {
+		return fmt.Errorf("failed to save feature flag: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a flag
+func (s *RedisStore) Delete(ctx context.Context, key string) error {
+	removed, err := s.client.HDel(ctx, s.key, key).Result()
+	if err != nil {
+		return fmt.Errorf("failed to delete feature flag: %w", err)
+	}
+	if removed == 0 {
+		return ErrFlagNotFound
+	}
+	return nil
+}
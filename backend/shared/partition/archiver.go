@@ -0,0 +1,54 @@
+package partition
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Archiver stores the rows of a partition before it is dropped
+type Archiver interface {
+	// Archive stores a gzipped CSV file, with a header row, read from r
+	// under name. r streams the export, so it is read once and not held in
+	// memory as a whole.
+	Archive(ctx context.Context, name string, r io.Reader) error
+}
+
+// ArchiverFunc adapts a function to the Archiver interface
+type ArchiverFunc func(ctx context.Context, name string, r io.Reader) error
+
+// Archive calls f
+func (f ArchiverFunc) Archive(ctx context.Context, name string, r io.Reader) error {
+	return f(ctx, name, r)
+}
+
+// DirArchiver writes archives as files of a local directory
+type DirArchiver string
+
+// Archive writes r to the file name of the directory. The file only appears
+// once it is complete, so a failed export leaves no partial archive behind.
+func (d DirArchiver) Archive(ctx context.Context, name string, r io.Reader) error {
+	if err := os.MkdirAll(string(d), 0755); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(string(d), name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0640); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(string(d), name))
+}
@@ -0,0 +1,346 @@
+// Package partition maintains the monthly range partitions of append-only
+// tables such as inventory movements and price history. Partitions are named
+// <table>_yYYYYmMM and cover one UTC calendar month of created_at; the tables
+// themselves are converted to partitioned tables by the service migrations.
+package partition
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Table is a partitioned table maintained by a Manager
+type Table struct {
+	Name string
+	// Retention is the number of past months kept attached in addition to the
+	// current one. Older partitions are archived and dropped; zero keeps
+	// every partition.
+	Retention int
+}
+
+// Manager creates the partitions of the coming months ahead of time and
+// archives the partitions that fell out of retention
+type Manager struct {
+	db       *sql.DB
+	tables   []Table
+	archiver Archiver
+	logger   *zap.Logger
+
+	// Ahead is the number of future months that get a partition in advance
+	Ahead int
+}
+
+// NewManager creates a manager for tables. Without an archiver, expired
+// partitions are kept.
+func NewManager(db *sql.DB, archiver Archiver, logger *zap.Logger, tables ...Table) *Manager {
+	return &Manager{
+		db:       db,
+		tables:   tables,
+		archiver: archiver,
+		logger:   logger.Named("partition"),
+		Ahead:    2,
+	}
+}
+
+// Start runs the maintenance immediately and then every interval until ctx
+// is done
+func (m *Manager) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := m.Run(ctx, time.Now()); err != nil {
+				m.logger.Error("Partition maintenance failed", zap.Error(err))
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// lockKey names the advisory lock held during maintenance
+const lockKey = "partition-maintenance"
+
+// Run creates the missing partitions from the month of now to Ahead months
+// later and archives the expired partitions of every table. It goes on with
+// the other tables when one fails and returns the first error. Only one
+// replica maintains the partitions at a time: Run returns without doing
+// anything while another one holds the maintenance lock.
+func (m *Manager) Run(ctx context.Context, now time.Time) error {
+	// The advisory lock belongs to the session, so it is taken and released
+	// on one reserved connection
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get connection: %w", err)
+	}
+	defer conn.Close()
+
+	var locked bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock(hashtext($1))", lockKey).Scan(&locked); err != nil {
+		return fmt.Errorf("failed to take maintenance lock: %w", err)
+	}
+	if !locked {
+		m.logger.Debug("Partition maintenance is running on another replica")
+		return nil
+	}
+	defer func() {
+		// A cancelled ctx must not keep the lock held on the pooled connection
+		if _, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", lockKey); err != nil {
+			m.logger.Warn("Failed to release maintenance lock", zap.Error(err))
+		}
+	}()
+
+	month := monthStart(now)
+
+	var firstErr error
+	for _, table := range m.tables {
+		err := m.createPartitions(ctx, table, month)
+		if err == nil {
+			err = m.archiveExpired(ctx, table, month)
+		}
+		if err != nil {
+			m.logger.Error("Failed to maintain partitions", zap.String("table", table.Name), zap.Error(err))
+			if firstErr == nil {
+				firstErr = err
+			}
+		}
+	}
+	return firstErr
+}
+
+func (m *Manager) createPartitions(ctx context.Context, table Table, month time.Time) error {
+	for i := 0; i <= m.Ahead; i++ {
+		from := month.AddDate(0, i, 0)
+		query := fmt.Sprintf(
+			"CREATE TABLE IF NOT EXISTS %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+			quoteIdent(Name(table.Name, from)), quoteIdent(table.Name),
+			from.Format(time.RFC3339), from.AddDate(0, 1, 0).Format(time.RFC3339),
+		)
+		if _, err := m.db.ExecContext(ctx, query); err != nil {
+			return fmt.Errorf("failed to create partition %s: %w", Name(table.Name, from), err)
+		}
+	}
+	return nil
+}
+
+// archiveExpired archives and drops the partitions of months before the
+// retention window, oldest first
+func (m *Manager) archiveExpired(ctx context.Context, table Table, month time.Time) error {
+	if table.Retention <= 0 || m.archiver == nil {
+		return nil
+	}
+	partitions, err := m.listPartitions(ctx, table.Name)
+	if err != nil {
+		return err
+	}
+	for _, p := range expired(partitions, month, table.Retention) {
+		if err := m.archivePartition(ctx, table.Name, p.name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// expired returns the partitions, sorted oldest first, of months before the
+// retention window of the given number of months preceding month
+func expired(partitions []monthlyPartition, month time.Time, retention int) []monthlyPartition {
+	cutoff := month.AddDate(0, -retention, 0)
+	for i, p := range partitions {
+		if !p.month.Before(cutoff) {
+			return partitions[:i]
+		}
+	}
+	return partitions
+}
+
+// archivePartition exports a partition through the archiver, then detaches
+// and drops it. The export is streamed to the archiver, so partitions of any
+// size are archived in constant memory. A partition whose export or upload
+// fails stays attached and is retried on the next run.
+func (m *Manager) archivePartition(ctx context.Context, table, name string) error {
+	pr, pw := io.Pipe()
+	exported := make(chan error, 1)
+	var rowCount int
+	out := &countingWriter{w: pw}
+	go func() {
+		var err error
+		rowCount, err = m.export(ctx, name, out)
+		pw.CloseWithError(err)
+		exported <- err
+	}()
+
+	err := m.archiver.Archive(ctx, name+".csv.gz", pr)
+	// Unblock the export when the archiver stopped reading early
+	pr.CloseWithError(err)
+	if exportErr := <-exported; exportErr != nil {
+		return fmt.Errorf("failed to export partition %s: %w", name, exportErr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to archive partition %s: %w", name, err)
+	}
+
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", quoteIdent(table), quoteIdent(name))); err != nil {
+		return fmt.Errorf("failed to detach partition %s: %w", name, err)
+	}
+	if _, err := tx.ExecContext(ctx, "DROP TABLE "+quoteIdent(name)); err != nil {
+		return fmt.Errorf("failed to drop partition %s: %w", name, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	m.logger.Info("Archived partition",
+		zap.String("table", table),
+		zap.String("partition", name),
+		zap.Int("rows", rowCount),
+		zap.Int64("bytes", out.n))
+	return nil
+}
+
+// export writes the rows of a partition to w as a gzipped CSV file and
+// returns the number of rows
+func (m *Manager) export(ctx context.Context, name string, w io.Writer) (int, error) {
+	rows, err := m.db.QueryContext(ctx, "SELECT * FROM "+quoteIdent(name))
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, err
+	}
+
+	zw := gzip.NewWriter(w)
+	cw := csv.NewWriter(zw)
+	if err := cw.Write(columns); err != nil {
+		return 0, err
+	}
+
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	record := make([]string, len(columns))
+
+	rowCount := 0
+	for rows.Next() {
+		if err := rows.Scan(dest...); err != nil {
+			return 0, err
+		}
+		// NULL and empty strings both export as empty fields
+		for i, v := range values {
+			record[i] = v.String
+		}
+		if err := cw.Write(record); err != nil {
+			return 0, err
+		}
+		rowCount++
+	}
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return 0, err
+	}
+	if err := zw.Close(); err != nil {
+		return 0, err
+	}
+	return rowCount, nil
+}
+
+// countingWriter counts the bytes written through it
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+type monthlyPartition struct {
+	name  string
+	month time.Time
+}
+
+// listPartitions returns the monthly partitions of a table, oldest first.
+// Partitions not following the naming scheme, such as the default partition,
+// are left out.
+func (m *Manager) listPartitions(ctx context.Context, table string) ([]monthlyPartition, error) {
+	const query = `
+		SELECT child.relname
+		FROM pg_inherits
+		JOIN pg_class parent ON parent.oid = pg_inherits.inhparent
+		JOIN pg_class child ON child.oid = pg_inherits.inhrelid
+		WHERE parent.relname = $1
+		ORDER BY child.relname
+	`
+	rows, err := m.db.QueryContext(ctx, query, table)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list partitions of %s: %w", table, err)
+	}
+	defer rows.Close()
+
+	var partitions []monthlyPartition
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		if month, ok := parseName(table, name); ok {
+			partitions = append(partitions, monthlyPartition{name: name, month: month})
+		}
+	}
+	return partitions, rows.Err()
+}
+
+// Name returns the name of the partition of table holding the month of t
+func Name(table string, t time.Time) string {
+	return fmt.Sprintf("%s_y%04dm%02d", table, t.UTC().Year(), int(t.UTC().Month()))
+}
+
+// parseName returns the month of a partition named by Name
+func parseName(table, name string) (time.Time, bool) {
+	suffix, ok := strings.CutPrefix(name, table+"_")
+	if !ok {
+		return time.Time{}, false
+	}
+	month, err := time.Parse("y2006m01", suffix)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return month, true
+}
+
+func monthStart(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
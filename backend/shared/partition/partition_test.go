@@ -0,0 +1,129 @@
+package partition
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNameRoundTrip(t *testing.T) {
+	month := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	name := Name("price_history", month)
+	if name != "price_history_y2024m03" {
+		t.Fatalf("Name = %q, want price_history_y2024m03", name)
+	}
+	got, ok := parseName("price_history", name)
+	if !ok || !got.Equal(month) {
+		t.Errorf("parseName(%q) = %v, %v, want %v", name, got, ok, month)
+	}
+}
+
+func TestNameUsesUTC(t *testing.T) {
+	// Midnight on the 1st in UTC+2 is still the previous month in UTC
+	zone := time.FixedZone("UTC+2", 2*60*60)
+	if got := Name("t", time.Date(2024, time.April, 1, 1, 0, 0, 0, zone)); got != "t_y2024m03" {
+		t.Errorf("Name = %q, want t_y2024m03", got)
+	}
+}
+
+func TestParseNameRejectsForeignNames(t *testing.T) {
+	for _, name := range []string{
+		"price_history_default",
+		"price_history_y2024m13",
+		"price_history_2024_03",
+		"other_y2024m03",
+		// A table whose name extends the one of another table
+		"price_history_archive_y2024m03",
+	} {
+		if _, ok := parseName("price_history", name); ok {
+			t.Errorf("parseName(%q) accepted a name not made by Name", name)
+		}
+	}
+}
+
+func TestMonthStart(t *testing.T) {
+	got := monthStart(time.Date(2024, time.February, 29, 23, 59, 0, 0, time.UTC))
+	if want := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("monthStart = %v, want %v", got, want)
+	}
+}
+
+func TestExpired(t *testing.T) {
+	var partitions []monthlyPartition
+	for m := time.January; m <= time.June; m++ {
+		month := time.Date(2024, m, 1, 0, 0, 0, 0, time.UTC)
+		partitions = append(partitions, monthlyPartition{name: Name("t", month), month: month})
+	}
+	june := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		retention int
+		want      []string
+	}{
+		// The cutoff month itself is kept
+		{retention: 3, want: []string{"t_y2024m01", "t_y2024m02"}},
+		{retention: 5, want: nil},
+		{retention: 12, want: nil},
+		// Only the current month is kept
+		{retention: 0, want: []string{"t_y2024m01", "t_y2024m02", "t_y2024m03", "t_y2024m04", "t_y2024m05"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, p := range expired(partitions, june, tt.retention) {
+			got = append(got, p.name)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("expired with a retention of %d months = %v, want %v", tt.retention, got, tt.want)
+		}
+	}
+}
+
+func TestExpiredAcrossYears(t *testing.T) {
+	dec := time.Date(2023, time.December, 1, 0, 0, 0, 0, time.UTC)
+	jan := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	partitions := []monthlyPartition{{name: Name("t", dec), month: dec}, {name: Name("t", jan), month: jan}}
+
+	got := expired(partitions, time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC), 1)
+	if len(got) != 1 || got[0].name != "t_y2023m12" {
+		t.Errorf("expired = %v, want only t_y2023m12", got)
+	}
+}
+
+func TestDirArchiver(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "partitions")
+
+	if err := DirArchiver(dir).Archive(context.Background(), "t_y2024m01.csv.gz", strings.NewReader("data")); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Name() != "t_y2024m01.csv.gz" {
+		t.Fatalf("directory holds %v, want only the archive", entries)
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "t_y2024m01.csv.gz"))
+	if err != nil || string(data) != "data" {
+		t.Errorf("archive = %q, %v, want data", data, err)
+	}
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, os.ErrClosed }
+
+func TestDirArchiverLeavesNothingOnFailure(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := DirArchiver(dir).Archive(context.Background(), "t_y2024m01.csv.gz", failingReader{}); err == nil {
+		t.Fatal("Archive succeeded on a failing reader")
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("directory holds %v after a failed archive, want nothing", entries)
+	}
+}
@@ -12,6 +12,10 @@ type CacheInterface interface {
 	GetUser(ctx context.Context, userID string) (*models.User, error)
 	SetUser(ctx context.Context, user *models.User) error
 
+	// User stats methods
+	GetUserStats(ctx context.Context, userID string) (*models.UserStats, error)
+	SetUserStats(ctx context.Context, stats *models.UserStats) error
+
 	// Token methods
 	StoreToken(ctx context.Context, userID, tokenType, token string) error
 	GetToken(ctx context.Context, userID, tokenType string) (string, error)
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
 	sharedCache "github.com/louai60/e-commerce_project/backend/shared/cache"
 	"github.com/louai60/e-commerce_project/backend/user-service/models"
 	"go.uber.org/zap"
@@ -67,8 +68,15 @@ func NewTieredUserCacheManager(opts TieredUserCacheOptions) (*TieredUserCacheMan
 func (cm *TieredUserCacheManager) GetUser(ctx context.Context, userID string) (*models.User, error) {
 	key := fmt.Sprintf("%s%s", UserKeyPrefix, userID)
 
+	// Admins may bypass the cache to see the stored profile
+	if cachectl.Bypassed(ctx) {
+		cachectl.Record(ctx, cachectl.StatusBypass)
+		return nil, fmt.Errorf("cache bypassed")
+	}
+
 	var user models.User
 	err := cm.tieredCache.GetObject(ctx, key, "user", &user)
+	cachectl.RecordLookup(ctx, err == nil)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +90,31 @@ func (cm *TieredUserCacheManager) SetUser(ctx context.Context, user *models.User
 	return cm.tieredCache.SetObject(ctx, key, user, "user")
 }
 
+// GetUserStats retrieves the lifetime metrics of a user from the cache
+func (cm *TieredUserCacheManager) GetUserStats(ctx context.Context, userID string) (*models.UserStats, error) {
+	key := fmt.Sprintf("%s%s", UserStatsKeyPrefix, userID)
+
+	if cachectl.Bypassed(ctx) {
+		cachectl.Record(ctx, cachectl.StatusBypass)
+		return nil, fmt.Errorf("cache bypassed")
+	}
+
+	var stats models.UserStats
+	err := cm.tieredCache.GetObject(ctx, key, "user", &stats)
+	cachectl.RecordLookup(ctx, err == nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// SetUserStats stores the lifetime metrics of a user in the cache
+func (cm *TieredUserCacheManager) SetUserStats(ctx context.Context, stats *models.UserStats) error {
+	key := fmt.Sprintf("%s%s", UserStatsKeyPrefix, stats.UserID)
+	return cm.tieredCache.SetObject(ctx, key, stats, "user")
+}
+
 // StoreToken stores a token in the cache
 func (cm *TieredUserCacheManager) StoreToken(ctx context.Context, userID, tokenType, token string) error {
 	key := fmt.Sprintf("%s%s:%s", TokenKeyPrefix, userID, tokenType)
@@ -131,6 +164,18 @@ func (cm *TieredUserCacheManager) InvalidateSession(ctx context.Context, session
 	return cm.tieredCache.Delete(ctx, key)
 }
 
+// ApplyTTLs sets the default TTL and the per key type TTLs
+func (cm *TieredUserCacheManager) ApplyTTLs(defaultTTL time.Duration, ttls map[string]time.Duration) {
+	if defaultTTL > 0 {
+		cm.tieredCache.SetDefaultTTL(defaultTTL)
+	}
+	for keyType, ttl := range ttls {
+		if ttl > 0 {
+			cm.tieredCache.SetTTL(keyType, ttl)
+		}
+	}
+}
+
 // WarmupResult contains the results of a cache warm-up operation
 type WarmupResult struct {
 	// SuccessCount is the number of successfully warmed up keys
@@ -159,6 +204,16 @@ func (cm *TieredUserCacheManager) GetCacheMetrics(ctx context.Context) (map[stri
 	return cm.tieredCache.GetMetrics(), nil
 }
 
+// Stats returns a snapshot of the cache counters and circuit breaker state
+func (cm *TieredUserCacheManager) Stats() sharedCache.Stats {
+	return cm.tieredCache.Stats()
+}
+
+// HealthCheck checks if the cache is healthy
+func (cm *TieredUserCacheManager) HealthCheck(ctx context.Context) error {
+	return cm.tieredCache.HealthCheck(ctx)
+}
+
 // ResetCacheMetrics resets the cache metrics
 func (cm *TieredUserCacheManager) ResetCacheMetrics() {
 	cm.tieredCache.ResetMetrics()
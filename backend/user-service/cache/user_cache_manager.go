@@ -11,12 +11,13 @@ import (
 )
 
 const (
-	UserKeyPrefix     = "user:"
-	TokenKeyPrefix    = "token:"
-	SessionKeyPrefix  = "session:"
-	DefaultUserTTL    = 30 * time.Minute
-	DefaultTokenTTL   = 24 * time.Hour
-	DefaultSessionTTL = 7 * 24 * time.Hour
+	UserKeyPrefix      = "user:"
+	UserStatsKeyPrefix = "user_stats:"
+	TokenKeyPrefix     = "token:"
+	SessionKeyPrefix   = "session:"
+	DefaultUserTTL     = 30 * time.Minute
+	DefaultTokenTTL    = 24 * time.Hour
+	DefaultSessionTTL  = 7 * 24 * time.Hour
 )
 
 type UserCacheManager struct {
@@ -63,6 +64,32 @@ func (cm *UserCacheManager) SetUser(ctx context.Context, user *models.User) erro
 	return cm.client.Set(ctx, key, data, DefaultUserTTL).Err()
 }
 
+// User stats caching
+func (cm *UserCacheManager) GetUserStats(ctx context.Context, userID string) (*models.UserStats, error) {
+	key := fmt.Sprintf("%s%s", UserStatsKeyPrefix, userID)
+	data, err := cm.client.Get(ctx, key).Bytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var stats models.UserStats
+	if err := json.Unmarshal(data, &stats); err != nil {
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+func (cm *UserCacheManager) SetUserStats(ctx context.Context, stats *models.UserStats) error {
+	key := fmt.Sprintf("%s%s", UserStatsKeyPrefix, stats.UserID)
+	data, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+
+	return cm.client.Set(ctx, key, data, DefaultUserTTL).Err()
+}
+
 // Token caching
 func (cm *UserCacheManager) StoreToken(ctx context.Context, userID, tokenType, token string) error {
 	key := fmt.Sprintf("%s%s:%s", TokenKeyPrefix, userID, tokenType)
@@ -53,7 +53,18 @@ type Config struct {
 		Attempts int
 		Duration time.Duration
 	}
-	Auth AuthConfig
+	// Profiling serves the pprof endpoints on Addr, which should stay internal
+	Profiling struct {
+		Enabled bool
+		Addr    string
+	}
+	Auth          AuthConfig
+	Cache         CacheConfig
+	CartReminders CartRemindersConfig `mapstructure:"cartReminders"`
+	Mail          MailConfig          `mapstructure:"mail"`
+	Invitations   InvitationsConfig   `mapstructure:"invitations"`
+	Quotes        QuotesConfig        `mapstructure:"quotes"`
+	Credit        CreditConfig        `mapstructure:"credit"`
 }
 
 type ServerConfig struct {
@@ -84,6 +95,65 @@ type AuthConfig struct {
 	AccessTokenDuration  time.Duration `mapstructure:"accessTokenDuration"`
 	RefreshTokenDuration time.Duration `mapstructure:"refreshTokenDuration"`
 	TokenDuration        time.Duration `mapstructure:"tokenDuration"`
+	// Keys configures the rotation of the token signing keys
+	Keys SigningKeysConfig `mapstructure:"keys"`
+}
+
+// SigningKeysConfig configures the directory of the token signing keys and
+// their rotation. A new key is published PublishDelay before it signs, so
+// that the gateway has fetched it from the JWKS by then.
+type SigningKeysConfig struct {
+	Dir              string        `mapstructure:"dir"`
+	RotationInterval time.Duration `mapstructure:"rotationInterval"` // 0 disables rotation
+	PublishDelay     time.Duration `mapstructure:"publishDelay"`
+	ReloadInterval   time.Duration `mapstructure:"reloadInterval"`
+}
+
+// CacheConfig holds the cache TTLs
+type CacheConfig struct {
+	DefaultTTL time.Duration `mapstructure:"defaultTTL"`
+	// TTLs overrides the TTL per key type: user, token or session
+	TTLs map[string]time.Duration `mapstructure:"ttls"`
+}
+
+// CartRemindersConfig configures the detection of abandoned carts. Carts
+// left unchanged for InactiveAfter are reminded once, unless their last
+// change is older than MaxAge.
+type CartRemindersConfig struct {
+	Enabled       bool          `mapstructure:"enabled"`
+	Interval      time.Duration `mapstructure:"interval"`
+	InactiveAfter time.Duration `mapstructure:"inactiveAfter"`
+	MaxAge        time.Duration `mapstructure:"maxAge"`
+}
+
+// MailConfig holds the SMTP relay emails are sent through. Emails are only
+// logged when SMTPHost is not set.
+type MailConfig struct {
+	SMTPHost     string `mapstructure:"smtpHost"`
+	SMTPPort     int    `mapstructure:"smtpPort"`
+	SMTPUsername string `mapstructure:"smtpUsername"`
+	SMTPPassword string `mapstructure:"smtpPassword"`
+	From         string `mapstructure:"from"`
+}
+
+// InvitationsConfig configures the invitations of imported users. URL is the
+// storefront page setting the password, with {token} where the invitation
+// token goes.
+type InvitationsConfig struct {
+	URL string        `mapstructure:"url"`
+	TTL time.Duration `mapstructure:"ttl"`
+}
+
+// QuotesConfig configures how often the quotes past their expiry are
+// expired
+type QuotesConfig struct {
+	ExpiryInterval time.Duration `mapstructure:"expiryInterval"`
+}
+
+// CreditConfig configures how often the credit charges of companies are
+// checked for being overdue
+type CreditConfig struct {
+	OverdueInterval time.Duration `mapstructure:"overdueInterval"`
 }
 
 type RateLimiter struct {
@@ -131,8 +201,24 @@ func LoadConfig() (*Config, error) {
 	// Set default values
 	v.SetDefault("auth.accessTokenDuration", "1h")
 	v.SetDefault("auth.refreshTokenDuration", "24h")
+	v.SetDefault("auth.keys.dir", "certificates/keys")
+	v.SetDefault("auth.keys.rotationInterval", "720h")
+	v.SetDefault("auth.keys.publishDelay", "1h")
+	v.SetDefault("auth.keys.reloadInterval", "1m")
 	v.SetDefault("rateLimiter.attempts", 5)
 	v.SetDefault("rateLimiter.duration", "1m")
+	v.SetDefault("profiling.enabled", false)
+	v.SetDefault("profiling.addr", "127.0.0.1:6063")
+	v.SetDefault("cache.defaultTTL", "30m")
+	v.SetDefault("cartReminders.enabled", true)
+	v.SetDefault("cartReminders.interval", "15m")
+	v.SetDefault("cartReminders.inactiveAfter", "4h")
+	v.SetDefault("cartReminders.maxAge", "72h")
+	v.SetDefault("mail.smtpPort", 587)
+	v.SetDefault("invitations.url", "http://localhost:3000/account/invitation?token={token}")
+	v.SetDefault("invitations.ttl", "168h")
+	v.SetDefault("quotes.expiryInterval", "5m")
+	v.SetDefault("credit.overdueInterval", "1h")
 
 	// Enable environment variable replacement
 	v.AutomaticEnv()
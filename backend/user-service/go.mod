@@ -9,6 +9,7 @@ require (
 	github.com/jmoiron/sqlx v1.4.0
 	github.com/joho/godotenv v1.5.1
 	github.com/lib/pq v1.10.9
+	github.com/louai60/e-commerce_project/backend/common v0.0.0
 	github.com/louai60/e-commerce_project/backend/shared v0.0.0
 	github.com/nbutton23/zxcvbn-go v0.0.0-20210217022336-fa2cb2858354
 	github.com/spf13/viper v1.18.2
@@ -47,4 +48,6 @@ require (
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
+replace github.com/louai60/e-commerce_project/backend/common => ../common
+
 replace github.com/louai60/e-commerce_project/backend/shared => ../shared
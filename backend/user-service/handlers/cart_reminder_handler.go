@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// defaultCartReminderStatsPeriod is the period of the cart reminder stats
+// when no start is given
+const defaultCartReminderStatsPeriod = 30 * 24 * time.Hour
+
+func (h *UserHandler) ListCartReminderEvents(ctx context.Context, req *pb.ListCartReminderEventsRequest) (*pb.ListCartReminderEventsResponse, error) {
+	reminders, err := h.service.ListCartReminderEvents(ctx, req.AfterId, int(req.Limit))
+	if err != nil {
+		return nil, h.cartReminderError(err, "failed to list cart reminder events")
+	}
+
+	response := &pb.ListCartReminderEventsResponse{Events: make([]*pb.CartReminderEvent, len(reminders))}
+	for i, reminder := range reminders {
+		response.Events[i] = &pb.CartReminderEvent{
+			Id:            reminder.ID,
+			UserId:        reminder.UserID.String(),
+			Email:         reminder.Email,
+			FirstName:     reminder.FirstName,
+			ItemCount:     int32(len(reminder.Items)),
+			Items:         convertShopperListToProto(models.ListCart, reminder.Items).Items,
+			CartUpdatedAt: reminder.CartUpdatedAt.Format(time.RFC3339),
+			RemindedAt:    reminder.RemindedAt.Format(time.RFC3339),
+		}
+	}
+	return response, nil
+}
+
+func (h *UserHandler) RecordCartConversion(ctx context.Context, req *pb.RecordCartConversionRequest) (*pb.RecordCartConversionResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	reminder, err := h.service.RecordCartConversion(ctx, userID, req.OrderReference)
+	if err != nil {
+		return nil, h.cartReminderError(err, "failed to record cart conversion")
+	}
+	if reminder == nil {
+		return &pb.RecordCartConversionResponse{}, nil
+	}
+	return &pb.RecordCartConversionResponse{Converted: true, ReminderId: reminder.ID}, nil
+}
+
+func (h *UserHandler) GetCartReminderStats(ctx context.Context, req *pb.GetCartReminderStatsRequest) (*pb.CartReminderStatsResponse, error) {
+	to := time.Now()
+	if req.To != "" {
+		parsed, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid to timestamp, expected RFC3339")
+		}
+		to = parsed
+	}
+	from := to.Add(-defaultCartReminderStatsPeriod)
+	if req.From != "" {
+		parsed, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid from timestamp, expected RFC3339")
+		}
+		from = parsed
+	}
+
+	stats, err := h.service.GetCartReminderStats(ctx, from, to)
+	if err != nil {
+		return nil, h.cartReminderError(err, "failed to get cart reminder stats")
+	}
+	return &pb.CartReminderStatsResponse{
+		Reminded:       stats.Reminded,
+		Converted:      stats.Converted,
+		ConversionRate: stats.ConversionRate(),
+	}, nil
+}
+
+// cartReminderError maps the errors of cart reminder operations to gRPC
+// status errors
+func (h *UserHandler) cartReminderError(err error, msg string) error {
+	if errors.Is(err, models.ErrInvalidOrderReference) || errors.Is(err, models.ErrInvalidStatsPeriod) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	h.logger.Error(msg, zap.Error(err))
+	return status.Error(codes.Internal, msg)
+}
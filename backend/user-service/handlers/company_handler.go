@@ -0,0 +1,326 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+func (h *UserHandler) CreateCompany(ctx context.Context, req *pb.CreateCompanyRequest) (*pb.CompanyResponse, error) {
+	company, err := h.service.CreateCompany(ctx, &models.Company{
+		Name:              req.Name,
+		PaymentTerms:      req.PaymentTerms,
+		ApprovalThreshold: approvalThreshold(req.RequiresApproval, req.ApprovalThreshold),
+	})
+	if err != nil {
+		return nil, h.companyError(err, "failed to create company")
+	}
+	return &pb.CompanyResponse{Company: convertCompanyToProto(company)}, nil
+}
+
+func (h *UserHandler) UpdateCompany(ctx context.Context, req *pb.UpdateCompanyRequest) (*pb.CompanyResponse, error) {
+	companyID, err := uuid.Parse(req.CompanyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+	}
+
+	company, err := h.service.UpdateCompany(ctx, &models.Company{
+		CompanyID:         companyID,
+		Name:              req.Name,
+		PaymentTerms:      req.PaymentTerms,
+		ApprovalThreshold: approvalThreshold(req.RequiresApproval, req.ApprovalThreshold),
+	})
+	if err != nil {
+		return nil, h.companyError(err, "failed to update company")
+	}
+	return &pb.CompanyResponse{Company: convertCompanyToProto(company)}, nil
+}
+
+func (h *UserHandler) GetCompany(ctx context.Context, req *pb.GetCompanyRequest) (*pb.CompanyResponse, error) {
+	companyID, err := uuid.Parse(req.CompanyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+	}
+
+	company, err := h.service.GetCompany(ctx, companyID)
+	if err != nil {
+		return nil, h.companyError(err, "failed to get company")
+	}
+	return &pb.CompanyResponse{Company: convertCompanyToProto(company)}, nil
+}
+
+func (h *UserHandler) ListCompanies(ctx context.Context, req *pb.ListCompaniesRequest) (*pb.ListCompaniesResponse, error) {
+	companies, total, err := h.service.ListCompanies(ctx, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, h.companyError(err, "failed to list companies")
+	}
+
+	response := &pb.ListCompaniesResponse{Companies: make([]*pb.Company, len(companies)), Total: total}
+	for i, company := range companies {
+		response.Companies[i] = convertCompanyToProto(company)
+	}
+	return response, nil
+}
+
+func (h *UserHandler) SetCompanyMember(ctx context.Context, req *pb.SetCompanyMemberRequest) (*pb.CompanyMemberResponse, error) {
+	companyID, err := uuid.Parse(req.CompanyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	member, err := h.service.SetCompanyMember(ctx, companyID, userID, req.Role)
+	if err != nil {
+		return nil, h.companyError(err, "failed to set company member")
+	}
+	return &pb.CompanyMemberResponse{Member: convertCompanyMemberToProto(member)}, nil
+}
+
+func (h *UserHandler) RemoveCompanyMember(ctx context.Context, req *pb.RemoveCompanyMemberRequest) (*pb.DeleteResponse, error) {
+	companyID, err := uuid.Parse(req.CompanyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+	}
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	if err := h.service.RemoveCompanyMember(ctx, companyID, userID); err != nil {
+		return nil, h.companyError(err, "failed to remove company member")
+	}
+
+	return &pb.DeleteResponse{
+		Success: true,
+		Message: "company member removed successfully",
+	}, nil
+}
+
+func (h *UserHandler) AddCompanyAddress(ctx context.Context, req *pb.AddCompanyAddressRequest) (*pb.CompanyAddressResponse, error) {
+	if req.Address == nil {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+	companyID, err := uuid.Parse(req.Address.CompanyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+	}
+
+	address, err := h.service.AddCompanyAddress(ctx, &models.CompanyAddress{
+		CompanyID:      companyID,
+		Label:          req.Address.Label,
+		AddressType:    req.Address.AddressType,
+		StreetAddress1: req.Address.StreetAddress1,
+		StreetAddress2: req.Address.StreetAddress2,
+		City:           req.Address.City,
+		State:          req.Address.State,
+		PostalCode:     req.Address.PostalCode,
+		Country:        req.Address.Country,
+		IsDefault:      req.Address.IsDefault,
+	})
+	if err != nil {
+		return nil, h.companyError(err, "failed to add company address")
+	}
+	return &pb.CompanyAddressResponse{Address: convertCompanyAddressToProto(address)}, nil
+}
+
+func (h *UserHandler) RemoveCompanyAddress(ctx context.Context, req *pb.RemoveCompanyAddressRequest) (*pb.DeleteResponse, error) {
+	companyID, err := uuid.Parse(req.CompanyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+	}
+	addressID, err := uuid.Parse(req.AddressId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address ID format")
+	}
+
+	if err := h.service.RemoveCompanyAddress(ctx, companyID, addressID); err != nil {
+		return nil, h.companyError(err, "failed to remove company address")
+	}
+
+	return &pb.DeleteResponse{
+		Success: true,
+		Message: "company address removed successfully",
+	}, nil
+}
+
+func (h *UserHandler) GetUserCompany(ctx context.Context, req *pb.GetUserCompanyRequest) (*pb.CompanyResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	company, member, err := h.service.GetUserCompany(ctx, userID)
+	if err != nil {
+		return nil, h.companyError(err, "failed to get user company")
+	}
+	return &pb.CompanyResponse{
+		Company:    convertCompanyToProto(company),
+		Membership: convertCompanyMemberToProto(member),
+	}, nil
+}
+
+func (h *UserHandler) RequestPurchaseApproval(ctx context.Context, req *pb.RequestPurchaseApprovalRequest) (*pb.PurchaseApprovalResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	approval, err := h.service.RequestPurchaseApproval(ctx, userID, req.OrderReference, req.Amount)
+	if err != nil {
+		return nil, h.companyError(err, "failed to request purchase approval")
+	}
+	return &pb.PurchaseApprovalResponse{Approval: convertPurchaseApprovalToProto(approval)}, nil
+}
+
+func (h *UserHandler) DecidePurchaseApproval(ctx context.Context, req *pb.DecidePurchaseApprovalRequest) (*pb.PurchaseApprovalResponse, error) {
+	approvalID, err := uuid.Parse(req.ApprovalId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid approval ID format")
+	}
+	approverID, err := uuid.Parse(req.ApproverId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid approver ID format")
+	}
+
+	approval, err := h.service.DecidePurchaseApproval(ctx, approverID, approvalID, req.Approve, req.Notes)
+	if err != nil {
+		return nil, h.companyError(err, "failed to decide purchase approval")
+	}
+	return &pb.PurchaseApprovalResponse{Approval: convertPurchaseApprovalToProto(approval)}, nil
+}
+
+func (h *UserHandler) ListPurchaseApprovals(ctx context.Context, req *pb.ListPurchaseApprovalsRequest) (*pb.ListPurchaseApprovalsResponse, error) {
+	var approvals []models.PurchaseApproval
+	var total int64
+	switch {
+	case req.CompanyId != "":
+		companyID, err := uuid.Parse(req.CompanyId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+		}
+		approvals, total, err = h.service.ListCompanyPurchaseApprovals(ctx, companyID, req.Status, int(req.Page), int(req.Limit))
+		if err != nil {
+			return nil, h.companyError(err, "failed to list purchase approvals")
+		}
+	case req.UserId != "":
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+		}
+		approvals, total, err = h.service.ListUserPurchaseApprovals(ctx, userID, req.Status, int(req.Page), int(req.Limit))
+		if err != nil {
+			return nil, h.companyError(err, "failed to list purchase approvals")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "company ID or user ID is required")
+	}
+
+	response := &pb.ListPurchaseApprovalsResponse{Approvals: make([]*pb.PurchaseApproval, len(approvals)), Total: total}
+	for i := range approvals {
+		response.Approvals[i] = convertPurchaseApprovalToProto(&approvals[i])
+	}
+	return response, nil
+}
+
+// companyError maps the errors of company operations to gRPC status errors
+func (h *UserHandler) companyError(err error, msg string) error {
+	switch kind := apperrors.KindOf(err); kind {
+	case apperrors.ErrNotFound, apperrors.ErrAlreadyExists, apperrors.ErrInvalidArgument,
+		apperrors.ErrFailedPrecondition, apperrors.ErrPermissionDenied:
+		return status.Error(kind.Code(), err.Error())
+	}
+	h.logger.Error(msg, zap.Error(err))
+	return status.Error(codes.Internal, msg)
+}
+
+// approvalThreshold returns the approval threshold of a company, nil when
+// its purchases need no approval
+func approvalThreshold(required bool, threshold float64) *float64 {
+	if !required {
+		return nil
+	}
+	return &threshold
+}
+
+func convertCompanyToProto(company *models.Company) *pb.Company {
+	response := &pb.Company{
+		CompanyId:    company.CompanyID.String(),
+		Name:         company.Name,
+		PaymentTerms: company.PaymentTerms,
+		CreatedAt:    company.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:    company.UpdatedAt.Format(time.RFC3339),
+		Members:      make([]*pb.CompanyMember, len(company.Members)),
+		Addresses:    make([]*pb.CompanyAddress, len(company.Addresses)),
+	}
+	if company.ApprovalThreshold != nil {
+		response.RequiresApproval = true
+		response.ApprovalThreshold = *company.ApprovalThreshold
+	}
+	for i := range company.Members {
+		response.Members[i] = convertCompanyMemberToProto(&company.Members[i])
+	}
+	for i := range company.Addresses {
+		response.Addresses[i] = convertCompanyAddressToProto(&company.Addresses[i])
+	}
+	return response
+}
+
+func convertCompanyMemberToProto(member *models.CompanyMember) *pb.CompanyMember {
+	return &pb.CompanyMember{
+		CompanyId: member.CompanyID.String(),
+		UserId:    member.UserID.String(),
+		Email:     member.Email,
+		FirstName: member.FirstName,
+		LastName:  member.LastName,
+		Role:      member.Role,
+		AddedAt:   member.AddedAt.Format(time.RFC3339),
+	}
+}
+
+func convertCompanyAddressToProto(address *models.CompanyAddress) *pb.CompanyAddress {
+	return &pb.CompanyAddress{
+		AddressId:      address.AddressID.String(),
+		CompanyId:      address.CompanyID.String(),
+		Label:          address.Label,
+		AddressType:    address.AddressType,
+		StreetAddress1: address.StreetAddress1,
+		StreetAddress2: address.StreetAddress2,
+		City:           address.City,
+		State:          address.State,
+		PostalCode:     address.PostalCode,
+		Country:        address.Country,
+		IsDefault:      address.IsDefault,
+		CreatedAt:      address.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func convertPurchaseApprovalToProto(approval *models.PurchaseApproval) *pb.PurchaseApproval {
+	response := &pb.PurchaseApproval{
+		ApprovalId:     approval.ApprovalID.String(),
+		CompanyId:      approval.CompanyID.String(),
+		RequesterId:    approval.RequesterID.String(),
+		OrderReference: approval.OrderReference,
+		Amount:         approval.Amount,
+		Status:         approval.Status,
+		Notes:          approval.Notes,
+		CreatedAt:      approval.CreatedAt.Format(time.RFC3339),
+	}
+	if approval.DecidedBy != nil {
+		response.DecidedBy = approval.DecidedBy.String()
+	}
+	if approval.DecidedAt != nil {
+		response.DecidedAt = approval.DecidedAt.Format(time.RFC3339)
+	}
+	return response
+}
@@ -0,0 +1,207 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+func (h *UserHandler) SetCreditLimit(ctx context.Context, req *pb.SetCreditLimitRequest) (*pb.CreditAccountResponse, error) {
+	companyID, err := uuid.Parse(req.CompanyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+	}
+
+	account, err := h.service.SetCreditLimit(ctx, companyID, req.CreditLimit)
+	if err != nil {
+		return nil, h.creditError(err, "failed to set credit limit")
+	}
+	return &pb.CreditAccountResponse{Account: convertCreditAccountToProto(account)}, nil
+}
+
+func (h *UserHandler) GetCreditAccount(ctx context.Context, req *pb.GetCreditAccountRequest) (*pb.CreditAccountResponse, error) {
+	var account *models.CreditAccount
+	if req.UserId != "" {
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+		}
+		if account, err = h.service.GetUserCreditAccount(ctx, userID); err != nil {
+			return nil, h.creditError(err, "failed to get credit account")
+		}
+	} else {
+		companyID, err := uuid.Parse(req.CompanyId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+		}
+		if account, err = h.service.GetCreditAccount(ctx, companyID); err != nil {
+			return nil, h.creditError(err, "failed to get credit account")
+		}
+	}
+	return &pb.CreditAccountResponse{Account: convertCreditAccountToProto(account)}, nil
+}
+
+func (h *UserHandler) ChargeCredit(ctx context.Context, req *pb.ChargeCreditRequest) (*pb.CreditChargeResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	approvalID, err := uuid.Parse(req.ApprovalId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid approval ID format")
+	}
+
+	charge, err := h.service.ChargeCredit(ctx, userID, approvalID)
+	if err != nil {
+		return nil, h.creditError(err, "failed to charge credit")
+	}
+	return &pb.CreditChargeResponse{Charge: convertCreditChargeToProto(charge)}, nil
+}
+
+func (h *UserHandler) RecordCreditPayment(ctx context.Context, req *pb.RecordCreditPaymentRequest) (*pb.CreditPaymentResponse, error) {
+	companyID, err := uuid.Parse(req.CompanyId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+	}
+
+	payment, err := h.service.RecordCreditPayment(ctx, companyID, req.Amount, req.Reference)
+	if err != nil {
+		return nil, h.creditError(err, "failed to record credit payment")
+	}
+	return &pb.CreditPaymentResponse{Payment: convertCreditPaymentToProto(payment)}, nil
+}
+
+func (h *UserHandler) GetCreditStatement(ctx context.Context, req *pb.GetCreditStatementRequest) (*pb.CreditStatementResponse, error) {
+	var from, to time.Time
+	if req.From != "" {
+		parsed, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid from timestamp, expected RFC3339")
+		}
+		from = parsed
+	}
+	if req.To != "" {
+		parsed, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid to timestamp, expected RFC3339")
+		}
+		to = parsed
+	}
+
+	var statement *models.CreditStatement
+	if req.UserId != "" {
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+		}
+		if statement, err = h.service.GetUserCreditStatement(ctx, userID, from, to); err != nil {
+			return nil, h.creditError(err, "failed to get credit statement")
+		}
+	} else {
+		companyID, err := uuid.Parse(req.CompanyId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid company ID format")
+		}
+		if statement, err = h.service.GetCreditStatement(ctx, companyID, from, to); err != nil {
+			return nil, h.creditError(err, "failed to get credit statement")
+		}
+	}
+
+	response := &pb.CreditStatementResponse{
+		CompanyId:      statement.CompanyID.String(),
+		From:           statement.From.Format(time.RFC3339),
+		To:             statement.To.Format(time.RFC3339),
+		OpeningBalance: statement.OpeningBalance,
+		ClosingBalance: statement.ClosingBalance,
+		Charges:        make([]*pb.CreditCharge, len(statement.Charges)),
+		Payments:       make([]*pb.CreditPayment, len(statement.Payments)),
+	}
+	for i := range statement.Charges {
+		response.Charges[i] = convertCreditChargeToProto(&statement.Charges[i])
+	}
+	for i := range statement.Payments {
+		response.Payments[i] = convertCreditPaymentToProto(&statement.Payments[i])
+	}
+	return response, nil
+}
+
+func (h *UserHandler) ListCreditEvents(ctx context.Context, req *pb.ListCreditEventsRequest) (*pb.ListCreditEventsResponse, error) {
+	events, err := h.service.ListCreditEvents(ctx, req.AfterId, int(req.Limit))
+	if err != nil {
+		return nil, h.creditError(err, "failed to list credit events")
+	}
+
+	response := &pb.ListCreditEventsResponse{Events: make([]*pb.CreditEvent, len(events))}
+	for i, event := range events {
+		response.Events[i] = &pb.CreditEvent{
+			Id:             event.ID,
+			CompanyId:      event.CompanyID.String(),
+			ChargeId:       event.ChargeID.String(),
+			UserId:         event.UserID.String(),
+			Email:          event.Email,
+			OrderReference: event.OrderReference,
+			Outstanding:    event.Outstanding,
+			DueAt:          event.DueAt.Format(time.RFC3339),
+			CreatedAt:      event.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return response, nil
+}
+
+// creditError maps the errors of credit account operations to gRPC status
+// errors
+func (h *UserHandler) creditError(err error, msg string) error {
+	switch kind := apperrors.KindOf(err); kind {
+	case apperrors.ErrNotFound, apperrors.ErrInvalidArgument, apperrors.ErrFailedPrecondition, apperrors.ErrPermissionDenied:
+		return status.Error(kind.Code(), err.Error())
+	}
+	h.logger.Error(msg, zap.Error(err))
+	return status.Error(codes.Internal, msg)
+}
+
+func convertCreditAccountToProto(account *models.CreditAccount) *pb.CreditAccount {
+	return &pb.CreditAccount{
+		CompanyId:   account.CompanyID.String(),
+		CreditLimit: account.CreditLimit,
+		Balance:     account.Balance,
+		Available:   account.Available(),
+		Overdue:     account.Overdue,
+		UpdatedAt:   account.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func convertCreditChargeToProto(charge *models.CreditCharge) *pb.CreditCharge {
+	response := &pb.CreditCharge{
+		ChargeId:       charge.ChargeID.String(),
+		CompanyId:      charge.CompanyID.String(),
+		UserId:         charge.UserID.String(),
+		OrderReference: charge.OrderReference,
+		Amount:         charge.Amount,
+		Outstanding:    charge.Outstanding,
+		DueAt:          charge.DueAt.Format(time.RFC3339),
+		CreatedAt:      charge.CreatedAt.Format(time.RFC3339),
+	}
+	if charge.OverdueAt != nil {
+		response.OverdueAt = charge.OverdueAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func convertCreditPaymentToProto(payment *models.CreditPayment) *pb.CreditPayment {
+	return &pb.CreditPayment{
+		PaymentId: payment.PaymentID.String(),
+		CompanyId: payment.CompanyID.String(),
+		Amount:    payment.Amount,
+		Reference: payment.Reference,
+		CreatedAt: payment.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+func (h *UserHandler) ImportUsers(ctx context.Context, req *pb.ImportUsersRequest) (*pb.ImportUsersResponse, error) {
+	actorID, err := uuid.Parse(req.ActorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid actor ID format")
+	}
+
+	result, err := h.service.ImportUsers(ctx, actorID, req.Csv)
+	if err != nil {
+		return nil, h.invitationError(err, "failed to import users")
+	}
+
+	response := &pb.ImportUsersResponse{
+		Rows:    make([]*pb.ImportRowResult, len(result.Rows)),
+		Invited: int32(result.Invited),
+		Failed:  int32(result.Failed),
+	}
+	for i, row := range result.Rows {
+		response.Rows[i] = &pb.ImportRowResult{
+			Row:       int32(row.Row),
+			Email:     row.Email,
+			Status:    row.Status,
+			Error:     row.Error,
+			EmailSent: row.EmailSent,
+		}
+		if row.UserID != uuid.Nil {
+			response.Rows[i].UserId = row.UserID.String()
+		}
+	}
+	return response, nil
+}
+
+func (h *UserHandler) ResendInvitation(ctx context.Context, req *pb.ResendInvitationRequest) (*pb.InvitationResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	actorID, err := uuid.Parse(req.ActorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid actor ID format")
+	}
+
+	invitation, err := h.service.ResendInvitation(ctx, actorID, userID)
+	if err != nil {
+		return nil, h.invitationError(err, "failed to resend invitation")
+	}
+	return &pb.InvitationResponse{
+		UserId:    invitation.UserID.String(),
+		Email:     invitation.Email,
+		SendCount: int32(invitation.SendCount),
+		ExpiresAt: invitation.ExpiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+func (h *UserHandler) AcceptInvitation(ctx context.Context, req *pb.AcceptInvitationRequest) (*pb.UserResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	user, err := h.service.AcceptInvitation(ctx, req.Token, req.Password)
+	if err != nil {
+		return nil, h.invitationError(err, "failed to accept invitation")
+	}
+	return &pb.UserResponse{User: convertUserToProto(user)}, nil
+}
+
+// invitationError maps the errors of user imports and invitations to gRPC
+// status errors
+func (h *UserHandler) invitationError(err error, msg string) error {
+	switch {
+	case errors.Is(err, models.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, models.ErrInvitationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrNotInvited):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, models.ErrImportDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case apperrors.KindOf(err) == apperrors.ErrInvalidArgument:
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	h.logger.Error(msg, zap.Error(err))
+	return status.Error(codes.Internal, msg)
+}
@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+// GetJWKS returns the public keys of the signing keys that are still valid,
+// newest first. Services verifying access tokens fetch them to follow key
+// rotations.
+func (h *UserHandler) GetJWKS(ctx context.Context, req *pb.GetJWKSRequest) (*pb.GetJWKSResponse, error) {
+	set := h.tokenManager.JWKS()
+
+	resp := &pb.GetJWKSResponse{Keys: make([]*pb.JWK, 0, len(set.Keys))}
+	for _, key := range set.Keys {
+		resp.Keys = append(resp.Keys, &pb.JWK{
+			Kid: key.Kid,
+			Kty: key.Kty,
+			Alg: key.Alg,
+			Use: key.Use,
+			N:   key.N,
+			E:   key.E,
+		})
+	}
+	return resp, nil
+}
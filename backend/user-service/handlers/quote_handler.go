@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+func (h *UserHandler) RequestQuote(ctx context.Context, req *pb.RequestQuoteRequest) (*pb.QuoteResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	quote, err := h.service.RequestQuote(ctx, userID, req.Notes)
+	if err != nil {
+		return nil, h.quoteError(err, "failed to request quote")
+	}
+	return &pb.QuoteResponse{Quote: convertQuoteToProto(quote)}, nil
+}
+
+func (h *UserHandler) RespondToQuote(ctx context.Context, req *pb.RespondToQuoteRequest) (*pb.QuoteResponse, error) {
+	quoteID, err := uuid.Parse(req.QuoteId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid quote ID format")
+	}
+	expiresAt, err := time.Parse(time.RFC3339, req.ExpiresAt)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid expires_at timestamp, expected RFC3339")
+	}
+
+	prices := make([]models.QuoteItem, len(req.Prices))
+	for i, price := range req.Prices {
+		unitPrice := price.UnitPrice
+		prices[i] = models.QuoteItem{
+			ProductID: price.ProductId,
+			VariantID: price.VariantId,
+			UnitPrice: &unitPrice,
+		}
+	}
+
+	quote, err := h.service.RespondToQuote(ctx, quoteID, prices, expiresAt, req.Notes)
+	if err != nil {
+		return nil, h.quoteError(err, "failed to respond to quote")
+	}
+	return &pb.QuoteResponse{Quote: convertQuoteToProto(quote)}, nil
+}
+
+func (h *UserHandler) AcceptQuote(ctx context.Context, req *pb.QuoteActionRequest) (*pb.QuoteResponse, error) {
+	quoteID, userID, err := parseQuoteAction(req.QuoteId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := h.service.AcceptQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, h.quoteError(err, "failed to accept quote")
+	}
+	return &pb.QuoteResponse{Quote: convertQuoteToProto(quote)}, nil
+}
+
+func (h *UserHandler) DeclineQuote(ctx context.Context, req *pb.QuoteActionRequest) (*pb.QuoteResponse, error) {
+	quoteID, userID, err := parseQuoteAction(req.QuoteId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := h.service.DeclineQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, h.quoteError(err, "failed to decline quote")
+	}
+	return &pb.QuoteResponse{Quote: convertQuoteToProto(quote)}, nil
+}
+
+func (h *UserHandler) ConvertQuote(ctx context.Context, req *pb.ConvertQuoteRequest) (*pb.QuoteResponse, error) {
+	quoteID, userID, err := parseQuoteAction(req.QuoteId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := h.service.ConvertQuote(ctx, userID, quoteID, req.OrderReference)
+	if err != nil {
+		return nil, h.quoteError(err, "failed to convert quote")
+	}
+	return &pb.QuoteResponse{Quote: convertQuoteToProto(quote)}, nil
+}
+
+func (h *UserHandler) GetQuote(ctx context.Context, req *pb.GetQuoteRequest) (*pb.QuoteResponse, error) {
+	quoteID, err := uuid.Parse(req.QuoteId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid quote ID format")
+	}
+
+	var quote *models.Quote
+	if req.UserId != "" {
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+		}
+		quote, err = h.service.GetUserQuote(ctx, userID, quoteID)
+		if err != nil {
+			return nil, h.quoteError(err, "failed to get quote")
+		}
+	} else {
+		quote, err = h.service.GetQuote(ctx, quoteID)
+		if err != nil {
+			return nil, h.quoteError(err, "failed to get quote")
+		}
+	}
+	return &pb.QuoteResponse{Quote: convertQuoteToProto(quote)}, nil
+}
+
+func (h *UserHandler) ListQuotes(ctx context.Context, req *pb.ListQuotesRequest) (*pb.ListQuotesResponse, error) {
+	filter := models.QuoteFilter{Status: req.Status}
+	if req.UserId != "" {
+		userID, err := uuid.Parse(req.UserId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+		}
+		filter.UserID = &userID
+	}
+
+	quotes, total, err := h.service.ListQuotes(ctx, filter, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, h.quoteError(err, "failed to list quotes")
+	}
+
+	response := &pb.ListQuotesResponse{Quotes: make([]*pb.Quote, len(quotes)), Total: total}
+	for i := range quotes {
+		response.Quotes[i] = convertQuoteToProto(&quotes[i])
+	}
+	return response, nil
+}
+
+func (h *UserHandler) ListQuoteEvents(ctx context.Context, req *pb.ListQuoteEventsRequest) (*pb.ListQuoteEventsResponse, error) {
+	events, err := h.service.ListQuoteEvents(ctx, req.AfterId, int(req.Limit))
+	if err != nil {
+		return nil, h.quoteError(err, "failed to list quote events")
+	}
+
+	response := &pb.ListQuoteEventsResponse{Events: make([]*pb.QuoteEvent, len(events))}
+	for i, event := range events {
+		response.Events[i] = &pb.QuoteEvent{
+			Id:        event.ID,
+			QuoteId:   event.QuoteID.String(),
+			UserId:    event.UserID.String(),
+			Email:     event.Email,
+			Status:    event.Status,
+			CreatedAt: event.CreatedAt.Format(time.RFC3339),
+		}
+		if event.Total != nil {
+			response.Events[i].Total = *event.Total
+		}
+		if event.ExpiresAt != nil {
+			response.Events[i].ExpiresAt = event.ExpiresAt.Format(time.RFC3339)
+		}
+	}
+	return response, nil
+}
+
+// quoteError maps the errors of quote operations to gRPC status errors
+func (h *UserHandler) quoteError(err error, msg string) error {
+	switch kind := apperrors.KindOf(err); kind {
+	case apperrors.ErrNotFound, apperrors.ErrInvalidArgument, apperrors.ErrFailedPrecondition:
+		return status.Error(kind.Code(), err.Error())
+	}
+	h.logger.Error(msg, zap.Error(err))
+	return status.Error(codes.Internal, msg)
+}
+
+func parseQuoteAction(quoteID, userID string) (uuid.UUID, uuid.UUID, error) {
+	quote, err := uuid.Parse(quoteID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid quote ID format")
+	}
+	user, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	return quote, user, nil
+}
+
+func convertQuoteToProto(quote *models.Quote) *pb.Quote {
+	response := &pb.Quote{
+		QuoteId:        quote.QuoteID.String(),
+		UserId:         quote.UserID.String(),
+		Status:         quote.Status,
+		Items:          make([]*pb.QuoteItem, len(quote.Items)),
+		BuyerNotes:     quote.BuyerNotes,
+		AdminNotes:     quote.AdminNotes,
+		OrderReference: quote.OrderReference,
+		CreatedAt:      quote.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      quote.UpdatedAt.Format(time.RFC3339),
+	}
+	for i, item := range quote.Items {
+		response.Items[i] = &pb.QuoteItem{
+			ProductId: item.ProductID,
+			VariantId: item.VariantID,
+			Quantity:  int32(item.Quantity),
+		}
+		if item.UnitPrice != nil {
+			response.Items[i].UnitPrice = *item.UnitPrice
+		}
+	}
+	if quote.CompanyID != nil {
+		response.CompanyId = quote.CompanyID.String()
+	}
+	if quote.Total != nil {
+		response.Total = *quote.Total
+	}
+	if quote.ExpiresAt != nil {
+		response.ExpiresAt = quote.ExpiresAt.Format(time.RFC3339)
+	}
+	if quote.QuotedAt != nil {
+		response.QuotedAt = quote.QuotedAt.Format(time.RFC3339)
+	}
+	if quote.ConvertedAt != nil {
+		response.ConvertedAt = quote.ConvertedAt.Format(time.RFC3339)
+	}
+	return response
+}
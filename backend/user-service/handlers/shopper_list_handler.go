@@ -0,0 +1,133 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+func (h *UserHandler) GetShopperList(ctx context.Context, req *pb.GetShopperListRequest) (*pb.ShopperListResponse, error) {
+	items, err := h.service.GetShopperList(ctx, req.OwnerId, req.List)
+	if err != nil {
+		return nil, h.shopperListError(err, "failed to get list", req.List)
+	}
+	return convertShopperListToProto(req.List, items), nil
+}
+
+func (h *UserHandler) SetShopperListItem(ctx context.Context, req *pb.SetShopperListItemRequest) (*pb.ShopperListResponse, error) {
+	items, err := h.service.SetShopperListItem(ctx, &models.ShopperListItem{
+		OwnerID:   req.OwnerId,
+		List:      req.List,
+		ProductID: req.ProductId,
+		VariantID: req.VariantId,
+		Quantity:  int(req.Quantity),
+
+		Personalization: convertPersonalizationFromProto(req.Personalization),
+	})
+	if err != nil {
+		return nil, h.shopperListError(err, "failed to update list", req.List)
+	}
+	return convertShopperListToProto(req.List, items), nil
+}
+
+func (h *UserHandler) RemoveShopperListItem(ctx context.Context, req *pb.RemoveShopperListItemRequest) (*pb.ShopperListResponse, error) {
+	items, err := h.service.RemoveShopperListItem(ctx, req.OwnerId, req.List, req.ProductId, req.VariantId)
+	if err != nil {
+		return nil, h.shopperListError(err, "failed to remove list item", req.List)
+	}
+	return convertShopperListToProto(req.List, items), nil
+}
+
+func (h *UserHandler) MergeGuestData(ctx context.Context, req *pb.MergeGuestDataRequest) (*pb.MergeGuestDataResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	result, err := h.service.MergeGuestData(ctx, req.GuestId, userID)
+	if err != nil {
+		if errors.Is(err, models.ErrUserNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, h.shopperListError(err, "failed to merge guest data", "")
+	}
+
+	return &pb.MergeGuestDataResponse{
+		CartItems:           int32(result.Cart),
+		WishlistItems:       int32(result.Wishlist),
+		RecentlyViewedItems: int32(result.RecentlyViewed),
+	}, nil
+}
+
+// shopperListError maps the errors of shopper list operations to gRPC status
+// errors
+func (h *UserHandler) shopperListError(err error, msg, list string) error {
+	switch {
+	case errors.Is(err, models.ErrListItemNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, models.ErrInvalidShopperList),
+		errors.Is(err, models.ErrInvalidShopperOwner),
+		errors.Is(err, models.ErrInvalidListItem):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	h.logger.Error(msg, zap.String("list", list), zap.Error(err))
+	return status.Error(codes.Internal, msg)
+}
+
+func convertShopperListToProto(list string, items []models.ShopperListItem) *pb.ShopperListResponse {
+	response := &pb.ShopperListResponse{List: list, Items: make([]*pb.ShopperListItem, len(items))}
+	for i, item := range items {
+		response.Items[i] = &pb.ShopperListItem{
+			ProductId: item.ProductID,
+			VariantId: item.VariantID,
+			Quantity:  int32(item.Quantity),
+			AddedAt:   item.AddedAt.Format(time.RFC3339),
+			UpdatedAt: item.UpdatedAt.Format(time.RFC3339),
+
+			Personalization: convertPersonalizationToProto(item.Personalization),
+		}
+	}
+	return response
+}
+
+func convertPersonalizationFromProto(values []*pb.PersonalizationValue) []models.PersonalizationValue {
+	if len(values) == 0 {
+		return nil
+	}
+	converted := make([]models.PersonalizationValue, len(values))
+	for i, value := range values {
+		converted[i] = models.PersonalizationValue{
+			OptionID: value.OptionId,
+			Name:     value.Name,
+			Text:     value.Text,
+			FileURL:  value.FileUrl,
+			FileName: value.FileName,
+		}
+	}
+	return converted
+}
+
+func convertPersonalizationToProto(values []models.PersonalizationValue) []*pb.PersonalizationValue {
+	if len(values) == 0 {
+		return nil
+	}
+	converted := make([]*pb.PersonalizationValue, len(values))
+	for i, value := range values {
+		converted[i] = &pb.PersonalizationValue{
+			OptionId: value.OptionID,
+			Name:     value.Name,
+			Text:     value.Text,
+			FileUrl:  value.FileURL,
+			FileName: value.FileName,
+		}
+	}
+	return converted
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+func (h *UserHandler) CreateStaff(ctx context.Context, req *pb.CreateStaffRequest) (*pb.UserResponse, error) {
+	actorID, err := uuid.Parse(req.ActorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid actor ID format")
+	}
+	if req.Email == "" || len(req.Password) < 8 {
+		return nil, status.Error(codes.InvalidArgument, "email and a password of at least 8 characters are required")
+	}
+
+	user, err := h.service.CreateStaff(ctx, actorID, &models.RegisterRequest{
+		Email:     req.Email,
+		Username:  req.Username,
+		Password:  req.Password,
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+	}, req.PermissionSets)
+	if err != nil {
+		return nil, h.staffError(err, "failed to create staff")
+	}
+	return &pb.UserResponse{User: convertUserToProto(user)}, nil
+}
+
+func (h *UserHandler) SetStaffPermissions(ctx context.Context, req *pb.SetStaffPermissionsRequest) (*pb.UserResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	actorID, err := uuid.Parse(req.ActorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid actor ID format")
+	}
+
+	user, err := h.service.SetStaffPermissions(ctx, actorID, userID, req.PermissionSets)
+	if err != nil {
+		return nil, h.staffError(err, "failed to set staff permissions")
+	}
+	return &pb.UserResponse{User: convertUserToProto(user)}, nil
+}
+
+func (h *UserHandler) ListStaff(ctx context.Context, req *pb.ListStaffRequest) (*pb.ListStaffResponse, error) {
+	staff, err := h.service.ListStaff(ctx)
+	if err != nil {
+		return nil, h.staffError(err, "failed to list staff")
+	}
+
+	response := &pb.ListStaffResponse{Staff: make([]*pb.User, len(staff))}
+	for i, user := range staff {
+		response.Staff[i] = convertUserToProto(user)
+	}
+	return response, nil
+}
+
+func (h *UserHandler) ListPermissionSets(ctx context.Context, req *pb.ListPermissionSetsRequest) (*pb.ListPermissionSetsResponse, error) {
+	response := &pb.ListPermissionSetsResponse{PermissionSets: make([]*pb.PermissionSet, len(models.PermissionSets))}
+	for i, set := range models.PermissionSets {
+		response.PermissionSets[i] = &pb.PermissionSet{
+			Name:        set.Name,
+			Description: set.Description,
+			Scopes:      set.Scopes,
+		}
+	}
+	return response, nil
+}
+
+// staffError maps the errors of staff operations to gRPC status errors
+func (h *UserHandler) staffError(err error, msg string) error {
+	switch {
+	case errors.Is(err, models.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, models.ErrInvalidPermissionSet):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrNotStaff):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, models.ErrSelfPermissionChange), errors.Is(err, models.ErrPermissionAssignment):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	// Account creation reports its own status errors
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	h.logger.Error(msg, zap.Error(err))
+	return status.Error(codes.Internal, msg)
+}
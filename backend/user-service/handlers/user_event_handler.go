@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+func (h *UserHandler) ListUserEvents(ctx context.Context, req *pb.ListUserEventsRequest) (*pb.ListUserEventsResponse, error) {
+	if req.BeforeId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "before_id cannot be negative")
+	}
+
+	events, err := h.service.ListUserEvents(ctx, req.BeforeId, int(req.Limit))
+	if err != nil {
+		h.logger.Error("Failed to list user events", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list user events")
+	}
+
+	response := &pb.ListUserEventsResponse{Events: make([]*pb.UserEvent, len(events))}
+	for i, event := range events {
+		response.Events[i] = &pb.UserEvent{
+			Id:        event.ID,
+			UserId:    event.UserID.String(),
+			Email:     event.Email,
+			Type:      event.Type,
+			ActorId:   event.ActorID,
+			CreatedAt: event.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return response, nil
+}
@@ -2,14 +2,16 @@ package handlers
 
 import (
 	"context"
-	"fmt"
-	"strings"
+	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/metadata"
+	"github.com/louai60/e-commerce_project/backend/shared/diagnostics"
 	"github.com/louai60/e-commerce_project/backend/user-service/models"
 	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
 	"github.com/louai60/e-commerce_project/backend/user-service/service"
@@ -22,13 +24,15 @@ type UserHandler struct {
 	service      *service.UserService
 	logger       *zap.Logger
 	tokenManager *service.JWTManager
+	diagnostics  *diagnostics.Collector
 }
 
-func NewUserHandler(service *service.UserService, logger *zap.Logger, tokenManager *service.JWTManager) *UserHandler {
+func NewUserHandler(service *service.UserService, logger *zap.Logger, tokenManager *service.JWTManager, diagnostics *diagnostics.Collector) *UserHandler {
 	return &UserHandler{
 		service:      service,
 		logger:       logger,
 		tokenManager: tokenManager,
+		diagnostics:  diagnostics,
 	}
 }
 
@@ -41,12 +45,16 @@ func (h *UserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.
 
 	user, err := h.service.GetUser(ctx, userID)
 	if err != nil {
+		if !errors.Is(err, models.ErrUserNotFound) {
+			h.logger.Error("Failed to get user", zap.String("userID", userID.String()), zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to get user")
+		}
 		h.logger.Warn("User not found", zap.String("userID", userID.String()), zap.Error(err))
 		return nil, status.Error(codes.NotFound, "user not found")
 	}
 
 	return &pb.UserResponse{
-		User: convertUserToProto(user),
+		User: h.convertUserWithMetadata(ctx, user),
 	}, nil
 }
 
@@ -69,7 +77,7 @@ func (h *UserHandler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (
 	}
 
 	for i, user := range users {
-		response.Users[i] = convertUserToProto(user)
+		response.Users[i] = h.convertUserWithMetadata(ctx, user)
 	}
 
 	return response, nil
@@ -102,7 +110,7 @@ func (h *UserHandler) CreateUser(ctx context.Context, req *pb.CreateUserRequest)
 
 		// Check for specific errors returned by the service/repository
 		// The repository now handles username uniqueness based on email.
-		if status.Code(err) == codes.AlreadyExists || strings.Contains(err.Error(), "already exists") {
+		if status.Code(err) == codes.AlreadyExists {
 			// Return a generic "already exists" error, as it could be email or the derived username
 			return nil, status.Errorf(codes.AlreadyExists, "email or username already exists")
 		}
@@ -122,6 +130,27 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
 	}
 
+	// Metadata is patched first, so an invalid patch leaves the user unchanged
+	var patched *models.User
+	if req.Metadata != nil {
+		patch, err := metadata.FromStruct(req.Metadata)
+		if err == nil {
+			patched, err = h.service.UpdateUserMetadata(ctx, userID, patch)
+		}
+		switch {
+		case err == nil:
+		case errors.Is(err, models.ErrUserNotFound):
+			return nil, status.Error(codes.NotFound, "user not found")
+		case apperrors.KindOf(err) == apperrors.ErrInvalidArgument:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		default:
+			h.logger.Error("Failed to update user metadata",
+				zap.String("userID", userID.String()),
+				zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to update user")
+		}
+	}
+
 	user := &models.User{
 		UserID:    userID,
 		Username:  req.Username,
@@ -136,9 +165,12 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 			zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to update user")
 	}
+	if patched != nil {
+		updatedUser.Metadata = patched.Metadata
+	}
 
 	return &pb.UserResponse{
-		User: convertUserToProto(updatedUser),
+		User: h.convertUserWithMetadata(ctx, updatedUser),
 	}, nil
 }
 
@@ -149,8 +181,17 @@ func (h *UserHandler) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest)
 		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
 	}
 
-	err = h.service.DeleteUser(ctx, userID)
-	if err != nil {
+	// Without an actor the deletion of administrator and staff accounts is
+	// refused
+	actorID, _ := uuid.Parse(req.ActorId)
+
+	err = h.service.DeleteUser(ctx, userID, actorID)
+	switch {
+	case errors.Is(err, models.ErrUserNotFound):
+		return nil, status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, models.ErrAccountDeletion):
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
 		h.logger.Error("Failed to delete user", zap.String("userID", userID.String()), zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to delete user")
 	}
@@ -207,18 +248,7 @@ func (h *UserHandler) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequ
 
 	// Extract claims from the refresh token
 	claims := jwt.MapClaims{}
-	publicKey, err := h.tokenManager.GetPublicKey()
-	if err != nil {
-		h.logger.Error("Failed to get public key for token validation", zap.Error(err))
-		return nil, status.Error(codes.Internal, "token validation setup error")
-	}
-
-	token, err := jwt.ParseWithClaims(req.RefreshToken, &claims, func(token *jwt.Token) (any, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %s", token.Header["alg"])
-		}
-		return publicKey, nil
-	})
+	token, err := jwt.ParseWithClaims(req.RefreshToken, &claims, h.tokenManager.Keyfunc)
 
 	// Explicit expiration check
 	if err != nil {
@@ -316,14 +346,34 @@ func (h *UserHandler) HealthCheck(ctx context.Context, req *pb.HealthCheckReques
 func (h *UserHandler) GetUserByEmail(ctx context.Context, req *pb.GetUserByEmailRequest) (*pb.UserResponse, error) {
 	user, err := h.service.GetUserByEmail(ctx, req.Email)
 	if err != nil {
-		h.logger.Error("User not found by email", zap.String("email", req.Email), zap.Error(err))
-		return nil, status.Errorf(codes.NotFound, "user not found: %v", err)
+		if !errors.Is(err, models.ErrUserNotFound) {
+			h.logger.Error("Failed to get user by email", zap.String("email", req.Email), zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to get user")
+		}
+		h.logger.Warn("User not found by email", zap.String("email", req.Email))
+		return nil, status.Error(codes.NotFound, "user not found")
 	}
 	return &pb.UserResponse{
 		User: convertUserToProto(user),
 	}, nil
 }
 
+// convertUserWithMetadata converts a user, including its metadata when the
+// store of ctx exposes it
+func (h *UserHandler) convertUserWithMetadata(ctx context.Context, user *models.User) *pb.User {
+	pbUser := convertUserToProto(user)
+	if pbUser == nil || !h.service.MetadataExposed(ctx) {
+		return pbUser
+	}
+	doc, err := metadata.ToStruct(user.Metadata)
+	if err != nil {
+		h.logger.Warn("Failed to decode user metadata", zap.String("userID", user.UserID.String()), zap.Error(err))
+		return pbUser
+	}
+	pbUser.Metadata = doc
+	return pbUser
+}
+
 func convertUserToProto(user *models.User) *pb.User {
 	if user == nil {
 		return nil
@@ -333,19 +383,20 @@ func convertUserToProto(user *models.User) *pb.User {
 		lastLoginStr = user.LastLogin.Time.Format(time.RFC3339)
 	}
 	return &pb.User{
-		UserId:        user.UserID.String(), // Convert UUID to string
-		Email:         user.Email,
-		Username:      user.Username,
-		FirstName:     user.FirstName,
-		LastName:      user.LastName,
-		PhoneNumber:   user.PhoneNumber,
-		UserType:      user.UserType,
-		Role:          user.Role,
-		AccountStatus: user.AccountStatus,
-		EmailVerified: user.EmailVerified,
-		PhoneVerified: user.PhoneVerified,
-		CreatedAt:     user.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:     user.UpdatedAt.Format(time.RFC3339),
-		LastLogin:     lastLoginStr,
+		UserId:         user.UserID.String(), // Convert UUID to string
+		Email:          user.Email,
+		Username:       user.Username,
+		FirstName:      user.FirstName,
+		LastName:       user.LastName,
+		PhoneNumber:    user.PhoneNumber,
+		UserType:       user.UserType,
+		Role:           user.Role,
+		AccountStatus:  user.AccountStatus,
+		EmailVerified:  user.EmailVerified,
+		PhoneVerified:  user.PhoneVerified,
+		CreatedAt:      user.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      user.UpdatedAt.Format(time.RFC3339),
+		LastLogin:      lastLoginStr,
+		PermissionSets: user.PermissionSets,
 	}
 }
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+func (h *UserHandler) CreateUserNote(ctx context.Context, req *pb.CreateUserNoteRequest) (*pb.UserNoteResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	authorID, err := uuid.Parse(req.AuthorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid author ID format")
+	}
+
+	note, err := h.service.CreateUserNote(ctx, &models.UserNote{
+		UserID:      userID,
+		AuthorID:    authorID,
+		AuthorEmail: req.AuthorEmail,
+		Body:        req.Body,
+	})
+	if err != nil {
+		return nil, h.noteError(err, "failed to create note", userID)
+	}
+
+	return &pb.UserNoteResponse{Note: convertUserNoteToProto(note)}, nil
+}
+
+func (h *UserHandler) ListUserNotes(ctx context.Context, req *pb.ListUserNotesRequest) (*pb.ListUserNotesResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	notes, err := h.service.ListUserNotes(ctx, userID)
+	if err != nil {
+		return nil, h.noteError(err, "failed to list notes", userID)
+	}
+
+	response := &pb.ListUserNotesResponse{Notes: make([]*pb.UserNote, len(notes))}
+	for i := range notes {
+		response.Notes[i] = convertUserNoteToProto(&notes[i])
+	}
+	return response, nil
+}
+
+func (h *UserHandler) UpdateUserNote(ctx context.Context, req *pb.UpdateUserNoteRequest) (*pb.UserNoteResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	noteID, err := uuid.Parse(req.NoteId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid note ID format")
+	}
+	authorID, err := uuid.Parse(req.AuthorId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid author ID format")
+	}
+
+	note, err := h.service.UpdateUserNote(ctx, userID, noteID, authorID, req.Body)
+	if err != nil {
+		return nil, h.noteError(err, "failed to update note", userID)
+	}
+
+	return &pb.UserNoteResponse{Note: convertUserNoteToProto(note)}, nil
+}
+
+func (h *UserHandler) DeleteUserNote(ctx context.Context, req *pb.DeleteUserNoteRequest) (*pb.DeleteResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	noteID, err := uuid.Parse(req.NoteId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid note ID format")
+	}
+
+	if err := h.service.DeleteUserNote(ctx, userID, noteID); err != nil {
+		return nil, h.noteError(err, "failed to delete note", userID)
+	}
+
+	return &pb.DeleteResponse{
+		Success: true,
+		Message: "note deleted successfully",
+	}, nil
+}
+
+// noteError maps the errors of note operations to gRPC status errors
+func (h *UserHandler) noteError(err error, msg string, userID uuid.UUID) error {
+	switch {
+	case errors.Is(err, models.ErrUserNotFound):
+		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, models.ErrUserNoteNotFound):
+		return status.Error(codes.NotFound, "note not found")
+	case errors.Is(err, models.ErrInvalidUserNote):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrNotNoteAuthor):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	h.logger.Error(msg, zap.String("userID", userID.String()), zap.Error(err))
+	return status.Error(codes.Internal, msg)
+}
+
+func convertUserNoteToProto(note *models.UserNote) *pb.UserNote {
+	return &pb.UserNote{
+		NoteId:      note.NoteID.String(),
+		UserId:      note.UserID.String(),
+		AuthorId:    note.AuthorID.String(),
+		AuthorEmail: note.AuthorEmail,
+		Body:        note.Body,
+		CreatedAt:   note.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   note.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+	pb "github.com/louai60/e-commerce_project/backend/user-service/proto"
+)
+
+func (h *UserHandler) RecordOrderEvent(ctx context.Context, req *pb.RecordOrderEventRequest) (*pb.UserStatsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	event := &models.OrderEvent{
+		UserID:         userID,
+		OrderReference: req.OrderReference,
+		Type:           req.Type,
+		Amount:         req.Amount,
+	}
+	if req.OccurredAt != "" {
+		event.OccurredAt, err = time.Parse(time.RFC3339, req.OccurredAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid occurred_at timestamp, expected RFC3339")
+		}
+	}
+
+	stats, err := h.service.RecordOrderEvent(ctx, req.EventId, event)
+	if err != nil {
+		return nil, h.userStatsError(err, "failed to record order event")
+	}
+	return &pb.UserStatsResponse{Stats: convertUserStatsToProto(stats)}, nil
+}
+
+func (h *UserHandler) GetUserStats(ctx context.Context, req *pb.GetUserStatsRequest) (*pb.UserStatsResponse, error) {
+	userID, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	stats, err := h.service.GetUserStats(ctx, userID)
+	if err != nil {
+		return nil, h.userStatsError(err, "failed to get user stats")
+	}
+	return &pb.UserStatsResponse{Stats: convertUserStatsToProto(stats)}, nil
+}
+
+func (h *UserHandler) ListUserStats(ctx context.Context, req *pb.ListUserStatsRequest) (*pb.ListUserStatsResponse, error) {
+	filter := models.UserStatsFilter{
+		MinOrders: req.MinOrders,
+		MinSpend:  req.MinSpend,
+	}
+	var err error
+	if req.LastOrderAfter != "" {
+		if filter.LastOrderAfter, err = time.Parse(time.RFC3339, req.LastOrderAfter); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid last_order_after timestamp, expected RFC3339")
+		}
+	}
+	if req.LastOrderBefore != "" {
+		if filter.LastOrderBefore, err = time.Parse(time.RFC3339, req.LastOrderBefore); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid last_order_before timestamp, expected RFC3339")
+		}
+	}
+
+	list, total, err := h.service.ListUserStats(ctx, filter, int(req.Page), int(req.Limit))
+	if err != nil {
+		return nil, h.userStatsError(err, "failed to list user stats")
+	}
+
+	response := &pb.ListUserStatsResponse{Stats: make([]*pb.UserStats, len(list)), Total: total}
+	for i := range list {
+		response.Stats[i] = convertUserStatsToProto(&list[i])
+	}
+	return response, nil
+}
+
+// userStatsError maps the errors of user stats operations to gRPC status
+// errors
+func (h *UserHandler) userStatsError(err error, msg string) error {
+	if errors.Is(err, models.ErrInvalidOrderReference) || errors.Is(err, models.ErrInvalidOrderEvent) ||
+		errors.Is(err, models.ErrInvalidStatsPeriod) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	h.logger.Error(msg, zap.Error(err))
+	return status.Error(codes.Internal, msg)
+}
+
+func convertUserStatsToProto(stats *models.UserStats) *pb.UserStats {
+	result := &pb.UserStats{
+		UserId:            stats.UserID.String(),
+		TotalOrders:       stats.TotalOrders,
+		TotalSpend:        stats.TotalSpend,
+		AverageOrderValue: stats.AverageOrderValue(),
+	}
+	if stats.LastOrderAt != nil {
+		result.LastOrderAt = stats.LastOrderAt.Format(time.RFC3339)
+	}
+	if !stats.UpdatedAt.IsZero() {
+		result.UpdatedAt = stats.UpdatedAt.Format(time.RFC3339)
+	}
+	return result
+}
@@ -10,7 +10,17 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/go-redis/redis/v8"
 	_ "github.com/lib/pq"
+	"github.com/louai60/e-commerce_project/backend/common/cachectl"
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/common/mail"
+	"github.com/louai60/e-commerce_project/backend/common/profiling"
+	"github.com/louai60/e-commerce_project/backend/common/recovery"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/shared/diagnostics"
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
 	"github.com/louai60/e-commerce_project/backend/user-service/cache"
 	"github.com/louai60/e-commerce_project/backend/user-service/config"
 	"github.com/louai60/e-commerce_project/backend/user-service/db"
@@ -141,10 +151,7 @@ func initializeDatabase(ctx context.Context, db *sql.DB, logger *zap.Logger) err
 
 func main() {
 	// Initialize logger
-	logger, err := zap.NewDevelopment()
-	if err != nil {
-		log.Fatal("Failed to initialize logger:", err)
-	}
+	logger := applogger.InitFromEnv("user-service")
 	defer func() {
 		if err := logger.Sync(); err != nil {
 			log.Printf("Failed to sync logger: %v", err)
@@ -196,19 +203,28 @@ func main() {
 		refreshTokenDuration = 7 * 24 * time.Hour // default to 7 days
 	}
 
-	// Initialize JWT manager
+	// Initialize the signing keys; the key pair of JWT_PRIVATE_KEY_PATH, used
+	// before rotation, keeps verifying the tokens it signed
 	privateKeyPath := os.Getenv("JWT_PRIVATE_KEY_PATH")
 	if privateKeyPath == "" {
 		privateKeyPath = "certificates/private_key.pem" // Default path
 	}
-	publicKeyPath := os.Getenv("JWT_PUBLIC_KEY_PATH")
-	if publicKeyPath == "" {
-		publicKeyPath = "certificates/public_key.pem" // Default path
+	keyRing, err := service.NewKeyRing(service.KeyRingConfig{
+		Dir:                  cfg.Auth.Keys.Dir,
+		LegacyPrivateKeyPath: privateKeyPath,
+		RotationInterval:     cfg.Auth.Keys.RotationInterval,
+		PublishDelay:         cfg.Auth.Keys.PublishDelay,
+		Retention:            refreshTokenDuration,
+		ReloadInterval:       cfg.Auth.Keys.ReloadInterval,
+	}, logger)
+	if err != nil {
+		logger.Fatal("Failed to load signing keys", zap.Error(err))
 	}
+	keyRing.Start(context.Background())
 
+	// Initialize JWT manager
 	jwtManager, err := service.NewJWTManager(
-		privateKeyPath,
-		publicKeyPath,
+		keyRing,
 		accessTokenDuration,
 		refreshTokenDuration,
 		repo,   // Pass the repository
@@ -242,7 +258,7 @@ func main() {
 		RedisPassword: redisPassword,
 		RedisDB:       redisDB,
 		RedisPoolSize: 10,
-		DefaultTTL:    30 * time.Minute,
+		DefaultTTL:    cfg.Cache.DefaultTTL,
 		Logger:        logger,
 		// Circuit breaker settings
 		FailureThreshold:         5,
@@ -277,6 +293,7 @@ func main() {
 			zap.Error(err),
 			zap.String("redis_addr", redisAddr))
 	}
+	cacheManager.ApplyTTLs(0, cfg.Cache.TTLs)
 
 	// Initialize service with all required dependencies
 	userService := service.NewUserService(
@@ -287,8 +304,59 @@ func main() {
 		jwtManager,
 	)
 
+	// Emails are only logged until an SMTP relay is configured
+	var mailer mail.Mailer = mail.NewLogMailer(logger)
+	if cfg.Mail.SMTPHost != "" {
+		if cfg.Mail.From == "" {
+			logger.Fatal("mail.from is required when mail.smtpHost is set")
+		}
+		mailer = mail.NewSMTPMailer(cfg.Mail.SMTPHost, cfg.Mail.SMTPPort, cfg.Mail.SMTPUsername, cfg.Mail.SMTPPassword, cfg.Mail.From)
+	}
+	userService.SetInvitations(mailer, service.InvitationConfig{
+		URL: cfg.Invitations.URL,
+		TTL: cfg.Invitations.TTL,
+	})
+
+	if cfg.CartReminders.Enabled {
+		userService.StartCartReminderScheduler(context.Background(), service.CartReminderConfig{
+			Interval:      cfg.CartReminders.Interval,
+			InactiveAfter: cfg.CartReminders.InactiveAfter,
+			MaxAge:        cfg.CartReminders.MaxAge,
+		})
+	}
+
+	// Feature flags are managed through the gateway admin API and shared via Redis
+	flagsRedis := redis.NewClient(&redis.Options{
+		Addr:     redisAddr,
+		Password: redisPassword,
+		DB:       redisDB,
+	})
+	defer flagsRedis.Close()
+	flagsLoadCtx, cancelFlagsLoad := context.WithTimeout(context.Background(), 3*time.Second)
+	flagsClient := featureflags.NewClient(flagsLoadCtx, featureflags.NewRedisStore(flagsRedis), logger)
+	cancelFlagsLoad()
+	flagsClient.Start(context.Background())
+	userService.SetFeatureFlags(flagsClient)
+
+	userService.StartQuoteExpiryScheduler(context.Background(), cfg.Quotes.ExpiryInterval)
+	userService.StartCreditOverdueScheduler(context.Background(), cfg.Credit.OverdueInterval)
+
+	if cfg.Profiling.Enabled {
+		if err := profiling.Start(context.Background(), cfg.Profiling.Addr, logger); err != nil {
+			logger.Error("Failed to start profiling endpoints", zap.Error(err))
+		}
+	}
+
+	// Register database pools and caches for the diagnostics endpoint
+	diagnosticsCollector := diagnostics.NewCollector("user-service")
+	diagnosticsCollector.AddDB("master", dbConfig.Master.DB, false)
+	for i, replica := range dbConfig.Replicas {
+		diagnosticsCollector.AddDB(fmt.Sprintf("replica-%d", i), replica.DB, true)
+	}
+	diagnosticsCollector.AddCache("tiered", cacheManager)
+
 	// Initialize handler
-	userHandler := handlers.NewUserHandler(userService, logger, jwtManager)
+	userHandler := handlers.NewUserHandler(userService, logger, jwtManager, diagnosticsCollector)
 
 	// Set up gRPC server
 	var opts []grpc.ServerOption
@@ -304,6 +372,18 @@ func main() {
 		opts = append(opts, grpc.Creds(creds))
 	}
 
+	// Recover panics of handlers, reporting them to Sentry when configured
+	panicReporter, err := recovery.ReporterFromEnv(context.Background(), logger)
+	if err != nil {
+		logger.Fatal("Invalid Sentry configuration", zap.Error(err))
+	}
+	recoverer := recovery.New("user-service", logger, panicReporter)
+
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(applogger.UnaryServerInterceptor(logger), recoverer.UnaryServerInterceptor(), tenant.UnaryServerInterceptor(), cachectl.UnaryServerInterceptor(), apperrors.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(recoverer.StreamServerInterceptor(), tenant.StreamServerInterceptor(), apperrors.StreamServerInterceptor()),
+	)
+
 	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterUserServiceServer(grpcServer, userHandler)
 
@@ -0,0 +1,10 @@
+-- Drop the per-store uniqueness first
+DROP INDEX IF EXISTS users_tenant_email_key;
+DROP INDEX IF EXISTS users_tenant_username_key;
+
+-- Restore globally unique emails and usernames
+ALTER TABLE users ADD CONSTRAINT users_email_key UNIQUE (email);
+ALTER TABLE users ADD CONSTRAINT users_username_key UNIQUE (username);
+
+-- Remove the tenant_id column
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
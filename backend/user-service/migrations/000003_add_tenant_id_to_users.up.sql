@@ -0,0 +1,10 @@
+-- Scope user accounts to the store (tenant) they registered with.
+-- Existing accounts belong to the default store, and emails and usernames
+-- become unique per store instead of globally.
+ALTER TABLE users ADD COLUMN tenant_id VARCHAR(50) NOT NULL DEFAULT 'default';
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_email_key;
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_username_key;
+
+CREATE UNIQUE INDEX users_tenant_email_key ON users (tenant_id, email);
+CREATE UNIQUE INDEX users_tenant_username_key ON users (tenant_id, username);
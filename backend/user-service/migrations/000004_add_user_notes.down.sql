@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_user_notes_user;
+DROP TABLE IF EXISTS user_notes;
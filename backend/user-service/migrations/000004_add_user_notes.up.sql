@@ -0,0 +1,14 @@
+-- Internal notes staff attach to customer accounts. Notes are only visible
+-- to staff and keep their author even when the author's account is removed.
+CREATE TABLE IF NOT EXISTS user_notes (
+    note_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    author_id UUID NOT NULL,
+    author_email VARCHAR(255) NOT NULL DEFAULT '',
+    body TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_notes_user ON user_notes (tenant_id, user_id, created_at DESC);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_shopper_list_items_updated;
+DROP TABLE IF EXISTS shopper_list_items;
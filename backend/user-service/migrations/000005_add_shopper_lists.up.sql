@@ -0,0 +1,16 @@
+-- Carts, wishlists and recently viewed products. Owners are users, by user
+-- ID, or guests, by the ID of their guest session; the lists of a guest
+-- are merged into the account they log in to.
+CREATE TABLE IF NOT EXISTS shopper_list_items (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    owner_id VARCHAR(64) NOT NULL,
+    list VARCHAR(20) NOT NULL CHECK (list IN ('cart', 'wishlist', 'recently_viewed')),
+    product_id VARCHAR(64) NOT NULL,
+    variant_id VARCHAR(64) NOT NULL DEFAULT '',
+    quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    added_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, owner_id, list, product_id, variant_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_shopper_list_items_updated ON shopper_list_items (tenant_id, owner_id, list, updated_at DESC);
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_cart_reminders_reminded;
+DROP INDEX IF EXISTS idx_cart_reminders_cart;
+DROP TABLE IF EXISTS cart_reminders;
@@ -0,0 +1,19 @@
+-- Reminders of abandoned carts. A reminder is recorded once for each period
+-- of inactivity of a user's cart, identified by the time of its last change,
+-- and read in ID order by the notification service that emails it. Orders
+-- placed from a reminded cart mark it converted.
+CREATE TABLE IF NOT EXISTS cart_reminders (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    email VARCHAR(255) NOT NULL,
+    first_name VARCHAR(100) NOT NULL DEFAULT '',
+    items JSONB NOT NULL DEFAULT '[]',
+    cart_updated_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    reminded_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    converted_at TIMESTAMP WITH TIME ZONE,
+    order_reference VARCHAR(100)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_cart_reminders_cart ON cart_reminders (tenant_id, user_id, cart_updated_at);
+CREATE INDEX IF NOT EXISTS idx_cart_reminders_reminded ON cart_reminders (tenant_id, reminded_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_user_events_tenant;
+DROP TABLE IF EXISTS user_events;
@@ -0,0 +1,14 @@
+-- Account events of users, listed newest first by the admin activity feed.
+-- Events keep the email of the account at the time, so they still read after
+-- the account is deleted.
+CREATE TABLE IF NOT EXISTS user_events (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    user_id UUID NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    event_type VARCHAR(20) NOT NULL CHECK (event_type IN ('registered', 'logged_in', 'updated', 'deleted')),
+    actor_id VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_events_tenant ON user_events (tenant_id, id DESC);
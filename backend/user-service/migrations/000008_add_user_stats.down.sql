@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_user_stats_spend;
+DROP TABLE IF EXISTS user_stats;
+DROP INDEX IF EXISTS idx_user_order_events_user;
+DROP TABLE IF EXISTS user_order_events;
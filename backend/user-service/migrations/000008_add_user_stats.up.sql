@@ -0,0 +1,30 @@
+-- Order events reported by the order system, kept per user so the lifetime
+-- metrics can be recomputed. Event IDs make reporting an event idempotent;
+-- they default to the order reference and the event type.
+CREATE TABLE IF NOT EXISTS user_order_events (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    event_id VARCHAR(128) NOT NULL,
+    user_id UUID NOT NULL,
+    order_reference VARCHAR(100) NOT NULL,
+    event_type VARCHAR(20) NOT NULL CHECK (event_type IN ('placed', 'cancelled', 'refunded')),
+    amount NUMERIC(14, 2) NOT NULL DEFAULT 0 CHECK (amount >= 0),
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, event_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_order_events_user ON user_order_events (tenant_id, user_id);
+
+-- Lifetime metrics of customers, recomputed from their order events. The
+-- average order value is total_spend / total_orders.
+CREATE TABLE IF NOT EXISTS user_stats (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    user_id UUID NOT NULL,
+    total_orders BIGINT NOT NULL DEFAULT 0,
+    total_spend NUMERIC(14, 2) NOT NULL DEFAULT 0,
+    last_order_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_stats_spend ON user_stats (tenant_id, total_spend DESC);
@@ -0,0 +1,5 @@
+DELETE FROM user_events WHERE event_type = 'permissions_changed';
+ALTER TABLE user_events DROP CONSTRAINT IF EXISTS user_events_event_type_check;
+ALTER TABLE user_events ADD CONSTRAINT user_events_event_type_check
+    CHECK (event_type IN ('registered', 'logged_in', 'updated', 'deleted'));
+DROP TABLE IF EXISTS staff_permissions;
@@ -0,0 +1,15 @@
+-- Permission sets assigned to staff accounts by administrators; the access
+-- tokens of staff grant the scopes of their sets.
+CREATE TABLE IF NOT EXISTS staff_permissions (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    user_id UUID NOT NULL,
+    permission_set VARCHAR(20) NOT NULL CHECK (permission_set IN ('catalog', 'inventory', 'orders', 'users')),
+    granted_by UUID NOT NULL,
+    granted_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, user_id, permission_set)
+);
+
+-- Changes of the permissions of staff show in the admin activity feed
+ALTER TABLE user_events DROP CONSTRAINT IF EXISTS user_events_event_type_check;
+ALTER TABLE user_events ADD CONSTRAINT user_events_event_type_check
+    CHECK (event_type IN ('registered', 'logged_in', 'updated', 'deleted', 'permissions_changed'));
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS purchase_approvals;
+DROP TABLE IF EXISTS company_addresses;
+DROP TABLE IF EXISTS company_members;
+DROP TABLE IF EXISTS companies;
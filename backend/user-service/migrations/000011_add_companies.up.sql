@@ -0,0 +1,61 @@
+-- B2B accounts. Purchases of their members above the approval threshold wait
+-- for an approver; a NULL threshold needs no approvals.
+CREATE TABLE IF NOT EXISTS companies (
+    company_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    name VARCHAR(255) NOT NULL,
+    payment_terms VARCHAR(20) NOT NULL DEFAULT 'prepaid' CHECK (payment_terms IN ('prepaid', 'net_15', 'net_30', 'net_60')),
+    approval_threshold NUMERIC(14, 2) CHECK (approval_threshold >= 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_companies_tenant ON companies (tenant_id, name);
+
+-- Members of companies; users belong to one company at most
+CREATE TABLE IF NOT EXISTS company_members (
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    company_id UUID NOT NULL REFERENCES companies(company_id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('purchaser', 'approver')),
+    added_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (tenant_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_company_members_company ON company_members (company_id);
+
+-- Addresses shared by the members of a company; one default per type
+CREATE TABLE IF NOT EXISTS company_addresses (
+    address_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    company_id UUID NOT NULL REFERENCES companies(company_id) ON DELETE CASCADE,
+    label VARCHAR(100) NOT NULL DEFAULT '',
+    address_type VARCHAR(20) NOT NULL CHECK (address_type IN ('shipping', 'billing')),
+    street_address1 VARCHAR(255) NOT NULL,
+    street_address2 VARCHAR(255) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL,
+    state VARCHAR(100) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL,
+    country VARCHAR(100) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_company_addresses_company ON company_addresses (company_id);
+
+-- Approvals of the purchases of company members, asked at checkout
+CREATE TABLE IF NOT EXISTS purchase_approvals (
+    approval_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    company_id UUID NOT NULL REFERENCES companies(company_id) ON DELETE CASCADE,
+    requester_id UUID NOT NULL,
+    order_reference VARCHAR(100) NOT NULL,
+    amount NUMERIC(14, 2) NOT NULL CHECK (amount > 0),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('pending', 'approved', 'rejected')),
+    decided_by UUID,
+    notes TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    decided_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_purchase_approvals_company ON purchase_approvals (company_id, status, created_at DESC);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS quote_events;
+DROP TABLE IF EXISTS quotes;
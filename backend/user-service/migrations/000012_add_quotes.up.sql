@@ -0,0 +1,38 @@
+-- Requests for quote of buyers' carts and the prices negotiated by the
+-- store. Items hold the cart when the quote was requested, with the quoted
+-- unit prices.
+CREATE TABLE IF NOT EXISTS quotes (
+    quote_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    company_id UUID REFERENCES companies(company_id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('requested', 'quoted', 'accepted', 'declined', 'expired', 'converted')),
+    items JSONB NOT NULL DEFAULT '[]',
+    buyer_notes TEXT NOT NULL DEFAULT '',
+    admin_notes TEXT NOT NULL DEFAULT '',
+    total NUMERIC(14, 2),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    order_reference VARCHAR(100) NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    quoted_at TIMESTAMP WITH TIME ZONE,
+    converted_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_quotes_user ON quotes (tenant_id, user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_quotes_status ON quotes (tenant_id, status, created_at DESC);
+
+-- Changes of status of quotes, read in ID order by the notification service
+CREATE TABLE IF NOT EXISTS quote_events (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    quote_id UUID NOT NULL REFERENCES quotes(quote_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    total NUMERIC(14, 2),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_quote_events_tenant ON quote_events (tenant_id, id);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS credit_events;
+DROP TABLE IF EXISTS credit_payments;
+DROP TABLE IF EXISTS credit_charges;
+DROP TABLE IF EXISTS credit_accounts;
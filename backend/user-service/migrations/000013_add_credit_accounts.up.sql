@@ -0,0 +1,60 @@
+-- Credit accounts of companies on net payment terms. The balance is the
+-- amount of the charges not paid yet, at most the credit limit.
+CREATE TABLE IF NOT EXISTS credit_accounts (
+    company_id UUID PRIMARY KEY REFERENCES companies(company_id) ON DELETE CASCADE,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    credit_limit NUMERIC(14, 2) NOT NULL CHECK (credit_limit >= 0),
+    balance NUMERIC(14, 2) NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_accounts_tenant ON credit_accounts (tenant_id);
+
+-- Orders placed on credit, due at the end of the company's payment terms.
+-- Payments settle the outstanding amount of the oldest charges first.
+CREATE TABLE IF NOT EXISTS credit_charges (
+    charge_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    company_id UUID NOT NULL REFERENCES credit_accounts(company_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    order_reference VARCHAR(100) NOT NULL,
+    amount NUMERIC(14, 2) NOT NULL CHECK (amount > 0),
+    outstanding NUMERIC(14, 2) NOT NULL CHECK (outstanding >= 0),
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    overdue_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (company_id, order_reference)
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_charges_company ON credit_charges (company_id, created_at);
+CREATE INDEX IF NOT EXISTS idx_credit_charges_due ON credit_charges (due_at) WHERE outstanding > 0 AND overdue_at IS NULL;
+
+-- Payments received on credit accounts
+CREATE TABLE IF NOT EXISTS credit_payments (
+    payment_id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    company_id UUID NOT NULL REFERENCES credit_accounts(company_id) ON DELETE CASCADE,
+    amount NUMERIC(14, 2) NOT NULL CHECK (amount > 0),
+    reference VARCHAR(100) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_payments_company ON credit_payments (company_id, created_at);
+
+-- Charges found overdue, read in ID order by the notification service that
+-- reminds the purchaser who placed the order
+CREATE TABLE IF NOT EXISTS credit_events (
+    id BIGSERIAL PRIMARY KEY,
+    tenant_id VARCHAR(50) NOT NULL DEFAULT 'default',
+    company_id UUID NOT NULL,
+    charge_id UUID NOT NULL,
+    user_id UUID NOT NULL,
+    email VARCHAR(255) NOT NULL,
+    order_reference VARCHAR(100) NOT NULL,
+    outstanding NUMERIC(14, 2) NOT NULL,
+    due_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_credit_events_tenant ON credit_events (tenant_id, id);
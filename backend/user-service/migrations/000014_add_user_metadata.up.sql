@@ -0,0 +1,10 @@
+-- Custom metadata integrators attach to user accounts, such as their ID in
+-- the ERP or CRM, a JSON object of at most 8 KiB
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS metadata JSONB NOT NULL DEFAULT '{}'::jsonb,
+    ADD CONSTRAINT users_metadata_check
+        CHECK (jsonb_typeof(metadata) = 'object' AND octet_length(metadata::text) <= 8192);
+
+-- ERP IDs are what integrators look accounts up by
+CREATE INDEX IF NOT EXISTS idx_users_metadata_erp_id ON users (tenant_id, (metadata->>'erp_id'))
+    WHERE metadata ? 'erp_id';
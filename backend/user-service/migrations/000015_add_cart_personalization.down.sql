@@ -0,0 +1 @@
+ALTER TABLE shopper_list_items DROP COLUMN IF EXISTS personalization;
@@ -0,0 +1,7 @@
+-- Personalization of cart items, such as an engraving text or uploaded
+-- artwork: a JSON array of the values the buyer gave for the
+-- personalization options of the product, validated by the product service
+ALTER TABLE shopper_list_items
+    ADD COLUMN IF NOT EXISTS personalization JSONB NOT NULL DEFAULT '[]'::jsonb,
+    ADD CONSTRAINT shopper_list_items_personalization_check
+        CHECK (jsonb_typeof(personalization) = 'array');
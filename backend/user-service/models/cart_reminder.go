@@ -0,0 +1,40 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CartReminderConversionWindow is how long after a reminder an order placed
+// by the user is counted as a conversion of the reminded cart
+const CartReminderConversionWindow = 7 * 24 * time.Hour
+
+// CartReminder is the reminder of a cart left inactive, recorded once for
+// each period of inactivity and emailed by the notification service
+type CartReminder struct {
+	ID             int64             `json:"id" db:"id"`
+	UserID         uuid.UUID         `json:"user_id" db:"user_id"`
+	Email          string            `json:"email" db:"email"`
+	FirstName      string            `json:"first_name" db:"first_name"`
+	Items          []ShopperListItem `json:"items" db:"items"`
+	CartUpdatedAt  time.Time         `json:"cart_updated_at" db:"cart_updated_at"`
+	RemindedAt     time.Time         `json:"reminded_at" db:"reminded_at"`
+	ConvertedAt    *time.Time        `json:"converted_at,omitempty" db:"converted_at"`
+	OrderReference string            `json:"order_reference,omitempty" db:"order_reference"`
+}
+
+// CartReminderStats counts the reminders sent over a period and those
+// followed by an order
+type CartReminderStats struct {
+	Reminded  int64
+	Converted int64
+}
+
+// ConversionRate returns the share of reminded carts that were converted
+func (s CartReminderStats) ConversionRate() float64 {
+	if s.Reminded == 0 {
+		return 0
+	}
+	return float64(s.Converted) / float64(s.Reminded)
+}
@@ -0,0 +1,142 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Roles of the members of a company. Approvers purchase too, and decide the
+// purchases of the company that need an approval.
+const (
+	CompanyRolePurchaser = "purchaser"
+	CompanyRoleApprover  = "approver"
+)
+
+// Payment terms of companies: prepaid, or invoiced and due the given number
+// of days after the order
+const (
+	PaymentTermsPrepaid = "prepaid"
+	PaymentTermsNet15   = "net_15"
+	PaymentTermsNet30   = "net_30"
+	PaymentTermsNet60   = "net_60"
+)
+
+// Types of the shared addresses of companies
+const (
+	CompanyAddressShipping = "shipping"
+	CompanyAddressBilling  = "billing"
+)
+
+// Statuses of purchase approvals
+const (
+	PurchasePending  = "pending"
+	PurchaseApproved = "approved"
+	PurchaseRejected = "rejected"
+)
+
+// Company is a B2B account whose members purchase on its behalf
+type Company struct {
+	CompanyID    uuid.UUID `json:"company_id" db:"company_id"`
+	Name         string    `json:"name" db:"name"`
+	PaymentTerms string    `json:"payment_terms" db:"payment_terms"`
+	// ApprovalThreshold is the amount above which purchases need an
+	// approver; nil when no purchase does
+	ApprovalThreshold *float64         `json:"approval_threshold,omitempty" db:"approval_threshold"`
+	CreatedAt         time.Time        `json:"created_at" db:"created_at"`
+	UpdatedAt         time.Time        `json:"updated_at" db:"updated_at"`
+	Members           []CompanyMember  `json:"members,omitempty" db:"-"`
+	Addresses         []CompanyAddress `json:"addresses,omitempty" db:"-"`
+}
+
+// CompanyMember is a user purchasing for a company. Users belong to one
+// company at most.
+type CompanyMember struct {
+	CompanyID uuid.UUID `json:"company_id" db:"company_id"`
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	Email     string    `json:"email" db:"email"`
+	FirstName string    `json:"first_name" db:"first_name"`
+	LastName  string    `json:"last_name" db:"last_name"`
+	Role      string    `json:"role" db:"role"`
+	AddedAt   time.Time `json:"added_at" db:"added_at"`
+}
+
+// CompanyAddress is an address shared by the members of a company
+type CompanyAddress struct {
+	AddressID      uuid.UUID `json:"address_id" db:"address_id"`
+	CompanyID      uuid.UUID `json:"company_id" db:"company_id"`
+	Label          string    `json:"label" db:"label"`
+	AddressType    string    `json:"address_type" db:"address_type"`
+	StreetAddress1 string    `json:"street_address1" db:"street_address1"`
+	StreetAddress2 string    `json:"street_address2" db:"street_address2"`
+	City           string    `json:"city" db:"city"`
+	State          string    `json:"state" db:"state"`
+	PostalCode     string    `json:"postal_code" db:"postal_code"`
+	Country        string    `json:"country" db:"country"`
+	IsDefault      bool      `json:"is_default" db:"is_default"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
+
+// PurchaseApproval is the approval of a purchase of a company member, asked
+// at checkout. Purchases up to the approval threshold are approved as they
+// are requested.
+type PurchaseApproval struct {
+	ApprovalID     uuid.UUID  `json:"approval_id" db:"approval_id"`
+	CompanyID      uuid.UUID  `json:"company_id" db:"company_id"`
+	RequesterID    uuid.UUID  `json:"requester_id" db:"requester_id"`
+	OrderReference string     `json:"order_reference" db:"order_reference"`
+	Amount         float64    `json:"amount" db:"amount"`
+	Status         string     `json:"status" db:"status"`
+	DecidedBy      *uuid.UUID `json:"decided_by,omitempty" db:"decided_by"`
+	Notes          string     `json:"notes" db:"notes"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+	DecidedAt      *time.Time `json:"decided_at,omitempty" db:"decided_at"`
+}
+
+// PurchaseApprovalFilter selects the purchase approvals of a company, of
+// any requester and status when unset
+type PurchaseApprovalFilter struct {
+	CompanyID   uuid.UUID
+	RequesterID *uuid.UUID
+	Status      string
+}
+
+// IsValidCompanyRole reports whether role is a role within a company
+func IsValidCompanyRole(role string) bool {
+	return role == CompanyRolePurchaser || role == CompanyRoleApprover
+}
+
+// IsValidPaymentTerms reports whether terms names payment terms
+func IsValidPaymentTerms(terms string) bool {
+	switch terms {
+	case PaymentTermsPrepaid, PaymentTermsNet15, PaymentTermsNet30, PaymentTermsNet60:
+		return true
+	}
+	return false
+}
+
+// IsValidCompanyAddressType reports whether addressType is a type of company
+// address
+func IsValidCompanyAddressType(addressType string) bool {
+	return addressType == CompanyAddressShipping || addressType == CompanyAddressBilling
+}
+
+// IsValidPurchaseStatus reports whether status is a status of purchase
+// approvals
+func IsValidPurchaseStatus(status string) bool {
+	switch status {
+	case PurchasePending, PurchaseApproved, PurchaseRejected:
+		return true
+	}
+	return false
+}
+
+// CanPurchase reports whether the member may purchase for the company
+func (m *CompanyMember) CanPurchase() bool {
+	return m.Role == CompanyRolePurchaser || m.Role == CompanyRoleApprover
+}
+
+// RequiresApproval reports whether a purchase of amount needs an approver
+func (c *Company) RequiresApproval(amount float64) bool {
+	return c.ApprovalThreshold != nil && amount > *c.ApprovalThreshold
+}
@@ -0,0 +1,88 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CreditAccount is the credit a company on net payment terms purchases
+// against. Its balance is the amount of the charges not paid yet.
+type CreditAccount struct {
+	CompanyID   uuid.UUID `json:"company_id" db:"company_id"`
+	CreditLimit float64   `json:"credit_limit" db:"credit_limit"`
+	Balance     float64   `json:"balance" db:"balance"`
+	// Overdue is the part of the balance past its due date
+	Overdue   float64   `json:"overdue" db:"-"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// CreditCharge is an order placed on credit, due at the end of the payment
+// terms of the company
+type CreditCharge struct {
+	ChargeID       uuid.UUID  `json:"charge_id" db:"charge_id"`
+	CompanyID      uuid.UUID  `json:"company_id" db:"company_id"`
+	UserID         uuid.UUID  `json:"user_id" db:"user_id"`
+	OrderReference string     `json:"order_reference" db:"order_reference"`
+	Amount         float64    `json:"amount" db:"amount"`
+	Outstanding    float64    `json:"outstanding" db:"outstanding"`
+	DueAt          time.Time  `json:"due_at" db:"due_at"`
+	OverdueAt      *time.Time `json:"overdue_at,omitempty" db:"overdue_at"`
+	CreatedAt      time.Time  `json:"created_at" db:"created_at"`
+}
+
+// CreditPayment is a payment received on a credit account
+type CreditPayment struct {
+	PaymentID uuid.UUID `json:"payment_id" db:"payment_id"`
+	CompanyID uuid.UUID `json:"company_id" db:"company_id"`
+	Amount    float64   `json:"amount" db:"amount"`
+	Reference string    `json:"reference" db:"reference"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// CreditStatement lists the charges and payments of a credit account over
+// a period, from the balance at its start to the balance at its end
+type CreditStatement struct {
+	CompanyID      uuid.UUID       `json:"company_id"`
+	From           time.Time       `json:"from"`
+	To             time.Time       `json:"to"`
+	OpeningBalance float64         `json:"opening_balance"`
+	ClosingBalance float64         `json:"closing_balance"`
+	Charges        []CreditCharge  `json:"charges"`
+	Payments       []CreditPayment `json:"payments"`
+}
+
+// CreditEvent is a charge found overdue, read in ID order by the
+// notification service that reminds the purchaser who placed the order
+type CreditEvent struct {
+	ID             int64     `json:"id" db:"id"`
+	CompanyID      uuid.UUID `json:"company_id" db:"company_id"`
+	ChargeID       uuid.UUID `json:"charge_id" db:"charge_id"`
+	UserID         uuid.UUID `json:"user_id" db:"user_id"`
+	Email          string    `json:"email" db:"email"`
+	OrderReference string    `json:"order_reference" db:"order_reference"`
+	Outstanding    float64   `json:"outstanding" db:"outstanding"`
+	DueAt          time.Time `json:"due_at" db:"due_at"`
+	CreatedAt      time.Time `json:"created_at" db:"created_at"`
+}
+
+// Available returns the credit left to purchase against
+func (a *CreditAccount) Available() float64 {
+	return math.Max(0, math.Round((a.CreditLimit-a.Balance)*100)/100)
+}
+
+// PaymentTermsDays returns the number of days after the order that charges
+// on terms are due, or 0 for prepaid terms, which purchase no credit
+func PaymentTermsDays(terms string) int {
+	switch terms {
+	case PaymentTermsNet15:
+		return 15
+	case PaymentTermsNet30:
+		return 30
+	case PaymentTermsNet60:
+		return 60
+	}
+	return 0
+}
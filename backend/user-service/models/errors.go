@@ -0,0 +1,61 @@
+package models
+
+import (
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+)
+
+var (
+	ErrUserNotFound             = apperrors.New(apperrors.ErrNotFound, "user not found")
+	ErrUserExists               = apperrors.New(apperrors.ErrAlreadyExists, "user already exists")
+	ErrAddressNotFound          = apperrors.New(apperrors.ErrNotFound, "address not found")
+	ErrPaymentMethodNotFound    = apperrors.New(apperrors.ErrNotFound, "payment method not found")
+	ErrPreferencesNotFound      = apperrors.New(apperrors.ErrNotFound, "preferences not found")
+	ErrUserNoteNotFound         = apperrors.New(apperrors.ErrNotFound, "note not found")
+	ErrInvalidUserNote          = apperrors.New(apperrors.ErrInvalidArgument, "note body must be between 1 and 5000 characters")
+	ErrNotNoteAuthor            = apperrors.New(apperrors.ErrPermissionDenied, "only the author can edit a note")
+	ErrInvalidShopperList       = apperrors.New(apperrors.ErrInvalidArgument, "list must be cart, wishlist or recently_viewed")
+	ErrInvalidShopperOwner      = apperrors.New(apperrors.ErrInvalidArgument, "owner must be a user ID or a guest session ID")
+	ErrInvalidListItem          = apperrors.New(apperrors.ErrInvalidArgument, "item needs a product ID and a quantity between 0 and 999")
+	ErrListItemNotFound         = apperrors.New(apperrors.ErrNotFound, "item not found in list")
+	ErrInvalidOrderReference    = apperrors.New(apperrors.ErrInvalidArgument, "order reference is required")
+	ErrInvalidStatsPeriod       = apperrors.New(apperrors.ErrInvalidArgument, "from must be before to")
+	ErrInvalidPermissionSet     = apperrors.New(apperrors.ErrInvalidArgument, "permission sets must be catalog, inventory, orders or users")
+	ErrNotStaff                 = apperrors.New(apperrors.ErrFailedPrecondition, "user is not a staff account")
+	ErrSelfPermissionChange     = apperrors.New(apperrors.ErrPermissionDenied, "staff cannot change their own permissions")
+	ErrPermissionAssignment     = apperrors.New(apperrors.ErrPermissionDenied, "only administrators can manage staff")
+	ErrAccountDeletion          = apperrors.New(apperrors.ErrPermissionDenied, "only administrators can delete administrator and staff accounts")
+	ErrInvalidImport            = apperrors.New(apperrors.ErrInvalidArgument, "import must be a CSV with email, name and role columns and at most 1000 rows")
+	ErrImportDenied             = apperrors.New(apperrors.ErrPermissionDenied, "only administrators can import users")
+	ErrInvitationNotFound       = apperrors.New(apperrors.ErrNotFound, "invitation not found or expired")
+	ErrNotInvited               = apperrors.New(apperrors.ErrFailedPrecondition, "user has no pending invitation")
+	ErrInvitationPending        = apperrors.New(apperrors.ErrFailedPrecondition, "invitation not accepted yet")
+	ErrInvalidOrderEvent        = apperrors.New(apperrors.ErrInvalidArgument, "order event needs a type of placed, cancelled or refunded and a non-negative amount")
+	ErrCompanyNotFound          = apperrors.New(apperrors.ErrNotFound, "company not found")
+	ErrInvalidCompany           = apperrors.New(apperrors.ErrInvalidArgument, "company needs a name, payment terms of prepaid, net_15, net_30 or net_60 and a non-negative approval threshold")
+	ErrInvalidCompanyRole       = apperrors.New(apperrors.ErrInvalidArgument, "company role must be purchaser or approver")
+	ErrCompanyMemberNotFound    = apperrors.New(apperrors.ErrNotFound, "user is not a member of the company")
+	ErrCompanyMembership        = apperrors.New(apperrors.ErrAlreadyExists, "user already belongs to another company")
+	ErrNotCustomer              = apperrors.New(apperrors.ErrFailedPrecondition, "company members must be customer accounts")
+	ErrInvalidCompanyAddress    = apperrors.New(apperrors.ErrInvalidArgument, "company address needs a type of shipping or billing, a street, city, state, postal code and country")
+	ErrCompanyAddressNotFound   = apperrors.New(apperrors.ErrNotFound, "company address not found")
+	ErrInvalidPurchase          = apperrors.New(apperrors.ErrInvalidArgument, "purchase needs an order reference and a positive amount")
+	ErrInvalidPurchaseStatus    = apperrors.New(apperrors.ErrInvalidArgument, "status must be pending, approved or rejected")
+	ErrPurchaseApprovalNotFound = apperrors.New(apperrors.ErrNotFound, "purchase approval not found")
+	ErrNotApprover              = apperrors.New(apperrors.ErrPermissionDenied, "only approvers of the company can decide purchases")
+	ErrSelfApproval             = apperrors.New(apperrors.ErrPermissionDenied, "approvers cannot decide their own purchases")
+	ErrPurchaseDecided          = apperrors.New(apperrors.ErrFailedPrecondition, "purchase approval already decided")
+	ErrNotPurchaser             = apperrors.New(apperrors.ErrPermissionDenied, "only purchasers and approvers of the company can purchase on its credit")
+	ErrPurchaseNotApproved      = apperrors.New(apperrors.ErrFailedPrecondition, "purchase has not been approved")
+	ErrQuoteNotFound            = apperrors.New(apperrors.ErrNotFound, "quote not found")
+	ErrEmptyQuoteCart           = apperrors.New(apperrors.ErrFailedPrecondition, "quotes are requested for a cart with items")
+	ErrInvalidQuote             = apperrors.New(apperrors.ErrInvalidArgument, "quote needs a non-negative unit price for each item, a future expiry and notes of at most 2000 characters")
+	ErrInvalidQuoteStatus       = apperrors.New(apperrors.ErrInvalidArgument, "status must be requested, quoted, accepted, declined, expired or converted")
+	ErrQuoteState               = apperrors.New(apperrors.ErrFailedPrecondition, "quote cannot make this change in its status")
+	ErrQuoteExpired             = apperrors.New(apperrors.ErrFailedPrecondition, "quote has expired")
+	ErrCreditAccountNotFound    = apperrors.New(apperrors.ErrNotFound, "company has no credit account")
+	ErrInvalidCreditLimit       = apperrors.New(apperrors.ErrInvalidArgument, "credit limit must not be negative")
+	ErrNoCreditTerms            = apperrors.New(apperrors.ErrFailedPrecondition, "company pays on prepaid terms and cannot purchase on credit")
+	ErrCreditLimitExceeded      = apperrors.New(apperrors.ErrFailedPrecondition, "purchase exceeds the available credit of the company")
+	ErrInvalidCreditPayment     = apperrors.New(apperrors.ErrInvalidArgument, "credit payment needs a reference and a positive amount of at most the balance")
+	ErrInvalidStatementPeriod   = apperrors.New(apperrors.ErrInvalidArgument, "statement period must end after it starts and span at most a year")
+)
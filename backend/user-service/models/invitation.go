@@ -0,0 +1,62 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AccountStatusInvited is the status of accounts created by an import until
+// the user accepts their invitation and sets a password. Invited accounts
+// cannot log in.
+const AccountStatusInvited = "invited"
+
+// MaxImportRows is the most rows a user import may hold
+const MaxImportRows = 1000
+
+// Results of the rows of a user import
+const (
+	ImportRowInvited = "invited"
+	ImportRowFailed  = "failed"
+)
+
+// ImportableRoles maps the roles user imports may assign to their user type.
+// Administrators are never imported.
+var ImportableRoles = map[string]string{
+	RoleUser:           UserTypeCustomer,
+	RoleBasicSeller:    UserTypeSeller,
+	RoleVerifiedSeller: UserTypeSeller,
+	RoleStaff:          UserTypeAdmin,
+}
+
+// Invitation is the invitation of an imported user to set their password.
+// Only the hash of the token sent in the email is stored.
+type Invitation struct {
+	UserID     uuid.UUID  `json:"user_id" db:"user_id"`
+	Email      string     `json:"email" db:"email"`
+	TokenHash  string     `json:"-" db:"token_hash"`
+	InvitedBy  uuid.UUID  `json:"invited_by" db:"invited_by"`
+	SendCount  int        `json:"send_count" db:"send_count"`
+	SentAt     *time.Time `json:"sent_at,omitempty" db:"sent_at"`
+	ExpiresAt  time.Time  `json:"expires_at" db:"expires_at"`
+	AcceptedAt *time.Time `json:"accepted_at,omitempty" db:"accepted_at"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at"`
+}
+
+// ImportRowResult is the validation result of a row of a user import. Rows
+// are numbered from 1, after the header.
+type ImportRowResult struct {
+	Row       int       `json:"row"`
+	Email     string    `json:"email"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+	UserID    uuid.UUID `json:"user_id,omitempty"`
+	EmailSent bool      `json:"email_sent"`
+}
+
+// ImportResult reports the rows of a user import
+type ImportResult struct {
+	Rows    []ImportRowResult `json:"rows"`
+	Invited int               `json:"invited"`
+	Failed  int               `json:"failed"`
+}
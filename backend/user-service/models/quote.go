@@ -0,0 +1,99 @@
+package models
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of quotes. A requested quote is quoted by an admin, then accepted
+// or declined by the buyer; accepted quotes are converted into an order.
+// Quoted and accepted quotes expire at their expiry.
+const (
+	QuoteRequested = "requested"
+	QuoteQuoted    = "quoted"
+	QuoteAccepted  = "accepted"
+	QuoteDeclined  = "declined"
+	QuoteExpired   = "expired"
+	QuoteConverted = "converted"
+)
+
+// MaxQuoteNotes bounds the notes of buyers and admins on quotes
+const MaxQuoteNotes = 2000
+
+// QuoteItem is a product of the cart a quote was requested for, with the
+// unit price negotiated once quoted
+type QuoteItem struct {
+	ProductID string   `json:"product_id"`
+	VariantID string   `json:"variant_id"`
+	Quantity  int      `json:"quantity"`
+	UnitPrice *float64 `json:"unit_price,omitempty"`
+}
+
+// Quote is a request for quote of a buyer's cart and the negotiated prices
+// answered by the store
+type Quote struct {
+	QuoteID        uuid.UUID   `json:"quote_id" db:"quote_id"`
+	UserID         uuid.UUID   `json:"user_id" db:"user_id"`
+	CompanyID      *uuid.UUID  `json:"company_id,omitempty" db:"company_id"`
+	Status         string      `json:"status" db:"status"`
+	Items          []QuoteItem `json:"items" db:"items"`
+	BuyerNotes     string      `json:"buyer_notes" db:"buyer_notes"`
+	AdminNotes     string      `json:"admin_notes" db:"admin_notes"`
+	Total          *float64    `json:"total,omitempty" db:"total"`
+	ExpiresAt      *time.Time  `json:"expires_at,omitempty" db:"expires_at"`
+	OrderReference string      `json:"order_reference,omitempty" db:"order_reference"`
+	CreatedAt      time.Time   `json:"created_at" db:"created_at"`
+	QuotedAt       *time.Time  `json:"quoted_at,omitempty" db:"quoted_at"`
+	ConvertedAt    *time.Time  `json:"converted_at,omitempty" db:"converted_at"`
+	UpdatedAt      time.Time   `json:"updated_at" db:"updated_at"`
+}
+
+// QuoteFilter selects quotes, of any buyer and status when unset
+type QuoteFilter struct {
+	UserID *uuid.UUID
+	Status string
+}
+
+// QuoteEvent is a change of status of a quote, read in ID order by the
+// notification service that emails the buyer or the store
+type QuoteEvent struct {
+	ID        int64      `json:"id" db:"id"`
+	QuoteID   uuid.UUID  `json:"quote_id" db:"quote_id"`
+	UserID    uuid.UUID  `json:"user_id" db:"user_id"`
+	Email     string     `json:"email" db:"email"`
+	Status    string     `json:"status" db:"status"`
+	Total     *float64   `json:"total,omitempty" db:"total"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty" db:"expires_at"`
+	CreatedAt time.Time  `json:"created_at" db:"created_at"`
+}
+
+// IsValidQuoteStatus reports whether status is a status of quotes
+func IsValidQuoteStatus(status string) bool {
+	switch status {
+	case QuoteRequested, QuoteQuoted, QuoteAccepted, QuoteDeclined, QuoteExpired, QuoteConverted:
+		return true
+	}
+	return false
+}
+
+// IsExpired reports whether the offer of a quoted or accepted quote has
+// expired at now
+func (q *Quote) IsExpired(now time.Time) bool {
+	if q.Status != QuoteQuoted && q.Status != QuoteAccepted {
+		return false
+	}
+	return q.ExpiresAt != nil && !now.Before(*q.ExpiresAt)
+}
+
+// QuoteTotal returns the total of quoted items, rounded to cents
+func QuoteTotal(items []QuoteItem) float64 {
+	var total float64
+	for _, item := range items {
+		if item.UnitPrice != nil {
+			total += *item.UnitPrice * float64(item.Quantity)
+		}
+	}
+	return math.Round(total*100) / 100
+}
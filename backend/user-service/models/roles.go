@@ -1,5 +1,7 @@
 package models
 
+import "github.com/louai60/e-commerce_project/backend/common/scope"
+
 // User Types
 const (
 	UserTypeCustomer = "customer"
@@ -94,3 +96,30 @@ var RolePermissions = map[string][]Permission{
 		PermFullAccess,
 	},
 }
+
+// permissionScopes maps permissions to the scopes they grant in access tokens
+var permissionScopes = map[Permission][]string{
+	PermBrowseProducts:  {scope.ProductsRead},
+	PermManageProducts:  {scope.ProductsRead, scope.ProductsWrite},
+	PermManageInventory: {scope.InventoryRead, scope.InventoryWrite},
+	PermManageWarehouse: {scope.InventoryRead, scope.InventoryWrite},
+	PermManageUsers:     {scope.UsersRead, scope.UsersWrite},
+}
+
+// RoleScopes returns the scopes access tokens grant to a role, derived from
+// its permissions. Administrators are granted every scope.
+func RoleScopes(role string) []string {
+	if role == RoleAdmin || role == RoleSuperAdmin {
+		return scope.All
+	}
+
+	scopes := []string{}
+	for _, permission := range RolePermissions[role] {
+		for _, s := range permissionScopes[permission] {
+			if !scope.Has(scopes, s) {
+				scopes = append(scopes, s)
+			}
+		}
+	}
+	return scopes
+}
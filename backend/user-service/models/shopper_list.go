@@ -0,0 +1,90 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Shopper lists
+const (
+	ListCart           = "cart"
+	ListWishlist       = "wishlist"
+	ListRecentlyViewed = "recently_viewed"
+)
+
+// Bounds of shopper lists
+const (
+	MaxCartQuantity = 999
+	// MaxRecentlyViewed is the number of recently viewed products kept; older
+	// views are dropped
+	MaxRecentlyViewed = 20
+	// MaxPersonalizationValues bounds the personalization values of a cart
+	// item, one per personalization option of its product
+	MaxPersonalizationValues = 10
+)
+
+// GuestIDPrefix starts the IDs of guest sessions, which are otherwise UUIDs,
+// so that they never collide with user IDs
+const GuestIDPrefix = "guest_"
+
+// ShopperListItem is a product in the cart, wishlist or recently viewed
+// products of a user or guest
+type ShopperListItem struct {
+	OwnerID   string    `json:"owner_id" db:"owner_id"`
+	List      string    `json:"list" db:"list"`
+	ProductID string    `json:"product_id" db:"product_id"`
+	VariantID string    `json:"variant_id" db:"variant_id"`
+	Quantity  int       `json:"quantity" db:"quantity"`
+	AddedAt   time.Time `json:"added_at" db:"added_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	// Personalization is only kept on cart items
+	Personalization []PersonalizationValue `json:"personalization,omitempty" db:"personalization"`
+}
+
+// PersonalizationValue is the input of a buyer for a personalization option
+// of a product, such as an engraving text or uploaded artwork, as validated
+// by the product service
+type PersonalizationValue struct {
+	OptionID string `json:"option_id"`
+	Name     string `json:"name"`
+	Text     string `json:"text,omitempty"`
+	FileURL  string `json:"file_url,omitempty"`
+	FileName string `json:"file_name,omitempty"`
+}
+
+// GuestMergeResult counts the guest items merged into each list of a user
+type GuestMergeResult struct {
+	Cart           int
+	Wishlist       int
+	RecentlyViewed int
+}
+
+// IsValidShopperList reports whether list names a shopper list
+func IsValidShopperList(list string) bool {
+	switch list {
+	case ListCart, ListWishlist, ListRecentlyViewed:
+		return true
+	}
+	return false
+}
+
+// IsGuestID reports whether id is the ID of a guest session
+func IsGuestID(id string) bool {
+	rest, ok := strings.CutPrefix(id, GuestIDPrefix)
+	if !ok {
+		return false
+	}
+	_, err := uuid.Parse(rest)
+	return err == nil
+}
+
+// IsValidShopperOwner reports whether id identifies a user or a guest
+func IsValidShopperOwner(id string) bool {
+	if IsGuestID(id) {
+		return true
+	}
+	_, err := uuid.Parse(id)
+	return err == nil
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"slices"
+
+	"github.com/louai60/e-commerce_project/backend/common/scope"
+)
+
+// RoleStaff is the role of employees of the store. Staff are admin users
+// holding only the permission sets assigned to them by administrators.
+const RoleStaff = "staff"
+
+// Permission sets assignable to staff
+const (
+	PermissionSetCatalog   = "catalog"
+	PermissionSetInventory = "inventory"
+	PermissionSetOrders    = "orders"
+	PermissionSetUsers     = "users"
+)
+
+// PermissionSet is a group of permissions assigned to staff as a whole
+type PermissionSet struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Scopes      []string `json:"scopes"`
+}
+
+// PermissionSets lists the permission sets assignable to staff
+var PermissionSets = []PermissionSet{
+	{
+		Name:        PermissionSetCatalog,
+		Description: "Manage products, categories, brands, collections and imports",
+		Scopes:      []string{scope.ProductsRead, scope.ProductsWrite},
+	},
+	{
+		Name:        PermissionSetInventory,
+		Description: "Manage stock, warehouses, suppliers and purchase orders",
+		Scopes:      []string{scope.InventoryRead, scope.InventoryWrite},
+	},
+	{
+		Name:        PermissionSetOrders,
+		Description: "Manage shipments, refunds and fraud reviews of orders",
+		Scopes:      []string{scope.OrdersRead, scope.OrdersWrite},
+	},
+	{
+		Name:        PermissionSetUsers,
+		Description: "View and manage customer accounts",
+		Scopes:      []string{scope.UsersRead, scope.UsersWrite},
+	},
+}
+
+// IsValidPermissionSet reports whether name is a permission set
+func IsValidPermissionSet(name string) bool {
+	for _, set := range PermissionSets {
+		if set.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAdministrator reports whether the user administers the store as a
+// whole, as opposed to staff holding permission sets
+func (u *User) IsAdministrator() bool {
+	return u.UserType == UserTypeAdmin && (u.Role == RoleAdmin || u.Role == RoleSuperAdmin)
+}
+
+// IsStaff reports whether the user is a staff account
+func (u *User) IsStaff() bool {
+	return u.UserType == UserTypeAdmin && u.Role == RoleStaff
+}
+
+// UserScopes returns the scopes access tokens grant to a user: those of
+// their role, and for staff those of their permission sets
+func UserScopes(user *User) []string {
+	scopes := RoleScopes(user.Role)
+	if !user.IsStaff() {
+		return scopes
+	}
+	for _, set := range PermissionSets {
+		if !slices.Contains(user.PermissionSets, set.Name) {
+			continue
+		}
+		for _, s := range set.Scopes {
+			if !scope.Has(scopes, s) {
+				scopes = append(scopes, s)
+			}
+		}
+	}
+	return scopes
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"database/sql"
+	"encoding/json"
 	"github.com/google/uuid"
 	"time"
 )
@@ -28,8 +29,12 @@ type User struct {
 	PhoneVerified  bool         `json:"phone_verified" db:"phone_verified"`
 	CreatedAt      time.Time    `json:"created_at" db:"created_at"`
 	UpdatedAt      time.Time    `json:"updated_at" db:"updated_at"`
-	LastLogin      sql.NullTime `json:"last_login" db:"last_login"` // Changed to sql.NullTime
-	RefreshTokenID string       `json:"-" db:"refresh_token_id"`    // JTI of the current valid refresh token
+	LastLogin      sql.NullTime `json:"last_login" db:"last_login"`       // Changed to sql.NullTime
+	RefreshTokenID string       `json:"-" db:"refresh_token_id"`          // JTI of the current valid refresh token
+	TenantID       string       `json:"tenant_id" db:"tenant_id"`         // Store the account belongs to
+	PermissionSets []string     `json:"permission_sets,omitempty" db:"-"` // Assigned to staff accounts
+	// Metadata is the custom JSON object integrators attach to the account
+	Metadata json.RawMessage `json:"metadata,omitempty" db:"metadata"`
 }
 
 type UserAddress struct {
@@ -75,6 +80,18 @@ type UserPreferences struct {
 	UpdatedAt         time.Time `json:"updated_at" db:"updated_at"`
 }
 
+// UserNote is an internal note staff attached to a user. Notes are never
+// shown to the user themselves.
+type UserNote struct {
+	NoteID      uuid.UUID `json:"note_id" db:"note_id"`
+	UserID      uuid.UUID `json:"user_id" db:"user_id"`
+	AuthorID    uuid.UUID `json:"author_id" db:"author_id"`
+	AuthorEmail string    `json:"author_email" db:"author_email"`
+	Body        string    `json:"body" db:"body"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
+}
+
 type RegisterRequest struct {
 	Email       string `json:"email" validate:"required,email"`
 	Username    string `json:"username" validate:"required,min=3,max=50"`
@@ -136,7 +153,7 @@ func IsValidUserType(userType string) bool {
 
 // IsValidRole validates the role
 func IsValidRole(userType, role string) bool {
-	if userType == UserTypeAdmin && (role == RoleAdmin || role == RoleSuperAdmin) {
+	if userType == UserTypeAdmin && (role == RoleAdmin || role == RoleSuperAdmin || role == RoleStaff) {
 		return true
 	}
 	if userType == UserTypeCustomer && role == RoleUser {
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// User event types
+const (
+	UserEventRegistered         = "registered"
+	UserEventLoggedIn           = "logged_in"
+	UserEventUpdated            = "updated"
+	UserEventDeleted            = "deleted"
+	UserEventPermissionsChanged = "permissions_changed"
+)
+
+// UserEvent is an event of a user account, as shown in the admin activity
+// feed
+type UserEvent struct {
+	ID        int64     `json:"id" db:"id"`
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	Email     string    `json:"email" db:"email"`
+	Type      string    `json:"type" db:"event_type"`
+	ActorID   string    `json:"actor_id,omitempty" db:"actor_id"` // Empty when the user acted themselves or unknown
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Order event types consumed into the lifetime metrics of customers
+const (
+	OrderEventPlaced    = "placed"
+	OrderEventCancelled = "cancelled"
+	OrderEventRefunded  = "refunded"
+)
+
+// IsValidOrderEventType reports whether t is an order event type
+func IsValidOrderEventType(t string) bool {
+	switch t {
+	case OrderEventPlaced, OrderEventCancelled, OrderEventRefunded:
+		return true
+	}
+	return false
+}
+
+// OrderEvent is an event of an order of a user reported by the order system.
+// Placed orders count towards the metrics of the user, cancelled orders are
+// taken out of them again and refunds lower the spend by their amount.
+type OrderEvent struct {
+	UserID         uuid.UUID `json:"user_id" db:"user_id"`
+	OrderReference string    `json:"order_reference" db:"order_reference"`
+	Type           string    `json:"type" db:"event_type"`
+	Amount         float64   `json:"amount" db:"amount"`
+	OccurredAt     time.Time `json:"occurred_at" db:"occurred_at"`
+}
+
+// UserStats are the lifetime metrics of a customer, maintained from the
+// events of their orders
+type UserStats struct {
+	UserID      uuid.UUID  `json:"user_id" db:"user_id"`
+	TotalOrders int64      `json:"total_orders" db:"total_orders"`
+	TotalSpend  float64    `json:"total_spend" db:"total_spend"`
+	LastOrderAt *time.Time `json:"last_order_at,omitempty" db:"last_order_at"`
+	UpdatedAt   time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+// AverageOrderValue returns the spend per order, 0 without orders
+func (s UserStats) AverageOrderValue() float64 {
+	if s.TotalOrders == 0 {
+		return 0
+	}
+	return s.TotalSpend / float64(s.TotalOrders)
+}
+
+// UserStatsFilter selects customers by their lifetime metrics, e.g. for a
+// segment. Zero values do not filter.
+type UserStatsFilter struct {
+	MinOrders       int64
+	MinSpend        float64
+	LastOrderAfter  time.Time
+	LastOrderBefore time.Time
+}
@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -204,8 +205,12 @@ type User struct {
 	UpdatedAt      string                 `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 formatted timestamp
 	LastLogin      string                 `protobuf:"bytes,14,opt,name=last_login,json=lastLogin,proto3" json:"last_login,omitempty"` // RFC3339 formatted timestamp
 	RefreshTokenId string                 `protobuf:"bytes,15,opt,name=refresh_token_id,json=refreshTokenId,proto3" json:"refresh_token_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	PermissionSets []string               `protobuf:"bytes,16,rep,name=permission_sets,json=permissionSets,proto3" json:"permission_sets,omitempty"` // Assigned to staff accounts
+	// Custom data of integrators, set in responses of GetUser, ListUsers and
+	// UpdateUser when exposed to the store
+	Metadata      *structpb.Struct `protobuf:"bytes,17,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -343,6 +348,20 @@ func (x *User) GetRefreshTokenId() string {
 	return ""
 }
 
+func (x *User) GetPermissionSets() []string {
+	if x != nil {
+		return x.PermissionSets
+	}
+	return nil
+}
+
+func (x *User) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type CreateUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -694,6 +713,7 @@ type UpdateUserRequest struct {
 	FirstName     string                 `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
 	LastName      string                 `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
 	PhoneNumber   string                 `protobuf:"bytes,5,opt,name=phone_number,json=phoneNumber,proto3" json:"phone_number,omitempty"`
+	Metadata      *structpb.Struct       `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"` // JSON merge patch of the user metadata
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -763,9 +783,17 @@ func (x *UpdateUserRequest) GetPhoneNumber() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetMetadata() *structpb.Struct {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
 type DeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // UUID string
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // UUID of the user deleting the account
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -807,6 +835,13 @@ func (x *DeleteUserRequest) GetUserId() string {
 	return ""
 }
 
+func (x *DeleteUserRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
 // Login related messages
 type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1661,38 +1696,34 @@ func (x *DeleteAddressRequest) GetUserId() string {
 	return ""
 }
 
-// Payment method related messages
-type PaymentMethod struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	PaymentMethodId string                 `protobuf:"bytes,1,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"` // UUID string
-	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // UUID string
-	PaymentType     string                 `protobuf:"bytes,3,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
-	CardLastFour    string                 `protobuf:"bytes,4,opt,name=card_last_four,json=cardLastFour,proto3" json:"card_last_four,omitempty"`
-	CardBrand       string                 `protobuf:"bytes,5,opt,name=card_brand,json=cardBrand,proto3" json:"card_brand,omitempty"`
-	ExpirationMonth int32                  `protobuf:"varint,6,opt,name=expiration_month,json=expirationMonth,proto3" json:"expiration_month,omitempty"`
-	ExpirationYear  int32                  `protobuf:"varint,7,opt,name=expiration_year,json=expirationYear,proto3" json:"expiration_year,omitempty"`
-	IsDefault       bool                   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	Token           string                 `protobuf:"bytes,9,opt,name=token,proto3" json:"token,omitempty"`
-	CreatedAt       string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 formatted timestamp
-	UpdatedAt       string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 formatted timestamp
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Staff note related messages
+type UserNote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`       // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // UUID string of the staff member
+	AuthorEmail   string                 `protobuf:"bytes,4,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 formatted timestamp
+	UpdatedAt     string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 formatted timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentMethod) Reset() {
-	*x = PaymentMethod{}
+func (x *UserNote) Reset() {
+	*x = UserNote{}
 	mi := &file_proto_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentMethod) String() string {
+func (x *UserNote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentMethod) ProtoMessage() {}
+func (*UserNote) ProtoMessage() {}
 
-func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
+func (x *UserNote) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1704,116 +1735,84 @@ func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
-func (*PaymentMethod) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserNote.ProtoReflect.Descriptor instead.
+func (*UserNote) Descriptor() ([]byte, []int) {
 	return file_proto_user_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *PaymentMethod) GetPaymentMethodId() string {
+func (x *UserNote) GetNoteId() string {
 	if x != nil {
-		return x.PaymentMethodId
+		return x.NoteId
 	}
 	return ""
 }
 
-func (x *PaymentMethod) GetUserId() string {
+func (x *UserNote) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *PaymentMethod) GetPaymentType() string {
-	if x != nil {
-		return x.PaymentType
-	}
-	return ""
-}
-
-func (x *PaymentMethod) GetCardLastFour() string {
+func (x *UserNote) GetAuthorId() string {
 	if x != nil {
-		return x.CardLastFour
+		return x.AuthorId
 	}
 	return ""
 }
 
-func (x *PaymentMethod) GetCardBrand() string {
+func (x *UserNote) GetAuthorEmail() string {
 	if x != nil {
-		return x.CardBrand
+		return x.AuthorEmail
 	}
 	return ""
 }
 
-func (x *PaymentMethod) GetExpirationMonth() int32 {
-	if x != nil {
-		return x.ExpirationMonth
-	}
-	return 0
-}
-
-func (x *PaymentMethod) GetExpirationYear() int32 {
-	if x != nil {
-		return x.ExpirationYear
-	}
-	return 0
-}
-
-func (x *PaymentMethod) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
-	}
-	return false
-}
-
-func (x *PaymentMethod) GetToken() string {
+func (x *UserNote) GetBody() string {
 	if x != nil {
-		return x.Token
+		return x.Body
 	}
 	return ""
 }
 
-func (x *PaymentMethod) GetCreatedAt() string {
+func (x *UserNote) GetCreatedAt() string {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return ""
 }
 
-func (x *PaymentMethod) GetUpdatedAt() string {
+func (x *UserNote) GetUpdatedAt() string {
 	if x != nil {
 		return x.UpdatedAt
 	}
 	return ""
 }
 
-type AddPaymentMethodRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
-	PaymentType     string                 `protobuf:"bytes,2,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
-	CardLastFour    string                 `protobuf:"bytes,3,opt,name=card_last_four,json=cardLastFour,proto3" json:"card_last_four,omitempty"`
-	CardBrand       string                 `protobuf:"bytes,4,opt,name=card_brand,json=cardBrand,proto3" json:"card_brand,omitempty"`
-	ExpirationMonth int32                  `protobuf:"varint,5,opt,name=expiration_month,json=expirationMonth,proto3" json:"expiration_month,omitempty"`
-	ExpirationYear  int32                  `protobuf:"varint,6,opt,name=expiration_year,json=expirationYear,proto3" json:"expiration_year,omitempty"`
-	IsDefault       bool                   `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	Token           string                 `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type CreateUserNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	AuthorId      string                 `protobuf:"bytes,2,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // UUID string of the staff member
+	AuthorEmail   string                 `protobuf:"bytes,3,opt,name=author_email,json=authorEmail,proto3" json:"author_email,omitempty"`
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPaymentMethodRequest) Reset() {
-	*x = AddPaymentMethodRequest{}
+func (x *CreateUserNoteRequest) Reset() {
+	*x = CreateUserNoteRequest{}
 	mi := &file_proto_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPaymentMethodRequest) String() string {
+func (x *CreateUserNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPaymentMethodRequest) ProtoMessage() {}
+func (*CreateUserNoteRequest) ProtoMessage() {}
 
-func (x *AddPaymentMethodRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateUserNoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1825,88 +1824,60 @@ func (x *AddPaymentMethodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPaymentMethodRequest.ProtoReflect.Descriptor instead.
-func (*AddPaymentMethodRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateUserNoteRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserNoteRequest) Descriptor() ([]byte, []int) {
 	return file_proto_user_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *AddPaymentMethodRequest) GetUserId() string {
+func (x *CreateUserNoteRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *AddPaymentMethodRequest) GetPaymentType() string {
-	if x != nil {
-		return x.PaymentType
-	}
-	return ""
-}
-
-func (x *AddPaymentMethodRequest) GetCardLastFour() string {
+func (x *CreateUserNoteRequest) GetAuthorId() string {
 	if x != nil {
-		return x.CardLastFour
+		return x.AuthorId
 	}
 	return ""
 }
 
-func (x *AddPaymentMethodRequest) GetCardBrand() string {
+func (x *CreateUserNoteRequest) GetAuthorEmail() string {
 	if x != nil {
-		return x.CardBrand
+		return x.AuthorEmail
 	}
 	return ""
 }
 
-func (x *AddPaymentMethodRequest) GetExpirationMonth() int32 {
-	if x != nil {
-		return x.ExpirationMonth
-	}
-	return 0
-}
-
-func (x *AddPaymentMethodRequest) GetExpirationYear() int32 {
-	if x != nil {
-		return x.ExpirationYear
-	}
-	return 0
-}
-
-func (x *AddPaymentMethodRequest) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
-	}
-	return false
-}
-
-func (x *AddPaymentMethodRequest) GetToken() string {
+func (x *CreateUserNoteRequest) GetBody() string {
 	if x != nil {
-		return x.Token
+		return x.Body
 	}
 	return ""
 }
 
-type PaymentMethodResponse struct {
+type ListUserNotesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PaymentMethod *PaymentMethod         `protobuf:"bytes,1,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentMethodResponse) Reset() {
-	*x = PaymentMethodResponse{}
+func (x *ListUserNotesRequest) Reset() {
+	*x = ListUserNotesRequest{}
 	mi := &file_proto_user_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentMethodResponse) String() string {
+func (x *ListUserNotesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentMethodResponse) ProtoMessage() {}
+func (*ListUserNotesRequest) ProtoMessage() {}
 
-func (x *PaymentMethodResponse) ProtoReflect() protoreflect.Message {
+func (x *ListUserNotesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_user_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1918,39 +1889,39 @@ func (x *PaymentMethodResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentMethodResponse.ProtoReflect.Descriptor instead.
-func (*PaymentMethodResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserNotesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserNotesRequest) Descriptor() ([]byte, []int) {
 	return file_proto_user_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *PaymentMethodResponse) GetPaymentMethod() *PaymentMethod {
+func (x *ListUserNotesRequest) GetUserId() string {
 	if x != nil {
-		return x.PaymentMethod
+		return x.UserId
 	}
-	return nil
+	return ""
 }
 
-type GetPaymentMethodsRequest struct {
+type ListUserNotesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Notes         []*UserNote            `protobuf:"bytes,1,rep,name=notes,proto3" json:"notes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetPaymentMethodsRequest) Reset() {
-	*x = GetPaymentMethodsRequest{}
+func (x *ListUserNotesResponse) Reset() {
+	*x = ListUserNotesResponse{}
 	mi := &file_proto_user_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetPaymentMethodsRequest) String() string {
+func (x *ListUserNotesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPaymentMethodsRequest) ProtoMessage() {}
+func (*ListUserNotesResponse) ProtoMessage() {}
 
-func (x *GetPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListUserNotesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_user_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1962,39 +1933,42 @@ func (x *GetPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPaymentMethodsRequest.ProtoReflect.Descriptor instead.
-func (*GetPaymentMethodsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserNotesResponse.ProtoReflect.Descriptor instead.
+func (*ListUserNotesResponse) Descriptor() ([]byte, []int) {
 	return file_proto_user_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetPaymentMethodsRequest) GetUserId() string {
+func (x *ListUserNotesResponse) GetNotes() []*UserNote {
 	if x != nil {
-		return x.UserId
+		return x.Notes
 	}
-	return ""
+	return nil
 }
 
-type PaymentMethodListResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	PaymentMethods []*PaymentMethod       `protobuf:"bytes,1,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+type UpdateUserNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"`       // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // UUID string
+	AuthorId      string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"` // UUID string of the staff member editing
+	Body          string                 `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PaymentMethodListResponse) Reset() {
-	*x = PaymentMethodListResponse{}
+func (x *UpdateUserNoteRequest) Reset() {
+	*x = UpdateUserNoteRequest{}
 	mi := &file_proto_user_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PaymentMethodListResponse) String() string {
+func (x *UpdateUserNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentMethodListResponse) ProtoMessage() {}
+func (*UpdateUserNoteRequest) ProtoMessage() {}
 
-func (x *PaymentMethodListResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateUserNoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_user_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2006,43 +1980,61 @@ func (x *PaymentMethodListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentMethodListResponse.ProtoReflect.Descriptor instead.
-func (*PaymentMethodListResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateUserNoteRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserNoteRequest) Descriptor() ([]byte, []int) {
 	return file_proto_user_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *PaymentMethodListResponse) GetPaymentMethods() []*PaymentMethod {
+func (x *UpdateUserNoteRequest) GetNoteId() string {
 	if x != nil {
-		return x.PaymentMethods
+		return x.NoteId
 	}
-	return nil
+	return ""
 }
 
-type UpdatePaymentMethodRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	PaymentMethodId string                 `protobuf:"bytes,1,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"` // UUID string
-	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // UUID string
-	ExpirationMonth int32                  `protobuf:"varint,3,opt,name=expiration_month,json=expirationMonth,proto3" json:"expiration_month,omitempty"`
-	ExpirationYear  int32                  `protobuf:"varint,4,opt,name=expiration_year,json=expirationYear,proto3" json:"expiration_year,omitempty"`
-	IsDefault       bool                   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *UpdateUserNoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *UpdatePaymentMethodRequest) Reset() {
-	*x = UpdatePaymentMethodRequest{}
+func (x *UpdateUserNoteRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *UpdateUserNoteRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type DeleteUserNoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NoteId        string                 `protobuf:"bytes,1,opt,name=note_id,json=noteId,proto3" json:"note_id,omitempty"` // UUID string
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserNoteRequest) Reset() {
+	*x = DeleteUserNoteRequest{}
 	mi := &file_proto_user_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePaymentMethodRequest) String() string {
+func (x *DeleteUserNoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdatePaymentMethodRequest) ProtoMessage() {}
+func (*DeleteUserNoteRequest) ProtoMessage() {}
 
-func (x *UpdatePaymentMethodRequest) ProtoReflect() protoreflect.Message {
+func (x *DeleteUserNoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_user_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2054,69 +2046,6500 @@ func (x *UpdatePaymentMethodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdatePaymentMethodRequest.ProtoReflect.Descriptor instead.
-func (*UpdatePaymentMethodRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteUserNoteRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserNoteRequest) Descriptor() ([]byte, []int) {
 	return file_proto_user_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *UpdatePaymentMethodRequest) GetPaymentMethodId() string {
+func (x *DeleteUserNoteRequest) GetNoteId() string {
 	if x != nil {
-		return x.PaymentMethodId
+		return x.NoteId
 	}
 	return ""
 }
 
-func (x *UpdatePaymentMethodRequest) GetUserId() string {
+func (x *DeleteUserNoteRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdatePaymentMethodRequest) GetExpirationMonth() int32 {
-	if x != nil {
-		return x.ExpirationMonth
-	}
-	return 0
+type UserNoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Note          *UserNote              `protobuf:"bytes,1,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdatePaymentMethodRequest) GetExpirationYear() int32 {
-	if x != nil {
-		return x.ExpirationYear
-	}
-	return 0
+func (x *UserNoteResponse) Reset() {
+	*x = UserNoteResponse{}
+	mi := &file_proto_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdatePaymentMethodRequest) GetIsDefault() bool {
-	if x != nil {
-		return x.IsDefault
-	}
-	return false
+func (x *UserNoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type DeletePaymentMethodRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
+func (*UserNoteResponse) ProtoMessage() {}
+
+func (x *UserNoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserNoteResponse.ProtoReflect.Descriptor instead.
+func (*UserNoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UserNoteResponse) GetNote() *UserNote {
+	if x != nil {
+		return x.Note
+	}
+	return nil
+}
+
+// Shopper list messages. Lists are "cart", "wishlist" or "recently_viewed"
+// and belong to a user, by user ID, or to a guest, by guest session ID.
+type ShopperListItem struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	ProductId       string                  `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId       string                  `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"` // Empty for products without variants
+	Quantity        int32                   `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`                   // Always 1 outside carts
+	AddedAt         string                  `protobuf:"bytes,4,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`       // RFC3339 formatted timestamp
+	UpdatedAt       string                  `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 formatted timestamp
+	Personalization []*PersonalizationValue `protobuf:"bytes,6,rep,name=personalization,proto3" json:"personalization,omitempty"`      // Cart items only
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ShopperListItem) Reset() {
+	*x = ShopperListItem{}
+	mi := &file_proto_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShopperListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShopperListItem) ProtoMessage() {}
+
+func (x *ShopperListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShopperListItem.ProtoReflect.Descriptor instead.
+func (*ShopperListItem) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ShopperListItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ShopperListItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *ShopperListItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ShopperListItem) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+func (x *ShopperListItem) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ShopperListItem) GetPersonalization() []*PersonalizationValue {
+	if x != nil {
+		return x.Personalization
+	}
+	return nil
+}
+
+// Input of a buyer for a personalization option of a product, such as an
+// engraving text or uploaded artwork, as validated by the product service
+type PersonalizationValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OptionId      string                 `protobuf:"bytes,1,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	FileUrl       string                 `protobuf:"bytes,4,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	FileName      string                 `protobuf:"bytes,5,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PersonalizationValue) Reset() {
+	*x = PersonalizationValue{}
+	mi := &file_proto_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PersonalizationValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PersonalizationValue) ProtoMessage() {}
+
+func (x *PersonalizationValue) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PersonalizationValue.ProtoReflect.Descriptor instead.
+func (*PersonalizationValue) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PersonalizationValue) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *PersonalizationValue) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+type GetShopperListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	List          string                 `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShopperListRequest) Reset() {
+	*x = GetShopperListRequest{}
+	mi := &file_proto_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShopperListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShopperListRequest) ProtoMessage() {}
+
+func (x *GetShopperListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShopperListRequest.ProtoReflect.Descriptor instead.
+func (*GetShopperListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetShopperListRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *GetShopperListRequest) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+type SetShopperListItemRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	OwnerId         string                  `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	List            string                  `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	ProductId       string                  `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId       string                  `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity        int32                   `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`              // Cart quantity; 0 removes the item
+	Personalization []*PersonalizationValue `protobuf:"bytes,6,rep,name=personalization,proto3" json:"personalization,omitempty"` // Replaces that of the cart item
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetShopperListItemRequest) Reset() {
+	*x = SetShopperListItemRequest{}
+	mi := &file_proto_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetShopperListItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShopperListItemRequest) ProtoMessage() {}
+
+func (x *SetShopperListItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShopperListItemRequest.ProtoReflect.Descriptor instead.
+func (*SetShopperListItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SetShopperListItemRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *SetShopperListItemRequest) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *SetShopperListItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SetShopperListItemRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *SetShopperListItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SetShopperListItemRequest) GetPersonalization() []*PersonalizationValue {
+	if x != nil {
+		return x.Personalization
+	}
+	return nil
+}
+
+type RemoveShopperListItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       string                 `protobuf:"bytes,1,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	List          string                 `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	ProductId     string                 `protobuf:"bytes,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveShopperListItemRequest) Reset() {
+	*x = RemoveShopperListItemRequest{}
+	mi := &file_proto_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveShopperListItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveShopperListItemRequest) ProtoMessage() {}
+
+func (x *RemoveShopperListItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveShopperListItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveShopperListItemRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RemoveShopperListItemRequest) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *RemoveShopperListItemRequest) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *RemoveShopperListItemRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RemoveShopperListItemRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type ShopperListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          string                 `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	Items         []*ShopperListItem     `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShopperListResponse) Reset() {
+	*x = ShopperListResponse{}
+	mi := &file_proto_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShopperListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShopperListResponse) ProtoMessage() {}
+
+func (x *ShopperListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShopperListResponse.ProtoReflect.Descriptor instead.
+func (*ShopperListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ShopperListResponse) GetList() string {
+	if x != nil {
+		return x.List
+	}
+	return ""
+}
+
+func (x *ShopperListResponse) GetItems() []*ShopperListItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type MergeGuestDataRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GuestId       string                 `protobuf:"bytes,1,opt,name=guest_id,json=guestId,proto3" json:"guest_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeGuestDataRequest) Reset() {
+	*x = MergeGuestDataRequest{}
+	mi := &file_proto_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGuestDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestDataRequest) ProtoMessage() {}
+
+func (x *MergeGuestDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestDataRequest.ProtoReflect.Descriptor instead.
+func (*MergeGuestDataRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *MergeGuestDataRequest) GetGuestId() string {
+	if x != nil {
+		return x.GuestId
+	}
+	return ""
+}
+
+func (x *MergeGuestDataRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Number of guest items moved into each list of the user
+type MergeGuestDataResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CartItems           int32                  `protobuf:"varint,1,opt,name=cart_items,json=cartItems,proto3" json:"cart_items,omitempty"`
+	WishlistItems       int32                  `protobuf:"varint,2,opt,name=wishlist_items,json=wishlistItems,proto3" json:"wishlist_items,omitempty"`
+	RecentlyViewedItems int32                  `protobuf:"varint,3,opt,name=recently_viewed_items,json=recentlyViewedItems,proto3" json:"recently_viewed_items,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MergeGuestDataResponse) Reset() {
+	*x = MergeGuestDataResponse{}
+	mi := &file_proto_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeGuestDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeGuestDataResponse) ProtoMessage() {}
+
+func (x *MergeGuestDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeGuestDataResponse.ProtoReflect.Descriptor instead.
+func (*MergeGuestDataResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MergeGuestDataResponse) GetCartItems() int32 {
+	if x != nil {
+		return x.CartItems
+	}
+	return 0
+}
+
+func (x *MergeGuestDataResponse) GetWishlistItems() int32 {
+	if x != nil {
+		return x.WishlistItems
+	}
+	return 0
+}
+
+func (x *MergeGuestDataResponse) GetRecentlyViewedItems() int32 {
+	if x != nil {
+		return x.RecentlyViewedItems
+	}
+	return 0
+}
+
+// Abandoned cart messages. A reminder is recorded once for each period of
+// inactivity of the cart of a user accepting notification emails.
+type CartReminderEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                      // Increasing; consumers resume after the last ID read
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName     string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	ItemCount     int32                  `protobuf:"varint,5,opt,name=item_count,json=itemCount,proto3" json:"item_count,omitempty"`
+	Items         []*ShopperListItem     `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`                                        // The cart when it was reminded
+	CartUpdatedAt string                 `protobuf:"bytes,7,opt,name=cart_updated_at,json=cartUpdatedAt,proto3" json:"cart_updated_at,omitempty"` // RFC3339 formatted timestamp of the last cart change
+	RemindedAt    string                 `protobuf:"bytes,8,opt,name=reminded_at,json=remindedAt,proto3" json:"reminded_at,omitempty"`            // RFC3339 formatted timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartReminderEvent) Reset() {
+	*x = CartReminderEvent{}
+	mi := &file_proto_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartReminderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartReminderEvent) ProtoMessage() {}
+
+func (x *CartReminderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartReminderEvent.ProtoReflect.Descriptor instead.
+func (*CartReminderEvent) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CartReminderEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CartReminderEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CartReminderEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CartReminderEvent) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CartReminderEvent) GetItemCount() int32 {
+	if x != nil {
+		return x.ItemCount
+	}
+	return 0
+}
+
+func (x *CartReminderEvent) GetItems() []*ShopperListItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CartReminderEvent) GetCartUpdatedAt() string {
+	if x != nil {
+		return x.CartUpdatedAt
+	}
+	return ""
+}
+
+func (x *CartReminderEvent) GetRemindedAt() string {
+	if x != nil {
+		return x.RemindedAt
+	}
+	return ""
+}
+
+type ListCartReminderEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterId       int64                  `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Default 100, at most 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCartReminderEventsRequest) Reset() {
+	*x = ListCartReminderEventsRequest{}
+	mi := &file_proto_user_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCartReminderEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartReminderEventsRequest) ProtoMessage() {}
+
+func (x *ListCartReminderEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartReminderEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListCartReminderEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListCartReminderEventsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListCartReminderEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCartReminderEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*CartReminderEvent   `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCartReminderEventsResponse) Reset() {
+	*x = ListCartReminderEventsResponse{}
+	mi := &file_proto_user_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCartReminderEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartReminderEventsResponse) ProtoMessage() {}
+
+func (x *ListCartReminderEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartReminderEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListCartReminderEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListCartReminderEventsResponse) GetEvents() []*CartReminderEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type RecordCartConversionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	OrderReference string                 `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecordCartConversionRequest) Reset() {
+	*x = RecordCartConversionRequest{}
+	mi := &file_proto_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordCartConversionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCartConversionRequest) ProtoMessage() {}
+
+func (x *RecordCartConversionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordCartConversionRequest.ProtoReflect.Descriptor instead.
+func (*RecordCartConversionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RecordCartConversionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordCartConversionRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+// converted is false when the user was not reminded of their cart shortly
+// before the order
+type RecordCartConversionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Converted     bool                   `protobuf:"varint,1,opt,name=converted,proto3" json:"converted,omitempty"`
+	ReminderId    int64                  `protobuf:"varint,2,opt,name=reminder_id,json=reminderId,proto3" json:"reminder_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordCartConversionResponse) Reset() {
+	*x = RecordCartConversionResponse{}
+	mi := &file_proto_user_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordCartConversionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCartConversionResponse) ProtoMessage() {}
+
+func (x *RecordCartConversionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordCartConversionResponse.ProtoReflect.Descriptor instead.
+func (*RecordCartConversionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *RecordCartConversionResponse) GetConverted() bool {
+	if x != nil {
+		return x.Converted
+	}
+	return false
+}
+
+func (x *RecordCartConversionResponse) GetReminderId() int64 {
+	if x != nil {
+		return x.ReminderId
+	}
+	return 0
+}
+
+type GetCartReminderStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          string                 `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"` // RFC3339; defaults to 30 days ago
+	To            string                 `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`     // RFC3339; defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCartReminderStatsRequest) Reset() {
+	*x = GetCartReminderStatsRequest{}
+	mi := &file_proto_user_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCartReminderStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCartReminderStatsRequest) ProtoMessage() {}
+
+func (x *GetCartReminderStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCartReminderStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCartReminderStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetCartReminderStatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetCartReminderStatsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type CartReminderStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Reminded       int64                  `protobuf:"varint,1,opt,name=reminded,proto3" json:"reminded,omitempty"`
+	Converted      int64                  `protobuf:"varint,2,opt,name=converted,proto3" json:"converted,omitempty"`
+	ConversionRate float64                `protobuf:"fixed64,3,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"` // converted / reminded, 0 without reminders
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CartReminderStatsResponse) Reset() {
+	*x = CartReminderStatsResponse{}
+	mi := &file_proto_user_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartReminderStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartReminderStatsResponse) ProtoMessage() {}
+
+func (x *CartReminderStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartReminderStatsResponse.ProtoReflect.Descriptor instead.
+func (*CartReminderStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CartReminderStatsResponse) GetReminded() int64 {
+	if x != nil {
+		return x.Reminded
+	}
+	return 0
+}
+
+func (x *CartReminderStatsResponse) GetConverted() int64 {
+	if x != nil {
+		return x.Converted
+	}
+	return 0
+}
+
+func (x *CartReminderStatsResponse) GetConversionRate() float64 {
+	if x != nil {
+		return x.ConversionRate
+	}
+	return 0
+}
+
+// User event related messages
+type UserEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`                            // registered, logged_in, updated, deleted or permissions_changed
+	ActorId       string                 `protobuf:"bytes,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`       // Set when another user, e.g. an admin, acted on the account
+	CreatedAt     string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 formatted timestamp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserEvent) Reset() {
+	*x = UserEvent{}
+	mi := &file_proto_user_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserEvent) ProtoMessage() {}
+
+func (x *UserEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserEvent.ProtoReflect.Descriptor instead.
+func (*UserEvent) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *UserEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UserEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *UserEvent) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+func (x *UserEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListUserEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BeforeId      int64                  `protobuf:"varint,1,opt,name=before_id,json=beforeId,proto3" json:"before_id,omitempty"` // Only return events older than this one; 0 for the newest
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserEventsRequest) Reset() {
+	*x = ListUserEventsRequest{}
+	mi := &file_proto_user_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserEventsRequest) ProtoMessage() {}
+
+func (x *ListUserEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListUserEventsRequest) GetBeforeId() int64 {
+	if x != nil {
+		return x.BeforeId
+	}
+	return 0
+}
+
+func (x *ListUserEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListUserEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*UserEvent           `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserEventsResponse) Reset() {
+	*x = ListUserEventsResponse{}
+	mi := &file_proto_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserEventsResponse) ProtoMessage() {}
+
+func (x *ListUserEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListUserEventsResponse) GetEvents() []*UserEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Staff related messages
+type CreateStaffRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Email          string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Username       string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password       string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	FirstName      string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName       string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	PermissionSets []string               `protobuf:"bytes,6,rep,name=permission_sets,json=permissionSets,proto3" json:"permission_sets,omitempty"` // catalog, inventory, orders or users
+	ActorId        string                 `protobuf:"bytes,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`                      // UUID of the administrator creating the account
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateStaffRequest) Reset() {
+	*x = CreateStaffRequest{}
+	mi := &file_proto_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateStaffRequest) ProtoMessage() {}
+
+func (x *CreateStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateStaffRequest.ProtoReflect.Descriptor instead.
+func (*CreateStaffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateStaffRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateStaffRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateStaffRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateStaffRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CreateStaffRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CreateStaffRequest) GetPermissionSets() []string {
+	if x != nil {
+		return x.PermissionSets
+	}
+	return nil
+}
+
+func (x *CreateStaffRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+// Staff cannot change their own permissions; only administrators assign them
+type SetStaffPermissionsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                         // UUID string
+	PermissionSets []string               `protobuf:"bytes,2,rep,name=permission_sets,json=permissionSets,proto3" json:"permission_sets,omitempty"` // Replace the current sets
+	ActorId        string                 `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`                      // UUID of the administrator making the change
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetStaffPermissionsRequest) Reset() {
+	*x = SetStaffPermissionsRequest{}
+	mi := &file_proto_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetStaffPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetStaffPermissionsRequest) ProtoMessage() {}
+
+func (x *SetStaffPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetStaffPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*SetStaffPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SetStaffPermissionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetStaffPermissionsRequest) GetPermissionSets() []string {
+	if x != nil {
+		return x.PermissionSets
+	}
+	return nil
+}
+
+func (x *SetStaffPermissionsRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ListStaffRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffRequest) Reset() {
+	*x = ListStaffRequest{}
+	mi := &file_proto_user_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffRequest) ProtoMessage() {}
+
+func (x *ListStaffRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffRequest.ProtoReflect.Descriptor instead.
+func (*ListStaffRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{50}
+}
+
+type ListStaffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Staff         []*User                `protobuf:"bytes,1,rep,name=staff,proto3" json:"staff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStaffResponse) Reset() {
+	*x = ListStaffResponse{}
+	mi := &file_proto_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStaffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStaffResponse) ProtoMessage() {}
+
+func (x *ListStaffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStaffResponse.ProtoReflect.Descriptor instead.
+func (*ListStaffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListStaffResponse) GetStaff() []*User {
+	if x != nil {
+		return x.Staff
+	}
+	return nil
+}
+
+type PermissionSet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"` // Granted to the access tokens of staff holding the set
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionSet) Reset() {
+	*x = PermissionSet{}
+	mi := &file_proto_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionSet) ProtoMessage() {}
+
+func (x *PermissionSet) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionSet.ProtoReflect.Descriptor instead.
+func (*PermissionSet) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *PermissionSet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PermissionSet) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PermissionSet) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+type ListPermissionSetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPermissionSetsRequest) Reset() {
+	*x = ListPermissionSetsRequest{}
+	mi := &file_proto_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionSetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionSetsRequest) ProtoMessage() {}
+
+func (x *ListPermissionSetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPermissionSetsRequest.ProtoReflect.Descriptor instead.
+func (*ListPermissionSetsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{53}
+}
+
+type ListPermissionSetsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PermissionSets []*PermissionSet       `protobuf:"bytes,1,rep,name=permission_sets,json=permissionSets,proto3" json:"permission_sets,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ListPermissionSetsResponse) Reset() {
+	*x = ListPermissionSetsResponse{}
+	mi := &file_proto_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPermissionSetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPermissionSetsResponse) ProtoMessage() {}
+
+func (x *ListPermissionSetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPermissionSetsResponse.ProtoReflect.Descriptor instead.
+func (*ListPermissionSetsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListPermissionSetsResponse) GetPermissionSets() []*PermissionSet {
+	if x != nil {
+		return x.PermissionSets
+	}
+	return nil
+}
+
+// Invitation related messages
+type ImportUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Csv           []byte                 `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`                        // Header with email, name (or first_name and last_name) and role columns
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // UUID of the administrator importing
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersRequest) Reset() {
+	*x = ImportUsersRequest{}
+	mi := &file_proto_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersRequest) ProtoMessage() {}
+
+func (x *ImportUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersRequest.ProtoReflect.Descriptor instead.
+func (*ImportUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ImportUsersRequest) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+func (x *ImportUsersRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type ImportRowResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Row           int32                  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"` // From 1, after the header
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`               // invited or failed
+	Error         string                 `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`                 // Why the row failed, or that its email was not sent
+	UserId        string                 `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Set when invited
+	EmailSent     bool                   `protobuf:"varint,6,opt,name=email_sent,json=emailSent,proto3" json:"email_sent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowResult) Reset() {
+	*x = ImportRowResult{}
+	mi := &file_proto_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowResult) ProtoMessage() {}
+
+func (x *ImportRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowResult.ProtoReflect.Descriptor instead.
+func (*ImportRowResult) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ImportRowResult) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowResult) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ImportRowResult) GetEmailSent() bool {
+	if x != nil {
+		return x.EmailSent
+	}
+	return false
+}
+
+type ImportUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rows          []*ImportRowResult     `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	Invited       int32                  `protobuf:"varint,2,opt,name=invited,proto3" json:"invited,omitempty"`
+	Failed        int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportUsersResponse) Reset() {
+	*x = ImportUsersResponse{}
+	mi := &file_proto_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportUsersResponse) ProtoMessage() {}
+
+func (x *ImportUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportUsersResponse.ProtoReflect.Descriptor instead.
+func (*ImportUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ImportUsersResponse) GetRows() []*ImportRowResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportUsersResponse) GetInvited() int32 {
+	if x != nil {
+		return x.Invited
+	}
+	return 0
+}
+
+func (x *ImportUsersResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+type ResendInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // UUID string
+	ActorId       string                 `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"` // UUID of the administrator resending
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendInvitationRequest) Reset() {
+	*x = ResendInvitationRequest{}
+	mi := &file_proto_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendInvitationRequest) ProtoMessage() {}
+
+func (x *ResendInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendInvitationRequest.ProtoReflect.Descriptor instead.
+func (*ResendInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ResendInvitationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResendInvitationRequest) GetActorId() string {
+	if x != nil {
+		return x.ActorId
+	}
+	return ""
+}
+
+type InvitationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	SendCount     int32                  `protobuf:"varint,3,opt,name=send_count,json=sendCount,proto3" json:"send_count,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvitationResponse) Reset() {
+	*x = InvitationResponse{}
+	mi := &file_proto_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationResponse) ProtoMessage() {}
+
+func (x *InvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationResponse.ProtoReflect.Descriptor instead.
+func (*InvitationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *InvitationResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InvitationResponse) GetSendCount() int32 {
+	if x != nil {
+		return x.SendCount
+	}
+	return 0
+}
+
+func (x *InvitationResponse) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type AcceptInvitationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // From the invitation link
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptInvitationRequest) Reset() {
+	*x = AcceptInvitationRequest{}
+	mi := &file_proto_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptInvitationRequest) ProtoMessage() {}
+
+func (x *AcceptInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptInvitationRequest.ProtoReflect.Descriptor instead.
+func (*AcceptInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *AcceptInvitationRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AcceptInvitationRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// User stats related messages
+type UserStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TotalOrders       int64                  `protobuf:"varint,2,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"`
+	TotalSpend        float64                `protobuf:"fixed64,3,opt,name=total_spend,json=totalSpend,proto3" json:"total_spend,omitempty"`
+	AverageOrderValue float64                `protobuf:"fixed64,4,opt,name=average_order_value,json=averageOrderValue,proto3" json:"average_order_value,omitempty"` // total_spend / total_orders, 0 without orders
+	LastOrderAt       string                 `protobuf:"bytes,5,opt,name=last_order_at,json=lastOrderAt,proto3" json:"last_order_at,omitempty"`                     // RFC3339; empty without orders
+	UpdatedAt         string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                             // RFC3339; empty without orders
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UserStats) Reset() {
+	*x = UserStats{}
+	mi := &file_proto_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStats) ProtoMessage() {}
+
+func (x *UserStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
+func (*UserStats) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UserStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserStats) GetTotalOrders() int64 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+func (x *UserStats) GetTotalSpend() float64 {
+	if x != nil {
+		return x.TotalSpend
+	}
+	return 0
+}
+
+func (x *UserStats) GetAverageOrderValue() float64 {
+	if x != nil {
+		return x.AverageOrderValue
+	}
+	return 0
+}
+
+func (x *UserStats) GetLastOrderAt() string {
+	if x != nil {
+		return x.LastOrderAt
+	}
+	return ""
+}
+
+func (x *UserStats) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type UserStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         *UserStats             `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserStatsResponse) Reset() {
+	*x = UserStatsResponse{}
+	mi := &file_proto_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStatsResponse) ProtoMessage() {}
+
+func (x *UserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStatsResponse.ProtoReflect.Descriptor instead.
+func (*UserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *UserStatsResponse) GetStats() *UserStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// Placed orders count towards the metrics until cancelled; refunds lower the
+// spend by their amount
+type RecordOrderEventRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	OrderReference string                 `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	Type           string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                               // placed, cancelled or refunded
+	Amount         float64                `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`                         // Order total, or refunded amount
+	OccurredAt     string                 `protobuf:"bytes,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // RFC3339; defaults to now
+	EventId        string                 `protobuf:"bytes,6,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`          // Idempotency key; defaults to the order reference and type
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RecordOrderEventRequest) Reset() {
+	*x = RecordOrderEventRequest{}
+	mi := &file_proto_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordOrderEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordOrderEventRequest) ProtoMessage() {}
+
+func (x *RecordOrderEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordOrderEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordOrderEventRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *RecordOrderEventRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RecordOrderEventRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *RecordOrderEventRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *RecordOrderEventRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecordOrderEventRequest) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *RecordOrderEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+type GetUserStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserStatsRequest) Reset() {
+	*x = GetUserStatsRequest{}
+	mi := &file_proto_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserStatsRequest) ProtoMessage() {}
+
+func (x *GetUserStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetUserStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetUserStatsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Filters select customers for a segment; zero values do not filter
+type ListUserStatsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MinOrders       int64                  `protobuf:"varint,1,opt,name=min_orders,json=minOrders,proto3" json:"min_orders,omitempty"`
+	MinSpend        float64                `protobuf:"fixed64,2,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"`
+	LastOrderAfter  string                 `protobuf:"bytes,3,opt,name=last_order_after,json=lastOrderAfter,proto3" json:"last_order_after,omitempty"`    // RFC3339
+	LastOrderBefore string                 `protobuf:"bytes,4,opt,name=last_order_before,json=lastOrderBefore,proto3" json:"last_order_before,omitempty"` // RFC3339
+	Page            int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	Limit           int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListUserStatsRequest) Reset() {
+	*x = ListUserStatsRequest{}
+	mi := &file_proto_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserStatsRequest) ProtoMessage() {}
+
+func (x *ListUserStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserStatsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListUserStatsRequest) GetMinOrders() int64 {
+	if x != nil {
+		return x.MinOrders
+	}
+	return 0
+}
+
+func (x *ListUserStatsRequest) GetMinSpend() float64 {
+	if x != nil {
+		return x.MinSpend
+	}
+	return 0
+}
+
+func (x *ListUserStatsRequest) GetLastOrderAfter() string {
+	if x != nil {
+		return x.LastOrderAfter
+	}
+	return ""
+}
+
+func (x *ListUserStatsRequest) GetLastOrderBefore() string {
+	if x != nil {
+		return x.LastOrderBefore
+	}
+	return ""
+}
+
+func (x *ListUserStatsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListUserStatsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListUserStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*UserStats           `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserStatsResponse) Reset() {
+	*x = ListUserStatsResponse{}
+	mi := &file_proto_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserStatsResponse) ProtoMessage() {}
+
+func (x *ListUserStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserStatsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListUserStatsResponse) GetStats() []*UserStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *ListUserStatsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Company messages
+type CompanyMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	FirstName     string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Role          string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`                      // purchaser or approver
+	AddedAt       string                 `protobuf:"bytes,7,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanyMember) Reset() {
+	*x = CompanyMember{}
+	mi := &file_proto_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyMember) ProtoMessage() {}
+
+func (x *CompanyMember) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyMember.ProtoReflect.Descriptor instead.
+func (*CompanyMember) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *CompanyMember) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CompanyMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CompanyMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CompanyMember) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *CompanyMember) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *CompanyMember) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CompanyMember) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
+	}
+	return ""
+}
+
+type CompanyAddress struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AddressId      string                 `protobuf:"bytes,1,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	CompanyId      string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Label          string                 `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	AddressType    string                 `protobuf:"bytes,4,opt,name=address_type,json=addressType,proto3" json:"address_type,omitempty"` // shipping or billing
+	StreetAddress1 string                 `protobuf:"bytes,5,opt,name=street_address1,json=streetAddress1,proto3" json:"street_address1,omitempty"`
+	StreetAddress2 string                 `protobuf:"bytes,6,opt,name=street_address2,json=streetAddress2,proto3" json:"street_address2,omitempty"`
+	City           string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
+	State          string                 `protobuf:"bytes,8,opt,name=state,proto3" json:"state,omitempty"`
+	PostalCode     string                 `protobuf:"bytes,9,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Country        string                 `protobuf:"bytes,10,opt,name=country,proto3" json:"country,omitempty"`
+	IsDefault      bool                   `protobuf:"varint,11,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompanyAddress) Reset() {
+	*x = CompanyAddress{}
+	mi := &file_proto_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyAddress) ProtoMessage() {}
+
+func (x *CompanyAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyAddress.ProtoReflect.Descriptor instead.
+func (*CompanyAddress) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *CompanyAddress) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+func (x *CompanyAddress) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CompanyAddress) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CompanyAddress) GetAddressType() string {
+	if x != nil {
+		return x.AddressType
+	}
+	return ""
+}
+
+func (x *CompanyAddress) GetStreetAddress1() string {
+	if x != nil {
+		return x.StreetAddress1
+	}
+	return ""
+}
+
+func (x *CompanyAddress) GetStreetAddress2() string {
+	if x != nil {
+		return x.StreetAddress2
+	}
+	return ""
+}
+
+func (x *CompanyAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CompanyAddress) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CompanyAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *CompanyAddress) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CompanyAddress) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *CompanyAddress) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type Company struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId         string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PaymentTerms      string                 `protobuf:"bytes,3,opt,name=payment_terms,json=paymentTerms,proto3" json:"payment_terms,omitempty"`              // prepaid, net_15, net_30 or net_60
+	RequiresApproval  bool                   `protobuf:"varint,4,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"` // Whether purchases above approval_threshold need an approver
+	ApprovalThreshold float64                `protobuf:"fixed64,5,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty"`
+	CreatedAt         string                 `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	UpdatedAt         string                 `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+	Members           []*CompanyMember       `protobuf:"bytes,8,rep,name=members,proto3" json:"members,omitempty"`
+	Addresses         []*CompanyAddress      `protobuf:"bytes,9,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Company) Reset() {
+	*x = Company{}
+	mi := &file_proto_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Company) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Company) ProtoMessage() {}
+
+func (x *Company) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Company.ProtoReflect.Descriptor instead.
+func (*Company) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Company) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *Company) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Company) GetPaymentTerms() string {
+	if x != nil {
+		return x.PaymentTerms
+	}
+	return ""
+}
+
+func (x *Company) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *Company) GetApprovalThreshold() float64 {
+	if x != nil {
+		return x.ApprovalThreshold
+	}
+	return 0
+}
+
+func (x *Company) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Company) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *Company) GetMembers() []*CompanyMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Company) GetAddresses() []*CompanyAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type CompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	Membership    *CompanyMember         `protobuf:"bytes,2,opt,name=membership,proto3" json:"membership,omitempty"` // Set by GetUserCompany
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanyResponse) Reset() {
+	*x = CompanyResponse{}
+	mi := &file_proto_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyResponse) ProtoMessage() {}
+
+func (x *CompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyResponse.ProtoReflect.Descriptor instead.
+func (*CompanyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+func (x *CompanyResponse) GetMembership() *CompanyMember {
+	if x != nil {
+		return x.Membership
+	}
+	return nil
+}
+
+type CreateCompanyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PaymentTerms      string                 `protobuf:"bytes,2,opt,name=payment_terms,json=paymentTerms,proto3" json:"payment_terms,omitempty"` // Defaults to prepaid
+	RequiresApproval  bool                   `protobuf:"varint,3,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	ApprovalThreshold float64                `protobuf:"fixed64,4,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_proto_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyRequest) ProtoMessage() {}
+
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetPaymentTerms() string {
+	if x != nil {
+		return x.PaymentTerms
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *CreateCompanyRequest) GetApprovalThreshold() float64 {
+	if x != nil {
+		return x.ApprovalThreshold
+	}
+	return 0
+}
+
+type UpdateCompanyRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId         string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Name              string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PaymentTerms      string                 `protobuf:"bytes,3,opt,name=payment_terms,json=paymentTerms,proto3" json:"payment_terms,omitempty"`
+	RequiresApproval  bool                   `protobuf:"varint,4,opt,name=requires_approval,json=requiresApproval,proto3" json:"requires_approval,omitempty"`
+	ApprovalThreshold float64                `protobuf:"fixed64,5,opt,name=approval_threshold,json=approvalThreshold,proto3" json:"approval_threshold,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyRequest) Reset() {
+	*x = UpdateCompanyRequest{}
+	mi := &file_proto_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdateCompanyRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetPaymentTerms() string {
+	if x != nil {
+		return x.PaymentTerms
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetRequiresApproval() bool {
+	if x != nil {
+		return x.RequiresApproval
+	}
+	return false
+}
+
+func (x *UpdateCompanyRequest) GetApprovalThreshold() float64 {
+	if x != nil {
+		return x.ApprovalThreshold
+	}
+	return 0
+}
+
+type GetCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	mi := &file_proto_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyRequest) ProtoMessage() {}
+
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetCompanyRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+type ListCompaniesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesRequest) Reset() {
+	*x = ListCompaniesRequest{}
+	mi := &file_proto_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesRequest) ProtoMessage() {}
+
+func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesRequest.ProtoReflect.Descriptor instead.
+func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListCompaniesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCompaniesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCompaniesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"` // Without members and addresses
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesResponse) Reset() {
+	*x = ListCompaniesResponse{}
+	mi := &file_proto_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesResponse) ProtoMessage() {}
+
+func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListCompaniesResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+func (x *ListCompaniesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type SetCompanyMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCompanyMemberRequest) Reset() {
+	*x = SetCompanyMemberRequest{}
+	mi := &file_proto_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCompanyMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCompanyMemberRequest) ProtoMessage() {}
+
+func (x *SetCompanyMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCompanyMemberRequest.ProtoReflect.Descriptor instead.
+func (*SetCompanyMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetCompanyMemberRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *SetCompanyMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetCompanyMemberRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type CompanyMemberResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *CompanyMember         `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanyMemberResponse) Reset() {
+	*x = CompanyMemberResponse{}
+	mi := &file_proto_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyMemberResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyMemberResponse) ProtoMessage() {}
+
+func (x *CompanyMemberResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyMemberResponse.ProtoReflect.Descriptor instead.
+func (*CompanyMemberResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *CompanyMemberResponse) GetMember() *CompanyMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type RemoveCompanyMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCompanyMemberRequest) Reset() {
+	*x = RemoveCompanyMemberRequest{}
+	mi := &file_proto_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCompanyMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCompanyMemberRequest) ProtoMessage() {}
+
+func (x *RemoveCompanyMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCompanyMemberRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyMemberRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *RemoveCompanyMemberRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *RemoveCompanyMemberRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AddCompanyAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *CompanyAddress        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddCompanyAddressRequest) Reset() {
+	*x = AddCompanyAddressRequest{}
+	mi := &file_proto_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddCompanyAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddCompanyAddressRequest) ProtoMessage() {}
+
+func (x *AddCompanyAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddCompanyAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddCompanyAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *AddCompanyAddressRequest) GetAddress() *CompanyAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type CompanyAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *CompanyAddress        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanyAddressResponse) Reset() {
+	*x = CompanyAddressResponse{}
+	mi := &file_proto_user_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanyAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanyAddressResponse) ProtoMessage() {}
+
+func (x *CompanyAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanyAddressResponse.ProtoReflect.Descriptor instead.
+func (*CompanyAddressResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CompanyAddressResponse) GetAddress() *CompanyAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type RemoveCompanyAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveCompanyAddressRequest) Reset() {
+	*x = RemoveCompanyAddressRequest{}
+	mi := &file_proto_user_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveCompanyAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveCompanyAddressRequest) ProtoMessage() {}
+
+func (x *RemoveCompanyAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveCompanyAddressRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyAddressRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RemoveCompanyAddressRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *RemoveCompanyAddressRequest) GetAddressId() string {
+	if x != nil {
+		return x.AddressId
+	}
+	return ""
+}
+
+type GetUserCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserCompanyRequest) Reset() {
+	*x = GetUserCompanyRequest{}
+	mi := &file_proto_user_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserCompanyRequest) ProtoMessage() {}
+
+func (x *GetUserCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetUserCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetUserCompanyRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PurchaseApproval struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId     string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	CompanyId      string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	RequesterId    string                 `protobuf:"bytes,3,opt,name=requester_id,json=requesterId,proto3" json:"requester_id,omitempty"`
+	OrderReference string                 `protobuf:"bytes,4,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	Amount         float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status         string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                        // pending, approved or rejected
+	DecidedBy      string                 `protobuf:"bytes,7,opt,name=decided_by,json=decidedBy,proto3" json:"decided_by,omitempty"` // Empty when pending or approved under the threshold
+	Notes          string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`  // RFC3339
+	DecidedAt      string                 `protobuf:"bytes,10,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"` // RFC3339; empty when pending
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PurchaseApproval) Reset() {
+	*x = PurchaseApproval{}
+	mi := &file_proto_user_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseApproval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseApproval) ProtoMessage() {}
+
+func (x *PurchaseApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseApproval.ProtoReflect.Descriptor instead.
+func (*PurchaseApproval) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *PurchaseApproval) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *PurchaseApproval) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *PurchaseApproval) GetRequesterId() string {
+	if x != nil {
+		return x.RequesterId
+	}
+	return ""
+}
+
+func (x *PurchaseApproval) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *PurchaseApproval) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PurchaseApproval) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PurchaseApproval) GetDecidedBy() string {
+	if x != nil {
+		return x.DecidedBy
+	}
+	return ""
+}
+
+func (x *PurchaseApproval) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *PurchaseApproval) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PurchaseApproval) GetDecidedAt() string {
+	if x != nil {
+		return x.DecidedAt
+	}
+	return ""
+}
+
+type PurchaseApprovalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approval      *PurchaseApproval      `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurchaseApprovalResponse) Reset() {
+	*x = PurchaseApprovalResponse{}
+	mi := &file_proto_user_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurchaseApprovalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseApprovalResponse) ProtoMessage() {}
+
+func (x *PurchaseApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseApprovalResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *PurchaseApprovalResponse) GetApproval() *PurchaseApproval {
+	if x != nil {
+		return x.Approval
+	}
+	return nil
+}
+
+// Asked at checkout by a company member; the order is placed once approved
+type RequestPurchaseApprovalRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderReference string                 `protobuf:"bytes,2,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	Amount         float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RequestPurchaseApprovalRequest) Reset() {
+	*x = RequestPurchaseApprovalRequest{}
+	mi := &file_proto_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPurchaseApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPurchaseApprovalRequest) ProtoMessage() {}
+
+func (x *RequestPurchaseApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPurchaseApprovalRequest.ProtoReflect.Descriptor instead.
+func (*RequestPurchaseApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RequestPurchaseApprovalRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequestPurchaseApprovalRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *RequestPurchaseApprovalRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type DecidePurchaseApprovalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApprovalId    string                 `protobuf:"bytes,1,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"`
+	ApproverId    string                 `protobuf:"bytes,2,opt,name=approver_id,json=approverId,proto3" json:"approver_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecidePurchaseApprovalRequest) Reset() {
+	*x = DecidePurchaseApprovalRequest{}
+	mi := &file_proto_user_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecidePurchaseApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecidePurchaseApprovalRequest) ProtoMessage() {}
+
+func (x *DecidePurchaseApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecidePurchaseApprovalRequest.ProtoReflect.Descriptor instead.
+func (*DecidePurchaseApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *DecidePurchaseApprovalRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+func (x *DecidePurchaseApprovalRequest) GetApproverId() string {
+	if x != nil {
+		return x.ApproverId
+	}
+	return ""
+}
+
+func (x *DecidePurchaseApprovalRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *DecidePurchaseApprovalRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// Lists the approvals of company_id, or those user_id sees as a member:
+// all of their company for approvers, their own for purchasers
+type ListPurchaseApprovalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // Empty for all
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPurchaseApprovalsRequest) Reset() {
+	*x = ListPurchaseApprovalsRequest{}
+	mi := &file_proto_user_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseApprovalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseApprovalsRequest) ProtoMessage() {}
+
+func (x *ListPurchaseApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListPurchaseApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ListPurchaseApprovalsRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *ListPurchaseApprovalsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListPurchaseApprovalsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPurchaseApprovalsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPurchaseApprovalsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPurchaseApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*PurchaseApproval    `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPurchaseApprovalsResponse) Reset() {
+	*x = ListPurchaseApprovalsResponse{}
+	mi := &file_proto_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPurchaseApprovalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPurchaseApprovalsResponse) ProtoMessage() {}
+
+func (x *ListPurchaseApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPurchaseApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListPurchaseApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListPurchaseApprovalsResponse) GetApprovals() []*PurchaseApproval {
+	if x != nil {
+		return x.Approvals
+	}
+	return nil
+}
+
+func (x *ListPurchaseApprovalsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// Quote messages. Quotes go from requested to quoted, then accepted or
+// declined by the buyer; accepted quotes are converted into an order. Quoted
+// and accepted quotes expire at their expiry.
+type QuoteItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     string                 `protobuf:"bytes,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"` // Empty for products without variants
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"` // Negotiated; set once quoted
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteItem) Reset() {
+	*x = QuoteItem{}
+	mi := &file_proto_user_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteItem) ProtoMessage() {}
+
+func (x *QuoteItem) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteItem.ProtoReflect.Descriptor instead.
+func (*QuoteItem) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *QuoteItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *QuoteItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *QuoteItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+type Quote struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId        string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CompanyId      string                 `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"` // Set for quotes of company members
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`                        // requested, quoted, accepted, declined, expired or converted
+	Items          []*QuoteItem           `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	BuyerNotes     string                 `protobuf:"bytes,6,opt,name=buyer_notes,json=buyerNotes,proto3" json:"buyer_notes,omitempty"`
+	AdminNotes     string                 `protobuf:"bytes,7,opt,name=admin_notes,json=adminNotes,proto3" json:"admin_notes,omitempty"`
+	Total          float64                `protobuf:"fixed64,8,opt,name=total,proto3" json:"total,omitempty"`                                        // Set once quoted
+	ExpiresAt      string                 `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                 // RFC3339; set once quoted
+	OrderReference string                 `protobuf:"bytes,10,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"` // Set once converted
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                // RFC3339
+	QuotedAt       string                 `protobuf:"bytes,12,opt,name=quoted_at,json=quotedAt,proto3" json:"quoted_at,omitempty"`                   // RFC3339
+	ConvertedAt    string                 `protobuf:"bytes,13,opt,name=converted_at,json=convertedAt,proto3" json:"converted_at,omitempty"`          // RFC3339
+	UpdatedAt      string                 `protobuf:"bytes,14,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                // RFC3339
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	mi := &file_proto_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *Quote) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *Quote) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Quote) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *Quote) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Quote) GetItems() []*QuoteItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Quote) GetBuyerNotes() string {
+	if x != nil {
+		return x.BuyerNotes
+	}
+	return ""
+}
+
+func (x *Quote) GetAdminNotes() string {
+	if x != nil {
+		return x.AdminNotes
+	}
+	return ""
+}
+
+func (x *Quote) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Quote) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *Quote) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *Quote) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Quote) GetQuotedAt() string {
+	if x != nil {
+		return x.QuotedAt
+	}
+	return ""
+}
+
+func (x *Quote) GetConvertedAt() string {
+	if x != nil {
+		return x.ConvertedAt
+	}
+	return ""
+}
+
+func (x *Quote) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type QuoteResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quote         *Quote                 `protobuf:"bytes,1,opt,name=quote,proto3" json:"quote,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteResponse) Reset() {
+	*x = QuoteResponse{}
+	mi := &file_proto_user_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteResponse) ProtoMessage() {}
+
+func (x *QuoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteResponse.ProtoReflect.Descriptor instead.
+func (*QuoteResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *QuoteResponse) GetQuote() *Quote {
+	if x != nil {
+		return x.Quote
+	}
+	return nil
+}
+
+// Requests a quote for the current cart of the buyer
+type RequestQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Notes         string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestQuoteRequest) Reset() {
+	*x = RequestQuoteRequest{}
+	mi := &file_proto_user_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestQuoteRequest) ProtoMessage() {}
+
+func (x *RequestQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestQuoteRequest.ProtoReflect.Descriptor instead.
+func (*RequestQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *RequestQuoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RequestQuoteRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+// Prices each item of the quote, matched by product and variant
+type RespondToQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId       string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	Prices        []*QuoteItem           `protobuf:"bytes,2,rep,name=prices,proto3" json:"prices,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC3339, in the future
+	Notes         string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RespondToQuoteRequest) Reset() {
+	*x = RespondToQuoteRequest{}
+	mi := &file_proto_user_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RespondToQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RespondToQuoteRequest) ProtoMessage() {}
+
+func (x *RespondToQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RespondToQuoteRequest.ProtoReflect.Descriptor instead.
+func (*RespondToQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *RespondToQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *RespondToQuoteRequest) GetPrices() []*QuoteItem {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *RespondToQuoteRequest) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *RespondToQuoteRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type QuoteActionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId       string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The buyer
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteActionRequest) Reset() {
+	*x = QuoteActionRequest{}
+	mi := &file_proto_user_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteActionRequest) ProtoMessage() {}
+
+func (x *QuoteActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteActionRequest.ProtoReflect.Descriptor instead.
+func (*QuoteActionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *QuoteActionRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *QuoteActionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ConvertQuoteRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId        string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The buyer
+	OrderReference string                 `protobuf:"bytes,3,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConvertQuoteRequest) Reset() {
+	*x = ConvertQuoteRequest{}
+	mi := &file_proto_user_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConvertQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertQuoteRequest) ProtoMessage() {}
+
+func (x *ConvertQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertQuoteRequest.ProtoReflect.Descriptor instead.
+func (*ConvertQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ConvertQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *ConvertQuoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ConvertQuoteRequest) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+type GetQuoteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	QuoteId       string                 `protobuf:"bytes,1,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // When set, only the quotes of this buyer are found
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetQuoteRequest) Reset() {
+	*x = GetQuoteRequest{}
+	mi := &file_proto_user_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetQuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuoteRequest) ProtoMessage() {}
+
+func (x *GetQuoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuoteRequest.ProtoReflect.Descriptor instead.
+func (*GetQuoteRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetQuoteRequest) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *GetQuoteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListQuotesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // Empty for the quotes of all buyers
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`               // Empty for all
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuotesRequest) Reset() {
+	*x = ListQuotesRequest{}
+	mi := &file_proto_user_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuotesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuotesRequest) ProtoMessage() {}
+
+func (x *ListQuotesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuotesRequest.ProtoReflect.Descriptor instead.
+func (*ListQuotesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListQuotesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListQuotesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListQuotesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListQuotesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListQuotesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quotes        []*Quote               `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuotesResponse) Reset() {
+	*x = ListQuotesResponse{}
+	mi := &file_proto_user_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuotesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuotesResponse) ProtoMessage() {}
+
+func (x *ListQuotesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuotesResponse.ProtoReflect.Descriptor instead.
+func (*ListQuotesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *ListQuotesResponse) GetQuotes() []*Quote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+func (x *ListQuotesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type QuoteEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Increasing; consumers resume after the last ID read
+	QuoteId       string                 `protobuf:"bytes,2,opt,name=quote_id,json=quoteId,proto3" json:"quote_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                          // Of the buyer
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`                        // The status the quote changed to
+	Total         float64                `protobuf:"fixed64,6,opt,name=total,proto3" json:"total,omitempty"`                        // Set once quoted
+	ExpiresAt     string                 `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // RFC3339; set once quoted
+	CreatedAt     string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuoteEvent) Reset() {
+	*x = QuoteEvent{}
+	mi := &file_proto_user_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuoteEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteEvent) ProtoMessage() {}
+
+func (x *QuoteEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuoteEvent.ProtoReflect.Descriptor instead.
+func (*QuoteEvent) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *QuoteEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuoteEvent) GetQuoteId() string {
+	if x != nil {
+		return x.QuoteId
+	}
+	return ""
+}
+
+func (x *QuoteEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuoteEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *QuoteEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *QuoteEvent) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *QuoteEvent) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *QuoteEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListQuoteEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterId       int64                  `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Default 100, at most 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuoteEventsRequest) Reset() {
+	*x = ListQuoteEventsRequest{}
+	mi := &file_proto_user_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuoteEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuoteEventsRequest) ProtoMessage() {}
+
+func (x *ListQuoteEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuoteEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuoteEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ListQuoteEventsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListQuoteEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListQuoteEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*QuoteEvent          `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuoteEventsResponse) Reset() {
+	*x = ListQuoteEventsResponse{}
+	mi := &file_proto_user_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuoteEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuoteEventsResponse) ProtoMessage() {}
+
+func (x *ListQuoteEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuoteEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuoteEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *ListQuoteEventsResponse) GetEvents() []*QuoteEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Credit account messages. The balance of an account is the amount of its
+// charges not paid yet; charges are due at the end of the payment terms of
+// the company.
+type CreditAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CreditLimit   float64                `protobuf:"fixed64,2,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"`
+	Balance       float64                `protobuf:"fixed64,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Available     float64                `protobuf:"fixed64,4,opt,name=available,proto3" json:"available,omitempty"`                // Credit left to purchase against
+	Overdue       float64                `protobuf:"fixed64,5,opt,name=overdue,proto3" json:"overdue,omitempty"`                    // Part of the balance past its due date
+	UpdatedAt     string                 `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditAccount) Reset() {
+	*x = CreditAccount{}
+	mi := &file_proto_user_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditAccount) ProtoMessage() {}
+
+func (x *CreditAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditAccount.ProtoReflect.Descriptor instead.
+func (*CreditAccount) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *CreditAccount) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CreditAccount) GetCreditLimit() float64 {
+	if x != nil {
+		return x.CreditLimit
+	}
+	return 0
+}
+
+func (x *CreditAccount) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *CreditAccount) GetAvailable() float64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *CreditAccount) GetOverdue() float64 {
+	if x != nil {
+		return x.Overdue
+	}
+	return 0
+}
+
+func (x *CreditAccount) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreditCharge struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChargeId       string                 `protobuf:"bytes,1,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	CompanyId      string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The purchaser
+	OrderReference string                 `protobuf:"bytes,4,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	Amount         float64                `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Outstanding    float64                `protobuf:"fixed64,6,opt,name=outstanding,proto3" json:"outstanding,omitempty"`            // Not paid yet
+	DueAt          string                 `protobuf:"bytes,7,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`             // RFC3339
+	OverdueAt      string                 `protobuf:"bytes,8,opt,name=overdue_at,json=overdueAt,proto3" json:"overdue_at,omitempty"` // RFC3339; set once found overdue
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreditCharge) Reset() {
+	*x = CreditCharge{}
+	mi := &file_proto_user_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditCharge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditCharge) ProtoMessage() {}
+
+func (x *CreditCharge) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditCharge.ProtoReflect.Descriptor instead.
+func (*CreditCharge) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CreditCharge) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *CreditCharge) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CreditCharge) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreditCharge) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *CreditCharge) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreditCharge) GetOutstanding() float64 {
+	if x != nil {
+		return x.Outstanding
+	}
+	return 0
+}
+
+func (x *CreditCharge) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *CreditCharge) GetOverdueAt() string {
+	if x != nil {
+		return x.OverdueAt
+	}
+	return ""
+}
+
+func (x *CreditCharge) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreditPayment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     string                 `protobuf:"bytes,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reference     string                 `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditPayment) Reset() {
+	*x = CreditPayment{}
+	mi := &file_proto_user_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditPayment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditPayment) ProtoMessage() {}
+
+func (x *CreditPayment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditPayment.ProtoReflect.Descriptor instead.
+func (*CreditPayment) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *CreditPayment) GetPaymentId() string {
+	if x != nil {
+		return x.PaymentId
+	}
+	return ""
+}
+
+func (x *CreditPayment) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CreditPayment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreditPayment) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CreditPayment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreditAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       *CreditAccount         `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditAccountResponse) Reset() {
+	*x = CreditAccountResponse{}
+	mi := &file_proto_user_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditAccountResponse) ProtoMessage() {}
+
+func (x *CreditAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreditAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *CreditAccountResponse) GetAccount() *CreditAccount {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+type CreditChargeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Charge        *CreditCharge          `protobuf:"bytes,1,opt,name=charge,proto3" json:"charge,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditChargeResponse) Reset() {
+	*x = CreditChargeResponse{}
+	mi := &file_proto_user_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditChargeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditChargeResponse) ProtoMessage() {}
+
+func (x *CreditChargeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditChargeResponse.ProtoReflect.Descriptor instead.
+func (*CreditChargeResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *CreditChargeResponse) GetCharge() *CreditCharge {
+	if x != nil {
+		return x.Charge
+	}
+	return nil
+}
+
+type CreditPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *CreditPayment         `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditPaymentResponse) Reset() {
+	*x = CreditPaymentResponse{}
+	mi := &file_proto_user_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditPaymentResponse) ProtoMessage() {}
+
+func (x *CreditPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditPaymentResponse.ProtoReflect.Descriptor instead.
+func (*CreditPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *CreditPaymentResponse) GetPayment() *CreditPayment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+// Opens the credit account of the company, or changes its limit
+type SetCreditLimitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	CreditLimit   float64                `protobuf:"fixed64,2,opt,name=credit_limit,json=creditLimit,proto3" json:"credit_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCreditLimitRequest) Reset() {
+	*x = SetCreditLimitRequest{}
+	mi := &file_proto_user_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCreditLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCreditLimitRequest) ProtoMessage() {}
+
+func (x *SetCreditLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCreditLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetCreditLimitRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *SetCreditLimitRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *SetCreditLimitRequest) GetCreditLimit() float64 {
+	if x != nil {
+		return x.CreditLimit
+	}
+	return 0
+}
+
+// Gets the credit account of a company, or of the company of a user
+type GetCreditAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreditAccountRequest) Reset() {
+	*x = GetCreditAccountRequest{}
+	mi := &file_proto_user_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditAccountRequest) ProtoMessage() {}
+
+func (x *GetCreditAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditAccountRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *GetCreditAccountRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetCreditAccountRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Charges an approved purchase of a company member to the credit of their
+// company, for the order reference and amount of its approval; charging the
+// same order reference again returns its charge
+type ChargeCreditRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ApprovalId    string                 `protobuf:"bytes,2,opt,name=approval_id,json=approvalId,proto3" json:"approval_id,omitempty"` // UUID of an approved purchase of the member
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChargeCreditRequest) Reset() {
+	*x = ChargeCreditRequest{}
+	mi := &file_proto_user_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChargeCreditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChargeCreditRequest) ProtoMessage() {}
+
+func (x *ChargeCreditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChargeCreditRequest.ProtoReflect.Descriptor instead.
+func (*ChargeCreditRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ChargeCreditRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ChargeCreditRequest) GetApprovalId() string {
+	if x != nil {
+		return x.ApprovalId
+	}
+	return ""
+}
+
+// Records a payment received, settling the oldest charges first
+type RecordCreditPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reference     string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordCreditPaymentRequest) Reset() {
+	*x = RecordCreditPaymentRequest{}
+	mi := &file_proto_user_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordCreditPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordCreditPaymentRequest) ProtoMessage() {}
+
+func (x *RecordCreditPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordCreditPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RecordCreditPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *RecordCreditPaymentRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *RecordCreditPaymentRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RecordCreditPaymentRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+// Gets the statement of the credit account of a company, or of the company
+// of a user, over [from, to); the current month when unset
+type GetCreditStatementRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	From          string                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"` // RFC3339
+	To            string                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`     // RFC3339; now when unset
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCreditStatementRequest) Reset() {
+	*x = GetCreditStatementRequest{}
+	mi := &file_proto_user_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCreditStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCreditStatementRequest) ProtoMessage() {}
+
+func (x *GetCreditStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCreditStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetCreditStatementRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetCreditStatementRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *GetCreditStatementRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetCreditStatementRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetCreditStatementRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type CreditStatementResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId      string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	From           string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // RFC3339
+	To             string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`     // RFC3339
+	OpeningBalance float64                `protobuf:"fixed64,4,opt,name=opening_balance,json=openingBalance,proto3" json:"opening_balance,omitempty"`
+	ClosingBalance float64                `protobuf:"fixed64,5,opt,name=closing_balance,json=closingBalance,proto3" json:"closing_balance,omitempty"`
+	Charges        []*CreditCharge        `protobuf:"bytes,6,rep,name=charges,proto3" json:"charges,omitempty"`
+	Payments       []*CreditPayment       `protobuf:"bytes,7,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreditStatementResponse) Reset() {
+	*x = CreditStatementResponse{}
+	mi := &file_proto_user_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditStatementResponse) ProtoMessage() {}
+
+func (x *CreditStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditStatementResponse.ProtoReflect.Descriptor instead.
+func (*CreditStatementResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CreditStatementResponse) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CreditStatementResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *CreditStatementResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *CreditStatementResponse) GetOpeningBalance() float64 {
+	if x != nil {
+		return x.OpeningBalance
+	}
+	return 0
+}
+
+func (x *CreditStatementResponse) GetClosingBalance() float64 {
+	if x != nil {
+		return x.ClosingBalance
+	}
+	return 0
+}
+
+func (x *CreditStatementResponse) GetCharges() []*CreditCharge {
+	if x != nil {
+		return x.Charges
+	}
+	return nil
+}
+
+func (x *CreditStatementResponse) GetPayments() []*CreditPayment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type CreditEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // Increasing; consumers resume after the last ID read
+	CompanyId      string                 `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	ChargeId       string                 `protobuf:"bytes,3,opt,name=charge_id,json=chargeId,proto3" json:"charge_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // The purchaser
+	Email          string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`                 // Of the purchaser
+	OrderReference string                 `protobuf:"bytes,6,opt,name=order_reference,json=orderReference,proto3" json:"order_reference,omitempty"`
+	Outstanding    float64                `protobuf:"fixed64,7,opt,name=outstanding,proto3" json:"outstanding,omitempty"`
+	DueAt          string                 `protobuf:"bytes,8,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`             // RFC3339
+	CreatedAt      string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreditEvent) Reset() {
+	*x = CreditEvent{}
+	mi := &file_proto_user_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditEvent) ProtoMessage() {}
+
+func (x *CreditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditEvent.ProtoReflect.Descriptor instead.
+func (*CreditEvent) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *CreditEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreditEvent) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CreditEvent) GetChargeId() string {
+	if x != nil {
+		return x.ChargeId
+	}
+	return ""
+}
+
+func (x *CreditEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreditEvent) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreditEvent) GetOrderReference() string {
+	if x != nil {
+		return x.OrderReference
+	}
+	return ""
+}
+
+func (x *CreditEvent) GetOutstanding() float64 {
+	if x != nil {
+		return x.Outstanding
+	}
+	return 0
+}
+
+func (x *CreditEvent) GetDueAt() string {
+	if x != nil {
+		return x.DueAt
+	}
+	return ""
+}
+
+func (x *CreditEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListCreditEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterId       int64                  `protobuf:"varint,1,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // Default 100, at most 500
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCreditEventsRequest) Reset() {
+	*x = ListCreditEventsRequest{}
+	mi := &file_proto_user_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCreditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreditEventsRequest) ProtoMessage() {}
+
+func (x *ListCreditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListCreditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ListCreditEventsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListCreditEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCreditEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*CreditEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCreditEventsResponse) Reset() {
+	*x = ListCreditEventsResponse{}
+	mi := &file_proto_user_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCreditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCreditEventsResponse) ProtoMessage() {}
+
+func (x *ListCreditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCreditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListCreditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListCreditEventsResponse) GetEvents() []*CreditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Payment method related messages
+type PaymentMethod struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethodId string                 `protobuf:"bytes,1,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"` // UUID string
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // UUID string
+	PaymentType     string                 `protobuf:"bytes,3,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
+	CardLastFour    string                 `protobuf:"bytes,4,opt,name=card_last_four,json=cardLastFour,proto3" json:"card_last_four,omitempty"`
+	CardBrand       string                 `protobuf:"bytes,5,opt,name=card_brand,json=cardBrand,proto3" json:"card_brand,omitempty"`
+	ExpirationMonth int32                  `protobuf:"varint,6,opt,name=expiration_month,json=expirationMonth,proto3" json:"expiration_month,omitempty"`
+	ExpirationYear  int32                  `protobuf:"varint,7,opt,name=expiration_year,json=expirationYear,proto3" json:"expiration_year,omitempty"`
+	IsDefault       bool                   `protobuf:"varint,8,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Token           string                 `protobuf:"bytes,9,opt,name=token,proto3" json:"token,omitempty"`
+	CreatedAt       string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // RFC3339 formatted timestamp
+	UpdatedAt       string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"` // RFC3339 formatted timestamp
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PaymentMethod) Reset() {
+	*x = PaymentMethod{}
+	mi := &file_proto_user_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethod) ProtoMessage() {}
+
+func (x *PaymentMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethod.ProtoReflect.Descriptor instead.
+func (*PaymentMethod) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *PaymentMethod) GetPaymentMethodId() string {
+	if x != nil {
+		return x.PaymentMethodId
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetCardLastFour() string {
+	if x != nil {
+		return x.CardLastFour
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetCardBrand() string {
+	if x != nil {
+		return x.CardBrand
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetExpirationMonth() int32 {
+	if x != nil {
+		return x.ExpirationMonth
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetExpirationYear() int32 {
+	if x != nil {
+		return x.ExpirationYear
+	}
+	return 0
+}
+
+func (x *PaymentMethod) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *PaymentMethod) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PaymentMethod) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type AddPaymentMethodRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	PaymentType     string                 `protobuf:"bytes,2,opt,name=payment_type,json=paymentType,proto3" json:"payment_type,omitempty"`
+	CardLastFour    string                 `protobuf:"bytes,3,opt,name=card_last_four,json=cardLastFour,proto3" json:"card_last_four,omitempty"`
+	CardBrand       string                 `protobuf:"bytes,4,opt,name=card_brand,json=cardBrand,proto3" json:"card_brand,omitempty"`
+	ExpirationMonth int32                  `protobuf:"varint,5,opt,name=expiration_month,json=expirationMonth,proto3" json:"expiration_month,omitempty"`
+	ExpirationYear  int32                  `protobuf:"varint,6,opt,name=expiration_year,json=expirationYear,proto3" json:"expiration_year,omitempty"`
+	IsDefault       bool                   `protobuf:"varint,7,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	Token           string                 `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AddPaymentMethodRequest) Reset() {
+	*x = AddPaymentMethodRequest{}
+	mi := &file_proto_user_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPaymentMethodRequest) ProtoMessage() {}
+
+func (x *AddPaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*AddPaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *AddPaymentMethodRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddPaymentMethodRequest) GetPaymentType() string {
+	if x != nil {
+		return x.PaymentType
+	}
+	return ""
+}
+
+func (x *AddPaymentMethodRequest) GetCardLastFour() string {
+	if x != nil {
+		return x.CardLastFour
+	}
+	return ""
+}
+
+func (x *AddPaymentMethodRequest) GetCardBrand() string {
+	if x != nil {
+		return x.CardBrand
+	}
+	return ""
+}
+
+func (x *AddPaymentMethodRequest) GetExpirationMonth() int32 {
+	if x != nil {
+		return x.ExpirationMonth
+	}
+	return 0
+}
+
+func (x *AddPaymentMethodRequest) GetExpirationYear() int32 {
+	if x != nil {
+		return x.ExpirationYear
+	}
+	return 0
+}
+
+func (x *AddPaymentMethodRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *AddPaymentMethodRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type PaymentMethodResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethod *PaymentMethod         `protobuf:"bytes,1,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentMethodResponse) Reset() {
+	*x = PaymentMethodResponse{}
+	mi := &file_proto_user_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethodResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethodResponse) ProtoMessage() {}
+
+func (x *PaymentMethodResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethodResponse.ProtoReflect.Descriptor instead.
+func (*PaymentMethodResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *PaymentMethodResponse) GetPaymentMethod() *PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return nil
+}
+
+type GetPaymentMethodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // UUID string
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentMethodsRequest) Reset() {
+	*x = GetPaymentMethodsRequest{}
+	mi := &file_proto_user_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentMethodsRequest) ProtoMessage() {}
+
+func (x *GetPaymentMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentMethodsRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *GetPaymentMethodsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PaymentMethodListResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethods []*PaymentMethod       `protobuf:"bytes,1,rep,name=payment_methods,json=paymentMethods,proto3" json:"payment_methods,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PaymentMethodListResponse) Reset() {
+	*x = PaymentMethodListResponse{}
+	mi := &file_proto_user_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentMethodListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentMethodListResponse) ProtoMessage() {}
+
+func (x *PaymentMethodListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentMethodListResponse.ProtoReflect.Descriptor instead.
+func (*PaymentMethodListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *PaymentMethodListResponse) GetPaymentMethods() []*PaymentMethod {
+	if x != nil {
+		return x.PaymentMethods
+	}
+	return nil
+}
+
+type UpdatePaymentMethodRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PaymentMethodId string                 `protobuf:"bytes,1,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"` // UUID string
+	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // UUID string
+	ExpirationMonth int32                  `protobuf:"varint,3,opt,name=expiration_month,json=expirationMonth,proto3" json:"expiration_month,omitempty"`
+	ExpirationYear  int32                  `protobuf:"varint,4,opt,name=expiration_year,json=expirationYear,proto3" json:"expiration_year,omitempty"`
+	IsDefault       bool                   `protobuf:"varint,5,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdatePaymentMethodRequest) Reset() {
+	*x = UpdatePaymentMethodRequest{}
+	mi := &file_proto_user_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePaymentMethodRequest) ProtoMessage() {}
+
+func (x *UpdatePaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *UpdatePaymentMethodRequest) GetPaymentMethodId() string {
+	if x != nil {
+		return x.PaymentMethodId
+	}
+	return ""
+}
+
+func (x *UpdatePaymentMethodRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdatePaymentMethodRequest) GetExpirationMonth() int32 {
+	if x != nil {
+		return x.ExpirationMonth
+	}
+	return 0
+}
+
+func (x *UpdatePaymentMethodRequest) GetExpirationYear() int32 {
+	if x != nil {
+		return x.ExpirationYear
+	}
+	return 0
+}
+
+func (x *UpdatePaymentMethodRequest) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+type DeletePaymentMethodRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
 	PaymentMethodId string                 `protobuf:"bytes,1,opt,name=payment_method_id,json=paymentMethodId,proto3" json:"payment_method_id,omitempty"` // UUID string
 	UserId          string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                              // UUID string
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeletePaymentMethodRequest) Reset() {
-	*x = DeletePaymentMethodRequest{}
-	mi := &file_proto_user_proto_msgTypes[29]
+func (x *DeletePaymentMethodRequest) Reset() {
+	*x = DeletePaymentMethodRequest{}
+	mi := &file_proto_user_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePaymentMethodRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePaymentMethodRequest) ProtoMessage() {}
+
+func (x *DeletePaymentMethodRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePaymentMethodRequest.ProtoReflect.Descriptor instead.
+func (*DeletePaymentMethodRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *DeletePaymentMethodRequest) GetPaymentMethodId() string {
+	if x != nil {
+		return x.PaymentMethodId
+	}
+	return ""
+}
+
+func (x *DeletePaymentMethodRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Health check messages
+type HealthCheckRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	mi := &file_proto_user_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{124}
+}
+
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	mi := &file_proto_user_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *HealthCheckResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Diagnostics messages
+type GetDiagnosticsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiagnosticsRequest) Reset() {
+	*x = GetDiagnosticsRequest{}
+	mi := &file_proto_user_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiagnosticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiagnosticsRequest) ProtoMessage() {}
+
+func (x *GetDiagnosticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiagnosticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiagnosticsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{126}
+}
+
+type DBPoolDiagnostics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Replica             bool                   `protobuf:"varint,2,opt,name=replica,proto3" json:"replica,omitempty"`
+	MaxOpenConnections  int32                  `protobuf:"varint,3,opt,name=max_open_connections,json=maxOpenConnections,proto3" json:"max_open_connections,omitempty"`
+	OpenConnections     int32                  `protobuf:"varint,4,opt,name=open_connections,json=openConnections,proto3" json:"open_connections,omitempty"`
+	InUse               int32                  `protobuf:"varint,5,opt,name=in_use,json=inUse,proto3" json:"in_use,omitempty"`
+	Idle                int32                  `protobuf:"varint,6,opt,name=idle,proto3" json:"idle,omitempty"`
+	WaitCount           int64                  `protobuf:"varint,7,opt,name=wait_count,json=waitCount,proto3" json:"wait_count,omitempty"`
+	WaitDurationSeconds float64                `protobuf:"fixed64,8,opt,name=wait_duration_seconds,json=waitDurationSeconds,proto3" json:"wait_duration_seconds,omitempty"`
+	MaxIdleClosed       int64                  `protobuf:"varint,9,opt,name=max_idle_closed,json=maxIdleClosed,proto3" json:"max_idle_closed,omitempty"`
+	MaxIdleTimeClosed   int64                  `protobuf:"varint,10,opt,name=max_idle_time_closed,json=maxIdleTimeClosed,proto3" json:"max_idle_time_closed,omitempty"`
+	MaxLifetimeClosed   int64                  `protobuf:"varint,11,opt,name=max_lifetime_closed,json=maxLifetimeClosed,proto3" json:"max_lifetime_closed,omitempty"`
+	ReplicaLagKnown     bool                   `protobuf:"varint,12,opt,name=replica_lag_known,json=replicaLagKnown,proto3" json:"replica_lag_known,omitempty"`
+	ReplicaLagSeconds   float64                `protobuf:"fixed64,13,opt,name=replica_lag_seconds,json=replicaLagSeconds,proto3" json:"replica_lag_seconds,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DBPoolDiagnostics) Reset() {
+	*x = DBPoolDiagnostics{}
+	mi := &file_proto_user_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DBPoolDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DBPoolDiagnostics) ProtoMessage() {}
+
+func (x *DBPoolDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DBPoolDiagnostics.ProtoReflect.Descriptor instead.
+func (*DBPoolDiagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *DBPoolDiagnostics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DBPoolDiagnostics) GetReplica() bool {
+	if x != nil {
+		return x.Replica
+	}
+	return false
+}
+
+func (x *DBPoolDiagnostics) GetMaxOpenConnections() int32 {
+	if x != nil {
+		return x.MaxOpenConnections
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetOpenConnections() int32 {
+	if x != nil {
+		return x.OpenConnections
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetInUse() int32 {
+	if x != nil {
+		return x.InUse
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetIdle() int32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetWaitCount() int64 {
+	if x != nil {
+		return x.WaitCount
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetWaitDurationSeconds() float64 {
+	if x != nil {
+		return x.WaitDurationSeconds
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxIdleClosed() int64 {
+	if x != nil {
+		return x.MaxIdleClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxIdleTimeClosed() int64 {
+	if x != nil {
+		return x.MaxIdleTimeClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetMaxLifetimeClosed() int64 {
+	if x != nil {
+		return x.MaxLifetimeClosed
+	}
+	return 0
+}
+
+func (x *DBPoolDiagnostics) GetReplicaLagKnown() bool {
+	if x != nil {
+		return x.ReplicaLagKnown
+	}
+	return false
+}
+
+func (x *DBPoolDiagnostics) GetReplicaLagSeconds() float64 {
+	if x != nil {
+		return x.ReplicaLagSeconds
+	}
+	return 0
+}
+
+type CacheDiagnostics struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Name                string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CircuitBreakerState string                 `protobuf:"bytes,2,opt,name=circuit_breaker_state,json=circuitBreakerState,proto3" json:"circuit_breaker_state,omitempty"` // closed, open or half-open
+	Healthy             bool                   `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	MemoryEntries       int64                  `protobuf:"varint,4,opt,name=memory_entries,json=memoryEntries,proto3" json:"memory_entries,omitempty"`
+	Hits                int64                  `protobuf:"varint,5,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses              int64                  `protobuf:"varint,6,opt,name=misses,proto3" json:"misses,omitempty"`
+	Errors              int64                  `protobuf:"varint,7,opt,name=errors,proto3" json:"errors,omitempty"`
+	HitRate             float64                `protobuf:"fixed64,8,opt,name=hit_rate,json=hitRate,proto3" json:"hit_rate,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CacheDiagnostics) Reset() {
+	*x = CacheDiagnostics{}
+	mi := &file_proto_user_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheDiagnostics) ProtoMessage() {}
+
+func (x *CacheDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheDiagnostics.ProtoReflect.Descriptor instead.
+func (*CacheDiagnostics) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CacheDiagnostics) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CacheDiagnostics) GetCircuitBreakerState() string {
+	if x != nil {
+		return x.CircuitBreakerState
+	}
+	return ""
+}
+
+func (x *CacheDiagnostics) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *CacheDiagnostics) GetMemoryEntries() int64 {
+	if x != nil {
+		return x.MemoryEntries
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *CacheDiagnostics) GetHitRate() float64 {
+	if x != nil {
+		return x.HitRate
+	}
+	return 0
+}
+
+type DiagnosticsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Service       string                 `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	CollectedAt   string                 `protobuf:"bytes,2,opt,name=collected_at,json=collectedAt,proto3" json:"collected_at,omitempty"` // RFC3339 formatted timestamp
+	UptimeSeconds float64                `protobuf:"fixed64,3,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	Goroutines    int32                  `protobuf:"varint,4,opt,name=goroutines,proto3" json:"goroutines,omitempty"`
+	DbPools       []*DBPoolDiagnostics   `protobuf:"bytes,5,rep,name=db_pools,json=dbPools,proto3" json:"db_pools,omitempty"`
+	Caches        []*CacheDiagnostics    `protobuf:"bytes,6,rep,name=caches,proto3" json:"caches,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosticsResponse) Reset() {
+	*x = DiagnosticsResponse{}
+	mi := &file_proto_user_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePaymentMethodRequest) String() string {
+func (x *DiagnosticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePaymentMethodRequest) ProtoMessage() {}
+func (*DiagnosticsResponse) ProtoMessage() {}
 
-func (x *DeletePaymentMethodRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[29]
+func (x *DiagnosticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2127,47 +8550,74 @@ func (x *DeletePaymentMethodRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePaymentMethodRequest.ProtoReflect.Descriptor instead.
-func (*DeletePaymentMethodRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use DiagnosticsResponse.ProtoReflect.Descriptor instead.
+func (*DiagnosticsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *DeletePaymentMethodRequest) GetPaymentMethodId() string {
+func (x *DiagnosticsResponse) GetService() string {
 	if x != nil {
-		return x.PaymentMethodId
+		return x.Service
 	}
 	return ""
 }
 
-func (x *DeletePaymentMethodRequest) GetUserId() string {
+func (x *DiagnosticsResponse) GetCollectedAt() string {
 	if x != nil {
-		return x.UserId
+		return x.CollectedAt
 	}
 	return ""
 }
 
-// Health check messages
-type HealthCheckRequest struct {
+func (x *DiagnosticsResponse) GetUptimeSeconds() float64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetGoroutines() int32 {
+	if x != nil {
+		return x.Goroutines
+	}
+	return 0
+}
+
+func (x *DiagnosticsResponse) GetDbPools() []*DBPoolDiagnostics {
+	if x != nil {
+		return x.DbPools
+	}
+	return nil
+}
+
+func (x *DiagnosticsResponse) GetCaches() []*CacheDiagnostics {
+	if x != nil {
+		return x.Caches
+	}
+	return nil
+}
+
+type GetJWKSRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthCheckRequest) Reset() {
-	*x = HealthCheckRequest{}
-	mi := &file_proto_user_proto_msgTypes[30]
+func (x *GetJWKSRequest) Reset() {
+	*x = GetJWKSRequest{}
+	mi := &file_proto_user_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckRequest) String() string {
+func (x *GetJWKSRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckRequest) ProtoMessage() {}
+func (*GetJWKSRequest) ProtoMessage() {}
 
-func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[30]
+func (x *GetJWKSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,33 +8628,39 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use GetJWKSRequest.ProtoReflect.Descriptor instead.
+func (*GetJWKSRequest) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{130}
 }
 
-type HealthCheckResponse struct {
+// RSA public key in JWK form (RFC 7517)
+type JWK struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Kid           string                 `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Kty           string                 `protobuf:"bytes,2,opt,name=kty,proto3" json:"kty,omitempty"`
+	Alg           string                 `protobuf:"bytes,3,opt,name=alg,proto3" json:"alg,omitempty"`
+	Use           string                 `protobuf:"bytes,4,opt,name=use,proto3" json:"use,omitempty"`
+	N             string                 `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"` // base64url encoded modulus
+	E             string                 `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"` // base64url encoded exponent
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HealthCheckResponse) Reset() {
-	*x = HealthCheckResponse{}
-	mi := &file_proto_user_proto_msgTypes[31]
+func (x *JWK) Reset() {
+	*x = JWK{}
+	mi := &file_proto_user_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HealthCheckResponse) String() string {
+func (x *JWK) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckResponse) ProtoMessage() {}
+func (*JWK) ProtoMessage() {}
 
-func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_user_proto_msgTypes[31]
+func (x *JWK) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2215,23 +8671,102 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_proto_user_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use JWK.ProtoReflect.Descriptor instead.
+func (*JWK) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *HealthCheckResponse) GetStatus() string {
+func (x *JWK) GetKid() string {
 	if x != nil {
-		return x.Status
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JWK) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *JWK) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JWK) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *JWK) GetN() string {
+	if x != nil {
+		return x.N
+	}
+	return ""
+}
+
+func (x *JWK) GetE() string {
+	if x != nil {
+		return x.E
 	}
 	return ""
 }
 
+type GetJWKSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*JWK                 `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJWKSResponse) Reset() {
+	*x = GetJWKSResponse{}
+	mi := &file_proto_user_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJWKSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJWKSResponse) ProtoMessage() {}
+
+func (x *GetJWKSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_user_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJWKSResponse.ProtoReflect.Descriptor instead.
+func (*GetJWKSResponse) Descriptor() ([]byte, []int) {
+	return file_proto_user_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *GetJWKSResponse) GetKeys() []*JWK {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 var File_proto_user_proto protoreflect.FileDescriptor
 
 const file_proto_user_proto_rawDesc = "" +
 	"\n" +
-	"\x10proto/user.proto\x12\x04user\"D\n" +
+	"\x10proto/user.proto\x12\x04user\x1a\x1cgoogle/protobuf/struct.proto\"D\n" +
 	"\x0eDeleteResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\":\n" +
@@ -2242,7 +8777,7 @@ const file_proto_user_proto_rawDesc = "" +
 	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\x12\x1e\n" +
 	"\x04user\x18\x03 \x01(\v2\n" +
 	".user.UserR\x04user\x12(\n" +
-	"\x06cookie\x18\x04 \x01(\v2\x10.user.CookieInfoR\x06cookie\"\xdd\x03\n" +
+	"\x06cookie\x18\x04 \x01(\v2\x10.user.CookieInfoR\x06cookie\"\xbb\x04\n" +
 	"\x04User\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -2263,7 +8798,9 @@ const file_proto_user_proto_rawDesc = "" +
 	"updated_at\x18\r \x01(\tR\tupdatedAt\x12\x1d\n" +
 	"\n" +
 	"last_login\x18\x0e \x01(\tR\tlastLogin\x12(\n" +
-	"\x10refresh_token_id\x18\x0f \x01(\tR\x0erefreshTokenId\"\xb2\x01\n" +
+	"\x10refresh_token_id\x18\x0f \x01(\tR\x0erefreshTokenId\x12'\n" +
+	"\x0fpermission_sets\x18\x10 \x03(\tR\x0epermissionSets\x123\n" +
+	"\bmetadata\x18\x11 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"\xb2\x01\n" +
 	"\x11CreateUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1d\n" +
@@ -2288,16 +8825,18 @@ const file_proto_user_proto_rawDesc = "" +
 	".user.UserR\x05users\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xa7\x01\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\xdc\x01\n" +
 	"\x11UpdateUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
 	"\tlast_name\x18\x04 \x01(\tR\blastName\x12!\n" +
-	"\fphone_number\x18\x05 \x01(\tR\vphoneNumber\",\n" +
+	"\fphone_number\x18\x05 \x01(\tR\vphoneNumber\x123\n" +
+	"\bmetadata\x18\x06 \x01(\v2\x17.google.protobuf.StructR\bmetadata\"G\n" +
 	"\x11DeleteUserRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"@\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\"@\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x94\x01\n" +
@@ -2380,7 +8919,502 @@ const file_proto_user_proto_rawDesc = "" +
 	"\x14DeleteAddressRequest\x12\x1d\n" +
 	"\n" +
 	"address_id\x18\x01 \x01(\tR\taddressId\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x83\x03\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\xce\x01\n" +
+	"\bUserNote\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12!\n" +
+	"\fauthor_email\x18\x04 \x01(\tR\vauthorEmail\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\x84\x01\n" +
+	"\x15CreateUserNoteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tauthor_id\x18\x02 \x01(\tR\bauthorId\x12!\n" +
+	"\fauthor_email\x18\x03 \x01(\tR\vauthorEmail\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"/\n" +
+	"\x14ListUserNotesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"=\n" +
+	"\x15ListUserNotesResponse\x12$\n" +
+	"\x05notes\x18\x01 \x03(\v2\x0e.user.UserNoteR\x05notes\"z\n" +
+	"\x15UpdateUserNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"I\n" +
+	"\x15DeleteUserNoteRequest\x12\x17\n" +
+	"\anote_id\x18\x01 \x01(\tR\x06noteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"6\n" +
+	"\x10UserNoteResponse\x12\"\n" +
+	"\x04note\x18\x01 \x01(\v2\x0e.user.UserNoteR\x04note\"\xeb\x01\n" +
+	"\x0fShopperListItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x19\n" +
+	"\badded_at\x18\x04 \x01(\tR\aaddedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\tR\tupdatedAt\x12D\n" +
+	"\x0fpersonalization\x18\x06 \x03(\v2\x1a.user.PersonalizationValueR\x0fpersonalization\"\x93\x01\n" +
+	"\x14PersonalizationValue\x12\x1b\n" +
+	"\toption_id\x18\x01 \x01(\tR\boptionId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\x12\x19\n" +
+	"\bfile_url\x18\x04 \x01(\tR\afileUrl\x12\x1b\n" +
+	"\tfile_name\x18\x05 \x01(\tR\bfileName\"F\n" +
+	"\x15GetShopperListRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04list\x18\x02 \x01(\tR\x04list\"\xea\x01\n" +
+	"\x19SetShopperListItemRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04list\x18\x02 \x01(\tR\x04list\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\tR\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12D\n" +
+	"\x0fpersonalization\x18\x06 \x03(\v2\x1a.user.PersonalizationValueR\x0fpersonalization\"\x8b\x01\n" +
+	"\x1cRemoveShopperListItemRequest\x12\x19\n" +
+	"\bowner_id\x18\x01 \x01(\tR\aownerId\x12\x12\n" +
+	"\x04list\x18\x02 \x01(\tR\x04list\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\tR\tvariantId\"V\n" +
+	"\x13ShopperListResponse\x12\x12\n" +
+	"\x04list\x18\x01 \x01(\tR\x04list\x12+\n" +
+	"\x05items\x18\x02 \x03(\v2\x15.user.ShopperListItemR\x05items\"K\n" +
+	"\x15MergeGuestDataRequest\x12\x19\n" +
+	"\bguest_id\x18\x01 \x01(\tR\aguestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x92\x01\n" +
+	"\x16MergeGuestDataResponse\x12\x1d\n" +
+	"\n" +
+	"cart_items\x18\x01 \x01(\x05R\tcartItems\x12%\n" +
+	"\x0ewishlist_items\x18\x02 \x01(\x05R\rwishlistItems\x122\n" +
+	"\x15recently_viewed_items\x18\x03 \x01(\x05R\x13recentlyViewedItems\"\x86\x02\n" +
+	"\x11CartReminderEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12\x1d\n" +
+	"\n" +
+	"item_count\x18\x05 \x01(\x05R\titemCount\x12+\n" +
+	"\x05items\x18\x06 \x03(\v2\x15.user.ShopperListItemR\x05items\x12&\n" +
+	"\x0fcart_updated_at\x18\a \x01(\tR\rcartUpdatedAt\x12\x1f\n" +
+	"\vreminded_at\x18\b \x01(\tR\n" +
+	"remindedAt\"P\n" +
+	"\x1dListCartReminderEventsRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"Q\n" +
+	"\x1eListCartReminderEventsResponse\x12/\n" +
+	"\x06events\x18\x01 \x03(\v2\x17.user.CartReminderEventR\x06events\"_\n" +
+	"\x1bRecordCartConversionRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\"]\n" +
+	"\x1cRecordCartConversionResponse\x12\x1c\n" +
+	"\tconverted\x18\x01 \x01(\bR\tconverted\x12\x1f\n" +
+	"\vreminder_id\x18\x02 \x01(\x03R\n" +
+	"reminderId\"A\n" +
+	"\x1bGetCartReminderStatsRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\"~\n" +
+	"\x19CartReminderStatsResponse\x12\x1a\n" +
+	"\breminded\x18\x01 \x01(\x03R\breminded\x12\x1c\n" +
+	"\tconverted\x18\x02 \x01(\x03R\tconverted\x12'\n" +
+	"\x0fconversion_rate\x18\x03 \x01(\x01R\x0econversionRate\"\x98\x01\n" +
+	"\tUserEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\tR\aactorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"J\n" +
+	"\x15ListUserEventsRequest\x12\x1b\n" +
+	"\tbefore_id\x18\x01 \x01(\x03R\bbeforeId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"A\n" +
+	"\x16ListUserEventsResponse\x12'\n" +
+	"\x06events\x18\x01 \x03(\v2\x0f.user.UserEventR\x06events\"\xe2\x01\n" +
+	"\x12CreateStaffRequest\x12\x14\n" +
+	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x05 \x01(\tR\blastName\x12'\n" +
+	"\x0fpermission_sets\x18\x06 \x03(\tR\x0epermissionSets\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\tR\aactorId\"y\n" +
+	"\x1aSetStaffPermissionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0fpermission_sets\x18\x02 \x03(\tR\x0epermissionSets\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\tR\aactorId\"\x12\n" +
+	"\x10ListStaffRequest\"5\n" +
+	"\x11ListStaffResponse\x12 \n" +
+	"\x05staff\x18\x01 \x03(\v2\n" +
+	".user.UserR\x05staff\"]\n" +
+	"\rPermissionSet\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\"\x1b\n" +
+	"\x19ListPermissionSetsRequest\"Z\n" +
+	"\x1aListPermissionSetsResponse\x12<\n" +
+	"\x0fpermission_sets\x18\x01 \x03(\v2\x13.user.PermissionSetR\x0epermissionSets\"A\n" +
+	"\x12ImportUsersRequest\x12\x10\n" +
+	"\x03csv\x18\x01 \x01(\fR\x03csv\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\"\x9f\x01\n" +
+	"\x0fImportRowResult\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"email_sent\x18\x06 \x01(\bR\temailSent\"r\n" +
+	"\x13ImportUsersResponse\x12)\n" +
+	"\x04rows\x18\x01 \x03(\v2\x15.user.ImportRowResultR\x04rows\x12\x18\n" +
+	"\ainvited\x18\x02 \x01(\x05R\ainvited\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\"M\n" +
+	"\x17ResendInvitationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x19\n" +
+	"\bactor_id\x18\x02 \x01(\tR\aactorId\"\x81\x01\n" +
+	"\x12InvitationResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"send_count\x18\x03 \x01(\x05R\tsendCount\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\"K\n" +
+	"\x17AcceptInvitationRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xdb\x01\n" +
+	"\tUserStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12!\n" +
+	"\ftotal_orders\x18\x02 \x01(\x03R\vtotalOrders\x12\x1f\n" +
+	"\vtotal_spend\x18\x03 \x01(\x01R\n" +
+	"totalSpend\x12.\n" +
+	"\x13average_order_value\x18\x04 \x01(\x01R\x11averageOrderValue\x12\"\n" +
+	"\rlast_order_at\x18\x05 \x01(\tR\vlastOrderAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\":\n" +
+	"\x11UserStatsResponse\x12%\n" +
+	"\x05stats\x18\x01 \x01(\v2\x0f.user.UserStatsR\x05stats\"\xc3\x01\n" +
+	"\x17RecordOrderEventRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12\x1f\n" +
+	"\voccurred_at\x18\x05 \x01(\tR\n" +
+	"occurredAt\x12\x19\n" +
+	"\bevent_id\x18\x06 \x01(\tR\aeventId\".\n" +
+	"\x13GetUserStatsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xd2\x01\n" +
+	"\x14ListUserStatsRequest\x12\x1d\n" +
+	"\n" +
+	"min_orders\x18\x01 \x01(\x03R\tminOrders\x12\x1b\n" +
+	"\tmin_spend\x18\x02 \x01(\x01R\bminSpend\x12(\n" +
+	"\x10last_order_after\x18\x03 \x01(\tR\x0elastOrderAfter\x12*\n" +
+	"\x11last_order_before\x18\x04 \x01(\tR\x0flastOrderBefore\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"T\n" +
+	"\x15ListUserStatsResponse\x12%\n" +
+	"\x05stats\x18\x01 \x03(\v2\x0f.user.UserStatsR\x05stats\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xc8\x01\n" +
+	"\rCompanyMember\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x04 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x05 \x01(\tR\blastName\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\x12\x19\n" +
+	"\badded_at\x18\a \x01(\tR\aaddedAt\"\xfc\x02\n" +
+	"\x0eCompanyAddress\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x01 \x01(\tR\taddressId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x14\n" +
+	"\x05label\x18\x03 \x01(\tR\x05label\x12!\n" +
+	"\faddress_type\x18\x04 \x01(\tR\vaddressType\x12'\n" +
+	"\x0fstreet_address1\x18\x05 \x01(\tR\x0estreetAddress1\x12'\n" +
+	"\x0fstreet_address2\x18\x06 \x01(\tR\x0estreetAddress2\x12\x12\n" +
+	"\x04city\x18\a \x01(\tR\x04city\x12\x14\n" +
+	"\x05state\x18\b \x01(\tR\x05state\x12\x1f\n" +
+	"\vpostal_code\x18\t \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acountry\x18\n" +
+	" \x01(\tR\acountry\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\v \x01(\bR\tisDefault\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"\xde\x02\n" +
+	"\aCompany\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rpayment_terms\x18\x03 \x01(\tR\fpaymentTerms\x12+\n" +
+	"\x11requires_approval\x18\x04 \x01(\bR\x10requiresApproval\x12-\n" +
+	"\x12approval_threshold\x18\x05 \x01(\x01R\x11approvalThreshold\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12-\n" +
+	"\amembers\x18\b \x03(\v2\x13.user.CompanyMemberR\amembers\x122\n" +
+	"\taddresses\x18\t \x03(\v2\x14.user.CompanyAddressR\taddresses\"o\n" +
+	"\x0fCompanyResponse\x12'\n" +
+	"\acompany\x18\x01 \x01(\v2\r.user.CompanyR\acompany\x123\n" +
+	"\n" +
+	"membership\x18\x02 \x01(\v2\x13.user.CompanyMemberR\n" +
+	"membership\"\xab\x01\n" +
+	"\x14CreateCompanyRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\rpayment_terms\x18\x02 \x01(\tR\fpaymentTerms\x12+\n" +
+	"\x11requires_approval\x18\x03 \x01(\bR\x10requiresApproval\x12-\n" +
+	"\x12approval_threshold\x18\x04 \x01(\x01R\x11approvalThreshold\"\xca\x01\n" +
+	"\x14UpdateCompanyRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\rpayment_terms\x18\x03 \x01(\tR\fpaymentTerms\x12+\n" +
+	"\x11requires_approval\x18\x04 \x01(\bR\x10requiresApproval\x12-\n" +
+	"\x12approval_threshold\x18\x05 \x01(\x01R\x11approvalThreshold\"2\n" +
+	"\x11GetCompanyRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\"@\n" +
+	"\x14ListCompaniesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"Z\n" +
+	"\x15ListCompaniesResponse\x12+\n" +
+	"\tcompanies\x18\x01 \x03(\v2\r.user.CompanyR\tcompanies\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"e\n" +
+	"\x17SetCompanyMemberRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"D\n" +
+	"\x15CompanyMemberResponse\x12+\n" +
+	"\x06member\x18\x01 \x01(\v2\x13.user.CompanyMemberR\x06member\"T\n" +
+	"\x1aRemoveCompanyMemberRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"J\n" +
+	"\x18AddCompanyAddressRequest\x12.\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.user.CompanyAddressR\aaddress\"H\n" +
+	"\x16CompanyAddressResponse\x12.\n" +
+	"\aaddress\x18\x01 \x01(\v2\x14.user.CompanyAddressR\aaddress\"[\n" +
+	"\x1bRemoveCompanyAddressRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tR\taddressId\"0\n" +
+	"\x15GetUserCompanyRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xc1\x02\n" +
+	"\x10PurchaseApproval\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12!\n" +
+	"\frequester_id\x18\x03 \x01(\tR\vrequesterId\x12'\n" +
+	"\x0forder_reference\x18\x04 \x01(\tR\x0eorderReference\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"decided_by\x18\a \x01(\tR\tdecidedBy\x12\x14\n" +
+	"\x05notes\x18\b \x01(\tR\x05notes\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"decided_at\x18\n" +
+	" \x01(\tR\tdecidedAt\"N\n" +
+	"\x18PurchaseApprovalResponse\x122\n" +
+	"\bapproval\x18\x01 \x01(\v2\x16.user.PurchaseApprovalR\bapproval\"z\n" +
+	"\x1eRequestPurchaseApprovalRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12'\n" +
+	"\x0forder_reference\x18\x02 \x01(\tR\x0eorderReference\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\"\x91\x01\n" +
+	"\x1dDecidePurchaseApprovalRequest\x12\x1f\n" +
+	"\vapproval_id\x18\x01 \x01(\tR\n" +
+	"approvalId\x12\x1f\n" +
+	"\vapprover_id\x18\x02 \x01(\tR\n" +
+	"approverId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\"\x98\x01\n" +
+	"\x1cListPurchaseApprovalsRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"k\n" +
+	"\x1dListPurchaseApprovalsResponse\x124\n" +
+	"\tapprovals\x18\x01 \x03(\v2\x16.user.PurchaseApprovalR\tapprovals\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x84\x01\n" +
+	"\tQuoteItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\tR\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x01R\tunitPrice\"\xb7\x03\n" +
+	"\x05Quote\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x03 \x01(\tR\tcompanyId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12%\n" +
+	"\x05items\x18\x05 \x03(\v2\x0f.user.QuoteItemR\x05items\x12\x1f\n" +
+	"\vbuyer_notes\x18\x06 \x01(\tR\n" +
+	"buyerNotes\x12\x1f\n" +
+	"\vadmin_notes\x18\a \x01(\tR\n" +
+	"adminNotes\x12\x14\n" +
+	"\x05total\x18\b \x01(\x01R\x05total\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\tR\texpiresAt\x12'\n" +
+	"\x0forder_reference\x18\n" +
+	" \x01(\tR\x0eorderReference\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1b\n" +
+	"\tquoted_at\x18\f \x01(\tR\bquotedAt\x12!\n" +
+	"\fconverted_at\x18\r \x01(\tR\vconvertedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0e \x01(\tR\tupdatedAt\"2\n" +
+	"\rQuoteResponse\x12!\n" +
+	"\x05quote\x18\x01 \x01(\v2\v.user.QuoteR\x05quote\"D\n" +
+	"\x13RequestQuoteRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05notes\x18\x02 \x01(\tR\x05notes\"\x90\x01\n" +
+	"\x15RespondToQuoteRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12'\n" +
+	"\x06prices\x18\x02 \x03(\v2\x0f.user.QuoteItemR\x06prices\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\tR\texpiresAt\x12\x14\n" +
+	"\x05notes\x18\x04 \x01(\tR\x05notes\"H\n" +
+	"\x12QuoteActionRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"r\n" +
+	"\x13ConvertQuoteRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12'\n" +
+	"\x0forder_reference\x18\x03 \x01(\tR\x0eorderReference\"E\n" +
+	"\x0fGetQuoteRequest\x12\x19\n" +
+	"\bquote_id\x18\x01 \x01(\tR\aquoteId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"n\n" +
+	"\x11ListQuotesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"O\n" +
+	"\x12ListQuotesResponse\x12#\n" +
+	"\x06quotes\x18\x01 \x03(\v2\v.user.QuoteR\x06quotes\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xd2\x01\n" +
+	"\n" +
+	"QuoteEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\bquote_id\x18\x02 \x01(\tR\aquoteId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x01R\x05total\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"I\n" +
+	"\x16ListQuoteEventsRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"C\n" +
+	"\x17ListQuoteEventsResponse\x12(\n" +
+	"\x06events\x18\x01 \x03(\v2\x10.user.QuoteEventR\x06events\"\xc2\x01\n" +
+	"\rCreditAccount\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12!\n" +
+	"\fcredit_limit\x18\x02 \x01(\x01R\vcreditLimit\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x01R\abalance\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\x01R\tavailable\x12\x18\n" +
+	"\aoverdue\x18\x05 \x01(\x01R\aoverdue\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\x9b\x02\n" +
+	"\fCreditCharge\x12\x1b\n" +
+	"\tcharge_id\x18\x01 \x01(\tR\bchargeId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12'\n" +
+	"\x0forder_reference\x18\x04 \x01(\tR\x0eorderReference\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x01R\x06amount\x12 \n" +
+	"\voutstanding\x18\x06 \x01(\x01R\voutstanding\x12\x15\n" +
+	"\x06due_at\x18\a \x01(\tR\x05dueAt\x12\x1d\n" +
+	"\n" +
+	"overdue_at\x18\b \x01(\tR\toverdueAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"\xa2\x01\n" +
+	"\rCreditPayment\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\tR\tpaymentId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"F\n" +
+	"\x15CreditAccountResponse\x12-\n" +
+	"\aaccount\x18\x01 \x01(\v2\x13.user.CreditAccountR\aaccount\"B\n" +
+	"\x14CreditChargeResponse\x12*\n" +
+	"\x06charge\x18\x01 \x01(\v2\x12.user.CreditChargeR\x06charge\"F\n" +
+	"\x15CreditPaymentResponse\x12-\n" +
+	"\apayment\x18\x01 \x01(\v2\x13.user.CreditPaymentR\apayment\"Y\n" +
+	"\x15SetCreditLimitRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12!\n" +
+	"\fcredit_limit\x18\x02 \x01(\x01R\vcreditLimit\"Q\n" +
+	"\x17GetCreditAccountRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"O\n" +
+	"\x13ChargeCreditRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vapproval_id\x18\x02 \x01(\tR\n" +
+	"approvalId\"q\n" +
+	"\x1aRecordCreditPaymentRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\"w\n" +
+	"\x19GetCreditStatementRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\tR\x02to\"\x8d\x02\n" +
+	"\x17CreditStatementResponse\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x12'\n" +
+	"\x0fopening_balance\x18\x04 \x01(\x01R\x0eopeningBalance\x12'\n" +
+	"\x0fclosing_balance\x18\x05 \x01(\x01R\x0eclosingBalance\x12,\n" +
+	"\acharges\x18\x06 \x03(\v2\x12.user.CreditChargeR\acharges\x12/\n" +
+	"\bpayments\x18\a \x03(\v2\x13.user.CreditPaymentR\bpayments\"\x89\x02\n" +
+	"\vCreditEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12\x1b\n" +
+	"\tcharge_id\x18\x03 \x01(\tR\bchargeId\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12'\n" +
+	"\x0forder_reference\x18\x06 \x01(\tR\x0eorderReference\x12 \n" +
+	"\voutstanding\x18\a \x01(\x01R\voutstanding\x12\x15\n" +
+	"\x06due_at\x18\b \x01(\tR\x05dueAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\"J\n" +
+	"\x17ListCreditEventsRequest\x12\x19\n" +
+	"\bafter_id\x18\x01 \x01(\x03R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"E\n" +
+	"\x18ListCreditEventsResponse\x12)\n" +
+	"\x06events\x18\x01 \x03(\v2\x11.user.CreditEventR\x06events\"\x83\x03\n" +
 	"\rPaymentMethod\x12*\n" +
 	"\x11payment_method_id\x18\x01 \x01(\tR\x0fpaymentMethodId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12!\n" +
@@ -2427,7 +9461,52 @@ const file_proto_user_proto_rawDesc = "" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\"\x14\n" +
 	"\x12HealthCheckRequest\"-\n" +
 	"\x13HealthCheckResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status2\x89\t\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"\x17\n" +
+	"\x15GetDiagnosticsRequest\"\x81\x04\n" +
+	"\x11DBPoolDiagnostics\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\areplica\x18\x02 \x01(\bR\areplica\x120\n" +
+	"\x14max_open_connections\x18\x03 \x01(\x05R\x12maxOpenConnections\x12)\n" +
+	"\x10open_connections\x18\x04 \x01(\x05R\x0fopenConnections\x12\x15\n" +
+	"\x06in_use\x18\x05 \x01(\x05R\x05inUse\x12\x12\n" +
+	"\x04idle\x18\x06 \x01(\x05R\x04idle\x12\x1d\n" +
+	"\n" +
+	"wait_count\x18\a \x01(\x03R\twaitCount\x122\n" +
+	"\x15wait_duration_seconds\x18\b \x01(\x01R\x13waitDurationSeconds\x12&\n" +
+	"\x0fmax_idle_closed\x18\t \x01(\x03R\rmaxIdleClosed\x12/\n" +
+	"\x14max_idle_time_closed\x18\n" +
+	" \x01(\x03R\x11maxIdleTimeClosed\x12.\n" +
+	"\x13max_lifetime_closed\x18\v \x01(\x03R\x11maxLifetimeClosed\x12*\n" +
+	"\x11replica_lag_known\x18\f \x01(\bR\x0freplicaLagKnown\x12.\n" +
+	"\x13replica_lag_seconds\x18\r \x01(\x01R\x11replicaLagSeconds\"\xfa\x01\n" +
+	"\x10CacheDiagnostics\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x122\n" +
+	"\x15circuit_breaker_state\x18\x02 \x01(\tR\x13circuitBreakerState\x12\x18\n" +
+	"\ahealthy\x18\x03 \x01(\bR\ahealthy\x12%\n" +
+	"\x0ememory_entries\x18\x04 \x01(\x03R\rmemoryEntries\x12\x12\n" +
+	"\x04hits\x18\x05 \x01(\x03R\x04hits\x12\x16\n" +
+	"\x06misses\x18\x06 \x01(\x03R\x06misses\x12\x16\n" +
+	"\x06errors\x18\a \x01(\x03R\x06errors\x12\x19\n" +
+	"\bhit_rate\x18\b \x01(\x01R\ahitRate\"\xfd\x01\n" +
+	"\x13DiagnosticsResponse\x12\x18\n" +
+	"\aservice\x18\x01 \x01(\tR\aservice\x12!\n" +
+	"\fcollected_at\x18\x02 \x01(\tR\vcollectedAt\x12%\n" +
+	"\x0euptime_seconds\x18\x03 \x01(\x01R\ruptimeSeconds\x12\x1e\n" +
+	"\n" +
+	"goroutines\x18\x04 \x01(\x05R\n" +
+	"goroutines\x122\n" +
+	"\bdb_pools\x18\x05 \x03(\v2\x17.user.DBPoolDiagnosticsR\adbPools\x12.\n" +
+	"\x06caches\x18\x06 \x03(\v2\x16.user.CacheDiagnosticsR\x06caches\"\x10\n" +
+	"\x0eGetJWKSRequest\"i\n" +
+	"\x03JWK\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03kty\x18\x02 \x01(\tR\x03kty\x12\x10\n" +
+	"\x03alg\x18\x03 \x01(\tR\x03alg\x12\x10\n" +
+	"\x03use\x18\x04 \x01(\tR\x03use\x12\f\n" +
+	"\x01n\x18\x05 \x01(\tR\x01n\x12\f\n" +
+	"\x01e\x18\x06 \x01(\tR\x01e\"0\n" +
+	"\x0fGetJWKSResponse\x12\x1d\n" +
+	"\x04keys\x18\x01 \x03(\v2\t.user.JWKR\x04keys2\xd0&\n" +
 	"\vUserService\x129\n" +
 	"\n" +
 	"CreateUser\x12\x17.user.CreateUserRequest\x1a\x12.user.UserResponse\x123\n" +
@@ -2439,7 +9518,8 @@ const file_proto_user_proto_rawDesc = "" +
 	"DeleteUser\x12\x17.user.DeleteUserRequest\x1a\x14.user.DeleteResponse\x12A\n" +
 	"\x0eGetUserByEmail\x12\x1b.user.GetUserByEmailRequest\x1a\x12.user.UserResponse\x120\n" +
 	"\x05Login\x12\x12.user.LoginRequest\x1a\x13.user.LoginResponse\x12E\n" +
-	"\fRefreshToken\x12\x19.user.RefreshTokenRequest\x1a\x1a.user.RefreshTokenResponse\x12<\n" +
+	"\fRefreshToken\x12\x19.user.RefreshTokenRequest\x1a\x1a.user.RefreshTokenResponse\x126\n" +
+	"\aGetJWKS\x12\x14.user.GetJWKSRequest\x1a\x15.user.GetJWKSResponse\x12<\n" +
 	"\n" +
 	"AddAddress\x12\x17.user.AddAddressRequest\x1a\x15.user.AddressResponse\x12D\n" +
 	"\fGetAddresses\x12\x19.user.GetAddressesRequest\x1a\x19.user.AddressListResponse\x12B\n" +
@@ -2448,8 +9528,59 @@ const file_proto_user_proto_rawDesc = "" +
 	"\x10AddPaymentMethod\x12\x1d.user.AddPaymentMethodRequest\x1a\x1b.user.PaymentMethodResponse\x12T\n" +
 	"\x11GetPaymentMethods\x12\x1e.user.GetPaymentMethodsRequest\x1a\x1f.user.PaymentMethodListResponse\x12T\n" +
 	"\x13UpdatePaymentMethod\x12 .user.UpdatePaymentMethodRequest\x1a\x1b.user.PaymentMethodResponse\x12M\n" +
-	"\x13DeletePaymentMethod\x12 .user.DeletePaymentMethodRequest\x1a\x14.user.DeleteResponse\x12B\n" +
-	"\vHealthCheck\x12\x18.user.HealthCheckRequest\x1a\x19.user.HealthCheckResponseBBZ@github.com/louai60/e-commerce_project/backend/user-service/protob\x06proto3"
+	"\x13DeletePaymentMethod\x12 .user.DeletePaymentMethodRequest\x1a\x14.user.DeleteResponse\x12E\n" +
+	"\x0eCreateUserNote\x12\x1b.user.CreateUserNoteRequest\x1a\x16.user.UserNoteResponse\x12H\n" +
+	"\rListUserNotes\x12\x1a.user.ListUserNotesRequest\x1a\x1b.user.ListUserNotesResponse\x12E\n" +
+	"\x0eUpdateUserNote\x12\x1b.user.UpdateUserNoteRequest\x1a\x16.user.UserNoteResponse\x12C\n" +
+	"\x0eDeleteUserNote\x12\x1b.user.DeleteUserNoteRequest\x1a\x14.user.DeleteResponse\x12H\n" +
+	"\x0eGetShopperList\x12\x1b.user.GetShopperListRequest\x1a\x19.user.ShopperListResponse\x12P\n" +
+	"\x12SetShopperListItem\x12\x1f.user.SetShopperListItemRequest\x1a\x19.user.ShopperListResponse\x12V\n" +
+	"\x15RemoveShopperListItem\x12\".user.RemoveShopperListItemRequest\x1a\x19.user.ShopperListResponse\x12K\n" +
+	"\x0eMergeGuestData\x12\x1b.user.MergeGuestDataRequest\x1a\x1c.user.MergeGuestDataResponse\x12c\n" +
+	"\x16ListCartReminderEvents\x12#.user.ListCartReminderEventsRequest\x1a$.user.ListCartReminderEventsResponse\x12]\n" +
+	"\x14RecordCartConversion\x12!.user.RecordCartConversionRequest\x1a\".user.RecordCartConversionResponse\x12Z\n" +
+	"\x14GetCartReminderStats\x12!.user.GetCartReminderStatsRequest\x1a\x1f.user.CartReminderStatsResponse\x12K\n" +
+	"\x0eListUserEvents\x12\x1b.user.ListUserEventsRequest\x1a\x1c.user.ListUserEventsResponse\x12;\n" +
+	"\vCreateStaff\x12\x18.user.CreateStaffRequest\x1a\x12.user.UserResponse\x12K\n" +
+	"\x13SetStaffPermissions\x12 .user.SetStaffPermissionsRequest\x1a\x12.user.UserResponse\x12<\n" +
+	"\tListStaff\x12\x16.user.ListStaffRequest\x1a\x17.user.ListStaffResponse\x12W\n" +
+	"\x12ListPermissionSets\x12\x1f.user.ListPermissionSetsRequest\x1a .user.ListPermissionSetsResponse\x12B\n" +
+	"\vImportUsers\x12\x18.user.ImportUsersRequest\x1a\x19.user.ImportUsersResponse\x12K\n" +
+	"\x10ResendInvitation\x12\x1d.user.ResendInvitationRequest\x1a\x18.user.InvitationResponse\x12E\n" +
+	"\x10AcceptInvitation\x12\x1d.user.AcceptInvitationRequest\x1a\x12.user.UserResponse\x12B\n" +
+	"\rCreateCompany\x12\x1a.user.CreateCompanyRequest\x1a\x15.user.CompanyResponse\x12B\n" +
+	"\rUpdateCompany\x12\x1a.user.UpdateCompanyRequest\x1a\x15.user.CompanyResponse\x12<\n" +
+	"\n" +
+	"GetCompany\x12\x17.user.GetCompanyRequest\x1a\x15.user.CompanyResponse\x12H\n" +
+	"\rListCompanies\x12\x1a.user.ListCompaniesRequest\x1a\x1b.user.ListCompaniesResponse\x12N\n" +
+	"\x10SetCompanyMember\x12\x1d.user.SetCompanyMemberRequest\x1a\x1b.user.CompanyMemberResponse\x12M\n" +
+	"\x13RemoveCompanyMember\x12 .user.RemoveCompanyMemberRequest\x1a\x14.user.DeleteResponse\x12Q\n" +
+	"\x11AddCompanyAddress\x12\x1e.user.AddCompanyAddressRequest\x1a\x1c.user.CompanyAddressResponse\x12O\n" +
+	"\x14RemoveCompanyAddress\x12!.user.RemoveCompanyAddressRequest\x1a\x14.user.DeleteResponse\x12D\n" +
+	"\x0eGetUserCompany\x12\x1b.user.GetUserCompanyRequest\x1a\x15.user.CompanyResponse\x12_\n" +
+	"\x17RequestPurchaseApproval\x12$.user.RequestPurchaseApprovalRequest\x1a\x1e.user.PurchaseApprovalResponse\x12]\n" +
+	"\x16DecidePurchaseApproval\x12#.user.DecidePurchaseApprovalRequest\x1a\x1e.user.PurchaseApprovalResponse\x12`\n" +
+	"\x15ListPurchaseApprovals\x12\".user.ListPurchaseApprovalsRequest\x1a#.user.ListPurchaseApprovalsResponse\x12>\n" +
+	"\fRequestQuote\x12\x19.user.RequestQuoteRequest\x1a\x13.user.QuoteResponse\x12B\n" +
+	"\x0eRespondToQuote\x12\x1b.user.RespondToQuoteRequest\x1a\x13.user.QuoteResponse\x12<\n" +
+	"\vAcceptQuote\x12\x18.user.QuoteActionRequest\x1a\x13.user.QuoteResponse\x12=\n" +
+	"\fDeclineQuote\x12\x18.user.QuoteActionRequest\x1a\x13.user.QuoteResponse\x12>\n" +
+	"\fConvertQuote\x12\x19.user.ConvertQuoteRequest\x1a\x13.user.QuoteResponse\x126\n" +
+	"\bGetQuote\x12\x15.user.GetQuoteRequest\x1a\x13.user.QuoteResponse\x12?\n" +
+	"\n" +
+	"ListQuotes\x12\x17.user.ListQuotesRequest\x1a\x18.user.ListQuotesResponse\x12N\n" +
+	"\x0fListQuoteEvents\x12\x1c.user.ListQuoteEventsRequest\x1a\x1d.user.ListQuoteEventsResponse\x12J\n" +
+	"\x0eSetCreditLimit\x12\x1b.user.SetCreditLimitRequest\x1a\x1b.user.CreditAccountResponse\x12N\n" +
+	"\x10GetCreditAccount\x12\x1d.user.GetCreditAccountRequest\x1a\x1b.user.CreditAccountResponse\x12E\n" +
+	"\fChargeCredit\x12\x19.user.ChargeCreditRequest\x1a\x1a.user.CreditChargeResponse\x12T\n" +
+	"\x13RecordCreditPayment\x12 .user.RecordCreditPaymentRequest\x1a\x1b.user.CreditPaymentResponse\x12T\n" +
+	"\x12GetCreditStatement\x12\x1f.user.GetCreditStatementRequest\x1a\x1d.user.CreditStatementResponse\x12Q\n" +
+	"\x10ListCreditEvents\x12\x1d.user.ListCreditEventsRequest\x1a\x1e.user.ListCreditEventsResponse\x12J\n" +
+	"\x10RecordOrderEvent\x12\x1d.user.RecordOrderEventRequest\x1a\x17.user.UserStatsResponse\x12B\n" +
+	"\fGetUserStats\x12\x19.user.GetUserStatsRequest\x1a\x17.user.UserStatsResponse\x12H\n" +
+	"\rListUserStats\x12\x1a.user.ListUserStatsRequest\x1a\x1b.user.ListUserStatsResponse\x12B\n" +
+	"\vHealthCheck\x12\x18.user.HealthCheckRequest\x1a\x19.user.HealthCheckResponse\x12H\n" +
+	"\x0eGetDiagnostics\x12\x1b.user.GetDiagnosticsRequest\x1a\x19.user.DiagnosticsResponseBBZ@github.com/louai60/e-commerce_project/backend/user-service/protob\x06proto3"
 
 var (
 	file_proto_user_proto_rawDescOnce sync.Once
@@ -2463,91 +9594,332 @@ func file_proto_user_proto_rawDescGZIP() []byte {
 	return file_proto_user_proto_rawDescData
 }
 
-var file_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_proto_user_proto_msgTypes = make([]protoimpl.MessageInfo, 133)
 var file_proto_user_proto_goTypes = []any{
-	(*DeleteResponse)(nil),             // 0: user.DeleteResponse
-	(*RefreshTokenRequest)(nil),        // 1: user.RefreshTokenRequest
-	(*RefreshTokenResponse)(nil),       // 2: user.RefreshTokenResponse
-	(*User)(nil),                       // 3: user.User
-	(*CreateUserRequest)(nil),          // 4: user.CreateUserRequest
-	(*UserResponse)(nil),               // 5: user.UserResponse
-	(*GetUserRequest)(nil),             // 6: user.GetUserRequest
-	(*GetUserByEmailRequest)(nil),      // 7: user.GetUserByEmailRequest
-	(*ListUsersRequest)(nil),           // 8: user.ListUsersRequest
-	(*ListUsersResponse)(nil),          // 9: user.ListUsersResponse
-	(*UpdateUserRequest)(nil),          // 10: user.UpdateUserRequest
-	(*DeleteUserRequest)(nil),          // 11: user.DeleteUserRequest
-	(*LoginRequest)(nil),               // 12: user.LoginRequest
-	(*LoginResponse)(nil),              // 13: user.LoginResponse
-	(*Cookie)(nil),                     // 14: user.Cookie
-	(*CookieInfo)(nil),                 // 15: user.CookieInfo
-	(*Address)(nil),                    // 16: user.Address
-	(*AddAddressRequest)(nil),          // 17: user.AddAddressRequest
-	(*AddressResponse)(nil),            // 18: user.AddressResponse
-	(*GetAddressesRequest)(nil),        // 19: user.GetAddressesRequest
-	(*AddressListResponse)(nil),        // 20: user.AddressListResponse
-	(*UpdateAddressRequest)(nil),       // 21: user.UpdateAddressRequest
-	(*DeleteAddressRequest)(nil),       // 22: user.DeleteAddressRequest
-	(*PaymentMethod)(nil),              // 23: user.PaymentMethod
-	(*AddPaymentMethodRequest)(nil),    // 24: user.AddPaymentMethodRequest
-	(*PaymentMethodResponse)(nil),      // 25: user.PaymentMethodResponse
-	(*GetPaymentMethodsRequest)(nil),   // 26: user.GetPaymentMethodsRequest
-	(*PaymentMethodListResponse)(nil),  // 27: user.PaymentMethodListResponse
-	(*UpdatePaymentMethodRequest)(nil), // 28: user.UpdatePaymentMethodRequest
-	(*DeletePaymentMethodRequest)(nil), // 29: user.DeletePaymentMethodRequest
-	(*HealthCheckRequest)(nil),         // 30: user.HealthCheckRequest
-	(*HealthCheckResponse)(nil),        // 31: user.HealthCheckResponse
+	(*DeleteResponse)(nil),                 // 0: user.DeleteResponse
+	(*RefreshTokenRequest)(nil),            // 1: user.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),           // 2: user.RefreshTokenResponse
+	(*User)(nil),                           // 3: user.User
+	(*CreateUserRequest)(nil),              // 4: user.CreateUserRequest
+	(*UserResponse)(nil),                   // 5: user.UserResponse
+	(*GetUserRequest)(nil),                 // 6: user.GetUserRequest
+	(*GetUserByEmailRequest)(nil),          // 7: user.GetUserByEmailRequest
+	(*ListUsersRequest)(nil),               // 8: user.ListUsersRequest
+	(*ListUsersResponse)(nil),              // 9: user.ListUsersResponse
+	(*UpdateUserRequest)(nil),              // 10: user.UpdateUserRequest
+	(*DeleteUserRequest)(nil),              // 11: user.DeleteUserRequest
+	(*LoginRequest)(nil),                   // 12: user.LoginRequest
+	(*LoginResponse)(nil),                  // 13: user.LoginResponse
+	(*Cookie)(nil),                         // 14: user.Cookie
+	(*CookieInfo)(nil),                     // 15: user.CookieInfo
+	(*Address)(nil),                        // 16: user.Address
+	(*AddAddressRequest)(nil),              // 17: user.AddAddressRequest
+	(*AddressResponse)(nil),                // 18: user.AddressResponse
+	(*GetAddressesRequest)(nil),            // 19: user.GetAddressesRequest
+	(*AddressListResponse)(nil),            // 20: user.AddressListResponse
+	(*UpdateAddressRequest)(nil),           // 21: user.UpdateAddressRequest
+	(*DeleteAddressRequest)(nil),           // 22: user.DeleteAddressRequest
+	(*UserNote)(nil),                       // 23: user.UserNote
+	(*CreateUserNoteRequest)(nil),          // 24: user.CreateUserNoteRequest
+	(*ListUserNotesRequest)(nil),           // 25: user.ListUserNotesRequest
+	(*ListUserNotesResponse)(nil),          // 26: user.ListUserNotesResponse
+	(*UpdateUserNoteRequest)(nil),          // 27: user.UpdateUserNoteRequest
+	(*DeleteUserNoteRequest)(nil),          // 28: user.DeleteUserNoteRequest
+	(*UserNoteResponse)(nil),               // 29: user.UserNoteResponse
+	(*ShopperListItem)(nil),                // 30: user.ShopperListItem
+	(*PersonalizationValue)(nil),           // 31: user.PersonalizationValue
+	(*GetShopperListRequest)(nil),          // 32: user.GetShopperListRequest
+	(*SetShopperListItemRequest)(nil),      // 33: user.SetShopperListItemRequest
+	(*RemoveShopperListItemRequest)(nil),   // 34: user.RemoveShopperListItemRequest
+	(*ShopperListResponse)(nil),            // 35: user.ShopperListResponse
+	(*MergeGuestDataRequest)(nil),          // 36: user.MergeGuestDataRequest
+	(*MergeGuestDataResponse)(nil),         // 37: user.MergeGuestDataResponse
+	(*CartReminderEvent)(nil),              // 38: user.CartReminderEvent
+	(*ListCartReminderEventsRequest)(nil),  // 39: user.ListCartReminderEventsRequest
+	(*ListCartReminderEventsResponse)(nil), // 40: user.ListCartReminderEventsResponse
+	(*RecordCartConversionRequest)(nil),    // 41: user.RecordCartConversionRequest
+	(*RecordCartConversionResponse)(nil),   // 42: user.RecordCartConversionResponse
+	(*GetCartReminderStatsRequest)(nil),    // 43: user.GetCartReminderStatsRequest
+	(*CartReminderStatsResponse)(nil),      // 44: user.CartReminderStatsResponse
+	(*UserEvent)(nil),                      // 45: user.UserEvent
+	(*ListUserEventsRequest)(nil),          // 46: user.ListUserEventsRequest
+	(*ListUserEventsResponse)(nil),         // 47: user.ListUserEventsResponse
+	(*CreateStaffRequest)(nil),             // 48: user.CreateStaffRequest
+	(*SetStaffPermissionsRequest)(nil),     // 49: user.SetStaffPermissionsRequest
+	(*ListStaffRequest)(nil),               // 50: user.ListStaffRequest
+	(*ListStaffResponse)(nil),              // 51: user.ListStaffResponse
+	(*PermissionSet)(nil),                  // 52: user.PermissionSet
+	(*ListPermissionSetsRequest)(nil),      // 53: user.ListPermissionSetsRequest
+	(*ListPermissionSetsResponse)(nil),     // 54: user.ListPermissionSetsResponse
+	(*ImportUsersRequest)(nil),             // 55: user.ImportUsersRequest
+	(*ImportRowResult)(nil),                // 56: user.ImportRowResult
+	(*ImportUsersResponse)(nil),            // 57: user.ImportUsersResponse
+	(*ResendInvitationRequest)(nil),        // 58: user.ResendInvitationRequest
+	(*InvitationResponse)(nil),             // 59: user.InvitationResponse
+	(*AcceptInvitationRequest)(nil),        // 60: user.AcceptInvitationRequest
+	(*UserStats)(nil),                      // 61: user.UserStats
+	(*UserStatsResponse)(nil),              // 62: user.UserStatsResponse
+	(*RecordOrderEventRequest)(nil),        // 63: user.RecordOrderEventRequest
+	(*GetUserStatsRequest)(nil),            // 64: user.GetUserStatsRequest
+	(*ListUserStatsRequest)(nil),           // 65: user.ListUserStatsRequest
+	(*ListUserStatsResponse)(nil),          // 66: user.ListUserStatsResponse
+	(*CompanyMember)(nil),                  // 67: user.CompanyMember
+	(*CompanyAddress)(nil),                 // 68: user.CompanyAddress
+	(*Company)(nil),                        // 69: user.Company
+	(*CompanyResponse)(nil),                // 70: user.CompanyResponse
+	(*CreateCompanyRequest)(nil),           // 71: user.CreateCompanyRequest
+	(*UpdateCompanyRequest)(nil),           // 72: user.UpdateCompanyRequest
+	(*GetCompanyRequest)(nil),              // 73: user.GetCompanyRequest
+	(*ListCompaniesRequest)(nil),           // 74: user.ListCompaniesRequest
+	(*ListCompaniesResponse)(nil),          // 75: user.ListCompaniesResponse
+	(*SetCompanyMemberRequest)(nil),        // 76: user.SetCompanyMemberRequest
+	(*CompanyMemberResponse)(nil),          // 77: user.CompanyMemberResponse
+	(*RemoveCompanyMemberRequest)(nil),     // 78: user.RemoveCompanyMemberRequest
+	(*AddCompanyAddressRequest)(nil),       // 79: user.AddCompanyAddressRequest
+	(*CompanyAddressResponse)(nil),         // 80: user.CompanyAddressResponse
+	(*RemoveCompanyAddressRequest)(nil),    // 81: user.RemoveCompanyAddressRequest
+	(*GetUserCompanyRequest)(nil),          // 82: user.GetUserCompanyRequest
+	(*PurchaseApproval)(nil),               // 83: user.PurchaseApproval
+	(*PurchaseApprovalResponse)(nil),       // 84: user.PurchaseApprovalResponse
+	(*RequestPurchaseApprovalRequest)(nil), // 85: user.RequestPurchaseApprovalRequest
+	(*DecidePurchaseApprovalRequest)(nil),  // 86: user.DecidePurchaseApprovalRequest
+	(*ListPurchaseApprovalsRequest)(nil),   // 87: user.ListPurchaseApprovalsRequest
+	(*ListPurchaseApprovalsResponse)(nil),  // 88: user.ListPurchaseApprovalsResponse
+	(*QuoteItem)(nil),                      // 89: user.QuoteItem
+	(*Quote)(nil),                          // 90: user.Quote
+	(*QuoteResponse)(nil),                  // 91: user.QuoteResponse
+	(*RequestQuoteRequest)(nil),            // 92: user.RequestQuoteRequest
+	(*RespondToQuoteRequest)(nil),          // 93: user.RespondToQuoteRequest
+	(*QuoteActionRequest)(nil),             // 94: user.QuoteActionRequest
+	(*ConvertQuoteRequest)(nil),            // 95: user.ConvertQuoteRequest
+	(*GetQuoteRequest)(nil),                // 96: user.GetQuoteRequest
+	(*ListQuotesRequest)(nil),              // 97: user.ListQuotesRequest
+	(*ListQuotesResponse)(nil),             // 98: user.ListQuotesResponse
+	(*QuoteEvent)(nil),                     // 99: user.QuoteEvent
+	(*ListQuoteEventsRequest)(nil),         // 100: user.ListQuoteEventsRequest
+	(*ListQuoteEventsResponse)(nil),        // 101: user.ListQuoteEventsResponse
+	(*CreditAccount)(nil),                  // 102: user.CreditAccount
+	(*CreditCharge)(nil),                   // 103: user.CreditCharge
+	(*CreditPayment)(nil),                  // 104: user.CreditPayment
+	(*CreditAccountResponse)(nil),          // 105: user.CreditAccountResponse
+	(*CreditChargeResponse)(nil),           // 106: user.CreditChargeResponse
+	(*CreditPaymentResponse)(nil),          // 107: user.CreditPaymentResponse
+	(*SetCreditLimitRequest)(nil),          // 108: user.SetCreditLimitRequest
+	(*GetCreditAccountRequest)(nil),        // 109: user.GetCreditAccountRequest
+	(*ChargeCreditRequest)(nil),            // 110: user.ChargeCreditRequest
+	(*RecordCreditPaymentRequest)(nil),     // 111: user.RecordCreditPaymentRequest
+	(*GetCreditStatementRequest)(nil),      // 112: user.GetCreditStatementRequest
+	(*CreditStatementResponse)(nil),        // 113: user.CreditStatementResponse
+	(*CreditEvent)(nil),                    // 114: user.CreditEvent
+	(*ListCreditEventsRequest)(nil),        // 115: user.ListCreditEventsRequest
+	(*ListCreditEventsResponse)(nil),       // 116: user.ListCreditEventsResponse
+	(*PaymentMethod)(nil),                  // 117: user.PaymentMethod
+	(*AddPaymentMethodRequest)(nil),        // 118: user.AddPaymentMethodRequest
+	(*PaymentMethodResponse)(nil),          // 119: user.PaymentMethodResponse
+	(*GetPaymentMethodsRequest)(nil),       // 120: user.GetPaymentMethodsRequest
+	(*PaymentMethodListResponse)(nil),      // 121: user.PaymentMethodListResponse
+	(*UpdatePaymentMethodRequest)(nil),     // 122: user.UpdatePaymentMethodRequest
+	(*DeletePaymentMethodRequest)(nil),     // 123: user.DeletePaymentMethodRequest
+	(*HealthCheckRequest)(nil),             // 124: user.HealthCheckRequest
+	(*HealthCheckResponse)(nil),            // 125: user.HealthCheckResponse
+	(*GetDiagnosticsRequest)(nil),          // 126: user.GetDiagnosticsRequest
+	(*DBPoolDiagnostics)(nil),              // 127: user.DBPoolDiagnostics
+	(*CacheDiagnostics)(nil),               // 128: user.CacheDiagnostics
+	(*DiagnosticsResponse)(nil),            // 129: user.DiagnosticsResponse
+	(*GetJWKSRequest)(nil),                 // 130: user.GetJWKSRequest
+	(*JWK)(nil),                            // 131: user.JWK
+	(*GetJWKSResponse)(nil),                // 132: user.GetJWKSResponse
+	(*structpb.Struct)(nil),                // 133: google.protobuf.Struct
 }
 var file_proto_user_proto_depIdxs = []int32{
-	3,  // 0: user.RefreshTokenResponse.user:type_name -> user.User
-	15, // 1: user.RefreshTokenResponse.cookie:type_name -> user.CookieInfo
-	3,  // 2: user.UserResponse.user:type_name -> user.User
-	3,  // 3: user.ListUsersResponse.users:type_name -> user.User
-	3,  // 4: user.LoginResponse.user:type_name -> user.User
-	15, // 5: user.LoginResponse.cookie:type_name -> user.CookieInfo
-	16, // 6: user.AddressResponse.address:type_name -> user.Address
-	16, // 7: user.AddressListResponse.addresses:type_name -> user.Address
-	23, // 8: user.PaymentMethodResponse.payment_method:type_name -> user.PaymentMethod
-	23, // 9: user.PaymentMethodListResponse.payment_methods:type_name -> user.PaymentMethod
-	4,  // 10: user.UserService.CreateUser:input_type -> user.CreateUserRequest
-	6,  // 11: user.UserService.GetUser:input_type -> user.GetUserRequest
-	8,  // 12: user.UserService.ListUsers:input_type -> user.ListUsersRequest
-	10, // 13: user.UserService.UpdateUser:input_type -> user.UpdateUserRequest
-	11, // 14: user.UserService.DeleteUser:input_type -> user.DeleteUserRequest
-	7,  // 15: user.UserService.GetUserByEmail:input_type -> user.GetUserByEmailRequest
-	12, // 16: user.UserService.Login:input_type -> user.LoginRequest
-	1,  // 17: user.UserService.RefreshToken:input_type -> user.RefreshTokenRequest
-	17, // 18: user.UserService.AddAddress:input_type -> user.AddAddressRequest
-	19, // 19: user.UserService.GetAddresses:input_type -> user.GetAddressesRequest
-	21, // 20: user.UserService.UpdateAddress:input_type -> user.UpdateAddressRequest
-	22, // 21: user.UserService.DeleteAddress:input_type -> user.DeleteAddressRequest
-	24, // 22: user.UserService.AddPaymentMethod:input_type -> user.AddPaymentMethodRequest
-	26, // 23: user.UserService.GetPaymentMethods:input_type -> user.GetPaymentMethodsRequest
-	28, // 24: user.UserService.UpdatePaymentMethod:input_type -> user.UpdatePaymentMethodRequest
-	29, // 25: user.UserService.DeletePaymentMethod:input_type -> user.DeletePaymentMethodRequest
-	30, // 26: user.UserService.HealthCheck:input_type -> user.HealthCheckRequest
-	5,  // 27: user.UserService.CreateUser:output_type -> user.UserResponse
-	5,  // 28: user.UserService.GetUser:output_type -> user.UserResponse
-	9,  // 29: user.UserService.ListUsers:output_type -> user.ListUsersResponse
-	5,  // 30: user.UserService.UpdateUser:output_type -> user.UserResponse
-	0,  // 31: user.UserService.DeleteUser:output_type -> user.DeleteResponse
-	5,  // 32: user.UserService.GetUserByEmail:output_type -> user.UserResponse
-	13, // 33: user.UserService.Login:output_type -> user.LoginResponse
-	2,  // 34: user.UserService.RefreshToken:output_type -> user.RefreshTokenResponse
-	18, // 35: user.UserService.AddAddress:output_type -> user.AddressResponse
-	20, // 36: user.UserService.GetAddresses:output_type -> user.AddressListResponse
-	18, // 37: user.UserService.UpdateAddress:output_type -> user.AddressResponse
-	0,  // 38: user.UserService.DeleteAddress:output_type -> user.DeleteResponse
-	25, // 39: user.UserService.AddPaymentMethod:output_type -> user.PaymentMethodResponse
-	27, // 40: user.UserService.GetPaymentMethods:output_type -> user.PaymentMethodListResponse
-	25, // 41: user.UserService.UpdatePaymentMethod:output_type -> user.PaymentMethodResponse
-	0,  // 42: user.UserService.DeletePaymentMethod:output_type -> user.DeleteResponse
-	31, // 43: user.UserService.HealthCheck:output_type -> user.HealthCheckResponse
-	27, // [27:44] is the sub-list for method output_type
-	10, // [10:27] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	3,   // 0: user.RefreshTokenResponse.user:type_name -> user.User
+	15,  // 1: user.RefreshTokenResponse.cookie:type_name -> user.CookieInfo
+	133, // 2: user.User.metadata:type_name -> google.protobuf.Struct
+	3,   // 3: user.UserResponse.user:type_name -> user.User
+	3,   // 4: user.ListUsersResponse.users:type_name -> user.User
+	133, // 5: user.UpdateUserRequest.metadata:type_name -> google.protobuf.Struct
+	3,   // 6: user.LoginResponse.user:type_name -> user.User
+	15,  // 7: user.LoginResponse.cookie:type_name -> user.CookieInfo
+	16,  // 8: user.AddressResponse.address:type_name -> user.Address
+	16,  // 9: user.AddressListResponse.addresses:type_name -> user.Address
+	23,  // 10: user.ListUserNotesResponse.notes:type_name -> user.UserNote
+	23,  // 11: user.UserNoteResponse.note:type_name -> user.UserNote
+	31,  // 12: user.ShopperListItem.personalization:type_name -> user.PersonalizationValue
+	31,  // 13: user.SetShopperListItemRequest.personalization:type_name -> user.PersonalizationValue
+	30,  // 14: user.ShopperListResponse.items:type_name -> user.ShopperListItem
+	30,  // 15: user.CartReminderEvent.items:type_name -> user.ShopperListItem
+	38,  // 16: user.ListCartReminderEventsResponse.events:type_name -> user.CartReminderEvent
+	45,  // 17: user.ListUserEventsResponse.events:type_name -> user.UserEvent
+	3,   // 18: user.ListStaffResponse.staff:type_name -> user.User
+	52,  // 19: user.ListPermissionSetsResponse.permission_sets:type_name -> user.PermissionSet
+	56,  // 20: user.ImportUsersResponse.rows:type_name -> user.ImportRowResult
+	61,  // 21: user.UserStatsResponse.stats:type_name -> user.UserStats
+	61,  // 22: user.ListUserStatsResponse.stats:type_name -> user.UserStats
+	67,  // 23: user.Company.members:type_name -> user.CompanyMember
+	68,  // 24: user.Company.addresses:type_name -> user.CompanyAddress
+	69,  // 25: user.CompanyResponse.company:type_name -> user.Company
+	67,  // 26: user.CompanyResponse.membership:type_name -> user.CompanyMember
+	69,  // 27: user.ListCompaniesResponse.companies:type_name -> user.Company
+	67,  // 28: user.CompanyMemberResponse.member:type_name -> user.CompanyMember
+	68,  // 29: user.AddCompanyAddressRequest.address:type_name -> user.CompanyAddress
+	68,  // 30: user.CompanyAddressResponse.address:type_name -> user.CompanyAddress
+	83,  // 31: user.PurchaseApprovalResponse.approval:type_name -> user.PurchaseApproval
+	83,  // 32: user.ListPurchaseApprovalsResponse.approvals:type_name -> user.PurchaseApproval
+	89,  // 33: user.Quote.items:type_name -> user.QuoteItem
+	90,  // 34: user.QuoteResponse.quote:type_name -> user.Quote
+	89,  // 35: user.RespondToQuoteRequest.prices:type_name -> user.QuoteItem
+	90,  // 36: user.ListQuotesResponse.quotes:type_name -> user.Quote
+	99,  // 37: user.ListQuoteEventsResponse.events:type_name -> user.QuoteEvent
+	102, // 38: user.CreditAccountResponse.account:type_name -> user.CreditAccount
+	103, // 39: user.CreditChargeResponse.charge:type_name -> user.CreditCharge
+	104, // 40: user.CreditPaymentResponse.payment:type_name -> user.CreditPayment
+	103, // 41: user.CreditStatementResponse.charges:type_name -> user.CreditCharge
+	104, // 42: user.CreditStatementResponse.payments:type_name -> user.CreditPayment
+	114, // 43: user.ListCreditEventsResponse.events:type_name -> user.CreditEvent
+	117, // 44: user.PaymentMethodResponse.payment_method:type_name -> user.PaymentMethod
+	117, // 45: user.PaymentMethodListResponse.payment_methods:type_name -> user.PaymentMethod
+	127, // 46: user.DiagnosticsResponse.db_pools:type_name -> user.DBPoolDiagnostics
+	128, // 47: user.DiagnosticsResponse.caches:type_name -> user.CacheDiagnostics
+	131, // 48: user.GetJWKSResponse.keys:type_name -> user.JWK
+	4,   // 49: user.UserService.CreateUser:input_type -> user.CreateUserRequest
+	6,   // 50: user.UserService.GetUser:input_type -> user.GetUserRequest
+	8,   // 51: user.UserService.ListUsers:input_type -> user.ListUsersRequest
+	10,  // 52: user.UserService.UpdateUser:input_type -> user.UpdateUserRequest
+	11,  // 53: user.UserService.DeleteUser:input_type -> user.DeleteUserRequest
+	7,   // 54: user.UserService.GetUserByEmail:input_type -> user.GetUserByEmailRequest
+	12,  // 55: user.UserService.Login:input_type -> user.LoginRequest
+	1,   // 56: user.UserService.RefreshToken:input_type -> user.RefreshTokenRequest
+	130, // 57: user.UserService.GetJWKS:input_type -> user.GetJWKSRequest
+	17,  // 58: user.UserService.AddAddress:input_type -> user.AddAddressRequest
+	19,  // 59: user.UserService.GetAddresses:input_type -> user.GetAddressesRequest
+	21,  // 60: user.UserService.UpdateAddress:input_type -> user.UpdateAddressRequest
+	22,  // 61: user.UserService.DeleteAddress:input_type -> user.DeleteAddressRequest
+	118, // 62: user.UserService.AddPaymentMethod:input_type -> user.AddPaymentMethodRequest
+	120, // 63: user.UserService.GetPaymentMethods:input_type -> user.GetPaymentMethodsRequest
+	122, // 64: user.UserService.UpdatePaymentMethod:input_type -> user.UpdatePaymentMethodRequest
+	123, // 65: user.UserService.DeletePaymentMethod:input_type -> user.DeletePaymentMethodRequest
+	24,  // 66: user.UserService.CreateUserNote:input_type -> user.CreateUserNoteRequest
+	25,  // 67: user.UserService.ListUserNotes:input_type -> user.ListUserNotesRequest
+	27,  // 68: user.UserService.UpdateUserNote:input_type -> user.UpdateUserNoteRequest
+	28,  // 69: user.UserService.DeleteUserNote:input_type -> user.DeleteUserNoteRequest
+	32,  // 70: user.UserService.GetShopperList:input_type -> user.GetShopperListRequest
+	33,  // 71: user.UserService.SetShopperListItem:input_type -> user.SetShopperListItemRequest
+	34,  // 72: user.UserService.RemoveShopperListItem:input_type -> user.RemoveShopperListItemRequest
+	36,  // 73: user.UserService.MergeGuestData:input_type -> user.MergeGuestDataRequest
+	39,  // 74: user.UserService.ListCartReminderEvents:input_type -> user.ListCartReminderEventsRequest
+	41,  // 75: user.UserService.RecordCartConversion:input_type -> user.RecordCartConversionRequest
+	43,  // 76: user.UserService.GetCartReminderStats:input_type -> user.GetCartReminderStatsRequest
+	46,  // 77: user.UserService.ListUserEvents:input_type -> user.ListUserEventsRequest
+	48,  // 78: user.UserService.CreateStaff:input_type -> user.CreateStaffRequest
+	49,  // 79: user.UserService.SetStaffPermissions:input_type -> user.SetStaffPermissionsRequest
+	50,  // 80: user.UserService.ListStaff:input_type -> user.ListStaffRequest
+	53,  // 81: user.UserService.ListPermissionSets:input_type -> user.ListPermissionSetsRequest
+	55,  // 82: user.UserService.ImportUsers:input_type -> user.ImportUsersRequest
+	58,  // 83: user.UserService.ResendInvitation:input_type -> user.ResendInvitationRequest
+	60,  // 84: user.UserService.AcceptInvitation:input_type -> user.AcceptInvitationRequest
+	71,  // 85: user.UserService.CreateCompany:input_type -> user.CreateCompanyRequest
+	72,  // 86: user.UserService.UpdateCompany:input_type -> user.UpdateCompanyRequest
+	73,  // 87: user.UserService.GetCompany:input_type -> user.GetCompanyRequest
+	74,  // 88: user.UserService.ListCompanies:input_type -> user.ListCompaniesRequest
+	76,  // 89: user.UserService.SetCompanyMember:input_type -> user.SetCompanyMemberRequest
+	78,  // 90: user.UserService.RemoveCompanyMember:input_type -> user.RemoveCompanyMemberRequest
+	79,  // 91: user.UserService.AddCompanyAddress:input_type -> user.AddCompanyAddressRequest
+	81,  // 92: user.UserService.RemoveCompanyAddress:input_type -> user.RemoveCompanyAddressRequest
+	82,  // 93: user.UserService.GetUserCompany:input_type -> user.GetUserCompanyRequest
+	85,  // 94: user.UserService.RequestPurchaseApproval:input_type -> user.RequestPurchaseApprovalRequest
+	86,  // 95: user.UserService.DecidePurchaseApproval:input_type -> user.DecidePurchaseApprovalRequest
+	87,  // 96: user.UserService.ListPurchaseApprovals:input_type -> user.ListPurchaseApprovalsRequest
+	92,  // 97: user.UserService.RequestQuote:input_type -> user.RequestQuoteRequest
+	93,  // 98: user.UserService.RespondToQuote:input_type -> user.RespondToQuoteRequest
+	94,  // 99: user.UserService.AcceptQuote:input_type -> user.QuoteActionRequest
+	94,  // 100: user.UserService.DeclineQuote:input_type -> user.QuoteActionRequest
+	95,  // 101: user.UserService.ConvertQuote:input_type -> user.ConvertQuoteRequest
+	96,  // 102: user.UserService.GetQuote:input_type -> user.GetQuoteRequest
+	97,  // 103: user.UserService.ListQuotes:input_type -> user.ListQuotesRequest
+	100, // 104: user.UserService.ListQuoteEvents:input_type -> user.ListQuoteEventsRequest
+	108, // 105: user.UserService.SetCreditLimit:input_type -> user.SetCreditLimitRequest
+	109, // 106: user.UserService.GetCreditAccount:input_type -> user.GetCreditAccountRequest
+	110, // 107: user.UserService.ChargeCredit:input_type -> user.ChargeCreditRequest
+	111, // 108: user.UserService.RecordCreditPayment:input_type -> user.RecordCreditPaymentRequest
+	112, // 109: user.UserService.GetCreditStatement:input_type -> user.GetCreditStatementRequest
+	115, // 110: user.UserService.ListCreditEvents:input_type -> user.ListCreditEventsRequest
+	63,  // 111: user.UserService.RecordOrderEvent:input_type -> user.RecordOrderEventRequest
+	64,  // 112: user.UserService.GetUserStats:input_type -> user.GetUserStatsRequest
+	65,  // 113: user.UserService.ListUserStats:input_type -> user.ListUserStatsRequest
+	124, // 114: user.UserService.HealthCheck:input_type -> user.HealthCheckRequest
+	126, // 115: user.UserService.GetDiagnostics:input_type -> user.GetDiagnosticsRequest
+	5,   // 116: user.UserService.CreateUser:output_type -> user.UserResponse
+	5,   // 117: user.UserService.GetUser:output_type -> user.UserResponse
+	9,   // 118: user.UserService.ListUsers:output_type -> user.ListUsersResponse
+	5,   // 119: user.UserService.UpdateUser:output_type -> user.UserResponse
+	0,   // 120: user.UserService.DeleteUser:output_type -> user.DeleteResponse
+	5,   // 121: user.UserService.GetUserByEmail:output_type -> user.UserResponse
+	13,  // 122: user.UserService.Login:output_type -> user.LoginResponse
+	2,   // 123: user.UserService.RefreshToken:output_type -> user.RefreshTokenResponse
+	132, // 124: user.UserService.GetJWKS:output_type -> user.GetJWKSResponse
+	18,  // 125: user.UserService.AddAddress:output_type -> user.AddressResponse
+	20,  // 126: user.UserService.GetAddresses:output_type -> user.AddressListResponse
+	18,  // 127: user.UserService.UpdateAddress:output_type -> user.AddressResponse
+	0,   // 128: user.UserService.DeleteAddress:output_type -> user.DeleteResponse
+	119, // 129: user.UserService.AddPaymentMethod:output_type -> user.PaymentMethodResponse
+	121, // 130: user.UserService.GetPaymentMethods:output_type -> user.PaymentMethodListResponse
+	119, // 131: user.UserService.UpdatePaymentMethod:output_type -> user.PaymentMethodResponse
+	0,   // 132: user.UserService.DeletePaymentMethod:output_type -> user.DeleteResponse
+	29,  // 133: user.UserService.CreateUserNote:output_type -> user.UserNoteResponse
+	26,  // 134: user.UserService.ListUserNotes:output_type -> user.ListUserNotesResponse
+	29,  // 135: user.UserService.UpdateUserNote:output_type -> user.UserNoteResponse
+	0,   // 136: user.UserService.DeleteUserNote:output_type -> user.DeleteResponse
+	35,  // 137: user.UserService.GetShopperList:output_type -> user.ShopperListResponse
+	35,  // 138: user.UserService.SetShopperListItem:output_type -> user.ShopperListResponse
+	35,  // 139: user.UserService.RemoveShopperListItem:output_type -> user.ShopperListResponse
+	37,  // 140: user.UserService.MergeGuestData:output_type -> user.MergeGuestDataResponse
+	40,  // 141: user.UserService.ListCartReminderEvents:output_type -> user.ListCartReminderEventsResponse
+	42,  // 142: user.UserService.RecordCartConversion:output_type -> user.RecordCartConversionResponse
+	44,  // 143: user.UserService.GetCartReminderStats:output_type -> user.CartReminderStatsResponse
+	47,  // 144: user.UserService.ListUserEvents:output_type -> user.ListUserEventsResponse
+	5,   // 145: user.UserService.CreateStaff:output_type -> user.UserResponse
+	5,   // 146: user.UserService.SetStaffPermissions:output_type -> user.UserResponse
+	51,  // 147: user.UserService.ListStaff:output_type -> user.ListStaffResponse
+	54,  // 148: user.UserService.ListPermissionSets:output_type -> user.ListPermissionSetsResponse
+	57,  // 149: user.UserService.ImportUsers:output_type -> user.ImportUsersResponse
+	59,  // 150: user.UserService.ResendInvitation:output_type -> user.InvitationResponse
+	5,   // 151: user.UserService.AcceptInvitation:output_type -> user.UserResponse
+	70,  // 152: user.UserService.CreateCompany:output_type -> user.CompanyResponse
+	70,  // 153: user.UserService.UpdateCompany:output_type -> user.CompanyResponse
+	70,  // 154: user.UserService.GetCompany:output_type -> user.CompanyResponse
+	75,  // 155: user.UserService.ListCompanies:output_type -> user.ListCompaniesResponse
+	77,  // 156: user.UserService.SetCompanyMember:output_type -> user.CompanyMemberResponse
+	0,   // 157: user.UserService.RemoveCompanyMember:output_type -> user.DeleteResponse
+	80,  // 158: user.UserService.AddCompanyAddress:output_type -> user.CompanyAddressResponse
+	0,   // 159: user.UserService.RemoveCompanyAddress:output_type -> user.DeleteResponse
+	70,  // 160: user.UserService.GetUserCompany:output_type -> user.CompanyResponse
+	84,  // 161: user.UserService.RequestPurchaseApproval:output_type -> user.PurchaseApprovalResponse
+	84,  // 162: user.UserService.DecidePurchaseApproval:output_type -> user.PurchaseApprovalResponse
+	88,  // 163: user.UserService.ListPurchaseApprovals:output_type -> user.ListPurchaseApprovalsResponse
+	91,  // 164: user.UserService.RequestQuote:output_type -> user.QuoteResponse
+	91,  // 165: user.UserService.RespondToQuote:output_type -> user.QuoteResponse
+	91,  // 166: user.UserService.AcceptQuote:output_type -> user.QuoteResponse
+	91,  // 167: user.UserService.DeclineQuote:output_type -> user.QuoteResponse
+	91,  // 168: user.UserService.ConvertQuote:output_type -> user.QuoteResponse
+	91,  // 169: user.UserService.GetQuote:output_type -> user.QuoteResponse
+	98,  // 170: user.UserService.ListQuotes:output_type -> user.ListQuotesResponse
+	101, // 171: user.UserService.ListQuoteEvents:output_type -> user.ListQuoteEventsResponse
+	105, // 172: user.UserService.SetCreditLimit:output_type -> user.CreditAccountResponse
+	105, // 173: user.UserService.GetCreditAccount:output_type -> user.CreditAccountResponse
+	106, // 174: user.UserService.ChargeCredit:output_type -> user.CreditChargeResponse
+	107, // 175: user.UserService.RecordCreditPayment:output_type -> user.CreditPaymentResponse
+	113, // 176: user.UserService.GetCreditStatement:output_type -> user.CreditStatementResponse
+	116, // 177: user.UserService.ListCreditEvents:output_type -> user.ListCreditEventsResponse
+	62,  // 178: user.UserService.RecordOrderEvent:output_type -> user.UserStatsResponse
+	62,  // 179: user.UserService.GetUserStats:output_type -> user.UserStatsResponse
+	66,  // 180: user.UserService.ListUserStats:output_type -> user.ListUserStatsResponse
+	125, // 181: user.UserService.HealthCheck:output_type -> user.HealthCheckResponse
+	129, // 182: user.UserService.GetDiagnostics:output_type -> user.DiagnosticsResponse
+	116, // [116:183] is the sub-list for method output_type
+	49,  // [49:116] is the sub-list for method input_type
+	49,  // [49:49] is the sub-list for extension type_name
+	49,  // [49:49] is the sub-list for extension extendee
+	0,   // [0:49] is the sub-list for field type_name
 }
 
 func init() { file_proto_user_proto_init() }
@@ -2561,7 +9933,7 @@ func file_proto_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_user_proto_rawDesc), len(file_proto_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   133,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,23 +19,73 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserService_CreateUser_FullMethodName          = "/user.UserService/CreateUser"
-	UserService_GetUser_FullMethodName             = "/user.UserService/GetUser"
-	UserService_ListUsers_FullMethodName           = "/user.UserService/ListUsers"
-	UserService_UpdateUser_FullMethodName          = "/user.UserService/UpdateUser"
-	UserService_DeleteUser_FullMethodName          = "/user.UserService/DeleteUser"
-	UserService_GetUserByEmail_FullMethodName      = "/user.UserService/GetUserByEmail"
-	UserService_Login_FullMethodName               = "/user.UserService/Login"
-	UserService_RefreshToken_FullMethodName        = "/user.UserService/RefreshToken"
-	UserService_AddAddress_FullMethodName          = "/user.UserService/AddAddress"
-	UserService_GetAddresses_FullMethodName        = "/user.UserService/GetAddresses"
-	UserService_UpdateAddress_FullMethodName       = "/user.UserService/UpdateAddress"
-	UserService_DeleteAddress_FullMethodName       = "/user.UserService/DeleteAddress"
-	UserService_AddPaymentMethod_FullMethodName    = "/user.UserService/AddPaymentMethod"
-	UserService_GetPaymentMethods_FullMethodName   = "/user.UserService/GetPaymentMethods"
-	UserService_UpdatePaymentMethod_FullMethodName = "/user.UserService/UpdatePaymentMethod"
-	UserService_DeletePaymentMethod_FullMethodName = "/user.UserService/DeletePaymentMethod"
-	UserService_HealthCheck_FullMethodName         = "/user.UserService/HealthCheck"
+	UserService_CreateUser_FullMethodName              = "/user.UserService/CreateUser"
+	UserService_GetUser_FullMethodName                 = "/user.UserService/GetUser"
+	UserService_ListUsers_FullMethodName               = "/user.UserService/ListUsers"
+	UserService_UpdateUser_FullMethodName              = "/user.UserService/UpdateUser"
+	UserService_DeleteUser_FullMethodName              = "/user.UserService/DeleteUser"
+	UserService_GetUserByEmail_FullMethodName          = "/user.UserService/GetUserByEmail"
+	UserService_Login_FullMethodName                   = "/user.UserService/Login"
+	UserService_RefreshToken_FullMethodName            = "/user.UserService/RefreshToken"
+	UserService_GetJWKS_FullMethodName                 = "/user.UserService/GetJWKS"
+	UserService_AddAddress_FullMethodName              = "/user.UserService/AddAddress"
+	UserService_GetAddresses_FullMethodName            = "/user.UserService/GetAddresses"
+	UserService_UpdateAddress_FullMethodName           = "/user.UserService/UpdateAddress"
+	UserService_DeleteAddress_FullMethodName           = "/user.UserService/DeleteAddress"
+	UserService_AddPaymentMethod_FullMethodName        = "/user.UserService/AddPaymentMethod"
+	UserService_GetPaymentMethods_FullMethodName       = "/user.UserService/GetPaymentMethods"
+	UserService_UpdatePaymentMethod_FullMethodName     = "/user.UserService/UpdatePaymentMethod"
+	UserService_DeletePaymentMethod_FullMethodName     = "/user.UserService/DeletePaymentMethod"
+	UserService_CreateUserNote_FullMethodName          = "/user.UserService/CreateUserNote"
+	UserService_ListUserNotes_FullMethodName           = "/user.UserService/ListUserNotes"
+	UserService_UpdateUserNote_FullMethodName          = "/user.UserService/UpdateUserNote"
+	UserService_DeleteUserNote_FullMethodName          = "/user.UserService/DeleteUserNote"
+	UserService_GetShopperList_FullMethodName          = "/user.UserService/GetShopperList"
+	UserService_SetShopperListItem_FullMethodName      = "/user.UserService/SetShopperListItem"
+	UserService_RemoveShopperListItem_FullMethodName   = "/user.UserService/RemoveShopperListItem"
+	UserService_MergeGuestData_FullMethodName          = "/user.UserService/MergeGuestData"
+	UserService_ListCartReminderEvents_FullMethodName  = "/user.UserService/ListCartReminderEvents"
+	UserService_RecordCartConversion_FullMethodName    = "/user.UserService/RecordCartConversion"
+	UserService_GetCartReminderStats_FullMethodName    = "/user.UserService/GetCartReminderStats"
+	UserService_ListUserEvents_FullMethodName          = "/user.UserService/ListUserEvents"
+	UserService_CreateStaff_FullMethodName             = "/user.UserService/CreateStaff"
+	UserService_SetStaffPermissions_FullMethodName     = "/user.UserService/SetStaffPermissions"
+	UserService_ListStaff_FullMethodName               = "/user.UserService/ListStaff"
+	UserService_ListPermissionSets_FullMethodName      = "/user.UserService/ListPermissionSets"
+	UserService_ImportUsers_FullMethodName             = "/user.UserService/ImportUsers"
+	UserService_ResendInvitation_FullMethodName        = "/user.UserService/ResendInvitation"
+	UserService_AcceptInvitation_FullMethodName        = "/user.UserService/AcceptInvitation"
+	UserService_CreateCompany_FullMethodName           = "/user.UserService/CreateCompany"
+	UserService_UpdateCompany_FullMethodName           = "/user.UserService/UpdateCompany"
+	UserService_GetCompany_FullMethodName              = "/user.UserService/GetCompany"
+	UserService_ListCompanies_FullMethodName           = "/user.UserService/ListCompanies"
+	UserService_SetCompanyMember_FullMethodName        = "/user.UserService/SetCompanyMember"
+	UserService_RemoveCompanyMember_FullMethodName     = "/user.UserService/RemoveCompanyMember"
+	UserService_AddCompanyAddress_FullMethodName       = "/user.UserService/AddCompanyAddress"
+	UserService_RemoveCompanyAddress_FullMethodName    = "/user.UserService/RemoveCompanyAddress"
+	UserService_GetUserCompany_FullMethodName          = "/user.UserService/GetUserCompany"
+	UserService_RequestPurchaseApproval_FullMethodName = "/user.UserService/RequestPurchaseApproval"
+	UserService_DecidePurchaseApproval_FullMethodName  = "/user.UserService/DecidePurchaseApproval"
+	UserService_ListPurchaseApprovals_FullMethodName   = "/user.UserService/ListPurchaseApprovals"
+	UserService_RequestQuote_FullMethodName            = "/user.UserService/RequestQuote"
+	UserService_RespondToQuote_FullMethodName          = "/user.UserService/RespondToQuote"
+	UserService_AcceptQuote_FullMethodName             = "/user.UserService/AcceptQuote"
+	UserService_DeclineQuote_FullMethodName            = "/user.UserService/DeclineQuote"
+	UserService_ConvertQuote_FullMethodName            = "/user.UserService/ConvertQuote"
+	UserService_GetQuote_FullMethodName                = "/user.UserService/GetQuote"
+	UserService_ListQuotes_FullMethodName              = "/user.UserService/ListQuotes"
+	UserService_ListQuoteEvents_FullMethodName         = "/user.UserService/ListQuoteEvents"
+	UserService_SetCreditLimit_FullMethodName          = "/user.UserService/SetCreditLimit"
+	UserService_GetCreditAccount_FullMethodName        = "/user.UserService/GetCreditAccount"
+	UserService_ChargeCredit_FullMethodName            = "/user.UserService/ChargeCredit"
+	UserService_RecordCreditPayment_FullMethodName     = "/user.UserService/RecordCreditPayment"
+	UserService_GetCreditStatement_FullMethodName      = "/user.UserService/GetCreditStatement"
+	UserService_ListCreditEvents_FullMethodName        = "/user.UserService/ListCreditEvents"
+	UserService_RecordOrderEvent_FullMethodName        = "/user.UserService/RecordOrderEvent"
+	UserService_GetUserStats_FullMethodName            = "/user.UserService/GetUserStats"
+	UserService_ListUserStats_FullMethodName           = "/user.UserService/ListUserStats"
+	UserService_HealthCheck_FullMethodName             = "/user.UserService/HealthCheck"
+	UserService_GetDiagnostics_FullMethodName          = "/user.UserService/GetDiagnostics"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -54,6 +104,8 @@ type UserServiceClient interface {
 	// Authentication
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	// Public keys access tokens are verified with, as a JWK set
+	GetJWKS(ctx context.Context, in *GetJWKSRequest, opts ...grpc.CallOption) (*GetJWKSResponse, error)
 	// Address operations
 	AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*AddressResponse, error)
 	GetAddresses(ctx context.Context, in *GetAddressesRequest, opts ...grpc.CallOption) (*AddressListResponse, error)
@@ -64,8 +116,75 @@ type UserServiceClient interface {
 	GetPaymentMethods(ctx context.Context, in *GetPaymentMethodsRequest, opts ...grpc.CallOption) (*PaymentMethodListResponse, error)
 	UpdatePaymentMethod(ctx context.Context, in *UpdatePaymentMethodRequest, opts ...grpc.CallOption) (*PaymentMethodResponse, error)
 	DeletePaymentMethod(ctx context.Context, in *DeletePaymentMethodRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Internal staff notes on users, never shown to the users themselves
+	CreateUserNote(ctx context.Context, in *CreateUserNoteRequest, opts ...grpc.CallOption) (*UserNoteResponse, error)
+	ListUserNotes(ctx context.Context, in *ListUserNotesRequest, opts ...grpc.CallOption) (*ListUserNotesResponse, error)
+	UpdateUserNote(ctx context.Context, in *UpdateUserNoteRequest, opts ...grpc.CallOption) (*UserNoteResponse, error)
+	DeleteUserNote(ctx context.Context, in *DeleteUserNoteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Carts, wishlists and recently viewed products of users and of guests,
+	// whose lists are merged into their account when they log in
+	GetShopperList(ctx context.Context, in *GetShopperListRequest, opts ...grpc.CallOption) (*ShopperListResponse, error)
+	SetShopperListItem(ctx context.Context, in *SetShopperListItemRequest, opts ...grpc.CallOption) (*ShopperListResponse, error)
+	RemoveShopperListItem(ctx context.Context, in *RemoveShopperListItemRequest, opts ...grpc.CallOption) (*ShopperListResponse, error)
+	MergeGuestData(ctx context.Context, in *MergeGuestDataRequest, opts ...grpc.CallOption) (*MergeGuestDataResponse, error)
+	// Reminders of carts left inactive, read by the notification service,
+	// and the orders placed from reminded carts
+	ListCartReminderEvents(ctx context.Context, in *ListCartReminderEventsRequest, opts ...grpc.CallOption) (*ListCartReminderEventsResponse, error)
+	RecordCartConversion(ctx context.Context, in *RecordCartConversionRequest, opts ...grpc.CallOption) (*RecordCartConversionResponse, error)
+	GetCartReminderStats(ctx context.Context, in *GetCartReminderStatsRequest, opts ...grpc.CallOption) (*CartReminderStatsResponse, error)
+	// Account events of the store, newest first, for the admin activity feed
+	ListUserEvents(ctx context.Context, in *ListUserEventsRequest, opts ...grpc.CallOption) (*ListUserEventsResponse, error)
+	// Staff accounts and their permission sets, managed by administrators
+	CreateStaff(ctx context.Context, in *CreateStaffRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	SetStaffPermissions(ctx context.Context, in *SetStaffPermissionsRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	ListStaff(ctx context.Context, in *ListStaffRequest, opts ...grpc.CallOption) (*ListStaffResponse, error)
+	ListPermissionSets(ctx context.Context, in *ListPermissionSetsRequest, opts ...grpc.CallOption) (*ListPermissionSetsResponse, error)
+	// Bulk imports of users invited to set their password
+	ImportUsers(ctx context.Context, in *ImportUsersRequest, opts ...grpc.CallOption) (*ImportUsersResponse, error)
+	ResendInvitation(ctx context.Context, in *ResendInvitationRequest, opts ...grpc.CallOption) (*InvitationResponse, error)
+	AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*UserResponse, error)
+	// B2B companies, their members and shared addresses, and the approval
+	// of the purchases of members above the threshold of their company
+	CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*CompanyResponse, error)
+	UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*CompanyResponse, error)
+	GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*CompanyResponse, error)
+	ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error)
+	SetCompanyMember(ctx context.Context, in *SetCompanyMemberRequest, opts ...grpc.CallOption) (*CompanyMemberResponse, error)
+	RemoveCompanyMember(ctx context.Context, in *RemoveCompanyMemberRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	AddCompanyAddress(ctx context.Context, in *AddCompanyAddressRequest, opts ...grpc.CallOption) (*CompanyAddressResponse, error)
+	RemoveCompanyAddress(ctx context.Context, in *RemoveCompanyAddressRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	GetUserCompany(ctx context.Context, in *GetUserCompanyRequest, opts ...grpc.CallOption) (*CompanyResponse, error)
+	RequestPurchaseApproval(ctx context.Context, in *RequestPurchaseApprovalRequest, opts ...grpc.CallOption) (*PurchaseApprovalResponse, error)
+	DecidePurchaseApproval(ctx context.Context, in *DecidePurchaseApprovalRequest, opts ...grpc.CallOption) (*PurchaseApprovalResponse, error)
+	ListPurchaseApprovals(ctx context.Context, in *ListPurchaseApprovalsRequest, opts ...grpc.CallOption) (*ListPurchaseApprovalsResponse, error)
+	// Requests for quote of buyers' carts, answered by admins with negotiated
+	// prices and converted into orders, and their events read by the
+	// notification service
+	RequestQuote(ctx context.Context, in *RequestQuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	RespondToQuote(ctx context.Context, in *RespondToQuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	AcceptQuote(ctx context.Context, in *QuoteActionRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	DeclineQuote(ctx context.Context, in *QuoteActionRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	ConvertQuote(ctx context.Context, in *ConvertQuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	ListQuotes(ctx context.Context, in *ListQuotesRequest, opts ...grpc.CallOption) (*ListQuotesResponse, error)
+	ListQuoteEvents(ctx context.Context, in *ListQuoteEventsRequest, opts ...grpc.CallOption) (*ListQuoteEventsResponse, error)
+	// Credit accounts of companies on net payment terms: orders charged to
+	// the available credit, payments, statements and the overdue charges
+	// read by the notification service
+	SetCreditLimit(ctx context.Context, in *SetCreditLimitRequest, opts ...grpc.CallOption) (*CreditAccountResponse, error)
+	GetCreditAccount(ctx context.Context, in *GetCreditAccountRequest, opts ...grpc.CallOption) (*CreditAccountResponse, error)
+	ChargeCredit(ctx context.Context, in *ChargeCreditRequest, opts ...grpc.CallOption) (*CreditChargeResponse, error)
+	RecordCreditPayment(ctx context.Context, in *RecordCreditPaymentRequest, opts ...grpc.CallOption) (*CreditPaymentResponse, error)
+	GetCreditStatement(ctx context.Context, in *GetCreditStatementRequest, opts ...grpc.CallOption) (*CreditStatementResponse, error)
+	ListCreditEvents(ctx context.Context, in *ListCreditEventsRequest, opts ...grpc.CallOption) (*ListCreditEventsResponse, error)
+	// Lifetime metrics of customers, maintained from the order events
+	// reported by the order system
+	RecordOrderEvent(ctx context.Context, in *RecordOrderEventRequest, opts ...grpc.CallOption) (*UserStatsResponse, error)
+	GetUserStats(ctx context.Context, in *GetUserStatsRequest, opts ...grpc.CallOption) (*UserStatsResponse, error)
+	ListUserStats(ctx context.Context, in *ListUserStatsRequest, opts ...grpc.CallOption) (*ListUserStatsResponse, error)
 	// System
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+	GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*DiagnosticsResponse, error)
 }
 
 type userServiceClient struct {
@@ -156,6 +275,16 @@ func (c *userServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRe
 	return out, nil
 }
 
+func (c *userServiceClient) GetJWKS(ctx context.Context, in *GetJWKSRequest, opts ...grpc.CallOption) (*GetJWKSResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetJWKSResponse)
+	err := c.cc.Invoke(ctx, UserService_GetJWKS_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) AddAddress(ctx context.Context, in *AddAddressRequest, opts ...grpc.CallOption) (*AddressResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AddressResponse)
@@ -236,410 +365,2001 @@ func (c *userServiceClient) DeletePaymentMethod(ctx context.Context, in *DeleteP
 	return out, nil
 }
 
-func (c *userServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+func (c *userServiceClient) CreateUserNote(ctx context.Context, in *CreateUserNoteRequest, opts ...grpc.CallOption) (*UserNoteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, UserService_HealthCheck_FullMethodName, in, out, cOpts...)
+	out := new(UserNoteResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateUserNote_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// UserServiceServer is the server API for UserService service.
-// All implementations must embed UnimplementedUserServiceServer
-// for forward compatibility.
-//
-// User service definition
-type UserServiceServer interface {
-	// User CRUD operations
-	CreateUser(context.Context, *CreateUserRequest) (*UserResponse, error)
-	GetUser(context.Context, *GetUserRequest) (*UserResponse, error)
-	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
-	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
-	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteResponse, error)
-	GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserResponse, error)
-	// Authentication
-	Login(context.Context, *LoginRequest) (*LoginResponse, error)
-	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
-	// Address operations
-	AddAddress(context.Context, *AddAddressRequest) (*AddressResponse, error)
-	GetAddresses(context.Context, *GetAddressesRequest) (*AddressListResponse, error)
-	UpdateAddress(context.Context, *UpdateAddressRequest) (*AddressResponse, error)
-	DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteResponse, error)
-	// Payment method operations
-	AddPaymentMethod(context.Context, *AddPaymentMethodRequest) (*PaymentMethodResponse, error)
-	GetPaymentMethods(context.Context, *GetPaymentMethodsRequest) (*PaymentMethodListResponse, error)
-	UpdatePaymentMethod(context.Context, *UpdatePaymentMethodRequest) (*PaymentMethodResponse, error)
-	DeletePaymentMethod(context.Context, *DeletePaymentMethodRequest) (*DeleteResponse, error)
-	// System
-	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
-	mustEmbedUnimplementedUserServiceServer()
+func (c *userServiceClient) ListUserNotes(ctx context.Context, in *ListUserNotesRequest, opts ...grpc.CallOption) (*ListUserNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserNotesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedUserServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedUserServiceServer struct{}
+func (c *userServiceClient) UpdateUserNote(ctx context.Context, in *UpdateUserNoteRequest, opts ...grpc.CallOption) (*UserNoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserNoteResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateUserNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+func (c *userServiceClient) DeleteUserNote(ctx context.Context, in *DeleteUserNoteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteUserNote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+
+func (c *userServiceClient) GetShopperList(ctx context.Context, in *GetShopperListRequest, opts ...grpc.CallOption) (*ShopperListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShopperListResponse)
+	err := c.cc.Invoke(ctx, UserService_GetShopperList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+
+func (c *userServiceClient) SetShopperListItem(ctx context.Context, in *SetShopperListItemRequest, opts ...grpc.CallOption) (*ShopperListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShopperListResponse)
+	err := c.cc.Invoke(ctx, UserService_SetShopperListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+
+func (c *userServiceClient) RemoveShopperListItem(ctx context.Context, in *RemoveShopperListItemRequest, opts ...grpc.CallOption) (*ShopperListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShopperListResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveShopperListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+
+func (c *userServiceClient) MergeGuestData(ctx context.Context, in *MergeGuestDataRequest, opts ...grpc.CallOption) (*MergeGuestDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MergeGuestDataResponse)
+	err := c.cc.Invoke(ctx, UserService_MergeGuestData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+
+func (c *userServiceClient) ListCartReminderEvents(ctx context.Context, in *ListCartReminderEventsRequest, opts ...grpc.CallOption) (*ListCartReminderEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCartReminderEventsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListCartReminderEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+
+func (c *userServiceClient) RecordCartConversion(ctx context.Context, in *RecordCartConversionRequest, opts ...grpc.CallOption) (*RecordCartConversionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordCartConversionResponse)
+	err := c.cc.Invoke(ctx, UserService_RecordCartConversion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+
+func (c *userServiceClient) GetCartReminderStats(ctx context.Context, in *GetCartReminderStatsRequest, opts ...grpc.CallOption) (*CartReminderStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartReminderStatsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetCartReminderStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) AddAddress(context.Context, *AddAddressRequest) (*AddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddAddress not implemented")
+
+func (c *userServiceClient) ListUserEvents(ctx context.Context, in *ListUserEventsRequest, opts ...grpc.CallOption) (*ListUserEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserEventsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetAddresses(context.Context, *GetAddressesRequest) (*AddressListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAddresses not implemented")
+
+func (c *userServiceClient) CreateStaff(ctx context.Context, in *CreateStaffRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) UpdateAddress(context.Context, *UpdateAddressRequest) (*AddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAddress not implemented")
+
+func (c *userServiceClient) SetStaffPermissions(ctx context.Context, in *SetStaffPermissionsRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_SetStaffPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddress not implemented")
+
+func (c *userServiceClient) ListStaff(ctx context.Context, in *ListStaffRequest, opts ...grpc.CallOption) (*ListStaffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaffResponse)
+	err := c.cc.Invoke(ctx, UserService_ListStaff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) AddPaymentMethod(context.Context, *AddPaymentMethodRequest) (*PaymentMethodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPaymentMethod not implemented")
+
+func (c *userServiceClient) ListPermissionSets(ctx context.Context, in *ListPermissionSetsRequest, opts ...grpc.CallOption) (*ListPermissionSetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPermissionSetsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListPermissionSets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) GetPaymentMethods(context.Context, *GetPaymentMethodsRequest) (*PaymentMethodListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentMethods not implemented")
+
+func (c *userServiceClient) ImportUsers(ctx context.Context, in *ImportUsersRequest, opts ...grpc.CallOption) (*ImportUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_ImportUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) UpdatePaymentMethod(context.Context, *UpdatePaymentMethodRequest) (*PaymentMethodResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdatePaymentMethod not implemented")
+
+func (c *userServiceClient) ResendInvitation(ctx context.Context, in *ResendInvitationRequest, opts ...grpc.CallOption) (*InvitationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvitationResponse)
+	err := c.cc.Invoke(ctx, UserService_ResendInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedUserServiceServer) DeletePaymentMethod(context.Context, *DeletePaymentMethodRequest) (*DeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePaymentMethod not implemented")
+
+func (c *userServiceClient) AcceptInvitation(ctx context.Context, in *AcceptInvitationRequest, opts ...grpc.CallOption) (*UserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserResponse)
+	err := c.cc.Invoke(ctx, UserService_AcceptInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) CreateCompany(ctx context.Context, in *CreateCompanyRequest, opts ...grpc.CallOption) (*CompanyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanyResponse)
+	err := c.cc.Invoke(ctx, UserService_CreateCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*CompanyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanyResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetCompany(ctx context.Context, in *GetCompanyRequest, opts ...grpc.CallOption) (*CompanyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanyResponse)
+	err := c.cc.Invoke(ctx, UserService_GetCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompaniesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListCompanies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetCompanyMember(ctx context.Context, in *SetCompanyMemberRequest, opts ...grpc.CallOption) (*CompanyMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanyMemberResponse)
+	err := c.cc.Invoke(ctx, UserService_SetCompanyMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveCompanyMember(ctx context.Context, in *RemoveCompanyMemberRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveCompanyMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddCompanyAddress(ctx context.Context, in *AddCompanyAddressRequest, opts ...grpc.CallOption) (*CompanyAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanyAddressResponse)
+	err := c.cc.Invoke(ctx, UserService_AddCompanyAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RemoveCompanyAddress(ctx context.Context, in *RemoveCompanyAddressRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, UserService_RemoveCompanyAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserCompany(ctx context.Context, in *GetUserCompanyRequest, opts ...grpc.CallOption) (*CompanyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanyResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserCompany_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestPurchaseApproval(ctx context.Context, in *RequestPurchaseApprovalRequest, opts ...grpc.CallOption) (*PurchaseApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseApprovalResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestPurchaseApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DecidePurchaseApproval(ctx context.Context, in *DecidePurchaseApprovalRequest, opts ...grpc.CallOption) (*PurchaseApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurchaseApprovalResponse)
+	err := c.cc.Invoke(ctx, UserService_DecidePurchaseApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListPurchaseApprovals(ctx context.Context, in *ListPurchaseApprovalsRequest, opts ...grpc.CallOption) (*ListPurchaseApprovalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPurchaseApprovalsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListPurchaseApprovals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RequestQuote(ctx context.Context, in *RequestQuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, UserService_RequestQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RespondToQuote(ctx context.Context, in *RespondToQuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, UserService_RespondToQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AcceptQuote(ctx context.Context, in *QuoteActionRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, UserService_AcceptQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeclineQuote(ctx context.Context, in *QuoteActionRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, UserService_DeclineQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ConvertQuote(ctx context.Context, in *ConvertQuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, UserService_ConvertQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetQuote(ctx context.Context, in *GetQuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, UserService_GetQuote_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListQuotes(ctx context.Context, in *ListQuotesRequest, opts ...grpc.CallOption) (*ListQuotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuotesResponse)
+	err := c.cc.Invoke(ctx, UserService_ListQuotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListQuoteEvents(ctx context.Context, in *ListQuoteEventsRequest, opts ...grpc.CallOption) (*ListQuoteEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuoteEventsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListQuoteEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetCreditLimit(ctx context.Context, in *SetCreditLimitRequest, opts ...grpc.CallOption) (*CreditAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_SetCreditLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetCreditAccount(ctx context.Context, in *GetCreditAccountRequest, opts ...grpc.CallOption) (*CreditAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditAccountResponse)
+	err := c.cc.Invoke(ctx, UserService_GetCreditAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ChargeCredit(ctx context.Context, in *ChargeCreditRequest, opts ...grpc.CallOption) (*CreditChargeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditChargeResponse)
+	err := c.cc.Invoke(ctx, UserService_ChargeCredit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RecordCreditPayment(ctx context.Context, in *RecordCreditPaymentRequest, opts ...grpc.CallOption) (*CreditPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditPaymentResponse)
+	err := c.cc.Invoke(ctx, UserService_RecordCreditPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetCreditStatement(ctx context.Context, in *GetCreditStatementRequest, opts ...grpc.CallOption) (*CreditStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreditStatementResponse)
+	err := c.cc.Invoke(ctx, UserService_GetCreditStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListCreditEvents(ctx context.Context, in *ListCreditEventsRequest, opts ...grpc.CallOption) (*ListCreditEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCreditEventsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListCreditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) RecordOrderEvent(ctx context.Context, in *RecordOrderEventRequest, opts ...grpc.CallOption) (*UserStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserStatsResponse)
+	err := c.cc.Invoke(ctx, UserService_RecordOrderEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetUserStats(ctx context.Context, in *GetUserStatsRequest, opts ...grpc.CallOption) (*UserStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserStatsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetUserStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ListUserStats(ctx context.Context, in *ListUserStatsRequest, opts ...grpc.CallOption) (*ListUserStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserStatsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListUserStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, UserService_HealthCheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDiagnostics(ctx context.Context, in *GetDiagnosticsRequest, opts ...grpc.CallOption) (*DiagnosticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiagnosticsResponse)
+	err := c.cc.Invoke(ctx, UserService_GetDiagnostics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility.
+//
+// User service definition
+type UserServiceServer interface {
+	// User CRUD operations
+	CreateUser(context.Context, *CreateUserRequest) (*UserResponse, error)
+	GetUser(context.Context, *GetUserRequest) (*UserResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteResponse, error)
+	GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserResponse, error)
+	// Authentication
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	// Public keys access tokens are verified with, as a JWK set
+	GetJWKS(context.Context, *GetJWKSRequest) (*GetJWKSResponse, error)
+	// Address operations
+	AddAddress(context.Context, *AddAddressRequest) (*AddressResponse, error)
+	GetAddresses(context.Context, *GetAddressesRequest) (*AddressListResponse, error)
+	UpdateAddress(context.Context, *UpdateAddressRequest) (*AddressResponse, error)
+	DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteResponse, error)
+	// Payment method operations
+	AddPaymentMethod(context.Context, *AddPaymentMethodRequest) (*PaymentMethodResponse, error)
+	GetPaymentMethods(context.Context, *GetPaymentMethodsRequest) (*PaymentMethodListResponse, error)
+	UpdatePaymentMethod(context.Context, *UpdatePaymentMethodRequest) (*PaymentMethodResponse, error)
+	DeletePaymentMethod(context.Context, *DeletePaymentMethodRequest) (*DeleteResponse, error)
+	// Internal staff notes on users, never shown to the users themselves
+	CreateUserNote(context.Context, *CreateUserNoteRequest) (*UserNoteResponse, error)
+	ListUserNotes(context.Context, *ListUserNotesRequest) (*ListUserNotesResponse, error)
+	UpdateUserNote(context.Context, *UpdateUserNoteRequest) (*UserNoteResponse, error)
+	DeleteUserNote(context.Context, *DeleteUserNoteRequest) (*DeleteResponse, error)
+	// Carts, wishlists and recently viewed products of users and of guests,
+	// whose lists are merged into their account when they log in
+	GetShopperList(context.Context, *GetShopperListRequest) (*ShopperListResponse, error)
+	SetShopperListItem(context.Context, *SetShopperListItemRequest) (*ShopperListResponse, error)
+	RemoveShopperListItem(context.Context, *RemoveShopperListItemRequest) (*ShopperListResponse, error)
+	MergeGuestData(context.Context, *MergeGuestDataRequest) (*MergeGuestDataResponse, error)
+	// Reminders of carts left inactive, read by the notification service,
+	// and the orders placed from reminded carts
+	ListCartReminderEvents(context.Context, *ListCartReminderEventsRequest) (*ListCartReminderEventsResponse, error)
+	RecordCartConversion(context.Context, *RecordCartConversionRequest) (*RecordCartConversionResponse, error)
+	GetCartReminderStats(context.Context, *GetCartReminderStatsRequest) (*CartReminderStatsResponse, error)
+	// Account events of the store, newest first, for the admin activity feed
+	ListUserEvents(context.Context, *ListUserEventsRequest) (*ListUserEventsResponse, error)
+	// Staff accounts and their permission sets, managed by administrators
+	CreateStaff(context.Context, *CreateStaffRequest) (*UserResponse, error)
+	SetStaffPermissions(context.Context, *SetStaffPermissionsRequest) (*UserResponse, error)
+	ListStaff(context.Context, *ListStaffRequest) (*ListStaffResponse, error)
+	ListPermissionSets(context.Context, *ListPermissionSetsRequest) (*ListPermissionSetsResponse, error)
+	// Bulk imports of users invited to set their password
+	ImportUsers(context.Context, *ImportUsersRequest) (*ImportUsersResponse, error)
+	ResendInvitation(context.Context, *ResendInvitationRequest) (*InvitationResponse, error)
+	AcceptInvitation(context.Context, *AcceptInvitationRequest) (*UserResponse, error)
+	// B2B companies, their members and shared addresses, and the approval
+	// of the purchases of members above the threshold of their company
+	CreateCompany(context.Context, *CreateCompanyRequest) (*CompanyResponse, error)
+	UpdateCompany(context.Context, *UpdateCompanyRequest) (*CompanyResponse, error)
+	GetCompany(context.Context, *GetCompanyRequest) (*CompanyResponse, error)
+	ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesResponse, error)
+	SetCompanyMember(context.Context, *SetCompanyMemberRequest) (*CompanyMemberResponse, error)
+	RemoveCompanyMember(context.Context, *RemoveCompanyMemberRequest) (*DeleteResponse, error)
+	AddCompanyAddress(context.Context, *AddCompanyAddressRequest) (*CompanyAddressResponse, error)
+	RemoveCompanyAddress(context.Context, *RemoveCompanyAddressRequest) (*DeleteResponse, error)
+	GetUserCompany(context.Context, *GetUserCompanyRequest) (*CompanyResponse, error)
+	RequestPurchaseApproval(context.Context, *RequestPurchaseApprovalRequest) (*PurchaseApprovalResponse, error)
+	DecidePurchaseApproval(context.Context, *DecidePurchaseApprovalRequest) (*PurchaseApprovalResponse, error)
+	ListPurchaseApprovals(context.Context, *ListPurchaseApprovalsRequest) (*ListPurchaseApprovalsResponse, error)
+	// Requests for quote of buyers' carts, answered by admins with negotiated
+	// prices and converted into orders, and their events read by the
+	// notification service
+	RequestQuote(context.Context, *RequestQuoteRequest) (*QuoteResponse, error)
+	RespondToQuote(context.Context, *RespondToQuoteRequest) (*QuoteResponse, error)
+	AcceptQuote(context.Context, *QuoteActionRequest) (*QuoteResponse, error)
+	DeclineQuote(context.Context, *QuoteActionRequest) (*QuoteResponse, error)
+	ConvertQuote(context.Context, *ConvertQuoteRequest) (*QuoteResponse, error)
+	GetQuote(context.Context, *GetQuoteRequest) (*QuoteResponse, error)
+	ListQuotes(context.Context, *ListQuotesRequest) (*ListQuotesResponse, error)
+	ListQuoteEvents(context.Context, *ListQuoteEventsRequest) (*ListQuoteEventsResponse, error)
+	// Credit accounts of companies on net payment terms: orders charged to
+	// the available credit, payments, statements and the overdue charges
+	// read by the notification service
+	SetCreditLimit(context.Context, *SetCreditLimitRequest) (*CreditAccountResponse, error)
+	GetCreditAccount(context.Context, *GetCreditAccountRequest) (*CreditAccountResponse, error)
+	ChargeCredit(context.Context, *ChargeCreditRequest) (*CreditChargeResponse, error)
+	RecordCreditPayment(context.Context, *RecordCreditPaymentRequest) (*CreditPaymentResponse, error)
+	GetCreditStatement(context.Context, *GetCreditStatementRequest) (*CreditStatementResponse, error)
+	ListCreditEvents(context.Context, *ListCreditEventsRequest) (*ListCreditEventsResponse, error)
+	// Lifetime metrics of customers, maintained from the order events
+	// reported by the order system
+	RecordOrderEvent(context.Context, *RecordOrderEventRequest) (*UserStatsResponse, error)
+	GetUserStats(context.Context, *GetUserStatsRequest) (*UserStatsResponse, error)
+	ListUserStats(context.Context, *ListUserStatsRequest) (*ListUserStatsResponse, error)
+	// System
+	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
+	GetDiagnostics(context.Context, *GetDiagnosticsRequest) (*DiagnosticsResponse, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) CreateUser(context.Context, *CreateUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetUser(context.Context, *GetUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserByEmail(context.Context, *GetUserByEmailRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserByEmail not implemented")
+}
+func (UnimplementedUserServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedUserServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedUserServiceServer) GetJWKS(context.Context, *GetJWKSRequest) (*GetJWKSResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJWKS not implemented")
+}
+func (UnimplementedUserServiceServer) AddAddress(context.Context, *AddAddressRequest) (*AddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddAddress not implemented")
+}
+func (UnimplementedUserServiceServer) GetAddresses(context.Context, *GetAddressesRequest) (*AddressListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddresses not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateAddress(context.Context, *UpdateAddressRequest) (*AddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAddress not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteAddress(context.Context, *DeleteAddressRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddress not implemented")
+}
+func (UnimplementedUserServiceServer) AddPaymentMethod(context.Context, *AddPaymentMethodRequest) (*PaymentMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPaymentMethod not implemented")
+}
+func (UnimplementedUserServiceServer) GetPaymentMethods(context.Context, *GetPaymentMethodsRequest) (*PaymentMethodListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentMethods not implemented")
+}
+func (UnimplementedUserServiceServer) UpdatePaymentMethod(context.Context, *UpdatePaymentMethodRequest) (*PaymentMethodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePaymentMethod not implemented")
+}
+func (UnimplementedUserServiceServer) DeletePaymentMethod(context.Context, *DeletePaymentMethodRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePaymentMethod not implemented")
+}
+func (UnimplementedUserServiceServer) CreateUserNote(context.Context, *CreateUserNoteRequest) (*UserNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUserNote not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserNotes(context.Context, *ListUserNotesRequest) (*ListUserNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserNotes not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateUserNote(context.Context, *UpdateUserNoteRequest) (*UserNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserNote not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUserNote(context.Context, *DeleteUserNoteRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserNote not implemented")
+}
+func (UnimplementedUserServiceServer) GetShopperList(context.Context, *GetShopperListRequest) (*ShopperListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShopperList not implemented")
+}
+func (UnimplementedUserServiceServer) SetShopperListItem(context.Context, *SetShopperListItemRequest) (*ShopperListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShopperListItem not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveShopperListItem(context.Context, *RemoveShopperListItemRequest) (*ShopperListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveShopperListItem not implemented")
+}
+func (UnimplementedUserServiceServer) MergeGuestData(context.Context, *MergeGuestDataRequest) (*MergeGuestDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeGuestData not implemented")
+}
+func (UnimplementedUserServiceServer) ListCartReminderEvents(context.Context, *ListCartReminderEventsRequest) (*ListCartReminderEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCartReminderEvents not implemented")
+}
+func (UnimplementedUserServiceServer) RecordCartConversion(context.Context, *RecordCartConversionRequest) (*RecordCartConversionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordCartConversion not implemented")
+}
+func (UnimplementedUserServiceServer) GetCartReminderStats(context.Context, *GetCartReminderStatsRequest) (*CartReminderStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCartReminderStats not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserEvents(context.Context, *ListUserEventsRequest) (*ListUserEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserEvents not implemented")
+}
+func (UnimplementedUserServiceServer) CreateStaff(context.Context, *CreateStaffRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStaff not implemented")
+}
+func (UnimplementedUserServiceServer) SetStaffPermissions(context.Context, *SetStaffPermissionsRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetStaffPermissions not implemented")
+}
+func (UnimplementedUserServiceServer) ListStaff(context.Context, *ListStaffRequest) (*ListStaffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaff not implemented")
+}
+func (UnimplementedUserServiceServer) ListPermissionSets(context.Context, *ListPermissionSetsRequest) (*ListPermissionSetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPermissionSets not implemented")
+}
+func (UnimplementedUserServiceServer) ImportUsers(context.Context, *ImportUsersRequest) (*ImportUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportUsers not implemented")
+}
+func (UnimplementedUserServiceServer) ResendInvitation(context.Context, *ResendInvitationRequest) (*InvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResendInvitation not implemented")
+}
+func (UnimplementedUserServiceServer) AcceptInvitation(context.Context, *AcceptInvitationRequest) (*UserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvitation not implemented")
+}
+func (UnimplementedUserServiceServer) CreateCompany(context.Context, *CreateCompanyRequest) (*CompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompany not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateCompany(context.Context, *UpdateCompanyRequest) (*CompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCompany not implemented")
+}
+func (UnimplementedUserServiceServer) GetCompany(context.Context, *GetCompanyRequest) (*CompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompany not implemented")
+}
+func (UnimplementedUserServiceServer) ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompanies not implemented")
+}
+func (UnimplementedUserServiceServer) SetCompanyMember(context.Context, *SetCompanyMemberRequest) (*CompanyMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCompanyMember not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveCompanyMember(context.Context, *RemoveCompanyMemberRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCompanyMember not implemented")
+}
+func (UnimplementedUserServiceServer) AddCompanyAddress(context.Context, *AddCompanyAddressRequest) (*CompanyAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCompanyAddress not implemented")
+}
+func (UnimplementedUserServiceServer) RemoveCompanyAddress(context.Context, *RemoveCompanyAddressRequest) (*DeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCompanyAddress not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserCompany(context.Context, *GetUserCompanyRequest) (*CompanyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserCompany not implemented")
+}
+func (UnimplementedUserServiceServer) RequestPurchaseApproval(context.Context, *RequestPurchaseApprovalRequest) (*PurchaseApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPurchaseApproval not implemented")
+}
+func (UnimplementedUserServiceServer) DecidePurchaseApproval(context.Context, *DecidePurchaseApprovalRequest) (*PurchaseApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecidePurchaseApproval not implemented")
+}
+func (UnimplementedUserServiceServer) ListPurchaseApprovals(context.Context, *ListPurchaseApprovalsRequest) (*ListPurchaseApprovalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPurchaseApprovals not implemented")
+}
+func (UnimplementedUserServiceServer) RequestQuote(context.Context, *RequestQuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestQuote not implemented")
+}
+func (UnimplementedUserServiceServer) RespondToQuote(context.Context, *RespondToQuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToQuote not implemented")
+}
+func (UnimplementedUserServiceServer) AcceptQuote(context.Context, *QuoteActionRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptQuote not implemented")
+}
+func (UnimplementedUserServiceServer) DeclineQuote(context.Context, *QuoteActionRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineQuote not implemented")
+}
+func (UnimplementedUserServiceServer) ConvertQuote(context.Context, *ConvertQuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertQuote not implemented")
+}
+func (UnimplementedUserServiceServer) GetQuote(context.Context, *GetQuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
+func (UnimplementedUserServiceServer) ListQuotes(context.Context, *ListQuotesRequest) (*ListQuotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuotes not implemented")
+}
+func (UnimplementedUserServiceServer) ListQuoteEvents(context.Context, *ListQuoteEventsRequest) (*ListQuoteEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuoteEvents not implemented")
+}
+func (UnimplementedUserServiceServer) SetCreditLimit(context.Context, *SetCreditLimitRequest) (*CreditAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCreditLimit not implemented")
+}
+func (UnimplementedUserServiceServer) GetCreditAccount(context.Context, *GetCreditAccountRequest) (*CreditAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditAccount not implemented")
+}
+func (UnimplementedUserServiceServer) ChargeCredit(context.Context, *ChargeCreditRequest) (*CreditChargeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChargeCredit not implemented")
+}
+func (UnimplementedUserServiceServer) RecordCreditPayment(context.Context, *RecordCreditPaymentRequest) (*CreditPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordCreditPayment not implemented")
+}
+func (UnimplementedUserServiceServer) GetCreditStatement(context.Context, *GetCreditStatementRequest) (*CreditStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditStatement not implemented")
+}
+func (UnimplementedUserServiceServer) ListCreditEvents(context.Context, *ListCreditEventsRequest) (*ListCreditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCreditEvents not implemented")
+}
+func (UnimplementedUserServiceServer) RecordOrderEvent(context.Context, *RecordOrderEventRequest) (*UserStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordOrderEvent not implemented")
+}
+func (UnimplementedUserServiceServer) GetUserStats(context.Context, *GetUserStatsRequest) (*UserStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserStats not implemented")
+}
+func (UnimplementedUserServiceServer) ListUserStats(context.Context, *ListUserStatsRequest) (*ListUserStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserStats not implemented")
+}
+func (UnimplementedUserServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+}
+func (UnimplementedUserServiceServer) GetDiagnostics(context.Context, *GetDiagnosticsRequest) (*DiagnosticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiagnostics not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserByEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserByEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserByEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserByEmail(ctx, req.(*GetUserByEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetJWKS_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJWKSRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetJWKS(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetJWKS_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetJWKS(ctx, req.(*GetJWKSRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddAddress(ctx, req.(*AddAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetAddresses(ctx, req.(*GetAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateAddress(ctx, req.(*UpdateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteAddress(ctx, req.(*DeleteAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddPaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPaymentMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddPaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddPaymentMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddPaymentMethod(ctx, req.(*AddPaymentMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentMethodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetPaymentMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetPaymentMethods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetPaymentMethods(ctx, req.(*GetPaymentMethodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdatePaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePaymentMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdatePaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdatePaymentMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdatePaymentMethod(ctx, req.(*UpdatePaymentMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeletePaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePaymentMethodRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeletePaymentMethod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeletePaymentMethod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeletePaymentMethod(ctx, req.(*DeletePaymentMethodRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateUserNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateUserNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateUserNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateUserNote(ctx, req.(*CreateUserNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserNotes(ctx, req.(*ListUserNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateUserNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateUserNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateUserNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateUserNote(ctx, req.(*UpdateUserNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUserNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUserNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUserNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUserNote(ctx, req.(*DeleteUserNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetShopperList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShopperListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetShopperList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetShopperList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetShopperList(ctx, req.(*GetShopperListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetShopperListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetShopperListItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetShopperListItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetShopperListItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetShopperListItem(ctx, req.(*SetShopperListItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveShopperListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveShopperListItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveShopperListItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveShopperListItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveShopperListItem(ctx, req.(*RemoveShopperListItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_MergeGuestData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeGuestDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).MergeGuestData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_MergeGuestData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).MergeGuestData(ctx, req.(*MergeGuestDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListCartReminderEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCartReminderEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListCartReminderEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListCartReminderEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListCartReminderEvents(ctx, req.(*ListCartReminderEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RecordCartConversion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordCartConversionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RecordCartConversion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RecordCartConversion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RecordCartConversion(ctx, req.(*RecordCartConversionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetCartReminderStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartReminderStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetCartReminderStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetCartReminderStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetCartReminderStats(ctx, req.(*GetCartReminderStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserEvents(ctx, req.(*ListUserEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateStaff(ctx, req.(*CreateStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetStaffPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetStaffPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetStaffPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetStaffPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetStaffPermissions(ctx, req.(*SetStaffPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListStaff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListStaff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListStaff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListStaff(ctx, req.(*ListStaffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListPermissionSets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPermissionSetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListPermissionSets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListPermissionSets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListPermissionSets(ctx, req.(*ListPermissionSetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ImportUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ImportUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ImportUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ImportUsers(ctx, req.(*ImportUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ResendInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResendInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ResendInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ResendInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ResendInvitation(ctx, req.(*ResendInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AcceptInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AcceptInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AcceptInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AcceptInvitation(ctx, req.(*AcceptInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_CreateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).CreateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_CreateCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).CreateCompany(ctx, req.(*CreateCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateCompany(ctx, req.(*UpdateCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetCompany(ctx, req.(*GetCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListCompanies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompaniesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListCompanies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListCompanies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListCompanies(ctx, req.(*ListCompaniesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetCompanyMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCompanyMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetCompanyMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetCompanyMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetCompanyMember(ctx, req.(*SetCompanyMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveCompanyMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCompanyMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveCompanyMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveCompanyMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveCompanyMember(ctx, req.(*RemoveCompanyMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddCompanyAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCompanyAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddCompanyAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddCompanyAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddCompanyAddress(ctx, req.(*AddCompanyAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_RemoveCompanyAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCompanyAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RemoveCompanyAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RemoveCompanyAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RemoveCompanyAddress(ctx, req.(*RemoveCompanyAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetUserCompany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserCompanyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetUserCompany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetUserCompany_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetUserCompany(ctx, req.(*GetUserCompanyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
+
+func _UserService_RequestPurchaseApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPurchaseApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RequestPurchaseApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_RequestPurchaseApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RequestPurchaseApproval(ctx, req.(*RequestPurchaseApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
-func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
-// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to UserServiceServer will
-// result in compilation errors.
-type UnsafeUserServiceServer interface {
-	mustEmbedUnimplementedUserServiceServer()
+func _UserService_DecidePurchaseApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecidePurchaseApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DecidePurchaseApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DecidePurchaseApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DecidePurchaseApproval(ctx, req.(*DecidePurchaseApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
-	// If the following call pancis, it indicates UnimplementedUserServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _UserService_ListPurchaseApprovals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPurchaseApprovalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&UserService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListPurchaseApprovals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListPurchaseApprovals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListPurchaseApprovals(ctx, req.(*ListPurchaseApprovalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _UserService_RequestQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestQuoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).CreateUser(ctx, in)
+		return srv.(UserServiceServer).RequestQuote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_CreateUser_FullMethodName,
+		FullMethod: UserService_RequestQuote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(UserServiceServer).RequestQuote(ctx, req.(*RequestQuoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _UserService_RespondToQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToQuoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUser(ctx, in)
+		return srv.(UserServiceServer).RespondToQuote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUser_FullMethodName,
+		FullMethod: UserService_RespondToQuote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(UserServiceServer).RespondToQuote(ctx, req.(*RespondToQuoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListUsersRequest)
+func _UserService_AcceptQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteActionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).ListUsers(ctx, in)
+		return srv.(UserServiceServer).AcceptQuote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_ListUsers_FullMethodName,
+		FullMethod: UserService_AcceptQuote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+		return srv.(UserServiceServer).AcceptQuote(ctx, req.(*QuoteActionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _UserService_DeclineQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteActionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateUser(ctx, in)
+		return srv.(UserServiceServer).DeclineQuote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateUser_FullMethodName,
+		FullMethod: UserService_DeclineQuote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(UserServiceServer).DeclineQuote(ctx, req.(*QuoteActionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteUserRequest)
+func _UserService_ConvertQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertQuoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).DeleteUser(ctx, in)
+		return srv.(UserServiceServer).ConvertQuote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_DeleteUser_FullMethodName,
+		FullMethod: UserService_ConvertQuote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+		return srv.(UserServiceServer).ConvertQuote(ctx, req.(*ConvertQuoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetUserByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserByEmailRequest)
+func _UserService_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuoteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetUserByEmail(ctx, in)
+		return srv.(UserServiceServer).GetQuote(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetUserByEmail_FullMethodName,
+		FullMethod: UserService_GetQuote_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetUserByEmail(ctx, req.(*GetUserByEmailRequest))
+		return srv.(UserServiceServer).GetQuote(ctx, req.(*GetQuoteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _UserService_ListQuotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).Login(ctx, in)
+		return srv.(UserServiceServer).ListQuotes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_Login_FullMethodName,
+		FullMethod: UserService_ListQuotes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).Login(ctx, req.(*LoginRequest))
+		return srv.(UserServiceServer).ListQuotes(ctx, req.(*ListQuotesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RefreshTokenRequest)
+func _UserService_ListQuoteEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuoteEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).RefreshToken(ctx, in)
+		return srv.(UserServiceServer).ListQuoteEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_RefreshToken_FullMethodName,
+		FullMethod: UserService_ListQuoteEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+		return srv.(UserServiceServer).ListQuoteEvents(ctx, req.(*ListQuoteEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_AddAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddAddressRequest)
+func _UserService_SetCreditLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCreditLimitRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).AddAddress(ctx, in)
+		return srv.(UserServiceServer).SetCreditLimit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_AddAddress_FullMethodName,
+		FullMethod: UserService_SetCreditLimit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).AddAddress(ctx, req.(*AddAddressRequest))
+		return srv.(UserServiceServer).SetCreditLimit(ctx, req.(*SetCreditLimitRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAddressesRequest)
+func _UserService_GetCreditAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditAccountRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetAddresses(ctx, in)
+		return srv.(UserServiceServer).GetCreditAccount(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetAddresses_FullMethodName,
+		FullMethod: UserService_GetCreditAccount_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetAddresses(ctx, req.(*GetAddressesRequest))
+		return srv.(UserServiceServer).GetCreditAccount(ctx, req.(*GetCreditAccountRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateAddressRequest)
+func _UserService_ChargeCredit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChargeCreditRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdateAddress(ctx, in)
+		return srv.(UserServiceServer).ChargeCredit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdateAddress_FullMethodName,
+		FullMethod: UserService_ChargeCredit_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdateAddress(ctx, req.(*UpdateAddressRequest))
+		return srv.(UserServiceServer).ChargeCredit(ctx, req.(*ChargeCreditRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_DeleteAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAddressRequest)
+func _UserService_RecordCreditPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordCreditPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).DeleteAddress(ctx, in)
+		return srv.(UserServiceServer).RecordCreditPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_DeleteAddress_FullMethodName,
+		FullMethod: UserService_RecordCreditPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeleteAddress(ctx, req.(*DeleteAddressRequest))
+		return srv.(UserServiceServer).RecordCreditPayment(ctx, req.(*RecordCreditPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_AddPaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPaymentMethodRequest)
+func _UserService_GetCreditStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditStatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).AddPaymentMethod(ctx, in)
+		return srv.(UserServiceServer).GetCreditStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_AddPaymentMethod_FullMethodName,
+		FullMethod: UserService_GetCreditStatement_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).AddPaymentMethod(ctx, req.(*AddPaymentMethodRequest))
+		return srv.(UserServiceServer).GetCreditStatement(ctx, req.(*GetCreditStatementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_GetPaymentMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPaymentMethodsRequest)
+func _UserService_ListCreditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCreditEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).GetPaymentMethods(ctx, in)
+		return srv.(UserServiceServer).ListCreditEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_GetPaymentMethods_FullMethodName,
+		FullMethod: UserService_ListCreditEvents_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).GetPaymentMethods(ctx, req.(*GetPaymentMethodsRequest))
+		return srv.(UserServiceServer).ListCreditEvents(ctx, req.(*ListCreditEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_UpdatePaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdatePaymentMethodRequest)
+func _UserService_RecordOrderEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordOrderEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).UpdatePaymentMethod(ctx, in)
+		return srv.(UserServiceServer).RecordOrderEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_UpdatePaymentMethod_FullMethodName,
+		FullMethod: UserService_RecordOrderEvent_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).UpdatePaymentMethod(ctx, req.(*UpdatePaymentMethodRequest))
+		return srv.(UserServiceServer).RecordOrderEvent(ctx, req.(*RecordOrderEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _UserService_DeletePaymentMethod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeletePaymentMethodRequest)
+func _UserService_GetUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServiceServer).DeletePaymentMethod(ctx, in)
+		return srv.(UserServiceServer).GetUserStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: UserService_DeletePaymentMethod_FullMethodName,
+		FullMethod: UserService_GetUserStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServiceServer).DeletePaymentMethod(ctx, req.(*DeletePaymentMethodRequest))
+		return srv.(UserServiceServer).GetUserStats(ctx, req.(*GetUserStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ListUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListUserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListUserStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListUserStats(ctx, req.(*ListUserStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -662,6 +2382,24 @@ func _UserService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiagnosticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDiagnostics(ctx, req.(*GetDiagnosticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -701,6 +2439,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RefreshToken",
 			Handler:    _UserService_RefreshToken_Handler,
 		},
+		{
+			MethodName: "GetJWKS",
+			Handler:    _UserService_GetJWKS_Handler,
+		},
 		{
 			MethodName: "AddAddress",
 			Handler:    _UserService_AddAddress_Handler,
@@ -733,10 +2475,206 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePaymentMethod",
 			Handler:    _UserService_DeletePaymentMethod_Handler,
 		},
+		{
+			MethodName: "CreateUserNote",
+			Handler:    _UserService_CreateUserNote_Handler,
+		},
+		{
+			MethodName: "ListUserNotes",
+			Handler:    _UserService_ListUserNotes_Handler,
+		},
+		{
+			MethodName: "UpdateUserNote",
+			Handler:    _UserService_UpdateUserNote_Handler,
+		},
+		{
+			MethodName: "DeleteUserNote",
+			Handler:    _UserService_DeleteUserNote_Handler,
+		},
+		{
+			MethodName: "GetShopperList",
+			Handler:    _UserService_GetShopperList_Handler,
+		},
+		{
+			MethodName: "SetShopperListItem",
+			Handler:    _UserService_SetShopperListItem_Handler,
+		},
+		{
+			MethodName: "RemoveShopperListItem",
+			Handler:    _UserService_RemoveShopperListItem_Handler,
+		},
+		{
+			MethodName: "MergeGuestData",
+			Handler:    _UserService_MergeGuestData_Handler,
+		},
+		{
+			MethodName: "ListCartReminderEvents",
+			Handler:    _UserService_ListCartReminderEvents_Handler,
+		},
+		{
+			MethodName: "RecordCartConversion",
+			Handler:    _UserService_RecordCartConversion_Handler,
+		},
+		{
+			MethodName: "GetCartReminderStats",
+			Handler:    _UserService_GetCartReminderStats_Handler,
+		},
+		{
+			MethodName: "ListUserEvents",
+			Handler:    _UserService_ListUserEvents_Handler,
+		},
+		{
+			MethodName: "CreateStaff",
+			Handler:    _UserService_CreateStaff_Handler,
+		},
+		{
+			MethodName: "SetStaffPermissions",
+			Handler:    _UserService_SetStaffPermissions_Handler,
+		},
+		{
+			MethodName: "ListStaff",
+			Handler:    _UserService_ListStaff_Handler,
+		},
+		{
+			MethodName: "ListPermissionSets",
+			Handler:    _UserService_ListPermissionSets_Handler,
+		},
+		{
+			MethodName: "ImportUsers",
+			Handler:    _UserService_ImportUsers_Handler,
+		},
+		{
+			MethodName: "ResendInvitation",
+			Handler:    _UserService_ResendInvitation_Handler,
+		},
+		{
+			MethodName: "AcceptInvitation",
+			Handler:    _UserService_AcceptInvitation_Handler,
+		},
+		{
+			MethodName: "CreateCompany",
+			Handler:    _UserService_CreateCompany_Handler,
+		},
+		{
+			MethodName: "UpdateCompany",
+			Handler:    _UserService_UpdateCompany_Handler,
+		},
+		{
+			MethodName: "GetCompany",
+			Handler:    _UserService_GetCompany_Handler,
+		},
+		{
+			MethodName: "ListCompanies",
+			Handler:    _UserService_ListCompanies_Handler,
+		},
+		{
+			MethodName: "SetCompanyMember",
+			Handler:    _UserService_SetCompanyMember_Handler,
+		},
+		{
+			MethodName: "RemoveCompanyMember",
+			Handler:    _UserService_RemoveCompanyMember_Handler,
+		},
+		{
+			MethodName: "AddCompanyAddress",
+			Handler:    _UserService_AddCompanyAddress_Handler,
+		},
+		{
+			MethodName: "RemoveCompanyAddress",
+			Handler:    _UserService_RemoveCompanyAddress_Handler,
+		},
+		{
+			MethodName: "GetUserCompany",
+			Handler:    _UserService_GetUserCompany_Handler,
+		},
+		{
+			MethodName: "RequestPurchaseApproval",
+			Handler:    _UserService_RequestPurchaseApproval_Handler,
+		},
+		{
+			MethodName: "DecidePurchaseApproval",
+			Handler:    _UserService_DecidePurchaseApproval_Handler,
+		},
+		{
+			MethodName: "ListPurchaseApprovals",
+			Handler:    _UserService_ListPurchaseApprovals_Handler,
+		},
+		{
+			MethodName: "RequestQuote",
+			Handler:    _UserService_RequestQuote_Handler,
+		},
+		{
+			MethodName: "RespondToQuote",
+			Handler:    _UserService_RespondToQuote_Handler,
+		},
+		{
+			MethodName: "AcceptQuote",
+			Handler:    _UserService_AcceptQuote_Handler,
+		},
+		{
+			MethodName: "DeclineQuote",
+			Handler:    _UserService_DeclineQuote_Handler,
+		},
+		{
+			MethodName: "ConvertQuote",
+			Handler:    _UserService_ConvertQuote_Handler,
+		},
+		{
+			MethodName: "GetQuote",
+			Handler:    _UserService_GetQuote_Handler,
+		},
+		{
+			MethodName: "ListQuotes",
+			Handler:    _UserService_ListQuotes_Handler,
+		},
+		{
+			MethodName: "ListQuoteEvents",
+			Handler:    _UserService_ListQuoteEvents_Handler,
+		},
+		{
+			MethodName: "SetCreditLimit",
+			Handler:    _UserService_SetCreditLimit_Handler,
+		},
+		{
+			MethodName: "GetCreditAccount",
+			Handler:    _UserService_GetCreditAccount_Handler,
+		},
+		{
+			MethodName: "ChargeCredit",
+			Handler:    _UserService_ChargeCredit_Handler,
+		},
+		{
+			MethodName: "RecordCreditPayment",
+			Handler:    _UserService_RecordCreditPayment_Handler,
+		},
+		{
+			MethodName: "GetCreditStatement",
+			Handler:    _UserService_GetCreditStatement_Handler,
+		},
+		{
+			MethodName: "ListCreditEvents",
+			Handler:    _UserService_ListCreditEvents_Handler,
+		},
+		{
+			MethodName: "RecordOrderEvent",
+			Handler:    _UserService_RecordOrderEvent_Handler,
+		},
+		{
+			MethodName: "GetUserStats",
+			Handler:    _UserService_GetUserStats_Handler,
+		},
+		{
+			MethodName: "ListUserStats",
+			Handler:    _UserService_ListUserStats_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _UserService_HealthCheck_Handler,
 		},
+		{
+			MethodName: "GetDiagnostics",
+			Handler:    _UserService_GetDiagnostics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/user.proto",
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// Cart reminder operations
+
+const cartReminderColumns = `id, user_id, email, first_name, items, cart_updated_at, reminded_at, converted_at, order_reference`
+
+// CreateCartReminders records a reminder for the carts of all stores last
+// changed between changedAfter and changedBefore and not reminded since.
+// Guests, whose email is unknown, and users who turned notification emails
+// off are left out. It returns the number of reminders recorded.
+func (r *PostgresRepository) CreateCartReminders(ctx context.Context, changedAfter, changedBefore time.Time) (int, error) {
+	query := `
+		INSERT INTO cart_reminders (tenant_id, user_id, email, first_name, items, cart_updated_at)
+		SELECT u.tenant_id, u.user_id, u.email, u.first_name, c.items, c.cart_updated_at
+		FROM (
+			SELECT tenant_id, owner_id, MAX(updated_at) AS cart_updated_at,
+				json_agg(json_build_object(
+					'product_id', product_id,
+					'variant_id', variant_id,
+					'quantity', quantity,
+					'added_at', added_at,
+					'updated_at', updated_at,
+					'personalization', personalization
+				) ORDER BY added_at) AS items
+			FROM shopper_list_items
+			WHERE list = 'cart' AND LEFT(owner_id, LENGTH($3)) <> $3
+			GROUP BY tenant_id, owner_id
+			HAVING MAX(updated_at) >= $1 AND MAX(updated_at) < $2
+		) c
+		JOIN users u ON u.tenant_id = c.tenant_id AND u.user_id::text = c.owner_id
+		LEFT JOIN user_preferences p ON p.user_id = u.user_id
+		WHERE COALESCE(p.notification_email, TRUE)
+			AND COALESCE(u.account_status, 'active') = 'active'
+			AND NOT EXISTS (
+				SELECT 1 FROM cart_reminders cr
+				WHERE cr.tenant_id = c.tenant_id AND cr.user_id = u.user_id
+					AND cr.cart_updated_at >= c.cart_updated_at
+			)
+		ON CONFLICT (tenant_id, user_id, cart_updated_at) DO NOTHING`
+
+	result, err := r.ExecuteExec(ctx, query, changedAfter, changedBefore, models.GuestIDPrefix)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create cart reminders: %w", err)
+	}
+	created, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return int(created), nil
+}
+
+// ListCartReminders lists the reminders of the current store after afterID,
+// in ID order
+func (r *PostgresRepository) ListCartReminders(ctx context.Context, afterID int64, limit int) ([]models.CartReminder, error) {
+	query := `
+		SELECT ` + cartReminderColumns + `
+		FROM cart_reminders
+		WHERE tenant_id = $1 AND id > $2
+		ORDER BY id
+		LIMIT $3`
+
+	// Read from the master: consumers resume right after the last reminder
+	rows, err := r.GetMaster().QueryContext(ctx, query, tenant.FromContext(ctx), afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query cart reminders: %w", err)
+	}
+	defer rows.Close()
+
+	reminders := []models.CartReminder{}
+	for rows.Next() {
+		reminder, err := scanCartReminder(rows)
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, *reminder)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating cart reminder rows: %w", err)
+	}
+
+	return reminders, nil
+}
+
+// ConvertCartReminder marks the latest reminder of a user sent since the
+// given time and not converted yet as converted by an order. Recording the
+// same order again returns the reminder it converted. It returns nil when
+// no reminder was converted.
+func (r *PostgresRepository) ConvertCartReminder(ctx context.Context, userID uuid.UUID, orderReference string, remindedSince time.Time) (*models.CartReminder, error) {
+	tenantID := tenant.FromContext(ctx)
+
+	reminder, err := scanCartReminder(r.GetMaster().QueryRowContext(ctx, `
+		SELECT `+cartReminderColumns+`
+		FROM cart_reminders
+		WHERE tenant_id = $1 AND user_id = $2 AND order_reference = $3`,
+		tenantID, userID, orderReference))
+	if err == nil {
+		return reminder, nil
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	reminder, err = scanCartReminder(r.ExecuteQueryRow(ctx, `
+		UPDATE cart_reminders
+		SET converted_at = CURRENT_TIMESTAMP, order_reference = $3
+		WHERE id = (
+			SELECT id FROM cart_reminders
+			WHERE tenant_id = $1 AND user_id = $2
+				AND converted_at IS NULL AND reminded_at >= $4
+			ORDER BY reminded_at DESC
+			LIMIT 1
+			FOR UPDATE
+		)
+		RETURNING `+cartReminderColumns,
+		tenantID, userID, orderReference, remindedSince))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert cart reminder: %w", err)
+	}
+	return reminder, nil
+}
+
+// GetCartReminderStats counts the reminders of the current store sent in
+// [from, to) and those converted
+func (r *PostgresRepository) GetCartReminderStats(ctx context.Context, from, to time.Time) (*models.CartReminderStats, error) {
+	query := `
+		SELECT COUNT(*), COUNT(converted_at)
+		FROM cart_reminders
+		WHERE tenant_id = $1 AND reminded_at >= $2 AND reminded_at < $3`
+
+	stats := &models.CartReminderStats{}
+	// Use ExecuteQueryRow for read operations (will use replica if available)
+	if err := r.ExecuteQueryRow(ctx, query, tenant.FromContext(ctx), from, to).Scan(&stats.Reminded, &stats.Converted); err != nil {
+		return nil, fmt.Errorf("failed to get cart reminder stats: %w", err)
+	}
+	return stats, nil
+}
+
+// scanCartReminder scans a row of cartReminderColumns, returning
+// sql.ErrNoRows as is
+func scanCartReminder(row interface{ Scan(...interface{}) error }) (*models.CartReminder, error) {
+	var reminder models.CartReminder
+	var items []byte
+	var convertedAt sql.NullTime
+	var orderReference sql.NullString
+	err := row.Scan(
+		&reminder.ID,
+		&reminder.UserID,
+		&reminder.Email,
+		&reminder.FirstName,
+		&items,
+		&reminder.CartUpdatedAt,
+		&reminder.RemindedAt,
+		&convertedAt,
+		&orderReference,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan cart reminder: %w", err)
+	}
+	if err := json.Unmarshal(items, &reminder.Items); err != nil {
+		return nil, fmt.Errorf("failed to decode cart reminder items: %w", err)
+	}
+	if convertedAt.Valid {
+		reminder.ConvertedAt = &convertedAt.Time
+	}
+	reminder.OrderReference = orderReference.String
+	return &reminder, nil
+}
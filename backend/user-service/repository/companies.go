@@ -0,0 +1,494 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// Company operations
+
+const companyColumns = `company_id, name, payment_terms, approval_threshold, created_at, updated_at`
+
+const companyAddressColumns = `address_id, company_id, label, address_type, street_address1, street_address2,
+	city, state, postal_code, country, is_default, created_at`
+
+const purchaseApprovalColumns = `approval_id, company_id, requester_id, order_reference, amount, status,
+	decided_by, notes, created_at, decided_at`
+
+// CreateCompany creates a company in the current store
+func (r *PostgresRepository) CreateCompany(ctx context.Context, company *models.Company) error {
+	query := `
+		INSERT INTO companies (tenant_id, name, payment_terms, approval_threshold)
+		VALUES ($1, $2, $3, $4)
+		RETURNING company_id, created_at, updated_at`
+
+	err := r.ExecuteQueryRow(ctx, query,
+		tenant.FromContext(ctx), company.Name, company.PaymentTerms, company.ApprovalThreshold,
+	).Scan(&company.CompanyID, &company.CreatedAt, &company.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create company: %w", err)
+	}
+	return nil
+}
+
+// UpdateCompany updates the name, payment terms and approval threshold of a
+// company of the current store
+func (r *PostgresRepository) UpdateCompany(ctx context.Context, company *models.Company) error {
+	query := `
+		UPDATE companies
+		SET name = $3, payment_terms = $4, approval_threshold = $5, updated_at = CURRENT_TIMESTAMP
+		WHERE tenant_id = $1 AND company_id = $2
+		RETURNING created_at, updated_at`
+
+	err := r.ExecuteQueryRow(ctx, query,
+		tenant.FromContext(ctx), company.CompanyID, company.Name, company.PaymentTerms, company.ApprovalThreshold,
+	).Scan(&company.CreatedAt, &company.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return models.ErrCompanyNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update company: %w", err)
+	}
+	return nil
+}
+
+// GetCompany returns a company of the current store, without its members
+// and addresses
+func (r *PostgresRepository) GetCompany(ctx context.Context, companyID uuid.UUID) (*models.Company, error) {
+	// Read from the master: companies are read right after they are changed
+	company, err := scanCompany(r.GetMaster().QueryRowContext(ctx, `
+		SELECT `+companyColumns+`
+		FROM companies
+		WHERE tenant_id = $1 AND company_id = $2`,
+		tenant.FromContext(ctx), companyID))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrCompanyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return company, nil
+}
+
+// ListCompanies lists the companies of the current store by name, with their
+// total count
+func (r *PostgresRepository) ListCompanies(ctx context.Context, page, limit int) ([]*models.Company, int64, error) {
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER()
+		FROM companies
+		WHERE tenant_id = $1
+		ORDER BY name, company_id
+		LIMIT %d OFFSET %d`,
+		companyColumns, limit, (page-1)*limit)
+
+	// Use ExecuteQuery for read operations (will use replica if available)
+	rows, err := r.ExecuteQuery(ctx, query, tenant.FromContext(ctx))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query companies: %w", err)
+	}
+	defer rows.Close()
+
+	companies := []*models.Company{}
+	var total int64
+	for rows.Next() {
+		company, err := scanCompany(rows, &total)
+		if err != nil {
+			return nil, 0, err
+		}
+		companies = append(companies, company)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating company rows: %w", err)
+	}
+
+	return companies, total, nil
+}
+
+// SetCompanyMember adds a user to a company of the current store, or sets
+// their role in it. Users of another company are refused with
+// ErrCompanyMembership.
+func (r *PostgresRepository) SetCompanyMember(ctx context.Context, member *models.CompanyMember) error {
+	query := `
+		INSERT INTO company_members (tenant_id, user_id, company_id, role)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (tenant_id, user_id) DO UPDATE SET role = EXCLUDED.role
+		WHERE company_members.company_id = EXCLUDED.company_id
+		RETURNING added_at`
+
+	err := r.ExecuteQueryRow(ctx, query,
+		tenant.FromContext(ctx), member.UserID, member.CompanyID, member.Role,
+	).Scan(&member.AddedAt)
+	if err == sql.ErrNoRows {
+		return models.ErrCompanyMembership
+	}
+	if err != nil {
+		return fmt.Errorf("failed to set company member: %w", err)
+	}
+	return nil
+}
+
+// DeleteCompanyMember removes a user from a company of the current store
+func (r *PostgresRepository) DeleteCompanyMember(ctx context.Context, companyID, userID uuid.UUID) error {
+	result, err := r.ExecuteExec(ctx, `
+		DELETE FROM company_members
+		WHERE tenant_id = $1 AND company_id = $2 AND user_id = $3`,
+		tenant.FromContext(ctx), companyID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to delete company member: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrCompanyMemberNotFound
+	}
+
+	return nil
+}
+
+// ListCompanyMembers lists the members of a company of the current store,
+// approvers first
+func (r *PostgresRepository) ListCompanyMembers(ctx context.Context, companyID uuid.UUID) ([]models.CompanyMember, error) {
+	rows, err := r.GetMaster().QueryContext(ctx, `
+		SELECT m.company_id, m.user_id, u.email, u.first_name, u.last_name, m.role, m.added_at
+		FROM company_members m
+		JOIN users u ON u.user_id = m.user_id
+		WHERE m.tenant_id = $1 AND m.company_id = $2
+		ORDER BY m.role, u.email`,
+		tenant.FromContext(ctx), companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query company members: %w", err)
+	}
+	defer rows.Close()
+
+	members := []models.CompanyMember{}
+	for rows.Next() {
+		member, err := scanCompanyMember(rows)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, *member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company member rows: %w", err)
+	}
+
+	return members, nil
+}
+
+// GetCompanyMembership returns the membership of a user of the current
+// store, or ErrCompanyMemberNotFound when they belong to no company
+func (r *PostgresRepository) GetCompanyMembership(ctx context.Context, userID uuid.UUID) (*models.CompanyMember, error) {
+	member, err := scanCompanyMember(r.GetMaster().QueryRowContext(ctx, `
+		SELECT m.company_id, m.user_id, u.email, u.first_name, u.last_name, m.role, m.added_at
+		FROM company_members m
+		JOIN users u ON u.user_id = m.user_id
+		WHERE m.tenant_id = $1 AND m.user_id = $2`,
+		tenant.FromContext(ctx), userID))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrCompanyMemberNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// CreateCompanyAddress adds a shared address to a company of the current
+// store. A default address replaces the default of its type, in the same
+// transaction.
+func (r *PostgresRepository) CreateCompanyAddress(ctx context.Context, address *models.CompanyAddress) error {
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	if address.IsDefault {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE company_addresses SET is_default = FALSE
+			WHERE tenant_id = $1 AND company_id = $2 AND address_type = $3 AND is_default`,
+			tenantID, address.CompanyID, address.AddressType); err != nil {
+			return fmt.Errorf("failed to clear default company address: %w", err)
+		}
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO company_addresses (tenant_id, company_id, label, address_type, street_address1,
+			street_address2, city, state, postal_code, country, is_default)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING address_id, created_at`,
+		tenantID, address.CompanyID, address.Label, address.AddressType, address.StreetAddress1,
+		address.StreetAddress2, address.City, address.State, address.PostalCode, address.Country, address.IsDefault,
+	).Scan(&address.AddressID, &address.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create company address: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit company address: %w", err)
+	}
+	return nil
+}
+
+// DeleteCompanyAddress removes a shared address of a company of the current
+// store
+func (r *PostgresRepository) DeleteCompanyAddress(ctx context.Context, companyID, addressID uuid.UUID) error {
+	result, err := r.ExecuteExec(ctx, `
+		DELETE FROM company_addresses
+		WHERE tenant_id = $1 AND company_id = $2 AND address_id = $3`,
+		tenant.FromContext(ctx), companyID, addressID)
+	if err != nil {
+		return fmt.Errorf("failed to delete company address: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrCompanyAddressNotFound
+	}
+
+	return nil
+}
+
+// ListCompanyAddresses lists the shared addresses of a company of the
+// current store, defaults first
+func (r *PostgresRepository) ListCompanyAddresses(ctx context.Context, companyID uuid.UUID) ([]models.CompanyAddress, error) {
+	rows, err := r.GetMaster().QueryContext(ctx, `
+		SELECT `+companyAddressColumns+`
+		FROM company_addresses
+		WHERE tenant_id = $1 AND company_id = $2
+		ORDER BY address_type, is_default DESC, created_at`,
+		tenant.FromContext(ctx), companyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query company addresses: %w", err)
+	}
+	defer rows.Close()
+
+	addresses := []models.CompanyAddress{}
+	for rows.Next() {
+		var address models.CompanyAddress
+		if err := rows.Scan(
+			&address.AddressID,
+			&address.CompanyID,
+			&address.Label,
+			&address.AddressType,
+			&address.StreetAddress1,
+			&address.StreetAddress2,
+			&address.City,
+			&address.State,
+			&address.PostalCode,
+			&address.Country,
+			&address.IsDefault,
+			&address.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan company address: %w", err)
+		}
+		addresses = append(addresses, address)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating company address rows: %w", err)
+	}
+
+	return addresses, nil
+}
+
+// CreatePurchaseApproval records the approval of a purchase of a company of
+// the current store, with its initial status
+func (r *PostgresRepository) CreatePurchaseApproval(ctx context.Context, approval *models.PurchaseApproval) error {
+	query := `
+		INSERT INTO purchase_approvals (tenant_id, company_id, requester_id, order_reference, amount, status, decided_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING approval_id, created_at`
+
+	err := r.ExecuteQueryRow(ctx, query,
+		tenant.FromContext(ctx), approval.CompanyID, approval.RequesterID, approval.OrderReference,
+		approval.Amount, approval.Status, approval.DecidedAt,
+	).Scan(&approval.ApprovalID, &approval.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create purchase approval: %w", err)
+	}
+	return nil
+}
+
+// GetPurchaseApproval returns a purchase approval of the current store
+func (r *PostgresRepository) GetPurchaseApproval(ctx context.Context, approvalID uuid.UUID) (*models.PurchaseApproval, error) {
+	approval, err := scanPurchaseApproval(r.GetMaster().QueryRowContext(ctx, `
+		SELECT `+purchaseApprovalColumns+`
+		FROM purchase_approvals
+		WHERE tenant_id = $1 AND approval_id = $2`,
+		tenant.FromContext(ctx), approvalID))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrPurchaseApprovalNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return approval, nil
+}
+
+// DecidePurchaseApproval approves or rejects a pending purchase approval of
+// the current store, as set in approval. Decided approvals are refused with
+// ErrPurchaseDecided, so that two approvers never both decide.
+func (r *PostgresRepository) DecidePurchaseApproval(ctx context.Context, approval *models.PurchaseApproval) error {
+	decided, err := scanPurchaseApproval(r.ExecuteQueryRow(ctx, `
+		UPDATE purchase_approvals
+		SET status = $3, decided_by = $4, notes = $5, decided_at = $6
+		WHERE tenant_id = $1 AND approval_id = $2 AND status = 'pending'
+		RETURNING `+purchaseApprovalColumns,
+		tenant.FromContext(ctx), approval.ApprovalID, approval.Status, approval.DecidedBy,
+		approval.Notes, approval.DecidedAt))
+	if err == sql.ErrNoRows {
+		if _, err := r.GetPurchaseApproval(ctx, approval.ApprovalID); err != nil {
+			return err
+		}
+		return models.ErrPurchaseDecided
+	}
+	if err != nil {
+		return err
+	}
+	*approval = *decided
+	return nil
+}
+
+// ListPurchaseApprovals lists the purchase approvals of a company of the
+// current store matching filter, most recent first, with their total count
+func (r *PostgresRepository) ListPurchaseApprovals(ctx context.Context, filter models.PurchaseApprovalFilter, page, limit int) ([]models.PurchaseApproval, int64, error) {
+	conditions := []string{"tenant_id = $1", "company_id = $2"}
+	args := []interface{}{tenant.FromContext(ctx), filter.CompanyID}
+	if filter.RequesterID != nil {
+		args = append(args, *filter.RequesterID)
+		conditions = append(conditions, fmt.Sprintf("requester_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER()
+		FROM purchase_approvals
+		WHERE %s
+		ORDER BY created_at DESC, approval_id
+		LIMIT %d OFFSET %d`,
+		purchaseApprovalColumns, strings.Join(conditions, " AND "), limit, (page-1)*limit)
+
+	rows, err := r.GetMaster().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query purchase approvals: %w", err)
+	}
+	defer rows.Close()
+
+	approvals := []models.PurchaseApproval{}
+	var total int64
+	for rows.Next() {
+		approval, err := scanPurchaseApproval(rows, &total)
+		if err != nil {
+			return nil, 0, err
+		}
+		approvals = append(approvals, *approval)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating purchase approval rows: %w", err)
+	}
+
+	return approvals, total, nil
+}
+
+// scanCompany scans a row of companyColumns followed by extra columns,
+// returning sql.ErrNoRows as is
+func scanCompany(row interface{ Scan(...interface{}) error }, extra ...interface{}) (*models.Company, error) {
+	var company models.Company
+	var threshold sql.NullFloat64
+	dest := append([]interface{}{
+		&company.CompanyID,
+		&company.Name,
+		&company.PaymentTerms,
+		&threshold,
+		&company.CreatedAt,
+		&company.UpdatedAt,
+	}, extra...)
+	err := row.Scan(dest...)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan company: %w", err)
+	}
+	if threshold.Valid {
+		company.ApprovalThreshold = &threshold.Float64
+	}
+	return &company, nil
+}
+
+func scanCompanyMember(row interface{ Scan(...interface{}) error }) (*models.CompanyMember, error) {
+	var member models.CompanyMember
+	err := row.Scan(
+		&member.CompanyID,
+		&member.UserID,
+		&member.Email,
+		&member.FirstName,
+		&member.LastName,
+		&member.Role,
+		&member.AddedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan company member: %w", err)
+	}
+	return &member, nil
+}
+
+// scanPurchaseApproval scans a row of purchaseApprovalColumns followed by
+// extra columns, returning sql.ErrNoRows as is
+func scanPurchaseApproval(row interface{ Scan(...interface{}) error }, extra ...interface{}) (*models.PurchaseApproval, error) {
+	var approval models.PurchaseApproval
+	var decidedBy uuid.NullUUID
+	var decidedAt sql.NullTime
+	dest := append([]interface{}{
+		&approval.ApprovalID,
+		&approval.CompanyID,
+		&approval.RequesterID,
+		&approval.OrderReference,
+		&approval.Amount,
+		&approval.Status,
+		&decidedBy,
+		&approval.Notes,
+		&approval.CreatedAt,
+		&decidedAt,
+	}, extra...)
+	err := row.Scan(dest...)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan purchase approval: %w", err)
+	}
+	if decidedBy.Valid {
+		approval.DecidedBy = &decidedBy.UUID
+	}
+	if decidedAt.Valid {
+		approval.DecidedAt = &decidedAt.Time
+	}
+	return &approval, nil
+}
@@ -0,0 +1,404 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// Credit account operations
+
+const creditAccountColumns = `company_id, credit_limit, balance, created_at, updated_at`
+
+const creditChargeColumns = `charge_id, company_id, user_id, order_reference, amount, outstanding,
+	due_at, overdue_at, created_at`
+
+const creditPaymentColumns = `payment_id, company_id, amount, reference, created_at`
+
+const creditEventColumns = `id, company_id, charge_id, user_id, email, order_reference, outstanding, due_at, created_at`
+
+// SetCreditLimit opens the credit account of a company of the current store
+// with limit, or changes the limit of its account. Lowering the limit below
+// the balance only stops further charges.
+func (r *PostgresRepository) SetCreditLimit(ctx context.Context, companyID uuid.UUID, limit float64) (*models.CreditAccount, error) {
+	account, err := scanCreditAccount(r.ExecuteQueryRow(ctx, `
+		INSERT INTO credit_accounts (tenant_id, company_id, credit_limit)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (company_id) DO UPDATE
+		SET credit_limit = EXCLUDED.credit_limit, updated_at = CURRENT_TIMESTAMP
+		WHERE credit_accounts.tenant_id = EXCLUDED.tenant_id
+		RETURNING `+creditAccountColumns,
+		tenant.FromContext(ctx), companyID, limit))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrCompanyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set credit limit: %w", err)
+	}
+	return account, nil
+}
+
+// GetCreditAccount returns the credit account of a company of the current
+// store, with the part of its balance overdue at now
+func (r *PostgresRepository) GetCreditAccount(ctx context.Context, companyID uuid.UUID, now time.Time) (*models.CreditAccount, error) {
+	// Read from the master: balances are read right before charging them
+	var overdue float64
+	account, err := scanCreditAccount(r.GetMaster().QueryRowContext(ctx, `
+		SELECT `+creditAccountColumns+`,
+			(SELECT COALESCE(SUM(outstanding), 0) FROM credit_charges
+			 WHERE company_id = a.company_id AND outstanding > 0 AND due_at <= $3)
+		FROM credit_accounts a
+		WHERE tenant_id = $1 AND company_id = $2`,
+		tenant.FromContext(ctx), companyID, now), &overdue)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrCreditAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	account.Overdue = overdue
+	return account, nil
+}
+
+// ChargeCredit charges an order to the credit account of its company, in
+// one transaction with the account locked so that concurrent orders never
+// exceed the limit together. Charging an order reference again returns its
+// charge.
+func (r *PostgresRepository) ChargeCredit(ctx context.Context, charge *models.CreditCharge) error {
+	tenantID := tenant.FromContext(ctx)
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var exceeded bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT balance + $3 > credit_limit
+		FROM credit_accounts
+		WHERE tenant_id = $1 AND company_id = $2
+		FOR UPDATE`,
+		tenantID, charge.CompanyID, charge.Amount).Scan(&exceeded)
+	if err == sql.ErrNoRows {
+		return models.ErrCreditAccountNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock credit account: %w", err)
+	}
+
+	existing, err := scanCreditCharge(tx.QueryRowContext(ctx, `
+		SELECT `+creditChargeColumns+`
+		FROM credit_charges
+		WHERE company_id = $1 AND order_reference = $2`,
+		charge.CompanyID, charge.OrderReference))
+	if err == nil {
+		*charge = *existing
+		return nil
+	}
+	if err != sql.ErrNoRows {
+		return err
+	}
+	if exceeded {
+		return models.ErrCreditLimitExceeded
+	}
+
+	created, err := scanCreditCharge(tx.QueryRowContext(ctx, `
+		INSERT INTO credit_charges (tenant_id, company_id, user_id, order_reference, amount, outstanding, due_at)
+		VALUES ($1, $2, $3, $4, $5, $5, $6)
+		RETURNING `+creditChargeColumns,
+		tenantID, charge.CompanyID, charge.UserID, charge.OrderReference, charge.Amount, charge.DueAt))
+	if err != nil {
+		return fmt.Errorf("failed to create credit charge: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE credit_accounts
+		SET balance = balance + $2, updated_at = CURRENT_TIMESTAMP
+		WHERE company_id = $1`,
+		charge.CompanyID, charge.Amount); err != nil {
+		return fmt.Errorf("failed to update credit balance: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit credit charge: %w", err)
+	}
+	*charge = *created
+	return nil
+}
+
+// RecordCreditPayment records a payment on the credit account of a company
+// of the current store and settles the oldest outstanding charges with it,
+// in one transaction. Payments above the balance are rejected.
+func (r *PostgresRepository) RecordCreditPayment(ctx context.Context, payment *models.CreditPayment) error {
+	tenantID := tenant.FromContext(ctx)
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var overpaid bool
+	err = tx.QueryRowContext(ctx, `
+		SELECT $3 > balance
+		FROM credit_accounts
+		WHERE tenant_id = $1 AND company_id = $2
+		FOR UPDATE`,
+		tenantID, payment.CompanyID, payment.Amount).Scan(&overpaid)
+	if err == sql.ErrNoRows {
+		return models.ErrCreditAccountNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock credit account: %w", err)
+	}
+	if overpaid {
+		return models.ErrInvalidCreditPayment
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO credit_payments (tenant_id, company_id, amount, reference)
+		VALUES ($1, $2, $3, $4)
+		RETURNING payment_id, created_at`,
+		tenantID, payment.CompanyID, payment.Amount, payment.Reference,
+	).Scan(&payment.PaymentID, &payment.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record credit payment: %w", err)
+	}
+
+	// Settle the charges in due order: each takes what the payment has left
+	// after the charges due before it
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE credit_charges c
+		SET outstanding = GREATEST(0, c.outstanding - ($2 - s.before))
+		FROM (
+			SELECT charge_id,
+				SUM(outstanding) OVER (ORDER BY due_at, created_at, charge_id) - outstanding AS before
+			FROM credit_charges
+			WHERE company_id = $1 AND outstanding > 0
+		) s
+		WHERE c.charge_id = s.charge_id AND s.before < $2`,
+		payment.CompanyID, payment.Amount); err != nil {
+		return fmt.Errorf("failed to settle credit charges: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE credit_accounts
+		SET balance = balance - $2, updated_at = CURRENT_TIMESTAMP
+		WHERE company_id = $1`,
+		payment.CompanyID, payment.Amount); err != nil {
+		return fmt.Errorf("failed to update credit balance: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit credit payment: %w", err)
+	}
+	return nil
+}
+
+// GetCreditStatement returns the statement of the credit account of a
+// company of the current store over [from, to)
+func (r *PostgresRepository) GetCreditStatement(ctx context.Context, companyID uuid.UUID, from, to time.Time) (*models.CreditStatement, error) {
+	tenantID := tenant.FromContext(ctx)
+	statement := &models.CreditStatement{
+		CompanyID: companyID,
+		From:      from,
+		To:        to,
+		Charges:   []models.CreditCharge{},
+		Payments:  []models.CreditPayment{},
+	}
+
+	err := r.ExecuteQueryRow(ctx, `
+		SELECT
+			(SELECT COALESCE(SUM(amount), 0) FROM credit_charges WHERE company_id = a.company_id AND created_at < $3)
+			- (SELECT COALESCE(SUM(amount), 0) FROM credit_payments WHERE company_id = a.company_id AND created_at < $3)
+		FROM credit_accounts a
+		WHERE tenant_id = $1 AND company_id = $2`,
+		tenantID, companyID, from).Scan(&statement.OpeningBalance)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrCreditAccountNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get opening balance: %w", err)
+	}
+
+	rows, err := r.ExecuteQuery(ctx, `
+		SELECT `+creditChargeColumns+`
+		FROM credit_charges
+		WHERE tenant_id = $1 AND company_id = $2 AND created_at >= $3 AND created_at < $4
+		ORDER BY created_at, charge_id`,
+		tenantID, companyID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query credit charges: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		charge, err := scanCreditCharge(rows)
+		if err != nil {
+			return nil, err
+		}
+		statement.Charges = append(statement.Charges, *charge)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating credit charge rows: %w", err)
+	}
+
+	payments, err := r.ExecuteQuery(ctx, `
+		SELECT `+creditPaymentColumns+`
+		FROM credit_payments
+		WHERE tenant_id = $1 AND company_id = $2 AND created_at >= $3 AND created_at < $4
+		ORDER BY created_at, payment_id`,
+		tenantID, companyID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query credit payments: %w", err)
+	}
+	defer payments.Close()
+	for payments.Next() {
+		var payment models.CreditPayment
+		if err := payments.Scan(
+			&payment.PaymentID,
+			&payment.CompanyID,
+			&payment.Amount,
+			&payment.Reference,
+			&payment.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan credit payment: %w", err)
+		}
+		statement.Payments = append(statement.Payments, payment)
+	}
+	if err = payments.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating credit payment rows: %w", err)
+	}
+
+	statement.ClosingBalance = statement.OpeningBalance
+	for _, charge := range statement.Charges {
+		statement.ClosingBalance += charge.Amount
+	}
+	for _, payment := range statement.Payments {
+		statement.ClosingBalance -= payment.Amount
+	}
+	return statement, nil
+}
+
+// MarkOverdueCredit marks the charges of all stores still outstanding at
+// their due date as overdue, once, with the events of their overdue. It
+// returns the number of charges marked.
+func (r *PostgresRepository) MarkOverdueCredit(ctx context.Context, now time.Time) (int, error) {
+	query := `
+		WITH overdue AS (
+			UPDATE credit_charges
+			SET overdue_at = $1
+			WHERE outstanding > 0 AND overdue_at IS NULL AND due_at <= $1
+			RETURNING tenant_id, company_id, charge_id, user_id, order_reference, outstanding, due_at
+		)
+		INSERT INTO credit_events (tenant_id, company_id, charge_id, user_id, email, order_reference, outstanding, due_at)
+		SELECT o.tenant_id, o.company_id, o.charge_id, o.user_id, u.email, o.order_reference, o.outstanding, o.due_at
+		FROM overdue o
+		JOIN users u ON u.user_id = o.user_id`
+
+	result, err := r.ExecuteExec(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to mark overdue credit: %w", err)
+	}
+	marked, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return int(marked), nil
+}
+
+// ListCreditEvents lists the credit events of the current store after
+// afterID, in ID order
+func (r *PostgresRepository) ListCreditEvents(ctx context.Context, afterID int64, limit int) ([]models.CreditEvent, error) {
+	query := `
+		SELECT ` + creditEventColumns + `
+		FROM credit_events
+		WHERE tenant_id = $1 AND id > $2
+		ORDER BY id
+		LIMIT $3`
+
+	// Read from the master: consumers resume right after the last event
+	rows, err := r.GetMaster().QueryContext(ctx, query, tenant.FromContext(ctx), afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query credit events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []models.CreditEvent{}
+	for rows.Next() {
+		var event models.CreditEvent
+		if err := rows.Scan(
+			&event.ID,
+			&event.CompanyID,
+			&event.ChargeID,
+			&event.UserID,
+			&event.Email,
+			&event.OrderReference,
+			&event.Outstanding,
+			&event.DueAt,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan credit event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating credit event rows: %w", err)
+	}
+
+	return events, nil
+}
+
+// scanCreditAccount scans a row of creditAccountColumns followed by extra
+// columns, returning sql.ErrNoRows as is
+func scanCreditAccount(row interface{ Scan(...interface{}) error }, extra ...interface{}) (*models.CreditAccount, error) {
+	var account models.CreditAccount
+	dest := []interface{}{
+		&account.CompanyID,
+		&account.CreditLimit,
+		&account.Balance,
+		&account.CreatedAt,
+		&account.UpdatedAt,
+	}
+	err := row.Scan(append(dest, extra...)...)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan credit account: %w", err)
+	}
+	return &account, nil
+}
+
+// scanCreditCharge scans a row of creditChargeColumns, returning
+// sql.ErrNoRows as is
+func scanCreditCharge(row interface{ Scan(...interface{}) error }) (*models.CreditCharge, error) {
+	var charge models.CreditCharge
+	var overdueAt sql.NullTime
+	err := row.Scan(
+		&charge.ChargeID,
+		&charge.CompanyID,
+		&charge.UserID,
+		&charge.OrderReference,
+		&charge.Amount,
+		&charge.Outstanding,
+		&charge.DueAt,
+		&overdueAt,
+		&charge.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan credit charge: %w", err)
+	}
+	if overdueAt.Valid {
+		charge.OverdueAt = &overdueAt.Time
+	}
+	return &charge, nil
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// Invitation operations
+
+const invitationColumns = `user_id, email, token_hash, invited_by, send_count, sent_at, expires_at, accepted_at, created_at`
+
+// SaveInvitation records the invitation of a user of the current store, or
+// replaces the token and expiry of their pending one
+func (r *PostgresRepository) SaveInvitation(ctx context.Context, invitation *models.Invitation) error {
+	saved, err := scanInvitation(r.ExecuteQueryRow(ctx, `
+		INSERT INTO user_invitations (tenant_id, user_id, email, token_hash, invited_by, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id, user_id) DO UPDATE SET
+			token_hash = EXCLUDED.token_hash,
+			expires_at = EXCLUDED.expires_at
+		WHERE user_invitations.accepted_at IS NULL
+		RETURNING `+invitationColumns,
+		tenant.FromContext(ctx), invitation.UserID, invitation.Email, invitation.TokenHash,
+		invitation.InvitedBy, invitation.ExpiresAt))
+	if err == sql.ErrNoRows {
+		return models.ErrNotInvited
+	}
+	if err != nil {
+		return err
+	}
+	*invitation = *saved
+	return nil
+}
+
+// MarkInvitationSent counts a sending of the invitation email of a user of
+// the current store
+func (r *PostgresRepository) MarkInvitationSent(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.ExecuteExec(ctx, `
+		UPDATE user_invitations
+		SET send_count = send_count + 1, sent_at = CURRENT_TIMESTAMP
+		WHERE tenant_id = $1 AND user_id = $2`,
+		tenant.FromContext(ctx), userID)
+	if err != nil {
+		return fmt.Errorf("failed to mark invitation sent: %w", err)
+	}
+	return nil
+}
+
+// GetInvitation returns the invitation of a user of the current store
+func (r *PostgresRepository) GetInvitation(ctx context.Context, userID uuid.UUID) (*models.Invitation, error) {
+	invitation, err := scanInvitation(r.GetMaster().QueryRowContext(ctx, `
+		SELECT `+invitationColumns+`
+		FROM user_invitations
+		WHERE tenant_id = $1 AND user_id = $2`,
+		tenant.FromContext(ctx), userID))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return invitation, nil
+}
+
+// AcceptInvitation sets the password of the user invited with the token of
+// tokenHash and activates their account, in one transaction. The invitation
+// must be pending and not expired at now.
+func (r *PostgresRepository) AcceptInvitation(ctx context.Context, tokenHash, hashedPassword string, now time.Time) (*models.Invitation, error) {
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	invitation, err := scanInvitation(tx.QueryRowContext(ctx, `
+		UPDATE user_invitations
+		SET accepted_at = $3
+		WHERE tenant_id = $1 AND token_hash = $2 AND accepted_at IS NULL AND expires_at > $3
+		RETURNING `+invitationColumns,
+		tenantID, tokenHash, now))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrInvitationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		UPDATE users
+		SET hashed_password = $3, account_status = 'active', email_verified = TRUE, updated_at = $4
+		WHERE tenant_id = $1 AND user_id = $2 AND account_status = $5`,
+		tenantID, invitation.UserID, hashedPassword, now, models.AccountStatusInvited)
+	if err != nil {
+		return nil, fmt.Errorf("failed to activate invited user: %w", err)
+	}
+	if activated, err := result.RowsAffected(); err != nil {
+		return nil, fmt.Errorf("failed to get rows affected: %w", err)
+	} else if activated == 0 {
+		return nil, models.ErrInvitationNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return invitation, nil
+}
+
+// scanInvitation scans a row of invitationColumns, returning sql.ErrNoRows
+// as is
+func scanInvitation(row interface{ Scan(...interface{}) error }) (*models.Invitation, error) {
+	var invitation models.Invitation
+	var sentAt, acceptedAt sql.NullTime
+	err := row.Scan(
+		&invitation.UserID,
+		&invitation.Email,
+		&invitation.TokenHash,
+		&invitation.InvitedBy,
+		&invitation.SendCount,
+		&sentAt,
+		&invitation.ExpiresAt,
+		&acceptedAt,
+		&invitation.CreatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan invitation: %w", err)
+	}
+	if sentAt.Valid {
+		invitation.SentAt = &sentAt.Time
+	}
+	if acceptedAt.Valid {
+		invitation.AcceptedAt = &acceptedAt.Time
+	}
+	return &invitation, nil
+}
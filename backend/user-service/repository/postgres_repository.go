@@ -4,11 +4,14 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
+	"github.com/louai60/e-commerce_project/backend/common/metadata"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
 	"github.com/louai60/e-commerce_project/backend/user-service/db"
 	"github.com/louai60/e-commerce_project/backend/user-service/models"
 	"go.uber.org/zap"
@@ -51,11 +54,14 @@ func (r *PostgresRepository) CreateUser(ctx context.Context, user *models.User)
 		INSERT INTO users (
 			username, email, hashed_password, first_name, last_name,
 			phone_number, user_type, role, account_status,
-			email_verified, phone_verified
+			email_verified, phone_verified, tenant_id
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 		RETURNING user_id, created_at, updated_at`
 
+	// Accounts belong to the store they registered with
+	user.TenantID = tenant.FromContext(ctx)
+
 	// Use ExecuteQueryRow for write operations (will use master)
 	err := r.ExecuteQueryRow(ctx, query,
 		user.Username,
@@ -69,11 +75,12 @@ func (r *PostgresRepository) CreateUser(ctx context.Context, user *models.User)
 		user.AccountStatus,
 		user.EmailVerified,
 		user.PhoneVerified,
+		user.TenantID,
 	).Scan(&user.UserID, &user.CreatedAt, &user.UpdatedAt)
 
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
-			return fmt.Errorf("user with email %s already exists", user.Email)
+			return models.ErrUserExists
 		}
 		return fmt.Errorf("failed to create user: %w", err)
 	}
@@ -90,7 +97,8 @@ func (r *PostgresRepository) GetUser(ctx context.Context, id uuid.UUID) (*models
 			email_verified, phone_verified,
 			COALESCE(refresh_token_id, ''),
 			created_at, updated_at,
-			COALESCE(last_login, created_at)
+			COALESCE(last_login, created_at),
+			tenant_id, metadata
 		FROM users
 		WHERE user_id = $1`
 
@@ -113,9 +121,11 @@ func (r *PostgresRepository) GetUser(ctx context.Context, id uuid.UUID) (*models
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.LastLogin,
+		&user.TenantID,
+		&user.Metadata,
 	); err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("user not found")
+			return nil, models.ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
@@ -153,6 +163,50 @@ func (r *PostgresRepository) UpdateUser(ctx context.Context, user *models.User)
 	).Scan(&user.UpdatedAt)
 }
 
+// PatchUserMetadata applies patch to the metadata of a user of the store in
+// ctx as a JSON merge patch, and returns the resulting metadata. The row is
+// locked from the read to the write, so concurrent patches are applied one
+// after the other instead of overwriting each other.
+func (r *PostgresRepository) PatchUserMetadata(ctx context.Context, userID uuid.UUID, patch json.RawMessage) (json.RawMessage, error) {
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	var current []byte
+	err = tx.QueryRowContext(ctx, `
+		SELECT metadata FROM users
+		WHERE user_id = $1 AND tenant_id = $2
+		FOR UPDATE`,
+		userID, tenantID).Scan(&current)
+	if err == sql.ErrNoRows {
+		return nil, models.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user metadata: %w", err)
+	}
+
+	doc, err := metadata.Merge(current, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE users
+		SET metadata = $1, updated_at = $2
+		WHERE user_id = $3 AND tenant_id = $4`,
+		[]byte(doc), time.Now(), userID, tenantID); err != nil {
+		return nil, fmt.Errorf("failed to update user metadata: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit user metadata: %w", err)
+	}
+	return doc, nil
+}
+
 func (r *PostgresRepository) DeleteUser(ctx context.Context, userID uuid.UUID) error {
 	query := `DELETE FROM users WHERE user_id = $1`
 	// Use ExecuteExec for write operations (will use master)
@@ -167,7 +221,7 @@ func (r *PostgresRepository) DeleteUser(ctx context.Context, userID uuid.UUID) e
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("user not found")
+		return models.ErrUserNotFound
 	}
 
 	return nil
@@ -184,13 +238,14 @@ func (r *PostgresRepository) GetUserByEmail(ctx context.Context, email string) (
 			email_verified, phone_verified,
 			COALESCE(refresh_token_id, ''),
 			created_at, updated_at,
-			COALESCE(last_login, created_at)
+			COALESCE(last_login, created_at),
+			tenant_id
 		FROM users
-		WHERE LOWER(email) = LOWER($1)`
+		WHERE LOWER(email) = LOWER($1) AND tenant_id = $2`
 
 	user := &models.User{}
 	// Use ExecuteQueryRow for read operations (will use replica if available)
-	err := r.ExecuteQueryRow(ctx, query, email).Scan(
+	err := r.ExecuteQueryRow(ctx, query, email, tenant.FromContext(ctx)).Scan(
 		&user.UserID,
 		&user.Username,
 		&user.Email,
@@ -207,12 +262,13 @@ func (r *PostgresRepository) GetUserByEmail(ctx context.Context, email string) (
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.LastLogin,
+		&user.TenantID,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
 			r.Logger.Error("User not found", zap.String("email", email))
-			return nil, fmt.Errorf("user not found")
+			return nil, models.ErrUserNotFound
 		}
 		r.Logger.Error("Database error", zap.Error(err))
 		return nil, fmt.Errorf("failed to get user by email: %w", err)
@@ -228,13 +284,13 @@ func (r *PostgresRepository) GetUserByUsername(ctx context.Context, username str
 	query := `
 		SELECT user_id, username, email, hashed_password, first_name, last_name,
 			   phone_number, user_type, role, account_status, email_verified,
-			   phone_verified, created_at, updated_at, last_login
+			   phone_verified, created_at, updated_at, last_login, tenant_id
 		FROM users
-		WHERE username = $1`
+		WHERE username = $1 AND tenant_id = $2`
 
 	user := &models.User{}
 	// Use ExecuteQueryRow for read operations (will use replica if available)
-	err := r.ExecuteQueryRow(ctx, query, username).Scan(
+	err := r.ExecuteQueryRow(ctx, query, username, tenant.FromContext(ctx)).Scan(
 		&user.UserID,
 		&user.Username,
 		&user.Email,
@@ -250,11 +306,12 @@ func (r *PostgresRepository) GetUserByUsername(ctx context.Context, username str
 		&user.CreatedAt,
 		&user.UpdatedAt,
 		&user.LastLogin,
+		&user.TenantID,
 	)
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("user not found")
+			return nil, models.ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to get user by username: %w", err)
 	}
@@ -266,7 +323,7 @@ func (r *PostgresRepository) ListUsers(ctx context.Context, page, limit int, whe
 	offset := (page - 1) * limit
 	query := `
 		SELECT user_id, username, email, first_name, last_name, phone_number,
-			   user_type, role, account_status, created_at, updated_at, last_login
+			   user_type, role, account_status, created_at, updated_at, last_login, metadata
 		FROM users
 	`
 	if where != "" {
@@ -297,6 +354,7 @@ func (r *PostgresRepository) ListUsers(ctx context.Context, page, limit int, whe
 			&user.CreatedAt,
 			&user.UpdatedAt,
 			&user.LastLogin,
+			&user.Metadata,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan user: %w", err)
@@ -428,7 +486,7 @@ func (r *PostgresRepository) DeleteAddress(ctx context.Context, addressID uuid.U
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("address not found or not owned by user")
+		return models.ErrAddressNotFound
 	}
 
 	return nil
@@ -461,7 +519,7 @@ func (r *PostgresRepository) GetDefaultAddress(ctx context.Context, userID uuid.
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("default address not found")
+			return nil, models.ErrAddressNotFound
 		}
 		return nil, fmt.Errorf("failed to get default address: %w", err)
 	}
@@ -571,7 +629,7 @@ func (r *PostgresRepository) DeletePaymentMethod(ctx context.Context, paymentID
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("payment method not found or not owned by user")
+		return models.ErrPaymentMethodNotFound
 	}
 
 	return nil
@@ -605,7 +663,7 @@ func (r *PostgresRepository) GetDefaultPaymentMethod(ctx context.Context, userID
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("default payment method not found")
+			return nil, models.ErrPaymentMethodNotFound
 		}
 		return nil, fmt.Errorf("failed to get default payment method: %w", err)
 	}
@@ -658,7 +716,7 @@ func (r *PostgresRepository) GetPreferences(ctx context.Context, userID uuid.UUI
 
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("preferences not found")
+			return nil, models.ErrPreferencesNotFound
 		}
 		return nil, fmt.Errorf("failed to get preferences: %w", err)
 	}
@@ -709,7 +767,122 @@ func (r *PostgresRepository) UpdateRefreshTokenID(ctx context.Context, userID uu
 	}
 
 	if rowsAffected == 0 {
-		return fmt.Errorf("user not found")
+		return models.ErrUserNotFound
+	}
+
+	return nil
+}
+
+// Staff note operations
+
+func (r *PostgresRepository) CreateUserNote(ctx context.Context, note *models.UserNote) error {
+	query := `
+		INSERT INTO user_notes (tenant_id, user_id, author_id, author_email, body)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING note_id, created_at, updated_at`
+
+	// Use ExecuteQueryRow for write operations (will use master)
+	return r.ExecuteQueryRow(ctx, query,
+		tenant.FromContext(ctx), note.UserID, note.AuthorID, note.AuthorEmail, note.Body,
+	).Scan(&note.NoteID, &note.CreatedAt, &note.UpdatedAt)
+}
+
+func (r *PostgresRepository) GetUserNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (*models.UserNote, error) {
+	query := `
+		SELECT note_id, user_id, author_id, author_email, body, created_at, updated_at
+		FROM user_notes
+		WHERE note_id = $1 AND user_id = $2 AND tenant_id = $3`
+
+	note := &models.UserNote{}
+	if err := r.ExecuteQueryRow(ctx, query, noteID, userID, tenant.FromContext(ctx)).Scan(
+		&note.NoteID,
+		&note.UserID,
+		&note.AuthorID,
+		&note.AuthorEmail,
+		&note.Body,
+		&note.CreatedAt,
+		&note.UpdatedAt,
+	); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, models.ErrUserNoteNotFound
+		}
+		return nil, fmt.Errorf("failed to get note: %w", err)
+	}
+	return note, nil
+}
+
+// ListUserNotes returns the notes on a user, newest first
+func (r *PostgresRepository) ListUserNotes(ctx context.Context, userID uuid.UUID) ([]models.UserNote, error) {
+	query := `
+		SELECT note_id, user_id, author_id, author_email, body, created_at, updated_at
+		FROM user_notes
+		WHERE user_id = $1 AND tenant_id = $2
+		ORDER BY created_at DESC`
+
+	// Use ExecuteQuery for read operations (will use replica if available)
+	rows, err := r.ExecuteQuery(ctx, query, userID, tenant.FromContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query notes: %w", err)
+	}
+	defer rows.Close()
+
+	notes := []models.UserNote{}
+	for rows.Next() {
+		var note models.UserNote
+		err := rows.Scan(
+			&note.NoteID,
+			&note.UserID,
+			&note.AuthorID,
+			&note.AuthorEmail,
+			&note.Body,
+			&note.CreatedAt,
+			&note.UpdatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan note: %w", err)
+		}
+		notes = append(notes, note)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating notes rows: %w", err)
+	}
+
+	return notes, nil
+}
+
+func (r *PostgresRepository) UpdateUserNote(ctx context.Context, note *models.UserNote) error {
+	query := `
+		UPDATE user_notes
+		SET body = $1, updated_at = $2
+		WHERE note_id = $3 AND user_id = $4 AND tenant_id = $5
+		RETURNING updated_at`
+
+	// Use ExecuteQueryRow for write operations (will use master)
+	err := r.ExecuteQueryRow(ctx, query,
+		note.Body, time.Now(), note.NoteID, note.UserID, tenant.FromContext(ctx),
+	).Scan(&note.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return models.ErrUserNoteNotFound
+	}
+	return err
+}
+
+func (r *PostgresRepository) DeleteUserNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error {
+	query := `DELETE FROM user_notes WHERE note_id = $1 AND user_id = $2 AND tenant_id = $3`
+	// Use ExecuteExec for write operations (will use master)
+	result, err := r.ExecuteExec(ctx, query, noteID, userID, tenant.FromContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to delete note: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrUserNoteNotFound
 	}
 
 	return nil
@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// Quote operations
+
+const quoteColumns = `quote_id, user_id, company_id, status, items, buyer_notes, admin_notes, total,
+	expires_at, order_reference, created_at, quoted_at, converted_at, updated_at`
+
+const quoteEventColumns = `id, quote_id, user_id, email, status, total, expires_at, created_at`
+
+// CreateQuote records a requested quote in the current store with the event
+// of its request, in one transaction
+func (r *PostgresRepository) CreateQuote(ctx context.Context, quote *models.Quote) error {
+	items, err := json.Marshal(quote.Items)
+	if err != nil {
+		return fmt.Errorf("failed to encode quote items: %w", err)
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO quotes (tenant_id, user_id, company_id, status, items, buyer_notes)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING quote_id, created_at, updated_at`,
+		tenant.FromContext(ctx), quote.UserID, quote.CompanyID, quote.Status, items, quote.BuyerNotes,
+	).Scan(&quote.QuoteID, &quote.CreatedAt, &quote.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create quote: %w", err)
+	}
+	if err := insertQuoteEvent(ctx, tx, quote); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit quote: %w", err)
+	}
+	return nil
+}
+
+// GetQuote returns a quote of the current store
+func (r *PostgresRepository) GetQuote(ctx context.Context, quoteID uuid.UUID) (*models.Quote, error) {
+	// Read from the master: quotes are read right before they change status
+	quote, err := scanQuote(r.GetMaster().QueryRowContext(ctx, `
+		SELECT `+quoteColumns+`
+		FROM quotes
+		WHERE tenant_id = $1 AND quote_id = $2`,
+		tenant.FromContext(ctx), quoteID))
+	if err == sql.ErrNoRows {
+		return nil, models.ErrQuoteNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// ListQuotes lists the quotes of the current store matching filter, most
+// recent first, with their total count
+func (r *PostgresRepository) ListQuotes(ctx context.Context, filter models.QuoteFilter, page, limit int) ([]models.Quote, int64, error) {
+	conditions := []string{"tenant_id = $1"}
+	args := []interface{}{tenant.FromContext(ctx)}
+	if filter.UserID != nil {
+		args = append(args, *filter.UserID)
+		conditions = append(conditions, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER()
+		FROM quotes
+		WHERE %s
+		ORDER BY created_at DESC, quote_id
+		LIMIT %d OFFSET %d`,
+		quoteColumns, strings.Join(conditions, " AND "), limit, (page-1)*limit)
+
+	rows, err := r.GetMaster().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query quotes: %w", err)
+	}
+	defer rows.Close()
+
+	quotes := []models.Quote{}
+	var total int64
+	for rows.Next() {
+		quote, err := scanQuote(rows, &total)
+		if err != nil {
+			return nil, 0, err
+		}
+		quotes = append(quotes, *quote)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating quote rows: %w", err)
+	}
+
+	return quotes, total, nil
+}
+
+// UpdateQuoteStatus saves a quote of the current store changing status, with
+// the event of the change, in one transaction. The quote must still be in
+// one of the from statuses, so that concurrent changes never both apply;
+// otherwise ErrQuoteState is returned.
+func (r *PostgresRepository) UpdateQuoteStatus(ctx context.Context, quote *models.Quote, from ...string) error {
+	items, err := json.Marshal(quote.Items)
+	if err != nil {
+		return fmt.Errorf("failed to encode quote items: %w", err)
+	}
+
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	updated, err := scanQuote(tx.QueryRowContext(ctx, `
+		UPDATE quotes
+		SET status = $3, items = $4, admin_notes = $5, total = $6, expires_at = $7,
+			order_reference = $8, quoted_at = $9, converted_at = $10, updated_at = CURRENT_TIMESTAMP
+		WHERE tenant_id = $1 AND quote_id = $2 AND status = ANY($11)
+		RETURNING `+quoteColumns,
+		tenant.FromContext(ctx), quote.QuoteID, quote.Status, items, quote.AdminNotes, quote.Total,
+		quote.ExpiresAt, quote.OrderReference, quote.QuotedAt, quote.ConvertedAt, pq.Array(from)))
+	if err == sql.ErrNoRows {
+		if _, err := r.GetQuote(ctx, quote.QuoteID); err != nil {
+			return err
+		}
+		return models.ErrQuoteState
+	}
+	if err != nil {
+		return err
+	}
+	if err := insertQuoteEvent(ctx, tx, updated); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit quote: %w", err)
+	}
+	*quote = *updated
+	return nil
+}
+
+// ExpireQuotes expires the quoted and accepted quotes of all stores whose
+// expiry is at or before now, with the events of their expiry. It returns the
+// number of quotes expired.
+func (r *PostgresRepository) ExpireQuotes(ctx context.Context, now time.Time) (int, error) {
+	query := `
+		WITH expired AS (
+			UPDATE quotes
+			SET status = 'expired', updated_at = CURRENT_TIMESTAMP
+			WHERE status IN ('quoted', 'accepted') AND expires_at <= $1
+			RETURNING tenant_id, quote_id, user_id, total, expires_at
+		)
+		INSERT INTO quote_events (tenant_id, quote_id, user_id, email, status, total, expires_at)
+		SELECT e.tenant_id, e.quote_id, e.user_id, u.email, 'expired', e.total, e.expires_at
+		FROM expired e
+		JOIN users u ON u.user_id = e.user_id`
+
+	result, err := r.ExecuteExec(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to expire quotes: %w", err)
+	}
+	expired, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return int(expired), nil
+}
+
+// ListQuoteEvents lists the quote events of the current store after
+// afterID, in ID order
+func (r *PostgresRepository) ListQuoteEvents(ctx context.Context, afterID int64, limit int) ([]models.QuoteEvent, error) {
+	query := `
+		SELECT ` + quoteEventColumns + `
+		FROM quote_events
+		WHERE tenant_id = $1 AND id > $2
+		ORDER BY id
+		LIMIT $3`
+
+	// Read from the master: consumers resume right after the last event
+	rows, err := r.GetMaster().QueryContext(ctx, query, tenant.FromContext(ctx), afterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query quote events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []models.QuoteEvent{}
+	for rows.Next() {
+		var event models.QuoteEvent
+		var total sql.NullFloat64
+		var expiresAt sql.NullTime
+		if err := rows.Scan(
+			&event.ID,
+			&event.QuoteID,
+			&event.UserID,
+			&event.Email,
+			&event.Status,
+			&total,
+			&expiresAt,
+			&event.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan quote event: %w", err)
+		}
+		if total.Valid {
+			event.Total = &total.Float64
+		}
+		if expiresAt.Valid {
+			event.ExpiresAt = &expiresAt.Time
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating quote event rows: %w", err)
+	}
+
+	return events, nil
+}
+
+// insertQuoteEvent records the event of the current status of a quote, for
+// the buyer's email
+func insertQuoteEvent(ctx context.Context, db execer, quote *models.Quote) error {
+	_, err := db.ExecContext(ctx, `
+		INSERT INTO quote_events (tenant_id, quote_id, user_id, email, status, total, expires_at)
+		SELECT $1, $2, u.user_id, u.email, $4, $5, $6
+		FROM users u
+		WHERE u.user_id = $3`,
+		tenant.FromContext(ctx), quote.QuoteID, quote.UserID, quote.Status, quote.Total, quote.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to record quote event: %w", err)
+	}
+	return nil
+}
+
+// scanQuote scans a row of quoteColumns followed by extra columns, returning
+// sql.ErrNoRows as is
+func scanQuote(row interface{ Scan(...interface{}) error }, extra ...interface{}) (*models.Quote, error) {
+	var quote models.Quote
+	var companyID uuid.NullUUID
+	var items []byte
+	var total sql.NullFloat64
+	var expiresAt, quotedAt, convertedAt sql.NullTime
+	dest := append([]interface{}{
+		&quote.QuoteID,
+		&quote.UserID,
+		&companyID,
+		&quote.Status,
+		&items,
+		&quote.BuyerNotes,
+		&quote.AdminNotes,
+		&total,
+		&expiresAt,
+		&quote.OrderReference,
+		&quote.CreatedAt,
+		&quotedAt,
+		&convertedAt,
+		&quote.UpdatedAt,
+	}, extra...)
+	err := row.Scan(dest...)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan quote: %w", err)
+	}
+	if err := json.Unmarshal(items, &quote.Items); err != nil {
+		return nil, fmt.Errorf("failed to decode quote items: %w", err)
+	}
+	if companyID.Valid {
+		quote.CompanyID = &companyID.UUID
+	}
+	if total.Valid {
+		quote.Total = &total.Float64
+	}
+	if expiresAt.Valid {
+		quote.ExpiresAt = &expiresAt.Time
+	}
+	if quotedAt.Valid {
+		quote.QuotedAt = &quotedAt.Time
+	}
+	if convertedAt.Valid {
+		quote.ConvertedAt = &convertedAt.Time
+	}
+	return &quote, nil
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/louai60/e-commerce_project/backend/user-service/models"
@@ -17,6 +19,7 @@ type Repository interface {
 	ListUsers(ctx context.Context, page, limit int, where string, args ...interface{}) ([]*models.User, error)
 	CountUsers(ctx context.Context, where string, args ...interface{}) (int64, error)
 	UpdateRefreshTokenID(ctx context.Context, userID uuid.UUID, refreshTokenID string) error
+	PatchUserMetadata(ctx context.Context, userID uuid.UUID, patch json.RawMessage) (json.RawMessage, error)
 
 	// Address operations
 	CreateAddress(ctx context.Context, address *models.UserAddress) error
@@ -37,6 +40,80 @@ type Repository interface {
 	GetPreferences(ctx context.Context, userID uuid.UUID) (*models.UserPreferences, error)
 	UpdatePreferences(ctx context.Context, prefs *models.UserPreferences) error
 
+	// Staff note operations
+	CreateUserNote(ctx context.Context, note *models.UserNote) error
+	GetUserNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) (*models.UserNote, error)
+	ListUserNotes(ctx context.Context, userID uuid.UUID) ([]models.UserNote, error)
+	UpdateUserNote(ctx context.Context, note *models.UserNote) error
+	DeleteUserNote(ctx context.Context, noteID uuid.UUID, userID uuid.UUID) error
+
+	// Shopper list operations; owners are user IDs or guest session IDs
+	ListShopperItems(ctx context.Context, ownerID, list string) ([]models.ShopperListItem, error)
+	UpsertShopperItem(ctx context.Context, item *models.ShopperListItem) error
+	DeleteShopperItem(ctx context.Context, ownerID, list, productID, variantID string) error
+	TrimShopperList(ctx context.Context, ownerID, list string, keep int) error
+	MergeShopperLists(ctx context.Context, guestID, userID string, keepRecent int) (*models.GuestMergeResult, error)
+
+	// Cart reminder operations
+	CreateCartReminders(ctx context.Context, changedAfter, changedBefore time.Time) (int, error)
+	ListCartReminders(ctx context.Context, afterID int64, limit int) ([]models.CartReminder, error)
+	ConvertCartReminder(ctx context.Context, userID uuid.UUID, orderReference string, remindedSince time.Time) (*models.CartReminder, error)
+	GetCartReminderStats(ctx context.Context, from, to time.Time) (*models.CartReminderStats, error)
+
+	// User event operations
+	CreateUserEvent(ctx context.Context, event *models.UserEvent) error
+	ListUserEvents(ctx context.Context, beforeID int64, limit int) ([]models.UserEvent, error)
+
+	// Staff permission operations
+	GetStaffPermissions(ctx context.Context, userID uuid.UUID) ([]string, error)
+	SetStaffPermissions(ctx context.Context, userID uuid.UUID, sets []string, grantedBy uuid.UUID) error
+	ListStaff(ctx context.Context) ([]*models.User, error)
+
+	// Invitation operations
+	SaveInvitation(ctx context.Context, invitation *models.Invitation) error
+	MarkInvitationSent(ctx context.Context, userID uuid.UUID) error
+	GetInvitation(ctx context.Context, userID uuid.UUID) (*models.Invitation, error)
+	AcceptInvitation(ctx context.Context, tokenHash, hashedPassword string, now time.Time) (*models.Invitation, error)
+
+	// Company operations
+	CreateCompany(ctx context.Context, company *models.Company) error
+	UpdateCompany(ctx context.Context, company *models.Company) error
+	GetCompany(ctx context.Context, companyID uuid.UUID) (*models.Company, error)
+	ListCompanies(ctx context.Context, page, limit int) ([]*models.Company, int64, error)
+	SetCompanyMember(ctx context.Context, member *models.CompanyMember) error
+	DeleteCompanyMember(ctx context.Context, companyID, userID uuid.UUID) error
+	ListCompanyMembers(ctx context.Context, companyID uuid.UUID) ([]models.CompanyMember, error)
+	GetCompanyMembership(ctx context.Context, userID uuid.UUID) (*models.CompanyMember, error)
+	CreateCompanyAddress(ctx context.Context, address *models.CompanyAddress) error
+	DeleteCompanyAddress(ctx context.Context, companyID, addressID uuid.UUID) error
+	ListCompanyAddresses(ctx context.Context, companyID uuid.UUID) ([]models.CompanyAddress, error)
+	CreatePurchaseApproval(ctx context.Context, approval *models.PurchaseApproval) error
+	GetPurchaseApproval(ctx context.Context, approvalID uuid.UUID) (*models.PurchaseApproval, error)
+	DecidePurchaseApproval(ctx context.Context, approval *models.PurchaseApproval) error
+	ListPurchaseApprovals(ctx context.Context, filter models.PurchaseApprovalFilter, page, limit int) ([]models.PurchaseApproval, int64, error)
+
+	// Quote operations
+	CreateQuote(ctx context.Context, quote *models.Quote) error
+	GetQuote(ctx context.Context, quoteID uuid.UUID) (*models.Quote, error)
+	ListQuotes(ctx context.Context, filter models.QuoteFilter, page, limit int) ([]models.Quote, int64, error)
+	UpdateQuoteStatus(ctx context.Context, quote *models.Quote, from ...string) error
+	ExpireQuotes(ctx context.Context, now time.Time) (int, error)
+	ListQuoteEvents(ctx context.Context, afterID int64, limit int) ([]models.QuoteEvent, error)
+
+	// Credit account operations
+	SetCreditLimit(ctx context.Context, companyID uuid.UUID, limit float64) (*models.CreditAccount, error)
+	GetCreditAccount(ctx context.Context, companyID uuid.UUID, now time.Time) (*models.CreditAccount, error)
+	ChargeCredit(ctx context.Context, charge *models.CreditCharge) error
+	RecordCreditPayment(ctx context.Context, payment *models.CreditPayment) error
+	GetCreditStatement(ctx context.Context, companyID uuid.UUID, from, to time.Time) (*models.CreditStatement, error)
+	MarkOverdueCredit(ctx context.Context, now time.Time) (int, error)
+	ListCreditEvents(ctx context.Context, afterID int64, limit int) ([]models.CreditEvent, error)
+
+	// User stats operations
+	RecordOrderEvent(ctx context.Context, eventID string, event *models.OrderEvent) (*models.UserStats, bool, error)
+	GetUserStats(ctx context.Context, userID uuid.UUID) (*models.UserStats, error)
+	ListUserStats(ctx context.Context, filter models.UserStatsFilter, page, limit int) ([]models.UserStats, int64, error)
+
 	// Database health check
 	Ping(ctx context.Context) error
 }
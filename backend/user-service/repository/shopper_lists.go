@@ -0,0 +1,210 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// Shopper list operations
+
+const shopperListItemColumns = `owner_id, list, product_id, variant_id, quantity, added_at, updated_at, personalization`
+
+// ListShopperItems returns a list of an owner. Recently viewed products come
+// most recent first, other lists in the order items were added.
+func (r *PostgresRepository) ListShopperItems(ctx context.Context, ownerID, list string) ([]models.ShopperListItem, error) {
+	order := "added_at, product_id, variant_id"
+	if list == models.ListRecentlyViewed {
+		order = "updated_at DESC"
+	}
+	query := `
+		SELECT ` + shopperListItemColumns + `
+		FROM shopper_list_items
+		WHERE tenant_id = $1 AND owner_id = $2 AND list = $3
+		ORDER BY ` + order
+
+	// Read from the master: lists are read right after they are changed
+	rows, err := r.GetMaster().QueryContext(ctx, query, tenant.FromContext(ctx), ownerID, list)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s items: %w", list, err)
+	}
+	defer rows.Close()
+
+	items := []models.ShopperListItem{}
+	for rows.Next() {
+		var item models.ShopperListItem
+		var personalization []byte
+		if err := rows.Scan(
+			&item.OwnerID,
+			&item.List,
+			&item.ProductID,
+			&item.VariantID,
+			&item.Quantity,
+			&item.AddedAt,
+			&item.UpdatedAt,
+			&personalization,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan %s item: %w", list, err)
+		}
+		if err := json.Unmarshal(personalization, &item.Personalization); err != nil {
+			return nil, fmt.Errorf("failed to decode %s item personalization: %w", list, err)
+		}
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating %s rows: %w", list, err)
+	}
+
+	return items, nil
+}
+
+// UpsertShopperItem adds an item to a list, or sets the quantity and
+// personalization of the item already there
+func (r *PostgresRepository) UpsertShopperItem(ctx context.Context, item *models.ShopperListItem) error {
+	personalization, err := json.Marshal(item.Personalization)
+	if err != nil {
+		return fmt.Errorf("failed to encode personalization: %w", err)
+	}
+	if item.Personalization == nil {
+		personalization = []byte(`[]`)
+	}
+
+	query := `
+		INSERT INTO shopper_list_items (tenant_id, owner_id, list, product_id, variant_id, quantity, personalization)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (tenant_id, owner_id, list, product_id, variant_id)
+		DO UPDATE SET
+			quantity = EXCLUDED.quantity,
+			personalization = EXCLUDED.personalization,
+			updated_at = CURRENT_TIMESTAMP
+		RETURNING added_at, updated_at`
+
+	// Use ExecuteQueryRow for write operations (will use master)
+	return r.ExecuteQueryRow(ctx, query,
+		tenant.FromContext(ctx), item.OwnerID, item.List, item.ProductID, item.VariantID, item.Quantity, personalization,
+	).Scan(&item.AddedAt, &item.UpdatedAt)
+}
+
+// DeleteShopperItem removes an item from a list
+func (r *PostgresRepository) DeleteShopperItem(ctx context.Context, ownerID, list, productID, variantID string) error {
+	query := `
+		DELETE FROM shopper_list_items
+		WHERE tenant_id = $1 AND owner_id = $2 AND list = $3 AND product_id = $4 AND variant_id = $5`
+
+	result, err := r.ExecuteExec(ctx, query, tenant.FromContext(ctx), ownerID, list, productID, variantID)
+	if err != nil {
+		return fmt.Errorf("failed to delete %s item: %w", list, err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if rowsAffected == 0 {
+		return models.ErrListItemNotFound
+	}
+
+	return nil
+}
+
+// TrimShopperList keeps the keep most recently updated items of a list
+func (r *PostgresRepository) TrimShopperList(ctx context.Context, ownerID, list string, keep int) error {
+	return trimShopperList(ctx, r.GetMaster(), ownerID, list, keep)
+}
+
+// MergeShopperLists moves the lists of a guest into those of a user, in one
+// transaction. Cart quantities of products in both carts add up and keep the
+// most recent personalization; other lists keep the most recent update of
+// their common products. The recently viewed
+// products of the user are then trimmed to keepRecent.
+func (r *PostgresRepository) MergeShopperLists(ctx context.Context, guestID, userID string, keepRecent int) (*models.GuestMergeResult, error) {
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	rows, err := tx.QueryContext(ctx, `
+		INSERT INTO shopper_list_items (tenant_id, owner_id, list, product_id, variant_id, quantity, added_at, updated_at, personalization)
+		SELECT tenant_id, $3, list, product_id, variant_id, quantity, added_at, updated_at, personalization
+		FROM shopper_list_items
+		WHERE tenant_id = $1 AND owner_id = $2
+		ON CONFLICT (tenant_id, owner_id, list, product_id, variant_id)
+		DO UPDATE SET
+			quantity = CASE WHEN shopper_list_items.list = 'cart'
+				THEN LEAST(shopper_list_items.quantity + EXCLUDED.quantity, $4)
+				ELSE shopper_list_items.quantity END,
+			personalization = CASE WHEN EXCLUDED.updated_at > shopper_list_items.updated_at
+				THEN EXCLUDED.personalization
+				ELSE shopper_list_items.personalization END,
+			updated_at = GREATEST(shopper_list_items.updated_at, EXCLUDED.updated_at)
+		RETURNING list`,
+		tenantID, guestID, userID, models.MaxCartQuantity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge guest lists: %w", err)
+	}
+	result := &models.GuestMergeResult{}
+	for rows.Next() {
+		var list string
+		if err := rows.Scan(&list); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan merged item: %w", err)
+		}
+		switch list {
+		case models.ListCart:
+			result.Cart++
+		case models.ListWishlist:
+			result.Wishlist++
+		case models.ListRecentlyViewed:
+			result.RecentlyViewed++
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating merged items: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM shopper_list_items WHERE tenant_id = $1 AND owner_id = $2`,
+		tenantID, guestID); err != nil {
+		return nil, fmt.Errorf("failed to delete guest lists: %w", err)
+	}
+	if err := trimShopperList(ctx, tx, userID, models.ListRecentlyViewed, keepRecent); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit guest merge: %w", err)
+	}
+	return result, nil
+}
+
+// execer runs statements on the database or in a transaction
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+func trimShopperList(ctx context.Context, db execer, ownerID, list string, keep int) error {
+	_, err := db.ExecContext(ctx, `
+		DELETE FROM shopper_list_items
+		WHERE tenant_id = $1 AND owner_id = $2 AND list = $3
+			AND (product_id, variant_id) NOT IN (
+				SELECT product_id, variant_id
+				FROM shopper_list_items
+				WHERE tenant_id = $1 AND owner_id = $2 AND list = $3
+				ORDER BY updated_at DESC
+				LIMIT $4
+			)`,
+		tenant.FromContext(ctx), ownerID, list, keep)
+	if err != nil {
+		return fmt.Errorf("failed to trim %s: %w", list, err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// Staff permission operations
+
+// GetStaffPermissions returns the permission sets of a user of the current
+// store, in name order
+func (r *PostgresRepository) GetStaffPermissions(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	query := `
+		SELECT permission_set
+		FROM staff_permissions
+		WHERE tenant_id = $1 AND user_id = $2
+		ORDER BY permission_set`
+
+	// Read from the master: tokens issued right after a change must grant it
+	rows, err := r.GetMaster().QueryContext(ctx, query, tenant.FromContext(ctx), userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query staff permissions: %w", err)
+	}
+	defer rows.Close()
+
+	sets := []string{}
+	for rows.Next() {
+		var set string
+		if err := rows.Scan(&set); err != nil {
+			return nil, fmt.Errorf("failed to scan staff permission: %w", err)
+		}
+		sets = append(sets, set)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating staff permission rows: %w", err)
+	}
+
+	return sets, nil
+}
+
+// SetStaffPermissions replaces the permission sets of a user of the current
+// store, in one transaction. Sets kept keep their grant.
+func (r *PostgresRepository) SetStaffPermissions(ctx context.Context, userID uuid.UUID, sets []string, grantedBy uuid.UUID) error {
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM staff_permissions
+		WHERE tenant_id = $1 AND user_id = $2 AND NOT (permission_set = ANY($3))`,
+		tenantID, userID, pq.Array(sets)); err != nil {
+		return fmt.Errorf("failed to revoke staff permissions: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO staff_permissions (tenant_id, user_id, permission_set, granted_by)
+		SELECT $1, $2, set, $4 FROM UNNEST($3::text[]) AS set
+		ON CONFLICT (tenant_id, user_id, permission_set) DO NOTHING`,
+		tenantID, userID, pq.Array(sets), grantedBy); err != nil {
+		return fmt.Errorf("failed to grant staff permissions: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// ListStaff lists the staff accounts of the current store with their
+// permission sets, by email
+func (r *PostgresRepository) ListStaff(ctx context.Context) ([]*models.User, error) {
+	query := `
+		SELECT u.user_id, u.username, u.email, u.first_name, u.last_name, u.phone_number,
+			u.user_type, u.role, u.account_status, u.created_at, u.updated_at, u.last_login,
+			COALESCE(ARRAY_AGG(p.permission_set ORDER BY p.permission_set)
+				FILTER (WHERE p.permission_set IS NOT NULL), '{}')
+		FROM users u
+		LEFT JOIN staff_permissions p ON p.tenant_id = u.tenant_id AND p.user_id = u.user_id
+		WHERE u.tenant_id = $1 AND u.user_type = $2 AND u.role = $3
+		GROUP BY u.user_id
+		ORDER BY u.email`
+
+	// Use ExecuteQuery for read operations (will use replica if available)
+	rows, err := r.ExecuteQuery(ctx, query, tenant.FromContext(ctx), models.UserTypeAdmin, models.RoleStaff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query staff: %w", err)
+	}
+	defer rows.Close()
+
+	staff := []*models.User{}
+	for rows.Next() {
+		user := &models.User{}
+		err := rows.Scan(
+			&user.UserID,
+			&user.Username,
+			&user.Email,
+			&user.FirstName,
+			&user.LastName,
+			&user.PhoneNumber,
+			&user.UserType,
+			&user.Role,
+			&user.AccountStatus,
+			&user.CreatedAt,
+			&user.UpdatedAt,
+			&user.LastLogin,
+			pq.Array(&user.PermissionSets),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan staff: %w", err)
+		}
+		staff = append(staff, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating staff rows: %w", err)
+	}
+
+	return staff, nil
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// User event operations
+
+// CreateUserEvent records an event of a user of the current store
+func (r *PostgresRepository) CreateUserEvent(ctx context.Context, event *models.UserEvent) error {
+	query := `
+		INSERT INTO user_events (tenant_id, user_id, email, event_type, actor_id)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`
+
+	err := r.ExecuteQueryRow(ctx, query, tenant.FromContext(ctx), event.UserID, event.Email, event.Type, event.ActorID).
+		Scan(&event.ID, &event.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create user event: %w", err)
+	}
+	return nil
+}
+
+// ListUserEvents lists up to limit events of the current store before
+// beforeID, newest first; the latest ones when beforeID is 0
+func (r *PostgresRepository) ListUserEvents(ctx context.Context, beforeID int64, limit int) ([]models.UserEvent, error) {
+	query := `
+		SELECT id, user_id, email, event_type, actor_id, created_at
+		FROM user_events
+		WHERE tenant_id = $1 AND ($2::bigint = 0 OR id < $2)
+		ORDER BY id DESC
+		LIMIT $3`
+
+	rows, err := r.ExecuteQuery(ctx, query, tenant.FromContext(ctx), beforeID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []models.UserEvent{}
+	for rows.Next() {
+		var event models.UserEvent
+		if err := rows.Scan(&event.ID, &event.UserID, &event.Email, &event.Type, &event.ActorID, &event.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan user event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user event rows: %w", err)
+	}
+
+	return events, nil
+}
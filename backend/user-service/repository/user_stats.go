@@ -0,0 +1,176 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// User stats operations
+
+const userStatsColumns = `user_id, total_orders, total_spend, last_order_at, updated_at`
+
+// RecordOrderEvent stores an order event of a user and recomputes their
+// lifetime metrics from all their events, in one transaction. An event ID
+// already stored is not recorded again. It returns the metrics of the user
+// and whether the event was recorded.
+func (r *PostgresRepository) RecordOrderEvent(ctx context.Context, eventID string, event *models.OrderEvent) (*models.UserStats, bool, error) {
+	tx, err := r.BeginTx(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	tenantID := tenant.FromContext(ctx)
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO user_order_events (tenant_id, event_id, user_id, order_reference, event_type, amount, occurred_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT (tenant_id, event_id) DO NOTHING`,
+		tenantID, eventID, event.UserID, event.OrderReference, event.Type, event.Amount, event.OccurredAt)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to record order event: %w", err)
+	}
+	recorded, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	// Orders count once placed and until cancelled; refunds lower the spend
+	stats, err := scanUserStats(tx.QueryRowContext(ctx, `
+		INSERT INTO user_stats (tenant_id, user_id, total_orders, total_spend, last_order_at, updated_at)
+		SELECT $1, $2,
+			COUNT(*) FILTER (WHERE e.event_type = 'placed' AND NOT e.cancelled),
+			COALESCE(SUM(CASE
+				WHEN e.event_type = 'placed' AND NOT e.cancelled THEN e.amount
+				WHEN e.event_type = 'refunded' THEN -e.amount
+				ELSE 0 END), 0),
+			MAX(e.occurred_at) FILTER (WHERE e.event_type = 'placed' AND NOT e.cancelled),
+			CURRENT_TIMESTAMP
+		FROM (
+			SELECT oe.event_type, oe.amount, oe.occurred_at,
+				EXISTS (
+					SELECT 1 FROM user_order_events c
+					WHERE c.tenant_id = oe.tenant_id AND c.order_reference = oe.order_reference
+						AND c.event_type = 'cancelled'
+				) AS cancelled
+			FROM user_order_events oe
+			WHERE oe.tenant_id = $1 AND oe.user_id = $2
+		) e
+		ON CONFLICT (tenant_id, user_id) DO UPDATE SET
+			total_orders = EXCLUDED.total_orders,
+			total_spend = EXCLUDED.total_spend,
+			last_order_at = EXCLUDED.last_order_at,
+			updated_at = EXCLUDED.updated_at
+		RETURNING `+userStatsColumns,
+		tenantID, event.UserID))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to update user stats: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return stats, recorded > 0, nil
+}
+
+// GetUserStats returns the lifetime metrics of a user of the current store.
+// Users without order events have zero metrics.
+func (r *PostgresRepository) GetUserStats(ctx context.Context, userID uuid.UUID) (*models.UserStats, error) {
+	stats, err := scanUserStats(r.ExecuteQueryRow(ctx, `
+		SELECT `+userStatsColumns+`
+		FROM user_stats
+		WHERE tenant_id = $1 AND user_id = $2`,
+		tenant.FromContext(ctx), userID))
+	if err == sql.ErrNoRows {
+		return &models.UserStats{UserID: userID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// ListUserStats lists the metrics of the customers of the current store
+// matching filter, highest spend first, and counts all matches
+func (r *PostgresRepository) ListUserStats(ctx context.Context, filter models.UserStatsFilter, page, limit int) ([]models.UserStats, int64, error) {
+	conditions := []string{"tenant_id = $1"}
+	args := []interface{}{tenant.FromContext(ctx)}
+	if filter.MinOrders > 0 {
+		args = append(args, filter.MinOrders)
+		conditions = append(conditions, fmt.Sprintf("total_orders >= $%d", len(args)))
+	}
+	if filter.MinSpend > 0 {
+		args = append(args, filter.MinSpend)
+		conditions = append(conditions, fmt.Sprintf("total_spend >= $%d", len(args)))
+	}
+	if !filter.LastOrderAfter.IsZero() {
+		args = append(args, filter.LastOrderAfter)
+		conditions = append(conditions, fmt.Sprintf("last_order_at >= $%d", len(args)))
+	}
+	if !filter.LastOrderBefore.IsZero() {
+		args = append(args, filter.LastOrderBefore)
+		conditions = append(conditions, fmt.Sprintf("last_order_at < $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s, COUNT(*) OVER()
+		FROM user_stats
+		WHERE %s
+		ORDER BY total_spend DESC, user_id
+		LIMIT %d OFFSET %d`,
+		userStatsColumns, strings.Join(conditions, " AND "), limit, (page-1)*limit)
+
+	// Use ExecuteQuery for read operations (will use replica if available)
+	rows, err := r.ExecuteQuery(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query user stats: %w", err)
+	}
+	defer rows.Close()
+
+	list := []models.UserStats{}
+	var total int64
+	for rows.Next() {
+		stats, err := scanUserStats(rows, &total)
+		if err != nil {
+			return nil, 0, err
+		}
+		list = append(list, *stats)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating user stats rows: %w", err)
+	}
+
+	return list, total, nil
+}
+
+// scanUserStats scans a row of userStatsColumns followed by extra columns,
+// returning sql.ErrNoRows as is
+func scanUserStats(row interface{ Scan(...interface{}) error }, extra ...interface{}) (*models.UserStats, error) {
+	var stats models.UserStats
+	var lastOrderAt sql.NullTime
+	dest := append([]interface{}{
+		&stats.UserID,
+		&stats.TotalOrders,
+		&stats.TotalSpend,
+		&lastOrderAt,
+		&stats.UpdatedAt,
+	}, extra...)
+	err := row.Scan(dest...)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan user stats: %w", err)
+	}
+	if lastOrderAt.Valid {
+		stats.LastOrderAt = &lastOrderAt.Time
+	}
+	return &stats, nil
+}
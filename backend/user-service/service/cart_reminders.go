@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// CartReminderConfig configures the detection of abandoned carts. Every
+// Interval, carts whose last change is older than InactiveAfter, but not
+// older than MaxAge, are recorded as reminders for the notification service.
+type CartReminderConfig struct {
+	Interval      time.Duration
+	InactiveAfter time.Duration
+	MaxAge        time.Duration
+}
+
+// StartCartReminderScheduler records the reminders of abandoned carts every
+// config.Interval until ctx is done
+func (s *UserService) StartCartReminderScheduler(ctx context.Context, config CartReminderConfig) {
+	if config.Interval <= 0 || config.InactiveAfter <= 0 || config.MaxAge <= config.InactiveAfter {
+		s.logger.Warn("Cart reminders disabled by their configuration",
+			zap.Duration("interval", config.Interval),
+			zap.Duration("inactive_after", config.InactiveAfter),
+			zap.Duration("max_age", config.MaxAge))
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(config.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			now := time.Now()
+			created, err := s.repo.CreateCartReminders(ctx, now.Add(-config.MaxAge), now.Add(-config.InactiveAfter))
+			if err != nil {
+				s.logger.Error("Failed to detect abandoned carts", zap.Error(err))
+				continue
+			}
+			if created > 0 {
+				s.logger.Info("Abandoned carts detected", zap.Int("reminders", created))
+			}
+		}
+	}()
+}
+
+// ListCartReminderEvents returns the reminders of abandoned carts recorded
+// after afterID, for the notification service to email
+func (s *UserService) ListCartReminderEvents(ctx context.Context, afterID int64, limit int) ([]models.CartReminder, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 500 {
+		limit = 500
+	}
+	return s.repo.ListCartReminders(ctx, afterID, limit)
+}
+
+// RecordCartConversion attributes an order of a user to the latest reminder
+// of their cart sent within models.CartReminderConversionWindow. It returns
+// nil when the user was not reminded in that window.
+func (s *UserService) RecordCartConversion(ctx context.Context, userID uuid.UUID, orderReference string) (*models.CartReminder, error) {
+	orderReference = strings.TrimSpace(orderReference)
+	if orderReference == "" {
+		return nil, models.ErrInvalidOrderReference
+	}
+
+	reminder, err := s.repo.ConvertCartReminder(ctx, userID, orderReference, time.Now().Add(-models.CartReminderConversionWindow))
+	if err != nil {
+		return nil, err
+	}
+	if reminder != nil {
+		s.logger.Info("Reminded cart converted",
+			zap.String("user_id", userID.String()),
+			zap.Int64("reminder_id", reminder.ID),
+			zap.String("order_reference", orderReference))
+	}
+	return reminder, nil
+}
+
+// GetCartReminderStats counts the reminders sent in [from, to) and those
+// followed by an order
+func (s *UserService) GetCartReminderStats(ctx context.Context, from, to time.Time) (*models.CartReminderStats, error) {
+	if !from.Before(to) {
+		return nil, models.ErrInvalidStatsPeriod
+	}
+	return s.repo.GetCartReminderStats(ctx, from, to)
+}
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// CreateCompany creates a B2B account, prepaid unless other payment terms
+// are set
+func (s *UserService) CreateCompany(ctx context.Context, company *models.Company) (*models.Company, error) {
+	if err := validateCompany(company); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateCompany(ctx, company); err != nil {
+		return nil, err
+	}
+	company.Members = []models.CompanyMember{}
+	company.Addresses = []models.CompanyAddress{}
+	return company, nil
+}
+
+// UpdateCompany updates the name, payment terms and approval threshold of a
+// company. Pending purchase approvals keep waiting for an approver.
+func (s *UserService) UpdateCompany(ctx context.Context, company *models.Company) (*models.Company, error) {
+	if err := validateCompany(company); err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateCompany(ctx, company); err != nil {
+		return nil, err
+	}
+	return s.GetCompany(ctx, company.CompanyID)
+}
+
+// GetCompany returns a company with its members and shared addresses
+func (s *UserService) GetCompany(ctx context.Context, companyID uuid.UUID) (*models.Company, error) {
+	company, err := s.repo.GetCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	if company.Members, err = s.repo.ListCompanyMembers(ctx, companyID); err != nil {
+		return nil, err
+	}
+	if company.Addresses, err = s.repo.ListCompanyAddresses(ctx, companyID); err != nil {
+		return nil, err
+	}
+	return company, nil
+}
+
+// ListCompanies lists the companies of the store by name
+func (s *UserService) ListCompanies(ctx context.Context, page, limit int) ([]*models.Company, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return s.repo.ListCompanies(ctx, page, limit)
+}
+
+// SetCompanyMember adds a customer to a company with a role, or changes
+// their role in it
+func (s *UserService) SetCompanyMember(ctx context.Context, companyID, userID uuid.UUID, role string) (*models.CompanyMember, error) {
+	if !models.IsValidCompanyRole(role) {
+		return nil, models.ErrInvalidCompanyRole
+	}
+	if _, err := s.repo.GetCompany(ctx, companyID); err != nil {
+		return nil, err
+	}
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.UserType != models.UserTypeCustomer {
+		return nil, models.ErrNotCustomer
+	}
+
+	member := &models.CompanyMember{
+		CompanyID: companyID,
+		UserID:    userID,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Role:      role,
+	}
+	if err := s.repo.SetCompanyMember(ctx, member); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Company member set",
+		zap.String("company_id", companyID.String()),
+		zap.String("user_id", userID.String()),
+		zap.String("role", role))
+	return member, nil
+}
+
+// RemoveCompanyMember removes a user from a company. Their pending purchase
+// approvals stay for the approvers to decide.
+func (s *UserService) RemoveCompanyMember(ctx context.Context, companyID, userID uuid.UUID) error {
+	return s.repo.DeleteCompanyMember(ctx, companyID, userID)
+}
+
+// AddCompanyAddress adds an address shared by the members of a company. A
+// default address replaces the default of its type.
+func (s *UserService) AddCompanyAddress(ctx context.Context, address *models.CompanyAddress) (*models.CompanyAddress, error) {
+	address.Label = strings.TrimSpace(address.Label)
+	address.AddressType = strings.TrimSpace(address.AddressType)
+	address.StreetAddress1 = strings.TrimSpace(address.StreetAddress1)
+	address.StreetAddress2 = strings.TrimSpace(address.StreetAddress2)
+	address.City = strings.TrimSpace(address.City)
+	address.State = strings.TrimSpace(address.State)
+	address.PostalCode = strings.TrimSpace(address.PostalCode)
+	address.Country = strings.TrimSpace(address.Country)
+	if !models.IsValidCompanyAddressType(address.AddressType) || address.StreetAddress1 == "" ||
+		address.City == "" || address.State == "" || address.PostalCode == "" || address.Country == "" {
+		return nil, models.ErrInvalidCompanyAddress
+	}
+
+	if _, err := s.repo.GetCompany(ctx, address.CompanyID); err != nil {
+		return nil, err
+	}
+	if err := s.repo.CreateCompanyAddress(ctx, address); err != nil {
+		return nil, err
+	}
+	return address, nil
+}
+
+// RemoveCompanyAddress removes a shared address of a company
+func (s *UserService) RemoveCompanyAddress(ctx context.Context, companyID, addressID uuid.UUID) error {
+	return s.repo.DeleteCompanyAddress(ctx, companyID, addressID)
+}
+
+// GetUserCompany returns the company of a user, with its members and shared
+// addresses, and the membership of the user
+func (s *UserService) GetUserCompany(ctx context.Context, userID uuid.UUID) (*models.Company, *models.CompanyMember, error) {
+	member, err := s.repo.GetCompanyMembership(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	company, err := s.GetCompany(ctx, member.CompanyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return company, member, nil
+}
+
+// RequestPurchaseApproval asks the approval of a purchase of a company
+// member at checkout. Purchases up to the approval threshold of the company
+// are approved at once; larger ones wait for an approver, and the order is
+// placed once approved.
+func (s *UserService) RequestPurchaseApproval(ctx context.Context, userID uuid.UUID, orderReference string, amount float64) (*models.PurchaseApproval, error) {
+	orderReference = strings.TrimSpace(orderReference)
+	if orderReference == "" || amount <= 0 {
+		return nil, models.ErrInvalidPurchase
+	}
+	member, err := s.repo.GetCompanyMembership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	company, err := s.repo.GetCompany(ctx, member.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	approval := &models.PurchaseApproval{
+		CompanyID:      company.CompanyID,
+		RequesterID:    userID,
+		OrderReference: orderReference,
+		Amount:         amount,
+		Status:         models.PurchasePending,
+	}
+	if !company.RequiresApproval(amount) {
+		now := time.Now()
+		approval.Status = models.PurchaseApproved
+		approval.DecidedAt = &now
+	}
+	if err := s.repo.CreatePurchaseApproval(ctx, approval); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Purchase approval requested",
+		zap.String("company_id", company.CompanyID.String()),
+		zap.String("approval_id", approval.ApprovalID.String()),
+		zap.String("order_reference", orderReference),
+		zap.String("status", approval.Status))
+	return approval, nil
+}
+
+// DecidePurchaseApproval approves or rejects a pending purchase on behalf of
+// an approver of the company. Approvers never decide their own purchases.
+func (s *UserService) DecidePurchaseApproval(ctx context.Context, approverID, approvalID uuid.UUID, approve bool, notes string) (*models.PurchaseApproval, error) {
+	approval, err := s.repo.GetPurchaseApproval(ctx, approvalID)
+	if err != nil {
+		return nil, err
+	}
+	member, err := s.repo.GetCompanyMembership(ctx, approverID)
+	if errors.Is(err, models.ErrCompanyMemberNotFound) {
+		return nil, models.ErrNotApprover
+	}
+	if err != nil {
+		return nil, err
+	}
+	if member.CompanyID != approval.CompanyID || member.Role != models.CompanyRoleApprover {
+		return nil, models.ErrNotApprover
+	}
+	if approval.RequesterID == approverID {
+		return nil, models.ErrSelfApproval
+	}
+
+	now := time.Now()
+	approval.Status = models.PurchaseRejected
+	if approve {
+		approval.Status = models.PurchaseApproved
+	}
+	approval.DecidedBy = &approverID
+	approval.DecidedAt = &now
+	approval.Notes = strings.TrimSpace(notes)
+	if err := s.repo.DecidePurchaseApproval(ctx, approval); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Purchase approval decided",
+		zap.String("approval_id", approvalID.String()),
+		zap.String("approver_id", approverID.String()),
+		zap.String("status", approval.Status))
+	return approval, nil
+}
+
+// ListUserPurchaseApprovals lists the purchase approvals a company member
+// sees: all those of the company for approvers, their own for purchasers
+func (s *UserService) ListUserPurchaseApprovals(ctx context.Context, userID uuid.UUID, status string, page, limit int) ([]models.PurchaseApproval, int64, error) {
+	member, err := s.repo.GetCompanyMembership(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	filter := models.PurchaseApprovalFilter{CompanyID: member.CompanyID, Status: status}
+	if member.Role != models.CompanyRoleApprover {
+		filter.RequesterID = &userID
+	}
+	return s.listPurchaseApprovals(ctx, filter, page, limit)
+}
+
+// ListCompanyPurchaseApprovals lists the purchase approvals of a company
+func (s *UserService) ListCompanyPurchaseApprovals(ctx context.Context, companyID uuid.UUID, status string, page, limit int) ([]models.PurchaseApproval, int64, error) {
+	if _, err := s.repo.GetCompany(ctx, companyID); err != nil {
+		return nil, 0, err
+	}
+	return s.listPurchaseApprovals(ctx, models.PurchaseApprovalFilter{CompanyID: companyID, Status: status}, page, limit)
+}
+
+func (s *UserService) listPurchaseApprovals(ctx context.Context, filter models.PurchaseApprovalFilter, page, limit int) ([]models.PurchaseApproval, int64, error) {
+	if filter.Status != "" && !models.IsValidPurchaseStatus(filter.Status) {
+		return nil, 0, models.ErrInvalidPurchaseStatus
+	}
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return s.repo.ListPurchaseApprovals(ctx, filter, page, limit)
+}
+
+func validateCompany(company *models.Company) error {
+	company.Name = strings.TrimSpace(company.Name)
+	if company.PaymentTerms == "" {
+		company.PaymentTerms = models.PaymentTermsPrepaid
+	}
+	if company.Name == "" || !models.IsValidPaymentTerms(company.PaymentTerms) ||
+		(company.ApprovalThreshold != nil && *company.ApprovalThreshold < 0) {
+		return models.ErrInvalidCompany
+	}
+	return nil
+}
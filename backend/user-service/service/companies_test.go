@@ -0,0 +1,50 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+func TestValidateCompany(t *testing.T) {
+	company := &models.Company{Name: "  Acme  "}
+	if err := validateCompany(company); err != nil {
+		t.Fatal(err)
+	}
+	if company.Name != "Acme" || company.PaymentTerms != models.PaymentTermsPrepaid {
+		t.Errorf("company = %+v, want name Acme and prepaid terms", company)
+	}
+
+	negative := -1.0
+	for _, invalid := range []*models.Company{
+		{Name: " "},
+		{Name: "Acme", PaymentTerms: "net_90"},
+		{Name: "Acme", ApprovalThreshold: &negative},
+	} {
+		if err := validateCompany(invalid); !errors.Is(err, models.ErrInvalidCompany) {
+			t.Errorf("validateCompany(%+v) = %v, want ErrInvalidCompany", invalid, err)
+		}
+	}
+}
+
+func TestRequiresApproval(t *testing.T) {
+	threshold := 500.0
+	company := &models.Company{ApprovalThreshold: &threshold}
+	if company.RequiresApproval(500) {
+		t.Error("purchase at the threshold requires approval")
+	}
+	if !company.RequiresApproval(500.01) {
+		t.Error("purchase above the threshold needs no approval")
+	}
+
+	zero := 0.0
+	company.ApprovalThreshold = &zero
+	if !company.RequiresApproval(1) {
+		t.Error("zero threshold lets purchases through")
+	}
+	company.ApprovalThreshold = nil
+	if company.RequiresApproval(1e9) {
+		t.Error("company without threshold requires approval")
+	}
+}
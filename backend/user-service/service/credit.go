@@ -0,0 +1,224 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// maxStatementPeriod bounds the period of credit statements
+const maxStatementPeriod = 366 * 24 * time.Hour
+
+// StartCreditOverdueScheduler marks the credit charges outstanding past their
+// due date as overdue every interval until ctx is done, so that their
+// purchasers are reminded
+func (s *UserService) StartCreditOverdueScheduler(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		s.logger.Warn("Overdue credit detection disabled by its configuration", zap.Duration("interval", interval))
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			marked, err := s.repo.MarkOverdueCredit(ctx, time.Now())
+			if err != nil {
+				s.logger.Error("Failed to mark overdue credit", zap.Error(err))
+				continue
+			}
+			if marked > 0 {
+				s.logger.Info("Credit charges overdue", zap.Int("charges", marked))
+			}
+		}
+	}()
+}
+
+// SetCreditLimit opens the credit account of a company with limit, or
+// changes the limit of its account
+func (s *UserService) SetCreditLimit(ctx context.Context, companyID uuid.UUID, limit float64) (*models.CreditAccount, error) {
+	if limit < 0 || math.IsNaN(limit) || math.IsInf(limit, 0) {
+		return nil, models.ErrInvalidCreditLimit
+	}
+	if _, err := s.repo.GetCompany(ctx, companyID); err != nil {
+		return nil, err
+	}
+
+	account, err := s.repo.SetCreditLimit(ctx, companyID, math.Round(limit*100)/100)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Credit limit set",
+		zap.String("company_id", companyID.String()),
+		zap.Float64("credit_limit", account.CreditLimit))
+	return s.repo.GetCreditAccount(ctx, companyID, time.Now())
+}
+
+// GetCreditAccount returns the credit account of a company
+func (s *UserService) GetCreditAccount(ctx context.Context, companyID uuid.UUID) (*models.CreditAccount, error) {
+	return s.repo.GetCreditAccount(ctx, companyID, time.Now())
+}
+
+// GetUserCreditAccount returns the credit account of the company of a user
+func (s *UserService) GetUserCreditAccount(ctx context.Context, userID uuid.UUID) (*models.CreditAccount, error) {
+	member, err := s.repo.GetCompanyMembership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetCreditAccount(ctx, member.CompanyID, time.Now())
+}
+
+// ChargeCredit places an approved purchase of a company member on the
+// credit of their company, due at the end of its payment terms. The order
+// reference and amount are those of the purchase approval, so that purchases
+// above the approval threshold are only charged once an approver agreed. The
+// order must fit in the available credit; charging the same order reference
+// again returns its charge.
+func (s *UserService) ChargeCredit(ctx context.Context, userID, approvalID uuid.UUID) (*models.CreditCharge, error) {
+	member, err := s.repo.GetCompanyMembership(ctx, userID)
+	if errors.Is(err, models.ErrCompanyMemberNotFound) {
+		return nil, models.ErrNotPurchaser
+	}
+	if err != nil {
+		return nil, err
+	}
+	approval, err := s.repo.GetPurchaseApproval(ctx, approvalID)
+	if err != nil {
+		return nil, err
+	}
+	company, err := s.repo.GetCompany(ctx, member.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCreditPurchase(member, approval, company); err != nil {
+		return nil, err
+	}
+	days := models.PaymentTermsDays(company.PaymentTerms)
+	if days == 0 {
+		return nil, models.ErrNoCreditTerms
+	}
+
+	charge := &models.CreditCharge{
+		CompanyID:      company.CompanyID,
+		UserID:         userID,
+		OrderReference: approval.OrderReference,
+		Amount:         math.Round(approval.Amount*100) / 100,
+		DueAt:          time.Now().AddDate(0, 0, days),
+	}
+	if err := s.repo.ChargeCredit(ctx, charge); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Order charged to credit",
+		zap.String("company_id", company.CompanyID.String()),
+		zap.String("approval_id", approvalID.String()),
+		zap.String("order_reference", charge.OrderReference),
+		zap.Float64("amount", charge.Amount),
+		zap.Time("due_at", charge.DueAt))
+	return charge, nil
+}
+
+// RecordCreditPayment records a payment received on the credit account of a
+// company, settling its oldest charges first
+func (s *UserService) RecordCreditPayment(ctx context.Context, companyID uuid.UUID, amount float64, reference string) (*models.CreditPayment, error) {
+	reference = strings.TrimSpace(reference)
+	if reference == "" || len(reference) > 100 || !(amount > 0) || math.IsInf(amount, 0) {
+		return nil, models.ErrInvalidCreditPayment
+	}
+
+	payment := &models.CreditPayment{
+		CompanyID: companyID,
+		Amount:    math.Round(amount*100) / 100,
+		Reference: reference,
+	}
+	if err := s.repo.RecordCreditPayment(ctx, payment); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Credit payment recorded",
+		zap.String("company_id", companyID.String()),
+		zap.Float64("amount", payment.Amount),
+		zap.String("reference", reference))
+	return payment, nil
+}
+
+// GetCreditStatement returns the statement of the credit account of a
+// company over [from, to). Without a period it covers the current month.
+func (s *UserService) GetCreditStatement(ctx context.Context, companyID uuid.UUID, from, to time.Time) (*models.CreditStatement, error) {
+	from, to, err := statementPeriod(from, to, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetCreditStatement(ctx, companyID, from, to)
+}
+
+// GetUserCreditStatement returns the statement of the credit account of the
+// company of a user over [from, to)
+func (s *UserService) GetUserCreditStatement(ctx context.Context, userID uuid.UUID, from, to time.Time) (*models.CreditStatement, error) {
+	member, err := s.repo.GetCompanyMembership(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.GetCreditStatement(ctx, member.CompanyID, from, to)
+}
+
+// ListCreditEvents returns the overdue charges recorded after afterID, for
+// the notification service
+func (s *UserService) ListCreditEvents(ctx context.Context, afterID int64, limit int) ([]models.CreditEvent, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 500 {
+		limit = 500
+	}
+	return s.repo.ListCreditEvents(ctx, afterID, limit)
+}
+
+// checkCreditPurchase checks that member may charge the purchase of approval
+// to the credit of company: members purchase for their company, their own
+// purchases only, once approved. A purchase approved at once when requested
+// needs an approver if the threshold of the company was lowered since.
+func checkCreditPurchase(member *models.CompanyMember, approval *models.PurchaseApproval, company *models.Company) error {
+	if !member.CanPurchase() {
+		return models.ErrNotPurchaser
+	}
+	// Purchases of others are not told apart from missing ones
+	if approval.CompanyID != member.CompanyID || approval.RequesterID != member.UserID {
+		return models.ErrPurchaseApprovalNotFound
+	}
+	if approval.Status != models.PurchaseApproved {
+		return models.ErrPurchaseNotApproved
+	}
+	if approval.DecidedBy == nil && company.RequiresApproval(approval.Amount) {
+		return models.ErrPurchaseNotApproved
+	}
+	return nil
+}
+
+// statementPeriod defaults an unset period to the month of now, and an unset
+// end to now
+func statementPeriod(from, to, now time.Time) (time.Time, time.Time, error) {
+	if from.IsZero() && to.IsZero() {
+		from = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return from, from.AddDate(0, 1, 0), nil
+	}
+	if to.IsZero() {
+		to = now
+	}
+	if !to.After(from) || to.Sub(from) > maxStatementPeriod {
+		return time.Time{}, time.Time{}, models.ErrInvalidStatementPeriod
+	}
+	return from, to, nil
+}
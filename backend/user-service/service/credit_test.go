@@ -0,0 +1,99 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+func TestStatementPeriod(t *testing.T) {
+	now := time.Date(2026, time.March, 14, 9, 30, 0, 0, time.UTC)
+
+	from, to, err := statementPeriod(time.Time{}, time.Time{}, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC); !from.Equal(want) || !to.Equal(want.AddDate(0, 1, 0)) {
+		t.Errorf("default period = [%v, %v), want the month of March", from, to)
+	}
+
+	start := now.AddDate(0, 0, -7)
+	if _, to, err := statementPeriod(start, time.Time{}, now); err != nil || !to.Equal(now) {
+		t.Errorf("statementPeriod without end = %v, %v, want it to end now", to, err)
+	}
+
+	for _, period := range [][2]time.Time{
+		{now, now},
+		{now, now.AddDate(0, 0, -1)},
+		{now.AddDate(-2, 0, 0), now},
+	} {
+		if _, _, err := statementPeriod(period[0], period[1], now); !errors.Is(err, models.ErrInvalidStatementPeriod) {
+			t.Errorf("statementPeriod(%v, %v) = %v, want ErrInvalidStatementPeriod", period[0], period[1], err)
+		}
+	}
+}
+
+func TestCreditAvailable(t *testing.T) {
+	account := &models.CreditAccount{CreditLimit: 1000, Balance: 250.5}
+	if available := account.Available(); available != 749.5 {
+		t.Errorf("Available = %v, want 749.5", available)
+	}
+	account.CreditLimit = 100
+	if available := account.Available(); available != 0 {
+		t.Errorf("Available over the limit = %v, want 0", available)
+	}
+
+	if days := models.PaymentTermsDays(models.PaymentTermsNet30); days != 30 {
+		t.Errorf("PaymentTermsDays(net_30) = %d, want 30", days)
+	}
+	if days := models.PaymentTermsDays(models.PaymentTermsPrepaid); days != 0 {
+		t.Errorf("PaymentTermsDays(prepaid) = %d, want 0", days)
+	}
+}
+
+func TestCheckCreditPurchase(t *testing.T) {
+	threshold := 500.0
+	company := &models.Company{CompanyID: uuid.New(), ApprovalThreshold: &threshold}
+	member := &models.CompanyMember{CompanyID: company.CompanyID, UserID: uuid.New(), Role: models.CompanyRolePurchaser}
+	approverID := uuid.New()
+	approval := func(amount float64, status string, decidedBy *uuid.UUID) *models.PurchaseApproval {
+		return &models.PurchaseApproval{
+			CompanyID:   company.CompanyID,
+			RequesterID: member.UserID,
+			Amount:      amount,
+			Status:      status,
+			DecidedBy:   decidedBy,
+		}
+	}
+
+	if err := checkCreditPurchase(member, approval(200, models.PurchaseApproved, nil), company); err != nil {
+		t.Errorf("purchase approved at once: %v", err)
+	}
+	if err := checkCreditPurchase(member, approval(900, models.PurchaseApproved, &approverID), company); err != nil {
+		t.Errorf("purchase approved by an approver: %v", err)
+	}
+
+	other := approval(200, models.PurchaseApproved, nil)
+	other.RequesterID = uuid.New()
+	tests := []struct {
+		name     string
+		member   *models.CompanyMember
+		approval *models.PurchaseApproval
+		want     error
+	}{
+		{"unknown role", &models.CompanyMember{CompanyID: company.CompanyID, UserID: member.UserID, Role: "viewer"}, approval(200, models.PurchaseApproved, nil), models.ErrNotPurchaser},
+		{"purchase of another member", member, other, models.ErrPurchaseApprovalNotFound},
+		{"pending", member, approval(900, models.PurchasePending, nil), models.ErrPurchaseNotApproved},
+		{"rejected", member, approval(900, models.PurchaseRejected, &approverID), models.ErrPurchaseNotApproved},
+		{"threshold lowered since", member, approval(600, models.PurchaseApproved, nil), models.ErrPurchaseNotApproved},
+	}
+	for _, tt := range tests {
+		if err := checkCreditPurchase(tt.member, tt.approval, company); !errors.Is(err, tt.want) {
+			t.Errorf("%s: checkCreditPurchase = %v, want %v", tt.name, err, tt.want)
+		}
+	}
+}
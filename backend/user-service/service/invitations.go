@@ -0,0 +1,326 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	netmail "net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	apperrors "github.com/louai60/e-commerce_project/backend/common/errors"
+	"github.com/louai60/e-commerce_project/backend/common/mail"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// InvitationConfig configures the invitations of imported users. URL is the
+// storefront page setting the password, with {token} where the invitation
+// token goes; invitations expire after TTL.
+type InvitationConfig struct {
+	URL string
+	TTL time.Duration
+}
+
+// SetInvitations sets the mailer invitation emails are sent with and their
+// configuration
+func (s *UserService) SetInvitations(mailer mail.Mailer, config InvitationConfig) {
+	if config.TTL <= 0 {
+		config.TTL = 7 * 24 * time.Hour
+	}
+	s.mailer = mailer
+	s.invitations = config
+}
+
+// importRow is a row of a user import
+type importRow struct {
+	email     string
+	firstName string
+	lastName  string
+	role      string
+}
+
+// ImportUsers creates the accounts of a CSV import in the invited status and
+// emails each user an invitation to set their password, on behalf of the
+// administrator actorID. The header names the columns: email, name (or
+// first_name and last_name) and role, which defaults to user. Each row is
+// validated and reported on its own; rows failing validation are skipped.
+func (s *UserService) ImportUsers(ctx context.Context, actorID uuid.UUID, data []byte) (*models.ImportResult, error) {
+	rows, err := parseImport(data)
+	if err != nil {
+		return nil, err
+	}
+	admin, err := s.isAdministrator(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !admin {
+		return nil, models.ErrImportDenied
+	}
+
+	result := &models.ImportResult{Rows: make([]models.ImportRowResult, len(rows))}
+	seen := map[string]bool{}
+	for i, row := range rows {
+		rowResult := models.ImportRowResult{Row: i + 1, Email: row.email}
+		if err := validateImportRow(row); err != nil {
+			rowResult.Status = models.ImportRowFailed
+			rowResult.Error = err.Error()
+		} else if seen[row.email] {
+			rowResult.Status = models.ImportRowFailed
+			rowResult.Error = "duplicate email in import"
+		} else {
+			rowResult = s.importUser(ctx, actorID, row, rowResult)
+		}
+		seen[row.email] = true
+
+		if rowResult.Status == models.ImportRowInvited {
+			result.Invited++
+		} else {
+			result.Failed++
+		}
+		result.Rows[i] = rowResult
+	}
+
+	s.logger.Info("Users imported",
+		zap.String("actor_id", actorID.String()),
+		zap.Int("invited", result.Invited),
+		zap.Int("failed", result.Failed))
+	return result, nil
+}
+
+// importUser creates the invited account of a valid row and sends its
+// invitation
+func (s *UserService) importUser(ctx context.Context, actorID uuid.UUID, row importRow, result models.ImportRowResult) models.ImportRowResult {
+	if _, err := s.repo.GetUserByEmail(ctx, row.email); err == nil {
+		result.Status = models.ImportRowFailed
+		result.Error = "account already exists"
+		return result
+	}
+
+	// Invited users cannot log in until they set their own password
+	unusable, err := bcrypt.GenerateFromPassword([]byte(uuid.NewString()), bcrypt.DefaultCost)
+	if err != nil {
+		result.Status = models.ImportRowFailed
+		result.Error = "failed to create account"
+		return result
+	}
+	user := &models.User{
+		Email:          row.email,
+		Username:       row.email,
+		HashedPassword: string(unusable),
+		FirstName:      row.firstName,
+		LastName:       row.lastName,
+		UserType:       models.ImportableRoles[row.role],
+		Role:           row.role,
+		AccountStatus:  models.AccountStatusInvited,
+	}
+	if err := s.repo.CreateUser(ctx, user); err != nil {
+		result.Status = models.ImportRowFailed
+		result.Error = "failed to create account"
+		if errors.Is(err, models.ErrUserExists) {
+			result.Error = "account already exists"
+		} else {
+			s.logger.Error("Failed to create imported user", zap.String("email", row.email), zap.Error(err))
+		}
+		return result
+	}
+	s.recordUserEvent(ctx, user.UserID, user.Email, models.UserEventRegistered)
+
+	result.Status = models.ImportRowInvited
+	result.UserID = user.UserID
+	if _, err := s.sendInvitation(ctx, user, actorID); err != nil {
+		s.logger.Warn("Failed to send invitation", zap.String("user_id", user.UserID.String()), zap.Error(err))
+		result.Error = "invitation email not sent, resend it"
+	} else {
+		result.EmailSent = true
+	}
+	return result
+}
+
+// ResendInvitation replaces the invitation token of a user still invited,
+// extending its expiry, and emails it again, on behalf of the administrator
+// actorID
+func (s *UserService) ResendInvitation(ctx context.Context, actorID, userID uuid.UUID) (*models.Invitation, error) {
+	admin, err := s.isAdministrator(ctx, actorID)
+	if err != nil {
+		return nil, err
+	}
+	if !admin {
+		return nil, models.ErrImportDenied
+	}
+
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.AccountStatus != models.AccountStatusInvited {
+		return nil, models.ErrNotInvited
+	}
+	invitedBy := actorID
+	if invitation, err := s.repo.GetInvitation(ctx, userID); err == nil {
+		invitedBy = invitation.InvitedBy
+	}
+	return s.sendInvitation(ctx, user, invitedBy)
+}
+
+// AcceptInvitation sets the password of the user invited with token and
+// activates their account
+func (s *UserService) AcceptInvitation(ctx context.Context, token, password string) (*models.User, error) {
+	if err := NewPasswordValidator().Validate(password); err != nil {
+		return nil, apperrors.New(apperrors.ErrInvalidArgument, err.Error())
+	}
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+
+	invitation, err := s.repo.AcceptInvitation(ctx, hashInvitationToken(token), string(hashedPassword), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.repo.GetUser(ctx, invitation.UserID)
+	if err != nil {
+		return nil, err
+	}
+	s.recordUserEvent(ctx, user.UserID, user.Email, models.UserEventUpdated)
+
+	// Replace the cached invited account
+	if err := s.cacheManager.SetUser(ctx, user); err != nil {
+		s.logger.Warn("Failed to cache user", zap.Error(err))
+	}
+	return user, nil
+}
+
+// sendInvitation issues a new invitation token for user and emails its link
+func (s *UserService) sendInvitation(ctx context.Context, user *models.User, invitedBy uuid.UUID) (*models.Invitation, error) {
+	token, err := newInvitationToken()
+	if err != nil {
+		return nil, err
+	}
+	invitation := &models.Invitation{
+		UserID:    user.UserID,
+		Email:     user.Email,
+		TokenHash: hashInvitationToken(token),
+		InvitedBy: invitedBy,
+		ExpiresAt: time.Now().Add(s.invitations.TTL),
+	}
+	if err := s.repo.SaveInvitation(ctx, invitation); err != nil {
+		return nil, err
+	}
+	if s.mailer == nil {
+		return nil, errors.New("invitations are not configured")
+	}
+
+	link := strings.ReplaceAll(s.invitations.URL, "{token}", token)
+	msg := mail.Message{
+		To:      []string{user.Email},
+		Subject: "You're invited to set up your account",
+		Body: fmt.Sprintf("Hi %s,\n\nAn account was created for you. Set your password to sign in:\n\n%s\n\nThis link expires on %s.\n",
+			user.FirstName, link, invitation.ExpiresAt.UTC().Format("January 2, 2006 15:04 MST")),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		return nil, err
+	}
+	if err := s.repo.MarkInvitationSent(ctx, user.UserID); err != nil {
+		s.logger.Warn("Failed to record invitation sending", zap.Error(err))
+	}
+	invitation.SendCount++
+	return invitation, nil
+}
+
+// parseImport reads the rows of a user import CSV
+func parseImport(data []byte) ([]importRow, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, models.ErrInvalidImport
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(strings.TrimPrefix(name, "\ufeff")))] = i
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, models.ErrInvalidImport
+	}
+	field := func(record []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rows []importRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, models.ErrInvalidImport
+		}
+		if len(rows) == models.MaxImportRows {
+			return nil, models.ErrInvalidImport
+		}
+
+		row := importRow{
+			email:     strings.ToLower(field(record, "email")),
+			firstName: field(record, "first_name"),
+			lastName:  field(record, "last_name"),
+			role:      strings.ToLower(field(record, "role")),
+		}
+		if name := field(record, "name"); name != "" && row.firstName == "" {
+			row.firstName, row.lastName, _ = strings.Cut(name, " ")
+			row.lastName = strings.TrimSpace(row.lastName)
+		}
+		if row.role == "" {
+			row.role = models.RoleUser
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, models.ErrInvalidImport
+	}
+	return rows, nil
+}
+
+// validateImportRow checks the fields of a row of a user import
+func validateImportRow(row importRow) error {
+	if address, err := netmail.ParseAddress(row.email); err != nil || address.Address != row.email {
+		return errors.New("invalid email")
+	}
+	if row.firstName == "" {
+		return errors.New("name is required")
+	}
+	if _, ok := models.ImportableRoles[row.role]; !ok {
+		return errors.New("role must be user, basic_seller, verified_seller or staff")
+	}
+	return nil
+}
+
+// newInvitationToken returns a random URL safe invitation token
+func newInvitationToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// hashInvitationToken returns the hash invitation tokens are stored by
+func hashInvitationToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
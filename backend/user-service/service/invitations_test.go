@@ -0,0 +1,55 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+func TestParseImport(t *testing.T) {
+	data := "\ufeffEmail, Name, Role\n" +
+		"Ada@Example.com, Ada Lovelace, staff\n" +
+		"grace@example.com, Grace,\n" +
+		"bad-email, Someone, user\n"
+	rows, err := parseImport([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("parsed %d rows, want 3", len(rows))
+	}
+
+	want := importRow{email: "ada@example.com", firstName: "Ada", lastName: "Lovelace", role: "staff"}
+	if rows[0] != want {
+		t.Errorf("row 1 = %+v, want %+v", rows[0], want)
+	}
+	if rows[1].role != models.RoleUser || rows[1].lastName != "" {
+		t.Errorf("row 2 = %+v, want role user and no last name", rows[1])
+	}
+
+	if err := validateImportRow(rows[0]); err != nil {
+		t.Errorf("row 1 invalid: %v", err)
+	}
+	if err := validateImportRow(rows[2]); err == nil {
+		t.Error("row with an invalid email is valid")
+	}
+	if err := validateImportRow(importRow{email: "x@example.com", firstName: "X", role: models.RoleAdmin}); err == nil {
+		t.Error("row importing an admin is valid")
+	}
+}
+
+func TestParseImportRejects(t *testing.T) {
+	tests := map[string]string{
+		"empty":         "",
+		"no email":      "name,role\nAda,user\n",
+		"no rows":       "email,name\n",
+		"too many rows": "email,name\n" + strings.Repeat("a@example.com,A\n", models.MaxImportRows+1),
+	}
+	for name, data := range tests {
+		if _, err := parseImport([]byte(data)); !errors.Is(err, models.ErrInvalidImport) {
+			t.Errorf("%s: error = %v, want ErrInvalidImport", name, err)
+		}
+	}
+}
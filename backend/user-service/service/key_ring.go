@@ -0,0 +1,317 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/jwks"
+)
+
+// KeyRingConfig configures where signing keys are kept and how they rotate
+type KeyRingConfig struct {
+	// Dir holds one PEM encoded RSA private key per file, named <kid>.pem.
+	// The modification time of a file is taken as the creation time of its
+	// key.
+	Dir string
+	// LegacyPrivateKeyPath is the single key used before rotation. It keeps
+	// verifying the tokens it signed, which carry no kid.
+	LegacyPrivateKeyPath string
+	// RotationInterval is how long a key signs before a new one takes over;
+	// zero disables generating keys
+	RotationInterval time.Duration
+	// PublishDelay is how long a new key is published before it signs, so
+	// that verifiers have fetched it by then
+	PublishDelay time.Duration
+	// Retention is how long a retired key keeps verifying, at least the
+	// lifetime of the longest lived token
+	Retention time.Duration
+	// ReloadInterval is how often the directory is read again, picking up
+	// keys added by hand or by another instance
+	ReloadInterval time.Duration
+}
+
+type ringKey struct {
+	kid       string
+	key       *rsa.PrivateKey
+	createdAt time.Time
+	path      string
+	legacy    bool
+}
+
+// KeyRing holds the keys tokens are signed and verified with. The newest
+// published key signs; older keys verify until their retention ends.
+type KeyRing struct {
+	config KeyRingConfig
+	logger *zap.Logger
+
+	mu   sync.RWMutex
+	keys []ringKey // Oldest first
+	now  func() time.Time
+}
+
+// NewKeyRing loads the keys of config, generating the first one when there
+// is none
+func NewKeyRing(config KeyRingConfig, logger *zap.Logger) (*KeyRing, error) {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	r := &KeyRing{
+		config: config,
+		logger: logger.Named("key_ring"),
+		now:    time.Now,
+	}
+
+	if config.Dir != "" {
+		if err := os.MkdirAll(config.Dir, 0o700); err != nil {
+			return nil, fmt.Errorf("could not create key directory: %w", err)
+		}
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	if len(r.keys) == 0 {
+		if config.Dir == "" {
+			return nil, fmt.Errorf("no signing key: set a key directory or a private key path")
+		}
+		if err := r.generate(); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// Start reloads the keys, rotates and prunes them every ReloadInterval until
+// ctx is done
+func (r *KeyRing) Start(ctx context.Context) {
+	if r.config.ReloadInterval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(r.config.ReloadInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			if err := r.Reload(); err != nil {
+				r.logger.Error("Failed to reload signing keys", zap.Error(err))
+				continue
+			}
+			if err := r.Rotate(); err != nil {
+				r.logger.Error("Failed to rotate signing keys", zap.Error(err))
+			}
+		}
+	}()
+}
+
+// Reload reads the key directory and the legacy key again. Keys that fail to
+// parse are skipped with an error log, so one bad file does not stop
+// signing.
+func (r *KeyRing) Reload() error {
+	var keys []ringKey
+
+	if r.config.LegacyPrivateKeyPath != "" {
+		key, createdAt, err := readPrivateKey(r.config.LegacyPrivateKeyPath)
+		switch {
+		case os.IsNotExist(err):
+		case err != nil:
+			return fmt.Errorf("could not load private key: %w", err)
+		default:
+			keys = append(keys, ringKey{
+				kid:       jwks.Thumbprint(&key.PublicKey),
+				key:       key,
+				createdAt: createdAt,
+				path:      r.config.LegacyPrivateKeyPath,
+				legacy:    true,
+			})
+		}
+	}
+
+	if r.config.Dir != "" {
+		paths, err := filepath.Glob(filepath.Join(r.config.Dir, "*.pem"))
+		if err != nil {
+			return err
+		}
+		for _, path := range paths {
+			key, createdAt, err := readPrivateKey(path)
+			if err != nil {
+				r.logger.Error("Skipping unreadable signing key", zap.String("path", path), zap.Error(err))
+				continue
+			}
+			keys = append(keys, ringKey{
+				kid:       strings.TrimSuffix(filepath.Base(path), ".pem"),
+				key:       key,
+				createdAt: createdAt,
+				path:      path,
+			})
+		}
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].createdAt.Before(keys[j].createdAt) })
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(keys) == 0 && len(r.keys) > 0 {
+		// Keep signing with the keys in memory rather than failing every login
+		return fmt.Errorf("no signing key found in %s", r.config.Dir)
+	}
+	r.keys = keys
+	return nil
+}
+
+// Rotate publishes a new key PublishDelay before the signing key reaches
+// RotationInterval, and deletes the keys of the directory whose retention
+// ended
+func (r *KeyRing) Rotate() error {
+	if r.config.RotationInterval <= 0 {
+		return nil
+	}
+
+	r.mu.RLock()
+	newest := r.keys[len(r.keys)-1]
+	r.mu.RUnlock()
+
+	if r.now().Sub(newest.createdAt) >= r.config.RotationInterval-r.config.PublishDelay {
+		if err := r.generate(); err != nil {
+			return err
+		}
+	}
+	return r.prune()
+}
+
+// SigningKey returns the key new tokens are signed with: the newest key
+// published for at least PublishDelay, or the newest key when none is
+func (r *KeyRing) SigningKey() (string, *rsa.PrivateKey) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	now := r.now()
+	for i := len(r.keys) - 1; i >= 0; i-- {
+		if !r.keys[i].createdAt.Add(r.config.PublishDelay).After(now) {
+			return r.keys[i].kid, r.keys[i].key
+		}
+	}
+	newest := r.keys[len(r.keys)-1]
+	return newest.kid, newest.key
+}
+
+// PublicKey returns the verification key of kid. An empty kid names the
+// legacy key, which signed tokens before they carried one.
+func (r *KeyRing) PublicKey(kid string) (*rsa.PublicKey, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, k := range r.keys {
+		if k.kid == kid || (kid == "" && k.legacy) {
+			return &k.key.PublicKey, true
+		}
+	}
+	return nil, false
+}
+
+// JWKS returns the public keys of the ring, newest first
+func (r *KeyRing) JWKS() jwks.Set {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	set := jwks.Set{Keys: make([]jwks.Key, 0, len(r.keys))}
+	for i := len(r.keys) - 1; i >= 0; i-- {
+		set.Keys = append(set.Keys, jwks.FromPublicKey(r.keys[i].kid, &r.keys[i].key.PublicKey))
+	}
+	return set
+}
+
+// generate writes a new key to the directory and adds it to the ring
+func (r *KeyRing) generate() error {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return fmt.Errorf("could not generate signing key: %w", err)
+	}
+
+	now := r.now().UTC()
+	kid := now.Format("20060102T150405Z")
+	path := filepath.Join(r.config.Dir, kid+".pem")
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	// Write to a temporary file first so a concurrent reload never reads a
+	// partial key
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("could not write signing key: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("could not write signing key: %w", err)
+	}
+
+	r.mu.Lock()
+	r.keys = append(r.keys, ringKey{kid: kid, key: key, createdAt: now, path: path})
+	r.mu.Unlock()
+
+	r.logger.Info("Generated signing key", zap.String("kid", kid), zap.Duration("publish_delay", r.config.PublishDelay))
+	return nil
+}
+
+// prune drops the keys retired for longer than Retention and deletes their
+// files. A key retires when the next one starts signing. The legacy key file
+// is never deleted, only left out of the ring.
+func (r *KeyRing) prune() error {
+	if r.config.Retention <= 0 {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.now()
+	kept := r.keys[:0]
+	for i, k := range r.keys {
+		if i < len(r.keys)-1 {
+			retiredAt := r.keys[i+1].createdAt.Add(r.config.PublishDelay)
+			if now.Sub(retiredAt) > r.config.Retention {
+				if !k.legacy {
+					if err := os.Remove(k.path); err != nil && !os.IsNotExist(err) {
+						return fmt.Errorf("could not delete signing key %s: %w", k.kid, err)
+					}
+					r.logger.Info("Retired signing key", zap.String("kid", k.kid))
+				}
+				continue
+			}
+		}
+		kept = append(kept, k)
+	}
+	r.keys = kept
+	return nil
+}
+
+// readPrivateKey parses a PEM encoded RSA private key and returns its file
+// modification time as its creation time
+func readPrivateKey(path string) (*rsa.PrivateKey, time.Time, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return key, info.ModTime(), nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestKeyRingRotation(t *testing.T) {
+	dir := t.TempDir()
+	config := KeyRingConfig{
+		Dir:              dir,
+		RotationInterval: 30 * 24 * time.Hour,
+		PublishDelay:     time.Hour,
+		Retention:        7 * 24 * time.Hour,
+	}
+	ring, err := NewKeyRing(config, zap.NewNop())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, _ := ring.SigningKey()
+	if _, ok := ring.PublicKey(first); !ok {
+		t.Fatalf("first key %s cannot verify", first)
+	}
+
+	// Shortly before the interval a new key is published but does not sign yet
+	start := time.Now()
+	ring.now = func() time.Time { return start.Add(config.RotationInterval - 30*time.Minute) }
+	if err := ring.Rotate(); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(ring.JWKS().Keys); got != 2 {
+		t.Fatalf("JWKS has %d keys after rotation, want 2", got)
+	}
+	if kid, _ := ring.SigningKey(); kid != first {
+		t.Errorf("signing key = %s during the publish delay, want %s", kid, first)
+	}
+
+	// Once published long enough the new key signs and the old one verifies
+	ring.now = func() time.Time { return start.Add(config.RotationInterval + time.Hour) }
+	second, _ := ring.SigningKey()
+	if second == first {
+		t.Fatalf("signing key is still %s after the publish delay", first)
+	}
+	if _, ok := ring.PublicKey(first); !ok {
+		t.Error("retired key no longer verifies")
+	}
+
+	// After the retention the old key is deleted
+	ring.now = func() time.Time { return start.Add(config.RotationInterval + config.Retention + 2*time.Hour) }
+	if err := ring.prune(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ring.PublicKey(first); ok {
+		t.Error("key past its retention still verifies")
+	}
+	if _, err := os.Stat(filepath.Join(dir, first+".pem")); !os.IsNotExist(err) {
+		t.Errorf("key file past its retention: %v, want deleted", err)
+	}
+
+	// A reload finds the remaining key on disk
+	if err := ring.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ring.PublicKey(second); !ok {
+		t.Error("reloaded ring misses the signing key")
+	}
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// StartQuoteExpiryScheduler expires the quotes past their expiry every
+// interval until ctx is done, so that buyers are notified of the expiry
+func (s *UserService) StartQuoteExpiryScheduler(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		s.logger.Warn("Quote expiry disabled by its configuration", zap.Duration("interval", interval))
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			expired, err := s.repo.ExpireQuotes(ctx, time.Now())
+			if err != nil {
+				s.logger.Error("Failed to expire quotes", zap.Error(err))
+				continue
+			}
+			if expired > 0 {
+				s.logger.Info("Quotes expired", zap.Int("quotes", expired))
+			}
+		}
+	}()
+}
+
+// RequestQuote requests a quote for the cart of a buyer. The quote keeps the
+// cart as it is now; the buyer may go on changing their cart.
+func (s *UserService) RequestQuote(ctx context.Context, userID uuid.UUID, notes string) (*models.Quote, error) {
+	notes = strings.TrimSpace(notes)
+	if len(notes) > models.MaxQuoteNotes {
+		return nil, models.ErrInvalidQuote
+	}
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	cart, err := s.repo.ListShopperItems(ctx, userID.String(), models.ListCart)
+	if err != nil {
+		return nil, err
+	}
+	if len(cart) == 0 {
+		return nil, models.ErrEmptyQuoteCart
+	}
+
+	quote := &models.Quote{
+		UserID:     userID,
+		Status:     models.QuoteRequested,
+		Items:      make([]models.QuoteItem, len(cart)),
+		BuyerNotes: notes,
+	}
+	for i, item := range cart {
+		quote.Items[i] = models.QuoteItem{
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+		}
+	}
+	// Quotes of company members are the company's
+	member, err := s.repo.GetCompanyMembership(ctx, userID)
+	if err != nil && !errors.Is(err, models.ErrCompanyMemberNotFound) {
+		return nil, err
+	}
+	if member != nil {
+		quote.CompanyID = &member.CompanyID
+	}
+
+	if err := s.repo.CreateQuote(ctx, quote); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Quote requested",
+		zap.String("quote_id", quote.QuoteID.String()),
+		zap.String("user_id", userID.String()),
+		zap.Int("items", len(quote.Items)))
+	return quote, nil
+}
+
+// RespondToQuote answers a requested quote with the negotiated unit price of
+// each of its items and the expiry of the offer. Quoted quotes not accepted
+// yet may be answered again.
+func (s *UserService) RespondToQuote(ctx context.Context, quoteID uuid.UUID, prices []models.QuoteItem, expiresAt time.Time, notes string) (*models.Quote, error) {
+	notes = strings.TrimSpace(notes)
+	now := time.Now()
+	if !expiresAt.After(now) || len(notes) > models.MaxQuoteNotes {
+		return nil, models.ErrInvalidQuote
+	}
+
+	quote, err := s.repo.GetQuote(ctx, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	if quote.Status != models.QuoteRequested && quote.Status != models.QuoteQuoted {
+		return nil, models.ErrQuoteState
+	}
+	if err := priceQuoteItems(quote.Items, prices); err != nil {
+		return nil, err
+	}
+
+	total := models.QuoteTotal(quote.Items)
+	quote.Status = models.QuoteQuoted
+	quote.Total = &total
+	quote.ExpiresAt = &expiresAt
+	quote.AdminNotes = notes
+	quote.QuotedAt = &now
+	if err := s.repo.UpdateQuoteStatus(ctx, quote, models.QuoteRequested, models.QuoteQuoted); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Quote answered",
+		zap.String("quote_id", quoteID.String()),
+		zap.Float64("total", total),
+		zap.Time("expires_at", expiresAt))
+	return quote, nil
+}
+
+// AcceptQuote accepts the offer of a quote on behalf of its buyer, before it
+// expires
+func (s *UserService) AcceptQuote(ctx context.Context, userID, quoteID uuid.UUID) (*models.Quote, error) {
+	quote, err := s.buyerQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	if quote.Status != models.QuoteQuoted {
+		return nil, models.ErrQuoteState
+	}
+	if err := s.expireQuote(ctx, quote); err != nil {
+		return nil, err
+	}
+
+	quote.Status = models.QuoteAccepted
+	if err := s.repo.UpdateQuoteStatus(ctx, quote, models.QuoteQuoted); err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// DeclineQuote declines a quote on behalf of its buyer, whether or not the
+// store answered it yet
+func (s *UserService) DeclineQuote(ctx context.Context, userID, quoteID uuid.UUID) (*models.Quote, error) {
+	quote, err := s.buyerQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	if quote.Status != models.QuoteRequested && quote.Status != models.QuoteQuoted {
+		return nil, models.ErrQuoteState
+	}
+
+	quote.Status = models.QuoteDeclined
+	if err := s.repo.UpdateQuoteStatus(ctx, quote, models.QuoteRequested, models.QuoteQuoted); err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// ConvertQuote records the order placed from an accepted quote of a buyer at
+// its quoted prices, before it expires. Converting again with the same order
+// reference returns the converted quote.
+func (s *UserService) ConvertQuote(ctx context.Context, userID, quoteID uuid.UUID, orderReference string) (*models.Quote, error) {
+	orderReference = strings.TrimSpace(orderReference)
+	if orderReference == "" {
+		return nil, models.ErrInvalidOrderReference
+	}
+	quote, err := s.buyerQuote(ctx, userID, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	if quote.Status == models.QuoteConverted && quote.OrderReference == orderReference {
+		return quote, nil
+	}
+	if quote.Status != models.QuoteAccepted {
+		return nil, models.ErrQuoteState
+	}
+	if err := s.expireQuote(ctx, quote); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	quote.Status = models.QuoteConverted
+	quote.OrderReference = orderReference
+	quote.ConvertedAt = &now
+	if err := s.repo.UpdateQuoteStatus(ctx, quote, models.QuoteAccepted); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Quote converted",
+		zap.String("quote_id", quoteID.String()),
+		zap.String("order_reference", orderReference))
+	return quote, nil
+}
+
+// GetQuote returns a quote
+func (s *UserService) GetQuote(ctx context.Context, quoteID uuid.UUID) (*models.Quote, error) {
+	return s.repo.GetQuote(ctx, quoteID)
+}
+
+// GetUserQuote returns a quote of a buyer
+func (s *UserService) GetUserQuote(ctx context.Context, userID, quoteID uuid.UUID) (*models.Quote, error) {
+	return s.buyerQuote(ctx, userID, quoteID)
+}
+
+// ListQuotes lists the quotes matching filter, most recent first
+func (s *UserService) ListQuotes(ctx context.Context, filter models.QuoteFilter, page, limit int) ([]models.Quote, int64, error) {
+	if filter.Status != "" && !models.IsValidQuoteStatus(filter.Status) {
+		return nil, 0, models.ErrInvalidQuoteStatus
+	}
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	return s.repo.ListQuotes(ctx, filter, page, limit)
+}
+
+// ListQuoteEvents returns the changes of status of quotes recorded after
+// afterID, for the notification service
+func (s *UserService) ListQuoteEvents(ctx context.Context, afterID int64, limit int) ([]models.QuoteEvent, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	if limit > 500 {
+		limit = 500
+	}
+	return s.repo.ListQuoteEvents(ctx, afterID, limit)
+}
+
+// buyerQuote returns a quote of userID; the quotes of other buyers are not
+// found
+func (s *UserService) buyerQuote(ctx context.Context, userID, quoteID uuid.UUID) (*models.Quote, error) {
+	quote, err := s.repo.GetQuote(ctx, quoteID)
+	if err != nil {
+		return nil, err
+	}
+	if quote.UserID != userID {
+		return nil, models.ErrQuoteNotFound
+	}
+	return quote, nil
+}
+
+// expireQuote expires a quote past its expiry ahead of the scheduler and
+// returns ErrQuoteExpired; it returns nil for quotes still valid
+func (s *UserService) expireQuote(ctx context.Context, quote *models.Quote) error {
+	if !quote.IsExpired(time.Now()) {
+		return nil
+	}
+	from := quote.Status
+	quote.Status = models.QuoteExpired
+	if err := s.repo.UpdateQuoteStatus(ctx, quote, from); err != nil && !errors.Is(err, models.ErrQuoteState) {
+		return err
+	}
+	return models.ErrQuoteExpired
+}
+
+// priceQuoteItems sets the unit prices of items from prices, which must
+// price each item with a non-negative amount
+func priceQuoteItems(items, prices []models.QuoteItem) error {
+	for i := range items {
+		items[i].UnitPrice = nil
+		for _, price := range prices {
+			if price.ProductID == items[i].ProductID && price.VariantID == items[i].VariantID && price.UnitPrice != nil {
+				unitPrice := *price.UnitPrice
+				items[i].UnitPrice = &unitPrice
+				break
+			}
+		}
+		if items[i].UnitPrice == nil || *items[i].UnitPrice < 0 {
+			return models.ErrInvalidQuote
+		}
+	}
+	return nil
+}
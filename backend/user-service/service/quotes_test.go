@@ -0,0 +1,55 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+func TestPriceQuoteItems(t *testing.T) {
+	price := func(amount float64) *float64 { return &amount }
+	items := []models.QuoteItem{
+		{ProductID: "p1", Quantity: 3},
+		{ProductID: "p2", VariantID: "v1", Quantity: 2},
+	}
+
+	err := priceQuoteItems(items, []models.QuoteItem{
+		{ProductID: "p2", VariantID: "v1", UnitPrice: price(4.5)},
+		{ProductID: "p1", UnitPrice: price(9.99)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total := models.QuoteTotal(items); total != 38.97 {
+		t.Errorf("QuoteTotal = %v, want 38.97", total)
+	}
+
+	for _, prices := range [][]models.QuoteItem{
+		{{ProductID: "p1", UnitPrice: price(9.99)}},
+		{{ProductID: "p1", UnitPrice: price(9.99)}, {ProductID: "p2", UnitPrice: price(4.5)}},
+		{{ProductID: "p1", UnitPrice: price(-1)}, {ProductID: "p2", VariantID: "v1", UnitPrice: price(4.5)}},
+	} {
+		if err := priceQuoteItems(items, prices); !errors.Is(err, models.ErrInvalidQuote) {
+			t.Errorf("priceQuoteItems(%+v) = %v, want ErrInvalidQuote", prices, err)
+		}
+	}
+}
+
+func TestQuoteIsExpired(t *testing.T) {
+	now := time.Now()
+	expiresAt := now.Add(time.Hour)
+	quote := &models.Quote{Status: models.QuoteQuoted, ExpiresAt: &expiresAt}
+	if quote.IsExpired(now) {
+		t.Error("quote expired before its expiry")
+	}
+	if !quote.IsExpired(expiresAt) {
+		t.Error("quote not expired at its expiry")
+	}
+
+	quote.Status = models.QuoteConverted
+	if quote.IsExpired(expiresAt) {
+		t.Error("converted quote expired")
+	}
+}
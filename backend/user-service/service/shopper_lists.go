@@ -0,0 +1,103 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// GetShopperList returns the cart, wishlist or recently viewed products of a
+// user or guest
+func (s *UserService) GetShopperList(ctx context.Context, ownerID, list string) ([]models.ShopperListItem, error) {
+	if err := validateShopperList(ownerID, list); err != nil {
+		return nil, err
+	}
+	return s.repo.ListShopperItems(ctx, ownerID, list)
+}
+
+// SetShopperListItem adds an item to a list or sets its cart quantity and
+// personalization; a quantity of 0 removes it. Outside carts the quantity is
+// always 1, and viewing a product again moves it to the top of the recently
+// viewed ones.
+func (s *UserService) SetShopperListItem(ctx context.Context, item *models.ShopperListItem) ([]models.ShopperListItem, error) {
+	if err := validateShopperList(item.OwnerID, item.List); err != nil {
+		return nil, err
+	}
+	item.ProductID = strings.TrimSpace(item.ProductID)
+	item.VariantID = strings.TrimSpace(item.VariantID)
+	if item.ProductID == "" || item.Quantity < 0 || item.Quantity > models.MaxCartQuantity {
+		return nil, models.ErrInvalidListItem
+	}
+
+	if item.List == models.ListCart && item.Quantity == 0 {
+		return s.RemoveShopperListItem(ctx, item.OwnerID, item.List, item.ProductID, item.VariantID)
+	}
+	if item.List != models.ListCart {
+		item.Quantity = 1
+		item.Personalization = nil
+	}
+	if len(item.Personalization) > models.MaxPersonalizationValues {
+		return nil, models.ErrInvalidListItem
+	}
+
+	if err := s.repo.UpsertShopperItem(ctx, item); err != nil {
+		return nil, err
+	}
+	if item.List == models.ListRecentlyViewed {
+		if err := s.repo.TrimShopperList(ctx, item.OwnerID, item.List, models.MaxRecentlyViewed); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.ListShopperItems(ctx, item.OwnerID, item.List)
+}
+
+// RemoveShopperListItem removes an item from a list
+func (s *UserService) RemoveShopperListItem(ctx context.Context, ownerID, list, productID, variantID string) ([]models.ShopperListItem, error) {
+	if err := validateShopperList(ownerID, list); err != nil {
+		return nil, err
+	}
+	if err := s.repo.DeleteShopperItem(ctx, ownerID, list, strings.TrimSpace(productID), strings.TrimSpace(variantID)); err != nil {
+		return nil, err
+	}
+	return s.repo.ListShopperItems(ctx, ownerID, list)
+}
+
+// MergeGuestData moves the cart, wishlist and recently viewed products of a
+// guest into the account they logged in to. The guest's lists are emptied,
+// so merging twice has no further effect.
+func (s *UserService) MergeGuestData(ctx context.Context, guestID string, userID uuid.UUID) (*models.GuestMergeResult, error) {
+	if !models.IsGuestID(guestID) {
+		return nil, models.ErrInvalidShopperOwner
+	}
+
+	// Verify user exists
+	if _, err := s.repo.GetUser(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.MergeShopperLists(ctx, guestID, userID.String(), models.MaxRecentlyViewed)
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Guest lists merged into user",
+		zap.String("user_id", userID.String()),
+		zap.Int("cart_items", result.Cart),
+		zap.Int("wishlist_items", result.Wishlist),
+		zap.Int("recently_viewed_items", result.RecentlyViewed))
+	return result, nil
+}
+
+func validateShopperList(ownerID, list string) error {
+	if !models.IsValidShopperOwner(ownerID) {
+		return models.ErrInvalidShopperOwner
+	}
+	if !models.IsValidShopperList(list) {
+		return models.ErrInvalidShopperList
+	}
+	return nil
+}
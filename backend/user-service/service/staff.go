@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// loadStaffPermissions sets the permission sets of staff users, for their
+// tokens to grant
+func (s *UserService) loadStaffPermissions(ctx context.Context, user *models.User) error {
+	if !user.IsStaff() {
+		return nil
+	}
+	sets, err := s.repo.GetStaffPermissions(ctx, user.UserID)
+	if err != nil {
+		return err
+	}
+	user.PermissionSets = sets
+	return nil
+}
+
+// isAdministrator reports whether actorID administers the store as a whole.
+// The actor is read from the database, not trusted from the caller.
+func (s *UserService) isAdministrator(ctx context.Context, actorID uuid.UUID) (bool, error) {
+	actor, err := s.repo.GetUser(ctx, actorID)
+	if errors.Is(err, models.ErrUserNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return actor.IsAdministrator(), nil
+}
+
+// authorizeStaffManager checks that actorID may manage staff: only
+// administrators do
+func (s *UserService) authorizeStaffManager(ctx context.Context, actorID uuid.UUID) error {
+	admin, err := s.isAdministrator(ctx, actorID)
+	if err != nil {
+		return err
+	}
+	if !admin {
+		return models.ErrPermissionAssignment
+	}
+	return nil
+}
+
+// normalizePermissionSets validates sets, dropping duplicates, in name order
+func normalizePermissionSets(sets []string) ([]string, error) {
+	normalized := []string{}
+	for _, set := range sets {
+		set = strings.TrimSpace(set)
+		if !models.IsValidPermissionSet(set) {
+			return nil, models.ErrInvalidPermissionSet
+		}
+		if !slices.Contains(normalized, set) {
+			normalized = append(normalized, set)
+		}
+	}
+	slices.Sort(normalized)
+	return normalized, nil
+}
+
+// CreateStaff creates a staff account holding the given permission sets, on
+// behalf of the administrator actorID
+func (s *UserService) CreateStaff(ctx context.Context, actorID uuid.UUID, req *models.RegisterRequest, sets []string) (*models.User, error) {
+	sets, err := normalizePermissionSets(sets)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeStaffManager(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	req.UserType = models.UserTypeAdmin
+	req.Role = models.RoleStaff
+	user, err := s.CreateUser(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.SetStaffPermissions(ctx, user.UserID, sets, actorID); err != nil {
+		return nil, err
+	}
+	user.PermissionSets = sets
+	s.recordUserEvent(ctx, user.UserID, user.Email, models.UserEventPermissionsChanged)
+	return user, nil
+}
+
+// SetStaffPermissions replaces the permission sets of a staff account, on
+// behalf of the administrator actorID. The change applies to the access
+// tokens issued from then on.
+func (s *UserService) SetStaffPermissions(ctx context.Context, actorID, userID uuid.UUID, sets []string) (*models.User, error) {
+	sets, err := normalizePermissionSets(sets)
+	if err != nil {
+		return nil, err
+	}
+	// No one changes their own permissions, not even administrators
+	if actorID == userID {
+		return nil, models.ErrSelfPermissionChange
+	}
+	if err := s.authorizeStaffManager(ctx, actorID); err != nil {
+		return nil, err
+	}
+
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.IsStaff() {
+		return nil, models.ErrNotStaff
+	}
+	if err := s.repo.SetStaffPermissions(ctx, userID, sets, actorID); err != nil {
+		return nil, err
+	}
+	user.PermissionSets = sets
+
+	s.logger.Info("Staff permissions changed",
+		zap.String("user_id", userID.String()),
+		zap.String("actor_id", actorID.String()),
+		zap.Strings("permission_sets", sets))
+	s.recordUserEvent(ctx, user.UserID, user.Email, models.UserEventPermissionsChanged)
+
+	// Refresh the cached user, which token refreshes read
+	if err := s.cacheManager.SetUser(ctx, user); err != nil {
+		s.logger.Warn("Failed to cache user", zap.Error(err))
+	}
+	return user, nil
+}
+
+// ListStaff lists the staff accounts of the store with their permission sets
+func (s *UserService) ListStaff(ctx context.Context) ([]*models.User, error) {
+	return s.repo.ListStaff(ctx)
+}
@@ -2,14 +2,14 @@ package service
 
 import (
 	"context"
-	"crypto/rsa"
 	"fmt"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
+	"github.com/louai60/e-commerce_project/backend/common/jwks"
+	"github.com/louai60/e-commerce_project/backend/common/scope"
 	"github.com/louai60/e-commerce_project/backend/user-service/models"
 	"github.com/louai60/e-commerce_project/backend/user-service/repository"
 	"go.uber.org/zap"
@@ -18,37 +18,22 @@ import (
 // JWTManager handles all JWT token operations including generation, validation,
 // and token pair management for secure authentication.
 type JWTManager struct {
-	privateKey           *rsa.PrivateKey       // RSA private key for signing tokens
-	publicKey            *rsa.PublicKey        // RSA public key for verifying tokens
+	keys                 *KeyRing              // Keys tokens are signed and verified with
 	accessTokenDuration  time.Duration         // Lifetime of access tokens
 	refreshTokenDuration time.Duration         // Lifetime of refresh tokens
 	repo                 repository.Repository // User data repository
 	logger               *zap.Logger           // Structured logger for operational insights
 }
 
-// NewJWTManager initializes a new JWT token manager with cryptographic keys and configuration.
+// NewJWTManager initializes a new JWT token manager with its key ring and configuration.
 func NewJWTManager(
-	privateKeyPath, publicKeyPath string,
+	keys *KeyRing,
 	accessTokenDuration, refreshTokenDuration time.Duration,
 	repo repository.Repository,
 	logger *zap.Logger,
 ) (*JWTManager, error) {
-	// Load and parse the RSA private key for token signing
-	privateKeyBytes, err := os.ReadFile(privateKeyPath)
-	if err != nil {
-		return nil, fmt.Errorf("could not read private key file: %w", err)
-	}
-	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privateKeyBytes)
-	if err != nil {
-		return nil, fmt.Errorf("could not parse private key: %w", err)
-	}
-
-	// Load and parse the RSA public key (optional for some implementations)
-	var publicKey *rsa.PublicKey
-	if publicKeyBytes, err := os.ReadFile(publicKeyPath); err == nil {
-		if publicKey, err = jwt.ParseRSAPublicKeyFromPEM(publicKeyBytes); err != nil {
-			return nil, fmt.Errorf("could not parse public key: %w", err)
-		}
+	if keys == nil {
+		return nil, fmt.Errorf("key ring is required")
 	}
 
 	// Initialize with default no-op logger if none provided
@@ -57,8 +42,7 @@ func NewJWTManager(
 	}
 
 	return &JWTManager{
-		privateKey:           privateKey,
-		publicKey:            publicKey,
+		keys:                 keys,
 		accessTokenDuration:  accessTokenDuration,
 		refreshTokenDuration: refreshTokenDuration,
 		repo:                 repo,
@@ -66,12 +50,23 @@ func NewJWTManager(
 	}, nil
 }
 
-// GetPublicKey provides access to the public key for external token validation.
-func (m *JWTManager) GetPublicKey() (*rsa.PublicKey, error) {
-	if m.publicKey == nil {
-		return nil, fmt.Errorf("public key not available")
+// Keyfunc returns the key a token is verified with, chosen by its kid header.
+// Tokens without a kid were signed by the legacy key.
+func (m *JWTManager) Keyfunc(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
 	}
-	return m.publicKey, nil
+	kid, _ := token.Header["kid"].(string)
+	key, ok := m.keys.PublicKey(kid)
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+// JWKS returns the public keys tokens are verified with, for the other services.
+func (m *JWTManager) JWKS() jwks.Set {
+	return m.keys.JWKS()
 }
 
 // GenerateTokenPair creates a new set of access and refresh tokens for user authentication.
@@ -86,11 +81,19 @@ func (m *JWTManager) GenerateTokenPair(user *models.User) (string, string, strin
 		"username":  user.Username,
 		"role":      user.Role,
 		"user_type": user.UserType,
+		"tenant_id": user.TenantID,
 		"iat":       time.Now().Unix(), // Issued at timestamp
 	}
 
+	// Only access tokens carry the scopes the services check; refreshing
+	// derives them again from the current role and permission sets
+	accessClaims := jwt.MapClaims{scope.Claim: scope.Join(models.UserScopes(user))}
+	for k, v := range commonClaims {
+		accessClaims[k] = v
+	}
+
 	// Generate access token with shorter lifespan
-	accessTokenString, err := m.generateToken("access", commonClaims)
+	accessTokenString, err := m.generateToken("access", accessClaims)
 	if err != nil {
 		return "", "", "", nil, fmt.Errorf("access token generation failed: %w", err)
 	}
@@ -118,12 +121,7 @@ func (m *JWTManager) GenerateTokenPair(user *models.User) (string, string, strin
 // ValidateToken thoroughly checks a refresh token's validity and ownership.
 func (m *JWTManager) ValidateToken(tokenString string) (*models.User, error) {
 	// Verify token signature and basic validity
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, fmt.Errorf("unexpected signing method")
-		}
-		return m.publicKey, nil
-	})
+	token, err := jwt.Parse(tokenString, m.Keyfunc)
 
 	if err != nil {
 		return nil, fmt.Errorf("token verification failed: %w", err)
@@ -190,8 +188,10 @@ func (m *JWTManager) generateToken(tokenType string, baseClaims jwt.MapClaims, e
 		zap.String("type", tokenType),
 		zap.Any("claims", claims))
 
+	kid, key := m.keys.SigningKey()
 	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
-	return token.SignedString(m.privateKey)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
 }
 
 // getTokenExpiration calculates the expiry time based on token type
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	applogger "github.com/louai60/e-commerce_project/backend/common/logger"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// recordUserEvent records an event of a user account for the admin activity
+// feed. The acting user is the authenticated user of the request when it is
+// not the user themselves. Failures are logged, never returned: the feed must
+// not fail the account operations.
+func (s *UserService) recordUserEvent(ctx context.Context, userID uuid.UUID, email, eventType string) {
+	actorID := applogger.UserIDFromContext(ctx)
+	if actorID == userID.String() {
+		actorID = ""
+	}
+
+	event := &models.UserEvent{
+		UserID:  userID,
+		Email:   email,
+		Type:    eventType,
+		ActorID: actorID,
+	}
+	if err := s.repo.CreateUserEvent(ctx, event); err != nil {
+		s.logger.Warn("Failed to record user event",
+			zap.String("user_id", userID.String()),
+			zap.String("type", eventType),
+			zap.Error(err))
+	}
+}
+
+// ListUserEvents returns the latest account events of the store, or those
+// before beforeID, newest first
+func (s *UserService) ListUserEvents(ctx context.Context, beforeID int64, limit int) ([]models.UserEvent, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 200 {
+		limit = 200
+	}
+	return s.repo.ListUserEvents(ctx, beforeID, limit)
+}
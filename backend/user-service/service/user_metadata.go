@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/common/metadata"
+	"github.com/louai60/e-commerce_project/backend/common/tenant"
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// SetFeatureFlags sets the client evaluating the flags of each store. Without
+// one, every flag takes its fallback.
+func (s *UserService) SetFeatureFlags(flags *featureflags.Client) {
+	s.flags = flags
+}
+
+// MetadataExposed reports whether user metadata is returned in the API
+// responses of the store of ctx
+func (s *UserService) MetadataExposed(ctx context.Context) bool {
+	return s.flags.IsEnabledOr(metadata.FlagKey, tenant.FromContext(ctx), false)
+}
+
+// UpdateUserMetadata applies patch to the metadata of a user as a JSON merge
+// patch, and returns the user with the resulting metadata
+func (s *UserService) UpdateUserMetadata(ctx context.Context, userID uuid.UUID, patch json.RawMessage) (*models.User, error) {
+	doc, err := s.repo.PatchUserMetadata(ctx, userID, patch)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	user.Metadata = doc
+
+	if err := s.cacheManager.SetUser(ctx, user); err != nil {
+		s.logger.Warn("Failed to cache user", zap.String("user_id", userID.String()), zap.Error(err))
+	}
+	return user, nil
+}
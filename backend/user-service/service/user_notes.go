@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// maxUserNoteLength bounds the body of a staff note, in characters
+const maxUserNoteLength = 5000
+
+// CreateUserNote attaches an internal note by a staff member to a user
+func (s *UserService) CreateUserNote(ctx context.Context, note *models.UserNote) (*models.UserNote, error) {
+	body, err := normalizeNoteBody(note.Body)
+	if err != nil {
+		return nil, err
+	}
+	note.Body = body
+
+	// Verify user exists
+	if _, err := s.repo.GetUser(ctx, note.UserID); err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.CreateUserNote(ctx, note); err != nil {
+		return nil, err
+	}
+
+	s.logger.Info("Note added to user",
+		zap.String("user_id", note.UserID.String()),
+		zap.String("note_id", note.NoteID.String()),
+		zap.String("author_id", note.AuthorID.String()))
+	return note, nil
+}
+
+// ListUserNotes returns the notes on a user, newest first
+func (s *UserService) ListUserNotes(ctx context.Context, userID uuid.UUID) ([]models.UserNote, error) {
+	return s.repo.ListUserNotes(ctx, userID)
+}
+
+// UpdateUserNote changes the body of a note. Only the author of a note may
+// edit it, so the attribution of a note stays truthful.
+func (s *UserService) UpdateUserNote(ctx context.Context, userID, noteID, authorID uuid.UUID, body string) (*models.UserNote, error) {
+	body, err := normalizeNoteBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	note, err := s.repo.GetUserNote(ctx, noteID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if note.AuthorID != authorID {
+		return nil, models.ErrNotNoteAuthor
+	}
+
+	note.Body = body
+	if err := s.repo.UpdateUserNote(ctx, note); err != nil {
+		return nil, err
+	}
+	return note, nil
+}
+
+// DeleteUserNote removes a note. Any staff member may remove a note.
+func (s *UserService) DeleteUserNote(ctx context.Context, userID, noteID uuid.UUID) error {
+	s.logger.Info("Deleting user note", zap.String("user_id", userID.String()), zap.String("note_id", noteID.String()))
+	return s.repo.DeleteUserNote(ctx, noteID, userID)
+}
+
+func normalizeNoteBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > maxUserNoteLength {
+		return "", models.ErrInvalidUserNote
+	}
+	return body, nil
+}
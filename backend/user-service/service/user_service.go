@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -15,6 +16,8 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/louai60/e-commerce_project/backend/common/mail"
+	"github.com/louai60/e-commerce_project/backend/shared/featureflags"
 	"github.com/louai60/e-commerce_project/backend/user-service/cache"
 	"github.com/louai60/e-commerce_project/backend/user-service/models"
 	"go.uber.org/zap"
@@ -26,6 +29,9 @@ type UserService struct {
 	rateLimiter  RateLimiter
 	tokenManager TokenManager
 	cacheManager cache.CacheInterface
+	mailer       mail.Mailer
+	invitations  InvitationConfig
+	flags        *featureflags.Client
 }
 
 type RateLimiter interface {
@@ -45,7 +51,7 @@ type UserServiceI interface {
 	CreateUser(ctx context.Context, req *models.RegisterRequest) (*models.User, error)
 	UpdateUser(ctx context.Context, user *models.User) (*models.User, error)
 	UpdatePassword(ctx context.Context, email string, newPassword string) error
-	DeleteUser(ctx context.Context, id uuid.UUID) error
+	DeleteUser(ctx context.Context, id, actorID uuid.UUID) error
 	Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error)
 	HealthCheck(ctx context.Context) error
 	GetUserByEmail(ctx context.Context, email string) (*models.User, error)
@@ -89,6 +95,9 @@ func (s *UserService) Authenticate(ctx context.Context, email, password string)
 			zap.Error(err))
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
+	if user.AccountStatus == models.AccountStatusInvited {
+		return nil, status.Error(codes.FailedPrecondition, models.ErrInvitationPending.Error())
+	}
 
 	// Verify password
 	err = bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(password))
@@ -99,6 +108,11 @@ func (s *UserService) Authenticate(ctx context.Context, email, password string)
 		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
 	}
 
+	if err := s.loadStaffPermissions(ctx, user); err != nil {
+		s.logger.Error("Failed to load staff permissions", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to load permissions")
+	}
+
 	return user, nil
 }
 
@@ -175,6 +189,9 @@ func (s *UserService) GetUser(ctx context.Context, id uuid.UUID) (*models.User,
 	if err != nil {
 		return nil, err
 	}
+	if err := s.loadStaffPermissions(ctx, user); err != nil {
+		return nil, err
+	}
 
 	// Store in cache for future requests
 	if err := s.cacheManager.SetUser(ctx, user); err != nil {
@@ -270,13 +287,12 @@ func (s *UserService) CreateUser(ctx context.Context, req *models.RegisterReques
 
 	if err := s.repo.CreateUser(ctx, user); err != nil {
 		s.logger.Error("Failed to create user in repository", zap.Error(err))
-		// Check for specific errors like duplicate email/username
-		if strings.Contains(err.Error(), "already exists") {
-			// Use the specific error message from the repository if available
-			return nil, status.Errorf(codes.AlreadyExists, "user already exists: %s", err.Error())
+		if errors.Is(err, models.ErrUserExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create user: %s", err.Error())
 	}
+	s.recordUserEvent(ctx, user.UserID, user.Email, models.UserEventRegistered)
 
 	return user, nil
 }
@@ -288,6 +304,7 @@ func (s *UserService) UpdateUser(ctx context.Context, user *models.User) (*model
 		s.logger.Error("Failed to update user", zap.Error(err))
 		return nil, err
 	}
+	s.recordUserEvent(ctx, user.UserID, user.Email, models.UserEventUpdated)
 
 	return user, nil
 }
@@ -314,9 +331,32 @@ func (s *UserService) UpdatePassword(ctx context.Context, email string, newPassw
 	return nil
 }
 
-func (s *UserService) DeleteUser(ctx context.Context, id uuid.UUID) error {
-	s.logger.Debug("Deleting user", zap.String("id", id.String()))
-	return s.repo.DeleteUser(ctx, id)
+// DeleteUser deletes the account id on behalf of actorID. Staff with the
+// users permission set manage customers only: administrator and staff
+// accounts are deleted by administrators.
+func (s *UserService) DeleteUser(ctx context.Context, id, actorID uuid.UUID) error {
+	s.logger.Debug("Deleting user", zap.String("id", id.String()), zap.String("actor_id", actorID.String()))
+
+	user, err := s.repo.GetUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if user.UserType == models.UserTypeAdmin {
+		admin, err := s.isAdministrator(ctx, actorID)
+		if err != nil {
+			return err
+		}
+		if !admin {
+			return models.ErrAccountDeletion
+		}
+	}
+
+	if err := s.repo.DeleteUser(ctx, id); err != nil {
+		return err
+	}
+	// The event keeps the email, since it outlives the account
+	s.recordUserEvent(ctx, id, user.Email, models.UserEventDeleted)
+	return nil
 }
 
 func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
@@ -330,6 +370,9 @@ func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 			zap.Error(err))
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
+	if user.AccountStatus == models.AccountStatusInvited {
+		return nil, status.Error(codes.FailedPrecondition, models.ErrInvitationPending.Error())
+	}
 
 	// Verify password
 	err = bcrypt.CompareHashAndPassword([]byte(user.HashedPassword), []byte(req.Password))
@@ -340,6 +383,11 @@ func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 		return nil, status.Errorf(codes.Unauthenticated, "invalid credentials")
 	}
 
+	if err := s.loadStaffPermissions(ctx, user); err != nil {
+		s.logger.Error("Failed to load staff permissions", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "failed to load permissions")
+	}
+
 	// Generate token pair
 	accessToken, _, refreshTokenID, refreshTokenCookie, err := s.tokenManager.GenerateTokenPair(user) // Use blank identifier for refreshToken string
 	if err != nil {
@@ -367,6 +415,7 @@ func (s *UserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Logi
 		// If storing the token state is critical, return an error.
 		return nil, status.Errorf(codes.Internal, "failed to update user state after login: %s", err.Error())
 	}
+	s.recordUserEvent(ctx, user.UserID, user.Email, models.UserEventLoggedIn)
 
 	// Prepare CookieInfo for gRPC response
 	cookieInfo := &pb.CookieInfo{
@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/louai60/e-commerce_project/backend/user-service/models"
+)
+
+// RecordOrderEvent consumes an order event of a user into their lifetime
+// metrics and refreshes the cached metrics. eventID makes the event
+// idempotent; it defaults to the order reference and the event type, so an
+// order has one event of each type unless the order system sets IDs, e.g.
+// for partial refunds. Events reported again leave the metrics unchanged.
+func (s *UserService) RecordOrderEvent(ctx context.Context, eventID string, event *models.OrderEvent) (*models.UserStats, error) {
+	event.OrderReference = strings.TrimSpace(event.OrderReference)
+	if event.OrderReference == "" {
+		return nil, models.ErrInvalidOrderReference
+	}
+	if !models.IsValidOrderEventType(event.Type) || event.Amount < 0 {
+		return nil, models.ErrInvalidOrderEvent
+	}
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	eventID = strings.TrimSpace(eventID)
+	if eventID == "" {
+		eventID = event.OrderReference + ":" + event.Type
+	}
+
+	stats, recorded, err := s.repo.RecordOrderEvent(ctx, eventID, event)
+	if err != nil {
+		return nil, err
+	}
+	if recorded {
+		s.logger.Debug("Order event recorded",
+			zap.String("user_id", event.UserID.String()),
+			zap.String("order_reference", event.OrderReference),
+			zap.String("type", event.Type))
+	}
+
+	if err := s.cacheManager.SetUserStats(ctx, stats); err != nil {
+		s.logger.Warn("Failed to cache user stats", zap.Error(err))
+	}
+	return stats, nil
+}
+
+// GetUserStats returns the lifetime metrics of a user, from the cache when
+// present
+func (s *UserService) GetUserStats(ctx context.Context, userID uuid.UUID) (*models.UserStats, error) {
+	stats, err := s.cacheManager.GetUserStats(ctx, userID.String())
+	if err == nil {
+		return stats, nil
+	}
+
+	stats, err = s.repo.GetUserStats(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.cacheManager.SetUserStats(ctx, stats); err != nil {
+		s.logger.Warn("Failed to cache user stats", zap.Error(err))
+	}
+	return stats, nil
+}
+
+// ListUserStats lists the customers matching the lifetime metrics of filter,
+// highest spend first, for segmentation
+func (s *UserService) ListUserStats(ctx context.Context, filter models.UserStatsFilter, page, limit int) ([]models.UserStats, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	if !filter.LastOrderAfter.IsZero() && !filter.LastOrderBefore.IsZero() && !filter.LastOrderAfter.Before(filter.LastOrderBefore) {
+		return nil, 0, models.ErrInvalidStatsPeriod
+	}
+	return s.repo.ListUserStats(ctx, filter, page, limit)
+}